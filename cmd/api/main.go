@@ -32,12 +32,14 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/getsentry/sentry-go"
 	sentryecho "github.com/getsentry/sentry-go/echo"
 	"github.com/jordanlanch/industrydb/config"
+	"github.com/jordanlanch/industrydb/pkg/activityreport"
 	"github.com/jordanlanch/industrydb/pkg/analytics"
 	"github.com/jordanlanch/industrydb/pkg/api/handlers"
 	custommw "github.com/jordanlanch/industrydb/pkg/api/middleware"
@@ -51,14 +53,27 @@ import (
 	"github.com/jordanlanch/industrydb/pkg/enrichment"
 	"github.com/jordanlanch/industrydb/pkg/slack"
 	"github.com/jordanlanch/industrydb/pkg/email"
+	"github.com/jordanlanch/industrydb/pkg/errortracking"
+	"github.com/jordanlanch/industrydb/pkg/crm"
+	"github.com/jordanlanch/industrydb/pkg/customfields"
 	"github.com/jordanlanch/industrydb/pkg/export"
+	"github.com/jordanlanch/industrydb/pkg/exporttemplate"
+	"github.com/jordanlanch/industrydb/pkg/geoip"
+	importpkg "github.com/jordanlanch/industrydb/pkg/import"
 	"github.com/jordanlanch/industrydb/pkg/industries"
 	"github.com/jordanlanch/industrydb/pkg/jobs"
+	"github.com/jordanlanch/industrydb/pkg/leadassignment"
+	"github.com/jordanlanch/industrydb/pkg/leadlifecycle"
 	"github.com/jordanlanch/industrydb/pkg/leads"
 	"github.com/jordanlanch/industrydb/pkg/metrics"
 	custommiddleware "github.com/jordanlanch/industrydb/pkg/middleware"
+	"github.com/jordanlanch/industrydb/pkg/notification"
+	"github.com/jordanlanch/industrydb/pkg/oauth"
+	"github.com/jordanlanch/industrydb/pkg/onboarding"
 	"github.com/jordanlanch/industrydb/pkg/organization"
+	"github.com/jordanlanch/industrydb/pkg/retention"
 	"github.com/jordanlanch/industrydb/pkg/savedsearch"
+	"github.com/jordanlanch/industrydb/pkg/scheduledexport"
 	"github.com/jordanlanch/industrydb/pkg/webhook"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
@@ -92,17 +107,14 @@ func main() {
 		err := sentry.Init(sentry.ClientOptions{
 			Dsn:              cfg.SentryDSN,
 			Environment:      cfg.SentryEnvironment,
-			TracesSampleRate: 1.0, // Capture 100% of transactions in development, adjust in production
+			TracesSampleRate: cfg.SentryTracesSampleRate,
 			AttachStacktrace: true,
-			BeforeSend: func(event *sentry.Event, hint *sentry.EventHint) *sentry.Event {
-				// Filter out sensitive data or customize events here
-				return event
-			},
+			BeforeSend:       errortracking.ScrubEvent, // Strip auth headers, passwords, API keys and PII
 		})
 		if err != nil {
 			log.Printf("⚠️  Failed to initialize Sentry: %v", err)
 		} else {
-			log.Printf("✅ Sentry initialized (environment: %s)", cfg.SentryEnvironment)
+			log.Printf("✅ Sentry initialized (environment: %s, traces sample rate: %.2f)", cfg.SentryEnvironment, cfg.SentryTracesSampleRate)
 			defer sentry.Flush(2 * time.Second)
 		}
 	} else {
@@ -116,7 +128,15 @@ func main() {
 		KeyPath:      cfg.DBSSLKeyPath,
 		RootCertPath: cfg.DBSSLRootCertPath,
 	}
-	db, err := database.NewClientWithSSL(cfg.DatabaseURL, sslCfg)
+	database.SetQueryTimeout(time.Duration(cfg.DBQueryTimeoutSeconds) * time.Second)
+	database.SetSlowQueryThreshold(time.Duration(cfg.DBSlowQueryThresholdMS) * time.Millisecond)
+	poolCfg := database.PoolConfigFor(cfg.APIEnvironment).Override(database.PoolConfig{
+		MaxOpenConns:    cfg.DBMaxOpenConns,
+		MaxIdleConns:    cfg.DBMaxIdleConns,
+		ConnMaxLifetime: time.Duration(cfg.DBConnMaxLifetimeSeconds) * time.Second,
+		ConnMaxIdleTime: time.Duration(cfg.DBConnMaxIdleTimeSeconds) * time.Second,
+	})
+	db, err := database.NewClientWithPoolAndSSL(cfg.DatabaseURL, poolCfg, sslCfg)
 	if err != nil {
 		log.Fatalf("❌ Failed to connect to database: %v", err)
 	}
@@ -131,6 +151,7 @@ func main() {
 
 	// Initialize Prometheus metrics
 	prometheusMetrics := metrics.New()
+	prometheusMetrics.RegisterDBPool(db.Stats)
 	log.Printf("✅ Prometheus metrics initialized")
 
 	// Initialize Echo
@@ -143,14 +164,19 @@ func main() {
 	authRateLimiter := custommiddleware.NewRateLimiter(cfg.RateLimitLoginPerMinute, cfg.RateLimitLoginBurst)                 // Login rate limit (configurable)
 	registerRateLimiter := custommiddleware.NewRateLimiter(cfg.RateLimitRegisterPerMinute, cfg.RateLimitRegisterBurst)       // Register rate limit (configurable)
 	webhookRateLimiter := custommiddleware.NewRateLimiter(100, 20)           // 100 req/min for Stripe webhooks
+	publicPreviewRateLimiter := custommiddleware.NewRateLimiter(cfg.RateLimitPublicPreviewPerMinute, cfg.RateLimitPublicPreviewBurst) // Anonymous lead preview (configurable)
 
 	// Global middleware
+	// Request IDs come first so every log line of a request, including slow
+	// queries, can be correlated
+	e.Use(custommiddleware.RequestID())
 	e.Use(middleware.RequestLoggerWithConfig(middleware.RequestLoggerConfig{
-		LogStatus: true,
-		LogURI:    true,
-		LogError:  true,
+		LogStatus:    true,
+		LogURI:       true,
+		LogError:     true,
+		LogRequestID: true,
 		LogValuesFunc: func(c echo.Context, v middleware.RequestLoggerValues) error {
-			log.Printf("[%s] %s - Status: %d", c.Request().Method, v.URI, v.Status)
+			log.Printf("[%s] %s - Status: %d - Request: %s", c.Request().Method, v.URI, v.Status, v.RequestID)
 			return nil
 		},
 	}))
@@ -167,11 +193,40 @@ func main() {
 	e.Use(prometheusMetrics.Middleware())
 
 	// CORS with restricted origins
-	e.Use(middleware.CORSWithConfig(custommiddleware.CORSConfig()))
+	corsConfig := custommiddleware.CORSConfigFor(cfg.APIEnvironment, cfg.CORSAllowedOrigins)
+	e.Use(middleware.CORSWithConfig(corsConfig))
+
+	// Export downloads pick their own encoding (see ExportHandler.Download),
+	// so compressed exports aren't compressed twice
+	e.Use(middleware.GzipWithConfig(middleware.GzipConfig{
+		Skipper: func(c echo.Context) bool {
+			return c.Path() == "/api/v1/exports/:id/download"
+		},
+	}))
 
-	e.Use(middleware.Gzip())
-	e.Use(middleware.Secure())
-	e.Use(custommiddleware.SecurityHeaders(custommiddleware.SecurityHeadersConfig{}))
+	// Security headers for the environment, with overrides from config
+	securityPolicy := custommiddleware.SecurityPolicyFor(cfg.APIEnvironment)
+	if cfg.ContentSecurityPolicy != "" {
+		securityPolicy.Headers.ContentSecurityPolicy = cfg.ContentSecurityPolicy
+	}
+	if cfg.DocsContentSecurityPolicy != "" {
+		securityPolicy.DocsContentSecurityPolicy = cfg.DocsContentSecurityPolicy
+	}
+	if cfg.HSTSMaxAge >= 0 {
+		securityPolicy.HSTSMaxAge = cfg.HSTSMaxAge
+	}
+	e.Use(custommiddleware.SecurityPolicyMiddleware(securityPolicy))
+
+	// Internal service tokens skip the global and tier rate limits. The
+	// secret must differ from the JWT secret so customers can't sign one.
+	serviceSecret := cfg.InternalServiceSecret
+	if serviceSecret != "" && (serviceSecret == cfg.JWTSecret || len(serviceSecret) < 32) {
+		log.Printf("⚠️  INTERNAL_SERVICE_SECRET must be at least 32 characters and differ from JWT_SECRET, internal service tokens disabled")
+		serviceSecret = ""
+	}
+	e.Use(custommiddleware.ServiceTokenMiddleware(serviceSecret, audit.NewService(db.Ent)))
+	globalRateLimiter.ExemptInternalServices()
+	tierRateLimiter.ExemptInternalServices()
 
 	// Global rate limiting (default 60 req/min)
 	e.Use(globalRateLimiter.RateLimitMiddleware())
@@ -281,6 +336,24 @@ func main() {
 		cfg.SendGridAPIKey,
 	)
 	// Service logs its own initialization status
+	emailService.SetDB(db.Ent) // Track sends for retries and bounce suppression
+	if cfg.EmailProvider != "sendgrid" || cfg.EmailFallbackProvider != "" {
+		err := emailService.ConfigureProviders(cfg.EmailProvider, cfg.EmailFallbackProvider, email.ProviderConfig{
+			SendGridAPIKey: cfg.SendGridAPIKey,
+			SES: email.SESConfig{
+				AWSAccessKeyID:     cfg.AWSAccessKeyID,
+				AWSSecretAccessKey: cfg.AWSSecretAccessKey,
+				AWSRegion:          cfg.SESRegion,
+			},
+		})
+		if err != nil {
+			log.Printf("⚠️  Failed to configure email providers, keeping defaults: %v", err)
+		}
+	}
+	if cfg.EmailTemplateDir != "" {
+		emailService.SetTemplateDir(cfg.EmailTemplateDir)
+		log.Printf("✅ Email templates loaded from %s (built-in defaults for missing templates)", cfg.EmailTemplateDir)
+	}
 
 	// Initialize Slack service (if webhook URL configured)
 	if cfg.SlackWebhookURL != "" {
@@ -316,10 +389,186 @@ func main() {
 		log.Printf("ℹ️  Backup service disabled (BACKUP_ENABLED=false)")
 	}
 
+	// Initialize data retention service (the nightly purge is opt-in)
+	var retentionArchiver retention.Archiver
+	if cfg.RetentionArchiveS3Bucket != "" {
+		s3Archiver, err := retention.NewS3Archiver(retention.S3Config{
+			AWSAccessKeyID:     cfg.AWSAccessKeyID,
+			AWSSecretAccessKey: cfg.AWSSecretAccessKey,
+			AWSRegion:          cfg.AWSRegion,
+			Bucket:             cfg.RetentionArchiveS3Bucket,
+		})
+		if err != nil {
+			// Never purge without the archive the operator asked for
+			log.Fatalf("❌ Failed to initialize retention archive: %v", err)
+		}
+		retentionArchiver = s3Archiver
+	}
+	retentionService := retention.NewService(db.Ent, retention.Config{
+		UsageLogRetentionDays: cfg.UsageLogRetentionDays,
+		AuditLogRetentionDays: cfg.AuditLogRetentionDays,
+	}, retentionArchiver)
+
+	// Initialize remote CSV import jobs (S3 imports need IMPORT_S3_BUCKET)
+	var importS3 *importpkg.S3Opener
+	if cfg.ImportS3Bucket != "" {
+		importS3, err = importpkg.NewS3Opener(importpkg.S3Config{
+			AWSAccessKeyID:     cfg.AWSAccessKeyID,
+			AWSSecretAccessKey: cfg.AWSSecretAccessKey,
+			AWSRegion:          cfg.AWSRegion,
+			Bucket:             cfg.ImportS3Bucket,
+		})
+		if err != nil {
+			log.Printf("⚠️  Failed to initialize S3 imports: %v", err)
+			importS3 = nil
+		}
+	}
+	importJobService := importpkg.NewJobService(db.Ent, importS3, importpkg.JobConfig{
+		MaxBytes:     int64(cfg.ImportMaxMB) * 1024 * 1024,
+		AllowedHosts: cfg.ImportAllowedHosts,
+		Timeout:      time.Duration(cfg.ImportTimeoutMin) * time.Minute,
+	})
+	if n, err := importJobService.FailInterrupted(context.Background()); err != nil {
+		log.Printf("⚠️  Failed to clean up interrupted import jobs: %v", err)
+	} else if n > 0 {
+		log.Printf("⚠️  Marked %d interrupted import jobs as failed", n)
+	}
+
+	// Configure lead quality score rubric
+	leads.SetQualityWeights(leads.QualityWeights{
+		Email:       cfg.QualityWeightEmail,
+		Phone:       cfg.QualityWeightPhone,
+		Website:     cfg.QualityWeightWebsite,
+		Address:     cfg.QualityWeightAddress,
+		Verified:    cfg.QualityWeightVerified,
+		Recency:     cfg.QualityWeightRecency,
+		RecencyDays: cfg.QualityRecencyWindowDays,
+	})
+
+	// Configure lead custom field limits
+	customfields.SetLimits(customfields.Limits{
+		MaxFields:     cfg.CustomFieldsMaxPerLead,
+		MaxKeyLength:  cfg.CustomFieldsMaxKeyLength,
+		MaxValueBytes: cfg.CustomFieldsMaxValueSize,
+	})
+
+	// Configure lead status SLAs
+	leadlifecycle.SetStatusSLAs(leadlifecycle.StatusSLAs{
+		leadlifecycle.StatusNew:         time.Duration(cfg.LeadSLANewDays) * 24 * time.Hour,
+		leadlifecycle.StatusContacted:   time.Duration(cfg.LeadSLAContactedDays) * 24 * time.Hour,
+		leadlifecycle.StatusQualified:   time.Duration(cfg.LeadSLAQualifiedDays) * 24 * time.Hour,
+		leadlifecycle.StatusNegotiating: time.Duration(cfg.LeadSLANegotiatingDays) * 24 * time.Hour,
+	})
+
+	// Configure per-tier usage limits (signup, upgrades and downgrades)
+	leads.SetTierUsageLimits(leads.TierUsageLimits{
+		"free":     cfg.UsageLimitFree,
+		"starter":  cfg.UsageLimitStarter,
+		"pro":      cfg.UsageLimitPro,
+		"business": cfg.UsageLimitBusiness,
+	})
+
+	// Configure per-tier export row caps (exports and search previews)
+	leads.SetTierExportRowCaps(leads.TierExportRowCaps{
+		"free":     cfg.ExportRowCapFree,
+		"starter":  cfg.ExportRowCapStarter,
+		"pro":      cfg.ExportRowCapPro,
+		"business": cfg.ExportRowCapBusiness,
+	})
+
+	// Configure per-tier maximum search page sizes (larger limits are clamped)
+	leads.SetTierMaxPageSizes(leads.TierMaxPageSizes{
+		"free":     cfg.MaxPageSizeFree,
+		"starter":  cfg.MaxPageSizeStarter,
+		"pro":      cfg.MaxPageSizePro,
+		"business": cfg.MaxPageSizeBusiness,
+	})
+
+	// Configure per-tier verified-only search and export defaults
+	leads.SetTierVerifiedOnly(leads.TierVerifiedOnly{
+		"free":     cfg.VerifiedOnlyFree,
+		"starter":  cfg.VerifiedOnlyStarter,
+		"pro":      cfg.VerifiedOnlyPro,
+		"business": cfg.VerifiedOnlyBusiness,
+	})
+
+	// Configure per-tier lead fields left out of search results
+	if err := leads.SetTierSearchHiddenFields(leads.TierSearchHiddenFields{
+		"free":     leads.ParseSearchFields(cfg.SearchHiddenFieldsFree),
+		"starter":  leads.ParseSearchFields(cfg.SearchHiddenFieldsStarter),
+		"pro":      leads.ParseSearchFields(cfg.SearchHiddenFieldsPro),
+		"business": leads.ParseSearchFields(cfg.SearchHiddenFieldsBusiness),
+	}); err != nil {
+		log.Fatalf("❌ Invalid SEARCH_HIDDEN_FIELDS_*: %v", err)
+	}
+
+	// Configure per-tier default monthly enrichment budgets
+	leads.SetTierEnrichmentBudgets(leads.TierEnrichmentBudgets{
+		"free":     {Calls: cfg.EnrichmentCallBudgetFree, CostCents: cfg.EnrichmentCostBudgetCentsFree},
+		"starter":  {Calls: cfg.EnrichmentCallBudgetStarter, CostCents: cfg.EnrichmentCostBudgetCentsStarter},
+		"pro":      {Calls: cfg.EnrichmentCallBudgetPro, CostCents: cfg.EnrichmentCostBudgetCentsPro},
+		"business": {Calls: cfg.EnrichmentCallBudgetBusiness, CostCents: cfg.EnrichmentCostBudgetCentsBusiness},
+	})
+
+	// Configure per-tier concurrent exports (further exports wait in the queue)
+	leads.SetTierExportConcurrency(leads.TierExportConcurrency{
+		"free":     cfg.ExportConcurrencyFree,
+		"starter":  cfg.ExportConcurrencyStarter,
+		"pro":      cfg.ExportConcurrencyPro,
+		"business": cfg.ExportConcurrencyBusiness,
+	})
+
+	// Configure export redaction profiles
+	redactionProfiles, err := export.ParseRedactionProfiles(cfg.ExportRedactionProfiles)
+	if err != nil {
+		log.Fatalf("❌ Invalid EXPORT_REDACTION_PROFILES: %v", err)
+	}
+	export.SetRedactionProfiles(redactionProfiles)
+	export.SetRedactionHashKey(cfg.ExportRedactionHashKey)
+	if cfg.ExportRedactionHashKey == "" && cfg.APIEnvironment == "production" {
+		log.Printf("⚠️  EXPORT_REDACTION_HASH_KEY is not set, hashed export fields are unkeyed")
+	}
+
+	// Load localized industry names (English comes from the industry configs)
+	if cfg.IndustryTranslationsPath != "" {
+		translations, err := industries.LoadTranslations(cfg.IndustryTranslationsPath)
+		if err != nil {
+			log.Printf("⚠️  Failed to load industry translations, serving English only: %v", err)
+		} else {
+			industries.SetTranslations(translations)
+			log.Printf("✅ Industry translations loaded for %d locales", len(translations))
+		}
+	}
+
 	// Initialize services
 	leadService := leads.NewService(db.Ent, redisClient)
+	leadService.SetRowEstimator(db) // Estimated counts for GET /leads/count
+	leadService.SetOrgScoping(cfg.LeadOrgScoping)
 	analyticsService := analytics.NewService(db.Ent)
 	exportService := export.NewService(db.Ent, leadService, analyticsService, cfg.StorageLocalPath)
+	exportService.ConfigureQueue(export.QueueConfig{
+		Workers:          cfg.ExportWorkers,
+		MaxQueued:        cfg.ExportMaxQueued,
+		MaxQueuedPerUser: cfg.ExportMaxQueuedPerUser,
+	}, prometheusMetrics)
+	exportService.SetCounters(prometheusMetrics)
+	if n, err := exportService.FailInterrupted(context.Background()); err != nil {
+		log.Printf("⚠️  Failed to clean up interrupted exports: %v", err)
+	} else if n > 0 {
+		log.Printf("⚠️  Marked %d interrupted exports as failed", n)
+	}
+	oauthService := oauth.NewService(db.Ent, cfg)
+	exportService.SetSheetsWriter(oauthService)
+	integrationCipher, err := oauth.NewIntegrationTokenCipher(cfg)
+	if err != nil {
+		log.Fatalf("❌ Failed to initialize integration token encryption: %v", err)
+	}
+	crmService := crm.NewService(db.Ent, leadService, integrationCipher, cfg.CRMPushMaxLeads)
+	if n, err := crmService.FailInterrupted(context.Background()); err != nil {
+		log.Printf("⚠️  Failed to clean up interrupted CRM push jobs: %v", err)
+	} else if n > 0 {
+		log.Printf("⚠️  Marked %d interrupted CRM push jobs as failed", n)
+	}
 	billingService := billing.NewService(db.Ent, leadService, &billing.StripeConfig{
 		SecretKey:       cfg.StripeSecretKey,
 		WebhookSecret:   cfg.StripeWebhookSecret,
@@ -337,47 +586,168 @@ func main() {
 	billingService.SetAuditLogger(billing.NewAuditServiceAdapter(auditLogger))
 	billingService.SetOrgMembershipChecker(organizationService)
 	billingService.SetIdempotencyStore(redisClient)
+	notificationPreferences := notification.NewService(db.Ent)
+	billingService.SetNotificationPreferences(notificationPreferences)
 	apiKeyService := apikey.NewService(db.Ent)
+	apiKeyRequestLog := apikey.NewRequestLog(redisClient)
 	industriesService := industries.NewService(db.Ent, redisClient)
+	leadReindexService := leads.NewReindexService(db.Ent, leadService, industriesService)
+	if n, err := leadReindexService.FailInterrupted(context.Background()); err != nil {
+		log.Printf("⚠️  Failed to clean up interrupted lead reindex jobs: %v", err)
+	} else if n > 0 {
+		log.Printf("⚠️  Marked %d interrupted lead reindex jobs as failed", n)
+	}
 	savedSearchService := savedsearch.NewService(db.Ent)
+	exportTemplateService := exporttemplate.NewService(db.Ent)
+	scheduledExportService := scheduledexport.NewService(db.Ent, exportService, leadService)
+	webhook.SetURLPolicy(webhook.URLPolicy{
+		AllowedHosts: cfg.WebhookAllowedHosts,
+		BlockedHosts: cfg.WebhookBlockedHosts,
+	})
+	if len(cfg.WebhookAllowedHosts) > 0 {
+		log.Printf("⚠️  Webhooks may target private hosts: %v", cfg.WebhookAllowedHosts)
+	}
 	webhookService := webhook.NewService(db.Ent)
+	webhookService.SetRedactor(export.LeadFieldRedactor{})
+	exportService.SetWebhookTrigger(webhookService)
 	log.Printf("✅ Webhook service initialized")
 
 	// Initialize cron manager for data acquisition jobs
 	cronManager := jobs.NewCronManager(db.Ent, redisClient, log.Default())
+	if cfg.LeadSLANotifyRep {
+		cronManager.GetLeadLifecycleService().SetNotifier(leadlifecycle.NewEmailNotifier(emailService))
+		cronManager.GetLeadLifecycleService().SetPreferences(notificationPreferences)
+	}
+	cronManager.SetEmailService(emailService)
+	cronManager.SetWebhookService(webhookService)
+	cronManager.SetScheduledExportService(scheduledExportService)
+	leadClaimTimeout := time.Duration(cfg.LeadClaimTimeoutHours) * time.Hour
+	if leadClaimTimeout > 0 {
+		claimService := leadassignment.NewService(db.Ent)
+		claimService.SetClaimTimeout(leadClaimTimeout)
+		cronManager.SetLeadClaimService(claimService)
+	}
+	if cfg.RetentionPurgeEnabled {
+		cronManager.SetRetentionService(retentionService)
+		log.Printf("✅ Data retention purge enabled (usage logs: %d days, audit logs: %d days, archive: %q)",
+			cfg.UsageLogRetentionDays, cfg.AuditLogRetentionDays, cfg.RetentionArchiveS3Bucket)
+	}
 	if err := cronManager.SetupJobs(); err != nil {
 		log.Fatalf("❌ Failed to setup cron jobs: %v", err)
 	}
 	cronManager.Start()
 	log.Printf("✅ Cron jobs started successfully")
 
+	// Cron job health (public): each job's last run and success, and whether
+	// it is overdue on its schedule. Overdue critical jobs report "degraded"
+	// without failing the check.
+	e.GET("/health/jobs", func(c echo.Context) error {
+		ctx, cancel := context.WithTimeout(c.Request().Context(), 2*time.Second)
+		defer cancel()
+
+		return c.JSON(http.StatusOK, cronManager.JobHealth(ctx, time.Now()))
+	})
+
 	// Initialize handlers
 	authHandler := handlers.NewAuthHandler(db.Ent, cfg, tokenBlacklist, redisClient, auditLogger, emailService)
+	sessionStore := auth.NewSessionStore(redisClient, tokenBlacklist)
+	sessionHandler := handlers.NewSessionHandler(sessionStore, auditLogger)
 	leadHandler := handlers.NewLeadHandler(leadService, analyticsService)
+	// Default the search country from the client's location
+	if cfg.GeoIPDatabasePath != "" {
+		countryResolver, err := geoip.Open(cfg.GeoIPDatabasePath)
+		if err != nil {
+			log.Printf("⚠️  Failed to open geo-IP database, search country defaults disabled: %v", err)
+		} else {
+			defer countryResolver.Close()
+			leadHandler.SetCountryResolver(countryResolver)
+			log.Printf("✅ Geo-IP search country defaults enabled")
+		}
+	}
+
+	// Configure the onboarding checklist
+	onboardingChecklist, err := onboarding.ParseChecklist(cfg.OnboardingChecklist)
+	if err == nil {
+		err = onboarding.SetChecklist(onboardingChecklist)
+	}
+	if err != nil {
+		log.Fatalf("❌ Invalid ONBOARDING_CHECKLIST: %v", err)
+	}
 	userHandler := handlers.NewUserHandler(db.Ent, leadService, auditLogger, billingService)
 	exportHandler := handlers.NewExportHandler(exportService, analyticsService)
+	exportHandler.SetAuditLogger(auditLogger)
+	exportTemplateHandler := handlers.NewExportTemplateHandler(exportTemplateService, exportService)
+	scheduledExportHandler := handlers.NewScheduledExportHandler(scheduledExportService)
+	integrationHandler := handlers.NewIntegrationHandler(oauthService, cfg, redisClient)
+	crmHandler := handlers.NewCRMHandler(crmService)
+	zapierHandler := handlers.NewZapierHandler(leadService)
 	billingHandler := handlers.NewBillingHandler(billingService)
 	auditHandler := handlers.NewAuditHandler(auditLogger)
 	adminHandler := handlers.NewAdminHandler(db.Ent, auditLogger)
+	activityReportHandler := handlers.NewActivityReportHandler(db.Ent, activityreport.NewService(db.Ent, auditLogger, analyticsService, sessionStore), auditLogger)
 	analyticsHandler := handlers.NewAnalyticsHandler(analyticsService)
 	organizationHandler := handlers.NewOrganizationHandler(organizationService)
 	apiKeyHandler := handlers.NewAPIKeyHandler(apiKeyService)
+	apiKeyHandler.SetRequestLog(apiKeyRequestLog)
 	industriesHandler := handlers.NewIndustryHandler(industriesService)
 	jobsHandler := handlers.NewJobsHandler(cronManager.GetMonitor())
-	savedSearchHandler := handlers.NewSavedSearchHandler(savedSearchService)
+	retentionHandler := handlers.NewRetentionHandler(retentionService)
+	importJobHandler := handlers.NewImportJobHandler(importJobService, auditLogger)
+	leadReindexHandler := handlers.NewLeadReindexHandler(leadReindexService, auditLogger)
+	emailTemplateHandler := handlers.NewEmailTemplateHandler(emailService)
+	emailDeliveryHandler := handlers.NewEmailDeliveryHandler(emailService, cfg.SendGridWebhookPublicKey)
+	savedSearchHandler := handlers.NewSavedSearchHandler(savedSearchService, leadService)
 	webhookHandler := handlers.NewWebhookHandler(webhookService)
+	webhookHandler.SetAuditLogger(auditLogger)
+	organizationHandler.SetWebhookService(webhookService)
 	batchHandler := handlers.NewBatchHandler(db.Ent, webhookService)
 	leadNoteHandler := handlers.NewLeadNoteHandler(db.Ent, auditLogger)
+	contactAttemptHandler := handlers.NewContactAttemptHandler(db.Ent)
+	notificationPreferenceHandler := handlers.NewNotificationPreferenceHandler(db.Ent)
 	leadLifecycleHandler := handlers.NewLeadLifecycleHandler(db.Ent, auditLogger)
+	leadVerificationHandler := handlers.NewLeadVerificationHandler(db.Ent, auditLogger)
 	customFieldsHandler := handlers.NewCustomFieldsHandler(db.Ent)
+	leadMergeHandler := handlers.NewLeadMergeHandler(db.Ent, auditLogger)
+	leadTagsHandler := handlers.NewLeadTagsHandler(db.Ent, leadService, auditLogger)
 	phoneHandler := handlers.NewPhoneHandler()
 	leadAssignmentHandler := handlers.NewLeadAssignmentHandler(db.Ent, auditLogger)
+	leadAssignmentHandler.SetNotifications(leadlifecycle.NewEmailNotifier(emailService), webhookService, notificationPreferences)
+	leadAssignmentHandler.SetClaimTimeout(leadClaimTimeout)
+
+	// Warn integrators nearing their tier rate limit
+	tierRateLimiter.SetTierWarningPercent("free", cfg.RateLimitWarningPercentFree)
+	tierRateLimiter.SetTierWarningPercent("starter", cfg.RateLimitWarningPercentStarter)
+	tierRateLimiter.SetTierWarningPercent("pro", cfg.RateLimitWarningPercentPro)
+	tierRateLimiter.SetTierWarningPercent("business", cfg.RateLimitWarningPercentBusiness)
+	tierRateLimiter.OnWarning(func(userID int, tier string, limits custommiddleware.TierLimits) {
+		ctx := context.Background()
+		data := map[string]interface{}{
+			"tier":                tier,
+			"requests_per_minute": limits.RequestsPerMinute,
+			"burst":               limits.Burst,
+			"warning_percent":     limits.WarningPercent,
+		}
+		// Members' preferences only apply to their personal webhooks
+		if notificationPreferences.Allows(ctx, userID, notification.EventRateLimit, notification.ChannelWebhook) {
+			webhookService.TriggerWebhooks(ctx, userID, webhook.EventRateLimitWarning, data)
+		}
+		webhookService.TriggerOrganizationWebhooks(ctx, userID, webhook.EventRateLimitWarning, data)
+	})
 	leadScoringHandler := handlers.NewLeadScoringHandler(db.Ent)
 	territoryHandler := handlers.NewTerritoryHandler(db.Ent)
-	emailSequenceHandler := handlers.NewEmailSequenceHandler(db.Ent)
+	territoryHandler.SetCache(redisClient)
+	emailSequenceHandler := handlers.NewEmailSequenceHandler(db.Ent, auditLogger)
 	funnelHandler := handlers.NewFunnelHandler(db.Ent)
 	cohortHandler := handlers.NewCohortHandler(db.Ent)
 	revenueHandler := handlers.NewRevenueHandler(db.Ent)
+	reportGuard := analytics.NewGuard(redisClient, analytics.GuardConfig{
+		MaxRangeDays: cfg.AnalyticsMaxRangeDays,
+		QueryTimeout: time.Duration(cfg.AnalyticsQueryTimeoutSeconds) * time.Second,
+		CacheTTL:     time.Duration(cfg.AnalyticsCacheTTLSeconds) * time.Second,
+	})
+	funnelHandler.SetGuard(reportGuard)
+	cohortHandler.SetGuard(reportGuard)
+	revenueHandler.SetGuard(reportGuard)
 	referralHandler := handlers.NewReferralHandler(db.Ent)
 	graphqlHandler := handlers.NewGraphQLHandler(
 		db.Ent,
@@ -388,12 +758,33 @@ func main() {
 		cfg.JWTSecret,
 		cfg.JWTExpirationHours,
 	)
+	graphqlHandler.SetSessionStore(sessionStore)
 
 	// Enrichment provider (stub for development - configure with real API in production)
 	// TODO: Replace with real provider (Clearbit, FullContact, etc.) in production
 	// Example: enrichmentProvider := clearbit.NewProvider(cfg.ClearbitAPIKey)
 	enrichmentProvider := &stubEnrichmentProvider{}
 	enrichmentHandler := handlers.NewEnrichmentHandler(db.Ent, enrichmentProvider)
+	enrichment.SetCandidateThresholds(enrichment.CandidateThresholds{
+		MinQualityScore: cfg.EnrichmentCandidateMinQuality,
+		DefaultLimit:    cfg.EnrichmentCandidateLimit,
+		MaxLimit:        cfg.EnrichmentCandidateMaxLimit,
+	})
+	enrichmentFields, err := enrichment.ParseFieldMap(cfg.EnrichmentFieldMap)
+	if err != nil {
+		log.Fatalf("❌ Invalid ENRICHMENT_FIELD_MAP: %v", err)
+	}
+	enrichment.SetAttemptCosts(enrichment.AttemptCosts{
+		Company:         cfg.EnrichmentCompanyCostCents,
+		EmailValidation: cfg.EnrichmentEmailValidationCostCents,
+	})
+	enrichment.SetFailedRetryAfter(time.Duration(cfg.EnrichmentFailedRetryHours) * time.Hour)
+	enrichment.SetMaxConcurrentCalls(cfg.EnrichmentMaxConcurrentCalls, prometheusMetrics)
+	enrichmentMapping := enrichment.DefaultFieldMapping().WithFields(enrichmentFields)
+	enrichmentMapping.Overwrite = enrichment.ParseOverwriteFields(cfg.EnrichmentOverwriteFields)
+	if err := enrichment.SetFieldMapping(enrichmentMapping); err != nil {
+		log.Fatalf("❌ Invalid enrichment field mapping: %v", err)
+	}
 	log.Printf("✅ Webhook and batch handlers initialized")
 
 	// Backup handler (admin only, if enabled)
@@ -436,41 +827,80 @@ func main() {
 	protected.Use(tierRateLimiter.Middleware()) // Apply tier-based rate limiting to all authenticated endpoints
 	{
 		// Lead routes (require email verification)
+		// Requests run as an organization with ?organization_id= see that
+		// organization's leads when LEAD_ORG_SCOPING is on
+		orgContext := custommiddleware.OptionalOrganizationContext(db.Ent, organizationService)
+		// Replays responses for retries carrying an Idempotency-Key header
+		idempotent := custommiddleware.Idempotency(redisClient, time.Duration(cfg.IdempotencyTTLHours)*time.Hour)
+		// Resolves the leads visible to the caller, after orgContext, and
+		// hides the lead in the path when it is outside them
+		leadScope := custommiddleware.LeadScope(leadService)
+		visibleLead := custommiddleware.RequireVisibleLead(db.Ent, "id")
+		visibleNotesLead := custommiddleware.RequireVisibleLead(db.Ent, "lead_id")
+
 		leadsGroup := protected.Group("/leads")
-		leadsGroup.Use(custommiddleware.RequireEmailVerified(db.Ent))
+		// Every lead route sees only the leads visible to the caller's
+		// organization (or the global pool without one) when
+		// LEAD_ORG_SCOPING is on; leads outside it are not found
+		leadsGroup.Use(custommiddleware.RequireEmailVerified(db.Ent), orgContext, leadScope)
 		{
 			leadsGroup.GET("", leadHandler.Search)
 			leadsGroup.GET("/preview", leadHandler.Preview) // Must be before /:id to avoid route conflict
+			leadsGroup.GET("/count", leadHandler.Count)
+			leadsGroup.GET("/clusters", leadHandler.Clusters)
+			leadsGroup.POST("/batch-get", leadHandler.BatchGet)
+			leadsGroup.GET("/suppressions", leadHandler.ListSuppressions)
+			leadsGroup.POST("/:id/suppress", leadHandler.Suppress, visibleLead)
+			leadsGroup.DELETE("/:id/suppress", leadHandler.Unsuppress, visibleLead)
 			leadsGroup.GET("/:id", leadHandler.GetByID)
+			leadsGroup.PATCH("/:id", leadHandler.UpdateLead) // Admins and owners of the lead's organization
+			leadsGroup.GET("/:id/history", leadHandler.GetHistory, visibleLead)
 			// Lead notes
-			leadsGroup.GET("/:lead_id/notes", leadNoteHandler.ListNotesByLead)
+			leadsGroup.GET("/:lead_id/notes", leadNoteHandler.ListNotesByLead, visibleNotesLead)
+			// Contact attempts (structured outreach log, separate from notes)
+			leadsGroup.POST("/:id/contact-attempts", contactAttemptHandler.CreateAttempt, visibleLead)
+			leadsGroup.GET("/:id/contact-attempts", contactAttemptHandler.ListAttempts, visibleLead)
+			leadsGroup.GET("/contact-stats", contactAttemptHandler.GetStats)
 			// Lead lifecycle
-			leadsGroup.PATCH("/:id/status", leadLifecycleHandler.UpdateLeadStatus)
-			leadsGroup.GET("/:id/status-history", leadLifecycleHandler.GetLeadStatusHistory)
+			leadsGroup.PATCH("/:id/status", leadLifecycleHandler.UpdateLeadStatus, visibleLead)
+			leadsGroup.GET("/:id/status-history", leadLifecycleHandler.GetLeadStatusHistory, visibleLead)
 			leadsGroup.GET("/by-status/:status", leadLifecycleHandler.GetLeadsByStatus)
 			leadsGroup.GET("/status-counts", leadLifecycleHandler.GetStatusCounts)
+			leadsGroup.GET("/overdue", leadLifecycleHandler.GetOverdueLeads)
+			// Lead verification
+			leadsGroup.POST("/:id/verify", leadVerificationHandler.VerifyLead, visibleLead)
+			leadsGroup.POST("/:id/unverify", leadVerificationHandler.UnverifyLead, visibleLead)
+			leadsGroup.GET("/:id/verification", leadVerificationHandler.GetVerificationStatus, visibleLead)
+			leadsGroup.GET("/:id/verification-history", leadVerificationHandler.GetVerificationHistory, visibleLead)
 			// Custom fields
-			leadsGroup.GET("/:id/custom-fields", customFieldsHandler.GetCustomFields)
-			leadsGroup.POST("/:id/custom-fields/set", customFieldsHandler.SetCustomField)
-			leadsGroup.PUT("/:id/custom-fields", customFieldsHandler.UpdateCustomFields)
-			leadsGroup.DELETE("/:id/custom-fields", customFieldsHandler.ClearCustomFields)
-			leadsGroup.DELETE("/:id/custom-fields/:key", customFieldsHandler.RemoveCustomField)
+			leadsGroup.GET("/:id/custom-fields", customFieldsHandler.GetCustomFields, visibleLead)
+			leadsGroup.POST("/:id/custom-fields/set", customFieldsHandler.SetCustomField, visibleLead)
+			leadsGroup.PUT("/:id/custom-fields", customFieldsHandler.UpdateCustomFields, visibleLead)
+			leadsGroup.DELETE("/:id/custom-fields", customFieldsHandler.ClearCustomFields, visibleLead)
+			leadsGroup.DELETE("/:id/custom-fields/:key", customFieldsHandler.RemoveCustomField, visibleLead)
+			// Duplicate merging
+			leadsGroup.POST("/:id/merge", leadMergeHandler.MergeLead, visibleLead)
+			// Tags
+			leadsGroup.PUT("/:id/tags", leadTagsHandler.SetTags, visibleLead)
+			leadsGroup.POST("/bulk-tags", leadTagsHandler.BulkUpdateTags)
 
 			// Lead assignment
-			leadsGroup.POST("/:id/assign", leadAssignmentHandler.AssignLead)
-			leadsGroup.POST("/:id/auto-assign", leadAssignmentHandler.AutoAssignLead)
-			leadsGroup.GET("/:id/assignment-history", leadAssignmentHandler.GetLeadAssignmentHistory)
-			leadsGroup.GET("/:id/current-assignment", leadAssignmentHandler.GetCurrentAssignment)
+			leadsGroup.POST("/:id/assign", leadAssignmentHandler.AssignLead, visibleLead)
+			leadsGroup.POST("/:id/auto-assign", leadAssignmentHandler.AutoAssignLead, visibleLead)
+			leadsGroup.GET("/:id/assignment-history", leadAssignmentHandler.GetLeadAssignmentHistory, visibleLead)
+			leadsGroup.GET("/:id/current-assignment", leadAssignmentHandler.GetCurrentAssignment, visibleLead)
+			leadsGroup.POST("/:id/claim", leadAssignmentHandler.ClaimLead, visibleLead)
+			leadsGroup.POST("/:id/release", leadAssignmentHandler.ReleaseLead, visibleLead)
 
 			// Lead scoring
-			leadsGroup.GET("/:id/score", leadScoringHandler.CalculateScore)
-			leadsGroup.POST("/:id/score", leadScoringHandler.UpdateScore)
+			leadsGroup.GET("/:id/score", leadScoringHandler.CalculateScore, visibleLead)
+			leadsGroup.POST("/:id/score", leadScoringHandler.UpdateScore, visibleLead)
 			leadsGroup.GET("/top-scoring", leadScoringHandler.GetTopScoringLeads)
 			leadsGroup.GET("/low-scoring", leadScoringHandler.GetLowScoringLeads)
 			leadsGroup.GET("/score-distribution", leadScoringHandler.GetScoreDistribution)
 
 			// Email sequence enrollments
-			leadsGroup.GET("/:id/enrollments", emailSequenceHandler.ListLeadEnrollments)
+			leadsGroup.GET("/:id/enrollments", emailSequenceHandler.ListLeadEnrollments, visibleLead)
 		}
 
 		// Lead notes routes (require email verification)
@@ -490,9 +920,13 @@ func main() {
 			// Territory CRUD
 			territoriesGroup.POST("", territoryHandler.CreateTerritory)
 			territoriesGroup.GET("", territoryHandler.ListTerritories)
+			territoriesGroup.GET("/performance", territoryHandler.ListTerritoryPerformance)
 			territoriesGroup.GET("/:id", territoryHandler.GetTerritory)
 			territoriesGroup.PUT("/:id", territoryHandler.UpdateTerritory)
 
+			// Territory performance (creator and members only)
+			territoriesGroup.GET("/:id/performance", territoryHandler.GetTerritoryPerformance)
+
 			// Territory members
 			territoriesGroup.POST("/:id/members", territoryHandler.AddMember)
 			territoriesGroup.GET("/:id/members", territoryHandler.GetTerritoryMembers)
@@ -518,6 +952,7 @@ func main() {
 			emailSequencesGroup.POST("/enroll", emailSequenceHandler.EnrollLead)
 			emailSequencesGroup.GET("/enrollments/:id", emailSequenceHandler.GetEnrollment)
 			emailSequencesGroup.POST("/enrollments/:id/stop", emailSequenceHandler.StopEnrollment)
+			emailSequencesGroup.POST("/:id/stop-all", emailSequenceHandler.StopAllEnrollments)
 		}
 
 		// User routes
@@ -525,6 +960,9 @@ func main() {
 		{
 			userGroup.GET("/usage", userHandler.GetUsage)
 			userGroup.PATCH("/profile", userHandler.UpdateProfile)
+			userGroup.GET("/notification-preferences", notificationPreferenceHandler.GetPreferences)
+			userGroup.PUT("/notification-preferences", notificationPreferenceHandler.UpdatePreferences)
+			userGroup.GET("/onboarding", userHandler.GetOnboarding)
 			userGroup.POST("/onboarding/complete", userHandler.CompleteOnboarding)
 			userGroup.POST("/onboarding/reset", userHandler.ResetOnboarding)
 			userGroup.GET("/data-export", userHandler.ExportPersonalData)
@@ -532,6 +970,9 @@ func main() {
 			userGroup.GET("/audit-logs", auditHandler.GetUserLogs)
 			userGroup.GET("/assigned-leads", leadAssignmentHandler.GetUserLeads)
 			userGroup.GET("/territories", territoryHandler.GetUserTerritories)
+			userGroup.GET("/sessions", sessionHandler.ListSessions)
+			userGroup.POST("/sessions/revoke-all", sessionHandler.RevokeAllSessions)
+			userGroup.POST("/sessions/:id/revoke", sessionHandler.RevokeSession)
 		}
 
 		// Analytics routes
@@ -540,6 +981,7 @@ func main() {
 			analyticsGroup.GET("/daily", analyticsHandler.GetDailyUsage)
 			analyticsGroup.GET("/summary", analyticsHandler.GetUsageSummary)
 			analyticsGroup.GET("/breakdown", analyticsHandler.GetActionBreakdown)
+			analyticsGroup.GET("/targeting", analyticsHandler.GetTargetingBreakdown)
 		}
 
 		// Funnel analytics routes (admin only)
@@ -550,6 +992,7 @@ func main() {
 			funnelGroup.GET("/details", funnelHandler.GetFunnelDetails)
 			funnelGroup.GET("/dropoff", funnelHandler.GetDropoffAnalysis)
 			funnelGroup.GET("/time-to-conversion", funnelHandler.GetTimeToConversion)
+			funnelGroup.GET("/outreach", funnelHandler.GetOutreachFunnel)
 		}
 
 		// Cohort analytics routes (admin only)
@@ -586,28 +1029,68 @@ func main() {
 		// Enrichment routes (protected - requires auth)
 		enrichmentGroup := protected.Group("/enrichment")
 		{
-			enrichmentGroup.GET("/stats", enrichmentHandler.GetEnrichmentStats)
+			enrichmentGroup.GET("/stats", enrichmentHandler.GetEnrichmentStats, orgContext)
 		}
-		protected.POST("/leads/:id/enrich", enrichmentHandler.EnrichLead)
-		protected.GET("/leads/:id/validate-email", enrichmentHandler.ValidateLeadEmail)
-		protected.POST("/leads/bulk-enrich", enrichmentHandler.BulkEnrichLeads)
+		// Enrichments run as an organization with ?organization_id= are
+		// charged to the organization's budget
+		protected.POST("/leads/:id/enrich", enrichmentHandler.EnrichLead, orgContext, leadScope, visibleLead)
+		protected.GET("/leads/:id/validate-email", enrichmentHandler.ValidateLeadEmail, orgContext, leadScope, visibleLead)
+		protected.GET("/leads/:id/enrichment-history", enrichmentHandler.GetEnrichmentHistory, orgContext, leadScope, visibleLead)
+		protected.POST("/leads/bulk-enrich", enrichmentHandler.BulkEnrichLeads, orgContext)
 
 		// Export routes (require email verification)
 		exportsGroup := protected.Group("/exports")
 		exportsGroup.Use(custommiddleware.RequireEmailVerified(db.Ent))
 		{
-			exportsGroup.POST("", exportHandler.Create)
-			exportsGroup.GET("", exportHandler.List)
+			exportsGroup.POST("", exportHandler.Create, orgContext, idempotent)
+			exportsGroup.POST("/estimate", exportHandler.Estimate, orgContext)
+			exportsGroup.POST("/from-template/:id", exportTemplateHandler.CreateExport, orgContext, idempotent)
+			exportsGroup.GET("", exportHandler.List, orgContext)
 			exportsGroup.GET("/:id", exportHandler.Get)
 			// Download route now requires Authorization header (more secure than query parameter)
 			exportsGroup.GET("/:id/download", exportHandler.Download)
 		}
 
+		// Export template routes (reusable export configurations)
+		exportTemplatesGroup := protected.Group("/export-templates")
+		{
+			exportTemplatesGroup.POST("", exportTemplateHandler.Create)
+			exportTemplatesGroup.GET("", exportTemplateHandler.List)
+			exportTemplatesGroup.GET("/:id", exportTemplateHandler.Get)
+			exportTemplatesGroup.DELETE("/:id", exportTemplateHandler.Delete)
+		}
+
+		// Scheduled export routes (recurring exports, require email verification)
+		scheduledExportsGroup := protected.Group("/scheduled-exports")
+		scheduledExportsGroup.Use(custommiddleware.RequireEmailVerified(db.Ent))
+		{
+			scheduledExportsGroup.POST("", scheduledExportHandler.Create)
+			scheduledExportsGroup.GET("", scheduledExportHandler.List)
+			scheduledExportsGroup.GET("/:id", scheduledExportHandler.Get)
+			scheduledExportsGroup.POST("/:id/pause", scheduledExportHandler.Pause)
+			scheduledExportsGroup.POST("/:id/resume", scheduledExportHandler.Resume)
+			scheduledExportsGroup.DELETE("/:id", scheduledExportHandler.Delete)
+		}
+
+		// Integration routes (accounts connected for export delivery)
+		integrationsGroup := protected.Group("/integrations")
+		{
+			integrationsGroup.GET("", integrationHandler.List)
+			integrationsGroup.GET("/google/connect", integrationHandler.ConnectGoogle)
+			integrationsGroup.DELETE("/google", integrationHandler.DisconnectGoogle)
+			integrationsGroup.GET("/hubspot", crmHandler.GetHubSpot)
+			integrationsGroup.PUT("/hubspot", crmHandler.SaveHubSpotToken)
+			integrationsGroup.DELETE("/hubspot", crmHandler.DeleteHubSpot)
+			integrationsGroup.POST("/hubspot/push", crmHandler.PushToHubSpot)
+			integrationsGroup.GET("/hubspot/jobs", crmHandler.ListHubSpotJobs)
+			integrationsGroup.GET("/hubspot/jobs/:id", crmHandler.GetHubSpotJob)
+		}
+
 		// Billing routes (checkout requires email verification)
 		billingGroup := protected.Group("/billing")
 		{
 			// Checkout requires email verification to prevent unverified users from upgrading
-			billingGroup.POST("/checkout", billingHandler.CreateCheckout, custommiddleware.RequireEmailVerified(db.Ent))
+			billingGroup.POST("/checkout", billingHandler.CreateCheckout, custommiddleware.RequireEmailVerified(db.Ent), idempotent)
 			billingGroup.POST("/portal", billingHandler.CreatePortalSession)
 		}
 
@@ -623,15 +1106,20 @@ func main() {
 			organizationGroup.POST("/:id/invite", organizationHandler.InviteMember)
 			organizationGroup.DELETE("/:id/members/:user_id", organizationHandler.RemoveMember)
 			organizationGroup.PATCH("/:id/members/:user_id", organizationHandler.UpdateMemberRole)
+			organizationGroup.GET("/:id/webhooks", organizationHandler.ListWebhooks)
+			organizationGroup.POST("/:id/webhooks", organizationHandler.CreateWebhook, idempotent)
+			organizationGroup.PATCH("/:id/webhooks/:webhook_id", organizationHandler.UpdateWebhook)
+			organizationGroup.DELETE("/:id/webhooks/:webhook_id", organizationHandler.DeleteWebhook)
 		}
 
 		// API Key routes (Business tier feature)
 		apiKeyGroup := protected.Group("/api-keys")
 		{
-			apiKeyGroup.POST("", apiKeyHandler.Create)
+			apiKeyGroup.POST("", apiKeyHandler.Create, idempotent)
 			apiKeyGroup.GET("", apiKeyHandler.List)
 			apiKeyGroup.GET("/stats", apiKeyHandler.GetStats)
 			apiKeyGroup.GET("/:id", apiKeyHandler.Get)
+			apiKeyGroup.GET("/:id/requests", apiKeyHandler.ListRequests)
 			apiKeyGroup.POST("/:id/revoke", apiKeyHandler.Revoke)
 			apiKeyGroup.PATCH("/:id", apiKeyHandler.UpdateName)
 			apiKeyGroup.DELETE("/:id", apiKeyHandler.Delete)
@@ -643,6 +1131,7 @@ func main() {
 			savedSearchGroup.POST("", savedSearchHandler.Create)
 			savedSearchGroup.GET("", savedSearchHandler.List)
 			savedSearchGroup.GET("/:id", savedSearchHandler.Get)
+			savedSearchGroup.GET("/:id/run", savedSearchHandler.Run, orgContext)
 			savedSearchGroup.PATCH("/:id", savedSearchHandler.Update)
 			savedSearchGroup.DELETE("/:id", savedSearchHandler.Delete)
 		}
@@ -650,10 +1139,16 @@ func main() {
 		// Webhook routes
 		webhookGroup := protected.Group("/webhooks")
 		{
-			webhookGroup.POST("", webhookHandler.CreateWebhook)
+			webhookGroup.POST("", webhookHandler.CreateWebhook, idempotent)
 			webhookGroup.GET("", webhookHandler.ListWebhooks)
+			webhookGroup.GET("/schema-versions", webhookHandler.ListSchemaVersions)
+			webhookGroup.POST("/bulk-delete", webhookHandler.BulkDeleteWebhooks)
 			webhookGroup.GET("/:id", webhookHandler.GetWebhook)
 			webhookGroup.PATCH("/:id", webhookHandler.UpdateWebhook)
+			webhookGroup.POST("/:id/pause", webhookHandler.PauseWebhook)
+			webhookGroup.POST("/:id/resume", webhookHandler.ResumeWebhook)
+			webhookGroup.GET("/:id/deliveries/failed", webhookHandler.ListFailedDeliveries)
+			webhookGroup.POST("/:id/deliveries/:delivery_id/replay", webhookHandler.ReplayDelivery)
 			webhookGroup.DELETE("/:id", webhookHandler.DeleteWebhook)
 		}
 
@@ -679,17 +1174,41 @@ func main() {
 		adminGroup.Use(custommiddleware.RequireAdmin(db.Ent))
 		{
 			adminGroup.GET("/stats", adminHandler.GetStats)
+			adminGroup.GET("/analytics/exports", analyticsHandler.GetExportAnalytics)
 			adminGroup.GET("/users", adminHandler.ListUsers)
+			adminGroup.POST("/users/bulk-update", adminHandler.BulkUpdateUsers)
 			adminGroup.GET("/users/:id", adminHandler.GetUser)
 			adminGroup.PATCH("/users/:id", adminHandler.UpdateUser)
 			adminGroup.DELETE("/users/:id", adminHandler.SuspendUser)
+			adminGroup.GET("/users/:id/activity-report", activityReportHandler.GetUserActivityReport)
 
 			// CSV bulk import routes
 			importGroup := adminGroup.Group("/import")
 			{
 				importGroup.POST("/csv", adminHandler.ImportLeadsCSV)
+				importGroup.POST("/csv-url", importJobHandler.ImportFromURL)
+				importGroup.GET("/jobs", importJobHandler.ListJobs)
+				importGroup.GET("/jobs/:id", importJobHandler.GetJob)
 			}
 
+			// Lead data maintenance routes
+			adminGroup.POST("/leads/bulk-verify", leadVerificationHandler.BulkVerifyLeads)
+			adminGroup.POST("/leads/recompute-quality", leadHandler.RecomputeQuality)
+			adminGroup.POST("/leads/reindex", leadReindexHandler.StartReindex)
+			adminGroup.GET("/leads/reindex", leadReindexHandler.ListReindexJobs)
+			adminGroup.GET("/leads/reindex/:id", leadReindexHandler.GetReindexJob)
+			adminGroup.GET("/leads/enrichment-candidates", enrichmentHandler.GetEnrichmentCandidates)
+			adminGroup.GET("/enrichment/config", enrichmentHandler.GetEnrichmentConfig)
+			adminGroup.GET("/enrichment/budgets/:scope/:id", enrichmentHandler.GetEnrichmentBudget)
+			adminGroup.PUT("/enrichment/budgets/:scope/:id", enrichmentHandler.SetEnrichmentBudget)
+			adminGroup.POST("/leads/enrichment-candidates", enrichmentHandler.EnrichCandidates)
+
+			// Lead visibility routes (see LEAD_ORG_SCOPING)
+			adminGroup.PUT("/leads/:id/owner", leadHandler.SetLeadOwner)
+			adminGroup.GET("/organizations/:id/lead-licenses", leadHandler.ListLeadLicenses)
+			adminGroup.POST("/organizations/:id/lead-licenses", leadHandler.GrantLeadLicense)
+			adminGroup.DELETE("/organizations/:id/lead-licenses/:license_id", leadHandler.RevokeLeadLicense)
+
 			// Data acquisition job routes
 			jobsGroup := adminGroup.Group("/jobs")
 			{
@@ -701,6 +1220,18 @@ func main() {
 				jobsGroup.POST("/auto-populate", jobsHandler.AutoPopulateHandler)
 			}
 
+			// Email template routes
+			adminGroup.GET("/email-templates", emailTemplateHandler.ListTemplates)
+			adminGroup.POST("/email-templates/:name/preview", emailTemplateHandler.Preview)
+			adminGroup.GET("/email/failures", emailDeliveryHandler.ListFailures)
+
+			// Data retention routes
+			retentionGroup := adminGroup.Group("/retention")
+			{
+				retentionGroup.GET("/preview", retentionHandler.Preview)
+				retentionGroup.POST("/purge", retentionHandler.Purge)
+			}
+
 			// Backup routes (if backup service enabled)
 			if backupHandler != nil {
 				backupGroup := adminGroup.Group("/backup")
@@ -717,18 +1248,37 @@ func main() {
 	v1.GET("/pricing", billingHandler.GetPricing)
 	// Stripe webhook with higher rate limit: 100 per minute
 	v1.POST("/webhook/stripe", billingHandler.HandleWebhook, webhookRateLimiter.RateLimitMiddleware())
+	v1.POST("/webhook/sendgrid", emailDeliveryHandler.HandleSendGridEvents, webhookRateLimiter.RateLimitMiddleware())
+
+	// Google consent redirect (no JWT, the one-time state identifies the user)
+	v1.GET("/integrations/google/callback", integrationHandler.GoogleCallback, webhookRateLimiter.RateLimitMiddleware())
+
+	// Zapier polling triggers (API key auth, Zapier cannot refresh JWTs)
+	zapierGroup := v1.Group("/integrations/zapier")
+	zapierGroup.Use(custommw.APIKeyMiddleware(apiKeyService, db.Ent, apiKeyRequestLog))
+	zapierGroup.Use(tierRateLimiter.Middleware())
+	{
+		zapierGroup.GET("/new-leads", zapierHandler.NewLeads)
+		zapierGroup.GET("/new-leads/sample", zapierHandler.NewLeadsSample)
+	}
+
+	// Public lead preview (no authentication, masked contacts, strict per-IP limit)
+	v1.GET("/public/leads/preview", leadHandler.PublicPreview, publicPreviewRateLimiter.RateLimitMiddleware())
 
 	// Public industries routes (no authentication required)
 	industriesGroup := v1.Group("/industries")
 	{
 		industriesGroup.GET("", industriesHandler.ListIndustries)
 		industriesGroup.GET("/with-leads", industriesHandler.ListIndustriesWithLeads)
+		industriesGroup.GET("/categories", industriesHandler.ListCategories)
+		industriesGroup.GET("/version", industriesHandler.GetConfigVersion) // Must be before /:id
 		industriesGroup.GET("/:id", industriesHandler.GetIndustry)
 		industriesGroup.GET("/:id/sub-niches", industriesHandler.GetSubNiches)
+		industriesGroup.GET("/:id/fields", industriesHandler.GetFields)
 	}
 
 	// Filter options routes (public - no auth required)
-	filterHandler := handlers.NewFilterHandler(db.Ent)
+	filterHandler := handlers.NewFilterHandler(db.Ent, redisClient)
 	filtersGroup := v1.Group("/leads/filters")
 	{
 		filtersGroup.GET("/countries", filterHandler.GetCountries)
@@ -779,10 +1329,10 @@ func main() {
 	log.Printf("🚀 IndustryDB API starting on %s", address)
 	log.Printf("📝 Log level: %s, Log format: %s", cfg.LogLevel, cfg.LogFormat)
 	log.Printf("🔐 JWT expiration: %d hours", cfg.JWTExpirationHours)
-	log.Printf("🌍 CORS: http://localhost:5678, https://industrydb.io, https://www.industrydb.io")
+	log.Printf("🌍 CORS: %s", strings.Join(corsConfig.AllowOrigins, ", "))
 	log.Printf("🛡️  Rate limiting: %d req/min (burst: %d)", cfg.RateLimitRequestsPerMinute, cfg.RateLimitBurst)
 	log.Printf("🔒 Auth endpoints: login (%d/min), register (%d/min), webhook (100/min)", cfg.RateLimitLoginPerMinute, cfg.RateLimitRegisterPerMinute)
-	log.Printf("⏰ Cron jobs: Daily 2AM (populate low-data), Weekly Sunday 3AM (populate missing), Daily 4AM (stats)")
+	log.Printf("⏰ Cron jobs: Daily 2AM (populate low-data), Weekly Sunday 3AM (populate missing), Daily 4AM (stats), Daily 5AM (quality scores)")
 	log.Printf("📊 Admin endpoints: /api/v1/admin/jobs/* (detect, trigger, stats, auto-populate)")
 
 	// Graceful shutdown
@@ -803,6 +1353,11 @@ func main() {
 	cronManager.Stop()
 	log.Println("✅ Cron jobs stopped")
 
+	// Queue events still collecting in webhook batches for the next deferred flush
+	if queued := webhookService.FlushBatches(context.Background()); queued > 0 {
+		log.Printf("✅ Queued %d batched webhook events", queued)
+	}
+
 	// Gracefully shutdown with timeout
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
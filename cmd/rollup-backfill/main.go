@@ -0,0 +1,96 @@
+// Command rollup-backfill computes daily usage rollups from historical usage
+// logs.
+//
+// By default it rolls up every completed day from the rollup watermark (or
+// the oldest usage log, on first run) through yesterday, like the nightly
+// job. Use -from and -to to recompute a specific range, for example after
+// correcting usage logs. Days whose usage logs were purged keep their rollups.
+//
+// Usage:
+//
+//	go run ./cmd/rollup-backfill
+//	go run ./cmd/rollup-backfill -from 2026-01-01 -to 2026-03-31
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"time"
+
+	"github.com/jordanlanch/industrydb/config"
+	"github.com/jordanlanch/industrydb/pkg/analytics"
+	"github.com/jordanlanch/industrydb/pkg/database"
+)
+
+func main() {
+	fromFlag := flag.String("from", "", "First day to roll up (YYYY-MM-DD, UTC); default: rollup watermark")
+	toFlag := flag.String("to", "", "Last day to roll up (YYYY-MM-DD, UTC, inclusive); default: yesterday")
+	flag.Parse()
+
+	cfg := config.Load()
+
+	db, err := database.NewClientWithSSL(cfg.DatabaseURL, &database.SSLConfig{
+		Mode:         cfg.DBSSLMode,
+		CertPath:     cfg.DBSSLCertPath,
+		KeyPath:      cfg.DBSSLKeyPath,
+		RootCertPath: cfg.DBSSLRootCertPath,
+	})
+	if err != nil {
+		log.Fatalf("❌ Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	service := analytics.NewService(db.Ent)
+	ctx := context.Background()
+	start := time.Now()
+
+	var written int
+	if *fromFlag == "" && *toFlag == "" {
+		log.Println("🕐 Rolling up all pending days...")
+		written, err = service.RollUpPending(ctx, time.Now())
+	} else {
+		from, to := parseRange(*fromFlag, *toFlag)
+		log.Printf("🕐 Rolling up %s through %s...", from.Format("2006-01-02"), to.AddDate(0, 0, -1).Format("2006-01-02"))
+		written, err = service.RollUpRange(ctx, from, to)
+	}
+	if err != nil {
+		log.Fatalf("❌ Backfill failed after %d rollups: %v", written, err)
+	}
+
+	log.Printf("✅ Backfill complete: %d rollups written in %s", written, time.Since(start).Round(time.Second))
+}
+
+// parseRange returns the half-open UTC day range [from, to+1 day). A missing
+// -from requires an explicit range, so it defaults to -to; a missing -to
+// defaults to yesterday.
+func parseRange(fromFlag, toFlag string) (time.Time, time.Time) {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	to := today.AddDate(0, 0, -1)
+	if toFlag != "" {
+		to = parseDay("to", toFlag)
+	}
+	if !to.Before(today) {
+		log.Fatalf("❌ -to must be before today (today is still being logged)")
+	}
+
+	from := to
+	if fromFlag != "" {
+		from = parseDay("from", fromFlag)
+	}
+	if from.After(to) {
+		log.Fatalf("❌ -from must not be after -to")
+	}
+
+	return from, to.AddDate(0, 0, 1)
+}
+
+// parseDay parses a YYYY-MM-DD flag value as a UTC day
+func parseDay(name, value string) time.Time {
+	day, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		log.Fatalf("❌ Invalid -%s %q: use YYYY-MM-DD", name, value)
+	}
+	return day
+}
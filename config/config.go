@@ -33,6 +33,21 @@ type Config struct {
 	DBReplicaFallbackPrimary bool     // Fallback to primary if replicas fail
 	DBReplicaHealthCheck     int      // Health check interval in seconds
 
+	// Database query limits
+	DBQueryTimeoutSeconds  int // Timeout of search and analytics queries per request
+	DBSlowQueryThresholdMS int // Queries taking longer are logged, 0 disables the log
+
+	// Admin funnel, cohort and revenue reports
+	AnalyticsMaxRangeDays        int // Longest range a report may cover
+	AnalyticsQueryTimeoutSeconds int // Timeout of a report's queries, 0 uses DBQueryTimeoutSeconds
+	AnalyticsCacheTTLSeconds     int // How long reports are cached, 0 disables caching
+
+	// Database connection pool, 0 keeps the API_ENVIRONMENT default
+	DBMaxOpenConns           int // Maximum open connections per instance
+	DBMaxIdleConns           int // Maximum idle connections kept warm
+	DBConnMaxLifetimeSeconds int // Connections are recycled after this time
+	DBConnMaxIdleTimeSeconds int // Idle connections are closed after this time
+
 	// Redis
 	RedisURL      string
 	RedisHost     string
@@ -43,9 +58,17 @@ type Config struct {
 	JWTSecret          string
 	JWTExpirationHours int
 
-	// CORS
+	// Signs internal service tokens that skip rate limiting (empty disables them)
+	InternalServiceSecret string
+
+	// CORS (empty uses the environment's default origins)
 	CORSAllowedOrigins []string
 
+	// Security headers (empty or negative uses the environment's default policy)
+	ContentSecurityPolicy     string
+	DocsContentSecurityPolicy string // For the Swagger UI pages
+	HSTSMaxAge                int    // Seconds, 0 disables HSTS
+
 	// Rate Limiting
 	RateLimitRequestsPerMinute int
 	RateLimitBurst             int
@@ -54,6 +77,15 @@ type Config struct {
 	RateLimitLoginPerMinute    int // Login endpoint rate limit
 	RateLimitLoginBurst        int
 
+	RateLimitPublicPreviewPerMinute int // Anonymous lead preview rate limit
+	RateLimitPublicPreviewBurst     int
+
+	// Percent of the tier rate limit burst used before warning, 0 disables
+	RateLimitWarningPercentFree     int
+	RateLimitWarningPercentStarter  int
+	RateLimitWarningPercentPro      int
+	RateLimitWarningPercentBusiness int
+
 	// Stripe
 	StripeSecretKey      string
 	StripePublishableKey string
@@ -65,6 +97,9 @@ type Config struct {
 	// Frontend
 	FrontendURL string
 
+	// JSON file of industry name/description translations (empty serves English only)
+	IndustryTranslationsPath string
+
 	// Logging
 	LogLevel  string
 	LogFormat string
@@ -85,6 +120,25 @@ type Config struct {
 	BackupS3Bucket      string
 	BackupLocalDir      string
 
+	// Data retention
+	RetentionPurgeEnabled    bool
+	UsageLogRetentionDays    int
+	AuditLogRetentionDays    int
+	RetentionArchiveS3Bucket string
+
+	// Remote CSV import
+	ImportS3Bucket     string
+	ImportMaxMB        int
+	ImportAllowedHosts []string
+	ImportTimeoutMin   int
+
+	// Webhook URL policy
+	WebhookAllowedHosts []string
+	WebhookBlockedHosts []string
+
+	// Hours Idempotency-Key responses are kept for replay
+	IdempotencyTTLHours int
+
 	// Email
 	SendGridAPIKey string
 	SMTPHost       string
@@ -93,6 +147,21 @@ type Config struct {
 	SMTPPassword   string
 	EmailFrom      string
 	EmailFromName  string
+	// Directory of email template overrides (<name>.subject, <name>.html, <name>.txt)
+	EmailTemplateDir string
+	// SendGrid signed event webhook verification key (base64 ECDSA public key)
+	SendGridWebhookPublicKey string
+	// Email delivery providers: sendgrid or ses (fallback is optional)
+	EmailProvider         string
+	EmailFallbackProvider string
+	SESRegion             string
+
+	// Verification email resend limits (per user, independent of IP rate limits)
+	VerificationResendCooldownSeconds int
+	VerificationResendMaxPerHour      int
+
+	// Password reset link lifetime
+	PasswordResetTokenTTLMinutes int
 
 	// Slack
 	SlackWebhookURL string
@@ -106,14 +175,133 @@ type Config struct {
 	MicrosoftClientSecret string
 	OAuthCallbackURL   string
 
+	// Integrations (Google Sheets export delivery, CRM push)
+	GoogleSheetsRedirectURL  string
+	IntegrationEncryptionKey string // Encrypts stored integration tokens, defaults to a key derived from JWT_SECRET
+	CRMPushMaxLeads          int    // Maximum leads pushed to a CRM by a single job
+
+	// Lead quality score rubric (points per signal, see leads.QualityWeights)
+	QualityWeightEmail       int
+	QualityWeightPhone       int
+	QualityWeightWebsite     int
+	QualityWeightAddress     int
+	QualityWeightVerified    int
+	QualityWeightRecency     int
+	QualityRecencyWindowDays int
+
+	// Lead status SLAs in days (0 disables the SLA for that status)
+	LeadSLANewDays         int
+	LeadSLAContactedDays   int
+	LeadSLAQualifiedDays   int
+	LeadSLANegotiatingDays int
+	LeadSLANotifyRep       bool
+
+	// Limit organization searches to owned and licensed leads (see leads.Service.SetOrgScoping)
+	LeadOrgScoping bool
+
+	// Lead custom field limits (see customfields.Limits)
+	CustomFieldsMaxPerLead   int
+	CustomFieldsMaxKeyLength int
+	CustomFieldsMaxValueSize int // Bytes, JSON-encoded
+
+	// Monthly usage limit per subscription tier (see leads.TierUsageLimits)
+	UsageLimitFree     int
+	UsageLimitStarter  int
+	UsageLimitPro      int
+	UsageLimitBusiness int
+
+	// Rows allowed per export per subscription tier, 0 = unlimited (see leads.TierExportRowCaps)
+	ExportRowCapFree     int
+	ExportRowCapStarter  int
+	ExportRowCapPro      int
+	ExportRowCapBusiness int
+
+	// Largest search page per subscription tier, larger requests are clamped (see leads.TierMaxPageSizes)
+	MaxPageSizeFree     int
+	MaxPageSizeStarter  int
+	MaxPageSizePro      int
+	MaxPageSizeBusiness int
+
+	// Whether searches and exports default to verified leads per subscription tier (see leads.TierVerifiedOnly)
+	VerifiedOnlyFree     bool
+	VerifiedOnlyStarter  bool
+	VerifiedOnlyPro      bool
+	VerifiedOnlyBusiness bool
+
+	// Lead fields left out of search results per subscription tier, comma-separated or "none" (see leads.TierSearchHiddenFields)
+	SearchHiddenFieldsFree     string
+	SearchHiddenFieldsStarter  string
+	SearchHiddenFieldsPro      string
+	SearchHiddenFieldsBusiness string
+
+	// MaxMind GeoIP2/GeoLite2 country or city database defaulting the
+	// search country to the client's location (empty disables it)
+	GeoIPDatabasePath string
+
+	// Export queue: workers and waiting limits, plus concurrent exports per user per tier
+	ExportWorkers             int
+	ExportMaxQueued           int
+	ExportMaxQueuedPerUser    int
+	ExportConcurrencyFree     int
+	ExportConcurrencyStarter  int
+	ExportConcurrencyPro      int
+	ExportConcurrencyBusiness int
+
+	// Export redaction: extra profiles ("name=field:action,...;...") and the
+	// key of hashed fields (see export.ParseRedactionProfiles)
+	ExportRedactionProfiles string
+	ExportRedactionHashKey  string
+
+	// Enrichment candidate heuristic (see enrichment.CandidateThresholds)
+	EnrichmentCandidateMinQuality int
+	EnrichmentCandidateLimit      int
+	EnrichmentCandidateMaxLimit   int
+
+	// Enrichment field mapping (see enrichment.FieldMapping): extra
+	// "provider_field=lead_field" pairs, and the lead fields enriched values
+	// may overwrite ("all", "none" or a comma-separated list)
+	EnrichmentFieldMap        string
+	EnrichmentOverwriteFields string
+
+	// Enrichment provider cost per call in cents, recorded in the lead
+	// enrichment history, and hours a failed lead is not retried with the
+	// same provider (0 always retries)
+	EnrichmentCompanyCostCents         int
+	EnrichmentEmailValidationCostCents int
+	EnrichmentFailedRetryHours         int
+
+	// Enrichment provider calls in flight at once, across all enrichments
+	// (see enrichment.SetMaxConcurrentCalls)
+	EnrichmentMaxConcurrentCalls int
+
+	// Default monthly enrichment budgets per tier: provider calls and cost
+	// in cents, 0 = unlimited (see leads.TierEnrichmentBudgets)
+	EnrichmentCallBudgetFree          int
+	EnrichmentCallBudgetStarter       int
+	EnrichmentCallBudgetPro           int
+	EnrichmentCallBudgetBusiness      int
+	EnrichmentCostBudgetCentsFree     int
+	EnrichmentCostBudgetCentsStarter  int
+	EnrichmentCostBudgetCentsPro      int
+	EnrichmentCostBudgetCentsBusiness int
+
+	// Onboarding checklist items, "key" or "key=Title" comma-separated (see
+	// onboarding.ParseChecklist); empty uses the default checklist
+	OnboardingChecklist string
+
+	// Lead claims expire after this many hours without activity by the
+	// claimer (0 keeps claims until released)
+	LeadClaimTimeoutHours int
+
 	// Features
 	FeatureEmailExports bool
 	FeatureAPIAccess    bool
 	FeatureSocialLogin  bool
 
 	// Monitoring
-	SentryDSN         string
-	SentryEnvironment string
+	SentryDSN              string
+	SentryEnvironment      string
+	SentryTracesSampleRate float64 // Fraction of transactions traced (0.0-1.0)
 
 	// Secrets Management
 	SecretsBackend        string // "env" or "aws-secrets-manager"
@@ -150,6 +338,21 @@ func Load() *Config {
 		DBReplicaFallbackPrimary: getEnvAsBool("DB_REPLICA_FALLBACK_PRIMARY", true),
 		DBReplicaHealthCheck:     getEnvAsInt("DB_REPLICA_HEALTH_CHECK_SECONDS", 30),
 
+		// Database query limits
+		DBQueryTimeoutSeconds:  getEnvAsInt("DB_QUERY_TIMEOUT_SECONDS", 15),
+		DBSlowQueryThresholdMS: getEnvAsInt("DB_SLOW_QUERY_THRESHOLD_MS", 1000),
+
+		// Admin funnel, cohort and revenue reports
+		AnalyticsMaxRangeDays:        getEnvAsInt("ANALYTICS_MAX_RANGE_DAYS", 730),
+		AnalyticsQueryTimeoutSeconds: getEnvAsInt("ANALYTICS_QUERY_TIMEOUT_SECONDS", 0),
+		AnalyticsCacheTTLSeconds:     getEnvAsInt("ANALYTICS_CACHE_TTL_SECONDS", 300),
+
+		// Database connection pool
+		DBMaxOpenConns:           getEnvAsInt("DB_MAX_OPEN_CONNS", 0),
+		DBMaxIdleConns:           getEnvAsInt("DB_MAX_IDLE_CONNS", 0),
+		DBConnMaxLifetimeSeconds: getEnvAsInt("DB_CONN_MAX_LIFETIME_SECONDS", 0),
+		DBConnMaxIdleTimeSeconds: getEnvAsInt("DB_CONN_MAX_IDLE_TIME_SECONDS", 0),
+
 		// Redis
 		RedisURL:      getEnv("REDIS_URL", "redis://localhost:6677"),
 		RedisHost:     getEnv("REDIS_HOST", "localhost"),
@@ -160,6 +363,17 @@ func Load() *Config {
 		JWTSecret:          getEnv("JWT_SECRET", "change-this-in-production"),
 		JWTExpirationHours: getEnvAsInt("JWT_EXPIRATION_HOURS", 24),
 
+		// Internal service tokens
+		InternalServiceSecret: getEnv("INTERNAL_SERVICE_SECRET", ""),
+
+		// CORS
+		CORSAllowedOrigins: parseCommaSeparated(getEnv("CORS_ALLOWED_ORIGINS", "")),
+
+		// Security headers
+		ContentSecurityPolicy:     getEnv("CONTENT_SECURITY_POLICY", ""),
+		DocsContentSecurityPolicy: getEnv("DOCS_CONTENT_SECURITY_POLICY", ""),
+		HSTSMaxAge:                getEnvAsInt("HSTS_MAX_AGE", -1),
+
 		// Rate Limiting
 		RateLimitRequestsPerMinute: getEnvAsInt("RATE_LIMIT_REQUESTS_PER_MINUTE", 60),
 		RateLimitBurst:             getEnvAsInt("RATE_LIMIT_BURST", 10),
@@ -168,6 +382,14 @@ func Load() *Config {
 		RateLimitLoginPerMinute:    getEnvAsInt("RATE_LIMIT_LOGIN_PER_MINUTE", 5),     // 5 per minute for production
 		RateLimitLoginBurst:        getEnvAsInt("RATE_LIMIT_LOGIN_BURST", 2),
 
+		RateLimitPublicPreviewPerMinute: getEnvAsInt("RATE_LIMIT_PUBLIC_PREVIEW_PER_MINUTE", 5),
+		RateLimitPublicPreviewBurst:     getEnvAsInt("RATE_LIMIT_PUBLIC_PREVIEW_BURST", 2),
+
+		RateLimitWarningPercentFree:     getEnvAsInt("RATE_LIMIT_WARNING_PERCENT_FREE", 80),
+		RateLimitWarningPercentStarter:  getEnvAsInt("RATE_LIMIT_WARNING_PERCENT_STARTER", 80),
+		RateLimitWarningPercentPro:      getEnvAsInt("RATE_LIMIT_WARNING_PERCENT_PRO", 80),
+		RateLimitWarningPercentBusiness: getEnvAsInt("RATE_LIMIT_WARNING_PERCENT_BUSINESS", 80),
+
 		// Stripe
 		StripeSecretKey:      getEnv("STRIPE_SECRET_KEY", ""),
 		StripePublishableKey: getEnv("STRIPE_PUBLISHABLE_KEY", ""),
@@ -179,6 +401,9 @@ func Load() *Config {
 		// Frontend
 		FrontendURL: getEnv("FRONTEND_URL", "http://localhost:5678"),
 
+		// Industry translations
+		IndustryTranslationsPath: getEnv("INDUSTRY_TRANSLATIONS_PATH", ""),
+
 		// Logging
 		LogLevel:  getEnv("LOG_LEVEL", "info"),
 		LogFormat: getEnv("LOG_FORMAT", "json"),
@@ -199,6 +424,24 @@ func Load() *Config {
 		BackupS3Bucket:      getEnv("BACKUP_S3_BUCKET", ""),
 		BackupLocalDir:      getEnv("BACKUP_LOCAL_DIR", "./data/backups"),
 
+		// Data retention
+		RetentionPurgeEnabled:    getEnvAsBool("RETENTION_PURGE_ENABLED", false),
+		UsageLogRetentionDays:    getEnvAsInt("USAGE_LOG_RETENTION_DAYS", 365),
+		AuditLogRetentionDays:    getEnvAsInt("AUDIT_LOG_RETENTION_DAYS", 2555),
+		RetentionArchiveS3Bucket: getEnv("RETENTION_ARCHIVE_S3_BUCKET", ""),
+
+		// Remote CSV import
+		ImportS3Bucket:     getEnv("IMPORT_S3_BUCKET", ""),
+		ImportMaxMB:        getEnvAsInt("IMPORT_MAX_MB", 5120),
+		ImportAllowedHosts: parseCommaSeparated(getEnv("IMPORT_ALLOWED_HOSTS", "")),
+		ImportTimeoutMin:   getEnvAsInt("IMPORT_TIMEOUT_MINUTES", 360),
+
+		// Webhook URL policy
+		WebhookAllowedHosts: parseCommaSeparated(getEnv("WEBHOOK_ALLOWED_HOSTS", "")),
+		WebhookBlockedHosts: parseCommaSeparated(getEnv("WEBHOOK_BLOCKED_HOSTS", "")),
+
+		IdempotencyTTLHours: getEnvAsInt("IDEMPOTENCY_TTL_HOURS", 24),
+
 		// Email
 		SendGridAPIKey: getEnv("SENDGRID_API_KEY", ""),
 		SMTPHost:       getEnv("SMTP_HOST", ""),
@@ -208,6 +451,16 @@ func Load() *Config {
 		EmailFrom:      getEnv("EMAIL_FROM", "noreply@industrydb.io"),
 		EmailFromName:  getEnv("EMAIL_FROM_NAME", "IndustryDB"),
 
+		EmailTemplateDir:         getEnv("EMAIL_TEMPLATE_DIR", ""),
+		SendGridWebhookPublicKey: getEnv("SENDGRID_WEBHOOK_PUBLIC_KEY", ""),
+		EmailProvider:            getEnv("EMAIL_PROVIDER", "sendgrid"),
+		EmailFallbackProvider:    getEnv("EMAIL_FALLBACK_PROVIDER", ""),
+		SESRegion:                getEnv("AWS_SES_REGION", getEnv("AWS_REGION", "us-east-1")),
+
+		VerificationResendCooldownSeconds: getEnvAsInt("VERIFICATION_RESEND_COOLDOWN_SECONDS", 60),
+		VerificationResendMaxPerHour:      getEnvAsInt("VERIFICATION_RESEND_MAX_PER_HOUR", 5),
+		PasswordResetTokenTTLMinutes:      getEnvAsInt("PASSWORD_RESET_TOKEN_TTL_MINUTES", 60),
+
 		// Slack
 		SlackWebhookURL: getEnv("SLACK_WEBHOOK_URL", ""),
 
@@ -220,14 +473,119 @@ func Load() *Config {
 		MicrosoftClientSecret: getEnv("MICROSOFT_CLIENT_SECRET", ""),
 		OAuthCallbackURL:      getEnv("OAUTH_CALLBACK_URL", "http://localhost:8080/api/v1/auth/oauth/callback"),
 
+		// Integrations
+		GoogleSheetsRedirectURL:  getEnv("GOOGLE_SHEETS_REDIRECT_URL", "http://localhost:8080/api/v1/integrations/google/callback"),
+		IntegrationEncryptionKey: getEnv("INTEGRATION_ENCRYPTION_KEY", ""),
+		CRMPushMaxLeads:          getEnvAsInt("CRM_PUSH_MAX_LEADS", 1000),
+
+		// Lead quality score rubric
+		QualityWeightEmail:       getEnvAsInt("QUALITY_WEIGHT_EMAIL", 20),
+		QualityWeightPhone:       getEnvAsInt("QUALITY_WEIGHT_PHONE", 20),
+		QualityWeightWebsite:     getEnvAsInt("QUALITY_WEIGHT_WEBSITE", 15),
+		QualityWeightAddress:     getEnvAsInt("QUALITY_WEIGHT_ADDRESS", 15),
+		QualityWeightVerified:    getEnvAsInt("QUALITY_WEIGHT_VERIFIED", 20),
+		QualityWeightRecency:     getEnvAsInt("QUALITY_WEIGHT_RECENCY", 10),
+		QualityRecencyWindowDays: getEnvAsInt("QUALITY_RECENCY_WINDOW_DAYS", 180),
+
+		// Lead status SLAs
+		LeadSLANewDays:         getEnvAsInt("LEAD_SLA_NEW_DAYS", 2),
+		LeadSLAContactedDays:   getEnvAsInt("LEAD_SLA_CONTACTED_DAYS", 3),
+		LeadSLAQualifiedDays:   getEnvAsInt("LEAD_SLA_QUALIFIED_DAYS", 7),
+		LeadSLANegotiatingDays: getEnvAsInt("LEAD_SLA_NEGOTIATING_DAYS", 14),
+		LeadSLANotifyRep:       getEnvAsBool("LEAD_SLA_NOTIFY_REP", true),
+
+		// Lead visibility
+		LeadOrgScoping: getEnvAsBool("LEAD_ORG_SCOPING", false),
+
+		// Custom field limits
+		CustomFieldsMaxPerLead:   getEnvAsInt("CUSTOM_FIELDS_MAX_PER_LEAD", 50),
+		CustomFieldsMaxKeyLength: getEnvAsInt("CUSTOM_FIELDS_MAX_KEY_LENGTH", 50),
+		CustomFieldsMaxValueSize: getEnvAsInt("CUSTOM_FIELDS_MAX_VALUE_BYTES", 2048),
+
+		// Tier usage limits
+		UsageLimitFree:     getEnvAsInt("USAGE_LIMIT_FREE", 50),
+		UsageLimitStarter:  getEnvAsInt("USAGE_LIMIT_STARTER", 500),
+		UsageLimitPro:      getEnvAsInt("USAGE_LIMIT_PRO", 2000),
+		UsageLimitBusiness: getEnvAsInt("USAGE_LIMIT_BUSINESS", 10000),
+
+		// Tier export row caps
+		ExportRowCapFree:     getEnvAsInt("EXPORT_ROW_CAP_FREE", 100),
+		ExportRowCapStarter:  getEnvAsInt("EXPORT_ROW_CAP_STARTER", 1000),
+		ExportRowCapPro:      getEnvAsInt("EXPORT_ROW_CAP_PRO", 10000),
+		ExportRowCapBusiness: getEnvAsInt("EXPORT_ROW_CAP_BUSINESS", 0),
+
+		// Tier maximum page sizes
+		MaxPageSizeFree:     getEnvAsInt("MAX_PAGE_SIZE_FREE", 100),
+		MaxPageSizeStarter:  getEnvAsInt("MAX_PAGE_SIZE_STARTER", 100),
+		MaxPageSizePro:      getEnvAsInt("MAX_PAGE_SIZE_PRO", 250),
+		MaxPageSizeBusiness: getEnvAsInt("MAX_PAGE_SIZE_BUSINESS", 1000),
+
+		// Tier verified-only defaults
+		VerifiedOnlyFree:     getEnvAsBool("VERIFIED_ONLY_FREE", true),
+		VerifiedOnlyStarter:  getEnvAsBool("VERIFIED_ONLY_STARTER", false),
+		VerifiedOnlyPro:      getEnvAsBool("VERIFIED_ONLY_PRO", false),
+		VerifiedOnlyBusiness: getEnvAsBool("VERIFIED_ONLY_BUSINESS", false),
+
+		// Tier search result field projection
+		SearchHiddenFieldsFree:     getEnv("SEARCH_HIDDEN_FIELDS_FREE", "email,phone"),
+		SearchHiddenFieldsStarter:  getEnv("SEARCH_HIDDEN_FIELDS_STARTER", "none"),
+		SearchHiddenFieldsPro:      getEnv("SEARCH_HIDDEN_FIELDS_PRO", "none"),
+		SearchHiddenFieldsBusiness: getEnv("SEARCH_HIDDEN_FIELDS_BUSINESS", "none"),
+
+		// Geo-IP search country default
+		GeoIPDatabasePath: getEnv("GEOIP_DATABASE_PATH", ""),
+
+		// Export queue
+		ExportWorkers:             getEnvAsInt("EXPORT_WORKERS", 4),
+		ExportMaxQueued:           getEnvAsInt("EXPORT_MAX_QUEUED", 100),
+		ExportMaxQueuedPerUser:    getEnvAsInt("EXPORT_MAX_QUEUED_PER_USER", 10),
+		ExportConcurrencyFree:     getEnvAsInt("EXPORT_CONCURRENCY_FREE", 1),
+		ExportConcurrencyStarter:  getEnvAsInt("EXPORT_CONCURRENCY_STARTER", 1),
+		ExportConcurrencyPro:      getEnvAsInt("EXPORT_CONCURRENCY_PRO", 2),
+		ExportConcurrencyBusiness: getEnvAsInt("EXPORT_CONCURRENCY_BUSINESS", 4),
+
+		// Export redaction profiles
+		ExportRedactionProfiles: getEnv("EXPORT_REDACTION_PROFILES", ""),
+		ExportRedactionHashKey:  getEnv("EXPORT_REDACTION_HASH_KEY", ""),
+
+		// Enrichment candidates
+		EnrichmentCandidateMinQuality: getEnvAsInt("ENRICHMENT_CANDIDATE_MIN_QUALITY", 50),
+		EnrichmentCandidateLimit:      getEnvAsInt("ENRICHMENT_CANDIDATE_LIMIT", 50),
+		EnrichmentCandidateMaxLimit:   getEnvAsInt("ENRICHMENT_CANDIDATE_MAX_LIMIT", 500),
+		EnrichmentFieldMap:            getEnv("ENRICHMENT_FIELD_MAP", ""),
+		EnrichmentOverwriteFields:     getEnv("ENRICHMENT_OVERWRITE_FIELDS", "all"),
+
+		// Enrichment history
+		EnrichmentCompanyCostCents:         getEnvAsInt("ENRICHMENT_COMPANY_COST_CENTS", 0),
+		EnrichmentEmailValidationCostCents: getEnvAsInt("ENRICHMENT_EMAIL_VALIDATION_COST_CENTS", 0),
+		EnrichmentFailedRetryHours:         getEnvAsInt("ENRICHMENT_FAILED_RETRY_HOURS", 24),
+		EnrichmentMaxConcurrentCalls:       getEnvAsInt("ENRICHMENT_MAX_CONCURRENT_CALLS", 10),
+
+		// Enrichment budgets
+		EnrichmentCallBudgetFree:          getEnvAsInt("ENRICHMENT_CALL_BUDGET_FREE", 25),
+		EnrichmentCallBudgetStarter:       getEnvAsInt("ENRICHMENT_CALL_BUDGET_STARTER", 250),
+		EnrichmentCallBudgetPro:           getEnvAsInt("ENRICHMENT_CALL_BUDGET_PRO", 1000),
+		EnrichmentCallBudgetBusiness:      getEnvAsInt("ENRICHMENT_CALL_BUDGET_BUSINESS", 5000),
+		EnrichmentCostBudgetCentsFree:     getEnvAsInt("ENRICHMENT_COST_BUDGET_CENTS_FREE", 0),
+		EnrichmentCostBudgetCentsStarter:  getEnvAsInt("ENRICHMENT_COST_BUDGET_CENTS_STARTER", 0),
+		EnrichmentCostBudgetCentsPro:      getEnvAsInt("ENRICHMENT_COST_BUDGET_CENTS_PRO", 0),
+		EnrichmentCostBudgetCentsBusiness: getEnvAsInt("ENRICHMENT_COST_BUDGET_CENTS_BUSINESS", 0),
+
+		// Onboarding
+		OnboardingChecklist: getEnv("ONBOARDING_CHECKLIST", ""),
+
+		// Lead claims
+		LeadClaimTimeoutHours: getEnvAsInt("LEAD_CLAIM_TIMEOUT_HOURS", 0),
+
 		// Features
 		FeatureEmailExports: getEnvAsBool("FEATURE_EMAIL_EXPORTS", true),
 		FeatureAPIAccess:    getEnvAsBool("FEATURE_API_ACCESS", true),
 		FeatureSocialLogin:  getEnvAsBool("FEATURE_SOCIAL_LOGIN", false),
 
 		// Monitoring
-		SentryDSN:         getEnv("SENTRY_DSN", ""),
-		SentryEnvironment: getEnv("SENTRY_ENVIRONMENT", "development"),
+		SentryDSN:              getEnv("SENTRY_DSN", ""),
+		SentryEnvironment:      getEnv("SENTRY_ENVIRONMENT", "development"),
+		SentryTracesSampleRate: getEnvAsSampleRate("SENTRY_TRACES_SAMPLE_RATE", defaultTracesSampleRate(getEnv("SENTRY_ENVIRONMENT", "development"))),
 
 		// Secrets Management
 		SecretsManagerEnabled: getEnvAsBool("AWS_SECRETS_MANAGER_ENABLED", false),
@@ -274,6 +632,31 @@ func getEnvAsBool(key string, defaultValue bool) bool {
 	return value
 }
 
+// getEnvAsSampleRate reads a sampling fraction, falling back to the default
+// when unset, invalid or outside 0.0-1.0
+func getEnvAsSampleRate(key string, defaultValue float64) float64 {
+	valueStr := os.Getenv(key)
+	if valueStr == "" {
+		return defaultValue
+	}
+
+	value, err := strconv.ParseFloat(valueStr, 64)
+	if err != nil || value < 0 || value > 1 {
+		return defaultValue
+	}
+
+	return value
+}
+
+// defaultTracesSampleRate keeps full tracing in development and samples 10%
+// of transactions elsewhere
+func defaultTracesSampleRate(environment string) float64 {
+	if environment == "development" {
+		return 1.0
+	}
+	return 0.1
+}
+
 func parseCommaSeparated(value string) []string {
 	if value == "" {
 		return []string{}
@@ -29,6 +29,7 @@ type AffiliateQuery struct {
 	withUser        *UserQuery
 	withClicks      *AffiliateClickQuery
 	withConversions *AffiliateConversionQuery
+	modifiers       []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -327,8 +328,9 @@ func (_q *AffiliateQuery) Clone() *AffiliateQuery {
 		withClicks:      _q.withClicks.Clone(),
 		withConversions: _q.withConversions.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -458,6 +460,9 @@ func (_q *AffiliateQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Af
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -582,6 +587,9 @@ func (_q *AffiliateQuery) loadConversions(ctx context.Context, query *AffiliateC
 
 func (_q *AffiliateQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -647,6 +655,9 @@ func (_q *AffiliateQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -664,6 +675,12 @@ func (_q *AffiliateQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *AffiliateQuery) Modify(modifiers ...func(s *sql.Selector)) *AffiliateSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // AffiliateGroupBy is the group-by builder for Affiliate entities.
 type AffiliateGroupBy struct {
 	selector
@@ -753,3 +770,9 @@ func (_s *AffiliateSelect) sqlScan(ctx context.Context, root *AffiliateQuery, v
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *AffiliateSelect) Modify(modifiers ...func(s *sql.Selector)) *AffiliateSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
@@ -21,8 +21,9 @@ import (
 // AffiliateUpdate is the builder for updating Affiliate entities.
 type AffiliateUpdate struct {
 	config
-	hooks    []Hook
-	mutation *AffiliateMutation
+	hooks     []Hook
+	mutation  *AffiliateMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the AffiliateUpdate builder.
@@ -427,6 +428,12 @@ func (_u *AffiliateUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AffiliateUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AffiliateUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *AffiliateUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -627,6 +634,7 @@ func (_u *AffiliateUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{affiliate.Label}
@@ -642,9 +650,10 @@ func (_u *AffiliateUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // AffiliateUpdateOne is the builder for updating a single Affiliate entity.
 type AffiliateUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *AffiliateMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *AffiliateMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUserID sets the "user_id" field.
@@ -1056,6 +1065,12 @@ func (_u *AffiliateUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AffiliateUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AffiliateUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *AffiliateUpdateOne) sqlSave(ctx context.Context) (_node *Affiliate, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -1273,6 +1288,7 @@ func (_u *AffiliateUpdateOne) sqlSave(ctx context.Context) (_node *Affiliate, er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Affiliate{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -24,6 +24,7 @@ type AffiliateClickQuery struct {
 	inters        []Interceptor
 	predicates    []predicate.AffiliateClick
 	withAffiliate *AffiliateQuery
+	modifiers     []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -276,8 +277,9 @@ func (_q *AffiliateClickQuery) Clone() *AffiliateClickQuery {
 		predicates:    append([]predicate.AffiliateClick{}, _q.predicates...),
 		withAffiliate: _q.withAffiliate.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -383,6 +385,9 @@ func (_q *AffiliateClickQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -433,6 +438,9 @@ func (_q *AffiliateClickQuery) loadAffiliate(ctx context.Context, query *Affilia
 
 func (_q *AffiliateClickQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -498,6 +506,9 @@ func (_q *AffiliateClickQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -515,6 +526,12 @@ func (_q *AffiliateClickQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *AffiliateClickQuery) Modify(modifiers ...func(s *sql.Selector)) *AffiliateClickSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // AffiliateClickGroupBy is the group-by builder for AffiliateClick entities.
 type AffiliateClickGroupBy struct {
 	selector
@@ -604,3 +621,9 @@ func (_s *AffiliateClickSelect) sqlScan(ctx context.Context, root *AffiliateClic
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *AffiliateClickSelect) Modify(modifiers ...func(s *sql.Selector)) *AffiliateClickSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
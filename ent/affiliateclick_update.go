@@ -18,8 +18,9 @@ import (
 // AffiliateClickUpdate is the builder for updating AffiliateClick entities.
 type AffiliateClickUpdate struct {
 	config
-	hooks    []Hook
-	mutation *AffiliateClickMutation
+	hooks     []Hook
+	mutation  *AffiliateClickMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the AffiliateClickUpdate builder.
@@ -247,6 +248,12 @@ func (_u *AffiliateClickUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AffiliateClickUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AffiliateClickUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *AffiliateClickUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -333,6 +340,7 @@ func (_u *AffiliateClickUpdate) sqlSave(ctx context.Context) (_node int, err err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{affiliateclick.Label}
@@ -348,9 +356,10 @@ func (_u *AffiliateClickUpdate) sqlSave(ctx context.Context) (_node int, err err
 // AffiliateClickUpdateOne is the builder for updating a single AffiliateClick entity.
 type AffiliateClickUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *AffiliateClickMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *AffiliateClickMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetAffiliateID sets the "affiliate_id" field.
@@ -585,6 +594,12 @@ func (_u *AffiliateClickUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AffiliateClickUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AffiliateClickUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *AffiliateClickUpdateOne) sqlSave(ctx context.Context) (_node *AffiliateClick, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -688,6 +703,7 @@ func (_u *AffiliateClickUpdateOne) sqlSave(ctx context.Context) (_node *Affiliat
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &AffiliateClick{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
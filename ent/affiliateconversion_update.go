@@ -20,8 +20,9 @@ import (
 // AffiliateConversionUpdate is the builder for updating AffiliateConversion entities.
 type AffiliateConversionUpdate struct {
 	config
-	hooks    []Hook
-	mutation *AffiliateConversionMutation
+	hooks     []Hook
+	mutation  *AffiliateConversionMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the AffiliateConversionUpdate builder.
@@ -279,6 +280,12 @@ func (_u *AffiliateConversionUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AffiliateConversionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AffiliateConversionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *AffiliateConversionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -391,6 +398,7 @@ func (_u *AffiliateConversionUpdate) sqlSave(ctx context.Context) (_node int, er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{affiliateconversion.Label}
@@ -406,9 +414,10 @@ func (_u *AffiliateConversionUpdate) sqlSave(ctx context.Context) (_node int, er
 // AffiliateConversionUpdateOne is the builder for updating a single AffiliateConversion entity.
 type AffiliateConversionUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *AffiliateConversionMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *AffiliateConversionMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetAffiliateID sets the "affiliate_id" field.
@@ -673,6 +682,12 @@ func (_u *AffiliateConversionUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AffiliateConversionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AffiliateConversionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *AffiliateConversionUpdateOne) sqlSave(ctx context.Context) (_node *AffiliateConversion, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -802,6 +817,7 @@ func (_u *AffiliateConversionUpdateOne) sqlSave(ctx context.Context) (_node *Aff
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &AffiliateConversion{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
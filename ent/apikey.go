@@ -50,9 +50,11 @@ type APIKey struct {
 type APIKeyEdges struct {
 	// API key owner
 	User *User `json:"user,omitempty"`
+	// Leads returned to this key by the Zapier new-leads poll
+	ZapierDeliveries []*ZapierDelivery `json:"zapier_deliveries,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -66,6 +68,15 @@ func (e APIKeyEdges) UserOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "user"}
 }
 
+// ZapierDeliveriesOrErr returns the ZapierDeliveries value or an error if the edge
+// was not loaded in eager-loading.
+func (e APIKeyEdges) ZapierDeliveriesOrErr() ([]*ZapierDelivery, error) {
+	if e.loadedTypes[1] {
+		return e.ZapierDeliveries, nil
+	}
+	return nil, &NotLoadedError{edge: "zapier_deliveries"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*APIKey) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -187,6 +198,11 @@ func (_m *APIKey) QueryUser() *UserQuery {
 	return NewAPIKeyClient(_m.config).QueryUser(_m)
 }
 
+// QueryZapierDeliveries queries the "zapier_deliveries" edge of the APIKey entity.
+func (_m *APIKey) QueryZapierDeliveries() *ZapierDeliveryQuery {
+	return NewAPIKeyClient(_m.config).QueryZapierDeliveries(_m)
+}
+
 // Update returns a builder for updating this APIKey.
 // Note that you need to call APIKey.Unwrap() before calling this method if this APIKey
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -38,6 +38,8 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
+	// EdgeZapierDeliveries holds the string denoting the zapier_deliveries edge name in mutations.
+	EdgeZapierDeliveries = "zapier_deliveries"
 	// Table holds the table name of the apikey in the database.
 	Table = "api_keys"
 	// UserTable is the table that holds the user relation/edge.
@@ -47,6 +49,13 @@ const (
 	UserInverseTable = "users"
 	// UserColumn is the table column denoting the user relation/edge.
 	UserColumn = "user_id"
+	// ZapierDeliveriesTable is the table that holds the zapier_deliveries relation/edge.
+	ZapierDeliveriesTable = "zapier_deliveries"
+	// ZapierDeliveriesInverseTable is the table name for the ZapierDelivery entity.
+	// It exists in this package in order to avoid circular dependency with the "zapierdelivery" package.
+	ZapierDeliveriesInverseTable = "zapier_deliveries"
+	// ZapierDeliveriesColumn is the table column denoting the zapier_deliveries relation/edge.
+	ZapierDeliveriesColumn = "api_key_id"
 )
 
 // Columns holds all SQL columns for apikey fields.
@@ -167,6 +176,20 @@ func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByZapierDeliveriesCount orders the results by zapier_deliveries count.
+func ByZapierDeliveriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newZapierDeliveriesStep(), opts...)
+	}
+}
+
+// ByZapierDeliveries orders the results by zapier_deliveries terms.
+func ByZapierDeliveries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newZapierDeliveriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -174,3 +197,10 @@ func newUserStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
 	)
 }
+func newZapierDeliveriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ZapierDeliveriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ZapierDeliveriesTable, ZapierDeliveriesColumn),
+	)
+}
@@ -628,6 +628,29 @@ func HasUserWith(preds ...predicate.User) predicate.APIKey {
 	})
 }
 
+// HasZapierDeliveries applies the HasEdge predicate on the "zapier_deliveries" edge.
+func HasZapierDeliveries() predicate.APIKey {
+	return predicate.APIKey(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ZapierDeliveriesTable, ZapierDeliveriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasZapierDeliveriesWith applies the HasEdge predicate on the "zapier_deliveries" edge with a given conditions (other predicates).
+func HasZapierDeliveriesWith(preds ...predicate.ZapierDelivery) predicate.APIKey {
+	return predicate.APIKey(func(s *sql.Selector) {
+		step := newZapierDeliveriesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.APIKey) predicate.APIKey {
 	return predicate.APIKey(sql.AndPredicates(predicates...))
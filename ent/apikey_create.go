@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/jordanlanch/industrydb/ent/apikey"
 	"github.com/jordanlanch/industrydb/ent/user"
+	"github.com/jordanlanch/industrydb/ent/zapierdelivery"
 )
 
 // APIKeyCreate is the builder for creating a APIKey entity.
@@ -148,6 +149,21 @@ func (_c *APIKeyCreate) SetUser(v *User) *APIKeyCreate {
 	return _c.SetUserID(v.ID)
 }
 
+// AddZapierDeliveryIDs adds the "zapier_deliveries" edge to the ZapierDelivery entity by IDs.
+func (_c *APIKeyCreate) AddZapierDeliveryIDs(ids ...int) *APIKeyCreate {
+	_c.mutation.AddZapierDeliveryIDs(ids...)
+	return _c
+}
+
+// AddZapierDeliveries adds the "zapier_deliveries" edges to the ZapierDelivery entity.
+func (_c *APIKeyCreate) AddZapierDeliveries(v ...*ZapierDelivery) *APIKeyCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddZapierDeliveryIDs(ids...)
+}
+
 // Mutation returns the APIKeyMutation object of the builder.
 func (_c *APIKeyCreate) Mutation() *APIKeyMutation {
 	return _c.mutation
@@ -338,6 +354,22 @@ func (_c *APIKeyCreate) createSpec() (*APIKey, *sqlgraph.CreateSpec) {
 		_node.UserID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ZapierDeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   apikey.ZapierDeliveriesTable,
+			Columns: []string{apikey.ZapierDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(zapierdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
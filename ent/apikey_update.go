@@ -14,13 +14,15 @@ import (
 	"github.com/jordanlanch/industrydb/ent/apikey"
 	"github.com/jordanlanch/industrydb/ent/predicate"
 	"github.com/jordanlanch/industrydb/ent/user"
+	"github.com/jordanlanch/industrydb/ent/zapierdelivery"
 )
 
 // APIKeyUpdate is the builder for updating APIKey entities.
 type APIKeyUpdate struct {
 	config
-	hooks    []Hook
-	mutation *APIKeyMutation
+	hooks     []Hook
+	mutation  *APIKeyMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the APIKeyUpdate builder.
@@ -191,6 +193,21 @@ func (_u *APIKeyUpdate) SetUser(v *User) *APIKeyUpdate {
 	return _u.SetUserID(v.ID)
 }
 
+// AddZapierDeliveryIDs adds the "zapier_deliveries" edge to the ZapierDelivery entity by IDs.
+func (_u *APIKeyUpdate) AddZapierDeliveryIDs(ids ...int) *APIKeyUpdate {
+	_u.mutation.AddZapierDeliveryIDs(ids...)
+	return _u
+}
+
+// AddZapierDeliveries adds the "zapier_deliveries" edges to the ZapierDelivery entity.
+func (_u *APIKeyUpdate) AddZapierDeliveries(v ...*ZapierDelivery) *APIKeyUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddZapierDeliveryIDs(ids...)
+}
+
 // Mutation returns the APIKeyMutation object of the builder.
 func (_u *APIKeyUpdate) Mutation() *APIKeyMutation {
 	return _u.mutation
@@ -202,6 +219,27 @@ func (_u *APIKeyUpdate) ClearUser() *APIKeyUpdate {
 	return _u
 }
 
+// ClearZapierDeliveries clears all "zapier_deliveries" edges to the ZapierDelivery entity.
+func (_u *APIKeyUpdate) ClearZapierDeliveries() *APIKeyUpdate {
+	_u.mutation.ClearZapierDeliveries()
+	return _u
+}
+
+// RemoveZapierDeliveryIDs removes the "zapier_deliveries" edge to ZapierDelivery entities by IDs.
+func (_u *APIKeyUpdate) RemoveZapierDeliveryIDs(ids ...int) *APIKeyUpdate {
+	_u.mutation.RemoveZapierDeliveryIDs(ids...)
+	return _u
+}
+
+// RemoveZapierDeliveries removes "zapier_deliveries" edges to ZapierDelivery entities.
+func (_u *APIKeyUpdate) RemoveZapierDeliveries(v ...*ZapierDelivery) *APIKeyUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveZapierDeliveryIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *APIKeyUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -271,6 +309,12 @@ func (_u *APIKeyUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *APIKeyUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *APIKeyUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *APIKeyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -351,6 +395,52 @@ func (_u *APIKeyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ZapierDeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   apikey.ZapierDeliveriesTable,
+			Columns: []string{apikey.ZapierDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(zapierdelivery.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedZapierDeliveriesIDs(); len(nodes) > 0 && !_u.mutation.ZapierDeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   apikey.ZapierDeliveriesTable,
+			Columns: []string{apikey.ZapierDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(zapierdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ZapierDeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   apikey.ZapierDeliveriesTable,
+			Columns: []string{apikey.ZapierDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(zapierdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{apikey.Label}
@@ -366,9 +456,10 @@ func (_u *APIKeyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // APIKeyUpdateOne is the builder for updating a single APIKey entity.
 type APIKeyUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *APIKeyMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *APIKeyMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUserID sets the "user_id" field.
@@ -533,6 +624,21 @@ func (_u *APIKeyUpdateOne) SetUser(v *User) *APIKeyUpdateOne {
 	return _u.SetUserID(v.ID)
 }
 
+// AddZapierDeliveryIDs adds the "zapier_deliveries" edge to the ZapierDelivery entity by IDs.
+func (_u *APIKeyUpdateOne) AddZapierDeliveryIDs(ids ...int) *APIKeyUpdateOne {
+	_u.mutation.AddZapierDeliveryIDs(ids...)
+	return _u
+}
+
+// AddZapierDeliveries adds the "zapier_deliveries" edges to the ZapierDelivery entity.
+func (_u *APIKeyUpdateOne) AddZapierDeliveries(v ...*ZapierDelivery) *APIKeyUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddZapierDeliveryIDs(ids...)
+}
+
 // Mutation returns the APIKeyMutation object of the builder.
 func (_u *APIKeyUpdateOne) Mutation() *APIKeyMutation {
 	return _u.mutation
@@ -544,6 +650,27 @@ func (_u *APIKeyUpdateOne) ClearUser() *APIKeyUpdateOne {
 	return _u
 }
 
+// ClearZapierDeliveries clears all "zapier_deliveries" edges to the ZapierDelivery entity.
+func (_u *APIKeyUpdateOne) ClearZapierDeliveries() *APIKeyUpdateOne {
+	_u.mutation.ClearZapierDeliveries()
+	return _u
+}
+
+// RemoveZapierDeliveryIDs removes the "zapier_deliveries" edge to ZapierDelivery entities by IDs.
+func (_u *APIKeyUpdateOne) RemoveZapierDeliveryIDs(ids ...int) *APIKeyUpdateOne {
+	_u.mutation.RemoveZapierDeliveryIDs(ids...)
+	return _u
+}
+
+// RemoveZapierDeliveries removes "zapier_deliveries" edges to ZapierDelivery entities.
+func (_u *APIKeyUpdateOne) RemoveZapierDeliveries(v ...*ZapierDelivery) *APIKeyUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveZapierDeliveryIDs(ids...)
+}
+
 // Where appends a list predicates to the APIKeyUpdate builder.
 func (_u *APIKeyUpdateOne) Where(ps ...predicate.APIKey) *APIKeyUpdateOne {
 	_u.mutation.Where(ps...)
@@ -626,6 +753,12 @@ func (_u *APIKeyUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *APIKeyUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *APIKeyUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *APIKeyUpdateOne) sqlSave(ctx context.Context) (_node *APIKey, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -723,6 +856,52 @@ func (_u *APIKeyUpdateOne) sqlSave(ctx context.Context) (_node *APIKey, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ZapierDeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   apikey.ZapierDeliveriesTable,
+			Columns: []string{apikey.ZapierDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(zapierdelivery.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedZapierDeliveriesIDs(); len(nodes) > 0 && !_u.mutation.ZapierDeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   apikey.ZapierDeliveriesTable,
+			Columns: []string{apikey.ZapierDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(zapierdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ZapierDeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   apikey.ZapierDeliveriesTable,
+			Columns: []string{apikey.ZapierDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(zapierdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &APIKey{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
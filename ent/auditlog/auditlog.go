@@ -83,27 +83,38 @@ type Action string
 
 // Action values.
 const (
-	ActionUserLogin          Action = "user_login"
-	ActionUserLogout         Action = "user_logout"
-	ActionUserRegister       Action = "user_register"
-	ActionUserProfileUpdate  Action = "user_profile_update"
-	ActionUserPasswordChange Action = "user_password_change"
-	ActionUserEmailVerify    Action = "user_email_verify"
-	ActionUserAccountDelete  Action = "user_account_delete"
-	ActionUserUpdate         Action = "user_update"
-	ActionUserSuspension     Action = "user_suspension"
-	ActionDataExport         Action = "data_export"
-	ActionLeadSearch         Action = "lead_search"
-	ActionLeadView           Action = "lead_view"
-	ActionExportCreate       Action = "export_create"
-	ActionExportDownload     Action = "export_download"
-	ActionSubscriptionCreate Action = "subscription_create"
-	ActionSubscriptionUpdate Action = "subscription_update"
-	ActionSubscriptionCancel Action = "subscription_cancel"
-	ActionPaymentSuccess     Action = "payment_success"
-	ActionPaymentFailed      Action = "payment_failed"
-	ActionAPIKeyCreate       Action = "api_key_create"
-	ActionAPIKeyDelete       Action = "api_key_delete"
+	ActionUserLogin              Action = "user_login"
+	ActionUserLogout             Action = "user_logout"
+	ActionSessionRevoke          Action = "session_revoke"
+	ActionUserRegister           Action = "user_register"
+	ActionUserProfileUpdate      Action = "user_profile_update"
+	ActionUserPasswordChange     Action = "user_password_change"
+	ActionUserEmailVerify        Action = "user_email_verify"
+	ActionUserAccountDelete      Action = "user_account_delete"
+	ActionUserUpdate             Action = "user_update"
+	ActionUserSuspension         Action = "user_suspension"
+	ActionUserActivityReport     Action = "user_activity_report"
+	ActionDataExport             Action = "data_export"
+	ActionDataPurge              Action = "data_purge"
+	ActionLeadSearch             Action = "lead_search"
+	ActionLeadView               Action = "lead_view"
+	ActionLeadVerify             Action = "lead_verify"
+	ActionLeadUnverify           Action = "lead_unverify"
+	ActionLeadTag                Action = "lead_tag"
+	ActionLeadMerge              Action = "lead_merge"
+	ActionLeadReindex            Action = "lead_reindex"
+	ActionSequenceStopAll        Action = "sequence_stop_all"
+	ActionWebhookBulkDelete      Action = "webhook_bulk_delete"
+	ActionExportCreate           Action = "export_create"
+	ActionExportDownload         Action = "export_download"
+	ActionSubscriptionCreate     Action = "subscription_create"
+	ActionSubscriptionUpdate     Action = "subscription_update"
+	ActionSubscriptionCancel     Action = "subscription_cancel"
+	ActionPaymentSuccess         Action = "payment_success"
+	ActionPaymentFailed          Action = "payment_failed"
+	ActionAPIKeyCreate           Action = "api_key_create"
+	ActionAPIKeyDelete           Action = "api_key_delete"
+	ActionInternalServiceRequest Action = "internal_service_request"
 )
 
 func (a Action) String() string {
@@ -113,7 +124,7 @@ func (a Action) String() string {
 // ActionValidator is a validator for the "action" field enum values. It is called by the builders before save.
 func ActionValidator(a Action) error {
 	switch a {
-	case ActionUserLogin, ActionUserLogout, ActionUserRegister, ActionUserProfileUpdate, ActionUserPasswordChange, ActionUserEmailVerify, ActionUserAccountDelete, ActionUserUpdate, ActionUserSuspension, ActionDataExport, ActionLeadSearch, ActionLeadView, ActionExportCreate, ActionExportDownload, ActionSubscriptionCreate, ActionSubscriptionUpdate, ActionSubscriptionCancel, ActionPaymentSuccess, ActionPaymentFailed, ActionAPIKeyCreate, ActionAPIKeyDelete:
+	case ActionUserLogin, ActionUserLogout, ActionSessionRevoke, ActionUserRegister, ActionUserProfileUpdate, ActionUserPasswordChange, ActionUserEmailVerify, ActionUserAccountDelete, ActionUserUpdate, ActionUserSuspension, ActionUserActivityReport, ActionDataExport, ActionDataPurge, ActionLeadSearch, ActionLeadView, ActionLeadVerify, ActionLeadUnverify, ActionLeadTag, ActionLeadMerge, ActionLeadReindex, ActionSequenceStopAll, ActionWebhookBulkDelete, ActionExportCreate, ActionExportDownload, ActionSubscriptionCreate, ActionSubscriptionUpdate, ActionSubscriptionCancel, ActionPaymentSuccess, ActionPaymentFailed, ActionAPIKeyCreate, ActionAPIKeyDelete, ActionInternalServiceRequest:
 		return nil
 	default:
 		return fmt.Errorf("auditlog: invalid enum value for action field: %q", a)
@@ -26,6 +26,7 @@ type CallLogQuery struct {
 	predicates []predicate.CallLog
 	withUser   *UserQuery
 	withLead   *LeadQuery
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -301,8 +302,9 @@ func (_q *CallLogQuery) Clone() *CallLogQuery {
 		withUser:   _q.withUser.Clone(),
 		withLead:   _q.withLead.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -420,6 +422,9 @@ func (_q *CallLogQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Call
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -508,6 +513,9 @@ func (_q *CallLogQuery) loadLead(ctx context.Context, query *LeadQuery, nodes []
 
 func (_q *CallLogQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -576,6 +584,9 @@ func (_q *CallLogQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -593,6 +604,12 @@ func (_q *CallLogQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *CallLogQuery) Modify(modifiers ...func(s *sql.Selector)) *CallLogSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // CallLogGroupBy is the group-by builder for CallLog entities.
 type CallLogGroupBy struct {
 	selector
@@ -682,3 +699,9 @@ func (_s *CallLogSelect) sqlScan(ctx context.Context, root *CallLogQuery, v any)
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *CallLogSelect) Modify(modifiers ...func(s *sql.Selector)) *CallLogSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
@@ -20,8 +20,9 @@ import (
 // CallLogUpdate is the builder for updating CallLog entities.
 type CallLogUpdate struct {
 	config
-	hooks    []Hook
-	mutation *CallLogMutation
+	hooks     []Hook
+	mutation  *CallLogMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the CallLogUpdate builder.
@@ -476,6 +477,12 @@ func (_u *CallLogUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CallLogUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CallLogUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *CallLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -630,6 +637,7 @@ func (_u *CallLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{calllog.Label}
@@ -645,9 +653,10 @@ func (_u *CallLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // CallLogUpdateOne is the builder for updating a single CallLog entity.
 type CallLogUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *CallLogMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *CallLogMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUserID sets the "user_id" field.
@@ -1109,6 +1118,12 @@ func (_u *CallLogUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CallLogUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CallLogUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *CallLogUpdateOne) sqlSave(ctx context.Context) (_node *CallLog, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -1280,6 +1295,7 @@ func (_u *CallLogUpdateOne) sqlSave(ctx context.Context) (_node *CallLog, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &CallLog{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
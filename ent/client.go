@@ -23,37 +23,57 @@ import (
 	"github.com/jordanlanch/industrydb/ent/calllog"
 	"github.com/jordanlanch/industrydb/ent/competitormetric"
 	"github.com/jordanlanch/industrydb/ent/competitorprofile"
+	"github.com/jordanlanch/industrydb/ent/contactattempt"
 	"github.com/jordanlanch/industrydb/ent/crmintegration"
 	"github.com/jordanlanch/industrydb/ent/crmleadsync"
+	"github.com/jordanlanch/industrydb/ent/crmpushjob"
 	"github.com/jordanlanch/industrydb/ent/emailcampaign"
 	"github.com/jordanlanch/industrydb/ent/emailcampaignrecipient"
+	"github.com/jordanlanch/industrydb/ent/emailsend"
 	"github.com/jordanlanch/industrydb/ent/emailsequence"
 	"github.com/jordanlanch/industrydb/ent/emailsequenceenrollment"
 	"github.com/jordanlanch/industrydb/ent/emailsequencesend"
 	"github.com/jordanlanch/industrydb/ent/emailsequencestep"
+	"github.com/jordanlanch/industrydb/ent/emailsuppression"
+	"github.com/jordanlanch/industrydb/ent/enrichmentattempt"
+	"github.com/jordanlanch/industrydb/ent/enrichmentbudget"
 	"github.com/jordanlanch/industrydb/ent/experiment"
 	"github.com/jordanlanch/industrydb/ent/experimentassignment"
 	"github.com/jordanlanch/industrydb/ent/export"
+	"github.com/jordanlanch/industrydb/ent/exporttemplate"
+	"github.com/jordanlanch/industrydb/ent/importjob"
 	"github.com/jordanlanch/industrydb/ent/industry"
+	"github.com/jordanlanch/industrydb/ent/integrationconnection"
 	"github.com/jordanlanch/industrydb/ent/lead"
 	"github.com/jordanlanch/industrydb/ent/leadassignment"
+	"github.com/jordanlanch/industrydb/ent/leadchange"
+	"github.com/jordanlanch/industrydb/ent/leadlicense"
 	"github.com/jordanlanch/industrydb/ent/leadnote"
 	"github.com/jordanlanch/industrydb/ent/leadrecommendation"
+	"github.com/jordanlanch/industrydb/ent/leadreindexjob"
 	"github.com/jordanlanch/industrydb/ent/leadstatushistory"
+	"github.com/jordanlanch/industrydb/ent/leadsuppression"
+	"github.com/jordanlanch/industrydb/ent/leadverification"
 	"github.com/jordanlanch/industrydb/ent/marketreport"
 	"github.com/jordanlanch/industrydb/ent/organization"
 	"github.com/jordanlanch/industrydb/ent/organizationmember"
 	"github.com/jordanlanch/industrydb/ent/referral"
 	"github.com/jordanlanch/industrydb/ent/savedsearch"
+	"github.com/jordanlanch/industrydb/ent/savedsearchsnapshot"
+	"github.com/jordanlanch/industrydb/ent/scheduledexport"
 	"github.com/jordanlanch/industrydb/ent/smscampaign"
 	"github.com/jordanlanch/industrydb/ent/smsmessage"
 	"github.com/jordanlanch/industrydb/ent/subscription"
 	"github.com/jordanlanch/industrydb/ent/territory"
 	"github.com/jordanlanch/industrydb/ent/territorymember"
+	"github.com/jordanlanch/industrydb/ent/usagedailyaggregate"
 	"github.com/jordanlanch/industrydb/ent/usagelog"
 	"github.com/jordanlanch/industrydb/ent/user"
 	"github.com/jordanlanch/industrydb/ent/userbehavior"
+	"github.com/jordanlanch/industrydb/ent/usernotificationpreference"
 	"github.com/jordanlanch/industrydb/ent/webhook"
+	"github.com/jordanlanch/industrydb/ent/webhookdelivery"
+	"github.com/jordanlanch/industrydb/ent/zapierdelivery"
 )
 
 // Client is the client that holds all ent builders.
@@ -75,16 +95,22 @@ type Client struct {
 	CRMIntegration *CRMIntegrationClient
 	// CRMLeadSync is the client for interacting with the CRMLeadSync builders.
 	CRMLeadSync *CRMLeadSyncClient
+	// CRMPushJob is the client for interacting with the CRMPushJob builders.
+	CRMPushJob *CRMPushJobClient
 	// CallLog is the client for interacting with the CallLog builders.
 	CallLog *CallLogClient
 	// CompetitorMetric is the client for interacting with the CompetitorMetric builders.
 	CompetitorMetric *CompetitorMetricClient
 	// CompetitorProfile is the client for interacting with the CompetitorProfile builders.
 	CompetitorProfile *CompetitorProfileClient
+	// ContactAttempt is the client for interacting with the ContactAttempt builders.
+	ContactAttempt *ContactAttemptClient
 	// EmailCampaign is the client for interacting with the EmailCampaign builders.
 	EmailCampaign *EmailCampaignClient
 	// EmailCampaignRecipient is the client for interacting with the EmailCampaignRecipient builders.
 	EmailCampaignRecipient *EmailCampaignRecipientClient
+	// EmailSend is the client for interacting with the EmailSend builders.
+	EmailSend *EmailSendClient
 	// EmailSequence is the client for interacting with the EmailSequence builders.
 	EmailSequence *EmailSequenceClient
 	// EmailSequenceEnrollment is the client for interacting with the EmailSequenceEnrollment builders.
@@ -93,24 +119,46 @@ type Client struct {
 	EmailSequenceSend *EmailSequenceSendClient
 	// EmailSequenceStep is the client for interacting with the EmailSequenceStep builders.
 	EmailSequenceStep *EmailSequenceStepClient
+	// EmailSuppression is the client for interacting with the EmailSuppression builders.
+	EmailSuppression *EmailSuppressionClient
+	// EnrichmentAttempt is the client for interacting with the EnrichmentAttempt builders.
+	EnrichmentAttempt *EnrichmentAttemptClient
+	// EnrichmentBudget is the client for interacting with the EnrichmentBudget builders.
+	EnrichmentBudget *EnrichmentBudgetClient
 	// Experiment is the client for interacting with the Experiment builders.
 	Experiment *ExperimentClient
 	// ExperimentAssignment is the client for interacting with the ExperimentAssignment builders.
 	ExperimentAssignment *ExperimentAssignmentClient
 	// Export is the client for interacting with the Export builders.
 	Export *ExportClient
+	// ExportTemplate is the client for interacting with the ExportTemplate builders.
+	ExportTemplate *ExportTemplateClient
+	// ImportJob is the client for interacting with the ImportJob builders.
+	ImportJob *ImportJobClient
 	// Industry is the client for interacting with the Industry builders.
 	Industry *IndustryClient
+	// IntegrationConnection is the client for interacting with the IntegrationConnection builders.
+	IntegrationConnection *IntegrationConnectionClient
 	// Lead is the client for interacting with the Lead builders.
 	Lead *LeadClient
 	// LeadAssignment is the client for interacting with the LeadAssignment builders.
 	LeadAssignment *LeadAssignmentClient
+	// LeadChange is the client for interacting with the LeadChange builders.
+	LeadChange *LeadChangeClient
+	// LeadLicense is the client for interacting with the LeadLicense builders.
+	LeadLicense *LeadLicenseClient
 	// LeadNote is the client for interacting with the LeadNote builders.
 	LeadNote *LeadNoteClient
 	// LeadRecommendation is the client for interacting with the LeadRecommendation builders.
 	LeadRecommendation *LeadRecommendationClient
+	// LeadReindexJob is the client for interacting with the LeadReindexJob builders.
+	LeadReindexJob *LeadReindexJobClient
 	// LeadStatusHistory is the client for interacting with the LeadStatusHistory builders.
 	LeadStatusHistory *LeadStatusHistoryClient
+	// LeadSuppression is the client for interacting with the LeadSuppression builders.
+	LeadSuppression *LeadSuppressionClient
+	// LeadVerification is the client for interacting with the LeadVerification builders.
+	LeadVerification *LeadVerificationClient
 	// MarketReport is the client for interacting with the MarketReport builders.
 	MarketReport *MarketReportClient
 	// Organization is the client for interacting with the Organization builders.
@@ -125,20 +173,32 @@ type Client struct {
 	SMSMessage *SMSMessageClient
 	// SavedSearch is the client for interacting with the SavedSearch builders.
 	SavedSearch *SavedSearchClient
+	// SavedSearchSnapshot is the client for interacting with the SavedSearchSnapshot builders.
+	SavedSearchSnapshot *SavedSearchSnapshotClient
+	// ScheduledExport is the client for interacting with the ScheduledExport builders.
+	ScheduledExport *ScheduledExportClient
 	// Subscription is the client for interacting with the Subscription builders.
 	Subscription *SubscriptionClient
 	// Territory is the client for interacting with the Territory builders.
 	Territory *TerritoryClient
 	// TerritoryMember is the client for interacting with the TerritoryMember builders.
 	TerritoryMember *TerritoryMemberClient
+	// UsageDailyAggregate is the client for interacting with the UsageDailyAggregate builders.
+	UsageDailyAggregate *UsageDailyAggregateClient
 	// UsageLog is the client for interacting with the UsageLog builders.
 	UsageLog *UsageLogClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// UserBehavior is the client for interacting with the UserBehavior builders.
 	UserBehavior *UserBehaviorClient
+	// UserNotificationPreference is the client for interacting with the UserNotificationPreference builders.
+	UserNotificationPreference *UserNotificationPreferenceClient
 	// Webhook is the client for interacting with the Webhook builders.
 	Webhook *WebhookClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
+	// ZapierDelivery is the client for interacting with the ZapierDelivery builders.
+	ZapierDelivery *ZapierDeliveryClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -157,24 +217,38 @@ func (c *Client) init() {
 	c.AuditLog = NewAuditLogClient(c.config)
 	c.CRMIntegration = NewCRMIntegrationClient(c.config)
 	c.CRMLeadSync = NewCRMLeadSyncClient(c.config)
+	c.CRMPushJob = NewCRMPushJobClient(c.config)
 	c.CallLog = NewCallLogClient(c.config)
 	c.CompetitorMetric = NewCompetitorMetricClient(c.config)
 	c.CompetitorProfile = NewCompetitorProfileClient(c.config)
+	c.ContactAttempt = NewContactAttemptClient(c.config)
 	c.EmailCampaign = NewEmailCampaignClient(c.config)
 	c.EmailCampaignRecipient = NewEmailCampaignRecipientClient(c.config)
+	c.EmailSend = NewEmailSendClient(c.config)
 	c.EmailSequence = NewEmailSequenceClient(c.config)
 	c.EmailSequenceEnrollment = NewEmailSequenceEnrollmentClient(c.config)
 	c.EmailSequenceSend = NewEmailSequenceSendClient(c.config)
 	c.EmailSequenceStep = NewEmailSequenceStepClient(c.config)
+	c.EmailSuppression = NewEmailSuppressionClient(c.config)
+	c.EnrichmentAttempt = NewEnrichmentAttemptClient(c.config)
+	c.EnrichmentBudget = NewEnrichmentBudgetClient(c.config)
 	c.Experiment = NewExperimentClient(c.config)
 	c.ExperimentAssignment = NewExperimentAssignmentClient(c.config)
 	c.Export = NewExportClient(c.config)
+	c.ExportTemplate = NewExportTemplateClient(c.config)
+	c.ImportJob = NewImportJobClient(c.config)
 	c.Industry = NewIndustryClient(c.config)
+	c.IntegrationConnection = NewIntegrationConnectionClient(c.config)
 	c.Lead = NewLeadClient(c.config)
 	c.LeadAssignment = NewLeadAssignmentClient(c.config)
+	c.LeadChange = NewLeadChangeClient(c.config)
+	c.LeadLicense = NewLeadLicenseClient(c.config)
 	c.LeadNote = NewLeadNoteClient(c.config)
 	c.LeadRecommendation = NewLeadRecommendationClient(c.config)
+	c.LeadReindexJob = NewLeadReindexJobClient(c.config)
 	c.LeadStatusHistory = NewLeadStatusHistoryClient(c.config)
+	c.LeadSuppression = NewLeadSuppressionClient(c.config)
+	c.LeadVerification = NewLeadVerificationClient(c.config)
 	c.MarketReport = NewMarketReportClient(c.config)
 	c.Organization = NewOrganizationClient(c.config)
 	c.OrganizationMember = NewOrganizationMemberClient(c.config)
@@ -182,13 +256,19 @@ func (c *Client) init() {
 	c.SMSCampaign = NewSMSCampaignClient(c.config)
 	c.SMSMessage = NewSMSMessageClient(c.config)
 	c.SavedSearch = NewSavedSearchClient(c.config)
+	c.SavedSearchSnapshot = NewSavedSearchSnapshotClient(c.config)
+	c.ScheduledExport = NewScheduledExportClient(c.config)
 	c.Subscription = NewSubscriptionClient(c.config)
 	c.Territory = NewTerritoryClient(c.config)
 	c.TerritoryMember = NewTerritoryMemberClient(c.config)
+	c.UsageDailyAggregate = NewUsageDailyAggregateClient(c.config)
 	c.UsageLog = NewUsageLogClient(c.config)
 	c.User = NewUserClient(c.config)
 	c.UserBehavior = NewUserBehaviorClient(c.config)
+	c.UserNotificationPreference = NewUserNotificationPreferenceClient(c.config)
 	c.Webhook = NewWebhookClient(c.config)
+	c.WebhookDelivery = NewWebhookDeliveryClient(c.config)
+	c.ZapierDelivery = NewZapierDeliveryClient(c.config)
 }
 
 type (
@@ -279,47 +359,67 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:                     ctx,
-		config:                  cfg,
-		APIKey:                  NewAPIKeyClient(cfg),
-		Affiliate:               NewAffiliateClient(cfg),
-		AffiliateClick:          NewAffiliateClickClient(cfg),
-		AffiliateConversion:     NewAffiliateConversionClient(cfg),
-		AuditLog:                NewAuditLogClient(cfg),
-		CRMIntegration:          NewCRMIntegrationClient(cfg),
-		CRMLeadSync:             NewCRMLeadSyncClient(cfg),
-		CallLog:                 NewCallLogClient(cfg),
-		CompetitorMetric:        NewCompetitorMetricClient(cfg),
-		CompetitorProfile:       NewCompetitorProfileClient(cfg),
-		EmailCampaign:           NewEmailCampaignClient(cfg),
-		EmailCampaignRecipient:  NewEmailCampaignRecipientClient(cfg),
-		EmailSequence:           NewEmailSequenceClient(cfg),
-		EmailSequenceEnrollment: NewEmailSequenceEnrollmentClient(cfg),
-		EmailSequenceSend:       NewEmailSequenceSendClient(cfg),
-		EmailSequenceStep:       NewEmailSequenceStepClient(cfg),
-		Experiment:              NewExperimentClient(cfg),
-		ExperimentAssignment:    NewExperimentAssignmentClient(cfg),
-		Export:                  NewExportClient(cfg),
-		Industry:                NewIndustryClient(cfg),
-		Lead:                    NewLeadClient(cfg),
-		LeadAssignment:          NewLeadAssignmentClient(cfg),
-		LeadNote:                NewLeadNoteClient(cfg),
-		LeadRecommendation:      NewLeadRecommendationClient(cfg),
-		LeadStatusHistory:       NewLeadStatusHistoryClient(cfg),
-		MarketReport:            NewMarketReportClient(cfg),
-		Organization:            NewOrganizationClient(cfg),
-		OrganizationMember:      NewOrganizationMemberClient(cfg),
-		Referral:                NewReferralClient(cfg),
-		SMSCampaign:             NewSMSCampaignClient(cfg),
-		SMSMessage:              NewSMSMessageClient(cfg),
-		SavedSearch:             NewSavedSearchClient(cfg),
-		Subscription:            NewSubscriptionClient(cfg),
-		Territory:               NewTerritoryClient(cfg),
-		TerritoryMember:         NewTerritoryMemberClient(cfg),
-		UsageLog:                NewUsageLogClient(cfg),
-		User:                    NewUserClient(cfg),
-		UserBehavior:            NewUserBehaviorClient(cfg),
-		Webhook:                 NewWebhookClient(cfg),
+		ctx:                        ctx,
+		config:                     cfg,
+		APIKey:                     NewAPIKeyClient(cfg),
+		Affiliate:                  NewAffiliateClient(cfg),
+		AffiliateClick:             NewAffiliateClickClient(cfg),
+		AffiliateConversion:        NewAffiliateConversionClient(cfg),
+		AuditLog:                   NewAuditLogClient(cfg),
+		CRMIntegration:             NewCRMIntegrationClient(cfg),
+		CRMLeadSync:                NewCRMLeadSyncClient(cfg),
+		CRMPushJob:                 NewCRMPushJobClient(cfg),
+		CallLog:                    NewCallLogClient(cfg),
+		CompetitorMetric:           NewCompetitorMetricClient(cfg),
+		CompetitorProfile:          NewCompetitorProfileClient(cfg),
+		ContactAttempt:             NewContactAttemptClient(cfg),
+		EmailCampaign:              NewEmailCampaignClient(cfg),
+		EmailCampaignRecipient:     NewEmailCampaignRecipientClient(cfg),
+		EmailSend:                  NewEmailSendClient(cfg),
+		EmailSequence:              NewEmailSequenceClient(cfg),
+		EmailSequenceEnrollment:    NewEmailSequenceEnrollmentClient(cfg),
+		EmailSequenceSend:          NewEmailSequenceSendClient(cfg),
+		EmailSequenceStep:          NewEmailSequenceStepClient(cfg),
+		EmailSuppression:           NewEmailSuppressionClient(cfg),
+		EnrichmentAttempt:          NewEnrichmentAttemptClient(cfg),
+		EnrichmentBudget:           NewEnrichmentBudgetClient(cfg),
+		Experiment:                 NewExperimentClient(cfg),
+		ExperimentAssignment:       NewExperimentAssignmentClient(cfg),
+		Export:                     NewExportClient(cfg),
+		ExportTemplate:             NewExportTemplateClient(cfg),
+		ImportJob:                  NewImportJobClient(cfg),
+		Industry:                   NewIndustryClient(cfg),
+		IntegrationConnection:      NewIntegrationConnectionClient(cfg),
+		Lead:                       NewLeadClient(cfg),
+		LeadAssignment:             NewLeadAssignmentClient(cfg),
+		LeadChange:                 NewLeadChangeClient(cfg),
+		LeadLicense:                NewLeadLicenseClient(cfg),
+		LeadNote:                   NewLeadNoteClient(cfg),
+		LeadRecommendation:         NewLeadRecommendationClient(cfg),
+		LeadReindexJob:             NewLeadReindexJobClient(cfg),
+		LeadStatusHistory:          NewLeadStatusHistoryClient(cfg),
+		LeadSuppression:            NewLeadSuppressionClient(cfg),
+		LeadVerification:           NewLeadVerificationClient(cfg),
+		MarketReport:               NewMarketReportClient(cfg),
+		Organization:               NewOrganizationClient(cfg),
+		OrganizationMember:         NewOrganizationMemberClient(cfg),
+		Referral:                   NewReferralClient(cfg),
+		SMSCampaign:                NewSMSCampaignClient(cfg),
+		SMSMessage:                 NewSMSMessageClient(cfg),
+		SavedSearch:                NewSavedSearchClient(cfg),
+		SavedSearchSnapshot:        NewSavedSearchSnapshotClient(cfg),
+		ScheduledExport:            NewScheduledExportClient(cfg),
+		Subscription:               NewSubscriptionClient(cfg),
+		Territory:                  NewTerritoryClient(cfg),
+		TerritoryMember:            NewTerritoryMemberClient(cfg),
+		UsageDailyAggregate:        NewUsageDailyAggregateClient(cfg),
+		UsageLog:                   NewUsageLogClient(cfg),
+		User:                       NewUserClient(cfg),
+		UserBehavior:               NewUserBehaviorClient(cfg),
+		UserNotificationPreference: NewUserNotificationPreferenceClient(cfg),
+		Webhook:                    NewWebhookClient(cfg),
+		WebhookDelivery:            NewWebhookDeliveryClient(cfg),
+		ZapierDelivery:             NewZapierDeliveryClient(cfg),
 	}, nil
 }
 
@@ -337,47 +437,67 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:                     ctx,
-		config:                  cfg,
-		APIKey:                  NewAPIKeyClient(cfg),
-		Affiliate:               NewAffiliateClient(cfg),
-		AffiliateClick:          NewAffiliateClickClient(cfg),
-		AffiliateConversion:     NewAffiliateConversionClient(cfg),
-		AuditLog:                NewAuditLogClient(cfg),
-		CRMIntegration:          NewCRMIntegrationClient(cfg),
-		CRMLeadSync:             NewCRMLeadSyncClient(cfg),
-		CallLog:                 NewCallLogClient(cfg),
-		CompetitorMetric:        NewCompetitorMetricClient(cfg),
-		CompetitorProfile:       NewCompetitorProfileClient(cfg),
-		EmailCampaign:           NewEmailCampaignClient(cfg),
-		EmailCampaignRecipient:  NewEmailCampaignRecipientClient(cfg),
-		EmailSequence:           NewEmailSequenceClient(cfg),
-		EmailSequenceEnrollment: NewEmailSequenceEnrollmentClient(cfg),
-		EmailSequenceSend:       NewEmailSequenceSendClient(cfg),
-		EmailSequenceStep:       NewEmailSequenceStepClient(cfg),
-		Experiment:              NewExperimentClient(cfg),
-		ExperimentAssignment:    NewExperimentAssignmentClient(cfg),
-		Export:                  NewExportClient(cfg),
-		Industry:                NewIndustryClient(cfg),
-		Lead:                    NewLeadClient(cfg),
-		LeadAssignment:          NewLeadAssignmentClient(cfg),
-		LeadNote:                NewLeadNoteClient(cfg),
-		LeadRecommendation:      NewLeadRecommendationClient(cfg),
-		LeadStatusHistory:       NewLeadStatusHistoryClient(cfg),
-		MarketReport:            NewMarketReportClient(cfg),
-		Organization:            NewOrganizationClient(cfg),
-		OrganizationMember:      NewOrganizationMemberClient(cfg),
-		Referral:                NewReferralClient(cfg),
-		SMSCampaign:             NewSMSCampaignClient(cfg),
-		SMSMessage:              NewSMSMessageClient(cfg),
-		SavedSearch:             NewSavedSearchClient(cfg),
-		Subscription:            NewSubscriptionClient(cfg),
-		Territory:               NewTerritoryClient(cfg),
-		TerritoryMember:         NewTerritoryMemberClient(cfg),
-		UsageLog:                NewUsageLogClient(cfg),
-		User:                    NewUserClient(cfg),
-		UserBehavior:            NewUserBehaviorClient(cfg),
-		Webhook:                 NewWebhookClient(cfg),
+		ctx:                        ctx,
+		config:                     cfg,
+		APIKey:                     NewAPIKeyClient(cfg),
+		Affiliate:                  NewAffiliateClient(cfg),
+		AffiliateClick:             NewAffiliateClickClient(cfg),
+		AffiliateConversion:        NewAffiliateConversionClient(cfg),
+		AuditLog:                   NewAuditLogClient(cfg),
+		CRMIntegration:             NewCRMIntegrationClient(cfg),
+		CRMLeadSync:                NewCRMLeadSyncClient(cfg),
+		CRMPushJob:                 NewCRMPushJobClient(cfg),
+		CallLog:                    NewCallLogClient(cfg),
+		CompetitorMetric:           NewCompetitorMetricClient(cfg),
+		CompetitorProfile:          NewCompetitorProfileClient(cfg),
+		ContactAttempt:             NewContactAttemptClient(cfg),
+		EmailCampaign:              NewEmailCampaignClient(cfg),
+		EmailCampaignRecipient:     NewEmailCampaignRecipientClient(cfg),
+		EmailSend:                  NewEmailSendClient(cfg),
+		EmailSequence:              NewEmailSequenceClient(cfg),
+		EmailSequenceEnrollment:    NewEmailSequenceEnrollmentClient(cfg),
+		EmailSequenceSend:          NewEmailSequenceSendClient(cfg),
+		EmailSequenceStep:          NewEmailSequenceStepClient(cfg),
+		EmailSuppression:           NewEmailSuppressionClient(cfg),
+		EnrichmentAttempt:          NewEnrichmentAttemptClient(cfg),
+		EnrichmentBudget:           NewEnrichmentBudgetClient(cfg),
+		Experiment:                 NewExperimentClient(cfg),
+		ExperimentAssignment:       NewExperimentAssignmentClient(cfg),
+		Export:                     NewExportClient(cfg),
+		ExportTemplate:             NewExportTemplateClient(cfg),
+		ImportJob:                  NewImportJobClient(cfg),
+		Industry:                   NewIndustryClient(cfg),
+		IntegrationConnection:      NewIntegrationConnectionClient(cfg),
+		Lead:                       NewLeadClient(cfg),
+		LeadAssignment:             NewLeadAssignmentClient(cfg),
+		LeadChange:                 NewLeadChangeClient(cfg),
+		LeadLicense:                NewLeadLicenseClient(cfg),
+		LeadNote:                   NewLeadNoteClient(cfg),
+		LeadRecommendation:         NewLeadRecommendationClient(cfg),
+		LeadReindexJob:             NewLeadReindexJobClient(cfg),
+		LeadStatusHistory:          NewLeadStatusHistoryClient(cfg),
+		LeadSuppression:            NewLeadSuppressionClient(cfg),
+		LeadVerification:           NewLeadVerificationClient(cfg),
+		MarketReport:               NewMarketReportClient(cfg),
+		Organization:               NewOrganizationClient(cfg),
+		OrganizationMember:         NewOrganizationMemberClient(cfg),
+		Referral:                   NewReferralClient(cfg),
+		SMSCampaign:                NewSMSCampaignClient(cfg),
+		SMSMessage:                 NewSMSMessageClient(cfg),
+		SavedSearch:                NewSavedSearchClient(cfg),
+		SavedSearchSnapshot:        NewSavedSearchSnapshotClient(cfg),
+		ScheduledExport:            NewScheduledExportClient(cfg),
+		Subscription:               NewSubscriptionClient(cfg),
+		Territory:                  NewTerritoryClient(cfg),
+		TerritoryMember:            NewTerritoryMemberClient(cfg),
+		UsageDailyAggregate:        NewUsageDailyAggregateClient(cfg),
+		UsageLog:                   NewUsageLogClient(cfg),
+		User:                       NewUserClient(cfg),
+		UserBehavior:               NewUserBehaviorClient(cfg),
+		UserNotificationPreference: NewUserNotificationPreferenceClient(cfg),
+		Webhook:                    NewWebhookClient(cfg),
+		WebhookDelivery:            NewWebhookDeliveryClient(cfg),
+		ZapierDelivery:             NewZapierDeliveryClient(cfg),
 	}, nil
 }
 
@@ -408,14 +528,19 @@ func (c *Client) Close() error {
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
 		c.APIKey, c.Affiliate, c.AffiliateClick, c.AffiliateConversion, c.AuditLog,
-		c.CRMIntegration, c.CRMLeadSync, c.CallLog, c.CompetitorMetric,
-		c.CompetitorProfile, c.EmailCampaign, c.EmailCampaignRecipient,
-		c.EmailSequence, c.EmailSequenceEnrollment, c.EmailSequenceSend,
-		c.EmailSequenceStep, c.Experiment, c.ExperimentAssignment, c.Export,
-		c.Industry, c.Lead, c.LeadAssignment, c.LeadNote, c.LeadRecommendation,
-		c.LeadStatusHistory, c.MarketReport, c.Organization, c.OrganizationMember,
-		c.Referral, c.SMSCampaign, c.SMSMessage, c.SavedSearch, c.Subscription,
-		c.Territory, c.TerritoryMember, c.UsageLog, c.User, c.UserBehavior, c.Webhook,
+		c.CRMIntegration, c.CRMLeadSync, c.CRMPushJob, c.CallLog, c.CompetitorMetric,
+		c.CompetitorProfile, c.ContactAttempt, c.EmailCampaign,
+		c.EmailCampaignRecipient, c.EmailSend, c.EmailSequence,
+		c.EmailSequenceEnrollment, c.EmailSequenceSend, c.EmailSequenceStep,
+		c.EmailSuppression, c.EnrichmentAttempt, c.EnrichmentBudget, c.Experiment,
+		c.ExperimentAssignment, c.Export, c.ExportTemplate, c.ImportJob, c.Industry,
+		c.IntegrationConnection, c.Lead, c.LeadAssignment, c.LeadChange, c.LeadLicense,
+		c.LeadNote, c.LeadRecommendation, c.LeadReindexJob, c.LeadStatusHistory,
+		c.LeadSuppression, c.LeadVerification, c.MarketReport, c.Organization,
+		c.OrganizationMember, c.Referral, c.SMSCampaign, c.SMSMessage, c.SavedSearch,
+		c.SavedSearchSnapshot, c.ScheduledExport, c.Subscription, c.Territory,
+		c.TerritoryMember, c.UsageDailyAggregate, c.UsageLog, c.User, c.UserBehavior,
+		c.UserNotificationPreference, c.Webhook, c.WebhookDelivery, c.ZapierDelivery,
 	} {
 		n.Use(hooks...)
 	}
@@ -426,14 +551,19 @@ func (c *Client) Use(hooks ...Hook) {
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
 		c.APIKey, c.Affiliate, c.AffiliateClick, c.AffiliateConversion, c.AuditLog,
-		c.CRMIntegration, c.CRMLeadSync, c.CallLog, c.CompetitorMetric,
-		c.CompetitorProfile, c.EmailCampaign, c.EmailCampaignRecipient,
-		c.EmailSequence, c.EmailSequenceEnrollment, c.EmailSequenceSend,
-		c.EmailSequenceStep, c.Experiment, c.ExperimentAssignment, c.Export,
-		c.Industry, c.Lead, c.LeadAssignment, c.LeadNote, c.LeadRecommendation,
-		c.LeadStatusHistory, c.MarketReport, c.Organization, c.OrganizationMember,
-		c.Referral, c.SMSCampaign, c.SMSMessage, c.SavedSearch, c.Subscription,
-		c.Territory, c.TerritoryMember, c.UsageLog, c.User, c.UserBehavior, c.Webhook,
+		c.CRMIntegration, c.CRMLeadSync, c.CRMPushJob, c.CallLog, c.CompetitorMetric,
+		c.CompetitorProfile, c.ContactAttempt, c.EmailCampaign,
+		c.EmailCampaignRecipient, c.EmailSend, c.EmailSequence,
+		c.EmailSequenceEnrollment, c.EmailSequenceSend, c.EmailSequenceStep,
+		c.EmailSuppression, c.EnrichmentAttempt, c.EnrichmentBudget, c.Experiment,
+		c.ExperimentAssignment, c.Export, c.ExportTemplate, c.ImportJob, c.Industry,
+		c.IntegrationConnection, c.Lead, c.LeadAssignment, c.LeadChange, c.LeadLicense,
+		c.LeadNote, c.LeadRecommendation, c.LeadReindexJob, c.LeadStatusHistory,
+		c.LeadSuppression, c.LeadVerification, c.MarketReport, c.Organization,
+		c.OrganizationMember, c.Referral, c.SMSCampaign, c.SMSMessage, c.SavedSearch,
+		c.SavedSearchSnapshot, c.ScheduledExport, c.Subscription, c.Territory,
+		c.TerritoryMember, c.UsageDailyAggregate, c.UsageLog, c.User, c.UserBehavior,
+		c.UserNotificationPreference, c.Webhook, c.WebhookDelivery, c.ZapierDelivery,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -456,16 +586,22 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.CRMIntegration.mutate(ctx, m)
 	case *CRMLeadSyncMutation:
 		return c.CRMLeadSync.mutate(ctx, m)
+	case *CRMPushJobMutation:
+		return c.CRMPushJob.mutate(ctx, m)
 	case *CallLogMutation:
 		return c.CallLog.mutate(ctx, m)
 	case *CompetitorMetricMutation:
 		return c.CompetitorMetric.mutate(ctx, m)
 	case *CompetitorProfileMutation:
 		return c.CompetitorProfile.mutate(ctx, m)
+	case *ContactAttemptMutation:
+		return c.ContactAttempt.mutate(ctx, m)
 	case *EmailCampaignMutation:
 		return c.EmailCampaign.mutate(ctx, m)
 	case *EmailCampaignRecipientMutation:
 		return c.EmailCampaignRecipient.mutate(ctx, m)
+	case *EmailSendMutation:
+		return c.EmailSend.mutate(ctx, m)
 	case *EmailSequenceMutation:
 		return c.EmailSequence.mutate(ctx, m)
 	case *EmailSequenceEnrollmentMutation:
@@ -474,24 +610,46 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.EmailSequenceSend.mutate(ctx, m)
 	case *EmailSequenceStepMutation:
 		return c.EmailSequenceStep.mutate(ctx, m)
+	case *EmailSuppressionMutation:
+		return c.EmailSuppression.mutate(ctx, m)
+	case *EnrichmentAttemptMutation:
+		return c.EnrichmentAttempt.mutate(ctx, m)
+	case *EnrichmentBudgetMutation:
+		return c.EnrichmentBudget.mutate(ctx, m)
 	case *ExperimentMutation:
 		return c.Experiment.mutate(ctx, m)
 	case *ExperimentAssignmentMutation:
 		return c.ExperimentAssignment.mutate(ctx, m)
 	case *ExportMutation:
 		return c.Export.mutate(ctx, m)
+	case *ExportTemplateMutation:
+		return c.ExportTemplate.mutate(ctx, m)
+	case *ImportJobMutation:
+		return c.ImportJob.mutate(ctx, m)
 	case *IndustryMutation:
 		return c.Industry.mutate(ctx, m)
+	case *IntegrationConnectionMutation:
+		return c.IntegrationConnection.mutate(ctx, m)
 	case *LeadMutation:
 		return c.Lead.mutate(ctx, m)
 	case *LeadAssignmentMutation:
 		return c.LeadAssignment.mutate(ctx, m)
+	case *LeadChangeMutation:
+		return c.LeadChange.mutate(ctx, m)
+	case *LeadLicenseMutation:
+		return c.LeadLicense.mutate(ctx, m)
 	case *LeadNoteMutation:
 		return c.LeadNote.mutate(ctx, m)
 	case *LeadRecommendationMutation:
 		return c.LeadRecommendation.mutate(ctx, m)
+	case *LeadReindexJobMutation:
+		return c.LeadReindexJob.mutate(ctx, m)
 	case *LeadStatusHistoryMutation:
 		return c.LeadStatusHistory.mutate(ctx, m)
+	case *LeadSuppressionMutation:
+		return c.LeadSuppression.mutate(ctx, m)
+	case *LeadVerificationMutation:
+		return c.LeadVerification.mutate(ctx, m)
 	case *MarketReportMutation:
 		return c.MarketReport.mutate(ctx, m)
 	case *OrganizationMutation:
@@ -506,20 +664,32 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.SMSMessage.mutate(ctx, m)
 	case *SavedSearchMutation:
 		return c.SavedSearch.mutate(ctx, m)
+	case *SavedSearchSnapshotMutation:
+		return c.SavedSearchSnapshot.mutate(ctx, m)
+	case *ScheduledExportMutation:
+		return c.ScheduledExport.mutate(ctx, m)
 	case *SubscriptionMutation:
 		return c.Subscription.mutate(ctx, m)
 	case *TerritoryMutation:
 		return c.Territory.mutate(ctx, m)
 	case *TerritoryMemberMutation:
 		return c.TerritoryMember.mutate(ctx, m)
+	case *UsageDailyAggregateMutation:
+		return c.UsageDailyAggregate.mutate(ctx, m)
 	case *UsageLogMutation:
 		return c.UsageLog.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	case *UserBehaviorMutation:
 		return c.UserBehavior.mutate(ctx, m)
+	case *UserNotificationPreferenceMutation:
+		return c.UserNotificationPreference.mutate(ctx, m)
 	case *WebhookMutation:
 		return c.Webhook.mutate(ctx, m)
+	case *WebhookDeliveryMutation:
+		return c.WebhookDelivery.mutate(ctx, m)
+	case *ZapierDeliveryMutation:
+		return c.ZapierDelivery.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -649,6 +819,22 @@ func (c *APIKeyClient) QueryUser(_m *APIKey) *UserQuery {
 	return query
 }
 
+// QueryZapierDeliveries queries the zapier_deliveries edge of a APIKey.
+func (c *APIKeyClient) QueryZapierDeliveries(_m *APIKey) *ZapierDeliveryQuery {
+	query := (&ZapierDeliveryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(apikey.Table, apikey.FieldID, id),
+			sqlgraph.To(zapierdelivery.Table, zapierdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, apikey.ZapierDeliveriesTable, apikey.ZapierDeliveriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *APIKeyClient) Hooks() []Hook {
 	return c.hooks.APIKey
@@ -1458,6 +1644,22 @@ func (c *CRMIntegrationClient) QuerySyncedLeads(_m *CRMIntegration) *CRMLeadSync
 	return query
 }
 
+// QueryPushJobs queries the push_jobs edge of a CRMIntegration.
+func (c *CRMIntegrationClient) QueryPushJobs(_m *CRMIntegration) *CRMPushJobQuery {
+	query := (&CRMPushJobClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(crmintegration.Table, crmintegration.FieldID, id),
+			sqlgraph.To(crmpushjob.Table, crmpushjob.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, crmintegration.PushJobsTable, crmintegration.PushJobsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *CRMIntegrationClient) Hooks() []Hook {
 	return c.hooks.CRMIntegration
@@ -1632,6 +1834,171 @@ func (c *CRMLeadSyncClient) mutate(ctx context.Context, m *CRMLeadSyncMutation)
 	}
 }
 
+// CRMPushJobClient is a client for the CRMPushJob schema.
+type CRMPushJobClient struct {
+	config
+}
+
+// NewCRMPushJobClient returns a client for the CRMPushJob from the given config.
+func NewCRMPushJobClient(c config) *CRMPushJobClient {
+	return &CRMPushJobClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `crmpushjob.Hooks(f(g(h())))`.
+func (c *CRMPushJobClient) Use(hooks ...Hook) {
+	c.hooks.CRMPushJob = append(c.hooks.CRMPushJob, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `crmpushjob.Intercept(f(g(h())))`.
+func (c *CRMPushJobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CRMPushJob = append(c.inters.CRMPushJob, interceptors...)
+}
+
+// Create returns a builder for creating a CRMPushJob entity.
+func (c *CRMPushJobClient) Create() *CRMPushJobCreate {
+	mutation := newCRMPushJobMutation(c.config, OpCreate)
+	return &CRMPushJobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CRMPushJob entities.
+func (c *CRMPushJobClient) CreateBulk(builders ...*CRMPushJobCreate) *CRMPushJobCreateBulk {
+	return &CRMPushJobCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CRMPushJobClient) MapCreateBulk(slice any, setFunc func(*CRMPushJobCreate, int)) *CRMPushJobCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CRMPushJobCreateBulk{err: fmt.Errorf("calling to CRMPushJobClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CRMPushJobCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CRMPushJobCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CRMPushJob.
+func (c *CRMPushJobClient) Update() *CRMPushJobUpdate {
+	mutation := newCRMPushJobMutation(c.config, OpUpdate)
+	return &CRMPushJobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CRMPushJobClient) UpdateOne(_m *CRMPushJob) *CRMPushJobUpdateOne {
+	mutation := newCRMPushJobMutation(c.config, OpUpdateOne, withCRMPushJob(_m))
+	return &CRMPushJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CRMPushJobClient) UpdateOneID(id int) *CRMPushJobUpdateOne {
+	mutation := newCRMPushJobMutation(c.config, OpUpdateOne, withCRMPushJobID(id))
+	return &CRMPushJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CRMPushJob.
+func (c *CRMPushJobClient) Delete() *CRMPushJobDelete {
+	mutation := newCRMPushJobMutation(c.config, OpDelete)
+	return &CRMPushJobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CRMPushJobClient) DeleteOne(_m *CRMPushJob) *CRMPushJobDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CRMPushJobClient) DeleteOneID(id int) *CRMPushJobDeleteOne {
+	builder := c.Delete().Where(crmpushjob.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CRMPushJobDeleteOne{builder}
+}
+
+// Query returns a query builder for CRMPushJob.
+func (c *CRMPushJobClient) Query() *CRMPushJobQuery {
+	return &CRMPushJobQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCRMPushJob},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CRMPushJob entity by its id.
+func (c *CRMPushJobClient) Get(ctx context.Context, id int) (*CRMPushJob, error) {
+	return c.Query().Where(crmpushjob.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CRMPushJobClient) GetX(ctx context.Context, id int) *CRMPushJob {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a CRMPushJob.
+func (c *CRMPushJobClient) QueryUser(_m *CRMPushJob) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(crmpushjob.Table, crmpushjob.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, crmpushjob.UserTable, crmpushjob.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryIntegration queries the integration edge of a CRMPushJob.
+func (c *CRMPushJobClient) QueryIntegration(_m *CRMPushJob) *CRMIntegrationQuery {
+	query := (&CRMIntegrationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(crmpushjob.Table, crmpushjob.FieldID, id),
+			sqlgraph.To(crmintegration.Table, crmintegration.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, crmpushjob.IntegrationTable, crmpushjob.IntegrationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CRMPushJobClient) Hooks() []Hook {
+	return c.hooks.CRMPushJob
+}
+
+// Interceptors returns the client interceptors.
+func (c *CRMPushJobClient) Interceptors() []Interceptor {
+	return c.inters.CRMPushJob
+}
+
+func (c *CRMPushJobClient) mutate(ctx context.Context, m *CRMPushJobMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CRMPushJobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CRMPushJobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CRMPushJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CRMPushJobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CRMPushJob mutation op: %q", m.Op())
+	}
+}
+
 // CallLogClient is a client for the CallLog schema.
 type CallLogClient struct {
 	config
@@ -2111,6 +2478,171 @@ func (c *CompetitorProfileClient) mutate(ctx context.Context, m *CompetitorProfi
 	}
 }
 
+// ContactAttemptClient is a client for the ContactAttempt schema.
+type ContactAttemptClient struct {
+	config
+}
+
+// NewContactAttemptClient returns a client for the ContactAttempt from the given config.
+func NewContactAttemptClient(c config) *ContactAttemptClient {
+	return &ContactAttemptClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `contactattempt.Hooks(f(g(h())))`.
+func (c *ContactAttemptClient) Use(hooks ...Hook) {
+	c.hooks.ContactAttempt = append(c.hooks.ContactAttempt, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `contactattempt.Intercept(f(g(h())))`.
+func (c *ContactAttemptClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ContactAttempt = append(c.inters.ContactAttempt, interceptors...)
+}
+
+// Create returns a builder for creating a ContactAttempt entity.
+func (c *ContactAttemptClient) Create() *ContactAttemptCreate {
+	mutation := newContactAttemptMutation(c.config, OpCreate)
+	return &ContactAttemptCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ContactAttempt entities.
+func (c *ContactAttemptClient) CreateBulk(builders ...*ContactAttemptCreate) *ContactAttemptCreateBulk {
+	return &ContactAttemptCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ContactAttemptClient) MapCreateBulk(slice any, setFunc func(*ContactAttemptCreate, int)) *ContactAttemptCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ContactAttemptCreateBulk{err: fmt.Errorf("calling to ContactAttemptClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ContactAttemptCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ContactAttemptCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ContactAttempt.
+func (c *ContactAttemptClient) Update() *ContactAttemptUpdate {
+	mutation := newContactAttemptMutation(c.config, OpUpdate)
+	return &ContactAttemptUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ContactAttemptClient) UpdateOne(_m *ContactAttempt) *ContactAttemptUpdateOne {
+	mutation := newContactAttemptMutation(c.config, OpUpdateOne, withContactAttempt(_m))
+	return &ContactAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ContactAttemptClient) UpdateOneID(id int) *ContactAttemptUpdateOne {
+	mutation := newContactAttemptMutation(c.config, OpUpdateOne, withContactAttemptID(id))
+	return &ContactAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ContactAttempt.
+func (c *ContactAttemptClient) Delete() *ContactAttemptDelete {
+	mutation := newContactAttemptMutation(c.config, OpDelete)
+	return &ContactAttemptDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ContactAttemptClient) DeleteOne(_m *ContactAttempt) *ContactAttemptDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ContactAttemptClient) DeleteOneID(id int) *ContactAttemptDeleteOne {
+	builder := c.Delete().Where(contactattempt.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ContactAttemptDeleteOne{builder}
+}
+
+// Query returns a query builder for ContactAttempt.
+func (c *ContactAttemptClient) Query() *ContactAttemptQuery {
+	return &ContactAttemptQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeContactAttempt},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ContactAttempt entity by its id.
+func (c *ContactAttemptClient) Get(ctx context.Context, id int) (*ContactAttempt, error) {
+	return c.Query().Where(contactattempt.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ContactAttemptClient) GetX(ctx context.Context, id int) *ContactAttempt {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLead queries the lead edge of a ContactAttempt.
+func (c *ContactAttemptClient) QueryLead(_m *ContactAttempt) *LeadQuery {
+	query := (&LeadClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(contactattempt.Table, contactattempt.FieldID, id),
+			sqlgraph.To(lead.Table, lead.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, contactattempt.LeadTable, contactattempt.LeadColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a ContactAttempt.
+func (c *ContactAttemptClient) QueryUser(_m *ContactAttempt) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(contactattempt.Table, contactattempt.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, contactattempt.UserTable, contactattempt.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ContactAttemptClient) Hooks() []Hook {
+	return c.hooks.ContactAttempt
+}
+
+// Interceptors returns the client interceptors.
+func (c *ContactAttemptClient) Interceptors() []Interceptor {
+	return c.inters.ContactAttempt
+}
+
+func (c *ContactAttemptClient) mutate(ctx context.Context, m *ContactAttemptMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ContactAttemptCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ContactAttemptUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ContactAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ContactAttemptDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ContactAttempt mutation op: %q", m.Op())
+	}
+}
+
 // EmailCampaignClient is a client for the EmailCampaign schema.
 type EmailCampaignClient struct {
 	config
@@ -2425,76 +2957,209 @@ func (c *EmailCampaignRecipientClient) mutate(ctx context.Context, m *EmailCampa
 	}
 }
 
-// EmailSequenceClient is a client for the EmailSequence schema.
-type EmailSequenceClient struct {
+// EmailSendClient is a client for the EmailSend schema.
+type EmailSendClient struct {
 	config
 }
 
-// NewEmailSequenceClient returns a client for the EmailSequence from the given config.
-func NewEmailSequenceClient(c config) *EmailSequenceClient {
-	return &EmailSequenceClient{config: c}
+// NewEmailSendClient returns a client for the EmailSend from the given config.
+func NewEmailSendClient(c config) *EmailSendClient {
+	return &EmailSendClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `emailsequence.Hooks(f(g(h())))`.
-func (c *EmailSequenceClient) Use(hooks ...Hook) {
-	c.hooks.EmailSequence = append(c.hooks.EmailSequence, hooks...)
+// A call to `Use(f, g, h)` equals to `emailsend.Hooks(f(g(h())))`.
+func (c *EmailSendClient) Use(hooks ...Hook) {
+	c.hooks.EmailSend = append(c.hooks.EmailSend, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `emailsequence.Intercept(f(g(h())))`.
-func (c *EmailSequenceClient) Intercept(interceptors ...Interceptor) {
-	c.inters.EmailSequence = append(c.inters.EmailSequence, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `emailsend.Intercept(f(g(h())))`.
+func (c *EmailSendClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EmailSend = append(c.inters.EmailSend, interceptors...)
 }
 
-// Create returns a builder for creating a EmailSequence entity.
-func (c *EmailSequenceClient) Create() *EmailSequenceCreate {
-	mutation := newEmailSequenceMutation(c.config, OpCreate)
-	return &EmailSequenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a EmailSend entity.
+func (c *EmailSendClient) Create() *EmailSendCreate {
+	mutation := newEmailSendMutation(c.config, OpCreate)
+	return &EmailSendCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of EmailSequence entities.
-func (c *EmailSequenceClient) CreateBulk(builders ...*EmailSequenceCreate) *EmailSequenceCreateBulk {
-	return &EmailSequenceCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of EmailSend entities.
+func (c *EmailSendClient) CreateBulk(builders ...*EmailSendCreate) *EmailSendCreateBulk {
+	return &EmailSendCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *EmailSequenceClient) MapCreateBulk(slice any, setFunc func(*EmailSequenceCreate, int)) *EmailSequenceCreateBulk {
+func (c *EmailSendClient) MapCreateBulk(slice any, setFunc func(*EmailSendCreate, int)) *EmailSendCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &EmailSequenceCreateBulk{err: fmt.Errorf("calling to EmailSequenceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &EmailSendCreateBulk{err: fmt.Errorf("calling to EmailSendClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*EmailSequenceCreate, rv.Len())
+	builders := make([]*EmailSendCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &EmailSequenceCreateBulk{config: c.config, builders: builders}
+	return &EmailSendCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for EmailSequence.
-func (c *EmailSequenceClient) Update() *EmailSequenceUpdate {
-	mutation := newEmailSequenceMutation(c.config, OpUpdate)
-	return &EmailSequenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for EmailSend.
+func (c *EmailSendClient) Update() *EmailSendUpdate {
+	mutation := newEmailSendMutation(c.config, OpUpdate)
+	return &EmailSendUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *EmailSequenceClient) UpdateOne(_m *EmailSequence) *EmailSequenceUpdateOne {
-	mutation := newEmailSequenceMutation(c.config, OpUpdateOne, withEmailSequence(_m))
-	return &EmailSequenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EmailSendClient) UpdateOne(_m *EmailSend) *EmailSendUpdateOne {
+	mutation := newEmailSendMutation(c.config, OpUpdateOne, withEmailSend(_m))
+	return &EmailSendUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *EmailSequenceClient) UpdateOneID(id int) *EmailSequenceUpdateOne {
-	mutation := newEmailSequenceMutation(c.config, OpUpdateOne, withEmailSequenceID(id))
-	return &EmailSequenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EmailSendClient) UpdateOneID(id int) *EmailSendUpdateOne {
+	mutation := newEmailSendMutation(c.config, OpUpdateOne, withEmailSendID(id))
+	return &EmailSendUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for EmailSequence.
-func (c *EmailSequenceClient) Delete() *EmailSequenceDelete {
-	mutation := newEmailSequenceMutation(c.config, OpDelete)
-	return &EmailSequenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for EmailSend.
+func (c *EmailSendClient) Delete() *EmailSendDelete {
+	mutation := newEmailSendMutation(c.config, OpDelete)
+	return &EmailSendDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EmailSendClient) DeleteOne(_m *EmailSend) *EmailSendDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EmailSendClient) DeleteOneID(id int) *EmailSendDeleteOne {
+	builder := c.Delete().Where(emailsend.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EmailSendDeleteOne{builder}
+}
+
+// Query returns a query builder for EmailSend.
+func (c *EmailSendClient) Query() *EmailSendQuery {
+	return &EmailSendQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEmailSend},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EmailSend entity by its id.
+func (c *EmailSendClient) Get(ctx context.Context, id int) (*EmailSend, error) {
+	return c.Query().Where(emailsend.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EmailSendClient) GetX(ctx context.Context, id int) *EmailSend {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EmailSendClient) Hooks() []Hook {
+	return c.hooks.EmailSend
+}
+
+// Interceptors returns the client interceptors.
+func (c *EmailSendClient) Interceptors() []Interceptor {
+	return c.inters.EmailSend
+}
+
+func (c *EmailSendClient) mutate(ctx context.Context, m *EmailSendMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EmailSendCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EmailSendUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EmailSendUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EmailSendDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EmailSend mutation op: %q", m.Op())
+	}
+}
+
+// EmailSequenceClient is a client for the EmailSequence schema.
+type EmailSequenceClient struct {
+	config
+}
+
+// NewEmailSequenceClient returns a client for the EmailSequence from the given config.
+func NewEmailSequenceClient(c config) *EmailSequenceClient {
+	return &EmailSequenceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `emailsequence.Hooks(f(g(h())))`.
+func (c *EmailSequenceClient) Use(hooks ...Hook) {
+	c.hooks.EmailSequence = append(c.hooks.EmailSequence, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `emailsequence.Intercept(f(g(h())))`.
+func (c *EmailSequenceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EmailSequence = append(c.inters.EmailSequence, interceptors...)
+}
+
+// Create returns a builder for creating a EmailSequence entity.
+func (c *EmailSequenceClient) Create() *EmailSequenceCreate {
+	mutation := newEmailSequenceMutation(c.config, OpCreate)
+	return &EmailSequenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EmailSequence entities.
+func (c *EmailSequenceClient) CreateBulk(builders ...*EmailSequenceCreate) *EmailSequenceCreateBulk {
+	return &EmailSequenceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EmailSequenceClient) MapCreateBulk(slice any, setFunc func(*EmailSequenceCreate, int)) *EmailSequenceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EmailSequenceCreateBulk{err: fmt.Errorf("calling to EmailSequenceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EmailSequenceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EmailSequenceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EmailSequence.
+func (c *EmailSequenceClient) Update() *EmailSequenceUpdate {
+	mutation := newEmailSequenceMutation(c.config, OpUpdate)
+	return &EmailSequenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EmailSequenceClient) UpdateOne(_m *EmailSequence) *EmailSequenceUpdateOne {
+	mutation := newEmailSequenceMutation(c.config, OpUpdateOne, withEmailSequence(_m))
+	return &EmailSequenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EmailSequenceClient) UpdateOneID(id int) *EmailSequenceUpdateOne {
+	mutation := newEmailSequenceMutation(c.config, OpUpdateOne, withEmailSequenceID(id))
+	return &EmailSequenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EmailSequence.
+func (c *EmailSequenceClient) Delete() *EmailSequenceDelete {
+	mutation := newEmailSequenceMutation(c.config, OpDelete)
+	return &EmailSequenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
@@ -3149,107 +3814,107 @@ func (c *EmailSequenceStepClient) mutate(ctx context.Context, m *EmailSequenceSt
 	}
 }
 
-// ExperimentClient is a client for the Experiment schema.
-type ExperimentClient struct {
+// EmailSuppressionClient is a client for the EmailSuppression schema.
+type EmailSuppressionClient struct {
 	config
 }
 
-// NewExperimentClient returns a client for the Experiment from the given config.
-func NewExperimentClient(c config) *ExperimentClient {
-	return &ExperimentClient{config: c}
+// NewEmailSuppressionClient returns a client for the EmailSuppression from the given config.
+func NewEmailSuppressionClient(c config) *EmailSuppressionClient {
+	return &EmailSuppressionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `experiment.Hooks(f(g(h())))`.
-func (c *ExperimentClient) Use(hooks ...Hook) {
-	c.hooks.Experiment = append(c.hooks.Experiment, hooks...)
+// A call to `Use(f, g, h)` equals to `emailsuppression.Hooks(f(g(h())))`.
+func (c *EmailSuppressionClient) Use(hooks ...Hook) {
+	c.hooks.EmailSuppression = append(c.hooks.EmailSuppression, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `experiment.Intercept(f(g(h())))`.
-func (c *ExperimentClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Experiment = append(c.inters.Experiment, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `emailsuppression.Intercept(f(g(h())))`.
+func (c *EmailSuppressionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EmailSuppression = append(c.inters.EmailSuppression, interceptors...)
 }
 
-// Create returns a builder for creating a Experiment entity.
-func (c *ExperimentClient) Create() *ExperimentCreate {
-	mutation := newExperimentMutation(c.config, OpCreate)
-	return &ExperimentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a EmailSuppression entity.
+func (c *EmailSuppressionClient) Create() *EmailSuppressionCreate {
+	mutation := newEmailSuppressionMutation(c.config, OpCreate)
+	return &EmailSuppressionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Experiment entities.
-func (c *ExperimentClient) CreateBulk(builders ...*ExperimentCreate) *ExperimentCreateBulk {
-	return &ExperimentCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of EmailSuppression entities.
+func (c *EmailSuppressionClient) CreateBulk(builders ...*EmailSuppressionCreate) *EmailSuppressionCreateBulk {
+	return &EmailSuppressionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ExperimentClient) MapCreateBulk(slice any, setFunc func(*ExperimentCreate, int)) *ExperimentCreateBulk {
+func (c *EmailSuppressionClient) MapCreateBulk(slice any, setFunc func(*EmailSuppressionCreate, int)) *EmailSuppressionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ExperimentCreateBulk{err: fmt.Errorf("calling to ExperimentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &EmailSuppressionCreateBulk{err: fmt.Errorf("calling to EmailSuppressionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ExperimentCreate, rv.Len())
+	builders := make([]*EmailSuppressionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ExperimentCreateBulk{config: c.config, builders: builders}
+	return &EmailSuppressionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Experiment.
-func (c *ExperimentClient) Update() *ExperimentUpdate {
-	mutation := newExperimentMutation(c.config, OpUpdate)
-	return &ExperimentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for EmailSuppression.
+func (c *EmailSuppressionClient) Update() *EmailSuppressionUpdate {
+	mutation := newEmailSuppressionMutation(c.config, OpUpdate)
+	return &EmailSuppressionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ExperimentClient) UpdateOne(_m *Experiment) *ExperimentUpdateOne {
-	mutation := newExperimentMutation(c.config, OpUpdateOne, withExperiment(_m))
-	return &ExperimentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EmailSuppressionClient) UpdateOne(_m *EmailSuppression) *EmailSuppressionUpdateOne {
+	mutation := newEmailSuppressionMutation(c.config, OpUpdateOne, withEmailSuppression(_m))
+	return &EmailSuppressionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ExperimentClient) UpdateOneID(id int) *ExperimentUpdateOne {
-	mutation := newExperimentMutation(c.config, OpUpdateOne, withExperimentID(id))
-	return &ExperimentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EmailSuppressionClient) UpdateOneID(id int) *EmailSuppressionUpdateOne {
+	mutation := newEmailSuppressionMutation(c.config, OpUpdateOne, withEmailSuppressionID(id))
+	return &EmailSuppressionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Experiment.
-func (c *ExperimentClient) Delete() *ExperimentDelete {
-	mutation := newExperimentMutation(c.config, OpDelete)
-	return &ExperimentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for EmailSuppression.
+func (c *EmailSuppressionClient) Delete() *EmailSuppressionDelete {
+	mutation := newEmailSuppressionMutation(c.config, OpDelete)
+	return &EmailSuppressionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ExperimentClient) DeleteOne(_m *Experiment) *ExperimentDeleteOne {
+func (c *EmailSuppressionClient) DeleteOne(_m *EmailSuppression) *EmailSuppressionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ExperimentClient) DeleteOneID(id int) *ExperimentDeleteOne {
-	builder := c.Delete().Where(experiment.ID(id))
+func (c *EmailSuppressionClient) DeleteOneID(id int) *EmailSuppressionDeleteOne {
+	builder := c.Delete().Where(emailsuppression.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ExperimentDeleteOne{builder}
+	return &EmailSuppressionDeleteOne{builder}
 }
 
-// Query returns a query builder for Experiment.
-func (c *ExperimentClient) Query() *ExperimentQuery {
-	return &ExperimentQuery{
+// Query returns a query builder for EmailSuppression.
+func (c *EmailSuppressionClient) Query() *EmailSuppressionQuery {
+	return &EmailSuppressionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeExperiment},
+		ctx:    &QueryContext{Type: TypeEmailSuppression},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Experiment entity by its id.
-func (c *ExperimentClient) Get(ctx context.Context, id int) (*Experiment, error) {
-	return c.Query().Where(experiment.ID(id)).Only(ctx)
+// Get returns a EmailSuppression entity by its id.
+func (c *EmailSuppressionClient) Get(ctx context.Context, id int) (*EmailSuppression, error) {
+	return c.Query().Where(emailsuppression.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ExperimentClient) GetX(ctx context.Context, id int) *Experiment {
+func (c *EmailSuppressionClient) GetX(ctx context.Context, id int) *EmailSuppression {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3257,148 +3922,132 @@ func (c *ExperimentClient) GetX(ctx context.Context, id int) *Experiment {
 	return obj
 }
 
-// QueryAssignments queries the assignments edge of a Experiment.
-func (c *ExperimentClient) QueryAssignments(_m *Experiment) *ExperimentAssignmentQuery {
-	query := (&ExperimentAssignmentClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(experiment.Table, experiment.FieldID, id),
-			sqlgraph.To(experimentassignment.Table, experimentassignment.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, experiment.AssignmentsTable, experiment.AssignmentsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *ExperimentClient) Hooks() []Hook {
-	return c.hooks.Experiment
+func (c *EmailSuppressionClient) Hooks() []Hook {
+	return c.hooks.EmailSuppression
 }
 
 // Interceptors returns the client interceptors.
-func (c *ExperimentClient) Interceptors() []Interceptor {
-	return c.inters.Experiment
+func (c *EmailSuppressionClient) Interceptors() []Interceptor {
+	return c.inters.EmailSuppression
 }
 
-func (c *ExperimentClient) mutate(ctx context.Context, m *ExperimentMutation) (Value, error) {
+func (c *EmailSuppressionClient) mutate(ctx context.Context, m *EmailSuppressionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ExperimentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EmailSuppressionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ExperimentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EmailSuppressionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ExperimentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EmailSuppressionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ExperimentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&EmailSuppressionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Experiment mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown EmailSuppression mutation op: %q", m.Op())
 	}
 }
 
-// ExperimentAssignmentClient is a client for the ExperimentAssignment schema.
-type ExperimentAssignmentClient struct {
+// EnrichmentAttemptClient is a client for the EnrichmentAttempt schema.
+type EnrichmentAttemptClient struct {
 	config
 }
 
-// NewExperimentAssignmentClient returns a client for the ExperimentAssignment from the given config.
-func NewExperimentAssignmentClient(c config) *ExperimentAssignmentClient {
-	return &ExperimentAssignmentClient{config: c}
+// NewEnrichmentAttemptClient returns a client for the EnrichmentAttempt from the given config.
+func NewEnrichmentAttemptClient(c config) *EnrichmentAttemptClient {
+	return &EnrichmentAttemptClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `experimentassignment.Hooks(f(g(h())))`.
-func (c *ExperimentAssignmentClient) Use(hooks ...Hook) {
-	c.hooks.ExperimentAssignment = append(c.hooks.ExperimentAssignment, hooks...)
+// A call to `Use(f, g, h)` equals to `enrichmentattempt.Hooks(f(g(h())))`.
+func (c *EnrichmentAttemptClient) Use(hooks ...Hook) {
+	c.hooks.EnrichmentAttempt = append(c.hooks.EnrichmentAttempt, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `experimentassignment.Intercept(f(g(h())))`.
-func (c *ExperimentAssignmentClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ExperimentAssignment = append(c.inters.ExperimentAssignment, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `enrichmentattempt.Intercept(f(g(h())))`.
+func (c *EnrichmentAttemptClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EnrichmentAttempt = append(c.inters.EnrichmentAttempt, interceptors...)
 }
 
-// Create returns a builder for creating a ExperimentAssignment entity.
-func (c *ExperimentAssignmentClient) Create() *ExperimentAssignmentCreate {
-	mutation := newExperimentAssignmentMutation(c.config, OpCreate)
-	return &ExperimentAssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a EnrichmentAttempt entity.
+func (c *EnrichmentAttemptClient) Create() *EnrichmentAttemptCreate {
+	mutation := newEnrichmentAttemptMutation(c.config, OpCreate)
+	return &EnrichmentAttemptCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ExperimentAssignment entities.
-func (c *ExperimentAssignmentClient) CreateBulk(builders ...*ExperimentAssignmentCreate) *ExperimentAssignmentCreateBulk {
-	return &ExperimentAssignmentCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of EnrichmentAttempt entities.
+func (c *EnrichmentAttemptClient) CreateBulk(builders ...*EnrichmentAttemptCreate) *EnrichmentAttemptCreateBulk {
+	return &EnrichmentAttemptCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ExperimentAssignmentClient) MapCreateBulk(slice any, setFunc func(*ExperimentAssignmentCreate, int)) *ExperimentAssignmentCreateBulk {
+func (c *EnrichmentAttemptClient) MapCreateBulk(slice any, setFunc func(*EnrichmentAttemptCreate, int)) *EnrichmentAttemptCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ExperimentAssignmentCreateBulk{err: fmt.Errorf("calling to ExperimentAssignmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &EnrichmentAttemptCreateBulk{err: fmt.Errorf("calling to EnrichmentAttemptClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ExperimentAssignmentCreate, rv.Len())
+	builders := make([]*EnrichmentAttemptCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ExperimentAssignmentCreateBulk{config: c.config, builders: builders}
+	return &EnrichmentAttemptCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ExperimentAssignment.
-func (c *ExperimentAssignmentClient) Update() *ExperimentAssignmentUpdate {
-	mutation := newExperimentAssignmentMutation(c.config, OpUpdate)
-	return &ExperimentAssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for EnrichmentAttempt.
+func (c *EnrichmentAttemptClient) Update() *EnrichmentAttemptUpdate {
+	mutation := newEnrichmentAttemptMutation(c.config, OpUpdate)
+	return &EnrichmentAttemptUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ExperimentAssignmentClient) UpdateOne(_m *ExperimentAssignment) *ExperimentAssignmentUpdateOne {
-	mutation := newExperimentAssignmentMutation(c.config, OpUpdateOne, withExperimentAssignment(_m))
-	return &ExperimentAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EnrichmentAttemptClient) UpdateOne(_m *EnrichmentAttempt) *EnrichmentAttemptUpdateOne {
+	mutation := newEnrichmentAttemptMutation(c.config, OpUpdateOne, withEnrichmentAttempt(_m))
+	return &EnrichmentAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ExperimentAssignmentClient) UpdateOneID(id int) *ExperimentAssignmentUpdateOne {
-	mutation := newExperimentAssignmentMutation(c.config, OpUpdateOne, withExperimentAssignmentID(id))
-	return &ExperimentAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EnrichmentAttemptClient) UpdateOneID(id int) *EnrichmentAttemptUpdateOne {
+	mutation := newEnrichmentAttemptMutation(c.config, OpUpdateOne, withEnrichmentAttemptID(id))
+	return &EnrichmentAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ExperimentAssignment.
-func (c *ExperimentAssignmentClient) Delete() *ExperimentAssignmentDelete {
-	mutation := newExperimentAssignmentMutation(c.config, OpDelete)
-	return &ExperimentAssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for EnrichmentAttempt.
+func (c *EnrichmentAttemptClient) Delete() *EnrichmentAttemptDelete {
+	mutation := newEnrichmentAttemptMutation(c.config, OpDelete)
+	return &EnrichmentAttemptDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ExperimentAssignmentClient) DeleteOne(_m *ExperimentAssignment) *ExperimentAssignmentDeleteOne {
+func (c *EnrichmentAttemptClient) DeleteOne(_m *EnrichmentAttempt) *EnrichmentAttemptDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ExperimentAssignmentClient) DeleteOneID(id int) *ExperimentAssignmentDeleteOne {
-	builder := c.Delete().Where(experimentassignment.ID(id))
+func (c *EnrichmentAttemptClient) DeleteOneID(id int) *EnrichmentAttemptDeleteOne {
+	builder := c.Delete().Where(enrichmentattempt.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ExperimentAssignmentDeleteOne{builder}
+	return &EnrichmentAttemptDeleteOne{builder}
 }
 
-// Query returns a query builder for ExperimentAssignment.
-func (c *ExperimentAssignmentClient) Query() *ExperimentAssignmentQuery {
-	return &ExperimentAssignmentQuery{
+// Query returns a query builder for EnrichmentAttempt.
+func (c *EnrichmentAttemptClient) Query() *EnrichmentAttemptQuery {
+	return &EnrichmentAttemptQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeExperimentAssignment},
+		ctx:    &QueryContext{Type: TypeEnrichmentAttempt},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ExperimentAssignment entity by its id.
-func (c *ExperimentAssignmentClient) Get(ctx context.Context, id int) (*ExperimentAssignment, error) {
-	return c.Query().Where(experimentassignment.ID(id)).Only(ctx)
+// Get returns a EnrichmentAttempt entity by its id.
+func (c *EnrichmentAttemptClient) Get(ctx context.Context, id int) (*EnrichmentAttempt, error) {
+	return c.Query().Where(enrichmentattempt.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ExperimentAssignmentClient) GetX(ctx context.Context, id int) *ExperimentAssignment {
+func (c *EnrichmentAttemptClient) GetX(ctx context.Context, id int) *EnrichmentAttempt {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3406,15 +4055,15 @@ func (c *ExperimentAssignmentClient) GetX(ctx context.Context, id int) *Experime
 	return obj
 }
 
-// QueryExperiment queries the experiment edge of a ExperimentAssignment.
-func (c *ExperimentAssignmentClient) QueryExperiment(_m *ExperimentAssignment) *ExperimentQuery {
-	query := (&ExperimentClient{config: c.config}).Query()
+// QueryLead queries the lead edge of a EnrichmentAttempt.
+func (c *EnrichmentAttemptClient) QueryLead(_m *EnrichmentAttempt) *LeadQuery {
+	query := (&LeadClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(experimentassignment.Table, experimentassignment.FieldID, id),
-			sqlgraph.To(experiment.Table, experiment.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, experimentassignment.ExperimentTable, experimentassignment.ExperimentColumn),
+			sqlgraph.From(enrichmentattempt.Table, enrichmentattempt.FieldID, id),
+			sqlgraph.To(lead.Table, lead.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, enrichmentattempt.LeadTable, enrichmentattempt.LeadColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -3422,15 +4071,15 @@ func (c *ExperimentAssignmentClient) QueryExperiment(_m *ExperimentAssignment) *
 	return query
 }
 
-// QueryUser queries the user edge of a ExperimentAssignment.
-func (c *ExperimentAssignmentClient) QueryUser(_m *ExperimentAssignment) *UserQuery {
+// QueryUser queries the user edge of a EnrichmentAttempt.
+func (c *EnrichmentAttemptClient) QueryUser(_m *EnrichmentAttempt) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(experimentassignment.Table, experimentassignment.FieldID, id),
+			sqlgraph.From(enrichmentattempt.Table, enrichmentattempt.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, experimentassignment.UserTable, experimentassignment.UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, enrichmentattempt.UserTable, enrichmentattempt.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -3439,131 +4088,131 @@ func (c *ExperimentAssignmentClient) QueryUser(_m *ExperimentAssignment) *UserQu
 }
 
 // Hooks returns the client hooks.
-func (c *ExperimentAssignmentClient) Hooks() []Hook {
-	return c.hooks.ExperimentAssignment
+func (c *EnrichmentAttemptClient) Hooks() []Hook {
+	return c.hooks.EnrichmentAttempt
 }
 
 // Interceptors returns the client interceptors.
-func (c *ExperimentAssignmentClient) Interceptors() []Interceptor {
-	return c.inters.ExperimentAssignment
+func (c *EnrichmentAttemptClient) Interceptors() []Interceptor {
+	return c.inters.EnrichmentAttempt
 }
 
-func (c *ExperimentAssignmentClient) mutate(ctx context.Context, m *ExperimentAssignmentMutation) (Value, error) {
+func (c *EnrichmentAttemptClient) mutate(ctx context.Context, m *EnrichmentAttemptMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ExperimentAssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EnrichmentAttemptCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ExperimentAssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EnrichmentAttemptUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ExperimentAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EnrichmentAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ExperimentAssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&EnrichmentAttemptDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ExperimentAssignment mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown EnrichmentAttempt mutation op: %q", m.Op())
 	}
 }
 
-// ExportClient is a client for the Export schema.
-type ExportClient struct {
+// EnrichmentBudgetClient is a client for the EnrichmentBudget schema.
+type EnrichmentBudgetClient struct {
 	config
 }
 
-// NewExportClient returns a client for the Export from the given config.
-func NewExportClient(c config) *ExportClient {
-	return &ExportClient{config: c}
+// NewEnrichmentBudgetClient returns a client for the EnrichmentBudget from the given config.
+func NewEnrichmentBudgetClient(c config) *EnrichmentBudgetClient {
+	return &EnrichmentBudgetClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `export.Hooks(f(g(h())))`.
-func (c *ExportClient) Use(hooks ...Hook) {
-	c.hooks.Export = append(c.hooks.Export, hooks...)
+// A call to `Use(f, g, h)` equals to `enrichmentbudget.Hooks(f(g(h())))`.
+func (c *EnrichmentBudgetClient) Use(hooks ...Hook) {
+	c.hooks.EnrichmentBudget = append(c.hooks.EnrichmentBudget, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `export.Intercept(f(g(h())))`.
-func (c *ExportClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Export = append(c.inters.Export, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `enrichmentbudget.Intercept(f(g(h())))`.
+func (c *EnrichmentBudgetClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EnrichmentBudget = append(c.inters.EnrichmentBudget, interceptors...)
 }
 
-// Create returns a builder for creating a Export entity.
-func (c *ExportClient) Create() *ExportCreate {
-	mutation := newExportMutation(c.config, OpCreate)
-	return &ExportCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a EnrichmentBudget entity.
+func (c *EnrichmentBudgetClient) Create() *EnrichmentBudgetCreate {
+	mutation := newEnrichmentBudgetMutation(c.config, OpCreate)
+	return &EnrichmentBudgetCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Export entities.
-func (c *ExportClient) CreateBulk(builders ...*ExportCreate) *ExportCreateBulk {
-	return &ExportCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of EnrichmentBudget entities.
+func (c *EnrichmentBudgetClient) CreateBulk(builders ...*EnrichmentBudgetCreate) *EnrichmentBudgetCreateBulk {
+	return &EnrichmentBudgetCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ExportClient) MapCreateBulk(slice any, setFunc func(*ExportCreate, int)) *ExportCreateBulk {
+func (c *EnrichmentBudgetClient) MapCreateBulk(slice any, setFunc func(*EnrichmentBudgetCreate, int)) *EnrichmentBudgetCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ExportCreateBulk{err: fmt.Errorf("calling to ExportClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &EnrichmentBudgetCreateBulk{err: fmt.Errorf("calling to EnrichmentBudgetClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ExportCreate, rv.Len())
+	builders := make([]*EnrichmentBudgetCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ExportCreateBulk{config: c.config, builders: builders}
+	return &EnrichmentBudgetCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Export.
-func (c *ExportClient) Update() *ExportUpdate {
-	mutation := newExportMutation(c.config, OpUpdate)
-	return &ExportUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for EnrichmentBudget.
+func (c *EnrichmentBudgetClient) Update() *EnrichmentBudgetUpdate {
+	mutation := newEnrichmentBudgetMutation(c.config, OpUpdate)
+	return &EnrichmentBudgetUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ExportClient) UpdateOne(_m *Export) *ExportUpdateOne {
-	mutation := newExportMutation(c.config, OpUpdateOne, withExport(_m))
-	return &ExportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EnrichmentBudgetClient) UpdateOne(_m *EnrichmentBudget) *EnrichmentBudgetUpdateOne {
+	mutation := newEnrichmentBudgetMutation(c.config, OpUpdateOne, withEnrichmentBudget(_m))
+	return &EnrichmentBudgetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ExportClient) UpdateOneID(id int) *ExportUpdateOne {
-	mutation := newExportMutation(c.config, OpUpdateOne, withExportID(id))
-	return &ExportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EnrichmentBudgetClient) UpdateOneID(id int) *EnrichmentBudgetUpdateOne {
+	mutation := newEnrichmentBudgetMutation(c.config, OpUpdateOne, withEnrichmentBudgetID(id))
+	return &EnrichmentBudgetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Export.
-func (c *ExportClient) Delete() *ExportDelete {
-	mutation := newExportMutation(c.config, OpDelete)
-	return &ExportDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for EnrichmentBudget.
+func (c *EnrichmentBudgetClient) Delete() *EnrichmentBudgetDelete {
+	mutation := newEnrichmentBudgetMutation(c.config, OpDelete)
+	return &EnrichmentBudgetDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ExportClient) DeleteOne(_m *Export) *ExportDeleteOne {
+func (c *EnrichmentBudgetClient) DeleteOne(_m *EnrichmentBudget) *EnrichmentBudgetDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ExportClient) DeleteOneID(id int) *ExportDeleteOne {
-	builder := c.Delete().Where(export.ID(id))
+func (c *EnrichmentBudgetClient) DeleteOneID(id int) *EnrichmentBudgetDeleteOne {
+	builder := c.Delete().Where(enrichmentbudget.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ExportDeleteOne{builder}
+	return &EnrichmentBudgetDeleteOne{builder}
 }
 
-// Query returns a query builder for Export.
-func (c *ExportClient) Query() *ExportQuery {
-	return &ExportQuery{
+// Query returns a query builder for EnrichmentBudget.
+func (c *EnrichmentBudgetClient) Query() *EnrichmentBudgetQuery {
+	return &EnrichmentBudgetQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeExport},
+		ctx:    &QueryContext{Type: TypeEnrichmentBudget},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Export entity by its id.
-func (c *ExportClient) Get(ctx context.Context, id int) (*Export, error) {
-	return c.Query().Where(export.ID(id)).Only(ctx)
+// Get returns a EnrichmentBudget entity by its id.
+func (c *EnrichmentBudgetClient) Get(ctx context.Context, id int) (*EnrichmentBudget, error) {
+	return c.Query().Where(enrichmentbudget.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ExportClient) GetX(ctx context.Context, id int) *Export {
+func (c *EnrichmentBudgetClient) GetX(ctx context.Context, id int) *EnrichmentBudget {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3571,15 +4220,15 @@ func (c *ExportClient) GetX(ctx context.Context, id int) *Export {
 	return obj
 }
 
-// QueryUser queries the user edge of a Export.
-func (c *ExportClient) QueryUser(_m *Export) *UserQuery {
+// QueryUser queries the user edge of a EnrichmentBudget.
+func (c *EnrichmentBudgetClient) QueryUser(_m *EnrichmentBudget) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(export.Table, export.FieldID, id),
+			sqlgraph.From(enrichmentbudget.Table, enrichmentbudget.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, export.UserTable, export.UserColumn),
+			sqlgraph.Edge(sqlgraph.O2O, true, enrichmentbudget.UserTable, enrichmentbudget.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -3587,15 +4236,15 @@ func (c *ExportClient) QueryUser(_m *Export) *UserQuery {
 	return query
 }
 
-// QueryOrganization queries the organization edge of a Export.
-func (c *ExportClient) QueryOrganization(_m *Export) *OrganizationQuery {
+// QueryOrganization queries the organization edge of a EnrichmentBudget.
+func (c *EnrichmentBudgetClient) QueryOrganization(_m *EnrichmentBudget) *OrganizationQuery {
 	query := (&OrganizationClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(export.Table, export.FieldID, id),
+			sqlgraph.From(enrichmentbudget.Table, enrichmentbudget.FieldID, id),
 			sqlgraph.To(organization.Table, organization.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, export.OrganizationTable, export.OrganizationColumn),
+			sqlgraph.Edge(sqlgraph.O2O, true, enrichmentbudget.OrganizationTable, enrichmentbudget.OrganizationColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -3604,131 +4253,131 @@ func (c *ExportClient) QueryOrganization(_m *Export) *OrganizationQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *ExportClient) Hooks() []Hook {
-	return c.hooks.Export
+func (c *EnrichmentBudgetClient) Hooks() []Hook {
+	return c.hooks.EnrichmentBudget
 }
 
 // Interceptors returns the client interceptors.
-func (c *ExportClient) Interceptors() []Interceptor {
-	return c.inters.Export
+func (c *EnrichmentBudgetClient) Interceptors() []Interceptor {
+	return c.inters.EnrichmentBudget
 }
 
-func (c *ExportClient) mutate(ctx context.Context, m *ExportMutation) (Value, error) {
+func (c *EnrichmentBudgetClient) mutate(ctx context.Context, m *EnrichmentBudgetMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ExportCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EnrichmentBudgetCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ExportUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EnrichmentBudgetUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ExportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EnrichmentBudgetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ExportDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&EnrichmentBudgetDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Export mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown EnrichmentBudget mutation op: %q", m.Op())
 	}
 }
 
-// IndustryClient is a client for the Industry schema.
-type IndustryClient struct {
+// ExperimentClient is a client for the Experiment schema.
+type ExperimentClient struct {
 	config
 }
 
-// NewIndustryClient returns a client for the Industry from the given config.
-func NewIndustryClient(c config) *IndustryClient {
-	return &IndustryClient{config: c}
+// NewExperimentClient returns a client for the Experiment from the given config.
+func NewExperimentClient(c config) *ExperimentClient {
+	return &ExperimentClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `industry.Hooks(f(g(h())))`.
-func (c *IndustryClient) Use(hooks ...Hook) {
-	c.hooks.Industry = append(c.hooks.Industry, hooks...)
+// A call to `Use(f, g, h)` equals to `experiment.Hooks(f(g(h())))`.
+func (c *ExperimentClient) Use(hooks ...Hook) {
+	c.hooks.Experiment = append(c.hooks.Experiment, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `industry.Intercept(f(g(h())))`.
-func (c *IndustryClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Industry = append(c.inters.Industry, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `experiment.Intercept(f(g(h())))`.
+func (c *ExperimentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Experiment = append(c.inters.Experiment, interceptors...)
 }
 
-// Create returns a builder for creating a Industry entity.
-func (c *IndustryClient) Create() *IndustryCreate {
-	mutation := newIndustryMutation(c.config, OpCreate)
-	return &IndustryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Experiment entity.
+func (c *ExperimentClient) Create() *ExperimentCreate {
+	mutation := newExperimentMutation(c.config, OpCreate)
+	return &ExperimentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Industry entities.
-func (c *IndustryClient) CreateBulk(builders ...*IndustryCreate) *IndustryCreateBulk {
-	return &IndustryCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Experiment entities.
+func (c *ExperimentClient) CreateBulk(builders ...*ExperimentCreate) *ExperimentCreateBulk {
+	return &ExperimentCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *IndustryClient) MapCreateBulk(slice any, setFunc func(*IndustryCreate, int)) *IndustryCreateBulk {
+func (c *ExperimentClient) MapCreateBulk(slice any, setFunc func(*ExperimentCreate, int)) *ExperimentCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &IndustryCreateBulk{err: fmt.Errorf("calling to IndustryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ExperimentCreateBulk{err: fmt.Errorf("calling to ExperimentClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*IndustryCreate, rv.Len())
+	builders := make([]*ExperimentCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &IndustryCreateBulk{config: c.config, builders: builders}
+	return &ExperimentCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Industry.
-func (c *IndustryClient) Update() *IndustryUpdate {
-	mutation := newIndustryMutation(c.config, OpUpdate)
-	return &IndustryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Experiment.
+func (c *ExperimentClient) Update() *ExperimentUpdate {
+	mutation := newExperimentMutation(c.config, OpUpdate)
+	return &ExperimentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *IndustryClient) UpdateOne(_m *Industry) *IndustryUpdateOne {
-	mutation := newIndustryMutation(c.config, OpUpdateOne, withIndustry(_m))
-	return &IndustryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ExperimentClient) UpdateOne(_m *Experiment) *ExperimentUpdateOne {
+	mutation := newExperimentMutation(c.config, OpUpdateOne, withExperiment(_m))
+	return &ExperimentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *IndustryClient) UpdateOneID(id string) *IndustryUpdateOne {
-	mutation := newIndustryMutation(c.config, OpUpdateOne, withIndustryID(id))
-	return &IndustryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ExperimentClient) UpdateOneID(id int) *ExperimentUpdateOne {
+	mutation := newExperimentMutation(c.config, OpUpdateOne, withExperimentID(id))
+	return &ExperimentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Industry.
-func (c *IndustryClient) Delete() *IndustryDelete {
-	mutation := newIndustryMutation(c.config, OpDelete)
-	return &IndustryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Experiment.
+func (c *ExperimentClient) Delete() *ExperimentDelete {
+	mutation := newExperimentMutation(c.config, OpDelete)
+	return &ExperimentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *IndustryClient) DeleteOne(_m *Industry) *IndustryDeleteOne {
+func (c *ExperimentClient) DeleteOne(_m *Experiment) *ExperimentDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *IndustryClient) DeleteOneID(id string) *IndustryDeleteOne {
-	builder := c.Delete().Where(industry.ID(id))
+func (c *ExperimentClient) DeleteOneID(id int) *ExperimentDeleteOne {
+	builder := c.Delete().Where(experiment.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &IndustryDeleteOne{builder}
+	return &ExperimentDeleteOne{builder}
 }
 
-// Query returns a query builder for Industry.
-func (c *IndustryClient) Query() *IndustryQuery {
-	return &IndustryQuery{
+// Query returns a query builder for Experiment.
+func (c *ExperimentClient) Query() *ExperimentQuery {
+	return &ExperimentQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeIndustry},
+		ctx:    &QueryContext{Type: TypeExperiment},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Industry entity by its id.
-func (c *IndustryClient) Get(ctx context.Context, id string) (*Industry, error) {
-	return c.Query().Where(industry.ID(id)).Only(ctx)
+// Get returns a Experiment entity by its id.
+func (c *ExperimentClient) Get(ctx context.Context, id int) (*Experiment, error) {
+	return c.Query().Where(experiment.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *IndustryClient) GetX(ctx context.Context, id string) *Industry {
+func (c *ExperimentClient) GetX(ctx context.Context, id int) *Experiment {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3736,132 +4385,2437 @@ func (c *IndustryClient) GetX(ctx context.Context, id string) *Industry {
 	return obj
 }
 
+// QueryAssignments queries the assignments edge of a Experiment.
+func (c *ExperimentClient) QueryAssignments(_m *Experiment) *ExperimentAssignmentQuery {
+	query := (&ExperimentAssignmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(experiment.Table, experiment.FieldID, id),
+			sqlgraph.To(experimentassignment.Table, experimentassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, experiment.AssignmentsTable, experiment.AssignmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *IndustryClient) Hooks() []Hook {
-	return c.hooks.Industry
+func (c *ExperimentClient) Hooks() []Hook {
+	return c.hooks.Experiment
 }
 
 // Interceptors returns the client interceptors.
-func (c *IndustryClient) Interceptors() []Interceptor {
-	return c.inters.Industry
+func (c *ExperimentClient) Interceptors() []Interceptor {
+	return c.inters.Experiment
 }
 
-func (c *IndustryClient) mutate(ctx context.Context, m *IndustryMutation) (Value, error) {
+func (c *ExperimentClient) mutate(ctx context.Context, m *ExperimentMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&IndustryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ExperimentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&IndustryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ExperimentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&IndustryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ExperimentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&IndustryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ExperimentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Industry mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Experiment mutation op: %q", m.Op())
 	}
 }
 
-// LeadClient is a client for the Lead schema.
-type LeadClient struct {
+// ExperimentAssignmentClient is a client for the ExperimentAssignment schema.
+type ExperimentAssignmentClient struct {
 	config
 }
 
-// NewLeadClient returns a client for the Lead from the given config.
-func NewLeadClient(c config) *LeadClient {
-	return &LeadClient{config: c}
+// NewExperimentAssignmentClient returns a client for the ExperimentAssignment from the given config.
+func NewExperimentAssignmentClient(c config) *ExperimentAssignmentClient {
+	return &ExperimentAssignmentClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `lead.Hooks(f(g(h())))`.
-func (c *LeadClient) Use(hooks ...Hook) {
-	c.hooks.Lead = append(c.hooks.Lead, hooks...)
+// A call to `Use(f, g, h)` equals to `experimentassignment.Hooks(f(g(h())))`.
+func (c *ExperimentAssignmentClient) Use(hooks ...Hook) {
+	c.hooks.ExperimentAssignment = append(c.hooks.ExperimentAssignment, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `lead.Intercept(f(g(h())))`.
-func (c *LeadClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Lead = append(c.inters.Lead, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `experimentassignment.Intercept(f(g(h())))`.
+func (c *ExperimentAssignmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ExperimentAssignment = append(c.inters.ExperimentAssignment, interceptors...)
 }
 
-// Create returns a builder for creating a Lead entity.
-func (c *LeadClient) Create() *LeadCreate {
-	mutation := newLeadMutation(c.config, OpCreate)
-	return &LeadCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ExperimentAssignment entity.
+func (c *ExperimentAssignmentClient) Create() *ExperimentAssignmentCreate {
+	mutation := newExperimentAssignmentMutation(c.config, OpCreate)
+	return &ExperimentAssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Lead entities.
-func (c *LeadClient) CreateBulk(builders ...*LeadCreate) *LeadCreateBulk {
-	return &LeadCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ExperimentAssignment entities.
+func (c *ExperimentAssignmentClient) CreateBulk(builders ...*ExperimentAssignmentCreate) *ExperimentAssignmentCreateBulk {
+	return &ExperimentAssignmentCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *LeadClient) MapCreateBulk(slice any, setFunc func(*LeadCreate, int)) *LeadCreateBulk {
+func (c *ExperimentAssignmentClient) MapCreateBulk(slice any, setFunc func(*ExperimentAssignmentCreate, int)) *ExperimentAssignmentCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &LeadCreateBulk{err: fmt.Errorf("calling to LeadClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ExperimentAssignmentCreateBulk{err: fmt.Errorf("calling to ExperimentAssignmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*LeadCreate, rv.Len())
+	builders := make([]*ExperimentAssignmentCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &LeadCreateBulk{config: c.config, builders: builders}
+	return &ExperimentAssignmentCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Lead.
-func (c *LeadClient) Update() *LeadUpdate {
-	mutation := newLeadMutation(c.config, OpUpdate)
-	return &LeadUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ExperimentAssignment.
+func (c *ExperimentAssignmentClient) Update() *ExperimentAssignmentUpdate {
+	mutation := newExperimentAssignmentMutation(c.config, OpUpdate)
+	return &ExperimentAssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *LeadClient) UpdateOne(_m *Lead) *LeadUpdateOne {
-	mutation := newLeadMutation(c.config, OpUpdateOne, withLead(_m))
-	return &LeadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ExperimentAssignmentClient) UpdateOne(_m *ExperimentAssignment) *ExperimentAssignmentUpdateOne {
+	mutation := newExperimentAssignmentMutation(c.config, OpUpdateOne, withExperimentAssignment(_m))
+	return &ExperimentAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *LeadClient) UpdateOneID(id int) *LeadUpdateOne {
+func (c *ExperimentAssignmentClient) UpdateOneID(id int) *ExperimentAssignmentUpdateOne {
+	mutation := newExperimentAssignmentMutation(c.config, OpUpdateOne, withExperimentAssignmentID(id))
+	return &ExperimentAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ExperimentAssignment.
+func (c *ExperimentAssignmentClient) Delete() *ExperimentAssignmentDelete {
+	mutation := newExperimentAssignmentMutation(c.config, OpDelete)
+	return &ExperimentAssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ExperimentAssignmentClient) DeleteOne(_m *ExperimentAssignment) *ExperimentAssignmentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ExperimentAssignmentClient) DeleteOneID(id int) *ExperimentAssignmentDeleteOne {
+	builder := c.Delete().Where(experimentassignment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ExperimentAssignmentDeleteOne{builder}
+}
+
+// Query returns a query builder for ExperimentAssignment.
+func (c *ExperimentAssignmentClient) Query() *ExperimentAssignmentQuery {
+	return &ExperimentAssignmentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeExperimentAssignment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ExperimentAssignment entity by its id.
+func (c *ExperimentAssignmentClient) Get(ctx context.Context, id int) (*ExperimentAssignment, error) {
+	return c.Query().Where(experimentassignment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ExperimentAssignmentClient) GetX(ctx context.Context, id int) *ExperimentAssignment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryExperiment queries the experiment edge of a ExperimentAssignment.
+func (c *ExperimentAssignmentClient) QueryExperiment(_m *ExperimentAssignment) *ExperimentQuery {
+	query := (&ExperimentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(experimentassignment.Table, experimentassignment.FieldID, id),
+			sqlgraph.To(experiment.Table, experiment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, experimentassignment.ExperimentTable, experimentassignment.ExperimentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a ExperimentAssignment.
+func (c *ExperimentAssignmentClient) QueryUser(_m *ExperimentAssignment) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(experimentassignment.Table, experimentassignment.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, experimentassignment.UserTable, experimentassignment.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ExperimentAssignmentClient) Hooks() []Hook {
+	return c.hooks.ExperimentAssignment
+}
+
+// Interceptors returns the client interceptors.
+func (c *ExperimentAssignmentClient) Interceptors() []Interceptor {
+	return c.inters.ExperimentAssignment
+}
+
+func (c *ExperimentAssignmentClient) mutate(ctx context.Context, m *ExperimentAssignmentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ExperimentAssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ExperimentAssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ExperimentAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ExperimentAssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ExperimentAssignment mutation op: %q", m.Op())
+	}
+}
+
+// ExportClient is a client for the Export schema.
+type ExportClient struct {
+	config
+}
+
+// NewExportClient returns a client for the Export from the given config.
+func NewExportClient(c config) *ExportClient {
+	return &ExportClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `export.Hooks(f(g(h())))`.
+func (c *ExportClient) Use(hooks ...Hook) {
+	c.hooks.Export = append(c.hooks.Export, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `export.Intercept(f(g(h())))`.
+func (c *ExportClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Export = append(c.inters.Export, interceptors...)
+}
+
+// Create returns a builder for creating a Export entity.
+func (c *ExportClient) Create() *ExportCreate {
+	mutation := newExportMutation(c.config, OpCreate)
+	return &ExportCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Export entities.
+func (c *ExportClient) CreateBulk(builders ...*ExportCreate) *ExportCreateBulk {
+	return &ExportCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ExportClient) MapCreateBulk(slice any, setFunc func(*ExportCreate, int)) *ExportCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ExportCreateBulk{err: fmt.Errorf("calling to ExportClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ExportCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ExportCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Export.
+func (c *ExportClient) Update() *ExportUpdate {
+	mutation := newExportMutation(c.config, OpUpdate)
+	return &ExportUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ExportClient) UpdateOne(_m *Export) *ExportUpdateOne {
+	mutation := newExportMutation(c.config, OpUpdateOne, withExport(_m))
+	return &ExportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ExportClient) UpdateOneID(id int) *ExportUpdateOne {
+	mutation := newExportMutation(c.config, OpUpdateOne, withExportID(id))
+	return &ExportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Export.
+func (c *ExportClient) Delete() *ExportDelete {
+	mutation := newExportMutation(c.config, OpDelete)
+	return &ExportDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ExportClient) DeleteOne(_m *Export) *ExportDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ExportClient) DeleteOneID(id int) *ExportDeleteOne {
+	builder := c.Delete().Where(export.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ExportDeleteOne{builder}
+}
+
+// Query returns a query builder for Export.
+func (c *ExportClient) Query() *ExportQuery {
+	return &ExportQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeExport},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Export entity by its id.
+func (c *ExportClient) Get(ctx context.Context, id int) (*Export, error) {
+	return c.Query().Where(export.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ExportClient) GetX(ctx context.Context, id int) *Export {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Export.
+func (c *ExportClient) QueryUser(_m *Export) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(export.Table, export.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, export.UserTable, export.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrganization queries the organization edge of a Export.
+func (c *ExportClient) QueryOrganization(_m *Export) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(export.Table, export.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, export.OrganizationTable, export.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ExportClient) Hooks() []Hook {
+	return c.hooks.Export
+}
+
+// Interceptors returns the client interceptors.
+func (c *ExportClient) Interceptors() []Interceptor {
+	return c.inters.Export
+}
+
+func (c *ExportClient) mutate(ctx context.Context, m *ExportMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ExportCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ExportUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ExportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ExportDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Export mutation op: %q", m.Op())
+	}
+}
+
+// ExportTemplateClient is a client for the ExportTemplate schema.
+type ExportTemplateClient struct {
+	config
+}
+
+// NewExportTemplateClient returns a client for the ExportTemplate from the given config.
+func NewExportTemplateClient(c config) *ExportTemplateClient {
+	return &ExportTemplateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `exporttemplate.Hooks(f(g(h())))`.
+func (c *ExportTemplateClient) Use(hooks ...Hook) {
+	c.hooks.ExportTemplate = append(c.hooks.ExportTemplate, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `exporttemplate.Intercept(f(g(h())))`.
+func (c *ExportTemplateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ExportTemplate = append(c.inters.ExportTemplate, interceptors...)
+}
+
+// Create returns a builder for creating a ExportTemplate entity.
+func (c *ExportTemplateClient) Create() *ExportTemplateCreate {
+	mutation := newExportTemplateMutation(c.config, OpCreate)
+	return &ExportTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ExportTemplate entities.
+func (c *ExportTemplateClient) CreateBulk(builders ...*ExportTemplateCreate) *ExportTemplateCreateBulk {
+	return &ExportTemplateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ExportTemplateClient) MapCreateBulk(slice any, setFunc func(*ExportTemplateCreate, int)) *ExportTemplateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ExportTemplateCreateBulk{err: fmt.Errorf("calling to ExportTemplateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ExportTemplateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ExportTemplateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ExportTemplate.
+func (c *ExportTemplateClient) Update() *ExportTemplateUpdate {
+	mutation := newExportTemplateMutation(c.config, OpUpdate)
+	return &ExportTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ExportTemplateClient) UpdateOne(_m *ExportTemplate) *ExportTemplateUpdateOne {
+	mutation := newExportTemplateMutation(c.config, OpUpdateOne, withExportTemplate(_m))
+	return &ExportTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ExportTemplateClient) UpdateOneID(id int) *ExportTemplateUpdateOne {
+	mutation := newExportTemplateMutation(c.config, OpUpdateOne, withExportTemplateID(id))
+	return &ExportTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ExportTemplate.
+func (c *ExportTemplateClient) Delete() *ExportTemplateDelete {
+	mutation := newExportTemplateMutation(c.config, OpDelete)
+	return &ExportTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ExportTemplateClient) DeleteOne(_m *ExportTemplate) *ExportTemplateDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ExportTemplateClient) DeleteOneID(id int) *ExportTemplateDeleteOne {
+	builder := c.Delete().Where(exporttemplate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ExportTemplateDeleteOne{builder}
+}
+
+// Query returns a query builder for ExportTemplate.
+func (c *ExportTemplateClient) Query() *ExportTemplateQuery {
+	return &ExportTemplateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeExportTemplate},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ExportTemplate entity by its id.
+func (c *ExportTemplateClient) Get(ctx context.Context, id int) (*ExportTemplate, error) {
+	return c.Query().Where(exporttemplate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ExportTemplateClient) GetX(ctx context.Context, id int) *ExportTemplate {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a ExportTemplate.
+func (c *ExportTemplateClient) QueryUser(_m *ExportTemplate) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(exporttemplate.Table, exporttemplate.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, exporttemplate.UserTable, exporttemplate.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrganization queries the organization edge of a ExportTemplate.
+func (c *ExportTemplateClient) QueryOrganization(_m *ExportTemplate) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(exporttemplate.Table, exporttemplate.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, exporttemplate.OrganizationTable, exporttemplate.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySavedSearch queries the saved_search edge of a ExportTemplate.
+func (c *ExportTemplateClient) QuerySavedSearch(_m *ExportTemplate) *SavedSearchQuery {
+	query := (&SavedSearchClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(exporttemplate.Table, exporttemplate.FieldID, id),
+			sqlgraph.To(savedsearch.Table, savedsearch.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, exporttemplate.SavedSearchTable, exporttemplate.SavedSearchColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ExportTemplateClient) Hooks() []Hook {
+	return c.hooks.ExportTemplate
+}
+
+// Interceptors returns the client interceptors.
+func (c *ExportTemplateClient) Interceptors() []Interceptor {
+	return c.inters.ExportTemplate
+}
+
+func (c *ExportTemplateClient) mutate(ctx context.Context, m *ExportTemplateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ExportTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ExportTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ExportTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ExportTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ExportTemplate mutation op: %q", m.Op())
+	}
+}
+
+// ImportJobClient is a client for the ImportJob schema.
+type ImportJobClient struct {
+	config
+}
+
+// NewImportJobClient returns a client for the ImportJob from the given config.
+func NewImportJobClient(c config) *ImportJobClient {
+	return &ImportJobClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `importjob.Hooks(f(g(h())))`.
+func (c *ImportJobClient) Use(hooks ...Hook) {
+	c.hooks.ImportJob = append(c.hooks.ImportJob, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `importjob.Intercept(f(g(h())))`.
+func (c *ImportJobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ImportJob = append(c.inters.ImportJob, interceptors...)
+}
+
+// Create returns a builder for creating a ImportJob entity.
+func (c *ImportJobClient) Create() *ImportJobCreate {
+	mutation := newImportJobMutation(c.config, OpCreate)
+	return &ImportJobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ImportJob entities.
+func (c *ImportJobClient) CreateBulk(builders ...*ImportJobCreate) *ImportJobCreateBulk {
+	return &ImportJobCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ImportJobClient) MapCreateBulk(slice any, setFunc func(*ImportJobCreate, int)) *ImportJobCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ImportJobCreateBulk{err: fmt.Errorf("calling to ImportJobClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ImportJobCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ImportJobCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ImportJob.
+func (c *ImportJobClient) Update() *ImportJobUpdate {
+	mutation := newImportJobMutation(c.config, OpUpdate)
+	return &ImportJobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ImportJobClient) UpdateOne(_m *ImportJob) *ImportJobUpdateOne {
+	mutation := newImportJobMutation(c.config, OpUpdateOne, withImportJob(_m))
+	return &ImportJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ImportJobClient) UpdateOneID(id int) *ImportJobUpdateOne {
+	mutation := newImportJobMutation(c.config, OpUpdateOne, withImportJobID(id))
+	return &ImportJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ImportJob.
+func (c *ImportJobClient) Delete() *ImportJobDelete {
+	mutation := newImportJobMutation(c.config, OpDelete)
+	return &ImportJobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ImportJobClient) DeleteOne(_m *ImportJob) *ImportJobDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ImportJobClient) DeleteOneID(id int) *ImportJobDeleteOne {
+	builder := c.Delete().Where(importjob.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ImportJobDeleteOne{builder}
+}
+
+// Query returns a query builder for ImportJob.
+func (c *ImportJobClient) Query() *ImportJobQuery {
+	return &ImportJobQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeImportJob},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ImportJob entity by its id.
+func (c *ImportJobClient) Get(ctx context.Context, id int) (*ImportJob, error) {
+	return c.Query().Where(importjob.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ImportJobClient) GetX(ctx context.Context, id int) *ImportJob {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a ImportJob.
+func (c *ImportJobClient) QueryUser(_m *ImportJob) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(importjob.Table, importjob.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, importjob.UserTable, importjob.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ImportJobClient) Hooks() []Hook {
+	return c.hooks.ImportJob
+}
+
+// Interceptors returns the client interceptors.
+func (c *ImportJobClient) Interceptors() []Interceptor {
+	return c.inters.ImportJob
+}
+
+func (c *ImportJobClient) mutate(ctx context.Context, m *ImportJobMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ImportJobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ImportJobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ImportJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ImportJobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ImportJob mutation op: %q", m.Op())
+	}
+}
+
+// IndustryClient is a client for the Industry schema.
+type IndustryClient struct {
+	config
+}
+
+// NewIndustryClient returns a client for the Industry from the given config.
+func NewIndustryClient(c config) *IndustryClient {
+	return &IndustryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `industry.Hooks(f(g(h())))`.
+func (c *IndustryClient) Use(hooks ...Hook) {
+	c.hooks.Industry = append(c.hooks.Industry, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `industry.Intercept(f(g(h())))`.
+func (c *IndustryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Industry = append(c.inters.Industry, interceptors...)
+}
+
+// Create returns a builder for creating a Industry entity.
+func (c *IndustryClient) Create() *IndustryCreate {
+	mutation := newIndustryMutation(c.config, OpCreate)
+	return &IndustryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Industry entities.
+func (c *IndustryClient) CreateBulk(builders ...*IndustryCreate) *IndustryCreateBulk {
+	return &IndustryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *IndustryClient) MapCreateBulk(slice any, setFunc func(*IndustryCreate, int)) *IndustryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &IndustryCreateBulk{err: fmt.Errorf("calling to IndustryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*IndustryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &IndustryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Industry.
+func (c *IndustryClient) Update() *IndustryUpdate {
+	mutation := newIndustryMutation(c.config, OpUpdate)
+	return &IndustryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IndustryClient) UpdateOne(_m *Industry) *IndustryUpdateOne {
+	mutation := newIndustryMutation(c.config, OpUpdateOne, withIndustry(_m))
+	return &IndustryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IndustryClient) UpdateOneID(id string) *IndustryUpdateOne {
+	mutation := newIndustryMutation(c.config, OpUpdateOne, withIndustryID(id))
+	return &IndustryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Industry.
+func (c *IndustryClient) Delete() *IndustryDelete {
+	mutation := newIndustryMutation(c.config, OpDelete)
+	return &IndustryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IndustryClient) DeleteOne(_m *Industry) *IndustryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *IndustryClient) DeleteOneID(id string) *IndustryDeleteOne {
+	builder := c.Delete().Where(industry.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IndustryDeleteOne{builder}
+}
+
+// Query returns a query builder for Industry.
+func (c *IndustryClient) Query() *IndustryQuery {
+	return &IndustryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeIndustry},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Industry entity by its id.
+func (c *IndustryClient) Get(ctx context.Context, id string) (*Industry, error) {
+	return c.Query().Where(industry.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IndustryClient) GetX(ctx context.Context, id string) *Industry {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *IndustryClient) Hooks() []Hook {
+	return c.hooks.Industry
+}
+
+// Interceptors returns the client interceptors.
+func (c *IndustryClient) Interceptors() []Interceptor {
+	return c.inters.Industry
+}
+
+func (c *IndustryClient) mutate(ctx context.Context, m *IndustryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IndustryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IndustryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IndustryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IndustryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Industry mutation op: %q", m.Op())
+	}
+}
+
+// IntegrationConnectionClient is a client for the IntegrationConnection schema.
+type IntegrationConnectionClient struct {
+	config
+}
+
+// NewIntegrationConnectionClient returns a client for the IntegrationConnection from the given config.
+func NewIntegrationConnectionClient(c config) *IntegrationConnectionClient {
+	return &IntegrationConnectionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `integrationconnection.Hooks(f(g(h())))`.
+func (c *IntegrationConnectionClient) Use(hooks ...Hook) {
+	c.hooks.IntegrationConnection = append(c.hooks.IntegrationConnection, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `integrationconnection.Intercept(f(g(h())))`.
+func (c *IntegrationConnectionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IntegrationConnection = append(c.inters.IntegrationConnection, interceptors...)
+}
+
+// Create returns a builder for creating a IntegrationConnection entity.
+func (c *IntegrationConnectionClient) Create() *IntegrationConnectionCreate {
+	mutation := newIntegrationConnectionMutation(c.config, OpCreate)
+	return &IntegrationConnectionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of IntegrationConnection entities.
+func (c *IntegrationConnectionClient) CreateBulk(builders ...*IntegrationConnectionCreate) *IntegrationConnectionCreateBulk {
+	return &IntegrationConnectionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *IntegrationConnectionClient) MapCreateBulk(slice any, setFunc func(*IntegrationConnectionCreate, int)) *IntegrationConnectionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &IntegrationConnectionCreateBulk{err: fmt.Errorf("calling to IntegrationConnectionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*IntegrationConnectionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &IntegrationConnectionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for IntegrationConnection.
+func (c *IntegrationConnectionClient) Update() *IntegrationConnectionUpdate {
+	mutation := newIntegrationConnectionMutation(c.config, OpUpdate)
+	return &IntegrationConnectionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IntegrationConnectionClient) UpdateOne(_m *IntegrationConnection) *IntegrationConnectionUpdateOne {
+	mutation := newIntegrationConnectionMutation(c.config, OpUpdateOne, withIntegrationConnection(_m))
+	return &IntegrationConnectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IntegrationConnectionClient) UpdateOneID(id int) *IntegrationConnectionUpdateOne {
+	mutation := newIntegrationConnectionMutation(c.config, OpUpdateOne, withIntegrationConnectionID(id))
+	return &IntegrationConnectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for IntegrationConnection.
+func (c *IntegrationConnectionClient) Delete() *IntegrationConnectionDelete {
+	mutation := newIntegrationConnectionMutation(c.config, OpDelete)
+	return &IntegrationConnectionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IntegrationConnectionClient) DeleteOne(_m *IntegrationConnection) *IntegrationConnectionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *IntegrationConnectionClient) DeleteOneID(id int) *IntegrationConnectionDeleteOne {
+	builder := c.Delete().Where(integrationconnection.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IntegrationConnectionDeleteOne{builder}
+}
+
+// Query returns a query builder for IntegrationConnection.
+func (c *IntegrationConnectionClient) Query() *IntegrationConnectionQuery {
+	return &IntegrationConnectionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeIntegrationConnection},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a IntegrationConnection entity by its id.
+func (c *IntegrationConnectionClient) Get(ctx context.Context, id int) (*IntegrationConnection, error) {
+	return c.Query().Where(integrationconnection.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IntegrationConnectionClient) GetX(ctx context.Context, id int) *IntegrationConnection {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a IntegrationConnection.
+func (c *IntegrationConnectionClient) QueryUser(_m *IntegrationConnection) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(integrationconnection.Table, integrationconnection.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, integrationconnection.UserTable, integrationconnection.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *IntegrationConnectionClient) Hooks() []Hook {
+	return c.hooks.IntegrationConnection
+}
+
+// Interceptors returns the client interceptors.
+func (c *IntegrationConnectionClient) Interceptors() []Interceptor {
+	return c.inters.IntegrationConnection
+}
+
+func (c *IntegrationConnectionClient) mutate(ctx context.Context, m *IntegrationConnectionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IntegrationConnectionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IntegrationConnectionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IntegrationConnectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IntegrationConnectionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown IntegrationConnection mutation op: %q", m.Op())
+	}
+}
+
+// LeadClient is a client for the Lead schema.
+type LeadClient struct {
+	config
+}
+
+// NewLeadClient returns a client for the Lead from the given config.
+func NewLeadClient(c config) *LeadClient {
+	return &LeadClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `lead.Hooks(f(g(h())))`.
+func (c *LeadClient) Use(hooks ...Hook) {
+	c.hooks.Lead = append(c.hooks.Lead, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `lead.Intercept(f(g(h())))`.
+func (c *LeadClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Lead = append(c.inters.Lead, interceptors...)
+}
+
+// Create returns a builder for creating a Lead entity.
+func (c *LeadClient) Create() *LeadCreate {
+	mutation := newLeadMutation(c.config, OpCreate)
+	return &LeadCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Lead entities.
+func (c *LeadClient) CreateBulk(builders ...*LeadCreate) *LeadCreateBulk {
+	return &LeadCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LeadClient) MapCreateBulk(slice any, setFunc func(*LeadCreate, int)) *LeadCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LeadCreateBulk{err: fmt.Errorf("calling to LeadClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LeadCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LeadCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Lead.
+func (c *LeadClient) Update() *LeadUpdate {
+	mutation := newLeadMutation(c.config, OpUpdate)
+	return &LeadUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LeadClient) UpdateOne(_m *Lead) *LeadUpdateOne {
+	mutation := newLeadMutation(c.config, OpUpdateOne, withLead(_m))
+	return &LeadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LeadClient) UpdateOneID(id int) *LeadUpdateOne {
 	mutation := newLeadMutation(c.config, OpUpdateOne, withLeadID(id))
 	return &LeadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Lead.
-func (c *LeadClient) Delete() *LeadDelete {
-	mutation := newLeadMutation(c.config, OpDelete)
-	return &LeadDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Lead.
+func (c *LeadClient) Delete() *LeadDelete {
+	mutation := newLeadMutation(c.config, OpDelete)
+	return &LeadDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LeadClient) DeleteOne(_m *Lead) *LeadDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LeadClient) DeleteOneID(id int) *LeadDeleteOne {
+	builder := c.Delete().Where(lead.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LeadDeleteOne{builder}
+}
+
+// Query returns a query builder for Lead.
+func (c *LeadClient) Query() *LeadQuery {
+	return &LeadQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLead},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Lead entity by its id.
+func (c *LeadClient) Get(ctx context.Context, id int) (*Lead, error) {
+	return c.Query().Where(lead.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LeadClient) GetX(ctx context.Context, id int) *Lead {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryNotes queries the notes edge of a Lead.
+func (c *LeadClient) QueryNotes(_m *Lead) *LeadNoteQuery {
+	query := (&LeadNoteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lead.Table, lead.FieldID, id),
+			sqlgraph.To(leadnote.Table, leadnote.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, lead.NotesTable, lead.NotesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryContactAttempts queries the contact_attempts edge of a Lead.
+func (c *LeadClient) QueryContactAttempts(_m *Lead) *ContactAttemptQuery {
+	query := (&ContactAttemptClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lead.Table, lead.FieldID, id),
+			sqlgraph.To(contactattempt.Table, contactattempt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, lead.ContactAttemptsTable, lead.ContactAttemptsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStatusHistory queries the status_history edge of a Lead.
+func (c *LeadClient) QueryStatusHistory(_m *Lead) *LeadStatusHistoryQuery {
+	query := (&LeadStatusHistoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lead.Table, lead.FieldID, id),
+			sqlgraph.To(leadstatushistory.Table, leadstatushistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, lead.StatusHistoryTable, lead.StatusHistoryColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChanges queries the changes edge of a Lead.
+func (c *LeadClient) QueryChanges(_m *Lead) *LeadChangeQuery {
+	query := (&LeadChangeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lead.Table, lead.FieldID, id),
+			sqlgraph.To(leadchange.Table, leadchange.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, lead.ChangesTable, lead.ChangesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEnrichmentAttempts queries the enrichment_attempts edge of a Lead.
+func (c *LeadClient) QueryEnrichmentAttempts(_m *Lead) *EnrichmentAttemptQuery {
+	query := (&EnrichmentAttemptClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lead.Table, lead.FieldID, id),
+			sqlgraph.To(enrichmentattempt.Table, enrichmentattempt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, lead.EnrichmentAttemptsTable, lead.EnrichmentAttemptsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAssignments queries the assignments edge of a Lead.
+func (c *LeadClient) QueryAssignments(_m *Lead) *LeadAssignmentQuery {
+	query := (&LeadAssignmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lead.Table, lead.FieldID, id),
+			sqlgraph.To(leadassignment.Table, leadassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, lead.AssignmentsTable, lead.AssignmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEmailSequenceEnrollments queries the email_sequence_enrollments edge of a Lead.
+func (c *LeadClient) QueryEmailSequenceEnrollments(_m *Lead) *EmailSequenceEnrollmentQuery {
+	query := (&EmailSequenceEnrollmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lead.Table, lead.FieldID, id),
+			sqlgraph.To(emailsequenceenrollment.Table, emailsequenceenrollment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, lead.EmailSequenceEnrollmentsTable, lead.EmailSequenceEnrollmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEmailSequenceSends queries the email_sequence_sends edge of a Lead.
+func (c *LeadClient) QueryEmailSequenceSends(_m *Lead) *EmailSequenceSendQuery {
+	query := (&EmailSequenceSendClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lead.Table, lead.FieldID, id),
+			sqlgraph.To(emailsequencesend.Table, emailsequencesend.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, lead.EmailSequenceSendsTable, lead.EmailSequenceSendsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTerritory queries the territory edge of a Lead.
+func (c *LeadClient) QueryTerritory(_m *Lead) *TerritoryQuery {
+	query := (&TerritoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lead.Table, lead.FieldID, id),
+			sqlgraph.To(territory.Table, territory.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, lead.TerritoryTable, lead.TerritoryColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySmsMessages queries the sms_messages edge of a Lead.
+func (c *LeadClient) QuerySmsMessages(_m *Lead) *SMSMessageQuery {
+	query := (&SMSMessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lead.Table, lead.FieldID, id),
+			sqlgraph.To(smsmessage.Table, smsmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, lead.SmsMessagesTable, lead.SmsMessagesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCallLogs queries the call_logs edge of a Lead.
+func (c *LeadClient) QueryCallLogs(_m *Lead) *CallLogQuery {
+	query := (&CallLogClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lead.Table, lead.FieldID, id),
+			sqlgraph.To(calllog.Table, calllog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, lead.CallLogsTable, lead.CallLogsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRecommendations queries the recommendations edge of a Lead.
+func (c *LeadClient) QueryRecommendations(_m *Lead) *LeadRecommendationQuery {
+	query := (&LeadRecommendationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lead.Table, lead.FieldID, id),
+			sqlgraph.To(leadrecommendation.Table, leadrecommendation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, lead.RecommendationsTable, lead.RecommendationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryVerifications queries the verifications edge of a Lead.
+func (c *LeadClient) QueryVerifications(_m *Lead) *LeadVerificationQuery {
+	query := (&LeadVerificationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lead.Table, lead.FieldID, id),
+			sqlgraph.To(leadverification.Table, leadverification.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, lead.VerificationsTable, lead.VerificationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySuppressions queries the suppressions edge of a Lead.
+func (c *LeadClient) QuerySuppressions(_m *Lead) *LeadSuppressionQuery {
+	query := (&LeadSuppressionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lead.Table, lead.FieldID, id),
+			sqlgraph.To(leadsuppression.Table, leadsuppression.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, lead.SuppressionsTable, lead.SuppressionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOwnerOrganization queries the owner_organization edge of a Lead.
+func (c *LeadClient) QueryOwnerOrganization(_m *Lead) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lead.Table, lead.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, lead.OwnerOrganizationTable, lead.OwnerOrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LeadClient) Hooks() []Hook {
+	return c.hooks.Lead
+}
+
+// Interceptors returns the client interceptors.
+func (c *LeadClient) Interceptors() []Interceptor {
+	return c.inters.Lead
+}
+
+func (c *LeadClient) mutate(ctx context.Context, m *LeadMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LeadCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LeadUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LeadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LeadDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Lead mutation op: %q", m.Op())
+	}
+}
+
+// LeadAssignmentClient is a client for the LeadAssignment schema.
+type LeadAssignmentClient struct {
+	config
+}
+
+// NewLeadAssignmentClient returns a client for the LeadAssignment from the given config.
+func NewLeadAssignmentClient(c config) *LeadAssignmentClient {
+	return &LeadAssignmentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `leadassignment.Hooks(f(g(h())))`.
+func (c *LeadAssignmentClient) Use(hooks ...Hook) {
+	c.hooks.LeadAssignment = append(c.hooks.LeadAssignment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `leadassignment.Intercept(f(g(h())))`.
+func (c *LeadAssignmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LeadAssignment = append(c.inters.LeadAssignment, interceptors...)
+}
+
+// Create returns a builder for creating a LeadAssignment entity.
+func (c *LeadAssignmentClient) Create() *LeadAssignmentCreate {
+	mutation := newLeadAssignmentMutation(c.config, OpCreate)
+	return &LeadAssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LeadAssignment entities.
+func (c *LeadAssignmentClient) CreateBulk(builders ...*LeadAssignmentCreate) *LeadAssignmentCreateBulk {
+	return &LeadAssignmentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LeadAssignmentClient) MapCreateBulk(slice any, setFunc func(*LeadAssignmentCreate, int)) *LeadAssignmentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LeadAssignmentCreateBulk{err: fmt.Errorf("calling to LeadAssignmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LeadAssignmentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LeadAssignmentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LeadAssignment.
+func (c *LeadAssignmentClient) Update() *LeadAssignmentUpdate {
+	mutation := newLeadAssignmentMutation(c.config, OpUpdate)
+	return &LeadAssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LeadAssignmentClient) UpdateOne(_m *LeadAssignment) *LeadAssignmentUpdateOne {
+	mutation := newLeadAssignmentMutation(c.config, OpUpdateOne, withLeadAssignment(_m))
+	return &LeadAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LeadAssignmentClient) UpdateOneID(id int) *LeadAssignmentUpdateOne {
+	mutation := newLeadAssignmentMutation(c.config, OpUpdateOne, withLeadAssignmentID(id))
+	return &LeadAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LeadAssignment.
+func (c *LeadAssignmentClient) Delete() *LeadAssignmentDelete {
+	mutation := newLeadAssignmentMutation(c.config, OpDelete)
+	return &LeadAssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LeadAssignmentClient) DeleteOne(_m *LeadAssignment) *LeadAssignmentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LeadAssignmentClient) DeleteOneID(id int) *LeadAssignmentDeleteOne {
+	builder := c.Delete().Where(leadassignment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LeadAssignmentDeleteOne{builder}
+}
+
+// Query returns a query builder for LeadAssignment.
+func (c *LeadAssignmentClient) Query() *LeadAssignmentQuery {
+	return &LeadAssignmentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLeadAssignment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LeadAssignment entity by its id.
+func (c *LeadAssignmentClient) Get(ctx context.Context, id int) (*LeadAssignment, error) {
+	return c.Query().Where(leadassignment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LeadAssignmentClient) GetX(ctx context.Context, id int) *LeadAssignment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLead queries the lead edge of a LeadAssignment.
+func (c *LeadAssignmentClient) QueryLead(_m *LeadAssignment) *LeadQuery {
+	query := (&LeadClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(leadassignment.Table, leadassignment.FieldID, id),
+			sqlgraph.To(lead.Table, lead.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, leadassignment.LeadTable, leadassignment.LeadColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a LeadAssignment.
+func (c *LeadAssignmentClient) QueryUser(_m *LeadAssignment) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(leadassignment.Table, leadassignment.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, leadassignment.UserTable, leadassignment.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAssignedBy queries the assigned_by edge of a LeadAssignment.
+func (c *LeadAssignmentClient) QueryAssignedBy(_m *LeadAssignment) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(leadassignment.Table, leadassignment.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, leadassignment.AssignedByTable, leadassignment.AssignedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LeadAssignmentClient) Hooks() []Hook {
+	return c.hooks.LeadAssignment
+}
+
+// Interceptors returns the client interceptors.
+func (c *LeadAssignmentClient) Interceptors() []Interceptor {
+	return c.inters.LeadAssignment
+}
+
+func (c *LeadAssignmentClient) mutate(ctx context.Context, m *LeadAssignmentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LeadAssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LeadAssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LeadAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LeadAssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LeadAssignment mutation op: %q", m.Op())
+	}
+}
+
+// LeadChangeClient is a client for the LeadChange schema.
+type LeadChangeClient struct {
+	config
+}
+
+// NewLeadChangeClient returns a client for the LeadChange from the given config.
+func NewLeadChangeClient(c config) *LeadChangeClient {
+	return &LeadChangeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `leadchange.Hooks(f(g(h())))`.
+func (c *LeadChangeClient) Use(hooks ...Hook) {
+	c.hooks.LeadChange = append(c.hooks.LeadChange, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `leadchange.Intercept(f(g(h())))`.
+func (c *LeadChangeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LeadChange = append(c.inters.LeadChange, interceptors...)
+}
+
+// Create returns a builder for creating a LeadChange entity.
+func (c *LeadChangeClient) Create() *LeadChangeCreate {
+	mutation := newLeadChangeMutation(c.config, OpCreate)
+	return &LeadChangeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LeadChange entities.
+func (c *LeadChangeClient) CreateBulk(builders ...*LeadChangeCreate) *LeadChangeCreateBulk {
+	return &LeadChangeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LeadChangeClient) MapCreateBulk(slice any, setFunc func(*LeadChangeCreate, int)) *LeadChangeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LeadChangeCreateBulk{err: fmt.Errorf("calling to LeadChangeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LeadChangeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LeadChangeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LeadChange.
+func (c *LeadChangeClient) Update() *LeadChangeUpdate {
+	mutation := newLeadChangeMutation(c.config, OpUpdate)
+	return &LeadChangeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LeadChangeClient) UpdateOne(_m *LeadChange) *LeadChangeUpdateOne {
+	mutation := newLeadChangeMutation(c.config, OpUpdateOne, withLeadChange(_m))
+	return &LeadChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LeadChangeClient) UpdateOneID(id int) *LeadChangeUpdateOne {
+	mutation := newLeadChangeMutation(c.config, OpUpdateOne, withLeadChangeID(id))
+	return &LeadChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LeadChange.
+func (c *LeadChangeClient) Delete() *LeadChangeDelete {
+	mutation := newLeadChangeMutation(c.config, OpDelete)
+	return &LeadChangeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LeadChangeClient) DeleteOne(_m *LeadChange) *LeadChangeDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LeadChangeClient) DeleteOneID(id int) *LeadChangeDeleteOne {
+	builder := c.Delete().Where(leadchange.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LeadChangeDeleteOne{builder}
+}
+
+// Query returns a query builder for LeadChange.
+func (c *LeadChangeClient) Query() *LeadChangeQuery {
+	return &LeadChangeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLeadChange},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LeadChange entity by its id.
+func (c *LeadChangeClient) Get(ctx context.Context, id int) (*LeadChange, error) {
+	return c.Query().Where(leadchange.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LeadChangeClient) GetX(ctx context.Context, id int) *LeadChange {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLead queries the lead edge of a LeadChange.
+func (c *LeadChangeClient) QueryLead(_m *LeadChange) *LeadQuery {
+	query := (&LeadClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(leadchange.Table, leadchange.FieldID, id),
+			sqlgraph.To(lead.Table, lead.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, leadchange.LeadTable, leadchange.LeadColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a LeadChange.
+func (c *LeadChangeClient) QueryUser(_m *LeadChange) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(leadchange.Table, leadchange.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, leadchange.UserTable, leadchange.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LeadChangeClient) Hooks() []Hook {
+	return c.hooks.LeadChange
+}
+
+// Interceptors returns the client interceptors.
+func (c *LeadChangeClient) Interceptors() []Interceptor {
+	return c.inters.LeadChange
+}
+
+func (c *LeadChangeClient) mutate(ctx context.Context, m *LeadChangeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LeadChangeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LeadChangeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LeadChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LeadChangeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LeadChange mutation op: %q", m.Op())
+	}
+}
+
+// LeadLicenseClient is a client for the LeadLicense schema.
+type LeadLicenseClient struct {
+	config
+}
+
+// NewLeadLicenseClient returns a client for the LeadLicense from the given config.
+func NewLeadLicenseClient(c config) *LeadLicenseClient {
+	return &LeadLicenseClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `leadlicense.Hooks(f(g(h())))`.
+func (c *LeadLicenseClient) Use(hooks ...Hook) {
+	c.hooks.LeadLicense = append(c.hooks.LeadLicense, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `leadlicense.Intercept(f(g(h())))`.
+func (c *LeadLicenseClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LeadLicense = append(c.inters.LeadLicense, interceptors...)
+}
+
+// Create returns a builder for creating a LeadLicense entity.
+func (c *LeadLicenseClient) Create() *LeadLicenseCreate {
+	mutation := newLeadLicenseMutation(c.config, OpCreate)
+	return &LeadLicenseCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LeadLicense entities.
+func (c *LeadLicenseClient) CreateBulk(builders ...*LeadLicenseCreate) *LeadLicenseCreateBulk {
+	return &LeadLicenseCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LeadLicenseClient) MapCreateBulk(slice any, setFunc func(*LeadLicenseCreate, int)) *LeadLicenseCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LeadLicenseCreateBulk{err: fmt.Errorf("calling to LeadLicenseClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LeadLicenseCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LeadLicenseCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LeadLicense.
+func (c *LeadLicenseClient) Update() *LeadLicenseUpdate {
+	mutation := newLeadLicenseMutation(c.config, OpUpdate)
+	return &LeadLicenseUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LeadLicenseClient) UpdateOne(_m *LeadLicense) *LeadLicenseUpdateOne {
+	mutation := newLeadLicenseMutation(c.config, OpUpdateOne, withLeadLicense(_m))
+	return &LeadLicenseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LeadLicenseClient) UpdateOneID(id int) *LeadLicenseUpdateOne {
+	mutation := newLeadLicenseMutation(c.config, OpUpdateOne, withLeadLicenseID(id))
+	return &LeadLicenseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LeadLicense.
+func (c *LeadLicenseClient) Delete() *LeadLicenseDelete {
+	mutation := newLeadLicenseMutation(c.config, OpDelete)
+	return &LeadLicenseDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LeadLicenseClient) DeleteOne(_m *LeadLicense) *LeadLicenseDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LeadLicenseClient) DeleteOneID(id int) *LeadLicenseDeleteOne {
+	builder := c.Delete().Where(leadlicense.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LeadLicenseDeleteOne{builder}
+}
+
+// Query returns a query builder for LeadLicense.
+func (c *LeadLicenseClient) Query() *LeadLicenseQuery {
+	return &LeadLicenseQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLeadLicense},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LeadLicense entity by its id.
+func (c *LeadLicenseClient) Get(ctx context.Context, id int) (*LeadLicense, error) {
+	return c.Query().Where(leadlicense.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LeadLicenseClient) GetX(ctx context.Context, id int) *LeadLicense {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrganization queries the organization edge of a LeadLicense.
+func (c *LeadLicenseClient) QueryOrganization(_m *LeadLicense) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(leadlicense.Table, leadlicense.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, leadlicense.OrganizationTable, leadlicense.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LeadLicenseClient) Hooks() []Hook {
+	return c.hooks.LeadLicense
+}
+
+// Interceptors returns the client interceptors.
+func (c *LeadLicenseClient) Interceptors() []Interceptor {
+	return c.inters.LeadLicense
+}
+
+func (c *LeadLicenseClient) mutate(ctx context.Context, m *LeadLicenseMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LeadLicenseCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LeadLicenseUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LeadLicenseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LeadLicenseDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LeadLicense mutation op: %q", m.Op())
+	}
+}
+
+// LeadNoteClient is a client for the LeadNote schema.
+type LeadNoteClient struct {
+	config
+}
+
+// NewLeadNoteClient returns a client for the LeadNote from the given config.
+func NewLeadNoteClient(c config) *LeadNoteClient {
+	return &LeadNoteClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `leadnote.Hooks(f(g(h())))`.
+func (c *LeadNoteClient) Use(hooks ...Hook) {
+	c.hooks.LeadNote = append(c.hooks.LeadNote, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `leadnote.Intercept(f(g(h())))`.
+func (c *LeadNoteClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LeadNote = append(c.inters.LeadNote, interceptors...)
+}
+
+// Create returns a builder for creating a LeadNote entity.
+func (c *LeadNoteClient) Create() *LeadNoteCreate {
+	mutation := newLeadNoteMutation(c.config, OpCreate)
+	return &LeadNoteCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LeadNote entities.
+func (c *LeadNoteClient) CreateBulk(builders ...*LeadNoteCreate) *LeadNoteCreateBulk {
+	return &LeadNoteCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LeadNoteClient) MapCreateBulk(slice any, setFunc func(*LeadNoteCreate, int)) *LeadNoteCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LeadNoteCreateBulk{err: fmt.Errorf("calling to LeadNoteClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LeadNoteCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LeadNoteCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LeadNote.
+func (c *LeadNoteClient) Update() *LeadNoteUpdate {
+	mutation := newLeadNoteMutation(c.config, OpUpdate)
+	return &LeadNoteUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LeadNoteClient) UpdateOne(_m *LeadNote) *LeadNoteUpdateOne {
+	mutation := newLeadNoteMutation(c.config, OpUpdateOne, withLeadNote(_m))
+	return &LeadNoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LeadNoteClient) UpdateOneID(id int) *LeadNoteUpdateOne {
+	mutation := newLeadNoteMutation(c.config, OpUpdateOne, withLeadNoteID(id))
+	return &LeadNoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LeadNote.
+func (c *LeadNoteClient) Delete() *LeadNoteDelete {
+	mutation := newLeadNoteMutation(c.config, OpDelete)
+	return &LeadNoteDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LeadNoteClient) DeleteOne(_m *LeadNote) *LeadNoteDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LeadNoteClient) DeleteOneID(id int) *LeadNoteDeleteOne {
+	builder := c.Delete().Where(leadnote.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LeadNoteDeleteOne{builder}
+}
+
+// Query returns a query builder for LeadNote.
+func (c *LeadNoteClient) Query() *LeadNoteQuery {
+	return &LeadNoteQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLeadNote},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LeadNote entity by its id.
+func (c *LeadNoteClient) Get(ctx context.Context, id int) (*LeadNote, error) {
+	return c.Query().Where(leadnote.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LeadNoteClient) GetX(ctx context.Context, id int) *LeadNote {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLead queries the lead edge of a LeadNote.
+func (c *LeadNoteClient) QueryLead(_m *LeadNote) *LeadQuery {
+	query := (&LeadClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(leadnote.Table, leadnote.FieldID, id),
+			sqlgraph.To(lead.Table, lead.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, leadnote.LeadTable, leadnote.LeadColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a LeadNote.
+func (c *LeadNoteClient) QueryUser(_m *LeadNote) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(leadnote.Table, leadnote.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, leadnote.UserTable, leadnote.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LeadNoteClient) Hooks() []Hook {
+	return c.hooks.LeadNote
+}
+
+// Interceptors returns the client interceptors.
+func (c *LeadNoteClient) Interceptors() []Interceptor {
+	return c.inters.LeadNote
+}
+
+func (c *LeadNoteClient) mutate(ctx context.Context, m *LeadNoteMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LeadNoteCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LeadNoteUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LeadNoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LeadNoteDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LeadNote mutation op: %q", m.Op())
+	}
+}
+
+// LeadRecommendationClient is a client for the LeadRecommendation schema.
+type LeadRecommendationClient struct {
+	config
+}
+
+// NewLeadRecommendationClient returns a client for the LeadRecommendation from the given config.
+func NewLeadRecommendationClient(c config) *LeadRecommendationClient {
+	return &LeadRecommendationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `leadrecommendation.Hooks(f(g(h())))`.
+func (c *LeadRecommendationClient) Use(hooks ...Hook) {
+	c.hooks.LeadRecommendation = append(c.hooks.LeadRecommendation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `leadrecommendation.Intercept(f(g(h())))`.
+func (c *LeadRecommendationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LeadRecommendation = append(c.inters.LeadRecommendation, interceptors...)
+}
+
+// Create returns a builder for creating a LeadRecommendation entity.
+func (c *LeadRecommendationClient) Create() *LeadRecommendationCreate {
+	mutation := newLeadRecommendationMutation(c.config, OpCreate)
+	return &LeadRecommendationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LeadRecommendation entities.
+func (c *LeadRecommendationClient) CreateBulk(builders ...*LeadRecommendationCreate) *LeadRecommendationCreateBulk {
+	return &LeadRecommendationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LeadRecommendationClient) MapCreateBulk(slice any, setFunc func(*LeadRecommendationCreate, int)) *LeadRecommendationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LeadRecommendationCreateBulk{err: fmt.Errorf("calling to LeadRecommendationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LeadRecommendationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LeadRecommendationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LeadRecommendation.
+func (c *LeadRecommendationClient) Update() *LeadRecommendationUpdate {
+	mutation := newLeadRecommendationMutation(c.config, OpUpdate)
+	return &LeadRecommendationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LeadRecommendationClient) UpdateOne(_m *LeadRecommendation) *LeadRecommendationUpdateOne {
+	mutation := newLeadRecommendationMutation(c.config, OpUpdateOne, withLeadRecommendation(_m))
+	return &LeadRecommendationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LeadRecommendationClient) UpdateOneID(id int) *LeadRecommendationUpdateOne {
+	mutation := newLeadRecommendationMutation(c.config, OpUpdateOne, withLeadRecommendationID(id))
+	return &LeadRecommendationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LeadRecommendation.
+func (c *LeadRecommendationClient) Delete() *LeadRecommendationDelete {
+	mutation := newLeadRecommendationMutation(c.config, OpDelete)
+	return &LeadRecommendationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LeadRecommendationClient) DeleteOne(_m *LeadRecommendation) *LeadRecommendationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LeadRecommendationClient) DeleteOneID(id int) *LeadRecommendationDeleteOne {
+	builder := c.Delete().Where(leadrecommendation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LeadRecommendationDeleteOne{builder}
+}
+
+// Query returns a query builder for LeadRecommendation.
+func (c *LeadRecommendationClient) Query() *LeadRecommendationQuery {
+	return &LeadRecommendationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLeadRecommendation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LeadRecommendation entity by its id.
+func (c *LeadRecommendationClient) Get(ctx context.Context, id int) (*LeadRecommendation, error) {
+	return c.Query().Where(leadrecommendation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LeadRecommendationClient) GetX(ctx context.Context, id int) *LeadRecommendation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a LeadRecommendation.
+func (c *LeadRecommendationClient) QueryUser(_m *LeadRecommendation) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(leadrecommendation.Table, leadrecommendation.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, leadrecommendation.UserTable, leadrecommendation.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLead queries the lead edge of a LeadRecommendation.
+func (c *LeadRecommendationClient) QueryLead(_m *LeadRecommendation) *LeadQuery {
+	query := (&LeadClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(leadrecommendation.Table, leadrecommendation.FieldID, id),
+			sqlgraph.To(lead.Table, lead.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, leadrecommendation.LeadTable, leadrecommendation.LeadColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LeadRecommendationClient) Hooks() []Hook {
+	return c.hooks.LeadRecommendation
+}
+
+// Interceptors returns the client interceptors.
+func (c *LeadRecommendationClient) Interceptors() []Interceptor {
+	return c.inters.LeadRecommendation
+}
+
+func (c *LeadRecommendationClient) mutate(ctx context.Context, m *LeadRecommendationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LeadRecommendationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LeadRecommendationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LeadRecommendationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LeadRecommendationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LeadRecommendation mutation op: %q", m.Op())
+	}
+}
+
+// LeadReindexJobClient is a client for the LeadReindexJob schema.
+type LeadReindexJobClient struct {
+	config
+}
+
+// NewLeadReindexJobClient returns a client for the LeadReindexJob from the given config.
+func NewLeadReindexJobClient(c config) *LeadReindexJobClient {
+	return &LeadReindexJobClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `leadreindexjob.Hooks(f(g(h())))`.
+func (c *LeadReindexJobClient) Use(hooks ...Hook) {
+	c.hooks.LeadReindexJob = append(c.hooks.LeadReindexJob, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `leadreindexjob.Intercept(f(g(h())))`.
+func (c *LeadReindexJobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LeadReindexJob = append(c.inters.LeadReindexJob, interceptors...)
+}
+
+// Create returns a builder for creating a LeadReindexJob entity.
+func (c *LeadReindexJobClient) Create() *LeadReindexJobCreate {
+	mutation := newLeadReindexJobMutation(c.config, OpCreate)
+	return &LeadReindexJobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LeadReindexJob entities.
+func (c *LeadReindexJobClient) CreateBulk(builders ...*LeadReindexJobCreate) *LeadReindexJobCreateBulk {
+	return &LeadReindexJobCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LeadReindexJobClient) MapCreateBulk(slice any, setFunc func(*LeadReindexJobCreate, int)) *LeadReindexJobCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LeadReindexJobCreateBulk{err: fmt.Errorf("calling to LeadReindexJobClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LeadReindexJobCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LeadReindexJobCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LeadReindexJob.
+func (c *LeadReindexJobClient) Update() *LeadReindexJobUpdate {
+	mutation := newLeadReindexJobMutation(c.config, OpUpdate)
+	return &LeadReindexJobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LeadReindexJobClient) UpdateOne(_m *LeadReindexJob) *LeadReindexJobUpdateOne {
+	mutation := newLeadReindexJobMutation(c.config, OpUpdateOne, withLeadReindexJob(_m))
+	return &LeadReindexJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LeadReindexJobClient) UpdateOneID(id int) *LeadReindexJobUpdateOne {
+	mutation := newLeadReindexJobMutation(c.config, OpUpdateOne, withLeadReindexJobID(id))
+	return &LeadReindexJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LeadReindexJob.
+func (c *LeadReindexJobClient) Delete() *LeadReindexJobDelete {
+	mutation := newLeadReindexJobMutation(c.config, OpDelete)
+	return &LeadReindexJobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LeadReindexJobClient) DeleteOne(_m *LeadReindexJob) *LeadReindexJobDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LeadReindexJobClient) DeleteOneID(id int) *LeadReindexJobDeleteOne {
+	builder := c.Delete().Where(leadreindexjob.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LeadReindexJobDeleteOne{builder}
+}
+
+// Query returns a query builder for LeadReindexJob.
+func (c *LeadReindexJobClient) Query() *LeadReindexJobQuery {
+	return &LeadReindexJobQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLeadReindexJob},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LeadReindexJob entity by its id.
+func (c *LeadReindexJobClient) Get(ctx context.Context, id int) (*LeadReindexJob, error) {
+	return c.Query().Where(leadreindexjob.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LeadReindexJobClient) GetX(ctx context.Context, id int) *LeadReindexJob {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a LeadReindexJob.
+func (c *LeadReindexJobClient) QueryUser(_m *LeadReindexJob) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(leadreindexjob.Table, leadreindexjob.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, leadreindexjob.UserTable, leadreindexjob.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LeadReindexJobClient) Hooks() []Hook {
+	return c.hooks.LeadReindexJob
+}
+
+// Interceptors returns the client interceptors.
+func (c *LeadReindexJobClient) Interceptors() []Interceptor {
+	return c.inters.LeadReindexJob
+}
+
+func (c *LeadReindexJobClient) mutate(ctx context.Context, m *LeadReindexJobMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LeadReindexJobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LeadReindexJobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LeadReindexJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LeadReindexJobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LeadReindexJob mutation op: %q", m.Op())
+	}
+}
+
+// LeadStatusHistoryClient is a client for the LeadStatusHistory schema.
+type LeadStatusHistoryClient struct {
+	config
+}
+
+// NewLeadStatusHistoryClient returns a client for the LeadStatusHistory from the given config.
+func NewLeadStatusHistoryClient(c config) *LeadStatusHistoryClient {
+	return &LeadStatusHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `leadstatushistory.Hooks(f(g(h())))`.
+func (c *LeadStatusHistoryClient) Use(hooks ...Hook) {
+	c.hooks.LeadStatusHistory = append(c.hooks.LeadStatusHistory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `leadstatushistory.Intercept(f(g(h())))`.
+func (c *LeadStatusHistoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LeadStatusHistory = append(c.inters.LeadStatusHistory, interceptors...)
+}
+
+// Create returns a builder for creating a LeadStatusHistory entity.
+func (c *LeadStatusHistoryClient) Create() *LeadStatusHistoryCreate {
+	mutation := newLeadStatusHistoryMutation(c.config, OpCreate)
+	return &LeadStatusHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LeadStatusHistory entities.
+func (c *LeadStatusHistoryClient) CreateBulk(builders ...*LeadStatusHistoryCreate) *LeadStatusHistoryCreateBulk {
+	return &LeadStatusHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LeadStatusHistoryClient) MapCreateBulk(slice any, setFunc func(*LeadStatusHistoryCreate, int)) *LeadStatusHistoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LeadStatusHistoryCreateBulk{err: fmt.Errorf("calling to LeadStatusHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LeadStatusHistoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LeadStatusHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LeadStatusHistory.
+func (c *LeadStatusHistoryClient) Update() *LeadStatusHistoryUpdate {
+	mutation := newLeadStatusHistoryMutation(c.config, OpUpdate)
+	return &LeadStatusHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LeadStatusHistoryClient) UpdateOne(_m *LeadStatusHistory) *LeadStatusHistoryUpdateOne {
+	mutation := newLeadStatusHistoryMutation(c.config, OpUpdateOne, withLeadStatusHistory(_m))
+	return &LeadStatusHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LeadStatusHistoryClient) UpdateOneID(id int) *LeadStatusHistoryUpdateOne {
+	mutation := newLeadStatusHistoryMutation(c.config, OpUpdateOne, withLeadStatusHistoryID(id))
+	return &LeadStatusHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LeadStatusHistory.
+func (c *LeadStatusHistoryClient) Delete() *LeadStatusHistoryDelete {
+	mutation := newLeadStatusHistoryMutation(c.config, OpDelete)
+	return &LeadStatusHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *LeadClient) DeleteOne(_m *Lead) *LeadDeleteOne {
+func (c *LeadStatusHistoryClient) DeleteOne(_m *LeadStatusHistory) *LeadStatusHistoryDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *LeadClient) DeleteOneID(id int) *LeadDeleteOne {
-	builder := c.Delete().Where(lead.ID(id))
+func (c *LeadStatusHistoryClient) DeleteOneID(id int) *LeadStatusHistoryDeleteOne {
+	builder := c.Delete().Where(leadstatushistory.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &LeadDeleteOne{builder}
+	return &LeadStatusHistoryDeleteOne{builder}
 }
 
-// Query returns a query builder for Lead.
-func (c *LeadClient) Query() *LeadQuery {
-	return &LeadQuery{
+// Query returns a query builder for LeadStatusHistory.
+func (c *LeadStatusHistoryClient) Query() *LeadStatusHistoryQuery {
+	return &LeadStatusHistoryQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeLead},
+		ctx:    &QueryContext{Type: TypeLeadStatusHistory},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Lead entity by its id.
-func (c *LeadClient) Get(ctx context.Context, id int) (*Lead, error) {
-	return c.Query().Where(lead.ID(id)).Only(ctx)
+// Get returns a LeadStatusHistory entity by its id.
+func (c *LeadStatusHistoryClient) Get(ctx context.Context, id int) (*LeadStatusHistory, error) {
+	return c.Query().Where(leadstatushistory.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *LeadClient) GetX(ctx context.Context, id int) *Lead {
+func (c *LeadStatusHistoryClient) GetX(ctx context.Context, id int) *LeadStatusHistory {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3869,127 +6823,15 @@ func (c *LeadClient) GetX(ctx context.Context, id int) *Lead {
 	return obj
 }
 
-// QueryNotes queries the notes edge of a Lead.
-func (c *LeadClient) QueryNotes(_m *Lead) *LeadNoteQuery {
-	query := (&LeadNoteClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(lead.Table, lead.FieldID, id),
-			sqlgraph.To(leadnote.Table, leadnote.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, lead.NotesTable, lead.NotesColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryStatusHistory queries the status_history edge of a Lead.
-func (c *LeadClient) QueryStatusHistory(_m *Lead) *LeadStatusHistoryQuery {
-	query := (&LeadStatusHistoryClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(lead.Table, lead.FieldID, id),
-			sqlgraph.To(leadstatushistory.Table, leadstatushistory.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, lead.StatusHistoryTable, lead.StatusHistoryColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryAssignments queries the assignments edge of a Lead.
-func (c *LeadClient) QueryAssignments(_m *Lead) *LeadAssignmentQuery {
-	query := (&LeadAssignmentClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(lead.Table, lead.FieldID, id),
-			sqlgraph.To(leadassignment.Table, leadassignment.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, lead.AssignmentsTable, lead.AssignmentsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryEmailSequenceEnrollments queries the email_sequence_enrollments edge of a Lead.
-func (c *LeadClient) QueryEmailSequenceEnrollments(_m *Lead) *EmailSequenceEnrollmentQuery {
-	query := (&EmailSequenceEnrollmentClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(lead.Table, lead.FieldID, id),
-			sqlgraph.To(emailsequenceenrollment.Table, emailsequenceenrollment.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, lead.EmailSequenceEnrollmentsTable, lead.EmailSequenceEnrollmentsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryEmailSequenceSends queries the email_sequence_sends edge of a Lead.
-func (c *LeadClient) QueryEmailSequenceSends(_m *Lead) *EmailSequenceSendQuery {
-	query := (&EmailSequenceSendClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(lead.Table, lead.FieldID, id),
-			sqlgraph.To(emailsequencesend.Table, emailsequencesend.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, lead.EmailSequenceSendsTable, lead.EmailSequenceSendsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryTerritory queries the territory edge of a Lead.
-func (c *LeadClient) QueryTerritory(_m *Lead) *TerritoryQuery {
-	query := (&TerritoryClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(lead.Table, lead.FieldID, id),
-			sqlgraph.To(territory.Table, territory.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, lead.TerritoryTable, lead.TerritoryColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QuerySmsMessages queries the sms_messages edge of a Lead.
-func (c *LeadClient) QuerySmsMessages(_m *Lead) *SMSMessageQuery {
-	query := (&SMSMessageClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(lead.Table, lead.FieldID, id),
-			sqlgraph.To(smsmessage.Table, smsmessage.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, lead.SmsMessagesTable, lead.SmsMessagesColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryCallLogs queries the call_logs edge of a Lead.
-func (c *LeadClient) QueryCallLogs(_m *Lead) *CallLogQuery {
-	query := (&CallLogClient{config: c.config}).Query()
+// QueryLead queries the lead edge of a LeadStatusHistory.
+func (c *LeadStatusHistoryClient) QueryLead(_m *LeadStatusHistory) *LeadQuery {
+	query := (&LeadClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(lead.Table, lead.FieldID, id),
-			sqlgraph.To(calllog.Table, calllog.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, lead.CallLogsTable, lead.CallLogsColumn),
+			sqlgraph.From(leadstatushistory.Table, leadstatushistory.FieldID, id),
+			sqlgraph.To(lead.Table, lead.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, leadstatushistory.LeadTable, leadstatushistory.LeadColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -3997,15 +6839,15 @@ func (c *LeadClient) QueryCallLogs(_m *Lead) *CallLogQuery {
 	return query
 }
 
-// QueryRecommendations queries the recommendations edge of a Lead.
-func (c *LeadClient) QueryRecommendations(_m *Lead) *LeadRecommendationQuery {
-	query := (&LeadRecommendationClient{config: c.config}).Query()
+// QueryUser queries the user edge of a LeadStatusHistory.
+func (c *LeadStatusHistoryClient) QueryUser(_m *LeadStatusHistory) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(lead.Table, lead.FieldID, id),
-			sqlgraph.To(leadrecommendation.Table, leadrecommendation.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, lead.RecommendationsTable, lead.RecommendationsColumn),
+			sqlgraph.From(leadstatushistory.Table, leadstatushistory.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, leadstatushistory.UserTable, leadstatushistory.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -4014,131 +6856,131 @@ func (c *LeadClient) QueryRecommendations(_m *Lead) *LeadRecommendationQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *LeadClient) Hooks() []Hook {
-	return c.hooks.Lead
+func (c *LeadStatusHistoryClient) Hooks() []Hook {
+	return c.hooks.LeadStatusHistory
 }
 
 // Interceptors returns the client interceptors.
-func (c *LeadClient) Interceptors() []Interceptor {
-	return c.inters.Lead
+func (c *LeadStatusHistoryClient) Interceptors() []Interceptor {
+	return c.inters.LeadStatusHistory
 }
 
-func (c *LeadClient) mutate(ctx context.Context, m *LeadMutation) (Value, error) {
+func (c *LeadStatusHistoryClient) mutate(ctx context.Context, m *LeadStatusHistoryMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&LeadCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LeadStatusHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&LeadUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LeadStatusHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&LeadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LeadStatusHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&LeadDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&LeadStatusHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Lead mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown LeadStatusHistory mutation op: %q", m.Op())
 	}
 }
 
-// LeadAssignmentClient is a client for the LeadAssignment schema.
-type LeadAssignmentClient struct {
+// LeadSuppressionClient is a client for the LeadSuppression schema.
+type LeadSuppressionClient struct {
 	config
 }
 
-// NewLeadAssignmentClient returns a client for the LeadAssignment from the given config.
-func NewLeadAssignmentClient(c config) *LeadAssignmentClient {
-	return &LeadAssignmentClient{config: c}
+// NewLeadSuppressionClient returns a client for the LeadSuppression from the given config.
+func NewLeadSuppressionClient(c config) *LeadSuppressionClient {
+	return &LeadSuppressionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `leadassignment.Hooks(f(g(h())))`.
-func (c *LeadAssignmentClient) Use(hooks ...Hook) {
-	c.hooks.LeadAssignment = append(c.hooks.LeadAssignment, hooks...)
+// A call to `Use(f, g, h)` equals to `leadsuppression.Hooks(f(g(h())))`.
+func (c *LeadSuppressionClient) Use(hooks ...Hook) {
+	c.hooks.LeadSuppression = append(c.hooks.LeadSuppression, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `leadassignment.Intercept(f(g(h())))`.
-func (c *LeadAssignmentClient) Intercept(interceptors ...Interceptor) {
-	c.inters.LeadAssignment = append(c.inters.LeadAssignment, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `leadsuppression.Intercept(f(g(h())))`.
+func (c *LeadSuppressionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LeadSuppression = append(c.inters.LeadSuppression, interceptors...)
 }
 
-// Create returns a builder for creating a LeadAssignment entity.
-func (c *LeadAssignmentClient) Create() *LeadAssignmentCreate {
-	mutation := newLeadAssignmentMutation(c.config, OpCreate)
-	return &LeadAssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a LeadSuppression entity.
+func (c *LeadSuppressionClient) Create() *LeadSuppressionCreate {
+	mutation := newLeadSuppressionMutation(c.config, OpCreate)
+	return &LeadSuppressionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of LeadAssignment entities.
-func (c *LeadAssignmentClient) CreateBulk(builders ...*LeadAssignmentCreate) *LeadAssignmentCreateBulk {
-	return &LeadAssignmentCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of LeadSuppression entities.
+func (c *LeadSuppressionClient) CreateBulk(builders ...*LeadSuppressionCreate) *LeadSuppressionCreateBulk {
+	return &LeadSuppressionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *LeadAssignmentClient) MapCreateBulk(slice any, setFunc func(*LeadAssignmentCreate, int)) *LeadAssignmentCreateBulk {
+func (c *LeadSuppressionClient) MapCreateBulk(slice any, setFunc func(*LeadSuppressionCreate, int)) *LeadSuppressionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &LeadAssignmentCreateBulk{err: fmt.Errorf("calling to LeadAssignmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &LeadSuppressionCreateBulk{err: fmt.Errorf("calling to LeadSuppressionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*LeadAssignmentCreate, rv.Len())
+	builders := make([]*LeadSuppressionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &LeadAssignmentCreateBulk{config: c.config, builders: builders}
+	return &LeadSuppressionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for LeadAssignment.
-func (c *LeadAssignmentClient) Update() *LeadAssignmentUpdate {
-	mutation := newLeadAssignmentMutation(c.config, OpUpdate)
-	return &LeadAssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for LeadSuppression.
+func (c *LeadSuppressionClient) Update() *LeadSuppressionUpdate {
+	mutation := newLeadSuppressionMutation(c.config, OpUpdate)
+	return &LeadSuppressionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *LeadAssignmentClient) UpdateOne(_m *LeadAssignment) *LeadAssignmentUpdateOne {
-	mutation := newLeadAssignmentMutation(c.config, OpUpdateOne, withLeadAssignment(_m))
-	return &LeadAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *LeadSuppressionClient) UpdateOne(_m *LeadSuppression) *LeadSuppressionUpdateOne {
+	mutation := newLeadSuppressionMutation(c.config, OpUpdateOne, withLeadSuppression(_m))
+	return &LeadSuppressionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *LeadAssignmentClient) UpdateOneID(id int) *LeadAssignmentUpdateOne {
-	mutation := newLeadAssignmentMutation(c.config, OpUpdateOne, withLeadAssignmentID(id))
-	return &LeadAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *LeadSuppressionClient) UpdateOneID(id int) *LeadSuppressionUpdateOne {
+	mutation := newLeadSuppressionMutation(c.config, OpUpdateOne, withLeadSuppressionID(id))
+	return &LeadSuppressionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for LeadAssignment.
-func (c *LeadAssignmentClient) Delete() *LeadAssignmentDelete {
-	mutation := newLeadAssignmentMutation(c.config, OpDelete)
-	return &LeadAssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for LeadSuppression.
+func (c *LeadSuppressionClient) Delete() *LeadSuppressionDelete {
+	mutation := newLeadSuppressionMutation(c.config, OpDelete)
+	return &LeadSuppressionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *LeadAssignmentClient) DeleteOne(_m *LeadAssignment) *LeadAssignmentDeleteOne {
+func (c *LeadSuppressionClient) DeleteOne(_m *LeadSuppression) *LeadSuppressionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *LeadAssignmentClient) DeleteOneID(id int) *LeadAssignmentDeleteOne {
-	builder := c.Delete().Where(leadassignment.ID(id))
+func (c *LeadSuppressionClient) DeleteOneID(id int) *LeadSuppressionDeleteOne {
+	builder := c.Delete().Where(leadsuppression.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &LeadAssignmentDeleteOne{builder}
+	return &LeadSuppressionDeleteOne{builder}
 }
 
-// Query returns a query builder for LeadAssignment.
-func (c *LeadAssignmentClient) Query() *LeadAssignmentQuery {
-	return &LeadAssignmentQuery{
+// Query returns a query builder for LeadSuppression.
+func (c *LeadSuppressionClient) Query() *LeadSuppressionQuery {
+	return &LeadSuppressionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeLeadAssignment},
+		ctx:    &QueryContext{Type: TypeLeadSuppression},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a LeadAssignment entity by its id.
-func (c *LeadAssignmentClient) Get(ctx context.Context, id int) (*LeadAssignment, error) {
-	return c.Query().Where(leadassignment.ID(id)).Only(ctx)
+// Get returns a LeadSuppression entity by its id.
+func (c *LeadSuppressionClient) Get(ctx context.Context, id int) (*LeadSuppression, error) {
+	return c.Query().Where(leadsuppression.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *LeadAssignmentClient) GetX(ctx context.Context, id int) *LeadAssignment {
+func (c *LeadSuppressionClient) GetX(ctx context.Context, id int) *LeadSuppression {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -4146,15 +6988,15 @@ func (c *LeadAssignmentClient) GetX(ctx context.Context, id int) *LeadAssignment
 	return obj
 }
 
-// QueryLead queries the lead edge of a LeadAssignment.
-func (c *LeadAssignmentClient) QueryLead(_m *LeadAssignment) *LeadQuery {
+// QueryLead queries the lead edge of a LeadSuppression.
+func (c *LeadSuppressionClient) QueryLead(_m *LeadSuppression) *LeadQuery {
 	query := (&LeadClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(leadassignment.Table, leadassignment.FieldID, id),
+			sqlgraph.From(leadsuppression.Table, leadsuppression.FieldID, id),
 			sqlgraph.To(lead.Table, lead.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, leadassignment.LeadTable, leadassignment.LeadColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, leadsuppression.LeadTable, leadsuppression.LeadColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -4162,15 +7004,15 @@ func (c *LeadAssignmentClient) QueryLead(_m *LeadAssignment) *LeadQuery {
 	return query
 }
 
-// QueryUser queries the user edge of a LeadAssignment.
-func (c *LeadAssignmentClient) QueryUser(_m *LeadAssignment) *UserQuery {
+// QueryUser queries the user edge of a LeadSuppression.
+func (c *LeadSuppressionClient) QueryUser(_m *LeadSuppression) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(leadassignment.Table, leadassignment.FieldID, id),
+			sqlgraph.From(leadsuppression.Table, leadsuppression.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, leadassignment.UserTable, leadassignment.UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, leadsuppression.UserTable, leadsuppression.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -4178,15 +7020,15 @@ func (c *LeadAssignmentClient) QueryUser(_m *LeadAssignment) *UserQuery {
 	return query
 }
 
-// QueryAssignedBy queries the assigned_by edge of a LeadAssignment.
-func (c *LeadAssignmentClient) QueryAssignedBy(_m *LeadAssignment) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryOrganization queries the organization edge of a LeadSuppression.
+func (c *LeadSuppressionClient) QueryOrganization(_m *LeadSuppression) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(leadassignment.Table, leadassignment.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, leadassignment.AssignedByTable, leadassignment.AssignedByColumn),
+			sqlgraph.From(leadsuppression.Table, leadsuppression.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, leadsuppression.OrganizationTable, leadsuppression.OrganizationColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -4195,131 +7037,131 @@ func (c *LeadAssignmentClient) QueryAssignedBy(_m *LeadAssignment) *UserQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *LeadAssignmentClient) Hooks() []Hook {
-	return c.hooks.LeadAssignment
+func (c *LeadSuppressionClient) Hooks() []Hook {
+	return c.hooks.LeadSuppression
 }
 
 // Interceptors returns the client interceptors.
-func (c *LeadAssignmentClient) Interceptors() []Interceptor {
-	return c.inters.LeadAssignment
+func (c *LeadSuppressionClient) Interceptors() []Interceptor {
+	return c.inters.LeadSuppression
 }
 
-func (c *LeadAssignmentClient) mutate(ctx context.Context, m *LeadAssignmentMutation) (Value, error) {
+func (c *LeadSuppressionClient) mutate(ctx context.Context, m *LeadSuppressionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&LeadAssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LeadSuppressionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&LeadAssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LeadSuppressionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&LeadAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LeadSuppressionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&LeadAssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&LeadSuppressionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown LeadAssignment mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown LeadSuppression mutation op: %q", m.Op())
 	}
 }
 
-// LeadNoteClient is a client for the LeadNote schema.
-type LeadNoteClient struct {
+// LeadVerificationClient is a client for the LeadVerification schema.
+type LeadVerificationClient struct {
 	config
 }
 
-// NewLeadNoteClient returns a client for the LeadNote from the given config.
-func NewLeadNoteClient(c config) *LeadNoteClient {
-	return &LeadNoteClient{config: c}
+// NewLeadVerificationClient returns a client for the LeadVerification from the given config.
+func NewLeadVerificationClient(c config) *LeadVerificationClient {
+	return &LeadVerificationClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `leadnote.Hooks(f(g(h())))`.
-func (c *LeadNoteClient) Use(hooks ...Hook) {
-	c.hooks.LeadNote = append(c.hooks.LeadNote, hooks...)
+// A call to `Use(f, g, h)` equals to `leadverification.Hooks(f(g(h())))`.
+func (c *LeadVerificationClient) Use(hooks ...Hook) {
+	c.hooks.LeadVerification = append(c.hooks.LeadVerification, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `leadnote.Intercept(f(g(h())))`.
-func (c *LeadNoteClient) Intercept(interceptors ...Interceptor) {
-	c.inters.LeadNote = append(c.inters.LeadNote, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `leadverification.Intercept(f(g(h())))`.
+func (c *LeadVerificationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LeadVerification = append(c.inters.LeadVerification, interceptors...)
 }
 
-// Create returns a builder for creating a LeadNote entity.
-func (c *LeadNoteClient) Create() *LeadNoteCreate {
-	mutation := newLeadNoteMutation(c.config, OpCreate)
-	return &LeadNoteCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a LeadVerification entity.
+func (c *LeadVerificationClient) Create() *LeadVerificationCreate {
+	mutation := newLeadVerificationMutation(c.config, OpCreate)
+	return &LeadVerificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of LeadNote entities.
-func (c *LeadNoteClient) CreateBulk(builders ...*LeadNoteCreate) *LeadNoteCreateBulk {
-	return &LeadNoteCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of LeadVerification entities.
+func (c *LeadVerificationClient) CreateBulk(builders ...*LeadVerificationCreate) *LeadVerificationCreateBulk {
+	return &LeadVerificationCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *LeadNoteClient) MapCreateBulk(slice any, setFunc func(*LeadNoteCreate, int)) *LeadNoteCreateBulk {
+func (c *LeadVerificationClient) MapCreateBulk(slice any, setFunc func(*LeadVerificationCreate, int)) *LeadVerificationCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &LeadNoteCreateBulk{err: fmt.Errorf("calling to LeadNoteClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &LeadVerificationCreateBulk{err: fmt.Errorf("calling to LeadVerificationClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*LeadNoteCreate, rv.Len())
+	builders := make([]*LeadVerificationCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &LeadNoteCreateBulk{config: c.config, builders: builders}
+	return &LeadVerificationCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for LeadNote.
-func (c *LeadNoteClient) Update() *LeadNoteUpdate {
-	mutation := newLeadNoteMutation(c.config, OpUpdate)
-	return &LeadNoteUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for LeadVerification.
+func (c *LeadVerificationClient) Update() *LeadVerificationUpdate {
+	mutation := newLeadVerificationMutation(c.config, OpUpdate)
+	return &LeadVerificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *LeadNoteClient) UpdateOne(_m *LeadNote) *LeadNoteUpdateOne {
-	mutation := newLeadNoteMutation(c.config, OpUpdateOne, withLeadNote(_m))
-	return &LeadNoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *LeadVerificationClient) UpdateOne(_m *LeadVerification) *LeadVerificationUpdateOne {
+	mutation := newLeadVerificationMutation(c.config, OpUpdateOne, withLeadVerification(_m))
+	return &LeadVerificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *LeadNoteClient) UpdateOneID(id int) *LeadNoteUpdateOne {
-	mutation := newLeadNoteMutation(c.config, OpUpdateOne, withLeadNoteID(id))
-	return &LeadNoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *LeadVerificationClient) UpdateOneID(id int) *LeadVerificationUpdateOne {
+	mutation := newLeadVerificationMutation(c.config, OpUpdateOne, withLeadVerificationID(id))
+	return &LeadVerificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for LeadNote.
-func (c *LeadNoteClient) Delete() *LeadNoteDelete {
-	mutation := newLeadNoteMutation(c.config, OpDelete)
-	return &LeadNoteDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for LeadVerification.
+func (c *LeadVerificationClient) Delete() *LeadVerificationDelete {
+	mutation := newLeadVerificationMutation(c.config, OpDelete)
+	return &LeadVerificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *LeadNoteClient) DeleteOne(_m *LeadNote) *LeadNoteDeleteOne {
+func (c *LeadVerificationClient) DeleteOne(_m *LeadVerification) *LeadVerificationDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *LeadNoteClient) DeleteOneID(id int) *LeadNoteDeleteOne {
-	builder := c.Delete().Where(leadnote.ID(id))
+func (c *LeadVerificationClient) DeleteOneID(id int) *LeadVerificationDeleteOne {
+	builder := c.Delete().Where(leadverification.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &LeadNoteDeleteOne{builder}
+	return &LeadVerificationDeleteOne{builder}
 }
 
-// Query returns a query builder for LeadNote.
-func (c *LeadNoteClient) Query() *LeadNoteQuery {
-	return &LeadNoteQuery{
+// Query returns a query builder for LeadVerification.
+func (c *LeadVerificationClient) Query() *LeadVerificationQuery {
+	return &LeadVerificationQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeLeadNote},
+		ctx:    &QueryContext{Type: TypeLeadVerification},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a LeadNote entity by its id.
-func (c *LeadNoteClient) Get(ctx context.Context, id int) (*LeadNote, error) {
-	return c.Query().Where(leadnote.ID(id)).Only(ctx)
+// Get returns a LeadVerification entity by its id.
+func (c *LeadVerificationClient) Get(ctx context.Context, id int) (*LeadVerification, error) {
+	return c.Query().Where(leadverification.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *LeadNoteClient) GetX(ctx context.Context, id int) *LeadNote {
+func (c *LeadVerificationClient) GetX(ctx context.Context, id int) *LeadVerification {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -4327,15 +7169,15 @@ func (c *LeadNoteClient) GetX(ctx context.Context, id int) *LeadNote {
 	return obj
 }
 
-// QueryLead queries the lead edge of a LeadNote.
-func (c *LeadNoteClient) QueryLead(_m *LeadNote) *LeadQuery {
+// QueryLead queries the lead edge of a LeadVerification.
+func (c *LeadVerificationClient) QueryLead(_m *LeadVerification) *LeadQuery {
 	query := (&LeadClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(leadnote.Table, leadnote.FieldID, id),
+			sqlgraph.From(leadverification.Table, leadverification.FieldID, id),
 			sqlgraph.To(lead.Table, lead.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, leadnote.LeadTable, leadnote.LeadColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, leadverification.LeadTable, leadverification.LeadColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -4343,15 +7185,15 @@ func (c *LeadNoteClient) QueryLead(_m *LeadNote) *LeadQuery {
 	return query
 }
 
-// QueryUser queries the user edge of a LeadNote.
-func (c *LeadNoteClient) QueryUser(_m *LeadNote) *UserQuery {
+// QueryUser queries the user edge of a LeadVerification.
+func (c *LeadVerificationClient) QueryUser(_m *LeadVerification) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(leadnote.Table, leadnote.FieldID, id),
+			sqlgraph.From(leadverification.Table, leadverification.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, leadnote.UserTable, leadnote.UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, leadverification.UserTable, leadverification.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -4360,131 +7202,131 @@ func (c *LeadNoteClient) QueryUser(_m *LeadNote) *UserQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *LeadNoteClient) Hooks() []Hook {
-	return c.hooks.LeadNote
+func (c *LeadVerificationClient) Hooks() []Hook {
+	return c.hooks.LeadVerification
 }
 
 // Interceptors returns the client interceptors.
-func (c *LeadNoteClient) Interceptors() []Interceptor {
-	return c.inters.LeadNote
+func (c *LeadVerificationClient) Interceptors() []Interceptor {
+	return c.inters.LeadVerification
 }
 
-func (c *LeadNoteClient) mutate(ctx context.Context, m *LeadNoteMutation) (Value, error) {
+func (c *LeadVerificationClient) mutate(ctx context.Context, m *LeadVerificationMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&LeadNoteCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LeadVerificationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&LeadNoteUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LeadVerificationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&LeadNoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LeadVerificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&LeadNoteDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&LeadVerificationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown LeadNote mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown LeadVerification mutation op: %q", m.Op())
 	}
 }
 
-// LeadRecommendationClient is a client for the LeadRecommendation schema.
-type LeadRecommendationClient struct {
+// MarketReportClient is a client for the MarketReport schema.
+type MarketReportClient struct {
 	config
 }
 
-// NewLeadRecommendationClient returns a client for the LeadRecommendation from the given config.
-func NewLeadRecommendationClient(c config) *LeadRecommendationClient {
-	return &LeadRecommendationClient{config: c}
+// NewMarketReportClient returns a client for the MarketReport from the given config.
+func NewMarketReportClient(c config) *MarketReportClient {
+	return &MarketReportClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `leadrecommendation.Hooks(f(g(h())))`.
-func (c *LeadRecommendationClient) Use(hooks ...Hook) {
-	c.hooks.LeadRecommendation = append(c.hooks.LeadRecommendation, hooks...)
+// A call to `Use(f, g, h)` equals to `marketreport.Hooks(f(g(h())))`.
+func (c *MarketReportClient) Use(hooks ...Hook) {
+	c.hooks.MarketReport = append(c.hooks.MarketReport, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `leadrecommendation.Intercept(f(g(h())))`.
-func (c *LeadRecommendationClient) Intercept(interceptors ...Interceptor) {
-	c.inters.LeadRecommendation = append(c.inters.LeadRecommendation, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `marketreport.Intercept(f(g(h())))`.
+func (c *MarketReportClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MarketReport = append(c.inters.MarketReport, interceptors...)
 }
 
-// Create returns a builder for creating a LeadRecommendation entity.
-func (c *LeadRecommendationClient) Create() *LeadRecommendationCreate {
-	mutation := newLeadRecommendationMutation(c.config, OpCreate)
-	return &LeadRecommendationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a MarketReport entity.
+func (c *MarketReportClient) Create() *MarketReportCreate {
+	mutation := newMarketReportMutation(c.config, OpCreate)
+	return &MarketReportCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of LeadRecommendation entities.
-func (c *LeadRecommendationClient) CreateBulk(builders ...*LeadRecommendationCreate) *LeadRecommendationCreateBulk {
-	return &LeadRecommendationCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of MarketReport entities.
+func (c *MarketReportClient) CreateBulk(builders ...*MarketReportCreate) *MarketReportCreateBulk {
+	return &MarketReportCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *LeadRecommendationClient) MapCreateBulk(slice any, setFunc func(*LeadRecommendationCreate, int)) *LeadRecommendationCreateBulk {
+func (c *MarketReportClient) MapCreateBulk(slice any, setFunc func(*MarketReportCreate, int)) *MarketReportCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &LeadRecommendationCreateBulk{err: fmt.Errorf("calling to LeadRecommendationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &MarketReportCreateBulk{err: fmt.Errorf("calling to MarketReportClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*LeadRecommendationCreate, rv.Len())
+	builders := make([]*MarketReportCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &LeadRecommendationCreateBulk{config: c.config, builders: builders}
+	return &MarketReportCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for LeadRecommendation.
-func (c *LeadRecommendationClient) Update() *LeadRecommendationUpdate {
-	mutation := newLeadRecommendationMutation(c.config, OpUpdate)
-	return &LeadRecommendationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for MarketReport.
+func (c *MarketReportClient) Update() *MarketReportUpdate {
+	mutation := newMarketReportMutation(c.config, OpUpdate)
+	return &MarketReportUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *LeadRecommendationClient) UpdateOne(_m *LeadRecommendation) *LeadRecommendationUpdateOne {
-	mutation := newLeadRecommendationMutation(c.config, OpUpdateOne, withLeadRecommendation(_m))
-	return &LeadRecommendationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *MarketReportClient) UpdateOne(_m *MarketReport) *MarketReportUpdateOne {
+	mutation := newMarketReportMutation(c.config, OpUpdateOne, withMarketReport(_m))
+	return &MarketReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *LeadRecommendationClient) UpdateOneID(id int) *LeadRecommendationUpdateOne {
-	mutation := newLeadRecommendationMutation(c.config, OpUpdateOne, withLeadRecommendationID(id))
-	return &LeadRecommendationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *MarketReportClient) UpdateOneID(id int) *MarketReportUpdateOne {
+	mutation := newMarketReportMutation(c.config, OpUpdateOne, withMarketReportID(id))
+	return &MarketReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for LeadRecommendation.
-func (c *LeadRecommendationClient) Delete() *LeadRecommendationDelete {
-	mutation := newLeadRecommendationMutation(c.config, OpDelete)
-	return &LeadRecommendationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for MarketReport.
+func (c *MarketReportClient) Delete() *MarketReportDelete {
+	mutation := newMarketReportMutation(c.config, OpDelete)
+	return &MarketReportDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *LeadRecommendationClient) DeleteOne(_m *LeadRecommendation) *LeadRecommendationDeleteOne {
+func (c *MarketReportClient) DeleteOne(_m *MarketReport) *MarketReportDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *LeadRecommendationClient) DeleteOneID(id int) *LeadRecommendationDeleteOne {
-	builder := c.Delete().Where(leadrecommendation.ID(id))
+func (c *MarketReportClient) DeleteOneID(id int) *MarketReportDeleteOne {
+	builder := c.Delete().Where(marketreport.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &LeadRecommendationDeleteOne{builder}
+	return &MarketReportDeleteOne{builder}
 }
 
-// Query returns a query builder for LeadRecommendation.
-func (c *LeadRecommendationClient) Query() *LeadRecommendationQuery {
-	return &LeadRecommendationQuery{
+// Query returns a query builder for MarketReport.
+func (c *MarketReportClient) Query() *MarketReportQuery {
+	return &MarketReportQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeLeadRecommendation},
+		ctx:    &QueryContext{Type: TypeMarketReport},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a LeadRecommendation entity by its id.
-func (c *LeadRecommendationClient) Get(ctx context.Context, id int) (*LeadRecommendation, error) {
-	return c.Query().Where(leadrecommendation.ID(id)).Only(ctx)
+// Get returns a MarketReport entity by its id.
+func (c *MarketReportClient) Get(ctx context.Context, id int) (*MarketReport, error) {
+	return c.Query().Where(marketreport.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *LeadRecommendationClient) GetX(ctx context.Context, id int) *LeadRecommendation {
+func (c *MarketReportClient) GetX(ctx context.Context, id int) *MarketReport {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -4492,31 +7334,15 @@ func (c *LeadRecommendationClient) GetX(ctx context.Context, id int) *LeadRecomm
 	return obj
 }
 
-// QueryUser queries the user edge of a LeadRecommendation.
-func (c *LeadRecommendationClient) QueryUser(_m *LeadRecommendation) *UserQuery {
+// QueryUser queries the user edge of a MarketReport.
+func (c *MarketReportClient) QueryUser(_m *MarketReport) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(leadrecommendation.Table, leadrecommendation.FieldID, id),
+			sqlgraph.From(marketreport.Table, marketreport.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, leadrecommendation.UserTable, leadrecommendation.UserColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryLead queries the lead edge of a LeadRecommendation.
-func (c *LeadRecommendationClient) QueryLead(_m *LeadRecommendation) *LeadQuery {
-	query := (&LeadClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(leadrecommendation.Table, leadrecommendation.FieldID, id),
-			sqlgraph.To(lead.Table, lead.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, leadrecommendation.LeadTable, leadrecommendation.LeadColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, marketreport.UserTable, marketreport.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -4525,131 +7351,131 @@ func (c *LeadRecommendationClient) QueryLead(_m *LeadRecommendation) *LeadQuery
 }
 
 // Hooks returns the client hooks.
-func (c *LeadRecommendationClient) Hooks() []Hook {
-	return c.hooks.LeadRecommendation
+func (c *MarketReportClient) Hooks() []Hook {
+	return c.hooks.MarketReport
 }
 
 // Interceptors returns the client interceptors.
-func (c *LeadRecommendationClient) Interceptors() []Interceptor {
-	return c.inters.LeadRecommendation
+func (c *MarketReportClient) Interceptors() []Interceptor {
+	return c.inters.MarketReport
 }
 
-func (c *LeadRecommendationClient) mutate(ctx context.Context, m *LeadRecommendationMutation) (Value, error) {
+func (c *MarketReportClient) mutate(ctx context.Context, m *MarketReportMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&LeadRecommendationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MarketReportCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&LeadRecommendationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MarketReportUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&LeadRecommendationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MarketReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&LeadRecommendationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&MarketReportDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown LeadRecommendation mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown MarketReport mutation op: %q", m.Op())
 	}
 }
 
-// LeadStatusHistoryClient is a client for the LeadStatusHistory schema.
-type LeadStatusHistoryClient struct {
+// OrganizationClient is a client for the Organization schema.
+type OrganizationClient struct {
 	config
 }
 
-// NewLeadStatusHistoryClient returns a client for the LeadStatusHistory from the given config.
-func NewLeadStatusHistoryClient(c config) *LeadStatusHistoryClient {
-	return &LeadStatusHistoryClient{config: c}
+// NewOrganizationClient returns a client for the Organization from the given config.
+func NewOrganizationClient(c config) *OrganizationClient {
+	return &OrganizationClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `leadstatushistory.Hooks(f(g(h())))`.
-func (c *LeadStatusHistoryClient) Use(hooks ...Hook) {
-	c.hooks.LeadStatusHistory = append(c.hooks.LeadStatusHistory, hooks...)
+// A call to `Use(f, g, h)` equals to `organization.Hooks(f(g(h())))`.
+func (c *OrganizationClient) Use(hooks ...Hook) {
+	c.hooks.Organization = append(c.hooks.Organization, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `leadstatushistory.Intercept(f(g(h())))`.
-func (c *LeadStatusHistoryClient) Intercept(interceptors ...Interceptor) {
-	c.inters.LeadStatusHistory = append(c.inters.LeadStatusHistory, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `organization.Intercept(f(g(h())))`.
+func (c *OrganizationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Organization = append(c.inters.Organization, interceptors...)
 }
 
-// Create returns a builder for creating a LeadStatusHistory entity.
-func (c *LeadStatusHistoryClient) Create() *LeadStatusHistoryCreate {
-	mutation := newLeadStatusHistoryMutation(c.config, OpCreate)
-	return &LeadStatusHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Organization entity.
+func (c *OrganizationClient) Create() *OrganizationCreate {
+	mutation := newOrganizationMutation(c.config, OpCreate)
+	return &OrganizationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of LeadStatusHistory entities.
-func (c *LeadStatusHistoryClient) CreateBulk(builders ...*LeadStatusHistoryCreate) *LeadStatusHistoryCreateBulk {
-	return &LeadStatusHistoryCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Organization entities.
+func (c *OrganizationClient) CreateBulk(builders ...*OrganizationCreate) *OrganizationCreateBulk {
+	return &OrganizationCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *LeadStatusHistoryClient) MapCreateBulk(slice any, setFunc func(*LeadStatusHistoryCreate, int)) *LeadStatusHistoryCreateBulk {
+func (c *OrganizationClient) MapCreateBulk(slice any, setFunc func(*OrganizationCreate, int)) *OrganizationCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &LeadStatusHistoryCreateBulk{err: fmt.Errorf("calling to LeadStatusHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &OrganizationCreateBulk{err: fmt.Errorf("calling to OrganizationClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*LeadStatusHistoryCreate, rv.Len())
+	builders := make([]*OrganizationCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &LeadStatusHistoryCreateBulk{config: c.config, builders: builders}
+	return &OrganizationCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for LeadStatusHistory.
-func (c *LeadStatusHistoryClient) Update() *LeadStatusHistoryUpdate {
-	mutation := newLeadStatusHistoryMutation(c.config, OpUpdate)
-	return &LeadStatusHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Organization.
+func (c *OrganizationClient) Update() *OrganizationUpdate {
+	mutation := newOrganizationMutation(c.config, OpUpdate)
+	return &OrganizationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *LeadStatusHistoryClient) UpdateOne(_m *LeadStatusHistory) *LeadStatusHistoryUpdateOne {
-	mutation := newLeadStatusHistoryMutation(c.config, OpUpdateOne, withLeadStatusHistory(_m))
-	return &LeadStatusHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *OrganizationClient) UpdateOne(_m *Organization) *OrganizationUpdateOne {
+	mutation := newOrganizationMutation(c.config, OpUpdateOne, withOrganization(_m))
+	return &OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *LeadStatusHistoryClient) UpdateOneID(id int) *LeadStatusHistoryUpdateOne {
-	mutation := newLeadStatusHistoryMutation(c.config, OpUpdateOne, withLeadStatusHistoryID(id))
-	return &LeadStatusHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *OrganizationClient) UpdateOneID(id int) *OrganizationUpdateOne {
+	mutation := newOrganizationMutation(c.config, OpUpdateOne, withOrganizationID(id))
+	return &OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for LeadStatusHistory.
-func (c *LeadStatusHistoryClient) Delete() *LeadStatusHistoryDelete {
-	mutation := newLeadStatusHistoryMutation(c.config, OpDelete)
-	return &LeadStatusHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Organization.
+func (c *OrganizationClient) Delete() *OrganizationDelete {
+	mutation := newOrganizationMutation(c.config, OpDelete)
+	return &OrganizationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *LeadStatusHistoryClient) DeleteOne(_m *LeadStatusHistory) *LeadStatusHistoryDeleteOne {
+func (c *OrganizationClient) DeleteOne(_m *Organization) *OrganizationDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *LeadStatusHistoryClient) DeleteOneID(id int) *LeadStatusHistoryDeleteOne {
-	builder := c.Delete().Where(leadstatushistory.ID(id))
+func (c *OrganizationClient) DeleteOneID(id int) *OrganizationDeleteOne {
+	builder := c.Delete().Where(organization.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &LeadStatusHistoryDeleteOne{builder}
+	return &OrganizationDeleteOne{builder}
 }
 
-// Query returns a query builder for LeadStatusHistory.
-func (c *LeadStatusHistoryClient) Query() *LeadStatusHistoryQuery {
-	return &LeadStatusHistoryQuery{
+// Query returns a query builder for Organization.
+func (c *OrganizationClient) Query() *OrganizationQuery {
+	return &OrganizationQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeLeadStatusHistory},
+		ctx:    &QueryContext{Type: TypeOrganization},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a LeadStatusHistory entity by its id.
-func (c *LeadStatusHistoryClient) Get(ctx context.Context, id int) (*LeadStatusHistory, error) {
-	return c.Query().Where(leadstatushistory.ID(id)).Only(ctx)
+// Get returns a Organization entity by its id.
+func (c *OrganizationClient) Get(ctx context.Context, id int) (*Organization, error) {
+	return c.Query().Where(organization.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *LeadStatusHistoryClient) GetX(ctx context.Context, id int) *LeadStatusHistory {
+func (c *OrganizationClient) GetX(ctx context.Context, id int) *Organization {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -4657,15 +7483,127 @@ func (c *LeadStatusHistoryClient) GetX(ctx context.Context, id int) *LeadStatusH
 	return obj
 }
 
-// QueryLead queries the lead edge of a LeadStatusHistory.
-func (c *LeadStatusHistoryClient) QueryLead(_m *LeadStatusHistory) *LeadQuery {
+// QueryOwner queries the owner edge of a Organization.
+func (c *OrganizationClient) QueryOwner(_m *Organization) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, organization.OwnerTable, organization.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMembers queries the members edge of a Organization.
+func (c *OrganizationClient) QueryMembers(_m *Organization) *OrganizationMemberQuery {
+	query := (&OrganizationMemberClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(organizationmember.Table, organizationmember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.MembersTable, organization.MembersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryExports queries the exports edge of a Organization.
+func (c *OrganizationClient) QueryExports(_m *Organization) *ExportQuery {
+	query := (&ExportClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(export.Table, export.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.ExportsTable, organization.ExportsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLeadSuppressions queries the lead_suppressions edge of a Organization.
+func (c *OrganizationClient) QueryLeadSuppressions(_m *Organization) *LeadSuppressionQuery {
+	query := (&LeadSuppressionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(leadsuppression.Table, leadsuppression.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.LeadSuppressionsTable, organization.LeadSuppressionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryExportTemplates queries the export_templates edge of a Organization.
+func (c *OrganizationClient) QueryExportTemplates(_m *Organization) *ExportTemplateQuery {
+	query := (&ExportTemplateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(exporttemplate.Table, exporttemplate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.ExportTemplatesTable, organization.ExportTemplatesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryScheduledExports queries the scheduled_exports edge of a Organization.
+func (c *OrganizationClient) QueryScheduledExports(_m *Organization) *ScheduledExportQuery {
+	query := (&ScheduledExportClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(scheduledexport.Table, scheduledexport.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.ScheduledExportsTable, organization.ScheduledExportsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWebhooks queries the webhooks edge of a Organization.
+func (c *OrganizationClient) QueryWebhooks(_m *Organization) *WebhookQuery {
+	query := (&WebhookClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(webhook.Table, webhook.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.WebhooksTable, organization.WebhooksColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOwnedLeads queries the owned_leads edge of a Organization.
+func (c *OrganizationClient) QueryOwnedLeads(_m *Organization) *LeadQuery {
 	query := (&LeadClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(leadstatushistory.Table, leadstatushistory.FieldID, id),
+			sqlgraph.From(organization.Table, organization.FieldID, id),
 			sqlgraph.To(lead.Table, lead.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, leadstatushistory.LeadTable, leadstatushistory.LeadColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.OwnedLeadsTable, organization.OwnedLeadsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -4673,15 +7611,31 @@ func (c *LeadStatusHistoryClient) QueryLead(_m *LeadStatusHistory) *LeadQuery {
 	return query
 }
 
-// QueryUser queries the user edge of a LeadStatusHistory.
-func (c *LeadStatusHistoryClient) QueryUser(_m *LeadStatusHistory) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryLeadLicenses queries the lead_licenses edge of a Organization.
+func (c *OrganizationClient) QueryLeadLicenses(_m *Organization) *LeadLicenseQuery {
+	query := (&LeadLicenseClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(leadstatushistory.Table, leadstatushistory.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, leadstatushistory.UserTable, leadstatushistory.UserColumn),
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(leadlicense.Table, leadlicense.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.LeadLicensesTable, organization.LeadLicensesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEnrichmentBudget queries the enrichment_budget edge of a Organization.
+func (c *OrganizationClient) QueryEnrichmentBudget(_m *Organization) *EnrichmentBudgetQuery {
+	query := (&EnrichmentBudgetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(enrichmentbudget.Table, enrichmentbudget.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, organization.EnrichmentBudgetTable, organization.EnrichmentBudgetColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -4690,131 +7644,131 @@ func (c *LeadStatusHistoryClient) QueryUser(_m *LeadStatusHistory) *UserQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *LeadStatusHistoryClient) Hooks() []Hook {
-	return c.hooks.LeadStatusHistory
+func (c *OrganizationClient) Hooks() []Hook {
+	return c.hooks.Organization
 }
 
 // Interceptors returns the client interceptors.
-func (c *LeadStatusHistoryClient) Interceptors() []Interceptor {
-	return c.inters.LeadStatusHistory
+func (c *OrganizationClient) Interceptors() []Interceptor {
+	return c.inters.Organization
 }
 
-func (c *LeadStatusHistoryClient) mutate(ctx context.Context, m *LeadStatusHistoryMutation) (Value, error) {
+func (c *OrganizationClient) mutate(ctx context.Context, m *OrganizationMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&LeadStatusHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&OrganizationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&LeadStatusHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&OrganizationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&LeadStatusHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&LeadStatusHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&OrganizationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown LeadStatusHistory mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Organization mutation op: %q", m.Op())
 	}
 }
 
-// MarketReportClient is a client for the MarketReport schema.
-type MarketReportClient struct {
+// OrganizationMemberClient is a client for the OrganizationMember schema.
+type OrganizationMemberClient struct {
 	config
 }
 
-// NewMarketReportClient returns a client for the MarketReport from the given config.
-func NewMarketReportClient(c config) *MarketReportClient {
-	return &MarketReportClient{config: c}
+// NewOrganizationMemberClient returns a client for the OrganizationMember from the given config.
+func NewOrganizationMemberClient(c config) *OrganizationMemberClient {
+	return &OrganizationMemberClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `marketreport.Hooks(f(g(h())))`.
-func (c *MarketReportClient) Use(hooks ...Hook) {
-	c.hooks.MarketReport = append(c.hooks.MarketReport, hooks...)
+// A call to `Use(f, g, h)` equals to `organizationmember.Hooks(f(g(h())))`.
+func (c *OrganizationMemberClient) Use(hooks ...Hook) {
+	c.hooks.OrganizationMember = append(c.hooks.OrganizationMember, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `marketreport.Intercept(f(g(h())))`.
-func (c *MarketReportClient) Intercept(interceptors ...Interceptor) {
-	c.inters.MarketReport = append(c.inters.MarketReport, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `organizationmember.Intercept(f(g(h())))`.
+func (c *OrganizationMemberClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OrganizationMember = append(c.inters.OrganizationMember, interceptors...)
 }
 
-// Create returns a builder for creating a MarketReport entity.
-func (c *MarketReportClient) Create() *MarketReportCreate {
-	mutation := newMarketReportMutation(c.config, OpCreate)
-	return &MarketReportCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a OrganizationMember entity.
+func (c *OrganizationMemberClient) Create() *OrganizationMemberCreate {
+	mutation := newOrganizationMemberMutation(c.config, OpCreate)
+	return &OrganizationMemberCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of MarketReport entities.
-func (c *MarketReportClient) CreateBulk(builders ...*MarketReportCreate) *MarketReportCreateBulk {
-	return &MarketReportCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of OrganizationMember entities.
+func (c *OrganizationMemberClient) CreateBulk(builders ...*OrganizationMemberCreate) *OrganizationMemberCreateBulk {
+	return &OrganizationMemberCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MarketReportClient) MapCreateBulk(slice any, setFunc func(*MarketReportCreate, int)) *MarketReportCreateBulk {
+func (c *OrganizationMemberClient) MapCreateBulk(slice any, setFunc func(*OrganizationMemberCreate, int)) *OrganizationMemberCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MarketReportCreateBulk{err: fmt.Errorf("calling to MarketReportClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &OrganizationMemberCreateBulk{err: fmt.Errorf("calling to OrganizationMemberClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MarketReportCreate, rv.Len())
+	builders := make([]*OrganizationMemberCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MarketReportCreateBulk{config: c.config, builders: builders}
+	return &OrganizationMemberCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for MarketReport.
-func (c *MarketReportClient) Update() *MarketReportUpdate {
-	mutation := newMarketReportMutation(c.config, OpUpdate)
-	return &MarketReportUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for OrganizationMember.
+func (c *OrganizationMemberClient) Update() *OrganizationMemberUpdate {
+	mutation := newOrganizationMemberMutation(c.config, OpUpdate)
+	return &OrganizationMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MarketReportClient) UpdateOne(_m *MarketReport) *MarketReportUpdateOne {
-	mutation := newMarketReportMutation(c.config, OpUpdateOne, withMarketReport(_m))
-	return &MarketReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *OrganizationMemberClient) UpdateOne(_m *OrganizationMember) *OrganizationMemberUpdateOne {
+	mutation := newOrganizationMemberMutation(c.config, OpUpdateOne, withOrganizationMember(_m))
+	return &OrganizationMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MarketReportClient) UpdateOneID(id int) *MarketReportUpdateOne {
-	mutation := newMarketReportMutation(c.config, OpUpdateOne, withMarketReportID(id))
-	return &MarketReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *OrganizationMemberClient) UpdateOneID(id int) *OrganizationMemberUpdateOne {
+	mutation := newOrganizationMemberMutation(c.config, OpUpdateOne, withOrganizationMemberID(id))
+	return &OrganizationMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for MarketReport.
-func (c *MarketReportClient) Delete() *MarketReportDelete {
-	mutation := newMarketReportMutation(c.config, OpDelete)
-	return &MarketReportDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for OrganizationMember.
+func (c *OrganizationMemberClient) Delete() *OrganizationMemberDelete {
+	mutation := newOrganizationMemberMutation(c.config, OpDelete)
+	return &OrganizationMemberDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *MarketReportClient) DeleteOne(_m *MarketReport) *MarketReportDeleteOne {
+func (c *OrganizationMemberClient) DeleteOne(_m *OrganizationMember) *OrganizationMemberDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *MarketReportClient) DeleteOneID(id int) *MarketReportDeleteOne {
-	builder := c.Delete().Where(marketreport.ID(id))
+func (c *OrganizationMemberClient) DeleteOneID(id int) *OrganizationMemberDeleteOne {
+	builder := c.Delete().Where(organizationmember.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &MarketReportDeleteOne{builder}
+	return &OrganizationMemberDeleteOne{builder}
 }
 
-// Query returns a query builder for MarketReport.
-func (c *MarketReportClient) Query() *MarketReportQuery {
-	return &MarketReportQuery{
+// Query returns a query builder for OrganizationMember.
+func (c *OrganizationMemberClient) Query() *OrganizationMemberQuery {
+	return &OrganizationMemberQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeMarketReport},
+		ctx:    &QueryContext{Type: TypeOrganizationMember},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a MarketReport entity by its id.
-func (c *MarketReportClient) Get(ctx context.Context, id int) (*MarketReport, error) {
-	return c.Query().Where(marketreport.ID(id)).Only(ctx)
+// Get returns a OrganizationMember entity by its id.
+func (c *OrganizationMemberClient) Get(ctx context.Context, id int) (*OrganizationMember, error) {
+	return c.Query().Where(organizationmember.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *MarketReportClient) GetX(ctx context.Context, id int) *MarketReport {
+func (c *OrganizationMemberClient) GetX(ctx context.Context, id int) *OrganizationMember {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -4822,15 +7776,31 @@ func (c *MarketReportClient) GetX(ctx context.Context, id int) *MarketReport {
 	return obj
 }
 
-// QueryUser queries the user edge of a MarketReport.
-func (c *MarketReportClient) QueryUser(_m *MarketReport) *UserQuery {
+// QueryOrganization queries the organization edge of a OrganizationMember.
+func (c *OrganizationMemberClient) QueryOrganization(_m *OrganizationMember) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organizationmember.Table, organizationmember.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, organizationmember.OrganizationTable, organizationmember.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a OrganizationMember.
+func (c *OrganizationMemberClient) QueryUser(_m *OrganizationMember) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(marketreport.Table, marketreport.FieldID, id),
+			sqlgraph.From(organizationmember.Table, organizationmember.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, marketreport.UserTable, marketreport.UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, organizationmember.UserTable, organizationmember.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -4839,131 +7809,131 @@ func (c *MarketReportClient) QueryUser(_m *MarketReport) *UserQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *MarketReportClient) Hooks() []Hook {
-	return c.hooks.MarketReport
+func (c *OrganizationMemberClient) Hooks() []Hook {
+	return c.hooks.OrganizationMember
 }
 
 // Interceptors returns the client interceptors.
-func (c *MarketReportClient) Interceptors() []Interceptor {
-	return c.inters.MarketReport
+func (c *OrganizationMemberClient) Interceptors() []Interceptor {
+	return c.inters.OrganizationMember
 }
 
-func (c *MarketReportClient) mutate(ctx context.Context, m *MarketReportMutation) (Value, error) {
+func (c *OrganizationMemberClient) mutate(ctx context.Context, m *OrganizationMemberMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&MarketReportCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&OrganizationMemberCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&MarketReportUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&OrganizationMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&MarketReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&OrganizationMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&MarketReportDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&OrganizationMemberDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown MarketReport mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown OrganizationMember mutation op: %q", m.Op())
 	}
 }
 
-// OrganizationClient is a client for the Organization schema.
-type OrganizationClient struct {
+// ReferralClient is a client for the Referral schema.
+type ReferralClient struct {
 	config
 }
 
-// NewOrganizationClient returns a client for the Organization from the given config.
-func NewOrganizationClient(c config) *OrganizationClient {
-	return &OrganizationClient{config: c}
+// NewReferralClient returns a client for the Referral from the given config.
+func NewReferralClient(c config) *ReferralClient {
+	return &ReferralClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `organization.Hooks(f(g(h())))`.
-func (c *OrganizationClient) Use(hooks ...Hook) {
-	c.hooks.Organization = append(c.hooks.Organization, hooks...)
+// A call to `Use(f, g, h)` equals to `referral.Hooks(f(g(h())))`.
+func (c *ReferralClient) Use(hooks ...Hook) {
+	c.hooks.Referral = append(c.hooks.Referral, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `organization.Intercept(f(g(h())))`.
-func (c *OrganizationClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Organization = append(c.inters.Organization, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `referral.Intercept(f(g(h())))`.
+func (c *ReferralClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Referral = append(c.inters.Referral, interceptors...)
 }
 
-// Create returns a builder for creating a Organization entity.
-func (c *OrganizationClient) Create() *OrganizationCreate {
-	mutation := newOrganizationMutation(c.config, OpCreate)
-	return &OrganizationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Referral entity.
+func (c *ReferralClient) Create() *ReferralCreate {
+	mutation := newReferralMutation(c.config, OpCreate)
+	return &ReferralCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Organization entities.
-func (c *OrganizationClient) CreateBulk(builders ...*OrganizationCreate) *OrganizationCreateBulk {
-	return &OrganizationCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Referral entities.
+func (c *ReferralClient) CreateBulk(builders ...*ReferralCreate) *ReferralCreateBulk {
+	return &ReferralCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *OrganizationClient) MapCreateBulk(slice any, setFunc func(*OrganizationCreate, int)) *OrganizationCreateBulk {
+func (c *ReferralClient) MapCreateBulk(slice any, setFunc func(*ReferralCreate, int)) *ReferralCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &OrganizationCreateBulk{err: fmt.Errorf("calling to OrganizationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ReferralCreateBulk{err: fmt.Errorf("calling to ReferralClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*OrganizationCreate, rv.Len())
+	builders := make([]*ReferralCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &OrganizationCreateBulk{config: c.config, builders: builders}
+	return &ReferralCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Organization.
-func (c *OrganizationClient) Update() *OrganizationUpdate {
-	mutation := newOrganizationMutation(c.config, OpUpdate)
-	return &OrganizationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Referral.
+func (c *ReferralClient) Update() *ReferralUpdate {
+	mutation := newReferralMutation(c.config, OpUpdate)
+	return &ReferralUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *OrganizationClient) UpdateOne(_m *Organization) *OrganizationUpdateOne {
-	mutation := newOrganizationMutation(c.config, OpUpdateOne, withOrganization(_m))
-	return &OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ReferralClient) UpdateOne(_m *Referral) *ReferralUpdateOne {
+	mutation := newReferralMutation(c.config, OpUpdateOne, withReferral(_m))
+	return &ReferralUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *OrganizationClient) UpdateOneID(id int) *OrganizationUpdateOne {
-	mutation := newOrganizationMutation(c.config, OpUpdateOne, withOrganizationID(id))
-	return &OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ReferralClient) UpdateOneID(id int) *ReferralUpdateOne {
+	mutation := newReferralMutation(c.config, OpUpdateOne, withReferralID(id))
+	return &ReferralUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Organization.
-func (c *OrganizationClient) Delete() *OrganizationDelete {
-	mutation := newOrganizationMutation(c.config, OpDelete)
-	return &OrganizationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Referral.
+func (c *ReferralClient) Delete() *ReferralDelete {
+	mutation := newReferralMutation(c.config, OpDelete)
+	return &ReferralDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *OrganizationClient) DeleteOne(_m *Organization) *OrganizationDeleteOne {
+func (c *ReferralClient) DeleteOne(_m *Referral) *ReferralDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *OrganizationClient) DeleteOneID(id int) *OrganizationDeleteOne {
-	builder := c.Delete().Where(organization.ID(id))
+func (c *ReferralClient) DeleteOneID(id int) *ReferralDeleteOne {
+	builder := c.Delete().Where(referral.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &OrganizationDeleteOne{builder}
+	return &ReferralDeleteOne{builder}
 }
 
-// Query returns a query builder for Organization.
-func (c *OrganizationClient) Query() *OrganizationQuery {
-	return &OrganizationQuery{
+// Query returns a query builder for Referral.
+func (c *ReferralClient) Query() *ReferralQuery {
+	return &ReferralQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeOrganization},
+		ctx:    &QueryContext{Type: TypeReferral},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Organization entity by its id.
-func (c *OrganizationClient) Get(ctx context.Context, id int) (*Organization, error) {
-	return c.Query().Where(organization.ID(id)).Only(ctx)
+// Get returns a Referral entity by its id.
+func (c *ReferralClient) Get(ctx context.Context, id int) (*Referral, error) {
+	return c.Query().Where(referral.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *OrganizationClient) GetX(ctx context.Context, id int) *Organization {
+func (c *ReferralClient) GetX(ctx context.Context, id int) *Referral {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -4971,31 +7941,15 @@ func (c *OrganizationClient) GetX(ctx context.Context, id int) *Organization {
 	return obj
 }
 
-// QueryOwner queries the owner edge of a Organization.
-func (c *OrganizationClient) QueryOwner(_m *Organization) *UserQuery {
+// QueryReferrer queries the referrer edge of a Referral.
+func (c *ReferralClient) QueryReferrer(_m *Referral) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.From(referral.Table, referral.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, organization.OwnerTable, organization.OwnerColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryMembers queries the members edge of a Organization.
-func (c *OrganizationClient) QueryMembers(_m *Organization) *OrganizationMemberQuery {
-	query := (&OrganizationMemberClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(organization.Table, organization.FieldID, id),
-			sqlgraph.To(organizationmember.Table, organizationmember.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, organization.MembersTable, organization.MembersColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, referral.ReferrerTable, referral.ReferrerColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -5003,15 +7957,15 @@ func (c *OrganizationClient) QueryMembers(_m *Organization) *OrganizationMemberQ
 	return query
 }
 
-// QueryExports queries the exports edge of a Organization.
-func (c *OrganizationClient) QueryExports(_m *Organization) *ExportQuery {
-	query := (&ExportClient{config: c.config}).Query()
+// QueryReferred queries the referred edge of a Referral.
+func (c *ReferralClient) QueryReferred(_m *Referral) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(organization.Table, organization.FieldID, id),
-			sqlgraph.To(export.Table, export.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, organization.ExportsTable, organization.ExportsColumn),
+			sqlgraph.From(referral.Table, referral.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, referral.ReferredTable, referral.ReferredColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -5020,131 +7974,131 @@ func (c *OrganizationClient) QueryExports(_m *Organization) *ExportQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *OrganizationClient) Hooks() []Hook {
-	return c.hooks.Organization
+func (c *ReferralClient) Hooks() []Hook {
+	return c.hooks.Referral
 }
 
 // Interceptors returns the client interceptors.
-func (c *OrganizationClient) Interceptors() []Interceptor {
-	return c.inters.Organization
+func (c *ReferralClient) Interceptors() []Interceptor {
+	return c.inters.Referral
 }
 
-func (c *OrganizationClient) mutate(ctx context.Context, m *OrganizationMutation) (Value, error) {
+func (c *ReferralClient) mutate(ctx context.Context, m *ReferralMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&OrganizationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReferralCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&OrganizationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReferralUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReferralUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&OrganizationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ReferralDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Organization mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Referral mutation op: %q", m.Op())
 	}
 }
 
-// OrganizationMemberClient is a client for the OrganizationMember schema.
-type OrganizationMemberClient struct {
+// SMSCampaignClient is a client for the SMSCampaign schema.
+type SMSCampaignClient struct {
 	config
 }
 
-// NewOrganizationMemberClient returns a client for the OrganizationMember from the given config.
-func NewOrganizationMemberClient(c config) *OrganizationMemberClient {
-	return &OrganizationMemberClient{config: c}
+// NewSMSCampaignClient returns a client for the SMSCampaign from the given config.
+func NewSMSCampaignClient(c config) *SMSCampaignClient {
+	return &SMSCampaignClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `organizationmember.Hooks(f(g(h())))`.
-func (c *OrganizationMemberClient) Use(hooks ...Hook) {
-	c.hooks.OrganizationMember = append(c.hooks.OrganizationMember, hooks...)
+// A call to `Use(f, g, h)` equals to `smscampaign.Hooks(f(g(h())))`.
+func (c *SMSCampaignClient) Use(hooks ...Hook) {
+	c.hooks.SMSCampaign = append(c.hooks.SMSCampaign, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `organizationmember.Intercept(f(g(h())))`.
-func (c *OrganizationMemberClient) Intercept(interceptors ...Interceptor) {
-	c.inters.OrganizationMember = append(c.inters.OrganizationMember, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `smscampaign.Intercept(f(g(h())))`.
+func (c *SMSCampaignClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SMSCampaign = append(c.inters.SMSCampaign, interceptors...)
 }
 
-// Create returns a builder for creating a OrganizationMember entity.
-func (c *OrganizationMemberClient) Create() *OrganizationMemberCreate {
-	mutation := newOrganizationMemberMutation(c.config, OpCreate)
-	return &OrganizationMemberCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a SMSCampaign entity.
+func (c *SMSCampaignClient) Create() *SMSCampaignCreate {
+	mutation := newSMSCampaignMutation(c.config, OpCreate)
+	return &SMSCampaignCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of OrganizationMember entities.
-func (c *OrganizationMemberClient) CreateBulk(builders ...*OrganizationMemberCreate) *OrganizationMemberCreateBulk {
-	return &OrganizationMemberCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of SMSCampaign entities.
+func (c *SMSCampaignClient) CreateBulk(builders ...*SMSCampaignCreate) *SMSCampaignCreateBulk {
+	return &SMSCampaignCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *OrganizationMemberClient) MapCreateBulk(slice any, setFunc func(*OrganizationMemberCreate, int)) *OrganizationMemberCreateBulk {
+func (c *SMSCampaignClient) MapCreateBulk(slice any, setFunc func(*SMSCampaignCreate, int)) *SMSCampaignCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &OrganizationMemberCreateBulk{err: fmt.Errorf("calling to OrganizationMemberClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SMSCampaignCreateBulk{err: fmt.Errorf("calling to SMSCampaignClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*OrganizationMemberCreate, rv.Len())
+	builders := make([]*SMSCampaignCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &OrganizationMemberCreateBulk{config: c.config, builders: builders}
+	return &SMSCampaignCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for OrganizationMember.
-func (c *OrganizationMemberClient) Update() *OrganizationMemberUpdate {
-	mutation := newOrganizationMemberMutation(c.config, OpUpdate)
-	return &OrganizationMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for SMSCampaign.
+func (c *SMSCampaignClient) Update() *SMSCampaignUpdate {
+	mutation := newSMSCampaignMutation(c.config, OpUpdate)
+	return &SMSCampaignUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *OrganizationMemberClient) UpdateOne(_m *OrganizationMember) *OrganizationMemberUpdateOne {
-	mutation := newOrganizationMemberMutation(c.config, OpUpdateOne, withOrganizationMember(_m))
-	return &OrganizationMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SMSCampaignClient) UpdateOne(_m *SMSCampaign) *SMSCampaignUpdateOne {
+	mutation := newSMSCampaignMutation(c.config, OpUpdateOne, withSMSCampaign(_m))
+	return &SMSCampaignUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *OrganizationMemberClient) UpdateOneID(id int) *OrganizationMemberUpdateOne {
-	mutation := newOrganizationMemberMutation(c.config, OpUpdateOne, withOrganizationMemberID(id))
-	return &OrganizationMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SMSCampaignClient) UpdateOneID(id int) *SMSCampaignUpdateOne {
+	mutation := newSMSCampaignMutation(c.config, OpUpdateOne, withSMSCampaignID(id))
+	return &SMSCampaignUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for OrganizationMember.
-func (c *OrganizationMemberClient) Delete() *OrganizationMemberDelete {
-	mutation := newOrganizationMemberMutation(c.config, OpDelete)
-	return &OrganizationMemberDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SMSCampaign.
+func (c *SMSCampaignClient) Delete() *SMSCampaignDelete {
+	mutation := newSMSCampaignMutation(c.config, OpDelete)
+	return &SMSCampaignDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *OrganizationMemberClient) DeleteOne(_m *OrganizationMember) *OrganizationMemberDeleteOne {
+func (c *SMSCampaignClient) DeleteOne(_m *SMSCampaign) *SMSCampaignDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *OrganizationMemberClient) DeleteOneID(id int) *OrganizationMemberDeleteOne {
-	builder := c.Delete().Where(organizationmember.ID(id))
+func (c *SMSCampaignClient) DeleteOneID(id int) *SMSCampaignDeleteOne {
+	builder := c.Delete().Where(smscampaign.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &OrganizationMemberDeleteOne{builder}
+	return &SMSCampaignDeleteOne{builder}
 }
 
-// Query returns a query builder for OrganizationMember.
-func (c *OrganizationMemberClient) Query() *OrganizationMemberQuery {
-	return &OrganizationMemberQuery{
+// Query returns a query builder for SMSCampaign.
+func (c *SMSCampaignClient) Query() *SMSCampaignQuery {
+	return &SMSCampaignQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeOrganizationMember},
+		ctx:    &QueryContext{Type: TypeSMSCampaign},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a OrganizationMember entity by its id.
-func (c *OrganizationMemberClient) Get(ctx context.Context, id int) (*OrganizationMember, error) {
-	return c.Query().Where(organizationmember.ID(id)).Only(ctx)
+// Get returns a SMSCampaign entity by its id.
+func (c *SMSCampaignClient) Get(ctx context.Context, id int) (*SMSCampaign, error) {
+	return c.Query().Where(smscampaign.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *OrganizationMemberClient) GetX(ctx context.Context, id int) *OrganizationMember {
+func (c *SMSCampaignClient) GetX(ctx context.Context, id int) *SMSCampaign {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -5152,15 +8106,15 @@ func (c *OrganizationMemberClient) GetX(ctx context.Context, id int) *Organizati
 	return obj
 }
 
-// QueryOrganization queries the organization edge of a OrganizationMember.
-func (c *OrganizationMemberClient) QueryOrganization(_m *OrganizationMember) *OrganizationQuery {
-	query := (&OrganizationClient{config: c.config}).Query()
+// QueryUser queries the user edge of a SMSCampaign.
+func (c *SMSCampaignClient) QueryUser(_m *SMSCampaign) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(organizationmember.Table, organizationmember.FieldID, id),
-			sqlgraph.To(organization.Table, organization.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, organizationmember.OrganizationTable, organizationmember.OrganizationColumn),
+			sqlgraph.From(smscampaign.Table, smscampaign.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, smscampaign.UserTable, smscampaign.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -5168,15 +8122,15 @@ func (c *OrganizationMemberClient) QueryOrganization(_m *OrganizationMember) *Or
 	return query
 }
 
-// QueryUser queries the user edge of a OrganizationMember.
-func (c *OrganizationMemberClient) QueryUser(_m *OrganizationMember) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryMessages queries the messages edge of a SMSCampaign.
+func (c *SMSCampaignClient) QueryMessages(_m *SMSCampaign) *SMSMessageQuery {
+	query := (&SMSMessageClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(organizationmember.Table, organizationmember.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, organizationmember.UserTable, organizationmember.UserColumn),
+			sqlgraph.From(smscampaign.Table, smscampaign.FieldID, id),
+			sqlgraph.To(smsmessage.Table, smsmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, smscampaign.MessagesTable, smscampaign.MessagesColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -5185,131 +8139,131 @@ func (c *OrganizationMemberClient) QueryUser(_m *OrganizationMember) *UserQuery
 }
 
 // Hooks returns the client hooks.
-func (c *OrganizationMemberClient) Hooks() []Hook {
-	return c.hooks.OrganizationMember
+func (c *SMSCampaignClient) Hooks() []Hook {
+	return c.hooks.SMSCampaign
 }
 
 // Interceptors returns the client interceptors.
-func (c *OrganizationMemberClient) Interceptors() []Interceptor {
-	return c.inters.OrganizationMember
+func (c *SMSCampaignClient) Interceptors() []Interceptor {
+	return c.inters.SMSCampaign
 }
 
-func (c *OrganizationMemberClient) mutate(ctx context.Context, m *OrganizationMemberMutation) (Value, error) {
+func (c *SMSCampaignClient) mutate(ctx context.Context, m *SMSCampaignMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&OrganizationMemberCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SMSCampaignCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&OrganizationMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SMSCampaignUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&OrganizationMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SMSCampaignUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&OrganizationMemberDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SMSCampaignDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown OrganizationMember mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SMSCampaign mutation op: %q", m.Op())
 	}
 }
 
-// ReferralClient is a client for the Referral schema.
-type ReferralClient struct {
+// SMSMessageClient is a client for the SMSMessage schema.
+type SMSMessageClient struct {
 	config
 }
 
-// NewReferralClient returns a client for the Referral from the given config.
-func NewReferralClient(c config) *ReferralClient {
-	return &ReferralClient{config: c}
+// NewSMSMessageClient returns a client for the SMSMessage from the given config.
+func NewSMSMessageClient(c config) *SMSMessageClient {
+	return &SMSMessageClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `referral.Hooks(f(g(h())))`.
-func (c *ReferralClient) Use(hooks ...Hook) {
-	c.hooks.Referral = append(c.hooks.Referral, hooks...)
+// A call to `Use(f, g, h)` equals to `smsmessage.Hooks(f(g(h())))`.
+func (c *SMSMessageClient) Use(hooks ...Hook) {
+	c.hooks.SMSMessage = append(c.hooks.SMSMessage, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `referral.Intercept(f(g(h())))`.
-func (c *ReferralClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Referral = append(c.inters.Referral, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `smsmessage.Intercept(f(g(h())))`.
+func (c *SMSMessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SMSMessage = append(c.inters.SMSMessage, interceptors...)
 }
 
-// Create returns a builder for creating a Referral entity.
-func (c *ReferralClient) Create() *ReferralCreate {
-	mutation := newReferralMutation(c.config, OpCreate)
-	return &ReferralCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a SMSMessage entity.
+func (c *SMSMessageClient) Create() *SMSMessageCreate {
+	mutation := newSMSMessageMutation(c.config, OpCreate)
+	return &SMSMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Referral entities.
-func (c *ReferralClient) CreateBulk(builders ...*ReferralCreate) *ReferralCreateBulk {
-	return &ReferralCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of SMSMessage entities.
+func (c *SMSMessageClient) CreateBulk(builders ...*SMSMessageCreate) *SMSMessageCreateBulk {
+	return &SMSMessageCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ReferralClient) MapCreateBulk(slice any, setFunc func(*ReferralCreate, int)) *ReferralCreateBulk {
+func (c *SMSMessageClient) MapCreateBulk(slice any, setFunc func(*SMSMessageCreate, int)) *SMSMessageCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ReferralCreateBulk{err: fmt.Errorf("calling to ReferralClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SMSMessageCreateBulk{err: fmt.Errorf("calling to SMSMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ReferralCreate, rv.Len())
+	builders := make([]*SMSMessageCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ReferralCreateBulk{config: c.config, builders: builders}
+	return &SMSMessageCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Referral.
-func (c *ReferralClient) Update() *ReferralUpdate {
-	mutation := newReferralMutation(c.config, OpUpdate)
-	return &ReferralUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for SMSMessage.
+func (c *SMSMessageClient) Update() *SMSMessageUpdate {
+	mutation := newSMSMessageMutation(c.config, OpUpdate)
+	return &SMSMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ReferralClient) UpdateOne(_m *Referral) *ReferralUpdateOne {
-	mutation := newReferralMutation(c.config, OpUpdateOne, withReferral(_m))
-	return &ReferralUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SMSMessageClient) UpdateOne(_m *SMSMessage) *SMSMessageUpdateOne {
+	mutation := newSMSMessageMutation(c.config, OpUpdateOne, withSMSMessage(_m))
+	return &SMSMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ReferralClient) UpdateOneID(id int) *ReferralUpdateOne {
-	mutation := newReferralMutation(c.config, OpUpdateOne, withReferralID(id))
-	return &ReferralUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SMSMessageClient) UpdateOneID(id int) *SMSMessageUpdateOne {
+	mutation := newSMSMessageMutation(c.config, OpUpdateOne, withSMSMessageID(id))
+	return &SMSMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Referral.
-func (c *ReferralClient) Delete() *ReferralDelete {
-	mutation := newReferralMutation(c.config, OpDelete)
-	return &ReferralDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SMSMessage.
+func (c *SMSMessageClient) Delete() *SMSMessageDelete {
+	mutation := newSMSMessageMutation(c.config, OpDelete)
+	return &SMSMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ReferralClient) DeleteOne(_m *Referral) *ReferralDeleteOne {
+func (c *SMSMessageClient) DeleteOne(_m *SMSMessage) *SMSMessageDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ReferralClient) DeleteOneID(id int) *ReferralDeleteOne {
-	builder := c.Delete().Where(referral.ID(id))
+func (c *SMSMessageClient) DeleteOneID(id int) *SMSMessageDeleteOne {
+	builder := c.Delete().Where(smsmessage.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ReferralDeleteOne{builder}
+	return &SMSMessageDeleteOne{builder}
 }
 
-// Query returns a query builder for Referral.
-func (c *ReferralClient) Query() *ReferralQuery {
-	return &ReferralQuery{
+// Query returns a query builder for SMSMessage.
+func (c *SMSMessageClient) Query() *SMSMessageQuery {
+	return &SMSMessageQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeReferral},
+		ctx:    &QueryContext{Type: TypeSMSMessage},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Referral entity by its id.
-func (c *ReferralClient) Get(ctx context.Context, id int) (*Referral, error) {
-	return c.Query().Where(referral.ID(id)).Only(ctx)
+// Get returns a SMSMessage entity by its id.
+func (c *SMSMessageClient) Get(ctx context.Context, id int) (*SMSMessage, error) {
+	return c.Query().Where(smsmessage.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ReferralClient) GetX(ctx context.Context, id int) *Referral {
+func (c *SMSMessageClient) GetX(ctx context.Context, id int) *SMSMessage {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -5317,15 +8271,15 @@ func (c *ReferralClient) GetX(ctx context.Context, id int) *Referral {
 	return obj
 }
 
-// QueryReferrer queries the referrer edge of a Referral.
-func (c *ReferralClient) QueryReferrer(_m *Referral) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryCampaign queries the campaign edge of a SMSMessage.
+func (c *SMSMessageClient) QueryCampaign(_m *SMSMessage) *SMSCampaignQuery {
+	query := (&SMSCampaignClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(referral.Table, referral.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, referral.ReferrerTable, referral.ReferrerColumn),
+			sqlgraph.From(smsmessage.Table, smsmessage.FieldID, id),
+			sqlgraph.To(smscampaign.Table, smscampaign.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, smsmessage.CampaignTable, smsmessage.CampaignColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -5333,15 +8287,15 @@ func (c *ReferralClient) QueryReferrer(_m *Referral) *UserQuery {
 	return query
 }
 
-// QueryReferred queries the referred edge of a Referral.
-func (c *ReferralClient) QueryReferred(_m *Referral) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryLead queries the lead edge of a SMSMessage.
+func (c *SMSMessageClient) QueryLead(_m *SMSMessage) *LeadQuery {
+	query := (&LeadClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(referral.Table, referral.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, referral.ReferredTable, referral.ReferredColumn),
+			sqlgraph.From(smsmessage.Table, smsmessage.FieldID, id),
+			sqlgraph.To(lead.Table, lead.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, smsmessage.LeadTable, smsmessage.LeadColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -5350,131 +8304,131 @@ func (c *ReferralClient) QueryReferred(_m *Referral) *UserQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *ReferralClient) Hooks() []Hook {
-	return c.hooks.Referral
+func (c *SMSMessageClient) Hooks() []Hook {
+	return c.hooks.SMSMessage
 }
 
 // Interceptors returns the client interceptors.
-func (c *ReferralClient) Interceptors() []Interceptor {
-	return c.inters.Referral
+func (c *SMSMessageClient) Interceptors() []Interceptor {
+	return c.inters.SMSMessage
 }
 
-func (c *ReferralClient) mutate(ctx context.Context, m *ReferralMutation) (Value, error) {
+func (c *SMSMessageClient) mutate(ctx context.Context, m *SMSMessageMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ReferralCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SMSMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ReferralUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SMSMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ReferralUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SMSMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ReferralDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SMSMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Referral mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SMSMessage mutation op: %q", m.Op())
 	}
 }
 
-// SMSCampaignClient is a client for the SMSCampaign schema.
-type SMSCampaignClient struct {
+// SavedSearchClient is a client for the SavedSearch schema.
+type SavedSearchClient struct {
 	config
 }
 
-// NewSMSCampaignClient returns a client for the SMSCampaign from the given config.
-func NewSMSCampaignClient(c config) *SMSCampaignClient {
-	return &SMSCampaignClient{config: c}
+// NewSavedSearchClient returns a client for the SavedSearch from the given config.
+func NewSavedSearchClient(c config) *SavedSearchClient {
+	return &SavedSearchClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `smscampaign.Hooks(f(g(h())))`.
-func (c *SMSCampaignClient) Use(hooks ...Hook) {
-	c.hooks.SMSCampaign = append(c.hooks.SMSCampaign, hooks...)
+// A call to `Use(f, g, h)` equals to `savedsearch.Hooks(f(g(h())))`.
+func (c *SavedSearchClient) Use(hooks ...Hook) {
+	c.hooks.SavedSearch = append(c.hooks.SavedSearch, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `smscampaign.Intercept(f(g(h())))`.
-func (c *SMSCampaignClient) Intercept(interceptors ...Interceptor) {
-	c.inters.SMSCampaign = append(c.inters.SMSCampaign, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `savedsearch.Intercept(f(g(h())))`.
+func (c *SavedSearchClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SavedSearch = append(c.inters.SavedSearch, interceptors...)
 }
 
-// Create returns a builder for creating a SMSCampaign entity.
-func (c *SMSCampaignClient) Create() *SMSCampaignCreate {
-	mutation := newSMSCampaignMutation(c.config, OpCreate)
-	return &SMSCampaignCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a SavedSearch entity.
+func (c *SavedSearchClient) Create() *SavedSearchCreate {
+	mutation := newSavedSearchMutation(c.config, OpCreate)
+	return &SavedSearchCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of SMSCampaign entities.
-func (c *SMSCampaignClient) CreateBulk(builders ...*SMSCampaignCreate) *SMSCampaignCreateBulk {
-	return &SMSCampaignCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of SavedSearch entities.
+func (c *SavedSearchClient) CreateBulk(builders ...*SavedSearchCreate) *SavedSearchCreateBulk {
+	return &SavedSearchCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *SMSCampaignClient) MapCreateBulk(slice any, setFunc func(*SMSCampaignCreate, int)) *SMSCampaignCreateBulk {
+func (c *SavedSearchClient) MapCreateBulk(slice any, setFunc func(*SavedSearchCreate, int)) *SavedSearchCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &SMSCampaignCreateBulk{err: fmt.Errorf("calling to SMSCampaignClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SavedSearchCreateBulk{err: fmt.Errorf("calling to SavedSearchClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*SMSCampaignCreate, rv.Len())
+	builders := make([]*SavedSearchCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &SMSCampaignCreateBulk{config: c.config, builders: builders}
+	return &SavedSearchCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for SMSCampaign.
-func (c *SMSCampaignClient) Update() *SMSCampaignUpdate {
-	mutation := newSMSCampaignMutation(c.config, OpUpdate)
-	return &SMSCampaignUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for SavedSearch.
+func (c *SavedSearchClient) Update() *SavedSearchUpdate {
+	mutation := newSavedSearchMutation(c.config, OpUpdate)
+	return &SavedSearchUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *SMSCampaignClient) UpdateOne(_m *SMSCampaign) *SMSCampaignUpdateOne {
-	mutation := newSMSCampaignMutation(c.config, OpUpdateOne, withSMSCampaign(_m))
-	return &SMSCampaignUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SavedSearchClient) UpdateOne(_m *SavedSearch) *SavedSearchUpdateOne {
+	mutation := newSavedSearchMutation(c.config, OpUpdateOne, withSavedSearch(_m))
+	return &SavedSearchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SMSCampaignClient) UpdateOneID(id int) *SMSCampaignUpdateOne {
-	mutation := newSMSCampaignMutation(c.config, OpUpdateOne, withSMSCampaignID(id))
-	return &SMSCampaignUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SavedSearchClient) UpdateOneID(id int) *SavedSearchUpdateOne {
+	mutation := newSavedSearchMutation(c.config, OpUpdateOne, withSavedSearchID(id))
+	return &SavedSearchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for SMSCampaign.
-func (c *SMSCampaignClient) Delete() *SMSCampaignDelete {
-	mutation := newSMSCampaignMutation(c.config, OpDelete)
-	return &SMSCampaignDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SavedSearch.
+func (c *SavedSearchClient) Delete() *SavedSearchDelete {
+	mutation := newSavedSearchMutation(c.config, OpDelete)
+	return &SavedSearchDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *SMSCampaignClient) DeleteOne(_m *SMSCampaign) *SMSCampaignDeleteOne {
+func (c *SavedSearchClient) DeleteOne(_m *SavedSearch) *SavedSearchDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SMSCampaignClient) DeleteOneID(id int) *SMSCampaignDeleteOne {
-	builder := c.Delete().Where(smscampaign.ID(id))
+func (c *SavedSearchClient) DeleteOneID(id int) *SavedSearchDeleteOne {
+	builder := c.Delete().Where(savedsearch.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &SMSCampaignDeleteOne{builder}
+	return &SavedSearchDeleteOne{builder}
 }
 
-// Query returns a query builder for SMSCampaign.
-func (c *SMSCampaignClient) Query() *SMSCampaignQuery {
-	return &SMSCampaignQuery{
+// Query returns a query builder for SavedSearch.
+func (c *SavedSearchClient) Query() *SavedSearchQuery {
+	return &SavedSearchQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeSMSCampaign},
+		ctx:    &QueryContext{Type: TypeSavedSearch},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a SMSCampaign entity by its id.
-func (c *SMSCampaignClient) Get(ctx context.Context, id int) (*SMSCampaign, error) {
-	return c.Query().Where(smscampaign.ID(id)).Only(ctx)
+// Get returns a SavedSearch entity by its id.
+func (c *SavedSearchClient) Get(ctx context.Context, id int) (*SavedSearch, error) {
+	return c.Query().Where(savedsearch.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *SMSCampaignClient) GetX(ctx context.Context, id int) *SMSCampaign {
+func (c *SavedSearchClient) GetX(ctx context.Context, id int) *SavedSearch {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -5482,15 +8436,15 @@ func (c *SMSCampaignClient) GetX(ctx context.Context, id int) *SMSCampaign {
 	return obj
 }
 
-// QueryUser queries the user edge of a SMSCampaign.
-func (c *SMSCampaignClient) QueryUser(_m *SMSCampaign) *UserQuery {
+// QueryUser queries the user edge of a SavedSearch.
+func (c *SavedSearchClient) QueryUser(_m *SavedSearch) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(smscampaign.Table, smscampaign.FieldID, id),
+			sqlgraph.From(savedsearch.Table, savedsearch.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, smscampaign.UserTable, smscampaign.UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, savedsearch.UserTable, savedsearch.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -5498,15 +8452,47 @@ func (c *SMSCampaignClient) QueryUser(_m *SMSCampaign) *UserQuery {
 	return query
 }
 
-// QueryMessages queries the messages edge of a SMSCampaign.
-func (c *SMSCampaignClient) QueryMessages(_m *SMSCampaign) *SMSMessageQuery {
-	query := (&SMSMessageClient{config: c.config}).Query()
+// QuerySnapshots queries the snapshots edge of a SavedSearch.
+func (c *SavedSearchClient) QuerySnapshots(_m *SavedSearch) *SavedSearchSnapshotQuery {
+	query := (&SavedSearchSnapshotClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(smscampaign.Table, smscampaign.FieldID, id),
-			sqlgraph.To(smsmessage.Table, smsmessage.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, smscampaign.MessagesTable, smscampaign.MessagesColumn),
+			sqlgraph.From(savedsearch.Table, savedsearch.FieldID, id),
+			sqlgraph.To(savedsearchsnapshot.Table, savedsearchsnapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, savedsearch.SnapshotsTable, savedsearch.SnapshotsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryExportTemplates queries the export_templates edge of a SavedSearch.
+func (c *SavedSearchClient) QueryExportTemplates(_m *SavedSearch) *ExportTemplateQuery {
+	query := (&ExportTemplateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(savedsearch.Table, savedsearch.FieldID, id),
+			sqlgraph.To(exporttemplate.Table, exporttemplate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, savedsearch.ExportTemplatesTable, savedsearch.ExportTemplatesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryScheduledExports queries the scheduled_exports edge of a SavedSearch.
+func (c *SavedSearchClient) QueryScheduledExports(_m *SavedSearch) *ScheduledExportQuery {
+	query := (&ScheduledExportClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(savedsearch.Table, savedsearch.FieldID, id),
+			sqlgraph.To(scheduledexport.Table, scheduledexport.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, savedsearch.ScheduledExportsTable, savedsearch.ScheduledExportsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -5515,131 +8501,131 @@ func (c *SMSCampaignClient) QueryMessages(_m *SMSCampaign) *SMSMessageQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *SMSCampaignClient) Hooks() []Hook {
-	return c.hooks.SMSCampaign
+func (c *SavedSearchClient) Hooks() []Hook {
+	return c.hooks.SavedSearch
 }
 
 // Interceptors returns the client interceptors.
-func (c *SMSCampaignClient) Interceptors() []Interceptor {
-	return c.inters.SMSCampaign
+func (c *SavedSearchClient) Interceptors() []Interceptor {
+	return c.inters.SavedSearch
 }
 
-func (c *SMSCampaignClient) mutate(ctx context.Context, m *SMSCampaignMutation) (Value, error) {
+func (c *SavedSearchClient) mutate(ctx context.Context, m *SavedSearchMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SMSCampaignCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SavedSearchCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SMSCampaignUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SavedSearchUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SMSCampaignUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SavedSearchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SMSCampaignDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SavedSearchDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown SMSCampaign mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SavedSearch mutation op: %q", m.Op())
 	}
 }
 
-// SMSMessageClient is a client for the SMSMessage schema.
-type SMSMessageClient struct {
+// SavedSearchSnapshotClient is a client for the SavedSearchSnapshot schema.
+type SavedSearchSnapshotClient struct {
 	config
 }
 
-// NewSMSMessageClient returns a client for the SMSMessage from the given config.
-func NewSMSMessageClient(c config) *SMSMessageClient {
-	return &SMSMessageClient{config: c}
+// NewSavedSearchSnapshotClient returns a client for the SavedSearchSnapshot from the given config.
+func NewSavedSearchSnapshotClient(c config) *SavedSearchSnapshotClient {
+	return &SavedSearchSnapshotClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `smsmessage.Hooks(f(g(h())))`.
-func (c *SMSMessageClient) Use(hooks ...Hook) {
-	c.hooks.SMSMessage = append(c.hooks.SMSMessage, hooks...)
+// A call to `Use(f, g, h)` equals to `savedsearchsnapshot.Hooks(f(g(h())))`.
+func (c *SavedSearchSnapshotClient) Use(hooks ...Hook) {
+	c.hooks.SavedSearchSnapshot = append(c.hooks.SavedSearchSnapshot, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `smsmessage.Intercept(f(g(h())))`.
-func (c *SMSMessageClient) Intercept(interceptors ...Interceptor) {
-	c.inters.SMSMessage = append(c.inters.SMSMessage, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `savedsearchsnapshot.Intercept(f(g(h())))`.
+func (c *SavedSearchSnapshotClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SavedSearchSnapshot = append(c.inters.SavedSearchSnapshot, interceptors...)
 }
 
-// Create returns a builder for creating a SMSMessage entity.
-func (c *SMSMessageClient) Create() *SMSMessageCreate {
-	mutation := newSMSMessageMutation(c.config, OpCreate)
-	return &SMSMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a SavedSearchSnapshot entity.
+func (c *SavedSearchSnapshotClient) Create() *SavedSearchSnapshotCreate {
+	mutation := newSavedSearchSnapshotMutation(c.config, OpCreate)
+	return &SavedSearchSnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of SMSMessage entities.
-func (c *SMSMessageClient) CreateBulk(builders ...*SMSMessageCreate) *SMSMessageCreateBulk {
-	return &SMSMessageCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of SavedSearchSnapshot entities.
+func (c *SavedSearchSnapshotClient) CreateBulk(builders ...*SavedSearchSnapshotCreate) *SavedSearchSnapshotCreateBulk {
+	return &SavedSearchSnapshotCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *SMSMessageClient) MapCreateBulk(slice any, setFunc func(*SMSMessageCreate, int)) *SMSMessageCreateBulk {
+func (c *SavedSearchSnapshotClient) MapCreateBulk(slice any, setFunc func(*SavedSearchSnapshotCreate, int)) *SavedSearchSnapshotCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &SMSMessageCreateBulk{err: fmt.Errorf("calling to SMSMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SavedSearchSnapshotCreateBulk{err: fmt.Errorf("calling to SavedSearchSnapshotClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*SMSMessageCreate, rv.Len())
+	builders := make([]*SavedSearchSnapshotCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &SMSMessageCreateBulk{config: c.config, builders: builders}
+	return &SavedSearchSnapshotCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for SMSMessage.
-func (c *SMSMessageClient) Update() *SMSMessageUpdate {
-	mutation := newSMSMessageMutation(c.config, OpUpdate)
-	return &SMSMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for SavedSearchSnapshot.
+func (c *SavedSearchSnapshotClient) Update() *SavedSearchSnapshotUpdate {
+	mutation := newSavedSearchSnapshotMutation(c.config, OpUpdate)
+	return &SavedSearchSnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *SMSMessageClient) UpdateOne(_m *SMSMessage) *SMSMessageUpdateOne {
-	mutation := newSMSMessageMutation(c.config, OpUpdateOne, withSMSMessage(_m))
-	return &SMSMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SavedSearchSnapshotClient) UpdateOne(_m *SavedSearchSnapshot) *SavedSearchSnapshotUpdateOne {
+	mutation := newSavedSearchSnapshotMutation(c.config, OpUpdateOne, withSavedSearchSnapshot(_m))
+	return &SavedSearchSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SMSMessageClient) UpdateOneID(id int) *SMSMessageUpdateOne {
-	mutation := newSMSMessageMutation(c.config, OpUpdateOne, withSMSMessageID(id))
-	return &SMSMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SavedSearchSnapshotClient) UpdateOneID(id int) *SavedSearchSnapshotUpdateOne {
+	mutation := newSavedSearchSnapshotMutation(c.config, OpUpdateOne, withSavedSearchSnapshotID(id))
+	return &SavedSearchSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for SMSMessage.
-func (c *SMSMessageClient) Delete() *SMSMessageDelete {
-	mutation := newSMSMessageMutation(c.config, OpDelete)
-	return &SMSMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SavedSearchSnapshot.
+func (c *SavedSearchSnapshotClient) Delete() *SavedSearchSnapshotDelete {
+	mutation := newSavedSearchSnapshotMutation(c.config, OpDelete)
+	return &SavedSearchSnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *SMSMessageClient) DeleteOne(_m *SMSMessage) *SMSMessageDeleteOne {
+func (c *SavedSearchSnapshotClient) DeleteOne(_m *SavedSearchSnapshot) *SavedSearchSnapshotDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SMSMessageClient) DeleteOneID(id int) *SMSMessageDeleteOne {
-	builder := c.Delete().Where(smsmessage.ID(id))
+func (c *SavedSearchSnapshotClient) DeleteOneID(id int) *SavedSearchSnapshotDeleteOne {
+	builder := c.Delete().Where(savedsearchsnapshot.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &SMSMessageDeleteOne{builder}
+	return &SavedSearchSnapshotDeleteOne{builder}
 }
 
-// Query returns a query builder for SMSMessage.
-func (c *SMSMessageClient) Query() *SMSMessageQuery {
-	return &SMSMessageQuery{
+// Query returns a query builder for SavedSearchSnapshot.
+func (c *SavedSearchSnapshotClient) Query() *SavedSearchSnapshotQuery {
+	return &SavedSearchSnapshotQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeSMSMessage},
+		ctx:    &QueryContext{Type: TypeSavedSearchSnapshot},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a SMSMessage entity by its id.
-func (c *SMSMessageClient) Get(ctx context.Context, id int) (*SMSMessage, error) {
-	return c.Query().Where(smsmessage.ID(id)).Only(ctx)
+// Get returns a SavedSearchSnapshot entity by its id.
+func (c *SavedSearchSnapshotClient) Get(ctx context.Context, id int) (*SavedSearchSnapshot, error) {
+	return c.Query().Where(savedsearchsnapshot.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *SMSMessageClient) GetX(ctx context.Context, id int) *SMSMessage {
+func (c *SavedSearchSnapshotClient) GetX(ctx context.Context, id int) *SavedSearchSnapshot {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -5647,31 +8633,15 @@ func (c *SMSMessageClient) GetX(ctx context.Context, id int) *SMSMessage {
 	return obj
 }
 
-// QueryCampaign queries the campaign edge of a SMSMessage.
-func (c *SMSMessageClient) QueryCampaign(_m *SMSMessage) *SMSCampaignQuery {
-	query := (&SMSCampaignClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(smsmessage.Table, smsmessage.FieldID, id),
-			sqlgraph.To(smscampaign.Table, smscampaign.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, smsmessage.CampaignTable, smsmessage.CampaignColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryLead queries the lead edge of a SMSMessage.
-func (c *SMSMessageClient) QueryLead(_m *SMSMessage) *LeadQuery {
-	query := (&LeadClient{config: c.config}).Query()
+// QuerySavedSearch queries the saved_search edge of a SavedSearchSnapshot.
+func (c *SavedSearchSnapshotClient) QuerySavedSearch(_m *SavedSearchSnapshot) *SavedSearchQuery {
+	query := (&SavedSearchClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(smsmessage.Table, smsmessage.FieldID, id),
-			sqlgraph.To(lead.Table, lead.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, smsmessage.LeadTable, smsmessage.LeadColumn),
+			sqlgraph.From(savedsearchsnapshot.Table, savedsearchsnapshot.FieldID, id),
+			sqlgraph.To(savedsearch.Table, savedsearch.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, savedsearchsnapshot.SavedSearchTable, savedsearchsnapshot.SavedSearchColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -5680,131 +8650,131 @@ func (c *SMSMessageClient) QueryLead(_m *SMSMessage) *LeadQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *SMSMessageClient) Hooks() []Hook {
-	return c.hooks.SMSMessage
+func (c *SavedSearchSnapshotClient) Hooks() []Hook {
+	return c.hooks.SavedSearchSnapshot
 }
 
 // Interceptors returns the client interceptors.
-func (c *SMSMessageClient) Interceptors() []Interceptor {
-	return c.inters.SMSMessage
+func (c *SavedSearchSnapshotClient) Interceptors() []Interceptor {
+	return c.inters.SavedSearchSnapshot
 }
 
-func (c *SMSMessageClient) mutate(ctx context.Context, m *SMSMessageMutation) (Value, error) {
+func (c *SavedSearchSnapshotClient) mutate(ctx context.Context, m *SavedSearchSnapshotMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SMSMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SavedSearchSnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SMSMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SavedSearchSnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SMSMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SavedSearchSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SMSMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SavedSearchSnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown SMSMessage mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SavedSearchSnapshot mutation op: %q", m.Op())
 	}
 }
 
-// SavedSearchClient is a client for the SavedSearch schema.
-type SavedSearchClient struct {
+// ScheduledExportClient is a client for the ScheduledExport schema.
+type ScheduledExportClient struct {
 	config
 }
 
-// NewSavedSearchClient returns a client for the SavedSearch from the given config.
-func NewSavedSearchClient(c config) *SavedSearchClient {
-	return &SavedSearchClient{config: c}
+// NewScheduledExportClient returns a client for the ScheduledExport from the given config.
+func NewScheduledExportClient(c config) *ScheduledExportClient {
+	return &ScheduledExportClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `savedsearch.Hooks(f(g(h())))`.
-func (c *SavedSearchClient) Use(hooks ...Hook) {
-	c.hooks.SavedSearch = append(c.hooks.SavedSearch, hooks...)
+// A call to `Use(f, g, h)` equals to `scheduledexport.Hooks(f(g(h())))`.
+func (c *ScheduledExportClient) Use(hooks ...Hook) {
+	c.hooks.ScheduledExport = append(c.hooks.ScheduledExport, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `savedsearch.Intercept(f(g(h())))`.
-func (c *SavedSearchClient) Intercept(interceptors ...Interceptor) {
-	c.inters.SavedSearch = append(c.inters.SavedSearch, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `scheduledexport.Intercept(f(g(h())))`.
+func (c *ScheduledExportClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ScheduledExport = append(c.inters.ScheduledExport, interceptors...)
 }
 
-// Create returns a builder for creating a SavedSearch entity.
-func (c *SavedSearchClient) Create() *SavedSearchCreate {
-	mutation := newSavedSearchMutation(c.config, OpCreate)
-	return &SavedSearchCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ScheduledExport entity.
+func (c *ScheduledExportClient) Create() *ScheduledExportCreate {
+	mutation := newScheduledExportMutation(c.config, OpCreate)
+	return &ScheduledExportCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of SavedSearch entities.
-func (c *SavedSearchClient) CreateBulk(builders ...*SavedSearchCreate) *SavedSearchCreateBulk {
-	return &SavedSearchCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ScheduledExport entities.
+func (c *ScheduledExportClient) CreateBulk(builders ...*ScheduledExportCreate) *ScheduledExportCreateBulk {
+	return &ScheduledExportCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *SavedSearchClient) MapCreateBulk(slice any, setFunc func(*SavedSearchCreate, int)) *SavedSearchCreateBulk {
+func (c *ScheduledExportClient) MapCreateBulk(slice any, setFunc func(*ScheduledExportCreate, int)) *ScheduledExportCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &SavedSearchCreateBulk{err: fmt.Errorf("calling to SavedSearchClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ScheduledExportCreateBulk{err: fmt.Errorf("calling to ScheduledExportClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*SavedSearchCreate, rv.Len())
+	builders := make([]*ScheduledExportCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &SavedSearchCreateBulk{config: c.config, builders: builders}
+	return &ScheduledExportCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for SavedSearch.
-func (c *SavedSearchClient) Update() *SavedSearchUpdate {
-	mutation := newSavedSearchMutation(c.config, OpUpdate)
-	return &SavedSearchUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ScheduledExport.
+func (c *ScheduledExportClient) Update() *ScheduledExportUpdate {
+	mutation := newScheduledExportMutation(c.config, OpUpdate)
+	return &ScheduledExportUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *SavedSearchClient) UpdateOne(_m *SavedSearch) *SavedSearchUpdateOne {
-	mutation := newSavedSearchMutation(c.config, OpUpdateOne, withSavedSearch(_m))
-	return &SavedSearchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ScheduledExportClient) UpdateOne(_m *ScheduledExport) *ScheduledExportUpdateOne {
+	mutation := newScheduledExportMutation(c.config, OpUpdateOne, withScheduledExport(_m))
+	return &ScheduledExportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SavedSearchClient) UpdateOneID(id int) *SavedSearchUpdateOne {
-	mutation := newSavedSearchMutation(c.config, OpUpdateOne, withSavedSearchID(id))
-	return &SavedSearchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ScheduledExportClient) UpdateOneID(id int) *ScheduledExportUpdateOne {
+	mutation := newScheduledExportMutation(c.config, OpUpdateOne, withScheduledExportID(id))
+	return &ScheduledExportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for SavedSearch.
-func (c *SavedSearchClient) Delete() *SavedSearchDelete {
-	mutation := newSavedSearchMutation(c.config, OpDelete)
-	return &SavedSearchDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ScheduledExport.
+func (c *ScheduledExportClient) Delete() *ScheduledExportDelete {
+	mutation := newScheduledExportMutation(c.config, OpDelete)
+	return &ScheduledExportDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *SavedSearchClient) DeleteOne(_m *SavedSearch) *SavedSearchDeleteOne {
+func (c *ScheduledExportClient) DeleteOne(_m *ScheduledExport) *ScheduledExportDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SavedSearchClient) DeleteOneID(id int) *SavedSearchDeleteOne {
-	builder := c.Delete().Where(savedsearch.ID(id))
+func (c *ScheduledExportClient) DeleteOneID(id int) *ScheduledExportDeleteOne {
+	builder := c.Delete().Where(scheduledexport.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &SavedSearchDeleteOne{builder}
+	return &ScheduledExportDeleteOne{builder}
 }
 
-// Query returns a query builder for SavedSearch.
-func (c *SavedSearchClient) Query() *SavedSearchQuery {
-	return &SavedSearchQuery{
+// Query returns a query builder for ScheduledExport.
+func (c *ScheduledExportClient) Query() *ScheduledExportQuery {
+	return &ScheduledExportQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeSavedSearch},
+		ctx:    &QueryContext{Type: TypeScheduledExport},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a SavedSearch entity by its id.
-func (c *SavedSearchClient) Get(ctx context.Context, id int) (*SavedSearch, error) {
-	return c.Query().Where(savedsearch.ID(id)).Only(ctx)
+// Get returns a ScheduledExport entity by its id.
+func (c *ScheduledExportClient) Get(ctx context.Context, id int) (*ScheduledExport, error) {
+	return c.Query().Where(scheduledexport.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *SavedSearchClient) GetX(ctx context.Context, id int) *SavedSearch {
+func (c *ScheduledExportClient) GetX(ctx context.Context, id int) *ScheduledExport {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -5812,15 +8782,47 @@ func (c *SavedSearchClient) GetX(ctx context.Context, id int) *SavedSearch {
 	return obj
 }
 
-// QueryUser queries the user edge of a SavedSearch.
-func (c *SavedSearchClient) QueryUser(_m *SavedSearch) *UserQuery {
+// QueryUser queries the user edge of a ScheduledExport.
+func (c *ScheduledExportClient) QueryUser(_m *ScheduledExport) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(savedsearch.Table, savedsearch.FieldID, id),
+			sqlgraph.From(scheduledexport.Table, scheduledexport.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, savedsearch.UserTable, savedsearch.UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, scheduledexport.UserTable, scheduledexport.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrganization queries the organization edge of a ScheduledExport.
+func (c *ScheduledExportClient) QueryOrganization(_m *ScheduledExport) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scheduledexport.Table, scheduledexport.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, scheduledexport.OrganizationTable, scheduledexport.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySavedSearch queries the saved_search edge of a ScheduledExport.
+func (c *ScheduledExportClient) QuerySavedSearch(_m *ScheduledExport) *SavedSearchQuery {
+	query := (&SavedSearchClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scheduledexport.Table, scheduledexport.FieldID, id),
+			sqlgraph.To(savedsearch.Table, savedsearch.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, scheduledexport.SavedSearchTable, scheduledexport.SavedSearchColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -5829,27 +8831,27 @@ func (c *SavedSearchClient) QueryUser(_m *SavedSearch) *UserQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *SavedSearchClient) Hooks() []Hook {
-	return c.hooks.SavedSearch
+func (c *ScheduledExportClient) Hooks() []Hook {
+	return c.hooks.ScheduledExport
 }
 
 // Interceptors returns the client interceptors.
-func (c *SavedSearchClient) Interceptors() []Interceptor {
-	return c.inters.SavedSearch
+func (c *ScheduledExportClient) Interceptors() []Interceptor {
+	return c.inters.ScheduledExport
 }
 
-func (c *SavedSearchClient) mutate(ctx context.Context, m *SavedSearchMutation) (Value, error) {
+func (c *ScheduledExportClient) mutate(ctx context.Context, m *ScheduledExportMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SavedSearchCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ScheduledExportCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SavedSearchUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ScheduledExportUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SavedSearchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ScheduledExportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SavedSearchDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ScheduledExportDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown SavedSearch mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ScheduledExport mutation op: %q", m.Op())
 	}
 }
 
@@ -6205,85 +9207,266 @@ func (c *TerritoryMemberClient) Intercept(interceptors ...Interceptor) {
 	c.inters.TerritoryMember = append(c.inters.TerritoryMember, interceptors...)
 }
 
-// Create returns a builder for creating a TerritoryMember entity.
-func (c *TerritoryMemberClient) Create() *TerritoryMemberCreate {
-	mutation := newTerritoryMemberMutation(c.config, OpCreate)
-	return &TerritoryMemberCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a TerritoryMember entity.
+func (c *TerritoryMemberClient) Create() *TerritoryMemberCreate {
+	mutation := newTerritoryMemberMutation(c.config, OpCreate)
+	return &TerritoryMemberCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TerritoryMember entities.
+func (c *TerritoryMemberClient) CreateBulk(builders ...*TerritoryMemberCreate) *TerritoryMemberCreateBulk {
+	return &TerritoryMemberCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TerritoryMemberClient) MapCreateBulk(slice any, setFunc func(*TerritoryMemberCreate, int)) *TerritoryMemberCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TerritoryMemberCreateBulk{err: fmt.Errorf("calling to TerritoryMemberClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TerritoryMemberCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TerritoryMemberCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TerritoryMember.
+func (c *TerritoryMemberClient) Update() *TerritoryMemberUpdate {
+	mutation := newTerritoryMemberMutation(c.config, OpUpdate)
+	return &TerritoryMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TerritoryMemberClient) UpdateOne(_m *TerritoryMember) *TerritoryMemberUpdateOne {
+	mutation := newTerritoryMemberMutation(c.config, OpUpdateOne, withTerritoryMember(_m))
+	return &TerritoryMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TerritoryMemberClient) UpdateOneID(id int) *TerritoryMemberUpdateOne {
+	mutation := newTerritoryMemberMutation(c.config, OpUpdateOne, withTerritoryMemberID(id))
+	return &TerritoryMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TerritoryMember.
+func (c *TerritoryMemberClient) Delete() *TerritoryMemberDelete {
+	mutation := newTerritoryMemberMutation(c.config, OpDelete)
+	return &TerritoryMemberDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TerritoryMemberClient) DeleteOne(_m *TerritoryMember) *TerritoryMemberDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TerritoryMemberClient) DeleteOneID(id int) *TerritoryMemberDeleteOne {
+	builder := c.Delete().Where(territorymember.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TerritoryMemberDeleteOne{builder}
+}
+
+// Query returns a query builder for TerritoryMember.
+func (c *TerritoryMemberClient) Query() *TerritoryMemberQuery {
+	return &TerritoryMemberQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTerritoryMember},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TerritoryMember entity by its id.
+func (c *TerritoryMemberClient) Get(ctx context.Context, id int) (*TerritoryMember, error) {
+	return c.Query().Where(territorymember.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TerritoryMemberClient) GetX(ctx context.Context, id int) *TerritoryMember {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTerritory queries the territory edge of a TerritoryMember.
+func (c *TerritoryMemberClient) QueryTerritory(_m *TerritoryMember) *TerritoryQuery {
+	query := (&TerritoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(territorymember.Table, territorymember.FieldID, id),
+			sqlgraph.To(territory.Table, territory.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, territorymember.TerritoryTable, territorymember.TerritoryColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a TerritoryMember.
+func (c *TerritoryMemberClient) QueryUser(_m *TerritoryMember) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(territorymember.Table, territorymember.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, territorymember.UserTable, territorymember.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAddedBy queries the added_by edge of a TerritoryMember.
+func (c *TerritoryMemberClient) QueryAddedBy(_m *TerritoryMember) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(territorymember.Table, territorymember.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, territorymember.AddedByTable, territorymember.AddedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TerritoryMemberClient) Hooks() []Hook {
+	return c.hooks.TerritoryMember
+}
+
+// Interceptors returns the client interceptors.
+func (c *TerritoryMemberClient) Interceptors() []Interceptor {
+	return c.inters.TerritoryMember
+}
+
+func (c *TerritoryMemberClient) mutate(ctx context.Context, m *TerritoryMemberMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TerritoryMemberCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TerritoryMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TerritoryMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TerritoryMemberDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TerritoryMember mutation op: %q", m.Op())
+	}
+}
+
+// UsageDailyAggregateClient is a client for the UsageDailyAggregate schema.
+type UsageDailyAggregateClient struct {
+	config
+}
+
+// NewUsageDailyAggregateClient returns a client for the UsageDailyAggregate from the given config.
+func NewUsageDailyAggregateClient(c config) *UsageDailyAggregateClient {
+	return &UsageDailyAggregateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `usagedailyaggregate.Hooks(f(g(h())))`.
+func (c *UsageDailyAggregateClient) Use(hooks ...Hook) {
+	c.hooks.UsageDailyAggregate = append(c.hooks.UsageDailyAggregate, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `usagedailyaggregate.Intercept(f(g(h())))`.
+func (c *UsageDailyAggregateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UsageDailyAggregate = append(c.inters.UsageDailyAggregate, interceptors...)
+}
+
+// Create returns a builder for creating a UsageDailyAggregate entity.
+func (c *UsageDailyAggregateClient) Create() *UsageDailyAggregateCreate {
+	mutation := newUsageDailyAggregateMutation(c.config, OpCreate)
+	return &UsageDailyAggregateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of TerritoryMember entities.
-func (c *TerritoryMemberClient) CreateBulk(builders ...*TerritoryMemberCreate) *TerritoryMemberCreateBulk {
-	return &TerritoryMemberCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of UsageDailyAggregate entities.
+func (c *UsageDailyAggregateClient) CreateBulk(builders ...*UsageDailyAggregateCreate) *UsageDailyAggregateCreateBulk {
+	return &UsageDailyAggregateCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *TerritoryMemberClient) MapCreateBulk(slice any, setFunc func(*TerritoryMemberCreate, int)) *TerritoryMemberCreateBulk {
+func (c *UsageDailyAggregateClient) MapCreateBulk(slice any, setFunc func(*UsageDailyAggregateCreate, int)) *UsageDailyAggregateCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &TerritoryMemberCreateBulk{err: fmt.Errorf("calling to TerritoryMemberClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &UsageDailyAggregateCreateBulk{err: fmt.Errorf("calling to UsageDailyAggregateClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*TerritoryMemberCreate, rv.Len())
+	builders := make([]*UsageDailyAggregateCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &TerritoryMemberCreateBulk{config: c.config, builders: builders}
+	return &UsageDailyAggregateCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for TerritoryMember.
-func (c *TerritoryMemberClient) Update() *TerritoryMemberUpdate {
-	mutation := newTerritoryMemberMutation(c.config, OpUpdate)
-	return &TerritoryMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for UsageDailyAggregate.
+func (c *UsageDailyAggregateClient) Update() *UsageDailyAggregateUpdate {
+	mutation := newUsageDailyAggregateMutation(c.config, OpUpdate)
+	return &UsageDailyAggregateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *TerritoryMemberClient) UpdateOne(_m *TerritoryMember) *TerritoryMemberUpdateOne {
-	mutation := newTerritoryMemberMutation(c.config, OpUpdateOne, withTerritoryMember(_m))
-	return &TerritoryMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *UsageDailyAggregateClient) UpdateOne(_m *UsageDailyAggregate) *UsageDailyAggregateUpdateOne {
+	mutation := newUsageDailyAggregateMutation(c.config, OpUpdateOne, withUsageDailyAggregate(_m))
+	return &UsageDailyAggregateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *TerritoryMemberClient) UpdateOneID(id int) *TerritoryMemberUpdateOne {
-	mutation := newTerritoryMemberMutation(c.config, OpUpdateOne, withTerritoryMemberID(id))
-	return &TerritoryMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *UsageDailyAggregateClient) UpdateOneID(id int) *UsageDailyAggregateUpdateOne {
+	mutation := newUsageDailyAggregateMutation(c.config, OpUpdateOne, withUsageDailyAggregateID(id))
+	return &UsageDailyAggregateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for TerritoryMember.
-func (c *TerritoryMemberClient) Delete() *TerritoryMemberDelete {
-	mutation := newTerritoryMemberMutation(c.config, OpDelete)
-	return &TerritoryMemberDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for UsageDailyAggregate.
+func (c *UsageDailyAggregateClient) Delete() *UsageDailyAggregateDelete {
+	mutation := newUsageDailyAggregateMutation(c.config, OpDelete)
+	return &UsageDailyAggregateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *TerritoryMemberClient) DeleteOne(_m *TerritoryMember) *TerritoryMemberDeleteOne {
+func (c *UsageDailyAggregateClient) DeleteOne(_m *UsageDailyAggregate) *UsageDailyAggregateDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *TerritoryMemberClient) DeleteOneID(id int) *TerritoryMemberDeleteOne {
-	builder := c.Delete().Where(territorymember.ID(id))
+func (c *UsageDailyAggregateClient) DeleteOneID(id int) *UsageDailyAggregateDeleteOne {
+	builder := c.Delete().Where(usagedailyaggregate.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &TerritoryMemberDeleteOne{builder}
+	return &UsageDailyAggregateDeleteOne{builder}
 }
 
-// Query returns a query builder for TerritoryMember.
-func (c *TerritoryMemberClient) Query() *TerritoryMemberQuery {
-	return &TerritoryMemberQuery{
+// Query returns a query builder for UsageDailyAggregate.
+func (c *UsageDailyAggregateClient) Query() *UsageDailyAggregateQuery {
+	return &UsageDailyAggregateQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeTerritoryMember},
+		ctx:    &QueryContext{Type: TypeUsageDailyAggregate},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a TerritoryMember entity by its id.
-func (c *TerritoryMemberClient) Get(ctx context.Context, id int) (*TerritoryMember, error) {
-	return c.Query().Where(territorymember.ID(id)).Only(ctx)
+// Get returns a UsageDailyAggregate entity by its id.
+func (c *UsageDailyAggregateClient) Get(ctx context.Context, id int) (*UsageDailyAggregate, error) {
+	return c.Query().Where(usagedailyaggregate.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *TerritoryMemberClient) GetX(ctx context.Context, id int) *TerritoryMember {
+func (c *UsageDailyAggregateClient) GetX(ctx context.Context, id int) *UsageDailyAggregate {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -6291,47 +9474,15 @@ func (c *TerritoryMemberClient) GetX(ctx context.Context, id int) *TerritoryMemb
 	return obj
 }
 
-// QueryTerritory queries the territory edge of a TerritoryMember.
-func (c *TerritoryMemberClient) QueryTerritory(_m *TerritoryMember) *TerritoryQuery {
-	query := (&TerritoryClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(territorymember.Table, territorymember.FieldID, id),
-			sqlgraph.To(territory.Table, territory.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, territorymember.TerritoryTable, territorymember.TerritoryColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryUser queries the user edge of a TerritoryMember.
-func (c *TerritoryMemberClient) QueryUser(_m *TerritoryMember) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(territorymember.Table, territorymember.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, territorymember.UserTable, territorymember.UserColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryAddedBy queries the added_by edge of a TerritoryMember.
-func (c *TerritoryMemberClient) QueryAddedBy(_m *TerritoryMember) *UserQuery {
+// QueryUser queries the user edge of a UsageDailyAggregate.
+func (c *UsageDailyAggregateClient) QueryUser(_m *UsageDailyAggregate) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(territorymember.Table, territorymember.FieldID, id),
+			sqlgraph.From(usagedailyaggregate.Table, usagedailyaggregate.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, territorymember.AddedByTable, territorymember.AddedByColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, usagedailyaggregate.UserTable, usagedailyaggregate.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -6340,27 +9491,27 @@ func (c *TerritoryMemberClient) QueryAddedBy(_m *TerritoryMember) *UserQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *TerritoryMemberClient) Hooks() []Hook {
-	return c.hooks.TerritoryMember
+func (c *UsageDailyAggregateClient) Hooks() []Hook {
+	return c.hooks.UsageDailyAggregate
 }
 
 // Interceptors returns the client interceptors.
-func (c *TerritoryMemberClient) Interceptors() []Interceptor {
-	return c.inters.TerritoryMember
+func (c *UsageDailyAggregateClient) Interceptors() []Interceptor {
+	return c.inters.UsageDailyAggregate
 }
 
-func (c *TerritoryMemberClient) mutate(ctx context.Context, m *TerritoryMemberMutation) (Value, error) {
+func (c *UsageDailyAggregateClient) mutate(ctx context.Context, m *UsageDailyAggregateMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&TerritoryMemberCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UsageDailyAggregateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&TerritoryMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UsageDailyAggregateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&TerritoryMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UsageDailyAggregateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&TerritoryMemberDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&UsageDailyAggregateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown TerritoryMember mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown UsageDailyAggregate mutation op: %q", m.Op())
 	}
 }
 
@@ -6607,29 +9758,221 @@ func (c *UserClient) Query() *UserQuery {
 	}
 }
 
-// Get returns a User entity by its id.
-func (c *UserClient) Get(ctx context.Context, id int) (*User, error) {
-	return c.Query().Where(user.ID(id)).Only(ctx)
-}
-
-// GetX is like Get, but panics if an error occurs.
-func (c *UserClient) GetX(ctx context.Context, id int) *User {
-	obj, err := c.Get(ctx, id)
-	if err != nil {
-		panic(err)
+// Get returns a User entity by its id.
+func (c *UserClient) Get(ctx context.Context, id int) (*User, error) {
+	return c.Query().Where(user.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserClient) GetX(ctx context.Context, id int) *User {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySubscriptions queries the subscriptions edge of a User.
+func (c *UserClient) QuerySubscriptions(_m *User) *SubscriptionQuery {
+	query := (&SubscriptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(subscription.Table, subscription.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SubscriptionsTable, user.SubscriptionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryExports queries the exports edge of a User.
+func (c *UserClient) QueryExports(_m *User) *ExportQuery {
+	query := (&ExportClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(export.Table, export.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ExportsTable, user.ExportsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryImportJobs queries the import_jobs edge of a User.
+func (c *UserClient) QueryImportJobs(_m *User) *ImportJobQuery {
+	query := (&ImportJobClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(importjob.Table, importjob.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ImportJobsTable, user.ImportJobsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLeadReindexJobs queries the lead_reindex_jobs edge of a User.
+func (c *UserClient) QueryLeadReindexJobs(_m *User) *LeadReindexJobQuery {
+	query := (&LeadReindexJobClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(leadreindexjob.Table, leadreindexjob.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.LeadReindexJobsTable, user.LeadReindexJobsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAPIKeys queries the api_keys edge of a User.
+func (c *UserClient) QueryAPIKeys(_m *User) *APIKeyQuery {
+	query := (&APIKeyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(apikey.Table, apikey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.APIKeysTable, user.APIKeysColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAuditLogs queries the audit_logs edge of a User.
+func (c *UserClient) QueryAuditLogs(_m *User) *AuditLogQuery {
+	query := (&AuditLogClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(auditlog.Table, auditlog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.AuditLogsTable, user.AuditLogsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUsageLogs queries the usage_logs edge of a User.
+func (c *UserClient) QueryUsageLogs(_m *User) *UsageLogQuery {
+	query := (&UsageLogClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(usagelog.Table, usagelog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.UsageLogsTable, user.UsageLogsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUsageDailyAggregates queries the usage_daily_aggregates edge of a User.
+func (c *UserClient) QueryUsageDailyAggregates(_m *User) *UsageDailyAggregateQuery {
+	query := (&UsageDailyAggregateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(usagedailyaggregate.Table, usagedailyaggregate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.UsageDailyAggregatesTable, user.UsageDailyAggregatesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOwnedOrganizations queries the owned_organizations edge of a User.
+func (c *UserClient) QueryOwnedOrganizations(_m *User) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.OwnedOrganizationsTable, user.OwnedOrganizationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrganizationMemberships queries the organization_memberships edge of a User.
+func (c *UserClient) QueryOrganizationMemberships(_m *User) *OrganizationMemberQuery {
+	query := (&OrganizationMemberClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(organizationmember.Table, organizationmember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.OrganizationMembershipsTable, user.OrganizationMembershipsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySavedSearches queries the saved_searches edge of a User.
+func (c *UserClient) QuerySavedSearches(_m *User) *SavedSearchQuery {
+	query := (&SavedSearchClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(savedsearch.Table, savedsearch.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SavedSearchesTable, user.SavedSearchesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryExportTemplates queries the export_templates edge of a User.
+func (c *UserClient) QueryExportTemplates(_m *User) *ExportTemplateQuery {
+	query := (&ExportTemplateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(exporttemplate.Table, exporttemplate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ExportTemplatesTable, user.ExportTemplatesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
 	}
-	return obj
+	return query
 }
 
-// QuerySubscriptions queries the subscriptions edge of a User.
-func (c *UserClient) QuerySubscriptions(_m *User) *SubscriptionQuery {
-	query := (&SubscriptionClient{config: c.config}).Query()
+// QueryScheduledExports queries the scheduled_exports edge of a User.
+func (c *UserClient) QueryScheduledExports(_m *User) *ScheduledExportQuery {
+	query := (&ScheduledExportClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(subscription.Table, subscription.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.SubscriptionsTable, user.SubscriptionsColumn),
+			sqlgraph.To(scheduledexport.Table, scheduledexport.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ScheduledExportsTable, user.ScheduledExportsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -6637,15 +9980,15 @@ func (c *UserClient) QuerySubscriptions(_m *User) *SubscriptionQuery {
 	return query
 }
 
-// QueryExports queries the exports edge of a User.
-func (c *UserClient) QueryExports(_m *User) *ExportQuery {
-	query := (&ExportClient{config: c.config}).Query()
+// QueryWebhooks queries the webhooks edge of a User.
+func (c *UserClient) QueryWebhooks(_m *User) *WebhookQuery {
+	query := (&WebhookClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(export.Table, export.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.ExportsTable, user.ExportsColumn),
+			sqlgraph.To(webhook.Table, webhook.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.WebhooksTable, user.WebhooksColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -6653,15 +9996,15 @@ func (c *UserClient) QueryExports(_m *User) *ExportQuery {
 	return query
 }
 
-// QueryAPIKeys queries the api_keys edge of a User.
-func (c *UserClient) QueryAPIKeys(_m *User) *APIKeyQuery {
-	query := (&APIKeyClient{config: c.config}).Query()
+// QueryLeadNotes queries the lead_notes edge of a User.
+func (c *UserClient) QueryLeadNotes(_m *User) *LeadNoteQuery {
+	query := (&LeadNoteClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(apikey.Table, apikey.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.APIKeysTable, user.APIKeysColumn),
+			sqlgraph.To(leadnote.Table, leadnote.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.LeadNotesTable, user.LeadNotesColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -6669,15 +10012,15 @@ func (c *UserClient) QueryAPIKeys(_m *User) *APIKeyQuery {
 	return query
 }
 
-// QueryAuditLogs queries the audit_logs edge of a User.
-func (c *UserClient) QueryAuditLogs(_m *User) *AuditLogQuery {
-	query := (&AuditLogClient{config: c.config}).Query()
+// QueryLeadSuppressions queries the lead_suppressions edge of a User.
+func (c *UserClient) QueryLeadSuppressions(_m *User) *LeadSuppressionQuery {
+	query := (&LeadSuppressionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(auditlog.Table, auditlog.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.AuditLogsTable, user.AuditLogsColumn),
+			sqlgraph.To(leadsuppression.Table, leadsuppression.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.LeadSuppressionsTable, user.LeadSuppressionsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -6685,15 +10028,15 @@ func (c *UserClient) QueryAuditLogs(_m *User) *AuditLogQuery {
 	return query
 }
 
-// QueryUsageLogs queries the usage_logs edge of a User.
-func (c *UserClient) QueryUsageLogs(_m *User) *UsageLogQuery {
-	query := (&UsageLogClient{config: c.config}).Query()
+// QueryContactAttempts queries the contact_attempts edge of a User.
+func (c *UserClient) QueryContactAttempts(_m *User) *ContactAttemptQuery {
+	query := (&ContactAttemptClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(usagelog.Table, usagelog.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.UsageLogsTable, user.UsageLogsColumn),
+			sqlgraph.To(contactattempt.Table, contactattempt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ContactAttemptsTable, user.ContactAttemptsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -6701,15 +10044,15 @@ func (c *UserClient) QueryUsageLogs(_m *User) *UsageLogQuery {
 	return query
 }
 
-// QueryOwnedOrganizations queries the owned_organizations edge of a User.
-func (c *UserClient) QueryOwnedOrganizations(_m *User) *OrganizationQuery {
-	query := (&OrganizationClient{config: c.config}).Query()
+// QueryNotificationPreferences queries the notification_preferences edge of a User.
+func (c *UserClient) QueryNotificationPreferences(_m *User) *UserNotificationPreferenceQuery {
+	query := (&UserNotificationPreferenceClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(organization.Table, organization.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.OwnedOrganizationsTable, user.OwnedOrganizationsColumn),
+			sqlgraph.To(usernotificationpreference.Table, usernotificationpreference.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.NotificationPreferencesTable, user.NotificationPreferencesColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -6717,15 +10060,15 @@ func (c *UserClient) QueryOwnedOrganizations(_m *User) *OrganizationQuery {
 	return query
 }
 
-// QueryOrganizationMemberships queries the organization_memberships edge of a User.
-func (c *UserClient) QueryOrganizationMemberships(_m *User) *OrganizationMemberQuery {
-	query := (&OrganizationMemberClient{config: c.config}).Query()
+// QueryLeadStatusChanges queries the lead_status_changes edge of a User.
+func (c *UserClient) QueryLeadStatusChanges(_m *User) *LeadStatusHistoryQuery {
+	query := (&LeadStatusHistoryClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(organizationmember.Table, organizationmember.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.OrganizationMembershipsTable, user.OrganizationMembershipsColumn),
+			sqlgraph.To(leadstatushistory.Table, leadstatushistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.LeadStatusChangesTable, user.LeadStatusChangesColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -6733,15 +10076,15 @@ func (c *UserClient) QueryOrganizationMemberships(_m *User) *OrganizationMemberQ
 	return query
 }
 
-// QuerySavedSearches queries the saved_searches edge of a User.
-func (c *UserClient) QuerySavedSearches(_m *User) *SavedSearchQuery {
-	query := (&SavedSearchClient{config: c.config}).Query()
+// QueryLeadChanges queries the lead_changes edge of a User.
+func (c *UserClient) QueryLeadChanges(_m *User) *LeadChangeQuery {
+	query := (&LeadChangeClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(savedsearch.Table, savedsearch.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.SavedSearchesTable, user.SavedSearchesColumn),
+			sqlgraph.To(leadchange.Table, leadchange.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.LeadChangesTable, user.LeadChangesColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -6749,15 +10092,15 @@ func (c *UserClient) QuerySavedSearches(_m *User) *SavedSearchQuery {
 	return query
 }
 
-// QueryWebhooks queries the webhooks edge of a User.
-func (c *UserClient) QueryWebhooks(_m *User) *WebhookQuery {
-	query := (&WebhookClient{config: c.config}).Query()
+// QueryEnrichmentAttempts queries the enrichment_attempts edge of a User.
+func (c *UserClient) QueryEnrichmentAttempts(_m *User) *EnrichmentAttemptQuery {
+	query := (&EnrichmentAttemptClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(webhook.Table, webhook.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.WebhooksTable, user.WebhooksColumn),
+			sqlgraph.To(enrichmentattempt.Table, enrichmentattempt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.EnrichmentAttemptsTable, user.EnrichmentAttemptsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -6765,15 +10108,15 @@ func (c *UserClient) QueryWebhooks(_m *User) *WebhookQuery {
 	return query
 }
 
-// QueryLeadNotes queries the lead_notes edge of a User.
-func (c *UserClient) QueryLeadNotes(_m *User) *LeadNoteQuery {
-	query := (&LeadNoteClient{config: c.config}).Query()
+// QueryEnrichmentBudget queries the enrichment_budget edge of a User.
+func (c *UserClient) QueryEnrichmentBudget(_m *User) *EnrichmentBudgetQuery {
+	query := (&EnrichmentBudgetClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(leadnote.Table, leadnote.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.LeadNotesTable, user.LeadNotesColumn),
+			sqlgraph.To(enrichmentbudget.Table, enrichmentbudget.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, user.EnrichmentBudgetTable, user.EnrichmentBudgetColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -6781,15 +10124,15 @@ func (c *UserClient) QueryLeadNotes(_m *User) *LeadNoteQuery {
 	return query
 }
 
-// QueryLeadStatusChanges queries the lead_status_changes edge of a User.
-func (c *UserClient) QueryLeadStatusChanges(_m *User) *LeadStatusHistoryQuery {
-	query := (&LeadStatusHistoryClient{config: c.config}).Query()
+// QueryLeadVerifications queries the lead_verifications edge of a User.
+func (c *UserClient) QueryLeadVerifications(_m *User) *LeadVerificationQuery {
+	query := (&LeadVerificationClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(leadstatushistory.Table, leadstatushistory.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.LeadStatusChangesTable, user.LeadStatusChangesColumn),
+			sqlgraph.To(leadverification.Table, leadverification.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.LeadVerificationsTable, user.LeadVerificationsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -7117,6 +10460,38 @@ func (c *UserClient) QueryCrmIntegrations(_m *User) *CRMIntegrationQuery {
 	return query
 }
 
+// QueryCrmPushJobs queries the crm_push_jobs edge of a User.
+func (c *UserClient) QueryCrmPushJobs(_m *User) *CRMPushJobQuery {
+	query := (&CRMPushJobClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(crmpushjob.Table, crmpushjob.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.CrmPushJobsTable, user.CrmPushJobsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryIntegrationConnections queries the integration_connections edge of a User.
+func (c *UserClient) QueryIntegrationConnections(_m *User) *IntegrationConnectionQuery {
+	query := (&IntegrationConnectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(integrationconnection.Table, integrationconnection.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.IntegrationConnectionsTable, user.IntegrationConnectionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -7153,96 +10528,245 @@ func NewUserBehaviorClient(c config) *UserBehaviorClient {
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `userbehavior.Hooks(f(g(h())))`.
-func (c *UserBehaviorClient) Use(hooks ...Hook) {
-	c.hooks.UserBehavior = append(c.hooks.UserBehavior, hooks...)
+// A call to `Use(f, g, h)` equals to `userbehavior.Hooks(f(g(h())))`.
+func (c *UserBehaviorClient) Use(hooks ...Hook) {
+	c.hooks.UserBehavior = append(c.hooks.UserBehavior, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `userbehavior.Intercept(f(g(h())))`.
+func (c *UserBehaviorClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserBehavior = append(c.inters.UserBehavior, interceptors...)
+}
+
+// Create returns a builder for creating a UserBehavior entity.
+func (c *UserBehaviorClient) Create() *UserBehaviorCreate {
+	mutation := newUserBehaviorMutation(c.config, OpCreate)
+	return &UserBehaviorCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserBehavior entities.
+func (c *UserBehaviorClient) CreateBulk(builders ...*UserBehaviorCreate) *UserBehaviorCreateBulk {
+	return &UserBehaviorCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserBehaviorClient) MapCreateBulk(slice any, setFunc func(*UserBehaviorCreate, int)) *UserBehaviorCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserBehaviorCreateBulk{err: fmt.Errorf("calling to UserBehaviorClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserBehaviorCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserBehaviorCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserBehavior.
+func (c *UserBehaviorClient) Update() *UserBehaviorUpdate {
+	mutation := newUserBehaviorMutation(c.config, OpUpdate)
+	return &UserBehaviorUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserBehaviorClient) UpdateOne(_m *UserBehavior) *UserBehaviorUpdateOne {
+	mutation := newUserBehaviorMutation(c.config, OpUpdateOne, withUserBehavior(_m))
+	return &UserBehaviorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserBehaviorClient) UpdateOneID(id int) *UserBehaviorUpdateOne {
+	mutation := newUserBehaviorMutation(c.config, OpUpdateOne, withUserBehaviorID(id))
+	return &UserBehaviorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserBehavior.
+func (c *UserBehaviorClient) Delete() *UserBehaviorDelete {
+	mutation := newUserBehaviorMutation(c.config, OpDelete)
+	return &UserBehaviorDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserBehaviorClient) DeleteOne(_m *UserBehavior) *UserBehaviorDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserBehaviorClient) DeleteOneID(id int) *UserBehaviorDeleteOne {
+	builder := c.Delete().Where(userbehavior.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserBehaviorDeleteOne{builder}
+}
+
+// Query returns a query builder for UserBehavior.
+func (c *UserBehaviorClient) Query() *UserBehaviorQuery {
+	return &UserBehaviorQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserBehavior},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserBehavior entity by its id.
+func (c *UserBehaviorClient) Get(ctx context.Context, id int) (*UserBehavior, error) {
+	return c.Query().Where(userbehavior.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserBehaviorClient) GetX(ctx context.Context, id int) *UserBehavior {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a UserBehavior.
+func (c *UserBehaviorClient) QueryUser(_m *UserBehavior) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(userbehavior.Table, userbehavior.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, userbehavior.UserTable, userbehavior.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *UserBehaviorClient) Hooks() []Hook {
+	return c.hooks.UserBehavior
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserBehaviorClient) Interceptors() []Interceptor {
+	return c.inters.UserBehavior
+}
+
+func (c *UserBehaviorClient) mutate(ctx context.Context, m *UserBehaviorMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserBehaviorCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserBehaviorUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserBehaviorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserBehaviorDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UserBehavior mutation op: %q", m.Op())
+	}
+}
+
+// UserNotificationPreferenceClient is a client for the UserNotificationPreference schema.
+type UserNotificationPreferenceClient struct {
+	config
+}
+
+// NewUserNotificationPreferenceClient returns a client for the UserNotificationPreference from the given config.
+func NewUserNotificationPreferenceClient(c config) *UserNotificationPreferenceClient {
+	return &UserNotificationPreferenceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `usernotificationpreference.Hooks(f(g(h())))`.
+func (c *UserNotificationPreferenceClient) Use(hooks ...Hook) {
+	c.hooks.UserNotificationPreference = append(c.hooks.UserNotificationPreference, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `userbehavior.Intercept(f(g(h())))`.
-func (c *UserBehaviorClient) Intercept(interceptors ...Interceptor) {
-	c.inters.UserBehavior = append(c.inters.UserBehavior, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `usernotificationpreference.Intercept(f(g(h())))`.
+func (c *UserNotificationPreferenceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserNotificationPreference = append(c.inters.UserNotificationPreference, interceptors...)
 }
 
-// Create returns a builder for creating a UserBehavior entity.
-func (c *UserBehaviorClient) Create() *UserBehaviorCreate {
-	mutation := newUserBehaviorMutation(c.config, OpCreate)
-	return &UserBehaviorCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a UserNotificationPreference entity.
+func (c *UserNotificationPreferenceClient) Create() *UserNotificationPreferenceCreate {
+	mutation := newUserNotificationPreferenceMutation(c.config, OpCreate)
+	return &UserNotificationPreferenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of UserBehavior entities.
-func (c *UserBehaviorClient) CreateBulk(builders ...*UserBehaviorCreate) *UserBehaviorCreateBulk {
-	return &UserBehaviorCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of UserNotificationPreference entities.
+func (c *UserNotificationPreferenceClient) CreateBulk(builders ...*UserNotificationPreferenceCreate) *UserNotificationPreferenceCreateBulk {
+	return &UserNotificationPreferenceCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *UserBehaviorClient) MapCreateBulk(slice any, setFunc func(*UserBehaviorCreate, int)) *UserBehaviorCreateBulk {
+func (c *UserNotificationPreferenceClient) MapCreateBulk(slice any, setFunc func(*UserNotificationPreferenceCreate, int)) *UserNotificationPreferenceCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &UserBehaviorCreateBulk{err: fmt.Errorf("calling to UserBehaviorClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &UserNotificationPreferenceCreateBulk{err: fmt.Errorf("calling to UserNotificationPreferenceClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*UserBehaviorCreate, rv.Len())
+	builders := make([]*UserNotificationPreferenceCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &UserBehaviorCreateBulk{config: c.config, builders: builders}
+	return &UserNotificationPreferenceCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for UserBehavior.
-func (c *UserBehaviorClient) Update() *UserBehaviorUpdate {
-	mutation := newUserBehaviorMutation(c.config, OpUpdate)
-	return &UserBehaviorUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for UserNotificationPreference.
+func (c *UserNotificationPreferenceClient) Update() *UserNotificationPreferenceUpdate {
+	mutation := newUserNotificationPreferenceMutation(c.config, OpUpdate)
+	return &UserNotificationPreferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *UserBehaviorClient) UpdateOne(_m *UserBehavior) *UserBehaviorUpdateOne {
-	mutation := newUserBehaviorMutation(c.config, OpUpdateOne, withUserBehavior(_m))
-	return &UserBehaviorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *UserNotificationPreferenceClient) UpdateOne(_m *UserNotificationPreference) *UserNotificationPreferenceUpdateOne {
+	mutation := newUserNotificationPreferenceMutation(c.config, OpUpdateOne, withUserNotificationPreference(_m))
+	return &UserNotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *UserBehaviorClient) UpdateOneID(id int) *UserBehaviorUpdateOne {
-	mutation := newUserBehaviorMutation(c.config, OpUpdateOne, withUserBehaviorID(id))
-	return &UserBehaviorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *UserNotificationPreferenceClient) UpdateOneID(id int) *UserNotificationPreferenceUpdateOne {
+	mutation := newUserNotificationPreferenceMutation(c.config, OpUpdateOne, withUserNotificationPreferenceID(id))
+	return &UserNotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for UserBehavior.
-func (c *UserBehaviorClient) Delete() *UserBehaviorDelete {
-	mutation := newUserBehaviorMutation(c.config, OpDelete)
-	return &UserBehaviorDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for UserNotificationPreference.
+func (c *UserNotificationPreferenceClient) Delete() *UserNotificationPreferenceDelete {
+	mutation := newUserNotificationPreferenceMutation(c.config, OpDelete)
+	return &UserNotificationPreferenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *UserBehaviorClient) DeleteOne(_m *UserBehavior) *UserBehaviorDeleteOne {
+func (c *UserNotificationPreferenceClient) DeleteOne(_m *UserNotificationPreference) *UserNotificationPreferenceDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *UserBehaviorClient) DeleteOneID(id int) *UserBehaviorDeleteOne {
-	builder := c.Delete().Where(userbehavior.ID(id))
+func (c *UserNotificationPreferenceClient) DeleteOneID(id int) *UserNotificationPreferenceDeleteOne {
+	builder := c.Delete().Where(usernotificationpreference.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &UserBehaviorDeleteOne{builder}
+	return &UserNotificationPreferenceDeleteOne{builder}
 }
 
-// Query returns a query builder for UserBehavior.
-func (c *UserBehaviorClient) Query() *UserBehaviorQuery {
-	return &UserBehaviorQuery{
+// Query returns a query builder for UserNotificationPreference.
+func (c *UserNotificationPreferenceClient) Query() *UserNotificationPreferenceQuery {
+	return &UserNotificationPreferenceQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeUserBehavior},
+		ctx:    &QueryContext{Type: TypeUserNotificationPreference},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a UserBehavior entity by its id.
-func (c *UserBehaviorClient) Get(ctx context.Context, id int) (*UserBehavior, error) {
-	return c.Query().Where(userbehavior.ID(id)).Only(ctx)
+// Get returns a UserNotificationPreference entity by its id.
+func (c *UserNotificationPreferenceClient) Get(ctx context.Context, id int) (*UserNotificationPreference, error) {
+	return c.Query().Where(usernotificationpreference.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *UserBehaviorClient) GetX(ctx context.Context, id int) *UserBehavior {
+func (c *UserNotificationPreferenceClient) GetX(ctx context.Context, id int) *UserNotificationPreference {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -7250,15 +10774,15 @@ func (c *UserBehaviorClient) GetX(ctx context.Context, id int) *UserBehavior {
 	return obj
 }
 
-// QueryUser queries the user edge of a UserBehavior.
-func (c *UserBehaviorClient) QueryUser(_m *UserBehavior) *UserQuery {
+// QueryUser queries the user edge of a UserNotificationPreference.
+func (c *UserNotificationPreferenceClient) QueryUser(_m *UserNotificationPreference) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(userbehavior.Table, userbehavior.FieldID, id),
+			sqlgraph.From(usernotificationpreference.Table, usernotificationpreference.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, userbehavior.UserTable, userbehavior.UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, usernotificationpreference.UserTable, usernotificationpreference.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -7267,27 +10791,27 @@ func (c *UserBehaviorClient) QueryUser(_m *UserBehavior) *UserQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *UserBehaviorClient) Hooks() []Hook {
-	return c.hooks.UserBehavior
+func (c *UserNotificationPreferenceClient) Hooks() []Hook {
+	return c.hooks.UserNotificationPreference
 }
 
 // Interceptors returns the client interceptors.
-func (c *UserBehaviorClient) Interceptors() []Interceptor {
-	return c.inters.UserBehavior
+func (c *UserNotificationPreferenceClient) Interceptors() []Interceptor {
+	return c.inters.UserNotificationPreference
 }
 
-func (c *UserBehaviorClient) mutate(ctx context.Context, m *UserBehaviorMutation) (Value, error) {
+func (c *UserNotificationPreferenceClient) mutate(ctx context.Context, m *UserNotificationPreferenceMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&UserBehaviorCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserNotificationPreferenceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&UserBehaviorUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserNotificationPreferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&UserBehaviorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserNotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&UserBehaviorDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&UserNotificationPreferenceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown UserBehavior mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown UserNotificationPreference mutation op: %q", m.Op())
 	}
 }
 
@@ -7415,6 +10939,38 @@ func (c *WebhookClient) QueryUser(_m *Webhook) *UserQuery {
 	return query
 }
 
+// QueryOrganization queries the organization edge of a Webhook.
+func (c *WebhookClient) QueryOrganization(_m *Webhook) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhook.Table, webhook.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, webhook.OrganizationTable, webhook.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDeliveries queries the deliveries edge of a Webhook.
+func (c *WebhookClient) QueryDeliveries(_m *Webhook) *WebhookDeliveryQuery {
+	query := (&WebhookDeliveryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhook.Table, webhook.FieldID, id),
+			sqlgraph.To(webhookdelivery.Table, webhookdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, webhook.DeliveriesTable, webhook.DeliveriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *WebhookClient) Hooks() []Hook {
 	return c.hooks.Webhook
@@ -7440,26 +10996,334 @@ func (c *WebhookClient) mutate(ctx context.Context, m *WebhookMutation) (Value,
 	}
 }
 
+// WebhookDeliveryClient is a client for the WebhookDelivery schema.
+type WebhookDeliveryClient struct {
+	config
+}
+
+// NewWebhookDeliveryClient returns a client for the WebhookDelivery from the given config.
+func NewWebhookDeliveryClient(c config) *WebhookDeliveryClient {
+	return &WebhookDeliveryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookdelivery.Hooks(f(g(h())))`.
+func (c *WebhookDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.WebhookDelivery = append(c.hooks.WebhookDelivery, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhookdelivery.Intercept(f(g(h())))`.
+func (c *WebhookDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookDelivery = append(c.inters.WebhookDelivery, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookDelivery entity.
+func (c *WebhookDeliveryClient) Create() *WebhookDeliveryCreate {
+	mutation := newWebhookDeliveryMutation(c.config, OpCreate)
+	return &WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookDelivery entities.
+func (c *WebhookDeliveryClient) CreateBulk(builders ...*WebhookDeliveryCreate) *WebhookDeliveryCreateBulk {
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookDeliveryClient) MapCreateBulk(slice any, setFunc func(*WebhookDeliveryCreate, int)) *WebhookDeliveryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookDeliveryCreateBulk{err: fmt.Errorf("calling to WebhookDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookDeliveryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Update() *WebhookDeliveryUpdate {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdate)
+	return &WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookDeliveryClient) UpdateOne(_m *WebhookDelivery) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDelivery(_m))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookDeliveryClient) UpdateOneID(id int) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDeliveryID(id))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Delete() *WebhookDeliveryDelete {
+	mutation := newWebhookDeliveryMutation(c.config, OpDelete)
+	return &WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookDeliveryClient) DeleteOne(_m *WebhookDelivery) *WebhookDeliveryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookDeliveryClient) DeleteOneID(id int) *WebhookDeliveryDeleteOne {
+	builder := c.Delete().Where(webhookdelivery.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeliveryDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Query() *WebhookDeliveryQuery {
+	return &WebhookDeliveryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhookDelivery},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebhookDelivery entity by its id.
+func (c *WebhookDeliveryClient) Get(ctx context.Context, id int) (*WebhookDelivery, error) {
+	return c.Query().Where(webhookdelivery.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookDeliveryClient) GetX(ctx context.Context, id int) *WebhookDelivery {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryWebhook queries the webhook edge of a WebhookDelivery.
+func (c *WebhookDeliveryClient) QueryWebhook(_m *WebhookDelivery) *WebhookQuery {
+	query := (&WebhookClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhookdelivery.Table, webhookdelivery.FieldID, id),
+			sqlgraph.To(webhook.Table, webhook.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, webhookdelivery.WebhookTable, webhookdelivery.WebhookColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookDeliveryClient) Hooks() []Hook {
+	return c.hooks.WebhookDelivery
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.WebhookDelivery
+}
+
+func (c *WebhookDeliveryClient) mutate(ctx context.Context, m *WebhookDeliveryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WebhookDelivery mutation op: %q", m.Op())
+	}
+}
+
+// ZapierDeliveryClient is a client for the ZapierDelivery schema.
+type ZapierDeliveryClient struct {
+	config
+}
+
+// NewZapierDeliveryClient returns a client for the ZapierDelivery from the given config.
+func NewZapierDeliveryClient(c config) *ZapierDeliveryClient {
+	return &ZapierDeliveryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `zapierdelivery.Hooks(f(g(h())))`.
+func (c *ZapierDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.ZapierDelivery = append(c.hooks.ZapierDelivery, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `zapierdelivery.Intercept(f(g(h())))`.
+func (c *ZapierDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ZapierDelivery = append(c.inters.ZapierDelivery, interceptors...)
+}
+
+// Create returns a builder for creating a ZapierDelivery entity.
+func (c *ZapierDeliveryClient) Create() *ZapierDeliveryCreate {
+	mutation := newZapierDeliveryMutation(c.config, OpCreate)
+	return &ZapierDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ZapierDelivery entities.
+func (c *ZapierDeliveryClient) CreateBulk(builders ...*ZapierDeliveryCreate) *ZapierDeliveryCreateBulk {
+	return &ZapierDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ZapierDeliveryClient) MapCreateBulk(slice any, setFunc func(*ZapierDeliveryCreate, int)) *ZapierDeliveryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ZapierDeliveryCreateBulk{err: fmt.Errorf("calling to ZapierDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ZapierDeliveryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ZapierDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ZapierDelivery.
+func (c *ZapierDeliveryClient) Update() *ZapierDeliveryUpdate {
+	mutation := newZapierDeliveryMutation(c.config, OpUpdate)
+	return &ZapierDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ZapierDeliveryClient) UpdateOne(_m *ZapierDelivery) *ZapierDeliveryUpdateOne {
+	mutation := newZapierDeliveryMutation(c.config, OpUpdateOne, withZapierDelivery(_m))
+	return &ZapierDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ZapierDeliveryClient) UpdateOneID(id int) *ZapierDeliveryUpdateOne {
+	mutation := newZapierDeliveryMutation(c.config, OpUpdateOne, withZapierDeliveryID(id))
+	return &ZapierDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ZapierDelivery.
+func (c *ZapierDeliveryClient) Delete() *ZapierDeliveryDelete {
+	mutation := newZapierDeliveryMutation(c.config, OpDelete)
+	return &ZapierDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ZapierDeliveryClient) DeleteOne(_m *ZapierDelivery) *ZapierDeliveryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ZapierDeliveryClient) DeleteOneID(id int) *ZapierDeliveryDeleteOne {
+	builder := c.Delete().Where(zapierdelivery.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ZapierDeliveryDeleteOne{builder}
+}
+
+// Query returns a query builder for ZapierDelivery.
+func (c *ZapierDeliveryClient) Query() *ZapierDeliveryQuery {
+	return &ZapierDeliveryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeZapierDelivery},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ZapierDelivery entity by its id.
+func (c *ZapierDeliveryClient) Get(ctx context.Context, id int) (*ZapierDelivery, error) {
+	return c.Query().Where(zapierdelivery.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ZapierDeliveryClient) GetX(ctx context.Context, id int) *ZapierDelivery {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAPIKey queries the api_key edge of a ZapierDelivery.
+func (c *ZapierDeliveryClient) QueryAPIKey(_m *ZapierDelivery) *APIKeyQuery {
+	query := (&APIKeyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(zapierdelivery.Table, zapierdelivery.FieldID, id),
+			sqlgraph.To(apikey.Table, apikey.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, zapierdelivery.APIKeyTable, zapierdelivery.APIKeyColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ZapierDeliveryClient) Hooks() []Hook {
+	return c.hooks.ZapierDelivery
+}
+
+// Interceptors returns the client interceptors.
+func (c *ZapierDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.ZapierDelivery
+}
+
+func (c *ZapierDeliveryClient) mutate(ctx context.Context, m *ZapierDeliveryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ZapierDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ZapierDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ZapierDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ZapierDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ZapierDelivery mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
 		APIKey, Affiliate, AffiliateClick, AffiliateConversion, AuditLog,
-		CRMIntegration, CRMLeadSync, CallLog, CompetitorMetric, CompetitorProfile,
-		EmailCampaign, EmailCampaignRecipient, EmailSequence, EmailSequenceEnrollment,
-		EmailSequenceSend, EmailSequenceStep, Experiment, ExperimentAssignment, Export,
-		Industry, Lead, LeadAssignment, LeadNote, LeadRecommendation,
-		LeadStatusHistory, MarketReport, Organization, OrganizationMember, Referral,
-		SMSCampaign, SMSMessage, SavedSearch, Subscription, Territory, TerritoryMember,
-		UsageLog, User, UserBehavior, Webhook []ent.Hook
+		CRMIntegration, CRMLeadSync, CRMPushJob, CallLog, CompetitorMetric,
+		CompetitorProfile, ContactAttempt, EmailCampaign, EmailCampaignRecipient,
+		EmailSend, EmailSequence, EmailSequenceEnrollment, EmailSequenceSend,
+		EmailSequenceStep, EmailSuppression, EnrichmentAttempt, EnrichmentBudget,
+		Experiment, ExperimentAssignment, Export, ExportTemplate, ImportJob, Industry,
+		IntegrationConnection, Lead, LeadAssignment, LeadChange, LeadLicense, LeadNote,
+		LeadRecommendation, LeadReindexJob, LeadStatusHistory, LeadSuppression,
+		LeadVerification, MarketReport, Organization, OrganizationMember, Referral,
+		SMSCampaign, SMSMessage, SavedSearch, SavedSearchSnapshot, ScheduledExport,
+		Subscription, Territory, TerritoryMember, UsageDailyAggregate, UsageLog, User,
+		UserBehavior, UserNotificationPreference, Webhook, WebhookDelivery,
+		ZapierDelivery []ent.Hook
 	}
 	inters struct {
 		APIKey, Affiliate, AffiliateClick, AffiliateConversion, AuditLog,
-		CRMIntegration, CRMLeadSync, CallLog, CompetitorMetric, CompetitorProfile,
-		EmailCampaign, EmailCampaignRecipient, EmailSequence, EmailSequenceEnrollment,
-		EmailSequenceSend, EmailSequenceStep, Experiment, ExperimentAssignment, Export,
-		Industry, Lead, LeadAssignment, LeadNote, LeadRecommendation,
-		LeadStatusHistory, MarketReport, Organization, OrganizationMember, Referral,
-		SMSCampaign, SMSMessage, SavedSearch, Subscription, Territory, TerritoryMember,
-		UsageLog, User, UserBehavior, Webhook []ent.Interceptor
+		CRMIntegration, CRMLeadSync, CRMPushJob, CallLog, CompetitorMetric,
+		CompetitorProfile, ContactAttempt, EmailCampaign, EmailCampaignRecipient,
+		EmailSend, EmailSequence, EmailSequenceEnrollment, EmailSequenceSend,
+		EmailSequenceStep, EmailSuppression, EnrichmentAttempt, EnrichmentBudget,
+		Experiment, ExperimentAssignment, Export, ExportTemplate, ImportJob, Industry,
+		IntegrationConnection, Lead, LeadAssignment, LeadChange, LeadLicense, LeadNote,
+		LeadRecommendation, LeadReindexJob, LeadStatusHistory, LeadSuppression,
+		LeadVerification, MarketReport, Organization, OrganizationMember, Referral,
+		SMSCampaign, SMSMessage, SavedSearch, SavedSearchSnapshot, ScheduledExport,
+		Subscription, Territory, TerritoryMember, UsageDailyAggregate, UsageLog, User,
+		UserBehavior, UserNotificationPreference, Webhook, WebhookDelivery,
+		ZapierDelivery []ent.Interceptor
 	}
 )
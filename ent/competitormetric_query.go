@@ -24,6 +24,7 @@ type CompetitorMetricQuery struct {
 	inters         []Interceptor
 	predicates     []predicate.CompetitorMetric
 	withCompetitor *CompetitorProfileQuery
+	modifiers      []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -276,8 +277,9 @@ func (_q *CompetitorMetricQuery) Clone() *CompetitorMetricQuery {
 		predicates:     append([]predicate.CompetitorMetric{}, _q.predicates...),
 		withCompetitor: _q.withCompetitor.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -383,6 +385,9 @@ func (_q *CompetitorMetricQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -433,6 +438,9 @@ func (_q *CompetitorMetricQuery) loadCompetitor(ctx context.Context, query *Comp
 
 func (_q *CompetitorMetricQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -498,6 +506,9 @@ func (_q *CompetitorMetricQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -515,6 +526,12 @@ func (_q *CompetitorMetricQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *CompetitorMetricQuery) Modify(modifiers ...func(s *sql.Selector)) *CompetitorMetricSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // CompetitorMetricGroupBy is the group-by builder for CompetitorMetric entities.
 type CompetitorMetricGroupBy struct {
 	selector
@@ -604,3 +621,9 @@ func (_s *CompetitorMetricSelect) sqlScan(ctx context.Context, root *CompetitorM
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *CompetitorMetricSelect) Modify(modifiers ...func(s *sql.Selector)) *CompetitorMetricSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
@@ -19,8 +19,9 @@ import (
 // CompetitorMetricUpdate is the builder for updating CompetitorMetric entities.
 type CompetitorMetricUpdate struct {
 	config
-	hooks    []Hook
-	mutation *CompetitorMetricMutation
+	hooks     []Hook
+	mutation  *CompetitorMetricMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the CompetitorMetricUpdate builder.
@@ -257,6 +258,12 @@ func (_u *CompetitorMetricUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CompetitorMetricUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CompetitorMetricUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *CompetitorMetricUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -337,6 +344,7 @@ func (_u *CompetitorMetricUpdate) sqlSave(ctx context.Context) (_node int, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{competitormetric.Label}
@@ -352,9 +360,10 @@ func (_u *CompetitorMetricUpdate) sqlSave(ctx context.Context) (_node int, err e
 // CompetitorMetricUpdateOne is the builder for updating a single CompetitorMetric entity.
 type CompetitorMetricUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *CompetitorMetricMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *CompetitorMetricMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetCompetitorID sets the "competitor_id" field.
@@ -598,6 +607,12 @@ func (_u *CompetitorMetricUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CompetitorMetricUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CompetitorMetricUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *CompetitorMetricUpdateOne) sqlSave(ctx context.Context) (_node *CompetitorMetric, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -695,6 +710,7 @@ func (_u *CompetitorMetricUpdateOne) sqlSave(ctx context.Context) (_node *Compet
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &CompetitorMetric{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
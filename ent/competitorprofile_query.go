@@ -27,6 +27,7 @@ type CompetitorProfileQuery struct {
 	predicates  []predicate.CompetitorProfile
 	withUser    *UserQuery
 	withMetrics *CompetitorMetricQuery
+	modifiers   []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -302,8 +303,9 @@ func (_q *CompetitorProfileQuery) Clone() *CompetitorProfileQuery {
 		withUser:    _q.withUser.Clone(),
 		withMetrics: _q.withMetrics.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -421,6 +423,9 @@ func (_q *CompetitorProfileQuery) sqlAll(ctx context.Context, hooks ...queryHook
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -508,6 +513,9 @@ func (_q *CompetitorProfileQuery) loadMetrics(ctx context.Context, query *Compet
 
 func (_q *CompetitorProfileQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -573,6 +581,9 @@ func (_q *CompetitorProfileQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -590,6 +601,12 @@ func (_q *CompetitorProfileQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *CompetitorProfileQuery) Modify(modifiers ...func(s *sql.Selector)) *CompetitorProfileSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // CompetitorProfileGroupBy is the group-by builder for CompetitorProfile entities.
 type CompetitorProfileGroupBy struct {
 	selector
@@ -679,3 +696,9 @@ func (_s *CompetitorProfileSelect) sqlScan(ctx context.Context, root *Competitor
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *CompetitorProfileSelect) Modify(modifiers ...func(s *sql.Selector)) *CompetitorProfileSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
@@ -21,8 +21,9 @@ import (
 // CompetitorProfileUpdate is the builder for updating CompetitorProfile entities.
 type CompetitorProfileUpdate struct {
 	config
-	hooks    []Hook
-	mutation *CompetitorProfileMutation
+	hooks     []Hook
+	mutation  *CompetitorProfileMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the CompetitorProfileUpdate builder.
@@ -495,6 +496,12 @@ func (_u *CompetitorProfileUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CompetitorProfileUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CompetitorProfileUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *CompetitorProfileUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -700,6 +707,7 @@ func (_u *CompetitorProfileUpdate) sqlSave(ctx context.Context) (_node int, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{competitorprofile.Label}
@@ -715,9 +723,10 @@ func (_u *CompetitorProfileUpdate) sqlSave(ctx context.Context) (_node int, err
 // CompetitorProfileUpdateOne is the builder for updating a single CompetitorProfile entity.
 type CompetitorProfileUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *CompetitorProfileMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *CompetitorProfileMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUserID sets the "user_id" field.
@@ -1197,6 +1206,12 @@ func (_u *CompetitorProfileUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CompetitorProfileUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CompetitorProfileUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *CompetitorProfileUpdateOne) sqlSave(ctx context.Context) (_node *CompetitorProfile, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -1419,6 +1434,7 @@ func (_u *CompetitorProfileUpdateOne) sqlSave(ctx context.Context) (_node *Compe
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &CompetitorProfile{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,209 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/jordanlanch/industrydb/ent/contactattempt"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/user"
+)
+
+// ContactAttempt is the model entity for the ContactAttempt schema.
+type ContactAttempt struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ID of the lead that was contacted
+	LeadID int `json:"lead_id,omitempty"`
+	// ID of the user who made the attempt
+	UserID int `json:"user_id,omitempty"`
+	// Outreach channel
+	Type contactattempt.Type `json:"type,omitempty"`
+	// Result of the attempt (replied and connected count as connects)
+	Outcome contactattempt.Outcome `json:"outcome,omitempty"`
+	// When the attempt was made
+	AttemptedAt time.Time `json:"attempted_at,omitempty"`
+	// Creation timestamp
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ContactAttemptQuery when eager-loading is set.
+	Edges        ContactAttemptEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ContactAttemptEdges holds the relations/edges for other nodes in the graph.
+type ContactAttemptEdges struct {
+	// Lead that was contacted
+	Lead *Lead `json:"lead,omitempty"`
+	// User who made the attempt
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// LeadOrErr returns the Lead value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ContactAttemptEdges) LeadOrErr() (*Lead, error) {
+	if e.Lead != nil {
+		return e.Lead, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: lead.Label}
+	}
+	return nil, &NotLoadedError{edge: "lead"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ContactAttemptEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ContactAttempt) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case contactattempt.FieldID, contactattempt.FieldLeadID, contactattempt.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case contactattempt.FieldType, contactattempt.FieldOutcome:
+			values[i] = new(sql.NullString)
+		case contactattempt.FieldAttemptedAt, contactattempt.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ContactAttempt fields.
+func (_m *ContactAttempt) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case contactattempt.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case contactattempt.FieldLeadID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field lead_id", values[i])
+			} else if value.Valid {
+				_m.LeadID = int(value.Int64)
+			}
+		case contactattempt.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case contactattempt.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				_m.Type = contactattempt.Type(value.String)
+			}
+		case contactattempt.FieldOutcome:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field outcome", values[i])
+			} else if value.Valid {
+				_m.Outcome = contactattempt.Outcome(value.String)
+			}
+		case contactattempt.FieldAttemptedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field attempted_at", values[i])
+			} else if value.Valid {
+				_m.AttemptedAt = value.Time
+			}
+		case contactattempt.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ContactAttempt.
+// This includes values selected through modifiers, order, etc.
+func (_m *ContactAttempt) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryLead queries the "lead" edge of the ContactAttempt entity.
+func (_m *ContactAttempt) QueryLead() *LeadQuery {
+	return NewContactAttemptClient(_m.config).QueryLead(_m)
+}
+
+// QueryUser queries the "user" edge of the ContactAttempt entity.
+func (_m *ContactAttempt) QueryUser() *UserQuery {
+	return NewContactAttemptClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this ContactAttempt.
+// Note that you need to call ContactAttempt.Unwrap() before calling this method if this ContactAttempt
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ContactAttempt) Update() *ContactAttemptUpdateOne {
+	return NewContactAttemptClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ContactAttempt entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ContactAttempt) Unwrap() *ContactAttempt {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ContactAttempt is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ContactAttempt) String() string {
+	var builder strings.Builder
+	builder.WriteString("ContactAttempt(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("lead_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LeadID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Type))
+	builder.WriteString(", ")
+	builder.WriteString("outcome=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Outcome))
+	builder.WriteString(", ")
+	builder.WriteString("attempted_at=")
+	builder.WriteString(_m.AttemptedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ContactAttempts is a parsable slice of ContactAttempt.
+type ContactAttempts []*ContactAttempt
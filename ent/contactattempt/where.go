@@ -0,0 +1,297 @@
+// Code generated by ent, DO NOT EDIT.
+
+package contactattempt
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldLTE(FieldID, id))
+}
+
+// LeadID applies equality check predicate on the "lead_id" field. It's identical to LeadIDEQ.
+func LeadID(v int) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldEQ(FieldLeadID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldEQ(FieldUserID, v))
+}
+
+// AttemptedAt applies equality check predicate on the "attempted_at" field. It's identical to AttemptedAtEQ.
+func AttemptedAt(v time.Time) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldEQ(FieldAttemptedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// LeadIDEQ applies the EQ predicate on the "lead_id" field.
+func LeadIDEQ(v int) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldEQ(FieldLeadID, v))
+}
+
+// LeadIDNEQ applies the NEQ predicate on the "lead_id" field.
+func LeadIDNEQ(v int) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldNEQ(FieldLeadID, v))
+}
+
+// LeadIDIn applies the In predicate on the "lead_id" field.
+func LeadIDIn(vs ...int) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldIn(FieldLeadID, vs...))
+}
+
+// LeadIDNotIn applies the NotIn predicate on the "lead_id" field.
+func LeadIDNotIn(vs ...int) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldNotIn(FieldLeadID, vs...))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v Type) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v Type) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...Type) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...Type) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldNotIn(FieldType, vs...))
+}
+
+// OutcomeEQ applies the EQ predicate on the "outcome" field.
+func OutcomeEQ(v Outcome) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldEQ(FieldOutcome, v))
+}
+
+// OutcomeNEQ applies the NEQ predicate on the "outcome" field.
+func OutcomeNEQ(v Outcome) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldNEQ(FieldOutcome, v))
+}
+
+// OutcomeIn applies the In predicate on the "outcome" field.
+func OutcomeIn(vs ...Outcome) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldIn(FieldOutcome, vs...))
+}
+
+// OutcomeNotIn applies the NotIn predicate on the "outcome" field.
+func OutcomeNotIn(vs ...Outcome) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldNotIn(FieldOutcome, vs...))
+}
+
+// AttemptedAtEQ applies the EQ predicate on the "attempted_at" field.
+func AttemptedAtEQ(v time.Time) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldEQ(FieldAttemptedAt, v))
+}
+
+// AttemptedAtNEQ applies the NEQ predicate on the "attempted_at" field.
+func AttemptedAtNEQ(v time.Time) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldNEQ(FieldAttemptedAt, v))
+}
+
+// AttemptedAtIn applies the In predicate on the "attempted_at" field.
+func AttemptedAtIn(vs ...time.Time) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldIn(FieldAttemptedAt, vs...))
+}
+
+// AttemptedAtNotIn applies the NotIn predicate on the "attempted_at" field.
+func AttemptedAtNotIn(vs ...time.Time) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldNotIn(FieldAttemptedAt, vs...))
+}
+
+// AttemptedAtGT applies the GT predicate on the "attempted_at" field.
+func AttemptedAtGT(v time.Time) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldGT(FieldAttemptedAt, v))
+}
+
+// AttemptedAtGTE applies the GTE predicate on the "attempted_at" field.
+func AttemptedAtGTE(v time.Time) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldGTE(FieldAttemptedAt, v))
+}
+
+// AttemptedAtLT applies the LT predicate on the "attempted_at" field.
+func AttemptedAtLT(v time.Time) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldLT(FieldAttemptedAt, v))
+}
+
+// AttemptedAtLTE applies the LTE predicate on the "attempted_at" field.
+func AttemptedAtLTE(v time.Time) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldLTE(FieldAttemptedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasLead applies the HasEdge predicate on the "lead" edge.
+func HasLead() predicate.ContactAttempt {
+	return predicate.ContactAttempt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, LeadTable, LeadColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLeadWith applies the HasEdge predicate on the "lead" edge with a given conditions (other predicates).
+func HasLeadWith(preds ...predicate.Lead) predicate.ContactAttempt {
+	return predicate.ContactAttempt(func(s *sql.Selector) {
+		step := newLeadStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.ContactAttempt {
+	return predicate.ContactAttempt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.ContactAttempt {
+	return predicate.ContactAttempt(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ContactAttempt) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ContactAttempt) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ContactAttempt) predicate.ContactAttempt {
+	return predicate.ContactAttempt(sql.NotPredicates(p))
+}
@@ -0,0 +1,343 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/contactattempt"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/user"
+)
+
+// ContactAttemptCreate is the builder for creating a ContactAttempt entity.
+type ContactAttemptCreate struct {
+	config
+	mutation *ContactAttemptMutation
+	hooks    []Hook
+}
+
+// SetLeadID sets the "lead_id" field.
+func (_c *ContactAttemptCreate) SetLeadID(v int) *ContactAttemptCreate {
+	_c.mutation.SetLeadID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *ContactAttemptCreate) SetUserID(v int) *ContactAttemptCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *ContactAttemptCreate) SetType(v contactattempt.Type) *ContactAttemptCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetOutcome sets the "outcome" field.
+func (_c *ContactAttemptCreate) SetOutcome(v contactattempt.Outcome) *ContactAttemptCreate {
+	_c.mutation.SetOutcome(v)
+	return _c
+}
+
+// SetAttemptedAt sets the "attempted_at" field.
+func (_c *ContactAttemptCreate) SetAttemptedAt(v time.Time) *ContactAttemptCreate {
+	_c.mutation.SetAttemptedAt(v)
+	return _c
+}
+
+// SetNillableAttemptedAt sets the "attempted_at" field if the given value is not nil.
+func (_c *ContactAttemptCreate) SetNillableAttemptedAt(v *time.Time) *ContactAttemptCreate {
+	if v != nil {
+		_c.SetAttemptedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ContactAttemptCreate) SetCreatedAt(v time.Time) *ContactAttemptCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ContactAttemptCreate) SetNillableCreatedAt(v *time.Time) *ContactAttemptCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetLead sets the "lead" edge to the Lead entity.
+func (_c *ContactAttemptCreate) SetLead(v *Lead) *ContactAttemptCreate {
+	return _c.SetLeadID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *ContactAttemptCreate) SetUser(v *User) *ContactAttemptCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the ContactAttemptMutation object of the builder.
+func (_c *ContactAttemptCreate) Mutation() *ContactAttemptMutation {
+	return _c.mutation
+}
+
+// Save creates the ContactAttempt in the database.
+func (_c *ContactAttemptCreate) Save(ctx context.Context) (*ContactAttempt, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ContactAttemptCreate) SaveX(ctx context.Context) *ContactAttempt {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ContactAttemptCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ContactAttemptCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ContactAttemptCreate) defaults() {
+	if _, ok := _c.mutation.AttemptedAt(); !ok {
+		v := contactattempt.DefaultAttemptedAt()
+		_c.mutation.SetAttemptedAt(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := contactattempt.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ContactAttemptCreate) check() error {
+	if _, ok := _c.mutation.LeadID(); !ok {
+		return &ValidationError{Name: "lead_id", err: errors.New(`ent: missing required field "ContactAttempt.lead_id"`)}
+	}
+	if v, ok := _c.mutation.LeadID(); ok {
+		if err := contactattempt.LeadIDValidator(v); err != nil {
+			return &ValidationError{Name: "lead_id", err: fmt.Errorf(`ent: validator failed for field "ContactAttempt.lead_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ContactAttempt.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := contactattempt.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "ContactAttempt.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "ContactAttempt.type"`)}
+	}
+	if v, ok := _c.mutation.GetType(); ok {
+		if err := contactattempt.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "ContactAttempt.type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Outcome(); !ok {
+		return &ValidationError{Name: "outcome", err: errors.New(`ent: missing required field "ContactAttempt.outcome"`)}
+	}
+	if v, ok := _c.mutation.Outcome(); ok {
+		if err := contactattempt.OutcomeValidator(v); err != nil {
+			return &ValidationError{Name: "outcome", err: fmt.Errorf(`ent: validator failed for field "ContactAttempt.outcome": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AttemptedAt(); !ok {
+		return &ValidationError{Name: "attempted_at", err: errors.New(`ent: missing required field "ContactAttempt.attempted_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ContactAttempt.created_at"`)}
+	}
+	if len(_c.mutation.LeadIDs()) == 0 {
+		return &ValidationError{Name: "lead", err: errors.New(`ent: missing required edge "ContactAttempt.lead"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "ContactAttempt.user"`)}
+	}
+	return nil
+}
+
+func (_c *ContactAttemptCreate) sqlSave(ctx context.Context) (*ContactAttempt, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ContactAttemptCreate) createSpec() (*ContactAttempt, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ContactAttempt{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(contactattempt.Table, sqlgraph.NewFieldSpec(contactattempt.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(contactattempt.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.Outcome(); ok {
+		_spec.SetField(contactattempt.FieldOutcome, field.TypeEnum, value)
+		_node.Outcome = value
+	}
+	if value, ok := _c.mutation.AttemptedAt(); ok {
+		_spec.SetField(contactattempt.FieldAttemptedAt, field.TypeTime, value)
+		_node.AttemptedAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(contactattempt.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.LeadIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contactattempt.LeadTable,
+			Columns: []string{contactattempt.LeadColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LeadID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contactattempt.UserTable,
+			Columns: []string{contactattempt.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ContactAttemptCreateBulk is the builder for creating many ContactAttempt entities in bulk.
+type ContactAttemptCreateBulk struct {
+	config
+	err      error
+	builders []*ContactAttemptCreate
+}
+
+// Save creates the ContactAttempt entities in the database.
+func (_c *ContactAttemptCreateBulk) Save(ctx context.Context) ([]*ContactAttempt, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ContactAttempt, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ContactAttemptMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ContactAttemptCreateBulk) SaveX(ctx context.Context) []*ContactAttempt {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ContactAttemptCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ContactAttemptCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,580 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/contactattempt"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+	"github.com/jordanlanch/industrydb/ent/user"
+)
+
+// ContactAttemptUpdate is the builder for updating ContactAttempt entities.
+type ContactAttemptUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ContactAttemptMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ContactAttemptUpdate builder.
+func (_u *ContactAttemptUpdate) Where(ps ...predicate.ContactAttempt) *ContactAttemptUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetLeadID sets the "lead_id" field.
+func (_u *ContactAttemptUpdate) SetLeadID(v int) *ContactAttemptUpdate {
+	_u.mutation.SetLeadID(v)
+	return _u
+}
+
+// SetNillableLeadID sets the "lead_id" field if the given value is not nil.
+func (_u *ContactAttemptUpdate) SetNillableLeadID(v *int) *ContactAttemptUpdate {
+	if v != nil {
+		_u.SetLeadID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ContactAttemptUpdate) SetUserID(v int) *ContactAttemptUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ContactAttemptUpdate) SetNillableUserID(v *int) *ContactAttemptUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *ContactAttemptUpdate) SetType(v contactattempt.Type) *ContactAttemptUpdate {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *ContactAttemptUpdate) SetNillableType(v *contactattempt.Type) *ContactAttemptUpdate {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetOutcome sets the "outcome" field.
+func (_u *ContactAttemptUpdate) SetOutcome(v contactattempt.Outcome) *ContactAttemptUpdate {
+	_u.mutation.SetOutcome(v)
+	return _u
+}
+
+// SetNillableOutcome sets the "outcome" field if the given value is not nil.
+func (_u *ContactAttemptUpdate) SetNillableOutcome(v *contactattempt.Outcome) *ContactAttemptUpdate {
+	if v != nil {
+		_u.SetOutcome(*v)
+	}
+	return _u
+}
+
+// SetAttemptedAt sets the "attempted_at" field.
+func (_u *ContactAttemptUpdate) SetAttemptedAt(v time.Time) *ContactAttemptUpdate {
+	_u.mutation.SetAttemptedAt(v)
+	return _u
+}
+
+// SetNillableAttemptedAt sets the "attempted_at" field if the given value is not nil.
+func (_u *ContactAttemptUpdate) SetNillableAttemptedAt(v *time.Time) *ContactAttemptUpdate {
+	if v != nil {
+		_u.SetAttemptedAt(*v)
+	}
+	return _u
+}
+
+// SetLead sets the "lead" edge to the Lead entity.
+func (_u *ContactAttemptUpdate) SetLead(v *Lead) *ContactAttemptUpdate {
+	return _u.SetLeadID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *ContactAttemptUpdate) SetUser(v *User) *ContactAttemptUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the ContactAttemptMutation object of the builder.
+func (_u *ContactAttemptUpdate) Mutation() *ContactAttemptMutation {
+	return _u.mutation
+}
+
+// ClearLead clears the "lead" edge to the Lead entity.
+func (_u *ContactAttemptUpdate) ClearLead() *ContactAttemptUpdate {
+	_u.mutation.ClearLead()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *ContactAttemptUpdate) ClearUser() *ContactAttemptUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ContactAttemptUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ContactAttemptUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ContactAttemptUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ContactAttemptUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ContactAttemptUpdate) check() error {
+	if v, ok := _u.mutation.LeadID(); ok {
+		if err := contactattempt.LeadIDValidator(v); err != nil {
+			return &ValidationError{Name: "lead_id", err: fmt.Errorf(`ent: validator failed for field "ContactAttempt.lead_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := contactattempt.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "ContactAttempt.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := contactattempt.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "ContactAttempt.type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Outcome(); ok {
+		if err := contactattempt.OutcomeValidator(v); err != nil {
+			return &ValidationError{Name: "outcome", err: fmt.Errorf(`ent: validator failed for field "ContactAttempt.outcome": %w`, err)}
+		}
+	}
+	if _u.mutation.LeadCleared() && len(_u.mutation.LeadIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ContactAttempt.lead"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ContactAttempt.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ContactAttemptUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ContactAttemptUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ContactAttemptUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(contactattempt.Table, contactattempt.Columns, sqlgraph.NewFieldSpec(contactattempt.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(contactattempt.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Outcome(); ok {
+		_spec.SetField(contactattempt.FieldOutcome, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.AttemptedAt(); ok {
+		_spec.SetField(contactattempt.FieldAttemptedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LeadCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contactattempt.LeadTable,
+			Columns: []string{contactattempt.LeadColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LeadIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contactattempt.LeadTable,
+			Columns: []string{contactattempt.LeadColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contactattempt.UserTable,
+			Columns: []string{contactattempt.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contactattempt.UserTable,
+			Columns: []string{contactattempt.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{contactattempt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ContactAttemptUpdateOne is the builder for updating a single ContactAttempt entity.
+type ContactAttemptUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ContactAttemptMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetLeadID sets the "lead_id" field.
+func (_u *ContactAttemptUpdateOne) SetLeadID(v int) *ContactAttemptUpdateOne {
+	_u.mutation.SetLeadID(v)
+	return _u
+}
+
+// SetNillableLeadID sets the "lead_id" field if the given value is not nil.
+func (_u *ContactAttemptUpdateOne) SetNillableLeadID(v *int) *ContactAttemptUpdateOne {
+	if v != nil {
+		_u.SetLeadID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ContactAttemptUpdateOne) SetUserID(v int) *ContactAttemptUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ContactAttemptUpdateOne) SetNillableUserID(v *int) *ContactAttemptUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *ContactAttemptUpdateOne) SetType(v contactattempt.Type) *ContactAttemptUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *ContactAttemptUpdateOne) SetNillableType(v *contactattempt.Type) *ContactAttemptUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetOutcome sets the "outcome" field.
+func (_u *ContactAttemptUpdateOne) SetOutcome(v contactattempt.Outcome) *ContactAttemptUpdateOne {
+	_u.mutation.SetOutcome(v)
+	return _u
+}
+
+// SetNillableOutcome sets the "outcome" field if the given value is not nil.
+func (_u *ContactAttemptUpdateOne) SetNillableOutcome(v *contactattempt.Outcome) *ContactAttemptUpdateOne {
+	if v != nil {
+		_u.SetOutcome(*v)
+	}
+	return _u
+}
+
+// SetAttemptedAt sets the "attempted_at" field.
+func (_u *ContactAttemptUpdateOne) SetAttemptedAt(v time.Time) *ContactAttemptUpdateOne {
+	_u.mutation.SetAttemptedAt(v)
+	return _u
+}
+
+// SetNillableAttemptedAt sets the "attempted_at" field if the given value is not nil.
+func (_u *ContactAttemptUpdateOne) SetNillableAttemptedAt(v *time.Time) *ContactAttemptUpdateOne {
+	if v != nil {
+		_u.SetAttemptedAt(*v)
+	}
+	return _u
+}
+
+// SetLead sets the "lead" edge to the Lead entity.
+func (_u *ContactAttemptUpdateOne) SetLead(v *Lead) *ContactAttemptUpdateOne {
+	return _u.SetLeadID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *ContactAttemptUpdateOne) SetUser(v *User) *ContactAttemptUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the ContactAttemptMutation object of the builder.
+func (_u *ContactAttemptUpdateOne) Mutation() *ContactAttemptMutation {
+	return _u.mutation
+}
+
+// ClearLead clears the "lead" edge to the Lead entity.
+func (_u *ContactAttemptUpdateOne) ClearLead() *ContactAttemptUpdateOne {
+	_u.mutation.ClearLead()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *ContactAttemptUpdateOne) ClearUser() *ContactAttemptUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the ContactAttemptUpdate builder.
+func (_u *ContactAttemptUpdateOne) Where(ps ...predicate.ContactAttempt) *ContactAttemptUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ContactAttemptUpdateOne) Select(field string, fields ...string) *ContactAttemptUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ContactAttempt entity.
+func (_u *ContactAttemptUpdateOne) Save(ctx context.Context) (*ContactAttempt, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ContactAttemptUpdateOne) SaveX(ctx context.Context) *ContactAttempt {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ContactAttemptUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ContactAttemptUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ContactAttemptUpdateOne) check() error {
+	if v, ok := _u.mutation.LeadID(); ok {
+		if err := contactattempt.LeadIDValidator(v); err != nil {
+			return &ValidationError{Name: "lead_id", err: fmt.Errorf(`ent: validator failed for field "ContactAttempt.lead_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := contactattempt.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "ContactAttempt.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := contactattempt.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "ContactAttempt.type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Outcome(); ok {
+		if err := contactattempt.OutcomeValidator(v); err != nil {
+			return &ValidationError{Name: "outcome", err: fmt.Errorf(`ent: validator failed for field "ContactAttempt.outcome": %w`, err)}
+		}
+	}
+	if _u.mutation.LeadCleared() && len(_u.mutation.LeadIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ContactAttempt.lead"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ContactAttempt.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ContactAttemptUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ContactAttemptUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ContactAttemptUpdateOne) sqlSave(ctx context.Context) (_node *ContactAttempt, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(contactattempt.Table, contactattempt.Columns, sqlgraph.NewFieldSpec(contactattempt.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ContactAttempt.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, contactattempt.FieldID)
+		for _, f := range fields {
+			if !contactattempt.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != contactattempt.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(contactattempt.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Outcome(); ok {
+		_spec.SetField(contactattempt.FieldOutcome, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.AttemptedAt(); ok {
+		_spec.SetField(contactattempt.FieldAttemptedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LeadCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contactattempt.LeadTable,
+			Columns: []string{contactattempt.LeadColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LeadIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contactattempt.LeadTable,
+			Columns: []string{contactattempt.LeadColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contactattempt.UserTable,
+			Columns: []string{contactattempt.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contactattempt.UserTable,
+			Columns: []string{contactattempt.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ContactAttempt{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{contactattempt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
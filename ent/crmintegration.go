@@ -65,9 +65,11 @@ type CRMIntegrationEdges struct {
 	User *User `json:"user,omitempty"`
 	// Leads synced through this integration
 	SyncedLeads []*CRMLeadSync `json:"synced_leads,omitempty"`
+	// Push jobs run through this integration
+	PushJobs []*CRMPushJob `json:"push_jobs,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -90,6 +92,15 @@ func (e CRMIntegrationEdges) SyncedLeadsOrErr() ([]*CRMLeadSync, error) {
 	return nil, &NotLoadedError{edge: "synced_leads"}
 }
 
+// PushJobsOrErr returns the PushJobs value or an error if the edge
+// was not loaded in eager-loading.
+func (e CRMIntegrationEdges) PushJobsOrErr() ([]*CRMPushJob, error) {
+	if e.loadedTypes[2] {
+		return e.PushJobs, nil
+	}
+	return nil, &NotLoadedError{edge: "push_jobs"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*CRMIntegration) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -255,6 +266,11 @@ func (_m *CRMIntegration) QuerySyncedLeads() *CRMLeadSyncQuery {
 	return NewCRMIntegrationClient(_m.config).QuerySyncedLeads(_m)
 }
 
+// QueryPushJobs queries the "push_jobs" edge of the CRMIntegration entity.
+func (_m *CRMIntegration) QueryPushJobs() *CRMPushJobQuery {
+	return NewCRMIntegrationClient(_m.config).QueryPushJobs(_m)
+}
+
 // Update returns a builder for updating this CRMIntegration.
 // Note that you need to call CRMIntegration.Unwrap() before calling this method if this CRMIntegration
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -53,6 +53,8 @@ const (
 	EdgeUser = "user"
 	// EdgeSyncedLeads holds the string denoting the synced_leads edge name in mutations.
 	EdgeSyncedLeads = "synced_leads"
+	// EdgePushJobs holds the string denoting the push_jobs edge name in mutations.
+	EdgePushJobs = "push_jobs"
 	// Table holds the table name of the crmintegration in the database.
 	Table = "crm_integrations"
 	// UserTable is the table that holds the user relation/edge.
@@ -69,6 +71,13 @@ const (
 	SyncedLeadsInverseTable = "crm_lead_syncs"
 	// SyncedLeadsColumn is the table column denoting the synced_leads relation/edge.
 	SyncedLeadsColumn = "integration_id"
+	// PushJobsTable is the table that holds the push_jobs relation/edge.
+	PushJobsTable = "crm_push_jobs"
+	// PushJobsInverseTable is the table name for the CRMPushJob entity.
+	// It exists in this package in order to avoid circular dependency with the "crmpushjob" package.
+	PushJobsInverseTable = "crm_push_jobs"
+	// PushJobsColumn is the table column denoting the push_jobs relation/edge.
+	PushJobsColumn = "integration_id"
 )
 
 // Columns holds all SQL columns for crmintegration fields.
@@ -284,6 +293,20 @@ func BySyncedLeads(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newSyncedLeadsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByPushJobsCount orders the results by push_jobs count.
+func ByPushJobsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPushJobsStep(), opts...)
+	}
+}
+
+// ByPushJobs orders the results by push_jobs terms.
+func ByPushJobs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPushJobsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -298,3 +321,10 @@ func newSyncedLeadsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, SyncedLeadsTable, SyncedLeadsColumn),
 	)
 }
+func newPushJobsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PushJobsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PushJobsTable, PushJobsColumn),
+	)
+}
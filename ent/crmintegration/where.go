@@ -886,6 +886,29 @@ func HasSyncedLeadsWith(preds ...predicate.CRMLeadSync) predicate.CRMIntegration
 	})
 }
 
+// HasPushJobs applies the HasEdge predicate on the "push_jobs" edge.
+func HasPushJobs() predicate.CRMIntegration {
+	return predicate.CRMIntegration(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PushJobsTable, PushJobsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPushJobsWith applies the HasEdge predicate on the "push_jobs" edge with a given conditions (other predicates).
+func HasPushJobsWith(preds ...predicate.CRMPushJob) predicate.CRMIntegration {
+	return predicate.CRMIntegration(func(s *sql.Selector) {
+		step := newPushJobsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.CRMIntegration) predicate.CRMIntegration {
 	return predicate.CRMIntegration(sql.AndPredicates(predicates...))
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/jordanlanch/industrydb/ent/crmintegration"
 	"github.com/jordanlanch/industrydb/ent/crmleadsync"
+	"github.com/jordanlanch/industrydb/ent/crmpushjob"
 	"github.com/jordanlanch/industrydb/ent/user"
 )
 
@@ -248,6 +249,21 @@ func (_c *CRMIntegrationCreate) AddSyncedLeads(v ...*CRMLeadSync) *CRMIntegratio
 	return _c.AddSyncedLeadIDs(ids...)
 }
 
+// AddPushJobIDs adds the "push_jobs" edge to the CRMPushJob entity by IDs.
+func (_c *CRMIntegrationCreate) AddPushJobIDs(ids ...int) *CRMIntegrationCreate {
+	_c.mutation.AddPushJobIDs(ids...)
+	return _c
+}
+
+// AddPushJobs adds the "push_jobs" edges to the CRMPushJob entity.
+func (_c *CRMIntegrationCreate) AddPushJobs(v ...*CRMPushJob) *CRMIntegrationCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPushJobIDs(ids...)
+}
+
 // Mutation returns the CRMIntegrationMutation object of the builder.
 func (_c *CRMIntegrationCreate) Mutation() *CRMIntegrationMutation {
 	return _c.mutation
@@ -491,6 +507,22 @@ func (_c *CRMIntegrationCreate) createSpec() (*CRMIntegration, *sqlgraph.CreateS
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.PushJobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   crmintegration.PushJobsTable,
+			Columns: []string{crmintegration.PushJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(crmpushjob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -14,6 +14,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/jordanlanch/industrydb/ent/crmintegration"
 	"github.com/jordanlanch/industrydb/ent/crmleadsync"
+	"github.com/jordanlanch/industrydb/ent/crmpushjob"
 	"github.com/jordanlanch/industrydb/ent/predicate"
 	"github.com/jordanlanch/industrydb/ent/user"
 )
@@ -27,6 +28,8 @@ type CRMIntegrationQuery struct {
 	predicates      []predicate.CRMIntegration
 	withUser        *UserQuery
 	withSyncedLeads *CRMLeadSyncQuery
+	withPushJobs    *CRMPushJobQuery
+	modifiers       []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -107,6 +110,28 @@ func (_q *CRMIntegrationQuery) QuerySyncedLeads() *CRMLeadSyncQuery {
 	return query
 }
 
+// QueryPushJobs chains the current query on the "push_jobs" edge.
+func (_q *CRMIntegrationQuery) QueryPushJobs() *CRMPushJobQuery {
+	query := (&CRMPushJobClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(crmintegration.Table, crmintegration.FieldID, selector),
+			sqlgraph.To(crmpushjob.Table, crmpushjob.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, crmintegration.PushJobsTable, crmintegration.PushJobsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first CRMIntegration entity from the query.
 // Returns a *NotFoundError when no CRMIntegration was found.
 func (_q *CRMIntegrationQuery) First(ctx context.Context) (*CRMIntegration, error) {
@@ -301,9 +326,11 @@ func (_q *CRMIntegrationQuery) Clone() *CRMIntegrationQuery {
 		predicates:      append([]predicate.CRMIntegration{}, _q.predicates...),
 		withUser:        _q.withUser.Clone(),
 		withSyncedLeads: _q.withSyncedLeads.Clone(),
+		withPushJobs:    _q.withPushJobs.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -329,6 +356,17 @@ func (_q *CRMIntegrationQuery) WithSyncedLeads(opts ...func(*CRMLeadSyncQuery))
 	return _q
 }
 
+// WithPushJobs tells the query-builder to eager-load the nodes that are connected to
+// the "push_jobs" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CRMIntegrationQuery) WithPushJobs(opts ...func(*CRMPushJobQuery)) *CRMIntegrationQuery {
+	query := (&CRMPushJobClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPushJobs = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -407,9 +445,10 @@ func (_q *CRMIntegrationQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 	var (
 		nodes       = []*CRMIntegration{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			_q.withUser != nil,
 			_q.withSyncedLeads != nil,
+			_q.withPushJobs != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -421,6 +460,9 @@ func (_q *CRMIntegrationQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -443,6 +485,13 @@ func (_q *CRMIntegrationQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 			return nil, err
 		}
 	}
+	if query := _q.withPushJobs; query != nil {
+		if err := _q.loadPushJobs(ctx, query, nodes,
+			func(n *CRMIntegration) { n.Edges.PushJobs = []*CRMPushJob{} },
+			func(n *CRMIntegration, e *CRMPushJob) { n.Edges.PushJobs = append(n.Edges.PushJobs, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -505,9 +554,42 @@ func (_q *CRMIntegrationQuery) loadSyncedLeads(ctx context.Context, query *CRMLe
 	}
 	return nil
 }
+func (_q *CRMIntegrationQuery) loadPushJobs(ctx context.Context, query *CRMPushJobQuery, nodes []*CRMIntegration, init func(*CRMIntegration), assign func(*CRMIntegration, *CRMPushJob)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*CRMIntegration)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(crmpushjob.FieldIntegrationID)
+	}
+	query.Where(predicate.CRMPushJob(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(crmintegration.PushJobsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.IntegrationID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "integration_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *CRMIntegrationQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -573,6 +655,9 @@ func (_q *CRMIntegrationQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -590,6 +675,12 @@ func (_q *CRMIntegrationQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *CRMIntegrationQuery) Modify(modifiers ...func(s *sql.Selector)) *CRMIntegrationSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // CRMIntegrationGroupBy is the group-by builder for CRMIntegration entities.
 type CRMIntegrationGroupBy struct {
 	selector
@@ -679,3 +770,9 @@ func (_s *CRMIntegrationSelect) sqlScan(ctx context.Context, root *CRMIntegratio
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *CRMIntegrationSelect) Modify(modifiers ...func(s *sql.Selector)) *CRMIntegrationSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/jordanlanch/industrydb/ent/crmintegration"
 	"github.com/jordanlanch/industrydb/ent/crmleadsync"
+	"github.com/jordanlanch/industrydb/ent/crmpushjob"
 	"github.com/jordanlanch/industrydb/ent/predicate"
 	"github.com/jordanlanch/industrydb/ent/user"
 )
@@ -20,8 +21,9 @@ import (
 // CRMIntegrationUpdate is the builder for updating CRMIntegration entities.
 type CRMIntegrationUpdate struct {
 	config
-	hooks    []Hook
-	mutation *CRMIntegrationMutation
+	hooks     []Hook
+	mutation  *CRMIntegrationMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the CRMIntegrationUpdate builder.
@@ -314,6 +316,21 @@ func (_u *CRMIntegrationUpdate) AddSyncedLeads(v ...*CRMLeadSync) *CRMIntegratio
 	return _u.AddSyncedLeadIDs(ids...)
 }
 
+// AddPushJobIDs adds the "push_jobs" edge to the CRMPushJob entity by IDs.
+func (_u *CRMIntegrationUpdate) AddPushJobIDs(ids ...int) *CRMIntegrationUpdate {
+	_u.mutation.AddPushJobIDs(ids...)
+	return _u
+}
+
+// AddPushJobs adds the "push_jobs" edges to the CRMPushJob entity.
+func (_u *CRMIntegrationUpdate) AddPushJobs(v ...*CRMPushJob) *CRMIntegrationUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPushJobIDs(ids...)
+}
+
 // Mutation returns the CRMIntegrationMutation object of the builder.
 func (_u *CRMIntegrationUpdate) Mutation() *CRMIntegrationMutation {
 	return _u.mutation
@@ -346,6 +363,27 @@ func (_u *CRMIntegrationUpdate) RemoveSyncedLeads(v ...*CRMLeadSync) *CRMIntegra
 	return _u.RemoveSyncedLeadIDs(ids...)
 }
 
+// ClearPushJobs clears all "push_jobs" edges to the CRMPushJob entity.
+func (_u *CRMIntegrationUpdate) ClearPushJobs() *CRMIntegrationUpdate {
+	_u.mutation.ClearPushJobs()
+	return _u
+}
+
+// RemovePushJobIDs removes the "push_jobs" edge to CRMPushJob entities by IDs.
+func (_u *CRMIntegrationUpdate) RemovePushJobIDs(ids ...int) *CRMIntegrationUpdate {
+	_u.mutation.RemovePushJobIDs(ids...)
+	return _u
+}
+
+// RemovePushJobs removes "push_jobs" edges to CRMPushJob entities.
+func (_u *CRMIntegrationUpdate) RemovePushJobs(v ...*CRMPushJob) *CRMIntegrationUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePushJobIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *CRMIntegrationUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -410,6 +448,12 @@ func (_u *CRMIntegrationUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CRMIntegrationUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CRMIntegrationUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *CRMIntegrationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -568,6 +612,52 @@ func (_u *CRMIntegrationUpdate) sqlSave(ctx context.Context) (_node int, err err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.PushJobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   crmintegration.PushJobsTable,
+			Columns: []string{crmintegration.PushJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(crmpushjob.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPushJobsIDs(); len(nodes) > 0 && !_u.mutation.PushJobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   crmintegration.PushJobsTable,
+			Columns: []string{crmintegration.PushJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(crmpushjob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PushJobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   crmintegration.PushJobsTable,
+			Columns: []string{crmintegration.PushJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(crmpushjob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{crmintegration.Label}
@@ -583,9 +673,10 @@ func (_u *CRMIntegrationUpdate) sqlSave(ctx context.Context) (_node int, err err
 // CRMIntegrationUpdateOne is the builder for updating a single CRMIntegration entity.
 type CRMIntegrationUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *CRMIntegrationMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *CRMIntegrationMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUserID sets the "user_id" field.
@@ -872,6 +963,21 @@ func (_u *CRMIntegrationUpdateOne) AddSyncedLeads(v ...*CRMLeadSync) *CRMIntegra
 	return _u.AddSyncedLeadIDs(ids...)
 }
 
+// AddPushJobIDs adds the "push_jobs" edge to the CRMPushJob entity by IDs.
+func (_u *CRMIntegrationUpdateOne) AddPushJobIDs(ids ...int) *CRMIntegrationUpdateOne {
+	_u.mutation.AddPushJobIDs(ids...)
+	return _u
+}
+
+// AddPushJobs adds the "push_jobs" edges to the CRMPushJob entity.
+func (_u *CRMIntegrationUpdateOne) AddPushJobs(v ...*CRMPushJob) *CRMIntegrationUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPushJobIDs(ids...)
+}
+
 // Mutation returns the CRMIntegrationMutation object of the builder.
 func (_u *CRMIntegrationUpdateOne) Mutation() *CRMIntegrationMutation {
 	return _u.mutation
@@ -904,6 +1010,27 @@ func (_u *CRMIntegrationUpdateOne) RemoveSyncedLeads(v ...*CRMLeadSync) *CRMInte
 	return _u.RemoveSyncedLeadIDs(ids...)
 }
 
+// ClearPushJobs clears all "push_jobs" edges to the CRMPushJob entity.
+func (_u *CRMIntegrationUpdateOne) ClearPushJobs() *CRMIntegrationUpdateOne {
+	_u.mutation.ClearPushJobs()
+	return _u
+}
+
+// RemovePushJobIDs removes the "push_jobs" edge to CRMPushJob entities by IDs.
+func (_u *CRMIntegrationUpdateOne) RemovePushJobIDs(ids ...int) *CRMIntegrationUpdateOne {
+	_u.mutation.RemovePushJobIDs(ids...)
+	return _u
+}
+
+// RemovePushJobs removes "push_jobs" edges to CRMPushJob entities.
+func (_u *CRMIntegrationUpdateOne) RemovePushJobs(v ...*CRMPushJob) *CRMIntegrationUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePushJobIDs(ids...)
+}
+
 // Where appends a list predicates to the CRMIntegrationUpdate builder.
 func (_u *CRMIntegrationUpdateOne) Where(ps ...predicate.CRMIntegration) *CRMIntegrationUpdateOne {
 	_u.mutation.Where(ps...)
@@ -981,6 +1108,12 @@ func (_u *CRMIntegrationUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CRMIntegrationUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CRMIntegrationUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *CRMIntegrationUpdateOne) sqlSave(ctx context.Context) (_node *CRMIntegration, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -1156,6 +1289,52 @@ func (_u *CRMIntegrationUpdateOne) sqlSave(ctx context.Context) (_node *CRMInteg
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.PushJobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   crmintegration.PushJobsTable,
+			Columns: []string{crmintegration.PushJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(crmpushjob.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPushJobsIDs(); len(nodes) > 0 && !_u.mutation.PushJobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   crmintegration.PushJobsTable,
+			Columns: []string{crmintegration.PushJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(crmpushjob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PushJobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   crmintegration.PushJobsTable,
+			Columns: []string{crmintegration.PushJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(crmpushjob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &CRMIntegration{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
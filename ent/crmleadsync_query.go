@@ -24,6 +24,7 @@ type CRMLeadSyncQuery struct {
 	inters          []Interceptor
 	predicates      []predicate.CRMLeadSync
 	withIntegration *CRMIntegrationQuery
+	modifiers       []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -276,8 +277,9 @@ func (_q *CRMLeadSyncQuery) Clone() *CRMLeadSyncQuery {
 		predicates:      append([]predicate.CRMLeadSync{}, _q.predicates...),
 		withIntegration: _q.withIntegration.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -383,6 +385,9 @@ func (_q *CRMLeadSyncQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -433,6 +438,9 @@ func (_q *CRMLeadSyncQuery) loadIntegration(ctx context.Context, query *CRMInteg
 
 func (_q *CRMLeadSyncQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -498,6 +506,9 @@ func (_q *CRMLeadSyncQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -515,6 +526,12 @@ func (_q *CRMLeadSyncQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *CRMLeadSyncQuery) Modify(modifiers ...func(s *sql.Selector)) *CRMLeadSyncSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // CRMLeadSyncGroupBy is the group-by builder for CRMLeadSync entities.
 type CRMLeadSyncGroupBy struct {
 	selector
@@ -604,3 +621,9 @@ func (_s *CRMLeadSyncSelect) sqlScan(ctx context.Context, root *CRMLeadSyncQuery
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *CRMLeadSyncSelect) Modify(modifiers ...func(s *sql.Selector)) *CRMLeadSyncSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
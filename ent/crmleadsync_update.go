@@ -19,8 +19,9 @@ import (
 // CRMLeadSyncUpdate is the builder for updating CRMLeadSync entities.
 type CRMLeadSyncUpdate struct {
 	config
-	hooks    []Hook
-	mutation *CRMLeadSyncMutation
+	hooks     []Hook
+	mutation  *CRMLeadSyncMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the CRMLeadSyncUpdate builder.
@@ -248,6 +249,12 @@ func (_u *CRMLeadSyncUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CRMLeadSyncUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CRMLeadSyncUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *CRMLeadSyncUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -328,6 +335,7 @@ func (_u *CRMLeadSyncUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{crmleadsync.Label}
@@ -343,9 +351,10 @@ func (_u *CRMLeadSyncUpdate) sqlSave(ctx context.Context) (_node int, err error)
 // CRMLeadSyncUpdateOne is the builder for updating a single CRMLeadSync entity.
 type CRMLeadSyncUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *CRMLeadSyncMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *CRMLeadSyncMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetIntegrationID sets the "integration_id" field.
@@ -580,6 +589,12 @@ func (_u *CRMLeadSyncUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CRMLeadSyncUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CRMLeadSyncUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *CRMLeadSyncUpdateOne) sqlSave(ctx context.Context) (_node *CRMLeadSync, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -677,6 +692,7 @@ func (_u *CRMLeadSyncUpdateOne) sqlSave(ctx context.Context) (_node *CRMLeadSync
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &CRMLeadSync{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,657 @@
+// Code generated by ent, DO NOT EDIT.
+
+package crmpushjob
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldEQ(FieldUserID, v))
+}
+
+// IntegrationID applies equality check predicate on the "integration_id" field. It's identical to IntegrationIDEQ.
+func IntegrationID(v int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldEQ(FieldIntegrationID, v))
+}
+
+// TotalLeads applies equality check predicate on the "total_leads" field. It's identical to TotalLeadsEQ.
+func TotalLeads(v int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldEQ(FieldTotalLeads, v))
+}
+
+// CreatedCount applies equality check predicate on the "created_count" field. It's identical to CreatedCountEQ.
+func CreatedCount(v int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldEQ(FieldCreatedCount, v))
+}
+
+// UpdatedCount applies equality check predicate on the "updated_count" field. It's identical to UpdatedCountEQ.
+func UpdatedCount(v int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldEQ(FieldUpdatedCount, v))
+}
+
+// FailureCount applies equality check predicate on the "failure_count" field. It's identical to FailureCountEQ.
+func FailureCount(v int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldEQ(FieldFailureCount, v))
+}
+
+// ErrorMessage applies equality check predicate on the "error_message" field. It's identical to ErrorMessageEQ.
+func ErrorMessage(v string) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldEQ(FieldErrorMessage, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
+func CompletedAt(v time.Time) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// IntegrationIDEQ applies the EQ predicate on the "integration_id" field.
+func IntegrationIDEQ(v int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldEQ(FieldIntegrationID, v))
+}
+
+// IntegrationIDNEQ applies the NEQ predicate on the "integration_id" field.
+func IntegrationIDNEQ(v int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldNEQ(FieldIntegrationID, v))
+}
+
+// IntegrationIDIn applies the In predicate on the "integration_id" field.
+func IntegrationIDIn(vs ...int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldIn(FieldIntegrationID, vs...))
+}
+
+// IntegrationIDNotIn applies the NotIn predicate on the "integration_id" field.
+func IntegrationIDNotIn(vs ...int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldNotIn(FieldIntegrationID, vs...))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// TotalLeadsEQ applies the EQ predicate on the "total_leads" field.
+func TotalLeadsEQ(v int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldEQ(FieldTotalLeads, v))
+}
+
+// TotalLeadsNEQ applies the NEQ predicate on the "total_leads" field.
+func TotalLeadsNEQ(v int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldNEQ(FieldTotalLeads, v))
+}
+
+// TotalLeadsIn applies the In predicate on the "total_leads" field.
+func TotalLeadsIn(vs ...int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldIn(FieldTotalLeads, vs...))
+}
+
+// TotalLeadsNotIn applies the NotIn predicate on the "total_leads" field.
+func TotalLeadsNotIn(vs ...int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldNotIn(FieldTotalLeads, vs...))
+}
+
+// TotalLeadsGT applies the GT predicate on the "total_leads" field.
+func TotalLeadsGT(v int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldGT(FieldTotalLeads, v))
+}
+
+// TotalLeadsGTE applies the GTE predicate on the "total_leads" field.
+func TotalLeadsGTE(v int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldGTE(FieldTotalLeads, v))
+}
+
+// TotalLeadsLT applies the LT predicate on the "total_leads" field.
+func TotalLeadsLT(v int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldLT(FieldTotalLeads, v))
+}
+
+// TotalLeadsLTE applies the LTE predicate on the "total_leads" field.
+func TotalLeadsLTE(v int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldLTE(FieldTotalLeads, v))
+}
+
+// CreatedCountEQ applies the EQ predicate on the "created_count" field.
+func CreatedCountEQ(v int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldEQ(FieldCreatedCount, v))
+}
+
+// CreatedCountNEQ applies the NEQ predicate on the "created_count" field.
+func CreatedCountNEQ(v int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldNEQ(FieldCreatedCount, v))
+}
+
+// CreatedCountIn applies the In predicate on the "created_count" field.
+func CreatedCountIn(vs ...int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldIn(FieldCreatedCount, vs...))
+}
+
+// CreatedCountNotIn applies the NotIn predicate on the "created_count" field.
+func CreatedCountNotIn(vs ...int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldNotIn(FieldCreatedCount, vs...))
+}
+
+// CreatedCountGT applies the GT predicate on the "created_count" field.
+func CreatedCountGT(v int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldGT(FieldCreatedCount, v))
+}
+
+// CreatedCountGTE applies the GTE predicate on the "created_count" field.
+func CreatedCountGTE(v int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldGTE(FieldCreatedCount, v))
+}
+
+// CreatedCountLT applies the LT predicate on the "created_count" field.
+func CreatedCountLT(v int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldLT(FieldCreatedCount, v))
+}
+
+// CreatedCountLTE applies the LTE predicate on the "created_count" field.
+func CreatedCountLTE(v int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldLTE(FieldCreatedCount, v))
+}
+
+// UpdatedCountEQ applies the EQ predicate on the "updated_count" field.
+func UpdatedCountEQ(v int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldEQ(FieldUpdatedCount, v))
+}
+
+// UpdatedCountNEQ applies the NEQ predicate on the "updated_count" field.
+func UpdatedCountNEQ(v int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldNEQ(FieldUpdatedCount, v))
+}
+
+// UpdatedCountIn applies the In predicate on the "updated_count" field.
+func UpdatedCountIn(vs ...int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldIn(FieldUpdatedCount, vs...))
+}
+
+// UpdatedCountNotIn applies the NotIn predicate on the "updated_count" field.
+func UpdatedCountNotIn(vs ...int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldNotIn(FieldUpdatedCount, vs...))
+}
+
+// UpdatedCountGT applies the GT predicate on the "updated_count" field.
+func UpdatedCountGT(v int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldGT(FieldUpdatedCount, v))
+}
+
+// UpdatedCountGTE applies the GTE predicate on the "updated_count" field.
+func UpdatedCountGTE(v int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldGTE(FieldUpdatedCount, v))
+}
+
+// UpdatedCountLT applies the LT predicate on the "updated_count" field.
+func UpdatedCountLT(v int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldLT(FieldUpdatedCount, v))
+}
+
+// UpdatedCountLTE applies the LTE predicate on the "updated_count" field.
+func UpdatedCountLTE(v int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldLTE(FieldUpdatedCount, v))
+}
+
+// FailureCountEQ applies the EQ predicate on the "failure_count" field.
+func FailureCountEQ(v int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldEQ(FieldFailureCount, v))
+}
+
+// FailureCountNEQ applies the NEQ predicate on the "failure_count" field.
+func FailureCountNEQ(v int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldNEQ(FieldFailureCount, v))
+}
+
+// FailureCountIn applies the In predicate on the "failure_count" field.
+func FailureCountIn(vs ...int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldIn(FieldFailureCount, vs...))
+}
+
+// FailureCountNotIn applies the NotIn predicate on the "failure_count" field.
+func FailureCountNotIn(vs ...int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldNotIn(FieldFailureCount, vs...))
+}
+
+// FailureCountGT applies the GT predicate on the "failure_count" field.
+func FailureCountGT(v int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldGT(FieldFailureCount, v))
+}
+
+// FailureCountGTE applies the GTE predicate on the "failure_count" field.
+func FailureCountGTE(v int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldGTE(FieldFailureCount, v))
+}
+
+// FailureCountLT applies the LT predicate on the "failure_count" field.
+func FailureCountLT(v int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldLT(FieldFailureCount, v))
+}
+
+// FailureCountLTE applies the LTE predicate on the "failure_count" field.
+func FailureCountLTE(v int) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldLTE(FieldFailureCount, v))
+}
+
+// ResultsIsNil applies the IsNil predicate on the "results" field.
+func ResultsIsNil() predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldIsNull(FieldResults))
+}
+
+// ResultsNotNil applies the NotNil predicate on the "results" field.
+func ResultsNotNil() predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldNotNull(FieldResults))
+}
+
+// ErrorMessageEQ applies the EQ predicate on the "error_message" field.
+func ErrorMessageEQ(v string) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldEQ(FieldErrorMessage, v))
+}
+
+// ErrorMessageNEQ applies the NEQ predicate on the "error_message" field.
+func ErrorMessageNEQ(v string) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldNEQ(FieldErrorMessage, v))
+}
+
+// ErrorMessageIn applies the In predicate on the "error_message" field.
+func ErrorMessageIn(vs ...string) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldIn(FieldErrorMessage, vs...))
+}
+
+// ErrorMessageNotIn applies the NotIn predicate on the "error_message" field.
+func ErrorMessageNotIn(vs ...string) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldNotIn(FieldErrorMessage, vs...))
+}
+
+// ErrorMessageGT applies the GT predicate on the "error_message" field.
+func ErrorMessageGT(v string) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldGT(FieldErrorMessage, v))
+}
+
+// ErrorMessageGTE applies the GTE predicate on the "error_message" field.
+func ErrorMessageGTE(v string) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldGTE(FieldErrorMessage, v))
+}
+
+// ErrorMessageLT applies the LT predicate on the "error_message" field.
+func ErrorMessageLT(v string) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldLT(FieldErrorMessage, v))
+}
+
+// ErrorMessageLTE applies the LTE predicate on the "error_message" field.
+func ErrorMessageLTE(v string) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldLTE(FieldErrorMessage, v))
+}
+
+// ErrorMessageContains applies the Contains predicate on the "error_message" field.
+func ErrorMessageContains(v string) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldContains(FieldErrorMessage, v))
+}
+
+// ErrorMessageHasPrefix applies the HasPrefix predicate on the "error_message" field.
+func ErrorMessageHasPrefix(v string) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldHasPrefix(FieldErrorMessage, v))
+}
+
+// ErrorMessageHasSuffix applies the HasSuffix predicate on the "error_message" field.
+func ErrorMessageHasSuffix(v string) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldHasSuffix(FieldErrorMessage, v))
+}
+
+// ErrorMessageIsNil applies the IsNil predicate on the "error_message" field.
+func ErrorMessageIsNil() predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldIsNull(FieldErrorMessage))
+}
+
+// ErrorMessageNotNil applies the NotNil predicate on the "error_message" field.
+func ErrorMessageNotNil() predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldNotNull(FieldErrorMessage))
+}
+
+// ErrorMessageEqualFold applies the EqualFold predicate on the "error_message" field.
+func ErrorMessageEqualFold(v string) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldEqualFold(FieldErrorMessage, v))
+}
+
+// ErrorMessageContainsFold applies the ContainsFold predicate on the "error_message" field.
+func ErrorMessageContainsFold(v string) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldContainsFold(FieldErrorMessage, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// StartedAtIsNil applies the IsNil predicate on the "started_at" field.
+func StartedAtIsNil() predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldIsNull(FieldStartedAt))
+}
+
+// StartedAtNotNil applies the NotNil predicate on the "started_at" field.
+func StartedAtNotNil() predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldNotNull(FieldStartedAt))
+}
+
+// CompletedAtEQ applies the EQ predicate on the "completed_at" field.
+func CompletedAtEQ(v time.Time) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtNEQ applies the NEQ predicate on the "completed_at" field.
+func CompletedAtNEQ(v time.Time) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldNEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtIn applies the In predicate on the "completed_at" field.
+func CompletedAtIn(vs ...time.Time) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtNotIn applies the NotIn predicate on the "completed_at" field.
+func CompletedAtNotIn(vs ...time.Time) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldNotIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtGT applies the GT predicate on the "completed_at" field.
+func CompletedAtGT(v time.Time) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldGT(FieldCompletedAt, v))
+}
+
+// CompletedAtGTE applies the GTE predicate on the "completed_at" field.
+func CompletedAtGTE(v time.Time) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldGTE(FieldCompletedAt, v))
+}
+
+// CompletedAtLT applies the LT predicate on the "completed_at" field.
+func CompletedAtLT(v time.Time) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldLT(FieldCompletedAt, v))
+}
+
+// CompletedAtLTE applies the LTE predicate on the "completed_at" field.
+func CompletedAtLTE(v time.Time) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldLTE(FieldCompletedAt, v))
+}
+
+// CompletedAtIsNil applies the IsNil predicate on the "completed_at" field.
+func CompletedAtIsNil() predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldIsNull(FieldCompletedAt))
+}
+
+// CompletedAtNotNil applies the NotNil predicate on the "completed_at" field.
+func CompletedAtNotNil() predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldNotNull(FieldCompletedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.CRMPushJob {
+	return predicate.CRMPushJob(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.CRMPushJob {
+	return predicate.CRMPushJob(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasIntegration applies the HasEdge predicate on the "integration" edge.
+func HasIntegration() predicate.CRMPushJob {
+	return predicate.CRMPushJob(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, IntegrationTable, IntegrationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasIntegrationWith applies the HasEdge predicate on the "integration" edge with a given conditions (other predicates).
+func HasIntegrationWith(preds ...predicate.CRMIntegration) predicate.CRMPushJob {
+	return predicate.CRMPushJob(func(s *sql.Selector) {
+		step := newIntegrationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CRMPushJob) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CRMPushJob) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CRMPushJob) predicate.CRMPushJob {
+	return predicate.CRMPushJob(sql.NotPredicates(p))
+}
@@ -0,0 +1,516 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/crmintegration"
+	"github.com/jordanlanch/industrydb/ent/crmpushjob"
+	"github.com/jordanlanch/industrydb/ent/user"
+)
+
+// CRMPushJobCreate is the builder for creating a CRMPushJob entity.
+type CRMPushJobCreate struct {
+	config
+	mutation *CRMPushJobMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *CRMPushJobCreate) SetUserID(v int) *CRMPushJobCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetIntegrationID sets the "integration_id" field.
+func (_c *CRMPushJobCreate) SetIntegrationID(v int) *CRMPushJobCreate {
+	_c.mutation.SetIntegrationID(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *CRMPushJobCreate) SetStatus(v crmpushjob.Status) *CRMPushJobCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *CRMPushJobCreate) SetNillableStatus(v *crmpushjob.Status) *CRMPushJobCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetTotalLeads sets the "total_leads" field.
+func (_c *CRMPushJobCreate) SetTotalLeads(v int) *CRMPushJobCreate {
+	_c.mutation.SetTotalLeads(v)
+	return _c
+}
+
+// SetNillableTotalLeads sets the "total_leads" field if the given value is not nil.
+func (_c *CRMPushJobCreate) SetNillableTotalLeads(v *int) *CRMPushJobCreate {
+	if v != nil {
+		_c.SetTotalLeads(*v)
+	}
+	return _c
+}
+
+// SetCreatedCount sets the "created_count" field.
+func (_c *CRMPushJobCreate) SetCreatedCount(v int) *CRMPushJobCreate {
+	_c.mutation.SetCreatedCount(v)
+	return _c
+}
+
+// SetNillableCreatedCount sets the "created_count" field if the given value is not nil.
+func (_c *CRMPushJobCreate) SetNillableCreatedCount(v *int) *CRMPushJobCreate {
+	if v != nil {
+		_c.SetCreatedCount(*v)
+	}
+	return _c
+}
+
+// SetUpdatedCount sets the "updated_count" field.
+func (_c *CRMPushJobCreate) SetUpdatedCount(v int) *CRMPushJobCreate {
+	_c.mutation.SetUpdatedCount(v)
+	return _c
+}
+
+// SetNillableUpdatedCount sets the "updated_count" field if the given value is not nil.
+func (_c *CRMPushJobCreate) SetNillableUpdatedCount(v *int) *CRMPushJobCreate {
+	if v != nil {
+		_c.SetUpdatedCount(*v)
+	}
+	return _c
+}
+
+// SetFailureCount sets the "failure_count" field.
+func (_c *CRMPushJobCreate) SetFailureCount(v int) *CRMPushJobCreate {
+	_c.mutation.SetFailureCount(v)
+	return _c
+}
+
+// SetNillableFailureCount sets the "failure_count" field if the given value is not nil.
+func (_c *CRMPushJobCreate) SetNillableFailureCount(v *int) *CRMPushJobCreate {
+	if v != nil {
+		_c.SetFailureCount(*v)
+	}
+	return _c
+}
+
+// SetResults sets the "results" field.
+func (_c *CRMPushJobCreate) SetResults(v []map[string]interface{}) *CRMPushJobCreate {
+	_c.mutation.SetResults(v)
+	return _c
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_c *CRMPushJobCreate) SetErrorMessage(v string) *CRMPushJobCreate {
+	_c.mutation.SetErrorMessage(v)
+	return _c
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_c *CRMPushJobCreate) SetNillableErrorMessage(v *string) *CRMPushJobCreate {
+	if v != nil {
+		_c.SetErrorMessage(*v)
+	}
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *CRMPushJobCreate) SetStartedAt(v time.Time) *CRMPushJobCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_c *CRMPushJobCreate) SetNillableStartedAt(v *time.Time) *CRMPushJobCreate {
+	if v != nil {
+		_c.SetStartedAt(*v)
+	}
+	return _c
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_c *CRMPushJobCreate) SetCompletedAt(v time.Time) *CRMPushJobCreate {
+	_c.mutation.SetCompletedAt(v)
+	return _c
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_c *CRMPushJobCreate) SetNillableCompletedAt(v *time.Time) *CRMPushJobCreate {
+	if v != nil {
+		_c.SetCompletedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CRMPushJobCreate) SetCreatedAt(v time.Time) *CRMPushJobCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CRMPushJobCreate) SetNillableCreatedAt(v *time.Time) *CRMPushJobCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *CRMPushJobCreate) SetUpdatedAt(v time.Time) *CRMPushJobCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *CRMPushJobCreate) SetNillableUpdatedAt(v *time.Time) *CRMPushJobCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *CRMPushJobCreate) SetUser(v *User) *CRMPushJobCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// SetIntegration sets the "integration" edge to the CRMIntegration entity.
+func (_c *CRMPushJobCreate) SetIntegration(v *CRMIntegration) *CRMPushJobCreate {
+	return _c.SetIntegrationID(v.ID)
+}
+
+// Mutation returns the CRMPushJobMutation object of the builder.
+func (_c *CRMPushJobCreate) Mutation() *CRMPushJobMutation {
+	return _c.mutation
+}
+
+// Save creates the CRMPushJob in the database.
+func (_c *CRMPushJobCreate) Save(ctx context.Context) (*CRMPushJob, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CRMPushJobCreate) SaveX(ctx context.Context) *CRMPushJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CRMPushJobCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CRMPushJobCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CRMPushJobCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := crmpushjob.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.TotalLeads(); !ok {
+		v := crmpushjob.DefaultTotalLeads
+		_c.mutation.SetTotalLeads(v)
+	}
+	if _, ok := _c.mutation.CreatedCount(); !ok {
+		v := crmpushjob.DefaultCreatedCount
+		_c.mutation.SetCreatedCount(v)
+	}
+	if _, ok := _c.mutation.UpdatedCount(); !ok {
+		v := crmpushjob.DefaultUpdatedCount
+		_c.mutation.SetUpdatedCount(v)
+	}
+	if _, ok := _c.mutation.FailureCount(); !ok {
+		v := crmpushjob.DefaultFailureCount
+		_c.mutation.SetFailureCount(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := crmpushjob.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := crmpushjob.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CRMPushJobCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "CRMPushJob.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := crmpushjob.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "CRMPushJob.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IntegrationID(); !ok {
+		return &ValidationError{Name: "integration_id", err: errors.New(`ent: missing required field "CRMPushJob.integration_id"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "CRMPushJob.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := crmpushjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "CRMPushJob.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TotalLeads(); !ok {
+		return &ValidationError{Name: "total_leads", err: errors.New(`ent: missing required field "CRMPushJob.total_leads"`)}
+	}
+	if v, ok := _c.mutation.TotalLeads(); ok {
+		if err := crmpushjob.TotalLeadsValidator(v); err != nil {
+			return &ValidationError{Name: "total_leads", err: fmt.Errorf(`ent: validator failed for field "CRMPushJob.total_leads": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedCount(); !ok {
+		return &ValidationError{Name: "created_count", err: errors.New(`ent: missing required field "CRMPushJob.created_count"`)}
+	}
+	if v, ok := _c.mutation.CreatedCount(); ok {
+		if err := crmpushjob.CreatedCountValidator(v); err != nil {
+			return &ValidationError{Name: "created_count", err: fmt.Errorf(`ent: validator failed for field "CRMPushJob.created_count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UpdatedCount(); !ok {
+		return &ValidationError{Name: "updated_count", err: errors.New(`ent: missing required field "CRMPushJob.updated_count"`)}
+	}
+	if v, ok := _c.mutation.UpdatedCount(); ok {
+		if err := crmpushjob.UpdatedCountValidator(v); err != nil {
+			return &ValidationError{Name: "updated_count", err: fmt.Errorf(`ent: validator failed for field "CRMPushJob.updated_count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FailureCount(); !ok {
+		return &ValidationError{Name: "failure_count", err: errors.New(`ent: missing required field "CRMPushJob.failure_count"`)}
+	}
+	if v, ok := _c.mutation.FailureCount(); ok {
+		if err := crmpushjob.FailureCountValidator(v); err != nil {
+			return &ValidationError{Name: "failure_count", err: fmt.Errorf(`ent: validator failed for field "CRMPushJob.failure_count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CRMPushJob.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CRMPushJob.updated_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "CRMPushJob.user"`)}
+	}
+	if len(_c.mutation.IntegrationIDs()) == 0 {
+		return &ValidationError{Name: "integration", err: errors.New(`ent: missing required edge "CRMPushJob.integration"`)}
+	}
+	return nil
+}
+
+func (_c *CRMPushJobCreate) sqlSave(ctx context.Context) (*CRMPushJob, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CRMPushJobCreate) createSpec() (*CRMPushJob, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CRMPushJob{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(crmpushjob.Table, sqlgraph.NewFieldSpec(crmpushjob.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(crmpushjob.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.TotalLeads(); ok {
+		_spec.SetField(crmpushjob.FieldTotalLeads, field.TypeInt, value)
+		_node.TotalLeads = value
+	}
+	if value, ok := _c.mutation.CreatedCount(); ok {
+		_spec.SetField(crmpushjob.FieldCreatedCount, field.TypeInt, value)
+		_node.CreatedCount = value
+	}
+	if value, ok := _c.mutation.UpdatedCount(); ok {
+		_spec.SetField(crmpushjob.FieldUpdatedCount, field.TypeInt, value)
+		_node.UpdatedCount = value
+	}
+	if value, ok := _c.mutation.FailureCount(); ok {
+		_spec.SetField(crmpushjob.FieldFailureCount, field.TypeInt, value)
+		_node.FailureCount = value
+	}
+	if value, ok := _c.mutation.Results(); ok {
+		_spec.SetField(crmpushjob.FieldResults, field.TypeJSON, value)
+		_node.Results = value
+	}
+	if value, ok := _c.mutation.ErrorMessage(); ok {
+		_spec.SetField(crmpushjob.FieldErrorMessage, field.TypeString, value)
+		_node.ErrorMessage = value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(crmpushjob.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = &value
+	}
+	if value, ok := _c.mutation.CompletedAt(); ok {
+		_spec.SetField(crmpushjob.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(crmpushjob.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(crmpushjob.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   crmpushjob.UserTable,
+			Columns: []string{crmpushjob.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.IntegrationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   crmpushjob.IntegrationTable,
+			Columns: []string{crmpushjob.IntegrationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(crmintegration.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.IntegrationID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CRMPushJobCreateBulk is the builder for creating many CRMPushJob entities in bulk.
+type CRMPushJobCreateBulk struct {
+	config
+	err      error
+	builders []*CRMPushJobCreate
+}
+
+// Save creates the CRMPushJob entities in the database.
+func (_c *CRMPushJobCreateBulk) Save(ctx context.Context) ([]*CRMPushJob, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CRMPushJob, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CRMPushJobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CRMPushJobCreateBulk) SaveX(ctx context.Context) []*CRMPushJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CRMPushJobCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CRMPushJobCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
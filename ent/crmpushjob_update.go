@@ -0,0 +1,999 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/crmintegration"
+	"github.com/jordanlanch/industrydb/ent/crmpushjob"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+	"github.com/jordanlanch/industrydb/ent/user"
+)
+
+// CRMPushJobUpdate is the builder for updating CRMPushJob entities.
+type CRMPushJobUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *CRMPushJobMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the CRMPushJobUpdate builder.
+func (_u *CRMPushJobUpdate) Where(ps ...predicate.CRMPushJob) *CRMPushJobUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *CRMPushJobUpdate) SetUserID(v int) *CRMPushJobUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *CRMPushJobUpdate) SetNillableUserID(v *int) *CRMPushJobUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetIntegrationID sets the "integration_id" field.
+func (_u *CRMPushJobUpdate) SetIntegrationID(v int) *CRMPushJobUpdate {
+	_u.mutation.SetIntegrationID(v)
+	return _u
+}
+
+// SetNillableIntegrationID sets the "integration_id" field if the given value is not nil.
+func (_u *CRMPushJobUpdate) SetNillableIntegrationID(v *int) *CRMPushJobUpdate {
+	if v != nil {
+		_u.SetIntegrationID(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CRMPushJobUpdate) SetStatus(v crmpushjob.Status) *CRMPushJobUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CRMPushJobUpdate) SetNillableStatus(v *crmpushjob.Status) *CRMPushJobUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetTotalLeads sets the "total_leads" field.
+func (_u *CRMPushJobUpdate) SetTotalLeads(v int) *CRMPushJobUpdate {
+	_u.mutation.ResetTotalLeads()
+	_u.mutation.SetTotalLeads(v)
+	return _u
+}
+
+// SetNillableTotalLeads sets the "total_leads" field if the given value is not nil.
+func (_u *CRMPushJobUpdate) SetNillableTotalLeads(v *int) *CRMPushJobUpdate {
+	if v != nil {
+		_u.SetTotalLeads(*v)
+	}
+	return _u
+}
+
+// AddTotalLeads adds value to the "total_leads" field.
+func (_u *CRMPushJobUpdate) AddTotalLeads(v int) *CRMPushJobUpdate {
+	_u.mutation.AddTotalLeads(v)
+	return _u
+}
+
+// SetCreatedCount sets the "created_count" field.
+func (_u *CRMPushJobUpdate) SetCreatedCount(v int) *CRMPushJobUpdate {
+	_u.mutation.ResetCreatedCount()
+	_u.mutation.SetCreatedCount(v)
+	return _u
+}
+
+// SetNillableCreatedCount sets the "created_count" field if the given value is not nil.
+func (_u *CRMPushJobUpdate) SetNillableCreatedCount(v *int) *CRMPushJobUpdate {
+	if v != nil {
+		_u.SetCreatedCount(*v)
+	}
+	return _u
+}
+
+// AddCreatedCount adds value to the "created_count" field.
+func (_u *CRMPushJobUpdate) AddCreatedCount(v int) *CRMPushJobUpdate {
+	_u.mutation.AddCreatedCount(v)
+	return _u
+}
+
+// SetUpdatedCount sets the "updated_count" field.
+func (_u *CRMPushJobUpdate) SetUpdatedCount(v int) *CRMPushJobUpdate {
+	_u.mutation.ResetUpdatedCount()
+	_u.mutation.SetUpdatedCount(v)
+	return _u
+}
+
+// SetNillableUpdatedCount sets the "updated_count" field if the given value is not nil.
+func (_u *CRMPushJobUpdate) SetNillableUpdatedCount(v *int) *CRMPushJobUpdate {
+	if v != nil {
+		_u.SetUpdatedCount(*v)
+	}
+	return _u
+}
+
+// AddUpdatedCount adds value to the "updated_count" field.
+func (_u *CRMPushJobUpdate) AddUpdatedCount(v int) *CRMPushJobUpdate {
+	_u.mutation.AddUpdatedCount(v)
+	return _u
+}
+
+// SetFailureCount sets the "failure_count" field.
+func (_u *CRMPushJobUpdate) SetFailureCount(v int) *CRMPushJobUpdate {
+	_u.mutation.ResetFailureCount()
+	_u.mutation.SetFailureCount(v)
+	return _u
+}
+
+// SetNillableFailureCount sets the "failure_count" field if the given value is not nil.
+func (_u *CRMPushJobUpdate) SetNillableFailureCount(v *int) *CRMPushJobUpdate {
+	if v != nil {
+		_u.SetFailureCount(*v)
+	}
+	return _u
+}
+
+// AddFailureCount adds value to the "failure_count" field.
+func (_u *CRMPushJobUpdate) AddFailureCount(v int) *CRMPushJobUpdate {
+	_u.mutation.AddFailureCount(v)
+	return _u
+}
+
+// SetResults sets the "results" field.
+func (_u *CRMPushJobUpdate) SetResults(v []map[string]interface{}) *CRMPushJobUpdate {
+	_u.mutation.SetResults(v)
+	return _u
+}
+
+// AppendResults appends value to the "results" field.
+func (_u *CRMPushJobUpdate) AppendResults(v []map[string]interface{}) *CRMPushJobUpdate {
+	_u.mutation.AppendResults(v)
+	return _u
+}
+
+// ClearResults clears the value of the "results" field.
+func (_u *CRMPushJobUpdate) ClearResults() *CRMPushJobUpdate {
+	_u.mutation.ClearResults()
+	return _u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_u *CRMPushJobUpdate) SetErrorMessage(v string) *CRMPushJobUpdate {
+	_u.mutation.SetErrorMessage(v)
+	return _u
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_u *CRMPushJobUpdate) SetNillableErrorMessage(v *string) *CRMPushJobUpdate {
+	if v != nil {
+		_u.SetErrorMessage(*v)
+	}
+	return _u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (_u *CRMPushJobUpdate) ClearErrorMessage() *CRMPushJobUpdate {
+	_u.mutation.ClearErrorMessage()
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *CRMPushJobUpdate) SetStartedAt(v time.Time) *CRMPushJobUpdate {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *CRMPushJobUpdate) SetNillableStartedAt(v *time.Time) *CRMPushJobUpdate {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *CRMPushJobUpdate) ClearStartedAt() *CRMPushJobUpdate {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *CRMPushJobUpdate) SetCompletedAt(v time.Time) *CRMPushJobUpdate {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *CRMPushJobUpdate) SetNillableCompletedAt(v *time.Time) *CRMPushJobUpdate {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *CRMPushJobUpdate) ClearCompletedAt() *CRMPushJobUpdate {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CRMPushJobUpdate) SetUpdatedAt(v time.Time) *CRMPushJobUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *CRMPushJobUpdate) SetUser(v *User) *CRMPushJobUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// SetIntegration sets the "integration" edge to the CRMIntegration entity.
+func (_u *CRMPushJobUpdate) SetIntegration(v *CRMIntegration) *CRMPushJobUpdate {
+	return _u.SetIntegrationID(v.ID)
+}
+
+// Mutation returns the CRMPushJobMutation object of the builder.
+func (_u *CRMPushJobUpdate) Mutation() *CRMPushJobMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *CRMPushJobUpdate) ClearUser() *CRMPushJobUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearIntegration clears the "integration" edge to the CRMIntegration entity.
+func (_u *CRMPushJobUpdate) ClearIntegration() *CRMPushJobUpdate {
+	_u.mutation.ClearIntegration()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CRMPushJobUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CRMPushJobUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CRMPushJobUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CRMPushJobUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CRMPushJobUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := crmpushjob.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CRMPushJobUpdate) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := crmpushjob.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "CRMPushJob.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := crmpushjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "CRMPushJob.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TotalLeads(); ok {
+		if err := crmpushjob.TotalLeadsValidator(v); err != nil {
+			return &ValidationError{Name: "total_leads", err: fmt.Errorf(`ent: validator failed for field "CRMPushJob.total_leads": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CreatedCount(); ok {
+		if err := crmpushjob.CreatedCountValidator(v); err != nil {
+			return &ValidationError{Name: "created_count", err: fmt.Errorf(`ent: validator failed for field "CRMPushJob.created_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UpdatedCount(); ok {
+		if err := crmpushjob.UpdatedCountValidator(v); err != nil {
+			return &ValidationError{Name: "updated_count", err: fmt.Errorf(`ent: validator failed for field "CRMPushJob.updated_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FailureCount(); ok {
+		if err := crmpushjob.FailureCountValidator(v); err != nil {
+			return &ValidationError{Name: "failure_count", err: fmt.Errorf(`ent: validator failed for field "CRMPushJob.failure_count": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CRMPushJob.user"`)
+	}
+	if _u.mutation.IntegrationCleared() && len(_u.mutation.IntegrationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CRMPushJob.integration"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CRMPushJobUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CRMPushJobUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *CRMPushJobUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(crmpushjob.Table, crmpushjob.Columns, sqlgraph.NewFieldSpec(crmpushjob.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(crmpushjob.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.TotalLeads(); ok {
+		_spec.SetField(crmpushjob.FieldTotalLeads, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalLeads(); ok {
+		_spec.AddField(crmpushjob.FieldTotalLeads, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedCount(); ok {
+		_spec.SetField(crmpushjob.FieldCreatedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedCount(); ok {
+		_spec.AddField(crmpushjob.FieldCreatedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedCount(); ok {
+		_spec.SetField(crmpushjob.FieldUpdatedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedCount(); ok {
+		_spec.AddField(crmpushjob.FieldUpdatedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FailureCount(); ok {
+		_spec.SetField(crmpushjob.FieldFailureCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailureCount(); ok {
+		_spec.AddField(crmpushjob.FieldFailureCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Results(); ok {
+		_spec.SetField(crmpushjob.FieldResults, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedResults(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, crmpushjob.FieldResults, value)
+		})
+	}
+	if _u.mutation.ResultsCleared() {
+		_spec.ClearField(crmpushjob.FieldResults, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ErrorMessage(); ok {
+		_spec.SetField(crmpushjob.FieldErrorMessage, field.TypeString, value)
+	}
+	if _u.mutation.ErrorMessageCleared() {
+		_spec.ClearField(crmpushjob.FieldErrorMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(crmpushjob.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(crmpushjob.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(crmpushjob.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(crmpushjob.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(crmpushjob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   crmpushjob.UserTable,
+			Columns: []string{crmpushjob.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   crmpushjob.UserTable,
+			Columns: []string{crmpushjob.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.IntegrationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   crmpushjob.IntegrationTable,
+			Columns: []string{crmpushjob.IntegrationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(crmintegration.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.IntegrationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   crmpushjob.IntegrationTable,
+			Columns: []string{crmpushjob.IntegrationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(crmintegration.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{crmpushjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CRMPushJobUpdateOne is the builder for updating a single CRMPushJob entity.
+type CRMPushJobUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *CRMPushJobMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *CRMPushJobUpdateOne) SetUserID(v int) *CRMPushJobUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *CRMPushJobUpdateOne) SetNillableUserID(v *int) *CRMPushJobUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetIntegrationID sets the "integration_id" field.
+func (_u *CRMPushJobUpdateOne) SetIntegrationID(v int) *CRMPushJobUpdateOne {
+	_u.mutation.SetIntegrationID(v)
+	return _u
+}
+
+// SetNillableIntegrationID sets the "integration_id" field if the given value is not nil.
+func (_u *CRMPushJobUpdateOne) SetNillableIntegrationID(v *int) *CRMPushJobUpdateOne {
+	if v != nil {
+		_u.SetIntegrationID(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CRMPushJobUpdateOne) SetStatus(v crmpushjob.Status) *CRMPushJobUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CRMPushJobUpdateOne) SetNillableStatus(v *crmpushjob.Status) *CRMPushJobUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetTotalLeads sets the "total_leads" field.
+func (_u *CRMPushJobUpdateOne) SetTotalLeads(v int) *CRMPushJobUpdateOne {
+	_u.mutation.ResetTotalLeads()
+	_u.mutation.SetTotalLeads(v)
+	return _u
+}
+
+// SetNillableTotalLeads sets the "total_leads" field if the given value is not nil.
+func (_u *CRMPushJobUpdateOne) SetNillableTotalLeads(v *int) *CRMPushJobUpdateOne {
+	if v != nil {
+		_u.SetTotalLeads(*v)
+	}
+	return _u
+}
+
+// AddTotalLeads adds value to the "total_leads" field.
+func (_u *CRMPushJobUpdateOne) AddTotalLeads(v int) *CRMPushJobUpdateOne {
+	_u.mutation.AddTotalLeads(v)
+	return _u
+}
+
+// SetCreatedCount sets the "created_count" field.
+func (_u *CRMPushJobUpdateOne) SetCreatedCount(v int) *CRMPushJobUpdateOne {
+	_u.mutation.ResetCreatedCount()
+	_u.mutation.SetCreatedCount(v)
+	return _u
+}
+
+// SetNillableCreatedCount sets the "created_count" field if the given value is not nil.
+func (_u *CRMPushJobUpdateOne) SetNillableCreatedCount(v *int) *CRMPushJobUpdateOne {
+	if v != nil {
+		_u.SetCreatedCount(*v)
+	}
+	return _u
+}
+
+// AddCreatedCount adds value to the "created_count" field.
+func (_u *CRMPushJobUpdateOne) AddCreatedCount(v int) *CRMPushJobUpdateOne {
+	_u.mutation.AddCreatedCount(v)
+	return _u
+}
+
+// SetUpdatedCount sets the "updated_count" field.
+func (_u *CRMPushJobUpdateOne) SetUpdatedCount(v int) *CRMPushJobUpdateOne {
+	_u.mutation.ResetUpdatedCount()
+	_u.mutation.SetUpdatedCount(v)
+	return _u
+}
+
+// SetNillableUpdatedCount sets the "updated_count" field if the given value is not nil.
+func (_u *CRMPushJobUpdateOne) SetNillableUpdatedCount(v *int) *CRMPushJobUpdateOne {
+	if v != nil {
+		_u.SetUpdatedCount(*v)
+	}
+	return _u
+}
+
+// AddUpdatedCount adds value to the "updated_count" field.
+func (_u *CRMPushJobUpdateOne) AddUpdatedCount(v int) *CRMPushJobUpdateOne {
+	_u.mutation.AddUpdatedCount(v)
+	return _u
+}
+
+// SetFailureCount sets the "failure_count" field.
+func (_u *CRMPushJobUpdateOne) SetFailureCount(v int) *CRMPushJobUpdateOne {
+	_u.mutation.ResetFailureCount()
+	_u.mutation.SetFailureCount(v)
+	return _u
+}
+
+// SetNillableFailureCount sets the "failure_count" field if the given value is not nil.
+func (_u *CRMPushJobUpdateOne) SetNillableFailureCount(v *int) *CRMPushJobUpdateOne {
+	if v != nil {
+		_u.SetFailureCount(*v)
+	}
+	return _u
+}
+
+// AddFailureCount adds value to the "failure_count" field.
+func (_u *CRMPushJobUpdateOne) AddFailureCount(v int) *CRMPushJobUpdateOne {
+	_u.mutation.AddFailureCount(v)
+	return _u
+}
+
+// SetResults sets the "results" field.
+func (_u *CRMPushJobUpdateOne) SetResults(v []map[string]interface{}) *CRMPushJobUpdateOne {
+	_u.mutation.SetResults(v)
+	return _u
+}
+
+// AppendResults appends value to the "results" field.
+func (_u *CRMPushJobUpdateOne) AppendResults(v []map[string]interface{}) *CRMPushJobUpdateOne {
+	_u.mutation.AppendResults(v)
+	return _u
+}
+
+// ClearResults clears the value of the "results" field.
+func (_u *CRMPushJobUpdateOne) ClearResults() *CRMPushJobUpdateOne {
+	_u.mutation.ClearResults()
+	return _u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_u *CRMPushJobUpdateOne) SetErrorMessage(v string) *CRMPushJobUpdateOne {
+	_u.mutation.SetErrorMessage(v)
+	return _u
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_u *CRMPushJobUpdateOne) SetNillableErrorMessage(v *string) *CRMPushJobUpdateOne {
+	if v != nil {
+		_u.SetErrorMessage(*v)
+	}
+	return _u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (_u *CRMPushJobUpdateOne) ClearErrorMessage() *CRMPushJobUpdateOne {
+	_u.mutation.ClearErrorMessage()
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *CRMPushJobUpdateOne) SetStartedAt(v time.Time) *CRMPushJobUpdateOne {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *CRMPushJobUpdateOne) SetNillableStartedAt(v *time.Time) *CRMPushJobUpdateOne {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *CRMPushJobUpdateOne) ClearStartedAt() *CRMPushJobUpdateOne {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *CRMPushJobUpdateOne) SetCompletedAt(v time.Time) *CRMPushJobUpdateOne {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *CRMPushJobUpdateOne) SetNillableCompletedAt(v *time.Time) *CRMPushJobUpdateOne {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *CRMPushJobUpdateOne) ClearCompletedAt() *CRMPushJobUpdateOne {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CRMPushJobUpdateOne) SetUpdatedAt(v time.Time) *CRMPushJobUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *CRMPushJobUpdateOne) SetUser(v *User) *CRMPushJobUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// SetIntegration sets the "integration" edge to the CRMIntegration entity.
+func (_u *CRMPushJobUpdateOne) SetIntegration(v *CRMIntegration) *CRMPushJobUpdateOne {
+	return _u.SetIntegrationID(v.ID)
+}
+
+// Mutation returns the CRMPushJobMutation object of the builder.
+func (_u *CRMPushJobUpdateOne) Mutation() *CRMPushJobMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *CRMPushJobUpdateOne) ClearUser() *CRMPushJobUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearIntegration clears the "integration" edge to the CRMIntegration entity.
+func (_u *CRMPushJobUpdateOne) ClearIntegration() *CRMPushJobUpdateOne {
+	_u.mutation.ClearIntegration()
+	return _u
+}
+
+// Where appends a list predicates to the CRMPushJobUpdate builder.
+func (_u *CRMPushJobUpdateOne) Where(ps ...predicate.CRMPushJob) *CRMPushJobUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CRMPushJobUpdateOne) Select(field string, fields ...string) *CRMPushJobUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CRMPushJob entity.
+func (_u *CRMPushJobUpdateOne) Save(ctx context.Context) (*CRMPushJob, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CRMPushJobUpdateOne) SaveX(ctx context.Context) *CRMPushJob {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CRMPushJobUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CRMPushJobUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CRMPushJobUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := crmpushjob.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CRMPushJobUpdateOne) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := crmpushjob.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "CRMPushJob.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := crmpushjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "CRMPushJob.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TotalLeads(); ok {
+		if err := crmpushjob.TotalLeadsValidator(v); err != nil {
+			return &ValidationError{Name: "total_leads", err: fmt.Errorf(`ent: validator failed for field "CRMPushJob.total_leads": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CreatedCount(); ok {
+		if err := crmpushjob.CreatedCountValidator(v); err != nil {
+			return &ValidationError{Name: "created_count", err: fmt.Errorf(`ent: validator failed for field "CRMPushJob.created_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UpdatedCount(); ok {
+		if err := crmpushjob.UpdatedCountValidator(v); err != nil {
+			return &ValidationError{Name: "updated_count", err: fmt.Errorf(`ent: validator failed for field "CRMPushJob.updated_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FailureCount(); ok {
+		if err := crmpushjob.FailureCountValidator(v); err != nil {
+			return &ValidationError{Name: "failure_count", err: fmt.Errorf(`ent: validator failed for field "CRMPushJob.failure_count": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CRMPushJob.user"`)
+	}
+	if _u.mutation.IntegrationCleared() && len(_u.mutation.IntegrationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CRMPushJob.integration"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CRMPushJobUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CRMPushJobUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *CRMPushJobUpdateOne) sqlSave(ctx context.Context) (_node *CRMPushJob, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(crmpushjob.Table, crmpushjob.Columns, sqlgraph.NewFieldSpec(crmpushjob.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CRMPushJob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, crmpushjob.FieldID)
+		for _, f := range fields {
+			if !crmpushjob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != crmpushjob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(crmpushjob.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.TotalLeads(); ok {
+		_spec.SetField(crmpushjob.FieldTotalLeads, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalLeads(); ok {
+		_spec.AddField(crmpushjob.FieldTotalLeads, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedCount(); ok {
+		_spec.SetField(crmpushjob.FieldCreatedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedCount(); ok {
+		_spec.AddField(crmpushjob.FieldCreatedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedCount(); ok {
+		_spec.SetField(crmpushjob.FieldUpdatedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedCount(); ok {
+		_spec.AddField(crmpushjob.FieldUpdatedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FailureCount(); ok {
+		_spec.SetField(crmpushjob.FieldFailureCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailureCount(); ok {
+		_spec.AddField(crmpushjob.FieldFailureCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Results(); ok {
+		_spec.SetField(crmpushjob.FieldResults, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedResults(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, crmpushjob.FieldResults, value)
+		})
+	}
+	if _u.mutation.ResultsCleared() {
+		_spec.ClearField(crmpushjob.FieldResults, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ErrorMessage(); ok {
+		_spec.SetField(crmpushjob.FieldErrorMessage, field.TypeString, value)
+	}
+	if _u.mutation.ErrorMessageCleared() {
+		_spec.ClearField(crmpushjob.FieldErrorMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(crmpushjob.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(crmpushjob.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(crmpushjob.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(crmpushjob.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(crmpushjob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   crmpushjob.UserTable,
+			Columns: []string{crmpushjob.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   crmpushjob.UserTable,
+			Columns: []string{crmpushjob.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.IntegrationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   crmpushjob.IntegrationTable,
+			Columns: []string{crmpushjob.IntegrationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(crmintegration.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.IntegrationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   crmpushjob.IntegrationTable,
+			Columns: []string{crmpushjob.IntegrationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(crmintegration.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &CRMPushJob{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{crmpushjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -21,8 +21,9 @@ import (
 // EmailCampaignUpdate is the builder for updating EmailCampaign entities.
 type EmailCampaignUpdate struct {
 	config
-	hooks    []Hook
-	mutation *EmailCampaignMutation
+	hooks     []Hook
+	mutation  *EmailCampaignMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the EmailCampaignUpdate builder.
@@ -530,6 +531,12 @@ func (_u *EmailCampaignUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *EmailCampaignUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EmailCampaignUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *EmailCampaignUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -714,6 +721,7 @@ func (_u *EmailCampaignUpdate) sqlSave(ctx context.Context) (_node int, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{emailcampaign.Label}
@@ -729,9 +737,10 @@ func (_u *EmailCampaignUpdate) sqlSave(ctx context.Context) (_node int, err erro
 // EmailCampaignUpdateOne is the builder for updating a single EmailCampaign entity.
 type EmailCampaignUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *EmailCampaignMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *EmailCampaignMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetName sets the "name" field.
@@ -1246,6 +1255,12 @@ func (_u *EmailCampaignUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *EmailCampaignUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EmailCampaignUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *EmailCampaignUpdateOne) sqlSave(ctx context.Context) (_node *EmailCampaign, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -1447,6 +1462,7 @@ func (_u *EmailCampaignUpdateOne) sqlSave(ctx context.Context) (_node *EmailCamp
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &EmailCampaign{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
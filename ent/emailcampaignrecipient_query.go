@@ -24,6 +24,7 @@ type EmailCampaignRecipientQuery struct {
 	inters       []Interceptor
 	predicates   []predicate.EmailCampaignRecipient
 	withCampaign *EmailCampaignQuery
+	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -276,8 +277,9 @@ func (_q *EmailCampaignRecipientQuery) Clone() *EmailCampaignRecipientQuery {
 		predicates:   append([]predicate.EmailCampaignRecipient{}, _q.predicates...),
 		withCampaign: _q.withCampaign.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -383,6 +385,9 @@ func (_q *EmailCampaignRecipientQuery) sqlAll(ctx context.Context, hooks ...quer
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -433,6 +438,9 @@ func (_q *EmailCampaignRecipientQuery) loadCampaign(ctx context.Context, query *
 
 func (_q *EmailCampaignRecipientQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -498,6 +506,9 @@ func (_q *EmailCampaignRecipientQuery) sqlQuery(ctx context.Context) *sql.Select
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -515,6 +526,12 @@ func (_q *EmailCampaignRecipientQuery) sqlQuery(ctx context.Context) *sql.Select
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *EmailCampaignRecipientQuery) Modify(modifiers ...func(s *sql.Selector)) *EmailCampaignRecipientSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // EmailCampaignRecipientGroupBy is the group-by builder for EmailCampaignRecipient entities.
 type EmailCampaignRecipientGroupBy struct {
 	selector
@@ -604,3 +621,9 @@ func (_s *EmailCampaignRecipientSelect) sqlScan(ctx context.Context, root *Email
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *EmailCampaignRecipientSelect) Modify(modifiers ...func(s *sql.Selector)) *EmailCampaignRecipientSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
@@ -19,8 +19,9 @@ import (
 // EmailCampaignRecipientUpdate is the builder for updating EmailCampaignRecipient entities.
 type EmailCampaignRecipientUpdate struct {
 	config
-	hooks    []Hook
-	mutation *EmailCampaignRecipientMutation
+	hooks     []Hook
+	mutation  *EmailCampaignRecipientMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the EmailCampaignRecipientUpdate builder.
@@ -287,6 +288,12 @@ func (_u *EmailCampaignRecipientUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *EmailCampaignRecipientUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EmailCampaignRecipientUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *EmailCampaignRecipientUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -379,6 +386,7 @@ func (_u *EmailCampaignRecipientUpdate) sqlSave(ctx context.Context) (_node int,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{emailcampaignrecipient.Label}
@@ -394,9 +402,10 @@ func (_u *EmailCampaignRecipientUpdate) sqlSave(ctx context.Context) (_node int,
 // EmailCampaignRecipientUpdateOne is the builder for updating a single EmailCampaignRecipient entity.
 type EmailCampaignRecipientUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *EmailCampaignRecipientMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *EmailCampaignRecipientMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetCampaignID sets the "campaign_id" field.
@@ -670,6 +679,12 @@ func (_u *EmailCampaignRecipientUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *EmailCampaignRecipientUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EmailCampaignRecipientUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *EmailCampaignRecipientUpdateOne) sqlSave(ctx context.Context) (_node *EmailCampaignRecipient, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -779,6 +794,7 @@ func (_u *EmailCampaignRecipientUpdateOne) sqlSave(ctx context.Context) (_node *
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &EmailCampaignRecipient{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
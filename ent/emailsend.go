@@ -0,0 +1,278 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/jordanlanch/industrydb/ent/emailsend"
+)
+
+// EmailSend is the model entity for the EmailSend schema.
+type EmailSend struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Recipient email address
+	ToEmail string `json:"to_email,omitempty"`
+	// Recipient name
+	ToName string `json:"to_name,omitempty"`
+	// Email template name (empty for raw emails)
+	Template string `json:"template,omitempty"`
+	// Email subject
+	Subject string `json:"subject,omitempty"`
+	// HTML body, kept only until the send is delivered or abandoned
+	HTMLBody string `json:"-"`
+	// Plain text body, kept only until the send is delivered or abandoned
+	TextBody string `json:"-"`
+	// Delivery status
+	Status emailsend.Status `json:"status,omitempty"`
+	// Number of send attempts made
+	Attempts int `json:"attempts,omitempty"`
+	// Provider HTTP status code of the last attempt
+	StatusCode *int `json:"status_code,omitempty"`
+	// Error from the last failed attempt or bounce
+	LastError string `json:"last_error,omitempty"`
+	// Provider of the last attempt (sendgrid, ses)
+	Provider string `json:"provider,omitempty"`
+	// Provider message ID, used to match bounce events
+	ProviderMessageID string `json:"provider_message_id,omitempty"`
+	// When the next retry is due (retrying only)
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+	// When the provider accepted the email
+	SentAt *time.Time `json:"sent_at,omitempty"`
+	// Creation timestamp
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Last update timestamp
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EmailSend) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case emailsend.FieldID, emailsend.FieldAttempts, emailsend.FieldStatusCode:
+			values[i] = new(sql.NullInt64)
+		case emailsend.FieldToEmail, emailsend.FieldToName, emailsend.FieldTemplate, emailsend.FieldSubject, emailsend.FieldHTMLBody, emailsend.FieldTextBody, emailsend.FieldStatus, emailsend.FieldLastError, emailsend.FieldProvider, emailsend.FieldProviderMessageID:
+			values[i] = new(sql.NullString)
+		case emailsend.FieldNextAttemptAt, emailsend.FieldSentAt, emailsend.FieldCreatedAt, emailsend.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EmailSend fields.
+func (_m *EmailSend) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case emailsend.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case emailsend.FieldToEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_email", values[i])
+			} else if value.Valid {
+				_m.ToEmail = value.String
+			}
+		case emailsend.FieldToName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_name", values[i])
+			} else if value.Valid {
+				_m.ToName = value.String
+			}
+		case emailsend.FieldTemplate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field template", values[i])
+			} else if value.Valid {
+				_m.Template = value.String
+			}
+		case emailsend.FieldSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject", values[i])
+			} else if value.Valid {
+				_m.Subject = value.String
+			}
+		case emailsend.FieldHTMLBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field html_body", values[i])
+			} else if value.Valid {
+				_m.HTMLBody = value.String
+			}
+		case emailsend.FieldTextBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field text_body", values[i])
+			} else if value.Valid {
+				_m.TextBody = value.String
+			}
+		case emailsend.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = emailsend.Status(value.String)
+			}
+		case emailsend.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				_m.Attempts = int(value.Int64)
+			}
+		case emailsend.FieldStatusCode:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status_code", values[i])
+			} else if value.Valid {
+				_m.StatusCode = new(int)
+				*_m.StatusCode = int(value.Int64)
+			}
+		case emailsend.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				_m.LastError = value.String
+			}
+		case emailsend.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				_m.Provider = value.String
+			}
+		case emailsend.FieldProviderMessageID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_message_id", values[i])
+			} else if value.Valid {
+				_m.ProviderMessageID = value.String
+			}
+		case emailsend.FieldNextAttemptAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_attempt_at", values[i])
+			} else if value.Valid {
+				_m.NextAttemptAt = new(time.Time)
+				*_m.NextAttemptAt = value.Time
+			}
+		case emailsend.FieldSentAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field sent_at", values[i])
+			} else if value.Valid {
+				_m.SentAt = new(time.Time)
+				*_m.SentAt = value.Time
+			}
+		case emailsend.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case emailsend.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EmailSend.
+// This includes values selected through modifiers, order, etc.
+func (_m *EmailSend) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this EmailSend.
+// Note that you need to call EmailSend.Unwrap() before calling this method if this EmailSend
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *EmailSend) Update() *EmailSendUpdateOne {
+	return NewEmailSendClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the EmailSend entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *EmailSend) Unwrap() *EmailSend {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EmailSend is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *EmailSend) String() string {
+	var builder strings.Builder
+	builder.WriteString("EmailSend(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("to_email=")
+	builder.WriteString(_m.ToEmail)
+	builder.WriteString(", ")
+	builder.WriteString("to_name=")
+	builder.WriteString(_m.ToName)
+	builder.WriteString(", ")
+	builder.WriteString("template=")
+	builder.WriteString(_m.Template)
+	builder.WriteString(", ")
+	builder.WriteString("subject=")
+	builder.WriteString(_m.Subject)
+	builder.WriteString(", ")
+	builder.WriteString("html_body=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("text_body=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Attempts))
+	builder.WriteString(", ")
+	if v := _m.StatusCode; v != nil {
+		builder.WriteString("status_code=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(_m.LastError)
+	builder.WriteString(", ")
+	builder.WriteString("provider=")
+	builder.WriteString(_m.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("provider_message_id=")
+	builder.WriteString(_m.ProviderMessageID)
+	builder.WriteString(", ")
+	if v := _m.NextAttemptAt; v != nil {
+		builder.WriteString("next_attempt_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.SentAt; v != nil {
+		builder.WriteString("sent_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EmailSends is a parsable slice of EmailSend.
+type EmailSends []*EmailSend
@@ -0,0 +1,215 @@
+// Code generated by ent, DO NOT EDIT.
+
+package emailsend
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the emailsend type in the database.
+	Label = "email_send"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldToEmail holds the string denoting the to_email field in the database.
+	FieldToEmail = "to_email"
+	// FieldToName holds the string denoting the to_name field in the database.
+	FieldToName = "to_name"
+	// FieldTemplate holds the string denoting the template field in the database.
+	FieldTemplate = "template"
+	// FieldSubject holds the string denoting the subject field in the database.
+	FieldSubject = "subject"
+	// FieldHTMLBody holds the string denoting the html_body field in the database.
+	FieldHTMLBody = "html_body"
+	// FieldTextBody holds the string denoting the text_body field in the database.
+	FieldTextBody = "text_body"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
+	// FieldStatusCode holds the string denoting the status_code field in the database.
+	FieldStatusCode = "status_code"
+	// FieldLastError holds the string denoting the last_error field in the database.
+	FieldLastError = "last_error"
+	// FieldProvider holds the string denoting the provider field in the database.
+	FieldProvider = "provider"
+	// FieldProviderMessageID holds the string denoting the provider_message_id field in the database.
+	FieldProviderMessageID = "provider_message_id"
+	// FieldNextAttemptAt holds the string denoting the next_attempt_at field in the database.
+	FieldNextAttemptAt = "next_attempt_at"
+	// FieldSentAt holds the string denoting the sent_at field in the database.
+	FieldSentAt = "sent_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the emailsend in the database.
+	Table = "email_sends"
+)
+
+// Columns holds all SQL columns for emailsend fields.
+var Columns = []string{
+	FieldID,
+	FieldToEmail,
+	FieldToName,
+	FieldTemplate,
+	FieldSubject,
+	FieldHTMLBody,
+	FieldTextBody,
+	FieldStatus,
+	FieldAttempts,
+	FieldStatusCode,
+	FieldLastError,
+	FieldProvider,
+	FieldProviderMessageID,
+	FieldNextAttemptAt,
+	FieldSentAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ToEmailValidator is a validator for the "to_email" field. It is called by the builders before save.
+	ToEmailValidator func(string) error
+	// DefaultAttempts holds the default value on creation for the "attempts" field.
+	DefaultAttempts int
+	// AttemptsValidator is a validator for the "attempts" field. It is called by the builders before save.
+	AttemptsValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending    Status = "pending"
+	StatusSent       Status = "sent"
+	StatusRetrying   Status = "retrying"
+	StatusFailed     Status = "failed"
+	StatusBounced    Status = "bounced"
+	StatusSuppressed Status = "suppressed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusSent, StatusRetrying, StatusFailed, StatusBounced, StatusSuppressed:
+		return nil
+	default:
+		return fmt.Errorf("emailsend: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the EmailSend queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByToEmail orders the results by the to_email field.
+func ByToEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToEmail, opts...).ToFunc()
+}
+
+// ByToName orders the results by the to_name field.
+func ByToName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToName, opts...).ToFunc()
+}
+
+// ByTemplate orders the results by the template field.
+func ByTemplate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTemplate, opts...).ToFunc()
+}
+
+// BySubject orders the results by the subject field.
+func BySubject(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubject, opts...).ToFunc()
+}
+
+// ByHTMLBody orders the results by the html_body field.
+func ByHTMLBody(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHTMLBody, opts...).ToFunc()
+}
+
+// ByTextBody orders the results by the text_body field.
+func ByTextBody(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTextBody, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByAttempts orders the results by the attempts field.
+func ByAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttempts, opts...).ToFunc()
+}
+
+// ByStatusCode orders the results by the status_code field.
+func ByStatusCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatusCode, opts...).ToFunc()
+}
+
+// ByLastError orders the results by the last_error field.
+func ByLastError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastError, opts...).ToFunc()
+}
+
+// ByProvider orders the results by the provider field.
+func ByProvider(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProvider, opts...).ToFunc()
+}
+
+// ByProviderMessageID orders the results by the provider_message_id field.
+func ByProviderMessageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProviderMessageID, opts...).ToFunc()
+}
+
+// ByNextAttemptAt orders the results by the next_attempt_at field.
+func ByNextAttemptAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextAttemptAt, opts...).ToFunc()
+}
+
+// BySentAt orders the results by the sent_at field.
+func BySentAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSentAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,1090 @@
+// Code generated by ent, DO NOT EDIT.
+
+package emailsend
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldLTE(FieldID, id))
+}
+
+// ToEmail applies equality check predicate on the "to_email" field. It's identical to ToEmailEQ.
+func ToEmail(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEQ(FieldToEmail, v))
+}
+
+// ToName applies equality check predicate on the "to_name" field. It's identical to ToNameEQ.
+func ToName(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEQ(FieldToName, v))
+}
+
+// Template applies equality check predicate on the "template" field. It's identical to TemplateEQ.
+func Template(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEQ(FieldTemplate, v))
+}
+
+// Subject applies equality check predicate on the "subject" field. It's identical to SubjectEQ.
+func Subject(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEQ(FieldSubject, v))
+}
+
+// HTMLBody applies equality check predicate on the "html_body" field. It's identical to HTMLBodyEQ.
+func HTMLBody(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEQ(FieldHTMLBody, v))
+}
+
+// TextBody applies equality check predicate on the "text_body" field. It's identical to TextBodyEQ.
+func TextBody(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEQ(FieldTextBody, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEQ(FieldAttempts, v))
+}
+
+// StatusCode applies equality check predicate on the "status_code" field. It's identical to StatusCodeEQ.
+func StatusCode(v int) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEQ(FieldStatusCode, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEQ(FieldLastError, v))
+}
+
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderMessageID applies equality check predicate on the "provider_message_id" field. It's identical to ProviderMessageIDEQ.
+func ProviderMessageID(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEQ(FieldProviderMessageID, v))
+}
+
+// NextAttemptAt applies equality check predicate on the "next_attempt_at" field. It's identical to NextAttemptAtEQ.
+func NextAttemptAt(v time.Time) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// SentAt applies equality check predicate on the "sent_at" field. It's identical to SentAtEQ.
+func SentAt(v time.Time) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEQ(FieldSentAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ToEmailEQ applies the EQ predicate on the "to_email" field.
+func ToEmailEQ(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEQ(FieldToEmail, v))
+}
+
+// ToEmailNEQ applies the NEQ predicate on the "to_email" field.
+func ToEmailNEQ(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNEQ(FieldToEmail, v))
+}
+
+// ToEmailIn applies the In predicate on the "to_email" field.
+func ToEmailIn(vs ...string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldIn(FieldToEmail, vs...))
+}
+
+// ToEmailNotIn applies the NotIn predicate on the "to_email" field.
+func ToEmailNotIn(vs ...string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNotIn(FieldToEmail, vs...))
+}
+
+// ToEmailGT applies the GT predicate on the "to_email" field.
+func ToEmailGT(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldGT(FieldToEmail, v))
+}
+
+// ToEmailGTE applies the GTE predicate on the "to_email" field.
+func ToEmailGTE(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldGTE(FieldToEmail, v))
+}
+
+// ToEmailLT applies the LT predicate on the "to_email" field.
+func ToEmailLT(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldLT(FieldToEmail, v))
+}
+
+// ToEmailLTE applies the LTE predicate on the "to_email" field.
+func ToEmailLTE(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldLTE(FieldToEmail, v))
+}
+
+// ToEmailContains applies the Contains predicate on the "to_email" field.
+func ToEmailContains(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldContains(FieldToEmail, v))
+}
+
+// ToEmailHasPrefix applies the HasPrefix predicate on the "to_email" field.
+func ToEmailHasPrefix(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldHasPrefix(FieldToEmail, v))
+}
+
+// ToEmailHasSuffix applies the HasSuffix predicate on the "to_email" field.
+func ToEmailHasSuffix(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldHasSuffix(FieldToEmail, v))
+}
+
+// ToEmailEqualFold applies the EqualFold predicate on the "to_email" field.
+func ToEmailEqualFold(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEqualFold(FieldToEmail, v))
+}
+
+// ToEmailContainsFold applies the ContainsFold predicate on the "to_email" field.
+func ToEmailContainsFold(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldContainsFold(FieldToEmail, v))
+}
+
+// ToNameEQ applies the EQ predicate on the "to_name" field.
+func ToNameEQ(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEQ(FieldToName, v))
+}
+
+// ToNameNEQ applies the NEQ predicate on the "to_name" field.
+func ToNameNEQ(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNEQ(FieldToName, v))
+}
+
+// ToNameIn applies the In predicate on the "to_name" field.
+func ToNameIn(vs ...string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldIn(FieldToName, vs...))
+}
+
+// ToNameNotIn applies the NotIn predicate on the "to_name" field.
+func ToNameNotIn(vs ...string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNotIn(FieldToName, vs...))
+}
+
+// ToNameGT applies the GT predicate on the "to_name" field.
+func ToNameGT(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldGT(FieldToName, v))
+}
+
+// ToNameGTE applies the GTE predicate on the "to_name" field.
+func ToNameGTE(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldGTE(FieldToName, v))
+}
+
+// ToNameLT applies the LT predicate on the "to_name" field.
+func ToNameLT(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldLT(FieldToName, v))
+}
+
+// ToNameLTE applies the LTE predicate on the "to_name" field.
+func ToNameLTE(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldLTE(FieldToName, v))
+}
+
+// ToNameContains applies the Contains predicate on the "to_name" field.
+func ToNameContains(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldContains(FieldToName, v))
+}
+
+// ToNameHasPrefix applies the HasPrefix predicate on the "to_name" field.
+func ToNameHasPrefix(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldHasPrefix(FieldToName, v))
+}
+
+// ToNameHasSuffix applies the HasSuffix predicate on the "to_name" field.
+func ToNameHasSuffix(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldHasSuffix(FieldToName, v))
+}
+
+// ToNameIsNil applies the IsNil predicate on the "to_name" field.
+func ToNameIsNil() predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldIsNull(FieldToName))
+}
+
+// ToNameNotNil applies the NotNil predicate on the "to_name" field.
+func ToNameNotNil() predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNotNull(FieldToName))
+}
+
+// ToNameEqualFold applies the EqualFold predicate on the "to_name" field.
+func ToNameEqualFold(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEqualFold(FieldToName, v))
+}
+
+// ToNameContainsFold applies the ContainsFold predicate on the "to_name" field.
+func ToNameContainsFold(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldContainsFold(FieldToName, v))
+}
+
+// TemplateEQ applies the EQ predicate on the "template" field.
+func TemplateEQ(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEQ(FieldTemplate, v))
+}
+
+// TemplateNEQ applies the NEQ predicate on the "template" field.
+func TemplateNEQ(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNEQ(FieldTemplate, v))
+}
+
+// TemplateIn applies the In predicate on the "template" field.
+func TemplateIn(vs ...string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldIn(FieldTemplate, vs...))
+}
+
+// TemplateNotIn applies the NotIn predicate on the "template" field.
+func TemplateNotIn(vs ...string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNotIn(FieldTemplate, vs...))
+}
+
+// TemplateGT applies the GT predicate on the "template" field.
+func TemplateGT(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldGT(FieldTemplate, v))
+}
+
+// TemplateGTE applies the GTE predicate on the "template" field.
+func TemplateGTE(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldGTE(FieldTemplate, v))
+}
+
+// TemplateLT applies the LT predicate on the "template" field.
+func TemplateLT(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldLT(FieldTemplate, v))
+}
+
+// TemplateLTE applies the LTE predicate on the "template" field.
+func TemplateLTE(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldLTE(FieldTemplate, v))
+}
+
+// TemplateContains applies the Contains predicate on the "template" field.
+func TemplateContains(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldContains(FieldTemplate, v))
+}
+
+// TemplateHasPrefix applies the HasPrefix predicate on the "template" field.
+func TemplateHasPrefix(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldHasPrefix(FieldTemplate, v))
+}
+
+// TemplateHasSuffix applies the HasSuffix predicate on the "template" field.
+func TemplateHasSuffix(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldHasSuffix(FieldTemplate, v))
+}
+
+// TemplateIsNil applies the IsNil predicate on the "template" field.
+func TemplateIsNil() predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldIsNull(FieldTemplate))
+}
+
+// TemplateNotNil applies the NotNil predicate on the "template" field.
+func TemplateNotNil() predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNotNull(FieldTemplate))
+}
+
+// TemplateEqualFold applies the EqualFold predicate on the "template" field.
+func TemplateEqualFold(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEqualFold(FieldTemplate, v))
+}
+
+// TemplateContainsFold applies the ContainsFold predicate on the "template" field.
+func TemplateContainsFold(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldContainsFold(FieldTemplate, v))
+}
+
+// SubjectEQ applies the EQ predicate on the "subject" field.
+func SubjectEQ(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEQ(FieldSubject, v))
+}
+
+// SubjectNEQ applies the NEQ predicate on the "subject" field.
+func SubjectNEQ(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNEQ(FieldSubject, v))
+}
+
+// SubjectIn applies the In predicate on the "subject" field.
+func SubjectIn(vs ...string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldIn(FieldSubject, vs...))
+}
+
+// SubjectNotIn applies the NotIn predicate on the "subject" field.
+func SubjectNotIn(vs ...string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNotIn(FieldSubject, vs...))
+}
+
+// SubjectGT applies the GT predicate on the "subject" field.
+func SubjectGT(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldGT(FieldSubject, v))
+}
+
+// SubjectGTE applies the GTE predicate on the "subject" field.
+func SubjectGTE(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldGTE(FieldSubject, v))
+}
+
+// SubjectLT applies the LT predicate on the "subject" field.
+func SubjectLT(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldLT(FieldSubject, v))
+}
+
+// SubjectLTE applies the LTE predicate on the "subject" field.
+func SubjectLTE(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldLTE(FieldSubject, v))
+}
+
+// SubjectContains applies the Contains predicate on the "subject" field.
+func SubjectContains(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldContains(FieldSubject, v))
+}
+
+// SubjectHasPrefix applies the HasPrefix predicate on the "subject" field.
+func SubjectHasPrefix(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldHasPrefix(FieldSubject, v))
+}
+
+// SubjectHasSuffix applies the HasSuffix predicate on the "subject" field.
+func SubjectHasSuffix(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldHasSuffix(FieldSubject, v))
+}
+
+// SubjectEqualFold applies the EqualFold predicate on the "subject" field.
+func SubjectEqualFold(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEqualFold(FieldSubject, v))
+}
+
+// SubjectContainsFold applies the ContainsFold predicate on the "subject" field.
+func SubjectContainsFold(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldContainsFold(FieldSubject, v))
+}
+
+// HTMLBodyEQ applies the EQ predicate on the "html_body" field.
+func HTMLBodyEQ(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEQ(FieldHTMLBody, v))
+}
+
+// HTMLBodyNEQ applies the NEQ predicate on the "html_body" field.
+func HTMLBodyNEQ(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNEQ(FieldHTMLBody, v))
+}
+
+// HTMLBodyIn applies the In predicate on the "html_body" field.
+func HTMLBodyIn(vs ...string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldIn(FieldHTMLBody, vs...))
+}
+
+// HTMLBodyNotIn applies the NotIn predicate on the "html_body" field.
+func HTMLBodyNotIn(vs ...string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNotIn(FieldHTMLBody, vs...))
+}
+
+// HTMLBodyGT applies the GT predicate on the "html_body" field.
+func HTMLBodyGT(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldGT(FieldHTMLBody, v))
+}
+
+// HTMLBodyGTE applies the GTE predicate on the "html_body" field.
+func HTMLBodyGTE(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldGTE(FieldHTMLBody, v))
+}
+
+// HTMLBodyLT applies the LT predicate on the "html_body" field.
+func HTMLBodyLT(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldLT(FieldHTMLBody, v))
+}
+
+// HTMLBodyLTE applies the LTE predicate on the "html_body" field.
+func HTMLBodyLTE(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldLTE(FieldHTMLBody, v))
+}
+
+// HTMLBodyContains applies the Contains predicate on the "html_body" field.
+func HTMLBodyContains(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldContains(FieldHTMLBody, v))
+}
+
+// HTMLBodyHasPrefix applies the HasPrefix predicate on the "html_body" field.
+func HTMLBodyHasPrefix(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldHasPrefix(FieldHTMLBody, v))
+}
+
+// HTMLBodyHasSuffix applies the HasSuffix predicate on the "html_body" field.
+func HTMLBodyHasSuffix(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldHasSuffix(FieldHTMLBody, v))
+}
+
+// HTMLBodyIsNil applies the IsNil predicate on the "html_body" field.
+func HTMLBodyIsNil() predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldIsNull(FieldHTMLBody))
+}
+
+// HTMLBodyNotNil applies the NotNil predicate on the "html_body" field.
+func HTMLBodyNotNil() predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNotNull(FieldHTMLBody))
+}
+
+// HTMLBodyEqualFold applies the EqualFold predicate on the "html_body" field.
+func HTMLBodyEqualFold(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEqualFold(FieldHTMLBody, v))
+}
+
+// HTMLBodyContainsFold applies the ContainsFold predicate on the "html_body" field.
+func HTMLBodyContainsFold(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldContainsFold(FieldHTMLBody, v))
+}
+
+// TextBodyEQ applies the EQ predicate on the "text_body" field.
+func TextBodyEQ(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEQ(FieldTextBody, v))
+}
+
+// TextBodyNEQ applies the NEQ predicate on the "text_body" field.
+func TextBodyNEQ(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNEQ(FieldTextBody, v))
+}
+
+// TextBodyIn applies the In predicate on the "text_body" field.
+func TextBodyIn(vs ...string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldIn(FieldTextBody, vs...))
+}
+
+// TextBodyNotIn applies the NotIn predicate on the "text_body" field.
+func TextBodyNotIn(vs ...string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNotIn(FieldTextBody, vs...))
+}
+
+// TextBodyGT applies the GT predicate on the "text_body" field.
+func TextBodyGT(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldGT(FieldTextBody, v))
+}
+
+// TextBodyGTE applies the GTE predicate on the "text_body" field.
+func TextBodyGTE(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldGTE(FieldTextBody, v))
+}
+
+// TextBodyLT applies the LT predicate on the "text_body" field.
+func TextBodyLT(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldLT(FieldTextBody, v))
+}
+
+// TextBodyLTE applies the LTE predicate on the "text_body" field.
+func TextBodyLTE(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldLTE(FieldTextBody, v))
+}
+
+// TextBodyContains applies the Contains predicate on the "text_body" field.
+func TextBodyContains(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldContains(FieldTextBody, v))
+}
+
+// TextBodyHasPrefix applies the HasPrefix predicate on the "text_body" field.
+func TextBodyHasPrefix(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldHasPrefix(FieldTextBody, v))
+}
+
+// TextBodyHasSuffix applies the HasSuffix predicate on the "text_body" field.
+func TextBodyHasSuffix(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldHasSuffix(FieldTextBody, v))
+}
+
+// TextBodyIsNil applies the IsNil predicate on the "text_body" field.
+func TextBodyIsNil() predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldIsNull(FieldTextBody))
+}
+
+// TextBodyNotNil applies the NotNil predicate on the "text_body" field.
+func TextBodyNotNil() predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNotNull(FieldTextBody))
+}
+
+// TextBodyEqualFold applies the EqualFold predicate on the "text_body" field.
+func TextBodyEqualFold(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEqualFold(FieldTextBody, v))
+}
+
+// TextBodyContainsFold applies the ContainsFold predicate on the "text_body" field.
+func TextBodyContainsFold(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldContainsFold(FieldTextBody, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldLTE(FieldAttempts, v))
+}
+
+// StatusCodeEQ applies the EQ predicate on the "status_code" field.
+func StatusCodeEQ(v int) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEQ(FieldStatusCode, v))
+}
+
+// StatusCodeNEQ applies the NEQ predicate on the "status_code" field.
+func StatusCodeNEQ(v int) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNEQ(FieldStatusCode, v))
+}
+
+// StatusCodeIn applies the In predicate on the "status_code" field.
+func StatusCodeIn(vs ...int) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldIn(FieldStatusCode, vs...))
+}
+
+// StatusCodeNotIn applies the NotIn predicate on the "status_code" field.
+func StatusCodeNotIn(vs ...int) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNotIn(FieldStatusCode, vs...))
+}
+
+// StatusCodeGT applies the GT predicate on the "status_code" field.
+func StatusCodeGT(v int) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldGT(FieldStatusCode, v))
+}
+
+// StatusCodeGTE applies the GTE predicate on the "status_code" field.
+func StatusCodeGTE(v int) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldGTE(FieldStatusCode, v))
+}
+
+// StatusCodeLT applies the LT predicate on the "status_code" field.
+func StatusCodeLT(v int) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldLT(FieldStatusCode, v))
+}
+
+// StatusCodeLTE applies the LTE predicate on the "status_code" field.
+func StatusCodeLTE(v int) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldLTE(FieldStatusCode, v))
+}
+
+// StatusCodeIsNil applies the IsNil predicate on the "status_code" field.
+func StatusCodeIsNil() predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldIsNull(FieldStatusCode))
+}
+
+// StatusCodeNotNil applies the NotNil predicate on the "status_code" field.
+func StatusCodeNotNil() predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNotNull(FieldStatusCode))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldGT(FieldProvider, v))
+}
+
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldGTE(FieldProvider, v))
+}
+
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldLT(FieldProvider, v))
+}
+
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldLTE(FieldProvider, v))
+}
+
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldContains(FieldProvider, v))
+}
+
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldHasPrefix(FieldProvider, v))
+}
+
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldHasSuffix(FieldProvider, v))
+}
+
+// ProviderIsNil applies the IsNil predicate on the "provider" field.
+func ProviderIsNil() predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldIsNull(FieldProvider))
+}
+
+// ProviderNotNil applies the NotNil predicate on the "provider" field.
+func ProviderNotNil() predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNotNull(FieldProvider))
+}
+
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEqualFold(FieldProvider, v))
+}
+
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldContainsFold(FieldProvider, v))
+}
+
+// ProviderMessageIDEQ applies the EQ predicate on the "provider_message_id" field.
+func ProviderMessageIDEQ(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEQ(FieldProviderMessageID, v))
+}
+
+// ProviderMessageIDNEQ applies the NEQ predicate on the "provider_message_id" field.
+func ProviderMessageIDNEQ(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNEQ(FieldProviderMessageID, v))
+}
+
+// ProviderMessageIDIn applies the In predicate on the "provider_message_id" field.
+func ProviderMessageIDIn(vs ...string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldIn(FieldProviderMessageID, vs...))
+}
+
+// ProviderMessageIDNotIn applies the NotIn predicate on the "provider_message_id" field.
+func ProviderMessageIDNotIn(vs ...string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNotIn(FieldProviderMessageID, vs...))
+}
+
+// ProviderMessageIDGT applies the GT predicate on the "provider_message_id" field.
+func ProviderMessageIDGT(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldGT(FieldProviderMessageID, v))
+}
+
+// ProviderMessageIDGTE applies the GTE predicate on the "provider_message_id" field.
+func ProviderMessageIDGTE(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldGTE(FieldProviderMessageID, v))
+}
+
+// ProviderMessageIDLT applies the LT predicate on the "provider_message_id" field.
+func ProviderMessageIDLT(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldLT(FieldProviderMessageID, v))
+}
+
+// ProviderMessageIDLTE applies the LTE predicate on the "provider_message_id" field.
+func ProviderMessageIDLTE(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldLTE(FieldProviderMessageID, v))
+}
+
+// ProviderMessageIDContains applies the Contains predicate on the "provider_message_id" field.
+func ProviderMessageIDContains(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldContains(FieldProviderMessageID, v))
+}
+
+// ProviderMessageIDHasPrefix applies the HasPrefix predicate on the "provider_message_id" field.
+func ProviderMessageIDHasPrefix(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldHasPrefix(FieldProviderMessageID, v))
+}
+
+// ProviderMessageIDHasSuffix applies the HasSuffix predicate on the "provider_message_id" field.
+func ProviderMessageIDHasSuffix(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldHasSuffix(FieldProviderMessageID, v))
+}
+
+// ProviderMessageIDIsNil applies the IsNil predicate on the "provider_message_id" field.
+func ProviderMessageIDIsNil() predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldIsNull(FieldProviderMessageID))
+}
+
+// ProviderMessageIDNotNil applies the NotNil predicate on the "provider_message_id" field.
+func ProviderMessageIDNotNil() predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNotNull(FieldProviderMessageID))
+}
+
+// ProviderMessageIDEqualFold applies the EqualFold predicate on the "provider_message_id" field.
+func ProviderMessageIDEqualFold(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEqualFold(FieldProviderMessageID, v))
+}
+
+// ProviderMessageIDContainsFold applies the ContainsFold predicate on the "provider_message_id" field.
+func ProviderMessageIDContainsFold(v string) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldContainsFold(FieldProviderMessageID, v))
+}
+
+// NextAttemptAtEQ applies the EQ predicate on the "next_attempt_at" field.
+func NextAttemptAtEQ(v time.Time) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtNEQ applies the NEQ predicate on the "next_attempt_at" field.
+func NextAttemptAtNEQ(v time.Time) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtIn applies the In predicate on the "next_attempt_at" field.
+func NextAttemptAtIn(vs ...time.Time) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtNotIn applies the NotIn predicate on the "next_attempt_at" field.
+func NextAttemptAtNotIn(vs ...time.Time) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNotIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtGT applies the GT predicate on the "next_attempt_at" field.
+func NextAttemptAtGT(v time.Time) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldGT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtGTE applies the GTE predicate on the "next_attempt_at" field.
+func NextAttemptAtGTE(v time.Time) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldGTE(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLT applies the LT predicate on the "next_attempt_at" field.
+func NextAttemptAtLT(v time.Time) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldLT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLTE applies the LTE predicate on the "next_attempt_at" field.
+func NextAttemptAtLTE(v time.Time) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldLTE(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtIsNil applies the IsNil predicate on the "next_attempt_at" field.
+func NextAttemptAtIsNil() predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldIsNull(FieldNextAttemptAt))
+}
+
+// NextAttemptAtNotNil applies the NotNil predicate on the "next_attempt_at" field.
+func NextAttemptAtNotNil() predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNotNull(FieldNextAttemptAt))
+}
+
+// SentAtEQ applies the EQ predicate on the "sent_at" field.
+func SentAtEQ(v time.Time) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEQ(FieldSentAt, v))
+}
+
+// SentAtNEQ applies the NEQ predicate on the "sent_at" field.
+func SentAtNEQ(v time.Time) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNEQ(FieldSentAt, v))
+}
+
+// SentAtIn applies the In predicate on the "sent_at" field.
+func SentAtIn(vs ...time.Time) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldIn(FieldSentAt, vs...))
+}
+
+// SentAtNotIn applies the NotIn predicate on the "sent_at" field.
+func SentAtNotIn(vs ...time.Time) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNotIn(FieldSentAt, vs...))
+}
+
+// SentAtGT applies the GT predicate on the "sent_at" field.
+func SentAtGT(v time.Time) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldGT(FieldSentAt, v))
+}
+
+// SentAtGTE applies the GTE predicate on the "sent_at" field.
+func SentAtGTE(v time.Time) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldGTE(FieldSentAt, v))
+}
+
+// SentAtLT applies the LT predicate on the "sent_at" field.
+func SentAtLT(v time.Time) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldLT(FieldSentAt, v))
+}
+
+// SentAtLTE applies the LTE predicate on the "sent_at" field.
+func SentAtLTE(v time.Time) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldLTE(FieldSentAt, v))
+}
+
+// SentAtIsNil applies the IsNil predicate on the "sent_at" field.
+func SentAtIsNil() predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldIsNull(FieldSentAt))
+}
+
+// SentAtNotNil applies the NotNil predicate on the "sent_at" field.
+func SentAtNotNil() predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNotNull(FieldSentAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.EmailSend {
+	return predicate.EmailSend(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EmailSend) predicate.EmailSend {
+	return predicate.EmailSend(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EmailSend) predicate.EmailSend {
+	return predicate.EmailSend(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EmailSend) predicate.EmailSend {
+	return predicate.EmailSend(sql.NotPredicates(p))
+}
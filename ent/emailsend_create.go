@@ -0,0 +1,498 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/emailsend"
+)
+
+// EmailSendCreate is the builder for creating a EmailSend entity.
+type EmailSendCreate struct {
+	config
+	mutation *EmailSendMutation
+	hooks    []Hook
+}
+
+// SetToEmail sets the "to_email" field.
+func (_c *EmailSendCreate) SetToEmail(v string) *EmailSendCreate {
+	_c.mutation.SetToEmail(v)
+	return _c
+}
+
+// SetToName sets the "to_name" field.
+func (_c *EmailSendCreate) SetToName(v string) *EmailSendCreate {
+	_c.mutation.SetToName(v)
+	return _c
+}
+
+// SetNillableToName sets the "to_name" field if the given value is not nil.
+func (_c *EmailSendCreate) SetNillableToName(v *string) *EmailSendCreate {
+	if v != nil {
+		_c.SetToName(*v)
+	}
+	return _c
+}
+
+// SetTemplate sets the "template" field.
+func (_c *EmailSendCreate) SetTemplate(v string) *EmailSendCreate {
+	_c.mutation.SetTemplate(v)
+	return _c
+}
+
+// SetNillableTemplate sets the "template" field if the given value is not nil.
+func (_c *EmailSendCreate) SetNillableTemplate(v *string) *EmailSendCreate {
+	if v != nil {
+		_c.SetTemplate(*v)
+	}
+	return _c
+}
+
+// SetSubject sets the "subject" field.
+func (_c *EmailSendCreate) SetSubject(v string) *EmailSendCreate {
+	_c.mutation.SetSubject(v)
+	return _c
+}
+
+// SetHTMLBody sets the "html_body" field.
+func (_c *EmailSendCreate) SetHTMLBody(v string) *EmailSendCreate {
+	_c.mutation.SetHTMLBody(v)
+	return _c
+}
+
+// SetNillableHTMLBody sets the "html_body" field if the given value is not nil.
+func (_c *EmailSendCreate) SetNillableHTMLBody(v *string) *EmailSendCreate {
+	if v != nil {
+		_c.SetHTMLBody(*v)
+	}
+	return _c
+}
+
+// SetTextBody sets the "text_body" field.
+func (_c *EmailSendCreate) SetTextBody(v string) *EmailSendCreate {
+	_c.mutation.SetTextBody(v)
+	return _c
+}
+
+// SetNillableTextBody sets the "text_body" field if the given value is not nil.
+func (_c *EmailSendCreate) SetNillableTextBody(v *string) *EmailSendCreate {
+	if v != nil {
+		_c.SetTextBody(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *EmailSendCreate) SetStatus(v emailsend.Status) *EmailSendCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *EmailSendCreate) SetNillableStatus(v *emailsend.Status) *EmailSendCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *EmailSendCreate) SetAttempts(v int) *EmailSendCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *EmailSendCreate) SetNillableAttempts(v *int) *EmailSendCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetStatusCode sets the "status_code" field.
+func (_c *EmailSendCreate) SetStatusCode(v int) *EmailSendCreate {
+	_c.mutation.SetStatusCode(v)
+	return _c
+}
+
+// SetNillableStatusCode sets the "status_code" field if the given value is not nil.
+func (_c *EmailSendCreate) SetNillableStatusCode(v *int) *EmailSendCreate {
+	if v != nil {
+		_c.SetStatusCode(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *EmailSendCreate) SetLastError(v string) *EmailSendCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *EmailSendCreate) SetNillableLastError(v *string) *EmailSendCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetProvider sets the "provider" field.
+func (_c *EmailSendCreate) SetProvider(v string) *EmailSendCreate {
+	_c.mutation.SetProvider(v)
+	return _c
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_c *EmailSendCreate) SetNillableProvider(v *string) *EmailSendCreate {
+	if v != nil {
+		_c.SetProvider(*v)
+	}
+	return _c
+}
+
+// SetProviderMessageID sets the "provider_message_id" field.
+func (_c *EmailSendCreate) SetProviderMessageID(v string) *EmailSendCreate {
+	_c.mutation.SetProviderMessageID(v)
+	return _c
+}
+
+// SetNillableProviderMessageID sets the "provider_message_id" field if the given value is not nil.
+func (_c *EmailSendCreate) SetNillableProviderMessageID(v *string) *EmailSendCreate {
+	if v != nil {
+		_c.SetProviderMessageID(*v)
+	}
+	return _c
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_c *EmailSendCreate) SetNextAttemptAt(v time.Time) *EmailSendCreate {
+	_c.mutation.SetNextAttemptAt(v)
+	return _c
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_c *EmailSendCreate) SetNillableNextAttemptAt(v *time.Time) *EmailSendCreate {
+	if v != nil {
+		_c.SetNextAttemptAt(*v)
+	}
+	return _c
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_c *EmailSendCreate) SetSentAt(v time.Time) *EmailSendCreate {
+	_c.mutation.SetSentAt(v)
+	return _c
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_c *EmailSendCreate) SetNillableSentAt(v *time.Time) *EmailSendCreate {
+	if v != nil {
+		_c.SetSentAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EmailSendCreate) SetCreatedAt(v time.Time) *EmailSendCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *EmailSendCreate) SetNillableCreatedAt(v *time.Time) *EmailSendCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *EmailSendCreate) SetUpdatedAt(v time.Time) *EmailSendCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *EmailSendCreate) SetNillableUpdatedAt(v *time.Time) *EmailSendCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the EmailSendMutation object of the builder.
+func (_c *EmailSendCreate) Mutation() *EmailSendMutation {
+	return _c.mutation
+}
+
+// Save creates the EmailSend in the database.
+func (_c *EmailSendCreate) Save(ctx context.Context) (*EmailSend, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EmailSendCreate) SaveX(ctx context.Context) *EmailSend {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EmailSendCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EmailSendCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EmailSendCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := emailsend.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := emailsend.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := emailsend.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := emailsend.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EmailSendCreate) check() error {
+	if _, ok := _c.mutation.ToEmail(); !ok {
+		return &ValidationError{Name: "to_email", err: errors.New(`ent: missing required field "EmailSend.to_email"`)}
+	}
+	if v, ok := _c.mutation.ToEmail(); ok {
+		if err := emailsend.ToEmailValidator(v); err != nil {
+			return &ValidationError{Name: "to_email", err: fmt.Errorf(`ent: validator failed for field "EmailSend.to_email": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`ent: missing required field "EmailSend.subject"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "EmailSend.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := emailsend.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EmailSend.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "EmailSend.attempts"`)}
+	}
+	if v, ok := _c.mutation.Attempts(); ok {
+		if err := emailsend.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`ent: validator failed for field "EmailSend.attempts": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EmailSend.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "EmailSend.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *EmailSendCreate) sqlSave(ctx context.Context) (*EmailSend, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EmailSendCreate) createSpec() (*EmailSend, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EmailSend{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(emailsend.Table, sqlgraph.NewFieldSpec(emailsend.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.ToEmail(); ok {
+		_spec.SetField(emailsend.FieldToEmail, field.TypeString, value)
+		_node.ToEmail = value
+	}
+	if value, ok := _c.mutation.ToName(); ok {
+		_spec.SetField(emailsend.FieldToName, field.TypeString, value)
+		_node.ToName = value
+	}
+	if value, ok := _c.mutation.Template(); ok {
+		_spec.SetField(emailsend.FieldTemplate, field.TypeString, value)
+		_node.Template = value
+	}
+	if value, ok := _c.mutation.Subject(); ok {
+		_spec.SetField(emailsend.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := _c.mutation.HTMLBody(); ok {
+		_spec.SetField(emailsend.FieldHTMLBody, field.TypeString, value)
+		_node.HTMLBody = value
+	}
+	if value, ok := _c.mutation.TextBody(); ok {
+		_spec.SetField(emailsend.FieldTextBody, field.TypeString, value)
+		_node.TextBody = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(emailsend.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(emailsend.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.StatusCode(); ok {
+		_spec.SetField(emailsend.FieldStatusCode, field.TypeInt, value)
+		_node.StatusCode = &value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(emailsend.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := _c.mutation.Provider(); ok {
+		_spec.SetField(emailsend.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := _c.mutation.ProviderMessageID(); ok {
+		_spec.SetField(emailsend.FieldProviderMessageID, field.TypeString, value)
+		_node.ProviderMessageID = value
+	}
+	if value, ok := _c.mutation.NextAttemptAt(); ok {
+		_spec.SetField(emailsend.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = &value
+	}
+	if value, ok := _c.mutation.SentAt(); ok {
+		_spec.SetField(emailsend.FieldSentAt, field.TypeTime, value)
+		_node.SentAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(emailsend.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(emailsend.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// EmailSendCreateBulk is the builder for creating many EmailSend entities in bulk.
+type EmailSendCreateBulk struct {
+	config
+	err      error
+	builders []*EmailSendCreate
+}
+
+// Save creates the EmailSend entities in the database.
+func (_c *EmailSendCreateBulk) Save(ctx context.Context) ([]*EmailSend, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EmailSend, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EmailSendMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EmailSendCreateBulk) SaveX(ctx context.Context) []*EmailSend {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EmailSendCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EmailSendCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,970 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/emailsend"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+)
+
+// EmailSendUpdate is the builder for updating EmailSend entities.
+type EmailSendUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *EmailSendMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the EmailSendUpdate builder.
+func (_u *EmailSendUpdate) Where(ps ...predicate.EmailSend) *EmailSendUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetToEmail sets the "to_email" field.
+func (_u *EmailSendUpdate) SetToEmail(v string) *EmailSendUpdate {
+	_u.mutation.SetToEmail(v)
+	return _u
+}
+
+// SetNillableToEmail sets the "to_email" field if the given value is not nil.
+func (_u *EmailSendUpdate) SetNillableToEmail(v *string) *EmailSendUpdate {
+	if v != nil {
+		_u.SetToEmail(*v)
+	}
+	return _u
+}
+
+// SetToName sets the "to_name" field.
+func (_u *EmailSendUpdate) SetToName(v string) *EmailSendUpdate {
+	_u.mutation.SetToName(v)
+	return _u
+}
+
+// SetNillableToName sets the "to_name" field if the given value is not nil.
+func (_u *EmailSendUpdate) SetNillableToName(v *string) *EmailSendUpdate {
+	if v != nil {
+		_u.SetToName(*v)
+	}
+	return _u
+}
+
+// ClearToName clears the value of the "to_name" field.
+func (_u *EmailSendUpdate) ClearToName() *EmailSendUpdate {
+	_u.mutation.ClearToName()
+	return _u
+}
+
+// SetTemplate sets the "template" field.
+func (_u *EmailSendUpdate) SetTemplate(v string) *EmailSendUpdate {
+	_u.mutation.SetTemplate(v)
+	return _u
+}
+
+// SetNillableTemplate sets the "template" field if the given value is not nil.
+func (_u *EmailSendUpdate) SetNillableTemplate(v *string) *EmailSendUpdate {
+	if v != nil {
+		_u.SetTemplate(*v)
+	}
+	return _u
+}
+
+// ClearTemplate clears the value of the "template" field.
+func (_u *EmailSendUpdate) ClearTemplate() *EmailSendUpdate {
+	_u.mutation.ClearTemplate()
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *EmailSendUpdate) SetSubject(v string) *EmailSendUpdate {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *EmailSendUpdate) SetNillableSubject(v *string) *EmailSendUpdate {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// SetHTMLBody sets the "html_body" field.
+func (_u *EmailSendUpdate) SetHTMLBody(v string) *EmailSendUpdate {
+	_u.mutation.SetHTMLBody(v)
+	return _u
+}
+
+// SetNillableHTMLBody sets the "html_body" field if the given value is not nil.
+func (_u *EmailSendUpdate) SetNillableHTMLBody(v *string) *EmailSendUpdate {
+	if v != nil {
+		_u.SetHTMLBody(*v)
+	}
+	return _u
+}
+
+// ClearHTMLBody clears the value of the "html_body" field.
+func (_u *EmailSendUpdate) ClearHTMLBody() *EmailSendUpdate {
+	_u.mutation.ClearHTMLBody()
+	return _u
+}
+
+// SetTextBody sets the "text_body" field.
+func (_u *EmailSendUpdate) SetTextBody(v string) *EmailSendUpdate {
+	_u.mutation.SetTextBody(v)
+	return _u
+}
+
+// SetNillableTextBody sets the "text_body" field if the given value is not nil.
+func (_u *EmailSendUpdate) SetNillableTextBody(v *string) *EmailSendUpdate {
+	if v != nil {
+		_u.SetTextBody(*v)
+	}
+	return _u
+}
+
+// ClearTextBody clears the value of the "text_body" field.
+func (_u *EmailSendUpdate) ClearTextBody() *EmailSendUpdate {
+	_u.mutation.ClearTextBody()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *EmailSendUpdate) SetStatus(v emailsend.Status) *EmailSendUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *EmailSendUpdate) SetNillableStatus(v *emailsend.Status) *EmailSendUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *EmailSendUpdate) SetAttempts(v int) *EmailSendUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *EmailSendUpdate) SetNillableAttempts(v *int) *EmailSendUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *EmailSendUpdate) AddAttempts(v int) *EmailSendUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetStatusCode sets the "status_code" field.
+func (_u *EmailSendUpdate) SetStatusCode(v int) *EmailSendUpdate {
+	_u.mutation.ResetStatusCode()
+	_u.mutation.SetStatusCode(v)
+	return _u
+}
+
+// SetNillableStatusCode sets the "status_code" field if the given value is not nil.
+func (_u *EmailSendUpdate) SetNillableStatusCode(v *int) *EmailSendUpdate {
+	if v != nil {
+		_u.SetStatusCode(*v)
+	}
+	return _u
+}
+
+// AddStatusCode adds value to the "status_code" field.
+func (_u *EmailSendUpdate) AddStatusCode(v int) *EmailSendUpdate {
+	_u.mutation.AddStatusCode(v)
+	return _u
+}
+
+// ClearStatusCode clears the value of the "status_code" field.
+func (_u *EmailSendUpdate) ClearStatusCode() *EmailSendUpdate {
+	_u.mutation.ClearStatusCode()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *EmailSendUpdate) SetLastError(v string) *EmailSendUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *EmailSendUpdate) SetNillableLastError(v *string) *EmailSendUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *EmailSendUpdate) ClearLastError() *EmailSendUpdate {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *EmailSendUpdate) SetProvider(v string) *EmailSendUpdate {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *EmailSendUpdate) SetNillableProvider(v *string) *EmailSendUpdate {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// ClearProvider clears the value of the "provider" field.
+func (_u *EmailSendUpdate) ClearProvider() *EmailSendUpdate {
+	_u.mutation.ClearProvider()
+	return _u
+}
+
+// SetProviderMessageID sets the "provider_message_id" field.
+func (_u *EmailSendUpdate) SetProviderMessageID(v string) *EmailSendUpdate {
+	_u.mutation.SetProviderMessageID(v)
+	return _u
+}
+
+// SetNillableProviderMessageID sets the "provider_message_id" field if the given value is not nil.
+func (_u *EmailSendUpdate) SetNillableProviderMessageID(v *string) *EmailSendUpdate {
+	if v != nil {
+		_u.SetProviderMessageID(*v)
+	}
+	return _u
+}
+
+// ClearProviderMessageID clears the value of the "provider_message_id" field.
+func (_u *EmailSendUpdate) ClearProviderMessageID() *EmailSendUpdate {
+	_u.mutation.ClearProviderMessageID()
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *EmailSendUpdate) SetNextAttemptAt(v time.Time) *EmailSendUpdate {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *EmailSendUpdate) SetNillableNextAttemptAt(v *time.Time) *EmailSendUpdate {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (_u *EmailSendUpdate) ClearNextAttemptAt() *EmailSendUpdate {
+	_u.mutation.ClearNextAttemptAt()
+	return _u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_u *EmailSendUpdate) SetSentAt(v time.Time) *EmailSendUpdate {
+	_u.mutation.SetSentAt(v)
+	return _u
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_u *EmailSendUpdate) SetNillableSentAt(v *time.Time) *EmailSendUpdate {
+	if v != nil {
+		_u.SetSentAt(*v)
+	}
+	return _u
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (_u *EmailSendUpdate) ClearSentAt() *EmailSendUpdate {
+	_u.mutation.ClearSentAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EmailSendUpdate) SetUpdatedAt(v time.Time) *EmailSendUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the EmailSendMutation object of the builder.
+func (_u *EmailSendUpdate) Mutation() *EmailSendMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EmailSendUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EmailSendUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EmailSendUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EmailSendUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EmailSendUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := emailsend.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EmailSendUpdate) check() error {
+	if v, ok := _u.mutation.ToEmail(); ok {
+		if err := emailsend.ToEmailValidator(v); err != nil {
+			return &ValidationError{Name: "to_email", err: fmt.Errorf(`ent: validator failed for field "EmailSend.to_email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := emailsend.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EmailSend.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Attempts(); ok {
+		if err := emailsend.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`ent: validator failed for field "EmailSend.attempts": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *EmailSendUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EmailSendUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *EmailSendUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emailsend.Table, emailsend.Columns, sqlgraph.NewFieldSpec(emailsend.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ToEmail(); ok {
+		_spec.SetField(emailsend.FieldToEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ToName(); ok {
+		_spec.SetField(emailsend.FieldToName, field.TypeString, value)
+	}
+	if _u.mutation.ToNameCleared() {
+		_spec.ClearField(emailsend.FieldToName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Template(); ok {
+		_spec.SetField(emailsend.FieldTemplate, field.TypeString, value)
+	}
+	if _u.mutation.TemplateCleared() {
+		_spec.ClearField(emailsend.FieldTemplate, field.TypeString)
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(emailsend.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.HTMLBody(); ok {
+		_spec.SetField(emailsend.FieldHTMLBody, field.TypeString, value)
+	}
+	if _u.mutation.HTMLBodyCleared() {
+		_spec.ClearField(emailsend.FieldHTMLBody, field.TypeString)
+	}
+	if value, ok := _u.mutation.TextBody(); ok {
+		_spec.SetField(emailsend.FieldTextBody, field.TypeString, value)
+	}
+	if _u.mutation.TextBodyCleared() {
+		_spec.ClearField(emailsend.FieldTextBody, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(emailsend.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(emailsend.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(emailsend.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.StatusCode(); ok {
+		_spec.SetField(emailsend.FieldStatusCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStatusCode(); ok {
+		_spec.AddField(emailsend.FieldStatusCode, field.TypeInt, value)
+	}
+	if _u.mutation.StatusCodeCleared() {
+		_spec.ClearField(emailsend.FieldStatusCode, field.TypeInt)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(emailsend.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(emailsend.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(emailsend.FieldProvider, field.TypeString, value)
+	}
+	if _u.mutation.ProviderCleared() {
+		_spec.ClearField(emailsend.FieldProvider, field.TypeString)
+	}
+	if value, ok := _u.mutation.ProviderMessageID(); ok {
+		_spec.SetField(emailsend.FieldProviderMessageID, field.TypeString, value)
+	}
+	if _u.mutation.ProviderMessageIDCleared() {
+		_spec.ClearField(emailsend.FieldProviderMessageID, field.TypeString)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(emailsend.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextAttemptAtCleared() {
+		_spec.ClearField(emailsend.FieldNextAttemptAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SentAt(); ok {
+		_spec.SetField(emailsend.FieldSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.SentAtCleared() {
+		_spec.ClearField(emailsend.FieldSentAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(emailsend.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emailsend.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EmailSendUpdateOne is the builder for updating a single EmailSend entity.
+type EmailSendUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *EmailSendMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetToEmail sets the "to_email" field.
+func (_u *EmailSendUpdateOne) SetToEmail(v string) *EmailSendUpdateOne {
+	_u.mutation.SetToEmail(v)
+	return _u
+}
+
+// SetNillableToEmail sets the "to_email" field if the given value is not nil.
+func (_u *EmailSendUpdateOne) SetNillableToEmail(v *string) *EmailSendUpdateOne {
+	if v != nil {
+		_u.SetToEmail(*v)
+	}
+	return _u
+}
+
+// SetToName sets the "to_name" field.
+func (_u *EmailSendUpdateOne) SetToName(v string) *EmailSendUpdateOne {
+	_u.mutation.SetToName(v)
+	return _u
+}
+
+// SetNillableToName sets the "to_name" field if the given value is not nil.
+func (_u *EmailSendUpdateOne) SetNillableToName(v *string) *EmailSendUpdateOne {
+	if v != nil {
+		_u.SetToName(*v)
+	}
+	return _u
+}
+
+// ClearToName clears the value of the "to_name" field.
+func (_u *EmailSendUpdateOne) ClearToName() *EmailSendUpdateOne {
+	_u.mutation.ClearToName()
+	return _u
+}
+
+// SetTemplate sets the "template" field.
+func (_u *EmailSendUpdateOne) SetTemplate(v string) *EmailSendUpdateOne {
+	_u.mutation.SetTemplate(v)
+	return _u
+}
+
+// SetNillableTemplate sets the "template" field if the given value is not nil.
+func (_u *EmailSendUpdateOne) SetNillableTemplate(v *string) *EmailSendUpdateOne {
+	if v != nil {
+		_u.SetTemplate(*v)
+	}
+	return _u
+}
+
+// ClearTemplate clears the value of the "template" field.
+func (_u *EmailSendUpdateOne) ClearTemplate() *EmailSendUpdateOne {
+	_u.mutation.ClearTemplate()
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *EmailSendUpdateOne) SetSubject(v string) *EmailSendUpdateOne {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *EmailSendUpdateOne) SetNillableSubject(v *string) *EmailSendUpdateOne {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// SetHTMLBody sets the "html_body" field.
+func (_u *EmailSendUpdateOne) SetHTMLBody(v string) *EmailSendUpdateOne {
+	_u.mutation.SetHTMLBody(v)
+	return _u
+}
+
+// SetNillableHTMLBody sets the "html_body" field if the given value is not nil.
+func (_u *EmailSendUpdateOne) SetNillableHTMLBody(v *string) *EmailSendUpdateOne {
+	if v != nil {
+		_u.SetHTMLBody(*v)
+	}
+	return _u
+}
+
+// ClearHTMLBody clears the value of the "html_body" field.
+func (_u *EmailSendUpdateOne) ClearHTMLBody() *EmailSendUpdateOne {
+	_u.mutation.ClearHTMLBody()
+	return _u
+}
+
+// SetTextBody sets the "text_body" field.
+func (_u *EmailSendUpdateOne) SetTextBody(v string) *EmailSendUpdateOne {
+	_u.mutation.SetTextBody(v)
+	return _u
+}
+
+// SetNillableTextBody sets the "text_body" field if the given value is not nil.
+func (_u *EmailSendUpdateOne) SetNillableTextBody(v *string) *EmailSendUpdateOne {
+	if v != nil {
+		_u.SetTextBody(*v)
+	}
+	return _u
+}
+
+// ClearTextBody clears the value of the "text_body" field.
+func (_u *EmailSendUpdateOne) ClearTextBody() *EmailSendUpdateOne {
+	_u.mutation.ClearTextBody()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *EmailSendUpdateOne) SetStatus(v emailsend.Status) *EmailSendUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *EmailSendUpdateOne) SetNillableStatus(v *emailsend.Status) *EmailSendUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *EmailSendUpdateOne) SetAttempts(v int) *EmailSendUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *EmailSendUpdateOne) SetNillableAttempts(v *int) *EmailSendUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *EmailSendUpdateOne) AddAttempts(v int) *EmailSendUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetStatusCode sets the "status_code" field.
+func (_u *EmailSendUpdateOne) SetStatusCode(v int) *EmailSendUpdateOne {
+	_u.mutation.ResetStatusCode()
+	_u.mutation.SetStatusCode(v)
+	return _u
+}
+
+// SetNillableStatusCode sets the "status_code" field if the given value is not nil.
+func (_u *EmailSendUpdateOne) SetNillableStatusCode(v *int) *EmailSendUpdateOne {
+	if v != nil {
+		_u.SetStatusCode(*v)
+	}
+	return _u
+}
+
+// AddStatusCode adds value to the "status_code" field.
+func (_u *EmailSendUpdateOne) AddStatusCode(v int) *EmailSendUpdateOne {
+	_u.mutation.AddStatusCode(v)
+	return _u
+}
+
+// ClearStatusCode clears the value of the "status_code" field.
+func (_u *EmailSendUpdateOne) ClearStatusCode() *EmailSendUpdateOne {
+	_u.mutation.ClearStatusCode()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *EmailSendUpdateOne) SetLastError(v string) *EmailSendUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *EmailSendUpdateOne) SetNillableLastError(v *string) *EmailSendUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *EmailSendUpdateOne) ClearLastError() *EmailSendUpdateOne {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *EmailSendUpdateOne) SetProvider(v string) *EmailSendUpdateOne {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *EmailSendUpdateOne) SetNillableProvider(v *string) *EmailSendUpdateOne {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// ClearProvider clears the value of the "provider" field.
+func (_u *EmailSendUpdateOne) ClearProvider() *EmailSendUpdateOne {
+	_u.mutation.ClearProvider()
+	return _u
+}
+
+// SetProviderMessageID sets the "provider_message_id" field.
+func (_u *EmailSendUpdateOne) SetProviderMessageID(v string) *EmailSendUpdateOne {
+	_u.mutation.SetProviderMessageID(v)
+	return _u
+}
+
+// SetNillableProviderMessageID sets the "provider_message_id" field if the given value is not nil.
+func (_u *EmailSendUpdateOne) SetNillableProviderMessageID(v *string) *EmailSendUpdateOne {
+	if v != nil {
+		_u.SetProviderMessageID(*v)
+	}
+	return _u
+}
+
+// ClearProviderMessageID clears the value of the "provider_message_id" field.
+func (_u *EmailSendUpdateOne) ClearProviderMessageID() *EmailSendUpdateOne {
+	_u.mutation.ClearProviderMessageID()
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *EmailSendUpdateOne) SetNextAttemptAt(v time.Time) *EmailSendUpdateOne {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *EmailSendUpdateOne) SetNillableNextAttemptAt(v *time.Time) *EmailSendUpdateOne {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (_u *EmailSendUpdateOne) ClearNextAttemptAt() *EmailSendUpdateOne {
+	_u.mutation.ClearNextAttemptAt()
+	return _u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_u *EmailSendUpdateOne) SetSentAt(v time.Time) *EmailSendUpdateOne {
+	_u.mutation.SetSentAt(v)
+	return _u
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_u *EmailSendUpdateOne) SetNillableSentAt(v *time.Time) *EmailSendUpdateOne {
+	if v != nil {
+		_u.SetSentAt(*v)
+	}
+	return _u
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (_u *EmailSendUpdateOne) ClearSentAt() *EmailSendUpdateOne {
+	_u.mutation.ClearSentAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EmailSendUpdateOne) SetUpdatedAt(v time.Time) *EmailSendUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the EmailSendMutation object of the builder.
+func (_u *EmailSendUpdateOne) Mutation() *EmailSendMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the EmailSendUpdate builder.
+func (_u *EmailSendUpdateOne) Where(ps ...predicate.EmailSend) *EmailSendUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EmailSendUpdateOne) Select(field string, fields ...string) *EmailSendUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EmailSend entity.
+func (_u *EmailSendUpdateOne) Save(ctx context.Context) (*EmailSend, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EmailSendUpdateOne) SaveX(ctx context.Context) *EmailSend {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EmailSendUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EmailSendUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EmailSendUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := emailsend.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EmailSendUpdateOne) check() error {
+	if v, ok := _u.mutation.ToEmail(); ok {
+		if err := emailsend.ToEmailValidator(v); err != nil {
+			return &ValidationError{Name: "to_email", err: fmt.Errorf(`ent: validator failed for field "EmailSend.to_email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := emailsend.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EmailSend.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Attempts(); ok {
+		if err := emailsend.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`ent: validator failed for field "EmailSend.attempts": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *EmailSendUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EmailSendUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *EmailSendUpdateOne) sqlSave(ctx context.Context) (_node *EmailSend, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emailsend.Table, emailsend.Columns, sqlgraph.NewFieldSpec(emailsend.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EmailSend.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, emailsend.FieldID)
+		for _, f := range fields {
+			if !emailsend.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != emailsend.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ToEmail(); ok {
+		_spec.SetField(emailsend.FieldToEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ToName(); ok {
+		_spec.SetField(emailsend.FieldToName, field.TypeString, value)
+	}
+	if _u.mutation.ToNameCleared() {
+		_spec.ClearField(emailsend.FieldToName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Template(); ok {
+		_spec.SetField(emailsend.FieldTemplate, field.TypeString, value)
+	}
+	if _u.mutation.TemplateCleared() {
+		_spec.ClearField(emailsend.FieldTemplate, field.TypeString)
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(emailsend.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.HTMLBody(); ok {
+		_spec.SetField(emailsend.FieldHTMLBody, field.TypeString, value)
+	}
+	if _u.mutation.HTMLBodyCleared() {
+		_spec.ClearField(emailsend.FieldHTMLBody, field.TypeString)
+	}
+	if value, ok := _u.mutation.TextBody(); ok {
+		_spec.SetField(emailsend.FieldTextBody, field.TypeString, value)
+	}
+	if _u.mutation.TextBodyCleared() {
+		_spec.ClearField(emailsend.FieldTextBody, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(emailsend.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(emailsend.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(emailsend.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.StatusCode(); ok {
+		_spec.SetField(emailsend.FieldStatusCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStatusCode(); ok {
+		_spec.AddField(emailsend.FieldStatusCode, field.TypeInt, value)
+	}
+	if _u.mutation.StatusCodeCleared() {
+		_spec.ClearField(emailsend.FieldStatusCode, field.TypeInt)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(emailsend.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(emailsend.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(emailsend.FieldProvider, field.TypeString, value)
+	}
+	if _u.mutation.ProviderCleared() {
+		_spec.ClearField(emailsend.FieldProvider, field.TypeString)
+	}
+	if value, ok := _u.mutation.ProviderMessageID(); ok {
+		_spec.SetField(emailsend.FieldProviderMessageID, field.TypeString, value)
+	}
+	if _u.mutation.ProviderMessageIDCleared() {
+		_spec.ClearField(emailsend.FieldProviderMessageID, field.TypeString)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(emailsend.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextAttemptAtCleared() {
+		_spec.ClearField(emailsend.FieldNextAttemptAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SentAt(); ok {
+		_spec.SetField(emailsend.FieldSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.SentAtCleared() {
+		_spec.ClearField(emailsend.FieldSentAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(emailsend.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &EmailSend{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emailsend.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -26,6 +26,12 @@ type EmailSequence struct {
 	Status emailsequence.Status `json:"status,omitempty"`
 	// What triggers enrollment in this sequence
 	Trigger emailsequence.Trigger `json:"trigger,omitempty"`
+	// IANA timezone of the send window (empty = UTC)
+	DeliveryTimezone string `json:"delivery_timezone,omitempty"`
+	// Local hour sends start (null = send 24/7)
+	DeliveryStartHour *int `json:"delivery_start_hour,omitempty"`
+	// Local hour sends stop, exclusive; before start_hour wraps past midnight
+	DeliveryEndHour *int `json:"delivery_end_hour,omitempty"`
 	// User who created this sequence
 	CreatedByUserID int `json:"created_by_user_id,omitempty"`
 	// Creation timestamp
@@ -85,9 +91,9 @@ func (*EmailSequence) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case emailsequence.FieldID, emailsequence.FieldCreatedByUserID:
+		case emailsequence.FieldID, emailsequence.FieldDeliveryStartHour, emailsequence.FieldDeliveryEndHour, emailsequence.FieldCreatedByUserID:
 			values[i] = new(sql.NullInt64)
-		case emailsequence.FieldName, emailsequence.FieldDescription, emailsequence.FieldStatus, emailsequence.FieldTrigger:
+		case emailsequence.FieldName, emailsequence.FieldDescription, emailsequence.FieldStatus, emailsequence.FieldTrigger, emailsequence.FieldDeliveryTimezone:
 			values[i] = new(sql.NullString)
 		case emailsequence.FieldCreatedAt, emailsequence.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -136,6 +142,26 @@ func (_m *EmailSequence) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Trigger = emailsequence.Trigger(value.String)
 			}
+		case emailsequence.FieldDeliveryTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field delivery_timezone", values[i])
+			} else if value.Valid {
+				_m.DeliveryTimezone = value.String
+			}
+		case emailsequence.FieldDeliveryStartHour:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field delivery_start_hour", values[i])
+			} else if value.Valid {
+				_m.DeliveryStartHour = new(int)
+				*_m.DeliveryStartHour = int(value.Int64)
+			}
+		case emailsequence.FieldDeliveryEndHour:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field delivery_end_hour", values[i])
+			} else if value.Valid {
+				_m.DeliveryEndHour = new(int)
+				*_m.DeliveryEndHour = int(value.Int64)
+			}
 		case emailsequence.FieldCreatedByUserID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field created_by_user_id", values[i])
@@ -217,6 +243,19 @@ func (_m *EmailSequence) String() string {
 	builder.WriteString("trigger=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Trigger))
 	builder.WriteString(", ")
+	builder.WriteString("delivery_timezone=")
+	builder.WriteString(_m.DeliveryTimezone)
+	builder.WriteString(", ")
+	if v := _m.DeliveryStartHour; v != nil {
+		builder.WriteString("delivery_start_hour=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeliveryEndHour; v != nil {
+		builder.WriteString("delivery_end_hour=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_by_user_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.CreatedByUserID))
 	builder.WriteString(", ")
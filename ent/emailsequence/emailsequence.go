@@ -23,6 +23,12 @@ const (
 	FieldStatus = "status"
 	// FieldTrigger holds the string denoting the trigger field in the database.
 	FieldTrigger = "trigger"
+	// FieldDeliveryTimezone holds the string denoting the delivery_timezone field in the database.
+	FieldDeliveryTimezone = "delivery_timezone"
+	// FieldDeliveryStartHour holds the string denoting the delivery_start_hour field in the database.
+	FieldDeliveryStartHour = "delivery_start_hour"
+	// FieldDeliveryEndHour holds the string denoting the delivery_end_hour field in the database.
+	FieldDeliveryEndHour = "delivery_end_hour"
 	// FieldCreatedByUserID holds the string denoting the created_by_user_id field in the database.
 	FieldCreatedByUserID = "created_by_user_id"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -67,6 +73,9 @@ var Columns = []string{
 	FieldDescription,
 	FieldStatus,
 	FieldTrigger,
+	FieldDeliveryTimezone,
+	FieldDeliveryStartHour,
+	FieldDeliveryEndHour,
 	FieldCreatedByUserID,
 	FieldCreatedAt,
 	FieldUpdatedAt,
@@ -85,6 +94,10 @@ func ValidColumn(column string) bool {
 var (
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
+	// DeliveryStartHourValidator is a validator for the "delivery_start_hour" field. It is called by the builders before save.
+	DeliveryStartHourValidator func(int) error
+	// DeliveryEndHourValidator is a validator for the "delivery_end_hour" field. It is called by the builders before save.
+	DeliveryEndHourValidator func(int) error
 	// CreatedByUserIDValidator is a validator for the "created_by_user_id" field. It is called by the builders before save.
 	CreatedByUserIDValidator func(int) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
@@ -179,6 +192,21 @@ func ByTrigger(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTrigger, opts...).ToFunc()
 }
 
+// ByDeliveryTimezone orders the results by the delivery_timezone field.
+func ByDeliveryTimezone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveryTimezone, opts...).ToFunc()
+}
+
+// ByDeliveryStartHour orders the results by the delivery_start_hour field.
+func ByDeliveryStartHour(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveryStartHour, opts...).ToFunc()
+}
+
+// ByDeliveryEndHour orders the results by the delivery_end_hour field.
+func ByDeliveryEndHour(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveryEndHour, opts...).ToFunc()
+}
+
 // ByCreatedByUserID orders the results by the created_by_user_id field.
 func ByCreatedByUserID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedByUserID, opts...).ToFunc()
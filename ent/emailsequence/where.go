@@ -65,6 +65,21 @@ func Description(v string) predicate.EmailSequence {
 	return predicate.EmailSequence(sql.FieldEQ(FieldDescription, v))
 }
 
+// DeliveryTimezone applies equality check predicate on the "delivery_timezone" field. It's identical to DeliveryTimezoneEQ.
+func DeliveryTimezone(v string) predicate.EmailSequence {
+	return predicate.EmailSequence(sql.FieldEQ(FieldDeliveryTimezone, v))
+}
+
+// DeliveryStartHour applies equality check predicate on the "delivery_start_hour" field. It's identical to DeliveryStartHourEQ.
+func DeliveryStartHour(v int) predicate.EmailSequence {
+	return predicate.EmailSequence(sql.FieldEQ(FieldDeliveryStartHour, v))
+}
+
+// DeliveryEndHour applies equality check predicate on the "delivery_end_hour" field. It's identical to DeliveryEndHourEQ.
+func DeliveryEndHour(v int) predicate.EmailSequence {
+	return predicate.EmailSequence(sql.FieldEQ(FieldDeliveryEndHour, v))
+}
+
 // CreatedByUserID applies equality check predicate on the "created_by_user_id" field. It's identical to CreatedByUserIDEQ.
 func CreatedByUserID(v int) predicate.EmailSequence {
 	return predicate.EmailSequence(sql.FieldEQ(FieldCreatedByUserID, v))
@@ -260,6 +275,181 @@ func TriggerNotIn(vs ...Trigger) predicate.EmailSequence {
 	return predicate.EmailSequence(sql.FieldNotIn(FieldTrigger, vs...))
 }
 
+// DeliveryTimezoneEQ applies the EQ predicate on the "delivery_timezone" field.
+func DeliveryTimezoneEQ(v string) predicate.EmailSequence {
+	return predicate.EmailSequence(sql.FieldEQ(FieldDeliveryTimezone, v))
+}
+
+// DeliveryTimezoneNEQ applies the NEQ predicate on the "delivery_timezone" field.
+func DeliveryTimezoneNEQ(v string) predicate.EmailSequence {
+	return predicate.EmailSequence(sql.FieldNEQ(FieldDeliveryTimezone, v))
+}
+
+// DeliveryTimezoneIn applies the In predicate on the "delivery_timezone" field.
+func DeliveryTimezoneIn(vs ...string) predicate.EmailSequence {
+	return predicate.EmailSequence(sql.FieldIn(FieldDeliveryTimezone, vs...))
+}
+
+// DeliveryTimezoneNotIn applies the NotIn predicate on the "delivery_timezone" field.
+func DeliveryTimezoneNotIn(vs ...string) predicate.EmailSequence {
+	return predicate.EmailSequence(sql.FieldNotIn(FieldDeliveryTimezone, vs...))
+}
+
+// DeliveryTimezoneGT applies the GT predicate on the "delivery_timezone" field.
+func DeliveryTimezoneGT(v string) predicate.EmailSequence {
+	return predicate.EmailSequence(sql.FieldGT(FieldDeliveryTimezone, v))
+}
+
+// DeliveryTimezoneGTE applies the GTE predicate on the "delivery_timezone" field.
+func DeliveryTimezoneGTE(v string) predicate.EmailSequence {
+	return predicate.EmailSequence(sql.FieldGTE(FieldDeliveryTimezone, v))
+}
+
+// DeliveryTimezoneLT applies the LT predicate on the "delivery_timezone" field.
+func DeliveryTimezoneLT(v string) predicate.EmailSequence {
+	return predicate.EmailSequence(sql.FieldLT(FieldDeliveryTimezone, v))
+}
+
+// DeliveryTimezoneLTE applies the LTE predicate on the "delivery_timezone" field.
+func DeliveryTimezoneLTE(v string) predicate.EmailSequence {
+	return predicate.EmailSequence(sql.FieldLTE(FieldDeliveryTimezone, v))
+}
+
+// DeliveryTimezoneContains applies the Contains predicate on the "delivery_timezone" field.
+func DeliveryTimezoneContains(v string) predicate.EmailSequence {
+	return predicate.EmailSequence(sql.FieldContains(FieldDeliveryTimezone, v))
+}
+
+// DeliveryTimezoneHasPrefix applies the HasPrefix predicate on the "delivery_timezone" field.
+func DeliveryTimezoneHasPrefix(v string) predicate.EmailSequence {
+	return predicate.EmailSequence(sql.FieldHasPrefix(FieldDeliveryTimezone, v))
+}
+
+// DeliveryTimezoneHasSuffix applies the HasSuffix predicate on the "delivery_timezone" field.
+func DeliveryTimezoneHasSuffix(v string) predicate.EmailSequence {
+	return predicate.EmailSequence(sql.FieldHasSuffix(FieldDeliveryTimezone, v))
+}
+
+// DeliveryTimezoneIsNil applies the IsNil predicate on the "delivery_timezone" field.
+func DeliveryTimezoneIsNil() predicate.EmailSequence {
+	return predicate.EmailSequence(sql.FieldIsNull(FieldDeliveryTimezone))
+}
+
+// DeliveryTimezoneNotNil applies the NotNil predicate on the "delivery_timezone" field.
+func DeliveryTimezoneNotNil() predicate.EmailSequence {
+	return predicate.EmailSequence(sql.FieldNotNull(FieldDeliveryTimezone))
+}
+
+// DeliveryTimezoneEqualFold applies the EqualFold predicate on the "delivery_timezone" field.
+func DeliveryTimezoneEqualFold(v string) predicate.EmailSequence {
+	return predicate.EmailSequence(sql.FieldEqualFold(FieldDeliveryTimezone, v))
+}
+
+// DeliveryTimezoneContainsFold applies the ContainsFold predicate on the "delivery_timezone" field.
+func DeliveryTimezoneContainsFold(v string) predicate.EmailSequence {
+	return predicate.EmailSequence(sql.FieldContainsFold(FieldDeliveryTimezone, v))
+}
+
+// DeliveryStartHourEQ applies the EQ predicate on the "delivery_start_hour" field.
+func DeliveryStartHourEQ(v int) predicate.EmailSequence {
+	return predicate.EmailSequence(sql.FieldEQ(FieldDeliveryStartHour, v))
+}
+
+// DeliveryStartHourNEQ applies the NEQ predicate on the "delivery_start_hour" field.
+func DeliveryStartHourNEQ(v int) predicate.EmailSequence {
+	return predicate.EmailSequence(sql.FieldNEQ(FieldDeliveryStartHour, v))
+}
+
+// DeliveryStartHourIn applies the In predicate on the "delivery_start_hour" field.
+func DeliveryStartHourIn(vs ...int) predicate.EmailSequence {
+	return predicate.EmailSequence(sql.FieldIn(FieldDeliveryStartHour, vs...))
+}
+
+// DeliveryStartHourNotIn applies the NotIn predicate on the "delivery_start_hour" field.
+func DeliveryStartHourNotIn(vs ...int) predicate.EmailSequence {
+	return predicate.EmailSequence(sql.FieldNotIn(FieldDeliveryStartHour, vs...))
+}
+
+// DeliveryStartHourGT applies the GT predicate on the "delivery_start_hour" field.
+func DeliveryStartHourGT(v int) predicate.EmailSequence {
+	return predicate.EmailSequence(sql.FieldGT(FieldDeliveryStartHour, v))
+}
+
+// DeliveryStartHourGTE applies the GTE predicate on the "delivery_start_hour" field.
+func DeliveryStartHourGTE(v int) predicate.EmailSequence {
+	return predicate.EmailSequence(sql.FieldGTE(FieldDeliveryStartHour, v))
+}
+
+// DeliveryStartHourLT applies the LT predicate on the "delivery_start_hour" field.
+func DeliveryStartHourLT(v int) predicate.EmailSequence {
+	return predicate.EmailSequence(sql.FieldLT(FieldDeliveryStartHour, v))
+}
+
+// DeliveryStartHourLTE applies the LTE predicate on the "delivery_start_hour" field.
+func DeliveryStartHourLTE(v int) predicate.EmailSequence {
+	return predicate.EmailSequence(sql.FieldLTE(FieldDeliveryStartHour, v))
+}
+
+// DeliveryStartHourIsNil applies the IsNil predicate on the "delivery_start_hour" field.
+func DeliveryStartHourIsNil() predicate.EmailSequence {
+	return predicate.EmailSequence(sql.FieldIsNull(FieldDeliveryStartHour))
+}
+
+// DeliveryStartHourNotNil applies the NotNil predicate on the "delivery_start_hour" field.
+func DeliveryStartHourNotNil() predicate.EmailSequence {
+	return predicate.EmailSequence(sql.FieldNotNull(FieldDeliveryStartHour))
+}
+
+// DeliveryEndHourEQ applies the EQ predicate on the "delivery_end_hour" field.
+func DeliveryEndHourEQ(v int) predicate.EmailSequence {
+	return predicate.EmailSequence(sql.FieldEQ(FieldDeliveryEndHour, v))
+}
+
+// DeliveryEndHourNEQ applies the NEQ predicate on the "delivery_end_hour" field.
+func DeliveryEndHourNEQ(v int) predicate.EmailSequence {
+	return predicate.EmailSequence(sql.FieldNEQ(FieldDeliveryEndHour, v))
+}
+
+// DeliveryEndHourIn applies the In predicate on the "delivery_end_hour" field.
+func DeliveryEndHourIn(vs ...int) predicate.EmailSequence {
+	return predicate.EmailSequence(sql.FieldIn(FieldDeliveryEndHour, vs...))
+}
+
+// DeliveryEndHourNotIn applies the NotIn predicate on the "delivery_end_hour" field.
+func DeliveryEndHourNotIn(vs ...int) predicate.EmailSequence {
+	return predicate.EmailSequence(sql.FieldNotIn(FieldDeliveryEndHour, vs...))
+}
+
+// DeliveryEndHourGT applies the GT predicate on the "delivery_end_hour" field.
+func DeliveryEndHourGT(v int) predicate.EmailSequence {
+	return predicate.EmailSequence(sql.FieldGT(FieldDeliveryEndHour, v))
+}
+
+// DeliveryEndHourGTE applies the GTE predicate on the "delivery_end_hour" field.
+func DeliveryEndHourGTE(v int) predicate.EmailSequence {
+	return predicate.EmailSequence(sql.FieldGTE(FieldDeliveryEndHour, v))
+}
+
+// DeliveryEndHourLT applies the LT predicate on the "delivery_end_hour" field.
+func DeliveryEndHourLT(v int) predicate.EmailSequence {
+	return predicate.EmailSequence(sql.FieldLT(FieldDeliveryEndHour, v))
+}
+
+// DeliveryEndHourLTE applies the LTE predicate on the "delivery_end_hour" field.
+func DeliveryEndHourLTE(v int) predicate.EmailSequence {
+	return predicate.EmailSequence(sql.FieldLTE(FieldDeliveryEndHour, v))
+}
+
+// DeliveryEndHourIsNil applies the IsNil predicate on the "delivery_end_hour" field.
+func DeliveryEndHourIsNil() predicate.EmailSequence {
+	return predicate.EmailSequence(sql.FieldIsNull(FieldDeliveryEndHour))
+}
+
+// DeliveryEndHourNotNil applies the NotNil predicate on the "delivery_end_hour" field.
+func DeliveryEndHourNotNil() predicate.EmailSequence {
+	return predicate.EmailSequence(sql.FieldNotNull(FieldDeliveryEndHour))
+}
+
 // CreatedByUserIDEQ applies the EQ predicate on the "created_by_user_id" field.
 func CreatedByUserIDEQ(v int) predicate.EmailSequence {
 	return predicate.EmailSequence(sql.FieldEQ(FieldCreatedByUserID, v))
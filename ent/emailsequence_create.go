@@ -71,6 +71,48 @@ func (_c *EmailSequenceCreate) SetNillableTrigger(v *emailsequence.Trigger) *Ema
 	return _c
 }
 
+// SetDeliveryTimezone sets the "delivery_timezone" field.
+func (_c *EmailSequenceCreate) SetDeliveryTimezone(v string) *EmailSequenceCreate {
+	_c.mutation.SetDeliveryTimezone(v)
+	return _c
+}
+
+// SetNillableDeliveryTimezone sets the "delivery_timezone" field if the given value is not nil.
+func (_c *EmailSequenceCreate) SetNillableDeliveryTimezone(v *string) *EmailSequenceCreate {
+	if v != nil {
+		_c.SetDeliveryTimezone(*v)
+	}
+	return _c
+}
+
+// SetDeliveryStartHour sets the "delivery_start_hour" field.
+func (_c *EmailSequenceCreate) SetDeliveryStartHour(v int) *EmailSequenceCreate {
+	_c.mutation.SetDeliveryStartHour(v)
+	return _c
+}
+
+// SetNillableDeliveryStartHour sets the "delivery_start_hour" field if the given value is not nil.
+func (_c *EmailSequenceCreate) SetNillableDeliveryStartHour(v *int) *EmailSequenceCreate {
+	if v != nil {
+		_c.SetDeliveryStartHour(*v)
+	}
+	return _c
+}
+
+// SetDeliveryEndHour sets the "delivery_end_hour" field.
+func (_c *EmailSequenceCreate) SetDeliveryEndHour(v int) *EmailSequenceCreate {
+	_c.mutation.SetDeliveryEndHour(v)
+	return _c
+}
+
+// SetNillableDeliveryEndHour sets the "delivery_end_hour" field if the given value is not nil.
+func (_c *EmailSequenceCreate) SetNillableDeliveryEndHour(v *int) *EmailSequenceCreate {
+	if v != nil {
+		_c.SetDeliveryEndHour(*v)
+	}
+	return _c
+}
+
 // SetCreatedByUserID sets the "created_by_user_id" field.
 func (_c *EmailSequenceCreate) SetCreatedByUserID(v int) *EmailSequenceCreate {
 	_c.mutation.SetCreatedByUserID(v)
@@ -225,6 +267,16 @@ func (_c *EmailSequenceCreate) check() error {
 			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "EmailSequence.trigger": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.DeliveryStartHour(); ok {
+		if err := emailsequence.DeliveryStartHourValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_start_hour", err: fmt.Errorf(`ent: validator failed for field "EmailSequence.delivery_start_hour": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DeliveryEndHour(); ok {
+		if err := emailsequence.DeliveryEndHourValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_end_hour", err: fmt.Errorf(`ent: validator failed for field "EmailSequence.delivery_end_hour": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedByUserID(); !ok {
 		return &ValidationError{Name: "created_by_user_id", err: errors.New(`ent: missing required field "EmailSequence.created_by_user_id"`)}
 	}
@@ -284,6 +336,18 @@ func (_c *EmailSequenceCreate) createSpec() (*EmailSequence, *sqlgraph.CreateSpe
 		_spec.SetField(emailsequence.FieldTrigger, field.TypeEnum, value)
 		_node.Trigger = value
 	}
+	if value, ok := _c.mutation.DeliveryTimezone(); ok {
+		_spec.SetField(emailsequence.FieldDeliveryTimezone, field.TypeString, value)
+		_node.DeliveryTimezone = value
+	}
+	if value, ok := _c.mutation.DeliveryStartHour(); ok {
+		_spec.SetField(emailsequence.FieldDeliveryStartHour, field.TypeInt, value)
+		_node.DeliveryStartHour = &value
+	}
+	if value, ok := _c.mutation.DeliveryEndHour(); ok {
+		_spec.SetField(emailsequence.FieldDeliveryEndHour, field.TypeInt, value)
+		_node.DeliveryEndHour = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(emailsequence.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -29,6 +29,7 @@ type EmailSequenceQuery struct {
 	withCreatedBy   *UserQuery
 	withSteps       *EmailSequenceStepQuery
 	withEnrollments *EmailSequenceEnrollmentQuery
+	modifiers       []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -327,8 +328,9 @@ func (_q *EmailSequenceQuery) Clone() *EmailSequenceQuery {
 		withSteps:       _q.withSteps.Clone(),
 		withEnrollments: _q.withEnrollments.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -458,6 +460,9 @@ func (_q *EmailSequenceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -584,6 +589,9 @@ func (_q *EmailSequenceQuery) loadEnrollments(ctx context.Context, query *EmailS
 
 func (_q *EmailSequenceQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -649,6 +657,9 @@ func (_q *EmailSequenceQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -666,6 +677,12 @@ func (_q *EmailSequenceQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *EmailSequenceQuery) Modify(modifiers ...func(s *sql.Selector)) *EmailSequenceSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // EmailSequenceGroupBy is the group-by builder for EmailSequence entities.
 type EmailSequenceGroupBy struct {
 	selector
@@ -755,3 +772,9 @@ func (_s *EmailSequenceSelect) sqlScan(ctx context.Context, root *EmailSequenceQ
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *EmailSequenceSelect) Modify(modifiers ...func(s *sql.Selector)) *EmailSequenceSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
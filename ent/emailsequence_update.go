@@ -21,8 +21,9 @@ import (
 // EmailSequenceUpdate is the builder for updating EmailSequence entities.
 type EmailSequenceUpdate struct {
 	config
-	hooks    []Hook
-	mutation *EmailSequenceMutation
+	hooks     []Hook
+	mutation  *EmailSequenceMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the EmailSequenceUpdate builder.
@@ -93,6 +94,80 @@ func (_u *EmailSequenceUpdate) SetNillableTrigger(v *emailsequence.Trigger) *Ema
 	return _u
 }
 
+// SetDeliveryTimezone sets the "delivery_timezone" field.
+func (_u *EmailSequenceUpdate) SetDeliveryTimezone(v string) *EmailSequenceUpdate {
+	_u.mutation.SetDeliveryTimezone(v)
+	return _u
+}
+
+// SetNillableDeliveryTimezone sets the "delivery_timezone" field if the given value is not nil.
+func (_u *EmailSequenceUpdate) SetNillableDeliveryTimezone(v *string) *EmailSequenceUpdate {
+	if v != nil {
+		_u.SetDeliveryTimezone(*v)
+	}
+	return _u
+}
+
+// ClearDeliveryTimezone clears the value of the "delivery_timezone" field.
+func (_u *EmailSequenceUpdate) ClearDeliveryTimezone() *EmailSequenceUpdate {
+	_u.mutation.ClearDeliveryTimezone()
+	return _u
+}
+
+// SetDeliveryStartHour sets the "delivery_start_hour" field.
+func (_u *EmailSequenceUpdate) SetDeliveryStartHour(v int) *EmailSequenceUpdate {
+	_u.mutation.ResetDeliveryStartHour()
+	_u.mutation.SetDeliveryStartHour(v)
+	return _u
+}
+
+// SetNillableDeliveryStartHour sets the "delivery_start_hour" field if the given value is not nil.
+func (_u *EmailSequenceUpdate) SetNillableDeliveryStartHour(v *int) *EmailSequenceUpdate {
+	if v != nil {
+		_u.SetDeliveryStartHour(*v)
+	}
+	return _u
+}
+
+// AddDeliveryStartHour adds value to the "delivery_start_hour" field.
+func (_u *EmailSequenceUpdate) AddDeliveryStartHour(v int) *EmailSequenceUpdate {
+	_u.mutation.AddDeliveryStartHour(v)
+	return _u
+}
+
+// ClearDeliveryStartHour clears the value of the "delivery_start_hour" field.
+func (_u *EmailSequenceUpdate) ClearDeliveryStartHour() *EmailSequenceUpdate {
+	_u.mutation.ClearDeliveryStartHour()
+	return _u
+}
+
+// SetDeliveryEndHour sets the "delivery_end_hour" field.
+func (_u *EmailSequenceUpdate) SetDeliveryEndHour(v int) *EmailSequenceUpdate {
+	_u.mutation.ResetDeliveryEndHour()
+	_u.mutation.SetDeliveryEndHour(v)
+	return _u
+}
+
+// SetNillableDeliveryEndHour sets the "delivery_end_hour" field if the given value is not nil.
+func (_u *EmailSequenceUpdate) SetNillableDeliveryEndHour(v *int) *EmailSequenceUpdate {
+	if v != nil {
+		_u.SetDeliveryEndHour(*v)
+	}
+	return _u
+}
+
+// AddDeliveryEndHour adds value to the "delivery_end_hour" field.
+func (_u *EmailSequenceUpdate) AddDeliveryEndHour(v int) *EmailSequenceUpdate {
+	_u.mutation.AddDeliveryEndHour(v)
+	return _u
+}
+
+// ClearDeliveryEndHour clears the value of the "delivery_end_hour" field.
+func (_u *EmailSequenceUpdate) ClearDeliveryEndHour() *EmailSequenceUpdate {
+	_u.mutation.ClearDeliveryEndHour()
+	return _u
+}
+
 // SetCreatedByUserID sets the "created_by_user_id" field.
 func (_u *EmailSequenceUpdate) SetCreatedByUserID(v int) *EmailSequenceUpdate {
 	_u.mutation.SetCreatedByUserID(v)
@@ -260,6 +335,16 @@ func (_u *EmailSequenceUpdate) check() error {
 			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "EmailSequence.trigger": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.DeliveryStartHour(); ok {
+		if err := emailsequence.DeliveryStartHourValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_start_hour", err: fmt.Errorf(`ent: validator failed for field "EmailSequence.delivery_start_hour": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeliveryEndHour(); ok {
+		if err := emailsequence.DeliveryEndHourValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_end_hour", err: fmt.Errorf(`ent: validator failed for field "EmailSequence.delivery_end_hour": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.CreatedByUserID(); ok {
 		if err := emailsequence.CreatedByUserIDValidator(v); err != nil {
 			return &ValidationError{Name: "created_by_user_id", err: fmt.Errorf(`ent: validator failed for field "EmailSequence.created_by_user_id": %w`, err)}
@@ -271,6 +356,12 @@ func (_u *EmailSequenceUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *EmailSequenceUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EmailSequenceUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *EmailSequenceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -298,6 +389,30 @@ func (_u *EmailSequenceUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if value, ok := _u.mutation.Trigger(); ok {
 		_spec.SetField(emailsequence.FieldTrigger, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.DeliveryTimezone(); ok {
+		_spec.SetField(emailsequence.FieldDeliveryTimezone, field.TypeString, value)
+	}
+	if _u.mutation.DeliveryTimezoneCleared() {
+		_spec.ClearField(emailsequence.FieldDeliveryTimezone, field.TypeString)
+	}
+	if value, ok := _u.mutation.DeliveryStartHour(); ok {
+		_spec.SetField(emailsequence.FieldDeliveryStartHour, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDeliveryStartHour(); ok {
+		_spec.AddField(emailsequence.FieldDeliveryStartHour, field.TypeInt, value)
+	}
+	if _u.mutation.DeliveryStartHourCleared() {
+		_spec.ClearField(emailsequence.FieldDeliveryStartHour, field.TypeInt)
+	}
+	if value, ok := _u.mutation.DeliveryEndHour(); ok {
+		_spec.SetField(emailsequence.FieldDeliveryEndHour, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDeliveryEndHour(); ok {
+		_spec.AddField(emailsequence.FieldDeliveryEndHour, field.TypeInt, value)
+	}
+	if _u.mutation.DeliveryEndHourCleared() {
+		_spec.ClearField(emailsequence.FieldDeliveryEndHour, field.TypeInt)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(emailsequence.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -420,6 +535,7 @@ func (_u *EmailSequenceUpdate) sqlSave(ctx context.Context) (_node int, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{emailsequence.Label}
@@ -435,9 +551,10 @@ func (_u *EmailSequenceUpdate) sqlSave(ctx context.Context) (_node int, err erro
 // EmailSequenceUpdateOne is the builder for updating a single EmailSequence entity.
 type EmailSequenceUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *EmailSequenceMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *EmailSequenceMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetName sets the "name" field.
@@ -502,6 +619,80 @@ func (_u *EmailSequenceUpdateOne) SetNillableTrigger(v *emailsequence.Trigger) *
 	return _u
 }
 
+// SetDeliveryTimezone sets the "delivery_timezone" field.
+func (_u *EmailSequenceUpdateOne) SetDeliveryTimezone(v string) *EmailSequenceUpdateOne {
+	_u.mutation.SetDeliveryTimezone(v)
+	return _u
+}
+
+// SetNillableDeliveryTimezone sets the "delivery_timezone" field if the given value is not nil.
+func (_u *EmailSequenceUpdateOne) SetNillableDeliveryTimezone(v *string) *EmailSequenceUpdateOne {
+	if v != nil {
+		_u.SetDeliveryTimezone(*v)
+	}
+	return _u
+}
+
+// ClearDeliveryTimezone clears the value of the "delivery_timezone" field.
+func (_u *EmailSequenceUpdateOne) ClearDeliveryTimezone() *EmailSequenceUpdateOne {
+	_u.mutation.ClearDeliveryTimezone()
+	return _u
+}
+
+// SetDeliveryStartHour sets the "delivery_start_hour" field.
+func (_u *EmailSequenceUpdateOne) SetDeliveryStartHour(v int) *EmailSequenceUpdateOne {
+	_u.mutation.ResetDeliveryStartHour()
+	_u.mutation.SetDeliveryStartHour(v)
+	return _u
+}
+
+// SetNillableDeliveryStartHour sets the "delivery_start_hour" field if the given value is not nil.
+func (_u *EmailSequenceUpdateOne) SetNillableDeliveryStartHour(v *int) *EmailSequenceUpdateOne {
+	if v != nil {
+		_u.SetDeliveryStartHour(*v)
+	}
+	return _u
+}
+
+// AddDeliveryStartHour adds value to the "delivery_start_hour" field.
+func (_u *EmailSequenceUpdateOne) AddDeliveryStartHour(v int) *EmailSequenceUpdateOne {
+	_u.mutation.AddDeliveryStartHour(v)
+	return _u
+}
+
+// ClearDeliveryStartHour clears the value of the "delivery_start_hour" field.
+func (_u *EmailSequenceUpdateOne) ClearDeliveryStartHour() *EmailSequenceUpdateOne {
+	_u.mutation.ClearDeliveryStartHour()
+	return _u
+}
+
+// SetDeliveryEndHour sets the "delivery_end_hour" field.
+func (_u *EmailSequenceUpdateOne) SetDeliveryEndHour(v int) *EmailSequenceUpdateOne {
+	_u.mutation.ResetDeliveryEndHour()
+	_u.mutation.SetDeliveryEndHour(v)
+	return _u
+}
+
+// SetNillableDeliveryEndHour sets the "delivery_end_hour" field if the given value is not nil.
+func (_u *EmailSequenceUpdateOne) SetNillableDeliveryEndHour(v *int) *EmailSequenceUpdateOne {
+	if v != nil {
+		_u.SetDeliveryEndHour(*v)
+	}
+	return _u
+}
+
+// AddDeliveryEndHour adds value to the "delivery_end_hour" field.
+func (_u *EmailSequenceUpdateOne) AddDeliveryEndHour(v int) *EmailSequenceUpdateOne {
+	_u.mutation.AddDeliveryEndHour(v)
+	return _u
+}
+
+// ClearDeliveryEndHour clears the value of the "delivery_end_hour" field.
+func (_u *EmailSequenceUpdateOne) ClearDeliveryEndHour() *EmailSequenceUpdateOne {
+	_u.mutation.ClearDeliveryEndHour()
+	return _u
+}
+
 // SetCreatedByUserID sets the "created_by_user_id" field.
 func (_u *EmailSequenceUpdateOne) SetCreatedByUserID(v int) *EmailSequenceUpdateOne {
 	_u.mutation.SetCreatedByUserID(v)
@@ -682,6 +873,16 @@ func (_u *EmailSequenceUpdateOne) check() error {
 			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "EmailSequence.trigger": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.DeliveryStartHour(); ok {
+		if err := emailsequence.DeliveryStartHourValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_start_hour", err: fmt.Errorf(`ent: validator failed for field "EmailSequence.delivery_start_hour": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeliveryEndHour(); ok {
+		if err := emailsequence.DeliveryEndHourValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_end_hour", err: fmt.Errorf(`ent: validator failed for field "EmailSequence.delivery_end_hour": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.CreatedByUserID(); ok {
 		if err := emailsequence.CreatedByUserIDValidator(v); err != nil {
 			return &ValidationError{Name: "created_by_user_id", err: fmt.Errorf(`ent: validator failed for field "EmailSequence.created_by_user_id": %w`, err)}
@@ -693,6 +894,12 @@ func (_u *EmailSequenceUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *EmailSequenceUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EmailSequenceUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *EmailSequenceUpdateOne) sqlSave(ctx context.Context) (_node *EmailSequence, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -737,6 +944,30 @@ func (_u *EmailSequenceUpdateOne) sqlSave(ctx context.Context) (_node *EmailSequ
 	if value, ok := _u.mutation.Trigger(); ok {
 		_spec.SetField(emailsequence.FieldTrigger, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.DeliveryTimezone(); ok {
+		_spec.SetField(emailsequence.FieldDeliveryTimezone, field.TypeString, value)
+	}
+	if _u.mutation.DeliveryTimezoneCleared() {
+		_spec.ClearField(emailsequence.FieldDeliveryTimezone, field.TypeString)
+	}
+	if value, ok := _u.mutation.DeliveryStartHour(); ok {
+		_spec.SetField(emailsequence.FieldDeliveryStartHour, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDeliveryStartHour(); ok {
+		_spec.AddField(emailsequence.FieldDeliveryStartHour, field.TypeInt, value)
+	}
+	if _u.mutation.DeliveryStartHourCleared() {
+		_spec.ClearField(emailsequence.FieldDeliveryStartHour, field.TypeInt)
+	}
+	if value, ok := _u.mutation.DeliveryEndHour(); ok {
+		_spec.SetField(emailsequence.FieldDeliveryEndHour, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDeliveryEndHour(); ok {
+		_spec.AddField(emailsequence.FieldDeliveryEndHour, field.TypeInt, value)
+	}
+	if _u.mutation.DeliveryEndHourCleared() {
+		_spec.ClearField(emailsequence.FieldDeliveryEndHour, field.TypeInt)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(emailsequence.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -859,6 +1090,7 @@ func (_u *EmailSequenceUpdateOne) sqlSave(ctx context.Context) (_node *EmailSequ
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &EmailSequence{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
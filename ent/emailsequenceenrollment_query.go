@@ -31,6 +31,7 @@ type EmailSequenceEnrollmentQuery struct {
 	withLead       *LeadQuery
 	withEnrolledBy *UserQuery
 	withSends      *EmailSequenceSendQuery
+	modifiers      []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -352,8 +353,9 @@ func (_q *EmailSequenceEnrollmentQuery) Clone() *EmailSequenceEnrollmentQuery {
 		withEnrolledBy: _q.withEnrolledBy.Clone(),
 		withSends:      _q.withSends.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -495,6 +497,9 @@ func (_q *EmailSequenceEnrollmentQuery) sqlAll(ctx context.Context, hooks ...que
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -652,6 +657,9 @@ func (_q *EmailSequenceEnrollmentQuery) loadSends(ctx context.Context, query *Em
 
 func (_q *EmailSequenceEnrollmentQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -723,6 +731,9 @@ func (_q *EmailSequenceEnrollmentQuery) sqlQuery(ctx context.Context) *sql.Selec
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -740,6 +751,12 @@ func (_q *EmailSequenceEnrollmentQuery) sqlQuery(ctx context.Context) *sql.Selec
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *EmailSequenceEnrollmentQuery) Modify(modifiers ...func(s *sql.Selector)) *EmailSequenceEnrollmentSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // EmailSequenceEnrollmentGroupBy is the group-by builder for EmailSequenceEnrollment entities.
 type EmailSequenceEnrollmentGroupBy struct {
 	selector
@@ -829,3 +846,9 @@ func (_s *EmailSequenceEnrollmentSelect) sqlScan(ctx context.Context, root *Emai
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *EmailSequenceEnrollmentSelect) Modify(modifiers ...func(s *sql.Selector)) *EmailSequenceEnrollmentSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
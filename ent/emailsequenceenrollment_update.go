@@ -22,8 +22,9 @@ import (
 // EmailSequenceEnrollmentUpdate is the builder for updating EmailSequenceEnrollment entities.
 type EmailSequenceEnrollmentUpdate struct {
 	config
-	hooks    []Hook
-	mutation *EmailSequenceEnrollmentMutation
+	hooks     []Hook
+	mutation  *EmailSequenceEnrollmentMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the EmailSequenceEnrollmentUpdate builder.
@@ -290,6 +291,12 @@ func (_u *EmailSequenceEnrollmentUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *EmailSequenceEnrollmentUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EmailSequenceEnrollmentUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *EmailSequenceEnrollmentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -452,6 +459,7 @@ func (_u *EmailSequenceEnrollmentUpdate) sqlSave(ctx context.Context) (_node int
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{emailsequenceenrollment.Label}
@@ -467,9 +475,10 @@ func (_u *EmailSequenceEnrollmentUpdate) sqlSave(ctx context.Context) (_node int
 // EmailSequenceEnrollmentUpdateOne is the builder for updating a single EmailSequenceEnrollment entity.
 type EmailSequenceEnrollmentUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *EmailSequenceEnrollmentMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *EmailSequenceEnrollmentMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetSequenceID sets the "sequence_id" field.
@@ -743,6 +752,12 @@ func (_u *EmailSequenceEnrollmentUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *EmailSequenceEnrollmentUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EmailSequenceEnrollmentUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *EmailSequenceEnrollmentUpdateOne) sqlSave(ctx context.Context) (_node *EmailSequenceEnrollment, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -922,6 +937,7 @@ func (_u *EmailSequenceEnrollmentUpdateOne) sqlSave(ctx context.Context) (_node
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &EmailSequenceEnrollment{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
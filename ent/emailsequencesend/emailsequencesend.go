@@ -128,6 +128,7 @@ const (
 	StatusClicked   Status = "clicked"
 	StatusBounced   Status = "bounced"
 	StatusFailed    Status = "failed"
+	StatusSkipped   Status = "skipped"
 )
 
 func (s Status) String() string {
@@ -137,7 +138,7 @@ func (s Status) String() string {
 // StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
 func StatusValidator(s Status) error {
 	switch s {
-	case StatusScheduled, StatusSent, StatusOpened, StatusClicked, StatusBounced, StatusFailed:
+	case StatusScheduled, StatusSent, StatusOpened, StatusClicked, StatusBounced, StatusFailed, StatusSkipped:
 		return nil
 	default:
 		return fmt.Errorf("emailsequencesend: invalid enum value for status field: %q", s)
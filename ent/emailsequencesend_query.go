@@ -28,6 +28,7 @@ type EmailSequenceSendQuery struct {
 	withEnrollment *EmailSequenceEnrollmentQuery
 	withStep       *EmailSequenceStepQuery
 	withLead       *LeadQuery
+	modifiers      []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -326,8 +327,9 @@ func (_q *EmailSequenceSendQuery) Clone() *EmailSequenceSendQuery {
 		withStep:       _q.withStep.Clone(),
 		withLead:       _q.withLead.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -457,6 +459,9 @@ func (_q *EmailSequenceSendQuery) sqlAll(ctx context.Context, hooks ...queryHook
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -577,6 +582,9 @@ func (_q *EmailSequenceSendQuery) loadLead(ctx context.Context, query *LeadQuery
 
 func (_q *EmailSequenceSendQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -648,6 +656,9 @@ func (_q *EmailSequenceSendQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -665,6 +676,12 @@ func (_q *EmailSequenceSendQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *EmailSequenceSendQuery) Modify(modifiers ...func(s *sql.Selector)) *EmailSequenceSendSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // EmailSequenceSendGroupBy is the group-by builder for EmailSequenceSend entities.
 type EmailSequenceSendGroupBy struct {
 	selector
@@ -754,3 +771,9 @@ func (_s *EmailSequenceSendSelect) sqlScan(ctx context.Context, root *EmailSeque
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *EmailSequenceSendSelect) Modify(modifiers ...func(s *sql.Selector)) *EmailSequenceSendSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
@@ -21,8 +21,9 @@ import (
 // EmailSequenceSendUpdate is the builder for updating EmailSequenceSend entities.
 type EmailSequenceSendUpdate struct {
 	config
-	hooks    []Hook
-	mutation *EmailSequenceSendMutation
+	hooks     []Hook
+	mutation  *EmailSequenceSendMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the EmailSequenceSendUpdate builder.
@@ -309,6 +310,12 @@ func (_u *EmailSequenceSendUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *EmailSequenceSendUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EmailSequenceSendUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *EmailSequenceSendUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -444,6 +451,7 @@ func (_u *EmailSequenceSendUpdate) sqlSave(ctx context.Context) (_node int, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{emailsequencesend.Label}
@@ -459,9 +467,10 @@ func (_u *EmailSequenceSendUpdate) sqlSave(ctx context.Context) (_node int, err
 // EmailSequenceSendUpdateOne is the builder for updating a single EmailSequenceSend entity.
 type EmailSequenceSendUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *EmailSequenceSendMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *EmailSequenceSendMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetEnrollmentID sets the "enrollment_id" field.
@@ -755,6 +764,12 @@ func (_u *EmailSequenceSendUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *EmailSequenceSendUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EmailSequenceSendUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *EmailSequenceSendUpdateOne) sqlSave(ctx context.Context) (_node *EmailSequenceSend, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -907,6 +922,7 @@ func (_u *EmailSequenceSendUpdateOne) sqlSave(ctx context.Context) (_node *Email
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &EmailSequenceSend{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -28,6 +28,14 @@ type EmailSequenceStep struct {
 	Subject string `json:"subject,omitempty"`
 	// Email body (supports variables: {{lead_name}}, {{user_name}}, etc.)
 	Body string `json:"body,omitempty"`
+	// Condition checked when the step is due, the step is skipped when it does not hold (null = always sent)
+	ConditionType *emailsequencestep.ConditionType `json:"condition_type,omitempty"`
+	// Earlier step whose email opens or clicks are checked
+	ConditionStepOrder *int `json:"condition_step_order,omitempty"`
+	// Lead field compared by field conditions
+	ConditionField string `json:"condition_field,omitempty"`
+	// Value compared by field conditions
+	ConditionValue string `json:"condition_value,omitempty"`
 	// Creation timestamp
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -72,9 +80,9 @@ func (*EmailSequenceStep) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case emailsequencestep.FieldID, emailsequencestep.FieldSequenceID, emailsequencestep.FieldStepOrder, emailsequencestep.FieldDelayDays:
+		case emailsequencestep.FieldID, emailsequencestep.FieldSequenceID, emailsequencestep.FieldStepOrder, emailsequencestep.FieldDelayDays, emailsequencestep.FieldConditionStepOrder:
 			values[i] = new(sql.NullInt64)
-		case emailsequencestep.FieldSubject, emailsequencestep.FieldBody:
+		case emailsequencestep.FieldSubject, emailsequencestep.FieldBody, emailsequencestep.FieldConditionType, emailsequencestep.FieldConditionField, emailsequencestep.FieldConditionValue:
 			values[i] = new(sql.NullString)
 		case emailsequencestep.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -129,6 +137,32 @@ func (_m *EmailSequenceStep) assignValues(columns []string, values []any) error
 			} else if value.Valid {
 				_m.Body = value.String
 			}
+		case emailsequencestep.FieldConditionType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field condition_type", values[i])
+			} else if value.Valid {
+				_m.ConditionType = new(emailsequencestep.ConditionType)
+				*_m.ConditionType = emailsequencestep.ConditionType(value.String)
+			}
+		case emailsequencestep.FieldConditionStepOrder:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field condition_step_order", values[i])
+			} else if value.Valid {
+				_m.ConditionStepOrder = new(int)
+				*_m.ConditionStepOrder = int(value.Int64)
+			}
+		case emailsequencestep.FieldConditionField:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field condition_field", values[i])
+			} else if value.Valid {
+				_m.ConditionField = value.String
+			}
+		case emailsequencestep.FieldConditionValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field condition_value", values[i])
+			} else if value.Valid {
+				_m.ConditionValue = value.String
+			}
 		case emailsequencestep.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -196,6 +230,22 @@ func (_m *EmailSequenceStep) String() string {
 	builder.WriteString("body=")
 	builder.WriteString(_m.Body)
 	builder.WriteString(", ")
+	if v := _m.ConditionType; v != nil {
+		builder.WriteString("condition_type=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ConditionStepOrder; v != nil {
+		builder.WriteString("condition_step_order=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("condition_field=")
+	builder.WriteString(_m.ConditionField)
+	builder.WriteString(", ")
+	builder.WriteString("condition_value=")
+	builder.WriteString(_m.ConditionValue)
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
@@ -3,6 +3,7 @@
 package emailsequencestep
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -24,6 +25,14 @@ const (
 	FieldSubject = "subject"
 	// FieldBody holds the string denoting the body field in the database.
 	FieldBody = "body"
+	// FieldConditionType holds the string denoting the condition_type field in the database.
+	FieldConditionType = "condition_type"
+	// FieldConditionStepOrder holds the string denoting the condition_step_order field in the database.
+	FieldConditionStepOrder = "condition_step_order"
+	// FieldConditionField holds the string denoting the condition_field field in the database.
+	FieldConditionField = "condition_field"
+	// FieldConditionValue holds the string denoting the condition_value field in the database.
+	FieldConditionValue = "condition_value"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// EdgeSequence holds the string denoting the sequence edge name in mutations.
@@ -56,6 +65,10 @@ var Columns = []string{
 	FieldDelayDays,
 	FieldSubject,
 	FieldBody,
+	FieldConditionType,
+	FieldConditionStepOrder,
+	FieldConditionField,
+	FieldConditionValue,
 	FieldCreatedAt,
 }
 
@@ -86,6 +99,33 @@ var (
 	DefaultCreatedAt func() time.Time
 )
 
+// ConditionType defines the type for the "condition_type" enum field.
+type ConditionType string
+
+// ConditionType values.
+const (
+	ConditionTypeOpened         ConditionType = "opened"
+	ConditionTypeNotOpened      ConditionType = "not_opened"
+	ConditionTypeClicked        ConditionType = "clicked"
+	ConditionTypeNotClicked     ConditionType = "not_clicked"
+	ConditionTypeFieldEquals    ConditionType = "field_equals"
+	ConditionTypeFieldNotEquals ConditionType = "field_not_equals"
+)
+
+func (ct ConditionType) String() string {
+	return string(ct)
+}
+
+// ConditionTypeValidator is a validator for the "condition_type" field enum values. It is called by the builders before save.
+func ConditionTypeValidator(ct ConditionType) error {
+	switch ct {
+	case ConditionTypeOpened, ConditionTypeNotOpened, ConditionTypeClicked, ConditionTypeNotClicked, ConditionTypeFieldEquals, ConditionTypeFieldNotEquals:
+		return nil
+	default:
+		return fmt.Errorf("emailsequencestep: invalid enum value for condition_type field: %q", ct)
+	}
+}
+
 // OrderOption defines the ordering options for the EmailSequenceStep queries.
 type OrderOption func(*sql.Selector)
 
@@ -119,6 +159,26 @@ func ByBody(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldBody, opts...).ToFunc()
 }
 
+// ByConditionType orders the results by the condition_type field.
+func ByConditionType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConditionType, opts...).ToFunc()
+}
+
+// ByConditionStepOrder orders the results by the condition_step_order field.
+func ByConditionStepOrder(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConditionStepOrder, opts...).ToFunc()
+}
+
+// ByConditionField orders the results by the condition_field field.
+func ByConditionField(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConditionField, opts...).ToFunc()
+}
+
+// ByConditionValue orders the results by the condition_value field.
+func ByConditionValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConditionValue, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
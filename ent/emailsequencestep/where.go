@@ -80,6 +80,21 @@ func Body(v string) predicate.EmailSequenceStep {
 	return predicate.EmailSequenceStep(sql.FieldEQ(FieldBody, v))
 }
 
+// ConditionStepOrder applies equality check predicate on the "condition_step_order" field. It's identical to ConditionStepOrderEQ.
+func ConditionStepOrder(v int) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldEQ(FieldConditionStepOrder, v))
+}
+
+// ConditionField applies equality check predicate on the "condition_field" field. It's identical to ConditionFieldEQ.
+func ConditionField(v string) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldEQ(FieldConditionField, v))
+}
+
+// ConditionValue applies equality check predicate on the "condition_value" field. It's identical to ConditionValueEQ.
+func ConditionValue(v string) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldEQ(FieldConditionValue, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.EmailSequenceStep {
 	return predicate.EmailSequenceStep(sql.FieldEQ(FieldCreatedAt, v))
@@ -315,6 +330,236 @@ func BodyContainsFold(v string) predicate.EmailSequenceStep {
 	return predicate.EmailSequenceStep(sql.FieldContainsFold(FieldBody, v))
 }
 
+// ConditionTypeEQ applies the EQ predicate on the "condition_type" field.
+func ConditionTypeEQ(v ConditionType) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldEQ(FieldConditionType, v))
+}
+
+// ConditionTypeNEQ applies the NEQ predicate on the "condition_type" field.
+func ConditionTypeNEQ(v ConditionType) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldNEQ(FieldConditionType, v))
+}
+
+// ConditionTypeIn applies the In predicate on the "condition_type" field.
+func ConditionTypeIn(vs ...ConditionType) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldIn(FieldConditionType, vs...))
+}
+
+// ConditionTypeNotIn applies the NotIn predicate on the "condition_type" field.
+func ConditionTypeNotIn(vs ...ConditionType) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldNotIn(FieldConditionType, vs...))
+}
+
+// ConditionTypeIsNil applies the IsNil predicate on the "condition_type" field.
+func ConditionTypeIsNil() predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldIsNull(FieldConditionType))
+}
+
+// ConditionTypeNotNil applies the NotNil predicate on the "condition_type" field.
+func ConditionTypeNotNil() predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldNotNull(FieldConditionType))
+}
+
+// ConditionStepOrderEQ applies the EQ predicate on the "condition_step_order" field.
+func ConditionStepOrderEQ(v int) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldEQ(FieldConditionStepOrder, v))
+}
+
+// ConditionStepOrderNEQ applies the NEQ predicate on the "condition_step_order" field.
+func ConditionStepOrderNEQ(v int) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldNEQ(FieldConditionStepOrder, v))
+}
+
+// ConditionStepOrderIn applies the In predicate on the "condition_step_order" field.
+func ConditionStepOrderIn(vs ...int) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldIn(FieldConditionStepOrder, vs...))
+}
+
+// ConditionStepOrderNotIn applies the NotIn predicate on the "condition_step_order" field.
+func ConditionStepOrderNotIn(vs ...int) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldNotIn(FieldConditionStepOrder, vs...))
+}
+
+// ConditionStepOrderGT applies the GT predicate on the "condition_step_order" field.
+func ConditionStepOrderGT(v int) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldGT(FieldConditionStepOrder, v))
+}
+
+// ConditionStepOrderGTE applies the GTE predicate on the "condition_step_order" field.
+func ConditionStepOrderGTE(v int) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldGTE(FieldConditionStepOrder, v))
+}
+
+// ConditionStepOrderLT applies the LT predicate on the "condition_step_order" field.
+func ConditionStepOrderLT(v int) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldLT(FieldConditionStepOrder, v))
+}
+
+// ConditionStepOrderLTE applies the LTE predicate on the "condition_step_order" field.
+func ConditionStepOrderLTE(v int) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldLTE(FieldConditionStepOrder, v))
+}
+
+// ConditionStepOrderIsNil applies the IsNil predicate on the "condition_step_order" field.
+func ConditionStepOrderIsNil() predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldIsNull(FieldConditionStepOrder))
+}
+
+// ConditionStepOrderNotNil applies the NotNil predicate on the "condition_step_order" field.
+func ConditionStepOrderNotNil() predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldNotNull(FieldConditionStepOrder))
+}
+
+// ConditionFieldEQ applies the EQ predicate on the "condition_field" field.
+func ConditionFieldEQ(v string) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldEQ(FieldConditionField, v))
+}
+
+// ConditionFieldNEQ applies the NEQ predicate on the "condition_field" field.
+func ConditionFieldNEQ(v string) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldNEQ(FieldConditionField, v))
+}
+
+// ConditionFieldIn applies the In predicate on the "condition_field" field.
+func ConditionFieldIn(vs ...string) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldIn(FieldConditionField, vs...))
+}
+
+// ConditionFieldNotIn applies the NotIn predicate on the "condition_field" field.
+func ConditionFieldNotIn(vs ...string) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldNotIn(FieldConditionField, vs...))
+}
+
+// ConditionFieldGT applies the GT predicate on the "condition_field" field.
+func ConditionFieldGT(v string) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldGT(FieldConditionField, v))
+}
+
+// ConditionFieldGTE applies the GTE predicate on the "condition_field" field.
+func ConditionFieldGTE(v string) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldGTE(FieldConditionField, v))
+}
+
+// ConditionFieldLT applies the LT predicate on the "condition_field" field.
+func ConditionFieldLT(v string) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldLT(FieldConditionField, v))
+}
+
+// ConditionFieldLTE applies the LTE predicate on the "condition_field" field.
+func ConditionFieldLTE(v string) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldLTE(FieldConditionField, v))
+}
+
+// ConditionFieldContains applies the Contains predicate on the "condition_field" field.
+func ConditionFieldContains(v string) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldContains(FieldConditionField, v))
+}
+
+// ConditionFieldHasPrefix applies the HasPrefix predicate on the "condition_field" field.
+func ConditionFieldHasPrefix(v string) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldHasPrefix(FieldConditionField, v))
+}
+
+// ConditionFieldHasSuffix applies the HasSuffix predicate on the "condition_field" field.
+func ConditionFieldHasSuffix(v string) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldHasSuffix(FieldConditionField, v))
+}
+
+// ConditionFieldIsNil applies the IsNil predicate on the "condition_field" field.
+func ConditionFieldIsNil() predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldIsNull(FieldConditionField))
+}
+
+// ConditionFieldNotNil applies the NotNil predicate on the "condition_field" field.
+func ConditionFieldNotNil() predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldNotNull(FieldConditionField))
+}
+
+// ConditionFieldEqualFold applies the EqualFold predicate on the "condition_field" field.
+func ConditionFieldEqualFold(v string) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldEqualFold(FieldConditionField, v))
+}
+
+// ConditionFieldContainsFold applies the ContainsFold predicate on the "condition_field" field.
+func ConditionFieldContainsFold(v string) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldContainsFold(FieldConditionField, v))
+}
+
+// ConditionValueEQ applies the EQ predicate on the "condition_value" field.
+func ConditionValueEQ(v string) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldEQ(FieldConditionValue, v))
+}
+
+// ConditionValueNEQ applies the NEQ predicate on the "condition_value" field.
+func ConditionValueNEQ(v string) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldNEQ(FieldConditionValue, v))
+}
+
+// ConditionValueIn applies the In predicate on the "condition_value" field.
+func ConditionValueIn(vs ...string) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldIn(FieldConditionValue, vs...))
+}
+
+// ConditionValueNotIn applies the NotIn predicate on the "condition_value" field.
+func ConditionValueNotIn(vs ...string) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldNotIn(FieldConditionValue, vs...))
+}
+
+// ConditionValueGT applies the GT predicate on the "condition_value" field.
+func ConditionValueGT(v string) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldGT(FieldConditionValue, v))
+}
+
+// ConditionValueGTE applies the GTE predicate on the "condition_value" field.
+func ConditionValueGTE(v string) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldGTE(FieldConditionValue, v))
+}
+
+// ConditionValueLT applies the LT predicate on the "condition_value" field.
+func ConditionValueLT(v string) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldLT(FieldConditionValue, v))
+}
+
+// ConditionValueLTE applies the LTE predicate on the "condition_value" field.
+func ConditionValueLTE(v string) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldLTE(FieldConditionValue, v))
+}
+
+// ConditionValueContains applies the Contains predicate on the "condition_value" field.
+func ConditionValueContains(v string) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldContains(FieldConditionValue, v))
+}
+
+// ConditionValueHasPrefix applies the HasPrefix predicate on the "condition_value" field.
+func ConditionValueHasPrefix(v string) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldHasPrefix(FieldConditionValue, v))
+}
+
+// ConditionValueHasSuffix applies the HasSuffix predicate on the "condition_value" field.
+func ConditionValueHasSuffix(v string) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldHasSuffix(FieldConditionValue, v))
+}
+
+// ConditionValueIsNil applies the IsNil predicate on the "condition_value" field.
+func ConditionValueIsNil() predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldIsNull(FieldConditionValue))
+}
+
+// ConditionValueNotNil applies the NotNil predicate on the "condition_value" field.
+func ConditionValueNotNil() predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldNotNull(FieldConditionValue))
+}
+
+// ConditionValueEqualFold applies the EqualFold predicate on the "condition_value" field.
+func ConditionValueEqualFold(v string) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldEqualFold(FieldConditionValue, v))
+}
+
+// ConditionValueContainsFold applies the ContainsFold predicate on the "condition_value" field.
+func ConditionValueContainsFold(v string) predicate.EmailSequenceStep {
+	return predicate.EmailSequenceStep(sql.FieldContainsFold(FieldConditionValue, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.EmailSequenceStep {
 	return predicate.EmailSequenceStep(sql.FieldEQ(FieldCreatedAt, v))
@@ -60,6 +60,62 @@ func (_c *EmailSequenceStepCreate) SetBody(v string) *EmailSequenceStepCreate {
 	return _c
 }
 
+// SetConditionType sets the "condition_type" field.
+func (_c *EmailSequenceStepCreate) SetConditionType(v emailsequencestep.ConditionType) *EmailSequenceStepCreate {
+	_c.mutation.SetConditionType(v)
+	return _c
+}
+
+// SetNillableConditionType sets the "condition_type" field if the given value is not nil.
+func (_c *EmailSequenceStepCreate) SetNillableConditionType(v *emailsequencestep.ConditionType) *EmailSequenceStepCreate {
+	if v != nil {
+		_c.SetConditionType(*v)
+	}
+	return _c
+}
+
+// SetConditionStepOrder sets the "condition_step_order" field.
+func (_c *EmailSequenceStepCreate) SetConditionStepOrder(v int) *EmailSequenceStepCreate {
+	_c.mutation.SetConditionStepOrder(v)
+	return _c
+}
+
+// SetNillableConditionStepOrder sets the "condition_step_order" field if the given value is not nil.
+func (_c *EmailSequenceStepCreate) SetNillableConditionStepOrder(v *int) *EmailSequenceStepCreate {
+	if v != nil {
+		_c.SetConditionStepOrder(*v)
+	}
+	return _c
+}
+
+// SetConditionField sets the "condition_field" field.
+func (_c *EmailSequenceStepCreate) SetConditionField(v string) *EmailSequenceStepCreate {
+	_c.mutation.SetConditionField(v)
+	return _c
+}
+
+// SetNillableConditionField sets the "condition_field" field if the given value is not nil.
+func (_c *EmailSequenceStepCreate) SetNillableConditionField(v *string) *EmailSequenceStepCreate {
+	if v != nil {
+		_c.SetConditionField(*v)
+	}
+	return _c
+}
+
+// SetConditionValue sets the "condition_value" field.
+func (_c *EmailSequenceStepCreate) SetConditionValue(v string) *EmailSequenceStepCreate {
+	_c.mutation.SetConditionValue(v)
+	return _c
+}
+
+// SetNillableConditionValue sets the "condition_value" field if the given value is not nil.
+func (_c *EmailSequenceStepCreate) SetNillableConditionValue(v *string) *EmailSequenceStepCreate {
+	if v != nil {
+		_c.SetConditionValue(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *EmailSequenceStepCreate) SetCreatedAt(v time.Time) *EmailSequenceStepCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -181,6 +237,11 @@ func (_c *EmailSequenceStepCreate) check() error {
 			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "EmailSequenceStep.body": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.ConditionType(); ok {
+		if err := emailsequencestep.ConditionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "condition_type", err: fmt.Errorf(`ent: validator failed for field "EmailSequenceStep.condition_type": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EmailSequenceStep.created_at"`)}
 	}
@@ -229,6 +290,22 @@ func (_c *EmailSequenceStepCreate) createSpec() (*EmailSequenceStep, *sqlgraph.C
 		_spec.SetField(emailsequencestep.FieldBody, field.TypeString, value)
 		_node.Body = value
 	}
+	if value, ok := _c.mutation.ConditionType(); ok {
+		_spec.SetField(emailsequencestep.FieldConditionType, field.TypeEnum, value)
+		_node.ConditionType = &value
+	}
+	if value, ok := _c.mutation.ConditionStepOrder(); ok {
+		_spec.SetField(emailsequencestep.FieldConditionStepOrder, field.TypeInt, value)
+		_node.ConditionStepOrder = &value
+	}
+	if value, ok := _c.mutation.ConditionField(); ok {
+		_spec.SetField(emailsequencestep.FieldConditionField, field.TypeString, value)
+		_node.ConditionField = value
+	}
+	if value, ok := _c.mutation.ConditionValue(); ok {
+		_spec.SetField(emailsequencestep.FieldConditionValue, field.TypeString, value)
+		_node.ConditionValue = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(emailsequencestep.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -27,6 +27,7 @@ type EmailSequenceStepQuery struct {
 	predicates   []predicate.EmailSequenceStep
 	withSequence *EmailSequenceQuery
 	withSends    *EmailSequenceSendQuery
+	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -302,8 +303,9 @@ func (_q *EmailSequenceStepQuery) Clone() *EmailSequenceStepQuery {
 		withSequence: _q.withSequence.Clone(),
 		withSends:    _q.withSends.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -421,6 +423,9 @@ func (_q *EmailSequenceStepQuery) sqlAll(ctx context.Context, hooks ...queryHook
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -508,6 +513,9 @@ func (_q *EmailSequenceStepQuery) loadSends(ctx context.Context, query *EmailSeq
 
 func (_q *EmailSequenceStepQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -573,6 +581,9 @@ func (_q *EmailSequenceStepQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -590,6 +601,12 @@ func (_q *EmailSequenceStepQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *EmailSequenceStepQuery) Modify(modifiers ...func(s *sql.Selector)) *EmailSequenceStepSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // EmailSequenceStepGroupBy is the group-by builder for EmailSequenceStep entities.
 type EmailSequenceStepGroupBy struct {
 	selector
@@ -679,3 +696,9 @@ func (_s *EmailSequenceStepSelect) sqlScan(ctx context.Context, root *EmailSeque
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *EmailSequenceStepSelect) Modify(modifiers ...func(s *sql.Selector)) *EmailSequenceStepSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
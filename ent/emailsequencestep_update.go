@@ -19,8 +19,9 @@ import (
 // EmailSequenceStepUpdate is the builder for updating EmailSequenceStep entities.
 type EmailSequenceStepUpdate struct {
 	config
-	hooks    []Hook
-	mutation *EmailSequenceStepMutation
+	hooks     []Hook
+	mutation  *EmailSequenceStepMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the EmailSequenceStepUpdate builder.
@@ -113,6 +114,93 @@ func (_u *EmailSequenceStepUpdate) SetNillableBody(v *string) *EmailSequenceStep
 	return _u
 }
 
+// SetConditionType sets the "condition_type" field.
+func (_u *EmailSequenceStepUpdate) SetConditionType(v emailsequencestep.ConditionType) *EmailSequenceStepUpdate {
+	_u.mutation.SetConditionType(v)
+	return _u
+}
+
+// SetNillableConditionType sets the "condition_type" field if the given value is not nil.
+func (_u *EmailSequenceStepUpdate) SetNillableConditionType(v *emailsequencestep.ConditionType) *EmailSequenceStepUpdate {
+	if v != nil {
+		_u.SetConditionType(*v)
+	}
+	return _u
+}
+
+// ClearConditionType clears the value of the "condition_type" field.
+func (_u *EmailSequenceStepUpdate) ClearConditionType() *EmailSequenceStepUpdate {
+	_u.mutation.ClearConditionType()
+	return _u
+}
+
+// SetConditionStepOrder sets the "condition_step_order" field.
+func (_u *EmailSequenceStepUpdate) SetConditionStepOrder(v int) *EmailSequenceStepUpdate {
+	_u.mutation.ResetConditionStepOrder()
+	_u.mutation.SetConditionStepOrder(v)
+	return _u
+}
+
+// SetNillableConditionStepOrder sets the "condition_step_order" field if the given value is not nil.
+func (_u *EmailSequenceStepUpdate) SetNillableConditionStepOrder(v *int) *EmailSequenceStepUpdate {
+	if v != nil {
+		_u.SetConditionStepOrder(*v)
+	}
+	return _u
+}
+
+// AddConditionStepOrder adds value to the "condition_step_order" field.
+func (_u *EmailSequenceStepUpdate) AddConditionStepOrder(v int) *EmailSequenceStepUpdate {
+	_u.mutation.AddConditionStepOrder(v)
+	return _u
+}
+
+// ClearConditionStepOrder clears the value of the "condition_step_order" field.
+func (_u *EmailSequenceStepUpdate) ClearConditionStepOrder() *EmailSequenceStepUpdate {
+	_u.mutation.ClearConditionStepOrder()
+	return _u
+}
+
+// SetConditionField sets the "condition_field" field.
+func (_u *EmailSequenceStepUpdate) SetConditionField(v string) *EmailSequenceStepUpdate {
+	_u.mutation.SetConditionField(v)
+	return _u
+}
+
+// SetNillableConditionField sets the "condition_field" field if the given value is not nil.
+func (_u *EmailSequenceStepUpdate) SetNillableConditionField(v *string) *EmailSequenceStepUpdate {
+	if v != nil {
+		_u.SetConditionField(*v)
+	}
+	return _u
+}
+
+// ClearConditionField clears the value of the "condition_field" field.
+func (_u *EmailSequenceStepUpdate) ClearConditionField() *EmailSequenceStepUpdate {
+	_u.mutation.ClearConditionField()
+	return _u
+}
+
+// SetConditionValue sets the "condition_value" field.
+func (_u *EmailSequenceStepUpdate) SetConditionValue(v string) *EmailSequenceStepUpdate {
+	_u.mutation.SetConditionValue(v)
+	return _u
+}
+
+// SetNillableConditionValue sets the "condition_value" field if the given value is not nil.
+func (_u *EmailSequenceStepUpdate) SetNillableConditionValue(v *string) *EmailSequenceStepUpdate {
+	if v != nil {
+		_u.SetConditionValue(*v)
+	}
+	return _u
+}
+
+// ClearConditionValue clears the value of the "condition_value" field.
+func (_u *EmailSequenceStepUpdate) ClearConditionValue() *EmailSequenceStepUpdate {
+	_u.mutation.ClearConditionValue()
+	return _u
+}
+
 // SetSequence sets the "sequence" edge to the EmailSequence entity.
 func (_u *EmailSequenceStepUpdate) SetSequence(v *EmailSequence) *EmailSequenceStepUpdate {
 	return _u.SetSequenceID(v.ID)
@@ -219,12 +307,23 @@ func (_u *EmailSequenceStepUpdate) check() error {
 			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "EmailSequenceStep.body": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.ConditionType(); ok {
+		if err := emailsequencestep.ConditionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "condition_type", err: fmt.Errorf(`ent: validator failed for field "EmailSequenceStep.condition_type": %w`, err)}
+		}
+	}
 	if _u.mutation.SequenceCleared() && len(_u.mutation.SequenceIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "EmailSequenceStep.sequence"`)
 	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *EmailSequenceStepUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EmailSequenceStepUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *EmailSequenceStepUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -255,6 +354,33 @@ func (_u *EmailSequenceStepUpdate) sqlSave(ctx context.Context) (_node int, err
 	if value, ok := _u.mutation.Body(); ok {
 		_spec.SetField(emailsequencestep.FieldBody, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.ConditionType(); ok {
+		_spec.SetField(emailsequencestep.FieldConditionType, field.TypeEnum, value)
+	}
+	if _u.mutation.ConditionTypeCleared() {
+		_spec.ClearField(emailsequencestep.FieldConditionType, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.ConditionStepOrder(); ok {
+		_spec.SetField(emailsequencestep.FieldConditionStepOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedConditionStepOrder(); ok {
+		_spec.AddField(emailsequencestep.FieldConditionStepOrder, field.TypeInt, value)
+	}
+	if _u.mutation.ConditionStepOrderCleared() {
+		_spec.ClearField(emailsequencestep.FieldConditionStepOrder, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ConditionField(); ok {
+		_spec.SetField(emailsequencestep.FieldConditionField, field.TypeString, value)
+	}
+	if _u.mutation.ConditionFieldCleared() {
+		_spec.ClearField(emailsequencestep.FieldConditionField, field.TypeString)
+	}
+	if value, ok := _u.mutation.ConditionValue(); ok {
+		_spec.SetField(emailsequencestep.FieldConditionValue, field.TypeString, value)
+	}
+	if _u.mutation.ConditionValueCleared() {
+		_spec.ClearField(emailsequencestep.FieldConditionValue, field.TypeString)
+	}
 	if _u.mutation.SequenceCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -329,6 +455,7 @@ func (_u *EmailSequenceStepUpdate) sqlSave(ctx context.Context) (_node int, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{emailsequencestep.Label}
@@ -344,9 +471,10 @@ func (_u *EmailSequenceStepUpdate) sqlSave(ctx context.Context) (_node int, err
 // EmailSequenceStepUpdateOne is the builder for updating a single EmailSequenceStep entity.
 type EmailSequenceStepUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *EmailSequenceStepMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *EmailSequenceStepMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetSequenceID sets the "sequence_id" field.
@@ -433,6 +561,93 @@ func (_u *EmailSequenceStepUpdateOne) SetNillableBody(v *string) *EmailSequenceS
 	return _u
 }
 
+// SetConditionType sets the "condition_type" field.
+func (_u *EmailSequenceStepUpdateOne) SetConditionType(v emailsequencestep.ConditionType) *EmailSequenceStepUpdateOne {
+	_u.mutation.SetConditionType(v)
+	return _u
+}
+
+// SetNillableConditionType sets the "condition_type" field if the given value is not nil.
+func (_u *EmailSequenceStepUpdateOne) SetNillableConditionType(v *emailsequencestep.ConditionType) *EmailSequenceStepUpdateOne {
+	if v != nil {
+		_u.SetConditionType(*v)
+	}
+	return _u
+}
+
+// ClearConditionType clears the value of the "condition_type" field.
+func (_u *EmailSequenceStepUpdateOne) ClearConditionType() *EmailSequenceStepUpdateOne {
+	_u.mutation.ClearConditionType()
+	return _u
+}
+
+// SetConditionStepOrder sets the "condition_step_order" field.
+func (_u *EmailSequenceStepUpdateOne) SetConditionStepOrder(v int) *EmailSequenceStepUpdateOne {
+	_u.mutation.ResetConditionStepOrder()
+	_u.mutation.SetConditionStepOrder(v)
+	return _u
+}
+
+// SetNillableConditionStepOrder sets the "condition_step_order" field if the given value is not nil.
+func (_u *EmailSequenceStepUpdateOne) SetNillableConditionStepOrder(v *int) *EmailSequenceStepUpdateOne {
+	if v != nil {
+		_u.SetConditionStepOrder(*v)
+	}
+	return _u
+}
+
+// AddConditionStepOrder adds value to the "condition_step_order" field.
+func (_u *EmailSequenceStepUpdateOne) AddConditionStepOrder(v int) *EmailSequenceStepUpdateOne {
+	_u.mutation.AddConditionStepOrder(v)
+	return _u
+}
+
+// ClearConditionStepOrder clears the value of the "condition_step_order" field.
+func (_u *EmailSequenceStepUpdateOne) ClearConditionStepOrder() *EmailSequenceStepUpdateOne {
+	_u.mutation.ClearConditionStepOrder()
+	return _u
+}
+
+// SetConditionField sets the "condition_field" field.
+func (_u *EmailSequenceStepUpdateOne) SetConditionField(v string) *EmailSequenceStepUpdateOne {
+	_u.mutation.SetConditionField(v)
+	return _u
+}
+
+// SetNillableConditionField sets the "condition_field" field if the given value is not nil.
+func (_u *EmailSequenceStepUpdateOne) SetNillableConditionField(v *string) *EmailSequenceStepUpdateOne {
+	if v != nil {
+		_u.SetConditionField(*v)
+	}
+	return _u
+}
+
+// ClearConditionField clears the value of the "condition_field" field.
+func (_u *EmailSequenceStepUpdateOne) ClearConditionField() *EmailSequenceStepUpdateOne {
+	_u.mutation.ClearConditionField()
+	return _u
+}
+
+// SetConditionValue sets the "condition_value" field.
+func (_u *EmailSequenceStepUpdateOne) SetConditionValue(v string) *EmailSequenceStepUpdateOne {
+	_u.mutation.SetConditionValue(v)
+	return _u
+}
+
+// SetNillableConditionValue sets the "condition_value" field if the given value is not nil.
+func (_u *EmailSequenceStepUpdateOne) SetNillableConditionValue(v *string) *EmailSequenceStepUpdateOne {
+	if v != nil {
+		_u.SetConditionValue(*v)
+	}
+	return _u
+}
+
+// ClearConditionValue clears the value of the "condition_value" field.
+func (_u *EmailSequenceStepUpdateOne) ClearConditionValue() *EmailSequenceStepUpdateOne {
+	_u.mutation.ClearConditionValue()
+	return _u
+}
+
 // SetSequence sets the "sequence" edge to the EmailSequence entity.
 func (_u *EmailSequenceStepUpdateOne) SetSequence(v *EmailSequence) *EmailSequenceStepUpdateOne {
 	return _u.SetSequenceID(v.ID)
@@ -552,12 +767,23 @@ func (_u *EmailSequenceStepUpdateOne) check() error {
 			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "EmailSequenceStep.body": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.ConditionType(); ok {
+		if err := emailsequencestep.ConditionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "condition_type", err: fmt.Errorf(`ent: validator failed for field "EmailSequenceStep.condition_type": %w`, err)}
+		}
+	}
 	if _u.mutation.SequenceCleared() && len(_u.mutation.SequenceIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "EmailSequenceStep.sequence"`)
 	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *EmailSequenceStepUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EmailSequenceStepUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *EmailSequenceStepUpdateOne) sqlSave(ctx context.Context) (_node *EmailSequenceStep, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -605,6 +831,33 @@ func (_u *EmailSequenceStepUpdateOne) sqlSave(ctx context.Context) (_node *Email
 	if value, ok := _u.mutation.Body(); ok {
 		_spec.SetField(emailsequencestep.FieldBody, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.ConditionType(); ok {
+		_spec.SetField(emailsequencestep.FieldConditionType, field.TypeEnum, value)
+	}
+	if _u.mutation.ConditionTypeCleared() {
+		_spec.ClearField(emailsequencestep.FieldConditionType, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.ConditionStepOrder(); ok {
+		_spec.SetField(emailsequencestep.FieldConditionStepOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedConditionStepOrder(); ok {
+		_spec.AddField(emailsequencestep.FieldConditionStepOrder, field.TypeInt, value)
+	}
+	if _u.mutation.ConditionStepOrderCleared() {
+		_spec.ClearField(emailsequencestep.FieldConditionStepOrder, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ConditionField(); ok {
+		_spec.SetField(emailsequencestep.FieldConditionField, field.TypeString, value)
+	}
+	if _u.mutation.ConditionFieldCleared() {
+		_spec.ClearField(emailsequencestep.FieldConditionField, field.TypeString)
+	}
+	if value, ok := _u.mutation.ConditionValue(); ok {
+		_spec.SetField(emailsequencestep.FieldConditionValue, field.TypeString, value)
+	}
+	if _u.mutation.ConditionValueCleared() {
+		_spec.ClearField(emailsequencestep.FieldConditionValue, field.TypeString)
+	}
 	if _u.mutation.SequenceCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -679,6 +932,7 @@ func (_u *EmailSequenceStepUpdateOne) sqlSave(ctx context.Context) (_node *Email
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &EmailSequenceStep{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
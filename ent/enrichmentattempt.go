@@ -0,0 +1,263 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/jordanlanch/industrydb/ent/enrichmentattempt"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/user"
+)
+
+// EnrichmentAttempt is the model entity for the EnrichmentAttempt schema.
+type EnrichmentAttempt struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ID of the enriched lead
+	LeadID int `json:"lead_id,omitempty"`
+	// ID of the user who requested the enrichment (null for system runs)
+	UserID *int `json:"user_id,omitempty"`
+	// Enrichment provider called
+	Provider string `json:"provider,omitempty"`
+	// What was requested from the provider
+	Kind enrichmentattempt.Kind `json:"kind,omitempty"`
+	// Whether the provider returned data
+	Success bool `json:"success,omitempty"`
+	// Provider fields returned with a value
+	FieldsReturned []string `json:"fields_returned,omitempty"`
+	// Provider error of a failed attempt
+	ErrorMessage string `json:"error_message,omitempty"`
+	// Provider cost of the attempt in cents
+	CostCents int `json:"cost_cents,omitempty"`
+	// Provider call duration in milliseconds
+	DurationMs int `json:"duration_ms,omitempty"`
+	// When the attempt was made
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EnrichmentAttemptQuery when eager-loading is set.
+	Edges        EnrichmentAttemptEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// EnrichmentAttemptEdges holds the relations/edges for other nodes in the graph.
+type EnrichmentAttemptEdges struct {
+	// Lead holds the value of the lead edge.
+	Lead *Lead `json:"lead,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// LeadOrErr returns the Lead value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EnrichmentAttemptEdges) LeadOrErr() (*Lead, error) {
+	if e.Lead != nil {
+		return e.Lead, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: lead.Label}
+	}
+	return nil, &NotLoadedError{edge: "lead"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EnrichmentAttemptEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EnrichmentAttempt) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case enrichmentattempt.FieldFieldsReturned:
+			values[i] = new([]byte)
+		case enrichmentattempt.FieldSuccess:
+			values[i] = new(sql.NullBool)
+		case enrichmentattempt.FieldID, enrichmentattempt.FieldLeadID, enrichmentattempt.FieldUserID, enrichmentattempt.FieldCostCents, enrichmentattempt.FieldDurationMs:
+			values[i] = new(sql.NullInt64)
+		case enrichmentattempt.FieldProvider, enrichmentattempt.FieldKind, enrichmentattempt.FieldErrorMessage:
+			values[i] = new(sql.NullString)
+		case enrichmentattempt.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EnrichmentAttempt fields.
+func (_m *EnrichmentAttempt) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case enrichmentattempt.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case enrichmentattempt.FieldLeadID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field lead_id", values[i])
+			} else if value.Valid {
+				_m.LeadID = int(value.Int64)
+			}
+		case enrichmentattempt.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = new(int)
+				*_m.UserID = int(value.Int64)
+			}
+		case enrichmentattempt.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				_m.Provider = value.String
+			}
+		case enrichmentattempt.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = enrichmentattempt.Kind(value.String)
+			}
+		case enrichmentattempt.FieldSuccess:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field success", values[i])
+			} else if value.Valid {
+				_m.Success = value.Bool
+			}
+		case enrichmentattempt.FieldFieldsReturned:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field fields_returned", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.FieldsReturned); err != nil {
+					return fmt.Errorf("unmarshal field fields_returned: %w", err)
+				}
+			}
+		case enrichmentattempt.FieldErrorMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error_message", values[i])
+			} else if value.Valid {
+				_m.ErrorMessage = value.String
+			}
+		case enrichmentattempt.FieldCostCents:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field cost_cents", values[i])
+			} else if value.Valid {
+				_m.CostCents = int(value.Int64)
+			}
+		case enrichmentattempt.FieldDurationMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_ms", values[i])
+			} else if value.Valid {
+				_m.DurationMs = int(value.Int64)
+			}
+		case enrichmentattempt.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EnrichmentAttempt.
+// This includes values selected through modifiers, order, etc.
+func (_m *EnrichmentAttempt) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryLead queries the "lead" edge of the EnrichmentAttempt entity.
+func (_m *EnrichmentAttempt) QueryLead() *LeadQuery {
+	return NewEnrichmentAttemptClient(_m.config).QueryLead(_m)
+}
+
+// QueryUser queries the "user" edge of the EnrichmentAttempt entity.
+func (_m *EnrichmentAttempt) QueryUser() *UserQuery {
+	return NewEnrichmentAttemptClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this EnrichmentAttempt.
+// Note that you need to call EnrichmentAttempt.Unwrap() before calling this method if this EnrichmentAttempt
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *EnrichmentAttempt) Update() *EnrichmentAttemptUpdateOne {
+	return NewEnrichmentAttemptClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the EnrichmentAttempt entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *EnrichmentAttempt) Unwrap() *EnrichmentAttempt {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EnrichmentAttempt is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *EnrichmentAttempt) String() string {
+	var builder strings.Builder
+	builder.WriteString("EnrichmentAttempt(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("lead_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LeadID))
+	builder.WriteString(", ")
+	if v := _m.UserID; v != nil {
+		builder.WriteString("user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("provider=")
+	builder.WriteString(_m.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("success=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Success))
+	builder.WriteString(", ")
+	builder.WriteString("fields_returned=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FieldsReturned))
+	builder.WriteString(", ")
+	builder.WriteString("error_message=")
+	builder.WriteString(_m.ErrorMessage)
+	builder.WriteString(", ")
+	builder.WriteString("cost_cents=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CostCents))
+	builder.WriteString(", ")
+	builder.WriteString("duration_ms=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DurationMs))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EnrichmentAttempts is a parsable slice of EnrichmentAttempt.
+type EnrichmentAttempts []*EnrichmentAttempt
@@ -0,0 +1,507 @@
+// Code generated by ent, DO NOT EDIT.
+
+package enrichmentattempt
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldLTE(FieldID, id))
+}
+
+// LeadID applies equality check predicate on the "lead_id" field. It's identical to LeadIDEQ.
+func LeadID(v int) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldEQ(FieldLeadID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldEQ(FieldUserID, v))
+}
+
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldEQ(FieldProvider, v))
+}
+
+// Success applies equality check predicate on the "success" field. It's identical to SuccessEQ.
+func Success(v bool) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldEQ(FieldSuccess, v))
+}
+
+// ErrorMessage applies equality check predicate on the "error_message" field. It's identical to ErrorMessageEQ.
+func ErrorMessage(v string) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldEQ(FieldErrorMessage, v))
+}
+
+// CostCents applies equality check predicate on the "cost_cents" field. It's identical to CostCentsEQ.
+func CostCents(v int) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldEQ(FieldCostCents, v))
+}
+
+// DurationMs applies equality check predicate on the "duration_ms" field. It's identical to DurationMsEQ.
+func DurationMs(v int) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldEQ(FieldDurationMs, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// LeadIDEQ applies the EQ predicate on the "lead_id" field.
+func LeadIDEQ(v int) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldEQ(FieldLeadID, v))
+}
+
+// LeadIDNEQ applies the NEQ predicate on the "lead_id" field.
+func LeadIDNEQ(v int) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldNEQ(FieldLeadID, v))
+}
+
+// LeadIDIn applies the In predicate on the "lead_id" field.
+func LeadIDIn(vs ...int) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldIn(FieldLeadID, vs...))
+}
+
+// LeadIDNotIn applies the NotIn predicate on the "lead_id" field.
+func LeadIDNotIn(vs ...int) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldNotIn(FieldLeadID, vs...))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldNotNull(FieldUserID))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldGT(FieldProvider, v))
+}
+
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldGTE(FieldProvider, v))
+}
+
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldLT(FieldProvider, v))
+}
+
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldLTE(FieldProvider, v))
+}
+
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldContains(FieldProvider, v))
+}
+
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldHasPrefix(FieldProvider, v))
+}
+
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldHasSuffix(FieldProvider, v))
+}
+
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldEqualFold(FieldProvider, v))
+}
+
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldContainsFold(FieldProvider, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// SuccessEQ applies the EQ predicate on the "success" field.
+func SuccessEQ(v bool) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldEQ(FieldSuccess, v))
+}
+
+// SuccessNEQ applies the NEQ predicate on the "success" field.
+func SuccessNEQ(v bool) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldNEQ(FieldSuccess, v))
+}
+
+// FieldsReturnedIsNil applies the IsNil predicate on the "fields_returned" field.
+func FieldsReturnedIsNil() predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldIsNull(FieldFieldsReturned))
+}
+
+// FieldsReturnedNotNil applies the NotNil predicate on the "fields_returned" field.
+func FieldsReturnedNotNil() predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldNotNull(FieldFieldsReturned))
+}
+
+// ErrorMessageEQ applies the EQ predicate on the "error_message" field.
+func ErrorMessageEQ(v string) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldEQ(FieldErrorMessage, v))
+}
+
+// ErrorMessageNEQ applies the NEQ predicate on the "error_message" field.
+func ErrorMessageNEQ(v string) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldNEQ(FieldErrorMessage, v))
+}
+
+// ErrorMessageIn applies the In predicate on the "error_message" field.
+func ErrorMessageIn(vs ...string) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldIn(FieldErrorMessage, vs...))
+}
+
+// ErrorMessageNotIn applies the NotIn predicate on the "error_message" field.
+func ErrorMessageNotIn(vs ...string) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldNotIn(FieldErrorMessage, vs...))
+}
+
+// ErrorMessageGT applies the GT predicate on the "error_message" field.
+func ErrorMessageGT(v string) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldGT(FieldErrorMessage, v))
+}
+
+// ErrorMessageGTE applies the GTE predicate on the "error_message" field.
+func ErrorMessageGTE(v string) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldGTE(FieldErrorMessage, v))
+}
+
+// ErrorMessageLT applies the LT predicate on the "error_message" field.
+func ErrorMessageLT(v string) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldLT(FieldErrorMessage, v))
+}
+
+// ErrorMessageLTE applies the LTE predicate on the "error_message" field.
+func ErrorMessageLTE(v string) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldLTE(FieldErrorMessage, v))
+}
+
+// ErrorMessageContains applies the Contains predicate on the "error_message" field.
+func ErrorMessageContains(v string) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldContains(FieldErrorMessage, v))
+}
+
+// ErrorMessageHasPrefix applies the HasPrefix predicate on the "error_message" field.
+func ErrorMessageHasPrefix(v string) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldHasPrefix(FieldErrorMessage, v))
+}
+
+// ErrorMessageHasSuffix applies the HasSuffix predicate on the "error_message" field.
+func ErrorMessageHasSuffix(v string) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldHasSuffix(FieldErrorMessage, v))
+}
+
+// ErrorMessageIsNil applies the IsNil predicate on the "error_message" field.
+func ErrorMessageIsNil() predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldIsNull(FieldErrorMessage))
+}
+
+// ErrorMessageNotNil applies the NotNil predicate on the "error_message" field.
+func ErrorMessageNotNil() predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldNotNull(FieldErrorMessage))
+}
+
+// ErrorMessageEqualFold applies the EqualFold predicate on the "error_message" field.
+func ErrorMessageEqualFold(v string) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldEqualFold(FieldErrorMessage, v))
+}
+
+// ErrorMessageContainsFold applies the ContainsFold predicate on the "error_message" field.
+func ErrorMessageContainsFold(v string) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldContainsFold(FieldErrorMessage, v))
+}
+
+// CostCentsEQ applies the EQ predicate on the "cost_cents" field.
+func CostCentsEQ(v int) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldEQ(FieldCostCents, v))
+}
+
+// CostCentsNEQ applies the NEQ predicate on the "cost_cents" field.
+func CostCentsNEQ(v int) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldNEQ(FieldCostCents, v))
+}
+
+// CostCentsIn applies the In predicate on the "cost_cents" field.
+func CostCentsIn(vs ...int) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldIn(FieldCostCents, vs...))
+}
+
+// CostCentsNotIn applies the NotIn predicate on the "cost_cents" field.
+func CostCentsNotIn(vs ...int) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldNotIn(FieldCostCents, vs...))
+}
+
+// CostCentsGT applies the GT predicate on the "cost_cents" field.
+func CostCentsGT(v int) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldGT(FieldCostCents, v))
+}
+
+// CostCentsGTE applies the GTE predicate on the "cost_cents" field.
+func CostCentsGTE(v int) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldGTE(FieldCostCents, v))
+}
+
+// CostCentsLT applies the LT predicate on the "cost_cents" field.
+func CostCentsLT(v int) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldLT(FieldCostCents, v))
+}
+
+// CostCentsLTE applies the LTE predicate on the "cost_cents" field.
+func CostCentsLTE(v int) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldLTE(FieldCostCents, v))
+}
+
+// DurationMsEQ applies the EQ predicate on the "duration_ms" field.
+func DurationMsEQ(v int) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldEQ(FieldDurationMs, v))
+}
+
+// DurationMsNEQ applies the NEQ predicate on the "duration_ms" field.
+func DurationMsNEQ(v int) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldNEQ(FieldDurationMs, v))
+}
+
+// DurationMsIn applies the In predicate on the "duration_ms" field.
+func DurationMsIn(vs ...int) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldIn(FieldDurationMs, vs...))
+}
+
+// DurationMsNotIn applies the NotIn predicate on the "duration_ms" field.
+func DurationMsNotIn(vs ...int) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldNotIn(FieldDurationMs, vs...))
+}
+
+// DurationMsGT applies the GT predicate on the "duration_ms" field.
+func DurationMsGT(v int) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldGT(FieldDurationMs, v))
+}
+
+// DurationMsGTE applies the GTE predicate on the "duration_ms" field.
+func DurationMsGTE(v int) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldGTE(FieldDurationMs, v))
+}
+
+// DurationMsLT applies the LT predicate on the "duration_ms" field.
+func DurationMsLT(v int) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldLT(FieldDurationMs, v))
+}
+
+// DurationMsLTE applies the LTE predicate on the "duration_ms" field.
+func DurationMsLTE(v int) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldLTE(FieldDurationMs, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasLead applies the HasEdge predicate on the "lead" edge.
+func HasLead() predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, LeadTable, LeadColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLeadWith applies the HasEdge predicate on the "lead" edge with a given conditions (other predicates).
+func HasLeadWith(preds ...predicate.Lead) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(func(s *sql.Selector) {
+		step := newLeadStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EnrichmentAttempt) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EnrichmentAttempt) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EnrichmentAttempt) predicate.EnrichmentAttempt {
+	return predicate.EnrichmentAttempt(sql.NotPredicates(p))
+}
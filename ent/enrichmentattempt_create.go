@@ -0,0 +1,416 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/enrichmentattempt"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/user"
+)
+
+// EnrichmentAttemptCreate is the builder for creating a EnrichmentAttempt entity.
+type EnrichmentAttemptCreate struct {
+	config
+	mutation *EnrichmentAttemptMutation
+	hooks    []Hook
+}
+
+// SetLeadID sets the "lead_id" field.
+func (_c *EnrichmentAttemptCreate) SetLeadID(v int) *EnrichmentAttemptCreate {
+	_c.mutation.SetLeadID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *EnrichmentAttemptCreate) SetUserID(v int) *EnrichmentAttemptCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *EnrichmentAttemptCreate) SetNillableUserID(v *int) *EnrichmentAttemptCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetProvider sets the "provider" field.
+func (_c *EnrichmentAttemptCreate) SetProvider(v string) *EnrichmentAttemptCreate {
+	_c.mutation.SetProvider(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *EnrichmentAttemptCreate) SetKind(v enrichmentattempt.Kind) *EnrichmentAttemptCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetSuccess sets the "success" field.
+func (_c *EnrichmentAttemptCreate) SetSuccess(v bool) *EnrichmentAttemptCreate {
+	_c.mutation.SetSuccess(v)
+	return _c
+}
+
+// SetFieldsReturned sets the "fields_returned" field.
+func (_c *EnrichmentAttemptCreate) SetFieldsReturned(v []string) *EnrichmentAttemptCreate {
+	_c.mutation.SetFieldsReturned(v)
+	return _c
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_c *EnrichmentAttemptCreate) SetErrorMessage(v string) *EnrichmentAttemptCreate {
+	_c.mutation.SetErrorMessage(v)
+	return _c
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_c *EnrichmentAttemptCreate) SetNillableErrorMessage(v *string) *EnrichmentAttemptCreate {
+	if v != nil {
+		_c.SetErrorMessage(*v)
+	}
+	return _c
+}
+
+// SetCostCents sets the "cost_cents" field.
+func (_c *EnrichmentAttemptCreate) SetCostCents(v int) *EnrichmentAttemptCreate {
+	_c.mutation.SetCostCents(v)
+	return _c
+}
+
+// SetNillableCostCents sets the "cost_cents" field if the given value is not nil.
+func (_c *EnrichmentAttemptCreate) SetNillableCostCents(v *int) *EnrichmentAttemptCreate {
+	if v != nil {
+		_c.SetCostCents(*v)
+	}
+	return _c
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_c *EnrichmentAttemptCreate) SetDurationMs(v int) *EnrichmentAttemptCreate {
+	_c.mutation.SetDurationMs(v)
+	return _c
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_c *EnrichmentAttemptCreate) SetNillableDurationMs(v *int) *EnrichmentAttemptCreate {
+	if v != nil {
+		_c.SetDurationMs(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EnrichmentAttemptCreate) SetCreatedAt(v time.Time) *EnrichmentAttemptCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *EnrichmentAttemptCreate) SetNillableCreatedAt(v *time.Time) *EnrichmentAttemptCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetLead sets the "lead" edge to the Lead entity.
+func (_c *EnrichmentAttemptCreate) SetLead(v *Lead) *EnrichmentAttemptCreate {
+	return _c.SetLeadID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *EnrichmentAttemptCreate) SetUser(v *User) *EnrichmentAttemptCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the EnrichmentAttemptMutation object of the builder.
+func (_c *EnrichmentAttemptCreate) Mutation() *EnrichmentAttemptMutation {
+	return _c.mutation
+}
+
+// Save creates the EnrichmentAttempt in the database.
+func (_c *EnrichmentAttemptCreate) Save(ctx context.Context) (*EnrichmentAttempt, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EnrichmentAttemptCreate) SaveX(ctx context.Context) *EnrichmentAttempt {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EnrichmentAttemptCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EnrichmentAttemptCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EnrichmentAttemptCreate) defaults() {
+	if _, ok := _c.mutation.CostCents(); !ok {
+		v := enrichmentattempt.DefaultCostCents
+		_c.mutation.SetCostCents(v)
+	}
+	if _, ok := _c.mutation.DurationMs(); !ok {
+		v := enrichmentattempt.DefaultDurationMs
+		_c.mutation.SetDurationMs(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := enrichmentattempt.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EnrichmentAttemptCreate) check() error {
+	if _, ok := _c.mutation.LeadID(); !ok {
+		return &ValidationError{Name: "lead_id", err: errors.New(`ent: missing required field "EnrichmentAttempt.lead_id"`)}
+	}
+	if v, ok := _c.mutation.LeadID(); ok {
+		if err := enrichmentattempt.LeadIDValidator(v); err != nil {
+			return &ValidationError{Name: "lead_id", err: fmt.Errorf(`ent: validator failed for field "EnrichmentAttempt.lead_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "EnrichmentAttempt.provider"`)}
+	}
+	if v, ok := _c.mutation.Provider(); ok {
+		if err := enrichmentattempt.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "EnrichmentAttempt.provider": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "EnrichmentAttempt.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := enrichmentattempt.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "EnrichmentAttempt.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Success(); !ok {
+		return &ValidationError{Name: "success", err: errors.New(`ent: missing required field "EnrichmentAttempt.success"`)}
+	}
+	if _, ok := _c.mutation.CostCents(); !ok {
+		return &ValidationError{Name: "cost_cents", err: errors.New(`ent: missing required field "EnrichmentAttempt.cost_cents"`)}
+	}
+	if v, ok := _c.mutation.CostCents(); ok {
+		if err := enrichmentattempt.CostCentsValidator(v); err != nil {
+			return &ValidationError{Name: "cost_cents", err: fmt.Errorf(`ent: validator failed for field "EnrichmentAttempt.cost_cents": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DurationMs(); !ok {
+		return &ValidationError{Name: "duration_ms", err: errors.New(`ent: missing required field "EnrichmentAttempt.duration_ms"`)}
+	}
+	if v, ok := _c.mutation.DurationMs(); ok {
+		if err := enrichmentattempt.DurationMsValidator(v); err != nil {
+			return &ValidationError{Name: "duration_ms", err: fmt.Errorf(`ent: validator failed for field "EnrichmentAttempt.duration_ms": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EnrichmentAttempt.created_at"`)}
+	}
+	if len(_c.mutation.LeadIDs()) == 0 {
+		return &ValidationError{Name: "lead", err: errors.New(`ent: missing required edge "EnrichmentAttempt.lead"`)}
+	}
+	return nil
+}
+
+func (_c *EnrichmentAttemptCreate) sqlSave(ctx context.Context) (*EnrichmentAttempt, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EnrichmentAttemptCreate) createSpec() (*EnrichmentAttempt, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EnrichmentAttempt{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(enrichmentattempt.Table, sqlgraph.NewFieldSpec(enrichmentattempt.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Provider(); ok {
+		_spec.SetField(enrichmentattempt.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(enrichmentattempt.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Success(); ok {
+		_spec.SetField(enrichmentattempt.FieldSuccess, field.TypeBool, value)
+		_node.Success = value
+	}
+	if value, ok := _c.mutation.FieldsReturned(); ok {
+		_spec.SetField(enrichmentattempt.FieldFieldsReturned, field.TypeJSON, value)
+		_node.FieldsReturned = value
+	}
+	if value, ok := _c.mutation.ErrorMessage(); ok {
+		_spec.SetField(enrichmentattempt.FieldErrorMessage, field.TypeString, value)
+		_node.ErrorMessage = value
+	}
+	if value, ok := _c.mutation.CostCents(); ok {
+		_spec.SetField(enrichmentattempt.FieldCostCents, field.TypeInt, value)
+		_node.CostCents = value
+	}
+	if value, ok := _c.mutation.DurationMs(); ok {
+		_spec.SetField(enrichmentattempt.FieldDurationMs, field.TypeInt, value)
+		_node.DurationMs = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(enrichmentattempt.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.LeadIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   enrichmentattempt.LeadTable,
+			Columns: []string{enrichmentattempt.LeadColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LeadID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   enrichmentattempt.UserTable,
+			Columns: []string{enrichmentattempt.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// EnrichmentAttemptCreateBulk is the builder for creating many EnrichmentAttempt entities in bulk.
+type EnrichmentAttemptCreateBulk struct {
+	config
+	err      error
+	builders []*EnrichmentAttemptCreate
+}
+
+// Save creates the EnrichmentAttempt entities in the database.
+func (_c *EnrichmentAttemptCreateBulk) Save(ctx context.Context) ([]*EnrichmentAttempt, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EnrichmentAttempt, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EnrichmentAttemptMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EnrichmentAttemptCreateBulk) SaveX(ctx context.Context) []*EnrichmentAttempt {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EnrichmentAttemptCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EnrichmentAttemptCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,814 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/enrichmentattempt"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+	"github.com/jordanlanch/industrydb/ent/user"
+)
+
+// EnrichmentAttemptUpdate is the builder for updating EnrichmentAttempt entities.
+type EnrichmentAttemptUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *EnrichmentAttemptMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the EnrichmentAttemptUpdate builder.
+func (_u *EnrichmentAttemptUpdate) Where(ps ...predicate.EnrichmentAttempt) *EnrichmentAttemptUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetLeadID sets the "lead_id" field.
+func (_u *EnrichmentAttemptUpdate) SetLeadID(v int) *EnrichmentAttemptUpdate {
+	_u.mutation.SetLeadID(v)
+	return _u
+}
+
+// SetNillableLeadID sets the "lead_id" field if the given value is not nil.
+func (_u *EnrichmentAttemptUpdate) SetNillableLeadID(v *int) *EnrichmentAttemptUpdate {
+	if v != nil {
+		_u.SetLeadID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *EnrichmentAttemptUpdate) SetUserID(v int) *EnrichmentAttemptUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *EnrichmentAttemptUpdate) SetNillableUserID(v *int) *EnrichmentAttemptUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *EnrichmentAttemptUpdate) ClearUserID() *EnrichmentAttemptUpdate {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *EnrichmentAttemptUpdate) SetProvider(v string) *EnrichmentAttemptUpdate {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *EnrichmentAttemptUpdate) SetNillableProvider(v *string) *EnrichmentAttemptUpdate {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *EnrichmentAttemptUpdate) SetKind(v enrichmentattempt.Kind) *EnrichmentAttemptUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *EnrichmentAttemptUpdate) SetNillableKind(v *enrichmentattempt.Kind) *EnrichmentAttemptUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetSuccess sets the "success" field.
+func (_u *EnrichmentAttemptUpdate) SetSuccess(v bool) *EnrichmentAttemptUpdate {
+	_u.mutation.SetSuccess(v)
+	return _u
+}
+
+// SetNillableSuccess sets the "success" field if the given value is not nil.
+func (_u *EnrichmentAttemptUpdate) SetNillableSuccess(v *bool) *EnrichmentAttemptUpdate {
+	if v != nil {
+		_u.SetSuccess(*v)
+	}
+	return _u
+}
+
+// SetFieldsReturned sets the "fields_returned" field.
+func (_u *EnrichmentAttemptUpdate) SetFieldsReturned(v []string) *EnrichmentAttemptUpdate {
+	_u.mutation.SetFieldsReturned(v)
+	return _u
+}
+
+// AppendFieldsReturned appends value to the "fields_returned" field.
+func (_u *EnrichmentAttemptUpdate) AppendFieldsReturned(v []string) *EnrichmentAttemptUpdate {
+	_u.mutation.AppendFieldsReturned(v)
+	return _u
+}
+
+// ClearFieldsReturned clears the value of the "fields_returned" field.
+func (_u *EnrichmentAttemptUpdate) ClearFieldsReturned() *EnrichmentAttemptUpdate {
+	_u.mutation.ClearFieldsReturned()
+	return _u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_u *EnrichmentAttemptUpdate) SetErrorMessage(v string) *EnrichmentAttemptUpdate {
+	_u.mutation.SetErrorMessage(v)
+	return _u
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_u *EnrichmentAttemptUpdate) SetNillableErrorMessage(v *string) *EnrichmentAttemptUpdate {
+	if v != nil {
+		_u.SetErrorMessage(*v)
+	}
+	return _u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (_u *EnrichmentAttemptUpdate) ClearErrorMessage() *EnrichmentAttemptUpdate {
+	_u.mutation.ClearErrorMessage()
+	return _u
+}
+
+// SetCostCents sets the "cost_cents" field.
+func (_u *EnrichmentAttemptUpdate) SetCostCents(v int) *EnrichmentAttemptUpdate {
+	_u.mutation.ResetCostCents()
+	_u.mutation.SetCostCents(v)
+	return _u
+}
+
+// SetNillableCostCents sets the "cost_cents" field if the given value is not nil.
+func (_u *EnrichmentAttemptUpdate) SetNillableCostCents(v *int) *EnrichmentAttemptUpdate {
+	if v != nil {
+		_u.SetCostCents(*v)
+	}
+	return _u
+}
+
+// AddCostCents adds value to the "cost_cents" field.
+func (_u *EnrichmentAttemptUpdate) AddCostCents(v int) *EnrichmentAttemptUpdate {
+	_u.mutation.AddCostCents(v)
+	return _u
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_u *EnrichmentAttemptUpdate) SetDurationMs(v int) *EnrichmentAttemptUpdate {
+	_u.mutation.ResetDurationMs()
+	_u.mutation.SetDurationMs(v)
+	return _u
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_u *EnrichmentAttemptUpdate) SetNillableDurationMs(v *int) *EnrichmentAttemptUpdate {
+	if v != nil {
+		_u.SetDurationMs(*v)
+	}
+	return _u
+}
+
+// AddDurationMs adds value to the "duration_ms" field.
+func (_u *EnrichmentAttemptUpdate) AddDurationMs(v int) *EnrichmentAttemptUpdate {
+	_u.mutation.AddDurationMs(v)
+	return _u
+}
+
+// SetLead sets the "lead" edge to the Lead entity.
+func (_u *EnrichmentAttemptUpdate) SetLead(v *Lead) *EnrichmentAttemptUpdate {
+	return _u.SetLeadID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *EnrichmentAttemptUpdate) SetUser(v *User) *EnrichmentAttemptUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the EnrichmentAttemptMutation object of the builder.
+func (_u *EnrichmentAttemptUpdate) Mutation() *EnrichmentAttemptMutation {
+	return _u.mutation
+}
+
+// ClearLead clears the "lead" edge to the Lead entity.
+func (_u *EnrichmentAttemptUpdate) ClearLead() *EnrichmentAttemptUpdate {
+	_u.mutation.ClearLead()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *EnrichmentAttemptUpdate) ClearUser() *EnrichmentAttemptUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EnrichmentAttemptUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EnrichmentAttemptUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EnrichmentAttemptUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EnrichmentAttemptUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EnrichmentAttemptUpdate) check() error {
+	if v, ok := _u.mutation.LeadID(); ok {
+		if err := enrichmentattempt.LeadIDValidator(v); err != nil {
+			return &ValidationError{Name: "lead_id", err: fmt.Errorf(`ent: validator failed for field "EnrichmentAttempt.lead_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Provider(); ok {
+		if err := enrichmentattempt.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "EnrichmentAttempt.provider": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := enrichmentattempt.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "EnrichmentAttempt.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CostCents(); ok {
+		if err := enrichmentattempt.CostCentsValidator(v); err != nil {
+			return &ValidationError{Name: "cost_cents", err: fmt.Errorf(`ent: validator failed for field "EnrichmentAttempt.cost_cents": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DurationMs(); ok {
+		if err := enrichmentattempt.DurationMsValidator(v); err != nil {
+			return &ValidationError{Name: "duration_ms", err: fmt.Errorf(`ent: validator failed for field "EnrichmentAttempt.duration_ms": %w`, err)}
+		}
+	}
+	if _u.mutation.LeadCleared() && len(_u.mutation.LeadIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EnrichmentAttempt.lead"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *EnrichmentAttemptUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EnrichmentAttemptUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *EnrichmentAttemptUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(enrichmentattempt.Table, enrichmentattempt.Columns, sqlgraph.NewFieldSpec(enrichmentattempt.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(enrichmentattempt.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(enrichmentattempt.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Success(); ok {
+		_spec.SetField(enrichmentattempt.FieldSuccess, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.FieldsReturned(); ok {
+		_spec.SetField(enrichmentattempt.FieldFieldsReturned, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedFieldsReturned(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, enrichmentattempt.FieldFieldsReturned, value)
+		})
+	}
+	if _u.mutation.FieldsReturnedCleared() {
+		_spec.ClearField(enrichmentattempt.FieldFieldsReturned, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ErrorMessage(); ok {
+		_spec.SetField(enrichmentattempt.FieldErrorMessage, field.TypeString, value)
+	}
+	if _u.mutation.ErrorMessageCleared() {
+		_spec.ClearField(enrichmentattempt.FieldErrorMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.CostCents(); ok {
+		_spec.SetField(enrichmentattempt.FieldCostCents, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCostCents(); ok {
+		_spec.AddField(enrichmentattempt.FieldCostCents, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DurationMs(); ok {
+		_spec.SetField(enrichmentattempt.FieldDurationMs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMs(); ok {
+		_spec.AddField(enrichmentattempt.FieldDurationMs, field.TypeInt, value)
+	}
+	if _u.mutation.LeadCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   enrichmentattempt.LeadTable,
+			Columns: []string{enrichmentattempt.LeadColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LeadIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   enrichmentattempt.LeadTable,
+			Columns: []string{enrichmentattempt.LeadColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   enrichmentattempt.UserTable,
+			Columns: []string{enrichmentattempt.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   enrichmentattempt.UserTable,
+			Columns: []string{enrichmentattempt.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{enrichmentattempt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EnrichmentAttemptUpdateOne is the builder for updating a single EnrichmentAttempt entity.
+type EnrichmentAttemptUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *EnrichmentAttemptMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetLeadID sets the "lead_id" field.
+func (_u *EnrichmentAttemptUpdateOne) SetLeadID(v int) *EnrichmentAttemptUpdateOne {
+	_u.mutation.SetLeadID(v)
+	return _u
+}
+
+// SetNillableLeadID sets the "lead_id" field if the given value is not nil.
+func (_u *EnrichmentAttemptUpdateOne) SetNillableLeadID(v *int) *EnrichmentAttemptUpdateOne {
+	if v != nil {
+		_u.SetLeadID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *EnrichmentAttemptUpdateOne) SetUserID(v int) *EnrichmentAttemptUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *EnrichmentAttemptUpdateOne) SetNillableUserID(v *int) *EnrichmentAttemptUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *EnrichmentAttemptUpdateOne) ClearUserID() *EnrichmentAttemptUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *EnrichmentAttemptUpdateOne) SetProvider(v string) *EnrichmentAttemptUpdateOne {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *EnrichmentAttemptUpdateOne) SetNillableProvider(v *string) *EnrichmentAttemptUpdateOne {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *EnrichmentAttemptUpdateOne) SetKind(v enrichmentattempt.Kind) *EnrichmentAttemptUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *EnrichmentAttemptUpdateOne) SetNillableKind(v *enrichmentattempt.Kind) *EnrichmentAttemptUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetSuccess sets the "success" field.
+func (_u *EnrichmentAttemptUpdateOne) SetSuccess(v bool) *EnrichmentAttemptUpdateOne {
+	_u.mutation.SetSuccess(v)
+	return _u
+}
+
+// SetNillableSuccess sets the "success" field if the given value is not nil.
+func (_u *EnrichmentAttemptUpdateOne) SetNillableSuccess(v *bool) *EnrichmentAttemptUpdateOne {
+	if v != nil {
+		_u.SetSuccess(*v)
+	}
+	return _u
+}
+
+// SetFieldsReturned sets the "fields_returned" field.
+func (_u *EnrichmentAttemptUpdateOne) SetFieldsReturned(v []string) *EnrichmentAttemptUpdateOne {
+	_u.mutation.SetFieldsReturned(v)
+	return _u
+}
+
+// AppendFieldsReturned appends value to the "fields_returned" field.
+func (_u *EnrichmentAttemptUpdateOne) AppendFieldsReturned(v []string) *EnrichmentAttemptUpdateOne {
+	_u.mutation.AppendFieldsReturned(v)
+	return _u
+}
+
+// ClearFieldsReturned clears the value of the "fields_returned" field.
+func (_u *EnrichmentAttemptUpdateOne) ClearFieldsReturned() *EnrichmentAttemptUpdateOne {
+	_u.mutation.ClearFieldsReturned()
+	return _u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_u *EnrichmentAttemptUpdateOne) SetErrorMessage(v string) *EnrichmentAttemptUpdateOne {
+	_u.mutation.SetErrorMessage(v)
+	return _u
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_u *EnrichmentAttemptUpdateOne) SetNillableErrorMessage(v *string) *EnrichmentAttemptUpdateOne {
+	if v != nil {
+		_u.SetErrorMessage(*v)
+	}
+	return _u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (_u *EnrichmentAttemptUpdateOne) ClearErrorMessage() *EnrichmentAttemptUpdateOne {
+	_u.mutation.ClearErrorMessage()
+	return _u
+}
+
+// SetCostCents sets the "cost_cents" field.
+func (_u *EnrichmentAttemptUpdateOne) SetCostCents(v int) *EnrichmentAttemptUpdateOne {
+	_u.mutation.ResetCostCents()
+	_u.mutation.SetCostCents(v)
+	return _u
+}
+
+// SetNillableCostCents sets the "cost_cents" field if the given value is not nil.
+func (_u *EnrichmentAttemptUpdateOne) SetNillableCostCents(v *int) *EnrichmentAttemptUpdateOne {
+	if v != nil {
+		_u.SetCostCents(*v)
+	}
+	return _u
+}
+
+// AddCostCents adds value to the "cost_cents" field.
+func (_u *EnrichmentAttemptUpdateOne) AddCostCents(v int) *EnrichmentAttemptUpdateOne {
+	_u.mutation.AddCostCents(v)
+	return _u
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_u *EnrichmentAttemptUpdateOne) SetDurationMs(v int) *EnrichmentAttemptUpdateOne {
+	_u.mutation.ResetDurationMs()
+	_u.mutation.SetDurationMs(v)
+	return _u
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_u *EnrichmentAttemptUpdateOne) SetNillableDurationMs(v *int) *EnrichmentAttemptUpdateOne {
+	if v != nil {
+		_u.SetDurationMs(*v)
+	}
+	return _u
+}
+
+// AddDurationMs adds value to the "duration_ms" field.
+func (_u *EnrichmentAttemptUpdateOne) AddDurationMs(v int) *EnrichmentAttemptUpdateOne {
+	_u.mutation.AddDurationMs(v)
+	return _u
+}
+
+// SetLead sets the "lead" edge to the Lead entity.
+func (_u *EnrichmentAttemptUpdateOne) SetLead(v *Lead) *EnrichmentAttemptUpdateOne {
+	return _u.SetLeadID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *EnrichmentAttemptUpdateOne) SetUser(v *User) *EnrichmentAttemptUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the EnrichmentAttemptMutation object of the builder.
+func (_u *EnrichmentAttemptUpdateOne) Mutation() *EnrichmentAttemptMutation {
+	return _u.mutation
+}
+
+// ClearLead clears the "lead" edge to the Lead entity.
+func (_u *EnrichmentAttemptUpdateOne) ClearLead() *EnrichmentAttemptUpdateOne {
+	_u.mutation.ClearLead()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *EnrichmentAttemptUpdateOne) ClearUser() *EnrichmentAttemptUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the EnrichmentAttemptUpdate builder.
+func (_u *EnrichmentAttemptUpdateOne) Where(ps ...predicate.EnrichmentAttempt) *EnrichmentAttemptUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EnrichmentAttemptUpdateOne) Select(field string, fields ...string) *EnrichmentAttemptUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EnrichmentAttempt entity.
+func (_u *EnrichmentAttemptUpdateOne) Save(ctx context.Context) (*EnrichmentAttempt, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EnrichmentAttemptUpdateOne) SaveX(ctx context.Context) *EnrichmentAttempt {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EnrichmentAttemptUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EnrichmentAttemptUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EnrichmentAttemptUpdateOne) check() error {
+	if v, ok := _u.mutation.LeadID(); ok {
+		if err := enrichmentattempt.LeadIDValidator(v); err != nil {
+			return &ValidationError{Name: "lead_id", err: fmt.Errorf(`ent: validator failed for field "EnrichmentAttempt.lead_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Provider(); ok {
+		if err := enrichmentattempt.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "EnrichmentAttempt.provider": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := enrichmentattempt.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "EnrichmentAttempt.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CostCents(); ok {
+		if err := enrichmentattempt.CostCentsValidator(v); err != nil {
+			return &ValidationError{Name: "cost_cents", err: fmt.Errorf(`ent: validator failed for field "EnrichmentAttempt.cost_cents": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DurationMs(); ok {
+		if err := enrichmentattempt.DurationMsValidator(v); err != nil {
+			return &ValidationError{Name: "duration_ms", err: fmt.Errorf(`ent: validator failed for field "EnrichmentAttempt.duration_ms": %w`, err)}
+		}
+	}
+	if _u.mutation.LeadCleared() && len(_u.mutation.LeadIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EnrichmentAttempt.lead"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *EnrichmentAttemptUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EnrichmentAttemptUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *EnrichmentAttemptUpdateOne) sqlSave(ctx context.Context) (_node *EnrichmentAttempt, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(enrichmentattempt.Table, enrichmentattempt.Columns, sqlgraph.NewFieldSpec(enrichmentattempt.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EnrichmentAttempt.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, enrichmentattempt.FieldID)
+		for _, f := range fields {
+			if !enrichmentattempt.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != enrichmentattempt.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(enrichmentattempt.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(enrichmentattempt.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Success(); ok {
+		_spec.SetField(enrichmentattempt.FieldSuccess, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.FieldsReturned(); ok {
+		_spec.SetField(enrichmentattempt.FieldFieldsReturned, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedFieldsReturned(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, enrichmentattempt.FieldFieldsReturned, value)
+		})
+	}
+	if _u.mutation.FieldsReturnedCleared() {
+		_spec.ClearField(enrichmentattempt.FieldFieldsReturned, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ErrorMessage(); ok {
+		_spec.SetField(enrichmentattempt.FieldErrorMessage, field.TypeString, value)
+	}
+	if _u.mutation.ErrorMessageCleared() {
+		_spec.ClearField(enrichmentattempt.FieldErrorMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.CostCents(); ok {
+		_spec.SetField(enrichmentattempt.FieldCostCents, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCostCents(); ok {
+		_spec.AddField(enrichmentattempt.FieldCostCents, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DurationMs(); ok {
+		_spec.SetField(enrichmentattempt.FieldDurationMs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMs(); ok {
+		_spec.AddField(enrichmentattempt.FieldDurationMs, field.TypeInt, value)
+	}
+	if _u.mutation.LeadCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   enrichmentattempt.LeadTable,
+			Columns: []string{enrichmentattempt.LeadColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LeadIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   enrichmentattempt.LeadTable,
+			Columns: []string{enrichmentattempt.LeadColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   enrichmentattempt.UserTable,
+			Columns: []string{enrichmentattempt.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   enrichmentattempt.UserTable,
+			Columns: []string{enrichmentattempt.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &EnrichmentAttempt{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{enrichmentattempt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
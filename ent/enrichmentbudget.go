@@ -0,0 +1,241 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/jordanlanch/industrydb/ent/enrichmentbudget"
+	"github.com/jordanlanch/industrydb/ent/organization"
+	"github.com/jordanlanch/industrydb/ent/user"
+)
+
+// EnrichmentBudget is the model entity for the EnrichmentBudget schema.
+type EnrichmentBudget struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// User whose personal enrichments are budgeted
+	UserID *int `json:"user_id,omitempty"`
+	// Organization whose enrichments are budgeted
+	OrganizationID *int `json:"organization_id,omitempty"`
+	// Monthly provider calls allowed, overriding the tier default (0 = unlimited)
+	CallLimit *int `json:"call_limit,omitempty"`
+	// Monthly provider cost allowed in cents, overriding the tier default (0 = unlimited)
+	CostLimitCents *int `json:"cost_limit_cents,omitempty"`
+	// Provider calls made this month
+	Calls int `json:"calls,omitempty"`
+	// Provider cost spent this month in cents
+	CostCents int `json:"cost_cents,omitempty"`
+	// Start of the month the counters cover
+	PeriodStart time.Time `json:"period_start,omitempty"`
+	// Last spend or limit change
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EnrichmentBudgetQuery when eager-loading is set.
+	Edges        EnrichmentBudgetEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// EnrichmentBudgetEdges holds the relations/edges for other nodes in the graph.
+type EnrichmentBudgetEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Organization holds the value of the organization edge.
+	Organization *Organization `json:"organization,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EnrichmentBudgetEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EnrichmentBudgetEdges) OrganizationOrErr() (*Organization, error) {
+	if e.Organization != nil {
+		return e.Organization, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EnrichmentBudget) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case enrichmentbudget.FieldID, enrichmentbudget.FieldUserID, enrichmentbudget.FieldOrganizationID, enrichmentbudget.FieldCallLimit, enrichmentbudget.FieldCostLimitCents, enrichmentbudget.FieldCalls, enrichmentbudget.FieldCostCents:
+			values[i] = new(sql.NullInt64)
+		case enrichmentbudget.FieldPeriodStart, enrichmentbudget.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EnrichmentBudget fields.
+func (_m *EnrichmentBudget) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case enrichmentbudget.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case enrichmentbudget.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = new(int)
+				*_m.UserID = int(value.Int64)
+			}
+		case enrichmentbudget.FieldOrganizationID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value.Valid {
+				_m.OrganizationID = new(int)
+				*_m.OrganizationID = int(value.Int64)
+			}
+		case enrichmentbudget.FieldCallLimit:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field call_limit", values[i])
+			} else if value.Valid {
+				_m.CallLimit = new(int)
+				*_m.CallLimit = int(value.Int64)
+			}
+		case enrichmentbudget.FieldCostLimitCents:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field cost_limit_cents", values[i])
+			} else if value.Valid {
+				_m.CostLimitCents = new(int)
+				*_m.CostLimitCents = int(value.Int64)
+			}
+		case enrichmentbudget.FieldCalls:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field calls", values[i])
+			} else if value.Valid {
+				_m.Calls = int(value.Int64)
+			}
+		case enrichmentbudget.FieldCostCents:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field cost_cents", values[i])
+			} else if value.Valid {
+				_m.CostCents = int(value.Int64)
+			}
+		case enrichmentbudget.FieldPeriodStart:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field period_start", values[i])
+			} else if value.Valid {
+				_m.PeriodStart = value.Time
+			}
+		case enrichmentbudget.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EnrichmentBudget.
+// This includes values selected through modifiers, order, etc.
+func (_m *EnrichmentBudget) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the EnrichmentBudget entity.
+func (_m *EnrichmentBudget) QueryUser() *UserQuery {
+	return NewEnrichmentBudgetClient(_m.config).QueryUser(_m)
+}
+
+// QueryOrganization queries the "organization" edge of the EnrichmentBudget entity.
+func (_m *EnrichmentBudget) QueryOrganization() *OrganizationQuery {
+	return NewEnrichmentBudgetClient(_m.config).QueryOrganization(_m)
+}
+
+// Update returns a builder for updating this EnrichmentBudget.
+// Note that you need to call EnrichmentBudget.Unwrap() before calling this method if this EnrichmentBudget
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *EnrichmentBudget) Update() *EnrichmentBudgetUpdateOne {
+	return NewEnrichmentBudgetClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the EnrichmentBudget entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *EnrichmentBudget) Unwrap() *EnrichmentBudget {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EnrichmentBudget is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *EnrichmentBudget) String() string {
+	var builder strings.Builder
+	builder.WriteString("EnrichmentBudget(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.UserID; v != nil {
+		builder.WriteString("user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.OrganizationID; v != nil {
+		builder.WriteString("organization_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CallLimit; v != nil {
+		builder.WriteString("call_limit=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CostLimitCents; v != nil {
+		builder.WriteString("cost_limit_cents=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("calls=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Calls))
+	builder.WriteString(", ")
+	builder.WriteString("cost_cents=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CostCents))
+	builder.WriteString(", ")
+	builder.WriteString("period_start=")
+	builder.WriteString(_m.PeriodStart.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EnrichmentBudgets is a parsable slice of EnrichmentBudget.
+type EnrichmentBudgets []*EnrichmentBudget
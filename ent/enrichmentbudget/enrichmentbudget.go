@@ -0,0 +1,173 @@
+// Code generated by ent, DO NOT EDIT.
+
+package enrichmentbudget
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the enrichmentbudget type in the database.
+	Label = "enrichment_budget"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldOrganizationID holds the string denoting the organization_id field in the database.
+	FieldOrganizationID = "organization_id"
+	// FieldCallLimit holds the string denoting the call_limit field in the database.
+	FieldCallLimit = "call_limit"
+	// FieldCostLimitCents holds the string denoting the cost_limit_cents field in the database.
+	FieldCostLimitCents = "cost_limit_cents"
+	// FieldCalls holds the string denoting the calls field in the database.
+	FieldCalls = "calls"
+	// FieldCostCents holds the string denoting the cost_cents field in the database.
+	FieldCostCents = "cost_cents"
+	// FieldPeriodStart holds the string denoting the period_start field in the database.
+	FieldPeriodStart = "period_start"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// EdgeOrganization holds the string denoting the organization edge name in mutations.
+	EdgeOrganization = "organization"
+	// Table holds the table name of the enrichmentbudget in the database.
+	Table = "enrichment_budgets"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "enrichment_budgets"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_id"
+	// OrganizationTable is the table that holds the organization relation/edge.
+	OrganizationTable = "enrichment_budgets"
+	// OrganizationInverseTable is the table name for the Organization entity.
+	// It exists in this package in order to avoid circular dependency with the "organization" package.
+	OrganizationInverseTable = "organizations"
+	// OrganizationColumn is the table column denoting the organization relation/edge.
+	OrganizationColumn = "organization_id"
+)
+
+// Columns holds all SQL columns for enrichmentbudget fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldOrganizationID,
+	FieldCallLimit,
+	FieldCostLimitCents,
+	FieldCalls,
+	FieldCostCents,
+	FieldPeriodStart,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// CallLimitValidator is a validator for the "call_limit" field. It is called by the builders before save.
+	CallLimitValidator func(int) error
+	// CostLimitCentsValidator is a validator for the "cost_limit_cents" field. It is called by the builders before save.
+	CostLimitCentsValidator func(int) error
+	// DefaultCalls holds the default value on creation for the "calls" field.
+	DefaultCalls int
+	// CallsValidator is a validator for the "calls" field. It is called by the builders before save.
+	CallsValidator func(int) error
+	// DefaultCostCents holds the default value on creation for the "cost_cents" field.
+	DefaultCostCents int
+	// CostCentsValidator is a validator for the "cost_cents" field. It is called by the builders before save.
+	CostCentsValidator func(int) error
+	// DefaultPeriodStart holds the default value on creation for the "period_start" field.
+	DefaultPeriodStart func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the EnrichmentBudget queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByOrganizationID orders the results by the organization_id field.
+func ByOrganizationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrganizationID, opts...).ToFunc()
+}
+
+// ByCallLimit orders the results by the call_limit field.
+func ByCallLimit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCallLimit, opts...).ToFunc()
+}
+
+// ByCostLimitCents orders the results by the cost_limit_cents field.
+func ByCostLimitCents(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCostLimitCents, opts...).ToFunc()
+}
+
+// ByCalls orders the results by the calls field.
+func ByCalls(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCalls, opts...).ToFunc()
+}
+
+// ByCostCents orders the results by the cost_cents field.
+func ByCostCents(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCostCents, opts...).ToFunc()
+}
+
+// ByPeriodStart orders the results by the period_start field.
+func ByPeriodStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeriodStart, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByOrganizationField orders the results by organization field.
+func ByOrganizationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrganizationStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, UserTable, UserColumn),
+	)
+}
+func newOrganizationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrganizationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, OrganizationTable, OrganizationColumn),
+	)
+}
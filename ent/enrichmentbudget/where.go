@@ -0,0 +1,477 @@
+// Code generated by ent, DO NOT EDIT.
+
+package enrichmentbudget
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldEQ(FieldUserID, v))
+}
+
+// OrganizationID applies equality check predicate on the "organization_id" field. It's identical to OrganizationIDEQ.
+func OrganizationID(v int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// CallLimit applies equality check predicate on the "call_limit" field. It's identical to CallLimitEQ.
+func CallLimit(v int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldEQ(FieldCallLimit, v))
+}
+
+// CostLimitCents applies equality check predicate on the "cost_limit_cents" field. It's identical to CostLimitCentsEQ.
+func CostLimitCents(v int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldEQ(FieldCostLimitCents, v))
+}
+
+// Calls applies equality check predicate on the "calls" field. It's identical to CallsEQ.
+func Calls(v int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldEQ(FieldCalls, v))
+}
+
+// CostCents applies equality check predicate on the "cost_cents" field. It's identical to CostCentsEQ.
+func CostCents(v int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldEQ(FieldCostCents, v))
+}
+
+// PeriodStart applies equality check predicate on the "period_start" field. It's identical to PeriodStartEQ.
+func PeriodStart(v time.Time) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldNotNull(FieldUserID))
+}
+
+// OrganizationIDEQ applies the EQ predicate on the "organization_id" field.
+func OrganizationIDEQ(v int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDNEQ applies the NEQ predicate on the "organization_id" field.
+func OrganizationIDNEQ(v int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldNEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDIn applies the In predicate on the "organization_id" field.
+func OrganizationIDIn(vs ...int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDNotIn applies the NotIn predicate on the "organization_id" field.
+func OrganizationIDNotIn(vs ...int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldNotIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDIsNil applies the IsNil predicate on the "organization_id" field.
+func OrganizationIDIsNil() predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldIsNull(FieldOrganizationID))
+}
+
+// OrganizationIDNotNil applies the NotNil predicate on the "organization_id" field.
+func OrganizationIDNotNil() predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldNotNull(FieldOrganizationID))
+}
+
+// CallLimitEQ applies the EQ predicate on the "call_limit" field.
+func CallLimitEQ(v int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldEQ(FieldCallLimit, v))
+}
+
+// CallLimitNEQ applies the NEQ predicate on the "call_limit" field.
+func CallLimitNEQ(v int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldNEQ(FieldCallLimit, v))
+}
+
+// CallLimitIn applies the In predicate on the "call_limit" field.
+func CallLimitIn(vs ...int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldIn(FieldCallLimit, vs...))
+}
+
+// CallLimitNotIn applies the NotIn predicate on the "call_limit" field.
+func CallLimitNotIn(vs ...int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldNotIn(FieldCallLimit, vs...))
+}
+
+// CallLimitGT applies the GT predicate on the "call_limit" field.
+func CallLimitGT(v int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldGT(FieldCallLimit, v))
+}
+
+// CallLimitGTE applies the GTE predicate on the "call_limit" field.
+func CallLimitGTE(v int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldGTE(FieldCallLimit, v))
+}
+
+// CallLimitLT applies the LT predicate on the "call_limit" field.
+func CallLimitLT(v int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldLT(FieldCallLimit, v))
+}
+
+// CallLimitLTE applies the LTE predicate on the "call_limit" field.
+func CallLimitLTE(v int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldLTE(FieldCallLimit, v))
+}
+
+// CallLimitIsNil applies the IsNil predicate on the "call_limit" field.
+func CallLimitIsNil() predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldIsNull(FieldCallLimit))
+}
+
+// CallLimitNotNil applies the NotNil predicate on the "call_limit" field.
+func CallLimitNotNil() predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldNotNull(FieldCallLimit))
+}
+
+// CostLimitCentsEQ applies the EQ predicate on the "cost_limit_cents" field.
+func CostLimitCentsEQ(v int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldEQ(FieldCostLimitCents, v))
+}
+
+// CostLimitCentsNEQ applies the NEQ predicate on the "cost_limit_cents" field.
+func CostLimitCentsNEQ(v int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldNEQ(FieldCostLimitCents, v))
+}
+
+// CostLimitCentsIn applies the In predicate on the "cost_limit_cents" field.
+func CostLimitCentsIn(vs ...int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldIn(FieldCostLimitCents, vs...))
+}
+
+// CostLimitCentsNotIn applies the NotIn predicate on the "cost_limit_cents" field.
+func CostLimitCentsNotIn(vs ...int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldNotIn(FieldCostLimitCents, vs...))
+}
+
+// CostLimitCentsGT applies the GT predicate on the "cost_limit_cents" field.
+func CostLimitCentsGT(v int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldGT(FieldCostLimitCents, v))
+}
+
+// CostLimitCentsGTE applies the GTE predicate on the "cost_limit_cents" field.
+func CostLimitCentsGTE(v int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldGTE(FieldCostLimitCents, v))
+}
+
+// CostLimitCentsLT applies the LT predicate on the "cost_limit_cents" field.
+func CostLimitCentsLT(v int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldLT(FieldCostLimitCents, v))
+}
+
+// CostLimitCentsLTE applies the LTE predicate on the "cost_limit_cents" field.
+func CostLimitCentsLTE(v int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldLTE(FieldCostLimitCents, v))
+}
+
+// CostLimitCentsIsNil applies the IsNil predicate on the "cost_limit_cents" field.
+func CostLimitCentsIsNil() predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldIsNull(FieldCostLimitCents))
+}
+
+// CostLimitCentsNotNil applies the NotNil predicate on the "cost_limit_cents" field.
+func CostLimitCentsNotNil() predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldNotNull(FieldCostLimitCents))
+}
+
+// CallsEQ applies the EQ predicate on the "calls" field.
+func CallsEQ(v int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldEQ(FieldCalls, v))
+}
+
+// CallsNEQ applies the NEQ predicate on the "calls" field.
+func CallsNEQ(v int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldNEQ(FieldCalls, v))
+}
+
+// CallsIn applies the In predicate on the "calls" field.
+func CallsIn(vs ...int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldIn(FieldCalls, vs...))
+}
+
+// CallsNotIn applies the NotIn predicate on the "calls" field.
+func CallsNotIn(vs ...int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldNotIn(FieldCalls, vs...))
+}
+
+// CallsGT applies the GT predicate on the "calls" field.
+func CallsGT(v int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldGT(FieldCalls, v))
+}
+
+// CallsGTE applies the GTE predicate on the "calls" field.
+func CallsGTE(v int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldGTE(FieldCalls, v))
+}
+
+// CallsLT applies the LT predicate on the "calls" field.
+func CallsLT(v int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldLT(FieldCalls, v))
+}
+
+// CallsLTE applies the LTE predicate on the "calls" field.
+func CallsLTE(v int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldLTE(FieldCalls, v))
+}
+
+// CostCentsEQ applies the EQ predicate on the "cost_cents" field.
+func CostCentsEQ(v int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldEQ(FieldCostCents, v))
+}
+
+// CostCentsNEQ applies the NEQ predicate on the "cost_cents" field.
+func CostCentsNEQ(v int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldNEQ(FieldCostCents, v))
+}
+
+// CostCentsIn applies the In predicate on the "cost_cents" field.
+func CostCentsIn(vs ...int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldIn(FieldCostCents, vs...))
+}
+
+// CostCentsNotIn applies the NotIn predicate on the "cost_cents" field.
+func CostCentsNotIn(vs ...int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldNotIn(FieldCostCents, vs...))
+}
+
+// CostCentsGT applies the GT predicate on the "cost_cents" field.
+func CostCentsGT(v int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldGT(FieldCostCents, v))
+}
+
+// CostCentsGTE applies the GTE predicate on the "cost_cents" field.
+func CostCentsGTE(v int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldGTE(FieldCostCents, v))
+}
+
+// CostCentsLT applies the LT predicate on the "cost_cents" field.
+func CostCentsLT(v int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldLT(FieldCostCents, v))
+}
+
+// CostCentsLTE applies the LTE predicate on the "cost_cents" field.
+func CostCentsLTE(v int) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldLTE(FieldCostCents, v))
+}
+
+// PeriodStartEQ applies the EQ predicate on the "period_start" field.
+func PeriodStartEQ(v time.Time) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartNEQ applies the NEQ predicate on the "period_start" field.
+func PeriodStartNEQ(v time.Time) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldNEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartIn applies the In predicate on the "period_start" field.
+func PeriodStartIn(vs ...time.Time) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartNotIn applies the NotIn predicate on the "period_start" field.
+func PeriodStartNotIn(vs ...time.Time) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldNotIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartGT applies the GT predicate on the "period_start" field.
+func PeriodStartGT(v time.Time) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldGT(FieldPeriodStart, v))
+}
+
+// PeriodStartGTE applies the GTE predicate on the "period_start" field.
+func PeriodStartGTE(v time.Time) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldGTE(FieldPeriodStart, v))
+}
+
+// PeriodStartLT applies the LT predicate on the "period_start" field.
+func PeriodStartLT(v time.Time) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldLT(FieldPeriodStart, v))
+}
+
+// PeriodStartLTE applies the LTE predicate on the "period_start" field.
+func PeriodStartLTE(v time.Time) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldLTE(FieldPeriodStart, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOrganization applies the HasEdge predicate on the "organization" edge.
+func HasOrganization() predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrganizationWith applies the HasEdge predicate on the "organization" edge with a given conditions (other predicates).
+func HasOrganizationWith(preds ...predicate.Organization) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(func(s *sql.Selector) {
+		step := newOrganizationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EnrichmentBudget) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EnrichmentBudget) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EnrichmentBudget) predicate.EnrichmentBudget {
+	return predicate.EnrichmentBudget(sql.NotPredicates(p))
+}
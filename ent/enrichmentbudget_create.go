@@ -0,0 +1,407 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/enrichmentbudget"
+	"github.com/jordanlanch/industrydb/ent/organization"
+	"github.com/jordanlanch/industrydb/ent/user"
+)
+
+// EnrichmentBudgetCreate is the builder for creating a EnrichmentBudget entity.
+type EnrichmentBudgetCreate struct {
+	config
+	mutation *EnrichmentBudgetMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *EnrichmentBudgetCreate) SetUserID(v int) *EnrichmentBudgetCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *EnrichmentBudgetCreate) SetNillableUserID(v *int) *EnrichmentBudgetCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_c *EnrichmentBudgetCreate) SetOrganizationID(v int) *EnrichmentBudgetCreate {
+	_c.mutation.SetOrganizationID(v)
+	return _c
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_c *EnrichmentBudgetCreate) SetNillableOrganizationID(v *int) *EnrichmentBudgetCreate {
+	if v != nil {
+		_c.SetOrganizationID(*v)
+	}
+	return _c
+}
+
+// SetCallLimit sets the "call_limit" field.
+func (_c *EnrichmentBudgetCreate) SetCallLimit(v int) *EnrichmentBudgetCreate {
+	_c.mutation.SetCallLimit(v)
+	return _c
+}
+
+// SetNillableCallLimit sets the "call_limit" field if the given value is not nil.
+func (_c *EnrichmentBudgetCreate) SetNillableCallLimit(v *int) *EnrichmentBudgetCreate {
+	if v != nil {
+		_c.SetCallLimit(*v)
+	}
+	return _c
+}
+
+// SetCostLimitCents sets the "cost_limit_cents" field.
+func (_c *EnrichmentBudgetCreate) SetCostLimitCents(v int) *EnrichmentBudgetCreate {
+	_c.mutation.SetCostLimitCents(v)
+	return _c
+}
+
+// SetNillableCostLimitCents sets the "cost_limit_cents" field if the given value is not nil.
+func (_c *EnrichmentBudgetCreate) SetNillableCostLimitCents(v *int) *EnrichmentBudgetCreate {
+	if v != nil {
+		_c.SetCostLimitCents(*v)
+	}
+	return _c
+}
+
+// SetCalls sets the "calls" field.
+func (_c *EnrichmentBudgetCreate) SetCalls(v int) *EnrichmentBudgetCreate {
+	_c.mutation.SetCalls(v)
+	return _c
+}
+
+// SetNillableCalls sets the "calls" field if the given value is not nil.
+func (_c *EnrichmentBudgetCreate) SetNillableCalls(v *int) *EnrichmentBudgetCreate {
+	if v != nil {
+		_c.SetCalls(*v)
+	}
+	return _c
+}
+
+// SetCostCents sets the "cost_cents" field.
+func (_c *EnrichmentBudgetCreate) SetCostCents(v int) *EnrichmentBudgetCreate {
+	_c.mutation.SetCostCents(v)
+	return _c
+}
+
+// SetNillableCostCents sets the "cost_cents" field if the given value is not nil.
+func (_c *EnrichmentBudgetCreate) SetNillableCostCents(v *int) *EnrichmentBudgetCreate {
+	if v != nil {
+		_c.SetCostCents(*v)
+	}
+	return _c
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (_c *EnrichmentBudgetCreate) SetPeriodStart(v time.Time) *EnrichmentBudgetCreate {
+	_c.mutation.SetPeriodStart(v)
+	return _c
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (_c *EnrichmentBudgetCreate) SetNillablePeriodStart(v *time.Time) *EnrichmentBudgetCreate {
+	if v != nil {
+		_c.SetPeriodStart(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *EnrichmentBudgetCreate) SetUpdatedAt(v time.Time) *EnrichmentBudgetCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *EnrichmentBudgetCreate) SetNillableUpdatedAt(v *time.Time) *EnrichmentBudgetCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *EnrichmentBudgetCreate) SetUser(v *User) *EnrichmentBudgetCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_c *EnrichmentBudgetCreate) SetOrganization(v *Organization) *EnrichmentBudgetCreate {
+	return _c.SetOrganizationID(v.ID)
+}
+
+// Mutation returns the EnrichmentBudgetMutation object of the builder.
+func (_c *EnrichmentBudgetCreate) Mutation() *EnrichmentBudgetMutation {
+	return _c.mutation
+}
+
+// Save creates the EnrichmentBudget in the database.
+func (_c *EnrichmentBudgetCreate) Save(ctx context.Context) (*EnrichmentBudget, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EnrichmentBudgetCreate) SaveX(ctx context.Context) *EnrichmentBudget {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EnrichmentBudgetCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EnrichmentBudgetCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EnrichmentBudgetCreate) defaults() {
+	if _, ok := _c.mutation.Calls(); !ok {
+		v := enrichmentbudget.DefaultCalls
+		_c.mutation.SetCalls(v)
+	}
+	if _, ok := _c.mutation.CostCents(); !ok {
+		v := enrichmentbudget.DefaultCostCents
+		_c.mutation.SetCostCents(v)
+	}
+	if _, ok := _c.mutation.PeriodStart(); !ok {
+		v := enrichmentbudget.DefaultPeriodStart()
+		_c.mutation.SetPeriodStart(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := enrichmentbudget.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EnrichmentBudgetCreate) check() error {
+	if v, ok := _c.mutation.CallLimit(); ok {
+		if err := enrichmentbudget.CallLimitValidator(v); err != nil {
+			return &ValidationError{Name: "call_limit", err: fmt.Errorf(`ent: validator failed for field "EnrichmentBudget.call_limit": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.CostLimitCents(); ok {
+		if err := enrichmentbudget.CostLimitCentsValidator(v); err != nil {
+			return &ValidationError{Name: "cost_limit_cents", err: fmt.Errorf(`ent: validator failed for field "EnrichmentBudget.cost_limit_cents": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Calls(); !ok {
+		return &ValidationError{Name: "calls", err: errors.New(`ent: missing required field "EnrichmentBudget.calls"`)}
+	}
+	if v, ok := _c.mutation.Calls(); ok {
+		if err := enrichmentbudget.CallsValidator(v); err != nil {
+			return &ValidationError{Name: "calls", err: fmt.Errorf(`ent: validator failed for field "EnrichmentBudget.calls": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CostCents(); !ok {
+		return &ValidationError{Name: "cost_cents", err: errors.New(`ent: missing required field "EnrichmentBudget.cost_cents"`)}
+	}
+	if v, ok := _c.mutation.CostCents(); ok {
+		if err := enrichmentbudget.CostCentsValidator(v); err != nil {
+			return &ValidationError{Name: "cost_cents", err: fmt.Errorf(`ent: validator failed for field "EnrichmentBudget.cost_cents": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PeriodStart(); !ok {
+		return &ValidationError{Name: "period_start", err: errors.New(`ent: missing required field "EnrichmentBudget.period_start"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "EnrichmentBudget.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *EnrichmentBudgetCreate) sqlSave(ctx context.Context) (*EnrichmentBudget, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EnrichmentBudgetCreate) createSpec() (*EnrichmentBudget, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EnrichmentBudget{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(enrichmentbudget.Table, sqlgraph.NewFieldSpec(enrichmentbudget.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CallLimit(); ok {
+		_spec.SetField(enrichmentbudget.FieldCallLimit, field.TypeInt, value)
+		_node.CallLimit = &value
+	}
+	if value, ok := _c.mutation.CostLimitCents(); ok {
+		_spec.SetField(enrichmentbudget.FieldCostLimitCents, field.TypeInt, value)
+		_node.CostLimitCents = &value
+	}
+	if value, ok := _c.mutation.Calls(); ok {
+		_spec.SetField(enrichmentbudget.FieldCalls, field.TypeInt, value)
+		_node.Calls = value
+	}
+	if value, ok := _c.mutation.CostCents(); ok {
+		_spec.SetField(enrichmentbudget.FieldCostCents, field.TypeInt, value)
+		_node.CostCents = value
+	}
+	if value, ok := _c.mutation.PeriodStart(); ok {
+		_spec.SetField(enrichmentbudget.FieldPeriodStart, field.TypeTime, value)
+		_node.PeriodStart = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(enrichmentbudget.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   enrichmentbudget.UserTable,
+			Columns: []string{enrichmentbudget.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   enrichmentbudget.OrganizationTable,
+			Columns: []string{enrichmentbudget.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrganizationID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// EnrichmentBudgetCreateBulk is the builder for creating many EnrichmentBudget entities in bulk.
+type EnrichmentBudgetCreateBulk struct {
+	config
+	err      error
+	builders []*EnrichmentBudgetCreate
+}
+
+// Save creates the EnrichmentBudget entities in the database.
+func (_c *EnrichmentBudgetCreateBulk) Save(ctx context.Context) ([]*EnrichmentBudget, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EnrichmentBudget, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EnrichmentBudgetMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EnrichmentBudgetCreateBulk) SaveX(ctx context.Context) []*EnrichmentBudget {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EnrichmentBudgetCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EnrichmentBudgetCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
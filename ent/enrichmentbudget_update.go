@@ -0,0 +1,812 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/enrichmentbudget"
+	"github.com/jordanlanch/industrydb/ent/organization"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+	"github.com/jordanlanch/industrydb/ent/user"
+)
+
+// EnrichmentBudgetUpdate is the builder for updating EnrichmentBudget entities.
+type EnrichmentBudgetUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *EnrichmentBudgetMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the EnrichmentBudgetUpdate builder.
+func (_u *EnrichmentBudgetUpdate) Where(ps ...predicate.EnrichmentBudget) *EnrichmentBudgetUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *EnrichmentBudgetUpdate) SetUserID(v int) *EnrichmentBudgetUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *EnrichmentBudgetUpdate) SetNillableUserID(v *int) *EnrichmentBudgetUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *EnrichmentBudgetUpdate) ClearUserID() *EnrichmentBudgetUpdate {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_u *EnrichmentBudgetUpdate) SetOrganizationID(v int) *EnrichmentBudgetUpdate {
+	_u.mutation.SetOrganizationID(v)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_u *EnrichmentBudgetUpdate) SetNillableOrganizationID(v *int) *EnrichmentBudgetUpdate {
+	if v != nil {
+		_u.SetOrganizationID(*v)
+	}
+	return _u
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (_u *EnrichmentBudgetUpdate) ClearOrganizationID() *EnrichmentBudgetUpdate {
+	_u.mutation.ClearOrganizationID()
+	return _u
+}
+
+// SetCallLimit sets the "call_limit" field.
+func (_u *EnrichmentBudgetUpdate) SetCallLimit(v int) *EnrichmentBudgetUpdate {
+	_u.mutation.ResetCallLimit()
+	_u.mutation.SetCallLimit(v)
+	return _u
+}
+
+// SetNillableCallLimit sets the "call_limit" field if the given value is not nil.
+func (_u *EnrichmentBudgetUpdate) SetNillableCallLimit(v *int) *EnrichmentBudgetUpdate {
+	if v != nil {
+		_u.SetCallLimit(*v)
+	}
+	return _u
+}
+
+// AddCallLimit adds value to the "call_limit" field.
+func (_u *EnrichmentBudgetUpdate) AddCallLimit(v int) *EnrichmentBudgetUpdate {
+	_u.mutation.AddCallLimit(v)
+	return _u
+}
+
+// ClearCallLimit clears the value of the "call_limit" field.
+func (_u *EnrichmentBudgetUpdate) ClearCallLimit() *EnrichmentBudgetUpdate {
+	_u.mutation.ClearCallLimit()
+	return _u
+}
+
+// SetCostLimitCents sets the "cost_limit_cents" field.
+func (_u *EnrichmentBudgetUpdate) SetCostLimitCents(v int) *EnrichmentBudgetUpdate {
+	_u.mutation.ResetCostLimitCents()
+	_u.mutation.SetCostLimitCents(v)
+	return _u
+}
+
+// SetNillableCostLimitCents sets the "cost_limit_cents" field if the given value is not nil.
+func (_u *EnrichmentBudgetUpdate) SetNillableCostLimitCents(v *int) *EnrichmentBudgetUpdate {
+	if v != nil {
+		_u.SetCostLimitCents(*v)
+	}
+	return _u
+}
+
+// AddCostLimitCents adds value to the "cost_limit_cents" field.
+func (_u *EnrichmentBudgetUpdate) AddCostLimitCents(v int) *EnrichmentBudgetUpdate {
+	_u.mutation.AddCostLimitCents(v)
+	return _u
+}
+
+// ClearCostLimitCents clears the value of the "cost_limit_cents" field.
+func (_u *EnrichmentBudgetUpdate) ClearCostLimitCents() *EnrichmentBudgetUpdate {
+	_u.mutation.ClearCostLimitCents()
+	return _u
+}
+
+// SetCalls sets the "calls" field.
+func (_u *EnrichmentBudgetUpdate) SetCalls(v int) *EnrichmentBudgetUpdate {
+	_u.mutation.ResetCalls()
+	_u.mutation.SetCalls(v)
+	return _u
+}
+
+// SetNillableCalls sets the "calls" field if the given value is not nil.
+func (_u *EnrichmentBudgetUpdate) SetNillableCalls(v *int) *EnrichmentBudgetUpdate {
+	if v != nil {
+		_u.SetCalls(*v)
+	}
+	return _u
+}
+
+// AddCalls adds value to the "calls" field.
+func (_u *EnrichmentBudgetUpdate) AddCalls(v int) *EnrichmentBudgetUpdate {
+	_u.mutation.AddCalls(v)
+	return _u
+}
+
+// SetCostCents sets the "cost_cents" field.
+func (_u *EnrichmentBudgetUpdate) SetCostCents(v int) *EnrichmentBudgetUpdate {
+	_u.mutation.ResetCostCents()
+	_u.mutation.SetCostCents(v)
+	return _u
+}
+
+// SetNillableCostCents sets the "cost_cents" field if the given value is not nil.
+func (_u *EnrichmentBudgetUpdate) SetNillableCostCents(v *int) *EnrichmentBudgetUpdate {
+	if v != nil {
+		_u.SetCostCents(*v)
+	}
+	return _u
+}
+
+// AddCostCents adds value to the "cost_cents" field.
+func (_u *EnrichmentBudgetUpdate) AddCostCents(v int) *EnrichmentBudgetUpdate {
+	_u.mutation.AddCostCents(v)
+	return _u
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (_u *EnrichmentBudgetUpdate) SetPeriodStart(v time.Time) *EnrichmentBudgetUpdate {
+	_u.mutation.SetPeriodStart(v)
+	return _u
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (_u *EnrichmentBudgetUpdate) SetNillablePeriodStart(v *time.Time) *EnrichmentBudgetUpdate {
+	if v != nil {
+		_u.SetPeriodStart(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EnrichmentBudgetUpdate) SetUpdatedAt(v time.Time) *EnrichmentBudgetUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *EnrichmentBudgetUpdate) SetUser(v *User) *EnrichmentBudgetUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_u *EnrichmentBudgetUpdate) SetOrganization(v *Organization) *EnrichmentBudgetUpdate {
+	return _u.SetOrganizationID(v.ID)
+}
+
+// Mutation returns the EnrichmentBudgetMutation object of the builder.
+func (_u *EnrichmentBudgetUpdate) Mutation() *EnrichmentBudgetMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *EnrichmentBudgetUpdate) ClearUser() *EnrichmentBudgetUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (_u *EnrichmentBudgetUpdate) ClearOrganization() *EnrichmentBudgetUpdate {
+	_u.mutation.ClearOrganization()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EnrichmentBudgetUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EnrichmentBudgetUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EnrichmentBudgetUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EnrichmentBudgetUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EnrichmentBudgetUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := enrichmentbudget.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EnrichmentBudgetUpdate) check() error {
+	if v, ok := _u.mutation.CallLimit(); ok {
+		if err := enrichmentbudget.CallLimitValidator(v); err != nil {
+			return &ValidationError{Name: "call_limit", err: fmt.Errorf(`ent: validator failed for field "EnrichmentBudget.call_limit": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CostLimitCents(); ok {
+		if err := enrichmentbudget.CostLimitCentsValidator(v); err != nil {
+			return &ValidationError{Name: "cost_limit_cents", err: fmt.Errorf(`ent: validator failed for field "EnrichmentBudget.cost_limit_cents": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Calls(); ok {
+		if err := enrichmentbudget.CallsValidator(v); err != nil {
+			return &ValidationError{Name: "calls", err: fmt.Errorf(`ent: validator failed for field "EnrichmentBudget.calls": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CostCents(); ok {
+		if err := enrichmentbudget.CostCentsValidator(v); err != nil {
+			return &ValidationError{Name: "cost_cents", err: fmt.Errorf(`ent: validator failed for field "EnrichmentBudget.cost_cents": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *EnrichmentBudgetUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EnrichmentBudgetUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *EnrichmentBudgetUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(enrichmentbudget.Table, enrichmentbudget.Columns, sqlgraph.NewFieldSpec(enrichmentbudget.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CallLimit(); ok {
+		_spec.SetField(enrichmentbudget.FieldCallLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCallLimit(); ok {
+		_spec.AddField(enrichmentbudget.FieldCallLimit, field.TypeInt, value)
+	}
+	if _u.mutation.CallLimitCleared() {
+		_spec.ClearField(enrichmentbudget.FieldCallLimit, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CostLimitCents(); ok {
+		_spec.SetField(enrichmentbudget.FieldCostLimitCents, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCostLimitCents(); ok {
+		_spec.AddField(enrichmentbudget.FieldCostLimitCents, field.TypeInt, value)
+	}
+	if _u.mutation.CostLimitCentsCleared() {
+		_spec.ClearField(enrichmentbudget.FieldCostLimitCents, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Calls(); ok {
+		_spec.SetField(enrichmentbudget.FieldCalls, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCalls(); ok {
+		_spec.AddField(enrichmentbudget.FieldCalls, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CostCents(); ok {
+		_spec.SetField(enrichmentbudget.FieldCostCents, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCostCents(); ok {
+		_spec.AddField(enrichmentbudget.FieldCostCents, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PeriodStart(); ok {
+		_spec.SetField(enrichmentbudget.FieldPeriodStart, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(enrichmentbudget.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   enrichmentbudget.UserTable,
+			Columns: []string{enrichmentbudget.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   enrichmentbudget.UserTable,
+			Columns: []string{enrichmentbudget.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   enrichmentbudget.OrganizationTable,
+			Columns: []string{enrichmentbudget.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   enrichmentbudget.OrganizationTable,
+			Columns: []string{enrichmentbudget.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{enrichmentbudget.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EnrichmentBudgetUpdateOne is the builder for updating a single EnrichmentBudget entity.
+type EnrichmentBudgetUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *EnrichmentBudgetMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *EnrichmentBudgetUpdateOne) SetUserID(v int) *EnrichmentBudgetUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *EnrichmentBudgetUpdateOne) SetNillableUserID(v *int) *EnrichmentBudgetUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *EnrichmentBudgetUpdateOne) ClearUserID() *EnrichmentBudgetUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_u *EnrichmentBudgetUpdateOne) SetOrganizationID(v int) *EnrichmentBudgetUpdateOne {
+	_u.mutation.SetOrganizationID(v)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_u *EnrichmentBudgetUpdateOne) SetNillableOrganizationID(v *int) *EnrichmentBudgetUpdateOne {
+	if v != nil {
+		_u.SetOrganizationID(*v)
+	}
+	return _u
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (_u *EnrichmentBudgetUpdateOne) ClearOrganizationID() *EnrichmentBudgetUpdateOne {
+	_u.mutation.ClearOrganizationID()
+	return _u
+}
+
+// SetCallLimit sets the "call_limit" field.
+func (_u *EnrichmentBudgetUpdateOne) SetCallLimit(v int) *EnrichmentBudgetUpdateOne {
+	_u.mutation.ResetCallLimit()
+	_u.mutation.SetCallLimit(v)
+	return _u
+}
+
+// SetNillableCallLimit sets the "call_limit" field if the given value is not nil.
+func (_u *EnrichmentBudgetUpdateOne) SetNillableCallLimit(v *int) *EnrichmentBudgetUpdateOne {
+	if v != nil {
+		_u.SetCallLimit(*v)
+	}
+	return _u
+}
+
+// AddCallLimit adds value to the "call_limit" field.
+func (_u *EnrichmentBudgetUpdateOne) AddCallLimit(v int) *EnrichmentBudgetUpdateOne {
+	_u.mutation.AddCallLimit(v)
+	return _u
+}
+
+// ClearCallLimit clears the value of the "call_limit" field.
+func (_u *EnrichmentBudgetUpdateOne) ClearCallLimit() *EnrichmentBudgetUpdateOne {
+	_u.mutation.ClearCallLimit()
+	return _u
+}
+
+// SetCostLimitCents sets the "cost_limit_cents" field.
+func (_u *EnrichmentBudgetUpdateOne) SetCostLimitCents(v int) *EnrichmentBudgetUpdateOne {
+	_u.mutation.ResetCostLimitCents()
+	_u.mutation.SetCostLimitCents(v)
+	return _u
+}
+
+// SetNillableCostLimitCents sets the "cost_limit_cents" field if the given value is not nil.
+func (_u *EnrichmentBudgetUpdateOne) SetNillableCostLimitCents(v *int) *EnrichmentBudgetUpdateOne {
+	if v != nil {
+		_u.SetCostLimitCents(*v)
+	}
+	return _u
+}
+
+// AddCostLimitCents adds value to the "cost_limit_cents" field.
+func (_u *EnrichmentBudgetUpdateOne) AddCostLimitCents(v int) *EnrichmentBudgetUpdateOne {
+	_u.mutation.AddCostLimitCents(v)
+	return _u
+}
+
+// ClearCostLimitCents clears the value of the "cost_limit_cents" field.
+func (_u *EnrichmentBudgetUpdateOne) ClearCostLimitCents() *EnrichmentBudgetUpdateOne {
+	_u.mutation.ClearCostLimitCents()
+	return _u
+}
+
+// SetCalls sets the "calls" field.
+func (_u *EnrichmentBudgetUpdateOne) SetCalls(v int) *EnrichmentBudgetUpdateOne {
+	_u.mutation.ResetCalls()
+	_u.mutation.SetCalls(v)
+	return _u
+}
+
+// SetNillableCalls sets the "calls" field if the given value is not nil.
+func (_u *EnrichmentBudgetUpdateOne) SetNillableCalls(v *int) *EnrichmentBudgetUpdateOne {
+	if v != nil {
+		_u.SetCalls(*v)
+	}
+	return _u
+}
+
+// AddCalls adds value to the "calls" field.
+func (_u *EnrichmentBudgetUpdateOne) AddCalls(v int) *EnrichmentBudgetUpdateOne {
+	_u.mutation.AddCalls(v)
+	return _u
+}
+
+// SetCostCents sets the "cost_cents" field.
+func (_u *EnrichmentBudgetUpdateOne) SetCostCents(v int) *EnrichmentBudgetUpdateOne {
+	_u.mutation.ResetCostCents()
+	_u.mutation.SetCostCents(v)
+	return _u
+}
+
+// SetNillableCostCents sets the "cost_cents" field if the given value is not nil.
+func (_u *EnrichmentBudgetUpdateOne) SetNillableCostCents(v *int) *EnrichmentBudgetUpdateOne {
+	if v != nil {
+		_u.SetCostCents(*v)
+	}
+	return _u
+}
+
+// AddCostCents adds value to the "cost_cents" field.
+func (_u *EnrichmentBudgetUpdateOne) AddCostCents(v int) *EnrichmentBudgetUpdateOne {
+	_u.mutation.AddCostCents(v)
+	return _u
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (_u *EnrichmentBudgetUpdateOne) SetPeriodStart(v time.Time) *EnrichmentBudgetUpdateOne {
+	_u.mutation.SetPeriodStart(v)
+	return _u
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (_u *EnrichmentBudgetUpdateOne) SetNillablePeriodStart(v *time.Time) *EnrichmentBudgetUpdateOne {
+	if v != nil {
+		_u.SetPeriodStart(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EnrichmentBudgetUpdateOne) SetUpdatedAt(v time.Time) *EnrichmentBudgetUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *EnrichmentBudgetUpdateOne) SetUser(v *User) *EnrichmentBudgetUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_u *EnrichmentBudgetUpdateOne) SetOrganization(v *Organization) *EnrichmentBudgetUpdateOne {
+	return _u.SetOrganizationID(v.ID)
+}
+
+// Mutation returns the EnrichmentBudgetMutation object of the builder.
+func (_u *EnrichmentBudgetUpdateOne) Mutation() *EnrichmentBudgetMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *EnrichmentBudgetUpdateOne) ClearUser() *EnrichmentBudgetUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (_u *EnrichmentBudgetUpdateOne) ClearOrganization() *EnrichmentBudgetUpdateOne {
+	_u.mutation.ClearOrganization()
+	return _u
+}
+
+// Where appends a list predicates to the EnrichmentBudgetUpdate builder.
+func (_u *EnrichmentBudgetUpdateOne) Where(ps ...predicate.EnrichmentBudget) *EnrichmentBudgetUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EnrichmentBudgetUpdateOne) Select(field string, fields ...string) *EnrichmentBudgetUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EnrichmentBudget entity.
+func (_u *EnrichmentBudgetUpdateOne) Save(ctx context.Context) (*EnrichmentBudget, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EnrichmentBudgetUpdateOne) SaveX(ctx context.Context) *EnrichmentBudget {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EnrichmentBudgetUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EnrichmentBudgetUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EnrichmentBudgetUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := enrichmentbudget.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EnrichmentBudgetUpdateOne) check() error {
+	if v, ok := _u.mutation.CallLimit(); ok {
+		if err := enrichmentbudget.CallLimitValidator(v); err != nil {
+			return &ValidationError{Name: "call_limit", err: fmt.Errorf(`ent: validator failed for field "EnrichmentBudget.call_limit": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CostLimitCents(); ok {
+		if err := enrichmentbudget.CostLimitCentsValidator(v); err != nil {
+			return &ValidationError{Name: "cost_limit_cents", err: fmt.Errorf(`ent: validator failed for field "EnrichmentBudget.cost_limit_cents": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Calls(); ok {
+		if err := enrichmentbudget.CallsValidator(v); err != nil {
+			return &ValidationError{Name: "calls", err: fmt.Errorf(`ent: validator failed for field "EnrichmentBudget.calls": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CostCents(); ok {
+		if err := enrichmentbudget.CostCentsValidator(v); err != nil {
+			return &ValidationError{Name: "cost_cents", err: fmt.Errorf(`ent: validator failed for field "EnrichmentBudget.cost_cents": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *EnrichmentBudgetUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EnrichmentBudgetUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *EnrichmentBudgetUpdateOne) sqlSave(ctx context.Context) (_node *EnrichmentBudget, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(enrichmentbudget.Table, enrichmentbudget.Columns, sqlgraph.NewFieldSpec(enrichmentbudget.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EnrichmentBudget.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, enrichmentbudget.FieldID)
+		for _, f := range fields {
+			if !enrichmentbudget.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != enrichmentbudget.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CallLimit(); ok {
+		_spec.SetField(enrichmentbudget.FieldCallLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCallLimit(); ok {
+		_spec.AddField(enrichmentbudget.FieldCallLimit, field.TypeInt, value)
+	}
+	if _u.mutation.CallLimitCleared() {
+		_spec.ClearField(enrichmentbudget.FieldCallLimit, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CostLimitCents(); ok {
+		_spec.SetField(enrichmentbudget.FieldCostLimitCents, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCostLimitCents(); ok {
+		_spec.AddField(enrichmentbudget.FieldCostLimitCents, field.TypeInt, value)
+	}
+	if _u.mutation.CostLimitCentsCleared() {
+		_spec.ClearField(enrichmentbudget.FieldCostLimitCents, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Calls(); ok {
+		_spec.SetField(enrichmentbudget.FieldCalls, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCalls(); ok {
+		_spec.AddField(enrichmentbudget.FieldCalls, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CostCents(); ok {
+		_spec.SetField(enrichmentbudget.FieldCostCents, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCostCents(); ok {
+		_spec.AddField(enrichmentbudget.FieldCostCents, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PeriodStart(); ok {
+		_spec.SetField(enrichmentbudget.FieldPeriodStart, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(enrichmentbudget.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   enrichmentbudget.UserTable,
+			Columns: []string{enrichmentbudget.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   enrichmentbudget.UserTable,
+			Columns: []string{enrichmentbudget.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   enrichmentbudget.OrganizationTable,
+			Columns: []string{enrichmentbudget.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   enrichmentbudget.OrganizationTable,
+			Columns: []string{enrichmentbudget.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &EnrichmentBudget{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{enrichmentbudget.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
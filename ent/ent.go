@@ -20,37 +20,57 @@ import (
 	"github.com/jordanlanch/industrydb/ent/calllog"
 	"github.com/jordanlanch/industrydb/ent/competitormetric"
 	"github.com/jordanlanch/industrydb/ent/competitorprofile"
+	"github.com/jordanlanch/industrydb/ent/contactattempt"
 	"github.com/jordanlanch/industrydb/ent/crmintegration"
 	"github.com/jordanlanch/industrydb/ent/crmleadsync"
+	"github.com/jordanlanch/industrydb/ent/crmpushjob"
 	"github.com/jordanlanch/industrydb/ent/emailcampaign"
 	"github.com/jordanlanch/industrydb/ent/emailcampaignrecipient"
+	"github.com/jordanlanch/industrydb/ent/emailsend"
 	"github.com/jordanlanch/industrydb/ent/emailsequence"
 	"github.com/jordanlanch/industrydb/ent/emailsequenceenrollment"
 	"github.com/jordanlanch/industrydb/ent/emailsequencesend"
 	"github.com/jordanlanch/industrydb/ent/emailsequencestep"
+	"github.com/jordanlanch/industrydb/ent/emailsuppression"
+	"github.com/jordanlanch/industrydb/ent/enrichmentattempt"
+	"github.com/jordanlanch/industrydb/ent/enrichmentbudget"
 	"github.com/jordanlanch/industrydb/ent/experiment"
 	"github.com/jordanlanch/industrydb/ent/experimentassignment"
 	"github.com/jordanlanch/industrydb/ent/export"
+	"github.com/jordanlanch/industrydb/ent/exporttemplate"
+	"github.com/jordanlanch/industrydb/ent/importjob"
 	"github.com/jordanlanch/industrydb/ent/industry"
+	"github.com/jordanlanch/industrydb/ent/integrationconnection"
 	"github.com/jordanlanch/industrydb/ent/lead"
 	"github.com/jordanlanch/industrydb/ent/leadassignment"
+	"github.com/jordanlanch/industrydb/ent/leadchange"
+	"github.com/jordanlanch/industrydb/ent/leadlicense"
 	"github.com/jordanlanch/industrydb/ent/leadnote"
 	"github.com/jordanlanch/industrydb/ent/leadrecommendation"
+	"github.com/jordanlanch/industrydb/ent/leadreindexjob"
 	"github.com/jordanlanch/industrydb/ent/leadstatushistory"
+	"github.com/jordanlanch/industrydb/ent/leadsuppression"
+	"github.com/jordanlanch/industrydb/ent/leadverification"
 	"github.com/jordanlanch/industrydb/ent/marketreport"
 	"github.com/jordanlanch/industrydb/ent/organization"
 	"github.com/jordanlanch/industrydb/ent/organizationmember"
 	"github.com/jordanlanch/industrydb/ent/referral"
 	"github.com/jordanlanch/industrydb/ent/savedsearch"
+	"github.com/jordanlanch/industrydb/ent/savedsearchsnapshot"
+	"github.com/jordanlanch/industrydb/ent/scheduledexport"
 	"github.com/jordanlanch/industrydb/ent/smscampaign"
 	"github.com/jordanlanch/industrydb/ent/smsmessage"
 	"github.com/jordanlanch/industrydb/ent/subscription"
 	"github.com/jordanlanch/industrydb/ent/territory"
 	"github.com/jordanlanch/industrydb/ent/territorymember"
+	"github.com/jordanlanch/industrydb/ent/usagedailyaggregate"
 	"github.com/jordanlanch/industrydb/ent/usagelog"
 	"github.com/jordanlanch/industrydb/ent/user"
 	"github.com/jordanlanch/industrydb/ent/userbehavior"
+	"github.com/jordanlanch/industrydb/ent/usernotificationpreference"
 	"github.com/jordanlanch/industrydb/ent/webhook"
+	"github.com/jordanlanch/industrydb/ent/webhookdelivery"
+	"github.com/jordanlanch/industrydb/ent/zapierdelivery"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -111,45 +131,65 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			apikey.Table:                  apikey.ValidColumn,
-			affiliate.Table:               affiliate.ValidColumn,
-			affiliateclick.Table:          affiliateclick.ValidColumn,
-			affiliateconversion.Table:     affiliateconversion.ValidColumn,
-			auditlog.Table:                auditlog.ValidColumn,
-			crmintegration.Table:          crmintegration.ValidColumn,
-			crmleadsync.Table:             crmleadsync.ValidColumn,
-			calllog.Table:                 calllog.ValidColumn,
-			competitormetric.Table:        competitormetric.ValidColumn,
-			competitorprofile.Table:       competitorprofile.ValidColumn,
-			emailcampaign.Table:           emailcampaign.ValidColumn,
-			emailcampaignrecipient.Table:  emailcampaignrecipient.ValidColumn,
-			emailsequence.Table:           emailsequence.ValidColumn,
-			emailsequenceenrollment.Table: emailsequenceenrollment.ValidColumn,
-			emailsequencesend.Table:       emailsequencesend.ValidColumn,
-			emailsequencestep.Table:       emailsequencestep.ValidColumn,
-			experiment.Table:              experiment.ValidColumn,
-			experimentassignment.Table:    experimentassignment.ValidColumn,
-			export.Table:                  export.ValidColumn,
-			industry.Table:                industry.ValidColumn,
-			lead.Table:                    lead.ValidColumn,
-			leadassignment.Table:          leadassignment.ValidColumn,
-			leadnote.Table:                leadnote.ValidColumn,
-			leadrecommendation.Table:      leadrecommendation.ValidColumn,
-			leadstatushistory.Table:       leadstatushistory.ValidColumn,
-			marketreport.Table:            marketreport.ValidColumn,
-			organization.Table:            organization.ValidColumn,
-			organizationmember.Table:      organizationmember.ValidColumn,
-			referral.Table:                referral.ValidColumn,
-			smscampaign.Table:             smscampaign.ValidColumn,
-			smsmessage.Table:              smsmessage.ValidColumn,
-			savedsearch.Table:             savedsearch.ValidColumn,
-			subscription.Table:            subscription.ValidColumn,
-			territory.Table:               territory.ValidColumn,
-			territorymember.Table:         territorymember.ValidColumn,
-			usagelog.Table:                usagelog.ValidColumn,
-			user.Table:                    user.ValidColumn,
-			userbehavior.Table:            userbehavior.ValidColumn,
-			webhook.Table:                 webhook.ValidColumn,
+			apikey.Table:                     apikey.ValidColumn,
+			affiliate.Table:                  affiliate.ValidColumn,
+			affiliateclick.Table:             affiliateclick.ValidColumn,
+			affiliateconversion.Table:        affiliateconversion.ValidColumn,
+			auditlog.Table:                   auditlog.ValidColumn,
+			crmintegration.Table:             crmintegration.ValidColumn,
+			crmleadsync.Table:                crmleadsync.ValidColumn,
+			crmpushjob.Table:                 crmpushjob.ValidColumn,
+			calllog.Table:                    calllog.ValidColumn,
+			competitormetric.Table:           competitormetric.ValidColumn,
+			competitorprofile.Table:          competitorprofile.ValidColumn,
+			contactattempt.Table:             contactattempt.ValidColumn,
+			emailcampaign.Table:              emailcampaign.ValidColumn,
+			emailcampaignrecipient.Table:     emailcampaignrecipient.ValidColumn,
+			emailsend.Table:                  emailsend.ValidColumn,
+			emailsequence.Table:              emailsequence.ValidColumn,
+			emailsequenceenrollment.Table:    emailsequenceenrollment.ValidColumn,
+			emailsequencesend.Table:          emailsequencesend.ValidColumn,
+			emailsequencestep.Table:          emailsequencestep.ValidColumn,
+			emailsuppression.Table:           emailsuppression.ValidColumn,
+			enrichmentattempt.Table:          enrichmentattempt.ValidColumn,
+			enrichmentbudget.Table:           enrichmentbudget.ValidColumn,
+			experiment.Table:                 experiment.ValidColumn,
+			experimentassignment.Table:       experimentassignment.ValidColumn,
+			export.Table:                     export.ValidColumn,
+			exporttemplate.Table:             exporttemplate.ValidColumn,
+			importjob.Table:                  importjob.ValidColumn,
+			industry.Table:                   industry.ValidColumn,
+			integrationconnection.Table:      integrationconnection.ValidColumn,
+			lead.Table:                       lead.ValidColumn,
+			leadassignment.Table:             leadassignment.ValidColumn,
+			leadchange.Table:                 leadchange.ValidColumn,
+			leadlicense.Table:                leadlicense.ValidColumn,
+			leadnote.Table:                   leadnote.ValidColumn,
+			leadrecommendation.Table:         leadrecommendation.ValidColumn,
+			leadreindexjob.Table:             leadreindexjob.ValidColumn,
+			leadstatushistory.Table:          leadstatushistory.ValidColumn,
+			leadsuppression.Table:            leadsuppression.ValidColumn,
+			leadverification.Table:           leadverification.ValidColumn,
+			marketreport.Table:               marketreport.ValidColumn,
+			organization.Table:               organization.ValidColumn,
+			organizationmember.Table:         organizationmember.ValidColumn,
+			referral.Table:                   referral.ValidColumn,
+			smscampaign.Table:                smscampaign.ValidColumn,
+			smsmessage.Table:                 smsmessage.ValidColumn,
+			savedsearch.Table:                savedsearch.ValidColumn,
+			savedsearchsnapshot.Table:        savedsearchsnapshot.ValidColumn,
+			scheduledexport.Table:            scheduledexport.ValidColumn,
+			subscription.Table:               subscription.ValidColumn,
+			territory.Table:                  territory.ValidColumn,
+			territorymember.Table:            territorymember.ValidColumn,
+			usagedailyaggregate.Table:        usagedailyaggregate.ValidColumn,
+			usagelog.Table:                   usagelog.ValidColumn,
+			user.Table:                       user.ValidColumn,
+			userbehavior.Table:               userbehavior.ValidColumn,
+			usernotificationpreference.Table: usernotificationpreference.ValidColumn,
+			webhook.Table:                    webhook.ValidColumn,
+			webhookdelivery.Table:            webhookdelivery.ValidColumn,
+			zapierdelivery.Table:             zapierdelivery.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -25,6 +25,7 @@ type ExperimentQuery struct {
 	inters          []Interceptor
 	predicates      []predicate.Experiment
 	withAssignments *ExperimentAssignmentQuery
+	modifiers       []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -277,8 +278,9 @@ func (_q *ExperimentQuery) Clone() *ExperimentQuery {
 		predicates:      append([]predicate.Experiment{}, _q.predicates...),
 		withAssignments: _q.withAssignments.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -384,6 +386,9 @@ func (_q *ExperimentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*E
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -436,6 +441,9 @@ func (_q *ExperimentQuery) loadAssignments(ctx context.Context, query *Experimen
 
 func (_q *ExperimentQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -498,6 +506,9 @@ func (_q *ExperimentQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -515,6 +526,12 @@ func (_q *ExperimentQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *ExperimentQuery) Modify(modifiers ...func(s *sql.Selector)) *ExperimentSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // ExperimentGroupBy is the group-by builder for Experiment entities.
 type ExperimentGroupBy struct {
 	selector
@@ -604,3 +621,9 @@ func (_s *ExperimentSelect) sqlScan(ctx context.Context, root *ExperimentQuery,
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *ExperimentSelect) Modify(modifiers ...func(s *sql.Selector)) *ExperimentSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
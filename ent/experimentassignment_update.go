@@ -20,8 +20,9 @@ import (
 // ExperimentAssignmentUpdate is the builder for updating ExperimentAssignment entities.
 type ExperimentAssignmentUpdate struct {
 	config
-	hooks    []Hook
-	mutation *ExperimentAssignmentMutation
+	hooks     []Hook
+	mutation  *ExperimentAssignmentMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the ExperimentAssignmentUpdate builder.
@@ -237,6 +238,12 @@ func (_u *ExperimentAssignmentUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ExperimentAssignmentUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ExperimentAssignmentUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ExperimentAssignmentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -337,6 +344,7 @@ func (_u *ExperimentAssignmentUpdate) sqlSave(ctx context.Context) (_node int, e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{experimentassignment.Label}
@@ -352,9 +360,10 @@ func (_u *ExperimentAssignmentUpdate) sqlSave(ctx context.Context) (_node int, e
 // ExperimentAssignmentUpdateOne is the builder for updating a single ExperimentAssignment entity.
 type ExperimentAssignmentUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *ExperimentAssignmentMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *ExperimentAssignmentMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUserID sets the "user_id" field.
@@ -577,6 +586,12 @@ func (_u *ExperimentAssignmentUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ExperimentAssignmentUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ExperimentAssignmentUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ExperimentAssignmentUpdateOne) sqlSave(ctx context.Context) (_node *ExperimentAssignment, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -694,6 +709,7 @@ func (_u *ExperimentAssignmentUpdateOne) sqlSave(ctx context.Context) (_node *Ex
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &ExperimentAssignment{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -30,6 +30,8 @@ type Export struct {
 	FiltersApplied map[string]interface{} `json:"filters_applied,omitempty"`
 	// Number of leads in export
 	LeadCount int `json:"lead_count,omitempty"`
+	// Matching leads left out of a geojson or kml export for having no coordinates
+	SkippedCount int `json:"skipped_count,omitempty"`
 	// URL to download file
 	FileURL string `json:"file_url,omitempty"`
 	// Local file path
@@ -40,6 +42,42 @@ type Export struct {
 	ErrorMessage string `json:"error_message,omitempty"`
 	// Expiration timestamp (24h after creation)
 	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// Delta exports only include leads updated after this time
+	Since *time.Time `json:"since,omitempty"`
+	// Latest lead updated_at covered by this export (resume point for the next delta)
+	HighWaterMark *time.Time `json:"high_water_mark,omitempty"`
+	// ID of the last lead covered at high_water_mark, so leads updated at the same instant are not skipped
+	HighWaterLeadID *int `json:"high_water_lead_id,omitempty"`
+	// Redaction profile applied to the exported fields (empty for none)
+	RedactionProfile string `json:"redaction_profile,omitempty"`
+	// URL the completed file is POSTed to
+	DeliveryURL string `json:"delivery_url,omitempty"`
+	// Secret used to sign the delivery request (HMAC-SHA256)
+	DeliverySecret string `json:"-"`
+	// Delivery status (null when no delivery_url)
+	DeliveryStatus *export.DeliveryStatus `json:"delivery_status,omitempty"`
+	// Number of delivery attempts made
+	DeliveryAttempts int `json:"delivery_attempts,omitempty"`
+	// When the file was delivered
+	DeliveredAt *time.Time `json:"delivered_at,omitempty"`
+	// Last delivery error
+	DeliveryError string `json:"delivery_error,omitempty"`
+	// Where the completed export is delivered (null for download only)
+	DeliveryMethod *export.DeliveryMethod `json:"delivery_method,omitempty"`
+	// Google spreadsheet the export was written to
+	SpreadsheetID string `json:"spreadsheet_id,omitempty"`
+	// URL of the Google spreadsheet the export was written to
+	SheetURL string `json:"sheet_url,omitempty"`
+	// Non-fatal delivery note, e.g. rows dropped at the Sheets cell limit
+	DeliveryWarning string `json:"delivery_warning,omitempty"`
+	// Compression of the export file (null when uncompressed)
+	Compression *export.Compression `json:"compression,omitempty"`
+	// Size of the generated file in bytes, after compression
+	FileSize *int64 `json:"file_size,omitempty"`
+	// Number of times the file was downloaded
+	DownloadCount int `json:"download_count,omitempty"`
+	// When the file was last downloaded
+	LastDownloadedAt *time.Time `json:"last_downloaded_at,omitempty"`
 	// Creation timestamp
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Last update timestamp
@@ -90,11 +128,11 @@ func (*Export) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case export.FieldFiltersApplied:
 			values[i] = new([]byte)
-		case export.FieldID, export.FieldUserID, export.FieldOrganizationID, export.FieldLeadCount:
+		case export.FieldID, export.FieldUserID, export.FieldOrganizationID, export.FieldLeadCount, export.FieldSkippedCount, export.FieldHighWaterLeadID, export.FieldDeliveryAttempts, export.FieldFileSize, export.FieldDownloadCount:
 			values[i] = new(sql.NullInt64)
-		case export.FieldFormat, export.FieldFileURL, export.FieldFilePath, export.FieldStatus, export.FieldErrorMessage:
+		case export.FieldFormat, export.FieldFileURL, export.FieldFilePath, export.FieldStatus, export.FieldErrorMessage, export.FieldRedactionProfile, export.FieldDeliveryURL, export.FieldDeliverySecret, export.FieldDeliveryStatus, export.FieldDeliveryError, export.FieldDeliveryMethod, export.FieldSpreadsheetID, export.FieldSheetURL, export.FieldDeliveryWarning, export.FieldCompression:
 			values[i] = new(sql.NullString)
-		case export.FieldExpiresAt, export.FieldCreatedAt, export.FieldUpdatedAt:
+		case export.FieldExpiresAt, export.FieldSince, export.FieldHighWaterMark, export.FieldDeliveredAt, export.FieldLastDownloadedAt, export.FieldCreatedAt, export.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -150,6 +188,12 @@ func (_m *Export) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.LeadCount = int(value.Int64)
 			}
+		case export.FieldSkippedCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field skipped_count", values[i])
+			} else if value.Valid {
+				_m.SkippedCount = int(value.Int64)
+			}
 		case export.FieldFileURL:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field file_url", values[i])
@@ -180,6 +224,123 @@ func (_m *Export) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ExpiresAt = value.Time
 			}
+		case export.FieldSince:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field since", values[i])
+			} else if value.Valid {
+				_m.Since = new(time.Time)
+				*_m.Since = value.Time
+			}
+		case export.FieldHighWaterMark:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field high_water_mark", values[i])
+			} else if value.Valid {
+				_m.HighWaterMark = new(time.Time)
+				*_m.HighWaterMark = value.Time
+			}
+		case export.FieldHighWaterLeadID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field high_water_lead_id", values[i])
+			} else if value.Valid {
+				_m.HighWaterLeadID = new(int)
+				*_m.HighWaterLeadID = int(value.Int64)
+			}
+		case export.FieldRedactionProfile:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field redaction_profile", values[i])
+			} else if value.Valid {
+				_m.RedactionProfile = value.String
+			}
+		case export.FieldDeliveryURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field delivery_url", values[i])
+			} else if value.Valid {
+				_m.DeliveryURL = value.String
+			}
+		case export.FieldDeliverySecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field delivery_secret", values[i])
+			} else if value.Valid {
+				_m.DeliverySecret = value.String
+			}
+		case export.FieldDeliveryStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field delivery_status", values[i])
+			} else if value.Valid {
+				_m.DeliveryStatus = new(export.DeliveryStatus)
+				*_m.DeliveryStatus = export.DeliveryStatus(value.String)
+			}
+		case export.FieldDeliveryAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field delivery_attempts", values[i])
+			} else if value.Valid {
+				_m.DeliveryAttempts = int(value.Int64)
+			}
+		case export.FieldDeliveredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delivered_at", values[i])
+			} else if value.Valid {
+				_m.DeliveredAt = new(time.Time)
+				*_m.DeliveredAt = value.Time
+			}
+		case export.FieldDeliveryError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field delivery_error", values[i])
+			} else if value.Valid {
+				_m.DeliveryError = value.String
+			}
+		case export.FieldDeliveryMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field delivery_method", values[i])
+			} else if value.Valid {
+				_m.DeliveryMethod = new(export.DeliveryMethod)
+				*_m.DeliveryMethod = export.DeliveryMethod(value.String)
+			}
+		case export.FieldSpreadsheetID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field spreadsheet_id", values[i])
+			} else if value.Valid {
+				_m.SpreadsheetID = value.String
+			}
+		case export.FieldSheetURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sheet_url", values[i])
+			} else if value.Valid {
+				_m.SheetURL = value.String
+			}
+		case export.FieldDeliveryWarning:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field delivery_warning", values[i])
+			} else if value.Valid {
+				_m.DeliveryWarning = value.String
+			}
+		case export.FieldCompression:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field compression", values[i])
+			} else if value.Valid {
+				_m.Compression = new(export.Compression)
+				*_m.Compression = export.Compression(value.String)
+			}
+		case export.FieldFileSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field file_size", values[i])
+			} else if value.Valid {
+				_m.FileSize = new(int64)
+				*_m.FileSize = value.Int64
+			}
+		case export.FieldDownloadCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field download_count", values[i])
+			} else if value.Valid {
+				_m.DownloadCount = int(value.Int64)
+			}
+		case export.FieldLastDownloadedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_downloaded_at", values[i])
+			} else if value.Valid {
+				_m.LastDownloadedAt = new(time.Time)
+				*_m.LastDownloadedAt = value.Time
+			}
 		case export.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -255,6 +416,9 @@ func (_m *Export) String() string {
 	builder.WriteString("lead_count=")
 	builder.WriteString(fmt.Sprintf("%v", _m.LeadCount))
 	builder.WriteString(", ")
+	builder.WriteString("skipped_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SkippedCount))
+	builder.WriteString(", ")
 	builder.WriteString("file_url=")
 	builder.WriteString(_m.FileURL)
 	builder.WriteString(", ")
@@ -270,6 +434,77 @@ func (_m *Export) String() string {
 	builder.WriteString("expires_at=")
 	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	if v := _m.Since; v != nil {
+		builder.WriteString("since=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.HighWaterMark; v != nil {
+		builder.WriteString("high_water_mark=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.HighWaterLeadID; v != nil {
+		builder.WriteString("high_water_lead_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("redaction_profile=")
+	builder.WriteString(_m.RedactionProfile)
+	builder.WriteString(", ")
+	builder.WriteString("delivery_url=")
+	builder.WriteString(_m.DeliveryURL)
+	builder.WriteString(", ")
+	builder.WriteString("delivery_secret=<sensitive>")
+	builder.WriteString(", ")
+	if v := _m.DeliveryStatus; v != nil {
+		builder.WriteString("delivery_status=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("delivery_attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeliveryAttempts))
+	builder.WriteString(", ")
+	if v := _m.DeliveredAt; v != nil {
+		builder.WriteString("delivered_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("delivery_error=")
+	builder.WriteString(_m.DeliveryError)
+	builder.WriteString(", ")
+	if v := _m.DeliveryMethod; v != nil {
+		builder.WriteString("delivery_method=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("spreadsheet_id=")
+	builder.WriteString(_m.SpreadsheetID)
+	builder.WriteString(", ")
+	builder.WriteString("sheet_url=")
+	builder.WriteString(_m.SheetURL)
+	builder.WriteString(", ")
+	builder.WriteString("delivery_warning=")
+	builder.WriteString(_m.DeliveryWarning)
+	builder.WriteString(", ")
+	if v := _m.Compression; v != nil {
+		builder.WriteString("compression=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.FileSize; v != nil {
+		builder.WriteString("file_size=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("download_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DownloadCount))
+	builder.WriteString(", ")
+	if v := _m.LastDownloadedAt; v != nil {
+		builder.WriteString("last_downloaded_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -25,6 +25,8 @@ const (
 	FieldFiltersApplied = "filters_applied"
 	// FieldLeadCount holds the string denoting the lead_count field in the database.
 	FieldLeadCount = "lead_count"
+	// FieldSkippedCount holds the string denoting the skipped_count field in the database.
+	FieldSkippedCount = "skipped_count"
 	// FieldFileURL holds the string denoting the file_url field in the database.
 	FieldFileURL = "file_url"
 	// FieldFilePath holds the string denoting the file_path field in the database.
@@ -35,6 +37,42 @@ const (
 	FieldErrorMessage = "error_message"
 	// FieldExpiresAt holds the string denoting the expires_at field in the database.
 	FieldExpiresAt = "expires_at"
+	// FieldSince holds the string denoting the since field in the database.
+	FieldSince = "since"
+	// FieldHighWaterMark holds the string denoting the high_water_mark field in the database.
+	FieldHighWaterMark = "high_water_mark"
+	// FieldHighWaterLeadID holds the string denoting the high_water_lead_id field in the database.
+	FieldHighWaterLeadID = "high_water_lead_id"
+	// FieldRedactionProfile holds the string denoting the redaction_profile field in the database.
+	FieldRedactionProfile = "redaction_profile"
+	// FieldDeliveryURL holds the string denoting the delivery_url field in the database.
+	FieldDeliveryURL = "delivery_url"
+	// FieldDeliverySecret holds the string denoting the delivery_secret field in the database.
+	FieldDeliverySecret = "delivery_secret"
+	// FieldDeliveryStatus holds the string denoting the delivery_status field in the database.
+	FieldDeliveryStatus = "delivery_status"
+	// FieldDeliveryAttempts holds the string denoting the delivery_attempts field in the database.
+	FieldDeliveryAttempts = "delivery_attempts"
+	// FieldDeliveredAt holds the string denoting the delivered_at field in the database.
+	FieldDeliveredAt = "delivered_at"
+	// FieldDeliveryError holds the string denoting the delivery_error field in the database.
+	FieldDeliveryError = "delivery_error"
+	// FieldDeliveryMethod holds the string denoting the delivery_method field in the database.
+	FieldDeliveryMethod = "delivery_method"
+	// FieldSpreadsheetID holds the string denoting the spreadsheet_id field in the database.
+	FieldSpreadsheetID = "spreadsheet_id"
+	// FieldSheetURL holds the string denoting the sheet_url field in the database.
+	FieldSheetURL = "sheet_url"
+	// FieldDeliveryWarning holds the string denoting the delivery_warning field in the database.
+	FieldDeliveryWarning = "delivery_warning"
+	// FieldCompression holds the string denoting the compression field in the database.
+	FieldCompression = "compression"
+	// FieldFileSize holds the string denoting the file_size field in the database.
+	FieldFileSize = "file_size"
+	// FieldDownloadCount holds the string denoting the download_count field in the database.
+	FieldDownloadCount = "download_count"
+	// FieldLastDownloadedAt holds the string denoting the last_downloaded_at field in the database.
+	FieldLastDownloadedAt = "last_downloaded_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -69,11 +107,30 @@ var Columns = []string{
 	FieldFormat,
 	FieldFiltersApplied,
 	FieldLeadCount,
+	FieldSkippedCount,
 	FieldFileURL,
 	FieldFilePath,
 	FieldStatus,
 	FieldErrorMessage,
 	FieldExpiresAt,
+	FieldSince,
+	FieldHighWaterMark,
+	FieldHighWaterLeadID,
+	FieldRedactionProfile,
+	FieldDeliveryURL,
+	FieldDeliverySecret,
+	FieldDeliveryStatus,
+	FieldDeliveryAttempts,
+	FieldDeliveredAt,
+	FieldDeliveryError,
+	FieldDeliveryMethod,
+	FieldSpreadsheetID,
+	FieldSheetURL,
+	FieldDeliveryWarning,
+	FieldCompression,
+	FieldFileSize,
+	FieldDownloadCount,
+	FieldLastDownloadedAt,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -93,6 +150,24 @@ var (
 	UserIDValidator func(int) error
 	// LeadCountValidator is a validator for the "lead_count" field. It is called by the builders before save.
 	LeadCountValidator func(int) error
+	// DefaultSkippedCount holds the default value on creation for the "skipped_count" field.
+	DefaultSkippedCount int
+	// SkippedCountValidator is a validator for the "skipped_count" field. It is called by the builders before save.
+	SkippedCountValidator func(int) error
+	// RedactionProfileValidator is a validator for the "redaction_profile" field. It is called by the builders before save.
+	RedactionProfileValidator func(string) error
+	// DeliveryURLValidator is a validator for the "delivery_url" field. It is called by the builders before save.
+	DeliveryURLValidator func(string) error
+	// DefaultDeliveryAttempts holds the default value on creation for the "delivery_attempts" field.
+	DefaultDeliveryAttempts int
+	// DeliveryAttemptsValidator is a validator for the "delivery_attempts" field. It is called by the builders before save.
+	DeliveryAttemptsValidator func(int) error
+	// SheetURLValidator is a validator for the "sheet_url" field. It is called by the builders before save.
+	SheetURLValidator func(string) error
+	// DefaultDownloadCount holds the default value on creation for the "download_count" field.
+	DefaultDownloadCount int
+	// DownloadCountValidator is a validator for the "download_count" field. It is called by the builders before save.
+	DownloadCountValidator func(int) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -106,8 +181,11 @@ type Format string
 
 // Format values.
 const (
-	FormatCsv   Format = "csv"
-	FormatExcel Format = "excel"
+	FormatCsv     Format = "csv"
+	FormatExcel   Format = "excel"
+	FormatVcard   Format = "vcard"
+	FormatGeojson Format = "geojson"
+	FormatKml     Format = "kml"
 )
 
 func (f Format) String() string {
@@ -117,7 +195,7 @@ func (f Format) String() string {
 // FormatValidator is a validator for the "format" field enum values. It is called by the builders before save.
 func FormatValidator(f Format) error {
 	switch f {
-	case FormatCsv, FormatExcel:
+	case FormatCsv, FormatExcel, FormatVcard, FormatGeojson, FormatKml:
 		return nil
 	default:
 		return fmt.Errorf("export: invalid enum value for format field: %q", f)
@@ -153,6 +231,76 @@ func StatusValidator(s Status) error {
 	}
 }
 
+// DeliveryStatus defines the type for the "delivery_status" enum field.
+type DeliveryStatus string
+
+// DeliveryStatus values.
+const (
+	DeliveryStatusPending   DeliveryStatus = "pending"
+	DeliveryStatusDelivered DeliveryStatus = "delivered"
+	DeliveryStatusFailed    DeliveryStatus = "failed"
+)
+
+func (ds DeliveryStatus) String() string {
+	return string(ds)
+}
+
+// DeliveryStatusValidator is a validator for the "delivery_status" field enum values. It is called by the builders before save.
+func DeliveryStatusValidator(ds DeliveryStatus) error {
+	switch ds {
+	case DeliveryStatusPending, DeliveryStatusDelivered, DeliveryStatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("export: invalid enum value for delivery_status field: %q", ds)
+	}
+}
+
+// DeliveryMethod defines the type for the "delivery_method" enum field.
+type DeliveryMethod string
+
+// DeliveryMethod values.
+const (
+	DeliveryMethodURL          DeliveryMethod = "url"
+	DeliveryMethodGoogleSheets DeliveryMethod = "google_sheets"
+)
+
+func (dm DeliveryMethod) String() string {
+	return string(dm)
+}
+
+// DeliveryMethodValidator is a validator for the "delivery_method" field enum values. It is called by the builders before save.
+func DeliveryMethodValidator(dm DeliveryMethod) error {
+	switch dm {
+	case DeliveryMethodURL, DeliveryMethodGoogleSheets:
+		return nil
+	default:
+		return fmt.Errorf("export: invalid enum value for delivery_method field: %q", dm)
+	}
+}
+
+// Compression defines the type for the "compression" enum field.
+type Compression string
+
+// Compression values.
+const (
+	CompressionGzip Compression = "gzip"
+	CompressionZip  Compression = "zip"
+)
+
+func (c Compression) String() string {
+	return string(c)
+}
+
+// CompressionValidator is a validator for the "compression" field enum values. It is called by the builders before save.
+func CompressionValidator(c Compression) error {
+	switch c {
+	case CompressionGzip, CompressionZip:
+		return nil
+	default:
+		return fmt.Errorf("export: invalid enum value for compression field: %q", c)
+	}
+}
+
 // OrderOption defines the ordering options for the Export queries.
 type OrderOption func(*sql.Selector)
 
@@ -181,6 +329,11 @@ func ByLeadCount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLeadCount, opts...).ToFunc()
 }
 
+// BySkippedCount orders the results by the skipped_count field.
+func BySkippedCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSkippedCount, opts...).ToFunc()
+}
+
 // ByFileURL orders the results by the file_url field.
 func ByFileURL(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldFileURL, opts...).ToFunc()
@@ -206,6 +359,96 @@ func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
 }
 
+// BySince orders the results by the since field.
+func BySince(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSince, opts...).ToFunc()
+}
+
+// ByHighWaterMark orders the results by the high_water_mark field.
+func ByHighWaterMark(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHighWaterMark, opts...).ToFunc()
+}
+
+// ByHighWaterLeadID orders the results by the high_water_lead_id field.
+func ByHighWaterLeadID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHighWaterLeadID, opts...).ToFunc()
+}
+
+// ByRedactionProfile orders the results by the redaction_profile field.
+func ByRedactionProfile(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRedactionProfile, opts...).ToFunc()
+}
+
+// ByDeliveryURL orders the results by the delivery_url field.
+func ByDeliveryURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveryURL, opts...).ToFunc()
+}
+
+// ByDeliverySecret orders the results by the delivery_secret field.
+func ByDeliverySecret(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliverySecret, opts...).ToFunc()
+}
+
+// ByDeliveryStatus orders the results by the delivery_status field.
+func ByDeliveryStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveryStatus, opts...).ToFunc()
+}
+
+// ByDeliveryAttempts orders the results by the delivery_attempts field.
+func ByDeliveryAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveryAttempts, opts...).ToFunc()
+}
+
+// ByDeliveredAt orders the results by the delivered_at field.
+func ByDeliveredAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveredAt, opts...).ToFunc()
+}
+
+// ByDeliveryError orders the results by the delivery_error field.
+func ByDeliveryError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveryError, opts...).ToFunc()
+}
+
+// ByDeliveryMethod orders the results by the delivery_method field.
+func ByDeliveryMethod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveryMethod, opts...).ToFunc()
+}
+
+// BySpreadsheetID orders the results by the spreadsheet_id field.
+func BySpreadsheetID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpreadsheetID, opts...).ToFunc()
+}
+
+// BySheetURL orders the results by the sheet_url field.
+func BySheetURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSheetURL, opts...).ToFunc()
+}
+
+// ByDeliveryWarning orders the results by the delivery_warning field.
+func ByDeliveryWarning(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveryWarning, opts...).ToFunc()
+}
+
+// ByCompression orders the results by the compression field.
+func ByCompression(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompression, opts...).ToFunc()
+}
+
+// ByFileSize orders the results by the file_size field.
+func ByFileSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFileSize, opts...).ToFunc()
+}
+
+// ByDownloadCount orders the results by the download_count field.
+func ByDownloadCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDownloadCount, opts...).ToFunc()
+}
+
+// ByLastDownloadedAt orders the results by the last_downloaded_at field.
+func ByLastDownloadedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastDownloadedAt, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -70,6 +70,11 @@ func LeadCount(v int) predicate.Export {
 	return predicate.Export(sql.FieldEQ(FieldLeadCount, v))
 }
 
+// SkippedCount applies equality check predicate on the "skipped_count" field. It's identical to SkippedCountEQ.
+func SkippedCount(v int) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldSkippedCount, v))
+}
+
 // FileURL applies equality check predicate on the "file_url" field. It's identical to FileURLEQ.
 func FileURL(v string) predicate.Export {
 	return predicate.Export(sql.FieldEQ(FieldFileURL, v))
@@ -90,6 +95,81 @@ func ExpiresAt(v time.Time) predicate.Export {
 	return predicate.Export(sql.FieldEQ(FieldExpiresAt, v))
 }
 
+// Since applies equality check predicate on the "since" field. It's identical to SinceEQ.
+func Since(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldSince, v))
+}
+
+// HighWaterMark applies equality check predicate on the "high_water_mark" field. It's identical to HighWaterMarkEQ.
+func HighWaterMark(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldHighWaterMark, v))
+}
+
+// HighWaterLeadID applies equality check predicate on the "high_water_lead_id" field. It's identical to HighWaterLeadIDEQ.
+func HighWaterLeadID(v int) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldHighWaterLeadID, v))
+}
+
+// RedactionProfile applies equality check predicate on the "redaction_profile" field. It's identical to RedactionProfileEQ.
+func RedactionProfile(v string) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldRedactionProfile, v))
+}
+
+// DeliveryURL applies equality check predicate on the "delivery_url" field. It's identical to DeliveryURLEQ.
+func DeliveryURL(v string) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldDeliveryURL, v))
+}
+
+// DeliverySecret applies equality check predicate on the "delivery_secret" field. It's identical to DeliverySecretEQ.
+func DeliverySecret(v string) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldDeliverySecret, v))
+}
+
+// DeliveryAttempts applies equality check predicate on the "delivery_attempts" field. It's identical to DeliveryAttemptsEQ.
+func DeliveryAttempts(v int) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldDeliveryAttempts, v))
+}
+
+// DeliveredAt applies equality check predicate on the "delivered_at" field. It's identical to DeliveredAtEQ.
+func DeliveredAt(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldDeliveredAt, v))
+}
+
+// DeliveryError applies equality check predicate on the "delivery_error" field. It's identical to DeliveryErrorEQ.
+func DeliveryError(v string) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldDeliveryError, v))
+}
+
+// SpreadsheetID applies equality check predicate on the "spreadsheet_id" field. It's identical to SpreadsheetIDEQ.
+func SpreadsheetID(v string) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldSpreadsheetID, v))
+}
+
+// SheetURL applies equality check predicate on the "sheet_url" field. It's identical to SheetURLEQ.
+func SheetURL(v string) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldSheetURL, v))
+}
+
+// DeliveryWarning applies equality check predicate on the "delivery_warning" field. It's identical to DeliveryWarningEQ.
+func DeliveryWarning(v string) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldDeliveryWarning, v))
+}
+
+// FileSize applies equality check predicate on the "file_size" field. It's identical to FileSizeEQ.
+func FileSize(v int64) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldFileSize, v))
+}
+
+// DownloadCount applies equality check predicate on the "download_count" field. It's identical to DownloadCountEQ.
+func DownloadCount(v int) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldDownloadCount, v))
+}
+
+// LastDownloadedAt applies equality check predicate on the "last_downloaded_at" field. It's identical to LastDownloadedAtEQ.
+func LastDownloadedAt(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldLastDownloadedAt, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Export {
 	return predicate.Export(sql.FieldEQ(FieldCreatedAt, v))
@@ -220,6 +300,46 @@ func LeadCountLTE(v int) predicate.Export {
 	return predicate.Export(sql.FieldLTE(FieldLeadCount, v))
 }
 
+// SkippedCountEQ applies the EQ predicate on the "skipped_count" field.
+func SkippedCountEQ(v int) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldSkippedCount, v))
+}
+
+// SkippedCountNEQ applies the NEQ predicate on the "skipped_count" field.
+func SkippedCountNEQ(v int) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldSkippedCount, v))
+}
+
+// SkippedCountIn applies the In predicate on the "skipped_count" field.
+func SkippedCountIn(vs ...int) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldSkippedCount, vs...))
+}
+
+// SkippedCountNotIn applies the NotIn predicate on the "skipped_count" field.
+func SkippedCountNotIn(vs ...int) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldSkippedCount, vs...))
+}
+
+// SkippedCountGT applies the GT predicate on the "skipped_count" field.
+func SkippedCountGT(v int) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldSkippedCount, v))
+}
+
+// SkippedCountGTE applies the GTE predicate on the "skipped_count" field.
+func SkippedCountGTE(v int) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldSkippedCount, v))
+}
+
+// SkippedCountLT applies the LT predicate on the "skipped_count" field.
+func SkippedCountLT(v int) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldSkippedCount, v))
+}
+
+// SkippedCountLTE applies the LTE predicate on the "skipped_count" field.
+func SkippedCountLTE(v int) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldSkippedCount, v))
+}
+
 // FileURLEQ applies the EQ predicate on the "file_url" field.
 func FileURLEQ(v string) predicate.Export {
 	return predicate.Export(sql.FieldEQ(FieldFileURL, v))
@@ -515,6 +635,1001 @@ func ExpiresAtNotNil() predicate.Export {
 	return predicate.Export(sql.FieldNotNull(FieldExpiresAt))
 }
 
+// SinceEQ applies the EQ predicate on the "since" field.
+func SinceEQ(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldSince, v))
+}
+
+// SinceNEQ applies the NEQ predicate on the "since" field.
+func SinceNEQ(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldSince, v))
+}
+
+// SinceIn applies the In predicate on the "since" field.
+func SinceIn(vs ...time.Time) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldSince, vs...))
+}
+
+// SinceNotIn applies the NotIn predicate on the "since" field.
+func SinceNotIn(vs ...time.Time) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldSince, vs...))
+}
+
+// SinceGT applies the GT predicate on the "since" field.
+func SinceGT(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldSince, v))
+}
+
+// SinceGTE applies the GTE predicate on the "since" field.
+func SinceGTE(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldSince, v))
+}
+
+// SinceLT applies the LT predicate on the "since" field.
+func SinceLT(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldSince, v))
+}
+
+// SinceLTE applies the LTE predicate on the "since" field.
+func SinceLTE(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldSince, v))
+}
+
+// SinceIsNil applies the IsNil predicate on the "since" field.
+func SinceIsNil() predicate.Export {
+	return predicate.Export(sql.FieldIsNull(FieldSince))
+}
+
+// SinceNotNil applies the NotNil predicate on the "since" field.
+func SinceNotNil() predicate.Export {
+	return predicate.Export(sql.FieldNotNull(FieldSince))
+}
+
+// HighWaterMarkEQ applies the EQ predicate on the "high_water_mark" field.
+func HighWaterMarkEQ(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldHighWaterMark, v))
+}
+
+// HighWaterMarkNEQ applies the NEQ predicate on the "high_water_mark" field.
+func HighWaterMarkNEQ(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldHighWaterMark, v))
+}
+
+// HighWaterMarkIn applies the In predicate on the "high_water_mark" field.
+func HighWaterMarkIn(vs ...time.Time) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldHighWaterMark, vs...))
+}
+
+// HighWaterMarkNotIn applies the NotIn predicate on the "high_water_mark" field.
+func HighWaterMarkNotIn(vs ...time.Time) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldHighWaterMark, vs...))
+}
+
+// HighWaterMarkGT applies the GT predicate on the "high_water_mark" field.
+func HighWaterMarkGT(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldHighWaterMark, v))
+}
+
+// HighWaterMarkGTE applies the GTE predicate on the "high_water_mark" field.
+func HighWaterMarkGTE(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldHighWaterMark, v))
+}
+
+// HighWaterMarkLT applies the LT predicate on the "high_water_mark" field.
+func HighWaterMarkLT(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldHighWaterMark, v))
+}
+
+// HighWaterMarkLTE applies the LTE predicate on the "high_water_mark" field.
+func HighWaterMarkLTE(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldHighWaterMark, v))
+}
+
+// HighWaterMarkIsNil applies the IsNil predicate on the "high_water_mark" field.
+func HighWaterMarkIsNil() predicate.Export {
+	return predicate.Export(sql.FieldIsNull(FieldHighWaterMark))
+}
+
+// HighWaterMarkNotNil applies the NotNil predicate on the "high_water_mark" field.
+func HighWaterMarkNotNil() predicate.Export {
+	return predicate.Export(sql.FieldNotNull(FieldHighWaterMark))
+}
+
+// HighWaterLeadIDEQ applies the EQ predicate on the "high_water_lead_id" field.
+func HighWaterLeadIDEQ(v int) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldHighWaterLeadID, v))
+}
+
+// HighWaterLeadIDNEQ applies the NEQ predicate on the "high_water_lead_id" field.
+func HighWaterLeadIDNEQ(v int) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldHighWaterLeadID, v))
+}
+
+// HighWaterLeadIDIn applies the In predicate on the "high_water_lead_id" field.
+func HighWaterLeadIDIn(vs ...int) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldHighWaterLeadID, vs...))
+}
+
+// HighWaterLeadIDNotIn applies the NotIn predicate on the "high_water_lead_id" field.
+func HighWaterLeadIDNotIn(vs ...int) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldHighWaterLeadID, vs...))
+}
+
+// HighWaterLeadIDGT applies the GT predicate on the "high_water_lead_id" field.
+func HighWaterLeadIDGT(v int) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldHighWaterLeadID, v))
+}
+
+// HighWaterLeadIDGTE applies the GTE predicate on the "high_water_lead_id" field.
+func HighWaterLeadIDGTE(v int) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldHighWaterLeadID, v))
+}
+
+// HighWaterLeadIDLT applies the LT predicate on the "high_water_lead_id" field.
+func HighWaterLeadIDLT(v int) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldHighWaterLeadID, v))
+}
+
+// HighWaterLeadIDLTE applies the LTE predicate on the "high_water_lead_id" field.
+func HighWaterLeadIDLTE(v int) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldHighWaterLeadID, v))
+}
+
+// HighWaterLeadIDIsNil applies the IsNil predicate on the "high_water_lead_id" field.
+func HighWaterLeadIDIsNil() predicate.Export {
+	return predicate.Export(sql.FieldIsNull(FieldHighWaterLeadID))
+}
+
+// HighWaterLeadIDNotNil applies the NotNil predicate on the "high_water_lead_id" field.
+func HighWaterLeadIDNotNil() predicate.Export {
+	return predicate.Export(sql.FieldNotNull(FieldHighWaterLeadID))
+}
+
+// RedactionProfileEQ applies the EQ predicate on the "redaction_profile" field.
+func RedactionProfileEQ(v string) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldRedactionProfile, v))
+}
+
+// RedactionProfileNEQ applies the NEQ predicate on the "redaction_profile" field.
+func RedactionProfileNEQ(v string) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldRedactionProfile, v))
+}
+
+// RedactionProfileIn applies the In predicate on the "redaction_profile" field.
+func RedactionProfileIn(vs ...string) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldRedactionProfile, vs...))
+}
+
+// RedactionProfileNotIn applies the NotIn predicate on the "redaction_profile" field.
+func RedactionProfileNotIn(vs ...string) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldRedactionProfile, vs...))
+}
+
+// RedactionProfileGT applies the GT predicate on the "redaction_profile" field.
+func RedactionProfileGT(v string) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldRedactionProfile, v))
+}
+
+// RedactionProfileGTE applies the GTE predicate on the "redaction_profile" field.
+func RedactionProfileGTE(v string) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldRedactionProfile, v))
+}
+
+// RedactionProfileLT applies the LT predicate on the "redaction_profile" field.
+func RedactionProfileLT(v string) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldRedactionProfile, v))
+}
+
+// RedactionProfileLTE applies the LTE predicate on the "redaction_profile" field.
+func RedactionProfileLTE(v string) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldRedactionProfile, v))
+}
+
+// RedactionProfileContains applies the Contains predicate on the "redaction_profile" field.
+func RedactionProfileContains(v string) predicate.Export {
+	return predicate.Export(sql.FieldContains(FieldRedactionProfile, v))
+}
+
+// RedactionProfileHasPrefix applies the HasPrefix predicate on the "redaction_profile" field.
+func RedactionProfileHasPrefix(v string) predicate.Export {
+	return predicate.Export(sql.FieldHasPrefix(FieldRedactionProfile, v))
+}
+
+// RedactionProfileHasSuffix applies the HasSuffix predicate on the "redaction_profile" field.
+func RedactionProfileHasSuffix(v string) predicate.Export {
+	return predicate.Export(sql.FieldHasSuffix(FieldRedactionProfile, v))
+}
+
+// RedactionProfileIsNil applies the IsNil predicate on the "redaction_profile" field.
+func RedactionProfileIsNil() predicate.Export {
+	return predicate.Export(sql.FieldIsNull(FieldRedactionProfile))
+}
+
+// RedactionProfileNotNil applies the NotNil predicate on the "redaction_profile" field.
+func RedactionProfileNotNil() predicate.Export {
+	return predicate.Export(sql.FieldNotNull(FieldRedactionProfile))
+}
+
+// RedactionProfileEqualFold applies the EqualFold predicate on the "redaction_profile" field.
+func RedactionProfileEqualFold(v string) predicate.Export {
+	return predicate.Export(sql.FieldEqualFold(FieldRedactionProfile, v))
+}
+
+// RedactionProfileContainsFold applies the ContainsFold predicate on the "redaction_profile" field.
+func RedactionProfileContainsFold(v string) predicate.Export {
+	return predicate.Export(sql.FieldContainsFold(FieldRedactionProfile, v))
+}
+
+// DeliveryURLEQ applies the EQ predicate on the "delivery_url" field.
+func DeliveryURLEQ(v string) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldDeliveryURL, v))
+}
+
+// DeliveryURLNEQ applies the NEQ predicate on the "delivery_url" field.
+func DeliveryURLNEQ(v string) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldDeliveryURL, v))
+}
+
+// DeliveryURLIn applies the In predicate on the "delivery_url" field.
+func DeliveryURLIn(vs ...string) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldDeliveryURL, vs...))
+}
+
+// DeliveryURLNotIn applies the NotIn predicate on the "delivery_url" field.
+func DeliveryURLNotIn(vs ...string) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldDeliveryURL, vs...))
+}
+
+// DeliveryURLGT applies the GT predicate on the "delivery_url" field.
+func DeliveryURLGT(v string) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldDeliveryURL, v))
+}
+
+// DeliveryURLGTE applies the GTE predicate on the "delivery_url" field.
+func DeliveryURLGTE(v string) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldDeliveryURL, v))
+}
+
+// DeliveryURLLT applies the LT predicate on the "delivery_url" field.
+func DeliveryURLLT(v string) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldDeliveryURL, v))
+}
+
+// DeliveryURLLTE applies the LTE predicate on the "delivery_url" field.
+func DeliveryURLLTE(v string) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldDeliveryURL, v))
+}
+
+// DeliveryURLContains applies the Contains predicate on the "delivery_url" field.
+func DeliveryURLContains(v string) predicate.Export {
+	return predicate.Export(sql.FieldContains(FieldDeliveryURL, v))
+}
+
+// DeliveryURLHasPrefix applies the HasPrefix predicate on the "delivery_url" field.
+func DeliveryURLHasPrefix(v string) predicate.Export {
+	return predicate.Export(sql.FieldHasPrefix(FieldDeliveryURL, v))
+}
+
+// DeliveryURLHasSuffix applies the HasSuffix predicate on the "delivery_url" field.
+func DeliveryURLHasSuffix(v string) predicate.Export {
+	return predicate.Export(sql.FieldHasSuffix(FieldDeliveryURL, v))
+}
+
+// DeliveryURLIsNil applies the IsNil predicate on the "delivery_url" field.
+func DeliveryURLIsNil() predicate.Export {
+	return predicate.Export(sql.FieldIsNull(FieldDeliveryURL))
+}
+
+// DeliveryURLNotNil applies the NotNil predicate on the "delivery_url" field.
+func DeliveryURLNotNil() predicate.Export {
+	return predicate.Export(sql.FieldNotNull(FieldDeliveryURL))
+}
+
+// DeliveryURLEqualFold applies the EqualFold predicate on the "delivery_url" field.
+func DeliveryURLEqualFold(v string) predicate.Export {
+	return predicate.Export(sql.FieldEqualFold(FieldDeliveryURL, v))
+}
+
+// DeliveryURLContainsFold applies the ContainsFold predicate on the "delivery_url" field.
+func DeliveryURLContainsFold(v string) predicate.Export {
+	return predicate.Export(sql.FieldContainsFold(FieldDeliveryURL, v))
+}
+
+// DeliverySecretEQ applies the EQ predicate on the "delivery_secret" field.
+func DeliverySecretEQ(v string) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldDeliverySecret, v))
+}
+
+// DeliverySecretNEQ applies the NEQ predicate on the "delivery_secret" field.
+func DeliverySecretNEQ(v string) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldDeliverySecret, v))
+}
+
+// DeliverySecretIn applies the In predicate on the "delivery_secret" field.
+func DeliverySecretIn(vs ...string) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldDeliverySecret, vs...))
+}
+
+// DeliverySecretNotIn applies the NotIn predicate on the "delivery_secret" field.
+func DeliverySecretNotIn(vs ...string) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldDeliverySecret, vs...))
+}
+
+// DeliverySecretGT applies the GT predicate on the "delivery_secret" field.
+func DeliverySecretGT(v string) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldDeliverySecret, v))
+}
+
+// DeliverySecretGTE applies the GTE predicate on the "delivery_secret" field.
+func DeliverySecretGTE(v string) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldDeliverySecret, v))
+}
+
+// DeliverySecretLT applies the LT predicate on the "delivery_secret" field.
+func DeliverySecretLT(v string) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldDeliverySecret, v))
+}
+
+// DeliverySecretLTE applies the LTE predicate on the "delivery_secret" field.
+func DeliverySecretLTE(v string) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldDeliverySecret, v))
+}
+
+// DeliverySecretContains applies the Contains predicate on the "delivery_secret" field.
+func DeliverySecretContains(v string) predicate.Export {
+	return predicate.Export(sql.FieldContains(FieldDeliverySecret, v))
+}
+
+// DeliverySecretHasPrefix applies the HasPrefix predicate on the "delivery_secret" field.
+func DeliverySecretHasPrefix(v string) predicate.Export {
+	return predicate.Export(sql.FieldHasPrefix(FieldDeliverySecret, v))
+}
+
+// DeliverySecretHasSuffix applies the HasSuffix predicate on the "delivery_secret" field.
+func DeliverySecretHasSuffix(v string) predicate.Export {
+	return predicate.Export(sql.FieldHasSuffix(FieldDeliverySecret, v))
+}
+
+// DeliverySecretIsNil applies the IsNil predicate on the "delivery_secret" field.
+func DeliverySecretIsNil() predicate.Export {
+	return predicate.Export(sql.FieldIsNull(FieldDeliverySecret))
+}
+
+// DeliverySecretNotNil applies the NotNil predicate on the "delivery_secret" field.
+func DeliverySecretNotNil() predicate.Export {
+	return predicate.Export(sql.FieldNotNull(FieldDeliverySecret))
+}
+
+// DeliverySecretEqualFold applies the EqualFold predicate on the "delivery_secret" field.
+func DeliverySecretEqualFold(v string) predicate.Export {
+	return predicate.Export(sql.FieldEqualFold(FieldDeliverySecret, v))
+}
+
+// DeliverySecretContainsFold applies the ContainsFold predicate on the "delivery_secret" field.
+func DeliverySecretContainsFold(v string) predicate.Export {
+	return predicate.Export(sql.FieldContainsFold(FieldDeliverySecret, v))
+}
+
+// DeliveryStatusEQ applies the EQ predicate on the "delivery_status" field.
+func DeliveryStatusEQ(v DeliveryStatus) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldDeliveryStatus, v))
+}
+
+// DeliveryStatusNEQ applies the NEQ predicate on the "delivery_status" field.
+func DeliveryStatusNEQ(v DeliveryStatus) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldDeliveryStatus, v))
+}
+
+// DeliveryStatusIn applies the In predicate on the "delivery_status" field.
+func DeliveryStatusIn(vs ...DeliveryStatus) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldDeliveryStatus, vs...))
+}
+
+// DeliveryStatusNotIn applies the NotIn predicate on the "delivery_status" field.
+func DeliveryStatusNotIn(vs ...DeliveryStatus) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldDeliveryStatus, vs...))
+}
+
+// DeliveryStatusIsNil applies the IsNil predicate on the "delivery_status" field.
+func DeliveryStatusIsNil() predicate.Export {
+	return predicate.Export(sql.FieldIsNull(FieldDeliveryStatus))
+}
+
+// DeliveryStatusNotNil applies the NotNil predicate on the "delivery_status" field.
+func DeliveryStatusNotNil() predicate.Export {
+	return predicate.Export(sql.FieldNotNull(FieldDeliveryStatus))
+}
+
+// DeliveryAttemptsEQ applies the EQ predicate on the "delivery_attempts" field.
+func DeliveryAttemptsEQ(v int) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldDeliveryAttempts, v))
+}
+
+// DeliveryAttemptsNEQ applies the NEQ predicate on the "delivery_attempts" field.
+func DeliveryAttemptsNEQ(v int) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldDeliveryAttempts, v))
+}
+
+// DeliveryAttemptsIn applies the In predicate on the "delivery_attempts" field.
+func DeliveryAttemptsIn(vs ...int) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldDeliveryAttempts, vs...))
+}
+
+// DeliveryAttemptsNotIn applies the NotIn predicate on the "delivery_attempts" field.
+func DeliveryAttemptsNotIn(vs ...int) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldDeliveryAttempts, vs...))
+}
+
+// DeliveryAttemptsGT applies the GT predicate on the "delivery_attempts" field.
+func DeliveryAttemptsGT(v int) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldDeliveryAttempts, v))
+}
+
+// DeliveryAttemptsGTE applies the GTE predicate on the "delivery_attempts" field.
+func DeliveryAttemptsGTE(v int) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldDeliveryAttempts, v))
+}
+
+// DeliveryAttemptsLT applies the LT predicate on the "delivery_attempts" field.
+func DeliveryAttemptsLT(v int) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldDeliveryAttempts, v))
+}
+
+// DeliveryAttemptsLTE applies the LTE predicate on the "delivery_attempts" field.
+func DeliveryAttemptsLTE(v int) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldDeliveryAttempts, v))
+}
+
+// DeliveredAtEQ applies the EQ predicate on the "delivered_at" field.
+func DeliveredAtEQ(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldDeliveredAt, v))
+}
+
+// DeliveredAtNEQ applies the NEQ predicate on the "delivered_at" field.
+func DeliveredAtNEQ(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldDeliveredAt, v))
+}
+
+// DeliveredAtIn applies the In predicate on the "delivered_at" field.
+func DeliveredAtIn(vs ...time.Time) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldDeliveredAt, vs...))
+}
+
+// DeliveredAtNotIn applies the NotIn predicate on the "delivered_at" field.
+func DeliveredAtNotIn(vs ...time.Time) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldDeliveredAt, vs...))
+}
+
+// DeliveredAtGT applies the GT predicate on the "delivered_at" field.
+func DeliveredAtGT(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldDeliveredAt, v))
+}
+
+// DeliveredAtGTE applies the GTE predicate on the "delivered_at" field.
+func DeliveredAtGTE(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldDeliveredAt, v))
+}
+
+// DeliveredAtLT applies the LT predicate on the "delivered_at" field.
+func DeliveredAtLT(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldDeliveredAt, v))
+}
+
+// DeliveredAtLTE applies the LTE predicate on the "delivered_at" field.
+func DeliveredAtLTE(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldDeliveredAt, v))
+}
+
+// DeliveredAtIsNil applies the IsNil predicate on the "delivered_at" field.
+func DeliveredAtIsNil() predicate.Export {
+	return predicate.Export(sql.FieldIsNull(FieldDeliveredAt))
+}
+
+// DeliveredAtNotNil applies the NotNil predicate on the "delivered_at" field.
+func DeliveredAtNotNil() predicate.Export {
+	return predicate.Export(sql.FieldNotNull(FieldDeliveredAt))
+}
+
+// DeliveryErrorEQ applies the EQ predicate on the "delivery_error" field.
+func DeliveryErrorEQ(v string) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldDeliveryError, v))
+}
+
+// DeliveryErrorNEQ applies the NEQ predicate on the "delivery_error" field.
+func DeliveryErrorNEQ(v string) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldDeliveryError, v))
+}
+
+// DeliveryErrorIn applies the In predicate on the "delivery_error" field.
+func DeliveryErrorIn(vs ...string) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldDeliveryError, vs...))
+}
+
+// DeliveryErrorNotIn applies the NotIn predicate on the "delivery_error" field.
+func DeliveryErrorNotIn(vs ...string) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldDeliveryError, vs...))
+}
+
+// DeliveryErrorGT applies the GT predicate on the "delivery_error" field.
+func DeliveryErrorGT(v string) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldDeliveryError, v))
+}
+
+// DeliveryErrorGTE applies the GTE predicate on the "delivery_error" field.
+func DeliveryErrorGTE(v string) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldDeliveryError, v))
+}
+
+// DeliveryErrorLT applies the LT predicate on the "delivery_error" field.
+func DeliveryErrorLT(v string) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldDeliveryError, v))
+}
+
+// DeliveryErrorLTE applies the LTE predicate on the "delivery_error" field.
+func DeliveryErrorLTE(v string) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldDeliveryError, v))
+}
+
+// DeliveryErrorContains applies the Contains predicate on the "delivery_error" field.
+func DeliveryErrorContains(v string) predicate.Export {
+	return predicate.Export(sql.FieldContains(FieldDeliveryError, v))
+}
+
+// DeliveryErrorHasPrefix applies the HasPrefix predicate on the "delivery_error" field.
+func DeliveryErrorHasPrefix(v string) predicate.Export {
+	return predicate.Export(sql.FieldHasPrefix(FieldDeliveryError, v))
+}
+
+// DeliveryErrorHasSuffix applies the HasSuffix predicate on the "delivery_error" field.
+func DeliveryErrorHasSuffix(v string) predicate.Export {
+	return predicate.Export(sql.FieldHasSuffix(FieldDeliveryError, v))
+}
+
+// DeliveryErrorIsNil applies the IsNil predicate on the "delivery_error" field.
+func DeliveryErrorIsNil() predicate.Export {
+	return predicate.Export(sql.FieldIsNull(FieldDeliveryError))
+}
+
+// DeliveryErrorNotNil applies the NotNil predicate on the "delivery_error" field.
+func DeliveryErrorNotNil() predicate.Export {
+	return predicate.Export(sql.FieldNotNull(FieldDeliveryError))
+}
+
+// DeliveryErrorEqualFold applies the EqualFold predicate on the "delivery_error" field.
+func DeliveryErrorEqualFold(v string) predicate.Export {
+	return predicate.Export(sql.FieldEqualFold(FieldDeliveryError, v))
+}
+
+// DeliveryErrorContainsFold applies the ContainsFold predicate on the "delivery_error" field.
+func DeliveryErrorContainsFold(v string) predicate.Export {
+	return predicate.Export(sql.FieldContainsFold(FieldDeliveryError, v))
+}
+
+// DeliveryMethodEQ applies the EQ predicate on the "delivery_method" field.
+func DeliveryMethodEQ(v DeliveryMethod) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldDeliveryMethod, v))
+}
+
+// DeliveryMethodNEQ applies the NEQ predicate on the "delivery_method" field.
+func DeliveryMethodNEQ(v DeliveryMethod) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldDeliveryMethod, v))
+}
+
+// DeliveryMethodIn applies the In predicate on the "delivery_method" field.
+func DeliveryMethodIn(vs ...DeliveryMethod) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldDeliveryMethod, vs...))
+}
+
+// DeliveryMethodNotIn applies the NotIn predicate on the "delivery_method" field.
+func DeliveryMethodNotIn(vs ...DeliveryMethod) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldDeliveryMethod, vs...))
+}
+
+// DeliveryMethodIsNil applies the IsNil predicate on the "delivery_method" field.
+func DeliveryMethodIsNil() predicate.Export {
+	return predicate.Export(sql.FieldIsNull(FieldDeliveryMethod))
+}
+
+// DeliveryMethodNotNil applies the NotNil predicate on the "delivery_method" field.
+func DeliveryMethodNotNil() predicate.Export {
+	return predicate.Export(sql.FieldNotNull(FieldDeliveryMethod))
+}
+
+// SpreadsheetIDEQ applies the EQ predicate on the "spreadsheet_id" field.
+func SpreadsheetIDEQ(v string) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldSpreadsheetID, v))
+}
+
+// SpreadsheetIDNEQ applies the NEQ predicate on the "spreadsheet_id" field.
+func SpreadsheetIDNEQ(v string) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldSpreadsheetID, v))
+}
+
+// SpreadsheetIDIn applies the In predicate on the "spreadsheet_id" field.
+func SpreadsheetIDIn(vs ...string) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldSpreadsheetID, vs...))
+}
+
+// SpreadsheetIDNotIn applies the NotIn predicate on the "spreadsheet_id" field.
+func SpreadsheetIDNotIn(vs ...string) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldSpreadsheetID, vs...))
+}
+
+// SpreadsheetIDGT applies the GT predicate on the "spreadsheet_id" field.
+func SpreadsheetIDGT(v string) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldSpreadsheetID, v))
+}
+
+// SpreadsheetIDGTE applies the GTE predicate on the "spreadsheet_id" field.
+func SpreadsheetIDGTE(v string) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldSpreadsheetID, v))
+}
+
+// SpreadsheetIDLT applies the LT predicate on the "spreadsheet_id" field.
+func SpreadsheetIDLT(v string) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldSpreadsheetID, v))
+}
+
+// SpreadsheetIDLTE applies the LTE predicate on the "spreadsheet_id" field.
+func SpreadsheetIDLTE(v string) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldSpreadsheetID, v))
+}
+
+// SpreadsheetIDContains applies the Contains predicate on the "spreadsheet_id" field.
+func SpreadsheetIDContains(v string) predicate.Export {
+	return predicate.Export(sql.FieldContains(FieldSpreadsheetID, v))
+}
+
+// SpreadsheetIDHasPrefix applies the HasPrefix predicate on the "spreadsheet_id" field.
+func SpreadsheetIDHasPrefix(v string) predicate.Export {
+	return predicate.Export(sql.FieldHasPrefix(FieldSpreadsheetID, v))
+}
+
+// SpreadsheetIDHasSuffix applies the HasSuffix predicate on the "spreadsheet_id" field.
+func SpreadsheetIDHasSuffix(v string) predicate.Export {
+	return predicate.Export(sql.FieldHasSuffix(FieldSpreadsheetID, v))
+}
+
+// SpreadsheetIDIsNil applies the IsNil predicate on the "spreadsheet_id" field.
+func SpreadsheetIDIsNil() predicate.Export {
+	return predicate.Export(sql.FieldIsNull(FieldSpreadsheetID))
+}
+
+// SpreadsheetIDNotNil applies the NotNil predicate on the "spreadsheet_id" field.
+func SpreadsheetIDNotNil() predicate.Export {
+	return predicate.Export(sql.FieldNotNull(FieldSpreadsheetID))
+}
+
+// SpreadsheetIDEqualFold applies the EqualFold predicate on the "spreadsheet_id" field.
+func SpreadsheetIDEqualFold(v string) predicate.Export {
+	return predicate.Export(sql.FieldEqualFold(FieldSpreadsheetID, v))
+}
+
+// SpreadsheetIDContainsFold applies the ContainsFold predicate on the "spreadsheet_id" field.
+func SpreadsheetIDContainsFold(v string) predicate.Export {
+	return predicate.Export(sql.FieldContainsFold(FieldSpreadsheetID, v))
+}
+
+// SheetURLEQ applies the EQ predicate on the "sheet_url" field.
+func SheetURLEQ(v string) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldSheetURL, v))
+}
+
+// SheetURLNEQ applies the NEQ predicate on the "sheet_url" field.
+func SheetURLNEQ(v string) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldSheetURL, v))
+}
+
+// SheetURLIn applies the In predicate on the "sheet_url" field.
+func SheetURLIn(vs ...string) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldSheetURL, vs...))
+}
+
+// SheetURLNotIn applies the NotIn predicate on the "sheet_url" field.
+func SheetURLNotIn(vs ...string) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldSheetURL, vs...))
+}
+
+// SheetURLGT applies the GT predicate on the "sheet_url" field.
+func SheetURLGT(v string) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldSheetURL, v))
+}
+
+// SheetURLGTE applies the GTE predicate on the "sheet_url" field.
+func SheetURLGTE(v string) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldSheetURL, v))
+}
+
+// SheetURLLT applies the LT predicate on the "sheet_url" field.
+func SheetURLLT(v string) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldSheetURL, v))
+}
+
+// SheetURLLTE applies the LTE predicate on the "sheet_url" field.
+func SheetURLLTE(v string) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldSheetURL, v))
+}
+
+// SheetURLContains applies the Contains predicate on the "sheet_url" field.
+func SheetURLContains(v string) predicate.Export {
+	return predicate.Export(sql.FieldContains(FieldSheetURL, v))
+}
+
+// SheetURLHasPrefix applies the HasPrefix predicate on the "sheet_url" field.
+func SheetURLHasPrefix(v string) predicate.Export {
+	return predicate.Export(sql.FieldHasPrefix(FieldSheetURL, v))
+}
+
+// SheetURLHasSuffix applies the HasSuffix predicate on the "sheet_url" field.
+func SheetURLHasSuffix(v string) predicate.Export {
+	return predicate.Export(sql.FieldHasSuffix(FieldSheetURL, v))
+}
+
+// SheetURLIsNil applies the IsNil predicate on the "sheet_url" field.
+func SheetURLIsNil() predicate.Export {
+	return predicate.Export(sql.FieldIsNull(FieldSheetURL))
+}
+
+// SheetURLNotNil applies the NotNil predicate on the "sheet_url" field.
+func SheetURLNotNil() predicate.Export {
+	return predicate.Export(sql.FieldNotNull(FieldSheetURL))
+}
+
+// SheetURLEqualFold applies the EqualFold predicate on the "sheet_url" field.
+func SheetURLEqualFold(v string) predicate.Export {
+	return predicate.Export(sql.FieldEqualFold(FieldSheetURL, v))
+}
+
+// SheetURLContainsFold applies the ContainsFold predicate on the "sheet_url" field.
+func SheetURLContainsFold(v string) predicate.Export {
+	return predicate.Export(sql.FieldContainsFold(FieldSheetURL, v))
+}
+
+// DeliveryWarningEQ applies the EQ predicate on the "delivery_warning" field.
+func DeliveryWarningEQ(v string) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldDeliveryWarning, v))
+}
+
+// DeliveryWarningNEQ applies the NEQ predicate on the "delivery_warning" field.
+func DeliveryWarningNEQ(v string) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldDeliveryWarning, v))
+}
+
+// DeliveryWarningIn applies the In predicate on the "delivery_warning" field.
+func DeliveryWarningIn(vs ...string) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldDeliveryWarning, vs...))
+}
+
+// DeliveryWarningNotIn applies the NotIn predicate on the "delivery_warning" field.
+func DeliveryWarningNotIn(vs ...string) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldDeliveryWarning, vs...))
+}
+
+// DeliveryWarningGT applies the GT predicate on the "delivery_warning" field.
+func DeliveryWarningGT(v string) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldDeliveryWarning, v))
+}
+
+// DeliveryWarningGTE applies the GTE predicate on the "delivery_warning" field.
+func DeliveryWarningGTE(v string) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldDeliveryWarning, v))
+}
+
+// DeliveryWarningLT applies the LT predicate on the "delivery_warning" field.
+func DeliveryWarningLT(v string) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldDeliveryWarning, v))
+}
+
+// DeliveryWarningLTE applies the LTE predicate on the "delivery_warning" field.
+func DeliveryWarningLTE(v string) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldDeliveryWarning, v))
+}
+
+// DeliveryWarningContains applies the Contains predicate on the "delivery_warning" field.
+func DeliveryWarningContains(v string) predicate.Export {
+	return predicate.Export(sql.FieldContains(FieldDeliveryWarning, v))
+}
+
+// DeliveryWarningHasPrefix applies the HasPrefix predicate on the "delivery_warning" field.
+func DeliveryWarningHasPrefix(v string) predicate.Export {
+	return predicate.Export(sql.FieldHasPrefix(FieldDeliveryWarning, v))
+}
+
+// DeliveryWarningHasSuffix applies the HasSuffix predicate on the "delivery_warning" field.
+func DeliveryWarningHasSuffix(v string) predicate.Export {
+	return predicate.Export(sql.FieldHasSuffix(FieldDeliveryWarning, v))
+}
+
+// DeliveryWarningIsNil applies the IsNil predicate on the "delivery_warning" field.
+func DeliveryWarningIsNil() predicate.Export {
+	return predicate.Export(sql.FieldIsNull(FieldDeliveryWarning))
+}
+
+// DeliveryWarningNotNil applies the NotNil predicate on the "delivery_warning" field.
+func DeliveryWarningNotNil() predicate.Export {
+	return predicate.Export(sql.FieldNotNull(FieldDeliveryWarning))
+}
+
+// DeliveryWarningEqualFold applies the EqualFold predicate on the "delivery_warning" field.
+func DeliveryWarningEqualFold(v string) predicate.Export {
+	return predicate.Export(sql.FieldEqualFold(FieldDeliveryWarning, v))
+}
+
+// DeliveryWarningContainsFold applies the ContainsFold predicate on the "delivery_warning" field.
+func DeliveryWarningContainsFold(v string) predicate.Export {
+	return predicate.Export(sql.FieldContainsFold(FieldDeliveryWarning, v))
+}
+
+// CompressionEQ applies the EQ predicate on the "compression" field.
+func CompressionEQ(v Compression) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldCompression, v))
+}
+
+// CompressionNEQ applies the NEQ predicate on the "compression" field.
+func CompressionNEQ(v Compression) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldCompression, v))
+}
+
+// CompressionIn applies the In predicate on the "compression" field.
+func CompressionIn(vs ...Compression) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldCompression, vs...))
+}
+
+// CompressionNotIn applies the NotIn predicate on the "compression" field.
+func CompressionNotIn(vs ...Compression) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldCompression, vs...))
+}
+
+// CompressionIsNil applies the IsNil predicate on the "compression" field.
+func CompressionIsNil() predicate.Export {
+	return predicate.Export(sql.FieldIsNull(FieldCompression))
+}
+
+// CompressionNotNil applies the NotNil predicate on the "compression" field.
+func CompressionNotNil() predicate.Export {
+	return predicate.Export(sql.FieldNotNull(FieldCompression))
+}
+
+// FileSizeEQ applies the EQ predicate on the "file_size" field.
+func FileSizeEQ(v int64) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldFileSize, v))
+}
+
+// FileSizeNEQ applies the NEQ predicate on the "file_size" field.
+func FileSizeNEQ(v int64) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldFileSize, v))
+}
+
+// FileSizeIn applies the In predicate on the "file_size" field.
+func FileSizeIn(vs ...int64) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldFileSize, vs...))
+}
+
+// FileSizeNotIn applies the NotIn predicate on the "file_size" field.
+func FileSizeNotIn(vs ...int64) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldFileSize, vs...))
+}
+
+// FileSizeGT applies the GT predicate on the "file_size" field.
+func FileSizeGT(v int64) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldFileSize, v))
+}
+
+// FileSizeGTE applies the GTE predicate on the "file_size" field.
+func FileSizeGTE(v int64) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldFileSize, v))
+}
+
+// FileSizeLT applies the LT predicate on the "file_size" field.
+func FileSizeLT(v int64) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldFileSize, v))
+}
+
+// FileSizeLTE applies the LTE predicate on the "file_size" field.
+func FileSizeLTE(v int64) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldFileSize, v))
+}
+
+// FileSizeIsNil applies the IsNil predicate on the "file_size" field.
+func FileSizeIsNil() predicate.Export {
+	return predicate.Export(sql.FieldIsNull(FieldFileSize))
+}
+
+// FileSizeNotNil applies the NotNil predicate on the "file_size" field.
+func FileSizeNotNil() predicate.Export {
+	return predicate.Export(sql.FieldNotNull(FieldFileSize))
+}
+
+// DownloadCountEQ applies the EQ predicate on the "download_count" field.
+func DownloadCountEQ(v int) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldDownloadCount, v))
+}
+
+// DownloadCountNEQ applies the NEQ predicate on the "download_count" field.
+func DownloadCountNEQ(v int) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldDownloadCount, v))
+}
+
+// DownloadCountIn applies the In predicate on the "download_count" field.
+func DownloadCountIn(vs ...int) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldDownloadCount, vs...))
+}
+
+// DownloadCountNotIn applies the NotIn predicate on the "download_count" field.
+func DownloadCountNotIn(vs ...int) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldDownloadCount, vs...))
+}
+
+// DownloadCountGT applies the GT predicate on the "download_count" field.
+func DownloadCountGT(v int) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldDownloadCount, v))
+}
+
+// DownloadCountGTE applies the GTE predicate on the "download_count" field.
+func DownloadCountGTE(v int) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldDownloadCount, v))
+}
+
+// DownloadCountLT applies the LT predicate on the "download_count" field.
+func DownloadCountLT(v int) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldDownloadCount, v))
+}
+
+// DownloadCountLTE applies the LTE predicate on the "download_count" field.
+func DownloadCountLTE(v int) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldDownloadCount, v))
+}
+
+// LastDownloadedAtEQ applies the EQ predicate on the "last_downloaded_at" field.
+func LastDownloadedAtEQ(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldLastDownloadedAt, v))
+}
+
+// LastDownloadedAtNEQ applies the NEQ predicate on the "last_downloaded_at" field.
+func LastDownloadedAtNEQ(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldLastDownloadedAt, v))
+}
+
+// LastDownloadedAtIn applies the In predicate on the "last_downloaded_at" field.
+func LastDownloadedAtIn(vs ...time.Time) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldLastDownloadedAt, vs...))
+}
+
+// LastDownloadedAtNotIn applies the NotIn predicate on the "last_downloaded_at" field.
+func LastDownloadedAtNotIn(vs ...time.Time) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldLastDownloadedAt, vs...))
+}
+
+// LastDownloadedAtGT applies the GT predicate on the "last_downloaded_at" field.
+func LastDownloadedAtGT(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldLastDownloadedAt, v))
+}
+
+// LastDownloadedAtGTE applies the GTE predicate on the "last_downloaded_at" field.
+func LastDownloadedAtGTE(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldLastDownloadedAt, v))
+}
+
+// LastDownloadedAtLT applies the LT predicate on the "last_downloaded_at" field.
+func LastDownloadedAtLT(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldLastDownloadedAt, v))
+}
+
+// LastDownloadedAtLTE applies the LTE predicate on the "last_downloaded_at" field.
+func LastDownloadedAtLTE(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldLastDownloadedAt, v))
+}
+
+// LastDownloadedAtIsNil applies the IsNil predicate on the "last_downloaded_at" field.
+func LastDownloadedAtIsNil() predicate.Export {
+	return predicate.Export(sql.FieldIsNull(FieldLastDownloadedAt))
+}
+
+// LastDownloadedAtNotNil applies the NotNil predicate on the "last_downloaded_at" field.
+func LastDownloadedAtNotNil() predicate.Export {
+	return predicate.Export(sql.FieldNotNull(FieldLastDownloadedAt))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Export {
 	return predicate.Export(sql.FieldEQ(FieldCreatedAt, v))
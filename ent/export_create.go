@@ -60,6 +60,20 @@ func (_c *ExportCreate) SetLeadCount(v int) *ExportCreate {
 	return _c
 }
 
+// SetSkippedCount sets the "skipped_count" field.
+func (_c *ExportCreate) SetSkippedCount(v int) *ExportCreate {
+	_c.mutation.SetSkippedCount(v)
+	return _c
+}
+
+// SetNillableSkippedCount sets the "skipped_count" field if the given value is not nil.
+func (_c *ExportCreate) SetNillableSkippedCount(v *int) *ExportCreate {
+	if v != nil {
+		_c.SetSkippedCount(*v)
+	}
+	return _c
+}
+
 // SetFileURL sets the "file_url" field.
 func (_c *ExportCreate) SetFileURL(v string) *ExportCreate {
 	_c.mutation.SetFileURL(v)
@@ -130,6 +144,258 @@ func (_c *ExportCreate) SetNillableExpiresAt(v *time.Time) *ExportCreate {
 	return _c
 }
 
+// SetSince sets the "since" field.
+func (_c *ExportCreate) SetSince(v time.Time) *ExportCreate {
+	_c.mutation.SetSince(v)
+	return _c
+}
+
+// SetNillableSince sets the "since" field if the given value is not nil.
+func (_c *ExportCreate) SetNillableSince(v *time.Time) *ExportCreate {
+	if v != nil {
+		_c.SetSince(*v)
+	}
+	return _c
+}
+
+// SetHighWaterMark sets the "high_water_mark" field.
+func (_c *ExportCreate) SetHighWaterMark(v time.Time) *ExportCreate {
+	_c.mutation.SetHighWaterMark(v)
+	return _c
+}
+
+// SetNillableHighWaterMark sets the "high_water_mark" field if the given value is not nil.
+func (_c *ExportCreate) SetNillableHighWaterMark(v *time.Time) *ExportCreate {
+	if v != nil {
+		_c.SetHighWaterMark(*v)
+	}
+	return _c
+}
+
+// SetHighWaterLeadID sets the "high_water_lead_id" field.
+func (_c *ExportCreate) SetHighWaterLeadID(v int) *ExportCreate {
+	_c.mutation.SetHighWaterLeadID(v)
+	return _c
+}
+
+// SetNillableHighWaterLeadID sets the "high_water_lead_id" field if the given value is not nil.
+func (_c *ExportCreate) SetNillableHighWaterLeadID(v *int) *ExportCreate {
+	if v != nil {
+		_c.SetHighWaterLeadID(*v)
+	}
+	return _c
+}
+
+// SetRedactionProfile sets the "redaction_profile" field.
+func (_c *ExportCreate) SetRedactionProfile(v string) *ExportCreate {
+	_c.mutation.SetRedactionProfile(v)
+	return _c
+}
+
+// SetNillableRedactionProfile sets the "redaction_profile" field if the given value is not nil.
+func (_c *ExportCreate) SetNillableRedactionProfile(v *string) *ExportCreate {
+	if v != nil {
+		_c.SetRedactionProfile(*v)
+	}
+	return _c
+}
+
+// SetDeliveryURL sets the "delivery_url" field.
+func (_c *ExportCreate) SetDeliveryURL(v string) *ExportCreate {
+	_c.mutation.SetDeliveryURL(v)
+	return _c
+}
+
+// SetNillableDeliveryURL sets the "delivery_url" field if the given value is not nil.
+func (_c *ExportCreate) SetNillableDeliveryURL(v *string) *ExportCreate {
+	if v != nil {
+		_c.SetDeliveryURL(*v)
+	}
+	return _c
+}
+
+// SetDeliverySecret sets the "delivery_secret" field.
+func (_c *ExportCreate) SetDeliverySecret(v string) *ExportCreate {
+	_c.mutation.SetDeliverySecret(v)
+	return _c
+}
+
+// SetNillableDeliverySecret sets the "delivery_secret" field if the given value is not nil.
+func (_c *ExportCreate) SetNillableDeliverySecret(v *string) *ExportCreate {
+	if v != nil {
+		_c.SetDeliverySecret(*v)
+	}
+	return _c
+}
+
+// SetDeliveryStatus sets the "delivery_status" field.
+func (_c *ExportCreate) SetDeliveryStatus(v export.DeliveryStatus) *ExportCreate {
+	_c.mutation.SetDeliveryStatus(v)
+	return _c
+}
+
+// SetNillableDeliveryStatus sets the "delivery_status" field if the given value is not nil.
+func (_c *ExportCreate) SetNillableDeliveryStatus(v *export.DeliveryStatus) *ExportCreate {
+	if v != nil {
+		_c.SetDeliveryStatus(*v)
+	}
+	return _c
+}
+
+// SetDeliveryAttempts sets the "delivery_attempts" field.
+func (_c *ExportCreate) SetDeliveryAttempts(v int) *ExportCreate {
+	_c.mutation.SetDeliveryAttempts(v)
+	return _c
+}
+
+// SetNillableDeliveryAttempts sets the "delivery_attempts" field if the given value is not nil.
+func (_c *ExportCreate) SetNillableDeliveryAttempts(v *int) *ExportCreate {
+	if v != nil {
+		_c.SetDeliveryAttempts(*v)
+	}
+	return _c
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (_c *ExportCreate) SetDeliveredAt(v time.Time) *ExportCreate {
+	_c.mutation.SetDeliveredAt(v)
+	return _c
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (_c *ExportCreate) SetNillableDeliveredAt(v *time.Time) *ExportCreate {
+	if v != nil {
+		_c.SetDeliveredAt(*v)
+	}
+	return _c
+}
+
+// SetDeliveryError sets the "delivery_error" field.
+func (_c *ExportCreate) SetDeliveryError(v string) *ExportCreate {
+	_c.mutation.SetDeliveryError(v)
+	return _c
+}
+
+// SetNillableDeliveryError sets the "delivery_error" field if the given value is not nil.
+func (_c *ExportCreate) SetNillableDeliveryError(v *string) *ExportCreate {
+	if v != nil {
+		_c.SetDeliveryError(*v)
+	}
+	return _c
+}
+
+// SetDeliveryMethod sets the "delivery_method" field.
+func (_c *ExportCreate) SetDeliveryMethod(v export.DeliveryMethod) *ExportCreate {
+	_c.mutation.SetDeliveryMethod(v)
+	return _c
+}
+
+// SetNillableDeliveryMethod sets the "delivery_method" field if the given value is not nil.
+func (_c *ExportCreate) SetNillableDeliveryMethod(v *export.DeliveryMethod) *ExportCreate {
+	if v != nil {
+		_c.SetDeliveryMethod(*v)
+	}
+	return _c
+}
+
+// SetSpreadsheetID sets the "spreadsheet_id" field.
+func (_c *ExportCreate) SetSpreadsheetID(v string) *ExportCreate {
+	_c.mutation.SetSpreadsheetID(v)
+	return _c
+}
+
+// SetNillableSpreadsheetID sets the "spreadsheet_id" field if the given value is not nil.
+func (_c *ExportCreate) SetNillableSpreadsheetID(v *string) *ExportCreate {
+	if v != nil {
+		_c.SetSpreadsheetID(*v)
+	}
+	return _c
+}
+
+// SetSheetURL sets the "sheet_url" field.
+func (_c *ExportCreate) SetSheetURL(v string) *ExportCreate {
+	_c.mutation.SetSheetURL(v)
+	return _c
+}
+
+// SetNillableSheetURL sets the "sheet_url" field if the given value is not nil.
+func (_c *ExportCreate) SetNillableSheetURL(v *string) *ExportCreate {
+	if v != nil {
+		_c.SetSheetURL(*v)
+	}
+	return _c
+}
+
+// SetDeliveryWarning sets the "delivery_warning" field.
+func (_c *ExportCreate) SetDeliveryWarning(v string) *ExportCreate {
+	_c.mutation.SetDeliveryWarning(v)
+	return _c
+}
+
+// SetNillableDeliveryWarning sets the "delivery_warning" field if the given value is not nil.
+func (_c *ExportCreate) SetNillableDeliveryWarning(v *string) *ExportCreate {
+	if v != nil {
+		_c.SetDeliveryWarning(*v)
+	}
+	return _c
+}
+
+// SetCompression sets the "compression" field.
+func (_c *ExportCreate) SetCompression(v export.Compression) *ExportCreate {
+	_c.mutation.SetCompression(v)
+	return _c
+}
+
+// SetNillableCompression sets the "compression" field if the given value is not nil.
+func (_c *ExportCreate) SetNillableCompression(v *export.Compression) *ExportCreate {
+	if v != nil {
+		_c.SetCompression(*v)
+	}
+	return _c
+}
+
+// SetFileSize sets the "file_size" field.
+func (_c *ExportCreate) SetFileSize(v int64) *ExportCreate {
+	_c.mutation.SetFileSize(v)
+	return _c
+}
+
+// SetNillableFileSize sets the "file_size" field if the given value is not nil.
+func (_c *ExportCreate) SetNillableFileSize(v *int64) *ExportCreate {
+	if v != nil {
+		_c.SetFileSize(*v)
+	}
+	return _c
+}
+
+// SetDownloadCount sets the "download_count" field.
+func (_c *ExportCreate) SetDownloadCount(v int) *ExportCreate {
+	_c.mutation.SetDownloadCount(v)
+	return _c
+}
+
+// SetNillableDownloadCount sets the "download_count" field if the given value is not nil.
+func (_c *ExportCreate) SetNillableDownloadCount(v *int) *ExportCreate {
+	if v != nil {
+		_c.SetDownloadCount(*v)
+	}
+	return _c
+}
+
+// SetLastDownloadedAt sets the "last_downloaded_at" field.
+func (_c *ExportCreate) SetLastDownloadedAt(v time.Time) *ExportCreate {
+	_c.mutation.SetLastDownloadedAt(v)
+	return _c
+}
+
+// SetNillableLastDownloadedAt sets the "last_downloaded_at" field if the given value is not nil.
+func (_c *ExportCreate) SetNillableLastDownloadedAt(v *time.Time) *ExportCreate {
+	if v != nil {
+		_c.SetLastDownloadedAt(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *ExportCreate) SetCreatedAt(v time.Time) *ExportCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -203,10 +469,22 @@ func (_c *ExportCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *ExportCreate) defaults() {
+	if _, ok := _c.mutation.SkippedCount(); !ok {
+		v := export.DefaultSkippedCount
+		_c.mutation.SetSkippedCount(v)
+	}
 	if _, ok := _c.mutation.Status(); !ok {
 		v := export.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
+	if _, ok := _c.mutation.DeliveryAttempts(); !ok {
+		v := export.DefaultDeliveryAttempts
+		_c.mutation.SetDeliveryAttempts(v)
+	}
+	if _, ok := _c.mutation.DownloadCount(); !ok {
+		v := export.DefaultDownloadCount
+		_c.mutation.SetDownloadCount(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := export.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -243,6 +521,14 @@ func (_c *ExportCreate) check() error {
 			return &ValidationError{Name: "lead_count", err: fmt.Errorf(`ent: validator failed for field "Export.lead_count": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.SkippedCount(); !ok {
+		return &ValidationError{Name: "skipped_count", err: errors.New(`ent: missing required field "Export.skipped_count"`)}
+	}
+	if v, ok := _c.mutation.SkippedCount(); ok {
+		if err := export.SkippedCountValidator(v); err != nil {
+			return &ValidationError{Name: "skipped_count", err: fmt.Errorf(`ent: validator failed for field "Export.skipped_count": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Export.status"`)}
 	}
@@ -251,6 +537,52 @@ func (_c *ExportCreate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Export.status": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.RedactionProfile(); ok {
+		if err := export.RedactionProfileValidator(v); err != nil {
+			return &ValidationError{Name: "redaction_profile", err: fmt.Errorf(`ent: validator failed for field "Export.redaction_profile": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DeliveryURL(); ok {
+		if err := export.DeliveryURLValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_url", err: fmt.Errorf(`ent: validator failed for field "Export.delivery_url": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DeliveryStatus(); ok {
+		if err := export.DeliveryStatusValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_status", err: fmt.Errorf(`ent: validator failed for field "Export.delivery_status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DeliveryAttempts(); !ok {
+		return &ValidationError{Name: "delivery_attempts", err: errors.New(`ent: missing required field "Export.delivery_attempts"`)}
+	}
+	if v, ok := _c.mutation.DeliveryAttempts(); ok {
+		if err := export.DeliveryAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_attempts", err: fmt.Errorf(`ent: validator failed for field "Export.delivery_attempts": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DeliveryMethod(); ok {
+		if err := export.DeliveryMethodValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_method", err: fmt.Errorf(`ent: validator failed for field "Export.delivery_method": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.SheetURL(); ok {
+		if err := export.SheetURLValidator(v); err != nil {
+			return &ValidationError{Name: "sheet_url", err: fmt.Errorf(`ent: validator failed for field "Export.sheet_url": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Compression(); ok {
+		if err := export.CompressionValidator(v); err != nil {
+			return &ValidationError{Name: "compression", err: fmt.Errorf(`ent: validator failed for field "Export.compression": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DownloadCount(); !ok {
+		return &ValidationError{Name: "download_count", err: errors.New(`ent: missing required field "Export.download_count"`)}
+	}
+	if v, ok := _c.mutation.DownloadCount(); ok {
+		if err := export.DownloadCountValidator(v); err != nil {
+			return &ValidationError{Name: "download_count", err: fmt.Errorf(`ent: validator failed for field "Export.download_count": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Export.created_at"`)}
 	}
@@ -298,6 +630,10 @@ func (_c *ExportCreate) createSpec() (*Export, *sqlgraph.CreateSpec) {
 		_spec.SetField(export.FieldLeadCount, field.TypeInt, value)
 		_node.LeadCount = value
 	}
+	if value, ok := _c.mutation.SkippedCount(); ok {
+		_spec.SetField(export.FieldSkippedCount, field.TypeInt, value)
+		_node.SkippedCount = value
+	}
 	if value, ok := _c.mutation.FileURL(); ok {
 		_spec.SetField(export.FieldFileURL, field.TypeString, value)
 		_node.FileURL = value
@@ -318,6 +654,78 @@ func (_c *ExportCreate) createSpec() (*Export, *sqlgraph.CreateSpec) {
 		_spec.SetField(export.FieldExpiresAt, field.TypeTime, value)
 		_node.ExpiresAt = value
 	}
+	if value, ok := _c.mutation.Since(); ok {
+		_spec.SetField(export.FieldSince, field.TypeTime, value)
+		_node.Since = &value
+	}
+	if value, ok := _c.mutation.HighWaterMark(); ok {
+		_spec.SetField(export.FieldHighWaterMark, field.TypeTime, value)
+		_node.HighWaterMark = &value
+	}
+	if value, ok := _c.mutation.HighWaterLeadID(); ok {
+		_spec.SetField(export.FieldHighWaterLeadID, field.TypeInt, value)
+		_node.HighWaterLeadID = &value
+	}
+	if value, ok := _c.mutation.RedactionProfile(); ok {
+		_spec.SetField(export.FieldRedactionProfile, field.TypeString, value)
+		_node.RedactionProfile = value
+	}
+	if value, ok := _c.mutation.DeliveryURL(); ok {
+		_spec.SetField(export.FieldDeliveryURL, field.TypeString, value)
+		_node.DeliveryURL = value
+	}
+	if value, ok := _c.mutation.DeliverySecret(); ok {
+		_spec.SetField(export.FieldDeliverySecret, field.TypeString, value)
+		_node.DeliverySecret = value
+	}
+	if value, ok := _c.mutation.DeliveryStatus(); ok {
+		_spec.SetField(export.FieldDeliveryStatus, field.TypeEnum, value)
+		_node.DeliveryStatus = &value
+	}
+	if value, ok := _c.mutation.DeliveryAttempts(); ok {
+		_spec.SetField(export.FieldDeliveryAttempts, field.TypeInt, value)
+		_node.DeliveryAttempts = value
+	}
+	if value, ok := _c.mutation.DeliveredAt(); ok {
+		_spec.SetField(export.FieldDeliveredAt, field.TypeTime, value)
+		_node.DeliveredAt = &value
+	}
+	if value, ok := _c.mutation.DeliveryError(); ok {
+		_spec.SetField(export.FieldDeliveryError, field.TypeString, value)
+		_node.DeliveryError = value
+	}
+	if value, ok := _c.mutation.DeliveryMethod(); ok {
+		_spec.SetField(export.FieldDeliveryMethod, field.TypeEnum, value)
+		_node.DeliveryMethod = &value
+	}
+	if value, ok := _c.mutation.SpreadsheetID(); ok {
+		_spec.SetField(export.FieldSpreadsheetID, field.TypeString, value)
+		_node.SpreadsheetID = value
+	}
+	if value, ok := _c.mutation.SheetURL(); ok {
+		_spec.SetField(export.FieldSheetURL, field.TypeString, value)
+		_node.SheetURL = value
+	}
+	if value, ok := _c.mutation.DeliveryWarning(); ok {
+		_spec.SetField(export.FieldDeliveryWarning, field.TypeString, value)
+		_node.DeliveryWarning = value
+	}
+	if value, ok := _c.mutation.Compression(); ok {
+		_spec.SetField(export.FieldCompression, field.TypeEnum, value)
+		_node.Compression = &value
+	}
+	if value, ok := _c.mutation.FileSize(); ok {
+		_spec.SetField(export.FieldFileSize, field.TypeInt64, value)
+		_node.FileSize = &value
+	}
+	if value, ok := _c.mutation.DownloadCount(); ok {
+		_spec.SetField(export.FieldDownloadCount, field.TypeInt, value)
+		_node.DownloadCount = value
+	}
+	if value, ok := _c.mutation.LastDownloadedAt(); ok {
+		_spec.SetField(export.FieldLastDownloadedAt, field.TypeTime, value)
+		_node.LastDownloadedAt = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(export.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
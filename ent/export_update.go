@@ -20,8 +20,9 @@ import (
 // ExportUpdate is the builder for updating Export entities.
 type ExportUpdate struct {
 	config
-	hooks    []Hook
-	mutation *ExportMutation
+	hooks     []Hook
+	mutation  *ExportMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the ExportUpdate builder.
@@ -111,6 +112,27 @@ func (_u *ExportUpdate) AddLeadCount(v int) *ExportUpdate {
 	return _u
 }
 
+// SetSkippedCount sets the "skipped_count" field.
+func (_u *ExportUpdate) SetSkippedCount(v int) *ExportUpdate {
+	_u.mutation.ResetSkippedCount()
+	_u.mutation.SetSkippedCount(v)
+	return _u
+}
+
+// SetNillableSkippedCount sets the "skipped_count" field if the given value is not nil.
+func (_u *ExportUpdate) SetNillableSkippedCount(v *int) *ExportUpdate {
+	if v != nil {
+		_u.SetSkippedCount(*v)
+	}
+	return _u
+}
+
+// AddSkippedCount adds value to the "skipped_count" field.
+func (_u *ExportUpdate) AddSkippedCount(v int) *ExportUpdate {
+	_u.mutation.AddSkippedCount(v)
+	return _u
+}
+
 // SetFileURL sets the "file_url" field.
 func (_u *ExportUpdate) SetFileURL(v string) *ExportUpdate {
 	_u.mutation.SetFileURL(v)
@@ -205,6 +227,382 @@ func (_u *ExportUpdate) ClearExpiresAt() *ExportUpdate {
 	return _u
 }
 
+// SetSince sets the "since" field.
+func (_u *ExportUpdate) SetSince(v time.Time) *ExportUpdate {
+	_u.mutation.SetSince(v)
+	return _u
+}
+
+// SetNillableSince sets the "since" field if the given value is not nil.
+func (_u *ExportUpdate) SetNillableSince(v *time.Time) *ExportUpdate {
+	if v != nil {
+		_u.SetSince(*v)
+	}
+	return _u
+}
+
+// ClearSince clears the value of the "since" field.
+func (_u *ExportUpdate) ClearSince() *ExportUpdate {
+	_u.mutation.ClearSince()
+	return _u
+}
+
+// SetHighWaterMark sets the "high_water_mark" field.
+func (_u *ExportUpdate) SetHighWaterMark(v time.Time) *ExportUpdate {
+	_u.mutation.SetHighWaterMark(v)
+	return _u
+}
+
+// SetNillableHighWaterMark sets the "high_water_mark" field if the given value is not nil.
+func (_u *ExportUpdate) SetNillableHighWaterMark(v *time.Time) *ExportUpdate {
+	if v != nil {
+		_u.SetHighWaterMark(*v)
+	}
+	return _u
+}
+
+// ClearHighWaterMark clears the value of the "high_water_mark" field.
+func (_u *ExportUpdate) ClearHighWaterMark() *ExportUpdate {
+	_u.mutation.ClearHighWaterMark()
+	return _u
+}
+
+// SetHighWaterLeadID sets the "high_water_lead_id" field.
+func (_u *ExportUpdate) SetHighWaterLeadID(v int) *ExportUpdate {
+	_u.mutation.ResetHighWaterLeadID()
+	_u.mutation.SetHighWaterLeadID(v)
+	return _u
+}
+
+// SetNillableHighWaterLeadID sets the "high_water_lead_id" field if the given value is not nil.
+func (_u *ExportUpdate) SetNillableHighWaterLeadID(v *int) *ExportUpdate {
+	if v != nil {
+		_u.SetHighWaterLeadID(*v)
+	}
+	return _u
+}
+
+// AddHighWaterLeadID adds value to the "high_water_lead_id" field.
+func (_u *ExportUpdate) AddHighWaterLeadID(v int) *ExportUpdate {
+	_u.mutation.AddHighWaterLeadID(v)
+	return _u
+}
+
+// ClearHighWaterLeadID clears the value of the "high_water_lead_id" field.
+func (_u *ExportUpdate) ClearHighWaterLeadID() *ExportUpdate {
+	_u.mutation.ClearHighWaterLeadID()
+	return _u
+}
+
+// SetRedactionProfile sets the "redaction_profile" field.
+func (_u *ExportUpdate) SetRedactionProfile(v string) *ExportUpdate {
+	_u.mutation.SetRedactionProfile(v)
+	return _u
+}
+
+// SetNillableRedactionProfile sets the "redaction_profile" field if the given value is not nil.
+func (_u *ExportUpdate) SetNillableRedactionProfile(v *string) *ExportUpdate {
+	if v != nil {
+		_u.SetRedactionProfile(*v)
+	}
+	return _u
+}
+
+// ClearRedactionProfile clears the value of the "redaction_profile" field.
+func (_u *ExportUpdate) ClearRedactionProfile() *ExportUpdate {
+	_u.mutation.ClearRedactionProfile()
+	return _u
+}
+
+// SetDeliveryURL sets the "delivery_url" field.
+func (_u *ExportUpdate) SetDeliveryURL(v string) *ExportUpdate {
+	_u.mutation.SetDeliveryURL(v)
+	return _u
+}
+
+// SetNillableDeliveryURL sets the "delivery_url" field if the given value is not nil.
+func (_u *ExportUpdate) SetNillableDeliveryURL(v *string) *ExportUpdate {
+	if v != nil {
+		_u.SetDeliveryURL(*v)
+	}
+	return _u
+}
+
+// ClearDeliveryURL clears the value of the "delivery_url" field.
+func (_u *ExportUpdate) ClearDeliveryURL() *ExportUpdate {
+	_u.mutation.ClearDeliveryURL()
+	return _u
+}
+
+// SetDeliverySecret sets the "delivery_secret" field.
+func (_u *ExportUpdate) SetDeliverySecret(v string) *ExportUpdate {
+	_u.mutation.SetDeliverySecret(v)
+	return _u
+}
+
+// SetNillableDeliverySecret sets the "delivery_secret" field if the given value is not nil.
+func (_u *ExportUpdate) SetNillableDeliverySecret(v *string) *ExportUpdate {
+	if v != nil {
+		_u.SetDeliverySecret(*v)
+	}
+	return _u
+}
+
+// ClearDeliverySecret clears the value of the "delivery_secret" field.
+func (_u *ExportUpdate) ClearDeliverySecret() *ExportUpdate {
+	_u.mutation.ClearDeliverySecret()
+	return _u
+}
+
+// SetDeliveryStatus sets the "delivery_status" field.
+func (_u *ExportUpdate) SetDeliveryStatus(v export.DeliveryStatus) *ExportUpdate {
+	_u.mutation.SetDeliveryStatus(v)
+	return _u
+}
+
+// SetNillableDeliveryStatus sets the "delivery_status" field if the given value is not nil.
+func (_u *ExportUpdate) SetNillableDeliveryStatus(v *export.DeliveryStatus) *ExportUpdate {
+	if v != nil {
+		_u.SetDeliveryStatus(*v)
+	}
+	return _u
+}
+
+// ClearDeliveryStatus clears the value of the "delivery_status" field.
+func (_u *ExportUpdate) ClearDeliveryStatus() *ExportUpdate {
+	_u.mutation.ClearDeliveryStatus()
+	return _u
+}
+
+// SetDeliveryAttempts sets the "delivery_attempts" field.
+func (_u *ExportUpdate) SetDeliveryAttempts(v int) *ExportUpdate {
+	_u.mutation.ResetDeliveryAttempts()
+	_u.mutation.SetDeliveryAttempts(v)
+	return _u
+}
+
+// SetNillableDeliveryAttempts sets the "delivery_attempts" field if the given value is not nil.
+func (_u *ExportUpdate) SetNillableDeliveryAttempts(v *int) *ExportUpdate {
+	if v != nil {
+		_u.SetDeliveryAttempts(*v)
+	}
+	return _u
+}
+
+// AddDeliveryAttempts adds value to the "delivery_attempts" field.
+func (_u *ExportUpdate) AddDeliveryAttempts(v int) *ExportUpdate {
+	_u.mutation.AddDeliveryAttempts(v)
+	return _u
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (_u *ExportUpdate) SetDeliveredAt(v time.Time) *ExportUpdate {
+	_u.mutation.SetDeliveredAt(v)
+	return _u
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (_u *ExportUpdate) SetNillableDeliveredAt(v *time.Time) *ExportUpdate {
+	if v != nil {
+		_u.SetDeliveredAt(*v)
+	}
+	return _u
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (_u *ExportUpdate) ClearDeliveredAt() *ExportUpdate {
+	_u.mutation.ClearDeliveredAt()
+	return _u
+}
+
+// SetDeliveryError sets the "delivery_error" field.
+func (_u *ExportUpdate) SetDeliveryError(v string) *ExportUpdate {
+	_u.mutation.SetDeliveryError(v)
+	return _u
+}
+
+// SetNillableDeliveryError sets the "delivery_error" field if the given value is not nil.
+func (_u *ExportUpdate) SetNillableDeliveryError(v *string) *ExportUpdate {
+	if v != nil {
+		_u.SetDeliveryError(*v)
+	}
+	return _u
+}
+
+// ClearDeliveryError clears the value of the "delivery_error" field.
+func (_u *ExportUpdate) ClearDeliveryError() *ExportUpdate {
+	_u.mutation.ClearDeliveryError()
+	return _u
+}
+
+// SetDeliveryMethod sets the "delivery_method" field.
+func (_u *ExportUpdate) SetDeliveryMethod(v export.DeliveryMethod) *ExportUpdate {
+	_u.mutation.SetDeliveryMethod(v)
+	return _u
+}
+
+// SetNillableDeliveryMethod sets the "delivery_method" field if the given value is not nil.
+func (_u *ExportUpdate) SetNillableDeliveryMethod(v *export.DeliveryMethod) *ExportUpdate {
+	if v != nil {
+		_u.SetDeliveryMethod(*v)
+	}
+	return _u
+}
+
+// ClearDeliveryMethod clears the value of the "delivery_method" field.
+func (_u *ExportUpdate) ClearDeliveryMethod() *ExportUpdate {
+	_u.mutation.ClearDeliveryMethod()
+	return _u
+}
+
+// SetSpreadsheetID sets the "spreadsheet_id" field.
+func (_u *ExportUpdate) SetSpreadsheetID(v string) *ExportUpdate {
+	_u.mutation.SetSpreadsheetID(v)
+	return _u
+}
+
+// SetNillableSpreadsheetID sets the "spreadsheet_id" field if the given value is not nil.
+func (_u *ExportUpdate) SetNillableSpreadsheetID(v *string) *ExportUpdate {
+	if v != nil {
+		_u.SetSpreadsheetID(*v)
+	}
+	return _u
+}
+
+// ClearSpreadsheetID clears the value of the "spreadsheet_id" field.
+func (_u *ExportUpdate) ClearSpreadsheetID() *ExportUpdate {
+	_u.mutation.ClearSpreadsheetID()
+	return _u
+}
+
+// SetSheetURL sets the "sheet_url" field.
+func (_u *ExportUpdate) SetSheetURL(v string) *ExportUpdate {
+	_u.mutation.SetSheetURL(v)
+	return _u
+}
+
+// SetNillableSheetURL sets the "sheet_url" field if the given value is not nil.
+func (_u *ExportUpdate) SetNillableSheetURL(v *string) *ExportUpdate {
+	if v != nil {
+		_u.SetSheetURL(*v)
+	}
+	return _u
+}
+
+// ClearSheetURL clears the value of the "sheet_url" field.
+func (_u *ExportUpdate) ClearSheetURL() *ExportUpdate {
+	_u.mutation.ClearSheetURL()
+	return _u
+}
+
+// SetDeliveryWarning sets the "delivery_warning" field.
+func (_u *ExportUpdate) SetDeliveryWarning(v string) *ExportUpdate {
+	_u.mutation.SetDeliveryWarning(v)
+	return _u
+}
+
+// SetNillableDeliveryWarning sets the "delivery_warning" field if the given value is not nil.
+func (_u *ExportUpdate) SetNillableDeliveryWarning(v *string) *ExportUpdate {
+	if v != nil {
+		_u.SetDeliveryWarning(*v)
+	}
+	return _u
+}
+
+// ClearDeliveryWarning clears the value of the "delivery_warning" field.
+func (_u *ExportUpdate) ClearDeliveryWarning() *ExportUpdate {
+	_u.mutation.ClearDeliveryWarning()
+	return _u
+}
+
+// SetCompression sets the "compression" field.
+func (_u *ExportUpdate) SetCompression(v export.Compression) *ExportUpdate {
+	_u.mutation.SetCompression(v)
+	return _u
+}
+
+// SetNillableCompression sets the "compression" field if the given value is not nil.
+func (_u *ExportUpdate) SetNillableCompression(v *export.Compression) *ExportUpdate {
+	if v != nil {
+		_u.SetCompression(*v)
+	}
+	return _u
+}
+
+// ClearCompression clears the value of the "compression" field.
+func (_u *ExportUpdate) ClearCompression() *ExportUpdate {
+	_u.mutation.ClearCompression()
+	return _u
+}
+
+// SetFileSize sets the "file_size" field.
+func (_u *ExportUpdate) SetFileSize(v int64) *ExportUpdate {
+	_u.mutation.ResetFileSize()
+	_u.mutation.SetFileSize(v)
+	return _u
+}
+
+// SetNillableFileSize sets the "file_size" field if the given value is not nil.
+func (_u *ExportUpdate) SetNillableFileSize(v *int64) *ExportUpdate {
+	if v != nil {
+		_u.SetFileSize(*v)
+	}
+	return _u
+}
+
+// AddFileSize adds value to the "file_size" field.
+func (_u *ExportUpdate) AddFileSize(v int64) *ExportUpdate {
+	_u.mutation.AddFileSize(v)
+	return _u
+}
+
+// ClearFileSize clears the value of the "file_size" field.
+func (_u *ExportUpdate) ClearFileSize() *ExportUpdate {
+	_u.mutation.ClearFileSize()
+	return _u
+}
+
+// SetDownloadCount sets the "download_count" field.
+func (_u *ExportUpdate) SetDownloadCount(v int) *ExportUpdate {
+	_u.mutation.ResetDownloadCount()
+	_u.mutation.SetDownloadCount(v)
+	return _u
+}
+
+// SetNillableDownloadCount sets the "download_count" field if the given value is not nil.
+func (_u *ExportUpdate) SetNillableDownloadCount(v *int) *ExportUpdate {
+	if v != nil {
+		_u.SetDownloadCount(*v)
+	}
+	return _u
+}
+
+// AddDownloadCount adds value to the "download_count" field.
+func (_u *ExportUpdate) AddDownloadCount(v int) *ExportUpdate {
+	_u.mutation.AddDownloadCount(v)
+	return _u
+}
+
+// SetLastDownloadedAt sets the "last_downloaded_at" field.
+func (_u *ExportUpdate) SetLastDownloadedAt(v time.Time) *ExportUpdate {
+	_u.mutation.SetLastDownloadedAt(v)
+	return _u
+}
+
+// SetNillableLastDownloadedAt sets the "last_downloaded_at" field if the given value is not nil.
+func (_u *ExportUpdate) SetNillableLastDownloadedAt(v *time.Time) *ExportUpdate {
+	if v != nil {
+		_u.SetLastDownloadedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastDownloadedAt clears the value of the "last_downloaded_at" field.
+func (_u *ExportUpdate) ClearLastDownloadedAt() *ExportUpdate {
+	_u.mutation.ClearLastDownloadedAt()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *ExportUpdate) SetUpdatedAt(v time.Time) *ExportUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -291,17 +689,68 @@ func (_u *ExportUpdate) check() error {
 			return &ValidationError{Name: "lead_count", err: fmt.Errorf(`ent: validator failed for field "Export.lead_count": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.SkippedCount(); ok {
+		if err := export.SkippedCountValidator(v); err != nil {
+			return &ValidationError{Name: "skipped_count", err: fmt.Errorf(`ent: validator failed for field "Export.skipped_count": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Status(); ok {
 		if err := export.StatusValidator(v); err != nil {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Export.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.RedactionProfile(); ok {
+		if err := export.RedactionProfileValidator(v); err != nil {
+			return &ValidationError{Name: "redaction_profile", err: fmt.Errorf(`ent: validator failed for field "Export.redaction_profile": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeliveryURL(); ok {
+		if err := export.DeliveryURLValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_url", err: fmt.Errorf(`ent: validator failed for field "Export.delivery_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeliveryStatus(); ok {
+		if err := export.DeliveryStatusValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_status", err: fmt.Errorf(`ent: validator failed for field "Export.delivery_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeliveryAttempts(); ok {
+		if err := export.DeliveryAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_attempts", err: fmt.Errorf(`ent: validator failed for field "Export.delivery_attempts": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeliveryMethod(); ok {
+		if err := export.DeliveryMethodValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_method", err: fmt.Errorf(`ent: validator failed for field "Export.delivery_method": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SheetURL(); ok {
+		if err := export.SheetURLValidator(v); err != nil {
+			return &ValidationError{Name: "sheet_url", err: fmt.Errorf(`ent: validator failed for field "Export.sheet_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Compression(); ok {
+		if err := export.CompressionValidator(v); err != nil {
+			return &ValidationError{Name: "compression", err: fmt.Errorf(`ent: validator failed for field "Export.compression": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DownloadCount(); ok {
+		if err := export.DownloadCountValidator(v); err != nil {
+			return &ValidationError{Name: "download_count", err: fmt.Errorf(`ent: validator failed for field "Export.download_count": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Export.user"`)
 	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ExportUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ExportUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ExportUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -329,6 +778,12 @@ func (_u *ExportUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedLeadCount(); ok {
 		_spec.AddField(export.FieldLeadCount, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.SkippedCount(); ok {
+		_spec.SetField(export.FieldSkippedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSkippedCount(); ok {
+		_spec.AddField(export.FieldSkippedCount, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.FileURL(); ok {
 		_spec.SetField(export.FieldFileURL, field.TypeString, value)
 	}
@@ -356,259 +811,772 @@ func (_u *ExportUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.ExpiresAtCleared() {
 		_spec.ClearField(export.FieldExpiresAt, field.TypeTime)
 	}
-	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(export.FieldUpdatedAt, field.TypeTime, value)
+	if value, ok := _u.mutation.Since(); ok {
+		_spec.SetField(export.FieldSince, field.TypeTime, value)
 	}
-	if _u.mutation.UserCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   export.UserTable,
-			Columns: []string{export.UserColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	if _u.mutation.SinceCleared() {
+		_spec.ClearField(export.FieldSince, field.TypeTime)
+	}
+	if value, ok := _u.mutation.HighWaterMark(); ok {
+		_spec.SetField(export.FieldHighWaterMark, field.TypeTime, value)
+	}
+	if _u.mutation.HighWaterMarkCleared() {
+		_spec.ClearField(export.FieldHighWaterMark, field.TypeTime)
+	}
+	if value, ok := _u.mutation.HighWaterLeadID(); ok {
+		_spec.SetField(export.FieldHighWaterLeadID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHighWaterLeadID(); ok {
+		_spec.AddField(export.FieldHighWaterLeadID, field.TypeInt, value)
+	}
+	if _u.mutation.HighWaterLeadIDCleared() {
+		_spec.ClearField(export.FieldHighWaterLeadID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.RedactionProfile(); ok {
+		_spec.SetField(export.FieldRedactionProfile, field.TypeString, value)
+	}
+	if _u.mutation.RedactionProfileCleared() {
+		_spec.ClearField(export.FieldRedactionProfile, field.TypeString)
+	}
+	if value, ok := _u.mutation.DeliveryURL(); ok {
+		_spec.SetField(export.FieldDeliveryURL, field.TypeString, value)
+	}
+	if _u.mutation.DeliveryURLCleared() {
+		_spec.ClearField(export.FieldDeliveryURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.DeliverySecret(); ok {
+		_spec.SetField(export.FieldDeliverySecret, field.TypeString, value)
+	}
+	if _u.mutation.DeliverySecretCleared() {
+		_spec.ClearField(export.FieldDeliverySecret, field.TypeString)
+	}
+	if value, ok := _u.mutation.DeliveryStatus(); ok {
+		_spec.SetField(export.FieldDeliveryStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.DeliveryStatusCleared() {
+		_spec.ClearField(export.FieldDeliveryStatus, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.DeliveryAttempts(); ok {
+		_spec.SetField(export.FieldDeliveryAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDeliveryAttempts(); ok {
+		_spec.AddField(export.FieldDeliveryAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DeliveredAt(); ok {
+		_spec.SetField(export.FieldDeliveredAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeliveredAtCleared() {
+		_spec.ClearField(export.FieldDeliveredAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeliveryError(); ok {
+		_spec.SetField(export.FieldDeliveryError, field.TypeString, value)
+	}
+	if _u.mutation.DeliveryErrorCleared() {
+		_spec.ClearField(export.FieldDeliveryError, field.TypeString)
+	}
+	if value, ok := _u.mutation.DeliveryMethod(); ok {
+		_spec.SetField(export.FieldDeliveryMethod, field.TypeEnum, value)
+	}
+	if _u.mutation.DeliveryMethodCleared() {
+		_spec.ClearField(export.FieldDeliveryMethod, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.SpreadsheetID(); ok {
+		_spec.SetField(export.FieldSpreadsheetID, field.TypeString, value)
+	}
+	if _u.mutation.SpreadsheetIDCleared() {
+		_spec.ClearField(export.FieldSpreadsheetID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SheetURL(); ok {
+		_spec.SetField(export.FieldSheetURL, field.TypeString, value)
+	}
+	if _u.mutation.SheetURLCleared() {
+		_spec.ClearField(export.FieldSheetURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.DeliveryWarning(); ok {
+		_spec.SetField(export.FieldDeliveryWarning, field.TypeString, value)
+	}
+	if _u.mutation.DeliveryWarningCleared() {
+		_spec.ClearField(export.FieldDeliveryWarning, field.TypeString)
+	}
+	if value, ok := _u.mutation.Compression(); ok {
+		_spec.SetField(export.FieldCompression, field.TypeEnum, value)
+	}
+	if _u.mutation.CompressionCleared() {
+		_spec.ClearField(export.FieldCompression, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.FileSize(); ok {
+		_spec.SetField(export.FieldFileSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedFileSize(); ok {
+		_spec.AddField(export.FieldFileSize, field.TypeInt64, value)
+	}
+	if _u.mutation.FileSizeCleared() {
+		_spec.ClearField(export.FieldFileSize, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.DownloadCount(); ok {
+		_spec.SetField(export.FieldDownloadCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDownloadCount(); ok {
+		_spec.AddField(export.FieldDownloadCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastDownloadedAt(); ok {
+		_spec.SetField(export.FieldLastDownloadedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastDownloadedAtCleared() {
+		_spec.ClearField(export.FieldLastDownloadedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(export.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   export.UserTable,
+			Columns: []string{export.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   export.UserTable,
+			Columns: []string{export.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   export.OrganizationTable,
+			Columns: []string{export.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   export.OrganizationTable,
+			Columns: []string{export.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{export.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ExportUpdateOne is the builder for updating a single Export entity.
+type ExportUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ExportMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ExportUpdateOne) SetUserID(v int) *ExportUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableUserID(v *int) *ExportUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_u *ExportUpdateOne) SetOrganizationID(v int) *ExportUpdateOne {
+	_u.mutation.SetOrganizationID(v)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableOrganizationID(v *int) *ExportUpdateOne {
+	if v != nil {
+		_u.SetOrganizationID(*v)
+	}
+	return _u
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (_u *ExportUpdateOne) ClearOrganizationID() *ExportUpdateOne {
+	_u.mutation.ClearOrganizationID()
+	return _u
+}
+
+// SetFormat sets the "format" field.
+func (_u *ExportUpdateOne) SetFormat(v export.Format) *ExportUpdateOne {
+	_u.mutation.SetFormat(v)
+	return _u
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableFormat(v *export.Format) *ExportUpdateOne {
+	if v != nil {
+		_u.SetFormat(*v)
+	}
+	return _u
+}
+
+// SetFiltersApplied sets the "filters_applied" field.
+func (_u *ExportUpdateOne) SetFiltersApplied(v map[string]interface{}) *ExportUpdateOne {
+	_u.mutation.SetFiltersApplied(v)
+	return _u
+}
+
+// ClearFiltersApplied clears the value of the "filters_applied" field.
+func (_u *ExportUpdateOne) ClearFiltersApplied() *ExportUpdateOne {
+	_u.mutation.ClearFiltersApplied()
+	return _u
+}
+
+// SetLeadCount sets the "lead_count" field.
+func (_u *ExportUpdateOne) SetLeadCount(v int) *ExportUpdateOne {
+	_u.mutation.ResetLeadCount()
+	_u.mutation.SetLeadCount(v)
+	return _u
+}
+
+// SetNillableLeadCount sets the "lead_count" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableLeadCount(v *int) *ExportUpdateOne {
+	if v != nil {
+		_u.SetLeadCount(*v)
+	}
+	return _u
+}
+
+// AddLeadCount adds value to the "lead_count" field.
+func (_u *ExportUpdateOne) AddLeadCount(v int) *ExportUpdateOne {
+	_u.mutation.AddLeadCount(v)
+	return _u
+}
+
+// SetSkippedCount sets the "skipped_count" field.
+func (_u *ExportUpdateOne) SetSkippedCount(v int) *ExportUpdateOne {
+	_u.mutation.ResetSkippedCount()
+	_u.mutation.SetSkippedCount(v)
+	return _u
+}
+
+// SetNillableSkippedCount sets the "skipped_count" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableSkippedCount(v *int) *ExportUpdateOne {
+	if v != nil {
+		_u.SetSkippedCount(*v)
+	}
+	return _u
+}
+
+// AddSkippedCount adds value to the "skipped_count" field.
+func (_u *ExportUpdateOne) AddSkippedCount(v int) *ExportUpdateOne {
+	_u.mutation.AddSkippedCount(v)
+	return _u
+}
+
+// SetFileURL sets the "file_url" field.
+func (_u *ExportUpdateOne) SetFileURL(v string) *ExportUpdateOne {
+	_u.mutation.SetFileURL(v)
+	return _u
+}
+
+// SetNillableFileURL sets the "file_url" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableFileURL(v *string) *ExportUpdateOne {
+	if v != nil {
+		_u.SetFileURL(*v)
+	}
+	return _u
+}
+
+// ClearFileURL clears the value of the "file_url" field.
+func (_u *ExportUpdateOne) ClearFileURL() *ExportUpdateOne {
+	_u.mutation.ClearFileURL()
+	return _u
+}
+
+// SetFilePath sets the "file_path" field.
+func (_u *ExportUpdateOne) SetFilePath(v string) *ExportUpdateOne {
+	_u.mutation.SetFilePath(v)
+	return _u
+}
+
+// SetNillableFilePath sets the "file_path" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableFilePath(v *string) *ExportUpdateOne {
+	if v != nil {
+		_u.SetFilePath(*v)
+	}
+	return _u
+}
+
+// ClearFilePath clears the value of the "file_path" field.
+func (_u *ExportUpdateOne) ClearFilePath() *ExportUpdateOne {
+	_u.mutation.ClearFilePath()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ExportUpdateOne) SetStatus(v export.Status) *ExportUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableStatus(v *export.Status) *ExportUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_u *ExportUpdateOne) SetErrorMessage(v string) *ExportUpdateOne {
+	_u.mutation.SetErrorMessage(v)
+	return _u
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableErrorMessage(v *string) *ExportUpdateOne {
+	if v != nil {
+		_u.SetErrorMessage(*v)
+	}
+	return _u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (_u *ExportUpdateOne) ClearErrorMessage() *ExportUpdateOne {
+	_u.mutation.ClearErrorMessage()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *ExportUpdateOne) SetExpiresAt(v time.Time) *ExportUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableExpiresAt(v *time.Time) *ExportUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *ExportUpdateOne) ClearExpiresAt() *ExportUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetSince sets the "since" field.
+func (_u *ExportUpdateOne) SetSince(v time.Time) *ExportUpdateOne {
+	_u.mutation.SetSince(v)
+	return _u
+}
+
+// SetNillableSince sets the "since" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableSince(v *time.Time) *ExportUpdateOne {
+	if v != nil {
+		_u.SetSince(*v)
+	}
+	return _u
+}
+
+// ClearSince clears the value of the "since" field.
+func (_u *ExportUpdateOne) ClearSince() *ExportUpdateOne {
+	_u.mutation.ClearSince()
+	return _u
+}
+
+// SetHighWaterMark sets the "high_water_mark" field.
+func (_u *ExportUpdateOne) SetHighWaterMark(v time.Time) *ExportUpdateOne {
+	_u.mutation.SetHighWaterMark(v)
+	return _u
+}
+
+// SetNillableHighWaterMark sets the "high_water_mark" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableHighWaterMark(v *time.Time) *ExportUpdateOne {
+	if v != nil {
+		_u.SetHighWaterMark(*v)
+	}
+	return _u
+}
+
+// ClearHighWaterMark clears the value of the "high_water_mark" field.
+func (_u *ExportUpdateOne) ClearHighWaterMark() *ExportUpdateOne {
+	_u.mutation.ClearHighWaterMark()
+	return _u
+}
+
+// SetHighWaterLeadID sets the "high_water_lead_id" field.
+func (_u *ExportUpdateOne) SetHighWaterLeadID(v int) *ExportUpdateOne {
+	_u.mutation.ResetHighWaterLeadID()
+	_u.mutation.SetHighWaterLeadID(v)
+	return _u
+}
+
+// SetNillableHighWaterLeadID sets the "high_water_lead_id" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableHighWaterLeadID(v *int) *ExportUpdateOne {
+	if v != nil {
+		_u.SetHighWaterLeadID(*v)
+	}
+	return _u
+}
+
+// AddHighWaterLeadID adds value to the "high_water_lead_id" field.
+func (_u *ExportUpdateOne) AddHighWaterLeadID(v int) *ExportUpdateOne {
+	_u.mutation.AddHighWaterLeadID(v)
+	return _u
+}
+
+// ClearHighWaterLeadID clears the value of the "high_water_lead_id" field.
+func (_u *ExportUpdateOne) ClearHighWaterLeadID() *ExportUpdateOne {
+	_u.mutation.ClearHighWaterLeadID()
+	return _u
+}
+
+// SetRedactionProfile sets the "redaction_profile" field.
+func (_u *ExportUpdateOne) SetRedactionProfile(v string) *ExportUpdateOne {
+	_u.mutation.SetRedactionProfile(v)
+	return _u
+}
+
+// SetNillableRedactionProfile sets the "redaction_profile" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableRedactionProfile(v *string) *ExportUpdateOne {
+	if v != nil {
+		_u.SetRedactionProfile(*v)
 	}
-	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   export.UserTable,
-			Columns: []string{export.UserColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	return _u
+}
+
+// ClearRedactionProfile clears the value of the "redaction_profile" field.
+func (_u *ExportUpdateOne) ClearRedactionProfile() *ExportUpdateOne {
+	_u.mutation.ClearRedactionProfile()
+	return _u
+}
+
+// SetDeliveryURL sets the "delivery_url" field.
+func (_u *ExportUpdateOne) SetDeliveryURL(v string) *ExportUpdateOne {
+	_u.mutation.SetDeliveryURL(v)
+	return _u
+}
+
+// SetNillableDeliveryURL sets the "delivery_url" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableDeliveryURL(v *string) *ExportUpdateOne {
+	if v != nil {
+		_u.SetDeliveryURL(*v)
 	}
-	if _u.mutation.OrganizationCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   export.OrganizationTable,
-			Columns: []string{export.OrganizationColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	return _u
+}
+
+// ClearDeliveryURL clears the value of the "delivery_url" field.
+func (_u *ExportUpdateOne) ClearDeliveryURL() *ExportUpdateOne {
+	_u.mutation.ClearDeliveryURL()
+	return _u
+}
+
+// SetDeliverySecret sets the "delivery_secret" field.
+func (_u *ExportUpdateOne) SetDeliverySecret(v string) *ExportUpdateOne {
+	_u.mutation.SetDeliverySecret(v)
+	return _u
+}
+
+// SetNillableDeliverySecret sets the "delivery_secret" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableDeliverySecret(v *string) *ExportUpdateOne {
+	if v != nil {
+		_u.SetDeliverySecret(*v)
 	}
-	if nodes := _u.mutation.OrganizationIDs(); len(nodes) > 0 {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   export.OrganizationTable,
-			Columns: []string{export.OrganizationColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	return _u
+}
+
+// ClearDeliverySecret clears the value of the "delivery_secret" field.
+func (_u *ExportUpdateOne) ClearDeliverySecret() *ExportUpdateOne {
+	_u.mutation.ClearDeliverySecret()
+	return _u
+}
+
+// SetDeliveryStatus sets the "delivery_status" field.
+func (_u *ExportUpdateOne) SetDeliveryStatus(v export.DeliveryStatus) *ExportUpdateOne {
+	_u.mutation.SetDeliveryStatus(v)
+	return _u
+}
+
+// SetNillableDeliveryStatus sets the "delivery_status" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableDeliveryStatus(v *export.DeliveryStatus) *ExportUpdateOne {
+	if v != nil {
+		_u.SetDeliveryStatus(*v)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{export.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
+	return _u
+}
+
+// ClearDeliveryStatus clears the value of the "delivery_status" field.
+func (_u *ExportUpdateOne) ClearDeliveryStatus() *ExportUpdateOne {
+	_u.mutation.ClearDeliveryStatus()
+	return _u
+}
+
+// SetDeliveryAttempts sets the "delivery_attempts" field.
+func (_u *ExportUpdateOne) SetDeliveryAttempts(v int) *ExportUpdateOne {
+	_u.mutation.ResetDeliveryAttempts()
+	_u.mutation.SetDeliveryAttempts(v)
+	return _u
+}
+
+// SetNillableDeliveryAttempts sets the "delivery_attempts" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableDeliveryAttempts(v *int) *ExportUpdateOne {
+	if v != nil {
+		_u.SetDeliveryAttempts(*v)
 	}
-	_u.mutation.done = true
-	return _node, nil
+	return _u
 }
 
-// ExportUpdateOne is the builder for updating a single Export entity.
-type ExportUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *ExportMutation
+// AddDeliveryAttempts adds value to the "delivery_attempts" field.
+func (_u *ExportUpdateOne) AddDeliveryAttempts(v int) *ExportUpdateOne {
+	_u.mutation.AddDeliveryAttempts(v)
+	return _u
 }
 
-// SetUserID sets the "user_id" field.
-func (_u *ExportUpdateOne) SetUserID(v int) *ExportUpdateOne {
-	_u.mutation.SetUserID(v)
+// SetDeliveredAt sets the "delivered_at" field.
+func (_u *ExportUpdateOne) SetDeliveredAt(v time.Time) *ExportUpdateOne {
+	_u.mutation.SetDeliveredAt(v)
 	return _u
 }
 
-// SetNillableUserID sets the "user_id" field if the given value is not nil.
-func (_u *ExportUpdateOne) SetNillableUserID(v *int) *ExportUpdateOne {
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableDeliveredAt(v *time.Time) *ExportUpdateOne {
 	if v != nil {
-		_u.SetUserID(*v)
+		_u.SetDeliveredAt(*v)
 	}
 	return _u
 }
 
-// SetOrganizationID sets the "organization_id" field.
-func (_u *ExportUpdateOne) SetOrganizationID(v int) *ExportUpdateOne {
-	_u.mutation.SetOrganizationID(v)
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (_u *ExportUpdateOne) ClearDeliveredAt() *ExportUpdateOne {
+	_u.mutation.ClearDeliveredAt()
 	return _u
 }
 
-// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
-func (_u *ExportUpdateOne) SetNillableOrganizationID(v *int) *ExportUpdateOne {
+// SetDeliveryError sets the "delivery_error" field.
+func (_u *ExportUpdateOne) SetDeliveryError(v string) *ExportUpdateOne {
+	_u.mutation.SetDeliveryError(v)
+	return _u
+}
+
+// SetNillableDeliveryError sets the "delivery_error" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableDeliveryError(v *string) *ExportUpdateOne {
 	if v != nil {
-		_u.SetOrganizationID(*v)
+		_u.SetDeliveryError(*v)
 	}
 	return _u
 }
 
-// ClearOrganizationID clears the value of the "organization_id" field.
-func (_u *ExportUpdateOne) ClearOrganizationID() *ExportUpdateOne {
-	_u.mutation.ClearOrganizationID()
+// ClearDeliveryError clears the value of the "delivery_error" field.
+func (_u *ExportUpdateOne) ClearDeliveryError() *ExportUpdateOne {
+	_u.mutation.ClearDeliveryError()
 	return _u
 }
 
-// SetFormat sets the "format" field.
-func (_u *ExportUpdateOne) SetFormat(v export.Format) *ExportUpdateOne {
-	_u.mutation.SetFormat(v)
+// SetDeliveryMethod sets the "delivery_method" field.
+func (_u *ExportUpdateOne) SetDeliveryMethod(v export.DeliveryMethod) *ExportUpdateOne {
+	_u.mutation.SetDeliveryMethod(v)
 	return _u
 }
 
-// SetNillableFormat sets the "format" field if the given value is not nil.
-func (_u *ExportUpdateOne) SetNillableFormat(v *export.Format) *ExportUpdateOne {
+// SetNillableDeliveryMethod sets the "delivery_method" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableDeliveryMethod(v *export.DeliveryMethod) *ExportUpdateOne {
 	if v != nil {
-		_u.SetFormat(*v)
+		_u.SetDeliveryMethod(*v)
 	}
 	return _u
 }
 
-// SetFiltersApplied sets the "filters_applied" field.
-func (_u *ExportUpdateOne) SetFiltersApplied(v map[string]interface{}) *ExportUpdateOne {
-	_u.mutation.SetFiltersApplied(v)
+// ClearDeliveryMethod clears the value of the "delivery_method" field.
+func (_u *ExportUpdateOne) ClearDeliveryMethod() *ExportUpdateOne {
+	_u.mutation.ClearDeliveryMethod()
 	return _u
 }
 
-// ClearFiltersApplied clears the value of the "filters_applied" field.
-func (_u *ExportUpdateOne) ClearFiltersApplied() *ExportUpdateOne {
-	_u.mutation.ClearFiltersApplied()
+// SetSpreadsheetID sets the "spreadsheet_id" field.
+func (_u *ExportUpdateOne) SetSpreadsheetID(v string) *ExportUpdateOne {
+	_u.mutation.SetSpreadsheetID(v)
 	return _u
 }
 
-// SetLeadCount sets the "lead_count" field.
-func (_u *ExportUpdateOne) SetLeadCount(v int) *ExportUpdateOne {
-	_u.mutation.ResetLeadCount()
-	_u.mutation.SetLeadCount(v)
+// SetNillableSpreadsheetID sets the "spreadsheet_id" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableSpreadsheetID(v *string) *ExportUpdateOne {
+	if v != nil {
+		_u.SetSpreadsheetID(*v)
+	}
 	return _u
 }
 
-// SetNillableLeadCount sets the "lead_count" field if the given value is not nil.
-func (_u *ExportUpdateOne) SetNillableLeadCount(v *int) *ExportUpdateOne {
+// ClearSpreadsheetID clears the value of the "spreadsheet_id" field.
+func (_u *ExportUpdateOne) ClearSpreadsheetID() *ExportUpdateOne {
+	_u.mutation.ClearSpreadsheetID()
+	return _u
+}
+
+// SetSheetURL sets the "sheet_url" field.
+func (_u *ExportUpdateOne) SetSheetURL(v string) *ExportUpdateOne {
+	_u.mutation.SetSheetURL(v)
+	return _u
+}
+
+// SetNillableSheetURL sets the "sheet_url" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableSheetURL(v *string) *ExportUpdateOne {
 	if v != nil {
-		_u.SetLeadCount(*v)
+		_u.SetSheetURL(*v)
 	}
 	return _u
 }
 
-// AddLeadCount adds value to the "lead_count" field.
-func (_u *ExportUpdateOne) AddLeadCount(v int) *ExportUpdateOne {
-	_u.mutation.AddLeadCount(v)
+// ClearSheetURL clears the value of the "sheet_url" field.
+func (_u *ExportUpdateOne) ClearSheetURL() *ExportUpdateOne {
+	_u.mutation.ClearSheetURL()
 	return _u
 }
 
-// SetFileURL sets the "file_url" field.
-func (_u *ExportUpdateOne) SetFileURL(v string) *ExportUpdateOne {
-	_u.mutation.SetFileURL(v)
+// SetDeliveryWarning sets the "delivery_warning" field.
+func (_u *ExportUpdateOne) SetDeliveryWarning(v string) *ExportUpdateOne {
+	_u.mutation.SetDeliveryWarning(v)
 	return _u
 }
 
-// SetNillableFileURL sets the "file_url" field if the given value is not nil.
-func (_u *ExportUpdateOne) SetNillableFileURL(v *string) *ExportUpdateOne {
+// SetNillableDeliveryWarning sets the "delivery_warning" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableDeliveryWarning(v *string) *ExportUpdateOne {
 	if v != nil {
-		_u.SetFileURL(*v)
+		_u.SetDeliveryWarning(*v)
 	}
 	return _u
 }
 
-// ClearFileURL clears the value of the "file_url" field.
-func (_u *ExportUpdateOne) ClearFileURL() *ExportUpdateOne {
-	_u.mutation.ClearFileURL()
+// ClearDeliveryWarning clears the value of the "delivery_warning" field.
+func (_u *ExportUpdateOne) ClearDeliveryWarning() *ExportUpdateOne {
+	_u.mutation.ClearDeliveryWarning()
 	return _u
 }
 
-// SetFilePath sets the "file_path" field.
-func (_u *ExportUpdateOne) SetFilePath(v string) *ExportUpdateOne {
-	_u.mutation.SetFilePath(v)
+// SetCompression sets the "compression" field.
+func (_u *ExportUpdateOne) SetCompression(v export.Compression) *ExportUpdateOne {
+	_u.mutation.SetCompression(v)
 	return _u
 }
 
-// SetNillableFilePath sets the "file_path" field if the given value is not nil.
-func (_u *ExportUpdateOne) SetNillableFilePath(v *string) *ExportUpdateOne {
+// SetNillableCompression sets the "compression" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableCompression(v *export.Compression) *ExportUpdateOne {
 	if v != nil {
-		_u.SetFilePath(*v)
+		_u.SetCompression(*v)
 	}
 	return _u
 }
 
-// ClearFilePath clears the value of the "file_path" field.
-func (_u *ExportUpdateOne) ClearFilePath() *ExportUpdateOne {
-	_u.mutation.ClearFilePath()
+// ClearCompression clears the value of the "compression" field.
+func (_u *ExportUpdateOne) ClearCompression() *ExportUpdateOne {
+	_u.mutation.ClearCompression()
 	return _u
 }
 
-// SetStatus sets the "status" field.
-func (_u *ExportUpdateOne) SetStatus(v export.Status) *ExportUpdateOne {
-	_u.mutation.SetStatus(v)
+// SetFileSize sets the "file_size" field.
+func (_u *ExportUpdateOne) SetFileSize(v int64) *ExportUpdateOne {
+	_u.mutation.ResetFileSize()
+	_u.mutation.SetFileSize(v)
 	return _u
 }
 
-// SetNillableStatus sets the "status" field if the given value is not nil.
-func (_u *ExportUpdateOne) SetNillableStatus(v *export.Status) *ExportUpdateOne {
+// SetNillableFileSize sets the "file_size" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableFileSize(v *int64) *ExportUpdateOne {
 	if v != nil {
-		_u.SetStatus(*v)
+		_u.SetFileSize(*v)
 	}
 	return _u
 }
 
-// SetErrorMessage sets the "error_message" field.
-func (_u *ExportUpdateOne) SetErrorMessage(v string) *ExportUpdateOne {
-	_u.mutation.SetErrorMessage(v)
+// AddFileSize adds value to the "file_size" field.
+func (_u *ExportUpdateOne) AddFileSize(v int64) *ExportUpdateOne {
+	_u.mutation.AddFileSize(v)
 	return _u
 }
 
-// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
-func (_u *ExportUpdateOne) SetNillableErrorMessage(v *string) *ExportUpdateOne {
+// ClearFileSize clears the value of the "file_size" field.
+func (_u *ExportUpdateOne) ClearFileSize() *ExportUpdateOne {
+	_u.mutation.ClearFileSize()
+	return _u
+}
+
+// SetDownloadCount sets the "download_count" field.
+func (_u *ExportUpdateOne) SetDownloadCount(v int) *ExportUpdateOne {
+	_u.mutation.ResetDownloadCount()
+	_u.mutation.SetDownloadCount(v)
+	return _u
+}
+
+// SetNillableDownloadCount sets the "download_count" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableDownloadCount(v *int) *ExportUpdateOne {
 	if v != nil {
-		_u.SetErrorMessage(*v)
+		_u.SetDownloadCount(*v)
 	}
 	return _u
 }
 
-// ClearErrorMessage clears the value of the "error_message" field.
-func (_u *ExportUpdateOne) ClearErrorMessage() *ExportUpdateOne {
-	_u.mutation.ClearErrorMessage()
+// AddDownloadCount adds value to the "download_count" field.
+func (_u *ExportUpdateOne) AddDownloadCount(v int) *ExportUpdateOne {
+	_u.mutation.AddDownloadCount(v)
 	return _u
 }
 
-// SetExpiresAt sets the "expires_at" field.
-func (_u *ExportUpdateOne) SetExpiresAt(v time.Time) *ExportUpdateOne {
-	_u.mutation.SetExpiresAt(v)
+// SetLastDownloadedAt sets the "last_downloaded_at" field.
+func (_u *ExportUpdateOne) SetLastDownloadedAt(v time.Time) *ExportUpdateOne {
+	_u.mutation.SetLastDownloadedAt(v)
 	return _u
 }
 
-// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
-func (_u *ExportUpdateOne) SetNillableExpiresAt(v *time.Time) *ExportUpdateOne {
+// SetNillableLastDownloadedAt sets the "last_downloaded_at" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableLastDownloadedAt(v *time.Time) *ExportUpdateOne {
 	if v != nil {
-		_u.SetExpiresAt(*v)
+		_u.SetLastDownloadedAt(*v)
 	}
 	return _u
 }
 
-// ClearExpiresAt clears the value of the "expires_at" field.
-func (_u *ExportUpdateOne) ClearExpiresAt() *ExportUpdateOne {
-	_u.mutation.ClearExpiresAt()
+// ClearLastDownloadedAt clears the value of the "last_downloaded_at" field.
+func (_u *ExportUpdateOne) ClearLastDownloadedAt() *ExportUpdateOne {
+	_u.mutation.ClearLastDownloadedAt()
 	return _u
 }
 
@@ -711,17 +1679,68 @@ func (_u *ExportUpdateOne) check() error {
 			return &ValidationError{Name: "lead_count", err: fmt.Errorf(`ent: validator failed for field "Export.lead_count": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.SkippedCount(); ok {
+		if err := export.SkippedCountValidator(v); err != nil {
+			return &ValidationError{Name: "skipped_count", err: fmt.Errorf(`ent: validator failed for field "Export.skipped_count": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Status(); ok {
 		if err := export.StatusValidator(v); err != nil {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Export.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.RedactionProfile(); ok {
+		if err := export.RedactionProfileValidator(v); err != nil {
+			return &ValidationError{Name: "redaction_profile", err: fmt.Errorf(`ent: validator failed for field "Export.redaction_profile": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeliveryURL(); ok {
+		if err := export.DeliveryURLValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_url", err: fmt.Errorf(`ent: validator failed for field "Export.delivery_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeliveryStatus(); ok {
+		if err := export.DeliveryStatusValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_status", err: fmt.Errorf(`ent: validator failed for field "Export.delivery_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeliveryAttempts(); ok {
+		if err := export.DeliveryAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_attempts", err: fmt.Errorf(`ent: validator failed for field "Export.delivery_attempts": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeliveryMethod(); ok {
+		if err := export.DeliveryMethodValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_method", err: fmt.Errorf(`ent: validator failed for field "Export.delivery_method": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SheetURL(); ok {
+		if err := export.SheetURLValidator(v); err != nil {
+			return &ValidationError{Name: "sheet_url", err: fmt.Errorf(`ent: validator failed for field "Export.sheet_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Compression(); ok {
+		if err := export.CompressionValidator(v); err != nil {
+			return &ValidationError{Name: "compression", err: fmt.Errorf(`ent: validator failed for field "Export.compression": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DownloadCount(); ok {
+		if err := export.DownloadCountValidator(v); err != nil {
+			return &ValidationError{Name: "download_count", err: fmt.Errorf(`ent: validator failed for field "Export.download_count": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Export.user"`)
 	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ExportUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ExportUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ExportUpdateOne) sqlSave(ctx context.Context) (_node *Export, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -766,6 +1785,12 @@ func (_u *ExportUpdateOne) sqlSave(ctx context.Context) (_node *Export, err erro
 	if value, ok := _u.mutation.AddedLeadCount(); ok {
 		_spec.AddField(export.FieldLeadCount, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.SkippedCount(); ok {
+		_spec.SetField(export.FieldSkippedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSkippedCount(); ok {
+		_spec.AddField(export.FieldSkippedCount, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.FileURL(); ok {
 		_spec.SetField(export.FieldFileURL, field.TypeString, value)
 	}
@@ -793,6 +1818,120 @@ func (_u *ExportUpdateOne) sqlSave(ctx context.Context) (_node *Export, err erro
 	if _u.mutation.ExpiresAtCleared() {
 		_spec.ClearField(export.FieldExpiresAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.Since(); ok {
+		_spec.SetField(export.FieldSince, field.TypeTime, value)
+	}
+	if _u.mutation.SinceCleared() {
+		_spec.ClearField(export.FieldSince, field.TypeTime)
+	}
+	if value, ok := _u.mutation.HighWaterMark(); ok {
+		_spec.SetField(export.FieldHighWaterMark, field.TypeTime, value)
+	}
+	if _u.mutation.HighWaterMarkCleared() {
+		_spec.ClearField(export.FieldHighWaterMark, field.TypeTime)
+	}
+	if value, ok := _u.mutation.HighWaterLeadID(); ok {
+		_spec.SetField(export.FieldHighWaterLeadID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHighWaterLeadID(); ok {
+		_spec.AddField(export.FieldHighWaterLeadID, field.TypeInt, value)
+	}
+	if _u.mutation.HighWaterLeadIDCleared() {
+		_spec.ClearField(export.FieldHighWaterLeadID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.RedactionProfile(); ok {
+		_spec.SetField(export.FieldRedactionProfile, field.TypeString, value)
+	}
+	if _u.mutation.RedactionProfileCleared() {
+		_spec.ClearField(export.FieldRedactionProfile, field.TypeString)
+	}
+	if value, ok := _u.mutation.DeliveryURL(); ok {
+		_spec.SetField(export.FieldDeliveryURL, field.TypeString, value)
+	}
+	if _u.mutation.DeliveryURLCleared() {
+		_spec.ClearField(export.FieldDeliveryURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.DeliverySecret(); ok {
+		_spec.SetField(export.FieldDeliverySecret, field.TypeString, value)
+	}
+	if _u.mutation.DeliverySecretCleared() {
+		_spec.ClearField(export.FieldDeliverySecret, field.TypeString)
+	}
+	if value, ok := _u.mutation.DeliveryStatus(); ok {
+		_spec.SetField(export.FieldDeliveryStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.DeliveryStatusCleared() {
+		_spec.ClearField(export.FieldDeliveryStatus, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.DeliveryAttempts(); ok {
+		_spec.SetField(export.FieldDeliveryAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDeliveryAttempts(); ok {
+		_spec.AddField(export.FieldDeliveryAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DeliveredAt(); ok {
+		_spec.SetField(export.FieldDeliveredAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeliveredAtCleared() {
+		_spec.ClearField(export.FieldDeliveredAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeliveryError(); ok {
+		_spec.SetField(export.FieldDeliveryError, field.TypeString, value)
+	}
+	if _u.mutation.DeliveryErrorCleared() {
+		_spec.ClearField(export.FieldDeliveryError, field.TypeString)
+	}
+	if value, ok := _u.mutation.DeliveryMethod(); ok {
+		_spec.SetField(export.FieldDeliveryMethod, field.TypeEnum, value)
+	}
+	if _u.mutation.DeliveryMethodCleared() {
+		_spec.ClearField(export.FieldDeliveryMethod, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.SpreadsheetID(); ok {
+		_spec.SetField(export.FieldSpreadsheetID, field.TypeString, value)
+	}
+	if _u.mutation.SpreadsheetIDCleared() {
+		_spec.ClearField(export.FieldSpreadsheetID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SheetURL(); ok {
+		_spec.SetField(export.FieldSheetURL, field.TypeString, value)
+	}
+	if _u.mutation.SheetURLCleared() {
+		_spec.ClearField(export.FieldSheetURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.DeliveryWarning(); ok {
+		_spec.SetField(export.FieldDeliveryWarning, field.TypeString, value)
+	}
+	if _u.mutation.DeliveryWarningCleared() {
+		_spec.ClearField(export.FieldDeliveryWarning, field.TypeString)
+	}
+	if value, ok := _u.mutation.Compression(); ok {
+		_spec.SetField(export.FieldCompression, field.TypeEnum, value)
+	}
+	if _u.mutation.CompressionCleared() {
+		_spec.ClearField(export.FieldCompression, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.FileSize(); ok {
+		_spec.SetField(export.FieldFileSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedFileSize(); ok {
+		_spec.AddField(export.FieldFileSize, field.TypeInt64, value)
+	}
+	if _u.mutation.FileSizeCleared() {
+		_spec.ClearField(export.FieldFileSize, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.DownloadCount(); ok {
+		_spec.SetField(export.FieldDownloadCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDownloadCount(); ok {
+		_spec.AddField(export.FieldDownloadCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastDownloadedAt(); ok {
+		_spec.SetField(export.FieldLastDownloadedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastDownloadedAtCleared() {
+		_spec.ClearField(export.FieldLastDownloadedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(export.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -854,6 +1993,7 @@ func (_u *ExportUpdateOne) sqlSave(ctx context.Context) (_node *Export, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Export{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,285 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/jordanlanch/industrydb/ent/exporttemplate"
+	"github.com/jordanlanch/industrydb/ent/organization"
+	"github.com/jordanlanch/industrydb/ent/savedsearch"
+	"github.com/jordanlanch/industrydb/ent/user"
+)
+
+// ExportTemplate is the model entity for the ExportTemplate schema.
+type ExportTemplate struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// User who created this template
+	UserID int `json:"user_id,omitempty"`
+	// Organization the template is shared with (nil = personal)
+	OrganizationID *int `json:"organization_id,omitempty"`
+	// Name of this template
+	Name string `json:"name,omitempty"`
+	// Export file format
+	Format exporttemplate.Format `json:"format,omitempty"`
+	// Columns to export, in order (empty = all columns)
+	Columns []string `json:"columns,omitempty"`
+	// Saved search whose filters select the leads
+	SavedSearchID *int `json:"saved_search_id,omitempty"`
+	// Inline filters in saved search format, when no saved search is referenced
+	Filters map[string]interface{} `json:"filters,omitempty"`
+	// Maximum leads per export (0 = default)
+	MaxLeads int `json:"max_leads,omitempty"`
+	// When this template was created
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Last update timestamp
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ExportTemplateQuery when eager-loading is set.
+	Edges        ExportTemplateEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ExportTemplateEdges holds the relations/edges for other nodes in the graph.
+type ExportTemplateEdges struct {
+	// User who owns this template
+	User *User `json:"user,omitempty"`
+	// Organization the template is shared with
+	Organization *Organization `json:"organization,omitempty"`
+	// Saved search the template exports
+	SavedSearch *SavedSearch `json:"saved_search,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ExportTemplateEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ExportTemplateEdges) OrganizationOrErr() (*Organization, error) {
+	if e.Organization != nil {
+		return e.Organization, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// SavedSearchOrErr returns the SavedSearch value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ExportTemplateEdges) SavedSearchOrErr() (*SavedSearch, error) {
+	if e.SavedSearch != nil {
+		return e.SavedSearch, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: savedsearch.Label}
+	}
+	return nil, &NotLoadedError{edge: "saved_search"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ExportTemplate) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case exporttemplate.FieldColumns, exporttemplate.FieldFilters:
+			values[i] = new([]byte)
+		case exporttemplate.FieldID, exporttemplate.FieldUserID, exporttemplate.FieldOrganizationID, exporttemplate.FieldSavedSearchID, exporttemplate.FieldMaxLeads:
+			values[i] = new(sql.NullInt64)
+		case exporttemplate.FieldName, exporttemplate.FieldFormat:
+			values[i] = new(sql.NullString)
+		case exporttemplate.FieldCreatedAt, exporttemplate.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ExportTemplate fields.
+func (_m *ExportTemplate) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case exporttemplate.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case exporttemplate.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case exporttemplate.FieldOrganizationID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value.Valid {
+				_m.OrganizationID = new(int)
+				*_m.OrganizationID = int(value.Int64)
+			}
+		case exporttemplate.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case exporttemplate.FieldFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field format", values[i])
+			} else if value.Valid {
+				_m.Format = exporttemplate.Format(value.String)
+			}
+		case exporttemplate.FieldColumns:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field columns", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Columns); err != nil {
+					return fmt.Errorf("unmarshal field columns: %w", err)
+				}
+			}
+		case exporttemplate.FieldSavedSearchID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field saved_search_id", values[i])
+			} else if value.Valid {
+				_m.SavedSearchID = new(int)
+				*_m.SavedSearchID = int(value.Int64)
+			}
+		case exporttemplate.FieldFilters:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field filters", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Filters); err != nil {
+					return fmt.Errorf("unmarshal field filters: %w", err)
+				}
+			}
+		case exporttemplate.FieldMaxLeads:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_leads", values[i])
+			} else if value.Valid {
+				_m.MaxLeads = int(value.Int64)
+			}
+		case exporttemplate.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case exporttemplate.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ExportTemplate.
+// This includes values selected through modifiers, order, etc.
+func (_m *ExportTemplate) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the ExportTemplate entity.
+func (_m *ExportTemplate) QueryUser() *UserQuery {
+	return NewExportTemplateClient(_m.config).QueryUser(_m)
+}
+
+// QueryOrganization queries the "organization" edge of the ExportTemplate entity.
+func (_m *ExportTemplate) QueryOrganization() *OrganizationQuery {
+	return NewExportTemplateClient(_m.config).QueryOrganization(_m)
+}
+
+// QuerySavedSearch queries the "saved_search" edge of the ExportTemplate entity.
+func (_m *ExportTemplate) QuerySavedSearch() *SavedSearchQuery {
+	return NewExportTemplateClient(_m.config).QuerySavedSearch(_m)
+}
+
+// Update returns a builder for updating this ExportTemplate.
+// Note that you need to call ExportTemplate.Unwrap() before calling this method if this ExportTemplate
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ExportTemplate) Update() *ExportTemplateUpdateOne {
+	return NewExportTemplateClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ExportTemplate entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ExportTemplate) Unwrap() *ExportTemplate {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ExportTemplate is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ExportTemplate) String() string {
+	var builder strings.Builder
+	builder.WriteString("ExportTemplate(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	if v := _m.OrganizationID; v != nil {
+		builder.WriteString("organization_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("format=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Format))
+	builder.WriteString(", ")
+	builder.WriteString("columns=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Columns))
+	builder.WriteString(", ")
+	if v := _m.SavedSearchID; v != nil {
+		builder.WriteString("saved_search_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("filters=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Filters))
+	builder.WriteString(", ")
+	builder.WriteString("max_leads=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxLeads))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ExportTemplates is a parsable slice of ExportTemplate.
+type ExportTemplates []*ExportTemplate
@@ -0,0 +1,480 @@
+// Code generated by ent, DO NOT EDIT.
+
+package exporttemplate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldEQ(FieldUserID, v))
+}
+
+// OrganizationID applies equality check predicate on the "organization_id" field. It's identical to OrganizationIDEQ.
+func OrganizationID(v int) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldEQ(FieldName, v))
+}
+
+// SavedSearchID applies equality check predicate on the "saved_search_id" field. It's identical to SavedSearchIDEQ.
+func SavedSearchID(v int) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldEQ(FieldSavedSearchID, v))
+}
+
+// MaxLeads applies equality check predicate on the "max_leads" field. It's identical to MaxLeadsEQ.
+func MaxLeads(v int) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldEQ(FieldMaxLeads, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// OrganizationIDEQ applies the EQ predicate on the "organization_id" field.
+func OrganizationIDEQ(v int) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDNEQ applies the NEQ predicate on the "organization_id" field.
+func OrganizationIDNEQ(v int) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldNEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDIn applies the In predicate on the "organization_id" field.
+func OrganizationIDIn(vs ...int) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDNotIn applies the NotIn predicate on the "organization_id" field.
+func OrganizationIDNotIn(vs ...int) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldNotIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDIsNil applies the IsNil predicate on the "organization_id" field.
+func OrganizationIDIsNil() predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldIsNull(FieldOrganizationID))
+}
+
+// OrganizationIDNotNil applies the NotNil predicate on the "organization_id" field.
+func OrganizationIDNotNil() predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldNotNull(FieldOrganizationID))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldContainsFold(FieldName, v))
+}
+
+// FormatEQ applies the EQ predicate on the "format" field.
+func FormatEQ(v Format) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldEQ(FieldFormat, v))
+}
+
+// FormatNEQ applies the NEQ predicate on the "format" field.
+func FormatNEQ(v Format) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldNEQ(FieldFormat, v))
+}
+
+// FormatIn applies the In predicate on the "format" field.
+func FormatIn(vs ...Format) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldIn(FieldFormat, vs...))
+}
+
+// FormatNotIn applies the NotIn predicate on the "format" field.
+func FormatNotIn(vs ...Format) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldNotIn(FieldFormat, vs...))
+}
+
+// ColumnsIsNil applies the IsNil predicate on the "columns" field.
+func ColumnsIsNil() predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldIsNull(FieldColumns))
+}
+
+// ColumnsNotNil applies the NotNil predicate on the "columns" field.
+func ColumnsNotNil() predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldNotNull(FieldColumns))
+}
+
+// SavedSearchIDEQ applies the EQ predicate on the "saved_search_id" field.
+func SavedSearchIDEQ(v int) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldEQ(FieldSavedSearchID, v))
+}
+
+// SavedSearchIDNEQ applies the NEQ predicate on the "saved_search_id" field.
+func SavedSearchIDNEQ(v int) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldNEQ(FieldSavedSearchID, v))
+}
+
+// SavedSearchIDIn applies the In predicate on the "saved_search_id" field.
+func SavedSearchIDIn(vs ...int) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldIn(FieldSavedSearchID, vs...))
+}
+
+// SavedSearchIDNotIn applies the NotIn predicate on the "saved_search_id" field.
+func SavedSearchIDNotIn(vs ...int) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldNotIn(FieldSavedSearchID, vs...))
+}
+
+// SavedSearchIDIsNil applies the IsNil predicate on the "saved_search_id" field.
+func SavedSearchIDIsNil() predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldIsNull(FieldSavedSearchID))
+}
+
+// SavedSearchIDNotNil applies the NotNil predicate on the "saved_search_id" field.
+func SavedSearchIDNotNil() predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldNotNull(FieldSavedSearchID))
+}
+
+// FiltersIsNil applies the IsNil predicate on the "filters" field.
+func FiltersIsNil() predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldIsNull(FieldFilters))
+}
+
+// FiltersNotNil applies the NotNil predicate on the "filters" field.
+func FiltersNotNil() predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldNotNull(FieldFilters))
+}
+
+// MaxLeadsEQ applies the EQ predicate on the "max_leads" field.
+func MaxLeadsEQ(v int) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldEQ(FieldMaxLeads, v))
+}
+
+// MaxLeadsNEQ applies the NEQ predicate on the "max_leads" field.
+func MaxLeadsNEQ(v int) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldNEQ(FieldMaxLeads, v))
+}
+
+// MaxLeadsIn applies the In predicate on the "max_leads" field.
+func MaxLeadsIn(vs ...int) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldIn(FieldMaxLeads, vs...))
+}
+
+// MaxLeadsNotIn applies the NotIn predicate on the "max_leads" field.
+func MaxLeadsNotIn(vs ...int) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldNotIn(FieldMaxLeads, vs...))
+}
+
+// MaxLeadsGT applies the GT predicate on the "max_leads" field.
+func MaxLeadsGT(v int) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldGT(FieldMaxLeads, v))
+}
+
+// MaxLeadsGTE applies the GTE predicate on the "max_leads" field.
+func MaxLeadsGTE(v int) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldGTE(FieldMaxLeads, v))
+}
+
+// MaxLeadsLT applies the LT predicate on the "max_leads" field.
+func MaxLeadsLT(v int) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldLT(FieldMaxLeads, v))
+}
+
+// MaxLeadsLTE applies the LTE predicate on the "max_leads" field.
+func MaxLeadsLTE(v int) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldLTE(FieldMaxLeads, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.ExportTemplate {
+	return predicate.ExportTemplate(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.ExportTemplate {
+	return predicate.ExportTemplate(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOrganization applies the HasEdge predicate on the "organization" edge.
+func HasOrganization() predicate.ExportTemplate {
+	return predicate.ExportTemplate(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrganizationWith applies the HasEdge predicate on the "organization" edge with a given conditions (other predicates).
+func HasOrganizationWith(preds ...predicate.Organization) predicate.ExportTemplate {
+	return predicate.ExportTemplate(func(s *sql.Selector) {
+		step := newOrganizationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSavedSearch applies the HasEdge predicate on the "saved_search" edge.
+func HasSavedSearch() predicate.ExportTemplate {
+	return predicate.ExportTemplate(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SavedSearchTable, SavedSearchColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSavedSearchWith applies the HasEdge predicate on the "saved_search" edge with a given conditions (other predicates).
+func HasSavedSearchWith(preds ...predicate.SavedSearch) predicate.ExportTemplate {
+	return predicate.ExportTemplate(func(s *sql.Selector) {
+		step := newSavedSearchStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ExportTemplate) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ExportTemplate) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ExportTemplate) predicate.ExportTemplate {
+	return predicate.ExportTemplate(sql.NotPredicates(p))
+}
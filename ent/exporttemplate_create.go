@@ -0,0 +1,427 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/exporttemplate"
+	"github.com/jordanlanch/industrydb/ent/organization"
+	"github.com/jordanlanch/industrydb/ent/savedsearch"
+	"github.com/jordanlanch/industrydb/ent/user"
+)
+
+// ExportTemplateCreate is the builder for creating a ExportTemplate entity.
+type ExportTemplateCreate struct {
+	config
+	mutation *ExportTemplateMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *ExportTemplateCreate) SetUserID(v int) *ExportTemplateCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_c *ExportTemplateCreate) SetOrganizationID(v int) *ExportTemplateCreate {
+	_c.mutation.SetOrganizationID(v)
+	return _c
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_c *ExportTemplateCreate) SetNillableOrganizationID(v *int) *ExportTemplateCreate {
+	if v != nil {
+		_c.SetOrganizationID(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *ExportTemplateCreate) SetName(v string) *ExportTemplateCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetFormat sets the "format" field.
+func (_c *ExportTemplateCreate) SetFormat(v exporttemplate.Format) *ExportTemplateCreate {
+	_c.mutation.SetFormat(v)
+	return _c
+}
+
+// SetColumns sets the "columns" field.
+func (_c *ExportTemplateCreate) SetColumns(v []string) *ExportTemplateCreate {
+	_c.mutation.SetColumns(v)
+	return _c
+}
+
+// SetSavedSearchID sets the "saved_search_id" field.
+func (_c *ExportTemplateCreate) SetSavedSearchID(v int) *ExportTemplateCreate {
+	_c.mutation.SetSavedSearchID(v)
+	return _c
+}
+
+// SetNillableSavedSearchID sets the "saved_search_id" field if the given value is not nil.
+func (_c *ExportTemplateCreate) SetNillableSavedSearchID(v *int) *ExportTemplateCreate {
+	if v != nil {
+		_c.SetSavedSearchID(*v)
+	}
+	return _c
+}
+
+// SetFilters sets the "filters" field.
+func (_c *ExportTemplateCreate) SetFilters(v map[string]interface{}) *ExportTemplateCreate {
+	_c.mutation.SetFilters(v)
+	return _c
+}
+
+// SetMaxLeads sets the "max_leads" field.
+func (_c *ExportTemplateCreate) SetMaxLeads(v int) *ExportTemplateCreate {
+	_c.mutation.SetMaxLeads(v)
+	return _c
+}
+
+// SetNillableMaxLeads sets the "max_leads" field if the given value is not nil.
+func (_c *ExportTemplateCreate) SetNillableMaxLeads(v *int) *ExportTemplateCreate {
+	if v != nil {
+		_c.SetMaxLeads(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ExportTemplateCreate) SetCreatedAt(v time.Time) *ExportTemplateCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ExportTemplateCreate) SetNillableCreatedAt(v *time.Time) *ExportTemplateCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ExportTemplateCreate) SetUpdatedAt(v time.Time) *ExportTemplateCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ExportTemplateCreate) SetNillableUpdatedAt(v *time.Time) *ExportTemplateCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *ExportTemplateCreate) SetUser(v *User) *ExportTemplateCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_c *ExportTemplateCreate) SetOrganization(v *Organization) *ExportTemplateCreate {
+	return _c.SetOrganizationID(v.ID)
+}
+
+// SetSavedSearch sets the "saved_search" edge to the SavedSearch entity.
+func (_c *ExportTemplateCreate) SetSavedSearch(v *SavedSearch) *ExportTemplateCreate {
+	return _c.SetSavedSearchID(v.ID)
+}
+
+// Mutation returns the ExportTemplateMutation object of the builder.
+func (_c *ExportTemplateCreate) Mutation() *ExportTemplateMutation {
+	return _c.mutation
+}
+
+// Save creates the ExportTemplate in the database.
+func (_c *ExportTemplateCreate) Save(ctx context.Context) (*ExportTemplate, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ExportTemplateCreate) SaveX(ctx context.Context) *ExportTemplate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExportTemplateCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExportTemplateCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ExportTemplateCreate) defaults() {
+	if _, ok := _c.mutation.MaxLeads(); !ok {
+		v := exporttemplate.DefaultMaxLeads
+		_c.mutation.SetMaxLeads(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := exporttemplate.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := exporttemplate.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ExportTemplateCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ExportTemplate.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := exporttemplate.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "ExportTemplate.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ExportTemplate.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := exporttemplate.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ExportTemplate.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Format(); !ok {
+		return &ValidationError{Name: "format", err: errors.New(`ent: missing required field "ExportTemplate.format"`)}
+	}
+	if v, ok := _c.mutation.Format(); ok {
+		if err := exporttemplate.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "ExportTemplate.format": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MaxLeads(); !ok {
+		return &ValidationError{Name: "max_leads", err: errors.New(`ent: missing required field "ExportTemplate.max_leads"`)}
+	}
+	if v, ok := _c.mutation.MaxLeads(); ok {
+		if err := exporttemplate.MaxLeadsValidator(v); err != nil {
+			return &ValidationError{Name: "max_leads", err: fmt.Errorf(`ent: validator failed for field "ExportTemplate.max_leads": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ExportTemplate.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ExportTemplate.updated_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "ExportTemplate.user"`)}
+	}
+	return nil
+}
+
+func (_c *ExportTemplateCreate) sqlSave(ctx context.Context) (*ExportTemplate, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ExportTemplateCreate) createSpec() (*ExportTemplate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ExportTemplate{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(exporttemplate.Table, sqlgraph.NewFieldSpec(exporttemplate.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(exporttemplate.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Format(); ok {
+		_spec.SetField(exporttemplate.FieldFormat, field.TypeEnum, value)
+		_node.Format = value
+	}
+	if value, ok := _c.mutation.Columns(); ok {
+		_spec.SetField(exporttemplate.FieldColumns, field.TypeJSON, value)
+		_node.Columns = value
+	}
+	if value, ok := _c.mutation.Filters(); ok {
+		_spec.SetField(exporttemplate.FieldFilters, field.TypeJSON, value)
+		_node.Filters = value
+	}
+	if value, ok := _c.mutation.MaxLeads(); ok {
+		_spec.SetField(exporttemplate.FieldMaxLeads, field.TypeInt, value)
+		_node.MaxLeads = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(exporttemplate.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(exporttemplate.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   exporttemplate.UserTable,
+			Columns: []string{exporttemplate.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   exporttemplate.OrganizationTable,
+			Columns: []string{exporttemplate.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrganizationID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SavedSearchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   exporttemplate.SavedSearchTable,
+			Columns: []string{exporttemplate.SavedSearchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SavedSearchID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ExportTemplateCreateBulk is the builder for creating many ExportTemplate entities in bulk.
+type ExportTemplateCreateBulk struct {
+	config
+	err      error
+	builders []*ExportTemplateCreate
+}
+
+// Save creates the ExportTemplate entities in the database.
+func (_c *ExportTemplateCreateBulk) Save(ctx context.Context) ([]*ExportTemplate, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ExportTemplate, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ExportTemplateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ExportTemplateCreateBulk) SaveX(ctx context.Context) []*ExportTemplate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExportTemplateCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExportTemplateCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
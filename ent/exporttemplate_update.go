@@ -0,0 +1,858 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/exporttemplate"
+	"github.com/jordanlanch/industrydb/ent/organization"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+	"github.com/jordanlanch/industrydb/ent/savedsearch"
+	"github.com/jordanlanch/industrydb/ent/user"
+)
+
+// ExportTemplateUpdate is the builder for updating ExportTemplate entities.
+type ExportTemplateUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ExportTemplateMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ExportTemplateUpdate builder.
+func (_u *ExportTemplateUpdate) Where(ps ...predicate.ExportTemplate) *ExportTemplateUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ExportTemplateUpdate) SetUserID(v int) *ExportTemplateUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ExportTemplateUpdate) SetNillableUserID(v *int) *ExportTemplateUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_u *ExportTemplateUpdate) SetOrganizationID(v int) *ExportTemplateUpdate {
+	_u.mutation.SetOrganizationID(v)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_u *ExportTemplateUpdate) SetNillableOrganizationID(v *int) *ExportTemplateUpdate {
+	if v != nil {
+		_u.SetOrganizationID(*v)
+	}
+	return _u
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (_u *ExportTemplateUpdate) ClearOrganizationID() *ExportTemplateUpdate {
+	_u.mutation.ClearOrganizationID()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ExportTemplateUpdate) SetName(v string) *ExportTemplateUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ExportTemplateUpdate) SetNillableName(v *string) *ExportTemplateUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetFormat sets the "format" field.
+func (_u *ExportTemplateUpdate) SetFormat(v exporttemplate.Format) *ExportTemplateUpdate {
+	_u.mutation.SetFormat(v)
+	return _u
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_u *ExportTemplateUpdate) SetNillableFormat(v *exporttemplate.Format) *ExportTemplateUpdate {
+	if v != nil {
+		_u.SetFormat(*v)
+	}
+	return _u
+}
+
+// SetColumns sets the "columns" field.
+func (_u *ExportTemplateUpdate) SetColumns(v []string) *ExportTemplateUpdate {
+	_u.mutation.SetColumns(v)
+	return _u
+}
+
+// AppendColumns appends value to the "columns" field.
+func (_u *ExportTemplateUpdate) AppendColumns(v []string) *ExportTemplateUpdate {
+	_u.mutation.AppendColumns(v)
+	return _u
+}
+
+// ClearColumns clears the value of the "columns" field.
+func (_u *ExportTemplateUpdate) ClearColumns() *ExportTemplateUpdate {
+	_u.mutation.ClearColumns()
+	return _u
+}
+
+// SetSavedSearchID sets the "saved_search_id" field.
+func (_u *ExportTemplateUpdate) SetSavedSearchID(v int) *ExportTemplateUpdate {
+	_u.mutation.SetSavedSearchID(v)
+	return _u
+}
+
+// SetNillableSavedSearchID sets the "saved_search_id" field if the given value is not nil.
+func (_u *ExportTemplateUpdate) SetNillableSavedSearchID(v *int) *ExportTemplateUpdate {
+	if v != nil {
+		_u.SetSavedSearchID(*v)
+	}
+	return _u
+}
+
+// ClearSavedSearchID clears the value of the "saved_search_id" field.
+func (_u *ExportTemplateUpdate) ClearSavedSearchID() *ExportTemplateUpdate {
+	_u.mutation.ClearSavedSearchID()
+	return _u
+}
+
+// SetFilters sets the "filters" field.
+func (_u *ExportTemplateUpdate) SetFilters(v map[string]interface{}) *ExportTemplateUpdate {
+	_u.mutation.SetFilters(v)
+	return _u
+}
+
+// ClearFilters clears the value of the "filters" field.
+func (_u *ExportTemplateUpdate) ClearFilters() *ExportTemplateUpdate {
+	_u.mutation.ClearFilters()
+	return _u
+}
+
+// SetMaxLeads sets the "max_leads" field.
+func (_u *ExportTemplateUpdate) SetMaxLeads(v int) *ExportTemplateUpdate {
+	_u.mutation.ResetMaxLeads()
+	_u.mutation.SetMaxLeads(v)
+	return _u
+}
+
+// SetNillableMaxLeads sets the "max_leads" field if the given value is not nil.
+func (_u *ExportTemplateUpdate) SetNillableMaxLeads(v *int) *ExportTemplateUpdate {
+	if v != nil {
+		_u.SetMaxLeads(*v)
+	}
+	return _u
+}
+
+// AddMaxLeads adds value to the "max_leads" field.
+func (_u *ExportTemplateUpdate) AddMaxLeads(v int) *ExportTemplateUpdate {
+	_u.mutation.AddMaxLeads(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ExportTemplateUpdate) SetUpdatedAt(v time.Time) *ExportTemplateUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *ExportTemplateUpdate) SetUser(v *User) *ExportTemplateUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_u *ExportTemplateUpdate) SetOrganization(v *Organization) *ExportTemplateUpdate {
+	return _u.SetOrganizationID(v.ID)
+}
+
+// SetSavedSearch sets the "saved_search" edge to the SavedSearch entity.
+func (_u *ExportTemplateUpdate) SetSavedSearch(v *SavedSearch) *ExportTemplateUpdate {
+	return _u.SetSavedSearchID(v.ID)
+}
+
+// Mutation returns the ExportTemplateMutation object of the builder.
+func (_u *ExportTemplateUpdate) Mutation() *ExportTemplateMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *ExportTemplateUpdate) ClearUser() *ExportTemplateUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (_u *ExportTemplateUpdate) ClearOrganization() *ExportTemplateUpdate {
+	_u.mutation.ClearOrganization()
+	return _u
+}
+
+// ClearSavedSearch clears the "saved_search" edge to the SavedSearch entity.
+func (_u *ExportTemplateUpdate) ClearSavedSearch() *ExportTemplateUpdate {
+	_u.mutation.ClearSavedSearch()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ExportTemplateUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ExportTemplateUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ExportTemplateUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ExportTemplateUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ExportTemplateUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := exporttemplate.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ExportTemplateUpdate) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := exporttemplate.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "ExportTemplate.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := exporttemplate.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ExportTemplate.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Format(); ok {
+		if err := exporttemplate.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "ExportTemplate.format": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxLeads(); ok {
+		if err := exporttemplate.MaxLeadsValidator(v); err != nil {
+			return &ValidationError{Name: "max_leads", err: fmt.Errorf(`ent: validator failed for field "ExportTemplate.max_leads": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ExportTemplate.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ExportTemplateUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ExportTemplateUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ExportTemplateUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(exporttemplate.Table, exporttemplate.Columns, sqlgraph.NewFieldSpec(exporttemplate.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(exporttemplate.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Format(); ok {
+		_spec.SetField(exporttemplate.FieldFormat, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Columns(); ok {
+		_spec.SetField(exporttemplate.FieldColumns, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedColumns(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, exporttemplate.FieldColumns, value)
+		})
+	}
+	if _u.mutation.ColumnsCleared() {
+		_spec.ClearField(exporttemplate.FieldColumns, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Filters(); ok {
+		_spec.SetField(exporttemplate.FieldFilters, field.TypeJSON, value)
+	}
+	if _u.mutation.FiltersCleared() {
+		_spec.ClearField(exporttemplate.FieldFilters, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.MaxLeads(); ok {
+		_spec.SetField(exporttemplate.FieldMaxLeads, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxLeads(); ok {
+		_spec.AddField(exporttemplate.FieldMaxLeads, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(exporttemplate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   exporttemplate.UserTable,
+			Columns: []string{exporttemplate.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   exporttemplate.UserTable,
+			Columns: []string{exporttemplate.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   exporttemplate.OrganizationTable,
+			Columns: []string{exporttemplate.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   exporttemplate.OrganizationTable,
+			Columns: []string{exporttemplate.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SavedSearchCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   exporttemplate.SavedSearchTable,
+			Columns: []string{exporttemplate.SavedSearchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SavedSearchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   exporttemplate.SavedSearchTable,
+			Columns: []string{exporttemplate.SavedSearchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{exporttemplate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ExportTemplateUpdateOne is the builder for updating a single ExportTemplate entity.
+type ExportTemplateUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ExportTemplateMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ExportTemplateUpdateOne) SetUserID(v int) *ExportTemplateUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ExportTemplateUpdateOne) SetNillableUserID(v *int) *ExportTemplateUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_u *ExportTemplateUpdateOne) SetOrganizationID(v int) *ExportTemplateUpdateOne {
+	_u.mutation.SetOrganizationID(v)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_u *ExportTemplateUpdateOne) SetNillableOrganizationID(v *int) *ExportTemplateUpdateOne {
+	if v != nil {
+		_u.SetOrganizationID(*v)
+	}
+	return _u
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (_u *ExportTemplateUpdateOne) ClearOrganizationID() *ExportTemplateUpdateOne {
+	_u.mutation.ClearOrganizationID()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ExportTemplateUpdateOne) SetName(v string) *ExportTemplateUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ExportTemplateUpdateOne) SetNillableName(v *string) *ExportTemplateUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetFormat sets the "format" field.
+func (_u *ExportTemplateUpdateOne) SetFormat(v exporttemplate.Format) *ExportTemplateUpdateOne {
+	_u.mutation.SetFormat(v)
+	return _u
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_u *ExportTemplateUpdateOne) SetNillableFormat(v *exporttemplate.Format) *ExportTemplateUpdateOne {
+	if v != nil {
+		_u.SetFormat(*v)
+	}
+	return _u
+}
+
+// SetColumns sets the "columns" field.
+func (_u *ExportTemplateUpdateOne) SetColumns(v []string) *ExportTemplateUpdateOne {
+	_u.mutation.SetColumns(v)
+	return _u
+}
+
+// AppendColumns appends value to the "columns" field.
+func (_u *ExportTemplateUpdateOne) AppendColumns(v []string) *ExportTemplateUpdateOne {
+	_u.mutation.AppendColumns(v)
+	return _u
+}
+
+// ClearColumns clears the value of the "columns" field.
+func (_u *ExportTemplateUpdateOne) ClearColumns() *ExportTemplateUpdateOne {
+	_u.mutation.ClearColumns()
+	return _u
+}
+
+// SetSavedSearchID sets the "saved_search_id" field.
+func (_u *ExportTemplateUpdateOne) SetSavedSearchID(v int) *ExportTemplateUpdateOne {
+	_u.mutation.SetSavedSearchID(v)
+	return _u
+}
+
+// SetNillableSavedSearchID sets the "saved_search_id" field if the given value is not nil.
+func (_u *ExportTemplateUpdateOne) SetNillableSavedSearchID(v *int) *ExportTemplateUpdateOne {
+	if v != nil {
+		_u.SetSavedSearchID(*v)
+	}
+	return _u
+}
+
+// ClearSavedSearchID clears the value of the "saved_search_id" field.
+func (_u *ExportTemplateUpdateOne) ClearSavedSearchID() *ExportTemplateUpdateOne {
+	_u.mutation.ClearSavedSearchID()
+	return _u
+}
+
+// SetFilters sets the "filters" field.
+func (_u *ExportTemplateUpdateOne) SetFilters(v map[string]interface{}) *ExportTemplateUpdateOne {
+	_u.mutation.SetFilters(v)
+	return _u
+}
+
+// ClearFilters clears the value of the "filters" field.
+func (_u *ExportTemplateUpdateOne) ClearFilters() *ExportTemplateUpdateOne {
+	_u.mutation.ClearFilters()
+	return _u
+}
+
+// SetMaxLeads sets the "max_leads" field.
+func (_u *ExportTemplateUpdateOne) SetMaxLeads(v int) *ExportTemplateUpdateOne {
+	_u.mutation.ResetMaxLeads()
+	_u.mutation.SetMaxLeads(v)
+	return _u
+}
+
+// SetNillableMaxLeads sets the "max_leads" field if the given value is not nil.
+func (_u *ExportTemplateUpdateOne) SetNillableMaxLeads(v *int) *ExportTemplateUpdateOne {
+	if v != nil {
+		_u.SetMaxLeads(*v)
+	}
+	return _u
+}
+
+// AddMaxLeads adds value to the "max_leads" field.
+func (_u *ExportTemplateUpdateOne) AddMaxLeads(v int) *ExportTemplateUpdateOne {
+	_u.mutation.AddMaxLeads(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ExportTemplateUpdateOne) SetUpdatedAt(v time.Time) *ExportTemplateUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *ExportTemplateUpdateOne) SetUser(v *User) *ExportTemplateUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_u *ExportTemplateUpdateOne) SetOrganization(v *Organization) *ExportTemplateUpdateOne {
+	return _u.SetOrganizationID(v.ID)
+}
+
+// SetSavedSearch sets the "saved_search" edge to the SavedSearch entity.
+func (_u *ExportTemplateUpdateOne) SetSavedSearch(v *SavedSearch) *ExportTemplateUpdateOne {
+	return _u.SetSavedSearchID(v.ID)
+}
+
+// Mutation returns the ExportTemplateMutation object of the builder.
+func (_u *ExportTemplateUpdateOne) Mutation() *ExportTemplateMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *ExportTemplateUpdateOne) ClearUser() *ExportTemplateUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (_u *ExportTemplateUpdateOne) ClearOrganization() *ExportTemplateUpdateOne {
+	_u.mutation.ClearOrganization()
+	return _u
+}
+
+// ClearSavedSearch clears the "saved_search" edge to the SavedSearch entity.
+func (_u *ExportTemplateUpdateOne) ClearSavedSearch() *ExportTemplateUpdateOne {
+	_u.mutation.ClearSavedSearch()
+	return _u
+}
+
+// Where appends a list predicates to the ExportTemplateUpdate builder.
+func (_u *ExportTemplateUpdateOne) Where(ps ...predicate.ExportTemplate) *ExportTemplateUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ExportTemplateUpdateOne) Select(field string, fields ...string) *ExportTemplateUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ExportTemplate entity.
+func (_u *ExportTemplateUpdateOne) Save(ctx context.Context) (*ExportTemplate, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ExportTemplateUpdateOne) SaveX(ctx context.Context) *ExportTemplate {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ExportTemplateUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ExportTemplateUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ExportTemplateUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := exporttemplate.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ExportTemplateUpdateOne) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := exporttemplate.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "ExportTemplate.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := exporttemplate.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ExportTemplate.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Format(); ok {
+		if err := exporttemplate.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "ExportTemplate.format": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxLeads(); ok {
+		if err := exporttemplate.MaxLeadsValidator(v); err != nil {
+			return &ValidationError{Name: "max_leads", err: fmt.Errorf(`ent: validator failed for field "ExportTemplate.max_leads": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ExportTemplate.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ExportTemplateUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ExportTemplateUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ExportTemplateUpdateOne) sqlSave(ctx context.Context) (_node *ExportTemplate, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(exporttemplate.Table, exporttemplate.Columns, sqlgraph.NewFieldSpec(exporttemplate.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ExportTemplate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, exporttemplate.FieldID)
+		for _, f := range fields {
+			if !exporttemplate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != exporttemplate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(exporttemplate.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Format(); ok {
+		_spec.SetField(exporttemplate.FieldFormat, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Columns(); ok {
+		_spec.SetField(exporttemplate.FieldColumns, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedColumns(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, exporttemplate.FieldColumns, value)
+		})
+	}
+	if _u.mutation.ColumnsCleared() {
+		_spec.ClearField(exporttemplate.FieldColumns, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Filters(); ok {
+		_spec.SetField(exporttemplate.FieldFilters, field.TypeJSON, value)
+	}
+	if _u.mutation.FiltersCleared() {
+		_spec.ClearField(exporttemplate.FieldFilters, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.MaxLeads(); ok {
+		_spec.SetField(exporttemplate.FieldMaxLeads, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxLeads(); ok {
+		_spec.AddField(exporttemplate.FieldMaxLeads, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(exporttemplate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   exporttemplate.UserTable,
+			Columns: []string{exporttemplate.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   exporttemplate.UserTable,
+			Columns: []string{exporttemplate.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   exporttemplate.OrganizationTable,
+			Columns: []string{exporttemplate.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   exporttemplate.OrganizationTable,
+			Columns: []string{exporttemplate.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SavedSearchCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   exporttemplate.SavedSearchTable,
+			Columns: []string{exporttemplate.SavedSearchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SavedSearchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   exporttemplate.SavedSearchTable,
+			Columns: []string{exporttemplate.SavedSearchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ExportTemplate{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{exporttemplate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
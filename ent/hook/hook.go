@@ -93,6 +93,18 @@ func (f CRMLeadSyncFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CRMLeadSyncMutation", m)
 }
 
+// The CRMPushJobFunc type is an adapter to allow the use of ordinary
+// function as CRMPushJob mutator.
+type CRMPushJobFunc func(context.Context, *ent.CRMPushJobMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CRMPushJobFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CRMPushJobMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CRMPushJobMutation", m)
+}
+
 // The CallLogFunc type is an adapter to allow the use of ordinary
 // function as CallLog mutator.
 type CallLogFunc func(context.Context, *ent.CallLogMutation) (ent.Value, error)
@@ -129,6 +141,18 @@ func (f CompetitorProfileFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CompetitorProfileMutation", m)
 }
 
+// The ContactAttemptFunc type is an adapter to allow the use of ordinary
+// function as ContactAttempt mutator.
+type ContactAttemptFunc func(context.Context, *ent.ContactAttemptMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ContactAttemptFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ContactAttemptMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ContactAttemptMutation", m)
+}
+
 // The EmailCampaignFunc type is an adapter to allow the use of ordinary
 // function as EmailCampaign mutator.
 type EmailCampaignFunc func(context.Context, *ent.EmailCampaignMutation) (ent.Value, error)
@@ -153,6 +177,18 @@ func (f EmailCampaignRecipientFunc) Mutate(ctx context.Context, m ent.Mutation)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EmailCampaignRecipientMutation", m)
 }
 
+// The EmailSendFunc type is an adapter to allow the use of ordinary
+// function as EmailSend mutator.
+type EmailSendFunc func(context.Context, *ent.EmailSendMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EmailSendFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EmailSendMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EmailSendMutation", m)
+}
+
 // The EmailSequenceFunc type is an adapter to allow the use of ordinary
 // function as EmailSequence mutator.
 type EmailSequenceFunc func(context.Context, *ent.EmailSequenceMutation) (ent.Value, error)
@@ -201,6 +237,42 @@ func (f EmailSequenceStepFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EmailSequenceStepMutation", m)
 }
 
+// The EmailSuppressionFunc type is an adapter to allow the use of ordinary
+// function as EmailSuppression mutator.
+type EmailSuppressionFunc func(context.Context, *ent.EmailSuppressionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EmailSuppressionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EmailSuppressionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EmailSuppressionMutation", m)
+}
+
+// The EnrichmentAttemptFunc type is an adapter to allow the use of ordinary
+// function as EnrichmentAttempt mutator.
+type EnrichmentAttemptFunc func(context.Context, *ent.EnrichmentAttemptMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EnrichmentAttemptFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EnrichmentAttemptMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EnrichmentAttemptMutation", m)
+}
+
+// The EnrichmentBudgetFunc type is an adapter to allow the use of ordinary
+// function as EnrichmentBudget mutator.
+type EnrichmentBudgetFunc func(context.Context, *ent.EnrichmentBudgetMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EnrichmentBudgetFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EnrichmentBudgetMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EnrichmentBudgetMutation", m)
+}
+
 // The ExperimentFunc type is an adapter to allow the use of ordinary
 // function as Experiment mutator.
 type ExperimentFunc func(context.Context, *ent.ExperimentMutation) (ent.Value, error)
@@ -237,6 +309,30 @@ func (f ExportFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, erro
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ExportMutation", m)
 }
 
+// The ExportTemplateFunc type is an adapter to allow the use of ordinary
+// function as ExportTemplate mutator.
+type ExportTemplateFunc func(context.Context, *ent.ExportTemplateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ExportTemplateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ExportTemplateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ExportTemplateMutation", m)
+}
+
+// The ImportJobFunc type is an adapter to allow the use of ordinary
+// function as ImportJob mutator.
+type ImportJobFunc func(context.Context, *ent.ImportJobMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ImportJobFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ImportJobMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ImportJobMutation", m)
+}
+
 // The IndustryFunc type is an adapter to allow the use of ordinary
 // function as Industry mutator.
 type IndustryFunc func(context.Context, *ent.IndustryMutation) (ent.Value, error)
@@ -249,6 +345,18 @@ func (f IndustryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.IndustryMutation", m)
 }
 
+// The IntegrationConnectionFunc type is an adapter to allow the use of ordinary
+// function as IntegrationConnection mutator.
+type IntegrationConnectionFunc func(context.Context, *ent.IntegrationConnectionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f IntegrationConnectionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.IntegrationConnectionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.IntegrationConnectionMutation", m)
+}
+
 // The LeadFunc type is an adapter to allow the use of ordinary
 // function as Lead mutator.
 type LeadFunc func(context.Context, *ent.LeadMutation) (ent.Value, error)
@@ -273,6 +381,30 @@ func (f LeadAssignmentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LeadAssignmentMutation", m)
 }
 
+// The LeadChangeFunc type is an adapter to allow the use of ordinary
+// function as LeadChange mutator.
+type LeadChangeFunc func(context.Context, *ent.LeadChangeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LeadChangeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LeadChangeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LeadChangeMutation", m)
+}
+
+// The LeadLicenseFunc type is an adapter to allow the use of ordinary
+// function as LeadLicense mutator.
+type LeadLicenseFunc func(context.Context, *ent.LeadLicenseMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LeadLicenseFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LeadLicenseMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LeadLicenseMutation", m)
+}
+
 // The LeadNoteFunc type is an adapter to allow the use of ordinary
 // function as LeadNote mutator.
 type LeadNoteFunc func(context.Context, *ent.LeadNoteMutation) (ent.Value, error)
@@ -297,6 +429,18 @@ func (f LeadRecommendationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LeadRecommendationMutation", m)
 }
 
+// The LeadReindexJobFunc type is an adapter to allow the use of ordinary
+// function as LeadReindexJob mutator.
+type LeadReindexJobFunc func(context.Context, *ent.LeadReindexJobMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LeadReindexJobFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LeadReindexJobMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LeadReindexJobMutation", m)
+}
+
 // The LeadStatusHistoryFunc type is an adapter to allow the use of ordinary
 // function as LeadStatusHistory mutator.
 type LeadStatusHistoryFunc func(context.Context, *ent.LeadStatusHistoryMutation) (ent.Value, error)
@@ -309,6 +453,30 @@ func (f LeadStatusHistoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LeadStatusHistoryMutation", m)
 }
 
+// The LeadSuppressionFunc type is an adapter to allow the use of ordinary
+// function as LeadSuppression mutator.
+type LeadSuppressionFunc func(context.Context, *ent.LeadSuppressionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LeadSuppressionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LeadSuppressionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LeadSuppressionMutation", m)
+}
+
+// The LeadVerificationFunc type is an adapter to allow the use of ordinary
+// function as LeadVerification mutator.
+type LeadVerificationFunc func(context.Context, *ent.LeadVerificationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LeadVerificationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LeadVerificationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LeadVerificationMutation", m)
+}
+
 // The MarketReportFunc type is an adapter to allow the use of ordinary
 // function as MarketReport mutator.
 type MarketReportFunc func(context.Context, *ent.MarketReportMutation) (ent.Value, error)
@@ -393,6 +561,30 @@ func (f SavedSearchFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SavedSearchMutation", m)
 }
 
+// The SavedSearchSnapshotFunc type is an adapter to allow the use of ordinary
+// function as SavedSearchSnapshot mutator.
+type SavedSearchSnapshotFunc func(context.Context, *ent.SavedSearchSnapshotMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SavedSearchSnapshotFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SavedSearchSnapshotMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SavedSearchSnapshotMutation", m)
+}
+
+// The ScheduledExportFunc type is an adapter to allow the use of ordinary
+// function as ScheduledExport mutator.
+type ScheduledExportFunc func(context.Context, *ent.ScheduledExportMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ScheduledExportFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ScheduledExportMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ScheduledExportMutation", m)
+}
+
 // The SubscriptionFunc type is an adapter to allow the use of ordinary
 // function as Subscription mutator.
 type SubscriptionFunc func(context.Context, *ent.SubscriptionMutation) (ent.Value, error)
@@ -429,6 +621,18 @@ func (f TerritoryMemberFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Va
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TerritoryMemberMutation", m)
 }
 
+// The UsageDailyAggregateFunc type is an adapter to allow the use of ordinary
+// function as UsageDailyAggregate mutator.
+type UsageDailyAggregateFunc func(context.Context, *ent.UsageDailyAggregateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UsageDailyAggregateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.UsageDailyAggregateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UsageDailyAggregateMutation", m)
+}
+
 // The UsageLogFunc type is an adapter to allow the use of ordinary
 // function as UsageLog mutator.
 type UsageLogFunc func(context.Context, *ent.UsageLogMutation) (ent.Value, error)
@@ -465,6 +669,18 @@ func (f UserBehaviorFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserBehaviorMutation", m)
 }
 
+// The UserNotificationPreferenceFunc type is an adapter to allow the use of ordinary
+// function as UserNotificationPreference mutator.
+type UserNotificationPreferenceFunc func(context.Context, *ent.UserNotificationPreferenceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UserNotificationPreferenceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.UserNotificationPreferenceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserNotificationPreferenceMutation", m)
+}
+
 // The WebhookFunc type is an adapter to allow the use of ordinary
 // function as Webhook mutator.
 type WebhookFunc func(context.Context, *ent.WebhookMutation) (ent.Value, error)
@@ -477,6 +693,30 @@ func (f WebhookFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WebhookMutation", m)
 }
 
+// The WebhookDeliveryFunc type is an adapter to allow the use of ordinary
+// function as WebhookDelivery mutator.
+type WebhookDeliveryFunc func(context.Context, *ent.WebhookDeliveryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookDeliveryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WebhookDeliveryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WebhookDeliveryMutation", m)
+}
+
+// The ZapierDeliveryFunc type is an adapter to allow the use of ordinary
+// function as ZapierDelivery mutator.
+type ZapierDeliveryFunc func(context.Context, *ent.ZapierDeliveryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ZapierDeliveryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ZapierDeliveryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ZapierDeliveryMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
@@ -0,0 +1,316 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/jordanlanch/industrydb/ent/importjob"
+	"github.com/jordanlanch/industrydb/ent/user"
+)
+
+// ImportJob is the model entity for the ImportJob schema.
+type ImportJob struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Admin who started the import
+	UserID int `json:"user_id,omitempty"`
+	// Where the CSV is read from
+	SourceType importjob.SourceType `json:"source_type,omitempty"`
+	// S3 object key or HTTPS URL
+	Source string `json:"source,omitempty"`
+	// Import job status
+	Status importjob.Status `json:"status,omitempty"`
+	// Only validate rows, don't create leads
+	ValidateOnly bool `json:"validate_only,omitempty"`
+	// Source size in bytes, when known
+	TotalBytes *int64 `json:"total_bytes,omitempty"`
+	// Bytes streamed so far
+	BytesRead int64 `json:"bytes_read,omitempty"`
+	// Rows read so far
+	TotalRows int `json:"total_rows,omitempty"`
+	// Rows imported (or validated) successfully
+	SuccessCount int `json:"success_count,omitempty"`
+	// Rows that failed validation or insert
+	FailureCount int `json:"failure_count,omitempty"`
+	// First row errors (capped)
+	Errors []map[string]interface{} `json:"errors,omitempty"`
+	// Error message if the job failed
+	ErrorMessage string `json:"error_message,omitempty"`
+	// When processing started
+	StartedAt *time.Time `json:"started_at,omitempty"`
+	// When processing finished
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// Creation timestamp
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Last update timestamp
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ImportJobQuery when eager-loading is set.
+	Edges        ImportJobEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ImportJobEdges holds the relations/edges for other nodes in the graph.
+type ImportJobEdges struct {
+	// Admin who started the import
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ImportJobEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ImportJob) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case importjob.FieldErrors:
+			values[i] = new([]byte)
+		case importjob.FieldValidateOnly:
+			values[i] = new(sql.NullBool)
+		case importjob.FieldID, importjob.FieldUserID, importjob.FieldTotalBytes, importjob.FieldBytesRead, importjob.FieldTotalRows, importjob.FieldSuccessCount, importjob.FieldFailureCount:
+			values[i] = new(sql.NullInt64)
+		case importjob.FieldSourceType, importjob.FieldSource, importjob.FieldStatus, importjob.FieldErrorMessage:
+			values[i] = new(sql.NullString)
+		case importjob.FieldStartedAt, importjob.FieldCompletedAt, importjob.FieldCreatedAt, importjob.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ImportJob fields.
+func (_m *ImportJob) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case importjob.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case importjob.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case importjob.FieldSourceType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_type", values[i])
+			} else if value.Valid {
+				_m.SourceType = importjob.SourceType(value.String)
+			}
+		case importjob.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				_m.Source = value.String
+			}
+		case importjob.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = importjob.Status(value.String)
+			}
+		case importjob.FieldValidateOnly:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field validate_only", values[i])
+			} else if value.Valid {
+				_m.ValidateOnly = value.Bool
+			}
+		case importjob.FieldTotalBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_bytes", values[i])
+			} else if value.Valid {
+				_m.TotalBytes = new(int64)
+				*_m.TotalBytes = value.Int64
+			}
+		case importjob.FieldBytesRead:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field bytes_read", values[i])
+			} else if value.Valid {
+				_m.BytesRead = value.Int64
+			}
+		case importjob.FieldTotalRows:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_rows", values[i])
+			} else if value.Valid {
+				_m.TotalRows = int(value.Int64)
+			}
+		case importjob.FieldSuccessCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field success_count", values[i])
+			} else if value.Valid {
+				_m.SuccessCount = int(value.Int64)
+			}
+		case importjob.FieldFailureCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failure_count", values[i])
+			} else if value.Valid {
+				_m.FailureCount = int(value.Int64)
+			}
+		case importjob.FieldErrors:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field errors", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Errors); err != nil {
+					return fmt.Errorf("unmarshal field errors: %w", err)
+				}
+			}
+		case importjob.FieldErrorMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error_message", values[i])
+			} else if value.Valid {
+				_m.ErrorMessage = value.String
+			}
+		case importjob.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				_m.StartedAt = new(time.Time)
+				*_m.StartedAt = value.Time
+			}
+		case importjob.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				_m.CompletedAt = new(time.Time)
+				*_m.CompletedAt = value.Time
+			}
+		case importjob.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case importjob.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ImportJob.
+// This includes values selected through modifiers, order, etc.
+func (_m *ImportJob) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the ImportJob entity.
+func (_m *ImportJob) QueryUser() *UserQuery {
+	return NewImportJobClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this ImportJob.
+// Note that you need to call ImportJob.Unwrap() before calling this method if this ImportJob
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ImportJob) Update() *ImportJobUpdateOne {
+	return NewImportJobClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ImportJob entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ImportJob) Unwrap() *ImportJob {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ImportJob is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ImportJob) String() string {
+	var builder strings.Builder
+	builder.WriteString("ImportJob(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("source_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SourceType))
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(_m.Source)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("validate_only=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ValidateOnly))
+	builder.WriteString(", ")
+	if v := _m.TotalBytes; v != nil {
+		builder.WriteString("total_bytes=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("bytes_read=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BytesRead))
+	builder.WriteString(", ")
+	builder.WriteString("total_rows=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalRows))
+	builder.WriteString(", ")
+	builder.WriteString("success_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SuccessCount))
+	builder.WriteString(", ")
+	builder.WriteString("failure_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FailureCount))
+	builder.WriteString(", ")
+	builder.WriteString("errors=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Errors))
+	builder.WriteString(", ")
+	builder.WriteString("error_message=")
+	builder.WriteString(_m.ErrorMessage)
+	builder.WriteString(", ")
+	if v := _m.StartedAt; v != nil {
+		builder.WriteString("started_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ImportJobs is a parsable slice of ImportJob.
+type ImportJobs []*ImportJob
@@ -0,0 +1,271 @@
+// Code generated by ent, DO NOT EDIT.
+
+package importjob
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the importjob type in the database.
+	Label = "import_job"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldSourceType holds the string denoting the source_type field in the database.
+	FieldSourceType = "source_type"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldValidateOnly holds the string denoting the validate_only field in the database.
+	FieldValidateOnly = "validate_only"
+	// FieldTotalBytes holds the string denoting the total_bytes field in the database.
+	FieldTotalBytes = "total_bytes"
+	// FieldBytesRead holds the string denoting the bytes_read field in the database.
+	FieldBytesRead = "bytes_read"
+	// FieldTotalRows holds the string denoting the total_rows field in the database.
+	FieldTotalRows = "total_rows"
+	// FieldSuccessCount holds the string denoting the success_count field in the database.
+	FieldSuccessCount = "success_count"
+	// FieldFailureCount holds the string denoting the failure_count field in the database.
+	FieldFailureCount = "failure_count"
+	// FieldErrors holds the string denoting the errors field in the database.
+	FieldErrors = "errors"
+	// FieldErrorMessage holds the string denoting the error_message field in the database.
+	FieldErrorMessage = "error_message"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldCompletedAt holds the string denoting the completed_at field in the database.
+	FieldCompletedAt = "completed_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// Table holds the table name of the importjob in the database.
+	Table = "import_jobs"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "import_jobs"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_id"
+)
+
+// Columns holds all SQL columns for importjob fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldSourceType,
+	FieldSource,
+	FieldStatus,
+	FieldValidateOnly,
+	FieldTotalBytes,
+	FieldBytesRead,
+	FieldTotalRows,
+	FieldSuccessCount,
+	FieldFailureCount,
+	FieldErrors,
+	FieldErrorMessage,
+	FieldStartedAt,
+	FieldCompletedAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	UserIDValidator func(int) error
+	// SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	SourceValidator func(string) error
+	// DefaultValidateOnly holds the default value on creation for the "validate_only" field.
+	DefaultValidateOnly bool
+	// DefaultBytesRead holds the default value on creation for the "bytes_read" field.
+	DefaultBytesRead int64
+	// BytesReadValidator is a validator for the "bytes_read" field. It is called by the builders before save.
+	BytesReadValidator func(int64) error
+	// DefaultTotalRows holds the default value on creation for the "total_rows" field.
+	DefaultTotalRows int
+	// TotalRowsValidator is a validator for the "total_rows" field. It is called by the builders before save.
+	TotalRowsValidator func(int) error
+	// DefaultSuccessCount holds the default value on creation for the "success_count" field.
+	DefaultSuccessCount int
+	// SuccessCountValidator is a validator for the "success_count" field. It is called by the builders before save.
+	SuccessCountValidator func(int) error
+	// DefaultFailureCount holds the default value on creation for the "failure_count" field.
+	DefaultFailureCount int
+	// FailureCountValidator is a validator for the "failure_count" field. It is called by the builders before save.
+	FailureCountValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// SourceType defines the type for the "source_type" enum field.
+type SourceType string
+
+// SourceType values.
+const (
+	SourceTypeS3  SourceType = "s3"
+	SourceTypeURL SourceType = "url"
+)
+
+func (st SourceType) String() string {
+	return string(st)
+}
+
+// SourceTypeValidator is a validator for the "source_type" field enum values. It is called by the builders before save.
+func SourceTypeValidator(st SourceType) error {
+	switch st {
+	case SourceTypeS3, SourceTypeURL:
+		return nil
+	default:
+		return fmt.Errorf("importjob: invalid enum value for source_type field: %q", st)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending    Status = "pending"
+	StatusProcessing Status = "processing"
+	StatusCompleted  Status = "completed"
+	StatusFailed     Status = "failed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusProcessing, StatusCompleted, StatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("importjob: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the ImportJob queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// BySourceType orders the results by the source_type field.
+func BySourceType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceType, opts...).ToFunc()
+}
+
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByValidateOnly orders the results by the validate_only field.
+func ByValidateOnly(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidateOnly, opts...).ToFunc()
+}
+
+// ByTotalBytes orders the results by the total_bytes field.
+func ByTotalBytes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalBytes, opts...).ToFunc()
+}
+
+// ByBytesRead orders the results by the bytes_read field.
+func ByBytesRead(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBytesRead, opts...).ToFunc()
+}
+
+// ByTotalRows orders the results by the total_rows field.
+func ByTotalRows(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalRows, opts...).ToFunc()
+}
+
+// BySuccessCount orders the results by the success_count field.
+func BySuccessCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSuccessCount, opts...).ToFunc()
+}
+
+// ByFailureCount orders the results by the failure_count field.
+func ByFailureCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailureCount, opts...).ToFunc()
+}
+
+// ByErrorMessage orders the results by the error_message field.
+func ByErrorMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldErrorMessage, opts...).ToFunc()
+}
+
+// ByStartedAt orders the results by the started_at field.
+func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
+}
+
+// ByCompletedAt orders the results by the completed_at field.
+func ByCompletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompletedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+	)
+}
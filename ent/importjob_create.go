@@ -0,0 +1,560 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/importjob"
+	"github.com/jordanlanch/industrydb/ent/user"
+)
+
+// ImportJobCreate is the builder for creating a ImportJob entity.
+type ImportJobCreate struct {
+	config
+	mutation *ImportJobMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *ImportJobCreate) SetUserID(v int) *ImportJobCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetSourceType sets the "source_type" field.
+func (_c *ImportJobCreate) SetSourceType(v importjob.SourceType) *ImportJobCreate {
+	_c.mutation.SetSourceType(v)
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *ImportJobCreate) SetSource(v string) *ImportJobCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ImportJobCreate) SetStatus(v importjob.Status) *ImportJobCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableStatus(v *importjob.Status) *ImportJobCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetValidateOnly sets the "validate_only" field.
+func (_c *ImportJobCreate) SetValidateOnly(v bool) *ImportJobCreate {
+	_c.mutation.SetValidateOnly(v)
+	return _c
+}
+
+// SetNillableValidateOnly sets the "validate_only" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableValidateOnly(v *bool) *ImportJobCreate {
+	if v != nil {
+		_c.SetValidateOnly(*v)
+	}
+	return _c
+}
+
+// SetTotalBytes sets the "total_bytes" field.
+func (_c *ImportJobCreate) SetTotalBytes(v int64) *ImportJobCreate {
+	_c.mutation.SetTotalBytes(v)
+	return _c
+}
+
+// SetNillableTotalBytes sets the "total_bytes" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableTotalBytes(v *int64) *ImportJobCreate {
+	if v != nil {
+		_c.SetTotalBytes(*v)
+	}
+	return _c
+}
+
+// SetBytesRead sets the "bytes_read" field.
+func (_c *ImportJobCreate) SetBytesRead(v int64) *ImportJobCreate {
+	_c.mutation.SetBytesRead(v)
+	return _c
+}
+
+// SetNillableBytesRead sets the "bytes_read" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableBytesRead(v *int64) *ImportJobCreate {
+	if v != nil {
+		_c.SetBytesRead(*v)
+	}
+	return _c
+}
+
+// SetTotalRows sets the "total_rows" field.
+func (_c *ImportJobCreate) SetTotalRows(v int) *ImportJobCreate {
+	_c.mutation.SetTotalRows(v)
+	return _c
+}
+
+// SetNillableTotalRows sets the "total_rows" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableTotalRows(v *int) *ImportJobCreate {
+	if v != nil {
+		_c.SetTotalRows(*v)
+	}
+	return _c
+}
+
+// SetSuccessCount sets the "success_count" field.
+func (_c *ImportJobCreate) SetSuccessCount(v int) *ImportJobCreate {
+	_c.mutation.SetSuccessCount(v)
+	return _c
+}
+
+// SetNillableSuccessCount sets the "success_count" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableSuccessCount(v *int) *ImportJobCreate {
+	if v != nil {
+		_c.SetSuccessCount(*v)
+	}
+	return _c
+}
+
+// SetFailureCount sets the "failure_count" field.
+func (_c *ImportJobCreate) SetFailureCount(v int) *ImportJobCreate {
+	_c.mutation.SetFailureCount(v)
+	return _c
+}
+
+// SetNillableFailureCount sets the "failure_count" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableFailureCount(v *int) *ImportJobCreate {
+	if v != nil {
+		_c.SetFailureCount(*v)
+	}
+	return _c
+}
+
+// SetErrors sets the "errors" field.
+func (_c *ImportJobCreate) SetErrors(v []map[string]interface{}) *ImportJobCreate {
+	_c.mutation.SetErrors(v)
+	return _c
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_c *ImportJobCreate) SetErrorMessage(v string) *ImportJobCreate {
+	_c.mutation.SetErrorMessage(v)
+	return _c
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableErrorMessage(v *string) *ImportJobCreate {
+	if v != nil {
+		_c.SetErrorMessage(*v)
+	}
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *ImportJobCreate) SetStartedAt(v time.Time) *ImportJobCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableStartedAt(v *time.Time) *ImportJobCreate {
+	if v != nil {
+		_c.SetStartedAt(*v)
+	}
+	return _c
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_c *ImportJobCreate) SetCompletedAt(v time.Time) *ImportJobCreate {
+	_c.mutation.SetCompletedAt(v)
+	return _c
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableCompletedAt(v *time.Time) *ImportJobCreate {
+	if v != nil {
+		_c.SetCompletedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ImportJobCreate) SetCreatedAt(v time.Time) *ImportJobCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableCreatedAt(v *time.Time) *ImportJobCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ImportJobCreate) SetUpdatedAt(v time.Time) *ImportJobCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableUpdatedAt(v *time.Time) *ImportJobCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *ImportJobCreate) SetUser(v *User) *ImportJobCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the ImportJobMutation object of the builder.
+func (_c *ImportJobCreate) Mutation() *ImportJobMutation {
+	return _c.mutation
+}
+
+// Save creates the ImportJob in the database.
+func (_c *ImportJobCreate) Save(ctx context.Context) (*ImportJob, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ImportJobCreate) SaveX(ctx context.Context) *ImportJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ImportJobCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ImportJobCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ImportJobCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := importjob.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.ValidateOnly(); !ok {
+		v := importjob.DefaultValidateOnly
+		_c.mutation.SetValidateOnly(v)
+	}
+	if _, ok := _c.mutation.BytesRead(); !ok {
+		v := importjob.DefaultBytesRead
+		_c.mutation.SetBytesRead(v)
+	}
+	if _, ok := _c.mutation.TotalRows(); !ok {
+		v := importjob.DefaultTotalRows
+		_c.mutation.SetTotalRows(v)
+	}
+	if _, ok := _c.mutation.SuccessCount(); !ok {
+		v := importjob.DefaultSuccessCount
+		_c.mutation.SetSuccessCount(v)
+	}
+	if _, ok := _c.mutation.FailureCount(); !ok {
+		v := importjob.DefaultFailureCount
+		_c.mutation.SetFailureCount(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := importjob.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := importjob.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ImportJobCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ImportJob.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := importjob.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "ImportJob.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SourceType(); !ok {
+		return &ValidationError{Name: "source_type", err: errors.New(`ent: missing required field "ImportJob.source_type"`)}
+	}
+	if v, ok := _c.mutation.SourceType(); ok {
+		if err := importjob.SourceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "source_type", err: fmt.Errorf(`ent: validator failed for field "ImportJob.source_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "ImportJob.source"`)}
+	}
+	if v, ok := _c.mutation.Source(); ok {
+		if err := importjob.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "ImportJob.source": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ImportJob.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := importjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ImportJob.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ValidateOnly(); !ok {
+		return &ValidationError{Name: "validate_only", err: errors.New(`ent: missing required field "ImportJob.validate_only"`)}
+	}
+	if _, ok := _c.mutation.BytesRead(); !ok {
+		return &ValidationError{Name: "bytes_read", err: errors.New(`ent: missing required field "ImportJob.bytes_read"`)}
+	}
+	if v, ok := _c.mutation.BytesRead(); ok {
+		if err := importjob.BytesReadValidator(v); err != nil {
+			return &ValidationError{Name: "bytes_read", err: fmt.Errorf(`ent: validator failed for field "ImportJob.bytes_read": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TotalRows(); !ok {
+		return &ValidationError{Name: "total_rows", err: errors.New(`ent: missing required field "ImportJob.total_rows"`)}
+	}
+	if v, ok := _c.mutation.TotalRows(); ok {
+		if err := importjob.TotalRowsValidator(v); err != nil {
+			return &ValidationError{Name: "total_rows", err: fmt.Errorf(`ent: validator failed for field "ImportJob.total_rows": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SuccessCount(); !ok {
+		return &ValidationError{Name: "success_count", err: errors.New(`ent: missing required field "ImportJob.success_count"`)}
+	}
+	if v, ok := _c.mutation.SuccessCount(); ok {
+		if err := importjob.SuccessCountValidator(v); err != nil {
+			return &ValidationError{Name: "success_count", err: fmt.Errorf(`ent: validator failed for field "ImportJob.success_count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FailureCount(); !ok {
+		return &ValidationError{Name: "failure_count", err: errors.New(`ent: missing required field "ImportJob.failure_count"`)}
+	}
+	if v, ok := _c.mutation.FailureCount(); ok {
+		if err := importjob.FailureCountValidator(v); err != nil {
+			return &ValidationError{Name: "failure_count", err: fmt.Errorf(`ent: validator failed for field "ImportJob.failure_count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ImportJob.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ImportJob.updated_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "ImportJob.user"`)}
+	}
+	return nil
+}
+
+func (_c *ImportJobCreate) sqlSave(ctx context.Context) (*ImportJob, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ImportJobCreate) createSpec() (*ImportJob, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ImportJob{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(importjob.Table, sqlgraph.NewFieldSpec(importjob.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.SourceType(); ok {
+		_spec.SetField(importjob.FieldSourceType, field.TypeEnum, value)
+		_node.SourceType = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(importjob.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(importjob.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.ValidateOnly(); ok {
+		_spec.SetField(importjob.FieldValidateOnly, field.TypeBool, value)
+		_node.ValidateOnly = value
+	}
+	if value, ok := _c.mutation.TotalBytes(); ok {
+		_spec.SetField(importjob.FieldTotalBytes, field.TypeInt64, value)
+		_node.TotalBytes = &value
+	}
+	if value, ok := _c.mutation.BytesRead(); ok {
+		_spec.SetField(importjob.FieldBytesRead, field.TypeInt64, value)
+		_node.BytesRead = value
+	}
+	if value, ok := _c.mutation.TotalRows(); ok {
+		_spec.SetField(importjob.FieldTotalRows, field.TypeInt, value)
+		_node.TotalRows = value
+	}
+	if value, ok := _c.mutation.SuccessCount(); ok {
+		_spec.SetField(importjob.FieldSuccessCount, field.TypeInt, value)
+		_node.SuccessCount = value
+	}
+	if value, ok := _c.mutation.FailureCount(); ok {
+		_spec.SetField(importjob.FieldFailureCount, field.TypeInt, value)
+		_node.FailureCount = value
+	}
+	if value, ok := _c.mutation.Errors(); ok {
+		_spec.SetField(importjob.FieldErrors, field.TypeJSON, value)
+		_node.Errors = value
+	}
+	if value, ok := _c.mutation.ErrorMessage(); ok {
+		_spec.SetField(importjob.FieldErrorMessage, field.TypeString, value)
+		_node.ErrorMessage = value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(importjob.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = &value
+	}
+	if value, ok := _c.mutation.CompletedAt(); ok {
+		_spec.SetField(importjob.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(importjob.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(importjob.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importjob.UserTable,
+			Columns: []string{importjob.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ImportJobCreateBulk is the builder for creating many ImportJob entities in bulk.
+type ImportJobCreateBulk struct {
+	config
+	err      error
+	builders []*ImportJobCreate
+}
+
+// Save creates the ImportJob entities in the database.
+func (_c *ImportJobCreateBulk) Save(ctx context.Context) ([]*ImportJob, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ImportJob, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ImportJobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ImportJobCreateBulk) SaveX(ctx context.Context) []*ImportJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ImportJobCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ImportJobCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1078 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/importjob"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+	"github.com/jordanlanch/industrydb/ent/user"
+)
+
+// ImportJobUpdate is the builder for updating ImportJob entities.
+type ImportJobUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ImportJobMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ImportJobUpdate builder.
+func (_u *ImportJobUpdate) Where(ps ...predicate.ImportJob) *ImportJobUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ImportJobUpdate) SetUserID(v int) *ImportJobUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableUserID(v *int) *ImportJobUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetSourceType sets the "source_type" field.
+func (_u *ImportJobUpdate) SetSourceType(v importjob.SourceType) *ImportJobUpdate {
+	_u.mutation.SetSourceType(v)
+	return _u
+}
+
+// SetNillableSourceType sets the "source_type" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableSourceType(v *importjob.SourceType) *ImportJobUpdate {
+	if v != nil {
+		_u.SetSourceType(*v)
+	}
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *ImportJobUpdate) SetSource(v string) *ImportJobUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableSource(v *string) *ImportJobUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ImportJobUpdate) SetStatus(v importjob.Status) *ImportJobUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableStatus(v *importjob.Status) *ImportJobUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetValidateOnly sets the "validate_only" field.
+func (_u *ImportJobUpdate) SetValidateOnly(v bool) *ImportJobUpdate {
+	_u.mutation.SetValidateOnly(v)
+	return _u
+}
+
+// SetNillableValidateOnly sets the "validate_only" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableValidateOnly(v *bool) *ImportJobUpdate {
+	if v != nil {
+		_u.SetValidateOnly(*v)
+	}
+	return _u
+}
+
+// SetTotalBytes sets the "total_bytes" field.
+func (_u *ImportJobUpdate) SetTotalBytes(v int64) *ImportJobUpdate {
+	_u.mutation.ResetTotalBytes()
+	_u.mutation.SetTotalBytes(v)
+	return _u
+}
+
+// SetNillableTotalBytes sets the "total_bytes" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableTotalBytes(v *int64) *ImportJobUpdate {
+	if v != nil {
+		_u.SetTotalBytes(*v)
+	}
+	return _u
+}
+
+// AddTotalBytes adds value to the "total_bytes" field.
+func (_u *ImportJobUpdate) AddTotalBytes(v int64) *ImportJobUpdate {
+	_u.mutation.AddTotalBytes(v)
+	return _u
+}
+
+// ClearTotalBytes clears the value of the "total_bytes" field.
+func (_u *ImportJobUpdate) ClearTotalBytes() *ImportJobUpdate {
+	_u.mutation.ClearTotalBytes()
+	return _u
+}
+
+// SetBytesRead sets the "bytes_read" field.
+func (_u *ImportJobUpdate) SetBytesRead(v int64) *ImportJobUpdate {
+	_u.mutation.ResetBytesRead()
+	_u.mutation.SetBytesRead(v)
+	return _u
+}
+
+// SetNillableBytesRead sets the "bytes_read" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableBytesRead(v *int64) *ImportJobUpdate {
+	if v != nil {
+		_u.SetBytesRead(*v)
+	}
+	return _u
+}
+
+// AddBytesRead adds value to the "bytes_read" field.
+func (_u *ImportJobUpdate) AddBytesRead(v int64) *ImportJobUpdate {
+	_u.mutation.AddBytesRead(v)
+	return _u
+}
+
+// SetTotalRows sets the "total_rows" field.
+func (_u *ImportJobUpdate) SetTotalRows(v int) *ImportJobUpdate {
+	_u.mutation.ResetTotalRows()
+	_u.mutation.SetTotalRows(v)
+	return _u
+}
+
+// SetNillableTotalRows sets the "total_rows" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableTotalRows(v *int) *ImportJobUpdate {
+	if v != nil {
+		_u.SetTotalRows(*v)
+	}
+	return _u
+}
+
+// AddTotalRows adds value to the "total_rows" field.
+func (_u *ImportJobUpdate) AddTotalRows(v int) *ImportJobUpdate {
+	_u.mutation.AddTotalRows(v)
+	return _u
+}
+
+// SetSuccessCount sets the "success_count" field.
+func (_u *ImportJobUpdate) SetSuccessCount(v int) *ImportJobUpdate {
+	_u.mutation.ResetSuccessCount()
+	_u.mutation.SetSuccessCount(v)
+	return _u
+}
+
+// SetNillableSuccessCount sets the "success_count" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableSuccessCount(v *int) *ImportJobUpdate {
+	if v != nil {
+		_u.SetSuccessCount(*v)
+	}
+	return _u
+}
+
+// AddSuccessCount adds value to the "success_count" field.
+func (_u *ImportJobUpdate) AddSuccessCount(v int) *ImportJobUpdate {
+	_u.mutation.AddSuccessCount(v)
+	return _u
+}
+
+// SetFailureCount sets the "failure_count" field.
+func (_u *ImportJobUpdate) SetFailureCount(v int) *ImportJobUpdate {
+	_u.mutation.ResetFailureCount()
+	_u.mutation.SetFailureCount(v)
+	return _u
+}
+
+// SetNillableFailureCount sets the "failure_count" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableFailureCount(v *int) *ImportJobUpdate {
+	if v != nil {
+		_u.SetFailureCount(*v)
+	}
+	return _u
+}
+
+// AddFailureCount adds value to the "failure_count" field.
+func (_u *ImportJobUpdate) AddFailureCount(v int) *ImportJobUpdate {
+	_u.mutation.AddFailureCount(v)
+	return _u
+}
+
+// SetErrors sets the "errors" field.
+func (_u *ImportJobUpdate) SetErrors(v []map[string]interface{}) *ImportJobUpdate {
+	_u.mutation.SetErrors(v)
+	return _u
+}
+
+// AppendErrors appends value to the "errors" field.
+func (_u *ImportJobUpdate) AppendErrors(v []map[string]interface{}) *ImportJobUpdate {
+	_u.mutation.AppendErrors(v)
+	return _u
+}
+
+// ClearErrors clears the value of the "errors" field.
+func (_u *ImportJobUpdate) ClearErrors() *ImportJobUpdate {
+	_u.mutation.ClearErrors()
+	return _u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_u *ImportJobUpdate) SetErrorMessage(v string) *ImportJobUpdate {
+	_u.mutation.SetErrorMessage(v)
+	return _u
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableErrorMessage(v *string) *ImportJobUpdate {
+	if v != nil {
+		_u.SetErrorMessage(*v)
+	}
+	return _u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (_u *ImportJobUpdate) ClearErrorMessage() *ImportJobUpdate {
+	_u.mutation.ClearErrorMessage()
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *ImportJobUpdate) SetStartedAt(v time.Time) *ImportJobUpdate {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableStartedAt(v *time.Time) *ImportJobUpdate {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *ImportJobUpdate) ClearStartedAt() *ImportJobUpdate {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *ImportJobUpdate) SetCompletedAt(v time.Time) *ImportJobUpdate {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableCompletedAt(v *time.Time) *ImportJobUpdate {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *ImportJobUpdate) ClearCompletedAt() *ImportJobUpdate {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ImportJobUpdate) SetUpdatedAt(v time.Time) *ImportJobUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *ImportJobUpdate) SetUser(v *User) *ImportJobUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the ImportJobMutation object of the builder.
+func (_u *ImportJobUpdate) Mutation() *ImportJobMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *ImportJobUpdate) ClearUser() *ImportJobUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ImportJobUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ImportJobUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ImportJobUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ImportJobUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ImportJobUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := importjob.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ImportJobUpdate) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := importjob.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "ImportJob.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SourceType(); ok {
+		if err := importjob.SourceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "source_type", err: fmt.Errorf(`ent: validator failed for field "ImportJob.source_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Source(); ok {
+		if err := importjob.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "ImportJob.source": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := importjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ImportJob.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BytesRead(); ok {
+		if err := importjob.BytesReadValidator(v); err != nil {
+			return &ValidationError{Name: "bytes_read", err: fmt.Errorf(`ent: validator failed for field "ImportJob.bytes_read": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TotalRows(); ok {
+		if err := importjob.TotalRowsValidator(v); err != nil {
+			return &ValidationError{Name: "total_rows", err: fmt.Errorf(`ent: validator failed for field "ImportJob.total_rows": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SuccessCount(); ok {
+		if err := importjob.SuccessCountValidator(v); err != nil {
+			return &ValidationError{Name: "success_count", err: fmt.Errorf(`ent: validator failed for field "ImportJob.success_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FailureCount(); ok {
+		if err := importjob.FailureCountValidator(v); err != nil {
+			return &ValidationError{Name: "failure_count", err: fmt.Errorf(`ent: validator failed for field "ImportJob.failure_count": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ImportJob.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ImportJobUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ImportJobUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ImportJobUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(importjob.Table, importjob.Columns, sqlgraph.NewFieldSpec(importjob.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.SourceType(); ok {
+		_spec.SetField(importjob.FieldSourceType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(importjob.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(importjob.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ValidateOnly(); ok {
+		_spec.SetField(importjob.FieldValidateOnly, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.TotalBytes(); ok {
+		_spec.SetField(importjob.FieldTotalBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTotalBytes(); ok {
+		_spec.AddField(importjob.FieldTotalBytes, field.TypeInt64, value)
+	}
+	if _u.mutation.TotalBytesCleared() {
+		_spec.ClearField(importjob.FieldTotalBytes, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.BytesRead(); ok {
+		_spec.SetField(importjob.FieldBytesRead, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedBytesRead(); ok {
+		_spec.AddField(importjob.FieldBytesRead, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.TotalRows(); ok {
+		_spec.SetField(importjob.FieldTotalRows, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalRows(); ok {
+		_spec.AddField(importjob.FieldTotalRows, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SuccessCount(); ok {
+		_spec.SetField(importjob.FieldSuccessCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSuccessCount(); ok {
+		_spec.AddField(importjob.FieldSuccessCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FailureCount(); ok {
+		_spec.SetField(importjob.FieldFailureCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailureCount(); ok {
+		_spec.AddField(importjob.FieldFailureCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Errors(); ok {
+		_spec.SetField(importjob.FieldErrors, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedErrors(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, importjob.FieldErrors, value)
+		})
+	}
+	if _u.mutation.ErrorsCleared() {
+		_spec.ClearField(importjob.FieldErrors, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ErrorMessage(); ok {
+		_spec.SetField(importjob.FieldErrorMessage, field.TypeString, value)
+	}
+	if _u.mutation.ErrorMessageCleared() {
+		_spec.ClearField(importjob.FieldErrorMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(importjob.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(importjob.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(importjob.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(importjob.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(importjob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importjob.UserTable,
+			Columns: []string{importjob.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importjob.UserTable,
+			Columns: []string{importjob.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{importjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ImportJobUpdateOne is the builder for updating a single ImportJob entity.
+type ImportJobUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ImportJobMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ImportJobUpdateOne) SetUserID(v int) *ImportJobUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableUserID(v *int) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetSourceType sets the "source_type" field.
+func (_u *ImportJobUpdateOne) SetSourceType(v importjob.SourceType) *ImportJobUpdateOne {
+	_u.mutation.SetSourceType(v)
+	return _u
+}
+
+// SetNillableSourceType sets the "source_type" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableSourceType(v *importjob.SourceType) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetSourceType(*v)
+	}
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *ImportJobUpdateOne) SetSource(v string) *ImportJobUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableSource(v *string) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ImportJobUpdateOne) SetStatus(v importjob.Status) *ImportJobUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableStatus(v *importjob.Status) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetValidateOnly sets the "validate_only" field.
+func (_u *ImportJobUpdateOne) SetValidateOnly(v bool) *ImportJobUpdateOne {
+	_u.mutation.SetValidateOnly(v)
+	return _u
+}
+
+// SetNillableValidateOnly sets the "validate_only" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableValidateOnly(v *bool) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetValidateOnly(*v)
+	}
+	return _u
+}
+
+// SetTotalBytes sets the "total_bytes" field.
+func (_u *ImportJobUpdateOne) SetTotalBytes(v int64) *ImportJobUpdateOne {
+	_u.mutation.ResetTotalBytes()
+	_u.mutation.SetTotalBytes(v)
+	return _u
+}
+
+// SetNillableTotalBytes sets the "total_bytes" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableTotalBytes(v *int64) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetTotalBytes(*v)
+	}
+	return _u
+}
+
+// AddTotalBytes adds value to the "total_bytes" field.
+func (_u *ImportJobUpdateOne) AddTotalBytes(v int64) *ImportJobUpdateOne {
+	_u.mutation.AddTotalBytes(v)
+	return _u
+}
+
+// ClearTotalBytes clears the value of the "total_bytes" field.
+func (_u *ImportJobUpdateOne) ClearTotalBytes() *ImportJobUpdateOne {
+	_u.mutation.ClearTotalBytes()
+	return _u
+}
+
+// SetBytesRead sets the "bytes_read" field.
+func (_u *ImportJobUpdateOne) SetBytesRead(v int64) *ImportJobUpdateOne {
+	_u.mutation.ResetBytesRead()
+	_u.mutation.SetBytesRead(v)
+	return _u
+}
+
+// SetNillableBytesRead sets the "bytes_read" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableBytesRead(v *int64) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetBytesRead(*v)
+	}
+	return _u
+}
+
+// AddBytesRead adds value to the "bytes_read" field.
+func (_u *ImportJobUpdateOne) AddBytesRead(v int64) *ImportJobUpdateOne {
+	_u.mutation.AddBytesRead(v)
+	return _u
+}
+
+// SetTotalRows sets the "total_rows" field.
+func (_u *ImportJobUpdateOne) SetTotalRows(v int) *ImportJobUpdateOne {
+	_u.mutation.ResetTotalRows()
+	_u.mutation.SetTotalRows(v)
+	return _u
+}
+
+// SetNillableTotalRows sets the "total_rows" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableTotalRows(v *int) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetTotalRows(*v)
+	}
+	return _u
+}
+
+// AddTotalRows adds value to the "total_rows" field.
+func (_u *ImportJobUpdateOne) AddTotalRows(v int) *ImportJobUpdateOne {
+	_u.mutation.AddTotalRows(v)
+	return _u
+}
+
+// SetSuccessCount sets the "success_count" field.
+func (_u *ImportJobUpdateOne) SetSuccessCount(v int) *ImportJobUpdateOne {
+	_u.mutation.ResetSuccessCount()
+	_u.mutation.SetSuccessCount(v)
+	return _u
+}
+
+// SetNillableSuccessCount sets the "success_count" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableSuccessCount(v *int) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetSuccessCount(*v)
+	}
+	return _u
+}
+
+// AddSuccessCount adds value to the "success_count" field.
+func (_u *ImportJobUpdateOne) AddSuccessCount(v int) *ImportJobUpdateOne {
+	_u.mutation.AddSuccessCount(v)
+	return _u
+}
+
+// SetFailureCount sets the "failure_count" field.
+func (_u *ImportJobUpdateOne) SetFailureCount(v int) *ImportJobUpdateOne {
+	_u.mutation.ResetFailureCount()
+	_u.mutation.SetFailureCount(v)
+	return _u
+}
+
+// SetNillableFailureCount sets the "failure_count" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableFailureCount(v *int) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetFailureCount(*v)
+	}
+	return _u
+}
+
+// AddFailureCount adds value to the "failure_count" field.
+func (_u *ImportJobUpdateOne) AddFailureCount(v int) *ImportJobUpdateOne {
+	_u.mutation.AddFailureCount(v)
+	return _u
+}
+
+// SetErrors sets the "errors" field.
+func (_u *ImportJobUpdateOne) SetErrors(v []map[string]interface{}) *ImportJobUpdateOne {
+	_u.mutation.SetErrors(v)
+	return _u
+}
+
+// AppendErrors appends value to the "errors" field.
+func (_u *ImportJobUpdateOne) AppendErrors(v []map[string]interface{}) *ImportJobUpdateOne {
+	_u.mutation.AppendErrors(v)
+	return _u
+}
+
+// ClearErrors clears the value of the "errors" field.
+func (_u *ImportJobUpdateOne) ClearErrors() *ImportJobUpdateOne {
+	_u.mutation.ClearErrors()
+	return _u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_u *ImportJobUpdateOne) SetErrorMessage(v string) *ImportJobUpdateOne {
+	_u.mutation.SetErrorMessage(v)
+	return _u
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableErrorMessage(v *string) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetErrorMessage(*v)
+	}
+	return _u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (_u *ImportJobUpdateOne) ClearErrorMessage() *ImportJobUpdateOne {
+	_u.mutation.ClearErrorMessage()
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *ImportJobUpdateOne) SetStartedAt(v time.Time) *ImportJobUpdateOne {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableStartedAt(v *time.Time) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *ImportJobUpdateOne) ClearStartedAt() *ImportJobUpdateOne {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *ImportJobUpdateOne) SetCompletedAt(v time.Time) *ImportJobUpdateOne {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableCompletedAt(v *time.Time) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *ImportJobUpdateOne) ClearCompletedAt() *ImportJobUpdateOne {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ImportJobUpdateOne) SetUpdatedAt(v time.Time) *ImportJobUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *ImportJobUpdateOne) SetUser(v *User) *ImportJobUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the ImportJobMutation object of the builder.
+func (_u *ImportJobUpdateOne) Mutation() *ImportJobMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *ImportJobUpdateOne) ClearUser() *ImportJobUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the ImportJobUpdate builder.
+func (_u *ImportJobUpdateOne) Where(ps ...predicate.ImportJob) *ImportJobUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ImportJobUpdateOne) Select(field string, fields ...string) *ImportJobUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ImportJob entity.
+func (_u *ImportJobUpdateOne) Save(ctx context.Context) (*ImportJob, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ImportJobUpdateOne) SaveX(ctx context.Context) *ImportJob {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ImportJobUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ImportJobUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ImportJobUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := importjob.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ImportJobUpdateOne) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := importjob.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "ImportJob.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SourceType(); ok {
+		if err := importjob.SourceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "source_type", err: fmt.Errorf(`ent: validator failed for field "ImportJob.source_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Source(); ok {
+		if err := importjob.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "ImportJob.source": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := importjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ImportJob.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BytesRead(); ok {
+		if err := importjob.BytesReadValidator(v); err != nil {
+			return &ValidationError{Name: "bytes_read", err: fmt.Errorf(`ent: validator failed for field "ImportJob.bytes_read": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TotalRows(); ok {
+		if err := importjob.TotalRowsValidator(v); err != nil {
+			return &ValidationError{Name: "total_rows", err: fmt.Errorf(`ent: validator failed for field "ImportJob.total_rows": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SuccessCount(); ok {
+		if err := importjob.SuccessCountValidator(v); err != nil {
+			return &ValidationError{Name: "success_count", err: fmt.Errorf(`ent: validator failed for field "ImportJob.success_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FailureCount(); ok {
+		if err := importjob.FailureCountValidator(v); err != nil {
+			return &ValidationError{Name: "failure_count", err: fmt.Errorf(`ent: validator failed for field "ImportJob.failure_count": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ImportJob.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ImportJobUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ImportJobUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ImportJobUpdateOne) sqlSave(ctx context.Context) (_node *ImportJob, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(importjob.Table, importjob.Columns, sqlgraph.NewFieldSpec(importjob.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ImportJob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, importjob.FieldID)
+		for _, f := range fields {
+			if !importjob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != importjob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.SourceType(); ok {
+		_spec.SetField(importjob.FieldSourceType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(importjob.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(importjob.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ValidateOnly(); ok {
+		_spec.SetField(importjob.FieldValidateOnly, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.TotalBytes(); ok {
+		_spec.SetField(importjob.FieldTotalBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTotalBytes(); ok {
+		_spec.AddField(importjob.FieldTotalBytes, field.TypeInt64, value)
+	}
+	if _u.mutation.TotalBytesCleared() {
+		_spec.ClearField(importjob.FieldTotalBytes, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.BytesRead(); ok {
+		_spec.SetField(importjob.FieldBytesRead, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedBytesRead(); ok {
+		_spec.AddField(importjob.FieldBytesRead, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.TotalRows(); ok {
+		_spec.SetField(importjob.FieldTotalRows, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalRows(); ok {
+		_spec.AddField(importjob.FieldTotalRows, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SuccessCount(); ok {
+		_spec.SetField(importjob.FieldSuccessCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSuccessCount(); ok {
+		_spec.AddField(importjob.FieldSuccessCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FailureCount(); ok {
+		_spec.SetField(importjob.FieldFailureCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailureCount(); ok {
+		_spec.AddField(importjob.FieldFailureCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Errors(); ok {
+		_spec.SetField(importjob.FieldErrors, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedErrors(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, importjob.FieldErrors, value)
+		})
+	}
+	if _u.mutation.ErrorsCleared() {
+		_spec.ClearField(importjob.FieldErrors, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ErrorMessage(); ok {
+		_spec.SetField(importjob.FieldErrorMessage, field.TypeString, value)
+	}
+	if _u.mutation.ErrorMessageCleared() {
+		_spec.ClearField(importjob.FieldErrorMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(importjob.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(importjob.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(importjob.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(importjob.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(importjob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importjob.UserTable,
+			Columns: []string{importjob.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importjob.UserTable,
+			Columns: []string{importjob.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ImportJob{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{importjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -19,8 +19,9 @@ import (
 // IndustryUpdate is the builder for updating Industry entities.
 type IndustryUpdate struct {
 	config
-	hooks    []Hook
-	mutation *IndustryMutation
+	hooks     []Hook
+	mutation  *IndustryMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the IndustryUpdate builder.
@@ -231,6 +232,12 @@ func (_u *IndustryUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *IndustryUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *IndustryUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *IndustryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -287,6 +294,7 @@ func (_u *IndustryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(industry.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{industry.Label}
@@ -302,9 +310,10 @@ func (_u *IndustryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // IndustryUpdateOne is the builder for updating a single Industry entity.
 type IndustryUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *IndustryMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *IndustryMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetName sets the "name" field.
@@ -522,6 +531,12 @@ func (_u *IndustryUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *IndustryUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *IndustryUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *IndustryUpdateOne) sqlSave(ctx context.Context) (_node *Industry, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -595,6 +610,7 @@ func (_u *IndustryUpdateOne) sqlSave(ctx context.Context) (_node *Industry, err
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(industry.FieldUpdatedAt, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Industry{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
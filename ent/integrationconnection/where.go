@@ -0,0 +1,459 @@
+// Code generated by ent, DO NOT EDIT.
+
+package integrationconnection
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldEQ(FieldUserID, v))
+}
+
+// AccountEmail applies equality check predicate on the "account_email" field. It's identical to AccountEmailEQ.
+func AccountEmail(v string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldEQ(FieldAccountEmail, v))
+}
+
+// RefreshToken applies equality check predicate on the "refresh_token" field. It's identical to RefreshTokenEQ.
+func RefreshToken(v string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldEQ(FieldRefreshToken, v))
+}
+
+// Scopes applies equality check predicate on the "scopes" field. It's identical to ScopesEQ.
+func Scopes(v string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldEQ(FieldScopes, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v Provider) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v Provider) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...Provider) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...Provider) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// AccountEmailEQ applies the EQ predicate on the "account_email" field.
+func AccountEmailEQ(v string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldEQ(FieldAccountEmail, v))
+}
+
+// AccountEmailNEQ applies the NEQ predicate on the "account_email" field.
+func AccountEmailNEQ(v string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldNEQ(FieldAccountEmail, v))
+}
+
+// AccountEmailIn applies the In predicate on the "account_email" field.
+func AccountEmailIn(vs ...string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldIn(FieldAccountEmail, vs...))
+}
+
+// AccountEmailNotIn applies the NotIn predicate on the "account_email" field.
+func AccountEmailNotIn(vs ...string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldNotIn(FieldAccountEmail, vs...))
+}
+
+// AccountEmailGT applies the GT predicate on the "account_email" field.
+func AccountEmailGT(v string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldGT(FieldAccountEmail, v))
+}
+
+// AccountEmailGTE applies the GTE predicate on the "account_email" field.
+func AccountEmailGTE(v string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldGTE(FieldAccountEmail, v))
+}
+
+// AccountEmailLT applies the LT predicate on the "account_email" field.
+func AccountEmailLT(v string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldLT(FieldAccountEmail, v))
+}
+
+// AccountEmailLTE applies the LTE predicate on the "account_email" field.
+func AccountEmailLTE(v string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldLTE(FieldAccountEmail, v))
+}
+
+// AccountEmailContains applies the Contains predicate on the "account_email" field.
+func AccountEmailContains(v string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldContains(FieldAccountEmail, v))
+}
+
+// AccountEmailHasPrefix applies the HasPrefix predicate on the "account_email" field.
+func AccountEmailHasPrefix(v string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldHasPrefix(FieldAccountEmail, v))
+}
+
+// AccountEmailHasSuffix applies the HasSuffix predicate on the "account_email" field.
+func AccountEmailHasSuffix(v string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldHasSuffix(FieldAccountEmail, v))
+}
+
+// AccountEmailIsNil applies the IsNil predicate on the "account_email" field.
+func AccountEmailIsNil() predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldIsNull(FieldAccountEmail))
+}
+
+// AccountEmailNotNil applies the NotNil predicate on the "account_email" field.
+func AccountEmailNotNil() predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldNotNull(FieldAccountEmail))
+}
+
+// AccountEmailEqualFold applies the EqualFold predicate on the "account_email" field.
+func AccountEmailEqualFold(v string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldEqualFold(FieldAccountEmail, v))
+}
+
+// AccountEmailContainsFold applies the ContainsFold predicate on the "account_email" field.
+func AccountEmailContainsFold(v string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldContainsFold(FieldAccountEmail, v))
+}
+
+// RefreshTokenEQ applies the EQ predicate on the "refresh_token" field.
+func RefreshTokenEQ(v string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldEQ(FieldRefreshToken, v))
+}
+
+// RefreshTokenNEQ applies the NEQ predicate on the "refresh_token" field.
+func RefreshTokenNEQ(v string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldNEQ(FieldRefreshToken, v))
+}
+
+// RefreshTokenIn applies the In predicate on the "refresh_token" field.
+func RefreshTokenIn(vs ...string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldIn(FieldRefreshToken, vs...))
+}
+
+// RefreshTokenNotIn applies the NotIn predicate on the "refresh_token" field.
+func RefreshTokenNotIn(vs ...string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldNotIn(FieldRefreshToken, vs...))
+}
+
+// RefreshTokenGT applies the GT predicate on the "refresh_token" field.
+func RefreshTokenGT(v string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldGT(FieldRefreshToken, v))
+}
+
+// RefreshTokenGTE applies the GTE predicate on the "refresh_token" field.
+func RefreshTokenGTE(v string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldGTE(FieldRefreshToken, v))
+}
+
+// RefreshTokenLT applies the LT predicate on the "refresh_token" field.
+func RefreshTokenLT(v string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldLT(FieldRefreshToken, v))
+}
+
+// RefreshTokenLTE applies the LTE predicate on the "refresh_token" field.
+func RefreshTokenLTE(v string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldLTE(FieldRefreshToken, v))
+}
+
+// RefreshTokenContains applies the Contains predicate on the "refresh_token" field.
+func RefreshTokenContains(v string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldContains(FieldRefreshToken, v))
+}
+
+// RefreshTokenHasPrefix applies the HasPrefix predicate on the "refresh_token" field.
+func RefreshTokenHasPrefix(v string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldHasPrefix(FieldRefreshToken, v))
+}
+
+// RefreshTokenHasSuffix applies the HasSuffix predicate on the "refresh_token" field.
+func RefreshTokenHasSuffix(v string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldHasSuffix(FieldRefreshToken, v))
+}
+
+// RefreshTokenEqualFold applies the EqualFold predicate on the "refresh_token" field.
+func RefreshTokenEqualFold(v string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldEqualFold(FieldRefreshToken, v))
+}
+
+// RefreshTokenContainsFold applies the ContainsFold predicate on the "refresh_token" field.
+func RefreshTokenContainsFold(v string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldContainsFold(FieldRefreshToken, v))
+}
+
+// ScopesEQ applies the EQ predicate on the "scopes" field.
+func ScopesEQ(v string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldEQ(FieldScopes, v))
+}
+
+// ScopesNEQ applies the NEQ predicate on the "scopes" field.
+func ScopesNEQ(v string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldNEQ(FieldScopes, v))
+}
+
+// ScopesIn applies the In predicate on the "scopes" field.
+func ScopesIn(vs ...string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldIn(FieldScopes, vs...))
+}
+
+// ScopesNotIn applies the NotIn predicate on the "scopes" field.
+func ScopesNotIn(vs ...string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldNotIn(FieldScopes, vs...))
+}
+
+// ScopesGT applies the GT predicate on the "scopes" field.
+func ScopesGT(v string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldGT(FieldScopes, v))
+}
+
+// ScopesGTE applies the GTE predicate on the "scopes" field.
+func ScopesGTE(v string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldGTE(FieldScopes, v))
+}
+
+// ScopesLT applies the LT predicate on the "scopes" field.
+func ScopesLT(v string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldLT(FieldScopes, v))
+}
+
+// ScopesLTE applies the LTE predicate on the "scopes" field.
+func ScopesLTE(v string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldLTE(FieldScopes, v))
+}
+
+// ScopesContains applies the Contains predicate on the "scopes" field.
+func ScopesContains(v string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldContains(FieldScopes, v))
+}
+
+// ScopesHasPrefix applies the HasPrefix predicate on the "scopes" field.
+func ScopesHasPrefix(v string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldHasPrefix(FieldScopes, v))
+}
+
+// ScopesHasSuffix applies the HasSuffix predicate on the "scopes" field.
+func ScopesHasSuffix(v string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldHasSuffix(FieldScopes, v))
+}
+
+// ScopesIsNil applies the IsNil predicate on the "scopes" field.
+func ScopesIsNil() predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldIsNull(FieldScopes))
+}
+
+// ScopesNotNil applies the NotNil predicate on the "scopes" field.
+func ScopesNotNil() predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldNotNull(FieldScopes))
+}
+
+// ScopesEqualFold applies the EqualFold predicate on the "scopes" field.
+func ScopesEqualFold(v string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldEqualFold(FieldScopes, v))
+}
+
+// ScopesContainsFold applies the ContainsFold predicate on the "scopes" field.
+func ScopesContainsFold(v string) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldContainsFold(FieldScopes, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.IntegrationConnection) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.IntegrationConnection) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.IntegrationConnection) predicate.IntegrationConnection {
+	return predicate.IntegrationConnection(sql.NotPredicates(p))
+}
@@ -0,0 +1,329 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/integrationconnection"
+	"github.com/jordanlanch/industrydb/ent/user"
+)
+
+// IntegrationConnectionCreate is the builder for creating a IntegrationConnection entity.
+type IntegrationConnectionCreate struct {
+	config
+	mutation *IntegrationConnectionMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *IntegrationConnectionCreate) SetUserID(v int) *IntegrationConnectionCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetProvider sets the "provider" field.
+func (_c *IntegrationConnectionCreate) SetProvider(v integrationconnection.Provider) *IntegrationConnectionCreate {
+	_c.mutation.SetProvider(v)
+	return _c
+}
+
+// SetAccountEmail sets the "account_email" field.
+func (_c *IntegrationConnectionCreate) SetAccountEmail(v string) *IntegrationConnectionCreate {
+	_c.mutation.SetAccountEmail(v)
+	return _c
+}
+
+// SetNillableAccountEmail sets the "account_email" field if the given value is not nil.
+func (_c *IntegrationConnectionCreate) SetNillableAccountEmail(v *string) *IntegrationConnectionCreate {
+	if v != nil {
+		_c.SetAccountEmail(*v)
+	}
+	return _c
+}
+
+// SetRefreshToken sets the "refresh_token" field.
+func (_c *IntegrationConnectionCreate) SetRefreshToken(v string) *IntegrationConnectionCreate {
+	_c.mutation.SetRefreshToken(v)
+	return _c
+}
+
+// SetScopes sets the "scopes" field.
+func (_c *IntegrationConnectionCreate) SetScopes(v string) *IntegrationConnectionCreate {
+	_c.mutation.SetScopes(v)
+	return _c
+}
+
+// SetNillableScopes sets the "scopes" field if the given value is not nil.
+func (_c *IntegrationConnectionCreate) SetNillableScopes(v *string) *IntegrationConnectionCreate {
+	if v != nil {
+		_c.SetScopes(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *IntegrationConnectionCreate) SetCreatedAt(v time.Time) *IntegrationConnectionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *IntegrationConnectionCreate) SetNillableCreatedAt(v *time.Time) *IntegrationConnectionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *IntegrationConnectionCreate) SetUpdatedAt(v time.Time) *IntegrationConnectionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *IntegrationConnectionCreate) SetNillableUpdatedAt(v *time.Time) *IntegrationConnectionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *IntegrationConnectionCreate) SetUser(v *User) *IntegrationConnectionCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the IntegrationConnectionMutation object of the builder.
+func (_c *IntegrationConnectionCreate) Mutation() *IntegrationConnectionMutation {
+	return _c.mutation
+}
+
+// Save creates the IntegrationConnection in the database.
+func (_c *IntegrationConnectionCreate) Save(ctx context.Context) (*IntegrationConnection, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *IntegrationConnectionCreate) SaveX(ctx context.Context) *IntegrationConnection {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *IntegrationConnectionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *IntegrationConnectionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *IntegrationConnectionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := integrationconnection.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := integrationconnection.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *IntegrationConnectionCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "IntegrationConnection.user_id"`)}
+	}
+	if _, ok := _c.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "IntegrationConnection.provider"`)}
+	}
+	if v, ok := _c.mutation.Provider(); ok {
+		if err := integrationconnection.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "IntegrationConnection.provider": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RefreshToken(); !ok {
+		return &ValidationError{Name: "refresh_token", err: errors.New(`ent: missing required field "IntegrationConnection.refresh_token"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "IntegrationConnection.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "IntegrationConnection.updated_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "IntegrationConnection.user"`)}
+	}
+	return nil
+}
+
+func (_c *IntegrationConnectionCreate) sqlSave(ctx context.Context) (*IntegrationConnection, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *IntegrationConnectionCreate) createSpec() (*IntegrationConnection, *sqlgraph.CreateSpec) {
+	var (
+		_node = &IntegrationConnection{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(integrationconnection.Table, sqlgraph.NewFieldSpec(integrationconnection.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Provider(); ok {
+		_spec.SetField(integrationconnection.FieldProvider, field.TypeEnum, value)
+		_node.Provider = value
+	}
+	if value, ok := _c.mutation.AccountEmail(); ok {
+		_spec.SetField(integrationconnection.FieldAccountEmail, field.TypeString, value)
+		_node.AccountEmail = value
+	}
+	if value, ok := _c.mutation.RefreshToken(); ok {
+		_spec.SetField(integrationconnection.FieldRefreshToken, field.TypeString, value)
+		_node.RefreshToken = value
+	}
+	if value, ok := _c.mutation.Scopes(); ok {
+		_spec.SetField(integrationconnection.FieldScopes, field.TypeString, value)
+		_node.Scopes = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(integrationconnection.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(integrationconnection.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   integrationconnection.UserTable,
+			Columns: []string{integrationconnection.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// IntegrationConnectionCreateBulk is the builder for creating many IntegrationConnection entities in bulk.
+type IntegrationConnectionCreateBulk struct {
+	config
+	err      error
+	builders []*IntegrationConnectionCreate
+}
+
+// Save creates the IntegrationConnection entities in the database.
+func (_c *IntegrationConnectionCreateBulk) Save(ctx context.Context) ([]*IntegrationConnection, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*IntegrationConnection, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*IntegrationConnectionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *IntegrationConnectionCreateBulk) SaveX(ctx context.Context) []*IntegrationConnection {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *IntegrationConnectionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *IntegrationConnectionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,541 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/integrationconnection"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+	"github.com/jordanlanch/industrydb/ent/user"
+)
+
+// IntegrationConnectionUpdate is the builder for updating IntegrationConnection entities.
+type IntegrationConnectionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *IntegrationConnectionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the IntegrationConnectionUpdate builder.
+func (_u *IntegrationConnectionUpdate) Where(ps ...predicate.IntegrationConnection) *IntegrationConnectionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *IntegrationConnectionUpdate) SetUserID(v int) *IntegrationConnectionUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *IntegrationConnectionUpdate) SetNillableUserID(v *int) *IntegrationConnectionUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *IntegrationConnectionUpdate) SetProvider(v integrationconnection.Provider) *IntegrationConnectionUpdate {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *IntegrationConnectionUpdate) SetNillableProvider(v *integrationconnection.Provider) *IntegrationConnectionUpdate {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetAccountEmail sets the "account_email" field.
+func (_u *IntegrationConnectionUpdate) SetAccountEmail(v string) *IntegrationConnectionUpdate {
+	_u.mutation.SetAccountEmail(v)
+	return _u
+}
+
+// SetNillableAccountEmail sets the "account_email" field if the given value is not nil.
+func (_u *IntegrationConnectionUpdate) SetNillableAccountEmail(v *string) *IntegrationConnectionUpdate {
+	if v != nil {
+		_u.SetAccountEmail(*v)
+	}
+	return _u
+}
+
+// ClearAccountEmail clears the value of the "account_email" field.
+func (_u *IntegrationConnectionUpdate) ClearAccountEmail() *IntegrationConnectionUpdate {
+	_u.mutation.ClearAccountEmail()
+	return _u
+}
+
+// SetRefreshToken sets the "refresh_token" field.
+func (_u *IntegrationConnectionUpdate) SetRefreshToken(v string) *IntegrationConnectionUpdate {
+	_u.mutation.SetRefreshToken(v)
+	return _u
+}
+
+// SetNillableRefreshToken sets the "refresh_token" field if the given value is not nil.
+func (_u *IntegrationConnectionUpdate) SetNillableRefreshToken(v *string) *IntegrationConnectionUpdate {
+	if v != nil {
+		_u.SetRefreshToken(*v)
+	}
+	return _u
+}
+
+// SetScopes sets the "scopes" field.
+func (_u *IntegrationConnectionUpdate) SetScopes(v string) *IntegrationConnectionUpdate {
+	_u.mutation.SetScopes(v)
+	return _u
+}
+
+// SetNillableScopes sets the "scopes" field if the given value is not nil.
+func (_u *IntegrationConnectionUpdate) SetNillableScopes(v *string) *IntegrationConnectionUpdate {
+	if v != nil {
+		_u.SetScopes(*v)
+	}
+	return _u
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (_u *IntegrationConnectionUpdate) ClearScopes() *IntegrationConnectionUpdate {
+	_u.mutation.ClearScopes()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *IntegrationConnectionUpdate) SetUpdatedAt(v time.Time) *IntegrationConnectionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *IntegrationConnectionUpdate) SetUser(v *User) *IntegrationConnectionUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the IntegrationConnectionMutation object of the builder.
+func (_u *IntegrationConnectionUpdate) Mutation() *IntegrationConnectionMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *IntegrationConnectionUpdate) ClearUser() *IntegrationConnectionUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *IntegrationConnectionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *IntegrationConnectionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *IntegrationConnectionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *IntegrationConnectionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *IntegrationConnectionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := integrationconnection.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *IntegrationConnectionUpdate) check() error {
+	if v, ok := _u.mutation.Provider(); ok {
+		if err := integrationconnection.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "IntegrationConnection.provider": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "IntegrationConnection.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *IntegrationConnectionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *IntegrationConnectionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *IntegrationConnectionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(integrationconnection.Table, integrationconnection.Columns, sqlgraph.NewFieldSpec(integrationconnection.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(integrationconnection.FieldProvider, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.AccountEmail(); ok {
+		_spec.SetField(integrationconnection.FieldAccountEmail, field.TypeString, value)
+	}
+	if _u.mutation.AccountEmailCleared() {
+		_spec.ClearField(integrationconnection.FieldAccountEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.RefreshToken(); ok {
+		_spec.SetField(integrationconnection.FieldRefreshToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Scopes(); ok {
+		_spec.SetField(integrationconnection.FieldScopes, field.TypeString, value)
+	}
+	if _u.mutation.ScopesCleared() {
+		_spec.ClearField(integrationconnection.FieldScopes, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(integrationconnection.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   integrationconnection.UserTable,
+			Columns: []string{integrationconnection.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   integrationconnection.UserTable,
+			Columns: []string{integrationconnection.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{integrationconnection.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// IntegrationConnectionUpdateOne is the builder for updating a single IntegrationConnection entity.
+type IntegrationConnectionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *IntegrationConnectionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *IntegrationConnectionUpdateOne) SetUserID(v int) *IntegrationConnectionUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *IntegrationConnectionUpdateOne) SetNillableUserID(v *int) *IntegrationConnectionUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *IntegrationConnectionUpdateOne) SetProvider(v integrationconnection.Provider) *IntegrationConnectionUpdateOne {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *IntegrationConnectionUpdateOne) SetNillableProvider(v *integrationconnection.Provider) *IntegrationConnectionUpdateOne {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetAccountEmail sets the "account_email" field.
+func (_u *IntegrationConnectionUpdateOne) SetAccountEmail(v string) *IntegrationConnectionUpdateOne {
+	_u.mutation.SetAccountEmail(v)
+	return _u
+}
+
+// SetNillableAccountEmail sets the "account_email" field if the given value is not nil.
+func (_u *IntegrationConnectionUpdateOne) SetNillableAccountEmail(v *string) *IntegrationConnectionUpdateOne {
+	if v != nil {
+		_u.SetAccountEmail(*v)
+	}
+	return _u
+}
+
+// ClearAccountEmail clears the value of the "account_email" field.
+func (_u *IntegrationConnectionUpdateOne) ClearAccountEmail() *IntegrationConnectionUpdateOne {
+	_u.mutation.ClearAccountEmail()
+	return _u
+}
+
+// SetRefreshToken sets the "refresh_token" field.
+func (_u *IntegrationConnectionUpdateOne) SetRefreshToken(v string) *IntegrationConnectionUpdateOne {
+	_u.mutation.SetRefreshToken(v)
+	return _u
+}
+
+// SetNillableRefreshToken sets the "refresh_token" field if the given value is not nil.
+func (_u *IntegrationConnectionUpdateOne) SetNillableRefreshToken(v *string) *IntegrationConnectionUpdateOne {
+	if v != nil {
+		_u.SetRefreshToken(*v)
+	}
+	return _u
+}
+
+// SetScopes sets the "scopes" field.
+func (_u *IntegrationConnectionUpdateOne) SetScopes(v string) *IntegrationConnectionUpdateOne {
+	_u.mutation.SetScopes(v)
+	return _u
+}
+
+// SetNillableScopes sets the "scopes" field if the given value is not nil.
+func (_u *IntegrationConnectionUpdateOne) SetNillableScopes(v *string) *IntegrationConnectionUpdateOne {
+	if v != nil {
+		_u.SetScopes(*v)
+	}
+	return _u
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (_u *IntegrationConnectionUpdateOne) ClearScopes() *IntegrationConnectionUpdateOne {
+	_u.mutation.ClearScopes()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *IntegrationConnectionUpdateOne) SetUpdatedAt(v time.Time) *IntegrationConnectionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *IntegrationConnectionUpdateOne) SetUser(v *User) *IntegrationConnectionUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the IntegrationConnectionMutation object of the builder.
+func (_u *IntegrationConnectionUpdateOne) Mutation() *IntegrationConnectionMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *IntegrationConnectionUpdateOne) ClearUser() *IntegrationConnectionUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the IntegrationConnectionUpdate builder.
+func (_u *IntegrationConnectionUpdateOne) Where(ps ...predicate.IntegrationConnection) *IntegrationConnectionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *IntegrationConnectionUpdateOne) Select(field string, fields ...string) *IntegrationConnectionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated IntegrationConnection entity.
+func (_u *IntegrationConnectionUpdateOne) Save(ctx context.Context) (*IntegrationConnection, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *IntegrationConnectionUpdateOne) SaveX(ctx context.Context) *IntegrationConnection {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *IntegrationConnectionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *IntegrationConnectionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *IntegrationConnectionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := integrationconnection.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *IntegrationConnectionUpdateOne) check() error {
+	if v, ok := _u.mutation.Provider(); ok {
+		if err := integrationconnection.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "IntegrationConnection.provider": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "IntegrationConnection.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *IntegrationConnectionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *IntegrationConnectionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *IntegrationConnectionUpdateOne) sqlSave(ctx context.Context) (_node *IntegrationConnection, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(integrationconnection.Table, integrationconnection.Columns, sqlgraph.NewFieldSpec(integrationconnection.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "IntegrationConnection.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, integrationconnection.FieldID)
+		for _, f := range fields {
+			if !integrationconnection.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != integrationconnection.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(integrationconnection.FieldProvider, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.AccountEmail(); ok {
+		_spec.SetField(integrationconnection.FieldAccountEmail, field.TypeString, value)
+	}
+	if _u.mutation.AccountEmailCleared() {
+		_spec.ClearField(integrationconnection.FieldAccountEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.RefreshToken(); ok {
+		_spec.SetField(integrationconnection.FieldRefreshToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Scopes(); ok {
+		_spec.SetField(integrationconnection.FieldScopes, field.TypeString, value)
+	}
+	if _u.mutation.ScopesCleared() {
+		_spec.ClearField(integrationconnection.FieldScopes, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(integrationconnection.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   integrationconnection.UserTable,
+			Columns: []string{integrationconnection.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   integrationconnection.UserTable,
+			Columns: []string{integrationconnection.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &IntegrationConnection{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{integrationconnection.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/organization"
 	"github.com/jordanlanch/industrydb/ent/territory"
 )
 
@@ -45,18 +46,36 @@ type Lead struct {
 	Longitude float64 `json:"longitude,omitempty"`
 	// Whether the lead has been verified
 	Verified bool `json:"verified,omitempty"`
+	// When the lead was last verified (null if not verified)
+	VerifiedSince *time.Time `json:"verified_since,omitempty"`
+	// ID of the user who last verified the lead
+	VerifiedBy *int `json:"verified_by,omitempty"`
 	// Data quality score (0-100)
 	QualityScore int `json:"quality_score,omitempty"`
+	// Share of contact and location fields populated (0-100), see leads.CalculateCompleteness
+	CompletenessScore int `json:"completeness_score,omitempty"`
 	// Lead lifecycle status
 	Status lead.Status `json:"status,omitempty"`
 	// When the status was last changed
 	StatusChangedAt time.Time `json:"status_changed_at,omitempty"`
+	// When the lead exceeded the SLA for its current status (cleared on status change)
+	SLAOverdueSince *time.Time `json:"sla_overdue_since,omitempty"`
 	// User-defined custom fields (flexible metadata storage)
 	CustomFields map[string]interface{} `json:"custom_fields,omitempty"`
+	// User-applied tags for organizing leads (normalized, e.g. [cold-outreach-q1])
+	Tags []string `json:"tags,omitempty"`
+	// Organization that owns this lead when lead scoping is on (nil = global pool)
+	OwnerOrganizationID *int `json:"owner_organization_id,omitempty"`
 	// OpenStreetMap ID
 	OsmID string `json:"osm_id,omitempty"`
 	// Additional metadata from OSM
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// Where the lead was acquired from (existing rows default to osm)
+	Source lead.Source `json:"source,omitempty"`
+	// Identifier of the lead in its source (e.g., OSM node ID)
+	SourceID string `json:"source_id,omitempty"`
+	// When the lead was last confirmed present in its source
+	LastSeenAt *time.Time `json:"last_seen_at,omitempty"`
 	// Sub-category within industry (e.g., italian, crossfit, watercolor)
 	SubNiche string `json:"sub_niche,omitempty"`
 	// Additional specialty tags (e.g., [pasta, seafood, fine_dining])
@@ -100,8 +119,14 @@ type Lead struct {
 type LeadEdges struct {
 	// Notes and comments on this lead
 	Notes []*LeadNote `json:"notes,omitempty"`
+	// Logged outreach attempts on this lead
+	ContactAttempts []*ContactAttempt `json:"contact_attempts,omitempty"`
 	// History of status changes for this lead
 	StatusHistory []*LeadStatusHistory `json:"status_history,omitempty"`
+	// Field-level change history for this lead
+	Changes []*LeadChange `json:"changes,omitempty"`
+	// Enrichment provider calls for this lead
+	EnrichmentAttempts []*EnrichmentAttempt `json:"enrichment_attempts,omitempty"`
 	// Assignment history for this lead
 	Assignments []*LeadAssignment `json:"assignments,omitempty"`
 	// Email sequences this lead is enrolled in
@@ -116,9 +141,15 @@ type LeadEdges struct {
 	CallLogs []*CallLog `json:"call_logs,omitempty"`
 	// Recommendations made for this lead
 	Recommendations []*LeadRecommendation `json:"recommendations,omitempty"`
+	// Verification events for this lead
+	Verifications []*LeadVerification `json:"verifications,omitempty"`
+	// Users and organizations that suppressed this lead
+	Suppressions []*LeadSuppression `json:"suppressions,omitempty"`
+	// Organization that owns this lead
+	OwnerOrganization *Organization `json:"owner_organization,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [9]bool
+	loadedTypes [15]bool
 }
 
 // NotesOrErr returns the Notes value or an error if the edge
@@ -130,19 +161,46 @@ func (e LeadEdges) NotesOrErr() ([]*LeadNote, error) {
 	return nil, &NotLoadedError{edge: "notes"}
 }
 
+// ContactAttemptsOrErr returns the ContactAttempts value or an error if the edge
+// was not loaded in eager-loading.
+func (e LeadEdges) ContactAttemptsOrErr() ([]*ContactAttempt, error) {
+	if e.loadedTypes[1] {
+		return e.ContactAttempts, nil
+	}
+	return nil, &NotLoadedError{edge: "contact_attempts"}
+}
+
 // StatusHistoryOrErr returns the StatusHistory value or an error if the edge
 // was not loaded in eager-loading.
 func (e LeadEdges) StatusHistoryOrErr() ([]*LeadStatusHistory, error) {
-	if e.loadedTypes[1] {
+	if e.loadedTypes[2] {
 		return e.StatusHistory, nil
 	}
 	return nil, &NotLoadedError{edge: "status_history"}
 }
 
+// ChangesOrErr returns the Changes value or an error if the edge
+// was not loaded in eager-loading.
+func (e LeadEdges) ChangesOrErr() ([]*LeadChange, error) {
+	if e.loadedTypes[3] {
+		return e.Changes, nil
+	}
+	return nil, &NotLoadedError{edge: "changes"}
+}
+
+// EnrichmentAttemptsOrErr returns the EnrichmentAttempts value or an error if the edge
+// was not loaded in eager-loading.
+func (e LeadEdges) EnrichmentAttemptsOrErr() ([]*EnrichmentAttempt, error) {
+	if e.loadedTypes[4] {
+		return e.EnrichmentAttempts, nil
+	}
+	return nil, &NotLoadedError{edge: "enrichment_attempts"}
+}
+
 // AssignmentsOrErr returns the Assignments value or an error if the edge
 // was not loaded in eager-loading.
 func (e LeadEdges) AssignmentsOrErr() ([]*LeadAssignment, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[5] {
 		return e.Assignments, nil
 	}
 	return nil, &NotLoadedError{edge: "assignments"}
@@ -151,7 +209,7 @@ func (e LeadEdges) AssignmentsOrErr() ([]*LeadAssignment, error) {
 // EmailSequenceEnrollmentsOrErr returns the EmailSequenceEnrollments value or an error if the edge
 // was not loaded in eager-loading.
 func (e LeadEdges) EmailSequenceEnrollmentsOrErr() ([]*EmailSequenceEnrollment, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[6] {
 		return e.EmailSequenceEnrollments, nil
 	}
 	return nil, &NotLoadedError{edge: "email_sequence_enrollments"}
@@ -160,7 +218,7 @@ func (e LeadEdges) EmailSequenceEnrollmentsOrErr() ([]*EmailSequenceEnrollment,
 // EmailSequenceSendsOrErr returns the EmailSequenceSends value or an error if the edge
 // was not loaded in eager-loading.
 func (e LeadEdges) EmailSequenceSendsOrErr() ([]*EmailSequenceSend, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[7] {
 		return e.EmailSequenceSends, nil
 	}
 	return nil, &NotLoadedError{edge: "email_sequence_sends"}
@@ -171,7 +229,7 @@ func (e LeadEdges) EmailSequenceSendsOrErr() ([]*EmailSequenceSend, error) {
 func (e LeadEdges) TerritoryOrErr() (*Territory, error) {
 	if e.Territory != nil {
 		return e.Territory, nil
-	} else if e.loadedTypes[5] {
+	} else if e.loadedTypes[8] {
 		return nil, &NotFoundError{label: territory.Label}
 	}
 	return nil, &NotLoadedError{edge: "territory"}
@@ -180,7 +238,7 @@ func (e LeadEdges) TerritoryOrErr() (*Territory, error) {
 // SmsMessagesOrErr returns the SmsMessages value or an error if the edge
 // was not loaded in eager-loading.
 func (e LeadEdges) SmsMessagesOrErr() ([]*SMSMessage, error) {
-	if e.loadedTypes[6] {
+	if e.loadedTypes[9] {
 		return e.SmsMessages, nil
 	}
 	return nil, &NotLoadedError{edge: "sms_messages"}
@@ -189,7 +247,7 @@ func (e LeadEdges) SmsMessagesOrErr() ([]*SMSMessage, error) {
 // CallLogsOrErr returns the CallLogs value or an error if the edge
 // was not loaded in eager-loading.
 func (e LeadEdges) CallLogsOrErr() ([]*CallLog, error) {
-	if e.loadedTypes[7] {
+	if e.loadedTypes[10] {
 		return e.CallLogs, nil
 	}
 	return nil, &NotLoadedError{edge: "call_logs"}
@@ -198,28 +256,57 @@ func (e LeadEdges) CallLogsOrErr() ([]*CallLog, error) {
 // RecommendationsOrErr returns the Recommendations value or an error if the edge
 // was not loaded in eager-loading.
 func (e LeadEdges) RecommendationsOrErr() ([]*LeadRecommendation, error) {
-	if e.loadedTypes[8] {
+	if e.loadedTypes[11] {
 		return e.Recommendations, nil
 	}
 	return nil, &NotLoadedError{edge: "recommendations"}
 }
 
+// VerificationsOrErr returns the Verifications value or an error if the edge
+// was not loaded in eager-loading.
+func (e LeadEdges) VerificationsOrErr() ([]*LeadVerification, error) {
+	if e.loadedTypes[12] {
+		return e.Verifications, nil
+	}
+	return nil, &NotLoadedError{edge: "verifications"}
+}
+
+// SuppressionsOrErr returns the Suppressions value or an error if the edge
+// was not loaded in eager-loading.
+func (e LeadEdges) SuppressionsOrErr() ([]*LeadSuppression, error) {
+	if e.loadedTypes[13] {
+		return e.Suppressions, nil
+	}
+	return nil, &NotLoadedError{edge: "suppressions"}
+}
+
+// OwnerOrganizationOrErr returns the OwnerOrganization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LeadEdges) OwnerOrganizationOrErr() (*Organization, error) {
+	if e.OwnerOrganization != nil {
+		return e.OwnerOrganization, nil
+	} else if e.loadedTypes[14] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "owner_organization"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Lead) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case lead.FieldSocialMedia, lead.FieldCustomFields, lead.FieldMetadata, lead.FieldSpecialties:
+		case lead.FieldSocialMedia, lead.FieldCustomFields, lead.FieldTags, lead.FieldMetadata, lead.FieldSpecialties:
 			values[i] = new([]byte)
 		case lead.FieldVerified, lead.FieldIsEnriched, lead.FieldEmailValidated:
 			values[i] = new(sql.NullBool)
 		case lead.FieldLatitude, lead.FieldLongitude:
 			values[i] = new(sql.NullFloat64)
-		case lead.FieldID, lead.FieldQualityScore, lead.FieldEmployeeCount:
+		case lead.FieldID, lead.FieldVerifiedBy, lead.FieldQualityScore, lead.FieldCompletenessScore, lead.FieldOwnerOrganizationID, lead.FieldEmployeeCount:
 			values[i] = new(sql.NullInt64)
-		case lead.FieldName, lead.FieldIndustry, lead.FieldCountry, lead.FieldCity, lead.FieldAddress, lead.FieldPostalCode, lead.FieldPhone, lead.FieldEmail, lead.FieldWebsite, lead.FieldStatus, lead.FieldOsmID, lead.FieldSubNiche, lead.FieldCuisineType, lead.FieldSportType, lead.FieldTattooStyle, lead.FieldCompanyDescription, lead.FieldCompanyRevenue, lead.FieldLinkedinURL, lead.FieldTwitterURL, lead.FieldFacebookURL:
+		case lead.FieldName, lead.FieldIndustry, lead.FieldCountry, lead.FieldCity, lead.FieldAddress, lead.FieldPostalCode, lead.FieldPhone, lead.FieldEmail, lead.FieldWebsite, lead.FieldStatus, lead.FieldOsmID, lead.FieldSource, lead.FieldSourceID, lead.FieldSubNiche, lead.FieldCuisineType, lead.FieldSportType, lead.FieldTattooStyle, lead.FieldCompanyDescription, lead.FieldCompanyRevenue, lead.FieldLinkedinURL, lead.FieldTwitterURL, lead.FieldFacebookURL:
 			values[i] = new(sql.NullString)
-		case lead.FieldStatusChangedAt, lead.FieldEnrichedAt, lead.FieldCreatedAt, lead.FieldUpdatedAt:
+		case lead.FieldVerifiedSince, lead.FieldStatusChangedAt, lead.FieldSLAOverdueSince, lead.FieldLastSeenAt, lead.FieldEnrichedAt, lead.FieldCreatedAt, lead.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case lead.ForeignKeys[0]: // territory_leads
 			values[i] = new(sql.NullInt64)
@@ -324,12 +411,32 @@ func (_m *Lead) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Verified = value.Bool
 			}
+		case lead.FieldVerifiedSince:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field verified_since", values[i])
+			} else if value.Valid {
+				_m.VerifiedSince = new(time.Time)
+				*_m.VerifiedSince = value.Time
+			}
+		case lead.FieldVerifiedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field verified_by", values[i])
+			} else if value.Valid {
+				_m.VerifiedBy = new(int)
+				*_m.VerifiedBy = int(value.Int64)
+			}
 		case lead.FieldQualityScore:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field quality_score", values[i])
 			} else if value.Valid {
 				_m.QualityScore = int(value.Int64)
 			}
+		case lead.FieldCompletenessScore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field completeness_score", values[i])
+			} else if value.Valid {
+				_m.CompletenessScore = int(value.Int64)
+			}
 		case lead.FieldStatus:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
@@ -342,6 +449,13 @@ func (_m *Lead) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.StatusChangedAt = value.Time
 			}
+		case lead.FieldSLAOverdueSince:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field sla_overdue_since", values[i])
+			} else if value.Valid {
+				_m.SLAOverdueSince = new(time.Time)
+				*_m.SLAOverdueSince = value.Time
+			}
 		case lead.FieldCustomFields:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field custom_fields", values[i])
@@ -350,6 +464,21 @@ func (_m *Lead) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field custom_fields: %w", err)
 				}
 			}
+		case lead.FieldTags:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Tags); err != nil {
+					return fmt.Errorf("unmarshal field tags: %w", err)
+				}
+			}
+		case lead.FieldOwnerOrganizationID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_organization_id", values[i])
+			} else if value.Valid {
+				_m.OwnerOrganizationID = new(int)
+				*_m.OwnerOrganizationID = int(value.Int64)
+			}
 		case lead.FieldOsmID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field osm_id", values[i])
@@ -364,6 +493,25 @@ func (_m *Lead) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field metadata: %w", err)
 				}
 			}
+		case lead.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				_m.Source = lead.Source(value.String)
+			}
+		case lead.FieldSourceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_id", values[i])
+			} else if value.Valid {
+				_m.SourceID = value.String
+			}
+		case lead.FieldLastSeenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_seen_at", values[i])
+			} else if value.Valid {
+				_m.LastSeenAt = new(time.Time)
+				*_m.LastSeenAt = value.Time
+			}
 		case lead.FieldSubNiche:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field sub_niche", values[i])
@@ -488,11 +636,26 @@ func (_m *Lead) QueryNotes() *LeadNoteQuery {
 	return NewLeadClient(_m.config).QueryNotes(_m)
 }
 
+// QueryContactAttempts queries the "contact_attempts" edge of the Lead entity.
+func (_m *Lead) QueryContactAttempts() *ContactAttemptQuery {
+	return NewLeadClient(_m.config).QueryContactAttempts(_m)
+}
+
 // QueryStatusHistory queries the "status_history" edge of the Lead entity.
 func (_m *Lead) QueryStatusHistory() *LeadStatusHistoryQuery {
 	return NewLeadClient(_m.config).QueryStatusHistory(_m)
 }
 
+// QueryChanges queries the "changes" edge of the Lead entity.
+func (_m *Lead) QueryChanges() *LeadChangeQuery {
+	return NewLeadClient(_m.config).QueryChanges(_m)
+}
+
+// QueryEnrichmentAttempts queries the "enrichment_attempts" edge of the Lead entity.
+func (_m *Lead) QueryEnrichmentAttempts() *EnrichmentAttemptQuery {
+	return NewLeadClient(_m.config).QueryEnrichmentAttempts(_m)
+}
+
 // QueryAssignments queries the "assignments" edge of the Lead entity.
 func (_m *Lead) QueryAssignments() *LeadAssignmentQuery {
 	return NewLeadClient(_m.config).QueryAssignments(_m)
@@ -528,6 +691,21 @@ func (_m *Lead) QueryRecommendations() *LeadRecommendationQuery {
 	return NewLeadClient(_m.config).QueryRecommendations(_m)
 }
 
+// QueryVerifications queries the "verifications" edge of the Lead entity.
+func (_m *Lead) QueryVerifications() *LeadVerificationQuery {
+	return NewLeadClient(_m.config).QueryVerifications(_m)
+}
+
+// QuerySuppressions queries the "suppressions" edge of the Lead entity.
+func (_m *Lead) QuerySuppressions() *LeadSuppressionQuery {
+	return NewLeadClient(_m.config).QuerySuppressions(_m)
+}
+
+// QueryOwnerOrganization queries the "owner_organization" edge of the Lead entity.
+func (_m *Lead) QueryOwnerOrganization() *OrganizationQuery {
+	return NewLeadClient(_m.config).QueryOwnerOrganization(_m)
+}
+
 // Update returns a builder for updating this Lead.
 // Note that you need to call Lead.Unwrap() before calling this method if this Lead
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -590,24 +768,61 @@ func (_m *Lead) String() string {
 	builder.WriteString("verified=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Verified))
 	builder.WriteString(", ")
+	if v := _m.VerifiedSince; v != nil {
+		builder.WriteString("verified_since=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.VerifiedBy; v != nil {
+		builder.WriteString("verified_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("quality_score=")
 	builder.WriteString(fmt.Sprintf("%v", _m.QualityScore))
 	builder.WriteString(", ")
+	builder.WriteString("completeness_score=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CompletenessScore))
+	builder.WriteString(", ")
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteString(", ")
 	builder.WriteString("status_changed_at=")
 	builder.WriteString(_m.StatusChangedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	if v := _m.SLAOverdueSince; v != nil {
+		builder.WriteString("sla_overdue_since=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("custom_fields=")
 	builder.WriteString(fmt.Sprintf("%v", _m.CustomFields))
 	builder.WriteString(", ")
+	builder.WriteString("tags=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Tags))
+	builder.WriteString(", ")
+	if v := _m.OwnerOrganizationID; v != nil {
+		builder.WriteString("owner_organization_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("osm_id=")
 	builder.WriteString(_m.OsmID)
 	builder.WriteString(", ")
 	builder.WriteString("metadata=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
 	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Source))
+	builder.WriteString(", ")
+	builder.WriteString("source_id=")
+	builder.WriteString(_m.SourceID)
+	builder.WriteString(", ")
+	if v := _m.LastSeenAt; v != nil {
+		builder.WriteString("last_seen_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("sub_niche=")
 	builder.WriteString(_m.SubNiche)
 	builder.WriteString(", ")
@@ -41,18 +41,36 @@ const (
 	FieldLongitude = "longitude"
 	// FieldVerified holds the string denoting the verified field in the database.
 	FieldVerified = "verified"
+	// FieldVerifiedSince holds the string denoting the verified_since field in the database.
+	FieldVerifiedSince = "verified_since"
+	// FieldVerifiedBy holds the string denoting the verified_by field in the database.
+	FieldVerifiedBy = "verified_by"
 	// FieldQualityScore holds the string denoting the quality_score field in the database.
 	FieldQualityScore = "quality_score"
+	// FieldCompletenessScore holds the string denoting the completeness_score field in the database.
+	FieldCompletenessScore = "completeness_score"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
 	// FieldStatusChangedAt holds the string denoting the status_changed_at field in the database.
 	FieldStatusChangedAt = "status_changed_at"
+	// FieldSLAOverdueSince holds the string denoting the sla_overdue_since field in the database.
+	FieldSLAOverdueSince = "sla_overdue_since"
 	// FieldCustomFields holds the string denoting the custom_fields field in the database.
 	FieldCustomFields = "custom_fields"
+	// FieldTags holds the string denoting the tags field in the database.
+	FieldTags = "tags"
+	// FieldOwnerOrganizationID holds the string denoting the owner_organization_id field in the database.
+	FieldOwnerOrganizationID = "owner_organization_id"
 	// FieldOsmID holds the string denoting the osm_id field in the database.
 	FieldOsmID = "osm_id"
 	// FieldMetadata holds the string denoting the metadata field in the database.
 	FieldMetadata = "metadata"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldSourceID holds the string denoting the source_id field in the database.
+	FieldSourceID = "source_id"
+	// FieldLastSeenAt holds the string denoting the last_seen_at field in the database.
+	FieldLastSeenAt = "last_seen_at"
 	// FieldSubNiche holds the string denoting the sub_niche field in the database.
 	FieldSubNiche = "sub_niche"
 	// FieldSpecialties holds the string denoting the specialties field in the database.
@@ -87,8 +105,14 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// EdgeNotes holds the string denoting the notes edge name in mutations.
 	EdgeNotes = "notes"
+	// EdgeContactAttempts holds the string denoting the contact_attempts edge name in mutations.
+	EdgeContactAttempts = "contact_attempts"
 	// EdgeStatusHistory holds the string denoting the status_history edge name in mutations.
 	EdgeStatusHistory = "status_history"
+	// EdgeChanges holds the string denoting the changes edge name in mutations.
+	EdgeChanges = "changes"
+	// EdgeEnrichmentAttempts holds the string denoting the enrichment_attempts edge name in mutations.
+	EdgeEnrichmentAttempts = "enrichment_attempts"
 	// EdgeAssignments holds the string denoting the assignments edge name in mutations.
 	EdgeAssignments = "assignments"
 	// EdgeEmailSequenceEnrollments holds the string denoting the email_sequence_enrollments edge name in mutations.
@@ -103,6 +127,12 @@ const (
 	EdgeCallLogs = "call_logs"
 	// EdgeRecommendations holds the string denoting the recommendations edge name in mutations.
 	EdgeRecommendations = "recommendations"
+	// EdgeVerifications holds the string denoting the verifications edge name in mutations.
+	EdgeVerifications = "verifications"
+	// EdgeSuppressions holds the string denoting the suppressions edge name in mutations.
+	EdgeSuppressions = "suppressions"
+	// EdgeOwnerOrganization holds the string denoting the owner_organization edge name in mutations.
+	EdgeOwnerOrganization = "owner_organization"
 	// Table holds the table name of the lead in the database.
 	Table = "leads"
 	// NotesTable is the table that holds the notes relation/edge.
@@ -112,6 +142,13 @@ const (
 	NotesInverseTable = "lead_notes"
 	// NotesColumn is the table column denoting the notes relation/edge.
 	NotesColumn = "lead_id"
+	// ContactAttemptsTable is the table that holds the contact_attempts relation/edge.
+	ContactAttemptsTable = "contact_attempts"
+	// ContactAttemptsInverseTable is the table name for the ContactAttempt entity.
+	// It exists in this package in order to avoid circular dependency with the "contactattempt" package.
+	ContactAttemptsInverseTable = "contact_attempts"
+	// ContactAttemptsColumn is the table column denoting the contact_attempts relation/edge.
+	ContactAttemptsColumn = "lead_id"
 	// StatusHistoryTable is the table that holds the status_history relation/edge.
 	StatusHistoryTable = "lead_status_histories"
 	// StatusHistoryInverseTable is the table name for the LeadStatusHistory entity.
@@ -119,6 +156,20 @@ const (
 	StatusHistoryInverseTable = "lead_status_histories"
 	// StatusHistoryColumn is the table column denoting the status_history relation/edge.
 	StatusHistoryColumn = "lead_id"
+	// ChangesTable is the table that holds the changes relation/edge.
+	ChangesTable = "lead_changes"
+	// ChangesInverseTable is the table name for the LeadChange entity.
+	// It exists in this package in order to avoid circular dependency with the "leadchange" package.
+	ChangesInverseTable = "lead_changes"
+	// ChangesColumn is the table column denoting the changes relation/edge.
+	ChangesColumn = "lead_id"
+	// EnrichmentAttemptsTable is the table that holds the enrichment_attempts relation/edge.
+	EnrichmentAttemptsTable = "enrichment_attempts"
+	// EnrichmentAttemptsInverseTable is the table name for the EnrichmentAttempt entity.
+	// It exists in this package in order to avoid circular dependency with the "enrichmentattempt" package.
+	EnrichmentAttemptsInverseTable = "enrichment_attempts"
+	// EnrichmentAttemptsColumn is the table column denoting the enrichment_attempts relation/edge.
+	EnrichmentAttemptsColumn = "lead_id"
 	// AssignmentsTable is the table that holds the assignments relation/edge.
 	AssignmentsTable = "lead_assignments"
 	// AssignmentsInverseTable is the table name for the LeadAssignment entity.
@@ -168,6 +219,27 @@ const (
 	RecommendationsInverseTable = "lead_recommendations"
 	// RecommendationsColumn is the table column denoting the recommendations relation/edge.
 	RecommendationsColumn = "lead_id"
+	// VerificationsTable is the table that holds the verifications relation/edge.
+	VerificationsTable = "lead_verifications"
+	// VerificationsInverseTable is the table name for the LeadVerification entity.
+	// It exists in this package in order to avoid circular dependency with the "leadverification" package.
+	VerificationsInverseTable = "lead_verifications"
+	// VerificationsColumn is the table column denoting the verifications relation/edge.
+	VerificationsColumn = "lead_id"
+	// SuppressionsTable is the table that holds the suppressions relation/edge.
+	SuppressionsTable = "lead_suppressions"
+	// SuppressionsInverseTable is the table name for the LeadSuppression entity.
+	// It exists in this package in order to avoid circular dependency with the "leadsuppression" package.
+	SuppressionsInverseTable = "lead_suppressions"
+	// SuppressionsColumn is the table column denoting the suppressions relation/edge.
+	SuppressionsColumn = "lead_id"
+	// OwnerOrganizationTable is the table that holds the owner_organization relation/edge.
+	OwnerOrganizationTable = "leads"
+	// OwnerOrganizationInverseTable is the table name for the Organization entity.
+	// It exists in this package in order to avoid circular dependency with the "organization" package.
+	OwnerOrganizationInverseTable = "organizations"
+	// OwnerOrganizationColumn is the table column denoting the owner_organization relation/edge.
+	OwnerOrganizationColumn = "owner_organization_id"
 )
 
 // Columns holds all SQL columns for lead fields.
@@ -186,12 +258,21 @@ var Columns = []string{
 	FieldLatitude,
 	FieldLongitude,
 	FieldVerified,
+	FieldVerifiedSince,
+	FieldVerifiedBy,
 	FieldQualityScore,
+	FieldCompletenessScore,
 	FieldStatus,
 	FieldStatusChangedAt,
+	FieldSLAOverdueSince,
 	FieldCustomFields,
+	FieldTags,
+	FieldOwnerOrganizationID,
 	FieldOsmID,
 	FieldMetadata,
+	FieldSource,
+	FieldSourceID,
+	FieldLastSeenAt,
 	FieldSubNiche,
 	FieldSpecialties,
 	FieldCuisineType,
@@ -244,6 +325,10 @@ var (
 	DefaultQualityScore int
 	// QualityScoreValidator is a validator for the "quality_score" field. It is called by the builders before save.
 	QualityScoreValidator func(int) error
+	// DefaultCompletenessScore holds the default value on creation for the "completeness_score" field.
+	DefaultCompletenessScore int
+	// CompletenessScoreValidator is a validator for the "completeness_score" field. It is called by the builders before save.
+	CompletenessScoreValidator func(int) error
 	// DefaultStatusChangedAt holds the default value on creation for the "status_changed_at" field.
 	DefaultStatusChangedAt func() time.Time
 	// DefaultIsEnriched holds the default value on creation for the "is_enriched" field.
@@ -400,6 +485,33 @@ func StatusValidator(s Status) error {
 	}
 }
 
+// Source defines the type for the "source" enum field.
+type Source string
+
+// SourceOsm is the default value of the Source enum.
+const DefaultSource = SourceOsm
+
+// Source values.
+const (
+	SourceOsm       Source = "osm"
+	SourceCsvImport Source = "csv_import"
+	SourceManual    Source = "manual"
+)
+
+func (s Source) String() string {
+	return string(s)
+}
+
+// SourceValidator is a validator for the "source" field enum values. It is called by the builders before save.
+func SourceValidator(s Source) error {
+	switch s {
+	case SourceOsm, SourceCsvImport, SourceManual:
+		return nil
+	default:
+		return fmt.Errorf("lead: invalid enum value for source field: %q", s)
+	}
+}
+
 // OrderOption defines the ordering options for the Lead queries.
 type OrderOption func(*sql.Selector)
 
@@ -468,11 +580,26 @@ func ByVerified(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldVerified, opts...).ToFunc()
 }
 
+// ByVerifiedSince orders the results by the verified_since field.
+func ByVerifiedSince(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerifiedSince, opts...).ToFunc()
+}
+
+// ByVerifiedBy orders the results by the verified_by field.
+func ByVerifiedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerifiedBy, opts...).ToFunc()
+}
+
 // ByQualityScore orders the results by the quality_score field.
 func ByQualityScore(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldQualityScore, opts...).ToFunc()
 }
 
+// ByCompletenessScore orders the results by the completeness_score field.
+func ByCompletenessScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompletenessScore, opts...).ToFunc()
+}
+
 // ByStatus orders the results by the status field.
 func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
@@ -483,11 +610,36 @@ func ByStatusChangedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatusChangedAt, opts...).ToFunc()
 }
 
+// BySLAOverdueSince orders the results by the sla_overdue_since field.
+func BySLAOverdueSince(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSLAOverdueSince, opts...).ToFunc()
+}
+
+// ByOwnerOrganizationID orders the results by the owner_organization_id field.
+func ByOwnerOrganizationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwnerOrganizationID, opts...).ToFunc()
+}
+
 // ByOsmID orders the results by the osm_id field.
 func ByOsmID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldOsmID, opts...).ToFunc()
 }
 
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// BySourceID orders the results by the source_id field.
+func BySourceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceID, opts...).ToFunc()
+}
+
+// ByLastSeenAt orders the results by the last_seen_at field.
+func ByLastSeenAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSeenAt, opts...).ToFunc()
+}
+
 // BySubNiche orders the results by the sub_niche field.
 func BySubNiche(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSubNiche, opts...).ToFunc()
@@ -577,6 +729,20 @@ func ByNotes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByContactAttemptsCount orders the results by contact_attempts count.
+func ByContactAttemptsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newContactAttemptsStep(), opts...)
+	}
+}
+
+// ByContactAttempts orders the results by contact_attempts terms.
+func ByContactAttempts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newContactAttemptsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByStatusHistoryCount orders the results by status_history count.
 func ByStatusHistoryCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -591,6 +757,34 @@ func ByStatusHistory(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByChangesCount orders the results by changes count.
+func ByChangesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChangesStep(), opts...)
+	}
+}
+
+// ByChanges orders the results by changes terms.
+func ByChanges(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChangesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByEnrichmentAttemptsCount orders the results by enrichment_attempts count.
+func ByEnrichmentAttemptsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEnrichmentAttemptsStep(), opts...)
+	}
+}
+
+// ByEnrichmentAttempts orders the results by enrichment_attempts terms.
+func ByEnrichmentAttempts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEnrichmentAttemptsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByAssignmentsCount orders the results by assignments count.
 func ByAssignmentsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -681,6 +875,41 @@ func ByRecommendations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newRecommendationsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByVerificationsCount orders the results by verifications count.
+func ByVerificationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newVerificationsStep(), opts...)
+	}
+}
+
+// ByVerifications orders the results by verifications terms.
+func ByVerifications(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVerificationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySuppressionsCount orders the results by suppressions count.
+func BySuppressionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSuppressionsStep(), opts...)
+	}
+}
+
+// BySuppressions orders the results by suppressions terms.
+func BySuppressions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSuppressionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByOwnerOrganizationField orders the results by owner_organization field.
+func ByOwnerOrganizationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOwnerOrganizationStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newNotesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -688,6 +917,13 @@ func newNotesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, NotesTable, NotesColumn),
 	)
 }
+func newContactAttemptsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ContactAttemptsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ContactAttemptsTable, ContactAttemptsColumn),
+	)
+}
 func newStatusHistoryStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -695,6 +931,20 @@ func newStatusHistoryStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, StatusHistoryTable, StatusHistoryColumn),
 	)
 }
+func newChangesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ChangesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChangesTable, ChangesColumn),
+	)
+}
+func newEnrichmentAttemptsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EnrichmentAttemptsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, EnrichmentAttemptsTable, EnrichmentAttemptsColumn),
+	)
+}
 func newAssignmentsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -744,3 +994,24 @@ func newRecommendationsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, RecommendationsTable, RecommendationsColumn),
 	)
 }
+func newVerificationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(VerificationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, VerificationsTable, VerificationsColumn),
+	)
+}
+func newSuppressionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SuppressionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SuppressionsTable, SuppressionsColumn),
+	)
+}
+func newOwnerOrganizationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OwnerOrganizationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, OwnerOrganizationTable, OwnerOrganizationColumn),
+	)
+}
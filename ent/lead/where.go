@@ -110,21 +110,56 @@ func Verified(v bool) predicate.Lead {
 	return predicate.Lead(sql.FieldEQ(FieldVerified, v))
 }
 
+// VerifiedSince applies equality check predicate on the "verified_since" field. It's identical to VerifiedSinceEQ.
+func VerifiedSince(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldVerifiedSince, v))
+}
+
+// VerifiedBy applies equality check predicate on the "verified_by" field. It's identical to VerifiedByEQ.
+func VerifiedBy(v int) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldVerifiedBy, v))
+}
+
 // QualityScore applies equality check predicate on the "quality_score" field. It's identical to QualityScoreEQ.
 func QualityScore(v int) predicate.Lead {
 	return predicate.Lead(sql.FieldEQ(FieldQualityScore, v))
 }
 
+// CompletenessScore applies equality check predicate on the "completeness_score" field. It's identical to CompletenessScoreEQ.
+func CompletenessScore(v int) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldCompletenessScore, v))
+}
+
 // StatusChangedAt applies equality check predicate on the "status_changed_at" field. It's identical to StatusChangedAtEQ.
 func StatusChangedAt(v time.Time) predicate.Lead {
 	return predicate.Lead(sql.FieldEQ(FieldStatusChangedAt, v))
 }
 
+// SLAOverdueSince applies equality check predicate on the "sla_overdue_since" field. It's identical to SLAOverdueSinceEQ.
+func SLAOverdueSince(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldSLAOverdueSince, v))
+}
+
+// OwnerOrganizationID applies equality check predicate on the "owner_organization_id" field. It's identical to OwnerOrganizationIDEQ.
+func OwnerOrganizationID(v int) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldOwnerOrganizationID, v))
+}
+
 // OsmID applies equality check predicate on the "osm_id" field. It's identical to OsmIDEQ.
 func OsmID(v string) predicate.Lead {
 	return predicate.Lead(sql.FieldEQ(FieldOsmID, v))
 }
 
+// SourceID applies equality check predicate on the "source_id" field. It's identical to SourceIDEQ.
+func SourceID(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldSourceID, v))
+}
+
+// LastSeenAt applies equality check predicate on the "last_seen_at" field. It's identical to LastSeenAtEQ.
+func LastSeenAt(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
 // SubNiche applies equality check predicate on the "sub_niche" field. It's identical to SubNicheEQ.
 func SubNiche(v string) predicate.Lead {
 	return predicate.Lead(sql.FieldEQ(FieldSubNiche, v))
@@ -910,6 +945,106 @@ func VerifiedNEQ(v bool) predicate.Lead {
 	return predicate.Lead(sql.FieldNEQ(FieldVerified, v))
 }
 
+// VerifiedSinceEQ applies the EQ predicate on the "verified_since" field.
+func VerifiedSinceEQ(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldVerifiedSince, v))
+}
+
+// VerifiedSinceNEQ applies the NEQ predicate on the "verified_since" field.
+func VerifiedSinceNEQ(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldNEQ(FieldVerifiedSince, v))
+}
+
+// VerifiedSinceIn applies the In predicate on the "verified_since" field.
+func VerifiedSinceIn(vs ...time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldIn(FieldVerifiedSince, vs...))
+}
+
+// VerifiedSinceNotIn applies the NotIn predicate on the "verified_since" field.
+func VerifiedSinceNotIn(vs ...time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldNotIn(FieldVerifiedSince, vs...))
+}
+
+// VerifiedSinceGT applies the GT predicate on the "verified_since" field.
+func VerifiedSinceGT(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldGT(FieldVerifiedSince, v))
+}
+
+// VerifiedSinceGTE applies the GTE predicate on the "verified_since" field.
+func VerifiedSinceGTE(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldGTE(FieldVerifiedSince, v))
+}
+
+// VerifiedSinceLT applies the LT predicate on the "verified_since" field.
+func VerifiedSinceLT(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldLT(FieldVerifiedSince, v))
+}
+
+// VerifiedSinceLTE applies the LTE predicate on the "verified_since" field.
+func VerifiedSinceLTE(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldLTE(FieldVerifiedSince, v))
+}
+
+// VerifiedSinceIsNil applies the IsNil predicate on the "verified_since" field.
+func VerifiedSinceIsNil() predicate.Lead {
+	return predicate.Lead(sql.FieldIsNull(FieldVerifiedSince))
+}
+
+// VerifiedSinceNotNil applies the NotNil predicate on the "verified_since" field.
+func VerifiedSinceNotNil() predicate.Lead {
+	return predicate.Lead(sql.FieldNotNull(FieldVerifiedSince))
+}
+
+// VerifiedByEQ applies the EQ predicate on the "verified_by" field.
+func VerifiedByEQ(v int) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldVerifiedBy, v))
+}
+
+// VerifiedByNEQ applies the NEQ predicate on the "verified_by" field.
+func VerifiedByNEQ(v int) predicate.Lead {
+	return predicate.Lead(sql.FieldNEQ(FieldVerifiedBy, v))
+}
+
+// VerifiedByIn applies the In predicate on the "verified_by" field.
+func VerifiedByIn(vs ...int) predicate.Lead {
+	return predicate.Lead(sql.FieldIn(FieldVerifiedBy, vs...))
+}
+
+// VerifiedByNotIn applies the NotIn predicate on the "verified_by" field.
+func VerifiedByNotIn(vs ...int) predicate.Lead {
+	return predicate.Lead(sql.FieldNotIn(FieldVerifiedBy, vs...))
+}
+
+// VerifiedByGT applies the GT predicate on the "verified_by" field.
+func VerifiedByGT(v int) predicate.Lead {
+	return predicate.Lead(sql.FieldGT(FieldVerifiedBy, v))
+}
+
+// VerifiedByGTE applies the GTE predicate on the "verified_by" field.
+func VerifiedByGTE(v int) predicate.Lead {
+	return predicate.Lead(sql.FieldGTE(FieldVerifiedBy, v))
+}
+
+// VerifiedByLT applies the LT predicate on the "verified_by" field.
+func VerifiedByLT(v int) predicate.Lead {
+	return predicate.Lead(sql.FieldLT(FieldVerifiedBy, v))
+}
+
+// VerifiedByLTE applies the LTE predicate on the "verified_by" field.
+func VerifiedByLTE(v int) predicate.Lead {
+	return predicate.Lead(sql.FieldLTE(FieldVerifiedBy, v))
+}
+
+// VerifiedByIsNil applies the IsNil predicate on the "verified_by" field.
+func VerifiedByIsNil() predicate.Lead {
+	return predicate.Lead(sql.FieldIsNull(FieldVerifiedBy))
+}
+
+// VerifiedByNotNil applies the NotNil predicate on the "verified_by" field.
+func VerifiedByNotNil() predicate.Lead {
+	return predicate.Lead(sql.FieldNotNull(FieldVerifiedBy))
+}
+
 // QualityScoreEQ applies the EQ predicate on the "quality_score" field.
 func QualityScoreEQ(v int) predicate.Lead {
 	return predicate.Lead(sql.FieldEQ(FieldQualityScore, v))
@@ -950,6 +1085,46 @@ func QualityScoreLTE(v int) predicate.Lead {
 	return predicate.Lead(sql.FieldLTE(FieldQualityScore, v))
 }
 
+// CompletenessScoreEQ applies the EQ predicate on the "completeness_score" field.
+func CompletenessScoreEQ(v int) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldCompletenessScore, v))
+}
+
+// CompletenessScoreNEQ applies the NEQ predicate on the "completeness_score" field.
+func CompletenessScoreNEQ(v int) predicate.Lead {
+	return predicate.Lead(sql.FieldNEQ(FieldCompletenessScore, v))
+}
+
+// CompletenessScoreIn applies the In predicate on the "completeness_score" field.
+func CompletenessScoreIn(vs ...int) predicate.Lead {
+	return predicate.Lead(sql.FieldIn(FieldCompletenessScore, vs...))
+}
+
+// CompletenessScoreNotIn applies the NotIn predicate on the "completeness_score" field.
+func CompletenessScoreNotIn(vs ...int) predicate.Lead {
+	return predicate.Lead(sql.FieldNotIn(FieldCompletenessScore, vs...))
+}
+
+// CompletenessScoreGT applies the GT predicate on the "completeness_score" field.
+func CompletenessScoreGT(v int) predicate.Lead {
+	return predicate.Lead(sql.FieldGT(FieldCompletenessScore, v))
+}
+
+// CompletenessScoreGTE applies the GTE predicate on the "completeness_score" field.
+func CompletenessScoreGTE(v int) predicate.Lead {
+	return predicate.Lead(sql.FieldGTE(FieldCompletenessScore, v))
+}
+
+// CompletenessScoreLT applies the LT predicate on the "completeness_score" field.
+func CompletenessScoreLT(v int) predicate.Lead {
+	return predicate.Lead(sql.FieldLT(FieldCompletenessScore, v))
+}
+
+// CompletenessScoreLTE applies the LTE predicate on the "completeness_score" field.
+func CompletenessScoreLTE(v int) predicate.Lead {
+	return predicate.Lead(sql.FieldLTE(FieldCompletenessScore, v))
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
 func StatusEQ(v Status) predicate.Lead {
 	return predicate.Lead(sql.FieldEQ(FieldStatus, v))
@@ -1010,6 +1185,56 @@ func StatusChangedAtLTE(v time.Time) predicate.Lead {
 	return predicate.Lead(sql.FieldLTE(FieldStatusChangedAt, v))
 }
 
+// SLAOverdueSinceEQ applies the EQ predicate on the "sla_overdue_since" field.
+func SLAOverdueSinceEQ(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldSLAOverdueSince, v))
+}
+
+// SLAOverdueSinceNEQ applies the NEQ predicate on the "sla_overdue_since" field.
+func SLAOverdueSinceNEQ(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldNEQ(FieldSLAOverdueSince, v))
+}
+
+// SLAOverdueSinceIn applies the In predicate on the "sla_overdue_since" field.
+func SLAOverdueSinceIn(vs ...time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldIn(FieldSLAOverdueSince, vs...))
+}
+
+// SLAOverdueSinceNotIn applies the NotIn predicate on the "sla_overdue_since" field.
+func SLAOverdueSinceNotIn(vs ...time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldNotIn(FieldSLAOverdueSince, vs...))
+}
+
+// SLAOverdueSinceGT applies the GT predicate on the "sla_overdue_since" field.
+func SLAOverdueSinceGT(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldGT(FieldSLAOverdueSince, v))
+}
+
+// SLAOverdueSinceGTE applies the GTE predicate on the "sla_overdue_since" field.
+func SLAOverdueSinceGTE(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldGTE(FieldSLAOverdueSince, v))
+}
+
+// SLAOverdueSinceLT applies the LT predicate on the "sla_overdue_since" field.
+func SLAOverdueSinceLT(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldLT(FieldSLAOverdueSince, v))
+}
+
+// SLAOverdueSinceLTE applies the LTE predicate on the "sla_overdue_since" field.
+func SLAOverdueSinceLTE(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldLTE(FieldSLAOverdueSince, v))
+}
+
+// SLAOverdueSinceIsNil applies the IsNil predicate on the "sla_overdue_since" field.
+func SLAOverdueSinceIsNil() predicate.Lead {
+	return predicate.Lead(sql.FieldIsNull(FieldSLAOverdueSince))
+}
+
+// SLAOverdueSinceNotNil applies the NotNil predicate on the "sla_overdue_since" field.
+func SLAOverdueSinceNotNil() predicate.Lead {
+	return predicate.Lead(sql.FieldNotNull(FieldSLAOverdueSince))
+}
+
 // CustomFieldsIsNil applies the IsNil predicate on the "custom_fields" field.
 func CustomFieldsIsNil() predicate.Lead {
 	return predicate.Lead(sql.FieldIsNull(FieldCustomFields))
@@ -1020,6 +1245,46 @@ func CustomFieldsNotNil() predicate.Lead {
 	return predicate.Lead(sql.FieldNotNull(FieldCustomFields))
 }
 
+// TagsIsNil applies the IsNil predicate on the "tags" field.
+func TagsIsNil() predicate.Lead {
+	return predicate.Lead(sql.FieldIsNull(FieldTags))
+}
+
+// TagsNotNil applies the NotNil predicate on the "tags" field.
+func TagsNotNil() predicate.Lead {
+	return predicate.Lead(sql.FieldNotNull(FieldTags))
+}
+
+// OwnerOrganizationIDEQ applies the EQ predicate on the "owner_organization_id" field.
+func OwnerOrganizationIDEQ(v int) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldOwnerOrganizationID, v))
+}
+
+// OwnerOrganizationIDNEQ applies the NEQ predicate on the "owner_organization_id" field.
+func OwnerOrganizationIDNEQ(v int) predicate.Lead {
+	return predicate.Lead(sql.FieldNEQ(FieldOwnerOrganizationID, v))
+}
+
+// OwnerOrganizationIDIn applies the In predicate on the "owner_organization_id" field.
+func OwnerOrganizationIDIn(vs ...int) predicate.Lead {
+	return predicate.Lead(sql.FieldIn(FieldOwnerOrganizationID, vs...))
+}
+
+// OwnerOrganizationIDNotIn applies the NotIn predicate on the "owner_organization_id" field.
+func OwnerOrganizationIDNotIn(vs ...int) predicate.Lead {
+	return predicate.Lead(sql.FieldNotIn(FieldOwnerOrganizationID, vs...))
+}
+
+// OwnerOrganizationIDIsNil applies the IsNil predicate on the "owner_organization_id" field.
+func OwnerOrganizationIDIsNil() predicate.Lead {
+	return predicate.Lead(sql.FieldIsNull(FieldOwnerOrganizationID))
+}
+
+// OwnerOrganizationIDNotNil applies the NotNil predicate on the "owner_organization_id" field.
+func OwnerOrganizationIDNotNil() predicate.Lead {
+	return predicate.Lead(sql.FieldNotNull(FieldOwnerOrganizationID))
+}
+
 // OsmIDEQ applies the EQ predicate on the "osm_id" field.
 func OsmIDEQ(v string) predicate.Lead {
 	return predicate.Lead(sql.FieldEQ(FieldOsmID, v))
@@ -1105,6 +1370,151 @@ func MetadataNotNil() predicate.Lead {
 	return predicate.Lead(sql.FieldNotNull(FieldMetadata))
 }
 
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v Source) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v Source) predicate.Lead {
+	return predicate.Lead(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...Source) predicate.Lead {
+	return predicate.Lead(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...Source) predicate.Lead {
+	return predicate.Lead(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceIDEQ applies the EQ predicate on the "source_id" field.
+func SourceIDEQ(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldSourceID, v))
+}
+
+// SourceIDNEQ applies the NEQ predicate on the "source_id" field.
+func SourceIDNEQ(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldNEQ(FieldSourceID, v))
+}
+
+// SourceIDIn applies the In predicate on the "source_id" field.
+func SourceIDIn(vs ...string) predicate.Lead {
+	return predicate.Lead(sql.FieldIn(FieldSourceID, vs...))
+}
+
+// SourceIDNotIn applies the NotIn predicate on the "source_id" field.
+func SourceIDNotIn(vs ...string) predicate.Lead {
+	return predicate.Lead(sql.FieldNotIn(FieldSourceID, vs...))
+}
+
+// SourceIDGT applies the GT predicate on the "source_id" field.
+func SourceIDGT(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldGT(FieldSourceID, v))
+}
+
+// SourceIDGTE applies the GTE predicate on the "source_id" field.
+func SourceIDGTE(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldGTE(FieldSourceID, v))
+}
+
+// SourceIDLT applies the LT predicate on the "source_id" field.
+func SourceIDLT(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldLT(FieldSourceID, v))
+}
+
+// SourceIDLTE applies the LTE predicate on the "source_id" field.
+func SourceIDLTE(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldLTE(FieldSourceID, v))
+}
+
+// SourceIDContains applies the Contains predicate on the "source_id" field.
+func SourceIDContains(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldContains(FieldSourceID, v))
+}
+
+// SourceIDHasPrefix applies the HasPrefix predicate on the "source_id" field.
+func SourceIDHasPrefix(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldHasPrefix(FieldSourceID, v))
+}
+
+// SourceIDHasSuffix applies the HasSuffix predicate on the "source_id" field.
+func SourceIDHasSuffix(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldHasSuffix(FieldSourceID, v))
+}
+
+// SourceIDIsNil applies the IsNil predicate on the "source_id" field.
+func SourceIDIsNil() predicate.Lead {
+	return predicate.Lead(sql.FieldIsNull(FieldSourceID))
+}
+
+// SourceIDNotNil applies the NotNil predicate on the "source_id" field.
+func SourceIDNotNil() predicate.Lead {
+	return predicate.Lead(sql.FieldNotNull(FieldSourceID))
+}
+
+// SourceIDEqualFold applies the EqualFold predicate on the "source_id" field.
+func SourceIDEqualFold(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldEqualFold(FieldSourceID, v))
+}
+
+// SourceIDContainsFold applies the ContainsFold predicate on the "source_id" field.
+func SourceIDContainsFold(v string) predicate.Lead {
+	return predicate.Lead(sql.FieldContainsFold(FieldSourceID, v))
+}
+
+// LastSeenAtEQ applies the EQ predicate on the "last_seen_at" field.
+func LastSeenAtEQ(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtNEQ applies the NEQ predicate on the "last_seen_at" field.
+func LastSeenAtNEQ(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldNEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtIn applies the In predicate on the "last_seen_at" field.
+func LastSeenAtIn(vs ...time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtNotIn applies the NotIn predicate on the "last_seen_at" field.
+func LastSeenAtNotIn(vs ...time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldNotIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtGT applies the GT predicate on the "last_seen_at" field.
+func LastSeenAtGT(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldGT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtGTE applies the GTE predicate on the "last_seen_at" field.
+func LastSeenAtGTE(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldGTE(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLT applies the LT predicate on the "last_seen_at" field.
+func LastSeenAtLT(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldLT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLTE applies the LTE predicate on the "last_seen_at" field.
+func LastSeenAtLTE(v time.Time) predicate.Lead {
+	return predicate.Lead(sql.FieldLTE(FieldLastSeenAt, v))
+}
+
+// LastSeenAtIsNil applies the IsNil predicate on the "last_seen_at" field.
+func LastSeenAtIsNil() predicate.Lead {
+	return predicate.Lead(sql.FieldIsNull(FieldLastSeenAt))
+}
+
+// LastSeenAtNotNil applies the NotNil predicate on the "last_seen_at" field.
+func LastSeenAtNotNil() predicate.Lead {
+	return predicate.Lead(sql.FieldNotNull(FieldLastSeenAt))
+}
+
 // SubNicheEQ applies the EQ predicate on the "sub_niche" field.
 func SubNicheEQ(v string) predicate.Lead {
 	return predicate.Lead(sql.FieldEQ(FieldSubNiche, v))
@@ -2013,6 +2423,29 @@ func HasNotesWith(preds ...predicate.LeadNote) predicate.Lead {
 	})
 }
 
+// HasContactAttempts applies the HasEdge predicate on the "contact_attempts" edge.
+func HasContactAttempts() predicate.Lead {
+	return predicate.Lead(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ContactAttemptsTable, ContactAttemptsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasContactAttemptsWith applies the HasEdge predicate on the "contact_attempts" edge with a given conditions (other predicates).
+func HasContactAttemptsWith(preds ...predicate.ContactAttempt) predicate.Lead {
+	return predicate.Lead(func(s *sql.Selector) {
+		step := newContactAttemptsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasStatusHistory applies the HasEdge predicate on the "status_history" edge.
 func HasStatusHistory() predicate.Lead {
 	return predicate.Lead(func(s *sql.Selector) {
@@ -2036,6 +2469,52 @@ func HasStatusHistoryWith(preds ...predicate.LeadStatusHistory) predicate.Lead {
 	})
 }
 
+// HasChanges applies the HasEdge predicate on the "changes" edge.
+func HasChanges() predicate.Lead {
+	return predicate.Lead(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChangesTable, ChangesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChangesWith applies the HasEdge predicate on the "changes" edge with a given conditions (other predicates).
+func HasChangesWith(preds ...predicate.LeadChange) predicate.Lead {
+	return predicate.Lead(func(s *sql.Selector) {
+		step := newChangesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEnrichmentAttempts applies the HasEdge predicate on the "enrichment_attempts" edge.
+func HasEnrichmentAttempts() predicate.Lead {
+	return predicate.Lead(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EnrichmentAttemptsTable, EnrichmentAttemptsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEnrichmentAttemptsWith applies the HasEdge predicate on the "enrichment_attempts" edge with a given conditions (other predicates).
+func HasEnrichmentAttemptsWith(preds ...predicate.EnrichmentAttempt) predicate.Lead {
+	return predicate.Lead(func(s *sql.Selector) {
+		step := newEnrichmentAttemptsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasAssignments applies the HasEdge predicate on the "assignments" edge.
 func HasAssignments() predicate.Lead {
 	return predicate.Lead(func(s *sql.Selector) {
@@ -2197,6 +2676,75 @@ func HasRecommendationsWith(preds ...predicate.LeadRecommendation) predicate.Lea
 	})
 }
 
+// HasVerifications applies the HasEdge predicate on the "verifications" edge.
+func HasVerifications() predicate.Lead {
+	return predicate.Lead(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, VerificationsTable, VerificationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVerificationsWith applies the HasEdge predicate on the "verifications" edge with a given conditions (other predicates).
+func HasVerificationsWith(preds ...predicate.LeadVerification) predicate.Lead {
+	return predicate.Lead(func(s *sql.Selector) {
+		step := newVerificationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSuppressions applies the HasEdge predicate on the "suppressions" edge.
+func HasSuppressions() predicate.Lead {
+	return predicate.Lead(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SuppressionsTable, SuppressionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSuppressionsWith applies the HasEdge predicate on the "suppressions" edge with a given conditions (other predicates).
+func HasSuppressionsWith(preds ...predicate.LeadSuppression) predicate.Lead {
+	return predicate.Lead(func(s *sql.Selector) {
+		step := newSuppressionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOwnerOrganization applies the HasEdge predicate on the "owner_organization" edge.
+func HasOwnerOrganization() predicate.Lead {
+	return predicate.Lead(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OwnerOrganizationTable, OwnerOrganizationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOwnerOrganizationWith applies the HasEdge predicate on the "owner_organization" edge with a given conditions (other predicates).
+func HasOwnerOrganizationWith(preds ...predicate.Organization) predicate.Lead {
+	return predicate.Lead(func(s *sql.Selector) {
+		step := newOwnerOrganizationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Lead) predicate.Lead {
 	return predicate.Lead(sql.AndPredicates(predicates...))
@@ -11,13 +11,19 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/jordanlanch/industrydb/ent/calllog"
+	"github.com/jordanlanch/industrydb/ent/contactattempt"
 	"github.com/jordanlanch/industrydb/ent/emailsequenceenrollment"
 	"github.com/jordanlanch/industrydb/ent/emailsequencesend"
+	"github.com/jordanlanch/industrydb/ent/enrichmentattempt"
 	"github.com/jordanlanch/industrydb/ent/lead"
 	"github.com/jordanlanch/industrydb/ent/leadassignment"
+	"github.com/jordanlanch/industrydb/ent/leadchange"
 	"github.com/jordanlanch/industrydb/ent/leadnote"
 	"github.com/jordanlanch/industrydb/ent/leadrecommendation"
 	"github.com/jordanlanch/industrydb/ent/leadstatushistory"
+	"github.com/jordanlanch/industrydb/ent/leadsuppression"
+	"github.com/jordanlanch/industrydb/ent/leadverification"
+	"github.com/jordanlanch/industrydb/ent/organization"
 	"github.com/jordanlanch/industrydb/ent/smsmessage"
 	"github.com/jordanlanch/industrydb/ent/territory"
 )
@@ -171,6 +177,34 @@ func (_c *LeadCreate) SetNillableVerified(v *bool) *LeadCreate {
 	return _c
 }
 
+// SetVerifiedSince sets the "verified_since" field.
+func (_c *LeadCreate) SetVerifiedSince(v time.Time) *LeadCreate {
+	_c.mutation.SetVerifiedSince(v)
+	return _c
+}
+
+// SetNillableVerifiedSince sets the "verified_since" field if the given value is not nil.
+func (_c *LeadCreate) SetNillableVerifiedSince(v *time.Time) *LeadCreate {
+	if v != nil {
+		_c.SetVerifiedSince(*v)
+	}
+	return _c
+}
+
+// SetVerifiedBy sets the "verified_by" field.
+func (_c *LeadCreate) SetVerifiedBy(v int) *LeadCreate {
+	_c.mutation.SetVerifiedBy(v)
+	return _c
+}
+
+// SetNillableVerifiedBy sets the "verified_by" field if the given value is not nil.
+func (_c *LeadCreate) SetNillableVerifiedBy(v *int) *LeadCreate {
+	if v != nil {
+		_c.SetVerifiedBy(*v)
+	}
+	return _c
+}
+
 // SetQualityScore sets the "quality_score" field.
 func (_c *LeadCreate) SetQualityScore(v int) *LeadCreate {
 	_c.mutation.SetQualityScore(v)
@@ -185,6 +219,20 @@ func (_c *LeadCreate) SetNillableQualityScore(v *int) *LeadCreate {
 	return _c
 }
 
+// SetCompletenessScore sets the "completeness_score" field.
+func (_c *LeadCreate) SetCompletenessScore(v int) *LeadCreate {
+	_c.mutation.SetCompletenessScore(v)
+	return _c
+}
+
+// SetNillableCompletenessScore sets the "completeness_score" field if the given value is not nil.
+func (_c *LeadCreate) SetNillableCompletenessScore(v *int) *LeadCreate {
+	if v != nil {
+		_c.SetCompletenessScore(*v)
+	}
+	return _c
+}
+
 // SetStatus sets the "status" field.
 func (_c *LeadCreate) SetStatus(v lead.Status) *LeadCreate {
 	_c.mutation.SetStatus(v)
@@ -213,12 +261,46 @@ func (_c *LeadCreate) SetNillableStatusChangedAt(v *time.Time) *LeadCreate {
 	return _c
 }
 
+// SetSLAOverdueSince sets the "sla_overdue_since" field.
+func (_c *LeadCreate) SetSLAOverdueSince(v time.Time) *LeadCreate {
+	_c.mutation.SetSLAOverdueSince(v)
+	return _c
+}
+
+// SetNillableSLAOverdueSince sets the "sla_overdue_since" field if the given value is not nil.
+func (_c *LeadCreate) SetNillableSLAOverdueSince(v *time.Time) *LeadCreate {
+	if v != nil {
+		_c.SetSLAOverdueSince(*v)
+	}
+	return _c
+}
+
 // SetCustomFields sets the "custom_fields" field.
 func (_c *LeadCreate) SetCustomFields(v map[string]interface{}) *LeadCreate {
 	_c.mutation.SetCustomFields(v)
 	return _c
 }
 
+// SetTags sets the "tags" field.
+func (_c *LeadCreate) SetTags(v []string) *LeadCreate {
+	_c.mutation.SetTags(v)
+	return _c
+}
+
+// SetOwnerOrganizationID sets the "owner_organization_id" field.
+func (_c *LeadCreate) SetOwnerOrganizationID(v int) *LeadCreate {
+	_c.mutation.SetOwnerOrganizationID(v)
+	return _c
+}
+
+// SetNillableOwnerOrganizationID sets the "owner_organization_id" field if the given value is not nil.
+func (_c *LeadCreate) SetNillableOwnerOrganizationID(v *int) *LeadCreate {
+	if v != nil {
+		_c.SetOwnerOrganizationID(*v)
+	}
+	return _c
+}
+
 // SetOsmID sets the "osm_id" field.
 func (_c *LeadCreate) SetOsmID(v string) *LeadCreate {
 	_c.mutation.SetOsmID(v)
@@ -239,6 +321,48 @@ func (_c *LeadCreate) SetMetadata(v map[string]interface{}) *LeadCreate {
 	return _c
 }
 
+// SetSource sets the "source" field.
+func (_c *LeadCreate) SetSource(v lead.Source) *LeadCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_c *LeadCreate) SetNillableSource(v *lead.Source) *LeadCreate {
+	if v != nil {
+		_c.SetSource(*v)
+	}
+	return _c
+}
+
+// SetSourceID sets the "source_id" field.
+func (_c *LeadCreate) SetSourceID(v string) *LeadCreate {
+	_c.mutation.SetSourceID(v)
+	return _c
+}
+
+// SetNillableSourceID sets the "source_id" field if the given value is not nil.
+func (_c *LeadCreate) SetNillableSourceID(v *string) *LeadCreate {
+	if v != nil {
+		_c.SetSourceID(*v)
+	}
+	return _c
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_c *LeadCreate) SetLastSeenAt(v time.Time) *LeadCreate {
+	_c.mutation.SetLastSeenAt(v)
+	return _c
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_c *LeadCreate) SetNillableLastSeenAt(v *time.Time) *LeadCreate {
+	if v != nil {
+		_c.SetLastSeenAt(*v)
+	}
+	return _c
+}
+
 // SetSubNiche sets the "sub_niche" field.
 func (_c *LeadCreate) SetSubNiche(v string) *LeadCreate {
 	_c.mutation.SetSubNiche(v)
@@ -470,6 +594,21 @@ func (_c *LeadCreate) AddNotes(v ...*LeadNote) *LeadCreate {
 	return _c.AddNoteIDs(ids...)
 }
 
+// AddContactAttemptIDs adds the "contact_attempts" edge to the ContactAttempt entity by IDs.
+func (_c *LeadCreate) AddContactAttemptIDs(ids ...int) *LeadCreate {
+	_c.mutation.AddContactAttemptIDs(ids...)
+	return _c
+}
+
+// AddContactAttempts adds the "contact_attempts" edges to the ContactAttempt entity.
+func (_c *LeadCreate) AddContactAttempts(v ...*ContactAttempt) *LeadCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddContactAttemptIDs(ids...)
+}
+
 // AddStatusHistoryIDs adds the "status_history" edge to the LeadStatusHistory entity by IDs.
 func (_c *LeadCreate) AddStatusHistoryIDs(ids ...int) *LeadCreate {
 	_c.mutation.AddStatusHistoryIDs(ids...)
@@ -485,6 +624,36 @@ func (_c *LeadCreate) AddStatusHistory(v ...*LeadStatusHistory) *LeadCreate {
 	return _c.AddStatusHistoryIDs(ids...)
 }
 
+// AddChangeIDs adds the "changes" edge to the LeadChange entity by IDs.
+func (_c *LeadCreate) AddChangeIDs(ids ...int) *LeadCreate {
+	_c.mutation.AddChangeIDs(ids...)
+	return _c
+}
+
+// AddChanges adds the "changes" edges to the LeadChange entity.
+func (_c *LeadCreate) AddChanges(v ...*LeadChange) *LeadCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddChangeIDs(ids...)
+}
+
+// AddEnrichmentAttemptIDs adds the "enrichment_attempts" edge to the EnrichmentAttempt entity by IDs.
+func (_c *LeadCreate) AddEnrichmentAttemptIDs(ids ...int) *LeadCreate {
+	_c.mutation.AddEnrichmentAttemptIDs(ids...)
+	return _c
+}
+
+// AddEnrichmentAttempts adds the "enrichment_attempts" edges to the EnrichmentAttempt entity.
+func (_c *LeadCreate) AddEnrichmentAttempts(v ...*EnrichmentAttempt) *LeadCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddEnrichmentAttemptIDs(ids...)
+}
+
 // AddAssignmentIDs adds the "assignments" edge to the LeadAssignment entity by IDs.
 func (_c *LeadCreate) AddAssignmentIDs(ids ...int) *LeadCreate {
 	_c.mutation.AddAssignmentIDs(ids...)
@@ -594,6 +763,41 @@ func (_c *LeadCreate) AddRecommendations(v ...*LeadRecommendation) *LeadCreate {
 	return _c.AddRecommendationIDs(ids...)
 }
 
+// AddVerificationIDs adds the "verifications" edge to the LeadVerification entity by IDs.
+func (_c *LeadCreate) AddVerificationIDs(ids ...int) *LeadCreate {
+	_c.mutation.AddVerificationIDs(ids...)
+	return _c
+}
+
+// AddVerifications adds the "verifications" edges to the LeadVerification entity.
+func (_c *LeadCreate) AddVerifications(v ...*LeadVerification) *LeadCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddVerificationIDs(ids...)
+}
+
+// AddSuppressionIDs adds the "suppressions" edge to the LeadSuppression entity by IDs.
+func (_c *LeadCreate) AddSuppressionIDs(ids ...int) *LeadCreate {
+	_c.mutation.AddSuppressionIDs(ids...)
+	return _c
+}
+
+// AddSuppressions adds the "suppressions" edges to the LeadSuppression entity.
+func (_c *LeadCreate) AddSuppressions(v ...*LeadSuppression) *LeadCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSuppressionIDs(ids...)
+}
+
+// SetOwnerOrganization sets the "owner_organization" edge to the Organization entity.
+func (_c *LeadCreate) SetOwnerOrganization(v *Organization) *LeadCreate {
+	return _c.SetOwnerOrganizationID(v.ID)
+}
+
 // Mutation returns the LeadMutation object of the builder.
 func (_c *LeadCreate) Mutation() *LeadMutation {
 	return _c.mutation
@@ -637,6 +841,10 @@ func (_c *LeadCreate) defaults() {
 		v := lead.DefaultQualityScore
 		_c.mutation.SetQualityScore(v)
 	}
+	if _, ok := _c.mutation.CompletenessScore(); !ok {
+		v := lead.DefaultCompletenessScore
+		_c.mutation.SetCompletenessScore(v)
+	}
 	if _, ok := _c.mutation.Status(); !ok {
 		v := lead.DefaultStatus
 		_c.mutation.SetStatus(v)
@@ -645,6 +853,10 @@ func (_c *LeadCreate) defaults() {
 		v := lead.DefaultStatusChangedAt()
 		_c.mutation.SetStatusChangedAt(v)
 	}
+	if _, ok := _c.mutation.Source(); !ok {
+		v := lead.DefaultSource
+		_c.mutation.SetSource(v)
+	}
 	if _, ok := _c.mutation.IsEnriched(); !ok {
 		v := lead.DefaultIsEnriched
 		_c.mutation.SetIsEnriched(v)
@@ -708,6 +920,14 @@ func (_c *LeadCreate) check() error {
 			return &ValidationError{Name: "quality_score", err: fmt.Errorf(`ent: validator failed for field "Lead.quality_score": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.CompletenessScore(); !ok {
+		return &ValidationError{Name: "completeness_score", err: errors.New(`ent: missing required field "Lead.completeness_score"`)}
+	}
+	if v, ok := _c.mutation.CompletenessScore(); ok {
+		if err := lead.CompletenessScoreValidator(v); err != nil {
+			return &ValidationError{Name: "completeness_score", err: fmt.Errorf(`ent: validator failed for field "Lead.completeness_score": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Lead.status"`)}
 	}
@@ -719,6 +939,14 @@ func (_c *LeadCreate) check() error {
 	if _, ok := _c.mutation.StatusChangedAt(); !ok {
 		return &ValidationError{Name: "status_changed_at", err: errors.New(`ent: missing required field "Lead.status_changed_at"`)}
 	}
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "Lead.source"`)}
+	}
+	if v, ok := _c.mutation.Source(); ok {
+		if err := lead.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "Lead.source": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.IsEnriched(); !ok {
 		return &ValidationError{Name: "is_enriched", err: errors.New(`ent: missing required field "Lead.is_enriched"`)}
 	}
@@ -809,10 +1037,22 @@ func (_c *LeadCreate) createSpec() (*Lead, *sqlgraph.CreateSpec) {
 		_spec.SetField(lead.FieldVerified, field.TypeBool, value)
 		_node.Verified = value
 	}
+	if value, ok := _c.mutation.VerifiedSince(); ok {
+		_spec.SetField(lead.FieldVerifiedSince, field.TypeTime, value)
+		_node.VerifiedSince = &value
+	}
+	if value, ok := _c.mutation.VerifiedBy(); ok {
+		_spec.SetField(lead.FieldVerifiedBy, field.TypeInt, value)
+		_node.VerifiedBy = &value
+	}
 	if value, ok := _c.mutation.QualityScore(); ok {
 		_spec.SetField(lead.FieldQualityScore, field.TypeInt, value)
 		_node.QualityScore = value
 	}
+	if value, ok := _c.mutation.CompletenessScore(); ok {
+		_spec.SetField(lead.FieldCompletenessScore, field.TypeInt, value)
+		_node.CompletenessScore = value
+	}
 	if value, ok := _c.mutation.Status(); ok {
 		_spec.SetField(lead.FieldStatus, field.TypeEnum, value)
 		_node.Status = value
@@ -821,10 +1061,18 @@ func (_c *LeadCreate) createSpec() (*Lead, *sqlgraph.CreateSpec) {
 		_spec.SetField(lead.FieldStatusChangedAt, field.TypeTime, value)
 		_node.StatusChangedAt = value
 	}
+	if value, ok := _c.mutation.SLAOverdueSince(); ok {
+		_spec.SetField(lead.FieldSLAOverdueSince, field.TypeTime, value)
+		_node.SLAOverdueSince = &value
+	}
 	if value, ok := _c.mutation.CustomFields(); ok {
 		_spec.SetField(lead.FieldCustomFields, field.TypeJSON, value)
 		_node.CustomFields = value
 	}
+	if value, ok := _c.mutation.Tags(); ok {
+		_spec.SetField(lead.FieldTags, field.TypeJSON, value)
+		_node.Tags = value
+	}
 	if value, ok := _c.mutation.OsmID(); ok {
 		_spec.SetField(lead.FieldOsmID, field.TypeString, value)
 		_node.OsmID = value
@@ -833,6 +1081,18 @@ func (_c *LeadCreate) createSpec() (*Lead, *sqlgraph.CreateSpec) {
 		_spec.SetField(lead.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
 	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(lead.FieldSource, field.TypeEnum, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.SourceID(); ok {
+		_spec.SetField(lead.FieldSourceID, field.TypeString, value)
+		_node.SourceID = value
+	}
+	if value, ok := _c.mutation.LastSeenAt(); ok {
+		_spec.SetField(lead.FieldLastSeenAt, field.TypeTime, value)
+		_node.LastSeenAt = &value
+	}
 	if value, ok := _c.mutation.SubNiche(); ok {
 		_spec.SetField(lead.FieldSubNiche, field.TypeString, value)
 		_node.SubNiche = value
@@ -913,6 +1173,22 @@ func (_c *LeadCreate) createSpec() (*Lead, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ContactAttemptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lead.ContactAttemptsTable,
+			Columns: []string{lead.ContactAttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contactattempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.StatusHistoryIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -929,6 +1205,38 @@ func (_c *LeadCreate) createSpec() (*Lead, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lead.ChangesTable,
+			Columns: []string{lead.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadchange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.EnrichmentAttemptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lead.EnrichmentAttemptsTable,
+			Columns: []string{lead.EnrichmentAttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrichmentattempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.AssignmentsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1042,6 +1350,55 @@ func (_c *LeadCreate) createSpec() (*Lead, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.VerificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lead.VerificationsTable,
+			Columns: []string{lead.VerificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadverification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SuppressionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lead.SuppressionsTable,
+			Columns: []string{lead.SuppressionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadsuppression.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.OwnerOrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lead.OwnerOrganizationTable,
+			Columns: []string{lead.OwnerOrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OwnerOrganizationID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -13,13 +13,19 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/jordanlanch/industrydb/ent/calllog"
+	"github.com/jordanlanch/industrydb/ent/contactattempt"
 	"github.com/jordanlanch/industrydb/ent/emailsequenceenrollment"
 	"github.com/jordanlanch/industrydb/ent/emailsequencesend"
+	"github.com/jordanlanch/industrydb/ent/enrichmentattempt"
 	"github.com/jordanlanch/industrydb/ent/lead"
 	"github.com/jordanlanch/industrydb/ent/leadassignment"
+	"github.com/jordanlanch/industrydb/ent/leadchange"
 	"github.com/jordanlanch/industrydb/ent/leadnote"
 	"github.com/jordanlanch/industrydb/ent/leadrecommendation"
 	"github.com/jordanlanch/industrydb/ent/leadstatushistory"
+	"github.com/jordanlanch/industrydb/ent/leadsuppression"
+	"github.com/jordanlanch/industrydb/ent/leadverification"
+	"github.com/jordanlanch/industrydb/ent/organization"
 	"github.com/jordanlanch/industrydb/ent/predicate"
 	"github.com/jordanlanch/industrydb/ent/smsmessage"
 	"github.com/jordanlanch/industrydb/ent/territory"
@@ -33,7 +39,10 @@ type LeadQuery struct {
 	inters                       []Interceptor
 	predicates                   []predicate.Lead
 	withNotes                    *LeadNoteQuery
+	withContactAttempts          *ContactAttemptQuery
 	withStatusHistory            *LeadStatusHistoryQuery
+	withChanges                  *LeadChangeQuery
+	withEnrichmentAttempts       *EnrichmentAttemptQuery
 	withAssignments              *LeadAssignmentQuery
 	withEmailSequenceEnrollments *EmailSequenceEnrollmentQuery
 	withEmailSequenceSends       *EmailSequenceSendQuery
@@ -41,7 +50,11 @@ type LeadQuery struct {
 	withSmsMessages              *SMSMessageQuery
 	withCallLogs                 *CallLogQuery
 	withRecommendations          *LeadRecommendationQuery
+	withVerifications            *LeadVerificationQuery
+	withSuppressions             *LeadSuppressionQuery
+	withOwnerOrganization        *OrganizationQuery
 	withFKs                      bool
+	modifiers                    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -100,6 +113,28 @@ func (_q *LeadQuery) QueryNotes() *LeadNoteQuery {
 	return query
 }
 
+// QueryContactAttempts chains the current query on the "contact_attempts" edge.
+func (_q *LeadQuery) QueryContactAttempts() *ContactAttemptQuery {
+	query := (&ContactAttemptClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lead.Table, lead.FieldID, selector),
+			sqlgraph.To(contactattempt.Table, contactattempt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, lead.ContactAttemptsTable, lead.ContactAttemptsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryStatusHistory chains the current query on the "status_history" edge.
 func (_q *LeadQuery) QueryStatusHistory() *LeadStatusHistoryQuery {
 	query := (&LeadStatusHistoryClient{config: _q.config}).Query()
@@ -122,6 +157,50 @@ func (_q *LeadQuery) QueryStatusHistory() *LeadStatusHistoryQuery {
 	return query
 }
 
+// QueryChanges chains the current query on the "changes" edge.
+func (_q *LeadQuery) QueryChanges() *LeadChangeQuery {
+	query := (&LeadChangeClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lead.Table, lead.FieldID, selector),
+			sqlgraph.To(leadchange.Table, leadchange.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, lead.ChangesTable, lead.ChangesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEnrichmentAttempts chains the current query on the "enrichment_attempts" edge.
+func (_q *LeadQuery) QueryEnrichmentAttempts() *EnrichmentAttemptQuery {
+	query := (&EnrichmentAttemptClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lead.Table, lead.FieldID, selector),
+			sqlgraph.To(enrichmentattempt.Table, enrichmentattempt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, lead.EnrichmentAttemptsTable, lead.EnrichmentAttemptsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryAssignments chains the current query on the "assignments" edge.
 func (_q *LeadQuery) QueryAssignments() *LeadAssignmentQuery {
 	query := (&LeadAssignmentClient{config: _q.config}).Query()
@@ -276,6 +355,72 @@ func (_q *LeadQuery) QueryRecommendations() *LeadRecommendationQuery {
 	return query
 }
 
+// QueryVerifications chains the current query on the "verifications" edge.
+func (_q *LeadQuery) QueryVerifications() *LeadVerificationQuery {
+	query := (&LeadVerificationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lead.Table, lead.FieldID, selector),
+			sqlgraph.To(leadverification.Table, leadverification.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, lead.VerificationsTable, lead.VerificationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySuppressions chains the current query on the "suppressions" edge.
+func (_q *LeadQuery) QuerySuppressions() *LeadSuppressionQuery {
+	query := (&LeadSuppressionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lead.Table, lead.FieldID, selector),
+			sqlgraph.To(leadsuppression.Table, leadsuppression.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, lead.SuppressionsTable, lead.SuppressionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOwnerOrganization chains the current query on the "owner_organization" edge.
+func (_q *LeadQuery) QueryOwnerOrganization() *OrganizationQuery {
+	query := (&OrganizationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lead.Table, lead.FieldID, selector),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, lead.OwnerOrganizationTable, lead.OwnerOrganizationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Lead entity from the query.
 // Returns a *NotFoundError when no Lead was found.
 func (_q *LeadQuery) First(ctx context.Context) (*Lead, error) {
@@ -469,7 +614,10 @@ func (_q *LeadQuery) Clone() *LeadQuery {
 		inters:                       append([]Interceptor{}, _q.inters...),
 		predicates:                   append([]predicate.Lead{}, _q.predicates...),
 		withNotes:                    _q.withNotes.Clone(),
+		withContactAttempts:          _q.withContactAttempts.Clone(),
 		withStatusHistory:            _q.withStatusHistory.Clone(),
+		withChanges:                  _q.withChanges.Clone(),
+		withEnrichmentAttempts:       _q.withEnrichmentAttempts.Clone(),
 		withAssignments:              _q.withAssignments.Clone(),
 		withEmailSequenceEnrollments: _q.withEmailSequenceEnrollments.Clone(),
 		withEmailSequenceSends:       _q.withEmailSequenceSends.Clone(),
@@ -477,9 +625,13 @@ func (_q *LeadQuery) Clone() *LeadQuery {
 		withSmsMessages:              _q.withSmsMessages.Clone(),
 		withCallLogs:                 _q.withCallLogs.Clone(),
 		withRecommendations:          _q.withRecommendations.Clone(),
+		withVerifications:            _q.withVerifications.Clone(),
+		withSuppressions:             _q.withSuppressions.Clone(),
+		withOwnerOrganization:        _q.withOwnerOrganization.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -494,6 +646,17 @@ func (_q *LeadQuery) WithNotes(opts ...func(*LeadNoteQuery)) *LeadQuery {
 	return _q
 }
 
+// WithContactAttempts tells the query-builder to eager-load the nodes that are connected to
+// the "contact_attempts" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *LeadQuery) WithContactAttempts(opts ...func(*ContactAttemptQuery)) *LeadQuery {
+	query := (&ContactAttemptClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withContactAttempts = query
+	return _q
+}
+
 // WithStatusHistory tells the query-builder to eager-load the nodes that are connected to
 // the "status_history" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *LeadQuery) WithStatusHistory(opts ...func(*LeadStatusHistoryQuery)) *LeadQuery {
@@ -505,6 +668,28 @@ func (_q *LeadQuery) WithStatusHistory(opts ...func(*LeadStatusHistoryQuery)) *L
 	return _q
 }
 
+// WithChanges tells the query-builder to eager-load the nodes that are connected to
+// the "changes" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *LeadQuery) WithChanges(opts ...func(*LeadChangeQuery)) *LeadQuery {
+	query := (&LeadChangeClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withChanges = query
+	return _q
+}
+
+// WithEnrichmentAttempts tells the query-builder to eager-load the nodes that are connected to
+// the "enrichment_attempts" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *LeadQuery) WithEnrichmentAttempts(opts ...func(*EnrichmentAttemptQuery)) *LeadQuery {
+	query := (&EnrichmentAttemptClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withEnrichmentAttempts = query
+	return _q
+}
+
 // WithAssignments tells the query-builder to eager-load the nodes that are connected to
 // the "assignments" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *LeadQuery) WithAssignments(opts ...func(*LeadAssignmentQuery)) *LeadQuery {
@@ -582,6 +767,39 @@ func (_q *LeadQuery) WithRecommendations(opts ...func(*LeadRecommendationQuery))
 	return _q
 }
 
+// WithVerifications tells the query-builder to eager-load the nodes that are connected to
+// the "verifications" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *LeadQuery) WithVerifications(opts ...func(*LeadVerificationQuery)) *LeadQuery {
+	query := (&LeadVerificationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withVerifications = query
+	return _q
+}
+
+// WithSuppressions tells the query-builder to eager-load the nodes that are connected to
+// the "suppressions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *LeadQuery) WithSuppressions(opts ...func(*LeadSuppressionQuery)) *LeadQuery {
+	query := (&LeadSuppressionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSuppressions = query
+	return _q
+}
+
+// WithOwnerOrganization tells the query-builder to eager-load the nodes that are connected to
+// the "owner_organization" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *LeadQuery) WithOwnerOrganization(opts ...func(*OrganizationQuery)) *LeadQuery {
+	query := (&OrganizationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withOwnerOrganization = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -661,9 +879,12 @@ func (_q *LeadQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Lead, e
 		nodes       = []*Lead{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [9]bool{
+		loadedTypes = [15]bool{
 			_q.withNotes != nil,
+			_q.withContactAttempts != nil,
 			_q.withStatusHistory != nil,
+			_q.withChanges != nil,
+			_q.withEnrichmentAttempts != nil,
 			_q.withAssignments != nil,
 			_q.withEmailSequenceEnrollments != nil,
 			_q.withEmailSequenceSends != nil,
@@ -671,6 +892,9 @@ func (_q *LeadQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Lead, e
 			_q.withSmsMessages != nil,
 			_q.withCallLogs != nil,
 			_q.withRecommendations != nil,
+			_q.withVerifications != nil,
+			_q.withSuppressions != nil,
+			_q.withOwnerOrganization != nil,
 		}
 	)
 	if _q.withTerritory != nil {
@@ -688,6 +912,9 @@ func (_q *LeadQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Lead, e
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -704,6 +931,13 @@ func (_q *LeadQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Lead, e
 			return nil, err
 		}
 	}
+	if query := _q.withContactAttempts; query != nil {
+		if err := _q.loadContactAttempts(ctx, query, nodes,
+			func(n *Lead) { n.Edges.ContactAttempts = []*ContactAttempt{} },
+			func(n *Lead, e *ContactAttempt) { n.Edges.ContactAttempts = append(n.Edges.ContactAttempts, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withStatusHistory; query != nil {
 		if err := _q.loadStatusHistory(ctx, query, nodes,
 			func(n *Lead) { n.Edges.StatusHistory = []*LeadStatusHistory{} },
@@ -711,6 +945,22 @@ func (_q *LeadQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Lead, e
 			return nil, err
 		}
 	}
+	if query := _q.withChanges; query != nil {
+		if err := _q.loadChanges(ctx, query, nodes,
+			func(n *Lead) { n.Edges.Changes = []*LeadChange{} },
+			func(n *Lead, e *LeadChange) { n.Edges.Changes = append(n.Edges.Changes, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withEnrichmentAttempts; query != nil {
+		if err := _q.loadEnrichmentAttempts(ctx, query, nodes,
+			func(n *Lead) { n.Edges.EnrichmentAttempts = []*EnrichmentAttempt{} },
+			func(n *Lead, e *EnrichmentAttempt) {
+				n.Edges.EnrichmentAttempts = append(n.Edges.EnrichmentAttempts, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withAssignments; query != nil {
 		if err := _q.loadAssignments(ctx, query, nodes,
 			func(n *Lead) { n.Edges.Assignments = []*LeadAssignment{} },
@@ -763,6 +1013,26 @@ func (_q *LeadQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Lead, e
 			return nil, err
 		}
 	}
+	if query := _q.withVerifications; query != nil {
+		if err := _q.loadVerifications(ctx, query, nodes,
+			func(n *Lead) { n.Edges.Verifications = []*LeadVerification{} },
+			func(n *Lead, e *LeadVerification) { n.Edges.Verifications = append(n.Edges.Verifications, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSuppressions; query != nil {
+		if err := _q.loadSuppressions(ctx, query, nodes,
+			func(n *Lead) { n.Edges.Suppressions = []*LeadSuppression{} },
+			func(n *Lead, e *LeadSuppression) { n.Edges.Suppressions = append(n.Edges.Suppressions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withOwnerOrganization; query != nil {
+		if err := _q.loadOwnerOrganization(ctx, query, nodes, nil,
+			func(n *Lead, e *Organization) { n.Edges.OwnerOrganization = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -796,6 +1066,36 @@ func (_q *LeadQuery) loadNotes(ctx context.Context, query *LeadNoteQuery, nodes
 	}
 	return nil
 }
+func (_q *LeadQuery) loadContactAttempts(ctx context.Context, query *ContactAttemptQuery, nodes []*Lead, init func(*Lead), assign func(*Lead, *ContactAttempt)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Lead)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(contactattempt.FieldLeadID)
+	}
+	query.Where(predicate.ContactAttempt(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(lead.ContactAttemptsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.LeadID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "lead_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (_q *LeadQuery) loadStatusHistory(ctx context.Context, query *LeadStatusHistoryQuery, nodes []*Lead, init func(*Lead), assign func(*Lead, *LeadStatusHistory)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*Lead)
@@ -826,6 +1126,66 @@ func (_q *LeadQuery) loadStatusHistory(ctx context.Context, query *LeadStatusHis
 	}
 	return nil
 }
+func (_q *LeadQuery) loadChanges(ctx context.Context, query *LeadChangeQuery, nodes []*Lead, init func(*Lead), assign func(*Lead, *LeadChange)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Lead)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(leadchange.FieldLeadID)
+	}
+	query.Where(predicate.LeadChange(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(lead.ChangesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.LeadID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "lead_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *LeadQuery) loadEnrichmentAttempts(ctx context.Context, query *EnrichmentAttemptQuery, nodes []*Lead, init func(*Lead), assign func(*Lead, *EnrichmentAttempt)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Lead)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(enrichmentattempt.FieldLeadID)
+	}
+	query.Where(predicate.EnrichmentAttempt(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(lead.EnrichmentAttemptsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.LeadID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "lead_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (_q *LeadQuery) loadAssignments(ctx context.Context, query *LeadAssignmentQuery, nodes []*Lead, init func(*Lead), assign func(*Lead, *LeadAssignment)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*Lead)
@@ -1044,9 +1404,104 @@ func (_q *LeadQuery) loadRecommendations(ctx context.Context, query *LeadRecomme
 	}
 	return nil
 }
+func (_q *LeadQuery) loadVerifications(ctx context.Context, query *LeadVerificationQuery, nodes []*Lead, init func(*Lead), assign func(*Lead, *LeadVerification)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Lead)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(leadverification.FieldLeadID)
+	}
+	query.Where(predicate.LeadVerification(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(lead.VerificationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.LeadID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "lead_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *LeadQuery) loadSuppressions(ctx context.Context, query *LeadSuppressionQuery, nodes []*Lead, init func(*Lead), assign func(*Lead, *LeadSuppression)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Lead)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(leadsuppression.FieldLeadID)
+	}
+	query.Where(predicate.LeadSuppression(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(lead.SuppressionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.LeadID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "lead_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *LeadQuery) loadOwnerOrganization(ctx context.Context, query *OrganizationQuery, nodes []*Lead, init func(*Lead), assign func(*Lead, *Organization)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Lead)
+	for i := range nodes {
+		if nodes[i].OwnerOrganizationID == nil {
+			continue
+		}
+		fk := *nodes[i].OwnerOrganizationID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(organization.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "owner_organization_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *LeadQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -1070,6 +1525,9 @@ func (_q *LeadQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if _q.withOwnerOrganization != nil {
+			_spec.Node.AddColumnOnce(lead.FieldOwnerOrganizationID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -1109,6 +1567,9 @@ func (_q *LeadQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -1126,6 +1587,12 @@ func (_q *LeadQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *LeadQuery) Modify(modifiers ...func(s *sql.Selector)) *LeadSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // LeadGroupBy is the group-by builder for Lead entities.
 type LeadGroupBy struct {
 	selector
@@ -1215,3 +1682,9 @@ func (_s *LeadSelect) sqlScan(ctx context.Context, root *LeadQuery, v any) error
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *LeadSelect) Modify(modifiers ...func(s *sql.Selector)) *LeadSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
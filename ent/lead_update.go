@@ -13,13 +13,19 @@ import (
 	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/jordanlanch/industrydb/ent/calllog"
+	"github.com/jordanlanch/industrydb/ent/contactattempt"
 	"github.com/jordanlanch/industrydb/ent/emailsequenceenrollment"
 	"github.com/jordanlanch/industrydb/ent/emailsequencesend"
+	"github.com/jordanlanch/industrydb/ent/enrichmentattempt"
 	"github.com/jordanlanch/industrydb/ent/lead"
 	"github.com/jordanlanch/industrydb/ent/leadassignment"
+	"github.com/jordanlanch/industrydb/ent/leadchange"
 	"github.com/jordanlanch/industrydb/ent/leadnote"
 	"github.com/jordanlanch/industrydb/ent/leadrecommendation"
 	"github.com/jordanlanch/industrydb/ent/leadstatushistory"
+	"github.com/jordanlanch/industrydb/ent/leadsuppression"
+	"github.com/jordanlanch/industrydb/ent/leadverification"
+	"github.com/jordanlanch/industrydb/ent/organization"
 	"github.com/jordanlanch/industrydb/ent/predicate"
 	"github.com/jordanlanch/industrydb/ent/smsmessage"
 	"github.com/jordanlanch/industrydb/ent/territory"
@@ -28,8 +34,9 @@ import (
 // LeadUpdate is the builder for updating Lead entities.
 type LeadUpdate struct {
 	config
-	hooks    []Hook
-	mutation *LeadMutation
+	hooks     []Hook
+	mutation  *LeadMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the LeadUpdate builder.
@@ -274,6 +281,53 @@ func (_u *LeadUpdate) SetNillableVerified(v *bool) *LeadUpdate {
 	return _u
 }
 
+// SetVerifiedSince sets the "verified_since" field.
+func (_u *LeadUpdate) SetVerifiedSince(v time.Time) *LeadUpdate {
+	_u.mutation.SetVerifiedSince(v)
+	return _u
+}
+
+// SetNillableVerifiedSince sets the "verified_since" field if the given value is not nil.
+func (_u *LeadUpdate) SetNillableVerifiedSince(v *time.Time) *LeadUpdate {
+	if v != nil {
+		_u.SetVerifiedSince(*v)
+	}
+	return _u
+}
+
+// ClearVerifiedSince clears the value of the "verified_since" field.
+func (_u *LeadUpdate) ClearVerifiedSince() *LeadUpdate {
+	_u.mutation.ClearVerifiedSince()
+	return _u
+}
+
+// SetVerifiedBy sets the "verified_by" field.
+func (_u *LeadUpdate) SetVerifiedBy(v int) *LeadUpdate {
+	_u.mutation.ResetVerifiedBy()
+	_u.mutation.SetVerifiedBy(v)
+	return _u
+}
+
+// SetNillableVerifiedBy sets the "verified_by" field if the given value is not nil.
+func (_u *LeadUpdate) SetNillableVerifiedBy(v *int) *LeadUpdate {
+	if v != nil {
+		_u.SetVerifiedBy(*v)
+	}
+	return _u
+}
+
+// AddVerifiedBy adds value to the "verified_by" field.
+func (_u *LeadUpdate) AddVerifiedBy(v int) *LeadUpdate {
+	_u.mutation.AddVerifiedBy(v)
+	return _u
+}
+
+// ClearVerifiedBy clears the value of the "verified_by" field.
+func (_u *LeadUpdate) ClearVerifiedBy() *LeadUpdate {
+	_u.mutation.ClearVerifiedBy()
+	return _u
+}
+
 // SetQualityScore sets the "quality_score" field.
 func (_u *LeadUpdate) SetQualityScore(v int) *LeadUpdate {
 	_u.mutation.ResetQualityScore()
@@ -295,6 +349,27 @@ func (_u *LeadUpdate) AddQualityScore(v int) *LeadUpdate {
 	return _u
 }
 
+// SetCompletenessScore sets the "completeness_score" field.
+func (_u *LeadUpdate) SetCompletenessScore(v int) *LeadUpdate {
+	_u.mutation.ResetCompletenessScore()
+	_u.mutation.SetCompletenessScore(v)
+	return _u
+}
+
+// SetNillableCompletenessScore sets the "completeness_score" field if the given value is not nil.
+func (_u *LeadUpdate) SetNillableCompletenessScore(v *int) *LeadUpdate {
+	if v != nil {
+		_u.SetCompletenessScore(*v)
+	}
+	return _u
+}
+
+// AddCompletenessScore adds value to the "completeness_score" field.
+func (_u *LeadUpdate) AddCompletenessScore(v int) *LeadUpdate {
+	_u.mutation.AddCompletenessScore(v)
+	return _u
+}
+
 // SetStatus sets the "status" field.
 func (_u *LeadUpdate) SetStatus(v lead.Status) *LeadUpdate {
 	_u.mutation.SetStatus(v)
@@ -323,6 +398,26 @@ func (_u *LeadUpdate) SetNillableStatusChangedAt(v *time.Time) *LeadUpdate {
 	return _u
 }
 
+// SetSLAOverdueSince sets the "sla_overdue_since" field.
+func (_u *LeadUpdate) SetSLAOverdueSince(v time.Time) *LeadUpdate {
+	_u.mutation.SetSLAOverdueSince(v)
+	return _u
+}
+
+// SetNillableSLAOverdueSince sets the "sla_overdue_since" field if the given value is not nil.
+func (_u *LeadUpdate) SetNillableSLAOverdueSince(v *time.Time) *LeadUpdate {
+	if v != nil {
+		_u.SetSLAOverdueSince(*v)
+	}
+	return _u
+}
+
+// ClearSLAOverdueSince clears the value of the "sla_overdue_since" field.
+func (_u *LeadUpdate) ClearSLAOverdueSince() *LeadUpdate {
+	_u.mutation.ClearSLAOverdueSince()
+	return _u
+}
+
 // SetCustomFields sets the "custom_fields" field.
 func (_u *LeadUpdate) SetCustomFields(v map[string]interface{}) *LeadUpdate {
 	_u.mutation.SetCustomFields(v)
@@ -335,6 +430,44 @@ func (_u *LeadUpdate) ClearCustomFields() *LeadUpdate {
 	return _u
 }
 
+// SetTags sets the "tags" field.
+func (_u *LeadUpdate) SetTags(v []string) *LeadUpdate {
+	_u.mutation.SetTags(v)
+	return _u
+}
+
+// AppendTags appends value to the "tags" field.
+func (_u *LeadUpdate) AppendTags(v []string) *LeadUpdate {
+	_u.mutation.AppendTags(v)
+	return _u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (_u *LeadUpdate) ClearTags() *LeadUpdate {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// SetOwnerOrganizationID sets the "owner_organization_id" field.
+func (_u *LeadUpdate) SetOwnerOrganizationID(v int) *LeadUpdate {
+	_u.mutation.SetOwnerOrganizationID(v)
+	return _u
+}
+
+// SetNillableOwnerOrganizationID sets the "owner_organization_id" field if the given value is not nil.
+func (_u *LeadUpdate) SetNillableOwnerOrganizationID(v *int) *LeadUpdate {
+	if v != nil {
+		_u.SetOwnerOrganizationID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerOrganizationID clears the value of the "owner_organization_id" field.
+func (_u *LeadUpdate) ClearOwnerOrganizationID() *LeadUpdate {
+	_u.mutation.ClearOwnerOrganizationID()
+	return _u
+}
+
 // SetOsmID sets the "osm_id" field.
 func (_u *LeadUpdate) SetOsmID(v string) *LeadUpdate {
 	_u.mutation.SetOsmID(v)
@@ -367,6 +500,60 @@ func (_u *LeadUpdate) ClearMetadata() *LeadUpdate {
 	return _u
 }
 
+// SetSource sets the "source" field.
+func (_u *LeadUpdate) SetSource(v lead.Source) *LeadUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *LeadUpdate) SetNillableSource(v *lead.Source) *LeadUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetSourceID sets the "source_id" field.
+func (_u *LeadUpdate) SetSourceID(v string) *LeadUpdate {
+	_u.mutation.SetSourceID(v)
+	return _u
+}
+
+// SetNillableSourceID sets the "source_id" field if the given value is not nil.
+func (_u *LeadUpdate) SetNillableSourceID(v *string) *LeadUpdate {
+	if v != nil {
+		_u.SetSourceID(*v)
+	}
+	return _u
+}
+
+// ClearSourceID clears the value of the "source_id" field.
+func (_u *LeadUpdate) ClearSourceID() *LeadUpdate {
+	_u.mutation.ClearSourceID()
+	return _u
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_u *LeadUpdate) SetLastSeenAt(v time.Time) *LeadUpdate {
+	_u.mutation.SetLastSeenAt(v)
+	return _u
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_u *LeadUpdate) SetNillableLastSeenAt(v *time.Time) *LeadUpdate {
+	if v != nil {
+		_u.SetLastSeenAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (_u *LeadUpdate) ClearLastSeenAt() *LeadUpdate {
+	_u.mutation.ClearLastSeenAt()
+	return _u
+}
+
 // SetSubNiche sets the "sub_niche" field.
 func (_u *LeadUpdate) SetSubNiche(v string) *LeadUpdate {
 	_u.mutation.SetSubNiche(v)
@@ -661,6 +848,21 @@ func (_u *LeadUpdate) AddNotes(v ...*LeadNote) *LeadUpdate {
 	return _u.AddNoteIDs(ids...)
 }
 
+// AddContactAttemptIDs adds the "contact_attempts" edge to the ContactAttempt entity by IDs.
+func (_u *LeadUpdate) AddContactAttemptIDs(ids ...int) *LeadUpdate {
+	_u.mutation.AddContactAttemptIDs(ids...)
+	return _u
+}
+
+// AddContactAttempts adds the "contact_attempts" edges to the ContactAttempt entity.
+func (_u *LeadUpdate) AddContactAttempts(v ...*ContactAttempt) *LeadUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddContactAttemptIDs(ids...)
+}
+
 // AddStatusHistoryIDs adds the "status_history" edge to the LeadStatusHistory entity by IDs.
 func (_u *LeadUpdate) AddStatusHistoryIDs(ids ...int) *LeadUpdate {
 	_u.mutation.AddStatusHistoryIDs(ids...)
@@ -676,6 +878,36 @@ func (_u *LeadUpdate) AddStatusHistory(v ...*LeadStatusHistory) *LeadUpdate {
 	return _u.AddStatusHistoryIDs(ids...)
 }
 
+// AddChangeIDs adds the "changes" edge to the LeadChange entity by IDs.
+func (_u *LeadUpdate) AddChangeIDs(ids ...int) *LeadUpdate {
+	_u.mutation.AddChangeIDs(ids...)
+	return _u
+}
+
+// AddChanges adds the "changes" edges to the LeadChange entity.
+func (_u *LeadUpdate) AddChanges(v ...*LeadChange) *LeadUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChangeIDs(ids...)
+}
+
+// AddEnrichmentAttemptIDs adds the "enrichment_attempts" edge to the EnrichmentAttempt entity by IDs.
+func (_u *LeadUpdate) AddEnrichmentAttemptIDs(ids ...int) *LeadUpdate {
+	_u.mutation.AddEnrichmentAttemptIDs(ids...)
+	return _u
+}
+
+// AddEnrichmentAttempts adds the "enrichment_attempts" edges to the EnrichmentAttempt entity.
+func (_u *LeadUpdate) AddEnrichmentAttempts(v ...*EnrichmentAttempt) *LeadUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEnrichmentAttemptIDs(ids...)
+}
+
 // AddAssignmentIDs adds the "assignments" edge to the LeadAssignment entity by IDs.
 func (_u *LeadUpdate) AddAssignmentIDs(ids ...int) *LeadUpdate {
 	_u.mutation.AddAssignmentIDs(ids...)
@@ -785,6 +1017,41 @@ func (_u *LeadUpdate) AddRecommendations(v ...*LeadRecommendation) *LeadUpdate {
 	return _u.AddRecommendationIDs(ids...)
 }
 
+// AddVerificationIDs adds the "verifications" edge to the LeadVerification entity by IDs.
+func (_u *LeadUpdate) AddVerificationIDs(ids ...int) *LeadUpdate {
+	_u.mutation.AddVerificationIDs(ids...)
+	return _u
+}
+
+// AddVerifications adds the "verifications" edges to the LeadVerification entity.
+func (_u *LeadUpdate) AddVerifications(v ...*LeadVerification) *LeadUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddVerificationIDs(ids...)
+}
+
+// AddSuppressionIDs adds the "suppressions" edge to the LeadSuppression entity by IDs.
+func (_u *LeadUpdate) AddSuppressionIDs(ids ...int) *LeadUpdate {
+	_u.mutation.AddSuppressionIDs(ids...)
+	return _u
+}
+
+// AddSuppressions adds the "suppressions" edges to the LeadSuppression entity.
+func (_u *LeadUpdate) AddSuppressions(v ...*LeadSuppression) *LeadUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSuppressionIDs(ids...)
+}
+
+// SetOwnerOrganization sets the "owner_organization" edge to the Organization entity.
+func (_u *LeadUpdate) SetOwnerOrganization(v *Organization) *LeadUpdate {
+	return _u.SetOwnerOrganizationID(v.ID)
+}
+
 // Mutation returns the LeadMutation object of the builder.
 func (_u *LeadUpdate) Mutation() *LeadMutation {
 	return _u.mutation
@@ -811,6 +1078,27 @@ func (_u *LeadUpdate) RemoveNotes(v ...*LeadNote) *LeadUpdate {
 	return _u.RemoveNoteIDs(ids...)
 }
 
+// ClearContactAttempts clears all "contact_attempts" edges to the ContactAttempt entity.
+func (_u *LeadUpdate) ClearContactAttempts() *LeadUpdate {
+	_u.mutation.ClearContactAttempts()
+	return _u
+}
+
+// RemoveContactAttemptIDs removes the "contact_attempts" edge to ContactAttempt entities by IDs.
+func (_u *LeadUpdate) RemoveContactAttemptIDs(ids ...int) *LeadUpdate {
+	_u.mutation.RemoveContactAttemptIDs(ids...)
+	return _u
+}
+
+// RemoveContactAttempts removes "contact_attempts" edges to ContactAttempt entities.
+func (_u *LeadUpdate) RemoveContactAttempts(v ...*ContactAttempt) *LeadUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveContactAttemptIDs(ids...)
+}
+
 // ClearStatusHistory clears all "status_history" edges to the LeadStatusHistory entity.
 func (_u *LeadUpdate) ClearStatusHistory() *LeadUpdate {
 	_u.mutation.ClearStatusHistory()
@@ -832,6 +1120,48 @@ func (_u *LeadUpdate) RemoveStatusHistory(v ...*LeadStatusHistory) *LeadUpdate {
 	return _u.RemoveStatusHistoryIDs(ids...)
 }
 
+// ClearChanges clears all "changes" edges to the LeadChange entity.
+func (_u *LeadUpdate) ClearChanges() *LeadUpdate {
+	_u.mutation.ClearChanges()
+	return _u
+}
+
+// RemoveChangeIDs removes the "changes" edge to LeadChange entities by IDs.
+func (_u *LeadUpdate) RemoveChangeIDs(ids ...int) *LeadUpdate {
+	_u.mutation.RemoveChangeIDs(ids...)
+	return _u
+}
+
+// RemoveChanges removes "changes" edges to LeadChange entities.
+func (_u *LeadUpdate) RemoveChanges(v ...*LeadChange) *LeadUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChangeIDs(ids...)
+}
+
+// ClearEnrichmentAttempts clears all "enrichment_attempts" edges to the EnrichmentAttempt entity.
+func (_u *LeadUpdate) ClearEnrichmentAttempts() *LeadUpdate {
+	_u.mutation.ClearEnrichmentAttempts()
+	return _u
+}
+
+// RemoveEnrichmentAttemptIDs removes the "enrichment_attempts" edge to EnrichmentAttempt entities by IDs.
+func (_u *LeadUpdate) RemoveEnrichmentAttemptIDs(ids ...int) *LeadUpdate {
+	_u.mutation.RemoveEnrichmentAttemptIDs(ids...)
+	return _u
+}
+
+// RemoveEnrichmentAttempts removes "enrichment_attempts" edges to EnrichmentAttempt entities.
+func (_u *LeadUpdate) RemoveEnrichmentAttempts(v ...*EnrichmentAttempt) *LeadUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEnrichmentAttemptIDs(ids...)
+}
+
 // ClearAssignments clears all "assignments" edges to the LeadAssignment entity.
 func (_u *LeadUpdate) ClearAssignments() *LeadUpdate {
 	_u.mutation.ClearAssignments()
@@ -964,6 +1294,54 @@ func (_u *LeadUpdate) RemoveRecommendations(v ...*LeadRecommendation) *LeadUpdat
 	return _u.RemoveRecommendationIDs(ids...)
 }
 
+// ClearVerifications clears all "verifications" edges to the LeadVerification entity.
+func (_u *LeadUpdate) ClearVerifications() *LeadUpdate {
+	_u.mutation.ClearVerifications()
+	return _u
+}
+
+// RemoveVerificationIDs removes the "verifications" edge to LeadVerification entities by IDs.
+func (_u *LeadUpdate) RemoveVerificationIDs(ids ...int) *LeadUpdate {
+	_u.mutation.RemoveVerificationIDs(ids...)
+	return _u
+}
+
+// RemoveVerifications removes "verifications" edges to LeadVerification entities.
+func (_u *LeadUpdate) RemoveVerifications(v ...*LeadVerification) *LeadUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveVerificationIDs(ids...)
+}
+
+// ClearSuppressions clears all "suppressions" edges to the LeadSuppression entity.
+func (_u *LeadUpdate) ClearSuppressions() *LeadUpdate {
+	_u.mutation.ClearSuppressions()
+	return _u
+}
+
+// RemoveSuppressionIDs removes the "suppressions" edge to LeadSuppression entities by IDs.
+func (_u *LeadUpdate) RemoveSuppressionIDs(ids ...int) *LeadUpdate {
+	_u.mutation.RemoveSuppressionIDs(ids...)
+	return _u
+}
+
+// RemoveSuppressions removes "suppressions" edges to LeadSuppression entities.
+func (_u *LeadUpdate) RemoveSuppressions(v ...*LeadSuppression) *LeadUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSuppressionIDs(ids...)
+}
+
+// ClearOwnerOrganization clears the "owner_organization" edge to the Organization entity.
+func (_u *LeadUpdate) ClearOwnerOrganization() *LeadUpdate {
+	_u.mutation.ClearOwnerOrganization()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *LeadUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -1027,14 +1405,30 @@ func (_u *LeadUpdate) check() error {
 			return &ValidationError{Name: "quality_score", err: fmt.Errorf(`ent: validator failed for field "Lead.quality_score": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.CompletenessScore(); ok {
+		if err := lead.CompletenessScoreValidator(v); err != nil {
+			return &ValidationError{Name: "completeness_score", err: fmt.Errorf(`ent: validator failed for field "Lead.completeness_score": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Status(); ok {
 		if err := lead.StatusValidator(v); err != nil {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Lead.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Source(); ok {
+		if err := lead.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "Lead.source": %w`, err)}
+		}
+	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LeadUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LeadUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LeadUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -1116,24 +1510,62 @@ func (_u *LeadUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Verified(); ok {
 		_spec.SetField(lead.FieldVerified, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.VerifiedSince(); ok {
+		_spec.SetField(lead.FieldVerifiedSince, field.TypeTime, value)
+	}
+	if _u.mutation.VerifiedSinceCleared() {
+		_spec.ClearField(lead.FieldVerifiedSince, field.TypeTime)
+	}
+	if value, ok := _u.mutation.VerifiedBy(); ok {
+		_spec.SetField(lead.FieldVerifiedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVerifiedBy(); ok {
+		_spec.AddField(lead.FieldVerifiedBy, field.TypeInt, value)
+	}
+	if _u.mutation.VerifiedByCleared() {
+		_spec.ClearField(lead.FieldVerifiedBy, field.TypeInt)
+	}
 	if value, ok := _u.mutation.QualityScore(); ok {
 		_spec.SetField(lead.FieldQualityScore, field.TypeInt, value)
 	}
 	if value, ok := _u.mutation.AddedQualityScore(); ok {
 		_spec.AddField(lead.FieldQualityScore, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.CompletenessScore(); ok {
+		_spec.SetField(lead.FieldCompletenessScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCompletenessScore(); ok {
+		_spec.AddField(lead.FieldCompletenessScore, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(lead.FieldStatus, field.TypeEnum, value)
 	}
 	if value, ok := _u.mutation.StatusChangedAt(); ok {
 		_spec.SetField(lead.FieldStatusChangedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.SLAOverdueSince(); ok {
+		_spec.SetField(lead.FieldSLAOverdueSince, field.TypeTime, value)
+	}
+	if _u.mutation.SLAOverdueSinceCleared() {
+		_spec.ClearField(lead.FieldSLAOverdueSince, field.TypeTime)
+	}
 	if value, ok := _u.mutation.CustomFields(); ok {
 		_spec.SetField(lead.FieldCustomFields, field.TypeJSON, value)
 	}
 	if _u.mutation.CustomFieldsCleared() {
 		_spec.ClearField(lead.FieldCustomFields, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.Tags(); ok {
+		_spec.SetField(lead.FieldTags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, lead.FieldTags, value)
+		})
+	}
+	if _u.mutation.TagsCleared() {
+		_spec.ClearField(lead.FieldTags, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.OsmID(); ok {
 		_spec.SetField(lead.FieldOsmID, field.TypeString, value)
 	}
@@ -1146,6 +1578,21 @@ func (_u *LeadUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.MetadataCleared() {
 		_spec.ClearField(lead.FieldMetadata, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(lead.FieldSource, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.SourceID(); ok {
+		_spec.SetField(lead.FieldSourceID, field.TypeString, value)
+	}
+	if _u.mutation.SourceIDCleared() {
+		_spec.ClearField(lead.FieldSourceID, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(lead.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSeenAtCleared() {
+		_spec.ClearField(lead.FieldLastSeenAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.SubNiche(); ok {
 		_spec.SetField(lead.FieldSubNiche, field.TypeString, value)
 	}
@@ -1280,28 +1727,28 @@ func (_u *LeadUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.StatusHistoryCleared() {
+	if _u.mutation.ContactAttemptsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   lead.StatusHistoryTable,
-			Columns: []string{lead.StatusHistoryColumn},
+			Table:   lead.ContactAttemptsTable,
+			Columns: []string{lead.ContactAttemptsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(leadstatushistory.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(contactattempt.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedStatusHistoryIDs(); len(nodes) > 0 && !_u.mutation.StatusHistoryCleared() {
+	if nodes := _u.mutation.RemovedContactAttemptsIDs(); len(nodes) > 0 && !_u.mutation.ContactAttemptsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   lead.StatusHistoryTable,
-			Columns: []string{lead.StatusHistoryColumn},
+			Table:   lead.ContactAttemptsTable,
+			Columns: []string{lead.ContactAttemptsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(leadstatushistory.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(contactattempt.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1309,15 +1756,15 @@ func (_u *LeadUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.StatusHistoryIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ContactAttemptsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   lead.StatusHistoryTable,
-			Columns: []string{lead.StatusHistoryColumn},
+			Table:   lead.ContactAttemptsTable,
+			Columns: []string{lead.ContactAttemptsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(leadstatushistory.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(contactattempt.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1325,24 +1772,159 @@ func (_u *LeadUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.AssignmentsCleared() {
+	if _u.mutation.StatusHistoryCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   lead.AssignmentsTable,
-			Columns: []string{lead.AssignmentsColumn},
+			Table:   lead.StatusHistoryTable,
+			Columns: []string{lead.StatusHistoryColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(leadassignment.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(leadstatushistory.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedAssignmentsIDs(); len(nodes) > 0 && !_u.mutation.AssignmentsCleared() {
+	if nodes := _u.mutation.RemovedStatusHistoryIDs(); len(nodes) > 0 && !_u.mutation.StatusHistoryCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   lead.AssignmentsTable,
+			Table:   lead.StatusHistoryTable,
+			Columns: []string{lead.StatusHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadstatushistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StatusHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lead.StatusHistoryTable,
+			Columns: []string{lead.StatusHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadstatushistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lead.ChangesTable,
+			Columns: []string{lead.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadchange.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChangesIDs(); len(nodes) > 0 && !_u.mutation.ChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lead.ChangesTable,
+			Columns: []string{lead.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadchange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lead.ChangesTable,
+			Columns: []string{lead.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadchange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EnrichmentAttemptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lead.EnrichmentAttemptsTable,
+			Columns: []string{lead.EnrichmentAttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrichmentattempt.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEnrichmentAttemptsIDs(); len(nodes) > 0 && !_u.mutation.EnrichmentAttemptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lead.EnrichmentAttemptsTable,
+			Columns: []string{lead.EnrichmentAttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrichmentattempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EnrichmentAttemptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lead.EnrichmentAttemptsTable,
+			Columns: []string{lead.EnrichmentAttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrichmentattempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lead.AssignmentsTable,
+			Columns: []string{lead.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadassignment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAssignmentsIDs(); len(nodes) > 0 && !_u.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lead.AssignmentsTable,
 			Columns: []string{lead.AssignmentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
@@ -1624,6 +2206,126 @@ func (_u *LeadUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.VerificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lead.VerificationsTable,
+			Columns: []string{lead.VerificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadverification.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedVerificationsIDs(); len(nodes) > 0 && !_u.mutation.VerificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lead.VerificationsTable,
+			Columns: []string{lead.VerificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadverification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VerificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lead.VerificationsTable,
+			Columns: []string{lead.VerificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadverification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SuppressionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lead.SuppressionsTable,
+			Columns: []string{lead.SuppressionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadsuppression.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSuppressionsIDs(); len(nodes) > 0 && !_u.mutation.SuppressionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lead.SuppressionsTable,
+			Columns: []string{lead.SuppressionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadsuppression.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SuppressionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lead.SuppressionsTable,
+			Columns: []string{lead.SuppressionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadsuppression.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OwnerOrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lead.OwnerOrganizationTable,
+			Columns: []string{lead.OwnerOrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OwnerOrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lead.OwnerOrganizationTable,
+			Columns: []string{lead.OwnerOrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{lead.Label}
@@ -1639,9 +2341,10 @@ func (_u *LeadUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // LeadUpdateOne is the builder for updating a single Lead entity.
 type LeadUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *LeadMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *LeadMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetName sets the "name" field.
@@ -1880,6 +2583,53 @@ func (_u *LeadUpdateOne) SetNillableVerified(v *bool) *LeadUpdateOne {
 	return _u
 }
 
+// SetVerifiedSince sets the "verified_since" field.
+func (_u *LeadUpdateOne) SetVerifiedSince(v time.Time) *LeadUpdateOne {
+	_u.mutation.SetVerifiedSince(v)
+	return _u
+}
+
+// SetNillableVerifiedSince sets the "verified_since" field if the given value is not nil.
+func (_u *LeadUpdateOne) SetNillableVerifiedSince(v *time.Time) *LeadUpdateOne {
+	if v != nil {
+		_u.SetVerifiedSince(*v)
+	}
+	return _u
+}
+
+// ClearVerifiedSince clears the value of the "verified_since" field.
+func (_u *LeadUpdateOne) ClearVerifiedSince() *LeadUpdateOne {
+	_u.mutation.ClearVerifiedSince()
+	return _u
+}
+
+// SetVerifiedBy sets the "verified_by" field.
+func (_u *LeadUpdateOne) SetVerifiedBy(v int) *LeadUpdateOne {
+	_u.mutation.ResetVerifiedBy()
+	_u.mutation.SetVerifiedBy(v)
+	return _u
+}
+
+// SetNillableVerifiedBy sets the "verified_by" field if the given value is not nil.
+func (_u *LeadUpdateOne) SetNillableVerifiedBy(v *int) *LeadUpdateOne {
+	if v != nil {
+		_u.SetVerifiedBy(*v)
+	}
+	return _u
+}
+
+// AddVerifiedBy adds value to the "verified_by" field.
+func (_u *LeadUpdateOne) AddVerifiedBy(v int) *LeadUpdateOne {
+	_u.mutation.AddVerifiedBy(v)
+	return _u
+}
+
+// ClearVerifiedBy clears the value of the "verified_by" field.
+func (_u *LeadUpdateOne) ClearVerifiedBy() *LeadUpdateOne {
+	_u.mutation.ClearVerifiedBy()
+	return _u
+}
+
 // SetQualityScore sets the "quality_score" field.
 func (_u *LeadUpdateOne) SetQualityScore(v int) *LeadUpdateOne {
 	_u.mutation.ResetQualityScore()
@@ -1901,6 +2651,27 @@ func (_u *LeadUpdateOne) AddQualityScore(v int) *LeadUpdateOne {
 	return _u
 }
 
+// SetCompletenessScore sets the "completeness_score" field.
+func (_u *LeadUpdateOne) SetCompletenessScore(v int) *LeadUpdateOne {
+	_u.mutation.ResetCompletenessScore()
+	_u.mutation.SetCompletenessScore(v)
+	return _u
+}
+
+// SetNillableCompletenessScore sets the "completeness_score" field if the given value is not nil.
+func (_u *LeadUpdateOne) SetNillableCompletenessScore(v *int) *LeadUpdateOne {
+	if v != nil {
+		_u.SetCompletenessScore(*v)
+	}
+	return _u
+}
+
+// AddCompletenessScore adds value to the "completeness_score" field.
+func (_u *LeadUpdateOne) AddCompletenessScore(v int) *LeadUpdateOne {
+	_u.mutation.AddCompletenessScore(v)
+	return _u
+}
+
 // SetStatus sets the "status" field.
 func (_u *LeadUpdateOne) SetStatus(v lead.Status) *LeadUpdateOne {
 	_u.mutation.SetStatus(v)
@@ -1929,6 +2700,26 @@ func (_u *LeadUpdateOne) SetNillableStatusChangedAt(v *time.Time) *LeadUpdateOne
 	return _u
 }
 
+// SetSLAOverdueSince sets the "sla_overdue_since" field.
+func (_u *LeadUpdateOne) SetSLAOverdueSince(v time.Time) *LeadUpdateOne {
+	_u.mutation.SetSLAOverdueSince(v)
+	return _u
+}
+
+// SetNillableSLAOverdueSince sets the "sla_overdue_since" field if the given value is not nil.
+func (_u *LeadUpdateOne) SetNillableSLAOverdueSince(v *time.Time) *LeadUpdateOne {
+	if v != nil {
+		_u.SetSLAOverdueSince(*v)
+	}
+	return _u
+}
+
+// ClearSLAOverdueSince clears the value of the "sla_overdue_since" field.
+func (_u *LeadUpdateOne) ClearSLAOverdueSince() *LeadUpdateOne {
+	_u.mutation.ClearSLAOverdueSince()
+	return _u
+}
+
 // SetCustomFields sets the "custom_fields" field.
 func (_u *LeadUpdateOne) SetCustomFields(v map[string]interface{}) *LeadUpdateOne {
 	_u.mutation.SetCustomFields(v)
@@ -1941,6 +2732,44 @@ func (_u *LeadUpdateOne) ClearCustomFields() *LeadUpdateOne {
 	return _u
 }
 
+// SetTags sets the "tags" field.
+func (_u *LeadUpdateOne) SetTags(v []string) *LeadUpdateOne {
+	_u.mutation.SetTags(v)
+	return _u
+}
+
+// AppendTags appends value to the "tags" field.
+func (_u *LeadUpdateOne) AppendTags(v []string) *LeadUpdateOne {
+	_u.mutation.AppendTags(v)
+	return _u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (_u *LeadUpdateOne) ClearTags() *LeadUpdateOne {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// SetOwnerOrganizationID sets the "owner_organization_id" field.
+func (_u *LeadUpdateOne) SetOwnerOrganizationID(v int) *LeadUpdateOne {
+	_u.mutation.SetOwnerOrganizationID(v)
+	return _u
+}
+
+// SetNillableOwnerOrganizationID sets the "owner_organization_id" field if the given value is not nil.
+func (_u *LeadUpdateOne) SetNillableOwnerOrganizationID(v *int) *LeadUpdateOne {
+	if v != nil {
+		_u.SetOwnerOrganizationID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerOrganizationID clears the value of the "owner_organization_id" field.
+func (_u *LeadUpdateOne) ClearOwnerOrganizationID() *LeadUpdateOne {
+	_u.mutation.ClearOwnerOrganizationID()
+	return _u
+}
+
 // SetOsmID sets the "osm_id" field.
 func (_u *LeadUpdateOne) SetOsmID(v string) *LeadUpdateOne {
 	_u.mutation.SetOsmID(v)
@@ -1973,6 +2802,60 @@ func (_u *LeadUpdateOne) ClearMetadata() *LeadUpdateOne {
 	return _u
 }
 
+// SetSource sets the "source" field.
+func (_u *LeadUpdateOne) SetSource(v lead.Source) *LeadUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *LeadUpdateOne) SetNillableSource(v *lead.Source) *LeadUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetSourceID sets the "source_id" field.
+func (_u *LeadUpdateOne) SetSourceID(v string) *LeadUpdateOne {
+	_u.mutation.SetSourceID(v)
+	return _u
+}
+
+// SetNillableSourceID sets the "source_id" field if the given value is not nil.
+func (_u *LeadUpdateOne) SetNillableSourceID(v *string) *LeadUpdateOne {
+	if v != nil {
+		_u.SetSourceID(*v)
+	}
+	return _u
+}
+
+// ClearSourceID clears the value of the "source_id" field.
+func (_u *LeadUpdateOne) ClearSourceID() *LeadUpdateOne {
+	_u.mutation.ClearSourceID()
+	return _u
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_u *LeadUpdateOne) SetLastSeenAt(v time.Time) *LeadUpdateOne {
+	_u.mutation.SetLastSeenAt(v)
+	return _u
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_u *LeadUpdateOne) SetNillableLastSeenAt(v *time.Time) *LeadUpdateOne {
+	if v != nil {
+		_u.SetLastSeenAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (_u *LeadUpdateOne) ClearLastSeenAt() *LeadUpdateOne {
+	_u.mutation.ClearLastSeenAt()
+	return _u
+}
+
 // SetSubNiche sets the "sub_niche" field.
 func (_u *LeadUpdateOne) SetSubNiche(v string) *LeadUpdateOne {
 	_u.mutation.SetSubNiche(v)
@@ -2267,6 +3150,21 @@ func (_u *LeadUpdateOne) AddNotes(v ...*LeadNote) *LeadUpdateOne {
 	return _u.AddNoteIDs(ids...)
 }
 
+// AddContactAttemptIDs adds the "contact_attempts" edge to the ContactAttempt entity by IDs.
+func (_u *LeadUpdateOne) AddContactAttemptIDs(ids ...int) *LeadUpdateOne {
+	_u.mutation.AddContactAttemptIDs(ids...)
+	return _u
+}
+
+// AddContactAttempts adds the "contact_attempts" edges to the ContactAttempt entity.
+func (_u *LeadUpdateOne) AddContactAttempts(v ...*ContactAttempt) *LeadUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddContactAttemptIDs(ids...)
+}
+
 // AddStatusHistoryIDs adds the "status_history" edge to the LeadStatusHistory entity by IDs.
 func (_u *LeadUpdateOne) AddStatusHistoryIDs(ids ...int) *LeadUpdateOne {
 	_u.mutation.AddStatusHistoryIDs(ids...)
@@ -2282,6 +3180,36 @@ func (_u *LeadUpdateOne) AddStatusHistory(v ...*LeadStatusHistory) *LeadUpdateOn
 	return _u.AddStatusHistoryIDs(ids...)
 }
 
+// AddChangeIDs adds the "changes" edge to the LeadChange entity by IDs.
+func (_u *LeadUpdateOne) AddChangeIDs(ids ...int) *LeadUpdateOne {
+	_u.mutation.AddChangeIDs(ids...)
+	return _u
+}
+
+// AddChanges adds the "changes" edges to the LeadChange entity.
+func (_u *LeadUpdateOne) AddChanges(v ...*LeadChange) *LeadUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChangeIDs(ids...)
+}
+
+// AddEnrichmentAttemptIDs adds the "enrichment_attempts" edge to the EnrichmentAttempt entity by IDs.
+func (_u *LeadUpdateOne) AddEnrichmentAttemptIDs(ids ...int) *LeadUpdateOne {
+	_u.mutation.AddEnrichmentAttemptIDs(ids...)
+	return _u
+}
+
+// AddEnrichmentAttempts adds the "enrichment_attempts" edges to the EnrichmentAttempt entity.
+func (_u *LeadUpdateOne) AddEnrichmentAttempts(v ...*EnrichmentAttempt) *LeadUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEnrichmentAttemptIDs(ids...)
+}
+
 // AddAssignmentIDs adds the "assignments" edge to the LeadAssignment entity by IDs.
 func (_u *LeadUpdateOne) AddAssignmentIDs(ids ...int) *LeadUpdateOne {
 	_u.mutation.AddAssignmentIDs(ids...)
@@ -2391,6 +3319,41 @@ func (_u *LeadUpdateOne) AddRecommendations(v ...*LeadRecommendation) *LeadUpdat
 	return _u.AddRecommendationIDs(ids...)
 }
 
+// AddVerificationIDs adds the "verifications" edge to the LeadVerification entity by IDs.
+func (_u *LeadUpdateOne) AddVerificationIDs(ids ...int) *LeadUpdateOne {
+	_u.mutation.AddVerificationIDs(ids...)
+	return _u
+}
+
+// AddVerifications adds the "verifications" edges to the LeadVerification entity.
+func (_u *LeadUpdateOne) AddVerifications(v ...*LeadVerification) *LeadUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddVerificationIDs(ids...)
+}
+
+// AddSuppressionIDs adds the "suppressions" edge to the LeadSuppression entity by IDs.
+func (_u *LeadUpdateOne) AddSuppressionIDs(ids ...int) *LeadUpdateOne {
+	_u.mutation.AddSuppressionIDs(ids...)
+	return _u
+}
+
+// AddSuppressions adds the "suppressions" edges to the LeadSuppression entity.
+func (_u *LeadUpdateOne) AddSuppressions(v ...*LeadSuppression) *LeadUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSuppressionIDs(ids...)
+}
+
+// SetOwnerOrganization sets the "owner_organization" edge to the Organization entity.
+func (_u *LeadUpdateOne) SetOwnerOrganization(v *Organization) *LeadUpdateOne {
+	return _u.SetOwnerOrganizationID(v.ID)
+}
+
 // Mutation returns the LeadMutation object of the builder.
 func (_u *LeadUpdateOne) Mutation() *LeadMutation {
 	return _u.mutation
@@ -2402,40 +3365,103 @@ func (_u *LeadUpdateOne) ClearNotes() *LeadUpdateOne {
 	return _u
 }
 
-// RemoveNoteIDs removes the "notes" edge to LeadNote entities by IDs.
-func (_u *LeadUpdateOne) RemoveNoteIDs(ids ...int) *LeadUpdateOne {
-	_u.mutation.RemoveNoteIDs(ids...)
+// RemoveNoteIDs removes the "notes" edge to LeadNote entities by IDs.
+func (_u *LeadUpdateOne) RemoveNoteIDs(ids ...int) *LeadUpdateOne {
+	_u.mutation.RemoveNoteIDs(ids...)
+	return _u
+}
+
+// RemoveNotes removes "notes" edges to LeadNote entities.
+func (_u *LeadUpdateOne) RemoveNotes(v ...*LeadNote) *LeadUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNoteIDs(ids...)
+}
+
+// ClearContactAttempts clears all "contact_attempts" edges to the ContactAttempt entity.
+func (_u *LeadUpdateOne) ClearContactAttempts() *LeadUpdateOne {
+	_u.mutation.ClearContactAttempts()
+	return _u
+}
+
+// RemoveContactAttemptIDs removes the "contact_attempts" edge to ContactAttempt entities by IDs.
+func (_u *LeadUpdateOne) RemoveContactAttemptIDs(ids ...int) *LeadUpdateOne {
+	_u.mutation.RemoveContactAttemptIDs(ids...)
+	return _u
+}
+
+// RemoveContactAttempts removes "contact_attempts" edges to ContactAttempt entities.
+func (_u *LeadUpdateOne) RemoveContactAttempts(v ...*ContactAttempt) *LeadUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveContactAttemptIDs(ids...)
+}
+
+// ClearStatusHistory clears all "status_history" edges to the LeadStatusHistory entity.
+func (_u *LeadUpdateOne) ClearStatusHistory() *LeadUpdateOne {
+	_u.mutation.ClearStatusHistory()
+	return _u
+}
+
+// RemoveStatusHistoryIDs removes the "status_history" edge to LeadStatusHistory entities by IDs.
+func (_u *LeadUpdateOne) RemoveStatusHistoryIDs(ids ...int) *LeadUpdateOne {
+	_u.mutation.RemoveStatusHistoryIDs(ids...)
+	return _u
+}
+
+// RemoveStatusHistory removes "status_history" edges to LeadStatusHistory entities.
+func (_u *LeadUpdateOne) RemoveStatusHistory(v ...*LeadStatusHistory) *LeadUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStatusHistoryIDs(ids...)
+}
+
+// ClearChanges clears all "changes" edges to the LeadChange entity.
+func (_u *LeadUpdateOne) ClearChanges() *LeadUpdateOne {
+	_u.mutation.ClearChanges()
+	return _u
+}
+
+// RemoveChangeIDs removes the "changes" edge to LeadChange entities by IDs.
+func (_u *LeadUpdateOne) RemoveChangeIDs(ids ...int) *LeadUpdateOne {
+	_u.mutation.RemoveChangeIDs(ids...)
 	return _u
 }
 
-// RemoveNotes removes "notes" edges to LeadNote entities.
-func (_u *LeadUpdateOne) RemoveNotes(v ...*LeadNote) *LeadUpdateOne {
+// RemoveChanges removes "changes" edges to LeadChange entities.
+func (_u *LeadUpdateOne) RemoveChanges(v ...*LeadChange) *LeadUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveNoteIDs(ids...)
+	return _u.RemoveChangeIDs(ids...)
 }
 
-// ClearStatusHistory clears all "status_history" edges to the LeadStatusHistory entity.
-func (_u *LeadUpdateOne) ClearStatusHistory() *LeadUpdateOne {
-	_u.mutation.ClearStatusHistory()
+// ClearEnrichmentAttempts clears all "enrichment_attempts" edges to the EnrichmentAttempt entity.
+func (_u *LeadUpdateOne) ClearEnrichmentAttempts() *LeadUpdateOne {
+	_u.mutation.ClearEnrichmentAttempts()
 	return _u
 }
 
-// RemoveStatusHistoryIDs removes the "status_history" edge to LeadStatusHistory entities by IDs.
-func (_u *LeadUpdateOne) RemoveStatusHistoryIDs(ids ...int) *LeadUpdateOne {
-	_u.mutation.RemoveStatusHistoryIDs(ids...)
+// RemoveEnrichmentAttemptIDs removes the "enrichment_attempts" edge to EnrichmentAttempt entities by IDs.
+func (_u *LeadUpdateOne) RemoveEnrichmentAttemptIDs(ids ...int) *LeadUpdateOne {
+	_u.mutation.RemoveEnrichmentAttemptIDs(ids...)
 	return _u
 }
 
-// RemoveStatusHistory removes "status_history" edges to LeadStatusHistory entities.
-func (_u *LeadUpdateOne) RemoveStatusHistory(v ...*LeadStatusHistory) *LeadUpdateOne {
+// RemoveEnrichmentAttempts removes "enrichment_attempts" edges to EnrichmentAttempt entities.
+func (_u *LeadUpdateOne) RemoveEnrichmentAttempts(v ...*EnrichmentAttempt) *LeadUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveStatusHistoryIDs(ids...)
+	return _u.RemoveEnrichmentAttemptIDs(ids...)
 }
 
 // ClearAssignments clears all "assignments" edges to the LeadAssignment entity.
@@ -2570,6 +3596,54 @@ func (_u *LeadUpdateOne) RemoveRecommendations(v ...*LeadRecommendation) *LeadUp
 	return _u.RemoveRecommendationIDs(ids...)
 }
 
+// ClearVerifications clears all "verifications" edges to the LeadVerification entity.
+func (_u *LeadUpdateOne) ClearVerifications() *LeadUpdateOne {
+	_u.mutation.ClearVerifications()
+	return _u
+}
+
+// RemoveVerificationIDs removes the "verifications" edge to LeadVerification entities by IDs.
+func (_u *LeadUpdateOne) RemoveVerificationIDs(ids ...int) *LeadUpdateOne {
+	_u.mutation.RemoveVerificationIDs(ids...)
+	return _u
+}
+
+// RemoveVerifications removes "verifications" edges to LeadVerification entities.
+func (_u *LeadUpdateOne) RemoveVerifications(v ...*LeadVerification) *LeadUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveVerificationIDs(ids...)
+}
+
+// ClearSuppressions clears all "suppressions" edges to the LeadSuppression entity.
+func (_u *LeadUpdateOne) ClearSuppressions() *LeadUpdateOne {
+	_u.mutation.ClearSuppressions()
+	return _u
+}
+
+// RemoveSuppressionIDs removes the "suppressions" edge to LeadSuppression entities by IDs.
+func (_u *LeadUpdateOne) RemoveSuppressionIDs(ids ...int) *LeadUpdateOne {
+	_u.mutation.RemoveSuppressionIDs(ids...)
+	return _u
+}
+
+// RemoveSuppressions removes "suppressions" edges to LeadSuppression entities.
+func (_u *LeadUpdateOne) RemoveSuppressions(v ...*LeadSuppression) *LeadUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSuppressionIDs(ids...)
+}
+
+// ClearOwnerOrganization clears the "owner_organization" edge to the Organization entity.
+func (_u *LeadUpdateOne) ClearOwnerOrganization() *LeadUpdateOne {
+	_u.mutation.ClearOwnerOrganization()
+	return _u
+}
+
 // Where appends a list predicates to the LeadUpdate builder.
 func (_u *LeadUpdateOne) Where(ps ...predicate.Lead) *LeadUpdateOne {
 	_u.mutation.Where(ps...)
@@ -2646,14 +3720,30 @@ func (_u *LeadUpdateOne) check() error {
 			return &ValidationError{Name: "quality_score", err: fmt.Errorf(`ent: validator failed for field "Lead.quality_score": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.CompletenessScore(); ok {
+		if err := lead.CompletenessScoreValidator(v); err != nil {
+			return &ValidationError{Name: "completeness_score", err: fmt.Errorf(`ent: validator failed for field "Lead.completeness_score": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Status(); ok {
 		if err := lead.StatusValidator(v); err != nil {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Lead.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Source(); ok {
+		if err := lead.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "Lead.source": %w`, err)}
+		}
+	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LeadUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LeadUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LeadUpdateOne) sqlSave(ctx context.Context) (_node *Lead, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -2752,24 +3842,62 @@ func (_u *LeadUpdateOne) sqlSave(ctx context.Context) (_node *Lead, err error) {
 	if value, ok := _u.mutation.Verified(); ok {
 		_spec.SetField(lead.FieldVerified, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.VerifiedSince(); ok {
+		_spec.SetField(lead.FieldVerifiedSince, field.TypeTime, value)
+	}
+	if _u.mutation.VerifiedSinceCleared() {
+		_spec.ClearField(lead.FieldVerifiedSince, field.TypeTime)
+	}
+	if value, ok := _u.mutation.VerifiedBy(); ok {
+		_spec.SetField(lead.FieldVerifiedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVerifiedBy(); ok {
+		_spec.AddField(lead.FieldVerifiedBy, field.TypeInt, value)
+	}
+	if _u.mutation.VerifiedByCleared() {
+		_spec.ClearField(lead.FieldVerifiedBy, field.TypeInt)
+	}
 	if value, ok := _u.mutation.QualityScore(); ok {
 		_spec.SetField(lead.FieldQualityScore, field.TypeInt, value)
 	}
 	if value, ok := _u.mutation.AddedQualityScore(); ok {
 		_spec.AddField(lead.FieldQualityScore, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.CompletenessScore(); ok {
+		_spec.SetField(lead.FieldCompletenessScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCompletenessScore(); ok {
+		_spec.AddField(lead.FieldCompletenessScore, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(lead.FieldStatus, field.TypeEnum, value)
 	}
 	if value, ok := _u.mutation.StatusChangedAt(); ok {
 		_spec.SetField(lead.FieldStatusChangedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.SLAOverdueSince(); ok {
+		_spec.SetField(lead.FieldSLAOverdueSince, field.TypeTime, value)
+	}
+	if _u.mutation.SLAOverdueSinceCleared() {
+		_spec.ClearField(lead.FieldSLAOverdueSince, field.TypeTime)
+	}
 	if value, ok := _u.mutation.CustomFields(); ok {
 		_spec.SetField(lead.FieldCustomFields, field.TypeJSON, value)
 	}
 	if _u.mutation.CustomFieldsCleared() {
 		_spec.ClearField(lead.FieldCustomFields, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.Tags(); ok {
+		_spec.SetField(lead.FieldTags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, lead.FieldTags, value)
+		})
+	}
+	if _u.mutation.TagsCleared() {
+		_spec.ClearField(lead.FieldTags, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.OsmID(); ok {
 		_spec.SetField(lead.FieldOsmID, field.TypeString, value)
 	}
@@ -2782,6 +3910,21 @@ func (_u *LeadUpdateOne) sqlSave(ctx context.Context) (_node *Lead, err error) {
 	if _u.mutation.MetadataCleared() {
 		_spec.ClearField(lead.FieldMetadata, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(lead.FieldSource, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.SourceID(); ok {
+		_spec.SetField(lead.FieldSourceID, field.TypeString, value)
+	}
+	if _u.mutation.SourceIDCleared() {
+		_spec.ClearField(lead.FieldSourceID, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(lead.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSeenAtCleared() {
+		_spec.ClearField(lead.FieldLastSeenAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.SubNiche(); ok {
 		_spec.SetField(lead.FieldSubNiche, field.TypeString, value)
 	}
@@ -2916,6 +4059,51 @@ func (_u *LeadUpdateOne) sqlSave(ctx context.Context) (_node *Lead, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ContactAttemptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lead.ContactAttemptsTable,
+			Columns: []string{lead.ContactAttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contactattempt.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedContactAttemptsIDs(); len(nodes) > 0 && !_u.mutation.ContactAttemptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lead.ContactAttemptsTable,
+			Columns: []string{lead.ContactAttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contactattempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ContactAttemptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lead.ContactAttemptsTable,
+			Columns: []string{lead.ContactAttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contactattempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.StatusHistoryCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -2961,6 +4149,96 @@ func (_u *LeadUpdateOne) sqlSave(ctx context.Context) (_node *Lead, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lead.ChangesTable,
+			Columns: []string{lead.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadchange.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChangesIDs(); len(nodes) > 0 && !_u.mutation.ChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lead.ChangesTable,
+			Columns: []string{lead.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadchange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lead.ChangesTable,
+			Columns: []string{lead.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadchange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EnrichmentAttemptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lead.EnrichmentAttemptsTable,
+			Columns: []string{lead.EnrichmentAttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrichmentattempt.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEnrichmentAttemptsIDs(); len(nodes) > 0 && !_u.mutation.EnrichmentAttemptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lead.EnrichmentAttemptsTable,
+			Columns: []string{lead.EnrichmentAttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrichmentattempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EnrichmentAttemptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lead.EnrichmentAttemptsTable,
+			Columns: []string{lead.EnrichmentAttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrichmentattempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.AssignmentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -3260,6 +4538,126 @@ func (_u *LeadUpdateOne) sqlSave(ctx context.Context) (_node *Lead, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.VerificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lead.VerificationsTable,
+			Columns: []string{lead.VerificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadverification.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedVerificationsIDs(); len(nodes) > 0 && !_u.mutation.VerificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lead.VerificationsTable,
+			Columns: []string{lead.VerificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadverification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VerificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lead.VerificationsTable,
+			Columns: []string{lead.VerificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadverification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SuppressionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lead.SuppressionsTable,
+			Columns: []string{lead.SuppressionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadsuppression.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSuppressionsIDs(); len(nodes) > 0 && !_u.mutation.SuppressionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lead.SuppressionsTable,
+			Columns: []string{lead.SuppressionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadsuppression.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SuppressionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lead.SuppressionsTable,
+			Columns: []string{lead.SuppressionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadsuppression.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OwnerOrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lead.OwnerOrganizationTable,
+			Columns: []string{lead.OwnerOrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OwnerOrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lead.OwnerOrganizationTable,
+			Columns: []string{lead.OwnerOrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Lead{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -25,7 +25,7 @@ type LeadAssignment struct {
 	UserID int `json:"user_id,omitempty"`
 	// ID of the user who made the assignment (null for automatic assignments)
 	AssignedByUserID *int `json:"assigned_by_user_id,omitempty"`
-	// Whether the assignment was automatic or manual
+	// Whether the assignment was automatic, manual, or claimed by the rep from a shared pool
 	AssignmentType leadassignment.AssignmentType `json:"assignment_type,omitempty"`
 	// Reason for assignment (e.g., 'round-robin', 'location match', 'manual')
 	AssignmentReason string `json:"assignment_reason,omitempty"`
@@ -33,6 +33,10 @@ type LeadAssignment struct {
 	AssignedAt time.Time `json:"assigned_at,omitempty"`
 	// Whether this is the current assignment (false if reassigned)
 	IsActive bool `json:"is_active,omitempty"`
+	// When the assignment stopped being current
+	EndedAt *time.Time `json:"ended_at,omitempty"`
+	// Why the assignment ended: reassigned, claim released, or claim expired after inactivity
+	EndReason *leadassignment.EndReason `json:"end_reason,omitempty"`
 	// Creation timestamp
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -96,9 +100,9 @@ func (*LeadAssignment) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case leadassignment.FieldID, leadassignment.FieldLeadID, leadassignment.FieldUserID, leadassignment.FieldAssignedByUserID:
 			values[i] = new(sql.NullInt64)
-		case leadassignment.FieldAssignmentType, leadassignment.FieldAssignmentReason:
+		case leadassignment.FieldAssignmentType, leadassignment.FieldAssignmentReason, leadassignment.FieldEndReason:
 			values[i] = new(sql.NullString)
-		case leadassignment.FieldAssignedAt, leadassignment.FieldCreatedAt:
+		case leadassignment.FieldAssignedAt, leadassignment.FieldEndedAt, leadassignment.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -164,6 +168,20 @@ func (_m *LeadAssignment) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.IsActive = value.Bool
 			}
+		case leadassignment.FieldEndedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ended_at", values[i])
+			} else if value.Valid {
+				_m.EndedAt = new(time.Time)
+				*_m.EndedAt = value.Time
+			}
+		case leadassignment.FieldEndReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field end_reason", values[i])
+			} else if value.Valid {
+				_m.EndReason = new(leadassignment.EndReason)
+				*_m.EndReason = leadassignment.EndReason(value.String)
+			}
 		case leadassignment.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -244,6 +262,16 @@ func (_m *LeadAssignment) String() string {
 	builder.WriteString("is_active=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsActive))
 	builder.WriteString(", ")
+	if v := _m.EndedAt; v != nil {
+		builder.WriteString("ended_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.EndReason; v != nil {
+		builder.WriteString("end_reason=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
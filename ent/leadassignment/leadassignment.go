@@ -29,6 +29,10 @@ const (
 	FieldAssignedAt = "assigned_at"
 	// FieldIsActive holds the string denoting the is_active field in the database.
 	FieldIsActive = "is_active"
+	// FieldEndedAt holds the string denoting the ended_at field in the database.
+	FieldEndedAt = "ended_at"
+	// FieldEndReason holds the string denoting the end_reason field in the database.
+	FieldEndReason = "end_reason"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// EdgeLead holds the string denoting the lead edge name in mutations.
@@ -72,6 +76,8 @@ var Columns = []string{
 	FieldAssignmentReason,
 	FieldAssignedAt,
 	FieldIsActive,
+	FieldEndedAt,
+	FieldEndReason,
 	FieldCreatedAt,
 }
 
@@ -110,6 +116,7 @@ const DefaultAssignmentType = AssignmentTypeManual
 const (
 	AssignmentTypeAuto   AssignmentType = "auto"
 	AssignmentTypeManual AssignmentType = "manual"
+	AssignmentTypeClaim  AssignmentType = "claim"
 )
 
 func (at AssignmentType) String() string {
@@ -119,13 +126,37 @@ func (at AssignmentType) String() string {
 // AssignmentTypeValidator is a validator for the "assignment_type" field enum values. It is called by the builders before save.
 func AssignmentTypeValidator(at AssignmentType) error {
 	switch at {
-	case AssignmentTypeAuto, AssignmentTypeManual:
+	case AssignmentTypeAuto, AssignmentTypeManual, AssignmentTypeClaim:
 		return nil
 	default:
 		return fmt.Errorf("leadassignment: invalid enum value for assignment_type field: %q", at)
 	}
 }
 
+// EndReason defines the type for the "end_reason" enum field.
+type EndReason string
+
+// EndReason values.
+const (
+	EndReasonReassigned EndReason = "reassigned"
+	EndReasonReleased   EndReason = "released"
+	EndReasonExpired    EndReason = "expired"
+)
+
+func (er EndReason) String() string {
+	return string(er)
+}
+
+// EndReasonValidator is a validator for the "end_reason" field enum values. It is called by the builders before save.
+func EndReasonValidator(er EndReason) error {
+	switch er {
+	case EndReasonReassigned, EndReasonReleased, EndReasonExpired:
+		return nil
+	default:
+		return fmt.Errorf("leadassignment: invalid enum value for end_reason field: %q", er)
+	}
+}
+
 // OrderOption defines the ordering options for the LeadAssignment queries.
 type OrderOption func(*sql.Selector)
 
@@ -169,6 +200,16 @@ func ByIsActive(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsActive, opts...).ToFunc()
 }
 
+// ByEndedAt orders the results by the ended_at field.
+func ByEndedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndedAt, opts...).ToFunc()
+}
+
+// ByEndReason orders the results by the end_reason field.
+func ByEndReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndReason, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
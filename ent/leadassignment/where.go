@@ -85,6 +85,11 @@ func IsActive(v bool) predicate.LeadAssignment {
 	return predicate.LeadAssignment(sql.FieldEQ(FieldIsActive, v))
 }
 
+// EndedAt applies equality check predicate on the "ended_at" field. It's identical to EndedAtEQ.
+func EndedAt(v time.Time) predicate.LeadAssignment {
+	return predicate.LeadAssignment(sql.FieldEQ(FieldEndedAt, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.LeadAssignment {
 	return predicate.LeadAssignment(sql.FieldEQ(FieldCreatedAt, v))
@@ -305,6 +310,86 @@ func IsActiveNEQ(v bool) predicate.LeadAssignment {
 	return predicate.LeadAssignment(sql.FieldNEQ(FieldIsActive, v))
 }
 
+// EndedAtEQ applies the EQ predicate on the "ended_at" field.
+func EndedAtEQ(v time.Time) predicate.LeadAssignment {
+	return predicate.LeadAssignment(sql.FieldEQ(FieldEndedAt, v))
+}
+
+// EndedAtNEQ applies the NEQ predicate on the "ended_at" field.
+func EndedAtNEQ(v time.Time) predicate.LeadAssignment {
+	return predicate.LeadAssignment(sql.FieldNEQ(FieldEndedAt, v))
+}
+
+// EndedAtIn applies the In predicate on the "ended_at" field.
+func EndedAtIn(vs ...time.Time) predicate.LeadAssignment {
+	return predicate.LeadAssignment(sql.FieldIn(FieldEndedAt, vs...))
+}
+
+// EndedAtNotIn applies the NotIn predicate on the "ended_at" field.
+func EndedAtNotIn(vs ...time.Time) predicate.LeadAssignment {
+	return predicate.LeadAssignment(sql.FieldNotIn(FieldEndedAt, vs...))
+}
+
+// EndedAtGT applies the GT predicate on the "ended_at" field.
+func EndedAtGT(v time.Time) predicate.LeadAssignment {
+	return predicate.LeadAssignment(sql.FieldGT(FieldEndedAt, v))
+}
+
+// EndedAtGTE applies the GTE predicate on the "ended_at" field.
+func EndedAtGTE(v time.Time) predicate.LeadAssignment {
+	return predicate.LeadAssignment(sql.FieldGTE(FieldEndedAt, v))
+}
+
+// EndedAtLT applies the LT predicate on the "ended_at" field.
+func EndedAtLT(v time.Time) predicate.LeadAssignment {
+	return predicate.LeadAssignment(sql.FieldLT(FieldEndedAt, v))
+}
+
+// EndedAtLTE applies the LTE predicate on the "ended_at" field.
+func EndedAtLTE(v time.Time) predicate.LeadAssignment {
+	return predicate.LeadAssignment(sql.FieldLTE(FieldEndedAt, v))
+}
+
+// EndedAtIsNil applies the IsNil predicate on the "ended_at" field.
+func EndedAtIsNil() predicate.LeadAssignment {
+	return predicate.LeadAssignment(sql.FieldIsNull(FieldEndedAt))
+}
+
+// EndedAtNotNil applies the NotNil predicate on the "ended_at" field.
+func EndedAtNotNil() predicate.LeadAssignment {
+	return predicate.LeadAssignment(sql.FieldNotNull(FieldEndedAt))
+}
+
+// EndReasonEQ applies the EQ predicate on the "end_reason" field.
+func EndReasonEQ(v EndReason) predicate.LeadAssignment {
+	return predicate.LeadAssignment(sql.FieldEQ(FieldEndReason, v))
+}
+
+// EndReasonNEQ applies the NEQ predicate on the "end_reason" field.
+func EndReasonNEQ(v EndReason) predicate.LeadAssignment {
+	return predicate.LeadAssignment(sql.FieldNEQ(FieldEndReason, v))
+}
+
+// EndReasonIn applies the In predicate on the "end_reason" field.
+func EndReasonIn(vs ...EndReason) predicate.LeadAssignment {
+	return predicate.LeadAssignment(sql.FieldIn(FieldEndReason, vs...))
+}
+
+// EndReasonNotIn applies the NotIn predicate on the "end_reason" field.
+func EndReasonNotIn(vs ...EndReason) predicate.LeadAssignment {
+	return predicate.LeadAssignment(sql.FieldNotIn(FieldEndReason, vs...))
+}
+
+// EndReasonIsNil applies the IsNil predicate on the "end_reason" field.
+func EndReasonIsNil() predicate.LeadAssignment {
+	return predicate.LeadAssignment(sql.FieldIsNull(FieldEndReason))
+}
+
+// EndReasonNotNil applies the NotNil predicate on the "end_reason" field.
+func EndReasonNotNil() predicate.LeadAssignment {
+	return predicate.LeadAssignment(sql.FieldNotNull(FieldEndReason))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.LeadAssignment {
 	return predicate.LeadAssignment(sql.FieldEQ(FieldCreatedAt, v))
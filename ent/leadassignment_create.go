@@ -104,6 +104,34 @@ func (_c *LeadAssignmentCreate) SetNillableIsActive(v *bool) *LeadAssignmentCrea
 	return _c
 }
 
+// SetEndedAt sets the "ended_at" field.
+func (_c *LeadAssignmentCreate) SetEndedAt(v time.Time) *LeadAssignmentCreate {
+	_c.mutation.SetEndedAt(v)
+	return _c
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (_c *LeadAssignmentCreate) SetNillableEndedAt(v *time.Time) *LeadAssignmentCreate {
+	if v != nil {
+		_c.SetEndedAt(*v)
+	}
+	return _c
+}
+
+// SetEndReason sets the "end_reason" field.
+func (_c *LeadAssignmentCreate) SetEndReason(v leadassignment.EndReason) *LeadAssignmentCreate {
+	_c.mutation.SetEndReason(v)
+	return _c
+}
+
+// SetNillableEndReason sets the "end_reason" field if the given value is not nil.
+func (_c *LeadAssignmentCreate) SetNillableEndReason(v *leadassignment.EndReason) *LeadAssignmentCreate {
+	if v != nil {
+		_c.SetEndReason(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *LeadAssignmentCreate) SetCreatedAt(v time.Time) *LeadAssignmentCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -237,6 +265,11 @@ func (_c *LeadAssignmentCreate) check() error {
 	if _, ok := _c.mutation.IsActive(); !ok {
 		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "LeadAssignment.is_active"`)}
 	}
+	if v, ok := _c.mutation.EndReason(); ok {
+		if err := leadassignment.EndReasonValidator(v); err != nil {
+			return &ValidationError{Name: "end_reason", err: fmt.Errorf(`ent: validator failed for field "LeadAssignment.end_reason": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LeadAssignment.created_at"`)}
 	}
@@ -288,6 +321,14 @@ func (_c *LeadAssignmentCreate) createSpec() (*LeadAssignment, *sqlgraph.CreateS
 		_spec.SetField(leadassignment.FieldIsActive, field.TypeBool, value)
 		_node.IsActive = value
 	}
+	if value, ok := _c.mutation.EndedAt(); ok {
+		_spec.SetField(leadassignment.FieldEndedAt, field.TypeTime, value)
+		_node.EndedAt = &value
+	}
+	if value, ok := _c.mutation.EndReason(); ok {
+		_spec.SetField(leadassignment.FieldEndReason, field.TypeEnum, value)
+		_node.EndReason = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(leadassignment.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
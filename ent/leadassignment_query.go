@@ -27,6 +27,7 @@ type LeadAssignmentQuery struct {
 	withLead       *LeadQuery
 	withUser       *UserQuery
 	withAssignedBy *UserQuery
+	modifiers      []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -325,8 +326,9 @@ func (_q *LeadAssignmentQuery) Clone() *LeadAssignmentQuery {
 		withUser:       _q.withUser.Clone(),
 		withAssignedBy: _q.withAssignedBy.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -456,6 +458,9 @@ func (_q *LeadAssignmentQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -579,6 +584,9 @@ func (_q *LeadAssignmentQuery) loadAssignedBy(ctx context.Context, query *UserQu
 
 func (_q *LeadAssignmentQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -650,6 +658,9 @@ func (_q *LeadAssignmentQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -667,6 +678,12 @@ func (_q *LeadAssignmentQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *LeadAssignmentQuery) Modify(modifiers ...func(s *sql.Selector)) *LeadAssignmentSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // LeadAssignmentGroupBy is the group-by builder for LeadAssignment entities.
 type LeadAssignmentGroupBy struct {
 	selector
@@ -756,3 +773,9 @@ func (_s *LeadAssignmentSelect) sqlScan(ctx context.Context, root *LeadAssignmen
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *LeadAssignmentSelect) Modify(modifiers ...func(s *sql.Selector)) *LeadAssignmentSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
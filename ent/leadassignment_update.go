@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -19,8 +20,9 @@ import (
 // LeadAssignmentUpdate is the builder for updating LeadAssignment entities.
 type LeadAssignmentUpdate struct {
 	config
-	hooks    []Hook
-	mutation *LeadAssignmentMutation
+	hooks     []Hook
+	mutation  *LeadAssignmentMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the LeadAssignmentUpdate builder.
@@ -125,6 +127,46 @@ func (_u *LeadAssignmentUpdate) SetNillableIsActive(v *bool) *LeadAssignmentUpda
 	return _u
 }
 
+// SetEndedAt sets the "ended_at" field.
+func (_u *LeadAssignmentUpdate) SetEndedAt(v time.Time) *LeadAssignmentUpdate {
+	_u.mutation.SetEndedAt(v)
+	return _u
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (_u *LeadAssignmentUpdate) SetNillableEndedAt(v *time.Time) *LeadAssignmentUpdate {
+	if v != nil {
+		_u.SetEndedAt(*v)
+	}
+	return _u
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (_u *LeadAssignmentUpdate) ClearEndedAt() *LeadAssignmentUpdate {
+	_u.mutation.ClearEndedAt()
+	return _u
+}
+
+// SetEndReason sets the "end_reason" field.
+func (_u *LeadAssignmentUpdate) SetEndReason(v leadassignment.EndReason) *LeadAssignmentUpdate {
+	_u.mutation.SetEndReason(v)
+	return _u
+}
+
+// SetNillableEndReason sets the "end_reason" field if the given value is not nil.
+func (_u *LeadAssignmentUpdate) SetNillableEndReason(v *leadassignment.EndReason) *LeadAssignmentUpdate {
+	if v != nil {
+		_u.SetEndReason(*v)
+	}
+	return _u
+}
+
+// ClearEndReason clears the value of the "end_reason" field.
+func (_u *LeadAssignmentUpdate) ClearEndReason() *LeadAssignmentUpdate {
+	_u.mutation.ClearEndReason()
+	return _u
+}
+
 // SetLead sets the "lead" edge to the Lead entity.
 func (_u *LeadAssignmentUpdate) SetLead(v *Lead) *LeadAssignmentUpdate {
 	return _u.SetLeadID(v.ID)
@@ -226,6 +268,11 @@ func (_u *LeadAssignmentUpdate) check() error {
 			return &ValidationError{Name: "assignment_reason", err: fmt.Errorf(`ent: validator failed for field "LeadAssignment.assignment_reason": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.EndReason(); ok {
+		if err := leadassignment.EndReasonValidator(v); err != nil {
+			return &ValidationError{Name: "end_reason", err: fmt.Errorf(`ent: validator failed for field "LeadAssignment.end_reason": %w`, err)}
+		}
+	}
 	if _u.mutation.LeadCleared() && len(_u.mutation.LeadIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "LeadAssignment.lead"`)
 	}
@@ -235,6 +282,12 @@ func (_u *LeadAssignmentUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LeadAssignmentUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LeadAssignmentUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LeadAssignmentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -259,6 +312,18 @@ func (_u *LeadAssignmentUpdate) sqlSave(ctx context.Context) (_node int, err err
 	if value, ok := _u.mutation.IsActive(); ok {
 		_spec.SetField(leadassignment.FieldIsActive, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.EndedAt(); ok {
+		_spec.SetField(leadassignment.FieldEndedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EndedAtCleared() {
+		_spec.ClearField(leadassignment.FieldEndedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.EndReason(); ok {
+		_spec.SetField(leadassignment.FieldEndReason, field.TypeEnum, value)
+	}
+	if _u.mutation.EndReasonCleared() {
+		_spec.ClearField(leadassignment.FieldEndReason, field.TypeEnum)
+	}
 	if _u.mutation.LeadCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -346,6 +411,7 @@ func (_u *LeadAssignmentUpdate) sqlSave(ctx context.Context) (_node int, err err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{leadassignment.Label}
@@ -361,9 +427,10 @@ func (_u *LeadAssignmentUpdate) sqlSave(ctx context.Context) (_node int, err err
 // LeadAssignmentUpdateOne is the builder for updating a single LeadAssignment entity.
 type LeadAssignmentUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *LeadAssignmentMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *LeadAssignmentMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetLeadID sets the "lead_id" field.
@@ -462,6 +529,46 @@ func (_u *LeadAssignmentUpdateOne) SetNillableIsActive(v *bool) *LeadAssignmentU
 	return _u
 }
 
+// SetEndedAt sets the "ended_at" field.
+func (_u *LeadAssignmentUpdateOne) SetEndedAt(v time.Time) *LeadAssignmentUpdateOne {
+	_u.mutation.SetEndedAt(v)
+	return _u
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (_u *LeadAssignmentUpdateOne) SetNillableEndedAt(v *time.Time) *LeadAssignmentUpdateOne {
+	if v != nil {
+		_u.SetEndedAt(*v)
+	}
+	return _u
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (_u *LeadAssignmentUpdateOne) ClearEndedAt() *LeadAssignmentUpdateOne {
+	_u.mutation.ClearEndedAt()
+	return _u
+}
+
+// SetEndReason sets the "end_reason" field.
+func (_u *LeadAssignmentUpdateOne) SetEndReason(v leadassignment.EndReason) *LeadAssignmentUpdateOne {
+	_u.mutation.SetEndReason(v)
+	return _u
+}
+
+// SetNillableEndReason sets the "end_reason" field if the given value is not nil.
+func (_u *LeadAssignmentUpdateOne) SetNillableEndReason(v *leadassignment.EndReason) *LeadAssignmentUpdateOne {
+	if v != nil {
+		_u.SetEndReason(*v)
+	}
+	return _u
+}
+
+// ClearEndReason clears the value of the "end_reason" field.
+func (_u *LeadAssignmentUpdateOne) ClearEndReason() *LeadAssignmentUpdateOne {
+	_u.mutation.ClearEndReason()
+	return _u
+}
+
 // SetLead sets the "lead" edge to the Lead entity.
 func (_u *LeadAssignmentUpdateOne) SetLead(v *Lead) *LeadAssignmentUpdateOne {
 	return _u.SetLeadID(v.ID)
@@ -576,6 +683,11 @@ func (_u *LeadAssignmentUpdateOne) check() error {
 			return &ValidationError{Name: "assignment_reason", err: fmt.Errorf(`ent: validator failed for field "LeadAssignment.assignment_reason": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.EndReason(); ok {
+		if err := leadassignment.EndReasonValidator(v); err != nil {
+			return &ValidationError{Name: "end_reason", err: fmt.Errorf(`ent: validator failed for field "LeadAssignment.end_reason": %w`, err)}
+		}
+	}
 	if _u.mutation.LeadCleared() && len(_u.mutation.LeadIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "LeadAssignment.lead"`)
 	}
@@ -585,6 +697,12 @@ func (_u *LeadAssignmentUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LeadAssignmentUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LeadAssignmentUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LeadAssignmentUpdateOne) sqlSave(ctx context.Context) (_node *LeadAssignment, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -626,6 +744,18 @@ func (_u *LeadAssignmentUpdateOne) sqlSave(ctx context.Context) (_node *LeadAssi
 	if value, ok := _u.mutation.IsActive(); ok {
 		_spec.SetField(leadassignment.FieldIsActive, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.EndedAt(); ok {
+		_spec.SetField(leadassignment.FieldEndedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EndedAtCleared() {
+		_spec.ClearField(leadassignment.FieldEndedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.EndReason(); ok {
+		_spec.SetField(leadassignment.FieldEndReason, field.TypeEnum, value)
+	}
+	if _u.mutation.EndReasonCleared() {
+		_spec.ClearField(leadassignment.FieldEndReason, field.TypeEnum)
+	}
 	if _u.mutation.LeadCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -713,6 +843,7 @@ func (_u *LeadAssignmentUpdateOne) sqlSave(ctx context.Context) (_node *LeadAssi
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &LeadAssignment{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,310 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/leadchange"
+	"github.com/jordanlanch/industrydb/ent/user"
+)
+
+// LeadChangeCreate is the builder for creating a LeadChange entity.
+type LeadChangeCreate struct {
+	config
+	mutation *LeadChangeMutation
+	hooks    []Hook
+}
+
+// SetLeadID sets the "lead_id" field.
+func (_c *LeadChangeCreate) SetLeadID(v int) *LeadChangeCreate {
+	_c.mutation.SetLeadID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *LeadChangeCreate) SetUserID(v int) *LeadChangeCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *LeadChangeCreate) SetNillableUserID(v *int) *LeadChangeCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *LeadChangeCreate) SetSource(v leadchange.Source) *LeadChangeCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetChanges sets the "changes" field.
+func (_c *LeadChangeCreate) SetChanges(v map[string][]interface{}) *LeadChangeCreate {
+	_c.mutation.SetChanges(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LeadChangeCreate) SetCreatedAt(v time.Time) *LeadChangeCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LeadChangeCreate) SetNillableCreatedAt(v *time.Time) *LeadChangeCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetLead sets the "lead" edge to the Lead entity.
+func (_c *LeadChangeCreate) SetLead(v *Lead) *LeadChangeCreate {
+	return _c.SetLeadID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *LeadChangeCreate) SetUser(v *User) *LeadChangeCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the LeadChangeMutation object of the builder.
+func (_c *LeadChangeCreate) Mutation() *LeadChangeMutation {
+	return _c.mutation
+}
+
+// Save creates the LeadChange in the database.
+func (_c *LeadChangeCreate) Save(ctx context.Context) (*LeadChange, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LeadChangeCreate) SaveX(ctx context.Context) *LeadChange {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LeadChangeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LeadChangeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LeadChangeCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := leadchange.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LeadChangeCreate) check() error {
+	if _, ok := _c.mutation.LeadID(); !ok {
+		return &ValidationError{Name: "lead_id", err: errors.New(`ent: missing required field "LeadChange.lead_id"`)}
+	}
+	if v, ok := _c.mutation.LeadID(); ok {
+		if err := leadchange.LeadIDValidator(v); err != nil {
+			return &ValidationError{Name: "lead_id", err: fmt.Errorf(`ent: validator failed for field "LeadChange.lead_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "LeadChange.source"`)}
+	}
+	if v, ok := _c.mutation.Source(); ok {
+		if err := leadchange.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "LeadChange.source": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Changes(); !ok {
+		return &ValidationError{Name: "changes", err: errors.New(`ent: missing required field "LeadChange.changes"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LeadChange.created_at"`)}
+	}
+	if len(_c.mutation.LeadIDs()) == 0 {
+		return &ValidationError{Name: "lead", err: errors.New(`ent: missing required edge "LeadChange.lead"`)}
+	}
+	return nil
+}
+
+func (_c *LeadChangeCreate) sqlSave(ctx context.Context) (*LeadChange, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LeadChangeCreate) createSpec() (*LeadChange, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LeadChange{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(leadchange.Table, sqlgraph.NewFieldSpec(leadchange.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(leadchange.FieldSource, field.TypeEnum, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.Changes(); ok {
+		_spec.SetField(leadchange.FieldChanges, field.TypeJSON, value)
+		_node.Changes = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(leadchange.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.LeadIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadchange.LeadTable,
+			Columns: []string{leadchange.LeadColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LeadID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadchange.UserTable,
+			Columns: []string{leadchange.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LeadChangeCreateBulk is the builder for creating many LeadChange entities in bulk.
+type LeadChangeCreateBulk struct {
+	config
+	err      error
+	builders []*LeadChangeCreate
+}
+
+// Save creates the LeadChange entities in the database.
+func (_c *LeadChangeCreateBulk) Save(ctx context.Context) ([]*LeadChange, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LeadChange, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LeadChangeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LeadChangeCreateBulk) SaveX(ctx context.Context) []*LeadChange {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LeadChangeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LeadChangeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,515 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/leadchange"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+	"github.com/jordanlanch/industrydb/ent/user"
+)
+
+// LeadChangeUpdate is the builder for updating LeadChange entities.
+type LeadChangeUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *LeadChangeMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the LeadChangeUpdate builder.
+func (_u *LeadChangeUpdate) Where(ps ...predicate.LeadChange) *LeadChangeUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetLeadID sets the "lead_id" field.
+func (_u *LeadChangeUpdate) SetLeadID(v int) *LeadChangeUpdate {
+	_u.mutation.SetLeadID(v)
+	return _u
+}
+
+// SetNillableLeadID sets the "lead_id" field if the given value is not nil.
+func (_u *LeadChangeUpdate) SetNillableLeadID(v *int) *LeadChangeUpdate {
+	if v != nil {
+		_u.SetLeadID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *LeadChangeUpdate) SetUserID(v int) *LeadChangeUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *LeadChangeUpdate) SetNillableUserID(v *int) *LeadChangeUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *LeadChangeUpdate) ClearUserID() *LeadChangeUpdate {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *LeadChangeUpdate) SetSource(v leadchange.Source) *LeadChangeUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *LeadChangeUpdate) SetNillableSource(v *leadchange.Source) *LeadChangeUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetChanges sets the "changes" field.
+func (_u *LeadChangeUpdate) SetChanges(v map[string][]interface{}) *LeadChangeUpdate {
+	_u.mutation.SetChanges(v)
+	return _u
+}
+
+// SetLead sets the "lead" edge to the Lead entity.
+func (_u *LeadChangeUpdate) SetLead(v *Lead) *LeadChangeUpdate {
+	return _u.SetLeadID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *LeadChangeUpdate) SetUser(v *User) *LeadChangeUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the LeadChangeMutation object of the builder.
+func (_u *LeadChangeUpdate) Mutation() *LeadChangeMutation {
+	return _u.mutation
+}
+
+// ClearLead clears the "lead" edge to the Lead entity.
+func (_u *LeadChangeUpdate) ClearLead() *LeadChangeUpdate {
+	_u.mutation.ClearLead()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *LeadChangeUpdate) ClearUser() *LeadChangeUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LeadChangeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LeadChangeUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LeadChangeUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LeadChangeUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LeadChangeUpdate) check() error {
+	if v, ok := _u.mutation.LeadID(); ok {
+		if err := leadchange.LeadIDValidator(v); err != nil {
+			return &ValidationError{Name: "lead_id", err: fmt.Errorf(`ent: validator failed for field "LeadChange.lead_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Source(); ok {
+		if err := leadchange.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "LeadChange.source": %w`, err)}
+		}
+	}
+	if _u.mutation.LeadCleared() && len(_u.mutation.LeadIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LeadChange.lead"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LeadChangeUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LeadChangeUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LeadChangeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(leadchange.Table, leadchange.Columns, sqlgraph.NewFieldSpec(leadchange.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(leadchange.FieldSource, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Changes(); ok {
+		_spec.SetField(leadchange.FieldChanges, field.TypeJSON, value)
+	}
+	if _u.mutation.LeadCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadchange.LeadTable,
+			Columns: []string{leadchange.LeadColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LeadIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadchange.LeadTable,
+			Columns: []string{leadchange.LeadColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadchange.UserTable,
+			Columns: []string{leadchange.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadchange.UserTable,
+			Columns: []string{leadchange.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{leadchange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LeadChangeUpdateOne is the builder for updating a single LeadChange entity.
+type LeadChangeUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *LeadChangeMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetLeadID sets the "lead_id" field.
+func (_u *LeadChangeUpdateOne) SetLeadID(v int) *LeadChangeUpdateOne {
+	_u.mutation.SetLeadID(v)
+	return _u
+}
+
+// SetNillableLeadID sets the "lead_id" field if the given value is not nil.
+func (_u *LeadChangeUpdateOne) SetNillableLeadID(v *int) *LeadChangeUpdateOne {
+	if v != nil {
+		_u.SetLeadID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *LeadChangeUpdateOne) SetUserID(v int) *LeadChangeUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *LeadChangeUpdateOne) SetNillableUserID(v *int) *LeadChangeUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *LeadChangeUpdateOne) ClearUserID() *LeadChangeUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *LeadChangeUpdateOne) SetSource(v leadchange.Source) *LeadChangeUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *LeadChangeUpdateOne) SetNillableSource(v *leadchange.Source) *LeadChangeUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetChanges sets the "changes" field.
+func (_u *LeadChangeUpdateOne) SetChanges(v map[string][]interface{}) *LeadChangeUpdateOne {
+	_u.mutation.SetChanges(v)
+	return _u
+}
+
+// SetLead sets the "lead" edge to the Lead entity.
+func (_u *LeadChangeUpdateOne) SetLead(v *Lead) *LeadChangeUpdateOne {
+	return _u.SetLeadID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *LeadChangeUpdateOne) SetUser(v *User) *LeadChangeUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the LeadChangeMutation object of the builder.
+func (_u *LeadChangeUpdateOne) Mutation() *LeadChangeMutation {
+	return _u.mutation
+}
+
+// ClearLead clears the "lead" edge to the Lead entity.
+func (_u *LeadChangeUpdateOne) ClearLead() *LeadChangeUpdateOne {
+	_u.mutation.ClearLead()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *LeadChangeUpdateOne) ClearUser() *LeadChangeUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the LeadChangeUpdate builder.
+func (_u *LeadChangeUpdateOne) Where(ps ...predicate.LeadChange) *LeadChangeUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LeadChangeUpdateOne) Select(field string, fields ...string) *LeadChangeUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LeadChange entity.
+func (_u *LeadChangeUpdateOne) Save(ctx context.Context) (*LeadChange, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LeadChangeUpdateOne) SaveX(ctx context.Context) *LeadChange {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LeadChangeUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LeadChangeUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LeadChangeUpdateOne) check() error {
+	if v, ok := _u.mutation.LeadID(); ok {
+		if err := leadchange.LeadIDValidator(v); err != nil {
+			return &ValidationError{Name: "lead_id", err: fmt.Errorf(`ent: validator failed for field "LeadChange.lead_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Source(); ok {
+		if err := leadchange.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "LeadChange.source": %w`, err)}
+		}
+	}
+	if _u.mutation.LeadCleared() && len(_u.mutation.LeadIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LeadChange.lead"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LeadChangeUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LeadChangeUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LeadChangeUpdateOne) sqlSave(ctx context.Context) (_node *LeadChange, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(leadchange.Table, leadchange.Columns, sqlgraph.NewFieldSpec(leadchange.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LeadChange.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, leadchange.FieldID)
+		for _, f := range fields {
+			if !leadchange.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != leadchange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(leadchange.FieldSource, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Changes(); ok {
+		_spec.SetField(leadchange.FieldChanges, field.TypeJSON, value)
+	}
+	if _u.mutation.LeadCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadchange.LeadTable,
+			Columns: []string{leadchange.LeadColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LeadIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadchange.LeadTable,
+			Columns: []string{leadchange.LeadColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadchange.UserTable,
+			Columns: []string{leadchange.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadchange.UserTable,
+			Columns: []string{leadchange.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &LeadChange{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{leadchange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
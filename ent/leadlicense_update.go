@@ -0,0 +1,518 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/leadlicense"
+	"github.com/jordanlanch/industrydb/ent/organization"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+)
+
+// LeadLicenseUpdate is the builder for updating LeadLicense entities.
+type LeadLicenseUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *LeadLicenseMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the LeadLicenseUpdate builder.
+func (_u *LeadLicenseUpdate) Where(ps ...predicate.LeadLicense) *LeadLicenseUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_u *LeadLicenseUpdate) SetOrganizationID(v int) *LeadLicenseUpdate {
+	_u.mutation.SetOrganizationID(v)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_u *LeadLicenseUpdate) SetNillableOrganizationID(v *int) *LeadLicenseUpdate {
+	if v != nil {
+		_u.SetOrganizationID(*v)
+	}
+	return _u
+}
+
+// SetIndustry sets the "industry" field.
+func (_u *LeadLicenseUpdate) SetIndustry(v string) *LeadLicenseUpdate {
+	_u.mutation.SetIndustry(v)
+	return _u
+}
+
+// SetNillableIndustry sets the "industry" field if the given value is not nil.
+func (_u *LeadLicenseUpdate) SetNillableIndustry(v *string) *LeadLicenseUpdate {
+	if v != nil {
+		_u.SetIndustry(*v)
+	}
+	return _u
+}
+
+// ClearIndustry clears the value of the "industry" field.
+func (_u *LeadLicenseUpdate) ClearIndustry() *LeadLicenseUpdate {
+	_u.mutation.ClearIndustry()
+	return _u
+}
+
+// SetCountry sets the "country" field.
+func (_u *LeadLicenseUpdate) SetCountry(v string) *LeadLicenseUpdate {
+	_u.mutation.SetCountry(v)
+	return _u
+}
+
+// SetNillableCountry sets the "country" field if the given value is not nil.
+func (_u *LeadLicenseUpdate) SetNillableCountry(v *string) *LeadLicenseUpdate {
+	if v != nil {
+		_u.SetCountry(*v)
+	}
+	return _u
+}
+
+// ClearCountry clears the value of the "country" field.
+func (_u *LeadLicenseUpdate) ClearCountry() *LeadLicenseUpdate {
+	_u.mutation.ClearCountry()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *LeadLicenseUpdate) SetCreatedBy(v int) *LeadLicenseUpdate {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *LeadLicenseUpdate) SetNillableCreatedBy(v *int) *LeadLicenseUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *LeadLicenseUpdate) AddCreatedBy(v int) *LeadLicenseUpdate {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *LeadLicenseUpdate) ClearCreatedBy() *LeadLicenseUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_u *LeadLicenseUpdate) SetOrganization(v *Organization) *LeadLicenseUpdate {
+	return _u.SetOrganizationID(v.ID)
+}
+
+// Mutation returns the LeadLicenseMutation object of the builder.
+func (_u *LeadLicenseUpdate) Mutation() *LeadLicenseMutation {
+	return _u.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (_u *LeadLicenseUpdate) ClearOrganization() *LeadLicenseUpdate {
+	_u.mutation.ClearOrganization()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LeadLicenseUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LeadLicenseUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LeadLicenseUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LeadLicenseUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LeadLicenseUpdate) check() error {
+	if v, ok := _u.mutation.OrganizationID(); ok {
+		if err := leadlicense.OrganizationIDValidator(v); err != nil {
+			return &ValidationError{Name: "organization_id", err: fmt.Errorf(`ent: validator failed for field "LeadLicense.organization_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Country(); ok {
+		if err := leadlicense.CountryValidator(v); err != nil {
+			return &ValidationError{Name: "country", err: fmt.Errorf(`ent: validator failed for field "LeadLicense.country": %w`, err)}
+		}
+	}
+	if _u.mutation.OrganizationCleared() && len(_u.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LeadLicense.organization"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LeadLicenseUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LeadLicenseUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LeadLicenseUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(leadlicense.Table, leadlicense.Columns, sqlgraph.NewFieldSpec(leadlicense.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Industry(); ok {
+		_spec.SetField(leadlicense.FieldIndustry, field.TypeString, value)
+	}
+	if _u.mutation.IndustryCleared() {
+		_spec.ClearField(leadlicense.FieldIndustry, field.TypeString)
+	}
+	if value, ok := _u.mutation.Country(); ok {
+		_spec.SetField(leadlicense.FieldCountry, field.TypeString, value)
+	}
+	if _u.mutation.CountryCleared() {
+		_spec.ClearField(leadlicense.FieldCountry, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(leadlicense.FieldCreatedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(leadlicense.FieldCreatedBy, field.TypeInt, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(leadlicense.FieldCreatedBy, field.TypeInt)
+	}
+	if _u.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadlicense.OrganizationTable,
+			Columns: []string{leadlicense.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadlicense.OrganizationTable,
+			Columns: []string{leadlicense.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{leadlicense.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LeadLicenseUpdateOne is the builder for updating a single LeadLicense entity.
+type LeadLicenseUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *LeadLicenseMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_u *LeadLicenseUpdateOne) SetOrganizationID(v int) *LeadLicenseUpdateOne {
+	_u.mutation.SetOrganizationID(v)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_u *LeadLicenseUpdateOne) SetNillableOrganizationID(v *int) *LeadLicenseUpdateOne {
+	if v != nil {
+		_u.SetOrganizationID(*v)
+	}
+	return _u
+}
+
+// SetIndustry sets the "industry" field.
+func (_u *LeadLicenseUpdateOne) SetIndustry(v string) *LeadLicenseUpdateOne {
+	_u.mutation.SetIndustry(v)
+	return _u
+}
+
+// SetNillableIndustry sets the "industry" field if the given value is not nil.
+func (_u *LeadLicenseUpdateOne) SetNillableIndustry(v *string) *LeadLicenseUpdateOne {
+	if v != nil {
+		_u.SetIndustry(*v)
+	}
+	return _u
+}
+
+// ClearIndustry clears the value of the "industry" field.
+func (_u *LeadLicenseUpdateOne) ClearIndustry() *LeadLicenseUpdateOne {
+	_u.mutation.ClearIndustry()
+	return _u
+}
+
+// SetCountry sets the "country" field.
+func (_u *LeadLicenseUpdateOne) SetCountry(v string) *LeadLicenseUpdateOne {
+	_u.mutation.SetCountry(v)
+	return _u
+}
+
+// SetNillableCountry sets the "country" field if the given value is not nil.
+func (_u *LeadLicenseUpdateOne) SetNillableCountry(v *string) *LeadLicenseUpdateOne {
+	if v != nil {
+		_u.SetCountry(*v)
+	}
+	return _u
+}
+
+// ClearCountry clears the value of the "country" field.
+func (_u *LeadLicenseUpdateOne) ClearCountry() *LeadLicenseUpdateOne {
+	_u.mutation.ClearCountry()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *LeadLicenseUpdateOne) SetCreatedBy(v int) *LeadLicenseUpdateOne {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *LeadLicenseUpdateOne) SetNillableCreatedBy(v *int) *LeadLicenseUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *LeadLicenseUpdateOne) AddCreatedBy(v int) *LeadLicenseUpdateOne {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *LeadLicenseUpdateOne) ClearCreatedBy() *LeadLicenseUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_u *LeadLicenseUpdateOne) SetOrganization(v *Organization) *LeadLicenseUpdateOne {
+	return _u.SetOrganizationID(v.ID)
+}
+
+// Mutation returns the LeadLicenseMutation object of the builder.
+func (_u *LeadLicenseUpdateOne) Mutation() *LeadLicenseMutation {
+	return _u.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (_u *LeadLicenseUpdateOne) ClearOrganization() *LeadLicenseUpdateOne {
+	_u.mutation.ClearOrganization()
+	return _u
+}
+
+// Where appends a list predicates to the LeadLicenseUpdate builder.
+func (_u *LeadLicenseUpdateOne) Where(ps ...predicate.LeadLicense) *LeadLicenseUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LeadLicenseUpdateOne) Select(field string, fields ...string) *LeadLicenseUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LeadLicense entity.
+func (_u *LeadLicenseUpdateOne) Save(ctx context.Context) (*LeadLicense, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LeadLicenseUpdateOne) SaveX(ctx context.Context) *LeadLicense {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LeadLicenseUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LeadLicenseUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LeadLicenseUpdateOne) check() error {
+	if v, ok := _u.mutation.OrganizationID(); ok {
+		if err := leadlicense.OrganizationIDValidator(v); err != nil {
+			return &ValidationError{Name: "organization_id", err: fmt.Errorf(`ent: validator failed for field "LeadLicense.organization_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Country(); ok {
+		if err := leadlicense.CountryValidator(v); err != nil {
+			return &ValidationError{Name: "country", err: fmt.Errorf(`ent: validator failed for field "LeadLicense.country": %w`, err)}
+		}
+	}
+	if _u.mutation.OrganizationCleared() && len(_u.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LeadLicense.organization"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LeadLicenseUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LeadLicenseUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LeadLicenseUpdateOne) sqlSave(ctx context.Context) (_node *LeadLicense, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(leadlicense.Table, leadlicense.Columns, sqlgraph.NewFieldSpec(leadlicense.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LeadLicense.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, leadlicense.FieldID)
+		for _, f := range fields {
+			if !leadlicense.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != leadlicense.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Industry(); ok {
+		_spec.SetField(leadlicense.FieldIndustry, field.TypeString, value)
+	}
+	if _u.mutation.IndustryCleared() {
+		_spec.ClearField(leadlicense.FieldIndustry, field.TypeString)
+	}
+	if value, ok := _u.mutation.Country(); ok {
+		_spec.SetField(leadlicense.FieldCountry, field.TypeString, value)
+	}
+	if _u.mutation.CountryCleared() {
+		_spec.ClearField(leadlicense.FieldCountry, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(leadlicense.FieldCreatedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(leadlicense.FieldCreatedBy, field.TypeInt, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(leadlicense.FieldCreatedBy, field.TypeInt)
+	}
+	if _u.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadlicense.OrganizationTable,
+			Columns: []string{leadlicense.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadlicense.OrganizationTable,
+			Columns: []string{leadlicense.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &LeadLicense{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{leadlicense.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -27,6 +27,8 @@ type LeadNote struct {
 	Content string `json:"content,omitempty"`
 	// Whether this note is pinned to the top
 	IsPinned bool `json:"is_pinned,omitempty"`
+	// Who can read the note: its author only (private) or everyone with access to the lead (team)
+	Visibility leadnote.Visibility `json:"visibility,omitempty"`
 	// Creation timestamp
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Last update timestamp
@@ -79,7 +81,7 @@ func (*LeadNote) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case leadnote.FieldID, leadnote.FieldLeadID, leadnote.FieldUserID:
 			values[i] = new(sql.NullInt64)
-		case leadnote.FieldContent:
+		case leadnote.FieldContent, leadnote.FieldVisibility:
 			values[i] = new(sql.NullString)
 		case leadnote.FieldCreatedAt, leadnote.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -128,6 +130,12 @@ func (_m *LeadNote) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.IsPinned = value.Bool
 			}
+		case leadnote.FieldVisibility:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field visibility", values[i])
+			} else if value.Valid {
+				_m.Visibility = leadnote.Visibility(value.String)
+			}
 		case leadnote.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -198,6 +206,9 @@ func (_m *LeadNote) String() string {
 	builder.WriteString("is_pinned=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsPinned))
 	builder.WriteString(", ")
+	builder.WriteString("visibility=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Visibility))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
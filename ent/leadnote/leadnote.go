@@ -3,6 +3,7 @@
 package leadnote
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -22,6 +23,8 @@ const (
 	FieldContent = "content"
 	// FieldIsPinned holds the string denoting the is_pinned field in the database.
 	FieldIsPinned = "is_pinned"
+	// FieldVisibility holds the string denoting the visibility field in the database.
+	FieldVisibility = "visibility"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -55,6 +58,7 @@ var Columns = []string{
 	FieldUserID,
 	FieldContent,
 	FieldIsPinned,
+	FieldVisibility,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -86,6 +90,32 @@ var (
 	UpdateDefaultUpdatedAt func() time.Time
 )
 
+// Visibility defines the type for the "visibility" enum field.
+type Visibility string
+
+// VisibilityTeam is the default value of the Visibility enum.
+const DefaultVisibility = VisibilityTeam
+
+// Visibility values.
+const (
+	VisibilityPrivate Visibility = "private"
+	VisibilityTeam    Visibility = "team"
+)
+
+func (v Visibility) String() string {
+	return string(v)
+}
+
+// VisibilityValidator is a validator for the "visibility" field enum values. It is called by the builders before save.
+func VisibilityValidator(v Visibility) error {
+	switch v {
+	case VisibilityPrivate, VisibilityTeam:
+		return nil
+	default:
+		return fmt.Errorf("leadnote: invalid enum value for visibility field: %q", v)
+	}
+}
+
 // OrderOption defines the ordering options for the LeadNote queries.
 type OrderOption func(*sql.Selector)
 
@@ -114,6 +144,11 @@ func ByIsPinned(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsPinned, opts...).ToFunc()
 }
 
+// ByVisibility orders the results by the visibility field.
+func ByVisibility(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVisibility, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
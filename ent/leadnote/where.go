@@ -200,6 +200,26 @@ func IsPinnedNEQ(v bool) predicate.LeadNote {
 	return predicate.LeadNote(sql.FieldNEQ(FieldIsPinned, v))
 }
 
+// VisibilityEQ applies the EQ predicate on the "visibility" field.
+func VisibilityEQ(v Visibility) predicate.LeadNote {
+	return predicate.LeadNote(sql.FieldEQ(FieldVisibility, v))
+}
+
+// VisibilityNEQ applies the NEQ predicate on the "visibility" field.
+func VisibilityNEQ(v Visibility) predicate.LeadNote {
+	return predicate.LeadNote(sql.FieldNEQ(FieldVisibility, v))
+}
+
+// VisibilityIn applies the In predicate on the "visibility" field.
+func VisibilityIn(vs ...Visibility) predicate.LeadNote {
+	return predicate.LeadNote(sql.FieldIn(FieldVisibility, vs...))
+}
+
+// VisibilityNotIn applies the NotIn predicate on the "visibility" field.
+func VisibilityNotIn(vs ...Visibility) predicate.LeadNote {
+	return predicate.LeadNote(sql.FieldNotIn(FieldVisibility, vs...))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.LeadNote {
 	return predicate.LeadNote(sql.FieldEQ(FieldCreatedAt, v))
@@ -54,6 +54,20 @@ func (_c *LeadNoteCreate) SetNillableIsPinned(v *bool) *LeadNoteCreate {
 	return _c
 }
 
+// SetVisibility sets the "visibility" field.
+func (_c *LeadNoteCreate) SetVisibility(v leadnote.Visibility) *LeadNoteCreate {
+	_c.mutation.SetVisibility(v)
+	return _c
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_c *LeadNoteCreate) SetNillableVisibility(v *leadnote.Visibility) *LeadNoteCreate {
+	if v != nil {
+		_c.SetVisibility(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *LeadNoteCreate) SetCreatedAt(v time.Time) *LeadNoteCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -131,6 +145,10 @@ func (_c *LeadNoteCreate) defaults() {
 		v := leadnote.DefaultIsPinned
 		_c.mutation.SetIsPinned(v)
 	}
+	if _, ok := _c.mutation.Visibility(); !ok {
+		v := leadnote.DefaultVisibility
+		_c.mutation.SetVisibility(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := leadnote.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -170,6 +188,14 @@ func (_c *LeadNoteCreate) check() error {
 	if _, ok := _c.mutation.IsPinned(); !ok {
 		return &ValidationError{Name: "is_pinned", err: errors.New(`ent: missing required field "LeadNote.is_pinned"`)}
 	}
+	if _, ok := _c.mutation.Visibility(); !ok {
+		return &ValidationError{Name: "visibility", err: errors.New(`ent: missing required field "LeadNote.visibility"`)}
+	}
+	if v, ok := _c.mutation.Visibility(); ok {
+		if err := leadnote.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "LeadNote.visibility": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LeadNote.created_at"`)}
 	}
@@ -216,6 +242,10 @@ func (_c *LeadNoteCreate) createSpec() (*LeadNote, *sqlgraph.CreateSpec) {
 		_spec.SetField(leadnote.FieldIsPinned, field.TypeBool, value)
 		_node.IsPinned = value
 	}
+	if value, ok := _c.mutation.Visibility(); ok {
+		_spec.SetField(leadnote.FieldVisibility, field.TypeEnum, value)
+		_node.Visibility = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(leadnote.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -20,8 +20,9 @@ import (
 // LeadNoteUpdate is the builder for updating LeadNote entities.
 type LeadNoteUpdate struct {
 	config
-	hooks    []Hook
-	mutation *LeadNoteMutation
+	hooks     []Hook
+	mutation  *LeadNoteMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the LeadNoteUpdate builder.
@@ -86,6 +87,20 @@ func (_u *LeadNoteUpdate) SetNillableIsPinned(v *bool) *LeadNoteUpdate {
 	return _u
 }
 
+// SetVisibility sets the "visibility" field.
+func (_u *LeadNoteUpdate) SetVisibility(v leadnote.Visibility) *LeadNoteUpdate {
+	_u.mutation.SetVisibility(v)
+	return _u
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_u *LeadNoteUpdate) SetNillableVisibility(v *leadnote.Visibility) *LeadNoteUpdate {
+	if v != nil {
+		_u.SetVisibility(*v)
+	}
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *LeadNoteUpdate) SetUpdatedAt(v time.Time) *LeadNoteUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -172,6 +187,11 @@ func (_u *LeadNoteUpdate) check() error {
 			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "LeadNote.content": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Visibility(); ok {
+		if err := leadnote.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "LeadNote.visibility": %w`, err)}
+		}
+	}
 	if _u.mutation.LeadCleared() && len(_u.mutation.LeadIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "LeadNote.lead"`)
 	}
@@ -181,6 +201,12 @@ func (_u *LeadNoteUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LeadNoteUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LeadNoteUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LeadNoteUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -199,6 +225,9 @@ func (_u *LeadNoteUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.IsPinned(); ok {
 		_spec.SetField(leadnote.FieldIsPinned, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.Visibility(); ok {
+		_spec.SetField(leadnote.FieldVisibility, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(leadnote.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -260,6 +289,7 @@ func (_u *LeadNoteUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{leadnote.Label}
@@ -275,9 +305,10 @@ func (_u *LeadNoteUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // LeadNoteUpdateOne is the builder for updating a single LeadNote entity.
 type LeadNoteUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *LeadNoteMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *LeadNoteMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetLeadID sets the "lead_id" field.
@@ -336,6 +367,20 @@ func (_u *LeadNoteUpdateOne) SetNillableIsPinned(v *bool) *LeadNoteUpdateOne {
 	return _u
 }
 
+// SetVisibility sets the "visibility" field.
+func (_u *LeadNoteUpdateOne) SetVisibility(v leadnote.Visibility) *LeadNoteUpdateOne {
+	_u.mutation.SetVisibility(v)
+	return _u
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_u *LeadNoteUpdateOne) SetNillableVisibility(v *leadnote.Visibility) *LeadNoteUpdateOne {
+	if v != nil {
+		_u.SetVisibility(*v)
+	}
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *LeadNoteUpdateOne) SetUpdatedAt(v time.Time) *LeadNoteUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -435,6 +480,11 @@ func (_u *LeadNoteUpdateOne) check() error {
 			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "LeadNote.content": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Visibility(); ok {
+		if err := leadnote.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "LeadNote.visibility": %w`, err)}
+		}
+	}
 	if _u.mutation.LeadCleared() && len(_u.mutation.LeadIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "LeadNote.lead"`)
 	}
@@ -444,6 +494,12 @@ func (_u *LeadNoteUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LeadNoteUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LeadNoteUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LeadNoteUpdateOne) sqlSave(ctx context.Context) (_node *LeadNote, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -479,6 +535,9 @@ func (_u *LeadNoteUpdateOne) sqlSave(ctx context.Context) (_node *LeadNote, err
 	if value, ok := _u.mutation.IsPinned(); ok {
 		_spec.SetField(leadnote.FieldIsPinned, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.Visibility(); ok {
+		_spec.SetField(leadnote.FieldVisibility, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(leadnote.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -540,6 +599,7 @@ func (_u *LeadNoteUpdateOne) sqlSave(ctx context.Context) (_node *LeadNote, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &LeadNote{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -26,6 +26,7 @@ type LeadRecommendationQuery struct {
 	predicates []predicate.LeadRecommendation
 	withUser   *UserQuery
 	withLead   *LeadQuery
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -301,8 +302,9 @@ func (_q *LeadRecommendationQuery) Clone() *LeadRecommendationQuery {
 		withUser:   _q.withUser.Clone(),
 		withLead:   _q.withLead.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -420,6 +422,9 @@ func (_q *LeadRecommendationQuery) sqlAll(ctx context.Context, hooks ...queryHoo
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -505,6 +510,9 @@ func (_q *LeadRecommendationQuery) loadLead(ctx context.Context, query *LeadQuer
 
 func (_q *LeadRecommendationQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -573,6 +581,9 @@ func (_q *LeadRecommendationQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -590,6 +601,12 @@ func (_q *LeadRecommendationQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *LeadRecommendationQuery) Modify(modifiers ...func(s *sql.Selector)) *LeadRecommendationSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // LeadRecommendationGroupBy is the group-by builder for LeadRecommendation entities.
 type LeadRecommendationGroupBy struct {
 	selector
@@ -679,3 +696,9 @@ func (_s *LeadRecommendationSelect) sqlScan(ctx context.Context, root *LeadRecom
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *LeadRecommendationSelect) Modify(modifiers ...func(s *sql.Selector)) *LeadRecommendationSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
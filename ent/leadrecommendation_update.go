@@ -20,8 +20,9 @@ import (
 // LeadRecommendationUpdate is the builder for updating LeadRecommendation entities.
 type LeadRecommendationUpdate struct {
 	config
-	hooks    []Hook
-	mutation *LeadRecommendationMutation
+	hooks     []Hook
+	mutation  *LeadRecommendationMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the LeadRecommendationUpdate builder.
@@ -228,6 +229,12 @@ func (_u *LeadRecommendationUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LeadRecommendationUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LeadRecommendationUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LeadRecommendationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -322,6 +329,7 @@ func (_u *LeadRecommendationUpdate) sqlSave(ctx context.Context) (_node int, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{leadrecommendation.Label}
@@ -337,9 +345,10 @@ func (_u *LeadRecommendationUpdate) sqlSave(ctx context.Context) (_node int, err
 // LeadRecommendationUpdateOne is the builder for updating a single LeadRecommendation entity.
 type LeadRecommendationUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *LeadRecommendationMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *LeadRecommendationMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUserID sets the "user_id" field.
@@ -553,6 +562,12 @@ func (_u *LeadRecommendationUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LeadRecommendationUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LeadRecommendationUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LeadRecommendationUpdateOne) sqlSave(ctx context.Context) (_node *LeadRecommendation, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -664,6 +679,7 @@ func (_u *LeadRecommendationUpdateOne) sqlSave(ctx context.Context) (_node *Lead
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &LeadRecommendation{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
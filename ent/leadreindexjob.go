@@ -0,0 +1,253 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/jordanlanch/industrydb/ent/leadreindexjob"
+	"github.com/jordanlanch/industrydb/ent/user"
+)
+
+// LeadReindexJob is the model entity for the LeadReindexJob schema.
+type LeadReindexJob struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Admin who started the reindex
+	UserID int `json:"user_id,omitempty"`
+	// Reindex job status
+	Status leadreindexjob.Status `json:"status,omitempty"`
+	// Only count leads with drifted data, don't write
+	DryRun bool `json:"dry_run,omitempty"`
+	// Leads to process, counted when the job starts
+	TotalLeads int `json:"total_leads,omitempty"`
+	// Leads processed so far
+	Processed int `json:"processed,omitempty"`
+	// Leads whose derived data was (or, in a dry run, would be) updated
+	Affected int `json:"affected,omitempty"`
+	// Error message if the job failed
+	ErrorMessage string `json:"error_message,omitempty"`
+	// When processing started
+	StartedAt *time.Time `json:"started_at,omitempty"`
+	// When processing finished
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// Creation timestamp
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Last update timestamp
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LeadReindexJobQuery when eager-loading is set.
+	Edges        LeadReindexJobEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LeadReindexJobEdges holds the relations/edges for other nodes in the graph.
+type LeadReindexJobEdges struct {
+	// Admin who started the reindex
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LeadReindexJobEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LeadReindexJob) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case leadreindexjob.FieldDryRun:
+			values[i] = new(sql.NullBool)
+		case leadreindexjob.FieldID, leadreindexjob.FieldUserID, leadreindexjob.FieldTotalLeads, leadreindexjob.FieldProcessed, leadreindexjob.FieldAffected:
+			values[i] = new(sql.NullInt64)
+		case leadreindexjob.FieldStatus, leadreindexjob.FieldErrorMessage:
+			values[i] = new(sql.NullString)
+		case leadreindexjob.FieldStartedAt, leadreindexjob.FieldCompletedAt, leadreindexjob.FieldCreatedAt, leadreindexjob.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LeadReindexJob fields.
+func (_m *LeadReindexJob) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case leadreindexjob.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case leadreindexjob.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case leadreindexjob.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = leadreindexjob.Status(value.String)
+			}
+		case leadreindexjob.FieldDryRun:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field dry_run", values[i])
+			} else if value.Valid {
+				_m.DryRun = value.Bool
+			}
+		case leadreindexjob.FieldTotalLeads:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_leads", values[i])
+			} else if value.Valid {
+				_m.TotalLeads = int(value.Int64)
+			}
+		case leadreindexjob.FieldProcessed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field processed", values[i])
+			} else if value.Valid {
+				_m.Processed = int(value.Int64)
+			}
+		case leadreindexjob.FieldAffected:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field affected", values[i])
+			} else if value.Valid {
+				_m.Affected = int(value.Int64)
+			}
+		case leadreindexjob.FieldErrorMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error_message", values[i])
+			} else if value.Valid {
+				_m.ErrorMessage = value.String
+			}
+		case leadreindexjob.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				_m.StartedAt = new(time.Time)
+				*_m.StartedAt = value.Time
+			}
+		case leadreindexjob.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				_m.CompletedAt = new(time.Time)
+				*_m.CompletedAt = value.Time
+			}
+		case leadreindexjob.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case leadreindexjob.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LeadReindexJob.
+// This includes values selected through modifiers, order, etc.
+func (_m *LeadReindexJob) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the LeadReindexJob entity.
+func (_m *LeadReindexJob) QueryUser() *UserQuery {
+	return NewLeadReindexJobClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this LeadReindexJob.
+// Note that you need to call LeadReindexJob.Unwrap() before calling this method if this LeadReindexJob
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LeadReindexJob) Update() *LeadReindexJobUpdateOne {
+	return NewLeadReindexJobClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LeadReindexJob entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LeadReindexJob) Unwrap() *LeadReindexJob {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LeadReindexJob is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LeadReindexJob) String() string {
+	var builder strings.Builder
+	builder.WriteString("LeadReindexJob(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("dry_run=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DryRun))
+	builder.WriteString(", ")
+	builder.WriteString("total_leads=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalLeads))
+	builder.WriteString(", ")
+	builder.WriteString("processed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Processed))
+	builder.WriteString(", ")
+	builder.WriteString("affected=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Affected))
+	builder.WriteString(", ")
+	builder.WriteString("error_message=")
+	builder.WriteString(_m.ErrorMessage)
+	builder.WriteString(", ")
+	if v := _m.StartedAt; v != nil {
+		builder.WriteString("started_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LeadReindexJobs is a parsable slice of LeadReindexJob.
+type LeadReindexJobs []*LeadReindexJob
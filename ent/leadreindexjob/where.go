@@ -0,0 +1,569 @@
+// Code generated by ent, DO NOT EDIT.
+
+package leadreindexjob
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldEQ(FieldUserID, v))
+}
+
+// DryRun applies equality check predicate on the "dry_run" field. It's identical to DryRunEQ.
+func DryRun(v bool) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldEQ(FieldDryRun, v))
+}
+
+// TotalLeads applies equality check predicate on the "total_leads" field. It's identical to TotalLeadsEQ.
+func TotalLeads(v int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldEQ(FieldTotalLeads, v))
+}
+
+// Processed applies equality check predicate on the "processed" field. It's identical to ProcessedEQ.
+func Processed(v int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldEQ(FieldProcessed, v))
+}
+
+// Affected applies equality check predicate on the "affected" field. It's identical to AffectedEQ.
+func Affected(v int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldEQ(FieldAffected, v))
+}
+
+// ErrorMessage applies equality check predicate on the "error_message" field. It's identical to ErrorMessageEQ.
+func ErrorMessage(v string) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldEQ(FieldErrorMessage, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
+func CompletedAt(v time.Time) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// DryRunEQ applies the EQ predicate on the "dry_run" field.
+func DryRunEQ(v bool) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldEQ(FieldDryRun, v))
+}
+
+// DryRunNEQ applies the NEQ predicate on the "dry_run" field.
+func DryRunNEQ(v bool) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldNEQ(FieldDryRun, v))
+}
+
+// TotalLeadsEQ applies the EQ predicate on the "total_leads" field.
+func TotalLeadsEQ(v int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldEQ(FieldTotalLeads, v))
+}
+
+// TotalLeadsNEQ applies the NEQ predicate on the "total_leads" field.
+func TotalLeadsNEQ(v int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldNEQ(FieldTotalLeads, v))
+}
+
+// TotalLeadsIn applies the In predicate on the "total_leads" field.
+func TotalLeadsIn(vs ...int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldIn(FieldTotalLeads, vs...))
+}
+
+// TotalLeadsNotIn applies the NotIn predicate on the "total_leads" field.
+func TotalLeadsNotIn(vs ...int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldNotIn(FieldTotalLeads, vs...))
+}
+
+// TotalLeadsGT applies the GT predicate on the "total_leads" field.
+func TotalLeadsGT(v int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldGT(FieldTotalLeads, v))
+}
+
+// TotalLeadsGTE applies the GTE predicate on the "total_leads" field.
+func TotalLeadsGTE(v int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldGTE(FieldTotalLeads, v))
+}
+
+// TotalLeadsLT applies the LT predicate on the "total_leads" field.
+func TotalLeadsLT(v int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldLT(FieldTotalLeads, v))
+}
+
+// TotalLeadsLTE applies the LTE predicate on the "total_leads" field.
+func TotalLeadsLTE(v int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldLTE(FieldTotalLeads, v))
+}
+
+// ProcessedEQ applies the EQ predicate on the "processed" field.
+func ProcessedEQ(v int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldEQ(FieldProcessed, v))
+}
+
+// ProcessedNEQ applies the NEQ predicate on the "processed" field.
+func ProcessedNEQ(v int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldNEQ(FieldProcessed, v))
+}
+
+// ProcessedIn applies the In predicate on the "processed" field.
+func ProcessedIn(vs ...int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldIn(FieldProcessed, vs...))
+}
+
+// ProcessedNotIn applies the NotIn predicate on the "processed" field.
+func ProcessedNotIn(vs ...int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldNotIn(FieldProcessed, vs...))
+}
+
+// ProcessedGT applies the GT predicate on the "processed" field.
+func ProcessedGT(v int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldGT(FieldProcessed, v))
+}
+
+// ProcessedGTE applies the GTE predicate on the "processed" field.
+func ProcessedGTE(v int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldGTE(FieldProcessed, v))
+}
+
+// ProcessedLT applies the LT predicate on the "processed" field.
+func ProcessedLT(v int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldLT(FieldProcessed, v))
+}
+
+// ProcessedLTE applies the LTE predicate on the "processed" field.
+func ProcessedLTE(v int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldLTE(FieldProcessed, v))
+}
+
+// AffectedEQ applies the EQ predicate on the "affected" field.
+func AffectedEQ(v int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldEQ(FieldAffected, v))
+}
+
+// AffectedNEQ applies the NEQ predicate on the "affected" field.
+func AffectedNEQ(v int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldNEQ(FieldAffected, v))
+}
+
+// AffectedIn applies the In predicate on the "affected" field.
+func AffectedIn(vs ...int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldIn(FieldAffected, vs...))
+}
+
+// AffectedNotIn applies the NotIn predicate on the "affected" field.
+func AffectedNotIn(vs ...int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldNotIn(FieldAffected, vs...))
+}
+
+// AffectedGT applies the GT predicate on the "affected" field.
+func AffectedGT(v int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldGT(FieldAffected, v))
+}
+
+// AffectedGTE applies the GTE predicate on the "affected" field.
+func AffectedGTE(v int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldGTE(FieldAffected, v))
+}
+
+// AffectedLT applies the LT predicate on the "affected" field.
+func AffectedLT(v int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldLT(FieldAffected, v))
+}
+
+// AffectedLTE applies the LTE predicate on the "affected" field.
+func AffectedLTE(v int) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldLTE(FieldAffected, v))
+}
+
+// ErrorMessageEQ applies the EQ predicate on the "error_message" field.
+func ErrorMessageEQ(v string) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldEQ(FieldErrorMessage, v))
+}
+
+// ErrorMessageNEQ applies the NEQ predicate on the "error_message" field.
+func ErrorMessageNEQ(v string) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldNEQ(FieldErrorMessage, v))
+}
+
+// ErrorMessageIn applies the In predicate on the "error_message" field.
+func ErrorMessageIn(vs ...string) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldIn(FieldErrorMessage, vs...))
+}
+
+// ErrorMessageNotIn applies the NotIn predicate on the "error_message" field.
+func ErrorMessageNotIn(vs ...string) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldNotIn(FieldErrorMessage, vs...))
+}
+
+// ErrorMessageGT applies the GT predicate on the "error_message" field.
+func ErrorMessageGT(v string) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldGT(FieldErrorMessage, v))
+}
+
+// ErrorMessageGTE applies the GTE predicate on the "error_message" field.
+func ErrorMessageGTE(v string) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldGTE(FieldErrorMessage, v))
+}
+
+// ErrorMessageLT applies the LT predicate on the "error_message" field.
+func ErrorMessageLT(v string) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldLT(FieldErrorMessage, v))
+}
+
+// ErrorMessageLTE applies the LTE predicate on the "error_message" field.
+func ErrorMessageLTE(v string) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldLTE(FieldErrorMessage, v))
+}
+
+// ErrorMessageContains applies the Contains predicate on the "error_message" field.
+func ErrorMessageContains(v string) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldContains(FieldErrorMessage, v))
+}
+
+// ErrorMessageHasPrefix applies the HasPrefix predicate on the "error_message" field.
+func ErrorMessageHasPrefix(v string) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldHasPrefix(FieldErrorMessage, v))
+}
+
+// ErrorMessageHasSuffix applies the HasSuffix predicate on the "error_message" field.
+func ErrorMessageHasSuffix(v string) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldHasSuffix(FieldErrorMessage, v))
+}
+
+// ErrorMessageIsNil applies the IsNil predicate on the "error_message" field.
+func ErrorMessageIsNil() predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldIsNull(FieldErrorMessage))
+}
+
+// ErrorMessageNotNil applies the NotNil predicate on the "error_message" field.
+func ErrorMessageNotNil() predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldNotNull(FieldErrorMessage))
+}
+
+// ErrorMessageEqualFold applies the EqualFold predicate on the "error_message" field.
+func ErrorMessageEqualFold(v string) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldEqualFold(FieldErrorMessage, v))
+}
+
+// ErrorMessageContainsFold applies the ContainsFold predicate on the "error_message" field.
+func ErrorMessageContainsFold(v string) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldContainsFold(FieldErrorMessage, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// StartedAtIsNil applies the IsNil predicate on the "started_at" field.
+func StartedAtIsNil() predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldIsNull(FieldStartedAt))
+}
+
+// StartedAtNotNil applies the NotNil predicate on the "started_at" field.
+func StartedAtNotNil() predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldNotNull(FieldStartedAt))
+}
+
+// CompletedAtEQ applies the EQ predicate on the "completed_at" field.
+func CompletedAtEQ(v time.Time) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtNEQ applies the NEQ predicate on the "completed_at" field.
+func CompletedAtNEQ(v time.Time) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldNEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtIn applies the In predicate on the "completed_at" field.
+func CompletedAtIn(vs ...time.Time) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtNotIn applies the NotIn predicate on the "completed_at" field.
+func CompletedAtNotIn(vs ...time.Time) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldNotIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtGT applies the GT predicate on the "completed_at" field.
+func CompletedAtGT(v time.Time) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldGT(FieldCompletedAt, v))
+}
+
+// CompletedAtGTE applies the GTE predicate on the "completed_at" field.
+func CompletedAtGTE(v time.Time) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldGTE(FieldCompletedAt, v))
+}
+
+// CompletedAtLT applies the LT predicate on the "completed_at" field.
+func CompletedAtLT(v time.Time) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldLT(FieldCompletedAt, v))
+}
+
+// CompletedAtLTE applies the LTE predicate on the "completed_at" field.
+func CompletedAtLTE(v time.Time) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldLTE(FieldCompletedAt, v))
+}
+
+// CompletedAtIsNil applies the IsNil predicate on the "completed_at" field.
+func CompletedAtIsNil() predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldIsNull(FieldCompletedAt))
+}
+
+// CompletedAtNotNil applies the NotNil predicate on the "completed_at" field.
+func CompletedAtNotNil() predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldNotNull(FieldCompletedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LeadReindexJob) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LeadReindexJob) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LeadReindexJob) predicate.LeadReindexJob {
+	return predicate.LeadReindexJob(sql.NotPredicates(p))
+}
@@ -0,0 +1,466 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/leadreindexjob"
+	"github.com/jordanlanch/industrydb/ent/user"
+)
+
+// LeadReindexJobCreate is the builder for creating a LeadReindexJob entity.
+type LeadReindexJobCreate struct {
+	config
+	mutation *LeadReindexJobMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *LeadReindexJobCreate) SetUserID(v int) *LeadReindexJobCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *LeadReindexJobCreate) SetStatus(v leadreindexjob.Status) *LeadReindexJobCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *LeadReindexJobCreate) SetNillableStatus(v *leadreindexjob.Status) *LeadReindexJobCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetDryRun sets the "dry_run" field.
+func (_c *LeadReindexJobCreate) SetDryRun(v bool) *LeadReindexJobCreate {
+	_c.mutation.SetDryRun(v)
+	return _c
+}
+
+// SetNillableDryRun sets the "dry_run" field if the given value is not nil.
+func (_c *LeadReindexJobCreate) SetNillableDryRun(v *bool) *LeadReindexJobCreate {
+	if v != nil {
+		_c.SetDryRun(*v)
+	}
+	return _c
+}
+
+// SetTotalLeads sets the "total_leads" field.
+func (_c *LeadReindexJobCreate) SetTotalLeads(v int) *LeadReindexJobCreate {
+	_c.mutation.SetTotalLeads(v)
+	return _c
+}
+
+// SetNillableTotalLeads sets the "total_leads" field if the given value is not nil.
+func (_c *LeadReindexJobCreate) SetNillableTotalLeads(v *int) *LeadReindexJobCreate {
+	if v != nil {
+		_c.SetTotalLeads(*v)
+	}
+	return _c
+}
+
+// SetProcessed sets the "processed" field.
+func (_c *LeadReindexJobCreate) SetProcessed(v int) *LeadReindexJobCreate {
+	_c.mutation.SetProcessed(v)
+	return _c
+}
+
+// SetNillableProcessed sets the "processed" field if the given value is not nil.
+func (_c *LeadReindexJobCreate) SetNillableProcessed(v *int) *LeadReindexJobCreate {
+	if v != nil {
+		_c.SetProcessed(*v)
+	}
+	return _c
+}
+
+// SetAffected sets the "affected" field.
+func (_c *LeadReindexJobCreate) SetAffected(v int) *LeadReindexJobCreate {
+	_c.mutation.SetAffected(v)
+	return _c
+}
+
+// SetNillableAffected sets the "affected" field if the given value is not nil.
+func (_c *LeadReindexJobCreate) SetNillableAffected(v *int) *LeadReindexJobCreate {
+	if v != nil {
+		_c.SetAffected(*v)
+	}
+	return _c
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_c *LeadReindexJobCreate) SetErrorMessage(v string) *LeadReindexJobCreate {
+	_c.mutation.SetErrorMessage(v)
+	return _c
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_c *LeadReindexJobCreate) SetNillableErrorMessage(v *string) *LeadReindexJobCreate {
+	if v != nil {
+		_c.SetErrorMessage(*v)
+	}
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *LeadReindexJobCreate) SetStartedAt(v time.Time) *LeadReindexJobCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_c *LeadReindexJobCreate) SetNillableStartedAt(v *time.Time) *LeadReindexJobCreate {
+	if v != nil {
+		_c.SetStartedAt(*v)
+	}
+	return _c
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_c *LeadReindexJobCreate) SetCompletedAt(v time.Time) *LeadReindexJobCreate {
+	_c.mutation.SetCompletedAt(v)
+	return _c
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_c *LeadReindexJobCreate) SetNillableCompletedAt(v *time.Time) *LeadReindexJobCreate {
+	if v != nil {
+		_c.SetCompletedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LeadReindexJobCreate) SetCreatedAt(v time.Time) *LeadReindexJobCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LeadReindexJobCreate) SetNillableCreatedAt(v *time.Time) *LeadReindexJobCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *LeadReindexJobCreate) SetUpdatedAt(v time.Time) *LeadReindexJobCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *LeadReindexJobCreate) SetNillableUpdatedAt(v *time.Time) *LeadReindexJobCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *LeadReindexJobCreate) SetUser(v *User) *LeadReindexJobCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the LeadReindexJobMutation object of the builder.
+func (_c *LeadReindexJobCreate) Mutation() *LeadReindexJobMutation {
+	return _c.mutation
+}
+
+// Save creates the LeadReindexJob in the database.
+func (_c *LeadReindexJobCreate) Save(ctx context.Context) (*LeadReindexJob, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LeadReindexJobCreate) SaveX(ctx context.Context) *LeadReindexJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LeadReindexJobCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LeadReindexJobCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LeadReindexJobCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := leadreindexjob.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.DryRun(); !ok {
+		v := leadreindexjob.DefaultDryRun
+		_c.mutation.SetDryRun(v)
+	}
+	if _, ok := _c.mutation.TotalLeads(); !ok {
+		v := leadreindexjob.DefaultTotalLeads
+		_c.mutation.SetTotalLeads(v)
+	}
+	if _, ok := _c.mutation.Processed(); !ok {
+		v := leadreindexjob.DefaultProcessed
+		_c.mutation.SetProcessed(v)
+	}
+	if _, ok := _c.mutation.Affected(); !ok {
+		v := leadreindexjob.DefaultAffected
+		_c.mutation.SetAffected(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := leadreindexjob.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := leadreindexjob.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LeadReindexJobCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "LeadReindexJob.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := leadreindexjob.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "LeadReindexJob.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "LeadReindexJob.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := leadreindexjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "LeadReindexJob.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DryRun(); !ok {
+		return &ValidationError{Name: "dry_run", err: errors.New(`ent: missing required field "LeadReindexJob.dry_run"`)}
+	}
+	if _, ok := _c.mutation.TotalLeads(); !ok {
+		return &ValidationError{Name: "total_leads", err: errors.New(`ent: missing required field "LeadReindexJob.total_leads"`)}
+	}
+	if v, ok := _c.mutation.TotalLeads(); ok {
+		if err := leadreindexjob.TotalLeadsValidator(v); err != nil {
+			return &ValidationError{Name: "total_leads", err: fmt.Errorf(`ent: validator failed for field "LeadReindexJob.total_leads": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Processed(); !ok {
+		return &ValidationError{Name: "processed", err: errors.New(`ent: missing required field "LeadReindexJob.processed"`)}
+	}
+	if v, ok := _c.mutation.Processed(); ok {
+		if err := leadreindexjob.ProcessedValidator(v); err != nil {
+			return &ValidationError{Name: "processed", err: fmt.Errorf(`ent: validator failed for field "LeadReindexJob.processed": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Affected(); !ok {
+		return &ValidationError{Name: "affected", err: errors.New(`ent: missing required field "LeadReindexJob.affected"`)}
+	}
+	if v, ok := _c.mutation.Affected(); ok {
+		if err := leadreindexjob.AffectedValidator(v); err != nil {
+			return &ValidationError{Name: "affected", err: fmt.Errorf(`ent: validator failed for field "LeadReindexJob.affected": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LeadReindexJob.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "LeadReindexJob.updated_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "LeadReindexJob.user"`)}
+	}
+	return nil
+}
+
+func (_c *LeadReindexJobCreate) sqlSave(ctx context.Context) (*LeadReindexJob, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LeadReindexJobCreate) createSpec() (*LeadReindexJob, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LeadReindexJob{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(leadreindexjob.Table, sqlgraph.NewFieldSpec(leadreindexjob.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(leadreindexjob.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.DryRun(); ok {
+		_spec.SetField(leadreindexjob.FieldDryRun, field.TypeBool, value)
+		_node.DryRun = value
+	}
+	if value, ok := _c.mutation.TotalLeads(); ok {
+		_spec.SetField(leadreindexjob.FieldTotalLeads, field.TypeInt, value)
+		_node.TotalLeads = value
+	}
+	if value, ok := _c.mutation.Processed(); ok {
+		_spec.SetField(leadreindexjob.FieldProcessed, field.TypeInt, value)
+		_node.Processed = value
+	}
+	if value, ok := _c.mutation.Affected(); ok {
+		_spec.SetField(leadreindexjob.FieldAffected, field.TypeInt, value)
+		_node.Affected = value
+	}
+	if value, ok := _c.mutation.ErrorMessage(); ok {
+		_spec.SetField(leadreindexjob.FieldErrorMessage, field.TypeString, value)
+		_node.ErrorMessage = value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(leadreindexjob.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = &value
+	}
+	if value, ok := _c.mutation.CompletedAt(); ok {
+		_spec.SetField(leadreindexjob.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(leadreindexjob.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(leadreindexjob.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadreindexjob.UserTable,
+			Columns: []string{leadreindexjob.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LeadReindexJobCreateBulk is the builder for creating many LeadReindexJob entities in bulk.
+type LeadReindexJobCreateBulk struct {
+	config
+	err      error
+	builders []*LeadReindexJobCreate
+}
+
+// Save creates the LeadReindexJob entities in the database.
+func (_c *LeadReindexJobCreateBulk) Save(ctx context.Context) ([]*LeadReindexJob, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LeadReindexJob, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LeadReindexJobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LeadReindexJobCreateBulk) SaveX(ctx context.Context) []*LeadReindexJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LeadReindexJobCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LeadReindexJobCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
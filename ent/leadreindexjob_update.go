@@ -0,0 +1,795 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/leadreindexjob"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+	"github.com/jordanlanch/industrydb/ent/user"
+)
+
+// LeadReindexJobUpdate is the builder for updating LeadReindexJob entities.
+type LeadReindexJobUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *LeadReindexJobMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the LeadReindexJobUpdate builder.
+func (_u *LeadReindexJobUpdate) Where(ps ...predicate.LeadReindexJob) *LeadReindexJobUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *LeadReindexJobUpdate) SetUserID(v int) *LeadReindexJobUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *LeadReindexJobUpdate) SetNillableUserID(v *int) *LeadReindexJobUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *LeadReindexJobUpdate) SetStatus(v leadreindexjob.Status) *LeadReindexJobUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *LeadReindexJobUpdate) SetNillableStatus(v *leadreindexjob.Status) *LeadReindexJobUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetDryRun sets the "dry_run" field.
+func (_u *LeadReindexJobUpdate) SetDryRun(v bool) *LeadReindexJobUpdate {
+	_u.mutation.SetDryRun(v)
+	return _u
+}
+
+// SetNillableDryRun sets the "dry_run" field if the given value is not nil.
+func (_u *LeadReindexJobUpdate) SetNillableDryRun(v *bool) *LeadReindexJobUpdate {
+	if v != nil {
+		_u.SetDryRun(*v)
+	}
+	return _u
+}
+
+// SetTotalLeads sets the "total_leads" field.
+func (_u *LeadReindexJobUpdate) SetTotalLeads(v int) *LeadReindexJobUpdate {
+	_u.mutation.ResetTotalLeads()
+	_u.mutation.SetTotalLeads(v)
+	return _u
+}
+
+// SetNillableTotalLeads sets the "total_leads" field if the given value is not nil.
+func (_u *LeadReindexJobUpdate) SetNillableTotalLeads(v *int) *LeadReindexJobUpdate {
+	if v != nil {
+		_u.SetTotalLeads(*v)
+	}
+	return _u
+}
+
+// AddTotalLeads adds value to the "total_leads" field.
+func (_u *LeadReindexJobUpdate) AddTotalLeads(v int) *LeadReindexJobUpdate {
+	_u.mutation.AddTotalLeads(v)
+	return _u
+}
+
+// SetProcessed sets the "processed" field.
+func (_u *LeadReindexJobUpdate) SetProcessed(v int) *LeadReindexJobUpdate {
+	_u.mutation.ResetProcessed()
+	_u.mutation.SetProcessed(v)
+	return _u
+}
+
+// SetNillableProcessed sets the "processed" field if the given value is not nil.
+func (_u *LeadReindexJobUpdate) SetNillableProcessed(v *int) *LeadReindexJobUpdate {
+	if v != nil {
+		_u.SetProcessed(*v)
+	}
+	return _u
+}
+
+// AddProcessed adds value to the "processed" field.
+func (_u *LeadReindexJobUpdate) AddProcessed(v int) *LeadReindexJobUpdate {
+	_u.mutation.AddProcessed(v)
+	return _u
+}
+
+// SetAffected sets the "affected" field.
+func (_u *LeadReindexJobUpdate) SetAffected(v int) *LeadReindexJobUpdate {
+	_u.mutation.ResetAffected()
+	_u.mutation.SetAffected(v)
+	return _u
+}
+
+// SetNillableAffected sets the "affected" field if the given value is not nil.
+func (_u *LeadReindexJobUpdate) SetNillableAffected(v *int) *LeadReindexJobUpdate {
+	if v != nil {
+		_u.SetAffected(*v)
+	}
+	return _u
+}
+
+// AddAffected adds value to the "affected" field.
+func (_u *LeadReindexJobUpdate) AddAffected(v int) *LeadReindexJobUpdate {
+	_u.mutation.AddAffected(v)
+	return _u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_u *LeadReindexJobUpdate) SetErrorMessage(v string) *LeadReindexJobUpdate {
+	_u.mutation.SetErrorMessage(v)
+	return _u
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_u *LeadReindexJobUpdate) SetNillableErrorMessage(v *string) *LeadReindexJobUpdate {
+	if v != nil {
+		_u.SetErrorMessage(*v)
+	}
+	return _u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (_u *LeadReindexJobUpdate) ClearErrorMessage() *LeadReindexJobUpdate {
+	_u.mutation.ClearErrorMessage()
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *LeadReindexJobUpdate) SetStartedAt(v time.Time) *LeadReindexJobUpdate {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *LeadReindexJobUpdate) SetNillableStartedAt(v *time.Time) *LeadReindexJobUpdate {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *LeadReindexJobUpdate) ClearStartedAt() *LeadReindexJobUpdate {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *LeadReindexJobUpdate) SetCompletedAt(v time.Time) *LeadReindexJobUpdate {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *LeadReindexJobUpdate) SetNillableCompletedAt(v *time.Time) *LeadReindexJobUpdate {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *LeadReindexJobUpdate) ClearCompletedAt() *LeadReindexJobUpdate {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LeadReindexJobUpdate) SetUpdatedAt(v time.Time) *LeadReindexJobUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *LeadReindexJobUpdate) SetUser(v *User) *LeadReindexJobUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the LeadReindexJobMutation object of the builder.
+func (_u *LeadReindexJobUpdate) Mutation() *LeadReindexJobMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *LeadReindexJobUpdate) ClearUser() *LeadReindexJobUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LeadReindexJobUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LeadReindexJobUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LeadReindexJobUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LeadReindexJobUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LeadReindexJobUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := leadreindexjob.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LeadReindexJobUpdate) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := leadreindexjob.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "LeadReindexJob.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := leadreindexjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "LeadReindexJob.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TotalLeads(); ok {
+		if err := leadreindexjob.TotalLeadsValidator(v); err != nil {
+			return &ValidationError{Name: "total_leads", err: fmt.Errorf(`ent: validator failed for field "LeadReindexJob.total_leads": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Processed(); ok {
+		if err := leadreindexjob.ProcessedValidator(v); err != nil {
+			return &ValidationError{Name: "processed", err: fmt.Errorf(`ent: validator failed for field "LeadReindexJob.processed": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Affected(); ok {
+		if err := leadreindexjob.AffectedValidator(v); err != nil {
+			return &ValidationError{Name: "affected", err: fmt.Errorf(`ent: validator failed for field "LeadReindexJob.affected": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LeadReindexJob.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LeadReindexJobUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LeadReindexJobUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LeadReindexJobUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(leadreindexjob.Table, leadreindexjob.Columns, sqlgraph.NewFieldSpec(leadreindexjob.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(leadreindexjob.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.DryRun(); ok {
+		_spec.SetField(leadreindexjob.FieldDryRun, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.TotalLeads(); ok {
+		_spec.SetField(leadreindexjob.FieldTotalLeads, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalLeads(); ok {
+		_spec.AddField(leadreindexjob.FieldTotalLeads, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Processed(); ok {
+		_spec.SetField(leadreindexjob.FieldProcessed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedProcessed(); ok {
+		_spec.AddField(leadreindexjob.FieldProcessed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Affected(); ok {
+		_spec.SetField(leadreindexjob.FieldAffected, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAffected(); ok {
+		_spec.AddField(leadreindexjob.FieldAffected, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ErrorMessage(); ok {
+		_spec.SetField(leadreindexjob.FieldErrorMessage, field.TypeString, value)
+	}
+	if _u.mutation.ErrorMessageCleared() {
+		_spec.ClearField(leadreindexjob.FieldErrorMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(leadreindexjob.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(leadreindexjob.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(leadreindexjob.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(leadreindexjob.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(leadreindexjob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadreindexjob.UserTable,
+			Columns: []string{leadreindexjob.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadreindexjob.UserTable,
+			Columns: []string{leadreindexjob.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{leadreindexjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LeadReindexJobUpdateOne is the builder for updating a single LeadReindexJob entity.
+type LeadReindexJobUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *LeadReindexJobMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *LeadReindexJobUpdateOne) SetUserID(v int) *LeadReindexJobUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *LeadReindexJobUpdateOne) SetNillableUserID(v *int) *LeadReindexJobUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *LeadReindexJobUpdateOne) SetStatus(v leadreindexjob.Status) *LeadReindexJobUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *LeadReindexJobUpdateOne) SetNillableStatus(v *leadreindexjob.Status) *LeadReindexJobUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetDryRun sets the "dry_run" field.
+func (_u *LeadReindexJobUpdateOne) SetDryRun(v bool) *LeadReindexJobUpdateOne {
+	_u.mutation.SetDryRun(v)
+	return _u
+}
+
+// SetNillableDryRun sets the "dry_run" field if the given value is not nil.
+func (_u *LeadReindexJobUpdateOne) SetNillableDryRun(v *bool) *LeadReindexJobUpdateOne {
+	if v != nil {
+		_u.SetDryRun(*v)
+	}
+	return _u
+}
+
+// SetTotalLeads sets the "total_leads" field.
+func (_u *LeadReindexJobUpdateOne) SetTotalLeads(v int) *LeadReindexJobUpdateOne {
+	_u.mutation.ResetTotalLeads()
+	_u.mutation.SetTotalLeads(v)
+	return _u
+}
+
+// SetNillableTotalLeads sets the "total_leads" field if the given value is not nil.
+func (_u *LeadReindexJobUpdateOne) SetNillableTotalLeads(v *int) *LeadReindexJobUpdateOne {
+	if v != nil {
+		_u.SetTotalLeads(*v)
+	}
+	return _u
+}
+
+// AddTotalLeads adds value to the "total_leads" field.
+func (_u *LeadReindexJobUpdateOne) AddTotalLeads(v int) *LeadReindexJobUpdateOne {
+	_u.mutation.AddTotalLeads(v)
+	return _u
+}
+
+// SetProcessed sets the "processed" field.
+func (_u *LeadReindexJobUpdateOne) SetProcessed(v int) *LeadReindexJobUpdateOne {
+	_u.mutation.ResetProcessed()
+	_u.mutation.SetProcessed(v)
+	return _u
+}
+
+// SetNillableProcessed sets the "processed" field if the given value is not nil.
+func (_u *LeadReindexJobUpdateOne) SetNillableProcessed(v *int) *LeadReindexJobUpdateOne {
+	if v != nil {
+		_u.SetProcessed(*v)
+	}
+	return _u
+}
+
+// AddProcessed adds value to the "processed" field.
+func (_u *LeadReindexJobUpdateOne) AddProcessed(v int) *LeadReindexJobUpdateOne {
+	_u.mutation.AddProcessed(v)
+	return _u
+}
+
+// SetAffected sets the "affected" field.
+func (_u *LeadReindexJobUpdateOne) SetAffected(v int) *LeadReindexJobUpdateOne {
+	_u.mutation.ResetAffected()
+	_u.mutation.SetAffected(v)
+	return _u
+}
+
+// SetNillableAffected sets the "affected" field if the given value is not nil.
+func (_u *LeadReindexJobUpdateOne) SetNillableAffected(v *int) *LeadReindexJobUpdateOne {
+	if v != nil {
+		_u.SetAffected(*v)
+	}
+	return _u
+}
+
+// AddAffected adds value to the "affected" field.
+func (_u *LeadReindexJobUpdateOne) AddAffected(v int) *LeadReindexJobUpdateOne {
+	_u.mutation.AddAffected(v)
+	return _u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_u *LeadReindexJobUpdateOne) SetErrorMessage(v string) *LeadReindexJobUpdateOne {
+	_u.mutation.SetErrorMessage(v)
+	return _u
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_u *LeadReindexJobUpdateOne) SetNillableErrorMessage(v *string) *LeadReindexJobUpdateOne {
+	if v != nil {
+		_u.SetErrorMessage(*v)
+	}
+	return _u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (_u *LeadReindexJobUpdateOne) ClearErrorMessage() *LeadReindexJobUpdateOne {
+	_u.mutation.ClearErrorMessage()
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *LeadReindexJobUpdateOne) SetStartedAt(v time.Time) *LeadReindexJobUpdateOne {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *LeadReindexJobUpdateOne) SetNillableStartedAt(v *time.Time) *LeadReindexJobUpdateOne {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *LeadReindexJobUpdateOne) ClearStartedAt() *LeadReindexJobUpdateOne {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *LeadReindexJobUpdateOne) SetCompletedAt(v time.Time) *LeadReindexJobUpdateOne {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *LeadReindexJobUpdateOne) SetNillableCompletedAt(v *time.Time) *LeadReindexJobUpdateOne {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *LeadReindexJobUpdateOne) ClearCompletedAt() *LeadReindexJobUpdateOne {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LeadReindexJobUpdateOne) SetUpdatedAt(v time.Time) *LeadReindexJobUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *LeadReindexJobUpdateOne) SetUser(v *User) *LeadReindexJobUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the LeadReindexJobMutation object of the builder.
+func (_u *LeadReindexJobUpdateOne) Mutation() *LeadReindexJobMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *LeadReindexJobUpdateOne) ClearUser() *LeadReindexJobUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the LeadReindexJobUpdate builder.
+func (_u *LeadReindexJobUpdateOne) Where(ps ...predicate.LeadReindexJob) *LeadReindexJobUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LeadReindexJobUpdateOne) Select(field string, fields ...string) *LeadReindexJobUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LeadReindexJob entity.
+func (_u *LeadReindexJobUpdateOne) Save(ctx context.Context) (*LeadReindexJob, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LeadReindexJobUpdateOne) SaveX(ctx context.Context) *LeadReindexJob {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LeadReindexJobUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LeadReindexJobUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LeadReindexJobUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := leadreindexjob.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LeadReindexJobUpdateOne) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := leadreindexjob.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "LeadReindexJob.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := leadreindexjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "LeadReindexJob.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TotalLeads(); ok {
+		if err := leadreindexjob.TotalLeadsValidator(v); err != nil {
+			return &ValidationError{Name: "total_leads", err: fmt.Errorf(`ent: validator failed for field "LeadReindexJob.total_leads": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Processed(); ok {
+		if err := leadreindexjob.ProcessedValidator(v); err != nil {
+			return &ValidationError{Name: "processed", err: fmt.Errorf(`ent: validator failed for field "LeadReindexJob.processed": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Affected(); ok {
+		if err := leadreindexjob.AffectedValidator(v); err != nil {
+			return &ValidationError{Name: "affected", err: fmt.Errorf(`ent: validator failed for field "LeadReindexJob.affected": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LeadReindexJob.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LeadReindexJobUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LeadReindexJobUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LeadReindexJobUpdateOne) sqlSave(ctx context.Context) (_node *LeadReindexJob, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(leadreindexjob.Table, leadreindexjob.Columns, sqlgraph.NewFieldSpec(leadreindexjob.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LeadReindexJob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, leadreindexjob.FieldID)
+		for _, f := range fields {
+			if !leadreindexjob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != leadreindexjob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(leadreindexjob.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.DryRun(); ok {
+		_spec.SetField(leadreindexjob.FieldDryRun, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.TotalLeads(); ok {
+		_spec.SetField(leadreindexjob.FieldTotalLeads, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalLeads(); ok {
+		_spec.AddField(leadreindexjob.FieldTotalLeads, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Processed(); ok {
+		_spec.SetField(leadreindexjob.FieldProcessed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedProcessed(); ok {
+		_spec.AddField(leadreindexjob.FieldProcessed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Affected(); ok {
+		_spec.SetField(leadreindexjob.FieldAffected, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAffected(); ok {
+		_spec.AddField(leadreindexjob.FieldAffected, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ErrorMessage(); ok {
+		_spec.SetField(leadreindexjob.FieldErrorMessage, field.TypeString, value)
+	}
+	if _u.mutation.ErrorMessageCleared() {
+		_spec.ClearField(leadreindexjob.FieldErrorMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(leadreindexjob.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(leadreindexjob.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(leadreindexjob.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(leadreindexjob.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(leadreindexjob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadreindexjob.UserTable,
+			Columns: []string{leadreindexjob.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadreindexjob.UserTable,
+			Columns: []string{leadreindexjob.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &LeadReindexJob{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{leadreindexjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
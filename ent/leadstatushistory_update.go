@@ -19,8 +19,9 @@ import (
 // LeadStatusHistoryUpdate is the builder for updating LeadStatusHistory entities.
 type LeadStatusHistoryUpdate struct {
 	config
-	hooks    []Hook
-	mutation *LeadStatusHistoryMutation
+	hooks     []Hook
+	mutation  *LeadStatusHistoryMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the LeadStatusHistoryUpdate builder.
@@ -201,6 +202,12 @@ func (_u *LeadStatusHistoryUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LeadStatusHistoryUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LeadStatusHistoryUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LeadStatusHistoryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -286,6 +293,7 @@ func (_u *LeadStatusHistoryUpdate) sqlSave(ctx context.Context) (_node int, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{leadstatushistory.Label}
@@ -301,9 +309,10 @@ func (_u *LeadStatusHistoryUpdate) sqlSave(ctx context.Context) (_node int, err
 // LeadStatusHistoryUpdateOne is the builder for updating a single LeadStatusHistory entity.
 type LeadStatusHistoryUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *LeadStatusHistoryMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *LeadStatusHistoryMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetLeadID sets the "lead_id" field.
@@ -491,6 +500,12 @@ func (_u *LeadStatusHistoryUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LeadStatusHistoryUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LeadStatusHistoryUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *LeadStatusHistoryUpdateOne) sqlSave(ctx context.Context) (_node *LeadStatusHistory, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -593,6 +608,7 @@ func (_u *LeadStatusHistoryUpdateOne) sqlSave(ctx context.Context) (_node *LeadS
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &LeadStatusHistory{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
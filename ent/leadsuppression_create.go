@@ -0,0 +1,342 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/leadsuppression"
+	"github.com/jordanlanch/industrydb/ent/organization"
+	"github.com/jordanlanch/industrydb/ent/user"
+)
+
+// LeadSuppressionCreate is the builder for creating a LeadSuppression entity.
+type LeadSuppressionCreate struct {
+	config
+	mutation *LeadSuppressionMutation
+	hooks    []Hook
+}
+
+// SetLeadID sets the "lead_id" field.
+func (_c *LeadSuppressionCreate) SetLeadID(v int) *LeadSuppressionCreate {
+	_c.mutation.SetLeadID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *LeadSuppressionCreate) SetUserID(v int) *LeadSuppressionCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_c *LeadSuppressionCreate) SetOrganizationID(v int) *LeadSuppressionCreate {
+	_c.mutation.SetOrganizationID(v)
+	return _c
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_c *LeadSuppressionCreate) SetNillableOrganizationID(v *int) *LeadSuppressionCreate {
+	if v != nil {
+		_c.SetOrganizationID(*v)
+	}
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *LeadSuppressionCreate) SetReason(v string) *LeadSuppressionCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *LeadSuppressionCreate) SetNillableReason(v *string) *LeadSuppressionCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LeadSuppressionCreate) SetCreatedAt(v time.Time) *LeadSuppressionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LeadSuppressionCreate) SetNillableCreatedAt(v *time.Time) *LeadSuppressionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetLead sets the "lead" edge to the Lead entity.
+func (_c *LeadSuppressionCreate) SetLead(v *Lead) *LeadSuppressionCreate {
+	return _c.SetLeadID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *LeadSuppressionCreate) SetUser(v *User) *LeadSuppressionCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_c *LeadSuppressionCreate) SetOrganization(v *Organization) *LeadSuppressionCreate {
+	return _c.SetOrganizationID(v.ID)
+}
+
+// Mutation returns the LeadSuppressionMutation object of the builder.
+func (_c *LeadSuppressionCreate) Mutation() *LeadSuppressionMutation {
+	return _c.mutation
+}
+
+// Save creates the LeadSuppression in the database.
+func (_c *LeadSuppressionCreate) Save(ctx context.Context) (*LeadSuppression, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LeadSuppressionCreate) SaveX(ctx context.Context) *LeadSuppression {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LeadSuppressionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LeadSuppressionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LeadSuppressionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := leadsuppression.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LeadSuppressionCreate) check() error {
+	if _, ok := _c.mutation.LeadID(); !ok {
+		return &ValidationError{Name: "lead_id", err: errors.New(`ent: missing required field "LeadSuppression.lead_id"`)}
+	}
+	if v, ok := _c.mutation.LeadID(); ok {
+		if err := leadsuppression.LeadIDValidator(v); err != nil {
+			return &ValidationError{Name: "lead_id", err: fmt.Errorf(`ent: validator failed for field "LeadSuppression.lead_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "LeadSuppression.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := leadsuppression.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "LeadSuppression.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Reason(); ok {
+		if err := leadsuppression.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "LeadSuppression.reason": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LeadSuppression.created_at"`)}
+	}
+	if len(_c.mutation.LeadIDs()) == 0 {
+		return &ValidationError{Name: "lead", err: errors.New(`ent: missing required edge "LeadSuppression.lead"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "LeadSuppression.user"`)}
+	}
+	return nil
+}
+
+func (_c *LeadSuppressionCreate) sqlSave(ctx context.Context) (*LeadSuppression, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LeadSuppressionCreate) createSpec() (*LeadSuppression, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LeadSuppression{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(leadsuppression.Table, sqlgraph.NewFieldSpec(leadsuppression.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(leadsuppression.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(leadsuppression.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.LeadIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadsuppression.LeadTable,
+			Columns: []string{leadsuppression.LeadColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LeadID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadsuppression.UserTable,
+			Columns: []string{leadsuppression.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadsuppression.OrganizationTable,
+			Columns: []string{leadsuppression.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrganizationID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LeadSuppressionCreateBulk is the builder for creating many LeadSuppression entities in bulk.
+type LeadSuppressionCreateBulk struct {
+	config
+	err      error
+	builders []*LeadSuppressionCreate
+}
+
+// Save creates the LeadSuppression entities in the database.
+func (_c *LeadSuppressionCreateBulk) Save(ctx context.Context) ([]*LeadSuppression, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LeadSuppression, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LeadSuppressionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LeadSuppressionCreateBulk) SaveX(ctx context.Context) []*LeadSuppression {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LeadSuppressionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LeadSuppressionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,640 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/leadsuppression"
+	"github.com/jordanlanch/industrydb/ent/organization"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+	"github.com/jordanlanch/industrydb/ent/user"
+)
+
+// LeadSuppressionUpdate is the builder for updating LeadSuppression entities.
+type LeadSuppressionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *LeadSuppressionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the LeadSuppressionUpdate builder.
+func (_u *LeadSuppressionUpdate) Where(ps ...predicate.LeadSuppression) *LeadSuppressionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetLeadID sets the "lead_id" field.
+func (_u *LeadSuppressionUpdate) SetLeadID(v int) *LeadSuppressionUpdate {
+	_u.mutation.SetLeadID(v)
+	return _u
+}
+
+// SetNillableLeadID sets the "lead_id" field if the given value is not nil.
+func (_u *LeadSuppressionUpdate) SetNillableLeadID(v *int) *LeadSuppressionUpdate {
+	if v != nil {
+		_u.SetLeadID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *LeadSuppressionUpdate) SetUserID(v int) *LeadSuppressionUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *LeadSuppressionUpdate) SetNillableUserID(v *int) *LeadSuppressionUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_u *LeadSuppressionUpdate) SetOrganizationID(v int) *LeadSuppressionUpdate {
+	_u.mutation.SetOrganizationID(v)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_u *LeadSuppressionUpdate) SetNillableOrganizationID(v *int) *LeadSuppressionUpdate {
+	if v != nil {
+		_u.SetOrganizationID(*v)
+	}
+	return _u
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (_u *LeadSuppressionUpdate) ClearOrganizationID() *LeadSuppressionUpdate {
+	_u.mutation.ClearOrganizationID()
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *LeadSuppressionUpdate) SetReason(v string) *LeadSuppressionUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *LeadSuppressionUpdate) SetNillableReason(v *string) *LeadSuppressionUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *LeadSuppressionUpdate) ClearReason() *LeadSuppressionUpdate {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetLead sets the "lead" edge to the Lead entity.
+func (_u *LeadSuppressionUpdate) SetLead(v *Lead) *LeadSuppressionUpdate {
+	return _u.SetLeadID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *LeadSuppressionUpdate) SetUser(v *User) *LeadSuppressionUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_u *LeadSuppressionUpdate) SetOrganization(v *Organization) *LeadSuppressionUpdate {
+	return _u.SetOrganizationID(v.ID)
+}
+
+// Mutation returns the LeadSuppressionMutation object of the builder.
+func (_u *LeadSuppressionUpdate) Mutation() *LeadSuppressionMutation {
+	return _u.mutation
+}
+
+// ClearLead clears the "lead" edge to the Lead entity.
+func (_u *LeadSuppressionUpdate) ClearLead() *LeadSuppressionUpdate {
+	_u.mutation.ClearLead()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *LeadSuppressionUpdate) ClearUser() *LeadSuppressionUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (_u *LeadSuppressionUpdate) ClearOrganization() *LeadSuppressionUpdate {
+	_u.mutation.ClearOrganization()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LeadSuppressionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LeadSuppressionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LeadSuppressionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LeadSuppressionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LeadSuppressionUpdate) check() error {
+	if v, ok := _u.mutation.LeadID(); ok {
+		if err := leadsuppression.LeadIDValidator(v); err != nil {
+			return &ValidationError{Name: "lead_id", err: fmt.Errorf(`ent: validator failed for field "LeadSuppression.lead_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := leadsuppression.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "LeadSuppression.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := leadsuppression.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "LeadSuppression.reason": %w`, err)}
+		}
+	}
+	if _u.mutation.LeadCleared() && len(_u.mutation.LeadIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LeadSuppression.lead"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LeadSuppression.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LeadSuppressionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LeadSuppressionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LeadSuppressionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(leadsuppression.Table, leadsuppression.Columns, sqlgraph.NewFieldSpec(leadsuppression.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(leadsuppression.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(leadsuppression.FieldReason, field.TypeString)
+	}
+	if _u.mutation.LeadCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadsuppression.LeadTable,
+			Columns: []string{leadsuppression.LeadColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LeadIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadsuppression.LeadTable,
+			Columns: []string{leadsuppression.LeadColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadsuppression.UserTable,
+			Columns: []string{leadsuppression.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadsuppression.UserTable,
+			Columns: []string{leadsuppression.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadsuppression.OrganizationTable,
+			Columns: []string{leadsuppression.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadsuppression.OrganizationTable,
+			Columns: []string{leadsuppression.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{leadsuppression.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LeadSuppressionUpdateOne is the builder for updating a single LeadSuppression entity.
+type LeadSuppressionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *LeadSuppressionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetLeadID sets the "lead_id" field.
+func (_u *LeadSuppressionUpdateOne) SetLeadID(v int) *LeadSuppressionUpdateOne {
+	_u.mutation.SetLeadID(v)
+	return _u
+}
+
+// SetNillableLeadID sets the "lead_id" field if the given value is not nil.
+func (_u *LeadSuppressionUpdateOne) SetNillableLeadID(v *int) *LeadSuppressionUpdateOne {
+	if v != nil {
+		_u.SetLeadID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *LeadSuppressionUpdateOne) SetUserID(v int) *LeadSuppressionUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *LeadSuppressionUpdateOne) SetNillableUserID(v *int) *LeadSuppressionUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_u *LeadSuppressionUpdateOne) SetOrganizationID(v int) *LeadSuppressionUpdateOne {
+	_u.mutation.SetOrganizationID(v)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_u *LeadSuppressionUpdateOne) SetNillableOrganizationID(v *int) *LeadSuppressionUpdateOne {
+	if v != nil {
+		_u.SetOrganizationID(*v)
+	}
+	return _u
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (_u *LeadSuppressionUpdateOne) ClearOrganizationID() *LeadSuppressionUpdateOne {
+	_u.mutation.ClearOrganizationID()
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *LeadSuppressionUpdateOne) SetReason(v string) *LeadSuppressionUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *LeadSuppressionUpdateOne) SetNillableReason(v *string) *LeadSuppressionUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *LeadSuppressionUpdateOne) ClearReason() *LeadSuppressionUpdateOne {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetLead sets the "lead" edge to the Lead entity.
+func (_u *LeadSuppressionUpdateOne) SetLead(v *Lead) *LeadSuppressionUpdateOne {
+	return _u.SetLeadID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *LeadSuppressionUpdateOne) SetUser(v *User) *LeadSuppressionUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_u *LeadSuppressionUpdateOne) SetOrganization(v *Organization) *LeadSuppressionUpdateOne {
+	return _u.SetOrganizationID(v.ID)
+}
+
+// Mutation returns the LeadSuppressionMutation object of the builder.
+func (_u *LeadSuppressionUpdateOne) Mutation() *LeadSuppressionMutation {
+	return _u.mutation
+}
+
+// ClearLead clears the "lead" edge to the Lead entity.
+func (_u *LeadSuppressionUpdateOne) ClearLead() *LeadSuppressionUpdateOne {
+	_u.mutation.ClearLead()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *LeadSuppressionUpdateOne) ClearUser() *LeadSuppressionUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (_u *LeadSuppressionUpdateOne) ClearOrganization() *LeadSuppressionUpdateOne {
+	_u.mutation.ClearOrganization()
+	return _u
+}
+
+// Where appends a list predicates to the LeadSuppressionUpdate builder.
+func (_u *LeadSuppressionUpdateOne) Where(ps ...predicate.LeadSuppression) *LeadSuppressionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LeadSuppressionUpdateOne) Select(field string, fields ...string) *LeadSuppressionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LeadSuppression entity.
+func (_u *LeadSuppressionUpdateOne) Save(ctx context.Context) (*LeadSuppression, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LeadSuppressionUpdateOne) SaveX(ctx context.Context) *LeadSuppression {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LeadSuppressionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LeadSuppressionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LeadSuppressionUpdateOne) check() error {
+	if v, ok := _u.mutation.LeadID(); ok {
+		if err := leadsuppression.LeadIDValidator(v); err != nil {
+			return &ValidationError{Name: "lead_id", err: fmt.Errorf(`ent: validator failed for field "LeadSuppression.lead_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := leadsuppression.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "LeadSuppression.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := leadsuppression.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "LeadSuppression.reason": %w`, err)}
+		}
+	}
+	if _u.mutation.LeadCleared() && len(_u.mutation.LeadIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LeadSuppression.lead"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LeadSuppression.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LeadSuppressionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LeadSuppressionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LeadSuppressionUpdateOne) sqlSave(ctx context.Context) (_node *LeadSuppression, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(leadsuppression.Table, leadsuppression.Columns, sqlgraph.NewFieldSpec(leadsuppression.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LeadSuppression.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, leadsuppression.FieldID)
+		for _, f := range fields {
+			if !leadsuppression.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != leadsuppression.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(leadsuppression.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(leadsuppression.FieldReason, field.TypeString)
+	}
+	if _u.mutation.LeadCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadsuppression.LeadTable,
+			Columns: []string{leadsuppression.LeadColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LeadIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadsuppression.LeadTable,
+			Columns: []string{leadsuppression.LeadColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadsuppression.UserTable,
+			Columns: []string{leadsuppression.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadsuppression.UserTable,
+			Columns: []string{leadsuppression.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadsuppression.OrganizationTable,
+			Columns: []string{leadsuppression.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadsuppression.OrganizationTable,
+			Columns: []string{leadsuppression.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &LeadSuppression{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{leadsuppression.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,234 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/leadverification"
+	"github.com/jordanlanch/industrydb/ent/user"
+)
+
+// LeadVerification is the model entity for the LeadVerification schema.
+type LeadVerification struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ID of the lead that was verified or unverified
+	LeadID int `json:"lead_id,omitempty"`
+	// ID of the user who performed the action
+	UserID int `json:"user_id,omitempty"`
+	// Whether the lead was verified or unverified
+	Action leadverification.Action `json:"action,omitempty"`
+	// How the lead was verified (null for unverify events)
+	Method *leadverification.Method `json:"method,omitempty"`
+	// Optional evidence note (e.g., 'Spoke with owner, hours confirmed')
+	Note string `json:"note,omitempty"`
+	// Reason for unverifying (e.g., 'Phone disconnected')
+	Reason string `json:"reason,omitempty"`
+	// Hash of the lead's contact data at the time of the event
+	DataFingerprint string `json:"data_fingerprint,omitempty"`
+	// When the verification event occurred
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LeadVerificationQuery when eager-loading is set.
+	Edges        LeadVerificationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LeadVerificationEdges holds the relations/edges for other nodes in the graph.
+type LeadVerificationEdges struct {
+	// Lead holds the value of the lead edge.
+	Lead *Lead `json:"lead,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// LeadOrErr returns the Lead value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LeadVerificationEdges) LeadOrErr() (*Lead, error) {
+	if e.Lead != nil {
+		return e.Lead, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: lead.Label}
+	}
+	return nil, &NotLoadedError{edge: "lead"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LeadVerificationEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LeadVerification) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case leadverification.FieldID, leadverification.FieldLeadID, leadverification.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case leadverification.FieldAction, leadverification.FieldMethod, leadverification.FieldNote, leadverification.FieldReason, leadverification.FieldDataFingerprint:
+			values[i] = new(sql.NullString)
+		case leadverification.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LeadVerification fields.
+func (_m *LeadVerification) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case leadverification.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case leadverification.FieldLeadID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field lead_id", values[i])
+			} else if value.Valid {
+				_m.LeadID = int(value.Int64)
+			}
+		case leadverification.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case leadverification.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				_m.Action = leadverification.Action(value.String)
+			}
+		case leadverification.FieldMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field method", values[i])
+			} else if value.Valid {
+				_m.Method = new(leadverification.Method)
+				*_m.Method = leadverification.Method(value.String)
+			}
+		case leadverification.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = value.String
+			}
+		case leadverification.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case leadverification.FieldDataFingerprint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field data_fingerprint", values[i])
+			} else if value.Valid {
+				_m.DataFingerprint = value.String
+			}
+		case leadverification.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LeadVerification.
+// This includes values selected through modifiers, order, etc.
+func (_m *LeadVerification) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryLead queries the "lead" edge of the LeadVerification entity.
+func (_m *LeadVerification) QueryLead() *LeadQuery {
+	return NewLeadVerificationClient(_m.config).QueryLead(_m)
+}
+
+// QueryUser queries the "user" edge of the LeadVerification entity.
+func (_m *LeadVerification) QueryUser() *UserQuery {
+	return NewLeadVerificationClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this LeadVerification.
+// Note that you need to call LeadVerification.Unwrap() before calling this method if this LeadVerification
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LeadVerification) Update() *LeadVerificationUpdateOne {
+	return NewLeadVerificationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LeadVerification entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LeadVerification) Unwrap() *LeadVerification {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LeadVerification is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LeadVerification) String() string {
+	var builder strings.Builder
+	builder.WriteString("LeadVerification(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("lead_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LeadID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Action))
+	builder.WriteString(", ")
+	if v := _m.Method; v != nil {
+		builder.WriteString("method=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(_m.Note)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("data_fingerprint=")
+	builder.WriteString(_m.DataFingerprint)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LeadVerifications is a parsable slice of LeadVerification.
+type LeadVerifications []*LeadVerification
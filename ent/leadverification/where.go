@@ -0,0 +1,502 @@
+// Code generated by ent, DO NOT EDIT.
+
+package leadverification
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldLTE(FieldID, id))
+}
+
+// LeadID applies equality check predicate on the "lead_id" field. It's identical to LeadIDEQ.
+func LeadID(v int) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldEQ(FieldLeadID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldEQ(FieldUserID, v))
+}
+
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldEQ(FieldNote, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldEQ(FieldReason, v))
+}
+
+// DataFingerprint applies equality check predicate on the "data_fingerprint" field. It's identical to DataFingerprintEQ.
+func DataFingerprint(v string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldEQ(FieldDataFingerprint, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// LeadIDEQ applies the EQ predicate on the "lead_id" field.
+func LeadIDEQ(v int) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldEQ(FieldLeadID, v))
+}
+
+// LeadIDNEQ applies the NEQ predicate on the "lead_id" field.
+func LeadIDNEQ(v int) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldNEQ(FieldLeadID, v))
+}
+
+// LeadIDIn applies the In predicate on the "lead_id" field.
+func LeadIDIn(vs ...int) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldIn(FieldLeadID, vs...))
+}
+
+// LeadIDNotIn applies the NotIn predicate on the "lead_id" field.
+func LeadIDNotIn(vs ...int) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldNotIn(FieldLeadID, vs...))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v Action) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v Action) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...Action) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...Action) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// MethodEQ applies the EQ predicate on the "method" field.
+func MethodEQ(v Method) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldEQ(FieldMethod, v))
+}
+
+// MethodNEQ applies the NEQ predicate on the "method" field.
+func MethodNEQ(v Method) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldNEQ(FieldMethod, v))
+}
+
+// MethodIn applies the In predicate on the "method" field.
+func MethodIn(vs ...Method) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldIn(FieldMethod, vs...))
+}
+
+// MethodNotIn applies the NotIn predicate on the "method" field.
+func MethodNotIn(vs ...Method) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldNotIn(FieldMethod, vs...))
+}
+
+// MethodIsNil applies the IsNil predicate on the "method" field.
+func MethodIsNil() predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldIsNull(FieldMethod))
+}
+
+// MethodNotNil applies the NotNil predicate on the "method" field.
+func MethodNotNil() predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldNotNull(FieldMethod))
+}
+
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldEQ(FieldNote, v))
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldNEQ(FieldNote, v))
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldIn(FieldNote, vs...))
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldNotIn(FieldNote, vs...))
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldGT(FieldNote, v))
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldGTE(FieldNote, v))
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldLT(FieldNote, v))
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldLTE(FieldNote, v))
+}
+
+// NoteContains applies the Contains predicate on the "note" field.
+func NoteContains(v string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldContains(FieldNote, v))
+}
+
+// NoteHasPrefix applies the HasPrefix predicate on the "note" field.
+func NoteHasPrefix(v string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldHasPrefix(FieldNote, v))
+}
+
+// NoteHasSuffix applies the HasSuffix predicate on the "note" field.
+func NoteHasSuffix(v string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldHasSuffix(FieldNote, v))
+}
+
+// NoteIsNil applies the IsNil predicate on the "note" field.
+func NoteIsNil() predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldIsNull(FieldNote))
+}
+
+// NoteNotNil applies the NotNil predicate on the "note" field.
+func NoteNotNil() predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldNotNull(FieldNote))
+}
+
+// NoteEqualFold applies the EqualFold predicate on the "note" field.
+func NoteEqualFold(v string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldEqualFold(FieldNote, v))
+}
+
+// NoteContainsFold applies the ContainsFold predicate on the "note" field.
+func NoteContainsFold(v string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldContainsFold(FieldNote, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldContainsFold(FieldReason, v))
+}
+
+// DataFingerprintEQ applies the EQ predicate on the "data_fingerprint" field.
+func DataFingerprintEQ(v string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldEQ(FieldDataFingerprint, v))
+}
+
+// DataFingerprintNEQ applies the NEQ predicate on the "data_fingerprint" field.
+func DataFingerprintNEQ(v string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldNEQ(FieldDataFingerprint, v))
+}
+
+// DataFingerprintIn applies the In predicate on the "data_fingerprint" field.
+func DataFingerprintIn(vs ...string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldIn(FieldDataFingerprint, vs...))
+}
+
+// DataFingerprintNotIn applies the NotIn predicate on the "data_fingerprint" field.
+func DataFingerprintNotIn(vs ...string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldNotIn(FieldDataFingerprint, vs...))
+}
+
+// DataFingerprintGT applies the GT predicate on the "data_fingerprint" field.
+func DataFingerprintGT(v string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldGT(FieldDataFingerprint, v))
+}
+
+// DataFingerprintGTE applies the GTE predicate on the "data_fingerprint" field.
+func DataFingerprintGTE(v string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldGTE(FieldDataFingerprint, v))
+}
+
+// DataFingerprintLT applies the LT predicate on the "data_fingerprint" field.
+func DataFingerprintLT(v string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldLT(FieldDataFingerprint, v))
+}
+
+// DataFingerprintLTE applies the LTE predicate on the "data_fingerprint" field.
+func DataFingerprintLTE(v string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldLTE(FieldDataFingerprint, v))
+}
+
+// DataFingerprintContains applies the Contains predicate on the "data_fingerprint" field.
+func DataFingerprintContains(v string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldContains(FieldDataFingerprint, v))
+}
+
+// DataFingerprintHasPrefix applies the HasPrefix predicate on the "data_fingerprint" field.
+func DataFingerprintHasPrefix(v string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldHasPrefix(FieldDataFingerprint, v))
+}
+
+// DataFingerprintHasSuffix applies the HasSuffix predicate on the "data_fingerprint" field.
+func DataFingerprintHasSuffix(v string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldHasSuffix(FieldDataFingerprint, v))
+}
+
+// DataFingerprintIsNil applies the IsNil predicate on the "data_fingerprint" field.
+func DataFingerprintIsNil() predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldIsNull(FieldDataFingerprint))
+}
+
+// DataFingerprintNotNil applies the NotNil predicate on the "data_fingerprint" field.
+func DataFingerprintNotNil() predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldNotNull(FieldDataFingerprint))
+}
+
+// DataFingerprintEqualFold applies the EqualFold predicate on the "data_fingerprint" field.
+func DataFingerprintEqualFold(v string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldEqualFold(FieldDataFingerprint, v))
+}
+
+// DataFingerprintContainsFold applies the ContainsFold predicate on the "data_fingerprint" field.
+func DataFingerprintContainsFold(v string) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldContainsFold(FieldDataFingerprint, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasLead applies the HasEdge predicate on the "lead" edge.
+func HasLead() predicate.LeadVerification {
+	return predicate.LeadVerification(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, LeadTable, LeadColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLeadWith applies the HasEdge predicate on the "lead" edge with a given conditions (other predicates).
+func HasLeadWith(preds ...predicate.Lead) predicate.LeadVerification {
+	return predicate.LeadVerification(func(s *sql.Selector) {
+		step := newLeadStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.LeadVerification {
+	return predicate.LeadVerification(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.LeadVerification {
+	return predicate.LeadVerification(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LeadVerification) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LeadVerification) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LeadVerification) predicate.LeadVerification {
+	return predicate.LeadVerification(sql.NotPredicates(p))
+}
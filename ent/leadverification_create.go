@@ -0,0 +1,387 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/leadverification"
+	"github.com/jordanlanch/industrydb/ent/user"
+)
+
+// LeadVerificationCreate is the builder for creating a LeadVerification entity.
+type LeadVerificationCreate struct {
+	config
+	mutation *LeadVerificationMutation
+	hooks    []Hook
+}
+
+// SetLeadID sets the "lead_id" field.
+func (_c *LeadVerificationCreate) SetLeadID(v int) *LeadVerificationCreate {
+	_c.mutation.SetLeadID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *LeadVerificationCreate) SetUserID(v int) *LeadVerificationCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *LeadVerificationCreate) SetAction(v leadverification.Action) *LeadVerificationCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetMethod sets the "method" field.
+func (_c *LeadVerificationCreate) SetMethod(v leadverification.Method) *LeadVerificationCreate {
+	_c.mutation.SetMethod(v)
+	return _c
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (_c *LeadVerificationCreate) SetNillableMethod(v *leadverification.Method) *LeadVerificationCreate {
+	if v != nil {
+		_c.SetMethod(*v)
+	}
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *LeadVerificationCreate) SetNote(v string) *LeadVerificationCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *LeadVerificationCreate) SetNillableNote(v *string) *LeadVerificationCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *LeadVerificationCreate) SetReason(v string) *LeadVerificationCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *LeadVerificationCreate) SetNillableReason(v *string) *LeadVerificationCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetDataFingerprint sets the "data_fingerprint" field.
+func (_c *LeadVerificationCreate) SetDataFingerprint(v string) *LeadVerificationCreate {
+	_c.mutation.SetDataFingerprint(v)
+	return _c
+}
+
+// SetNillableDataFingerprint sets the "data_fingerprint" field if the given value is not nil.
+func (_c *LeadVerificationCreate) SetNillableDataFingerprint(v *string) *LeadVerificationCreate {
+	if v != nil {
+		_c.SetDataFingerprint(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LeadVerificationCreate) SetCreatedAt(v time.Time) *LeadVerificationCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LeadVerificationCreate) SetNillableCreatedAt(v *time.Time) *LeadVerificationCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetLead sets the "lead" edge to the Lead entity.
+func (_c *LeadVerificationCreate) SetLead(v *Lead) *LeadVerificationCreate {
+	return _c.SetLeadID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *LeadVerificationCreate) SetUser(v *User) *LeadVerificationCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the LeadVerificationMutation object of the builder.
+func (_c *LeadVerificationCreate) Mutation() *LeadVerificationMutation {
+	return _c.mutation
+}
+
+// Save creates the LeadVerification in the database.
+func (_c *LeadVerificationCreate) Save(ctx context.Context) (*LeadVerification, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LeadVerificationCreate) SaveX(ctx context.Context) *LeadVerification {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LeadVerificationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LeadVerificationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LeadVerificationCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := leadverification.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LeadVerificationCreate) check() error {
+	if _, ok := _c.mutation.LeadID(); !ok {
+		return &ValidationError{Name: "lead_id", err: errors.New(`ent: missing required field "LeadVerification.lead_id"`)}
+	}
+	if v, ok := _c.mutation.LeadID(); ok {
+		if err := leadverification.LeadIDValidator(v); err != nil {
+			return &ValidationError{Name: "lead_id", err: fmt.Errorf(`ent: validator failed for field "LeadVerification.lead_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "LeadVerification.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := leadverification.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "LeadVerification.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "LeadVerification.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := leadverification.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "LeadVerification.action": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Method(); ok {
+		if err := leadverification.MethodValidator(v); err != nil {
+			return &ValidationError{Name: "method", err: fmt.Errorf(`ent: validator failed for field "LeadVerification.method": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Note(); ok {
+		if err := leadverification.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "LeadVerification.note": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Reason(); ok {
+		if err := leadverification.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "LeadVerification.reason": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LeadVerification.created_at"`)}
+	}
+	if len(_c.mutation.LeadIDs()) == 0 {
+		return &ValidationError{Name: "lead", err: errors.New(`ent: missing required edge "LeadVerification.lead"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "LeadVerification.user"`)}
+	}
+	return nil
+}
+
+func (_c *LeadVerificationCreate) sqlSave(ctx context.Context) (*LeadVerification, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LeadVerificationCreate) createSpec() (*LeadVerification, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LeadVerification{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(leadverification.Table, sqlgraph.NewFieldSpec(leadverification.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(leadverification.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.Method(); ok {
+		_spec.SetField(leadverification.FieldMethod, field.TypeEnum, value)
+		_node.Method = &value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(leadverification.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(leadverification.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.DataFingerprint(); ok {
+		_spec.SetField(leadverification.FieldDataFingerprint, field.TypeString, value)
+		_node.DataFingerprint = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(leadverification.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.LeadIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadverification.LeadTable,
+			Columns: []string{leadverification.LeadColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LeadID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadverification.UserTable,
+			Columns: []string{leadverification.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LeadVerificationCreateBulk is the builder for creating many LeadVerification entities in bulk.
+type LeadVerificationCreateBulk struct {
+	config
+	err      error
+	builders []*LeadVerificationCreate
+}
+
+// Save creates the LeadVerification entities in the database.
+func (_c *LeadVerificationCreateBulk) Save(ctx context.Context) ([]*LeadVerification, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LeadVerification, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LeadVerificationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LeadVerificationCreateBulk) SaveX(ctx context.Context) []*LeadVerification {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LeadVerificationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LeadVerificationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,739 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/leadverification"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+	"github.com/jordanlanch/industrydb/ent/user"
+)
+
+// LeadVerificationUpdate is the builder for updating LeadVerification entities.
+type LeadVerificationUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *LeadVerificationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the LeadVerificationUpdate builder.
+func (_u *LeadVerificationUpdate) Where(ps ...predicate.LeadVerification) *LeadVerificationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetLeadID sets the "lead_id" field.
+func (_u *LeadVerificationUpdate) SetLeadID(v int) *LeadVerificationUpdate {
+	_u.mutation.SetLeadID(v)
+	return _u
+}
+
+// SetNillableLeadID sets the "lead_id" field if the given value is not nil.
+func (_u *LeadVerificationUpdate) SetNillableLeadID(v *int) *LeadVerificationUpdate {
+	if v != nil {
+		_u.SetLeadID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *LeadVerificationUpdate) SetUserID(v int) *LeadVerificationUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *LeadVerificationUpdate) SetNillableUserID(v *int) *LeadVerificationUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *LeadVerificationUpdate) SetAction(v leadverification.Action) *LeadVerificationUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *LeadVerificationUpdate) SetNillableAction(v *leadverification.Action) *LeadVerificationUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetMethod sets the "method" field.
+func (_u *LeadVerificationUpdate) SetMethod(v leadverification.Method) *LeadVerificationUpdate {
+	_u.mutation.SetMethod(v)
+	return _u
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (_u *LeadVerificationUpdate) SetNillableMethod(v *leadverification.Method) *LeadVerificationUpdate {
+	if v != nil {
+		_u.SetMethod(*v)
+	}
+	return _u
+}
+
+// ClearMethod clears the value of the "method" field.
+func (_u *LeadVerificationUpdate) ClearMethod() *LeadVerificationUpdate {
+	_u.mutation.ClearMethod()
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *LeadVerificationUpdate) SetNote(v string) *LeadVerificationUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *LeadVerificationUpdate) SetNillableNote(v *string) *LeadVerificationUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *LeadVerificationUpdate) ClearNote() *LeadVerificationUpdate {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *LeadVerificationUpdate) SetReason(v string) *LeadVerificationUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *LeadVerificationUpdate) SetNillableReason(v *string) *LeadVerificationUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *LeadVerificationUpdate) ClearReason() *LeadVerificationUpdate {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetDataFingerprint sets the "data_fingerprint" field.
+func (_u *LeadVerificationUpdate) SetDataFingerprint(v string) *LeadVerificationUpdate {
+	_u.mutation.SetDataFingerprint(v)
+	return _u
+}
+
+// SetNillableDataFingerprint sets the "data_fingerprint" field if the given value is not nil.
+func (_u *LeadVerificationUpdate) SetNillableDataFingerprint(v *string) *LeadVerificationUpdate {
+	if v != nil {
+		_u.SetDataFingerprint(*v)
+	}
+	return _u
+}
+
+// ClearDataFingerprint clears the value of the "data_fingerprint" field.
+func (_u *LeadVerificationUpdate) ClearDataFingerprint() *LeadVerificationUpdate {
+	_u.mutation.ClearDataFingerprint()
+	return _u
+}
+
+// SetLead sets the "lead" edge to the Lead entity.
+func (_u *LeadVerificationUpdate) SetLead(v *Lead) *LeadVerificationUpdate {
+	return _u.SetLeadID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *LeadVerificationUpdate) SetUser(v *User) *LeadVerificationUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the LeadVerificationMutation object of the builder.
+func (_u *LeadVerificationUpdate) Mutation() *LeadVerificationMutation {
+	return _u.mutation
+}
+
+// ClearLead clears the "lead" edge to the Lead entity.
+func (_u *LeadVerificationUpdate) ClearLead() *LeadVerificationUpdate {
+	_u.mutation.ClearLead()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *LeadVerificationUpdate) ClearUser() *LeadVerificationUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LeadVerificationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LeadVerificationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LeadVerificationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LeadVerificationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LeadVerificationUpdate) check() error {
+	if v, ok := _u.mutation.LeadID(); ok {
+		if err := leadverification.LeadIDValidator(v); err != nil {
+			return &ValidationError{Name: "lead_id", err: fmt.Errorf(`ent: validator failed for field "LeadVerification.lead_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := leadverification.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "LeadVerification.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := leadverification.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "LeadVerification.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Method(); ok {
+		if err := leadverification.MethodValidator(v); err != nil {
+			return &ValidationError{Name: "method", err: fmt.Errorf(`ent: validator failed for field "LeadVerification.method": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Note(); ok {
+		if err := leadverification.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "LeadVerification.note": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := leadverification.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "LeadVerification.reason": %w`, err)}
+		}
+	}
+	if _u.mutation.LeadCleared() && len(_u.mutation.LeadIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LeadVerification.lead"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LeadVerification.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LeadVerificationUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LeadVerificationUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LeadVerificationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(leadverification.Table, leadverification.Columns, sqlgraph.NewFieldSpec(leadverification.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(leadverification.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Method(); ok {
+		_spec.SetField(leadverification.FieldMethod, field.TypeEnum, value)
+	}
+	if _u.mutation.MethodCleared() {
+		_spec.ClearField(leadverification.FieldMethod, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(leadverification.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(leadverification.FieldNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(leadverification.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(leadverification.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.DataFingerprint(); ok {
+		_spec.SetField(leadverification.FieldDataFingerprint, field.TypeString, value)
+	}
+	if _u.mutation.DataFingerprintCleared() {
+		_spec.ClearField(leadverification.FieldDataFingerprint, field.TypeString)
+	}
+	if _u.mutation.LeadCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadverification.LeadTable,
+			Columns: []string{leadverification.LeadColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LeadIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadverification.LeadTable,
+			Columns: []string{leadverification.LeadColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadverification.UserTable,
+			Columns: []string{leadverification.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadverification.UserTable,
+			Columns: []string{leadverification.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{leadverification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LeadVerificationUpdateOne is the builder for updating a single LeadVerification entity.
+type LeadVerificationUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *LeadVerificationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetLeadID sets the "lead_id" field.
+func (_u *LeadVerificationUpdateOne) SetLeadID(v int) *LeadVerificationUpdateOne {
+	_u.mutation.SetLeadID(v)
+	return _u
+}
+
+// SetNillableLeadID sets the "lead_id" field if the given value is not nil.
+func (_u *LeadVerificationUpdateOne) SetNillableLeadID(v *int) *LeadVerificationUpdateOne {
+	if v != nil {
+		_u.SetLeadID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *LeadVerificationUpdateOne) SetUserID(v int) *LeadVerificationUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *LeadVerificationUpdateOne) SetNillableUserID(v *int) *LeadVerificationUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *LeadVerificationUpdateOne) SetAction(v leadverification.Action) *LeadVerificationUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *LeadVerificationUpdateOne) SetNillableAction(v *leadverification.Action) *LeadVerificationUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetMethod sets the "method" field.
+func (_u *LeadVerificationUpdateOne) SetMethod(v leadverification.Method) *LeadVerificationUpdateOne {
+	_u.mutation.SetMethod(v)
+	return _u
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (_u *LeadVerificationUpdateOne) SetNillableMethod(v *leadverification.Method) *LeadVerificationUpdateOne {
+	if v != nil {
+		_u.SetMethod(*v)
+	}
+	return _u
+}
+
+// ClearMethod clears the value of the "method" field.
+func (_u *LeadVerificationUpdateOne) ClearMethod() *LeadVerificationUpdateOne {
+	_u.mutation.ClearMethod()
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *LeadVerificationUpdateOne) SetNote(v string) *LeadVerificationUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *LeadVerificationUpdateOne) SetNillableNote(v *string) *LeadVerificationUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *LeadVerificationUpdateOne) ClearNote() *LeadVerificationUpdateOne {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *LeadVerificationUpdateOne) SetReason(v string) *LeadVerificationUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *LeadVerificationUpdateOne) SetNillableReason(v *string) *LeadVerificationUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *LeadVerificationUpdateOne) ClearReason() *LeadVerificationUpdateOne {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetDataFingerprint sets the "data_fingerprint" field.
+func (_u *LeadVerificationUpdateOne) SetDataFingerprint(v string) *LeadVerificationUpdateOne {
+	_u.mutation.SetDataFingerprint(v)
+	return _u
+}
+
+// SetNillableDataFingerprint sets the "data_fingerprint" field if the given value is not nil.
+func (_u *LeadVerificationUpdateOne) SetNillableDataFingerprint(v *string) *LeadVerificationUpdateOne {
+	if v != nil {
+		_u.SetDataFingerprint(*v)
+	}
+	return _u
+}
+
+// ClearDataFingerprint clears the value of the "data_fingerprint" field.
+func (_u *LeadVerificationUpdateOne) ClearDataFingerprint() *LeadVerificationUpdateOne {
+	_u.mutation.ClearDataFingerprint()
+	return _u
+}
+
+// SetLead sets the "lead" edge to the Lead entity.
+func (_u *LeadVerificationUpdateOne) SetLead(v *Lead) *LeadVerificationUpdateOne {
+	return _u.SetLeadID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *LeadVerificationUpdateOne) SetUser(v *User) *LeadVerificationUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the LeadVerificationMutation object of the builder.
+func (_u *LeadVerificationUpdateOne) Mutation() *LeadVerificationMutation {
+	return _u.mutation
+}
+
+// ClearLead clears the "lead" edge to the Lead entity.
+func (_u *LeadVerificationUpdateOne) ClearLead() *LeadVerificationUpdateOne {
+	_u.mutation.ClearLead()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *LeadVerificationUpdateOne) ClearUser() *LeadVerificationUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the LeadVerificationUpdate builder.
+func (_u *LeadVerificationUpdateOne) Where(ps ...predicate.LeadVerification) *LeadVerificationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LeadVerificationUpdateOne) Select(field string, fields ...string) *LeadVerificationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LeadVerification entity.
+func (_u *LeadVerificationUpdateOne) Save(ctx context.Context) (*LeadVerification, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LeadVerificationUpdateOne) SaveX(ctx context.Context) *LeadVerification {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LeadVerificationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LeadVerificationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LeadVerificationUpdateOne) check() error {
+	if v, ok := _u.mutation.LeadID(); ok {
+		if err := leadverification.LeadIDValidator(v); err != nil {
+			return &ValidationError{Name: "lead_id", err: fmt.Errorf(`ent: validator failed for field "LeadVerification.lead_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := leadverification.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "LeadVerification.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := leadverification.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "LeadVerification.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Method(); ok {
+		if err := leadverification.MethodValidator(v); err != nil {
+			return &ValidationError{Name: "method", err: fmt.Errorf(`ent: validator failed for field "LeadVerification.method": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Note(); ok {
+		if err := leadverification.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "LeadVerification.note": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := leadverification.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "LeadVerification.reason": %w`, err)}
+		}
+	}
+	if _u.mutation.LeadCleared() && len(_u.mutation.LeadIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LeadVerification.lead"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LeadVerification.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LeadVerificationUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LeadVerificationUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LeadVerificationUpdateOne) sqlSave(ctx context.Context) (_node *LeadVerification, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(leadverification.Table, leadverification.Columns, sqlgraph.NewFieldSpec(leadverification.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LeadVerification.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, leadverification.FieldID)
+		for _, f := range fields {
+			if !leadverification.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != leadverification.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(leadverification.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Method(); ok {
+		_spec.SetField(leadverification.FieldMethod, field.TypeEnum, value)
+	}
+	if _u.mutation.MethodCleared() {
+		_spec.ClearField(leadverification.FieldMethod, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(leadverification.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(leadverification.FieldNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(leadverification.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(leadverification.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.DataFingerprint(); ok {
+		_spec.SetField(leadverification.FieldDataFingerprint, field.TypeString, value)
+	}
+	if _u.mutation.DataFingerprintCleared() {
+		_spec.ClearField(leadverification.FieldDataFingerprint, field.TypeString)
+	}
+	if _u.mutation.LeadCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadverification.LeadTable,
+			Columns: []string{leadverification.LeadColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LeadIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadverification.LeadTable,
+			Columns: []string{leadverification.LeadColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadverification.UserTable,
+			Columns: []string{leadverification.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leadverification.UserTable,
+			Columns: []string{leadverification.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &LeadVerification{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{leadverification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -19,8 +19,9 @@ import (
 // MarketReportUpdate is the builder for updating MarketReport entities.
 type MarketReportUpdate struct {
 	config
-	hooks    []Hook
-	mutation *MarketReportMutation
+	hooks     []Hook
+	mutation  *MarketReportMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the MarketReportUpdate builder.
@@ -242,6 +243,12 @@ func (_u *MarketReportUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *MarketReportUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MarketReportUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *MarketReportUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -319,6 +326,7 @@ func (_u *MarketReportUpdate) sqlSave(ctx context.Context) (_node int, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{marketreport.Label}
@@ -334,9 +342,10 @@ func (_u *MarketReportUpdate) sqlSave(ctx context.Context) (_node int, err error
 // MarketReportUpdateOne is the builder for updating a single MarketReport entity.
 type MarketReportUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *MarketReportMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *MarketReportMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUserID sets the "user_id" field.
@@ -565,6 +574,12 @@ func (_u *MarketReportUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *MarketReportUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MarketReportUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *MarketReportUpdateOne) sqlSave(ctx context.Context) (_node *MarketReport, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -659,6 +674,7 @@ func (_u *MarketReportUpdateOne) sqlSave(ctx context.Context) (_node *MarketRepo
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &MarketReport{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
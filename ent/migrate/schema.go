@@ -3,6 +3,7 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
@@ -229,7 +230,7 @@ var (
 	// AuditLogsColumns holds the columns for the "audit_logs" table.
 	AuditLogsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "action", Type: field.TypeEnum, Enums: []string{"user_login", "user_logout", "user_register", "user_profile_update", "user_password_change", "user_email_verify", "user_account_delete", "user_update", "user_suspension", "data_export", "lead_search", "lead_view", "export_create", "export_download", "subscription_create", "subscription_update", "subscription_cancel", "payment_success", "payment_failed", "api_key_create", "api_key_delete"}},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"user_login", "user_logout", "session_revoke", "user_register", "user_profile_update", "user_password_change", "user_email_verify", "user_account_delete", "user_update", "user_suspension", "user_activity_report", "data_export", "data_purge", "lead_search", "lead_view", "lead_verify", "lead_unverify", "lead_tag", "lead_merge", "lead_reindex", "sequence_stop_all", "webhook_bulk_delete", "export_create", "export_download", "subscription_create", "subscription_update", "subscription_cancel", "payment_success", "payment_failed", "api_key_create", "api_key_delete", "internal_service_request"}},
 		{Name: "resource_type", Type: field.TypeString, Nullable: true},
 		{Name: "resource_id", Type: field.TypeString, Nullable: true},
 		{Name: "ip_address", Type: field.TypeString, Nullable: true},
@@ -404,6 +405,65 @@ var (
 			},
 		},
 	}
+	// CrmPushJobsColumns holds the columns for the "crm_push_jobs" table.
+	CrmPushJobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "processing", "completed", "failed"}, Default: "pending"},
+		{Name: "total_leads", Type: field.TypeInt, Default: 0},
+		{Name: "created_count", Type: field.TypeInt, Default: 0},
+		{Name: "updated_count", Type: field.TypeInt, Default: 0},
+		{Name: "failure_count", Type: field.TypeInt, Default: 0},
+		{Name: "results", Type: field.TypeJSON, Nullable: true},
+		{Name: "error_message", Type: field.TypeString, Nullable: true},
+		{Name: "started_at", Type: field.TypeTime, Nullable: true},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "integration_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// CrmPushJobsTable holds the schema information for the "crm_push_jobs" table.
+	CrmPushJobsTable = &schema.Table{
+		Name:       "crm_push_jobs",
+		Columns:    CrmPushJobsColumns,
+		PrimaryKey: []*schema.Column{CrmPushJobsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "crm_push_jobs_crm_integrations_push_jobs",
+				Columns:    []*schema.Column{CrmPushJobsColumns[12]},
+				RefColumns: []*schema.Column{CrmIntegrationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "crm_push_jobs_users_crm_push_jobs",
+				Columns:    []*schema.Column{CrmPushJobsColumns[13]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "crmpushjob_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{CrmPushJobsColumns[13]},
+			},
+			{
+				Name:    "crmpushjob_integration_id",
+				Unique:  false,
+				Columns: []*schema.Column{CrmPushJobsColumns[12]},
+			},
+			{
+				Name:    "crmpushjob_status",
+				Unique:  false,
+				Columns: []*schema.Column{CrmPushJobsColumns[1]},
+			},
+			{
+				Name:    "crmpushjob_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{CrmPushJobsColumns[10]},
+			},
+		},
+	}
 	// CallLogsColumns holds the columns for the "call_logs" table.
 	CallLogsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -604,6 +664,53 @@ var (
 			},
 		},
 	}
+	// ContactAttemptsColumns holds the columns for the "contact_attempts" table.
+	ContactAttemptsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"call", "email", "sms"}},
+		{Name: "outcome", Type: field.TypeEnum, Enums: []string{"no_answer", "left_voicemail", "busy", "wrong_number", "bounced", "opted_out", "replied", "connected"}},
+		{Name: "attempted_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "lead_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// ContactAttemptsTable holds the schema information for the "contact_attempts" table.
+	ContactAttemptsTable = &schema.Table{
+		Name:       "contact_attempts",
+		Columns:    ContactAttemptsColumns,
+		PrimaryKey: []*schema.Column{ContactAttemptsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "contact_attempts_leads_contact_attempts",
+				Columns:    []*schema.Column{ContactAttemptsColumns[5]},
+				RefColumns: []*schema.Column{LeadsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "contact_attempts_users_contact_attempts",
+				Columns:    []*schema.Column{ContactAttemptsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "contactattempt_lead_id_attempted_at",
+				Unique:  false,
+				Columns: []*schema.Column{ContactAttemptsColumns[5], ContactAttemptsColumns[3]},
+			},
+			{
+				Name:    "contactattempt_user_id_attempted_at",
+				Unique:  false,
+				Columns: []*schema.Column{ContactAttemptsColumns[6], ContactAttemptsColumns[3]},
+			},
+			{
+				Name:    "contactattempt_attempted_at",
+				Unique:  false,
+				Columns: []*schema.Column{ContactAttemptsColumns[3]},
+			},
+		},
+	}
 	// EmailCampaignsColumns holds the columns for the "email_campaigns" table.
 	EmailCampaignsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -732,6 +839,54 @@ var (
 			},
 		},
 	}
+	// EmailSendsColumns holds the columns for the "email_sends" table.
+	EmailSendsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "to_email", Type: field.TypeString},
+		{Name: "to_name", Type: field.TypeString, Nullable: true},
+		{Name: "template", Type: field.TypeString, Nullable: true},
+		{Name: "subject", Type: field.TypeString},
+		{Name: "html_body", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "text_body", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "sent", "retrying", "failed", "bounced", "suppressed"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "status_code", Type: field.TypeInt, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "provider", Type: field.TypeString, Nullable: true},
+		{Name: "provider_message_id", Type: field.TypeString, Nullable: true},
+		{Name: "next_attempt_at", Type: field.TypeTime, Nullable: true},
+		{Name: "sent_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// EmailSendsTable holds the schema information for the "email_sends" table.
+	EmailSendsTable = &schema.Table{
+		Name:       "email_sends",
+		Columns:    EmailSendsColumns,
+		PrimaryKey: []*schema.Column{EmailSendsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "emailsend_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{EmailSendsColumns[7], EmailSendsColumns[13]},
+			},
+			{
+				Name:    "emailsend_to_email",
+				Unique:  false,
+				Columns: []*schema.Column{EmailSendsColumns[1]},
+			},
+			{
+				Name:    "emailsend_provider_message_id",
+				Unique:  false,
+				Columns: []*schema.Column{EmailSendsColumns[12]},
+			},
+			{
+				Name:    "emailsend_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{EmailSendsColumns[15]},
+			},
+		},
+	}
 	// EmailSequencesColumns holds the columns for the "email_sequences" table.
 	EmailSequencesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -739,6 +894,9 @@ var (
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"draft", "active", "paused", "archived"}, Default: "draft"},
 		{Name: "trigger", Type: field.TypeEnum, Enums: []string{"lead_created", "lead_assigned", "lead_status_changed", "manual"}, Default: "manual"},
+		{Name: "delivery_timezone", Type: field.TypeString, Nullable: true},
+		{Name: "delivery_start_hour", Type: field.TypeInt, Nullable: true},
+		{Name: "delivery_end_hour", Type: field.TypeInt, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "created_by_user_id", Type: field.TypeInt},
@@ -751,7 +909,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "email_sequences_users_email_sequences_created",
-				Columns:    []*schema.Column{EmailSequencesColumns[7]},
+				Columns:    []*schema.Column{EmailSequencesColumns[10]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -770,12 +928,12 @@ var (
 			{
 				Name:    "emailsequence_created_by_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{EmailSequencesColumns[7]},
+				Columns: []*schema.Column{EmailSequencesColumns[10]},
 			},
 			{
 				Name:    "emailsequence_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{EmailSequencesColumns[5]},
+				Columns: []*schema.Column{EmailSequencesColumns[8]},
 			},
 		},
 	}
@@ -843,7 +1001,7 @@ var (
 	// EmailSequenceSendsColumns holds the columns for the "email_sequence_sends" table.
 	EmailSequenceSendsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "status", Type: field.TypeEnum, Enums: []string{"scheduled", "sent", "opened", "clicked", "bounced", "failed"}, Default: "scheduled"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"scheduled", "sent", "opened", "clicked", "bounced", "failed", "skipped"}, Default: "scheduled"},
 		{Name: "scheduled_for", Type: field.TypeTime},
 		{Name: "sent_at", Type: field.TypeTime, Nullable: true},
 		{Name: "opened_at", Type: field.TypeTime, Nullable: true},
@@ -916,6 +1074,10 @@ var (
 		{Name: "delay_days", Type: field.TypeInt, Default: 0},
 		{Name: "subject", Type: field.TypeString, Size: 500},
 		{Name: "body", Type: field.TypeString, Size: 2147483647},
+		{Name: "condition_type", Type: field.TypeEnum, Nullable: true, Enums: []string{"opened", "not_opened", "clicked", "not_clicked", "field_equals", "field_not_equals"}},
+		{Name: "condition_step_order", Type: field.TypeInt, Nullable: true},
+		{Name: "condition_field", Type: field.TypeString, Nullable: true},
+		{Name: "condition_value", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "sequence_id", Type: field.TypeInt},
 	}
@@ -927,7 +1089,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "email_sequence_steps_email_sequences_steps",
-				Columns:    []*schema.Column{EmailSequenceStepsColumns[6]},
+				Columns:    []*schema.Column{EmailSequenceStepsColumns[10]},
 				RefColumns: []*schema.Column{EmailSequencesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -936,7 +1098,105 @@ var (
 			{
 				Name:    "idx_email_sequence_step_order",
 				Unique:  true,
-				Columns: []*schema.Column{EmailSequenceStepsColumns[6], EmailSequenceStepsColumns[1]},
+				Columns: []*schema.Column{EmailSequenceStepsColumns[10], EmailSequenceStepsColumns[1]},
+			},
+		},
+	}
+	// EmailSuppressionsColumns holds the columns for the "email_suppressions" table.
+	EmailSuppressionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "email", Type: field.TypeString},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// EmailSuppressionsTable holds the schema information for the "email_suppressions" table.
+	EmailSuppressionsTable = &schema.Table{
+		Name:       "email_suppressions",
+		Columns:    EmailSuppressionsColumns,
+		PrimaryKey: []*schema.Column{EmailSuppressionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "emailsuppression_email",
+				Unique:  true,
+				Columns: []*schema.Column{EmailSuppressionsColumns[1]},
+			},
+		},
+	}
+	// EnrichmentAttemptsColumns holds the columns for the "enrichment_attempts" table.
+	EnrichmentAttemptsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "provider", Type: field.TypeString, Size: 50},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"company", "email_validation"}},
+		{Name: "success", Type: field.TypeBool},
+		{Name: "fields_returned", Type: field.TypeJSON, Nullable: true},
+		{Name: "error_message", Type: field.TypeString, Nullable: true},
+		{Name: "cost_cents", Type: field.TypeInt, Default: 0},
+		{Name: "duration_ms", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "lead_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+	}
+	// EnrichmentAttemptsTable holds the schema information for the "enrichment_attempts" table.
+	EnrichmentAttemptsTable = &schema.Table{
+		Name:       "enrichment_attempts",
+		Columns:    EnrichmentAttemptsColumns,
+		PrimaryKey: []*schema.Column{EnrichmentAttemptsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "enrichment_attempts_leads_enrichment_attempts",
+				Columns:    []*schema.Column{EnrichmentAttemptsColumns[9]},
+				RefColumns: []*schema.Column{LeadsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "enrichment_attempts_users_enrichment_attempts",
+				Columns:    []*schema.Column{EnrichmentAttemptsColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_enrichment_attempt_lead_time",
+				Unique:  false,
+				Columns: []*schema.Column{EnrichmentAttemptsColumns[9], EnrichmentAttemptsColumns[8]},
+			},
+			{
+				Name:    "idx_enrichment_attempt_provider",
+				Unique:  false,
+				Columns: []*schema.Column{EnrichmentAttemptsColumns[1], EnrichmentAttemptsColumns[3]},
+			},
+		},
+	}
+	// EnrichmentBudgetsColumns holds the columns for the "enrichment_budgets" table.
+	EnrichmentBudgetsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "call_limit", Type: field.TypeInt, Nullable: true},
+		{Name: "cost_limit_cents", Type: field.TypeInt, Nullable: true},
+		{Name: "calls", Type: field.TypeInt, Default: 0},
+		{Name: "cost_cents", Type: field.TypeInt, Default: 0},
+		{Name: "period_start", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "organization_id", Type: field.TypeInt, Unique: true, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt, Unique: true, Nullable: true},
+	}
+	// EnrichmentBudgetsTable holds the schema information for the "enrichment_budgets" table.
+	EnrichmentBudgetsTable = &schema.Table{
+		Name:       "enrichment_budgets",
+		Columns:    EnrichmentBudgetsColumns,
+		PrimaryKey: []*schema.Column{EnrichmentBudgetsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "enrichment_budgets_organizations_enrichment_budget",
+				Columns:    []*schema.Column{EnrichmentBudgetsColumns[7]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "enrichment_budgets_users_enrichment_budget",
+				Columns:    []*schema.Column{EnrichmentBudgetsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
 			},
 		},
 	}
@@ -1043,14 +1303,33 @@ var (
 	// ExportsColumns holds the columns for the "exports" table.
 	ExportsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "format", Type: field.TypeEnum, Enums: []string{"csv", "excel"}},
+		{Name: "format", Type: field.TypeEnum, Enums: []string{"csv", "excel", "vcard", "geojson", "kml"}},
 		{Name: "filters_applied", Type: field.TypeJSON, Nullable: true},
 		{Name: "lead_count", Type: field.TypeInt},
+		{Name: "skipped_count", Type: field.TypeInt, Default: 0},
 		{Name: "file_url", Type: field.TypeString, Nullable: true},
 		{Name: "file_path", Type: field.TypeString, Nullable: true},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "processing", "ready", "failed", "expired"}, Default: "pending"},
 		{Name: "error_message", Type: field.TypeString, Nullable: true},
 		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "since", Type: field.TypeTime, Nullable: true},
+		{Name: "high_water_mark", Type: field.TypeTime, Nullable: true},
+		{Name: "high_water_lead_id", Type: field.TypeInt, Nullable: true},
+		{Name: "redaction_profile", Type: field.TypeString, Nullable: true, Size: 50},
+		{Name: "delivery_url", Type: field.TypeString, Nullable: true, Size: 2048},
+		{Name: "delivery_secret", Type: field.TypeString, Nullable: true},
+		{Name: "delivery_status", Type: field.TypeEnum, Nullable: true, Enums: []string{"pending", "delivered", "failed"}},
+		{Name: "delivery_attempts", Type: field.TypeInt, Default: 0},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "delivery_error", Type: field.TypeString, Nullable: true},
+		{Name: "delivery_method", Type: field.TypeEnum, Nullable: true, Enums: []string{"url", "google_sheets"}},
+		{Name: "spreadsheet_id", Type: field.TypeString, Nullable: true},
+		{Name: "sheet_url", Type: field.TypeString, Nullable: true, Size: 2048},
+		{Name: "delivery_warning", Type: field.TypeString, Nullable: true},
+		{Name: "compression", Type: field.TypeEnum, Nullable: true, Enums: []string{"gzip", "zip"}},
+		{Name: "file_size", Type: field.TypeInt64, Nullable: true},
+		{Name: "download_count", Type: field.TypeInt, Default: 0},
+		{Name: "last_downloaded_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
@@ -1064,13 +1343,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "exports_organizations_exports",
-				Columns:    []*schema.Column{ExportsColumns[11]},
+				Columns:    []*schema.Column{ExportsColumns[30]},
 				RefColumns: []*schema.Column{OrganizationsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "exports_users_exports",
-				Columns:    []*schema.Column{ExportsColumns[12]},
+				Columns:    []*schema.Column{ExportsColumns[31]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -1079,27 +1358,135 @@ var (
 			{
 				Name:    "export_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{ExportsColumns[12]},
+				Columns: []*schema.Column{ExportsColumns[31]},
 			},
 			{
 				Name:    "export_organization_id",
 				Unique:  false,
-				Columns: []*schema.Column{ExportsColumns[11]},
+				Columns: []*schema.Column{ExportsColumns[30]},
 			},
 			{
 				Name:    "export_status",
 				Unique:  false,
-				Columns: []*schema.Column{ExportsColumns[6]},
+				Columns: []*schema.Column{ExportsColumns[7]},
 			},
 			{
 				Name:    "export_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{ExportsColumns[9]},
+				Columns: []*schema.Column{ExportsColumns[28]},
 			},
 			{
 				Name:    "export_expires_at",
 				Unique:  false,
-				Columns: []*schema.Column{ExportsColumns[8]},
+				Columns: []*schema.Column{ExportsColumns[9]},
+			},
+		},
+	}
+	// ExportTemplatesColumns holds the columns for the "export_templates" table.
+	ExportTemplatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "format", Type: field.TypeEnum, Enums: []string{"csv", "excel", "vcard", "geojson", "kml"}},
+		{Name: "columns", Type: field.TypeJSON, Nullable: true},
+		{Name: "filters", Type: field.TypeJSON, Nullable: true},
+		{Name: "max_leads", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
+		{Name: "saved_search_id", Type: field.TypeInt, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// ExportTemplatesTable holds the schema information for the "export_templates" table.
+	ExportTemplatesTable = &schema.Table{
+		Name:       "export_templates",
+		Columns:    ExportTemplatesColumns,
+		PrimaryKey: []*schema.Column{ExportTemplatesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "export_templates_organizations_export_templates",
+				Columns:    []*schema.Column{ExportTemplatesColumns[8]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "export_templates_saved_searches_export_templates",
+				Columns:    []*schema.Column{ExportTemplatesColumns[9]},
+				RefColumns: []*schema.Column{SavedSearchesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "export_templates_users_export_templates",
+				Columns:    []*schema.Column{ExportTemplatesColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "exporttemplate_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{ExportTemplatesColumns[10]},
+			},
+			{
+				Name:    "exporttemplate_organization_id",
+				Unique:  false,
+				Columns: []*schema.Column{ExportTemplatesColumns[8]},
+			},
+			{
+				Name:    "exporttemplate_saved_search_id",
+				Unique:  false,
+				Columns: []*schema.Column{ExportTemplatesColumns[9]},
+			},
+		},
+	}
+	// ImportJobsColumns holds the columns for the "import_jobs" table.
+	ImportJobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "source_type", Type: field.TypeEnum, Enums: []string{"s3", "url"}},
+		{Name: "source", Type: field.TypeString, Size: 2048},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "processing", "completed", "failed"}, Default: "pending"},
+		{Name: "validate_only", Type: field.TypeBool, Default: false},
+		{Name: "total_bytes", Type: field.TypeInt64, Nullable: true},
+		{Name: "bytes_read", Type: field.TypeInt64, Default: 0},
+		{Name: "total_rows", Type: field.TypeInt, Default: 0},
+		{Name: "success_count", Type: field.TypeInt, Default: 0},
+		{Name: "failure_count", Type: field.TypeInt, Default: 0},
+		{Name: "errors", Type: field.TypeJSON, Nullable: true},
+		{Name: "error_message", Type: field.TypeString, Nullable: true},
+		{Name: "started_at", Type: field.TypeTime, Nullable: true},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// ImportJobsTable holds the schema information for the "import_jobs" table.
+	ImportJobsTable = &schema.Table{
+		Name:       "import_jobs",
+		Columns:    ImportJobsColumns,
+		PrimaryKey: []*schema.Column{ImportJobsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "import_jobs_users_import_jobs",
+				Columns:    []*schema.Column{ImportJobsColumns[16]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "importjob_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{ImportJobsColumns[16]},
+			},
+			{
+				Name:    "importjob_status",
+				Unique:  false,
+				Columns: []*schema.Column{ImportJobsColumns[3]},
+			},
+			{
+				Name:    "importjob_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ImportJobsColumns[14]},
 			},
 		},
 	}
@@ -1140,6 +1527,38 @@ var (
 			},
 		},
 	}
+	// IntegrationConnectionsColumns holds the columns for the "integration_connections" table.
+	IntegrationConnectionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "provider", Type: field.TypeEnum, Enums: []string{"google_sheets"}},
+		{Name: "account_email", Type: field.TypeString, Nullable: true},
+		{Name: "refresh_token", Type: field.TypeString},
+		{Name: "scopes", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// IntegrationConnectionsTable holds the schema information for the "integration_connections" table.
+	IntegrationConnectionsTable = &schema.Table{
+		Name:       "integration_connections",
+		Columns:    IntegrationConnectionsColumns,
+		PrimaryKey: []*schema.Column{IntegrationConnectionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "integration_connections_users_integration_connections",
+				Columns:    []*schema.Column{IntegrationConnectionsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "integrationconnection_user_id_provider",
+				Unique:  true,
+				Columns: []*schema.Column{IntegrationConnectionsColumns[7], IntegrationConnectionsColumns[1]},
+			},
+		},
+	}
 	// LeadsColumns holds the columns for the "leads" table.
 	LeadsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -1156,12 +1575,20 @@ var (
 		{Name: "latitude", Type: field.TypeFloat64, Nullable: true},
 		{Name: "longitude", Type: field.TypeFloat64, Nullable: true},
 		{Name: "verified", Type: field.TypeBool, Default: false},
+		{Name: "verified_since", Type: field.TypeTime, Nullable: true},
+		{Name: "verified_by", Type: field.TypeInt, Nullable: true},
 		{Name: "quality_score", Type: field.TypeInt, Default: 50},
+		{Name: "completeness_score", Type: field.TypeInt, Default: 0},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"new", "contacted", "qualified", "negotiating", "won", "lost", "archived"}, Default: "new"},
 		{Name: "status_changed_at", Type: field.TypeTime},
+		{Name: "sla_overdue_since", Type: field.TypeTime, Nullable: true},
 		{Name: "custom_fields", Type: field.TypeJSON, Nullable: true},
+		{Name: "tags", Type: field.TypeJSON, Nullable: true},
 		{Name: "osm_id", Type: field.TypeString, Nullable: true},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "source", Type: field.TypeEnum, Enums: []string{"osm", "csv_import", "manual"}, Default: "osm"},
+		{Name: "source_id", Type: field.TypeString, Nullable: true},
+		{Name: "last_seen_at", Type: field.TypeTime, Nullable: true},
 		{Name: "sub_niche", Type: field.TypeString, Nullable: true},
 		{Name: "specialties", Type: field.TypeJSON, Nullable: true},
 		{Name: "cuisine_type", Type: field.TypeString, Nullable: true},
@@ -1178,6 +1605,7 @@ var (
 		{Name: "email_validated", Type: field.TypeBool, Default: false},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "owner_organization_id", Type: field.TypeInt, Nullable: true},
 		{Name: "territory_leads", Type: field.TypeInt, Nullable: true},
 	}
 	// LeadsTable holds the schema information for the "leads" table.
@@ -1186,9 +1614,15 @@ var (
 		Columns:    LeadsColumns,
 		PrimaryKey: []*schema.Column{LeadsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "leads_organizations_owned_leads",
+				Columns:    []*schema.Column{LeadsColumns[44]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "leads_territories_leads",
-				Columns:    []*schema.Column{LeadsColumns[36]},
+				Columns:    []*schema.Column{LeadsColumns[45]},
 				RefColumns: []*schema.Column{TerritoriesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -1232,57 +1666,84 @@ var (
 			{
 				Name:    "lead_quality_score",
 				Unique:  false,
-				Columns: []*schema.Column{LeadsColumns[14]},
+				Columns: []*schema.Column{LeadsColumns[16]},
+			},
+			{
+				Name:    "lead_completeness_score",
+				Unique:  false,
+				Columns: []*schema.Column{LeadsColumns[17]},
+			},
+			{
+				Name:    "lead_sla_overdue_since",
+				Unique:  false,
+				Columns: []*schema.Column{LeadsColumns[20]},
 			},
 			{
 				Name:    "lead_osm_id",
 				Unique:  true,
-				Columns: []*schema.Column{LeadsColumns[18]},
+				Columns: []*schema.Column{LeadsColumns[23]},
+			},
+			{
+				Name:    "lead_source_source_id",
+				Unique:  false,
+				Columns: []*schema.Column{LeadsColumns[25], LeadsColumns[26]},
+			},
+			{
+				Name:    "lead_last_seen_at",
+				Unique:  false,
+				Columns: []*schema.Column{LeadsColumns[27]},
+			},
+			{
+				Name:    "lead_owner_organization_id",
+				Unique:  false,
+				Columns: []*schema.Column{LeadsColumns[44]},
 			},
 			{
 				Name:    "lead_industry_sub_niche",
 				Unique:  false,
-				Columns: []*schema.Column{LeadsColumns[2], LeadsColumns[20]},
+				Columns: []*schema.Column{LeadsColumns[2], LeadsColumns[28]},
 			},
 			{
 				Name:    "lead_industry_country_sub_niche",
 				Unique:  false,
-				Columns: []*schema.Column{LeadsColumns[2], LeadsColumns[3], LeadsColumns[20]},
+				Columns: []*schema.Column{LeadsColumns[2], LeadsColumns[3], LeadsColumns[28]},
 			},
 			{
 				Name:    "lead_sub_niche",
 				Unique:  false,
-				Columns: []*schema.Column{LeadsColumns[20]},
+				Columns: []*schema.Column{LeadsColumns[28]},
 			},
 			{
 				Name:    "lead_cuisine_type",
 				Unique:  false,
-				Columns: []*schema.Column{LeadsColumns[22]},
+				Columns: []*schema.Column{LeadsColumns[30]},
 			},
 			{
 				Name:    "lead_sport_type",
 				Unique:  false,
-				Columns: []*schema.Column{LeadsColumns[23]},
+				Columns: []*schema.Column{LeadsColumns[31]},
 			},
 			{
 				Name:    "lead_tattoo_style",
 				Unique:  false,
-				Columns: []*schema.Column{LeadsColumns[24]},
+				Columns: []*schema.Column{LeadsColumns[32]},
 			},
 			{
 				Name:    "lead_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{LeadsColumns[34]},
+				Columns: []*schema.Column{LeadsColumns[42]},
 			},
 		},
 	}
 	// LeadAssignmentsColumns holds the columns for the "lead_assignments" table.
 	LeadAssignmentsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "assignment_type", Type: field.TypeEnum, Enums: []string{"auto", "manual"}, Default: "manual"},
+		{Name: "assignment_type", Type: field.TypeEnum, Enums: []string{"auto", "manual", "claim"}, Default: "manual"},
 		{Name: "assignment_reason", Type: field.TypeString, Nullable: true, Size: 200},
 		{Name: "assigned_at", Type: field.TypeTime},
 		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "ended_at", Type: field.TypeTime, Nullable: true},
+		{Name: "end_reason", Type: field.TypeEnum, Nullable: true, Enums: []string{"reassigned", "released", "expired"}},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "lead_id", Type: field.TypeInt},
 		{Name: "user_id", Type: field.TypeInt},
@@ -1296,19 +1757,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "lead_assignments_leads_assignments",
-				Columns:    []*schema.Column{LeadAssignmentsColumns[6]},
+				Columns:    []*schema.Column{LeadAssignmentsColumns[8]},
 				RefColumns: []*schema.Column{LeadsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "lead_assignments_users_assigned_leads",
-				Columns:    []*schema.Column{LeadAssignmentsColumns[7]},
+				Columns:    []*schema.Column{LeadAssignmentsColumns[9]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "lead_assignments_users_lead_assignments_made",
-				Columns:    []*schema.Column{LeadAssignmentsColumns[8]},
+				Columns:    []*schema.Column{LeadAssignmentsColumns[10]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -1317,12 +1778,20 @@ var (
 			{
 				Name:    "idx_lead_assignment_user_active",
 				Unique:  false,
-				Columns: []*schema.Column{LeadAssignmentsColumns[7], LeadAssignmentsColumns[4]},
+				Columns: []*schema.Column{LeadAssignmentsColumns[9], LeadAssignmentsColumns[4]},
 			},
 			{
 				Name:    "idx_lead_assignment_lead_active",
 				Unique:  false,
-				Columns: []*schema.Column{LeadAssignmentsColumns[6], LeadAssignmentsColumns[4]},
+				Columns: []*schema.Column{LeadAssignmentsColumns[8], LeadAssignmentsColumns[4]},
+			},
+			{
+				Name:    "uniq_lead_assignment_lead_current",
+				Unique:  true,
+				Columns: []*schema.Column{LeadAssignmentsColumns[8]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "is_active",
+				},
 			},
 			{
 				Name:    "idx_lead_assignment_time",
@@ -1336,11 +1805,83 @@ var (
 			},
 		},
 	}
+	// LeadChangesColumns holds the columns for the "lead_changes" table.
+	LeadChangesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "source", Type: field.TypeEnum, Enums: []string{"manual", "enrichment", "verification", "system"}},
+		{Name: "changes", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "lead_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+	}
+	// LeadChangesTable holds the schema information for the "lead_changes" table.
+	LeadChangesTable = &schema.Table{
+		Name:       "lead_changes",
+		Columns:    LeadChangesColumns,
+		PrimaryKey: []*schema.Column{LeadChangesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "lead_changes_leads_changes",
+				Columns:    []*schema.Column{LeadChangesColumns[4]},
+				RefColumns: []*schema.Column{LeadsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "lead_changes_users_lead_changes",
+				Columns:    []*schema.Column{LeadChangesColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_lead_change_lead_time",
+				Unique:  false,
+				Columns: []*schema.Column{LeadChangesColumns[4], LeadChangesColumns[3]},
+			},
+			{
+				Name:    "idx_lead_change_user",
+				Unique:  false,
+				Columns: []*schema.Column{LeadChangesColumns[5]},
+			},
+		},
+	}
+	// LeadLicensesColumns holds the columns for the "lead_licenses" table.
+	LeadLicensesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "industry", Type: field.TypeString, Nullable: true},
+		{Name: "country", Type: field.TypeString, Nullable: true, Size: 2},
+		{Name: "created_by", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "organization_id", Type: field.TypeInt},
+	}
+	// LeadLicensesTable holds the schema information for the "lead_licenses" table.
+	LeadLicensesTable = &schema.Table{
+		Name:       "lead_licenses",
+		Columns:    LeadLicensesColumns,
+		PrimaryKey: []*schema.Column{LeadLicensesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "lead_licenses_organizations_lead_licenses",
+				Columns:    []*schema.Column{LeadLicensesColumns[5]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "leadlicense_organization_id_industry_country",
+				Unique:  true,
+				Columns: []*schema.Column{LeadLicensesColumns[5], LeadLicensesColumns[1], LeadLicensesColumns[2]},
+			},
+		},
+	}
 	// LeadNotesColumns holds the columns for the "lead_notes" table.
 	LeadNotesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "content", Type: field.TypeString, Size: 10000},
 		{Name: "is_pinned", Type: field.TypeBool, Default: false},
+		{Name: "visibility", Type: field.TypeEnum, Enums: []string{"private", "team"}, Default: "team"},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "lead_id", Type: field.TypeInt},
@@ -1354,13 +1895,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "lead_notes_leads_notes",
-				Columns:    []*schema.Column{LeadNotesColumns[5]},
+				Columns:    []*schema.Column{LeadNotesColumns[6]},
 				RefColumns: []*schema.Column{LeadsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "lead_notes_users_lead_notes",
-				Columns:    []*schema.Column{LeadNotesColumns[6]},
+				Columns:    []*schema.Column{LeadNotesColumns[7]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -1369,17 +1910,17 @@ var (
 			{
 				Name:    "leadnote_lead_id_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{LeadNotesColumns[5], LeadNotesColumns[3]},
+				Columns: []*schema.Column{LeadNotesColumns[6], LeadNotesColumns[4]},
 			},
 			{
 				Name:    "leadnote_user_id_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{LeadNotesColumns[6], LeadNotesColumns[3]},
+				Columns: []*schema.Column{LeadNotesColumns[7], LeadNotesColumns[4]},
 			},
 			{
 				Name:    "leadnote_lead_id_is_pinned_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{LeadNotesColumns[5], LeadNotesColumns[2], LeadNotesColumns[3]},
+				Columns: []*schema.Column{LeadNotesColumns[6], LeadNotesColumns[2], LeadNotesColumns[4]},
 			},
 		},
 	}
@@ -1448,6 +1989,47 @@ var (
 			},
 		},
 	}
+	// LeadReindexJobsColumns holds the columns for the "lead_reindex_jobs" table.
+	LeadReindexJobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "processing", "completed", "failed"}, Default: "pending"},
+		{Name: "dry_run", Type: field.TypeBool, Default: false},
+		{Name: "total_leads", Type: field.TypeInt, Default: 0},
+		{Name: "processed", Type: field.TypeInt, Default: 0},
+		{Name: "affected", Type: field.TypeInt, Default: 0},
+		{Name: "error_message", Type: field.TypeString, Nullable: true},
+		{Name: "started_at", Type: field.TypeTime, Nullable: true},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// LeadReindexJobsTable holds the schema information for the "lead_reindex_jobs" table.
+	LeadReindexJobsTable = &schema.Table{
+		Name:       "lead_reindex_jobs",
+		Columns:    LeadReindexJobsColumns,
+		PrimaryKey: []*schema.Column{LeadReindexJobsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "lead_reindex_jobs_users_lead_reindex_jobs",
+				Columns:    []*schema.Column{LeadReindexJobsColumns[11]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "leadreindexjob_status",
+				Unique:  false,
+				Columns: []*schema.Column{LeadReindexJobsColumns[1]},
+			},
+			{
+				Name:    "leadreindexjob_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{LeadReindexJobsColumns[9]},
+			},
+		},
+	}
 	// LeadStatusHistoriesColumns holds the columns for the "lead_status_histories" table.
 	LeadStatusHistoriesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -1495,6 +2077,102 @@ var (
 			},
 		},
 	}
+	// LeadSuppressionsColumns holds the columns for the "lead_suppressions" table.
+	LeadSuppressionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "lead_id", Type: field.TypeInt},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// LeadSuppressionsTable holds the schema information for the "lead_suppressions" table.
+	LeadSuppressionsTable = &schema.Table{
+		Name:       "lead_suppressions",
+		Columns:    LeadSuppressionsColumns,
+		PrimaryKey: []*schema.Column{LeadSuppressionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "lead_suppressions_leads_suppressions",
+				Columns:    []*schema.Column{LeadSuppressionsColumns[3]},
+				RefColumns: []*schema.Column{LeadsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "lead_suppressions_organizations_lead_suppressions",
+				Columns:    []*schema.Column{LeadSuppressionsColumns[4]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "lead_suppressions_users_lead_suppressions",
+				Columns:    []*schema.Column{LeadSuppressionsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "leadsuppression_user_id_lead_id",
+				Unique:  true,
+				Columns: []*schema.Column{LeadSuppressionsColumns[5], LeadSuppressionsColumns[3]},
+			},
+			{
+				Name:    "leadsuppression_organization_id",
+				Unique:  false,
+				Columns: []*schema.Column{LeadSuppressionsColumns[4]},
+			},
+			{
+				Name:    "leadsuppression_lead_id",
+				Unique:  false,
+				Columns: []*schema.Column{LeadSuppressionsColumns[3]},
+			},
+		},
+	}
+	// LeadVerificationsColumns holds the columns for the "lead_verifications" table.
+	LeadVerificationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"verify", "unverify"}},
+		{Name: "method", Type: field.TypeEnum, Nullable: true, Enums: []string{"phone_confirmed", "email_confirmed", "website_checked", "site_visit", "third_party", "manual_review"}},
+		{Name: "note", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "data_fingerprint", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "lead_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// LeadVerificationsTable holds the schema information for the "lead_verifications" table.
+	LeadVerificationsTable = &schema.Table{
+		Name:       "lead_verifications",
+		Columns:    LeadVerificationsColumns,
+		PrimaryKey: []*schema.Column{LeadVerificationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "lead_verifications_leads_verifications",
+				Columns:    []*schema.Column{LeadVerificationsColumns[7]},
+				RefColumns: []*schema.Column{LeadsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "lead_verifications_users_lead_verifications",
+				Columns:    []*schema.Column{LeadVerificationsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_lead_verifications_lead_time",
+				Unique:  false,
+				Columns: []*schema.Column{LeadVerificationsColumns[7], LeadVerificationsColumns[6]},
+			},
+			{
+				Name:    "idx_lead_verifications_user",
+				Unique:  false,
+				Columns: []*schema.Column{LeadVerificationsColumns[8]},
+			},
+		},
+	}
 	// MarketReportsColumns holds the columns for the "market_reports" table.
 	MarketReportsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -1569,6 +2247,7 @@ var (
 		{Name: "stripe_customer_id", Type: field.TypeString, Nullable: true},
 		{Name: "billing_email", Type: field.TypeString, Nullable: true},
 		{Name: "active", Type: field.TypeBool, Default: true},
+		{Name: "mask_unassigned_contacts", Type: field.TypeBool, Default: false},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "saml_enabled", Type: field.TypeBool, Default: false},
@@ -1586,7 +2265,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "organizations_users_owned_organizations",
-				Columns:    []*schema.Column{OrganizationsColumns[17]},
+				Columns:    []*schema.Column{OrganizationsColumns[18]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -1600,7 +2279,7 @@ var (
 			{
 				Name:    "organization_owner_id",
 				Unique:  false,
-				Columns: []*schema.Column{OrganizationsColumns[17]},
+				Columns: []*schema.Column{OrganizationsColumns[18]},
 			},
 			{
 				Name:    "organization_subscription_tier",
@@ -1615,7 +2294,7 @@ var (
 			{
 				Name:    "organization_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{OrganizationsColumns[10]},
+				Columns: []*schema.Column{OrganizationsColumns[11]},
 			},
 		},
 	}
@@ -1864,6 +2543,7 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "name", Type: field.TypeString, Size: 100},
 		{Name: "filters", Type: field.TypeJSON},
+		{Name: "track_changes", Type: field.TypeBool, Default: false},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "user_id", Type: field.TypeInt},
@@ -1876,7 +2556,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "saved_searches_users_saved_searches",
-				Columns:    []*schema.Column{SavedSearchesColumns[5]},
+				Columns:    []*schema.Column{SavedSearchesColumns[6]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -1885,18 +2565,117 @@ var (
 			{
 				Name:    "savedsearch_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{SavedSearchesColumns[5]},
+				Columns: []*schema.Column{SavedSearchesColumns[6]},
 			},
 			{
 				Name:    "savedsearch_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{SavedSearchesColumns[3]},
+				Columns: []*schema.Column{SavedSearchesColumns[4]},
+			},
+		},
+	}
+	// SavedSearchSnapshotsColumns holds the columns for the "saved_search_snapshots" table.
+	SavedSearchSnapshotsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "lead_ids", Type: field.TypeBytes},
+		{Name: "lead_count", Type: field.TypeInt},
+		{Name: "truncated", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "saved_search_id", Type: field.TypeInt},
+	}
+	// SavedSearchSnapshotsTable holds the schema information for the "saved_search_snapshots" table.
+	SavedSearchSnapshotsTable = &schema.Table{
+		Name:       "saved_search_snapshots",
+		Columns:    SavedSearchSnapshotsColumns,
+		PrimaryKey: []*schema.Column{SavedSearchSnapshotsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "saved_search_snapshots_saved_searches_snapshots",
+				Columns:    []*schema.Column{SavedSearchSnapshotsColumns[5]},
+				RefColumns: []*schema.Column{SavedSearchesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "savedsearchsnapshot_saved_search_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SavedSearchSnapshotsColumns[5], SavedSearchSnapshotsColumns[4]},
+			},
+		},
+	}
+	// ScheduledExportsColumns holds the columns for the "scheduled_exports" table.
+	ScheduledExportsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "format", Type: field.TypeEnum, Enums: []string{"csv", "excel", "vcard", "geojson", "kml"}},
+		{Name: "columns", Type: field.TypeJSON, Nullable: true},
+		{Name: "filters", Type: field.TypeJSON, Nullable: true},
+		{Name: "max_leads", Type: field.TypeInt, Default: 0},
+		{Name: "since_last_export", Type: field.TypeBool, Default: false},
+		{Name: "cadence", Type: field.TypeString, Size: 100},
+		{Name: "delivery_method", Type: field.TypeEnum, Enums: []string{"download", "url"}, Default: "download"},
+		{Name: "delivery_url", Type: field.TypeString, Nullable: true, Size: 2048},
+		{Name: "delivery_secret", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "paused"}, Default: "active"},
+		{Name: "next_run_at", Type: field.TypeTime},
+		{Name: "last_run_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_export_id", Type: field.TypeInt, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "run_count", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
+		{Name: "saved_search_id", Type: field.TypeInt, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// ScheduledExportsTable holds the schema information for the "scheduled_exports" table.
+	ScheduledExportsTable = &schema.Table{
+		Name:       "scheduled_exports",
+		Columns:    ScheduledExportsColumns,
+		PrimaryKey: []*schema.Column{ScheduledExportsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "scheduled_exports_organizations_scheduled_exports",
+				Columns:    []*schema.Column{ScheduledExportsColumns[19]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "scheduled_exports_saved_searches_scheduled_exports",
+				Columns:    []*schema.Column{ScheduledExportsColumns[20]},
+				RefColumns: []*schema.Column{SavedSearchesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "scheduled_exports_users_scheduled_exports",
+				Columns:    []*schema.Column{ScheduledExportsColumns[21]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "scheduledexport_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{ScheduledExportsColumns[21]},
+			},
+			{
+				Name:    "scheduledexport_status_next_run_at",
+				Unique:  false,
+				Columns: []*schema.Column{ScheduledExportsColumns[11], ScheduledExportsColumns[12]},
+			},
+			{
+				Name:    "scheduledexport_saved_search_id",
+				Unique:  false,
+				Columns: []*schema.Column{ScheduledExportsColumns[20]},
 			},
 		},
 	}
 	// SubscriptionsColumns holds the columns for the "subscriptions" table.
 	SubscriptionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
 		{Name: "tier", Type: field.TypeEnum, Enums: []string{"free", "starter", "pro", "business"}},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "canceled", "past_due", "unpaid", "trialing"}, Default: "active"},
 		{Name: "stripe_subscription_id", Type: field.TypeString, Nullable: true},
@@ -1917,7 +2696,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "subscriptions_users_subscriptions",
-				Columns:    []*schema.Column{SubscriptionsColumns[11]},
+				Columns:    []*schema.Column{SubscriptionsColumns[12]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -1926,22 +2705,27 @@ var (
 			{
 				Name:    "subscription_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{SubscriptionsColumns[11]},
+				Columns: []*schema.Column{SubscriptionsColumns[12]},
+			},
+			{
+				Name:    "subscription_organization_id",
+				Unique:  false,
+				Columns: []*schema.Column{SubscriptionsColumns[1]},
 			},
 			{
 				Name:    "subscription_stripe_subscription_id",
 				Unique:  true,
-				Columns: []*schema.Column{SubscriptionsColumns[3]},
+				Columns: []*schema.Column{SubscriptionsColumns[4]},
 			},
 			{
 				Name:    "subscription_status",
 				Unique:  false,
-				Columns: []*schema.Column{SubscriptionsColumns[2]},
+				Columns: []*schema.Column{SubscriptionsColumns[3]},
 			},
 			{
 				Name:    "subscription_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{SubscriptionsColumns[9]},
+				Columns: []*schema.Column{SubscriptionsColumns[10]},
 			},
 		},
 	}
@@ -2042,10 +2826,46 @@ var (
 			},
 		},
 	}
+	// UsageDailyAggregatesColumns holds the columns for the "usage_daily_aggregates" table.
+	UsageDailyAggregatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "date", Type: field.TypeTime},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"search", "export", "api_call", "enrichment"}},
+		{Name: "events", Type: field.TypeInt, Default: 0},
+		{Name: "count", Type: field.TypeInt, Default: 0},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// UsageDailyAggregatesTable holds the schema information for the "usage_daily_aggregates" table.
+	UsageDailyAggregatesTable = &schema.Table{
+		Name:       "usage_daily_aggregates",
+		Columns:    UsageDailyAggregatesColumns,
+		PrimaryKey: []*schema.Column{UsageDailyAggregatesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "usage_daily_aggregates_users_usage_daily_aggregates",
+				Columns:    []*schema.Column{UsageDailyAggregatesColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "usagedailyaggregate_user_id_date_action",
+				Unique:  true,
+				Columns: []*schema.Column{UsageDailyAggregatesColumns[6], UsageDailyAggregatesColumns[1], UsageDailyAggregatesColumns[2]},
+			},
+			{
+				Name:    "usagedailyaggregate_date",
+				Unique:  false,
+				Columns: []*schema.Column{UsageDailyAggregatesColumns[1]},
+			},
+		},
+	}
 	// UsageLogsColumns holds the columns for the "usage_logs" table.
 	UsageLogsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "action", Type: field.TypeEnum, Enums: []string{"search", "export", "api_call"}},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"search", "export", "api_call", "enrichment"}},
 		{Name: "count", Type: field.TypeInt, Default: 1},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
@@ -2196,6 +3016,36 @@ var (
 			},
 		},
 	}
+	// UserNotificationPreferencesColumns holds the columns for the "user_notification_preferences" table.
+	UserNotificationPreferencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "event_type", Type: field.TypeString, Size: 50},
+		{Name: "email", Type: field.TypeBool},
+		{Name: "webhook", Type: field.TypeBool},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// UserNotificationPreferencesTable holds the schema information for the "user_notification_preferences" table.
+	UserNotificationPreferencesTable = &schema.Table{
+		Name:       "user_notification_preferences",
+		Columns:    UserNotificationPreferencesColumns,
+		PrimaryKey: []*schema.Column{UserNotificationPreferencesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_notification_preferences_users_notification_preferences",
+				Columns:    []*schema.Column{UserNotificationPreferencesColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "usernotificationpreference_user_id_event_type",
+				Unique:  true,
+				Columns: []*schema.Column{UserNotificationPreferencesColumns[5], UserNotificationPreferencesColumns[1]},
+			},
+		},
+	}
 	// WebhooksColumns holds the columns for the "webhooks" table.
 	WebhooksColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -2203,13 +3053,24 @@ var (
 		{Name: "events", Type: field.TypeJSON},
 		{Name: "secret", Type: field.TypeString},
 		{Name: "active", Type: field.TypeBool, Default: true},
+		{Name: "paused_at", Type: field.TypeTime, Nullable: true},
+		{Name: "queued_events", Type: field.TypeJSON, Nullable: true},
+		{Name: "schema_version", Type: field.TypeString, Default: "1"},
 		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "delivery_timezone", Type: field.TypeString, Nullable: true},
+		{Name: "delivery_start_hour", Type: field.TypeInt, Nullable: true},
+		{Name: "delivery_end_hour", Type: field.TypeInt, Nullable: true},
+		{Name: "batch_max_events", Type: field.TypeInt, Nullable: true},
+		{Name: "batch_window_seconds", Type: field.TypeInt, Nullable: true},
+		{Name: "redaction_profile", Type: field.TypeString, Nullable: true},
+		{Name: "lead_change_cursor", Type: field.TypeInt, Nullable: true},
 		{Name: "retry_count", Type: field.TypeInt, Default: 3},
 		{Name: "last_triggered_at", Type: field.TypeTime, Nullable: true},
 		{Name: "success_count", Type: field.TypeInt, Default: 0},
 		{Name: "failure_count", Type: field.TypeInt, Default: 0},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
 		{Name: "user_webhooks", Type: field.TypeInt},
 	}
 	// WebhooksTable holds the schema information for the "webhooks" table.
@@ -2218,9 +3079,15 @@ var (
 		Columns:    WebhooksColumns,
 		PrimaryKey: []*schema.Column{WebhooksColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhooks_organizations_webhooks",
+				Columns:    []*schema.Column{WebhooksColumns[22]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "webhooks_users_webhooks",
-				Columns:    []*schema.Column{WebhooksColumns[12]},
+				Columns:    []*schema.Column{WebhooksColumns[23]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -2231,10 +3098,85 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{WebhooksColumns[4]},
 			},
+			{
+				Name:    "webhook_organization_id",
+				Unique:  false,
+				Columns: []*schema.Column{WebhooksColumns[22]},
+			},
 			{
 				Name:    "webhook_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{WebhooksColumns[10]},
+				Columns: []*schema.Column{WebhooksColumns[20]},
+			},
+		},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "event_id", Type: field.TypeString, Nullable: true},
+		{Name: "event", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeJSON},
+		{Name: "schema_version", Type: field.TypeString},
+		{Name: "attempts", Type: field.TypeJSON, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"failed", "delivered"}, Default: "failed"},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "replay_count", Type: field.TypeInt, Default: 0},
+		{Name: "last_replayed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "webhook_id", Type: field.TypeInt},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_webhooks_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[12]},
+				RefColumns: []*schema.Column{WebhooksColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_webhook_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[12], WebhookDeliveriesColumns[6]},
+			},
+			{
+				Name:    "webhookdelivery_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[10]},
+			},
+		},
+	}
+	// ZapierDeliveriesColumns holds the columns for the "zapier_deliveries" table.
+	ZapierDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "lead_id", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "api_key_id", Type: field.TypeInt},
+	}
+	// ZapierDeliveriesTable holds the schema information for the "zapier_deliveries" table.
+	ZapierDeliveriesTable = &schema.Table{
+		Name:       "zapier_deliveries",
+		Columns:    ZapierDeliveriesColumns,
+		PrimaryKey: []*schema.Column{ZapierDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "zapier_deliveries_api_keys_zapier_deliveries",
+				Columns:    []*schema.Column{ZapierDeliveriesColumns[3]},
+				RefColumns: []*schema.Column{APIKeysColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "zapierdelivery_api_key_id_lead_id",
+				Unique:  true,
+				Columns: []*schema.Column{ZapierDeliveriesColumns[3], ZapierDeliveriesColumns[1]},
 			},
 		},
 	}
@@ -2247,24 +3189,38 @@ var (
 		AuditLogsTable,
 		CrmIntegrationsTable,
 		CrmLeadSyncsTable,
+		CrmPushJobsTable,
 		CallLogsTable,
 		CompetitorMetricsTable,
 		CompetitorProfilesTable,
+		ContactAttemptsTable,
 		EmailCampaignsTable,
 		EmailCampaignRecipientsTable,
+		EmailSendsTable,
 		EmailSequencesTable,
 		EmailSequenceEnrollmentsTable,
 		EmailSequenceSendsTable,
 		EmailSequenceStepsTable,
+		EmailSuppressionsTable,
+		EnrichmentAttemptsTable,
+		EnrichmentBudgetsTable,
 		ExperimentsTable,
 		ExperimentAssignmentsTable,
 		ExportsTable,
+		ExportTemplatesTable,
+		ImportJobsTable,
 		IndustriesTable,
+		IntegrationConnectionsTable,
 		LeadsTable,
 		LeadAssignmentsTable,
+		LeadChangesTable,
+		LeadLicensesTable,
 		LeadNotesTable,
 		LeadRecommendationsTable,
+		LeadReindexJobsTable,
 		LeadStatusHistoriesTable,
+		LeadSuppressionsTable,
+		LeadVerificationsTable,
 		MarketReportsTable,
 		OrganizationsTable,
 		OrganizationMembersTable,
@@ -2272,13 +3228,19 @@ var (
 		SmsCampaignsTable,
 		SmsMessagesTable,
 		SavedSearchesTable,
+		SavedSearchSnapshotsTable,
+		ScheduledExportsTable,
 		SubscriptionsTable,
 		TerritoriesTable,
 		TerritoryMembersTable,
+		UsageDailyAggregatesTable,
 		UsageLogsTable,
 		UsersTable,
 		UserBehaviorsTable,
+		UserNotificationPreferencesTable,
 		WebhooksTable,
+		WebhookDeliveriesTable,
+		ZapierDeliveriesTable,
 	}
 )
 
@@ -2291,10 +3253,14 @@ func init() {
 	AuditLogsTable.ForeignKeys[0].RefTable = UsersTable
 	CrmIntegrationsTable.ForeignKeys[0].RefTable = UsersTable
 	CrmLeadSyncsTable.ForeignKeys[0].RefTable = CrmIntegrationsTable
+	CrmPushJobsTable.ForeignKeys[0].RefTable = CrmIntegrationsTable
+	CrmPushJobsTable.ForeignKeys[1].RefTable = UsersTable
 	CallLogsTable.ForeignKeys[0].RefTable = LeadsTable
 	CallLogsTable.ForeignKeys[1].RefTable = UsersTable
 	CompetitorMetricsTable.ForeignKeys[0].RefTable = CompetitorProfilesTable
 	CompetitorProfilesTable.ForeignKeys[0].RefTable = UsersTable
+	ContactAttemptsTable.ForeignKeys[0].RefTable = LeadsTable
+	ContactAttemptsTable.ForeignKeys[1].RefTable = UsersTable
 	EmailCampaignsTable.ForeignKeys[0].RefTable = UsersTable
 	EmailCampaignRecipientsTable.ForeignKeys[0].RefTable = EmailCampaignsTable
 	EmailSequencesTable.ForeignKeys[0].RefTable = UsersTable
@@ -2305,20 +3271,39 @@ func init() {
 	EmailSequenceSendsTable.ForeignKeys[1].RefTable = EmailSequenceStepsTable
 	EmailSequenceSendsTable.ForeignKeys[2].RefTable = LeadsTable
 	EmailSequenceStepsTable.ForeignKeys[0].RefTable = EmailSequencesTable
+	EnrichmentAttemptsTable.ForeignKeys[0].RefTable = LeadsTable
+	EnrichmentAttemptsTable.ForeignKeys[1].RefTable = UsersTable
+	EnrichmentBudgetsTable.ForeignKeys[0].RefTable = OrganizationsTable
+	EnrichmentBudgetsTable.ForeignKeys[1].RefTable = UsersTable
 	ExperimentAssignmentsTable.ForeignKeys[0].RefTable = ExperimentsTable
 	ExperimentAssignmentsTable.ForeignKeys[1].RefTable = UsersTable
 	ExportsTable.ForeignKeys[0].RefTable = OrganizationsTable
 	ExportsTable.ForeignKeys[1].RefTable = UsersTable
-	LeadsTable.ForeignKeys[0].RefTable = TerritoriesTable
+	ExportTemplatesTable.ForeignKeys[0].RefTable = OrganizationsTable
+	ExportTemplatesTable.ForeignKeys[1].RefTable = SavedSearchesTable
+	ExportTemplatesTable.ForeignKeys[2].RefTable = UsersTable
+	ImportJobsTable.ForeignKeys[0].RefTable = UsersTable
+	IntegrationConnectionsTable.ForeignKeys[0].RefTable = UsersTable
+	LeadsTable.ForeignKeys[0].RefTable = OrganizationsTable
+	LeadsTable.ForeignKeys[1].RefTable = TerritoriesTable
 	LeadAssignmentsTable.ForeignKeys[0].RefTable = LeadsTable
 	LeadAssignmentsTable.ForeignKeys[1].RefTable = UsersTable
 	LeadAssignmentsTable.ForeignKeys[2].RefTable = UsersTable
+	LeadChangesTable.ForeignKeys[0].RefTable = LeadsTable
+	LeadChangesTable.ForeignKeys[1].RefTable = UsersTable
+	LeadLicensesTable.ForeignKeys[0].RefTable = OrganizationsTable
 	LeadNotesTable.ForeignKeys[0].RefTable = LeadsTable
 	LeadNotesTable.ForeignKeys[1].RefTable = UsersTable
 	LeadRecommendationsTable.ForeignKeys[0].RefTable = LeadsTable
 	LeadRecommendationsTable.ForeignKeys[1].RefTable = UsersTable
+	LeadReindexJobsTable.ForeignKeys[0].RefTable = UsersTable
 	LeadStatusHistoriesTable.ForeignKeys[0].RefTable = LeadsTable
 	LeadStatusHistoriesTable.ForeignKeys[1].RefTable = UsersTable
+	LeadSuppressionsTable.ForeignKeys[0].RefTable = LeadsTable
+	LeadSuppressionsTable.ForeignKeys[1].RefTable = OrganizationsTable
+	LeadSuppressionsTable.ForeignKeys[2].RefTable = UsersTable
+	LeadVerificationsTable.ForeignKeys[0].RefTable = LeadsTable
+	LeadVerificationsTable.ForeignKeys[1].RefTable = UsersTable
 	MarketReportsTable.ForeignKeys[0].RefTable = UsersTable
 	OrganizationsTable.ForeignKeys[0].RefTable = UsersTable
 	OrganizationMembersTable.ForeignKeys[0].RefTable = OrganizationsTable
@@ -2329,12 +3314,21 @@ func init() {
 	SmsMessagesTable.ForeignKeys[0].RefTable = LeadsTable
 	SmsMessagesTable.ForeignKeys[1].RefTable = SmsCampaignsTable
 	SavedSearchesTable.ForeignKeys[0].RefTable = UsersTable
+	SavedSearchSnapshotsTable.ForeignKeys[0].RefTable = SavedSearchesTable
+	ScheduledExportsTable.ForeignKeys[0].RefTable = OrganizationsTable
+	ScheduledExportsTable.ForeignKeys[1].RefTable = SavedSearchesTable
+	ScheduledExportsTable.ForeignKeys[2].RefTable = UsersTable
 	SubscriptionsTable.ForeignKeys[0].RefTable = UsersTable
 	TerritoriesTable.ForeignKeys[0].RefTable = UsersTable
 	TerritoryMembersTable.ForeignKeys[0].RefTable = TerritoriesTable
 	TerritoryMembersTable.ForeignKeys[1].RefTable = UsersTable
 	TerritoryMembersTable.ForeignKeys[2].RefTable = UsersTable
+	UsageDailyAggregatesTable.ForeignKeys[0].RefTable = UsersTable
 	UsageLogsTable.ForeignKeys[0].RefTable = UsersTable
 	UserBehaviorsTable.ForeignKeys[0].RefTable = UsersTable
-	WebhooksTable.ForeignKeys[0].RefTable = UsersTable
+	UserNotificationPreferencesTable.ForeignKeys[0].RefTable = UsersTable
+	WebhooksTable.ForeignKeys[0].RefTable = OrganizationsTable
+	WebhooksTable.ForeignKeys[1].RefTable = UsersTable
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = WebhooksTable
+	ZapierDeliveriesTable.ForeignKeys[0].RefTable = APIKeysTable
 }
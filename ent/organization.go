@@ -9,6 +9,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/jordanlanch/industrydb/ent/enrichmentbudget"
 	"github.com/jordanlanch/industrydb/ent/organization"
 	"github.com/jordanlanch/industrydb/ent/user"
 )
@@ -38,6 +39,8 @@ type Organization struct {
 	BillingEmail *string `json:"billing_email,omitempty"`
 	// Whether organization is active
 	Active bool `json:"active,omitempty"`
+	// Whether members without an owner or admin role see masked emails and phones on leads not assigned to them
+	MaskUnassignedContacts bool `json:"mask_unassigned_contacts,omitempty"`
 	// Creation timestamp
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Last update timestamp
@@ -66,9 +69,23 @@ type OrganizationEdges struct {
 	Members []*OrganizationMember `json:"members,omitempty"`
 	// Organization exports
 	Exports []*Export `json:"exports,omitempty"`
+	// Leads suppressed for all members
+	LeadSuppressions []*LeadSuppression `json:"lead_suppressions,omitempty"`
+	// Export templates shared with all members
+	ExportTemplates []*ExportTemplate `json:"export_templates,omitempty"`
+	// Recurring exports run as this organization
+	ScheduledExports []*ScheduledExport `json:"scheduled_exports,omitempty"`
+	// Organization-wide webhooks
+	Webhooks []*Webhook `json:"webhooks,omitempty"`
+	// Leads only this organization sees when lead scoping is on
+	OwnedLeads []*Lead `json:"owned_leads,omitempty"`
+	// Global pool segments this organization may see when lead scoping is on
+	LeadLicenses []*LeadLicense `json:"lead_licenses,omitempty"`
+	// Monthly enrichment spend and limits of the organization
+	EnrichmentBudget *EnrichmentBudget `json:"enrichment_budget,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [10]bool
 }
 
 // OwnerOrErr returns the Owner value or an error if the edge
@@ -100,12 +117,77 @@ func (e OrganizationEdges) ExportsOrErr() ([]*Export, error) {
 	return nil, &NotLoadedError{edge: "exports"}
 }
 
+// LeadSuppressionsOrErr returns the LeadSuppressions value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) LeadSuppressionsOrErr() ([]*LeadSuppression, error) {
+	if e.loadedTypes[3] {
+		return e.LeadSuppressions, nil
+	}
+	return nil, &NotLoadedError{edge: "lead_suppressions"}
+}
+
+// ExportTemplatesOrErr returns the ExportTemplates value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) ExportTemplatesOrErr() ([]*ExportTemplate, error) {
+	if e.loadedTypes[4] {
+		return e.ExportTemplates, nil
+	}
+	return nil, &NotLoadedError{edge: "export_templates"}
+}
+
+// ScheduledExportsOrErr returns the ScheduledExports value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) ScheduledExportsOrErr() ([]*ScheduledExport, error) {
+	if e.loadedTypes[5] {
+		return e.ScheduledExports, nil
+	}
+	return nil, &NotLoadedError{edge: "scheduled_exports"}
+}
+
+// WebhooksOrErr returns the Webhooks value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) WebhooksOrErr() ([]*Webhook, error) {
+	if e.loadedTypes[6] {
+		return e.Webhooks, nil
+	}
+	return nil, &NotLoadedError{edge: "webhooks"}
+}
+
+// OwnedLeadsOrErr returns the OwnedLeads value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) OwnedLeadsOrErr() ([]*Lead, error) {
+	if e.loadedTypes[7] {
+		return e.OwnedLeads, nil
+	}
+	return nil, &NotLoadedError{edge: "owned_leads"}
+}
+
+// LeadLicensesOrErr returns the LeadLicenses value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) LeadLicensesOrErr() ([]*LeadLicense, error) {
+	if e.loadedTypes[8] {
+		return e.LeadLicenses, nil
+	}
+	return nil, &NotLoadedError{edge: "lead_licenses"}
+}
+
+// EnrichmentBudgetOrErr returns the EnrichmentBudget value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrganizationEdges) EnrichmentBudgetOrErr() (*EnrichmentBudget, error) {
+	if e.EnrichmentBudget != nil {
+		return e.EnrichmentBudget, nil
+	} else if e.loadedTypes[9] {
+		return nil, &NotFoundError{label: enrichmentbudget.Label}
+	}
+	return nil, &NotLoadedError{edge: "enrichment_budget"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Organization) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case organization.FieldActive, organization.FieldSamlEnabled:
+		case organization.FieldActive, organization.FieldMaskUnassignedContacts, organization.FieldSamlEnabled:
 			values[i] = new(sql.NullBool)
 		case organization.FieldID, organization.FieldOwnerID, organization.FieldUsageLimit, organization.FieldUsageCount:
 			values[i] = new(sql.NullInt64)
@@ -196,6 +278,12 @@ func (_m *Organization) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Active = value.Bool
 			}
+		case organization.FieldMaskUnassignedContacts:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field mask_unassigned_contacts", values[i])
+			} else if value.Valid {
+				_m.MaskUnassignedContacts = value.Bool
+			}
 		case organization.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -270,6 +358,41 @@ func (_m *Organization) QueryExports() *ExportQuery {
 	return NewOrganizationClient(_m.config).QueryExports(_m)
 }
 
+// QueryLeadSuppressions queries the "lead_suppressions" edge of the Organization entity.
+func (_m *Organization) QueryLeadSuppressions() *LeadSuppressionQuery {
+	return NewOrganizationClient(_m.config).QueryLeadSuppressions(_m)
+}
+
+// QueryExportTemplates queries the "export_templates" edge of the Organization entity.
+func (_m *Organization) QueryExportTemplates() *ExportTemplateQuery {
+	return NewOrganizationClient(_m.config).QueryExportTemplates(_m)
+}
+
+// QueryScheduledExports queries the "scheduled_exports" edge of the Organization entity.
+func (_m *Organization) QueryScheduledExports() *ScheduledExportQuery {
+	return NewOrganizationClient(_m.config).QueryScheduledExports(_m)
+}
+
+// QueryWebhooks queries the "webhooks" edge of the Organization entity.
+func (_m *Organization) QueryWebhooks() *WebhookQuery {
+	return NewOrganizationClient(_m.config).QueryWebhooks(_m)
+}
+
+// QueryOwnedLeads queries the "owned_leads" edge of the Organization entity.
+func (_m *Organization) QueryOwnedLeads() *LeadQuery {
+	return NewOrganizationClient(_m.config).QueryOwnedLeads(_m)
+}
+
+// QueryLeadLicenses queries the "lead_licenses" edge of the Organization entity.
+func (_m *Organization) QueryLeadLicenses() *LeadLicenseQuery {
+	return NewOrganizationClient(_m.config).QueryLeadLicenses(_m)
+}
+
+// QueryEnrichmentBudget queries the "enrichment_budget" edge of the Organization entity.
+func (_m *Organization) QueryEnrichmentBudget() *EnrichmentBudgetQuery {
+	return NewOrganizationClient(_m.config).QueryEnrichmentBudget(_m)
+}
+
 // Update returns a builder for updating this Organization.
 // Note that you need to call Organization.Unwrap() before calling this method if this Organization
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -327,6 +450,9 @@ func (_m *Organization) String() string {
 	builder.WriteString("active=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Active))
 	builder.WriteString(", ")
+	builder.WriteString("mask_unassigned_contacts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaskUnassignedContacts))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
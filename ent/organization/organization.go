@@ -35,6 +35,8 @@ const (
 	FieldBillingEmail = "billing_email"
 	// FieldActive holds the string denoting the active field in the database.
 	FieldActive = "active"
+	// FieldMaskUnassignedContacts holds the string denoting the mask_unassigned_contacts field in the database.
+	FieldMaskUnassignedContacts = "mask_unassigned_contacts"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -55,6 +57,20 @@ const (
 	EdgeMembers = "members"
 	// EdgeExports holds the string denoting the exports edge name in mutations.
 	EdgeExports = "exports"
+	// EdgeLeadSuppressions holds the string denoting the lead_suppressions edge name in mutations.
+	EdgeLeadSuppressions = "lead_suppressions"
+	// EdgeExportTemplates holds the string denoting the export_templates edge name in mutations.
+	EdgeExportTemplates = "export_templates"
+	// EdgeScheduledExports holds the string denoting the scheduled_exports edge name in mutations.
+	EdgeScheduledExports = "scheduled_exports"
+	// EdgeWebhooks holds the string denoting the webhooks edge name in mutations.
+	EdgeWebhooks = "webhooks"
+	// EdgeOwnedLeads holds the string denoting the owned_leads edge name in mutations.
+	EdgeOwnedLeads = "owned_leads"
+	// EdgeLeadLicenses holds the string denoting the lead_licenses edge name in mutations.
+	EdgeLeadLicenses = "lead_licenses"
+	// EdgeEnrichmentBudget holds the string denoting the enrichment_budget edge name in mutations.
+	EdgeEnrichmentBudget = "enrichment_budget"
 	// Table holds the table name of the organization in the database.
 	Table = "organizations"
 	// OwnerTable is the table that holds the owner relation/edge.
@@ -78,6 +94,55 @@ const (
 	ExportsInverseTable = "exports"
 	// ExportsColumn is the table column denoting the exports relation/edge.
 	ExportsColumn = "organization_id"
+	// LeadSuppressionsTable is the table that holds the lead_suppressions relation/edge.
+	LeadSuppressionsTable = "lead_suppressions"
+	// LeadSuppressionsInverseTable is the table name for the LeadSuppression entity.
+	// It exists in this package in order to avoid circular dependency with the "leadsuppression" package.
+	LeadSuppressionsInverseTable = "lead_suppressions"
+	// LeadSuppressionsColumn is the table column denoting the lead_suppressions relation/edge.
+	LeadSuppressionsColumn = "organization_id"
+	// ExportTemplatesTable is the table that holds the export_templates relation/edge.
+	ExportTemplatesTable = "export_templates"
+	// ExportTemplatesInverseTable is the table name for the ExportTemplate entity.
+	// It exists in this package in order to avoid circular dependency with the "exporttemplate" package.
+	ExportTemplatesInverseTable = "export_templates"
+	// ExportTemplatesColumn is the table column denoting the export_templates relation/edge.
+	ExportTemplatesColumn = "organization_id"
+	// ScheduledExportsTable is the table that holds the scheduled_exports relation/edge.
+	ScheduledExportsTable = "scheduled_exports"
+	// ScheduledExportsInverseTable is the table name for the ScheduledExport entity.
+	// It exists in this package in order to avoid circular dependency with the "scheduledexport" package.
+	ScheduledExportsInverseTable = "scheduled_exports"
+	// ScheduledExportsColumn is the table column denoting the scheduled_exports relation/edge.
+	ScheduledExportsColumn = "organization_id"
+	// WebhooksTable is the table that holds the webhooks relation/edge.
+	WebhooksTable = "webhooks"
+	// WebhooksInverseTable is the table name for the Webhook entity.
+	// It exists in this package in order to avoid circular dependency with the "webhook" package.
+	WebhooksInverseTable = "webhooks"
+	// WebhooksColumn is the table column denoting the webhooks relation/edge.
+	WebhooksColumn = "organization_id"
+	// OwnedLeadsTable is the table that holds the owned_leads relation/edge.
+	OwnedLeadsTable = "leads"
+	// OwnedLeadsInverseTable is the table name for the Lead entity.
+	// It exists in this package in order to avoid circular dependency with the "lead" package.
+	OwnedLeadsInverseTable = "leads"
+	// OwnedLeadsColumn is the table column denoting the owned_leads relation/edge.
+	OwnedLeadsColumn = "owner_organization_id"
+	// LeadLicensesTable is the table that holds the lead_licenses relation/edge.
+	LeadLicensesTable = "lead_licenses"
+	// LeadLicensesInverseTable is the table name for the LeadLicense entity.
+	// It exists in this package in order to avoid circular dependency with the "leadlicense" package.
+	LeadLicensesInverseTable = "lead_licenses"
+	// LeadLicensesColumn is the table column denoting the lead_licenses relation/edge.
+	LeadLicensesColumn = "organization_id"
+	// EnrichmentBudgetTable is the table that holds the enrichment_budget relation/edge.
+	EnrichmentBudgetTable = "enrichment_budgets"
+	// EnrichmentBudgetInverseTable is the table name for the EnrichmentBudget entity.
+	// It exists in this package in order to avoid circular dependency with the "enrichmentbudget" package.
+	EnrichmentBudgetInverseTable = "enrichment_budgets"
+	// EnrichmentBudgetColumn is the table column denoting the enrichment_budget relation/edge.
+	EnrichmentBudgetColumn = "organization_id"
 )
 
 // Columns holds all SQL columns for organization fields.
@@ -93,6 +158,7 @@ var Columns = []string{
 	FieldStripeCustomerID,
 	FieldBillingEmail,
 	FieldActive,
+	FieldMaskUnassignedContacts,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldSamlEnabled,
@@ -129,6 +195,8 @@ var (
 	DefaultLastResetAt func() time.Time
 	// DefaultActive holds the default value on creation for the "active" field.
 	DefaultActive bool
+	// DefaultMaskUnassignedContacts holds the default value on creation for the "mask_unassigned_contacts" field.
+	DefaultMaskUnassignedContacts bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -225,6 +293,11 @@ func ByActive(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldActive, opts...).ToFunc()
 }
 
+// ByMaskUnassignedContacts orders the results by the mask_unassigned_contacts field.
+func ByMaskUnassignedContacts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaskUnassignedContacts, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -294,6 +367,97 @@ func ByExports(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newExportsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByLeadSuppressionsCount orders the results by lead_suppressions count.
+func ByLeadSuppressionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLeadSuppressionsStep(), opts...)
+	}
+}
+
+// ByLeadSuppressions orders the results by lead_suppressions terms.
+func ByLeadSuppressions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLeadSuppressionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByExportTemplatesCount orders the results by export_templates count.
+func ByExportTemplatesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newExportTemplatesStep(), opts...)
+	}
+}
+
+// ByExportTemplates orders the results by export_templates terms.
+func ByExportTemplates(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newExportTemplatesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByScheduledExportsCount orders the results by scheduled_exports count.
+func ByScheduledExportsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newScheduledExportsStep(), opts...)
+	}
+}
+
+// ByScheduledExports orders the results by scheduled_exports terms.
+func ByScheduledExports(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newScheduledExportsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWebhooksCount orders the results by webhooks count.
+func ByWebhooksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWebhooksStep(), opts...)
+	}
+}
+
+// ByWebhooks orders the results by webhooks terms.
+func ByWebhooks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWebhooksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByOwnedLeadsCount orders the results by owned_leads count.
+func ByOwnedLeadsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOwnedLeadsStep(), opts...)
+	}
+}
+
+// ByOwnedLeads orders the results by owned_leads terms.
+func ByOwnedLeads(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOwnedLeadsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLeadLicensesCount orders the results by lead_licenses count.
+func ByLeadLicensesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLeadLicensesStep(), opts...)
+	}
+}
+
+// ByLeadLicenses orders the results by lead_licenses terms.
+func ByLeadLicenses(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLeadLicensesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByEnrichmentBudgetField orders the results by enrichment_budget field.
+func ByEnrichmentBudgetField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEnrichmentBudgetStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newOwnerStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -315,3 +479,52 @@ func newExportsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ExportsTable, ExportsColumn),
 	)
 }
+func newLeadSuppressionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LeadSuppressionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LeadSuppressionsTable, LeadSuppressionsColumn),
+	)
+}
+func newExportTemplatesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ExportTemplatesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ExportTemplatesTable, ExportTemplatesColumn),
+	)
+}
+func newScheduledExportsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ScheduledExportsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ScheduledExportsTable, ScheduledExportsColumn),
+	)
+}
+func newWebhooksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WebhooksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WebhooksTable, WebhooksColumn),
+	)
+}
+func newOwnedLeadsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OwnedLeadsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OwnedLeadsTable, OwnedLeadsColumn),
+	)
+}
+func newLeadLicensesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LeadLicensesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LeadLicensesTable, LeadLicensesColumn),
+	)
+}
+func newEnrichmentBudgetStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EnrichmentBudgetInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, EnrichmentBudgetTable, EnrichmentBudgetColumn),
+	)
+}
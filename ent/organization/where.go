@@ -100,6 +100,11 @@ func Active(v bool) predicate.Organization {
 	return predicate.Organization(sql.FieldEQ(FieldActive, v))
 }
 
+// MaskUnassignedContacts applies equality check predicate on the "mask_unassigned_contacts" field. It's identical to MaskUnassignedContactsEQ.
+func MaskUnassignedContacts(v bool) predicate.Organization {
+	return predicate.Organization(sql.FieldEQ(FieldMaskUnassignedContacts, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Organization {
 	return predicate.Organization(sql.FieldEQ(FieldCreatedAt, v))
@@ -585,6 +590,16 @@ func ActiveNEQ(v bool) predicate.Organization {
 	return predicate.Organization(sql.FieldNEQ(FieldActive, v))
 }
 
+// MaskUnassignedContactsEQ applies the EQ predicate on the "mask_unassigned_contacts" field.
+func MaskUnassignedContactsEQ(v bool) predicate.Organization {
+	return predicate.Organization(sql.FieldEQ(FieldMaskUnassignedContacts, v))
+}
+
+// MaskUnassignedContactsNEQ applies the NEQ predicate on the "mask_unassigned_contacts" field.
+func MaskUnassignedContactsNEQ(v bool) predicate.Organization {
+	return predicate.Organization(sql.FieldNEQ(FieldMaskUnassignedContacts, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Organization {
 	return predicate.Organization(sql.FieldEQ(FieldCreatedAt, v))
@@ -1044,6 +1059,167 @@ func HasExportsWith(preds ...predicate.Export) predicate.Organization {
 	})
 }
 
+// HasLeadSuppressions applies the HasEdge predicate on the "lead_suppressions" edge.
+func HasLeadSuppressions() predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LeadSuppressionsTable, LeadSuppressionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLeadSuppressionsWith applies the HasEdge predicate on the "lead_suppressions" edge with a given conditions (other predicates).
+func HasLeadSuppressionsWith(preds ...predicate.LeadSuppression) predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := newLeadSuppressionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasExportTemplates applies the HasEdge predicate on the "export_templates" edge.
+func HasExportTemplates() predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ExportTemplatesTable, ExportTemplatesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasExportTemplatesWith applies the HasEdge predicate on the "export_templates" edge with a given conditions (other predicates).
+func HasExportTemplatesWith(preds ...predicate.ExportTemplate) predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := newExportTemplatesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasScheduledExports applies the HasEdge predicate on the "scheduled_exports" edge.
+func HasScheduledExports() predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ScheduledExportsTable, ScheduledExportsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasScheduledExportsWith applies the HasEdge predicate on the "scheduled_exports" edge with a given conditions (other predicates).
+func HasScheduledExportsWith(preds ...predicate.ScheduledExport) predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := newScheduledExportsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWebhooks applies the HasEdge predicate on the "webhooks" edge.
+func HasWebhooks() predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WebhooksTable, WebhooksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWebhooksWith applies the HasEdge predicate on the "webhooks" edge with a given conditions (other predicates).
+func HasWebhooksWith(preds ...predicate.Webhook) predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := newWebhooksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOwnedLeads applies the HasEdge predicate on the "owned_leads" edge.
+func HasOwnedLeads() predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OwnedLeadsTable, OwnedLeadsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOwnedLeadsWith applies the HasEdge predicate on the "owned_leads" edge with a given conditions (other predicates).
+func HasOwnedLeadsWith(preds ...predicate.Lead) predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := newOwnedLeadsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLeadLicenses applies the HasEdge predicate on the "lead_licenses" edge.
+func HasLeadLicenses() predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LeadLicensesTable, LeadLicensesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLeadLicensesWith applies the HasEdge predicate on the "lead_licenses" edge with a given conditions (other predicates).
+func HasLeadLicensesWith(preds ...predicate.LeadLicense) predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := newLeadLicensesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEnrichmentBudget applies the HasEdge predicate on the "enrichment_budget" edge.
+func HasEnrichmentBudget() predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, EnrichmentBudgetTable, EnrichmentBudgetColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEnrichmentBudgetWith applies the HasEdge predicate on the "enrichment_budget" edge with a given conditions (other predicates).
+func HasEnrichmentBudgetWith(preds ...predicate.EnrichmentBudget) predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := newEnrichmentBudgetStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Organization) predicate.Organization {
 	return predicate.Organization(sql.AndPredicates(predicates...))
@@ -10,10 +10,17 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/enrichmentbudget"
 	"github.com/jordanlanch/industrydb/ent/export"
+	"github.com/jordanlanch/industrydb/ent/exporttemplate"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/leadlicense"
+	"github.com/jordanlanch/industrydb/ent/leadsuppression"
 	"github.com/jordanlanch/industrydb/ent/organization"
 	"github.com/jordanlanch/industrydb/ent/organizationmember"
+	"github.com/jordanlanch/industrydb/ent/scheduledexport"
 	"github.com/jordanlanch/industrydb/ent/user"
+	"github.com/jordanlanch/industrydb/ent/webhook"
 )
 
 // OrganizationCreate is the builder for creating a Organization entity.
@@ -139,6 +146,20 @@ func (_c *OrganizationCreate) SetNillableActive(v *bool) *OrganizationCreate {
 	return _c
 }
 
+// SetMaskUnassignedContacts sets the "mask_unassigned_contacts" field.
+func (_c *OrganizationCreate) SetMaskUnassignedContacts(v bool) *OrganizationCreate {
+	_c.mutation.SetMaskUnassignedContacts(v)
+	return _c
+}
+
+// SetNillableMaskUnassignedContacts sets the "mask_unassigned_contacts" field if the given value is not nil.
+func (_c *OrganizationCreate) SetNillableMaskUnassignedContacts(v *bool) *OrganizationCreate {
+	if v != nil {
+		_c.SetMaskUnassignedContacts(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *OrganizationCreate) SetCreatedAt(v time.Time) *OrganizationCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -272,6 +293,115 @@ func (_c *OrganizationCreate) AddExports(v ...*Export) *OrganizationCreate {
 	return _c.AddExportIDs(ids...)
 }
 
+// AddLeadSuppressionIDs adds the "lead_suppressions" edge to the LeadSuppression entity by IDs.
+func (_c *OrganizationCreate) AddLeadSuppressionIDs(ids ...int) *OrganizationCreate {
+	_c.mutation.AddLeadSuppressionIDs(ids...)
+	return _c
+}
+
+// AddLeadSuppressions adds the "lead_suppressions" edges to the LeadSuppression entity.
+func (_c *OrganizationCreate) AddLeadSuppressions(v ...*LeadSuppression) *OrganizationCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLeadSuppressionIDs(ids...)
+}
+
+// AddExportTemplateIDs adds the "export_templates" edge to the ExportTemplate entity by IDs.
+func (_c *OrganizationCreate) AddExportTemplateIDs(ids ...int) *OrganizationCreate {
+	_c.mutation.AddExportTemplateIDs(ids...)
+	return _c
+}
+
+// AddExportTemplates adds the "export_templates" edges to the ExportTemplate entity.
+func (_c *OrganizationCreate) AddExportTemplates(v ...*ExportTemplate) *OrganizationCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddExportTemplateIDs(ids...)
+}
+
+// AddScheduledExportIDs adds the "scheduled_exports" edge to the ScheduledExport entity by IDs.
+func (_c *OrganizationCreate) AddScheduledExportIDs(ids ...int) *OrganizationCreate {
+	_c.mutation.AddScheduledExportIDs(ids...)
+	return _c
+}
+
+// AddScheduledExports adds the "scheduled_exports" edges to the ScheduledExport entity.
+func (_c *OrganizationCreate) AddScheduledExports(v ...*ScheduledExport) *OrganizationCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddScheduledExportIDs(ids...)
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (_c *OrganizationCreate) AddWebhookIDs(ids ...int) *OrganizationCreate {
+	_c.mutation.AddWebhookIDs(ids...)
+	return _c
+}
+
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (_c *OrganizationCreate) AddWebhooks(v ...*Webhook) *OrganizationCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddWebhookIDs(ids...)
+}
+
+// AddOwnedLeadIDs adds the "owned_leads" edge to the Lead entity by IDs.
+func (_c *OrganizationCreate) AddOwnedLeadIDs(ids ...int) *OrganizationCreate {
+	_c.mutation.AddOwnedLeadIDs(ids...)
+	return _c
+}
+
+// AddOwnedLeads adds the "owned_leads" edges to the Lead entity.
+func (_c *OrganizationCreate) AddOwnedLeads(v ...*Lead) *OrganizationCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddOwnedLeadIDs(ids...)
+}
+
+// AddLeadLicenseIDs adds the "lead_licenses" edge to the LeadLicense entity by IDs.
+func (_c *OrganizationCreate) AddLeadLicenseIDs(ids ...int) *OrganizationCreate {
+	_c.mutation.AddLeadLicenseIDs(ids...)
+	return _c
+}
+
+// AddLeadLicenses adds the "lead_licenses" edges to the LeadLicense entity.
+func (_c *OrganizationCreate) AddLeadLicenses(v ...*LeadLicense) *OrganizationCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLeadLicenseIDs(ids...)
+}
+
+// SetEnrichmentBudgetID sets the "enrichment_budget" edge to the EnrichmentBudget entity by ID.
+func (_c *OrganizationCreate) SetEnrichmentBudgetID(id int) *OrganizationCreate {
+	_c.mutation.SetEnrichmentBudgetID(id)
+	return _c
+}
+
+// SetNillableEnrichmentBudgetID sets the "enrichment_budget" edge to the EnrichmentBudget entity by ID if the given value is not nil.
+func (_c *OrganizationCreate) SetNillableEnrichmentBudgetID(id *int) *OrganizationCreate {
+	if id != nil {
+		_c = _c.SetEnrichmentBudgetID(*id)
+	}
+	return _c
+}
+
+// SetEnrichmentBudget sets the "enrichment_budget" edge to the EnrichmentBudget entity.
+func (_c *OrganizationCreate) SetEnrichmentBudget(v *EnrichmentBudget) *OrganizationCreate {
+	return _c.SetEnrichmentBudgetID(v.ID)
+}
+
 // Mutation returns the OrganizationMutation object of the builder.
 func (_c *OrganizationCreate) Mutation() *OrganizationMutation {
 	return _c.mutation
@@ -327,6 +457,10 @@ func (_c *OrganizationCreate) defaults() {
 		v := organization.DefaultActive
 		_c.mutation.SetActive(v)
 	}
+	if _, ok := _c.mutation.MaskUnassignedContacts(); !ok {
+		v := organization.DefaultMaskUnassignedContacts
+		_c.mutation.SetMaskUnassignedContacts(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := organization.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -392,6 +526,9 @@ func (_c *OrganizationCreate) check() error {
 	if _, ok := _c.mutation.Active(); !ok {
 		return &ValidationError{Name: "active", err: errors.New(`ent: missing required field "Organization.active"`)}
 	}
+	if _, ok := _c.mutation.MaskUnassignedContacts(); !ok {
+		return &ValidationError{Name: "mask_unassigned_contacts", err: errors.New(`ent: missing required field "Organization.mask_unassigned_contacts"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Organization.created_at"`)}
 	}
@@ -466,6 +603,10 @@ func (_c *OrganizationCreate) createSpec() (*Organization, *sqlgraph.CreateSpec)
 		_spec.SetField(organization.FieldActive, field.TypeBool, value)
 		_node.Active = value
 	}
+	if value, ok := _c.mutation.MaskUnassignedContacts(); ok {
+		_spec.SetField(organization.FieldMaskUnassignedContacts, field.TypeBool, value)
+		_node.MaskUnassignedContacts = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(organization.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -543,6 +684,118 @@ func (_c *OrganizationCreate) createSpec() (*Organization, *sqlgraph.CreateSpec)
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.LeadSuppressionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.LeadSuppressionsTable,
+			Columns: []string{organization.LeadSuppressionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadsuppression.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ExportTemplatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ExportTemplatesTable,
+			Columns: []string{organization.ExportTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exporttemplate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ScheduledExportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ScheduledExportsTable,
+			Columns: []string{organization.ScheduledExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledexport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.WebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.WebhooksTable,
+			Columns: []string{organization.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.OwnedLeadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.OwnedLeadsTable,
+			Columns: []string{organization.OwnedLeadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LeadLicensesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.LeadLicensesTable,
+			Columns: []string{organization.LeadLicensesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadlicense.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.EnrichmentBudgetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   organization.EnrichmentBudgetTable,
+			Columns: []string{organization.EnrichmentBudgetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrichmentbudget.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
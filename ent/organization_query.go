@@ -12,23 +12,38 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/enrichmentbudget"
 	"github.com/jordanlanch/industrydb/ent/export"
+	"github.com/jordanlanch/industrydb/ent/exporttemplate"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/leadlicense"
+	"github.com/jordanlanch/industrydb/ent/leadsuppression"
 	"github.com/jordanlanch/industrydb/ent/organization"
 	"github.com/jordanlanch/industrydb/ent/organizationmember"
 	"github.com/jordanlanch/industrydb/ent/predicate"
+	"github.com/jordanlanch/industrydb/ent/scheduledexport"
 	"github.com/jordanlanch/industrydb/ent/user"
+	"github.com/jordanlanch/industrydb/ent/webhook"
 )
 
 // OrganizationQuery is the builder for querying Organization entities.
 type OrganizationQuery struct {
 	config
-	ctx         *QueryContext
-	order       []organization.OrderOption
-	inters      []Interceptor
-	predicates  []predicate.Organization
-	withOwner   *UserQuery
-	withMembers *OrganizationMemberQuery
-	withExports *ExportQuery
+	ctx                  *QueryContext
+	order                []organization.OrderOption
+	inters               []Interceptor
+	predicates           []predicate.Organization
+	withOwner            *UserQuery
+	withMembers          *OrganizationMemberQuery
+	withExports          *ExportQuery
+	withLeadSuppressions *LeadSuppressionQuery
+	withExportTemplates  *ExportTemplateQuery
+	withScheduledExports *ScheduledExportQuery
+	withWebhooks         *WebhookQuery
+	withOwnedLeads       *LeadQuery
+	withLeadLicenses     *LeadLicenseQuery
+	withEnrichmentBudget *EnrichmentBudgetQuery
+	modifiers            []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -131,6 +146,160 @@ func (_q *OrganizationQuery) QueryExports() *ExportQuery {
 	return query
 }
 
+// QueryLeadSuppressions chains the current query on the "lead_suppressions" edge.
+func (_q *OrganizationQuery) QueryLeadSuppressions() *LeadSuppressionQuery {
+	query := (&LeadSuppressionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, selector),
+			sqlgraph.To(leadsuppression.Table, leadsuppression.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.LeadSuppressionsTable, organization.LeadSuppressionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryExportTemplates chains the current query on the "export_templates" edge.
+func (_q *OrganizationQuery) QueryExportTemplates() *ExportTemplateQuery {
+	query := (&ExportTemplateClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, selector),
+			sqlgraph.To(exporttemplate.Table, exporttemplate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.ExportTemplatesTable, organization.ExportTemplatesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryScheduledExports chains the current query on the "scheduled_exports" edge.
+func (_q *OrganizationQuery) QueryScheduledExports() *ScheduledExportQuery {
+	query := (&ScheduledExportClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, selector),
+			sqlgraph.To(scheduledexport.Table, scheduledexport.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.ScheduledExportsTable, organization.ScheduledExportsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWebhooks chains the current query on the "webhooks" edge.
+func (_q *OrganizationQuery) QueryWebhooks() *WebhookQuery {
+	query := (&WebhookClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, selector),
+			sqlgraph.To(webhook.Table, webhook.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.WebhooksTable, organization.WebhooksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOwnedLeads chains the current query on the "owned_leads" edge.
+func (_q *OrganizationQuery) QueryOwnedLeads() *LeadQuery {
+	query := (&LeadClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, selector),
+			sqlgraph.To(lead.Table, lead.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.OwnedLeadsTable, organization.OwnedLeadsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLeadLicenses chains the current query on the "lead_licenses" edge.
+func (_q *OrganizationQuery) QueryLeadLicenses() *LeadLicenseQuery {
+	query := (&LeadLicenseClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, selector),
+			sqlgraph.To(leadlicense.Table, leadlicense.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.LeadLicensesTable, organization.LeadLicensesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEnrichmentBudget chains the current query on the "enrichment_budget" edge.
+func (_q *OrganizationQuery) QueryEnrichmentBudget() *EnrichmentBudgetQuery {
+	query := (&EnrichmentBudgetClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, selector),
+			sqlgraph.To(enrichmentbudget.Table, enrichmentbudget.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, organization.EnrichmentBudgetTable, organization.EnrichmentBudgetColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Organization entity from the query.
 // Returns a *NotFoundError when no Organization was found.
 func (_q *OrganizationQuery) First(ctx context.Context) (*Organization, error) {
@@ -318,17 +487,25 @@ func (_q *OrganizationQuery) Clone() *OrganizationQuery {
 		return nil
 	}
 	return &OrganizationQuery{
-		config:      _q.config,
-		ctx:         _q.ctx.Clone(),
-		order:       append([]organization.OrderOption{}, _q.order...),
-		inters:      append([]Interceptor{}, _q.inters...),
-		predicates:  append([]predicate.Organization{}, _q.predicates...),
-		withOwner:   _q.withOwner.Clone(),
-		withMembers: _q.withMembers.Clone(),
-		withExports: _q.withExports.Clone(),
+		config:               _q.config,
+		ctx:                  _q.ctx.Clone(),
+		order:                append([]organization.OrderOption{}, _q.order...),
+		inters:               append([]Interceptor{}, _q.inters...),
+		predicates:           append([]predicate.Organization{}, _q.predicates...),
+		withOwner:            _q.withOwner.Clone(),
+		withMembers:          _q.withMembers.Clone(),
+		withExports:          _q.withExports.Clone(),
+		withLeadSuppressions: _q.withLeadSuppressions.Clone(),
+		withExportTemplates:  _q.withExportTemplates.Clone(),
+		withScheduledExports: _q.withScheduledExports.Clone(),
+		withWebhooks:         _q.withWebhooks.Clone(),
+		withOwnedLeads:       _q.withOwnedLeads.Clone(),
+		withLeadLicenses:     _q.withLeadLicenses.Clone(),
+		withEnrichmentBudget: _q.withEnrichmentBudget.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -365,6 +542,83 @@ func (_q *OrganizationQuery) WithExports(opts ...func(*ExportQuery)) *Organizati
 	return _q
 }
 
+// WithLeadSuppressions tells the query-builder to eager-load the nodes that are connected to
+// the "lead_suppressions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *OrganizationQuery) WithLeadSuppressions(opts ...func(*LeadSuppressionQuery)) *OrganizationQuery {
+	query := (&LeadSuppressionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLeadSuppressions = query
+	return _q
+}
+
+// WithExportTemplates tells the query-builder to eager-load the nodes that are connected to
+// the "export_templates" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *OrganizationQuery) WithExportTemplates(opts ...func(*ExportTemplateQuery)) *OrganizationQuery {
+	query := (&ExportTemplateClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withExportTemplates = query
+	return _q
+}
+
+// WithScheduledExports tells the query-builder to eager-load the nodes that are connected to
+// the "scheduled_exports" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *OrganizationQuery) WithScheduledExports(opts ...func(*ScheduledExportQuery)) *OrganizationQuery {
+	query := (&ScheduledExportClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withScheduledExports = query
+	return _q
+}
+
+// WithWebhooks tells the query-builder to eager-load the nodes that are connected to
+// the "webhooks" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *OrganizationQuery) WithWebhooks(opts ...func(*WebhookQuery)) *OrganizationQuery {
+	query := (&WebhookClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withWebhooks = query
+	return _q
+}
+
+// WithOwnedLeads tells the query-builder to eager-load the nodes that are connected to
+// the "owned_leads" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *OrganizationQuery) WithOwnedLeads(opts ...func(*LeadQuery)) *OrganizationQuery {
+	query := (&LeadClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withOwnedLeads = query
+	return _q
+}
+
+// WithLeadLicenses tells the query-builder to eager-load the nodes that are connected to
+// the "lead_licenses" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *OrganizationQuery) WithLeadLicenses(opts ...func(*LeadLicenseQuery)) *OrganizationQuery {
+	query := (&LeadLicenseClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLeadLicenses = query
+	return _q
+}
+
+// WithEnrichmentBudget tells the query-builder to eager-load the nodes that are connected to
+// the "enrichment_budget" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *OrganizationQuery) WithEnrichmentBudget(opts ...func(*EnrichmentBudgetQuery)) *OrganizationQuery {
+	query := (&EnrichmentBudgetClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withEnrichmentBudget = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -443,10 +697,17 @@ func (_q *OrganizationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 	var (
 		nodes       = []*Organization{}
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [10]bool{
 			_q.withOwner != nil,
 			_q.withMembers != nil,
 			_q.withExports != nil,
+			_q.withLeadSuppressions != nil,
+			_q.withExportTemplates != nil,
+			_q.withScheduledExports != nil,
+			_q.withWebhooks != nil,
+			_q.withOwnedLeads != nil,
+			_q.withLeadLicenses != nil,
+			_q.withEnrichmentBudget != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -458,6 +719,9 @@ func (_q *OrganizationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -487,6 +751,58 @@ func (_q *OrganizationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 			return nil, err
 		}
 	}
+	if query := _q.withLeadSuppressions; query != nil {
+		if err := _q.loadLeadSuppressions(ctx, query, nodes,
+			func(n *Organization) { n.Edges.LeadSuppressions = []*LeadSuppression{} },
+			func(n *Organization, e *LeadSuppression) {
+				n.Edges.LeadSuppressions = append(n.Edges.LeadSuppressions, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withExportTemplates; query != nil {
+		if err := _q.loadExportTemplates(ctx, query, nodes,
+			func(n *Organization) { n.Edges.ExportTemplates = []*ExportTemplate{} },
+			func(n *Organization, e *ExportTemplate) { n.Edges.ExportTemplates = append(n.Edges.ExportTemplates, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withScheduledExports; query != nil {
+		if err := _q.loadScheduledExports(ctx, query, nodes,
+			func(n *Organization) { n.Edges.ScheduledExports = []*ScheduledExport{} },
+			func(n *Organization, e *ScheduledExport) {
+				n.Edges.ScheduledExports = append(n.Edges.ScheduledExports, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withWebhooks; query != nil {
+		if err := _q.loadWebhooks(ctx, query, nodes,
+			func(n *Organization) { n.Edges.Webhooks = []*Webhook{} },
+			func(n *Organization, e *Webhook) { n.Edges.Webhooks = append(n.Edges.Webhooks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withOwnedLeads; query != nil {
+		if err := _q.loadOwnedLeads(ctx, query, nodes,
+			func(n *Organization) { n.Edges.OwnedLeads = []*Lead{} },
+			func(n *Organization, e *Lead) { n.Edges.OwnedLeads = append(n.Edges.OwnedLeads, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withLeadLicenses; query != nil {
+		if err := _q.loadLeadLicenses(ctx, query, nodes,
+			func(n *Organization) { n.Edges.LeadLicenses = []*LeadLicense{} },
+			func(n *Organization, e *LeadLicense) { n.Edges.LeadLicenses = append(n.Edges.LeadLicenses, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withEnrichmentBudget; query != nil {
+		if err := _q.loadEnrichmentBudget(ctx, query, nodes, nil,
+			func(n *Organization, e *EnrichmentBudget) { n.Edges.EnrichmentBudget = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -582,9 +898,239 @@ func (_q *OrganizationQuery) loadExports(ctx context.Context, query *ExportQuery
 	}
 	return nil
 }
+func (_q *OrganizationQuery) loadLeadSuppressions(ctx context.Context, query *LeadSuppressionQuery, nodes []*Organization, init func(*Organization), assign func(*Organization, *LeadSuppression)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Organization)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(leadsuppression.FieldOrganizationID)
+	}
+	query.Where(predicate.LeadSuppression(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(organization.LeadSuppressionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OrganizationID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "organization_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "organization_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *OrganizationQuery) loadExportTemplates(ctx context.Context, query *ExportTemplateQuery, nodes []*Organization, init func(*Organization), assign func(*Organization, *ExportTemplate)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Organization)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(exporttemplate.FieldOrganizationID)
+	}
+	query.Where(predicate.ExportTemplate(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(organization.ExportTemplatesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OrganizationID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "organization_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "organization_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *OrganizationQuery) loadScheduledExports(ctx context.Context, query *ScheduledExportQuery, nodes []*Organization, init func(*Organization), assign func(*Organization, *ScheduledExport)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Organization)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(scheduledexport.FieldOrganizationID)
+	}
+	query.Where(predicate.ScheduledExport(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(organization.ScheduledExportsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OrganizationID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "organization_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "organization_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *OrganizationQuery) loadWebhooks(ctx context.Context, query *WebhookQuery, nodes []*Organization, init func(*Organization), assign func(*Organization, *Webhook)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Organization)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(webhook.FieldOrganizationID)
+	}
+	query.Where(predicate.Webhook(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(organization.WebhooksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OrganizationID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "organization_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "organization_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *OrganizationQuery) loadOwnedLeads(ctx context.Context, query *LeadQuery, nodes []*Organization, init func(*Organization), assign func(*Organization, *Lead)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Organization)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(lead.FieldOwnerOrganizationID)
+	}
+	query.Where(predicate.Lead(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(organization.OwnedLeadsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OwnerOrganizationID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "owner_organization_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "owner_organization_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *OrganizationQuery) loadLeadLicenses(ctx context.Context, query *LeadLicenseQuery, nodes []*Organization, init func(*Organization), assign func(*Organization, *LeadLicense)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Organization)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(leadlicense.FieldOrganizationID)
+	}
+	query.Where(predicate.LeadLicense(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(organization.LeadLicensesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OrganizationID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "organization_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *OrganizationQuery) loadEnrichmentBudget(ctx context.Context, query *EnrichmentBudgetQuery, nodes []*Organization, init func(*Organization), assign func(*Organization, *EnrichmentBudget)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Organization)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(enrichmentbudget.FieldOrganizationID)
+	}
+	query.Where(predicate.EnrichmentBudget(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(organization.EnrichmentBudgetColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OrganizationID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "organization_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "organization_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *OrganizationQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -650,6 +1196,9 @@ func (_q *OrganizationQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -667,6 +1216,12 @@ func (_q *OrganizationQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *OrganizationQuery) Modify(modifiers ...func(s *sql.Selector)) *OrganizationSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // OrganizationGroupBy is the group-by builder for Organization entities.
 type OrganizationGroupBy struct {
 	selector
@@ -756,3 +1311,9 @@ func (_s *OrganizationSelect) sqlScan(ctx context.Context, root *OrganizationQue
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *OrganizationSelect) Modify(modifiers ...func(s *sql.Selector)) *OrganizationSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
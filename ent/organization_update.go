@@ -11,18 +11,26 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/enrichmentbudget"
 	"github.com/jordanlanch/industrydb/ent/export"
+	"github.com/jordanlanch/industrydb/ent/exporttemplate"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/leadlicense"
+	"github.com/jordanlanch/industrydb/ent/leadsuppression"
 	"github.com/jordanlanch/industrydb/ent/organization"
 	"github.com/jordanlanch/industrydb/ent/organizationmember"
 	"github.com/jordanlanch/industrydb/ent/predicate"
+	"github.com/jordanlanch/industrydb/ent/scheduledexport"
 	"github.com/jordanlanch/industrydb/ent/user"
+	"github.com/jordanlanch/industrydb/ent/webhook"
 )
 
 // OrganizationUpdate is the builder for updating Organization entities.
 type OrganizationUpdate struct {
 	config
-	hooks    []Hook
-	mutation *OrganizationMutation
+	hooks     []Hook
+	mutation  *OrganizationMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the OrganizationUpdate builder.
@@ -197,6 +205,20 @@ func (_u *OrganizationUpdate) SetNillableActive(v *bool) *OrganizationUpdate {
 	return _u
 }
 
+// SetMaskUnassignedContacts sets the "mask_unassigned_contacts" field.
+func (_u *OrganizationUpdate) SetMaskUnassignedContacts(v bool) *OrganizationUpdate {
+	_u.mutation.SetMaskUnassignedContacts(v)
+	return _u
+}
+
+// SetNillableMaskUnassignedContacts sets the "mask_unassigned_contacts" field if the given value is not nil.
+func (_u *OrganizationUpdate) SetNillableMaskUnassignedContacts(v *bool) *OrganizationUpdate {
+	if v != nil {
+		_u.SetMaskUnassignedContacts(*v)
+	}
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *OrganizationUpdate) SetUpdatedAt(v time.Time) *OrganizationUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -332,6 +354,115 @@ func (_u *OrganizationUpdate) AddExports(v ...*Export) *OrganizationUpdate {
 	return _u.AddExportIDs(ids...)
 }
 
+// AddLeadSuppressionIDs adds the "lead_suppressions" edge to the LeadSuppression entity by IDs.
+func (_u *OrganizationUpdate) AddLeadSuppressionIDs(ids ...int) *OrganizationUpdate {
+	_u.mutation.AddLeadSuppressionIDs(ids...)
+	return _u
+}
+
+// AddLeadSuppressions adds the "lead_suppressions" edges to the LeadSuppression entity.
+func (_u *OrganizationUpdate) AddLeadSuppressions(v ...*LeadSuppression) *OrganizationUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLeadSuppressionIDs(ids...)
+}
+
+// AddExportTemplateIDs adds the "export_templates" edge to the ExportTemplate entity by IDs.
+func (_u *OrganizationUpdate) AddExportTemplateIDs(ids ...int) *OrganizationUpdate {
+	_u.mutation.AddExportTemplateIDs(ids...)
+	return _u
+}
+
+// AddExportTemplates adds the "export_templates" edges to the ExportTemplate entity.
+func (_u *OrganizationUpdate) AddExportTemplates(v ...*ExportTemplate) *OrganizationUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddExportTemplateIDs(ids...)
+}
+
+// AddScheduledExportIDs adds the "scheduled_exports" edge to the ScheduledExport entity by IDs.
+func (_u *OrganizationUpdate) AddScheduledExportIDs(ids ...int) *OrganizationUpdate {
+	_u.mutation.AddScheduledExportIDs(ids...)
+	return _u
+}
+
+// AddScheduledExports adds the "scheduled_exports" edges to the ScheduledExport entity.
+func (_u *OrganizationUpdate) AddScheduledExports(v ...*ScheduledExport) *OrganizationUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddScheduledExportIDs(ids...)
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (_u *OrganizationUpdate) AddWebhookIDs(ids ...int) *OrganizationUpdate {
+	_u.mutation.AddWebhookIDs(ids...)
+	return _u
+}
+
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (_u *OrganizationUpdate) AddWebhooks(v ...*Webhook) *OrganizationUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWebhookIDs(ids...)
+}
+
+// AddOwnedLeadIDs adds the "owned_leads" edge to the Lead entity by IDs.
+func (_u *OrganizationUpdate) AddOwnedLeadIDs(ids ...int) *OrganizationUpdate {
+	_u.mutation.AddOwnedLeadIDs(ids...)
+	return _u
+}
+
+// AddOwnedLeads adds the "owned_leads" edges to the Lead entity.
+func (_u *OrganizationUpdate) AddOwnedLeads(v ...*Lead) *OrganizationUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOwnedLeadIDs(ids...)
+}
+
+// AddLeadLicenseIDs adds the "lead_licenses" edge to the LeadLicense entity by IDs.
+func (_u *OrganizationUpdate) AddLeadLicenseIDs(ids ...int) *OrganizationUpdate {
+	_u.mutation.AddLeadLicenseIDs(ids...)
+	return _u
+}
+
+// AddLeadLicenses adds the "lead_licenses" edges to the LeadLicense entity.
+func (_u *OrganizationUpdate) AddLeadLicenses(v ...*LeadLicense) *OrganizationUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLeadLicenseIDs(ids...)
+}
+
+// SetEnrichmentBudgetID sets the "enrichment_budget" edge to the EnrichmentBudget entity by ID.
+func (_u *OrganizationUpdate) SetEnrichmentBudgetID(id int) *OrganizationUpdate {
+	_u.mutation.SetEnrichmentBudgetID(id)
+	return _u
+}
+
+// SetNillableEnrichmentBudgetID sets the "enrichment_budget" edge to the EnrichmentBudget entity by ID if the given value is not nil.
+func (_u *OrganizationUpdate) SetNillableEnrichmentBudgetID(id *int) *OrganizationUpdate {
+	if id != nil {
+		_u = _u.SetEnrichmentBudgetID(*id)
+	}
+	return _u
+}
+
+// SetEnrichmentBudget sets the "enrichment_budget" edge to the EnrichmentBudget entity.
+func (_u *OrganizationUpdate) SetEnrichmentBudget(v *EnrichmentBudget) *OrganizationUpdate {
+	return _u.SetEnrichmentBudgetID(v.ID)
+}
+
 // Mutation returns the OrganizationMutation object of the builder.
 func (_u *OrganizationUpdate) Mutation() *OrganizationMutation {
 	return _u.mutation
@@ -385,6 +516,138 @@ func (_u *OrganizationUpdate) RemoveExports(v ...*Export) *OrganizationUpdate {
 	return _u.RemoveExportIDs(ids...)
 }
 
+// ClearLeadSuppressions clears all "lead_suppressions" edges to the LeadSuppression entity.
+func (_u *OrganizationUpdate) ClearLeadSuppressions() *OrganizationUpdate {
+	_u.mutation.ClearLeadSuppressions()
+	return _u
+}
+
+// RemoveLeadSuppressionIDs removes the "lead_suppressions" edge to LeadSuppression entities by IDs.
+func (_u *OrganizationUpdate) RemoveLeadSuppressionIDs(ids ...int) *OrganizationUpdate {
+	_u.mutation.RemoveLeadSuppressionIDs(ids...)
+	return _u
+}
+
+// RemoveLeadSuppressions removes "lead_suppressions" edges to LeadSuppression entities.
+func (_u *OrganizationUpdate) RemoveLeadSuppressions(v ...*LeadSuppression) *OrganizationUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLeadSuppressionIDs(ids...)
+}
+
+// ClearExportTemplates clears all "export_templates" edges to the ExportTemplate entity.
+func (_u *OrganizationUpdate) ClearExportTemplates() *OrganizationUpdate {
+	_u.mutation.ClearExportTemplates()
+	return _u
+}
+
+// RemoveExportTemplateIDs removes the "export_templates" edge to ExportTemplate entities by IDs.
+func (_u *OrganizationUpdate) RemoveExportTemplateIDs(ids ...int) *OrganizationUpdate {
+	_u.mutation.RemoveExportTemplateIDs(ids...)
+	return _u
+}
+
+// RemoveExportTemplates removes "export_templates" edges to ExportTemplate entities.
+func (_u *OrganizationUpdate) RemoveExportTemplates(v ...*ExportTemplate) *OrganizationUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveExportTemplateIDs(ids...)
+}
+
+// ClearScheduledExports clears all "scheduled_exports" edges to the ScheduledExport entity.
+func (_u *OrganizationUpdate) ClearScheduledExports() *OrganizationUpdate {
+	_u.mutation.ClearScheduledExports()
+	return _u
+}
+
+// RemoveScheduledExportIDs removes the "scheduled_exports" edge to ScheduledExport entities by IDs.
+func (_u *OrganizationUpdate) RemoveScheduledExportIDs(ids ...int) *OrganizationUpdate {
+	_u.mutation.RemoveScheduledExportIDs(ids...)
+	return _u
+}
+
+// RemoveScheduledExports removes "scheduled_exports" edges to ScheduledExport entities.
+func (_u *OrganizationUpdate) RemoveScheduledExports(v ...*ScheduledExport) *OrganizationUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveScheduledExportIDs(ids...)
+}
+
+// ClearWebhooks clears all "webhooks" edges to the Webhook entity.
+func (_u *OrganizationUpdate) ClearWebhooks() *OrganizationUpdate {
+	_u.mutation.ClearWebhooks()
+	return _u
+}
+
+// RemoveWebhookIDs removes the "webhooks" edge to Webhook entities by IDs.
+func (_u *OrganizationUpdate) RemoveWebhookIDs(ids ...int) *OrganizationUpdate {
+	_u.mutation.RemoveWebhookIDs(ids...)
+	return _u
+}
+
+// RemoveWebhooks removes "webhooks" edges to Webhook entities.
+func (_u *OrganizationUpdate) RemoveWebhooks(v ...*Webhook) *OrganizationUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWebhookIDs(ids...)
+}
+
+// ClearOwnedLeads clears all "owned_leads" edges to the Lead entity.
+func (_u *OrganizationUpdate) ClearOwnedLeads() *OrganizationUpdate {
+	_u.mutation.ClearOwnedLeads()
+	return _u
+}
+
+// RemoveOwnedLeadIDs removes the "owned_leads" edge to Lead entities by IDs.
+func (_u *OrganizationUpdate) RemoveOwnedLeadIDs(ids ...int) *OrganizationUpdate {
+	_u.mutation.RemoveOwnedLeadIDs(ids...)
+	return _u
+}
+
+// RemoveOwnedLeads removes "owned_leads" edges to Lead entities.
+func (_u *OrganizationUpdate) RemoveOwnedLeads(v ...*Lead) *OrganizationUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOwnedLeadIDs(ids...)
+}
+
+// ClearLeadLicenses clears all "lead_licenses" edges to the LeadLicense entity.
+func (_u *OrganizationUpdate) ClearLeadLicenses() *OrganizationUpdate {
+	_u.mutation.ClearLeadLicenses()
+	return _u
+}
+
+// RemoveLeadLicenseIDs removes the "lead_licenses" edge to LeadLicense entities by IDs.
+func (_u *OrganizationUpdate) RemoveLeadLicenseIDs(ids ...int) *OrganizationUpdate {
+	_u.mutation.RemoveLeadLicenseIDs(ids...)
+	return _u
+}
+
+// RemoveLeadLicenses removes "lead_licenses" edges to LeadLicense entities.
+func (_u *OrganizationUpdate) RemoveLeadLicenses(v ...*LeadLicense) *OrganizationUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLeadLicenseIDs(ids...)
+}
+
+// ClearEnrichmentBudget clears the "enrichment_budget" edge to the EnrichmentBudget entity.
+func (_u *OrganizationUpdate) ClearEnrichmentBudget() *OrganizationUpdate {
+	_u.mutation.ClearEnrichmentBudget()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *OrganizationUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -454,6 +717,12 @@ func (_u *OrganizationUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *OrganizationUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OrganizationUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *OrganizationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -505,6 +774,9 @@ func (_u *OrganizationUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if value, ok := _u.mutation.Active(); ok {
 		_spec.SetField(organization.FieldActive, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.MaskUnassignedContacts(); ok {
+		_spec.SetField(organization.FieldMaskUnassignedContacts, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(organization.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -654,83 +926,384 @@ func (_u *OrganizationUpdate) sqlSave(ctx context.Context) (_node int, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{organization.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if _u.mutation.LeadSuppressionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.LeadSuppressionsTable,
+			Columns: []string{organization.LeadSuppressionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadsuppression.FieldID, field.TypeInt),
+			},
 		}
-		return 0, err
-	}
-	_u.mutation.done = true
-	return _node, nil
-}
-
-// OrganizationUpdateOne is the builder for updating a single Organization entity.
-type OrganizationUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *OrganizationMutation
-}
-
-// SetName sets the "name" field.
-func (_u *OrganizationUpdateOne) SetName(v string) *OrganizationUpdateOne {
-	_u.mutation.SetName(v)
-	return _u
-}
-
-// SetNillableName sets the "name" field if the given value is not nil.
-func (_u *OrganizationUpdateOne) SetNillableName(v *string) *OrganizationUpdateOne {
-	if v != nil {
-		_u.SetName(*v)
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetSlug sets the "slug" field.
-func (_u *OrganizationUpdateOne) SetSlug(v string) *OrganizationUpdateOne {
-	_u.mutation.SetSlug(v)
-	return _u
-}
-
-// SetNillableSlug sets the "slug" field if the given value is not nil.
-func (_u *OrganizationUpdateOne) SetNillableSlug(v *string) *OrganizationUpdateOne {
-	if v != nil {
-		_u.SetSlug(*v)
+	if nodes := _u.mutation.RemovedLeadSuppressionsIDs(); len(nodes) > 0 && !_u.mutation.LeadSuppressionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.LeadSuppressionsTable,
+			Columns: []string{organization.LeadSuppressionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadsuppression.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetOwnerID sets the "owner_id" field.
-func (_u *OrganizationUpdateOne) SetOwnerID(v int) *OrganizationUpdateOne {
-	_u.mutation.SetOwnerID(v)
-	return _u
-}
-
-// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
-func (_u *OrganizationUpdateOne) SetNillableOwnerID(v *int) *OrganizationUpdateOne {
-	if v != nil {
-		_u.SetOwnerID(*v)
+	if nodes := _u.mutation.LeadSuppressionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.LeadSuppressionsTable,
+			Columns: []string{organization.LeadSuppressionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadsuppression.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u
-}
-
-// SetSubscriptionTier sets the "subscription_tier" field.
-func (_u *OrganizationUpdateOne) SetSubscriptionTier(v organization.SubscriptionTier) *OrganizationUpdateOne {
-	_u.mutation.SetSubscriptionTier(v)
-	return _u
-}
-
-// SetNillableSubscriptionTier sets the "subscription_tier" field if the given value is not nil.
-func (_u *OrganizationUpdateOne) SetNillableSubscriptionTier(v *organization.SubscriptionTier) *OrganizationUpdateOne {
-	if v != nil {
-		_u.SetSubscriptionTier(*v)
+	if _u.mutation.ExportTemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ExportTemplatesTable,
+			Columns: []string{organization.ExportTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exporttemplate.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetUsageLimit sets the "usage_limit" field.
+	if nodes := _u.mutation.RemovedExportTemplatesIDs(); len(nodes) > 0 && !_u.mutation.ExportTemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ExportTemplatesTable,
+			Columns: []string{organization.ExportTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exporttemplate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ExportTemplatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ExportTemplatesTable,
+			Columns: []string{organization.ExportTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exporttemplate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ScheduledExportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ScheduledExportsTable,
+			Columns: []string{organization.ScheduledExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledexport.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedScheduledExportsIDs(); len(nodes) > 0 && !_u.mutation.ScheduledExportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ScheduledExportsTable,
+			Columns: []string{organization.ScheduledExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledexport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScheduledExportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ScheduledExportsTable,
+			Columns: []string{organization.ScheduledExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledexport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.WebhooksTable,
+			Columns: []string{organization.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWebhooksIDs(); len(nodes) > 0 && !_u.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.WebhooksTable,
+			Columns: []string{organization.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.WebhooksTable,
+			Columns: []string{organization.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OwnedLeadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.OwnedLeadsTable,
+			Columns: []string{organization.OwnedLeadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOwnedLeadsIDs(); len(nodes) > 0 && !_u.mutation.OwnedLeadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.OwnedLeadsTable,
+			Columns: []string{organization.OwnedLeadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OwnedLeadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.OwnedLeadsTable,
+			Columns: []string{organization.OwnedLeadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LeadLicensesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.LeadLicensesTable,
+			Columns: []string{organization.LeadLicensesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadlicense.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLeadLicensesIDs(); len(nodes) > 0 && !_u.mutation.LeadLicensesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.LeadLicensesTable,
+			Columns: []string{organization.LeadLicensesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadlicense.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LeadLicensesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.LeadLicensesTable,
+			Columns: []string{organization.LeadLicensesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadlicense.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EnrichmentBudgetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   organization.EnrichmentBudgetTable,
+			Columns: []string{organization.EnrichmentBudgetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrichmentbudget.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EnrichmentBudgetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   organization.EnrichmentBudgetTable,
+			Columns: []string{organization.EnrichmentBudgetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrichmentbudget.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{organization.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OrganizationUpdateOne is the builder for updating a single Organization entity.
+type OrganizationUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *OrganizationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (_u *OrganizationUpdateOne) SetName(v string) *OrganizationUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *OrganizationUpdateOne) SetNillableName(v *string) *OrganizationUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetSlug sets the "slug" field.
+func (_u *OrganizationUpdateOne) SetSlug(v string) *OrganizationUpdateOne {
+	_u.mutation.SetSlug(v)
+	return _u
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (_u *OrganizationUpdateOne) SetNillableSlug(v *string) *OrganizationUpdateOne {
+	if v != nil {
+		_u.SetSlug(*v)
+	}
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *OrganizationUpdateOne) SetOwnerID(v int) *OrganizationUpdateOne {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *OrganizationUpdateOne) SetNillableOwnerID(v *int) *OrganizationUpdateOne {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// SetSubscriptionTier sets the "subscription_tier" field.
+func (_u *OrganizationUpdateOne) SetSubscriptionTier(v organization.SubscriptionTier) *OrganizationUpdateOne {
+	_u.mutation.SetSubscriptionTier(v)
+	return _u
+}
+
+// SetNillableSubscriptionTier sets the "subscription_tier" field if the given value is not nil.
+func (_u *OrganizationUpdateOne) SetNillableSubscriptionTier(v *organization.SubscriptionTier) *OrganizationUpdateOne {
+	if v != nil {
+		_u.SetSubscriptionTier(*v)
+	}
+	return _u
+}
+
+// SetUsageLimit sets the "usage_limit" field.
 func (_u *OrganizationUpdateOne) SetUsageLimit(v int) *OrganizationUpdateOne {
 	_u.mutation.ResetUsageLimit()
 	_u.mutation.SetUsageLimit(v)
@@ -840,6 +1413,20 @@ func (_u *OrganizationUpdateOne) SetNillableActive(v *bool) *OrganizationUpdateO
 	return _u
 }
 
+// SetMaskUnassignedContacts sets the "mask_unassigned_contacts" field.
+func (_u *OrganizationUpdateOne) SetMaskUnassignedContacts(v bool) *OrganizationUpdateOne {
+	_u.mutation.SetMaskUnassignedContacts(v)
+	return _u
+}
+
+// SetNillableMaskUnassignedContacts sets the "mask_unassigned_contacts" field if the given value is not nil.
+func (_u *OrganizationUpdateOne) SetNillableMaskUnassignedContacts(v *bool) *OrganizationUpdateOne {
+	if v != nil {
+		_u.SetMaskUnassignedContacts(*v)
+	}
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *OrganizationUpdateOne) SetUpdatedAt(v time.Time) *OrganizationUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -972,60 +1559,301 @@ func (_u *OrganizationUpdateOne) AddExports(v ...*Export) *OrganizationUpdateOne
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddExportIDs(ids...)
+	return _u.AddExportIDs(ids...)
+}
+
+// AddLeadSuppressionIDs adds the "lead_suppressions" edge to the LeadSuppression entity by IDs.
+func (_u *OrganizationUpdateOne) AddLeadSuppressionIDs(ids ...int) *OrganizationUpdateOne {
+	_u.mutation.AddLeadSuppressionIDs(ids...)
+	return _u
+}
+
+// AddLeadSuppressions adds the "lead_suppressions" edges to the LeadSuppression entity.
+func (_u *OrganizationUpdateOne) AddLeadSuppressions(v ...*LeadSuppression) *OrganizationUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLeadSuppressionIDs(ids...)
+}
+
+// AddExportTemplateIDs adds the "export_templates" edge to the ExportTemplate entity by IDs.
+func (_u *OrganizationUpdateOne) AddExportTemplateIDs(ids ...int) *OrganizationUpdateOne {
+	_u.mutation.AddExportTemplateIDs(ids...)
+	return _u
+}
+
+// AddExportTemplates adds the "export_templates" edges to the ExportTemplate entity.
+func (_u *OrganizationUpdateOne) AddExportTemplates(v ...*ExportTemplate) *OrganizationUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddExportTemplateIDs(ids...)
+}
+
+// AddScheduledExportIDs adds the "scheduled_exports" edge to the ScheduledExport entity by IDs.
+func (_u *OrganizationUpdateOne) AddScheduledExportIDs(ids ...int) *OrganizationUpdateOne {
+	_u.mutation.AddScheduledExportIDs(ids...)
+	return _u
+}
+
+// AddScheduledExports adds the "scheduled_exports" edges to the ScheduledExport entity.
+func (_u *OrganizationUpdateOne) AddScheduledExports(v ...*ScheduledExport) *OrganizationUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddScheduledExportIDs(ids...)
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (_u *OrganizationUpdateOne) AddWebhookIDs(ids ...int) *OrganizationUpdateOne {
+	_u.mutation.AddWebhookIDs(ids...)
+	return _u
+}
+
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (_u *OrganizationUpdateOne) AddWebhooks(v ...*Webhook) *OrganizationUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWebhookIDs(ids...)
+}
+
+// AddOwnedLeadIDs adds the "owned_leads" edge to the Lead entity by IDs.
+func (_u *OrganizationUpdateOne) AddOwnedLeadIDs(ids ...int) *OrganizationUpdateOne {
+	_u.mutation.AddOwnedLeadIDs(ids...)
+	return _u
+}
+
+// AddOwnedLeads adds the "owned_leads" edges to the Lead entity.
+func (_u *OrganizationUpdateOne) AddOwnedLeads(v ...*Lead) *OrganizationUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOwnedLeadIDs(ids...)
+}
+
+// AddLeadLicenseIDs adds the "lead_licenses" edge to the LeadLicense entity by IDs.
+func (_u *OrganizationUpdateOne) AddLeadLicenseIDs(ids ...int) *OrganizationUpdateOne {
+	_u.mutation.AddLeadLicenseIDs(ids...)
+	return _u
+}
+
+// AddLeadLicenses adds the "lead_licenses" edges to the LeadLicense entity.
+func (_u *OrganizationUpdateOne) AddLeadLicenses(v ...*LeadLicense) *OrganizationUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLeadLicenseIDs(ids...)
+}
+
+// SetEnrichmentBudgetID sets the "enrichment_budget" edge to the EnrichmentBudget entity by ID.
+func (_u *OrganizationUpdateOne) SetEnrichmentBudgetID(id int) *OrganizationUpdateOne {
+	_u.mutation.SetEnrichmentBudgetID(id)
+	return _u
+}
+
+// SetNillableEnrichmentBudgetID sets the "enrichment_budget" edge to the EnrichmentBudget entity by ID if the given value is not nil.
+func (_u *OrganizationUpdateOne) SetNillableEnrichmentBudgetID(id *int) *OrganizationUpdateOne {
+	if id != nil {
+		_u = _u.SetEnrichmentBudgetID(*id)
+	}
+	return _u
+}
+
+// SetEnrichmentBudget sets the "enrichment_budget" edge to the EnrichmentBudget entity.
+func (_u *OrganizationUpdateOne) SetEnrichmentBudget(v *EnrichmentBudget) *OrganizationUpdateOne {
+	return _u.SetEnrichmentBudgetID(v.ID)
+}
+
+// Mutation returns the OrganizationMutation object of the builder.
+func (_u *OrganizationUpdateOne) Mutation() *OrganizationMutation {
+	return _u.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (_u *OrganizationUpdateOne) ClearOwner() *OrganizationUpdateOne {
+	_u.mutation.ClearOwner()
+	return _u
+}
+
+// ClearMembers clears all "members" edges to the OrganizationMember entity.
+func (_u *OrganizationUpdateOne) ClearMembers() *OrganizationUpdateOne {
+	_u.mutation.ClearMembers()
+	return _u
+}
+
+// RemoveMemberIDs removes the "members" edge to OrganizationMember entities by IDs.
+func (_u *OrganizationUpdateOne) RemoveMemberIDs(ids ...int) *OrganizationUpdateOne {
+	_u.mutation.RemoveMemberIDs(ids...)
+	return _u
+}
+
+// RemoveMembers removes "members" edges to OrganizationMember entities.
+func (_u *OrganizationUpdateOne) RemoveMembers(v ...*OrganizationMember) *OrganizationUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMemberIDs(ids...)
+}
+
+// ClearExports clears all "exports" edges to the Export entity.
+func (_u *OrganizationUpdateOne) ClearExports() *OrganizationUpdateOne {
+	_u.mutation.ClearExports()
+	return _u
+}
+
+// RemoveExportIDs removes the "exports" edge to Export entities by IDs.
+func (_u *OrganizationUpdateOne) RemoveExportIDs(ids ...int) *OrganizationUpdateOne {
+	_u.mutation.RemoveExportIDs(ids...)
+	return _u
+}
+
+// RemoveExports removes "exports" edges to Export entities.
+func (_u *OrganizationUpdateOne) RemoveExports(v ...*Export) *OrganizationUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveExportIDs(ids...)
+}
+
+// ClearLeadSuppressions clears all "lead_suppressions" edges to the LeadSuppression entity.
+func (_u *OrganizationUpdateOne) ClearLeadSuppressions() *OrganizationUpdateOne {
+	_u.mutation.ClearLeadSuppressions()
+	return _u
+}
+
+// RemoveLeadSuppressionIDs removes the "lead_suppressions" edge to LeadSuppression entities by IDs.
+func (_u *OrganizationUpdateOne) RemoveLeadSuppressionIDs(ids ...int) *OrganizationUpdateOne {
+	_u.mutation.RemoveLeadSuppressionIDs(ids...)
+	return _u
+}
+
+// RemoveLeadSuppressions removes "lead_suppressions" edges to LeadSuppression entities.
+func (_u *OrganizationUpdateOne) RemoveLeadSuppressions(v ...*LeadSuppression) *OrganizationUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLeadSuppressionIDs(ids...)
+}
+
+// ClearExportTemplates clears all "export_templates" edges to the ExportTemplate entity.
+func (_u *OrganizationUpdateOne) ClearExportTemplates() *OrganizationUpdateOne {
+	_u.mutation.ClearExportTemplates()
+	return _u
+}
+
+// RemoveExportTemplateIDs removes the "export_templates" edge to ExportTemplate entities by IDs.
+func (_u *OrganizationUpdateOne) RemoveExportTemplateIDs(ids ...int) *OrganizationUpdateOne {
+	_u.mutation.RemoveExportTemplateIDs(ids...)
+	return _u
+}
+
+// RemoveExportTemplates removes "export_templates" edges to ExportTemplate entities.
+func (_u *OrganizationUpdateOne) RemoveExportTemplates(v ...*ExportTemplate) *OrganizationUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveExportTemplateIDs(ids...)
+}
+
+// ClearScheduledExports clears all "scheduled_exports" edges to the ScheduledExport entity.
+func (_u *OrganizationUpdateOne) ClearScheduledExports() *OrganizationUpdateOne {
+	_u.mutation.ClearScheduledExports()
+	return _u
+}
+
+// RemoveScheduledExportIDs removes the "scheduled_exports" edge to ScheduledExport entities by IDs.
+func (_u *OrganizationUpdateOne) RemoveScheduledExportIDs(ids ...int) *OrganizationUpdateOne {
+	_u.mutation.RemoveScheduledExportIDs(ids...)
+	return _u
+}
+
+// RemoveScheduledExports removes "scheduled_exports" edges to ScheduledExport entities.
+func (_u *OrganizationUpdateOne) RemoveScheduledExports(v ...*ScheduledExport) *OrganizationUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveScheduledExportIDs(ids...)
 }
 
-// Mutation returns the OrganizationMutation object of the builder.
-func (_u *OrganizationUpdateOne) Mutation() *OrganizationMutation {
-	return _u.mutation
+// ClearWebhooks clears all "webhooks" edges to the Webhook entity.
+func (_u *OrganizationUpdateOne) ClearWebhooks() *OrganizationUpdateOne {
+	_u.mutation.ClearWebhooks()
+	return _u
 }
 
-// ClearOwner clears the "owner" edge to the User entity.
-func (_u *OrganizationUpdateOne) ClearOwner() *OrganizationUpdateOne {
-	_u.mutation.ClearOwner()
+// RemoveWebhookIDs removes the "webhooks" edge to Webhook entities by IDs.
+func (_u *OrganizationUpdateOne) RemoveWebhookIDs(ids ...int) *OrganizationUpdateOne {
+	_u.mutation.RemoveWebhookIDs(ids...)
 	return _u
 }
 
-// ClearMembers clears all "members" edges to the OrganizationMember entity.
-func (_u *OrganizationUpdateOne) ClearMembers() *OrganizationUpdateOne {
-	_u.mutation.ClearMembers()
+// RemoveWebhooks removes "webhooks" edges to Webhook entities.
+func (_u *OrganizationUpdateOne) RemoveWebhooks(v ...*Webhook) *OrganizationUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWebhookIDs(ids...)
+}
+
+// ClearOwnedLeads clears all "owned_leads" edges to the Lead entity.
+func (_u *OrganizationUpdateOne) ClearOwnedLeads() *OrganizationUpdateOne {
+	_u.mutation.ClearOwnedLeads()
 	return _u
 }
 
-// RemoveMemberIDs removes the "members" edge to OrganizationMember entities by IDs.
-func (_u *OrganizationUpdateOne) RemoveMemberIDs(ids ...int) *OrganizationUpdateOne {
-	_u.mutation.RemoveMemberIDs(ids...)
+// RemoveOwnedLeadIDs removes the "owned_leads" edge to Lead entities by IDs.
+func (_u *OrganizationUpdateOne) RemoveOwnedLeadIDs(ids ...int) *OrganizationUpdateOne {
+	_u.mutation.RemoveOwnedLeadIDs(ids...)
 	return _u
 }
 
-// RemoveMembers removes "members" edges to OrganizationMember entities.
-func (_u *OrganizationUpdateOne) RemoveMembers(v ...*OrganizationMember) *OrganizationUpdateOne {
+// RemoveOwnedLeads removes "owned_leads" edges to Lead entities.
+func (_u *OrganizationUpdateOne) RemoveOwnedLeads(v ...*Lead) *OrganizationUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveMemberIDs(ids...)
+	return _u.RemoveOwnedLeadIDs(ids...)
 }
 
-// ClearExports clears all "exports" edges to the Export entity.
-func (_u *OrganizationUpdateOne) ClearExports() *OrganizationUpdateOne {
-	_u.mutation.ClearExports()
+// ClearLeadLicenses clears all "lead_licenses" edges to the LeadLicense entity.
+func (_u *OrganizationUpdateOne) ClearLeadLicenses() *OrganizationUpdateOne {
+	_u.mutation.ClearLeadLicenses()
 	return _u
 }
 
-// RemoveExportIDs removes the "exports" edge to Export entities by IDs.
-func (_u *OrganizationUpdateOne) RemoveExportIDs(ids ...int) *OrganizationUpdateOne {
-	_u.mutation.RemoveExportIDs(ids...)
+// RemoveLeadLicenseIDs removes the "lead_licenses" edge to LeadLicense entities by IDs.
+func (_u *OrganizationUpdateOne) RemoveLeadLicenseIDs(ids ...int) *OrganizationUpdateOne {
+	_u.mutation.RemoveLeadLicenseIDs(ids...)
 	return _u
 }
 
-// RemoveExports removes "exports" edges to Export entities.
-func (_u *OrganizationUpdateOne) RemoveExports(v ...*Export) *OrganizationUpdateOne {
+// RemoveLeadLicenses removes "lead_licenses" edges to LeadLicense entities.
+func (_u *OrganizationUpdateOne) RemoveLeadLicenses(v ...*LeadLicense) *OrganizationUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveExportIDs(ids...)
+	return _u.RemoveLeadLicenseIDs(ids...)
+}
+
+// ClearEnrichmentBudget clears the "enrichment_budget" edge to the EnrichmentBudget entity.
+func (_u *OrganizationUpdateOne) ClearEnrichmentBudget() *OrganizationUpdateOne {
+	_u.mutation.ClearEnrichmentBudget()
+	return _u
 }
 
 // Where appends a list predicates to the OrganizationUpdate builder.
@@ -1110,6 +1938,12 @@ func (_u *OrganizationUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *OrganizationUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OrganizationUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *OrganizationUpdateOne) sqlSave(ctx context.Context) (_node *Organization, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -1178,6 +2012,9 @@ func (_u *OrganizationUpdateOne) sqlSave(ctx context.Context) (_node *Organizati
 	if value, ok := _u.mutation.Active(); ok {
 		_spec.SetField(organization.FieldActive, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.MaskUnassignedContacts(); ok {
+		_spec.SetField(organization.FieldMaskUnassignedContacts, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(organization.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -1327,6 +2164,306 @@ func (_u *OrganizationUpdateOne) sqlSave(ctx context.Context) (_node *Organizati
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.LeadSuppressionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.LeadSuppressionsTable,
+			Columns: []string{organization.LeadSuppressionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadsuppression.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLeadSuppressionsIDs(); len(nodes) > 0 && !_u.mutation.LeadSuppressionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.LeadSuppressionsTable,
+			Columns: []string{organization.LeadSuppressionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadsuppression.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LeadSuppressionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.LeadSuppressionsTable,
+			Columns: []string{organization.LeadSuppressionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadsuppression.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ExportTemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ExportTemplatesTable,
+			Columns: []string{organization.ExportTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exporttemplate.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedExportTemplatesIDs(); len(nodes) > 0 && !_u.mutation.ExportTemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ExportTemplatesTable,
+			Columns: []string{organization.ExportTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exporttemplate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ExportTemplatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ExportTemplatesTable,
+			Columns: []string{organization.ExportTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exporttemplate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ScheduledExportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ScheduledExportsTable,
+			Columns: []string{organization.ScheduledExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledexport.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedScheduledExportsIDs(); len(nodes) > 0 && !_u.mutation.ScheduledExportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ScheduledExportsTable,
+			Columns: []string{organization.ScheduledExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledexport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScheduledExportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ScheduledExportsTable,
+			Columns: []string{organization.ScheduledExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledexport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.WebhooksTable,
+			Columns: []string{organization.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWebhooksIDs(); len(nodes) > 0 && !_u.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.WebhooksTable,
+			Columns: []string{organization.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.WebhooksTable,
+			Columns: []string{organization.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OwnedLeadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.OwnedLeadsTable,
+			Columns: []string{organization.OwnedLeadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOwnedLeadsIDs(); len(nodes) > 0 && !_u.mutation.OwnedLeadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.OwnedLeadsTable,
+			Columns: []string{organization.OwnedLeadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OwnedLeadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.OwnedLeadsTable,
+			Columns: []string{organization.OwnedLeadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lead.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LeadLicensesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.LeadLicensesTable,
+			Columns: []string{organization.LeadLicensesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadlicense.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLeadLicensesIDs(); len(nodes) > 0 && !_u.mutation.LeadLicensesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.LeadLicensesTable,
+			Columns: []string{organization.LeadLicensesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadlicense.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LeadLicensesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.LeadLicensesTable,
+			Columns: []string{organization.LeadLicensesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadlicense.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EnrichmentBudgetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   organization.EnrichmentBudgetTable,
+			Columns: []string{organization.EnrichmentBudgetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrichmentbudget.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EnrichmentBudgetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   organization.EnrichmentBudgetTable,
+			Columns: []string{organization.EnrichmentBudgetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrichmentbudget.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Organization{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
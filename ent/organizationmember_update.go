@@ -20,8 +20,9 @@ import (
 // OrganizationMemberUpdate is the builder for updating OrganizationMember entities.
 type OrganizationMemberUpdate struct {
 	config
-	hooks    []Hook
-	mutation *OrganizationMemberMutation
+	hooks     []Hook
+	mutation  *OrganizationMemberMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the OrganizationMemberUpdate builder.
@@ -230,6 +231,12 @@ func (_u *OrganizationMemberUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *OrganizationMemberUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OrganizationMemberUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *OrganizationMemberUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -324,6 +331,7 @@ func (_u *OrganizationMemberUpdate) sqlSave(ctx context.Context) (_node int, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{organizationmember.Label}
@@ -339,9 +347,10 @@ func (_u *OrganizationMemberUpdate) sqlSave(ctx context.Context) (_node int, err
 // OrganizationMemberUpdateOne is the builder for updating a single OrganizationMember entity.
 type OrganizationMemberUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *OrganizationMemberMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *OrganizationMemberMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetOrganizationID sets the "organization_id" field.
@@ -557,6 +566,12 @@ func (_u *OrganizationMemberUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *OrganizationMemberUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OrganizationMemberUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *OrganizationMemberUpdateOne) sqlSave(ctx context.Context) (_node *OrganizationMember, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -668,6 +683,7 @@ func (_u *OrganizationMemberUpdateOne) sqlSave(ctx context.Context) (_node *Orga
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &OrganizationMember{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
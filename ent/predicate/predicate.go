@@ -27,6 +27,9 @@ type CRMIntegration func(*sql.Selector)
 // CRMLeadSync is the predicate function for crmleadsync builders.
 type CRMLeadSync func(*sql.Selector)
 
+// CRMPushJob is the predicate function for crmpushjob builders.
+type CRMPushJob func(*sql.Selector)
+
 // CallLog is the predicate function for calllog builders.
 type CallLog func(*sql.Selector)
 
@@ -36,12 +39,18 @@ type CompetitorMetric func(*sql.Selector)
 // CompetitorProfile is the predicate function for competitorprofile builders.
 type CompetitorProfile func(*sql.Selector)
 
+// ContactAttempt is the predicate function for contactattempt builders.
+type ContactAttempt func(*sql.Selector)
+
 // EmailCampaign is the predicate function for emailcampaign builders.
 type EmailCampaign func(*sql.Selector)
 
 // EmailCampaignRecipient is the predicate function for emailcampaignrecipient builders.
 type EmailCampaignRecipient func(*sql.Selector)
 
+// EmailSend is the predicate function for emailsend builders.
+type EmailSend func(*sql.Selector)
+
 // EmailSequence is the predicate function for emailsequence builders.
 type EmailSequence func(*sql.Selector)
 
@@ -54,6 +63,15 @@ type EmailSequenceSend func(*sql.Selector)
 // EmailSequenceStep is the predicate function for emailsequencestep builders.
 type EmailSequenceStep func(*sql.Selector)
 
+// EmailSuppression is the predicate function for emailsuppression builders.
+type EmailSuppression func(*sql.Selector)
+
+// EnrichmentAttempt is the predicate function for enrichmentattempt builders.
+type EnrichmentAttempt func(*sql.Selector)
+
+// EnrichmentBudget is the predicate function for enrichmentbudget builders.
+type EnrichmentBudget func(*sql.Selector)
+
 // Experiment is the predicate function for experiment builders.
 type Experiment func(*sql.Selector)
 
@@ -63,24 +81,48 @@ type ExperimentAssignment func(*sql.Selector)
 // Export is the predicate function for export builders.
 type Export func(*sql.Selector)
 
+// ExportTemplate is the predicate function for exporttemplate builders.
+type ExportTemplate func(*sql.Selector)
+
+// ImportJob is the predicate function for importjob builders.
+type ImportJob func(*sql.Selector)
+
 // Industry is the predicate function for industry builders.
 type Industry func(*sql.Selector)
 
+// IntegrationConnection is the predicate function for integrationconnection builders.
+type IntegrationConnection func(*sql.Selector)
+
 // Lead is the predicate function for lead builders.
 type Lead func(*sql.Selector)
 
 // LeadAssignment is the predicate function for leadassignment builders.
 type LeadAssignment func(*sql.Selector)
 
+// LeadChange is the predicate function for leadchange builders.
+type LeadChange func(*sql.Selector)
+
+// LeadLicense is the predicate function for leadlicense builders.
+type LeadLicense func(*sql.Selector)
+
 // LeadNote is the predicate function for leadnote builders.
 type LeadNote func(*sql.Selector)
 
 // LeadRecommendation is the predicate function for leadrecommendation builders.
 type LeadRecommendation func(*sql.Selector)
 
+// LeadReindexJob is the predicate function for leadreindexjob builders.
+type LeadReindexJob func(*sql.Selector)
+
 // LeadStatusHistory is the predicate function for leadstatushistory builders.
 type LeadStatusHistory func(*sql.Selector)
 
+// LeadSuppression is the predicate function for leadsuppression builders.
+type LeadSuppression func(*sql.Selector)
+
+// LeadVerification is the predicate function for leadverification builders.
+type LeadVerification func(*sql.Selector)
+
 // MarketReport is the predicate function for marketreport builders.
 type MarketReport func(*sql.Selector)
 
@@ -102,6 +144,12 @@ type SMSMessage func(*sql.Selector)
 // SavedSearch is the predicate function for savedsearch builders.
 type SavedSearch func(*sql.Selector)
 
+// SavedSearchSnapshot is the predicate function for savedsearchsnapshot builders.
+type SavedSearchSnapshot func(*sql.Selector)
+
+// ScheduledExport is the predicate function for scheduledexport builders.
+type ScheduledExport func(*sql.Selector)
+
 // Subscription is the predicate function for subscription builders.
 type Subscription func(*sql.Selector)
 
@@ -111,6 +159,9 @@ type Territory func(*sql.Selector)
 // TerritoryMember is the predicate function for territorymember builders.
 type TerritoryMember func(*sql.Selector)
 
+// UsageDailyAggregate is the predicate function for usagedailyaggregate builders.
+type UsageDailyAggregate func(*sql.Selector)
+
 // UsageLog is the predicate function for usagelog builders.
 type UsageLog func(*sql.Selector)
 
@@ -120,5 +171,14 @@ type User func(*sql.Selector)
 // UserBehavior is the predicate function for userbehavior builders.
 type UserBehavior func(*sql.Selector)
 
+// UserNotificationPreference is the predicate function for usernotificationpreference builders.
+type UserNotificationPreference func(*sql.Selector)
+
 // Webhook is the predicate function for webhook builders.
 type Webhook func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
+
+// ZapierDelivery is the predicate function for zapierdelivery builders.
+type ZapierDelivery func(*sql.Selector)
@@ -25,6 +25,7 @@ type ReferralQuery struct {
 	predicates   []predicate.Referral
 	withReferrer *UserQuery
 	withReferred *UserQuery
+	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -300,8 +301,9 @@ func (_q *ReferralQuery) Clone() *ReferralQuery {
 		withReferrer: _q.withReferrer.Clone(),
 		withReferred: _q.withReferred.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -419,6 +421,9 @@ func (_q *ReferralQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Ref
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -507,6 +512,9 @@ func (_q *ReferralQuery) loadReferred(ctx context.Context, query *UserQuery, nod
 
 func (_q *ReferralQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -575,6 +583,9 @@ func (_q *ReferralQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -592,6 +603,12 @@ func (_q *ReferralQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *ReferralQuery) Modify(modifiers ...func(s *sql.Selector)) *ReferralSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // ReferralGroupBy is the group-by builder for Referral entities.
 type ReferralGroupBy struct {
 	selector
@@ -681,3 +698,9 @@ func (_s *ReferralSelect) sqlScan(ctx context.Context, root *ReferralQuery, v an
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *ReferralSelect) Modify(modifiers ...func(s *sql.Selector)) *ReferralSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
@@ -13,38 +13,58 @@ import (
 	"github.com/jordanlanch/industrydb/ent/calllog"
 	"github.com/jordanlanch/industrydb/ent/competitormetric"
 	"github.com/jordanlanch/industrydb/ent/competitorprofile"
+	"github.com/jordanlanch/industrydb/ent/contactattempt"
 	"github.com/jordanlanch/industrydb/ent/crmintegration"
 	"github.com/jordanlanch/industrydb/ent/crmleadsync"
+	"github.com/jordanlanch/industrydb/ent/crmpushjob"
 	"github.com/jordanlanch/industrydb/ent/emailcampaign"
 	"github.com/jordanlanch/industrydb/ent/emailcampaignrecipient"
+	"github.com/jordanlanch/industrydb/ent/emailsend"
 	"github.com/jordanlanch/industrydb/ent/emailsequence"
 	"github.com/jordanlanch/industrydb/ent/emailsequenceenrollment"
 	"github.com/jordanlanch/industrydb/ent/emailsequencesend"
 	"github.com/jordanlanch/industrydb/ent/emailsequencestep"
+	"github.com/jordanlanch/industrydb/ent/emailsuppression"
+	"github.com/jordanlanch/industrydb/ent/enrichmentattempt"
+	"github.com/jordanlanch/industrydb/ent/enrichmentbudget"
 	"github.com/jordanlanch/industrydb/ent/experiment"
 	"github.com/jordanlanch/industrydb/ent/experimentassignment"
 	"github.com/jordanlanch/industrydb/ent/export"
+	"github.com/jordanlanch/industrydb/ent/exporttemplate"
+	"github.com/jordanlanch/industrydb/ent/importjob"
 	"github.com/jordanlanch/industrydb/ent/industry"
+	"github.com/jordanlanch/industrydb/ent/integrationconnection"
 	"github.com/jordanlanch/industrydb/ent/lead"
 	"github.com/jordanlanch/industrydb/ent/leadassignment"
+	"github.com/jordanlanch/industrydb/ent/leadchange"
+	"github.com/jordanlanch/industrydb/ent/leadlicense"
 	"github.com/jordanlanch/industrydb/ent/leadnote"
 	"github.com/jordanlanch/industrydb/ent/leadrecommendation"
+	"github.com/jordanlanch/industrydb/ent/leadreindexjob"
 	"github.com/jordanlanch/industrydb/ent/leadstatushistory"
+	"github.com/jordanlanch/industrydb/ent/leadsuppression"
+	"github.com/jordanlanch/industrydb/ent/leadverification"
 	"github.com/jordanlanch/industrydb/ent/marketreport"
 	"github.com/jordanlanch/industrydb/ent/organization"
 	"github.com/jordanlanch/industrydb/ent/organizationmember"
 	"github.com/jordanlanch/industrydb/ent/referral"
 	"github.com/jordanlanch/industrydb/ent/savedsearch"
+	"github.com/jordanlanch/industrydb/ent/savedsearchsnapshot"
+	"github.com/jordanlanch/industrydb/ent/scheduledexport"
 	"github.com/jordanlanch/industrydb/ent/schema"
 	"github.com/jordanlanch/industrydb/ent/smscampaign"
 	"github.com/jordanlanch/industrydb/ent/smsmessage"
 	"github.com/jordanlanch/industrydb/ent/subscription"
 	"github.com/jordanlanch/industrydb/ent/territory"
 	"github.com/jordanlanch/industrydb/ent/territorymember"
+	"github.com/jordanlanch/industrydb/ent/usagedailyaggregate"
 	"github.com/jordanlanch/industrydb/ent/usagelog"
 	"github.com/jordanlanch/industrydb/ent/user"
 	"github.com/jordanlanch/industrydb/ent/userbehavior"
+	"github.com/jordanlanch/industrydb/ent/usernotificationpreference"
 	"github.com/jordanlanch/industrydb/ent/webhook"
+	"github.com/jordanlanch/industrydb/ent/webhookdelivery"
+	"github.com/jordanlanch/industrydb/ent/zapierdelivery"
 )
 
 // The init function reads all schema descriptors with runtime code
@@ -217,6 +237,46 @@ func init() {
 	crmleadsync.DefaultUpdatedAt = crmleadsyncDescUpdatedAt.Default.(func() time.Time)
 	// crmleadsync.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	crmleadsync.UpdateDefaultUpdatedAt = crmleadsyncDescUpdatedAt.UpdateDefault.(func() time.Time)
+	crmpushjobFields := schema.CRMPushJob{}.Fields()
+	_ = crmpushjobFields
+	// crmpushjobDescUserID is the schema descriptor for user_id field.
+	crmpushjobDescUserID := crmpushjobFields[0].Descriptor()
+	// crmpushjob.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	crmpushjob.UserIDValidator = crmpushjobDescUserID.Validators[0].(func(int) error)
+	// crmpushjobDescTotalLeads is the schema descriptor for total_leads field.
+	crmpushjobDescTotalLeads := crmpushjobFields[3].Descriptor()
+	// crmpushjob.DefaultTotalLeads holds the default value on creation for the total_leads field.
+	crmpushjob.DefaultTotalLeads = crmpushjobDescTotalLeads.Default.(int)
+	// crmpushjob.TotalLeadsValidator is a validator for the "total_leads" field. It is called by the builders before save.
+	crmpushjob.TotalLeadsValidator = crmpushjobDescTotalLeads.Validators[0].(func(int) error)
+	// crmpushjobDescCreatedCount is the schema descriptor for created_count field.
+	crmpushjobDescCreatedCount := crmpushjobFields[4].Descriptor()
+	// crmpushjob.DefaultCreatedCount holds the default value on creation for the created_count field.
+	crmpushjob.DefaultCreatedCount = crmpushjobDescCreatedCount.Default.(int)
+	// crmpushjob.CreatedCountValidator is a validator for the "created_count" field. It is called by the builders before save.
+	crmpushjob.CreatedCountValidator = crmpushjobDescCreatedCount.Validators[0].(func(int) error)
+	// crmpushjobDescUpdatedCount is the schema descriptor for updated_count field.
+	crmpushjobDescUpdatedCount := crmpushjobFields[5].Descriptor()
+	// crmpushjob.DefaultUpdatedCount holds the default value on creation for the updated_count field.
+	crmpushjob.DefaultUpdatedCount = crmpushjobDescUpdatedCount.Default.(int)
+	// crmpushjob.UpdatedCountValidator is a validator for the "updated_count" field. It is called by the builders before save.
+	crmpushjob.UpdatedCountValidator = crmpushjobDescUpdatedCount.Validators[0].(func(int) error)
+	// crmpushjobDescFailureCount is the schema descriptor for failure_count field.
+	crmpushjobDescFailureCount := crmpushjobFields[6].Descriptor()
+	// crmpushjob.DefaultFailureCount holds the default value on creation for the failure_count field.
+	crmpushjob.DefaultFailureCount = crmpushjobDescFailureCount.Default.(int)
+	// crmpushjob.FailureCountValidator is a validator for the "failure_count" field. It is called by the builders before save.
+	crmpushjob.FailureCountValidator = crmpushjobDescFailureCount.Validators[0].(func(int) error)
+	// crmpushjobDescCreatedAt is the schema descriptor for created_at field.
+	crmpushjobDescCreatedAt := crmpushjobFields[11].Descriptor()
+	// crmpushjob.DefaultCreatedAt holds the default value on creation for the created_at field.
+	crmpushjob.DefaultCreatedAt = crmpushjobDescCreatedAt.Default.(func() time.Time)
+	// crmpushjobDescUpdatedAt is the schema descriptor for updated_at field.
+	crmpushjobDescUpdatedAt := crmpushjobFields[12].Descriptor()
+	// crmpushjob.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	crmpushjob.DefaultUpdatedAt = crmpushjobDescUpdatedAt.Default.(func() time.Time)
+	// crmpushjob.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	crmpushjob.UpdateDefaultUpdatedAt = crmpushjobDescUpdatedAt.UpdateDefault.(func() time.Time)
 	calllogFields := schema.CallLog{}.Fields()
 	_ = calllogFields
 	// calllogDescPhoneNumber is the schema descriptor for phone_number field.
@@ -387,6 +447,24 @@ func init() {
 	competitorprofile.DefaultUpdatedAt = competitorprofileDescUpdatedAt.Default.(func() time.Time)
 	// competitorprofile.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	competitorprofile.UpdateDefaultUpdatedAt = competitorprofileDescUpdatedAt.UpdateDefault.(func() time.Time)
+	contactattemptFields := schema.ContactAttempt{}.Fields()
+	_ = contactattemptFields
+	// contactattemptDescLeadID is the schema descriptor for lead_id field.
+	contactattemptDescLeadID := contactattemptFields[0].Descriptor()
+	// contactattempt.LeadIDValidator is a validator for the "lead_id" field. It is called by the builders before save.
+	contactattempt.LeadIDValidator = contactattemptDescLeadID.Validators[0].(func(int) error)
+	// contactattemptDescUserID is the schema descriptor for user_id field.
+	contactattemptDescUserID := contactattemptFields[1].Descriptor()
+	// contactattempt.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	contactattempt.UserIDValidator = contactattemptDescUserID.Validators[0].(func(int) error)
+	// contactattemptDescAttemptedAt is the schema descriptor for attempted_at field.
+	contactattemptDescAttemptedAt := contactattemptFields[4].Descriptor()
+	// contactattempt.DefaultAttemptedAt holds the default value on creation for the attempted_at field.
+	contactattempt.DefaultAttemptedAt = contactattemptDescAttemptedAt.Default.(func() time.Time)
+	// contactattemptDescCreatedAt is the schema descriptor for created_at field.
+	contactattemptDescCreatedAt := contactattemptFields[5].Descriptor()
+	// contactattempt.DefaultCreatedAt holds the default value on creation for the created_at field.
+	contactattempt.DefaultCreatedAt = contactattemptDescCreatedAt.Default.(func() time.Time)
 	emailcampaignFields := schema.EmailCampaign{}.Fields()
 	_ = emailcampaignFields
 	// emailcampaignDescName is the schema descriptor for name field.
@@ -467,6 +545,28 @@ func init() {
 	emailcampaignrecipient.DefaultUpdatedAt = emailcampaignrecipientDescUpdatedAt.Default.(func() time.Time)
 	// emailcampaignrecipient.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	emailcampaignrecipient.UpdateDefaultUpdatedAt = emailcampaignrecipientDescUpdatedAt.UpdateDefault.(func() time.Time)
+	emailsendFields := schema.EmailSend{}.Fields()
+	_ = emailsendFields
+	// emailsendDescToEmail is the schema descriptor for to_email field.
+	emailsendDescToEmail := emailsendFields[0].Descriptor()
+	// emailsend.ToEmailValidator is a validator for the "to_email" field. It is called by the builders before save.
+	emailsend.ToEmailValidator = emailsendDescToEmail.Validators[0].(func(string) error)
+	// emailsendDescAttempts is the schema descriptor for attempts field.
+	emailsendDescAttempts := emailsendFields[7].Descriptor()
+	// emailsend.DefaultAttempts holds the default value on creation for the attempts field.
+	emailsend.DefaultAttempts = emailsendDescAttempts.Default.(int)
+	// emailsend.AttemptsValidator is a validator for the "attempts" field. It is called by the builders before save.
+	emailsend.AttemptsValidator = emailsendDescAttempts.Validators[0].(func(int) error)
+	// emailsendDescCreatedAt is the schema descriptor for created_at field.
+	emailsendDescCreatedAt := emailsendFields[14].Descriptor()
+	// emailsend.DefaultCreatedAt holds the default value on creation for the created_at field.
+	emailsend.DefaultCreatedAt = emailsendDescCreatedAt.Default.(func() time.Time)
+	// emailsendDescUpdatedAt is the schema descriptor for updated_at field.
+	emailsendDescUpdatedAt := emailsendFields[15].Descriptor()
+	// emailsend.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	emailsend.DefaultUpdatedAt = emailsendDescUpdatedAt.Default.(func() time.Time)
+	// emailsend.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	emailsend.UpdateDefaultUpdatedAt = emailsendDescUpdatedAt.UpdateDefault.(func() time.Time)
 	emailsequenceFields := schema.EmailSequence{}.Fields()
 	_ = emailsequenceFields
 	// emailsequenceDescName is the schema descriptor for name field.
@@ -487,16 +587,24 @@ func init() {
 			return nil
 		}
 	}()
+	// emailsequenceDescDeliveryStartHour is the schema descriptor for delivery_start_hour field.
+	emailsequenceDescDeliveryStartHour := emailsequenceFields[5].Descriptor()
+	// emailsequence.DeliveryStartHourValidator is a validator for the "delivery_start_hour" field. It is called by the builders before save.
+	emailsequence.DeliveryStartHourValidator = emailsequenceDescDeliveryStartHour.Validators[0].(func(int) error)
+	// emailsequenceDescDeliveryEndHour is the schema descriptor for delivery_end_hour field.
+	emailsequenceDescDeliveryEndHour := emailsequenceFields[6].Descriptor()
+	// emailsequence.DeliveryEndHourValidator is a validator for the "delivery_end_hour" field. It is called by the builders before save.
+	emailsequence.DeliveryEndHourValidator = emailsequenceDescDeliveryEndHour.Validators[0].(func(int) error)
 	// emailsequenceDescCreatedByUserID is the schema descriptor for created_by_user_id field.
-	emailsequenceDescCreatedByUserID := emailsequenceFields[4].Descriptor()
+	emailsequenceDescCreatedByUserID := emailsequenceFields[7].Descriptor()
 	// emailsequence.CreatedByUserIDValidator is a validator for the "created_by_user_id" field. It is called by the builders before save.
 	emailsequence.CreatedByUserIDValidator = emailsequenceDescCreatedByUserID.Validators[0].(func(int) error)
 	// emailsequenceDescCreatedAt is the schema descriptor for created_at field.
-	emailsequenceDescCreatedAt := emailsequenceFields[5].Descriptor()
+	emailsequenceDescCreatedAt := emailsequenceFields[8].Descriptor()
 	// emailsequence.DefaultCreatedAt holds the default value on creation for the created_at field.
 	emailsequence.DefaultCreatedAt = emailsequenceDescCreatedAt.Default.(func() time.Time)
 	// emailsequenceDescUpdatedAt is the schema descriptor for updated_at field.
-	emailsequenceDescUpdatedAt := emailsequenceFields[6].Descriptor()
+	emailsequenceDescUpdatedAt := emailsequenceFields[9].Descriptor()
 	// emailsequence.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	emailsequence.DefaultUpdatedAt = emailsequenceDescUpdatedAt.Default.(func() time.Time)
 	// emailsequence.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -602,9 +710,91 @@ func init() {
 	// emailsequencestep.BodyValidator is a validator for the "body" field. It is called by the builders before save.
 	emailsequencestep.BodyValidator = emailsequencestepDescBody.Validators[0].(func(string) error)
 	// emailsequencestepDescCreatedAt is the schema descriptor for created_at field.
-	emailsequencestepDescCreatedAt := emailsequencestepFields[5].Descriptor()
+	emailsequencestepDescCreatedAt := emailsequencestepFields[9].Descriptor()
 	// emailsequencestep.DefaultCreatedAt holds the default value on creation for the created_at field.
 	emailsequencestep.DefaultCreatedAt = emailsequencestepDescCreatedAt.Default.(func() time.Time)
+	emailsuppressionFields := schema.EmailSuppression{}.Fields()
+	_ = emailsuppressionFields
+	// emailsuppressionDescEmail is the schema descriptor for email field.
+	emailsuppressionDescEmail := emailsuppressionFields[0].Descriptor()
+	// emailsuppression.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	emailsuppression.EmailValidator = emailsuppressionDescEmail.Validators[0].(func(string) error)
+	// emailsuppressionDescCreatedAt is the schema descriptor for created_at field.
+	emailsuppressionDescCreatedAt := emailsuppressionFields[2].Descriptor()
+	// emailsuppression.DefaultCreatedAt holds the default value on creation for the created_at field.
+	emailsuppression.DefaultCreatedAt = emailsuppressionDescCreatedAt.Default.(func() time.Time)
+	enrichmentattemptFields := schema.EnrichmentAttempt{}.Fields()
+	_ = enrichmentattemptFields
+	// enrichmentattemptDescLeadID is the schema descriptor for lead_id field.
+	enrichmentattemptDescLeadID := enrichmentattemptFields[0].Descriptor()
+	// enrichmentattempt.LeadIDValidator is a validator for the "lead_id" field. It is called by the builders before save.
+	enrichmentattempt.LeadIDValidator = enrichmentattemptDescLeadID.Validators[0].(func(int) error)
+	// enrichmentattemptDescProvider is the schema descriptor for provider field.
+	enrichmentattemptDescProvider := enrichmentattemptFields[2].Descriptor()
+	// enrichmentattempt.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	enrichmentattempt.ProviderValidator = func() func(string) error {
+		validators := enrichmentattemptDescProvider.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(provider string) error {
+			for _, fn := range fns {
+				if err := fn(provider); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// enrichmentattemptDescCostCents is the schema descriptor for cost_cents field.
+	enrichmentattemptDescCostCents := enrichmentattemptFields[7].Descriptor()
+	// enrichmentattempt.DefaultCostCents holds the default value on creation for the cost_cents field.
+	enrichmentattempt.DefaultCostCents = enrichmentattemptDescCostCents.Default.(int)
+	// enrichmentattempt.CostCentsValidator is a validator for the "cost_cents" field. It is called by the builders before save.
+	enrichmentattempt.CostCentsValidator = enrichmentattemptDescCostCents.Validators[0].(func(int) error)
+	// enrichmentattemptDescDurationMs is the schema descriptor for duration_ms field.
+	enrichmentattemptDescDurationMs := enrichmentattemptFields[8].Descriptor()
+	// enrichmentattempt.DefaultDurationMs holds the default value on creation for the duration_ms field.
+	enrichmentattempt.DefaultDurationMs = enrichmentattemptDescDurationMs.Default.(int)
+	// enrichmentattempt.DurationMsValidator is a validator for the "duration_ms" field. It is called by the builders before save.
+	enrichmentattempt.DurationMsValidator = enrichmentattemptDescDurationMs.Validators[0].(func(int) error)
+	// enrichmentattemptDescCreatedAt is the schema descriptor for created_at field.
+	enrichmentattemptDescCreatedAt := enrichmentattemptFields[9].Descriptor()
+	// enrichmentattempt.DefaultCreatedAt holds the default value on creation for the created_at field.
+	enrichmentattempt.DefaultCreatedAt = enrichmentattemptDescCreatedAt.Default.(func() time.Time)
+	enrichmentbudgetFields := schema.EnrichmentBudget{}.Fields()
+	_ = enrichmentbudgetFields
+	// enrichmentbudgetDescCallLimit is the schema descriptor for call_limit field.
+	enrichmentbudgetDescCallLimit := enrichmentbudgetFields[2].Descriptor()
+	// enrichmentbudget.CallLimitValidator is a validator for the "call_limit" field. It is called by the builders before save.
+	enrichmentbudget.CallLimitValidator = enrichmentbudgetDescCallLimit.Validators[0].(func(int) error)
+	// enrichmentbudgetDescCostLimitCents is the schema descriptor for cost_limit_cents field.
+	enrichmentbudgetDescCostLimitCents := enrichmentbudgetFields[3].Descriptor()
+	// enrichmentbudget.CostLimitCentsValidator is a validator for the "cost_limit_cents" field. It is called by the builders before save.
+	enrichmentbudget.CostLimitCentsValidator = enrichmentbudgetDescCostLimitCents.Validators[0].(func(int) error)
+	// enrichmentbudgetDescCalls is the schema descriptor for calls field.
+	enrichmentbudgetDescCalls := enrichmentbudgetFields[4].Descriptor()
+	// enrichmentbudget.DefaultCalls holds the default value on creation for the calls field.
+	enrichmentbudget.DefaultCalls = enrichmentbudgetDescCalls.Default.(int)
+	// enrichmentbudget.CallsValidator is a validator for the "calls" field. It is called by the builders before save.
+	enrichmentbudget.CallsValidator = enrichmentbudgetDescCalls.Validators[0].(func(int) error)
+	// enrichmentbudgetDescCostCents is the schema descriptor for cost_cents field.
+	enrichmentbudgetDescCostCents := enrichmentbudgetFields[5].Descriptor()
+	// enrichmentbudget.DefaultCostCents holds the default value on creation for the cost_cents field.
+	enrichmentbudget.DefaultCostCents = enrichmentbudgetDescCostCents.Default.(int)
+	// enrichmentbudget.CostCentsValidator is a validator for the "cost_cents" field. It is called by the builders before save.
+	enrichmentbudget.CostCentsValidator = enrichmentbudgetDescCostCents.Validators[0].(func(int) error)
+	// enrichmentbudgetDescPeriodStart is the schema descriptor for period_start field.
+	enrichmentbudgetDescPeriodStart := enrichmentbudgetFields[6].Descriptor()
+	// enrichmentbudget.DefaultPeriodStart holds the default value on creation for the period_start field.
+	enrichmentbudget.DefaultPeriodStart = enrichmentbudgetDescPeriodStart.Default.(func() time.Time)
+	// enrichmentbudgetDescUpdatedAt is the schema descriptor for updated_at field.
+	enrichmentbudgetDescUpdatedAt := enrichmentbudgetFields[7].Descriptor()
+	// enrichmentbudget.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	enrichmentbudget.DefaultUpdatedAt = enrichmentbudgetDescUpdatedAt.Default.(func() time.Time)
+	// enrichmentbudget.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	enrichmentbudget.UpdateDefaultUpdatedAt = enrichmentbudgetDescUpdatedAt.UpdateDefault.(func() time.Time)
 	experimentFields := schema.Experiment{}.Fields()
 	_ = experimentFields
 	// experimentDescName is the schema descriptor for name field.
@@ -689,16 +879,148 @@ func init() {
 	exportDescLeadCount := exportFields[4].Descriptor()
 	// export.LeadCountValidator is a validator for the "lead_count" field. It is called by the builders before save.
 	export.LeadCountValidator = exportDescLeadCount.Validators[0].(func(int) error)
+	// exportDescSkippedCount is the schema descriptor for skipped_count field.
+	exportDescSkippedCount := exportFields[5].Descriptor()
+	// export.DefaultSkippedCount holds the default value on creation for the skipped_count field.
+	export.DefaultSkippedCount = exportDescSkippedCount.Default.(int)
+	// export.SkippedCountValidator is a validator for the "skipped_count" field. It is called by the builders before save.
+	export.SkippedCountValidator = exportDescSkippedCount.Validators[0].(func(int) error)
+	// exportDescRedactionProfile is the schema descriptor for redaction_profile field.
+	exportDescRedactionProfile := exportFields[14].Descriptor()
+	// export.RedactionProfileValidator is a validator for the "redaction_profile" field. It is called by the builders before save.
+	export.RedactionProfileValidator = exportDescRedactionProfile.Validators[0].(func(string) error)
+	// exportDescDeliveryURL is the schema descriptor for delivery_url field.
+	exportDescDeliveryURL := exportFields[15].Descriptor()
+	// export.DeliveryURLValidator is a validator for the "delivery_url" field. It is called by the builders before save.
+	export.DeliveryURLValidator = exportDescDeliveryURL.Validators[0].(func(string) error)
+	// exportDescDeliveryAttempts is the schema descriptor for delivery_attempts field.
+	exportDescDeliveryAttempts := exportFields[18].Descriptor()
+	// export.DefaultDeliveryAttempts holds the default value on creation for the delivery_attempts field.
+	export.DefaultDeliveryAttempts = exportDescDeliveryAttempts.Default.(int)
+	// export.DeliveryAttemptsValidator is a validator for the "delivery_attempts" field. It is called by the builders before save.
+	export.DeliveryAttemptsValidator = exportDescDeliveryAttempts.Validators[0].(func(int) error)
+	// exportDescSheetURL is the schema descriptor for sheet_url field.
+	exportDescSheetURL := exportFields[23].Descriptor()
+	// export.SheetURLValidator is a validator for the "sheet_url" field. It is called by the builders before save.
+	export.SheetURLValidator = exportDescSheetURL.Validators[0].(func(string) error)
+	// exportDescDownloadCount is the schema descriptor for download_count field.
+	exportDescDownloadCount := exportFields[27].Descriptor()
+	// export.DefaultDownloadCount holds the default value on creation for the download_count field.
+	export.DefaultDownloadCount = exportDescDownloadCount.Default.(int)
+	// export.DownloadCountValidator is a validator for the "download_count" field. It is called by the builders before save.
+	export.DownloadCountValidator = exportDescDownloadCount.Validators[0].(func(int) error)
 	// exportDescCreatedAt is the schema descriptor for created_at field.
-	exportDescCreatedAt := exportFields[10].Descriptor()
+	exportDescCreatedAt := exportFields[29].Descriptor()
 	// export.DefaultCreatedAt holds the default value on creation for the created_at field.
 	export.DefaultCreatedAt = exportDescCreatedAt.Default.(func() time.Time)
 	// exportDescUpdatedAt is the schema descriptor for updated_at field.
-	exportDescUpdatedAt := exportFields[11].Descriptor()
+	exportDescUpdatedAt := exportFields[30].Descriptor()
 	// export.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	export.DefaultUpdatedAt = exportDescUpdatedAt.Default.(func() time.Time)
 	// export.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	export.UpdateDefaultUpdatedAt = exportDescUpdatedAt.UpdateDefault.(func() time.Time)
+	exporttemplateFields := schema.ExportTemplate{}.Fields()
+	_ = exporttemplateFields
+	// exporttemplateDescUserID is the schema descriptor for user_id field.
+	exporttemplateDescUserID := exporttemplateFields[0].Descriptor()
+	// exporttemplate.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	exporttemplate.UserIDValidator = exporttemplateDescUserID.Validators[0].(func(int) error)
+	// exporttemplateDescName is the schema descriptor for name field.
+	exporttemplateDescName := exporttemplateFields[2].Descriptor()
+	// exporttemplate.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	exporttemplate.NameValidator = func() func(string) error {
+		validators := exporttemplateDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// exporttemplateDescMaxLeads is the schema descriptor for max_leads field.
+	exporttemplateDescMaxLeads := exporttemplateFields[7].Descriptor()
+	// exporttemplate.DefaultMaxLeads holds the default value on creation for the max_leads field.
+	exporttemplate.DefaultMaxLeads = exporttemplateDescMaxLeads.Default.(int)
+	// exporttemplate.MaxLeadsValidator is a validator for the "max_leads" field. It is called by the builders before save.
+	exporttemplate.MaxLeadsValidator = exporttemplateDescMaxLeads.Validators[0].(func(int) error)
+	// exporttemplateDescCreatedAt is the schema descriptor for created_at field.
+	exporttemplateDescCreatedAt := exporttemplateFields[8].Descriptor()
+	// exporttemplate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	exporttemplate.DefaultCreatedAt = exporttemplateDescCreatedAt.Default.(func() time.Time)
+	// exporttemplateDescUpdatedAt is the schema descriptor for updated_at field.
+	exporttemplateDescUpdatedAt := exporttemplateFields[9].Descriptor()
+	// exporttemplate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	exporttemplate.DefaultUpdatedAt = exporttemplateDescUpdatedAt.Default.(func() time.Time)
+	// exporttemplate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	exporttemplate.UpdateDefaultUpdatedAt = exporttemplateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	importjobFields := schema.ImportJob{}.Fields()
+	_ = importjobFields
+	// importjobDescUserID is the schema descriptor for user_id field.
+	importjobDescUserID := importjobFields[0].Descriptor()
+	// importjob.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	importjob.UserIDValidator = importjobDescUserID.Validators[0].(func(int) error)
+	// importjobDescSource is the schema descriptor for source field.
+	importjobDescSource := importjobFields[2].Descriptor()
+	// importjob.SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	importjob.SourceValidator = func() func(string) error {
+		validators := importjobDescSource.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(source string) error {
+			for _, fn := range fns {
+				if err := fn(source); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// importjobDescValidateOnly is the schema descriptor for validate_only field.
+	importjobDescValidateOnly := importjobFields[4].Descriptor()
+	// importjob.DefaultValidateOnly holds the default value on creation for the validate_only field.
+	importjob.DefaultValidateOnly = importjobDescValidateOnly.Default.(bool)
+	// importjobDescBytesRead is the schema descriptor for bytes_read field.
+	importjobDescBytesRead := importjobFields[6].Descriptor()
+	// importjob.DefaultBytesRead holds the default value on creation for the bytes_read field.
+	importjob.DefaultBytesRead = importjobDescBytesRead.Default.(int64)
+	// importjob.BytesReadValidator is a validator for the "bytes_read" field. It is called by the builders before save.
+	importjob.BytesReadValidator = importjobDescBytesRead.Validators[0].(func(int64) error)
+	// importjobDescTotalRows is the schema descriptor for total_rows field.
+	importjobDescTotalRows := importjobFields[7].Descriptor()
+	// importjob.DefaultTotalRows holds the default value on creation for the total_rows field.
+	importjob.DefaultTotalRows = importjobDescTotalRows.Default.(int)
+	// importjob.TotalRowsValidator is a validator for the "total_rows" field. It is called by the builders before save.
+	importjob.TotalRowsValidator = importjobDescTotalRows.Validators[0].(func(int) error)
+	// importjobDescSuccessCount is the schema descriptor for success_count field.
+	importjobDescSuccessCount := importjobFields[8].Descriptor()
+	// importjob.DefaultSuccessCount holds the default value on creation for the success_count field.
+	importjob.DefaultSuccessCount = importjobDescSuccessCount.Default.(int)
+	// importjob.SuccessCountValidator is a validator for the "success_count" field. It is called by the builders before save.
+	importjob.SuccessCountValidator = importjobDescSuccessCount.Validators[0].(func(int) error)
+	// importjobDescFailureCount is the schema descriptor for failure_count field.
+	importjobDescFailureCount := importjobFields[9].Descriptor()
+	// importjob.DefaultFailureCount holds the default value on creation for the failure_count field.
+	importjob.DefaultFailureCount = importjobDescFailureCount.Default.(int)
+	// importjob.FailureCountValidator is a validator for the "failure_count" field. It is called by the builders before save.
+	importjob.FailureCountValidator = importjobDescFailureCount.Validators[0].(func(int) error)
+	// importjobDescCreatedAt is the schema descriptor for created_at field.
+	importjobDescCreatedAt := importjobFields[14].Descriptor()
+	// importjob.DefaultCreatedAt holds the default value on creation for the created_at field.
+	importjob.DefaultCreatedAt = importjobDescCreatedAt.Default.(func() time.Time)
+	// importjobDescUpdatedAt is the schema descriptor for updated_at field.
+	importjobDescUpdatedAt := importjobFields[15].Descriptor()
+	// importjob.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	importjob.DefaultUpdatedAt = importjobDescUpdatedAt.Default.(func() time.Time)
+	// importjob.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	importjob.UpdateDefaultUpdatedAt = importjobDescUpdatedAt.UpdateDefault.(func() time.Time)
 	industryFields := schema.Industry{}.Fields()
 	_ = industryFields
 	// industryDescName is the schema descriptor for name field.
@@ -735,6 +1057,18 @@ func init() {
 	industryDescID := industryFields[0].Descriptor()
 	// industry.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	industry.IDValidator = industryDescID.Validators[0].(func(string) error)
+	integrationconnectionFields := schema.IntegrationConnection{}.Fields()
+	_ = integrationconnectionFields
+	// integrationconnectionDescCreatedAt is the schema descriptor for created_at field.
+	integrationconnectionDescCreatedAt := integrationconnectionFields[5].Descriptor()
+	// integrationconnection.DefaultCreatedAt holds the default value on creation for the created_at field.
+	integrationconnection.DefaultCreatedAt = integrationconnectionDescCreatedAt.Default.(func() time.Time)
+	// integrationconnectionDescUpdatedAt is the schema descriptor for updated_at field.
+	integrationconnectionDescUpdatedAt := integrationconnectionFields[6].Descriptor()
+	// integrationconnection.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	integrationconnection.DefaultUpdatedAt = integrationconnectionDescUpdatedAt.Default.(func() time.Time)
+	// integrationconnection.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	integrationconnection.UpdateDefaultUpdatedAt = integrationconnectionDescUpdatedAt.UpdateDefault.(func() time.Time)
 	leadFields := schema.Lead{}.Fields()
 	_ = leadFields
 	// leadDescName is the schema descriptor for name field.
@@ -768,7 +1102,7 @@ func init() {
 	// lead.DefaultVerified holds the default value on creation for the verified field.
 	lead.DefaultVerified = leadDescVerified.Default.(bool)
 	// leadDescQualityScore is the schema descriptor for quality_score field.
-	leadDescQualityScore := leadFields[13].Descriptor()
+	leadDescQualityScore := leadFields[15].Descriptor()
 	// lead.DefaultQualityScore holds the default value on creation for the quality_score field.
 	lead.DefaultQualityScore = leadDescQualityScore.Default.(int)
 	// lead.QualityScoreValidator is a validator for the "quality_score" field. It is called by the builders before save.
@@ -787,24 +1121,44 @@ func init() {
 			return nil
 		}
 	}()
+	// leadDescCompletenessScore is the schema descriptor for completeness_score field.
+	leadDescCompletenessScore := leadFields[16].Descriptor()
+	// lead.DefaultCompletenessScore holds the default value on creation for the completeness_score field.
+	lead.DefaultCompletenessScore = leadDescCompletenessScore.Default.(int)
+	// lead.CompletenessScoreValidator is a validator for the "completeness_score" field. It is called by the builders before save.
+	lead.CompletenessScoreValidator = func() func(int) error {
+		validators := leadDescCompletenessScore.Validators
+		fns := [...]func(int) error{
+			validators[0].(func(int) error),
+			validators[1].(func(int) error),
+		}
+		return func(completeness_score int) error {
+			for _, fn := range fns {
+				if err := fn(completeness_score); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	// leadDescStatusChangedAt is the schema descriptor for status_changed_at field.
-	leadDescStatusChangedAt := leadFields[15].Descriptor()
+	leadDescStatusChangedAt := leadFields[18].Descriptor()
 	// lead.DefaultStatusChangedAt holds the default value on creation for the status_changed_at field.
 	lead.DefaultStatusChangedAt = leadDescStatusChangedAt.Default.(func() time.Time)
 	// leadDescIsEnriched is the schema descriptor for is_enriched field.
-	leadDescIsEnriched := leadFields[30].Descriptor()
+	leadDescIsEnriched := leadFields[39].Descriptor()
 	// lead.DefaultIsEnriched holds the default value on creation for the is_enriched field.
 	lead.DefaultIsEnriched = leadDescIsEnriched.Default.(bool)
 	// leadDescEmailValidated is the schema descriptor for email_validated field.
-	leadDescEmailValidated := leadFields[32].Descriptor()
+	leadDescEmailValidated := leadFields[41].Descriptor()
 	// lead.DefaultEmailValidated holds the default value on creation for the email_validated field.
 	lead.DefaultEmailValidated = leadDescEmailValidated.Default.(bool)
 	// leadDescCreatedAt is the schema descriptor for created_at field.
-	leadDescCreatedAt := leadFields[33].Descriptor()
+	leadDescCreatedAt := leadFields[42].Descriptor()
 	// lead.DefaultCreatedAt holds the default value on creation for the created_at field.
 	lead.DefaultCreatedAt = leadDescCreatedAt.Default.(func() time.Time)
 	// leadDescUpdatedAt is the schema descriptor for updated_at field.
-	leadDescUpdatedAt := leadFields[34].Descriptor()
+	leadDescUpdatedAt := leadFields[43].Descriptor()
 	// lead.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	lead.DefaultUpdatedAt = leadDescUpdatedAt.Default.(func() time.Time)
 	// lead.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -832,9 +1186,33 @@ func init() {
 	// leadassignment.DefaultIsActive holds the default value on creation for the is_active field.
 	leadassignment.DefaultIsActive = leadassignmentDescIsActive.Default.(bool)
 	// leadassignmentDescCreatedAt is the schema descriptor for created_at field.
-	leadassignmentDescCreatedAt := leadassignmentFields[7].Descriptor()
+	leadassignmentDescCreatedAt := leadassignmentFields[9].Descriptor()
 	// leadassignment.DefaultCreatedAt holds the default value on creation for the created_at field.
 	leadassignment.DefaultCreatedAt = leadassignmentDescCreatedAt.Default.(func() time.Time)
+	leadchangeFields := schema.LeadChange{}.Fields()
+	_ = leadchangeFields
+	// leadchangeDescLeadID is the schema descriptor for lead_id field.
+	leadchangeDescLeadID := leadchangeFields[0].Descriptor()
+	// leadchange.LeadIDValidator is a validator for the "lead_id" field. It is called by the builders before save.
+	leadchange.LeadIDValidator = leadchangeDescLeadID.Validators[0].(func(int) error)
+	// leadchangeDescCreatedAt is the schema descriptor for created_at field.
+	leadchangeDescCreatedAt := leadchangeFields[4].Descriptor()
+	// leadchange.DefaultCreatedAt holds the default value on creation for the created_at field.
+	leadchange.DefaultCreatedAt = leadchangeDescCreatedAt.Default.(func() time.Time)
+	leadlicenseFields := schema.LeadLicense{}.Fields()
+	_ = leadlicenseFields
+	// leadlicenseDescOrganizationID is the schema descriptor for organization_id field.
+	leadlicenseDescOrganizationID := leadlicenseFields[0].Descriptor()
+	// leadlicense.OrganizationIDValidator is a validator for the "organization_id" field. It is called by the builders before save.
+	leadlicense.OrganizationIDValidator = leadlicenseDescOrganizationID.Validators[0].(func(int) error)
+	// leadlicenseDescCountry is the schema descriptor for country field.
+	leadlicenseDescCountry := leadlicenseFields[2].Descriptor()
+	// leadlicense.CountryValidator is a validator for the "country" field. It is called by the builders before save.
+	leadlicense.CountryValidator = leadlicenseDescCountry.Validators[0].(func(string) error)
+	// leadlicenseDescCreatedAt is the schema descriptor for created_at field.
+	leadlicenseDescCreatedAt := leadlicenseFields[4].Descriptor()
+	// leadlicense.DefaultCreatedAt holds the default value on creation for the created_at field.
+	leadlicense.DefaultCreatedAt = leadlicenseDescCreatedAt.Default.(func() time.Time)
 	leadnoteFields := schema.LeadNote{}.Fields()
 	_ = leadnoteFields
 	// leadnoteDescLeadID is the schema descriptor for lead_id field.
@@ -868,11 +1246,11 @@ func init() {
 	// leadnote.DefaultIsPinned holds the default value on creation for the is_pinned field.
 	leadnote.DefaultIsPinned = leadnoteDescIsPinned.Default.(bool)
 	// leadnoteDescCreatedAt is the schema descriptor for created_at field.
-	leadnoteDescCreatedAt := leadnoteFields[4].Descriptor()
+	leadnoteDescCreatedAt := leadnoteFields[5].Descriptor()
 	// leadnote.DefaultCreatedAt holds the default value on creation for the created_at field.
 	leadnote.DefaultCreatedAt = leadnoteDescCreatedAt.Default.(func() time.Time)
 	// leadnoteDescUpdatedAt is the schema descriptor for updated_at field.
-	leadnoteDescUpdatedAt := leadnoteFields[5].Descriptor()
+	leadnoteDescUpdatedAt := leadnoteFields[6].Descriptor()
 	// leadnote.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	leadnote.DefaultUpdatedAt = leadnoteDescUpdatedAt.Default.(func() time.Time)
 	// leadnote.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -925,6 +1303,44 @@ func init() {
 	leadrecommendation.DefaultUpdatedAt = leadrecommendationDescUpdatedAt.Default.(func() time.Time)
 	// leadrecommendation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	leadrecommendation.UpdateDefaultUpdatedAt = leadrecommendationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	leadreindexjobFields := schema.LeadReindexJob{}.Fields()
+	_ = leadreindexjobFields
+	// leadreindexjobDescUserID is the schema descriptor for user_id field.
+	leadreindexjobDescUserID := leadreindexjobFields[0].Descriptor()
+	// leadreindexjob.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	leadreindexjob.UserIDValidator = leadreindexjobDescUserID.Validators[0].(func(int) error)
+	// leadreindexjobDescDryRun is the schema descriptor for dry_run field.
+	leadreindexjobDescDryRun := leadreindexjobFields[2].Descriptor()
+	// leadreindexjob.DefaultDryRun holds the default value on creation for the dry_run field.
+	leadreindexjob.DefaultDryRun = leadreindexjobDescDryRun.Default.(bool)
+	// leadreindexjobDescTotalLeads is the schema descriptor for total_leads field.
+	leadreindexjobDescTotalLeads := leadreindexjobFields[3].Descriptor()
+	// leadreindexjob.DefaultTotalLeads holds the default value on creation for the total_leads field.
+	leadreindexjob.DefaultTotalLeads = leadreindexjobDescTotalLeads.Default.(int)
+	// leadreindexjob.TotalLeadsValidator is a validator for the "total_leads" field. It is called by the builders before save.
+	leadreindexjob.TotalLeadsValidator = leadreindexjobDescTotalLeads.Validators[0].(func(int) error)
+	// leadreindexjobDescProcessed is the schema descriptor for processed field.
+	leadreindexjobDescProcessed := leadreindexjobFields[4].Descriptor()
+	// leadreindexjob.DefaultProcessed holds the default value on creation for the processed field.
+	leadreindexjob.DefaultProcessed = leadreindexjobDescProcessed.Default.(int)
+	// leadreindexjob.ProcessedValidator is a validator for the "processed" field. It is called by the builders before save.
+	leadreindexjob.ProcessedValidator = leadreindexjobDescProcessed.Validators[0].(func(int) error)
+	// leadreindexjobDescAffected is the schema descriptor for affected field.
+	leadreindexjobDescAffected := leadreindexjobFields[5].Descriptor()
+	// leadreindexjob.DefaultAffected holds the default value on creation for the affected field.
+	leadreindexjob.DefaultAffected = leadreindexjobDescAffected.Default.(int)
+	// leadreindexjob.AffectedValidator is a validator for the "affected" field. It is called by the builders before save.
+	leadreindexjob.AffectedValidator = leadreindexjobDescAffected.Validators[0].(func(int) error)
+	// leadreindexjobDescCreatedAt is the schema descriptor for created_at field.
+	leadreindexjobDescCreatedAt := leadreindexjobFields[9].Descriptor()
+	// leadreindexjob.DefaultCreatedAt holds the default value on creation for the created_at field.
+	leadreindexjob.DefaultCreatedAt = leadreindexjobDescCreatedAt.Default.(func() time.Time)
+	// leadreindexjobDescUpdatedAt is the schema descriptor for updated_at field.
+	leadreindexjobDescUpdatedAt := leadreindexjobFields[10].Descriptor()
+	// leadreindexjob.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	leadreindexjob.DefaultUpdatedAt = leadreindexjobDescUpdatedAt.Default.(func() time.Time)
+	// leadreindexjob.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	leadreindexjob.UpdateDefaultUpdatedAt = leadreindexjobDescUpdatedAt.UpdateDefault.(func() time.Time)
 	leadstatushistoryFields := schema.LeadStatusHistory{}.Fields()
 	_ = leadstatushistoryFields
 	// leadstatushistoryDescLeadID is the schema descriptor for lead_id field.
@@ -943,6 +1359,46 @@ func init() {
 	leadstatushistoryDescCreatedAt := leadstatushistoryFields[5].Descriptor()
 	// leadstatushistory.DefaultCreatedAt holds the default value on creation for the created_at field.
 	leadstatushistory.DefaultCreatedAt = leadstatushistoryDescCreatedAt.Default.(func() time.Time)
+	leadsuppressionFields := schema.LeadSuppression{}.Fields()
+	_ = leadsuppressionFields
+	// leadsuppressionDescLeadID is the schema descriptor for lead_id field.
+	leadsuppressionDescLeadID := leadsuppressionFields[0].Descriptor()
+	// leadsuppression.LeadIDValidator is a validator for the "lead_id" field. It is called by the builders before save.
+	leadsuppression.LeadIDValidator = leadsuppressionDescLeadID.Validators[0].(func(int) error)
+	// leadsuppressionDescUserID is the schema descriptor for user_id field.
+	leadsuppressionDescUserID := leadsuppressionFields[1].Descriptor()
+	// leadsuppression.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	leadsuppression.UserIDValidator = leadsuppressionDescUserID.Validators[0].(func(int) error)
+	// leadsuppressionDescReason is the schema descriptor for reason field.
+	leadsuppressionDescReason := leadsuppressionFields[3].Descriptor()
+	// leadsuppression.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	leadsuppression.ReasonValidator = leadsuppressionDescReason.Validators[0].(func(string) error)
+	// leadsuppressionDescCreatedAt is the schema descriptor for created_at field.
+	leadsuppressionDescCreatedAt := leadsuppressionFields[4].Descriptor()
+	// leadsuppression.DefaultCreatedAt holds the default value on creation for the created_at field.
+	leadsuppression.DefaultCreatedAt = leadsuppressionDescCreatedAt.Default.(func() time.Time)
+	leadverificationFields := schema.LeadVerification{}.Fields()
+	_ = leadverificationFields
+	// leadverificationDescLeadID is the schema descriptor for lead_id field.
+	leadverificationDescLeadID := leadverificationFields[0].Descriptor()
+	// leadverification.LeadIDValidator is a validator for the "lead_id" field. It is called by the builders before save.
+	leadverification.LeadIDValidator = leadverificationDescLeadID.Validators[0].(func(int) error)
+	// leadverificationDescUserID is the schema descriptor for user_id field.
+	leadverificationDescUserID := leadverificationFields[1].Descriptor()
+	// leadverification.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	leadverification.UserIDValidator = leadverificationDescUserID.Validators[0].(func(int) error)
+	// leadverificationDescNote is the schema descriptor for note field.
+	leadverificationDescNote := leadverificationFields[4].Descriptor()
+	// leadverification.NoteValidator is a validator for the "note" field. It is called by the builders before save.
+	leadverification.NoteValidator = leadverificationDescNote.Validators[0].(func(string) error)
+	// leadverificationDescReason is the schema descriptor for reason field.
+	leadverificationDescReason := leadverificationFields[5].Descriptor()
+	// leadverification.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	leadverification.ReasonValidator = leadverificationDescReason.Validators[0].(func(string) error)
+	// leadverificationDescCreatedAt is the schema descriptor for created_at field.
+	leadverificationDescCreatedAt := leadverificationFields[7].Descriptor()
+	// leadverification.DefaultCreatedAt holds the default value on creation for the created_at field.
+	leadverification.DefaultCreatedAt = leadverificationDescCreatedAt.Default.(func() time.Time)
 	marketreportFields := schema.MarketReport{}.Fields()
 	_ = marketreportFields
 	// marketreportDescTitle is the schema descriptor for title field.
@@ -1023,18 +1479,22 @@ func init() {
 	organizationDescActive := organizationFields[9].Descriptor()
 	// organization.DefaultActive holds the default value on creation for the active field.
 	organization.DefaultActive = organizationDescActive.Default.(bool)
+	// organizationDescMaskUnassignedContacts is the schema descriptor for mask_unassigned_contacts field.
+	organizationDescMaskUnassignedContacts := organizationFields[10].Descriptor()
+	// organization.DefaultMaskUnassignedContacts holds the default value on creation for the mask_unassigned_contacts field.
+	organization.DefaultMaskUnassignedContacts = organizationDescMaskUnassignedContacts.Default.(bool)
 	// organizationDescCreatedAt is the schema descriptor for created_at field.
-	organizationDescCreatedAt := organizationFields[10].Descriptor()
+	organizationDescCreatedAt := organizationFields[11].Descriptor()
 	// organization.DefaultCreatedAt holds the default value on creation for the created_at field.
 	organization.DefaultCreatedAt = organizationDescCreatedAt.Default.(func() time.Time)
 	// organizationDescUpdatedAt is the schema descriptor for updated_at field.
-	organizationDescUpdatedAt := organizationFields[11].Descriptor()
+	organizationDescUpdatedAt := organizationFields[12].Descriptor()
 	// organization.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	organization.DefaultUpdatedAt = organizationDescUpdatedAt.Default.(func() time.Time)
 	// organization.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	organization.UpdateDefaultUpdatedAt = organizationDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// organizationDescSamlEnabled is the schema descriptor for saml_enabled field.
-	organizationDescSamlEnabled := organizationFields[12].Descriptor()
+	organizationDescSamlEnabled := organizationFields[13].Descriptor()
 	// organization.DefaultSamlEnabled holds the default value on creation for the saml_enabled field.
 	organization.DefaultSamlEnabled = organizationDescSamlEnabled.Default.(bool)
 	organizationmemberFields := schema.OrganizationMember{}.Fields()
@@ -1195,16 +1655,110 @@ func init() {
 			return nil
 		}
 	}()
+	// savedsearchDescTrackChanges is the schema descriptor for track_changes field.
+	savedsearchDescTrackChanges := savedsearchFields[3].Descriptor()
+	// savedsearch.DefaultTrackChanges holds the default value on creation for the track_changes field.
+	savedsearch.DefaultTrackChanges = savedsearchDescTrackChanges.Default.(bool)
 	// savedsearchDescCreatedAt is the schema descriptor for created_at field.
-	savedsearchDescCreatedAt := savedsearchFields[3].Descriptor()
+	savedsearchDescCreatedAt := savedsearchFields[4].Descriptor()
 	// savedsearch.DefaultCreatedAt holds the default value on creation for the created_at field.
 	savedsearch.DefaultCreatedAt = savedsearchDescCreatedAt.Default.(func() time.Time)
 	// savedsearchDescUpdatedAt is the schema descriptor for updated_at field.
-	savedsearchDescUpdatedAt := savedsearchFields[4].Descriptor()
+	savedsearchDescUpdatedAt := savedsearchFields[5].Descriptor()
 	// savedsearch.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	savedsearch.DefaultUpdatedAt = savedsearchDescUpdatedAt.Default.(func() time.Time)
 	// savedsearch.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	savedsearch.UpdateDefaultUpdatedAt = savedsearchDescUpdatedAt.UpdateDefault.(func() time.Time)
+	savedsearchsnapshotFields := schema.SavedSearchSnapshot{}.Fields()
+	_ = savedsearchsnapshotFields
+	// savedsearchsnapshotDescLeadCount is the schema descriptor for lead_count field.
+	savedsearchsnapshotDescLeadCount := savedsearchsnapshotFields[2].Descriptor()
+	// savedsearchsnapshot.LeadCountValidator is a validator for the "lead_count" field. It is called by the builders before save.
+	savedsearchsnapshot.LeadCountValidator = savedsearchsnapshotDescLeadCount.Validators[0].(func(int) error)
+	// savedsearchsnapshotDescTruncated is the schema descriptor for truncated field.
+	savedsearchsnapshotDescTruncated := savedsearchsnapshotFields[3].Descriptor()
+	// savedsearchsnapshot.DefaultTruncated holds the default value on creation for the truncated field.
+	savedsearchsnapshot.DefaultTruncated = savedsearchsnapshotDescTruncated.Default.(bool)
+	// savedsearchsnapshotDescCreatedAt is the schema descriptor for created_at field.
+	savedsearchsnapshotDescCreatedAt := savedsearchsnapshotFields[4].Descriptor()
+	// savedsearchsnapshot.DefaultCreatedAt holds the default value on creation for the created_at field.
+	savedsearchsnapshot.DefaultCreatedAt = savedsearchsnapshotDescCreatedAt.Default.(func() time.Time)
+	scheduledexportFields := schema.ScheduledExport{}.Fields()
+	_ = scheduledexportFields
+	// scheduledexportDescUserID is the schema descriptor for user_id field.
+	scheduledexportDescUserID := scheduledexportFields[0].Descriptor()
+	// scheduledexport.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	scheduledexport.UserIDValidator = scheduledexportDescUserID.Validators[0].(func(int) error)
+	// scheduledexportDescName is the schema descriptor for name field.
+	scheduledexportDescName := scheduledexportFields[2].Descriptor()
+	// scheduledexport.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	scheduledexport.NameValidator = func() func(string) error {
+		validators := scheduledexportDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// scheduledexportDescMaxLeads is the schema descriptor for max_leads field.
+	scheduledexportDescMaxLeads := scheduledexportFields[7].Descriptor()
+	// scheduledexport.DefaultMaxLeads holds the default value on creation for the max_leads field.
+	scheduledexport.DefaultMaxLeads = scheduledexportDescMaxLeads.Default.(int)
+	// scheduledexport.MaxLeadsValidator is a validator for the "max_leads" field. It is called by the builders before save.
+	scheduledexport.MaxLeadsValidator = scheduledexportDescMaxLeads.Validators[0].(func(int) error)
+	// scheduledexportDescSinceLastExport is the schema descriptor for since_last_export field.
+	scheduledexportDescSinceLastExport := scheduledexportFields[8].Descriptor()
+	// scheduledexport.DefaultSinceLastExport holds the default value on creation for the since_last_export field.
+	scheduledexport.DefaultSinceLastExport = scheduledexportDescSinceLastExport.Default.(bool)
+	// scheduledexportDescCadence is the schema descriptor for cadence field.
+	scheduledexportDescCadence := scheduledexportFields[9].Descriptor()
+	// scheduledexport.CadenceValidator is a validator for the "cadence" field. It is called by the builders before save.
+	scheduledexport.CadenceValidator = func() func(string) error {
+		validators := scheduledexportDescCadence.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(cadence string) error {
+			for _, fn := range fns {
+				if err := fn(cadence); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// scheduledexportDescDeliveryURL is the schema descriptor for delivery_url field.
+	scheduledexportDescDeliveryURL := scheduledexportFields[11].Descriptor()
+	// scheduledexport.DeliveryURLValidator is a validator for the "delivery_url" field. It is called by the builders before save.
+	scheduledexport.DeliveryURLValidator = scheduledexportDescDeliveryURL.Validators[0].(func(string) error)
+	// scheduledexportDescLastError is the schema descriptor for last_error field.
+	scheduledexportDescLastError := scheduledexportFields[17].Descriptor()
+	// scheduledexport.LastErrorValidator is a validator for the "last_error" field. It is called by the builders before save.
+	scheduledexport.LastErrorValidator = scheduledexportDescLastError.Validators[0].(func(string) error)
+	// scheduledexportDescRunCount is the schema descriptor for run_count field.
+	scheduledexportDescRunCount := scheduledexportFields[18].Descriptor()
+	// scheduledexport.DefaultRunCount holds the default value on creation for the run_count field.
+	scheduledexport.DefaultRunCount = scheduledexportDescRunCount.Default.(int)
+	// scheduledexport.RunCountValidator is a validator for the "run_count" field. It is called by the builders before save.
+	scheduledexport.RunCountValidator = scheduledexportDescRunCount.Validators[0].(func(int) error)
+	// scheduledexportDescCreatedAt is the schema descriptor for created_at field.
+	scheduledexportDescCreatedAt := scheduledexportFields[19].Descriptor()
+	// scheduledexport.DefaultCreatedAt holds the default value on creation for the created_at field.
+	scheduledexport.DefaultCreatedAt = scheduledexportDescCreatedAt.Default.(func() time.Time)
+	// scheduledexportDescUpdatedAt is the schema descriptor for updated_at field.
+	scheduledexportDescUpdatedAt := scheduledexportFields[20].Descriptor()
+	// scheduledexport.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	scheduledexport.DefaultUpdatedAt = scheduledexportDescUpdatedAt.Default.(func() time.Time)
+	// scheduledexport.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	scheduledexport.UpdateDefaultUpdatedAt = scheduledexportDescUpdatedAt.UpdateDefault.(func() time.Time)
 	subscriptionFields := schema.Subscription{}.Fields()
 	_ = subscriptionFields
 	// subscriptionDescUserID is the schema descriptor for user_id field.
@@ -1212,15 +1766,15 @@ func init() {
 	// subscription.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
 	subscription.UserIDValidator = subscriptionDescUserID.Validators[0].(func(int) error)
 	// subscriptionDescCancelAtPeriodEnd is the schema descriptor for cancel_at_period_end field.
-	subscriptionDescCancelAtPeriodEnd := subscriptionFields[7].Descriptor()
+	subscriptionDescCancelAtPeriodEnd := subscriptionFields[8].Descriptor()
 	// subscription.DefaultCancelAtPeriodEnd holds the default value on creation for the cancel_at_period_end field.
 	subscription.DefaultCancelAtPeriodEnd = subscriptionDescCancelAtPeriodEnd.Default.(bool)
 	// subscriptionDescCreatedAt is the schema descriptor for created_at field.
-	subscriptionDescCreatedAt := subscriptionFields[9].Descriptor()
+	subscriptionDescCreatedAt := subscriptionFields[10].Descriptor()
 	// subscription.DefaultCreatedAt holds the default value on creation for the created_at field.
 	subscription.DefaultCreatedAt = subscriptionDescCreatedAt.Default.(func() time.Time)
 	// subscriptionDescUpdatedAt is the schema descriptor for updated_at field.
-	subscriptionDescUpdatedAt := subscriptionFields[10].Descriptor()
+	subscriptionDescUpdatedAt := subscriptionFields[11].Descriptor()
 	// subscription.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	subscription.DefaultUpdatedAt = subscriptionDescUpdatedAt.Default.(func() time.Time)
 	// subscription.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -1281,6 +1835,26 @@ func init() {
 	territorymemberDescAddedByUserID := territorymemberFields[4].Descriptor()
 	// territorymember.AddedByUserIDValidator is a validator for the "added_by_user_id" field. It is called by the builders before save.
 	territorymember.AddedByUserIDValidator = territorymemberDescAddedByUserID.Validators[0].(func(int) error)
+	usagedailyaggregateFields := schema.UsageDailyAggregate{}.Fields()
+	_ = usagedailyaggregateFields
+	// usagedailyaggregateDescEvents is the schema descriptor for events field.
+	usagedailyaggregateDescEvents := usagedailyaggregateFields[3].Descriptor()
+	// usagedailyaggregate.DefaultEvents holds the default value on creation for the events field.
+	usagedailyaggregate.DefaultEvents = usagedailyaggregateDescEvents.Default.(int)
+	// usagedailyaggregate.EventsValidator is a validator for the "events" field. It is called by the builders before save.
+	usagedailyaggregate.EventsValidator = usagedailyaggregateDescEvents.Validators[0].(func(int) error)
+	// usagedailyaggregateDescCount is the schema descriptor for count field.
+	usagedailyaggregateDescCount := usagedailyaggregateFields[4].Descriptor()
+	// usagedailyaggregate.DefaultCount holds the default value on creation for the count field.
+	usagedailyaggregate.DefaultCount = usagedailyaggregateDescCount.Default.(int)
+	// usagedailyaggregate.CountValidator is a validator for the "count" field. It is called by the builders before save.
+	usagedailyaggregate.CountValidator = usagedailyaggregateDescCount.Validators[0].(func(int) error)
+	// usagedailyaggregateDescUpdatedAt is the schema descriptor for updated_at field.
+	usagedailyaggregateDescUpdatedAt := usagedailyaggregateFields[5].Descriptor()
+	// usagedailyaggregate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	usagedailyaggregate.DefaultUpdatedAt = usagedailyaggregateDescUpdatedAt.Default.(func() time.Time)
+	// usagedailyaggregate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	usagedailyaggregate.UpdateDefaultUpdatedAt = usagedailyaggregateDescUpdatedAt.UpdateDefault.(func() time.Time)
 	usagelogFields := schema.UsageLog{}.Fields()
 	_ = usagelogFields
 	// usagelogDescCount is the schema descriptor for count field.
@@ -1367,6 +1941,32 @@ func init() {
 	userbehaviorDescCreatedAt := userbehaviorFields[7].Descriptor()
 	// userbehavior.DefaultCreatedAt holds the default value on creation for the created_at field.
 	userbehavior.DefaultCreatedAt = userbehaviorDescCreatedAt.Default.(func() time.Time)
+	usernotificationpreferenceFields := schema.UserNotificationPreference{}.Fields()
+	_ = usernotificationpreferenceFields
+	// usernotificationpreferenceDescEventType is the schema descriptor for event_type field.
+	usernotificationpreferenceDescEventType := usernotificationpreferenceFields[1].Descriptor()
+	// usernotificationpreference.EventTypeValidator is a validator for the "event_type" field. It is called by the builders before save.
+	usernotificationpreference.EventTypeValidator = func() func(string) error {
+		validators := usernotificationpreferenceDescEventType.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(event_type string) error {
+			for _, fn := range fns {
+				if err := fn(event_type); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// usernotificationpreferenceDescUpdatedAt is the schema descriptor for updated_at field.
+	usernotificationpreferenceDescUpdatedAt := usernotificationpreferenceFields[4].Descriptor()
+	// usernotificationpreference.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	usernotificationpreference.DefaultUpdatedAt = usernotificationpreferenceDescUpdatedAt.Default.(func() time.Time)
+	// usernotificationpreference.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	usernotificationpreference.UpdateDefaultUpdatedAt = usernotificationpreferenceDescUpdatedAt.UpdateDefault.(func() time.Time)
 	webhookFields := schema.Webhook{}.Fields()
 	_ = webhookFields
 	// webhookDescURL is the schema descriptor for url field.
@@ -1374,29 +1974,87 @@ func init() {
 	// webhook.URLValidator is a validator for the "url" field. It is called by the builders before save.
 	webhook.URLValidator = webhookDescURL.Validators[0].(func(string) error)
 	// webhookDescActive is the schema descriptor for active field.
-	webhookDescActive := webhookFields[3].Descriptor()
+	webhookDescActive := webhookFields[4].Descriptor()
 	// webhook.DefaultActive holds the default value on creation for the active field.
 	webhook.DefaultActive = webhookDescActive.Default.(bool)
+	// webhookDescSchemaVersion is the schema descriptor for schema_version field.
+	webhookDescSchemaVersion := webhookFields[7].Descriptor()
+	// webhook.DefaultSchemaVersion holds the default value on creation for the schema_version field.
+	webhook.DefaultSchemaVersion = webhookDescSchemaVersion.Default.(string)
+	// webhookDescDeliveryStartHour is the schema descriptor for delivery_start_hour field.
+	webhookDescDeliveryStartHour := webhookFields[10].Descriptor()
+	// webhook.DeliveryStartHourValidator is a validator for the "delivery_start_hour" field. It is called by the builders before save.
+	webhook.DeliveryStartHourValidator = webhookDescDeliveryStartHour.Validators[0].(func(int) error)
+	// webhookDescDeliveryEndHour is the schema descriptor for delivery_end_hour field.
+	webhookDescDeliveryEndHour := webhookFields[11].Descriptor()
+	// webhook.DeliveryEndHourValidator is a validator for the "delivery_end_hour" field. It is called by the builders before save.
+	webhook.DeliveryEndHourValidator = webhookDescDeliveryEndHour.Validators[0].(func(int) error)
+	// webhookDescBatchMaxEvents is the schema descriptor for batch_max_events field.
+	webhookDescBatchMaxEvents := webhookFields[12].Descriptor()
+	// webhook.BatchMaxEventsValidator is a validator for the "batch_max_events" field. It is called by the builders before save.
+	webhook.BatchMaxEventsValidator = webhookDescBatchMaxEvents.Validators[0].(func(int) error)
+	// webhookDescBatchWindowSeconds is the schema descriptor for batch_window_seconds field.
+	webhookDescBatchWindowSeconds := webhookFields[13].Descriptor()
+	// webhook.BatchWindowSecondsValidator is a validator for the "batch_window_seconds" field. It is called by the builders before save.
+	webhook.BatchWindowSecondsValidator = webhookDescBatchWindowSeconds.Validators[0].(func(int) error)
 	// webhookDescRetryCount is the schema descriptor for retry_count field.
-	webhookDescRetryCount := webhookFields[5].Descriptor()
+	webhookDescRetryCount := webhookFields[16].Descriptor()
 	// webhook.DefaultRetryCount holds the default value on creation for the retry_count field.
 	webhook.DefaultRetryCount = webhookDescRetryCount.Default.(int)
 	// webhookDescSuccessCount is the schema descriptor for success_count field.
-	webhookDescSuccessCount := webhookFields[7].Descriptor()
+	webhookDescSuccessCount := webhookFields[18].Descriptor()
 	// webhook.DefaultSuccessCount holds the default value on creation for the success_count field.
 	webhook.DefaultSuccessCount = webhookDescSuccessCount.Default.(int)
 	// webhookDescFailureCount is the schema descriptor for failure_count field.
-	webhookDescFailureCount := webhookFields[8].Descriptor()
+	webhookDescFailureCount := webhookFields[19].Descriptor()
 	// webhook.DefaultFailureCount holds the default value on creation for the failure_count field.
 	webhook.DefaultFailureCount = webhookDescFailureCount.Default.(int)
 	// webhookDescCreatedAt is the schema descriptor for created_at field.
-	webhookDescCreatedAt := webhookFields[9].Descriptor()
+	webhookDescCreatedAt := webhookFields[20].Descriptor()
 	// webhook.DefaultCreatedAt holds the default value on creation for the created_at field.
 	webhook.DefaultCreatedAt = webhookDescCreatedAt.Default.(func() time.Time)
 	// webhookDescUpdatedAt is the schema descriptor for updated_at field.
-	webhookDescUpdatedAt := webhookFields[10].Descriptor()
+	webhookDescUpdatedAt := webhookFields[21].Descriptor()
 	// webhook.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	webhook.DefaultUpdatedAt = webhookDescUpdatedAt.Default.(func() time.Time)
 	// webhook.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	webhook.UpdateDefaultUpdatedAt = webhookDescUpdatedAt.UpdateDefault.(func() time.Time)
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescWebhookID is the schema descriptor for webhook_id field.
+	webhookdeliveryDescWebhookID := webhookdeliveryFields[0].Descriptor()
+	// webhookdelivery.WebhookIDValidator is a validator for the "webhook_id" field. It is called by the builders before save.
+	webhookdelivery.WebhookIDValidator = webhookdeliveryDescWebhookID.Validators[0].(func(int) error)
+	// webhookdeliveryDescEvent is the schema descriptor for event field.
+	webhookdeliveryDescEvent := webhookdeliveryFields[2].Descriptor()
+	// webhookdelivery.EventValidator is a validator for the "event" field. It is called by the builders before save.
+	webhookdelivery.EventValidator = webhookdeliveryDescEvent.Validators[0].(func(string) error)
+	// webhookdeliveryDescReplayCount is the schema descriptor for replay_count field.
+	webhookdeliveryDescReplayCount := webhookdeliveryFields[8].Descriptor()
+	// webhookdelivery.DefaultReplayCount holds the default value on creation for the replay_count field.
+	webhookdelivery.DefaultReplayCount = webhookdeliveryDescReplayCount.Default.(int)
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryFields[10].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
+	// webhookdeliveryDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookdeliveryDescUpdatedAt := webhookdeliveryFields[11].Descriptor()
+	// webhookdelivery.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhookdelivery.DefaultUpdatedAt = webhookdeliveryDescUpdatedAt.Default.(func() time.Time)
+	// webhookdelivery.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhookdelivery.UpdateDefaultUpdatedAt = webhookdeliveryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	zapierdeliveryFields := schema.ZapierDelivery{}.Fields()
+	_ = zapierdeliveryFields
+	// zapierdeliveryDescAPIKeyID is the schema descriptor for api_key_id field.
+	zapierdeliveryDescAPIKeyID := zapierdeliveryFields[0].Descriptor()
+	// zapierdelivery.APIKeyIDValidator is a validator for the "api_key_id" field. It is called by the builders before save.
+	zapierdelivery.APIKeyIDValidator = zapierdeliveryDescAPIKeyID.Validators[0].(func(int) error)
+	// zapierdeliveryDescLeadID is the schema descriptor for lead_id field.
+	zapierdeliveryDescLeadID := zapierdeliveryFields[1].Descriptor()
+	// zapierdelivery.LeadIDValidator is a validator for the "lead_id" field. It is called by the builders before save.
+	zapierdelivery.LeadIDValidator = zapierdeliveryDescLeadID.Validators[0].(func(int) error)
+	// zapierdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	zapierdeliveryDescCreatedAt := zapierdeliveryFields[2].Descriptor()
+	// zapierdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	zapierdelivery.DefaultCreatedAt = zapierdeliveryDescCreatedAt.Default.(func() time.Time)
 }
@@ -25,6 +25,8 @@ type SavedSearch struct {
 	Name string `json:"name,omitempty"`
 	// Search filters (industry, country, city, etc.)
 	Filters map[string]interface{} `json:"filters,omitempty"`
+	// Snapshot results on each run to report added and removed leads
+	TrackChanges bool `json:"track_changes,omitempty"`
 	// When this search was saved
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Last update timestamp
@@ -39,9 +41,15 @@ type SavedSearch struct {
 type SavedSearchEdges struct {
 	// User who owns this saved search
 	User *User `json:"user,omitempty"`
+	// Result snapshots of past runs, when tracking changes
+	Snapshots []*SavedSearchSnapshot `json:"snapshots,omitempty"`
+	// Export templates selecting leads with this search
+	ExportTemplates []*ExportTemplate `json:"export_templates,omitempty"`
+	// Scheduled exports selecting leads with this search
+	ScheduledExports []*ScheduledExport `json:"scheduled_exports,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [4]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -55,6 +63,33 @@ func (e SavedSearchEdges) UserOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "user"}
 }
 
+// SnapshotsOrErr returns the Snapshots value or an error if the edge
+// was not loaded in eager-loading.
+func (e SavedSearchEdges) SnapshotsOrErr() ([]*SavedSearchSnapshot, error) {
+	if e.loadedTypes[1] {
+		return e.Snapshots, nil
+	}
+	return nil, &NotLoadedError{edge: "snapshots"}
+}
+
+// ExportTemplatesOrErr returns the ExportTemplates value or an error if the edge
+// was not loaded in eager-loading.
+func (e SavedSearchEdges) ExportTemplatesOrErr() ([]*ExportTemplate, error) {
+	if e.loadedTypes[2] {
+		return e.ExportTemplates, nil
+	}
+	return nil, &NotLoadedError{edge: "export_templates"}
+}
+
+// ScheduledExportsOrErr returns the ScheduledExports value or an error if the edge
+// was not loaded in eager-loading.
+func (e SavedSearchEdges) ScheduledExportsOrErr() ([]*ScheduledExport, error) {
+	if e.loadedTypes[3] {
+		return e.ScheduledExports, nil
+	}
+	return nil, &NotLoadedError{edge: "scheduled_exports"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*SavedSearch) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -62,6 +97,8 @@ func (*SavedSearch) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case savedsearch.FieldFilters:
 			values[i] = new([]byte)
+		case savedsearch.FieldTrackChanges:
+			values[i] = new(sql.NullBool)
 		case savedsearch.FieldID, savedsearch.FieldUserID:
 			values[i] = new(sql.NullInt64)
 		case savedsearch.FieldName:
@@ -109,6 +146,12 @@ func (_m *SavedSearch) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field filters: %w", err)
 				}
 			}
+		case savedsearch.FieldTrackChanges:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field track_changes", values[i])
+			} else if value.Valid {
+				_m.TrackChanges = value.Bool
+			}
 		case savedsearch.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -139,6 +182,21 @@ func (_m *SavedSearch) QueryUser() *UserQuery {
 	return NewSavedSearchClient(_m.config).QueryUser(_m)
 }
 
+// QuerySnapshots queries the "snapshots" edge of the SavedSearch entity.
+func (_m *SavedSearch) QuerySnapshots() *SavedSearchSnapshotQuery {
+	return NewSavedSearchClient(_m.config).QuerySnapshots(_m)
+}
+
+// QueryExportTemplates queries the "export_templates" edge of the SavedSearch entity.
+func (_m *SavedSearch) QueryExportTemplates() *ExportTemplateQuery {
+	return NewSavedSearchClient(_m.config).QueryExportTemplates(_m)
+}
+
+// QueryScheduledExports queries the "scheduled_exports" edge of the SavedSearch entity.
+func (_m *SavedSearch) QueryScheduledExports() *ScheduledExportQuery {
+	return NewSavedSearchClient(_m.config).QueryScheduledExports(_m)
+}
+
 // Update returns a builder for updating this SavedSearch.
 // Note that you need to call SavedSearch.Unwrap() before calling this method if this SavedSearch
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -171,6 +229,9 @@ func (_m *SavedSearch) String() string {
 	builder.WriteString("filters=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Filters))
 	builder.WriteString(", ")
+	builder.WriteString("track_changes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TrackChanges))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
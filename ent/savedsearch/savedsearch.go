@@ -20,12 +20,20 @@ const (
 	FieldName = "name"
 	// FieldFilters holds the string denoting the filters field in the database.
 	FieldFilters = "filters"
+	// FieldTrackChanges holds the string denoting the track_changes field in the database.
+	FieldTrackChanges = "track_changes"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
+	// EdgeSnapshots holds the string denoting the snapshots edge name in mutations.
+	EdgeSnapshots = "snapshots"
+	// EdgeExportTemplates holds the string denoting the export_templates edge name in mutations.
+	EdgeExportTemplates = "export_templates"
+	// EdgeScheduledExports holds the string denoting the scheduled_exports edge name in mutations.
+	EdgeScheduledExports = "scheduled_exports"
 	// Table holds the table name of the savedsearch in the database.
 	Table = "saved_searches"
 	// UserTable is the table that holds the user relation/edge.
@@ -35,6 +43,27 @@ const (
 	UserInverseTable = "users"
 	// UserColumn is the table column denoting the user relation/edge.
 	UserColumn = "user_id"
+	// SnapshotsTable is the table that holds the snapshots relation/edge.
+	SnapshotsTable = "saved_search_snapshots"
+	// SnapshotsInverseTable is the table name for the SavedSearchSnapshot entity.
+	// It exists in this package in order to avoid circular dependency with the "savedsearchsnapshot" package.
+	SnapshotsInverseTable = "saved_search_snapshots"
+	// SnapshotsColumn is the table column denoting the snapshots relation/edge.
+	SnapshotsColumn = "saved_search_id"
+	// ExportTemplatesTable is the table that holds the export_templates relation/edge.
+	ExportTemplatesTable = "export_templates"
+	// ExportTemplatesInverseTable is the table name for the ExportTemplate entity.
+	// It exists in this package in order to avoid circular dependency with the "exporttemplate" package.
+	ExportTemplatesInverseTable = "export_templates"
+	// ExportTemplatesColumn is the table column denoting the export_templates relation/edge.
+	ExportTemplatesColumn = "saved_search_id"
+	// ScheduledExportsTable is the table that holds the scheduled_exports relation/edge.
+	ScheduledExportsTable = "scheduled_exports"
+	// ScheduledExportsInverseTable is the table name for the ScheduledExport entity.
+	// It exists in this package in order to avoid circular dependency with the "scheduledexport" package.
+	ScheduledExportsInverseTable = "scheduled_exports"
+	// ScheduledExportsColumn is the table column denoting the scheduled_exports relation/edge.
+	ScheduledExportsColumn = "saved_search_id"
 )
 
 // Columns holds all SQL columns for savedsearch fields.
@@ -43,6 +72,7 @@ var Columns = []string{
 	FieldUserID,
 	FieldName,
 	FieldFilters,
+	FieldTrackChanges,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -60,6 +90,8 @@ func ValidColumn(column string) bool {
 var (
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
+	// DefaultTrackChanges holds the default value on creation for the "track_changes" field.
+	DefaultTrackChanges bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -86,6 +118,11 @@ func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
 }
 
+// ByTrackChanges orders the results by the track_changes field.
+func ByTrackChanges(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrackChanges, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -102,6 +139,48 @@ func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// BySnapshotsCount orders the results by snapshots count.
+func BySnapshotsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSnapshotsStep(), opts...)
+	}
+}
+
+// BySnapshots orders the results by snapshots terms.
+func BySnapshots(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSnapshotsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByExportTemplatesCount orders the results by export_templates count.
+func ByExportTemplatesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newExportTemplatesStep(), opts...)
+	}
+}
+
+// ByExportTemplates orders the results by export_templates terms.
+func ByExportTemplates(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newExportTemplatesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByScheduledExportsCount orders the results by scheduled_exports count.
+func ByScheduledExportsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newScheduledExportsStep(), opts...)
+	}
+}
+
+// ByScheduledExports orders the results by scheduled_exports terms.
+func ByScheduledExports(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newScheduledExportsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -109,3 +188,24 @@ func newUserStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
 	)
 }
+func newSnapshotsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SnapshotsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SnapshotsTable, SnapshotsColumn),
+	)
+}
+func newExportTemplatesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ExportTemplatesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ExportTemplatesTable, ExportTemplatesColumn),
+	)
+}
+func newScheduledExportsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ScheduledExportsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ScheduledExportsTable, ScheduledExportsColumn),
+	)
+}
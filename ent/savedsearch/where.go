@@ -65,6 +65,11 @@ func Name(v string) predicate.SavedSearch {
 	return predicate.SavedSearch(sql.FieldEQ(FieldName, v))
 }
 
+// TrackChanges applies equality check predicate on the "track_changes" field. It's identical to TrackChangesEQ.
+func TrackChanges(v bool) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldTrackChanges, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.SavedSearch {
 	return predicate.SavedSearch(sql.FieldEQ(FieldCreatedAt, v))
@@ -160,6 +165,16 @@ func NameContainsFold(v string) predicate.SavedSearch {
 	return predicate.SavedSearch(sql.FieldContainsFold(FieldName, v))
 }
 
+// TrackChangesEQ applies the EQ predicate on the "track_changes" field.
+func TrackChangesEQ(v bool) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldTrackChanges, v))
+}
+
+// TrackChangesNEQ applies the NEQ predicate on the "track_changes" field.
+func TrackChangesNEQ(v bool) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNEQ(FieldTrackChanges, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.SavedSearch {
 	return predicate.SavedSearch(sql.FieldEQ(FieldCreatedAt, v))
@@ -263,6 +278,75 @@ func HasUserWith(preds ...predicate.User) predicate.SavedSearch {
 	})
 }
 
+// HasSnapshots applies the HasEdge predicate on the "snapshots" edge.
+func HasSnapshots() predicate.SavedSearch {
+	return predicate.SavedSearch(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SnapshotsTable, SnapshotsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSnapshotsWith applies the HasEdge predicate on the "snapshots" edge with a given conditions (other predicates).
+func HasSnapshotsWith(preds ...predicate.SavedSearchSnapshot) predicate.SavedSearch {
+	return predicate.SavedSearch(func(s *sql.Selector) {
+		step := newSnapshotsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasExportTemplates applies the HasEdge predicate on the "export_templates" edge.
+func HasExportTemplates() predicate.SavedSearch {
+	return predicate.SavedSearch(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ExportTemplatesTable, ExportTemplatesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasExportTemplatesWith applies the HasEdge predicate on the "export_templates" edge with a given conditions (other predicates).
+func HasExportTemplatesWith(preds ...predicate.ExportTemplate) predicate.SavedSearch {
+	return predicate.SavedSearch(func(s *sql.Selector) {
+		step := newExportTemplatesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasScheduledExports applies the HasEdge predicate on the "scheduled_exports" edge.
+func HasScheduledExports() predicate.SavedSearch {
+	return predicate.SavedSearch(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ScheduledExportsTable, ScheduledExportsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasScheduledExportsWith applies the HasEdge predicate on the "scheduled_exports" edge with a given conditions (other predicates).
+func HasScheduledExportsWith(preds ...predicate.ScheduledExport) predicate.SavedSearch {
+	return predicate.SavedSearch(func(s *sql.Selector) {
+		step := newScheduledExportsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.SavedSearch) predicate.SavedSearch {
 	return predicate.SavedSearch(sql.AndPredicates(predicates...))
@@ -10,7 +10,10 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/exporttemplate"
 	"github.com/jordanlanch/industrydb/ent/savedsearch"
+	"github.com/jordanlanch/industrydb/ent/savedsearchsnapshot"
+	"github.com/jordanlanch/industrydb/ent/scheduledexport"
 	"github.com/jordanlanch/industrydb/ent/user"
 )
 
@@ -39,6 +42,20 @@ func (_c *SavedSearchCreate) SetFilters(v map[string]interface{}) *SavedSearchCr
 	return _c
 }
 
+// SetTrackChanges sets the "track_changes" field.
+func (_c *SavedSearchCreate) SetTrackChanges(v bool) *SavedSearchCreate {
+	_c.mutation.SetTrackChanges(v)
+	return _c
+}
+
+// SetNillableTrackChanges sets the "track_changes" field if the given value is not nil.
+func (_c *SavedSearchCreate) SetNillableTrackChanges(v *bool) *SavedSearchCreate {
+	if v != nil {
+		_c.SetTrackChanges(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *SavedSearchCreate) SetCreatedAt(v time.Time) *SavedSearchCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -72,6 +89,51 @@ func (_c *SavedSearchCreate) SetUser(v *User) *SavedSearchCreate {
 	return _c.SetUserID(v.ID)
 }
 
+// AddSnapshotIDs adds the "snapshots" edge to the SavedSearchSnapshot entity by IDs.
+func (_c *SavedSearchCreate) AddSnapshotIDs(ids ...int) *SavedSearchCreate {
+	_c.mutation.AddSnapshotIDs(ids...)
+	return _c
+}
+
+// AddSnapshots adds the "snapshots" edges to the SavedSearchSnapshot entity.
+func (_c *SavedSearchCreate) AddSnapshots(v ...*SavedSearchSnapshot) *SavedSearchCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSnapshotIDs(ids...)
+}
+
+// AddExportTemplateIDs adds the "export_templates" edge to the ExportTemplate entity by IDs.
+func (_c *SavedSearchCreate) AddExportTemplateIDs(ids ...int) *SavedSearchCreate {
+	_c.mutation.AddExportTemplateIDs(ids...)
+	return _c
+}
+
+// AddExportTemplates adds the "export_templates" edges to the ExportTemplate entity.
+func (_c *SavedSearchCreate) AddExportTemplates(v ...*ExportTemplate) *SavedSearchCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddExportTemplateIDs(ids...)
+}
+
+// AddScheduledExportIDs adds the "scheduled_exports" edge to the ScheduledExport entity by IDs.
+func (_c *SavedSearchCreate) AddScheduledExportIDs(ids ...int) *SavedSearchCreate {
+	_c.mutation.AddScheduledExportIDs(ids...)
+	return _c
+}
+
+// AddScheduledExports adds the "scheduled_exports" edges to the ScheduledExport entity.
+func (_c *SavedSearchCreate) AddScheduledExports(v ...*ScheduledExport) *SavedSearchCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddScheduledExportIDs(ids...)
+}
+
 // Mutation returns the SavedSearchMutation object of the builder.
 func (_c *SavedSearchCreate) Mutation() *SavedSearchMutation {
 	return _c.mutation
@@ -107,6 +169,10 @@ func (_c *SavedSearchCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *SavedSearchCreate) defaults() {
+	if _, ok := _c.mutation.TrackChanges(); !ok {
+		v := savedsearch.DefaultTrackChanges
+		_c.mutation.SetTrackChanges(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := savedsearch.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -133,6 +199,9 @@ func (_c *SavedSearchCreate) check() error {
 	if _, ok := _c.mutation.Filters(); !ok {
 		return &ValidationError{Name: "filters", err: errors.New(`ent: missing required field "SavedSearch.filters"`)}
 	}
+	if _, ok := _c.mutation.TrackChanges(); !ok {
+		return &ValidationError{Name: "track_changes", err: errors.New(`ent: missing required field "SavedSearch.track_changes"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SavedSearch.created_at"`)}
 	}
@@ -176,6 +245,10 @@ func (_c *SavedSearchCreate) createSpec() (*SavedSearch, *sqlgraph.CreateSpec) {
 		_spec.SetField(savedsearch.FieldFilters, field.TypeJSON, value)
 		_node.Filters = value
 	}
+	if value, ok := _c.mutation.TrackChanges(); ok {
+		_spec.SetField(savedsearch.FieldTrackChanges, field.TypeBool, value)
+		_node.TrackChanges = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(savedsearch.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -201,6 +274,54 @@ func (_c *SavedSearchCreate) createSpec() (*SavedSearch, *sqlgraph.CreateSpec) {
 		_node.UserID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.SnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   savedsearch.SnapshotsTable,
+			Columns: []string{savedsearch.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearchsnapshot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ExportTemplatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   savedsearch.ExportTemplatesTable,
+			Columns: []string{savedsearch.ExportTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exporttemplate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ScheduledExportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   savedsearch.ScheduledExportsTable,
+			Columns: []string{savedsearch.ScheduledExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledexport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -11,19 +12,26 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/exporttemplate"
 	"github.com/jordanlanch/industrydb/ent/predicate"
 	"github.com/jordanlanch/industrydb/ent/savedsearch"
+	"github.com/jordanlanch/industrydb/ent/savedsearchsnapshot"
+	"github.com/jordanlanch/industrydb/ent/scheduledexport"
 	"github.com/jordanlanch/industrydb/ent/user"
 )
 
 // SavedSearchQuery is the builder for querying SavedSearch entities.
 type SavedSearchQuery struct {
 	config
-	ctx        *QueryContext
-	order      []savedsearch.OrderOption
-	inters     []Interceptor
-	predicates []predicate.SavedSearch
-	withUser   *UserQuery
+	ctx                  *QueryContext
+	order                []savedsearch.OrderOption
+	inters               []Interceptor
+	predicates           []predicate.SavedSearch
+	withUser             *UserQuery
+	withSnapshots        *SavedSearchSnapshotQuery
+	withExportTemplates  *ExportTemplateQuery
+	withScheduledExports *ScheduledExportQuery
+	modifiers            []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -82,6 +90,72 @@ func (_q *SavedSearchQuery) QueryUser() *UserQuery {
 	return query
 }
 
+// QuerySnapshots chains the current query on the "snapshots" edge.
+func (_q *SavedSearchQuery) QuerySnapshots() *SavedSearchSnapshotQuery {
+	query := (&SavedSearchSnapshotClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(savedsearch.Table, savedsearch.FieldID, selector),
+			sqlgraph.To(savedsearchsnapshot.Table, savedsearchsnapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, savedsearch.SnapshotsTable, savedsearch.SnapshotsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryExportTemplates chains the current query on the "export_templates" edge.
+func (_q *SavedSearchQuery) QueryExportTemplates() *ExportTemplateQuery {
+	query := (&ExportTemplateClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(savedsearch.Table, savedsearch.FieldID, selector),
+			sqlgraph.To(exporttemplate.Table, exporttemplate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, savedsearch.ExportTemplatesTable, savedsearch.ExportTemplatesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryScheduledExports chains the current query on the "scheduled_exports" edge.
+func (_q *SavedSearchQuery) QueryScheduledExports() *ScheduledExportQuery {
+	query := (&ScheduledExportClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(savedsearch.Table, savedsearch.FieldID, selector),
+			sqlgraph.To(scheduledexport.Table, scheduledexport.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, savedsearch.ScheduledExportsTable, savedsearch.ScheduledExportsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first SavedSearch entity from the query.
 // Returns a *NotFoundError when no SavedSearch was found.
 func (_q *SavedSearchQuery) First(ctx context.Context) (*SavedSearch, error) {
@@ -269,15 +343,19 @@ func (_q *SavedSearchQuery) Clone() *SavedSearchQuery {
 		return nil
 	}
 	return &SavedSearchQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]savedsearch.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.SavedSearch{}, _q.predicates...),
-		withUser:   _q.withUser.Clone(),
+		config:               _q.config,
+		ctx:                  _q.ctx.Clone(),
+		order:                append([]savedsearch.OrderOption{}, _q.order...),
+		inters:               append([]Interceptor{}, _q.inters...),
+		predicates:           append([]predicate.SavedSearch{}, _q.predicates...),
+		withUser:             _q.withUser.Clone(),
+		withSnapshots:        _q.withSnapshots.Clone(),
+		withExportTemplates:  _q.withExportTemplates.Clone(),
+		withScheduledExports: _q.withScheduledExports.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -292,6 +370,39 @@ func (_q *SavedSearchQuery) WithUser(opts ...func(*UserQuery)) *SavedSearchQuery
 	return _q
 }
 
+// WithSnapshots tells the query-builder to eager-load the nodes that are connected to
+// the "snapshots" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *SavedSearchQuery) WithSnapshots(opts ...func(*SavedSearchSnapshotQuery)) *SavedSearchQuery {
+	query := (&SavedSearchSnapshotClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSnapshots = query
+	return _q
+}
+
+// WithExportTemplates tells the query-builder to eager-load the nodes that are connected to
+// the "export_templates" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *SavedSearchQuery) WithExportTemplates(opts ...func(*ExportTemplateQuery)) *SavedSearchQuery {
+	query := (&ExportTemplateClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withExportTemplates = query
+	return _q
+}
+
+// WithScheduledExports tells the query-builder to eager-load the nodes that are connected to
+// the "scheduled_exports" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *SavedSearchQuery) WithScheduledExports(opts ...func(*ScheduledExportQuery)) *SavedSearchQuery {
+	query := (&ScheduledExportClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withScheduledExports = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -370,8 +481,11 @@ func (_q *SavedSearchQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 	var (
 		nodes       = []*SavedSearch{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [4]bool{
 			_q.withUser != nil,
+			_q.withSnapshots != nil,
+			_q.withExportTemplates != nil,
+			_q.withScheduledExports != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -383,6 +497,9 @@ func (_q *SavedSearchQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -398,6 +515,29 @@ func (_q *SavedSearchQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 			return nil, err
 		}
 	}
+	if query := _q.withSnapshots; query != nil {
+		if err := _q.loadSnapshots(ctx, query, nodes,
+			func(n *SavedSearch) { n.Edges.Snapshots = []*SavedSearchSnapshot{} },
+			func(n *SavedSearch, e *SavedSearchSnapshot) { n.Edges.Snapshots = append(n.Edges.Snapshots, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withExportTemplates; query != nil {
+		if err := _q.loadExportTemplates(ctx, query, nodes,
+			func(n *SavedSearch) { n.Edges.ExportTemplates = []*ExportTemplate{} },
+			func(n *SavedSearch, e *ExportTemplate) { n.Edges.ExportTemplates = append(n.Edges.ExportTemplates, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withScheduledExports; query != nil {
+		if err := _q.loadScheduledExports(ctx, query, nodes,
+			func(n *SavedSearch) { n.Edges.ScheduledExports = []*ScheduledExport{} },
+			func(n *SavedSearch, e *ScheduledExport) {
+				n.Edges.ScheduledExports = append(n.Edges.ScheduledExports, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -430,9 +570,108 @@ func (_q *SavedSearchQuery) loadUser(ctx context.Context, query *UserQuery, node
 	}
 	return nil
 }
+func (_q *SavedSearchQuery) loadSnapshots(ctx context.Context, query *SavedSearchSnapshotQuery, nodes []*SavedSearch, init func(*SavedSearch), assign func(*SavedSearch, *SavedSearchSnapshot)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*SavedSearch)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(savedsearchsnapshot.FieldSavedSearchID)
+	}
+	query.Where(predicate.SavedSearchSnapshot(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(savedsearch.SnapshotsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SavedSearchID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "saved_search_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *SavedSearchQuery) loadExportTemplates(ctx context.Context, query *ExportTemplateQuery, nodes []*SavedSearch, init func(*SavedSearch), assign func(*SavedSearch, *ExportTemplate)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*SavedSearch)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(exporttemplate.FieldSavedSearchID)
+	}
+	query.Where(predicate.ExportTemplate(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(savedsearch.ExportTemplatesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SavedSearchID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "saved_search_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "saved_search_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *SavedSearchQuery) loadScheduledExports(ctx context.Context, query *ScheduledExportQuery, nodes []*SavedSearch, init func(*SavedSearch), assign func(*SavedSearch, *ScheduledExport)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*SavedSearch)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(scheduledexport.FieldSavedSearchID)
+	}
+	query.Where(predicate.ScheduledExport(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(savedsearch.ScheduledExportsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SavedSearchID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "saved_search_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "saved_search_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *SavedSearchQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -498,6 +737,9 @@ func (_q *SavedSearchQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -515,6 +757,12 @@ func (_q *SavedSearchQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *SavedSearchQuery) Modify(modifiers ...func(s *sql.Selector)) *SavedSearchSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // SavedSearchGroupBy is the group-by builder for SavedSearch entities.
 type SavedSearchGroupBy struct {
 	selector
@@ -604,3 +852,9 @@ func (_s *SavedSearchSelect) sqlScan(ctx context.Context, root *SavedSearchQuery
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *SavedSearchSelect) Modify(modifiers ...func(s *sql.Selector)) *SavedSearchSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
@@ -11,16 +11,20 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/exporttemplate"
 	"github.com/jordanlanch/industrydb/ent/predicate"
 	"github.com/jordanlanch/industrydb/ent/savedsearch"
+	"github.com/jordanlanch/industrydb/ent/savedsearchsnapshot"
+	"github.com/jordanlanch/industrydb/ent/scheduledexport"
 	"github.com/jordanlanch/industrydb/ent/user"
 )
 
 // SavedSearchUpdate is the builder for updating SavedSearch entities.
 type SavedSearchUpdate struct {
 	config
-	hooks    []Hook
-	mutation *SavedSearchMutation
+	hooks     []Hook
+	mutation  *SavedSearchMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the SavedSearchUpdate builder.
@@ -63,6 +67,20 @@ func (_u *SavedSearchUpdate) SetFilters(v map[string]interface{}) *SavedSearchUp
 	return _u
 }
 
+// SetTrackChanges sets the "track_changes" field.
+func (_u *SavedSearchUpdate) SetTrackChanges(v bool) *SavedSearchUpdate {
+	_u.mutation.SetTrackChanges(v)
+	return _u
+}
+
+// SetNillableTrackChanges sets the "track_changes" field if the given value is not nil.
+func (_u *SavedSearchUpdate) SetNillableTrackChanges(v *bool) *SavedSearchUpdate {
+	if v != nil {
+		_u.SetTrackChanges(*v)
+	}
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *SavedSearchUpdate) SetUpdatedAt(v time.Time) *SavedSearchUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -74,6 +92,51 @@ func (_u *SavedSearchUpdate) SetUser(v *User) *SavedSearchUpdate {
 	return _u.SetUserID(v.ID)
 }
 
+// AddSnapshotIDs adds the "snapshots" edge to the SavedSearchSnapshot entity by IDs.
+func (_u *SavedSearchUpdate) AddSnapshotIDs(ids ...int) *SavedSearchUpdate {
+	_u.mutation.AddSnapshotIDs(ids...)
+	return _u
+}
+
+// AddSnapshots adds the "snapshots" edges to the SavedSearchSnapshot entity.
+func (_u *SavedSearchUpdate) AddSnapshots(v ...*SavedSearchSnapshot) *SavedSearchUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSnapshotIDs(ids...)
+}
+
+// AddExportTemplateIDs adds the "export_templates" edge to the ExportTemplate entity by IDs.
+func (_u *SavedSearchUpdate) AddExportTemplateIDs(ids ...int) *SavedSearchUpdate {
+	_u.mutation.AddExportTemplateIDs(ids...)
+	return _u
+}
+
+// AddExportTemplates adds the "export_templates" edges to the ExportTemplate entity.
+func (_u *SavedSearchUpdate) AddExportTemplates(v ...*ExportTemplate) *SavedSearchUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddExportTemplateIDs(ids...)
+}
+
+// AddScheduledExportIDs adds the "scheduled_exports" edge to the ScheduledExport entity by IDs.
+func (_u *SavedSearchUpdate) AddScheduledExportIDs(ids ...int) *SavedSearchUpdate {
+	_u.mutation.AddScheduledExportIDs(ids...)
+	return _u
+}
+
+// AddScheduledExports adds the "scheduled_exports" edges to the ScheduledExport entity.
+func (_u *SavedSearchUpdate) AddScheduledExports(v ...*ScheduledExport) *SavedSearchUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddScheduledExportIDs(ids...)
+}
+
 // Mutation returns the SavedSearchMutation object of the builder.
 func (_u *SavedSearchUpdate) Mutation() *SavedSearchMutation {
 	return _u.mutation
@@ -85,6 +148,69 @@ func (_u *SavedSearchUpdate) ClearUser() *SavedSearchUpdate {
 	return _u
 }
 
+// ClearSnapshots clears all "snapshots" edges to the SavedSearchSnapshot entity.
+func (_u *SavedSearchUpdate) ClearSnapshots() *SavedSearchUpdate {
+	_u.mutation.ClearSnapshots()
+	return _u
+}
+
+// RemoveSnapshotIDs removes the "snapshots" edge to SavedSearchSnapshot entities by IDs.
+func (_u *SavedSearchUpdate) RemoveSnapshotIDs(ids ...int) *SavedSearchUpdate {
+	_u.mutation.RemoveSnapshotIDs(ids...)
+	return _u
+}
+
+// RemoveSnapshots removes "snapshots" edges to SavedSearchSnapshot entities.
+func (_u *SavedSearchUpdate) RemoveSnapshots(v ...*SavedSearchSnapshot) *SavedSearchUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSnapshotIDs(ids...)
+}
+
+// ClearExportTemplates clears all "export_templates" edges to the ExportTemplate entity.
+func (_u *SavedSearchUpdate) ClearExportTemplates() *SavedSearchUpdate {
+	_u.mutation.ClearExportTemplates()
+	return _u
+}
+
+// RemoveExportTemplateIDs removes the "export_templates" edge to ExportTemplate entities by IDs.
+func (_u *SavedSearchUpdate) RemoveExportTemplateIDs(ids ...int) *SavedSearchUpdate {
+	_u.mutation.RemoveExportTemplateIDs(ids...)
+	return _u
+}
+
+// RemoveExportTemplates removes "export_templates" edges to ExportTemplate entities.
+func (_u *SavedSearchUpdate) RemoveExportTemplates(v ...*ExportTemplate) *SavedSearchUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveExportTemplateIDs(ids...)
+}
+
+// ClearScheduledExports clears all "scheduled_exports" edges to the ScheduledExport entity.
+func (_u *SavedSearchUpdate) ClearScheduledExports() *SavedSearchUpdate {
+	_u.mutation.ClearScheduledExports()
+	return _u
+}
+
+// RemoveScheduledExportIDs removes the "scheduled_exports" edge to ScheduledExport entities by IDs.
+func (_u *SavedSearchUpdate) RemoveScheduledExportIDs(ids ...int) *SavedSearchUpdate {
+	_u.mutation.RemoveScheduledExportIDs(ids...)
+	return _u
+}
+
+// RemoveScheduledExports removes "scheduled_exports" edges to ScheduledExport entities.
+func (_u *SavedSearchUpdate) RemoveScheduledExports(v ...*ScheduledExport) *SavedSearchUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveScheduledExportIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *SavedSearchUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -134,6 +260,12 @@ func (_u *SavedSearchUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SavedSearchUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SavedSearchUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *SavedSearchUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -152,6 +284,9 @@ func (_u *SavedSearchUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.Filters(); ok {
 		_spec.SetField(savedsearch.FieldFilters, field.TypeJSON, value)
 	}
+	if value, ok := _u.mutation.TrackChanges(); ok {
+		_spec.SetField(savedsearch.FieldTrackChanges, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(savedsearch.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -184,6 +319,142 @@ func (_u *SavedSearchUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   savedsearch.SnapshotsTable,
+			Columns: []string{savedsearch.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearchsnapshot.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSnapshotsIDs(); len(nodes) > 0 && !_u.mutation.SnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   savedsearch.SnapshotsTable,
+			Columns: []string{savedsearch.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearchsnapshot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   savedsearch.SnapshotsTable,
+			Columns: []string{savedsearch.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearchsnapshot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ExportTemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   savedsearch.ExportTemplatesTable,
+			Columns: []string{savedsearch.ExportTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exporttemplate.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedExportTemplatesIDs(); len(nodes) > 0 && !_u.mutation.ExportTemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   savedsearch.ExportTemplatesTable,
+			Columns: []string{savedsearch.ExportTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exporttemplate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ExportTemplatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   savedsearch.ExportTemplatesTable,
+			Columns: []string{savedsearch.ExportTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exporttemplate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ScheduledExportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   savedsearch.ScheduledExportsTable,
+			Columns: []string{savedsearch.ScheduledExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledexport.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedScheduledExportsIDs(); len(nodes) > 0 && !_u.mutation.ScheduledExportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   savedsearch.ScheduledExportsTable,
+			Columns: []string{savedsearch.ScheduledExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledexport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScheduledExportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   savedsearch.ScheduledExportsTable,
+			Columns: []string{savedsearch.ScheduledExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledexport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{savedsearch.Label}
@@ -199,9 +470,10 @@ func (_u *SavedSearchUpdate) sqlSave(ctx context.Context) (_node int, err error)
 // SavedSearchUpdateOne is the builder for updating a single SavedSearch entity.
 type SavedSearchUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *SavedSearchMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *SavedSearchMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUserID sets the "user_id" field.
@@ -238,6 +510,20 @@ func (_u *SavedSearchUpdateOne) SetFilters(v map[string]interface{}) *SavedSearc
 	return _u
 }
 
+// SetTrackChanges sets the "track_changes" field.
+func (_u *SavedSearchUpdateOne) SetTrackChanges(v bool) *SavedSearchUpdateOne {
+	_u.mutation.SetTrackChanges(v)
+	return _u
+}
+
+// SetNillableTrackChanges sets the "track_changes" field if the given value is not nil.
+func (_u *SavedSearchUpdateOne) SetNillableTrackChanges(v *bool) *SavedSearchUpdateOne {
+	if v != nil {
+		_u.SetTrackChanges(*v)
+	}
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *SavedSearchUpdateOne) SetUpdatedAt(v time.Time) *SavedSearchUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -249,6 +535,51 @@ func (_u *SavedSearchUpdateOne) SetUser(v *User) *SavedSearchUpdateOne {
 	return _u.SetUserID(v.ID)
 }
 
+// AddSnapshotIDs adds the "snapshots" edge to the SavedSearchSnapshot entity by IDs.
+func (_u *SavedSearchUpdateOne) AddSnapshotIDs(ids ...int) *SavedSearchUpdateOne {
+	_u.mutation.AddSnapshotIDs(ids...)
+	return _u
+}
+
+// AddSnapshots adds the "snapshots" edges to the SavedSearchSnapshot entity.
+func (_u *SavedSearchUpdateOne) AddSnapshots(v ...*SavedSearchSnapshot) *SavedSearchUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSnapshotIDs(ids...)
+}
+
+// AddExportTemplateIDs adds the "export_templates" edge to the ExportTemplate entity by IDs.
+func (_u *SavedSearchUpdateOne) AddExportTemplateIDs(ids ...int) *SavedSearchUpdateOne {
+	_u.mutation.AddExportTemplateIDs(ids...)
+	return _u
+}
+
+// AddExportTemplates adds the "export_templates" edges to the ExportTemplate entity.
+func (_u *SavedSearchUpdateOne) AddExportTemplates(v ...*ExportTemplate) *SavedSearchUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddExportTemplateIDs(ids...)
+}
+
+// AddScheduledExportIDs adds the "scheduled_exports" edge to the ScheduledExport entity by IDs.
+func (_u *SavedSearchUpdateOne) AddScheduledExportIDs(ids ...int) *SavedSearchUpdateOne {
+	_u.mutation.AddScheduledExportIDs(ids...)
+	return _u
+}
+
+// AddScheduledExports adds the "scheduled_exports" edges to the ScheduledExport entity.
+func (_u *SavedSearchUpdateOne) AddScheduledExports(v ...*ScheduledExport) *SavedSearchUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddScheduledExportIDs(ids...)
+}
+
 // Mutation returns the SavedSearchMutation object of the builder.
 func (_u *SavedSearchUpdateOne) Mutation() *SavedSearchMutation {
 	return _u.mutation
@@ -260,6 +591,69 @@ func (_u *SavedSearchUpdateOne) ClearUser() *SavedSearchUpdateOne {
 	return _u
 }
 
+// ClearSnapshots clears all "snapshots" edges to the SavedSearchSnapshot entity.
+func (_u *SavedSearchUpdateOne) ClearSnapshots() *SavedSearchUpdateOne {
+	_u.mutation.ClearSnapshots()
+	return _u
+}
+
+// RemoveSnapshotIDs removes the "snapshots" edge to SavedSearchSnapshot entities by IDs.
+func (_u *SavedSearchUpdateOne) RemoveSnapshotIDs(ids ...int) *SavedSearchUpdateOne {
+	_u.mutation.RemoveSnapshotIDs(ids...)
+	return _u
+}
+
+// RemoveSnapshots removes "snapshots" edges to SavedSearchSnapshot entities.
+func (_u *SavedSearchUpdateOne) RemoveSnapshots(v ...*SavedSearchSnapshot) *SavedSearchUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSnapshotIDs(ids...)
+}
+
+// ClearExportTemplates clears all "export_templates" edges to the ExportTemplate entity.
+func (_u *SavedSearchUpdateOne) ClearExportTemplates() *SavedSearchUpdateOne {
+	_u.mutation.ClearExportTemplates()
+	return _u
+}
+
+// RemoveExportTemplateIDs removes the "export_templates" edge to ExportTemplate entities by IDs.
+func (_u *SavedSearchUpdateOne) RemoveExportTemplateIDs(ids ...int) *SavedSearchUpdateOne {
+	_u.mutation.RemoveExportTemplateIDs(ids...)
+	return _u
+}
+
+// RemoveExportTemplates removes "export_templates" edges to ExportTemplate entities.
+func (_u *SavedSearchUpdateOne) RemoveExportTemplates(v ...*ExportTemplate) *SavedSearchUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveExportTemplateIDs(ids...)
+}
+
+// ClearScheduledExports clears all "scheduled_exports" edges to the ScheduledExport entity.
+func (_u *SavedSearchUpdateOne) ClearScheduledExports() *SavedSearchUpdateOne {
+	_u.mutation.ClearScheduledExports()
+	return _u
+}
+
+// RemoveScheduledExportIDs removes the "scheduled_exports" edge to ScheduledExport entities by IDs.
+func (_u *SavedSearchUpdateOne) RemoveScheduledExportIDs(ids ...int) *SavedSearchUpdateOne {
+	_u.mutation.RemoveScheduledExportIDs(ids...)
+	return _u
+}
+
+// RemoveScheduledExports removes "scheduled_exports" edges to ScheduledExport entities.
+func (_u *SavedSearchUpdateOne) RemoveScheduledExports(v ...*ScheduledExport) *SavedSearchUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveScheduledExportIDs(ids...)
+}
+
 // Where appends a list predicates to the SavedSearchUpdate builder.
 func (_u *SavedSearchUpdateOne) Where(ps ...predicate.SavedSearch) *SavedSearchUpdateOne {
 	_u.mutation.Where(ps...)
@@ -322,6 +716,12 @@ func (_u *SavedSearchUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SavedSearchUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SavedSearchUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *SavedSearchUpdateOne) sqlSave(ctx context.Context) (_node *SavedSearch, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -357,6 +757,9 @@ func (_u *SavedSearchUpdateOne) sqlSave(ctx context.Context) (_node *SavedSearch
 	if value, ok := _u.mutation.Filters(); ok {
 		_spec.SetField(savedsearch.FieldFilters, field.TypeJSON, value)
 	}
+	if value, ok := _u.mutation.TrackChanges(); ok {
+		_spec.SetField(savedsearch.FieldTrackChanges, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(savedsearch.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -389,6 +792,142 @@ func (_u *SavedSearchUpdateOne) sqlSave(ctx context.Context) (_node *SavedSearch
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   savedsearch.SnapshotsTable,
+			Columns: []string{savedsearch.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearchsnapshot.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSnapshotsIDs(); len(nodes) > 0 && !_u.mutation.SnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   savedsearch.SnapshotsTable,
+			Columns: []string{savedsearch.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearchsnapshot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   savedsearch.SnapshotsTable,
+			Columns: []string{savedsearch.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearchsnapshot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ExportTemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   savedsearch.ExportTemplatesTable,
+			Columns: []string{savedsearch.ExportTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exporttemplate.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedExportTemplatesIDs(); len(nodes) > 0 && !_u.mutation.ExportTemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   savedsearch.ExportTemplatesTable,
+			Columns: []string{savedsearch.ExportTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exporttemplate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ExportTemplatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   savedsearch.ExportTemplatesTable,
+			Columns: []string{savedsearch.ExportTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exporttemplate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ScheduledExportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   savedsearch.ScheduledExportsTable,
+			Columns: []string{savedsearch.ScheduledExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledexport.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedScheduledExportsIDs(); len(nodes) > 0 && !_u.mutation.ScheduledExportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   savedsearch.ScheduledExportsTable,
+			Columns: []string{savedsearch.ScheduledExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledexport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScheduledExportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   savedsearch.ScheduledExportsTable,
+			Columns: []string{savedsearch.ScheduledExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledexport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &SavedSearch{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
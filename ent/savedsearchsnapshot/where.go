@@ -0,0 +1,269 @@
+// Code generated by ent, DO NOT EDIT.
+
+package savedsearchsnapshot
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldLTE(FieldID, id))
+}
+
+// SavedSearchID applies equality check predicate on the "saved_search_id" field. It's identical to SavedSearchIDEQ.
+func SavedSearchID(v int) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldEQ(FieldSavedSearchID, v))
+}
+
+// LeadIds applies equality check predicate on the "lead_ids" field. It's identical to LeadIdsEQ.
+func LeadIds(v []byte) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldEQ(FieldLeadIds, v))
+}
+
+// LeadCount applies equality check predicate on the "lead_count" field. It's identical to LeadCountEQ.
+func LeadCount(v int) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldEQ(FieldLeadCount, v))
+}
+
+// Truncated applies equality check predicate on the "truncated" field. It's identical to TruncatedEQ.
+func Truncated(v bool) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldEQ(FieldTruncated, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// SavedSearchIDEQ applies the EQ predicate on the "saved_search_id" field.
+func SavedSearchIDEQ(v int) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldEQ(FieldSavedSearchID, v))
+}
+
+// SavedSearchIDNEQ applies the NEQ predicate on the "saved_search_id" field.
+func SavedSearchIDNEQ(v int) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldNEQ(FieldSavedSearchID, v))
+}
+
+// SavedSearchIDIn applies the In predicate on the "saved_search_id" field.
+func SavedSearchIDIn(vs ...int) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldIn(FieldSavedSearchID, vs...))
+}
+
+// SavedSearchIDNotIn applies the NotIn predicate on the "saved_search_id" field.
+func SavedSearchIDNotIn(vs ...int) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldNotIn(FieldSavedSearchID, vs...))
+}
+
+// LeadIdsEQ applies the EQ predicate on the "lead_ids" field.
+func LeadIdsEQ(v []byte) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldEQ(FieldLeadIds, v))
+}
+
+// LeadIdsNEQ applies the NEQ predicate on the "lead_ids" field.
+func LeadIdsNEQ(v []byte) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldNEQ(FieldLeadIds, v))
+}
+
+// LeadIdsIn applies the In predicate on the "lead_ids" field.
+func LeadIdsIn(vs ...[]byte) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldIn(FieldLeadIds, vs...))
+}
+
+// LeadIdsNotIn applies the NotIn predicate on the "lead_ids" field.
+func LeadIdsNotIn(vs ...[]byte) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldNotIn(FieldLeadIds, vs...))
+}
+
+// LeadIdsGT applies the GT predicate on the "lead_ids" field.
+func LeadIdsGT(v []byte) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldGT(FieldLeadIds, v))
+}
+
+// LeadIdsGTE applies the GTE predicate on the "lead_ids" field.
+func LeadIdsGTE(v []byte) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldGTE(FieldLeadIds, v))
+}
+
+// LeadIdsLT applies the LT predicate on the "lead_ids" field.
+func LeadIdsLT(v []byte) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldLT(FieldLeadIds, v))
+}
+
+// LeadIdsLTE applies the LTE predicate on the "lead_ids" field.
+func LeadIdsLTE(v []byte) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldLTE(FieldLeadIds, v))
+}
+
+// LeadCountEQ applies the EQ predicate on the "lead_count" field.
+func LeadCountEQ(v int) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldEQ(FieldLeadCount, v))
+}
+
+// LeadCountNEQ applies the NEQ predicate on the "lead_count" field.
+func LeadCountNEQ(v int) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldNEQ(FieldLeadCount, v))
+}
+
+// LeadCountIn applies the In predicate on the "lead_count" field.
+func LeadCountIn(vs ...int) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldIn(FieldLeadCount, vs...))
+}
+
+// LeadCountNotIn applies the NotIn predicate on the "lead_count" field.
+func LeadCountNotIn(vs ...int) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldNotIn(FieldLeadCount, vs...))
+}
+
+// LeadCountGT applies the GT predicate on the "lead_count" field.
+func LeadCountGT(v int) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldGT(FieldLeadCount, v))
+}
+
+// LeadCountGTE applies the GTE predicate on the "lead_count" field.
+func LeadCountGTE(v int) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldGTE(FieldLeadCount, v))
+}
+
+// LeadCountLT applies the LT predicate on the "lead_count" field.
+func LeadCountLT(v int) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldLT(FieldLeadCount, v))
+}
+
+// LeadCountLTE applies the LTE predicate on the "lead_count" field.
+func LeadCountLTE(v int) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldLTE(FieldLeadCount, v))
+}
+
+// TruncatedEQ applies the EQ predicate on the "truncated" field.
+func TruncatedEQ(v bool) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldEQ(FieldTruncated, v))
+}
+
+// TruncatedNEQ applies the NEQ predicate on the "truncated" field.
+func TruncatedNEQ(v bool) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldNEQ(FieldTruncated, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasSavedSearch applies the HasEdge predicate on the "saved_search" edge.
+func HasSavedSearch() predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SavedSearchTable, SavedSearchColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSavedSearchWith applies the HasEdge predicate on the "saved_search" edge with a given conditions (other predicates).
+func HasSavedSearchWith(preds ...predicate.SavedSearch) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(func(s *sql.Selector) {
+		step := newSavedSearchStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SavedSearchSnapshot) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SavedSearchSnapshot) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SavedSearchSnapshot) predicate.SavedSearchSnapshot {
+	return predicate.SavedSearchSnapshot(sql.NotPredicates(p))
+}
@@ -0,0 +1,293 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/savedsearch"
+	"github.com/jordanlanch/industrydb/ent/savedsearchsnapshot"
+)
+
+// SavedSearchSnapshotCreate is the builder for creating a SavedSearchSnapshot entity.
+type SavedSearchSnapshotCreate struct {
+	config
+	mutation *SavedSearchSnapshotMutation
+	hooks    []Hook
+}
+
+// SetSavedSearchID sets the "saved_search_id" field.
+func (_c *SavedSearchSnapshotCreate) SetSavedSearchID(v int) *SavedSearchSnapshotCreate {
+	_c.mutation.SetSavedSearchID(v)
+	return _c
+}
+
+// SetLeadIds sets the "lead_ids" field.
+func (_c *SavedSearchSnapshotCreate) SetLeadIds(v []byte) *SavedSearchSnapshotCreate {
+	_c.mutation.SetLeadIds(v)
+	return _c
+}
+
+// SetLeadCount sets the "lead_count" field.
+func (_c *SavedSearchSnapshotCreate) SetLeadCount(v int) *SavedSearchSnapshotCreate {
+	_c.mutation.SetLeadCount(v)
+	return _c
+}
+
+// SetTruncated sets the "truncated" field.
+func (_c *SavedSearchSnapshotCreate) SetTruncated(v bool) *SavedSearchSnapshotCreate {
+	_c.mutation.SetTruncated(v)
+	return _c
+}
+
+// SetNillableTruncated sets the "truncated" field if the given value is not nil.
+func (_c *SavedSearchSnapshotCreate) SetNillableTruncated(v *bool) *SavedSearchSnapshotCreate {
+	if v != nil {
+		_c.SetTruncated(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SavedSearchSnapshotCreate) SetCreatedAt(v time.Time) *SavedSearchSnapshotCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SavedSearchSnapshotCreate) SetNillableCreatedAt(v *time.Time) *SavedSearchSnapshotCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetSavedSearch sets the "saved_search" edge to the SavedSearch entity.
+func (_c *SavedSearchSnapshotCreate) SetSavedSearch(v *SavedSearch) *SavedSearchSnapshotCreate {
+	return _c.SetSavedSearchID(v.ID)
+}
+
+// Mutation returns the SavedSearchSnapshotMutation object of the builder.
+func (_c *SavedSearchSnapshotCreate) Mutation() *SavedSearchSnapshotMutation {
+	return _c.mutation
+}
+
+// Save creates the SavedSearchSnapshot in the database.
+func (_c *SavedSearchSnapshotCreate) Save(ctx context.Context) (*SavedSearchSnapshot, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SavedSearchSnapshotCreate) SaveX(ctx context.Context) *SavedSearchSnapshot {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SavedSearchSnapshotCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SavedSearchSnapshotCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SavedSearchSnapshotCreate) defaults() {
+	if _, ok := _c.mutation.Truncated(); !ok {
+		v := savedsearchsnapshot.DefaultTruncated
+		_c.mutation.SetTruncated(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := savedsearchsnapshot.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SavedSearchSnapshotCreate) check() error {
+	if _, ok := _c.mutation.SavedSearchID(); !ok {
+		return &ValidationError{Name: "saved_search_id", err: errors.New(`ent: missing required field "SavedSearchSnapshot.saved_search_id"`)}
+	}
+	if _, ok := _c.mutation.LeadIds(); !ok {
+		return &ValidationError{Name: "lead_ids", err: errors.New(`ent: missing required field "SavedSearchSnapshot.lead_ids"`)}
+	}
+	if _, ok := _c.mutation.LeadCount(); !ok {
+		return &ValidationError{Name: "lead_count", err: errors.New(`ent: missing required field "SavedSearchSnapshot.lead_count"`)}
+	}
+	if v, ok := _c.mutation.LeadCount(); ok {
+		if err := savedsearchsnapshot.LeadCountValidator(v); err != nil {
+			return &ValidationError{Name: "lead_count", err: fmt.Errorf(`ent: validator failed for field "SavedSearchSnapshot.lead_count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Truncated(); !ok {
+		return &ValidationError{Name: "truncated", err: errors.New(`ent: missing required field "SavedSearchSnapshot.truncated"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SavedSearchSnapshot.created_at"`)}
+	}
+	if len(_c.mutation.SavedSearchIDs()) == 0 {
+		return &ValidationError{Name: "saved_search", err: errors.New(`ent: missing required edge "SavedSearchSnapshot.saved_search"`)}
+	}
+	return nil
+}
+
+func (_c *SavedSearchSnapshotCreate) sqlSave(ctx context.Context) (*SavedSearchSnapshot, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SavedSearchSnapshotCreate) createSpec() (*SavedSearchSnapshot, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SavedSearchSnapshot{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(savedsearchsnapshot.Table, sqlgraph.NewFieldSpec(savedsearchsnapshot.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.LeadIds(); ok {
+		_spec.SetField(savedsearchsnapshot.FieldLeadIds, field.TypeBytes, value)
+		_node.LeadIds = value
+	}
+	if value, ok := _c.mutation.LeadCount(); ok {
+		_spec.SetField(savedsearchsnapshot.FieldLeadCount, field.TypeInt, value)
+		_node.LeadCount = value
+	}
+	if value, ok := _c.mutation.Truncated(); ok {
+		_spec.SetField(savedsearchsnapshot.FieldTruncated, field.TypeBool, value)
+		_node.Truncated = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(savedsearchsnapshot.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.SavedSearchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedsearchsnapshot.SavedSearchTable,
+			Columns: []string{savedsearchsnapshot.SavedSearchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SavedSearchID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SavedSearchSnapshotCreateBulk is the builder for creating many SavedSearchSnapshot entities in bulk.
+type SavedSearchSnapshotCreateBulk struct {
+	config
+	err      error
+	builders []*SavedSearchSnapshotCreate
+}
+
+// Save creates the SavedSearchSnapshot entities in the database.
+func (_c *SavedSearchSnapshotCreateBulk) Save(ctx context.Context) ([]*SavedSearchSnapshot, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SavedSearchSnapshot, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SavedSearchSnapshotMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SavedSearchSnapshotCreateBulk) SaveX(ctx context.Context) []*SavedSearchSnapshot {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SavedSearchSnapshotCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SavedSearchSnapshotCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
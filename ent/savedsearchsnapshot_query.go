@@ -0,0 +1,629 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+	"github.com/jordanlanch/industrydb/ent/savedsearch"
+	"github.com/jordanlanch/industrydb/ent/savedsearchsnapshot"
+)
+
+// SavedSearchSnapshotQuery is the builder for querying SavedSearchSnapshot entities.
+type SavedSearchSnapshotQuery struct {
+	config
+	ctx             *QueryContext
+	order           []savedsearchsnapshot.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.SavedSearchSnapshot
+	withSavedSearch *SavedSearchQuery
+	modifiers       []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SavedSearchSnapshotQuery builder.
+func (_q *SavedSearchSnapshotQuery) Where(ps ...predicate.SavedSearchSnapshot) *SavedSearchSnapshotQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *SavedSearchSnapshotQuery) Limit(limit int) *SavedSearchSnapshotQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *SavedSearchSnapshotQuery) Offset(offset int) *SavedSearchSnapshotQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *SavedSearchSnapshotQuery) Unique(unique bool) *SavedSearchSnapshotQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *SavedSearchSnapshotQuery) Order(o ...savedsearchsnapshot.OrderOption) *SavedSearchSnapshotQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QuerySavedSearch chains the current query on the "saved_search" edge.
+func (_q *SavedSearchSnapshotQuery) QuerySavedSearch() *SavedSearchQuery {
+	query := (&SavedSearchClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(savedsearchsnapshot.Table, savedsearchsnapshot.FieldID, selector),
+			sqlgraph.To(savedsearch.Table, savedsearch.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, savedsearchsnapshot.SavedSearchTable, savedsearchsnapshot.SavedSearchColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SavedSearchSnapshot entity from the query.
+// Returns a *NotFoundError when no SavedSearchSnapshot was found.
+func (_q *SavedSearchSnapshotQuery) First(ctx context.Context) (*SavedSearchSnapshot, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{savedsearchsnapshot.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *SavedSearchSnapshotQuery) FirstX(ctx context.Context) *SavedSearchSnapshot {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SavedSearchSnapshot ID from the query.
+// Returns a *NotFoundError when no SavedSearchSnapshot ID was found.
+func (_q *SavedSearchSnapshotQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{savedsearchsnapshot.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *SavedSearchSnapshotQuery) FirstIDX(ctx context.Context) int {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SavedSearchSnapshot entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SavedSearchSnapshot entity is found.
+// Returns a *NotFoundError when no SavedSearchSnapshot entities are found.
+func (_q *SavedSearchSnapshotQuery) Only(ctx context.Context) (*SavedSearchSnapshot, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{savedsearchsnapshot.Label}
+	default:
+		return nil, &NotSingularError{savedsearchsnapshot.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *SavedSearchSnapshotQuery) OnlyX(ctx context.Context) *SavedSearchSnapshot {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SavedSearchSnapshot ID in the query.
+// Returns a *NotSingularError when more than one SavedSearchSnapshot ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *SavedSearchSnapshotQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{savedsearchsnapshot.Label}
+	default:
+		err = &NotSingularError{savedsearchsnapshot.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *SavedSearchSnapshotQuery) OnlyIDX(ctx context.Context) int {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SavedSearchSnapshots.
+func (_q *SavedSearchSnapshotQuery) All(ctx context.Context) ([]*SavedSearchSnapshot, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SavedSearchSnapshot, *SavedSearchSnapshotQuery]()
+	return withInterceptors[[]*SavedSearchSnapshot](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *SavedSearchSnapshotQuery) AllX(ctx context.Context) []*SavedSearchSnapshot {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SavedSearchSnapshot IDs.
+func (_q *SavedSearchSnapshotQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(savedsearchsnapshot.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *SavedSearchSnapshotQuery) IDsX(ctx context.Context) []int {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *SavedSearchSnapshotQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*SavedSearchSnapshotQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *SavedSearchSnapshotQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *SavedSearchSnapshotQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *SavedSearchSnapshotQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SavedSearchSnapshotQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *SavedSearchSnapshotQuery) Clone() *SavedSearchSnapshotQuery {
+	if _q == nil {
+		return nil
+	}
+	return &SavedSearchSnapshotQuery{
+		config:          _q.config,
+		ctx:             _q.ctx.Clone(),
+		order:           append([]savedsearchsnapshot.OrderOption{}, _q.order...),
+		inters:          append([]Interceptor{}, _q.inters...),
+		predicates:      append([]predicate.SavedSearchSnapshot{}, _q.predicates...),
+		withSavedSearch: _q.withSavedSearch.Clone(),
+		// clone intermediate query.
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
+	}
+}
+
+// WithSavedSearch tells the query-builder to eager-load the nodes that are connected to
+// the "saved_search" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *SavedSearchSnapshotQuery) WithSavedSearch(opts ...func(*SavedSearchQuery)) *SavedSearchSnapshotQuery {
+	query := (&SavedSearchClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSavedSearch = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		SavedSearchID int `json:"saved_search_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SavedSearchSnapshot.Query().
+//		GroupBy(savedsearchsnapshot.FieldSavedSearchID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *SavedSearchSnapshotQuery) GroupBy(field string, fields ...string) *SavedSearchSnapshotGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SavedSearchSnapshotGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = savedsearchsnapshot.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		SavedSearchID int `json:"saved_search_id,omitempty"`
+//	}
+//
+//	client.SavedSearchSnapshot.Query().
+//		Select(savedsearchsnapshot.FieldSavedSearchID).
+//		Scan(ctx, &v)
+func (_q *SavedSearchSnapshotQuery) Select(fields ...string) *SavedSearchSnapshotSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &SavedSearchSnapshotSelect{SavedSearchSnapshotQuery: _q}
+	sbuild.label = savedsearchsnapshot.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SavedSearchSnapshotSelect configured with the given aggregations.
+func (_q *SavedSearchSnapshotQuery) Aggregate(fns ...AggregateFunc) *SavedSearchSnapshotSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *SavedSearchSnapshotQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !savedsearchsnapshot.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *SavedSearchSnapshotQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SavedSearchSnapshot, error) {
+	var (
+		nodes       = []*SavedSearchSnapshot{}
+		_spec       = _q.querySpec()
+		loadedTypes = [1]bool{
+			_q.withSavedSearch != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SavedSearchSnapshot).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SavedSearchSnapshot{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withSavedSearch; query != nil {
+		if err := _q.loadSavedSearch(ctx, query, nodes, nil,
+			func(n *SavedSearchSnapshot, e *SavedSearch) { n.Edges.SavedSearch = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *SavedSearchSnapshotQuery) loadSavedSearch(ctx context.Context, query *SavedSearchQuery, nodes []*SavedSearchSnapshot, init func(*SavedSearchSnapshot), assign func(*SavedSearchSnapshot, *SavedSearch)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*SavedSearchSnapshot)
+	for i := range nodes {
+		fk := nodes[i].SavedSearchID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(savedsearch.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "saved_search_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (_q *SavedSearchSnapshotQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *SavedSearchSnapshotQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(savedsearchsnapshot.Table, savedsearchsnapshot.Columns, sqlgraph.NewFieldSpec(savedsearchsnapshot.FieldID, field.TypeInt))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, savedsearchsnapshot.FieldID)
+		for i := range fields {
+			if fields[i] != savedsearchsnapshot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if _q.withSavedSearch != nil {
+			_spec.Node.AddColumnOnce(savedsearchsnapshot.FieldSavedSearchID)
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *SavedSearchSnapshotQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(savedsearchsnapshot.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = savedsearchsnapshot.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *SavedSearchSnapshotQuery) Modify(modifiers ...func(s *sql.Selector)) *SavedSearchSnapshotSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
+// SavedSearchSnapshotGroupBy is the group-by builder for SavedSearchSnapshot entities.
+type SavedSearchSnapshotGroupBy struct {
+	selector
+	build *SavedSearchSnapshotQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *SavedSearchSnapshotGroupBy) Aggregate(fns ...AggregateFunc) *SavedSearchSnapshotGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *SavedSearchSnapshotGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SavedSearchSnapshotQuery, *SavedSearchSnapshotGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *SavedSearchSnapshotGroupBy) sqlScan(ctx context.Context, root *SavedSearchSnapshotQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SavedSearchSnapshotSelect is the builder for selecting fields of SavedSearchSnapshot entities.
+type SavedSearchSnapshotSelect struct {
+	*SavedSearchSnapshotQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *SavedSearchSnapshotSelect) Aggregate(fns ...AggregateFunc) *SavedSearchSnapshotSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *SavedSearchSnapshotSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SavedSearchSnapshotQuery, *SavedSearchSnapshotSelect](ctx, _s.SavedSearchSnapshotQuery, _s, _s.inters, v)
+}
+
+func (_s *SavedSearchSnapshotSelect) sqlScan(ctx context.Context, root *SavedSearchSnapshotQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *SavedSearchSnapshotSelect) Modify(modifiers ...func(s *sql.Selector)) *SavedSearchSnapshotSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
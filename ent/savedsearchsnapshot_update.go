@@ -0,0 +1,438 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+	"github.com/jordanlanch/industrydb/ent/savedsearch"
+	"github.com/jordanlanch/industrydb/ent/savedsearchsnapshot"
+)
+
+// SavedSearchSnapshotUpdate is the builder for updating SavedSearchSnapshot entities.
+type SavedSearchSnapshotUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SavedSearchSnapshotMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SavedSearchSnapshotUpdate builder.
+func (_u *SavedSearchSnapshotUpdate) Where(ps ...predicate.SavedSearchSnapshot) *SavedSearchSnapshotUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetSavedSearchID sets the "saved_search_id" field.
+func (_u *SavedSearchSnapshotUpdate) SetSavedSearchID(v int) *SavedSearchSnapshotUpdate {
+	_u.mutation.SetSavedSearchID(v)
+	return _u
+}
+
+// SetNillableSavedSearchID sets the "saved_search_id" field if the given value is not nil.
+func (_u *SavedSearchSnapshotUpdate) SetNillableSavedSearchID(v *int) *SavedSearchSnapshotUpdate {
+	if v != nil {
+		_u.SetSavedSearchID(*v)
+	}
+	return _u
+}
+
+// SetLeadIds sets the "lead_ids" field.
+func (_u *SavedSearchSnapshotUpdate) SetLeadIds(v []byte) *SavedSearchSnapshotUpdate {
+	_u.mutation.SetLeadIds(v)
+	return _u
+}
+
+// SetLeadCount sets the "lead_count" field.
+func (_u *SavedSearchSnapshotUpdate) SetLeadCount(v int) *SavedSearchSnapshotUpdate {
+	_u.mutation.ResetLeadCount()
+	_u.mutation.SetLeadCount(v)
+	return _u
+}
+
+// SetNillableLeadCount sets the "lead_count" field if the given value is not nil.
+func (_u *SavedSearchSnapshotUpdate) SetNillableLeadCount(v *int) *SavedSearchSnapshotUpdate {
+	if v != nil {
+		_u.SetLeadCount(*v)
+	}
+	return _u
+}
+
+// AddLeadCount adds value to the "lead_count" field.
+func (_u *SavedSearchSnapshotUpdate) AddLeadCount(v int) *SavedSearchSnapshotUpdate {
+	_u.mutation.AddLeadCount(v)
+	return _u
+}
+
+// SetTruncated sets the "truncated" field.
+func (_u *SavedSearchSnapshotUpdate) SetTruncated(v bool) *SavedSearchSnapshotUpdate {
+	_u.mutation.SetTruncated(v)
+	return _u
+}
+
+// SetNillableTruncated sets the "truncated" field if the given value is not nil.
+func (_u *SavedSearchSnapshotUpdate) SetNillableTruncated(v *bool) *SavedSearchSnapshotUpdate {
+	if v != nil {
+		_u.SetTruncated(*v)
+	}
+	return _u
+}
+
+// SetSavedSearch sets the "saved_search" edge to the SavedSearch entity.
+func (_u *SavedSearchSnapshotUpdate) SetSavedSearch(v *SavedSearch) *SavedSearchSnapshotUpdate {
+	return _u.SetSavedSearchID(v.ID)
+}
+
+// Mutation returns the SavedSearchSnapshotMutation object of the builder.
+func (_u *SavedSearchSnapshotUpdate) Mutation() *SavedSearchSnapshotMutation {
+	return _u.mutation
+}
+
+// ClearSavedSearch clears the "saved_search" edge to the SavedSearch entity.
+func (_u *SavedSearchSnapshotUpdate) ClearSavedSearch() *SavedSearchSnapshotUpdate {
+	_u.mutation.ClearSavedSearch()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SavedSearchSnapshotUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SavedSearchSnapshotUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SavedSearchSnapshotUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SavedSearchSnapshotUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SavedSearchSnapshotUpdate) check() error {
+	if v, ok := _u.mutation.LeadCount(); ok {
+		if err := savedsearchsnapshot.LeadCountValidator(v); err != nil {
+			return &ValidationError{Name: "lead_count", err: fmt.Errorf(`ent: validator failed for field "SavedSearchSnapshot.lead_count": %w`, err)}
+		}
+	}
+	if _u.mutation.SavedSearchCleared() && len(_u.mutation.SavedSearchIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SavedSearchSnapshot.saved_search"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SavedSearchSnapshotUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SavedSearchSnapshotUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SavedSearchSnapshotUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(savedsearchsnapshot.Table, savedsearchsnapshot.Columns, sqlgraph.NewFieldSpec(savedsearchsnapshot.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.LeadIds(); ok {
+		_spec.SetField(savedsearchsnapshot.FieldLeadIds, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.LeadCount(); ok {
+		_spec.SetField(savedsearchsnapshot.FieldLeadCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLeadCount(); ok {
+		_spec.AddField(savedsearchsnapshot.FieldLeadCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Truncated(); ok {
+		_spec.SetField(savedsearchsnapshot.FieldTruncated, field.TypeBool, value)
+	}
+	if _u.mutation.SavedSearchCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedsearchsnapshot.SavedSearchTable,
+			Columns: []string{savedsearchsnapshot.SavedSearchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SavedSearchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedsearchsnapshot.SavedSearchTable,
+			Columns: []string{savedsearchsnapshot.SavedSearchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{savedsearchsnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SavedSearchSnapshotUpdateOne is the builder for updating a single SavedSearchSnapshot entity.
+type SavedSearchSnapshotUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SavedSearchSnapshotMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetSavedSearchID sets the "saved_search_id" field.
+func (_u *SavedSearchSnapshotUpdateOne) SetSavedSearchID(v int) *SavedSearchSnapshotUpdateOne {
+	_u.mutation.SetSavedSearchID(v)
+	return _u
+}
+
+// SetNillableSavedSearchID sets the "saved_search_id" field if the given value is not nil.
+func (_u *SavedSearchSnapshotUpdateOne) SetNillableSavedSearchID(v *int) *SavedSearchSnapshotUpdateOne {
+	if v != nil {
+		_u.SetSavedSearchID(*v)
+	}
+	return _u
+}
+
+// SetLeadIds sets the "lead_ids" field.
+func (_u *SavedSearchSnapshotUpdateOne) SetLeadIds(v []byte) *SavedSearchSnapshotUpdateOne {
+	_u.mutation.SetLeadIds(v)
+	return _u
+}
+
+// SetLeadCount sets the "lead_count" field.
+func (_u *SavedSearchSnapshotUpdateOne) SetLeadCount(v int) *SavedSearchSnapshotUpdateOne {
+	_u.mutation.ResetLeadCount()
+	_u.mutation.SetLeadCount(v)
+	return _u
+}
+
+// SetNillableLeadCount sets the "lead_count" field if the given value is not nil.
+func (_u *SavedSearchSnapshotUpdateOne) SetNillableLeadCount(v *int) *SavedSearchSnapshotUpdateOne {
+	if v != nil {
+		_u.SetLeadCount(*v)
+	}
+	return _u
+}
+
+// AddLeadCount adds value to the "lead_count" field.
+func (_u *SavedSearchSnapshotUpdateOne) AddLeadCount(v int) *SavedSearchSnapshotUpdateOne {
+	_u.mutation.AddLeadCount(v)
+	return _u
+}
+
+// SetTruncated sets the "truncated" field.
+func (_u *SavedSearchSnapshotUpdateOne) SetTruncated(v bool) *SavedSearchSnapshotUpdateOne {
+	_u.mutation.SetTruncated(v)
+	return _u
+}
+
+// SetNillableTruncated sets the "truncated" field if the given value is not nil.
+func (_u *SavedSearchSnapshotUpdateOne) SetNillableTruncated(v *bool) *SavedSearchSnapshotUpdateOne {
+	if v != nil {
+		_u.SetTruncated(*v)
+	}
+	return _u
+}
+
+// SetSavedSearch sets the "saved_search" edge to the SavedSearch entity.
+func (_u *SavedSearchSnapshotUpdateOne) SetSavedSearch(v *SavedSearch) *SavedSearchSnapshotUpdateOne {
+	return _u.SetSavedSearchID(v.ID)
+}
+
+// Mutation returns the SavedSearchSnapshotMutation object of the builder.
+func (_u *SavedSearchSnapshotUpdateOne) Mutation() *SavedSearchSnapshotMutation {
+	return _u.mutation
+}
+
+// ClearSavedSearch clears the "saved_search" edge to the SavedSearch entity.
+func (_u *SavedSearchSnapshotUpdateOne) ClearSavedSearch() *SavedSearchSnapshotUpdateOne {
+	_u.mutation.ClearSavedSearch()
+	return _u
+}
+
+// Where appends a list predicates to the SavedSearchSnapshotUpdate builder.
+func (_u *SavedSearchSnapshotUpdateOne) Where(ps ...predicate.SavedSearchSnapshot) *SavedSearchSnapshotUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SavedSearchSnapshotUpdateOne) Select(field string, fields ...string) *SavedSearchSnapshotUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SavedSearchSnapshot entity.
+func (_u *SavedSearchSnapshotUpdateOne) Save(ctx context.Context) (*SavedSearchSnapshot, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SavedSearchSnapshotUpdateOne) SaveX(ctx context.Context) *SavedSearchSnapshot {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SavedSearchSnapshotUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SavedSearchSnapshotUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SavedSearchSnapshotUpdateOne) check() error {
+	if v, ok := _u.mutation.LeadCount(); ok {
+		if err := savedsearchsnapshot.LeadCountValidator(v); err != nil {
+			return &ValidationError{Name: "lead_count", err: fmt.Errorf(`ent: validator failed for field "SavedSearchSnapshot.lead_count": %w`, err)}
+		}
+	}
+	if _u.mutation.SavedSearchCleared() && len(_u.mutation.SavedSearchIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SavedSearchSnapshot.saved_search"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SavedSearchSnapshotUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SavedSearchSnapshotUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SavedSearchSnapshotUpdateOne) sqlSave(ctx context.Context) (_node *SavedSearchSnapshot, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(savedsearchsnapshot.Table, savedsearchsnapshot.Columns, sqlgraph.NewFieldSpec(savedsearchsnapshot.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SavedSearchSnapshot.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, savedsearchsnapshot.FieldID)
+		for _, f := range fields {
+			if !savedsearchsnapshot.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != savedsearchsnapshot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.LeadIds(); ok {
+		_spec.SetField(savedsearchsnapshot.FieldLeadIds, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.LeadCount(); ok {
+		_spec.SetField(savedsearchsnapshot.FieldLeadCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLeadCount(); ok {
+		_spec.AddField(savedsearchsnapshot.FieldLeadCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Truncated(); ok {
+		_spec.SetField(savedsearchsnapshot.FieldTruncated, field.TypeBool, value)
+	}
+	if _u.mutation.SavedSearchCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedsearchsnapshot.SavedSearchTable,
+			Columns: []string{savedsearchsnapshot.SavedSearchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SavedSearchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedsearchsnapshot.SavedSearchTable,
+			Columns: []string{savedsearchsnapshot.SavedSearchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &SavedSearchSnapshot{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{savedsearchsnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
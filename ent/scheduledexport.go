@@ -0,0 +1,413 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/jordanlanch/industrydb/ent/organization"
+	"github.com/jordanlanch/industrydb/ent/savedsearch"
+	"github.com/jordanlanch/industrydb/ent/scheduledexport"
+	"github.com/jordanlanch/industrydb/ent/user"
+)
+
+// ScheduledExport is the model entity for the ScheduledExport schema.
+type ScheduledExport struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// User who owns this schedule
+	UserID int `json:"user_id,omitempty"`
+	// Organization the exports run as (nil = personal)
+	OrganizationID *int `json:"organization_id,omitempty"`
+	// Name of this schedule
+	Name string `json:"name,omitempty"`
+	// Export file format
+	Format scheduledexport.Format `json:"format,omitempty"`
+	// Columns to export, in order (empty = all columns)
+	Columns []string `json:"columns,omitempty"`
+	// Saved search whose filters select the leads
+	SavedSearchID *int `json:"saved_search_id,omitempty"`
+	// Inline filters in saved search format, when no saved search is referenced
+	Filters map[string]interface{} `json:"filters,omitempty"`
+	// Maximum leads per export (0 = default)
+	MaxLeads int `json:"max_leads,omitempty"`
+	// Export only leads created or updated since the previous export (delta)
+	SinceLastExport bool `json:"since_last_export,omitempty"`
+	// Cron expression (5 fields or @daily/@weekly), optionally prefixed with CRON_TZ=
+	Cadence string `json:"cadence,omitempty"`
+	// How each export is delivered: download link or POST to delivery_url
+	DeliveryMethod scheduledexport.DeliveryMethod `json:"delivery_method,omitempty"`
+	// URL each export file is POSTed to, for url delivery
+	DeliveryURL string `json:"delivery_url,omitempty"`
+	// Secret signing every delivery of this schedule
+	DeliverySecret string `json:"-"`
+	// Paused schedules do not run
+	Status scheduledexport.Status `json:"status,omitempty"`
+	// When the schedule runs next
+	NextRunAt time.Time `json:"next_run_at,omitempty"`
+	// When the schedule last ran
+	LastRunAt *time.Time `json:"last_run_at,omitempty"`
+	// Export created by the last successful run
+	LastExportID *int `json:"last_export_id,omitempty"`
+	// Error of the last run, cleared on success
+	LastError string `json:"last_error,omitempty"`
+	// Number of exports created by this schedule
+	RunCount int `json:"run_count,omitempty"`
+	// When this schedule was created
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Last update timestamp
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ScheduledExportQuery when eager-loading is set.
+	Edges        ScheduledExportEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ScheduledExportEdges holds the relations/edges for other nodes in the graph.
+type ScheduledExportEdges struct {
+	// User who owns this schedule
+	User *User `json:"user,omitempty"`
+	// Organization the exports run as
+	Organization *Organization `json:"organization,omitempty"`
+	// Saved search the schedule exports
+	SavedSearch *SavedSearch `json:"saved_search,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScheduledExportEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScheduledExportEdges) OrganizationOrErr() (*Organization, error) {
+	if e.Organization != nil {
+		return e.Organization, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// SavedSearchOrErr returns the SavedSearch value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScheduledExportEdges) SavedSearchOrErr() (*SavedSearch, error) {
+	if e.SavedSearch != nil {
+		return e.SavedSearch, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: savedsearch.Label}
+	}
+	return nil, &NotLoadedError{edge: "saved_search"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ScheduledExport) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case scheduledexport.FieldColumns, scheduledexport.FieldFilters:
+			values[i] = new([]byte)
+		case scheduledexport.FieldSinceLastExport:
+			values[i] = new(sql.NullBool)
+		case scheduledexport.FieldID, scheduledexport.FieldUserID, scheduledexport.FieldOrganizationID, scheduledexport.FieldSavedSearchID, scheduledexport.FieldMaxLeads, scheduledexport.FieldLastExportID, scheduledexport.FieldRunCount:
+			values[i] = new(sql.NullInt64)
+		case scheduledexport.FieldName, scheduledexport.FieldFormat, scheduledexport.FieldCadence, scheduledexport.FieldDeliveryMethod, scheduledexport.FieldDeliveryURL, scheduledexport.FieldDeliverySecret, scheduledexport.FieldStatus, scheduledexport.FieldLastError:
+			values[i] = new(sql.NullString)
+		case scheduledexport.FieldNextRunAt, scheduledexport.FieldLastRunAt, scheduledexport.FieldCreatedAt, scheduledexport.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ScheduledExport fields.
+func (_m *ScheduledExport) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case scheduledexport.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case scheduledexport.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case scheduledexport.FieldOrganizationID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value.Valid {
+				_m.OrganizationID = new(int)
+				*_m.OrganizationID = int(value.Int64)
+			}
+		case scheduledexport.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case scheduledexport.FieldFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field format", values[i])
+			} else if value.Valid {
+				_m.Format = scheduledexport.Format(value.String)
+			}
+		case scheduledexport.FieldColumns:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field columns", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Columns); err != nil {
+					return fmt.Errorf("unmarshal field columns: %w", err)
+				}
+			}
+		case scheduledexport.FieldSavedSearchID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field saved_search_id", values[i])
+			} else if value.Valid {
+				_m.SavedSearchID = new(int)
+				*_m.SavedSearchID = int(value.Int64)
+			}
+		case scheduledexport.FieldFilters:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field filters", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Filters); err != nil {
+					return fmt.Errorf("unmarshal field filters: %w", err)
+				}
+			}
+		case scheduledexport.FieldMaxLeads:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_leads", values[i])
+			} else if value.Valid {
+				_m.MaxLeads = int(value.Int64)
+			}
+		case scheduledexport.FieldSinceLastExport:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field since_last_export", values[i])
+			} else if value.Valid {
+				_m.SinceLastExport = value.Bool
+			}
+		case scheduledexport.FieldCadence:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cadence", values[i])
+			} else if value.Valid {
+				_m.Cadence = value.String
+			}
+		case scheduledexport.FieldDeliveryMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field delivery_method", values[i])
+			} else if value.Valid {
+				_m.DeliveryMethod = scheduledexport.DeliveryMethod(value.String)
+			}
+		case scheduledexport.FieldDeliveryURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field delivery_url", values[i])
+			} else if value.Valid {
+				_m.DeliveryURL = value.String
+			}
+		case scheduledexport.FieldDeliverySecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field delivery_secret", values[i])
+			} else if value.Valid {
+				_m.DeliverySecret = value.String
+			}
+		case scheduledexport.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = scheduledexport.Status(value.String)
+			}
+		case scheduledexport.FieldNextRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_run_at", values[i])
+			} else if value.Valid {
+				_m.NextRunAt = value.Time
+			}
+		case scheduledexport.FieldLastRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_run_at", values[i])
+			} else if value.Valid {
+				_m.LastRunAt = new(time.Time)
+				*_m.LastRunAt = value.Time
+			}
+		case scheduledexport.FieldLastExportID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_export_id", values[i])
+			} else if value.Valid {
+				_m.LastExportID = new(int)
+				*_m.LastExportID = int(value.Int64)
+			}
+		case scheduledexport.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				_m.LastError = value.String
+			}
+		case scheduledexport.FieldRunCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field run_count", values[i])
+			} else if value.Valid {
+				_m.RunCount = int(value.Int64)
+			}
+		case scheduledexport.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case scheduledexport.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ScheduledExport.
+// This includes values selected through modifiers, order, etc.
+func (_m *ScheduledExport) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the ScheduledExport entity.
+func (_m *ScheduledExport) QueryUser() *UserQuery {
+	return NewScheduledExportClient(_m.config).QueryUser(_m)
+}
+
+// QueryOrganization queries the "organization" edge of the ScheduledExport entity.
+func (_m *ScheduledExport) QueryOrganization() *OrganizationQuery {
+	return NewScheduledExportClient(_m.config).QueryOrganization(_m)
+}
+
+// QuerySavedSearch queries the "saved_search" edge of the ScheduledExport entity.
+func (_m *ScheduledExport) QuerySavedSearch() *SavedSearchQuery {
+	return NewScheduledExportClient(_m.config).QuerySavedSearch(_m)
+}
+
+// Update returns a builder for updating this ScheduledExport.
+// Note that you need to call ScheduledExport.Unwrap() before calling this method if this ScheduledExport
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ScheduledExport) Update() *ScheduledExportUpdateOne {
+	return NewScheduledExportClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ScheduledExport entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ScheduledExport) Unwrap() *ScheduledExport {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ScheduledExport is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ScheduledExport) String() string {
+	var builder strings.Builder
+	builder.WriteString("ScheduledExport(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	if v := _m.OrganizationID; v != nil {
+		builder.WriteString("organization_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("format=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Format))
+	builder.WriteString(", ")
+	builder.WriteString("columns=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Columns))
+	builder.WriteString(", ")
+	if v := _m.SavedSearchID; v != nil {
+		builder.WriteString("saved_search_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("filters=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Filters))
+	builder.WriteString(", ")
+	builder.WriteString("max_leads=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxLeads))
+	builder.WriteString(", ")
+	builder.WriteString("since_last_export=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SinceLastExport))
+	builder.WriteString(", ")
+	builder.WriteString("cadence=")
+	builder.WriteString(_m.Cadence)
+	builder.WriteString(", ")
+	builder.WriteString("delivery_method=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeliveryMethod))
+	builder.WriteString(", ")
+	builder.WriteString("delivery_url=")
+	builder.WriteString(_m.DeliveryURL)
+	builder.WriteString(", ")
+	builder.WriteString("delivery_secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("next_run_at=")
+	builder.WriteString(_m.NextRunAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.LastRunAt; v != nil {
+		builder.WriteString("last_run_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.LastExportID; v != nil {
+		builder.WriteString("last_export_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(_m.LastError)
+	builder.WriteString(", ")
+	builder.WriteString("run_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RunCount))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ScheduledExports is a parsable slice of ScheduledExport.
+type ScheduledExports []*ScheduledExport
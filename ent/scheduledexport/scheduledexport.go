@@ -0,0 +1,377 @@
+// Code generated by ent, DO NOT EDIT.
+
+package scheduledexport
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the scheduledexport type in the database.
+	Label = "scheduled_export"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldOrganizationID holds the string denoting the organization_id field in the database.
+	FieldOrganizationID = "organization_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldFormat holds the string denoting the format field in the database.
+	FieldFormat = "format"
+	// FieldColumns holds the string denoting the columns field in the database.
+	FieldColumns = "columns"
+	// FieldSavedSearchID holds the string denoting the saved_search_id field in the database.
+	FieldSavedSearchID = "saved_search_id"
+	// FieldFilters holds the string denoting the filters field in the database.
+	FieldFilters = "filters"
+	// FieldMaxLeads holds the string denoting the max_leads field in the database.
+	FieldMaxLeads = "max_leads"
+	// FieldSinceLastExport holds the string denoting the since_last_export field in the database.
+	FieldSinceLastExport = "since_last_export"
+	// FieldCadence holds the string denoting the cadence field in the database.
+	FieldCadence = "cadence"
+	// FieldDeliveryMethod holds the string denoting the delivery_method field in the database.
+	FieldDeliveryMethod = "delivery_method"
+	// FieldDeliveryURL holds the string denoting the delivery_url field in the database.
+	FieldDeliveryURL = "delivery_url"
+	// FieldDeliverySecret holds the string denoting the delivery_secret field in the database.
+	FieldDeliverySecret = "delivery_secret"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldNextRunAt holds the string denoting the next_run_at field in the database.
+	FieldNextRunAt = "next_run_at"
+	// FieldLastRunAt holds the string denoting the last_run_at field in the database.
+	FieldLastRunAt = "last_run_at"
+	// FieldLastExportID holds the string denoting the last_export_id field in the database.
+	FieldLastExportID = "last_export_id"
+	// FieldLastError holds the string denoting the last_error field in the database.
+	FieldLastError = "last_error"
+	// FieldRunCount holds the string denoting the run_count field in the database.
+	FieldRunCount = "run_count"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// EdgeOrganization holds the string denoting the organization edge name in mutations.
+	EdgeOrganization = "organization"
+	// EdgeSavedSearch holds the string denoting the saved_search edge name in mutations.
+	EdgeSavedSearch = "saved_search"
+	// Table holds the table name of the scheduledexport in the database.
+	Table = "scheduled_exports"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "scheduled_exports"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_id"
+	// OrganizationTable is the table that holds the organization relation/edge.
+	OrganizationTable = "scheduled_exports"
+	// OrganizationInverseTable is the table name for the Organization entity.
+	// It exists in this package in order to avoid circular dependency with the "organization" package.
+	OrganizationInverseTable = "organizations"
+	// OrganizationColumn is the table column denoting the organization relation/edge.
+	OrganizationColumn = "organization_id"
+	// SavedSearchTable is the table that holds the saved_search relation/edge.
+	SavedSearchTable = "scheduled_exports"
+	// SavedSearchInverseTable is the table name for the SavedSearch entity.
+	// It exists in this package in order to avoid circular dependency with the "savedsearch" package.
+	SavedSearchInverseTable = "saved_searches"
+	// SavedSearchColumn is the table column denoting the saved_search relation/edge.
+	SavedSearchColumn = "saved_search_id"
+)
+
+// Columns holds all SQL columns for scheduledexport fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldOrganizationID,
+	FieldName,
+	FieldFormat,
+	FieldColumns,
+	FieldSavedSearchID,
+	FieldFilters,
+	FieldMaxLeads,
+	FieldSinceLastExport,
+	FieldCadence,
+	FieldDeliveryMethod,
+	FieldDeliveryURL,
+	FieldDeliverySecret,
+	FieldStatus,
+	FieldNextRunAt,
+	FieldLastRunAt,
+	FieldLastExportID,
+	FieldLastError,
+	FieldRunCount,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	UserIDValidator func(int) error
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultMaxLeads holds the default value on creation for the "max_leads" field.
+	DefaultMaxLeads int
+	// MaxLeadsValidator is a validator for the "max_leads" field. It is called by the builders before save.
+	MaxLeadsValidator func(int) error
+	// DefaultSinceLastExport holds the default value on creation for the "since_last_export" field.
+	DefaultSinceLastExport bool
+	// CadenceValidator is a validator for the "cadence" field. It is called by the builders before save.
+	CadenceValidator func(string) error
+	// DeliveryURLValidator is a validator for the "delivery_url" field. It is called by the builders before save.
+	DeliveryURLValidator func(string) error
+	// LastErrorValidator is a validator for the "last_error" field. It is called by the builders before save.
+	LastErrorValidator func(string) error
+	// DefaultRunCount holds the default value on creation for the "run_count" field.
+	DefaultRunCount int
+	// RunCountValidator is a validator for the "run_count" field. It is called by the builders before save.
+	RunCountValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// Format defines the type for the "format" enum field.
+type Format string
+
+// Format values.
+const (
+	FormatCsv     Format = "csv"
+	FormatExcel   Format = "excel"
+	FormatVcard   Format = "vcard"
+	FormatGeojson Format = "geojson"
+	FormatKml     Format = "kml"
+)
+
+func (f Format) String() string {
+	return string(f)
+}
+
+// FormatValidator is a validator for the "format" field enum values. It is called by the builders before save.
+func FormatValidator(f Format) error {
+	switch f {
+	case FormatCsv, FormatExcel, FormatVcard, FormatGeojson, FormatKml:
+		return nil
+	default:
+		return fmt.Errorf("scheduledexport: invalid enum value for format field: %q", f)
+	}
+}
+
+// DeliveryMethod defines the type for the "delivery_method" enum field.
+type DeliveryMethod string
+
+// DeliveryMethodDownload is the default value of the DeliveryMethod enum.
+const DefaultDeliveryMethod = DeliveryMethodDownload
+
+// DeliveryMethod values.
+const (
+	DeliveryMethodDownload DeliveryMethod = "download"
+	DeliveryMethodURL      DeliveryMethod = "url"
+)
+
+func (dm DeliveryMethod) String() string {
+	return string(dm)
+}
+
+// DeliveryMethodValidator is a validator for the "delivery_method" field enum values. It is called by the builders before save.
+func DeliveryMethodValidator(dm DeliveryMethod) error {
+	switch dm {
+	case DeliveryMethodDownload, DeliveryMethodURL:
+		return nil
+	default:
+		return fmt.Errorf("scheduledexport: invalid enum value for delivery_method field: %q", dm)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusActive is the default value of the Status enum.
+const DefaultStatus = StatusActive
+
+// Status values.
+const (
+	StatusActive Status = "active"
+	StatusPaused Status = "paused"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusActive, StatusPaused:
+		return nil
+	default:
+		return fmt.Errorf("scheduledexport: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the ScheduledExport queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByOrganizationID orders the results by the organization_id field.
+func ByOrganizationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrganizationID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByFormat orders the results by the format field.
+func ByFormat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFormat, opts...).ToFunc()
+}
+
+// BySavedSearchID orders the results by the saved_search_id field.
+func BySavedSearchID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSavedSearchID, opts...).ToFunc()
+}
+
+// ByMaxLeads orders the results by the max_leads field.
+func ByMaxLeads(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxLeads, opts...).ToFunc()
+}
+
+// BySinceLastExport orders the results by the since_last_export field.
+func BySinceLastExport(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSinceLastExport, opts...).ToFunc()
+}
+
+// ByCadence orders the results by the cadence field.
+func ByCadence(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCadence, opts...).ToFunc()
+}
+
+// ByDeliveryMethod orders the results by the delivery_method field.
+func ByDeliveryMethod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveryMethod, opts...).ToFunc()
+}
+
+// ByDeliveryURL orders the results by the delivery_url field.
+func ByDeliveryURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveryURL, opts...).ToFunc()
+}
+
+// ByDeliverySecret orders the results by the delivery_secret field.
+func ByDeliverySecret(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliverySecret, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByNextRunAt orders the results by the next_run_at field.
+func ByNextRunAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextRunAt, opts...).ToFunc()
+}
+
+// ByLastRunAt orders the results by the last_run_at field.
+func ByLastRunAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastRunAt, opts...).ToFunc()
+}
+
+// ByLastExportID orders the results by the last_export_id field.
+func ByLastExportID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastExportID, opts...).ToFunc()
+}
+
+// ByLastError orders the results by the last_error field.
+func ByLastError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastError, opts...).ToFunc()
+}
+
+// ByRunCount orders the results by the run_count field.
+func ByRunCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRunCount, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByOrganizationField orders the results by organization field.
+func ByOrganizationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrganizationStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// BySavedSearchField orders the results by saved_search field.
+func BySavedSearchField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSavedSearchStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+	)
+}
+func newOrganizationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrganizationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, OrganizationTable, OrganizationColumn),
+	)
+}
+func newSavedSearchStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SavedSearchInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SavedSearchTable, SavedSearchColumn),
+	)
+}
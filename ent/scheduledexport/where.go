@@ -0,0 +1,1045 @@
+// Code generated by ent, DO NOT EDIT.
+
+package scheduledexport
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEQ(FieldUserID, v))
+}
+
+// OrganizationID applies equality check predicate on the "organization_id" field. It's identical to OrganizationIDEQ.
+func OrganizationID(v int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEQ(FieldName, v))
+}
+
+// SavedSearchID applies equality check predicate on the "saved_search_id" field. It's identical to SavedSearchIDEQ.
+func SavedSearchID(v int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEQ(FieldSavedSearchID, v))
+}
+
+// MaxLeads applies equality check predicate on the "max_leads" field. It's identical to MaxLeadsEQ.
+func MaxLeads(v int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEQ(FieldMaxLeads, v))
+}
+
+// SinceLastExport applies equality check predicate on the "since_last_export" field. It's identical to SinceLastExportEQ.
+func SinceLastExport(v bool) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEQ(FieldSinceLastExport, v))
+}
+
+// Cadence applies equality check predicate on the "cadence" field. It's identical to CadenceEQ.
+func Cadence(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEQ(FieldCadence, v))
+}
+
+// DeliveryURL applies equality check predicate on the "delivery_url" field. It's identical to DeliveryURLEQ.
+func DeliveryURL(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEQ(FieldDeliveryURL, v))
+}
+
+// DeliverySecret applies equality check predicate on the "delivery_secret" field. It's identical to DeliverySecretEQ.
+func DeliverySecret(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEQ(FieldDeliverySecret, v))
+}
+
+// NextRunAt applies equality check predicate on the "next_run_at" field. It's identical to NextRunAtEQ.
+func NextRunAt(v time.Time) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEQ(FieldNextRunAt, v))
+}
+
+// LastRunAt applies equality check predicate on the "last_run_at" field. It's identical to LastRunAtEQ.
+func LastRunAt(v time.Time) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEQ(FieldLastRunAt, v))
+}
+
+// LastExportID applies equality check predicate on the "last_export_id" field. It's identical to LastExportIDEQ.
+func LastExportID(v int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEQ(FieldLastExportID, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEQ(FieldLastError, v))
+}
+
+// RunCount applies equality check predicate on the "run_count" field. It's identical to RunCountEQ.
+func RunCount(v int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEQ(FieldRunCount, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// OrganizationIDEQ applies the EQ predicate on the "organization_id" field.
+func OrganizationIDEQ(v int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDNEQ applies the NEQ predicate on the "organization_id" field.
+func OrganizationIDNEQ(v int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDIn applies the In predicate on the "organization_id" field.
+func OrganizationIDIn(vs ...int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDNotIn applies the NotIn predicate on the "organization_id" field.
+func OrganizationIDNotIn(vs ...int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNotIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDIsNil applies the IsNil predicate on the "organization_id" field.
+func OrganizationIDIsNil() predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldIsNull(FieldOrganizationID))
+}
+
+// OrganizationIDNotNil applies the NotNil predicate on the "organization_id" field.
+func OrganizationIDNotNil() predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNotNull(FieldOrganizationID))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldContainsFold(FieldName, v))
+}
+
+// FormatEQ applies the EQ predicate on the "format" field.
+func FormatEQ(v Format) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEQ(FieldFormat, v))
+}
+
+// FormatNEQ applies the NEQ predicate on the "format" field.
+func FormatNEQ(v Format) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNEQ(FieldFormat, v))
+}
+
+// FormatIn applies the In predicate on the "format" field.
+func FormatIn(vs ...Format) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldIn(FieldFormat, vs...))
+}
+
+// FormatNotIn applies the NotIn predicate on the "format" field.
+func FormatNotIn(vs ...Format) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNotIn(FieldFormat, vs...))
+}
+
+// ColumnsIsNil applies the IsNil predicate on the "columns" field.
+func ColumnsIsNil() predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldIsNull(FieldColumns))
+}
+
+// ColumnsNotNil applies the NotNil predicate on the "columns" field.
+func ColumnsNotNil() predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNotNull(FieldColumns))
+}
+
+// SavedSearchIDEQ applies the EQ predicate on the "saved_search_id" field.
+func SavedSearchIDEQ(v int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEQ(FieldSavedSearchID, v))
+}
+
+// SavedSearchIDNEQ applies the NEQ predicate on the "saved_search_id" field.
+func SavedSearchIDNEQ(v int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNEQ(FieldSavedSearchID, v))
+}
+
+// SavedSearchIDIn applies the In predicate on the "saved_search_id" field.
+func SavedSearchIDIn(vs ...int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldIn(FieldSavedSearchID, vs...))
+}
+
+// SavedSearchIDNotIn applies the NotIn predicate on the "saved_search_id" field.
+func SavedSearchIDNotIn(vs ...int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNotIn(FieldSavedSearchID, vs...))
+}
+
+// SavedSearchIDIsNil applies the IsNil predicate on the "saved_search_id" field.
+func SavedSearchIDIsNil() predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldIsNull(FieldSavedSearchID))
+}
+
+// SavedSearchIDNotNil applies the NotNil predicate on the "saved_search_id" field.
+func SavedSearchIDNotNil() predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNotNull(FieldSavedSearchID))
+}
+
+// FiltersIsNil applies the IsNil predicate on the "filters" field.
+func FiltersIsNil() predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldIsNull(FieldFilters))
+}
+
+// FiltersNotNil applies the NotNil predicate on the "filters" field.
+func FiltersNotNil() predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNotNull(FieldFilters))
+}
+
+// MaxLeadsEQ applies the EQ predicate on the "max_leads" field.
+func MaxLeadsEQ(v int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEQ(FieldMaxLeads, v))
+}
+
+// MaxLeadsNEQ applies the NEQ predicate on the "max_leads" field.
+func MaxLeadsNEQ(v int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNEQ(FieldMaxLeads, v))
+}
+
+// MaxLeadsIn applies the In predicate on the "max_leads" field.
+func MaxLeadsIn(vs ...int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldIn(FieldMaxLeads, vs...))
+}
+
+// MaxLeadsNotIn applies the NotIn predicate on the "max_leads" field.
+func MaxLeadsNotIn(vs ...int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNotIn(FieldMaxLeads, vs...))
+}
+
+// MaxLeadsGT applies the GT predicate on the "max_leads" field.
+func MaxLeadsGT(v int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldGT(FieldMaxLeads, v))
+}
+
+// MaxLeadsGTE applies the GTE predicate on the "max_leads" field.
+func MaxLeadsGTE(v int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldGTE(FieldMaxLeads, v))
+}
+
+// MaxLeadsLT applies the LT predicate on the "max_leads" field.
+func MaxLeadsLT(v int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldLT(FieldMaxLeads, v))
+}
+
+// MaxLeadsLTE applies the LTE predicate on the "max_leads" field.
+func MaxLeadsLTE(v int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldLTE(FieldMaxLeads, v))
+}
+
+// SinceLastExportEQ applies the EQ predicate on the "since_last_export" field.
+func SinceLastExportEQ(v bool) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEQ(FieldSinceLastExport, v))
+}
+
+// SinceLastExportNEQ applies the NEQ predicate on the "since_last_export" field.
+func SinceLastExportNEQ(v bool) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNEQ(FieldSinceLastExport, v))
+}
+
+// CadenceEQ applies the EQ predicate on the "cadence" field.
+func CadenceEQ(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEQ(FieldCadence, v))
+}
+
+// CadenceNEQ applies the NEQ predicate on the "cadence" field.
+func CadenceNEQ(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNEQ(FieldCadence, v))
+}
+
+// CadenceIn applies the In predicate on the "cadence" field.
+func CadenceIn(vs ...string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldIn(FieldCadence, vs...))
+}
+
+// CadenceNotIn applies the NotIn predicate on the "cadence" field.
+func CadenceNotIn(vs ...string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNotIn(FieldCadence, vs...))
+}
+
+// CadenceGT applies the GT predicate on the "cadence" field.
+func CadenceGT(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldGT(FieldCadence, v))
+}
+
+// CadenceGTE applies the GTE predicate on the "cadence" field.
+func CadenceGTE(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldGTE(FieldCadence, v))
+}
+
+// CadenceLT applies the LT predicate on the "cadence" field.
+func CadenceLT(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldLT(FieldCadence, v))
+}
+
+// CadenceLTE applies the LTE predicate on the "cadence" field.
+func CadenceLTE(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldLTE(FieldCadence, v))
+}
+
+// CadenceContains applies the Contains predicate on the "cadence" field.
+func CadenceContains(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldContains(FieldCadence, v))
+}
+
+// CadenceHasPrefix applies the HasPrefix predicate on the "cadence" field.
+func CadenceHasPrefix(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldHasPrefix(FieldCadence, v))
+}
+
+// CadenceHasSuffix applies the HasSuffix predicate on the "cadence" field.
+func CadenceHasSuffix(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldHasSuffix(FieldCadence, v))
+}
+
+// CadenceEqualFold applies the EqualFold predicate on the "cadence" field.
+func CadenceEqualFold(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEqualFold(FieldCadence, v))
+}
+
+// CadenceContainsFold applies the ContainsFold predicate on the "cadence" field.
+func CadenceContainsFold(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldContainsFold(FieldCadence, v))
+}
+
+// DeliveryMethodEQ applies the EQ predicate on the "delivery_method" field.
+func DeliveryMethodEQ(v DeliveryMethod) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEQ(FieldDeliveryMethod, v))
+}
+
+// DeliveryMethodNEQ applies the NEQ predicate on the "delivery_method" field.
+func DeliveryMethodNEQ(v DeliveryMethod) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNEQ(FieldDeliveryMethod, v))
+}
+
+// DeliveryMethodIn applies the In predicate on the "delivery_method" field.
+func DeliveryMethodIn(vs ...DeliveryMethod) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldIn(FieldDeliveryMethod, vs...))
+}
+
+// DeliveryMethodNotIn applies the NotIn predicate on the "delivery_method" field.
+func DeliveryMethodNotIn(vs ...DeliveryMethod) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNotIn(FieldDeliveryMethod, vs...))
+}
+
+// DeliveryURLEQ applies the EQ predicate on the "delivery_url" field.
+func DeliveryURLEQ(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEQ(FieldDeliveryURL, v))
+}
+
+// DeliveryURLNEQ applies the NEQ predicate on the "delivery_url" field.
+func DeliveryURLNEQ(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNEQ(FieldDeliveryURL, v))
+}
+
+// DeliveryURLIn applies the In predicate on the "delivery_url" field.
+func DeliveryURLIn(vs ...string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldIn(FieldDeliveryURL, vs...))
+}
+
+// DeliveryURLNotIn applies the NotIn predicate on the "delivery_url" field.
+func DeliveryURLNotIn(vs ...string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNotIn(FieldDeliveryURL, vs...))
+}
+
+// DeliveryURLGT applies the GT predicate on the "delivery_url" field.
+func DeliveryURLGT(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldGT(FieldDeliveryURL, v))
+}
+
+// DeliveryURLGTE applies the GTE predicate on the "delivery_url" field.
+func DeliveryURLGTE(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldGTE(FieldDeliveryURL, v))
+}
+
+// DeliveryURLLT applies the LT predicate on the "delivery_url" field.
+func DeliveryURLLT(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldLT(FieldDeliveryURL, v))
+}
+
+// DeliveryURLLTE applies the LTE predicate on the "delivery_url" field.
+func DeliveryURLLTE(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldLTE(FieldDeliveryURL, v))
+}
+
+// DeliveryURLContains applies the Contains predicate on the "delivery_url" field.
+func DeliveryURLContains(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldContains(FieldDeliveryURL, v))
+}
+
+// DeliveryURLHasPrefix applies the HasPrefix predicate on the "delivery_url" field.
+func DeliveryURLHasPrefix(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldHasPrefix(FieldDeliveryURL, v))
+}
+
+// DeliveryURLHasSuffix applies the HasSuffix predicate on the "delivery_url" field.
+func DeliveryURLHasSuffix(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldHasSuffix(FieldDeliveryURL, v))
+}
+
+// DeliveryURLIsNil applies the IsNil predicate on the "delivery_url" field.
+func DeliveryURLIsNil() predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldIsNull(FieldDeliveryURL))
+}
+
+// DeliveryURLNotNil applies the NotNil predicate on the "delivery_url" field.
+func DeliveryURLNotNil() predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNotNull(FieldDeliveryURL))
+}
+
+// DeliveryURLEqualFold applies the EqualFold predicate on the "delivery_url" field.
+func DeliveryURLEqualFold(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEqualFold(FieldDeliveryURL, v))
+}
+
+// DeliveryURLContainsFold applies the ContainsFold predicate on the "delivery_url" field.
+func DeliveryURLContainsFold(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldContainsFold(FieldDeliveryURL, v))
+}
+
+// DeliverySecretEQ applies the EQ predicate on the "delivery_secret" field.
+func DeliverySecretEQ(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEQ(FieldDeliverySecret, v))
+}
+
+// DeliverySecretNEQ applies the NEQ predicate on the "delivery_secret" field.
+func DeliverySecretNEQ(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNEQ(FieldDeliverySecret, v))
+}
+
+// DeliverySecretIn applies the In predicate on the "delivery_secret" field.
+func DeliverySecretIn(vs ...string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldIn(FieldDeliverySecret, vs...))
+}
+
+// DeliverySecretNotIn applies the NotIn predicate on the "delivery_secret" field.
+func DeliverySecretNotIn(vs ...string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNotIn(FieldDeliverySecret, vs...))
+}
+
+// DeliverySecretGT applies the GT predicate on the "delivery_secret" field.
+func DeliverySecretGT(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldGT(FieldDeliverySecret, v))
+}
+
+// DeliverySecretGTE applies the GTE predicate on the "delivery_secret" field.
+func DeliverySecretGTE(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldGTE(FieldDeliverySecret, v))
+}
+
+// DeliverySecretLT applies the LT predicate on the "delivery_secret" field.
+func DeliverySecretLT(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldLT(FieldDeliverySecret, v))
+}
+
+// DeliverySecretLTE applies the LTE predicate on the "delivery_secret" field.
+func DeliverySecretLTE(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldLTE(FieldDeliverySecret, v))
+}
+
+// DeliverySecretContains applies the Contains predicate on the "delivery_secret" field.
+func DeliverySecretContains(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldContains(FieldDeliverySecret, v))
+}
+
+// DeliverySecretHasPrefix applies the HasPrefix predicate on the "delivery_secret" field.
+func DeliverySecretHasPrefix(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldHasPrefix(FieldDeliverySecret, v))
+}
+
+// DeliverySecretHasSuffix applies the HasSuffix predicate on the "delivery_secret" field.
+func DeliverySecretHasSuffix(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldHasSuffix(FieldDeliverySecret, v))
+}
+
+// DeliverySecretIsNil applies the IsNil predicate on the "delivery_secret" field.
+func DeliverySecretIsNil() predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldIsNull(FieldDeliverySecret))
+}
+
+// DeliverySecretNotNil applies the NotNil predicate on the "delivery_secret" field.
+func DeliverySecretNotNil() predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNotNull(FieldDeliverySecret))
+}
+
+// DeliverySecretEqualFold applies the EqualFold predicate on the "delivery_secret" field.
+func DeliverySecretEqualFold(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEqualFold(FieldDeliverySecret, v))
+}
+
+// DeliverySecretContainsFold applies the ContainsFold predicate on the "delivery_secret" field.
+func DeliverySecretContainsFold(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldContainsFold(FieldDeliverySecret, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// NextRunAtEQ applies the EQ predicate on the "next_run_at" field.
+func NextRunAtEQ(v time.Time) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEQ(FieldNextRunAt, v))
+}
+
+// NextRunAtNEQ applies the NEQ predicate on the "next_run_at" field.
+func NextRunAtNEQ(v time.Time) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNEQ(FieldNextRunAt, v))
+}
+
+// NextRunAtIn applies the In predicate on the "next_run_at" field.
+func NextRunAtIn(vs ...time.Time) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldIn(FieldNextRunAt, vs...))
+}
+
+// NextRunAtNotIn applies the NotIn predicate on the "next_run_at" field.
+func NextRunAtNotIn(vs ...time.Time) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNotIn(FieldNextRunAt, vs...))
+}
+
+// NextRunAtGT applies the GT predicate on the "next_run_at" field.
+func NextRunAtGT(v time.Time) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldGT(FieldNextRunAt, v))
+}
+
+// NextRunAtGTE applies the GTE predicate on the "next_run_at" field.
+func NextRunAtGTE(v time.Time) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldGTE(FieldNextRunAt, v))
+}
+
+// NextRunAtLT applies the LT predicate on the "next_run_at" field.
+func NextRunAtLT(v time.Time) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldLT(FieldNextRunAt, v))
+}
+
+// NextRunAtLTE applies the LTE predicate on the "next_run_at" field.
+func NextRunAtLTE(v time.Time) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldLTE(FieldNextRunAt, v))
+}
+
+// LastRunAtEQ applies the EQ predicate on the "last_run_at" field.
+func LastRunAtEQ(v time.Time) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEQ(FieldLastRunAt, v))
+}
+
+// LastRunAtNEQ applies the NEQ predicate on the "last_run_at" field.
+func LastRunAtNEQ(v time.Time) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNEQ(FieldLastRunAt, v))
+}
+
+// LastRunAtIn applies the In predicate on the "last_run_at" field.
+func LastRunAtIn(vs ...time.Time) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldIn(FieldLastRunAt, vs...))
+}
+
+// LastRunAtNotIn applies the NotIn predicate on the "last_run_at" field.
+func LastRunAtNotIn(vs ...time.Time) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNotIn(FieldLastRunAt, vs...))
+}
+
+// LastRunAtGT applies the GT predicate on the "last_run_at" field.
+func LastRunAtGT(v time.Time) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldGT(FieldLastRunAt, v))
+}
+
+// LastRunAtGTE applies the GTE predicate on the "last_run_at" field.
+func LastRunAtGTE(v time.Time) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldGTE(FieldLastRunAt, v))
+}
+
+// LastRunAtLT applies the LT predicate on the "last_run_at" field.
+func LastRunAtLT(v time.Time) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldLT(FieldLastRunAt, v))
+}
+
+// LastRunAtLTE applies the LTE predicate on the "last_run_at" field.
+func LastRunAtLTE(v time.Time) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldLTE(FieldLastRunAt, v))
+}
+
+// LastRunAtIsNil applies the IsNil predicate on the "last_run_at" field.
+func LastRunAtIsNil() predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldIsNull(FieldLastRunAt))
+}
+
+// LastRunAtNotNil applies the NotNil predicate on the "last_run_at" field.
+func LastRunAtNotNil() predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNotNull(FieldLastRunAt))
+}
+
+// LastExportIDEQ applies the EQ predicate on the "last_export_id" field.
+func LastExportIDEQ(v int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEQ(FieldLastExportID, v))
+}
+
+// LastExportIDNEQ applies the NEQ predicate on the "last_export_id" field.
+func LastExportIDNEQ(v int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNEQ(FieldLastExportID, v))
+}
+
+// LastExportIDIn applies the In predicate on the "last_export_id" field.
+func LastExportIDIn(vs ...int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldIn(FieldLastExportID, vs...))
+}
+
+// LastExportIDNotIn applies the NotIn predicate on the "last_export_id" field.
+func LastExportIDNotIn(vs ...int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNotIn(FieldLastExportID, vs...))
+}
+
+// LastExportIDGT applies the GT predicate on the "last_export_id" field.
+func LastExportIDGT(v int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldGT(FieldLastExportID, v))
+}
+
+// LastExportIDGTE applies the GTE predicate on the "last_export_id" field.
+func LastExportIDGTE(v int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldGTE(FieldLastExportID, v))
+}
+
+// LastExportIDLT applies the LT predicate on the "last_export_id" field.
+func LastExportIDLT(v int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldLT(FieldLastExportID, v))
+}
+
+// LastExportIDLTE applies the LTE predicate on the "last_export_id" field.
+func LastExportIDLTE(v int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldLTE(FieldLastExportID, v))
+}
+
+// LastExportIDIsNil applies the IsNil predicate on the "last_export_id" field.
+func LastExportIDIsNil() predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldIsNull(FieldLastExportID))
+}
+
+// LastExportIDNotNil applies the NotNil predicate on the "last_export_id" field.
+func LastExportIDNotNil() predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNotNull(FieldLastExportID))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// RunCountEQ applies the EQ predicate on the "run_count" field.
+func RunCountEQ(v int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEQ(FieldRunCount, v))
+}
+
+// RunCountNEQ applies the NEQ predicate on the "run_count" field.
+func RunCountNEQ(v int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNEQ(FieldRunCount, v))
+}
+
+// RunCountIn applies the In predicate on the "run_count" field.
+func RunCountIn(vs ...int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldIn(FieldRunCount, vs...))
+}
+
+// RunCountNotIn applies the NotIn predicate on the "run_count" field.
+func RunCountNotIn(vs ...int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNotIn(FieldRunCount, vs...))
+}
+
+// RunCountGT applies the GT predicate on the "run_count" field.
+func RunCountGT(v int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldGT(FieldRunCount, v))
+}
+
+// RunCountGTE applies the GTE predicate on the "run_count" field.
+func RunCountGTE(v int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldGTE(FieldRunCount, v))
+}
+
+// RunCountLT applies the LT predicate on the "run_count" field.
+func RunCountLT(v int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldLT(FieldRunCount, v))
+}
+
+// RunCountLTE applies the LTE predicate on the "run_count" field.
+func RunCountLTE(v int) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldLTE(FieldRunCount, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.ScheduledExport {
+	return predicate.ScheduledExport(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.ScheduledExport {
+	return predicate.ScheduledExport(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOrganization applies the HasEdge predicate on the "organization" edge.
+func HasOrganization() predicate.ScheduledExport {
+	return predicate.ScheduledExport(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrganizationWith applies the HasEdge predicate on the "organization" edge with a given conditions (other predicates).
+func HasOrganizationWith(preds ...predicate.Organization) predicate.ScheduledExport {
+	return predicate.ScheduledExport(func(s *sql.Selector) {
+		step := newOrganizationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSavedSearch applies the HasEdge predicate on the "saved_search" edge.
+func HasSavedSearch() predicate.ScheduledExport {
+	return predicate.ScheduledExport(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SavedSearchTable, SavedSearchColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSavedSearchWith applies the HasEdge predicate on the "saved_search" edge with a given conditions (other predicates).
+func HasSavedSearchWith(preds ...predicate.SavedSearch) predicate.ScheduledExport {
+	return predicate.ScheduledExport(func(s *sql.Selector) {
+		step := newSavedSearchStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ScheduledExport) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ScheduledExport) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ScheduledExport) predicate.ScheduledExport {
+	return predicate.ScheduledExport(sql.NotPredicates(p))
+}
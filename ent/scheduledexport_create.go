@@ -0,0 +1,673 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/organization"
+	"github.com/jordanlanch/industrydb/ent/savedsearch"
+	"github.com/jordanlanch/industrydb/ent/scheduledexport"
+	"github.com/jordanlanch/industrydb/ent/user"
+)
+
+// ScheduledExportCreate is the builder for creating a ScheduledExport entity.
+type ScheduledExportCreate struct {
+	config
+	mutation *ScheduledExportMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *ScheduledExportCreate) SetUserID(v int) *ScheduledExportCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_c *ScheduledExportCreate) SetOrganizationID(v int) *ScheduledExportCreate {
+	_c.mutation.SetOrganizationID(v)
+	return _c
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_c *ScheduledExportCreate) SetNillableOrganizationID(v *int) *ScheduledExportCreate {
+	if v != nil {
+		_c.SetOrganizationID(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *ScheduledExportCreate) SetName(v string) *ScheduledExportCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetFormat sets the "format" field.
+func (_c *ScheduledExportCreate) SetFormat(v scheduledexport.Format) *ScheduledExportCreate {
+	_c.mutation.SetFormat(v)
+	return _c
+}
+
+// SetColumns sets the "columns" field.
+func (_c *ScheduledExportCreate) SetColumns(v []string) *ScheduledExportCreate {
+	_c.mutation.SetColumns(v)
+	return _c
+}
+
+// SetSavedSearchID sets the "saved_search_id" field.
+func (_c *ScheduledExportCreate) SetSavedSearchID(v int) *ScheduledExportCreate {
+	_c.mutation.SetSavedSearchID(v)
+	return _c
+}
+
+// SetNillableSavedSearchID sets the "saved_search_id" field if the given value is not nil.
+func (_c *ScheduledExportCreate) SetNillableSavedSearchID(v *int) *ScheduledExportCreate {
+	if v != nil {
+		_c.SetSavedSearchID(*v)
+	}
+	return _c
+}
+
+// SetFilters sets the "filters" field.
+func (_c *ScheduledExportCreate) SetFilters(v map[string]interface{}) *ScheduledExportCreate {
+	_c.mutation.SetFilters(v)
+	return _c
+}
+
+// SetMaxLeads sets the "max_leads" field.
+func (_c *ScheduledExportCreate) SetMaxLeads(v int) *ScheduledExportCreate {
+	_c.mutation.SetMaxLeads(v)
+	return _c
+}
+
+// SetNillableMaxLeads sets the "max_leads" field if the given value is not nil.
+func (_c *ScheduledExportCreate) SetNillableMaxLeads(v *int) *ScheduledExportCreate {
+	if v != nil {
+		_c.SetMaxLeads(*v)
+	}
+	return _c
+}
+
+// SetSinceLastExport sets the "since_last_export" field.
+func (_c *ScheduledExportCreate) SetSinceLastExport(v bool) *ScheduledExportCreate {
+	_c.mutation.SetSinceLastExport(v)
+	return _c
+}
+
+// SetNillableSinceLastExport sets the "since_last_export" field if the given value is not nil.
+func (_c *ScheduledExportCreate) SetNillableSinceLastExport(v *bool) *ScheduledExportCreate {
+	if v != nil {
+		_c.SetSinceLastExport(*v)
+	}
+	return _c
+}
+
+// SetCadence sets the "cadence" field.
+func (_c *ScheduledExportCreate) SetCadence(v string) *ScheduledExportCreate {
+	_c.mutation.SetCadence(v)
+	return _c
+}
+
+// SetDeliveryMethod sets the "delivery_method" field.
+func (_c *ScheduledExportCreate) SetDeliveryMethod(v scheduledexport.DeliveryMethod) *ScheduledExportCreate {
+	_c.mutation.SetDeliveryMethod(v)
+	return _c
+}
+
+// SetNillableDeliveryMethod sets the "delivery_method" field if the given value is not nil.
+func (_c *ScheduledExportCreate) SetNillableDeliveryMethod(v *scheduledexport.DeliveryMethod) *ScheduledExportCreate {
+	if v != nil {
+		_c.SetDeliveryMethod(*v)
+	}
+	return _c
+}
+
+// SetDeliveryURL sets the "delivery_url" field.
+func (_c *ScheduledExportCreate) SetDeliveryURL(v string) *ScheduledExportCreate {
+	_c.mutation.SetDeliveryURL(v)
+	return _c
+}
+
+// SetNillableDeliveryURL sets the "delivery_url" field if the given value is not nil.
+func (_c *ScheduledExportCreate) SetNillableDeliveryURL(v *string) *ScheduledExportCreate {
+	if v != nil {
+		_c.SetDeliveryURL(*v)
+	}
+	return _c
+}
+
+// SetDeliverySecret sets the "delivery_secret" field.
+func (_c *ScheduledExportCreate) SetDeliverySecret(v string) *ScheduledExportCreate {
+	_c.mutation.SetDeliverySecret(v)
+	return _c
+}
+
+// SetNillableDeliverySecret sets the "delivery_secret" field if the given value is not nil.
+func (_c *ScheduledExportCreate) SetNillableDeliverySecret(v *string) *ScheduledExportCreate {
+	if v != nil {
+		_c.SetDeliverySecret(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ScheduledExportCreate) SetStatus(v scheduledexport.Status) *ScheduledExportCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ScheduledExportCreate) SetNillableStatus(v *scheduledexport.Status) *ScheduledExportCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (_c *ScheduledExportCreate) SetNextRunAt(v time.Time) *ScheduledExportCreate {
+	_c.mutation.SetNextRunAt(v)
+	return _c
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (_c *ScheduledExportCreate) SetLastRunAt(v time.Time) *ScheduledExportCreate {
+	_c.mutation.SetLastRunAt(v)
+	return _c
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (_c *ScheduledExportCreate) SetNillableLastRunAt(v *time.Time) *ScheduledExportCreate {
+	if v != nil {
+		_c.SetLastRunAt(*v)
+	}
+	return _c
+}
+
+// SetLastExportID sets the "last_export_id" field.
+func (_c *ScheduledExportCreate) SetLastExportID(v int) *ScheduledExportCreate {
+	_c.mutation.SetLastExportID(v)
+	return _c
+}
+
+// SetNillableLastExportID sets the "last_export_id" field if the given value is not nil.
+func (_c *ScheduledExportCreate) SetNillableLastExportID(v *int) *ScheduledExportCreate {
+	if v != nil {
+		_c.SetLastExportID(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *ScheduledExportCreate) SetLastError(v string) *ScheduledExportCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *ScheduledExportCreate) SetNillableLastError(v *string) *ScheduledExportCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetRunCount sets the "run_count" field.
+func (_c *ScheduledExportCreate) SetRunCount(v int) *ScheduledExportCreate {
+	_c.mutation.SetRunCount(v)
+	return _c
+}
+
+// SetNillableRunCount sets the "run_count" field if the given value is not nil.
+func (_c *ScheduledExportCreate) SetNillableRunCount(v *int) *ScheduledExportCreate {
+	if v != nil {
+		_c.SetRunCount(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ScheduledExportCreate) SetCreatedAt(v time.Time) *ScheduledExportCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ScheduledExportCreate) SetNillableCreatedAt(v *time.Time) *ScheduledExportCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ScheduledExportCreate) SetUpdatedAt(v time.Time) *ScheduledExportCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ScheduledExportCreate) SetNillableUpdatedAt(v *time.Time) *ScheduledExportCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *ScheduledExportCreate) SetUser(v *User) *ScheduledExportCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_c *ScheduledExportCreate) SetOrganization(v *Organization) *ScheduledExportCreate {
+	return _c.SetOrganizationID(v.ID)
+}
+
+// SetSavedSearch sets the "saved_search" edge to the SavedSearch entity.
+func (_c *ScheduledExportCreate) SetSavedSearch(v *SavedSearch) *ScheduledExportCreate {
+	return _c.SetSavedSearchID(v.ID)
+}
+
+// Mutation returns the ScheduledExportMutation object of the builder.
+func (_c *ScheduledExportCreate) Mutation() *ScheduledExportMutation {
+	return _c.mutation
+}
+
+// Save creates the ScheduledExport in the database.
+func (_c *ScheduledExportCreate) Save(ctx context.Context) (*ScheduledExport, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ScheduledExportCreate) SaveX(ctx context.Context) *ScheduledExport {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScheduledExportCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScheduledExportCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ScheduledExportCreate) defaults() {
+	if _, ok := _c.mutation.MaxLeads(); !ok {
+		v := scheduledexport.DefaultMaxLeads
+		_c.mutation.SetMaxLeads(v)
+	}
+	if _, ok := _c.mutation.SinceLastExport(); !ok {
+		v := scheduledexport.DefaultSinceLastExport
+		_c.mutation.SetSinceLastExport(v)
+	}
+	if _, ok := _c.mutation.DeliveryMethod(); !ok {
+		v := scheduledexport.DefaultDeliveryMethod
+		_c.mutation.SetDeliveryMethod(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := scheduledexport.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.RunCount(); !ok {
+		v := scheduledexport.DefaultRunCount
+		_c.mutation.SetRunCount(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := scheduledexport.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := scheduledexport.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ScheduledExportCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ScheduledExport.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := scheduledexport.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "ScheduledExport.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ScheduledExport.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := scheduledexport.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ScheduledExport.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Format(); !ok {
+		return &ValidationError{Name: "format", err: errors.New(`ent: missing required field "ScheduledExport.format"`)}
+	}
+	if v, ok := _c.mutation.Format(); ok {
+		if err := scheduledexport.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "ScheduledExport.format": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MaxLeads(); !ok {
+		return &ValidationError{Name: "max_leads", err: errors.New(`ent: missing required field "ScheduledExport.max_leads"`)}
+	}
+	if v, ok := _c.mutation.MaxLeads(); ok {
+		if err := scheduledexport.MaxLeadsValidator(v); err != nil {
+			return &ValidationError{Name: "max_leads", err: fmt.Errorf(`ent: validator failed for field "ScheduledExport.max_leads": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SinceLastExport(); !ok {
+		return &ValidationError{Name: "since_last_export", err: errors.New(`ent: missing required field "ScheduledExport.since_last_export"`)}
+	}
+	if _, ok := _c.mutation.Cadence(); !ok {
+		return &ValidationError{Name: "cadence", err: errors.New(`ent: missing required field "ScheduledExport.cadence"`)}
+	}
+	if v, ok := _c.mutation.Cadence(); ok {
+		if err := scheduledexport.CadenceValidator(v); err != nil {
+			return &ValidationError{Name: "cadence", err: fmt.Errorf(`ent: validator failed for field "ScheduledExport.cadence": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DeliveryMethod(); !ok {
+		return &ValidationError{Name: "delivery_method", err: errors.New(`ent: missing required field "ScheduledExport.delivery_method"`)}
+	}
+	if v, ok := _c.mutation.DeliveryMethod(); ok {
+		if err := scheduledexport.DeliveryMethodValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_method", err: fmt.Errorf(`ent: validator failed for field "ScheduledExport.delivery_method": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DeliveryURL(); ok {
+		if err := scheduledexport.DeliveryURLValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_url", err: fmt.Errorf(`ent: validator failed for field "ScheduledExport.delivery_url": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ScheduledExport.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := scheduledexport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ScheduledExport.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.NextRunAt(); !ok {
+		return &ValidationError{Name: "next_run_at", err: errors.New(`ent: missing required field "ScheduledExport.next_run_at"`)}
+	}
+	if v, ok := _c.mutation.LastError(); ok {
+		if err := scheduledexport.LastErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_error", err: fmt.Errorf(`ent: validator failed for field "ScheduledExport.last_error": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RunCount(); !ok {
+		return &ValidationError{Name: "run_count", err: errors.New(`ent: missing required field "ScheduledExport.run_count"`)}
+	}
+	if v, ok := _c.mutation.RunCount(); ok {
+		if err := scheduledexport.RunCountValidator(v); err != nil {
+			return &ValidationError{Name: "run_count", err: fmt.Errorf(`ent: validator failed for field "ScheduledExport.run_count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ScheduledExport.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ScheduledExport.updated_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "ScheduledExport.user"`)}
+	}
+	return nil
+}
+
+func (_c *ScheduledExportCreate) sqlSave(ctx context.Context) (*ScheduledExport, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ScheduledExportCreate) createSpec() (*ScheduledExport, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ScheduledExport{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(scheduledexport.Table, sqlgraph.NewFieldSpec(scheduledexport.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(scheduledexport.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Format(); ok {
+		_spec.SetField(scheduledexport.FieldFormat, field.TypeEnum, value)
+		_node.Format = value
+	}
+	if value, ok := _c.mutation.Columns(); ok {
+		_spec.SetField(scheduledexport.FieldColumns, field.TypeJSON, value)
+		_node.Columns = value
+	}
+	if value, ok := _c.mutation.Filters(); ok {
+		_spec.SetField(scheduledexport.FieldFilters, field.TypeJSON, value)
+		_node.Filters = value
+	}
+	if value, ok := _c.mutation.MaxLeads(); ok {
+		_spec.SetField(scheduledexport.FieldMaxLeads, field.TypeInt, value)
+		_node.MaxLeads = value
+	}
+	if value, ok := _c.mutation.SinceLastExport(); ok {
+		_spec.SetField(scheduledexport.FieldSinceLastExport, field.TypeBool, value)
+		_node.SinceLastExport = value
+	}
+	if value, ok := _c.mutation.Cadence(); ok {
+		_spec.SetField(scheduledexport.FieldCadence, field.TypeString, value)
+		_node.Cadence = value
+	}
+	if value, ok := _c.mutation.DeliveryMethod(); ok {
+		_spec.SetField(scheduledexport.FieldDeliveryMethod, field.TypeEnum, value)
+		_node.DeliveryMethod = value
+	}
+	if value, ok := _c.mutation.DeliveryURL(); ok {
+		_spec.SetField(scheduledexport.FieldDeliveryURL, field.TypeString, value)
+		_node.DeliveryURL = value
+	}
+	if value, ok := _c.mutation.DeliverySecret(); ok {
+		_spec.SetField(scheduledexport.FieldDeliverySecret, field.TypeString, value)
+		_node.DeliverySecret = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(scheduledexport.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.NextRunAt(); ok {
+		_spec.SetField(scheduledexport.FieldNextRunAt, field.TypeTime, value)
+		_node.NextRunAt = value
+	}
+	if value, ok := _c.mutation.LastRunAt(); ok {
+		_spec.SetField(scheduledexport.FieldLastRunAt, field.TypeTime, value)
+		_node.LastRunAt = &value
+	}
+	if value, ok := _c.mutation.LastExportID(); ok {
+		_spec.SetField(scheduledexport.FieldLastExportID, field.TypeInt, value)
+		_node.LastExportID = &value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(scheduledexport.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := _c.mutation.RunCount(); ok {
+		_spec.SetField(scheduledexport.FieldRunCount, field.TypeInt, value)
+		_node.RunCount = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(scheduledexport.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(scheduledexport.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduledexport.UserTable,
+			Columns: []string{scheduledexport.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduledexport.OrganizationTable,
+			Columns: []string{scheduledexport.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrganizationID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SavedSearchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduledexport.SavedSearchTable,
+			Columns: []string{scheduledexport.SavedSearchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SavedSearchID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ScheduledExportCreateBulk is the builder for creating many ScheduledExport entities in bulk.
+type ScheduledExportCreateBulk struct {
+	config
+	err      error
+	builders []*ScheduledExportCreate
+}
+
+// Save creates the ScheduledExport entities in the database.
+func (_c *ScheduledExportCreateBulk) Save(ctx context.Context) ([]*ScheduledExport, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ScheduledExport, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScheduledExportMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ScheduledExportCreateBulk) SaveX(ctx context.Context) []*ScheduledExport {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScheduledExportCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScheduledExportCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
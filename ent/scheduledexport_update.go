@@ -0,0 +1,1422 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/organization"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+	"github.com/jordanlanch/industrydb/ent/savedsearch"
+	"github.com/jordanlanch/industrydb/ent/scheduledexport"
+	"github.com/jordanlanch/industrydb/ent/user"
+)
+
+// ScheduledExportUpdate is the builder for updating ScheduledExport entities.
+type ScheduledExportUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ScheduledExportMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ScheduledExportUpdate builder.
+func (_u *ScheduledExportUpdate) Where(ps ...predicate.ScheduledExport) *ScheduledExportUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ScheduledExportUpdate) SetUserID(v int) *ScheduledExportUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ScheduledExportUpdate) SetNillableUserID(v *int) *ScheduledExportUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_u *ScheduledExportUpdate) SetOrganizationID(v int) *ScheduledExportUpdate {
+	_u.mutation.SetOrganizationID(v)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_u *ScheduledExportUpdate) SetNillableOrganizationID(v *int) *ScheduledExportUpdate {
+	if v != nil {
+		_u.SetOrganizationID(*v)
+	}
+	return _u
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (_u *ScheduledExportUpdate) ClearOrganizationID() *ScheduledExportUpdate {
+	_u.mutation.ClearOrganizationID()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ScheduledExportUpdate) SetName(v string) *ScheduledExportUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ScheduledExportUpdate) SetNillableName(v *string) *ScheduledExportUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetFormat sets the "format" field.
+func (_u *ScheduledExportUpdate) SetFormat(v scheduledexport.Format) *ScheduledExportUpdate {
+	_u.mutation.SetFormat(v)
+	return _u
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_u *ScheduledExportUpdate) SetNillableFormat(v *scheduledexport.Format) *ScheduledExportUpdate {
+	if v != nil {
+		_u.SetFormat(*v)
+	}
+	return _u
+}
+
+// SetColumns sets the "columns" field.
+func (_u *ScheduledExportUpdate) SetColumns(v []string) *ScheduledExportUpdate {
+	_u.mutation.SetColumns(v)
+	return _u
+}
+
+// AppendColumns appends value to the "columns" field.
+func (_u *ScheduledExportUpdate) AppendColumns(v []string) *ScheduledExportUpdate {
+	_u.mutation.AppendColumns(v)
+	return _u
+}
+
+// ClearColumns clears the value of the "columns" field.
+func (_u *ScheduledExportUpdate) ClearColumns() *ScheduledExportUpdate {
+	_u.mutation.ClearColumns()
+	return _u
+}
+
+// SetSavedSearchID sets the "saved_search_id" field.
+func (_u *ScheduledExportUpdate) SetSavedSearchID(v int) *ScheduledExportUpdate {
+	_u.mutation.SetSavedSearchID(v)
+	return _u
+}
+
+// SetNillableSavedSearchID sets the "saved_search_id" field if the given value is not nil.
+func (_u *ScheduledExportUpdate) SetNillableSavedSearchID(v *int) *ScheduledExportUpdate {
+	if v != nil {
+		_u.SetSavedSearchID(*v)
+	}
+	return _u
+}
+
+// ClearSavedSearchID clears the value of the "saved_search_id" field.
+func (_u *ScheduledExportUpdate) ClearSavedSearchID() *ScheduledExportUpdate {
+	_u.mutation.ClearSavedSearchID()
+	return _u
+}
+
+// SetFilters sets the "filters" field.
+func (_u *ScheduledExportUpdate) SetFilters(v map[string]interface{}) *ScheduledExportUpdate {
+	_u.mutation.SetFilters(v)
+	return _u
+}
+
+// ClearFilters clears the value of the "filters" field.
+func (_u *ScheduledExportUpdate) ClearFilters() *ScheduledExportUpdate {
+	_u.mutation.ClearFilters()
+	return _u
+}
+
+// SetMaxLeads sets the "max_leads" field.
+func (_u *ScheduledExportUpdate) SetMaxLeads(v int) *ScheduledExportUpdate {
+	_u.mutation.ResetMaxLeads()
+	_u.mutation.SetMaxLeads(v)
+	return _u
+}
+
+// SetNillableMaxLeads sets the "max_leads" field if the given value is not nil.
+func (_u *ScheduledExportUpdate) SetNillableMaxLeads(v *int) *ScheduledExportUpdate {
+	if v != nil {
+		_u.SetMaxLeads(*v)
+	}
+	return _u
+}
+
+// AddMaxLeads adds value to the "max_leads" field.
+func (_u *ScheduledExportUpdate) AddMaxLeads(v int) *ScheduledExportUpdate {
+	_u.mutation.AddMaxLeads(v)
+	return _u
+}
+
+// SetSinceLastExport sets the "since_last_export" field.
+func (_u *ScheduledExportUpdate) SetSinceLastExport(v bool) *ScheduledExportUpdate {
+	_u.mutation.SetSinceLastExport(v)
+	return _u
+}
+
+// SetNillableSinceLastExport sets the "since_last_export" field if the given value is not nil.
+func (_u *ScheduledExportUpdate) SetNillableSinceLastExport(v *bool) *ScheduledExportUpdate {
+	if v != nil {
+		_u.SetSinceLastExport(*v)
+	}
+	return _u
+}
+
+// SetCadence sets the "cadence" field.
+func (_u *ScheduledExportUpdate) SetCadence(v string) *ScheduledExportUpdate {
+	_u.mutation.SetCadence(v)
+	return _u
+}
+
+// SetNillableCadence sets the "cadence" field if the given value is not nil.
+func (_u *ScheduledExportUpdate) SetNillableCadence(v *string) *ScheduledExportUpdate {
+	if v != nil {
+		_u.SetCadence(*v)
+	}
+	return _u
+}
+
+// SetDeliveryMethod sets the "delivery_method" field.
+func (_u *ScheduledExportUpdate) SetDeliveryMethod(v scheduledexport.DeliveryMethod) *ScheduledExportUpdate {
+	_u.mutation.SetDeliveryMethod(v)
+	return _u
+}
+
+// SetNillableDeliveryMethod sets the "delivery_method" field if the given value is not nil.
+func (_u *ScheduledExportUpdate) SetNillableDeliveryMethod(v *scheduledexport.DeliveryMethod) *ScheduledExportUpdate {
+	if v != nil {
+		_u.SetDeliveryMethod(*v)
+	}
+	return _u
+}
+
+// SetDeliveryURL sets the "delivery_url" field.
+func (_u *ScheduledExportUpdate) SetDeliveryURL(v string) *ScheduledExportUpdate {
+	_u.mutation.SetDeliveryURL(v)
+	return _u
+}
+
+// SetNillableDeliveryURL sets the "delivery_url" field if the given value is not nil.
+func (_u *ScheduledExportUpdate) SetNillableDeliveryURL(v *string) *ScheduledExportUpdate {
+	if v != nil {
+		_u.SetDeliveryURL(*v)
+	}
+	return _u
+}
+
+// ClearDeliveryURL clears the value of the "delivery_url" field.
+func (_u *ScheduledExportUpdate) ClearDeliveryURL() *ScheduledExportUpdate {
+	_u.mutation.ClearDeliveryURL()
+	return _u
+}
+
+// SetDeliverySecret sets the "delivery_secret" field.
+func (_u *ScheduledExportUpdate) SetDeliverySecret(v string) *ScheduledExportUpdate {
+	_u.mutation.SetDeliverySecret(v)
+	return _u
+}
+
+// SetNillableDeliverySecret sets the "delivery_secret" field if the given value is not nil.
+func (_u *ScheduledExportUpdate) SetNillableDeliverySecret(v *string) *ScheduledExportUpdate {
+	if v != nil {
+		_u.SetDeliverySecret(*v)
+	}
+	return _u
+}
+
+// ClearDeliverySecret clears the value of the "delivery_secret" field.
+func (_u *ScheduledExportUpdate) ClearDeliverySecret() *ScheduledExportUpdate {
+	_u.mutation.ClearDeliverySecret()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ScheduledExportUpdate) SetStatus(v scheduledexport.Status) *ScheduledExportUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ScheduledExportUpdate) SetNillableStatus(v *scheduledexport.Status) *ScheduledExportUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (_u *ScheduledExportUpdate) SetNextRunAt(v time.Time) *ScheduledExportUpdate {
+	_u.mutation.SetNextRunAt(v)
+	return _u
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (_u *ScheduledExportUpdate) SetNillableNextRunAt(v *time.Time) *ScheduledExportUpdate {
+	if v != nil {
+		_u.SetNextRunAt(*v)
+	}
+	return _u
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (_u *ScheduledExportUpdate) SetLastRunAt(v time.Time) *ScheduledExportUpdate {
+	_u.mutation.SetLastRunAt(v)
+	return _u
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (_u *ScheduledExportUpdate) SetNillableLastRunAt(v *time.Time) *ScheduledExportUpdate {
+	if v != nil {
+		_u.SetLastRunAt(*v)
+	}
+	return _u
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (_u *ScheduledExportUpdate) ClearLastRunAt() *ScheduledExportUpdate {
+	_u.mutation.ClearLastRunAt()
+	return _u
+}
+
+// SetLastExportID sets the "last_export_id" field.
+func (_u *ScheduledExportUpdate) SetLastExportID(v int) *ScheduledExportUpdate {
+	_u.mutation.ResetLastExportID()
+	_u.mutation.SetLastExportID(v)
+	return _u
+}
+
+// SetNillableLastExportID sets the "last_export_id" field if the given value is not nil.
+func (_u *ScheduledExportUpdate) SetNillableLastExportID(v *int) *ScheduledExportUpdate {
+	if v != nil {
+		_u.SetLastExportID(*v)
+	}
+	return _u
+}
+
+// AddLastExportID adds value to the "last_export_id" field.
+func (_u *ScheduledExportUpdate) AddLastExportID(v int) *ScheduledExportUpdate {
+	_u.mutation.AddLastExportID(v)
+	return _u
+}
+
+// ClearLastExportID clears the value of the "last_export_id" field.
+func (_u *ScheduledExportUpdate) ClearLastExportID() *ScheduledExportUpdate {
+	_u.mutation.ClearLastExportID()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *ScheduledExportUpdate) SetLastError(v string) *ScheduledExportUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *ScheduledExportUpdate) SetNillableLastError(v *string) *ScheduledExportUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *ScheduledExportUpdate) ClearLastError() *ScheduledExportUpdate {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetRunCount sets the "run_count" field.
+func (_u *ScheduledExportUpdate) SetRunCount(v int) *ScheduledExportUpdate {
+	_u.mutation.ResetRunCount()
+	_u.mutation.SetRunCount(v)
+	return _u
+}
+
+// SetNillableRunCount sets the "run_count" field if the given value is not nil.
+func (_u *ScheduledExportUpdate) SetNillableRunCount(v *int) *ScheduledExportUpdate {
+	if v != nil {
+		_u.SetRunCount(*v)
+	}
+	return _u
+}
+
+// AddRunCount adds value to the "run_count" field.
+func (_u *ScheduledExportUpdate) AddRunCount(v int) *ScheduledExportUpdate {
+	_u.mutation.AddRunCount(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ScheduledExportUpdate) SetUpdatedAt(v time.Time) *ScheduledExportUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *ScheduledExportUpdate) SetUser(v *User) *ScheduledExportUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_u *ScheduledExportUpdate) SetOrganization(v *Organization) *ScheduledExportUpdate {
+	return _u.SetOrganizationID(v.ID)
+}
+
+// SetSavedSearch sets the "saved_search" edge to the SavedSearch entity.
+func (_u *ScheduledExportUpdate) SetSavedSearch(v *SavedSearch) *ScheduledExportUpdate {
+	return _u.SetSavedSearchID(v.ID)
+}
+
+// Mutation returns the ScheduledExportMutation object of the builder.
+func (_u *ScheduledExportUpdate) Mutation() *ScheduledExportMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *ScheduledExportUpdate) ClearUser() *ScheduledExportUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (_u *ScheduledExportUpdate) ClearOrganization() *ScheduledExportUpdate {
+	_u.mutation.ClearOrganization()
+	return _u
+}
+
+// ClearSavedSearch clears the "saved_search" edge to the SavedSearch entity.
+func (_u *ScheduledExportUpdate) ClearSavedSearch() *ScheduledExportUpdate {
+	_u.mutation.ClearSavedSearch()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ScheduledExportUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ScheduledExportUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ScheduledExportUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ScheduledExportUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ScheduledExportUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := scheduledexport.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ScheduledExportUpdate) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := scheduledexport.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "ScheduledExport.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := scheduledexport.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ScheduledExport.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Format(); ok {
+		if err := scheduledexport.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "ScheduledExport.format": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxLeads(); ok {
+		if err := scheduledexport.MaxLeadsValidator(v); err != nil {
+			return &ValidationError{Name: "max_leads", err: fmt.Errorf(`ent: validator failed for field "ScheduledExport.max_leads": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Cadence(); ok {
+		if err := scheduledexport.CadenceValidator(v); err != nil {
+			return &ValidationError{Name: "cadence", err: fmt.Errorf(`ent: validator failed for field "ScheduledExport.cadence": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeliveryMethod(); ok {
+		if err := scheduledexport.DeliveryMethodValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_method", err: fmt.Errorf(`ent: validator failed for field "ScheduledExport.delivery_method": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeliveryURL(); ok {
+		if err := scheduledexport.DeliveryURLValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_url", err: fmt.Errorf(`ent: validator failed for field "ScheduledExport.delivery_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := scheduledexport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ScheduledExport.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastError(); ok {
+		if err := scheduledexport.LastErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_error", err: fmt.Errorf(`ent: validator failed for field "ScheduledExport.last_error": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RunCount(); ok {
+		if err := scheduledexport.RunCountValidator(v); err != nil {
+			return &ValidationError{Name: "run_count", err: fmt.Errorf(`ent: validator failed for field "ScheduledExport.run_count": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScheduledExport.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ScheduledExportUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ScheduledExportUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ScheduledExportUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scheduledexport.Table, scheduledexport.Columns, sqlgraph.NewFieldSpec(scheduledexport.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(scheduledexport.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Format(); ok {
+		_spec.SetField(scheduledexport.FieldFormat, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Columns(); ok {
+		_spec.SetField(scheduledexport.FieldColumns, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedColumns(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, scheduledexport.FieldColumns, value)
+		})
+	}
+	if _u.mutation.ColumnsCleared() {
+		_spec.ClearField(scheduledexport.FieldColumns, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Filters(); ok {
+		_spec.SetField(scheduledexport.FieldFilters, field.TypeJSON, value)
+	}
+	if _u.mutation.FiltersCleared() {
+		_spec.ClearField(scheduledexport.FieldFilters, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.MaxLeads(); ok {
+		_spec.SetField(scheduledexport.FieldMaxLeads, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxLeads(); ok {
+		_spec.AddField(scheduledexport.FieldMaxLeads, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SinceLastExport(); ok {
+		_spec.SetField(scheduledexport.FieldSinceLastExport, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Cadence(); ok {
+		_spec.SetField(scheduledexport.FieldCadence, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DeliveryMethod(); ok {
+		_spec.SetField(scheduledexport.FieldDeliveryMethod, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.DeliveryURL(); ok {
+		_spec.SetField(scheduledexport.FieldDeliveryURL, field.TypeString, value)
+	}
+	if _u.mutation.DeliveryURLCleared() {
+		_spec.ClearField(scheduledexport.FieldDeliveryURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.DeliverySecret(); ok {
+		_spec.SetField(scheduledexport.FieldDeliverySecret, field.TypeString, value)
+	}
+	if _u.mutation.DeliverySecretCleared() {
+		_spec.ClearField(scheduledexport.FieldDeliverySecret, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(scheduledexport.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.NextRunAt(); ok {
+		_spec.SetField(scheduledexport.FieldNextRunAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastRunAt(); ok {
+		_spec.SetField(scheduledexport.FieldLastRunAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastRunAtCleared() {
+		_spec.ClearField(scheduledexport.FieldLastRunAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastExportID(); ok {
+		_spec.SetField(scheduledexport.FieldLastExportID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastExportID(); ok {
+		_spec.AddField(scheduledexport.FieldLastExportID, field.TypeInt, value)
+	}
+	if _u.mutation.LastExportIDCleared() {
+		_spec.ClearField(scheduledexport.FieldLastExportID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(scheduledexport.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(scheduledexport.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.RunCount(); ok {
+		_spec.SetField(scheduledexport.FieldRunCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRunCount(); ok {
+		_spec.AddField(scheduledexport.FieldRunCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(scheduledexport.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduledexport.UserTable,
+			Columns: []string{scheduledexport.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduledexport.UserTable,
+			Columns: []string{scheduledexport.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduledexport.OrganizationTable,
+			Columns: []string{scheduledexport.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduledexport.OrganizationTable,
+			Columns: []string{scheduledexport.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SavedSearchCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduledexport.SavedSearchTable,
+			Columns: []string{scheduledexport.SavedSearchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SavedSearchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduledexport.SavedSearchTable,
+			Columns: []string{scheduledexport.SavedSearchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scheduledexport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ScheduledExportUpdateOne is the builder for updating a single ScheduledExport entity.
+type ScheduledExportUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ScheduledExportMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ScheduledExportUpdateOne) SetUserID(v int) *ScheduledExportUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ScheduledExportUpdateOne) SetNillableUserID(v *int) *ScheduledExportUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_u *ScheduledExportUpdateOne) SetOrganizationID(v int) *ScheduledExportUpdateOne {
+	_u.mutation.SetOrganizationID(v)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_u *ScheduledExportUpdateOne) SetNillableOrganizationID(v *int) *ScheduledExportUpdateOne {
+	if v != nil {
+		_u.SetOrganizationID(*v)
+	}
+	return _u
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (_u *ScheduledExportUpdateOne) ClearOrganizationID() *ScheduledExportUpdateOne {
+	_u.mutation.ClearOrganizationID()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ScheduledExportUpdateOne) SetName(v string) *ScheduledExportUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ScheduledExportUpdateOne) SetNillableName(v *string) *ScheduledExportUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetFormat sets the "format" field.
+func (_u *ScheduledExportUpdateOne) SetFormat(v scheduledexport.Format) *ScheduledExportUpdateOne {
+	_u.mutation.SetFormat(v)
+	return _u
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_u *ScheduledExportUpdateOne) SetNillableFormat(v *scheduledexport.Format) *ScheduledExportUpdateOne {
+	if v != nil {
+		_u.SetFormat(*v)
+	}
+	return _u
+}
+
+// SetColumns sets the "columns" field.
+func (_u *ScheduledExportUpdateOne) SetColumns(v []string) *ScheduledExportUpdateOne {
+	_u.mutation.SetColumns(v)
+	return _u
+}
+
+// AppendColumns appends value to the "columns" field.
+func (_u *ScheduledExportUpdateOne) AppendColumns(v []string) *ScheduledExportUpdateOne {
+	_u.mutation.AppendColumns(v)
+	return _u
+}
+
+// ClearColumns clears the value of the "columns" field.
+func (_u *ScheduledExportUpdateOne) ClearColumns() *ScheduledExportUpdateOne {
+	_u.mutation.ClearColumns()
+	return _u
+}
+
+// SetSavedSearchID sets the "saved_search_id" field.
+func (_u *ScheduledExportUpdateOne) SetSavedSearchID(v int) *ScheduledExportUpdateOne {
+	_u.mutation.SetSavedSearchID(v)
+	return _u
+}
+
+// SetNillableSavedSearchID sets the "saved_search_id" field if the given value is not nil.
+func (_u *ScheduledExportUpdateOne) SetNillableSavedSearchID(v *int) *ScheduledExportUpdateOne {
+	if v != nil {
+		_u.SetSavedSearchID(*v)
+	}
+	return _u
+}
+
+// ClearSavedSearchID clears the value of the "saved_search_id" field.
+func (_u *ScheduledExportUpdateOne) ClearSavedSearchID() *ScheduledExportUpdateOne {
+	_u.mutation.ClearSavedSearchID()
+	return _u
+}
+
+// SetFilters sets the "filters" field.
+func (_u *ScheduledExportUpdateOne) SetFilters(v map[string]interface{}) *ScheduledExportUpdateOne {
+	_u.mutation.SetFilters(v)
+	return _u
+}
+
+// ClearFilters clears the value of the "filters" field.
+func (_u *ScheduledExportUpdateOne) ClearFilters() *ScheduledExportUpdateOne {
+	_u.mutation.ClearFilters()
+	return _u
+}
+
+// SetMaxLeads sets the "max_leads" field.
+func (_u *ScheduledExportUpdateOne) SetMaxLeads(v int) *ScheduledExportUpdateOne {
+	_u.mutation.ResetMaxLeads()
+	_u.mutation.SetMaxLeads(v)
+	return _u
+}
+
+// SetNillableMaxLeads sets the "max_leads" field if the given value is not nil.
+func (_u *ScheduledExportUpdateOne) SetNillableMaxLeads(v *int) *ScheduledExportUpdateOne {
+	if v != nil {
+		_u.SetMaxLeads(*v)
+	}
+	return _u
+}
+
+// AddMaxLeads adds value to the "max_leads" field.
+func (_u *ScheduledExportUpdateOne) AddMaxLeads(v int) *ScheduledExportUpdateOne {
+	_u.mutation.AddMaxLeads(v)
+	return _u
+}
+
+// SetSinceLastExport sets the "since_last_export" field.
+func (_u *ScheduledExportUpdateOne) SetSinceLastExport(v bool) *ScheduledExportUpdateOne {
+	_u.mutation.SetSinceLastExport(v)
+	return _u
+}
+
+// SetNillableSinceLastExport sets the "since_last_export" field if the given value is not nil.
+func (_u *ScheduledExportUpdateOne) SetNillableSinceLastExport(v *bool) *ScheduledExportUpdateOne {
+	if v != nil {
+		_u.SetSinceLastExport(*v)
+	}
+	return _u
+}
+
+// SetCadence sets the "cadence" field.
+func (_u *ScheduledExportUpdateOne) SetCadence(v string) *ScheduledExportUpdateOne {
+	_u.mutation.SetCadence(v)
+	return _u
+}
+
+// SetNillableCadence sets the "cadence" field if the given value is not nil.
+func (_u *ScheduledExportUpdateOne) SetNillableCadence(v *string) *ScheduledExportUpdateOne {
+	if v != nil {
+		_u.SetCadence(*v)
+	}
+	return _u
+}
+
+// SetDeliveryMethod sets the "delivery_method" field.
+func (_u *ScheduledExportUpdateOne) SetDeliveryMethod(v scheduledexport.DeliveryMethod) *ScheduledExportUpdateOne {
+	_u.mutation.SetDeliveryMethod(v)
+	return _u
+}
+
+// SetNillableDeliveryMethod sets the "delivery_method" field if the given value is not nil.
+func (_u *ScheduledExportUpdateOne) SetNillableDeliveryMethod(v *scheduledexport.DeliveryMethod) *ScheduledExportUpdateOne {
+	if v != nil {
+		_u.SetDeliveryMethod(*v)
+	}
+	return _u
+}
+
+// SetDeliveryURL sets the "delivery_url" field.
+func (_u *ScheduledExportUpdateOne) SetDeliveryURL(v string) *ScheduledExportUpdateOne {
+	_u.mutation.SetDeliveryURL(v)
+	return _u
+}
+
+// SetNillableDeliveryURL sets the "delivery_url" field if the given value is not nil.
+func (_u *ScheduledExportUpdateOne) SetNillableDeliveryURL(v *string) *ScheduledExportUpdateOne {
+	if v != nil {
+		_u.SetDeliveryURL(*v)
+	}
+	return _u
+}
+
+// ClearDeliveryURL clears the value of the "delivery_url" field.
+func (_u *ScheduledExportUpdateOne) ClearDeliveryURL() *ScheduledExportUpdateOne {
+	_u.mutation.ClearDeliveryURL()
+	return _u
+}
+
+// SetDeliverySecret sets the "delivery_secret" field.
+func (_u *ScheduledExportUpdateOne) SetDeliverySecret(v string) *ScheduledExportUpdateOne {
+	_u.mutation.SetDeliverySecret(v)
+	return _u
+}
+
+// SetNillableDeliverySecret sets the "delivery_secret" field if the given value is not nil.
+func (_u *ScheduledExportUpdateOne) SetNillableDeliverySecret(v *string) *ScheduledExportUpdateOne {
+	if v != nil {
+		_u.SetDeliverySecret(*v)
+	}
+	return _u
+}
+
+// ClearDeliverySecret clears the value of the "delivery_secret" field.
+func (_u *ScheduledExportUpdateOne) ClearDeliverySecret() *ScheduledExportUpdateOne {
+	_u.mutation.ClearDeliverySecret()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ScheduledExportUpdateOne) SetStatus(v scheduledexport.Status) *ScheduledExportUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ScheduledExportUpdateOne) SetNillableStatus(v *scheduledexport.Status) *ScheduledExportUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (_u *ScheduledExportUpdateOne) SetNextRunAt(v time.Time) *ScheduledExportUpdateOne {
+	_u.mutation.SetNextRunAt(v)
+	return _u
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (_u *ScheduledExportUpdateOne) SetNillableNextRunAt(v *time.Time) *ScheduledExportUpdateOne {
+	if v != nil {
+		_u.SetNextRunAt(*v)
+	}
+	return _u
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (_u *ScheduledExportUpdateOne) SetLastRunAt(v time.Time) *ScheduledExportUpdateOne {
+	_u.mutation.SetLastRunAt(v)
+	return _u
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (_u *ScheduledExportUpdateOne) SetNillableLastRunAt(v *time.Time) *ScheduledExportUpdateOne {
+	if v != nil {
+		_u.SetLastRunAt(*v)
+	}
+	return _u
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (_u *ScheduledExportUpdateOne) ClearLastRunAt() *ScheduledExportUpdateOne {
+	_u.mutation.ClearLastRunAt()
+	return _u
+}
+
+// SetLastExportID sets the "last_export_id" field.
+func (_u *ScheduledExportUpdateOne) SetLastExportID(v int) *ScheduledExportUpdateOne {
+	_u.mutation.ResetLastExportID()
+	_u.mutation.SetLastExportID(v)
+	return _u
+}
+
+// SetNillableLastExportID sets the "last_export_id" field if the given value is not nil.
+func (_u *ScheduledExportUpdateOne) SetNillableLastExportID(v *int) *ScheduledExportUpdateOne {
+	if v != nil {
+		_u.SetLastExportID(*v)
+	}
+	return _u
+}
+
+// AddLastExportID adds value to the "last_export_id" field.
+func (_u *ScheduledExportUpdateOne) AddLastExportID(v int) *ScheduledExportUpdateOne {
+	_u.mutation.AddLastExportID(v)
+	return _u
+}
+
+// ClearLastExportID clears the value of the "last_export_id" field.
+func (_u *ScheduledExportUpdateOne) ClearLastExportID() *ScheduledExportUpdateOne {
+	_u.mutation.ClearLastExportID()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *ScheduledExportUpdateOne) SetLastError(v string) *ScheduledExportUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *ScheduledExportUpdateOne) SetNillableLastError(v *string) *ScheduledExportUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *ScheduledExportUpdateOne) ClearLastError() *ScheduledExportUpdateOne {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetRunCount sets the "run_count" field.
+func (_u *ScheduledExportUpdateOne) SetRunCount(v int) *ScheduledExportUpdateOne {
+	_u.mutation.ResetRunCount()
+	_u.mutation.SetRunCount(v)
+	return _u
+}
+
+// SetNillableRunCount sets the "run_count" field if the given value is not nil.
+func (_u *ScheduledExportUpdateOne) SetNillableRunCount(v *int) *ScheduledExportUpdateOne {
+	if v != nil {
+		_u.SetRunCount(*v)
+	}
+	return _u
+}
+
+// AddRunCount adds value to the "run_count" field.
+func (_u *ScheduledExportUpdateOne) AddRunCount(v int) *ScheduledExportUpdateOne {
+	_u.mutation.AddRunCount(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ScheduledExportUpdateOne) SetUpdatedAt(v time.Time) *ScheduledExportUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *ScheduledExportUpdateOne) SetUser(v *User) *ScheduledExportUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_u *ScheduledExportUpdateOne) SetOrganization(v *Organization) *ScheduledExportUpdateOne {
+	return _u.SetOrganizationID(v.ID)
+}
+
+// SetSavedSearch sets the "saved_search" edge to the SavedSearch entity.
+func (_u *ScheduledExportUpdateOne) SetSavedSearch(v *SavedSearch) *ScheduledExportUpdateOne {
+	return _u.SetSavedSearchID(v.ID)
+}
+
+// Mutation returns the ScheduledExportMutation object of the builder.
+func (_u *ScheduledExportUpdateOne) Mutation() *ScheduledExportMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *ScheduledExportUpdateOne) ClearUser() *ScheduledExportUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (_u *ScheduledExportUpdateOne) ClearOrganization() *ScheduledExportUpdateOne {
+	_u.mutation.ClearOrganization()
+	return _u
+}
+
+// ClearSavedSearch clears the "saved_search" edge to the SavedSearch entity.
+func (_u *ScheduledExportUpdateOne) ClearSavedSearch() *ScheduledExportUpdateOne {
+	_u.mutation.ClearSavedSearch()
+	return _u
+}
+
+// Where appends a list predicates to the ScheduledExportUpdate builder.
+func (_u *ScheduledExportUpdateOne) Where(ps ...predicate.ScheduledExport) *ScheduledExportUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ScheduledExportUpdateOne) Select(field string, fields ...string) *ScheduledExportUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ScheduledExport entity.
+func (_u *ScheduledExportUpdateOne) Save(ctx context.Context) (*ScheduledExport, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ScheduledExportUpdateOne) SaveX(ctx context.Context) *ScheduledExport {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ScheduledExportUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ScheduledExportUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ScheduledExportUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := scheduledexport.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ScheduledExportUpdateOne) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := scheduledexport.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "ScheduledExport.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := scheduledexport.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ScheduledExport.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Format(); ok {
+		if err := scheduledexport.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "ScheduledExport.format": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxLeads(); ok {
+		if err := scheduledexport.MaxLeadsValidator(v); err != nil {
+			return &ValidationError{Name: "max_leads", err: fmt.Errorf(`ent: validator failed for field "ScheduledExport.max_leads": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Cadence(); ok {
+		if err := scheduledexport.CadenceValidator(v); err != nil {
+			return &ValidationError{Name: "cadence", err: fmt.Errorf(`ent: validator failed for field "ScheduledExport.cadence": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeliveryMethod(); ok {
+		if err := scheduledexport.DeliveryMethodValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_method", err: fmt.Errorf(`ent: validator failed for field "ScheduledExport.delivery_method": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeliveryURL(); ok {
+		if err := scheduledexport.DeliveryURLValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_url", err: fmt.Errorf(`ent: validator failed for field "ScheduledExport.delivery_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := scheduledexport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ScheduledExport.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastError(); ok {
+		if err := scheduledexport.LastErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_error", err: fmt.Errorf(`ent: validator failed for field "ScheduledExport.last_error": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RunCount(); ok {
+		if err := scheduledexport.RunCountValidator(v); err != nil {
+			return &ValidationError{Name: "run_count", err: fmt.Errorf(`ent: validator failed for field "ScheduledExport.run_count": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScheduledExport.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ScheduledExportUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ScheduledExportUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ScheduledExportUpdateOne) sqlSave(ctx context.Context) (_node *ScheduledExport, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scheduledexport.Table, scheduledexport.Columns, sqlgraph.NewFieldSpec(scheduledexport.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ScheduledExport.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, scheduledexport.FieldID)
+		for _, f := range fields {
+			if !scheduledexport.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != scheduledexport.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(scheduledexport.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Format(); ok {
+		_spec.SetField(scheduledexport.FieldFormat, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Columns(); ok {
+		_spec.SetField(scheduledexport.FieldColumns, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedColumns(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, scheduledexport.FieldColumns, value)
+		})
+	}
+	if _u.mutation.ColumnsCleared() {
+		_spec.ClearField(scheduledexport.FieldColumns, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Filters(); ok {
+		_spec.SetField(scheduledexport.FieldFilters, field.TypeJSON, value)
+	}
+	if _u.mutation.FiltersCleared() {
+		_spec.ClearField(scheduledexport.FieldFilters, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.MaxLeads(); ok {
+		_spec.SetField(scheduledexport.FieldMaxLeads, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxLeads(); ok {
+		_spec.AddField(scheduledexport.FieldMaxLeads, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SinceLastExport(); ok {
+		_spec.SetField(scheduledexport.FieldSinceLastExport, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Cadence(); ok {
+		_spec.SetField(scheduledexport.FieldCadence, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DeliveryMethod(); ok {
+		_spec.SetField(scheduledexport.FieldDeliveryMethod, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.DeliveryURL(); ok {
+		_spec.SetField(scheduledexport.FieldDeliveryURL, field.TypeString, value)
+	}
+	if _u.mutation.DeliveryURLCleared() {
+		_spec.ClearField(scheduledexport.FieldDeliveryURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.DeliverySecret(); ok {
+		_spec.SetField(scheduledexport.FieldDeliverySecret, field.TypeString, value)
+	}
+	if _u.mutation.DeliverySecretCleared() {
+		_spec.ClearField(scheduledexport.FieldDeliverySecret, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(scheduledexport.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.NextRunAt(); ok {
+		_spec.SetField(scheduledexport.FieldNextRunAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastRunAt(); ok {
+		_spec.SetField(scheduledexport.FieldLastRunAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastRunAtCleared() {
+		_spec.ClearField(scheduledexport.FieldLastRunAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastExportID(); ok {
+		_spec.SetField(scheduledexport.FieldLastExportID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastExportID(); ok {
+		_spec.AddField(scheduledexport.FieldLastExportID, field.TypeInt, value)
+	}
+	if _u.mutation.LastExportIDCleared() {
+		_spec.ClearField(scheduledexport.FieldLastExportID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(scheduledexport.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(scheduledexport.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.RunCount(); ok {
+		_spec.SetField(scheduledexport.FieldRunCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRunCount(); ok {
+		_spec.AddField(scheduledexport.FieldRunCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(scheduledexport.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduledexport.UserTable,
+			Columns: []string{scheduledexport.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduledexport.UserTable,
+			Columns: []string{scheduledexport.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduledexport.OrganizationTable,
+			Columns: []string{scheduledexport.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduledexport.OrganizationTable,
+			Columns: []string{scheduledexport.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SavedSearchCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduledexport.SavedSearchTable,
+			Columns: []string{scheduledexport.SavedSearchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SavedSearchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduledexport.SavedSearchTable,
+			Columns: []string{scheduledexport.SavedSearchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ScheduledExport{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scheduledexport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
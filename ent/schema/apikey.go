@@ -70,6 +70,8 @@ func (APIKey) Edges() []ent.Edge {
 			Unique().
 			Required().
 			Comment("API key owner"),
+		edge.To("zapier_deliveries", ZapierDelivery.Type).
+			Comment("Leads returned to this key by the Zapier new-leads poll"),
 	}
 }
 
@@ -25,6 +25,7 @@ func (AuditLog) Fields() []ent.Field {
 			Values(
 				"user_login",
 				"user_logout",
+				"session_revoke",
 				"user_register",
 				"user_profile_update",
 				"user_password_change",
@@ -32,9 +33,18 @@ func (AuditLog) Fields() []ent.Field {
 				"user_account_delete",
 				"user_update",
 				"user_suspension",
+				"user_activity_report",
 				"data_export",
+				"data_purge",
 				"lead_search",
 				"lead_view",
+				"lead_verify",
+				"lead_unverify",
+				"lead_tag",
+				"lead_merge",
+				"lead_reindex",
+				"sequence_stop_all",
+				"webhook_bulk_delete",
 				"export_create",
 				"export_download",
 				"subscription_create",
@@ -44,6 +54,7 @@ func (AuditLog) Fields() []ent.Field {
 				"payment_failed",
 				"api_key_create",
 				"api_key_delete",
+				"internal_service_request",
 			).
 			Comment("Action performed"),
 		field.String("resource_type").
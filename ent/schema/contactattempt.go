@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ContactAttempt holds the schema definition for the ContactAttempt entity.
+// It is a structured outreach log, separate from free-text lead notes.
+type ContactAttempt struct {
+	ent.Schema
+}
+
+// Fields of the ContactAttempt.
+func (ContactAttempt) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("lead_id").
+			Positive().
+			Comment("ID of the lead that was contacted"),
+		field.Int("user_id").
+			Positive().
+			Comment("ID of the user who made the attempt"),
+		field.Enum("type").
+			Values("call", "email", "sms").
+			Comment("Outreach channel"),
+		field.Enum("outcome").
+			Values("no_answer", "left_voicemail", "busy", "wrong_number", "bounced", "opted_out", "replied", "connected").
+			Comment("Result of the attempt (replied and connected count as connects)"),
+		field.Time("attempted_at").
+			Default(time.Now).
+			Comment("When the attempt was made"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Creation timestamp"),
+	}
+}
+
+// Edges of the ContactAttempt.
+func (ContactAttempt) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("lead", Lead.Type).
+			Ref("contact_attempts").
+			Field("lead_id").
+			Unique().
+			Required().
+			Comment("Lead that was contacted"),
+		edge.From("user", User.Type).
+			Ref("contact_attempts").
+			Field("user_id").
+			Unique().
+			Required().
+			Comment("User who made the attempt"),
+	}
+}
+
+// Indexes of the ContactAttempt.
+func (ContactAttempt) Indexes() []ent.Index {
+	return []ent.Index{
+		// Attempts on a lead, newest first
+		index.Fields("lead_id", "attempted_at"),
+		// Per-user stats
+		index.Fields("user_id", "attempted_at"),
+		// Outreach funnel analytics
+		index.Fields("attempted_at"),
+	}
+}
@@ -90,6 +90,8 @@ func (CRMIntegration) Edges() []ent.Edge {
 			Comment("Integration owner"),
 		edge.To("synced_leads", CRMLeadSync.Type).
 			Comment("Leads synced through this integration"),
+		edge.To("push_jobs", CRMPushJob.Type).
+			Comment("Push jobs run through this integration"),
 	}
 }
 
@@ -0,0 +1,98 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CRMPushJob holds the schema definition for the CRMPushJob entity.
+// A push job sends a selection of leads to a CRM in the background and
+// records the outcome for every lead.
+type CRMPushJob struct {
+	ent.Schema
+}
+
+// Fields of the CRMPushJob.
+func (CRMPushJob) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id").
+			Positive().
+			Comment("User who started the push"),
+		field.Int("integration_id").
+			Comment("CRM integration the leads are pushed to"),
+		field.Enum("status").
+			Values("pending", "processing", "completed", "failed").
+			Default("pending").
+			Comment("Push job status"),
+		field.Int("total_leads").
+			Default(0).
+			NonNegative().
+			Comment("Leads selected for the push"),
+		field.Int("created_count").
+			Default(0).
+			NonNegative().
+			Comment("Leads that created new CRM records"),
+		field.Int("updated_count").
+			Default(0).
+			NonNegative().
+			Comment("Leads that updated existing CRM records"),
+		field.Int("failure_count").
+			Default(0).
+			NonNegative().
+			Comment("Leads that failed to push"),
+		field.JSON("results", []map[string]interface{}{}).
+			Optional().
+			Comment("Per-lead results: lead_id, action, CRM record IDs, error"),
+		field.String("error_message").
+			Optional().
+			Comment("Error message if the job failed"),
+		field.Time("started_at").
+			Optional().
+			Nillable().
+			Comment("When processing started"),
+		field.Time("completed_at").
+			Optional().
+			Nillable().
+			Comment("When processing finished"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Creation timestamp"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("Last update timestamp"),
+	}
+}
+
+// Edges of the CRMPushJob.
+func (CRMPushJob) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("crm_push_jobs").
+			Field("user_id").
+			Unique().
+			Required().
+			Comment("User who started the push"),
+		edge.From("integration", CRMIntegration.Type).
+			Ref("push_jobs").
+			Field("integration_id").
+			Unique().
+			Required().
+			Comment("Target CRM integration"),
+	}
+}
+
+// Indexes of the CRMPushJob.
+func (CRMPushJob) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+		index.Fields("integration_id"),
+		index.Fields("status"),
+		index.Fields("created_at"),
+	}
+}
@@ -0,0 +1,88 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// EmailSend holds the schema definition for the EmailSend entity.
+// Each transactional email sent through the email service is recorded so
+// failed sends can be retried and inspected.
+type EmailSend struct {
+	ent.Schema
+}
+
+// Fields of the EmailSend.
+func (EmailSend) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("to_email").
+			NotEmpty().
+			Comment("Recipient email address"),
+		field.String("to_name").
+			Optional().
+			Comment("Recipient name"),
+		field.String("template").
+			Optional().
+			Comment("Email template name (empty for raw emails)"),
+		field.String("subject").
+			Comment("Email subject"),
+		field.Text("html_body").
+			Optional().
+			Sensitive().
+			Comment("HTML body, kept only until the send is delivered or abandoned"),
+		field.Text("text_body").
+			Optional().
+			Sensitive().
+			Comment("Plain text body, kept only until the send is delivered or abandoned"),
+		field.Enum("status").
+			Values("pending", "sent", "retrying", "failed", "bounced", "suppressed").
+			Default("pending").
+			Comment("Delivery status"),
+		field.Int("attempts").
+			Default(0).
+			NonNegative().
+			Comment("Number of send attempts made"),
+		field.Int("status_code").
+			Optional().
+			Nillable().
+			Comment("Provider HTTP status code of the last attempt"),
+		field.Text("last_error").
+			Optional().
+			Comment("Error from the last failed attempt or bounce"),
+		field.String("provider").
+			Optional().
+			Comment("Provider of the last attempt (sendgrid, ses)"),
+		field.String("provider_message_id").
+			Optional().
+			Comment("Provider message ID, used to match bounce events"),
+		field.Time("next_attempt_at").
+			Optional().
+			Nillable().
+			Comment("When the next retry is due (retrying only)"),
+		field.Time("sent_at").
+			Optional().
+			Nillable().
+			Comment("When the provider accepted the email"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Creation timestamp"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("Last update timestamp"),
+	}
+}
+
+// Indexes of the EmailSend.
+func (EmailSend) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_attempt_at"),
+		index.Fields("to_email"),
+		index.Fields("provider_message_id"),
+		index.Fields("created_at"),
+	}
+}
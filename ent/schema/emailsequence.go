@@ -36,6 +36,22 @@ func (EmailSequence) Fields() []ent.Field {
 			Default("manual").
 			Comment("What triggers enrollment in this sequence"),
 
+		field.String("delivery_timezone").
+			Optional().
+			Comment("IANA timezone of the send window (empty = UTC)"),
+
+		field.Int("delivery_start_hour").
+			Optional().
+			Nillable().
+			Range(0, 23).
+			Comment("Local hour sends start (null = send 24/7)"),
+
+		field.Int("delivery_end_hour").
+			Optional().
+			Nillable().
+			Range(1, 24).
+			Comment("Local hour sends stop, exclusive; before start_hour wraps past midnight"),
+
 		field.Int("created_by_user_id").
 			Positive().
 			Comment("User who created this sequence"),
@@ -30,7 +30,7 @@ func (EmailSequenceSend) Fields() []ent.Field {
 			Comment("Lead receiving this email"),
 
 		field.Enum("status").
-			Values("scheduled", "sent", "opened", "clicked", "bounced", "failed").
+			Values("scheduled", "sent", "opened", "clicked", "bounced", "failed", "skipped").
 			Default("scheduled").
 			Comment("Send status"),
 
@@ -39,6 +39,25 @@ func (EmailSequenceStep) Fields() []ent.Field {
 			NotEmpty().
 			Comment("Email body (supports variables: {{lead_name}}, {{user_name}}, etc.)"),
 
+		field.Enum("condition_type").
+			Values("opened", "not_opened", "clicked", "not_clicked", "field_equals", "field_not_equals").
+			Optional().
+			Nillable().
+			Comment("Condition checked when the step is due, the step is skipped when it does not hold (null = always sent)"),
+
+		field.Int("condition_step_order").
+			Optional().
+			Nillable().
+			Comment("Earlier step whose email opens or clicks are checked"),
+
+		field.String("condition_field").
+			Optional().
+			Comment("Lead field compared by field conditions"),
+
+		field.String("condition_value").
+			Optional().
+			Comment("Value compared by field conditions"),
+
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable().
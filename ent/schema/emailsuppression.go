@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// EmailSuppression holds the schema definition for the EmailSuppression entity.
+// Suppressed addresses hard-bounced and are not emailed again.
+type EmailSuppression struct {
+	ent.Schema
+}
+
+// Fields of the EmailSuppression.
+func (EmailSuppression) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("email").
+			NotEmpty().
+			Comment("Undeliverable email address (lowercase)"),
+		field.Text("reason").
+			Optional().
+			Comment("Bounce reason reported by the provider"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("When the address was marked undeliverable"),
+	}
+}
+
+// Indexes of the EmailSuppression.
+func (EmailSuppression) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("email").Unique(),
+	}
+}
@@ -0,0 +1,93 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// EnrichmentAttempt holds the schema definition for the EnrichmentAttempt entity.
+// Each row records one call to an enrichment provider for a lead, successful
+// or not, with what it returned and what it cost.
+type EnrichmentAttempt struct {
+	ent.Schema
+}
+
+// Fields of the EnrichmentAttempt.
+func (EnrichmentAttempt) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("lead_id").
+			Positive().
+			Comment("ID of the enriched lead"),
+
+		field.Int("user_id").
+			Optional().
+			Nillable().
+			Comment("ID of the user who requested the enrichment (null for system runs)"),
+
+		field.String("provider").
+			NotEmpty().
+			MaxLen(50).
+			Comment("Enrichment provider called"),
+
+		field.Enum("kind").
+			Values("company", "email_validation").
+			Comment("What was requested from the provider"),
+
+		field.Bool("success").
+			Comment("Whether the provider returned data"),
+
+		field.JSON("fields_returned", []string{}).
+			Optional().
+			Comment("Provider fields returned with a value"),
+
+		field.String("error_message").
+			Optional().
+			Comment("Provider error of a failed attempt"),
+
+		field.Int("cost_cents").
+			Default(0).
+			NonNegative().
+			Comment("Provider cost of the attempt in cents"),
+
+		field.Int("duration_ms").
+			Default(0).
+			NonNegative().
+			Comment("Provider call duration in milliseconds"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("When the attempt was made"),
+	}
+}
+
+// Edges of the EnrichmentAttempt.
+func (EnrichmentAttempt) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("lead", Lead.Type).
+			Ref("enrichment_attempts").
+			Field("lead_id").
+			Unique().
+			Required(),
+
+		edge.From("user", User.Type).
+			Ref("enrichment_attempts").
+			Field("user_id").
+			Unique(),
+	}
+}
+
+// Indexes of the EnrichmentAttempt.
+func (EnrichmentAttempt) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("lead_id", "created_at").
+			StorageKey("idx_enrichment_attempt_lead_time"),
+
+		index.Fields("provider", "success").
+			StorageKey("idx_enrichment_attempt_provider"),
+	}
+}
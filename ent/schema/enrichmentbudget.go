@@ -0,0 +1,80 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// EnrichmentBudget holds the schema definition for the EnrichmentBudget entity.
+// Each row tracks the enrichment provider spend of a user or an organization
+// in the current month, with the admin-set limits overriding its tier
+// defaults.
+type EnrichmentBudget struct {
+	ent.Schema
+}
+
+// Fields of the EnrichmentBudget.
+func (EnrichmentBudget) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id").
+			Optional().
+			Nillable().
+			Unique().
+			Comment("User whose personal enrichments are budgeted"),
+
+		field.Int("organization_id").
+			Optional().
+			Nillable().
+			Unique().
+			Comment("Organization whose enrichments are budgeted"),
+
+		field.Int("call_limit").
+			Optional().
+			Nillable().
+			NonNegative().
+			Comment("Monthly provider calls allowed, overriding the tier default (0 = unlimited)"),
+
+		field.Int("cost_limit_cents").
+			Optional().
+			Nillable().
+			NonNegative().
+			Comment("Monthly provider cost allowed in cents, overriding the tier default (0 = unlimited)"),
+
+		field.Int("calls").
+			Default(0).
+			NonNegative().
+			Comment("Provider calls made this month"),
+
+		field.Int("cost_cents").
+			Default(0).
+			NonNegative().
+			Comment("Provider cost spent this month in cents"),
+
+		field.Time("period_start").
+			Default(time.Now).
+			Comment("Start of the month the counters cover"),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("Last spend or limit change"),
+	}
+}
+
+// Edges of the EnrichmentBudget.
+func (EnrichmentBudget) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("enrichment_budget").
+			Field("user_id").
+			Unique(),
+
+		edge.From("organization", Organization.Type).
+			Ref("enrichment_budget").
+			Field("organization_id").
+			Unique(),
+	}
+}
@@ -25,7 +25,7 @@ func (Export) Fields() []ent.Field {
 			Nillable().
 			Comment("Organization ID if export belongs to organization"),
 		field.Enum("format").
-			Values("csv", "excel").
+			Values("csv", "excel", "vcard", "geojson", "kml").
 			Comment("Export format"),
 		field.JSON("filters_applied", map[string]interface{}{}).
 			Optional().
@@ -33,6 +33,10 @@ func (Export) Fields() []ent.Field {
 		field.Int("lead_count").
 			NonNegative().
 			Comment("Number of leads in export"),
+		field.Int("skipped_count").
+			Default(0).
+			NonNegative().
+			Comment("Matching leads left out of a geojson or kml export for having no coordinates"),
 		field.String("file_url").
 			Optional().
 			Comment("URL to download file"),
@@ -49,6 +53,78 @@ func (Export) Fields() []ent.Field {
 		field.Time("expires_at").
 			Optional().
 			Comment("Expiration timestamp (24h after creation)"),
+		field.Time("since").
+			Optional().
+			Nillable().
+			Comment("Delta exports only include leads updated after this time"),
+		field.Time("high_water_mark").
+			Optional().
+			Nillable().
+			Comment("Latest lead updated_at covered by this export (resume point for the next delta)"),
+		field.Int("high_water_lead_id").
+			Optional().
+			Nillable().
+			Comment("ID of the last lead covered at high_water_mark, so leads updated at the same instant are not skipped"),
+		field.String("redaction_profile").
+			Optional().
+			MaxLen(50).
+			Comment("Redaction profile applied to the exported fields (empty for none)"),
+		field.String("delivery_url").
+			Optional().
+			MaxLen(2048).
+			Comment("URL the completed file is POSTed to"),
+		field.String("delivery_secret").
+			Optional().
+			Sensitive().
+			Comment("Secret used to sign the delivery request (HMAC-SHA256)"),
+		field.Enum("delivery_status").
+			Values("pending", "delivered", "failed").
+			Optional().
+			Nillable().
+			Comment("Delivery status (null when no delivery_url)"),
+		field.Int("delivery_attempts").
+			Default(0).
+			NonNegative().
+			Comment("Number of delivery attempts made"),
+		field.Time("delivered_at").
+			Optional().
+			Nillable().
+			Comment("When the file was delivered"),
+		field.String("delivery_error").
+			Optional().
+			Comment("Last delivery error"),
+		field.Enum("delivery_method").
+			Values("url", "google_sheets").
+			Optional().
+			Nillable().
+			Comment("Where the completed export is delivered (null for download only)"),
+		field.String("spreadsheet_id").
+			Optional().
+			Comment("Google spreadsheet the export was written to"),
+		field.String("sheet_url").
+			Optional().
+			MaxLen(2048).
+			Comment("URL of the Google spreadsheet the export was written to"),
+		field.String("delivery_warning").
+			Optional().
+			Comment("Non-fatal delivery note, e.g. rows dropped at the Sheets cell limit"),
+		field.Enum("compression").
+			Values("gzip", "zip").
+			Optional().
+			Nillable().
+			Comment("Compression of the export file (null when uncompressed)"),
+		field.Int64("file_size").
+			Optional().
+			Nillable().
+			Comment("Size of the generated file in bytes, after compression"),
+		field.Int("download_count").
+			Default(0).
+			NonNegative().
+			Comment("Number of times the file was downloaded"),
+		field.Time("last_downloaded_at").
+			Optional().
+			Nillable().
+			Comment("When the file was last downloaded"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable().
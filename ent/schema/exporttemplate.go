@@ -0,0 +1,90 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ExportTemplate holds the schema definition for the ExportTemplate entity.
+// A template stores an export configuration (format, columns and either a
+// saved search or inline filters) to create exports from in one call.
+type ExportTemplate struct {
+	ent.Schema
+}
+
+// Fields of the ExportTemplate.
+func (ExportTemplate) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id").
+			Positive().
+			Comment("User who created this template"),
+		field.Int("organization_id").
+			Optional().
+			Nillable().
+			Comment("Organization the template is shared with (nil = personal)"),
+		field.String("name").
+			NotEmpty().
+			MaxLen(100).
+			Comment("Name of this template"),
+		field.Enum("format").
+			Values("csv", "excel", "vcard", "geojson", "kml").
+			Comment("Export file format"),
+		field.JSON("columns", []string{}).
+			Optional().
+			Comment("Columns to export, in order (empty = all columns)"),
+		field.Int("saved_search_id").
+			Optional().
+			Nillable().
+			Comment("Saved search whose filters select the leads"),
+		field.JSON("filters", map[string]interface{}{}).
+			Optional().
+			Comment("Inline filters in saved search format, when no saved search is referenced"),
+		field.Int("max_leads").
+			Default(0).
+			NonNegative().
+			Comment("Maximum leads per export (0 = default)"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("When this template was created"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("Last update timestamp"),
+	}
+}
+
+// Edges of the ExportTemplate.
+func (ExportTemplate) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("export_templates").
+			Field("user_id").
+			Unique().
+			Required().
+			Comment("User who owns this template"),
+		edge.From("organization", Organization.Type).
+			Ref("export_templates").
+			Field("organization_id").
+			Unique().
+			Comment("Organization the template is shared with"),
+		edge.From("saved_search", SavedSearch.Type).
+			Ref("export_templates").
+			Field("saved_search_id").
+			Unique().
+			Comment("Saved search the template exports"),
+	}
+}
+
+// Indexes of the ExportTemplate.
+func (ExportTemplate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+		index.Fields("organization_id"),
+		index.Fields("saved_search_id"),
+	}
+}
@@ -0,0 +1,102 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ImportJob holds the schema definition for the ImportJob entity.
+// An import job streams a leads CSV from S3 or an HTTPS URL in the background.
+type ImportJob struct {
+	ent.Schema
+}
+
+// Fields of the ImportJob.
+func (ImportJob) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id").
+			Positive().
+			Comment("Admin who started the import"),
+		field.Enum("source_type").
+			Values("s3", "url").
+			Comment("Where the CSV is read from"),
+		field.String("source").
+			NotEmpty().
+			MaxLen(2048).
+			Comment("S3 object key or HTTPS URL"),
+		field.Enum("status").
+			Values("pending", "processing", "completed", "failed").
+			Default("pending").
+			Comment("Import job status"),
+		field.Bool("validate_only").
+			Default(false).
+			Comment("Only validate rows, don't create leads"),
+		field.Int64("total_bytes").
+			Optional().
+			Nillable().
+			Comment("Source size in bytes, when known"),
+		field.Int64("bytes_read").
+			Default(0).
+			NonNegative().
+			Comment("Bytes streamed so far"),
+		field.Int("total_rows").
+			Default(0).
+			NonNegative().
+			Comment("Rows read so far"),
+		field.Int("success_count").
+			Default(0).
+			NonNegative().
+			Comment("Rows imported (or validated) successfully"),
+		field.Int("failure_count").
+			Default(0).
+			NonNegative().
+			Comment("Rows that failed validation or insert"),
+		field.JSON("errors", []map[string]interface{}{}).
+			Optional().
+			Comment("First row errors (capped)"),
+		field.String("error_message").
+			Optional().
+			Comment("Error message if the job failed"),
+		field.Time("started_at").
+			Optional().
+			Nillable().
+			Comment("When processing started"),
+		field.Time("completed_at").
+			Optional().
+			Nillable().
+			Comment("When processing finished"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Creation timestamp"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("Last update timestamp"),
+	}
+}
+
+// Edges of the ImportJob.
+func (ImportJob) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("import_jobs").
+			Field("user_id").
+			Unique().
+			Required().
+			Comment("Admin who started the import"),
+	}
+}
+
+// Indexes of the ImportJob.
+func (ImportJob) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+		index.Fields("status"),
+		index.Fields("created_at"),
+	}
+}
@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// IntegrationConnection holds the schema definition for the IntegrationConnection entity.
+// It stores an OAuth connection a user granted to a third-party service that
+// exports are delivered to (e.g. Google Sheets).
+type IntegrationConnection struct {
+	ent.Schema
+}
+
+// Fields of the IntegrationConnection.
+func (IntegrationConnection) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id").
+			Comment("User who connected the account"),
+		field.Enum("provider").
+			Values("google_sheets").
+			Comment("Connected service"),
+		field.String("account_email").
+			Optional().
+			Comment("Email of the connected account"),
+		field.String("refresh_token").
+			Sensitive().
+			Comment("OAuth refresh token (encrypted)"),
+		field.String("scopes").
+			Optional().
+			Comment("Granted OAuth scopes, space separated"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("When the account was connected"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("Last update timestamp"),
+	}
+}
+
+// Edges of the IntegrationConnection.
+func (IntegrationConnection) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("integration_connections").
+			Unique().
+			Required().
+			Field("user_id").
+			Comment("Connection owner"),
+	}
+}
+
+// Indexes of the IntegrationConnection.
+func (IntegrationConnection) Indexes() []ent.Index {
+	return []ent.Index{
+		// Unique: one connection per user per provider
+		index.Fields("user_id", "provider").Unique(),
+	}
+}
@@ -94,11 +94,24 @@ func (Lead) Fields() []ent.Field {
 		field.Bool("verified").
 			Default(false).
 			Comment("Whether the lead has been verified"),
+		field.Time("verified_since").
+			Optional().
+			Nillable().
+			Comment("When the lead was last verified (null if not verified)"),
+		field.Int("verified_by").
+			Optional().
+			Nillable().
+			Comment("ID of the user who last verified the lead"),
 		field.Int("quality_score").
 			Default(50).
 			Min(0).
 			Max(100).
 			Comment("Data quality score (0-100)"),
+		field.Int("completeness_score").
+			Default(0).
+			Min(0).
+			Max(100).
+			Comment("Share of contact and location fields populated (0-100), see leads.CalculateCompleteness"),
 
 		// CRM Lifecycle fields
 		field.Enum("status").
@@ -108,9 +121,20 @@ func (Lead) Fields() []ent.Field {
 		field.Time("status_changed_at").
 			Default(time.Now).
 			Comment("When the status was last changed"),
+		field.Time("sla_overdue_since").
+			Optional().
+			Nillable().
+			Comment("When the lead exceeded the SLA for its current status (cleared on status change)"),
 		field.JSON("custom_fields", map[string]interface{}{}).
 			Optional().
 			Comment("User-defined custom fields (flexible metadata storage)"),
+		field.JSON("tags", []string{}).
+			Optional().
+			Comment("User-applied tags for organizing leads (normalized, e.g. [cold-outreach-q1])"),
+		field.Int("owner_organization_id").
+			Optional().
+			Nillable().
+			Comment("Organization that owns this lead when lead scoping is on (nil = global pool)"),
 		field.String("osm_id").
 			Optional().
 			Comment("OpenStreetMap ID"),
@@ -118,6 +142,19 @@ func (Lead) Fields() []ent.Field {
 			Optional().
 			Comment("Additional metadata from OSM"),
 
+		// Provenance fields
+		field.Enum("source").
+			Values("osm", "csv_import", "manual").
+			Default("osm").
+			Comment("Where the lead was acquired from (existing rows default to osm)"),
+		field.String("source_id").
+			Optional().
+			Comment("Identifier of the lead in its source (e.g., OSM node ID)"),
+		field.Time("last_seen_at").
+			Optional().
+			Nillable().
+			Comment("When the lead was last confirmed present in its source"),
+
 		// Sub-niche categorization fields
 		field.String("sub_niche").
 			Optional().
@@ -184,9 +221,18 @@ func (Lead) Edges() []ent.Edge {
 		edge.To("notes", LeadNote.Type).
 			Comment("Notes and comments on this lead"),
 
+		edge.To("contact_attempts", ContactAttempt.Type).
+			Comment("Logged outreach attempts on this lead"),
+
 		edge.To("status_history", LeadStatusHistory.Type).
 			Comment("History of status changes for this lead"),
 
+		edge.To("changes", LeadChange.Type).
+			Comment("Field-level change history for this lead"),
+
+		edge.To("enrichment_attempts", EnrichmentAttempt.Type).
+			Comment("Enrichment provider calls for this lead"),
+
 		edge.To("assignments", LeadAssignment.Type).
 			Comment("Assignment history for this lead"),
 
@@ -209,6 +255,18 @@ func (Lead) Edges() []ent.Edge {
 
 		edge.To("recommendations", LeadRecommendation.Type).
 			Comment("Recommendations made for this lead"),
+
+		edge.To("verifications", LeadVerification.Type).
+			Comment("Verification events for this lead"),
+
+		edge.To("suppressions", LeadSuppression.Type).
+			Comment("Users and organizations that suppressed this lead"),
+
+		edge.From("owner_organization", Organization.Type).
+			Ref("owned_leads").
+			Field("owner_organization_id").
+			Unique().
+			Comment("Organization that owns this lead"),
 	}
 }
 
@@ -230,7 +288,12 @@ func (Lead) Indexes() []ent.Index {
 
 		// Quality and uniqueness
 		index.Fields("quality_score"),
+		index.Fields("completeness_score"),
+		index.Fields("sla_overdue_since"),
 		index.Fields("osm_id").Unique(),
+		index.Fields("source", "source_id"),
+		index.Fields("last_seen_at"),
+		index.Fields("owner_organization_id"),
 
 		// Sub-niche indexes
 		index.Fields("industry", "sub_niche"),
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -31,9 +32,9 @@ func (LeadAssignment) Fields() []ent.Field {
 			Comment("ID of the user who made the assignment (null for automatic assignments)"),
 
 		field.Enum("assignment_type").
-			Values("auto", "manual").
+			Values("auto", "manual", "claim").
 			Default("manual").
-			Comment("Whether the assignment was automatic or manual"),
+			Comment("Whether the assignment was automatic, manual, or claimed by the rep from a shared pool"),
 
 		field.String("assignment_reason").
 			Optional().
@@ -49,6 +50,17 @@ func (LeadAssignment) Fields() []ent.Field {
 			Default(true).
 			Comment("Whether this is the current assignment (false if reassigned)"),
 
+		field.Time("ended_at").
+			Optional().
+			Nillable().
+			Comment("When the assignment stopped being current"),
+
+		field.Enum("end_reason").
+			Values("reassigned", "released", "expired").
+			Optional().
+			Nillable().
+			Comment("Why the assignment ended: reassigned, claim released, or claim expired after inactivity"),
+
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable().
@@ -89,6 +101,13 @@ func (LeadAssignment) Indexes() []ent.Index {
 		index.Fields("lead_id", "is_active").
 			StorageKey("idx_lead_assignment_lead_active"),
 
+		// At most one current assignment per lead, so concurrent claims
+		// can't both succeed
+		index.Fields("lead_id").
+			Unique().
+			Annotations(entsql.IndexWhere("is_active")).
+			StorageKey("uniq_lead_assignment_lead_current"),
+
 		// Assignment history
 		index.Fields("assigned_at").
 			StorageKey("idx_lead_assignment_time"),
@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LeadChange holds the schema definition for the LeadChange entity.
+// Each row captures the fields modified by a single lead update.
+type LeadChange struct {
+	ent.Schema
+}
+
+// Fields of the LeadChange.
+func (LeadChange) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("lead_id").
+			Positive().
+			Comment("ID of the lead that changed"),
+
+		field.Int("user_id").
+			Optional().
+			Nillable().
+			Comment("ID of the user who made the change (null for system changes)"),
+
+		field.Enum("source").
+			Values("manual", "enrichment", "verification", "system").
+			Comment("What produced the change"),
+
+		field.JSON("changes", map[string][]interface{}{}).
+			Comment("Changed fields as field -> [old, new]"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("When the change occurred"),
+	}
+}
+
+// Edges of the LeadChange.
+func (LeadChange) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("lead", Lead.Type).
+			Ref("changes").
+			Field("lead_id").
+			Unique().
+			Required(),
+
+		edge.From("user", User.Type).
+			Ref("lead_changes").
+			Field("user_id").
+			Unique(),
+	}
+}
+
+// Indexes of the LeadChange.
+func (LeadChange) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("lead_id", "created_at").
+			StorageKey("idx_lead_change_lead_time"),
+
+		index.Fields("user_id").
+			StorageKey("idx_lead_change_user"),
+	}
+}
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LeadLicense holds the schema definition for the LeadLicense entity.
+// A license grants an organization a segment of the global lead pool when
+// lead scoping is on. Empty industry or country match any value, so a
+// license with neither grants the whole pool.
+type LeadLicense struct {
+	ent.Schema
+}
+
+// Fields of the LeadLicense.
+func (LeadLicense) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("organization_id").
+			Positive().
+			Comment("ID of the licensed organization"),
+		field.String("industry").
+			Optional().
+			Comment("Licensed industry (empty = any)"),
+		field.String("country").
+			Optional().
+			MaxLen(2).
+			Comment("Licensed ISO country code (empty = any)"),
+		field.Int("created_by").
+			Optional().
+			Nillable().
+			Comment("Admin who granted the license"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("When the license was granted"),
+	}
+}
+
+// Edges of the LeadLicense.
+func (LeadLicense) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("lead_licenses").
+			Field("organization_id").
+			Unique().
+			Required().
+			Comment("Licensed organization"),
+	}
+}
+
+// Indexes of the LeadLicense.
+func (LeadLicense) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("organization_id", "industry", "country").Unique(),
+	}
+}
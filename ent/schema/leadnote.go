@@ -30,6 +30,10 @@ func (LeadNote) Fields() []ent.Field {
 		field.Bool("is_pinned").
 			Default(false).
 			Comment("Whether this note is pinned to the top"),
+		field.Enum("visibility").
+			Values("private", "team").
+			Default("team").
+			Comment("Who can read the note: its author only (private) or everyone with access to the lead (team)"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable().
@@ -0,0 +1,84 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LeadReindexJob holds the schema definition for the LeadReindexJob entity.
+// A reindex job recomputes derived lead data (scores, cached counts) in the
+// background.
+type LeadReindexJob struct {
+	ent.Schema
+}
+
+// Fields of the LeadReindexJob.
+func (LeadReindexJob) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id").
+			Positive().
+			Comment("Admin who started the reindex"),
+		field.Enum("status").
+			Values("pending", "processing", "completed", "failed").
+			Default("pending").
+			Comment("Reindex job status"),
+		field.Bool("dry_run").
+			Default(false).
+			Comment("Only count leads with drifted data, don't write"),
+		field.Int("total_leads").
+			Default(0).
+			NonNegative().
+			Comment("Leads to process, counted when the job starts"),
+		field.Int("processed").
+			Default(0).
+			NonNegative().
+			Comment("Leads processed so far"),
+		field.Int("affected").
+			Default(0).
+			NonNegative().
+			Comment("Leads whose derived data was (or, in a dry run, would be) updated"),
+		field.String("error_message").
+			Optional().
+			Comment("Error message if the job failed"),
+		field.Time("started_at").
+			Optional().
+			Nillable().
+			Comment("When processing started"),
+		field.Time("completed_at").
+			Optional().
+			Nillable().
+			Comment("When processing finished"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Creation timestamp"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("Last update timestamp"),
+	}
+}
+
+// Edges of the LeadReindexJob.
+func (LeadReindexJob) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("lead_reindex_jobs").
+			Field("user_id").
+			Unique().
+			Required().
+			Comment("Admin who started the reindex"),
+	}
+}
+
+// Indexes of the LeadReindexJob.
+func (LeadReindexJob) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status"),
+		index.Fields("created_at"),
+	}
+}
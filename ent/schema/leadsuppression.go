@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LeadSuppression holds the schema definition for the LeadSuppression entity.
+// Suppressed leads are hidden from the user's searches and exports, and from
+// every member's when shared with an organization. Unlike a soft delete the
+// lead stays visible to everyone else.
+type LeadSuppression struct {
+	ent.Schema
+}
+
+// Fields of the LeadSuppression.
+func (LeadSuppression) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("lead_id").
+			Positive().
+			Comment("ID of the suppressed lead"),
+		field.Int("user_id").
+			Positive().
+			Comment("ID of the user who suppressed the lead"),
+		field.Int("organization_id").
+			Optional().
+			Nillable().
+			Comment("Organization the suppression is shared with (nil = personal)"),
+		field.Text("reason").
+			Optional().
+			MaxLen(500).
+			Comment("Why the lead was disqualified"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("When the lead was suppressed"),
+	}
+}
+
+// Edges of the LeadSuppression.
+func (LeadSuppression) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("lead", Lead.Type).
+			Ref("suppressions").
+			Field("lead_id").
+			Unique().
+			Required().
+			Comment("Suppressed lead"),
+		edge.From("user", User.Type).
+			Ref("lead_suppressions").
+			Field("user_id").
+			Unique().
+			Required().
+			Comment("User who suppressed the lead"),
+		edge.From("organization", Organization.Type).
+			Ref("lead_suppressions").
+			Field("organization_id").
+			Unique().
+			Comment("Organization the suppression is shared with"),
+	}
+}
+
+// Indexes of the LeadSuppression.
+func (LeadSuppression) Indexes() []ent.Index {
+	return []ent.Index{
+		// A user suppresses a lead once, personally or for an organization
+		index.Fields("user_id", "lead_id").Unique(),
+		index.Fields("organization_id"),
+		index.Fields("lead_id"),
+	}
+}
@@ -0,0 +1,85 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LeadVerification holds the schema definition for the LeadVerification entity.
+type LeadVerification struct {
+	ent.Schema
+}
+
+// Fields of the LeadVerification.
+func (LeadVerification) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("lead_id").
+			Positive().
+			Comment("ID of the lead that was verified or unverified"),
+
+		field.Int("user_id").
+			Positive().
+			Comment("ID of the user who performed the action"),
+
+		field.Enum("action").
+			Values("verify", "unverify").
+			Comment("Whether the lead was verified or unverified"),
+
+		field.Enum("method").
+			Values("phone_confirmed", "email_confirmed", "website_checked", "site_visit", "third_party", "manual_review").
+			Optional().
+			Nillable().
+			Comment("How the lead was verified (null for unverify events)"),
+
+		field.Text("note").
+			Optional().
+			MaxLen(1000).
+			Comment("Optional evidence note (e.g., 'Spoke with owner, hours confirmed')"),
+
+		field.Text("reason").
+			Optional().
+			MaxLen(1000).
+			Comment("Reason for unverifying (e.g., 'Phone disconnected')"),
+
+		field.String("data_fingerprint").
+			Optional().
+			Comment("Hash of the lead's contact data at the time of the event"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("When the verification event occurred"),
+	}
+}
+
+// Edges of the LeadVerification.
+func (LeadVerification) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("lead", Lead.Type).
+			Ref("verifications").
+			Field("lead_id").
+			Unique().
+			Required(),
+
+		edge.From("user", User.Type).
+			Ref("lead_verifications").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the LeadVerification.
+func (LeadVerification) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("lead_id", "created_at").
+			StorageKey("idx_lead_verifications_lead_time"),
+
+		index.Fields("user_id").
+			StorageKey("idx_lead_verifications_user"),
+	}
+}
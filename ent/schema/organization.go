@@ -52,6 +52,9 @@ func (Organization) Fields() []ent.Field {
 		field.Bool("active").
 			Default(true).
 			Comment("Whether organization is active"),
+		field.Bool("mask_unassigned_contacts").
+			Default(false).
+			Comment("Whether members without an owner or admin role see masked emails and phones on leads not assigned to them"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable().
@@ -98,6 +101,21 @@ func (Organization) Edges() []ent.Edge {
 			Comment("Organization members"),
 		edge.To("exports", Export.Type).
 			Comment("Organization exports"),
+		edge.To("lead_suppressions", LeadSuppression.Type).
+			Comment("Leads suppressed for all members"),
+		edge.To("export_templates", ExportTemplate.Type).
+			Comment("Export templates shared with all members"),
+		edge.To("scheduled_exports", ScheduledExport.Type).
+			Comment("Recurring exports run as this organization"),
+		edge.To("webhooks", Webhook.Type).
+			Comment("Organization-wide webhooks"),
+		edge.To("owned_leads", Lead.Type).
+			Comment("Leads only this organization sees when lead scoping is on"),
+		edge.To("lead_licenses", LeadLicense.Type).
+			Comment("Global pool segments this organization may see when lead scoping is on"),
+		edge.To("enrichment_budget", EnrichmentBudget.Type).
+			Unique().
+			Comment("Monthly enrichment spend and limits of the organization"),
 	}
 }
 
@@ -25,6 +25,9 @@ func (SavedSearch) Fields() []ent.Field {
 			Comment("Name/title for this saved search"),
 		field.JSON("filters", map[string]interface{}{}).
 			Comment("Search filters (industry, country, city, etc.)"),
+		field.Bool("track_changes").
+			Default(false).
+			Comment("Snapshot results on each run to report added and removed leads"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable().
@@ -45,6 +48,12 @@ func (SavedSearch) Edges() []ent.Edge {
 			Unique().
 			Required().
 			Comment("User who owns this saved search"),
+		edge.To("snapshots", SavedSearchSnapshot.Type).
+			Comment("Result snapshots of past runs, when tracking changes"),
+		edge.To("export_templates", ExportTemplate.Type).
+			Comment("Export templates selecting leads with this search"),
+		edge.To("scheduled_exports", ScheduledExport.Type).
+			Comment("Scheduled exports selecting leads with this search"),
 	}
 }
 
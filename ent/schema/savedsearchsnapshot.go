@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SavedSearchSnapshot holds the schema definition for the SavedSearchSnapshot entity.
+type SavedSearchSnapshot struct {
+	ent.Schema
+}
+
+// Fields of the SavedSearchSnapshot.
+func (SavedSearchSnapshot) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("saved_search_id").
+			Comment("Saved search that was run"),
+		field.Bytes("lead_ids").
+			Comment("Sorted matching lead IDs, delta and varint encoded"),
+		field.Int("lead_count").
+			NonNegative().
+			Comment("Number of lead IDs in the snapshot"),
+		field.Bool("truncated").
+			Default(false).
+			Comment("Results exceeded the snapshot size limit and were cut off"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("When the run happened"),
+	}
+}
+
+// Edges of the SavedSearchSnapshot.
+func (SavedSearchSnapshot) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("saved_search", SavedSearch.Type).
+			Ref("snapshots").
+			Field("saved_search_id").
+			Unique().
+			Required().
+			Comment("Saved search this snapshot belongs to"),
+	}
+}
+
+// Indexes of the SavedSearchSnapshot.
+func (SavedSearchSnapshot) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("saved_search_id", "created_at"),
+	}
+}
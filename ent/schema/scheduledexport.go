@@ -0,0 +1,131 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ScheduledExport holds the schema definition for the ScheduledExport entity.
+// A scheduled export creates an export on a cron cadence from a saved search
+// or inline filters, and delivers it as a download link or to a URL.
+type ScheduledExport struct {
+	ent.Schema
+}
+
+// Fields of the ScheduledExport.
+func (ScheduledExport) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id").
+			Positive().
+			Comment("User who owns this schedule"),
+		field.Int("organization_id").
+			Optional().
+			Nillable().
+			Comment("Organization the exports run as (nil = personal)"),
+		field.String("name").
+			NotEmpty().
+			MaxLen(100).
+			Comment("Name of this schedule"),
+		field.Enum("format").
+			Values("csv", "excel", "vcard", "geojson", "kml").
+			Comment("Export file format"),
+		field.JSON("columns", []string{}).
+			Optional().
+			Comment("Columns to export, in order (empty = all columns)"),
+		field.Int("saved_search_id").
+			Optional().
+			Nillable().
+			Comment("Saved search whose filters select the leads"),
+		field.JSON("filters", map[string]interface{}{}).
+			Optional().
+			Comment("Inline filters in saved search format, when no saved search is referenced"),
+		field.Int("max_leads").
+			Default(0).
+			NonNegative().
+			Comment("Maximum leads per export (0 = default)"),
+		field.Bool("since_last_export").
+			Default(false).
+			Comment("Export only leads created or updated since the previous export (delta)"),
+		field.String("cadence").
+			NotEmpty().
+			MaxLen(100).
+			Comment("Cron expression (5 fields or @daily/@weekly), optionally prefixed with CRON_TZ="),
+		field.Enum("delivery_method").
+			Values("download", "url").
+			Default("download").
+			Comment("How each export is delivered: download link or POST to delivery_url"),
+		field.String("delivery_url").
+			Optional().
+			MaxLen(2048).
+			Comment("URL each export file is POSTed to, for url delivery"),
+		field.String("delivery_secret").
+			Optional().
+			Sensitive().
+			Comment("Secret signing every delivery of this schedule"),
+		field.Enum("status").
+			Values("active", "paused").
+			Default("active").
+			Comment("Paused schedules do not run"),
+		field.Time("next_run_at").
+			Comment("When the schedule runs next"),
+		field.Time("last_run_at").
+			Optional().
+			Nillable().
+			Comment("When the schedule last ran"),
+		field.Int("last_export_id").
+			Optional().
+			Nillable().
+			Comment("Export created by the last successful run"),
+		field.String("last_error").
+			Optional().
+			MaxLen(1000).
+			Comment("Error of the last run, cleared on success"),
+		field.Int("run_count").
+			Default(0).
+			NonNegative().
+			Comment("Number of exports created by this schedule"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("When this schedule was created"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("Last update timestamp"),
+	}
+}
+
+// Edges of the ScheduledExport.
+func (ScheduledExport) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("scheduled_exports").
+			Field("user_id").
+			Unique().
+			Required().
+			Comment("User who owns this schedule"),
+		edge.From("organization", Organization.Type).
+			Ref("scheduled_exports").
+			Field("organization_id").
+			Unique().
+			Comment("Organization the exports run as"),
+		edge.From("saved_search", SavedSearch.Type).
+			Ref("scheduled_exports").
+			Field("saved_search_id").
+			Unique().
+			Comment("Saved search the schedule exports"),
+	}
+}
+
+// Indexes of the ScheduledExport.
+func (ScheduledExport) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+		index.Fields("status", "next_run_at"),
+		index.Fields("saved_search_id"),
+	}
+}
@@ -20,6 +20,10 @@ func (Subscription) Fields() []ent.Field {
 		field.Int("user_id").
 			Positive().
 			Comment("User ID foreign key"),
+		field.Int("organization_id").
+			Optional().
+			Nillable().
+			Comment("Organization the subscription pays for, nil for personal subscriptions"),
 		field.Enum("tier").
 			Values("free", "starter", "pro", "business").
 			Comment("Subscription tier"),
@@ -73,6 +77,7 @@ func (Subscription) Edges() []ent.Edge {
 func (Subscription) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("user_id"),
+		index.Fields("organization_id"),
 		index.Fields("stripe_subscription_id").Unique(),
 		index.Fields("status"),
 		index.Fields("created_at"),
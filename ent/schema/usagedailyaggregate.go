@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UsageDailyAggregate holds the schema definition for the UsageDailyAggregate entity.
+// It is a per-user daily usage rollup, computed nightly from usage logs. Rollups
+// replace usage logs for past days in analytics and outlive the retention purge.
+type UsageDailyAggregate struct {
+	ent.Schema
+}
+
+// Fields of the UsageDailyAggregate.
+func (UsageDailyAggregate) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id").
+			Comment("User who performed the actions"),
+		field.Time("date").
+			Comment("Day of the actions (midnight UTC)"),
+		field.Enum("action").
+			Values(
+				"search",
+				"export",
+				"api_call",
+				"enrichment",
+			).
+			Comment("Type of action performed"),
+		field.Int("events").
+			Default(0).
+			NonNegative().
+			Comment("Number of usage log entries"),
+		field.Int("count").
+			Default(0).
+			NonNegative().
+			Comment("Sum of leads accessed/exported/enriched by the entries"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("Last time the day was rolled up"),
+	}
+}
+
+// Edges of the UsageDailyAggregate.
+func (UsageDailyAggregate) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("usage_daily_aggregates").
+			Field("user_id").
+			Unique().
+			Required().
+			Comment("User who performed the actions"),
+	}
+}
+
+// Indexes of the UsageDailyAggregate.
+func (UsageDailyAggregate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "date", "action").Unique(),
+		index.Fields("date"),
+	}
+}
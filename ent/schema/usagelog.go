@@ -24,6 +24,7 @@ func (UsageLog) Fields() []ent.Field {
 				"search",
 				"export",
 				"api_call",
+				"enrichment",
 			).
 			Comment("Type of action performed"),
 		field.Int("count").
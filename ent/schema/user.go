@@ -120,24 +120,49 @@ func (User) Edges() []ent.Edge {
 			Comment("User's subscription history"),
 		edge.To("exports", Export.Type).
 			Comment("User's export history"),
+		edge.To("import_jobs", ImportJob.Type).
+			Comment("Remote CSV import jobs started by this user"),
+		edge.To("lead_reindex_jobs", LeadReindexJob.Type).
+			Comment("Lead reindex jobs started by this user"),
 		edge.To("api_keys", APIKey.Type).
 			Comment("User's API keys"),
 		edge.To("audit_logs", AuditLog.Type).
 			Comment("User's audit log entries"),
 		edge.To("usage_logs", UsageLog.Type).
 			Comment("User's usage log entries"),
+		edge.To("usage_daily_aggregates", UsageDailyAggregate.Type).
+			Comment("Daily usage rollups for this user"),
 		edge.To("owned_organizations", Organization.Type).
 			Comment("Organizations owned by this user"),
 		edge.To("organization_memberships", OrganizationMember.Type).
 			Comment("Organization memberships"),
 		edge.To("saved_searches", SavedSearch.Type).
 			Comment("User's saved searches"),
+		edge.To("export_templates", ExportTemplate.Type).
+			Comment("User's saved export configurations"),
+		edge.To("scheduled_exports", ScheduledExport.Type).
+			Comment("User's recurring exports"),
 		edge.To("webhooks", Webhook.Type).
 			Comment("User's configured webhooks"),
 		edge.To("lead_notes", LeadNote.Type).
 			Comment("Notes created by this user on leads"),
+		edge.To("lead_suppressions", LeadSuppression.Type).
+			Comment("Leads suppressed by this user"),
+		edge.To("contact_attempts", ContactAttempt.Type).
+			Comment("Outreach attempts logged by this user"),
+		edge.To("notification_preferences", UserNotificationPreference.Type).
+			Comment("Per-event notification channel preferences"),
 		edge.To("lead_status_changes", LeadStatusHistory.Type).
 			Comment("Lead status changes made by this user"),
+		edge.To("lead_changes", LeadChange.Type).
+			Comment("Lead field changes made by this user"),
+		edge.To("enrichment_attempts", EnrichmentAttempt.Type).
+			Comment("Lead enrichments requested by this user"),
+		edge.To("enrichment_budget", EnrichmentBudget.Type).
+			Unique().
+			Comment("Monthly enrichment spend and limits of personal enrichments"),
+		edge.To("lead_verifications", LeadVerification.Type).
+			Comment("Lead verification events recorded by this user"),
 		edge.To("assigned_leads", LeadAssignment.Type).
 			Comment("Leads assigned to this user"),
 		edge.To("lead_assignments_made", LeadAssignment.Type).
@@ -179,6 +204,10 @@ func (User) Edges() []ent.Edge {
 			Comment("Email marketing campaigns created by this user"),
 		edge.To("crm_integrations", CRMIntegration.Type).
 			Comment("CRM integrations configured by this user"),
+		edge.To("crm_push_jobs", CRMPushJob.Type).
+			Comment("CRM push jobs started by this user"),
+		edge.To("integration_connections", IntegrationConnection.Type).
+			Comment("Accounts connected for export delivery"),
 	}
 }
 
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserNotificationPreference holds the schema definition for the UserNotificationPreference entity.
+type UserNotificationPreference struct {
+	ent.Schema
+}
+
+// Fields of the UserNotificationPreference.
+func (UserNotificationPreference) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id").
+			Comment("User the preference belongs to"),
+		field.String("event_type").
+			NotEmpty().
+			MaxLen(50).
+			Comment("Notification event type (see notification.EventTypes)"),
+		field.Bool("email").
+			Comment("Send this event by email"),
+		field.Bool("webhook").
+			Comment("Send this event to the user's webhooks"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("Last update timestamp"),
+	}
+}
+
+// Edges of the UserNotificationPreference.
+func (UserNotificationPreference) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("notification_preferences").
+			Field("user_id").
+			Unique().
+			Required().
+			Comment("User the preference belongs to"),
+	}
+}
+
+// Indexes of the UserNotificationPreference.
+func (UserNotificationPreference) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "event_type").Unique(),
+	}
+}
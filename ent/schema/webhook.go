@@ -20,6 +20,10 @@ func (Webhook) Fields() []ent.Field {
 		field.String("url").
 			NotEmpty().
 			Comment("Webhook endpoint URL"),
+		field.Int("organization_id").
+			Optional().
+			Nillable().
+			Comment("Organization the webhook belongs to (null = personal webhook); user is then the creator"),
 		field.JSON("events", []string{}).
 			Comment("List of events to subscribe to (lead.created, export.completed, etc.)"),
 		field.String("secret").
@@ -28,9 +32,49 @@ func (Webhook) Fields() []ent.Field {
 		field.Bool("active").
 			Default(true).
 			Comment("Whether webhook is active"),
+		field.Time("paused_at").
+			Optional().
+			Nillable().
+			Comment("When deliveries were paused (null when not paused)"),
+		field.JSON("queued_events", []map[string]interface{}{}).
+			Optional().
+			Comment("Events received while paused or outside the delivery window, delivered on resume or when the window opens"),
+		field.String("schema_version").
+			Default("1").
+			Comment("Pinned payload schema version (webhooks created before versioning stay on 1)"),
 		field.String("description").
 			Optional().
 			Comment("User-provided description of webhook"),
+		field.String("delivery_timezone").
+			Optional().
+			Comment("IANA timezone of the delivery window (empty = UTC)"),
+		field.Int("delivery_start_hour").
+			Optional().
+			Nillable().
+			Range(0, 23).
+			Comment("Local hour deliveries start (null = deliver 24/7)"),
+		field.Int("delivery_end_hour").
+			Optional().
+			Nillable().
+			Range(1, 24).
+			Comment("Local hour deliveries stop, exclusive; before start_hour wraps past midnight"),
+		field.Int("batch_max_events").
+			Optional().
+			Nillable().
+			Range(2, 100).
+			Comment("Events per batched delivery (null = one delivery per event)"),
+		field.Int("batch_window_seconds").
+			Optional().
+			Nillable().
+			Range(1, 60).
+			Comment("Seconds a batch collects events before it is delivered, if not full earlier"),
+		field.String("redaction_profile").
+			Optional().
+			Comment("Redaction profile applied to lead data in payloads (empty = none)"),
+		field.Int("lead_change_cursor").
+			Optional().
+			Nillable().
+			Comment("ID of the last lead change sent as lead.updated (null = not subscribed yet)"),
 		field.Int("retry_count").
 			Default(3).
 			Comment("Number of retries for failed deliveries"),
@@ -62,6 +106,13 @@ func (Webhook) Edges() []ent.Edge {
 			Ref("webhooks").
 			Unique().
 			Required(),
+		edge.From("organization", Organization.Type).
+			Ref("webhooks").
+			Field("organization_id").
+			Unique().
+			Comment("Organization this webhook belongs to (optional)"),
+		edge.To("deliveries", WebhookDelivery.Type).
+			Comment("Dead-lettered deliveries that failed every retry"),
 	}
 }
 
@@ -69,6 +120,7 @@ func (Webhook) Edges() []ent.Edge {
 func (Webhook) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("active"),
+		index.Fields("organization_id"),
 		index.Fields("created_at"),
 	}
 }
@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WebhookDelivery holds the schema definition for the WebhookDelivery entity.
+// It is the dead-letter store: a delivery is recorded once every retry has
+// failed, so it can be inspected and replayed after the endpoint is fixed.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("webhook_id").
+			Positive().
+			Comment("Webhook the delivery was for"),
+		field.String("event_id").
+			Optional().
+			Comment("Payload event ID, kept on replays"),
+		field.String("event").
+			NotEmpty().
+			Comment("Event type (lead.created, export.completed, etc.)"),
+		field.JSON("payload", map[string]interface{}{}).
+			Comment("Original event payload in the latest schema version"),
+		field.String("schema_version").
+			Comment("Payload schema version the failed delivery was sent in"),
+		field.JSON("attempts", []map[string]interface{}{}).
+			Optional().
+			Comment("Every delivery attempt, including replays: at, status_code, error"),
+		field.Enum("status").
+			Values("failed", "delivered").
+			Default("failed").
+			Comment("failed until a replay succeeds"),
+		field.String("last_error").
+			Optional().
+			Comment("Error or response status of the last failed attempt"),
+		field.Int("replay_count").
+			Default(0).
+			Comment("Number of manual replays"),
+		field.Time("last_replayed_at").
+			Optional().
+			Nillable().
+			Comment("Last manual replay"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("When the delivery was dead-lettered"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("Last update timestamp"),
+	}
+}
+
+// Edges of the WebhookDelivery.
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("webhook", Webhook.Type).
+			Ref("deliveries").
+			Field("webhook_id").
+			Unique().
+			Required().
+			Comment("Webhook the delivery was for"),
+	}
+}
+
+// Indexes of the WebhookDelivery.
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("webhook_id", "status"),
+		index.Fields("created_at"),
+	}
+}
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ZapierDelivery holds the schema definition for the ZapierDelivery entity.
+// It records that a lead was returned to an API key by the Zapier new-leads
+// poll, so the lead is only charged the first time.
+type ZapierDelivery struct {
+	ent.Schema
+}
+
+// Fields of the ZapierDelivery.
+func (ZapierDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("api_key_id").
+			Positive().
+			Comment("ID of the API key the lead was returned to"),
+		field.Int("lead_id").
+			Positive().
+			Comment("ID of the lead returned"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("When the lead was first returned (and charged)"),
+	}
+}
+
+// Edges of the ZapierDelivery.
+func (ZapierDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("api_key", APIKey.Type).
+			Ref("zapier_deliveries").
+			Field("api_key_id").
+			Unique().
+			Required().
+			Comment("API key the lead was returned to"),
+	}
+}
+
+// Indexes of the ZapierDelivery.
+func (ZapierDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("api_key_id", "lead_id").Unique(),
+	}
+}
@@ -27,6 +27,7 @@ type SMSCampaignQuery struct {
 	predicates   []predicate.SMSCampaign
 	withUser     *UserQuery
 	withMessages *SMSMessageQuery
+	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -302,8 +303,9 @@ func (_q *SMSCampaignQuery) Clone() *SMSCampaignQuery {
 		withUser:     _q.withUser.Clone(),
 		withMessages: _q.withMessages.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -421,6 +423,9 @@ func (_q *SMSCampaignQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -511,6 +516,9 @@ func (_q *SMSCampaignQuery) loadMessages(ctx context.Context, query *SMSMessageQ
 
 func (_q *SMSCampaignQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -576,6 +584,9 @@ func (_q *SMSCampaignQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -593,6 +604,12 @@ func (_q *SMSCampaignQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *SMSCampaignQuery) Modify(modifiers ...func(s *sql.Selector)) *SMSCampaignSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // SMSCampaignGroupBy is the group-by builder for SMSCampaign entities.
 type SMSCampaignGroupBy struct {
 	selector
@@ -682,3 +699,9 @@ func (_s *SMSCampaignSelect) sqlScan(ctx context.Context, root *SMSCampaignQuery
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *SMSCampaignSelect) Modify(modifiers ...func(s *sql.Selector)) *SMSCampaignSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
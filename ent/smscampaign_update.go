@@ -20,8 +20,9 @@ import (
 // SMSCampaignUpdate is the builder for updating SMSCampaign entities.
 type SMSCampaignUpdate struct {
 	config
-	hooks    []Hook
-	mutation *SMSCampaignMutation
+	hooks     []Hook
+	mutation  *SMSCampaignMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the SMSCampaignUpdate builder.
@@ -411,6 +412,12 @@ func (_u *SMSCampaignUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SMSCampaignUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SMSCampaignUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *SMSCampaignUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -563,6 +570,7 @@ func (_u *SMSCampaignUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{smscampaign.Label}
@@ -578,9 +586,10 @@ func (_u *SMSCampaignUpdate) sqlSave(ctx context.Context) (_node int, err error)
 // SMSCampaignUpdateOne is the builder for updating a single SMSCampaign entity.
 type SMSCampaignUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *SMSCampaignMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *SMSCampaignMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUserID sets the "user_id" field.
@@ -977,6 +986,12 @@ func (_u *SMSCampaignUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SMSCampaignUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SMSCampaignUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *SMSCampaignUpdateOne) sqlSave(ctx context.Context) (_node *SMSCampaign, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -1146,6 +1161,7 @@ func (_u *SMSCampaignUpdateOne) sqlSave(ctx context.Context) (_node *SMSCampaign
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &SMSCampaign{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -26,6 +26,7 @@ type SMSMessageQuery struct {
 	predicates   []predicate.SMSMessage
 	withCampaign *SMSCampaignQuery
 	withLead     *LeadQuery
+	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -301,8 +302,9 @@ func (_q *SMSMessageQuery) Clone() *SMSMessageQuery {
 		withCampaign: _q.withCampaign.Clone(),
 		withLead:     _q.withLead.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -420,6 +422,9 @@ func (_q *SMSMessageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*S
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -511,6 +516,9 @@ func (_q *SMSMessageQuery) loadLead(ctx context.Context, query *LeadQuery, nodes
 
 func (_q *SMSMessageQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -579,6 +587,9 @@ func (_q *SMSMessageQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -596,6 +607,12 @@ func (_q *SMSMessageQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *SMSMessageQuery) Modify(modifiers ...func(s *sql.Selector)) *SMSMessageSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // SMSMessageGroupBy is the group-by builder for SMSMessage entities.
 type SMSMessageGroupBy struct {
 	selector
@@ -685,3 +702,9 @@ func (_s *SMSMessageSelect) sqlScan(ctx context.Context, root *SMSMessageQuery,
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *SMSMessageSelect) Modify(modifiers ...func(s *sql.Selector)) *SMSMessageSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
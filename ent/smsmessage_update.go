@@ -20,8 +20,9 @@ import (
 // SMSMessageUpdate is the builder for updating SMSMessage entities.
 type SMSMessageUpdate struct {
 	config
-	hooks    []Hook
-	mutation *SMSMessageMutation
+	hooks     []Hook
+	mutation  *SMSMessageMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the SMSMessageUpdate builder.
@@ -344,6 +345,12 @@ func (_u *SMSMessageUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SMSMessageUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SMSMessageUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *SMSMessageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -468,6 +475,7 @@ func (_u *SMSMessageUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{smsmessage.Label}
@@ -483,9 +491,10 @@ func (_u *SMSMessageUpdate) sqlSave(ctx context.Context) (_node int, err error)
 // SMSMessageUpdateOne is the builder for updating a single SMSMessage entity.
 type SMSMessageUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *SMSMessageMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *SMSMessageMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetCampaignID sets the "campaign_id" field.
@@ -815,6 +824,12 @@ func (_u *SMSMessageUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SMSMessageUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SMSMessageUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *SMSMessageUpdateOne) sqlSave(ctx context.Context) (_node *SMSMessage, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -956,6 +971,7 @@ func (_u *SMSMessageUpdateOne) sqlSave(ctx context.Context) (_node *SMSMessage,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &SMSMessage{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
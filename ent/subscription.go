@@ -20,6 +20,8 @@ type Subscription struct {
 	ID int `json:"id,omitempty"`
 	// User ID foreign key
 	UserID int `json:"user_id,omitempty"`
+	// Organization the subscription pays for, nil for personal subscriptions
+	OrganizationID *int `json:"organization_id,omitempty"`
 	// Subscription tier
 	Tier subscription.Tier `json:"tier,omitempty"`
 	// Subscription status
@@ -73,7 +75,7 @@ func (*Subscription) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case subscription.FieldCancelAtPeriodEnd:
 			values[i] = new(sql.NullBool)
-		case subscription.FieldID, subscription.FieldUserID:
+		case subscription.FieldID, subscription.FieldUserID, subscription.FieldOrganizationID:
 			values[i] = new(sql.NullInt64)
 		case subscription.FieldTier, subscription.FieldStatus, subscription.FieldStripeSubscriptionID, subscription.FieldStripePriceID:
 			values[i] = new(sql.NullString)
@@ -106,6 +108,13 @@ func (_m *Subscription) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UserID = int(value.Int64)
 			}
+		case subscription.FieldOrganizationID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value.Valid {
+				_m.OrganizationID = new(int)
+				*_m.OrganizationID = int(value.Int64)
+			}
 		case subscription.FieldTier:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field tier", values[i])
@@ -211,6 +220,11 @@ func (_m *Subscription) String() string {
 	builder.WriteString("user_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
 	builder.WriteString(", ")
+	if v := _m.OrganizationID; v != nil {
+		builder.WriteString("organization_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("tier=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Tier))
 	builder.WriteString(", ")
@@ -60,6 +60,11 @@ func UserID(v int) predicate.Subscription {
 	return predicate.Subscription(sql.FieldEQ(FieldUserID, v))
 }
 
+// OrganizationID applies equality check predicate on the "organization_id" field. It's identical to OrganizationIDEQ.
+func OrganizationID(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldOrganizationID, v))
+}
+
 // StripeSubscriptionID applies equality check predicate on the "stripe_subscription_id" field. It's identical to StripeSubscriptionIDEQ.
 func StripeSubscriptionID(v string) predicate.Subscription {
 	return predicate.Subscription(sql.FieldEQ(FieldStripeSubscriptionID, v))
@@ -120,6 +125,56 @@ func UserIDNotIn(vs ...int) predicate.Subscription {
 	return predicate.Subscription(sql.FieldNotIn(FieldUserID, vs...))
 }
 
+// OrganizationIDEQ applies the EQ predicate on the "organization_id" field.
+func OrganizationIDEQ(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDNEQ applies the NEQ predicate on the "organization_id" field.
+func OrganizationIDNEQ(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDIn applies the In predicate on the "organization_id" field.
+func OrganizationIDIn(vs ...int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDNotIn applies the NotIn predicate on the "organization_id" field.
+func OrganizationIDNotIn(vs ...int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDGT applies the GT predicate on the "organization_id" field.
+func OrganizationIDGT(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldOrganizationID, v))
+}
+
+// OrganizationIDGTE applies the GTE predicate on the "organization_id" field.
+func OrganizationIDGTE(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldOrganizationID, v))
+}
+
+// OrganizationIDLT applies the LT predicate on the "organization_id" field.
+func OrganizationIDLT(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldOrganizationID, v))
+}
+
+// OrganizationIDLTE applies the LTE predicate on the "organization_id" field.
+func OrganizationIDLTE(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldOrganizationID, v))
+}
+
+// OrganizationIDIsNil applies the IsNil predicate on the "organization_id" field.
+func OrganizationIDIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldOrganizationID))
+}
+
+// OrganizationIDNotNil applies the NotNil predicate on the "organization_id" field.
+func OrganizationIDNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldOrganizationID))
+}
+
 // TierEQ applies the EQ predicate on the "tier" field.
 func TierEQ(v Tier) predicate.Subscription {
 	return predicate.Subscription(sql.FieldEQ(FieldTier, v))
@@ -27,6 +27,20 @@ func (_c *SubscriptionCreate) SetUserID(v int) *SubscriptionCreate {
 	return _c
 }
 
+// SetOrganizationID sets the "organization_id" field.
+func (_c *SubscriptionCreate) SetOrganizationID(v int) *SubscriptionCreate {
+	_c.mutation.SetOrganizationID(v)
+	return _c
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_c *SubscriptionCreate) SetNillableOrganizationID(v *int) *SubscriptionCreate {
+	if v != nil {
+		_c.SetOrganizationID(*v)
+	}
+	return _c
+}
+
 // SetTier sets the "tier" field.
 func (_c *SubscriptionCreate) SetTier(v subscription.Tier) *SubscriptionCreate {
 	_c.mutation.SetTier(v)
@@ -281,6 +295,10 @@ func (_c *SubscriptionCreate) createSpec() (*Subscription, *sqlgraph.CreateSpec)
 		_node = &Subscription{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(subscription.Table, sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeInt))
 	)
+	if value, ok := _c.mutation.OrganizationID(); ok {
+		_spec.SetField(subscription.FieldOrganizationID, field.TypeInt, value)
+		_node.OrganizationID = &value
+	}
 	if value, ok := _c.mutation.Tier(); ok {
 		_spec.SetField(subscription.FieldTier, field.TypeEnum, value)
 		_node.Tier = value
@@ -19,8 +19,9 @@ import (
 // SubscriptionUpdate is the builder for updating Subscription entities.
 type SubscriptionUpdate struct {
 	config
-	hooks    []Hook
-	mutation *SubscriptionMutation
+	hooks     []Hook
+	mutation  *SubscriptionMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the SubscriptionUpdate builder.
@@ -43,6 +44,33 @@ func (_u *SubscriptionUpdate) SetNillableUserID(v *int) *SubscriptionUpdate {
 	return _u
 }
 
+// SetOrganizationID sets the "organization_id" field.
+func (_u *SubscriptionUpdate) SetOrganizationID(v int) *SubscriptionUpdate {
+	_u.mutation.ResetOrganizationID()
+	_u.mutation.SetOrganizationID(v)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_u *SubscriptionUpdate) SetNillableOrganizationID(v *int) *SubscriptionUpdate {
+	if v != nil {
+		_u.SetOrganizationID(*v)
+	}
+	return _u
+}
+
+// AddOrganizationID adds value to the "organization_id" field.
+func (_u *SubscriptionUpdate) AddOrganizationID(v int) *SubscriptionUpdate {
+	_u.mutation.AddOrganizationID(v)
+	return _u
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (_u *SubscriptionUpdate) ClearOrganizationID() *SubscriptionUpdate {
+	_u.mutation.ClearOrganizationID()
+	return _u
+}
+
 // SetTier sets the "tier" field.
 func (_u *SubscriptionUpdate) SetTier(v subscription.Tier) *SubscriptionUpdate {
 	_u.mutation.SetTier(v)
@@ -266,6 +294,12 @@ func (_u *SubscriptionUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SubscriptionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SubscriptionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *SubscriptionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -278,6 +312,15 @@ func (_u *SubscriptionUpdate) sqlSave(ctx context.Context) (_node int, err error
 			}
 		}
 	}
+	if value, ok := _u.mutation.OrganizationID(); ok {
+		_spec.SetField(subscription.FieldOrganizationID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOrganizationID(); ok {
+		_spec.AddField(subscription.FieldOrganizationID, field.TypeInt, value)
+	}
+	if _u.mutation.OrganizationIDCleared() {
+		_spec.ClearField(subscription.FieldOrganizationID, field.TypeInt)
+	}
 	if value, ok := _u.mutation.Tier(); ok {
 		_spec.SetField(subscription.FieldTier, field.TypeEnum, value)
 	}
@@ -349,6 +392,7 @@ func (_u *SubscriptionUpdate) sqlSave(ctx context.Context) (_node int, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{subscription.Label}
@@ -364,9 +408,10 @@ func (_u *SubscriptionUpdate) sqlSave(ctx context.Context) (_node int, err error
 // SubscriptionUpdateOne is the builder for updating a single Subscription entity.
 type SubscriptionUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *SubscriptionMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *SubscriptionMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUserID sets the "user_id" field.
@@ -383,6 +428,33 @@ func (_u *SubscriptionUpdateOne) SetNillableUserID(v *int) *SubscriptionUpdateOn
 	return _u
 }
 
+// SetOrganizationID sets the "organization_id" field.
+func (_u *SubscriptionUpdateOne) SetOrganizationID(v int) *SubscriptionUpdateOne {
+	_u.mutation.ResetOrganizationID()
+	_u.mutation.SetOrganizationID(v)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_u *SubscriptionUpdateOne) SetNillableOrganizationID(v *int) *SubscriptionUpdateOne {
+	if v != nil {
+		_u.SetOrganizationID(*v)
+	}
+	return _u
+}
+
+// AddOrganizationID adds value to the "organization_id" field.
+func (_u *SubscriptionUpdateOne) AddOrganizationID(v int) *SubscriptionUpdateOne {
+	_u.mutation.AddOrganizationID(v)
+	return _u
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (_u *SubscriptionUpdateOne) ClearOrganizationID() *SubscriptionUpdateOne {
+	_u.mutation.ClearOrganizationID()
+	return _u
+}
+
 // SetTier sets the "tier" field.
 func (_u *SubscriptionUpdateOne) SetTier(v subscription.Tier) *SubscriptionUpdateOne {
 	_u.mutation.SetTier(v)
@@ -619,6 +691,12 @@ func (_u *SubscriptionUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SubscriptionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SubscriptionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *SubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *Subscription, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -648,6 +726,15 @@ func (_u *SubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *Subscripti
 			}
 		}
 	}
+	if value, ok := _u.mutation.OrganizationID(); ok {
+		_spec.SetField(subscription.FieldOrganizationID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOrganizationID(); ok {
+		_spec.AddField(subscription.FieldOrganizationID, field.TypeInt, value)
+	}
+	if _u.mutation.OrganizationIDCleared() {
+		_spec.ClearField(subscription.FieldOrganizationID, field.TypeInt)
+	}
 	if value, ok := _u.mutation.Tier(); ok {
 		_spec.SetField(subscription.FieldTier, field.TypeEnum, value)
 	}
@@ -719,6 +806,7 @@ func (_u *SubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *Subscripti
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Subscription{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -29,6 +29,7 @@ type TerritoryQuery struct {
 	withCreatedBy *UserQuery
 	withMembers   *TerritoryMemberQuery
 	withLeads     *LeadQuery
+	modifiers     []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -327,8 +328,9 @@ func (_q *TerritoryQuery) Clone() *TerritoryQuery {
 		withMembers:   _q.withMembers.Clone(),
 		withLeads:     _q.withLeads.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -458,6 +460,9 @@ func (_q *TerritoryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Te
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -583,6 +588,9 @@ func (_q *TerritoryQuery) loadLeads(ctx context.Context, query *LeadQuery, nodes
 
 func (_q *TerritoryQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -648,6 +656,9 @@ func (_q *TerritoryQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -665,6 +676,12 @@ func (_q *TerritoryQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *TerritoryQuery) Modify(modifiers ...func(s *sql.Selector)) *TerritorySelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // TerritoryGroupBy is the group-by builder for Territory entities.
 type TerritoryGroupBy struct {
 	selector
@@ -754,3 +771,9 @@ func (_s *TerritorySelect) sqlScan(ctx context.Context, root *TerritoryQuery, v
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *TerritorySelect) Modify(modifiers ...func(s *sql.Selector)) *TerritorySelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
@@ -22,8 +22,9 @@ import (
 // TerritoryUpdate is the builder for updating Territory entities.
 type TerritoryUpdate struct {
 	config
-	hooks    []Hook
-	mutation *TerritoryMutation
+	hooks     []Hook
+	mutation  *TerritoryMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the TerritoryUpdate builder.
@@ -320,6 +321,12 @@ func (_u *TerritoryUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TerritoryUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TerritoryUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *TerritoryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -510,6 +517,7 @@ func (_u *TerritoryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{territory.Label}
@@ -525,9 +533,10 @@ func (_u *TerritoryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // TerritoryUpdateOne is the builder for updating a single Territory entity.
 type TerritoryUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *TerritoryMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *TerritoryMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetName sets the "name" field.
@@ -831,6 +840,12 @@ func (_u *TerritoryUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TerritoryUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TerritoryUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *TerritoryUpdateOne) sqlSave(ctx context.Context) (_node *Territory, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -1038,6 +1053,7 @@ func (_u *TerritoryUpdateOne) sqlSave(ctx context.Context) (_node *Territory, er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Territory{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -27,6 +27,7 @@ type TerritoryMemberQuery struct {
 	withTerritory *TerritoryQuery
 	withUser      *UserQuery
 	withAddedBy   *UserQuery
+	modifiers     []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -325,8 +326,9 @@ func (_q *TerritoryMemberQuery) Clone() *TerritoryMemberQuery {
 		withUser:      _q.withUser.Clone(),
 		withAddedBy:   _q.withAddedBy.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -456,6 +458,9 @@ func (_q *TerritoryMemberQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -576,6 +581,9 @@ func (_q *TerritoryMemberQuery) loadAddedBy(ctx context.Context, query *UserQuer
 
 func (_q *TerritoryMemberQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -647,6 +655,9 @@ func (_q *TerritoryMemberQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -664,6 +675,12 @@ func (_q *TerritoryMemberQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *TerritoryMemberQuery) Modify(modifiers ...func(s *sql.Selector)) *TerritoryMemberSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // TerritoryMemberGroupBy is the group-by builder for TerritoryMember entities.
 type TerritoryMemberGroupBy struct {
 	selector
@@ -753,3 +770,9 @@ func (_s *TerritoryMemberSelect) sqlScan(ctx context.Context, root *TerritoryMem
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *TerritoryMemberSelect) Modify(modifiers ...func(s *sql.Selector)) *TerritoryMemberSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
@@ -19,8 +19,9 @@ import (
 // TerritoryMemberUpdate is the builder for updating TerritoryMember entities.
 type TerritoryMemberUpdate struct {
 	config
-	hooks    []Hook
-	mutation *TerritoryMemberMutation
+	hooks     []Hook
+	mutation  *TerritoryMemberMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the TerritoryMemberUpdate builder.
@@ -190,6 +191,12 @@ func (_u *TerritoryMemberUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TerritoryMemberUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TerritoryMemberUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *TerritoryMemberUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -292,6 +299,7 @@ func (_u *TerritoryMemberUpdate) sqlSave(ctx context.Context) (_node int, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{territorymember.Label}
@@ -307,9 +315,10 @@ func (_u *TerritoryMemberUpdate) sqlSave(ctx context.Context) (_node int, err er
 // TerritoryMemberUpdateOne is the builder for updating a single TerritoryMember entity.
 type TerritoryMemberUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *TerritoryMemberMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *TerritoryMemberMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetTerritoryID sets the "territory_id" field.
@@ -486,6 +495,12 @@ func (_u *TerritoryMemberUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TerritoryMemberUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TerritoryMemberUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *TerritoryMemberUpdateOne) sqlSave(ctx context.Context) (_node *TerritoryMember, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -605,6 +620,7 @@ func (_u *TerritoryMemberUpdateOne) sqlSave(ctx context.Context) (_node *Territo
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &TerritoryMember{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -26,16 +26,22 @@ type Tx struct {
 	CRMIntegration *CRMIntegrationClient
 	// CRMLeadSync is the client for interacting with the CRMLeadSync builders.
 	CRMLeadSync *CRMLeadSyncClient
+	// CRMPushJob is the client for interacting with the CRMPushJob builders.
+	CRMPushJob *CRMPushJobClient
 	// CallLog is the client for interacting with the CallLog builders.
 	CallLog *CallLogClient
 	// CompetitorMetric is the client for interacting with the CompetitorMetric builders.
 	CompetitorMetric *CompetitorMetricClient
 	// CompetitorProfile is the client for interacting with the CompetitorProfile builders.
 	CompetitorProfile *CompetitorProfileClient
+	// ContactAttempt is the client for interacting with the ContactAttempt builders.
+	ContactAttempt *ContactAttemptClient
 	// EmailCampaign is the client for interacting with the EmailCampaign builders.
 	EmailCampaign *EmailCampaignClient
 	// EmailCampaignRecipient is the client for interacting with the EmailCampaignRecipient builders.
 	EmailCampaignRecipient *EmailCampaignRecipientClient
+	// EmailSend is the client for interacting with the EmailSend builders.
+	EmailSend *EmailSendClient
 	// EmailSequence is the client for interacting with the EmailSequence builders.
 	EmailSequence *EmailSequenceClient
 	// EmailSequenceEnrollment is the client for interacting with the EmailSequenceEnrollment builders.
@@ -44,24 +50,46 @@ type Tx struct {
 	EmailSequenceSend *EmailSequenceSendClient
 	// EmailSequenceStep is the client for interacting with the EmailSequenceStep builders.
 	EmailSequenceStep *EmailSequenceStepClient
+	// EmailSuppression is the client for interacting with the EmailSuppression builders.
+	EmailSuppression *EmailSuppressionClient
+	// EnrichmentAttempt is the client for interacting with the EnrichmentAttempt builders.
+	EnrichmentAttempt *EnrichmentAttemptClient
+	// EnrichmentBudget is the client for interacting with the EnrichmentBudget builders.
+	EnrichmentBudget *EnrichmentBudgetClient
 	// Experiment is the client for interacting with the Experiment builders.
 	Experiment *ExperimentClient
 	// ExperimentAssignment is the client for interacting with the ExperimentAssignment builders.
 	ExperimentAssignment *ExperimentAssignmentClient
 	// Export is the client for interacting with the Export builders.
 	Export *ExportClient
+	// ExportTemplate is the client for interacting with the ExportTemplate builders.
+	ExportTemplate *ExportTemplateClient
+	// ImportJob is the client for interacting with the ImportJob builders.
+	ImportJob *ImportJobClient
 	// Industry is the client for interacting with the Industry builders.
 	Industry *IndustryClient
+	// IntegrationConnection is the client for interacting with the IntegrationConnection builders.
+	IntegrationConnection *IntegrationConnectionClient
 	// Lead is the client for interacting with the Lead builders.
 	Lead *LeadClient
 	// LeadAssignment is the client for interacting with the LeadAssignment builders.
 	LeadAssignment *LeadAssignmentClient
+	// LeadChange is the client for interacting with the LeadChange builders.
+	LeadChange *LeadChangeClient
+	// LeadLicense is the client for interacting with the LeadLicense builders.
+	LeadLicense *LeadLicenseClient
 	// LeadNote is the client for interacting with the LeadNote builders.
 	LeadNote *LeadNoteClient
 	// LeadRecommendation is the client for interacting with the LeadRecommendation builders.
 	LeadRecommendation *LeadRecommendationClient
+	// LeadReindexJob is the client for interacting with the LeadReindexJob builders.
+	LeadReindexJob *LeadReindexJobClient
 	// LeadStatusHistory is the client for interacting with the LeadStatusHistory builders.
 	LeadStatusHistory *LeadStatusHistoryClient
+	// LeadSuppression is the client for interacting with the LeadSuppression builders.
+	LeadSuppression *LeadSuppressionClient
+	// LeadVerification is the client for interacting with the LeadVerification builders.
+	LeadVerification *LeadVerificationClient
 	// MarketReport is the client for interacting with the MarketReport builders.
 	MarketReport *MarketReportClient
 	// Organization is the client for interacting with the Organization builders.
@@ -76,20 +104,32 @@ type Tx struct {
 	SMSMessage *SMSMessageClient
 	// SavedSearch is the client for interacting with the SavedSearch builders.
 	SavedSearch *SavedSearchClient
+	// SavedSearchSnapshot is the client for interacting with the SavedSearchSnapshot builders.
+	SavedSearchSnapshot *SavedSearchSnapshotClient
+	// ScheduledExport is the client for interacting with the ScheduledExport builders.
+	ScheduledExport *ScheduledExportClient
 	// Subscription is the client for interacting with the Subscription builders.
 	Subscription *SubscriptionClient
 	// Territory is the client for interacting with the Territory builders.
 	Territory *TerritoryClient
 	// TerritoryMember is the client for interacting with the TerritoryMember builders.
 	TerritoryMember *TerritoryMemberClient
+	// UsageDailyAggregate is the client for interacting with the UsageDailyAggregate builders.
+	UsageDailyAggregate *UsageDailyAggregateClient
 	// UsageLog is the client for interacting with the UsageLog builders.
 	UsageLog *UsageLogClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// UserBehavior is the client for interacting with the UserBehavior builders.
 	UserBehavior *UserBehaviorClient
+	// UserNotificationPreference is the client for interacting with the UserNotificationPreference builders.
+	UserNotificationPreference *UserNotificationPreferenceClient
 	// Webhook is the client for interacting with the Webhook builders.
 	Webhook *WebhookClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
+	// ZapierDelivery is the client for interacting with the ZapierDelivery builders.
+	ZapierDelivery *ZapierDeliveryClient
 
 	// lazily loaded.
 	client     *Client
@@ -228,24 +268,38 @@ func (tx *Tx) init() {
 	tx.AuditLog = NewAuditLogClient(tx.config)
 	tx.CRMIntegration = NewCRMIntegrationClient(tx.config)
 	tx.CRMLeadSync = NewCRMLeadSyncClient(tx.config)
+	tx.CRMPushJob = NewCRMPushJobClient(tx.config)
 	tx.CallLog = NewCallLogClient(tx.config)
 	tx.CompetitorMetric = NewCompetitorMetricClient(tx.config)
 	tx.CompetitorProfile = NewCompetitorProfileClient(tx.config)
+	tx.ContactAttempt = NewContactAttemptClient(tx.config)
 	tx.EmailCampaign = NewEmailCampaignClient(tx.config)
 	tx.EmailCampaignRecipient = NewEmailCampaignRecipientClient(tx.config)
+	tx.EmailSend = NewEmailSendClient(tx.config)
 	tx.EmailSequence = NewEmailSequenceClient(tx.config)
 	tx.EmailSequenceEnrollment = NewEmailSequenceEnrollmentClient(tx.config)
 	tx.EmailSequenceSend = NewEmailSequenceSendClient(tx.config)
 	tx.EmailSequenceStep = NewEmailSequenceStepClient(tx.config)
+	tx.EmailSuppression = NewEmailSuppressionClient(tx.config)
+	tx.EnrichmentAttempt = NewEnrichmentAttemptClient(tx.config)
+	tx.EnrichmentBudget = NewEnrichmentBudgetClient(tx.config)
 	tx.Experiment = NewExperimentClient(tx.config)
 	tx.ExperimentAssignment = NewExperimentAssignmentClient(tx.config)
 	tx.Export = NewExportClient(tx.config)
+	tx.ExportTemplate = NewExportTemplateClient(tx.config)
+	tx.ImportJob = NewImportJobClient(tx.config)
 	tx.Industry = NewIndustryClient(tx.config)
+	tx.IntegrationConnection = NewIntegrationConnectionClient(tx.config)
 	tx.Lead = NewLeadClient(tx.config)
 	tx.LeadAssignment = NewLeadAssignmentClient(tx.config)
+	tx.LeadChange = NewLeadChangeClient(tx.config)
+	tx.LeadLicense = NewLeadLicenseClient(tx.config)
 	tx.LeadNote = NewLeadNoteClient(tx.config)
 	tx.LeadRecommendation = NewLeadRecommendationClient(tx.config)
+	tx.LeadReindexJob = NewLeadReindexJobClient(tx.config)
 	tx.LeadStatusHistory = NewLeadStatusHistoryClient(tx.config)
+	tx.LeadSuppression = NewLeadSuppressionClient(tx.config)
+	tx.LeadVerification = NewLeadVerificationClient(tx.config)
 	tx.MarketReport = NewMarketReportClient(tx.config)
 	tx.Organization = NewOrganizationClient(tx.config)
 	tx.OrganizationMember = NewOrganizationMemberClient(tx.config)
@@ -253,13 +307,19 @@ func (tx *Tx) init() {
 	tx.SMSCampaign = NewSMSCampaignClient(tx.config)
 	tx.SMSMessage = NewSMSMessageClient(tx.config)
 	tx.SavedSearch = NewSavedSearchClient(tx.config)
+	tx.SavedSearchSnapshot = NewSavedSearchSnapshotClient(tx.config)
+	tx.ScheduledExport = NewScheduledExportClient(tx.config)
 	tx.Subscription = NewSubscriptionClient(tx.config)
 	tx.Territory = NewTerritoryClient(tx.config)
 	tx.TerritoryMember = NewTerritoryMemberClient(tx.config)
+	tx.UsageDailyAggregate = NewUsageDailyAggregateClient(tx.config)
 	tx.UsageLog = NewUsageLogClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 	tx.UserBehavior = NewUserBehaviorClient(tx.config)
+	tx.UserNotificationPreference = NewUserNotificationPreferenceClient(tx.config)
 	tx.Webhook = NewWebhookClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
+	tx.ZapierDelivery = NewZapierDeliveryClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
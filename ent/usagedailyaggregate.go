@@ -0,0 +1,190 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/jordanlanch/industrydb/ent/usagedailyaggregate"
+	"github.com/jordanlanch/industrydb/ent/user"
+)
+
+// UsageDailyAggregate is the model entity for the UsageDailyAggregate schema.
+type UsageDailyAggregate struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// User who performed the actions
+	UserID int `json:"user_id,omitempty"`
+	// Day of the actions (midnight UTC)
+	Date time.Time `json:"date,omitempty"`
+	// Type of action performed
+	Action usagedailyaggregate.Action `json:"action,omitempty"`
+	// Number of usage log entries
+	Events int `json:"events,omitempty"`
+	// Sum of leads accessed/exported/enriched by the entries
+	Count int `json:"count,omitempty"`
+	// Last time the day was rolled up
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UsageDailyAggregateQuery when eager-loading is set.
+	Edges        UsageDailyAggregateEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// UsageDailyAggregateEdges holds the relations/edges for other nodes in the graph.
+type UsageDailyAggregateEdges struct {
+	// User who performed the actions
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UsageDailyAggregateEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UsageDailyAggregate) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case usagedailyaggregate.FieldID, usagedailyaggregate.FieldUserID, usagedailyaggregate.FieldEvents, usagedailyaggregate.FieldCount:
+			values[i] = new(sql.NullInt64)
+		case usagedailyaggregate.FieldAction:
+			values[i] = new(sql.NullString)
+		case usagedailyaggregate.FieldDate, usagedailyaggregate.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UsageDailyAggregate fields.
+func (_m *UsageDailyAggregate) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case usagedailyaggregate.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case usagedailyaggregate.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case usagedailyaggregate.FieldDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field date", values[i])
+			} else if value.Valid {
+				_m.Date = value.Time
+			}
+		case usagedailyaggregate.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				_m.Action = usagedailyaggregate.Action(value.String)
+			}
+		case usagedailyaggregate.FieldEvents:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field events", values[i])
+			} else if value.Valid {
+				_m.Events = int(value.Int64)
+			}
+		case usagedailyaggregate.FieldCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field count", values[i])
+			} else if value.Valid {
+				_m.Count = int(value.Int64)
+			}
+		case usagedailyaggregate.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UsageDailyAggregate.
+// This includes values selected through modifiers, order, etc.
+func (_m *UsageDailyAggregate) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the UsageDailyAggregate entity.
+func (_m *UsageDailyAggregate) QueryUser() *UserQuery {
+	return NewUsageDailyAggregateClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this UsageDailyAggregate.
+// Note that you need to call UsageDailyAggregate.Unwrap() before calling this method if this UsageDailyAggregate
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *UsageDailyAggregate) Update() *UsageDailyAggregateUpdateOne {
+	return NewUsageDailyAggregateClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the UsageDailyAggregate entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *UsageDailyAggregate) Unwrap() *UsageDailyAggregate {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UsageDailyAggregate is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *UsageDailyAggregate) String() string {
+	var builder strings.Builder
+	builder.WriteString("UsageDailyAggregate(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("date=")
+	builder.WriteString(_m.Date.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Action))
+	builder.WriteString(", ")
+	builder.WriteString("events=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Events))
+	builder.WriteString(", ")
+	builder.WriteString("count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Count))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UsageDailyAggregates is a parsable slice of UsageDailyAggregate.
+type UsageDailyAggregates []*UsageDailyAggregate
@@ -0,0 +1,319 @@
+// Code generated by ent, DO NOT EDIT.
+
+package usagedailyaggregate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldEQ(FieldUserID, v))
+}
+
+// Date applies equality check predicate on the "date" field. It's identical to DateEQ.
+func Date(v time.Time) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldEQ(FieldDate, v))
+}
+
+// Events applies equality check predicate on the "events" field. It's identical to EventsEQ.
+func Events(v int) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldEQ(FieldEvents, v))
+}
+
+// Count applies equality check predicate on the "count" field. It's identical to CountEQ.
+func Count(v int) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldEQ(FieldCount, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// DateEQ applies the EQ predicate on the "date" field.
+func DateEQ(v time.Time) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldEQ(FieldDate, v))
+}
+
+// DateNEQ applies the NEQ predicate on the "date" field.
+func DateNEQ(v time.Time) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldNEQ(FieldDate, v))
+}
+
+// DateIn applies the In predicate on the "date" field.
+func DateIn(vs ...time.Time) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldIn(FieldDate, vs...))
+}
+
+// DateNotIn applies the NotIn predicate on the "date" field.
+func DateNotIn(vs ...time.Time) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldNotIn(FieldDate, vs...))
+}
+
+// DateGT applies the GT predicate on the "date" field.
+func DateGT(v time.Time) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldGT(FieldDate, v))
+}
+
+// DateGTE applies the GTE predicate on the "date" field.
+func DateGTE(v time.Time) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldGTE(FieldDate, v))
+}
+
+// DateLT applies the LT predicate on the "date" field.
+func DateLT(v time.Time) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldLT(FieldDate, v))
+}
+
+// DateLTE applies the LTE predicate on the "date" field.
+func DateLTE(v time.Time) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldLTE(FieldDate, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v Action) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v Action) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...Action) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...Action) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// EventsEQ applies the EQ predicate on the "events" field.
+func EventsEQ(v int) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldEQ(FieldEvents, v))
+}
+
+// EventsNEQ applies the NEQ predicate on the "events" field.
+func EventsNEQ(v int) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldNEQ(FieldEvents, v))
+}
+
+// EventsIn applies the In predicate on the "events" field.
+func EventsIn(vs ...int) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldIn(FieldEvents, vs...))
+}
+
+// EventsNotIn applies the NotIn predicate on the "events" field.
+func EventsNotIn(vs ...int) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldNotIn(FieldEvents, vs...))
+}
+
+// EventsGT applies the GT predicate on the "events" field.
+func EventsGT(v int) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldGT(FieldEvents, v))
+}
+
+// EventsGTE applies the GTE predicate on the "events" field.
+func EventsGTE(v int) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldGTE(FieldEvents, v))
+}
+
+// EventsLT applies the LT predicate on the "events" field.
+func EventsLT(v int) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldLT(FieldEvents, v))
+}
+
+// EventsLTE applies the LTE predicate on the "events" field.
+func EventsLTE(v int) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldLTE(FieldEvents, v))
+}
+
+// CountEQ applies the EQ predicate on the "count" field.
+func CountEQ(v int) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldEQ(FieldCount, v))
+}
+
+// CountNEQ applies the NEQ predicate on the "count" field.
+func CountNEQ(v int) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldNEQ(FieldCount, v))
+}
+
+// CountIn applies the In predicate on the "count" field.
+func CountIn(vs ...int) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldIn(FieldCount, vs...))
+}
+
+// CountNotIn applies the NotIn predicate on the "count" field.
+func CountNotIn(vs ...int) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldNotIn(FieldCount, vs...))
+}
+
+// CountGT applies the GT predicate on the "count" field.
+func CountGT(v int) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldGT(FieldCount, v))
+}
+
+// CountGTE applies the GTE predicate on the "count" field.
+func CountGTE(v int) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldGTE(FieldCount, v))
+}
+
+// CountLT applies the LT predicate on the "count" field.
+func CountLT(v int) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldLT(FieldCount, v))
+}
+
+// CountLTE applies the LTE predicate on the "count" field.
+func CountLTE(v int) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldLTE(FieldCount, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UsageDailyAggregate) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UsageDailyAggregate) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UsageDailyAggregate) predicate.UsageDailyAggregate {
+	return predicate.UsageDailyAggregate(sql.NotPredicates(p))
+}
@@ -0,0 +1,328 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/usagedailyaggregate"
+	"github.com/jordanlanch/industrydb/ent/user"
+)
+
+// UsageDailyAggregateCreate is the builder for creating a UsageDailyAggregate entity.
+type UsageDailyAggregateCreate struct {
+	config
+	mutation *UsageDailyAggregateMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *UsageDailyAggregateCreate) SetUserID(v int) *UsageDailyAggregateCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetDate sets the "date" field.
+func (_c *UsageDailyAggregateCreate) SetDate(v time.Time) *UsageDailyAggregateCreate {
+	_c.mutation.SetDate(v)
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *UsageDailyAggregateCreate) SetAction(v usagedailyaggregate.Action) *UsageDailyAggregateCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetEvents sets the "events" field.
+func (_c *UsageDailyAggregateCreate) SetEvents(v int) *UsageDailyAggregateCreate {
+	_c.mutation.SetEvents(v)
+	return _c
+}
+
+// SetNillableEvents sets the "events" field if the given value is not nil.
+func (_c *UsageDailyAggregateCreate) SetNillableEvents(v *int) *UsageDailyAggregateCreate {
+	if v != nil {
+		_c.SetEvents(*v)
+	}
+	return _c
+}
+
+// SetCount sets the "count" field.
+func (_c *UsageDailyAggregateCreate) SetCount(v int) *UsageDailyAggregateCreate {
+	_c.mutation.SetCount(v)
+	return _c
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (_c *UsageDailyAggregateCreate) SetNillableCount(v *int) *UsageDailyAggregateCreate {
+	if v != nil {
+		_c.SetCount(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *UsageDailyAggregateCreate) SetUpdatedAt(v time.Time) *UsageDailyAggregateCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *UsageDailyAggregateCreate) SetNillableUpdatedAt(v *time.Time) *UsageDailyAggregateCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *UsageDailyAggregateCreate) SetUser(v *User) *UsageDailyAggregateCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the UsageDailyAggregateMutation object of the builder.
+func (_c *UsageDailyAggregateCreate) Mutation() *UsageDailyAggregateMutation {
+	return _c.mutation
+}
+
+// Save creates the UsageDailyAggregate in the database.
+func (_c *UsageDailyAggregateCreate) Save(ctx context.Context) (*UsageDailyAggregate, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *UsageDailyAggregateCreate) SaveX(ctx context.Context) *UsageDailyAggregate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UsageDailyAggregateCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UsageDailyAggregateCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *UsageDailyAggregateCreate) defaults() {
+	if _, ok := _c.mutation.Events(); !ok {
+		v := usagedailyaggregate.DefaultEvents
+		_c.mutation.SetEvents(v)
+	}
+	if _, ok := _c.mutation.Count(); !ok {
+		v := usagedailyaggregate.DefaultCount
+		_c.mutation.SetCount(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := usagedailyaggregate.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *UsageDailyAggregateCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UsageDailyAggregate.user_id"`)}
+	}
+	if _, ok := _c.mutation.Date(); !ok {
+		return &ValidationError{Name: "date", err: errors.New(`ent: missing required field "UsageDailyAggregate.date"`)}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "UsageDailyAggregate.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := usagedailyaggregate.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "UsageDailyAggregate.action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Events(); !ok {
+		return &ValidationError{Name: "events", err: errors.New(`ent: missing required field "UsageDailyAggregate.events"`)}
+	}
+	if v, ok := _c.mutation.Events(); ok {
+		if err := usagedailyaggregate.EventsValidator(v); err != nil {
+			return &ValidationError{Name: "events", err: fmt.Errorf(`ent: validator failed for field "UsageDailyAggregate.events": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Count(); !ok {
+		return &ValidationError{Name: "count", err: errors.New(`ent: missing required field "UsageDailyAggregate.count"`)}
+	}
+	if v, ok := _c.mutation.Count(); ok {
+		if err := usagedailyaggregate.CountValidator(v); err != nil {
+			return &ValidationError{Name: "count", err: fmt.Errorf(`ent: validator failed for field "UsageDailyAggregate.count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "UsageDailyAggregate.updated_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "UsageDailyAggregate.user"`)}
+	}
+	return nil
+}
+
+func (_c *UsageDailyAggregateCreate) sqlSave(ctx context.Context) (*UsageDailyAggregate, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *UsageDailyAggregateCreate) createSpec() (*UsageDailyAggregate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UsageDailyAggregate{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(usagedailyaggregate.Table, sqlgraph.NewFieldSpec(usagedailyaggregate.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Date(); ok {
+		_spec.SetField(usagedailyaggregate.FieldDate, field.TypeTime, value)
+		_node.Date = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(usagedailyaggregate.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.Events(); ok {
+		_spec.SetField(usagedailyaggregate.FieldEvents, field.TypeInt, value)
+		_node.Events = value
+	}
+	if value, ok := _c.mutation.Count(); ok {
+		_spec.SetField(usagedailyaggregate.FieldCount, field.TypeInt, value)
+		_node.Count = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(usagedailyaggregate.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   usagedailyaggregate.UserTable,
+			Columns: []string{usagedailyaggregate.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// UsageDailyAggregateCreateBulk is the builder for creating many UsageDailyAggregate entities in bulk.
+type UsageDailyAggregateCreateBulk struct {
+	config
+	err      error
+	builders []*UsageDailyAggregateCreate
+}
+
+// Save creates the UsageDailyAggregate entities in the database.
+func (_c *UsageDailyAggregateCreateBulk) Save(ctx context.Context) ([]*UsageDailyAggregate, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*UsageDailyAggregate, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UsageDailyAggregateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *UsageDailyAggregateCreateBulk) SaveX(ctx context.Context) []*UsageDailyAggregate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UsageDailyAggregateCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UsageDailyAggregateCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,565 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+	"github.com/jordanlanch/industrydb/ent/usagedailyaggregate"
+	"github.com/jordanlanch/industrydb/ent/user"
+)
+
+// UsageDailyAggregateUpdate is the builder for updating UsageDailyAggregate entities.
+type UsageDailyAggregateUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *UsageDailyAggregateMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the UsageDailyAggregateUpdate builder.
+func (_u *UsageDailyAggregateUpdate) Where(ps ...predicate.UsageDailyAggregate) *UsageDailyAggregateUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *UsageDailyAggregateUpdate) SetUserID(v int) *UsageDailyAggregateUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *UsageDailyAggregateUpdate) SetNillableUserID(v *int) *UsageDailyAggregateUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetDate sets the "date" field.
+func (_u *UsageDailyAggregateUpdate) SetDate(v time.Time) *UsageDailyAggregateUpdate {
+	_u.mutation.SetDate(v)
+	return _u
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (_u *UsageDailyAggregateUpdate) SetNillableDate(v *time.Time) *UsageDailyAggregateUpdate {
+	if v != nil {
+		_u.SetDate(*v)
+	}
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *UsageDailyAggregateUpdate) SetAction(v usagedailyaggregate.Action) *UsageDailyAggregateUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *UsageDailyAggregateUpdate) SetNillableAction(v *usagedailyaggregate.Action) *UsageDailyAggregateUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetEvents sets the "events" field.
+func (_u *UsageDailyAggregateUpdate) SetEvents(v int) *UsageDailyAggregateUpdate {
+	_u.mutation.ResetEvents()
+	_u.mutation.SetEvents(v)
+	return _u
+}
+
+// SetNillableEvents sets the "events" field if the given value is not nil.
+func (_u *UsageDailyAggregateUpdate) SetNillableEvents(v *int) *UsageDailyAggregateUpdate {
+	if v != nil {
+		_u.SetEvents(*v)
+	}
+	return _u
+}
+
+// AddEvents adds value to the "events" field.
+func (_u *UsageDailyAggregateUpdate) AddEvents(v int) *UsageDailyAggregateUpdate {
+	_u.mutation.AddEvents(v)
+	return _u
+}
+
+// SetCount sets the "count" field.
+func (_u *UsageDailyAggregateUpdate) SetCount(v int) *UsageDailyAggregateUpdate {
+	_u.mutation.ResetCount()
+	_u.mutation.SetCount(v)
+	return _u
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (_u *UsageDailyAggregateUpdate) SetNillableCount(v *int) *UsageDailyAggregateUpdate {
+	if v != nil {
+		_u.SetCount(*v)
+	}
+	return _u
+}
+
+// AddCount adds value to the "count" field.
+func (_u *UsageDailyAggregateUpdate) AddCount(v int) *UsageDailyAggregateUpdate {
+	_u.mutation.AddCount(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UsageDailyAggregateUpdate) SetUpdatedAt(v time.Time) *UsageDailyAggregateUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *UsageDailyAggregateUpdate) SetUser(v *User) *UsageDailyAggregateUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the UsageDailyAggregateMutation object of the builder.
+func (_u *UsageDailyAggregateUpdate) Mutation() *UsageDailyAggregateMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *UsageDailyAggregateUpdate) ClearUser() *UsageDailyAggregateUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UsageDailyAggregateUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UsageDailyAggregateUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UsageDailyAggregateUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UsageDailyAggregateUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *UsageDailyAggregateUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := usagedailyaggregate.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UsageDailyAggregateUpdate) check() error {
+	if v, ok := _u.mutation.Action(); ok {
+		if err := usagedailyaggregate.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "UsageDailyAggregate.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Events(); ok {
+		if err := usagedailyaggregate.EventsValidator(v); err != nil {
+			return &ValidationError{Name: "events", err: fmt.Errorf(`ent: validator failed for field "UsageDailyAggregate.events": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Count(); ok {
+		if err := usagedailyaggregate.CountValidator(v); err != nil {
+			return &ValidationError{Name: "count", err: fmt.Errorf(`ent: validator failed for field "UsageDailyAggregate.count": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UsageDailyAggregate.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *UsageDailyAggregateUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UsageDailyAggregateUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *UsageDailyAggregateUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usagedailyaggregate.Table, usagedailyaggregate.Columns, sqlgraph.NewFieldSpec(usagedailyaggregate.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Date(); ok {
+		_spec.SetField(usagedailyaggregate.FieldDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(usagedailyaggregate.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Events(); ok {
+		_spec.SetField(usagedailyaggregate.FieldEvents, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEvents(); ok {
+		_spec.AddField(usagedailyaggregate.FieldEvents, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Count(); ok {
+		_spec.SetField(usagedailyaggregate.FieldCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCount(); ok {
+		_spec.AddField(usagedailyaggregate.FieldCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(usagedailyaggregate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   usagedailyaggregate.UserTable,
+			Columns: []string{usagedailyaggregate.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   usagedailyaggregate.UserTable,
+			Columns: []string{usagedailyaggregate.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usagedailyaggregate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UsageDailyAggregateUpdateOne is the builder for updating a single UsageDailyAggregate entity.
+type UsageDailyAggregateUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *UsageDailyAggregateMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *UsageDailyAggregateUpdateOne) SetUserID(v int) *UsageDailyAggregateUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *UsageDailyAggregateUpdateOne) SetNillableUserID(v *int) *UsageDailyAggregateUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetDate sets the "date" field.
+func (_u *UsageDailyAggregateUpdateOne) SetDate(v time.Time) *UsageDailyAggregateUpdateOne {
+	_u.mutation.SetDate(v)
+	return _u
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (_u *UsageDailyAggregateUpdateOne) SetNillableDate(v *time.Time) *UsageDailyAggregateUpdateOne {
+	if v != nil {
+		_u.SetDate(*v)
+	}
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *UsageDailyAggregateUpdateOne) SetAction(v usagedailyaggregate.Action) *UsageDailyAggregateUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *UsageDailyAggregateUpdateOne) SetNillableAction(v *usagedailyaggregate.Action) *UsageDailyAggregateUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetEvents sets the "events" field.
+func (_u *UsageDailyAggregateUpdateOne) SetEvents(v int) *UsageDailyAggregateUpdateOne {
+	_u.mutation.ResetEvents()
+	_u.mutation.SetEvents(v)
+	return _u
+}
+
+// SetNillableEvents sets the "events" field if the given value is not nil.
+func (_u *UsageDailyAggregateUpdateOne) SetNillableEvents(v *int) *UsageDailyAggregateUpdateOne {
+	if v != nil {
+		_u.SetEvents(*v)
+	}
+	return _u
+}
+
+// AddEvents adds value to the "events" field.
+func (_u *UsageDailyAggregateUpdateOne) AddEvents(v int) *UsageDailyAggregateUpdateOne {
+	_u.mutation.AddEvents(v)
+	return _u
+}
+
+// SetCount sets the "count" field.
+func (_u *UsageDailyAggregateUpdateOne) SetCount(v int) *UsageDailyAggregateUpdateOne {
+	_u.mutation.ResetCount()
+	_u.mutation.SetCount(v)
+	return _u
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (_u *UsageDailyAggregateUpdateOne) SetNillableCount(v *int) *UsageDailyAggregateUpdateOne {
+	if v != nil {
+		_u.SetCount(*v)
+	}
+	return _u
+}
+
+// AddCount adds value to the "count" field.
+func (_u *UsageDailyAggregateUpdateOne) AddCount(v int) *UsageDailyAggregateUpdateOne {
+	_u.mutation.AddCount(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UsageDailyAggregateUpdateOne) SetUpdatedAt(v time.Time) *UsageDailyAggregateUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *UsageDailyAggregateUpdateOne) SetUser(v *User) *UsageDailyAggregateUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the UsageDailyAggregateMutation object of the builder.
+func (_u *UsageDailyAggregateUpdateOne) Mutation() *UsageDailyAggregateMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *UsageDailyAggregateUpdateOne) ClearUser() *UsageDailyAggregateUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the UsageDailyAggregateUpdate builder.
+func (_u *UsageDailyAggregateUpdateOne) Where(ps ...predicate.UsageDailyAggregate) *UsageDailyAggregateUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UsageDailyAggregateUpdateOne) Select(field string, fields ...string) *UsageDailyAggregateUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated UsageDailyAggregate entity.
+func (_u *UsageDailyAggregateUpdateOne) Save(ctx context.Context) (*UsageDailyAggregate, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UsageDailyAggregateUpdateOne) SaveX(ctx context.Context) *UsageDailyAggregate {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UsageDailyAggregateUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UsageDailyAggregateUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *UsageDailyAggregateUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := usagedailyaggregate.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UsageDailyAggregateUpdateOne) check() error {
+	if v, ok := _u.mutation.Action(); ok {
+		if err := usagedailyaggregate.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "UsageDailyAggregate.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Events(); ok {
+		if err := usagedailyaggregate.EventsValidator(v); err != nil {
+			return &ValidationError{Name: "events", err: fmt.Errorf(`ent: validator failed for field "UsageDailyAggregate.events": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Count(); ok {
+		if err := usagedailyaggregate.CountValidator(v); err != nil {
+			return &ValidationError{Name: "count", err: fmt.Errorf(`ent: validator failed for field "UsageDailyAggregate.count": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UsageDailyAggregate.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *UsageDailyAggregateUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UsageDailyAggregateUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *UsageDailyAggregateUpdateOne) sqlSave(ctx context.Context) (_node *UsageDailyAggregate, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usagedailyaggregate.Table, usagedailyaggregate.Columns, sqlgraph.NewFieldSpec(usagedailyaggregate.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UsageDailyAggregate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usagedailyaggregate.FieldID)
+		for _, f := range fields {
+			if !usagedailyaggregate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != usagedailyaggregate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Date(); ok {
+		_spec.SetField(usagedailyaggregate.FieldDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(usagedailyaggregate.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Events(); ok {
+		_spec.SetField(usagedailyaggregate.FieldEvents, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEvents(); ok {
+		_spec.AddField(usagedailyaggregate.FieldEvents, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Count(); ok {
+		_spec.SetField(usagedailyaggregate.FieldCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCount(); ok {
+		_spec.AddField(usagedailyaggregate.FieldCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(usagedailyaggregate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   usagedailyaggregate.UserTable,
+			Columns: []string{usagedailyaggregate.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   usagedailyaggregate.UserTable,
+			Columns: []string{usagedailyaggregate.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &UsageDailyAggregate{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usagedailyaggregate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -70,9 +70,10 @@ type Action string
 
 // Action values.
 const (
-	ActionSearch  Action = "search"
-	ActionExport  Action = "export"
-	ActionAPICall Action = "api_call"
+	ActionSearch     Action = "search"
+	ActionExport     Action = "export"
+	ActionAPICall    Action = "api_call"
+	ActionEnrichment Action = "enrichment"
 )
 
 func (a Action) String() string {
@@ -82,7 +83,7 @@ func (a Action) String() string {
 // ActionValidator is a validator for the "action" field enum values. It is called by the builders before save.
 func ActionValidator(a Action) error {
 	switch a {
-	case ActionSearch, ActionExport, ActionAPICall:
+	case ActionSearch, ActionExport, ActionAPICall, ActionEnrichment:
 		return nil
 	default:
 		return fmt.Errorf("usagelog: invalid enum value for action field: %q", a)
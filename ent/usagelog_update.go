@@ -18,8 +18,9 @@ import (
 // UsageLogUpdate is the builder for updating UsageLog entities.
 type UsageLogUpdate struct {
 	config
-	hooks    []Hook
-	mutation *UsageLogMutation
+	hooks     []Hook
+	mutation  *UsageLogMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the UsageLogUpdate builder.
@@ -145,6 +146,12 @@ func (_u *UsageLogUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *UsageLogUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UsageLogUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *UsageLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -201,6 +208,7 @@ func (_u *UsageLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{usagelog.Label}
@@ -216,9 +224,10 @@ func (_u *UsageLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // UsageLogUpdateOne is the builder for updating a single UsageLog entity.
 type UsageLogUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *UsageLogMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *UsageLogMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUserID sets the "user_id" field.
@@ -351,6 +360,12 @@ func (_u *UsageLogUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *UsageLogUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UsageLogUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *UsageLogUpdateOne) sqlSave(ctx context.Context) (_node *UsageLog, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -424,6 +439,7 @@ func (_u *UsageLogUpdateOne) sqlSave(ctx context.Context) (_node *UsageLog, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &UsageLog{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
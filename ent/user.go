@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/jordanlanch/industrydb/ent/affiliate"
+	"github.com/jordanlanch/industrydb/ent/enrichmentbudget"
 	"github.com/jordanlanch/industrydb/ent/user"
 )
 
@@ -78,24 +79,48 @@ type UserEdges struct {
 	Subscriptions []*Subscription `json:"subscriptions,omitempty"`
 	// User's export history
 	Exports []*Export `json:"exports,omitempty"`
+	// Remote CSV import jobs started by this user
+	ImportJobs []*ImportJob `json:"import_jobs,omitempty"`
+	// Lead reindex jobs started by this user
+	LeadReindexJobs []*LeadReindexJob `json:"lead_reindex_jobs,omitempty"`
 	// User's API keys
 	APIKeys []*APIKey `json:"api_keys,omitempty"`
 	// User's audit log entries
 	AuditLogs []*AuditLog `json:"audit_logs,omitempty"`
 	// User's usage log entries
 	UsageLogs []*UsageLog `json:"usage_logs,omitempty"`
+	// Daily usage rollups for this user
+	UsageDailyAggregates []*UsageDailyAggregate `json:"usage_daily_aggregates,omitempty"`
 	// Organizations owned by this user
 	OwnedOrganizations []*Organization `json:"owned_organizations,omitempty"`
 	// Organization memberships
 	OrganizationMemberships []*OrganizationMember `json:"organization_memberships,omitempty"`
 	// User's saved searches
 	SavedSearches []*SavedSearch `json:"saved_searches,omitempty"`
+	// User's saved export configurations
+	ExportTemplates []*ExportTemplate `json:"export_templates,omitempty"`
+	// User's recurring exports
+	ScheduledExports []*ScheduledExport `json:"scheduled_exports,omitempty"`
 	// User's configured webhooks
 	Webhooks []*Webhook `json:"webhooks,omitempty"`
 	// Notes created by this user on leads
 	LeadNotes []*LeadNote `json:"lead_notes,omitempty"`
+	// Leads suppressed by this user
+	LeadSuppressions []*LeadSuppression `json:"lead_suppressions,omitempty"`
+	// Outreach attempts logged by this user
+	ContactAttempts []*ContactAttempt `json:"contact_attempts,omitempty"`
+	// Per-event notification channel preferences
+	NotificationPreferences []*UserNotificationPreference `json:"notification_preferences,omitempty"`
 	// Lead status changes made by this user
 	LeadStatusChanges []*LeadStatusHistory `json:"lead_status_changes,omitempty"`
+	// Lead field changes made by this user
+	LeadChanges []*LeadChange `json:"lead_changes,omitempty"`
+	// Lead enrichments requested by this user
+	EnrichmentAttempts []*EnrichmentAttempt `json:"enrichment_attempts,omitempty"`
+	// Monthly enrichment spend and limits of personal enrichments
+	EnrichmentBudget *EnrichmentBudget `json:"enrichment_budget,omitempty"`
+	// Lead verification events recorded by this user
+	LeadVerifications []*LeadVerification `json:"lead_verifications,omitempty"`
 	// Leads assigned to this user
 	AssignedLeads []*LeadAssignment `json:"assigned_leads,omitempty"`
 	// Lead assignments made by this user
@@ -136,9 +161,13 @@ type UserEdges struct {
 	EmailCampaigns []*EmailCampaign `json:"email_campaigns,omitempty"`
 	// CRM integrations configured by this user
 	CrmIntegrations []*CRMIntegration `json:"crm_integrations,omitempty"`
+	// CRM push jobs started by this user
+	CrmPushJobs []*CRMPushJob `json:"crm_push_jobs,omitempty"`
+	// Accounts connected for export delivery
+	IntegrationConnections []*IntegrationConnection `json:"integration_connections,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [31]bool
+	loadedTypes [45]bool
 }
 
 // SubscriptionsOrErr returns the Subscriptions value or an error if the edge
@@ -159,10 +188,28 @@ func (e UserEdges) ExportsOrErr() ([]*Export, error) {
 	return nil, &NotLoadedError{edge: "exports"}
 }
 
+// ImportJobsOrErr returns the ImportJobs value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ImportJobsOrErr() ([]*ImportJob, error) {
+	if e.loadedTypes[2] {
+		return e.ImportJobs, nil
+	}
+	return nil, &NotLoadedError{edge: "import_jobs"}
+}
+
+// LeadReindexJobsOrErr returns the LeadReindexJobs value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) LeadReindexJobsOrErr() ([]*LeadReindexJob, error) {
+	if e.loadedTypes[3] {
+		return e.LeadReindexJobs, nil
+	}
+	return nil, &NotLoadedError{edge: "lead_reindex_jobs"}
+}
+
 // APIKeysOrErr returns the APIKeys value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) APIKeysOrErr() ([]*APIKey, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[4] {
 		return e.APIKeys, nil
 	}
 	return nil, &NotLoadedError{edge: "api_keys"}
@@ -171,7 +218,7 @@ func (e UserEdges) APIKeysOrErr() ([]*APIKey, error) {
 // AuditLogsOrErr returns the AuditLogs value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) AuditLogsOrErr() ([]*AuditLog, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[5] {
 		return e.AuditLogs, nil
 	}
 	return nil, &NotLoadedError{edge: "audit_logs"}
@@ -180,16 +227,25 @@ func (e UserEdges) AuditLogsOrErr() ([]*AuditLog, error) {
 // UsageLogsOrErr returns the UsageLogs value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) UsageLogsOrErr() ([]*UsageLog, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[6] {
 		return e.UsageLogs, nil
 	}
 	return nil, &NotLoadedError{edge: "usage_logs"}
 }
 
+// UsageDailyAggregatesOrErr returns the UsageDailyAggregates value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) UsageDailyAggregatesOrErr() ([]*UsageDailyAggregate, error) {
+	if e.loadedTypes[7] {
+		return e.UsageDailyAggregates, nil
+	}
+	return nil, &NotLoadedError{edge: "usage_daily_aggregates"}
+}
+
 // OwnedOrganizationsOrErr returns the OwnedOrganizations value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) OwnedOrganizationsOrErr() ([]*Organization, error) {
-	if e.loadedTypes[5] {
+	if e.loadedTypes[8] {
 		return e.OwnedOrganizations, nil
 	}
 	return nil, &NotLoadedError{edge: "owned_organizations"}
@@ -198,7 +254,7 @@ func (e UserEdges) OwnedOrganizationsOrErr() ([]*Organization, error) {
 // OrganizationMembershipsOrErr returns the OrganizationMemberships value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) OrganizationMembershipsOrErr() ([]*OrganizationMember, error) {
-	if e.loadedTypes[6] {
+	if e.loadedTypes[9] {
 		return e.OrganizationMemberships, nil
 	}
 	return nil, &NotLoadedError{edge: "organization_memberships"}
@@ -207,16 +263,34 @@ func (e UserEdges) OrganizationMembershipsOrErr() ([]*OrganizationMember, error)
 // SavedSearchesOrErr returns the SavedSearches value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) SavedSearchesOrErr() ([]*SavedSearch, error) {
-	if e.loadedTypes[7] {
+	if e.loadedTypes[10] {
 		return e.SavedSearches, nil
 	}
 	return nil, &NotLoadedError{edge: "saved_searches"}
 }
 
+// ExportTemplatesOrErr returns the ExportTemplates value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ExportTemplatesOrErr() ([]*ExportTemplate, error) {
+	if e.loadedTypes[11] {
+		return e.ExportTemplates, nil
+	}
+	return nil, &NotLoadedError{edge: "export_templates"}
+}
+
+// ScheduledExportsOrErr returns the ScheduledExports value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ScheduledExportsOrErr() ([]*ScheduledExport, error) {
+	if e.loadedTypes[12] {
+		return e.ScheduledExports, nil
+	}
+	return nil, &NotLoadedError{edge: "scheduled_exports"}
+}
+
 // WebhooksOrErr returns the Webhooks value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) WebhooksOrErr() ([]*Webhook, error) {
-	if e.loadedTypes[8] {
+	if e.loadedTypes[13] {
 		return e.Webhooks, nil
 	}
 	return nil, &NotLoadedError{edge: "webhooks"}
@@ -225,25 +299,90 @@ func (e UserEdges) WebhooksOrErr() ([]*Webhook, error) {
 // LeadNotesOrErr returns the LeadNotes value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) LeadNotesOrErr() ([]*LeadNote, error) {
-	if e.loadedTypes[9] {
+	if e.loadedTypes[14] {
 		return e.LeadNotes, nil
 	}
 	return nil, &NotLoadedError{edge: "lead_notes"}
 }
 
+// LeadSuppressionsOrErr returns the LeadSuppressions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) LeadSuppressionsOrErr() ([]*LeadSuppression, error) {
+	if e.loadedTypes[15] {
+		return e.LeadSuppressions, nil
+	}
+	return nil, &NotLoadedError{edge: "lead_suppressions"}
+}
+
+// ContactAttemptsOrErr returns the ContactAttempts value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ContactAttemptsOrErr() ([]*ContactAttempt, error) {
+	if e.loadedTypes[16] {
+		return e.ContactAttempts, nil
+	}
+	return nil, &NotLoadedError{edge: "contact_attempts"}
+}
+
+// NotificationPreferencesOrErr returns the NotificationPreferences value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) NotificationPreferencesOrErr() ([]*UserNotificationPreference, error) {
+	if e.loadedTypes[17] {
+		return e.NotificationPreferences, nil
+	}
+	return nil, &NotLoadedError{edge: "notification_preferences"}
+}
+
 // LeadStatusChangesOrErr returns the LeadStatusChanges value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) LeadStatusChangesOrErr() ([]*LeadStatusHistory, error) {
-	if e.loadedTypes[10] {
+	if e.loadedTypes[18] {
 		return e.LeadStatusChanges, nil
 	}
 	return nil, &NotLoadedError{edge: "lead_status_changes"}
 }
 
+// LeadChangesOrErr returns the LeadChanges value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) LeadChangesOrErr() ([]*LeadChange, error) {
+	if e.loadedTypes[19] {
+		return e.LeadChanges, nil
+	}
+	return nil, &NotLoadedError{edge: "lead_changes"}
+}
+
+// EnrichmentAttemptsOrErr returns the EnrichmentAttempts value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) EnrichmentAttemptsOrErr() ([]*EnrichmentAttempt, error) {
+	if e.loadedTypes[20] {
+		return e.EnrichmentAttempts, nil
+	}
+	return nil, &NotLoadedError{edge: "enrichment_attempts"}
+}
+
+// EnrichmentBudgetOrErr returns the EnrichmentBudget value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserEdges) EnrichmentBudgetOrErr() (*EnrichmentBudget, error) {
+	if e.EnrichmentBudget != nil {
+		return e.EnrichmentBudget, nil
+	} else if e.loadedTypes[21] {
+		return nil, &NotFoundError{label: enrichmentbudget.Label}
+	}
+	return nil, &NotLoadedError{edge: "enrichment_budget"}
+}
+
+// LeadVerificationsOrErr returns the LeadVerifications value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) LeadVerificationsOrErr() ([]*LeadVerification, error) {
+	if e.loadedTypes[22] {
+		return e.LeadVerifications, nil
+	}
+	return nil, &NotLoadedError{edge: "lead_verifications"}
+}
+
 // AssignedLeadsOrErr returns the AssignedLeads value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) AssignedLeadsOrErr() ([]*LeadAssignment, error) {
-	if e.loadedTypes[11] {
+	if e.loadedTypes[23] {
 		return e.AssignedLeads, nil
 	}
 	return nil, &NotLoadedError{edge: "assigned_leads"}
@@ -252,7 +391,7 @@ func (e UserEdges) AssignedLeadsOrErr() ([]*LeadAssignment, error) {
 // LeadAssignmentsMadeOrErr returns the LeadAssignmentsMade value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) LeadAssignmentsMadeOrErr() ([]*LeadAssignment, error) {
-	if e.loadedTypes[12] {
+	if e.loadedTypes[24] {
 		return e.LeadAssignmentsMade, nil
 	}
 	return nil, &NotLoadedError{edge: "lead_assignments_made"}
@@ -261,7 +400,7 @@ func (e UserEdges) LeadAssignmentsMadeOrErr() ([]*LeadAssignment, error) {
 // EmailSequencesCreatedOrErr returns the EmailSequencesCreated value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) EmailSequencesCreatedOrErr() ([]*EmailSequence, error) {
-	if e.loadedTypes[13] {
+	if e.loadedTypes[25] {
 		return e.EmailSequencesCreated, nil
 	}
 	return nil, &NotLoadedError{edge: "email_sequences_created"}
@@ -270,7 +409,7 @@ func (e UserEdges) EmailSequencesCreatedOrErr() ([]*EmailSequence, error) {
 // EmailSequenceEnrollmentsMadeOrErr returns the EmailSequenceEnrollmentsMade value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) EmailSequenceEnrollmentsMadeOrErr() ([]*EmailSequenceEnrollment, error) {
-	if e.loadedTypes[14] {
+	if e.loadedTypes[26] {
 		return e.EmailSequenceEnrollmentsMade, nil
 	}
 	return nil, &NotLoadedError{edge: "email_sequence_enrollments_made"}
@@ -279,7 +418,7 @@ func (e UserEdges) EmailSequenceEnrollmentsMadeOrErr() ([]*EmailSequenceEnrollme
 // TerritoriesCreatedOrErr returns the TerritoriesCreated value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) TerritoriesCreatedOrErr() ([]*Territory, error) {
-	if e.loadedTypes[15] {
+	if e.loadedTypes[27] {
 		return e.TerritoriesCreated, nil
 	}
 	return nil, &NotLoadedError{edge: "territories_created"}
@@ -288,7 +427,7 @@ func (e UserEdges) TerritoriesCreatedOrErr() ([]*Territory, error) {
 // TerritoryMembershipsOrErr returns the TerritoryMemberships value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) TerritoryMembershipsOrErr() ([]*TerritoryMember, error) {
-	if e.loadedTypes[16] {
+	if e.loadedTypes[28] {
 		return e.TerritoryMemberships, nil
 	}
 	return nil, &NotLoadedError{edge: "territory_memberships"}
@@ -297,7 +436,7 @@ func (e UserEdges) TerritoryMembershipsOrErr() ([]*TerritoryMember, error) {
 // TerritoryMembersAddedOrErr returns the TerritoryMembersAdded value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) TerritoryMembersAddedOrErr() ([]*TerritoryMember, error) {
-	if e.loadedTypes[17] {
+	if e.loadedTypes[29] {
 		return e.TerritoryMembersAdded, nil
 	}
 	return nil, &NotLoadedError{edge: "territory_members_added"}
@@ -306,7 +445,7 @@ func (e UserEdges) TerritoryMembersAddedOrErr() ([]*TerritoryMember, error) {
 // SentReferralsOrErr returns the SentReferrals value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) SentReferralsOrErr() ([]*Referral, error) {
-	if e.loadedTypes[18] {
+	if e.loadedTypes[30] {
 		return e.SentReferrals, nil
 	}
 	return nil, &NotLoadedError{edge: "sent_referrals"}
@@ -315,7 +454,7 @@ func (e UserEdges) SentReferralsOrErr() ([]*Referral, error) {
 // ReceivedReferralsOrErr returns the ReceivedReferrals value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) ReceivedReferralsOrErr() ([]*Referral, error) {
-	if e.loadedTypes[19] {
+	if e.loadedTypes[31] {
 		return e.ReceivedReferrals, nil
 	}
 	return nil, &NotLoadedError{edge: "received_referrals"}
@@ -324,7 +463,7 @@ func (e UserEdges) ReceivedReferralsOrErr() ([]*Referral, error) {
 // ExperimentAssignmentsOrErr returns the ExperimentAssignments value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) ExperimentAssignmentsOrErr() ([]*ExperimentAssignment, error) {
-	if e.loadedTypes[20] {
+	if e.loadedTypes[32] {
 		return e.ExperimentAssignments, nil
 	}
 	return nil, &NotLoadedError{edge: "experiment_assignments"}
@@ -335,7 +474,7 @@ func (e UserEdges) ExperimentAssignmentsOrErr() ([]*ExperimentAssignment, error)
 func (e UserEdges) AffiliateOrErr() (*Affiliate, error) {
 	if e.Affiliate != nil {
 		return e.Affiliate, nil
-	} else if e.loadedTypes[21] {
+	} else if e.loadedTypes[33] {
 		return nil, &NotFoundError{label: affiliate.Label}
 	}
 	return nil, &NotLoadedError{edge: "affiliate"}
@@ -344,7 +483,7 @@ func (e UserEdges) AffiliateOrErr() (*Affiliate, error) {
 // AffiliateConversionsOrErr returns the AffiliateConversions value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) AffiliateConversionsOrErr() ([]*AffiliateConversion, error) {
-	if e.loadedTypes[22] {
+	if e.loadedTypes[34] {
 		return e.AffiliateConversions, nil
 	}
 	return nil, &NotLoadedError{edge: "affiliate_conversions"}
@@ -353,7 +492,7 @@ func (e UserEdges) AffiliateConversionsOrErr() ([]*AffiliateConversion, error) {
 // SmsCampaignsOrErr returns the SmsCampaigns value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) SmsCampaignsOrErr() ([]*SMSCampaign, error) {
-	if e.loadedTypes[23] {
+	if e.loadedTypes[35] {
 		return e.SmsCampaigns, nil
 	}
 	return nil, &NotLoadedError{edge: "sms_campaigns"}
@@ -362,7 +501,7 @@ func (e UserEdges) SmsCampaignsOrErr() ([]*SMSCampaign, error) {
 // CallLogsOrErr returns the CallLogs value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) CallLogsOrErr() ([]*CallLog, error) {
-	if e.loadedTypes[24] {
+	if e.loadedTypes[36] {
 		return e.CallLogs, nil
 	}
 	return nil, &NotLoadedError{edge: "call_logs"}
@@ -371,7 +510,7 @@ func (e UserEdges) CallLogsOrErr() ([]*CallLog, error) {
 // CompetitorProfilesOrErr returns the CompetitorProfiles value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) CompetitorProfilesOrErr() ([]*CompetitorProfile, error) {
-	if e.loadedTypes[25] {
+	if e.loadedTypes[37] {
 		return e.CompetitorProfiles, nil
 	}
 	return nil, &NotLoadedError{edge: "competitor_profiles"}
@@ -380,7 +519,7 @@ func (e UserEdges) CompetitorProfilesOrErr() ([]*CompetitorProfile, error) {
 // LeadRecommendationsOrErr returns the LeadRecommendations value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) LeadRecommendationsOrErr() ([]*LeadRecommendation, error) {
-	if e.loadedTypes[26] {
+	if e.loadedTypes[38] {
 		return e.LeadRecommendations, nil
 	}
 	return nil, &NotLoadedError{edge: "lead_recommendations"}
@@ -389,7 +528,7 @@ func (e UserEdges) LeadRecommendationsOrErr() ([]*LeadRecommendation, error) {
 // BehaviorsOrErr returns the Behaviors value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) BehaviorsOrErr() ([]*UserBehavior, error) {
-	if e.loadedTypes[27] {
+	if e.loadedTypes[39] {
 		return e.Behaviors, nil
 	}
 	return nil, &NotLoadedError{edge: "behaviors"}
@@ -398,7 +537,7 @@ func (e UserEdges) BehaviorsOrErr() ([]*UserBehavior, error) {
 // MarketReportsOrErr returns the MarketReports value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) MarketReportsOrErr() ([]*MarketReport, error) {
-	if e.loadedTypes[28] {
+	if e.loadedTypes[40] {
 		return e.MarketReports, nil
 	}
 	return nil, &NotLoadedError{edge: "market_reports"}
@@ -407,7 +546,7 @@ func (e UserEdges) MarketReportsOrErr() ([]*MarketReport, error) {
 // EmailCampaignsOrErr returns the EmailCampaigns value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) EmailCampaignsOrErr() ([]*EmailCampaign, error) {
-	if e.loadedTypes[29] {
+	if e.loadedTypes[41] {
 		return e.EmailCampaigns, nil
 	}
 	return nil, &NotLoadedError{edge: "email_campaigns"}
@@ -416,12 +555,30 @@ func (e UserEdges) EmailCampaignsOrErr() ([]*EmailCampaign, error) {
 // CrmIntegrationsOrErr returns the CrmIntegrations value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) CrmIntegrationsOrErr() ([]*CRMIntegration, error) {
-	if e.loadedTypes[30] {
+	if e.loadedTypes[42] {
 		return e.CrmIntegrations, nil
 	}
 	return nil, &NotLoadedError{edge: "crm_integrations"}
 }
 
+// CrmPushJobsOrErr returns the CrmPushJobs value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) CrmPushJobsOrErr() ([]*CRMPushJob, error) {
+	if e.loadedTypes[43] {
+		return e.CrmPushJobs, nil
+	}
+	return nil, &NotLoadedError{edge: "crm_push_jobs"}
+}
+
+// IntegrationConnectionsOrErr returns the IntegrationConnections value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) IntegrationConnectionsOrErr() ([]*IntegrationConnection, error) {
+	if e.loadedTypes[44] {
+		return e.IntegrationConnections, nil
+	}
+	return nil, &NotLoadedError{edge: "integration_connections"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -633,6 +790,16 @@ func (_m *User) QueryExports() *ExportQuery {
 	return NewUserClient(_m.config).QueryExports(_m)
 }
 
+// QueryImportJobs queries the "import_jobs" edge of the User entity.
+func (_m *User) QueryImportJobs() *ImportJobQuery {
+	return NewUserClient(_m.config).QueryImportJobs(_m)
+}
+
+// QueryLeadReindexJobs queries the "lead_reindex_jobs" edge of the User entity.
+func (_m *User) QueryLeadReindexJobs() *LeadReindexJobQuery {
+	return NewUserClient(_m.config).QueryLeadReindexJobs(_m)
+}
+
 // QueryAPIKeys queries the "api_keys" edge of the User entity.
 func (_m *User) QueryAPIKeys() *APIKeyQuery {
 	return NewUserClient(_m.config).QueryAPIKeys(_m)
@@ -648,6 +815,11 @@ func (_m *User) QueryUsageLogs() *UsageLogQuery {
 	return NewUserClient(_m.config).QueryUsageLogs(_m)
 }
 
+// QueryUsageDailyAggregates queries the "usage_daily_aggregates" edge of the User entity.
+func (_m *User) QueryUsageDailyAggregates() *UsageDailyAggregateQuery {
+	return NewUserClient(_m.config).QueryUsageDailyAggregates(_m)
+}
+
 // QueryOwnedOrganizations queries the "owned_organizations" edge of the User entity.
 func (_m *User) QueryOwnedOrganizations() *OrganizationQuery {
 	return NewUserClient(_m.config).QueryOwnedOrganizations(_m)
@@ -663,6 +835,16 @@ func (_m *User) QuerySavedSearches() *SavedSearchQuery {
 	return NewUserClient(_m.config).QuerySavedSearches(_m)
 }
 
+// QueryExportTemplates queries the "export_templates" edge of the User entity.
+func (_m *User) QueryExportTemplates() *ExportTemplateQuery {
+	return NewUserClient(_m.config).QueryExportTemplates(_m)
+}
+
+// QueryScheduledExports queries the "scheduled_exports" edge of the User entity.
+func (_m *User) QueryScheduledExports() *ScheduledExportQuery {
+	return NewUserClient(_m.config).QueryScheduledExports(_m)
+}
+
 // QueryWebhooks queries the "webhooks" edge of the User entity.
 func (_m *User) QueryWebhooks() *WebhookQuery {
 	return NewUserClient(_m.config).QueryWebhooks(_m)
@@ -673,11 +855,46 @@ func (_m *User) QueryLeadNotes() *LeadNoteQuery {
 	return NewUserClient(_m.config).QueryLeadNotes(_m)
 }
 
+// QueryLeadSuppressions queries the "lead_suppressions" edge of the User entity.
+func (_m *User) QueryLeadSuppressions() *LeadSuppressionQuery {
+	return NewUserClient(_m.config).QueryLeadSuppressions(_m)
+}
+
+// QueryContactAttempts queries the "contact_attempts" edge of the User entity.
+func (_m *User) QueryContactAttempts() *ContactAttemptQuery {
+	return NewUserClient(_m.config).QueryContactAttempts(_m)
+}
+
+// QueryNotificationPreferences queries the "notification_preferences" edge of the User entity.
+func (_m *User) QueryNotificationPreferences() *UserNotificationPreferenceQuery {
+	return NewUserClient(_m.config).QueryNotificationPreferences(_m)
+}
+
 // QueryLeadStatusChanges queries the "lead_status_changes" edge of the User entity.
 func (_m *User) QueryLeadStatusChanges() *LeadStatusHistoryQuery {
 	return NewUserClient(_m.config).QueryLeadStatusChanges(_m)
 }
 
+// QueryLeadChanges queries the "lead_changes" edge of the User entity.
+func (_m *User) QueryLeadChanges() *LeadChangeQuery {
+	return NewUserClient(_m.config).QueryLeadChanges(_m)
+}
+
+// QueryEnrichmentAttempts queries the "enrichment_attempts" edge of the User entity.
+func (_m *User) QueryEnrichmentAttempts() *EnrichmentAttemptQuery {
+	return NewUserClient(_m.config).QueryEnrichmentAttempts(_m)
+}
+
+// QueryEnrichmentBudget queries the "enrichment_budget" edge of the User entity.
+func (_m *User) QueryEnrichmentBudget() *EnrichmentBudgetQuery {
+	return NewUserClient(_m.config).QueryEnrichmentBudget(_m)
+}
+
+// QueryLeadVerifications queries the "lead_verifications" edge of the User entity.
+func (_m *User) QueryLeadVerifications() *LeadVerificationQuery {
+	return NewUserClient(_m.config).QueryLeadVerifications(_m)
+}
+
 // QueryAssignedLeads queries the "assigned_leads" edge of the User entity.
 func (_m *User) QueryAssignedLeads() *LeadAssignmentQuery {
 	return NewUserClient(_m.config).QueryAssignedLeads(_m)
@@ -778,6 +995,16 @@ func (_m *User) QueryCrmIntegrations() *CRMIntegrationQuery {
 	return NewUserClient(_m.config).QueryCrmIntegrations(_m)
 }
 
+// QueryCrmPushJobs queries the "crm_push_jobs" edge of the User entity.
+func (_m *User) QueryCrmPushJobs() *CRMPushJobQuery {
+	return NewUserClient(_m.config).QueryCrmPushJobs(_m)
+}
+
+// QueryIntegrationConnections queries the "integration_connections" edge of the User entity.
+func (_m *User) QueryIntegrationConnections() *IntegrationConnectionQuery {
+	return NewUserClient(_m.config).QueryIntegrationConnections(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
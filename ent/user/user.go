@@ -67,24 +67,48 @@ const (
 	EdgeSubscriptions = "subscriptions"
 	// EdgeExports holds the string denoting the exports edge name in mutations.
 	EdgeExports = "exports"
+	// EdgeImportJobs holds the string denoting the import_jobs edge name in mutations.
+	EdgeImportJobs = "import_jobs"
+	// EdgeLeadReindexJobs holds the string denoting the lead_reindex_jobs edge name in mutations.
+	EdgeLeadReindexJobs = "lead_reindex_jobs"
 	// EdgeAPIKeys holds the string denoting the api_keys edge name in mutations.
 	EdgeAPIKeys = "api_keys"
 	// EdgeAuditLogs holds the string denoting the audit_logs edge name in mutations.
 	EdgeAuditLogs = "audit_logs"
 	// EdgeUsageLogs holds the string denoting the usage_logs edge name in mutations.
 	EdgeUsageLogs = "usage_logs"
+	// EdgeUsageDailyAggregates holds the string denoting the usage_daily_aggregates edge name in mutations.
+	EdgeUsageDailyAggregates = "usage_daily_aggregates"
 	// EdgeOwnedOrganizations holds the string denoting the owned_organizations edge name in mutations.
 	EdgeOwnedOrganizations = "owned_organizations"
 	// EdgeOrganizationMemberships holds the string denoting the organization_memberships edge name in mutations.
 	EdgeOrganizationMemberships = "organization_memberships"
 	// EdgeSavedSearches holds the string denoting the saved_searches edge name in mutations.
 	EdgeSavedSearches = "saved_searches"
+	// EdgeExportTemplates holds the string denoting the export_templates edge name in mutations.
+	EdgeExportTemplates = "export_templates"
+	// EdgeScheduledExports holds the string denoting the scheduled_exports edge name in mutations.
+	EdgeScheduledExports = "scheduled_exports"
 	// EdgeWebhooks holds the string denoting the webhooks edge name in mutations.
 	EdgeWebhooks = "webhooks"
 	// EdgeLeadNotes holds the string denoting the lead_notes edge name in mutations.
 	EdgeLeadNotes = "lead_notes"
+	// EdgeLeadSuppressions holds the string denoting the lead_suppressions edge name in mutations.
+	EdgeLeadSuppressions = "lead_suppressions"
+	// EdgeContactAttempts holds the string denoting the contact_attempts edge name in mutations.
+	EdgeContactAttempts = "contact_attempts"
+	// EdgeNotificationPreferences holds the string denoting the notification_preferences edge name in mutations.
+	EdgeNotificationPreferences = "notification_preferences"
 	// EdgeLeadStatusChanges holds the string denoting the lead_status_changes edge name in mutations.
 	EdgeLeadStatusChanges = "lead_status_changes"
+	// EdgeLeadChanges holds the string denoting the lead_changes edge name in mutations.
+	EdgeLeadChanges = "lead_changes"
+	// EdgeEnrichmentAttempts holds the string denoting the enrichment_attempts edge name in mutations.
+	EdgeEnrichmentAttempts = "enrichment_attempts"
+	// EdgeEnrichmentBudget holds the string denoting the enrichment_budget edge name in mutations.
+	EdgeEnrichmentBudget = "enrichment_budget"
+	// EdgeLeadVerifications holds the string denoting the lead_verifications edge name in mutations.
+	EdgeLeadVerifications = "lead_verifications"
 	// EdgeAssignedLeads holds the string denoting the assigned_leads edge name in mutations.
 	EdgeAssignedLeads = "assigned_leads"
 	// EdgeLeadAssignmentsMade holds the string denoting the lead_assignments_made edge name in mutations.
@@ -125,6 +149,10 @@ const (
 	EdgeEmailCampaigns = "email_campaigns"
 	// EdgeCrmIntegrations holds the string denoting the crm_integrations edge name in mutations.
 	EdgeCrmIntegrations = "crm_integrations"
+	// EdgeCrmPushJobs holds the string denoting the crm_push_jobs edge name in mutations.
+	EdgeCrmPushJobs = "crm_push_jobs"
+	// EdgeIntegrationConnections holds the string denoting the integration_connections edge name in mutations.
+	EdgeIntegrationConnections = "integration_connections"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// SubscriptionsTable is the table that holds the subscriptions relation/edge.
@@ -141,6 +169,20 @@ const (
 	ExportsInverseTable = "exports"
 	// ExportsColumn is the table column denoting the exports relation/edge.
 	ExportsColumn = "user_id"
+	// ImportJobsTable is the table that holds the import_jobs relation/edge.
+	ImportJobsTable = "import_jobs"
+	// ImportJobsInverseTable is the table name for the ImportJob entity.
+	// It exists in this package in order to avoid circular dependency with the "importjob" package.
+	ImportJobsInverseTable = "import_jobs"
+	// ImportJobsColumn is the table column denoting the import_jobs relation/edge.
+	ImportJobsColumn = "user_id"
+	// LeadReindexJobsTable is the table that holds the lead_reindex_jobs relation/edge.
+	LeadReindexJobsTable = "lead_reindex_jobs"
+	// LeadReindexJobsInverseTable is the table name for the LeadReindexJob entity.
+	// It exists in this package in order to avoid circular dependency with the "leadreindexjob" package.
+	LeadReindexJobsInverseTable = "lead_reindex_jobs"
+	// LeadReindexJobsColumn is the table column denoting the lead_reindex_jobs relation/edge.
+	LeadReindexJobsColumn = "user_id"
 	// APIKeysTable is the table that holds the api_keys relation/edge.
 	APIKeysTable = "api_keys"
 	// APIKeysInverseTable is the table name for the APIKey entity.
@@ -162,6 +204,13 @@ const (
 	UsageLogsInverseTable = "usage_logs"
 	// UsageLogsColumn is the table column denoting the usage_logs relation/edge.
 	UsageLogsColumn = "user_id"
+	// UsageDailyAggregatesTable is the table that holds the usage_daily_aggregates relation/edge.
+	UsageDailyAggregatesTable = "usage_daily_aggregates"
+	// UsageDailyAggregatesInverseTable is the table name for the UsageDailyAggregate entity.
+	// It exists in this package in order to avoid circular dependency with the "usagedailyaggregate" package.
+	UsageDailyAggregatesInverseTable = "usage_daily_aggregates"
+	// UsageDailyAggregatesColumn is the table column denoting the usage_daily_aggregates relation/edge.
+	UsageDailyAggregatesColumn = "user_id"
 	// OwnedOrganizationsTable is the table that holds the owned_organizations relation/edge.
 	OwnedOrganizationsTable = "organizations"
 	// OwnedOrganizationsInverseTable is the table name for the Organization entity.
@@ -183,6 +232,20 @@ const (
 	SavedSearchesInverseTable = "saved_searches"
 	// SavedSearchesColumn is the table column denoting the saved_searches relation/edge.
 	SavedSearchesColumn = "user_id"
+	// ExportTemplatesTable is the table that holds the export_templates relation/edge.
+	ExportTemplatesTable = "export_templates"
+	// ExportTemplatesInverseTable is the table name for the ExportTemplate entity.
+	// It exists in this package in order to avoid circular dependency with the "exporttemplate" package.
+	ExportTemplatesInverseTable = "export_templates"
+	// ExportTemplatesColumn is the table column denoting the export_templates relation/edge.
+	ExportTemplatesColumn = "user_id"
+	// ScheduledExportsTable is the table that holds the scheduled_exports relation/edge.
+	ScheduledExportsTable = "scheduled_exports"
+	// ScheduledExportsInverseTable is the table name for the ScheduledExport entity.
+	// It exists in this package in order to avoid circular dependency with the "scheduledexport" package.
+	ScheduledExportsInverseTable = "scheduled_exports"
+	// ScheduledExportsColumn is the table column denoting the scheduled_exports relation/edge.
+	ScheduledExportsColumn = "user_id"
 	// WebhooksTable is the table that holds the webhooks relation/edge.
 	WebhooksTable = "webhooks"
 	// WebhooksInverseTable is the table name for the Webhook entity.
@@ -197,6 +260,27 @@ const (
 	LeadNotesInverseTable = "lead_notes"
 	// LeadNotesColumn is the table column denoting the lead_notes relation/edge.
 	LeadNotesColumn = "user_id"
+	// LeadSuppressionsTable is the table that holds the lead_suppressions relation/edge.
+	LeadSuppressionsTable = "lead_suppressions"
+	// LeadSuppressionsInverseTable is the table name for the LeadSuppression entity.
+	// It exists in this package in order to avoid circular dependency with the "leadsuppression" package.
+	LeadSuppressionsInverseTable = "lead_suppressions"
+	// LeadSuppressionsColumn is the table column denoting the lead_suppressions relation/edge.
+	LeadSuppressionsColumn = "user_id"
+	// ContactAttemptsTable is the table that holds the contact_attempts relation/edge.
+	ContactAttemptsTable = "contact_attempts"
+	// ContactAttemptsInverseTable is the table name for the ContactAttempt entity.
+	// It exists in this package in order to avoid circular dependency with the "contactattempt" package.
+	ContactAttemptsInverseTable = "contact_attempts"
+	// ContactAttemptsColumn is the table column denoting the contact_attempts relation/edge.
+	ContactAttemptsColumn = "user_id"
+	// NotificationPreferencesTable is the table that holds the notification_preferences relation/edge.
+	NotificationPreferencesTable = "user_notification_preferences"
+	// NotificationPreferencesInverseTable is the table name for the UserNotificationPreference entity.
+	// It exists in this package in order to avoid circular dependency with the "usernotificationpreference" package.
+	NotificationPreferencesInverseTable = "user_notification_preferences"
+	// NotificationPreferencesColumn is the table column denoting the notification_preferences relation/edge.
+	NotificationPreferencesColumn = "user_id"
 	// LeadStatusChangesTable is the table that holds the lead_status_changes relation/edge.
 	LeadStatusChangesTable = "lead_status_histories"
 	// LeadStatusChangesInverseTable is the table name for the LeadStatusHistory entity.
@@ -204,6 +288,34 @@ const (
 	LeadStatusChangesInverseTable = "lead_status_histories"
 	// LeadStatusChangesColumn is the table column denoting the lead_status_changes relation/edge.
 	LeadStatusChangesColumn = "user_id"
+	// LeadChangesTable is the table that holds the lead_changes relation/edge.
+	LeadChangesTable = "lead_changes"
+	// LeadChangesInverseTable is the table name for the LeadChange entity.
+	// It exists in this package in order to avoid circular dependency with the "leadchange" package.
+	LeadChangesInverseTable = "lead_changes"
+	// LeadChangesColumn is the table column denoting the lead_changes relation/edge.
+	LeadChangesColumn = "user_id"
+	// EnrichmentAttemptsTable is the table that holds the enrichment_attempts relation/edge.
+	EnrichmentAttemptsTable = "enrichment_attempts"
+	// EnrichmentAttemptsInverseTable is the table name for the EnrichmentAttempt entity.
+	// It exists in this package in order to avoid circular dependency with the "enrichmentattempt" package.
+	EnrichmentAttemptsInverseTable = "enrichment_attempts"
+	// EnrichmentAttemptsColumn is the table column denoting the enrichment_attempts relation/edge.
+	EnrichmentAttemptsColumn = "user_id"
+	// EnrichmentBudgetTable is the table that holds the enrichment_budget relation/edge.
+	EnrichmentBudgetTable = "enrichment_budgets"
+	// EnrichmentBudgetInverseTable is the table name for the EnrichmentBudget entity.
+	// It exists in this package in order to avoid circular dependency with the "enrichmentbudget" package.
+	EnrichmentBudgetInverseTable = "enrichment_budgets"
+	// EnrichmentBudgetColumn is the table column denoting the enrichment_budget relation/edge.
+	EnrichmentBudgetColumn = "user_id"
+	// LeadVerificationsTable is the table that holds the lead_verifications relation/edge.
+	LeadVerificationsTable = "lead_verifications"
+	// LeadVerificationsInverseTable is the table name for the LeadVerification entity.
+	// It exists in this package in order to avoid circular dependency with the "leadverification" package.
+	LeadVerificationsInverseTable = "lead_verifications"
+	// LeadVerificationsColumn is the table column denoting the lead_verifications relation/edge.
+	LeadVerificationsColumn = "user_id"
 	// AssignedLeadsTable is the table that holds the assigned_leads relation/edge.
 	AssignedLeadsTable = "lead_assignments"
 	// AssignedLeadsInverseTable is the table name for the LeadAssignment entity.
@@ -344,6 +456,20 @@ const (
 	CrmIntegrationsInverseTable = "crm_integrations"
 	// CrmIntegrationsColumn is the table column denoting the crm_integrations relation/edge.
 	CrmIntegrationsColumn = "user_id"
+	// CrmPushJobsTable is the table that holds the crm_push_jobs relation/edge.
+	CrmPushJobsTable = "crm_push_jobs"
+	// CrmPushJobsInverseTable is the table name for the CRMPushJob entity.
+	// It exists in this package in order to avoid circular dependency with the "crmpushjob" package.
+	CrmPushJobsInverseTable = "crm_push_jobs"
+	// CrmPushJobsColumn is the table column denoting the crm_push_jobs relation/edge.
+	CrmPushJobsColumn = "user_id"
+	// IntegrationConnectionsTable is the table that holds the integration_connections relation/edge.
+	IntegrationConnectionsTable = "integration_connections"
+	// IntegrationConnectionsInverseTable is the table name for the IntegrationConnection entity.
+	// It exists in this package in order to avoid circular dependency with the "integrationconnection" package.
+	IntegrationConnectionsInverseTable = "integration_connections"
+	// IntegrationConnectionsColumn is the table column denoting the integration_connections relation/edge.
+	IntegrationConnectionsColumn = "user_id"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -631,6 +757,34 @@ func ByExports(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByImportJobsCount orders the results by import_jobs count.
+func ByImportJobsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newImportJobsStep(), opts...)
+	}
+}
+
+// ByImportJobs orders the results by import_jobs terms.
+func ByImportJobs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newImportJobsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLeadReindexJobsCount orders the results by lead_reindex_jobs count.
+func ByLeadReindexJobsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLeadReindexJobsStep(), opts...)
+	}
+}
+
+// ByLeadReindexJobs orders the results by lead_reindex_jobs terms.
+func ByLeadReindexJobs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLeadReindexJobsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByAPIKeysCount orders the results by api_keys count.
 func ByAPIKeysCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -673,6 +827,20 @@ func ByUsageLogs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByUsageDailyAggregatesCount orders the results by usage_daily_aggregates count.
+func ByUsageDailyAggregatesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newUsageDailyAggregatesStep(), opts...)
+	}
+}
+
+// ByUsageDailyAggregates orders the results by usage_daily_aggregates terms.
+func ByUsageDailyAggregates(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUsageDailyAggregatesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByOwnedOrganizationsCount orders the results by owned_organizations count.
 func ByOwnedOrganizationsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -715,6 +883,34 @@ func BySavedSearches(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByExportTemplatesCount orders the results by export_templates count.
+func ByExportTemplatesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newExportTemplatesStep(), opts...)
+	}
+}
+
+// ByExportTemplates orders the results by export_templates terms.
+func ByExportTemplates(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newExportTemplatesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByScheduledExportsCount orders the results by scheduled_exports count.
+func ByScheduledExportsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newScheduledExportsStep(), opts...)
+	}
+}
+
+// ByScheduledExports orders the results by scheduled_exports terms.
+func ByScheduledExports(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newScheduledExportsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByWebhooksCount orders the results by webhooks count.
 func ByWebhooksCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -743,6 +939,48 @@ func ByLeadNotes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByLeadSuppressionsCount orders the results by lead_suppressions count.
+func ByLeadSuppressionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLeadSuppressionsStep(), opts...)
+	}
+}
+
+// ByLeadSuppressions orders the results by lead_suppressions terms.
+func ByLeadSuppressions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLeadSuppressionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByContactAttemptsCount orders the results by contact_attempts count.
+func ByContactAttemptsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newContactAttemptsStep(), opts...)
+	}
+}
+
+// ByContactAttempts orders the results by contact_attempts terms.
+func ByContactAttempts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newContactAttemptsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByNotificationPreferencesCount orders the results by notification_preferences count.
+func ByNotificationPreferencesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newNotificationPreferencesStep(), opts...)
+	}
+}
+
+// ByNotificationPreferences orders the results by notification_preferences terms.
+func ByNotificationPreferences(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNotificationPreferencesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByLeadStatusChangesCount orders the results by lead_status_changes count.
 func ByLeadStatusChangesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -757,6 +995,55 @@ func ByLeadStatusChanges(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption
 	}
 }
 
+// ByLeadChangesCount orders the results by lead_changes count.
+func ByLeadChangesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLeadChangesStep(), opts...)
+	}
+}
+
+// ByLeadChanges orders the results by lead_changes terms.
+func ByLeadChanges(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLeadChangesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByEnrichmentAttemptsCount orders the results by enrichment_attempts count.
+func ByEnrichmentAttemptsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEnrichmentAttemptsStep(), opts...)
+	}
+}
+
+// ByEnrichmentAttempts orders the results by enrichment_attempts terms.
+func ByEnrichmentAttempts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEnrichmentAttemptsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByEnrichmentBudgetField orders the results by enrichment_budget field.
+func ByEnrichmentBudgetField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEnrichmentBudgetStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByLeadVerificationsCount orders the results by lead_verifications count.
+func ByLeadVerificationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLeadVerificationsStep(), opts...)
+	}
+}
+
+// ByLeadVerifications orders the results by lead_verifications terms.
+func ByLeadVerifications(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLeadVerificationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByAssignedLeadsCount orders the results by assigned_leads count.
 func ByAssignedLeadsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -1029,6 +1316,34 @@ func ByCrmIntegrations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newCrmIntegrationsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByCrmPushJobsCount orders the results by crm_push_jobs count.
+func ByCrmPushJobsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCrmPushJobsStep(), opts...)
+	}
+}
+
+// ByCrmPushJobs orders the results by crm_push_jobs terms.
+func ByCrmPushJobs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCrmPushJobsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByIntegrationConnectionsCount orders the results by integration_connections count.
+func ByIntegrationConnectionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newIntegrationConnectionsStep(), opts...)
+	}
+}
+
+// ByIntegrationConnections orders the results by integration_connections terms.
+func ByIntegrationConnections(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newIntegrationConnectionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newSubscriptionsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -1043,6 +1358,20 @@ func newExportsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ExportsTable, ExportsColumn),
 	)
 }
+func newImportJobsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ImportJobsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ImportJobsTable, ImportJobsColumn),
+	)
+}
+func newLeadReindexJobsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LeadReindexJobsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LeadReindexJobsTable, LeadReindexJobsColumn),
+	)
+}
 func newAPIKeysStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -1064,6 +1393,13 @@ func newUsageLogsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, UsageLogsTable, UsageLogsColumn),
 	)
 }
+func newUsageDailyAggregatesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UsageDailyAggregatesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, UsageDailyAggregatesTable, UsageDailyAggregatesColumn),
+	)
+}
 func newOwnedOrganizationsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -1085,6 +1421,20 @@ func newSavedSearchesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, SavedSearchesTable, SavedSearchesColumn),
 	)
 }
+func newExportTemplatesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ExportTemplatesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ExportTemplatesTable, ExportTemplatesColumn),
+	)
+}
+func newScheduledExportsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ScheduledExportsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ScheduledExportsTable, ScheduledExportsColumn),
+	)
+}
 func newWebhooksStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -1099,6 +1449,27 @@ func newLeadNotesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, LeadNotesTable, LeadNotesColumn),
 	)
 }
+func newLeadSuppressionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LeadSuppressionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LeadSuppressionsTable, LeadSuppressionsColumn),
+	)
+}
+func newContactAttemptsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ContactAttemptsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ContactAttemptsTable, ContactAttemptsColumn),
+	)
+}
+func newNotificationPreferencesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NotificationPreferencesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, NotificationPreferencesTable, NotificationPreferencesColumn),
+	)
+}
 func newLeadStatusChangesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -1106,6 +1477,34 @@ func newLeadStatusChangesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, LeadStatusChangesTable, LeadStatusChangesColumn),
 	)
 }
+func newLeadChangesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LeadChangesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LeadChangesTable, LeadChangesColumn),
+	)
+}
+func newEnrichmentAttemptsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EnrichmentAttemptsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, EnrichmentAttemptsTable, EnrichmentAttemptsColumn),
+	)
+}
+func newEnrichmentBudgetStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EnrichmentBudgetInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, EnrichmentBudgetTable, EnrichmentBudgetColumn),
+	)
+}
+func newLeadVerificationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LeadVerificationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LeadVerificationsTable, LeadVerificationsColumn),
+	)
+}
 func newAssignedLeadsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -1246,3 +1645,17 @@ func newCrmIntegrationsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, CrmIntegrationsTable, CrmIntegrationsColumn),
 	)
 }
+func newCrmPushJobsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CrmPushJobsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CrmPushJobsTable, CrmPushJobsColumn),
+	)
+}
+func newIntegrationConnectionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(IntegrationConnectionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, IntegrationConnectionsTable, IntegrationConnectionsColumn),
+	)
+}
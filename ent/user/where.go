@@ -1341,6 +1341,52 @@ func HasExportsWith(preds ...predicate.Export) predicate.User {
 	})
 }
 
+// HasImportJobs applies the HasEdge predicate on the "import_jobs" edge.
+func HasImportJobs() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ImportJobsTable, ImportJobsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasImportJobsWith applies the HasEdge predicate on the "import_jobs" edge with a given conditions (other predicates).
+func HasImportJobsWith(preds ...predicate.ImportJob) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newImportJobsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLeadReindexJobs applies the HasEdge predicate on the "lead_reindex_jobs" edge.
+func HasLeadReindexJobs() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LeadReindexJobsTable, LeadReindexJobsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLeadReindexJobsWith applies the HasEdge predicate on the "lead_reindex_jobs" edge with a given conditions (other predicates).
+func HasLeadReindexJobsWith(preds ...predicate.LeadReindexJob) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newLeadReindexJobsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasAPIKeys applies the HasEdge predicate on the "api_keys" edge.
 func HasAPIKeys() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -1410,6 +1456,29 @@ func HasUsageLogsWith(preds ...predicate.UsageLog) predicate.User {
 	})
 }
 
+// HasUsageDailyAggregates applies the HasEdge predicate on the "usage_daily_aggregates" edge.
+func HasUsageDailyAggregates() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, UsageDailyAggregatesTable, UsageDailyAggregatesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUsageDailyAggregatesWith applies the HasEdge predicate on the "usage_daily_aggregates" edge with a given conditions (other predicates).
+func HasUsageDailyAggregatesWith(preds ...predicate.UsageDailyAggregate) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newUsageDailyAggregatesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasOwnedOrganizations applies the HasEdge predicate on the "owned_organizations" edge.
 func HasOwnedOrganizations() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -1479,6 +1548,52 @@ func HasSavedSearchesWith(preds ...predicate.SavedSearch) predicate.User {
 	})
 }
 
+// HasExportTemplates applies the HasEdge predicate on the "export_templates" edge.
+func HasExportTemplates() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ExportTemplatesTable, ExportTemplatesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasExportTemplatesWith applies the HasEdge predicate on the "export_templates" edge with a given conditions (other predicates).
+func HasExportTemplatesWith(preds ...predicate.ExportTemplate) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newExportTemplatesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasScheduledExports applies the HasEdge predicate on the "scheduled_exports" edge.
+func HasScheduledExports() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ScheduledExportsTable, ScheduledExportsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasScheduledExportsWith applies the HasEdge predicate on the "scheduled_exports" edge with a given conditions (other predicates).
+func HasScheduledExportsWith(preds ...predicate.ScheduledExport) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newScheduledExportsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasWebhooks applies the HasEdge predicate on the "webhooks" edge.
 func HasWebhooks() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -1525,6 +1640,75 @@ func HasLeadNotesWith(preds ...predicate.LeadNote) predicate.User {
 	})
 }
 
+// HasLeadSuppressions applies the HasEdge predicate on the "lead_suppressions" edge.
+func HasLeadSuppressions() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LeadSuppressionsTable, LeadSuppressionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLeadSuppressionsWith applies the HasEdge predicate on the "lead_suppressions" edge with a given conditions (other predicates).
+func HasLeadSuppressionsWith(preds ...predicate.LeadSuppression) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newLeadSuppressionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasContactAttempts applies the HasEdge predicate on the "contact_attempts" edge.
+func HasContactAttempts() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ContactAttemptsTable, ContactAttemptsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasContactAttemptsWith applies the HasEdge predicate on the "contact_attempts" edge with a given conditions (other predicates).
+func HasContactAttemptsWith(preds ...predicate.ContactAttempt) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newContactAttemptsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNotificationPreferences applies the HasEdge predicate on the "notification_preferences" edge.
+func HasNotificationPreferences() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, NotificationPreferencesTable, NotificationPreferencesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNotificationPreferencesWith applies the HasEdge predicate on the "notification_preferences" edge with a given conditions (other predicates).
+func HasNotificationPreferencesWith(preds ...predicate.UserNotificationPreference) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newNotificationPreferencesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasLeadStatusChanges applies the HasEdge predicate on the "lead_status_changes" edge.
 func HasLeadStatusChanges() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -1548,6 +1732,98 @@ func HasLeadStatusChangesWith(preds ...predicate.LeadStatusHistory) predicate.Us
 	})
 }
 
+// HasLeadChanges applies the HasEdge predicate on the "lead_changes" edge.
+func HasLeadChanges() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LeadChangesTable, LeadChangesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLeadChangesWith applies the HasEdge predicate on the "lead_changes" edge with a given conditions (other predicates).
+func HasLeadChangesWith(preds ...predicate.LeadChange) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newLeadChangesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEnrichmentAttempts applies the HasEdge predicate on the "enrichment_attempts" edge.
+func HasEnrichmentAttempts() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EnrichmentAttemptsTable, EnrichmentAttemptsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEnrichmentAttemptsWith applies the HasEdge predicate on the "enrichment_attempts" edge with a given conditions (other predicates).
+func HasEnrichmentAttemptsWith(preds ...predicate.EnrichmentAttempt) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newEnrichmentAttemptsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEnrichmentBudget applies the HasEdge predicate on the "enrichment_budget" edge.
+func HasEnrichmentBudget() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, EnrichmentBudgetTable, EnrichmentBudgetColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEnrichmentBudgetWith applies the HasEdge predicate on the "enrichment_budget" edge with a given conditions (other predicates).
+func HasEnrichmentBudgetWith(preds ...predicate.EnrichmentBudget) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newEnrichmentBudgetStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLeadVerifications applies the HasEdge predicate on the "lead_verifications" edge.
+func HasLeadVerifications() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LeadVerificationsTable, LeadVerificationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLeadVerificationsWith applies the HasEdge predicate on the "lead_verifications" edge with a given conditions (other predicates).
+func HasLeadVerificationsWith(preds ...predicate.LeadVerification) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newLeadVerificationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasAssignedLeads applies the HasEdge predicate on the "assigned_leads" edge.
 func HasAssignedLeads() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -2008,6 +2284,52 @@ func HasCrmIntegrationsWith(preds ...predicate.CRMIntegration) predicate.User {
 	})
 }
 
+// HasCrmPushJobs applies the HasEdge predicate on the "crm_push_jobs" edge.
+func HasCrmPushJobs() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CrmPushJobsTable, CrmPushJobsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCrmPushJobsWith applies the HasEdge predicate on the "crm_push_jobs" edge with a given conditions (other predicates).
+func HasCrmPushJobsWith(preds ...predicate.CRMPushJob) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newCrmPushJobsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasIntegrationConnections applies the HasEdge predicate on the "integration_connections" edge.
+func HasIntegrationConnections() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, IntegrationConnectionsTable, IntegrationConnectionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasIntegrationConnectionsWith applies the HasEdge predicate on the "integration_connections" edge with a given conditions (other predicates).
+func HasIntegrationConnectionsWith(preds ...predicate.IntegrationConnection) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newIntegrationConnectionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
@@ -16,28 +16,42 @@ import (
 	"github.com/jordanlanch/industrydb/ent/auditlog"
 	"github.com/jordanlanch/industrydb/ent/calllog"
 	"github.com/jordanlanch/industrydb/ent/competitorprofile"
+	"github.com/jordanlanch/industrydb/ent/contactattempt"
 	"github.com/jordanlanch/industrydb/ent/crmintegration"
+	"github.com/jordanlanch/industrydb/ent/crmpushjob"
 	"github.com/jordanlanch/industrydb/ent/emailcampaign"
 	"github.com/jordanlanch/industrydb/ent/emailsequence"
 	"github.com/jordanlanch/industrydb/ent/emailsequenceenrollment"
+	"github.com/jordanlanch/industrydb/ent/enrichmentattempt"
+	"github.com/jordanlanch/industrydb/ent/enrichmentbudget"
 	"github.com/jordanlanch/industrydb/ent/experimentassignment"
 	"github.com/jordanlanch/industrydb/ent/export"
+	"github.com/jordanlanch/industrydb/ent/exporttemplate"
+	"github.com/jordanlanch/industrydb/ent/importjob"
+	"github.com/jordanlanch/industrydb/ent/integrationconnection"
 	"github.com/jordanlanch/industrydb/ent/leadassignment"
+	"github.com/jordanlanch/industrydb/ent/leadchange"
 	"github.com/jordanlanch/industrydb/ent/leadnote"
 	"github.com/jordanlanch/industrydb/ent/leadrecommendation"
+	"github.com/jordanlanch/industrydb/ent/leadreindexjob"
 	"github.com/jordanlanch/industrydb/ent/leadstatushistory"
+	"github.com/jordanlanch/industrydb/ent/leadsuppression"
+	"github.com/jordanlanch/industrydb/ent/leadverification"
 	"github.com/jordanlanch/industrydb/ent/marketreport"
 	"github.com/jordanlanch/industrydb/ent/organization"
 	"github.com/jordanlanch/industrydb/ent/organizationmember"
 	"github.com/jordanlanch/industrydb/ent/referral"
 	"github.com/jordanlanch/industrydb/ent/savedsearch"
+	"github.com/jordanlanch/industrydb/ent/scheduledexport"
 	"github.com/jordanlanch/industrydb/ent/smscampaign"
 	"github.com/jordanlanch/industrydb/ent/subscription"
 	"github.com/jordanlanch/industrydb/ent/territory"
 	"github.com/jordanlanch/industrydb/ent/territorymember"
+	"github.com/jordanlanch/industrydb/ent/usagedailyaggregate"
 	"github.com/jordanlanch/industrydb/ent/usagelog"
 	"github.com/jordanlanch/industrydb/ent/user"
 	"github.com/jordanlanch/industrydb/ent/userbehavior"
+	"github.com/jordanlanch/industrydb/ent/usernotificationpreference"
 	"github.com/jordanlanch/industrydb/ent/webhook"
 )
 
@@ -390,6 +404,36 @@ func (_c *UserCreate) AddExports(v ...*Export) *UserCreate {
 	return _c.AddExportIDs(ids...)
 }
 
+// AddImportJobIDs adds the "import_jobs" edge to the ImportJob entity by IDs.
+func (_c *UserCreate) AddImportJobIDs(ids ...int) *UserCreate {
+	_c.mutation.AddImportJobIDs(ids...)
+	return _c
+}
+
+// AddImportJobs adds the "import_jobs" edges to the ImportJob entity.
+func (_c *UserCreate) AddImportJobs(v ...*ImportJob) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddImportJobIDs(ids...)
+}
+
+// AddLeadReindexJobIDs adds the "lead_reindex_jobs" edge to the LeadReindexJob entity by IDs.
+func (_c *UserCreate) AddLeadReindexJobIDs(ids ...int) *UserCreate {
+	_c.mutation.AddLeadReindexJobIDs(ids...)
+	return _c
+}
+
+// AddLeadReindexJobs adds the "lead_reindex_jobs" edges to the LeadReindexJob entity.
+func (_c *UserCreate) AddLeadReindexJobs(v ...*LeadReindexJob) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLeadReindexJobIDs(ids...)
+}
+
 // AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
 func (_c *UserCreate) AddAPIKeyIDs(ids ...int) *UserCreate {
 	_c.mutation.AddAPIKeyIDs(ids...)
@@ -435,6 +479,21 @@ func (_c *UserCreate) AddUsageLogs(v ...*UsageLog) *UserCreate {
 	return _c.AddUsageLogIDs(ids...)
 }
 
+// AddUsageDailyAggregateIDs adds the "usage_daily_aggregates" edge to the UsageDailyAggregate entity by IDs.
+func (_c *UserCreate) AddUsageDailyAggregateIDs(ids ...int) *UserCreate {
+	_c.mutation.AddUsageDailyAggregateIDs(ids...)
+	return _c
+}
+
+// AddUsageDailyAggregates adds the "usage_daily_aggregates" edges to the UsageDailyAggregate entity.
+func (_c *UserCreate) AddUsageDailyAggregates(v ...*UsageDailyAggregate) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddUsageDailyAggregateIDs(ids...)
+}
+
 // AddOwnedOrganizationIDs adds the "owned_organizations" edge to the Organization entity by IDs.
 func (_c *UserCreate) AddOwnedOrganizationIDs(ids ...int) *UserCreate {
 	_c.mutation.AddOwnedOrganizationIDs(ids...)
@@ -480,6 +539,36 @@ func (_c *UserCreate) AddSavedSearches(v ...*SavedSearch) *UserCreate {
 	return _c.AddSavedSearchIDs(ids...)
 }
 
+// AddExportTemplateIDs adds the "export_templates" edge to the ExportTemplate entity by IDs.
+func (_c *UserCreate) AddExportTemplateIDs(ids ...int) *UserCreate {
+	_c.mutation.AddExportTemplateIDs(ids...)
+	return _c
+}
+
+// AddExportTemplates adds the "export_templates" edges to the ExportTemplate entity.
+func (_c *UserCreate) AddExportTemplates(v ...*ExportTemplate) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddExportTemplateIDs(ids...)
+}
+
+// AddScheduledExportIDs adds the "scheduled_exports" edge to the ScheduledExport entity by IDs.
+func (_c *UserCreate) AddScheduledExportIDs(ids ...int) *UserCreate {
+	_c.mutation.AddScheduledExportIDs(ids...)
+	return _c
+}
+
+// AddScheduledExports adds the "scheduled_exports" edges to the ScheduledExport entity.
+func (_c *UserCreate) AddScheduledExports(v ...*ScheduledExport) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddScheduledExportIDs(ids...)
+}
+
 // AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
 func (_c *UserCreate) AddWebhookIDs(ids ...int) *UserCreate {
 	_c.mutation.AddWebhookIDs(ids...)
@@ -510,6 +599,51 @@ func (_c *UserCreate) AddLeadNotes(v ...*LeadNote) *UserCreate {
 	return _c.AddLeadNoteIDs(ids...)
 }
 
+// AddLeadSuppressionIDs adds the "lead_suppressions" edge to the LeadSuppression entity by IDs.
+func (_c *UserCreate) AddLeadSuppressionIDs(ids ...int) *UserCreate {
+	_c.mutation.AddLeadSuppressionIDs(ids...)
+	return _c
+}
+
+// AddLeadSuppressions adds the "lead_suppressions" edges to the LeadSuppression entity.
+func (_c *UserCreate) AddLeadSuppressions(v ...*LeadSuppression) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLeadSuppressionIDs(ids...)
+}
+
+// AddContactAttemptIDs adds the "contact_attempts" edge to the ContactAttempt entity by IDs.
+func (_c *UserCreate) AddContactAttemptIDs(ids ...int) *UserCreate {
+	_c.mutation.AddContactAttemptIDs(ids...)
+	return _c
+}
+
+// AddContactAttempts adds the "contact_attempts" edges to the ContactAttempt entity.
+func (_c *UserCreate) AddContactAttempts(v ...*ContactAttempt) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddContactAttemptIDs(ids...)
+}
+
+// AddNotificationPreferenceIDs adds the "notification_preferences" edge to the UserNotificationPreference entity by IDs.
+func (_c *UserCreate) AddNotificationPreferenceIDs(ids ...int) *UserCreate {
+	_c.mutation.AddNotificationPreferenceIDs(ids...)
+	return _c
+}
+
+// AddNotificationPreferences adds the "notification_preferences" edges to the UserNotificationPreference entity.
+func (_c *UserCreate) AddNotificationPreferences(v ...*UserNotificationPreference) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddNotificationPreferenceIDs(ids...)
+}
+
 // AddLeadStatusChangeIDs adds the "lead_status_changes" edge to the LeadStatusHistory entity by IDs.
 func (_c *UserCreate) AddLeadStatusChangeIDs(ids ...int) *UserCreate {
 	_c.mutation.AddLeadStatusChangeIDs(ids...)
@@ -525,6 +659,70 @@ func (_c *UserCreate) AddLeadStatusChanges(v ...*LeadStatusHistory) *UserCreate
 	return _c.AddLeadStatusChangeIDs(ids...)
 }
 
+// AddLeadChangeIDs adds the "lead_changes" edge to the LeadChange entity by IDs.
+func (_c *UserCreate) AddLeadChangeIDs(ids ...int) *UserCreate {
+	_c.mutation.AddLeadChangeIDs(ids...)
+	return _c
+}
+
+// AddLeadChanges adds the "lead_changes" edges to the LeadChange entity.
+func (_c *UserCreate) AddLeadChanges(v ...*LeadChange) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLeadChangeIDs(ids...)
+}
+
+// AddEnrichmentAttemptIDs adds the "enrichment_attempts" edge to the EnrichmentAttempt entity by IDs.
+func (_c *UserCreate) AddEnrichmentAttemptIDs(ids ...int) *UserCreate {
+	_c.mutation.AddEnrichmentAttemptIDs(ids...)
+	return _c
+}
+
+// AddEnrichmentAttempts adds the "enrichment_attempts" edges to the EnrichmentAttempt entity.
+func (_c *UserCreate) AddEnrichmentAttempts(v ...*EnrichmentAttempt) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddEnrichmentAttemptIDs(ids...)
+}
+
+// SetEnrichmentBudgetID sets the "enrichment_budget" edge to the EnrichmentBudget entity by ID.
+func (_c *UserCreate) SetEnrichmentBudgetID(id int) *UserCreate {
+	_c.mutation.SetEnrichmentBudgetID(id)
+	return _c
+}
+
+// SetNillableEnrichmentBudgetID sets the "enrichment_budget" edge to the EnrichmentBudget entity by ID if the given value is not nil.
+func (_c *UserCreate) SetNillableEnrichmentBudgetID(id *int) *UserCreate {
+	if id != nil {
+		_c = _c.SetEnrichmentBudgetID(*id)
+	}
+	return _c
+}
+
+// SetEnrichmentBudget sets the "enrichment_budget" edge to the EnrichmentBudget entity.
+func (_c *UserCreate) SetEnrichmentBudget(v *EnrichmentBudget) *UserCreate {
+	return _c.SetEnrichmentBudgetID(v.ID)
+}
+
+// AddLeadVerificationIDs adds the "lead_verifications" edge to the LeadVerification entity by IDs.
+func (_c *UserCreate) AddLeadVerificationIDs(ids ...int) *UserCreate {
+	_c.mutation.AddLeadVerificationIDs(ids...)
+	return _c
+}
+
+// AddLeadVerifications adds the "lead_verifications" edges to the LeadVerification entity.
+func (_c *UserCreate) AddLeadVerifications(v ...*LeadVerification) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLeadVerificationIDs(ids...)
+}
+
 // AddAssignedLeadIDs adds the "assigned_leads" edge to the LeadAssignment entity by IDs.
 func (_c *UserCreate) AddAssignedLeadIDs(ids ...int) *UserCreate {
 	_c.mutation.AddAssignedLeadIDs(ids...)
@@ -829,6 +1027,36 @@ func (_c *UserCreate) AddCrmIntegrations(v ...*CRMIntegration) *UserCreate {
 	return _c.AddCrmIntegrationIDs(ids...)
 }
 
+// AddCrmPushJobIDs adds the "crm_push_jobs" edge to the CRMPushJob entity by IDs.
+func (_c *UserCreate) AddCrmPushJobIDs(ids ...int) *UserCreate {
+	_c.mutation.AddCrmPushJobIDs(ids...)
+	return _c
+}
+
+// AddCrmPushJobs adds the "crm_push_jobs" edges to the CRMPushJob entity.
+func (_c *UserCreate) AddCrmPushJobs(v ...*CRMPushJob) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCrmPushJobIDs(ids...)
+}
+
+// AddIntegrationConnectionIDs adds the "integration_connections" edge to the IntegrationConnection entity by IDs.
+func (_c *UserCreate) AddIntegrationConnectionIDs(ids ...int) *UserCreate {
+	_c.mutation.AddIntegrationConnectionIDs(ids...)
+	return _c
+}
+
+// AddIntegrationConnections adds the "integration_connections" edges to the IntegrationConnection entity.
+func (_c *UserCreate) AddIntegrationConnections(v ...*IntegrationConnection) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddIntegrationConnectionIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_c *UserCreate) Mutation() *UserMutation {
 	return _c.mutation
@@ -1148,6 +1376,38 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ImportJobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ImportJobsTable,
+			Columns: []string{user.ImportJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importjob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LeadReindexJobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LeadReindexJobsTable,
+			Columns: []string{user.LeadReindexJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadreindexjob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.APIKeysIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1196,6 +1456,22 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.UsageDailyAggregatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UsageDailyAggregatesTable,
+			Columns: []string{user.UsageDailyAggregatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usagedailyaggregate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.OwnedOrganizationsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1244,6 +1520,38 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ExportTemplatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ExportTemplatesTable,
+			Columns: []string{user.ExportTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exporttemplate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ScheduledExportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ScheduledExportsTable,
+			Columns: []string{user.ScheduledExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledexport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.WebhooksIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1276,6 +1584,54 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.LeadSuppressionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LeadSuppressionsTable,
+			Columns: []string{user.LeadSuppressionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadsuppression.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ContactAttemptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ContactAttemptsTable,
+			Columns: []string{user.ContactAttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contactattempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.NotificationPreferencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationPreferencesTable,
+			Columns: []string{user.NotificationPreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usernotificationpreference.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.LeadStatusChangesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1292,6 +1648,70 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.LeadChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LeadChangesTable,
+			Columns: []string{user.LeadChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadchange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.EnrichmentAttemptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EnrichmentAttemptsTable,
+			Columns: []string{user.EnrichmentAttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrichmentattempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.EnrichmentBudgetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.EnrichmentBudgetTable,
+			Columns: []string{user.EnrichmentBudgetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrichmentbudget.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LeadVerificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LeadVerificationsTable,
+			Columns: []string{user.LeadVerificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadverification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.AssignedLeadsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1612,6 +2032,38 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.CrmPushJobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CrmPushJobsTable,
+			Columns: []string{user.CrmPushJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(crmpushjob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.IntegrationConnectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IntegrationConnectionsTable,
+			Columns: []string{user.IntegrationConnectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(integrationconnection.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -18,29 +18,43 @@ import (
 	"github.com/jordanlanch/industrydb/ent/auditlog"
 	"github.com/jordanlanch/industrydb/ent/calllog"
 	"github.com/jordanlanch/industrydb/ent/competitorprofile"
+	"github.com/jordanlanch/industrydb/ent/contactattempt"
 	"github.com/jordanlanch/industrydb/ent/crmintegration"
+	"github.com/jordanlanch/industrydb/ent/crmpushjob"
 	"github.com/jordanlanch/industrydb/ent/emailcampaign"
 	"github.com/jordanlanch/industrydb/ent/emailsequence"
 	"github.com/jordanlanch/industrydb/ent/emailsequenceenrollment"
+	"github.com/jordanlanch/industrydb/ent/enrichmentattempt"
+	"github.com/jordanlanch/industrydb/ent/enrichmentbudget"
 	"github.com/jordanlanch/industrydb/ent/experimentassignment"
 	"github.com/jordanlanch/industrydb/ent/export"
+	"github.com/jordanlanch/industrydb/ent/exporttemplate"
+	"github.com/jordanlanch/industrydb/ent/importjob"
+	"github.com/jordanlanch/industrydb/ent/integrationconnection"
 	"github.com/jordanlanch/industrydb/ent/leadassignment"
+	"github.com/jordanlanch/industrydb/ent/leadchange"
 	"github.com/jordanlanch/industrydb/ent/leadnote"
 	"github.com/jordanlanch/industrydb/ent/leadrecommendation"
+	"github.com/jordanlanch/industrydb/ent/leadreindexjob"
 	"github.com/jordanlanch/industrydb/ent/leadstatushistory"
+	"github.com/jordanlanch/industrydb/ent/leadsuppression"
+	"github.com/jordanlanch/industrydb/ent/leadverification"
 	"github.com/jordanlanch/industrydb/ent/marketreport"
 	"github.com/jordanlanch/industrydb/ent/organization"
 	"github.com/jordanlanch/industrydb/ent/organizationmember"
 	"github.com/jordanlanch/industrydb/ent/predicate"
 	"github.com/jordanlanch/industrydb/ent/referral"
 	"github.com/jordanlanch/industrydb/ent/savedsearch"
+	"github.com/jordanlanch/industrydb/ent/scheduledexport"
 	"github.com/jordanlanch/industrydb/ent/smscampaign"
 	"github.com/jordanlanch/industrydb/ent/subscription"
 	"github.com/jordanlanch/industrydb/ent/territory"
 	"github.com/jordanlanch/industrydb/ent/territorymember"
+	"github.com/jordanlanch/industrydb/ent/usagedailyaggregate"
 	"github.com/jordanlanch/industrydb/ent/usagelog"
 	"github.com/jordanlanch/industrydb/ent/user"
 	"github.com/jordanlanch/industrydb/ent/userbehavior"
+	"github.com/jordanlanch/industrydb/ent/usernotificationpreference"
 	"github.com/jordanlanch/industrydb/ent/webhook"
 )
 
@@ -53,15 +67,27 @@ type UserQuery struct {
 	predicates                       []predicate.User
 	withSubscriptions                *SubscriptionQuery
 	withExports                      *ExportQuery
+	withImportJobs                   *ImportJobQuery
+	withLeadReindexJobs              *LeadReindexJobQuery
 	withAPIKeys                      *APIKeyQuery
 	withAuditLogs                    *AuditLogQuery
 	withUsageLogs                    *UsageLogQuery
+	withUsageDailyAggregates         *UsageDailyAggregateQuery
 	withOwnedOrganizations           *OrganizationQuery
 	withOrganizationMemberships      *OrganizationMemberQuery
 	withSavedSearches                *SavedSearchQuery
+	withExportTemplates              *ExportTemplateQuery
+	withScheduledExports             *ScheduledExportQuery
 	withWebhooks                     *WebhookQuery
 	withLeadNotes                    *LeadNoteQuery
+	withLeadSuppressions             *LeadSuppressionQuery
+	withContactAttempts              *ContactAttemptQuery
+	withNotificationPreferences      *UserNotificationPreferenceQuery
 	withLeadStatusChanges            *LeadStatusHistoryQuery
+	withLeadChanges                  *LeadChangeQuery
+	withEnrichmentAttempts           *EnrichmentAttemptQuery
+	withEnrichmentBudget             *EnrichmentBudgetQuery
+	withLeadVerifications            *LeadVerificationQuery
 	withAssignedLeads                *LeadAssignmentQuery
 	withLeadAssignmentsMade          *LeadAssignmentQuery
 	withEmailSequencesCreated        *EmailSequenceQuery
@@ -82,6 +108,9 @@ type UserQuery struct {
 	withMarketReports                *MarketReportQuery
 	withEmailCampaigns               *EmailCampaignQuery
 	withCrmIntegrations              *CRMIntegrationQuery
+	withCrmPushJobs                  *CRMPushJobQuery
+	withIntegrationConnections       *IntegrationConnectionQuery
+	modifiers                        []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -162,6 +191,50 @@ func (_q *UserQuery) QueryExports() *ExportQuery {
 	return query
 }
 
+// QueryImportJobs chains the current query on the "import_jobs" edge.
+func (_q *UserQuery) QueryImportJobs() *ImportJobQuery {
+	query := (&ImportJobClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(importjob.Table, importjob.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ImportJobsTable, user.ImportJobsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLeadReindexJobs chains the current query on the "lead_reindex_jobs" edge.
+func (_q *UserQuery) QueryLeadReindexJobs() *LeadReindexJobQuery {
+	query := (&LeadReindexJobClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(leadreindexjob.Table, leadreindexjob.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.LeadReindexJobsTable, user.LeadReindexJobsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryAPIKeys chains the current query on the "api_keys" edge.
 func (_q *UserQuery) QueryAPIKeys() *APIKeyQuery {
 	query := (&APIKeyClient{config: _q.config}).Query()
@@ -228,6 +301,28 @@ func (_q *UserQuery) QueryUsageLogs() *UsageLogQuery {
 	return query
 }
 
+// QueryUsageDailyAggregates chains the current query on the "usage_daily_aggregates" edge.
+func (_q *UserQuery) QueryUsageDailyAggregates() *UsageDailyAggregateQuery {
+	query := (&UsageDailyAggregateClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(usagedailyaggregate.Table, usagedailyaggregate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.UsageDailyAggregatesTable, user.UsageDailyAggregatesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryOwnedOrganizations chains the current query on the "owned_organizations" edge.
 func (_q *UserQuery) QueryOwnedOrganizations() *OrganizationQuery {
 	query := (&OrganizationClient{config: _q.config}).Query()
@@ -294,6 +389,50 @@ func (_q *UserQuery) QuerySavedSearches() *SavedSearchQuery {
 	return query
 }
 
+// QueryExportTemplates chains the current query on the "export_templates" edge.
+func (_q *UserQuery) QueryExportTemplates() *ExportTemplateQuery {
+	query := (&ExportTemplateClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(exporttemplate.Table, exporttemplate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ExportTemplatesTable, user.ExportTemplatesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryScheduledExports chains the current query on the "scheduled_exports" edge.
+func (_q *UserQuery) QueryScheduledExports() *ScheduledExportQuery {
+	query := (&ScheduledExportClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(scheduledexport.Table, scheduledexport.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ScheduledExportsTable, user.ScheduledExportsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryWebhooks chains the current query on the "webhooks" edge.
 func (_q *UserQuery) QueryWebhooks() *WebhookQuery {
 	query := (&WebhookClient{config: _q.config}).Query()
@@ -338,6 +477,72 @@ func (_q *UserQuery) QueryLeadNotes() *LeadNoteQuery {
 	return query
 }
 
+// QueryLeadSuppressions chains the current query on the "lead_suppressions" edge.
+func (_q *UserQuery) QueryLeadSuppressions() *LeadSuppressionQuery {
+	query := (&LeadSuppressionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(leadsuppression.Table, leadsuppression.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.LeadSuppressionsTable, user.LeadSuppressionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryContactAttempts chains the current query on the "contact_attempts" edge.
+func (_q *UserQuery) QueryContactAttempts() *ContactAttemptQuery {
+	query := (&ContactAttemptClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(contactattempt.Table, contactattempt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ContactAttemptsTable, user.ContactAttemptsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryNotificationPreferences chains the current query on the "notification_preferences" edge.
+func (_q *UserQuery) QueryNotificationPreferences() *UserNotificationPreferenceQuery {
+	query := (&UserNotificationPreferenceClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(usernotificationpreference.Table, usernotificationpreference.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.NotificationPreferencesTable, user.NotificationPreferencesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryLeadStatusChanges chains the current query on the "lead_status_changes" edge.
 func (_q *UserQuery) QueryLeadStatusChanges() *LeadStatusHistoryQuery {
 	query := (&LeadStatusHistoryClient{config: _q.config}).Query()
@@ -360,6 +565,94 @@ func (_q *UserQuery) QueryLeadStatusChanges() *LeadStatusHistoryQuery {
 	return query
 }
 
+// QueryLeadChanges chains the current query on the "lead_changes" edge.
+func (_q *UserQuery) QueryLeadChanges() *LeadChangeQuery {
+	query := (&LeadChangeClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(leadchange.Table, leadchange.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.LeadChangesTable, user.LeadChangesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEnrichmentAttempts chains the current query on the "enrichment_attempts" edge.
+func (_q *UserQuery) QueryEnrichmentAttempts() *EnrichmentAttemptQuery {
+	query := (&EnrichmentAttemptClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(enrichmentattempt.Table, enrichmentattempt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.EnrichmentAttemptsTable, user.EnrichmentAttemptsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEnrichmentBudget chains the current query on the "enrichment_budget" edge.
+func (_q *UserQuery) QueryEnrichmentBudget() *EnrichmentBudgetQuery {
+	query := (&EnrichmentBudgetClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(enrichmentbudget.Table, enrichmentbudget.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, user.EnrichmentBudgetTable, user.EnrichmentBudgetColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLeadVerifications chains the current query on the "lead_verifications" edge.
+func (_q *UserQuery) QueryLeadVerifications() *LeadVerificationQuery {
+	query := (&LeadVerificationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(leadverification.Table, leadverification.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.LeadVerificationsTable, user.LeadVerificationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryAssignedLeads chains the current query on the "assigned_leads" edge.
 func (_q *UserQuery) QueryAssignedLeads() *LeadAssignmentQuery {
 	query := (&LeadAssignmentClient{config: _q.config}).Query()
@@ -800,6 +1093,50 @@ func (_q *UserQuery) QueryCrmIntegrations() *CRMIntegrationQuery {
 	return query
 }
 
+// QueryCrmPushJobs chains the current query on the "crm_push_jobs" edge.
+func (_q *UserQuery) QueryCrmPushJobs() *CRMPushJobQuery {
+	query := (&CRMPushJobClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(crmpushjob.Table, crmpushjob.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.CrmPushJobsTable, user.CrmPushJobsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryIntegrationConnections chains the current query on the "integration_connections" edge.
+func (_q *UserQuery) QueryIntegrationConnections() *IntegrationConnectionQuery {
+	query := (&IntegrationConnectionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(integrationconnection.Table, integrationconnection.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.IntegrationConnectionsTable, user.IntegrationConnectionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first User entity from the query.
 // Returns a *NotFoundError when no User was found.
 func (_q *UserQuery) First(ctx context.Context) (*User, error) {
@@ -994,15 +1331,27 @@ func (_q *UserQuery) Clone() *UserQuery {
 		predicates:                       append([]predicate.User{}, _q.predicates...),
 		withSubscriptions:                _q.withSubscriptions.Clone(),
 		withExports:                      _q.withExports.Clone(),
+		withImportJobs:                   _q.withImportJobs.Clone(),
+		withLeadReindexJobs:              _q.withLeadReindexJobs.Clone(),
 		withAPIKeys:                      _q.withAPIKeys.Clone(),
 		withAuditLogs:                    _q.withAuditLogs.Clone(),
 		withUsageLogs:                    _q.withUsageLogs.Clone(),
+		withUsageDailyAggregates:         _q.withUsageDailyAggregates.Clone(),
 		withOwnedOrganizations:           _q.withOwnedOrganizations.Clone(),
 		withOrganizationMemberships:      _q.withOrganizationMemberships.Clone(),
 		withSavedSearches:                _q.withSavedSearches.Clone(),
+		withExportTemplates:              _q.withExportTemplates.Clone(),
+		withScheduledExports:             _q.withScheduledExports.Clone(),
 		withWebhooks:                     _q.withWebhooks.Clone(),
 		withLeadNotes:                    _q.withLeadNotes.Clone(),
+		withLeadSuppressions:             _q.withLeadSuppressions.Clone(),
+		withContactAttempts:              _q.withContactAttempts.Clone(),
+		withNotificationPreferences:      _q.withNotificationPreferences.Clone(),
 		withLeadStatusChanges:            _q.withLeadStatusChanges.Clone(),
+		withLeadChanges:                  _q.withLeadChanges.Clone(),
+		withEnrichmentAttempts:           _q.withEnrichmentAttempts.Clone(),
+		withEnrichmentBudget:             _q.withEnrichmentBudget.Clone(),
+		withLeadVerifications:            _q.withLeadVerifications.Clone(),
 		withAssignedLeads:                _q.withAssignedLeads.Clone(),
 		withLeadAssignmentsMade:          _q.withLeadAssignmentsMade.Clone(),
 		withEmailSequencesCreated:        _q.withEmailSequencesCreated.Clone(),
@@ -1023,9 +1372,12 @@ func (_q *UserQuery) Clone() *UserQuery {
 		withMarketReports:                _q.withMarketReports.Clone(),
 		withEmailCampaigns:               _q.withEmailCampaigns.Clone(),
 		withCrmIntegrations:              _q.withCrmIntegrations.Clone(),
+		withCrmPushJobs:                  _q.withCrmPushJobs.Clone(),
+		withIntegrationConnections:       _q.withIntegrationConnections.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -1051,6 +1403,28 @@ func (_q *UserQuery) WithExports(opts ...func(*ExportQuery)) *UserQuery {
 	return _q
 }
 
+// WithImportJobs tells the query-builder to eager-load the nodes that are connected to
+// the "import_jobs" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithImportJobs(opts ...func(*ImportJobQuery)) *UserQuery {
+	query := (&ImportJobClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withImportJobs = query
+	return _q
+}
+
+// WithLeadReindexJobs tells the query-builder to eager-load the nodes that are connected to
+// the "lead_reindex_jobs" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithLeadReindexJobs(opts ...func(*LeadReindexJobQuery)) *UserQuery {
+	query := (&LeadReindexJobClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLeadReindexJobs = query
+	return _q
+}
+
 // WithAPIKeys tells the query-builder to eager-load the nodes that are connected to
 // the "api_keys" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *UserQuery) WithAPIKeys(opts ...func(*APIKeyQuery)) *UserQuery {
@@ -1084,6 +1458,17 @@ func (_q *UserQuery) WithUsageLogs(opts ...func(*UsageLogQuery)) *UserQuery {
 	return _q
 }
 
+// WithUsageDailyAggregates tells the query-builder to eager-load the nodes that are connected to
+// the "usage_daily_aggregates" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithUsageDailyAggregates(opts ...func(*UsageDailyAggregateQuery)) *UserQuery {
+	query := (&UsageDailyAggregateClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withUsageDailyAggregates = query
+	return _q
+}
+
 // WithOwnedOrganizations tells the query-builder to eager-load the nodes that are connected to
 // the "owned_organizations" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *UserQuery) WithOwnedOrganizations(opts ...func(*OrganizationQuery)) *UserQuery {
@@ -1117,6 +1502,28 @@ func (_q *UserQuery) WithSavedSearches(opts ...func(*SavedSearchQuery)) *UserQue
 	return _q
 }
 
+// WithExportTemplates tells the query-builder to eager-load the nodes that are connected to
+// the "export_templates" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithExportTemplates(opts ...func(*ExportTemplateQuery)) *UserQuery {
+	query := (&ExportTemplateClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withExportTemplates = query
+	return _q
+}
+
+// WithScheduledExports tells the query-builder to eager-load the nodes that are connected to
+// the "scheduled_exports" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithScheduledExports(opts ...func(*ScheduledExportQuery)) *UserQuery {
+	query := (&ScheduledExportClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withScheduledExports = query
+	return _q
+}
+
 // WithWebhooks tells the query-builder to eager-load the nodes that are connected to
 // the "webhooks" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *UserQuery) WithWebhooks(opts ...func(*WebhookQuery)) *UserQuery {
@@ -1139,6 +1546,39 @@ func (_q *UserQuery) WithLeadNotes(opts ...func(*LeadNoteQuery)) *UserQuery {
 	return _q
 }
 
+// WithLeadSuppressions tells the query-builder to eager-load the nodes that are connected to
+// the "lead_suppressions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithLeadSuppressions(opts ...func(*LeadSuppressionQuery)) *UserQuery {
+	query := (&LeadSuppressionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLeadSuppressions = query
+	return _q
+}
+
+// WithContactAttempts tells the query-builder to eager-load the nodes that are connected to
+// the "contact_attempts" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithContactAttempts(opts ...func(*ContactAttemptQuery)) *UserQuery {
+	query := (&ContactAttemptClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withContactAttempts = query
+	return _q
+}
+
+// WithNotificationPreferences tells the query-builder to eager-load the nodes that are connected to
+// the "notification_preferences" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithNotificationPreferences(opts ...func(*UserNotificationPreferenceQuery)) *UserQuery {
+	query := (&UserNotificationPreferenceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withNotificationPreferences = query
+	return _q
+}
+
 // WithLeadStatusChanges tells the query-builder to eager-load the nodes that are connected to
 // the "lead_status_changes" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *UserQuery) WithLeadStatusChanges(opts ...func(*LeadStatusHistoryQuery)) *UserQuery {
@@ -1150,6 +1590,50 @@ func (_q *UserQuery) WithLeadStatusChanges(opts ...func(*LeadStatusHistoryQuery)
 	return _q
 }
 
+// WithLeadChanges tells the query-builder to eager-load the nodes that are connected to
+// the "lead_changes" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithLeadChanges(opts ...func(*LeadChangeQuery)) *UserQuery {
+	query := (&LeadChangeClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLeadChanges = query
+	return _q
+}
+
+// WithEnrichmentAttempts tells the query-builder to eager-load the nodes that are connected to
+// the "enrichment_attempts" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithEnrichmentAttempts(opts ...func(*EnrichmentAttemptQuery)) *UserQuery {
+	query := (&EnrichmentAttemptClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withEnrichmentAttempts = query
+	return _q
+}
+
+// WithEnrichmentBudget tells the query-builder to eager-load the nodes that are connected to
+// the "enrichment_budget" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithEnrichmentBudget(opts ...func(*EnrichmentBudgetQuery)) *UserQuery {
+	query := (&EnrichmentBudgetClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withEnrichmentBudget = query
+	return _q
+}
+
+// WithLeadVerifications tells the query-builder to eager-load the nodes that are connected to
+// the "lead_verifications" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithLeadVerifications(opts ...func(*LeadVerificationQuery)) *UserQuery {
+	query := (&LeadVerificationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLeadVerifications = query
+	return _q
+}
+
 // WithAssignedLeads tells the query-builder to eager-load the nodes that are connected to
 // the "assigned_leads" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *UserQuery) WithAssignedLeads(opts ...func(*LeadAssignmentQuery)) *UserQuery {
@@ -1370,6 +1854,28 @@ func (_q *UserQuery) WithCrmIntegrations(opts ...func(*CRMIntegrationQuery)) *Us
 	return _q
 }
 
+// WithCrmPushJobs tells the query-builder to eager-load the nodes that are connected to
+// the "crm_push_jobs" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithCrmPushJobs(opts ...func(*CRMPushJobQuery)) *UserQuery {
+	query := (&CRMPushJobClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCrmPushJobs = query
+	return _q
+}
+
+// WithIntegrationConnections tells the query-builder to eager-load the nodes that are connected to
+// the "integration_connections" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithIntegrationConnections(opts ...func(*IntegrationConnectionQuery)) *UserQuery {
+	query := (&IntegrationConnectionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withIntegrationConnections = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -1448,18 +1954,30 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	var (
 		nodes       = []*User{}
 		_spec       = _q.querySpec()
-		loadedTypes = [31]bool{
+		loadedTypes = [45]bool{
 			_q.withSubscriptions != nil,
 			_q.withExports != nil,
+			_q.withImportJobs != nil,
+			_q.withLeadReindexJobs != nil,
 			_q.withAPIKeys != nil,
 			_q.withAuditLogs != nil,
 			_q.withUsageLogs != nil,
+			_q.withUsageDailyAggregates != nil,
 			_q.withOwnedOrganizations != nil,
 			_q.withOrganizationMemberships != nil,
 			_q.withSavedSearches != nil,
+			_q.withExportTemplates != nil,
+			_q.withScheduledExports != nil,
 			_q.withWebhooks != nil,
 			_q.withLeadNotes != nil,
+			_q.withLeadSuppressions != nil,
+			_q.withContactAttempts != nil,
+			_q.withNotificationPreferences != nil,
 			_q.withLeadStatusChanges != nil,
+			_q.withLeadChanges != nil,
+			_q.withEnrichmentAttempts != nil,
+			_q.withEnrichmentBudget != nil,
+			_q.withLeadVerifications != nil,
 			_q.withAssignedLeads != nil,
 			_q.withLeadAssignmentsMade != nil,
 			_q.withEmailSequencesCreated != nil,
@@ -1480,6 +1998,8 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			_q.withMarketReports != nil,
 			_q.withEmailCampaigns != nil,
 			_q.withCrmIntegrations != nil,
+			_q.withCrmPushJobs != nil,
+			_q.withIntegrationConnections != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -1491,6 +2011,9 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -1514,6 +2037,20 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := _q.withImportJobs; query != nil {
+		if err := _q.loadImportJobs(ctx, query, nodes,
+			func(n *User) { n.Edges.ImportJobs = []*ImportJob{} },
+			func(n *User, e *ImportJob) { n.Edges.ImportJobs = append(n.Edges.ImportJobs, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withLeadReindexJobs; query != nil {
+		if err := _q.loadLeadReindexJobs(ctx, query, nodes,
+			func(n *User) { n.Edges.LeadReindexJobs = []*LeadReindexJob{} },
+			func(n *User, e *LeadReindexJob) { n.Edges.LeadReindexJobs = append(n.Edges.LeadReindexJobs, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withAPIKeys; query != nil {
 		if err := _q.loadAPIKeys(ctx, query, nodes,
 			func(n *User) { n.Edges.APIKeys = []*APIKey{} },
@@ -1535,6 +2072,15 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := _q.withUsageDailyAggregates; query != nil {
+		if err := _q.loadUsageDailyAggregates(ctx, query, nodes,
+			func(n *User) { n.Edges.UsageDailyAggregates = []*UsageDailyAggregate{} },
+			func(n *User, e *UsageDailyAggregate) {
+				n.Edges.UsageDailyAggregates = append(n.Edges.UsageDailyAggregates, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withOwnedOrganizations; query != nil {
 		if err := _q.loadOwnedOrganizations(ctx, query, nodes,
 			func(n *User) { n.Edges.OwnedOrganizations = []*Organization{} },
@@ -1558,6 +2104,20 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := _q.withExportTemplates; query != nil {
+		if err := _q.loadExportTemplates(ctx, query, nodes,
+			func(n *User) { n.Edges.ExportTemplates = []*ExportTemplate{} },
+			func(n *User, e *ExportTemplate) { n.Edges.ExportTemplates = append(n.Edges.ExportTemplates, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withScheduledExports; query != nil {
+		if err := _q.loadScheduledExports(ctx, query, nodes,
+			func(n *User) { n.Edges.ScheduledExports = []*ScheduledExport{} },
+			func(n *User, e *ScheduledExport) { n.Edges.ScheduledExports = append(n.Edges.ScheduledExports, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withWebhooks; query != nil {
 		if err := _q.loadWebhooks(ctx, query, nodes,
 			func(n *User) { n.Edges.Webhooks = []*Webhook{} },
@@ -1572,6 +2132,29 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := _q.withLeadSuppressions; query != nil {
+		if err := _q.loadLeadSuppressions(ctx, query, nodes,
+			func(n *User) { n.Edges.LeadSuppressions = []*LeadSuppression{} },
+			func(n *User, e *LeadSuppression) { n.Edges.LeadSuppressions = append(n.Edges.LeadSuppressions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withContactAttempts; query != nil {
+		if err := _q.loadContactAttempts(ctx, query, nodes,
+			func(n *User) { n.Edges.ContactAttempts = []*ContactAttempt{} },
+			func(n *User, e *ContactAttempt) { n.Edges.ContactAttempts = append(n.Edges.ContactAttempts, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withNotificationPreferences; query != nil {
+		if err := _q.loadNotificationPreferences(ctx, query, nodes,
+			func(n *User) { n.Edges.NotificationPreferences = []*UserNotificationPreference{} },
+			func(n *User, e *UserNotificationPreference) {
+				n.Edges.NotificationPreferences = append(n.Edges.NotificationPreferences, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withLeadStatusChanges; query != nil {
 		if err := _q.loadLeadStatusChanges(ctx, query, nodes,
 			func(n *User) { n.Edges.LeadStatusChanges = []*LeadStatusHistory{} },
@@ -1579,6 +2162,35 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := _q.withLeadChanges; query != nil {
+		if err := _q.loadLeadChanges(ctx, query, nodes,
+			func(n *User) { n.Edges.LeadChanges = []*LeadChange{} },
+			func(n *User, e *LeadChange) { n.Edges.LeadChanges = append(n.Edges.LeadChanges, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withEnrichmentAttempts; query != nil {
+		if err := _q.loadEnrichmentAttempts(ctx, query, nodes,
+			func(n *User) { n.Edges.EnrichmentAttempts = []*EnrichmentAttempt{} },
+			func(n *User, e *EnrichmentAttempt) {
+				n.Edges.EnrichmentAttempts = append(n.Edges.EnrichmentAttempts, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withEnrichmentBudget; query != nil {
+		if err := _q.loadEnrichmentBudget(ctx, query, nodes, nil,
+			func(n *User, e *EnrichmentBudget) { n.Edges.EnrichmentBudget = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withLeadVerifications; query != nil {
+		if err := _q.loadLeadVerifications(ctx, query, nodes,
+			func(n *User) { n.Edges.LeadVerifications = []*LeadVerification{} },
+			func(n *User, e *LeadVerification) { n.Edges.LeadVerifications = append(n.Edges.LeadVerifications, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withAssignedLeads; query != nil {
 		if err := _q.loadAssignedLeads(ctx, query, nodes,
 			func(n *User) { n.Edges.AssignedLeads = []*LeadAssignment{} },
@@ -1713,31 +2325,320 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
-	if query := _q.withMarketReports; query != nil {
-		if err := _q.loadMarketReports(ctx, query, nodes,
-			func(n *User) { n.Edges.MarketReports = []*MarketReport{} },
-			func(n *User, e *MarketReport) { n.Edges.MarketReports = append(n.Edges.MarketReports, e) }); err != nil {
-			return nil, err
-		}
+	if query := _q.withMarketReports; query != nil {
+		if err := _q.loadMarketReports(ctx, query, nodes,
+			func(n *User) { n.Edges.MarketReports = []*MarketReport{} },
+			func(n *User, e *MarketReport) { n.Edges.MarketReports = append(n.Edges.MarketReports, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withEmailCampaigns; query != nil {
+		if err := _q.loadEmailCampaigns(ctx, query, nodes,
+			func(n *User) { n.Edges.EmailCampaigns = []*EmailCampaign{} },
+			func(n *User, e *EmailCampaign) { n.Edges.EmailCampaigns = append(n.Edges.EmailCampaigns, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withCrmIntegrations; query != nil {
+		if err := _q.loadCrmIntegrations(ctx, query, nodes,
+			func(n *User) { n.Edges.CrmIntegrations = []*CRMIntegration{} },
+			func(n *User, e *CRMIntegration) { n.Edges.CrmIntegrations = append(n.Edges.CrmIntegrations, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withCrmPushJobs; query != nil {
+		if err := _q.loadCrmPushJobs(ctx, query, nodes,
+			func(n *User) { n.Edges.CrmPushJobs = []*CRMPushJob{} },
+			func(n *User, e *CRMPushJob) { n.Edges.CrmPushJobs = append(n.Edges.CrmPushJobs, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withIntegrationConnections; query != nil {
+		if err := _q.loadIntegrationConnections(ctx, query, nodes,
+			func(n *User) { n.Edges.IntegrationConnections = []*IntegrationConnection{} },
+			func(n *User, e *IntegrationConnection) {
+				n.Edges.IntegrationConnections = append(n.Edges.IntegrationConnections, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *UserQuery) loadSubscriptions(ctx context.Context, query *SubscriptionQuery, nodes []*User, init func(*User), assign func(*User, *Subscription)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(subscription.FieldUserID)
+	}
+	query.Where(predicate.Subscription(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.SubscriptionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadExports(ctx context.Context, query *ExportQuery, nodes []*User, init func(*User), assign func(*User, *Export)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(export.FieldUserID)
+	}
+	query.Where(predicate.Export(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.ExportsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadImportJobs(ctx context.Context, query *ImportJobQuery, nodes []*User, init func(*User), assign func(*User, *ImportJob)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(importjob.FieldUserID)
+	}
+	query.Where(predicate.ImportJob(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.ImportJobsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadLeadReindexJobs(ctx context.Context, query *LeadReindexJobQuery, nodes []*User, init func(*User), assign func(*User, *LeadReindexJob)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(leadreindexjob.FieldUserID)
+	}
+	query.Where(predicate.LeadReindexJob(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.LeadReindexJobsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadAPIKeys(ctx context.Context, query *APIKeyQuery, nodes []*User, init func(*User), assign func(*User, *APIKey)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(apikey.FieldUserID)
+	}
+	query.Where(predicate.APIKey(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.APIKeysColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadAuditLogs(ctx context.Context, query *AuditLogQuery, nodes []*User, init func(*User), assign func(*User, *AuditLog)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(auditlog.FieldUserID)
+	}
+	query.Where(predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.AuditLogsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadUsageLogs(ctx context.Context, query *UsageLogQuery, nodes []*User, init func(*User), assign func(*User, *UsageLog)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(usagelog.FieldUserID)
+	}
+	query.Where(predicate.UsageLog(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.UsageLogsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadUsageDailyAggregates(ctx context.Context, query *UsageDailyAggregateQuery, nodes []*User, init func(*User), assign func(*User, *UsageDailyAggregate)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(usagedailyaggregate.FieldUserID)
+	}
+	query.Where(predicate.UsageDailyAggregate(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.UsageDailyAggregatesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadOwnedOrganizations(ctx context.Context, query *OrganizationQuery, nodes []*User, init func(*User), assign func(*User, *Organization)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(organization.FieldOwnerID)
 	}
-	if query := _q.withEmailCampaigns; query != nil {
-		if err := _q.loadEmailCampaigns(ctx, query, nodes,
-			func(n *User) { n.Edges.EmailCampaigns = []*EmailCampaign{} },
-			func(n *User, e *EmailCampaign) { n.Edges.EmailCampaigns = append(n.Edges.EmailCampaigns, e) }); err != nil {
-			return nil, err
-		}
+	query.Where(predicate.Organization(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.OwnedOrganizationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
 	}
-	if query := _q.withCrmIntegrations; query != nil {
-		if err := _q.loadCrmIntegrations(ctx, query, nodes,
-			func(n *User) { n.Edges.CrmIntegrations = []*CRMIntegration{} },
-			func(n *User, e *CRMIntegration) { n.Edges.CrmIntegrations = append(n.Edges.CrmIntegrations, e) }); err != nil {
-			return nil, err
+	for _, n := range neighbors {
+		fk := n.OwnerID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "owner_id" returned %v for node %v`, fk, n.ID)
 		}
+		assign(node, n)
 	}
-	return nodes, nil
+	return nil
 }
-
-func (_q *UserQuery) loadSubscriptions(ctx context.Context, query *SubscriptionQuery, nodes []*User, init func(*User), assign func(*User, *Subscription)) error {
+func (_q *UserQuery) loadOrganizationMemberships(ctx context.Context, query *OrganizationMemberQuery, nodes []*User, init func(*User), assign func(*User, *OrganizationMember)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*User)
 	for i := range nodes {
@@ -1748,10 +2649,10 @@ func (_q *UserQuery) loadSubscriptions(ctx context.Context, query *SubscriptionQ
 		}
 	}
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(subscription.FieldUserID)
+		query.ctx.AppendFieldOnce(organizationmember.FieldUserID)
 	}
-	query.Where(predicate.Subscription(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.SubscriptionsColumn), fks...))
+	query.Where(predicate.OrganizationMember(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.OrganizationMembershipsColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
@@ -1767,7 +2668,7 @@ func (_q *UserQuery) loadSubscriptions(ctx context.Context, query *SubscriptionQ
 	}
 	return nil
 }
-func (_q *UserQuery) loadExports(ctx context.Context, query *ExportQuery, nodes []*User, init func(*User), assign func(*User, *Export)) error {
+func (_q *UserQuery) loadSavedSearches(ctx context.Context, query *SavedSearchQuery, nodes []*User, init func(*User), assign func(*User, *SavedSearch)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*User)
 	for i := range nodes {
@@ -1778,10 +2679,10 @@ func (_q *UserQuery) loadExports(ctx context.Context, query *ExportQuery, nodes
 		}
 	}
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(export.FieldUserID)
+		query.ctx.AppendFieldOnce(savedsearch.FieldUserID)
 	}
-	query.Where(predicate.Export(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.ExportsColumn), fks...))
+	query.Where(predicate.SavedSearch(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.SavedSearchesColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
@@ -1797,7 +2698,7 @@ func (_q *UserQuery) loadExports(ctx context.Context, query *ExportQuery, nodes
 	}
 	return nil
 }
-func (_q *UserQuery) loadAPIKeys(ctx context.Context, query *APIKeyQuery, nodes []*User, init func(*User), assign func(*User, *APIKey)) error {
+func (_q *UserQuery) loadExportTemplates(ctx context.Context, query *ExportTemplateQuery, nodes []*User, init func(*User), assign func(*User, *ExportTemplate)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*User)
 	for i := range nodes {
@@ -1808,10 +2709,10 @@ func (_q *UserQuery) loadAPIKeys(ctx context.Context, query *APIKeyQuery, nodes
 		}
 	}
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(apikey.FieldUserID)
+		query.ctx.AppendFieldOnce(exporttemplate.FieldUserID)
 	}
-	query.Where(predicate.APIKey(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.APIKeysColumn), fks...))
+	query.Where(predicate.ExportTemplate(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.ExportTemplatesColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
@@ -1827,7 +2728,7 @@ func (_q *UserQuery) loadAPIKeys(ctx context.Context, query *APIKeyQuery, nodes
 	}
 	return nil
 }
-func (_q *UserQuery) loadAuditLogs(ctx context.Context, query *AuditLogQuery, nodes []*User, init func(*User), assign func(*User, *AuditLog)) error {
+func (_q *UserQuery) loadScheduledExports(ctx context.Context, query *ScheduledExportQuery, nodes []*User, init func(*User), assign func(*User, *ScheduledExport)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*User)
 	for i := range nodes {
@@ -1838,10 +2739,10 @@ func (_q *UserQuery) loadAuditLogs(ctx context.Context, query *AuditLogQuery, no
 		}
 	}
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(auditlog.FieldUserID)
+		query.ctx.AppendFieldOnce(scheduledexport.FieldUserID)
 	}
-	query.Where(predicate.AuditLog(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.AuditLogsColumn), fks...))
+	query.Where(predicate.ScheduledExport(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.ScheduledExportsColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
@@ -1849,18 +2750,46 @@ func (_q *UserQuery) loadAuditLogs(ctx context.Context, query *AuditLogQuery, no
 	}
 	for _, n := range neighbors {
 		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadWebhooks(ctx context.Context, query *WebhookQuery, nodes []*User, init func(*User), assign func(*User, *Webhook)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Webhook(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.WebhooksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_webhooks
 		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_id" is nil for node %v`, n.ID)
+			return fmt.Errorf(`foreign-key "user_webhooks" is nil for node %v`, n.ID)
 		}
 		node, ok := nodeids[*fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "user_webhooks" returned %v for node %v`, *fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (_q *UserQuery) loadUsageLogs(ctx context.Context, query *UsageLogQuery, nodes []*User, init func(*User), assign func(*User, *UsageLog)) error {
+func (_q *UserQuery) loadLeadNotes(ctx context.Context, query *LeadNoteQuery, nodes []*User, init func(*User), assign func(*User, *LeadNote)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*User)
 	for i := range nodes {
@@ -1871,10 +2800,10 @@ func (_q *UserQuery) loadUsageLogs(ctx context.Context, query *UsageLogQuery, no
 		}
 	}
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(usagelog.FieldUserID)
+		query.ctx.AppendFieldOnce(leadnote.FieldUserID)
 	}
-	query.Where(predicate.UsageLog(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.UsageLogsColumn), fks...))
+	query.Where(predicate.LeadNote(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.LeadNotesColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
@@ -1890,7 +2819,7 @@ func (_q *UserQuery) loadUsageLogs(ctx context.Context, query *UsageLogQuery, no
 	}
 	return nil
 }
-func (_q *UserQuery) loadOwnedOrganizations(ctx context.Context, query *OrganizationQuery, nodes []*User, init func(*User), assign func(*User, *Organization)) error {
+func (_q *UserQuery) loadLeadSuppressions(ctx context.Context, query *LeadSuppressionQuery, nodes []*User, init func(*User), assign func(*User, *LeadSuppression)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*User)
 	for i := range nodes {
@@ -1901,26 +2830,26 @@ func (_q *UserQuery) loadOwnedOrganizations(ctx context.Context, query *Organiza
 		}
 	}
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(organization.FieldOwnerID)
+		query.ctx.AppendFieldOnce(leadsuppression.FieldUserID)
 	}
-	query.Where(predicate.Organization(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.OwnedOrganizationsColumn), fks...))
+	query.Where(predicate.LeadSuppression(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.LeadSuppressionsColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.OwnerID
+		fk := n.UserID
 		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "owner_id" returned %v for node %v`, fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (_q *UserQuery) loadOrganizationMemberships(ctx context.Context, query *OrganizationMemberQuery, nodes []*User, init func(*User), assign func(*User, *OrganizationMember)) error {
+func (_q *UserQuery) loadContactAttempts(ctx context.Context, query *ContactAttemptQuery, nodes []*User, init func(*User), assign func(*User, *ContactAttempt)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*User)
 	for i := range nodes {
@@ -1931,10 +2860,10 @@ func (_q *UserQuery) loadOrganizationMemberships(ctx context.Context, query *Org
 		}
 	}
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(organizationmember.FieldUserID)
+		query.ctx.AppendFieldOnce(contactattempt.FieldUserID)
 	}
-	query.Where(predicate.OrganizationMember(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.OrganizationMembershipsColumn), fks...))
+	query.Where(predicate.ContactAttempt(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.ContactAttemptsColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
@@ -1950,7 +2879,7 @@ func (_q *UserQuery) loadOrganizationMemberships(ctx context.Context, query *Org
 	}
 	return nil
 }
-func (_q *UserQuery) loadSavedSearches(ctx context.Context, query *SavedSearchQuery, nodes []*User, init func(*User), assign func(*User, *SavedSearch)) error {
+func (_q *UserQuery) loadNotificationPreferences(ctx context.Context, query *UserNotificationPreferenceQuery, nodes []*User, init func(*User), assign func(*User, *UserNotificationPreference)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*User)
 	for i := range nodes {
@@ -1961,10 +2890,10 @@ func (_q *UserQuery) loadSavedSearches(ctx context.Context, query *SavedSearchQu
 		}
 	}
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(savedsearch.FieldUserID)
+		query.ctx.AppendFieldOnce(usernotificationpreference.FieldUserID)
 	}
-	query.Where(predicate.SavedSearch(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.SavedSearchesColumn), fks...))
+	query.Where(predicate.UserNotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.NotificationPreferencesColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
@@ -1980,7 +2909,7 @@ func (_q *UserQuery) loadSavedSearches(ctx context.Context, query *SavedSearchQu
 	}
 	return nil
 }
-func (_q *UserQuery) loadWebhooks(ctx context.Context, query *WebhookQuery, nodes []*User, init func(*User), assign func(*User, *Webhook)) error {
+func (_q *UserQuery) loadLeadStatusChanges(ctx context.Context, query *LeadStatusHistoryQuery, nodes []*User, init func(*User), assign func(*User, *LeadStatusHistory)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*User)
 	for i := range nodes {
@@ -1990,28 +2919,60 @@ func (_q *UserQuery) loadWebhooks(ctx context.Context, query *WebhookQuery, node
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
-	query.Where(predicate.Webhook(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.WebhooksColumn), fks...))
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(leadstatushistory.FieldUserID)
+	}
+	query.Where(predicate.LeadStatusHistory(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.LeadStatusChangesColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.user_webhooks
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadLeadChanges(ctx context.Context, query *LeadChangeQuery, nodes []*User, init func(*User), assign func(*User, *LeadChange)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(leadchange.FieldUserID)
+	}
+	query.Where(predicate.LeadChange(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.LeadChangesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
 		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_webhooks" is nil for node %v`, n.ID)
+			return fmt.Errorf(`foreign-key "user_id" is nil for node %v`, n.ID)
 		}
 		node, ok := nodeids[*fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_webhooks" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, *fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (_q *UserQuery) loadLeadNotes(ctx context.Context, query *LeadNoteQuery, nodes []*User, init func(*User), assign func(*User, *LeadNote)) error {
+func (_q *UserQuery) loadEnrichmentAttempts(ctx context.Context, query *EnrichmentAttemptQuery, nodes []*User, init func(*User), assign func(*User, *EnrichmentAttempt)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*User)
 	for i := range nodes {
@@ -2022,10 +2983,10 @@ func (_q *UserQuery) loadLeadNotes(ctx context.Context, query *LeadNoteQuery, no
 		}
 	}
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(leadnote.FieldUserID)
+		query.ctx.AppendFieldOnce(enrichmentattempt.FieldUserID)
 	}
-	query.Where(predicate.LeadNote(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.LeadNotesColumn), fks...))
+	query.Where(predicate.EnrichmentAttempt(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.EnrichmentAttemptsColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
@@ -2033,15 +2994,48 @@ func (_q *UserQuery) loadLeadNotes(ctx context.Context, query *LeadNoteQuery, no
 	}
 	for _, n := range neighbors {
 		fk := n.UserID
-		node, ok := nodeids[fk]
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, *fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (_q *UserQuery) loadLeadStatusChanges(ctx context.Context, query *LeadStatusHistoryQuery, nodes []*User, init func(*User), assign func(*User, *LeadStatusHistory)) error {
+func (_q *UserQuery) loadEnrichmentBudget(ctx context.Context, query *EnrichmentBudgetQuery, nodes []*User, init func(*User), assign func(*User, *EnrichmentBudget)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(enrichmentbudget.FieldUserID)
+	}
+	query.Where(predicate.EnrichmentBudget(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.EnrichmentBudgetColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadLeadVerifications(ctx context.Context, query *LeadVerificationQuery, nodes []*User, init func(*User), assign func(*User, *LeadVerification)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*User)
 	for i := range nodes {
@@ -2052,10 +3046,10 @@ func (_q *UserQuery) loadLeadStatusChanges(ctx context.Context, query *LeadStatu
 		}
 	}
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(leadstatushistory.FieldUserID)
+		query.ctx.AppendFieldOnce(leadverification.FieldUserID)
 	}
-	query.Where(predicate.LeadStatusHistory(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.LeadStatusChangesColumn), fks...))
+	query.Where(predicate.LeadVerification(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.LeadVerificationsColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
@@ -2674,9 +3668,72 @@ func (_q *UserQuery) loadCrmIntegrations(ctx context.Context, query *CRMIntegrat
 	}
 	return nil
 }
+func (_q *UserQuery) loadCrmPushJobs(ctx context.Context, query *CRMPushJobQuery, nodes []*User, init func(*User), assign func(*User, *CRMPushJob)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(crmpushjob.FieldUserID)
+	}
+	query.Where(predicate.CRMPushJob(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.CrmPushJobsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadIntegrationConnections(ctx context.Context, query *IntegrationConnectionQuery, nodes []*User, init func(*User), assign func(*User, *IntegrationConnection)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(integrationconnection.FieldUserID)
+	}
+	query.Where(predicate.IntegrationConnection(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.IntegrationConnectionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -2739,6 +3796,9 @@ func (_q *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -2756,6 +3816,12 @@ func (_q *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *UserQuery) Modify(modifiers ...func(s *sql.Selector)) *UserSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // UserGroupBy is the group-by builder for User entities.
 type UserGroupBy struct {
 	selector
@@ -2845,3 +3911,9 @@ func (_s *UserSelect) sqlScan(ctx context.Context, root *UserQuery, v any) error
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *UserSelect) Modify(modifiers ...func(s *sql.Selector)) *UserSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
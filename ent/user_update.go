@@ -17,37 +17,52 @@ import (
 	"github.com/jordanlanch/industrydb/ent/auditlog"
 	"github.com/jordanlanch/industrydb/ent/calllog"
 	"github.com/jordanlanch/industrydb/ent/competitorprofile"
+	"github.com/jordanlanch/industrydb/ent/contactattempt"
 	"github.com/jordanlanch/industrydb/ent/crmintegration"
+	"github.com/jordanlanch/industrydb/ent/crmpushjob"
 	"github.com/jordanlanch/industrydb/ent/emailcampaign"
 	"github.com/jordanlanch/industrydb/ent/emailsequence"
 	"github.com/jordanlanch/industrydb/ent/emailsequenceenrollment"
+	"github.com/jordanlanch/industrydb/ent/enrichmentattempt"
+	"github.com/jordanlanch/industrydb/ent/enrichmentbudget"
 	"github.com/jordanlanch/industrydb/ent/experimentassignment"
 	"github.com/jordanlanch/industrydb/ent/export"
+	"github.com/jordanlanch/industrydb/ent/exporttemplate"
+	"github.com/jordanlanch/industrydb/ent/importjob"
+	"github.com/jordanlanch/industrydb/ent/integrationconnection"
 	"github.com/jordanlanch/industrydb/ent/leadassignment"
+	"github.com/jordanlanch/industrydb/ent/leadchange"
 	"github.com/jordanlanch/industrydb/ent/leadnote"
 	"github.com/jordanlanch/industrydb/ent/leadrecommendation"
+	"github.com/jordanlanch/industrydb/ent/leadreindexjob"
 	"github.com/jordanlanch/industrydb/ent/leadstatushistory"
+	"github.com/jordanlanch/industrydb/ent/leadsuppression"
+	"github.com/jordanlanch/industrydb/ent/leadverification"
 	"github.com/jordanlanch/industrydb/ent/marketreport"
 	"github.com/jordanlanch/industrydb/ent/organization"
 	"github.com/jordanlanch/industrydb/ent/organizationmember"
 	"github.com/jordanlanch/industrydb/ent/predicate"
 	"github.com/jordanlanch/industrydb/ent/referral"
 	"github.com/jordanlanch/industrydb/ent/savedsearch"
+	"github.com/jordanlanch/industrydb/ent/scheduledexport"
 	"github.com/jordanlanch/industrydb/ent/smscampaign"
 	"github.com/jordanlanch/industrydb/ent/subscription"
 	"github.com/jordanlanch/industrydb/ent/territory"
 	"github.com/jordanlanch/industrydb/ent/territorymember"
+	"github.com/jordanlanch/industrydb/ent/usagedailyaggregate"
 	"github.com/jordanlanch/industrydb/ent/usagelog"
 	"github.com/jordanlanch/industrydb/ent/user"
 	"github.com/jordanlanch/industrydb/ent/userbehavior"
+	"github.com/jordanlanch/industrydb/ent/usernotificationpreference"
 	"github.com/jordanlanch/industrydb/ent/webhook"
 )
 
 // UserUpdate is the builder for updating User entities.
 type UserUpdate struct {
 	config
-	hooks    []Hook
-	mutation *UserMutation
+	hooks     []Hook
+	mutation  *UserMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the UserUpdate builder.
@@ -481,6 +496,36 @@ func (_u *UserUpdate) AddExports(v ...*Export) *UserUpdate {
 	return _u.AddExportIDs(ids...)
 }
 
+// AddImportJobIDs adds the "import_jobs" edge to the ImportJob entity by IDs.
+func (_u *UserUpdate) AddImportJobIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddImportJobIDs(ids...)
+	return _u
+}
+
+// AddImportJobs adds the "import_jobs" edges to the ImportJob entity.
+func (_u *UserUpdate) AddImportJobs(v ...*ImportJob) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddImportJobIDs(ids...)
+}
+
+// AddLeadReindexJobIDs adds the "lead_reindex_jobs" edge to the LeadReindexJob entity by IDs.
+func (_u *UserUpdate) AddLeadReindexJobIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddLeadReindexJobIDs(ids...)
+	return _u
+}
+
+// AddLeadReindexJobs adds the "lead_reindex_jobs" edges to the LeadReindexJob entity.
+func (_u *UserUpdate) AddLeadReindexJobs(v ...*LeadReindexJob) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLeadReindexJobIDs(ids...)
+}
+
 // AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
 func (_u *UserUpdate) AddAPIKeyIDs(ids ...int) *UserUpdate {
 	_u.mutation.AddAPIKeyIDs(ids...)
@@ -526,6 +571,21 @@ func (_u *UserUpdate) AddUsageLogs(v ...*UsageLog) *UserUpdate {
 	return _u.AddUsageLogIDs(ids...)
 }
 
+// AddUsageDailyAggregateIDs adds the "usage_daily_aggregates" edge to the UsageDailyAggregate entity by IDs.
+func (_u *UserUpdate) AddUsageDailyAggregateIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddUsageDailyAggregateIDs(ids...)
+	return _u
+}
+
+// AddUsageDailyAggregates adds the "usage_daily_aggregates" edges to the UsageDailyAggregate entity.
+func (_u *UserUpdate) AddUsageDailyAggregates(v ...*UsageDailyAggregate) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddUsageDailyAggregateIDs(ids...)
+}
+
 // AddOwnedOrganizationIDs adds the "owned_organizations" edge to the Organization entity by IDs.
 func (_u *UserUpdate) AddOwnedOrganizationIDs(ids ...int) *UserUpdate {
 	_u.mutation.AddOwnedOrganizationIDs(ids...)
@@ -571,6 +631,36 @@ func (_u *UserUpdate) AddSavedSearches(v ...*SavedSearch) *UserUpdate {
 	return _u.AddSavedSearchIDs(ids...)
 }
 
+// AddExportTemplateIDs adds the "export_templates" edge to the ExportTemplate entity by IDs.
+func (_u *UserUpdate) AddExportTemplateIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddExportTemplateIDs(ids...)
+	return _u
+}
+
+// AddExportTemplates adds the "export_templates" edges to the ExportTemplate entity.
+func (_u *UserUpdate) AddExportTemplates(v ...*ExportTemplate) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddExportTemplateIDs(ids...)
+}
+
+// AddScheduledExportIDs adds the "scheduled_exports" edge to the ScheduledExport entity by IDs.
+func (_u *UserUpdate) AddScheduledExportIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddScheduledExportIDs(ids...)
+	return _u
+}
+
+// AddScheduledExports adds the "scheduled_exports" edges to the ScheduledExport entity.
+func (_u *UserUpdate) AddScheduledExports(v ...*ScheduledExport) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddScheduledExportIDs(ids...)
+}
+
 // AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
 func (_u *UserUpdate) AddWebhookIDs(ids ...int) *UserUpdate {
 	_u.mutation.AddWebhookIDs(ids...)
@@ -601,6 +691,51 @@ func (_u *UserUpdate) AddLeadNotes(v ...*LeadNote) *UserUpdate {
 	return _u.AddLeadNoteIDs(ids...)
 }
 
+// AddLeadSuppressionIDs adds the "lead_suppressions" edge to the LeadSuppression entity by IDs.
+func (_u *UserUpdate) AddLeadSuppressionIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddLeadSuppressionIDs(ids...)
+	return _u
+}
+
+// AddLeadSuppressions adds the "lead_suppressions" edges to the LeadSuppression entity.
+func (_u *UserUpdate) AddLeadSuppressions(v ...*LeadSuppression) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLeadSuppressionIDs(ids...)
+}
+
+// AddContactAttemptIDs adds the "contact_attempts" edge to the ContactAttempt entity by IDs.
+func (_u *UserUpdate) AddContactAttemptIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddContactAttemptIDs(ids...)
+	return _u
+}
+
+// AddContactAttempts adds the "contact_attempts" edges to the ContactAttempt entity.
+func (_u *UserUpdate) AddContactAttempts(v ...*ContactAttempt) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddContactAttemptIDs(ids...)
+}
+
+// AddNotificationPreferenceIDs adds the "notification_preferences" edge to the UserNotificationPreference entity by IDs.
+func (_u *UserUpdate) AddNotificationPreferenceIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddNotificationPreferenceIDs(ids...)
+	return _u
+}
+
+// AddNotificationPreferences adds the "notification_preferences" edges to the UserNotificationPreference entity.
+func (_u *UserUpdate) AddNotificationPreferences(v ...*UserNotificationPreference) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNotificationPreferenceIDs(ids...)
+}
+
 // AddLeadStatusChangeIDs adds the "lead_status_changes" edge to the LeadStatusHistory entity by IDs.
 func (_u *UserUpdate) AddLeadStatusChangeIDs(ids ...int) *UserUpdate {
 	_u.mutation.AddLeadStatusChangeIDs(ids...)
@@ -616,6 +751,70 @@ func (_u *UserUpdate) AddLeadStatusChanges(v ...*LeadStatusHistory) *UserUpdate
 	return _u.AddLeadStatusChangeIDs(ids...)
 }
 
+// AddLeadChangeIDs adds the "lead_changes" edge to the LeadChange entity by IDs.
+func (_u *UserUpdate) AddLeadChangeIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddLeadChangeIDs(ids...)
+	return _u
+}
+
+// AddLeadChanges adds the "lead_changes" edges to the LeadChange entity.
+func (_u *UserUpdate) AddLeadChanges(v ...*LeadChange) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLeadChangeIDs(ids...)
+}
+
+// AddEnrichmentAttemptIDs adds the "enrichment_attempts" edge to the EnrichmentAttempt entity by IDs.
+func (_u *UserUpdate) AddEnrichmentAttemptIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddEnrichmentAttemptIDs(ids...)
+	return _u
+}
+
+// AddEnrichmentAttempts adds the "enrichment_attempts" edges to the EnrichmentAttempt entity.
+func (_u *UserUpdate) AddEnrichmentAttempts(v ...*EnrichmentAttempt) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEnrichmentAttemptIDs(ids...)
+}
+
+// SetEnrichmentBudgetID sets the "enrichment_budget" edge to the EnrichmentBudget entity by ID.
+func (_u *UserUpdate) SetEnrichmentBudgetID(id int) *UserUpdate {
+	_u.mutation.SetEnrichmentBudgetID(id)
+	return _u
+}
+
+// SetNillableEnrichmentBudgetID sets the "enrichment_budget" edge to the EnrichmentBudget entity by ID if the given value is not nil.
+func (_u *UserUpdate) SetNillableEnrichmentBudgetID(id *int) *UserUpdate {
+	if id != nil {
+		_u = _u.SetEnrichmentBudgetID(*id)
+	}
+	return _u
+}
+
+// SetEnrichmentBudget sets the "enrichment_budget" edge to the EnrichmentBudget entity.
+func (_u *UserUpdate) SetEnrichmentBudget(v *EnrichmentBudget) *UserUpdate {
+	return _u.SetEnrichmentBudgetID(v.ID)
+}
+
+// AddLeadVerificationIDs adds the "lead_verifications" edge to the LeadVerification entity by IDs.
+func (_u *UserUpdate) AddLeadVerificationIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddLeadVerificationIDs(ids...)
+	return _u
+}
+
+// AddLeadVerifications adds the "lead_verifications" edges to the LeadVerification entity.
+func (_u *UserUpdate) AddLeadVerifications(v ...*LeadVerification) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLeadVerificationIDs(ids...)
+}
+
 // AddAssignedLeadIDs adds the "assigned_leads" edge to the LeadAssignment entity by IDs.
 func (_u *UserUpdate) AddAssignedLeadIDs(ids ...int) *UserUpdate {
 	_u.mutation.AddAssignedLeadIDs(ids...)
@@ -920,6 +1119,36 @@ func (_u *UserUpdate) AddCrmIntegrations(v ...*CRMIntegration) *UserUpdate {
 	return _u.AddCrmIntegrationIDs(ids...)
 }
 
+// AddCrmPushJobIDs adds the "crm_push_jobs" edge to the CRMPushJob entity by IDs.
+func (_u *UserUpdate) AddCrmPushJobIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddCrmPushJobIDs(ids...)
+	return _u
+}
+
+// AddCrmPushJobs adds the "crm_push_jobs" edges to the CRMPushJob entity.
+func (_u *UserUpdate) AddCrmPushJobs(v ...*CRMPushJob) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCrmPushJobIDs(ids...)
+}
+
+// AddIntegrationConnectionIDs adds the "integration_connections" edge to the IntegrationConnection entity by IDs.
+func (_u *UserUpdate) AddIntegrationConnectionIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddIntegrationConnectionIDs(ids...)
+	return _u
+}
+
+// AddIntegrationConnections adds the "integration_connections" edges to the IntegrationConnection entity.
+func (_u *UserUpdate) AddIntegrationConnections(v ...*IntegrationConnection) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddIntegrationConnectionIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdate) Mutation() *UserMutation {
 	return _u.mutation
@@ -967,6 +1196,48 @@ func (_u *UserUpdate) RemoveExports(v ...*Export) *UserUpdate {
 	return _u.RemoveExportIDs(ids...)
 }
 
+// ClearImportJobs clears all "import_jobs" edges to the ImportJob entity.
+func (_u *UserUpdate) ClearImportJobs() *UserUpdate {
+	_u.mutation.ClearImportJobs()
+	return _u
+}
+
+// RemoveImportJobIDs removes the "import_jobs" edge to ImportJob entities by IDs.
+func (_u *UserUpdate) RemoveImportJobIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveImportJobIDs(ids...)
+	return _u
+}
+
+// RemoveImportJobs removes "import_jobs" edges to ImportJob entities.
+func (_u *UserUpdate) RemoveImportJobs(v ...*ImportJob) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveImportJobIDs(ids...)
+}
+
+// ClearLeadReindexJobs clears all "lead_reindex_jobs" edges to the LeadReindexJob entity.
+func (_u *UserUpdate) ClearLeadReindexJobs() *UserUpdate {
+	_u.mutation.ClearLeadReindexJobs()
+	return _u
+}
+
+// RemoveLeadReindexJobIDs removes the "lead_reindex_jobs" edge to LeadReindexJob entities by IDs.
+func (_u *UserUpdate) RemoveLeadReindexJobIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveLeadReindexJobIDs(ids...)
+	return _u
+}
+
+// RemoveLeadReindexJobs removes "lead_reindex_jobs" edges to LeadReindexJob entities.
+func (_u *UserUpdate) RemoveLeadReindexJobs(v ...*LeadReindexJob) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLeadReindexJobIDs(ids...)
+}
+
 // ClearAPIKeys clears all "api_keys" edges to the APIKey entity.
 func (_u *UserUpdate) ClearAPIKeys() *UserUpdate {
 	_u.mutation.ClearAPIKeys()
@@ -1030,6 +1301,27 @@ func (_u *UserUpdate) RemoveUsageLogs(v ...*UsageLog) *UserUpdate {
 	return _u.RemoveUsageLogIDs(ids...)
 }
 
+// ClearUsageDailyAggregates clears all "usage_daily_aggregates" edges to the UsageDailyAggregate entity.
+func (_u *UserUpdate) ClearUsageDailyAggregates() *UserUpdate {
+	_u.mutation.ClearUsageDailyAggregates()
+	return _u
+}
+
+// RemoveUsageDailyAggregateIDs removes the "usage_daily_aggregates" edge to UsageDailyAggregate entities by IDs.
+func (_u *UserUpdate) RemoveUsageDailyAggregateIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveUsageDailyAggregateIDs(ids...)
+	return _u
+}
+
+// RemoveUsageDailyAggregates removes "usage_daily_aggregates" edges to UsageDailyAggregate entities.
+func (_u *UserUpdate) RemoveUsageDailyAggregates(v ...*UsageDailyAggregate) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUsageDailyAggregateIDs(ids...)
+}
+
 // ClearOwnedOrganizations clears all "owned_organizations" edges to the Organization entity.
 func (_u *UserUpdate) ClearOwnedOrganizations() *UserUpdate {
 	_u.mutation.ClearOwnedOrganizations()
@@ -1093,6 +1385,48 @@ func (_u *UserUpdate) RemoveSavedSearches(v ...*SavedSearch) *UserUpdate {
 	return _u.RemoveSavedSearchIDs(ids...)
 }
 
+// ClearExportTemplates clears all "export_templates" edges to the ExportTemplate entity.
+func (_u *UserUpdate) ClearExportTemplates() *UserUpdate {
+	_u.mutation.ClearExportTemplates()
+	return _u
+}
+
+// RemoveExportTemplateIDs removes the "export_templates" edge to ExportTemplate entities by IDs.
+func (_u *UserUpdate) RemoveExportTemplateIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveExportTemplateIDs(ids...)
+	return _u
+}
+
+// RemoveExportTemplates removes "export_templates" edges to ExportTemplate entities.
+func (_u *UserUpdate) RemoveExportTemplates(v ...*ExportTemplate) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveExportTemplateIDs(ids...)
+}
+
+// ClearScheduledExports clears all "scheduled_exports" edges to the ScheduledExport entity.
+func (_u *UserUpdate) ClearScheduledExports() *UserUpdate {
+	_u.mutation.ClearScheduledExports()
+	return _u
+}
+
+// RemoveScheduledExportIDs removes the "scheduled_exports" edge to ScheduledExport entities by IDs.
+func (_u *UserUpdate) RemoveScheduledExportIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveScheduledExportIDs(ids...)
+	return _u
+}
+
+// RemoveScheduledExports removes "scheduled_exports" edges to ScheduledExport entities.
+func (_u *UserUpdate) RemoveScheduledExports(v ...*ScheduledExport) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveScheduledExportIDs(ids...)
+}
+
 // ClearWebhooks clears all "webhooks" edges to the Webhook entity.
 func (_u *UserUpdate) ClearWebhooks() *UserUpdate {
 	_u.mutation.ClearWebhooks()
@@ -1135,141 +1469,273 @@ func (_u *UserUpdate) RemoveLeadNotes(v ...*LeadNote) *UserUpdate {
 	return _u.RemoveLeadNoteIDs(ids...)
 }
 
-// ClearLeadStatusChanges clears all "lead_status_changes" edges to the LeadStatusHistory entity.
-func (_u *UserUpdate) ClearLeadStatusChanges() *UserUpdate {
-	_u.mutation.ClearLeadStatusChanges()
+// ClearLeadSuppressions clears all "lead_suppressions" edges to the LeadSuppression entity.
+func (_u *UserUpdate) ClearLeadSuppressions() *UserUpdate {
+	_u.mutation.ClearLeadSuppressions()
 	return _u
 }
 
-// RemoveLeadStatusChangeIDs removes the "lead_status_changes" edge to LeadStatusHistory entities by IDs.
-func (_u *UserUpdate) RemoveLeadStatusChangeIDs(ids ...int) *UserUpdate {
-	_u.mutation.RemoveLeadStatusChangeIDs(ids...)
+// RemoveLeadSuppressionIDs removes the "lead_suppressions" edge to LeadSuppression entities by IDs.
+func (_u *UserUpdate) RemoveLeadSuppressionIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveLeadSuppressionIDs(ids...)
 	return _u
 }
 
-// RemoveLeadStatusChanges removes "lead_status_changes" edges to LeadStatusHistory entities.
-func (_u *UserUpdate) RemoveLeadStatusChanges(v ...*LeadStatusHistory) *UserUpdate {
+// RemoveLeadSuppressions removes "lead_suppressions" edges to LeadSuppression entities.
+func (_u *UserUpdate) RemoveLeadSuppressions(v ...*LeadSuppression) *UserUpdate {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveLeadStatusChangeIDs(ids...)
+	return _u.RemoveLeadSuppressionIDs(ids...)
 }
 
-// ClearAssignedLeads clears all "assigned_leads" edges to the LeadAssignment entity.
-func (_u *UserUpdate) ClearAssignedLeads() *UserUpdate {
-	_u.mutation.ClearAssignedLeads()
+// ClearContactAttempts clears all "contact_attempts" edges to the ContactAttempt entity.
+func (_u *UserUpdate) ClearContactAttempts() *UserUpdate {
+	_u.mutation.ClearContactAttempts()
 	return _u
 }
 
-// RemoveAssignedLeadIDs removes the "assigned_leads" edge to LeadAssignment entities by IDs.
-func (_u *UserUpdate) RemoveAssignedLeadIDs(ids ...int) *UserUpdate {
-	_u.mutation.RemoveAssignedLeadIDs(ids...)
+// RemoveContactAttemptIDs removes the "contact_attempts" edge to ContactAttempt entities by IDs.
+func (_u *UserUpdate) RemoveContactAttemptIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveContactAttemptIDs(ids...)
 	return _u
 }
 
-// RemoveAssignedLeads removes "assigned_leads" edges to LeadAssignment entities.
-func (_u *UserUpdate) RemoveAssignedLeads(v ...*LeadAssignment) *UserUpdate {
+// RemoveContactAttempts removes "contact_attempts" edges to ContactAttempt entities.
+func (_u *UserUpdate) RemoveContactAttempts(v ...*ContactAttempt) *UserUpdate {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveAssignedLeadIDs(ids...)
+	return _u.RemoveContactAttemptIDs(ids...)
 }
 
-// ClearLeadAssignmentsMade clears all "lead_assignments_made" edges to the LeadAssignment entity.
-func (_u *UserUpdate) ClearLeadAssignmentsMade() *UserUpdate {
-	_u.mutation.ClearLeadAssignmentsMade()
+// ClearNotificationPreferences clears all "notification_preferences" edges to the UserNotificationPreference entity.
+func (_u *UserUpdate) ClearNotificationPreferences() *UserUpdate {
+	_u.mutation.ClearNotificationPreferences()
 	return _u
 }
 
-// RemoveLeadAssignmentsMadeIDs removes the "lead_assignments_made" edge to LeadAssignment entities by IDs.
-func (_u *UserUpdate) RemoveLeadAssignmentsMadeIDs(ids ...int) *UserUpdate {
-	_u.mutation.RemoveLeadAssignmentsMadeIDs(ids...)
+// RemoveNotificationPreferenceIDs removes the "notification_preferences" edge to UserNotificationPreference entities by IDs.
+func (_u *UserUpdate) RemoveNotificationPreferenceIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveNotificationPreferenceIDs(ids...)
 	return _u
 }
 
-// RemoveLeadAssignmentsMade removes "lead_assignments_made" edges to LeadAssignment entities.
-func (_u *UserUpdate) RemoveLeadAssignmentsMade(v ...*LeadAssignment) *UserUpdate {
+// RemoveNotificationPreferences removes "notification_preferences" edges to UserNotificationPreference entities.
+func (_u *UserUpdate) RemoveNotificationPreferences(v ...*UserNotificationPreference) *UserUpdate {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveLeadAssignmentsMadeIDs(ids...)
+	return _u.RemoveNotificationPreferenceIDs(ids...)
 }
 
-// ClearEmailSequencesCreated clears all "email_sequences_created" edges to the EmailSequence entity.
-func (_u *UserUpdate) ClearEmailSequencesCreated() *UserUpdate {
-	_u.mutation.ClearEmailSequencesCreated()
+// ClearLeadStatusChanges clears all "lead_status_changes" edges to the LeadStatusHistory entity.
+func (_u *UserUpdate) ClearLeadStatusChanges() *UserUpdate {
+	_u.mutation.ClearLeadStatusChanges()
 	return _u
 }
 
-// RemoveEmailSequencesCreatedIDs removes the "email_sequences_created" edge to EmailSequence entities by IDs.
-func (_u *UserUpdate) RemoveEmailSequencesCreatedIDs(ids ...int) *UserUpdate {
-	_u.mutation.RemoveEmailSequencesCreatedIDs(ids...)
+// RemoveLeadStatusChangeIDs removes the "lead_status_changes" edge to LeadStatusHistory entities by IDs.
+func (_u *UserUpdate) RemoveLeadStatusChangeIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveLeadStatusChangeIDs(ids...)
 	return _u
 }
 
-// RemoveEmailSequencesCreated removes "email_sequences_created" edges to EmailSequence entities.
-func (_u *UserUpdate) RemoveEmailSequencesCreated(v ...*EmailSequence) *UserUpdate {
+// RemoveLeadStatusChanges removes "lead_status_changes" edges to LeadStatusHistory entities.
+func (_u *UserUpdate) RemoveLeadStatusChanges(v ...*LeadStatusHistory) *UserUpdate {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveEmailSequencesCreatedIDs(ids...)
+	return _u.RemoveLeadStatusChangeIDs(ids...)
 }
 
-// ClearEmailSequenceEnrollmentsMade clears all "email_sequence_enrollments_made" edges to the EmailSequenceEnrollment entity.
-func (_u *UserUpdate) ClearEmailSequenceEnrollmentsMade() *UserUpdate {
-	_u.mutation.ClearEmailSequenceEnrollmentsMade()
+// ClearLeadChanges clears all "lead_changes" edges to the LeadChange entity.
+func (_u *UserUpdate) ClearLeadChanges() *UserUpdate {
+	_u.mutation.ClearLeadChanges()
 	return _u
 }
 
-// RemoveEmailSequenceEnrollmentsMadeIDs removes the "email_sequence_enrollments_made" edge to EmailSequenceEnrollment entities by IDs.
-func (_u *UserUpdate) RemoveEmailSequenceEnrollmentsMadeIDs(ids ...int) *UserUpdate {
-	_u.mutation.RemoveEmailSequenceEnrollmentsMadeIDs(ids...)
+// RemoveLeadChangeIDs removes the "lead_changes" edge to LeadChange entities by IDs.
+func (_u *UserUpdate) RemoveLeadChangeIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveLeadChangeIDs(ids...)
 	return _u
 }
 
-// RemoveEmailSequenceEnrollmentsMade removes "email_sequence_enrollments_made" edges to EmailSequenceEnrollment entities.
-func (_u *UserUpdate) RemoveEmailSequenceEnrollmentsMade(v ...*EmailSequenceEnrollment) *UserUpdate {
+// RemoveLeadChanges removes "lead_changes" edges to LeadChange entities.
+func (_u *UserUpdate) RemoveLeadChanges(v ...*LeadChange) *UserUpdate {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveEmailSequenceEnrollmentsMadeIDs(ids...)
+	return _u.RemoveLeadChangeIDs(ids...)
 }
 
-// ClearTerritoriesCreated clears all "territories_created" edges to the Territory entity.
-func (_u *UserUpdate) ClearTerritoriesCreated() *UserUpdate {
-	_u.mutation.ClearTerritoriesCreated()
+// ClearEnrichmentAttempts clears all "enrichment_attempts" edges to the EnrichmentAttempt entity.
+func (_u *UserUpdate) ClearEnrichmentAttempts() *UserUpdate {
+	_u.mutation.ClearEnrichmentAttempts()
 	return _u
 }
 
-// RemoveTerritoriesCreatedIDs removes the "territories_created" edge to Territory entities by IDs.
-func (_u *UserUpdate) RemoveTerritoriesCreatedIDs(ids ...int) *UserUpdate {
-	_u.mutation.RemoveTerritoriesCreatedIDs(ids...)
+// RemoveEnrichmentAttemptIDs removes the "enrichment_attempts" edge to EnrichmentAttempt entities by IDs.
+func (_u *UserUpdate) RemoveEnrichmentAttemptIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveEnrichmentAttemptIDs(ids...)
 	return _u
 }
 
-// RemoveTerritoriesCreated removes "territories_created" edges to Territory entities.
-func (_u *UserUpdate) RemoveTerritoriesCreated(v ...*Territory) *UserUpdate {
+// RemoveEnrichmentAttempts removes "enrichment_attempts" edges to EnrichmentAttempt entities.
+func (_u *UserUpdate) RemoveEnrichmentAttempts(v ...*EnrichmentAttempt) *UserUpdate {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveTerritoriesCreatedIDs(ids...)
+	return _u.RemoveEnrichmentAttemptIDs(ids...)
 }
 
-// ClearTerritoryMemberships clears all "territory_memberships" edges to the TerritoryMember entity.
-func (_u *UserUpdate) ClearTerritoryMemberships() *UserUpdate {
-	_u.mutation.ClearTerritoryMemberships()
+// ClearEnrichmentBudget clears the "enrichment_budget" edge to the EnrichmentBudget entity.
+func (_u *UserUpdate) ClearEnrichmentBudget() *UserUpdate {
+	_u.mutation.ClearEnrichmentBudget()
 	return _u
 }
 
-// RemoveTerritoryMembershipIDs removes the "territory_memberships" edge to TerritoryMember entities by IDs.
-func (_u *UserUpdate) RemoveTerritoryMembershipIDs(ids ...int) *UserUpdate {
-	_u.mutation.RemoveTerritoryMembershipIDs(ids...)
+// ClearLeadVerifications clears all "lead_verifications" edges to the LeadVerification entity.
+func (_u *UserUpdate) ClearLeadVerifications() *UserUpdate {
+	_u.mutation.ClearLeadVerifications()
+	return _u
+}
+
+// RemoveLeadVerificationIDs removes the "lead_verifications" edge to LeadVerification entities by IDs.
+func (_u *UserUpdate) RemoveLeadVerificationIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveLeadVerificationIDs(ids...)
+	return _u
+}
+
+// RemoveLeadVerifications removes "lead_verifications" edges to LeadVerification entities.
+func (_u *UserUpdate) RemoveLeadVerifications(v ...*LeadVerification) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLeadVerificationIDs(ids...)
+}
+
+// ClearAssignedLeads clears all "assigned_leads" edges to the LeadAssignment entity.
+func (_u *UserUpdate) ClearAssignedLeads() *UserUpdate {
+	_u.mutation.ClearAssignedLeads()
+	return _u
+}
+
+// RemoveAssignedLeadIDs removes the "assigned_leads" edge to LeadAssignment entities by IDs.
+func (_u *UserUpdate) RemoveAssignedLeadIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveAssignedLeadIDs(ids...)
+	return _u
+}
+
+// RemoveAssignedLeads removes "assigned_leads" edges to LeadAssignment entities.
+func (_u *UserUpdate) RemoveAssignedLeads(v ...*LeadAssignment) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAssignedLeadIDs(ids...)
+}
+
+// ClearLeadAssignmentsMade clears all "lead_assignments_made" edges to the LeadAssignment entity.
+func (_u *UserUpdate) ClearLeadAssignmentsMade() *UserUpdate {
+	_u.mutation.ClearLeadAssignmentsMade()
+	return _u
+}
+
+// RemoveLeadAssignmentsMadeIDs removes the "lead_assignments_made" edge to LeadAssignment entities by IDs.
+func (_u *UserUpdate) RemoveLeadAssignmentsMadeIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveLeadAssignmentsMadeIDs(ids...)
+	return _u
+}
+
+// RemoveLeadAssignmentsMade removes "lead_assignments_made" edges to LeadAssignment entities.
+func (_u *UserUpdate) RemoveLeadAssignmentsMade(v ...*LeadAssignment) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLeadAssignmentsMadeIDs(ids...)
+}
+
+// ClearEmailSequencesCreated clears all "email_sequences_created" edges to the EmailSequence entity.
+func (_u *UserUpdate) ClearEmailSequencesCreated() *UserUpdate {
+	_u.mutation.ClearEmailSequencesCreated()
+	return _u
+}
+
+// RemoveEmailSequencesCreatedIDs removes the "email_sequences_created" edge to EmailSequence entities by IDs.
+func (_u *UserUpdate) RemoveEmailSequencesCreatedIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveEmailSequencesCreatedIDs(ids...)
+	return _u
+}
+
+// RemoveEmailSequencesCreated removes "email_sequences_created" edges to EmailSequence entities.
+func (_u *UserUpdate) RemoveEmailSequencesCreated(v ...*EmailSequence) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEmailSequencesCreatedIDs(ids...)
+}
+
+// ClearEmailSequenceEnrollmentsMade clears all "email_sequence_enrollments_made" edges to the EmailSequenceEnrollment entity.
+func (_u *UserUpdate) ClearEmailSequenceEnrollmentsMade() *UserUpdate {
+	_u.mutation.ClearEmailSequenceEnrollmentsMade()
+	return _u
+}
+
+// RemoveEmailSequenceEnrollmentsMadeIDs removes the "email_sequence_enrollments_made" edge to EmailSequenceEnrollment entities by IDs.
+func (_u *UserUpdate) RemoveEmailSequenceEnrollmentsMadeIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveEmailSequenceEnrollmentsMadeIDs(ids...)
+	return _u
+}
+
+// RemoveEmailSequenceEnrollmentsMade removes "email_sequence_enrollments_made" edges to EmailSequenceEnrollment entities.
+func (_u *UserUpdate) RemoveEmailSequenceEnrollmentsMade(v ...*EmailSequenceEnrollment) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEmailSequenceEnrollmentsMadeIDs(ids...)
+}
+
+// ClearTerritoriesCreated clears all "territories_created" edges to the Territory entity.
+func (_u *UserUpdate) ClearTerritoriesCreated() *UserUpdate {
+	_u.mutation.ClearTerritoriesCreated()
+	return _u
+}
+
+// RemoveTerritoriesCreatedIDs removes the "territories_created" edge to Territory entities by IDs.
+func (_u *UserUpdate) RemoveTerritoriesCreatedIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveTerritoriesCreatedIDs(ids...)
+	return _u
+}
+
+// RemoveTerritoriesCreated removes "territories_created" edges to Territory entities.
+func (_u *UserUpdate) RemoveTerritoriesCreated(v ...*Territory) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTerritoriesCreatedIDs(ids...)
+}
+
+// ClearTerritoryMemberships clears all "territory_memberships" edges to the TerritoryMember entity.
+func (_u *UserUpdate) ClearTerritoryMemberships() *UserUpdate {
+	_u.mutation.ClearTerritoryMemberships()
+	return _u
+}
+
+// RemoveTerritoryMembershipIDs removes the "territory_memberships" edge to TerritoryMember entities by IDs.
+func (_u *UserUpdate) RemoveTerritoryMembershipIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveTerritoryMembershipIDs(ids...)
 	return _u
 }
 
@@ -1561,6 +2027,48 @@ func (_u *UserUpdate) RemoveCrmIntegrations(v ...*CRMIntegration) *UserUpdate {
 	return _u.RemoveCrmIntegrationIDs(ids...)
 }
 
+// ClearCrmPushJobs clears all "crm_push_jobs" edges to the CRMPushJob entity.
+func (_u *UserUpdate) ClearCrmPushJobs() *UserUpdate {
+	_u.mutation.ClearCrmPushJobs()
+	return _u
+}
+
+// RemoveCrmPushJobIDs removes the "crm_push_jobs" edge to CRMPushJob entities by IDs.
+func (_u *UserUpdate) RemoveCrmPushJobIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveCrmPushJobIDs(ids...)
+	return _u
+}
+
+// RemoveCrmPushJobs removes "crm_push_jobs" edges to CRMPushJob entities.
+func (_u *UserUpdate) RemoveCrmPushJobs(v ...*CRMPushJob) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCrmPushJobIDs(ids...)
+}
+
+// ClearIntegrationConnections clears all "integration_connections" edges to the IntegrationConnection entity.
+func (_u *UserUpdate) ClearIntegrationConnections() *UserUpdate {
+	_u.mutation.ClearIntegrationConnections()
+	return _u
+}
+
+// RemoveIntegrationConnectionIDs removes the "integration_connections" edge to IntegrationConnection entities by IDs.
+func (_u *UserUpdate) RemoveIntegrationConnectionIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveIntegrationConnectionIDs(ids...)
+	return _u
+}
+
+// RemoveIntegrationConnections removes "integration_connections" edges to IntegrationConnection entities.
+func (_u *UserUpdate) RemoveIntegrationConnections(v ...*IntegrationConnection) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveIntegrationConnectionIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *UserUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -1642,6 +2150,12 @@ func (_u *UserUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *UserUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -1852,6 +2366,96 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ImportJobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ImportJobsTable,
+			Columns: []string{user.ImportJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importjob.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedImportJobsIDs(); len(nodes) > 0 && !_u.mutation.ImportJobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ImportJobsTable,
+			Columns: []string{user.ImportJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importjob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ImportJobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ImportJobsTable,
+			Columns: []string{user.ImportJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importjob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LeadReindexJobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LeadReindexJobsTable,
+			Columns: []string{user.LeadReindexJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadreindexjob.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLeadReindexJobsIDs(); len(nodes) > 0 && !_u.mutation.LeadReindexJobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LeadReindexJobsTable,
+			Columns: []string{user.LeadReindexJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadreindexjob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LeadReindexJobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LeadReindexJobsTable,
+			Columns: []string{user.LeadReindexJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadreindexjob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.APIKeysCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1987,6 +2591,51 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.UsageDailyAggregatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UsageDailyAggregatesTable,
+			Columns: []string{user.UsageDailyAggregatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usagedailyaggregate.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedUsageDailyAggregatesIDs(); len(nodes) > 0 && !_u.mutation.UsageDailyAggregatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UsageDailyAggregatesTable,
+			Columns: []string{user.UsageDailyAggregatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usagedailyaggregate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UsageDailyAggregatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UsageDailyAggregatesTable,
+			Columns: []string{user.UsageDailyAggregatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usagedailyaggregate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.OwnedOrganizationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -2122,28 +2771,28 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.WebhooksCleared() {
+	if _u.mutation.ExportTemplatesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.WebhooksTable,
-			Columns: []string{user.WebhooksColumn},
+			Table:   user.ExportTemplatesTable,
+			Columns: []string{user.ExportTemplatesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(exporttemplate.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedWebhooksIDs(); len(nodes) > 0 && !_u.mutation.WebhooksCleared() {
+	if nodes := _u.mutation.RemovedExportTemplatesIDs(); len(nodes) > 0 && !_u.mutation.ExportTemplatesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.WebhooksTable,
-			Columns: []string{user.WebhooksColumn},
+			Table:   user.ExportTemplatesTable,
+			Columns: []string{user.ExportTemplatesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(exporttemplate.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2151,15 +2800,15 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.WebhooksIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ExportTemplatesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.WebhooksTable,
-			Columns: []string{user.WebhooksColumn},
+			Table:   user.ExportTemplatesTable,
+			Columns: []string{user.ExportTemplatesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(exporttemplate.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2167,28 +2816,28 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.LeadNotesCleared() {
+	if _u.mutation.ScheduledExportsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.LeadNotesTable,
-			Columns: []string{user.LeadNotesColumn},
+			Table:   user.ScheduledExportsTable,
+			Columns: []string{user.ScheduledExportsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(leadnote.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(scheduledexport.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedLeadNotesIDs(); len(nodes) > 0 && !_u.mutation.LeadNotesCleared() {
+	if nodes := _u.mutation.RemovedScheduledExportsIDs(); len(nodes) > 0 && !_u.mutation.ScheduledExportsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.LeadNotesTable,
-			Columns: []string{user.LeadNotesColumn},
+			Table:   user.ScheduledExportsTable,
+			Columns: []string{user.ScheduledExportsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(leadnote.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(scheduledexport.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2196,15 +2845,15 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.LeadNotesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ScheduledExportsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.LeadNotesTable,
-			Columns: []string{user.LeadNotesColumn},
+			Table:   user.ScheduledExportsTable,
+			Columns: []string{user.ScheduledExportsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(leadnote.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(scheduledexport.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2212,28 +2861,28 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.LeadStatusChangesCleared() {
+	if _u.mutation.WebhooksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.LeadStatusChangesTable,
-			Columns: []string{user.LeadStatusChangesColumn},
+			Table:   user.WebhooksTable,
+			Columns: []string{user.WebhooksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(leadstatushistory.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedLeadStatusChangesIDs(); len(nodes) > 0 && !_u.mutation.LeadStatusChangesCleared() {
+	if nodes := _u.mutation.RemovedWebhooksIDs(); len(nodes) > 0 && !_u.mutation.WebhooksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.LeadStatusChangesTable,
-			Columns: []string{user.LeadStatusChangesColumn},
+			Table:   user.WebhooksTable,
+			Columns: []string{user.WebhooksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(leadstatushistory.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2241,15 +2890,15 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.LeadStatusChangesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.WebhooksIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.LeadStatusChangesTable,
-			Columns: []string{user.LeadStatusChangesColumn},
+			Table:   user.WebhooksTable,
+			Columns: []string{user.WebhooksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(leadstatushistory.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2257,28 +2906,28 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.AssignedLeadsCleared() {
+	if _u.mutation.LeadNotesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.AssignedLeadsTable,
-			Columns: []string{user.AssignedLeadsColumn},
+			Table:   user.LeadNotesTable,
+			Columns: []string{user.LeadNotesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(leadassignment.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(leadnote.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedAssignedLeadsIDs(); len(nodes) > 0 && !_u.mutation.AssignedLeadsCleared() {
+	if nodes := _u.mutation.RemovedLeadNotesIDs(); len(nodes) > 0 && !_u.mutation.LeadNotesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.AssignedLeadsTable,
-			Columns: []string{user.AssignedLeadsColumn},
+			Table:   user.LeadNotesTable,
+			Columns: []string{user.LeadNotesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(leadassignment.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(leadnote.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2286,15 +2935,15 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.AssignedLeadsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.LeadNotesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.AssignedLeadsTable,
-			Columns: []string{user.AssignedLeadsColumn},
+			Table:   user.LeadNotesTable,
+			Columns: []string{user.LeadNotesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(leadassignment.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(leadnote.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2302,28 +2951,28 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.LeadAssignmentsMadeCleared() {
+	if _u.mutation.LeadSuppressionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.LeadAssignmentsMadeTable,
-			Columns: []string{user.LeadAssignmentsMadeColumn},
+			Table:   user.LeadSuppressionsTable,
+			Columns: []string{user.LeadSuppressionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(leadassignment.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(leadsuppression.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedLeadAssignmentsMadeIDs(); len(nodes) > 0 && !_u.mutation.LeadAssignmentsMadeCleared() {
+	if nodes := _u.mutation.RemovedLeadSuppressionsIDs(); len(nodes) > 0 && !_u.mutation.LeadSuppressionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.LeadAssignmentsMadeTable,
-			Columns: []string{user.LeadAssignmentsMadeColumn},
+			Table:   user.LeadSuppressionsTable,
+			Columns: []string{user.LeadSuppressionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(leadassignment.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(leadsuppression.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2331,15 +2980,15 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.LeadAssignmentsMadeIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.LeadSuppressionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.LeadAssignmentsMadeTable,
-			Columns: []string{user.LeadAssignmentsMadeColumn},
+			Table:   user.LeadSuppressionsTable,
+			Columns: []string{user.LeadSuppressionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(leadassignment.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(leadsuppression.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2347,28 +2996,28 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.EmailSequencesCreatedCleared() {
+	if _u.mutation.ContactAttemptsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.EmailSequencesCreatedTable,
-			Columns: []string{user.EmailSequencesCreatedColumn},
+			Table:   user.ContactAttemptsTable,
+			Columns: []string{user.ContactAttemptsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(emailsequence.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(contactattempt.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedEmailSequencesCreatedIDs(); len(nodes) > 0 && !_u.mutation.EmailSequencesCreatedCleared() {
+	if nodes := _u.mutation.RemovedContactAttemptsIDs(); len(nodes) > 0 && !_u.mutation.ContactAttemptsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.EmailSequencesCreatedTable,
-			Columns: []string{user.EmailSequencesCreatedColumn},
+			Table:   user.ContactAttemptsTable,
+			Columns: []string{user.ContactAttemptsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(emailsequence.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(contactattempt.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2376,15 +3025,15 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.EmailSequencesCreatedIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ContactAttemptsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.EmailSequencesCreatedTable,
-			Columns: []string{user.EmailSequencesCreatedColumn},
+			Table:   user.ContactAttemptsTable,
+			Columns: []string{user.ContactAttemptsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(emailsequence.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(contactattempt.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2392,28 +3041,28 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.EmailSequenceEnrollmentsMadeCleared() {
+	if _u.mutation.NotificationPreferencesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.EmailSequenceEnrollmentsMadeTable,
-			Columns: []string{user.EmailSequenceEnrollmentsMadeColumn},
+			Table:   user.NotificationPreferencesTable,
+			Columns: []string{user.NotificationPreferencesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(emailsequenceenrollment.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(usernotificationpreference.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedEmailSequenceEnrollmentsMadeIDs(); len(nodes) > 0 && !_u.mutation.EmailSequenceEnrollmentsMadeCleared() {
+	if nodes := _u.mutation.RemovedNotificationPreferencesIDs(); len(nodes) > 0 && !_u.mutation.NotificationPreferencesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.EmailSequenceEnrollmentsMadeTable,
-			Columns: []string{user.EmailSequenceEnrollmentsMadeColumn},
+			Table:   user.NotificationPreferencesTable,
+			Columns: []string{user.NotificationPreferencesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(emailsequenceenrollment.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(usernotificationpreference.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2421,15 +3070,15 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.EmailSequenceEnrollmentsMadeIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.NotificationPreferencesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.EmailSequenceEnrollmentsMadeTable,
-			Columns: []string{user.EmailSequenceEnrollmentsMadeColumn},
+			Table:   user.NotificationPreferencesTable,
+			Columns: []string{user.NotificationPreferencesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(emailsequenceenrollment.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(usernotificationpreference.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2437,28 +3086,28 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.TerritoriesCreatedCleared() {
+	if _u.mutation.LeadStatusChangesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.TerritoriesCreatedTable,
-			Columns: []string{user.TerritoriesCreatedColumn},
+			Table:   user.LeadStatusChangesTable,
+			Columns: []string{user.LeadStatusChangesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(territory.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(leadstatushistory.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedTerritoriesCreatedIDs(); len(nodes) > 0 && !_u.mutation.TerritoriesCreatedCleared() {
+	if nodes := _u.mutation.RemovedLeadStatusChangesIDs(); len(nodes) > 0 && !_u.mutation.LeadStatusChangesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.TerritoriesCreatedTable,
-			Columns: []string{user.TerritoriesCreatedColumn},
+			Table:   user.LeadStatusChangesTable,
+			Columns: []string{user.LeadStatusChangesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(territory.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(leadstatushistory.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2466,15 +3115,15 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.TerritoriesCreatedIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.LeadStatusChangesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.TerritoriesCreatedTable,
-			Columns: []string{user.TerritoriesCreatedColumn},
+			Table:   user.LeadStatusChangesTable,
+			Columns: []string{user.LeadStatusChangesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(territory.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(leadstatushistory.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2482,28 +3131,28 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.TerritoryMembershipsCleared() {
+	if _u.mutation.LeadChangesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.TerritoryMembershipsTable,
-			Columns: []string{user.TerritoryMembershipsColumn},
+			Table:   user.LeadChangesTable,
+			Columns: []string{user.LeadChangesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(territorymember.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(leadchange.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedTerritoryMembershipsIDs(); len(nodes) > 0 && !_u.mutation.TerritoryMembershipsCleared() {
+	if nodes := _u.mutation.RemovedLeadChangesIDs(); len(nodes) > 0 && !_u.mutation.LeadChangesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.TerritoryMembershipsTable,
-			Columns: []string{user.TerritoryMembershipsColumn},
+			Table:   user.LeadChangesTable,
+			Columns: []string{user.LeadChangesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(territorymember.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(leadchange.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2511,15 +3160,15 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.TerritoryMembershipsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.LeadChangesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.TerritoryMembershipsTable,
-			Columns: []string{user.TerritoryMembershipsColumn},
+			Table:   user.LeadChangesTable,
+			Columns: []string{user.LeadChangesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(territorymember.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(leadchange.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2527,28 +3176,28 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.TerritoryMembersAddedCleared() {
+	if _u.mutation.EnrichmentAttemptsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.TerritoryMembersAddedTable,
-			Columns: []string{user.TerritoryMembersAddedColumn},
+			Table:   user.EnrichmentAttemptsTable,
+			Columns: []string{user.EnrichmentAttemptsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(territorymember.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(enrichmentattempt.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedTerritoryMembersAddedIDs(); len(nodes) > 0 && !_u.mutation.TerritoryMembersAddedCleared() {
+	if nodes := _u.mutation.RemovedEnrichmentAttemptsIDs(); len(nodes) > 0 && !_u.mutation.EnrichmentAttemptsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.TerritoryMembersAddedTable,
-			Columns: []string{user.TerritoryMembersAddedColumn},
+			Table:   user.EnrichmentAttemptsTable,
+			Columns: []string{user.EnrichmentAttemptsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(territorymember.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(enrichmentattempt.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2556,15 +3205,15 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.TerritoryMembersAddedIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.EnrichmentAttemptsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.TerritoryMembersAddedTable,
-			Columns: []string{user.TerritoryMembersAddedColumn},
+			Table:   user.EnrichmentAttemptsTable,
+			Columns: []string{user.EnrichmentAttemptsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(territorymember.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(enrichmentattempt.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2572,28 +3221,57 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.SentReferralsCleared() {
+	if _u.mutation.EnrichmentBudgetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.EnrichmentBudgetTable,
+			Columns: []string{user.EnrichmentBudgetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrichmentbudget.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EnrichmentBudgetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.EnrichmentBudgetTable,
+			Columns: []string{user.EnrichmentBudgetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrichmentbudget.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LeadVerificationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.SentReferralsTable,
-			Columns: []string{user.SentReferralsColumn},
+			Table:   user.LeadVerificationsTable,
+			Columns: []string{user.LeadVerificationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(referral.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(leadverification.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedSentReferralsIDs(); len(nodes) > 0 && !_u.mutation.SentReferralsCleared() {
+	if nodes := _u.mutation.RemovedLeadVerificationsIDs(); len(nodes) > 0 && !_u.mutation.LeadVerificationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.SentReferralsTable,
-			Columns: []string{user.SentReferralsColumn},
+			Table:   user.LeadVerificationsTable,
+			Columns: []string{user.LeadVerificationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(referral.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(leadverification.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2601,15 +3279,15 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.SentReferralsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.LeadVerificationsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.SentReferralsTable,
-			Columns: []string{user.SentReferralsColumn},
+			Table:   user.LeadVerificationsTable,
+			Columns: []string{user.LeadVerificationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(referral.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(leadverification.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2617,28 +3295,28 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ReceivedReferralsCleared() {
+	if _u.mutation.AssignedLeadsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ReceivedReferralsTable,
-			Columns: []string{user.ReceivedReferralsColumn},
+			Table:   user.AssignedLeadsTable,
+			Columns: []string{user.AssignedLeadsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(referral.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(leadassignment.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedReceivedReferralsIDs(); len(nodes) > 0 && !_u.mutation.ReceivedReferralsCleared() {
+	if nodes := _u.mutation.RemovedAssignedLeadsIDs(); len(nodes) > 0 && !_u.mutation.AssignedLeadsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ReceivedReferralsTable,
-			Columns: []string{user.ReceivedReferralsColumn},
+			Table:   user.AssignedLeadsTable,
+			Columns: []string{user.AssignedLeadsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(referral.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(leadassignment.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2646,15 +3324,15 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ReceivedReferralsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.AssignedLeadsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ReceivedReferralsTable,
-			Columns: []string{user.ReceivedReferralsColumn},
+			Table:   user.AssignedLeadsTable,
+			Columns: []string{user.AssignedLeadsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(referral.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(leadassignment.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2662,28 +3340,28 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ExperimentAssignmentsCleared() {
+	if _u.mutation.LeadAssignmentsMadeCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ExperimentAssignmentsTable,
-			Columns: []string{user.ExperimentAssignmentsColumn},
+			Table:   user.LeadAssignmentsMadeTable,
+			Columns: []string{user.LeadAssignmentsMadeColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(experimentassignment.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(leadassignment.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedExperimentAssignmentsIDs(); len(nodes) > 0 && !_u.mutation.ExperimentAssignmentsCleared() {
+	if nodes := _u.mutation.RemovedLeadAssignmentsMadeIDs(); len(nodes) > 0 && !_u.mutation.LeadAssignmentsMadeCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ExperimentAssignmentsTable,
-			Columns: []string{user.ExperimentAssignmentsColumn},
+			Table:   user.LeadAssignmentsMadeTable,
+			Columns: []string{user.LeadAssignmentsMadeColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(experimentassignment.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(leadassignment.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2691,15 +3369,15 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ExperimentAssignmentsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.LeadAssignmentsMadeIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ExperimentAssignmentsTable,
-			Columns: []string{user.ExperimentAssignmentsColumn},
+			Table:   user.LeadAssignmentsMadeTable,
+			Columns: []string{user.LeadAssignmentsMadeColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(experimentassignment.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(leadassignment.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2707,57 +3385,73 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.AffiliateCleared() {
+	if _u.mutation.EmailSequencesCreatedCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.AffiliateTable,
-			Columns: []string{user.AffiliateColumn},
+			Table:   user.EmailSequencesCreatedTable,
+			Columns: []string{user.EmailSequencesCreatedColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(affiliate.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(emailsequence.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.AffiliateIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.RemovedEmailSequencesCreatedIDs(); len(nodes) > 0 && !_u.mutation.EmailSequencesCreatedCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.AffiliateTable,
-			Columns: []string{user.AffiliateColumn},
+			Table:   user.EmailSequencesCreatedTable,
+			Columns: []string{user.EmailSequencesCreatedColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(affiliate.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(emailsequence.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if _u.mutation.AffiliateConversionsCleared() {
+	if nodes := _u.mutation.EmailSequencesCreatedIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.AffiliateConversionsTable,
-			Columns: []string{user.AffiliateConversionsColumn},
+			Table:   user.EmailSequencesCreatedTable,
+			Columns: []string{user.EmailSequencesCreatedColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(affiliateconversion.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(emailsequence.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EmailSequenceEnrollmentsMadeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailSequenceEnrollmentsMadeTable,
+			Columns: []string{user.EmailSequenceEnrollmentsMadeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailsequenceenrollment.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedAffiliateConversionsIDs(); len(nodes) > 0 && !_u.mutation.AffiliateConversionsCleared() {
+	if nodes := _u.mutation.RemovedEmailSequenceEnrollmentsMadeIDs(); len(nodes) > 0 && !_u.mutation.EmailSequenceEnrollmentsMadeCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.AffiliateConversionsTable,
-			Columns: []string{user.AffiliateConversionsColumn},
+			Table:   user.EmailSequenceEnrollmentsMadeTable,
+			Columns: []string{user.EmailSequenceEnrollmentsMadeColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(affiliateconversion.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(emailsequenceenrollment.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2765,15 +3459,15 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.AffiliateConversionsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.EmailSequenceEnrollmentsMadeIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.AffiliateConversionsTable,
-			Columns: []string{user.AffiliateConversionsColumn},
+			Table:   user.EmailSequenceEnrollmentsMadeTable,
+			Columns: []string{user.EmailSequenceEnrollmentsMadeColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(affiliateconversion.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(emailsequenceenrollment.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2781,28 +3475,28 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.SmsCampaignsCleared() {
+	if _u.mutation.TerritoriesCreatedCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.SmsCampaignsTable,
-			Columns: []string{user.SmsCampaignsColumn},
+			Table:   user.TerritoriesCreatedTable,
+			Columns: []string{user.TerritoriesCreatedColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(smscampaign.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(territory.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedSmsCampaignsIDs(); len(nodes) > 0 && !_u.mutation.SmsCampaignsCleared() {
+	if nodes := _u.mutation.RemovedTerritoriesCreatedIDs(); len(nodes) > 0 && !_u.mutation.TerritoriesCreatedCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.SmsCampaignsTable,
-			Columns: []string{user.SmsCampaignsColumn},
+			Table:   user.TerritoriesCreatedTable,
+			Columns: []string{user.TerritoriesCreatedColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(smscampaign.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(territory.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2810,15 +3504,15 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.SmsCampaignsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.TerritoriesCreatedIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.SmsCampaignsTable,
-			Columns: []string{user.SmsCampaignsColumn},
+			Table:   user.TerritoriesCreatedTable,
+			Columns: []string{user.TerritoriesCreatedColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(smscampaign.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(territory.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2826,28 +3520,28 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.CallLogsCleared() {
+	if _u.mutation.TerritoryMembershipsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.CallLogsTable,
-			Columns: []string{user.CallLogsColumn},
+			Table:   user.TerritoryMembershipsTable,
+			Columns: []string{user.TerritoryMembershipsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(calllog.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(territorymember.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedCallLogsIDs(); len(nodes) > 0 && !_u.mutation.CallLogsCleared() {
+	if nodes := _u.mutation.RemovedTerritoryMembershipsIDs(); len(nodes) > 0 && !_u.mutation.TerritoryMembershipsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.CallLogsTable,
-			Columns: []string{user.CallLogsColumn},
+			Table:   user.TerritoryMembershipsTable,
+			Columns: []string{user.TerritoryMembershipsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(calllog.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(territorymember.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2855,15 +3549,15 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.CallLogsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.TerritoryMembershipsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.CallLogsTable,
-			Columns: []string{user.CallLogsColumn},
+			Table:   user.TerritoryMembershipsTable,
+			Columns: []string{user.TerritoryMembershipsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(calllog.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(territorymember.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2871,28 +3565,28 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.CompetitorProfilesCleared() {
+	if _u.mutation.TerritoryMembersAddedCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.CompetitorProfilesTable,
-			Columns: []string{user.CompetitorProfilesColumn},
+			Table:   user.TerritoryMembersAddedTable,
+			Columns: []string{user.TerritoryMembersAddedColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(competitorprofile.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(territorymember.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedCompetitorProfilesIDs(); len(nodes) > 0 && !_u.mutation.CompetitorProfilesCleared() {
+	if nodes := _u.mutation.RemovedTerritoryMembersAddedIDs(); len(nodes) > 0 && !_u.mutation.TerritoryMembersAddedCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.CompetitorProfilesTable,
-			Columns: []string{user.CompetitorProfilesColumn},
+			Table:   user.TerritoryMembersAddedTable,
+			Columns: []string{user.TerritoryMembersAddedColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(competitorprofile.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(territorymember.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2900,15 +3594,15 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.CompetitorProfilesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.TerritoryMembersAddedIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.CompetitorProfilesTable,
-			Columns: []string{user.CompetitorProfilesColumn},
+			Table:   user.TerritoryMembersAddedTable,
+			Columns: []string{user.TerritoryMembersAddedColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(competitorprofile.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(territorymember.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2916,28 +3610,28 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.LeadRecommendationsCleared() {
+	if _u.mutation.SentReferralsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.LeadRecommendationsTable,
-			Columns: []string{user.LeadRecommendationsColumn},
+			Table:   user.SentReferralsTable,
+			Columns: []string{user.SentReferralsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(leadrecommendation.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(referral.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedLeadRecommendationsIDs(); len(nodes) > 0 && !_u.mutation.LeadRecommendationsCleared() {
+	if nodes := _u.mutation.RemovedSentReferralsIDs(); len(nodes) > 0 && !_u.mutation.SentReferralsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.LeadRecommendationsTable,
-			Columns: []string{user.LeadRecommendationsColumn},
+			Table:   user.SentReferralsTable,
+			Columns: []string{user.SentReferralsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(leadrecommendation.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(referral.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2945,15 +3639,15 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.LeadRecommendationsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.SentReferralsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.LeadRecommendationsTable,
-			Columns: []string{user.LeadRecommendationsColumn},
+			Table:   user.SentReferralsTable,
+			Columns: []string{user.SentReferralsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(leadrecommendation.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(referral.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2961,28 +3655,28 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.BehaviorsCleared() {
+	if _u.mutation.ReceivedReferralsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.BehaviorsTable,
-			Columns: []string{user.BehaviorsColumn},
+			Table:   user.ReceivedReferralsTable,
+			Columns: []string{user.ReceivedReferralsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(userbehavior.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(referral.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedBehaviorsIDs(); len(nodes) > 0 && !_u.mutation.BehaviorsCleared() {
+	if nodes := _u.mutation.RemovedReceivedReferralsIDs(); len(nodes) > 0 && !_u.mutation.ReceivedReferralsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.BehaviorsTable,
-			Columns: []string{user.BehaviorsColumn},
+			Table:   user.ReceivedReferralsTable,
+			Columns: []string{user.ReceivedReferralsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(userbehavior.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(referral.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2990,15 +3684,15 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.BehaviorsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ReceivedReferralsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.BehaviorsTable,
-			Columns: []string{user.BehaviorsColumn},
+			Table:   user.ReceivedReferralsTable,
+			Columns: []string{user.ReceivedReferralsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(userbehavior.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(referral.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -3006,28 +3700,28 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.MarketReportsCleared() {
+	if _u.mutation.ExperimentAssignmentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.MarketReportsTable,
-			Columns: []string{user.MarketReportsColumn},
+			Table:   user.ExperimentAssignmentsTable,
+			Columns: []string{user.ExperimentAssignmentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(marketreport.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(experimentassignment.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedMarketReportsIDs(); len(nodes) > 0 && !_u.mutation.MarketReportsCleared() {
+	if nodes := _u.mutation.RemovedExperimentAssignmentsIDs(); len(nodes) > 0 && !_u.mutation.ExperimentAssignmentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.MarketReportsTable,
-			Columns: []string{user.MarketReportsColumn},
+			Table:   user.ExperimentAssignmentsTable,
+			Columns: []string{user.ExperimentAssignmentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(marketreport.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(experimentassignment.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -3035,15 +3729,15 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.MarketReportsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ExperimentAssignmentsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.MarketReportsTable,
-			Columns: []string{user.MarketReportsColumn},
+			Table:   user.ExperimentAssignmentsTable,
+			Columns: []string{user.ExperimentAssignmentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(marketreport.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(experimentassignment.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -3051,28 +3745,57 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.EmailCampaignsCleared() {
+	if _u.mutation.AffiliateCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.AffiliateTable,
+			Columns: []string{user.AffiliateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(affiliate.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AffiliateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.AffiliateTable,
+			Columns: []string{user.AffiliateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(affiliate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AffiliateConversionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.EmailCampaignsTable,
-			Columns: []string{user.EmailCampaignsColumn},
+			Table:   user.AffiliateConversionsTable,
+			Columns: []string{user.AffiliateConversionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(emailcampaign.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(affiliateconversion.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedEmailCampaignsIDs(); len(nodes) > 0 && !_u.mutation.EmailCampaignsCleared() {
+	if nodes := _u.mutation.RemovedAffiliateConversionsIDs(); len(nodes) > 0 && !_u.mutation.AffiliateConversionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.EmailCampaignsTable,
-			Columns: []string{user.EmailCampaignsColumn},
+			Table:   user.AffiliateConversionsTable,
+			Columns: []string{user.AffiliateConversionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(emailcampaign.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(affiliateconversion.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -3080,15 +3803,15 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.EmailCampaignsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.AffiliateConversionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.EmailCampaignsTable,
-			Columns: []string{user.EmailCampaignsColumn},
+			Table:   user.AffiliateConversionsTable,
+			Columns: []string{user.AffiliateConversionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(emailcampaign.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(affiliateconversion.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -3096,28 +3819,28 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.CrmIntegrationsCleared() {
+	if _u.mutation.SmsCampaignsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.CrmIntegrationsTable,
-			Columns: []string{user.CrmIntegrationsColumn},
+			Table:   user.SmsCampaignsTable,
+			Columns: []string{user.SmsCampaignsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(crmintegration.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(smscampaign.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedCrmIntegrationsIDs(); len(nodes) > 0 && !_u.mutation.CrmIntegrationsCleared() {
+	if nodes := _u.mutation.RemovedSmsCampaignsIDs(); len(nodes) > 0 && !_u.mutation.SmsCampaignsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.CrmIntegrationsTable,
-			Columns: []string{user.CrmIntegrationsColumn},
+			Table:   user.SmsCampaignsTable,
+			Columns: []string{user.SmsCampaignsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(crmintegration.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(smscampaign.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -3125,15 +3848,15 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.CrmIntegrationsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.SmsCampaignsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.CrmIntegrationsTable,
-			Columns: []string{user.CrmIntegrationsColumn},
+			Table:   user.SmsCampaignsTable,
+			Columns: []string{user.SmsCampaignsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(crmintegration.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(smscampaign.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -3141,1784 +3864,3185 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if _u.mutation.CallLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CallLogsTable,
+			Columns: []string{user.CallLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(calllog.FieldID, field.TypeInt),
+			},
 		}
-		return 0, err
-	}
-	_u.mutation.done = true
-	return _node, nil
-}
-
-// UserUpdateOne is the builder for updating a single User entity.
-type UserUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserMutation
-}
-
-// SetEmail sets the "email" field.
-func (_u *UserUpdateOne) SetEmail(v string) *UserUpdateOne {
-	_u.mutation.SetEmail(v)
-	return _u
-}
-
-// SetNillableEmail sets the "email" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableEmail(v *string) *UserUpdateOne {
-	if v != nil {
-		_u.SetEmail(*v)
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetPasswordHash sets the "password_hash" field.
-func (_u *UserUpdateOne) SetPasswordHash(v string) *UserUpdateOne {
-	_u.mutation.SetPasswordHash(v)
-	return _u
-}
-
-// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillablePasswordHash(v *string) *UserUpdateOne {
-	if v != nil {
-		_u.SetPasswordHash(*v)
+	if nodes := _u.mutation.RemovedCallLogsIDs(); len(nodes) > 0 && !_u.mutation.CallLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CallLogsTable,
+			Columns: []string{user.CallLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(calllog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetName sets the "name" field.
-func (_u *UserUpdateOne) SetName(v string) *UserUpdateOne {
-	_u.mutation.SetName(v)
-	return _u
-}
-
-// SetNillableName sets the "name" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableName(v *string) *UserUpdateOne {
-	if v != nil {
-		_u.SetName(*v)
+	if nodes := _u.mutation.CallLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CallLogsTable,
+			Columns: []string{user.CallLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(calllog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u
-}
-
-// SetSubscriptionTier sets the "subscription_tier" field.
-func (_u *UserUpdateOne) SetSubscriptionTier(v user.SubscriptionTier) *UserUpdateOne {
-	_u.mutation.SetSubscriptionTier(v)
-	return _u
-}
-
-// SetNillableSubscriptionTier sets the "subscription_tier" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableSubscriptionTier(v *user.SubscriptionTier) *UserUpdateOne {
-	if v != nil {
-		_u.SetSubscriptionTier(*v)
+	if _u.mutation.CompetitorProfilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CompetitorProfilesTable,
+			Columns: []string{user.CompetitorProfilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(competitorprofile.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetRole sets the "role" field.
-func (_u *UserUpdateOne) SetRole(v user.Role) *UserUpdateOne {
-	_u.mutation.SetRole(v)
-	return _u
-}
-
-// SetNillableRole sets the "role" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableRole(v *user.Role) *UserUpdateOne {
-	if v != nil {
-		_u.SetRole(*v)
+	if nodes := _u.mutation.RemovedCompetitorProfilesIDs(); len(nodes) > 0 && !_u.mutation.CompetitorProfilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CompetitorProfilesTable,
+			Columns: []string{user.CompetitorProfilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(competitorprofile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetUsageCount sets the "usage_count" field.
-func (_u *UserUpdateOne) SetUsageCount(v int) *UserUpdateOne {
-	_u.mutation.ResetUsageCount()
-	_u.mutation.SetUsageCount(v)
-	return _u
-}
-
-// SetNillableUsageCount sets the "usage_count" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableUsageCount(v *int) *UserUpdateOne {
-	if v != nil {
-		_u.SetUsageCount(*v)
+	if nodes := _u.mutation.CompetitorProfilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CompetitorProfilesTable,
+			Columns: []string{user.CompetitorProfilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(competitorprofile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u
-}
-
-// AddUsageCount adds value to the "usage_count" field.
-func (_u *UserUpdateOne) AddUsageCount(v int) *UserUpdateOne {
-	_u.mutation.AddUsageCount(v)
-	return _u
-}
-
-// SetUsageLimit sets the "usage_limit" field.
-func (_u *UserUpdateOne) SetUsageLimit(v int) *UserUpdateOne {
-	_u.mutation.ResetUsageLimit()
-	_u.mutation.SetUsageLimit(v)
-	return _u
-}
-
-// SetNillableUsageLimit sets the "usage_limit" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableUsageLimit(v *int) *UserUpdateOne {
-	if v != nil {
-		_u.SetUsageLimit(*v)
+	if _u.mutation.LeadRecommendationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LeadRecommendationsTable,
+			Columns: []string{user.LeadRecommendationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadrecommendation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// AddUsageLimit adds value to the "usage_limit" field.
-func (_u *UserUpdateOne) AddUsageLimit(v int) *UserUpdateOne {
-	_u.mutation.AddUsageLimit(v)
-	return _u
-}
-
-// SetLastResetAt sets the "last_reset_at" field.
-func (_u *UserUpdateOne) SetLastResetAt(v time.Time) *UserUpdateOne {
-	_u.mutation.SetLastResetAt(v)
-	return _u
-}
-
-// SetNillableLastResetAt sets the "last_reset_at" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableLastResetAt(v *time.Time) *UserUpdateOne {
-	if v != nil {
-		_u.SetLastResetAt(*v)
+	if nodes := _u.mutation.RemovedLeadRecommendationsIDs(); len(nodes) > 0 && !_u.mutation.LeadRecommendationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LeadRecommendationsTable,
+			Columns: []string{user.LeadRecommendationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadrecommendation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetLastLoginAt sets the "last_login_at" field.
-func (_u *UserUpdateOne) SetLastLoginAt(v time.Time) *UserUpdateOne {
-	_u.mutation.SetLastLoginAt(v)
-	return _u
-}
-
-// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableLastLoginAt(v *time.Time) *UserUpdateOne {
-	if v != nil {
-		_u.SetLastLoginAt(*v)
-	}
-	return _u
-}
-
-// ClearLastLoginAt clears the value of the "last_login_at" field.
-func (_u *UserUpdateOne) ClearLastLoginAt() *UserUpdateOne {
-	_u.mutation.ClearLastLoginAt()
-	return _u
-}
-
-// SetEmailVerified sets the "email_verified" field.
-func (_u *UserUpdateOne) SetEmailVerified(v bool) *UserUpdateOne {
-	_u.mutation.SetEmailVerified(v)
-	return _u
-}
-
-// SetNillableEmailVerified sets the "email_verified" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableEmailVerified(v *bool) *UserUpdateOne {
-	if v != nil {
-		_u.SetEmailVerified(*v)
+	if nodes := _u.mutation.LeadRecommendationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LeadRecommendationsTable,
+			Columns: []string{user.LeadRecommendationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadrecommendation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u
-}
-
-// SetEmailVerificationToken sets the "email_verification_token" field.
-func (_u *UserUpdateOne) SetEmailVerificationToken(v string) *UserUpdateOne {
-	_u.mutation.SetEmailVerificationToken(v)
-	return _u
-}
-
-// SetNillableEmailVerificationToken sets the "email_verification_token" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableEmailVerificationToken(v *string) *UserUpdateOne {
-	if v != nil {
-		_u.SetEmailVerificationToken(*v)
+	if _u.mutation.BehaviorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BehaviorsTable,
+			Columns: []string{user.BehaviorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userbehavior.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// ClearEmailVerificationToken clears the value of the "email_verification_token" field.
-func (_u *UserUpdateOne) ClearEmailVerificationToken() *UserUpdateOne {
-	_u.mutation.ClearEmailVerificationToken()
-	return _u
-}
-
-// SetEmailVerificationTokenExpiresAt sets the "email_verification_token_expires_at" field.
-func (_u *UserUpdateOne) SetEmailVerificationTokenExpiresAt(v time.Time) *UserUpdateOne {
-	_u.mutation.SetEmailVerificationTokenExpiresAt(v)
-	return _u
-}
-
-// SetNillableEmailVerificationTokenExpiresAt sets the "email_verification_token_expires_at" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableEmailVerificationTokenExpiresAt(v *time.Time) *UserUpdateOne {
-	if v != nil {
-		_u.SetEmailVerificationTokenExpiresAt(*v)
+	if nodes := _u.mutation.RemovedBehaviorsIDs(); len(nodes) > 0 && !_u.mutation.BehaviorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BehaviorsTable,
+			Columns: []string{user.BehaviorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userbehavior.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// ClearEmailVerificationTokenExpiresAt clears the value of the "email_verification_token_expires_at" field.
-func (_u *UserUpdateOne) ClearEmailVerificationTokenExpiresAt() *UserUpdateOne {
-	_u.mutation.ClearEmailVerificationTokenExpiresAt()
-	return _u
-}
-
-// SetEmailVerifiedAt sets the "email_verified_at" field.
-func (_u *UserUpdateOne) SetEmailVerifiedAt(v time.Time) *UserUpdateOne {
-	_u.mutation.SetEmailVerifiedAt(v)
-	return _u
-}
-
-// SetNillableEmailVerifiedAt sets the "email_verified_at" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableEmailVerifiedAt(v *time.Time) *UserUpdateOne {
-	if v != nil {
-		_u.SetEmailVerifiedAt(*v)
+	if nodes := _u.mutation.BehaviorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BehaviorsTable,
+			Columns: []string{user.BehaviorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userbehavior.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u
-}
-
-// ClearEmailVerifiedAt clears the value of the "email_verified_at" field.
-func (_u *UserUpdateOne) ClearEmailVerifiedAt() *UserUpdateOne {
-	_u.mutation.ClearEmailVerifiedAt()
-	return _u
-}
-
-// SetAcceptedTermsAt sets the "accepted_terms_at" field.
-func (_u *UserUpdateOne) SetAcceptedTermsAt(v time.Time) *UserUpdateOne {
-	_u.mutation.SetAcceptedTermsAt(v)
-	return _u
-}
-
-// SetNillableAcceptedTermsAt sets the "accepted_terms_at" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableAcceptedTermsAt(v *time.Time) *UserUpdateOne {
-	if v != nil {
-		_u.SetAcceptedTermsAt(*v)
+	if _u.mutation.MarketReportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MarketReportsTable,
+			Columns: []string{user.MarketReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(marketreport.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// ClearAcceptedTermsAt clears the value of the "accepted_terms_at" field.
-func (_u *UserUpdateOne) ClearAcceptedTermsAt() *UserUpdateOne {
-	_u.mutation.ClearAcceptedTermsAt()
-	return _u
-}
-
-// SetOnboardingCompleted sets the "onboarding_completed" field.
-func (_u *UserUpdateOne) SetOnboardingCompleted(v bool) *UserUpdateOne {
-	_u.mutation.SetOnboardingCompleted(v)
-	return _u
-}
-
-// SetNillableOnboardingCompleted sets the "onboarding_completed" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableOnboardingCompleted(v *bool) *UserUpdateOne {
-	if v != nil {
-		_u.SetOnboardingCompleted(*v)
+	if nodes := _u.mutation.RemovedMarketReportsIDs(); len(nodes) > 0 && !_u.mutation.MarketReportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MarketReportsTable,
+			Columns: []string{user.MarketReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(marketreport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetTotpEnabled sets the "totp_enabled" field.
-func (_u *UserUpdateOne) SetTotpEnabled(v bool) *UserUpdateOne {
-	_u.mutation.SetTotpEnabled(v)
-	return _u
-}
-
-// SetNillableTotpEnabled sets the "totp_enabled" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableTotpEnabled(v *bool) *UserUpdateOne {
-	if v != nil {
-		_u.SetTotpEnabled(*v)
+	if nodes := _u.mutation.MarketReportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MarketReportsTable,
+			Columns: []string{user.MarketReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(marketreport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u
-}
-
-// SetTotpSecret sets the "totp_secret" field.
-func (_u *UserUpdateOne) SetTotpSecret(v string) *UserUpdateOne {
-	_u.mutation.SetTotpSecret(v)
-	return _u
-}
-
-// SetNillableTotpSecret sets the "totp_secret" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableTotpSecret(v *string) *UserUpdateOne {
-	if v != nil {
-		_u.SetTotpSecret(*v)
+	if _u.mutation.EmailCampaignsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailCampaignsTable,
+			Columns: []string{user.EmailCampaignsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailcampaign.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// ClearTotpSecret clears the value of the "totp_secret" field.
-func (_u *UserUpdateOne) ClearTotpSecret() *UserUpdateOne {
-	_u.mutation.ClearTotpSecret()
-	return _u
-}
+	if nodes := _u.mutation.RemovedEmailCampaignsIDs(); len(nodes) > 0 && !_u.mutation.EmailCampaignsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailCampaignsTable,
+			Columns: []string{user.EmailCampaignsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailcampaign.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EmailCampaignsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailCampaignsTable,
+			Columns: []string{user.EmailCampaignsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailcampaign.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CrmIntegrationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CrmIntegrationsTable,
+			Columns: []string{user.CrmIntegrationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(crmintegration.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCrmIntegrationsIDs(); len(nodes) > 0 && !_u.mutation.CrmIntegrationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CrmIntegrationsTable,
+			Columns: []string{user.CrmIntegrationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(crmintegration.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CrmIntegrationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CrmIntegrationsTable,
+			Columns: []string{user.CrmIntegrationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(crmintegration.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CrmPushJobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CrmPushJobsTable,
+			Columns: []string{user.CrmPushJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(crmpushjob.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCrmPushJobsIDs(); len(nodes) > 0 && !_u.mutation.CrmPushJobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CrmPushJobsTable,
+			Columns: []string{user.CrmPushJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(crmpushjob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CrmPushJobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CrmPushJobsTable,
+			Columns: []string{user.CrmPushJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(crmpushjob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.IntegrationConnectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IntegrationConnectionsTable,
+			Columns: []string{user.IntegrationConnectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(integrationconnection.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedIntegrationConnectionsIDs(); len(nodes) > 0 && !_u.mutation.IntegrationConnectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IntegrationConnectionsTable,
+			Columns: []string{user.IntegrationConnectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(integrationconnection.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.IntegrationConnectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IntegrationConnectionsTable,
+			Columns: []string{user.IntegrationConnectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(integrationconnection.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{user.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
 
-// SetOauthProvider sets the "oauth_provider" field.
-func (_u *UserUpdateOne) SetOauthProvider(v string) *UserUpdateOne {
-	_u.mutation.SetOauthProvider(v)
+// UserUpdateOne is the builder for updating a single User entity.
+type UserUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *UserMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetEmail sets the "email" field.
+func (_u *UserUpdateOne) SetEmail(v string) *UserUpdateOne {
+	_u.mutation.SetEmail(v)
 	return _u
 }
 
-// SetNillableOauthProvider sets the "oauth_provider" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableOauthProvider(v *string) *UserUpdateOne {
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableEmail(v *string) *UserUpdateOne {
 	if v != nil {
-		_u.SetOauthProvider(*v)
+		_u.SetEmail(*v)
 	}
 	return _u
 }
 
-// ClearOauthProvider clears the value of the "oauth_provider" field.
-func (_u *UserUpdateOne) ClearOauthProvider() *UserUpdateOne {
-	_u.mutation.ClearOauthProvider()
+// SetPasswordHash sets the "password_hash" field.
+func (_u *UserUpdateOne) SetPasswordHash(v string) *UserUpdateOne {
+	_u.mutation.SetPasswordHash(v)
 	return _u
 }
 
-// SetOauthID sets the "oauth_id" field.
-func (_u *UserUpdateOne) SetOauthID(v string) *UserUpdateOne {
-	_u.mutation.SetOauthID(v)
+// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillablePasswordHash(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetPasswordHash(*v)
+	}
 	return _u
 }
 
-// SetNillableOauthID sets the "oauth_id" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableOauthID(v *string) *UserUpdateOne {
-	if v != nil {
-		_u.SetOauthID(*v)
-	}
+// SetName sets the "name" field.
+func (_u *UserUpdateOne) SetName(v string) *UserUpdateOne {
+	_u.mutation.SetName(v)
 	return _u
 }
 
-// ClearOauthID clears the value of the "oauth_id" field.
-func (_u *UserUpdateOne) ClearOauthID() *UserUpdateOne {
-	_u.mutation.ClearOauthID()
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableName(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
 	return _u
 }
 
-// SetStripeCustomerID sets the "stripe_customer_id" field.
-func (_u *UserUpdateOne) SetStripeCustomerID(v string) *UserUpdateOne {
-	_u.mutation.SetStripeCustomerID(v)
+// SetSubscriptionTier sets the "subscription_tier" field.
+func (_u *UserUpdateOne) SetSubscriptionTier(v user.SubscriptionTier) *UserUpdateOne {
+	_u.mutation.SetSubscriptionTier(v)
 	return _u
 }
 
-// SetNillableStripeCustomerID sets the "stripe_customer_id" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableStripeCustomerID(v *string) *UserUpdateOne {
+// SetNillableSubscriptionTier sets the "subscription_tier" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableSubscriptionTier(v *user.SubscriptionTier) *UserUpdateOne {
 	if v != nil {
-		_u.SetStripeCustomerID(*v)
+		_u.SetSubscriptionTier(*v)
 	}
 	return _u
 }
 
-// ClearStripeCustomerID clears the value of the "stripe_customer_id" field.
-func (_u *UserUpdateOne) ClearStripeCustomerID() *UserUpdateOne {
-	_u.mutation.ClearStripeCustomerID()
+// SetRole sets the "role" field.
+func (_u *UserUpdateOne) SetRole(v user.Role) *UserUpdateOne {
+	_u.mutation.SetRole(v)
 	return _u
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *UserUpdateOne) SetUpdatedAt(v time.Time) *UserUpdateOne {
-	_u.mutation.SetUpdatedAt(v)
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableRole(v *user.Role) *UserUpdateOne {
+	if v != nil {
+		_u.SetRole(*v)
+	}
 	return _u
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (_u *UserUpdateOne) SetDeletedAt(v time.Time) *UserUpdateOne {
-	_u.mutation.SetDeletedAt(v)
+// SetUsageCount sets the "usage_count" field.
+func (_u *UserUpdateOne) SetUsageCount(v int) *UserUpdateOne {
+	_u.mutation.ResetUsageCount()
+	_u.mutation.SetUsageCount(v)
 	return _u
 }
 
-// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableDeletedAt(v *time.Time) *UserUpdateOne {
+// SetNillableUsageCount sets the "usage_count" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableUsageCount(v *int) *UserUpdateOne {
 	if v != nil {
-		_u.SetDeletedAt(*v)
+		_u.SetUsageCount(*v)
 	}
 	return _u
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (_u *UserUpdateOne) ClearDeletedAt() *UserUpdateOne {
-	_u.mutation.ClearDeletedAt()
+// AddUsageCount adds value to the "usage_count" field.
+func (_u *UserUpdateOne) AddUsageCount(v int) *UserUpdateOne {
+	_u.mutation.AddUsageCount(v)
 	return _u
 }
 
-// SetOnboardingStep sets the "onboarding_step" field.
-func (_u *UserUpdateOne) SetOnboardingStep(v int) *UserUpdateOne {
-	_u.mutation.ResetOnboardingStep()
-	_u.mutation.SetOnboardingStep(v)
+// SetUsageLimit sets the "usage_limit" field.
+func (_u *UserUpdateOne) SetUsageLimit(v int) *UserUpdateOne {
+	_u.mutation.ResetUsageLimit()
+	_u.mutation.SetUsageLimit(v)
 	return _u
 }
 
-// SetNillableOnboardingStep sets the "onboarding_step" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableOnboardingStep(v *int) *UserUpdateOne {
+// SetNillableUsageLimit sets the "usage_limit" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableUsageLimit(v *int) *UserUpdateOne {
 	if v != nil {
-		_u.SetOnboardingStep(*v)
+		_u.SetUsageLimit(*v)
 	}
 	return _u
 }
 
-// AddOnboardingStep adds value to the "onboarding_step" field.
-func (_u *UserUpdateOne) AddOnboardingStep(v int) *UserUpdateOne {
-	_u.mutation.AddOnboardingStep(v)
+// AddUsageLimit adds value to the "usage_limit" field.
+func (_u *UserUpdateOne) AddUsageLimit(v int) *UserUpdateOne {
+	_u.mutation.AddUsageLimit(v)
 	return _u
 }
 
-// AddSubscriptionIDs adds the "subscriptions" edge to the Subscription entity by IDs.
-func (_u *UserUpdateOne) AddSubscriptionIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.AddSubscriptionIDs(ids...)
+// SetLastResetAt sets the "last_reset_at" field.
+func (_u *UserUpdateOne) SetLastResetAt(v time.Time) *UserUpdateOne {
+	_u.mutation.SetLastResetAt(v)
 	return _u
 }
 
-// AddSubscriptions adds the "subscriptions" edges to the Subscription entity.
-func (_u *UserUpdateOne) AddSubscriptions(v ...*Subscription) *UserUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetNillableLastResetAt sets the "last_reset_at" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableLastResetAt(v *time.Time) *UserUpdateOne {
+	if v != nil {
+		_u.SetLastResetAt(*v)
 	}
-	return _u.AddSubscriptionIDs(ids...)
+	return _u
 }
 
-// AddExportIDs adds the "exports" edge to the Export entity by IDs.
-func (_u *UserUpdateOne) AddExportIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.AddExportIDs(ids...)
+// SetLastLoginAt sets the "last_login_at" field.
+func (_u *UserUpdateOne) SetLastLoginAt(v time.Time) *UserUpdateOne {
+	_u.mutation.SetLastLoginAt(v)
 	return _u
 }
 
-// AddExports adds the "exports" edges to the Export entity.
-func (_u *UserUpdateOne) AddExports(v ...*Export) *UserUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableLastLoginAt(v *time.Time) *UserUpdateOne {
+	if v != nil {
+		_u.SetLastLoginAt(*v)
 	}
-	return _u.AddExportIDs(ids...)
-}
-
-// AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
-func (_u *UserUpdateOne) AddAPIKeyIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.AddAPIKeyIDs(ids...)
 	return _u
 }
 
-// AddAPIKeys adds the "api_keys" edges to the APIKey entity.
-func (_u *UserUpdateOne) AddAPIKeys(v ...*APIKey) *UserUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
-	}
-	return _u.AddAPIKeyIDs(ids...)
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (_u *UserUpdateOne) ClearLastLoginAt() *UserUpdateOne {
+	_u.mutation.ClearLastLoginAt()
+	return _u
 }
 
-// AddAuditLogIDs adds the "audit_logs" edge to the AuditLog entity by IDs.
-func (_u *UserUpdateOne) AddAuditLogIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.AddAuditLogIDs(ids...)
+// SetEmailVerified sets the "email_verified" field.
+func (_u *UserUpdateOne) SetEmailVerified(v bool) *UserUpdateOne {
+	_u.mutation.SetEmailVerified(v)
 	return _u
 }
 
-// AddAuditLogs adds the "audit_logs" edges to the AuditLog entity.
-func (_u *UserUpdateOne) AddAuditLogs(v ...*AuditLog) *UserUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetNillableEmailVerified sets the "email_verified" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableEmailVerified(v *bool) *UserUpdateOne {
+	if v != nil {
+		_u.SetEmailVerified(*v)
 	}
-	return _u.AddAuditLogIDs(ids...)
+	return _u
 }
 
-// AddUsageLogIDs adds the "usage_logs" edge to the UsageLog entity by IDs.
-func (_u *UserUpdateOne) AddUsageLogIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.AddUsageLogIDs(ids...)
+// SetEmailVerificationToken sets the "email_verification_token" field.
+func (_u *UserUpdateOne) SetEmailVerificationToken(v string) *UserUpdateOne {
+	_u.mutation.SetEmailVerificationToken(v)
 	return _u
 }
 
-// AddUsageLogs adds the "usage_logs" edges to the UsageLog entity.
-func (_u *UserUpdateOne) AddUsageLogs(v ...*UsageLog) *UserUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetNillableEmailVerificationToken sets the "email_verification_token" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableEmailVerificationToken(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetEmailVerificationToken(*v)
 	}
-	return _u.AddUsageLogIDs(ids...)
+	return _u
 }
 
-// AddOwnedOrganizationIDs adds the "owned_organizations" edge to the Organization entity by IDs.
-func (_u *UserUpdateOne) AddOwnedOrganizationIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.AddOwnedOrganizationIDs(ids...)
+// ClearEmailVerificationToken clears the value of the "email_verification_token" field.
+func (_u *UserUpdateOne) ClearEmailVerificationToken() *UserUpdateOne {
+	_u.mutation.ClearEmailVerificationToken()
 	return _u
 }
 
-// AddOwnedOrganizations adds the "owned_organizations" edges to the Organization entity.
-func (_u *UserUpdateOne) AddOwnedOrganizations(v ...*Organization) *UserUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
-	}
-	return _u.AddOwnedOrganizationIDs(ids...)
+// SetEmailVerificationTokenExpiresAt sets the "email_verification_token_expires_at" field.
+func (_u *UserUpdateOne) SetEmailVerificationTokenExpiresAt(v time.Time) *UserUpdateOne {
+	_u.mutation.SetEmailVerificationTokenExpiresAt(v)
+	return _u
 }
 
-// AddOrganizationMembershipIDs adds the "organization_memberships" edge to the OrganizationMember entity by IDs.
-func (_u *UserUpdateOne) AddOrganizationMembershipIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.AddOrganizationMembershipIDs(ids...)
+// SetNillableEmailVerificationTokenExpiresAt sets the "email_verification_token_expires_at" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableEmailVerificationTokenExpiresAt(v *time.Time) *UserUpdateOne {
+	if v != nil {
+		_u.SetEmailVerificationTokenExpiresAt(*v)
+	}
 	return _u
 }
 
-// AddOrganizationMemberships adds the "organization_memberships" edges to the OrganizationMember entity.
-func (_u *UserUpdateOne) AddOrganizationMemberships(v ...*OrganizationMember) *UserUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
-	}
-	return _u.AddOrganizationMembershipIDs(ids...)
+// ClearEmailVerificationTokenExpiresAt clears the value of the "email_verification_token_expires_at" field.
+func (_u *UserUpdateOne) ClearEmailVerificationTokenExpiresAt() *UserUpdateOne {
+	_u.mutation.ClearEmailVerificationTokenExpiresAt()
+	return _u
 }
 
-// AddSavedSearchIDs adds the "saved_searches" edge to the SavedSearch entity by IDs.
-func (_u *UserUpdateOne) AddSavedSearchIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.AddSavedSearchIDs(ids...)
+// SetEmailVerifiedAt sets the "email_verified_at" field.
+func (_u *UserUpdateOne) SetEmailVerifiedAt(v time.Time) *UserUpdateOne {
+	_u.mutation.SetEmailVerifiedAt(v)
 	return _u
 }
 
-// AddSavedSearches adds the "saved_searches" edges to the SavedSearch entity.
-func (_u *UserUpdateOne) AddSavedSearches(v ...*SavedSearch) *UserUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetNillableEmailVerifiedAt sets the "email_verified_at" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableEmailVerifiedAt(v *time.Time) *UserUpdateOne {
+	if v != nil {
+		_u.SetEmailVerifiedAt(*v)
 	}
-	return _u.AddSavedSearchIDs(ids...)
+	return _u
 }
 
-// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
-func (_u *UserUpdateOne) AddWebhookIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.AddWebhookIDs(ids...)
+// ClearEmailVerifiedAt clears the value of the "email_verified_at" field.
+func (_u *UserUpdateOne) ClearEmailVerifiedAt() *UserUpdateOne {
+	_u.mutation.ClearEmailVerifiedAt()
 	return _u
 }
 
-// AddWebhooks adds the "webhooks" edges to the Webhook entity.
-func (_u *UserUpdateOne) AddWebhooks(v ...*Webhook) *UserUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
-	}
-	return _u.AddWebhookIDs(ids...)
+// SetAcceptedTermsAt sets the "accepted_terms_at" field.
+func (_u *UserUpdateOne) SetAcceptedTermsAt(v time.Time) *UserUpdateOne {
+	_u.mutation.SetAcceptedTermsAt(v)
+	return _u
 }
 
-// AddLeadNoteIDs adds the "lead_notes" edge to the LeadNote entity by IDs.
-func (_u *UserUpdateOne) AddLeadNoteIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.AddLeadNoteIDs(ids...)
+// SetNillableAcceptedTermsAt sets the "accepted_terms_at" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableAcceptedTermsAt(v *time.Time) *UserUpdateOne {
+	if v != nil {
+		_u.SetAcceptedTermsAt(*v)
+	}
 	return _u
 }
 
-// AddLeadNotes adds the "lead_notes" edges to the LeadNote entity.
-func (_u *UserUpdateOne) AddLeadNotes(v ...*LeadNote) *UserUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
-	}
-	return _u.AddLeadNoteIDs(ids...)
+// ClearAcceptedTermsAt clears the value of the "accepted_terms_at" field.
+func (_u *UserUpdateOne) ClearAcceptedTermsAt() *UserUpdateOne {
+	_u.mutation.ClearAcceptedTermsAt()
+	return _u
 }
 
-// AddLeadStatusChangeIDs adds the "lead_status_changes" edge to the LeadStatusHistory entity by IDs.
-func (_u *UserUpdateOne) AddLeadStatusChangeIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.AddLeadStatusChangeIDs(ids...)
+// SetOnboardingCompleted sets the "onboarding_completed" field.
+func (_u *UserUpdateOne) SetOnboardingCompleted(v bool) *UserUpdateOne {
+	_u.mutation.SetOnboardingCompleted(v)
 	return _u
 }
 
-// AddLeadStatusChanges adds the "lead_status_changes" edges to the LeadStatusHistory entity.
-func (_u *UserUpdateOne) AddLeadStatusChanges(v ...*LeadStatusHistory) *UserUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetNillableOnboardingCompleted sets the "onboarding_completed" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableOnboardingCompleted(v *bool) *UserUpdateOne {
+	if v != nil {
+		_u.SetOnboardingCompleted(*v)
 	}
-	return _u.AddLeadStatusChangeIDs(ids...)
+	return _u
 }
 
-// AddAssignedLeadIDs adds the "assigned_leads" edge to the LeadAssignment entity by IDs.
-func (_u *UserUpdateOne) AddAssignedLeadIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.AddAssignedLeadIDs(ids...)
+// SetTotpEnabled sets the "totp_enabled" field.
+func (_u *UserUpdateOne) SetTotpEnabled(v bool) *UserUpdateOne {
+	_u.mutation.SetTotpEnabled(v)
 	return _u
 }
 
-// AddAssignedLeads adds the "assigned_leads" edges to the LeadAssignment entity.
-func (_u *UserUpdateOne) AddAssignedLeads(v ...*LeadAssignment) *UserUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetNillableTotpEnabled sets the "totp_enabled" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableTotpEnabled(v *bool) *UserUpdateOne {
+	if v != nil {
+		_u.SetTotpEnabled(*v)
 	}
-	return _u.AddAssignedLeadIDs(ids...)
+	return _u
 }
 
-// AddLeadAssignmentsMadeIDs adds the "lead_assignments_made" edge to the LeadAssignment entity by IDs.
-func (_u *UserUpdateOne) AddLeadAssignmentsMadeIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.AddLeadAssignmentsMadeIDs(ids...)
+// SetTotpSecret sets the "totp_secret" field.
+func (_u *UserUpdateOne) SetTotpSecret(v string) *UserUpdateOne {
+	_u.mutation.SetTotpSecret(v)
 	return _u
 }
 
-// AddLeadAssignmentsMade adds the "lead_assignments_made" edges to the LeadAssignment entity.
-func (_u *UserUpdateOne) AddLeadAssignmentsMade(v ...*LeadAssignment) *UserUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetNillableTotpSecret sets the "totp_secret" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableTotpSecret(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetTotpSecret(*v)
 	}
-	return _u.AddLeadAssignmentsMadeIDs(ids...)
-}
-
-// AddEmailSequencesCreatedIDs adds the "email_sequences_created" edge to the EmailSequence entity by IDs.
-func (_u *UserUpdateOne) AddEmailSequencesCreatedIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.AddEmailSequencesCreatedIDs(ids...)
 	return _u
 }
 
-// AddEmailSequencesCreated adds the "email_sequences_created" edges to the EmailSequence entity.
-func (_u *UserUpdateOne) AddEmailSequencesCreated(v ...*EmailSequence) *UserUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
-	}
-	return _u.AddEmailSequencesCreatedIDs(ids...)
+// ClearTotpSecret clears the value of the "totp_secret" field.
+func (_u *UserUpdateOne) ClearTotpSecret() *UserUpdateOne {
+	_u.mutation.ClearTotpSecret()
+	return _u
 }
 
-// AddEmailSequenceEnrollmentsMadeIDs adds the "email_sequence_enrollments_made" edge to the EmailSequenceEnrollment entity by IDs.
-func (_u *UserUpdateOne) AddEmailSequenceEnrollmentsMadeIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.AddEmailSequenceEnrollmentsMadeIDs(ids...)
+// SetOauthProvider sets the "oauth_provider" field.
+func (_u *UserUpdateOne) SetOauthProvider(v string) *UserUpdateOne {
+	_u.mutation.SetOauthProvider(v)
 	return _u
 }
 
-// AddEmailSequenceEnrollmentsMade adds the "email_sequence_enrollments_made" edges to the EmailSequenceEnrollment entity.
-func (_u *UserUpdateOne) AddEmailSequenceEnrollmentsMade(v ...*EmailSequenceEnrollment) *UserUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetNillableOauthProvider sets the "oauth_provider" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableOauthProvider(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetOauthProvider(*v)
 	}
-	return _u.AddEmailSequenceEnrollmentsMadeIDs(ids...)
+	return _u
 }
 
-// AddTerritoriesCreatedIDs adds the "territories_created" edge to the Territory entity by IDs.
-func (_u *UserUpdateOne) AddTerritoriesCreatedIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.AddTerritoriesCreatedIDs(ids...)
+// ClearOauthProvider clears the value of the "oauth_provider" field.
+func (_u *UserUpdateOne) ClearOauthProvider() *UserUpdateOne {
+	_u.mutation.ClearOauthProvider()
 	return _u
 }
 
-// AddTerritoriesCreated adds the "territories_created" edges to the Territory entity.
-func (_u *UserUpdateOne) AddTerritoriesCreated(v ...*Territory) *UserUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
-	}
-	return _u.AddTerritoriesCreatedIDs(ids...)
+// SetOauthID sets the "oauth_id" field.
+func (_u *UserUpdateOne) SetOauthID(v string) *UserUpdateOne {
+	_u.mutation.SetOauthID(v)
+	return _u
 }
 
-// AddTerritoryMembershipIDs adds the "territory_memberships" edge to the TerritoryMember entity by IDs.
-func (_u *UserUpdateOne) AddTerritoryMembershipIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.AddTerritoryMembershipIDs(ids...)
+// SetNillableOauthID sets the "oauth_id" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableOauthID(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetOauthID(*v)
+	}
 	return _u
 }
 
-// AddTerritoryMemberships adds the "territory_memberships" edges to the TerritoryMember entity.
-func (_u *UserUpdateOne) AddTerritoryMemberships(v ...*TerritoryMember) *UserUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
-	}
-	return _u.AddTerritoryMembershipIDs(ids...)
+// ClearOauthID clears the value of the "oauth_id" field.
+func (_u *UserUpdateOne) ClearOauthID() *UserUpdateOne {
+	_u.mutation.ClearOauthID()
+	return _u
 }
 
-// AddTerritoryMembersAddedIDs adds the "territory_members_added" edge to the TerritoryMember entity by IDs.
-func (_u *UserUpdateOne) AddTerritoryMembersAddedIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.AddTerritoryMembersAddedIDs(ids...)
+// SetStripeCustomerID sets the "stripe_customer_id" field.
+func (_u *UserUpdateOne) SetStripeCustomerID(v string) *UserUpdateOne {
+	_u.mutation.SetStripeCustomerID(v)
 	return _u
 }
 
-// AddTerritoryMembersAdded adds the "territory_members_added" edges to the TerritoryMember entity.
-func (_u *UserUpdateOne) AddTerritoryMembersAdded(v ...*TerritoryMember) *UserUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetNillableStripeCustomerID sets the "stripe_customer_id" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableStripeCustomerID(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetStripeCustomerID(*v)
 	}
-	return _u.AddTerritoryMembersAddedIDs(ids...)
+	return _u
 }
 
-// AddSentReferralIDs adds the "sent_referrals" edge to the Referral entity by IDs.
-func (_u *UserUpdateOne) AddSentReferralIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.AddSentReferralIDs(ids...)
+// ClearStripeCustomerID clears the value of the "stripe_customer_id" field.
+func (_u *UserUpdateOne) ClearStripeCustomerID() *UserUpdateOne {
+	_u.mutation.ClearStripeCustomerID()
 	return _u
 }
 
-// AddSentReferrals adds the "sent_referrals" edges to the Referral entity.
-func (_u *UserUpdateOne) AddSentReferrals(v ...*Referral) *UserUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
-	}
-	return _u.AddSentReferralIDs(ids...)
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UserUpdateOne) SetUpdatedAt(v time.Time) *UserUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
 }
 
-// AddReceivedReferralIDs adds the "received_referrals" edge to the Referral entity by IDs.
-func (_u *UserUpdateOne) AddReceivedReferralIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.AddReceivedReferralIDs(ids...)
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *UserUpdateOne) SetDeletedAt(v time.Time) *UserUpdateOne {
+	_u.mutation.SetDeletedAt(v)
 	return _u
 }
 
-// AddReceivedReferrals adds the "received_referrals" edges to the Referral entity.
-func (_u *UserUpdateOne) AddReceivedReferrals(v ...*Referral) *UserUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableDeletedAt(v *time.Time) *UserUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
 	}
-	return _u.AddReceivedReferralIDs(ids...)
-}
-
-// AddExperimentAssignmentIDs adds the "experiment_assignments" edge to the ExperimentAssignment entity by IDs.
-func (_u *UserUpdateOne) AddExperimentAssignmentIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.AddExperimentAssignmentIDs(ids...)
 	return _u
 }
 
-// AddExperimentAssignments adds the "experiment_assignments" edges to the ExperimentAssignment entity.
-func (_u *UserUpdateOne) AddExperimentAssignments(v ...*ExperimentAssignment) *UserUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
-	}
-	return _u.AddExperimentAssignmentIDs(ids...)
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *UserUpdateOne) ClearDeletedAt() *UserUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
 }
 
-// SetAffiliateID sets the "affiliate" edge to the Affiliate entity by ID.
-func (_u *UserUpdateOne) SetAffiliateID(id int) *UserUpdateOne {
-	_u.mutation.SetAffiliateID(id)
+// SetOnboardingStep sets the "onboarding_step" field.
+func (_u *UserUpdateOne) SetOnboardingStep(v int) *UserUpdateOne {
+	_u.mutation.ResetOnboardingStep()
+	_u.mutation.SetOnboardingStep(v)
 	return _u
 }
 
-// SetNillableAffiliateID sets the "affiliate" edge to the Affiliate entity by ID if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableAffiliateID(id *int) *UserUpdateOne {
-	if id != nil {
-		_u = _u.SetAffiliateID(*id)
+// SetNillableOnboardingStep sets the "onboarding_step" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableOnboardingStep(v *int) *UserUpdateOne {
+	if v != nil {
+		_u.SetOnboardingStep(*v)
 	}
 	return _u
 }
 
-// SetAffiliate sets the "affiliate" edge to the Affiliate entity.
-func (_u *UserUpdateOne) SetAffiliate(v *Affiliate) *UserUpdateOne {
-	return _u.SetAffiliateID(v.ID)
+// AddOnboardingStep adds value to the "onboarding_step" field.
+func (_u *UserUpdateOne) AddOnboardingStep(v int) *UserUpdateOne {
+	_u.mutation.AddOnboardingStep(v)
+	return _u
 }
 
-// AddAffiliateConversionIDs adds the "affiliate_conversions" edge to the AffiliateConversion entity by IDs.
-func (_u *UserUpdateOne) AddAffiliateConversionIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.AddAffiliateConversionIDs(ids...)
+// AddSubscriptionIDs adds the "subscriptions" edge to the Subscription entity by IDs.
+func (_u *UserUpdateOne) AddSubscriptionIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddSubscriptionIDs(ids...)
 	return _u
 }
 
-// AddAffiliateConversions adds the "affiliate_conversions" edges to the AffiliateConversion entity.
-func (_u *UserUpdateOne) AddAffiliateConversions(v ...*AffiliateConversion) *UserUpdateOne {
+// AddSubscriptions adds the "subscriptions" edges to the Subscription entity.
+func (_u *UserUpdateOne) AddSubscriptions(v ...*Subscription) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddAffiliateConversionIDs(ids...)
+	return _u.AddSubscriptionIDs(ids...)
 }
 
-// AddSmsCampaignIDs adds the "sms_campaigns" edge to the SMSCampaign entity by IDs.
-func (_u *UserUpdateOne) AddSmsCampaignIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.AddSmsCampaignIDs(ids...)
+// AddExportIDs adds the "exports" edge to the Export entity by IDs.
+func (_u *UserUpdateOne) AddExportIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddExportIDs(ids...)
 	return _u
 }
 
-// AddSmsCampaigns adds the "sms_campaigns" edges to the SMSCampaign entity.
-func (_u *UserUpdateOne) AddSmsCampaigns(v ...*SMSCampaign) *UserUpdateOne {
+// AddExports adds the "exports" edges to the Export entity.
+func (_u *UserUpdateOne) AddExports(v ...*Export) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddSmsCampaignIDs(ids...)
+	return _u.AddExportIDs(ids...)
 }
 
-// AddCallLogIDs adds the "call_logs" edge to the CallLog entity by IDs.
-func (_u *UserUpdateOne) AddCallLogIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.AddCallLogIDs(ids...)
+// AddImportJobIDs adds the "import_jobs" edge to the ImportJob entity by IDs.
+func (_u *UserUpdateOne) AddImportJobIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddImportJobIDs(ids...)
 	return _u
 }
 
-// AddCallLogs adds the "call_logs" edges to the CallLog entity.
-func (_u *UserUpdateOne) AddCallLogs(v ...*CallLog) *UserUpdateOne {
+// AddImportJobs adds the "import_jobs" edges to the ImportJob entity.
+func (_u *UserUpdateOne) AddImportJobs(v ...*ImportJob) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddCallLogIDs(ids...)
+	return _u.AddImportJobIDs(ids...)
 }
 
-// AddCompetitorProfileIDs adds the "competitor_profiles" edge to the CompetitorProfile entity by IDs.
-func (_u *UserUpdateOne) AddCompetitorProfileIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.AddCompetitorProfileIDs(ids...)
+// AddLeadReindexJobIDs adds the "lead_reindex_jobs" edge to the LeadReindexJob entity by IDs.
+func (_u *UserUpdateOne) AddLeadReindexJobIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddLeadReindexJobIDs(ids...)
 	return _u
 }
 
-// AddCompetitorProfiles adds the "competitor_profiles" edges to the CompetitorProfile entity.
-func (_u *UserUpdateOne) AddCompetitorProfiles(v ...*CompetitorProfile) *UserUpdateOne {
+// AddLeadReindexJobs adds the "lead_reindex_jobs" edges to the LeadReindexJob entity.
+func (_u *UserUpdateOne) AddLeadReindexJobs(v ...*LeadReindexJob) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddCompetitorProfileIDs(ids...)
+	return _u.AddLeadReindexJobIDs(ids...)
 }
 
-// AddLeadRecommendationIDs adds the "lead_recommendations" edge to the LeadRecommendation entity by IDs.
-func (_u *UserUpdateOne) AddLeadRecommendationIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.AddLeadRecommendationIDs(ids...)
+// AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
+func (_u *UserUpdateOne) AddAPIKeyIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddAPIKeyIDs(ids...)
 	return _u
 }
 
-// AddLeadRecommendations adds the "lead_recommendations" edges to the LeadRecommendation entity.
-func (_u *UserUpdateOne) AddLeadRecommendations(v ...*LeadRecommendation) *UserUpdateOne {
+// AddAPIKeys adds the "api_keys" edges to the APIKey entity.
+func (_u *UserUpdateOne) AddAPIKeys(v ...*APIKey) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddLeadRecommendationIDs(ids...)
+	return _u.AddAPIKeyIDs(ids...)
 }
 
-// AddBehaviorIDs adds the "behaviors" edge to the UserBehavior entity by IDs.
-func (_u *UserUpdateOne) AddBehaviorIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.AddBehaviorIDs(ids...)
+// AddAuditLogIDs adds the "audit_logs" edge to the AuditLog entity by IDs.
+func (_u *UserUpdateOne) AddAuditLogIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddAuditLogIDs(ids...)
 	return _u
 }
 
-// AddBehaviors adds the "behaviors" edges to the UserBehavior entity.
-func (_u *UserUpdateOne) AddBehaviors(v ...*UserBehavior) *UserUpdateOne {
+// AddAuditLogs adds the "audit_logs" edges to the AuditLog entity.
+func (_u *UserUpdateOne) AddAuditLogs(v ...*AuditLog) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddBehaviorIDs(ids...)
+	return _u.AddAuditLogIDs(ids...)
 }
 
-// AddMarketReportIDs adds the "market_reports" edge to the MarketReport entity by IDs.
-func (_u *UserUpdateOne) AddMarketReportIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.AddMarketReportIDs(ids...)
+// AddUsageLogIDs adds the "usage_logs" edge to the UsageLog entity by IDs.
+func (_u *UserUpdateOne) AddUsageLogIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddUsageLogIDs(ids...)
 	return _u
 }
 
-// AddMarketReports adds the "market_reports" edges to the MarketReport entity.
-func (_u *UserUpdateOne) AddMarketReports(v ...*MarketReport) *UserUpdateOne {
+// AddUsageLogs adds the "usage_logs" edges to the UsageLog entity.
+func (_u *UserUpdateOne) AddUsageLogs(v ...*UsageLog) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddMarketReportIDs(ids...)
+	return _u.AddUsageLogIDs(ids...)
 }
 
-// AddEmailCampaignIDs adds the "email_campaigns" edge to the EmailCampaign entity by IDs.
-func (_u *UserUpdateOne) AddEmailCampaignIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.AddEmailCampaignIDs(ids...)
+// AddUsageDailyAggregateIDs adds the "usage_daily_aggregates" edge to the UsageDailyAggregate entity by IDs.
+func (_u *UserUpdateOne) AddUsageDailyAggregateIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddUsageDailyAggregateIDs(ids...)
 	return _u
 }
 
-// AddEmailCampaigns adds the "email_campaigns" edges to the EmailCampaign entity.
-func (_u *UserUpdateOne) AddEmailCampaigns(v ...*EmailCampaign) *UserUpdateOne {
+// AddUsageDailyAggregates adds the "usage_daily_aggregates" edges to the UsageDailyAggregate entity.
+func (_u *UserUpdateOne) AddUsageDailyAggregates(v ...*UsageDailyAggregate) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddEmailCampaignIDs(ids...)
+	return _u.AddUsageDailyAggregateIDs(ids...)
 }
 
-// AddCrmIntegrationIDs adds the "crm_integrations" edge to the CRMIntegration entity by IDs.
-func (_u *UserUpdateOne) AddCrmIntegrationIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.AddCrmIntegrationIDs(ids...)
+// AddOwnedOrganizationIDs adds the "owned_organizations" edge to the Organization entity by IDs.
+func (_u *UserUpdateOne) AddOwnedOrganizationIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddOwnedOrganizationIDs(ids...)
 	return _u
 }
 
-// AddCrmIntegrations adds the "crm_integrations" edges to the CRMIntegration entity.
-func (_u *UserUpdateOne) AddCrmIntegrations(v ...*CRMIntegration) *UserUpdateOne {
+// AddOwnedOrganizations adds the "owned_organizations" edges to the Organization entity.
+func (_u *UserUpdateOne) AddOwnedOrganizations(v ...*Organization) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddCrmIntegrationIDs(ids...)
+	return _u.AddOwnedOrganizationIDs(ids...)
 }
 
-// Mutation returns the UserMutation object of the builder.
-func (_u *UserUpdateOne) Mutation() *UserMutation {
-	return _u.mutation
+// AddOrganizationMembershipIDs adds the "organization_memberships" edge to the OrganizationMember entity by IDs.
+func (_u *UserUpdateOne) AddOrganizationMembershipIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddOrganizationMembershipIDs(ids...)
+	return _u
 }
 
-// ClearSubscriptions clears all "subscriptions" edges to the Subscription entity.
-func (_u *UserUpdateOne) ClearSubscriptions() *UserUpdateOne {
-	_u.mutation.ClearSubscriptions()
-	return _u
+// AddOrganizationMemberships adds the "organization_memberships" edges to the OrganizationMember entity.
+func (_u *UserUpdateOne) AddOrganizationMemberships(v ...*OrganizationMember) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOrganizationMembershipIDs(ids...)
 }
 
-// RemoveSubscriptionIDs removes the "subscriptions" edge to Subscription entities by IDs.
-func (_u *UserUpdateOne) RemoveSubscriptionIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveSubscriptionIDs(ids...)
+// AddSavedSearchIDs adds the "saved_searches" edge to the SavedSearch entity by IDs.
+func (_u *UserUpdateOne) AddSavedSearchIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddSavedSearchIDs(ids...)
 	return _u
 }
 
-// RemoveSubscriptions removes "subscriptions" edges to Subscription entities.
-func (_u *UserUpdateOne) RemoveSubscriptions(v ...*Subscription) *UserUpdateOne {
+// AddSavedSearches adds the "saved_searches" edges to the SavedSearch entity.
+func (_u *UserUpdateOne) AddSavedSearches(v ...*SavedSearch) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveSubscriptionIDs(ids...)
+	return _u.AddSavedSearchIDs(ids...)
 }
 
-// ClearExports clears all "exports" edges to the Export entity.
-func (_u *UserUpdateOne) ClearExports() *UserUpdateOne {
-	_u.mutation.ClearExports()
+// AddExportTemplateIDs adds the "export_templates" edge to the ExportTemplate entity by IDs.
+func (_u *UserUpdateOne) AddExportTemplateIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddExportTemplateIDs(ids...)
 	return _u
 }
 
-// RemoveExportIDs removes the "exports" edge to Export entities by IDs.
-func (_u *UserUpdateOne) RemoveExportIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveExportIDs(ids...)
-	return _u
-}
-
-// RemoveExports removes "exports" edges to Export entities.
-func (_u *UserUpdateOne) RemoveExports(v ...*Export) *UserUpdateOne {
+// AddExportTemplates adds the "export_templates" edges to the ExportTemplate entity.
+func (_u *UserUpdateOne) AddExportTemplates(v ...*ExportTemplate) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveExportIDs(ids...)
-}
-
-// ClearAPIKeys clears all "api_keys" edges to the APIKey entity.
-func (_u *UserUpdateOne) ClearAPIKeys() *UserUpdateOne {
-	_u.mutation.ClearAPIKeys()
-	return _u
+	return _u.AddExportTemplateIDs(ids...)
 }
 
-// RemoveAPIKeyIDs removes the "api_keys" edge to APIKey entities by IDs.
-func (_u *UserUpdateOne) RemoveAPIKeyIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveAPIKeyIDs(ids...)
+// AddScheduledExportIDs adds the "scheduled_exports" edge to the ScheduledExport entity by IDs.
+func (_u *UserUpdateOne) AddScheduledExportIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddScheduledExportIDs(ids...)
 	return _u
 }
 
-// RemoveAPIKeys removes "api_keys" edges to APIKey entities.
-func (_u *UserUpdateOne) RemoveAPIKeys(v ...*APIKey) *UserUpdateOne {
+// AddScheduledExports adds the "scheduled_exports" edges to the ScheduledExport entity.
+func (_u *UserUpdateOne) AddScheduledExports(v ...*ScheduledExport) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveAPIKeyIDs(ids...)
-}
-
-// ClearAuditLogs clears all "audit_logs" edges to the AuditLog entity.
-func (_u *UserUpdateOne) ClearAuditLogs() *UserUpdateOne {
-	_u.mutation.ClearAuditLogs()
-	return _u
+	return _u.AddScheduledExportIDs(ids...)
 }
 
-// RemoveAuditLogIDs removes the "audit_logs" edge to AuditLog entities by IDs.
-func (_u *UserUpdateOne) RemoveAuditLogIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveAuditLogIDs(ids...)
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (_u *UserUpdateOne) AddWebhookIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddWebhookIDs(ids...)
 	return _u
 }
 
-// RemoveAuditLogs removes "audit_logs" edges to AuditLog entities.
-func (_u *UserUpdateOne) RemoveAuditLogs(v ...*AuditLog) *UserUpdateOne {
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (_u *UserUpdateOne) AddWebhooks(v ...*Webhook) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveAuditLogIDs(ids...)
-}
-
-// ClearUsageLogs clears all "usage_logs" edges to the UsageLog entity.
-func (_u *UserUpdateOne) ClearUsageLogs() *UserUpdateOne {
-	_u.mutation.ClearUsageLogs()
-	return _u
+	return _u.AddWebhookIDs(ids...)
 }
 
-// RemoveUsageLogIDs removes the "usage_logs" edge to UsageLog entities by IDs.
-func (_u *UserUpdateOne) RemoveUsageLogIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveUsageLogIDs(ids...)
+// AddLeadNoteIDs adds the "lead_notes" edge to the LeadNote entity by IDs.
+func (_u *UserUpdateOne) AddLeadNoteIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddLeadNoteIDs(ids...)
 	return _u
 }
 
-// RemoveUsageLogs removes "usage_logs" edges to UsageLog entities.
-func (_u *UserUpdateOne) RemoveUsageLogs(v ...*UsageLog) *UserUpdateOne {
+// AddLeadNotes adds the "lead_notes" edges to the LeadNote entity.
+func (_u *UserUpdateOne) AddLeadNotes(v ...*LeadNote) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveUsageLogIDs(ids...)
-}
-
-// ClearOwnedOrganizations clears all "owned_organizations" edges to the Organization entity.
-func (_u *UserUpdateOne) ClearOwnedOrganizations() *UserUpdateOne {
-	_u.mutation.ClearOwnedOrganizations()
-	return _u
+	return _u.AddLeadNoteIDs(ids...)
 }
 
-// RemoveOwnedOrganizationIDs removes the "owned_organizations" edge to Organization entities by IDs.
-func (_u *UserUpdateOne) RemoveOwnedOrganizationIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveOwnedOrganizationIDs(ids...)
+// AddLeadSuppressionIDs adds the "lead_suppressions" edge to the LeadSuppression entity by IDs.
+func (_u *UserUpdateOne) AddLeadSuppressionIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddLeadSuppressionIDs(ids...)
 	return _u
 }
 
-// RemoveOwnedOrganizations removes "owned_organizations" edges to Organization entities.
-func (_u *UserUpdateOne) RemoveOwnedOrganizations(v ...*Organization) *UserUpdateOne {
+// AddLeadSuppressions adds the "lead_suppressions" edges to the LeadSuppression entity.
+func (_u *UserUpdateOne) AddLeadSuppressions(v ...*LeadSuppression) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveOwnedOrganizationIDs(ids...)
-}
-
-// ClearOrganizationMemberships clears all "organization_memberships" edges to the OrganizationMember entity.
-func (_u *UserUpdateOne) ClearOrganizationMemberships() *UserUpdateOne {
-	_u.mutation.ClearOrganizationMemberships()
-	return _u
+	return _u.AddLeadSuppressionIDs(ids...)
 }
 
-// RemoveOrganizationMembershipIDs removes the "organization_memberships" edge to OrganizationMember entities by IDs.
-func (_u *UserUpdateOne) RemoveOrganizationMembershipIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveOrganizationMembershipIDs(ids...)
+// AddContactAttemptIDs adds the "contact_attempts" edge to the ContactAttempt entity by IDs.
+func (_u *UserUpdateOne) AddContactAttemptIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddContactAttemptIDs(ids...)
 	return _u
 }
 
-// RemoveOrganizationMemberships removes "organization_memberships" edges to OrganizationMember entities.
-func (_u *UserUpdateOne) RemoveOrganizationMemberships(v ...*OrganizationMember) *UserUpdateOne {
+// AddContactAttempts adds the "contact_attempts" edges to the ContactAttempt entity.
+func (_u *UserUpdateOne) AddContactAttempts(v ...*ContactAttempt) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveOrganizationMembershipIDs(ids...)
-}
-
-// ClearSavedSearches clears all "saved_searches" edges to the SavedSearch entity.
-func (_u *UserUpdateOne) ClearSavedSearches() *UserUpdateOne {
-	_u.mutation.ClearSavedSearches()
-	return _u
+	return _u.AddContactAttemptIDs(ids...)
 }
 
-// RemoveSavedSearchIDs removes the "saved_searches" edge to SavedSearch entities by IDs.
-func (_u *UserUpdateOne) RemoveSavedSearchIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveSavedSearchIDs(ids...)
+// AddNotificationPreferenceIDs adds the "notification_preferences" edge to the UserNotificationPreference entity by IDs.
+func (_u *UserUpdateOne) AddNotificationPreferenceIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddNotificationPreferenceIDs(ids...)
 	return _u
 }
 
-// RemoveSavedSearches removes "saved_searches" edges to SavedSearch entities.
-func (_u *UserUpdateOne) RemoveSavedSearches(v ...*SavedSearch) *UserUpdateOne {
+// AddNotificationPreferences adds the "notification_preferences" edges to the UserNotificationPreference entity.
+func (_u *UserUpdateOne) AddNotificationPreferences(v ...*UserNotificationPreference) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveSavedSearchIDs(ids...)
-}
-
-// ClearWebhooks clears all "webhooks" edges to the Webhook entity.
-func (_u *UserUpdateOne) ClearWebhooks() *UserUpdateOne {
-	_u.mutation.ClearWebhooks()
-	return _u
+	return _u.AddNotificationPreferenceIDs(ids...)
 }
 
-// RemoveWebhookIDs removes the "webhooks" edge to Webhook entities by IDs.
-func (_u *UserUpdateOne) RemoveWebhookIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveWebhookIDs(ids...)
+// AddLeadStatusChangeIDs adds the "lead_status_changes" edge to the LeadStatusHistory entity by IDs.
+func (_u *UserUpdateOne) AddLeadStatusChangeIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddLeadStatusChangeIDs(ids...)
 	return _u
 }
 
-// RemoveWebhooks removes "webhooks" edges to Webhook entities.
-func (_u *UserUpdateOne) RemoveWebhooks(v ...*Webhook) *UserUpdateOne {
+// AddLeadStatusChanges adds the "lead_status_changes" edges to the LeadStatusHistory entity.
+func (_u *UserUpdateOne) AddLeadStatusChanges(v ...*LeadStatusHistory) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveWebhookIDs(ids...)
-}
-
-// ClearLeadNotes clears all "lead_notes" edges to the LeadNote entity.
-func (_u *UserUpdateOne) ClearLeadNotes() *UserUpdateOne {
-	_u.mutation.ClearLeadNotes()
-	return _u
+	return _u.AddLeadStatusChangeIDs(ids...)
 }
 
-// RemoveLeadNoteIDs removes the "lead_notes" edge to LeadNote entities by IDs.
-func (_u *UserUpdateOne) RemoveLeadNoteIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveLeadNoteIDs(ids...)
+// AddLeadChangeIDs adds the "lead_changes" edge to the LeadChange entity by IDs.
+func (_u *UserUpdateOne) AddLeadChangeIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddLeadChangeIDs(ids...)
 	return _u
 }
 
-// RemoveLeadNotes removes "lead_notes" edges to LeadNote entities.
-func (_u *UserUpdateOne) RemoveLeadNotes(v ...*LeadNote) *UserUpdateOne {
+// AddLeadChanges adds the "lead_changes" edges to the LeadChange entity.
+func (_u *UserUpdateOne) AddLeadChanges(v ...*LeadChange) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveLeadNoteIDs(ids...)
-}
-
-// ClearLeadStatusChanges clears all "lead_status_changes" edges to the LeadStatusHistory entity.
-func (_u *UserUpdateOne) ClearLeadStatusChanges() *UserUpdateOne {
-	_u.mutation.ClearLeadStatusChanges()
-	return _u
+	return _u.AddLeadChangeIDs(ids...)
 }
 
-// RemoveLeadStatusChangeIDs removes the "lead_status_changes" edge to LeadStatusHistory entities by IDs.
-func (_u *UserUpdateOne) RemoveLeadStatusChangeIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveLeadStatusChangeIDs(ids...)
+// AddEnrichmentAttemptIDs adds the "enrichment_attempts" edge to the EnrichmentAttempt entity by IDs.
+func (_u *UserUpdateOne) AddEnrichmentAttemptIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddEnrichmentAttemptIDs(ids...)
 	return _u
 }
 
-// RemoveLeadStatusChanges removes "lead_status_changes" edges to LeadStatusHistory entities.
-func (_u *UserUpdateOne) RemoveLeadStatusChanges(v ...*LeadStatusHistory) *UserUpdateOne {
+// AddEnrichmentAttempts adds the "enrichment_attempts" edges to the EnrichmentAttempt entity.
+func (_u *UserUpdateOne) AddEnrichmentAttempts(v ...*EnrichmentAttempt) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveLeadStatusChangeIDs(ids...)
+	return _u.AddEnrichmentAttemptIDs(ids...)
 }
 
-// ClearAssignedLeads clears all "assigned_leads" edges to the LeadAssignment entity.
-func (_u *UserUpdateOne) ClearAssignedLeads() *UserUpdateOne {
-	_u.mutation.ClearAssignedLeads()
+// SetEnrichmentBudgetID sets the "enrichment_budget" edge to the EnrichmentBudget entity by ID.
+func (_u *UserUpdateOne) SetEnrichmentBudgetID(id int) *UserUpdateOne {
+	_u.mutation.SetEnrichmentBudgetID(id)
 	return _u
 }
 
-// RemoveAssignedLeadIDs removes the "assigned_leads" edge to LeadAssignment entities by IDs.
-func (_u *UserUpdateOne) RemoveAssignedLeadIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveAssignedLeadIDs(ids...)
+// SetNillableEnrichmentBudgetID sets the "enrichment_budget" edge to the EnrichmentBudget entity by ID if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableEnrichmentBudgetID(id *int) *UserUpdateOne {
+	if id != nil {
+		_u = _u.SetEnrichmentBudgetID(*id)
+	}
 	return _u
 }
 
-// RemoveAssignedLeads removes "assigned_leads" edges to LeadAssignment entities.
-func (_u *UserUpdateOne) RemoveAssignedLeads(v ...*LeadAssignment) *UserUpdateOne {
+// SetEnrichmentBudget sets the "enrichment_budget" edge to the EnrichmentBudget entity.
+func (_u *UserUpdateOne) SetEnrichmentBudget(v *EnrichmentBudget) *UserUpdateOne {
+	return _u.SetEnrichmentBudgetID(v.ID)
+}
+
+// AddLeadVerificationIDs adds the "lead_verifications" edge to the LeadVerification entity by IDs.
+func (_u *UserUpdateOne) AddLeadVerificationIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddLeadVerificationIDs(ids...)
+	return _u
+}
+
+// AddLeadVerifications adds the "lead_verifications" edges to the LeadVerification entity.
+func (_u *UserUpdateOne) AddLeadVerifications(v ...*LeadVerification) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveAssignedLeadIDs(ids...)
-}
-
-// ClearLeadAssignmentsMade clears all "lead_assignments_made" edges to the LeadAssignment entity.
-func (_u *UserUpdateOne) ClearLeadAssignmentsMade() *UserUpdateOne {
-	_u.mutation.ClearLeadAssignmentsMade()
-	return _u
+	return _u.AddLeadVerificationIDs(ids...)
 }
 
-// RemoveLeadAssignmentsMadeIDs removes the "lead_assignments_made" edge to LeadAssignment entities by IDs.
-func (_u *UserUpdateOne) RemoveLeadAssignmentsMadeIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveLeadAssignmentsMadeIDs(ids...)
+// AddAssignedLeadIDs adds the "assigned_leads" edge to the LeadAssignment entity by IDs.
+func (_u *UserUpdateOne) AddAssignedLeadIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddAssignedLeadIDs(ids...)
 	return _u
 }
 
-// RemoveLeadAssignmentsMade removes "lead_assignments_made" edges to LeadAssignment entities.
-func (_u *UserUpdateOne) RemoveLeadAssignmentsMade(v ...*LeadAssignment) *UserUpdateOne {
+// AddAssignedLeads adds the "assigned_leads" edges to the LeadAssignment entity.
+func (_u *UserUpdateOne) AddAssignedLeads(v ...*LeadAssignment) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveLeadAssignmentsMadeIDs(ids...)
-}
-
-// ClearEmailSequencesCreated clears all "email_sequences_created" edges to the EmailSequence entity.
-func (_u *UserUpdateOne) ClearEmailSequencesCreated() *UserUpdateOne {
-	_u.mutation.ClearEmailSequencesCreated()
-	return _u
+	return _u.AddAssignedLeadIDs(ids...)
 }
 
-// RemoveEmailSequencesCreatedIDs removes the "email_sequences_created" edge to EmailSequence entities by IDs.
-func (_u *UserUpdateOne) RemoveEmailSequencesCreatedIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveEmailSequencesCreatedIDs(ids...)
+// AddLeadAssignmentsMadeIDs adds the "lead_assignments_made" edge to the LeadAssignment entity by IDs.
+func (_u *UserUpdateOne) AddLeadAssignmentsMadeIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddLeadAssignmentsMadeIDs(ids...)
 	return _u
 }
 
-// RemoveEmailSequencesCreated removes "email_sequences_created" edges to EmailSequence entities.
-func (_u *UserUpdateOne) RemoveEmailSequencesCreated(v ...*EmailSequence) *UserUpdateOne {
+// AddLeadAssignmentsMade adds the "lead_assignments_made" edges to the LeadAssignment entity.
+func (_u *UserUpdateOne) AddLeadAssignmentsMade(v ...*LeadAssignment) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveEmailSequencesCreatedIDs(ids...)
-}
-
-// ClearEmailSequenceEnrollmentsMade clears all "email_sequence_enrollments_made" edges to the EmailSequenceEnrollment entity.
-func (_u *UserUpdateOne) ClearEmailSequenceEnrollmentsMade() *UserUpdateOne {
-	_u.mutation.ClearEmailSequenceEnrollmentsMade()
-	return _u
+	return _u.AddLeadAssignmentsMadeIDs(ids...)
 }
 
-// RemoveEmailSequenceEnrollmentsMadeIDs removes the "email_sequence_enrollments_made" edge to EmailSequenceEnrollment entities by IDs.
-func (_u *UserUpdateOne) RemoveEmailSequenceEnrollmentsMadeIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveEmailSequenceEnrollmentsMadeIDs(ids...)
+// AddEmailSequencesCreatedIDs adds the "email_sequences_created" edge to the EmailSequence entity by IDs.
+func (_u *UserUpdateOne) AddEmailSequencesCreatedIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddEmailSequencesCreatedIDs(ids...)
 	return _u
 }
 
-// RemoveEmailSequenceEnrollmentsMade removes "email_sequence_enrollments_made" edges to EmailSequenceEnrollment entities.
-func (_u *UserUpdateOne) RemoveEmailSequenceEnrollmentsMade(v ...*EmailSequenceEnrollment) *UserUpdateOne {
+// AddEmailSequencesCreated adds the "email_sequences_created" edges to the EmailSequence entity.
+func (_u *UserUpdateOne) AddEmailSequencesCreated(v ...*EmailSequence) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveEmailSequenceEnrollmentsMadeIDs(ids...)
-}
-
-// ClearTerritoriesCreated clears all "territories_created" edges to the Territory entity.
-func (_u *UserUpdateOne) ClearTerritoriesCreated() *UserUpdateOne {
-	_u.mutation.ClearTerritoriesCreated()
-	return _u
+	return _u.AddEmailSequencesCreatedIDs(ids...)
 }
 
-// RemoveTerritoriesCreatedIDs removes the "territories_created" edge to Territory entities by IDs.
-func (_u *UserUpdateOne) RemoveTerritoriesCreatedIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveTerritoriesCreatedIDs(ids...)
+// AddEmailSequenceEnrollmentsMadeIDs adds the "email_sequence_enrollments_made" edge to the EmailSequenceEnrollment entity by IDs.
+func (_u *UserUpdateOne) AddEmailSequenceEnrollmentsMadeIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddEmailSequenceEnrollmentsMadeIDs(ids...)
 	return _u
 }
 
-// RemoveTerritoriesCreated removes "territories_created" edges to Territory entities.
-func (_u *UserUpdateOne) RemoveTerritoriesCreated(v ...*Territory) *UserUpdateOne {
+// AddEmailSequenceEnrollmentsMade adds the "email_sequence_enrollments_made" edges to the EmailSequenceEnrollment entity.
+func (_u *UserUpdateOne) AddEmailSequenceEnrollmentsMade(v ...*EmailSequenceEnrollment) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveTerritoriesCreatedIDs(ids...)
+	return _u.AddEmailSequenceEnrollmentsMadeIDs(ids...)
 }
 
-// ClearTerritoryMemberships clears all "territory_memberships" edges to the TerritoryMember entity.
-func (_u *UserUpdateOne) ClearTerritoryMemberships() *UserUpdateOne {
-	_u.mutation.ClearTerritoryMemberships()
+// AddTerritoriesCreatedIDs adds the "territories_created" edge to the Territory entity by IDs.
+func (_u *UserUpdateOne) AddTerritoriesCreatedIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddTerritoriesCreatedIDs(ids...)
 	return _u
 }
 
-// RemoveTerritoryMembershipIDs removes the "territory_memberships" edge to TerritoryMember entities by IDs.
-func (_u *UserUpdateOne) RemoveTerritoryMembershipIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveTerritoryMembershipIDs(ids...)
+// AddTerritoriesCreated adds the "territories_created" edges to the Territory entity.
+func (_u *UserUpdateOne) AddTerritoriesCreated(v ...*Territory) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTerritoriesCreatedIDs(ids...)
+}
+
+// AddTerritoryMembershipIDs adds the "territory_memberships" edge to the TerritoryMember entity by IDs.
+func (_u *UserUpdateOne) AddTerritoryMembershipIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddTerritoryMembershipIDs(ids...)
 	return _u
 }
 
-// RemoveTerritoryMemberships removes "territory_memberships" edges to TerritoryMember entities.
-func (_u *UserUpdateOne) RemoveTerritoryMemberships(v ...*TerritoryMember) *UserUpdateOne {
+// AddTerritoryMemberships adds the "territory_memberships" edges to the TerritoryMember entity.
+func (_u *UserUpdateOne) AddTerritoryMemberships(v ...*TerritoryMember) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveTerritoryMembershipIDs(ids...)
+	return _u.AddTerritoryMembershipIDs(ids...)
 }
 
-// ClearTerritoryMembersAdded clears all "territory_members_added" edges to the TerritoryMember entity.
-func (_u *UserUpdateOne) ClearTerritoryMembersAdded() *UserUpdateOne {
-	_u.mutation.ClearTerritoryMembersAdded()
+// AddTerritoryMembersAddedIDs adds the "territory_members_added" edge to the TerritoryMember entity by IDs.
+func (_u *UserUpdateOne) AddTerritoryMembersAddedIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddTerritoryMembersAddedIDs(ids...)
 	return _u
 }
 
-// RemoveTerritoryMembersAddedIDs removes the "territory_members_added" edge to TerritoryMember entities by IDs.
-func (_u *UserUpdateOne) RemoveTerritoryMembersAddedIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveTerritoryMembersAddedIDs(ids...)
+// AddTerritoryMembersAdded adds the "territory_members_added" edges to the TerritoryMember entity.
+func (_u *UserUpdateOne) AddTerritoryMembersAdded(v ...*TerritoryMember) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTerritoryMembersAddedIDs(ids...)
+}
+
+// AddSentReferralIDs adds the "sent_referrals" edge to the Referral entity by IDs.
+func (_u *UserUpdateOne) AddSentReferralIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddSentReferralIDs(ids...)
 	return _u
 }
 
-// RemoveTerritoryMembersAdded removes "territory_members_added" edges to TerritoryMember entities.
-func (_u *UserUpdateOne) RemoveTerritoryMembersAdded(v ...*TerritoryMember) *UserUpdateOne {
+// AddSentReferrals adds the "sent_referrals" edges to the Referral entity.
+func (_u *UserUpdateOne) AddSentReferrals(v ...*Referral) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveTerritoryMembersAddedIDs(ids...)
+	return _u.AddSentReferralIDs(ids...)
 }
 
-// ClearSentReferrals clears all "sent_referrals" edges to the Referral entity.
-func (_u *UserUpdateOne) ClearSentReferrals() *UserUpdateOne {
-	_u.mutation.ClearSentReferrals()
+// AddReceivedReferralIDs adds the "received_referrals" edge to the Referral entity by IDs.
+func (_u *UserUpdateOne) AddReceivedReferralIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddReceivedReferralIDs(ids...)
 	return _u
 }
 
-// RemoveSentReferralIDs removes the "sent_referrals" edge to Referral entities by IDs.
-func (_u *UserUpdateOne) RemoveSentReferralIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveSentReferralIDs(ids...)
+// AddReceivedReferrals adds the "received_referrals" edges to the Referral entity.
+func (_u *UserUpdateOne) AddReceivedReferrals(v ...*Referral) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReceivedReferralIDs(ids...)
+}
+
+// AddExperimentAssignmentIDs adds the "experiment_assignments" edge to the ExperimentAssignment entity by IDs.
+func (_u *UserUpdateOne) AddExperimentAssignmentIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddExperimentAssignmentIDs(ids...)
 	return _u
 }
 
-// RemoveSentReferrals removes "sent_referrals" edges to Referral entities.
-func (_u *UserUpdateOne) RemoveSentReferrals(v ...*Referral) *UserUpdateOne {
+// AddExperimentAssignments adds the "experiment_assignments" edges to the ExperimentAssignment entity.
+func (_u *UserUpdateOne) AddExperimentAssignments(v ...*ExperimentAssignment) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveSentReferralIDs(ids...)
+	return _u.AddExperimentAssignmentIDs(ids...)
 }
 
-// ClearReceivedReferrals clears all "received_referrals" edges to the Referral entity.
-func (_u *UserUpdateOne) ClearReceivedReferrals() *UserUpdateOne {
-	_u.mutation.ClearReceivedReferrals()
+// SetAffiliateID sets the "affiliate" edge to the Affiliate entity by ID.
+func (_u *UserUpdateOne) SetAffiliateID(id int) *UserUpdateOne {
+	_u.mutation.SetAffiliateID(id)
 	return _u
 }
 
-// RemoveReceivedReferralIDs removes the "received_referrals" edge to Referral entities by IDs.
-func (_u *UserUpdateOne) RemoveReceivedReferralIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveReceivedReferralIDs(ids...)
+// SetNillableAffiliateID sets the "affiliate" edge to the Affiliate entity by ID if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableAffiliateID(id *int) *UserUpdateOne {
+	if id != nil {
+		_u = _u.SetAffiliateID(*id)
+	}
 	return _u
 }
 
-// RemoveReceivedReferrals removes "received_referrals" edges to Referral entities.
-func (_u *UserUpdateOne) RemoveReceivedReferrals(v ...*Referral) *UserUpdateOne {
+// SetAffiliate sets the "affiliate" edge to the Affiliate entity.
+func (_u *UserUpdateOne) SetAffiliate(v *Affiliate) *UserUpdateOne {
+	return _u.SetAffiliateID(v.ID)
+}
+
+// AddAffiliateConversionIDs adds the "affiliate_conversions" edge to the AffiliateConversion entity by IDs.
+func (_u *UserUpdateOne) AddAffiliateConversionIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddAffiliateConversionIDs(ids...)
+	return _u
+}
+
+// AddAffiliateConversions adds the "affiliate_conversions" edges to the AffiliateConversion entity.
+func (_u *UserUpdateOne) AddAffiliateConversions(v ...*AffiliateConversion) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveReceivedReferralIDs(ids...)
+	return _u.AddAffiliateConversionIDs(ids...)
 }
 
-// ClearExperimentAssignments clears all "experiment_assignments" edges to the ExperimentAssignment entity.
-func (_u *UserUpdateOne) ClearExperimentAssignments() *UserUpdateOne {
-	_u.mutation.ClearExperimentAssignments()
+// AddSmsCampaignIDs adds the "sms_campaigns" edge to the SMSCampaign entity by IDs.
+func (_u *UserUpdateOne) AddSmsCampaignIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddSmsCampaignIDs(ids...)
 	return _u
 }
 
-// RemoveExperimentAssignmentIDs removes the "experiment_assignments" edge to ExperimentAssignment entities by IDs.
-func (_u *UserUpdateOne) RemoveExperimentAssignmentIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveExperimentAssignmentIDs(ids...)
+// AddSmsCampaigns adds the "sms_campaigns" edges to the SMSCampaign entity.
+func (_u *UserUpdateOne) AddSmsCampaigns(v ...*SMSCampaign) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSmsCampaignIDs(ids...)
+}
+
+// AddCallLogIDs adds the "call_logs" edge to the CallLog entity by IDs.
+func (_u *UserUpdateOne) AddCallLogIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddCallLogIDs(ids...)
 	return _u
 }
 
-// RemoveExperimentAssignments removes "experiment_assignments" edges to ExperimentAssignment entities.
-func (_u *UserUpdateOne) RemoveExperimentAssignments(v ...*ExperimentAssignment) *UserUpdateOne {
+// AddCallLogs adds the "call_logs" edges to the CallLog entity.
+func (_u *UserUpdateOne) AddCallLogs(v ...*CallLog) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveExperimentAssignmentIDs(ids...)
+	return _u.AddCallLogIDs(ids...)
 }
 
-// ClearAffiliate clears the "affiliate" edge to the Affiliate entity.
-func (_u *UserUpdateOne) ClearAffiliate() *UserUpdateOne {
-	_u.mutation.ClearAffiliate()
+// AddCompetitorProfileIDs adds the "competitor_profiles" edge to the CompetitorProfile entity by IDs.
+func (_u *UserUpdateOne) AddCompetitorProfileIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddCompetitorProfileIDs(ids...)
 	return _u
 }
 
-// ClearAffiliateConversions clears all "affiliate_conversions" edges to the AffiliateConversion entity.
-func (_u *UserUpdateOne) ClearAffiliateConversions() *UserUpdateOne {
-	_u.mutation.ClearAffiliateConversions()
+// AddCompetitorProfiles adds the "competitor_profiles" edges to the CompetitorProfile entity.
+func (_u *UserUpdateOne) AddCompetitorProfiles(v ...*CompetitorProfile) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCompetitorProfileIDs(ids...)
+}
+
+// AddLeadRecommendationIDs adds the "lead_recommendations" edge to the LeadRecommendation entity by IDs.
+func (_u *UserUpdateOne) AddLeadRecommendationIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddLeadRecommendationIDs(ids...)
 	return _u
 }
 
-// RemoveAffiliateConversionIDs removes the "affiliate_conversions" edge to AffiliateConversion entities by IDs.
-func (_u *UserUpdateOne) RemoveAffiliateConversionIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveAffiliateConversionIDs(ids...)
+// AddLeadRecommendations adds the "lead_recommendations" edges to the LeadRecommendation entity.
+func (_u *UserUpdateOne) AddLeadRecommendations(v ...*LeadRecommendation) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLeadRecommendationIDs(ids...)
+}
+
+// AddBehaviorIDs adds the "behaviors" edge to the UserBehavior entity by IDs.
+func (_u *UserUpdateOne) AddBehaviorIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddBehaviorIDs(ids...)
 	return _u
 }
 
-// RemoveAffiliateConversions removes "affiliate_conversions" edges to AffiliateConversion entities.
-func (_u *UserUpdateOne) RemoveAffiliateConversions(v ...*AffiliateConversion) *UserUpdateOne {
+// AddBehaviors adds the "behaviors" edges to the UserBehavior entity.
+func (_u *UserUpdateOne) AddBehaviors(v ...*UserBehavior) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveAffiliateConversionIDs(ids...)
+	return _u.AddBehaviorIDs(ids...)
 }
 
-// ClearSmsCampaigns clears all "sms_campaigns" edges to the SMSCampaign entity.
-func (_u *UserUpdateOne) ClearSmsCampaigns() *UserUpdateOne {
-	_u.mutation.ClearSmsCampaigns()
+// AddMarketReportIDs adds the "market_reports" edge to the MarketReport entity by IDs.
+func (_u *UserUpdateOne) AddMarketReportIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddMarketReportIDs(ids...)
 	return _u
 }
 
-// RemoveSmsCampaignIDs removes the "sms_campaigns" edge to SMSCampaign entities by IDs.
-func (_u *UserUpdateOne) RemoveSmsCampaignIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveSmsCampaignIDs(ids...)
+// AddMarketReports adds the "market_reports" edges to the MarketReport entity.
+func (_u *UserUpdateOne) AddMarketReports(v ...*MarketReport) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMarketReportIDs(ids...)
+}
+
+// AddEmailCampaignIDs adds the "email_campaigns" edge to the EmailCampaign entity by IDs.
+func (_u *UserUpdateOne) AddEmailCampaignIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddEmailCampaignIDs(ids...)
 	return _u
 }
 
-// RemoveSmsCampaigns removes "sms_campaigns" edges to SMSCampaign entities.
-func (_u *UserUpdateOne) RemoveSmsCampaigns(v ...*SMSCampaign) *UserUpdateOne {
+// AddEmailCampaigns adds the "email_campaigns" edges to the EmailCampaign entity.
+func (_u *UserUpdateOne) AddEmailCampaigns(v ...*EmailCampaign) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveSmsCampaignIDs(ids...)
+	return _u.AddEmailCampaignIDs(ids...)
 }
 
-// ClearCallLogs clears all "call_logs" edges to the CallLog entity.
-func (_u *UserUpdateOne) ClearCallLogs() *UserUpdateOne {
-	_u.mutation.ClearCallLogs()
+// AddCrmIntegrationIDs adds the "crm_integrations" edge to the CRMIntegration entity by IDs.
+func (_u *UserUpdateOne) AddCrmIntegrationIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddCrmIntegrationIDs(ids...)
 	return _u
 }
 
-// RemoveCallLogIDs removes the "call_logs" edge to CallLog entities by IDs.
-func (_u *UserUpdateOne) RemoveCallLogIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveCallLogIDs(ids...)
+// AddCrmIntegrations adds the "crm_integrations" edges to the CRMIntegration entity.
+func (_u *UserUpdateOne) AddCrmIntegrations(v ...*CRMIntegration) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCrmIntegrationIDs(ids...)
+}
+
+// AddCrmPushJobIDs adds the "crm_push_jobs" edge to the CRMPushJob entity by IDs.
+func (_u *UserUpdateOne) AddCrmPushJobIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddCrmPushJobIDs(ids...)
 	return _u
 }
 
-// RemoveCallLogs removes "call_logs" edges to CallLog entities.
-func (_u *UserUpdateOne) RemoveCallLogs(v ...*CallLog) *UserUpdateOne {
+// AddCrmPushJobs adds the "crm_push_jobs" edges to the CRMPushJob entity.
+func (_u *UserUpdateOne) AddCrmPushJobs(v ...*CRMPushJob) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveCallLogIDs(ids...)
+	return _u.AddCrmPushJobIDs(ids...)
 }
 
-// ClearCompetitorProfiles clears all "competitor_profiles" edges to the CompetitorProfile entity.
-func (_u *UserUpdateOne) ClearCompetitorProfiles() *UserUpdateOne {
-	_u.mutation.ClearCompetitorProfiles()
+// AddIntegrationConnectionIDs adds the "integration_connections" edge to the IntegrationConnection entity by IDs.
+func (_u *UserUpdateOne) AddIntegrationConnectionIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddIntegrationConnectionIDs(ids...)
 	return _u
 }
 
-// RemoveCompetitorProfileIDs removes the "competitor_profiles" edge to CompetitorProfile entities by IDs.
-func (_u *UserUpdateOne) RemoveCompetitorProfileIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveCompetitorProfileIDs(ids...)
+// AddIntegrationConnections adds the "integration_connections" edges to the IntegrationConnection entity.
+func (_u *UserUpdateOne) AddIntegrationConnections(v ...*IntegrationConnection) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddIntegrationConnectionIDs(ids...)
+}
+
+// Mutation returns the UserMutation object of the builder.
+func (_u *UserUpdateOne) Mutation() *UserMutation {
+	return _u.mutation
+}
+
+// ClearSubscriptions clears all "subscriptions" edges to the Subscription entity.
+func (_u *UserUpdateOne) ClearSubscriptions() *UserUpdateOne {
+	_u.mutation.ClearSubscriptions()
 	return _u
 }
 
-// RemoveCompetitorProfiles removes "competitor_profiles" edges to CompetitorProfile entities.
-func (_u *UserUpdateOne) RemoveCompetitorProfiles(v ...*CompetitorProfile) *UserUpdateOne {
+// RemoveSubscriptionIDs removes the "subscriptions" edge to Subscription entities by IDs.
+func (_u *UserUpdateOne) RemoveSubscriptionIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveSubscriptionIDs(ids...)
+	return _u
+}
+
+// RemoveSubscriptions removes "subscriptions" edges to Subscription entities.
+func (_u *UserUpdateOne) RemoveSubscriptions(v ...*Subscription) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveCompetitorProfileIDs(ids...)
+	return _u.RemoveSubscriptionIDs(ids...)
 }
 
-// ClearLeadRecommendations clears all "lead_recommendations" edges to the LeadRecommendation entity.
-func (_u *UserUpdateOne) ClearLeadRecommendations() *UserUpdateOne {
-	_u.mutation.ClearLeadRecommendations()
+// ClearExports clears all "exports" edges to the Export entity.
+func (_u *UserUpdateOne) ClearExports() *UserUpdateOne {
+	_u.mutation.ClearExports()
 	return _u
 }
 
-// RemoveLeadRecommendationIDs removes the "lead_recommendations" edge to LeadRecommendation entities by IDs.
-func (_u *UserUpdateOne) RemoveLeadRecommendationIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveLeadRecommendationIDs(ids...)
+// RemoveExportIDs removes the "exports" edge to Export entities by IDs.
+func (_u *UserUpdateOne) RemoveExportIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveExportIDs(ids...)
 	return _u
 }
 
-// RemoveLeadRecommendations removes "lead_recommendations" edges to LeadRecommendation entities.
-func (_u *UserUpdateOne) RemoveLeadRecommendations(v ...*LeadRecommendation) *UserUpdateOne {
+// RemoveExports removes "exports" edges to Export entities.
+func (_u *UserUpdateOne) RemoveExports(v ...*Export) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveLeadRecommendationIDs(ids...)
-}
-
-// ClearBehaviors clears all "behaviors" edges to the UserBehavior entity.
-func (_u *UserUpdateOne) ClearBehaviors() *UserUpdateOne {
-	_u.mutation.ClearBehaviors()
-	return _u
-}
-
-// RemoveBehaviorIDs removes the "behaviors" edge to UserBehavior entities by IDs.
-func (_u *UserUpdateOne) RemoveBehaviorIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveBehaviorIDs(ids...)
-	return _u
-}
-
-// RemoveBehaviors removes "behaviors" edges to UserBehavior entities.
-func (_u *UserUpdateOne) RemoveBehaviors(v ...*UserBehavior) *UserUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	return _u.RemoveExportIDs(ids...)
+}
+
+// ClearImportJobs clears all "import_jobs" edges to the ImportJob entity.
+func (_u *UserUpdateOne) ClearImportJobs() *UserUpdateOne {
+	_u.mutation.ClearImportJobs()
+	return _u
+}
+
+// RemoveImportJobIDs removes the "import_jobs" edge to ImportJob entities by IDs.
+func (_u *UserUpdateOne) RemoveImportJobIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveImportJobIDs(ids...)
+	return _u
+}
+
+// RemoveImportJobs removes "import_jobs" edges to ImportJob entities.
+func (_u *UserUpdateOne) RemoveImportJobs(v ...*ImportJob) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveImportJobIDs(ids...)
+}
+
+// ClearLeadReindexJobs clears all "lead_reindex_jobs" edges to the LeadReindexJob entity.
+func (_u *UserUpdateOne) ClearLeadReindexJobs() *UserUpdateOne {
+	_u.mutation.ClearLeadReindexJobs()
+	return _u
+}
+
+// RemoveLeadReindexJobIDs removes the "lead_reindex_jobs" edge to LeadReindexJob entities by IDs.
+func (_u *UserUpdateOne) RemoveLeadReindexJobIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveLeadReindexJobIDs(ids...)
+	return _u
+}
+
+// RemoveLeadReindexJobs removes "lead_reindex_jobs" edges to LeadReindexJob entities.
+func (_u *UserUpdateOne) RemoveLeadReindexJobs(v ...*LeadReindexJob) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLeadReindexJobIDs(ids...)
+}
+
+// ClearAPIKeys clears all "api_keys" edges to the APIKey entity.
+func (_u *UserUpdateOne) ClearAPIKeys() *UserUpdateOne {
+	_u.mutation.ClearAPIKeys()
+	return _u
+}
+
+// RemoveAPIKeyIDs removes the "api_keys" edge to APIKey entities by IDs.
+func (_u *UserUpdateOne) RemoveAPIKeyIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveAPIKeyIDs(ids...)
+	return _u
+}
+
+// RemoveAPIKeys removes "api_keys" edges to APIKey entities.
+func (_u *UserUpdateOne) RemoveAPIKeys(v ...*APIKey) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAPIKeyIDs(ids...)
+}
+
+// ClearAuditLogs clears all "audit_logs" edges to the AuditLog entity.
+func (_u *UserUpdateOne) ClearAuditLogs() *UserUpdateOne {
+	_u.mutation.ClearAuditLogs()
+	return _u
+}
+
+// RemoveAuditLogIDs removes the "audit_logs" edge to AuditLog entities by IDs.
+func (_u *UserUpdateOne) RemoveAuditLogIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveAuditLogIDs(ids...)
+	return _u
+}
+
+// RemoveAuditLogs removes "audit_logs" edges to AuditLog entities.
+func (_u *UserUpdateOne) RemoveAuditLogs(v ...*AuditLog) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAuditLogIDs(ids...)
+}
+
+// ClearUsageLogs clears all "usage_logs" edges to the UsageLog entity.
+func (_u *UserUpdateOne) ClearUsageLogs() *UserUpdateOne {
+	_u.mutation.ClearUsageLogs()
+	return _u
+}
+
+// RemoveUsageLogIDs removes the "usage_logs" edge to UsageLog entities by IDs.
+func (_u *UserUpdateOne) RemoveUsageLogIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveUsageLogIDs(ids...)
+	return _u
+}
+
+// RemoveUsageLogs removes "usage_logs" edges to UsageLog entities.
+func (_u *UserUpdateOne) RemoveUsageLogs(v ...*UsageLog) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUsageLogIDs(ids...)
+}
+
+// ClearUsageDailyAggregates clears all "usage_daily_aggregates" edges to the UsageDailyAggregate entity.
+func (_u *UserUpdateOne) ClearUsageDailyAggregates() *UserUpdateOne {
+	_u.mutation.ClearUsageDailyAggregates()
+	return _u
+}
+
+// RemoveUsageDailyAggregateIDs removes the "usage_daily_aggregates" edge to UsageDailyAggregate entities by IDs.
+func (_u *UserUpdateOne) RemoveUsageDailyAggregateIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveUsageDailyAggregateIDs(ids...)
+	return _u
+}
+
+// RemoveUsageDailyAggregates removes "usage_daily_aggregates" edges to UsageDailyAggregate entities.
+func (_u *UserUpdateOne) RemoveUsageDailyAggregates(v ...*UsageDailyAggregate) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUsageDailyAggregateIDs(ids...)
+}
+
+// ClearOwnedOrganizations clears all "owned_organizations" edges to the Organization entity.
+func (_u *UserUpdateOne) ClearOwnedOrganizations() *UserUpdateOne {
+	_u.mutation.ClearOwnedOrganizations()
+	return _u
+}
+
+// RemoveOwnedOrganizationIDs removes the "owned_organizations" edge to Organization entities by IDs.
+func (_u *UserUpdateOne) RemoveOwnedOrganizationIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveOwnedOrganizationIDs(ids...)
+	return _u
+}
+
+// RemoveOwnedOrganizations removes "owned_organizations" edges to Organization entities.
+func (_u *UserUpdateOne) RemoveOwnedOrganizations(v ...*Organization) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOwnedOrganizationIDs(ids...)
+}
+
+// ClearOrganizationMemberships clears all "organization_memberships" edges to the OrganizationMember entity.
+func (_u *UserUpdateOne) ClearOrganizationMemberships() *UserUpdateOne {
+	_u.mutation.ClearOrganizationMemberships()
+	return _u
+}
+
+// RemoveOrganizationMembershipIDs removes the "organization_memberships" edge to OrganizationMember entities by IDs.
+func (_u *UserUpdateOne) RemoveOrganizationMembershipIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveOrganizationMembershipIDs(ids...)
+	return _u
+}
+
+// RemoveOrganizationMemberships removes "organization_memberships" edges to OrganizationMember entities.
+func (_u *UserUpdateOne) RemoveOrganizationMemberships(v ...*OrganizationMember) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOrganizationMembershipIDs(ids...)
+}
+
+// ClearSavedSearches clears all "saved_searches" edges to the SavedSearch entity.
+func (_u *UserUpdateOne) ClearSavedSearches() *UserUpdateOne {
+	_u.mutation.ClearSavedSearches()
+	return _u
+}
+
+// RemoveSavedSearchIDs removes the "saved_searches" edge to SavedSearch entities by IDs.
+func (_u *UserUpdateOne) RemoveSavedSearchIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveSavedSearchIDs(ids...)
+	return _u
+}
+
+// RemoveSavedSearches removes "saved_searches" edges to SavedSearch entities.
+func (_u *UserUpdateOne) RemoveSavedSearches(v ...*SavedSearch) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSavedSearchIDs(ids...)
+}
+
+// ClearExportTemplates clears all "export_templates" edges to the ExportTemplate entity.
+func (_u *UserUpdateOne) ClearExportTemplates() *UserUpdateOne {
+	_u.mutation.ClearExportTemplates()
+	return _u
+}
+
+// RemoveExportTemplateIDs removes the "export_templates" edge to ExportTemplate entities by IDs.
+func (_u *UserUpdateOne) RemoveExportTemplateIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveExportTemplateIDs(ids...)
+	return _u
+}
+
+// RemoveExportTemplates removes "export_templates" edges to ExportTemplate entities.
+func (_u *UserUpdateOne) RemoveExportTemplates(v ...*ExportTemplate) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveExportTemplateIDs(ids...)
+}
+
+// ClearScheduledExports clears all "scheduled_exports" edges to the ScheduledExport entity.
+func (_u *UserUpdateOne) ClearScheduledExports() *UserUpdateOne {
+	_u.mutation.ClearScheduledExports()
+	return _u
+}
+
+// RemoveScheduledExportIDs removes the "scheduled_exports" edge to ScheduledExport entities by IDs.
+func (_u *UserUpdateOne) RemoveScheduledExportIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveScheduledExportIDs(ids...)
+	return _u
+}
+
+// RemoveScheduledExports removes "scheduled_exports" edges to ScheduledExport entities.
+func (_u *UserUpdateOne) RemoveScheduledExports(v ...*ScheduledExport) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveScheduledExportIDs(ids...)
+}
+
+// ClearWebhooks clears all "webhooks" edges to the Webhook entity.
+func (_u *UserUpdateOne) ClearWebhooks() *UserUpdateOne {
+	_u.mutation.ClearWebhooks()
+	return _u
+}
+
+// RemoveWebhookIDs removes the "webhooks" edge to Webhook entities by IDs.
+func (_u *UserUpdateOne) RemoveWebhookIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveWebhookIDs(ids...)
+	return _u
+}
+
+// RemoveWebhooks removes "webhooks" edges to Webhook entities.
+func (_u *UserUpdateOne) RemoveWebhooks(v ...*Webhook) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWebhookIDs(ids...)
+}
+
+// ClearLeadNotes clears all "lead_notes" edges to the LeadNote entity.
+func (_u *UserUpdateOne) ClearLeadNotes() *UserUpdateOne {
+	_u.mutation.ClearLeadNotes()
+	return _u
+}
+
+// RemoveLeadNoteIDs removes the "lead_notes" edge to LeadNote entities by IDs.
+func (_u *UserUpdateOne) RemoveLeadNoteIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveLeadNoteIDs(ids...)
+	return _u
+}
+
+// RemoveLeadNotes removes "lead_notes" edges to LeadNote entities.
+func (_u *UserUpdateOne) RemoveLeadNotes(v ...*LeadNote) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLeadNoteIDs(ids...)
+}
+
+// ClearLeadSuppressions clears all "lead_suppressions" edges to the LeadSuppression entity.
+func (_u *UserUpdateOne) ClearLeadSuppressions() *UserUpdateOne {
+	_u.mutation.ClearLeadSuppressions()
+	return _u
+}
+
+// RemoveLeadSuppressionIDs removes the "lead_suppressions" edge to LeadSuppression entities by IDs.
+func (_u *UserUpdateOne) RemoveLeadSuppressionIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveLeadSuppressionIDs(ids...)
+	return _u
+}
+
+// RemoveLeadSuppressions removes "lead_suppressions" edges to LeadSuppression entities.
+func (_u *UserUpdateOne) RemoveLeadSuppressions(v ...*LeadSuppression) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLeadSuppressionIDs(ids...)
+}
+
+// ClearContactAttempts clears all "contact_attempts" edges to the ContactAttempt entity.
+func (_u *UserUpdateOne) ClearContactAttempts() *UserUpdateOne {
+	_u.mutation.ClearContactAttempts()
+	return _u
+}
+
+// RemoveContactAttemptIDs removes the "contact_attempts" edge to ContactAttempt entities by IDs.
+func (_u *UserUpdateOne) RemoveContactAttemptIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveContactAttemptIDs(ids...)
+	return _u
+}
+
+// RemoveContactAttempts removes "contact_attempts" edges to ContactAttempt entities.
+func (_u *UserUpdateOne) RemoveContactAttempts(v ...*ContactAttempt) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveContactAttemptIDs(ids...)
+}
+
+// ClearNotificationPreferences clears all "notification_preferences" edges to the UserNotificationPreference entity.
+func (_u *UserUpdateOne) ClearNotificationPreferences() *UserUpdateOne {
+	_u.mutation.ClearNotificationPreferences()
+	return _u
+}
+
+// RemoveNotificationPreferenceIDs removes the "notification_preferences" edge to UserNotificationPreference entities by IDs.
+func (_u *UserUpdateOne) RemoveNotificationPreferenceIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveNotificationPreferenceIDs(ids...)
+	return _u
+}
+
+// RemoveNotificationPreferences removes "notification_preferences" edges to UserNotificationPreference entities.
+func (_u *UserUpdateOne) RemoveNotificationPreferences(v ...*UserNotificationPreference) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNotificationPreferenceIDs(ids...)
+}
+
+// ClearLeadStatusChanges clears all "lead_status_changes" edges to the LeadStatusHistory entity.
+func (_u *UserUpdateOne) ClearLeadStatusChanges() *UserUpdateOne {
+	_u.mutation.ClearLeadStatusChanges()
+	return _u
+}
+
+// RemoveLeadStatusChangeIDs removes the "lead_status_changes" edge to LeadStatusHistory entities by IDs.
+func (_u *UserUpdateOne) RemoveLeadStatusChangeIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveLeadStatusChangeIDs(ids...)
+	return _u
+}
+
+// RemoveLeadStatusChanges removes "lead_status_changes" edges to LeadStatusHistory entities.
+func (_u *UserUpdateOne) RemoveLeadStatusChanges(v ...*LeadStatusHistory) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLeadStatusChangeIDs(ids...)
+}
+
+// ClearLeadChanges clears all "lead_changes" edges to the LeadChange entity.
+func (_u *UserUpdateOne) ClearLeadChanges() *UserUpdateOne {
+	_u.mutation.ClearLeadChanges()
+	return _u
+}
+
+// RemoveLeadChangeIDs removes the "lead_changes" edge to LeadChange entities by IDs.
+func (_u *UserUpdateOne) RemoveLeadChangeIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveLeadChangeIDs(ids...)
+	return _u
+}
+
+// RemoveLeadChanges removes "lead_changes" edges to LeadChange entities.
+func (_u *UserUpdateOne) RemoveLeadChanges(v ...*LeadChange) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLeadChangeIDs(ids...)
+}
+
+// ClearEnrichmentAttempts clears all "enrichment_attempts" edges to the EnrichmentAttempt entity.
+func (_u *UserUpdateOne) ClearEnrichmentAttempts() *UserUpdateOne {
+	_u.mutation.ClearEnrichmentAttempts()
+	return _u
+}
+
+// RemoveEnrichmentAttemptIDs removes the "enrichment_attempts" edge to EnrichmentAttempt entities by IDs.
+func (_u *UserUpdateOne) RemoveEnrichmentAttemptIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveEnrichmentAttemptIDs(ids...)
+	return _u
+}
+
+// RemoveEnrichmentAttempts removes "enrichment_attempts" edges to EnrichmentAttempt entities.
+func (_u *UserUpdateOne) RemoveEnrichmentAttempts(v ...*EnrichmentAttempt) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEnrichmentAttemptIDs(ids...)
+}
+
+// ClearEnrichmentBudget clears the "enrichment_budget" edge to the EnrichmentBudget entity.
+func (_u *UserUpdateOne) ClearEnrichmentBudget() *UserUpdateOne {
+	_u.mutation.ClearEnrichmentBudget()
+	return _u
+}
+
+// ClearLeadVerifications clears all "lead_verifications" edges to the LeadVerification entity.
+func (_u *UserUpdateOne) ClearLeadVerifications() *UserUpdateOne {
+	_u.mutation.ClearLeadVerifications()
+	return _u
+}
+
+// RemoveLeadVerificationIDs removes the "lead_verifications" edge to LeadVerification entities by IDs.
+func (_u *UserUpdateOne) RemoveLeadVerificationIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveLeadVerificationIDs(ids...)
+	return _u
+}
+
+// RemoveLeadVerifications removes "lead_verifications" edges to LeadVerification entities.
+func (_u *UserUpdateOne) RemoveLeadVerifications(v ...*LeadVerification) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLeadVerificationIDs(ids...)
+}
+
+// ClearAssignedLeads clears all "assigned_leads" edges to the LeadAssignment entity.
+func (_u *UserUpdateOne) ClearAssignedLeads() *UserUpdateOne {
+	_u.mutation.ClearAssignedLeads()
+	return _u
+}
+
+// RemoveAssignedLeadIDs removes the "assigned_leads" edge to LeadAssignment entities by IDs.
+func (_u *UserUpdateOne) RemoveAssignedLeadIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveAssignedLeadIDs(ids...)
+	return _u
+}
+
+// RemoveAssignedLeads removes "assigned_leads" edges to LeadAssignment entities.
+func (_u *UserUpdateOne) RemoveAssignedLeads(v ...*LeadAssignment) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAssignedLeadIDs(ids...)
+}
+
+// ClearLeadAssignmentsMade clears all "lead_assignments_made" edges to the LeadAssignment entity.
+func (_u *UserUpdateOne) ClearLeadAssignmentsMade() *UserUpdateOne {
+	_u.mutation.ClearLeadAssignmentsMade()
+	return _u
+}
+
+// RemoveLeadAssignmentsMadeIDs removes the "lead_assignments_made" edge to LeadAssignment entities by IDs.
+func (_u *UserUpdateOne) RemoveLeadAssignmentsMadeIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveLeadAssignmentsMadeIDs(ids...)
+	return _u
+}
+
+// RemoveLeadAssignmentsMade removes "lead_assignments_made" edges to LeadAssignment entities.
+func (_u *UserUpdateOne) RemoveLeadAssignmentsMade(v ...*LeadAssignment) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLeadAssignmentsMadeIDs(ids...)
+}
+
+// ClearEmailSequencesCreated clears all "email_sequences_created" edges to the EmailSequence entity.
+func (_u *UserUpdateOne) ClearEmailSequencesCreated() *UserUpdateOne {
+	_u.mutation.ClearEmailSequencesCreated()
+	return _u
+}
+
+// RemoveEmailSequencesCreatedIDs removes the "email_sequences_created" edge to EmailSequence entities by IDs.
+func (_u *UserUpdateOne) RemoveEmailSequencesCreatedIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveEmailSequencesCreatedIDs(ids...)
+	return _u
+}
+
+// RemoveEmailSequencesCreated removes "email_sequences_created" edges to EmailSequence entities.
+func (_u *UserUpdateOne) RemoveEmailSequencesCreated(v ...*EmailSequence) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEmailSequencesCreatedIDs(ids...)
+}
+
+// ClearEmailSequenceEnrollmentsMade clears all "email_sequence_enrollments_made" edges to the EmailSequenceEnrollment entity.
+func (_u *UserUpdateOne) ClearEmailSequenceEnrollmentsMade() *UserUpdateOne {
+	_u.mutation.ClearEmailSequenceEnrollmentsMade()
+	return _u
+}
+
+// RemoveEmailSequenceEnrollmentsMadeIDs removes the "email_sequence_enrollments_made" edge to EmailSequenceEnrollment entities by IDs.
+func (_u *UserUpdateOne) RemoveEmailSequenceEnrollmentsMadeIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveEmailSequenceEnrollmentsMadeIDs(ids...)
+	return _u
+}
+
+// RemoveEmailSequenceEnrollmentsMade removes "email_sequence_enrollments_made" edges to EmailSequenceEnrollment entities.
+func (_u *UserUpdateOne) RemoveEmailSequenceEnrollmentsMade(v ...*EmailSequenceEnrollment) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEmailSequenceEnrollmentsMadeIDs(ids...)
+}
+
+// ClearTerritoriesCreated clears all "territories_created" edges to the Territory entity.
+func (_u *UserUpdateOne) ClearTerritoriesCreated() *UserUpdateOne {
+	_u.mutation.ClearTerritoriesCreated()
+	return _u
+}
+
+// RemoveTerritoriesCreatedIDs removes the "territories_created" edge to Territory entities by IDs.
+func (_u *UserUpdateOne) RemoveTerritoriesCreatedIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveTerritoriesCreatedIDs(ids...)
+	return _u
+}
+
+// RemoveTerritoriesCreated removes "territories_created" edges to Territory entities.
+func (_u *UserUpdateOne) RemoveTerritoriesCreated(v ...*Territory) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTerritoriesCreatedIDs(ids...)
+}
+
+// ClearTerritoryMemberships clears all "territory_memberships" edges to the TerritoryMember entity.
+func (_u *UserUpdateOne) ClearTerritoryMemberships() *UserUpdateOne {
+	_u.mutation.ClearTerritoryMemberships()
+	return _u
+}
+
+// RemoveTerritoryMembershipIDs removes the "territory_memberships" edge to TerritoryMember entities by IDs.
+func (_u *UserUpdateOne) RemoveTerritoryMembershipIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveTerritoryMembershipIDs(ids...)
+	return _u
+}
+
+// RemoveTerritoryMemberships removes "territory_memberships" edges to TerritoryMember entities.
+func (_u *UserUpdateOne) RemoveTerritoryMemberships(v ...*TerritoryMember) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTerritoryMembershipIDs(ids...)
+}
+
+// ClearTerritoryMembersAdded clears all "territory_members_added" edges to the TerritoryMember entity.
+func (_u *UserUpdateOne) ClearTerritoryMembersAdded() *UserUpdateOne {
+	_u.mutation.ClearTerritoryMembersAdded()
+	return _u
+}
+
+// RemoveTerritoryMembersAddedIDs removes the "territory_members_added" edge to TerritoryMember entities by IDs.
+func (_u *UserUpdateOne) RemoveTerritoryMembersAddedIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveTerritoryMembersAddedIDs(ids...)
+	return _u
+}
+
+// RemoveTerritoryMembersAdded removes "territory_members_added" edges to TerritoryMember entities.
+func (_u *UserUpdateOne) RemoveTerritoryMembersAdded(v ...*TerritoryMember) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTerritoryMembersAddedIDs(ids...)
+}
+
+// ClearSentReferrals clears all "sent_referrals" edges to the Referral entity.
+func (_u *UserUpdateOne) ClearSentReferrals() *UserUpdateOne {
+	_u.mutation.ClearSentReferrals()
+	return _u
+}
+
+// RemoveSentReferralIDs removes the "sent_referrals" edge to Referral entities by IDs.
+func (_u *UserUpdateOne) RemoveSentReferralIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveSentReferralIDs(ids...)
+	return _u
+}
+
+// RemoveSentReferrals removes "sent_referrals" edges to Referral entities.
+func (_u *UserUpdateOne) RemoveSentReferrals(v ...*Referral) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSentReferralIDs(ids...)
+}
+
+// ClearReceivedReferrals clears all "received_referrals" edges to the Referral entity.
+func (_u *UserUpdateOne) ClearReceivedReferrals() *UserUpdateOne {
+	_u.mutation.ClearReceivedReferrals()
+	return _u
+}
+
+// RemoveReceivedReferralIDs removes the "received_referrals" edge to Referral entities by IDs.
+func (_u *UserUpdateOne) RemoveReceivedReferralIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveReceivedReferralIDs(ids...)
+	return _u
+}
+
+// RemoveReceivedReferrals removes "received_referrals" edges to Referral entities.
+func (_u *UserUpdateOne) RemoveReceivedReferrals(v ...*Referral) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReceivedReferralIDs(ids...)
+}
+
+// ClearExperimentAssignments clears all "experiment_assignments" edges to the ExperimentAssignment entity.
+func (_u *UserUpdateOne) ClearExperimentAssignments() *UserUpdateOne {
+	_u.mutation.ClearExperimentAssignments()
+	return _u
+}
+
+// RemoveExperimentAssignmentIDs removes the "experiment_assignments" edge to ExperimentAssignment entities by IDs.
+func (_u *UserUpdateOne) RemoveExperimentAssignmentIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveExperimentAssignmentIDs(ids...)
+	return _u
+}
+
+// RemoveExperimentAssignments removes "experiment_assignments" edges to ExperimentAssignment entities.
+func (_u *UserUpdateOne) RemoveExperimentAssignments(v ...*ExperimentAssignment) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveExperimentAssignmentIDs(ids...)
+}
+
+// ClearAffiliate clears the "affiliate" edge to the Affiliate entity.
+func (_u *UserUpdateOne) ClearAffiliate() *UserUpdateOne {
+	_u.mutation.ClearAffiliate()
+	return _u
+}
+
+// ClearAffiliateConversions clears all "affiliate_conversions" edges to the AffiliateConversion entity.
+func (_u *UserUpdateOne) ClearAffiliateConversions() *UserUpdateOne {
+	_u.mutation.ClearAffiliateConversions()
+	return _u
+}
+
+// RemoveAffiliateConversionIDs removes the "affiliate_conversions" edge to AffiliateConversion entities by IDs.
+func (_u *UserUpdateOne) RemoveAffiliateConversionIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveAffiliateConversionIDs(ids...)
+	return _u
+}
+
+// RemoveAffiliateConversions removes "affiliate_conversions" edges to AffiliateConversion entities.
+func (_u *UserUpdateOne) RemoveAffiliateConversions(v ...*AffiliateConversion) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAffiliateConversionIDs(ids...)
+}
+
+// ClearSmsCampaigns clears all "sms_campaigns" edges to the SMSCampaign entity.
+func (_u *UserUpdateOne) ClearSmsCampaigns() *UserUpdateOne {
+	_u.mutation.ClearSmsCampaigns()
+	return _u
+}
+
+// RemoveSmsCampaignIDs removes the "sms_campaigns" edge to SMSCampaign entities by IDs.
+func (_u *UserUpdateOne) RemoveSmsCampaignIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveSmsCampaignIDs(ids...)
+	return _u
+}
+
+// RemoveSmsCampaigns removes "sms_campaigns" edges to SMSCampaign entities.
+func (_u *UserUpdateOne) RemoveSmsCampaigns(v ...*SMSCampaign) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSmsCampaignIDs(ids...)
+}
+
+// ClearCallLogs clears all "call_logs" edges to the CallLog entity.
+func (_u *UserUpdateOne) ClearCallLogs() *UserUpdateOne {
+	_u.mutation.ClearCallLogs()
+	return _u
+}
+
+// RemoveCallLogIDs removes the "call_logs" edge to CallLog entities by IDs.
+func (_u *UserUpdateOne) RemoveCallLogIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveCallLogIDs(ids...)
+	return _u
+}
+
+// RemoveCallLogs removes "call_logs" edges to CallLog entities.
+func (_u *UserUpdateOne) RemoveCallLogs(v ...*CallLog) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCallLogIDs(ids...)
+}
+
+// ClearCompetitorProfiles clears all "competitor_profiles" edges to the CompetitorProfile entity.
+func (_u *UserUpdateOne) ClearCompetitorProfiles() *UserUpdateOne {
+	_u.mutation.ClearCompetitorProfiles()
+	return _u
+}
+
+// RemoveCompetitorProfileIDs removes the "competitor_profiles" edge to CompetitorProfile entities by IDs.
+func (_u *UserUpdateOne) RemoveCompetitorProfileIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveCompetitorProfileIDs(ids...)
+	return _u
+}
+
+// RemoveCompetitorProfiles removes "competitor_profiles" edges to CompetitorProfile entities.
+func (_u *UserUpdateOne) RemoveCompetitorProfiles(v ...*CompetitorProfile) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCompetitorProfileIDs(ids...)
+}
+
+// ClearLeadRecommendations clears all "lead_recommendations" edges to the LeadRecommendation entity.
+func (_u *UserUpdateOne) ClearLeadRecommendations() *UserUpdateOne {
+	_u.mutation.ClearLeadRecommendations()
+	return _u
+}
+
+// RemoveLeadRecommendationIDs removes the "lead_recommendations" edge to LeadRecommendation entities by IDs.
+func (_u *UserUpdateOne) RemoveLeadRecommendationIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveLeadRecommendationIDs(ids...)
+	return _u
+}
+
+// RemoveLeadRecommendations removes "lead_recommendations" edges to LeadRecommendation entities.
+func (_u *UserUpdateOne) RemoveLeadRecommendations(v ...*LeadRecommendation) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLeadRecommendationIDs(ids...)
+}
+
+// ClearBehaviors clears all "behaviors" edges to the UserBehavior entity.
+func (_u *UserUpdateOne) ClearBehaviors() *UserUpdateOne {
+	_u.mutation.ClearBehaviors()
+	return _u
+}
+
+// RemoveBehaviorIDs removes the "behaviors" edge to UserBehavior entities by IDs.
+func (_u *UserUpdateOne) RemoveBehaviorIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveBehaviorIDs(ids...)
+	return _u
+}
+
+// RemoveBehaviors removes "behaviors" edges to UserBehavior entities.
+func (_u *UserUpdateOne) RemoveBehaviors(v ...*UserBehavior) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBehaviorIDs(ids...)
+}
+
+// ClearMarketReports clears all "market_reports" edges to the MarketReport entity.
+func (_u *UserUpdateOne) ClearMarketReports() *UserUpdateOne {
+	_u.mutation.ClearMarketReports()
+	return _u
+}
+
+// RemoveMarketReportIDs removes the "market_reports" edge to MarketReport entities by IDs.
+func (_u *UserUpdateOne) RemoveMarketReportIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveMarketReportIDs(ids...)
+	return _u
+}
+
+// RemoveMarketReports removes "market_reports" edges to MarketReport entities.
+func (_u *UserUpdateOne) RemoveMarketReports(v ...*MarketReport) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMarketReportIDs(ids...)
+}
+
+// ClearEmailCampaigns clears all "email_campaigns" edges to the EmailCampaign entity.
+func (_u *UserUpdateOne) ClearEmailCampaigns() *UserUpdateOne {
+	_u.mutation.ClearEmailCampaigns()
+	return _u
+}
+
+// RemoveEmailCampaignIDs removes the "email_campaigns" edge to EmailCampaign entities by IDs.
+func (_u *UserUpdateOne) RemoveEmailCampaignIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveEmailCampaignIDs(ids...)
+	return _u
+}
+
+// RemoveEmailCampaigns removes "email_campaigns" edges to EmailCampaign entities.
+func (_u *UserUpdateOne) RemoveEmailCampaigns(v ...*EmailCampaign) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEmailCampaignIDs(ids...)
+}
+
+// ClearCrmIntegrations clears all "crm_integrations" edges to the CRMIntegration entity.
+func (_u *UserUpdateOne) ClearCrmIntegrations() *UserUpdateOne {
+	_u.mutation.ClearCrmIntegrations()
+	return _u
+}
+
+// RemoveCrmIntegrationIDs removes the "crm_integrations" edge to CRMIntegration entities by IDs.
+func (_u *UserUpdateOne) RemoveCrmIntegrationIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveCrmIntegrationIDs(ids...)
+	return _u
+}
+
+// RemoveCrmIntegrations removes "crm_integrations" edges to CRMIntegration entities.
+func (_u *UserUpdateOne) RemoveCrmIntegrations(v ...*CRMIntegration) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCrmIntegrationIDs(ids...)
+}
+
+// ClearCrmPushJobs clears all "crm_push_jobs" edges to the CRMPushJob entity.
+func (_u *UserUpdateOne) ClearCrmPushJobs() *UserUpdateOne {
+	_u.mutation.ClearCrmPushJobs()
+	return _u
+}
+
+// RemoveCrmPushJobIDs removes the "crm_push_jobs" edge to CRMPushJob entities by IDs.
+func (_u *UserUpdateOne) RemoveCrmPushJobIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveCrmPushJobIDs(ids...)
+	return _u
+}
+
+// RemoveCrmPushJobs removes "crm_push_jobs" edges to CRMPushJob entities.
+func (_u *UserUpdateOne) RemoveCrmPushJobs(v ...*CRMPushJob) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCrmPushJobIDs(ids...)
+}
+
+// ClearIntegrationConnections clears all "integration_connections" edges to the IntegrationConnection entity.
+func (_u *UserUpdateOne) ClearIntegrationConnections() *UserUpdateOne {
+	_u.mutation.ClearIntegrationConnections()
+	return _u
+}
+
+// RemoveIntegrationConnectionIDs removes the "integration_connections" edge to IntegrationConnection entities by IDs.
+func (_u *UserUpdateOne) RemoveIntegrationConnectionIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveIntegrationConnectionIDs(ids...)
+	return _u
+}
+
+// RemoveIntegrationConnections removes "integration_connections" edges to IntegrationConnection entities.
+func (_u *UserUpdateOne) RemoveIntegrationConnections(v ...*IntegrationConnection) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveIntegrationConnectionIDs(ids...)
+}
+
+// Where appends a list predicates to the UserUpdate builder.
+func (_u *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UserUpdateOne) Select(field string, fields ...string) *UserUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated User entity.
+func (_u *UserUpdateOne) Save(ctx context.Context) (*User, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserUpdateOne) SaveX(ctx context.Context) *User {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UserUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *UserUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := user.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserUpdateOne) check() error {
+	if v, ok := _u.mutation.Email(); ok {
+		if err := user.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PasswordHash(); ok {
+		if err := user.PasswordHashValidator(v); err != nil {
+			return &ValidationError{Name: "password_hash", err: fmt.Errorf(`ent: validator failed for field "User.password_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := user.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "User.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SubscriptionTier(); ok {
+		if err := user.SubscriptionTierValidator(v); err != nil {
+			return &ValidationError{Name: "subscription_tier", err: fmt.Errorf(`ent: validator failed for field "User.subscription_tier": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Role(); ok {
+		if err := user.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UsageCount(); ok {
+		if err := user.UsageCountValidator(v); err != nil {
+			return &ValidationError{Name: "usage_count", err: fmt.Errorf(`ent: validator failed for field "User.usage_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UsageLimit(); ok {
+		if err := user.UsageLimitValidator(v); err != nil {
+			return &ValidationError{Name: "usage_limit", err: fmt.Errorf(`ent: validator failed for field "User.usage_limit": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OnboardingStep(); ok {
+		if err := user.OnboardingStepValidator(v); err != nil {
+			return &ValidationError{Name: "onboarding_step", err: fmt.Errorf(`ent: validator failed for field "User.onboarding_step": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *UserUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "User.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, user.FieldID)
+		for _, f := range fields {
+			if !user.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != user.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(user.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PasswordHash(); ok {
+		_spec.SetField(user.FieldPasswordHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(user.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SubscriptionTier(); ok {
+		_spec.SetField(user.FieldSubscriptionTier, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(user.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.UsageCount(); ok {
+		_spec.SetField(user.FieldUsageCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUsageCount(); ok {
+		_spec.AddField(user.FieldUsageCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UsageLimit(); ok {
+		_spec.SetField(user.FieldUsageLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUsageLimit(); ok {
+		_spec.AddField(user.FieldUsageLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastResetAt(); ok {
+		_spec.SetField(user.FieldLastResetAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastLoginAt(); ok {
+		_spec.SetField(user.FieldLastLoginAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastLoginAtCleared() {
+		_spec.ClearField(user.FieldLastLoginAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.EmailVerified(); ok {
+		_spec.SetField(user.FieldEmailVerified, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.EmailVerificationToken(); ok {
+		_spec.SetField(user.FieldEmailVerificationToken, field.TypeString, value)
+	}
+	if _u.mutation.EmailVerificationTokenCleared() {
+		_spec.ClearField(user.FieldEmailVerificationToken, field.TypeString)
+	}
+	if value, ok := _u.mutation.EmailVerificationTokenExpiresAt(); ok {
+		_spec.SetField(user.FieldEmailVerificationTokenExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.EmailVerificationTokenExpiresAtCleared() {
+		_spec.ClearField(user.FieldEmailVerificationTokenExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.EmailVerifiedAt(); ok {
+		_spec.SetField(user.FieldEmailVerifiedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EmailVerifiedAtCleared() {
+		_spec.ClearField(user.FieldEmailVerifiedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AcceptedTermsAt(); ok {
+		_spec.SetField(user.FieldAcceptedTermsAt, field.TypeTime, value)
+	}
+	if _u.mutation.AcceptedTermsAtCleared() {
+		_spec.ClearField(user.FieldAcceptedTermsAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.OnboardingCompleted(); ok {
+		_spec.SetField(user.FieldOnboardingCompleted, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.TotpEnabled(); ok {
+		_spec.SetField(user.FieldTotpEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.TotpSecret(); ok {
+		_spec.SetField(user.FieldTotpSecret, field.TypeString, value)
+	}
+	if _u.mutation.TotpSecretCleared() {
+		_spec.ClearField(user.FieldTotpSecret, field.TypeString)
+	}
+	if value, ok := _u.mutation.OauthProvider(); ok {
+		_spec.SetField(user.FieldOauthProvider, field.TypeString, value)
+	}
+	if _u.mutation.OauthProviderCleared() {
+		_spec.ClearField(user.FieldOauthProvider, field.TypeString)
+	}
+	if value, ok := _u.mutation.OauthID(); ok {
+		_spec.SetField(user.FieldOauthID, field.TypeString, value)
+	}
+	if _u.mutation.OauthIDCleared() {
+		_spec.ClearField(user.FieldOauthID, field.TypeString)
+	}
+	if value, ok := _u.mutation.StripeCustomerID(); ok {
+		_spec.SetField(user.FieldStripeCustomerID, field.TypeString, value)
+	}
+	if _u.mutation.StripeCustomerIDCleared() {
+		_spec.ClearField(user.FieldStripeCustomerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(user.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(user.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.OnboardingStep(); ok {
+		_spec.SetField(user.FieldOnboardingStep, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOnboardingStep(); ok {
+		_spec.AddField(user.FieldOnboardingStep, field.TypeInt, value)
+	}
+	if _u.mutation.SubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SubscriptionsTable,
+			Columns: []string{user.SubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSubscriptionsIDs(); len(nodes) > 0 && !_u.mutation.SubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SubscriptionsTable,
+			Columns: []string{user.SubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SubscriptionsTable,
+			Columns: []string{user.SubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ExportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ExportsTable,
+			Columns: []string{user.ExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(export.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedExportsIDs(); len(nodes) > 0 && !_u.mutation.ExportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ExportsTable,
+			Columns: []string{user.ExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(export.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ExportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ExportsTable,
+			Columns: []string{user.ExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(export.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ImportJobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ImportJobsTable,
+			Columns: []string{user.ImportJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importjob.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedImportJobsIDs(); len(nodes) > 0 && !_u.mutation.ImportJobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ImportJobsTable,
+			Columns: []string{user.ImportJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importjob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ImportJobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ImportJobsTable,
+			Columns: []string{user.ImportJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importjob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LeadReindexJobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LeadReindexJobsTable,
+			Columns: []string{user.LeadReindexJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadreindexjob.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLeadReindexJobsIDs(); len(nodes) > 0 && !_u.mutation.LeadReindexJobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LeadReindexJobsTable,
+			Columns: []string{user.LeadReindexJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadreindexjob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LeadReindexJobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LeadReindexJobsTable,
+			Columns: []string{user.LeadReindexJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leadreindexjob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.APIKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.APIKeysTable,
+			Columns: []string{user.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAPIKeysIDs(); len(nodes) > 0 && !_u.mutation.APIKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.APIKeysTable,
+			Columns: []string{user.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.APIKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.APIKeysTable,
+			Columns: []string{user.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AuditLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuditLogsTable,
+			Columns: []string{user.AuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAuditLogsIDs(); len(nodes) > 0 && !_u.mutation.AuditLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuditLogsTable,
+			Columns: []string{user.AuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.RemoveBehaviorIDs(ids...)
-}
-
-// ClearMarketReports clears all "market_reports" edges to the MarketReport entity.
-func (_u *UserUpdateOne) ClearMarketReports() *UserUpdateOne {
-	_u.mutation.ClearMarketReports()
-	return _u
-}
-
-// RemoveMarketReportIDs removes the "market_reports" edge to MarketReport entities by IDs.
-func (_u *UserUpdateOne) RemoveMarketReportIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveMarketReportIDs(ids...)
-	return _u
-}
-
-// RemoveMarketReports removes "market_reports" edges to MarketReport entities.
-func (_u *UserUpdateOne) RemoveMarketReports(v ...*MarketReport) *UserUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.AuditLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuditLogsTable,
+			Columns: []string{user.AuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.RemoveMarketReportIDs(ids...)
-}
-
-// ClearEmailCampaigns clears all "email_campaigns" edges to the EmailCampaign entity.
-func (_u *UserUpdateOne) ClearEmailCampaigns() *UserUpdateOne {
-	_u.mutation.ClearEmailCampaigns()
-	return _u
-}
-
-// RemoveEmailCampaignIDs removes the "email_campaigns" edge to EmailCampaign entities by IDs.
-func (_u *UserUpdateOne) RemoveEmailCampaignIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveEmailCampaignIDs(ids...)
-	return _u
-}
-
-// RemoveEmailCampaigns removes "email_campaigns" edges to EmailCampaign entities.
-func (_u *UserUpdateOne) RemoveEmailCampaigns(v ...*EmailCampaign) *UserUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.UsageLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UsageLogsTable,
+			Columns: []string{user.UsageLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usagelog.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.RemoveEmailCampaignIDs(ids...)
-}
-
-// ClearCrmIntegrations clears all "crm_integrations" edges to the CRMIntegration entity.
-func (_u *UserUpdateOne) ClearCrmIntegrations() *UserUpdateOne {
-	_u.mutation.ClearCrmIntegrations()
-	return _u
-}
-
-// RemoveCrmIntegrationIDs removes the "crm_integrations" edge to CRMIntegration entities by IDs.
-func (_u *UserUpdateOne) RemoveCrmIntegrationIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveCrmIntegrationIDs(ids...)
-	return _u
-}
-
-// RemoveCrmIntegrations removes "crm_integrations" edges to CRMIntegration entities.
-func (_u *UserUpdateOne) RemoveCrmIntegrations(v ...*CRMIntegration) *UserUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedUsageLogsIDs(); len(nodes) > 0 && !_u.mutation.UsageLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UsageLogsTable,
+			Columns: []string{user.UsageLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usagelog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.RemoveCrmIntegrationIDs(ids...)
-}
-
-// Where appends a list predicates to the UserUpdate builder.
-func (_u *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
-	_u.mutation.Where(ps...)
-	return _u
-}
-
-// Select allows selecting one or more fields (columns) of the returned entity.
-// The default is selecting all fields defined in the entity schema.
-func (_u *UserUpdateOne) Select(field string, fields ...string) *UserUpdateOne {
-	_u.fields = append([]string{field}, fields...)
-	return _u
-}
-
-// Save executes the query and returns the updated User entity.
-func (_u *UserUpdateOne) Save(ctx context.Context) (*User, error) {
-	_u.defaults()
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
-}
-
-// SaveX is like Save, but panics if an error occurs.
-func (_u *UserUpdateOne) SaveX(ctx context.Context) *User {
-	node, err := _u.Save(ctx)
-	if err != nil {
-		panic(err)
+	if nodes := _u.mutation.UsageLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UsageLogsTable,
+			Columns: []string{user.UsageLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usagelog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return node
-}
-
-// Exec executes the query on the entity.
-func (_u *UserUpdateOne) Exec(ctx context.Context) error {
-	_, err := _u.Save(ctx)
-	return err
-}
-
-// ExecX is like Exec, but panics if an error occurs.
-func (_u *UserUpdateOne) ExecX(ctx context.Context) {
-	if err := _u.Exec(ctx); err != nil {
-		panic(err)
+	if _u.mutation.UsageDailyAggregatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UsageDailyAggregatesTable,
+			Columns: []string{user.UsageDailyAggregatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usagedailyaggregate.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-}
-
-// defaults sets the default values of the builder before save.
-func (_u *UserUpdateOne) defaults() {
-	if _, ok := _u.mutation.UpdatedAt(); !ok {
-		v := user.UpdateDefaultUpdatedAt()
-		_u.mutation.SetUpdatedAt(v)
+	if nodes := _u.mutation.RemovedUsageDailyAggregatesIDs(); len(nodes) > 0 && !_u.mutation.UsageDailyAggregatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UsageDailyAggregatesTable,
+			Columns: []string{user.UsageDailyAggregatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usagedailyaggregate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-}
-
-// check runs all checks and user-defined validators on the builder.
-func (_u *UserUpdateOne) check() error {
-	if v, ok := _u.mutation.Email(); ok {
-		if err := user.EmailValidator(v); err != nil {
-			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
+	if nodes := _u.mutation.UsageDailyAggregatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UsageDailyAggregatesTable,
+			Columns: []string{user.UsageDailyAggregatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usagedailyaggregate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OwnedOrganizationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OwnedOrganizationsTable,
+			Columns: []string{user.OwnedOrganizationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOwnedOrganizationsIDs(); len(nodes) > 0 && !_u.mutation.OwnedOrganizationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OwnedOrganizationsTable,
+			Columns: []string{user.OwnedOrganizationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
 		}
-	}
-	if v, ok := _u.mutation.PasswordHash(); ok {
-		if err := user.PasswordHashValidator(v); err != nil {
-			return &ValidationError{Name: "password_hash", err: fmt.Errorf(`ent: validator failed for field "User.password_hash": %w`, err)}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if v, ok := _u.mutation.Name(); ok {
-		if err := user.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "User.name": %w`, err)}
+	if nodes := _u.mutation.OwnedOrganizationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OwnedOrganizationsTable,
+			Columns: []string{user.OwnedOrganizationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
 		}
-	}
-	if v, ok := _u.mutation.SubscriptionTier(); ok {
-		if err := user.SubscriptionTierValidator(v); err != nil {
-			return &ValidationError{Name: "subscription_tier", err: fmt.Errorf(`ent: validator failed for field "User.subscription_tier": %w`, err)}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if v, ok := _u.mutation.Role(); ok {
-		if err := user.RoleValidator(v); err != nil {
-			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+	if _u.mutation.OrganizationMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OrganizationMembershipsTable,
+			Columns: []string{user.OrganizationMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organizationmember.FieldID, field.TypeInt),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if v, ok := _u.mutation.UsageCount(); ok {
-		if err := user.UsageCountValidator(v); err != nil {
-			return &ValidationError{Name: "usage_count", err: fmt.Errorf(`ent: validator failed for field "User.usage_count": %w`, err)}
+	if nodes := _u.mutation.RemovedOrganizationMembershipsIDs(); len(nodes) > 0 && !_u.mutation.OrganizationMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OrganizationMembershipsTable,
+			Columns: []string{user.OrganizationMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organizationmember.FieldID, field.TypeInt),
+			},
 		}
-	}
-	if v, ok := _u.mutation.UsageLimit(); ok {
-		if err := user.UsageLimitValidator(v); err != nil {
-			return &ValidationError{Name: "usage_limit", err: fmt.Errorf(`ent: validator failed for field "User.usage_limit": %w`, err)}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if v, ok := _u.mutation.OnboardingStep(); ok {
-		if err := user.OnboardingStepValidator(v); err != nil {
-			return &ValidationError{Name: "onboarding_step", err: fmt.Errorf(`ent: validator failed for field "User.onboarding_step": %w`, err)}
+	if nodes := _u.mutation.OrganizationMembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OrganizationMembershipsTable,
+			Columns: []string{user.OrganizationMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organizationmember.FieldID, field.TypeInt),
+			},
 		}
-	}
-	return nil
-}
-
-func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
-	if err := _u.check(); err != nil {
-		return _node, err
-	}
-	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt))
-	id, ok := _u.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "User.id" for update`)}
-	}
-	_spec.Node.ID.Value = id
-	if fields := _u.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, user.FieldID)
-		for _, f := range fields {
-			if !user.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
-			}
-			if f != user.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if ps := _u.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
+	if _u.mutation.SavedSearchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SavedSearchesTable,
+			Columns: []string{user.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.Email(); ok {
-		_spec.SetField(user.FieldEmail, field.TypeString, value)
-	}
-	if value, ok := _u.mutation.PasswordHash(); ok {
-		_spec.SetField(user.FieldPasswordHash, field.TypeString, value)
-	}
-	if value, ok := _u.mutation.Name(); ok {
-		_spec.SetField(user.FieldName, field.TypeString, value)
-	}
-	if value, ok := _u.mutation.SubscriptionTier(); ok {
-		_spec.SetField(user.FieldSubscriptionTier, field.TypeEnum, value)
-	}
-	if value, ok := _u.mutation.Role(); ok {
-		_spec.SetField(user.FieldRole, field.TypeEnum, value)
-	}
-	if value, ok := _u.mutation.UsageCount(); ok {
-		_spec.SetField(user.FieldUsageCount, field.TypeInt, value)
-	}
-	if value, ok := _u.mutation.AddedUsageCount(); ok {
-		_spec.AddField(user.FieldUsageCount, field.TypeInt, value)
-	}
-	if value, ok := _u.mutation.UsageLimit(); ok {
-		_spec.SetField(user.FieldUsageLimit, field.TypeInt, value)
-	}
-	if value, ok := _u.mutation.AddedUsageLimit(); ok {
-		_spec.AddField(user.FieldUsageLimit, field.TypeInt, value)
-	}
-	if value, ok := _u.mutation.LastResetAt(); ok {
-		_spec.SetField(user.FieldLastResetAt, field.TypeTime, value)
-	}
-	if value, ok := _u.mutation.LastLoginAt(); ok {
-		_spec.SetField(user.FieldLastLoginAt, field.TypeTime, value)
-	}
-	if _u.mutation.LastLoginAtCleared() {
-		_spec.ClearField(user.FieldLastLoginAt, field.TypeTime)
-	}
-	if value, ok := _u.mutation.EmailVerified(); ok {
-		_spec.SetField(user.FieldEmailVerified, field.TypeBool, value)
-	}
-	if value, ok := _u.mutation.EmailVerificationToken(); ok {
-		_spec.SetField(user.FieldEmailVerificationToken, field.TypeString, value)
-	}
-	if _u.mutation.EmailVerificationTokenCleared() {
-		_spec.ClearField(user.FieldEmailVerificationToken, field.TypeString)
-	}
-	if value, ok := _u.mutation.EmailVerificationTokenExpiresAt(); ok {
-		_spec.SetField(user.FieldEmailVerificationTokenExpiresAt, field.TypeTime, value)
-	}
-	if _u.mutation.EmailVerificationTokenExpiresAtCleared() {
-		_spec.ClearField(user.FieldEmailVerificationTokenExpiresAt, field.TypeTime)
-	}
-	if value, ok := _u.mutation.EmailVerifiedAt(); ok {
-		_spec.SetField(user.FieldEmailVerifiedAt, field.TypeTime, value)
-	}
-	if _u.mutation.EmailVerifiedAtCleared() {
-		_spec.ClearField(user.FieldEmailVerifiedAt, field.TypeTime)
-	}
-	if value, ok := _u.mutation.AcceptedTermsAt(); ok {
-		_spec.SetField(user.FieldAcceptedTermsAt, field.TypeTime, value)
-	}
-	if _u.mutation.AcceptedTermsAtCleared() {
-		_spec.ClearField(user.FieldAcceptedTermsAt, field.TypeTime)
-	}
-	if value, ok := _u.mutation.OnboardingCompleted(); ok {
-		_spec.SetField(user.FieldOnboardingCompleted, field.TypeBool, value)
-	}
-	if value, ok := _u.mutation.TotpEnabled(); ok {
-		_spec.SetField(user.FieldTotpEnabled, field.TypeBool, value)
-	}
-	if value, ok := _u.mutation.TotpSecret(); ok {
-		_spec.SetField(user.FieldTotpSecret, field.TypeString, value)
-	}
-	if _u.mutation.TotpSecretCleared() {
-		_spec.ClearField(user.FieldTotpSecret, field.TypeString)
-	}
-	if value, ok := _u.mutation.OauthProvider(); ok {
-		_spec.SetField(user.FieldOauthProvider, field.TypeString, value)
-	}
-	if _u.mutation.OauthProviderCleared() {
-		_spec.ClearField(user.FieldOauthProvider, field.TypeString)
-	}
-	if value, ok := _u.mutation.OauthID(); ok {
-		_spec.SetField(user.FieldOauthID, field.TypeString, value)
-	}
-	if _u.mutation.OauthIDCleared() {
-		_spec.ClearField(user.FieldOauthID, field.TypeString)
-	}
-	if value, ok := _u.mutation.StripeCustomerID(); ok {
-		_spec.SetField(user.FieldStripeCustomerID, field.TypeString, value)
-	}
-	if _u.mutation.StripeCustomerIDCleared() {
-		_spec.ClearField(user.FieldStripeCustomerID, field.TypeString)
-	}
-	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
-	}
-	if value, ok := _u.mutation.DeletedAt(); ok {
-		_spec.SetField(user.FieldDeletedAt, field.TypeTime, value)
-	}
-	if _u.mutation.DeletedAtCleared() {
-		_spec.ClearField(user.FieldDeletedAt, field.TypeTime)
-	}
-	if value, ok := _u.mutation.OnboardingStep(); ok {
-		_spec.SetField(user.FieldOnboardingStep, field.TypeInt, value)
+	if nodes := _u.mutation.RemovedSavedSearchesIDs(); len(nodes) > 0 && !_u.mutation.SavedSearchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SavedSearchesTable,
+			Columns: []string{user.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.AddedOnboardingStep(); ok {
-		_spec.AddField(user.FieldOnboardingStep, field.TypeInt, value)
+	if nodes := _u.mutation.SavedSearchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SavedSearchesTable,
+			Columns: []string{user.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.SubscriptionsCleared() {
+	if _u.mutation.ExportTemplatesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.SubscriptionsTable,
-			Columns: []string{user.SubscriptionsColumn},
+			Table:   user.ExportTemplatesTable,
+			Columns: []string{user.ExportTemplatesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(exporttemplate.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedSubscriptionsIDs(); len(nodes) > 0 && !_u.mutation.SubscriptionsCleared() {
+	if nodes := _u.mutation.RemovedExportTemplatesIDs(); len(nodes) > 0 && !_u.mutation.ExportTemplatesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.SubscriptionsTable,
-			Columns: []string{user.SubscriptionsColumn},
+			Table:   user.ExportTemplatesTable,
+			Columns: []string{user.ExportTemplatesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(exporttemplate.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -4926,15 +7050,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.SubscriptionsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ExportTemplatesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.SubscriptionsTable,
-			Columns: []string{user.SubscriptionsColumn},
+			Table:   user.ExportTemplatesTable,
+			Columns: []string{user.ExportTemplatesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(exporttemplate.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -4942,28 +7066,28 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ExportsCleared() {
+	if _u.mutation.ScheduledExportsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ExportsTable,
-			Columns: []string{user.ExportsColumn},
+			Table:   user.ScheduledExportsTable,
+			Columns: []string{user.ScheduledExportsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(export.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(scheduledexport.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedExportsIDs(); len(nodes) > 0 && !_u.mutation.ExportsCleared() {
+	if nodes := _u.mutation.RemovedScheduledExportsIDs(); len(nodes) > 0 && !_u.mutation.ScheduledExportsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ExportsTable,
-			Columns: []string{user.ExportsColumn},
+			Table:   user.ScheduledExportsTable,
+			Columns: []string{user.ScheduledExportsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(export.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(scheduledexport.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -4971,15 +7095,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ExportsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ScheduledExportsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ExportsTable,
-			Columns: []string{user.ExportsColumn},
+			Table:   user.ScheduledExportsTable,
+			Columns: []string{user.ScheduledExportsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(export.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(scheduledexport.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -4987,28 +7111,28 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.APIKeysCleared() {
+	if _u.mutation.WebhooksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.APIKeysTable,
-			Columns: []string{user.APIKeysColumn},
+			Table:   user.WebhooksTable,
+			Columns: []string{user.WebhooksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedAPIKeysIDs(); len(nodes) > 0 && !_u.mutation.APIKeysCleared() {
+	if nodes := _u.mutation.RemovedWebhooksIDs(); len(nodes) > 0 && !_u.mutation.WebhooksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.APIKeysTable,
-			Columns: []string{user.APIKeysColumn},
+			Table:   user.WebhooksTable,
+			Columns: []string{user.WebhooksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5016,15 +7140,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.APIKeysIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.WebhooksIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.APIKeysTable,
-			Columns: []string{user.APIKeysColumn},
+			Table:   user.WebhooksTable,
+			Columns: []string{user.WebhooksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5032,28 +7156,28 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.AuditLogsCleared() {
+	if _u.mutation.LeadNotesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.AuditLogsTable,
-			Columns: []string{user.AuditLogsColumn},
+			Table:   user.LeadNotesTable,
+			Columns: []string{user.LeadNotesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(leadnote.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedAuditLogsIDs(); len(nodes) > 0 && !_u.mutation.AuditLogsCleared() {
+	if nodes := _u.mutation.RemovedLeadNotesIDs(); len(nodes) > 0 && !_u.mutation.LeadNotesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.AuditLogsTable,
-			Columns: []string{user.AuditLogsColumn},
+			Table:   user.LeadNotesTable,
+			Columns: []string{user.LeadNotesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(leadnote.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5061,15 +7185,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.AuditLogsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.LeadNotesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.AuditLogsTable,
-			Columns: []string{user.AuditLogsColumn},
+			Table:   user.LeadNotesTable,
+			Columns: []string{user.LeadNotesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(leadnote.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5077,28 +7201,28 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.UsageLogsCleared() {
+	if _u.mutation.LeadSuppressionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.UsageLogsTable,
-			Columns: []string{user.UsageLogsColumn},
+			Table:   user.LeadSuppressionsTable,
+			Columns: []string{user.LeadSuppressionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(usagelog.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(leadsuppression.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedUsageLogsIDs(); len(nodes) > 0 && !_u.mutation.UsageLogsCleared() {
+	if nodes := _u.mutation.RemovedLeadSuppressionsIDs(); len(nodes) > 0 && !_u.mutation.LeadSuppressionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.UsageLogsTable,
-			Columns: []string{user.UsageLogsColumn},
+			Table:   user.LeadSuppressionsTable,
+			Columns: []string{user.LeadSuppressionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(usagelog.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(leadsuppression.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5106,15 +7230,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.UsageLogsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.LeadSuppressionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.UsageLogsTable,
-			Columns: []string{user.UsageLogsColumn},
+			Table:   user.LeadSuppressionsTable,
+			Columns: []string{user.LeadSuppressionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(usagelog.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(leadsuppression.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5122,28 +7246,28 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.OwnedOrganizationsCleared() {
+	if _u.mutation.ContactAttemptsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.OwnedOrganizationsTable,
-			Columns: []string{user.OwnedOrganizationsColumn},
+			Table:   user.ContactAttemptsTable,
+			Columns: []string{user.ContactAttemptsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(contactattempt.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedOwnedOrganizationsIDs(); len(nodes) > 0 && !_u.mutation.OwnedOrganizationsCleared() {
+	if nodes := _u.mutation.RemovedContactAttemptsIDs(); len(nodes) > 0 && !_u.mutation.ContactAttemptsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.OwnedOrganizationsTable,
-			Columns: []string{user.OwnedOrganizationsColumn},
+			Table:   user.ContactAttemptsTable,
+			Columns: []string{user.ContactAttemptsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(contactattempt.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5151,15 +7275,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.OwnedOrganizationsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ContactAttemptsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.OwnedOrganizationsTable,
-			Columns: []string{user.OwnedOrganizationsColumn},
+			Table:   user.ContactAttemptsTable,
+			Columns: []string{user.ContactAttemptsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(contactattempt.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5167,28 +7291,28 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.OrganizationMembershipsCleared() {
+	if _u.mutation.NotificationPreferencesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.OrganizationMembershipsTable,
-			Columns: []string{user.OrganizationMembershipsColumn},
+			Table:   user.NotificationPreferencesTable,
+			Columns: []string{user.NotificationPreferencesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(organizationmember.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(usernotificationpreference.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedOrganizationMembershipsIDs(); len(nodes) > 0 && !_u.mutation.OrganizationMembershipsCleared() {
+	if nodes := _u.mutation.RemovedNotificationPreferencesIDs(); len(nodes) > 0 && !_u.mutation.NotificationPreferencesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.OrganizationMembershipsTable,
-			Columns: []string{user.OrganizationMembershipsColumn},
+			Table:   user.NotificationPreferencesTable,
+			Columns: []string{user.NotificationPreferencesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(organizationmember.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(usernotificationpreference.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5196,15 +7320,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.OrganizationMembershipsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.NotificationPreferencesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.OrganizationMembershipsTable,
-			Columns: []string{user.OrganizationMembershipsColumn},
+			Table:   user.NotificationPreferencesTable,
+			Columns: []string{user.NotificationPreferencesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(organizationmember.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(usernotificationpreference.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5212,28 +7336,28 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.SavedSearchesCleared() {
+	if _u.mutation.LeadStatusChangesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.SavedSearchesTable,
-			Columns: []string{user.SavedSearchesColumn},
+			Table:   user.LeadStatusChangesTable,
+			Columns: []string{user.LeadStatusChangesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(leadstatushistory.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedSavedSearchesIDs(); len(nodes) > 0 && !_u.mutation.SavedSearchesCleared() {
+	if nodes := _u.mutation.RemovedLeadStatusChangesIDs(); len(nodes) > 0 && !_u.mutation.LeadStatusChangesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.SavedSearchesTable,
-			Columns: []string{user.SavedSearchesColumn},
+			Table:   user.LeadStatusChangesTable,
+			Columns: []string{user.LeadStatusChangesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(leadstatushistory.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5241,15 +7365,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.SavedSearchesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.LeadStatusChangesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.SavedSearchesTable,
-			Columns: []string{user.SavedSearchesColumn},
+			Table:   user.LeadStatusChangesTable,
+			Columns: []string{user.LeadStatusChangesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(leadstatushistory.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5257,28 +7381,28 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.WebhooksCleared() {
+	if _u.mutation.LeadChangesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.WebhooksTable,
-			Columns: []string{user.WebhooksColumn},
+			Table:   user.LeadChangesTable,
+			Columns: []string{user.LeadChangesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(leadchange.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedWebhooksIDs(); len(nodes) > 0 && !_u.mutation.WebhooksCleared() {
+	if nodes := _u.mutation.RemovedLeadChangesIDs(); len(nodes) > 0 && !_u.mutation.LeadChangesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.WebhooksTable,
-			Columns: []string{user.WebhooksColumn},
+			Table:   user.LeadChangesTable,
+			Columns: []string{user.LeadChangesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(leadchange.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5286,15 +7410,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.WebhooksIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.LeadChangesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.WebhooksTable,
-			Columns: []string{user.WebhooksColumn},
+			Table:   user.LeadChangesTable,
+			Columns: []string{user.LeadChangesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(leadchange.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5302,28 +7426,28 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.LeadNotesCleared() {
+	if _u.mutation.EnrichmentAttemptsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.LeadNotesTable,
-			Columns: []string{user.LeadNotesColumn},
+			Table:   user.EnrichmentAttemptsTable,
+			Columns: []string{user.EnrichmentAttemptsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(leadnote.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(enrichmentattempt.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedLeadNotesIDs(); len(nodes) > 0 && !_u.mutation.LeadNotesCleared() {
+	if nodes := _u.mutation.RemovedEnrichmentAttemptsIDs(); len(nodes) > 0 && !_u.mutation.EnrichmentAttemptsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.LeadNotesTable,
-			Columns: []string{user.LeadNotesColumn},
+			Table:   user.EnrichmentAttemptsTable,
+			Columns: []string{user.EnrichmentAttemptsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(leadnote.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(enrichmentattempt.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5331,15 +7455,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.LeadNotesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.EnrichmentAttemptsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.LeadNotesTable,
-			Columns: []string{user.LeadNotesColumn},
+			Table:   user.EnrichmentAttemptsTable,
+			Columns: []string{user.EnrichmentAttemptsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(leadnote.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(enrichmentattempt.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5347,28 +7471,57 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.LeadStatusChangesCleared() {
+	if _u.mutation.EnrichmentBudgetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.EnrichmentBudgetTable,
+			Columns: []string{user.EnrichmentBudgetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrichmentbudget.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EnrichmentBudgetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.EnrichmentBudgetTable,
+			Columns: []string{user.EnrichmentBudgetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(enrichmentbudget.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LeadVerificationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.LeadStatusChangesTable,
-			Columns: []string{user.LeadStatusChangesColumn},
+			Table:   user.LeadVerificationsTable,
+			Columns: []string{user.LeadVerificationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(leadstatushistory.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(leadverification.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedLeadStatusChangesIDs(); len(nodes) > 0 && !_u.mutation.LeadStatusChangesCleared() {
+	if nodes := _u.mutation.RemovedLeadVerificationsIDs(); len(nodes) > 0 && !_u.mutation.LeadVerificationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.LeadStatusChangesTable,
-			Columns: []string{user.LeadStatusChangesColumn},
+			Table:   user.LeadVerificationsTable,
+			Columns: []string{user.LeadVerificationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(leadstatushistory.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(leadverification.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -5376,15 +7529,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.LeadStatusChangesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.LeadVerificationsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.LeadStatusChangesTable,
-			Columns: []string{user.LeadStatusChangesColumn},
+			Table:   user.LeadVerificationsTable,
+			Columns: []string{user.LeadVerificationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(leadstatushistory.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(leadverification.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -6276,6 +8429,97 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.CrmPushJobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CrmPushJobsTable,
+			Columns: []string{user.CrmPushJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(crmpushjob.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCrmPushJobsIDs(); len(nodes) > 0 && !_u.mutation.CrmPushJobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CrmPushJobsTable,
+			Columns: []string{user.CrmPushJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(crmpushjob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CrmPushJobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CrmPushJobsTable,
+			Columns: []string{user.CrmPushJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(crmpushjob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.IntegrationConnectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IntegrationConnectionsTable,
+			Columns: []string{user.IntegrationConnectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(integrationconnection.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedIntegrationConnectionsIDs(); len(nodes) > 0 && !_u.mutation.IntegrationConnectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IntegrationConnectionsTable,
+			Columns: []string{user.IntegrationConnectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(integrationconnection.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.IntegrationConnectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IntegrationConnectionsTable,
+			Columns: []string{user.IntegrationConnectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(integrationconnection.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &User{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -18,8 +18,9 @@ import (
 // UserBehaviorUpdate is the builder for updating UserBehavior entities.
 type UserBehaviorUpdate struct {
 	config
-	hooks    []Hook
-	mutation *UserBehaviorMutation
+	hooks     []Hook
+	mutation  *UserBehaviorMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the UserBehaviorUpdate builder.
@@ -226,6 +227,12 @@ func (_u *UserBehaviorUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *UserBehaviorUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserBehaviorUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *UserBehaviorUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -303,6 +310,7 @@ func (_u *UserBehaviorUpdate) sqlSave(ctx context.Context) (_node int, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{userbehavior.Label}
@@ -318,9 +326,10 @@ func (_u *UserBehaviorUpdate) sqlSave(ctx context.Context) (_node int, err error
 // UserBehaviorUpdateOne is the builder for updating a single UserBehavior entity.
 type UserBehaviorUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserBehaviorMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *UserBehaviorMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUserID sets the "user_id" field.
@@ -534,6 +543,12 @@ func (_u *UserBehaviorUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *UserBehaviorUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserBehaviorUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *UserBehaviorUpdateOne) sqlSave(ctx context.Context) (_node *UserBehavior, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -628,6 +643,7 @@ func (_u *UserBehaviorUpdateOne) sqlSave(ctx context.Context) (_node *UserBehavi
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &UserBehavior{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,264 @@
+// Code generated by ent, DO NOT EDIT.
+
+package usernotificationpreference
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldEQ(FieldUserID, v))
+}
+
+// EventType applies equality check predicate on the "event_type" field. It's identical to EventTypeEQ.
+func EventType(v string) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldEQ(FieldEventType, v))
+}
+
+// Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
+func Email(v bool) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldEQ(FieldEmail, v))
+}
+
+// Webhook applies equality check predicate on the "webhook" field. It's identical to WebhookEQ.
+func Webhook(v bool) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldEQ(FieldWebhook, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// EventTypeEQ applies the EQ predicate on the "event_type" field.
+func EventTypeEQ(v string) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldEQ(FieldEventType, v))
+}
+
+// EventTypeNEQ applies the NEQ predicate on the "event_type" field.
+func EventTypeNEQ(v string) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldNEQ(FieldEventType, v))
+}
+
+// EventTypeIn applies the In predicate on the "event_type" field.
+func EventTypeIn(vs ...string) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldIn(FieldEventType, vs...))
+}
+
+// EventTypeNotIn applies the NotIn predicate on the "event_type" field.
+func EventTypeNotIn(vs ...string) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldNotIn(FieldEventType, vs...))
+}
+
+// EventTypeGT applies the GT predicate on the "event_type" field.
+func EventTypeGT(v string) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldGT(FieldEventType, v))
+}
+
+// EventTypeGTE applies the GTE predicate on the "event_type" field.
+func EventTypeGTE(v string) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldGTE(FieldEventType, v))
+}
+
+// EventTypeLT applies the LT predicate on the "event_type" field.
+func EventTypeLT(v string) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldLT(FieldEventType, v))
+}
+
+// EventTypeLTE applies the LTE predicate on the "event_type" field.
+func EventTypeLTE(v string) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldLTE(FieldEventType, v))
+}
+
+// EventTypeContains applies the Contains predicate on the "event_type" field.
+func EventTypeContains(v string) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldContains(FieldEventType, v))
+}
+
+// EventTypeHasPrefix applies the HasPrefix predicate on the "event_type" field.
+func EventTypeHasPrefix(v string) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldHasPrefix(FieldEventType, v))
+}
+
+// EventTypeHasSuffix applies the HasSuffix predicate on the "event_type" field.
+func EventTypeHasSuffix(v string) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldHasSuffix(FieldEventType, v))
+}
+
+// EventTypeEqualFold applies the EqualFold predicate on the "event_type" field.
+func EventTypeEqualFold(v string) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldEqualFold(FieldEventType, v))
+}
+
+// EventTypeContainsFold applies the ContainsFold predicate on the "event_type" field.
+func EventTypeContainsFold(v string) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldContainsFold(FieldEventType, v))
+}
+
+// EmailEQ applies the EQ predicate on the "email" field.
+func EmailEQ(v bool) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldEQ(FieldEmail, v))
+}
+
+// EmailNEQ applies the NEQ predicate on the "email" field.
+func EmailNEQ(v bool) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldNEQ(FieldEmail, v))
+}
+
+// WebhookEQ applies the EQ predicate on the "webhook" field.
+func WebhookEQ(v bool) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldEQ(FieldWebhook, v))
+}
+
+// WebhookNEQ applies the NEQ predicate on the "webhook" field.
+func WebhookNEQ(v bool) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldNEQ(FieldWebhook, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UserNotificationPreference) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UserNotificationPreference) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UserNotificationPreference) predicate.UserNotificationPreference {
+	return predicate.UserNotificationPreference(sql.NotPredicates(p))
+}
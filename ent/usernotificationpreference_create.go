@@ -0,0 +1,281 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/user"
+	"github.com/jordanlanch/industrydb/ent/usernotificationpreference"
+)
+
+// UserNotificationPreferenceCreate is the builder for creating a UserNotificationPreference entity.
+type UserNotificationPreferenceCreate struct {
+	config
+	mutation *UserNotificationPreferenceMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *UserNotificationPreferenceCreate) SetUserID(v int) *UserNotificationPreferenceCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetEventType sets the "event_type" field.
+func (_c *UserNotificationPreferenceCreate) SetEventType(v string) *UserNotificationPreferenceCreate {
+	_c.mutation.SetEventType(v)
+	return _c
+}
+
+// SetEmail sets the "email" field.
+func (_c *UserNotificationPreferenceCreate) SetEmail(v bool) *UserNotificationPreferenceCreate {
+	_c.mutation.SetEmail(v)
+	return _c
+}
+
+// SetWebhook sets the "webhook" field.
+func (_c *UserNotificationPreferenceCreate) SetWebhook(v bool) *UserNotificationPreferenceCreate {
+	_c.mutation.SetWebhook(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *UserNotificationPreferenceCreate) SetUpdatedAt(v time.Time) *UserNotificationPreferenceCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *UserNotificationPreferenceCreate) SetNillableUpdatedAt(v *time.Time) *UserNotificationPreferenceCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *UserNotificationPreferenceCreate) SetUser(v *User) *UserNotificationPreferenceCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the UserNotificationPreferenceMutation object of the builder.
+func (_c *UserNotificationPreferenceCreate) Mutation() *UserNotificationPreferenceMutation {
+	return _c.mutation
+}
+
+// Save creates the UserNotificationPreference in the database.
+func (_c *UserNotificationPreferenceCreate) Save(ctx context.Context) (*UserNotificationPreference, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *UserNotificationPreferenceCreate) SaveX(ctx context.Context) *UserNotificationPreference {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserNotificationPreferenceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserNotificationPreferenceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *UserNotificationPreferenceCreate) defaults() {
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := usernotificationpreference.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *UserNotificationPreferenceCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UserNotificationPreference.user_id"`)}
+	}
+	if _, ok := _c.mutation.EventType(); !ok {
+		return &ValidationError{Name: "event_type", err: errors.New(`ent: missing required field "UserNotificationPreference.event_type"`)}
+	}
+	if v, ok := _c.mutation.EventType(); ok {
+		if err := usernotificationpreference.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`ent: validator failed for field "UserNotificationPreference.event_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "UserNotificationPreference.email"`)}
+	}
+	if _, ok := _c.mutation.Webhook(); !ok {
+		return &ValidationError{Name: "webhook", err: errors.New(`ent: missing required field "UserNotificationPreference.webhook"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "UserNotificationPreference.updated_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "UserNotificationPreference.user"`)}
+	}
+	return nil
+}
+
+func (_c *UserNotificationPreferenceCreate) sqlSave(ctx context.Context) (*UserNotificationPreference, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *UserNotificationPreferenceCreate) createSpec() (*UserNotificationPreference, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserNotificationPreference{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(usernotificationpreference.Table, sqlgraph.NewFieldSpec(usernotificationpreference.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.EventType(); ok {
+		_spec.SetField(usernotificationpreference.FieldEventType, field.TypeString, value)
+		_node.EventType = value
+	}
+	if value, ok := _c.mutation.Email(); ok {
+		_spec.SetField(usernotificationpreference.FieldEmail, field.TypeBool, value)
+		_node.Email = value
+	}
+	if value, ok := _c.mutation.Webhook(); ok {
+		_spec.SetField(usernotificationpreference.FieldWebhook, field.TypeBool, value)
+		_node.Webhook = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(usernotificationpreference.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   usernotificationpreference.UserTable,
+			Columns: []string{usernotificationpreference.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// UserNotificationPreferenceCreateBulk is the builder for creating many UserNotificationPreference entities in bulk.
+type UserNotificationPreferenceCreateBulk struct {
+	config
+	err      error
+	builders []*UserNotificationPreferenceCreate
+}
+
+// Save creates the UserNotificationPreference entities in the database.
+func (_c *UserNotificationPreferenceCreateBulk) Save(ctx context.Context) ([]*UserNotificationPreference, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*UserNotificationPreference, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserNotificationPreferenceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *UserNotificationPreferenceCreateBulk) SaveX(ctx context.Context) []*UserNotificationPreference {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserNotificationPreferenceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserNotificationPreferenceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,471 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+	"github.com/jordanlanch/industrydb/ent/user"
+	"github.com/jordanlanch/industrydb/ent/usernotificationpreference"
+)
+
+// UserNotificationPreferenceUpdate is the builder for updating UserNotificationPreference entities.
+type UserNotificationPreferenceUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *UserNotificationPreferenceMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the UserNotificationPreferenceUpdate builder.
+func (_u *UserNotificationPreferenceUpdate) Where(ps ...predicate.UserNotificationPreference) *UserNotificationPreferenceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *UserNotificationPreferenceUpdate) SetUserID(v int) *UserNotificationPreferenceUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *UserNotificationPreferenceUpdate) SetNillableUserID(v *int) *UserNotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetEventType sets the "event_type" field.
+func (_u *UserNotificationPreferenceUpdate) SetEventType(v string) *UserNotificationPreferenceUpdate {
+	_u.mutation.SetEventType(v)
+	return _u
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_u *UserNotificationPreferenceUpdate) SetNillableEventType(v *string) *UserNotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetEventType(*v)
+	}
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *UserNotificationPreferenceUpdate) SetEmail(v bool) *UserNotificationPreferenceUpdate {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *UserNotificationPreferenceUpdate) SetNillableEmail(v *bool) *UserNotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// SetWebhook sets the "webhook" field.
+func (_u *UserNotificationPreferenceUpdate) SetWebhook(v bool) *UserNotificationPreferenceUpdate {
+	_u.mutation.SetWebhook(v)
+	return _u
+}
+
+// SetNillableWebhook sets the "webhook" field if the given value is not nil.
+func (_u *UserNotificationPreferenceUpdate) SetNillableWebhook(v *bool) *UserNotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetWebhook(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UserNotificationPreferenceUpdate) SetUpdatedAt(v time.Time) *UserNotificationPreferenceUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *UserNotificationPreferenceUpdate) SetUser(v *User) *UserNotificationPreferenceUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the UserNotificationPreferenceMutation object of the builder.
+func (_u *UserNotificationPreferenceUpdate) Mutation() *UserNotificationPreferenceMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *UserNotificationPreferenceUpdate) ClearUser() *UserNotificationPreferenceUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UserNotificationPreferenceUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserNotificationPreferenceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UserNotificationPreferenceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserNotificationPreferenceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *UserNotificationPreferenceUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := usernotificationpreference.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserNotificationPreferenceUpdate) check() error {
+	if v, ok := _u.mutation.EventType(); ok {
+		if err := usernotificationpreference.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`ent: validator failed for field "UserNotificationPreference.event_type": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UserNotificationPreference.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *UserNotificationPreferenceUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserNotificationPreferenceUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *UserNotificationPreferenceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usernotificationpreference.Table, usernotificationpreference.Columns, sqlgraph.NewFieldSpec(usernotificationpreference.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EventType(); ok {
+		_spec.SetField(usernotificationpreference.FieldEventType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(usernotificationpreference.FieldEmail, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Webhook(); ok {
+		_spec.SetField(usernotificationpreference.FieldWebhook, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(usernotificationpreference.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   usernotificationpreference.UserTable,
+			Columns: []string{usernotificationpreference.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   usernotificationpreference.UserTable,
+			Columns: []string{usernotificationpreference.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usernotificationpreference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UserNotificationPreferenceUpdateOne is the builder for updating a single UserNotificationPreference entity.
+type UserNotificationPreferenceUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *UserNotificationPreferenceMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *UserNotificationPreferenceUpdateOne) SetUserID(v int) *UserNotificationPreferenceUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *UserNotificationPreferenceUpdateOne) SetNillableUserID(v *int) *UserNotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetEventType sets the "event_type" field.
+func (_u *UserNotificationPreferenceUpdateOne) SetEventType(v string) *UserNotificationPreferenceUpdateOne {
+	_u.mutation.SetEventType(v)
+	return _u
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_u *UserNotificationPreferenceUpdateOne) SetNillableEventType(v *string) *UserNotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetEventType(*v)
+	}
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *UserNotificationPreferenceUpdateOne) SetEmail(v bool) *UserNotificationPreferenceUpdateOne {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *UserNotificationPreferenceUpdateOne) SetNillableEmail(v *bool) *UserNotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// SetWebhook sets the "webhook" field.
+func (_u *UserNotificationPreferenceUpdateOne) SetWebhook(v bool) *UserNotificationPreferenceUpdateOne {
+	_u.mutation.SetWebhook(v)
+	return _u
+}
+
+// SetNillableWebhook sets the "webhook" field if the given value is not nil.
+func (_u *UserNotificationPreferenceUpdateOne) SetNillableWebhook(v *bool) *UserNotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetWebhook(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UserNotificationPreferenceUpdateOne) SetUpdatedAt(v time.Time) *UserNotificationPreferenceUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *UserNotificationPreferenceUpdateOne) SetUser(v *User) *UserNotificationPreferenceUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the UserNotificationPreferenceMutation object of the builder.
+func (_u *UserNotificationPreferenceUpdateOne) Mutation() *UserNotificationPreferenceMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *UserNotificationPreferenceUpdateOne) ClearUser() *UserNotificationPreferenceUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the UserNotificationPreferenceUpdate builder.
+func (_u *UserNotificationPreferenceUpdateOne) Where(ps ...predicate.UserNotificationPreference) *UserNotificationPreferenceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UserNotificationPreferenceUpdateOne) Select(field string, fields ...string) *UserNotificationPreferenceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated UserNotificationPreference entity.
+func (_u *UserNotificationPreferenceUpdateOne) Save(ctx context.Context) (*UserNotificationPreference, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserNotificationPreferenceUpdateOne) SaveX(ctx context.Context) *UserNotificationPreference {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UserNotificationPreferenceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserNotificationPreferenceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *UserNotificationPreferenceUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := usernotificationpreference.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserNotificationPreferenceUpdateOne) check() error {
+	if v, ok := _u.mutation.EventType(); ok {
+		if err := usernotificationpreference.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`ent: validator failed for field "UserNotificationPreference.event_type": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UserNotificationPreference.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *UserNotificationPreferenceUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserNotificationPreferenceUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *UserNotificationPreferenceUpdateOne) sqlSave(ctx context.Context) (_node *UserNotificationPreference, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usernotificationpreference.Table, usernotificationpreference.Columns, sqlgraph.NewFieldSpec(usernotificationpreference.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserNotificationPreference.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usernotificationpreference.FieldID)
+		for _, f := range fields {
+			if !usernotificationpreference.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != usernotificationpreference.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EventType(); ok {
+		_spec.SetField(usernotificationpreference.FieldEventType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(usernotificationpreference.FieldEmail, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Webhook(); ok {
+		_spec.SetField(usernotificationpreference.FieldWebhook, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(usernotificationpreference.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   usernotificationpreference.UserTable,
+			Columns: []string{usernotificationpreference.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   usernotificationpreference.UserTable,
+			Columns: []string{usernotificationpreference.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &UserNotificationPreference{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usernotificationpreference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
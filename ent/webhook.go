@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/jordanlanch/industrydb/ent/organization"
 	"github.com/jordanlanch/industrydb/ent/user"
 	"github.com/jordanlanch/industrydb/ent/webhook"
 )
@@ -21,14 +22,36 @@ type Webhook struct {
 	ID int `json:"id,omitempty"`
 	// Webhook endpoint URL
 	URL string `json:"url,omitempty"`
+	// Organization the webhook belongs to (null = personal webhook); user is then the creator
+	OrganizationID *int `json:"organization_id,omitempty"`
 	// List of events to subscribe to (lead.created, export.completed, etc.)
 	Events []string `json:"events,omitempty"`
 	// Secret for HMAC signature verification
 	Secret string `json:"-"`
 	// Whether webhook is active
 	Active bool `json:"active,omitempty"`
+	// When deliveries were paused (null when not paused)
+	PausedAt *time.Time `json:"paused_at,omitempty"`
+	// Events received while paused or outside the delivery window, delivered on resume or when the window opens
+	QueuedEvents []map[string]interface{} `json:"queued_events,omitempty"`
+	// Pinned payload schema version (webhooks created before versioning stay on 1)
+	SchemaVersion string `json:"schema_version,omitempty"`
 	// User-provided description of webhook
 	Description string `json:"description,omitempty"`
+	// IANA timezone of the delivery window (empty = UTC)
+	DeliveryTimezone string `json:"delivery_timezone,omitempty"`
+	// Local hour deliveries start (null = deliver 24/7)
+	DeliveryStartHour *int `json:"delivery_start_hour,omitempty"`
+	// Local hour deliveries stop, exclusive; before start_hour wraps past midnight
+	DeliveryEndHour *int `json:"delivery_end_hour,omitempty"`
+	// Events per batched delivery (null = one delivery per event)
+	BatchMaxEvents *int `json:"batch_max_events,omitempty"`
+	// Seconds a batch collects events before it is delivered, if not full earlier
+	BatchWindowSeconds *int `json:"batch_window_seconds,omitempty"`
+	// Redaction profile applied to lead data in payloads (empty = none)
+	RedactionProfile string `json:"redaction_profile,omitempty"`
+	// ID of the last lead change sent as lead.updated (null = not subscribed yet)
+	LeadChangeCursor *int `json:"lead_change_cursor,omitempty"`
 	// Number of retries for failed deliveries
 	RetryCount int `json:"retry_count,omitempty"`
 	// Last time webhook was triggered
@@ -52,9 +75,13 @@ type Webhook struct {
 type WebhookEdges struct {
 	// User holds the value of the user edge.
 	User *User `json:"user,omitempty"`
+	// Organization this webhook belongs to (optional)
+	Organization *Organization `json:"organization,omitempty"`
+	// Dead-lettered deliveries that failed every retry
+	Deliveries []*WebhookDelivery `json:"deliveries,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [3]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -68,20 +95,40 @@ func (e WebhookEdges) UserOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "user"}
 }
 
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WebhookEdges) OrganizationOrErr() (*Organization, error) {
+	if e.Organization != nil {
+		return e.Organization, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// DeliveriesOrErr returns the Deliveries value or an error if the edge
+// was not loaded in eager-loading.
+func (e WebhookEdges) DeliveriesOrErr() ([]*WebhookDelivery, error) {
+	if e.loadedTypes[2] {
+		return e.Deliveries, nil
+	}
+	return nil, &NotLoadedError{edge: "deliveries"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Webhook) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case webhook.FieldEvents:
+		case webhook.FieldEvents, webhook.FieldQueuedEvents:
 			values[i] = new([]byte)
 		case webhook.FieldActive:
 			values[i] = new(sql.NullBool)
-		case webhook.FieldID, webhook.FieldRetryCount, webhook.FieldSuccessCount, webhook.FieldFailureCount:
+		case webhook.FieldID, webhook.FieldOrganizationID, webhook.FieldDeliveryStartHour, webhook.FieldDeliveryEndHour, webhook.FieldBatchMaxEvents, webhook.FieldBatchWindowSeconds, webhook.FieldLeadChangeCursor, webhook.FieldRetryCount, webhook.FieldSuccessCount, webhook.FieldFailureCount:
 			values[i] = new(sql.NullInt64)
-		case webhook.FieldURL, webhook.FieldSecret, webhook.FieldDescription:
+		case webhook.FieldURL, webhook.FieldSecret, webhook.FieldSchemaVersion, webhook.FieldDescription, webhook.FieldDeliveryTimezone, webhook.FieldRedactionProfile:
 			values[i] = new(sql.NullString)
-		case webhook.FieldLastTriggeredAt, webhook.FieldCreatedAt, webhook.FieldUpdatedAt:
+		case webhook.FieldPausedAt, webhook.FieldLastTriggeredAt, webhook.FieldCreatedAt, webhook.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case webhook.ForeignKeys[0]: // user_webhooks
 			values[i] = new(sql.NullInt64)
@@ -112,6 +159,13 @@ func (_m *Webhook) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.URL = value.String
 			}
+		case webhook.FieldOrganizationID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value.Valid {
+				_m.OrganizationID = new(int)
+				*_m.OrganizationID = int(value.Int64)
+			}
 		case webhook.FieldEvents:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field events", values[i])
@@ -132,12 +186,80 @@ func (_m *Webhook) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Active = value.Bool
 			}
+		case webhook.FieldPausedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field paused_at", values[i])
+			} else if value.Valid {
+				_m.PausedAt = new(time.Time)
+				*_m.PausedAt = value.Time
+			}
+		case webhook.FieldQueuedEvents:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field queued_events", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.QueuedEvents); err != nil {
+					return fmt.Errorf("unmarshal field queued_events: %w", err)
+				}
+			}
+		case webhook.FieldSchemaVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field schema_version", values[i])
+			} else if value.Valid {
+				_m.SchemaVersion = value.String
+			}
 		case webhook.FieldDescription:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field description", values[i])
 			} else if value.Valid {
 				_m.Description = value.String
 			}
+		case webhook.FieldDeliveryTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field delivery_timezone", values[i])
+			} else if value.Valid {
+				_m.DeliveryTimezone = value.String
+			}
+		case webhook.FieldDeliveryStartHour:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field delivery_start_hour", values[i])
+			} else if value.Valid {
+				_m.DeliveryStartHour = new(int)
+				*_m.DeliveryStartHour = int(value.Int64)
+			}
+		case webhook.FieldDeliveryEndHour:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field delivery_end_hour", values[i])
+			} else if value.Valid {
+				_m.DeliveryEndHour = new(int)
+				*_m.DeliveryEndHour = int(value.Int64)
+			}
+		case webhook.FieldBatchMaxEvents:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field batch_max_events", values[i])
+			} else if value.Valid {
+				_m.BatchMaxEvents = new(int)
+				*_m.BatchMaxEvents = int(value.Int64)
+			}
+		case webhook.FieldBatchWindowSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field batch_window_seconds", values[i])
+			} else if value.Valid {
+				_m.BatchWindowSeconds = new(int)
+				*_m.BatchWindowSeconds = int(value.Int64)
+			}
+		case webhook.FieldRedactionProfile:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field redaction_profile", values[i])
+			} else if value.Valid {
+				_m.RedactionProfile = value.String
+			}
+		case webhook.FieldLeadChangeCursor:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field lead_change_cursor", values[i])
+			} else if value.Valid {
+				_m.LeadChangeCursor = new(int)
+				*_m.LeadChangeCursor = int(value.Int64)
+			}
 		case webhook.FieldRetryCount:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field retry_count", values[i])
@@ -200,6 +322,16 @@ func (_m *Webhook) QueryUser() *UserQuery {
 	return NewWebhookClient(_m.config).QueryUser(_m)
 }
 
+// QueryOrganization queries the "organization" edge of the Webhook entity.
+func (_m *Webhook) QueryOrganization() *OrganizationQuery {
+	return NewWebhookClient(_m.config).QueryOrganization(_m)
+}
+
+// QueryDeliveries queries the "deliveries" edge of the Webhook entity.
+func (_m *Webhook) QueryDeliveries() *WebhookDeliveryQuery {
+	return NewWebhookClient(_m.config).QueryDeliveries(_m)
+}
+
 // Update returns a builder for updating this Webhook.
 // Note that you need to call Webhook.Unwrap() before calling this method if this Webhook
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -226,6 +358,11 @@ func (_m *Webhook) String() string {
 	builder.WriteString("url=")
 	builder.WriteString(_m.URL)
 	builder.WriteString(", ")
+	if v := _m.OrganizationID; v != nil {
+		builder.WriteString("organization_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("events=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Events))
 	builder.WriteString(", ")
@@ -234,9 +371,51 @@ func (_m *Webhook) String() string {
 	builder.WriteString("active=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Active))
 	builder.WriteString(", ")
+	if v := _m.PausedAt; v != nil {
+		builder.WriteString("paused_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("queued_events=")
+	builder.WriteString(fmt.Sprintf("%v", _m.QueuedEvents))
+	builder.WriteString(", ")
+	builder.WriteString("schema_version=")
+	builder.WriteString(_m.SchemaVersion)
+	builder.WriteString(", ")
 	builder.WriteString("description=")
 	builder.WriteString(_m.Description)
 	builder.WriteString(", ")
+	builder.WriteString("delivery_timezone=")
+	builder.WriteString(_m.DeliveryTimezone)
+	builder.WriteString(", ")
+	if v := _m.DeliveryStartHour; v != nil {
+		builder.WriteString("delivery_start_hour=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeliveryEndHour; v != nil {
+		builder.WriteString("delivery_end_hour=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.BatchMaxEvents; v != nil {
+		builder.WriteString("batch_max_events=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.BatchWindowSeconds; v != nil {
+		builder.WriteString("batch_window_seconds=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("redaction_profile=")
+	builder.WriteString(_m.RedactionProfile)
+	builder.WriteString(", ")
+	if v := _m.LeadChangeCursor; v != nil {
+		builder.WriteString("lead_change_cursor=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("retry_count=")
 	builder.WriteString(fmt.Sprintf("%v", _m.RetryCount))
 	builder.WriteString(", ")
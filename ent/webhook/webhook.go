@@ -16,14 +16,36 @@ const (
 	FieldID = "id"
 	// FieldURL holds the string denoting the url field in the database.
 	FieldURL = "url"
+	// FieldOrganizationID holds the string denoting the organization_id field in the database.
+	FieldOrganizationID = "organization_id"
 	// FieldEvents holds the string denoting the events field in the database.
 	FieldEvents = "events"
 	// FieldSecret holds the string denoting the secret field in the database.
 	FieldSecret = "secret"
 	// FieldActive holds the string denoting the active field in the database.
 	FieldActive = "active"
+	// FieldPausedAt holds the string denoting the paused_at field in the database.
+	FieldPausedAt = "paused_at"
+	// FieldQueuedEvents holds the string denoting the queued_events field in the database.
+	FieldQueuedEvents = "queued_events"
+	// FieldSchemaVersion holds the string denoting the schema_version field in the database.
+	FieldSchemaVersion = "schema_version"
 	// FieldDescription holds the string denoting the description field in the database.
 	FieldDescription = "description"
+	// FieldDeliveryTimezone holds the string denoting the delivery_timezone field in the database.
+	FieldDeliveryTimezone = "delivery_timezone"
+	// FieldDeliveryStartHour holds the string denoting the delivery_start_hour field in the database.
+	FieldDeliveryStartHour = "delivery_start_hour"
+	// FieldDeliveryEndHour holds the string denoting the delivery_end_hour field in the database.
+	FieldDeliveryEndHour = "delivery_end_hour"
+	// FieldBatchMaxEvents holds the string denoting the batch_max_events field in the database.
+	FieldBatchMaxEvents = "batch_max_events"
+	// FieldBatchWindowSeconds holds the string denoting the batch_window_seconds field in the database.
+	FieldBatchWindowSeconds = "batch_window_seconds"
+	// FieldRedactionProfile holds the string denoting the redaction_profile field in the database.
+	FieldRedactionProfile = "redaction_profile"
+	// FieldLeadChangeCursor holds the string denoting the lead_change_cursor field in the database.
+	FieldLeadChangeCursor = "lead_change_cursor"
 	// FieldRetryCount holds the string denoting the retry_count field in the database.
 	FieldRetryCount = "retry_count"
 	// FieldLastTriggeredAt holds the string denoting the last_triggered_at field in the database.
@@ -38,6 +60,10 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
+	// EdgeOrganization holds the string denoting the organization edge name in mutations.
+	EdgeOrganization = "organization"
+	// EdgeDeliveries holds the string denoting the deliveries edge name in mutations.
+	EdgeDeliveries = "deliveries"
 	// Table holds the table name of the webhook in the database.
 	Table = "webhooks"
 	// UserTable is the table that holds the user relation/edge.
@@ -47,16 +73,41 @@ const (
 	UserInverseTable = "users"
 	// UserColumn is the table column denoting the user relation/edge.
 	UserColumn = "user_webhooks"
+	// OrganizationTable is the table that holds the organization relation/edge.
+	OrganizationTable = "webhooks"
+	// OrganizationInverseTable is the table name for the Organization entity.
+	// It exists in this package in order to avoid circular dependency with the "organization" package.
+	OrganizationInverseTable = "organizations"
+	// OrganizationColumn is the table column denoting the organization relation/edge.
+	OrganizationColumn = "organization_id"
+	// DeliveriesTable is the table that holds the deliveries relation/edge.
+	DeliveriesTable = "webhook_deliveries"
+	// DeliveriesInverseTable is the table name for the WebhookDelivery entity.
+	// It exists in this package in order to avoid circular dependency with the "webhookdelivery" package.
+	DeliveriesInverseTable = "webhook_deliveries"
+	// DeliveriesColumn is the table column denoting the deliveries relation/edge.
+	DeliveriesColumn = "webhook_id"
 )
 
 // Columns holds all SQL columns for webhook fields.
 var Columns = []string{
 	FieldID,
 	FieldURL,
+	FieldOrganizationID,
 	FieldEvents,
 	FieldSecret,
 	FieldActive,
+	FieldPausedAt,
+	FieldQueuedEvents,
+	FieldSchemaVersion,
 	FieldDescription,
+	FieldDeliveryTimezone,
+	FieldDeliveryStartHour,
+	FieldDeliveryEndHour,
+	FieldBatchMaxEvents,
+	FieldBatchWindowSeconds,
+	FieldRedactionProfile,
+	FieldLeadChangeCursor,
 	FieldRetryCount,
 	FieldLastTriggeredAt,
 	FieldSuccessCount,
@@ -91,6 +142,16 @@ var (
 	URLValidator func(string) error
 	// DefaultActive holds the default value on creation for the "active" field.
 	DefaultActive bool
+	// DefaultSchemaVersion holds the default value on creation for the "schema_version" field.
+	DefaultSchemaVersion string
+	// DeliveryStartHourValidator is a validator for the "delivery_start_hour" field. It is called by the builders before save.
+	DeliveryStartHourValidator func(int) error
+	// DeliveryEndHourValidator is a validator for the "delivery_end_hour" field. It is called by the builders before save.
+	DeliveryEndHourValidator func(int) error
+	// BatchMaxEventsValidator is a validator for the "batch_max_events" field. It is called by the builders before save.
+	BatchMaxEventsValidator func(int) error
+	// BatchWindowSecondsValidator is a validator for the "batch_window_seconds" field. It is called by the builders before save.
+	BatchWindowSecondsValidator func(int) error
 	// DefaultRetryCount holds the default value on creation for the "retry_count" field.
 	DefaultRetryCount int
 	// DefaultSuccessCount holds the default value on creation for the "success_count" field.
@@ -118,6 +179,11 @@ func ByURL(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldURL, opts...).ToFunc()
 }
 
+// ByOrganizationID orders the results by the organization_id field.
+func ByOrganizationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrganizationID, opts...).ToFunc()
+}
+
 // BySecret orders the results by the secret field.
 func BySecret(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSecret, opts...).ToFunc()
@@ -128,11 +194,56 @@ func ByActive(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldActive, opts...).ToFunc()
 }
 
+// ByPausedAt orders the results by the paused_at field.
+func ByPausedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPausedAt, opts...).ToFunc()
+}
+
+// BySchemaVersion orders the results by the schema_version field.
+func BySchemaVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSchemaVersion, opts...).ToFunc()
+}
+
 // ByDescription orders the results by the description field.
 func ByDescription(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDescription, opts...).ToFunc()
 }
 
+// ByDeliveryTimezone orders the results by the delivery_timezone field.
+func ByDeliveryTimezone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveryTimezone, opts...).ToFunc()
+}
+
+// ByDeliveryStartHour orders the results by the delivery_start_hour field.
+func ByDeliveryStartHour(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveryStartHour, opts...).ToFunc()
+}
+
+// ByDeliveryEndHour orders the results by the delivery_end_hour field.
+func ByDeliveryEndHour(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveryEndHour, opts...).ToFunc()
+}
+
+// ByBatchMaxEvents orders the results by the batch_max_events field.
+func ByBatchMaxEvents(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBatchMaxEvents, opts...).ToFunc()
+}
+
+// ByBatchWindowSeconds orders the results by the batch_window_seconds field.
+func ByBatchWindowSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBatchWindowSeconds, opts...).ToFunc()
+}
+
+// ByRedactionProfile orders the results by the redaction_profile field.
+func ByRedactionProfile(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRedactionProfile, opts...).ToFunc()
+}
+
+// ByLeadChangeCursor orders the results by the lead_change_cursor field.
+func ByLeadChangeCursor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLeadChangeCursor, opts...).ToFunc()
+}
+
 // ByRetryCount orders the results by the retry_count field.
 func ByRetryCount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRetryCount, opts...).ToFunc()
@@ -169,6 +280,27 @@ func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByOrganizationField orders the results by organization field.
+func ByOrganizationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrganizationStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByDeliveriesCount orders the results by deliveries count.
+func ByDeliveriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDeliveriesStep(), opts...)
+	}
+}
+
+// ByDeliveries orders the results by deliveries terms.
+func ByDeliveries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDeliveriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -176,3 +308,17 @@ func newUserStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
 	)
 }
+func newOrganizationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrganizationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, OrganizationTable, OrganizationColumn),
+	)
+}
+func newDeliveriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DeliveriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DeliveriesTable, DeliveriesColumn),
+	)
+}
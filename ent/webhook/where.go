@@ -60,6 +60,11 @@ func URL(v string) predicate.Webhook {
 	return predicate.Webhook(sql.FieldEQ(FieldURL, v))
 }
 
+// OrganizationID applies equality check predicate on the "organization_id" field. It's identical to OrganizationIDEQ.
+func OrganizationID(v int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldEQ(FieldOrganizationID, v))
+}
+
 // Secret applies equality check predicate on the "secret" field. It's identical to SecretEQ.
 func Secret(v string) predicate.Webhook {
 	return predicate.Webhook(sql.FieldEQ(FieldSecret, v))
@@ -70,11 +75,56 @@ func Active(v bool) predicate.Webhook {
 	return predicate.Webhook(sql.FieldEQ(FieldActive, v))
 }
 
+// PausedAt applies equality check predicate on the "paused_at" field. It's identical to PausedAtEQ.
+func PausedAt(v time.Time) predicate.Webhook {
+	return predicate.Webhook(sql.FieldEQ(FieldPausedAt, v))
+}
+
+// SchemaVersion applies equality check predicate on the "schema_version" field. It's identical to SchemaVersionEQ.
+func SchemaVersion(v string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldEQ(FieldSchemaVersion, v))
+}
+
 // Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
 func Description(v string) predicate.Webhook {
 	return predicate.Webhook(sql.FieldEQ(FieldDescription, v))
 }
 
+// DeliveryTimezone applies equality check predicate on the "delivery_timezone" field. It's identical to DeliveryTimezoneEQ.
+func DeliveryTimezone(v string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldEQ(FieldDeliveryTimezone, v))
+}
+
+// DeliveryStartHour applies equality check predicate on the "delivery_start_hour" field. It's identical to DeliveryStartHourEQ.
+func DeliveryStartHour(v int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldEQ(FieldDeliveryStartHour, v))
+}
+
+// DeliveryEndHour applies equality check predicate on the "delivery_end_hour" field. It's identical to DeliveryEndHourEQ.
+func DeliveryEndHour(v int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldEQ(FieldDeliveryEndHour, v))
+}
+
+// BatchMaxEvents applies equality check predicate on the "batch_max_events" field. It's identical to BatchMaxEventsEQ.
+func BatchMaxEvents(v int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldEQ(FieldBatchMaxEvents, v))
+}
+
+// BatchWindowSeconds applies equality check predicate on the "batch_window_seconds" field. It's identical to BatchWindowSecondsEQ.
+func BatchWindowSeconds(v int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldEQ(FieldBatchWindowSeconds, v))
+}
+
+// RedactionProfile applies equality check predicate on the "redaction_profile" field. It's identical to RedactionProfileEQ.
+func RedactionProfile(v string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldEQ(FieldRedactionProfile, v))
+}
+
+// LeadChangeCursor applies equality check predicate on the "lead_change_cursor" field. It's identical to LeadChangeCursorEQ.
+func LeadChangeCursor(v int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldEQ(FieldLeadChangeCursor, v))
+}
+
 // RetryCount applies equality check predicate on the "retry_count" field. It's identical to RetryCountEQ.
 func RetryCount(v int) predicate.Webhook {
 	return predicate.Webhook(sql.FieldEQ(FieldRetryCount, v))
@@ -170,6 +220,36 @@ func URLContainsFold(v string) predicate.Webhook {
 	return predicate.Webhook(sql.FieldContainsFold(FieldURL, v))
 }
 
+// OrganizationIDEQ applies the EQ predicate on the "organization_id" field.
+func OrganizationIDEQ(v int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDNEQ applies the NEQ predicate on the "organization_id" field.
+func OrganizationIDNEQ(v int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldNEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDIn applies the In predicate on the "organization_id" field.
+func OrganizationIDIn(vs ...int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDNotIn applies the NotIn predicate on the "organization_id" field.
+func OrganizationIDNotIn(vs ...int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldNotIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDIsNil applies the IsNil predicate on the "organization_id" field.
+func OrganizationIDIsNil() predicate.Webhook {
+	return predicate.Webhook(sql.FieldIsNull(FieldOrganizationID))
+}
+
+// OrganizationIDNotNil applies the NotNil predicate on the "organization_id" field.
+func OrganizationIDNotNil() predicate.Webhook {
+	return predicate.Webhook(sql.FieldNotNull(FieldOrganizationID))
+}
+
 // SecretEQ applies the EQ predicate on the "secret" field.
 func SecretEQ(v string) predicate.Webhook {
 	return predicate.Webhook(sql.FieldEQ(FieldSecret, v))
@@ -245,6 +325,131 @@ func ActiveNEQ(v bool) predicate.Webhook {
 	return predicate.Webhook(sql.FieldNEQ(FieldActive, v))
 }
 
+// PausedAtEQ applies the EQ predicate on the "paused_at" field.
+func PausedAtEQ(v time.Time) predicate.Webhook {
+	return predicate.Webhook(sql.FieldEQ(FieldPausedAt, v))
+}
+
+// PausedAtNEQ applies the NEQ predicate on the "paused_at" field.
+func PausedAtNEQ(v time.Time) predicate.Webhook {
+	return predicate.Webhook(sql.FieldNEQ(FieldPausedAt, v))
+}
+
+// PausedAtIn applies the In predicate on the "paused_at" field.
+func PausedAtIn(vs ...time.Time) predicate.Webhook {
+	return predicate.Webhook(sql.FieldIn(FieldPausedAt, vs...))
+}
+
+// PausedAtNotIn applies the NotIn predicate on the "paused_at" field.
+func PausedAtNotIn(vs ...time.Time) predicate.Webhook {
+	return predicate.Webhook(sql.FieldNotIn(FieldPausedAt, vs...))
+}
+
+// PausedAtGT applies the GT predicate on the "paused_at" field.
+func PausedAtGT(v time.Time) predicate.Webhook {
+	return predicate.Webhook(sql.FieldGT(FieldPausedAt, v))
+}
+
+// PausedAtGTE applies the GTE predicate on the "paused_at" field.
+func PausedAtGTE(v time.Time) predicate.Webhook {
+	return predicate.Webhook(sql.FieldGTE(FieldPausedAt, v))
+}
+
+// PausedAtLT applies the LT predicate on the "paused_at" field.
+func PausedAtLT(v time.Time) predicate.Webhook {
+	return predicate.Webhook(sql.FieldLT(FieldPausedAt, v))
+}
+
+// PausedAtLTE applies the LTE predicate on the "paused_at" field.
+func PausedAtLTE(v time.Time) predicate.Webhook {
+	return predicate.Webhook(sql.FieldLTE(FieldPausedAt, v))
+}
+
+// PausedAtIsNil applies the IsNil predicate on the "paused_at" field.
+func PausedAtIsNil() predicate.Webhook {
+	return predicate.Webhook(sql.FieldIsNull(FieldPausedAt))
+}
+
+// PausedAtNotNil applies the NotNil predicate on the "paused_at" field.
+func PausedAtNotNil() predicate.Webhook {
+	return predicate.Webhook(sql.FieldNotNull(FieldPausedAt))
+}
+
+// QueuedEventsIsNil applies the IsNil predicate on the "queued_events" field.
+func QueuedEventsIsNil() predicate.Webhook {
+	return predicate.Webhook(sql.FieldIsNull(FieldQueuedEvents))
+}
+
+// QueuedEventsNotNil applies the NotNil predicate on the "queued_events" field.
+func QueuedEventsNotNil() predicate.Webhook {
+	return predicate.Webhook(sql.FieldNotNull(FieldQueuedEvents))
+}
+
+// SchemaVersionEQ applies the EQ predicate on the "schema_version" field.
+func SchemaVersionEQ(v string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldEQ(FieldSchemaVersion, v))
+}
+
+// SchemaVersionNEQ applies the NEQ predicate on the "schema_version" field.
+func SchemaVersionNEQ(v string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldNEQ(FieldSchemaVersion, v))
+}
+
+// SchemaVersionIn applies the In predicate on the "schema_version" field.
+func SchemaVersionIn(vs ...string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldIn(FieldSchemaVersion, vs...))
+}
+
+// SchemaVersionNotIn applies the NotIn predicate on the "schema_version" field.
+func SchemaVersionNotIn(vs ...string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldNotIn(FieldSchemaVersion, vs...))
+}
+
+// SchemaVersionGT applies the GT predicate on the "schema_version" field.
+func SchemaVersionGT(v string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldGT(FieldSchemaVersion, v))
+}
+
+// SchemaVersionGTE applies the GTE predicate on the "schema_version" field.
+func SchemaVersionGTE(v string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldGTE(FieldSchemaVersion, v))
+}
+
+// SchemaVersionLT applies the LT predicate on the "schema_version" field.
+func SchemaVersionLT(v string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldLT(FieldSchemaVersion, v))
+}
+
+// SchemaVersionLTE applies the LTE predicate on the "schema_version" field.
+func SchemaVersionLTE(v string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldLTE(FieldSchemaVersion, v))
+}
+
+// SchemaVersionContains applies the Contains predicate on the "schema_version" field.
+func SchemaVersionContains(v string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldContains(FieldSchemaVersion, v))
+}
+
+// SchemaVersionHasPrefix applies the HasPrefix predicate on the "schema_version" field.
+func SchemaVersionHasPrefix(v string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldHasPrefix(FieldSchemaVersion, v))
+}
+
+// SchemaVersionHasSuffix applies the HasSuffix predicate on the "schema_version" field.
+func SchemaVersionHasSuffix(v string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldHasSuffix(FieldSchemaVersion, v))
+}
+
+// SchemaVersionEqualFold applies the EqualFold predicate on the "schema_version" field.
+func SchemaVersionEqualFold(v string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldEqualFold(FieldSchemaVersion, v))
+}
+
+// SchemaVersionContainsFold applies the ContainsFold predicate on the "schema_version" field.
+func SchemaVersionContainsFold(v string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldContainsFold(FieldSchemaVersion, v))
+}
+
 // DescriptionEQ applies the EQ predicate on the "description" field.
 func DescriptionEQ(v string) predicate.Webhook {
 	return predicate.Webhook(sql.FieldEQ(FieldDescription, v))
@@ -320,6 +525,406 @@ func DescriptionContainsFold(v string) predicate.Webhook {
 	return predicate.Webhook(sql.FieldContainsFold(FieldDescription, v))
 }
 
+// DeliveryTimezoneEQ applies the EQ predicate on the "delivery_timezone" field.
+func DeliveryTimezoneEQ(v string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldEQ(FieldDeliveryTimezone, v))
+}
+
+// DeliveryTimezoneNEQ applies the NEQ predicate on the "delivery_timezone" field.
+func DeliveryTimezoneNEQ(v string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldNEQ(FieldDeliveryTimezone, v))
+}
+
+// DeliveryTimezoneIn applies the In predicate on the "delivery_timezone" field.
+func DeliveryTimezoneIn(vs ...string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldIn(FieldDeliveryTimezone, vs...))
+}
+
+// DeliveryTimezoneNotIn applies the NotIn predicate on the "delivery_timezone" field.
+func DeliveryTimezoneNotIn(vs ...string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldNotIn(FieldDeliveryTimezone, vs...))
+}
+
+// DeliveryTimezoneGT applies the GT predicate on the "delivery_timezone" field.
+func DeliveryTimezoneGT(v string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldGT(FieldDeliveryTimezone, v))
+}
+
+// DeliveryTimezoneGTE applies the GTE predicate on the "delivery_timezone" field.
+func DeliveryTimezoneGTE(v string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldGTE(FieldDeliveryTimezone, v))
+}
+
+// DeliveryTimezoneLT applies the LT predicate on the "delivery_timezone" field.
+func DeliveryTimezoneLT(v string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldLT(FieldDeliveryTimezone, v))
+}
+
+// DeliveryTimezoneLTE applies the LTE predicate on the "delivery_timezone" field.
+func DeliveryTimezoneLTE(v string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldLTE(FieldDeliveryTimezone, v))
+}
+
+// DeliveryTimezoneContains applies the Contains predicate on the "delivery_timezone" field.
+func DeliveryTimezoneContains(v string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldContains(FieldDeliveryTimezone, v))
+}
+
+// DeliveryTimezoneHasPrefix applies the HasPrefix predicate on the "delivery_timezone" field.
+func DeliveryTimezoneHasPrefix(v string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldHasPrefix(FieldDeliveryTimezone, v))
+}
+
+// DeliveryTimezoneHasSuffix applies the HasSuffix predicate on the "delivery_timezone" field.
+func DeliveryTimezoneHasSuffix(v string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldHasSuffix(FieldDeliveryTimezone, v))
+}
+
+// DeliveryTimezoneIsNil applies the IsNil predicate on the "delivery_timezone" field.
+func DeliveryTimezoneIsNil() predicate.Webhook {
+	return predicate.Webhook(sql.FieldIsNull(FieldDeliveryTimezone))
+}
+
+// DeliveryTimezoneNotNil applies the NotNil predicate on the "delivery_timezone" field.
+func DeliveryTimezoneNotNil() predicate.Webhook {
+	return predicate.Webhook(sql.FieldNotNull(FieldDeliveryTimezone))
+}
+
+// DeliveryTimezoneEqualFold applies the EqualFold predicate on the "delivery_timezone" field.
+func DeliveryTimezoneEqualFold(v string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldEqualFold(FieldDeliveryTimezone, v))
+}
+
+// DeliveryTimezoneContainsFold applies the ContainsFold predicate on the "delivery_timezone" field.
+func DeliveryTimezoneContainsFold(v string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldContainsFold(FieldDeliveryTimezone, v))
+}
+
+// DeliveryStartHourEQ applies the EQ predicate on the "delivery_start_hour" field.
+func DeliveryStartHourEQ(v int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldEQ(FieldDeliveryStartHour, v))
+}
+
+// DeliveryStartHourNEQ applies the NEQ predicate on the "delivery_start_hour" field.
+func DeliveryStartHourNEQ(v int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldNEQ(FieldDeliveryStartHour, v))
+}
+
+// DeliveryStartHourIn applies the In predicate on the "delivery_start_hour" field.
+func DeliveryStartHourIn(vs ...int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldIn(FieldDeliveryStartHour, vs...))
+}
+
+// DeliveryStartHourNotIn applies the NotIn predicate on the "delivery_start_hour" field.
+func DeliveryStartHourNotIn(vs ...int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldNotIn(FieldDeliveryStartHour, vs...))
+}
+
+// DeliveryStartHourGT applies the GT predicate on the "delivery_start_hour" field.
+func DeliveryStartHourGT(v int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldGT(FieldDeliveryStartHour, v))
+}
+
+// DeliveryStartHourGTE applies the GTE predicate on the "delivery_start_hour" field.
+func DeliveryStartHourGTE(v int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldGTE(FieldDeliveryStartHour, v))
+}
+
+// DeliveryStartHourLT applies the LT predicate on the "delivery_start_hour" field.
+func DeliveryStartHourLT(v int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldLT(FieldDeliveryStartHour, v))
+}
+
+// DeliveryStartHourLTE applies the LTE predicate on the "delivery_start_hour" field.
+func DeliveryStartHourLTE(v int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldLTE(FieldDeliveryStartHour, v))
+}
+
+// DeliveryStartHourIsNil applies the IsNil predicate on the "delivery_start_hour" field.
+func DeliveryStartHourIsNil() predicate.Webhook {
+	return predicate.Webhook(sql.FieldIsNull(FieldDeliveryStartHour))
+}
+
+// DeliveryStartHourNotNil applies the NotNil predicate on the "delivery_start_hour" field.
+func DeliveryStartHourNotNil() predicate.Webhook {
+	return predicate.Webhook(sql.FieldNotNull(FieldDeliveryStartHour))
+}
+
+// DeliveryEndHourEQ applies the EQ predicate on the "delivery_end_hour" field.
+func DeliveryEndHourEQ(v int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldEQ(FieldDeliveryEndHour, v))
+}
+
+// DeliveryEndHourNEQ applies the NEQ predicate on the "delivery_end_hour" field.
+func DeliveryEndHourNEQ(v int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldNEQ(FieldDeliveryEndHour, v))
+}
+
+// DeliveryEndHourIn applies the In predicate on the "delivery_end_hour" field.
+func DeliveryEndHourIn(vs ...int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldIn(FieldDeliveryEndHour, vs...))
+}
+
+// DeliveryEndHourNotIn applies the NotIn predicate on the "delivery_end_hour" field.
+func DeliveryEndHourNotIn(vs ...int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldNotIn(FieldDeliveryEndHour, vs...))
+}
+
+// DeliveryEndHourGT applies the GT predicate on the "delivery_end_hour" field.
+func DeliveryEndHourGT(v int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldGT(FieldDeliveryEndHour, v))
+}
+
+// DeliveryEndHourGTE applies the GTE predicate on the "delivery_end_hour" field.
+func DeliveryEndHourGTE(v int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldGTE(FieldDeliveryEndHour, v))
+}
+
+// DeliveryEndHourLT applies the LT predicate on the "delivery_end_hour" field.
+func DeliveryEndHourLT(v int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldLT(FieldDeliveryEndHour, v))
+}
+
+// DeliveryEndHourLTE applies the LTE predicate on the "delivery_end_hour" field.
+func DeliveryEndHourLTE(v int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldLTE(FieldDeliveryEndHour, v))
+}
+
+// DeliveryEndHourIsNil applies the IsNil predicate on the "delivery_end_hour" field.
+func DeliveryEndHourIsNil() predicate.Webhook {
+	return predicate.Webhook(sql.FieldIsNull(FieldDeliveryEndHour))
+}
+
+// DeliveryEndHourNotNil applies the NotNil predicate on the "delivery_end_hour" field.
+func DeliveryEndHourNotNil() predicate.Webhook {
+	return predicate.Webhook(sql.FieldNotNull(FieldDeliveryEndHour))
+}
+
+// BatchMaxEventsEQ applies the EQ predicate on the "batch_max_events" field.
+func BatchMaxEventsEQ(v int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldEQ(FieldBatchMaxEvents, v))
+}
+
+// BatchMaxEventsNEQ applies the NEQ predicate on the "batch_max_events" field.
+func BatchMaxEventsNEQ(v int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldNEQ(FieldBatchMaxEvents, v))
+}
+
+// BatchMaxEventsIn applies the In predicate on the "batch_max_events" field.
+func BatchMaxEventsIn(vs ...int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldIn(FieldBatchMaxEvents, vs...))
+}
+
+// BatchMaxEventsNotIn applies the NotIn predicate on the "batch_max_events" field.
+func BatchMaxEventsNotIn(vs ...int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldNotIn(FieldBatchMaxEvents, vs...))
+}
+
+// BatchMaxEventsGT applies the GT predicate on the "batch_max_events" field.
+func BatchMaxEventsGT(v int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldGT(FieldBatchMaxEvents, v))
+}
+
+// BatchMaxEventsGTE applies the GTE predicate on the "batch_max_events" field.
+func BatchMaxEventsGTE(v int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldGTE(FieldBatchMaxEvents, v))
+}
+
+// BatchMaxEventsLT applies the LT predicate on the "batch_max_events" field.
+func BatchMaxEventsLT(v int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldLT(FieldBatchMaxEvents, v))
+}
+
+// BatchMaxEventsLTE applies the LTE predicate on the "batch_max_events" field.
+func BatchMaxEventsLTE(v int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldLTE(FieldBatchMaxEvents, v))
+}
+
+// BatchMaxEventsIsNil applies the IsNil predicate on the "batch_max_events" field.
+func BatchMaxEventsIsNil() predicate.Webhook {
+	return predicate.Webhook(sql.FieldIsNull(FieldBatchMaxEvents))
+}
+
+// BatchMaxEventsNotNil applies the NotNil predicate on the "batch_max_events" field.
+func BatchMaxEventsNotNil() predicate.Webhook {
+	return predicate.Webhook(sql.FieldNotNull(FieldBatchMaxEvents))
+}
+
+// BatchWindowSecondsEQ applies the EQ predicate on the "batch_window_seconds" field.
+func BatchWindowSecondsEQ(v int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldEQ(FieldBatchWindowSeconds, v))
+}
+
+// BatchWindowSecondsNEQ applies the NEQ predicate on the "batch_window_seconds" field.
+func BatchWindowSecondsNEQ(v int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldNEQ(FieldBatchWindowSeconds, v))
+}
+
+// BatchWindowSecondsIn applies the In predicate on the "batch_window_seconds" field.
+func BatchWindowSecondsIn(vs ...int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldIn(FieldBatchWindowSeconds, vs...))
+}
+
+// BatchWindowSecondsNotIn applies the NotIn predicate on the "batch_window_seconds" field.
+func BatchWindowSecondsNotIn(vs ...int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldNotIn(FieldBatchWindowSeconds, vs...))
+}
+
+// BatchWindowSecondsGT applies the GT predicate on the "batch_window_seconds" field.
+func BatchWindowSecondsGT(v int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldGT(FieldBatchWindowSeconds, v))
+}
+
+// BatchWindowSecondsGTE applies the GTE predicate on the "batch_window_seconds" field.
+func BatchWindowSecondsGTE(v int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldGTE(FieldBatchWindowSeconds, v))
+}
+
+// BatchWindowSecondsLT applies the LT predicate on the "batch_window_seconds" field.
+func BatchWindowSecondsLT(v int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldLT(FieldBatchWindowSeconds, v))
+}
+
+// BatchWindowSecondsLTE applies the LTE predicate on the "batch_window_seconds" field.
+func BatchWindowSecondsLTE(v int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldLTE(FieldBatchWindowSeconds, v))
+}
+
+// BatchWindowSecondsIsNil applies the IsNil predicate on the "batch_window_seconds" field.
+func BatchWindowSecondsIsNil() predicate.Webhook {
+	return predicate.Webhook(sql.FieldIsNull(FieldBatchWindowSeconds))
+}
+
+// BatchWindowSecondsNotNil applies the NotNil predicate on the "batch_window_seconds" field.
+func BatchWindowSecondsNotNil() predicate.Webhook {
+	return predicate.Webhook(sql.FieldNotNull(FieldBatchWindowSeconds))
+}
+
+// RedactionProfileEQ applies the EQ predicate on the "redaction_profile" field.
+func RedactionProfileEQ(v string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldEQ(FieldRedactionProfile, v))
+}
+
+// RedactionProfileNEQ applies the NEQ predicate on the "redaction_profile" field.
+func RedactionProfileNEQ(v string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldNEQ(FieldRedactionProfile, v))
+}
+
+// RedactionProfileIn applies the In predicate on the "redaction_profile" field.
+func RedactionProfileIn(vs ...string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldIn(FieldRedactionProfile, vs...))
+}
+
+// RedactionProfileNotIn applies the NotIn predicate on the "redaction_profile" field.
+func RedactionProfileNotIn(vs ...string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldNotIn(FieldRedactionProfile, vs...))
+}
+
+// RedactionProfileGT applies the GT predicate on the "redaction_profile" field.
+func RedactionProfileGT(v string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldGT(FieldRedactionProfile, v))
+}
+
+// RedactionProfileGTE applies the GTE predicate on the "redaction_profile" field.
+func RedactionProfileGTE(v string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldGTE(FieldRedactionProfile, v))
+}
+
+// RedactionProfileLT applies the LT predicate on the "redaction_profile" field.
+func RedactionProfileLT(v string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldLT(FieldRedactionProfile, v))
+}
+
+// RedactionProfileLTE applies the LTE predicate on the "redaction_profile" field.
+func RedactionProfileLTE(v string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldLTE(FieldRedactionProfile, v))
+}
+
+// RedactionProfileContains applies the Contains predicate on the "redaction_profile" field.
+func RedactionProfileContains(v string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldContains(FieldRedactionProfile, v))
+}
+
+// RedactionProfileHasPrefix applies the HasPrefix predicate on the "redaction_profile" field.
+func RedactionProfileHasPrefix(v string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldHasPrefix(FieldRedactionProfile, v))
+}
+
+// RedactionProfileHasSuffix applies the HasSuffix predicate on the "redaction_profile" field.
+func RedactionProfileHasSuffix(v string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldHasSuffix(FieldRedactionProfile, v))
+}
+
+// RedactionProfileIsNil applies the IsNil predicate on the "redaction_profile" field.
+func RedactionProfileIsNil() predicate.Webhook {
+	return predicate.Webhook(sql.FieldIsNull(FieldRedactionProfile))
+}
+
+// RedactionProfileNotNil applies the NotNil predicate on the "redaction_profile" field.
+func RedactionProfileNotNil() predicate.Webhook {
+	return predicate.Webhook(sql.FieldNotNull(FieldRedactionProfile))
+}
+
+// RedactionProfileEqualFold applies the EqualFold predicate on the "redaction_profile" field.
+func RedactionProfileEqualFold(v string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldEqualFold(FieldRedactionProfile, v))
+}
+
+// RedactionProfileContainsFold applies the ContainsFold predicate on the "redaction_profile" field.
+func RedactionProfileContainsFold(v string) predicate.Webhook {
+	return predicate.Webhook(sql.FieldContainsFold(FieldRedactionProfile, v))
+}
+
+// LeadChangeCursorEQ applies the EQ predicate on the "lead_change_cursor" field.
+func LeadChangeCursorEQ(v int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldEQ(FieldLeadChangeCursor, v))
+}
+
+// LeadChangeCursorNEQ applies the NEQ predicate on the "lead_change_cursor" field.
+func LeadChangeCursorNEQ(v int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldNEQ(FieldLeadChangeCursor, v))
+}
+
+// LeadChangeCursorIn applies the In predicate on the "lead_change_cursor" field.
+func LeadChangeCursorIn(vs ...int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldIn(FieldLeadChangeCursor, vs...))
+}
+
+// LeadChangeCursorNotIn applies the NotIn predicate on the "lead_change_cursor" field.
+func LeadChangeCursorNotIn(vs ...int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldNotIn(FieldLeadChangeCursor, vs...))
+}
+
+// LeadChangeCursorGT applies the GT predicate on the "lead_change_cursor" field.
+func LeadChangeCursorGT(v int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldGT(FieldLeadChangeCursor, v))
+}
+
+// LeadChangeCursorGTE applies the GTE predicate on the "lead_change_cursor" field.
+func LeadChangeCursorGTE(v int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldGTE(FieldLeadChangeCursor, v))
+}
+
+// LeadChangeCursorLT applies the LT predicate on the "lead_change_cursor" field.
+func LeadChangeCursorLT(v int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldLT(FieldLeadChangeCursor, v))
+}
+
+// LeadChangeCursorLTE applies the LTE predicate on the "lead_change_cursor" field.
+func LeadChangeCursorLTE(v int) predicate.Webhook {
+	return predicate.Webhook(sql.FieldLTE(FieldLeadChangeCursor, v))
+}
+
+// LeadChangeCursorIsNil applies the IsNil predicate on the "lead_change_cursor" field.
+func LeadChangeCursorIsNil() predicate.Webhook {
+	return predicate.Webhook(sql.FieldIsNull(FieldLeadChangeCursor))
+}
+
+// LeadChangeCursorNotNil applies the NotNil predicate on the "lead_change_cursor" field.
+func LeadChangeCursorNotNil() predicate.Webhook {
+	return predicate.Webhook(sql.FieldNotNull(FieldLeadChangeCursor))
+}
+
 // RetryCountEQ applies the EQ predicate on the "retry_count" field.
 func RetryCountEQ(v int) predicate.Webhook {
 	return predicate.Webhook(sql.FieldEQ(FieldRetryCount, v))
@@ -593,6 +1198,52 @@ func HasUserWith(preds ...predicate.User) predicate.Webhook {
 	})
 }
 
+// HasOrganization applies the HasEdge predicate on the "organization" edge.
+func HasOrganization() predicate.Webhook {
+	return predicate.Webhook(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrganizationWith applies the HasEdge predicate on the "organization" edge with a given conditions (other predicates).
+func HasOrganizationWith(preds ...predicate.Organization) predicate.Webhook {
+	return predicate.Webhook(func(s *sql.Selector) {
+		step := newOrganizationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDeliveries applies the HasEdge predicate on the "deliveries" edge.
+func HasDeliveries() predicate.Webhook {
+	return predicate.Webhook(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DeliveriesTable, DeliveriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDeliveriesWith applies the HasEdge predicate on the "deliveries" edge with a given conditions (other predicates).
+func HasDeliveriesWith(preds ...predicate.WebhookDelivery) predicate.Webhook {
+	return predicate.Webhook(func(s *sql.Selector) {
+		step := newDeliveriesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Webhook) predicate.Webhook {
 	return predicate.Webhook(sql.AndPredicates(predicates...))
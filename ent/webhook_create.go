@@ -10,8 +10,10 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/organization"
 	"github.com/jordanlanch/industrydb/ent/user"
 	"github.com/jordanlanch/industrydb/ent/webhook"
+	"github.com/jordanlanch/industrydb/ent/webhookdelivery"
 )
 
 // WebhookCreate is the builder for creating a Webhook entity.
@@ -27,6 +29,20 @@ func (_c *WebhookCreate) SetURL(v string) *WebhookCreate {
 	return _c
 }
 
+// SetOrganizationID sets the "organization_id" field.
+func (_c *WebhookCreate) SetOrganizationID(v int) *WebhookCreate {
+	_c.mutation.SetOrganizationID(v)
+	return _c
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_c *WebhookCreate) SetNillableOrganizationID(v *int) *WebhookCreate {
+	if v != nil {
+		_c.SetOrganizationID(*v)
+	}
+	return _c
+}
+
 // SetEvents sets the "events" field.
 func (_c *WebhookCreate) SetEvents(v []string) *WebhookCreate {
 	_c.mutation.SetEvents(v)
@@ -53,6 +69,40 @@ func (_c *WebhookCreate) SetNillableActive(v *bool) *WebhookCreate {
 	return _c
 }
 
+// SetPausedAt sets the "paused_at" field.
+func (_c *WebhookCreate) SetPausedAt(v time.Time) *WebhookCreate {
+	_c.mutation.SetPausedAt(v)
+	return _c
+}
+
+// SetNillablePausedAt sets the "paused_at" field if the given value is not nil.
+func (_c *WebhookCreate) SetNillablePausedAt(v *time.Time) *WebhookCreate {
+	if v != nil {
+		_c.SetPausedAt(*v)
+	}
+	return _c
+}
+
+// SetQueuedEvents sets the "queued_events" field.
+func (_c *WebhookCreate) SetQueuedEvents(v []map[string]interface{}) *WebhookCreate {
+	_c.mutation.SetQueuedEvents(v)
+	return _c
+}
+
+// SetSchemaVersion sets the "schema_version" field.
+func (_c *WebhookCreate) SetSchemaVersion(v string) *WebhookCreate {
+	_c.mutation.SetSchemaVersion(v)
+	return _c
+}
+
+// SetNillableSchemaVersion sets the "schema_version" field if the given value is not nil.
+func (_c *WebhookCreate) SetNillableSchemaVersion(v *string) *WebhookCreate {
+	if v != nil {
+		_c.SetSchemaVersion(*v)
+	}
+	return _c
+}
+
 // SetDescription sets the "description" field.
 func (_c *WebhookCreate) SetDescription(v string) *WebhookCreate {
 	_c.mutation.SetDescription(v)
@@ -67,6 +117,104 @@ func (_c *WebhookCreate) SetNillableDescription(v *string) *WebhookCreate {
 	return _c
 }
 
+// SetDeliveryTimezone sets the "delivery_timezone" field.
+func (_c *WebhookCreate) SetDeliveryTimezone(v string) *WebhookCreate {
+	_c.mutation.SetDeliveryTimezone(v)
+	return _c
+}
+
+// SetNillableDeliveryTimezone sets the "delivery_timezone" field if the given value is not nil.
+func (_c *WebhookCreate) SetNillableDeliveryTimezone(v *string) *WebhookCreate {
+	if v != nil {
+		_c.SetDeliveryTimezone(*v)
+	}
+	return _c
+}
+
+// SetDeliveryStartHour sets the "delivery_start_hour" field.
+func (_c *WebhookCreate) SetDeliveryStartHour(v int) *WebhookCreate {
+	_c.mutation.SetDeliveryStartHour(v)
+	return _c
+}
+
+// SetNillableDeliveryStartHour sets the "delivery_start_hour" field if the given value is not nil.
+func (_c *WebhookCreate) SetNillableDeliveryStartHour(v *int) *WebhookCreate {
+	if v != nil {
+		_c.SetDeliveryStartHour(*v)
+	}
+	return _c
+}
+
+// SetDeliveryEndHour sets the "delivery_end_hour" field.
+func (_c *WebhookCreate) SetDeliveryEndHour(v int) *WebhookCreate {
+	_c.mutation.SetDeliveryEndHour(v)
+	return _c
+}
+
+// SetNillableDeliveryEndHour sets the "delivery_end_hour" field if the given value is not nil.
+func (_c *WebhookCreate) SetNillableDeliveryEndHour(v *int) *WebhookCreate {
+	if v != nil {
+		_c.SetDeliveryEndHour(*v)
+	}
+	return _c
+}
+
+// SetBatchMaxEvents sets the "batch_max_events" field.
+func (_c *WebhookCreate) SetBatchMaxEvents(v int) *WebhookCreate {
+	_c.mutation.SetBatchMaxEvents(v)
+	return _c
+}
+
+// SetNillableBatchMaxEvents sets the "batch_max_events" field if the given value is not nil.
+func (_c *WebhookCreate) SetNillableBatchMaxEvents(v *int) *WebhookCreate {
+	if v != nil {
+		_c.SetBatchMaxEvents(*v)
+	}
+	return _c
+}
+
+// SetBatchWindowSeconds sets the "batch_window_seconds" field.
+func (_c *WebhookCreate) SetBatchWindowSeconds(v int) *WebhookCreate {
+	_c.mutation.SetBatchWindowSeconds(v)
+	return _c
+}
+
+// SetNillableBatchWindowSeconds sets the "batch_window_seconds" field if the given value is not nil.
+func (_c *WebhookCreate) SetNillableBatchWindowSeconds(v *int) *WebhookCreate {
+	if v != nil {
+		_c.SetBatchWindowSeconds(*v)
+	}
+	return _c
+}
+
+// SetRedactionProfile sets the "redaction_profile" field.
+func (_c *WebhookCreate) SetRedactionProfile(v string) *WebhookCreate {
+	_c.mutation.SetRedactionProfile(v)
+	return _c
+}
+
+// SetNillableRedactionProfile sets the "redaction_profile" field if the given value is not nil.
+func (_c *WebhookCreate) SetNillableRedactionProfile(v *string) *WebhookCreate {
+	if v != nil {
+		_c.SetRedactionProfile(*v)
+	}
+	return _c
+}
+
+// SetLeadChangeCursor sets the "lead_change_cursor" field.
+func (_c *WebhookCreate) SetLeadChangeCursor(v int) *WebhookCreate {
+	_c.mutation.SetLeadChangeCursor(v)
+	return _c
+}
+
+// SetNillableLeadChangeCursor sets the "lead_change_cursor" field if the given value is not nil.
+func (_c *WebhookCreate) SetNillableLeadChangeCursor(v *int) *WebhookCreate {
+	if v != nil {
+		_c.SetLeadChangeCursor(*v)
+	}
+	return _c
+}
+
 // SetRetryCount sets the "retry_count" field.
 func (_c *WebhookCreate) SetRetryCount(v int) *WebhookCreate {
 	_c.mutation.SetRetryCount(v)
@@ -162,6 +310,26 @@ func (_c *WebhookCreate) SetUser(v *User) *WebhookCreate {
 	return _c.SetUserID(v.ID)
 }
 
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_c *WebhookCreate) SetOrganization(v *Organization) *WebhookCreate {
+	return _c.SetOrganizationID(v.ID)
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (_c *WebhookCreate) AddDeliveryIDs(ids ...int) *WebhookCreate {
+	_c.mutation.AddDeliveryIDs(ids...)
+	return _c
+}
+
+// AddDeliveries adds the "deliveries" edges to the WebhookDelivery entity.
+func (_c *WebhookCreate) AddDeliveries(v ...*WebhookDelivery) *WebhookCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDeliveryIDs(ids...)
+}
+
 // Mutation returns the WebhookMutation object of the builder.
 func (_c *WebhookCreate) Mutation() *WebhookMutation {
 	return _c.mutation
@@ -201,6 +369,10 @@ func (_c *WebhookCreate) defaults() {
 		v := webhook.DefaultActive
 		_c.mutation.SetActive(v)
 	}
+	if _, ok := _c.mutation.SchemaVersion(); !ok {
+		v := webhook.DefaultSchemaVersion
+		_c.mutation.SetSchemaVersion(v)
+	}
 	if _, ok := _c.mutation.RetryCount(); !ok {
 		v := webhook.DefaultRetryCount
 		_c.mutation.SetRetryCount(v)
@@ -242,6 +414,29 @@ func (_c *WebhookCreate) check() error {
 	if _, ok := _c.mutation.Active(); !ok {
 		return &ValidationError{Name: "active", err: errors.New(`ent: missing required field "Webhook.active"`)}
 	}
+	if _, ok := _c.mutation.SchemaVersion(); !ok {
+		return &ValidationError{Name: "schema_version", err: errors.New(`ent: missing required field "Webhook.schema_version"`)}
+	}
+	if v, ok := _c.mutation.DeliveryStartHour(); ok {
+		if err := webhook.DeliveryStartHourValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_start_hour", err: fmt.Errorf(`ent: validator failed for field "Webhook.delivery_start_hour": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DeliveryEndHour(); ok {
+		if err := webhook.DeliveryEndHourValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_end_hour", err: fmt.Errorf(`ent: validator failed for field "Webhook.delivery_end_hour": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.BatchMaxEvents(); ok {
+		if err := webhook.BatchMaxEventsValidator(v); err != nil {
+			return &ValidationError{Name: "batch_max_events", err: fmt.Errorf(`ent: validator failed for field "Webhook.batch_max_events": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.BatchWindowSeconds(); ok {
+		if err := webhook.BatchWindowSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "batch_window_seconds", err: fmt.Errorf(`ent: validator failed for field "Webhook.batch_window_seconds": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.RetryCount(); !ok {
 		return &ValidationError{Name: "retry_count", err: errors.New(`ent: missing required field "Webhook.retry_count"`)}
 	}
@@ -302,10 +497,50 @@ func (_c *WebhookCreate) createSpec() (*Webhook, *sqlgraph.CreateSpec) {
 		_spec.SetField(webhook.FieldActive, field.TypeBool, value)
 		_node.Active = value
 	}
+	if value, ok := _c.mutation.PausedAt(); ok {
+		_spec.SetField(webhook.FieldPausedAt, field.TypeTime, value)
+		_node.PausedAt = &value
+	}
+	if value, ok := _c.mutation.QueuedEvents(); ok {
+		_spec.SetField(webhook.FieldQueuedEvents, field.TypeJSON, value)
+		_node.QueuedEvents = value
+	}
+	if value, ok := _c.mutation.SchemaVersion(); ok {
+		_spec.SetField(webhook.FieldSchemaVersion, field.TypeString, value)
+		_node.SchemaVersion = value
+	}
 	if value, ok := _c.mutation.Description(); ok {
 		_spec.SetField(webhook.FieldDescription, field.TypeString, value)
 		_node.Description = value
 	}
+	if value, ok := _c.mutation.DeliveryTimezone(); ok {
+		_spec.SetField(webhook.FieldDeliveryTimezone, field.TypeString, value)
+		_node.DeliveryTimezone = value
+	}
+	if value, ok := _c.mutation.DeliveryStartHour(); ok {
+		_spec.SetField(webhook.FieldDeliveryStartHour, field.TypeInt, value)
+		_node.DeliveryStartHour = &value
+	}
+	if value, ok := _c.mutation.DeliveryEndHour(); ok {
+		_spec.SetField(webhook.FieldDeliveryEndHour, field.TypeInt, value)
+		_node.DeliveryEndHour = &value
+	}
+	if value, ok := _c.mutation.BatchMaxEvents(); ok {
+		_spec.SetField(webhook.FieldBatchMaxEvents, field.TypeInt, value)
+		_node.BatchMaxEvents = &value
+	}
+	if value, ok := _c.mutation.BatchWindowSeconds(); ok {
+		_spec.SetField(webhook.FieldBatchWindowSeconds, field.TypeInt, value)
+		_node.BatchWindowSeconds = &value
+	}
+	if value, ok := _c.mutation.RedactionProfile(); ok {
+		_spec.SetField(webhook.FieldRedactionProfile, field.TypeString, value)
+		_node.RedactionProfile = value
+	}
+	if value, ok := _c.mutation.LeadChangeCursor(); ok {
+		_spec.SetField(webhook.FieldLeadChangeCursor, field.TypeInt, value)
+		_node.LeadChangeCursor = &value
+	}
 	if value, ok := _c.mutation.RetryCount(); ok {
 		_spec.SetField(webhook.FieldRetryCount, field.TypeInt, value)
 		_node.RetryCount = value
@@ -347,6 +582,39 @@ func (_c *WebhookCreate) createSpec() (*Webhook, *sqlgraph.CreateSpec) {
 		_node.user_webhooks = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhook.OrganizationTable,
+			Columns: []string{webhook.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrganizationID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
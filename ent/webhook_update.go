@@ -12,16 +12,19 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/organization"
 	"github.com/jordanlanch/industrydb/ent/predicate"
 	"github.com/jordanlanch/industrydb/ent/user"
 	"github.com/jordanlanch/industrydb/ent/webhook"
+	"github.com/jordanlanch/industrydb/ent/webhookdelivery"
 )
 
 // WebhookUpdate is the builder for updating Webhook entities.
 type WebhookUpdate struct {
 	config
-	hooks    []Hook
-	mutation *WebhookMutation
+	hooks     []Hook
+	mutation  *WebhookMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the WebhookUpdate builder.
@@ -44,6 +47,26 @@ func (_u *WebhookUpdate) SetNillableURL(v *string) *WebhookUpdate {
 	return _u
 }
 
+// SetOrganizationID sets the "organization_id" field.
+func (_u *WebhookUpdate) SetOrganizationID(v int) *WebhookUpdate {
+	_u.mutation.SetOrganizationID(v)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_u *WebhookUpdate) SetNillableOrganizationID(v *int) *WebhookUpdate {
+	if v != nil {
+		_u.SetOrganizationID(*v)
+	}
+	return _u
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (_u *WebhookUpdate) ClearOrganizationID() *WebhookUpdate {
+	_u.mutation.ClearOrganizationID()
+	return _u
+}
+
 // SetEvents sets the "events" field.
 func (_u *WebhookUpdate) SetEvents(v []string) *WebhookUpdate {
 	_u.mutation.SetEvents(v)
@@ -84,6 +107,58 @@ func (_u *WebhookUpdate) SetNillableActive(v *bool) *WebhookUpdate {
 	return _u
 }
 
+// SetPausedAt sets the "paused_at" field.
+func (_u *WebhookUpdate) SetPausedAt(v time.Time) *WebhookUpdate {
+	_u.mutation.SetPausedAt(v)
+	return _u
+}
+
+// SetNillablePausedAt sets the "paused_at" field if the given value is not nil.
+func (_u *WebhookUpdate) SetNillablePausedAt(v *time.Time) *WebhookUpdate {
+	if v != nil {
+		_u.SetPausedAt(*v)
+	}
+	return _u
+}
+
+// ClearPausedAt clears the value of the "paused_at" field.
+func (_u *WebhookUpdate) ClearPausedAt() *WebhookUpdate {
+	_u.mutation.ClearPausedAt()
+	return _u
+}
+
+// SetQueuedEvents sets the "queued_events" field.
+func (_u *WebhookUpdate) SetQueuedEvents(v []map[string]interface{}) *WebhookUpdate {
+	_u.mutation.SetQueuedEvents(v)
+	return _u
+}
+
+// AppendQueuedEvents appends value to the "queued_events" field.
+func (_u *WebhookUpdate) AppendQueuedEvents(v []map[string]interface{}) *WebhookUpdate {
+	_u.mutation.AppendQueuedEvents(v)
+	return _u
+}
+
+// ClearQueuedEvents clears the value of the "queued_events" field.
+func (_u *WebhookUpdate) ClearQueuedEvents() *WebhookUpdate {
+	_u.mutation.ClearQueuedEvents()
+	return _u
+}
+
+// SetSchemaVersion sets the "schema_version" field.
+func (_u *WebhookUpdate) SetSchemaVersion(v string) *WebhookUpdate {
+	_u.mutation.SetSchemaVersion(v)
+	return _u
+}
+
+// SetNillableSchemaVersion sets the "schema_version" field if the given value is not nil.
+func (_u *WebhookUpdate) SetNillableSchemaVersion(v *string) *WebhookUpdate {
+	if v != nil {
+		_u.SetSchemaVersion(*v)
+	}
+	return _u
+}
+
 // SetDescription sets the "description" field.
 func (_u *WebhookUpdate) SetDescription(v string) *WebhookUpdate {
 	_u.mutation.SetDescription(v)
@@ -104,6 +179,181 @@ func (_u *WebhookUpdate) ClearDescription() *WebhookUpdate {
 	return _u
 }
 
+// SetDeliveryTimezone sets the "delivery_timezone" field.
+func (_u *WebhookUpdate) SetDeliveryTimezone(v string) *WebhookUpdate {
+	_u.mutation.SetDeliveryTimezone(v)
+	return _u
+}
+
+// SetNillableDeliveryTimezone sets the "delivery_timezone" field if the given value is not nil.
+func (_u *WebhookUpdate) SetNillableDeliveryTimezone(v *string) *WebhookUpdate {
+	if v != nil {
+		_u.SetDeliveryTimezone(*v)
+	}
+	return _u
+}
+
+// ClearDeliveryTimezone clears the value of the "delivery_timezone" field.
+func (_u *WebhookUpdate) ClearDeliveryTimezone() *WebhookUpdate {
+	_u.mutation.ClearDeliveryTimezone()
+	return _u
+}
+
+// SetDeliveryStartHour sets the "delivery_start_hour" field.
+func (_u *WebhookUpdate) SetDeliveryStartHour(v int) *WebhookUpdate {
+	_u.mutation.ResetDeliveryStartHour()
+	_u.mutation.SetDeliveryStartHour(v)
+	return _u
+}
+
+// SetNillableDeliveryStartHour sets the "delivery_start_hour" field if the given value is not nil.
+func (_u *WebhookUpdate) SetNillableDeliveryStartHour(v *int) *WebhookUpdate {
+	if v != nil {
+		_u.SetDeliveryStartHour(*v)
+	}
+	return _u
+}
+
+// AddDeliveryStartHour adds value to the "delivery_start_hour" field.
+func (_u *WebhookUpdate) AddDeliveryStartHour(v int) *WebhookUpdate {
+	_u.mutation.AddDeliveryStartHour(v)
+	return _u
+}
+
+// ClearDeliveryStartHour clears the value of the "delivery_start_hour" field.
+func (_u *WebhookUpdate) ClearDeliveryStartHour() *WebhookUpdate {
+	_u.mutation.ClearDeliveryStartHour()
+	return _u
+}
+
+// SetDeliveryEndHour sets the "delivery_end_hour" field.
+func (_u *WebhookUpdate) SetDeliveryEndHour(v int) *WebhookUpdate {
+	_u.mutation.ResetDeliveryEndHour()
+	_u.mutation.SetDeliveryEndHour(v)
+	return _u
+}
+
+// SetNillableDeliveryEndHour sets the "delivery_end_hour" field if the given value is not nil.
+func (_u *WebhookUpdate) SetNillableDeliveryEndHour(v *int) *WebhookUpdate {
+	if v != nil {
+		_u.SetDeliveryEndHour(*v)
+	}
+	return _u
+}
+
+// AddDeliveryEndHour adds value to the "delivery_end_hour" field.
+func (_u *WebhookUpdate) AddDeliveryEndHour(v int) *WebhookUpdate {
+	_u.mutation.AddDeliveryEndHour(v)
+	return _u
+}
+
+// ClearDeliveryEndHour clears the value of the "delivery_end_hour" field.
+func (_u *WebhookUpdate) ClearDeliveryEndHour() *WebhookUpdate {
+	_u.mutation.ClearDeliveryEndHour()
+	return _u
+}
+
+// SetBatchMaxEvents sets the "batch_max_events" field.
+func (_u *WebhookUpdate) SetBatchMaxEvents(v int) *WebhookUpdate {
+	_u.mutation.ResetBatchMaxEvents()
+	_u.mutation.SetBatchMaxEvents(v)
+	return _u
+}
+
+// SetNillableBatchMaxEvents sets the "batch_max_events" field if the given value is not nil.
+func (_u *WebhookUpdate) SetNillableBatchMaxEvents(v *int) *WebhookUpdate {
+	if v != nil {
+		_u.SetBatchMaxEvents(*v)
+	}
+	return _u
+}
+
+// AddBatchMaxEvents adds value to the "batch_max_events" field.
+func (_u *WebhookUpdate) AddBatchMaxEvents(v int) *WebhookUpdate {
+	_u.mutation.AddBatchMaxEvents(v)
+	return _u
+}
+
+// ClearBatchMaxEvents clears the value of the "batch_max_events" field.
+func (_u *WebhookUpdate) ClearBatchMaxEvents() *WebhookUpdate {
+	_u.mutation.ClearBatchMaxEvents()
+	return _u
+}
+
+// SetBatchWindowSeconds sets the "batch_window_seconds" field.
+func (_u *WebhookUpdate) SetBatchWindowSeconds(v int) *WebhookUpdate {
+	_u.mutation.ResetBatchWindowSeconds()
+	_u.mutation.SetBatchWindowSeconds(v)
+	return _u
+}
+
+// SetNillableBatchWindowSeconds sets the "batch_window_seconds" field if the given value is not nil.
+func (_u *WebhookUpdate) SetNillableBatchWindowSeconds(v *int) *WebhookUpdate {
+	if v != nil {
+		_u.SetBatchWindowSeconds(*v)
+	}
+	return _u
+}
+
+// AddBatchWindowSeconds adds value to the "batch_window_seconds" field.
+func (_u *WebhookUpdate) AddBatchWindowSeconds(v int) *WebhookUpdate {
+	_u.mutation.AddBatchWindowSeconds(v)
+	return _u
+}
+
+// ClearBatchWindowSeconds clears the value of the "batch_window_seconds" field.
+func (_u *WebhookUpdate) ClearBatchWindowSeconds() *WebhookUpdate {
+	_u.mutation.ClearBatchWindowSeconds()
+	return _u
+}
+
+// SetRedactionProfile sets the "redaction_profile" field.
+func (_u *WebhookUpdate) SetRedactionProfile(v string) *WebhookUpdate {
+	_u.mutation.SetRedactionProfile(v)
+	return _u
+}
+
+// SetNillableRedactionProfile sets the "redaction_profile" field if the given value is not nil.
+func (_u *WebhookUpdate) SetNillableRedactionProfile(v *string) *WebhookUpdate {
+	if v != nil {
+		_u.SetRedactionProfile(*v)
+	}
+	return _u
+}
+
+// ClearRedactionProfile clears the value of the "redaction_profile" field.
+func (_u *WebhookUpdate) ClearRedactionProfile() *WebhookUpdate {
+	_u.mutation.ClearRedactionProfile()
+	return _u
+}
+
+// SetLeadChangeCursor sets the "lead_change_cursor" field.
+func (_u *WebhookUpdate) SetLeadChangeCursor(v int) *WebhookUpdate {
+	_u.mutation.ResetLeadChangeCursor()
+	_u.mutation.SetLeadChangeCursor(v)
+	return _u
+}
+
+// SetNillableLeadChangeCursor sets the "lead_change_cursor" field if the given value is not nil.
+func (_u *WebhookUpdate) SetNillableLeadChangeCursor(v *int) *WebhookUpdate {
+	if v != nil {
+		_u.SetLeadChangeCursor(*v)
+	}
+	return _u
+}
+
+// AddLeadChangeCursor adds value to the "lead_change_cursor" field.
+func (_u *WebhookUpdate) AddLeadChangeCursor(v int) *WebhookUpdate {
+	_u.mutation.AddLeadChangeCursor(v)
+	return _u
+}
+
+// ClearLeadChangeCursor clears the value of the "lead_change_cursor" field.
+func (_u *WebhookUpdate) ClearLeadChangeCursor() *WebhookUpdate {
+	_u.mutation.ClearLeadChangeCursor()
+	return _u
+}
+
 // SetRetryCount sets the "retry_count" field.
 func (_u *WebhookUpdate) SetRetryCount(v int) *WebhookUpdate {
 	_u.mutation.ResetRetryCount()
@@ -204,6 +454,26 @@ func (_u *WebhookUpdate) SetUser(v *User) *WebhookUpdate {
 	return _u.SetUserID(v.ID)
 }
 
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_u *WebhookUpdate) SetOrganization(v *Organization) *WebhookUpdate {
+	return _u.SetOrganizationID(v.ID)
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (_u *WebhookUpdate) AddDeliveryIDs(ids ...int) *WebhookUpdate {
+	_u.mutation.AddDeliveryIDs(ids...)
+	return _u
+}
+
+// AddDeliveries adds the "deliveries" edges to the WebhookDelivery entity.
+func (_u *WebhookUpdate) AddDeliveries(v ...*WebhookDelivery) *WebhookUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDeliveryIDs(ids...)
+}
+
 // Mutation returns the WebhookMutation object of the builder.
 func (_u *WebhookUpdate) Mutation() *WebhookMutation {
 	return _u.mutation
@@ -215,6 +485,33 @@ func (_u *WebhookUpdate) ClearUser() *WebhookUpdate {
 	return _u
 }
 
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (_u *WebhookUpdate) ClearOrganization() *WebhookUpdate {
+	_u.mutation.ClearOrganization()
+	return _u
+}
+
+// ClearDeliveries clears all "deliveries" edges to the WebhookDelivery entity.
+func (_u *WebhookUpdate) ClearDeliveries() *WebhookUpdate {
+	_u.mutation.ClearDeliveries()
+	return _u
+}
+
+// RemoveDeliveryIDs removes the "deliveries" edge to WebhookDelivery entities by IDs.
+func (_u *WebhookUpdate) RemoveDeliveryIDs(ids ...int) *WebhookUpdate {
+	_u.mutation.RemoveDeliveryIDs(ids...)
+	return _u
+}
+
+// RemoveDeliveries removes "deliveries" edges to WebhookDelivery entities.
+func (_u *WebhookUpdate) RemoveDeliveries(v ...*WebhookDelivery) *WebhookUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDeliveryIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *WebhookUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -258,12 +555,38 @@ func (_u *WebhookUpdate) check() error {
 			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "Webhook.url": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.DeliveryStartHour(); ok {
+		if err := webhook.DeliveryStartHourValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_start_hour", err: fmt.Errorf(`ent: validator failed for field "Webhook.delivery_start_hour": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeliveryEndHour(); ok {
+		if err := webhook.DeliveryEndHourValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_end_hour", err: fmt.Errorf(`ent: validator failed for field "Webhook.delivery_end_hour": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BatchMaxEvents(); ok {
+		if err := webhook.BatchMaxEventsValidator(v); err != nil {
+			return &ValidationError{Name: "batch_max_events", err: fmt.Errorf(`ent: validator failed for field "Webhook.batch_max_events": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BatchWindowSeconds(); ok {
+		if err := webhook.BatchWindowSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "batch_window_seconds", err: fmt.Errorf(`ent: validator failed for field "Webhook.batch_window_seconds": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Webhook.user"`)
 	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *WebhookUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WebhookUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *WebhookUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -293,12 +616,89 @@ func (_u *WebhookUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Active(); ok {
 		_spec.SetField(webhook.FieldActive, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.PausedAt(); ok {
+		_spec.SetField(webhook.FieldPausedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PausedAtCleared() {
+		_spec.ClearField(webhook.FieldPausedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.QueuedEvents(); ok {
+		_spec.SetField(webhook.FieldQueuedEvents, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedQueuedEvents(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhook.FieldQueuedEvents, value)
+		})
+	}
+	if _u.mutation.QueuedEventsCleared() {
+		_spec.ClearField(webhook.FieldQueuedEvents, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SchemaVersion(); ok {
+		_spec.SetField(webhook.FieldSchemaVersion, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.Description(); ok {
 		_spec.SetField(webhook.FieldDescription, field.TypeString, value)
 	}
 	if _u.mutation.DescriptionCleared() {
 		_spec.ClearField(webhook.FieldDescription, field.TypeString)
 	}
+	if value, ok := _u.mutation.DeliveryTimezone(); ok {
+		_spec.SetField(webhook.FieldDeliveryTimezone, field.TypeString, value)
+	}
+	if _u.mutation.DeliveryTimezoneCleared() {
+		_spec.ClearField(webhook.FieldDeliveryTimezone, field.TypeString)
+	}
+	if value, ok := _u.mutation.DeliveryStartHour(); ok {
+		_spec.SetField(webhook.FieldDeliveryStartHour, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDeliveryStartHour(); ok {
+		_spec.AddField(webhook.FieldDeliveryStartHour, field.TypeInt, value)
+	}
+	if _u.mutation.DeliveryStartHourCleared() {
+		_spec.ClearField(webhook.FieldDeliveryStartHour, field.TypeInt)
+	}
+	if value, ok := _u.mutation.DeliveryEndHour(); ok {
+		_spec.SetField(webhook.FieldDeliveryEndHour, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDeliveryEndHour(); ok {
+		_spec.AddField(webhook.FieldDeliveryEndHour, field.TypeInt, value)
+	}
+	if _u.mutation.DeliveryEndHourCleared() {
+		_spec.ClearField(webhook.FieldDeliveryEndHour, field.TypeInt)
+	}
+	if value, ok := _u.mutation.BatchMaxEvents(); ok {
+		_spec.SetField(webhook.FieldBatchMaxEvents, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBatchMaxEvents(); ok {
+		_spec.AddField(webhook.FieldBatchMaxEvents, field.TypeInt, value)
+	}
+	if _u.mutation.BatchMaxEventsCleared() {
+		_spec.ClearField(webhook.FieldBatchMaxEvents, field.TypeInt)
+	}
+	if value, ok := _u.mutation.BatchWindowSeconds(); ok {
+		_spec.SetField(webhook.FieldBatchWindowSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBatchWindowSeconds(); ok {
+		_spec.AddField(webhook.FieldBatchWindowSeconds, field.TypeInt, value)
+	}
+	if _u.mutation.BatchWindowSecondsCleared() {
+		_spec.ClearField(webhook.FieldBatchWindowSeconds, field.TypeInt)
+	}
+	if value, ok := _u.mutation.RedactionProfile(); ok {
+		_spec.SetField(webhook.FieldRedactionProfile, field.TypeString, value)
+	}
+	if _u.mutation.RedactionProfileCleared() {
+		_spec.ClearField(webhook.FieldRedactionProfile, field.TypeString)
+	}
+	if value, ok := _u.mutation.LeadChangeCursor(); ok {
+		_spec.SetField(webhook.FieldLeadChangeCursor, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLeadChangeCursor(); ok {
+		_spec.AddField(webhook.FieldLeadChangeCursor, field.TypeInt, value)
+	}
+	if _u.mutation.LeadChangeCursorCleared() {
+		_spec.ClearField(webhook.FieldLeadChangeCursor, field.TypeInt)
+	}
 	if value, ok := _u.mutation.RetryCount(); ok {
 		_spec.SetField(webhook.FieldRetryCount, field.TypeInt, value)
 	}
@@ -355,6 +755,81 @@ func (_u *WebhookUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhook.OrganizationTable,
+			Columns: []string{webhook.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhook.OrganizationTable,
+			Columns: []string{webhook.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDeliveriesIDs(); len(nodes) > 0 && !_u.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{webhook.Label}
@@ -370,9 +845,10 @@ func (_u *WebhookUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // WebhookUpdateOne is the builder for updating a single Webhook entity.
 type WebhookUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *WebhookMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *WebhookMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetURL sets the "url" field.
@@ -389,6 +865,26 @@ func (_u *WebhookUpdateOne) SetNillableURL(v *string) *WebhookUpdateOne {
 	return _u
 }
 
+// SetOrganizationID sets the "organization_id" field.
+func (_u *WebhookUpdateOne) SetOrganizationID(v int) *WebhookUpdateOne {
+	_u.mutation.SetOrganizationID(v)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_u *WebhookUpdateOne) SetNillableOrganizationID(v *int) *WebhookUpdateOne {
+	if v != nil {
+		_u.SetOrganizationID(*v)
+	}
+	return _u
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (_u *WebhookUpdateOne) ClearOrganizationID() *WebhookUpdateOne {
+	_u.mutation.ClearOrganizationID()
+	return _u
+}
+
 // SetEvents sets the "events" field.
 func (_u *WebhookUpdateOne) SetEvents(v []string) *WebhookUpdateOne {
 	_u.mutation.SetEvents(v)
@@ -429,6 +925,58 @@ func (_u *WebhookUpdateOne) SetNillableActive(v *bool) *WebhookUpdateOne {
 	return _u
 }
 
+// SetPausedAt sets the "paused_at" field.
+func (_u *WebhookUpdateOne) SetPausedAt(v time.Time) *WebhookUpdateOne {
+	_u.mutation.SetPausedAt(v)
+	return _u
+}
+
+// SetNillablePausedAt sets the "paused_at" field if the given value is not nil.
+func (_u *WebhookUpdateOne) SetNillablePausedAt(v *time.Time) *WebhookUpdateOne {
+	if v != nil {
+		_u.SetPausedAt(*v)
+	}
+	return _u
+}
+
+// ClearPausedAt clears the value of the "paused_at" field.
+func (_u *WebhookUpdateOne) ClearPausedAt() *WebhookUpdateOne {
+	_u.mutation.ClearPausedAt()
+	return _u
+}
+
+// SetQueuedEvents sets the "queued_events" field.
+func (_u *WebhookUpdateOne) SetQueuedEvents(v []map[string]interface{}) *WebhookUpdateOne {
+	_u.mutation.SetQueuedEvents(v)
+	return _u
+}
+
+// AppendQueuedEvents appends value to the "queued_events" field.
+func (_u *WebhookUpdateOne) AppendQueuedEvents(v []map[string]interface{}) *WebhookUpdateOne {
+	_u.mutation.AppendQueuedEvents(v)
+	return _u
+}
+
+// ClearQueuedEvents clears the value of the "queued_events" field.
+func (_u *WebhookUpdateOne) ClearQueuedEvents() *WebhookUpdateOne {
+	_u.mutation.ClearQueuedEvents()
+	return _u
+}
+
+// SetSchemaVersion sets the "schema_version" field.
+func (_u *WebhookUpdateOne) SetSchemaVersion(v string) *WebhookUpdateOne {
+	_u.mutation.SetSchemaVersion(v)
+	return _u
+}
+
+// SetNillableSchemaVersion sets the "schema_version" field if the given value is not nil.
+func (_u *WebhookUpdateOne) SetNillableSchemaVersion(v *string) *WebhookUpdateOne {
+	if v != nil {
+		_u.SetSchemaVersion(*v)
+	}
+	return _u
+}
+
 // SetDescription sets the "description" field.
 func (_u *WebhookUpdateOne) SetDescription(v string) *WebhookUpdateOne {
 	_u.mutation.SetDescription(v)
@@ -449,6 +997,181 @@ func (_u *WebhookUpdateOne) ClearDescription() *WebhookUpdateOne {
 	return _u
 }
 
+// SetDeliveryTimezone sets the "delivery_timezone" field.
+func (_u *WebhookUpdateOne) SetDeliveryTimezone(v string) *WebhookUpdateOne {
+	_u.mutation.SetDeliveryTimezone(v)
+	return _u
+}
+
+// SetNillableDeliveryTimezone sets the "delivery_timezone" field if the given value is not nil.
+func (_u *WebhookUpdateOne) SetNillableDeliveryTimezone(v *string) *WebhookUpdateOne {
+	if v != nil {
+		_u.SetDeliveryTimezone(*v)
+	}
+	return _u
+}
+
+// ClearDeliveryTimezone clears the value of the "delivery_timezone" field.
+func (_u *WebhookUpdateOne) ClearDeliveryTimezone() *WebhookUpdateOne {
+	_u.mutation.ClearDeliveryTimezone()
+	return _u
+}
+
+// SetDeliveryStartHour sets the "delivery_start_hour" field.
+func (_u *WebhookUpdateOne) SetDeliveryStartHour(v int) *WebhookUpdateOne {
+	_u.mutation.ResetDeliveryStartHour()
+	_u.mutation.SetDeliveryStartHour(v)
+	return _u
+}
+
+// SetNillableDeliveryStartHour sets the "delivery_start_hour" field if the given value is not nil.
+func (_u *WebhookUpdateOne) SetNillableDeliveryStartHour(v *int) *WebhookUpdateOne {
+	if v != nil {
+		_u.SetDeliveryStartHour(*v)
+	}
+	return _u
+}
+
+// AddDeliveryStartHour adds value to the "delivery_start_hour" field.
+func (_u *WebhookUpdateOne) AddDeliveryStartHour(v int) *WebhookUpdateOne {
+	_u.mutation.AddDeliveryStartHour(v)
+	return _u
+}
+
+// ClearDeliveryStartHour clears the value of the "delivery_start_hour" field.
+func (_u *WebhookUpdateOne) ClearDeliveryStartHour() *WebhookUpdateOne {
+	_u.mutation.ClearDeliveryStartHour()
+	return _u
+}
+
+// SetDeliveryEndHour sets the "delivery_end_hour" field.
+func (_u *WebhookUpdateOne) SetDeliveryEndHour(v int) *WebhookUpdateOne {
+	_u.mutation.ResetDeliveryEndHour()
+	_u.mutation.SetDeliveryEndHour(v)
+	return _u
+}
+
+// SetNillableDeliveryEndHour sets the "delivery_end_hour" field if the given value is not nil.
+func (_u *WebhookUpdateOne) SetNillableDeliveryEndHour(v *int) *WebhookUpdateOne {
+	if v != nil {
+		_u.SetDeliveryEndHour(*v)
+	}
+	return _u
+}
+
+// AddDeliveryEndHour adds value to the "delivery_end_hour" field.
+func (_u *WebhookUpdateOne) AddDeliveryEndHour(v int) *WebhookUpdateOne {
+	_u.mutation.AddDeliveryEndHour(v)
+	return _u
+}
+
+// ClearDeliveryEndHour clears the value of the "delivery_end_hour" field.
+func (_u *WebhookUpdateOne) ClearDeliveryEndHour() *WebhookUpdateOne {
+	_u.mutation.ClearDeliveryEndHour()
+	return _u
+}
+
+// SetBatchMaxEvents sets the "batch_max_events" field.
+func (_u *WebhookUpdateOne) SetBatchMaxEvents(v int) *WebhookUpdateOne {
+	_u.mutation.ResetBatchMaxEvents()
+	_u.mutation.SetBatchMaxEvents(v)
+	return _u
+}
+
+// SetNillableBatchMaxEvents sets the "batch_max_events" field if the given value is not nil.
+func (_u *WebhookUpdateOne) SetNillableBatchMaxEvents(v *int) *WebhookUpdateOne {
+	if v != nil {
+		_u.SetBatchMaxEvents(*v)
+	}
+	return _u
+}
+
+// AddBatchMaxEvents adds value to the "batch_max_events" field.
+func (_u *WebhookUpdateOne) AddBatchMaxEvents(v int) *WebhookUpdateOne {
+	_u.mutation.AddBatchMaxEvents(v)
+	return _u
+}
+
+// ClearBatchMaxEvents clears the value of the "batch_max_events" field.
+func (_u *WebhookUpdateOne) ClearBatchMaxEvents() *WebhookUpdateOne {
+	_u.mutation.ClearBatchMaxEvents()
+	return _u
+}
+
+// SetBatchWindowSeconds sets the "batch_window_seconds" field.
+func (_u *WebhookUpdateOne) SetBatchWindowSeconds(v int) *WebhookUpdateOne {
+	_u.mutation.ResetBatchWindowSeconds()
+	_u.mutation.SetBatchWindowSeconds(v)
+	return _u
+}
+
+// SetNillableBatchWindowSeconds sets the "batch_window_seconds" field if the given value is not nil.
+func (_u *WebhookUpdateOne) SetNillableBatchWindowSeconds(v *int) *WebhookUpdateOne {
+	if v != nil {
+		_u.SetBatchWindowSeconds(*v)
+	}
+	return _u
+}
+
+// AddBatchWindowSeconds adds value to the "batch_window_seconds" field.
+func (_u *WebhookUpdateOne) AddBatchWindowSeconds(v int) *WebhookUpdateOne {
+	_u.mutation.AddBatchWindowSeconds(v)
+	return _u
+}
+
+// ClearBatchWindowSeconds clears the value of the "batch_window_seconds" field.
+func (_u *WebhookUpdateOne) ClearBatchWindowSeconds() *WebhookUpdateOne {
+	_u.mutation.ClearBatchWindowSeconds()
+	return _u
+}
+
+// SetRedactionProfile sets the "redaction_profile" field.
+func (_u *WebhookUpdateOne) SetRedactionProfile(v string) *WebhookUpdateOne {
+	_u.mutation.SetRedactionProfile(v)
+	return _u
+}
+
+// SetNillableRedactionProfile sets the "redaction_profile" field if the given value is not nil.
+func (_u *WebhookUpdateOne) SetNillableRedactionProfile(v *string) *WebhookUpdateOne {
+	if v != nil {
+		_u.SetRedactionProfile(*v)
+	}
+	return _u
+}
+
+// ClearRedactionProfile clears the value of the "redaction_profile" field.
+func (_u *WebhookUpdateOne) ClearRedactionProfile() *WebhookUpdateOne {
+	_u.mutation.ClearRedactionProfile()
+	return _u
+}
+
+// SetLeadChangeCursor sets the "lead_change_cursor" field.
+func (_u *WebhookUpdateOne) SetLeadChangeCursor(v int) *WebhookUpdateOne {
+	_u.mutation.ResetLeadChangeCursor()
+	_u.mutation.SetLeadChangeCursor(v)
+	return _u
+}
+
+// SetNillableLeadChangeCursor sets the "lead_change_cursor" field if the given value is not nil.
+func (_u *WebhookUpdateOne) SetNillableLeadChangeCursor(v *int) *WebhookUpdateOne {
+	if v != nil {
+		_u.SetLeadChangeCursor(*v)
+	}
+	return _u
+}
+
+// AddLeadChangeCursor adds value to the "lead_change_cursor" field.
+func (_u *WebhookUpdateOne) AddLeadChangeCursor(v int) *WebhookUpdateOne {
+	_u.mutation.AddLeadChangeCursor(v)
+	return _u
+}
+
+// ClearLeadChangeCursor clears the value of the "lead_change_cursor" field.
+func (_u *WebhookUpdateOne) ClearLeadChangeCursor() *WebhookUpdateOne {
+	_u.mutation.ClearLeadChangeCursor()
+	return _u
+}
+
 // SetRetryCount sets the "retry_count" field.
 func (_u *WebhookUpdateOne) SetRetryCount(v int) *WebhookUpdateOne {
 	_u.mutation.ResetRetryCount()
@@ -549,6 +1272,26 @@ func (_u *WebhookUpdateOne) SetUser(v *User) *WebhookUpdateOne {
 	return _u.SetUserID(v.ID)
 }
 
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_u *WebhookUpdateOne) SetOrganization(v *Organization) *WebhookUpdateOne {
+	return _u.SetOrganizationID(v.ID)
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (_u *WebhookUpdateOne) AddDeliveryIDs(ids ...int) *WebhookUpdateOne {
+	_u.mutation.AddDeliveryIDs(ids...)
+	return _u
+}
+
+// AddDeliveries adds the "deliveries" edges to the WebhookDelivery entity.
+func (_u *WebhookUpdateOne) AddDeliveries(v ...*WebhookDelivery) *WebhookUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDeliveryIDs(ids...)
+}
+
 // Mutation returns the WebhookMutation object of the builder.
 func (_u *WebhookUpdateOne) Mutation() *WebhookMutation {
 	return _u.mutation
@@ -560,6 +1303,33 @@ func (_u *WebhookUpdateOne) ClearUser() *WebhookUpdateOne {
 	return _u
 }
 
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (_u *WebhookUpdateOne) ClearOrganization() *WebhookUpdateOne {
+	_u.mutation.ClearOrganization()
+	return _u
+}
+
+// ClearDeliveries clears all "deliveries" edges to the WebhookDelivery entity.
+func (_u *WebhookUpdateOne) ClearDeliveries() *WebhookUpdateOne {
+	_u.mutation.ClearDeliveries()
+	return _u
+}
+
+// RemoveDeliveryIDs removes the "deliveries" edge to WebhookDelivery entities by IDs.
+func (_u *WebhookUpdateOne) RemoveDeliveryIDs(ids ...int) *WebhookUpdateOne {
+	_u.mutation.RemoveDeliveryIDs(ids...)
+	return _u
+}
+
+// RemoveDeliveries removes "deliveries" edges to WebhookDelivery entities.
+func (_u *WebhookUpdateOne) RemoveDeliveries(v ...*WebhookDelivery) *WebhookUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDeliveryIDs(ids...)
+}
+
 // Where appends a list predicates to the WebhookUpdate builder.
 func (_u *WebhookUpdateOne) Where(ps ...predicate.Webhook) *WebhookUpdateOne {
 	_u.mutation.Where(ps...)
@@ -616,12 +1386,38 @@ func (_u *WebhookUpdateOne) check() error {
 			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "Webhook.url": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.DeliveryStartHour(); ok {
+		if err := webhook.DeliveryStartHourValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_start_hour", err: fmt.Errorf(`ent: validator failed for field "Webhook.delivery_start_hour": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeliveryEndHour(); ok {
+		if err := webhook.DeliveryEndHourValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_end_hour", err: fmt.Errorf(`ent: validator failed for field "Webhook.delivery_end_hour": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BatchMaxEvents(); ok {
+		if err := webhook.BatchMaxEventsValidator(v); err != nil {
+			return &ValidationError{Name: "batch_max_events", err: fmt.Errorf(`ent: validator failed for field "Webhook.batch_max_events": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BatchWindowSeconds(); ok {
+		if err := webhook.BatchWindowSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "batch_window_seconds", err: fmt.Errorf(`ent: validator failed for field "Webhook.batch_window_seconds": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Webhook.user"`)
 	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *WebhookUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WebhookUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *WebhookUpdateOne) sqlSave(ctx context.Context) (_node *Webhook, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -668,12 +1464,89 @@ func (_u *WebhookUpdateOne) sqlSave(ctx context.Context) (_node *Webhook, err er
 	if value, ok := _u.mutation.Active(); ok {
 		_spec.SetField(webhook.FieldActive, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.PausedAt(); ok {
+		_spec.SetField(webhook.FieldPausedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PausedAtCleared() {
+		_spec.ClearField(webhook.FieldPausedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.QueuedEvents(); ok {
+		_spec.SetField(webhook.FieldQueuedEvents, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedQueuedEvents(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhook.FieldQueuedEvents, value)
+		})
+	}
+	if _u.mutation.QueuedEventsCleared() {
+		_spec.ClearField(webhook.FieldQueuedEvents, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SchemaVersion(); ok {
+		_spec.SetField(webhook.FieldSchemaVersion, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.Description(); ok {
 		_spec.SetField(webhook.FieldDescription, field.TypeString, value)
 	}
 	if _u.mutation.DescriptionCleared() {
 		_spec.ClearField(webhook.FieldDescription, field.TypeString)
 	}
+	if value, ok := _u.mutation.DeliveryTimezone(); ok {
+		_spec.SetField(webhook.FieldDeliveryTimezone, field.TypeString, value)
+	}
+	if _u.mutation.DeliveryTimezoneCleared() {
+		_spec.ClearField(webhook.FieldDeliveryTimezone, field.TypeString)
+	}
+	if value, ok := _u.mutation.DeliveryStartHour(); ok {
+		_spec.SetField(webhook.FieldDeliveryStartHour, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDeliveryStartHour(); ok {
+		_spec.AddField(webhook.FieldDeliveryStartHour, field.TypeInt, value)
+	}
+	if _u.mutation.DeliveryStartHourCleared() {
+		_spec.ClearField(webhook.FieldDeliveryStartHour, field.TypeInt)
+	}
+	if value, ok := _u.mutation.DeliveryEndHour(); ok {
+		_spec.SetField(webhook.FieldDeliveryEndHour, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDeliveryEndHour(); ok {
+		_spec.AddField(webhook.FieldDeliveryEndHour, field.TypeInt, value)
+	}
+	if _u.mutation.DeliveryEndHourCleared() {
+		_spec.ClearField(webhook.FieldDeliveryEndHour, field.TypeInt)
+	}
+	if value, ok := _u.mutation.BatchMaxEvents(); ok {
+		_spec.SetField(webhook.FieldBatchMaxEvents, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBatchMaxEvents(); ok {
+		_spec.AddField(webhook.FieldBatchMaxEvents, field.TypeInt, value)
+	}
+	if _u.mutation.BatchMaxEventsCleared() {
+		_spec.ClearField(webhook.FieldBatchMaxEvents, field.TypeInt)
+	}
+	if value, ok := _u.mutation.BatchWindowSeconds(); ok {
+		_spec.SetField(webhook.FieldBatchWindowSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBatchWindowSeconds(); ok {
+		_spec.AddField(webhook.FieldBatchWindowSeconds, field.TypeInt, value)
+	}
+	if _u.mutation.BatchWindowSecondsCleared() {
+		_spec.ClearField(webhook.FieldBatchWindowSeconds, field.TypeInt)
+	}
+	if value, ok := _u.mutation.RedactionProfile(); ok {
+		_spec.SetField(webhook.FieldRedactionProfile, field.TypeString, value)
+	}
+	if _u.mutation.RedactionProfileCleared() {
+		_spec.ClearField(webhook.FieldRedactionProfile, field.TypeString)
+	}
+	if value, ok := _u.mutation.LeadChangeCursor(); ok {
+		_spec.SetField(webhook.FieldLeadChangeCursor, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLeadChangeCursor(); ok {
+		_spec.AddField(webhook.FieldLeadChangeCursor, field.TypeInt, value)
+	}
+	if _u.mutation.LeadChangeCursorCleared() {
+		_spec.ClearField(webhook.FieldLeadChangeCursor, field.TypeInt)
+	}
 	if value, ok := _u.mutation.RetryCount(); ok {
 		_spec.SetField(webhook.FieldRetryCount, field.TypeInt, value)
 	}
@@ -730,6 +1603,81 @@ func (_u *WebhookUpdateOne) sqlSave(ctx context.Context) (_node *Webhook, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhook.OrganizationTable,
+			Columns: []string{webhook.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhook.OrganizationTable,
+			Columns: []string{webhook.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDeliveriesIDs(); len(nodes) > 0 && !_u.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Webhook{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,778 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+	"github.com/jordanlanch/industrydb/ent/webhook"
+	"github.com/jordanlanch/industrydb/ent/webhookdelivery"
+)
+
+// WebhookDeliveryUpdate is the builder for updating WebhookDelivery entities.
+type WebhookDeliveryUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *WebhookDeliveryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the WebhookDeliveryUpdate builder.
+func (_u *WebhookDeliveryUpdate) Where(ps ...predicate.WebhookDelivery) *WebhookDeliveryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetWebhookID sets the "webhook_id" field.
+func (_u *WebhookDeliveryUpdate) SetWebhookID(v int) *WebhookDeliveryUpdate {
+	_u.mutation.SetWebhookID(v)
+	return _u
+}
+
+// SetNillableWebhookID sets the "webhook_id" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdate) SetNillableWebhookID(v *int) *WebhookDeliveryUpdate {
+	if v != nil {
+		_u.SetWebhookID(*v)
+	}
+	return _u
+}
+
+// SetEventID sets the "event_id" field.
+func (_u *WebhookDeliveryUpdate) SetEventID(v string) *WebhookDeliveryUpdate {
+	_u.mutation.SetEventID(v)
+	return _u
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdate) SetNillableEventID(v *string) *WebhookDeliveryUpdate {
+	if v != nil {
+		_u.SetEventID(*v)
+	}
+	return _u
+}
+
+// ClearEventID clears the value of the "event_id" field.
+func (_u *WebhookDeliveryUpdate) ClearEventID() *WebhookDeliveryUpdate {
+	_u.mutation.ClearEventID()
+	return _u
+}
+
+// SetEvent sets the "event" field.
+func (_u *WebhookDeliveryUpdate) SetEvent(v string) *WebhookDeliveryUpdate {
+	_u.mutation.SetEvent(v)
+	return _u
+}
+
+// SetNillableEvent sets the "event" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdate) SetNillableEvent(v *string) *WebhookDeliveryUpdate {
+	if v != nil {
+		_u.SetEvent(*v)
+	}
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *WebhookDeliveryUpdate) SetPayload(v map[string]interface{}) *WebhookDeliveryUpdate {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// SetSchemaVersion sets the "schema_version" field.
+func (_u *WebhookDeliveryUpdate) SetSchemaVersion(v string) *WebhookDeliveryUpdate {
+	_u.mutation.SetSchemaVersion(v)
+	return _u
+}
+
+// SetNillableSchemaVersion sets the "schema_version" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdate) SetNillableSchemaVersion(v *string) *WebhookDeliveryUpdate {
+	if v != nil {
+		_u.SetSchemaVersion(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *WebhookDeliveryUpdate) SetAttempts(v []map[string]interface{}) *WebhookDeliveryUpdate {
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// AppendAttempts appends value to the "attempts" field.
+func (_u *WebhookDeliveryUpdate) AppendAttempts(v []map[string]interface{}) *WebhookDeliveryUpdate {
+	_u.mutation.AppendAttempts(v)
+	return _u
+}
+
+// ClearAttempts clears the value of the "attempts" field.
+func (_u *WebhookDeliveryUpdate) ClearAttempts() *WebhookDeliveryUpdate {
+	_u.mutation.ClearAttempts()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *WebhookDeliveryUpdate) SetStatus(v webhookdelivery.Status) *WebhookDeliveryUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdate) SetNillableStatus(v *webhookdelivery.Status) *WebhookDeliveryUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *WebhookDeliveryUpdate) SetLastError(v string) *WebhookDeliveryUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdate) SetNillableLastError(v *string) *WebhookDeliveryUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *WebhookDeliveryUpdate) ClearLastError() *WebhookDeliveryUpdate {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetReplayCount sets the "replay_count" field.
+func (_u *WebhookDeliveryUpdate) SetReplayCount(v int) *WebhookDeliveryUpdate {
+	_u.mutation.ResetReplayCount()
+	_u.mutation.SetReplayCount(v)
+	return _u
+}
+
+// SetNillableReplayCount sets the "replay_count" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdate) SetNillableReplayCount(v *int) *WebhookDeliveryUpdate {
+	if v != nil {
+		_u.SetReplayCount(*v)
+	}
+	return _u
+}
+
+// AddReplayCount adds value to the "replay_count" field.
+func (_u *WebhookDeliveryUpdate) AddReplayCount(v int) *WebhookDeliveryUpdate {
+	_u.mutation.AddReplayCount(v)
+	return _u
+}
+
+// SetLastReplayedAt sets the "last_replayed_at" field.
+func (_u *WebhookDeliveryUpdate) SetLastReplayedAt(v time.Time) *WebhookDeliveryUpdate {
+	_u.mutation.SetLastReplayedAt(v)
+	return _u
+}
+
+// SetNillableLastReplayedAt sets the "last_replayed_at" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdate) SetNillableLastReplayedAt(v *time.Time) *WebhookDeliveryUpdate {
+	if v != nil {
+		_u.SetLastReplayedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastReplayedAt clears the value of the "last_replayed_at" field.
+func (_u *WebhookDeliveryUpdate) ClearLastReplayedAt() *WebhookDeliveryUpdate {
+	_u.mutation.ClearLastReplayedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WebhookDeliveryUpdate) SetUpdatedAt(v time.Time) *WebhookDeliveryUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetWebhook sets the "webhook" edge to the Webhook entity.
+func (_u *WebhookDeliveryUpdate) SetWebhook(v *Webhook) *WebhookDeliveryUpdate {
+	return _u.SetWebhookID(v.ID)
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (_u *WebhookDeliveryUpdate) Mutation() *WebhookDeliveryMutation {
+	return _u.mutation
+}
+
+// ClearWebhook clears the "webhook" edge to the Webhook entity.
+func (_u *WebhookDeliveryUpdate) ClearWebhook() *WebhookDeliveryUpdate {
+	_u.mutation.ClearWebhook()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WebhookDeliveryUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WebhookDeliveryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WebhookDeliveryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WebhookDeliveryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WebhookDeliveryUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := webhookdelivery.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WebhookDeliveryUpdate) check() error {
+	if v, ok := _u.mutation.WebhookID(); ok {
+		if err := webhookdelivery.WebhookIDValidator(v); err != nil {
+			return &ValidationError{Name: "webhook_id", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.webhook_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Event(); ok {
+		if err := webhookdelivery.EventValidator(v); err != nil {
+			return &ValidationError{Name: "event", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.event": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := webhookdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.status": %w`, err)}
+		}
+	}
+	if _u.mutation.WebhookCleared() && len(_u.mutation.WebhookIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WebhookDelivery.webhook"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *WebhookDeliveryUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WebhookDeliveryUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *WebhookDeliveryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookdelivery.Table, webhookdelivery.Columns, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EventID(); ok {
+		_spec.SetField(webhookdelivery.FieldEventID, field.TypeString, value)
+	}
+	if _u.mutation.EventIDCleared() {
+		_spec.ClearField(webhookdelivery.FieldEventID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Event(); ok {
+		_spec.SetField(webhookdelivery.FieldEvent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(webhookdelivery.FieldPayload, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.SchemaVersion(); ok {
+		_spec.SetField(webhookdelivery.FieldSchemaVersion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(webhookdelivery.FieldAttempts, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAttempts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhookdelivery.FieldAttempts, value)
+		})
+	}
+	if _u.mutation.AttemptsCleared() {
+		_spec.ClearField(webhookdelivery.FieldAttempts, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(webhookdelivery.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(webhookdelivery.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(webhookdelivery.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReplayCount(); ok {
+		_spec.SetField(webhookdelivery.FieldReplayCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReplayCount(); ok {
+		_spec.AddField(webhookdelivery.FieldReplayCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastReplayedAt(); ok {
+		_spec.SetField(webhookdelivery.FieldLastReplayedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastReplayedAtCleared() {
+		_spec.ClearField(webhookdelivery.FieldLastReplayedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhookdelivery.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.WebhookCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WebhookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WebhookDeliveryUpdateOne is the builder for updating a single WebhookDelivery entity.
+type WebhookDeliveryUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *WebhookDeliveryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetWebhookID sets the "webhook_id" field.
+func (_u *WebhookDeliveryUpdateOne) SetWebhookID(v int) *WebhookDeliveryUpdateOne {
+	_u.mutation.SetWebhookID(v)
+	return _u
+}
+
+// SetNillableWebhookID sets the "webhook_id" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdateOne) SetNillableWebhookID(v *int) *WebhookDeliveryUpdateOne {
+	if v != nil {
+		_u.SetWebhookID(*v)
+	}
+	return _u
+}
+
+// SetEventID sets the "event_id" field.
+func (_u *WebhookDeliveryUpdateOne) SetEventID(v string) *WebhookDeliveryUpdateOne {
+	_u.mutation.SetEventID(v)
+	return _u
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdateOne) SetNillableEventID(v *string) *WebhookDeliveryUpdateOne {
+	if v != nil {
+		_u.SetEventID(*v)
+	}
+	return _u
+}
+
+// ClearEventID clears the value of the "event_id" field.
+func (_u *WebhookDeliveryUpdateOne) ClearEventID() *WebhookDeliveryUpdateOne {
+	_u.mutation.ClearEventID()
+	return _u
+}
+
+// SetEvent sets the "event" field.
+func (_u *WebhookDeliveryUpdateOne) SetEvent(v string) *WebhookDeliveryUpdateOne {
+	_u.mutation.SetEvent(v)
+	return _u
+}
+
+// SetNillableEvent sets the "event" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdateOne) SetNillableEvent(v *string) *WebhookDeliveryUpdateOne {
+	if v != nil {
+		_u.SetEvent(*v)
+	}
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *WebhookDeliveryUpdateOne) SetPayload(v map[string]interface{}) *WebhookDeliveryUpdateOne {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// SetSchemaVersion sets the "schema_version" field.
+func (_u *WebhookDeliveryUpdateOne) SetSchemaVersion(v string) *WebhookDeliveryUpdateOne {
+	_u.mutation.SetSchemaVersion(v)
+	return _u
+}
+
+// SetNillableSchemaVersion sets the "schema_version" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdateOne) SetNillableSchemaVersion(v *string) *WebhookDeliveryUpdateOne {
+	if v != nil {
+		_u.SetSchemaVersion(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *WebhookDeliveryUpdateOne) SetAttempts(v []map[string]interface{}) *WebhookDeliveryUpdateOne {
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// AppendAttempts appends value to the "attempts" field.
+func (_u *WebhookDeliveryUpdateOne) AppendAttempts(v []map[string]interface{}) *WebhookDeliveryUpdateOne {
+	_u.mutation.AppendAttempts(v)
+	return _u
+}
+
+// ClearAttempts clears the value of the "attempts" field.
+func (_u *WebhookDeliveryUpdateOne) ClearAttempts() *WebhookDeliveryUpdateOne {
+	_u.mutation.ClearAttempts()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *WebhookDeliveryUpdateOne) SetStatus(v webhookdelivery.Status) *WebhookDeliveryUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdateOne) SetNillableStatus(v *webhookdelivery.Status) *WebhookDeliveryUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *WebhookDeliveryUpdateOne) SetLastError(v string) *WebhookDeliveryUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdateOne) SetNillableLastError(v *string) *WebhookDeliveryUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *WebhookDeliveryUpdateOne) ClearLastError() *WebhookDeliveryUpdateOne {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetReplayCount sets the "replay_count" field.
+func (_u *WebhookDeliveryUpdateOne) SetReplayCount(v int) *WebhookDeliveryUpdateOne {
+	_u.mutation.ResetReplayCount()
+	_u.mutation.SetReplayCount(v)
+	return _u
+}
+
+// SetNillableReplayCount sets the "replay_count" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdateOne) SetNillableReplayCount(v *int) *WebhookDeliveryUpdateOne {
+	if v != nil {
+		_u.SetReplayCount(*v)
+	}
+	return _u
+}
+
+// AddReplayCount adds value to the "replay_count" field.
+func (_u *WebhookDeliveryUpdateOne) AddReplayCount(v int) *WebhookDeliveryUpdateOne {
+	_u.mutation.AddReplayCount(v)
+	return _u
+}
+
+// SetLastReplayedAt sets the "last_replayed_at" field.
+func (_u *WebhookDeliveryUpdateOne) SetLastReplayedAt(v time.Time) *WebhookDeliveryUpdateOne {
+	_u.mutation.SetLastReplayedAt(v)
+	return _u
+}
+
+// SetNillableLastReplayedAt sets the "last_replayed_at" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdateOne) SetNillableLastReplayedAt(v *time.Time) *WebhookDeliveryUpdateOne {
+	if v != nil {
+		_u.SetLastReplayedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastReplayedAt clears the value of the "last_replayed_at" field.
+func (_u *WebhookDeliveryUpdateOne) ClearLastReplayedAt() *WebhookDeliveryUpdateOne {
+	_u.mutation.ClearLastReplayedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WebhookDeliveryUpdateOne) SetUpdatedAt(v time.Time) *WebhookDeliveryUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetWebhook sets the "webhook" edge to the Webhook entity.
+func (_u *WebhookDeliveryUpdateOne) SetWebhook(v *Webhook) *WebhookDeliveryUpdateOne {
+	return _u.SetWebhookID(v.ID)
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (_u *WebhookDeliveryUpdateOne) Mutation() *WebhookDeliveryMutation {
+	return _u.mutation
+}
+
+// ClearWebhook clears the "webhook" edge to the Webhook entity.
+func (_u *WebhookDeliveryUpdateOne) ClearWebhook() *WebhookDeliveryUpdateOne {
+	_u.mutation.ClearWebhook()
+	return _u
+}
+
+// Where appends a list predicates to the WebhookDeliveryUpdate builder.
+func (_u *WebhookDeliveryUpdateOne) Where(ps ...predicate.WebhookDelivery) *WebhookDeliveryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WebhookDeliveryUpdateOne) Select(field string, fields ...string) *WebhookDeliveryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated WebhookDelivery entity.
+func (_u *WebhookDeliveryUpdateOne) Save(ctx context.Context) (*WebhookDelivery, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WebhookDeliveryUpdateOne) SaveX(ctx context.Context) *WebhookDelivery {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WebhookDeliveryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WebhookDeliveryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WebhookDeliveryUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := webhookdelivery.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WebhookDeliveryUpdateOne) check() error {
+	if v, ok := _u.mutation.WebhookID(); ok {
+		if err := webhookdelivery.WebhookIDValidator(v); err != nil {
+			return &ValidationError{Name: "webhook_id", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.webhook_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Event(); ok {
+		if err := webhookdelivery.EventValidator(v); err != nil {
+			return &ValidationError{Name: "event", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.event": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := webhookdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.status": %w`, err)}
+		}
+	}
+	if _u.mutation.WebhookCleared() && len(_u.mutation.WebhookIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WebhookDelivery.webhook"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *WebhookDeliveryUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WebhookDeliveryUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *WebhookDeliveryUpdateOne) sqlSave(ctx context.Context) (_node *WebhookDelivery, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookdelivery.Table, webhookdelivery.Columns, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WebhookDelivery.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhookdelivery.FieldID)
+		for _, f := range fields {
+			if !webhookdelivery.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != webhookdelivery.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EventID(); ok {
+		_spec.SetField(webhookdelivery.FieldEventID, field.TypeString, value)
+	}
+	if _u.mutation.EventIDCleared() {
+		_spec.ClearField(webhookdelivery.FieldEventID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Event(); ok {
+		_spec.SetField(webhookdelivery.FieldEvent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(webhookdelivery.FieldPayload, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.SchemaVersion(); ok {
+		_spec.SetField(webhookdelivery.FieldSchemaVersion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(webhookdelivery.FieldAttempts, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAttempts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhookdelivery.FieldAttempts, value)
+		})
+	}
+	if _u.mutation.AttemptsCleared() {
+		_spec.ClearField(webhookdelivery.FieldAttempts, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(webhookdelivery.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(webhookdelivery.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(webhookdelivery.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReplayCount(); ok {
+		_spec.SetField(webhookdelivery.FieldReplayCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReplayCount(); ok {
+		_spec.AddField(webhookdelivery.FieldReplayCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastReplayedAt(); ok {
+		_spec.SetField(webhookdelivery.FieldLastReplayedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastReplayedAtCleared() {
+		_spec.ClearField(webhookdelivery.FieldLastReplayedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhookdelivery.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.WebhookCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WebhookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &WebhookDelivery{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
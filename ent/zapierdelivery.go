@@ -0,0 +1,155 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/jordanlanch/industrydb/ent/apikey"
+	"github.com/jordanlanch/industrydb/ent/zapierdelivery"
+)
+
+// ZapierDelivery is the model entity for the ZapierDelivery schema.
+type ZapierDelivery struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ID of the API key the lead was returned to
+	APIKeyID int `json:"api_key_id,omitempty"`
+	// ID of the lead returned
+	LeadID int `json:"lead_id,omitempty"`
+	// When the lead was first returned (and charged)
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ZapierDeliveryQuery when eager-loading is set.
+	Edges        ZapierDeliveryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ZapierDeliveryEdges holds the relations/edges for other nodes in the graph.
+type ZapierDeliveryEdges struct {
+	// API key the lead was returned to
+	APIKey *APIKey `json:"api_key,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// APIKeyOrErr returns the APIKey value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ZapierDeliveryEdges) APIKeyOrErr() (*APIKey, error) {
+	if e.APIKey != nil {
+		return e.APIKey, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: apikey.Label}
+	}
+	return nil, &NotLoadedError{edge: "api_key"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ZapierDelivery) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case zapierdelivery.FieldID, zapierdelivery.FieldAPIKeyID, zapierdelivery.FieldLeadID:
+			values[i] = new(sql.NullInt64)
+		case zapierdelivery.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ZapierDelivery fields.
+func (_m *ZapierDelivery) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case zapierdelivery.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case zapierdelivery.FieldAPIKeyID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field api_key_id", values[i])
+			} else if value.Valid {
+				_m.APIKeyID = int(value.Int64)
+			}
+		case zapierdelivery.FieldLeadID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field lead_id", values[i])
+			} else if value.Valid {
+				_m.LeadID = int(value.Int64)
+			}
+		case zapierdelivery.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ZapierDelivery.
+// This includes values selected through modifiers, order, etc.
+func (_m *ZapierDelivery) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAPIKey queries the "api_key" edge of the ZapierDelivery entity.
+func (_m *ZapierDelivery) QueryAPIKey() *APIKeyQuery {
+	return NewZapierDeliveryClient(_m.config).QueryAPIKey(_m)
+}
+
+// Update returns a builder for updating this ZapierDelivery.
+// Note that you need to call ZapierDelivery.Unwrap() before calling this method if this ZapierDelivery
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ZapierDelivery) Update() *ZapierDeliveryUpdateOne {
+	return NewZapierDeliveryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ZapierDelivery entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ZapierDelivery) Unwrap() *ZapierDelivery {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ZapierDelivery is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ZapierDelivery) String() string {
+	var builder strings.Builder
+	builder.WriteString("ZapierDelivery(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("api_key_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.APIKeyID))
+	builder.WriteString(", ")
+	builder.WriteString("lead_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LeadID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ZapierDeliveries is a parsable slice of ZapierDelivery.
+type ZapierDeliveries []*ZapierDelivery
@@ -0,0 +1,209 @@
+// Code generated by ent, DO NOT EDIT.
+
+package zapierdelivery
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ZapierDelivery {
+	return predicate.ZapierDelivery(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ZapierDelivery {
+	return predicate.ZapierDelivery(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ZapierDelivery {
+	return predicate.ZapierDelivery(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ZapierDelivery {
+	return predicate.ZapierDelivery(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ZapierDelivery {
+	return predicate.ZapierDelivery(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ZapierDelivery {
+	return predicate.ZapierDelivery(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ZapierDelivery {
+	return predicate.ZapierDelivery(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ZapierDelivery {
+	return predicate.ZapierDelivery(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ZapierDelivery {
+	return predicate.ZapierDelivery(sql.FieldLTE(FieldID, id))
+}
+
+// APIKeyID applies equality check predicate on the "api_key_id" field. It's identical to APIKeyIDEQ.
+func APIKeyID(v int) predicate.ZapierDelivery {
+	return predicate.ZapierDelivery(sql.FieldEQ(FieldAPIKeyID, v))
+}
+
+// LeadID applies equality check predicate on the "lead_id" field. It's identical to LeadIDEQ.
+func LeadID(v int) predicate.ZapierDelivery {
+	return predicate.ZapierDelivery(sql.FieldEQ(FieldLeadID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ZapierDelivery {
+	return predicate.ZapierDelivery(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// APIKeyIDEQ applies the EQ predicate on the "api_key_id" field.
+func APIKeyIDEQ(v int) predicate.ZapierDelivery {
+	return predicate.ZapierDelivery(sql.FieldEQ(FieldAPIKeyID, v))
+}
+
+// APIKeyIDNEQ applies the NEQ predicate on the "api_key_id" field.
+func APIKeyIDNEQ(v int) predicate.ZapierDelivery {
+	return predicate.ZapierDelivery(sql.FieldNEQ(FieldAPIKeyID, v))
+}
+
+// APIKeyIDIn applies the In predicate on the "api_key_id" field.
+func APIKeyIDIn(vs ...int) predicate.ZapierDelivery {
+	return predicate.ZapierDelivery(sql.FieldIn(FieldAPIKeyID, vs...))
+}
+
+// APIKeyIDNotIn applies the NotIn predicate on the "api_key_id" field.
+func APIKeyIDNotIn(vs ...int) predicate.ZapierDelivery {
+	return predicate.ZapierDelivery(sql.FieldNotIn(FieldAPIKeyID, vs...))
+}
+
+// LeadIDEQ applies the EQ predicate on the "lead_id" field.
+func LeadIDEQ(v int) predicate.ZapierDelivery {
+	return predicate.ZapierDelivery(sql.FieldEQ(FieldLeadID, v))
+}
+
+// LeadIDNEQ applies the NEQ predicate on the "lead_id" field.
+func LeadIDNEQ(v int) predicate.ZapierDelivery {
+	return predicate.ZapierDelivery(sql.FieldNEQ(FieldLeadID, v))
+}
+
+// LeadIDIn applies the In predicate on the "lead_id" field.
+func LeadIDIn(vs ...int) predicate.ZapierDelivery {
+	return predicate.ZapierDelivery(sql.FieldIn(FieldLeadID, vs...))
+}
+
+// LeadIDNotIn applies the NotIn predicate on the "lead_id" field.
+func LeadIDNotIn(vs ...int) predicate.ZapierDelivery {
+	return predicate.ZapierDelivery(sql.FieldNotIn(FieldLeadID, vs...))
+}
+
+// LeadIDGT applies the GT predicate on the "lead_id" field.
+func LeadIDGT(v int) predicate.ZapierDelivery {
+	return predicate.ZapierDelivery(sql.FieldGT(FieldLeadID, v))
+}
+
+// LeadIDGTE applies the GTE predicate on the "lead_id" field.
+func LeadIDGTE(v int) predicate.ZapierDelivery {
+	return predicate.ZapierDelivery(sql.FieldGTE(FieldLeadID, v))
+}
+
+// LeadIDLT applies the LT predicate on the "lead_id" field.
+func LeadIDLT(v int) predicate.ZapierDelivery {
+	return predicate.ZapierDelivery(sql.FieldLT(FieldLeadID, v))
+}
+
+// LeadIDLTE applies the LTE predicate on the "lead_id" field.
+func LeadIDLTE(v int) predicate.ZapierDelivery {
+	return predicate.ZapierDelivery(sql.FieldLTE(FieldLeadID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ZapierDelivery {
+	return predicate.ZapierDelivery(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ZapierDelivery {
+	return predicate.ZapierDelivery(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ZapierDelivery {
+	return predicate.ZapierDelivery(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ZapierDelivery {
+	return predicate.ZapierDelivery(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ZapierDelivery {
+	return predicate.ZapierDelivery(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ZapierDelivery {
+	return predicate.ZapierDelivery(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ZapierDelivery {
+	return predicate.ZapierDelivery(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ZapierDelivery {
+	return predicate.ZapierDelivery(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasAPIKey applies the HasEdge predicate on the "api_key" edge.
+func HasAPIKey() predicate.ZapierDelivery {
+	return predicate.ZapierDelivery(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, APIKeyTable, APIKeyColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAPIKeyWith applies the HasEdge predicate on the "api_key" edge with a given conditions (other predicates).
+func HasAPIKeyWith(preds ...predicate.APIKey) predicate.ZapierDelivery {
+	return predicate.ZapierDelivery(func(s *sql.Selector) {
+		step := newAPIKeyStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ZapierDelivery) predicate.ZapierDelivery {
+	return predicate.ZapierDelivery(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ZapierDelivery) predicate.ZapierDelivery {
+	return predicate.ZapierDelivery(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ZapierDelivery) predicate.ZapierDelivery {
+	return predicate.ZapierDelivery(sql.NotPredicates(p))
+}
@@ -0,0 +1,260 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/apikey"
+	"github.com/jordanlanch/industrydb/ent/zapierdelivery"
+)
+
+// ZapierDeliveryCreate is the builder for creating a ZapierDelivery entity.
+type ZapierDeliveryCreate struct {
+	config
+	mutation *ZapierDeliveryMutation
+	hooks    []Hook
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (_c *ZapierDeliveryCreate) SetAPIKeyID(v int) *ZapierDeliveryCreate {
+	_c.mutation.SetAPIKeyID(v)
+	return _c
+}
+
+// SetLeadID sets the "lead_id" field.
+func (_c *ZapierDeliveryCreate) SetLeadID(v int) *ZapierDeliveryCreate {
+	_c.mutation.SetLeadID(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ZapierDeliveryCreate) SetCreatedAt(v time.Time) *ZapierDeliveryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ZapierDeliveryCreate) SetNillableCreatedAt(v *time.Time) *ZapierDeliveryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetAPIKey sets the "api_key" edge to the APIKey entity.
+func (_c *ZapierDeliveryCreate) SetAPIKey(v *APIKey) *ZapierDeliveryCreate {
+	return _c.SetAPIKeyID(v.ID)
+}
+
+// Mutation returns the ZapierDeliveryMutation object of the builder.
+func (_c *ZapierDeliveryCreate) Mutation() *ZapierDeliveryMutation {
+	return _c.mutation
+}
+
+// Save creates the ZapierDelivery in the database.
+func (_c *ZapierDeliveryCreate) Save(ctx context.Context) (*ZapierDelivery, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ZapierDeliveryCreate) SaveX(ctx context.Context) *ZapierDelivery {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ZapierDeliveryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ZapierDeliveryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ZapierDeliveryCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := zapierdelivery.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ZapierDeliveryCreate) check() error {
+	if _, ok := _c.mutation.APIKeyID(); !ok {
+		return &ValidationError{Name: "api_key_id", err: errors.New(`ent: missing required field "ZapierDelivery.api_key_id"`)}
+	}
+	if v, ok := _c.mutation.APIKeyID(); ok {
+		if err := zapierdelivery.APIKeyIDValidator(v); err != nil {
+			return &ValidationError{Name: "api_key_id", err: fmt.Errorf(`ent: validator failed for field "ZapierDelivery.api_key_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LeadID(); !ok {
+		return &ValidationError{Name: "lead_id", err: errors.New(`ent: missing required field "ZapierDelivery.lead_id"`)}
+	}
+	if v, ok := _c.mutation.LeadID(); ok {
+		if err := zapierdelivery.LeadIDValidator(v); err != nil {
+			return &ValidationError{Name: "lead_id", err: fmt.Errorf(`ent: validator failed for field "ZapierDelivery.lead_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ZapierDelivery.created_at"`)}
+	}
+	if len(_c.mutation.APIKeyIDs()) == 0 {
+		return &ValidationError{Name: "api_key", err: errors.New(`ent: missing required edge "ZapierDelivery.api_key"`)}
+	}
+	return nil
+}
+
+func (_c *ZapierDeliveryCreate) sqlSave(ctx context.Context) (*ZapierDelivery, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ZapierDeliveryCreate) createSpec() (*ZapierDelivery, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ZapierDelivery{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(zapierdelivery.Table, sqlgraph.NewFieldSpec(zapierdelivery.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.LeadID(); ok {
+		_spec.SetField(zapierdelivery.FieldLeadID, field.TypeInt, value)
+		_node.LeadID = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(zapierdelivery.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.APIKeyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   zapierdelivery.APIKeyTable,
+			Columns: []string{zapierdelivery.APIKeyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.APIKeyID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ZapierDeliveryCreateBulk is the builder for creating many ZapierDelivery entities in bulk.
+type ZapierDeliveryCreateBulk struct {
+	config
+	err      error
+	builders []*ZapierDeliveryCreate
+}
+
+// Save creates the ZapierDelivery entities in the database.
+func (_c *ZapierDeliveryCreateBulk) Save(ctx context.Context) ([]*ZapierDelivery, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ZapierDelivery, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ZapierDeliveryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ZapierDeliveryCreateBulk) SaveX(ctx context.Context) []*ZapierDelivery {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ZapierDeliveryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ZapierDeliveryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,396 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jordanlanch/industrydb/ent/apikey"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+	"github.com/jordanlanch/industrydb/ent/zapierdelivery"
+)
+
+// ZapierDeliveryUpdate is the builder for updating ZapierDelivery entities.
+type ZapierDeliveryUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ZapierDeliveryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ZapierDeliveryUpdate builder.
+func (_u *ZapierDeliveryUpdate) Where(ps ...predicate.ZapierDelivery) *ZapierDeliveryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (_u *ZapierDeliveryUpdate) SetAPIKeyID(v int) *ZapierDeliveryUpdate {
+	_u.mutation.SetAPIKeyID(v)
+	return _u
+}
+
+// SetNillableAPIKeyID sets the "api_key_id" field if the given value is not nil.
+func (_u *ZapierDeliveryUpdate) SetNillableAPIKeyID(v *int) *ZapierDeliveryUpdate {
+	if v != nil {
+		_u.SetAPIKeyID(*v)
+	}
+	return _u
+}
+
+// SetLeadID sets the "lead_id" field.
+func (_u *ZapierDeliveryUpdate) SetLeadID(v int) *ZapierDeliveryUpdate {
+	_u.mutation.ResetLeadID()
+	_u.mutation.SetLeadID(v)
+	return _u
+}
+
+// SetNillableLeadID sets the "lead_id" field if the given value is not nil.
+func (_u *ZapierDeliveryUpdate) SetNillableLeadID(v *int) *ZapierDeliveryUpdate {
+	if v != nil {
+		_u.SetLeadID(*v)
+	}
+	return _u
+}
+
+// AddLeadID adds value to the "lead_id" field.
+func (_u *ZapierDeliveryUpdate) AddLeadID(v int) *ZapierDeliveryUpdate {
+	_u.mutation.AddLeadID(v)
+	return _u
+}
+
+// SetAPIKey sets the "api_key" edge to the APIKey entity.
+func (_u *ZapierDeliveryUpdate) SetAPIKey(v *APIKey) *ZapierDeliveryUpdate {
+	return _u.SetAPIKeyID(v.ID)
+}
+
+// Mutation returns the ZapierDeliveryMutation object of the builder.
+func (_u *ZapierDeliveryUpdate) Mutation() *ZapierDeliveryMutation {
+	return _u.mutation
+}
+
+// ClearAPIKey clears the "api_key" edge to the APIKey entity.
+func (_u *ZapierDeliveryUpdate) ClearAPIKey() *ZapierDeliveryUpdate {
+	_u.mutation.ClearAPIKey()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ZapierDeliveryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ZapierDeliveryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ZapierDeliveryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ZapierDeliveryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ZapierDeliveryUpdate) check() error {
+	if v, ok := _u.mutation.APIKeyID(); ok {
+		if err := zapierdelivery.APIKeyIDValidator(v); err != nil {
+			return &ValidationError{Name: "api_key_id", err: fmt.Errorf(`ent: validator failed for field "ZapierDelivery.api_key_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LeadID(); ok {
+		if err := zapierdelivery.LeadIDValidator(v); err != nil {
+			return &ValidationError{Name: "lead_id", err: fmt.Errorf(`ent: validator failed for field "ZapierDelivery.lead_id": %w`, err)}
+		}
+	}
+	if _u.mutation.APIKeyCleared() && len(_u.mutation.APIKeyIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ZapierDelivery.api_key"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ZapierDeliveryUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ZapierDeliveryUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ZapierDeliveryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(zapierdelivery.Table, zapierdelivery.Columns, sqlgraph.NewFieldSpec(zapierdelivery.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.LeadID(); ok {
+		_spec.SetField(zapierdelivery.FieldLeadID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLeadID(); ok {
+		_spec.AddField(zapierdelivery.FieldLeadID, field.TypeInt, value)
+	}
+	if _u.mutation.APIKeyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   zapierdelivery.APIKeyTable,
+			Columns: []string{zapierdelivery.APIKeyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.APIKeyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   zapierdelivery.APIKeyTable,
+			Columns: []string{zapierdelivery.APIKeyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{zapierdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ZapierDeliveryUpdateOne is the builder for updating a single ZapierDelivery entity.
+type ZapierDeliveryUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ZapierDeliveryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (_u *ZapierDeliveryUpdateOne) SetAPIKeyID(v int) *ZapierDeliveryUpdateOne {
+	_u.mutation.SetAPIKeyID(v)
+	return _u
+}
+
+// SetNillableAPIKeyID sets the "api_key_id" field if the given value is not nil.
+func (_u *ZapierDeliveryUpdateOne) SetNillableAPIKeyID(v *int) *ZapierDeliveryUpdateOne {
+	if v != nil {
+		_u.SetAPIKeyID(*v)
+	}
+	return _u
+}
+
+// SetLeadID sets the "lead_id" field.
+func (_u *ZapierDeliveryUpdateOne) SetLeadID(v int) *ZapierDeliveryUpdateOne {
+	_u.mutation.ResetLeadID()
+	_u.mutation.SetLeadID(v)
+	return _u
+}
+
+// SetNillableLeadID sets the "lead_id" field if the given value is not nil.
+func (_u *ZapierDeliveryUpdateOne) SetNillableLeadID(v *int) *ZapierDeliveryUpdateOne {
+	if v != nil {
+		_u.SetLeadID(*v)
+	}
+	return _u
+}
+
+// AddLeadID adds value to the "lead_id" field.
+func (_u *ZapierDeliveryUpdateOne) AddLeadID(v int) *ZapierDeliveryUpdateOne {
+	_u.mutation.AddLeadID(v)
+	return _u
+}
+
+// SetAPIKey sets the "api_key" edge to the APIKey entity.
+func (_u *ZapierDeliveryUpdateOne) SetAPIKey(v *APIKey) *ZapierDeliveryUpdateOne {
+	return _u.SetAPIKeyID(v.ID)
+}
+
+// Mutation returns the ZapierDeliveryMutation object of the builder.
+func (_u *ZapierDeliveryUpdateOne) Mutation() *ZapierDeliveryMutation {
+	return _u.mutation
+}
+
+// ClearAPIKey clears the "api_key" edge to the APIKey entity.
+func (_u *ZapierDeliveryUpdateOne) ClearAPIKey() *ZapierDeliveryUpdateOne {
+	_u.mutation.ClearAPIKey()
+	return _u
+}
+
+// Where appends a list predicates to the ZapierDeliveryUpdate builder.
+func (_u *ZapierDeliveryUpdateOne) Where(ps ...predicate.ZapierDelivery) *ZapierDeliveryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ZapierDeliveryUpdateOne) Select(field string, fields ...string) *ZapierDeliveryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ZapierDelivery entity.
+func (_u *ZapierDeliveryUpdateOne) Save(ctx context.Context) (*ZapierDelivery, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ZapierDeliveryUpdateOne) SaveX(ctx context.Context) *ZapierDelivery {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ZapierDeliveryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ZapierDeliveryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ZapierDeliveryUpdateOne) check() error {
+	if v, ok := _u.mutation.APIKeyID(); ok {
+		if err := zapierdelivery.APIKeyIDValidator(v); err != nil {
+			return &ValidationError{Name: "api_key_id", err: fmt.Errorf(`ent: validator failed for field "ZapierDelivery.api_key_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LeadID(); ok {
+		if err := zapierdelivery.LeadIDValidator(v); err != nil {
+			return &ValidationError{Name: "lead_id", err: fmt.Errorf(`ent: validator failed for field "ZapierDelivery.lead_id": %w`, err)}
+		}
+	}
+	if _u.mutation.APIKeyCleared() && len(_u.mutation.APIKeyIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ZapierDelivery.api_key"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ZapierDeliveryUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ZapierDeliveryUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ZapierDeliveryUpdateOne) sqlSave(ctx context.Context) (_node *ZapierDelivery, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(zapierdelivery.Table, zapierdelivery.Columns, sqlgraph.NewFieldSpec(zapierdelivery.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ZapierDelivery.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, zapierdelivery.FieldID)
+		for _, f := range fields {
+			if !zapierdelivery.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != zapierdelivery.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.LeadID(); ok {
+		_spec.SetField(zapierdelivery.FieldLeadID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLeadID(); ok {
+		_spec.AddField(zapierdelivery.FieldLeadID, field.TypeInt, value)
+	}
+	if _u.mutation.APIKeyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   zapierdelivery.APIKeyTable,
+			Columns: []string{zapierdelivery.APIKeyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.APIKeyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   zapierdelivery.APIKeyTable,
+			Columns: []string{zapierdelivery.APIKeyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ZapierDelivery{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{zapierdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -79,6 +79,7 @@ type ComplexityRoot struct {
 		Edges      func(childComplexity int) int
 		PageInfo   func(childComplexity int) int
 		TotalCount func(childComplexity int) int
+		ZoomIn     func(childComplexity int) int
 	}
 
 	LeadEdge struct {
@@ -97,6 +98,8 @@ type ComplexityRoot struct {
 		EndCursor       func(childComplexity int) int
 		HasNextPage     func(childComplexity int) int
 		HasPreviousPage func(childComplexity int) int
+		Limit           func(childComplexity int) int
+		LimitClamped    func(childComplexity int) int
 		StartCursor     func(childComplexity int) int
 	}
 
@@ -297,6 +300,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.LeadConnection.TotalCount(childComplexity), true
+	case "LeadConnection.zoomIn":
+		if e.complexity.LeadConnection.ZoomIn == nil {
+			break
+		}
+
+		return e.complexity.LeadConnection.ZoomIn(childComplexity), true
 
 	case "LeadEdge.cursor":
 		if e.complexity.LeadEdge.Cursor == nil {
@@ -369,6 +378,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.PageInfo.HasPreviousPage(childComplexity), true
+	case "PageInfo.limit":
+		if e.complexity.PageInfo.Limit == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.Limit(childComplexity), true
+	case "PageInfo.limitClamped":
+		if e.complexity.PageInfo.LimitClamped == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.LimitClamped(childComplexity), true
 	case "PageInfo.startCursor":
 		if e.complexity.PageInfo.StartCursor == nil {
 			break
@@ -535,6 +556,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	opCtx := graphql.GetOperationContext(ctx)
 	ec := executionContext{opCtx, e, 0, 0, make(chan graphql.DeferredResult)}
 	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputBoundingBoxInput,
 		ec.unmarshalInputLeadSearchInput,
 		ec.unmarshalInputLoginInput,
 		ec.unmarshalInputRegisterInput,
@@ -1395,6 +1417,10 @@ func (ec *executionContext) fieldContext_LeadConnection_pageInfo(_ context.Conte
 				return ec.fieldContext_PageInfo_startCursor(ctx, field)
 			case "endCursor":
 				return ec.fieldContext_PageInfo_endCursor(ctx, field)
+			case "limit":
+				return ec.fieldContext_PageInfo_limit(ctx, field)
+			case "limitClamped":
+				return ec.fieldContext_PageInfo_limitClamped(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
 		},
@@ -1431,6 +1457,35 @@ func (ec *executionContext) fieldContext_LeadConnection_totalCount(_ context.Con
 	return fc, nil
 }
 
+func (ec *executionContext) _LeadConnection_zoomIn(ctx context.Context, field graphql.CollectedField, obj *model.LeadConnection) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LeadConnection_zoomIn,
+		func(ctx context.Context) (any, error) {
+			return obj.ZoomIn, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_LeadConnection_zoomIn(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LeadConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _LeadEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.LeadEdge) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -1811,6 +1866,64 @@ func (ec *executionContext) fieldContext_PageInfo_endCursor(_ context.Context, f
 	return fc, nil
 }
 
+func (ec *executionContext) _PageInfo_limit(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PageInfo_limit,
+		func(ctx context.Context) (any, error) {
+			return obj.Limit, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_limit(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PageInfo_limitClamped(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PageInfo_limitClamped,
+		func(ctx context.Context) (any, error) {
+			return obj.LimitClamped, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_limitClamped(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Query_me(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -1960,6 +2073,8 @@ func (ec *executionContext) fieldContext_Query_leads(ctx context.Context, field
 				return ec.fieldContext_LeadConnection_pageInfo(ctx, field)
 			case "totalCount":
 				return ec.fieldContext_LeadConnection_totalCount(ctx, field)
+			case "zoomIn":
+				return ec.fieldContext_LeadConnection_zoomIn(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type LeadConnection", field.Name)
 		},
@@ -4117,6 +4232,54 @@ func (ec *executionContext) fieldContext___Type_isOneOf(_ context.Context, field
 
 // region    **************************** input.gotpl *****************************
 
+func (ec *executionContext) unmarshalInputBoundingBoxInput(ctx context.Context, obj any) (model.BoundingBoxInput, error) {
+	var it model.BoundingBoxInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"minLat", "minLng", "maxLat", "maxLng"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "minLat":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("minLat"))
+			data, err := ec.unmarshalNFloat2float64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MinLat = data
+		case "minLng":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("minLng"))
+			data, err := ec.unmarshalNFloat2float64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MinLng = data
+		case "maxLat":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maxLat"))
+			data, err := ec.unmarshalNFloat2float64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MaxLat = data
+		case "maxLng":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maxLng"))
+			data, err := ec.unmarshalNFloat2float64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MaxLng = data
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputLeadSearchInput(ctx context.Context, obj any) (model.LeadSearchInput, error) {
 	var it model.LeadSearchInput
 	asMap := map[string]any{}
@@ -4124,7 +4287,7 @@ func (ec *executionContext) unmarshalInputLeadSearchInput(ctx context.Context, o
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"industry", "country", "city", "hasEmail", "hasPhone", "minQualityScore", "limit", "offset"}
+	fieldsInOrder := [...]string{"industry", "industries", "country", "city", "hasEmail", "hasPhone", "hasWebsite", "hasAddress", "missing", "verified", "minQualityScore", "maxQualityScore", "boundingBox", "limit", "offset"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -4138,6 +4301,13 @@ func (ec *executionContext) unmarshalInputLeadSearchInput(ctx context.Context, o
 				return it, err
 			}
 			it.Industry = data
+		case "industries":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("industries"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Industries = data
 		case "country":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("country"))
 			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
@@ -4166,6 +4336,34 @@ func (ec *executionContext) unmarshalInputLeadSearchInput(ctx context.Context, o
 				return it, err
 			}
 			it.HasPhone = data
+		case "hasWebsite":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hasWebsite"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.HasWebsite = data
+		case "hasAddress":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hasAddress"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.HasAddress = data
+		case "missing":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("missing"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Missing = data
+		case "verified":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("verified"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Verified = data
 		case "minQualityScore":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("minQualityScore"))
 			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
@@ -4173,6 +4371,20 @@ func (ec *executionContext) unmarshalInputLeadSearchInput(ctx context.Context, o
 				return it, err
 			}
 			it.MinQualityScore = data
+		case "maxQualityScore":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maxQualityScore"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MaxQualityScore = data
+		case "boundingBox":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("boundingBox"))
+			data, err := ec.unmarshalOBoundingBoxInput2ᚖgithubᚗcomᚋjordanlanchᚋindustrydbᚋgraphᚋmodelᚐBoundingBoxInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.BoundingBox = data
 		case "limit":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
 			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
@@ -4473,6 +4685,11 @@ func (ec *executionContext) _LeadConnection(ctx context.Context, sel ast.Selecti
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "zoomIn":
+			out.Values[i] = ec._LeadConnection_zoomIn(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -4635,6 +4852,16 @@ func (ec *executionContext) _PageInfo(ctx context.Context, sel ast.SelectionSet,
 			out.Values[i] = ec._PageInfo_startCursor(ctx, field, obj)
 		case "endCursor":
 			out.Values[i] = ec._PageInfo_endCursor(ctx, field, obj)
+		case "limit":
+			out.Values[i] = ec._PageInfo_limit(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "limitClamped":
+			out.Values[i] = ec._PageInfo_limitClamped(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -5889,6 +6116,14 @@ func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast
 	return res
 }
 
+func (ec *executionContext) unmarshalOBoundingBoxInput2ᚖgithubᚗcomᚋjordanlanchᚋindustrydbᚋgraphᚋmodelᚐBoundingBoxInput(ctx context.Context, v any) (*model.BoundingBoxInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputBoundingBoxInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalOFloat2ᚖfloat64(ctx context.Context, v any) (*float64, error) {
 	if v == nil {
 		return nil, nil
@@ -5931,6 +6166,42 @@ func (ec *executionContext) marshalOLead2ᚖgithubᚗcomᚋjordanlanchᚋindustr
 	return ec._Lead(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalOString2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalOString2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
+	}
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) unmarshalOString2ᚖstring(ctx context.Context, v any) (*string, error) {
 	if v == nil {
 		return nil, nil
@@ -11,6 +11,13 @@ type AuthResponse struct {
 	User  *User  `json:"user"`
 }
 
+type BoundingBoxInput struct {
+	MinLat float64 `json:"minLat"`
+	MinLng float64 `json:"minLng"`
+	MaxLat float64 `json:"maxLat"`
+	MaxLng float64 `json:"maxLng"`
+}
+
 type GenericResponse struct {
 	Success bool   `json:"success"`
 	Message string `json:"message"`
@@ -37,6 +44,7 @@ type LeadConnection struct {
 	Edges      []*LeadEdge `json:"edges"`
 	PageInfo   *PageInfo   `json:"pageInfo"`
 	TotalCount int         `json:"totalCount"`
+	ZoomIn     bool        `json:"zoomIn"`
 }
 
 type LeadEdge struct {
@@ -45,14 +53,21 @@ type LeadEdge struct {
 }
 
 type LeadSearchInput struct {
-	Industry        *string `json:"industry,omitempty"`
-	Country         *string `json:"country,omitempty"`
-	City            *string `json:"city,omitempty"`
-	HasEmail        *bool   `json:"hasEmail,omitempty"`
-	HasPhone        *bool   `json:"hasPhone,omitempty"`
-	MinQualityScore *int    `json:"minQualityScore,omitempty"`
-	Limit           *int    `json:"limit,omitempty"`
-	Offset          *int    `json:"offset,omitempty"`
+	Industry        *string           `json:"industry,omitempty"`
+	Industries      []string          `json:"industries,omitempty"`
+	Country         *string           `json:"country,omitempty"`
+	City            *string           `json:"city,omitempty"`
+	HasEmail        *bool             `json:"hasEmail,omitempty"`
+	HasPhone        *bool             `json:"hasPhone,omitempty"`
+	HasWebsite      *bool             `json:"hasWebsite,omitempty"`
+	HasAddress      *bool             `json:"hasAddress,omitempty"`
+	Missing         []string          `json:"missing,omitempty"`
+	Verified        *bool             `json:"verified,omitempty"`
+	MinQualityScore *int              `json:"minQualityScore,omitempty"`
+	MaxQualityScore *int              `json:"maxQualityScore,omitempty"`
+	BoundingBox     *BoundingBoxInput `json:"boundingBox,omitempty"`
+	Limit           *int              `json:"limit,omitempty"`
+	Offset          *int              `json:"offset,omitempty"`
 }
 
 type LoginInput struct {
@@ -68,6 +83,8 @@ type PageInfo struct {
 	HasPreviousPage bool    `json:"hasPreviousPage"`
 	StartCursor     *string `json:"startCursor,omitempty"`
 	EndCursor       *string `json:"endCursor,omitempty"`
+	Limit           int     `json:"limit"`
+	LimitClamped    bool    `json:"limitClamped"`
 }
 
 type Query struct {
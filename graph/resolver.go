@@ -1,8 +1,11 @@
 package graph
 
 import (
+	"context"
+
 	"github.com/jordanlanch/industrydb/ent"
 	"github.com/jordanlanch/industrydb/pkg/analytics"
+	"github.com/jordanlanch/industrydb/pkg/auth"
 	"github.com/jordanlanch/industrydb/pkg/domain"
 	"github.com/jordanlanch/industrydb/pkg/export"
 	"github.com/jordanlanch/industrydb/pkg/leads"
@@ -19,6 +22,15 @@ type Resolver struct {
 	ExportService      *export.Service
 	AnalyticsService   *analytics.Service
 	TokenBlacklist     domain.TokenBlacklist
+	SessionStore       *auth.SessionStore
 	JWTSecret          string
 	JWTExpirationHours int
 }
+
+// issueToken generates a JWT for a user signing in through a mutation and,
+// when SessionStore is set, tracks it as a session of the requesting client
+func (r *Resolver) issueToken(ctx context.Context, u *ent.User) (string, error) {
+	ipAddress, _ := ctx.Value("ip_address").(string)
+	userAgent, _ := ctx.Value("user_agent").(string)
+	return auth.IssueToken(ctx, r.SessionStore, u.ID, u.Email, u.SubscriptionTier.String(), r.JWTSecret, r.JWTExpirationHours, userAgent, ipAddress)
+}
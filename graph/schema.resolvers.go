@@ -12,10 +12,12 @@ import (
 	"time"
 
 	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/lead"
 	"github.com/jordanlanch/industrydb/ent/subscription"
 	"github.com/jordanlanch/industrydb/ent/user"
 	"github.com/jordanlanch/industrydb/graph/model"
 	"github.com/jordanlanch/industrydb/pkg/auth"
+	"github.com/jordanlanch/industrydb/pkg/leads"
 	"github.com/jordanlanch/industrydb/pkg/models"
 )
 
@@ -32,14 +34,15 @@ func (r *mutationResolver) Register(ctx context.Context, input model.RegisterInp
 		SetEmail(input.Email).
 		SetPasswordHash(hashedPassword).
 		SetName(input.Name).
+		SetUsageLimit(leads.GetUsageLimitForTier(string(user.SubscriptionTierFree))).
 		SetAcceptedTermsAt(time.Now()).
 		Save(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	// Generate JWT token
-	token, err := auth.GenerateJWT(u.ID, u.Email, u.SubscriptionTier.String(), r.Resolver.JWTSecret, r.Resolver.JWTExpirationHours)
+	// Generate JWT token, tracked as a session like REST logins
+	token, err := r.Resolver.issueToken(ctx, u)
 	if err != nil {
 		return nil, err
 	}
@@ -76,8 +79,8 @@ func (r *mutationResolver) Login(ctx context.Context, input model.LoginInput) (*
 		return nil, fmt.Errorf("invalid credentials")
 	}
 
-	// Generate JWT token
-	token, err := auth.GenerateJWT(u.ID, u.Email, u.SubscriptionTier.String(), r.Resolver.JWTSecret, r.Resolver.JWTExpirationHours)
+	// Generate JWT token, tracked as a session like REST logins
+	token, err := r.Resolver.issueToken(ctx, u)
 	if err != nil {
 		return nil, err
 	}
@@ -129,12 +132,33 @@ func (r *mutationResolver) ExportLeads(ctx context.Context, input model.LeadSear
 
 	// Map GraphQL input to lead search filters
 	filters := models.LeadSearchRequest{
-		HasEmail: input.HasEmail,
-		HasPhone: input.HasPhone,
+		HasEmail:   input.HasEmail,
+		HasPhone:   input.HasPhone,
+		HasWebsite: input.HasWebsite,
+		HasAddress: input.HasAddress,
+		Verified:   input.Verified,
 	}
 	if input.Industry != nil {
 		filters.Industry = *input.Industry
 	}
+	if err := validateIndustries(input.Industries); err != nil {
+		return nil, err
+	}
+	filters.Industries = input.Industries
+	if err := validateMissing(input.Missing); err != nil {
+		return nil, err
+	}
+	filters.Missing = input.Missing
+	if err := validateQualityRange(input.MinQualityScore, input.MaxQualityScore); err != nil {
+		return nil, err
+	}
+	filters.MinQuality = input.MinQualityScore
+	filters.MaxQuality = input.MaxQualityScore
+	bbox, err := boundingBoxParam(input.BoundingBox)
+	if err != nil {
+		return nil, err
+	}
+	filters.BBox = bbox
 	if input.Country != nil {
 		filters.Country = *input.Country
 	}
@@ -146,14 +170,15 @@ func (r *mutationResolver) ExportLeads(ctx context.Context, input model.LeadSear
 	}
 
 	// Create export request
+	// MaxLeads is left unset so the export service applies its default of
+	// 1000 rows, lowered to the tier's export row cap
 	exportReq := models.ExportRequest{
-		Format:   "csv", // Default to CSV
-		Filters:  filters,
-		MaxLeads: 1000, // Default limit
+		Format:  "csv", // Default to CSV
+		Filters: filters,
 	}
 
 	// Create export via service (async processing)
-	_, err := r.Resolver.ExportService.CreateExport(ctx, userID, nil, exportReq)
+	_, err = r.Resolver.ExportService.CreateExport(ctx, userID, nil, exportReq)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create export: %w", err)
 	}
@@ -199,8 +224,9 @@ func (r *queryResolver) Lead(ctx context.Context, id string) (*model.Lead, error
 		return nil, fmt.Errorf("invalid lead ID")
 	}
 
-	// Get lead from service
-	lead, err := r.Resolver.LeadService.GetByID(ctx, leadID)
+	// Get lead from service. GraphQL has no organization context, so like
+	// the leads query it only sees the global pool when lead scoping is on.
+	lead, err := r.Resolver.LeadService.GetByID(ctx, leadID, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -213,27 +239,59 @@ func (r *queryResolver) Lead(ctx context.Context, id string) (*model.Lead, error
 func (r *queryResolver) Leads(ctx context.Context, input model.LeadSearchInput) (*model.LeadConnection, error) {
 	// Map GraphQL input to lead search request
 	req := models.LeadSearchRequest{
-		HasEmail: input.HasEmail,
-		HasPhone: input.HasPhone,
+		HasEmail:   input.HasEmail,
+		HasPhone:   input.HasPhone,
+		HasWebsite: input.HasWebsite,
+		HasAddress: input.HasAddress,
+		Verified:   input.Verified,
 	}
 	if input.Industry != nil {
 		req.Industry = *input.Industry
 	}
+	if err := validateIndustries(input.Industries); err != nil {
+		return nil, err
+	}
+	req.Industries = input.Industries
+	if err := validateMissing(input.Missing); err != nil {
+		return nil, err
+	}
+	req.Missing = input.Missing
 	if input.Country != nil {
 		req.Country = *input.Country
 	}
 	if input.City != nil {
 		req.City = *input.City
 	}
-	if input.MinQualityScore != nil {
-		// Note: MinQualityScore not in LeadSearchRequest, would need to add
-		_ = *input.MinQualityScore
+	if err := validateQualityRange(input.MinQualityScore, input.MaxQualityScore); err != nil {
+		return nil, err
 	}
+	req.MinQuality = input.MinQualityScore
+	req.MaxQuality = input.MaxQualityScore
+	bbox, err := boundingBoxParam(input.BoundingBox)
+	if err != nil {
+		return nil, err
+	}
+	req.BBox = bbox
 	if input.Limit != nil {
 		req.Limit = *input.Limit
 	} else {
 		req.Limit = 50
 	}
+	// Clamp oversized pages to the caller's tier maximum and apply its
+	// verified-only default and field projection (free for anonymous)
+	tier := "free"
+	if userID, ok := ctx.Value("user_id").(int); ok {
+		tier, err = r.Resolver.LeadService.GetExportTier(ctx, userID, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	req.MaxLimit = leads.GetMaxPageSizeForTier(tier)
+	leads.ApplyVerifiedDefault(&req, tier)
+	req.HiddenFields = leads.GetSearchHiddenFieldsForTier(tier)
+	if req.Limit > req.MaxLimit {
+		req.Limit = req.MaxLimit
+	}
 	req.Page = 1
 	if input.Offset != nil {
 		req.Page = (*input.Offset / req.Limit) + 1
@@ -273,8 +331,11 @@ func (r *queryResolver) Leads(ctx context.Context, input model.LeadSearchInput)
 			HasPreviousPage: hasPreviousPage,
 			StartCursor:     startCursor,
 			EndCursor:       endCursor,
+			Limit:           response.Pagination.Limit,
+			LimitClamped:    input.Limit != nil && *input.Limit > response.Pagination.Limit,
 		},
 		TotalCount: response.Pagination.Total,
+		ZoomIn:     response.ZoomIn,
 	}, nil
 }
 
@@ -403,3 +464,54 @@ func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
 type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+
+// Helper function to validate industry IDs against the known industries
+func validateIndustries(industries []string) error {
+	for _, industry := range industries {
+		if err := lead.IndustryValidator(lead.Industry(industry)); err != nil {
+			return fmt.Errorf("invalid industry: %s", industry)
+		}
+	}
+	return nil
+}
+
+// Helper function to validate the fields of the missing filter
+func validateMissing(fields []string) error {
+	for _, field := range fields {
+		switch field {
+		case "email", "phone", "website", "address":
+		default:
+			return fmt.Errorf("invalid missing field: %s (use email, phone, website or address)", field)
+		}
+	}
+	return nil
+}
+
+// Helper function to validate quality score bounds (0-100, min <= max)
+func validateQualityRange(minScore, maxScore *int) error {
+	if minScore != nil && (*minScore < 0 || *minScore > 100) {
+		return fmt.Errorf("minQualityScore must be between 0 and 100")
+	}
+	if maxScore != nil && (*maxScore < 0 || *maxScore > 100) {
+		return fmt.Errorf("maxQualityScore must be between 0 and 100")
+	}
+	if minScore != nil && maxScore != nil && *minScore > *maxScore {
+		return fmt.Errorf("minQualityScore cannot be greater than maxQualityScore")
+	}
+	return nil
+}
+
+// Helper function to convert a bounding box input to the bbox search
+// parameter, validating it
+func boundingBoxParam(box *model.BoundingBoxInput) (string, error) {
+	if box == nil {
+		return "", nil
+	}
+	bbox := fmt.Sprintf("%s,%s,%s,%s",
+		strconv.FormatFloat(box.MinLng, 'f', -1, 64), strconv.FormatFloat(box.MinLat, 'f', -1, 64),
+		strconv.FormatFloat(box.MaxLng, 'f', -1, 64), strconv.FormatFloat(box.MaxLat, 'f', -1, 64))
+	if _, err := leads.ParseBoundingBox(bbox); err != nil {
+		return "", err
+	}
+	return bbox, nil
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/alicebob/miniredis/v2"
 	"github.com/jordanlanch/industrydb/ent"
 	"github.com/jordanlanch/industrydb/ent/enttest"
 	entlead "github.com/jordanlanch/industrydb/ent/lead"
@@ -16,6 +17,7 @@ import (
 	"github.com/jordanlanch/industrydb/graph/model"
 	"github.com/jordanlanch/industrydb/pkg/analytics"
 	"github.com/jordanlanch/industrydb/pkg/auth"
+	"github.com/jordanlanch/industrydb/pkg/cache"
 	"github.com/jordanlanch/industrydb/pkg/export"
 	"github.com/jordanlanch/industrydb/pkg/leads"
 	_ "github.com/mattn/go-sqlite3"
@@ -275,6 +277,68 @@ func TestLeads(t *testing.T) {
 		}
 	})
 
+	t.Run("filter by multiple industries", func(t *testing.T) {
+		input := model.LeadSearchInput{Industries: []string{"tattoo", "beauty"}}
+		conn, err := queryRes.Leads(context.Background(), input)
+		require.NoError(t, err)
+
+		assert.Equal(t, 4, conn.TotalCount)
+		for _, edge := range conn.Edges {
+			assert.Contains(t, []string{"tattoo", "beauty"}, edge.Node.Industry)
+		}
+	})
+
+	t.Run("filter by multiple industries and country", func(t *testing.T) {
+		input := model.LeadSearchInput{Industries: []string{"beauty", "gym"}, Country: stringPtr("US")}
+		conn, err := queryRes.Leads(context.Background(), input)
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, conn.TotalCount)
+	})
+
+	t.Run("filter by website and address presence", func(t *testing.T) {
+		input := model.LeadSearchInput{Industry: stringPtr("tattoo"), HasAddress: boolPtr(false)}
+		conn, err := queryRes.Leads(context.Background(), input)
+		require.NoError(t, err)
+		assert.Equal(t, 3, conn.TotalCount, "hasAddress false doesn't filter")
+
+		input = model.LeadSearchInput{HasWebsite: boolPtr(true)}
+		conn, err = queryRes.Leads(context.Background(), input)
+		require.NoError(t, err)
+		assert.Equal(t, 0, conn.TotalCount, "seeded leads have no website")
+	})
+
+	t.Run("filter by missing fields", func(t *testing.T) {
+		input := model.LeadSearchInput{Industry: stringPtr("tattoo"), Missing: []string{"website"}}
+		conn, err := queryRes.Leads(context.Background(), input)
+		require.NoError(t, err)
+		assert.Equal(t, 3, conn.TotalCount, "seeded leads have no website")
+
+		input = model.LeadSearchInput{Missing: []string{"fax"}}
+		_, err = queryRes.Leads(context.Background(), input)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid missing field")
+	})
+
+	t.Run("min quality greater than max", func(t *testing.T) {
+		input := model.LeadSearchInput{MinQualityScore: intPtr(80), MaxQualityScore: intPtr(20)}
+		_, err := queryRes.Leads(context.Background(), input)
+		require.Error(t, err)
+	})
+
+	t.Run("quality out of range", func(t *testing.T) {
+		input := model.LeadSearchInput{MinQualityScore: intPtr(150)}
+		_, err := queryRes.Leads(context.Background(), input)
+		require.Error(t, err)
+	})
+
+	t.Run("invalid industry in list", func(t *testing.T) {
+		input := model.LeadSearchInput{Industries: []string{"tattoo", "spaceships"}}
+		_, err := queryRes.Leads(context.Background(), input)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid industry")
+	})
+
 	t.Run("filter by country", func(t *testing.T) {
 		input := model.LeadSearchInput{Country: stringPtr("US")}
 		conn, err := queryRes.Leads(context.Background(), input)
@@ -386,6 +450,37 @@ func TestLeads(t *testing.T) {
 		assert.NotEmpty(t, edge.Node.ID)
 		assert.NotEmpty(t, edge.Node.Name)
 	})
+
+	t.Run("over-limit page size is clamped per tier", func(t *testing.T) {
+		leads.SetTierMaxPageSizes(leads.TierMaxPageSizes{"free": 2, "business": 3})
+		t.Cleanup(func() { leads.SetTierMaxPageSizes(nil) })
+
+		// Anonymous and free callers get the free maximum
+		conn, err := queryRes.Leads(context.Background(), model.LeadSearchInput{Limit: intPtr(100000)})
+		require.NoError(t, err)
+		assert.Len(t, conn.Edges, 2)
+		assert.Equal(t, 2, conn.PageInfo.Limit)
+		assert.True(t, conn.PageInfo.LimitClamped)
+		assert.True(t, conn.PageInfo.HasNextPage)
+
+		business := createTestUser(t, resolver.DB, "business@example.com", "Business")
+		resolver.DB.User.UpdateOne(business).SetSubscriptionTier(user.SubscriptionTierBusiness).ExecX(context.Background())
+		conn, err = queryRes.Leads(ctxWithUser(business.ID), model.LeadSearchInput{Limit: intPtr(100000)})
+		require.NoError(t, err)
+		assert.Len(t, conn.Edges, 3)
+		assert.Equal(t, 3, conn.PageInfo.Limit)
+		assert.True(t, conn.PageInfo.LimitClamped)
+
+		// Offsets advance by the clamped page size
+		conn, err = queryRes.Leads(ctxWithUser(business.ID), model.LeadSearchInput{Limit: intPtr(100000), Offset: intPtr(3)})
+		require.NoError(t, err)
+		assert.Len(t, conn.Edges, 2)
+		assert.True(t, conn.PageInfo.HasPreviousPage)
+
+		conn, err = queryRes.Leads(ctxWithUser(business.ID), model.LeadSearchInput{Limit: intPtr(3)})
+		require.NoError(t, err)
+		assert.False(t, conn.PageInfo.LimitClamped)
+	})
 }
 
 // ---------------------------------------------------------------------------
@@ -464,6 +559,20 @@ func TestRegister(t *testing.T) {
 		assert.NoError(t, parseErr)
 		assert.Greater(t, id, 0)
 	})
+
+	t.Run("uses configured free tier usage limit", func(t *testing.T) {
+		leads.SetTierUsageLimits(leads.TierUsageLimits{"free": 75})
+		defer leads.SetTierUsageLimits(nil)
+
+		input := model.RegisterInput{
+			Email:    "configuredlimit@example.com",
+			Password: "pass123",
+			Name:     "Configured Limit",
+		}
+		resp, err := mutationRes.Register(context.Background(), input)
+		require.NoError(t, err)
+		assert.Equal(t, 75, resp.User.UsageLimit)
+	})
 }
 
 // ---------------------------------------------------------------------------
@@ -533,6 +642,52 @@ func TestLogin(t *testing.T) {
 	})
 }
 
+func TestLoginAndRegister_TrackSessions(t *testing.T) {
+	resolver, _, mutationRes, cleanup := setupTestResolver(t)
+	defer cleanup()
+
+	mr := miniredis.RunT(t)
+	redisClient, err := cache.NewClient("redis://" + mr.Addr())
+	require.NoError(t, err)
+	defer redisClient.Close()
+	sessions := auth.NewSessionStore(redisClient, auth.NewTokenBlacklist(redisClient))
+	resolver.SessionStore = sessions
+
+	ctx := context.WithValue(context.Background(), "ip_address", "203.0.113.7")
+	ctx = context.WithValue(ctx, "user_agent", "graphql-client/1.0")
+
+	registered, err := mutationRes.Register(ctx, model.RegisterInput{
+		Email:    "sessions@example.com",
+		Password: "SecurePass123!",
+		Name:     "Session User",
+	})
+	require.NoError(t, err)
+	loggedIn, err := mutationRes.Login(ctx, model.LoginInput{
+		Email:    "sessions@example.com",
+		Password: "SecurePass123!",
+	})
+	require.NoError(t, err)
+
+	// Both tokens are listed as sessions of the user, like REST logins
+	userID, err := strconv.Atoi(registered.User.ID)
+	require.NoError(t, err)
+	listed, err := sessions.List(context.Background(), userID, "")
+	require.NoError(t, err)
+	require.Len(t, listed, 2)
+
+	ids := []string{}
+	for _, token := range []string{registered.Token, loggedIn.Token} {
+		claims, err := auth.ValidateJWT(token, "test-secret")
+		require.NoError(t, err)
+		ids = append(ids, claims.ID)
+	}
+	for _, session := range listed {
+		assert.Contains(t, ids, session.ID)
+		assert.Equal(t, "203.0.113.7", session.IPAddress)
+		assert.Equal(t, "graphql-client/1.0", session.UserAgent)
+	}
+}
+
 // ---------------------------------------------------------------------------
 // Mutation.logout
 // ---------------------------------------------------------------------------
@@ -899,7 +1054,7 @@ func TestMapLeadResponseToGraphQL(t *testing.T) {
 		require.NoError(t, err)
 
 		// Fetch via service (which returns LeadResponse)
-		leadResp, err := resolver.LeadService.GetByID(context.Background(), l.ID)
+		leadResp, err := resolver.LeadService.GetByID(context.Background(), l.ID, nil)
 		require.NoError(t, err)
 
 		result := mapLeadResponseToGraphQL(leadResp)
@@ -928,7 +1083,7 @@ func TestMapLeadResponseToGraphQL(t *testing.T) {
 			Save(context.Background())
 		require.NoError(t, err)
 
-		leadResp, err := resolver.LeadService.GetByID(context.Background(), l.ID)
+		leadResp, err := resolver.LeadService.GetByID(context.Background(), l.ID, nil)
 		require.NoError(t, err)
 
 		result := mapLeadResponseToGraphQL(leadResp)
@@ -0,0 +1,269 @@
+// Package activityreport builds the per-user activity reports admins download
+// for compliance reviews: a user's audit events, usage, exports and sessions
+// over a date range, as CSV or JSON.
+package activityreport
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/export"
+	"github.com/jordanlanch/industrydb/pkg/analytics"
+	"github.com/jordanlanch/industrydb/pkg/audit"
+	"github.com/jordanlanch/industrydb/pkg/auth"
+)
+
+// Format is the file format of a report
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatJSON Format = "json"
+)
+
+// MaxRange is the longest period a single report may cover
+const MaxRange = 366 * 24 * time.Hour
+
+// defaultBatchSize is how many audit events or exports are read, and written
+// out, at a time
+const defaultBatchSize = 500
+
+// SessionLister lists a user's active sessions
+type SessionLister interface {
+	List(ctx context.Context, userID int, currentID string) ([]auth.Session, error)
+}
+
+// Request selects the period and format of a report. From is inclusive, To
+// exclusive.
+type Request struct {
+	From   time.Time
+	To     time.Time
+	Format Format
+}
+
+// AuditEvent is an audit log entry in a report
+type AuditEvent struct {
+	ID           int                    `json:"id"`
+	Action       string                 `json:"action"`
+	Severity     string                 `json:"severity"`
+	ResourceType string                 `json:"resource_type,omitempty"`
+	ResourceID   string                 `json:"resource_id,omitempty"`
+	IPAddress    string                 `json:"ip_address,omitempty"`
+	UserAgent    string                 `json:"user_agent,omitempty"`
+	Description  string                 `json:"description,omitempty"`
+	Metadata     map[string]interface{} `json:"metadata,omitempty"`
+	CreatedAt    time.Time              `json:"created_at"`
+}
+
+// ExportRecord is an export in a report
+type ExportRecord struct {
+	ID               int                    `json:"id"`
+	Format           string                 `json:"format"`
+	Status           string                 `json:"status"`
+	LeadCount        int                    `json:"lead_count"`
+	Filters          map[string]interface{} `json:"filters,omitempty"`
+	DeliveryMethod   string                 `json:"delivery_method,omitempty"`
+	DownloadCount    int                    `json:"download_count"`
+	LastDownloadedAt *time.Time             `json:"last_downloaded_at,omitempty"`
+	CreatedAt        time.Time              `json:"created_at"`
+}
+
+// SessionRecord is an active session in a report
+type SessionRecord struct {
+	ID        string    `json:"id"`
+	IssuedAt  time.Time `json:"issued_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+	IPAddress string    `json:"ip_address,omitempty"`
+	UserAgent string    `json:"user_agent,omitempty"`
+}
+
+// Service writes activity reports. Audit events come from the audit log
+// filters and usage from the daily usage rollups.
+type Service struct {
+	db        *ent.Client
+	audit     *audit.Service
+	analytics *analytics.Service
+	sessions  SessionLister
+	batchSize int
+}
+
+// NewService creates a new activity report service. sessions may be nil, in
+// which case reports have no sessions.
+func NewService(db *ent.Client, auditService *audit.Service, analyticsService *analytics.Service, sessions SessionLister) *Service {
+	return &Service{
+		db:        db,
+		audit:     auditService,
+		analytics: analyticsService,
+		sessions:  sessions,
+		batchSize: defaultBatchSize,
+	}
+}
+
+// Write writes the activity report of u to w. Audit events and exports are
+// read and written in batches, flushing w after each one if it supports
+// flushing, so heavy users' reports stream rather than build up in memory.
+// Sessions are those still active that were started in the period; earlier
+// sign-ins appear as user_login audit events.
+func (s *Service) Write(ctx context.Context, w io.Writer, u *ent.User, req Request) error {
+	var out reportWriter
+	switch req.Format {
+	case FormatCSV:
+		out = newCSVWriter(w)
+	case FormatJSON:
+		out = newJSONWriter(w)
+	default:
+		return fmt.Errorf("unsupported report format %q", req.Format)
+	}
+
+	if err := out.begin(u, req, time.Now()); err != nil {
+		return err
+	}
+	if err := s.writeAuditEvents(ctx, out, u.ID, req); err != nil {
+		return err
+	}
+	if err := s.writeUsage(ctx, out, u.ID, req); err != nil {
+		return err
+	}
+	if err := s.writeExports(ctx, out, u.ID, req); err != nil {
+		return err
+	}
+	if err := s.writeSessions(ctx, out, u.ID, req); err != nil {
+		return err
+	}
+	return out.end()
+}
+
+func (s *Service) writeAuditEvents(ctx context.Context, out reportWriter, userID int, req Request) error {
+	if err := out.section(sectionAuditEvents); err != nil {
+		return err
+	}
+
+	filter := audit.LogFilter{UserID: &userID, From: req.From, To: req.To, Limit: s.batchSize}
+	for {
+		logs, err := s.audit.QueryLogs(ctx, filter)
+		if err != nil {
+			return fmt.Errorf("failed to query audit logs: %w", err)
+		}
+		for _, log := range logs {
+			event := AuditEvent{
+				ID:           log.ID,
+				Action:       string(log.Action),
+				Severity:     string(log.Severity),
+				ResourceType: log.ResourceType,
+				ResourceID:   log.ResourceID,
+				IPAddress:    log.IPAddress,
+				UserAgent:    log.UserAgent,
+				Description:  log.Description,
+				Metadata:     log.Metadata,
+				CreatedAt:    log.CreatedAt,
+			}
+			if err := out.auditEvent(event); err != nil {
+				return err
+			}
+		}
+		if err := out.flush(); err != nil {
+			return err
+		}
+		if len(logs) < s.batchSize {
+			return out.endSection()
+		}
+		filter.AfterID = logs[len(logs)-1].ID
+	}
+}
+
+func (s *Service) writeUsage(ctx context.Context, out reportWriter, userID int, req Request) error {
+	if err := out.section(sectionUsage); err != nil {
+		return err
+	}
+
+	usage, err := s.analytics.GetUsageByDay(ctx, userID, req.From, req.To)
+	if err != nil {
+		return fmt.Errorf("failed to load usage: %w", err)
+	}
+	for _, day := range usage {
+		if err := out.usage(day); err != nil {
+			return err
+		}
+	}
+	return out.endSection()
+}
+
+func (s *Service) writeExports(ctx context.Context, out reportWriter, userID int, req Request) error {
+	if err := out.section(sectionExports); err != nil {
+		return err
+	}
+
+	afterID := 0
+	for {
+		exports, err := s.db.Export.Query().
+			Where(
+				export.UserIDEQ(userID),
+				export.CreatedAtGTE(req.From),
+				export.CreatedAtLT(req.To),
+				export.IDGT(afterID),
+			).
+			Order(ent.Asc(export.FieldID)).
+			Limit(s.batchSize).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query exports: %w", err)
+		}
+		for _, exp := range exports {
+			record := ExportRecord{
+				ID:               exp.ID,
+				Format:           string(exp.Format),
+				Status:           string(exp.Status),
+				LeadCount:        exp.LeadCount,
+				Filters:          exp.FiltersApplied,
+				DownloadCount:    exp.DownloadCount,
+				LastDownloadedAt: exp.LastDownloadedAt,
+				CreatedAt:        exp.CreatedAt,
+			}
+			if exp.DeliveryMethod != nil {
+				record.DeliveryMethod = string(*exp.DeliveryMethod)
+			}
+			if err := out.export(record); err != nil {
+				return err
+			}
+		}
+		if err := out.flush(); err != nil {
+			return err
+		}
+		if len(exports) < s.batchSize {
+			return out.endSection()
+		}
+		afterID = exports[len(exports)-1].ID
+	}
+}
+
+func (s *Service) writeSessions(ctx context.Context, out reportWriter, userID int, req Request) error {
+	if err := out.section(sectionSessions); err != nil {
+		return err
+	}
+
+	if s.sessions != nil {
+		sessions, err := s.sessions.List(ctx, userID, "")
+		if err != nil {
+			return err
+		}
+		for _, session := range sessions {
+			if session.IssuedAt.Before(req.From) || !session.IssuedAt.Before(req.To) {
+				continue
+			}
+			record := SessionRecord{
+				ID:        session.ID,
+				IssuedAt:  session.IssuedAt,
+				ExpiresAt: session.ExpiresAt,
+				IPAddress: session.IPAddress,
+				UserAgent: session.UserAgent,
+			}
+			if err := out.session(record); err != nil {
+				return err
+			}
+		}
+	}
+	return out.endSection()
+}
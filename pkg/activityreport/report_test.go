@@ -0,0 +1,218 @@
+package activityreport
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/auditlog"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/ent/export"
+	"github.com/jordanlanch/industrydb/ent/usagelog"
+	"github.com/jordanlanch/industrydb/ent/user"
+	"github.com/jordanlanch/industrydb/pkg/analytics"
+	"github.com/jordanlanch/industrydb/pkg/audit"
+	"github.com/jordanlanch/industrydb/pkg/auth"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubSessions []auth.Session
+
+func (s stubSessions) List(ctx context.Context, userID int, currentID string) ([]auth.Session, error) {
+	return s, nil
+}
+
+type reportFixture struct {
+	service *Service
+	user    *ent.User
+	req     Request
+}
+
+func setupReportTest(t *testing.T) *reportFixture {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	ctx := context.Background()
+
+	newUser := func(email string) *ent.User {
+		return client.User.Create().
+			SetEmail(email).
+			SetName("Report User").
+			SetPasswordHash("hashed").
+			SetSubscriptionTier(user.SubscriptionTierPro).
+			SaveX(ctx)
+	}
+	u := newUser("report@test.com")
+	other := newUser("other@test.com")
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	req := Request{From: today.AddDate(0, 0, -10), To: today.AddDate(0, 0, 1)}
+
+	newLog := func(userID int, action auditlog.Action, createdAt time.Time) {
+		client.AuditLog.Create().
+			SetUserID(userID).
+			SetAction(action).
+			SetIPAddress("10.0.0.1").
+			SetDescription(string(action)).
+			SetCreatedAt(createdAt).
+			SaveX(ctx)
+	}
+	newLog(u.ID, auditlog.ActionUserLogin, today.AddDate(0, 0, -5))
+	newLog(u.ID, auditlog.ActionLeadSearch, today.AddDate(0, 0, -4))
+	newLog(u.ID, auditlog.ActionExportCreate, today.AddDate(0, 0, -3))
+	newLog(u.ID, auditlog.ActionUserLogout, today.Add(time.Minute))
+	newLog(u.ID, auditlog.ActionUserLogin, today.AddDate(0, 0, -20)) // Before the period
+	newLog(other.ID, auditlog.ActionUserLogin, today)                // Someone else
+
+	newUsage := func(userID int, action usagelog.Action, count int, createdAt time.Time) {
+		client.UsageLog.Create().SetUserID(userID).SetAction(action).SetCount(count).SetCreatedAt(createdAt).SaveX(ctx)
+	}
+	newUsage(u.ID, usagelog.ActionSearch, 10, today.AddDate(0, 0, -4).Add(time.Hour))
+	newUsage(u.ID, usagelog.ActionSearch, 5, today.AddDate(0, 0, -4).Add(2*time.Hour))
+	newUsage(u.ID, usagelog.ActionExport, 100, today.AddDate(0, 0, -3).Add(time.Hour))
+	newUsage(u.ID, usagelog.ActionSearch, 3, today.Add(time.Minute))
+	newUsage(u.ID, usagelog.ActionSearch, 50, today.AddDate(0, 0, -20)) // Before the period
+	newUsage(other.ID, usagelog.ActionSearch, 7, today.Add(time.Minute))
+
+	analyticsService := analytics.NewService(client)
+	_, err := analyticsService.RollUpPending(ctx, time.Now())
+	require.NoError(t, err)
+
+	newExport := func(userID int, leadCount int, createdAt time.Time) *ent.ExportCreate {
+		return client.Export.Create().
+			SetUserID(userID).
+			SetFormat(export.FormatCsv).
+			SetStatus(export.StatusReady).
+			SetLeadCount(leadCount).
+			SetExpiresAt(createdAt.Add(24 * time.Hour)).
+			SetCreatedAt(createdAt)
+	}
+	newExport(u.ID, 100, today.AddDate(0, 0, -3)).SetDownloadCount(2).SaveX(ctx)
+	newExport(u.ID, 40, today.AddDate(0, 0, -2)).SaveX(ctx)
+	newExport(u.ID, 40, today.AddDate(0, 0, -1)).SetDeliveryMethod(export.DeliveryMethodURL).SetDeliveryURL("https://example.com/hook").SaveX(ctx)
+	newExport(u.ID, 999, today.AddDate(0, 0, -20)).SaveX(ctx) // Before the period
+	newExport(other.ID, 999, today).SaveX(ctx)
+
+	sessions := stubSessions{
+		{ID: "jti-new", IssuedAt: today.Add(time.Minute), ExpiresAt: today.AddDate(0, 0, 7), IPAddress: "10.0.0.2", UserAgent: "Firefox"},
+		{ID: "jti-old", IssuedAt: today.AddDate(0, 0, -12), ExpiresAt: today.AddDate(0, 0, 1)}, // Before the period
+	}
+
+	service := NewService(client, audit.NewService(client), analyticsService, sessions)
+	// Small batches so reports span several pages
+	service.batchSize = 2
+
+	return &reportFixture{service: service, user: u, req: req}
+}
+
+func TestWrite_JSON(t *testing.T) {
+	f := setupReportTest(t)
+	f.req.Format = FormatJSON
+
+	var buf bytes.Buffer
+	require.NoError(t, f.service.Write(context.Background(), &buf, f.user, f.req))
+
+	var report struct {
+		User        reportUser                   `json:"user"`
+		From        string                       `json:"from"`
+		To          string                       `json:"to"`
+		AuditEvents []AuditEvent                 `json:"audit_events"`
+		Usage       []analytics.DailyActionUsage `json:"usage"`
+		Exports     []ExportRecord               `json:"exports"`
+		Sessions    []SessionRecord              `json:"sessions"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &report), buf.String())
+
+	assert.Equal(t, f.user.ID, report.User.ID)
+	assert.Equal(t, "report@test.com", report.User.Email)
+	assert.Equal(t, f.req.From.Format(time.RFC3339), report.From)
+
+	require.Len(t, report.AuditEvents, 4)
+	assert.Equal(t, "user_login", report.AuditEvents[0].Action)
+	assert.Equal(t, "user_logout", report.AuditEvents[3].Action)
+	assert.Equal(t, "10.0.0.1", report.AuditEvents[0].IPAddress)
+
+	day := func(offset int) string { return f.req.To.AddDate(0, 0, offset-1).Format("2006-01-02") }
+	assert.Equal(t, []analytics.DailyActionUsage{
+		{Date: day(-4), Action: "search", Count: 15},
+		{Date: day(-3), Action: "export", Count: 100},
+		{Date: day(0), Action: "search", Count: 3},
+	}, report.Usage)
+
+	require.Len(t, report.Exports, 3)
+	assert.Equal(t, 100, report.Exports[0].LeadCount)
+	assert.Equal(t, 2, report.Exports[0].DownloadCount)
+	assert.Equal(t, "url", report.Exports[2].DeliveryMethod)
+
+	require.Len(t, report.Sessions, 1)
+	assert.Equal(t, "jti-new", report.Sessions[0].ID)
+	assert.Equal(t, "Firefox", report.Sessions[0].UserAgent)
+}
+
+func TestWrite_CSV(t *testing.T) {
+	f := setupReportTest(t)
+	f.req.Format = FormatCSV
+
+	var buf bytes.Buffer
+	require.NoError(t, f.service.Write(context.Background(), &buf, f.user, f.req))
+
+	rows, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, csvHeader, rows[0])
+
+	sections := make(map[string][][]string)
+	for _, row := range rows[1:] {
+		require.Len(t, row, len(csvHeader))
+		sections[row[0]] = append(sections[row[0]], row)
+	}
+	require.Len(t, sections["user"], 1)
+	assert.Contains(t, sections["user"][0][8], "report@test.com")
+	assert.Len(t, sections[sectionAuditEvents], 4)
+	assert.Len(t, sections[sectionUsage], 3)
+	assert.Len(t, sections[sectionExports], 3)
+	require.Len(t, sections[sectionSessions], 1)
+
+	export := sections[sectionExports][0]
+	assert.Equal(t, []string{"csv", "100", "ready"}, export[3:6])
+	assert.Equal(t, "downloads: 2", export[8])
+	assert.Equal(t, "jti-new", sections[sectionSessions][0][1])
+}
+
+func TestWrite_EmptyPages(t *testing.T) {
+	f := setupReportTest(t)
+
+	var buf bytes.Buffer
+	req := Request{From: f.req.From.AddDate(-1, 0, 0), To: f.req.From.AddDate(-1, 0, 1), Format: FormatJSON}
+	require.NoError(t, f.service.Write(context.Background(), &buf, f.user, req))
+
+	var report map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &report))
+	for _, section := range []string{sectionAuditEvents, sectionUsage, sectionExports, sectionSessions} {
+		assert.JSONEq(t, "[]", string(report[section]), section)
+	}
+
+	// The last batch is full, so the next page comes back empty
+	f.service.batchSize = 4
+	buf.Reset()
+	f.req.Format = FormatJSON
+	require.NoError(t, f.service.Write(context.Background(), &buf, f.user, f.req))
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &report))
+	var events []AuditEvent
+	require.NoError(t, json.Unmarshal(report[sectionAuditEvents], &events))
+	assert.Len(t, events, 4)
+}
+
+func TestWrite_UnsupportedFormat(t *testing.T) {
+	f := setupReportTest(t)
+	f.req.Format = "xml"
+
+	var buf bytes.Buffer
+	assert.Error(t, f.service.Write(context.Background(), &buf, f.user, f.req))
+	assert.Zero(t, buf.Len())
+}
@@ -0,0 +1,214 @@
+package activityreport
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/pkg/analytics"
+)
+
+// Report sections, in the order they are written
+const (
+	sectionAuditEvents = "audit_events"
+	sectionUsage       = "usage"
+	sectionExports     = "exports"
+	sectionSessions    = "sessions"
+)
+
+// csvHeader lists the columns shared by every section of a CSV report.
+// Columns that don't apply to a section are left empty.
+var csvHeader = []string{"section", "id", "occurred_at", "action", "count", "status", "ip_address", "user_agent", "details"}
+
+// reportWriter writes a report in one format, one section after the other
+type reportWriter interface {
+	begin(u *ent.User, req Request, generatedAt time.Time) error
+	section(name string) error
+	auditEvent(event AuditEvent) error
+	usage(day analytics.DailyActionUsage) error
+	export(record ExportRecord) error
+	session(record SessionRecord) error
+	endSection() error
+	// flush sends what was written so far on to the client
+	flush() error
+	end() error
+}
+
+// flusher is implemented by HTTP responses that can send buffered data early
+type flusher interface {
+	Flush()
+}
+
+// reportUser is the user a report is about
+type reportUser struct {
+	ID               int       `json:"id"`
+	Email            string    `json:"email"`
+	Name             string    `json:"name"`
+	SubscriptionTier string    `json:"subscription_tier"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+// csvWriter writes a single CSV with one row per record, tagged with its
+// section. The first row after the header is the user.
+type csvWriter struct {
+	w       io.Writer
+	csv     *csv.Writer
+	current string // Section being written
+}
+
+func newCSVWriter(w io.Writer) *csvWriter {
+	return &csvWriter{w: w, csv: csv.NewWriter(w)}
+}
+
+func (cw *csvWriter) begin(u *ent.User, req Request, generatedAt time.Time) error {
+	if err := cw.csv.Write(csvHeader); err != nil {
+		return err
+	}
+	details := fmt.Sprintf("%s; period %s to %s; generated %s",
+		u.Email, req.From.Format(time.RFC3339), req.To.Format(time.RFC3339), generatedAt.Format(time.RFC3339))
+	return cw.csv.Write([]string{"user", strconv.Itoa(u.ID), u.CreatedAt.Format(time.RFC3339), "", "", string(u.SubscriptionTier), "", "", details})
+}
+
+func (cw *csvWriter) section(name string) error {
+	cw.current = name
+	return nil
+}
+
+func (cw *csvWriter) auditEvent(event AuditEvent) error {
+	details := event.Description
+	if event.ResourceType != "" {
+		details = fmt.Sprintf("%s (%s %s)", details, event.ResourceType, event.ResourceID)
+	}
+	return cw.row(strconv.Itoa(event.ID), event.CreatedAt.Format(time.RFC3339), event.Action, "", event.Severity, event.IPAddress, event.UserAgent, details)
+}
+
+func (cw *csvWriter) usage(day analytics.DailyActionUsage) error {
+	return cw.row("", day.Date, day.Action, strconv.Itoa(day.Count), "", "", "", "")
+}
+
+func (cw *csvWriter) export(record ExportRecord) error {
+	details := fmt.Sprintf("downloads: %d", record.DownloadCount)
+	if record.DeliveryMethod != "" {
+		details += "; delivered by " + record.DeliveryMethod
+	}
+	return cw.row(strconv.Itoa(record.ID), record.CreatedAt.Format(time.RFC3339), record.Format, strconv.Itoa(record.LeadCount), record.Status, "", "", details)
+}
+
+func (cw *csvWriter) session(record SessionRecord) error {
+	details := "expires " + record.ExpiresAt.Format(time.RFC3339)
+	return cw.row(record.ID, record.IssuedAt.Format(time.RFC3339), "", "", "active", record.IPAddress, record.UserAgent, details)
+}
+
+func (cw *csvWriter) row(fields ...string) error {
+	return cw.csv.Write(append([]string{cw.current}, fields...))
+}
+
+func (cw *csvWriter) endSection() error {
+	return nil
+}
+
+func (cw *csvWriter) flush() error {
+	cw.csv.Flush()
+	if err := cw.csv.Error(); err != nil {
+		return err
+	}
+	if f, ok := cw.w.(flusher); ok {
+		f.Flush()
+	}
+	return nil
+}
+
+func (cw *csvWriter) end() error {
+	return cw.flush()
+}
+
+// jsonWriter writes one JSON object with the user, the period and an array
+// per section, encoding records as they come
+type jsonWriter struct {
+	w     io.Writer
+	buf   *bufio.Writer
+	first bool // No record written yet in the current section
+}
+
+func newJSONWriter(w io.Writer) *jsonWriter {
+	return &jsonWriter{w: w, buf: bufio.NewWriter(w)}
+}
+
+func (jw *jsonWriter) begin(u *ent.User, req Request, generatedAt time.Time) error {
+	user, err := json.Marshal(reportUser{
+		ID:               u.ID,
+		Email:            u.Email,
+		Name:             u.Name,
+		SubscriptionTier: string(u.SubscriptionTier),
+		CreatedAt:        u.CreatedAt,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(jw.buf, `{"user":%s,"from":%q,"to":%q,"generated_at":%q`,
+		user, req.From.Format(time.RFC3339), req.To.Format(time.RFC3339), generatedAt.Format(time.RFC3339))
+	return err
+}
+
+func (jw *jsonWriter) section(name string) error {
+	jw.first = true
+	_, err := fmt.Fprintf(jw.buf, `,%q:[`, name)
+	return err
+}
+
+func (jw *jsonWriter) auditEvent(event AuditEvent) error {
+	return jw.record(event)
+}
+
+func (jw *jsonWriter) usage(day analytics.DailyActionUsage) error {
+	return jw.record(day)
+}
+
+func (jw *jsonWriter) export(record ExportRecord) error {
+	return jw.record(record)
+}
+
+func (jw *jsonWriter) session(record SessionRecord) error {
+	return jw.record(record)
+}
+
+func (jw *jsonWriter) record(v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if !jw.first {
+		if err := jw.buf.WriteByte(','); err != nil {
+			return err
+		}
+	}
+	jw.first = false
+	_, err = jw.buf.Write(data)
+	return err
+}
+
+func (jw *jsonWriter) endSection() error {
+	return jw.buf.WriteByte(']')
+}
+
+func (jw *jsonWriter) flush() error {
+	if err := jw.buf.Flush(); err != nil {
+		return err
+	}
+	if f, ok := jw.w.(flusher); ok {
+		f.Flush()
+	}
+	return nil
+}
+
+func (jw *jsonWriter) end() error {
+	if _, err := jw.buf.WriteString("}\n"); err != nil {
+		return err
+	}
+	return jw.flush()
+}
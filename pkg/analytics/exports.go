@@ -0,0 +1,158 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent/export"
+)
+
+// ExportFormatStats is how often exports of one format are created and
+// downloaded
+type ExportFormatStats struct {
+	Format       string  `json:"format"`
+	Created      int     `json:"created"`
+	Downloaded   int     `json:"downloaded"`    // Downloaded at least once
+	DownloadRate float64 `json:"download_rate"` // Of ready exports not pushed elsewhere, downloaded (%)
+	Share        float64 `json:"share"`         // Of all exports created (%)
+}
+
+// ExportAnalytics compares how many exports are created with how many are
+// actually downloaded, to inform storage retention and tier caps
+type ExportAnalytics struct {
+	Days       int `json:"days"`
+	Created    int `json:"created"`    // Exports created in the period
+	Ready      int `json:"ready"`      // Of those, generated successfully
+	Failed     int `json:"failed"`     // Of those, failed to generate
+	Pushed     int `json:"pushed"`     // Ready exports pushed to a URL or spreadsheet, which need no download
+	Downloaded int `json:"downloaded"` // Downloaded at least once
+	Abandoned  int `json:"abandoned"`  // Download-only exports that expired without a download
+
+	DownloadRate           float64 `json:"download_rate"`              // Of ready exports not pushed elsewhere, downloaded (%)
+	TotalDownloads         int     `json:"total_downloads"`            // Sum of all download counts
+	AvgDownloadsPerExport  float64 `json:"avg_downloads_per_export"`   // Per downloaded export
+	AvgHoursToLastDownload float64 `json:"avg_hours_to_last_download"` // How long after creation files are still fetched
+
+	AvgLeadCount     float64 `json:"avg_lead_count"`      // Per ready export
+	AvgFileSizeBytes int64   `json:"avg_file_size_bytes"` // Per ready export with a recorded size
+
+	Formats []ExportFormatStats `json:"formats"` // Most created first
+}
+
+// GetExportAnalytics summarizes the exports created in the last days:
+// creation versus download rates, average size, format distribution and
+// how long after creation files are last downloaded
+func (s *Service) GetExportAnalytics(ctx context.Context, days int, now time.Time) (*ExportAnalytics, error) {
+	exports, err := s.db.Export.Query().
+		Where(export.CreatedAtGTE(now.AddDate(0, 0, -days))).
+		Select(
+			export.FieldFormat,
+			export.FieldStatus,
+			export.FieldLeadCount,
+			export.FieldFileSize,
+			export.FieldDownloadCount,
+			export.FieldLastDownloadedAt,
+			export.FieldExpiresAt,
+			export.FieldDeliveryMethod,
+			export.FieldCreatedAt,
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query exports: %w", err)
+	}
+
+	result := &ExportAnalytics{Days: days, Created: len(exports)}
+	formats := make(map[string]*ExportFormatStats)
+	// Ready exports without push delivery, and how many of them were
+	// downloaded, by format
+	downloadOnly := make(map[string]int)
+	downloadOnlyFetched := make(map[string]int)
+
+	var leadTotal, sized int
+	var sizeTotal int64
+	var hoursToLastDownload float64
+
+	for _, exp := range exports {
+		format := string(exp.Format)
+		stats, ok := formats[format]
+		if !ok {
+			stats = &ExportFormatStats{Format: format}
+			formats[format] = stats
+		}
+		stats.Created++
+
+		switch exp.Status {
+		case export.StatusFailed:
+			result.Failed++
+		case export.StatusReady:
+			result.Ready++
+			leadTotal += exp.LeadCount
+			if exp.FileSize != nil {
+				sized++
+				sizeTotal += *exp.FileSize
+			}
+			if exp.DeliveryMethod != nil {
+				result.Pushed++
+			} else {
+				downloadOnly[format]++
+				if exp.DownloadCount > 0 {
+					downloadOnlyFetched[format]++
+				} else if !exp.ExpiresAt.IsZero() && now.After(exp.ExpiresAt) {
+					result.Abandoned++
+				}
+			}
+		}
+
+		if exp.DownloadCount > 0 {
+			result.Downloaded++
+			stats.Downloaded++
+			result.TotalDownloads += exp.DownloadCount
+			if exp.LastDownloadedAt != nil {
+				hoursToLastDownload += exp.LastDownloadedAt.Sub(exp.CreatedAt).Hours()
+			}
+		}
+	}
+
+	fetched := 0
+	for _, count := range downloadOnlyFetched {
+		fetched += count
+	}
+	result.DownloadRate = percent(fetched, result.Ready-result.Pushed)
+	if result.Downloaded > 0 {
+		result.AvgDownloadsPerExport = float64(result.TotalDownloads) / float64(result.Downloaded)
+		result.AvgHoursToLastDownload = hoursToLastDownload / float64(result.Downloaded)
+	}
+	if result.Ready > 0 {
+		result.AvgLeadCount = float64(leadTotal) / float64(result.Ready)
+	}
+	if sized > 0 {
+		result.AvgFileSizeBytes = sizeTotal / int64(sized)
+	}
+
+	result.Formats = make([]ExportFormatStats, 0, len(formats))
+	for format, stats := range formats {
+		stats.DownloadRate = percent(downloadOnlyFetched[format], downloadOnly[format])
+		stats.Share = percent(stats.Created, result.Created)
+		result.Formats = append(result.Formats, *stats)
+	}
+	sort.Slice(result.Formats, func(i, j int) bool {
+		if result.Formats[i].Created != result.Formats[j].Created {
+			return result.Formats[i].Created > result.Formats[j].Created
+		}
+		return result.Formats[i].Format < result.Formats[j].Format
+	})
+
+	return result, nil
+}
+
+// percent returns part of total as a percentage rounded to 2 decimals, 0
+// for an empty total
+func percent(part, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return math.Round(float64(part)/float64(total)*10000) / 100
+}
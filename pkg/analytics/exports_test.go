@@ -0,0 +1,87 @@
+package analytics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/export"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetExportAnalytics(t *testing.T) {
+	client, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	service := NewService(client)
+	now := time.Now()
+	u := createTestUser(t, client, "exports@test.com", "pro", now.AddDate(0, -1, 0))
+
+	newExport := func(format export.Format, status export.Status, createdAt time.Time) *ent.ExportCreate {
+		return client.Export.Create().
+			SetUserID(u.ID).
+			SetFormat(format).
+			SetStatus(status).
+			SetLeadCount(100).
+			SetExpiresAt(createdAt.Add(24 * time.Hour)).
+			SetCreatedAt(createdAt)
+	}
+
+	twoDaysAgo := now.Add(-48 * time.Hour)
+	// Downloaded twice, last 6 hours after creation
+	newExport(export.FormatCsv, export.StatusReady, twoDaysAgo).
+		SetFileSize(1000).
+		SetDownloadCount(2).
+		SetLastDownloadedAt(twoDaysAgo.Add(6 * time.Hour)).
+		SaveX(ctx)
+	// Expired without a download
+	newExport(export.FormatCsv, export.StatusReady, twoDaysAgo).
+		SetFileSize(3000).
+		SetLeadCount(300).
+		SaveX(ctx)
+	// Still downloadable, not downloaded yet
+	newExport(export.FormatExcel, export.StatusReady, now.Add(-time.Hour)).SaveX(ctx)
+	// Pushed to a URL: not abandoned without a download
+	newExport(export.FormatExcel, export.StatusReady, twoDaysAgo).
+		SetDeliveryMethod(export.DeliveryMethodURL).
+		SetDeliveryURL("https://example.com/hook").
+		SaveX(ctx)
+	newExport(export.FormatCsv, export.StatusFailed, now.Add(-time.Hour)).SetLeadCount(0).SaveX(ctx)
+	// Outside the period
+	newExport(export.FormatKml, export.StatusReady, now.AddDate(0, 0, -40)).SetDownloadCount(5).SaveX(ctx)
+
+	result, err := service.GetExportAnalytics(ctx, 30, now)
+	require.NoError(t, err)
+
+	assert.Equal(t, 30, result.Days)
+	assert.Equal(t, 5, result.Created)
+	assert.Equal(t, 4, result.Ready)
+	assert.Equal(t, 1, result.Failed)
+	assert.Equal(t, 1, result.Pushed)
+	assert.Equal(t, 1, result.Downloaded)
+	assert.Equal(t, 1, result.Abandoned)
+	assert.Equal(t, 33.33, result.DownloadRate) // 1 of 3 download-only ready exports
+	assert.Equal(t, 2, result.TotalDownloads)
+	assert.Equal(t, 2.0, result.AvgDownloadsPerExport)
+	assert.InDelta(t, 6.0, result.AvgHoursToLastDownload, 0.01)
+	assert.Equal(t, 150.0, result.AvgLeadCount)           // (100+300+100+100)/4
+	assert.Equal(t, int64(2000), result.AvgFileSizeBytes) // Only exports with a recorded size
+
+	require.Len(t, result.Formats, 2)
+	assert.Equal(t, ExportFormatStats{Format: "csv", Created: 3, Downloaded: 1, DownloadRate: 50, Share: 60}, result.Formats[0])
+	assert.Equal(t, ExportFormatStats{Format: "excel", Created: 2, Downloaded: 0, DownloadRate: 0, Share: 40}, result.Formats[1])
+}
+
+func TestGetExportAnalytics_Empty(t *testing.T) {
+	client, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	result, err := NewService(client).GetExportAnalytics(context.Background(), 7, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.Created)
+	assert.Equal(t, 0.0, result.DownloadRate)
+	assert.Empty(t, result.Formats)
+}
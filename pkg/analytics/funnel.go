@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/contactattempt"
 	"github.com/jordanlanch/industrydb/ent/user"
 	"github.com/jordanlanch/industrydb/ent/usagelog"
 )
@@ -71,6 +72,16 @@ type TimeDistribution struct {
 	Distribution map[string]int64  `json:"distribution"`
 }
 
+// OutreachFunnel tracks lead outreach from logged contact attempts
+type OutreachFunnel struct {
+	TotalAttempts      int64   `json:"total_attempts"`
+	LeadsAttempted     int64   `json:"leads_attempted"`
+	LeadsConnected     int64   `json:"leads_connected"`
+	ConnectRate        float64 `json:"connect_rate"`         // Percentage of attempted leads that connected
+	AttemptsPerConnect float64 `json:"attempts_per_connect"` // Average attempts needed per connected lead
+	PeriodDays         int     `json:"period_days"`
+}
+
 // GetFunnelMetrics retrieves conversion funnel metrics for the specified period
 func (s *Service) GetFunnelMetrics(ctx context.Context, days int) (*FunnelMetrics, error) {
 	startDate := time.Now().AddDate(0, 0, -days)
@@ -320,6 +331,57 @@ func (s *Service) GetTimeToConversion(ctx context.Context, days int) (*TimeToCon
 	}, nil
 }
 
+// GetOutreachFunnel retrieves the attempted → connected outreach funnel
+// from contact attempts logged in the specified period
+func (s *Service) GetOutreachFunnel(ctx context.Context, days int) (*OutreachFunnel, error) {
+	startDate := time.Now().AddDate(0, 0, -days)
+
+	totalAttempts, err := s.db.ContactAttempt.
+		Query().
+		Where(contactattempt.AttemptedAtGTE(startDate)).
+		Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count contact attempts: %w", err)
+	}
+
+	leadsAttempted, err := s.db.ContactAttempt.
+		Query().
+		Where(contactattempt.AttemptedAtGTE(startDate)).
+		Unique(true).
+		Select(contactattempt.FieldLeadID).
+		Ints(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count attempted leads: %w", err)
+	}
+
+	leadsConnected, err := s.db.ContactAttempt.
+		Query().
+		Where(
+			contactattempt.AttemptedAtGTE(startDate),
+			contactattempt.OutcomeIn(contactattempt.OutcomeConnected, contactattempt.OutcomeReplied),
+		).
+		Unique(true).
+		Select(contactattempt.FieldLeadID).
+		Ints(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count connected leads: %w", err)
+	}
+
+	attemptsPerConnect := 0.0
+	if len(leadsConnected) > 0 {
+		attemptsPerConnect = math.Round(float64(totalAttempts)/float64(len(leadsConnected))*100) / 100
+	}
+
+	return &OutreachFunnel{
+		TotalAttempts:      int64(totalAttempts),
+		LeadsAttempted:     int64(len(leadsAttempted)),
+		LeadsConnected:     int64(len(leadsConnected)),
+		ConnectRate:        calculateRate(int64(len(leadsConnected)), int64(len(leadsAttempted))),
+		AttemptsPerConnect: attemptsPerConnect,
+		PeriodDays:         days,
+	}, nil
+}
+
 // calculateRate calculates percentage rate (numerator/denominator * 100)
 func calculateRate(numerator, denominator int64) float64 {
 	if denominator == 0 {
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/contactattempt"
 	"github.com/jordanlanch/industrydb/ent/enttest"
 	"github.com/jordanlanch/industrydb/ent/user"
 	"github.com/jordanlanch/industrydb/ent/usagelog"
@@ -245,3 +246,52 @@ func TestGetTimeToConversion(t *testing.T) {
 		assert.Contains(t, timeMetrics.SignupToSearch.Distribution, "0-1 days")
 	})
 }
+
+func TestGetOutreachFunnel(t *testing.T) {
+	client, cleanup := setupFunnelTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	service := NewService(client)
+
+	rep := createFunnelTestUser(t, client, "rep@test.com", user.SubscriptionTierPro)
+	var leadIDs []int
+	for _, name := range []string{"Studio A", "Studio B", "Studio C"} {
+		l, err := client.Lead.Create().
+			SetName(name).
+			SetIndustry("tattoo").
+			SetCountry("US").
+			SetCity("Austin").
+			Save(ctx)
+		require.NoError(t, err)
+		leadIDs = append(leadIDs, l.ID)
+	}
+
+	attempts := []struct {
+		leadID  int
+		outcome contactattempt.Outcome
+	}{
+		{leadIDs[0], contactattempt.OutcomeNoAnswer},
+		{leadIDs[0], contactattempt.OutcomeConnected},
+		{leadIDs[1], contactattempt.OutcomeReplied},
+		{leadIDs[2], contactattempt.OutcomeBounced},
+	}
+	for _, a := range attempts {
+		_, err := client.ContactAttempt.Create().
+			SetUserID(rep.ID).
+			SetLeadID(a.leadID).
+			SetType(contactattempt.TypeCall).
+			SetOutcome(a.outcome).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	funnel, err := service.GetOutreachFunnel(ctx, 30)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), funnel.TotalAttempts)
+	assert.Equal(t, int64(3), funnel.LeadsAttempted)
+	assert.Equal(t, int64(2), funnel.LeadsConnected)
+	assert.Equal(t, 66.67, funnel.ConnectRate)
+	assert.Equal(t, 2.0, funnel.AttemptsPerConnect)
+	assert.Equal(t, 30, funnel.PeriodDays)
+}
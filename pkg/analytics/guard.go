@@ -0,0 +1,109 @@
+package analytics
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jordanlanch/industrydb/pkg/cache"
+	"github.com/jordanlanch/industrydb/pkg/database"
+)
+
+// DefaultMaxRangeDays is the longest range an admin report may cover by
+// default, two years
+const DefaultMaxRangeDays = 730
+
+// ErrRangeTooLarge is returned for reports covering more than the maximum range
+var ErrRangeTooLarge = errors.New("requested range exceeds the maximum")
+
+// GuardConfig bounds the funnel, cohort and revenue reports
+type GuardConfig struct {
+	MaxRangeDays int           // Longest range a report may cover, 0 uses DefaultMaxRangeDays
+	QueryTimeout time.Duration // Timeout of a report's queries, 0 uses database.QueryTimeout
+	CacheTTL     time.Duration // How long reports are cached, 0 disables caching
+}
+
+// Guard keeps heavy admin reports from monopolizing the database: it bounds
+// their range, times out their queries and caches their results.
+type Guard struct {
+	cache  *cache.Client
+	config GuardConfig
+}
+
+// NewGuard creates a report guard. Without a cache, reports aren't cached.
+func NewGuard(cache *cache.Client, config GuardConfig) *Guard {
+	if config.MaxRangeDays <= 0 {
+		config.MaxRangeDays = DefaultMaxRangeDays
+	}
+	return &Guard{cache: cache, config: config}
+}
+
+// MaxRangeDays returns the longest range a report may cover
+func (g *Guard) MaxRangeDays() int {
+	return g.config.MaxRangeDays
+}
+
+// CheckRange returns ErrRangeTooLarge when a report covering days exceeds
+// the maximum range
+func (g *Guard) CheckRange(days int) error {
+	if days > g.config.MaxRangeDays {
+		return fmt.Errorf("%w: %d days requested, at most %d days allowed", ErrRangeTooLarge, days, g.config.MaxRangeDays)
+	}
+	return nil
+}
+
+// withTimeout returns a copy of ctx canceled after the report timeout. When
+// it expires, lib/pq cancels the running statement on the server.
+func (g *Guard) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if g.config.QueryTimeout > 0 {
+		return context.WithTimeout(ctx, g.config.QueryTimeout)
+	}
+	return database.WithQueryTimeout(ctx)
+}
+
+// PeriodDays is the length in days of a cohort period, months counting as
+// 30 days
+func PeriodDays(period string) int {
+	switch period {
+	case "day":
+		return 1
+	case "week":
+		return 7
+	default:
+		return 30
+	}
+}
+
+// Guarded runs a report's query under the guard's timeout and returns its
+// result, cached under key (which must identify the report and its
+// parameters) for the cache TTL. Cache errors fall through to the query.
+func Guarded[T any](ctx context.Context, g *Guard, key string, query func(context.Context) (T, error)) (T, error) {
+	caching := g.cache != nil && g.config.CacheTTL > 0
+	cacheKey := "analytics:" + key
+
+	if caching {
+		if cached, err := g.cache.Get(ctx, cacheKey); err == nil && cached != "" {
+			var result T
+			if err := json.Unmarshal([]byte(cached), &result); err == nil {
+				return result, nil
+			}
+		}
+	}
+
+	queryCtx, cancel := g.withTimeout(ctx)
+	defer cancel()
+
+	result, err := query(queryCtx)
+	if err != nil {
+		return result, err
+	}
+
+	if caching {
+		if data, err := json.Marshal(result); err == nil {
+			_ = g.cache.Set(ctx, cacheKey, data, g.config.CacheTTL)
+		}
+	}
+	return result, nil
+}
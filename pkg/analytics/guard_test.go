@@ -0,0 +1,77 @@
+package analytics
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/jordanlanch/industrydb/pkg/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGuard_CheckRange(t *testing.T) {
+	guard := NewGuard(nil, GuardConfig{})
+	assert.Equal(t, DefaultMaxRangeDays, guard.MaxRangeDays())
+	assert.NoError(t, guard.CheckRange(DefaultMaxRangeDays))
+	assert.ErrorIs(t, guard.CheckRange(DefaultMaxRangeDays+1), ErrRangeTooLarge)
+
+	guard = NewGuard(nil, GuardConfig{MaxRangeDays: 90})
+	err := guard.CheckRange(13 * PeriodDays("week"))
+	require.ErrorIs(t, err, ErrRangeTooLarge)
+	assert.Contains(t, err.Error(), "91 days requested")
+	assert.NoError(t, guard.CheckRange(3*PeriodDays("month")))
+}
+
+func TestGuarded(t *testing.T) {
+	mr := miniredis.RunT(t)
+	cacheClient, err := cache.NewClient("redis://" + mr.Addr())
+	require.NoError(t, err)
+	defer cacheClient.Close()
+
+	ctx := context.Background()
+	guard := NewGuard(cacheClient, GuardConfig{CacheTTL: time.Minute})
+
+	runs := 0
+	query := func(ctx context.Context) (*FunnelMetrics, error) {
+		runs++
+		return &FunnelMetrics{TotalSignups: int64(runs), PeriodDays: 30}, nil
+	}
+
+	first, err := Guarded(ctx, guard, "funnel:metrics:30", query)
+	require.NoError(t, err)
+	second, err := Guarded(ctx, guard, "funnel:metrics:30", query)
+	require.NoError(t, err)
+	assert.Equal(t, 1, runs, "the second call is served from the cache")
+	assert.Equal(t, first, second)
+	assert.Equal(t, time.Minute, mr.TTL("analytics:funnel:metrics:30"))
+
+	// Other parameters are cached separately
+	_, err = Guarded(ctx, guard, "funnel:metrics:7", query)
+	require.NoError(t, err)
+	assert.Equal(t, 2, runs)
+
+	// Errors aren't cached
+	failing := errors.New("boom")
+	_, err = Guarded(ctx, guard, "funnel:failing", func(ctx context.Context) (int, error) { return 0, failing })
+	assert.ErrorIs(t, err, failing)
+	assert.False(t, mr.Exists("analytics:funnel:failing"))
+
+	// Without a TTL nothing is cached
+	uncached := NewGuard(cacheClient, GuardConfig{})
+	_, err = Guarded(ctx, uncached, "funnel:metrics:90", query)
+	require.NoError(t, err)
+	assert.False(t, mr.Exists("analytics:funnel:metrics:90"))
+}
+
+func TestGuarded_QueryTimeout(t *testing.T) {
+	guard := NewGuard(nil, GuardConfig{QueryTimeout: time.Millisecond})
+
+	_, err := Guarded(context.Background(), guard, "slow", func(ctx context.Context) (int, error) {
+		<-ctx.Done()
+		return 0, ctx.Err()
+	})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
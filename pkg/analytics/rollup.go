@@ -0,0 +1,152 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/usagedailyaggregate"
+	"github.com/jordanlanch/industrydb/ent/usagelog"
+)
+
+// Daily usage rollups.
+//
+// A nightly job rolls up each completed UTC day of usage logs into one
+// UsageDailyAggregate row per user and action. The rollup watermark is the
+// day after the latest rollup: analytics read rollups before the watermark and
+// scan raw usage logs only from the watermark on (normally just today). Days
+// are rolled up oldest first, so there are no gaps below the watermark.
+
+// dayStart returns midnight UTC of the day containing t
+func dayStart(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
+
+// RollupWatermark returns the start of the first day that has not been rolled
+// up, or the zero time when nothing has been rolled up yet
+func (s *Service) RollupWatermark(ctx context.Context) (time.Time, error) {
+	latest, err := s.db.UsageDailyAggregate.Query().
+		Order(ent.Desc(usagedailyaggregate.FieldDate)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to load rollup watermark: %w", err)
+	}
+	return dayStart(latest.Date).AddDate(0, 0, 1), nil
+}
+
+// RollUpDay recomputes the rollups for the UTC day containing day from its
+// usage logs and returns the number of rollups written. Users and actions
+// with no usage logs that day keep their existing rollup, so days whose logs
+// were purged are left untouched.
+func (s *Service) RollUpDay(ctx context.Context, day time.Time) (int, error) {
+	start := dayStart(day)
+
+	var totals []struct {
+		UserID int             `json:"user_id"`
+		Action usagelog.Action `json:"action"`
+		Events int             `json:"events"`
+		Count  int             `json:"count"`
+	}
+	err := s.db.UsageLog.Query().
+		Where(
+			usagelog.CreatedAtGTE(start),
+			usagelog.CreatedAtLT(start.AddDate(0, 0, 1)),
+		).
+		GroupBy(usagelog.FieldUserID, usagelog.FieldAction).
+		Aggregate(
+			ent.As(ent.Count(), "events"),
+			ent.As(ent.Sum(usagelog.FieldCount), "count"),
+		).
+		Scan(ctx, &totals)
+	if err != nil {
+		return 0, fmt.Errorf("failed to aggregate usage logs for %s: %w", start.Format("2006-01-02"), err)
+	}
+	if len(totals) == 0 {
+		return 0, nil
+	}
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	for _, total := range totals {
+		action := usagedailyaggregate.Action(total.Action)
+		existing, err := tx.UsageDailyAggregate.Query().
+			Where(
+				usagedailyaggregate.UserIDEQ(total.UserID),
+				usagedailyaggregate.DateEQ(start),
+				usagedailyaggregate.ActionEQ(action),
+			).
+			Only(ctx)
+		switch {
+		case ent.IsNotFound(err):
+			err = tx.UsageDailyAggregate.Create().
+				SetUserID(total.UserID).
+				SetDate(start).
+				SetAction(action).
+				SetEvents(total.Events).
+				SetCount(total.Count).
+				Exec(ctx)
+		case err == nil:
+			err = existing.Update().
+				SetEvents(total.Events).
+				SetCount(total.Count).
+				Exec(ctx)
+		}
+		if err != nil {
+			tx.Rollback()
+			return 0, fmt.Errorf("failed to save usage rollup: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit usage rollups: %w", err)
+	}
+
+	return len(totals), nil
+}
+
+// RollUpRange rolls up every UTC day from the day containing from up to, but
+// not including, the day containing to. It returns the number of rollups
+// written.
+func (s *Service) RollUpRange(ctx context.Context, from, to time.Time) (int, error) {
+	written := 0
+	for day := dayStart(from); day.Before(dayStart(to)); day = day.AddDate(0, 0, 1) {
+		n, err := s.RollUpDay(ctx, day)
+		if err != nil {
+			return written, err
+		}
+		written += n
+	}
+	return written, nil
+}
+
+// RollUpPending rolls up every completed day from the watermark (or the
+// oldest usage log, on first run) through yesterday. It is safe to run
+// repeatedly and catches up on missed nights.
+func (s *Service) RollUpPending(ctx context.Context, now time.Time) (int, error) {
+	from, err := s.RollupWatermark(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if from.IsZero() {
+		oldest, err := s.db.UsageLog.Query().
+			Order(ent.Asc(usagelog.FieldCreatedAt)).
+			First(ctx)
+		if ent.IsNotFound(err) {
+			return 0, nil
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to find oldest usage log: %w", err)
+		}
+		from = oldest.CreatedAt
+	}
+
+	return s.RollUpRange(ctx, from, now)
+}
@@ -0,0 +1,220 @@
+package analytics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/ent/usagedailyaggregate"
+	"github.com/jordanlanch/industrydb/ent/usagelog"
+	"github.com/jordanlanch/industrydb/ent/user"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createRollupTestLog(t *testing.T, client *ent.Client, userID int, action usagelog.Action, count int, createdAt time.Time) {
+	_, err := client.UsageLog.Create().
+		SetUserID(userID).
+		SetAction(action).
+		SetCount(count).
+		SetCreatedAt(createdAt).
+		Save(context.Background())
+	require.NoError(t, err)
+}
+
+func TestRollUpDay(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+	service := NewService(client)
+
+	u := createFunnelTestUser(t, client, "rollup-day@example.com", user.SubscriptionTierPro)
+	day := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	createRollupTestLog(t, client, u.ID, usagelog.ActionSearch, 10, day.Add(1*time.Hour))
+	createRollupTestLog(t, client, u.ID, usagelog.ActionSearch, 5, day.Add(23*time.Hour))
+	createRollupTestLog(t, client, u.ID, usagelog.ActionEnrichment, 3, day.Add(12*time.Hour))
+	// Outside the day
+	createRollupTestLog(t, client, u.ID, usagelog.ActionSearch, 100, day.Add(-time.Minute))
+	createRollupTestLog(t, client, u.ID, usagelog.ActionSearch, 100, day.AddDate(0, 0, 1))
+
+	written, err := service.RollUpDay(ctx, day.Add(15*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 2, written)
+
+	search, err := client.UsageDailyAggregate.Query().
+		Where(usagedailyaggregate.ActionEQ(usagedailyaggregate.ActionSearch)).
+		Only(ctx)
+	require.NoError(t, err)
+	assert.True(t, day.Equal(search.Date))
+	assert.Equal(t, 2, search.Events)
+	assert.Equal(t, 15, search.Count)
+
+	enrichment, err := client.UsageDailyAggregate.Query().
+		Where(usagedailyaggregate.ActionEQ(usagedailyaggregate.ActionEnrichment)).
+		Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, enrichment.Count)
+
+	t.Run("is idempotent", func(t *testing.T) {
+		_, err := service.RollUpDay(ctx, day)
+		require.NoError(t, err)
+
+		rollups, err := client.UsageDailyAggregate.Query().All(ctx)
+		require.NoError(t, err)
+		assert.Len(t, rollups, 2)
+		for _, rollup := range rollups {
+			if rollup.Action == usagedailyaggregate.ActionSearch {
+				assert.Equal(t, 15, rollup.Count)
+			}
+		}
+	})
+
+	t.Run("keeps rollups of purged days", func(t *testing.T) {
+		_, err := client.UsageLog.Delete().
+			Where(usagelog.CreatedAtGTE(day), usagelog.CreatedAtLT(day.AddDate(0, 0, 1))).
+			Exec(ctx)
+		require.NoError(t, err)
+
+		written, err := service.RollUpDay(ctx, day)
+		require.NoError(t, err)
+		assert.Equal(t, 0, written)
+
+		rollups, err := client.UsageDailyAggregate.Query().Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 2, rollups)
+	})
+}
+
+func TestRollUpPending(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+	service := NewService(client)
+
+	u := createFunnelTestUser(t, client, "rollup-pending@example.com", user.SubscriptionTierPro)
+	now := time.Now().UTC()
+	today := now.Truncate(24 * time.Hour)
+
+	watermark, err := service.RollupWatermark(ctx)
+	require.NoError(t, err)
+	assert.True(t, watermark.IsZero())
+
+	// Nothing to roll up yet
+	written, err := service.RollUpPending(ctx, now)
+	require.NoError(t, err)
+	assert.Equal(t, 0, written)
+
+	createRollupTestLog(t, client, u.ID, usagelog.ActionSearch, 10, today.AddDate(0, 0, -5).Add(time.Hour))
+	createRollupTestLog(t, client, u.ID, usagelog.ActionExport, 50, today.AddDate(0, 0, -2).Add(time.Hour))
+	createRollupTestLog(t, client, u.ID, usagelog.ActionSearch, 7, today.Add(time.Minute))
+
+	// First run backfills from the oldest usage log through yesterday
+	written, err = service.RollUpPending(ctx, now)
+	require.NoError(t, err)
+	assert.Equal(t, 2, written)
+
+	watermark, err = service.RollupWatermark(ctx)
+	require.NoError(t, err)
+	assert.True(t, today.AddDate(0, 0, -1).Equal(watermark))
+
+	// Usage after the watermark is picked up by the next run
+	createRollupTestLog(t, client, u.ID, usagelog.ActionSearch, 4, today.AddDate(0, 0, -1).Add(time.Hour))
+	written, err = service.RollUpPending(ctx, now)
+	require.NoError(t, err)
+	assert.Equal(t, 1, written)
+
+	watermark, err = service.RollupWatermark(ctx)
+	require.NoError(t, err)
+	assert.True(t, today.Equal(watermark))
+
+	rollups, err := client.UsageDailyAggregate.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, rollups, "today is never rolled up")
+}
+
+func TestUsageReadsRollups(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+	service := NewService(client)
+
+	u := createFunnelTestUser(t, client, "rollup-read@example.com", user.SubscriptionTierPro)
+	now := time.Now().UTC()
+	today := now.Truncate(24 * time.Hour)
+
+	createRollupTestLog(t, client, u.ID, usagelog.ActionSearch, 10, today.AddDate(0, 0, -3).Add(time.Hour))
+	createRollupTestLog(t, client, u.ID, usagelog.ActionExport, 50, today.AddDate(0, 0, -3).Add(2*time.Hour))
+	createRollupTestLog(t, client, u.ID, usagelog.ActionEnrichment, 2, today.AddDate(0, 0, -1).Add(time.Hour))
+	createRollupTestLog(t, client, u.ID, usagelog.ActionSearch, 7, today.Add(time.Minute))
+
+	rawSummary, err := service.GetUsageSummary(ctx, u.ID, 7)
+	require.NoError(t, err)
+	rawDaily, err := service.GetDailyUsage(ctx, u.ID, 7)
+	require.NoError(t, err)
+
+	_, err = service.RollUpPending(ctx, now)
+	require.NoError(t, err)
+
+	// Rolled-up results match the raw scan
+	summary, err := service.GetUsageSummary(ctx, u.ID, 7)
+	require.NoError(t, err)
+	assert.Equal(t, rawSummary, summary)
+	assert.Equal(t, 17, summary.TotalSearches)
+	assert.Equal(t, 2, summary.TotalEnrichments)
+
+	daily, err := service.GetDailyUsage(ctx, u.ID, 7)
+	require.NoError(t, err)
+	assert.Equal(t, rawDaily, daily)
+
+	// Past days come from rollups: raw logs before the watermark are not scanned
+	_, err = client.UsageLog.Delete().Where(usagelog.CreatedAtLT(today)).Exec(ctx)
+	require.NoError(t, err)
+
+	summary, err = service.GetUsageSummary(ctx, u.ID, 7)
+	require.NoError(t, err)
+	assert.Equal(t, rawSummary, summary)
+
+	breakdown, err := service.GetActionBreakdown(ctx, u.ID, 7)
+	require.NoError(t, err)
+	assert.Len(t, breakdown, 3)
+}
+
+func TestGetUsageByDay(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+	service := NewService(client)
+
+	u := createFunnelTestUser(t, client, "usage-by-day@example.com", user.SubscriptionTierPro)
+	now := time.Now().UTC()
+	today := now.Truncate(24 * time.Hour)
+
+	createRollupTestLog(t, client, u.ID, usagelog.ActionSearch, 10, today.AddDate(0, 0, -3).Add(time.Hour))
+	createRollupTestLog(t, client, u.ID, usagelog.ActionSearch, 5, today.AddDate(0, 0, -3).Add(5*time.Hour))
+	createRollupTestLog(t, client, u.ID, usagelog.ActionExport, 50, today.AddDate(0, 0, -3).Add(2*time.Hour))
+	createRollupTestLog(t, client, u.ID, usagelog.ActionEnrichment, 2, today.AddDate(0, 0, -1).Add(time.Hour))
+	createRollupTestLog(t, client, u.ID, usagelog.ActionSearch, 7, today.Add(time.Minute))
+
+	_, err := service.RollUpPending(ctx, now)
+	require.NoError(t, err)
+
+	day := func(offset int) string { return today.AddDate(0, 0, offset).Format("2006-01-02") }
+
+	usage, err := service.GetUsageByDay(ctx, u.ID, today.AddDate(0, 0, -7), now)
+	require.NoError(t, err)
+	assert.Equal(t, []DailyActionUsage{
+		{Date: day(-3), Action: "export", Count: 50},
+		{Date: day(-3), Action: "search", Count: 15},
+		{Date: day(-1), Action: "enrichment", Count: 2},
+		{Date: day(0), Action: "search", Count: 7},
+	}, usage)
+
+	// Partial days count whole, the end day is excluded at midnight
+	usage, err = service.GetUsageByDay(ctx, u.ID, today.AddDate(0, 0, -1).Add(12*time.Hour), today)
+	require.NoError(t, err)
+	assert.Equal(t, []DailyActionUsage{{Date: day(-1), Action: "enrichment", Count: 2}}, usage)
+}
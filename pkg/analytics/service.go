@@ -2,9 +2,11 @@ package analytics
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/usagedailyaggregate"
 	"github.com/jordanlanch/industrydb/ent/usagelog"
 )
 
@@ -35,23 +37,48 @@ func (s *Service) LogUsage(ctx context.Context, userID int, action usagelog.Acti
 	return err
 }
 
-// DailyUsage represents usage for a single day
-type DailyUsage struct {
-	Date   string `json:"date"`
-	Search int    `json:"search"`
-	Export int    `json:"export"`
-	Total  int    `json:"total"`
+// usageEntry is one usage log, or a daily rollup of usage logs
+type usageEntry struct {
+	Date   string
+	Action usagelog.Action
+	Count  int
 }
 
-// GetDailyUsage returns usage grouped by day for the last N days
-func (s *Service) GetDailyUsage(ctx context.Context, userID int, days int) ([]DailyUsage, error) {
-	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
-	defer cancel()
+// loadUsage returns a user's usage since startDate. Days before the rollup
+// watermark are read from daily rollups, which cover whole days; only later
+// days (normally just today) are scanned from raw usage logs.
+func (s *Service) loadUsage(ctx context.Context, userID int, startDate time.Time) ([]usageEntry, error) {
+	watermark, err := s.RollupWatermark(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	// Calculate start date
-	startDate := time.Now().UTC().AddDate(0, 0, -days)
+	var entries []usageEntry
+
+	if watermark.After(startDate) {
+		aggregates, err := s.db.UsageDailyAggregate.Query().
+			Where(
+				usagedailyaggregate.UserIDEQ(userID),
+				usagedailyaggregate.DateGTE(dayStart(startDate)),
+				usagedailyaggregate.DateLT(watermark),
+			).
+			Order(ent.Asc(usagedailyaggregate.FieldDate)).
+			All(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, aggregate := range aggregates {
+			entries = append(entries, usageEntry{
+				Date:   aggregate.Date.UTC().Format("2006-01-02"),
+				Action: usagelog.Action(aggregate.Action),
+				Count:  aggregate.Count,
+			})
+		}
+		startDate = watermark
+	}
 
-	// Query usage logs
 	logs, err := s.db.UsageLog.Query().
 		Where(
 			usagelog.UserIDEQ(userID),
@@ -64,11 +91,45 @@ func (s *Service) GetDailyUsage(ctx context.Context, userID int, days int) ([]Da
 		return nil, err
 	}
 
+	for _, log := range logs {
+		entries = append(entries, usageEntry{
+			Date:   log.CreatedAt.UTC().Format("2006-01-02"),
+			Action: log.Action,
+			Count:  log.Count,
+		})
+	}
+
+	return entries, nil
+}
+
+// DailyUsage represents usage for a single day
+type DailyUsage struct {
+	Date       string `json:"date"`
+	Search     int    `json:"search"`
+	Export     int    `json:"export"`
+	Enrichment int    `json:"enrichment"`
+	Total      int    `json:"total"`
+}
+
+// GetDailyUsage returns usage grouped by day for the last N days
+func (s *Service) GetDailyUsage(ctx context.Context, userID int, days int) ([]DailyUsage, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	// Calculate start date
+	startDate := time.Now().UTC().AddDate(0, 0, -days)
+
+	// Load usage (rollups for past days, raw logs for today)
+	logs, err := s.loadUsage(ctx, userID, startDate)
+	if err != nil {
+		return nil, err
+	}
+
 	// Group by day
 	dailyMap := make(map[string]*DailyUsage)
 
 	for _, log := range logs {
-		date := log.CreatedAt.Format("2006-01-02")
+		date := log.Date
 
 		if _, exists := dailyMap[date]; !exists {
 			dailyMap[date] = &DailyUsage{
@@ -84,6 +145,8 @@ func (s *Service) GetDailyUsage(ctx context.Context, userID int, days int) ([]Da
 			dailyMap[date].Search += log.Count
 		case usagelog.ActionExport:
 			dailyMap[date].Export += log.Count
+		case usagelog.ActionEnrichment:
+			dailyMap[date].Enrichment += log.Count
 		}
 		dailyMap[date].Total += log.Count
 	}
@@ -107,14 +170,58 @@ func (s *Service) GetDailyUsage(ctx context.Context, userID int, days int) ([]Da
 	return result, nil
 }
 
+// DailyActionUsage is a user's usage of one action on one UTC day
+type DailyActionUsage struct {
+	Date   string `json:"date"`
+	Action string `json:"action"`
+	Count  int    `json:"count"`
+}
+
+// GetUsageByDay returns a user's usage per action for each UTC day
+// overlapping from to to (exclusive), oldest first. Like the other usage
+// reports it reads daily rollups, so days are always counted whole.
+func (s *Service) GetUsageByDay(ctx context.Context, userID int, from, to time.Time) ([]DailyActionUsage, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	logs, err := s.loadUsage(ctx, userID, dayStart(from))
+	if err != nil {
+		return nil, err
+	}
+
+	lastDay := dayStart(to.Add(-time.Nanosecond)).Format("2006-01-02")
+	totals := make(map[DailyActionUsage]int)
+	for _, log := range logs {
+		if log.Date > lastDay {
+			continue
+		}
+		totals[DailyActionUsage{Date: log.Date, Action: string(log.Action)}] += log.Count
+	}
+
+	result := make([]DailyActionUsage, 0, len(totals))
+	for key, count := range totals {
+		key.Count = count
+		result = append(result, key)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Date != result[j].Date {
+			return result[i].Date < result[j].Date
+		}
+		return result[i].Action < result[j].Action
+	})
+
+	return result, nil
+}
+
 // UsageSummary represents aggregated usage statistics
 type UsageSummary struct {
-	TotalSearches int     `json:"total_searches"`
-	TotalExports  int     `json:"total_exports"`
-	TotalLeads    int     `json:"total_leads"`
-	AvgPerDay     float64 `json:"avg_per_day"`
-	PeakDay       string  `json:"peak_day"`
-	PeakCount     int     `json:"peak_count"`
+	TotalSearches    int     `json:"total_searches"`
+	TotalExports     int     `json:"total_exports"`
+	TotalEnrichments int     `json:"total_enrichments"`
+	TotalLeads       int     `json:"total_leads"`
+	AvgPerDay        float64 `json:"avg_per_day"`
+	PeakDay          string  `json:"peak_day"`
+	PeakCount        int     `json:"peak_count"`
 }
 
 // GetUsageSummary returns aggregated usage statistics
@@ -124,13 +231,7 @@ func (s *Service) GetUsageSummary(ctx context.Context, userID int, days int) (*U
 
 	startDate := time.Now().UTC().AddDate(0, 0, -days)
 
-	logs, err := s.db.UsageLog.Query().
-		Where(
-			usagelog.UserIDEQ(userID),
-			usagelog.CreatedAtGTE(startDate),
-		).
-		All(ctx)
-
+	logs, err := s.loadUsage(ctx, userID, startDate)
 	if err != nil {
 		return nil, err
 	}
@@ -147,7 +248,7 @@ func (s *Service) GetUsageSummary(ctx context.Context, userID int, days int) (*U
 	dailyTotals := make(map[string]int)
 
 	for _, log := range logs {
-		date := log.CreatedAt.Format("2006-01-02")
+		date := log.Date
 		dailyTotals[date] += log.Count
 
 		switch log.Action {
@@ -155,6 +256,8 @@ func (s *Service) GetUsageSummary(ctx context.Context, userID int, days int) (*U
 			summary.TotalSearches += log.Count
 		case usagelog.ActionExport:
 			summary.TotalExports += log.Count
+		case usagelog.ActionEnrichment:
+			summary.TotalEnrichments += log.Count
 		}
 		summary.TotalLeads += log.Count
 	}
@@ -189,13 +292,7 @@ func (s *Service) GetActionBreakdown(ctx context.Context, userID int, days int)
 
 	startDate := time.Now().UTC().AddDate(0, 0, -days)
 
-	logs, err := s.db.UsageLog.Query().
-		Where(
-			usagelog.UserIDEQ(userID),
-			usagelog.CreatedAtGTE(startDate),
-		).
-		All(ctx)
-
+	logs, err := s.loadUsage(ctx, userID, startDate)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,207 @@
+package analytics
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent/usagelog"
+)
+
+// Usage log metadata schema.
+//
+// Search and export usage logs record what they targeted in the metadata map.
+// Versions:
+//   - 2 (current): "metadata_version" (2), "industries" (every targeted
+//     industry, from both the industry and industries filters), "country"
+//     (upper-case ISO 3166-1 alpha-2) and "city". Empty values are omitted.
+//     Searches also record "has_email" and "has_phone"; exports record
+//     "format", "max_leads", "filters", "lead_count" and "export_id".
+//   - 1 (no "metadata_version" key): searches recorded a single "industry",
+//     "country" and "city"; exports only recorded the raw "filters" request
+//     with Go field names ("Industry", "Industries", "Country").
+//
+// GetTargetingBreakdown reads both versions. Daily aggregates kept for purged
+// usage logs have no metadata, so targeting only covers the retention window.
+const UsageMetadataVersion = 2
+
+// Usage log metadata keys
+const (
+	MetadataKeyVersion    = "metadata_version"
+	MetadataKeyIndustries = "industries"
+	MetadataKeyCountry    = "country"
+	MetadataKeyCity       = "city"
+)
+
+// TargetingMetadata adds the versioned targeting fields to a usage log
+// metadata map and returns it
+func TargetingMetadata(metadata map[string]interface{}, industry string, industries []string, country, city string) map[string]interface{} {
+	if metadata == nil {
+		metadata = make(map[string]interface{})
+	}
+	metadata[MetadataKeyVersion] = UsageMetadataVersion
+
+	targeted := make([]string, 0, len(industries)+1)
+	seen := make(map[string]bool)
+	for _, ind := range append([]string{industry}, industries...) {
+		if ind != "" && !seen[ind] {
+			seen[ind] = true
+			targeted = append(targeted, ind)
+		}
+	}
+	if len(targeted) > 0 {
+		metadata[MetadataKeyIndustries] = targeted
+	}
+	if country != "" {
+		metadata[MetadataKeyCountry] = strings.ToUpper(country)
+	}
+	if city != "" {
+		metadata[MetadataKeyCity] = city
+	}
+	return metadata
+}
+
+// TargetingCount represents how often an industry or country was targeted
+type TargetingCount struct {
+	Value    string `json:"value"`
+	Searches int    `json:"searches"`
+	Exports  int    `json:"exports"`
+	Leads    int    `json:"leads"` // Leads returned by searches plus leads exported
+}
+
+// TargetingBreakdown represents usage grouped by targeted industry and country
+type TargetingBreakdown struct {
+	Industries []TargetingCount `json:"industries"`
+	Countries  []TargetingCount `json:"countries"`
+	Untargeted int              `json:"untargeted"` // Searches and exports with no industry or country filter
+	PeriodDays int              `json:"period_days"`
+}
+
+// GetTargetingBreakdown returns search and export usage grouped by the
+// industries and countries they targeted, most targeted first
+func (s *Service) GetTargetingBreakdown(ctx context.Context, userID int, days int) (*TargetingBreakdown, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	startDate := time.Now().UTC().AddDate(0, 0, -days)
+
+	logs, err := s.db.UsageLog.Query().
+		Where(
+			usagelog.UserIDEQ(userID),
+			usagelog.CreatedAtGTE(startDate),
+			usagelog.ActionIn(usagelog.ActionSearch, usagelog.ActionExport),
+		).
+		All(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	industries := make(map[string]*TargetingCount)
+	countries := make(map[string]*TargetingCount)
+	breakdown := &TargetingBreakdown{PeriodDays: days}
+
+	for _, log := range logs {
+		logIndustries, country := targetingFromMetadata(log.Metadata)
+		if len(logIndustries) == 0 && country == "" {
+			breakdown.Untargeted++
+			continue
+		}
+
+		for _, industry := range logIndustries {
+			countTargeting(industries, industry, log.Action, log.Count)
+		}
+		if country != "" {
+			countTargeting(countries, country, log.Action, log.Count)
+		}
+	}
+
+	breakdown.Industries = sortedTargeting(industries)
+	breakdown.Countries = sortedTargeting(countries)
+
+	return breakdown, nil
+}
+
+// targetingFromMetadata extracts the targeted industries and country from
+// usage log metadata of any schema version
+func targetingFromMetadata(metadata map[string]interface{}) ([]string, string) {
+	if _, ok := metadata[MetadataKeyVersion]; ok {
+		return stringSlice(metadata[MetadataKeyIndustries]), stringValue(metadata[MetadataKeyCountry])
+	}
+
+	// Version 1 search
+	if _, ok := metadata["industry"]; ok {
+		var industries []string
+		if industry := stringValue(metadata["industry"]); industry != "" {
+			industries = []string{industry}
+		}
+		return industries, strings.ToUpper(stringValue(metadata["country"]))
+	}
+
+	// Version 1 export
+	if filters, ok := metadata["filters"].(map[string]interface{}); ok {
+		industries := stringSlice(filters["Industries"])
+		if industry := stringValue(filters["Industry"]); industry != "" {
+			industries = append([]string{industry}, industries...)
+		}
+		return industries, strings.ToUpper(stringValue(filters["Country"]))
+	}
+
+	return nil, ""
+}
+
+// countTargeting adds a usage log to the counts for a targeted value
+func countTargeting(counts map[string]*TargetingCount, value string, action usagelog.Action, leads int) {
+	entry, ok := counts[value]
+	if !ok {
+		entry = &TargetingCount{Value: value}
+		counts[value] = entry
+	}
+	if action == usagelog.ActionExport {
+		entry.Exports++
+	} else {
+		entry.Searches++
+	}
+	entry.Leads += leads
+}
+
+// sortedTargeting orders counts by searches plus exports, then by value
+func sortedTargeting(counts map[string]*TargetingCount) []TargetingCount {
+	result := make([]TargetingCount, 0, len(counts))
+	for _, entry := range counts {
+		result = append(result, *entry)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		ti := result[i].Searches + result[i].Exports
+		tj := result[j].Searches + result[j].Exports
+		if ti != tj {
+			return ti > tj
+		}
+		return result[i].Value < result[j].Value
+	})
+	return result
+}
+
+// stringValue returns v as a string, or "" when it is not one
+func stringValue(v interface{}) string {
+	s, _ := v.(string)
+	return s
+}
+
+// stringSlice returns the strings in a JSON-decoded or in-memory list
+func stringSlice(v interface{}) []string {
+	switch list := v.(type) {
+	case []string:
+		return list
+	case []interface{}:
+		result := make([]string, 0, len(list))
+		for _, item := range list {
+			if s, ok := item.(string); ok && s != "" {
+				result = append(result, s)
+			}
+		}
+		return result
+	}
+	return nil
+}
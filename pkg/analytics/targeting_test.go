@@ -0,0 +1,89 @@
+package analytics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/ent/usagelog"
+	"github.com/jordanlanch/industrydb/ent/user"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTargetingMetadata(t *testing.T) {
+	metadata := TargetingMetadata(map[string]interface{}{"format": "csv"}, "tattoo", []string{"tattoo", "beauty"}, "us", "New York")
+
+	assert.Equal(t, UsageMetadataVersion, metadata[MetadataKeyVersion])
+	assert.Equal(t, []string{"tattoo", "beauty"}, metadata[MetadataKeyIndustries])
+	assert.Equal(t, "US", metadata[MetadataKeyCountry])
+	assert.Equal(t, "New York", metadata[MetadataKeyCity])
+	assert.Equal(t, "csv", metadata["format"])
+
+	t.Run("omits empty values", func(t *testing.T) {
+		metadata := TargetingMetadata(nil, "", nil, "", "")
+		assert.Equal(t, map[string]interface{}{MetadataKeyVersion: UsageMetadataVersion}, metadata)
+	})
+}
+
+func TestGetTargetingBreakdown(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+	service := NewService(client)
+
+	u := createFunnelTestUser(t, client, "targeting@example.com", user.SubscriptionTierPro)
+	other := createFunnelTestUser(t, client, "other-targeting@example.com", user.SubscriptionTierPro)
+
+	logUsage := func(userID int, action usagelog.Action, count int, metadata map[string]interface{}, createdAt time.Time) {
+		_, err := client.UsageLog.Create().
+			SetUserID(userID).
+			SetAction(action).
+			SetCount(count).
+			SetMetadata(metadata).
+			SetCreatedAt(createdAt).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	now := time.Now()
+	// Version 2 search and export
+	logUsage(u.ID, usagelog.ActionSearch, 20, TargetingMetadata(nil, "tattoo", []string{"beauty"}, "us", ""), now)
+	logUsage(u.ID, usagelog.ActionExport, 100, TargetingMetadata(map[string]interface{}{"format": "csv"}, "tattoo", nil, "US", ""), now)
+	// Version 1 search and export
+	logUsage(u.ID, usagelog.ActionSearch, 10, map[string]interface{}{"industry": "gym", "country": "gb", "city": ""}, now)
+	logUsage(u.ID, usagelog.ActionExport, 50, map[string]interface{}{"format": "excel", "filters": map[string]interface{}{"Industry": "tattoo", "Industries": nil, "Country": "US"}}, now)
+	// Untargeted search
+	logUsage(u.ID, usagelog.ActionSearch, 5, map[string]interface{}{"industry": "", "country": ""}, now)
+	// Not counted: other actions, other users and older logs
+	logUsage(u.ID, usagelog.ActionAPICall, 1, map[string]interface{}{"industry": "gym"}, now)
+	logUsage(other.ID, usagelog.ActionSearch, 10, TargetingMetadata(nil, "bar", nil, "FR", ""), now)
+	logUsage(u.ID, usagelog.ActionSearch, 10, TargetingMetadata(nil, "cafe", nil, "DE", ""), now.AddDate(0, 0, -60))
+
+	breakdown, err := service.GetTargetingBreakdown(ctx, u.ID, 30)
+	require.NoError(t, err)
+
+	assert.Equal(t, 30, breakdown.PeriodDays)
+	assert.Equal(t, 1, breakdown.Untargeted)
+	assert.Equal(t, []TargetingCount{
+		{Value: "tattoo", Searches: 1, Exports: 2, Leads: 170},
+		{Value: "beauty", Searches: 1, Exports: 0, Leads: 20},
+		{Value: "gym", Searches: 1, Exports: 0, Leads: 10},
+	}, breakdown.Industries)
+	assert.Equal(t, []TargetingCount{
+		{Value: "US", Searches: 1, Exports: 2, Leads: 170},
+		{Value: "GB", Searches: 1, Exports: 0, Leads: 10},
+	}, breakdown.Countries)
+
+	t.Run("no usage", func(t *testing.T) {
+		fresh := createFunnelTestUser(t, client, "fresh-targeting@example.com", user.SubscriptionTierFree)
+
+		breakdown, err := service.GetTargetingBreakdown(ctx, fresh.ID, 30)
+		require.NoError(t, err)
+		assert.Empty(t, breakdown.Industries)
+		assert.Empty(t, breakdown.Countries)
+		assert.Equal(t, 0, breakdown.Untargeted)
+	})
+}
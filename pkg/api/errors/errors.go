@@ -4,11 +4,15 @@ import (
 	"log"
 	"net/http"
 
+	"github.com/jordanlanch/industrydb/pkg/api/validation"
+	"github.com/jordanlanch/industrydb/pkg/database"
 	"github.com/jordanlanch/industrydb/pkg/models"
 	"github.com/labstack/echo/v4"
 )
 
-// ValidationError returns a generic validation error without exposing internal details
+// ValidationError returns a generic validation error without exposing internal
+// details. Errors from struct tag validation (see validation.Bind) list the
+// invalid fields.
 func ValidationError(c echo.Context, err error) error {
 	// Log the actual error for debugging
 	log.Printf("[VALIDATION ERROR] Path: %s, Error: %v", c.Request().URL.Path, err)
@@ -16,11 +20,17 @@ func ValidationError(c echo.Context, err error) error {
 	return c.JSON(http.StatusBadRequest, models.ErrorResponse{
 		Error:   "validation_error",
 		Message: "Invalid request data. Please check your input and try again.",
+		Fields:  validation.Fields(err),
 	})
 }
 
-// DatabaseError returns a generic database error without exposing internal details
+// DatabaseError returns a generic database error without exposing internal
+// details. Queries that ran out of time return a QueryTimeoutError.
 func DatabaseError(c echo.Context, err error) error {
+	if database.IsQueryTimeout(err) {
+		return QueryTimeoutError(c, err)
+	}
+
 	// Log the actual error for debugging
 	log.Printf("[DATABASE ERROR] Path: %s, Error: %v", c.Request().URL.Path, err)
 
@@ -30,8 +40,13 @@ func DatabaseError(c echo.Context, err error) error {
 	})
 }
 
-// InternalError returns a generic internal server error
+// InternalError returns a generic internal server error. Queries that ran
+// out of time return a QueryTimeoutError.
 func InternalError(c echo.Context, err error) error {
+	if database.IsQueryTimeout(err) {
+		return QueryTimeoutError(c, err)
+	}
+
 	// Log the actual error for debugging
 	log.Printf("[INTERNAL ERROR] Path: %s, Error: %v", c.Request().URL.Path, err)
 
@@ -41,6 +56,19 @@ func InternalError(c echo.Context, err error) error {
 	})
 }
 
+// QueryTimeoutError returns a 504 for a request whose queries ran out of
+// time (see database.WithQueryTimeout), so clients can retry or narrow it
+func QueryTimeoutError(c echo.Context, err error) error {
+	// Log the actual error for debugging
+	log.Printf("[QUERY TIMEOUT] Path: %s, Request: %s, Error: %v",
+		c.Request().URL.Path, c.Response().Header().Get(echo.HeaderXRequestID), err)
+
+	return c.JSON(http.StatusGatewayTimeout, models.ErrorResponse{
+		Error:   "query_timeout",
+		Message: "The request took too long. Please narrow your filters or try again later.",
+	})
+}
+
 // UnauthorizedError returns a generic unauthorized error
 func UnauthorizedError(c echo.Context, reason string) error {
 	return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
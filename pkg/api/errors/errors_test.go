@@ -2,15 +2,19 @@ package errors
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"log"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	"github.com/jordanlanch/industrydb/pkg/api/validation"
 	"github.com/jordanlanch/industrydb/pkg/models"
 	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -318,6 +322,51 @@ func TestConflictError_ContentType(t *testing.T) {
 	assert.Contains(t, rec.Header().Get("Content-Type"), "application/json")
 }
 
+// ---------- QueryTimeoutError ----------
+
+func TestQueryTimeoutError_ResponseBody(t *testing.T) {
+	c, rec := newContext(http.MethodGet, "/api/v1/leads")
+	_ = QueryTimeoutError(c, context.DeadlineExceeded)
+
+	assert.Equal(t, http.StatusGatewayTimeout, rec.Code)
+	resp := parseBody(t, rec)
+	assert.Equal(t, "query_timeout", resp.Error)
+	assert.NotEmpty(t, resp.Message)
+}
+
+func TestQueryTimeoutError_FromDatabaseAndInternalErrors(t *testing.T) {
+	timeouts := []error{
+		fmt.Errorf("failed to query leads: %w", context.DeadlineExceeded),
+		fmt.Errorf("failed to count signups: %w", &pq.Error{Code: "57014", Message: "canceling statement due to statement timeout"}),
+	}
+	for _, err := range timeouts {
+		c, rec := newContext(http.MethodGet, "/api/v1/leads")
+		_ = DatabaseError(c, err)
+		assert.Equal(t, http.StatusGatewayTimeout, rec.Code, err.Error())
+
+		c, rec = newContext(http.MethodGet, "/api/v1/leads")
+		_ = InternalError(c, err)
+		assert.Equal(t, http.StatusGatewayTimeout, rec.Code, err.Error())
+	}
+
+	// Other PostgreSQL errors are still 500s
+	c, rec := newContext(http.MethodGet, "/api/v1/leads")
+	_ = DatabaseError(c, &pq.Error{Code: "42P01"})
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+}
+
+func TestQueryTimeoutError_LogsInternalError(t *testing.T) {
+	logged := captureLog(func() {
+		c, _ := newContext(http.MethodGet, "/api/v1/analytics/cohorts")
+		c.Response().Header().Set(echo.HeaderXRequestID, "req-123")
+		_ = QueryTimeoutError(c, context.DeadlineExceeded)
+	})
+
+	assert.Contains(t, logged, "[QUERY TIMEOUT]")
+	assert.Contains(t, logged, "/api/v1/analytics/cohorts")
+	assert.Contains(t, logged, "req-123")
+}
+
 // ---------- Table-driven summary test ----------
 
 func TestAllErrors_StatusCodes(t *testing.T) {
@@ -345,6 +394,12 @@ func TestAllErrors_StatusCodes(t *testing.T) {
 			wantStatus: http.StatusInternalServerError,
 			wantError:  "internal_error",
 		},
+		{
+			name:       "QueryTimeoutError → 504",
+			call:       func(c echo.Context) error { return QueryTimeoutError(c, context.DeadlineExceeded) },
+			wantStatus: http.StatusGatewayTimeout,
+			wantError:  "query_timeout",
+		},
 		{
 			name:       "UnauthorizedError → 401",
 			call:       func(c echo.Context) error { return UnauthorizedError(c, "reason") },
@@ -385,3 +440,20 @@ func TestAllErrors_StatusCodes(t *testing.T) {
 		})
 	}
 }
+
+func TestValidationError_FieldErrors(t *testing.T) {
+	var req struct {
+		Name string `json:"name" validate:"required"`
+	}
+	c, rec := newContext(http.MethodPost, "/api/v1/organizations")
+	_ = ValidationError(c, validation.Validator().Struct(req))
+
+	resp := parseBody(t, rec)
+	assert.Equal(t, "validation_error", resp.Error)
+	assert.Equal(t, []models.FieldError{{Field: "name", Rule: "required", Message: "name is required"}}, resp.Fields)
+
+	// Other errors have no fields
+	c, rec = newContext(http.MethodPost, "/api/v1/organizations")
+	_ = ValidationError(c, errors.New("unexpected EOF"))
+	assert.NotContains(t, rec.Body.String(), "fields")
+}
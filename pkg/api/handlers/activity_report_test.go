@@ -0,0 +1,166 @@
+package handlers
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/auditlog"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/pkg/activityreport"
+	"github.com/jordanlanch/industrydb/pkg/analytics"
+	"github.com/jordanlanch/industrydb/pkg/audit"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupActivityReportTest(t *testing.T) (*ActivityReportHandler, *ent.Client, *ent.User, *ent.User) {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	auditService := audit.NewService(client)
+	reports := activityreport.NewService(client, auditService, analytics.NewService(client), nil)
+	handler := NewActivityReportHandler(client, reports, auditService)
+
+	admin, target, cleanup := createAdminAndRegularUser(t, client)
+	t.Cleanup(cleanup)
+
+	require.NoError(t, auditService.LogUserLogin(context.Background(), target.ID, "10.0.0.1", "test-agent"))
+	return handler, client, admin, target
+}
+
+func activityReportRequest(adminID int, userID string, query string) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/users/"+userID+"/activity-report?"+query, nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(userID)
+	c.Set("user_id", adminID)
+	return c, rec
+}
+
+func TestGetUserActivityReport_CSV(t *testing.T) {
+	handler, client, admin, target := setupActivityReportTest(t)
+
+	c, rec := activityReportRequest(admin.ID, strconv.Itoa(target.ID), "")
+	require.NoError(t, handler.GetUserActivityReport(c))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/csv", rec.Header().Get(echo.HeaderContentType))
+	assert.Contains(t, rec.Header().Get(echo.HeaderContentDisposition), "activity-report-user-"+strconv.Itoa(target.ID))
+
+	rows, err := csv.NewReader(rec.Body).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 3) // Header, user, login
+	assert.Equal(t, "audit_events", rows[2][0])
+	assert.Equal(t, "user_login", rows[2][3])
+
+	// The download itself is audited, as the admin
+	entry, err := client.AuditLog.Query().
+		Where(auditlog.ActionEQ(auditlog.ActionUserActivityReport)).
+		Only(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, entry.UserID)
+	assert.Equal(t, admin.ID, *entry.UserID)
+	assert.Equal(t, strconv.Itoa(target.ID), entry.ResourceID)
+	assert.Equal(t, "csv", entry.Metadata["format"])
+}
+
+func TestGetUserActivityReport_JSON(t *testing.T) {
+	handler, _, admin, target := setupActivityReportTest(t)
+
+	today := time.Now().UTC().Format("2006-01-02")
+	c, rec := activityReportRequest(admin.ID, strconv.Itoa(target.ID), "format=json&from="+today+"&to="+today)
+	require.NoError(t, handler.GetUserActivityReport(c))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var report struct {
+		User struct {
+			ID int `json:"id"`
+		} `json:"user"`
+		AuditEvents []activityreport.AuditEvent    `json:"audit_events"`
+		Sessions    []activityreport.SessionRecord `json:"sessions"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+	assert.Equal(t, target.ID, report.User.ID)
+	require.Len(t, report.AuditEvents, 1)
+	assert.Equal(t, "user_login", report.AuditEvents[0].Action)
+	assert.Empty(t, report.Sessions)
+}
+
+func TestGetUserActivityReport_UserNotFound(t *testing.T) {
+	handler, client, admin, _ := setupActivityReportTest(t)
+
+	c, rec := activityReportRequest(admin.ID, "99999", "")
+	require.NoError(t, handler.GetUserActivityReport(c))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	// Nothing was downloaded, so nothing is audited
+	count, err := client.AuditLog.Query().
+		Where(auditlog.ActionEQ(auditlog.ActionUserActivityReport)).
+		Count(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
+
+func TestGetUserActivityReport_InvalidRequest(t *testing.T) {
+	handler, _, admin, target := setupActivityReportTest(t)
+
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{"invalid from", "from=yesterday"},
+		{"invalid to", "to=2026-13-01"},
+		{"from after to", "from=2026-10-10&to=2026-10-01"},
+		{"period too long", "from=2024-01-01&to=2026-01-01"},
+		{"unsupported format", "format=xml"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, rec := activityReportRequest(admin.ID, strconv.Itoa(target.ID), tt.query)
+			require.NoError(t, handler.GetUserActivityReport(c))
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		})
+	}
+
+	t.Run("invalid user ID", func(t *testing.T) {
+		c, rec := activityReportRequest(admin.ID, "abc", "")
+		require.NoError(t, handler.GetUserActivityReport(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestParseActivityReportRequest(t *testing.T) {
+	now := time.Date(2026, 10, 16, 15, 0, 0, 0, time.UTC)
+	parse := func(query string) activityreport.Request {
+		c, _ := activityReportRequest(1, "1", query)
+		req, err := parseActivityReportRequest(c, now)
+		require.NoError(t, err)
+		return req
+	}
+
+	req := parse("")
+	assert.Equal(t, now.AddDate(0, 0, -30), req.From)
+	assert.Equal(t, now, req.To)
+	assert.Equal(t, activityreport.FormatCSV, req.Format)
+
+	// Dates cover whole days
+	req = parse("from=2026-09-01&to=2026-09-30")
+	assert.Equal(t, time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC), req.From)
+	assert.Equal(t, time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), req.To)
+
+	// Timestamps are exact, and the default period ends at to
+	req = parse("to=2026-09-30T12:00:00Z")
+	assert.Equal(t, time.Date(2026, 9, 30, 12, 0, 0, 0, time.UTC), req.To)
+	assert.Equal(t, time.Date(2026, 8, 31, 12, 0, 0, 0, time.UTC), req.From)
+}
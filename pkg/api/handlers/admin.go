@@ -10,11 +10,14 @@ import (
 
 	"github.com/go-playground/validator/v10"
 	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/auditlog"
 	"github.com/jordanlanch/industrydb/ent/export"
 	"github.com/jordanlanch/industrydb/ent/user"
 	"github.com/jordanlanch/industrydb/pkg/api/errors"
+	"github.com/jordanlanch/industrydb/pkg/api/validation"
 	"github.com/jordanlanch/industrydb/pkg/audit"
 	importpkg "github.com/jordanlanch/industrydb/pkg/import"
+	"github.com/jordanlanch/industrydb/pkg/leads"
 	"github.com/jordanlanch/industrydb/pkg/models"
 	"github.com/labstack/echo/v4"
 )
@@ -31,7 +34,7 @@ func NewAdminHandler(db *ent.Client, auditLogger *audit.Service) *AdminHandler {
 	return &AdminHandler{
 		db:          db,
 		auditLogger: auditLogger,
-		validator:   validator.New(),
+		validator:   validation.Validator(),
 	}
 }
 
@@ -351,6 +354,224 @@ func (h *AdminHandler) UpdateUser(c echo.Context) error {
 	})
 }
 
+// MaxBulkUserUpdate caps the number of users changed by one bulk update
+const MaxBulkUserUpdate = 500
+
+// BulkUpdateUsersRequest represents an admin bulk tier override request.
+// Users are selected by user_ids or by filter, not both.
+type BulkUpdateUsersRequest struct {
+	UserIDs          []int           `json:"user_ids" validate:"omitempty,dive,min=1"`
+	Filter           *BulkUserFilter `json:"filter"`
+	SubscriptionTier *string         `json:"subscription_tier" validate:"omitempty,oneof=free starter pro business"`
+	UsageLimit       *int            `json:"usage_limit" validate:"omitempty,min=0"`
+	Reason           string          `json:"reason" validate:"required,max=500"`
+}
+
+// BulkUserFilter selects users for a bulk update
+type BulkUserFilter struct {
+	SubscriptionTier string     `json:"subscription_tier" validate:"omitempty,oneof=free starter pro business"`
+	CreatedBefore    *time.Time `json:"created_before"`
+}
+
+// BulkUpdateUsersResult summarizes a bulk user update
+type BulkUpdateUsersResult struct {
+	Matched   int                     `json:"matched"`
+	Updated   []int                   `json:"updated"`
+	Unchanged []int                   `json:"unchanged"`
+	NotFound  []int                   `json:"not_found"`
+	Failed    []BulkUpdateUserFailure `json:"failed"`
+	Billing   string                  `json:"billing"` // Always "not_affected": overrides never touch Stripe
+}
+
+// BulkUpdateUserFailure describes a user the bulk update could not change
+type BulkUpdateUserFailure struct {
+	UserID int    `json:"user_id"`
+	Error  string `json:"error"`
+}
+
+// BulkUpdateUsers applies a tier and/or usage limit override to many users
+// @Summary Bulk update user tiers
+// @Description Internal tier/usage limit override for a cohort of users, selected by user_ids or by filter (tier, created_before), max 500 users. Each user is updated in its own transaction together with an audit entry. Stripe subscriptions are not changed; this is not a paid upgrade. When only subscription_tier is given, the usage limit is set to the tier's default (admin only)
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body BulkUpdateUsersRequest true "Users and target tier/usage limit"
+// @Success 200 {object} BulkUpdateUsersResult "Bulk update summary"
+// @Failure 400 {object} models.ErrorResponse "Invalid request or too many users"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden - Admin access required"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /admin/users/bulk-update [post]
+func (h *AdminHandler) BulkUpdateUsers(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 2*time.Minute)
+	defer cancel()
+
+	adminID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "User not authenticated",
+		})
+	}
+
+	var req BulkUpdateUsersRequest
+	if err := c.Bind(&req); err != nil {
+		return errors.ValidationError(c, err)
+	}
+	if err := h.validator.Struct(req); err != nil {
+		return errors.ValidationError(c, err)
+	}
+
+	if req.SubscriptionTier == nil && req.UsageLimit == nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "subscription_tier or usage_limit is required",
+		})
+	}
+	if (len(req.UserIDs) > 0) == (req.Filter != nil) {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Provide either user_ids or filter",
+		})
+	}
+	if req.Filter != nil && req.Filter.SubscriptionTier == "" && req.Filter.CreatedBefore == nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "filter requires subscription_tier or created_before",
+		})
+	}
+
+	// Default the usage limit to the target tier's limit
+	usageLimit := req.UsageLimit
+	if usageLimit == nil {
+		limit := leads.GetUsageLimitForTier(*req.SubscriptionTier)
+		usageLimit = &limit
+	}
+
+	// Resolve target user IDs
+	var userIDs []int
+	if req.Filter != nil {
+		query := h.db.User.Query()
+		if req.Filter.SubscriptionTier != "" {
+			query = query.Where(user.SubscriptionTierEQ(user.SubscriptionTier(req.Filter.SubscriptionTier)))
+		}
+		if req.Filter.CreatedBefore != nil {
+			query = query.Where(user.CreatedAtLT(*req.Filter.CreatedBefore))
+		}
+		ids, err := query.Order(ent.Asc(user.FieldID)).Limit(MaxBulkUserUpdate + 1).IDs(ctx)
+		if err != nil {
+			return errors.DatabaseError(c, err)
+		}
+		userIDs = ids
+	} else {
+		seen := make(map[int]bool, len(req.UserIDs))
+		for _, id := range req.UserIDs {
+			if !seen[id] {
+				seen[id] = true
+				userIDs = append(userIDs, id)
+			}
+		}
+	}
+
+	if len(userIDs) > MaxBulkUserUpdate {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "too_many_users",
+			Message: fmt.Sprintf("Cannot update more than %d users at once", MaxBulkUserUpdate),
+		})
+	}
+
+	result := BulkUpdateUsersResult{
+		Matched:   len(userIDs),
+		Updated:   []int{},
+		Unchanged: []int{},
+		NotFound:  []int{},
+		Failed:    []BulkUpdateUserFailure{},
+		Billing:   "not_affected",
+	}
+
+	ipAddress, userAgent := audit.GetRequestContext(c)
+	for _, id := range userIDs {
+		changed, err := h.overrideUserTier(ctx, adminID, id, req.SubscriptionTier, *usageLimit, req.Reason, ipAddress, userAgent)
+		switch {
+		case ent.IsNotFound(err):
+			result.NotFound = append(result.NotFound, id)
+		case err != nil:
+			result.Failed = append(result.Failed, BulkUpdateUserFailure{UserID: id, Error: err.Error()})
+		case changed:
+			result.Updated = append(result.Updated, id)
+		default:
+			result.Unchanged = append(result.Unchanged, id)
+		}
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// overrideUserTier updates one user's tier and usage limit and records the
+// audit entry in the same transaction. It reports whether anything changed.
+func (h *AdminHandler) overrideUserTier(ctx context.Context, adminID, userID int, tier *string, usageLimit int, reason, ipAddress, userAgent string) (bool, error) {
+	tx, err := h.db.Tx(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	u, err := tx.User.Get(ctx, userID)
+	if err != nil {
+		_ = tx.Rollback()
+		return false, err
+	}
+
+	newTier := u.SubscriptionTier
+	if tier != nil {
+		newTier = user.SubscriptionTier(*tier)
+	}
+	if newTier == u.SubscriptionTier && usageLimit == u.UsageLimit {
+		_ = tx.Rollback()
+		return false, nil
+	}
+
+	if _, err := tx.User.UpdateOneID(userID).
+		SetSubscriptionTier(newTier).
+		SetUsageLimit(usageLimit).
+		Save(ctx); err != nil {
+		_ = tx.Rollback()
+		return false, err
+	}
+
+	description := fmt.Sprintf("Admin tier override (internal, not billed): %s -> %s", u.SubscriptionTier, newTier)
+	if _, err := tx.AuditLog.Create().
+		SetUserID(adminID).
+		SetAction(auditlog.ActionUserUpdate).
+		SetResourceType("user").
+		SetResourceID(strconv.Itoa(userID)).
+		SetIPAddress(ipAddress).
+		SetUserAgent(userAgent).
+		SetSeverity(auditlog.SeverityWarning).
+		SetDescription(description).
+		SetMetadata(map[string]interface{}{
+			"admin_id":             adminID,
+			"target_user_id":       userID,
+			"bulk":                 true,
+			"override":             true,
+			"billing":              "not_affected",
+			"reason":               reason,
+			"previous_tier":        string(u.SubscriptionTier),
+			"subscription_tier":    string(newTier),
+			"previous_usage_limit": u.UsageLimit,
+			"usage_limit":          usageLimit,
+		}).
+		Save(ctx); err != nil {
+		_ = tx.Rollback()
+		return false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 // SuspendUser suspends a user account (soft delete)
 // @Summary Suspend user account
 // @Description Suspend (soft delete) a user account - cannot suspend yourself or superadmins (admin only)
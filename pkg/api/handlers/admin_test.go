@@ -3,6 +3,7 @@ package handlers
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -10,6 +11,7 @@ import (
 	"time"
 
 	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/auditlog"
 	"github.com/jordanlanch/industrydb/ent/enttest"
 	"github.com/jordanlanch/industrydb/ent/user"
 	"github.com/jordanlanch/industrydb/pkg/audit"
@@ -340,3 +342,160 @@ func TestListUsersRequiresAdmin(t *testing.T) {
 	// This test documents expected behavior
 	assert.NoError(t, err) // Handler itself doesn't check, middleware does
 }
+
+// setupBulkUpdateTest creates an isolated database with an admin and a
+// starter cohort for bulk update tests
+func setupBulkUpdateTest(t *testing.T, name string) (*ent.Client, *ent.User, []*ent.User) {
+	client := enttest.Open(t, "sqlite3", "file:"+name+"?mode=memory&cache=shared&_fk=1")
+	ctx := context.Background()
+
+	admin, err := client.User.Create().
+		SetEmail("bulk-admin@test.com").
+		SetName("Bulk Admin").
+		SetPasswordHash("hashed_password").
+		SetRole(user.RoleSuperadmin).
+		SetSubscriptionTier(user.SubscriptionTierFree).
+		SetUsageLimit(50).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("failed creating admin: %v", err)
+	}
+
+	var cohort []*ent.User
+	for i := 0; i < 3; i++ {
+		u, err := client.User.Create().
+			SetEmail(fmt.Sprintf("partner%d@test.com", i)).
+			SetName("Partner User").
+			SetPasswordHash("hashed_password").
+			SetSubscriptionTier(user.SubscriptionTierStarter).
+			SetUsageLimit(500).
+			Save(ctx)
+		if err != nil {
+			t.Fatalf("failed creating user: %v", err)
+		}
+		cohort = append(cohort, u)
+	}
+
+	return client, admin, cohort
+}
+
+func newBulkUpdateContext(adminID int, body string) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/users/bulk-update", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", adminID)
+	return c, rec
+}
+
+func TestBulkUpdateUsers_ByIDs(t *testing.T) {
+	client, admin, cohort := setupBulkUpdateTest(t, "admin_bulk_ids")
+	defer client.Close()
+	ctx := context.Background()
+
+	handler := NewAdminHandler(client, audit.NewService(client))
+	body := fmt.Sprintf(`{"user_ids":[%d,%d,%d,%d],"subscription_tier":"pro","reason":"Partner migration"}`,
+		cohort[0].ID, cohort[1].ID, cohort[0].ID, 99999)
+	c, rec := newBulkUpdateContext(admin.ID, body)
+
+	assert.NoError(t, handler.BulkUpdateUsers(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var result BulkUpdateUsersResult
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+	assert.Equal(t, 3, result.Matched) // Duplicates are ignored
+	assert.ElementsMatch(t, []int{cohort[0].ID, cohort[1].ID}, result.Updated)
+	assert.Equal(t, []int{99999}, result.NotFound)
+	assert.Equal(t, "not_affected", result.Billing)
+
+	// Tier and default usage limit applied; untouched users unchanged
+	updated, err := client.User.Get(ctx, cohort[0].ID)
+	assert.NoError(t, err)
+	assert.Equal(t, user.SubscriptionTierPro, updated.SubscriptionTier)
+	assert.Equal(t, 2000, updated.UsageLimit)
+	untouched, err := client.User.Get(ctx, cohort[2].ID)
+	assert.NoError(t, err)
+	assert.Equal(t, user.SubscriptionTierStarter, untouched.SubscriptionTier)
+
+	// One audit entry per updated user, marked as an internal override
+	logs, err := client.AuditLog.Query().
+		Where(auditlog.ActionEQ(auditlog.ActionUserUpdate)).
+		All(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, logs, 2)
+	for _, l := range logs {
+		assert.Equal(t, true, l.Metadata["override"])
+		assert.Equal(t, "not_affected", l.Metadata["billing"])
+		assert.Equal(t, "Partner migration", l.Metadata["reason"])
+	}
+
+	// Re-running is a no-op
+	c, rec = newBulkUpdateContext(admin.ID, body)
+	assert.NoError(t, handler.BulkUpdateUsers(c))
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+	assert.Empty(t, result.Updated)
+	assert.ElementsMatch(t, []int{cohort[0].ID, cohort[1].ID}, result.Unchanged)
+}
+
+func TestBulkUpdateUsers_ByFilter(t *testing.T) {
+	client, admin, cohort := setupBulkUpdateTest(t, "admin_bulk_filter")
+	defer client.Close()
+	ctx := context.Background()
+
+	handler := NewAdminHandler(client, audit.NewService(client))
+	c, rec := newBulkUpdateContext(admin.ID, `{"filter":{"subscription_tier":"starter"},"subscription_tier":"business","usage_limit":25000,"reason":"Enterprise pilot"}`)
+
+	assert.NoError(t, handler.BulkUpdateUsers(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var result BulkUpdateUsersResult
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+	assert.Equal(t, 3, result.Matched)
+	assert.Len(t, result.Updated, 3)
+
+	for _, u := range cohort {
+		updated, err := client.User.Get(ctx, u.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, user.SubscriptionTierBusiness, updated.SubscriptionTier)
+		assert.Equal(t, 25000, updated.UsageLimit)
+	}
+
+	// The admin (free tier) was not matched
+	adminUser, err := client.User.Get(ctx, admin.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, user.SubscriptionTierFree, adminUser.SubscriptionTier)
+}
+
+func TestBulkUpdateUsers_Validation(t *testing.T) {
+	client, admin, cohort := setupBulkUpdateTest(t, "admin_bulk_validation")
+	defer client.Close()
+
+	handler := NewAdminHandler(client, audit.NewService(client))
+
+	tooMany := make([]string, MaxBulkUserUpdate+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprint(i + 1)
+	}
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"missing target", fmt.Sprintf(`{"user_ids":[%d],"reason":"x"}`, cohort[0].ID)},
+		{"missing reason", fmt.Sprintf(`{"user_ids":[%d],"subscription_tier":"pro"}`, cohort[0].ID)},
+		{"no selection", `{"subscription_tier":"pro","reason":"x"}`},
+		{"ids and filter", fmt.Sprintf(`{"user_ids":[%d],"filter":{"subscription_tier":"starter"},"subscription_tier":"pro","reason":"x"}`, cohort[0].ID)},
+		{"empty filter", `{"filter":{},"subscription_tier":"pro","reason":"x"}`},
+		{"invalid tier", fmt.Sprintf(`{"user_ids":[%d],"subscription_tier":"platinum","reason":"x"}`, cohort[0].ID)},
+		{"too many users", `{"user_ids":[` + strings.Join(tooMany, ",") + `],"subscription_tier":"pro","reason":"x"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, rec := newBulkUpdateContext(admin.ID, tt.body)
+			assert.NoError(t, handler.BulkUpdateUsers(c))
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		})
+	}
+}
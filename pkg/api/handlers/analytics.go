@@ -1,13 +1,13 @@
 package handlers
 
 import (
-	"context"
 	"net/http"
 	"strconv"
 	"time"
 
 	"github.com/jordanlanch/industrydb/pkg/analytics"
 	"github.com/jordanlanch/industrydb/pkg/api/errors"
+	"github.com/jordanlanch/industrydb/pkg/database"
 	"github.com/labstack/echo/v4"
 )
 
@@ -34,6 +34,7 @@ func NewAnalyticsHandler(analyticsService *analytics.Service) *AnalyticsHandler
 // @Success 200 {object} map[string]interface{} "Daily usage data with day count"
 // @Failure 401 {object} map[string]string "Unauthorized"
 // @Failure 500 {object} map[string]string "Internal server error"
+// @Failure 504 {object} map[string]string "Query timed out"
 // @Router /user/analytics/daily [get]
 func (h *AnalyticsHandler) GetDailyUsage(c echo.Context) error {
 	// Get user ID from context
@@ -54,7 +55,7 @@ func (h *AnalyticsHandler) GetDailyUsage(c echo.Context) error {
 	}
 
 	// Create context with timeout
-	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	ctx, cancel := database.WithQueryTimeout(c.Request().Context())
 	defer cancel()
 
 	// Get daily usage
@@ -80,6 +81,7 @@ func (h *AnalyticsHandler) GetDailyUsage(c echo.Context) error {
 // @Success 200 {object} map[string]interface{} "Aggregated usage summary"
 // @Failure 401 {object} map[string]string "Unauthorized"
 // @Failure 500 {object} map[string]string "Internal server error"
+// @Failure 504 {object} map[string]string "Query timed out"
 // @Router /user/analytics/summary [get]
 func (h *AnalyticsHandler) GetUsageSummary(c echo.Context) error {
 	// Get user ID from context
@@ -100,7 +102,7 @@ func (h *AnalyticsHandler) GetUsageSummary(c echo.Context) error {
 	}
 
 	// Create context with timeout
-	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	ctx, cancel := database.WithQueryTimeout(c.Request().Context())
 	defer cancel()
 
 	// Get summary
@@ -123,6 +125,7 @@ func (h *AnalyticsHandler) GetUsageSummary(c echo.Context) error {
 // @Success 200 {object} map[string]interface{} "Usage breakdown by action type"
 // @Failure 401 {object} map[string]string "Unauthorized"
 // @Failure 500 {object} map[string]string "Internal server error"
+// @Failure 504 {object} map[string]string "Query timed out"
 // @Router /user/analytics/breakdown [get]
 func (h *AnalyticsHandler) GetActionBreakdown(c echo.Context) error {
 	// Get user ID from context
@@ -143,7 +146,7 @@ func (h *AnalyticsHandler) GetActionBreakdown(c echo.Context) error {
 	}
 
 	// Create context with timeout
-	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	ctx, cancel := database.WithQueryTimeout(c.Request().Context())
 	defer cancel()
 
 	// Get breakdown
@@ -157,3 +160,79 @@ func (h *AnalyticsHandler) GetActionBreakdown(c echo.Context) error {
 		"days":      days,
 	})
 }
+
+// GetTargetingBreakdown godoc
+// @Summary Get usage breakdown by targeted industry and country
+// @Description Returns the industries and countries the authenticated user's searches and exports targeted most
+// @Tags Analytics
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param days query integer false "Number of days to analyze (1-365)" default(30)
+// @Success 200 {object} analytics.TargetingBreakdown "Usage breakdown by industry and country"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 500 {object} map[string]string "Internal server error"
+// @Failure 504 {object} map[string]string "Query timed out"
+// @Router /user/analytics/targeting [get]
+func (h *AnalyticsHandler) GetTargetingBreakdown(c echo.Context) error {
+	// Get user ID from context
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{
+			"error": "unauthorized",
+		})
+	}
+
+	// Get days parameter (default: 30)
+	daysStr := c.QueryParam("days")
+	days := 30
+	if daysStr != "" {
+		if d, err := strconv.Atoi(daysStr); err == nil && d > 0 && d <= 365 {
+			days = d
+		}
+	}
+
+	// Create context with timeout
+	ctx, cancel := database.WithQueryTimeout(c.Request().Context())
+	defer cancel()
+
+	// Get breakdown
+	breakdown, err := h.analyticsService.GetTargetingBreakdown(ctx, userID, days)
+	if err != nil {
+		return errors.DatabaseError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, breakdown)
+}
+
+// GetExportAnalytics godoc
+// @Summary Get export download analytics (admin)
+// @Description Returns how many exports were created versus downloaded over a configurable number of days: download rate, abandoned exports, downloads per export, time from creation to last download, average lead count and file size, and format distribution
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param days query integer false "Number of days to analyze (1-365)" default(30)
+// @Success 200 {object} analytics.ExportAnalytics
+// @Failure 500 {object} map[string]string "Internal server error"
+// @Failure 504 {object} map[string]string "Query timed out"
+// @Router /admin/analytics/exports [get]
+func (h *AnalyticsHandler) GetExportAnalytics(c echo.Context) error {
+	// Get days parameter (default: 30)
+	daysStr := c.QueryParam("days")
+	days := 30
+	if daysStr != "" {
+		if d, err := strconv.Atoi(daysStr); err == nil && d > 0 && d <= 365 {
+			days = d
+		}
+	}
+
+	ctx, cancel := database.WithQueryTimeout(c.Request().Context())
+	defer cancel()
+
+	stats, err := h.analyticsService.GetExportAnalytics(ctx, days, time.Now())
+	if err != nil {
+		return errors.DatabaseError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, stats)
+}
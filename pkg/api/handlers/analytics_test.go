@@ -407,3 +407,108 @@ func TestAnalyticsEndpoints_EdgeCases(t *testing.T) {
 		assert.Equal(t, float64(365), response["days"])
 	})
 }
+
+func TestGetTargetingBreakdown_Success(t *testing.T) {
+	client, handler, cleanup := setupAnalyticsTest(t)
+	defer cleanup()
+
+	user := createAnalyticsTestUser(t, client)
+	createTestUsageLogs(t, client, user.ID, 7)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/user/analytics/targeting?days=7", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", user.ID)
+
+	err := handler.GetTargetingBreakdown(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response analytics.TargetingBreakdown
+	err = json.Unmarshal(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+
+	assert.Equal(t, 7, response.PeriodDays)
+	require.Len(t, response.Industries, 1)
+	assert.Equal(t, "tattoo", response.Industries[0].Value)
+	assert.Greater(t, response.Industries[0].Searches, 0)
+	// Test exports only record the format
+	assert.Greater(t, response.Untargeted, 0)
+}
+
+func TestGetTargetingBreakdown_Unauthorized(t *testing.T) {
+	_, handler, cleanup := setupAnalyticsTest(t)
+	defer cleanup()
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/user/analytics/targeting", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	// No user_id
+
+	err := handler.GetTargetingBreakdown(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestGetExportAnalytics_Success(t *testing.T) {
+	client, handler, cleanup := setupAnalyticsTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	user := createAnalyticsTestUser(t, client)
+	now := time.Now()
+	for i, downloads := range []int{3, 0} {
+		_, err := client.Export.Create().
+			SetUserID(user.ID).
+			SetFormat("csv").
+			SetStatus("ready").
+			SetLeadCount(50 * (i + 1)).
+			SetDownloadCount(downloads).
+			SetExpiresAt(now.Add(24 * time.Hour)).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/admin/analytics/exports?days=7", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := handler.GetExportAnalytics(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response analytics.ExportAnalytics
+	err = json.Unmarshal(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+
+	assert.Equal(t, 7, response.Days)
+	assert.Equal(t, 2, response.Created)
+	assert.Equal(t, 1, response.Downloaded)
+	assert.Equal(t, 50.0, response.DownloadRate)
+	assert.Equal(t, 3, response.TotalDownloads)
+	assert.Equal(t, 75.0, response.AvgLeadCount)
+	require.Len(t, response.Formats, 1)
+	assert.Equal(t, "csv", response.Formats[0].Format)
+}
+
+func TestGetExportAnalytics_InvalidDaysUsesDefault(t *testing.T) {
+	_, handler, cleanup := setupAnalyticsTest(t)
+	defer cleanup()
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/admin/analytics/exports?days=400", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := handler.GetExportAnalytics(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response analytics.ExportAnalytics
+	err = json.Unmarshal(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+	assert.Equal(t, 30, response.Days)
+}
@@ -6,8 +6,8 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/go-playground/validator/v10"
 	"github.com/jordanlanch/industrydb/pkg/api/errors"
+	"github.com/jordanlanch/industrydb/pkg/api/validation"
 	"github.com/jordanlanch/industrydb/pkg/apikey"
 	"github.com/jordanlanch/industrydb/pkg/models"
 	"github.com/labstack/echo/v4"
@@ -16,17 +16,21 @@ import (
 // APIKeyHandler handles API key endpoints
 type APIKeyHandler struct {
 	apiKeyService *apikey.Service
-	validator     *validator.Validate
+	requestLog    *apikey.RequestLog
 }
 
 // NewAPIKeyHandler creates a new API key handler
 func NewAPIKeyHandler(apiKeyService *apikey.Service) *APIKeyHandler {
 	return &APIKeyHandler{
 		apiKeyService: apiKeyService,
-		validator:     validator.New(),
 	}
 }
 
+// SetRequestLog enables the per-key request history endpoint
+func (h *APIKeyHandler) SetRequestLog(requestLog *apikey.RequestLog) {
+	h.requestLog = requestLog
+}
+
 // Create godoc
 // @Summary Create a new API key
 // @Description Create a new API key for programmatic access. Requires Business tier subscription. The plain key is only shown once on creation.
@@ -35,6 +39,7 @@ func NewAPIKeyHandler(apiKeyService *apikey.Service) *APIKeyHandler {
 // @Produce json
 // @Security BearerAuth
 // @Param request body apikey.CreateAPIKeyRequest true "API key configuration"
+// @Param Idempotency-Key header string false "Retries with the same key within the idempotency window (default 24 hours) return the first response instead of repeating the request"
 // @Success 201 {object} map[string]interface{} "API key created with plain key (shown only once)"
 // @Failure 400 {object} models.ErrorResponse "Invalid request"
 // @Failure 401 {object} models.ErrorResponse "Unauthorized"
@@ -51,14 +56,9 @@ func (h *APIKeyHandler) Create(c echo.Context) error {
 		})
 	}
 
-	// Parse request
+	// Parse and validate request
 	var req apikey.CreateAPIKeyRequest
-	if err := c.Bind(&req); err != nil {
-		return errors.ValidationError(c, err)
-	}
-
-	// Validate request
-	if err := h.validator.Struct(req); err != nil {
+	if err := validation.Bind(c, &req); err != nil {
 		return errors.ValidationError(c, err)
 	}
 
@@ -91,7 +91,9 @@ func (h *APIKeyHandler) Create(c echo.Context) error {
 // @Tags API Keys
 // @Produce json
 // @Security BearerAuth
-// @Success 200 {object} map[string]interface{} "List of API keys with total count"
+// @Param page query int false "Page number (default 1)"
+// @Param limit query int false "Items per page (default all, max 100)"
+// @Success 200 {object} ListResponse{data=[]ent.APIKey} "List of API keys, paginated"
 // @Failure 401 {object} models.ErrorResponse "Unauthorized"
 // @Failure 500 {object} models.ErrorResponse "Internal server error"
 // @Router /api-keys [get]
@@ -110,15 +112,13 @@ func (h *APIKeyHandler) List(c echo.Context) error {
 	defer cancel()
 
 	// List API keys
-	keys, err := h.apiKeyService.ListAPIKeys(ctx, userID)
+	page := parseListPage(c)
+	keys, total, err := h.apiKeyService.ListAPIKeys(ctx, userID, page.Limit, page.Offset())
 	if err != nil {
 		return errors.InternalError(c, err)
 	}
 
-	return c.JSON(http.StatusOK, map[string]interface{}{
-		"api_keys": keys,
-		"total":    len(keys),
-	})
+	return respondList(c, page, keys, total, "api_keys", "total")
 }
 
 // Get godoc
@@ -170,6 +170,78 @@ func (h *APIKeyHandler) Get(c echo.Context) error {
 	return c.JSON(http.StatusOK, key)
 }
 
+// ListRequests godoc
+// @Summary List recent requests of an API key
+// @Description Get the most recent requests made with an API key, newest first: time, method, route, response status and latency. The last 200 requests of each key are kept for up to 7 days after its last use. Use it to debug integrations.
+// @Tags API Keys
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "API key ID"
+// @Param limit query int false "Maximum requests to return (default 50, max 200)"
+// @Success 200 {object} map[string]interface{} "Recent requests and count"
+// @Failure 400 {object} models.ErrorResponse "Invalid ID"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 404 {object} models.ErrorResponse "API key not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Failure 503 {object} models.ErrorResponse "Request log unavailable"
+// @Router /api-keys/{id}/requests [get]
+func (h *APIKeyHandler) ListRequests(c echo.Context) error {
+	// Get user ID from context
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "Authentication required",
+		})
+	}
+
+	// Parse key ID
+	keyID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "API key ID must be a number",
+		})
+	}
+
+	if h.requestLog == nil {
+		return c.JSON(http.StatusServiceUnavailable, models.ErrorResponse{
+			Error:   "request_log_unavailable",
+			Message: "API key request history is not available",
+		})
+	}
+
+	limit := 50
+	if l, err := strconv.Atoi(c.QueryParam("limit")); err == nil && l > 0 {
+		limit = l
+	}
+	if limit > apikey.MaxRequestLogEntries {
+		limit = apikey.MaxRequestLogEntries
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	// Verify ownership
+	if _, err := h.apiKeyService.GetAPIKey(ctx, userID, keyID); err != nil {
+		if err.Error() == "API key not found" {
+			return errors.NotFoundError(c, "API key")
+		}
+		return errors.InternalError(c, err)
+	}
+
+	requests, err := h.requestLog.List(ctx, keyID, limit)
+	if err != nil {
+		return errors.InternalError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"requests": requests,
+		"count":    len(requests),
+	})
+}
+
 // Revoke godoc
 // @Summary Revoke an API key
 // @Description Revoke an API key (soft delete). The key can no longer be used for authentication but the record is preserved.
@@ -265,6 +337,12 @@ func (h *APIKeyHandler) Delete(c echo.Context) error {
 		return errors.InternalError(c, err)
 	}
 
+	if h.requestLog != nil {
+		if err := h.requestLog.Clear(ctx, keyID); err != nil {
+			c.Logger().Warnf("failed to clear request log of API key %d: %v", keyID, err)
+		}
+	}
+
 	return c.JSON(http.StatusOK, map[string]string{
 		"message": "API key deleted successfully",
 	})
@@ -305,14 +383,11 @@ func (h *APIKeyHandler) UpdateName(c echo.Context) error {
 		})
 	}
 
-	// Parse request
+	// Parse and validate request
 	var req struct {
 		Name string `json:"name" validate:"required,min=2,max=100"`
 	}
-	if err := c.Bind(&req); err != nil {
-		return errors.ValidationError(c, err)
-	}
-	if err := h.validator.Struct(req); err != nil {
+	if err := validation.Bind(c, &req); err != nil {
 		return errors.ValidationError(c, err)
 	}
 
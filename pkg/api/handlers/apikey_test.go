@@ -10,10 +10,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/alicebob/miniredis/v2"
 	"github.com/jordanlanch/industrydb/ent"
 	"github.com/jordanlanch/industrydb/ent/enttest"
 	"github.com/jordanlanch/industrydb/ent/user"
+	custommw "github.com/jordanlanch/industrydb/pkg/api/middleware"
 	"github.com/jordanlanch/industrydb/pkg/apikey"
+	"github.com/jordanlanch/industrydb/pkg/cache"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -745,3 +748,99 @@ func TestAPIKeyHandler_GetStats_Unauthorized(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, http.StatusUnauthorized, rec.Code)
 }
+
+// --- Request Log Tests ---
+
+func TestAPIKeyHandler_ListRequests(t *testing.T) {
+	handler, svc, client, cleanup := setupAPIKeyHandler(t)
+	defer cleanup()
+
+	mr := miniredis.RunT(t)
+	redisClient, err := cache.NewClient("redis://" + mr.Addr())
+	require.NoError(t, err)
+	defer redisClient.Close()
+	requestLog := apikey.NewRequestLog(redisClient)
+	handler.SetRequestLog(requestLog)
+
+	userID := createAPIKeyTestUser(t, client, "business")
+	created, err := svc.CreateAPIKey(context.Background(), userID, apikey.CreateAPIKeyRequest{Name: "Integration"})
+	require.NoError(t, err)
+
+	// Requests authenticated with the key are logged by the middleware
+	e := echo.New()
+	api := e.Group("/api/v1/integrations", custommw.APIKeyMiddleware(svc, client, requestLog))
+	api.GET("/ok", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+	api.GET("/missing", func(c echo.Context) error { return echo.NewHTTPError(http.StatusNotFound) })
+	for _, path := range []string{"/api/v1/integrations/ok", "/api/v1/integrations/missing"} {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set(custommw.APIKeyHeader, created.Key)
+		e.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	listRequests := func(userID int, id string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/api-keys/"+id+"/requests", nil)
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		c.Set("user_id", userID)
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+		require.NoError(t, handler.ListRequests(c))
+		return rec
+	}
+
+	rec := listRequests(userID, strconv.Itoa(created.ID))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var response struct {
+		Requests []apikey.RequestLogEntry `json:"requests"`
+		Count    int                      `json:"count"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Equal(t, 2, response.Count)
+	assert.Equal(t, "/api/v1/integrations/missing", response.Requests[0].Route)
+	assert.Equal(t, http.StatusNotFound, response.Requests[0].Status)
+	assert.Equal(t, "/api/v1/integrations/ok", response.Requests[1].Route)
+	assert.Equal(t, http.StatusOK, response.Requests[1].Status)
+	assert.Equal(t, http.MethodGet, response.Requests[1].Method)
+
+	// Other users can't see the key's requests
+	other, err := client.User.Create().
+		SetEmail("apikey-other@example.com").
+		SetPasswordHash("$2a$10$hash").
+		SetName("Other").
+		SetAcceptedTermsAt(time.Now()).
+		Save(context.Background())
+	require.NoError(t, err)
+	rec = listRequests(other.ID, strconv.Itoa(created.ID))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = listRequests(userID, "abc")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestAPIKeyMiddleware_RequiresBusinessTier(t *testing.T) {
+	_, svc, client, cleanup := setupAPIKeyHandler(t)
+	defer cleanup()
+
+	userID := createAPIKeyTestUser(t, client, "business")
+	created, err := svc.CreateAPIKey(context.Background(), userID, apikey.CreateAPIKeyRequest{Name: "Integration"})
+	require.NoError(t, err)
+
+	e := echo.New()
+	api := e.Group("/api/v1/integrations", custommw.APIKeyMiddleware(svc, client, nil))
+	api.GET("/ok", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+	call := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/integrations/ok", nil)
+		req.Header.Set(custommw.APIKeyHeader, created.Key)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	assert.Equal(t, http.StatusOK, call().Code)
+
+	// Keys of downgraded users stop working
+	client.User.UpdateOneID(userID).SetSubscriptionTier(user.SubscriptionTierPro).ExecX(context.Background())
+	rec := call()
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Contains(t, rec.Body.String(), "upgrade_required")
+}
@@ -3,9 +3,11 @@ package handlers
 import (
 	"context"
 	"crypto/rand"
-	"crypto/sha256"
 	"encoding/hex"
+	stderrors "errors"
 	"fmt"
+	"log"
+	"math"
 	"net/http"
 	"strconv"
 	"time"
@@ -14,10 +16,12 @@ import (
 	"github.com/jordanlanch/industrydb/ent"
 	"github.com/jordanlanch/industrydb/ent/user"
 	"github.com/jordanlanch/industrydb/pkg/api/errors"
+	"github.com/jordanlanch/industrydb/pkg/api/validation"
 	"github.com/jordanlanch/industrydb/pkg/audit"
 	"github.com/jordanlanch/industrydb/pkg/auth"
 	"github.com/jordanlanch/industrydb/pkg/cache"
 	"github.com/jordanlanch/industrydb/pkg/email"
+	"github.com/jordanlanch/industrydb/pkg/leads"
 	"github.com/jordanlanch/industrydb/pkg/models"
 	"github.com/jordanlanch/industrydb/pkg/oauth"
 	"github.com/labstack/echo/v4"
@@ -29,6 +33,7 @@ type AuthHandler struct {
 	db           *ent.Client
 	config       *config.Config
 	blacklist    *auth.TokenBlacklist
+	sessions     *auth.SessionStore
 	cache        *cache.Client
 	auditLogger  *audit.Service
 	emailService *email.Service
@@ -41,10 +46,11 @@ func NewAuthHandler(db *ent.Client, cfg *config.Config, blacklist *auth.TokenBla
 		db:           db,
 		config:       cfg,
 		blacklist:    blacklist,
+		sessions:     auth.NewSessionStore(cache, blacklist),
 		cache:        cache,
 		auditLogger:  auditLogger,
 		emailService: emailService,
-		validator:    validator.New(),
+		validator:    validation.Validator(),
 	}
 }
 
@@ -116,7 +122,7 @@ func (h *AuthHandler) Register(c echo.Context) error {
 		SetName(req.Name).
 		SetSubscriptionTier(user.SubscriptionTierFree).
 		SetUsageCount(0).
-		SetUsageLimit(50).
+		SetUsageLimit(leads.GetUsageLimitForTier(string(user.SubscriptionTierFree))).
 		SetLastResetAt(time.Now()).
 		SetAcceptedTermsAt(time.Now()).
 		SetEmailVerificationToken(verificationToken).
@@ -137,13 +143,7 @@ func (h *AuthHandler) Register(c echo.Context) error {
 	go h.emailService.SendVerificationEmail(newUser.Email, newUser.Name, verificationToken)
 
 	// Generate JWT
-	token, err := auth.GenerateJWT(
-		newUser.ID,
-		newUser.Email,
-		string(newUser.SubscriptionTier),
-		h.config.JWTSecret,
-		h.config.JWTExpirationHours,
-	)
+	token, err := h.issueToken(c, newUser.ID, newUser.Email, string(newUser.SubscriptionTier))
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error: "token_generation_error",
@@ -231,13 +231,7 @@ func (h *AuthHandler) Login(c echo.Context) error {
 	go h.auditLogger.LogUserLogin(context.Background(), u.ID, ipAddress, userAgent)
 
 	// Generate JWT
-	token, err := auth.GenerateJWT(
-		u.ID,
-		u.Email,
-		string(u.SubscriptionTier),
-		h.config.JWTSecret,
-		h.config.JWTExpirationHours,
-	)
+	token, err := h.issueToken(c, u.ID, u.Email, string(u.SubscriptionTier))
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error: "token_generation_error",
@@ -327,6 +321,13 @@ func (h *AuthHandler) Logout(c echo.Context) error {
 		})
 	}
 
+	// Stop tracking the session, revoking any other token it may have
+	if sessionID, _ := c.Get("session_id").(string); sessionID != "" && userID > 0 && h.sessions != nil {
+		if err := h.sessions.Revoke(ctx, userID, sessionID); err != nil && !stderrors.Is(err, auth.ErrSessionNotFound) {
+			log.Printf("⚠️  Failed to revoke session of user %d: %v", userID, err)
+		}
+	}
+
 	// Log logout event
 	if userID > 0 {
 		ipAddress, userAgent := audit.GetRequestContext(c)
@@ -432,6 +433,17 @@ func (h *AuthHandler) ResendVerificationEmail(c echo.Context) error {
 		})
 	}
 
+	// Per-user resend limit (protects the user's inbox and our sender reputation)
+	if retryAfter := h.checkVerificationResendLimit(ctx, userID); retryAfter > 0 {
+		seconds := int(math.Ceil(retryAfter.Seconds()))
+		c.Response().Header().Set("Retry-After", strconv.Itoa(seconds))
+		return c.JSON(http.StatusTooManyRequests, map[string]interface{}{
+			"error":       "too_many_requests",
+			"message":     fmt.Sprintf("Verification email was sent recently. Please try again in %d seconds.", seconds),
+			"retry_after": seconds,
+		})
+	}
+
 	// Generate new verification token
 	verificationToken, err := generateVerificationToken()
 	if err != nil {
@@ -505,12 +517,8 @@ func (h *AuthHandler) ForgotPassword(c echo.Context) error {
 		})
 	}
 
-	// Store token hash in Redis with 1-hour expiration
-	tokenHash := sha256.Sum256([]byte(resetToken))
-	tokenKey := fmt.Sprintf("password_reset:%s", hex.EncodeToString(tokenHash[:]))
-
-	err = h.cache.Set(ctx, tokenKey, fmt.Sprintf("%d", u.ID), time.Hour)
-	if err != nil {
+	// Store the token hash in Redis, superseding any earlier reset link
+	if err := h.storePasswordResetToken(ctx, u.ID, auth.HashResetToken(resetToken)); err != nil {
 		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error:   "cache_error",
 			Message: "Failed to store reset token",
@@ -553,17 +561,12 @@ func (h *AuthHandler) ResetPassword(c echo.Context) error {
 		})
 	}
 
-	// Hash token to look up in Redis
-	tokenHash := sha256.Sum256([]byte(req.Token))
-	tokenKey := fmt.Sprintf("password_reset:%s", hex.EncodeToString(tokenHash[:]))
-
-	// Get user ID from Redis
-	userIDStr, err := h.cache.Get(ctx, tokenKey)
+	// Consume the token atomically, so it can only be used once even by
+	// concurrent requests
+	tokenHash := auth.HashResetToken(req.Token)
+	userIDStr, err := h.cache.Redis.GetDel(ctx, passwordResetKey(tokenHash)).Result()
 	if err != nil || userIDStr == "" {
-		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
-			Error:   "invalid_token",
-			Message: "Invalid or expired reset token",
-		})
+		return h.rejectPasswordResetToken(ctx, c, tokenHash)
 	}
 
 	// Convert user ID to int
@@ -596,8 +599,18 @@ func (h *AuthHandler) ResetPassword(c echo.Context) error {
 		})
 	}
 
-	// Delete token from Redis (one-time use)
-	h.cache.Delete(ctx, tokenKey)
+	// Remember the token was used, so retries get a specific error
+	if err := h.cache.Set(ctx, passwordResetStateKey(tokenHash), passwordResetUsed, h.passwordResetTTL()+passwordResetStateRetention); err != nil {
+		log.Printf("⚠️  Failed to mark password reset token used for user %d: %v", userID, err)
+	}
+	h.cache.Delete(ctx, passwordResetUserKey(userID))
+
+	// Whoever knew the old password may still be signed in
+	if h.sessions != nil {
+		if _, err := h.sessions.RevokeAll(ctx, userID, ""); err != nil {
+			log.Printf("⚠️  Failed to revoke sessions after password reset for user %d: %v", userID, err)
+		}
+	}
 
 	// Log password reset success
 	ipAddress, userAgent := audit.GetRequestContext(c)
@@ -608,6 +621,160 @@ func (h *AuthHandler) ResetPassword(c echo.Context) error {
 	})
 }
 
+// Password reset token lifetime used when the config leaves it unset
+const defaultPasswordResetTTL = time.Hour
+
+// passwordResetStateRetention is how long after expiring a reset token is
+// still recognized, to tell expired and used tokens from unknown ones
+const passwordResetStateRetention = 24 * time.Hour
+
+// Password reset token states
+const (
+	passwordResetIssued     = "issued"
+	passwordResetUsed       = "used"
+	passwordResetSuperseded = "superseded"
+)
+
+// passwordResetKey holds the user ID of a valid reset token
+func passwordResetKey(tokenHash string) string {
+	return "password_reset:" + tokenHash
+}
+
+// passwordResetStateKey holds the state of a reset token
+func passwordResetStateKey(tokenHash string) string {
+	return "password_reset_state:" + tokenHash
+}
+
+// passwordResetUserKey holds the hash of a user's latest reset token
+func passwordResetUserKey(userID int) string {
+	return fmt.Sprintf("password_reset_user:%d", userID)
+}
+
+// passwordResetTTL returns how long reset tokens are valid
+func (h *AuthHandler) passwordResetTTL() time.Duration {
+	if h.config != nil && h.config.PasswordResetTokenTTLMinutes > 0 {
+		return time.Duration(h.config.PasswordResetTokenTTLMinutes) * time.Minute
+	}
+	return defaultPasswordResetTTL
+}
+
+// storePasswordResetToken stores a new reset token for a user and
+// invalidates the user's previous one, so only the latest link works
+func (h *AuthHandler) storePasswordResetToken(ctx context.Context, userID int, tokenHash string) error {
+	ttl := h.passwordResetTTL()
+	stateTTL := ttl + passwordResetStateRetention
+
+	previous, err := h.cache.Get(ctx, passwordResetUserKey(userID))
+	if err == nil && previous != "" {
+		if err := h.cache.Delete(ctx, passwordResetKey(previous)); err != nil {
+			return err
+		}
+		if err := h.cache.Set(ctx, passwordResetStateKey(previous), passwordResetSuperseded, stateTTL); err != nil {
+			return err
+		}
+	}
+
+	if err := h.cache.Set(ctx, passwordResetKey(tokenHash), strconv.Itoa(userID), ttl); err != nil {
+		return err
+	}
+	if err := h.cache.Set(ctx, passwordResetStateKey(tokenHash), passwordResetIssued, stateTTL); err != nil {
+		return err
+	}
+	return h.cache.Set(ctx, passwordResetUserKey(userID), tokenHash, ttl)
+}
+
+// rejectPasswordResetToken responds to a reset token that isn't valid with
+// an error saying whether it was used, superseded or expired
+func (h *AuthHandler) rejectPasswordResetToken(ctx context.Context, c echo.Context, tokenHash string) error {
+	state, _ := h.cache.Get(ctx, passwordResetStateKey(tokenHash))
+
+	switch state {
+	case passwordResetUsed:
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "token_used",
+			Message: "This reset link has already been used",
+		})
+	case passwordResetSuperseded:
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "token_superseded",
+			Message: "A newer reset link was requested; use the link in the latest email",
+		})
+	case passwordResetIssued:
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "token_expired",
+			Message: "This reset link has expired; request a new one",
+		})
+	}
+
+	return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+		Error:   "invalid_token",
+		Message: "Invalid or expired reset token",
+	})
+}
+
+// Verification resend limits used when the config leaves them unset
+const (
+	defaultVerificationResendCooldown   = 60 * time.Second
+	defaultVerificationResendMaxPerHour = 5
+)
+
+// checkVerificationResendLimit enforces the per-user resend cooldown and
+// hourly cap in Redis. It returns how long the user must wait, or zero if
+// the resend is allowed. Redis errors fail open so an outage doesn't block
+// verification.
+func (h *AuthHandler) checkVerificationResendLimit(ctx context.Context, userID int) time.Duration {
+	if h.cache == nil {
+		return 0
+	}
+
+	cooldown := defaultVerificationResendCooldown
+	maxPerHour := defaultVerificationResendMaxPerHour
+	if h.config != nil {
+		if h.config.VerificationResendCooldownSeconds > 0 {
+			cooldown = time.Duration(h.config.VerificationResendCooldownSeconds) * time.Second
+		}
+		if h.config.VerificationResendMaxPerHour > 0 {
+			maxPerHour = h.config.VerificationResendMaxPerHour
+		}
+	}
+
+	cooldownKey := fmt.Sprintf("verification_resend:cooldown:%d", userID)
+	allowed, err := h.cache.Redis.SetNX(ctx, cooldownKey, "1", cooldown).Result()
+	if err != nil {
+		log.Printf("⚠️  Failed to check verification resend cooldown for user %d: %v", userID, err)
+		return 0
+	}
+	if !allowed {
+		return h.remainingTTL(ctx, cooldownKey, cooldown)
+	}
+
+	hourlyKey := fmt.Sprintf("verification_resend:hourly:%d", userID)
+	count, err := h.cache.Redis.Incr(ctx, hourlyKey).Result()
+	if err != nil {
+		log.Printf("⚠️  Failed to count verification resends for user %d: %v", userID, err)
+		return 0
+	}
+	if count == 1 {
+		if err := h.cache.Expire(ctx, hourlyKey, time.Hour); err != nil {
+			log.Printf("⚠️  Failed to set verification resend window for user %d: %v", userID, err)
+		}
+	}
+	if count > int64(maxPerHour) {
+		return h.remainingTTL(ctx, hourlyKey, time.Hour)
+	}
+
+	return 0
+}
+
+// remainingTTL returns a key's remaining lifetime, or fallback if unknown
+func (h *AuthHandler) remainingTTL(ctx context.Context, key string, fallback time.Duration) time.Duration {
+	ttl, err := h.cache.TTL(ctx, key)
+	if err != nil || ttl <= 0 {
+		return fallback
+	}
+	return ttl
+}
+
 // generateVerificationToken generates a random token for email verification
 func generateVerificationToken() (string, error) {
 	bytes := make([]byte, 32)
@@ -743,7 +910,7 @@ func (h *AuthHandler) OAuthCallback(c echo.Context) error {
 	}
 
 	// Generate JWT token
-	token, err := auth.GenerateJWT(user.ID, user.Email, user.SubscriptionTier.String(), h.config.JWTSecret, h.config.JWTExpirationHours)
+	token, err := h.issueToken(c, user.ID, user.Email, user.SubscriptionTier.String())
 	if err != nil {
 		return c.Redirect(http.StatusTemporaryRedirect, h.config.FrontendURL+"/login?error=token_generation_failed")
 	}
@@ -761,6 +928,13 @@ func (h *AuthHandler) OAuthCallback(c echo.Context) error {
 	return c.Redirect(http.StatusTemporaryRedirect, redirectURL)
 }
 
+// issueToken generates a JWT for the user signing in with this request and
+// tracks it as an active session (see auth.IssueToken)
+func (h *AuthHandler) issueToken(c echo.Context, userID int, email, tier string) (string, error) {
+	ipAddress, userAgent := audit.GetRequestContext(c)
+	return auth.IssueToken(c.Request().Context(), h.sessions, userID, email, tier, h.config.JWTSecret, h.config.JWTExpirationHours, userAgent, ipAddress)
+}
+
 // Helper method to get OAuth service
 func (h *AuthHandler) getOAuthService() *oauth.Service {
 	return oauth.NewService(h.db, h.config)
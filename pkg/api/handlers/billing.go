@@ -7,6 +7,7 @@ import (
 
 	"github.com/go-playground/validator/v10"
 	"github.com/jordanlanch/industrydb/pkg/api/errors"
+	"github.com/jordanlanch/industrydb/pkg/api/validation"
 	"github.com/jordanlanch/industrydb/pkg/billing"
 	"github.com/jordanlanch/industrydb/pkg/models"
 	"github.com/labstack/echo/v4"
@@ -22,7 +23,7 @@ type BillingHandler struct {
 func NewBillingHandler(billingService *billing.Service) *BillingHandler {
 	return &BillingHandler{
 		billingService: billingService,
-		validator:      validator.New(),
+		validator:      validation.Validator(),
 	}
 }
 
@@ -79,6 +80,7 @@ func validateReturnURL(returnURL string) string {
 // @Produce json
 // @Security BearerAuth
 // @Param request body models.CheckoutRequest true "Checkout configuration with subscription tier"
+// @Param Idempotency-Key header string false "Retries with the same key within the idempotency window (default 24 hours) return the first response instead of repeating the request"
 // @Success 200 {object} map[string]string "Checkout session created with URL"
 // @Failure 400 {object} models.ErrorResponse "Invalid request"
 // @Failure 401 {object} models.ErrorResponse "Unauthorized"
@@ -2,12 +2,15 @@ package handlers
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"strconv"
 	"time"
 
 	"github.com/jordanlanch/industrydb/ent"
 	"github.com/jordanlanch/industrydb/pkg/analytics"
+	"github.com/jordanlanch/industrydb/pkg/api/errors"
+	"github.com/jordanlanch/industrydb/pkg/database"
 	"github.com/jordanlanch/industrydb/pkg/models"
 	"github.com/labstack/echo/v4"
 )
@@ -15,15 +18,22 @@ import (
 // CohortHandler handles cohort analytics operations
 type CohortHandler struct {
 	service *analytics.Service
+	guard   *analytics.Guard
 }
 
 // NewCohortHandler creates a new cohort analytics handler
 func NewCohortHandler(db *ent.Client) *CohortHandler {
 	return &CohortHandler{
 		service: analytics.NewService(db),
+		guard:   analytics.NewGuard(nil, analytics.GuardConfig{}),
 	}
 }
 
+// SetGuard sets the range limit, timeout and cache of cohort reports
+func (h *CohortHandler) SetGuard(guard *analytics.Guard) {
+	h.guard = guard
+}
+
 // GetCohorts godoc
 // @Summary Get user cohorts
 // @Description Get list of user cohorts grouped by time period
@@ -32,14 +42,12 @@ func NewCohortHandler(db *ent.Client) *CohortHandler {
 // @Param period query string false "Time period (day, week, month)" default(week)
 // @Param count query int false "Number of periods to retrieve" default(12)
 // @Success 200 {array} analytics.Cohort
-// @Failure 400 {object} models.ErrorResponse
+// @Failure 400 {object} models.ErrorResponse "Invalid parameters or range too large"
 // @Failure 500 {object} models.ErrorResponse
+// @Failure 504 {object} models.ErrorResponse "Query timed out"
 // @Security BearerAuth
 // @Router /api/v1/analytics/cohorts [get]
 func (h *CohortHandler) GetCohorts(c echo.Context) error {
-	ctx, cancel := context.WithTimeout(c.Request().Context(), 10*time.Second)
-	defer cancel()
-
 	// Parse period parameter
 	period := c.QueryParam("period")
 	if period == "" {
@@ -66,9 +74,18 @@ func (h *CohortHandler) GetCohorts(c echo.Context) error {
 		}
 		count = parsedCount
 	}
+	if err := h.guard.CheckRange(count * analytics.PeriodDays(period)); err != nil {
+		return analyticsRangeTooLarge(c, err)
+	}
 
-	cohorts, err := h.service.GetCohorts(ctx, period, count)
+	key := fmt.Sprintf("cohorts:%s:%d", period, count)
+	cohorts, err := analytics.Guarded(c.Request().Context(), h.guard, key, func(ctx context.Context) ([]analytics.Cohort, error) {
+		return h.service.GetCohorts(ctx, period, count)
+	})
 	if err != nil {
+		if database.IsQueryTimeout(err) {
+			return errors.QueryTimeoutError(c, err)
+		}
 		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error:   "server_error",
 			Message: err.Error(),
@@ -87,14 +104,12 @@ func (h *CohortHandler) GetCohorts(c echo.Context) error {
 // @Param period query string false "Time period (day, week, month)" default(week)
 // @Param periods query int false "Number of periods to track" default(12)
 // @Success 200 {object} analytics.CohortRetention
-// @Failure 400 {object} models.ErrorResponse
+// @Failure 400 {object} models.ErrorResponse "Invalid parameters or range too large"
 // @Failure 500 {object} models.ErrorResponse
+// @Failure 504 {object} models.ErrorResponse "Query timed out"
 // @Security BearerAuth
 // @Router /api/v1/analytics/cohorts/retention [get]
 func (h *CohortHandler) GetCohortRetention(c echo.Context) error {
-	ctx, cancel := context.WithTimeout(c.Request().Context(), 10*time.Second)
-	defer cancel()
-
 	// Parse cohort_start parameter
 	cohortStartStr := c.QueryParam("cohort_start")
 	if cohortStartStr == "" {
@@ -138,9 +153,18 @@ func (h *CohortHandler) GetCohortRetention(c echo.Context) error {
 		}
 		periods = parsedPeriods
 	}
+	if err := h.guard.CheckRange(periods * analytics.PeriodDays(period)); err != nil {
+		return analyticsRangeTooLarge(c, err)
+	}
 
-	retention, err := h.service.GetCohortRetention(ctx, cohortStart, period, periods)
+	key := fmt.Sprintf("cohorts:retention:%d:%s:%d", cohortStart.Unix(), period, periods)
+	retention, err := analytics.Guarded(c.Request().Context(), h.guard, key, func(ctx context.Context) (*analytics.CohortRetention, error) {
+		return h.service.GetCohortRetention(ctx, cohortStart, period, periods)
+	})
 	if err != nil {
+		if database.IsQueryTimeout(err) {
+			return errors.QueryTimeoutError(c, err)
+		}
 		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error:   "server_error",
 			Message: err.Error(),
@@ -159,14 +183,12 @@ func (h *CohortHandler) GetCohortRetention(c echo.Context) error {
 // @Param cohort_count query int false "Number of cohorts to compare" default(6)
 // @Param retention_periods query int false "Number of retention periods" default(12)
 // @Success 200 {object} analytics.CohortComparison
-// @Failure 400 {object} models.ErrorResponse
+// @Failure 400 {object} models.ErrorResponse "Invalid parameters or range too large"
 // @Failure 500 {object} models.ErrorResponse
+// @Failure 504 {object} models.ErrorResponse "Query timed out"
 // @Security BearerAuth
 // @Router /api/v1/analytics/cohorts/comparison [get]
 func (h *CohortHandler) GetCohortComparison(c echo.Context) error {
-	ctx, cancel := context.WithTimeout(c.Request().Context(), 15*time.Second)
-	defer cancel()
-
 	// Parse period parameter
 	period := c.QueryParam("period")
 	if period == "" {
@@ -207,9 +229,19 @@ func (h *CohortHandler) GetCohortComparison(c echo.Context) error {
 		}
 		retentionPeriods = parsedPeriods
 	}
+	// The oldest cohort is followed for the retention periods
+	if err := h.guard.CheckRange((cohortCount + retentionPeriods) * analytics.PeriodDays(period)); err != nil {
+		return analyticsRangeTooLarge(c, err)
+	}
 
-	comparison, err := h.service.GetCohortComparison(ctx, period, cohortCount, retentionPeriods)
+	key := fmt.Sprintf("cohorts:comparison:%s:%d:%d", period, cohortCount, retentionPeriods)
+	comparison, err := analytics.Guarded(c.Request().Context(), h.guard, key, func(ctx context.Context) (*analytics.CohortComparison, error) {
+		return h.service.GetCohortComparison(ctx, period, cohortCount, retentionPeriods)
+	})
 	if err != nil {
+		if database.IsQueryTimeout(err) {
+			return errors.QueryTimeoutError(c, err)
+		}
 		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error:   "server_error",
 			Message: err.Error(),
@@ -227,14 +259,12 @@ func (h *CohortHandler) GetCohortComparison(c echo.Context) error {
 // @Param cohort_start query string true "Cohort start date (RFC3339 format)"
 // @Param weeks query int false "Number of weeks to track" default(4)
 // @Success 200 {object} analytics.CohortActivityMetrics
-// @Failure 400 {object} models.ErrorResponse
+// @Failure 400 {object} models.ErrorResponse "Invalid parameters or range too large"
 // @Failure 500 {object} models.ErrorResponse
+// @Failure 504 {object} models.ErrorResponse "Query timed out"
 // @Security BearerAuth
 // @Router /api/v1/analytics/cohorts/activity [get]
 func (h *CohortHandler) GetCohortActivityMetrics(c echo.Context) error {
-	ctx, cancel := context.WithTimeout(c.Request().Context(), 10*time.Second)
-	defer cancel()
-
 	// Parse cohort_start parameter
 	cohortStartStr := c.QueryParam("cohort_start")
 	if cohortStartStr == "" {
@@ -265,9 +295,18 @@ func (h *CohortHandler) GetCohortActivityMetrics(c echo.Context) error {
 		}
 		weeks = parsedWeeks
 	}
+	if err := h.guard.CheckRange(weeks * analytics.PeriodDays("week")); err != nil {
+		return analyticsRangeTooLarge(c, err)
+	}
 
-	metrics, err := h.service.GetCohortActivityMetrics(ctx, cohortStart, weeks)
+	key := fmt.Sprintf("cohorts:activity:%d:%d", cohortStart.Unix(), weeks)
+	metrics, err := analytics.Guarded(c.Request().Context(), h.guard, key, func(ctx context.Context) (*analytics.CohortActivityMetrics, error) {
+		return h.service.GetCohortActivityMetrics(ctx, cohortStart, weeks)
+	})
 	if err != nil {
+		if database.IsQueryTimeout(err) {
+			return errors.QueryTimeoutError(c, err)
+		}
 		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error:   "server_error",
 			Message: err.Error(),
@@ -149,6 +149,25 @@ func TestGetCohorts_CountBoundary(t *testing.T) {
 	}
 }
 
+func TestGetCohorts_RangeTooLarge(t *testing.T) {
+	handler, cleanup := setupCohortHandler(t)
+	defer cleanup()
+
+	// 25 months exceed the default two-year maximum
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/analytics/cohorts?period=month&count=25", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := handler.GetCohorts(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var errResp models.ErrorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &errResp))
+	assert.Equal(t, "range_too_large", errResp.Error)
+}
+
 // --- GetCohortRetention ---
 
 func TestGetCohortRetention_ServiceError_EmptyDB(t *testing.T) {
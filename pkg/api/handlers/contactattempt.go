@@ -0,0 +1,196 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/pkg/api/validation"
+	"github.com/jordanlanch/industrydb/pkg/contactattempt"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/labstack/echo/v4"
+)
+
+// ContactAttemptHandler handles lead contact attempt HTTP requests.
+type ContactAttemptHandler struct {
+	service   *contactattempt.Service
+	validator *validator.Validate
+}
+
+// NewContactAttemptHandler creates a new contact attempt handler.
+func NewContactAttemptHandler(client *ent.Client) *ContactAttemptHandler {
+	return &ContactAttemptHandler{
+		service:   contactattempt.NewService(client),
+		validator: validation.Validator(),
+	}
+}
+
+// CreateAttempt godoc
+// @Summary Log a contact attempt
+// @Description Log a structured outreach attempt (call, email or SMS) and its outcome on a lead. Outcomes: no_answer, left_voicemail, busy, wrong_number, bounced, opted_out, replied, connected
+// @Tags Contact Attempts
+// @Accept json
+// @Produce json
+// @Param id path int true "Lead ID"
+// @Param request body contactattempt.CreateAttemptRequest true "Attempt details"
+// @Success 201 {object} contactattempt.AttemptResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/leads/{id}/contact-attempts [post]
+func (h *ContactAttemptHandler) CreateAttempt(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 10*time.Second)
+	defer cancel()
+
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "User not authenticated",
+		})
+	}
+
+	leadID, err := strconv.Atoi(c.Param("id"))
+	if err != nil || leadID <= 0 {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid lead ID",
+		})
+	}
+
+	var req contactattempt.CreateAttemptRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "Invalid request body",
+		})
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "type must be one of: call, email, sms; outcome must be one of: no_answer, left_voicemail, busy, wrong_number, bounced, opted_out, replied, connected",
+		})
+	}
+
+	attempt, err := h.service.CreateAttempt(ctx, userID, leadID, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, contactattempt.ErrLeadNotFound):
+			return c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_found",
+				Message: "Lead not found",
+			})
+		case errors.Is(err, contactattempt.ErrFutureAttempt):
+			return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "validation_error",
+				Message: err.Error(),
+			})
+		}
+		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "server_error",
+			Message: "Failed to log contact attempt",
+		})
+	}
+
+	return c.JSON(http.StatusCreated, attempt)
+}
+
+// ListAttempts godoc
+// @Summary List contact attempts on a lead
+// @Description List the caller's contact attempts on a lead, newest first
+// @Tags Contact Attempts
+// @Produce json
+// @Param id path int true "Lead ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/leads/{id}/contact-attempts [get]
+func (h *ContactAttemptHandler) ListAttempts(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 10*time.Second)
+	defer cancel()
+
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "User not authenticated",
+		})
+	}
+
+	leadID, err := strconv.Atoi(c.Param("id"))
+	if err != nil || leadID <= 0 {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid lead ID",
+		})
+	}
+
+	attempts, err := h.service.ListAttemptsByLead(ctx, userID, leadID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "server_error",
+			Message: "Failed to list contact attempts",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"attempts": attempts,
+		"count":    len(attempts),
+	})
+}
+
+// GetStats godoc
+// @Summary Get contact stats
+// @Description Get the caller's outreach stats: attempts, connects and connect rates by channel and outcome
+// @Tags Contact Attempts
+// @Produce json
+// @Param days query int false "Number of days to analyze (default: 30, max: 365)"
+// @Success 200 {object} contactattempt.Stats
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/leads/contact-stats [get]
+func (h *ContactAttemptHandler) GetStats(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 10*time.Second)
+	defer cancel()
+
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "User not authenticated",
+		})
+	}
+
+	days := 30
+	if daysStr := c.QueryParam("days"); daysStr != "" {
+		parsedDays, err := strconv.Atoi(daysStr)
+		if err != nil || parsedDays < 1 || parsedDays > 365 {
+			return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "invalid_days",
+				Message: "days must be between 1 and 365",
+			})
+		}
+		days = parsedDays
+	}
+
+	stats, err := h.service.GetStats(ctx, userID, days)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "server_error",
+			Message: "Failed to load contact stats",
+		})
+	}
+
+	return c.JSON(http.StatusOK, stats)
+}
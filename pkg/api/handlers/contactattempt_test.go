@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/pkg/contactattempt"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/labstack/echo/v4"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupContactAttemptTest(t *testing.T) (*ent.Client, *ContactAttemptHandler, *ent.User, *ent.Lead) {
+	client := enttest.Open(t, "sqlite3", "file:contactattempt_test?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	user := createLeadNoteTestUser(t, client, "rep@example.com", "Rep")
+	lead := createLeadNoteTestLead(t, client)
+	return client, NewContactAttemptHandler(client), user, lead
+}
+
+func newContactAttemptContext(method, body string, userID, leadID int) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(method, "/", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", userID)
+	c.SetParamNames("id")
+	c.SetParamValues(strconv.Itoa(leadID))
+	return c, rec
+}
+
+func TestContactAttemptHandler_CreateAndList(t *testing.T) {
+	_, handler, user, lead := setupContactAttemptTest(t)
+
+	c, rec := newContactAttemptContext(http.MethodPost, `{"type":"call","outcome":"connected"}`, user.ID, lead.ID)
+	require.NoError(t, handler.CreateAttempt(c))
+	assert.Equal(t, http.StatusCreated, rec.Code)
+
+	var created contactattempt.AttemptResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+	assert.Equal(t, "connected", created.Outcome)
+	assert.True(t, created.Connected)
+
+	c, rec = newContactAttemptContext(http.MethodGet, "", user.ID, lead.ID)
+	require.NoError(t, handler.ListAttempts(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var list struct {
+		Attempts []contactattempt.AttemptResponse `json:"attempts"`
+		Count    int                              `json:"count"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &list))
+	assert.Equal(t, 1, list.Count)
+	assert.Equal(t, created.ID, list.Attempts[0].ID)
+}
+
+func TestContactAttemptHandler_CreateValidation(t *testing.T) {
+	_, handler, user, lead := setupContactAttemptTest(t)
+
+	tests := []struct {
+		name   string
+		body   string
+		leadID int
+		status int
+		error  string
+	}{
+		{"invalid outcome", `{"type":"call","outcome":"maybe"}`, lead.ID, http.StatusBadRequest, "validation_error"},
+		{"invalid type", `{"type":"fax","outcome":"connected"}`, lead.ID, http.StatusBadRequest, "validation_error"},
+		{"missing outcome", `{"type":"call"}`, lead.ID, http.StatusBadRequest, "validation_error"},
+		{"future attempt", `{"type":"call","outcome":"connected","attempted_at":"2999-01-01T00:00:00Z"}`, lead.ID, http.StatusBadRequest, "validation_error"},
+		{"lead not found", `{"type":"call","outcome":"connected"}`, 99999, http.StatusNotFound, "not_found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, rec := newContactAttemptContext(http.MethodPost, tt.body, user.ID, tt.leadID)
+			require.NoError(t, handler.CreateAttempt(c))
+			assert.Equal(t, tt.status, rec.Code)
+
+			var resp models.ErrorResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, tt.error, resp.Error)
+		})
+	}
+}
+
+func TestContactAttemptHandler_GetStats(t *testing.T) {
+	_, handler, user, lead := setupContactAttemptTest(t)
+
+	for _, body := range []string{
+		`{"type":"call","outcome":"no_answer"}`,
+		`{"type":"call","outcome":"connected"}`,
+	} {
+		c, rec := newContactAttemptContext(http.MethodPost, body, user.ID, lead.ID)
+		require.NoError(t, handler.CreateAttempt(c))
+		require.Equal(t, http.StatusCreated, rec.Code)
+	}
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/?days=7", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", user.ID)
+	require.NoError(t, handler.GetStats(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var stats contactattempt.Stats
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &stats))
+	assert.Equal(t, 2, stats.TotalAttempts)
+	assert.Equal(t, 50.0, stats.ConnectRate)
+	assert.Equal(t, 7, stats.PeriodDays)
+
+	req = httptest.NewRequest(http.MethodGet, "/?days=0", nil)
+	rec = httptest.NewRecorder()
+	c = e.NewContext(req, rec)
+	c.Set("user_id", user.ID)
+	require.NoError(t, handler.GetStats(c))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
@@ -0,0 +1,228 @@
+package handlers
+
+import (
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/jordanlanch/industrydb/ent/crmintegration"
+	"github.com/jordanlanch/industrydb/pkg/api/errors"
+	"github.com/jordanlanch/industrydb/pkg/crm"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/labstack/echo/v4"
+)
+
+// CRMHandler handles CRM credentials and lead push jobs
+type CRMHandler struct {
+	service *crm.Service
+}
+
+// NewCRMHandler creates a new CRM handler
+func NewCRMHandler(service *crm.Service) *CRMHandler {
+	return &CRMHandler{service: service}
+}
+
+// CRMTokenRequest carries a CRM API token
+type CRMTokenRequest struct {
+	APIToken string `json:"api_token" validate:"required"`
+}
+
+// CRMPushRequest selects the leads to push: explicit lead IDs, or a search
+// filter when lead_ids is empty
+type CRMPushRequest struct {
+	LeadIDs []int                     `json:"lead_ids,omitempty"`
+	Filters *models.LeadSearchRequest `json:"filters,omitempty"`
+}
+
+// SaveHubSpotToken godoc
+// @Summary Connect HubSpot
+// @Description Validate a HubSpot private app token (scopes crm.objects.companies.read/write and crm.objects.contacts.read/write) and store it encrypted. Replaces any token saved before.
+// @Tags Integrations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body CRMTokenRequest true "HubSpot token"
+// @Success 200 {object} ent.CRMIntegration
+// @Failure 400 {object} models.ErrorResponse "Missing or rejected token"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 502 {object} models.ErrorResponse "HubSpot unavailable"
+// @Router /integrations/hubspot [put]
+func (h *CRMHandler) SaveHubSpotToken(c echo.Context) error {
+	userID := c.Get("user_id").(int)
+
+	var req CRMTokenRequest
+	if err := c.Bind(&req); err != nil || req.APIToken == "" {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "api_token is required",
+		})
+	}
+
+	integration, err := h.service.SaveToken(c.Request().Context(), userID, crmintegration.ProviderHubspot, req.APIToken)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, integration)
+}
+
+// GetHubSpot godoc
+// @Summary Get the HubSpot connection
+// @Description Returns the HubSpot integration with its last sync status
+// @Tags Integrations
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} ent.CRMIntegration
+// @Failure 404 {object} models.ErrorResponse "HubSpot not connected"
+// @Router /integrations/hubspot [get]
+func (h *CRMHandler) GetHubSpot(c echo.Context) error {
+	userID := c.Get("user_id").(int)
+
+	integration, err := h.service.GetIntegration(c.Request().Context(), userID, crmintegration.ProviderHubspot)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, integration)
+}
+
+// DeleteHubSpot godoc
+// @Summary Disconnect HubSpot
+// @Description Delete the stored HubSpot token, sync records and push jobs. Records already pushed to HubSpot are kept there.
+// @Tags Integrations
+// @Security BearerAuth
+// @Success 204 "Disconnected"
+// @Failure 404 {object} models.ErrorResponse "HubSpot not connected"
+// @Router /integrations/hubspot [delete]
+func (h *CRMHandler) DeleteHubSpot(c echo.Context) error {
+	userID := c.Get("user_id").(int)
+
+	if err := h.service.DeleteIntegration(c.Request().Context(), userID, crmintegration.ProviderHubspot); err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// PushToHubSpot godoc
+// @Summary Push leads to HubSpot
+// @Description Push leads to HubSpot as companies, plus a contact for leads with an email, as a background job. Companies are deduped on website domain and contacts on email, so pushing a lead again updates it. Select leads with lead_ids or, when empty, with filters. Poll GET /integrations/hubspot/jobs/{id} for per-lead results.
+// @Tags Integrations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body CRMPushRequest true "Leads to push"
+// @Success 202 {object} ent.CRMPushJob
+// @Failure 400 {object} models.ErrorResponse "No leads selected, too many leads or HubSpot not connected"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /integrations/hubspot/push [post]
+func (h *CRMHandler) PushToHubSpot(c echo.Context) error {
+	userID := c.Get("user_id").(int)
+
+	var req CRMPushRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "Invalid request body",
+		})
+	}
+
+	job, err := h.service.StartPush(c.Request().Context(), userID, crmintegration.ProviderHubspot, req.LeadIDs, req.Filters)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusAccepted, job)
+}
+
+// ListHubSpotJobs godoc
+// @Summary List HubSpot push jobs
+// @Description List the 50 most recent push jobs
+// @Tags Integrations
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} map[string]interface{}
+// @Failure 500 {object} models.ErrorResponse
+// @Router /integrations/hubspot/jobs [get]
+func (h *CRMHandler) ListHubSpotJobs(c echo.Context) error {
+	userID := c.Get("user_id").(int)
+
+	jobs, err := h.service.ListJobs(c.Request().Context(), userID, 50)
+	if err != nil {
+		return errors.InternalError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"data": jobs,
+	})
+}
+
+// GetHubSpotJob godoc
+// @Summary Get a HubSpot push job
+// @Description Get a push job's status, counts and per-lead results (lead_id, action, company_id, contact_id or error)
+// @Tags Integrations
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Push job ID"
+// @Success 200 {object} ent.CRMPushJob
+// @Failure 400 {object} models.ErrorResponse "Invalid ID"
+// @Failure 404 {object} models.ErrorResponse "Push job not found"
+// @Router /integrations/hubspot/jobs/{id} [get]
+func (h *CRMHandler) GetHubSpotJob(c echo.Context) error {
+	userID := c.Get("user_id").(int)
+
+	jobID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Push job ID must be a number",
+		})
+	}
+
+	job, err := h.service.GetJob(c.Request().Context(), userID, jobID)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, job)
+}
+
+// handleError maps CRM service errors to responses
+func (h *CRMHandler) handleError(c echo.Context, err error) error {
+	switch {
+	case stderrors.Is(err, crm.ErrInvalidToken):
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_token",
+			Message: "HubSpot rejected the API token",
+		})
+	case stderrors.Is(err, crm.ErrNotConnected):
+		return c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "crm_not_connected",
+			Message: "Connect HubSpot with PUT /integrations/hubspot first",
+		})
+	case stderrors.Is(err, crm.ErrNoLeads):
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "no_leads",
+			Message: "Select leads with lead_ids or filters",
+		})
+	case stderrors.Is(err, crm.ErrTooManyLeads):
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "too_many_leads",
+			Message: fmt.Sprintf("A push may include at most %d leads", h.service.MaxLeads()),
+		})
+	case stderrors.Is(err, crm.ErrJobNotFound):
+		return c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "not_found",
+			Message: "Push job not found",
+		})
+	case stderrors.Is(err, crm.ErrCRMAPIError):
+		return c.JSON(http.StatusBadGateway, models.ErrorResponse{
+			Error:   "crm_unavailable",
+			Message: "HubSpot returned an unexpected response",
+		})
+	default:
+		return errors.InternalError(c, err)
+	}
+}
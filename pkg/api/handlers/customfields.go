@@ -70,7 +70,7 @@ func (h *CustomFieldsHandler) GetCustomFields(c echo.Context) error {
 
 // SetCustomField godoc
 // @Summary Set a single custom field
-// @Description Set or update a single custom field for a lead
+// @Description Set or update a single custom field for a lead. Keys may only contain letters, digits, '_', '-' and '.' and are limited in length, values in JSON-encoded size, and leads in their number of keys (see CUSTOM_FIELDS_* settings); violations return 400.
 // @Tags Custom Fields
 // @Accept json
 // @Produce json
@@ -114,7 +114,8 @@ func (h *CustomFieldsHandler) SetCustomField(c echo.Context) error {
 	}
 
 	// Set custom field
-	result, err := h.service.SetCustomField(ctx, leadID, req.Key, req.Value)
+	userID, _ := c.Get("user_id").(int)
+	result, err := h.service.SetCustomField(ctx, userID, leadID, req.Key, req.Value)
 	if err != nil {
 		if err.Error() == "lead not found" {
 			return c.JSON(http.StatusNotFound, models.ErrorResponse{
@@ -122,7 +123,7 @@ func (h *CustomFieldsHandler) SetCustomField(c echo.Context) error {
 				Message: "Lead not found",
 			})
 		}
-		if err.Error() == "key cannot be empty" || err.Error() == "key too long (max 50 characters)" {
+		if customfields.IsValidationError(err) {
 			return c.JSON(http.StatusBadRequest, models.ErrorResponse{
 				Error:   "validation_error",
 				Message: err.Error(),
@@ -174,7 +175,8 @@ func (h *CustomFieldsHandler) RemoveCustomField(c echo.Context) error {
 	}
 
 	// Remove custom field
-	result, err := h.service.RemoveCustomField(ctx, leadID, key)
+	userID, _ := c.Get("user_id").(int)
+	result, err := h.service.RemoveCustomField(ctx, userID, leadID, key)
 	if err != nil {
 		if err.Error() == "lead not found" {
 			return c.JSON(http.StatusNotFound, models.ErrorResponse{
@@ -193,7 +195,7 @@ func (h *CustomFieldsHandler) RemoveCustomField(c echo.Context) error {
 
 // UpdateCustomFields godoc
 // @Summary Update all custom fields (bulk)
-// @Description Replace all custom fields for a lead with new values
+// @Description Replace all custom fields for a lead with new values. The same key, value size and field count limits as setting a single field apply.
 // @Tags Custom Fields
 // @Accept json
 // @Produce json
@@ -229,7 +231,8 @@ func (h *CustomFieldsHandler) UpdateCustomFields(c echo.Context) error {
 	}
 
 	// Update custom fields
-	result, err := h.service.UpdateCustomFields(ctx, leadID, req.CustomFields)
+	userID, _ := c.Get("user_id").(int)
+	result, err := h.service.UpdateCustomFields(ctx, userID, leadID, req.CustomFields)
 	if err != nil {
 		if err.Error() == "lead not found" {
 			return c.JSON(http.StatusNotFound, models.ErrorResponse{
@@ -237,9 +240,7 @@ func (h *CustomFieldsHandler) UpdateCustomFields(c echo.Context) error {
 				Message: "Lead not found",
 			})
 		}
-		if err.Error() == "custom field key cannot be empty" ||
-		   err.Error() == "key too long (max 50 characters)" ||
-		   (len(err.Error()) > 0 && err.Error()[0:3] == "cus") { // Starts with "custom field key"
+		if customfields.IsValidationError(err) {
 			return c.JSON(http.StatusBadRequest, models.ErrorResponse{
 				Error:   "validation_error",
 				Message: err.Error(),
@@ -281,7 +282,8 @@ func (h *CustomFieldsHandler) ClearCustomFields(c echo.Context) error {
 	}
 
 	// Clear custom fields
-	result, err := h.service.ClearCustomFields(ctx, leadID)
+	userID, _ := c.Get("user_id").(int)
+	result, err := h.service.ClearCustomFields(ctx, userID, leadID)
 	if err != nil {
 		if err.Error() == "lead not found" {
 			return c.JSON(http.StatusNotFound, models.ErrorResponse{
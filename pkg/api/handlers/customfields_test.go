@@ -230,7 +230,7 @@ func TestCustomFieldsHandler_RemoveCustomField_Success(t *testing.T) {
 
 	// Set a field first
 	svc := customfields.NewService(client)
-	_, err := svc.SetCustomField(t.Context(), lead.ID, "style", "Japanese")
+	_, err := svc.SetCustomField(t.Context(), 0, lead.ID, "style", "Japanese")
 	require.NoError(t, err)
 
 	handler := NewCustomFieldsHandler(client)
@@ -316,6 +316,44 @@ func TestCustomFieldsHandler_UpdateCustomFields_Success(t *testing.T) {
 	assert.Equal(t, true, resp.CustomFields["accepts_walk_ins"])
 }
 
+func TestCustomFieldsHandler_UpdateCustomFields_Limits(t *testing.T) {
+	client := setupCustomFieldsTestDB(t)
+	defer client.Close()
+
+	customfields.SetLimits(customfields.Limits{MaxFields: 2})
+	defer customfields.SetLimits(customfields.DefaultLimits)
+
+	lead := createCustomFieldsTestLead(t, client, "Studio Limits")
+	handler := NewCustomFieldsHandler(client)
+
+	tests := []struct {
+		name            string
+		body            string
+		expectedMessage string
+	}{
+		{"Too many fields", `{"custom_fields":{"a":1,"b":2,"c":3}}`, "at most 2 custom fields"},
+		{"Invalid key characters", `{"custom_fields":{"owner name":"Jo"}}`, "may only contain"},
+		{"Value too large", `{"custom_fields":{"notes":"` + strings.Repeat("x", 3000) + `"}}`, "too large"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/leads/"+strconv.Itoa(lead.ID)+"/custom-fields", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(strconv.Itoa(lead.ID))
+
+			require.NoError(t, handler.UpdateCustomFields(c))
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			assert.Contains(t, rec.Body.String(), "validation_error")
+			assert.Contains(t, rec.Body.String(), tt.expectedMessage)
+		})
+	}
+}
+
 func TestCustomFieldsHandler_UpdateCustomFields_LeadNotFound(t *testing.T) {
 	client := setupCustomFieldsTestDB(t)
 	defer client.Close()
@@ -366,9 +404,9 @@ func TestCustomFieldsHandler_ClearCustomFields_Success(t *testing.T) {
 
 	// Set some fields first
 	svc := customfields.NewService(client)
-	_, err := svc.SetCustomField(t.Context(), lead.ID, "style", "Japanese")
+	_, err := svc.SetCustomField(t.Context(), 0, lead.ID, "style", "Japanese")
 	require.NoError(t, err)
-	_, err = svc.SetCustomField(t.Context(), lead.ID, "rating", 5)
+	_, err = svc.SetCustomField(t.Context(), 0, lead.ID, "rating", 5)
 	require.NoError(t, err)
 
 	handler := NewCustomFieldsHandler(client)
@@ -0,0 +1,134 @@
+package handlers
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/jordanlanch/industrydb/ent/emailsend"
+	"github.com/jordanlanch/industrydb/pkg/email"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/labstack/echo/v4"
+)
+
+// EmailDeliveryHandler handles email send tracking and SendGrid events
+type EmailDeliveryHandler struct {
+	emailService     *email.Service
+	webhookPublicKey string
+}
+
+// NewEmailDeliveryHandler creates a new email delivery handler. webhookPublicKey
+// is the SendGrid event webhook verification key; events are rejected when it
+// is empty.
+func NewEmailDeliveryHandler(emailService *email.Service, webhookPublicKey string) *EmailDeliveryHandler {
+	return &EmailDeliveryHandler{
+		emailService:     emailService,
+		webhookPublicKey: webhookPublicKey,
+	}
+}
+
+// ListFailures godoc
+// @Summary List failed email sends
+// @Description List recent transactional emails that were not delivered: retrying, failed, bounced or suppressed (admin only)
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Filter by status (retrying, failed, bounced, suppressed)"
+// @Param limit query int false "Maximum results (default 50, max 200)"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /admin/email/failures [get]
+func (h *EmailDeliveryHandler) ListFailures(c echo.Context) error {
+	status := c.QueryParam("status")
+	if status != "" {
+		if err := emailsend.StatusValidator(emailsend.Status(status)); err != nil || status == string(emailsend.StatusSent) {
+			return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "invalid_status",
+				Message: "Status must be one of: pending, retrying, failed, bounced, suppressed",
+			})
+		}
+	}
+
+	limit := 50
+	if raw := c.QueryParam("limit"); raw != "" {
+		if n, err := strconv.Atoi(raw); err == nil && n > 0 {
+			limit = min(n, 200)
+		}
+	}
+
+	failures, err := h.emailService.ListFailures(c.Request().Context(), status, limit)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "server_error",
+			Message: "Failed to list email failures",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"failures": failures,
+		"count":    len(failures),
+	})
+}
+
+// HandleSendGridEvents godoc
+// @Summary SendGrid event webhook
+// @Description Receive SendGrid delivery events. Hard bounces mark the address undeliverable. Requests must be signed (SENDGRID_WEBHOOK_PUBLIC_KEY).
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Success 200 {object} models.SuccessResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 503 {object} models.ErrorResponse
+// @Router /webhook/sendgrid [post]
+func (h *EmailDeliveryHandler) HandleSendGridEvents(c echo.Context) error {
+	if h.webhookPublicKey == "" {
+		return c.JSON(http.StatusServiceUnavailable, models.ErrorResponse{
+			Error:   "not_configured",
+			Message: "SendGrid event webhook is not configured",
+		})
+	}
+
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_body",
+			Message: "Failed to read request body",
+		})
+	}
+
+	err = email.VerifyEventSignature(
+		h.webhookPublicKey,
+		c.Request().Header.Get("X-Twilio-Email-Event-Webhook-Signature"),
+		c.Request().Header.Get("X-Twilio-Email-Event-Webhook-Timestamp"),
+		body,
+	)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error:   "invalid_signature",
+			Message: "Invalid event webhook signature",
+		})
+	}
+
+	var events []email.Event
+	if err := json.Unmarshal(body, &events); err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_body",
+			Message: "Expected a JSON array of events",
+		})
+	}
+
+	if _, err := h.emailService.HandleEvents(c.Request().Context(), events); err != nil {
+		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "server_error",
+			Message: "Failed to process events",
+		})
+	}
+
+	return c.JSON(http.StatusOK, models.SuccessResponse{
+		Success: true,
+		Message: "Events processed",
+	})
+}
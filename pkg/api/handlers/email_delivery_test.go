@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jordanlanch/industrydb/ent/emailsend"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/pkg/email"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestEmailDeliveryHandler_ListFailures(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+
+	for _, status := range []emailsend.Status{emailsend.StatusSent, emailsend.StatusFailed, emailsend.StatusRetrying} {
+		_, err := client.EmailSend.Create().
+			SetToEmail(string(status) + "@example.com").
+			SetSubject("Verify your IndustryDB account").
+			SetHTMLBody("<p>secret link</p>").
+			SetStatus(status).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	emailService := email.NewService("from@example.com", "IndustryDB", "https://app.industrydb.io", "")
+	emailService.SetDB(client)
+	handler := NewEmailDeliveryHandler(emailService, "")
+	e := echo.New()
+
+	t.Run("all undelivered", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/admin/email/failures", nil)
+		rec := httptest.NewRecorder()
+
+		require.NoError(t, handler.ListFailures(e.NewContext(req, rec)))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.NotContains(t, rec.Body.String(), "secret link", "bodies are never exposed")
+
+		var resp struct {
+			Failures []map[string]interface{} `json:"failures"`
+			Count    int                      `json:"count"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, 2, resp.Count)
+	})
+
+	t.Run("filtered by status", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/admin/email/failures?status=failed", nil)
+		rec := httptest.NewRecorder()
+
+		require.NoError(t, handler.ListFailures(e.NewContext(req, rec)))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), "failed@example.com")
+		assert.NotContains(t, rec.Body.String(), "retrying@example.com")
+	})
+
+	t.Run("invalid status", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/admin/email/failures?status=sent", nil)
+		rec := httptest.NewRecorder()
+
+		require.NoError(t, handler.ListFailures(e.NewContext(req, rec)))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestEmailDeliveryHandler_HandleSendGridEvents(t *testing.T) {
+	emailService := email.NewService("from@example.com", "IndustryDB", "https://app.industrydb.io", "")
+	e := echo.New()
+	body := `[{"email":"a@example.com","event":"bounce","type":"bounce"}]`
+
+	t.Run("not configured", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/webhook/sendgrid", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+
+		require.NoError(t, NewEmailDeliveryHandler(emailService, "").HandleSendGridEvents(e.NewContext(req, rec)))
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	})
+
+	t.Run("unsigned request", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/webhook/sendgrid", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+
+		handler := NewEmailDeliveryHandler(emailService, "MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAE83T4O/n84iotIvIW4mdBgQ/7dAfSmpqIM8kF9mN1flpVKS3GRqe62gw+2fNNRaINXvVpiglSI8eNEc6wEA3F+g==")
+		require.NoError(t, handler.HandleSendGridEvents(e.NewContext(req, rec)))
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+}
@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/jordanlanch/industrydb/pkg/email"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/labstack/echo/v4"
+)
+
+// EmailTemplateHandler handles email template previews
+type EmailTemplateHandler struct {
+	emailService *email.Service
+}
+
+// NewEmailTemplateHandler creates a new email template handler
+func NewEmailTemplateHandler(emailService *email.Service) *EmailTemplateHandler {
+	return &EmailTemplateHandler{
+		emailService: emailService,
+	}
+}
+
+// sampleTemplateData fills preview variables the request left empty
+func sampleTemplateData(data email.TemplateData) email.TemplateData {
+	if data.Name == "" {
+		data.Name = "Jane Doe"
+	}
+	if data.Link == "" {
+		data.Link = "https://app.industrydb.io/example"
+	}
+	if data.Organization == "" {
+		data.Organization = "Acme Corp"
+	}
+	if data.Inviter == "" {
+		data.Inviter = "John Smith"
+	}
+	if len(data.Items) == 0 {
+		data.Items = []string{"42 new leads in your saved searches", "3 exports ready to download"}
+	}
+	return data
+}
+
+// ListTemplates godoc
+// @Summary List email templates
+// @Description List the named email templates that can be customized (admin only)
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} map[string]interface{}
+// @Router /admin/email-templates [get]
+func (h *EmailTemplateHandler) ListTemplates(c echo.Context) error {
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"templates": email.TemplateNames,
+	})
+}
+
+// Preview godoc
+// @Summary Preview email template
+// @Description Render an email template with sample data, or with the variables in the request body (admin only). Nothing is sent.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param name path string true "Template name (verification, reset, welcome, invite, digest)"
+// @Param request body email.TemplateData false "Template variables"
+// @Success 200 {object} email.RenderedEmail
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /admin/email-templates/{name}/preview [post]
+func (h *EmailTemplateHandler) Preview(c echo.Context) error {
+	var data email.TemplateData
+	if c.Request().ContentLength > 0 {
+		if err := c.Bind(&data); err != nil {
+			return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "invalid_request",
+				Message: "Invalid request body",
+			})
+		}
+	}
+
+	rendered, err := h.emailService.RenderTemplate(email.TemplateName(c.Param("name")), sampleTemplateData(data))
+	if err != nil {
+		if errors.Is(err, email.ErrUnknownTemplate) {
+			return c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_found",
+				Message: "Email template not found",
+			})
+		}
+		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "server_error",
+			Message: "Failed to render email template",
+		})
+	}
+
+	return c.JSON(http.StatusOK, rendered)
+}
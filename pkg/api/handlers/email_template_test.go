@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jordanlanch/industrydb/pkg/email"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newEmailTemplateTestContext(e *echo.Echo, name, body string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodPost, "/admin/email-templates/"+name+"/preview", strings.NewReader(body))
+	if body != "" {
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	}
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("name")
+	c.SetParamValues(name)
+	return c, rec
+}
+
+func TestEmailTemplateHandler_Preview(t *testing.T) {
+	handler := NewEmailTemplateHandler(email.NewService("from@example.com", "IndustryDB", "https://app.industrydb.io", ""))
+	e := echo.New()
+
+	t.Run("sample data", func(t *testing.T) {
+		c, rec := newEmailTemplateTestContext(e, "invite", "")
+
+		require.NoError(t, handler.Preview(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var rendered email.RenderedEmail
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &rendered))
+		assert.Equal(t, "You've been invited to join Acme Corp on IndustryDB", rendered.Subject)
+		assert.Contains(t, rendered.HTML, "Jane Doe")
+		assert.Contains(t, rendered.Text, "John Smith")
+	})
+
+	t.Run("custom variables", func(t *testing.T) {
+		c, rec := newEmailTemplateTestContext(e, "digest", `{"name":"Sam","company":"LeadCo","items":["5 new leads"]}`)
+
+		require.NoError(t, handler.Preview(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var rendered email.RenderedEmail
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &rendered))
+		assert.Equal(t, "Your LeadCo digest", rendered.Subject)
+		assert.Contains(t, rendered.HTML, "<li>5 new leads</li>")
+	})
+
+	t.Run("unknown template", func(t *testing.T) {
+		c, rec := newEmailTemplateTestContext(e, "newsletter", "")
+
+		require.NoError(t, handler.Preview(c))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
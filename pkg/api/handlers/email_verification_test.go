@@ -10,11 +10,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/alicebob/miniredis/v2"
 	"github.com/jordanlanch/industrydb/config"
 	"github.com/jordanlanch/industrydb/ent"
 	"github.com/jordanlanch/industrydb/ent/enttest"
 	"github.com/jordanlanch/industrydb/ent/user"
 	"github.com/jordanlanch/industrydb/pkg/auth"
+	"github.com/jordanlanch/industrydb/pkg/cache"
 	"github.com/jordanlanch/industrydb/pkg/email"
 	custommiddleware "github.com/jordanlanch/industrydb/pkg/middleware"
 	"github.com/jordanlanch/industrydb/pkg/models"
@@ -424,6 +426,97 @@ func TestResendVerificationEmail_Unauthorized(t *testing.T) {
 	}
 }
 
+// resendVerification calls ResendVerificationEmail as the given user
+func resendVerification(t *testing.T, handler *AuthHandler, userID int) *httptest.ResponseRecorder {
+	t.Helper()
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/auth/resend-verification", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", userID)
+
+	if err := handler.ResendVerificationEmail(c); err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+	return rec
+}
+
+// TestResendVerificationEmail_Cooldown tests the per-user resend limits
+func TestResendVerificationEmail_Cooldown(t *testing.T) {
+	handler, client, cleanup := setupAuthTestHandler(t)
+	defer cleanup()
+
+	mr := miniredis.RunT(t)
+	cacheClient, err := cache.NewClient("redis://" + mr.Addr())
+	if err != nil {
+		t.Fatalf("Failed to create cache client: %v", err)
+	}
+	defer cacheClient.Close()
+	handler.cache = cacheClient
+	handler.config.VerificationResendCooldownSeconds = 60
+	handler.config.VerificationResendMaxPerHour = 3
+
+	ctx := context.Background()
+	u, err := createVerificationTestUser(ctx, client, "cooldown@example.com", "Cooldown User", "old-token", false, false)
+	if err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+
+	// First resend is allowed
+	if rec := resendVerification(t, handler, u.ID); rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", rec.Code)
+	}
+
+	// Immediate retry hits the cooldown
+	rec := resendVerification(t, handler, u.ID)
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("Expected status 429, got %d", rec.Code)
+	}
+	var response map[string]interface{}
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if response["error"] != "too_many_requests" {
+		t.Errorf("Expected error 'too_many_requests', got %v", response["error"])
+	}
+	if retryAfter, ok := response["retry_after"].(float64); !ok || retryAfter <= 0 || retryAfter > 60 {
+		t.Errorf("Expected retry_after in (0, 60], got %v", response["retry_after"])
+	}
+	if rec.Header().Get("Retry-After") == "" {
+		t.Error("Expected Retry-After header")
+	}
+
+	// Other users are not affected
+	other, err := createVerificationTestUser(ctx, client, "other@example.com", "Other User", "other-token", false, false)
+	if err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+	if rec := resendVerification(t, handler, other.ID); rec.Code != http.StatusOK {
+		t.Errorf("Expected status 200 for another user, got %d", rec.Code)
+	}
+
+	// After the cooldown, resends are allowed up to the hourly cap
+	for i := 2; i <= 3; i++ {
+		mr.FastForward(61 * time.Second)
+		if rec := resendVerification(t, handler, u.ID); rec.Code != http.StatusOK {
+			t.Fatalf("Resend %d: expected status 200, got %d", i, rec.Code)
+		}
+	}
+
+	mr.FastForward(61 * time.Second)
+	rec = resendVerification(t, handler, u.ID)
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("Expected status 429 after hourly cap, got %d", rec.Code)
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if retryAfter, ok := response["retry_after"].(float64); !ok || retryAfter <= 60 {
+		t.Errorf("Expected retry_after beyond the cooldown, got %v", response["retry_after"])
+	}
+}
+
 // TestRequireEmailVerified_AllowsVerifiedUser tests middleware allows verified users
 func TestRequireEmailVerified_AllowsVerifiedUser(t *testing.T) {
 	_, client, cleanup := setupAuthTestHandler(t)
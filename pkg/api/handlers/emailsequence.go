@@ -2,11 +2,18 @@ package handlers
 
 import (
 	"context"
+	stderrors "errors"
+	"fmt"
 	"net/http"
 	"strconv"
 	"time"
 
 	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/auditlog"
+	"github.com/jordanlanch/industrydb/pkg/api/errors"
+	"github.com/jordanlanch/industrydb/pkg/api/validation"
+	"github.com/jordanlanch/industrydb/pkg/audit"
+	"github.com/jordanlanch/industrydb/pkg/deliverywindow"
 	"github.com/jordanlanch/industrydb/pkg/emailsequence"
 	"github.com/jordanlanch/industrydb/pkg/models"
 	"github.com/labstack/echo/v4"
@@ -14,19 +21,21 @@ import (
 
 // EmailSequenceHandler handles email sequence operations.
 type EmailSequenceHandler struct {
-	service *emailsequence.Service
+	service     *emailsequence.Service
+	auditLogger *audit.Service
 }
 
 // NewEmailSequenceHandler creates a new email sequence handler.
-func NewEmailSequenceHandler(db *ent.Client) *EmailSequenceHandler {
+func NewEmailSequenceHandler(db *ent.Client, auditLogger *audit.Service) *EmailSequenceHandler {
 	return &EmailSequenceHandler{
-		service: emailsequence.NewService(db),
+		service:     emailsequence.NewService(db),
+		auditLogger: auditLogger,
 	}
 }
 
 // CreateSequence godoc
 // @Summary Create email sequence
-// @Description Create a new email drip campaign sequence
+// @Description Create a new email drip campaign sequence. An optional delivery_window (timezone, start_hour, end_hour) restricts sends to those local hours; sends falling outside it are deferred to the next opening. Default is 24/7.
 // @Tags Email Sequences
 // @Accept json
 // @Produce json
@@ -43,15 +52,18 @@ func (h *EmailSequenceHandler) CreateSequence(c echo.Context) error {
 	userID := c.Get("user_id").(int)
 
 	var req emailsequence.CreateSequenceRequest
-	if err := c.Bind(&req); err != nil {
-		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
-			Error:   "invalid_request",
-			Message: "Invalid request body",
-		})
+	if err := validation.Bind(c, &req); err != nil {
+		return errors.ValidationError(c, err)
 	}
 
 	result, err := h.service.CreateSequence(ctx, userID, req)
 	if err != nil {
+		if stderrors.Is(err, deliverywindow.ErrInvalidWindow) {
+			return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "invalid_delivery_window",
+				Message: err.Error(),
+			})
+		}
 		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error:   "server_error",
 			Message: err.Error(),
@@ -131,7 +143,7 @@ func (h *EmailSequenceHandler) ListSequences(c echo.Context) error {
 
 // UpdateSequence godoc
 // @Summary Update email sequence
-// @Description Update name, description, or status of an email sequence
+// @Description Update name, description, status, or delivery window of an email sequence. Setting a delivery_window moves already scheduled sends that fall outside it to its next opening; clear_delivery_window restores 24/7 sending.
 // @Tags Email Sequences
 // @Accept json
 // @Produce json
@@ -160,15 +172,18 @@ func (h *EmailSequenceHandler) UpdateSequence(c echo.Context) error {
 	}
 
 	var req emailsequence.UpdateSequenceRequest
-	if err := c.Bind(&req); err != nil {
-		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
-			Error:   "invalid_request",
-			Message: "Invalid request body",
-		})
+	if err := validation.Bind(c, &req); err != nil {
+		return errors.ValidationError(c, err)
 	}
 
 	result, err := h.service.UpdateSequence(ctx, userID, sequenceID, req)
 	if err != nil {
+		if stderrors.Is(err, deliverywindow.ErrInvalidWindow) {
+			return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "invalid_delivery_window",
+				Message: err.Error(),
+			})
+		}
 		if err.Error() == "sequence not found or unauthorized" {
 			return c.JSON(http.StatusNotFound, models.ErrorResponse{
 				Error:   "not_found_or_unauthorized",
@@ -233,7 +248,7 @@ func (h *EmailSequenceHandler) DeleteSequence(c echo.Context) error {
 
 // CreateStep godoc
 // @Summary Create sequence step
-// @Description Add a new email step to a sequence
+// @Description Add a new email step to a sequence. An optional condition makes the dispatch job skip the step when it is due and the condition does not hold, sending the next step instead: opened, not_opened, clicked or not_clicked check the email of an earlier step (step_order), field_equals or field_not_equals compare a lead field (industry, sub_niche, country, city, status, verified) to value. Steps without a condition are always sent.
 // @Tags Email Sequences
 // @Accept json
 // @Produce json
@@ -252,15 +267,18 @@ func (h *EmailSequenceHandler) CreateStep(c echo.Context) error {
 	userID := c.Get("user_id").(int)
 
 	var req emailsequence.CreateStepRequest
-	if err := c.Bind(&req); err != nil {
-		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
-			Error:   "invalid_request",
-			Message: "Invalid request body",
-		})
+	if err := validation.Bind(c, &req); err != nil {
+		return errors.ValidationError(c, err)
 	}
 
 	result, err := h.service.CreateStep(ctx, userID, req)
 	if err != nil {
+		if stderrors.Is(err, emailsequence.ErrInvalidCondition) {
+			return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "invalid_condition",
+				Message: err.Error(),
+			})
+		}
 		if err.Error() == "sequence not found or unauthorized" {
 			return c.JSON(http.StatusNotFound, models.ErrorResponse{
 				Error:   "not_found_or_unauthorized",
@@ -320,7 +338,7 @@ func (h *EmailSequenceHandler) GetStep(c echo.Context) error {
 
 // EnrollLead godoc
 // @Summary Enroll lead in sequence
-// @Description Enroll a lead in an email drip campaign sequence
+// @Description Enroll a lead in an email drip campaign sequence. Leads suppressed by the user or the sequence owner are rejected with 409.
 // @Tags Email Sequences
 // @Accept json
 // @Produce json
@@ -339,11 +357,8 @@ func (h *EmailSequenceHandler) EnrollLead(c echo.Context) error {
 	userID := c.Get("user_id").(int)
 
 	var req emailsequence.EnrollLeadRequest
-	if err := c.Bind(&req); err != nil {
-		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
-			Error:   "invalid_request",
-			Message: "Invalid request body",
-		})
+	if err := validation.Bind(c, &req); err != nil {
+		return errors.ValidationError(c, err)
 	}
 
 	result, err := h.service.EnrollLead(ctx, userID, req)
@@ -360,6 +375,12 @@ func (h *EmailSequenceHandler) EnrollLead(c echo.Context) error {
 				Message: err.Error(),
 			})
 		}
+		if err.Error() == "lead is suppressed" {
+			return c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "lead_suppressed",
+				Message: err.Error(),
+			})
+		}
 		if err.Error() == "lead already enrolled in this sequence" {
 			return c.JSON(http.StatusConflict, models.ErrorResponse{
 				Error:   "already_enrolled",
@@ -495,3 +516,74 @@ func (h *EmailSequenceHandler) StopEnrollment(c echo.Context) error {
 		"message": "Enrollment stopped successfully",
 	})
 }
+
+// StopAllEnrollments godoc
+// @Summary Stop all enrollments of a sequence
+// @Description Stop the active enrollments of an email sequence owned by the user, e.g. when pausing a campaign. Optional lead filters (lead_ids, industry, country, city, lead_status) limit the enrollments stopped; without any, all are stopped. Returns how many were stopped.
+// @Tags Email Sequences
+// @Accept json
+// @Produce json
+// @Param id path int true "Sequence ID"
+// @Param body body emailsequence.StopAllRequest false "Lead filters"
+// @Success 200 {object} emailsequence.StopAllResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/email-sequences/{id}/stop-all [post]
+func (h *EmailSequenceHandler) StopAllEnrollments(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 10*time.Second)
+	defer cancel()
+
+	userID := c.Get("user_id").(int)
+
+	sequenceIDStr := c.Param("id")
+	sequenceID, err := strconv.Atoi(sequenceIDStr)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_sequence_id",
+			Message: "Sequence ID must be a valid number",
+		})
+	}
+
+	var req emailsequence.StopAllRequest
+	if err := validation.Bind(c, &req); err != nil {
+		return errors.ValidationError(c, err)
+	}
+
+	result, err := h.service.StopAllEnrollments(ctx, userID, sequenceID, req)
+	if err != nil {
+		if err.Error() == "sequence not found or unauthorized" {
+			return c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_found_or_unauthorized",
+				Message: err.Error(),
+			})
+		}
+		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "server_error",
+			Message: err.Error(),
+		})
+	}
+
+	// Audit log (non-blocking)
+	resourceType := "email_sequence"
+	resourceID := strconv.Itoa(sequenceID)
+	ipAddress, userAgent := audit.GetRequestContext(c)
+	description := fmt.Sprintf("Stopped %d enrollments of email sequence %d", result.Stopped, sequenceID)
+	go h.auditLogger.Log(context.Background(), audit.LogEntry{
+		UserID:       &userID,
+		Action:       auditlog.ActionSequenceStopAll,
+		ResourceType: &resourceType,
+		ResourceID:   &resourceID,
+		IPAddress:    &ipAddress,
+		UserAgent:    &userAgent,
+		Description:  &description,
+		Severity:     auditlog.SeverityInfo,
+		Metadata: map[string]interface{}{
+			"stopped": result.Stopped,
+			"filters": req,
+		},
+	})
+
+	return c.JSON(http.StatusOK, result)
+}
@@ -13,6 +13,8 @@ import (
 	"github.com/jordanlanch/industrydb/ent/emailsequence"
 	"github.com/jordanlanch/industrydb/ent/enttest"
 	"github.com/jordanlanch/industrydb/ent/user"
+	"github.com/jordanlanch/industrydb/pkg/audit"
+	"github.com/jordanlanch/industrydb/pkg/models"
 	"github.com/labstack/echo/v4"
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/assert"
@@ -46,7 +48,7 @@ func setupEmailSequenceTest(t *testing.T) (*ent.Client, *EmailSequenceHandler, *
 		Save(ctx)
 	require.NoError(t, err)
 
-	handler := NewEmailSequenceHandler(client)
+	handler := NewEmailSequenceHandler(client, audit.NewService(client))
 
 	return client, handler, owner, otherUser
 }
@@ -119,6 +121,29 @@ func TestEmailSequenceHandler_CreateSequence(t *testing.T) {
 		assert.Equal(t, "lead_created", resp["trigger"])
 	})
 
+	t.Run("invalid_trigger", func(t *testing.T) {
+		_, handler, owner, _ := setupEmailSequenceTest(t)
+
+		body := `{"name":"Bad Trigger","trigger":"sometimes"}`
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/email-sequences", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", owner.ID)
+
+		err := handler.CreateSequence(c)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		var resp models.ErrorResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, "validation_error", resp.Error)
+		require.Len(t, resp.Fields, 1)
+		assert.Equal(t, "trigger", resp.Fields[0].Field)
+		assert.Equal(t, "oneof", resp.Fields[0].Rule)
+	})
+
 	t.Run("invalid_json", func(t *testing.T) {
 		_, handler, owner, _ := setupEmailSequenceTest(t)
 
@@ -422,6 +447,32 @@ func TestEmailSequenceHandler_CreateStep(t *testing.T) {
 		assert.Equal(t, float64(7), resp["delay_days"])
 	})
 
+	t.Run("invalid_condition", func(t *testing.T) {
+		client, handler, owner, _ := setupEmailSequenceTest(t)
+		seq := createTestSequence(t, client, owner.ID, "Drip Campaign", "manual", "draft")
+
+		body := fmt.Sprintf(`{
+			"sequence_id": %d,
+			"step_order": 2,
+			"subject": "Follow Up",
+			"body": "Just checking in...",
+			"condition": {"type": "opened", "step_order": 1}
+		}`, seq.ID)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/email-sequences/steps", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", owner.ID)
+
+		// Step 1 does not exist
+		err := handler.CreateStep(c)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "invalid_condition")
+	})
+
 	t.Run("sequence_not_found_or_unauthorized", func(t *testing.T) {
 		_, handler, _, otherUser := setupEmailSequenceTest(t)
 
@@ -855,3 +906,63 @@ func TestEmailSequenceHandler_StopEnrollment(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
 	})
 }
+
+func TestEmailSequenceHandler_StopAllEnrollments(t *testing.T) {
+	stopAll := func(handler *EmailSequenceHandler, userID int, sequenceID, body string) *httptest.ResponseRecorder {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/email-sequences/"+sequenceID+"/stop-all", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(sequenceID)
+		c.Set("user_id", userID)
+
+		require.NoError(t, handler.StopAllEnrollments(c))
+		return rec
+	}
+
+	t.Run("success", func(t *testing.T) {
+		client, handler, owner, _ := setupEmailSequenceTest(t)
+		seq := createTestSequence(t, client, owner.ID, "Seq", "manual", "active")
+		ctx := context.Background()
+
+		var enrollments []*ent.EmailSequenceEnrollment
+		for _, name := range []string{"Studio A", "Studio B"} {
+			lead := createTestLead(t, client, name)
+			enrollments = append(enrollments, client.EmailSequenceEnrollment.Create().
+				SetSequenceID(seq.ID).
+				SetLeadID(lead.ID).
+				SetEnrolledByUserID(owner.ID).
+				SaveX(ctx))
+		}
+
+		rec := stopAll(handler, owner.ID, fmt.Sprint(seq.ID), fmt.Sprintf(`{"lead_ids":[%d]}`, enrollments[0].LeadID))
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var resp map[string]interface{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, float64(1), resp["stopped"])
+		assert.Equal(t, "stopped", string(client.EmailSequenceEnrollment.GetX(ctx, enrollments[0].ID).Status))
+		assert.Equal(t, "active", string(client.EmailSequenceEnrollment.GetX(ctx, enrollments[1].ID).Status))
+
+		rec = stopAll(handler, owner.ID, fmt.Sprint(seq.ID), "")
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, float64(1), resp["stopped"])
+	})
+
+	t.Run("other_users_sequence", func(t *testing.T) {
+		client, handler, owner, otherUser := setupEmailSequenceTest(t)
+		seq := createTestSequence(t, client, owner.ID, "Seq", "manual", "active")
+
+		rec := stopAll(handler, otherUser.ID, fmt.Sprint(seq.ID), "")
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("invalid_id", func(t *testing.T) {
+		_, handler, owner, _ := setupEmailSequenceTest(t)
+
+		rec := stopAll(handler, owner.ID, "abc", "")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
@@ -2,11 +2,15 @@ package handlers
 
 import (
 	"context"
+	stderrors "errors"
+	"log"
 	"net/http"
 	"strconv"
 	"time"
 
 	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/usagelog"
+	"github.com/jordanlanch/industrydb/pkg/analytics"
 	"github.com/jordanlanch/industrydb/pkg/enrichment"
 	"github.com/jordanlanch/industrydb/pkg/models"
 	"github.com/labstack/echo/v4"
@@ -14,24 +18,28 @@ import (
 
 // EnrichmentHandler handles lead enrichment operations
 type EnrichmentHandler struct {
-	service *enrichment.Service
+	service          *enrichment.Service
+	analyticsService *analytics.Service
 }
 
 // NewEnrichmentHandler creates a new enrichment handler
 func NewEnrichmentHandler(db *ent.Client, provider enrichment.EnrichmentProvider) *EnrichmentHandler {
 	return &EnrichmentHandler{
-		service: enrichment.NewService(db, provider),
+		service:          enrichment.NewService(db, provider),
+		analyticsService: analytics.NewService(db),
 	}
 }
 
 // EnrichLead godoc
 // @Summary Enrich a single lead
-// @Description Enrich a lead with additional company data from third-party APIs
+// @Description Enrich a lead with additional company data from third-party APIs. Every provider call is logged in the lead's enrichment history and charged to the monthly enrichment budget of the user, or of the organization with ?organization_id=. A lead whose last enrichment with the provider failed recently is not sent again (409).
 // @Tags Enrichment
 // @Produce json
 // @Param id path int true "Lead ID"
 // @Success 200 {object} ent.Lead
 // @Failure 400 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse "Enrichment budget exceeded"
+// @Failure 409 {object} models.ErrorResponse "Last enrichment with the provider failed within the retry window"
 // @Failure 500 {object} models.ErrorResponse
 // @Security BearerAuth
 // @Router /api/v1/leads/{id}/enrich [post]
@@ -50,20 +58,32 @@ func (h *EnrichmentHandler) EnrichLead(c echo.Context) error {
 	}
 
 	// Enrich lead
-	enrichedLead, err := h.service.EnrichLead(ctx, leadID)
+	userID, _ := c.Get("user_id").(int)
+	enrichedLead, err := h.service.EnrichLead(budgetContext(ctx, c), userID, leadID)
 	if err != nil {
+		if stderrors.Is(err, enrichment.ErrRecentlyFailed) {
+			return c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "recently_failed",
+				Message: err.Error(),
+			})
+		}
+		if stderrors.Is(err, enrichment.ErrBudgetExceeded) {
+			return budgetExceeded(c, err)
+		}
 		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error:   "enrichment_failed",
 			Message: err.Error(),
 		})
 	}
 
+	h.logEnrichment(c, 1, map[string]interface{}{"lead_id": leadID})
+
 	return c.JSON(http.StatusOK, enrichedLead)
 }
 
 // BulkEnrichLeads godoc
 // @Summary Enrich multiple leads
-// @Description Enrich multiple leads in bulk
+// @Description Enrich multiple leads in bulk. Once the enrichment budget runs out, the remaining leads fail without calling the provider and budget_exceeded is set.
 // @Tags Enrichment
 // @Accept json
 // @Produce json
@@ -104,7 +124,8 @@ func (h *EnrichmentHandler) BulkEnrichLeads(c echo.Context) error {
 	}
 
 	// Bulk enrich
-	result, err := h.service.BulkEnrichLeads(ctx, req.LeadIDs)
+	userID, _ := c.Get("user_id").(int)
+	result, err := h.service.BulkEnrichLeads(budgetContext(ctx, c), userID, req.LeadIDs)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error:   "bulk_enrichment_failed",
@@ -112,17 +133,48 @@ func (h *EnrichmentHandler) BulkEnrichLeads(c echo.Context) error {
 		})
 	}
 
+	h.logEnrichment(c, result.SuccessCount, map[string]interface{}{"bulk": true, "requested": result.TotalLeads})
+
 	return c.JSON(http.StatusOK, result)
 }
 
+// budgetContext charges the enrichments of a request run as an organization
+// to the organization's budget rather than the user's
+func budgetContext(ctx context.Context, c echo.Context) context.Context {
+	if orgID, ok := c.Get("organization_id").(int); ok {
+		return enrichment.WithOrganizationBudget(ctx, orgID)
+	}
+	return ctx
+}
+
+// budgetExceeded responds to an enrichment refused by the caller's budget
+func budgetExceeded(c echo.Context, err error) error {
+	return c.JSON(http.StatusForbidden, models.ErrorResponse{
+		Error:   "budget_exceeded",
+		Message: err.Error(),
+	})
+}
+
+// logEnrichment records successful enrichments in the caller's usage log
+func (h *EnrichmentHandler) logEnrichment(c echo.Context, count int, metadata map[string]interface{}) {
+	userID, ok := c.Get("user_id").(int)
+	if !ok || count == 0 {
+		return
+	}
+	if err := h.analyticsService.LogUsage(c.Request().Context(), userID, usagelog.ActionEnrichment, count, metadata); err != nil {
+		log.Printf("Failed to log enrichment usage: %v", err)
+	}
+}
+
 // ValidateLeadEmail godoc
 // @Summary Validate lead email
-// @Description Validate a lead's email address using third-party API
+// @Description Validate a lead's email address using third-party API, charged to the monthly enrichment budget
 // @Tags Enrichment
 // @Produce json
 // @Param id path int true "Lead ID"
 // @Success 200 {object} enrichment.EmailValidation
 // @Failure 400 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse "Enrichment budget exceeded"
 // @Failure 500 {object} models.ErrorResponse
 // @Security BearerAuth
 // @Router /api/v1/leads/{id}/validate-email [get]
@@ -141,8 +193,12 @@ func (h *EnrichmentHandler) ValidateLeadEmail(c echo.Context) error {
 	}
 
 	// Validate email
-	validation, err := h.service.ValidateLeadEmail(ctx, leadID)
+	userID, _ := c.Get("user_id").(int)
+	validation, err := h.service.ValidateLeadEmail(budgetContext(ctx, c), userID, leadID)
 	if err != nil {
+		if stderrors.Is(err, enrichment.ErrBudgetExceeded) {
+			return budgetExceeded(c, err)
+		}
 		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error:   "email_validation_failed",
 			Message: err.Error(),
@@ -154,7 +210,7 @@ func (h *EnrichmentHandler) ValidateLeadEmail(c echo.Context) error {
 
 // GetEnrichmentStats godoc
 // @Summary Get enrichment statistics
-// @Description Get statistics about lead enrichment status
+// @Description Get statistics about lead enrichment status, plus provider attempts, failures and cost from the enrichment history, in total and per provider, and the caller's spend this month against its enrichment budget
 // @Tags Enrichment
 // @Produce json
 // @Success 200 {object} enrichment.EnrichmentStats
@@ -166,7 +222,8 @@ func (h *EnrichmentHandler) GetEnrichmentStats(c echo.Context) error {
 	defer cancel()
 
 	// Get stats
-	stats, err := h.service.GetEnrichmentStats(ctx)
+	userID, _ := c.Get("user_id").(int)
+	stats, err := h.service.GetEnrichmentStats(budgetContext(ctx, c), userID)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error:   "stats_failed",
@@ -176,3 +233,258 @@ func (h *EnrichmentHandler) GetEnrichmentStats(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, stats)
 }
+
+// GetEnrichmentHistory godoc
+// @Summary Get lead enrichment history
+// @Description List the enrichment provider calls made for a lead, newest first: provider, kind (company or email_validation), success, the provider fields returned or the error, cost and duration. Paginated with page and limit.
+// @Tags Enrichment
+// @Produce json
+// @Param id path int true "Lead ID"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Attempts per page (max 100, default all)"
+// @Success 200 {object} ListResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/leads/{id}/enrichment-history [get]
+func (h *EnrichmentHandler) GetEnrichmentHistory(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 10*time.Second)
+	defer cancel()
+
+	leadID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_lead_id",
+			Message: "Lead ID must be a valid integer",
+		})
+	}
+
+	page := parseListPage(c)
+	attempts, total, err := h.service.GetEnrichmentHistory(ctx, leadID, page.Limit, page.Offset())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_found",
+				Message: "Lead not found",
+			})
+		}
+		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "history_failed",
+			Message: err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, pageEnvelope(page, attempts, total))
+}
+
+// GetEnrichmentCandidates godoc
+// @Summary List enrichment candidates (admin)
+// @Description Get a prioritized worklist of unenriched leads that are missing an email but have a website, at or above the configured minimum quality score, highest quality first
+// @Tags Admin
+// @Produce json
+// @Param industry query string false "Industry filter"
+// @Param country query string false "Country code filter"
+// @Param limit query int false "Maximum candidates to return"
+// @Success 200 {object} enrichment.EnrichmentCandidatesResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/leads/enrichment-candidates [get]
+func (h *EnrichmentHandler) GetEnrichmentCandidates(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 10*time.Second)
+	defer cancel()
+
+	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+
+	candidates, err := h.service.GetEnrichmentCandidates(ctx, enrichment.CandidateFilter{
+		Industry: c.QueryParam("industry"),
+		Country:  c.QueryParam("country"),
+		Limit:    limit,
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "candidates_failed",
+			Message: err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, candidates)
+}
+
+// EnrichCandidates godoc
+// @Summary Enrich selected candidates (admin)
+// @Description Push a selection of leads into bulk enrichment. Without lead_ids, the top limit enrichment candidates are enriched.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param request body map[string]interface{} true "lead_ids (max 100) or limit (max 100)"
+// @Success 200 {object} enrichment.BulkEnrichmentResult
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/leads/enrichment-candidates [post]
+func (h *EnrichmentHandler) EnrichCandidates(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Minute)
+	defer cancel()
+
+	var req struct {
+		LeadIDs []int `json:"lead_ids"`
+		Limit   int   `json:"limit"`
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "Invalid request body",
+		})
+	}
+
+	if len(req.LeadIDs) > 100 || req.Limit > 100 {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "too_many_leads",
+			Message: "Maximum 100 leads can be enriched at once",
+		})
+	}
+
+	if len(req.LeadIDs) == 0 && req.Limit <= 0 {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "empty_selection",
+			Message: "Provide lead_ids or a limit of top candidates to enrich",
+		})
+	}
+
+	userID, _ := c.Get("user_id").(int)
+	result, err := h.service.EnrichCandidates(ctx, userID, req.LeadIDs, req.Limit)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "bulk_enrichment_failed",
+			Message: err.Error(),
+		})
+	}
+
+	h.logEnrichment(c, result.SuccessCount, map[string]interface{}{"bulk": true, "candidates": true, "requested": result.TotalLeads})
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// GetEnrichmentConfig godoc
+// @Summary Get enrichment field mapping (admin)
+// @Description Get the effective enrichment field mapping: for each lead field enrichment can fill, the provider field it comes from and whether it may overwrite existing lead data
+// @Tags Admin
+// @Produce json
+// @Success 200 {object} enrichment.MappingConfig
+// @Security BearerAuth
+// @Router /api/v1/admin/enrichment/config [get]
+func (h *EnrichmentHandler) GetEnrichmentConfig(c echo.Context) error {
+	return c.JSON(http.StatusOK, enrichment.GetMappingConfig())
+}
+
+// GetEnrichmentBudget godoc
+// @Summary Get an enrichment budget (admin)
+// @Description Get the enrichment spend of a user or organization this month against its budget: the admin-set limits, or the tier defaults. A limit of 0 is unlimited.
+// @Tags Admin
+// @Produce json
+// @Param scope path string true "users or organizations"
+// @Param id path int true "User or organization ID"
+// @Success 200 {object} enrichment.BudgetStatus
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/enrichment/budgets/{scope}/{id} [get]
+func (h *EnrichmentHandler) GetEnrichmentBudget(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	owner, ok := parseBudgetOwner(c)
+	if !ok {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_budget_owner",
+			Message: "Budgets belong to users or organizations by ID",
+		})
+	}
+
+	budget, err := h.service.GetBudget(ctx, owner)
+	if err != nil {
+		return budgetError(c, err)
+	}
+	return c.JSON(http.StatusOK, budget)
+}
+
+// SetEnrichmentBudget godoc
+// @Summary Set an enrichment budget (admin)
+// @Description Set the monthly enrichment call and cost limits of a user or organization, overriding its tier defaults. A null limit reverts to the tier default; 0 is unlimited.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param scope path string true "users or organizations"
+// @Param id path int true "User or organization ID"
+// @Param request body enrichment.BudgetLimits true "Budget limits"
+// @Success 200 {object} enrichment.BudgetStatus
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/enrichment/budgets/{scope}/{id} [put]
+func (h *EnrichmentHandler) SetEnrichmentBudget(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	owner, ok := parseBudgetOwner(c)
+	if !ok {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_budget_owner",
+			Message: "Budgets belong to users or organizations by ID",
+		})
+	}
+
+	var req enrichment.BudgetLimits
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "Invalid request body",
+		})
+	}
+
+	budget, err := h.service.SetBudgetLimits(ctx, owner, req)
+	if err != nil {
+		return budgetError(c, err)
+	}
+	return c.JSON(http.StatusOK, budget)
+}
+
+// parseBudgetOwner reads the owner of a budget from the :scope and :id path
+// parameters
+func parseBudgetOwner(c echo.Context) (enrichment.BudgetOwner, bool) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		return enrichment.BudgetOwner{}, false
+	}
+	switch c.Param("scope") {
+	case "users":
+		return enrichment.BudgetOwner{UserID: id}, true
+	case "organizations":
+		return enrichment.BudgetOwner{OrganizationID: id}, true
+	}
+	return enrichment.BudgetOwner{}, false
+}
+
+// budgetError maps an enrichment budget error to its response
+func budgetError(c echo.Context, err error) error {
+	switch {
+	case ent.IsNotFound(err):
+		return c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "not_found",
+			Message: "User or organization not found",
+		})
+	case stderrors.Is(err, enrichment.ErrInvalidBudget):
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_budget",
+			Message: err.Error(),
+		})
+	}
+	return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+		Error:   "budget_failed",
+		Message: err.Error(),
+	})
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/jordanlanch/industrydb/ent"
 	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/ent/usagelog"
 	"github.com/jordanlanch/industrydb/pkg/enrichment"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
@@ -211,6 +212,173 @@ func TestEnrichmentHandler_EnrichLead_ProviderError(t *testing.T) {
 	assert.Equal(t, http.StatusInternalServerError, rec.Code)
 }
 
+func TestEnrichmentHandler_EnrichLead_RecentlyFailed(t *testing.T) {
+	provider := &mockEnrichmentProvider{
+		enrichErr: errors.New("external API unavailable"),
+	}
+	handler, client, cleanup := setupEnrichmentHandler(t, provider)
+	defer cleanup()
+
+	leadID := createEnrichmentTestLead(t, client, "Retry Studio", "https://retrystudio.com", "")
+
+	enrich := func() *httptest.ResponseRecorder {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(strconv.Itoa(leadID))
+		require.NoError(t, handler.EnrichLead(c))
+		return rec
+	}
+
+	assert.Equal(t, http.StatusInternalServerError, enrich().Code)
+
+	// The failed provider isn't paid again within the retry window
+	provider.enrichErr = nil
+	provider.enrichResult = &enrichment.CompanyData{Description: "Retry studio"}
+	rec := enrich()
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.Contains(t, rec.Body.String(), "recently_failed")
+}
+
+func TestEnrichmentHandler_EnrichLead_BudgetExceeded(t *testing.T) {
+	provider := &mockEnrichmentProvider{
+		enrichResult: &enrichment.CompanyData{Description: "Budget studio"},
+	}
+	handler, client, cleanup := setupEnrichmentHandler(t, provider)
+	defer cleanup()
+
+	u, err := client.User.Create().
+		SetEmail("budget-handler@example.com").
+		SetPasswordHash("hash").
+		SetName("Budget User").
+		Save(context.Background())
+	require.NoError(t, err)
+	callLimit := 1
+	_, err = handler.service.SetBudgetLimits(context.Background(), enrichment.BudgetOwner{UserID: u.ID}, enrichment.BudgetLimits{CallLimit: &callLimit})
+	require.NoError(t, err)
+
+	enrich := func(leadID int) *httptest.ResponseRecorder {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", u.ID)
+		c.SetParamNames("id")
+		c.SetParamValues(strconv.Itoa(leadID))
+		require.NoError(t, handler.EnrichLead(c))
+		return rec
+	}
+
+	first := createEnrichmentTestLead(t, client, "Budget One", "https://budgetone.com", "")
+	second := createEnrichmentTestLead(t, client, "Budget Two", "https://budgettwo.com", "")
+	assert.Equal(t, http.StatusOK, enrich(first).Code)
+
+	rec := enrich(second)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Contains(t, rec.Body.String(), "budget_exceeded")
+}
+
+// --- Enrichment Budget Tests ---
+
+func TestEnrichmentHandler_Budget(t *testing.T) {
+	handler, client, cleanup := setupEnrichmentHandler(t, &mockEnrichmentProvider{})
+	defer cleanup()
+
+	u, err := client.User.Create().
+		SetEmail("budget-admin@example.com").
+		SetPasswordHash("hash").
+		SetName("Budget User").
+		SetSubscriptionTier("starter").
+		Save(context.Background())
+	require.NoError(t, err)
+
+	call := func(method, scope, id, body string) *httptest.ResponseRecorder {
+		e := echo.New()
+		req := httptest.NewRequest(method, "/", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("scope", "id")
+		c.SetParamValues(scope, id)
+		if method == http.MethodPut {
+			require.NoError(t, handler.SetEnrichmentBudget(c))
+		} else {
+			require.NoError(t, handler.GetEnrichmentBudget(c))
+		}
+		return rec
+	}
+	userID := strconv.Itoa(u.ID)
+
+	rec := call(http.MethodGet, "users", userID, "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var status enrichment.BudgetStatus
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &status))
+	assert.Equal(t, "starter", status.Tier)
+	assert.Equal(t, 250, status.CallLimit)
+	assert.False(t, status.Custom)
+
+	rec = call(http.MethodPut, "users", userID, `{"call_limit": 10, "cost_limit_cents": 500}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &status))
+	assert.Equal(t, 10, status.CallLimit)
+	assert.Equal(t, 500, status.CostLimitCents)
+	assert.True(t, status.Custom)
+
+	assert.Equal(t, http.StatusBadRequest, call(http.MethodPut, "users", userID, `{"call_limit": -5}`).Code)
+	assert.Equal(t, http.StatusBadRequest, call(http.MethodGet, "teams", userID, "").Code)
+	assert.Equal(t, http.StatusNotFound, call(http.MethodGet, "organizations", "99999", "").Code)
+}
+
+// --- GetEnrichmentHistory Tests ---
+
+func TestEnrichmentHandler_GetHistory(t *testing.T) {
+	provider := &mockEnrichmentProvider{
+		enrichResult: &enrichment.CompanyData{Description: "Premium tattoo studio", EmployeeCount: 5},
+	}
+	handler, client, cleanup := setupEnrichmentHandler(t, provider)
+	defer cleanup()
+
+	leadID := createEnrichmentTestLead(t, client, "History Studio", "https://historystudio.com", "info@historystudio.com")
+	_, err := handler.service.EnrichLead(context.Background(), 0, leadID)
+	require.NoError(t, err)
+	_, err = handler.service.ValidateLeadEmail(context.Background(), 0, leadID)
+	require.NoError(t, err)
+
+	history := func(id string) *httptest.ResponseRecorder {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/?limit=1", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+		require.NoError(t, handler.GetEnrichmentHistory(c))
+		return rec
+	}
+
+	rec := history(strconv.Itoa(leadID))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response struct {
+		Data []struct {
+			Kind           string   `json:"kind"`
+			Success        bool     `json:"success"`
+			FieldsReturned []string `json:"fields_returned"`
+		} `json:"data"`
+		Total   int  `json:"total"`
+		HasMore bool `json:"has_more"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, 2, response.Total)
+	assert.True(t, response.HasMore)
+	require.Len(t, response.Data, 1)
+	assert.Equal(t, "email_validation", response.Data[0].Kind)
+	assert.True(t, response.Data[0].Success)
+
+	assert.Equal(t, http.StatusNotFound, history("99999").Code)
+	assert.Equal(t, http.StatusBadRequest, history("abc").Code)
+}
+
 // --- BulkEnrichLeads Tests ---
 
 func TestEnrichmentHandler_BulkEnrich_Success(t *testing.T) {
@@ -245,6 +413,42 @@ func TestEnrichmentHandler_BulkEnrich_Success(t *testing.T) {
 	assert.Equal(t, 0, response.FailureCount)
 }
 
+func TestEnrichmentHandler_BulkEnrich_LogsUsage(t *testing.T) {
+	provider := &mockEnrichmentProvider{
+		enrichResult: &enrichment.CompanyData{
+			Name:        "Studio",
+			Description: "A studio",
+		},
+	}
+	handler, client, cleanup := setupEnrichmentHandler(t, provider)
+	defer cleanup()
+
+	u := createTestUserForHandlers(t, client, "enrich-usage@example.com")
+	lead1 := createEnrichmentTestLead(t, client, "Studio 1", "https://studio1.com", "")
+	lead2 := createEnrichmentTestLead(t, client, "Studio 2", "", "")
+
+	e := echo.New()
+	body := `{"lead_ids":[` + strconv.Itoa(lead1) + `,` + strconv.Itoa(lead2) + `]}`
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", u.ID)
+
+	err := handler.BulkEnrichLeads(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	// Only successful enrichments count as usage
+	logs, err := client.UsageLog.Query().
+		Where(usagelog.UserIDEQ(u.ID)).
+		All(context.Background())
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	assert.Equal(t, usagelog.ActionEnrichment, logs[0].Action)
+	assert.Equal(t, 1, logs[0].Count)
+}
+
 func TestEnrichmentHandler_BulkEnrich_PartialFailure(t *testing.T) {
 	provider := &mockEnrichmentProvider{
 		enrichResult: &enrichment.CompanyData{
@@ -578,3 +782,41 @@ func TestEnrichmentHandler_GetStats_Empty(t *testing.T) {
 	assert.Equal(t, 0, response.EnrichedLeads)
 	assert.Equal(t, 0.0, response.EnrichmentRate)
 }
+
+// --- GetEnrichmentConfig Tests ---
+
+func TestEnrichmentHandler_GetConfig(t *testing.T) {
+	provider := &mockEnrichmentProvider{}
+	handler, _, cleanup := setupEnrichmentHandler(t, provider)
+	defer cleanup()
+	defer enrichment.SetFieldMapping(enrichment.DefaultFieldMapping())
+
+	mapping := enrichment.DefaultFieldMapping().WithFields(map[string]string{"employees": enrichment.LeadFieldEmployeeCount})
+	mapping.Overwrite = []string{enrichment.LeadFieldCompanyRevenue}
+	require.NoError(t, enrichment.SetFieldMapping(mapping))
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/enrichment/config", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := handler.GetEnrichmentConfig(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response enrichment.MappingConfig
+	err = json.Unmarshal(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+	assert.Equal(t, "default", response.Provider)
+	require.Len(t, response.Fields, len(enrichment.LeadFields))
+	for _, rule := range response.Fields {
+		switch rule.LeadField {
+		case enrichment.LeadFieldEmployeeCount:
+			assert.Equal(t, "employees", rule.ProviderField)
+			assert.False(t, rule.Overwrite)
+		case enrichment.LeadFieldCompanyRevenue:
+			assert.Equal(t, "revenue", rule.ProviderField)
+			assert.True(t, rule.Overwrite)
+		}
+	}
+}
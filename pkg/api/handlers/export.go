@@ -1,16 +1,24 @@
 package handlers
 
 import (
+	"context"
+	stderrors "errors"
+	"log"
 	"net/http"
 	"path/filepath"
 	"strconv"
+	"strings"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/jordanlanch/industrydb/pkg/analytics"
 	"github.com/jordanlanch/industrydb/pkg/api/errors"
+	"github.com/jordanlanch/industrydb/pkg/api/validation"
+	"github.com/jordanlanch/industrydb/pkg/audit"
 	"github.com/jordanlanch/industrydb/pkg/export"
+	"github.com/jordanlanch/industrydb/pkg/leads"
 	"github.com/jordanlanch/industrydb/pkg/models"
 	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
 )
 
 // ExportHandler handles export endpoints
@@ -18,6 +26,7 @@ type ExportHandler struct {
 	exportService    *export.Service
 	analyticsService *analytics.Service
 	validator        *validator.Validate
+	auditLogger      *audit.Service // Records export creations, nil records none
 }
 
 // NewExportHandler creates a new export handler
@@ -25,23 +34,32 @@ func NewExportHandler(exportService *export.Service, analyticsService *analytics
 	return &ExportHandler{
 		exportService:    exportService,
 		analyticsService: analyticsService,
-		validator:        validator.New(),
+		validator:        validation.Validator(),
 	}
 }
 
+// SetAuditLogger sets where export creations are audited
+func (h *ExportHandler) SetAuditLogger(auditLogger *audit.Service) {
+	h.auditLogger = auditLogger
+}
+
 // Create handles creating a new export
 // @Summary Create new export
-// @Description Create a new data export in CSV or Excel format with optional filters
+// @Description Create a new data export in CSV, Excel or vCard (format=vcard, a .vcf with one vCard 3.0 entry per lead built from the selected contact columns), GeoJSON or KML format with optional filters. GeoJSON and KML exports contain a point per lead with coordinates, with the selected columns as properties; leads without coordinates are left out and counted in skipped_count. Set since (RFC3339) or since_last_export=true for a delta export containing only leads created or updated after that point; the response carries the high_water_mark the next delta continues from. Set delivery_url (https on a public host, without credentials, like webhook URLs) to have the completed file POSTed there, signed with the returned delivery_secret (X-Webhook-Signature, HMAC-SHA256). Exports are processed by a bounded worker pool with a per-tier limit on concurrent exports per user; a waiting export stays pending and reports its queue_position. Set columns to choose and order the exported columns. Set delivery=google_sheets to write the export to the connected Google account (see /integrations/google/connect), appending to spreadsheet_id or creating a new spreadsheet; the export reports the sheet_url, and rows past the Google Sheets cell limit are dropped with a delivery_warning. Leads suppressed by the user or their organizations are left out; set suppressed=annotate to include them with a Suppressed column instead. Set redaction_profile to a named profile (built in: internal, partner) to null or hash the fields the recipient may not see; hashes are deterministic so the same lead matches across exports, and the profile is recorded on the export and in the audit log. Set compress=gzip or compress=zip (a single entry named after the export) to store a compressed file, which makes large exports faster to download; exports are uncompressed by default.
 // @Tags Exports
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param request body models.ExportRequest true "Export configuration"
+// @Param Idempotency-Key header string false "Retries with the same key within the idempotency window (default 24 hours) return the first response instead of repeating the request"
 // @Success 201 {object} models.ExportResponse "Export created successfully"
-// @Failure 400 {object} models.ErrorResponse "Invalid request"
+// @Failure 400 {object} models.ErrorResponse "Invalid request, column, redaction profile, delivery URL, or Google account not connected"
 // @Failure 401 {object} models.ErrorResponse "Unauthorized"
 // @Failure 402 {object} models.ErrorResponse "Usage limit exceeded"
+// @Failure 403 {object} models.ErrorResponse "max_leads exceeds the tier's per-export row cap (upgrade_required)"
+// @Failure 429 {object} models.ErrorResponse "Export queue full"
 // @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Failure 503 {object} models.ErrorResponse "Google Sheets delivery not configured"
 // @Router /exports [post]
 func (h *ExportHandler) Create(c echo.Context) error {
 	// Get user ID from context
@@ -62,6 +80,15 @@ func (h *ExportHandler) Create(c echo.Context) error {
 	if err := h.validator.Struct(req); err != nil {
 		return errors.ValidationError(c, err)
 	}
+	if !validQualityRange(req.Filters) {
+		return invalidQualityRangeError(c)
+	}
+	if !validCompletenessRange(req.Filters) {
+		return invalidCompletenessRangeError(c)
+	}
+	if _, err := leads.ParseBoundingBox(req.Filters.BBox); err != nil {
+		return invalidBoundingBoxError(c, err)
+	}
 
 	// Check if user is acting as part of an organization
 	var organizationID *int
@@ -72,13 +99,135 @@ func (h *ExportHandler) Create(c echo.Context) error {
 	// Create export
 	exportResp, err := h.exportService.CreateExport(c.Request().Context(), userID, organizationID, req)
 	if err != nil {
-		return errors.InternalError(c, err)
+		return createExportError(c, err)
+	}
+
+	if h.auditLogger != nil {
+		ipAddress, userAgent := audit.GetRequestContext(c)
+		metadata := map[string]interface{}{
+			"format":            req.Format,
+			"max_leads":         req.MaxLeads,
+			"redaction_profile": req.RedactionProfile,
+		}
+		go h.auditLogger.LogExportCreate(context.Background(), userID, exportResp.ID, metadata, ipAddress, userAgent)
 	}
 
 	// Analytics will be logged after export completes (in export service)
 	return c.JSON(http.StatusCreated, exportResp)
 }
 
+// createExportError responds to an error creating an export
+func createExportError(c echo.Context, err error) error {
+	if stderrors.Is(err, export.ErrInvalidDeliveryURL) {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_delivery_url",
+			Message: err.Error(),
+		})
+	}
+	if stderrors.Is(err, export.ErrInvalidColumn) {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_column",
+			Message: err.Error(),
+		})
+	}
+	if stderrors.Is(err, export.ErrInvalidRedactionProfile) {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_redaction_profile",
+			Message: err.Error(),
+		})
+	}
+	if stderrors.Is(err, export.ErrConflictingDelivery) {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_delivery",
+			Message: err.Error(),
+		})
+	}
+	if stderrors.Is(err, export.ErrSheetsNotConnected) {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "google_not_connected",
+			Message: err.Error(),
+		})
+	}
+	if stderrors.Is(err, export.ErrSheetsUnavailable) {
+		return c.JSON(http.StatusServiceUnavailable, models.ErrorResponse{
+			Error:   "delivery_unavailable",
+			Message: err.Error(),
+		})
+	}
+	if stderrors.Is(err, export.ErrExportRowCapExceeded) {
+		return c.JSON(http.StatusForbidden, models.ErrorResponse{
+			Error:   "upgrade_required",
+			Message: err.Error(),
+		})
+	}
+	if stderrors.Is(err, export.ErrExportQueueFull) {
+		return c.JSON(http.StatusTooManyRequests, models.ErrorResponse{
+			Error:   "export_queue_full",
+			Message: err.Error(),
+		})
+	}
+	return errors.InternalError(c, err)
+}
+
+// Estimate handles estimating an export before creating it
+// @Summary Estimate export cost
+// @Description Estimate how many rows an export would contain and the lead credits it would use (one per exported lead), without creating or charging anything. Takes the same body as creating an export; format may be omitted. The matching count uses the fast count of GET /leads/count, so large results are planner estimates (exact=false). estimated_rows respects max_leads and the tier's per-export row cap; row_cap_exceeded means creating the export as requested would be rejected. exceeds_remaining reports whether quota_cost is more than the remaining monthly credits.
+// @Tags Exports
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body models.ExportRequest true "Export configuration"
+// @Success 200 {object} models.ExportEstimateResponse "Export estimate"
+// @Failure 400 {object} models.ErrorResponse "Invalid request"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /exports/estimate [post]
+func (h *ExportHandler) Estimate(c echo.Context) error {
+	// Get user ID from context
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	// Parse request
+	var req models.ExportRequest
+	if err := c.Bind(&req); err != nil {
+		return errors.ValidationError(c, err)
+	}
+
+	// The format does not change the estimate
+	if req.Format == "" {
+		req.Format = "csv"
+	}
+	if err := h.validator.Struct(req); err != nil {
+		return errors.ValidationError(c, err)
+	}
+	if !validQualityRange(req.Filters) {
+		return invalidQualityRangeError(c)
+	}
+	if !validCompletenessRange(req.Filters) {
+		return invalidCompletenessRangeError(c)
+	}
+	if _, err := leads.ParseBoundingBox(req.Filters.BBox); err != nil {
+		return invalidBoundingBoxError(c, err)
+	}
+
+	// Check if user is acting as part of an organization
+	var organizationID *int
+	if orgID, hasOrgContext := c.Get("organization_id").(int); hasOrgContext {
+		organizationID = &orgID
+	}
+
+	estimate, err := h.exportService.EstimateExport(c.Request().Context(), userID, organizationID, req)
+	if err != nil {
+		return errors.InternalError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, estimate)
+}
+
 // Get handles retrieving a single export
 // @Summary Get export details
 // @Description Get detailed information about a specific export including status and download URL
@@ -176,12 +325,12 @@ func (h *ExportHandler) List(c echo.Context) error {
 
 // Download handles downloading an export file
 // @Summary Download export file
-// @Description Download the generated CSV or Excel file for a specific export
+// @Description Download the generated CSV, Excel, vCard (.vcf, served as text/vcard), GeoJSON (application/geo+json) or KML (application/vnd.google-earth.kml+xml) file for a specific export. Exports created with compress=gzip are served with Content-Encoding: gzip and the content type of the file; compress=zip exports are served as application/zip.
 // @Tags Exports
 // @Produce application/octet-stream
 // @Security BearerAuth
 // @Param id path int true "Export ID"
-// @Success 200 {file} file "Export file (CSV or Excel)"
+// @Success 200 {file} file "Export file (CSV, Excel, vCard, GeoJSON or KML)"
 // @Failure 400 {object} models.ErrorResponse "Invalid export ID"
 // @Failure 401 {object} models.ErrorResponse "Unauthorized"
 // @Failure 404 {object} models.ErrorResponse "Export not found or file unavailable"
@@ -212,13 +361,43 @@ func (h *ExportHandler) Download(c echo.Context) error {
 		return errors.InternalError(c, err)
 	}
 
-	// Get filename
+	// Count the download for export analytics, without failing it
+	if err := h.exportService.RecordDownload(c.Request().Context(), exportID); err != nil {
+		log.Printf("Failed to record download of export %d: %v", exportID, err)
+	}
+
+	// Get filename; gzip compressed files are named after their content,
+	// which the client decodes
 	filename := filepath.Base(filePath)
+	compressed := strings.HasSuffix(filename, ".zip")
+	if strings.HasSuffix(filename, ".gz") {
+		filename = strings.TrimSuffix(filename, ".gz")
+		c.Response().Header().Set(echo.HeaderContentEncoding, "gzip")
+		compressed = true
+	}
 
 	// Set headers for download
 	c.Response().Header().Set("Content-Disposition", "attachment; filename="+filename)
-	c.Response().Header().Set("Content-Type", "application/octet-stream")
+	contentType := "application/octet-stream"
+	switch filepath.Ext(filename) {
+	case ".vcf":
+		contentType = "text/vcard; charset=utf-8"
+	case ".geojson":
+		contentType = "application/geo+json"
+	case ".kml":
+		contentType = "application/vnd.google-earth.kml+xml"
+	case ".zip":
+		contentType = "application/zip"
+	}
+	c.Response().Header().Set("Content-Type", contentType)
 
-	// Send file
+	// Send file. The global Gzip middleware skips this route: compressed
+	// exports are served as stored, the others are gzipped in transit when
+	// the client accepts it.
+	if !compressed {
+		return middleware.Gzip()(func(c echo.Context) error {
+			return c.File(filePath)
+		})(c)
+	}
 	return c.File(filePath)
 }
@@ -1,7 +1,11 @@
 package handlers
 
 import (
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -19,6 +23,7 @@ import (
 	"github.com/jordanlanch/industrydb/pkg/analytics"
 	exportpkg "github.com/jordanlanch/industrydb/pkg/export"
 	"github.com/jordanlanch/industrydb/pkg/leads"
+	"github.com/jordanlanch/industrydb/pkg/models"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -137,6 +142,348 @@ func TestExportHandler_Create_Excel(t *testing.T) {
 	assert.Equal(t, "pending", response["status"])
 }
 
+func TestExportHandler_Create_VCard(t *testing.T) {
+	client, handler, cleanup := setupExportTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	user := createExportTestUser(t, client, "vcard@example.com", "pro")
+	_, err := client.Lead.Create().
+		SetName("Ink, Inc.").
+		SetIndustry("tattoo").
+		SetCountry("US").
+		SetCity("Austin").
+		SetPhone("+1 512 555 0100").
+		SetEmail("hello@ink.example").
+		Save(ctx)
+	require.NoError(t, err)
+
+	created := createDeltaExport(t, handler, user.ID, `{"format":"vcard","filters":{"industry":"tattoo","country":"US","page":1,"limit":50},"max_leads":100,"columns":["name","phone","city"]}`)
+	assert.Equal(t, "vcard", created["format"])
+	exp := waitForExport(t, client, int(created["id"].(float64)))
+	assert.Equal(t, ".vcf", filepath.Ext(exp.FilePath))
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/exports/%d/download", exp.ID), nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(fmt.Sprint(exp.ID))
+	c.Set("user_id", user.ID)
+
+	require.NoError(t, handler.Download(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/vcard; charset=utf-8", rec.Header().Get("Content-Type"))
+	assert.Contains(t, rec.Header().Get("Content-Disposition"), ".vcf")
+
+	body := rec.Body.String()
+	assert.Contains(t, body, "BEGIN:VCARD\r\nVERSION:3.0\r\n")
+	assert.Contains(t, body, "ORG:Ink\\, Inc.\r\n")
+	assert.Contains(t, body, "TEL;TYPE=WORK,VOICE:+1 512 555 0100\r\n")
+	assert.Contains(t, body, "ADR;TYPE=WORK:;;;Austin;;;\r\n")
+	// Email was not selected
+	assert.NotContains(t, body, "EMAIL")
+
+	downloaded, err := client.Export.Get(ctx, exp.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, downloaded.DownloadCount)
+	assert.NotNil(t, downloaded.LastDownloadedAt)
+	assert.NotNil(t, downloaded.FileSize)
+}
+
+func TestExportHandler_Create_Compressed(t *testing.T) {
+	client, handler, cleanup := setupExportTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	user := createExportTestUser(t, client, "compress@example.com", "pro")
+	_, err := client.Lead.Create().
+		SetName("Packed Studio").
+		SetIndustry("tattoo").
+		SetCountry("US").
+		SetCity("Austin").
+		Save(ctx)
+	require.NoError(t, err)
+
+	download := func(compress string) (*ent.Export, *httptest.ResponseRecorder) {
+		created := createDeltaExport(t, handler, user.ID, `{"format":"csv","filters":{"industry":"tattoo","country":"US","page":1,"limit":50},"max_leads":100,"columns":["name","city"],"compress":"`+compress+`"}`)
+		if compress != "" {
+			assert.Equal(t, compress, created["compression"])
+		}
+		exp := waitForExport(t, client, int(created["id"].(float64)))
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/exports/%d/download", exp.ID), nil)
+		req.Header.Set(echo.HeaderAcceptEncoding, "gzip")
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(fmt.Sprint(exp.ID))
+		c.Set("user_id", user.ID)
+		require.NoError(t, handler.Download(c))
+		require.Equal(t, http.StatusOK, rec.Code)
+		return exp, rec
+	}
+
+	exp, rec := download("gzip")
+	assert.True(t, strings.HasSuffix(exp.FilePath, ".csv.gz"))
+	assert.Equal(t, "gzip", rec.Header().Get("Content-Encoding"))
+	assert.Contains(t, rec.Header().Get("Content-Disposition"), ".csv")
+	assert.NotContains(t, rec.Header().Get("Content-Disposition"), ".gz")
+	require.NotNil(t, exp.FileSize)
+	assert.Equal(t, int64(rec.Body.Len()), *exp.FileSize, "the stored size is the compressed size")
+	reader, err := gzip.NewReader(rec.Body)
+	require.NoError(t, err)
+	rows, err := csv.NewReader(reader).ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"Name", "City"}, {"Packed Studio", "Austin"}}, rows)
+
+	exp, rec = download("zip")
+	assert.Equal(t, ".zip", filepath.Ext(exp.FilePath))
+	assert.Equal(t, "application/zip", rec.Header().Get("Content-Type"))
+	assert.Empty(t, rec.Header().Get("Content-Encoding"))
+	archive, err := zip.NewReader(bytes.NewReader(rec.Body.Bytes()), int64(rec.Body.Len()))
+	require.NoError(t, err)
+	require.Len(t, archive.File, 1)
+	assert.Equal(t, strings.TrimSuffix(filepath.Base(exp.FilePath), ".zip")+".csv", archive.File[0].Name)
+	entry, err := archive.File[0].Open()
+	require.NoError(t, err)
+	rows, err = csv.NewReader(entry).ReadAll()
+	require.NoError(t, err)
+	assert.Len(t, rows, 2)
+
+	// Uncompressed exports are gzipped in transit instead
+	exp, rec = download("")
+	assert.Equal(t, ".csv", filepath.Ext(exp.FilePath))
+	assert.Equal(t, "gzip", rec.Header().Get("Content-Encoding"))
+	reader, err = gzip.NewReader(rec.Body)
+	require.NoError(t, err)
+	rows, err = csv.NewReader(reader).ReadAll()
+	require.NoError(t, err)
+	assert.Len(t, rows, 2)
+
+	// Unknown compressions are rejected
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/exports", strings.NewReader(`{"format":"csv","max_leads":100,"compress":"rar"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec = httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.Set("user_id", user.ID)
+	require.NoError(t, handler.Create(c))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestExportHandler_Create_RedactionProfile(t *testing.T) {
+	client, handler, cleanup := setupExportTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	user := createExportTestUser(t, client, "redact@example.com", "pro")
+	_, err := client.Lead.Create().
+		SetName("Partner Studio").
+		SetIndustry("tattoo").
+		SetCountry("US").
+		SetCity("Austin").
+		SetAddress("1 Congress Ave").
+		SetPhone("+1 512 555 0100").
+		SetEmail("hello@partner.example").
+		SetWebsite("https://partner.example").
+		Save(ctx)
+	require.NoError(t, err)
+
+	download := func(body string) (*ent.Export, [][]string) {
+		created := createDeltaExport(t, handler, user.ID, body)
+		exp := waitForExport(t, client, int(created["id"].(float64)))
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/exports/%d/download", exp.ID), nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(fmt.Sprint(exp.ID))
+		c.Set("user_id", user.ID)
+		require.NoError(t, handler.Download(c))
+
+		rows, err := csv.NewReader(rec.Body).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+		return exp, rows
+	}
+
+	const columns = `"columns":["name","city","address","phone","email","website"]`
+	exp, rows := download(`{"format":"csv","filters":{"industry":"tattoo","country":"US","page":1,"limit":50},"max_leads":100,` + columns + `,"redaction_profile":"partner"}`)
+	assert.Equal(t, "partner", exp.RedactionProfile)
+	partner := rows[1]
+	assert.Equal(t, "Partner Studio", partner[0])
+	assert.Equal(t, "Austin", partner[1])
+	assert.Empty(t, partner[2])
+	assert.Len(t, partner[3], 64)
+	assert.Len(t, partner[4], 64)
+	assert.NotEqual(t, "hello@partner.example", partner[4])
+	assert.Empty(t, partner[5])
+
+	// Hashes are deterministic, so a second export joins on them
+	_, rows = download(`{"format":"csv","filters":{"industry":"tattoo","country":"US","page":1,"limit":50},"max_leads":100,` + columns + `,"redaction_profile":"partner"}`)
+	assert.Equal(t, partner, rows[1])
+
+	// The internal profile exports everything
+	_, rows = download(`{"format":"csv","filters":{"industry":"tattoo","country":"US","page":1,"limit":50},"max_leads":100,` + columns + `,"redaction_profile":"internal"}`)
+	assert.Equal(t, []string{"Partner Studio", "Austin", "1 Congress Ave", "+1 512 555 0100", "hello@partner.example", "https://partner.example"}, rows[1])
+
+	t.Run("unknown profile", func(t *testing.T) {
+		e := echo.New()
+		body := `{"format":"csv","filters":{"industry":"tattoo","page":1,"limit":50},"max_leads":100,"redaction_profile":"everyone"}`
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/exports", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", user.ID)
+
+		require.NoError(t, handler.Create(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "invalid_redaction_profile")
+	})
+}
+
+func TestExportHandler_Create_GeoFormats(t *testing.T) {
+	client, handler, cleanup := setupExportTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	user := createExportTestUser(t, client, "geo@example.com", "pro")
+	_, err := client.Lead.Create().
+		SetName("Mapped Studio").
+		SetIndustry("tattoo").
+		SetCountry("US").
+		SetCity("Austin").
+		SetLatitude(30.2672).
+		SetLongitude(-97.7431).
+		Save(ctx)
+	require.NoError(t, err)
+	_, err = client.Lead.Create().
+		SetName("Unmapped Studio").
+		SetIndustry("tattoo").
+		SetCountry("US").
+		SetCity("Austin").
+		Save(ctx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		format      string
+		contentType string
+		contains    string
+	}{
+		{"geojson", "application/geo+json", `"type":"FeatureCollection"`},
+		{"kml", "application/vnd.google-earth.kml+xml", "<name>Mapped Studio</name>"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			created := createDeltaExport(t, handler, user.ID, `{"format":"`+tt.format+`","filters":{"industry":"tattoo","country":"US","page":1,"limit":50},"max_leads":100}`)
+			exp := waitForExport(t, client, int(created["id"].(float64)))
+			assert.Equal(t, 1, exp.LeadCount)
+			assert.Equal(t, 1, exp.SkippedCount)
+			assert.Equal(t, "."+tt.format, filepath.Ext(exp.FilePath))
+
+			exportContext := func() (echo.Context, *httptest.ResponseRecorder) {
+				rec := httptest.NewRecorder()
+				c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+				c.SetParamNames("id")
+				c.SetParamValues(fmt.Sprint(exp.ID))
+				c.Set("user_id", user.ID)
+				return c, rec
+			}
+
+			c, rec := exportContext()
+			require.NoError(t, handler.Get(c))
+			var response map[string]interface{}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Equal(t, float64(1), response["skipped_count"])
+
+			c, rec = exportContext()
+			require.NoError(t, handler.Download(c))
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, tt.contentType, rec.Header().Get("Content-Type"))
+			assert.Contains(t, rec.Body.String(), tt.contains)
+			assert.NotContains(t, rec.Body.String(), "Unmapped Studio")
+		})
+	}
+}
+
+func TestExportHandler_Estimate(t *testing.T) {
+	client, handler, cleanup := setupExportTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	for i, name := range []string{"Studio A", "Studio B", "Studio C"} {
+		_, err := client.Lead.Create().
+			SetName(name).
+			SetIndustry("tattoo").
+			SetCountry("US").
+			SetCity("Austin").
+			SetVerified(i < 2).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	estimate := func(t *testing.T, userID int, body string) models.ExportEstimateResponse {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/exports/estimate", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		c.Set("user_id", userID)
+
+		require.NoError(t, handler.Estimate(c))
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		var response models.ExportEstimateResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		return response
+	}
+
+	t.Run("counts rows and credits without charging", func(t *testing.T) {
+		user := createExportTestUser(t, client, "estimate@example.com", "pro")
+
+		result := estimate(t, user.ID, `{"filters":{"industry":"tattoo","country":"US","page":1,"limit":50},"max_leads":2}`)
+		assert.Equal(t, 3, result.MatchingCount)
+		assert.True(t, result.Exact)
+		assert.Equal(t, 2, result.EstimatedRows)
+		assert.Equal(t, 2, result.QuotaCost)
+		assert.Equal(t, 50, result.Remaining)
+		assert.False(t, result.ExceedsRemaining)
+		assert.Equal(t, "pro", result.Tier)
+		assert.False(t, result.RowCapExceeded)
+
+		exports, err := client.Export.Query().Count(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, exports)
+		unchanged, err := client.User.Get(ctx, user.ID)
+		require.NoError(t, err)
+		assert.Zero(t, unchanged.UsageCount)
+	})
+
+	t.Run("flags a cost above the remaining credits", func(t *testing.T) {
+		user := createExportTestUser(t, client, "almost-out@example.com", "pro")
+		_, err := client.User.UpdateOne(user).SetUsageCount(49).Save(ctx)
+		require.NoError(t, err)
+
+		result := estimate(t, user.ID, `{"format":"kml","filters":{"industry":"tattoo","country":"US","page":1,"limit":50},"max_leads":100}`)
+		assert.Equal(t, 3, result.QuotaCost)
+		assert.Equal(t, 1, result.Remaining)
+		assert.True(t, result.ExceedsRemaining)
+	})
+
+	t.Run("respects the tier row cap", func(t *testing.T) {
+		user := createExportTestUser(t, client, "free-estimate@example.com", "free")
+
+		result := estimate(t, user.ID, `{"filters":{"industry":"tattoo","country":"US","page":1,"limit":50},"max_leads":500}`)
+		assert.Equal(t, 100, result.RowCap)
+		assert.True(t, result.RowCapExceeded)
+		// Free exports only include verified leads by default
+		assert.Equal(t, 2, result.EstimatedRows)
+
+		result = estimate(t, user.ID, `{"filters":{"industry":"tattoo","country":"US","verified":false,"page":1,"limit":50},"max_leads":100}`)
+		assert.Equal(t, 1, result.EstimatedRows)
+	})
+}
+
 func TestExportHandler_Create_InvalidFormat(t *testing.T) {
 	client, handler, cleanup := setupExportTest(t)
 	defer cleanup()
@@ -649,3 +996,180 @@ func TestExportHandler_Download_Success(t *testing.T) {
 	assert.Equal(t, "application/octet-stream", rec.Header().Get("Content-Type"))
 	assert.Contains(t, rec.Body.String(), "ID,Name")
 }
+
+// waitForExport polls until the async export processing finishes
+func waitForExport(t *testing.T, client *ent.Client, exportID int) *ent.Export {
+	var exp *ent.Export
+	require.Eventually(t, func() bool {
+		var err error
+		exp, err = client.Export.Get(context.Background(), exportID)
+		return err == nil && (exp.Status == export.StatusReady || exp.Status == export.StatusFailed)
+	}, 5*time.Second, 20*time.Millisecond)
+	require.Equal(t, export.StatusReady, exp.Status, exp.ErrorMessage)
+	return exp
+}
+
+func createDeltaExport(t *testing.T, handler *ExportHandler, userID int, body string) map[string]interface{} {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/exports", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", userID)
+
+	require.NoError(t, handler.Create(c))
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	var response map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	return response
+}
+
+func TestExportHandler_Create_DeltaSinceLastExport(t *testing.T) {
+	client, handler, cleanup := setupExportTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	user := createExportTestUser(t, client, "delta@example.com", "pro")
+	base := time.Date(2026, 5, 1, 10, 0, 0, 500, time.UTC)
+
+	createLead := func(name string, updatedAt time.Time) *ent.Lead {
+		l, err := client.Lead.Create().
+			SetName(name).
+			SetIndustry("tattoo").
+			SetCountry("US").
+			SetCity("Austin").
+			SetUpdatedAt(updatedAt).
+			Save(ctx)
+		require.NoError(t, err)
+		return l
+	}
+	createLead("Old Studio", base)
+	changed := createLead("Changed Studio", base.Add(time.Hour))
+
+	filters := `"filters":{"industry":"tattoo","country":"US","page":1,"limit":50}`
+
+	// Full export covers everything and records the latest updated_at
+	full := createDeltaExport(t, handler, user.ID, `{"format":"csv",`+filters+`,"max_leads":100}`)
+	fullExp := waitForExport(t, client, int(full["id"].(float64)))
+	assert.Equal(t, 2, fullExp.LeadCount)
+	assert.Nil(t, fullExp.Since)
+	require.NotNil(t, fullExp.HighWaterMark)
+	assert.True(t, changed.UpdatedAt.Equal(*fullExp.HighWaterMark))
+
+	// One lead changes and one is added after the full export
+	_, err := client.Lead.UpdateOne(changed).SetUpdatedAt(base.Add(2 * time.Hour)).Save(ctx)
+	require.NoError(t, err)
+	createLead("New Studio", base.Add(3*time.Hour))
+
+	delta := createDeltaExport(t, handler, user.ID, `{"format":"csv",`+filters+`,"max_leads":100,"since_last_export":true}`)
+	assert.Equal(t, fullExp.HighWaterMark.Format(time.RFC3339), delta["since"])
+	deltaExp := waitForExport(t, client, int(delta["id"].(float64)))
+	assert.Equal(t, 2, deltaExp.LeadCount)
+	require.NotNil(t, deltaExp.HighWaterMark)
+	assert.True(t, base.Add(3*time.Hour).Equal(*deltaExp.HighWaterMark))
+
+	content, err := os.ReadFile(deltaExp.FilePath)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "Changed Studio")
+	assert.Contains(t, string(content), "New Studio")
+	assert.NotContains(t, string(content), "Old Studio")
+
+	// Nothing changed since: empty delta keeps the previous mark
+	empty := createDeltaExport(t, handler, user.ID, `{"format":"csv",`+filters+`,"max_leads":100,"since_last_export":true}`)
+	emptyExp := waitForExport(t, client, int(empty["id"].(float64)))
+	assert.Equal(t, 0, emptyExp.LeadCount)
+	require.NotNil(t, emptyExp.HighWaterMark)
+	assert.True(t, deltaExp.HighWaterMark.Equal(*emptyExp.HighWaterMark))
+
+	// Different filters do not continue another sync
+	other := createDeltaExport(t, handler, user.ID, `{"format":"csv","filters":{"industry":"tattoo","country":"GB","page":1,"limit":50},"max_leads":100,"since_last_export":true}`)
+	assert.Nil(t, other["since"])
+	waitForExport(t, client, int(other["id"].(float64)))
+}
+
+func TestExportHandler_Create_DeltaResumesBetweenTiedLeads(t *testing.T) {
+	client, handler, cleanup := setupExportTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	user := createExportTestUser(t, client, "delta-ties@example.com", "pro")
+	base := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	createLead := func(name string, updatedAt time.Time) *ent.Lead {
+		l, err := client.Lead.Create().
+			SetName(name).
+			SetIndustry("tattoo").
+			SetCountry("US").
+			SetCity("Austin").
+			SetUpdatedAt(updatedAt).
+			Save(ctx)
+		require.NoError(t, err)
+		return l
+	}
+	createLead("First Studio", base)
+
+	filters := `"filters":{"industry":"tattoo","country":"US","page":1,"limit":50}`
+	full := createDeltaExport(t, handler, user.ID, `{"format":"csv",`+filters+`,"max_leads":100}`)
+	waitForExport(t, client, int(full["id"].(float64)))
+
+	// Three leads change at the same instant; a capped delta covers two
+	batch := base.Add(time.Hour)
+	createLead("Tied Studio A", batch)
+	tiedB := createLead("Tied Studio B", batch)
+	createLead("Tied Studio C", batch)
+
+	capped := createDeltaExport(t, handler, user.ID, `{"format":"csv",`+filters+`,"max_leads":2,"since_last_export":true}`)
+	cappedExp := waitForExport(t, client, int(capped["id"].(float64)))
+	assert.Equal(t, 2, cappedExp.LeadCount)
+	require.NotNil(t, cappedExp.HighWaterMark)
+	assert.True(t, batch.Equal(*cappedExp.HighWaterMark))
+	require.NotNil(t, cappedExp.HighWaterLeadID)
+	assert.Equal(t, tiedB.ID, *cappedExp.HighWaterLeadID)
+
+	// The next delta picks up the tied lead the capped one left out
+	next := createDeltaExport(t, handler, user.ID, `{"format":"csv",`+filters+`,"max_leads":100,"since_last_export":true}`)
+	nextExp := waitForExport(t, client, int(next["id"].(float64)))
+	assert.Equal(t, 1, nextExp.LeadCount)
+
+	content, err := os.ReadFile(nextExp.FilePath)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "Tied Studio C")
+	assert.NotContains(t, string(content), "Tied Studio B")
+}
+
+func TestExportHandler_Create_InvalidDeliveryURL(t *testing.T) {
+	client, handler, cleanup := setupExportTest(t)
+	defer cleanup()
+
+	user := createExportTestUser(t, client, "export@example.com", "pro")
+
+	e := echo.New()
+	body := `{"format":"csv","filters":{"page":1,"limit":50},"max_leads":100,"delivery_url":"http://169.254.169.254/latest"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/exports", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", user.ID)
+
+	require.NoError(t, handler.Create(c))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "invalid_delivery_url")
+
+	count, err := client.Export.Query().Count(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
+
+func TestExportHandler_Create_WithDeliveryURL(t *testing.T) {
+	client, handler, cleanup := setupExportTest(t)
+	defer cleanup()
+
+	user := createExportTestUser(t, client, "export@example.com", "pro")
+
+	response := createDeltaExport(t, handler, user.ID, `{"format":"csv","filters":{"page":1,"limit":50},"max_leads":100,"delivery_url":"https://crm.example.com/imports"}`)
+
+	assert.Equal(t, "https://crm.example.com/imports", response["delivery_url"])
+	assert.Equal(t, "pending", response["delivery_status"])
+	assert.Len(t, response["delivery_secret"], 64)
+}
@@ -0,0 +1,273 @@
+package handlers
+
+import (
+	stderrors "errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jordanlanch/industrydb/pkg/api/errors"
+	"github.com/jordanlanch/industrydb/pkg/api/validation"
+	"github.com/jordanlanch/industrydb/pkg/export"
+	"github.com/jordanlanch/industrydb/pkg/exporttemplate"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/jordanlanch/industrydb/pkg/savedsearch"
+	"github.com/labstack/echo/v4"
+)
+
+// ExportTemplateHandler handles export template endpoints
+type ExportTemplateHandler struct {
+	templateService *exporttemplate.Service
+	exportService   *export.Service
+	validator       *validator.Validate
+}
+
+// NewExportTemplateHandler creates a new export template handler
+func NewExportTemplateHandler(templateService *exporttemplate.Service, exportService *export.Service) *ExportTemplateHandler {
+	return &ExportTemplateHandler{
+		templateService: templateService,
+		exportService:   exportService,
+		validator:       validation.Validator(),
+	}
+}
+
+// ExportFromTemplateResponse is an export created from a template, with the
+// template filters that no longer apply
+type ExportFromTemplateResponse struct {
+	*models.ExportResponse
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// Create godoc
+// @Summary Create export template
+// @Description Save an export configuration to reuse: format, columns, max_leads and the leads to export, given by either saved_search_id (one of the user's saved searches, whose current filters are used on each export) or inline filters in saved search format. With organization_id the template is shared with every member of that organization.
+// @Tags Export Templates
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body exporttemplate.CreateRequest true "Template configuration"
+// @Success 201 {object} exporttemplate.TemplateResponse "Template created"
+// @Failure 400 {object} models.ErrorResponse "Invalid request, column, filters, or saved search"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Not a member of the organization"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /export-templates [post]
+func (h *ExportTemplateHandler) Create(c echo.Context) error {
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	var req exporttemplate.CreateRequest
+	if err := c.Bind(&req); err != nil {
+		return errors.ValidationError(c, err)
+	}
+	if err := h.validator.Struct(req); err != nil {
+		return errors.ValidationError(c, err)
+	}
+
+	template, err := h.templateService.Create(c.Request().Context(), userID, req)
+	if err != nil {
+		switch {
+		case stderrors.Is(err, exporttemplate.ErrAmbiguousSource):
+			return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "invalid_source",
+				Message: err.Error(),
+			})
+		case stderrors.Is(err, exporttemplate.ErrInvalidFilters):
+			return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "invalid_filters",
+				Message: err.Error(),
+			})
+		case stderrors.Is(err, export.ErrInvalidColumn):
+			return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "invalid_column",
+				Message: err.Error(),
+			})
+		case stderrors.Is(err, exporttemplate.ErrSavedSearchNotFound):
+			return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "invalid_saved_search",
+				Message: err.Error(),
+			})
+		case stderrors.Is(err, exporttemplate.ErrNotOrganizationMember):
+			return c.JSON(http.StatusForbidden, models.ErrorResponse{
+				Error:   "not_organization_member",
+				Message: "You are not a member of this organization",
+			})
+		}
+		return errors.InternalError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, template)
+}
+
+// List godoc
+// @Summary List export templates
+// @Description List the user's export templates and those shared with their organizations, newest first
+// @Tags Export Templates
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} map[string]interface{} "Templates and total"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /export-templates [get]
+func (h *ExportTemplateHandler) List(c echo.Context) error {
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	templates, err := h.templateService.List(c.Request().Context(), userID)
+	if err != nil {
+		return errors.InternalError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"templates": templates,
+		"total":     len(templates),
+	})
+}
+
+// Get godoc
+// @Summary Get export template
+// @Description Get an export template of the user or shared with their organizations
+// @Tags Export Templates
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Template ID"
+// @Success 200 {object} exporttemplate.TemplateResponse "Template"
+// @Failure 400 {object} models.ErrorResponse "Invalid template ID"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 404 {object} models.ErrorResponse "Template not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /export-templates/{id} [get]
+func (h *ExportTemplateHandler) Get(c echo.Context) error {
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	templateID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return invalidTemplateIDError(c)
+	}
+
+	template, err := h.templateService.Get(c.Request().Context(), templateID, userID)
+	if err != nil {
+		if stderrors.Is(err, exporttemplate.ErrTemplateNotFound) {
+			return errors.NotFoundError(c, "export template")
+		}
+		return errors.InternalError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, template)
+}
+
+// Delete godoc
+// @Summary Delete export template
+// @Description Delete an export template created by the user. Exports created from it are kept.
+// @Tags Export Templates
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Template ID"
+// @Success 204 "Template deleted"
+// @Failure 400 {object} models.ErrorResponse "Invalid template ID"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 404 {object} models.ErrorResponse "Template not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /export-templates/{id} [delete]
+func (h *ExportTemplateHandler) Delete(c echo.Context) error {
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	templateID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return invalidTemplateIDError(c)
+	}
+
+	if err := h.templateService.Delete(c.Request().Context(), templateID, userID); err != nil {
+		if stderrors.Is(err, exporttemplate.ErrTemplateNotFound) {
+			return errors.NotFoundError(c, "export template")
+		}
+		return errors.InternalError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// CreateExport godoc
+// @Summary Create export from template
+// @Description Create an export configured by an export template of the user or shared with their organizations, as POST /exports would. Template filters that no longer apply, such as removed industries, are ignored and reported in warnings.
+// @Tags Exports
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Template ID"
+// @Param Idempotency-Key header string false "Retries with the same key within the idempotency window (default 24 hours) return the first response instead of repeating the request"
+// @Success 201 {object} ExportFromTemplateResponse "Export created successfully"
+// @Failure 400 {object} models.ErrorResponse "Invalid template ID or column"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "max_leads exceeds the tier's per-export row cap (upgrade_required)"
+// @Failure 404 {object} models.ErrorResponse "Template not found"
+// @Failure 409 {object} models.ErrorResponse "The template's saved search was deleted, or none of its industries are available anymore"
+// @Failure 429 {object} models.ErrorResponse "Export queue full"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /exports/from-template/{id} [post]
+func (h *ExportTemplateHandler) CreateExport(c echo.Context) error {
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	templateID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return invalidTemplateIDError(c)
+	}
+
+	req, warnings, err := h.templateService.ExportRequest(c.Request().Context(), templateID, userID)
+	if err != nil {
+		switch {
+		case stderrors.Is(err, exporttemplate.ErrTemplateNotFound):
+			return errors.NotFoundError(c, "export template")
+		case stderrors.Is(err, exporttemplate.ErrSavedSearchDeleted):
+			return errors.ConflictError(c, "The template's saved search was deleted")
+		case stderrors.Is(err, savedsearch.ErrNoAvailableIndustries):
+			return errors.ConflictError(c, "None of the template's industries are available anymore")
+		}
+		return errors.InternalError(c, err)
+	}
+
+	// Check if user is acting as part of an organization
+	var organizationID *int
+	if orgID, hasOrgContext := c.Get("organization_id").(int); hasOrgContext {
+		organizationID = &orgID
+	}
+
+	exportResp, err := h.exportService.CreateExport(c.Request().Context(), userID, organizationID, req)
+	if err != nil {
+		return createExportError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, ExportFromTemplateResponse{
+		ExportResponse: exportResp,
+		Warnings:       warnings,
+	})
+}
+
+func invalidTemplateIDError(c echo.Context) error {
+	return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+		Error:   "invalid_id",
+		Message: "Template ID must be a number",
+	})
+}
@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/pkg/analytics"
+	exportpkg "github.com/jordanlanch/industrydb/pkg/export"
+	"github.com/jordanlanch/industrydb/pkg/exporttemplate"
+	"github.com/jordanlanch/industrydb/pkg/leads"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func setupExportTemplateTest(t *testing.T) (*ent.Client, *ExportTemplateHandler) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	analyticsService := analytics.NewService(client)
+	leadService := leads.NewService(client, nil)
+	exportService := exportpkg.NewService(client, leadService, analyticsService, t.TempDir())
+	t.Cleanup(func() {
+		// Allow async export processing goroutines to complete before closing DB
+		time.Sleep(100 * time.Millisecond)
+		client.Close()
+	})
+	return client, NewExportTemplateHandler(exporttemplate.NewService(client), exportService)
+}
+
+func exportTemplateRequest(method, body string, userID int, id string) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(method, "/", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", userID)
+	if id != "" {
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+	}
+	return c, rec
+}
+
+func TestExportTemplateHandler_Create(t *testing.T) {
+	client, handler := setupExportTemplateTest(t)
+	user := createExportTestUser(t, client, "templates@example.com", "pro")
+
+	tests := []struct {
+		name          string
+		body          string
+		expectedCode  int
+		expectedError string
+	}{
+		{"Inline filters", `{"name":"US tattoo","format":"csv","columns":["name","email"],"filters":{"industry":"tattoo","country":"US"}}`, http.StatusCreated, ""},
+		{"Missing format", `{"name":"No format","filters":{}}`, http.StatusBadRequest, "validation_error"},
+		{"No filters or saved search", `{"name":"Empty","format":"csv"}`, http.StatusBadRequest, "invalid_source"},
+		{"Unknown column", `{"name":"Columns","format":"csv","columns":["ssn"],"filters":{}}`, http.StatusBadRequest, "invalid_column"},
+		{"Unknown filter", `{"name":"Filters","format":"csv","filters":{"ssn":"1"}}`, http.StatusBadRequest, "invalid_filters"},
+		{"Another user's saved search", `{"name":"Search","format":"csv","saved_search_id":999}`, http.StatusBadRequest, "invalid_saved_search"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, rec := exportTemplateRequest(http.MethodPost, tt.body, user.ID, "")
+			require.NoError(t, handler.Create(c))
+			assert.Equal(t, tt.expectedCode, rec.Code, rec.Body.String())
+			if tt.expectedError != "" {
+				assert.Contains(t, rec.Body.String(), tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestExportTemplateHandler_CreateExport(t *testing.T) {
+	client, handler := setupExportTemplateTest(t)
+	user := createExportTestUser(t, client, "template-export@example.com", "pro")
+	other := createExportTestUser(t, client, "other-template@example.com", "pro")
+
+	c, rec := exportTemplateRequest(http.MethodPost, `{"name":"US tattoo","format":"excel","filters":{"industry":"tattoo","city":7},"max_leads":100}`, user.ID, "")
+	require.NoError(t, handler.Create(c))
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var template exporttemplate.TemplateResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &template))
+	templateID := fmt.Sprint(template.ID)
+
+	t.Run("Creates the configured export", func(t *testing.T) {
+		c, rec := exportTemplateRequest(http.MethodPost, "", user.ID, templateID)
+		require.NoError(t, handler.CreateExport(c))
+		assert.Equal(t, http.StatusCreated, rec.Code)
+
+		var response map[string]interface{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, "excel", response["format"])
+		assert.NotZero(t, response["id"])
+		assert.Len(t, response["warnings"], 1) // city has an invalid value
+	})
+
+	t.Run("Another user's template", func(t *testing.T) {
+		c, rec := exportTemplateRequest(http.MethodPost, "", other.ID, templateID)
+		require.NoError(t, handler.CreateExport(c))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("Invalid ID", func(t *testing.T) {
+		c, rec := exportTemplateRequest(http.MethodPost, "", user.ID, "abc")
+		require.NoError(t, handler.CreateExport(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
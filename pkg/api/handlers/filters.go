@@ -1,26 +1,38 @@
 package handlers
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 	"unicode"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"github.com/labstack/echo/v4"
 	"github.com/jordanlanch/industrydb/ent"
 	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+	"github.com/jordanlanch/industrydb/pkg/cache"
+	"github.com/jordanlanch/industrydb/pkg/models"
 	"golang.org/x/text/unicode/norm"
 )
 
 // FilterHandler handles filter options requests
 type FilterHandler struct {
-	db *ent.Client
+	db    *ent.Client
+	cache *cache.Client
 }
 
-// NewFilterHandler creates a new filter handler
-func NewFilterHandler(db *ent.Client) *FilterHandler {
-	return &FilterHandler{db: db}
+// NewFilterHandler creates a new filter handler.
+// cache may be nil, in which case autocomplete results are not cached.
+func NewFilterHandler(db *ent.Client, cache *cache.Client) *FilterHandler {
+	return &FilterHandler{db: db, cache: cache}
 }
 
 var (
@@ -47,17 +59,46 @@ func removeAccents(s string) string {
 	return norm.NFC.String(result)
 }
 
+// CountryCount represents a country with its lead and distinct city counts
+type CountryCount struct {
+	Country   string `json:"country"`
+	LeadCount int    `json:"lead_count"`
+	CityCount int    `json:"city_count"`
+}
+
+// countryCountsCacheKey caches the per-country aggregation
+const countryCountsCacheKey = "filters:countries:counts"
+
+// countryCountsCacheTTL is how long the per-country aggregation is cached
+const countryCountsCacheTTL = 15 * time.Minute
+
 // GetCountries godoc
 // @Summary Get list of countries
-// @Description Returns a sorted list of unique countries that have lead data in the database
+// @Description Returns a sorted list of unique countries that have lead data in the database.
+// @Description With with_counts=true, each country includes its lead count and number of distinct cities (normalized like the city list, so spelling variants count once), sorted by lead count (descending).
 // @Tags Filters
 // @Produce json
+// @Param with_counts query boolean false "Include lead and city counts per country, sorted by lead count"
 // @Success 200 {object} map[string]interface{} "List of countries with total count"
 // @Failure 500 {object} map[string]string "Internal server error"
 // @Router /leads/filters/countries [get]
 func (h *FilterHandler) GetCountries(c echo.Context) error {
 	ctx := c.Request().Context()
 
+	if withCounts, _ := strconv.ParseBool(c.QueryParam("with_counts")); withCounts {
+		counts, err := h.countryCounts(ctx)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, map[string]string{
+				"error": "Failed to fetch countries",
+			})
+		}
+
+		return c.JSON(http.StatusOK, map[string]interface{}{
+			"countries": counts,
+			"total":     len(counts),
+		})
+	}
+
 	// Query distinct countries from leads
 	var countries []string
 	err := h.db.Lead.Query().
@@ -80,6 +121,72 @@ func (h *FilterHandler) GetCountries(c echo.Context) error {
 	})
 }
 
+// countryCounts returns lead and distinct city counts per country, sorted
+// by lead count (descending). Cities are counted by the normalized name the
+// city list and autocomplete use, so spelling variants count once. Results
+// are cached.
+func (h *FilterHandler) countryCounts(ctx context.Context) ([]CountryCount, error) {
+	if h.cache != nil {
+		if cached, err := h.cache.Get(ctx, countryCountsCacheKey); err == nil && cached != "" {
+			var counts []CountryCount
+			if err := json.Unmarshal([]byte(cached), &counts); err == nil {
+				return counts, nil
+			}
+		}
+	}
+
+	var rows []struct {
+		Country   string `json:"country"`
+		City      string `json:"city"`
+		LeadCount int    `json:"lead_count"`
+	}
+	err := h.db.Lead.Query().
+		GroupBy(lead.FieldCountry, lead.FieldCity).
+		Aggregate(func(s *sql.Selector) string {
+			return sql.As(sql.Count("*"), "lead_count")
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	byCountry := make(map[string]*CountryCount)
+	cities := make(map[string]map[string]bool)
+	for _, row := range rows {
+		cc, ok := byCountry[row.Country]
+		if !ok {
+			cc = &CountryCount{Country: row.Country}
+			byCountry[row.Country] = cc
+			cities[row.Country] = make(map[string]bool)
+		}
+		cc.LeadCount += row.LeadCount
+		if normalized := normalizeCity(row.City); normalized != "" {
+			cities[row.Country][strings.ToLower(normalized)] = true
+		}
+	}
+
+	counts := make([]CountryCount, 0, len(byCountry))
+	for country, cc := range byCountry {
+		cc.CityCount = len(cities[country])
+		counts = append(counts, *cc)
+	}
+
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].LeadCount != counts[j].LeadCount {
+			return counts[i].LeadCount > counts[j].LeadCount
+		}
+		return counts[i].Country < counts[j].Country
+	})
+
+	if h.cache != nil {
+		if data, err := json.Marshal(counts); err == nil {
+			_ = h.cache.Set(ctx, countryCountsCacheKey, data, countryCountsCacheTTL)
+		}
+	}
+
+	return counts, nil
+}
+
 // normalizeCity normalizes city name for deduplication
 // Handles: whitespace, accents, case, administrative suffixes
 func normalizeCity(city string) string {
@@ -101,19 +208,121 @@ func normalizeCity(city string) string {
 	return strings.Title(strings.ToLower(noSuffix))
 }
 
+// CityCount represents a city with its lead count
+type CityCount struct {
+	City  string `json:"city"`
+	Count int    `json:"count"`
+}
+
+const (
+	// defaultCityAutocompleteLimit is the number of suggestions returned when q is set
+	defaultCityAutocompleteLimit = 10
+	// maxCityAutocompleteLimit caps the number of suggestions
+	maxCityAutocompleteLimit = 50
+	// defaultCityPageLimit is the page size of the full city list
+	defaultCityPageLimit = 100
+	// maxCityPageLimit caps the page size of the full city list
+	maxCityPageLimit = 1000
+	// cityAutocompleteCacheTTL is how long autocomplete matches are cached
+	cityAutocompleteCacheTTL = 15 * time.Minute
+)
+
 // GetCities godoc
 // @Summary Get list of cities
-// @Description Returns a sorted, deduplicated list of cities with lead data. Optionally filtered by country. City names are normalized (accents removed, title-cased).
+// @Description Returns a sorted, deduplicated, paginated list of cities with lead data. Optionally filtered by country. City names are normalized (accents removed, title-cased).
+// @Description When q is set, returns up to limit autocomplete suggestions with lead counts instead: cities starting with q come first, then cities containing q, each ordered by lead count. Matching ignores case and accents. total counts the matches found, at most 50.
 // @Tags Filters
 // @Produce json
 // @Param country query string false "Country code to filter cities (e.g., US, GB, DE)"
-// @Success 200 {object} map[string]interface{} "List of cities with total count and applied country filter"
+// @Param q query string false "Autocomplete text matched against city names (prefix first, then substring)"
+// @Param limit query int false "Suggestions to return with q (default 10, max 50), or page size without q (default 100, max 1000)"
+// @Param page query int false "Page number of the full list when q is not set (default 1)"
+// @Success 200 {object} map[string]interface{} "List of cities (or suggestions with counts) with total count and applied filters"
 // @Failure 500 {object} map[string]string "Internal server error"
 // @Router /leads/filters/cities [get]
 func (h *FilterHandler) GetCities(c echo.Context) error {
 	ctx := c.Request().Context()
 	country := c.QueryParam("country")
+	q := strings.TrimSpace(c.QueryParam("q"))
+
+	if q != "" {
+		limit := queryInt(c, "limit", defaultCityAutocompleteLimit)
+		if limit < 1 || limit > maxCityAutocompleteLimit {
+			limit = defaultCityAutocompleteLimit
+		}
+
+		matches, err := h.autocompleteCities(ctx, country, q)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, map[string]string{
+				"error": "Failed to fetch cities",
+			})
+		}
+
+		total := len(matches)
+		if len(matches) > limit {
+			matches = matches[:limit]
+		}
+
+		return c.JSON(http.StatusOK, map[string]interface{}{
+			"cities":  matches,
+			"total":   total,
+			"country": country,
+			"q":       q,
+		})
+	}
+
+	counts, err := h.cityCounts(ctx, country)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, map[string]string{
+			"error": "Failed to fetch cities",
+		})
+	}
+
+	// Sort alphabetically
+	result := make([]string, 0, len(counts))
+	for _, cc := range counts {
+		result = append(result, cc.City)
+	}
+	sort.Strings(result)
+
+	// Paginate
+	page := queryInt(c, "page", 1)
+	if page < 1 {
+		page = 1
+	}
+	limit := queryInt(c, "limit", defaultCityPageLimit)
+	if limit < 1 || limit > maxCityPageLimit {
+		limit = defaultCityPageLimit
+	}
+
+	total := len(result)
+	totalPages := (total + limit - 1) / limit
+	start := (page - 1) * limit
+	if start > total {
+		start = total
+	}
+	end := start + limit
+	if end > total {
+		end = total
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"cities":  result[start:end],
+		"total":   total,
+		"country": country,
+		"pagination": models.PaginationInfo{
+			Page:       page,
+			Limit:      limit,
+			Total:      total,
+			TotalPages: totalPages,
+			HasNext:    page < totalPages,
+			HasPrev:    page > 1,
+		},
+	})
+}
 
+// cityCounts returns normalized, deduplicated cities with their lead counts
+func (h *FilterHandler) cityCounts(ctx context.Context, country string) ([]CityCount, error) {
 	query := h.db.Lead.Query()
 
 	// Filter by country if provided
@@ -122,45 +331,195 @@ func (h *FilterHandler) GetCities(c echo.Context) error {
 	}
 
 	// Get all cities (may include duplicates due to whitespace/case issues)
-	var cities []string
-	err := query.Select(lead.FieldCity).
+	var rows []CityCount
+	err := query.
 		Where(lead.CityNEQ("")).
 		GroupBy(lead.FieldCity).
-		Scan(ctx, &cities)
-
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, map[string]string{
-			"error": "Failed to fetch cities",
-		})
+		return nil, err
 	}
 
-	// Deduplicate and normalize cities using a map
-	uniqueCities := make(map[string]string)
-	for _, city := range cities {
-		normalized := normalizeCity(city)
+	// Deduplicate and normalize cities, summing counts of variants
+	uniqueCities := make(map[string]*CityCount)
+	for _, row := range rows {
+		normalized := normalizeCity(row.City)
 		if normalized == "" {
 			continue
 		}
 		// Use lowercase as key for deduplication
 		key := strings.ToLower(normalized)
-		// Keep first occurrence (they should all normalize to same value anyway)
-		if _, exists := uniqueCities[key]; !exists {
-			uniqueCities[key] = normalized
+		if existing, exists := uniqueCities[key]; exists {
+			existing.Count += row.Count
+			continue
 		}
+		uniqueCities[key] = &CityCount{City: normalized, Count: row.Count}
 	}
 
-	// Extract unique normalized cities
-	result := make([]string, 0, len(uniqueCities))
-	for _, city := range uniqueCities {
-		result = append(result, city)
+	result := make([]CityCount, 0, len(uniqueCities))
+	for _, cc := range uniqueCities {
+		result = append(result, *cc)
 	}
 
-	// Sort alphabetically
-	sort.Strings(result)
+	return result, nil
+}
 
-	return c.JSON(http.StatusOK, map[string]interface{}{
-		"cities":  result,
-		"total":   len(result),
-		"country": country,
+// autocompleteCities returns up to maxCityAutocompleteLimit cities
+// matching q, prefix matches first, then substring matches, each ordered by
+// lead count. Matching runs in SQL with a limit, so only candidate cities are
+// loaded; the substring query only runs when there are too few prefix
+// matches. Results are cached per (country, q).
+func (h *FilterHandler) autocompleteCities(ctx context.Context, country, q string) ([]CityCount, error) {
+	needle := strings.ToLower(removeAccents(q))
+	cacheKey := fmt.Sprintf("filters:cities:%s:%s", strings.ToUpper(country), needle)
+
+	if h.cache != nil {
+		if cached, err := h.cache.Get(ctx, cacheKey); err == nil && cached != "" {
+			var matches []CityCount
+			if err := json.Unmarshal([]byte(cached), &matches); err == nil {
+				return matches, nil
+			}
+		}
+	}
+
+	pattern := cityLikePattern(needle)
+	prefix, err := h.matchCities(ctx, country, pattern+"%", func(name string) bool {
+		return strings.HasPrefix(name, needle)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	matches := prefix
+	if len(matches) < maxCityAutocompleteLimit {
+		substring, err := h.matchCities(ctx, country, "%"+pattern+"%", func(name string) bool {
+			return !strings.HasPrefix(name, needle) && strings.Contains(name, needle)
+		})
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, substring...)
+	}
+	if len(matches) > maxCityAutocompleteLimit {
+		matches = matches[:maxCityAutocompleteLimit]
+	}
+
+	if h.cache != nil {
+		if data, err := json.Marshal(matches); err == nil {
+			_ = h.cache.Set(ctx, cacheKey, data, cityAutocompleteCacheTTL)
+		}
+	}
+
+	return matches, nil
+}
+
+// cityMatchHeadroom is how many raw city rows are fetched per suggestion,
+// leaving room for spelling variants merged by normalizeCity and for
+// candidates dropped by the exact match
+const cityMatchHeadroom = 4
+
+// matchCities returns the normalized cities whose raw name matches the LIKE
+// pattern (case-insensitive) and whose lowercase normalized name passes keep,
+// ordered by lead count. At most maxCityAutocompleteLimit*cityMatchHeadroom
+// raw cities, the most common first, are read.
+func (h *FilterHandler) matchCities(ctx context.Context, country, pattern string, keep func(name string) bool) ([]CityCount, error) {
+	query := h.db.Lead.Query().Where(lead.CityNEQ(""), cityLike(pattern))
+	if country != "" {
+		query = query.Where(lead.CountryEQ(country))
+	}
+
+	var rows []CityCount
+	err := query.
+		Modify(func(s *sql.Selector) {
+			s.Select(s.C(lead.FieldCity), sql.As(sql.Count("*"), "count")).
+				GroupBy(s.C(lead.FieldCity)).
+				OrderBy(sql.Desc(sql.Count("*")), s.C(lead.FieldCity)).
+				Limit(maxCityAutocompleteLimit * cityMatchHeadroom)
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	uniqueCities := make(map[string]*CityCount)
+	matches := make([]*CityCount, 0)
+	for _, row := range rows {
+		normalized := normalizeCity(row.City)
+		key := strings.ToLower(normalized)
+		if normalized == "" || !keep(key) {
+			continue
+		}
+		if existing, exists := uniqueCities[key]; exists {
+			existing.Count += row.Count
+			continue
+		}
+		cc := &CityCount{City: normalized, Count: row.Count}
+		uniqueCities[key] = cc
+		matches = append(matches, cc)
+	}
+
+	result := make([]CityCount, 0, len(matches))
+	for _, cc := range matches {
+		result = append(result, *cc)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Count != result[j].Count {
+			return result[i].Count > result[j].Count
+		}
+		return result[i].City < result[j].City
 	})
+
+	return result, nil
+}
+
+// accentedLetters are the letters that have accented forms in city names
+const accentedLetters = "aeiouycn"
+
+// cityLikePattern turns an accent-free, lowercase needle into a LIKE pattern
+// body. Letters with accented forms match any single character, so "bogota"
+// also finds "Bogotá"; matchCities drops the false positives after
+// normalizing.
+func cityLikePattern(needle string) string {
+	var b strings.Builder
+	for _, r := range needle {
+		switch {
+		case r == '%' || r == '_' || r == '\\':
+			b.WriteRune('\\')
+			b.WriteRune(r)
+		case strings.ContainsRune(accentedLetters, r):
+			b.WriteRune('_')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// cityLike matches the city column against a lowercase LIKE pattern,
+// ignoring case (ILIKE on PostgreSQL)
+func cityLike(pattern string) predicate.Lead {
+	return func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			if b.Dialect() == dialect.Postgres {
+				b.Ident(s.C(lead.FieldCity)).WriteString(" ILIKE ").Arg(pattern)
+				return
+			}
+			b.WriteString("LOWER(").Ident(s.C(lead.FieldCity)).WriteString(") LIKE ").Arg(pattern)
+			b.WriteString(" ESCAPE ").Arg("\\")
+		}))
+	}
+}
+
+// queryInt parses an integer query parameter, returning def when absent or invalid
+func queryInt(c echo.Context, name string, def int) int {
+	value := c.QueryParam(name)
+	if value == "" {
+		return def
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return def
+	}
+	return n
 }
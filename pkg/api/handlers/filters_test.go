@@ -0,0 +1,184 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/pkg/cache"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/alicebob/miniredis/v2"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// setupFilterTest creates a test database with in-memory Redis and filter handler
+func setupFilterTest(t *testing.T) (*ent.Client, *miniredis.Miniredis, *FilterHandler, func()) {
+	client := enttest.Open(t, "sqlite3", "file:filters_test?mode=memory&cache=shared&_fk=1")
+
+	mr := miniredis.RunT(t)
+	cacheClient, err := cache.NewClient("redis://" + mr.Addr())
+	require.NoError(t, err)
+
+	handler := NewFilterHandler(client, cacheClient)
+
+	cleanup := func() {
+		cacheClient.Close()
+		client.Close()
+	}
+	return client, mr, handler, cleanup
+}
+
+// seedCityLeads creates n leads in the given city
+func seedCityLeads(t *testing.T, client *ent.Client, country, city string, n int) {
+	for i := 0; i < n; i++ {
+		_, err := client.Lead.Create().
+			SetName(city + " Studio").
+			SetIndustry(lead.IndustryTattoo).
+			SetCountry(country).
+			SetCity(city).
+			Save(context.Background())
+		require.NoError(t, err)
+	}
+}
+
+func getCities(t *testing.T, handler *FilterHandler, query string) map[string]interface{} {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/leads/filters/cities?"+query, nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	require.NoError(t, handler.GetCities(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	return response
+}
+
+func TestFilterHandler_GetCities_Autocomplete(t *testing.T) {
+	client, mr, handler, cleanup := setupFilterTest(t)
+	defer cleanup()
+
+	seedCityLeads(t, client, "US", "New York", 3)
+	seedCityLeads(t, client, "US", "new york ", 1) // variant, merged into New York
+	seedCityLeads(t, client, "US", "Newark", 5)
+	seedCityLeads(t, client, "US", "West New Market", 9)
+	seedCityLeads(t, client, "US", "Boston", 2)
+	seedCityLeads(t, client, "GB", "Newcastle", 4)
+
+	response := getCities(t, handler, "country=US&q=new")
+
+	cities := response["cities"].([]interface{})
+	require.Len(t, cities, 3)
+	// Prefix matches first (by count), then substring matches
+	assert.Equal(t, map[string]interface{}{"city": "Newark", "count": float64(5)}, cities[0])
+	assert.Equal(t, map[string]interface{}{"city": "New York", "count": float64(4)}, cities[1])
+	assert.Equal(t, map[string]interface{}{"city": "West New Market", "count": float64(9)}, cities[2])
+	assert.Equal(t, float64(3), response["total"])
+	assert.Equal(t, "new", response["q"])
+
+	// Cached per (country, q)
+	assert.True(t, mr.Exists("filters:cities:US:new"))
+
+	// Limit applies to suggestions, total reports all matches
+	response = getCities(t, handler, "country=US&q=new&limit=1")
+	assert.Len(t, response["cities"].([]interface{}), 1)
+	assert.Equal(t, float64(3), response["total"])
+}
+
+func TestFilterHandler_GetCities_AutocompleteIgnoresAccents(t *testing.T) {
+	client, _, handler, cleanup := setupFilterTest(t)
+	defer cleanup()
+
+	seedCityLeads(t, client, "CO", "Bogotá", 2)
+	seedCityLeads(t, client, "CO", "Bigotes", 3) // matches the LIKE pattern only
+
+	response := getCities(t, handler, "country=CO&q=BOGOTA")
+
+	cities := response["cities"].([]interface{})
+	require.Len(t, cities, 1)
+	assert.Equal(t, "Bogota", cities[0].(map[string]interface{})["city"])
+
+	// LIKE wildcards in q are matched literally
+	response = getCities(t, handler, "country=CO&q=%25")
+	assert.Empty(t, response["cities"])
+}
+
+func TestFilterHandler_GetCities_FullListPaginated(t *testing.T) {
+	client, _, handler, cleanup := setupFilterTest(t)
+	defer cleanup()
+
+	seedCityLeads(t, client, "US", "Austin", 1)
+	seedCityLeads(t, client, "US", "Boston", 1)
+	seedCityLeads(t, client, "US", "Chicago", 1)
+
+	response := getCities(t, handler, "country=US&limit=2&page=2")
+
+	assert.Equal(t, []interface{}{"Chicago"}, response["cities"])
+	assert.Equal(t, float64(3), response["total"])
+	pagination := response["pagination"].(map[string]interface{})
+	assert.Equal(t, float64(2), pagination["page"])
+	assert.Equal(t, float64(2), pagination["total_pages"])
+	assert.Equal(t, false, pagination["has_next"])
+	assert.Equal(t, true, pagination["has_prev"])
+}
+
+func TestFilterHandler_GetCountries_WithCounts(t *testing.T) {
+	client, mr, handler, cleanup := setupFilterTest(t)
+	defer cleanup()
+
+	seedCityLeads(t, client, "US", "Austin", 2)
+	seedCityLeads(t, client, "US", "Boston", 1)
+	seedCityLeads(t, client, "US", "boston ", 1) // variant, counted as Boston
+	seedCityLeads(t, client, "GB", "London", 4)
+	seedCityLeads(t, client, "DE", "Berlin", 1)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/leads/filters/countries?with_counts=true", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	require.NoError(t, handler.GetCountries(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response struct {
+		Countries []CountryCount `json:"countries"`
+		Total     int            `json:"total"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+
+	assert.Equal(t, 3, response.Total)
+	assert.Equal(t, []CountryCount{
+		{Country: "GB", LeadCount: 4, CityCount: 1},
+		{Country: "US", LeadCount: 4, CityCount: 2},
+		{Country: "DE", LeadCount: 1, CityCount: 1},
+	}, response.Countries)
+	assert.True(t, mr.Exists("filters:countries:counts"))
+}
+
+func TestFilterHandler_GetCountries_DefaultList(t *testing.T) {
+	client, _, handler, cleanup := setupFilterTest(t)
+	defer cleanup()
+
+	seedCityLeads(t, client, "US", "Austin", 2)
+	seedCityLeads(t, client, "GB", "London", 1)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/leads/filters/countries", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	require.NoError(t, handler.GetCountries(c))
+
+	var response map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, []interface{}{"GB", "US"}, response["countries"])
+}
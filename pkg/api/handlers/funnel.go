@@ -2,12 +2,14 @@ package handlers
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"strconv"
-	"time"
 
 	"github.com/jordanlanch/industrydb/ent"
 	"github.com/jordanlanch/industrydb/pkg/analytics"
+	"github.com/jordanlanch/industrydb/pkg/api/errors"
+	"github.com/jordanlanch/industrydb/pkg/database"
 	"github.com/jordanlanch/industrydb/pkg/models"
 	"github.com/labstack/echo/v4"
 )
@@ -15,15 +17,22 @@ import (
 // FunnelHandler handles funnel analytics operations
 type FunnelHandler struct {
 	service *analytics.Service
+	guard   *analytics.Guard
 }
 
 // NewFunnelHandler creates a new funnel analytics handler
 func NewFunnelHandler(db *ent.Client) *FunnelHandler {
 	return &FunnelHandler{
 		service: analytics.NewService(db),
+		guard:   analytics.NewGuard(nil, analytics.GuardConfig{}),
 	}
 }
 
+// SetGuard sets the range limit, timeout and cache of funnel reports
+func (h *FunnelHandler) SetGuard(guard *analytics.Guard) {
+	h.guard = guard
+}
+
 // GetFunnelMetrics godoc
 // @Summary Get conversion funnel metrics
 // @Description Get conversion rates through signup → search → export → upgrade funnel
@@ -31,14 +40,12 @@ func NewFunnelHandler(db *ent.Client) *FunnelHandler {
 // @Produce json
 // @Param days query int false "Number of days to analyze (default: 30, max: 365)"
 // @Success 200 {object} analytics.FunnelMetrics
-// @Failure 400 {object} models.ErrorResponse
+// @Failure 400 {object} models.ErrorResponse "Invalid parameters or range too large"
 // @Failure 500 {object} models.ErrorResponse
+// @Failure 504 {object} models.ErrorResponse "Query timed out"
 // @Security BearerAuth
 // @Router /api/v1/analytics/funnel/metrics [get]
 func (h *FunnelHandler) GetFunnelMetrics(c echo.Context) error {
-	ctx, cancel := context.WithTimeout(c.Request().Context(), 10*time.Second)
-	defer cancel()
-
 	// Parse days parameter
 	daysStr := c.QueryParam("days")
 	days := 30 // default
@@ -52,9 +59,17 @@ func (h *FunnelHandler) GetFunnelMetrics(c echo.Context) error {
 		}
 		days = parsedDays
 	}
+	if err := h.guard.CheckRange(days); err != nil {
+		return analyticsRangeTooLarge(c, err)
+	}
 
-	metrics, err := h.service.GetFunnelMetrics(ctx, days)
+	metrics, err := analytics.Guarded(c.Request().Context(), h.guard, fmt.Sprintf("funnel:metrics:%d", days), func(ctx context.Context) (*analytics.FunnelMetrics, error) {
+		return h.service.GetFunnelMetrics(ctx, days)
+	})
 	if err != nil {
+		if database.IsQueryTimeout(err) {
+			return errors.QueryTimeoutError(c, err)
+		}
 		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error:   "server_error",
 			Message: err.Error(),
@@ -71,14 +86,12 @@ func (h *FunnelHandler) GetFunnelMetrics(c echo.Context) error {
 // @Produce json
 // @Param days query int false "Number of days to analyze (default: 30, max: 365)"
 // @Success 200 {object} analytics.FunnelDetails
-// @Failure 400 {object} models.ErrorResponse
+// @Failure 400 {object} models.ErrorResponse "Invalid parameters or range too large"
 // @Failure 500 {object} models.ErrorResponse
+// @Failure 504 {object} models.ErrorResponse "Query timed out"
 // @Security BearerAuth
 // @Router /api/v1/analytics/funnel/details [get]
 func (h *FunnelHandler) GetFunnelDetails(c echo.Context) error {
-	ctx, cancel := context.WithTimeout(c.Request().Context(), 10*time.Second)
-	defer cancel()
-
 	// Parse days parameter
 	daysStr := c.QueryParam("days")
 	days := 30 // default
@@ -92,9 +105,17 @@ func (h *FunnelHandler) GetFunnelDetails(c echo.Context) error {
 		}
 		days = parsedDays
 	}
+	if err := h.guard.CheckRange(days); err != nil {
+		return analyticsRangeTooLarge(c, err)
+	}
 
-	details, err := h.service.GetFunnelDetails(ctx, days)
+	details, err := analytics.Guarded(c.Request().Context(), h.guard, fmt.Sprintf("funnel:details:%d", days), func(ctx context.Context) (*analytics.FunnelDetails, error) {
+		return h.service.GetFunnelDetails(ctx, days)
+	})
 	if err != nil {
+		if database.IsQueryTimeout(err) {
+			return errors.QueryTimeoutError(c, err)
+		}
 		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error:   "server_error",
 			Message: err.Error(),
@@ -111,14 +132,12 @@ func (h *FunnelHandler) GetFunnelDetails(c echo.Context) error {
 // @Produce json
 // @Param days query int false "Number of days to analyze (default: 30, max: 365)"
 // @Success 200 {object} analytics.DropoffAnalysis
-// @Failure 400 {object} models.ErrorResponse
+// @Failure 400 {object} models.ErrorResponse "Invalid parameters or range too large"
 // @Failure 500 {object} models.ErrorResponse
+// @Failure 504 {object} models.ErrorResponse "Query timed out"
 // @Security BearerAuth
 // @Router /api/v1/analytics/funnel/dropoff [get]
 func (h *FunnelHandler) GetDropoffAnalysis(c echo.Context) error {
-	ctx, cancel := context.WithTimeout(c.Request().Context(), 10*time.Second)
-	defer cancel()
-
 	// Parse days parameter
 	daysStr := c.QueryParam("days")
 	days := 30 // default
@@ -132,9 +151,17 @@ func (h *FunnelHandler) GetDropoffAnalysis(c echo.Context) error {
 		}
 		days = parsedDays
 	}
+	if err := h.guard.CheckRange(days); err != nil {
+		return analyticsRangeTooLarge(c, err)
+	}
 
-	analysis, err := h.service.GetDropoffAnalysis(ctx, days)
+	analysis, err := analytics.Guarded(c.Request().Context(), h.guard, fmt.Sprintf("funnel:dropoff:%d", days), func(ctx context.Context) (*analytics.DropoffAnalysis, error) {
+		return h.service.GetDropoffAnalysis(ctx, days)
+	})
 	if err != nil {
+		if database.IsQueryTimeout(err) {
+			return errors.QueryTimeoutError(c, err)
+		}
 		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error:   "server_error",
 			Message: err.Error(),
@@ -151,14 +178,12 @@ func (h *FunnelHandler) GetDropoffAnalysis(c echo.Context) error {
 // @Produce json
 // @Param days query int false "Number of days to analyze (default: 30, max: 365)"
 // @Success 200 {object} analytics.TimeToConversionMetrics
-// @Failure 400 {object} models.ErrorResponse
+// @Failure 400 {object} models.ErrorResponse "Invalid parameters or range too large"
 // @Failure 500 {object} models.ErrorResponse
+// @Failure 504 {object} models.ErrorResponse "Query timed out"
 // @Security BearerAuth
 // @Router /api/v1/analytics/funnel/time-to-conversion [get]
 func (h *FunnelHandler) GetTimeToConversion(c echo.Context) error {
-	ctx, cancel := context.WithTimeout(c.Request().Context(), 10*time.Second)
-	defer cancel()
-
 	// Parse days parameter
 	daysStr := c.QueryParam("days")
 	days := 30 // default
@@ -172,9 +197,17 @@ func (h *FunnelHandler) GetTimeToConversion(c echo.Context) error {
 		}
 		days = parsedDays
 	}
+	if err := h.guard.CheckRange(days); err != nil {
+		return analyticsRangeTooLarge(c, err)
+	}
 
-	timeMetrics, err := h.service.GetTimeToConversion(ctx, days)
+	timeMetrics, err := analytics.Guarded(c.Request().Context(), h.guard, fmt.Sprintf("funnel:time-to-conversion:%d", days), func(ctx context.Context) (*analytics.TimeToConversionMetrics, error) {
+		return h.service.GetTimeToConversion(ctx, days)
+	})
 	if err != nil {
+		if database.IsQueryTimeout(err) {
+			return errors.QueryTimeoutError(c, err)
+		}
 		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error:   "server_error",
 			Message: err.Error(),
@@ -183,3 +216,58 @@ func (h *FunnelHandler) GetTimeToConversion(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, timeMetrics)
 }
+
+// GetOutreachFunnel godoc
+// @Summary Get outreach funnel
+// @Description Get the attempted → connected lead outreach funnel from logged contact attempts
+// @Tags Analytics
+// @Produce json
+// @Param days query int false "Number of days to analyze (default: 30, max: 365)"
+// @Success 200 {object} analytics.OutreachFunnel
+// @Failure 400 {object} models.ErrorResponse "Invalid parameters or range too large"
+// @Failure 500 {object} models.ErrorResponse
+// @Failure 504 {object} models.ErrorResponse "Query timed out"
+// @Security BearerAuth
+// @Router /api/v1/analytics/funnel/outreach [get]
+func (h *FunnelHandler) GetOutreachFunnel(c echo.Context) error {
+	// Parse days parameter
+	daysStr := c.QueryParam("days")
+	days := 30 // default
+	if daysStr != "" {
+		parsedDays, err := strconv.Atoi(daysStr)
+		if err != nil || parsedDays < 1 || parsedDays > 365 {
+			return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "invalid_days",
+				Message: "days must be between 1 and 365",
+			})
+		}
+		days = parsedDays
+	}
+	if err := h.guard.CheckRange(days); err != nil {
+		return analyticsRangeTooLarge(c, err)
+	}
+
+	funnel, err := analytics.Guarded(c.Request().Context(), h.guard, fmt.Sprintf("funnel:outreach:%d", days), func(ctx context.Context) (*analytics.OutreachFunnel, error) {
+		return h.service.GetOutreachFunnel(ctx, days)
+	})
+	if err != nil {
+		if database.IsQueryTimeout(err) {
+			return errors.QueryTimeoutError(c, err)
+		}
+		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "server_error",
+			Message: err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, funnel)
+}
+
+// analyticsRangeTooLarge responds to a funnel, cohort or revenue report
+// covering more than the guard's maximum range
+func analyticsRangeTooLarge(c echo.Context, err error) error {
+	return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+		Error:   "range_too_large",
+		Message: err.Error(),
+	})
+}
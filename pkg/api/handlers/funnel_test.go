@@ -5,8 +5,11 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/pkg/analytics"
+	"github.com/jordanlanch/industrydb/pkg/database"
 	"github.com/jordanlanch/industrydb/pkg/models"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
@@ -111,6 +114,48 @@ func TestGetFunnelMetrics_DaysBoundary(t *testing.T) {
 	}
 }
 
+func TestGetFunnelMetrics_QueryTimeout(t *testing.T) {
+	handler, cleanup := setupFunnelHandler(t)
+	defer cleanup()
+
+	// A timeout too short for any query
+	database.SetQueryTimeout(time.Nanosecond)
+	defer database.SetQueryTimeout(0)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/analytics/funnel/metrics", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := handler.GetFunnelMetrics(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusGatewayTimeout, rec.Code)
+
+	var resp models.ErrorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, "query_timeout", resp.Error)
+}
+
+func TestGetFunnelMetrics_RangeTooLarge(t *testing.T) {
+	handler, cleanup := setupFunnelHandler(t)
+	defer cleanup()
+	handler.SetGuard(analytics.NewGuard(nil, analytics.GuardConfig{MaxRangeDays: 30}))
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/analytics/funnel/metrics?days=31", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := handler.GetFunnelMetrics(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var resp models.ErrorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, "range_too_large", resp.Error)
+	assert.Contains(t, resp.Message, "at most 30 days")
+}
+
 // --- GetFunnelDetails ---
 
 func TestGetFunnelDetails_Success(t *testing.T) {
@@ -1,11 +1,15 @@
 package handlers
 
 import (
+	"context"
+
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/playground"
 	"github.com/jordanlanch/industrydb/ent"
 	"github.com/jordanlanch/industrydb/graph"
 	"github.com/jordanlanch/industrydb/pkg/analytics"
+	"github.com/jordanlanch/industrydb/pkg/audit"
+	"github.com/jordanlanch/industrydb/pkg/auth"
 	"github.com/jordanlanch/industrydb/pkg/domain"
 	"github.com/jordanlanch/industrydb/pkg/export"
 	"github.com/jordanlanch/industrydb/pkg/leads"
@@ -42,12 +46,28 @@ func NewGraphQLHandler(
 	}
 }
 
+// SetSessionStore enables tracking the tokens issued by the register and
+// login mutations as sessions (see GET /auth/sessions)
+func (h *GraphQLHandler) SetSessionStore(sessions *auth.SessionStore) {
+	h.resolver.SessionStore = sessions
+}
+
 // GraphQLEndpoint handles GraphQL queries
 func (h *GraphQLHandler) GraphQLEndpoint(c echo.Context) error {
 	srv := handler.NewDefaultServer(graph.NewExecutableSchema(graph.Config{Resolvers: h.resolver}))
 
+	// Expose the authenticated user to resolvers (tier-aware page sizes, me, usageStats)
+	ctx := c.Request().Context()
+	if userID, ok := c.Get("user_id").(int); ok {
+		ctx = context.WithValue(ctx, "user_id", userID)
+	}
+	// Describe the client of sessions started by register and login
+	ipAddress, userAgent := audit.GetRequestContext(c)
+	ctx = context.WithValue(ctx, "ip_address", ipAddress)
+	ctx = context.WithValue(ctx, "user_agent", userAgent)
+
 	// Wrap the GraphQL handler
-	srv.ServeHTTP(c.Response(), c.Request())
+	srv.ServeHTTP(c.Response(), c.Request().WithContext(ctx))
 	return nil
 }
 
@@ -0,0 +1,156 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/pkg/audit"
+	importpkg "github.com/jordanlanch/industrydb/pkg/import"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/labstack/echo/v4"
+)
+
+// ImportJobHandler handles remote CSV import jobs
+type ImportJobHandler struct {
+	service     *importpkg.JobService
+	auditLogger *audit.Service
+}
+
+// NewImportJobHandler creates a new import job handler
+func NewImportJobHandler(service *importpkg.JobService, auditLogger *audit.Service) *ImportJobHandler {
+	return &ImportJobHandler{
+		service:     service,
+		auditLogger: auditLogger,
+	}
+}
+
+// ImportFromURL godoc
+// @Summary Import leads from a remote CSV
+// @Description Stream a leads CSV from the import S3 bucket or an HTTPS URL as a background job (admin only). Set exactly one of s3_key or url. Poll GET /admin/import/jobs/{id} for progress.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body importpkg.JobRequest true "Import source"
+// @Success 202 {object} ent.ImportJob
+// @Failure 400 {object} models.ErrorResponse "Invalid source"
+// @Failure 501 {object} models.ErrorResponse "S3 imports not configured"
+// @Failure 500 {object} models.ErrorResponse
+// @Router /admin/import/csv-url [post]
+func (h *ImportJobHandler) ImportFromURL(c echo.Context) error {
+	var req importpkg.JobRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "Invalid request body",
+		})
+	}
+
+	adminID := c.Get("user_id").(int)
+
+	job, err := h.service.Start(c.Request().Context(), adminID, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, importpkg.ErrInvalidSource):
+			return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "invalid_source",
+				Message: err.Error(),
+			})
+		case errors.Is(err, importpkg.ErrS3NotConfigured):
+			return c.JSON(http.StatusNotImplemented, models.ErrorResponse{
+				Error:   "s3_not_configured",
+				Message: "S3 imports are not configured (IMPORT_S3_BUCKET)",
+			})
+		}
+		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "server_error",
+			Message: "Failed to start import",
+		})
+	}
+
+	ipAddress, userAgent := audit.GetRequestContext(c)
+	resourceType := "lead"
+	description := fmt.Sprintf("Remote CSV import started from %s", job.SourceType)
+
+	go h.auditLogger.Log(context.Background(), audit.LogEntry{
+		UserID:       &adminID,
+		Action:       "csv_import",
+		ResourceType: &resourceType,
+		IPAddress:    &ipAddress,
+		UserAgent:    &userAgent,
+		Metadata: map[string]interface{}{
+			"import_job_id": job.ID,
+			"source_type":   string(job.SourceType),
+			"source":        job.Source,
+			"validate_only": job.ValidateOnly,
+		},
+		Severity:    "info",
+		Description: &description,
+	})
+
+	return c.JSON(http.StatusAccepted, job)
+}
+
+// GetJob godoc
+// @Summary Get import job
+// @Description Get the status and progress of a remote CSV import job (admin only)
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Import job ID"
+// @Success 200 {object} ent.ImportJob
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Router /admin/import/jobs/{id} [get]
+func (h *ImportJobHandler) GetJob(c echo.Context) error {
+	jobID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Import job ID must be a number",
+		})
+	}
+
+	job, err := h.service.Get(c.Request().Context(), jobID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_found",
+				Message: "Import job not found",
+			})
+		}
+		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "server_error",
+			Message: "Failed to load import job",
+		})
+	}
+
+	return c.JSON(http.StatusOK, job)
+}
+
+// ListJobs godoc
+// @Summary List import jobs
+// @Description List the 50 most recent remote CSV import jobs (admin only)
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} map[string]interface{}
+// @Failure 500 {object} models.ErrorResponse
+// @Router /admin/import/jobs [get]
+func (h *ImportJobHandler) ListJobs(c echo.Context) error {
+	jobs, err := h.service.List(c.Request().Context(), 50)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "server_error",
+			Message: "Failed to list import jobs",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"jobs": jobs,
+	})
+}
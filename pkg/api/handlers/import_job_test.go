@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/ent/importjob"
+	"github.com/jordanlanch/industrydb/ent/user"
+	"github.com/jordanlanch/industrydb/pkg/audit"
+	importpkg "github.com/jordanlanch/industrydb/pkg/import"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func setupImportJobTest(t *testing.T) (*ent.Client, *ImportJobHandler, *ent.User) {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	admin, err := client.User.Create().
+		SetEmail("import-job-admin@example.com").
+		SetName("Admin User").
+		SetPasswordHash("hashed_password").
+		SetRole(user.RoleAdmin).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	service := importpkg.NewJobService(client, nil, importpkg.JobConfig{})
+	return client, NewImportJobHandler(service, audit.NewService(client)), admin
+}
+
+func TestImportJobHandler_ImportFromURL_Validation(t *testing.T) {
+	client, handler, admin := setupImportJobTest(t)
+	e := echo.New()
+
+	tests := []struct {
+		name   string
+		body   string
+		status int
+		errKey string
+	}{
+		{"missing source", `{}`, http.StatusBadRequest, "invalid_source"},
+		{"http url", `{"url":"http://example.com/leads.csv"}`, http.StatusBadRequest, "invalid_source"},
+		{"loopback url", `{"url":"https://127.0.0.1/leads.csv"}`, http.StatusBadRequest, "invalid_source"},
+		{"s3 not configured", `{"s3_key":"drops/leads.csv"}`, http.StatusNotImplemented, "s3_not_configured"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/admin/import/csv-url", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", admin.ID)
+
+			require.NoError(t, handler.ImportFromURL(c))
+			assert.Equal(t, tt.status, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.errKey)
+		})
+	}
+
+	jobs, err := client.ImportJob.Query().Count(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, jobs, "rejected sources must not create jobs")
+}
+
+func TestImportJobHandler_GetJob(t *testing.T) {
+	client, handler, admin := setupImportJobTest(t)
+	e := echo.New()
+
+	job, err := client.ImportJob.Create().
+		SetUserID(admin.ID).
+		SetSourceType(importjob.SourceTypeS3).
+		SetSource("drops/leads.csv").
+		SetStatus(importjob.StatusProcessing).
+		SetBytesRead(1024).
+		SetTotalRows(500).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	t.Run("found", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/admin/import/jobs/1", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		require.NoError(t, handler.GetJob(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var got ent.ImportJob
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+		assert.Equal(t, job.ID, got.ID)
+		assert.Equal(t, importjob.StatusProcessing, got.Status)
+		assert.Equal(t, int64(1024), got.BytesRead)
+		assert.Equal(t, 500, got.TotalRows)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/admin/import/jobs/999", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues("999")
+
+		require.NoError(t, handler.GetJob(c))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/admin/import/jobs", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		require.NoError(t, handler.ListJobs(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), "drops/leads.csv")
+	})
+}
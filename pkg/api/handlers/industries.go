@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/labstack/echo/v4"
 	"github.com/jordanlanch/industrydb/pkg/industries"
@@ -19,11 +20,21 @@ func NewIndustryHandler(industryService *industries.Service) *IndustryHandler {
 	}
 }
 
+// requestLocale resolves the response language from the lang query parameter
+// or the Accept-Language header
+func requestLocale(c echo.Context) string {
+	locale := industries.ResolveLocale(c.QueryParam("lang"), c.Request().Header.Get("Accept-Language"))
+	c.Response().Header().Add(echo.HeaderVary, "Accept-Language")
+	c.Response().Header().Set("Content-Language", locale)
+	return locale
+}
+
 // ListIndustries godoc
 // @Summary List all industries
 // @Description Returns all active industries grouped by category (e.g., Personal Care, Health & Fitness, Food & Beverage)
 // @Tags Industries
 // @Produce json
+// @Param lang query string false "Response language (e.g., es); defaults to the Accept-Language header, falling back to English"
 // @Success 200 {object} map[string]interface{} "Industries grouped by category with total count"
 // @Failure 500 {object} map[string]string "Internal server error"
 // @Router /industries [get]
@@ -39,6 +50,8 @@ func (h *IndustryHandler) ListIndustries(c echo.Context) error {
 		})
 	}
 
+	industries.LocalizeCategoryResponses(requestLocale(c), categories)
+
 	return c.JSON(http.StatusOK, map[string]interface{}{
 		"categories": categories,
 		"total":      len(categories),
@@ -52,6 +65,7 @@ func (h *IndustryHandler) ListIndustries(c echo.Context) error {
 // @Produce json
 // @Param country query string false "Country code to filter (e.g., US, CO, DE)"
 // @Param city query string false "City name to filter (e.g., Bogota, New York)"
+// @Param lang query string false "Response language (e.g., es); defaults to the Accept-Language header, falling back to English"
 // @Success 200 {object} map[string]interface{} "Industries with lead counts and applied filters"
 // @Failure 500 {object} map[string]string "Internal server error"
 // @Router /industries/with-leads [get]
@@ -71,6 +85,8 @@ func (h *IndustryHandler) ListIndustriesWithLeads(c echo.Context) error {
 		})
 	}
 
+	industries.LocalizeIndustriesWithCount(requestLocale(c), industriesWithCounts)
+
 	return c.JSON(http.StatusOK, map[string]interface{}{
 		"industries": industriesWithCounts,
 		"total":      len(industriesWithCounts),
@@ -81,12 +97,81 @@ func (h *IndustryHandler) ListIndustriesWithLeads(c echo.Context) error {
 	})
 }
 
+// ListCategories godoc
+// @Summary List industry categories with lead counts
+// @Description Returns every industry category with its industries nested, both sorted by sort order, and lead counts per industry and per category. Powers hierarchical browsing.
+// @Tags Industries
+// @Produce json
+// @Param include_inactive query boolean false "Include inactive industries (default false)"
+// @Param lang query string false "Response language (e.g., es); defaults to the Accept-Language header, falling back to English"
+// @Success 200 {object} map[string]interface{} "Categories with nested industries and lead counts"
+// @Failure 400 {object} map[string]string "Invalid include_inactive"
+// @Failure 500 {object} map[string]string "Internal server error"
+// @Router /industries/categories [get]
+func (h *IndustryHandler) ListCategories(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	includeInactive := false
+	if value := c.QueryParam("include_inactive"); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, map[string]string{
+				"error":   "invalid include_inactive",
+				"message": "include_inactive must be true or false",
+			})
+		}
+		includeInactive = parsed
+	}
+
+	categories, err := h.industryService.GetCategoriesWithCounts(ctx, includeInactive)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, map[string]string{
+			"error":   "failed to fetch categories",
+			"message": err.Error(),
+		})
+	}
+
+	industries.LocalizeCategoriesWithIndustries(requestLocale(c), categories)
+
+	totalLeads := 0
+	for _, cat := range categories {
+		totalLeads += cat.LeadCount
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"categories":  categories,
+		"total":       len(categories),
+		"total_leads": totalLeads,
+	})
+}
+
+// GetConfigVersion godoc
+// @Summary Get the industry configuration version
+// @Description Returns a hash of the industry configuration (industries, sub-niches, field templates, categories and translations) with the industry and category counts. The version changes whenever the configuration does, so clients can poll it to invalidate cached industry lists without downloading them.
+// @Tags Industries
+// @Produce json
+// @Success 200 {object} industries.ConfigVersion "Configuration version"
+// @Failure 500 {object} map[string]string "Internal server error"
+// @Router /industries/version [get]
+func (h *IndustryHandler) GetConfigVersion(c echo.Context) error {
+	version, err := industries.CurrentConfigVersion()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, map[string]string{
+			"error":   "failed to compute industry version",
+			"message": err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, version)
+}
+
 // GetIndustry godoc
 // @Summary Get industry by ID
 // @Description Returns detailed information about a specific industry including OSM tags, category, and sort order
 // @Tags Industries
 // @Produce json
 // @Param id path string true "Industry ID (e.g., tattoo, beauty, gym)"
+// @Param lang query string false "Response language (e.g., es); defaults to the Accept-Language header, falling back to English"
 // @Success 200 {object} industries.IndustryResponse "Industry details"
 // @Failure 404 {object} map[string]string "Industry not found"
 // @Router /industries/{id} [get]
@@ -102,7 +187,7 @@ func (h *IndustryHandler) GetIndustry(c echo.Context) error {
 		})
 	}
 
-	return c.JSON(http.StatusOK, industries.IndustryResponse{
+	response := industries.IndustryResponse{
 		ID:                industry.ID,
 		Name:              industry.Name,
 		Category:          industry.Category,
@@ -112,7 +197,10 @@ func (h *IndustryHandler) GetIndustry(c echo.Context) error {
 		Description:       industry.Description,
 		Active:            industry.Active,
 		SortOrder:         industry.SortOrder,
-	})
+	}
+	industries.LocalizeIndustryResponse(requestLocale(c), &response)
+
+	return c.JSON(http.StatusOK, response)
 }
 
 // GetSubNiches godoc
@@ -121,6 +209,7 @@ func (h *IndustryHandler) GetIndustry(c echo.Context) error {
 // @Tags Industries
 // @Produce json
 // @Param id path string true "Industry ID (e.g., restaurant, tattoo, gym)"
+// @Param lang query string false "Response language (e.g., es); defaults to the Accept-Language header, falling back to English"
 // @Success 200 {object} map[string]interface{} "Sub-niches with counts and industry metadata"
 // @Failure 404 {object} map[string]string "Industry not found"
 // @Failure 500 {object} map[string]string "Internal server error"
@@ -157,11 +246,43 @@ func (h *IndustryHandler) GetSubNiches(c echo.Context) error {
 		})
 	}
 
+	locale := requestLocale(c)
+	industries.LocalizeSubNiches(locale, industryID, subNichesWithCounts)
+
 	return c.JSON(http.StatusOK, map[string]interface{}{
 		"industry":        industryID,
 		"has_sub_niches":  true,
-		"sub_niche_label": industryConfig.SubNicheLabel,
+		"sub_niche_label": industries.LocalizeSubNicheLabel(locale, industryID, industryConfig.SubNicheLabel),
 		"sub_niches":      subNichesWithCounts,
 		"total_count":     len(subNichesWithCounts),
 	})
 }
+
+// GetFields godoc
+// @Summary Get recommended lead fields for an industry
+// @Description Returns the structured fields recommended for leads in an industry (e.g., seating capacity for restaurants, star rating for hotels) so clients can render typed inputs. Values are stored as lead custom fields and validated against these types.
+// @Tags Industries
+// @Produce json
+// @Param id path string true "Industry ID (e.g., restaurant, hotel, gym)"
+// @Success 200 {object} map[string]interface{} "Recommended fields with industry metadata"
+// @Failure 404 {object} map[string]string "Industry not found"
+// @Router /industries/{id}/fields [get]
+func (h *IndustryHandler) GetFields(c echo.Context) error {
+	industryID := c.Param("id")
+
+	// Get industry config
+	industryConfig := industries.GetIndustryByID(industryID)
+	if industryConfig == nil {
+		return echo.NewHTTPError(http.StatusNotFound, map[string]string{
+			"error": "industry not found",
+		})
+	}
+
+	fields := industries.GetFieldTemplates(industryID)
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"industry":    industryID,
+		"fields":      fields,
+		"total_count": len(fields),
+	})
+}
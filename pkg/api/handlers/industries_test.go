@@ -186,6 +186,73 @@ func TestIndustryHandler_GetIndustry_ValidID(t *testing.T) {
 	assert.Equal(t, true, response["active"])
 }
 
+func TestIndustryHandler_GetIndustry_Localized(t *testing.T) {
+	client, handler, cleanup := setupIndustryTest(t)
+	defer cleanup()
+
+	seedIndustries(t, client)
+	industries.SetTranslations(industries.Translations{
+		"es": {Industries: map[string]industries.Translation{"tattoo": {Name: "Estudios de tatuaje"}}},
+	})
+	defer industries.SetTranslations(nil)
+
+	tests := []struct {
+		name           string
+		target         string
+		acceptLanguage string
+		locale         string
+		expectedName   string
+	}{
+		{"Accept-Language", "/api/v1/industries/tattoo", "es-CO,es;q=0.9", "es", "Estudios de tatuaje"},
+		{"Lang param", "/api/v1/industries/tattoo?lang=es", "", "es", "Estudios de tatuaje"},
+		{"Unsupported language", "/api/v1/industries/tattoo", "fr", "en", "Tattoo Studios"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			if tt.acceptLanguage != "" {
+				req.Header.Set("Accept-Language", tt.acceptLanguage)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues("tattoo")
+
+			require.NoError(t, handler.GetIndustry(c))
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, tt.locale, rec.Header().Get("Content-Language"))
+
+			var response map[string]interface{}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Equal(t, tt.expectedName, response["name"])
+			// Untranslated fields stay in English
+			assert.Equal(t, "Tattoo and body art studios", response["description"])
+		})
+	}
+}
+
+func TestIndustryHandler_GetConfigVersion(t *testing.T) {
+	_, handler, cleanup := setupIndustryTest(t)
+	defer cleanup()
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/industries/version", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	require.NoError(t, handler.GetConfigVersion(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var version industries.ConfigVersion
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &version))
+	expected, err := industries.CurrentConfigVersion()
+	require.NoError(t, err)
+	assert.Equal(t, expected, version)
+	assert.Equal(t, len(industries.AllIndustries()), version.IndustryCount)
+}
+
 func TestIndustryHandler_GetIndustry_InvalidID(t *testing.T) {
 	client, handler, cleanup := setupIndustryTest(t)
 	defer cleanup()
@@ -420,3 +487,139 @@ func TestIndustryHandler_GetSubNiches_NonExistentIndustry(t *testing.T) {
 	assert.True(t, ok)
 	assert.Equal(t, http.StatusNotFound, httpErr.Code)
 }
+
+// --- GetFields Tests ---
+
+func TestIndustryHandler_GetFields_IndustryWithFields(t *testing.T) {
+	_, handler, cleanup := setupIndustryTest(t)
+	defer cleanup()
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/industries/hotel/fields", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("hotel")
+
+	err := handler.GetFields(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response map[string]interface{}
+	err = json.Unmarshal(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+
+	assert.Equal(t, "hotel", response["industry"])
+	fields := response["fields"].([]interface{})
+	assert.Greater(t, len(fields), 0, "Hotel industry should have recommended fields")
+
+	starRating := fields[0].(map[string]interface{})
+	assert.Equal(t, "star_rating", starRating["key"])
+	assert.Equal(t, "integer", starRating["type"])
+	assert.Equal(t, float64(1), starRating["min"])
+	assert.Equal(t, float64(5), starRating["max"])
+}
+
+func TestIndustryHandler_GetFields_IndustryWithoutFields(t *testing.T) {
+	_, handler, cleanup := setupIndustryTest(t)
+	defer cleanup()
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/industries/barber/fields", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("barber")
+
+	err := handler.GetFields(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response map[string]interface{}
+	err = json.Unmarshal(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+
+	assert.Len(t, response["fields"].([]interface{}), 0)
+	assert.Equal(t, float64(0), response["total_count"])
+}
+
+func TestIndustryHandler_GetFields_NonExistentIndustry(t *testing.T) {
+	_, handler, cleanup := setupIndustryTest(t)
+	defer cleanup()
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/industries/nonexistent/fields", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("nonexistent")
+
+	err := handler.GetFields(c)
+	assert.Error(t, err)
+	httpErr, ok := err.(*echo.HTTPError)
+	assert.True(t, ok)
+	assert.Equal(t, http.StatusNotFound, httpErr.Code)
+}
+
+// --- ListCategories Tests ---
+
+func TestIndustryHandler_ListCategories(t *testing.T) {
+	client, handler, cleanup := setupIndustryTest(t)
+	defer cleanup()
+
+	seedLeads(t, client, "tattoo", "US", "New York", 5)
+	seedLeads(t, client, "beauty", "US", "New York", 2)
+	seedLeads(t, client, "restaurant", "US", "Los Angeles", 3)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/industries/categories", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := handler.ListCategories(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response struct {
+		Categories []industries.CategoryWithIndustries `json:"categories"`
+		Total      int                                 `json:"total"`
+		TotalLeads int                                 `json:"total_leads"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+
+	assert.Equal(t, len(industries.AllCategories()), response.Total)
+	assert.Equal(t, 10, response.TotalLeads)
+
+	counts := map[string]int{}
+	for i, cat := range response.Categories {
+		if i > 0 {
+			assert.Less(t, response.Categories[i-1].SortOrder, cat.SortOrder)
+		}
+		assert.Len(t, cat.Industries, len(industries.GetIndustriesByCategory(cat.ID)))
+		for _, ind := range cat.Industries {
+			counts[ind.ID] = ind.LeadCount
+		}
+		if cat.ID == "personal_care" {
+			assert.Equal(t, 7, cat.LeadCount)
+		}
+	}
+	assert.Equal(t, 5, counts["tattoo"])
+	assert.Equal(t, 3, counts["restaurant"])
+	assert.Equal(t, 0, counts["gym"])
+}
+
+func TestIndustryHandler_ListCategories_InvalidIncludeInactive(t *testing.T) {
+	_, handler, cleanup := setupIndustryTest(t)
+	defer cleanup()
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/industries/categories?include_inactive=maybe", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := handler.ListCategories(c)
+	require.Error(t, err)
+	httpErr, ok := err.(*echo.HTTPError)
+	require.True(t, ok)
+	assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+}
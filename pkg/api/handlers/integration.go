@@ -0,0 +1,183 @@
+package handlers
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jordanlanch/industrydb/config"
+	"github.com/jordanlanch/industrydb/pkg/api/errors"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/jordanlanch/industrydb/pkg/oauth"
+	"github.com/labstack/echo/v4"
+)
+
+// integrationStateTTL bounds how long a Google consent screen may stay open
+const integrationStateTTL = 10 * time.Minute
+
+// integrationStateStore keeps OAuth state tokens between connect and callback
+// (implemented by cache.Client)
+type integrationStateStore interface {
+	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error
+	Get(ctx context.Context, key string) (string, error)
+	Delete(ctx context.Context, keys ...string) error
+}
+
+// IntegrationHandler handles connecting third-party accounts used for
+// export delivery
+type IntegrationHandler struct {
+	oauthService *oauth.Service
+	config       *config.Config
+	states       integrationStateStore
+}
+
+// NewIntegrationHandler creates a new integration handler
+func NewIntegrationHandler(oauthService *oauth.Service, cfg *config.Config, states integrationStateStore) *IntegrationHandler {
+	return &IntegrationHandler{
+		oauthService: oauthService,
+		config:       cfg,
+		states:       states,
+	}
+}
+
+// List godoc
+// @Summary List connected accounts
+// @Description List the third-party accounts connected for export delivery
+// @Tags Integrations
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} map[string]interface{} "Connected accounts"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /integrations [get]
+func (h *IntegrationHandler) List(c echo.Context) error {
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	connections, err := h.oauthService.ListConnections(c.Request().Context(), userID)
+	if err != nil {
+		return errors.InternalError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"data": connections,
+	})
+}
+
+// ConnectGoogle godoc
+// @Summary Start connecting a Google account
+// @Description Returns the Google consent URL for granting Sheets access, used by google_sheets export delivery. Open it in the browser; Google redirects back to /integrations/google/callback.
+// @Tags Integrations
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} map[string]string "auth_url"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 503 {object} models.ErrorResponse "Google integration not configured"
+// @Router /integrations/google/connect [get]
+func (h *IntegrationHandler) ConnectGoogle(c echo.Context) error {
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	if h.config.GoogleClientID == "" {
+		return c.JSON(http.StatusServiceUnavailable, models.ErrorResponse{
+			Error:   "integration_unavailable",
+			Message: "Google integration is not configured",
+		})
+	}
+
+	state, err := generateStateToken()
+	if err != nil {
+		return errors.InternalError(c, err)
+	}
+
+	// The callback arrives without our JWT, the state identifies the user
+	if err := h.states.Set(c.Request().Context(), "integration_state:"+state, strconv.Itoa(userID), integrationStateTTL); err != nil {
+		return errors.InternalError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"auth_url": h.oauthService.GetGoogleSheetsAuthURL(state),
+	})
+}
+
+// GoogleCallback godoc
+// @Summary Finish connecting a Google account
+// @Description Google redirects here after consent. Stores the encrypted refresh token and redirects to the frontend integrations page with connected=google or an error.
+// @Tags Integrations
+// @Param code query string true "Authorization code"
+// @Param state query string true "State token"
+// @Success 302 "Redirect to frontend"
+// @Router /integrations/google/callback [get]
+func (h *IntegrationHandler) GoogleCallback(c echo.Context) error {
+	redirect := func(result string) error {
+		return c.Redirect(http.StatusTemporaryRedirect, h.config.FrontendURL+"/dashboard/settings/integrations?"+result)
+	}
+
+	code := c.QueryParam("code")
+	state := c.QueryParam("state")
+	if code == "" || state == "" {
+		return redirect("error=google_denied")
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 20*time.Second)
+	defer cancel()
+
+	// Verify state token (CSRF protection, one-time use)
+	storedUserID, err := h.states.Get(ctx, "integration_state:"+state)
+	if err != nil {
+		return redirect("error=invalid_state")
+	}
+	h.states.Delete(ctx, "integration_state:"+state)
+
+	userID, err := strconv.Atoi(storedUserID)
+	if err != nil {
+		return redirect("error=invalid_state")
+	}
+
+	if _, err := h.oauthService.ConnectGoogleSheets(ctx, userID, code); err != nil {
+		if stderrors.Is(err, oauth.ErrInvalidCode) {
+			return redirect("error=invalid_code")
+		}
+		c.Logger().Errorf("Google connection failed for user %d: %v", userID, err)
+		return redirect("error=google_failed")
+	}
+
+	return redirect("connected=google")
+}
+
+// DisconnectGoogle godoc
+// @Summary Disconnect the Google account
+// @Description Removes the stored Google connection; google_sheets exports fail until it is reconnected
+// @Tags Integrations
+// @Security BearerAuth
+// @Success 204 "Disconnected"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 404 {object} models.ErrorResponse "Not connected"
+// @Router /integrations/google [delete]
+func (h *IntegrationHandler) DisconnectGoogle(c echo.Context) error {
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	if err := h.oauthService.DisconnectGoogleSheets(c.Request().Context(), userID); err != nil {
+		if stderrors.Is(err, oauth.ErrNotConnected) {
+			return errors.NotFoundError(c, "Google connection")
+		}
+		return errors.InternalError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
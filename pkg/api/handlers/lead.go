@@ -5,6 +5,7 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
+	stderrors "errors"
 	"net/http"
 	"strconv"
 	"sync"
@@ -14,6 +15,8 @@ import (
 	"github.com/jordanlanch/industrydb/ent/usagelog"
 	"github.com/jordanlanch/industrydb/pkg/analytics"
 	"github.com/jordanlanch/industrydb/pkg/api/errors"
+	"github.com/jordanlanch/industrydb/pkg/api/validation"
+	"github.com/jordanlanch/industrydb/pkg/database"
 	"github.com/jordanlanch/industrydb/pkg/leads"
 	"github.com/jordanlanch/industrydb/pkg/models"
 	"github.com/labstack/echo/v4"
@@ -35,6 +38,13 @@ type LeadHandler struct {
 	leadService      *leads.Service
 	analyticsService *analytics.Service
 	validator        *validator.Validate
+	countryResolver  CountryResolver
+}
+
+// CountryResolver resolves a client IP to an ISO country code, "" when
+// unknown
+type CountryResolver interface {
+	Country(ip string) string
 }
 
 // NewLeadHandler creates a new lead handler
@@ -45,7 +55,7 @@ func NewLeadHandler(leadService *leads.Service, analyticsService *analytics.Serv
 	return &LeadHandler{
 		leadService:      leadService,
 		analyticsService: analyticsService,
-		validator:        validator.New(),
+		validator:        validation.Validator(),
 	}
 }
 
@@ -54,12 +64,23 @@ func NewLeadHandler(leadService *leads.Service, analyticsService *analytics.Serv
 func createFilterHash(req models.LeadSearchRequest) string {
 	// Create a copy without page/limit
 	hashReq := models.LeadSearchRequest{
-		Industry:  req.Industry,
-		Country:   req.Country,
-		City:      req.City,
-		HasEmail:  req.HasEmail,
-		HasPhone:  req.HasPhone,
-		Verified:  req.Verified,
+		Industry:        req.Industry,
+		Industries:      req.Industries,
+		Country:         req.Country,
+		City:            req.City,
+		HasEmail:        req.HasEmail,
+		HasPhone:        req.HasPhone,
+		HasWebsite:      req.HasWebsite,
+		HasAddress:      req.HasAddress,
+		Missing:         req.Missing,
+		Verified:        req.Verified,
+		Source:          req.Source,
+		UpdatedSince:    req.UpdatedSince,
+		MinQuality:      req.MinQuality,
+		MaxQuality:      req.MaxQuality,
+		MinCompleteness: req.MinCompleteness,
+		MaxCompleteness: req.MaxCompleteness,
+		BBox:            req.BBox,
 	}
 
 	// Marshal to JSON
@@ -70,6 +91,77 @@ func createFilterHash(req models.LeadSearchRequest) string {
 	return hex.EncodeToString(hash[:])
 }
 
+// validQualityRange reports whether the quality score bounds are consistent
+func validQualityRange(req models.LeadSearchRequest) bool {
+	return req.MinQuality == nil || req.MaxQuality == nil || *req.MinQuality <= *req.MaxQuality
+}
+
+// invalidQualityRangeError responds with 400 when min_quality exceeds max_quality
+func invalidQualityRangeError(c echo.Context) error {
+	return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+		Error:   "invalid_quality_range",
+		Message: "min_quality cannot be greater than max_quality",
+	})
+}
+
+// validCompletenessRange reports whether the completeness score bounds are consistent
+func validCompletenessRange(req models.LeadSearchRequest) bool {
+	return req.MinCompleteness == nil || req.MaxCompleteness == nil || *req.MinCompleteness <= *req.MaxCompleteness
+}
+
+// invalidCompletenessRangeError responds with 400 when min_completeness exceeds max_completeness
+func invalidCompletenessRangeError(c echo.Context) error {
+	return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+		Error:   "invalid_completeness_range",
+		Message: "min_completeness cannot be greater than max_completeness",
+	})
+}
+
+// invalidBoundingBoxError responds with 400 for a malformed bbox
+func invalidBoundingBoxError(c echo.Context, err error) error {
+	return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+		Error:   "invalid_bbox",
+		Message: err.Error(),
+	})
+}
+
+// SetCountryResolver enables defaulting the search country to the client's
+// geo-IP country
+func (h *LeadHandler) SetCountryResolver(resolver CountryResolver) {
+	h.countryResolver = resolver
+}
+
+// applyGeoCountry defaults the country of a search without one to the
+// client's geo-IP country, reported in the X-Default-Country header. It is a
+// soft default: an explicit country parameter, even empty to search
+// globally, or any other location filter disables it.
+func (h *LeadHandler) applyGeoCountry(c echo.Context, req *models.LeadSearchRequest) bool {
+	if h.countryResolver == nil || c.QueryParams().Has("country") {
+		return false
+	}
+	if req.City != "" || req.BBox != "" || req.Latitude != nil || req.Longitude != nil {
+		return false
+	}
+
+	country := h.countryResolver.Country(c.RealIP())
+	if country == "" {
+		return false
+	}
+	req.Country = country
+	c.Response().Header().Set("X-Default-Country", country)
+	return true
+}
+
+// maskContacts masks the email and phone of the leads not assigned to the
+// user when their organization's masking policy applies to them
+func (h *LeadHandler) maskContacts(c echo.Context, userID int, organizationID *int, data []models.LeadResponse) error {
+	masking, err := h.leadService.ContactMaskingFor(c.Request().Context(), userID, organizationID)
+	if err != nil {
+		return err
+	}
+	return h.leadService.MaskContacts(c.Request().Context(), masking, data)
+}
+
 // cleanupExpiredSessions removes search sessions older than 5 minutes
 func cleanupExpiredSessions() {
 	ticker := time.NewTicker(5 * time.Minute)
@@ -114,22 +206,37 @@ func createSession(sessionKey string, userID int) {
 
 // Search godoc
 // @Summary Search for business leads
-// @Description Search leads with filters (industry, location, contact info). Requires authentication.
+// @Description Search leads with filters (industry, location, contact info). Leads suppressed by the user or their organizations are hidden. When the organization masks unassigned contacts, members other than owners and admins get masked email and phone (contact_masked) on leads not assigned to them. A bbox search pages through at most 1000 leads; when more match, zoom_in is set and the client should narrow the box. Requires authentication.
 // @Tags Leads
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param industry query string false "Industry filter (tattoo, beauty, gym, restaurant)"
-// @Param country query string false "Country code (US, GB, ES, etc.)"
+// @Param industries query []string false "Match any of these industries (repeat the parameter, e.g. industries=cafe&industries=bakery)" collectionFormat(multi)
+// @Param country query string false "Country code (US, GB, ES, etc.). When geo-IP is configured and no location filter is set, defaults to the client's country (X-Default-Country header, filters.country_defaulted); pass country= to search globally"
 // @Param city query string false "City name"
-// @Param has_email query boolean false "Filter by email presence"
-// @Param has_phone query boolean false "Filter by phone presence"
+// @Param has_email query boolean false "Only leads with email when true; false doesn't filter (use missing=email)"
+// @Param has_phone query boolean false "Only leads with phone when true; false doesn't filter (use missing=phone)"
+// @Param has_website query boolean false "Only leads with website when true; false doesn't filter (use missing=website)"
+// @Param has_address query boolean false "Only leads with a street address when true; false doesn't filter (use missing=address)"
+// @Param missing query []string false "Only leads missing these fields: email, phone, website, address (repeat the parameter, e.g. missing=email&missing=phone)" collectionFormat(multi)
+// @Param verified query boolean false "Only verified (true) or unverified (false) leads. When unset, verified-only tiers (free by default) only see verified leads"
+// @Param source query string false "Acquisition source (osm, csv_import, manual)"
+// @Param updated_since query string false "Only leads created or updated after this time (RFC3339)"
+// @Param min_quality query integer false "Minimum quality score (0-100, inclusive)"
+// @Param max_quality query integer false "Maximum quality score (0-100, inclusive). Returns 400 if lower than min_quality"
+// @Param min_completeness query integer false "Minimum completeness score (0-100, inclusive): how many of email, phone, website, address and coordinates the lead has"
+// @Param max_completeness query integer false "Maximum completeness score (0-100, inclusive). Returns 400 if lower than min_completeness"
+// @Param bbox query string false "Bounding box min_lng,min_lat,max_lng,max_lat (e.g. the map viewport); leads without coordinates are excluded. Returns 400 if malformed"
+// @Param sort_by query string false "Sort order (newest, quality_score, completeness_score, distance, verified, relevance, updated_at)"
 // @Param page query integer false "Page number" default(1)
-// @Param limit query integer false "Results per page" default(50)
+// @Param limit query integer false "Results per page. Larger values are clamped to the plan's maximum page size (free/starter 100, pro 250, business 1000), flagged by pagination.limit_clamped" default(50)
 // @Success 200 {object} models.LeadListResponse "Search results"
+// @Failure 400 {object} models.ErrorResponse "Invalid filters (including min_quality > max_quality)"
 // @Failure 401 {object} models.ErrorResponse "Unauthorized"
 // @Failure 403 {object} models.ErrorResponse "Usage limit exceeded"
 // @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Failure 504 {object} models.ErrorResponse "Query timed out"
 // @Router /leads [get]
 func (h *LeadHandler) Search(c echo.Context) error {
 	// Get user ID from context (set by JWT middleware)
@@ -150,6 +257,41 @@ func (h *LeadHandler) Search(c echo.Context) error {
 	if err := h.validator.Struct(req); err != nil {
 		return errors.ValidationError(c, err)
 	}
+	if !validQualityRange(req) {
+		return invalidQualityRangeError(c)
+	}
+	if !validCompletenessRange(req) {
+		return invalidCompletenessRangeError(c)
+	}
+	if _, err := leads.ParseBoundingBox(req.BBox); err != nil {
+		return invalidBoundingBoxError(c, err)
+	}
+	// Default the country from the client's location
+	countryDefaulted := h.applyGeoCountry(c, &req)
+
+	// Hide the leads the user or their organizations suppressed
+	suppressed, err := h.leadService.SuppressedLeadIDs(c.Request().Context(), userID)
+	if err != nil {
+		return errors.InternalError(c, err)
+	}
+	req.ExcludeLeadIDs = suppressed
+
+	// Clamp oversized pages to the tier's maximum page size
+	var organizationID *int
+	if orgID, hasOrgContext := c.Get("organization_id").(int); hasOrgContext {
+		organizationID = &orgID
+	}
+	tier, err := h.leadService.GetExportTier(c.Request().Context(), userID, organizationID)
+	if err != nil {
+		return errors.InternalError(c, err)
+	}
+	req.MaxLimit = leads.GetMaxPageSizeForTier(tier)
+	// Verified-only tiers see verified leads unless the filter is set
+	leads.ApplyVerifiedDefault(&req, tier)
+	// Leave out the fields the tier only gets from the lead detail view
+	req.HiddenFields = leads.GetSearchHiddenFieldsForTier(tier)
+	// Limit scoped deployments to the organization's accessible leads
+	req.OrgScope = organizationID
 
 	// Create hash of filters (excluding page/limit) to identify search session
 	filterHash := createFilterHash(req)
@@ -165,12 +307,12 @@ func (h *LeadHandler) Search(c echo.Context) error {
 		if hasOrgContext {
 			// Use organization usage limits
 			if err := h.leadService.CheckAndIncrementOrganizationUsage(c.Request().Context(), orgID, 1); err != nil {
-				return errors.ForbiddenError(c, "usage_limit_exceeded")
+				return usageError(c, err)
 			}
 		} else {
 			// Use personal usage limits
 			if err := h.leadService.CheckAndIncrementUsage(c.Request().Context(), userID, 1); err != nil {
-				return errors.ForbiddenError(c, "usage_limit_exceeded")
+				return usageError(c, err)
 			}
 		}
 		// Create session for this search
@@ -178,20 +320,23 @@ func (h *LeadHandler) Search(c echo.Context) error {
 	}
 
 	// Execute search
-	results, err := h.leadService.Search(c.Request().Context(), req)
+	ctx, cancel := database.WithQueryTimeout(c.Request().Context())
+	defer cancel()
+	results, err := h.leadService.Search(ctx, req)
 	if err != nil {
 		return errors.InternalError(c, err)
 	}
+	if err := h.maskContacts(c, userID, organizationID, results.Data); err != nil {
+		return errors.InternalError(c, err)
+	}
+	results.Filters.CountryDefaulted = countryDefaulted
 
 	// Log usage for analytics (async, don't block on error)
 	go func() {
-		metadata := map[string]interface{}{
-			"industry":  req.Industry,
-			"country":   req.Country,
-			"city":      req.City,
+		metadata := analytics.TargetingMetadata(map[string]interface{}{
 			"has_email": req.HasEmail,
 			"has_phone": req.HasPhone,
-		}
+		}, req.Industry, req.Industries, req.Country, req.City)
 		h.analyticsService.LogUsage(context.Background(), userID, usagelog.ActionSearch, len(results.Data), metadata)
 	}()
 
@@ -200,7 +345,7 @@ func (h *LeadHandler) Search(c echo.Context) error {
 
 // GetByID godoc
 // @Summary Get lead by ID
-// @Description Retrieve detailed information about a specific lead. Requires authentication.
+// @Description Retrieve detailed information about a specific lead. Email and phone are masked (contact_masked) when the organization's masking policy applies to the caller and the lead isn't assigned to them. When lead scoping is on, leads not visible to the caller's organization (or, without one, leads an organization owns) are not found. Requires authentication.
 // @Tags Leads
 // @Accept json
 // @Produce json
@@ -208,7 +353,7 @@ func (h *LeadHandler) Search(c echo.Context) error {
 // @Param id path integer true "Lead ID"
 // @Success 200 {object} map[string]interface{} "Lead details"
 // @Failure 401 {object} models.ErrorResponse "Unauthorized"
-// @Failure 404 {object} models.ErrorResponse "Lead not found"
+// @Failure 404 {object} models.ErrorResponse "Lead not found or not visible to the organization"
 // @Failure 500 {object} models.ErrorResponse "Internal server error"
 // @Router /leads/{id} [get]
 func (h *LeadHandler) GetByID(c echo.Context) error {
@@ -236,17 +381,135 @@ func (h *LeadHandler) GetByID(c echo.Context) error {
 	if hasOrgContext {
 		// Use organization usage limits
 		if err := h.leadService.CheckAndIncrementOrganizationUsage(c.Request().Context(), orgID, 1); err != nil {
-			return errors.ForbiddenError(c, "usage_limit_exceeded")
+			return usageError(c, err)
 		}
 	} else {
 		// Use personal usage limits
 		if err := h.leadService.CheckAndIncrementUsage(c.Request().Context(), userID, 1); err != nil {
-			return errors.ForbiddenError(c, "usage_limit_exceeded")
+			return usageError(c, err)
+		}
+	}
+
+	var organizationID *int
+	if hasOrgContext {
+		organizationID = &orgID
+	}
+
+	// Get lead, if visible to the organization when lead scoping is on
+	lead, err := h.leadService.GetByID(c.Request().Context(), leadID, organizationID)
+	if err != nil {
+		if stderrors.Is(err, leads.ErrLeadNotFound) {
+			return errors.NotFoundError(c, "lead")
+		}
+		return errors.InternalError(c, err)
+	}
+	masked := []models.LeadResponse{*lead}
+	if err := h.maskContacts(c, userID, organizationID, masked); err != nil {
+		return errors.InternalError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, masked[0])
+}
+
+// BatchGet godoc
+// @Summary Get leads by IDs
+// @Description Retrieve up to 100 leads by ID in one call, e.g. to refresh leads an integration already knows. Duplicate IDs are ignored. IDs that don't exist or aren't visible to the caller are listed in not_found. Each lead returned uses one credit; the request is rejected without charging if the credits don't cover them.
+// @Tags Leads
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body leads.BatchGetRequest true "Lead IDs"
+// @Success 200 {object} leads.BatchGetResponse "Leads found, in request order"
+// @Failure 400 {object} models.ErrorResponse "Invalid or too many IDs"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Usage limit exceeded"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /leads/batch-get [post]
+func (h *LeadHandler) BatchGet(c echo.Context) error {
+	// Get user ID from context
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	var req leads.BatchGetRequest
+	if err := c.Bind(&req); err != nil {
+		return errors.ValidationError(c, err)
+	}
+	if err := h.validator.Struct(req); err != nil {
+		return errors.ValidationError(c, err)
+	}
+
+	// Limit scoped deployments to the organization's accessible leads
+	var organizationID *int
+	orgID, hasOrgContext := c.Get("organization_id").(int)
+	if hasOrgContext {
+		organizationID = &orgID
+	}
+
+	result, err := h.leadService.GetByIDs(c.Request().Context(), req.IDs, organizationID)
+	if err != nil {
+		if stderrors.Is(err, leads.ErrBatchTooLarge) || stderrors.Is(err, leads.ErrBatchEmpty) {
+			return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "invalid_batch",
+				Message: err.Error(),
+			})
 		}
+		return errors.InternalError(c, err)
 	}
 
-	// Get lead
-	lead, err := h.leadService.GetByID(c.Request().Context(), leadID)
+	// One credit per lead returned
+	if found := len(result.Data); found > 0 {
+		if hasOrgContext {
+			// Use organization usage limits
+			if err := h.leadService.CheckAndIncrementOrganizationUsage(c.Request().Context(), orgID, found); err != nil {
+				return usageError(c, err)
+			}
+		} else {
+			// Use personal usage limits
+			if err := h.leadService.CheckAndIncrementUsage(c.Request().Context(), userID, found); err != nil {
+				return usageError(c, err)
+			}
+		}
+	}
+	if err := h.maskContacts(c, userID, organizationID, result.Data); err != nil {
+		return errors.InternalError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// GetHistory godoc
+// @Summary Get lead change history
+// @Description Get field-level changes (old/new value, actor, source, timestamp) made to a lead by manual edits, enrichment and verification, newest first
+// @Tags Leads
+// @Produce json
+// @Security BearerAuth
+// @Param id path integer true "Lead ID"
+// @Param limit query integer false "Maximum entries to return (default 50, max 200)"
+// @Success 200 {array} leads.ChangeEntry "Change history"
+// @Failure 400 {object} models.ErrorResponse "Invalid lead ID"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 404 {object} models.ErrorResponse "Lead not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /leads/{id}/history [get]
+func (h *LeadHandler) GetHistory(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	leadID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Lead ID must be a number",
+		})
+	}
+
+	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+
+	history, err := h.leadService.GetChangeHistory(ctx, leadID, limit)
 	if err != nil {
 		if err.Error() == "lead not found" {
 			return errors.NotFoundError(c, "lead")
@@ -254,7 +517,7 @@ func (h *LeadHandler) GetByID(c echo.Context) error {
 		return errors.InternalError(c, err)
 	}
 
-	return c.JSON(http.StatusOK, lead)
+	return c.JSON(http.StatusOK, history)
 }
 
 // Preview godoc
@@ -265,17 +528,31 @@ func (h *LeadHandler) GetByID(c echo.Context) error {
 // @Produce json
 // @Security BearerAuth
 // @Param industry query string false "Industry filter (tattoo, beauty, gym, restaurant)"
-// @Param country query string false "Country code (US, GB, ES, etc.)"
+// @Param industries query []string false "Match any of these industries (repeat the parameter, e.g. industries=cafe&industries=bakery)" collectionFormat(multi)
+// @Param country query string false "Country code (US, GB, ES, etc.). Defaults to the client's geo-IP country as in search; pass country= to search globally"
 // @Param city query string false "City name"
-// @Param has_email query boolean false "Filter by email presence"
-// @Param has_phone query boolean false "Filter by phone presence"
+// @Param has_email query boolean false "Only leads with email when true; false doesn't filter (use missing=email)"
+// @Param has_phone query boolean false "Only leads with phone when true; false doesn't filter (use missing=phone)"
+// @Param has_website query boolean false "Only leads with website when true; false doesn't filter (use missing=website)"
+// @Param has_address query boolean false "Only leads with a street address when true; false doesn't filter (use missing=address)"
+// @Param missing query []string false "Only leads missing these fields: email, phone, website, address (repeat the parameter, e.g. missing=email&missing=phone)" collectionFormat(multi)
+// @Param verified query boolean false "Only verified (true) or unverified (false) leads. When unset, verified-only tiers (free by default) only see verified leads"
+// @Param source query string false "Acquisition source (osm, csv_import, manual)"
+// @Param updated_since query string false "Only leads created or updated after this time (RFC3339)"
+// @Param min_quality query integer false "Minimum quality score (0-100, inclusive)"
+// @Param max_quality query integer false "Maximum quality score (0-100, inclusive). Returns 400 if lower than min_quality"
+// @Param min_completeness query integer false "Minimum completeness score (0-100, inclusive): how many of email, phone, website, address and coordinates the lead has"
+// @Param max_completeness query integer false "Maximum completeness score (0-100, inclusive). Returns 400 if lower than min_completeness"
+// @Param bbox query string false "Bounding box min_lng,min_lat,max_lng,max_lat (e.g. the map viewport); leads without coordinates are excluded. Returns 400 if malformed"
 // @Success 200 {object} models.LeadPreviewResponse "Preview statistics"
+// @Failure 400 {object} models.ErrorResponse "Invalid filters (including min_quality > max_quality)"
 // @Failure 401 {object} models.ErrorResponse "Unauthorized"
 // @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Failure 504 {object} models.ErrorResponse "Query timed out"
 // @Router /leads/preview [get]
 func (h *LeadHandler) Preview(c echo.Context) error {
 	// Get user ID from context (authentication required, but no credit charge)
-	_, ok := c.Get("user_id").(int)
+	userID, ok := c.Get("user_id").(int)
 	if !ok {
 		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
 			Error: "unauthorized",
@@ -292,12 +569,294 @@ func (h *LeadHandler) Preview(c echo.Context) error {
 	if err := h.validator.Struct(req); err != nil {
 		return errors.ValidationError(c, err)
 	}
+	if !validQualityRange(req) {
+		return invalidQualityRangeError(c)
+	}
+	if !validCompletenessRange(req) {
+		return invalidCompletenessRangeError(c)
+	}
+	if _, err := leads.ParseBoundingBox(req.BBox); err != nil {
+		return invalidBoundingBoxError(c, err)
+	}
+	h.applyGeoCountry(c, &req)
+
+	var organizationID *int
+	if orgID, hasOrgContext := c.Get("organization_id").(int); hasOrgContext {
+		organizationID = &orgID
+	}
+	req.OrgScope = organizationID
+
+	tier, err := h.leadService.GetExportTier(c.Request().Context(), userID, organizationID)
+	if err != nil {
+		return errors.InternalError(c, err)
+	}
+	leads.ApplyVerifiedDefault(&req, tier)
 
 	// Execute preview (NO credit charge, NO usage check)
-	preview, err := h.leadService.Preview(c.Request().Context(), req)
+	ctx, cancel := database.WithQueryTimeout(c.Request().Context())
+	defer cancel()
+	preview, err := h.leadService.Preview(ctx, req)
 	if err != nil {
 		return errors.InternalError(c, err)
 	}
 
+	// Warn before the tier's per-export row cap bites
+	leads.ApplyExportRowCap(preview, tier)
+
 	return c.JSON(http.StatusOK, preview)
 }
+
+// Count godoc
+// @Summary Count search results
+// @Description Count the leads matching a search without charging credits, for pagination totals. Large results (over 10,000 leads by planner estimate) return a fast estimate from database statistics with exact=false; smaller results are counted exactly. Leads suppressed by the user or their organizations are not counted.
+// @Tags Leads
+// @Produce json
+// @Security BearerAuth
+// @Param industry query string false "Industry filter (tattoo, beauty, gym, restaurant)"
+// @Param industries query []string false "Match any of these industries (repeat the parameter, e.g. industries=cafe&industries=bakery)" collectionFormat(multi)
+// @Param country query string false "Country code (US, GB, ES, etc.). Defaults to the client's geo-IP country as in search; pass country= to search globally"
+// @Param city query string false "City name"
+// @Param has_email query boolean false "Only leads with email when true; false doesn't filter (use missing=email)"
+// @Param has_phone query boolean false "Only leads with phone when true; false doesn't filter (use missing=phone)"
+// @Param has_website query boolean false "Only leads with website when true; false doesn't filter (use missing=website)"
+// @Param has_address query boolean false "Only leads with a street address when true; false doesn't filter (use missing=address)"
+// @Param missing query []string false "Only leads missing these fields: email, phone, website, address (repeat the parameter, e.g. missing=email&missing=phone)" collectionFormat(multi)
+// @Param verified query boolean false "Only verified (true) or unverified (false) leads. When unset, verified-only tiers (free by default) only see verified leads"
+// @Param source query string false "Acquisition source (osm, csv_import, manual)"
+// @Param updated_since query string false "Only leads created or updated after this time (RFC3339)"
+// @Param min_quality query integer false "Minimum quality score (0-100, inclusive)"
+// @Param max_quality query integer false "Maximum quality score (0-100, inclusive). Returns 400 if lower than min_quality"
+// @Param min_completeness query integer false "Minimum completeness score (0-100, inclusive): how many of email, phone, website, address and coordinates the lead has"
+// @Param max_completeness query integer false "Maximum completeness score (0-100, inclusive). Returns 400 if lower than min_completeness"
+// @Param bbox query string false "Bounding box min_lng,min_lat,max_lng,max_lat (e.g. the map viewport); leads without coordinates are excluded. Returns 400 if malformed"
+// @Success 200 {object} models.LeadCountResponse "Exact or estimated count"
+// @Failure 400 {object} models.ErrorResponse "Invalid filters (including min_quality > max_quality)"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Failure 504 {object} models.ErrorResponse "Query timed out"
+// @Router /leads/count [get]
+func (h *LeadHandler) Count(c echo.Context) error {
+	// Get user ID from context (authentication required, but no credit charge)
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	// Parse query parameters (same as Search); pagination does not apply
+	var req models.LeadSearchRequest
+	if err := c.Bind(&req); err != nil {
+		return errors.ValidationError(c, err)
+	}
+	req.Page, req.Limit = 1, 1
+
+	// Validate request
+	if err := h.validator.Struct(req); err != nil {
+		return errors.ValidationError(c, err)
+	}
+	if !validQualityRange(req) {
+		return invalidQualityRangeError(c)
+	}
+	if !validCompletenessRange(req) {
+		return invalidCompletenessRangeError(c)
+	}
+	if _, err := leads.ParseBoundingBox(req.BBox); err != nil {
+		return invalidBoundingBoxError(c, err)
+	}
+	h.applyGeoCountry(c, &req)
+
+	// Count what Search would return
+	suppressed, err := h.leadService.SuppressedLeadIDs(c.Request().Context(), userID)
+	if err != nil {
+		return errors.InternalError(c, err)
+	}
+	req.ExcludeLeadIDs = suppressed
+	if orgID, hasOrgContext := c.Get("organization_id").(int); hasOrgContext {
+		req.OrgScope = &orgID
+	}
+	tier, err := h.leadService.GetExportTier(c.Request().Context(), userID, req.OrgScope)
+	if err != nil {
+		return errors.InternalError(c, err)
+	}
+	leads.ApplyVerifiedDefault(&req, tier)
+
+	ctx, cancel := database.WithQueryTimeout(c.Request().Context())
+	defer cancel()
+	count, err := h.leadService.EstimateCount(ctx, req)
+	if err != nil {
+		return errors.InternalError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, count)
+}
+
+// Clusters godoc
+// @Summary Cluster leads for map display
+// @Description Aggregate the leads inside a bounding box for a map at a zoom level, without charging credits. Below zoom 15 leads are bucketed into a grid of about 64px cells anchored at -180,-90 and each cluster has its centroid, count and industry breakdown (lead_id when it is a single lead). From zoom 15 leads are returned individually (id, name, industry, coordinates), at most 1000 with zoom_in set when more match. The search filters apply. Results are cached for 5 minutes. Requires authentication.
+// @Tags Leads
+// @Produce json
+// @Security BearerAuth
+// @Param bbox query string true "Bounding box min_lng,min_lat,max_lng,max_lat (the map viewport)"
+// @Param zoom query integer true "Map zoom level (0-22)"
+// @Param industry query string false "Industry (tattoo, beauty, gym, restaurant)"
+// @Param industries query []string false "Match any of these industries (repeat the parameter, e.g. industries=cafe&industries=bakery)" collectionFormat(multi)
+// @Param country query string false "Country code (US, GB, ES, etc.)"
+// @Param city query string false "City name"
+// @Param has_email query boolean false "Only leads with email when true; false doesn't filter (use missing=email)"
+// @Param has_phone query boolean false "Only leads with phone when true; false doesn't filter (use missing=phone)"
+// @Param verified query boolean false "Only verified (true) or unverified (false) leads. When unset, verified-only tiers (free by default) only see verified leads"
+// @Param min_quality query integer false "Minimum quality score (0-100, inclusive)"
+// @Param max_quality query integer false "Maximum quality score (0-100, inclusive). Returns 400 if lower than min_quality"
+// @Success 200 {object} leads.LeadClusterResponse "Clusters or individual leads"
+// @Failure 400 {object} models.ErrorResponse "Missing or invalid bbox or zoom, or invalid filters"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Failure 504 {object} models.ErrorResponse "Query timed out"
+// @Router /leads/clusters [get]
+func (h *LeadHandler) Clusters(c echo.Context) error {
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	// Parse query parameters (same as Search); pagination does not apply
+	var req models.LeadSearchRequest
+	if err := c.Bind(&req); err != nil {
+		return errors.ValidationError(c, err)
+	}
+	req.Page, req.Limit = 1, 1
+
+	if err := h.validator.Struct(req); err != nil {
+		return errors.ValidationError(c, err)
+	}
+	if !validQualityRange(req) {
+		return invalidQualityRangeError(c)
+	}
+	if !validCompletenessRange(req) {
+		return invalidCompletenessRangeError(c)
+	}
+	if req.BBox == "" {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_bbox",
+			Message: "bbox is required",
+		})
+	}
+	if _, err := leads.ParseBoundingBox(req.BBox); err != nil {
+		return invalidBoundingBoxError(c, err)
+	}
+	zoom, err := strconv.Atoi(c.QueryParam("zoom"))
+	if err != nil || zoom < 0 || zoom > leads.MaxClusterZoom {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_zoom",
+			Message: "zoom must be an integer between 0 and 22",
+		})
+	}
+
+	// Cluster what Search would return
+	suppressed, err := h.leadService.SuppressedLeadIDs(c.Request().Context(), userID)
+	if err != nil {
+		return errors.InternalError(c, err)
+	}
+	req.ExcludeLeadIDs = suppressed
+	if orgID, hasOrgContext := c.Get("organization_id").(int); hasOrgContext {
+		req.OrgScope = &orgID
+	}
+	tier, err := h.leadService.GetExportTier(c.Request().Context(), userID, req.OrgScope)
+	if err != nil {
+		return errors.InternalError(c, err)
+	}
+	leads.ApplyVerifiedDefault(&req, tier)
+
+	ctx, cancel := database.WithQueryTimeout(c.Request().Context())
+	defer cancel()
+	clusters, err := h.leadService.Clusters(ctx, req, zoom)
+	if err != nil {
+		return errors.InternalError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, clusters)
+}
+
+// PublicPreview godoc
+// @Summary Preview leads without an account
+// @Description Return a small sample of leads for an industry and city with masked email and phone. No authentication required; heavily rate limited per IP.
+// @Tags Leads
+// @Accept json
+// @Produce json
+// @Param industry query string true "Industry (tattoo, beauty, gym, restaurant, ...)"
+// @Param city query string true "City name"
+// @Param country query string false "Country code (US, GB, ES, etc.)"
+// @Success 200 {object} leads.PublicPreviewResponse "Masked lead sample"
+// @Failure 400 {object} models.ErrorResponse "Missing or invalid industry/city"
+// @Failure 429 {object} models.ErrorResponse "Rate limit exceeded"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /public/leads/preview [get]
+func (h *LeadHandler) PublicPreview(c echo.Context) error {
+	var req models.PublicLeadPreviewRequest
+	if err := c.Bind(&req); err != nil {
+		return errors.ValidationError(c, err)
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		return errors.ValidationError(c, err)
+	}
+
+	// Anonymous: no usage check, the response only carries masked contacts
+	preview, err := h.leadService.PublicPreview(c.Request().Context(), req.Industry, req.Country, req.City)
+	if err != nil {
+		return errors.InternalError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, preview)
+}
+
+// RecomputeQualityRequest represents a request to recompute lead quality scores
+type RecomputeQualityRequest struct {
+	LeadIDs []int `json:"lead_ids,omitempty"`
+}
+
+// RecomputeQuality godoc
+// @Summary Recompute lead quality scores
+// @Description Recalculate quality scores from lead completeness using the configured rubric, along with completeness scores (admin only). Recomputes the given leads, or every lead when lead_ids is empty.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body RecomputeQualityRequest false "Optional lead IDs to recompute"
+// @Success 200 {object} leads.QualityRecomputeResult "Recompute results"
+// @Failure 400 {object} models.ErrorResponse "Invalid request"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden - Admin access required"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /admin/leads/recompute-quality [post]
+func (h *LeadHandler) RecomputeQuality(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Minute)
+	defer cancel()
+
+	var req RecomputeQualityRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "Invalid request body",
+		})
+	}
+
+	result, err := h.leadService.RecomputeQualityScores(ctx, req.LeadIDs)
+	if err != nil {
+		return errors.InternalError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// usageError answers a failed usage increment: 403 when the limit is
+// reached, 500 when usage couldn't be recorded
+func usageError(c echo.Context, err error) error {
+	if stderrors.Is(err, leads.ErrUsageLimitExceeded) {
+		return errors.ForbiddenError(c, "usage_limit_exceeded")
+	}
+	return errors.InternalError(c, err)
+}
@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/pkg/leads"
+	"github.com/labstack/echo/v4"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeadHandler_BatchGet(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:lead_batch_test?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	u := client.User.Create().
+		SetEmail("batch@example.com").
+		SetPasswordHash("hash").
+		SetName("Batch").
+		SetUsageLimit(3).
+		SetLastResetAt(time.Now()).
+		SaveX(t.Context())
+	var ids []int
+	for _, name := range []string{"One", "Two"} {
+		l := client.Lead.Create().
+			SetName(name).
+			SetIndustry(lead.IndustryTattoo).
+			SetCountry("US").
+			SetCity("Austin").
+			SaveX(t.Context())
+		ids = append(ids, l.ID)
+	}
+
+	handler := NewLeadHandler(leads.NewService(client, nil), nil)
+	e := echo.New()
+
+	call := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/leads/batch-get", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", u.ID)
+		require.NoError(t, handler.BatchGet(c))
+		return rec
+	}
+	usage := func() int {
+		return client.User.GetX(t.Context(), u.ID).UsageCount
+	}
+
+	t.Run("One credit per lead returned", func(t *testing.T) {
+		body, _ := json.Marshal(map[string][]int{"ids": {ids[0], ids[1], ids[0], 99999}})
+		rec := call(string(body))
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		var resp leads.BatchGetResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Len(t, resp.Data, 2)
+		assert.Equal(t, []int{99999}, resp.NotFound)
+		assert.Equal(t, 2, usage())
+	})
+
+	t.Run("Not found IDs are free", func(t *testing.T) {
+		rec := call(`{"ids": [99998, 99999]}`)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, 2, usage())
+	})
+
+	t.Run("Credits must cover every lead returned", func(t *testing.T) {
+		body, _ := json.Marshal(map[string][]int{"ids": ids})
+		rec := call(string(body))
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		assert.Equal(t, 2, usage())
+	})
+
+	t.Run("Invalid batches", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, call(`{"ids": []}`).Code)
+		assert.Equal(t, http.StatusBadRequest, call(`{"ids": [0]}`).Code)
+
+		tooMany := make([]int, 0, leads.MaxBatchGetIDs+1)
+		for i := 1; i <= leads.MaxBatchGetIDs+1; i++ {
+			tooMany = append(tooMany, i)
+		}
+		body, _ := json.Marshal(map[string][]int{"ids": tooMany})
+		assert.Equal(t, http.StatusBadRequest, call(string(body)).Code)
+	})
+}
@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/organizationmember"
+	"github.com/jordanlanch/industrydb/pkg/leads"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/labstack/echo/v4"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeadHandler_GetByID_ContactMasking(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:lead_masking_test?mode=memory&_fk=1")
+	defer client.Close()
+
+	newUser := func(email string) int {
+		return client.User.Create().
+			SetEmail(email).
+			SetPasswordHash("hash").
+			SetName(email).
+			SaveX(t.Context()).ID
+	}
+	owner := newUser("owner@example.com")
+	junior := newUser("junior@example.com")
+
+	org := client.Organization.Create().
+		SetName("Sales Team").
+		SetSlug("sales-team").
+		SetOwnerID(owner).
+		SetUsageLimit(100).
+		SetLastResetAt(time.Now()).
+		SetMaskUnassignedContacts(true).
+		SaveX(t.Context())
+	client.OrganizationMember.Create().SetOrganizationID(org.ID).SetUserID(junior).SetRole(organizationmember.RoleMember).SaveX(t.Context())
+
+	l := client.Lead.Create().
+		SetName("Masked Ink").
+		SetIndustry(lead.IndustryTattoo).
+		SetCountry("US").
+		SetCity("Austin").
+		SetEmail("owner@maskedink.com").
+		SetPhone("+1 512-555-0100").
+		SaveX(t.Context())
+
+	handler := NewLeadHandler(leads.NewService(client, nil), nil)
+	e := echo.New()
+
+	get := func(userID int) models.LeadResponse {
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/leads/%d", l.ID), nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", userID)
+		c.Set("organization_id", org.ID)
+		c.SetParamNames("id")
+		c.SetParamValues(fmt.Sprintf("%d", l.ID))
+		require.NoError(t, handler.GetByID(c))
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var resp models.LeadResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		return resp
+	}
+
+	t.Run("Masked for members on unassigned leads", func(t *testing.T) {
+		resp := get(junior)
+		assert.True(t, resp.ContactMasked)
+		assert.Equal(t, "o****@m********.com", resp.Email)
+		assert.Equal(t, "+* ***-***-**00", resp.Phone)
+	})
+
+	t.Run("Unmasked for the owner", func(t *testing.T) {
+		resp := get(owner)
+		assert.False(t, resp.ContactMasked)
+		assert.Equal(t, "owner@maskedink.com", resp.Email)
+	})
+
+	t.Run("Unmasked once assigned", func(t *testing.T) {
+		client.LeadAssignment.Create().SetLeadID(l.ID).SetUserID(junior).SaveX(t.Context())
+
+		resp := get(junior)
+		assert.False(t, resp.ContactMasked)
+		assert.Equal(t, "+1 512-555-0100", resp.Phone)
+	})
+}
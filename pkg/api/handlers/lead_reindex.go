@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/auditlog"
+	"github.com/jordanlanch/industrydb/pkg/api/errors"
+	"github.com/jordanlanch/industrydb/pkg/api/validation"
+	"github.com/jordanlanch/industrydb/pkg/audit"
+	"github.com/jordanlanch/industrydb/pkg/leads"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/labstack/echo/v4"
+)
+
+// LeadReindexHandler handles lead reindex jobs
+type LeadReindexHandler struct {
+	service     *leads.ReindexService
+	auditLogger *audit.Service
+}
+
+// NewLeadReindexHandler creates a new lead reindex handler
+func NewLeadReindexHandler(service *leads.ReindexService, auditLogger *audit.Service) *LeadReindexHandler {
+	return &LeadReindexHandler{
+		service:     service,
+		auditLogger: auditLogger,
+	}
+}
+
+// ReindexRequest represents a request to reindex lead data
+type ReindexRequest struct {
+	DryRun bool `json:"dry_run"` // Only count leads with drifted data
+}
+
+// StartReindex godoc
+// @Summary Reindex lead data
+// @Description Recompute derived lead data (quality and completeness scores, precomputed per-industry counts) in batches as a background job (admin only). Safe to run online. With dry_run the job only counts the leads whose data drifted. Poll GET /admin/leads/reindex/{id} for progress.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body ReindexRequest false "Reindex options"
+// @Success 202 {object} ent.LeadReindexJob
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 409 {object} models.ErrorResponse "Reindex already running"
+// @Failure 500 {object} models.ErrorResponse
+// @Router /admin/leads/reindex [post]
+func (h *LeadReindexHandler) StartReindex(c echo.Context) error {
+	var req ReindexRequest
+	if err := validation.Bind(c, &req); err != nil {
+		return errors.ValidationError(c, err)
+	}
+
+	adminID := c.Get("user_id").(int)
+
+	job, err := h.service.Start(c.Request().Context(), adminID, req.DryRun)
+	if err != nil {
+		if stderrors.Is(err, leads.ErrReindexRunning) {
+			return c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "reindex_running",
+				Message: "A lead reindex is already running",
+			})
+		}
+		return errors.InternalError(c, err)
+	}
+
+	ipAddress, userAgent := audit.GetRequestContext(c)
+	resourceType := "lead"
+	description := fmt.Sprintf("Lead reindex started (dry run: %t)", job.DryRun)
+
+	go h.auditLogger.Log(context.Background(), audit.LogEntry{
+		UserID:       &adminID,
+		Action:       auditlog.ActionLeadReindex,
+		ResourceType: &resourceType,
+		IPAddress:    &ipAddress,
+		UserAgent:    &userAgent,
+		Metadata: map[string]interface{}{
+			"reindex_job_id": job.ID,
+			"dry_run":        job.DryRun,
+		},
+		Severity:    "info",
+		Description: &description,
+	})
+
+	return c.JSON(http.StatusAccepted, job)
+}
+
+// GetReindexJob godoc
+// @Summary Get lead reindex job
+// @Description Get the status and progress of a lead reindex job (admin only). affected counts the leads updated, or that a dry run would update.
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Reindex job ID"
+// @Success 200 {object} ent.LeadReindexJob
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Router /admin/leads/reindex/{id} [get]
+func (h *LeadReindexHandler) GetReindexJob(c echo.Context) error {
+	jobID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Reindex job ID must be a number",
+		})
+	}
+
+	job, err := h.service.Get(c.Request().Context(), jobID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_found",
+				Message: "Reindex job not found",
+			})
+		}
+		return errors.InternalError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, job)
+}
+
+// ListReindexJobs godoc
+// @Summary List lead reindex jobs
+// @Description List the 50 most recent lead reindex jobs (admin only)
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} map[string]interface{}
+// @Failure 500 {object} models.ErrorResponse
+// @Router /admin/leads/reindex [get]
+func (h *LeadReindexHandler) ListReindexJobs(c echo.Context) error {
+	jobs, err := h.service.List(c.Request().Context(), 50)
+	if err != nil {
+		return errors.InternalError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"jobs": jobs,
+	})
+}
@@ -1,11 +1,23 @@
 package handlers
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
 	"testing"
 	"time"
 
+	"github.com/go-playground/validator/v10"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/user"
+	"github.com/jordanlanch/industrydb/pkg/analytics"
+	"github.com/jordanlanch/industrydb/pkg/leads"
 	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func boolPtr(b bool) *bool {
@@ -63,6 +75,18 @@ func TestCreateFilterHash(t *testing.T) {
 			},
 			shouldMatch: false,
 		},
+		{
+			name: "Different industry lists should not match",
+			req1: models.LeadSearchRequest{
+				Industries: []string{"cafe", "bakery"},
+				Country:    "US",
+			},
+			req2: models.LeadSearchRequest{
+				Industries: []string{"cafe"},
+				Country:    "US",
+			},
+			shouldMatch: false,
+		},
 		{
 			name: "Different countries should not match",
 			req1: models.LeadSearchRequest{
@@ -166,3 +190,429 @@ func TestHashConsistency(t *testing.T) {
 	// Hash should be hex-encoded SHA256 (64 characters)
 	assert.Len(t, hash1, 64, "SHA256 hash should be 64 hex characters")
 }
+
+func TestSearch_QualityRangeValidation(t *testing.T) {
+	// Validation runs before any service call, so no lead service is needed
+	h := &LeadHandler{validator: validator.New()}
+	e := echo.New()
+
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+		wantError  string
+	}{
+		{"min greater than max", "min_quality=80&max_quality=20", http.StatusBadRequest, "invalid_quality_range"},
+		{"min out of range", "min_quality=101", http.StatusBadRequest, "validation_error"},
+		{"max out of range", "max_quality=-1", http.StatusBadRequest, "validation_error"},
+		{"malformed bbox", "bbox=-74.1,40.6,-73.9", http.StatusBadRequest, "invalid_bbox"},
+		{"bbox latitude out of range", "bbox=-74.1,40.6,-73.9,95", http.StatusBadRequest, "invalid_bbox"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/leads?page=1&limit=10&"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", 1)
+
+			require.NoError(t, h.Search(c))
+			assert.Equal(t, tt.wantStatus, rec.Code)
+
+			var resp models.ErrorResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, tt.wantError, resp.Error)
+		})
+	}
+}
+
+func TestClusters_Validation(t *testing.T) {
+	// Validation runs before any service call, so no lead service is needed
+	h := &LeadHandler{validator: validator.New()}
+	e := echo.New()
+
+	tests := []struct {
+		name      string
+		query     string
+		wantError string
+	}{
+		{"missing bbox", "zoom=5", "invalid_bbox"},
+		{"malformed bbox", "bbox=-75,40,-70&zoom=5", "invalid_bbox"},
+		{"missing zoom", "bbox=-75,40,-70,43", "invalid_zoom"},
+		{"zoom out of range", "bbox=-75,40,-70,43&zoom=23", "invalid_zoom"},
+		{"invalid filters", "bbox=-75,40,-70,43&zoom=5&min_quality=80&max_quality=20", "invalid_quality_range"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/leads/clusters?"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", 1)
+
+			require.NoError(t, h.Clusters(c))
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+			var resp models.ErrorResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, tt.wantError, resp.Error)
+		})
+	}
+}
+
+func TestSearch_ClampsPageSizeByTier(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:lead_page_size_test?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	leads.SetTierMaxPageSizes(leads.TierMaxPageSizes{"free": 2, "business": 3})
+	t.Cleanup(func() { leads.SetTierMaxPageSizes(nil) })
+
+	for _, name := range []string{"Ink One", "Ink Two", "Ink Three", "Ink Four"} {
+		client.Lead.Create().
+			SetName(name).
+			SetIndustry(lead.IndustryTattoo).
+			SetCountry("US").
+			SetCity("Austin").
+			SetVerified(true).
+			SaveX(t.Context())
+	}
+	free := client.User.Create().
+		SetEmail("free@example.com").
+		SetPasswordHash("hash").
+		SetName("Free").
+		SaveX(t.Context())
+	business := client.User.Create().
+		SetEmail("business@example.com").
+		SetPasswordHash("hash").
+		SetName("Business").
+		SetSubscriptionTier(user.SubscriptionTierBusiness).
+		SetUsageLimit(10000).
+		SaveX(t.Context())
+
+	h := NewLeadHandler(leads.NewService(client, nil), analytics.NewService(client))
+	e := echo.New()
+
+	search := func(userID int, query string) models.LeadListResponse {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/leads?page=1&"+query, nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", userID)
+
+		require.NoError(t, h.Search(c))
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		var resp models.LeadListResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		return resp
+	}
+
+	t.Run("over-limit request is clamped, not rejected", func(t *testing.T) {
+		resp := search(free.ID, "limit=100000")
+		assert.Len(t, resp.Data, 2)
+		assert.Equal(t, 2, resp.Pagination.Limit)
+		assert.True(t, resp.Pagination.LimitClamped)
+		assert.Equal(t, 100000, resp.Pagination.RequestedLimit)
+	})
+
+	t.Run("business tier pages larger", func(t *testing.T) {
+		resp := search(business.ID, "limit=100000")
+		assert.Len(t, resp.Data, 3)
+		assert.Equal(t, 3, resp.Pagination.Limit)
+		assert.True(t, resp.Pagination.LimitClamped)
+	})
+
+	t.Run("request within the limit is not flagged", func(t *testing.T) {
+		resp := search(business.ID, "limit=3")
+		assert.Len(t, resp.Data, 3)
+		assert.False(t, resp.Pagination.LimitClamped)
+		assert.Zero(t, resp.Pagination.RequestedLimit)
+	})
+}
+
+// fakeCountryResolver maps client IPs to countries
+type fakeCountryResolver map[string]string
+
+func (f fakeCountryResolver) Country(ip string) string { return f[ip] }
+
+func TestSearch_GeoCountryDefault(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:lead_geo_country_test?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	for _, l := range []struct{ name, country, city string }{
+		{"London Ink", "GB", "London"},
+		{"Austin Ink", "US", "Austin"},
+	} {
+		client.Lead.Create().
+			SetName(l.name).
+			SetIndustry(lead.IndustryTattoo).
+			SetCountry(l.country).
+			SetCity(l.city).
+			SetVerified(true).
+			SaveX(t.Context())
+	}
+	u := client.User.Create().
+		SetEmail("geo@example.com").
+		SetPasswordHash("hash").
+		SetName("Geo").
+		SetSubscriptionTier(user.SubscriptionTierBusiness).
+		SetUsageLimit(10000).
+		SaveX(t.Context())
+
+	h := NewLeadHandler(leads.NewService(client, nil), analytics.NewService(client))
+	h.SetCountryResolver(fakeCountryResolver{"81.2.69.142": "GB"})
+	e := echo.New()
+
+	search := func(ip, query string) (models.LeadListResponse, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/leads?page=1&limit=10&"+query, nil)
+		req.Header.Set(echo.HeaderXForwardedFor, ip+", 10.0.0.1")
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", u.ID)
+
+		require.NoError(t, h.Search(c))
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		var resp models.LeadListResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		return resp, rec
+	}
+	names := func(resp models.LeadListResponse) []string {
+		var names []string
+		for _, l := range resp.Data {
+			names = append(names, l.Name)
+		}
+		return names
+	}
+
+	t.Run("defaults to the forwarded client's country", func(t *testing.T) {
+		resp, rec := search("81.2.69.142", "industry=tattoo")
+		assert.Equal(t, []string{"London Ink"}, names(resp))
+		assert.Equal(t, "GB", resp.Filters.Country)
+		assert.True(t, resp.Filters.CountryDefaulted)
+		assert.Equal(t, "GB", rec.Header().Get("X-Default-Country"))
+	})
+
+	t.Run("explicit country overrides", func(t *testing.T) {
+		resp, rec := search("81.2.69.142", "industry=tattoo&country=US")
+		assert.Equal(t, []string{"Austin Ink"}, names(resp))
+		assert.False(t, resp.Filters.CountryDefaulted)
+		assert.Empty(t, rec.Header().Get("X-Default-Country"))
+	})
+
+	t.Run("empty country searches globally", func(t *testing.T) {
+		resp, _ := search("81.2.69.142", "industry=tattoo&country=")
+		assert.ElementsMatch(t, []string{"London Ink", "Austin Ink"}, names(resp))
+		assert.False(t, resp.Filters.CountryDefaulted)
+	})
+
+	t.Run("other location filters disable it", func(t *testing.T) {
+		resp, _ := search("81.2.69.142", "industry=tattoo&city=Austin")
+		assert.Equal(t, []string{"Austin Ink"}, names(resp))
+		assert.False(t, resp.Filters.CountryDefaulted)
+	})
+
+	t.Run("unknown location searches globally", func(t *testing.T) {
+		resp, rec := search("198.51.100.7", "industry=tattoo")
+		assert.ElementsMatch(t, []string{"London Ink", "Austin Ink"}, names(resp))
+		assert.False(t, resp.Filters.CountryDefaulted)
+		assert.Empty(t, rec.Header().Get("X-Default-Country"))
+	})
+}
+
+func TestSearch_VerifiedDefaultByTier(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:lead_verified_default_test?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	for _, l := range []struct {
+		name     string
+		verified bool
+		quality  int
+	}{
+		{"Verified Ink", true, 80},
+		{"Unverified Ink", false, 70},
+		{"Sketchy Ink", false, 10},
+	} {
+		client.Lead.Create().
+			SetName(l.name).
+			SetIndustry(lead.IndustryTattoo).
+			SetCountry("US").
+			SetCity("Austin").
+			SetVerified(l.verified).
+			SetQualityScore(l.quality).
+			SaveX(t.Context())
+	}
+	free := client.User.Create().
+		SetEmail("free-verified@example.com").
+		SetPasswordHash("hash").
+		SetName("Free").
+		SaveX(t.Context())
+	business := client.User.Create().
+		SetEmail("business-verified@example.com").
+		SetPasswordHash("hash").
+		SetName("Business").
+		SetSubscriptionTier(user.SubscriptionTierBusiness).
+		SetUsageLimit(10000).
+		SaveX(t.Context())
+
+	h := NewLeadHandler(leads.NewService(client, nil), analytics.NewService(client))
+	e := echo.New()
+
+	search := func(userID int, query string) []string {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/leads?page=1&limit=10&sort_by=quality_score&"+query, nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", userID)
+
+		require.NoError(t, h.Search(c))
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		var resp models.LeadListResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		names := make([]string, len(resp.Data))
+		for i, l := range resp.Data {
+			names[i] = l.Name
+		}
+		return names
+	}
+
+	t.Run("free search excludes unverified leads by default", func(t *testing.T) {
+		assert.Equal(t, []string{"Verified Ink"}, search(free.ID, "industry=tattoo"))
+	})
+
+	t.Run("business search includes unverified leads by default", func(t *testing.T) {
+		assert.Equal(t, []string{"Verified Ink", "Unverified Ink", "Sketchy Ink"}, search(business.ID, "industry=tattoo"))
+	})
+
+	t.Run("free users can override the default", func(t *testing.T) {
+		assert.Equal(t, []string{"Unverified Ink", "Sketchy Ink"}, search(free.ID, "industry=tattoo&verified=false"))
+	})
+
+	t.Run("min_quality applies on top of the default", func(t *testing.T) {
+		assert.Equal(t, []string{"Verified Ink"}, search(free.ID, "industry=tattoo&min_quality=50"))
+		assert.Equal(t, []string{"Verified Ink", "Unverified Ink"}, search(business.ID, "industry=tattoo&min_quality=50"))
+	})
+}
+
+func TestSearch_FieldProjectionByTier(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:lead_projection_test?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	contact := client.Lead.Create().
+		SetName("Contact Ink").
+		SetIndustry(lead.IndustryTattoo).
+		SetCountry("US").
+		SetCity("Austin").
+		SetEmail("hello@contact.ink").
+		SetPhone("+1-512-555-0100").
+		SetWebsite("https://contact.ink").
+		SetVerified(true).
+		SaveX(t.Context())
+	free := client.User.Create().
+		SetEmail("free-projection@example.com").
+		SetPasswordHash("hash").
+		SetName("Free").
+		SaveX(t.Context())
+	pro := client.User.Create().
+		SetEmail("pro-projection@example.com").
+		SetPasswordHash("hash").
+		SetName("Pro").
+		SetSubscriptionTier(user.SubscriptionTierPro).
+		SetUsageLimit(2000).
+		SaveX(t.Context())
+
+	h := NewLeadHandler(leads.NewService(client, nil), analytics.NewService(client))
+	e := echo.New()
+
+	search := func(userID int) map[string]interface{} {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/leads?industry=tattoo&page=1&limit=10", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", userID)
+
+		require.NoError(t, h.Search(c))
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		var resp map[string]interface{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		return resp
+	}
+	firstLead := func(resp map[string]interface{}) map[string]interface{} {
+		data := resp["data"].([]interface{})
+		require.Len(t, data, 1)
+		return data[0].(map[string]interface{})
+	}
+
+	t.Run("free results omit email and phone", func(t *testing.T) {
+		resp := search(free.ID)
+		assert.Equal(t, []interface{}{"email", "phone"}, resp["hidden_fields"])
+		l := firstLead(resp)
+		assert.NotContains(t, l, "email")
+		assert.NotContains(t, l, "phone")
+		assert.Equal(t, "https://contact.ink", l["website"])
+	})
+
+	t.Run("paid results include them inline", func(t *testing.T) {
+		resp := search(pro.ID)
+		assert.NotContains(t, resp, "hidden_fields")
+		l := firstLead(resp)
+		assert.Equal(t, "hello@contact.ink", l["email"])
+		assert.Equal(t, "+1-512-555-0100", l["phone"])
+	})
+
+	t.Run("free detail view includes them", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/leads/"+strconv.Itoa(contact.ID), nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(strconv.Itoa(contact.ID))
+		c.Set("user_id", free.ID)
+
+		require.NoError(t, h.GetByID(c))
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		var l models.LeadResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &l))
+		assert.Equal(t, "hello@contact.ink", l.Email)
+		assert.Equal(t, "+1-512-555-0100", l.Phone)
+	})
+}
+
+func TestValidQualityRange(t *testing.T) {
+	low, high := 20, 80
+	assert.True(t, validQualityRange(models.LeadSearchRequest{}))
+	assert.True(t, validQualityRange(models.LeadSearchRequest{MinQuality: &low}))
+	assert.True(t, validQualityRange(models.LeadSearchRequest{MinQuality: &low, MaxQuality: &high}))
+	assert.True(t, validQualityRange(models.LeadSearchRequest{MinQuality: &low, MaxQuality: &low}))
+	assert.False(t, validQualityRange(models.LeadSearchRequest{MinQuality: &high, MaxQuality: &low}))
+}
+
+
+func TestPublicPreview_Validation(t *testing.T) {
+	// Validation runs before any service call, so no lead service is needed
+	h := &LeadHandler{validator: validator.New()}
+	e := echo.New()
+
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{"missing industry", "city=Austin"},
+		{"unknown industry", "industry=casino&city=Austin"},
+		{"missing city", "industry=tattoo"},
+		{"invalid country", "industry=tattoo&city=Austin&country=USA"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/public/leads/preview?"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			require.NoError(t, h.PublicPreview(c))
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+			var resp models.ErrorResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, "validation_error", resp.Error)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"strconv"
 	"time"
@@ -28,9 +29,24 @@ func NewLeadAssignmentHandler(db *ent.Client, auditLogger *audit.Service) *LeadA
 	}
 }
 
+// SetNotifications sets how assigned reps are told about new assignments:
+// email through notifier and lead.assigned events through webhooks, on the
+// channels each rep's preferences allow. Any of them may be nil.
+func (h *LeadAssignmentHandler) SetNotifications(notifier leadassignment.Notifier, webhooks leadassignment.WebhookTrigger, preferences leadassignment.PreferenceChecker) {
+	if notifier != nil {
+		h.service.SetNotifier(notifier)
+	}
+	if webhooks != nil {
+		h.service.SetWebhookTrigger(webhooks)
+	}
+	if preferences != nil {
+		h.service.SetPreferences(preferences)
+	}
+}
+
 // AssignLead godoc
 // @Summary Manually assign lead to user
-// @Description Assign a lead to a specific user with a reason
+// @Description Assign a lead to a specific user with a reason. The user is emailed and their webhooks receive lead.assigned, as their lead_assigned notification preferences allow, except when assigning to themselves.
 // @Tags Lead Assignment
 // @Accept json
 // @Produce json
@@ -103,12 +119,15 @@ func (h *LeadAssignmentHandler) AssignLead(c echo.Context) error {
 		Severity:     auditlog.SeverityInfo,
 	})
 
+	// Tell the assigned rep (non-blocking)
+	go h.service.NotifyAssignment(context.Background(), result, userID)
+
 	return c.JSON(http.StatusOK, result)
 }
 
 // AutoAssignLead godoc
 // @Summary Auto-assign lead using round-robin
-// @Description Automatically assign lead to user with fewest active leads
+// @Description Automatically assign lead to user with fewest active leads. The selected user is notified like a manual assignment.
 // @Tags Lead Assignment
 // @Produce json
 // @Param id path int true "Lead ID"
@@ -173,9 +192,142 @@ func (h *LeadAssignmentHandler) AutoAssignLead(c echo.Context) error {
 		Severity:     auditlog.SeverityInfo,
 	})
 
+	// Tell the assigned rep (non-blocking)
+	go h.service.NotifyAssignment(context.Background(), result, userID)
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// ClaimLead godoc
+// @Summary Claim a lead from the shared pool
+// @Description Assign a lead to yourself if nobody holds it, so others don't work it. The check is atomic: of concurrent claims only one succeeds. Claiming a lead you already hold returns your current assignment. Claims are recorded in the assignment history and, when a claim timeout is configured, expire after that long without activity by the claimer.
+// @Tags Lead Assignment
+// @Produce json
+// @Param id path int true "Lead ID"
+// @Success 200 {object} leadassignment.AssignmentResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 409 {object} models.ErrorResponse "Lead already claimed or assigned"
+// @Failure 500 {object} models.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/leads/{id}/claim [post]
+func (h *LeadAssignmentHandler) ClaimLead(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 10*time.Second)
+	defer cancel()
+
+	// Get lead ID from path
+	leadID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_lead_id",
+			Message: "Lead ID must be a valid number",
+		})
+	}
+
+	userID := c.Get("user_id").(int)
+
+	result, err := h.service.ClaimLead(ctx, leadID, userID)
+	if err != nil {
+		if errors.Is(err, leadassignment.ErrLeadClaimed) {
+			return c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "lead_claimed",
+				Message: "Lead is already claimed or assigned to someone else",
+			})
+		}
+		if err.Error() == "lead not found" || err.Error() == "user not found" {
+			return c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_found",
+				Message: err.Error(),
+			})
+		}
+		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "server_error",
+			Message: err.Error(),
+		})
+	}
+
+	h.logClaim(c, userID, result.ID, "Claimed lead from shared pool")
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// ReleaseLead godoc
+// @Summary Release a claimed lead
+// @Description End your claim on a lead, returning it to the shared pool. Only claims can be released, not assignments made by someone else.
+// @Tags Lead Assignment
+// @Produce json
+// @Param id path int true "Lead ID"
+// @Success 200 {object} leadassignment.AssignmentResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 409 {object} models.ErrorResponse "Lead not claimed by you"
+// @Failure 500 {object} models.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/leads/{id}/release [post]
+func (h *LeadAssignmentHandler) ReleaseLead(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 10*time.Second)
+	defer cancel()
+
+	// Get lead ID from path
+	leadID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_lead_id",
+			Message: "Lead ID must be a valid number",
+		})
+	}
+
+	userID := c.Get("user_id").(int)
+
+	result, err := h.service.ReleaseLead(ctx, leadID, userID)
+	if err != nil {
+		if errors.Is(err, leadassignment.ErrNotClaimed) {
+			return c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "not_claimed",
+				Message: "You don't hold a claim on this lead",
+			})
+		}
+		if err.Error() == "lead not found" {
+			return c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_found",
+				Message: err.Error(),
+			})
+		}
+		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "server_error",
+			Message: err.Error(),
+		})
+	}
+
+	h.logClaim(c, userID, result.ID, "Released claimed lead")
+
 	return c.JSON(http.StatusOK, result)
 }
 
+// logClaim audit-logs a claim or release (non-blocking)
+func (h *LeadAssignmentHandler) logClaim(c echo.Context, userID, assignmentID int, description string) {
+	resourceType := "lead_assignment"
+	resourceID := strconv.Itoa(assignmentID)
+	ipAddress := c.RealIP()
+	userAgent := c.Request().UserAgent()
+	go h.auditLogger.Log(context.Background(), audit.LogEntry{
+		UserID:       &userID,
+		Action:       auditlog.ActionDataExport, // Reuse existing action
+		ResourceType: &resourceType,
+		ResourceID:   &resourceID,
+		IPAddress:    &ipAddress,
+		UserAgent:    &userAgent,
+		Description:  &description,
+		Severity:     auditlog.SeverityInfo,
+	})
+}
+
+// SetClaimTimeout makes lead claims expire after timeout without activity
+// by the claimer. Zero keeps claims until released.
+func (h *LeadAssignmentHandler) SetClaimTimeout(timeout time.Duration) {
+	h.service.SetClaimTimeout(timeout)
+}
+
 // GetUserLeads godoc
 // @Summary Get user's assigned leads
 // @Description Get all active leads assigned to the current user
@@ -411,3 +411,61 @@ func TestLeadAssignmentHandler_GetUserLeads_Success(t *testing.T) {
 	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
 	assert.Len(t, resp, 2)
 }
+
+// --- ClaimLead / ReleaseLead ---
+
+func TestLeadAssignmentHandler_ClaimAndRelease(t *testing.T) {
+	client := setupLeadAssignmentTestDB(t)
+	defer client.Close()
+
+	rep1 := createAssignmentTestUser(t, client, "claimer1@b.com", "Claimer 1", true)
+	rep2 := createAssignmentTestUser(t, client, "claimer2@b.com", "Claimer 2", true)
+	lead := createAssignmentTestLead(t, client, "Pool Studio")
+
+	handler := newAssignmentHandler(client)
+	call := func(action func(echo.Context) error, leadID string, userID int) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/leads/"+leadID+"/claim", nil)
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(leadID)
+		c.Set("user_id", userID)
+		require.NoError(t, action(c))
+		return rec
+	}
+	leadID := strconv.Itoa(lead.ID)
+
+	rec := call(handler.ClaimLead, leadID, rep1.ID)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var claim leadassignment.AssignmentResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &claim))
+	assert.Equal(t, "claim", claim.AssignmentType)
+	assert.Equal(t, rep1.ID, claim.UserID)
+
+	// Already claimed
+	rec = call(handler.ClaimLead, leadID, rep2.ID)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	var errResp models.ErrorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &errResp))
+	assert.Equal(t, "lead_claimed", errResp.Error)
+
+	// Only the claimer can release
+	rec = call(handler.ReleaseLead, leadID, rep2.ID)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+
+	rec = call(handler.ReleaseLead, leadID, rep1.ID)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var released leadassignment.AssignmentResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &released))
+	assert.False(t, released.IsActive)
+	assert.Equal(t, "released", released.EndReason)
+
+	rec = call(handler.ClaimLead, leadID, rep2.ID)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = call(handler.ClaimLead, "99999", rep1.ID)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = call(handler.ReleaseLead, "abc", rep1.ID)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
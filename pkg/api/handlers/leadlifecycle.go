@@ -9,6 +9,7 @@ import (
 	"github.com/jordanlanch/industrydb/ent"
 	"github.com/jordanlanch/industrydb/pkg/audit"
 	"github.com/jordanlanch/industrydb/pkg/leadlifecycle"
+	custommiddleware "github.com/jordanlanch/industrydb/pkg/middleware"
 	"github.com/jordanlanch/industrydb/pkg/models"
 	"github.com/labstack/echo/v4"
 )
@@ -214,7 +215,7 @@ func (h *LeadLifecycleHandler) GetLeadsByStatus(c echo.Context) error {
 	}
 
 	// Get leads
-	leads, err := h.service.GetLeadsByStatus(ctx, status, limit)
+	leads, err := h.service.GetLeadsByStatus(ctx, status, limit, custommiddleware.LeadScopePredicates(c)...)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error:   "internal_error",
@@ -225,6 +226,49 @@ func (h *LeadLifecycleHandler) GetLeadsByStatus(c echo.Context) error {
 	return c.JSON(http.StatusOK, leads)
 }
 
+// GetOverdueLeads godoc
+// @Summary Get overdue leads
+// @Description Get leads that exceeded the SLA for their current status and are assigned to the current user or belong to one of their territories
+// @Tags Leads
+// @Produce json
+// @Param limit query int false "Limit (default 50, max 100)"
+// @Success 200 {array} leadlifecycle.OverdueLeadResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/leads/overdue [get]
+func (h *LeadLifecycleHandler) GetOverdueLeads(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 10*time.Second)
+	defer cancel()
+
+	// Get user ID from context
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "User not authenticated",
+		})
+	}
+
+	// Parse limit
+	limit := 50 // Default
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		if l, err := strconv.Atoi(limitStr); err == nil {
+			limit = l
+		}
+	}
+
+	leads, err := h.service.GetOverdueLeads(ctx, userID, limit, custommiddleware.LeadScopePredicates(c)...)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to fetch overdue leads",
+		})
+	}
+
+	return c.JSON(http.StatusOK, leads)
+}
+
 // GetStatusCounts godoc
 // @Summary Get lead counts by status
 // @Description Get count of leads in each lifecycle status
@@ -239,7 +283,7 @@ func (h *LeadLifecycleHandler) GetStatusCounts(c echo.Context) error {
 	defer cancel()
 
 	// Get counts
-	counts, err := h.service.GetStatusCounts(ctx)
+	counts, err := h.service.GetStatusCounts(ctx, custommiddleware.LeadScopePredicates(c)...)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error:   "internal_error",
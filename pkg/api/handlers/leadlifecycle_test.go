@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/jordanlanch/industrydb/ent"
 	"github.com/jordanlanch/industrydb/ent/enttest"
@@ -385,6 +386,62 @@ func TestLeadLifecycleHandler_GetLeadsByStatus_EmptyResult(t *testing.T) {
 	assert.Len(t, resp, 0)
 }
 
+// --- GetOverdueLeads ---
+
+func TestLeadLifecycleHandler_GetOverdueLeads_Success(t *testing.T) {
+	client := setupLeadLifecycleTestDB(t)
+	defer client.Close()
+
+	user := createLifecycleTestUser(t, client, "a@b.com", "Alice")
+	overdue := createLifecycleTestLead(t, client, "Overdue Studio")
+	createLifecycleTestLead(t, client, "Unassigned Studio")
+
+	_, err := client.LeadAssignment.Create().
+		SetLeadID(overdue.ID).
+		SetUserID(user.ID).
+		Save(t.Context())
+	require.NoError(t, err)
+	_, err = client.Lead.UpdateOne(overdue).
+		SetStatusChangedAt(time.Now().Add(-72 * time.Hour)).
+		Save(t.Context())
+	require.NoError(t, err)
+
+	_, err = leadlifecycle.NewService(client).FlagOverdueLeads(t.Context(), time.Now())
+	require.NoError(t, err)
+
+	handler := newLifecycleHandler(client)
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/leads/overdue", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", user.ID)
+
+	err = handler.GetOverdueLeads(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var resp []leadlifecycle.OverdueLeadResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.Len(t, resp, 1)
+	assert.Equal(t, overdue.ID, resp[0].ID)
+	assert.Equal(t, "new", resp[0].Status)
+}
+
+func TestLeadLifecycleHandler_GetOverdueLeads_Unauthorized(t *testing.T) {
+	client := setupLeadLifecycleTestDB(t)
+	defer client.Close()
+
+	handler := newLifecycleHandler(client)
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/leads/overdue", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := handler.GetOverdueLeads(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
 // --- GetStatusCounts ---
 
 func TestLeadLifecycleHandler_GetStatusCounts_Success(t *testing.T) {
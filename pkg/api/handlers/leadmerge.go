@@ -0,0 +1,161 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/auditlog"
+	"github.com/jordanlanch/industrydb/pkg/audit"
+	"github.com/jordanlanch/industrydb/pkg/leadmerge"
+	custommiddleware "github.com/jordanlanch/industrydb/pkg/middleware"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/labstack/echo/v4"
+)
+
+// LeadMergeHandler handles merging duplicate leads.
+type LeadMergeHandler struct {
+	client      *ent.Client
+	service     *leadmerge.Service
+	auditLogger *audit.Service
+}
+
+// NewLeadMergeHandler creates a new lead merge handler.
+func NewLeadMergeHandler(client *ent.Client, auditLogger *audit.Service) *LeadMergeHandler {
+	return &LeadMergeHandler{
+		client:      client,
+		service:     leadmerge.NewService(client),
+		auditLogger: auditLogger,
+	}
+}
+
+// MergeLeadRequest represents a request to merge a duplicate into a lead.
+type MergeLeadRequest struct {
+	DuplicateID int                `json:"duplicate_id" validate:"required"`
+	Strategy    leadmerge.Strategy `json:"strategy,omitempty"` // "keep_survivor" (default), "keep_newest" or "keep_non_empty"
+	Preview     bool               `json:"preview,omitempty"`
+}
+
+// MergeLead godoc
+// @Summary Merge a duplicate lead into a lead
+// @Description Merge duplicate_id into the lead in the path, the survivor. Custom fields set on only one lead are kept; fields both leads set to different values are conflicts, resolved by strategy: keep_survivor (default) keeps the survivor's value, keep_newest the value of the lead updated last, keep_non_empty the survivor's value unless it is empty. The duplicate's notes and assignment history move to the survivor; if both have a current assignment the duplicate's ends as reassigned. The duplicate is archived with merged_into in its metadata, not deleted. With preview=true nothing is changed and the response shows the conflicts and the resulting custom fields.
+// @Tags Leads
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Survivor lead ID"
+// @Param request body MergeLeadRequest true "Duplicate, strategy and preview flag"
+// @Success 200 {object} leadmerge.Result
+// @Failure 400 {object} models.ErrorResponse "Invalid request, strategy, or too many merged custom fields"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 404 {object} models.ErrorResponse "Lead not found"
+// @Failure 409 {object} models.ErrorResponse "Either lead was already merged"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /leads/{id}/merge [post]
+func (h *LeadMergeHandler) MergeLead(c echo.Context) error {
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	survivorID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid lead ID",
+		})
+	}
+
+	var req MergeLeadRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "Invalid request body",
+		})
+	}
+	if req.DuplicateID <= 0 {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "duplicate_id is required",
+		})
+	}
+
+	// The survivor's visibility is checked by RequireVisibleLead
+	visible, err := custommiddleware.IsLeadVisible(c, h.client, req.DuplicateID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to merge leads",
+		})
+	}
+	if !visible {
+		return c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "not_found",
+			Message: leadmerge.ErrLeadNotFound.Error(),
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 10*time.Second)
+	defer cancel()
+
+	var result *leadmerge.Result
+	if req.Preview {
+		result, err = h.service.Preview(ctx, survivorID, req.DuplicateID, req.Strategy)
+	} else {
+		result, err = h.service.Merge(ctx, userID, survivorID, req.DuplicateID, req.Strategy)
+	}
+	if err != nil {
+		switch {
+		case errors.Is(err, leadmerge.ErrLeadNotFound):
+			return c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_found",
+				Message: err.Error(),
+			})
+		case errors.Is(err, leadmerge.ErrAlreadyMerged):
+			return c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "already_merged",
+				Message: err.Error(),
+			})
+		case leadmerge.IsValidationError(err):
+			return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "validation_error",
+				Message: err.Error(),
+			})
+		}
+		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to merge leads",
+		})
+	}
+
+	if result.Merged {
+		// Audit log (non-blocking)
+		resourceType := "lead"
+		resourceID := strconv.Itoa(survivorID)
+		ipAddress, userAgent := audit.GetRequestContext(c)
+		description := fmt.Sprintf("Merged lead %d into lead %d", req.DuplicateID, survivorID)
+		go h.auditLogger.Log(context.Background(), audit.LogEntry{
+			UserID:       &userID,
+			Action:       auditlog.ActionLeadMerge,
+			ResourceType: &resourceType,
+			ResourceID:   &resourceID,
+			IPAddress:    &ipAddress,
+			UserAgent:    &userAgent,
+			Description:  &description,
+			Severity:     auditlog.SeverityInfo,
+			Metadata: map[string]interface{}{
+				"duplicate_id": req.DuplicateID,
+				"strategy":     string(result.Strategy),
+				"conflicts":    len(result.Conflicts),
+			},
+		})
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
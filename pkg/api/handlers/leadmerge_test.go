@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/pkg/audit"
+	"github.com/jordanlanch/industrydb/pkg/leadmerge"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupLeadMergeTest(t *testing.T) (*LeadMergeHandler, *ent.Client, *ent.User, *ent.Lead, *ent.Lead) {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	ctx := context.Background()
+
+	u := client.User.Create().SetEmail("merge@test.com").SetName("Merger").SetPasswordHash("hashed").SaveX(ctx)
+	newLead := func(name string, fields map[string]interface{}) *ent.Lead {
+		return client.Lead.Create().SetName(name).SetIndustry("tattoo").SetCountry("US").SetCity("NYC").SetCustomFields(fields).SaveX(ctx)
+	}
+	survivor := newLead("Ink Studio", map[string]interface{}{"budget": "10k"})
+	duplicate := newLead("Ink Studio NYC", map[string]interface{}{"budget": "20k", "region": "west"})
+
+	return NewLeadMergeHandler(client, audit.NewService(client)), client, u, survivor, duplicate
+}
+
+func mergeLeadRequest(userID, survivorID int, body string) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	id := strconv.Itoa(survivorID)
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/leads/"+id+"/merge", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(id)
+	c.Set("user_id", userID)
+	return c, rec
+}
+
+func TestMergeLead_Preview(t *testing.T) {
+	handler, client, u, survivor, duplicate := setupLeadMergeTest(t)
+
+	body := `{"duplicate_id": ` + strconv.Itoa(duplicate.ID) + `, "strategy": "keep_newest", "preview": true}`
+	c, rec := mergeLeadRequest(u.ID, survivor.ID, body)
+	require.NoError(t, handler.MergeLead(c))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var result leadmerge.Result
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+	assert.False(t, result.Merged)
+	require.Len(t, result.Conflicts, 1)
+	assert.Equal(t, "budget", result.Conflicts[0].Key)
+	assert.Equal(t, "20k", result.Conflicts[0].ResolvedValue)
+	assert.Equal(t, map[string]interface{}{"budget": "20k", "region": "west"}, result.CustomFields)
+
+	// Previews change nothing
+	assert.Equal(t, lead.StatusNew, client.Lead.GetX(context.Background(), duplicate.ID).Status)
+}
+
+func TestMergeLead_Merge(t *testing.T) {
+	handler, client, u, survivor, duplicate := setupLeadMergeTest(t)
+
+	c, rec := mergeLeadRequest(u.ID, survivor.ID, `{"duplicate_id": `+strconv.Itoa(duplicate.ID)+`}`)
+	require.NoError(t, handler.MergeLead(c))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var result leadmerge.Result
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+	assert.True(t, result.Merged)
+	assert.Equal(t, leadmerge.StrategyKeepSurvivor, result.Strategy)
+
+	ctx := context.Background()
+	assert.Equal(t, map[string]interface{}{"budget": "10k", "region": "west"}, client.Lead.GetX(ctx, survivor.ID).CustomFields)
+	assert.Equal(t, lead.StatusArchived, client.Lead.GetX(ctx, duplicate.ID).Status)
+
+	t.Run("merging again conflicts", func(t *testing.T) {
+		c, rec := mergeLeadRequest(u.ID, survivor.ID, `{"duplicate_id": `+strconv.Itoa(duplicate.ID)+`}`)
+		require.NoError(t, handler.MergeLead(c))
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+}
+
+func TestMergeLead_InvalidRequest(t *testing.T) {
+	handler, _, u, survivor, duplicate := setupLeadMergeTest(t)
+	dup := strconv.Itoa(duplicate.ID)
+
+	tests := []struct {
+		name string
+		body string
+		code int
+	}{
+		{"missing duplicate", `{}`, http.StatusBadRequest},
+		{"invalid strategy", `{"duplicate_id": ` + dup + `, "strategy": "keep_oldest"}`, http.StatusBadRequest},
+		{"same lead", `{"duplicate_id": ` + strconv.Itoa(survivor.ID) + `}`, http.StatusBadRequest},
+		{"unknown duplicate", `{"duplicate_id": 99999}`, http.StatusNotFound},
+		{"invalid body", `{"duplicate_id": "abc"}`, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, rec := mergeLeadRequest(u.ID, survivor.ID, tt.body)
+			require.NoError(t, handler.MergeLead(c))
+			assert.Equal(t, tt.code, rec.Code, rec.Body.String())
+		})
+	}
+}
@@ -29,7 +29,7 @@ func NewLeadNoteHandler(client *ent.Client, auditLogger *audit.Service) *LeadNot
 
 // CreateNote godoc
 // @Summary Create a new note on a lead
-// @Description Create a new note/comment on a lead
+// @Description Create a new note/comment on a lead. Notes are visible to the team by default; private notes are visible to their author only.
 // @Tags Lead Notes
 // @Accept json
 // @Produce json
@@ -84,6 +84,13 @@ func (h *LeadNoteHandler) CreateNote(c echo.Context) error {
 		})
 	}
 
+	if _, err := leadnote.ParseVisibility(req.Visibility); err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Visibility must be private or team",
+		})
+	}
+
 	// Create note
 	note, err := h.noteService.CreateNote(ctx, userID, req)
 	if err != nil {
@@ -114,12 +121,13 @@ func (h *LeadNoteHandler) CreateNote(c echo.Context) error {
 
 // GetNote godoc
 // @Summary Get a single note
-// @Description Get a note by ID
+// @Description Get a note by ID. Other users' private notes are not found.
 // @Tags Lead Notes
 // @Produce json
 // @Param id path int true "Note ID"
 // @Success 200 {object} leadnote.NoteResponse
 // @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
 // @Failure 404 {object} models.ErrorResponse
 // @Failure 500 {object} models.ErrorResponse
 // @Security BearerAuth
@@ -128,6 +136,15 @@ func (h *LeadNoteHandler) GetNote(c echo.Context) error {
 	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
 	defer cancel()
 
+	// Get user from context
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "User not authenticated",
+		})
+	}
+
 	// Parse note ID
 	noteID, err := strconv.Atoi(c.Param("id"))
 	if err != nil || noteID <= 0 {
@@ -138,7 +155,7 @@ func (h *LeadNoteHandler) GetNote(c echo.Context) error {
 	}
 
 	// Get note
-	note, err := h.noteService.GetNoteByID(ctx, noteID)
+	note, err := h.noteService.GetNoteByID(ctx, userID, noteID)
 	if err != nil {
 		if err.Error() == "note not found" {
 			return c.JSON(http.StatusNotFound, models.ErrorResponse{
@@ -157,12 +174,13 @@ func (h *LeadNoteHandler) GetNote(c echo.Context) error {
 
 // ListNotesByLead godoc
 // @Summary List all notes for a lead
-// @Description Get all notes for a specific lead, ordered by pinned first then by date
+// @Description Get the notes of a lead visible to the user (team notes and their own private notes), ordered by pinned first then by date
 // @Tags Lead Notes
 // @Produce json
 // @Param lead_id path int true "Lead ID"
 // @Success 200 {array} leadnote.NoteResponse
 // @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
 // @Failure 500 {object} models.ErrorResponse
 // @Security BearerAuth
 // @Router /api/v1/leads/{lead_id}/notes [get]
@@ -170,6 +188,15 @@ func (h *LeadNoteHandler) ListNotesByLead(c echo.Context) error {
 	ctx, cancel := context.WithTimeout(c.Request().Context(), 10*time.Second)
 	defer cancel()
 
+	// Get user from context
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "User not authenticated",
+		})
+	}
+
 	// Parse lead ID
 	leadID, err := strconv.Atoi(c.Param("lead_id"))
 	if err != nil || leadID <= 0 {
@@ -180,7 +207,7 @@ func (h *LeadNoteHandler) ListNotesByLead(c echo.Context) error {
 	}
 
 	// List notes
-	notes, err := h.noteService.ListNotesByLead(ctx, leadID)
+	notes, err := h.noteService.ListNotesByLead(ctx, userID, leadID)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error:   "server_error",
@@ -193,7 +220,7 @@ func (h *LeadNoteHandler) ListNotesByLead(c echo.Context) error {
 
 // UpdateNote godoc
 // @Summary Update a note
-// @Description Update a note's content or pinned status (only owner can update)
+// @Description Update a note's content, pinned status or visibility (only owner can update)
 // @Tags Lead Notes
 // @Accept json
 // @Produce json
@@ -254,6 +281,15 @@ func (h *LeadNoteHandler) UpdateNote(c echo.Context) error {
 		}
 	}
 
+	if req.Visibility != nil {
+		if _, err := leadnote.ParseVisibility(*req.Visibility); err != nil || *req.Visibility == "" {
+			return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "validation_error",
+				Message: "Visibility must be private or team",
+			})
+		}
+	}
+
 	// Update note
 	note, err := h.noteService.UpdateNote(ctx, userID, noteID, req)
 	if err != nil {
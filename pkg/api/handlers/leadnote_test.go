@@ -190,6 +190,7 @@ func TestLeadNoteHandler_GetNote_Success(t *testing.T) {
 	c := e.NewContext(req, rec)
 	c.SetParamNames("id")
 	c.SetParamValues(strconv.Itoa(note.ID))
+	c.Set("user_id", user.ID)
 
 	err = handler.GetNote(c)
 	require.NoError(t, err)
@@ -212,6 +213,7 @@ func TestLeadNoteHandler_GetNote_NotFound(t *testing.T) {
 	c := e.NewContext(req, rec)
 	c.SetParamNames("id")
 	c.SetParamValues("99999")
+	c.Set("user_id", 1)
 
 	err := handler.GetNote(c)
 	require.NoError(t, err)
@@ -229,6 +231,7 @@ func TestLeadNoteHandler_GetNote_InvalidID(t *testing.T) {
 	c := e.NewContext(req, rec)
 	c.SetParamNames("id")
 	c.SetParamValues("abc")
+	c.Set("user_id", 1)
 
 	err := handler.GetNote(c)
 	require.NoError(t, err)
@@ -261,6 +264,7 @@ func TestLeadNoteHandler_ListNotesByLead_Success(t *testing.T) {
 	c := e.NewContext(req, rec)
 	c.SetParamNames("lead_id")
 	c.SetParamValues(strconv.Itoa(lead.ID))
+	c.Set("user_id", user.ID)
 
 	err = handler.ListNotesByLead(c)
 	require.NoError(t, err)
@@ -284,6 +288,7 @@ func TestLeadNoteHandler_ListNotesByLead_InvalidLeadID(t *testing.T) {
 	c := e.NewContext(req, rec)
 	c.SetParamNames("lead_id")
 	c.SetParamValues("abc")
+	c.Set("user_id", 1)
 
 	err := handler.ListNotesByLead(c)
 	require.NoError(t, err)
@@ -303,6 +308,7 @@ func TestLeadNoteHandler_ListNotesByLead_EmptyResult(t *testing.T) {
 	c := e.NewContext(req, rec)
 	c.SetParamNames("lead_id")
 	c.SetParamValues(strconv.Itoa(lead.ID))
+	c.Set("user_id", 1)
 
 	err := handler.ListNotesByLead(c)
 	require.NoError(t, err)
@@ -313,6 +319,70 @@ func TestLeadNoteHandler_ListNotesByLead_EmptyResult(t *testing.T) {
 	assert.Len(t, resp, 0)
 }
 
+func TestLeadNoteHandler_PrivateNotesDoNotLeak(t *testing.T) {
+	client := setupLeadNoteTestDB(t)
+	defer client.Close()
+
+	author := createLeadNoteTestUser(t, client, "private-author@b.com", "Author")
+	teammate := createLeadNoteTestUser(t, client, "private-teammate@b.com", "Teammate")
+	lead := createLeadNoteTestLead(t, client)
+	handler := newLeadNoteHandler(client)
+	e := echo.New()
+
+	create := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/lead-notes", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", author.ID)
+		require.NoError(t, handler.CreateNote(c))
+		return rec
+	}
+
+	rec := create(`{"lead_id":` + strconv.Itoa(lead.ID) + `,"content":"Team note"}`)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	rec = create(`{"lead_id":` + strconv.Itoa(lead.ID) + `,"content":"Private note","visibility":"private"}`)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var private leadnote.NoteResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &private))
+	assert.Equal(t, "private", private.Visibility)
+	rec = create(`{"lead_id":` + strconv.Itoa(lead.ID) + `,"content":"Note","visibility":"public"}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	list := func(userID int) []*leadnote.NoteResponse {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/leads/"+strconv.Itoa(lead.ID)+"/notes", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("lead_id")
+		c.SetParamValues(strconv.Itoa(lead.ID))
+		c.Set("user_id", userID)
+		require.NoError(t, handler.ListNotesByLead(c))
+		require.Equal(t, http.StatusOK, rec.Code)
+		var notes []*leadnote.NoteResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &notes))
+		return notes
+	}
+
+	assert.Len(t, list(author.ID), 2)
+	notes := list(teammate.ID)
+	require.Len(t, notes, 1)
+	assert.Equal(t, "Team note", notes[0].Content)
+
+	get := func(userID int) int {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/lead-notes/"+strconv.Itoa(private.ID), nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(strconv.Itoa(private.ID))
+		c.Set("user_id", userID)
+		require.NoError(t, handler.GetNote(c))
+		return rec.Code
+	}
+
+	assert.Equal(t, http.StatusOK, get(author.ID))
+	assert.Equal(t, http.StatusNotFound, get(teammate.ID))
+}
+
 // --- UpdateNote ---
 
 func TestLeadNoteHandler_UpdateNote_Success(t *testing.T) {
@@ -455,7 +525,7 @@ func TestLeadNoteHandler_DeleteNote_Success(t *testing.T) {
 	assert.Equal(t, http.StatusOK, rec.Code)
 
 	// Verify deletion
-	_, err = svc.GetNoteByID(t.Context(), note.ID)
+	_, err = svc.GetNoteByID(t.Context(), user.ID, note.ID)
 	assert.Error(t, err)
 }
 
@@ -0,0 +1,186 @@
+package handlers
+
+import (
+	stderrors "errors"
+	"net/http"
+	"strconv"
+
+	"github.com/jordanlanch/industrydb/pkg/api/errors"
+	"github.com/jordanlanch/industrydb/pkg/leads"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/labstack/echo/v4"
+)
+
+// ListLeadLicenses godoc
+// @Summary List an organization's lead licenses
+// @Description List the segments of the global lead pool licensed to an organization (admin only). Licenses only take effect when lead scoping (LEAD_ORG_SCOPING) is on.
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path integer true "Organization ID"
+// @Success 200 {array} leads.LeadLicenseResponse "Lead licenses"
+// @Failure 400 {object} models.ErrorResponse "Invalid organization ID"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden - Admin access required"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /admin/organizations/{id}/lead-licenses [get]
+func (h *LeadHandler) ListLeadLicenses(c echo.Context) error {
+	orgID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Organization ID must be a number",
+		})
+	}
+
+	licenses, err := h.leadService.ListLeadLicenses(c.Request().Context(), orgID)
+	if err != nil {
+		return errors.InternalError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, licenses)
+}
+
+// GrantLeadLicense godoc
+// @Summary License a lead segment to an organization
+// @Description Let an organization's members see the unowned leads of an industry and/or country when lead scoping is on (admin only). Leave industry or country empty to match any; leaving both empty licenses the whole global pool.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path integer true "Organization ID"
+// @Param request body leads.LeadLicenseRequest true "Licensed segment"
+// @Success 201 {object} leads.LeadLicenseResponse "Lead license"
+// @Failure 400 {object} models.ErrorResponse "Invalid organization ID or segment"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden - Admin access required"
+// @Failure 404 {object} models.ErrorResponse "Organization not found"
+// @Failure 409 {object} models.ErrorResponse "Segment already licensed"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /admin/organizations/{id}/lead-licenses [post]
+func (h *LeadHandler) GrantLeadLicense(c echo.Context) error {
+	adminID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	orgID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Organization ID must be a number",
+		})
+	}
+
+	var req leads.LeadLicenseRequest
+	if err := c.Bind(&req); err != nil {
+		return errors.ValidationError(c, err)
+	}
+	if err := h.validator.Struct(req); err != nil {
+		return errors.ValidationError(c, err)
+	}
+
+	license, err := h.leadService.GrantLeadLicense(c.Request().Context(), adminID, orgID, req)
+	if err != nil {
+		switch {
+		case stderrors.Is(err, leads.ErrInvalidLicense):
+			return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "invalid_license",
+				Message: err.Error(),
+			})
+		case stderrors.Is(err, leads.ErrOrganizationNotFound):
+			return errors.NotFoundError(c, "organization")
+		case stderrors.Is(err, leads.ErrLicenseExists):
+			return errors.ConflictError(c, "Organization already has this lead license")
+		}
+		return errors.InternalError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, license)
+}
+
+// RevokeLeadLicense godoc
+// @Summary Revoke an organization's lead license
+// @Description Remove a licensed segment from an organization (admin only)
+// @Tags Admin
+// @Security BearerAuth
+// @Param id path integer true "Organization ID"
+// @Param license_id path integer true "Lead license ID"
+// @Success 204 "License revoked"
+// @Failure 400 {object} models.ErrorResponse "Invalid ID"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden - Admin access required"
+// @Failure 404 {object} models.ErrorResponse "License not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /admin/organizations/{id}/lead-licenses/{license_id} [delete]
+func (h *LeadHandler) RevokeLeadLicense(c echo.Context) error {
+	orgID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Organization ID must be a number",
+		})
+	}
+	licenseID, err := strconv.Atoi(c.Param("license_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "License ID must be a number",
+		})
+	}
+
+	if err := h.leadService.RevokeLeadLicense(c.Request().Context(), orgID, licenseID); err != nil {
+		if stderrors.Is(err, leads.ErrLicenseNotFound) {
+			return errors.NotFoundError(c, "lead license")
+		}
+		return errors.InternalError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// SetLeadOwner godoc
+// @Summary Set a lead's owning organization
+// @Description Give a lead to an organization, so only its members see it when lead scoping is on, or return it to the global pool with a null organization_id (admin only)
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path integer true "Lead ID"
+// @Param request body leads.SetLeadOwnerRequest true "Owning organization"
+// @Success 200 {object} models.LeadResponse "Updated lead"
+// @Failure 400 {object} models.ErrorResponse "Invalid lead ID or request"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden - Admin access required"
+// @Failure 404 {object} models.ErrorResponse "Lead or organization not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /admin/leads/{id}/owner [put]
+func (h *LeadHandler) SetLeadOwner(c echo.Context) error {
+	leadID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Lead ID must be a number",
+		})
+	}
+
+	var req leads.SetLeadOwnerRequest
+	if err := c.Bind(&req); err != nil {
+		return errors.ValidationError(c, err)
+	}
+
+	lead, err := h.leadService.SetLeadOwner(c.Request().Context(), leadID, req.OrganizationID)
+	if err != nil {
+		switch {
+		case stderrors.Is(err, leads.ErrLeadNotFound):
+			return errors.NotFoundError(c, "lead")
+		case stderrors.Is(err, leads.ErrOrganizationNotFound):
+			return errors.NotFoundError(c, "organization")
+		}
+		return errors.InternalError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, lead)
+}
@@ -0,0 +1,268 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/organizationmember"
+	"github.com/jordanlanch/industrydb/pkg/leads"
+	custommiddleware "github.com/jordanlanch/industrydb/pkg/middleware"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/jordanlanch/industrydb/pkg/organization"
+	"github.com/labstack/echo/v4"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeadHandler_LeadScoping(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:lead_scope_test?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	admin := client.User.Create().
+		SetEmail("admin@example.com").
+		SetPasswordHash("hash").
+		SetName("Admin").
+		SaveX(t.Context())
+	org := client.Organization.Create().
+		SetName("Acme").
+		SetSlug("acme").
+		SetOwnerID(admin.ID).
+		SetLastResetAt(time.Now()).
+		SaveX(t.Context())
+	l := client.Lead.Create().
+		SetName("Ink Studio").
+		SetIndustry(lead.IndustryTattoo).
+		SetCountry("US").
+		SetCity("Austin").
+		SaveX(t.Context())
+
+	handler := NewLeadHandler(leads.NewService(client, nil), nil)
+	e := echo.New()
+
+	call := func(method, body string, params map[string]string, fn echo.HandlerFunc) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/api/v1/admin", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", admin.ID)
+		var names, values []string
+		for name, value := range params {
+			names = append(names, name)
+			values = append(values, value)
+		}
+		c.SetParamNames(names...)
+		c.SetParamValues(values...)
+		require.NoError(t, fn(c))
+		return rec
+	}
+	orgParam := map[string]string{"id": strconv.Itoa(org.ID)}
+
+	t.Run("Grant, list and revoke licenses", func(t *testing.T) {
+		rec := call(http.MethodPost, `{"industry":"tattoo","country":"US"}`, orgParam, handler.GrantLeadLicense)
+		require.Equal(t, http.StatusCreated, rec.Code)
+		var license leads.LeadLicenseResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &license))
+		assert.Equal(t, "tattoo", license.Industry)
+
+		rec = call(http.MethodPost, `{"industry":"tattoo","country":"US"}`, orgParam, handler.GrantLeadLicense)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+
+		rec = call(http.MethodPost, `{"country":"USA"}`, orgParam, handler.GrantLeadLicense)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		rec = call(http.MethodPost, `{"industry":"gym"}`, map[string]string{"id": "99999"}, handler.GrantLeadLicense)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+
+		rec = call(http.MethodGet, "", orgParam, handler.ListLeadLicenses)
+		require.Equal(t, http.StatusOK, rec.Code)
+		var licenses []leads.LeadLicenseResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &licenses))
+		assert.Len(t, licenses, 1)
+
+		revoke := map[string]string{"id": strconv.Itoa(org.ID), "license_id": strconv.Itoa(license.ID)}
+		rec = call(http.MethodDelete, "", revoke, handler.RevokeLeadLicense)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		rec = call(http.MethodDelete, "", revoke, handler.RevokeLeadLicense)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("Set lead owner", func(t *testing.T) {
+		leadParam := map[string]string{"id": strconv.Itoa(l.ID)}
+		rec := call(http.MethodPut, fmt.Sprintf(`{"organization_id":%d}`, org.ID), leadParam, handler.SetLeadOwner)
+		require.Equal(t, http.StatusOK, rec.Code)
+		var resp models.LeadResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		require.NotNil(t, resp.OwnerOrganizationID)
+		assert.Equal(t, org.ID, *resp.OwnerOrganizationID)
+
+		rec = call(http.MethodPut, `{"organization_id":null}`, leadParam, handler.SetLeadOwner)
+		require.Equal(t, http.StatusOK, rec.Code)
+		resp = models.LeadResponse{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Nil(t, resp.OwnerOrganizationID)
+
+		rec = call(http.MethodPut, `{"organization_id":99999}`, leadParam, handler.SetLeadOwner)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func TestLeadHandler_GetByID_CrossOrganization(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:lead_scope_get_test?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	member := client.User.Create().
+		SetEmail("scope-member@example.com").
+		SetPasswordHash("hash").
+		SetName("Member").
+		SaveX(t.Context())
+	acme := client.Organization.Create().
+		SetName("Acme").
+		SetSlug("acme-get").
+		SetOwnerID(member.ID).
+		SetLastResetAt(time.Now()).
+		SaveX(t.Context())
+	globex := client.Organization.Create().
+		SetName("Globex").
+		SetSlug("globex-get").
+		SetOwnerID(member.ID).
+		SetLastResetAt(time.Now()).
+		SaveX(t.Context())
+	globexLead := client.Lead.Create().
+		SetName("Globex Ink").
+		SetIndustry(lead.IndustryTattoo).
+		SetCountry("US").
+		SetCity("Austin").
+		SetOwnerOrganizationID(globex.ID).
+		SaveX(t.Context())
+
+	service := leads.NewService(client, nil)
+	service.SetOrgScoping(true)
+	handler := NewLeadHandler(service, nil)
+	e := echo.New()
+
+	get := func(orgID int) int {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/leads/"+strconv.Itoa(globexLead.ID), nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", member.ID)
+		c.Set("organization_id", orgID)
+		c.SetParamNames("id")
+		c.SetParamValues(strconv.Itoa(globexLead.ID))
+		require.NoError(t, handler.GetByID(c))
+		return rec.Code
+	}
+
+	assert.Equal(t, http.StatusNotFound, get(acme.ID), "another organization's lead is not visible")
+	assert.Equal(t, http.StatusOK, get(globex.ID))
+}
+
+func TestLeadRoutes_CrossOrganization(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:lead_scope_routes_test?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	newUser := func(email string) int {
+		return client.User.Create().
+			SetEmail(email).
+			SetPasswordHash("hash").
+			SetName("Member").
+			SaveX(t.Context()).ID
+	}
+	acmeUser, globexUser := newUser("acme-routes@example.com"), newUser("globex-routes@example.com")
+	newOrg := func(slug string, ownerID int) int {
+		org := client.Organization.Create().
+			SetName(slug).
+			SetSlug(slug).
+			SetOwnerID(ownerID).
+			SetLastResetAt(time.Now()).
+			SaveX(t.Context())
+		client.OrganizationMember.Create().
+			SetOrganizationID(org.ID).
+			SetUserID(ownerID).
+			SetRole(organizationmember.RoleOwner).
+			SaveX(t.Context())
+		return org.ID
+	}
+	acme, globex := newOrg("acme-routes", acmeUser), newOrg("globex-routes", globexUser)
+	globexLead := client.Lead.Create().
+		SetName("Globex Ink").
+		SetIndustry(lead.IndustryTattoo).
+		SetCountry("US").
+		SetCity("Austin").
+		SetQualityScore(95).
+		SetOwnerOrganizationID(globex).
+		SaveX(t.Context())
+	client.Lead.Create().
+		SetName("Global Ink").
+		SetIndustry(lead.IndustryTattoo).
+		SetCountry("US").
+		SetCity("Austin").
+		SetQualityScore(50).
+		SaveX(t.Context())
+
+	service := leads.NewService(client, nil)
+	service.SetOrgScoping(true)
+	orgContext := custommiddleware.OptionalOrganizationContext(client, organization.NewService(client))
+	leadScope := custommiddleware.LeadScope(service)
+	visibleLead := custommiddleware.RequireVisibleLead(client, "id")
+
+	e := echo.New()
+	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			userID, _ := strconv.Atoi(c.Request().Header.Get("X-User-ID"))
+			c.Set("user_id", userID)
+			return next(c)
+		}
+	})
+	e.GET("/leads/top-scoring", NewLeadScoringHandler(client).GetTopScoringLeads, orgContext, leadScope)
+	e.GET("/leads/:id/history", NewLeadHandler(service, nil).GetHistory, orgContext, leadScope, visibleLead)
+
+	call := func(path string, userID, orgID int) *httptest.ResponseRecorder {
+		if orgID != 0 {
+			path += "?organization_id=" + strconv.Itoa(orgID)
+		}
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("X-User-ID", strconv.Itoa(userID))
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+	topScoringIDs := func(userID, orgID int) []int {
+		rec := call("/leads/top-scoring", userID, orgID)
+		require.Equal(t, http.StatusOK, rec.Code)
+		var found []struct {
+			ID int `json:"id"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &found))
+		ids := make([]int, len(found))
+		for i, l := range found {
+			ids[i] = l.ID
+		}
+		return ids
+	}
+	history := fmt.Sprintf("/leads/%d/history", globexLead.ID)
+
+	t.Run("Another organization can't read the lead", func(t *testing.T) {
+		assert.Equal(t, http.StatusNotFound, call(history, acmeUser, acme).Code)
+		assert.Equal(t, http.StatusNotFound, call(history, acmeUser, 0).Code, "the global pool has no owned leads")
+		assert.NotContains(t, topScoringIDs(acmeUser, acme), globexLead.ID)
+		assert.NotContains(t, topScoringIDs(acmeUser, 0), globexLead.ID)
+	})
+
+	t.Run("The owning organization reads the lead", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, call(history, globexUser, globex).Code)
+		assert.Equal(t, []int{globexLead.ID}, topScoringIDs(globexUser, globex))
+	})
+
+	t.Run("Other organizations stay out of reach", func(t *testing.T) {
+		assert.Equal(t, http.StatusForbidden, call(history, acmeUser, globex).Code)
+	})
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/jordanlanch/industrydb/ent"
 	"github.com/jordanlanch/industrydb/pkg/leadscoring"
+	custommiddleware "github.com/jordanlanch/industrydb/pkg/middleware"
 	"github.com/jordanlanch/industrydb/pkg/models"
 	"github.com/labstack/echo/v4"
 )
@@ -137,7 +138,7 @@ func (h *LeadScoringHandler) GetTopScoringLeads(c echo.Context) error {
 	}
 
 	// Get top scoring leads
-	leads, err := h.service.GetTopScoringLeads(ctx, limit)
+	leads, err := h.service.GetTopScoringLeads(ctx, limit, custommiddleware.LeadScopePredicates(c)...)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error:   "server_error",
@@ -184,7 +185,7 @@ func (h *LeadScoringHandler) GetLowScoringLeads(c echo.Context) error {
 	}
 
 	// Get low scoring leads
-	leads, err := h.service.GetLowScoringLeads(ctx, threshold, limit)
+	leads, err := h.service.GetLowScoringLeads(ctx, threshold, limit, custommiddleware.LeadScopePredicates(c)...)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error:   "server_error",
@@ -209,7 +210,7 @@ func (h *LeadScoringHandler) GetScoreDistribution(c echo.Context) error {
 	defer cancel()
 
 	// Get distribution
-	distribution, err := h.service.GetScoreDistribution(ctx)
+	distribution, err := h.service.GetScoreDistribution(ctx, custommiddleware.LeadScopePredicates(c)...)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error:   "server_error",
@@ -0,0 +1,149 @@
+package handlers
+
+import (
+	stderrors "errors"
+	"net/http"
+	"strconv"
+
+	"github.com/jordanlanch/industrydb/pkg/api/errors"
+	"github.com/jordanlanch/industrydb/pkg/leads"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/labstack/echo/v4"
+)
+
+// SuppressLeadRequest is the body of a lead suppression
+type SuppressLeadRequest struct {
+	Reason string `json:"reason" validate:"max=500"`
+	// Share the suppression with every member of this organization
+	OrganizationID *int `json:"organization_id,omitempty"`
+}
+
+// Suppress godoc
+// @Summary Suppress a lead
+// @Description Add a lead to the user's suppression list, e.g. after disqualifying it. Suppressed leads are hidden from the user's searches, saved searches and exports and cannot be enrolled in email sequences. With organization_id the suppression applies to every member of that organization. Suppressing again replaces the reason and sharing. Unlike deletion, the lead stays visible to everyone else.
+// @Tags Leads
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path integer true "Lead ID"
+// @Param request body SuppressLeadRequest true "Suppression reason and sharing"
+// @Success 200 {object} leads.SuppressionResponse "Suppression"
+// @Failure 400 {object} models.ErrorResponse "Invalid lead ID or request"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Not a member of the organization"
+// @Failure 404 {object} models.ErrorResponse "Lead not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /leads/{id}/suppress [post]
+func (h *LeadHandler) Suppress(c echo.Context) error {
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	leadID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Lead ID must be a number",
+		})
+	}
+
+	var req SuppressLeadRequest
+	if err := c.Bind(&req); err != nil {
+		return errors.ValidationError(c, err)
+	}
+	if err := h.validator.Struct(req); err != nil {
+		return errors.ValidationError(c, err)
+	}
+
+	suppression, err := h.leadService.Suppress(c.Request().Context(), userID, leadID, req.Reason, req.OrganizationID)
+	if err != nil {
+		switch {
+		case stderrors.Is(err, leads.ErrLeadNotFound):
+			return errors.NotFoundError(c, "lead")
+		case stderrors.Is(err, leads.ErrNotOrganizationMember):
+			return c.JSON(http.StatusForbidden, models.ErrorResponse{
+				Error:   "not_organization_member",
+				Message: "You are not a member of this organization",
+			})
+		}
+		return errors.InternalError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, suppression)
+}
+
+// Unsuppress godoc
+// @Summary Unsuppress a lead
+// @Description Remove a lead from the user's suppression list, along with the suppressions shared with organizations the user owns or administers
+// @Tags Leads
+// @Produce json
+// @Security BearerAuth
+// @Param id path integer true "Lead ID"
+// @Success 204 "Lead unsuppressed"
+// @Failure 400 {object} models.ErrorResponse "Invalid lead ID"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 404 {object} models.ErrorResponse "Lead is not suppressed"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /leads/{id}/suppress [delete]
+func (h *LeadHandler) Unsuppress(c echo.Context) error {
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	leadID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Lead ID must be a number",
+		})
+	}
+
+	if err := h.leadService.Unsuppress(c.Request().Context(), userID, leadID); err != nil {
+		if stderrors.Is(err, leads.ErrNotSuppressed) {
+			return c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_suppressed",
+				Message: "Lead is not suppressed",
+			})
+		}
+		return errors.InternalError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ListSuppressions godoc
+// @Summary List suppressed leads
+// @Description List the leads hidden from the user: their own suppressions and those shared with their organizations, newest first
+// @Tags Leads
+// @Produce json
+// @Security BearerAuth
+// @Param page query integer false "Page number" default(1)
+// @Param limit query integer false "Results per page (max 100)" default(50)
+// @Success 200 {object} leads.SuppressionListResponse "Suppressed leads"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /leads/suppressions [get]
+func (h *LeadHandler) ListSuppressions(c echo.Context) error {
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	page, _ := strconv.Atoi(c.QueryParam("page"))
+	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+
+	suppressions, err := h.leadService.ListSuppressions(c.Request().Context(), userID, page, limit)
+	if err != nil {
+		return errors.InternalError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, suppressions)
+}
@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/pkg/leads"
+	"github.com/labstack/echo/v4"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeadHandler_Suppressions(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:lead_suppression_test?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	user := client.User.Create().
+		SetEmail("rep@example.com").
+		SetPasswordHash("hash").
+		SetName("Rep").
+		SaveX(t.Context())
+	l := client.Lead.Create().
+		SetName("Disqualified Ink").
+		SetIndustry(lead.IndustryTattoo).
+		SetCountry("US").
+		SetCity("Austin").
+		SaveX(t.Context())
+
+	handler := NewLeadHandler(leads.NewService(client, nil), nil)
+	e := echo.New()
+
+	call := func(method, path, body string, id int, fn echo.HandlerFunc) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", user.ID)
+		if id != 0 {
+			c.SetParamNames("id")
+			c.SetParamValues(strconv.Itoa(id))
+		}
+		require.NoError(t, fn(c))
+		return rec
+	}
+
+	rec := call(http.MethodPost, "/api/v1/leads/1/suppress", `{"reason":"Closed down"}`, l.ID, handler.Suppress)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = call(http.MethodPost, "/api/v1/leads/1/suppress", `{}`, 999999, handler.Suppress)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = call(http.MethodGet, "/api/v1/leads/suppressions", "", 0, handler.ListSuppressions)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var list leads.SuppressionListResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &list))
+	require.Len(t, list.Data, 1)
+	assert.Equal(t, l.ID, list.Data[0].LeadID)
+	assert.Equal(t, "Closed down", list.Data[0].Reason)
+
+	rec = call(http.MethodDelete, "/api/v1/leads/1/suppress", "", l.ID, handler.Unsuppress)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec = call(http.MethodDelete, "/api/v1/leads/1/suppress", "", l.ID, handler.Unsuppress)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -0,0 +1,191 @@
+package handlers
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/pkg/api/errors"
+	"github.com/jordanlanch/industrydb/pkg/api/validation"
+	"github.com/jordanlanch/industrydb/pkg/audit"
+	"github.com/jordanlanch/industrydb/pkg/leads"
+	"github.com/jordanlanch/industrydb/pkg/leadtags"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/labstack/echo/v4"
+)
+
+// LeadTagsHandler handles lead tag endpoints.
+type LeadTagsHandler struct {
+	service     *leadtags.Service
+	validator   *validator.Validate
+	auditLogger *audit.Service
+}
+
+// NewLeadTagsHandler creates a new lead tags handler.
+func NewLeadTagsHandler(client *ent.Client, leadService *leads.Service, auditLogger *audit.Service) *LeadTagsHandler {
+	return &LeadTagsHandler{
+		service:     leadtags.NewService(client, leadService),
+		validator:   validation.Validator(),
+		auditLogger: auditLogger,
+	}
+}
+
+// SetTags godoc
+// @Summary Set lead tags
+// @Description Replace the tags of a lead. Tags are lowercased with words joined by hyphens, may contain letters, digits, hyphens and underscores, and are at most 50 characters. A lead can have at most 20 tags.
+// @Tags Leads
+// @Accept json
+// @Produce json
+// @Param id path int true "Lead ID"
+// @Param request body leadtags.SetTagsRequest true "Tags"
+// @Success 200 {object} leadtags.TagsResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/leads/{id}/tags [put]
+func (h *LeadTagsHandler) SetTags(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "User not authenticated",
+		})
+	}
+
+	leadID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid lead ID",
+		})
+	}
+
+	var req leadtags.SetTagsRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "Invalid request body",
+		})
+	}
+
+	result, err := h.service.SetTags(ctx, userID, leadID, req.Tags)
+	if err != nil {
+		switch {
+		case stderrors.Is(err, leadtags.ErrLeadNotFound):
+			return c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_found",
+				Message: "Lead not found",
+			})
+		case stderrors.Is(err, leadtags.ErrInvalidTag), stderrors.Is(err, leadtags.ErrTooManyTags):
+			return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "validation_error",
+				Message: err.Error(),
+			})
+		}
+		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to set tags",
+		})
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// BulkUpdateTags godoc
+// @Summary Bulk add or remove lead tags
+// @Description Add and/or remove tags across up to 1,000 leads selected by lead_ids or by a lead search filter (not both). Tags are normalized as for PUT /leads/{id}/tags. Each lead is updated in its own transaction and the outcome is reported per lead: updated, unchanged, not_found, or tag_limit_exceeded when the lead would end up with more than 20 tags (the lead is then left untouched).
+// @Tags Leads
+// @Accept json
+// @Produce json
+// @Param request body leadtags.BulkTagRequest true "Target leads and tags to add or remove"
+// @Success 200 {object} leadtags.BulkTagResult
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/leads/bulk-tags [post]
+func (h *LeadTagsHandler) BulkUpdateTags(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 2*time.Minute)
+	defer cancel()
+
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "User not authenticated",
+		})
+	}
+
+	var req leadtags.BulkTagRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "Invalid request body",
+		})
+	}
+	if req.Filters != nil {
+		// Pagination doesn't apply, every matching lead is tagged
+		if err := h.validator.StructExcept(req.Filters, "Page", "Limit"); err != nil {
+			return errors.ValidationError(c, err)
+		}
+		if orgID, hasOrgContext := c.Get("organization_id").(int); hasOrgContext {
+			req.Filters.OrgScope = &orgID
+		}
+	}
+
+	result, err := h.service.BulkUpdateTags(ctx, userID, req)
+	if err != nil {
+		switch {
+		case stderrors.Is(err, leadtags.ErrInvalidTag),
+			stderrors.Is(err, leadtags.ErrNoTagChanges),
+			stderrors.Is(err, leadtags.ErrInvalidTargets),
+			stderrors.Is(err, leadtags.ErrTooManyLeads):
+			return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "validation_error",
+				Message: err.Error(),
+			})
+		}
+		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to update tags",
+		})
+	}
+
+	source := "lead_ids"
+	if req.Filters != nil {
+		source = "filters"
+	}
+	resourceType := "lead"
+	ipAddress, userAgent := audit.GetRequestContext(c)
+	description := fmt.Sprintf("Bulk tagged leads by %s: %d updated, %d unchanged, %d failed",
+		source, result.Updated, result.Unchanged, result.Failed)
+	go h.auditLogger.Log(context.Background(), audit.LogEntry{
+		UserID:       &userID,
+		Action:       "lead_tag",
+		ResourceType: &resourceType,
+		IPAddress:    &ipAddress,
+		UserAgent:    &userAgent,
+		Metadata: map[string]interface{}{
+			"source":    source,
+			"add":       result.Add,
+			"remove":    result.Remove,
+			"requested": result.Requested,
+			"updated":   result.Updated,
+			"unchanged": result.Unchanged,
+			"failed":    result.Failed,
+		},
+		Severity:    "info",
+		Description: &description,
+	})
+
+	return c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,150 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/auditlog"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/pkg/audit"
+	"github.com/jordanlanch/industrydb/pkg/leads"
+	"github.com/jordanlanch/industrydb/pkg/leadtags"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/labstack/echo/v4"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupLeadTagsTestHandler(t *testing.T) (*LeadTagsHandler, *ent.Client) {
+	client := enttest.Open(t, "sqlite3", "file:leadtags_handler_test?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	return NewLeadTagsHandler(client, leads.NewService(client, nil), audit.NewService(client)), client
+}
+
+func newLeadTagsRequest(method, path, body string, leadID string, userID int) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	if leadID != "" {
+		c.SetParamNames("id")
+		c.SetParamValues(leadID)
+	}
+	if userID != 0 {
+		c.Set("user_id", userID)
+	}
+	return c, rec
+}
+
+func TestLeadTagsHandler_SetTags(t *testing.T) {
+	handler, client := setupLeadTagsTestHandler(t)
+	user := createLifecycleTestUser(t, client, "tags@b.com", "Tagger")
+	lead := createLifecycleTestLead(t, client, "Studio A")
+	id := strconv.Itoa(lead.ID)
+
+	c, rec := newLeadTagsRequest(http.MethodPut, "/api/v1/leads/"+id+"/tags", `{"tags":["VIP","Cold Outreach Q1"]}`, id, user.ID)
+	require.NoError(t, handler.SetTags(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var resp leadtags.TagsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, []string{"vip", "cold-outreach-q1"}, resp.Tags)
+
+	t.Run("Invalid tag", func(t *testing.T) {
+		c, rec := newLeadTagsRequest(http.MethodPut, "/api/v1/leads/"+id+"/tags", `{"tags":["a/b"]}`, id, user.ID)
+		require.NoError(t, handler.SetTags(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("Lead not found", func(t *testing.T) {
+		c, rec := newLeadTagsRequest(http.MethodPut, "/api/v1/leads/99999/tags", `{"tags":["vip"]}`, "99999", user.ID)
+		require.NoError(t, handler.SetTags(c))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func TestLeadTagsHandler_BulkUpdateTags(t *testing.T) {
+	handler, client := setupLeadTagsTestHandler(t)
+	user := createLifecycleTestUser(t, client, "tags@b.com", "Tagger")
+	first := createLifecycleTestLead(t, client, "Studio A")
+	second := createLifecycleTestLead(t, client, "Studio B")
+
+	body := fmt.Sprintf(`{"lead_ids":[%d,%d,99999],"add":["cold-outreach-Q1"]}`, first.ID, second.ID)
+	c, rec := newLeadTagsRequest(http.MethodPost, "/api/v1/leads/bulk-tags", body, "", user.ID)
+	require.NoError(t, handler.BulkUpdateTags(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var resp leadtags.BulkTagResult
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, 3, resp.Requested)
+	assert.Equal(t, 2, resp.Updated)
+	assert.Equal(t, 1, resp.Failed)
+	require.Len(t, resp.Results, 3)
+	assert.Equal(t, leadtags.StatusNotFound, resp.Results[2].Status)
+
+	reloaded, err := client.Lead.Get(c.Request().Context(), first.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cold-outreach-q1"}, reloaded.Tags)
+
+	assert.Eventually(t, func() bool {
+		count, err := client.AuditLog.Query().Where(auditlog.ActionEQ(auditlog.ActionLeadTag)).Count(c.Request().Context())
+		return err == nil && count == 1
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestLeadTagsHandler_BulkUpdateTags_Filters(t *testing.T) {
+	handler, client := setupLeadTagsTestHandler(t)
+	user := createLifecycleTestUser(t, client, "tags@b.com", "Tagger")
+	lead := createLifecycleTestLead(t, client, "Studio A")
+
+	c, rec := newLeadTagsRequest(http.MethodPost, "/api/v1/leads/bulk-tags", `{"filters":{"country":"US"},"add":["vip"]}`, "", user.ID)
+	require.NoError(t, handler.BulkUpdateTags(c))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	reloaded, err := client.Lead.Get(c.Request().Context(), lead.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"vip"}, reloaded.Tags)
+}
+
+func TestLeadTagsHandler_BulkUpdateTags_Validation(t *testing.T) {
+	handler, client := setupLeadTagsTestHandler(t)
+	user := createLifecycleTestUser(t, client, "tags@b.com", "Tagger")
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "No targets", body: `{"add":["vip"]}`},
+		{name: "Both targets", body: `{"lead_ids":[1],"filters":{"City":"Austin"},"add":["vip"]}`},
+		{name: "No tags", body: `{"lead_ids":[1]}`},
+		{name: "Invalid tag", body: `{"lead_ids":[1],"add":["a/b"]}`},
+		{name: "Invalid filter", body: `{"filters":{"Country":"USA"},"add":["vip"]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, rec := newLeadTagsRequest(http.MethodPost, "/api/v1/leads/bulk-tags", tt.body, "", user.ID)
+			require.NoError(t, handler.BulkUpdateTags(c))
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+			var resp models.ErrorResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.NotEmpty(t, resp.Message)
+		})
+	}
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		c, rec := newLeadTagsRequest(http.MethodPost, "/api/v1/leads/bulk-tags", `{"lead_ids":[1],"add":["vip"]}`, "", 0)
+		require.NoError(t, handler.BulkUpdateTags(c))
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+}
@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	stderrors "errors"
+	"net/http"
+	"strconv"
+
+	"github.com/jordanlanch/industrydb/pkg/api/errors"
+	"github.com/jordanlanch/industrydb/pkg/leads"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/labstack/echo/v4"
+)
+
+// UpdateLead godoc
+// @Summary Partially update a lead
+// @Description Correct a lead's fields. Only the fields provided are changed, and an empty string clears an optional field. Phones are validated for the lead's country and stored in E.164, websites must be absolute http(s) URLs, and coordinates must be set together and in range. The quality and completeness scores are recomputed and the change is recorded in the lead's history. Send the lead's updated_at as last read to fail with 409 if it changed since. Admins can edit any lead; organization owners can edit the leads their organization owns.
+// @Tags Leads
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path integer true "Lead ID"
+// @Param request body leads.UpdateLeadRequest true "Fields to update"
+// @Success 200 {object} models.LeadResponse "Updated lead"
+// @Failure 400 {object} models.ErrorResponse "Invalid lead ID or field value"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Not allowed to edit this lead"
+// @Failure 404 {object} models.ErrorResponse "Lead not found"
+// @Failure 409 {object} models.ErrorResponse "Lead was modified since it was read"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /leads/{id} [patch]
+func (h *LeadHandler) UpdateLead(c echo.Context) error {
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	leadID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Lead ID must be a number",
+		})
+	}
+
+	var req leads.UpdateLeadRequest
+	if err := c.Bind(&req); err != nil {
+		return errors.ValidationError(c, err)
+	}
+
+	lead, err := h.leadService.UpdateLead(c.Request().Context(), userID, leadID, req)
+	if err != nil {
+		switch {
+		case stderrors.Is(err, leads.ErrLeadNotFound):
+			return errors.NotFoundError(c, "lead")
+		case stderrors.Is(err, leads.ErrInvalidLeadUpdate):
+			return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "invalid_lead_update",
+				Message: err.Error(),
+			})
+		case stderrors.Is(err, leads.ErrLeadEditForbidden):
+			return c.JSON(http.StatusForbidden, models.ErrorResponse{
+				Error:   "forbidden",
+				Message: err.Error(),
+			})
+		case stderrors.Is(err, leads.ErrLeadConflict):
+			return c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "lead_conflict",
+				Message: "The lead was modified since it was read; reload it and retry",
+			})
+		}
+		return errors.InternalError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, lead)
+}
@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/user"
+	"github.com/jordanlanch/industrydb/pkg/leads"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/labstack/echo/v4"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeadHandler_UpdateLead(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:lead_update_test?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	admin := client.User.Create().
+		SetEmail("lead-update-admin@example.com").
+		SetPasswordHash("hash").
+		SetName("Data Ops").
+		SetRole(user.RoleAdmin).
+		SaveX(t.Context())
+	rep := client.User.Create().
+		SetEmail("lead-update-rep@example.com").
+		SetPasswordHash("hash").
+		SetName("Rep").
+		SaveX(t.Context())
+	l := client.Lead.Create().
+		SetName("Misspeled Ink").
+		SetIndustry(lead.IndustryTattoo).
+		SetCountry("US").
+		SetCity("Austin").
+		SaveX(t.Context())
+
+	handler := NewLeadHandler(leads.NewService(client, nil), nil)
+	e := echo.New()
+
+	call := func(userID, id int, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPatch, "/api/v1/leads/"+strconv.Itoa(id), strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", userID)
+		c.SetParamNames("id")
+		c.SetParamValues(strconv.Itoa(id))
+		require.NoError(t, handler.UpdateLead(c))
+		return rec
+	}
+
+	rec := call(admin.ID, l.ID, `{"name":"Misspelled Ink","website":"https://ink.example.com"}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var updated models.LeadResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &updated))
+	assert.Equal(t, "Misspelled Ink", updated.Name)
+	assert.Equal(t, "https://ink.example.com", updated.Website)
+	assert.Equal(t, "Austin", updated.City)
+
+	rec = call(admin.ID, l.ID, `{"latitude":120,"longitude":0}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = call(admin.ID, l.ID, `{"name":"Stale","updated_at":"2020-01-01T00:00:00Z"}`)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+
+	rec = call(admin.ID, l.ID, `{"name":"Fresh","updated_at":"`+updated.UpdatedAt+`"}`)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = call(rep.ID, l.ID, `{"name":"Hijacked"}`)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec = call(admin.ID, 999999, `{"name":"Ghost"}`)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -0,0 +1,424 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/pkg/audit"
+	"github.com/jordanlanch/industrydb/pkg/leadverification"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/labstack/echo/v4"
+)
+
+// LeadVerificationHandler handles lead verification endpoints.
+type LeadVerificationHandler struct {
+	service     *leadverification.Service
+	auditLogger *audit.Service
+}
+
+// NewLeadVerificationHandler creates a new lead verification handler.
+func NewLeadVerificationHandler(client *ent.Client, auditLogger *audit.Service) *LeadVerificationHandler {
+	return &LeadVerificationHandler{
+		service:     leadverification.NewService(client),
+		auditLogger: auditLogger,
+	}
+}
+
+// VerifyLead godoc
+// @Summary Verify a lead
+// @Description Mark a lead as verified and record the verification evidence (method and optional note). Verifying an already verified lead records a re-verification.
+// @Tags Leads
+// @Accept json
+// @Produce json
+// @Param id path int true "Lead ID"
+// @Param request body leadverification.VerifyRequest true "Verification evidence"
+// @Success 200 {object} leadverification.StatusResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/leads/{id}/verify [post]
+func (h *LeadVerificationHandler) VerifyLead(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "User not authenticated",
+		})
+	}
+
+	leadID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid lead ID",
+		})
+	}
+
+	var req leadverification.VerifyRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "Invalid request body",
+		})
+	}
+
+	if !leadverification.IsValidMethod(req.Method) {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_method",
+			Message: "Invalid verification method. Must be one of: phone_confirmed, email_confirmed, website_checked, site_visit, third_party, manual_review",
+		})
+	}
+	if len(req.Note) > 1000 {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Note cannot exceed 1,000 characters",
+		})
+	}
+
+	result, err := h.service.VerifyLead(ctx, userID, leadID, req)
+	if err != nil {
+		if err.Error() == "lead not found" {
+			return c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_found",
+				Message: "Lead not found",
+			})
+		}
+		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to verify lead",
+		})
+	}
+
+	resourceType := "lead"
+	resourceID := strconv.Itoa(leadID)
+	ipAddress, userAgent := audit.GetRequestContext(c)
+	description := "Verified lead via " + req.Method
+	go h.auditLogger.Log(context.Background(), audit.LogEntry{
+		UserID:       &userID,
+		Action:       "lead_verify",
+		ResourceType: &resourceType,
+		ResourceID:   &resourceID,
+		IPAddress:    &ipAddress,
+		UserAgent:    &userAgent,
+		Metadata: map[string]interface{}{
+			"method": req.Method,
+		},
+		Severity:    "info",
+		Description: &description,
+	})
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// BulkVerifyLeads godoc
+// @Summary Bulk verify leads
+// @Description Mark many leads as verified with a shared method and note (admin only). Accepts a JSON body with lead_ids, or a multipart upload of a CSV of lead IDs with method and note form fields. Already verified leads are left untouched.
+// @Tags Admin
+// @Accept json
+// @Accept multipart/form-data
+// @Produce json
+// @Param request body leadverification.BulkVerifyRequest false "Lead IDs and verification evidence"
+// @Param file formData file false "CSV file of lead IDs"
+// @Param method formData string false "Verification method (multipart only)"
+// @Param note formData string false "Verification note (multipart only)"
+// @Success 200 {object} leadverification.BulkVerifyResult
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Security BearerAuth
+// @Router /admin/leads/bulk-verify [post]
+func (h *LeadVerificationHandler) BulkVerifyLeads(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 2*time.Minute)
+	defer cancel()
+
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "User not authenticated",
+		})
+	}
+
+	var req leadverification.BulkVerifyRequest
+	source := "json"
+	if strings.HasPrefix(c.Request().Header.Get(echo.HeaderContentType), echo.MIMEMultipartForm) {
+		source = "csv"
+		file, err := c.FormFile("file")
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "invalid_file",
+				Message: "No file uploaded or invalid file",
+			})
+		}
+
+		src, err := file.Open()
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+				Error:   "internal_error",
+				Message: "Failed to read uploaded file",
+			})
+		}
+		defer src.Close()
+
+		ids, err := leadverification.ParseLeadIDsCSV(src)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "invalid_format",
+				Message: err.Error(),
+			})
+		}
+
+		req.LeadIDs = ids
+		req.Method = c.FormValue("method")
+		req.Note = c.FormValue("note")
+	} else if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "Invalid request body",
+		})
+	}
+
+	if !leadverification.IsValidMethod(req.Method) {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_method",
+			Message: "Invalid verification method. Must be one of: phone_confirmed, email_confirmed, website_checked, site_visit, third_party, manual_review",
+		})
+	}
+	if len(req.Note) > 1000 {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Note cannot exceed 1,000 characters",
+		})
+	}
+	if len(req.LeadIDs) == 0 {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "At least one lead ID is required",
+		})
+	}
+	if len(req.LeadIDs) > leadverification.MaxBulkVerifyLeads {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: fmt.Sprintf("Cannot verify more than %d leads at once", leadverification.MaxBulkVerifyLeads),
+		})
+	}
+
+	result, err := h.service.BulkVerifyLeads(ctx, userID, req)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to verify leads",
+		})
+	}
+
+	resourceType := "lead"
+	ipAddress, userAgent := audit.GetRequestContext(c)
+	description := fmt.Sprintf("Bulk verified leads via %s: %d updated, %d already verified, %d not found",
+		req.Method, result.Updated, result.AlreadyVerified, result.NotFound)
+	go h.auditLogger.Log(context.Background(), audit.LogEntry{
+		UserID:       &userID,
+		Action:       "lead_verify",
+		ResourceType: &resourceType,
+		IPAddress:    &ipAddress,
+		UserAgent:    &userAgent,
+		Metadata: map[string]interface{}{
+			"bulk":             true,
+			"source":           source,
+			"method":           req.Method,
+			"requested":        result.Requested,
+			"updated":          result.Updated,
+			"already_verified": result.AlreadyVerified,
+			"not_found":        result.NotFound,
+		},
+		Severity:    "info",
+		Description: &description,
+	})
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// UnverifyLead godoc
+// @Summary Unverify a lead
+// @Description Remove a lead's verification and record the reason
+// @Tags Leads
+// @Accept json
+// @Produce json
+// @Param id path int true "Lead ID"
+// @Param request body leadverification.UnverifyRequest true "Unverify reason"
+// @Success 200 {object} leadverification.StatusResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 409 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/leads/{id}/unverify [post]
+func (h *LeadVerificationHandler) UnverifyLead(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "User not authenticated",
+		})
+	}
+
+	leadID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid lead ID",
+		})
+	}
+
+	var req leadverification.UnverifyRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "Invalid request body",
+		})
+	}
+
+	if req.Reason == "" {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Reason is required",
+		})
+	}
+	if len(req.Reason) > 1000 {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Reason cannot exceed 1,000 characters",
+		})
+	}
+
+	result, err := h.service.UnverifyLead(ctx, userID, leadID, req)
+	if err != nil {
+		switch err.Error() {
+		case "lead not found":
+			return c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_found",
+				Message: "Lead not found",
+			})
+		case "lead is not verified":
+			return c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "not_verified",
+				Message: "Lead is not verified",
+			})
+		}
+		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to unverify lead",
+		})
+	}
+
+	resourceType := "lead"
+	resourceID := strconv.Itoa(leadID)
+	ipAddress, userAgent := audit.GetRequestContext(c)
+	description := "Unverified lead: " + req.Reason
+	go h.auditLogger.Log(context.Background(), audit.LogEntry{
+		UserID:       &userID,
+		Action:       "lead_unverify",
+		ResourceType: &resourceType,
+		ResourceID:   &resourceID,
+		IPAddress:    &ipAddress,
+		UserAgent:    &userAgent,
+		Severity:     "info",
+		Description:  &description,
+	})
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// GetVerificationStatus godoc
+// @Summary Get lead verification status
+// @Description Get the current verification state of a lead, including whether its contact data changed since it was verified
+// @Tags Leads
+// @Produce json
+// @Param id path int true "Lead ID"
+// @Success 200 {object} leadverification.StatusResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/leads/{id}/verification [get]
+func (h *LeadVerificationHandler) GetVerificationStatus(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	leadID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid lead ID",
+		})
+	}
+
+	status, err := h.service.GetStatus(ctx, leadID)
+	if err != nil {
+		if err.Error() == "lead not found" {
+			return c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_found",
+				Message: "Lead not found",
+			})
+		}
+		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to fetch verification status",
+		})
+	}
+
+	return c.JSON(http.StatusOK, status)
+}
+
+// GetVerificationHistory godoc
+// @Summary Get lead verification history
+// @Description Get all verify/unverify events recorded for a lead, newest first
+// @Tags Leads
+// @Produce json
+// @Param id path int true "Lead ID"
+// @Success 200 {array} leadverification.VerificationResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/leads/{id}/verification-history [get]
+func (h *LeadVerificationHandler) GetVerificationHistory(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	leadID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Invalid lead ID",
+		})
+	}
+
+	history, err := h.service.GetVerificationHistory(ctx, leadID)
+	if err != nil {
+		if err.Error() == "lead not found" {
+			return c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_found",
+				Message: "Lead not found",
+			})
+		}
+		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to fetch verification history",
+		})
+	}
+
+	return c.JSON(http.StatusOK, history)
+}
@@ -0,0 +1,216 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/pkg/audit"
+	"github.com/jordanlanch/industrydb/pkg/leadverification"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/labstack/echo/v4"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupLeadVerificationTestDB(t *testing.T) *ent.Client {
+	return enttest.Open(t, "sqlite3", "file:leadverification_handler_test?mode=memory&cache=shared&_fk=1")
+}
+
+func newVerificationRequest(method, path, body string, leadID string, userID int) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(leadID)
+	if userID != 0 {
+		c.Set("user_id", userID)
+	}
+	return c, rec
+}
+
+func TestLeadVerificationHandler_VerifyLead_Success(t *testing.T) {
+	client := setupLeadVerificationTestDB(t)
+	defer client.Close()
+
+	user := createLifecycleTestUser(t, client, "qa@b.com", "QA")
+	lead := createLifecycleTestLead(t, client, "Studio A")
+	handler := NewLeadVerificationHandler(client, audit.NewService(client))
+
+	id := strconv.Itoa(lead.ID)
+	c, rec := newVerificationRequest(http.MethodPost, "/api/v1/leads/"+id+"/verify",
+		`{"method":"phone_confirmed","note":"Owner confirmed"}`, id, user.ID)
+
+	require.NoError(t, handler.VerifyLead(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var resp leadverification.StatusResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.True(t, resp.Verified)
+	require.NotNil(t, resp.VerifiedBy)
+	assert.Equal(t, user.ID, *resp.VerifiedBy)
+}
+
+func TestLeadVerificationHandler_VerifyLead_InvalidMethod(t *testing.T) {
+	client := setupLeadVerificationTestDB(t)
+	defer client.Close()
+
+	user := createLifecycleTestUser(t, client, "qa@b.com", "QA")
+	lead := createLifecycleTestLead(t, client, "Studio A")
+	handler := NewLeadVerificationHandler(client, audit.NewService(client))
+
+	id := strconv.Itoa(lead.ID)
+	c, rec := newVerificationRequest(http.MethodPost, "/api/v1/leads/"+id+"/verify", `{"method":"guess"}`, id, user.ID)
+
+	require.NoError(t, handler.VerifyLead(c))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var resp models.ErrorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, "invalid_method", resp.Error)
+}
+
+func TestLeadVerificationHandler_VerifyLead_NotFound(t *testing.T) {
+	client := setupLeadVerificationTestDB(t)
+	defer client.Close()
+
+	user := createLifecycleTestUser(t, client, "qa@b.com", "QA")
+	handler := NewLeadVerificationHandler(client, audit.NewService(client))
+
+	c, rec := newVerificationRequest(http.MethodPost, "/api/v1/leads/99999/verify", `{"method":"manual_review"}`, "99999", user.ID)
+
+	require.NoError(t, handler.VerifyLead(c))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestLeadVerificationHandler_VerifyLead_Unauthorized(t *testing.T) {
+	client := setupLeadVerificationTestDB(t)
+	defer client.Close()
+
+	handler := NewLeadVerificationHandler(client, audit.NewService(client))
+
+	c, rec := newVerificationRequest(http.MethodPost, "/api/v1/leads/1/verify", `{"method":"manual_review"}`, "1", 0)
+
+	require.NoError(t, handler.VerifyLead(c))
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestLeadVerificationHandler_UnverifyLead(t *testing.T) {
+	client := setupLeadVerificationTestDB(t)
+	defer client.Close()
+
+	user := createLifecycleTestUser(t, client, "qa@b.com", "QA")
+	lead := createLifecycleTestLead(t, client, "Studio A")
+	handler := NewLeadVerificationHandler(client, audit.NewService(client))
+	id := strconv.Itoa(lead.ID)
+
+	// Not yet verified -> conflict
+	c, rec := newVerificationRequest(http.MethodPost, "/api/v1/leads/"+id+"/unverify", `{"reason":"Closed"}`, id, user.ID)
+	require.NoError(t, handler.UnverifyLead(c))
+	assert.Equal(t, http.StatusConflict, rec.Code)
+
+	// Missing reason -> bad request
+	c, rec = newVerificationRequest(http.MethodPost, "/api/v1/leads/"+id+"/unverify", `{}`, id, user.ID)
+	require.NoError(t, handler.UnverifyLead(c))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// Verify then unverify
+	c, rec = newVerificationRequest(http.MethodPost, "/api/v1/leads/"+id+"/verify", `{"method":"website_checked"}`, id, user.ID)
+	require.NoError(t, handler.VerifyLead(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	c, rec = newVerificationRequest(http.MethodPost, "/api/v1/leads/"+id+"/unverify", `{"reason":"Closed permanently"}`, id, user.ID)
+	require.NoError(t, handler.UnverifyLead(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	// History shows both events
+	c, rec = newVerificationRequest(http.MethodGet, "/api/v1/leads/"+id+"/verification-history", "", id, user.ID)
+	require.NoError(t, handler.GetVerificationHistory(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var history []leadverification.VerificationResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &history))
+	require.Len(t, history, 2)
+	assert.Equal(t, "unverify", history[0].Action)
+	assert.Equal(t, "verify", history[1].Action)
+}
+
+func TestLeadVerificationHandler_BulkVerifyLeads_JSON(t *testing.T) {
+	client := setupLeadVerificationTestDB(t)
+	defer client.Close()
+
+	user := createLifecycleTestUser(t, client, "admin@b.com", "Admin")
+	leadA := createLifecycleTestLead(t, client, "Studio A")
+	leadB := createLifecycleTestLead(t, client, "Studio B")
+	handler := NewLeadVerificationHandler(client, audit.NewService(client))
+
+	body := `{"lead_ids":[` + strconv.Itoa(leadA.ID) + `,` + strconv.Itoa(leadB.ID) + `,99999],"method":"third_party","note":"Vendor file"}`
+	c, rec := newVerificationRequest(http.MethodPost, "/api/v1/admin/leads/bulk-verify", body, "", user.ID)
+
+	require.NoError(t, handler.BulkVerifyLeads(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var result leadverification.BulkVerifyResult
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+	assert.Equal(t, 2, result.Updated)
+	assert.Equal(t, 0, result.AlreadyVerified)
+	assert.Equal(t, 1, result.NotFound)
+}
+
+func TestLeadVerificationHandler_BulkVerifyLeads_CSV(t *testing.T) {
+	client := setupLeadVerificationTestDB(t)
+	defer client.Close()
+
+	user := createLifecycleTestUser(t, client, "admin@b.com", "Admin")
+	leadA := createLifecycleTestLead(t, client, "Studio A")
+	handler := NewLeadVerificationHandler(client, audit.NewService(client))
+
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+	part, err := writer.CreateFormFile("file", "verified.csv")
+	require.NoError(t, err)
+	_, err = part.Write([]byte("lead_id\n" + strconv.Itoa(leadA.ID) + "\n"))
+	require.NoError(t, err)
+	require.NoError(t, writer.WriteField("method", "manual_review"))
+	require.NoError(t, writer.Close())
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/leads/bulk-verify", &buf)
+	req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", user.ID)
+
+	require.NoError(t, handler.BulkVerifyLeads(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var result leadverification.BulkVerifyResult
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+	assert.Equal(t, 1, result.Updated)
+}
+
+func TestLeadVerificationHandler_BulkVerifyLeads_Validation(t *testing.T) {
+	client := setupLeadVerificationTestDB(t)
+	defer client.Close()
+
+	user := createLifecycleTestUser(t, client, "admin@b.com", "Admin")
+	handler := NewLeadVerificationHandler(client, audit.NewService(client))
+
+	c, rec := newVerificationRequest(http.MethodPost, "/api/v1/admin/leads/bulk-verify", `{"lead_ids":[1],"method":"guess"}`, "", user.ID)
+	require.NoError(t, handler.BulkVerifyLeads(c))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	c, rec = newVerificationRequest(http.MethodPost, "/api/v1/admin/leads/bulk-verify", `{"lead_ids":[],"method":"manual_review"}`, "", user.ID)
+	require.NoError(t, handler.BulkVerifyLeads(c))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/pkg/api/validation"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/jordanlanch/industrydb/pkg/notification"
+	"github.com/labstack/echo/v4"
+)
+
+// NotificationPreferenceHandler handles notification preference HTTP requests.
+type NotificationPreferenceHandler struct {
+	service   *notification.Service
+	validator *validator.Validate
+}
+
+// NewNotificationPreferenceHandler creates a new notification preference handler.
+func NewNotificationPreferenceHandler(client *ent.Client) *NotificationPreferenceHandler {
+	return &NotificationPreferenceHandler{
+		service:   notification.NewService(client),
+		validator: validation.Validator(),
+	}
+}
+
+// UpdateNotificationPreferencesRequest represents a request to change notification preferences.
+type UpdateNotificationPreferencesRequest struct {
+	Preferences []notification.UpdatePreferenceRequest `json:"preferences" validate:"required,min=1,dive"`
+}
+
+// GetPreferences godoc
+// @Summary Get notification preferences
+// @Description Get the caller's email and webhook settings for every notification event type (security, billing, lead_assigned, lead_sla_overdue, mention, digest, marketing). Event types never changed show their defaults; locked ones (security) cannot be turned off.
+// @Tags User
+// @Produce json
+// @Success 200 {object} map[string]interface{}
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/user/notification-preferences [get]
+func (h *NotificationPreferenceHandler) GetPreferences(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 10*time.Second)
+	defer cancel()
+
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "User not authenticated",
+		})
+	}
+
+	prefs, err := h.service.Get(ctx, userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "server_error",
+			Message: "Failed to get notification preferences",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"preferences": prefs,
+	})
+}
+
+// UpdatePreferences godoc
+// @Summary Update notification preferences
+// @Description Turn email and webhook notifications on or off per event type. Omitted event types and channels are left unchanged. Unknown or locked event types reject the whole request.
+// @Tags User
+// @Accept json
+// @Produce json
+// @Param request body UpdateNotificationPreferencesRequest true "Preference changes"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/user/notification-preferences [put]
+func (h *NotificationPreferenceHandler) UpdatePreferences(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 10*time.Second)
+	defer cancel()
+
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "User not authenticated",
+		})
+	}
+
+	var req UpdateNotificationPreferencesRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "Invalid request body",
+		})
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "preferences must list at least one change, each with an event_type",
+		})
+	}
+
+	prefs, err := h.service.Update(ctx, userID, req.Preferences)
+	if err != nil {
+		if errors.Is(err, notification.ErrUnknownEventType) || errors.Is(err, notification.ErrLockedEventType) {
+			return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "validation_error",
+				Message: err.Error(),
+			})
+		}
+		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "server_error",
+			Message: "Failed to update notification preferences",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"preferences": prefs,
+	})
+}
@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/jordanlanch/industrydb/pkg/notification"
+	"github.com/labstack/echo/v4"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newNotificationPreferenceContext(method, body string, userID int) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(method, "/", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", userID)
+	return c, rec
+}
+
+func TestNotificationPreferenceHandler(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:notificationpreference_test?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	handler := NewNotificationPreferenceHandler(client)
+	user := createLeadNoteTestUser(t, client, "prefs@example.com", "Prefs")
+
+	decode := func(t *testing.T, rec *httptest.ResponseRecorder) map[string]notification.Preference {
+		var body struct {
+			Preferences []notification.Preference `json:"preferences"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		prefs := make(map[string]notification.Preference, len(body.Preferences))
+		for _, pref := range body.Preferences {
+			prefs[pref.EventType] = pref
+		}
+		return prefs
+	}
+
+	t.Run("GET returns defaults", func(t *testing.T) {
+		c, rec := newNotificationPreferenceContext(http.MethodGet, "", user.ID)
+		require.NoError(t, handler.GetPreferences(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		prefs := decode(t, rec)
+		assert.Len(t, prefs, len(notification.EventTypes))
+		assert.True(t, prefs[notification.EventSecurity].Locked)
+		assert.True(t, prefs[notification.EventLeadAssigned].Email)
+		assert.False(t, prefs[notification.EventMarketing].Email)
+	})
+
+	t.Run("PUT changes the given channels", func(t *testing.T) {
+		c, rec := newNotificationPreferenceContext(http.MethodPut,
+			`{"preferences":[{"event_type":"lead_assigned","email":false},{"event_type":"digest","webhook":true}]}`, user.ID)
+		require.NoError(t, handler.UpdatePreferences(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		prefs := decode(t, rec)
+		assert.False(t, prefs[notification.EventLeadAssigned].Email)
+		assert.True(t, prefs[notification.EventLeadAssigned].Webhook)
+		assert.True(t, prefs[notification.EventDigest].Email)
+		assert.True(t, prefs[notification.EventDigest].Webhook)
+
+		c, rec = newNotificationPreferenceContext(http.MethodGet, "", user.ID)
+		require.NoError(t, handler.GetPreferences(c))
+		assert.False(t, decode(t, rec)[notification.EventLeadAssigned].Email)
+	})
+
+	t.Run("PUT rejects invalid changes", func(t *testing.T) {
+		tests := []struct {
+			name string
+			body string
+		}{
+			{"empty list", `{"preferences":[]}`},
+			{"missing event type", `{"preferences":[{"email":false}]}`},
+			{"unknown event type", `{"preferences":[{"event_type":"fax","email":false}]}`},
+			{"locked event type", `{"preferences":[{"event_type":"security","email":false}]}`},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				c, rec := newNotificationPreferenceContext(http.MethodPut, tt.body, user.ID)
+				require.NoError(t, handler.UpdatePreferences(c))
+				assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+				var resp models.ErrorResponse
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+				assert.Equal(t, "validation_error", resp.Error)
+			})
+		}
+	})
+
+	t.Run("requires authentication", func(t *testing.T) {
+		e := echo.New()
+		rec := httptest.NewRecorder()
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+		require.NoError(t, handler.GetPreferences(c))
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+}
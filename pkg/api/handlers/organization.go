@@ -6,24 +6,24 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/go-playground/validator/v10"
 	"github.com/jordanlanch/industrydb/pkg/api/errors"
+	"github.com/jordanlanch/industrydb/pkg/api/validation"
 	"github.com/jordanlanch/industrydb/pkg/models"
 	"github.com/jordanlanch/industrydb/pkg/organization"
+	"github.com/jordanlanch/industrydb/pkg/webhook"
 	"github.com/labstack/echo/v4"
 )
 
 // OrganizationHandler handles organization endpoints
 type OrganizationHandler struct {
 	orgService *organization.Service
-	validator  *validator.Validate
+	webhooks   *webhook.Service // Organization webhooks (see organization_webhook.go)
 }
 
 // NewOrganizationHandler creates a new organization handler
 func NewOrganizationHandler(orgService *organization.Service) *OrganizationHandler {
 	return &OrganizationHandler{
 		orgService: orgService,
-		validator:  validator.New(),
 	}
 }
 
@@ -51,14 +51,9 @@ func (h *OrganizationHandler) Create(c echo.Context) error {
 		})
 	}
 
-	// Parse request
+	// Parse and validate request
 	var req organization.CreateOrganizationRequest
-	if err := c.Bind(&req); err != nil {
-		return errors.ValidationError(c, err)
-	}
-
-	// Validate request
-	if err := h.validator.Struct(req); err != nil {
+	if err := validation.Bind(c, &req); err != nil {
 		return errors.ValidationError(c, err)
 	}
 
@@ -149,7 +144,9 @@ func (h *OrganizationHandler) Get(c echo.Context) error {
 // @Tags Organizations
 // @Produce json
 // @Security BearerAuth
-// @Success 200 {object} map[string]interface{} "List of organizations with total count"
+// @Param page query int false "Page number (default 1)"
+// @Param limit query int false "Items per page (default all, max 100)"
+// @Success 200 {object} ListResponse{data=[]ent.Organization} "List of organizations, paginated"
 // @Failure 401 {object} models.ErrorResponse "Unauthorized"
 // @Failure 500 {object} models.ErrorResponse "Internal server error"
 // @Router /organizations [get]
@@ -168,15 +165,13 @@ func (h *OrganizationHandler) List(c echo.Context) error {
 	defer cancel()
 
 	// List organizations
-	orgs, err := h.orgService.ListUserOrganizations(ctx, userID)
+	page := parseListPage(c)
+	orgs, total, err := h.orgService.ListUserOrganizations(ctx, userID, page.Limit, page.Offset())
 	if err != nil {
 		return errors.InternalError(c, err)
 	}
 
-	return c.JSON(http.StatusOK, map[string]interface{}{
-		"organizations": orgs,
-		"total":         len(orgs),
-	})
+	return respondList(c, page, orgs, total, "organizations", "total")
 }
 
 // Update godoc
@@ -231,14 +226,9 @@ func (h *OrganizationHandler) Update(c echo.Context) error {
 		})
 	}
 
-	// Parse request
+	// Parse and validate request
 	var req organization.UpdateOrganizationRequest
-	if err := c.Bind(&req); err != nil {
-		return errors.ValidationError(c, err)
-	}
-
-	// Validate request
-	if err := h.validator.Struct(req); err != nil {
+	if err := validation.Bind(c, &req); err != nil {
 		return errors.ValidationError(c, err)
 	}
 
@@ -431,14 +421,9 @@ func (h *OrganizationHandler) InviteMember(c echo.Context) error {
 		})
 	}
 
-	// Parse request
+	// Parse and validate request
 	var req organization.InviteMemberRequest
-	if err := c.Bind(&req); err != nil {
-		return errors.ValidationError(c, err)
-	}
-
-	// Validate request
-	if err := h.validator.Struct(req); err != nil {
+	if err := validation.Bind(c, &req); err != nil {
 		return errors.ValidationError(c, err)
 	}
 
@@ -593,14 +578,11 @@ func (h *OrganizationHandler) UpdateMemberRole(c echo.Context) error {
 		})
 	}
 
-	// Parse request
+	// Parse and validate request
 	var req struct {
 		Role string `json:"role" validate:"required,oneof=admin member viewer"`
 	}
-	if err := c.Bind(&req); err != nil {
-		return errors.ValidationError(c, err)
-	}
-	if err := h.validator.Struct(req); err != nil {
+	if err := validation.Bind(c, &req); err != nil {
 		return errors.ValidationError(c, err)
 	}
 
@@ -0,0 +1,409 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/pkg/api/errors"
+	"github.com/jordanlanch/industrydb/pkg/api/validation"
+	"github.com/jordanlanch/industrydb/pkg/deliverywindow"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/jordanlanch/industrydb/pkg/webhook"
+	"github.com/labstack/echo/v4"
+)
+
+// SetWebhookService sets the webhook service behind the organization
+// webhook endpoints
+func (h *OrganizationHandler) SetWebhookService(service *webhook.Service) {
+	h.webhooks = service
+}
+
+// organizationWebhookResponse formats an organization webhook (secret excluded)
+func organizationWebhookResponse(wh *ent.Webhook) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                wh.ID,
+		"organization_id":   wh.OrganizationID,
+		"url":               wh.URL,
+		"events":            wh.Events,
+		"description":       wh.Description,
+		"active":            wh.Active,
+		"schema_version":    wh.SchemaVersion,
+		"delivery_window":   webhook.DeliveryWindow(wh),
+		"batching":          webhook.BatchingOf(wh),
+		"redaction_profile": wh.RedactionProfile,
+		"queued_events":     len(wh.QueuedEvents),
+		"success_count":     wh.SuccessCount,
+		"failure_count":     wh.FailureCount,
+		"last_triggered_at": wh.LastTriggeredAt,
+		"created_at":        wh.CreatedAt,
+	}
+}
+
+// checkWebhookAccess responds with 403 unless the user is a member of the
+// organization, and an owner or admin when manage is set. It reports
+// whether the request may continue.
+func (h *OrganizationHandler) checkWebhookAccess(c echo.Context, ctx context.Context, orgID, userID int, manage bool) (bool, error) {
+	isMember, role, err := h.orgService.CheckMembership(ctx, orgID, userID)
+	if err != nil {
+		return false, errors.InternalError(c, err)
+	}
+	if !isMember {
+		return false, c.JSON(http.StatusForbidden, models.ErrorResponse{
+			Error:   "forbidden",
+			Message: "You are not a member of this organization",
+		})
+	}
+	if manage && role != "owner" && role != "admin" {
+		return false, c.JSON(http.StatusForbidden, models.ErrorResponse{
+			Error:   "forbidden",
+			Message: "Only owners and admins can manage organization webhooks",
+		})
+	}
+	return true, nil
+}
+
+// CreateWebhook godoc
+// @Summary Create an organization webhook
+// @Description Create a webhook owned by the organization. It receives events for all members (e.g. lead.assigned for any member) and keeps working when its creator leaves. Deliveries are signed, filtered by events and retried like personal webhooks. An organization can have up to 10 webhooks. Requires owner or admin role.
+// @Tags Organizations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Organization ID"
+// @Param body body map[string]interface{} true "Webhook configuration: url, events, description, delivery_window, batching"
+// @Param Idempotency-Key header string false "Retries with the same key within the idempotency window (default 24 hours) return the first response instead of repeating the request"
+// @Success 201 {object} map[string]interface{} "Webhook created, with its signing secret"
+// @Failure 400 {object} models.ErrorResponse "Invalid request"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden - owner or admin required"
+// @Failure 409 {object} models.ErrorResponse "Organization webhook limit reached"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /organizations/{id}/webhooks [post]
+func (h *OrganizationHandler) CreateWebhook(c echo.Context) error {
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "Authentication required",
+		})
+	}
+
+	orgID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Organization ID must be a number",
+		})
+	}
+
+	var req struct {
+		URL              string                 `json:"url" validate:"required,url"`
+		Events           []string               `json:"events" validate:"required,min=1"`
+		Description      string                 `json:"description"`
+		DeliveryWindow   *deliverywindow.Window `json:"delivery_window"`   // Allowed local hours (default 24/7)
+		Batching         *webhook.Batching      `json:"batching"`          // Batched delivery (default one delivery per event)
+		RedactionProfile string                 `json:"redaction_profile"` // Redaction profile applied to lead data (default none)
+	}
+	if err := validation.Bind(c, &req); err != nil {
+		return errors.ValidationError(c, err)
+	}
+	if err := req.DeliveryWindow.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+	}
+	if err := req.Batching.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+	}
+	if err := h.webhooks.ValidateRedactionProfile(req.RedactionProfile); err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 10*time.Second)
+	defer cancel()
+
+	if ok, err := h.checkWebhookAccess(c, ctx, orgID, userID, true); !ok {
+		return err
+	}
+
+	wh, err := h.webhooks.CreateOrganizationWebhook(ctx, orgID, userID, req.URL, req.Events, req.Description)
+	if err != nil {
+		if stderrors.Is(err, webhook.ErrOrganizationWebhookLimit) {
+			return c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "webhook_limit_reached",
+				Message: "Organization webhook limit reached (" + strconv.Itoa(webhook.MaxOrganizationWebhooks) + ")",
+			})
+		}
+		if stderrors.Is(err, webhook.ErrInvalidURL) {
+			return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "invalid_url",
+				Message: err.Error(),
+			})
+		}
+		return errors.InternalError(c, err)
+	}
+	if req.DeliveryWindow != nil {
+		wh, err = h.webhooks.SetOrganizationDeliveryWindow(ctx, orgID, wh.ID, req.DeliveryWindow)
+		if err != nil {
+			return errors.InternalError(c, err)
+		}
+	}
+	if req.Batching != nil {
+		wh, err = h.webhooks.SetOrganizationBatching(ctx, orgID, wh.ID, req.Batching)
+		if err != nil {
+			return errors.InternalError(c, err)
+		}
+	}
+	if req.RedactionProfile != "" {
+		wh, err = h.webhooks.SetOrganizationRedactionProfile(ctx, orgID, wh.ID, req.RedactionProfile)
+		if err != nil {
+			return errors.InternalError(c, err)
+		}
+	}
+
+	response := organizationWebhookResponse(wh)
+	response["secret"] = wh.Secret // Return secret only on creation
+	return c.JSON(http.StatusCreated, response)
+}
+
+// ListWebhooks godoc
+// @Summary List organization webhooks
+// @Description List the organization's webhooks. Any member can list them; secrets are not included.
+// @Tags Organizations
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Organization ID"
+// @Param page query int false "Page number (default 1)"
+// @Param limit query int false "Items per page (default all, max 100)"
+// @Success 200 {object} ListResponse{data=[]object} "List of webhooks, paginated"
+// @Failure 400 {object} models.ErrorResponse "Invalid ID"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Not a member"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /organizations/{id}/webhooks [get]
+func (h *OrganizationHandler) ListWebhooks(c echo.Context) error {
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "Authentication required",
+		})
+	}
+
+	orgID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Organization ID must be a number",
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if ok, err := h.checkWebhookAccess(c, ctx, orgID, userID, false); !ok {
+		return err
+	}
+
+	page := parseListPage(c)
+	webhooks, total, err := h.webhooks.ListOrganizationWebhooks(ctx, orgID, page.Limit, page.Offset())
+	if err != nil {
+		return errors.InternalError(c, err)
+	}
+
+	response := make([]map[string]interface{}, len(webhooks))
+	for i, wh := range webhooks {
+		response[i] = organizationWebhookResponse(wh)
+	}
+
+	return respondList(c, page, response, total, "webhooks", "count")
+}
+
+// UpdateWebhook godoc
+// @Summary Update an organization webhook
+// @Description Update an organization webhook's url, events, active flag, pinned schema_version, delivery_window (null to deliver 24/7) or batching (null for one delivery per event). Requires owner or admin role.
+// @Tags Organizations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Organization ID"
+// @Param webhook_id path int true "Webhook ID"
+// @Param body body map[string]interface{} true "Update fields"
+// @Success 200 {object} map[string]interface{} "Updated webhook"
+// @Failure 400 {object} models.ErrorResponse "Invalid request"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden - owner or admin required"
+// @Failure 404 {object} models.ErrorResponse "Webhook not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /organizations/{id}/webhooks/{webhook_id} [patch]
+func (h *OrganizationHandler) UpdateWebhook(c echo.Context) error {
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "Authentication required",
+		})
+	}
+
+	orgID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Organization ID must be a number",
+		})
+	}
+	webhookID, err := strconv.Atoi(c.Param("webhook_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_webhook_id",
+			Message: "Webhook ID must be a number",
+		})
+	}
+
+	var req struct {
+		URL              *string         `json:"url" validate:"omitempty,url"`
+		Events           []string        `json:"events" validate:"omitempty,min=1"`
+		Active           *bool           `json:"active"`
+		SchemaVersion    *string         `json:"schema_version"`    // Pin the payload schema version
+		DeliveryWindow   json.RawMessage `json:"delivery_window"`   // Allowed local hours, null for 24/7
+		Batching         json.RawMessage `json:"batching"`          // Batched delivery, null for one delivery per event
+		RedactionProfile *string         `json:"redaction_profile"` // Redaction profile applied to lead data, "" for none
+	}
+	if err := validation.Bind(c, &req); err != nil {
+		return errors.ValidationError(c, err)
+	}
+
+	var window *deliverywindow.Window
+	if len(req.DeliveryWindow) > 0 {
+		if err := json.Unmarshal(req.DeliveryWindow, &window); err != nil {
+			return errors.ValidationError(c, err)
+		}
+		if err := window.Validate(); err != nil {
+			return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "validation_error",
+				Message: err.Error(),
+			})
+		}
+	}
+
+	var batching *webhook.Batching
+	if len(req.Batching) > 0 {
+		if err := json.Unmarshal(req.Batching, &batching); err != nil {
+			return errors.ValidationError(c, err)
+		}
+		if err := batching.Validate(); err != nil {
+			return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "validation_error",
+				Message: err.Error(),
+			})
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 10*time.Second)
+	defer cancel()
+
+	if ok, err := h.checkWebhookAccess(c, ctx, orgID, userID, true); !ok {
+		return err
+	}
+
+	wh, err := h.webhooks.UpdateOrganizationWebhook(ctx, orgID, webhookID, req.URL, req.Events, req.Active, req.SchemaVersion)
+	if err == nil && len(req.DeliveryWindow) > 0 {
+		wh, err = h.webhooks.SetOrganizationDeliveryWindow(ctx, orgID, webhookID, window)
+	}
+	if err == nil && len(req.Batching) > 0 {
+		wh, err = h.webhooks.SetOrganizationBatching(ctx, orgID, webhookID, batching)
+	}
+	if err == nil && req.RedactionProfile != nil {
+		wh, err = h.webhooks.SetOrganizationRedactionProfile(ctx, orgID, webhookID, *req.RedactionProfile)
+	}
+	if err != nil {
+		if stderrors.Is(err, webhook.ErrUnsupportedSchemaVersion) || stderrors.Is(err, webhook.ErrInvalidRedactionProfile) {
+			return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "validation_error",
+				Message: err.Error(),
+			})
+		}
+		if stderrors.Is(err, webhook.ErrInvalidURL) {
+			return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "invalid_url",
+				Message: err.Error(),
+			})
+		}
+		if ent.IsNotFound(err) {
+			return errors.NotFoundError(c, "webhook")
+		}
+		return errors.InternalError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, organizationWebhookResponse(wh))
+}
+
+// DeleteWebhook godoc
+// @Summary Delete an organization webhook
+// @Description Delete an organization webhook. Requires owner or admin role.
+// @Tags Organizations
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Organization ID"
+// @Param webhook_id path int true "Webhook ID"
+// @Success 200 {object} map[string]string "Webhook deleted"
+// @Failure 400 {object} models.ErrorResponse "Invalid ID"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden - owner or admin required"
+// @Failure 404 {object} models.ErrorResponse "Webhook not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /organizations/{id}/webhooks/{webhook_id} [delete]
+func (h *OrganizationHandler) DeleteWebhook(c echo.Context) error {
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "Authentication required",
+		})
+	}
+
+	orgID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_id",
+			Message: "Organization ID must be a number",
+		})
+	}
+	webhookID, err := strconv.Atoi(c.Param("webhook_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_webhook_id",
+			Message: "Webhook ID must be a number",
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 10*time.Second)
+	defer cancel()
+
+	if ok, err := h.checkWebhookAccess(c, ctx, orgID, userID, true); !ok {
+		return err
+	}
+
+	if err := h.webhooks.DeleteOrganizationWebhook(ctx, orgID, webhookID); err != nil {
+		if ent.IsNotFound(err) {
+			return errors.NotFoundError(c, "webhook")
+		}
+		return errors.InternalError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "Webhook deleted successfully",
+	})
+}
@@ -0,0 +1,147 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent/organizationmember"
+	"github.com/jordanlanch/industrydb/pkg/webhook"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newOrgWebhookContext builds a request context for an organization webhook endpoint
+func newOrgWebhookContext(method string, userID, orgID, webhookID int, body string) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(method, "/api/v1/organizations/"+strconv.Itoa(orgID)+"/webhooks", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", userID)
+	if webhookID > 0 {
+		c.SetParamNames("id", "webhook_id")
+		c.SetParamValues(strconv.Itoa(orgID), strconv.Itoa(webhookID))
+	} else {
+		c.SetParamNames("id")
+		c.SetParamValues(strconv.Itoa(orgID))
+	}
+	return c, rec
+}
+
+func TestOrganizationHandler_Webhooks(t *testing.T) {
+	client, handler, owner, member := setupOrgTest(t)
+	handler.SetWebhookService(webhook.NewService(client))
+	org := createTestOrg(t, client, owner.ID, "Webhook Org", "webhook-org")
+	_, err := client.OrganizationMember.Create().
+		SetOrganizationID(org.ID).
+		SetUserID(member.ID).
+		SetRole(organizationmember.RoleMember).
+		SetStatus(organizationmember.StatusActive).
+		SetJoinedAt(time.Now()).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	body := `{"url":"https://crm.example.com/hook","events":["lead.assigned"],"description":"CRM"}`
+	var webhookID int
+
+	t.Run("owner_creates", func(t *testing.T) {
+		c, rec := newOrgWebhookContext(http.MethodPost, owner.ID, org.ID, 0, body)
+		require.NoError(t, handler.CreateWebhook(c))
+		require.Equal(t, http.StatusCreated, rec.Code)
+
+		var resp map[string]interface{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, float64(org.ID), resp["organization_id"])
+		assert.NotEmpty(t, resp["secret"])
+		webhookID = int(resp["id"].(float64))
+	})
+
+	t.Run("member_cannot_create", func(t *testing.T) {
+		c, rec := newOrgWebhookContext(http.MethodPost, member.ID, org.ID, 0, body)
+		require.NoError(t, handler.CreateWebhook(c))
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("invalid_url", func(t *testing.T) {
+		c, rec := newOrgWebhookContext(http.MethodPost, owner.ID, org.ID, 0, `{"url":"not a url","events":["lead.assigned"]}`)
+		require.NoError(t, handler.CreateWebhook(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("member_lists_without_secret", func(t *testing.T) {
+		c, rec := newOrgWebhookContext(http.MethodGet, member.ID, org.ID, 0, "")
+		require.NoError(t, handler.ListWebhooks(c))
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var resp struct {
+			Webhooks []map[string]interface{} `json:"webhooks"`
+			Count    int                      `json:"count"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		require.Equal(t, 1, resp.Count)
+		assert.Equal(t, "https://crm.example.com/hook", resp.Webhooks[0]["url"])
+		assert.NotContains(t, resp.Webhooks[0], "secret")
+	})
+
+	t.Run("non_member_cannot_list", func(t *testing.T) {
+		outsider := createWebhookTestUser(t, client, "outsider@test.com")
+		c, rec := newOrgWebhookContext(http.MethodGet, outsider, org.ID, 0, "")
+		require.NoError(t, handler.ListWebhooks(c))
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("member_cannot_edit", func(t *testing.T) {
+		c, rec := newOrgWebhookContext(http.MethodPatch, member.ID, org.ID, webhookID, `{"active":false}`)
+		require.NoError(t, handler.UpdateWebhook(c))
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+
+		c, rec = newOrgWebhookContext(http.MethodDelete, member.ID, org.ID, webhookID, "")
+		require.NoError(t, handler.DeleteWebhook(c))
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("owner_updates", func(t *testing.T) {
+		c, rec := newOrgWebhookContext(http.MethodPatch, owner.ID, org.ID, webhookID, `{"events":["lead.assigned","lead.created"],"active":false}`)
+		require.NoError(t, handler.UpdateWebhook(c))
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var resp map[string]interface{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, false, resp["active"])
+		assert.Len(t, resp["events"], 2)
+	})
+
+	t.Run("not_in_personal_webhooks", func(t *testing.T) {
+		c, rec := newWebhookIDContext(owner.ID, webhookID)
+		require.NoError(t, NewWebhookHandler(webhook.NewService(client)).GetWebhook(c))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("limit_reached", func(t *testing.T) {
+		for i := 1; i < webhook.MaxOrganizationWebhooks; i++ {
+			c, rec := newOrgWebhookContext(http.MethodPost, owner.ID, org.ID, 0, body)
+			require.NoError(t, handler.CreateWebhook(c))
+			require.Equal(t, http.StatusCreated, rec.Code)
+		}
+		c, rec := newOrgWebhookContext(http.MethodPost, owner.ID, org.ID, 0, body)
+		require.NoError(t, handler.CreateWebhook(c))
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+
+	t.Run("owner_deletes", func(t *testing.T) {
+		c, rec := newOrgWebhookContext(http.MethodDelete, owner.ID, org.ID, webhookID, "")
+		require.NoError(t, handler.DeleteWebhook(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		c, rec = newOrgWebhookContext(http.MethodDelete, owner.ID, org.ID, webhookID, "")
+		require.NoError(t, handler.DeleteWebhook(c))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+// MaxListLimit caps the page size of list endpoints
+const MaxListLimit = 100
+
+// maxListOffset caps the offset of a page, which is then past the end of
+// any list
+const maxListOffset = math.MaxInt32
+
+// ListResponse is the pagination envelope returned by list endpoints. Data
+// holds the requested page of items and Total the number of items across
+// all pages.
+type ListResponse struct {
+	Data    interface{} `json:"data"`
+	Total   int         `json:"total"`
+	Page    int         `json:"page"`
+	Limit   int         `json:"limit"`
+	HasMore bool        `json:"has_more"`
+}
+
+// listPage is the page of a list endpoint selected by the page (default 1)
+// and limit (default all items, max MaxListLimit) query params. Services
+// load only that page, with Limit and Offset, and count the total
+// separately.
+type listPage struct {
+	Page int
+	// Page size, 0 for every item
+	Limit int
+}
+
+// parseListPage reads the page and limit query params. Invalid values fall
+// back to the defaults.
+func parseListPage(c echo.Context) listPage {
+	page := 1
+	if p, err := strconv.Atoi(c.QueryParam("page")); err == nil && p > 0 {
+		page = p
+	}
+	limit := 0
+	if l, err := strconv.Atoi(c.QueryParam("limit")); err == nil && l > 0 {
+		limit = min(l, MaxListLimit)
+	}
+
+	// Without a limit every item is returned, as list endpoints did before
+	// the envelope
+	if limit == 0 {
+		page = 1
+	}
+	return listPage{Page: page, Limit: limit}
+}
+
+// Offset is the number of items before the page
+func (p listPage) Offset() int {
+	if p.Limit == 0 {
+		return 0
+	}
+	if p.Page-1 > maxListOffset/p.Limit {
+		return maxListOffset
+	}
+	return (p.Page - 1) * p.Limit
+}
+
+// pageEnvelope wraps the loaded items of a page in the ListResponse
+// envelope, total being the number of items across all pages
+func pageEnvelope[T any](p listPage, items []T, total int) ListResponse {
+	limit := p.Limit
+	if limit == 0 {
+		limit = total
+	}
+	return ListResponse{
+		Data:    items,
+		Total:   total,
+		Page:    p.Page,
+		Limit:   limit,
+		HasMore: p.Offset()+len(items) < total,
+	}
+}
+
+// respondList writes the loaded items of a page in the ListResponse
+// envelope. During the transition to the envelope the page is also
+// returned under the list endpoint's legacy key, with the total under
+// legacyCountKey; both are deprecated and will be dropped in the next API
+// version.
+func respondList[T any](c echo.Context, p listPage, items []T, total int, legacyKey, legacyCountKey string) error {
+	envelope := pageEnvelope(p, items, total)
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"data":         envelope.Data,
+		"total":        envelope.Total,
+		"page":         envelope.Page,
+		"limit":        envelope.Limit,
+		"has_more":     envelope.HasMore,
+		legacyKey:      envelope.Data,
+		legacyCountKey: envelope.Total,
+	})
+}
@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func paginationContext(query string) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/items?"+query, nil)
+	rec := httptest.NewRecorder()
+	return e.NewContext(req, rec), rec
+}
+
+func TestParseListPage(t *testing.T) {
+	tests := []struct {
+		name   string
+		query  string
+		page   int
+		limit  int
+		offset int
+	}{
+		{"no limit returns everything", "page=3", 1, 0, 0},
+		{"first page", "limit=2", 1, 2, 0},
+		{"middle page", "page=2&limit=2", 2, 2, 2},
+		{"huge page", "page=9223372036854775807&limit=2", 9223372036854775807, 2, maxListOffset},
+		{"limit is capped", "limit=1000", 1, MaxListLimit, 0},
+		{"invalid values", "page=-1&limit=abc", 1, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := paginationContext(tt.query)
+			page := parseListPage(c)
+
+			assert.Equal(t, tt.page, page.Page)
+			assert.Equal(t, tt.limit, page.Limit)
+			assert.Equal(t, tt.offset, page.Offset())
+		})
+	}
+}
+
+func TestPageEnvelope(t *testing.T) {
+	tests := []struct {
+		name    string
+		page    listPage
+		items   []int
+		limit   int
+		hasMore bool
+	}{
+		{"no limit reports the total as limit", listPage{Page: 1}, []int{1, 2, 3, 4, 5}, 5, false},
+		{"first page", listPage{Page: 1, Limit: 2}, []int{1, 2}, 2, true},
+		{"last page", listPage{Page: 3, Limit: 2}, []int{5}, 2, false},
+		{"past the end", listPage{Page: 9, Limit: 2}, []int{}, 2, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			envelope := pageEnvelope(tt.page, tt.items, 5)
+
+			assert.Equal(t, tt.items, envelope.Data)
+			assert.Equal(t, 5, envelope.Total)
+			assert.Equal(t, tt.page.Page, envelope.Page)
+			assert.Equal(t, tt.limit, envelope.Limit)
+			assert.Equal(t, tt.hasMore, envelope.HasMore)
+		})
+	}
+}
+
+func TestRespondList(t *testing.T) {
+	c, rec := paginationContext("page=2&limit=1")
+	require.NoError(t, respondList(c, parseListPage(c), []string{"b"}, 3, "webhooks", "count"))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var resp map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, []interface{}{"b"}, resp["data"])
+	assert.Equal(t, float64(3), resp["total"])
+	assert.Equal(t, float64(2), resp["page"])
+	assert.Equal(t, float64(1), resp["limit"])
+	assert.Equal(t, true, resp["has_more"])
+
+	// Legacy keys are kept during the transition
+	assert.Equal(t, []interface{}{"b"}, resp["webhooks"])
+	assert.Equal(t, float64(3), resp["count"])
+}
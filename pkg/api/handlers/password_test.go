@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/alicebob/miniredis/v2"
 	"github.com/jordanlanch/industrydb/config"
 	"github.com/jordanlanch/industrydb/ent"
 	"github.com/jordanlanch/industrydb/ent/enttest"
@@ -485,8 +486,8 @@ func TestResetPassword_TokenReuse(t *testing.T) {
 		t.Fatalf("Failed to parse response: %v", err)
 	}
 
-	if response.Error != "invalid_token" {
-		t.Errorf("Expected error 'invalid_token' (token already used), got %s", response.Error)
+	if response.Error != "token_used" {
+		t.Errorf("Expected error 'token_used', got %s", response.Error)
 	}
 }
 
@@ -523,6 +524,99 @@ func TestResetPassword_MissingToken(t *testing.T) {
 	}
 }
 
+// resetPasswordWith posts a password reset and returns the status and error code
+func resetPasswordWith(t *testing.T, handler *AuthHandler, token string) (int, string) {
+	t.Helper()
+
+	e := newTestEchoWithValidator()
+	body := `{"token":"` + token + `","new_password":"newpassword123"}`
+	req := httptest.NewRequest(http.MethodPost, "/auth/reset-password", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	if err := handler.ResetPassword(e.NewContext(req, rec)); err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+
+	var response models.ErrorResponse
+	_ = json.Unmarshal(rec.Body.Bytes(), &response)
+	return rec.Code, response.Error
+}
+
+// TestResetPassword_TokenLifecycle tests the reset token TTL, single use,
+// superseding and session revocation
+func TestResetPassword_TokenLifecycle(t *testing.T) {
+	handler, client, cleanup := setupAuthTestHandler(t)
+	defer cleanup()
+
+	mr := miniredis.RunT(t)
+	cacheClient, err := cache.NewClient("redis://" + mr.Addr())
+	if err != nil {
+		t.Fatalf("Failed to create cache client: %v", err)
+	}
+	defer cacheClient.Close()
+	blacklist := auth.NewTokenBlacklist(cacheClient)
+	handler.cache = cacheClient
+	handler.sessions = auth.NewSessionStore(cacheClient, blacklist)
+	handler.auditLogger = audit.NewService(client)
+	handler.config.PasswordResetTokenTTLMinutes = 30
+
+	ctx := context.Background()
+	u, err := createPasswordTestUser(ctx, client, "reset-lifecycle@example.com", "Reset User")
+	if err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+
+	// The token lives for the configured TTL
+	if err := handler.storePasswordResetToken(ctx, u.ID, auth.HashResetToken("first-token")); err != nil {
+		t.Fatalf("Failed to store reset token: %v", err)
+	}
+	if ttl := mr.TTL(passwordResetKey(auth.HashResetToken("first-token"))); ttl != 30*time.Minute {
+		t.Errorf("Expected token TTL 30m, got %v", ttl)
+	}
+
+	// A new request supersedes the previous link
+	if err := handler.storePasswordResetToken(ctx, u.ID, auth.HashResetToken("second-token")); err != nil {
+		t.Fatalf("Failed to store reset token: %v", err)
+	}
+	if code, errorCode := resetPasswordWith(t, handler, "first-token"); code != http.StatusBadRequest || errorCode != "token_superseded" {
+		t.Errorf("Superseded token: expected 400 token_superseded, got %d %s", code, errorCode)
+	}
+
+	// Resetting signs out every session
+	token, err := auth.GenerateJWT(u.ID, u.Email, "free", "test-secret-key", 24)
+	if err != nil {
+		t.Fatalf("Failed to generate JWT: %v", err)
+	}
+	if err := handler.sessions.Track(ctx, token, "test-agent", "10.0.0.1"); err != nil {
+		t.Fatalf("Failed to track session: %v", err)
+	}
+
+	if code, errorCode := resetPasswordWith(t, handler, "second-token"); code != http.StatusOK {
+		t.Fatalf("Valid token: expected 200, got %d %s", code, errorCode)
+	}
+	if _, err := auth.ValidateJWTWithBlacklist(ctx, token, "test-secret-key", blacklist); err == nil {
+		t.Error("Expected the session to be revoked after the password reset")
+	}
+
+	// The token can't be used twice
+	if code, errorCode := resetPasswordWith(t, handler, "second-token"); code != http.StatusBadRequest || errorCode != "token_used" {
+		t.Errorf("Reused token: expected 400 token_used, got %d %s", code, errorCode)
+	}
+
+	// Tokens stop working after the TTL
+	if err := handler.storePasswordResetToken(ctx, u.ID, auth.HashResetToken("third-token")); err != nil {
+		t.Fatalf("Failed to store reset token: %v", err)
+	}
+	mr.FastForward(31 * time.Minute)
+	if code, errorCode := resetPasswordWith(t, handler, "third-token"); code != http.StatusBadRequest || errorCode != "token_expired" {
+		t.Errorf("Expired token: expected 400 token_expired, got %d %s", code, errorCode)
+	}
+
+	if code, errorCode := resetPasswordWith(t, handler, "unknown-token"); code != http.StatusBadRequest || errorCode != "invalid_token" {
+		t.Errorf("Unknown token: expected 400 invalid_token, got %d %s", code, errorCode)
+	}
+}
+
 // TestGeneratePasswordResetToken tests token generation produces unique tokens
 func TestGeneratePasswordResetToken(t *testing.T) {
 	tokens := make(map[string]bool)
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/jordanlanch/industrydb/pkg/retention"
+	"github.com/labstack/echo/v4"
+)
+
+// RetentionHandler handles data retention requests
+type RetentionHandler struct {
+	service *retention.Service
+}
+
+// NewRetentionHandler creates a new retention handler
+func NewRetentionHandler(service *retention.Service) *RetentionHandler {
+	return &RetentionHandler{
+		service: service,
+	}
+}
+
+// PurgeRequest confirms a manual retention purge
+type PurgeRequest struct {
+	Confirm bool `json:"confirm"`
+}
+
+// Preview godoc
+// @Summary Preview data retention purge
+// @Description Show how many usage logs and audit logs a purge would remove right now (admin only)
+// @Tags admin, retention
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} retention.Preview
+// @Failure 500 {object} models.ErrorResponse
+// @Router /admin/retention/preview [get]
+func (h *RetentionHandler) Preview(c echo.Context) error {
+	preview, err := h.service.Preview(c.Request().Context(), time.Now())
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "server_error",
+			Message: "Failed to preview retention purge",
+		})
+	}
+
+	return c.JSON(http.StatusOK, preview)
+}
+
+// Purge godoc
+// @Summary Run data retention purge
+// @Description Roll up and purge usage logs and purge audit logs past their retention window now (admin only). Requires {"confirm": true}; use the preview endpoint first.
+// @Tags admin, retention
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body PurgeRequest true "Purge confirmation"
+// @Success 200 {object} retention.PurgeResult
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 409 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /admin/retention/purge [post]
+func (h *RetentionHandler) Purge(c echo.Context) error {
+	var req PurgeRequest
+	if err := c.Bind(&req); err != nil || !req.Confirm {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "confirmation_required",
+			Message: "Set confirm to true to purge data; use GET /admin/retention/preview to see what would be removed",
+		})
+	}
+
+	var actorID *int
+	if userID, ok := c.Get("user_id").(int); ok {
+		actorID = &userID
+	}
+
+	result, err := h.service.Purge(c.Request().Context(), time.Now(), actorID)
+	if err != nil {
+		if errors.Is(err, retention.ErrPurgeInProgress) {
+			return c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "purge_in_progress",
+				Message: "A retention purge is already running",
+			})
+		}
+		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "server_error",
+			Message: "Retention purge failed: " + err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/auditlog"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/pkg/retention"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func setupRetentionTest(t *testing.T) (*ent.Client, *RetentionHandler) {
+	client := enttest.Open(t, "sqlite3", "file:retention_handler?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	ctx := context.Background()
+	for _, createdAt := range []time.Time{time.Now().AddDate(-8, 0, 0), time.Now()} {
+		_, err := client.AuditLog.Create().
+			SetAction(auditlog.ActionUserLogin).
+			SetCreatedAt(createdAt).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	service := retention.NewService(client, retention.Config{UsageLogRetentionDays: 365, AuditLogRetentionDays: 2555}, nil)
+	return client, NewRetentionHandler(service)
+}
+
+func TestRetentionHandler_Preview(t *testing.T) {
+	client, handler := setupRetentionTest(t)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/admin/retention/preview", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	require.NoError(t, handler.Preview(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var preview retention.Preview
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &preview))
+	assert.Equal(t, 0, preview.UsageLogs.Rows)
+	assert.Equal(t, 2555, preview.AuditLogs.RetentionDays)
+	assert.Equal(t, 1, preview.AuditLogs.Rows)
+
+	count, err := client.AuditLog.Query().Count(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, count, "preview must not delete rows")
+}
+
+func TestRetentionHandler_Purge(t *testing.T) {
+	client, handler := setupRetentionTest(t)
+	e := echo.New()
+
+	t.Run("requires confirmation", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/admin/retention/purge", strings.NewReader(`{}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		require.NoError(t, handler.Purge(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "confirmation_required")
+
+		count, err := client.AuditLog.Query().Count(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+	})
+
+	t.Run("purges expired rows", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/admin/retention/purge", strings.NewReader(`{"confirm":true}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		require.NoError(t, handler.Purge(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var result retention.PurgeResult
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+		assert.Equal(t, 1, result.AuditLogs.Purged)
+		assert.Equal(t, 0, result.AuditLogs.Archived)
+
+		logins, err := client.AuditLog.Query().Where(auditlog.ActionEQ(auditlog.ActionUserLogin)).Count(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 1, logins)
+	})
+}
@@ -2,12 +2,14 @@ package handlers
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"strconv"
-	"time"
 
 	"github.com/jordanlanch/industrydb/ent"
 	"github.com/jordanlanch/industrydb/pkg/analytics"
+	"github.com/jordanlanch/industrydb/pkg/api/errors"
+	"github.com/jordanlanch/industrydb/pkg/database"
 	"github.com/jordanlanch/industrydb/pkg/models"
 	"github.com/labstack/echo/v4"
 )
@@ -15,15 +17,22 @@ import (
 // RevenueHandler handles revenue forecasting operations
 type RevenueHandler struct {
 	service *analytics.Service
+	guard   *analytics.Guard
 }
 
 // NewRevenueHandler creates a new revenue forecasting handler
 func NewRevenueHandler(db *ent.Client) *RevenueHandler {
 	return &RevenueHandler{
 		service: analytics.NewService(db),
+		guard:   analytics.NewGuard(nil, analytics.GuardConfig{}),
 	}
 }
 
+// SetGuard sets the range limit, timeout and cache of revenue reports
+func (h *RevenueHandler) SetGuard(guard *analytics.Guard) {
+	h.guard = guard
+}
+
 // GetMonthlyRevenueForecast godoc
 // @Summary Get monthly revenue forecast
 // @Description Get forecasted revenue for the next N months based on historical data
@@ -33,12 +42,10 @@ func NewRevenueHandler(db *ent.Client) *RevenueHandler {
 // @Success 200 {object} analytics.MonthlyRevenueForecast
 // @Failure 400 {object} models.ErrorResponse
 // @Failure 500 {object} models.ErrorResponse
+// @Failure 504 {object} models.ErrorResponse "Query timed out"
 // @Security BearerAuth
 // @Router /api/v1/analytics/revenue/monthly-forecast [get]
 func (h *RevenueHandler) GetMonthlyRevenueForecast(c echo.Context) error {
-	ctx, cancel := context.WithTimeout(c.Request().Context(), 10*time.Second)
-	defer cancel()
-
 	// Parse months parameter
 	monthsStr := c.QueryParam("months")
 	months := 12 // default
@@ -53,8 +60,13 @@ func (h *RevenueHandler) GetMonthlyRevenueForecast(c echo.Context) error {
 		months = parsedMonths
 	}
 
-	forecast, err := h.service.GetMonthlyRevenueForecast(ctx, months)
+	forecast, err := analytics.Guarded(c.Request().Context(), h.guard, fmt.Sprintf("revenue:monthly-forecast:%d", months), func(ctx context.Context) (*analytics.MonthlyRevenueForecast, error) {
+		return h.service.GetMonthlyRevenueForecast(ctx, months)
+	})
 	if err != nil {
+		if database.IsQueryTimeout(err) {
+			return errors.QueryTimeoutError(c, err)
+		}
 		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error:   "server_error",
 			Message: err.Error(),
@@ -71,14 +83,15 @@ func (h *RevenueHandler) GetMonthlyRevenueForecast(c echo.Context) error {
 // @Produce json
 // @Success 200 {object} analytics.AnnualRevenueForecast
 // @Failure 500 {object} models.ErrorResponse
+// @Failure 504 {object} models.ErrorResponse "Query timed out"
 // @Security BearerAuth
 // @Router /api/v1/analytics/revenue/annual-forecast [get]
 func (h *RevenueHandler) GetAnnualRevenueForecast(c echo.Context) error {
-	ctx, cancel := context.WithTimeout(c.Request().Context(), 10*time.Second)
-	defer cancel()
-
-	forecast, err := h.service.GetAnnualRevenueForecast(ctx)
+	forecast, err := analytics.Guarded(c.Request().Context(), h.guard, "revenue:annual-forecast", h.service.GetAnnualRevenueForecast)
 	if err != nil {
+		if database.IsQueryTimeout(err) {
+			return errors.QueryTimeoutError(c, err)
+		}
 		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error:   "server_error",
 			Message: err.Error(),
@@ -95,14 +108,15 @@ func (h *RevenueHandler) GetAnnualRevenueForecast(c echo.Context) error {
 // @Produce json
 // @Success 200 {object} analytics.RevenueByTier
 // @Failure 500 {object} models.ErrorResponse
+// @Failure 504 {object} models.ErrorResponse "Query timed out"
 // @Security BearerAuth
 // @Router /api/v1/analytics/revenue/by-tier [get]
 func (h *RevenueHandler) GetRevenueByTier(c echo.Context) error {
-	ctx, cancel := context.WithTimeout(c.Request().Context(), 10*time.Second)
-	defer cancel()
-
-	breakdown, err := h.service.GetRevenueByTier(ctx)
+	breakdown, err := analytics.Guarded(c.Request().Context(), h.guard, "revenue:by-tier", h.service.GetRevenueByTier)
 	if err != nil {
+		if database.IsQueryTimeout(err) {
+			return errors.QueryTimeoutError(c, err)
+		}
 		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error:   "server_error",
 			Message: err.Error(),
@@ -119,14 +133,12 @@ func (h *RevenueHandler) GetRevenueByTier(c echo.Context) error {
 // @Produce json
 // @Param months query int false "Number of months to analyze (default: 3, max: 12)"
 // @Success 200 {object} map[string]float64
-// @Failure 400 {object} models.ErrorResponse
+// @Failure 400 {object} models.ErrorResponse "Invalid parameters or range too large"
 // @Failure 500 {object} models.ErrorResponse
+// @Failure 504 {object} models.ErrorResponse "Query timed out"
 // @Security BearerAuth
 // @Router /api/v1/analytics/revenue/growth-rate [get]
 func (h *RevenueHandler) GetGrowthRate(c echo.Context) error {
-	ctx, cancel := context.WithTimeout(c.Request().Context(), 10*time.Second)
-	defer cancel()
-
 	// Parse months parameter
 	monthsStr := c.QueryParam("months")
 	months := 3 // default
@@ -140,9 +152,17 @@ func (h *RevenueHandler) GetGrowthRate(c echo.Context) error {
 		}
 		months = parsedMonths
 	}
+	if err := h.guard.CheckRange(months * analytics.PeriodDays("month")); err != nil {
+		return analyticsRangeTooLarge(c, err)
+	}
 
-	growthRate, err := h.service.GetGrowthRate(ctx, months)
+	growthRate, err := analytics.Guarded(c.Request().Context(), h.guard, fmt.Sprintf("revenue:growth-rate:%d", months), func(ctx context.Context) (float64, error) {
+		return h.service.GetGrowthRate(ctx, months)
+	})
 	if err != nil {
+		if database.IsQueryTimeout(err) {
+			return errors.QueryTimeoutError(c, err)
+		}
 		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error:   "server_error",
 			Message: err.Error(),
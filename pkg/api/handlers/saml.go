@@ -20,6 +20,7 @@ import (
 type SAMLHandler struct {
 	samlService *saml.Service
 	auditLogger *audit.Service
+	sessions    *auth.SessionStore
 	jwtSecret   string
 	jwtExp      int
 	frontendURL string
@@ -36,6 +37,12 @@ func NewSAMLHandler(samlService *saml.Service, auditLogger *audit.Service, jwtSe
 	}
 }
 
+// SetSessionStore enables tracking SSO logins as sessions (see
+// SessionHandler)
+func (h *SAMLHandler) SetSessionStore(sessions *auth.SessionStore) {
+	h.sessions = sessions
+}
+
 // GetMetadata godoc
 // @Summary Get SAML Service Provider metadata
 // @Description Returns the SAML 2.0 Service Provider metadata XML for the specified organization
@@ -211,21 +218,15 @@ func (h *SAMLHandler) AssertionConsumerService(c echo.Context) error {
 		return h.redirectWithError(c, "user_creation_error")
 	}
 
-	// Generate JWT
-	token, err := auth.GenerateJWT(
-		u.ID,
-		u.Email,
-		string(u.SubscriptionTier),
-		h.jwtSecret,
-		h.jwtExp,
-	)
+	// Generate JWT, tracked as a session when a session store is set
+	ipAddress, userAgent := audit.GetRequestContext(c)
+	token, err := auth.IssueToken(ctx, h.sessions, u.ID, u.Email, string(u.SubscriptionTier), h.jwtSecret, h.jwtExp, userAgent, ipAddress)
 	if err != nil {
 		return h.redirectWithError(c, "token_generation_error")
 	}
 
 	// Audit log
 	if h.auditLogger != nil {
-		ipAddress, userAgent := audit.GetRequestContext(c)
 		go h.auditLogger.LogUserLogin(context.Background(), u.ID, ipAddress, userAgent)
 	}
 
@@ -1,63 +1,72 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 
 	"github.com/labstack/echo/v4"
 	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/pkg/leads"
+	"github.com/jordanlanch/industrydb/pkg/models"
 	"github.com/jordanlanch/industrydb/pkg/savedsearch"
 )
 
 // SavedSearchHandler handles saved search-related HTTP requests
 type SavedSearchHandler struct {
-	service *savedsearch.Service
+	service     *savedsearch.Service
+	leadService *leads.Service
 }
 
 // NewSavedSearchHandler creates a new saved search handler
-func NewSavedSearchHandler(service *savedsearch.Service) *SavedSearchHandler {
+func NewSavedSearchHandler(service *savedsearch.Service, leadService *leads.Service) *SavedSearchHandler {
 	return &SavedSearchHandler{
-		service: service,
+		service:     service,
+		leadService: leadService,
 	}
 }
 
 // CreateRequest represents a create saved search request
 type CreateSavedSearchRequest struct {
-	Name    string                 `json:"name" validate:"required,min=1,max=100"`
-	Filters map[string]interface{} `json:"filters" validate:"required"`
+	Name         string                 `json:"name" validate:"required,min=1,max=100"`
+	Filters      map[string]interface{} `json:"filters" validate:"required"`
+	TrackChanges bool                   `json:"track_changes"` // Report added and removed leads on each run
 }
 
 // UpdateRequest represents an update saved search request
 type UpdateSavedSearchRequest struct {
-	Name    *string                `json:"name,omitempty" validate:"omitempty,min=1,max=100"`
-	Filters map[string]interface{} `json:"filters,omitempty"`
+	Name         *string                `json:"name,omitempty" validate:"omitempty,min=1,max=100"`
+	Filters      map[string]interface{} `json:"filters,omitempty"`
+	TrackChanges *bool                  `json:"track_changes,omitempty"`
 }
 
 // SavedSearchResponse represents a saved search in API responses
 type SavedSearchResponse struct {
-	ID        int                    `json:"id"`
-	UserID    int                    `json:"user_id"`
-	Name      string                 `json:"name"`
-	Filters   map[string]interface{} `json:"filters"`
-	CreatedAt string                 `json:"created_at"`
-	UpdatedAt string                 `json:"updated_at"`
+	ID           int                    `json:"id"`
+	UserID       int                    `json:"user_id"`
+	Name         string                 `json:"name"`
+	Filters      map[string]interface{} `json:"filters"`
+	TrackChanges bool                   `json:"track_changes"`
+	CreatedAt    string                 `json:"created_at"`
+	UpdatedAt    string                 `json:"updated_at"`
 }
 
 // toResponse converts ent.SavedSearch to SavedSearchResponse
 func toSavedSearchResponse(s *ent.SavedSearch) SavedSearchResponse {
 	return SavedSearchResponse{
-		ID:        s.ID,
-		UserID:    s.UserID,
-		Name:      s.Name,
-		Filters:   s.Filters,
-		CreatedAt: s.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt: s.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		ID:           s.ID,
+		UserID:       s.UserID,
+		Name:         s.Name,
+		Filters:      s.Filters,
+		TrackChanges: s.TrackChanges,
+		CreatedAt:    s.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:    s.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 	}
 }
 
 // Create godoc
 // @Summary Create a saved search
-// @Description Save a search query with filters for quick access. Name must be unique per user.
+// @Description Save a search query with filters for quick access. Name must be unique per user. With track_changes, each run reports the leads added and removed since the previous run.
 // @Tags Saved Searches
 // @Accept json
 // @Produce json
@@ -101,6 +110,12 @@ func (h *SavedSearchHandler) Create(c echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create saved search")
 	}
+	if req.TrackChanges {
+		search, err = h.service.SetTrackChanges(c.Request().Context(), search.ID, user.ID, true)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create saved search")
+		}
+	}
 
 	return c.JSON(http.StatusCreated, toSavedSearchResponse(search))
 }
@@ -111,7 +126,9 @@ func (h *SavedSearchHandler) Create(c echo.Context) error {
 // @Tags Saved Searches
 // @Produce json
 // @Security BearerAuth
-// @Success 200 {object} map[string]interface{} "List of saved searches with count"
+// @Param page query int false "Page number (default 1)"
+// @Param limit query int false "Items per page (default all, max 100)"
+// @Success 200 {object} ListResponse{data=[]SavedSearchResponse} "List of saved searches, paginated"
 // @Failure 401 {object} map[string]string "Unauthorized"
 // @Failure 500 {object} map[string]string "Internal server error"
 // @Router /saved-searches [get]
@@ -122,8 +139,9 @@ func (h *SavedSearchHandler) List(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
 	}
 
-	// Get all saved searches for user
-	searches, err := h.service.List(c.Request().Context(), user.ID)
+	// Get the requested page of the user's saved searches
+	page := parseListPage(c)
+	searches, total, err := h.service.List(c.Request().Context(), user.ID, page.Limit, page.Offset())
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to fetch saved searches")
 	}
@@ -134,10 +152,7 @@ func (h *SavedSearchHandler) List(c echo.Context) error {
 		response[i] = toSavedSearchResponse(search)
 	}
 
-	return c.JSON(http.StatusOK, map[string]interface{}{
-		"searches": response,
-		"count":    len(response),
-	})
+	return respondList(c, page, response, total, "searches", "count")
 }
 
 // Get godoc
@@ -178,9 +193,151 @@ func (h *SavedSearchHandler) Get(c echo.Context) error {
 	return c.JSON(http.StatusOK, toSavedSearchResponse(search))
 }
 
+// SavedSearchRunResponse represents the results of running a saved search
+type SavedSearchRunResponse struct {
+	SavedSearchID int    `json:"saved_search_id"`
+	Name          string `json:"name"`
+	*models.LeadListResponse
+	Warnings []string `json:"warnings"`
+	// Leads added and removed since the previous run, when tracking changes
+	Changes *savedsearch.ResultDiff `json:"changes,omitempty"`
+}
+
+// Run godoc
+// @Summary Run saved search
+// @Description Execute a saved search and return matching leads. Counts against usage like a normal search (paging through the same results is free). Filters that no longer apply, such as removed industries, are ignored and reported in warnings; when none of the search's industries are left it is refused with 422, without charging. When the search tracks changes, changes lists the lead IDs added and removed since the previous run; paging through the same run repeats that diff.
+// @Tags Saved Searches
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Saved search ID"
+// @Param page query int false "Page number (default 1)"
+// @Param limit query int false "Results per page (default 50), clamped to the plan's maximum page size"
+// @Success 200 {object} SavedSearchRunResponse "Search results"
+// @Failure 400 {object} map[string]string "Invalid ID or pagination"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Usage limit exceeded"
+// @Failure 404 {object} map[string]string "Saved search not found"
+// @Failure 422 {object} map[string]string "None of the saved search's industries are available anymore"
+// @Failure 500 {object} map[string]string "Internal server error"
+// @Router /saved-searches/{id}/run [get]
+func (h *SavedSearchHandler) Run(c echo.Context) error {
+	// Get user from context
+	user, ok := c.Get("user").(*ent.User)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	// Parse ID
+	searchID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid search ID")
+	}
+
+	// Parse pagination
+	var page, limit int
+	if p := c.QueryParam("page"); p != "" {
+		page, err = strconv.Atoi(p)
+		if err != nil || page < 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, "Invalid page")
+		}
+	}
+	if l := c.QueryParam("limit"); l != "" {
+		limit, err = strconv.Atoi(l)
+		if err != nil || limit < 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, "Invalid limit")
+		}
+	}
+
+	// Get saved search
+	search, err := h.service.Get(c.Request().Context(), searchID, user.ID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return echo.NewHTTPError(http.StatusNotFound, "Saved search not found")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to fetch saved search")
+	}
+
+	// Refused before charging: without its industries the search would
+	// cover every industry
+	req, warnings, err := savedsearch.ToSearchRequest(search.Filters)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnprocessableEntity, "None of the saved search's industries are available anymore")
+	}
+	req.Page = page
+	req.Limit = limit
+
+	// Charge usage like a normal search; paging through the same results is free
+	sessionKey := strconv.Itoa(user.ID) + ":" + createFilterHash(req)
+	newRun := !isExistingSession(sessionKey)
+	if newRun {
+		var usageErr error
+		if orgID, hasOrgContext := c.Get("organization_id").(int); hasOrgContext {
+			usageErr = h.leadService.CheckAndIncrementOrganizationUsage(c.Request().Context(), orgID, 1)
+		} else {
+			usageErr = h.leadService.CheckAndIncrementUsage(c.Request().Context(), user.ID, 1)
+		}
+		if usageErr != nil {
+			if errors.Is(usageErr, leads.ErrUsageLimitExceeded) {
+				return echo.NewHTTPError(http.StatusForbidden, "usage_limit_exceeded")
+			}
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to run saved search")
+		}
+		createSession(sessionKey, user.ID)
+	}
+
+	req.ExcludeLeadIDs, err = h.leadService.SuppressedLeadIDs(c.Request().Context(), user.ID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to run saved search")
+	}
+
+	var organizationID *int
+	if orgID, hasOrgContext := c.Get("organization_id").(int); hasOrgContext {
+		organizationID = &orgID
+	}
+	tier, err := h.leadService.GetExportTier(c.Request().Context(), user.ID, organizationID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to run saved search")
+	}
+	req.MaxLimit = leads.GetMaxPageSizeForTier(tier)
+	leads.ApplyVerifiedDefault(&req, tier)
+	req.HiddenFields = leads.GetSearchHiddenFieldsForTier(tier)
+	req.OrgScope = organizationID
+
+	results, err := h.leadService.Search(c.Request().Context(), req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to run saved search")
+	}
+
+	var changes *savedsearch.ResultDiff
+	if search.TrackChanges {
+		if newRun {
+			ids, truncated, err := h.leadService.MatchingLeadIDs(c.Request().Context(), req, savedsearch.MaxSnapshotLeads)
+			if err == nil {
+				changes, err = h.service.RecordSnapshot(c.Request().Context(), search.ID, ids, truncated)
+			}
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, "Failed to run saved search")
+			}
+		} else {
+			changes, err = h.service.LatestDiff(c.Request().Context(), search.ID)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, "Failed to run saved search")
+			}
+		}
+	}
+
+	return c.JSON(http.StatusOK, SavedSearchRunResponse{
+		SavedSearchID:    search.ID,
+		Name:             search.Name,
+		LeadListResponse: results,
+		Warnings:         warnings,
+		Changes:          changes,
+	})
+}
+
 // Update godoc
 // @Summary Update saved search
-// @Description Update the name and/or filters of an existing saved search
+// @Description Update the name, filters and/or change tracking of an existing saved search. Changing the filters or turning track_changes off discards the stored result snapshots.
 // @Tags Saved Searches
 // @Accept json
 // @Produce json
@@ -237,6 +394,9 @@ func (h *SavedSearchHandler) Update(c echo.Context) error {
 
 	// Update saved search
 	search, err := h.service.Update(c.Request().Context(), searchID, user.ID, req.Name, req.Filters)
+	if err == nil && req.TrackChanges != nil {
+		search, err = h.service.SetTrackChanges(c.Request().Context(), searchID, user.ID, *req.TrackChanges)
+	}
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return echo.NewHTTPError(http.StatusNotFound, "Saved search not found")
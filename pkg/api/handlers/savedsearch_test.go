@@ -16,7 +16,9 @@ import (
 
 	"github.com/jordanlanch/industrydb/ent"
 	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/ent/lead"
 	"github.com/jordanlanch/industrydb/ent/user"
+	"github.com/jordanlanch/industrydb/pkg/leads"
 	"github.com/jordanlanch/industrydb/pkg/savedsearch"
 )
 
@@ -49,7 +51,7 @@ func TestSavedSearchHandler_Create(t *testing.T) {
 
 	user := createTestUserForHandlers(t, client, "test@example.com")
 	service := savedsearch.NewService(client)
-	handler := NewSavedSearchHandler(service)
+	handler := NewSavedSearchHandler(service, nil)
 
 	e := echo.New()
 	reqBody := `{"name":"NYC Restaurants","filters":{"industry":"restaurant","country":"US","city":"New York"}}`
@@ -76,7 +78,7 @@ func TestSavedSearchHandler_Create_Unauthorized(t *testing.T) {
 	defer client.Close()
 
 	service := savedsearch.NewService(client)
-	handler := NewSavedSearchHandler(service)
+	handler := NewSavedSearchHandler(service, nil)
 
 	e := echo.New()
 	reqBody := `{"name":"Test","filters":{"industry":"restaurant"}}`
@@ -99,7 +101,7 @@ func TestSavedSearchHandler_Create_InvalidFilters(t *testing.T) {
 
 	user := createTestUserForHandlers(t, client, "test@example.com")
 	service := savedsearch.NewService(client)
-	handler := NewSavedSearchHandler(service)
+	handler := NewSavedSearchHandler(service, nil)
 
 	e := echo.New()
 	reqBody := `{"name":"Test","filters":{"invalid_key":"value"}}`
@@ -122,7 +124,7 @@ func TestSavedSearchHandler_Create_DuplicateName(t *testing.T) {
 
 	user := createTestUserForHandlers(t, client, "test@example.com")
 	service := savedsearch.NewService(client)
-	handler := NewSavedSearchHandler(service)
+	handler := NewSavedSearchHandler(service, nil)
 
 	// Create first search
 	ctx := context.Background()
@@ -152,7 +154,7 @@ func TestSavedSearchHandler_List(t *testing.T) {
 
 	user := createTestUserForHandlers(t, client, "test@example.com")
 	service := savedsearch.NewService(client)
-	handler := NewSavedSearchHandler(service)
+	handler := NewSavedSearchHandler(service, nil)
 
 	// Create some searches
 	ctx := context.Background()
@@ -190,7 +192,7 @@ func TestSavedSearchHandler_Get(t *testing.T) {
 
 	user := createTestUserForHandlers(t, client, "test@example.com")
 	service := savedsearch.NewService(client)
-	handler := NewSavedSearchHandler(service)
+	handler := NewSavedSearchHandler(service, nil)
 
 	// Create search
 	ctx := context.Background()
@@ -225,7 +227,7 @@ func TestSavedSearchHandler_Get_NotFound(t *testing.T) {
 
 	user := createTestUserForHandlers(t, client, "test@example.com")
 	service := savedsearch.NewService(client)
-	handler := NewSavedSearchHandler(service)
+	handler := NewSavedSearchHandler(service, nil)
 
 	e := echo.New()
 	req := httptest.NewRequest(http.MethodGet, "/api/v1/saved-searches/999", nil)
@@ -250,7 +252,7 @@ func TestSavedSearchHandler_Get_WrongUser(t *testing.T) {
 	user1 := createTestUserForHandlers(t, client, "test1@example.com")
 	user2 := createTestUserForHandlers(t, client, "test2@example.com")
 	service := savedsearch.NewService(client)
-	handler := NewSavedSearchHandler(service)
+	handler := NewSavedSearchHandler(service, nil)
 
 	// Create search for user1
 	ctx := context.Background()
@@ -281,7 +283,7 @@ func TestSavedSearchHandler_Update(t *testing.T) {
 
 	user := createTestUserForHandlers(t, client, "test@example.com")
 	service := savedsearch.NewService(client)
-	handler := NewSavedSearchHandler(service)
+	handler := NewSavedSearchHandler(service, nil)
 
 	// Create search
 	ctx := context.Background()
@@ -318,7 +320,7 @@ func TestSavedSearchHandler_Update_NameOnly(t *testing.T) {
 
 	user := createTestUserForHandlers(t, client, "test@example.com")
 	service := savedsearch.NewService(client)
-	handler := NewSavedSearchHandler(service)
+	handler := NewSavedSearchHandler(service, nil)
 
 	// Create search
 	ctx := context.Background()
@@ -355,7 +357,7 @@ func TestSavedSearchHandler_Delete(t *testing.T) {
 
 	user := createTestUserForHandlers(t, client, "test@example.com")
 	service := savedsearch.NewService(client)
-	handler := NewSavedSearchHandler(service)
+	handler := NewSavedSearchHandler(service, nil)
 
 	// Create search
 	ctx := context.Background()
@@ -388,7 +390,7 @@ func TestSavedSearchHandler_Delete_NotFound(t *testing.T) {
 
 	user := createTestUserForHandlers(t, client, "test@example.com")
 	service := savedsearch.NewService(client)
-	handler := NewSavedSearchHandler(service)
+	handler := NewSavedSearchHandler(service, nil)
 
 	e := echo.New()
 	req := httptest.NewRequest(http.MethodDelete, "/api/v1/saved-searches/999", nil)
@@ -413,7 +415,7 @@ func TestSavedSearchHandler_Delete_WrongUser(t *testing.T) {
 	user1 := createTestUserForHandlers(t, client, "test1@example.com")
 	user2 := createTestUserForHandlers(t, client, "test2@example.com")
 	service := savedsearch.NewService(client)
-	handler := NewSavedSearchHandler(service)
+	handler := NewSavedSearchHandler(service, nil)
 
 	// Create search for user1
 	ctx := context.Background()
@@ -442,3 +444,173 @@ func TestSavedSearchHandler_Delete_WrongUser(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotNil(t, search)
 }
+
+func TestSavedSearchHandler_Run(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:savedsearch_run?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	u := createTestUserForHandlers(t, client, "run@example.com")
+	// Free searches only show verified leads by default
+	for _, name := range []string{"Ink One", "Ink Two", "Ink Three"} {
+		_, err := client.Lead.Create().
+			SetName(name).
+			SetIndustry(lead.IndustryTattoo).
+			SetCountry("US").
+			SetCity("Austin").
+			SetVerified(true).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+	_, err := client.Lead.Create().
+		SetName("Bean There").
+		SetIndustry(lead.IndustryCafe).
+		SetCountry("US").
+		SetCity("Austin").
+		Save(ctx)
+	require.NoError(t, err)
+
+	service := savedsearch.NewService(client)
+	handler := NewSavedSearchHandler(service, leads.NewService(client, nil))
+	search, err := service.Create(ctx, u.ID, "Austin Tattoo", map[string]interface{}{
+		"industries": []interface{}{"tattoo", "blacksmith"},
+		"city":       "Austin",
+	})
+	require.NoError(t, err)
+
+	run := func(query string) (*httptest.ResponseRecorder, error) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/saved-searches/"+strconv.Itoa(search.ID)+"/run"+query, nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user", u)
+		c.SetParamNames("id")
+		c.SetParamValues(strconv.Itoa(search.ID))
+		return rec, handler.Run(c)
+	}
+
+	rec, err := run("?page=1&limit=2")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response SavedSearchRunResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, search.ID, response.SavedSearchID)
+	assert.Equal(t, "Austin Tattoo", response.Name)
+	assert.Len(t, response.Data, 2)
+	assert.Equal(t, 3, response.Pagination.Total)
+	assert.Equal(t, []string{"industry 'blacksmith' is no longer available and was ignored"}, response.Warnings)
+
+	// Paging through the same results does not charge again
+	_, err = run("?page=2&limit=2")
+	require.NoError(t, err)
+	refreshed, err := client.User.Get(ctx, u.ID)
+	require.NoError(t, err)
+	assert.Equal(t, u.UsageCount+1, refreshed.UsageCount)
+
+	// Oversized pages are clamped to the free tier's maximum
+	rec, err = run("?limit=500")
+	require.NoError(t, err)
+	response = SavedSearchRunResponse{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, leads.GetMaxPageSizeForTier("free"), response.Pagination.Limit)
+	assert.True(t, response.Pagination.LimitClamped)
+	assert.Equal(t, 500, response.Pagination.RequestedLimit)
+
+	// Invalid pagination is rejected
+	_, err = run("?limit=0")
+	he, ok := err.(*echo.HTTPError)
+	require.True(t, ok)
+	assert.Equal(t, http.StatusBadRequest, he.Code)
+
+	// Without any of its industries left the run is refused, not widened
+	before, err := client.User.Get(ctx, u.ID)
+	require.NoError(t, err)
+	_, err = client.SavedSearch.UpdateOneID(search.ID).
+		SetFilters(map[string]interface{}{"industries": []interface{}{"blacksmith"}, "city": "Austin"}).
+		Save(ctx)
+	require.NoError(t, err)
+	_, err = run("")
+	he, ok = err.(*echo.HTTPError)
+	require.True(t, ok)
+	assert.Equal(t, http.StatusUnprocessableEntity, he.Code)
+	refreshed, err = client.User.Get(ctx, u.ID)
+	require.NoError(t, err)
+	assert.Equal(t, before.UsageCount, refreshed.UsageCount)
+}
+
+func TestSavedSearchHandler_RunTracksChanges(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:savedsearch_run_changes?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	u := createTestUserForHandlers(t, client, "changes@example.com")
+	u = client.User.UpdateOne(u).SetUsageLimit(100).SaveX(ctx)
+	createLead := func(name string) int {
+		return client.Lead.Create().
+			SetName(name).
+			SetIndustry(lead.IndustryTattoo).
+			SetCountry("US").
+			SetCity("Austin").
+			SetVerified(true).
+			SaveX(ctx).ID
+	}
+	first := createLead("Ink One")
+	closing := createLead("Ink Two")
+
+	service := savedsearch.NewService(client)
+	handler := NewSavedSearchHandler(service, leads.NewService(client, nil))
+	search, err := service.Create(ctx, u.ID, "Austin Tattoo", map[string]interface{}{"city": "Austin"})
+	require.NoError(t, err)
+	_, err = service.SetTrackChanges(ctx, search.ID, u.ID, true)
+	require.NoError(t, err)
+
+	run := func(query string) SavedSearchRunResponse {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/saved-searches/"+strconv.Itoa(search.ID)+"/run"+query, nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user", u)
+		c.SetParamNames("id")
+		c.SetParamValues(strconv.Itoa(search.ID))
+		require.NoError(t, handler.Run(c))
+
+		var response SavedSearchRunResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		return response
+	}
+	// Expire the user's search session so the next run is a new one
+	newRun := func() {
+		searchSessionsMutex.Lock()
+		for key, session := range searchSessions {
+			if session.UserID == u.ID {
+				delete(searchSessions, key)
+			}
+		}
+		searchSessionsMutex.Unlock()
+	}
+
+	response := run("?limit=1")
+	require.NotNil(t, response.Changes)
+	assert.Nil(t, response.Changes.PreviousRunAt)
+	assert.Empty(t, response.Changes.Added)
+
+	// Results change between runs
+	_, err = client.Lead.Delete().Where(lead.ID(closing)).Exec(ctx)
+	require.NoError(t, err)
+	opened := createLead("Ink Three")
+
+	newRun()
+	response = run("?limit=1")
+	require.NotNil(t, response.Changes)
+	assert.NotNil(t, response.Changes.PreviousRunAt)
+	assert.Equal(t, []int{opened}, response.Changes.Added)
+	assert.Equal(t, []int{closing}, response.Changes.Removed)
+
+	// Paging through the run repeats its diff without a new snapshot
+	response = run("?page=2&limit=1")
+	require.NotNil(t, response.Changes)
+	assert.Equal(t, []int{opened}, response.Changes.Added)
+	assert.Equal(t, 2, client.SavedSearchSnapshot.Query().CountX(ctx))
+	assert.NotContains(t, response.Changes.Removed, first)
+}
@@ -0,0 +1,302 @@
+package handlers
+
+import (
+	stderrors "errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jordanlanch/industrydb/pkg/api/errors"
+	"github.com/jordanlanch/industrydb/pkg/api/validation"
+	"github.com/jordanlanch/industrydb/pkg/export"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/jordanlanch/industrydb/pkg/scheduledexport"
+	"github.com/labstack/echo/v4"
+)
+
+// ScheduledExportHandler handles scheduled export endpoints
+type ScheduledExportHandler struct {
+	scheduleService *scheduledexport.Service
+	validator       *validator.Validate
+}
+
+// NewScheduledExportHandler creates a new scheduled export handler
+func NewScheduledExportHandler(scheduleService *scheduledexport.Service) *ScheduledExportHandler {
+	return &ScheduledExportHandler{
+		scheduleService: scheduleService,
+		validator:       validation.Validator(),
+	}
+}
+
+// Create godoc
+// @Summary Create scheduled export
+// @Description Schedule a recurring export. Leads are selected by either saved_search_id (one of the user's saved searches, whose current filters are used on each run) or inline filters in saved search format. The cadence is a cron expression such as "0 9 * * 1" (Mondays 9:00 UTC) or @daily/@weekly/@monthly, optionally prefixed with CRON_TZ=<zone>. Each run creates an export through the export queue; with since_last_export only new or updated leads are exported, and with delivery_method url each file is POSTed to delivery_url signed with the delivery_secret returned here only. The tier limits how often schedules run (starter weekly, pro daily, business hourly) and how many are active; free has none.
+// @Tags Scheduled Exports
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body scheduledexport.CreateRequest true "Schedule configuration"
+// @Success 201 {object} scheduledexport.ScheduleResponse "Schedule created"
+// @Failure 400 {object} models.ErrorResponse "Invalid request, cadence, column, filters, delivery URL, or saved search"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Cadence or schedule count exceeds the plan (upgrade_required), or not a member of the organization"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /scheduled-exports [post]
+func (h *ScheduledExportHandler) Create(c echo.Context) error {
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	var req scheduledexport.CreateRequest
+	if err := c.Bind(&req); err != nil {
+		return errors.ValidationError(c, err)
+	}
+	if err := h.validator.Struct(req); err != nil {
+		return errors.ValidationError(c, err)
+	}
+
+	schedule, err := h.scheduleService.Create(c.Request().Context(), userID, req)
+	if err != nil {
+		switch {
+		case stderrors.Is(err, scheduledexport.ErrAmbiguousSource):
+			return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "invalid_source",
+				Message: err.Error(),
+			})
+		case stderrors.Is(err, scheduledexport.ErrInvalidFilters):
+			return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "invalid_filters",
+				Message: err.Error(),
+			})
+		case stderrors.Is(err, export.ErrInvalidColumn):
+			return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "invalid_column",
+				Message: err.Error(),
+			})
+		case stderrors.Is(err, scheduledexport.ErrSavedSearchNotFound):
+			return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "invalid_saved_search",
+				Message: err.Error(),
+			})
+		case stderrors.Is(err, scheduledexport.ErrDeliveryURLRequired), stderrors.Is(err, export.ErrInvalidDeliveryURL):
+			return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "invalid_delivery_url",
+				Message: err.Error(),
+			})
+		case stderrors.Is(err, scheduledexport.ErrNotOrganizationMember):
+			return c.JSON(http.StatusForbidden, models.ErrorResponse{
+				Error:   "not_organization_member",
+				Message: "You are not a member of this organization",
+			})
+		}
+		return scheduleTierError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, schedule)
+}
+
+// List godoc
+// @Summary List scheduled exports
+// @Description List the user's scheduled exports, newest first, with their last run and next run
+// @Tags Scheduled Exports
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} map[string]interface{} "Schedules and total"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /scheduled-exports [get]
+func (h *ScheduledExportHandler) List(c echo.Context) error {
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	schedules, err := h.scheduleService.List(c.Request().Context(), userID)
+	if err != nil {
+		return errors.InternalError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"schedules": schedules,
+		"total":     len(schedules),
+	})
+}
+
+// Get godoc
+// @Summary Get scheduled export
+// @Description Get a scheduled export of the user
+// @Tags Scheduled Exports
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Schedule ID"
+// @Success 200 {object} scheduledexport.ScheduleResponse "Schedule"
+// @Failure 400 {object} models.ErrorResponse "Invalid schedule ID"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 404 {object} models.ErrorResponse "Schedule not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /scheduled-exports/{id} [get]
+func (h *ScheduledExportHandler) Get(c echo.Context) error {
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	scheduleID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return invalidScheduleIDError(c)
+	}
+
+	schedule, err := h.scheduleService.Get(c.Request().Context(), scheduleID, userID)
+	if err != nil {
+		if stderrors.Is(err, scheduledexport.ErrScheduleNotFound) {
+			return errors.NotFoundError(c, "scheduled export")
+		}
+		return errors.InternalError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, schedule)
+}
+
+// Pause godoc
+// @Summary Pause scheduled export
+// @Description Stop a scheduled export from running until it is resumed
+// @Tags Scheduled Exports
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Schedule ID"
+// @Success 200 {object} scheduledexport.ScheduleResponse "Schedule paused"
+// @Failure 400 {object} models.ErrorResponse "Invalid schedule ID"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 404 {object} models.ErrorResponse "Schedule not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /scheduled-exports/{id}/pause [post]
+func (h *ScheduledExportHandler) Pause(c echo.Context) error {
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	scheduleID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return invalidScheduleIDError(c)
+	}
+
+	schedule, err := h.scheduleService.Pause(c.Request().Context(), scheduleID, userID)
+	if err != nil {
+		if stderrors.Is(err, scheduledexport.ErrScheduleNotFound) {
+			return errors.NotFoundError(c, "scheduled export")
+		}
+		return errors.InternalError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, schedule)
+}
+
+// Resume godoc
+// @Summary Resume scheduled export
+// @Description Reactivate a paused scheduled export from its next run; missed runs are skipped. The cadence and schedule count are checked against the current plan again, so schedules paused after a downgrade need a plan that allows them.
+// @Tags Scheduled Exports
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Schedule ID"
+// @Success 200 {object} scheduledexport.ScheduleResponse "Schedule resumed"
+// @Failure 400 {object} models.ErrorResponse "Invalid schedule ID"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Cadence or schedule count exceeds the plan (upgrade_required)"
+// @Failure 404 {object} models.ErrorResponse "Schedule not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /scheduled-exports/{id}/resume [post]
+func (h *ScheduledExportHandler) Resume(c echo.Context) error {
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	scheduleID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return invalidScheduleIDError(c)
+	}
+
+	schedule, err := h.scheduleService.Resume(c.Request().Context(), scheduleID, userID)
+	if err != nil {
+		if stderrors.Is(err, scheduledexport.ErrScheduleNotFound) {
+			return errors.NotFoundError(c, "scheduled export")
+		}
+		return scheduleTierError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, schedule)
+}
+
+// Delete godoc
+// @Summary Delete scheduled export
+// @Description Delete a scheduled export of the user. Exports it created are kept.
+// @Tags Scheduled Exports
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Schedule ID"
+// @Success 204 "Schedule deleted"
+// @Failure 400 {object} models.ErrorResponse "Invalid schedule ID"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 404 {object} models.ErrorResponse "Schedule not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /scheduled-exports/{id} [delete]
+func (h *ScheduledExportHandler) Delete(c echo.Context) error {
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	scheduleID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return invalidScheduleIDError(c)
+	}
+
+	if err := h.scheduleService.Delete(c.Request().Context(), scheduleID, userID); err != nil {
+		if stderrors.Is(err, scheduledexport.ErrScheduleNotFound) {
+			return errors.NotFoundError(c, "scheduled export")
+		}
+		return errors.InternalError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// scheduleTierError maps cadence and tier limit errors, shared by Create and Resume
+func scheduleTierError(c echo.Context, err error) error {
+	switch {
+	case stderrors.Is(err, scheduledexport.ErrInvalidCadence):
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_cadence",
+			Message: err.Error(),
+		})
+	case stderrors.Is(err, scheduledexport.ErrCadenceTooFrequent),
+		stderrors.Is(err, scheduledexport.ErrSchedulesNotInPlan),
+		stderrors.Is(err, scheduledexport.ErrScheduleLimit):
+		return c.JSON(http.StatusForbidden, models.ErrorResponse{
+			Error:   "upgrade_required",
+			Message: err.Error(),
+		})
+	}
+	return errors.InternalError(c, err)
+}
+
+func invalidScheduleIDError(c echo.Context) error {
+	return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+		Error:   "invalid_id",
+		Message: "Schedule ID must be a number",
+	})
+}
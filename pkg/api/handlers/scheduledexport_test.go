@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/pkg/analytics"
+	exportpkg "github.com/jordanlanch/industrydb/pkg/export"
+	"github.com/jordanlanch/industrydb/pkg/leads"
+	"github.com/jordanlanch/industrydb/pkg/scheduledexport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func setupScheduledExportTest(t *testing.T) (*ent.Client, *ScheduledExportHandler) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	analyticsService := analytics.NewService(client)
+	leadService := leads.NewService(client, nil)
+	exportService := exportpkg.NewService(client, leadService, analyticsService, t.TempDir())
+	return client, NewScheduledExportHandler(scheduledexport.NewService(client, exportService, leadService))
+}
+
+func TestScheduledExportHandler_Create(t *testing.T) {
+	client, handler := setupScheduledExportTest(t)
+	pro := createExportTestUser(t, client, "schedules@example.com", "pro")
+	free := createExportTestUser(t, client, "free-schedules@example.com", "free")
+
+	tests := []struct {
+		name          string
+		userID        int
+		body          string
+		expectedCode  int
+		expectedError string
+	}{
+		{"Daily download", pro.ID, `{"name":"Daily US","format":"csv","filters":{"country":"US"},"cadence":"@daily"}`, http.StatusCreated, ""},
+		{"Weekly URL delivery", pro.ID, `{"name":"Weekly","format":"csv","filters":{},"cadence":"0 9 * * 1","since_last_export":true,"delivery_method":"url","delivery_url":"https://example.com/hook"}`, http.StatusCreated, "delivery_secret"},
+		{"Missing cadence", pro.ID, `{"name":"X","format":"csv","filters":{}}`, http.StatusBadRequest, "validation_error"},
+		{"Invalid cadence", pro.ID, `{"name":"X","format":"csv","filters":{},"cadence":"sometimes"}`, http.StatusBadRequest, "invalid_cadence"},
+		{"Too frequent for the plan", pro.ID, `{"name":"X","format":"csv","filters":{},"cadence":"@hourly"}`, http.StatusForbidden, "upgrade_required"},
+		{"Free plan", free.ID, `{"name":"X","format":"csv","filters":{},"cadence":"@weekly"}`, http.StatusForbidden, "upgrade_required"},
+		{"URL delivery without URL", pro.ID, `{"name":"X","format":"csv","filters":{},"cadence":"@daily","delivery_method":"url"}`, http.StatusBadRequest, "invalid_delivery_url"},
+		{"No filters or saved search", pro.ID, `{"name":"X","format":"csv","cadence":"@daily"}`, http.StatusBadRequest, "invalid_source"},
+		{"Another user's saved search", pro.ID, `{"name":"X","format":"csv","saved_search_id":999,"cadence":"@daily"}`, http.StatusBadRequest, "invalid_saved_search"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, rec := exportTemplateRequest(http.MethodPost, tt.body, tt.userID, "")
+			require.NoError(t, handler.Create(c))
+			assert.Equal(t, tt.expectedCode, rec.Code, rec.Body.String())
+			if tt.expectedError != "" {
+				assert.Contains(t, rec.Body.String(), tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestScheduledExportHandler_Lifecycle(t *testing.T) {
+	client, handler := setupScheduledExportTest(t)
+	owner := createExportTestUser(t, client, "owner-schedules@example.com", "pro")
+	other := createExportTestUser(t, client, "other-schedules@example.com", "pro")
+
+	c, rec := exportTemplateRequest(http.MethodPost, `{"name":"Daily","format":"csv","filters":{"country":"US"},"cadence":"@daily"}`, owner.ID, "")
+	require.NoError(t, handler.Create(c))
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var created scheduledexport.ScheduleResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+	id := fmt.Sprint(created.ID)
+
+	c, rec = exportTemplateRequest(http.MethodGet, "", owner.ID, "")
+	require.NoError(t, handler.List(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"total":1`)
+
+	c, rec = exportTemplateRequest(http.MethodGet, "", other.ID, id)
+	require.NoError(t, handler.Get(c))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	c, rec = exportTemplateRequest(http.MethodPost, "", owner.ID, id)
+	require.NoError(t, handler.Pause(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"status":"paused"`)
+
+	c, rec = exportTemplateRequest(http.MethodPost, "", owner.ID, id)
+	require.NoError(t, handler.Resume(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"status":"active"`)
+
+	c, rec = exportTemplateRequest(http.MethodPost, "", owner.ID, "abc")
+	require.NoError(t, handler.Pause(c))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	c, rec = exportTemplateRequest(http.MethodDelete, "", other.ID, id)
+	require.NoError(t, handler.Delete(c))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	c, rec = exportTemplateRequest(http.MethodDelete, "", owner.ID, id)
+	require.NoError(t, handler.Delete(c))
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
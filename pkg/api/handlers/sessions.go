@@ -0,0 +1,181 @@
+package handlers
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/jordanlanch/industrydb/pkg/api/errors"
+	"github.com/jordanlanch/industrydb/pkg/audit"
+	"github.com/jordanlanch/industrydb/pkg/auth"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/labstack/echo/v4"
+)
+
+// SessionHandler handles listing and revoking a user's sessions.
+type SessionHandler struct {
+	sessions    *auth.SessionStore
+	auditLogger *audit.Service
+}
+
+// NewSessionHandler creates a new session handler.
+func NewSessionHandler(sessions *auth.SessionStore, auditLogger *audit.Service) *SessionHandler {
+	return &SessionHandler{
+		sessions:    sessions,
+		auditLogger: auditLogger,
+	}
+}
+
+// RevokeAllSessionsRequest is the body of a revoke-all request.
+type RevokeAllSessionsRequest struct {
+	// Keep the session making the request signed in
+	KeepCurrent bool `json:"keep_current"`
+}
+
+// ListSessions godoc
+// @Summary List active sessions
+// @Description List the current user's signed-in sessions, newest first. The session making the request is marked current.
+// @Tags User
+// @Produce json
+// @Success 200 {object} map[string]interface{}
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/user/sessions [get]
+func (h *SessionHandler) ListSessions(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "User not authenticated",
+		})
+	}
+	currentID, _ := c.Get("session_id").(string)
+
+	sessions, err := h.sessions.List(ctx, userID, currentID)
+	if err != nil {
+		return errors.InternalError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"sessions": sessions,
+		"total":    len(sessions),
+	})
+}
+
+// RevokeSession godoc
+// @Summary Revoke a session
+// @Description Sign out one of the current user's sessions. Its token is rejected from then on.
+// @Tags User
+// @Produce json
+// @Param id path string true "Session ID"
+// @Success 200 {object} models.SuccessResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/user/sessions/{id}/revoke [post]
+func (h *SessionHandler) RevokeSession(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "User not authenticated",
+		})
+	}
+	sessionID := c.Param("id")
+
+	if err := h.sessions.Revoke(ctx, userID, sessionID); err != nil {
+		if stderrors.Is(err, auth.ErrSessionNotFound) {
+			return errors.NotFoundError(c, "session")
+		}
+		return errors.InternalError(c, err)
+	}
+
+	h.logRevoke(c, userID, sessionID, fmt.Sprintf("Revoked session %s", sessionID), nil)
+
+	return c.JSON(http.StatusOK, models.SuccessResponse{
+		Success: true,
+		Message: "Session revoked",
+	})
+}
+
+// RevokeAllSessions godoc
+// @Summary Revoke all sessions
+// @Description Sign out all of the current user's sessions, e.g. after a suspected compromise. With keep_current the session making the request stays signed in.
+// @Tags User
+// @Accept json
+// @Produce json
+// @Param request body RevokeAllSessionsRequest false "Options"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/user/sessions/revoke-all [post]
+func (h *SessionHandler) RevokeAllSessions(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 10*time.Second)
+	defer cancel()
+
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "User not authenticated",
+		})
+	}
+
+	var req RevokeAllSessionsRequest
+	if err := c.Bind(&req); err != nil {
+		return errors.ValidationError(c, err)
+	}
+
+	keepID := ""
+	if req.KeepCurrent {
+		keepID, _ = c.Get("session_id").(string)
+	}
+
+	revoked, err := h.sessions.RevokeAll(ctx, userID, keepID)
+	if err != nil {
+		return errors.InternalError(c, err)
+	}
+
+	h.logRevoke(c, userID, "all", fmt.Sprintf("Revoked %d sessions", revoked), map[string]interface{}{
+		"revoked":      revoked,
+		"keep_current": keepID != "",
+	})
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"revoked":      revoked,
+		"kept_current": keepID != "",
+	})
+}
+
+// logRevoke records revoked sessions in the audit log.
+func (h *SessionHandler) logRevoke(c echo.Context, userID int, resourceID, description string, metadata map[string]interface{}) {
+	if h.auditLogger == nil {
+		return
+	}
+
+	resourceType := "session"
+	ipAddress, userAgent := audit.GetRequestContext(c)
+	go h.auditLogger.Log(context.Background(), audit.LogEntry{
+		UserID:       &userID,
+		Action:       "session_revoke",
+		ResourceType: &resourceType,
+		ResourceID:   &resourceID,
+		IPAddress:    &ipAddress,
+		UserAgent:    &userAgent,
+		Metadata:     metadata,
+		Severity:     "warning",
+		Description:  &description,
+	})
+}
@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/jordanlanch/industrydb/pkg/auth"
+	"github.com/jordanlanch/industrydb/pkg/cache"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sessionTestSecret = "session-test-secret-minimum-32-chars"
+
+func setupSessionHandler(t *testing.T) (*SessionHandler, *auth.SessionStore, *auth.TokenBlacklist) {
+	t.Helper()
+
+	mr := miniredis.RunT(t)
+	client, err := cache.NewClient("redis://" + mr.Addr())
+	require.NoError(t, err)
+	t.Cleanup(func() { client.Close() })
+
+	blacklist := auth.NewTokenBlacklist(client)
+	store := auth.NewSessionStore(client, blacklist)
+	return NewSessionHandler(store, nil), store, blacklist
+}
+
+// signIn issues a tracked token for the user and returns it with its session ID
+func signIn(t *testing.T, store *auth.SessionStore, userID int, userAgent string) (string, string) {
+	t.Helper()
+
+	token, err := auth.GenerateJWT(userID, "sessions@test.com", "free", sessionTestSecret, 24)
+	require.NoError(t, err)
+	require.NoError(t, store.Track(context.Background(), token, userAgent, "10.0.0.1"))
+	claims, err := auth.ValidateJWT(token, sessionTestSecret)
+	require.NoError(t, err)
+	return token, claims.ID
+}
+
+func sessionRequest(method, body string, userID int, sessionID string) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(method, "/", strings.NewReader(body))
+	if body != "" {
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	}
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", userID)
+	c.Set("session_id", sessionID)
+	return c, rec
+}
+
+func TestSessionHandler_ListSessions(t *testing.T) {
+	handler, store, _ := setupSessionHandler(t)
+	_, laptop := signIn(t, store, 1, "Laptop")
+	signIn(t, store, 1, "Phone")
+
+	c, rec := sessionRequest(http.MethodGet, "", 1, laptop)
+	require.NoError(t, handler.ListSessions(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var resp struct {
+		Sessions []auth.Session `json:"sessions"`
+		Total    int            `json:"total"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, 2, resp.Total)
+	for _, s := range resp.Sessions {
+		assert.Equal(t, s.ID == laptop, s.Current)
+	}
+}
+
+func TestSessionHandler_RevokeAllSessions(t *testing.T) {
+	handler, store, blacklist := setupSessionHandler(t)
+	ctx := context.Background()
+	laptopToken, laptop := signIn(t, store, 1, "Laptop")
+	phoneToken, _ := signIn(t, store, 1, "Phone")
+
+	t.Run("Keeping the current session", func(t *testing.T) {
+		c, rec := sessionRequest(http.MethodPost, `{"keep_current": true}`, 1, laptop)
+		require.NoError(t, handler.RevokeAllSessions(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"revoked": 1, "kept_current": true}`, rec.Body.String())
+
+		_, err := auth.ValidateJWTWithBlacklist(ctx, phoneToken, sessionTestSecret, blacklist)
+		assert.Error(t, err)
+		_, err = auth.ValidateJWTWithBlacklist(ctx, laptopToken, sessionTestSecret, blacklist)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Everywhere by default", func(t *testing.T) {
+		c, rec := sessionRequest(http.MethodPost, "", 1, laptop)
+		require.NoError(t, handler.RevokeAllSessions(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"revoked": 1, "kept_current": false}`, rec.Body.String())
+
+		_, err := auth.ValidateJWTWithBlacklist(ctx, laptopToken, sessionTestSecret, blacklist)
+		assert.Error(t, err)
+	})
+}
+
+func TestSessionHandler_RevokeSession(t *testing.T) {
+	handler, store, blacklist := setupSessionHandler(t)
+	phoneToken, phone := signIn(t, store, 1, "Phone")
+
+	t.Run("Another user's session", func(t *testing.T) {
+		c, rec := sessionRequest(http.MethodPost, "", 2, "")
+		c.SetParamNames("id")
+		c.SetParamValues(phone)
+		require.NoError(t, handler.RevokeSession(c))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("Own session", func(t *testing.T) {
+		c, rec := sessionRequest(http.MethodPost, "", 1, "")
+		c.SetParamNames("id")
+		c.SetParamValues(phone)
+		require.NoError(t, handler.RevokeSession(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		_, err := auth.ValidateJWTWithBlacklist(context.Background(), phoneToken, sessionTestSecret, blacklist)
+		assert.Error(t, err)
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/pkg/cache"
 	"github.com/jordanlanch/industrydb/pkg/models"
 	"github.com/jordanlanch/industrydb/pkg/territory"
 	"github.com/labstack/echo/v4"
@@ -24,6 +25,11 @@ func NewTerritoryHandler(db *ent.Client) *TerritoryHandler {
 	}
 }
 
+// SetCache enables caching of territory performance aggregates
+func (h *TerritoryHandler) SetCache(client *cache.Client) {
+	h.service.SetCache(client)
+}
+
 // CreateTerritory godoc
 // @Summary Create new territory
 // @Description Create a new sales territory with geographic and industry filters
@@ -377,3 +383,74 @@ func (h *TerritoryHandler) GetUserTerritories(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, territories)
 }
+
+// GetTerritoryPerformance godoc
+// @Summary Get territory performance
+// @Description Get lead counts, conversion rates and average time-to-conversion of a territory. Only its creator and members can view it. Aggregates are cached for 10 minutes.
+// @Tags Territories
+// @Produce json
+// @Param id path int true "Territory ID"
+// @Success 200 {object} territory.TerritoryPerformance
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/territories/{id}/performance [get]
+func (h *TerritoryHandler) GetTerritoryPerformance(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 10*time.Second)
+	defer cancel()
+
+	userID := c.Get("user_id").(int)
+
+	territoryID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid_territory_id",
+			Message: "Territory ID must be a valid number",
+		})
+	}
+
+	result, err := h.service.GetTerritoryPerformance(ctx, territoryID, userID)
+	if err != nil {
+		if err.Error() == "territory not found" {
+			return c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_found",
+				Message: err.Error(),
+			})
+		}
+		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "server_error",
+			Message: err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// ListTerritoryPerformance godoc
+// @Summary Get performance of all territories
+// @Description Get the performance of every territory the user created or is a member of, with combined totals. Aggregates are cached for 10 minutes.
+// @Tags Territories
+// @Produce json
+// @Param active query boolean false "Only active territories"
+// @Success 200 {object} territory.PerformanceOverview
+// @Failure 500 {object} models.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/territories/performance [get]
+func (h *TerritoryHandler) ListTerritoryPerformance(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 10*time.Second)
+	defer cancel()
+
+	userID := c.Get("user_id").(int)
+	activeOnly := c.QueryParam("active") == "true"
+
+	result, err := h.service.ListTerritoryPerformance(ctx, userID, activeOnly)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "server_error",
+			Message: err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
@@ -671,3 +671,83 @@ func TestTerritoryHandler_GetUserTerritories(t *testing.T) {
 		assert.Equal(t, 0, len(resp))
 	})
 }
+
+func TestTerritoryHandler_GetTerritoryPerformance(t *testing.T) {
+	client, handler, creator, otherUser := setupTerritoryTest(t)
+	ctx := context.Background()
+
+	terr := client.Territory.Create().
+		SetName("West").
+		SetCreatedByUserID(creator.ID).
+		SaveX(ctx)
+	client.Lead.Create().
+		SetName("Won Studio").
+		SetIndustry("tattoo").
+		SetCountry("US").
+		SetCity("Seattle").
+		SetStatus("won").
+		SetTerritoryID(terr.ID).
+		SaveX(ctx)
+
+	performanceRequest := func(userID int, id string) (*httptest.ResponseRecorder, error) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/territories/"+id+"/performance", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", userID)
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+		return rec, handler.GetTerritoryPerformance(c)
+	}
+
+	t.Run("success_for_creator", func(t *testing.T) {
+		rec, err := performanceRequest(creator.ID, fmt.Sprintf("%d", terr.ID))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var resp map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &resp)
+		assert.Equal(t, "West", resp["name"])
+		assert.Equal(t, float64(1), resp["total_leads"])
+		assert.Equal(t, float64(100), resp["conversion_rate"])
+	})
+
+	t.Run("not_found_for_non_member", func(t *testing.T) {
+		rec, err := performanceRequest(otherUser.ID, fmt.Sprintf("%d", terr.ID))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("invalid_id", func(t *testing.T) {
+		rec, err := performanceRequest(creator.ID, "abc")
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestTerritoryHandler_ListTerritoryPerformance(t *testing.T) {
+	client, handler, creator, otherUser := setupTerritoryTest(t)
+	ctx := context.Background()
+
+	client.Territory.Create().SetName("West").SetCreatedByUserID(creator.ID).SaveX(ctx)
+	client.Territory.Create().SetName("East").SetCreatedByUserID(otherUser.ID).SaveX(ctx)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/territories/performance", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", creator.ID)
+
+	err := handler.ListTerritoryPerformance(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var resp struct {
+		Territories []map[string]interface{} `json:"territories"`
+		Totals      map[string]interface{}   `json:"totals"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.Len(t, resp.Territories, 1)
+	assert.Equal(t, "West", resp.Territories[0]["name"])
+	assert.Equal(t, float64(0), resp.Totals["total_leads"])
+}
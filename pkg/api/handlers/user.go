@@ -13,11 +13,13 @@ import (
 	"github.com/jordanlanch/industrydb/ent/subscription"
 	"github.com/jordanlanch/industrydb/ent/user"
 	"github.com/jordanlanch/industrydb/pkg/api/errors"
+	"github.com/jordanlanch/industrydb/pkg/api/validation"
 	"github.com/jordanlanch/industrydb/pkg/audit"
 	"github.com/jordanlanch/industrydb/pkg/auth"
 	"github.com/jordanlanch/industrydb/pkg/billing"
 	"github.com/jordanlanch/industrydb/pkg/leads"
 	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/jordanlanch/industrydb/pkg/onboarding"
 	"github.com/labstack/echo/v4"
 )
 
@@ -27,6 +29,7 @@ type UserHandler struct {
 	leadService    *leads.Service
 	auditLogger    *audit.Service
 	billingService *billing.Service
+	onboarding     *onboarding.Service
 	validator      *validator.Validate
 }
 
@@ -38,7 +41,8 @@ func NewUserHandler(db *ent.Client, leadService *leads.Service, auditLogger *aud
 		leadService:    leadService,
 		auditLogger:    auditLogger,
 		billingService: billingService,
-		validator:      validator.New(),
+		onboarding:     onboarding.NewService(db),
+		validator:      validation.Validator(),
 	}
 }
 
@@ -344,9 +348,40 @@ func (h *UserHandler) DeleteAccount(c echo.Context) error {
 	})
 }
 
+// GetOnboarding godoc
+// @Summary Get onboarding checklist
+// @Description Get the user's onboarding checklist (verify email, first search, first export, invite a teammate by default) with each item's completion computed from the user's activity. Onboarding is marked completed once every item is done.
+// @Tags User
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} onboarding.Status
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /user/onboarding [get]
+func (h *UserHandler) GetOnboarding(c echo.Context) error {
+	// Get user ID from context
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	status, err := h.onboarding.GetStatus(ctx, userID)
+	if err != nil {
+		return errors.InternalError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, status)
+}
+
 // CompleteOnboarding godoc
 // @Summary Mark onboarding as completed
-// @Description Mark the user's onboarding wizard as completed
+// @Description Mark the user's onboarding wizard as completed, whatever its checklist items
 // @Tags User
 // @Accept json
 // @Produce json
@@ -382,3 +382,46 @@ func TestDeleteAccount_Unauthorized(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, http.StatusUnauthorized, rec.Code)
 }
+
+func TestGetOnboarding(t *testing.T) {
+	handler, client, cleanup := setupTestHandler(t)
+	defer cleanup()
+
+	user, err := client.User.Create().
+		SetEmail("onboarding@example.com").
+		SetPasswordHash("$2a$10$test_hash").
+		SetName("Onboarding User").
+		SetEmailVerified(true).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/user/onboarding", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", user.ID)
+
+	require.NoError(t, handler.GetOnboarding(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response struct {
+		Items []struct {
+			Key       string `json:"key"`
+			Completed bool   `json:"completed"`
+		} `json:"items"`
+		CompletedCount int  `json:"completed_count"`
+		Completed      bool `json:"completed"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Len(t, response.Items, 4)
+	// The user's email is verified
+	assert.Equal(t, "verify_email", response.Items[0].Key)
+	assert.True(t, response.Items[0].Completed)
+	assert.Equal(t, 1, response.CompletedCount)
+	assert.False(t, response.Completed)
+
+	// Unauthenticated
+	rec = httptest.NewRecorder()
+	require.NoError(t, handler.GetOnboarding(e.NewContext(req, rec)))
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
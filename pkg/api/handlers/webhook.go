@@ -1,15 +1,25 @@
 package handlers
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"time"
 
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/auditlog"
+	"github.com/jordanlanch/industrydb/pkg/audit"
+	"github.com/jordanlanch/industrydb/pkg/deliverywindow"
 	"github.com/jordanlanch/industrydb/pkg/webhook"
 	"github.com/labstack/echo/v4"
 )
 
 // WebhookHandler handles webhook-related requests
 type WebhookHandler struct {
-	service *webhook.Service
+	service     *webhook.Service
+	auditLogger *audit.Service // Records bulk deletes, nil records none
 }
 
 // NewWebhookHandler creates a new webhook handler
@@ -19,14 +29,20 @@ func NewWebhookHandler(service *webhook.Service) *WebhookHandler {
 	}
 }
 
+// SetAuditLogger sets where bulk webhook deletes are audited
+func (h *WebhookHandler) SetAuditLogger(auditLogger *audit.Service) {
+	h.auditLogger = auditLogger
+}
+
 // CreateWebhook godoc
 // @Summary Create webhook
-// @Description Create a new webhook subscription
+// @Description Create a new webhook subscription. An optional delivery_window {"timezone", "start_hour", "end_hour"} restricts deliveries to local hours; events outside it are deferred until it opens, never dropped. An optional batching {"max_events", "window_seconds"} (default 100 events / 5 seconds) delivers events in signed JSON arrays, sent when full or when the window ends. An optional redaction_profile (an export redaction profile such as "partner") redacts lead data in lead.updated payloads.
 // @Tags webhooks
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param body body map[string]interface{} true "Webhook configuration"
+// @Param Idempotency-Key header string false "Retries with the same key within the idempotency window (default 24 hours) return the first response instead of repeating the request"
 // @Success 201 {object} map[string]interface{} "Webhook created"
 // @Failure 400 {object} map[string]string "Bad request"
 // @Failure 500 {object} map[string]string "Internal server error"
@@ -36,9 +52,12 @@ func (h *WebhookHandler) CreateWebhook(c echo.Context) error {
 	userID := c.Get("user_id").(int)
 
 	var req struct {
-		URL         string   `json:"url" validate:"required,url"`
-		Events      []string `json:"events" validate:"required,min=1"`
-		Description string   `json:"description"`
+		URL              string                 `json:"url" validate:"required,url"`
+		Events           []string               `json:"events" validate:"required,min=1"`
+		Description      string                 `json:"description"`
+		DeliveryWindow   *deliverywindow.Window `json:"delivery_window"`   // Allowed local hours (default 24/7)
+		Batching         *webhook.Batching      `json:"batching"`          // Batched delivery (default one delivery per event)
+		RedactionProfile string                 `json:"redaction_profile"` // Redaction profile applied to lead data (default none)
 	}
 
 	if err := c.Bind(&req); err != nil {
@@ -59,21 +78,75 @@ func (h *WebhookHandler) CreateWebhook(c echo.Context) error {
 		})
 	}
 
+	if err := req.DeliveryWindow.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	if err := req.Batching.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	if err := h.service.ValidateRedactionProfile(req.RedactionProfile); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
 	wh, err := h.service.CreateWebhook(ctx, userID, req.URL, req.Events, req.Description)
 	if err != nil {
+		if errors.Is(err, webhook.ErrInvalidURL) {
+			return c.JSON(http.StatusBadRequest, map[string]string{
+				"error": err.Error(),
+			})
+		}
 		return c.JSON(http.StatusInternalServerError, map[string]string{
 			"error": err.Error(),
 		})
 	}
 
+	if req.DeliveryWindow != nil {
+		wh, err = h.service.SetDeliveryWindow(ctx, wh.ID, userID, req.DeliveryWindow)
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, map[string]string{
+				"error": err.Error(),
+			})
+		}
+	}
+
+	if req.Batching != nil {
+		wh, err = h.service.SetBatching(ctx, wh.ID, userID, req.Batching)
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, map[string]string{
+				"error": err.Error(),
+			})
+		}
+	}
+
+	if req.RedactionProfile != "" {
+		wh, err = h.service.SetRedactionProfile(ctx, wh.ID, userID, req.RedactionProfile)
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, map[string]string{
+				"error": err.Error(),
+			})
+		}
+	}
+
 	return c.JSON(http.StatusCreated, map[string]interface{}{
-		"id":          wh.ID,
-		"url":         wh.URL,
-		"events":      wh.Events,
-		"description": wh.Description,
-		"active":      wh.Active,
-		"secret":      wh.Secret, // Return secret only on creation
-		"created_at":  wh.CreatedAt,
+		"id":                wh.ID,
+		"url":               wh.URL,
+		"events":            wh.Events,
+		"description":       wh.Description,
+		"active":            wh.Active,
+		"schema_version":    wh.SchemaVersion,
+		"delivery_window":   webhook.DeliveryWindow(wh),
+		"batching":          webhook.BatchingOf(wh),
+		"redaction_profile": wh.RedactionProfile,
+		"secret":            wh.Secret, // Return secret only on creation
+		"created_at":        wh.CreatedAt,
 	})
 }
 
@@ -84,14 +157,17 @@ func (h *WebhookHandler) CreateWebhook(c echo.Context) error {
 // @Accept json
 // @Produce json
 // @Security BearerAuth
-// @Success 200 {object} map[string]interface{} "List of webhooks"
+// @Param page query int false "Page number (default 1)"
+// @Param limit query int false "Items per page (default all, max 100)"
+// @Success 200 {object} ListResponse{data=[]object} "List of webhooks, paginated"
 // @Failure 500 {object} map[string]string "Internal server error"
 // @Router /webhooks [get]
 func (h *WebhookHandler) ListWebhooks(c echo.Context) error {
 	ctx := c.Request().Context()
 	userID := c.Get("user_id").(int)
 
-	webhooks, err := h.service.ListWebhooks(ctx, userID)
+	page := parseListPage(c)
+	webhooks, total, err := h.service.ListWebhooks(ctx, userID, page.Limit, page.Offset())
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{
 			"error": err.Error(),
@@ -107,6 +183,13 @@ func (h *WebhookHandler) ListWebhooks(c echo.Context) error {
 			"events":            wh.Events,
 			"description":       wh.Description,
 			"active":            wh.Active,
+			"schema_version":    wh.SchemaVersion,
+			"paused":            wh.PausedAt != nil,
+			"paused_at":         wh.PausedAt,
+			"delivery_window":   webhook.DeliveryWindow(wh),
+			"batching":          webhook.BatchingOf(wh),
+			"redaction_profile": wh.RedactionProfile,
+			"queued_events":     len(wh.QueuedEvents),
 			"success_count":     wh.SuccessCount,
 			"failure_count":     wh.FailureCount,
 			"last_triggered_at": wh.LastTriggeredAt,
@@ -114,10 +197,7 @@ func (h *WebhookHandler) ListWebhooks(c echo.Context) error {
 		}
 	}
 
-	return c.JSON(http.StatusOK, map[string]interface{}{
-		"webhooks": response,
-		"count":    len(webhooks),
-	})
+	return respondList(c, page, response, total, "webhooks", "count")
 }
 
 // GetWebhook godoc
@@ -156,6 +236,13 @@ func (h *WebhookHandler) GetWebhook(c echo.Context) error {
 		"events":            wh.Events,
 		"description":       wh.Description,
 		"active":            wh.Active,
+		"schema_version":    wh.SchemaVersion,
+		"paused":            wh.PausedAt != nil,
+		"paused_at":         wh.PausedAt,
+		"delivery_window":   webhook.DeliveryWindow(wh),
+		"batching":          webhook.BatchingOf(wh),
+		"redaction_profile": wh.RedactionProfile,
+		"queued_events":     len(wh.QueuedEvents),
 		"success_count":     wh.SuccessCount,
 		"failure_count":     wh.FailureCount,
 		"last_triggered_at": wh.LastTriggeredAt,
@@ -165,7 +252,7 @@ func (h *WebhookHandler) GetWebhook(c echo.Context) error {
 
 // UpdateWebhook godoc
 // @Summary Update webhook
-// @Description Update webhook configuration
+// @Description Update webhook configuration. Set delivery_window to {"timezone", "start_hour", "end_hour"} to restrict deliveries to local hours, or null to deliver 24/7. Set batching to {"max_events", "window_seconds"} to deliver events in batches, or null for one delivery per event. Set redaction_profile to redact lead data in lead.updated payloads, or "" to send it as is.
 // @Tags webhooks
 // @Accept json
 // @Produce json
@@ -189,9 +276,13 @@ func (h *WebhookHandler) UpdateWebhook(c echo.Context) error {
 	}
 
 	var req struct {
-		URL    *string  `json:"url"`
-		Events []string `json:"events"`
-		Active *bool    `json:"active"`
+		URL              *string         `json:"url"`
+		Events           []string        `json:"events"`
+		Active           *bool           `json:"active"`
+		SchemaVersion    *string         `json:"schema_version"`    // Pin the payload schema version
+		DeliveryWindow   json.RawMessage `json:"delivery_window"`   // Allowed local hours, null for 24/7
+		Batching         json.RawMessage `json:"batching"`          // Batched delivery, null for one delivery per event
+		RedactionProfile *string         `json:"redaction_profile"` // Redaction profile applied to lead data, "" for none
 	}
 
 	if err := c.Bind(&req); err != nil {
@@ -200,23 +291,270 @@ func (h *WebhookHandler) UpdateWebhook(c echo.Context) error {
 		})
 	}
 
-	wh, err := h.service.UpdateWebhook(ctx, webhookID, userID, req.URL, req.Events, req.Active)
+	var window *deliverywindow.Window
+	if len(req.DeliveryWindow) > 0 {
+		if err := json.Unmarshal(req.DeliveryWindow, &window); err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{
+				"error": "Invalid delivery_window",
+			})
+		}
+		if err := window.Validate(); err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{
+				"error": err.Error(),
+			})
+		}
+	}
+
+	var batching *webhook.Batching
+	if len(req.Batching) > 0 {
+		if err := json.Unmarshal(req.Batching, &batching); err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{
+				"error": "Invalid batching",
+			})
+		}
+		if err := batching.Validate(); err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{
+				"error": err.Error(),
+			})
+		}
+	}
+
+	wh, err := h.service.UpdateWebhook(ctx, webhookID, userID, req.URL, req.Events, req.Active, req.SchemaVersion)
+	if err == nil && len(req.DeliveryWindow) > 0 {
+		wh, err = h.service.SetDeliveryWindow(ctx, webhookID, userID, window)
+	}
+	if err == nil && len(req.Batching) > 0 {
+		wh, err = h.service.SetBatching(ctx, webhookID, userID, batching)
+	}
+	if err == nil && req.RedactionProfile != nil {
+		wh, err = h.service.SetRedactionProfile(ctx, webhookID, userID, *req.RedactionProfile)
+	}
 	if err != nil {
+		if errors.Is(err, webhook.ErrUnsupportedSchemaVersion) || errors.Is(err, webhook.ErrInvalidURL) || errors.Is(err, webhook.ErrInvalidRedactionProfile) {
+			return c.JSON(http.StatusBadRequest, map[string]string{
+				"error": err.Error(),
+			})
+		}
 		return c.JSON(http.StatusNotFound, map[string]string{
 			"error": err.Error(),
 		})
 	}
 
 	return c.JSON(http.StatusOK, map[string]interface{}{
-		"id":          wh.ID,
-		"url":         wh.URL,
-		"events":      wh.Events,
-		"description": wh.Description,
-		"active":      wh.Active,
-		"updated_at":  wh.UpdatedAt,
+		"id":                wh.ID,
+		"url":               wh.URL,
+		"events":            wh.Events,
+		"description":       wh.Description,
+		"active":            wh.Active,
+		"schema_version":    wh.SchemaVersion,
+		"delivery_window":   webhook.DeliveryWindow(wh),
+		"batching":          webhook.BatchingOf(wh),
+		"redaction_profile": wh.RedactionProfile,
+		"updated_at":        wh.UpdatedAt,
+	})
+}
+
+// ListSchemaVersions godoc
+// @Summary List webhook payload schema versions
+// @Description List the payload schema versions a webhook can be pinned to, with their sunset dates
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} map[string]interface{} "Supported schema versions"
+// @Router /webhooks/schema-versions [get]
+func (h *WebhookHandler) ListSchemaVersions(c echo.Context) error {
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"latest":   webhook.LatestSchemaVersion,
+		"versions": webhook.SupportedSchemaVersions(time.Now()),
+	})
+}
+
+// PauseWebhook godoc
+// @Summary Pause webhook
+// @Description Temporarily stop deliveries without deleting the webhook. Events are queued (up to 100) and replayed on resume. Paused deliveries are not counted as failures.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Webhook ID"
+// @Success 200 {object} map[string]interface{} "Paused webhook"
+// @Failure 400 {object} map[string]string "Invalid webhook ID"
+// @Failure 404 {object} map[string]string "Webhook not found"
+// @Failure 500 {object} map[string]string "Internal server error"
+// @Router /webhooks/{id}/pause [post]
+func (h *WebhookHandler) PauseWebhook(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID := c.Get("user_id").(int)
+
+	var webhookID int
+	if err := echo.PathParamsBinder(c).Int("id", &webhookID).BindError(); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Invalid webhook ID",
+		})
+	}
+
+	wh, err := h.service.PauseWebhook(ctx, webhookID, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, map[string]string{
+				"error": "Webhook not found",
+			})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"id":            wh.ID,
+		"paused":        true,
+		"paused_at":     wh.PausedAt,
+		"queued_events": len(wh.QueuedEvents),
 	})
 }
 
+// ResumeWebhook godoc
+// @Summary Resume webhook
+// @Description Resume deliveries for a paused webhook and replay events queued during the pause. Outside the webhook's delivery window the queued events are delivered when the window opens (replayed_events is 0).
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Webhook ID"
+// @Success 200 {object} map[string]interface{} "Resumed webhook with number of replayed events"
+// @Failure 400 {object} map[string]string "Invalid webhook ID"
+// @Failure 404 {object} map[string]string "Webhook not found"
+// @Failure 500 {object} map[string]string "Internal server error"
+// @Router /webhooks/{id}/resume [post]
+func (h *WebhookHandler) ResumeWebhook(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID := c.Get("user_id").(int)
+
+	var webhookID int
+	if err := echo.PathParamsBinder(c).Int("id", &webhookID).BindError(); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Invalid webhook ID",
+		})
+	}
+
+	wh, replayed, err := h.service.ResumeWebhook(ctx, webhookID, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, map[string]string{
+				"error": "Webhook not found",
+			})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"id":              wh.ID,
+		"paused":          false,
+		"replayed_events": replayed,
+	})
+}
+
+// ListFailedDeliveries godoc
+// @Summary List failed webhook deliveries
+// @Description List deliveries that failed every retry (the dead-letter store), newest first, with the payload as originally sent and the time, response status and error of every attempt. Deliveries successfully replayed are no longer listed. The newest 500 per webhook are kept.
+// @Tags webhooks
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Webhook ID"
+// @Param limit query int false "Maximum deliveries to return (default 50, max 500)"
+// @Success 200 {object} map[string]interface{} "Failed deliveries"
+// @Failure 400 {object} map[string]string "Invalid webhook ID or limit"
+// @Failure 404 {object} map[string]string "Webhook not found"
+// @Failure 500 {object} map[string]string "Internal server error"
+// @Router /webhooks/{id}/deliveries/failed [get]
+func (h *WebhookHandler) ListFailedDeliveries(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID := c.Get("user_id").(int)
+
+	var webhookID int
+	if err := echo.PathParamsBinder(c).Int("id", &webhookID).BindError(); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Invalid webhook ID",
+		})
+	}
+
+	limit := 50
+	if err := echo.QueryParamsBinder(c).Int("limit", &limit).BindError(); err != nil || limit < 1 || limit > webhook.MaxFailedDeliveries {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "limit must be between 1 and 500",
+		})
+	}
+
+	deliveries, err := h.service.ListFailedDeliveries(ctx, webhookID, userID, limit)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, map[string]string{
+				"error": "Webhook not found",
+			})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"deliveries": deliveries,
+		"total":      len(deliveries),
+	})
+}
+
+// ReplayDelivery godoc
+// @Summary Replay a failed webhook delivery
+// @Description Re-attempt a failed delivery once, for example after fixing the endpoint. The payload keeps its event id but gets a fresh timestamp, is rendered in the webhook's current schema version and signed with its current secret. The attempt is added to the delivery's attempts; on success the delivery is marked delivered.
+// @Tags webhooks
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Webhook ID"
+// @Param delivery_id path int true "Delivery ID"
+// @Success 200 {object} webhook.FailedDelivery "Replayed delivery; status is delivered on success"
+// @Failure 400 {object} map[string]string "Invalid ID"
+// @Failure 404 {object} map[string]string "Webhook or delivery not found"
+// @Failure 409 {object} map[string]string "Delivery already replayed successfully"
+// @Failure 500 {object} map[string]string "Internal server error"
+// @Router /webhooks/{id}/deliveries/{delivery_id}/replay [post]
+func (h *WebhookHandler) ReplayDelivery(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID := c.Get("user_id").(int)
+
+	var webhookID, deliveryID int
+	if err := echo.PathParamsBinder(c).Int("id", &webhookID).Int("delivery_id", &deliveryID).BindError(); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Invalid webhook or delivery ID",
+		})
+	}
+
+	delivery, err := h.service.ReplayDelivery(ctx, webhookID, userID, deliveryID)
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			return c.JSON(http.StatusNotFound, map[string]string{
+				"error": "Webhook not found",
+			})
+		case errors.Is(err, webhook.ErrDeliveryNotFound):
+			return c.JSON(http.StatusNotFound, map[string]string{
+				"error": "Delivery not found",
+			})
+		case errors.Is(err, webhook.ErrDeliveryAlreadyDelivered):
+			return c.JSON(http.StatusConflict, map[string]string{
+				"error": err.Error(),
+			})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, delivery)
+}
+
 // DeleteWebhook godoc
 // @Summary Delete webhook
 // @Description Delete a webhook
@@ -250,3 +588,83 @@ func (h *WebhookHandler) DeleteWebhook(c echo.Context) error {
 		"message": "Webhook deleted successfully",
 	})
 }
+
+// BulkDeleteWebhooksRequest selects the webhooks to delete
+type BulkDeleteWebhooksRequest struct {
+	webhook.BulkDeleteFilter
+	Confirm bool `json:"confirm"` // Must be true to delete
+}
+
+// BulkDeleteWebhooks godoc
+// @Summary Bulk delete webhooks by filter
+// @Description Delete all personal webhooks matching a filter, with their failed deliveries: inactive (only disabled webhooks), host (only webhooks whose URL targets this host, case-insensitive and ignoring the port) and created_before (RFC3339). Filters combine and at least one is required. Requires confirm=true. Use /batch/webhooks/delete to delete by ID.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body BulkDeleteWebhooksRequest true "Filter and confirmation"
+// @Success 200 {object} map[string]interface{} "Number and IDs of webhooks deleted"
+// @Failure 400 {object} map[string]string "Bad request"
+// @Failure 500 {object} map[string]string "Internal server error"
+// @Router /webhooks/bulk-delete [post]
+func (h *WebhookHandler) BulkDeleteWebhooks(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID := c.Get("user_id").(int)
+
+	var req BulkDeleteWebhooksRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Invalid request body",
+		})
+	}
+
+	if !req.Confirm {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Set confirm to true to delete every webhook matching the filter",
+		})
+	}
+
+	ids, err := h.service.BulkDeleteWebhooks(ctx, userID, req.BulkDeleteFilter)
+	if err != nil {
+		if errors.Is(err, webhook.ErrEmptyBulkDeleteFilter) {
+			return c.JSON(http.StatusBadRequest, map[string]string{
+				"error": "At least one filter (inactive, host, created_before) is required",
+			})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	if h.auditLogger != nil {
+		resourceType := "webhook"
+		ipAddress, userAgent := audit.GetRequestContext(c)
+		description := fmt.Sprintf("Bulk deleted %d webhooks", len(ids))
+		metadata := map[string]interface{}{
+			"webhook_ids": ids,
+			"inactive":    req.Inactive,
+		}
+		if req.Host != "" {
+			metadata["host"] = req.Host
+		}
+		if req.CreatedBefore != nil {
+			metadata["created_before"] = req.CreatedBefore.UTC().Format(time.RFC3339)
+		}
+
+		go h.auditLogger.Log(context.Background(), audit.LogEntry{
+			UserID:       &userID,
+			Action:       auditlog.ActionWebhookBulkDelete,
+			ResourceType: &resourceType,
+			IPAddress:    &ipAddress,
+			UserAgent:    &userAgent,
+			Description:  &description,
+			Severity:     auditlog.SeverityInfo,
+			Metadata:     metadata,
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"deleted":     len(ids),
+		"webhook_ids": ids,
+	})
+}
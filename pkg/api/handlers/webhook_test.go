@@ -7,11 +7,14 @@ import (
 	"net/http/httptest"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/jordanlanch/industrydb/ent"
 	"github.com/jordanlanch/industrydb/ent/enttest"
+	exportpkg "github.com/jordanlanch/industrydb/pkg/export"
 	"github.com/jordanlanch/industrydb/pkg/webhook"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
@@ -131,6 +134,65 @@ func TestWebhookHandler_Create_EmptyURL(t *testing.T) {
 	assert.Equal(t, "URL is required", response["error"])
 }
 
+func TestWebhookHandler_Create_InternalURL(t *testing.T) {
+	handler, svc, client, cleanup := setupWebhookHandler(t)
+	defer cleanup()
+
+	userID := createWebhookTestUser(t, client, "wh-internal@example.com")
+
+	for _, url := range []string{
+		"http://example.com/webhook",
+		"https://169.254.169.254/latest/meta-data",
+		"https://localhost:8080/webhook",
+	} {
+		e := echo.New()
+		body := `{"url":"` + url + `","events":["lead.created"]}`
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/webhooks", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", userID)
+
+		err := handler.CreateWebhook(c)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code, url)
+	}
+
+	webhooks, _, err := svc.ListWebhooks(context.Background(), userID, 0, 0)
+	require.NoError(t, err)
+	assert.Empty(t, webhooks)
+}
+
+func TestWebhookHandler_Create_RedactionProfile(t *testing.T) {
+	handler, svc, client, cleanup := setupWebhookHandler(t)
+	defer cleanup()
+	svc.SetRedactor(exportpkg.LeadFieldRedactor{})
+
+	userID := createWebhookTestUser(t, client, "wh-redaction@example.com")
+
+	create := func(profile string) *httptest.ResponseRecorder {
+		e := echo.New()
+		body := `{"url":"https://example.com/webhook","events":["lead.updated"],"redaction_profile":"` + profile + `"}`
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/webhooks", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", userID)
+		require.NoError(t, handler.CreateWebhook(c))
+		return rec
+	}
+
+	rec := create("partner")
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var response map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, "partner", response["redaction_profile"])
+
+	rec = create("unknown")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "invalid redaction profile")
+}
+
 func TestWebhookHandler_Create_NoEvents(t *testing.T) {
 	handler, _, client, cleanup := setupWebhookHandler(t)
 	defer cleanup()
@@ -416,6 +478,331 @@ func TestWebhookHandler_Update_NotFound(t *testing.T) {
 	assert.Equal(t, http.StatusNotFound, rec.Code)
 }
 
+// --- Schema Version Tests ---
+
+// newWebhookPatchContext creates a PATCH context for a webhook with a JSON body
+func newWebhookPatchContext(userID, webhookID int, body string) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPatch, "/", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", userID)
+	c.SetParamNames("id")
+	c.SetParamValues(intToStr(webhookID))
+	return c, rec
+}
+
+func TestWebhookHandler_Update_PinSchemaVersion(t *testing.T) {
+	handler, svc, client, cleanup := setupWebhookHandler(t)
+	defer cleanup()
+
+	var mu sync.Mutex
+	var bodies []map[string]interface{}
+	var versions []string
+	// httptest servers listen on loopback
+	webhook.SetURLPolicy(webhook.URLPolicy{AllowedHosts: []string{"127.0.0.1"}})
+	defer webhook.SetURLPolicy(webhook.URLPolicy{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		mu.Lock()
+		bodies = append(bodies, body)
+		versions = append(versions, r.Header.Get("X-Webhook-Schema-Version"))
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	received := func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(bodies)
+	}
+
+	userID := createWebhookTestUser(t, client, "wh-version@example.com")
+	ctx := context.Background()
+	wh, err := svc.CreateWebhook(ctx, userID, server.URL, []string{"lead.created"}, "Versioned")
+	require.NoError(t, err)
+	assert.Equal(t, webhook.LatestSchemaVersion, wh.SchemaVersion)
+
+	// Unknown versions are rejected
+	c, rec := newWebhookPatchContext(userID, wh.ID, `{"schema_version":"99"}`)
+	require.NoError(t, handler.UpdateWebhook(c))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// Pinned to version 1, the original payload shape is delivered
+	c, rec = newWebhookPatchContext(userID, wh.ID, `{"schema_version":"1"}`)
+	require.NoError(t, handler.UpdateWebhook(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var response map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, "1", response["schema_version"])
+
+	svc.TriggerWebhooks(ctx, userID, "lead.created", map[string]interface{}{"lead_id": 1})
+	assert.Eventually(t, func() bool { return received() == 1 }, 5*time.Second, 20*time.Millisecond)
+
+	mu.Lock()
+	assert.Equal(t, "1", versions[0])
+	assert.Equal(t, "1", bodies[0]["schema_version"])
+	assert.Equal(t, "lead.created", bodies[0]["event"])
+	assert.NotContains(t, bodies[0], "id")
+	assert.NotContains(t, bodies[0], "occurred_at")
+	mu.Unlock()
+
+	// Moving to version 2 adds the event ID and occurred_at
+	c, rec = newWebhookPatchContext(userID, wh.ID, `{"schema_version":"2"}`)
+	require.NoError(t, handler.UpdateWebhook(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	svc.TriggerWebhooks(ctx, userID, "lead.created", map[string]interface{}{"lead_id": 2})
+	assert.Eventually(t, func() bool { return received() == 2 }, 5*time.Second, 20*time.Millisecond)
+
+	mu.Lock()
+	assert.Equal(t, "2", versions[1])
+	assert.Equal(t, "2", bodies[1]["schema_version"])
+	assert.Regexp(t, `^evt_[0-9a-f]{24}$`, bodies[1]["id"])
+	assert.NotEmpty(t, bodies[1]["occurred_at"])
+	mu.Unlock()
+}
+
+func TestWebhookHandler_Update_Batching(t *testing.T) {
+	handler, svc, client, cleanup := setupWebhookHandler(t)
+	defer cleanup()
+
+	userID := createWebhookTestUser(t, client, "wh-batching@example.com")
+	wh, err := svc.CreateWebhook(context.Background(), userID, "https://example.com/webhook", []string{"lead.created"}, "Batched")
+	require.NoError(t, err)
+
+	// Out-of-range batch sizes are rejected
+	c, rec := newWebhookPatchContext(userID, wh.ID, `{"batching":{"max_events":500}}`)
+	require.NoError(t, handler.UpdateWebhook(c))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// Unset fields get the defaults
+	c, rec = newWebhookPatchContext(userID, wh.ID, `{"batching":{}}`)
+	require.NoError(t, handler.UpdateWebhook(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var response map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, map[string]interface{}{"max_events": float64(100), "window_seconds": float64(5)}, response["batching"])
+
+	// null goes back to one delivery per event
+	c, rec = newWebhookPatchContext(userID, wh.ID, `{"batching":null}`)
+	require.NoError(t, handler.UpdateWebhook(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Nil(t, response["batching"])
+}
+
+func TestWebhookHandler_ListSchemaVersions(t *testing.T) {
+	handler, _, _, cleanup := setupWebhookHandler(t)
+	defer cleanup()
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	require.NoError(t, handler.ListSchemaVersions(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response struct {
+		Latest   string `json:"latest"`
+		Versions []struct {
+			Version string  `json:"version"`
+			Sunset  *string `json:"sunset"`
+		} `json:"versions"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, webhook.LatestSchemaVersion, response.Latest)
+	require.NotEmpty(t, response.Versions)
+	assert.Equal(t, webhook.LatestSchemaVersion, response.Versions[0].Version)
+}
+
+// --- Pause/Resume Tests ---
+
+func newWebhookIDContext(userID, webhookID int) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", userID)
+	c.SetParamNames("id")
+	c.SetParamValues(intToStr(webhookID))
+	return c, rec
+}
+
+func TestWebhookHandler_PauseResume_QueuesAndReplaysEvents(t *testing.T) {
+	handler, svc, client, cleanup := setupWebhookHandler(t)
+	defer cleanup()
+
+	var received atomic.Int32
+	// httptest servers listen on loopback
+	webhook.SetURLPolicy(webhook.URLPolicy{AllowedHosts: []string{"127.0.0.1"}})
+	defer webhook.SetURLPolicy(webhook.URLPolicy{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	userID := createWebhookTestUser(t, client, "wh-pause@example.com")
+	ctx := context.Background()
+	wh, err := svc.CreateWebhook(ctx, userID, server.URL, []string{"lead.created"}, "Pausable")
+	require.NoError(t, err)
+
+	// Pause
+	c, rec := newWebhookIDContext(userID, wh.ID)
+	require.NoError(t, handler.PauseWebhook(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	// Events are queued instead of delivered while paused
+	svc.TriggerWebhooks(ctx, userID, "lead.created", map[string]interface{}{"lead_id": 1})
+	svc.TriggerWebhooks(ctx, userID, "lead.created", map[string]interface{}{"lead_id": 2})
+	svc.TriggerWebhooks(ctx, userID, "export.completed", map[string]interface{}{"export_id": 3})
+
+	c, rec = newWebhookIDContext(userID, wh.ID)
+	require.NoError(t, handler.GetWebhook(c))
+	var details map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &details))
+	assert.Equal(t, true, details["paused"])
+	assert.NotNil(t, details["paused_at"])
+	assert.Equal(t, float64(2), details["queued_events"])
+	assert.Equal(t, float64(0), details["failure_count"])
+	assert.Equal(t, int32(0), received.Load())
+
+	// Resume replays the queued events
+	c, rec = newWebhookIDContext(userID, wh.ID)
+	require.NoError(t, handler.ResumeWebhook(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var resumed map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resumed))
+	assert.Equal(t, false, resumed["paused"])
+	assert.Equal(t, float64(2), resumed["replayed_events"])
+
+	assert.Eventually(t, func() bool { return received.Load() == 2 }, 5*time.Second, 20*time.Millisecond)
+
+	updated, err := client.Webhook.Get(ctx, wh.ID)
+	require.NoError(t, err)
+	assert.Nil(t, updated.PausedAt)
+	assert.Empty(t, updated.QueuedEvents)
+}
+
+func TestWebhookHandler_Pause_Idempotent(t *testing.T) {
+	handler, svc, client, cleanup := setupWebhookHandler(t)
+	defer cleanup()
+
+	userID := createWebhookTestUser(t, client, "wh-pause-twice@example.com")
+	wh, err := svc.CreateWebhook(context.Background(), userID, "https://example.com/hook", []string{"lead.created"}, "")
+	require.NoError(t, err)
+
+	c, _ := newWebhookIDContext(userID, wh.ID)
+	require.NoError(t, handler.PauseWebhook(c))
+	first, err := client.Webhook.Get(context.Background(), wh.ID)
+	require.NoError(t, err)
+
+	c, rec := newWebhookIDContext(userID, wh.ID)
+	require.NoError(t, handler.PauseWebhook(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	second, err := client.Webhook.Get(context.Background(), wh.ID)
+	require.NoError(t, err)
+	assert.Equal(t, first.PausedAt.Unix(), second.PausedAt.Unix())
+}
+
+func TestWebhookHandler_Pause_Ownership(t *testing.T) {
+	handler, svc, client, cleanup := setupWebhookHandler(t)
+	defer cleanup()
+
+	ownerID := createWebhookTestUser(t, client, "wh-pause-owner@example.com")
+	otherID := createWebhookTestUser(t, client, "wh-pause-other@example.com")
+	wh, err := svc.CreateWebhook(context.Background(), ownerID, "https://example.com/hook", []string{"lead.created"}, "")
+	require.NoError(t, err)
+
+	c, rec := newWebhookIDContext(otherID, wh.ID)
+	require.NoError(t, handler.PauseWebhook(c))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	c, rec = newWebhookIDContext(otherID, wh.ID)
+	require.NoError(t, handler.ResumeWebhook(c))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+// --- Failed Delivery Tests ---
+
+func TestWebhookHandler_FailedDeliveries_ListAndReplay(t *testing.T) {
+	handler, svc, client, cleanup := setupWebhookHandler(t)
+	defer cleanup()
+
+	var received atomic.Int32
+	// httptest servers listen on loopback
+	webhook.SetURLPolicy(webhook.URLPolicy{AllowedHosts: []string{"127.0.0.1"}})
+	defer webhook.SetURLPolicy(webhook.URLPolicy{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	userID := createWebhookTestUser(t, client, "wh-deadletter@example.com")
+	ctx := context.Background()
+	wh, err := svc.CreateWebhook(ctx, userID, server.URL, []string{"lead.created"}, "")
+	require.NoError(t, err)
+	delivery := client.WebhookDelivery.Create().
+		SetWebhookID(wh.ID).
+		SetEventID("evt_1").
+		SetEvent("lead.created").
+		SetPayload(map[string]interface{}{"id": "evt_1", "event": "lead.created", "data": map[string]interface{}{"lead_id": 1}, "timestamp": 1000}).
+		SetSchemaVersion(webhook.LatestSchemaVersion).
+		SetAttempts([]map[string]interface{}{{"at": time.Now(), "status_code": 500, "error": "endpoint returned status 500"}}).
+		SetLastError("endpoint returned status 500").
+		SaveX(ctx)
+
+	e := echo.New()
+	call := func(fn echo.HandlerFunc, query string, names []string, values ...string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/?"+query, nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", userID)
+		c.SetParamNames(names...)
+		c.SetParamValues(values...)
+		require.NoError(t, fn(c))
+		return rec
+	}
+
+	rec := call(handler.ListFailedDeliveries, "", []string{"id"}, intToStr(wh.ID))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var list struct {
+		Deliveries []webhook.FailedDelivery `json:"deliveries"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &list))
+	require.Len(t, list.Deliveries, 1)
+	assert.Equal(t, "evt_1", list.Deliveries[0].Payload.ID)
+	assert.Len(t, list.Deliveries[0].Attempts, 1)
+
+	rec = call(handler.ListFailedDeliveries, "limit=0", []string{"id"}, intToStr(wh.ID))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = call(handler.ReplayDelivery, "", []string{"id", "delivery_id"}, intToStr(wh.ID), intToStr(delivery.ID))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var replayed webhook.FailedDelivery
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &replayed))
+	assert.Equal(t, "delivered", replayed.Status)
+	assert.Len(t, replayed.Attempts, 2)
+	assert.Equal(t, int32(1), received.Load())
+
+	rec = call(handler.ReplayDelivery, "", []string{"id", "delivery_id"}, intToStr(wh.ID), intToStr(delivery.ID))
+	assert.Equal(t, http.StatusConflict, rec.Code)
+
+	rec = call(handler.ReplayDelivery, "", []string{"id", "delivery_id"}, intToStr(wh.ID), "99999")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = call(handler.ListFailedDeliveries, "", []string{"id"}, "99999")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
 // --- DeleteWebhook Tests ---
 
 func TestWebhookHandler_Delete_Success(t *testing.T) {
@@ -514,6 +901,54 @@ func TestWebhookHandler_Delete_InvalidID(t *testing.T) {
 	assert.Equal(t, http.StatusBadRequest, rec.Code)
 }
 
+// --- BulkDeleteWebhooks Tests ---
+
+func TestWebhookHandler_BulkDelete(t *testing.T) {
+	handler, svc, client, cleanup := setupWebhookHandler(t)
+	defer cleanup()
+
+	userID := createWebhookTestUser(t, client, "wh-bulkdel@example.com")
+	otherID := createWebhookTestUser(t, client, "wh-bulkdel-other@example.com")
+	ctx := context.Background()
+	junk, err := svc.CreateWebhook(ctx, userID, "https://junk.example.com/hook", []string{"lead.created"}, "Junk")
+	require.NoError(t, err)
+	kept, err := svc.CreateWebhook(ctx, userID, "https://crm.example.com/hook", []string{"lead.created"}, "CRM")
+	require.NoError(t, err)
+	othersJunk, err := svc.CreateWebhook(ctx, otherID, "https://junk.example.com/hook", []string{"lead.created"}, "Junk")
+	require.NoError(t, err)
+
+	bulkDelete := func(body string) *httptest.ResponseRecorder {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/webhooks/bulk-delete", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", userID)
+		require.NoError(t, handler.BulkDeleteWebhooks(c))
+		return rec
+	}
+
+	// Confirmation and a filter are required
+	assert.Equal(t, http.StatusBadRequest, bulkDelete(`{"host":"junk.example.com"}`).Code)
+	assert.Equal(t, http.StatusBadRequest, bulkDelete(`{"confirm":true}`).Code)
+
+	rec := bulkDelete(`{"host":"junk.example.com","confirm":true}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response struct {
+		Deleted    int   `json:"deleted"`
+		WebhookIDs []int `json:"webhook_ids"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, 1, response.Deleted)
+	assert.Equal(t, []int{junk.ID}, response.WebhookIDs)
+
+	_, err = client.Webhook.Get(ctx, kept.ID)
+	assert.NoError(t, err)
+	_, err = client.Webhook.Get(ctx, othersJunk.ID)
+	assert.NoError(t, err, "other users' webhooks are not deleted")
+}
+
 // --- Helper ---
 
 func intToStr(i int) string {
@@ -0,0 +1,183 @@
+package handlers
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jordanlanch/industrydb/pkg/api/errors"
+	"github.com/jordanlanch/industrydb/pkg/leads"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/jordanlanch/industrydb/pkg/webhook"
+	"github.com/labstack/echo/v4"
+)
+
+// Zapier polling limits
+const (
+	zapierDefaultLimit = 100
+	zapierMaxLimit     = leads.MaxNewLeads
+)
+
+// ZapierHandler serves Zapier polling triggers
+type ZapierHandler struct {
+	leadService *leads.Service
+}
+
+// NewZapierHandler creates a new Zapier handler
+func NewZapierHandler(leadService *leads.Service) *ZapierHandler {
+	return &ZapierHandler{leadService: leadService}
+}
+
+// NewLeads godoc
+// @Summary Poll for new leads (Zapier trigger)
+// @Description Returns leads created after `since`, newest first, as a bare JSON array as Zapier polling triggers expect. Items are keyed by the stable lead `id` Zapier dedupes on, so polling repeatedly is safe. Authenticate with the X-API-Key header of a Business tier user. Without `since`, the most recent leads are returned. Leads are scoped, suppressed, masked and projected as in searches. Each lead uses one credit the first time it is returned to the API key; leads returned again by later polls are free. The poll is rejected without charging if the credits don't cover the new leads.
+// @Tags Integrations
+// @Produce json
+// @Security ApiKeyAuth
+// @Param since query string false "Only leads created after this time (RFC3339 or Unix seconds)"
+// @Param industry query string false "Industry filter"
+// @Param country query string false "Country code filter"
+// @Param city query string false "City filter"
+// @Param limit query int false "Maximum leads (1-100, default 100)"
+// @Success 200 {array} webhook.LeadEvent
+// @Failure 400 {object} models.ErrorResponse "Invalid since or limit"
+// @Failure 401 {object} models.ErrorResponse "Missing or invalid API key"
+// @Failure 403 {object} models.ErrorResponse "Not on the Business tier (upgrade_required) or usage limit exceeded"
+// @Failure 500 {object} models.ErrorResponse "Internal server error"
+// @Router /integrations/zapier/new-leads [get]
+func (h *ZapierHandler) NewLeads(c echo.Context) error {
+	// Get user ID from context (set by the API key middleware)
+	userID, ok := c.Get("user_id").(int)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	req := leads.NewLeadsRequest{
+		Industry: c.QueryParam("industry"),
+		Country:  c.QueryParam("country"),
+		City:     c.QueryParam("city"),
+		Limit:    zapierDefaultLimit,
+	}
+	if raw := c.QueryParam("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 || parsed > zapierMaxLimit {
+			return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "invalid_limit",
+				Message: fmt.Sprintf("limit must be between 1 and %d", zapierMaxLimit),
+			})
+		}
+		req.Limit = parsed
+	}
+	if raw := c.QueryParam("since"); raw != "" {
+		since, err := parseZapierSince(raw)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "invalid_since",
+				Message: "since must be an RFC3339 timestamp or Unix seconds",
+			})
+		}
+		req.Since = &since
+	}
+
+	ctx := c.Request().Context()
+
+	// Limit scoped deployments to the organization's accessible leads
+	var organizationID *int
+	orgID, hasOrgContext := c.Get("organization_id").(int)
+	if hasOrgContext {
+		organizationID = &orgID
+	}
+	req.OrgScope = organizationID
+
+	// Leave out the fields the tier doesn't get in search results
+	tier, err := h.leadService.GetExportTier(ctx, userID, organizationID)
+	if err != nil {
+		return errors.InternalError(c, err)
+	}
+	req.HiddenFields = leads.GetSearchHiddenFieldsForTier(tier)
+
+	// Hide the leads the user or their organizations suppressed
+	suppressed, err := h.leadService.SuppressedLeadIDs(ctx, userID)
+	if err != nil {
+		return errors.InternalError(c, err)
+	}
+	req.ExcludeLeadIDs = suppressed
+
+	found, err := h.leadService.NewLeads(ctx, req)
+	if err != nil {
+		return errors.InternalError(c, err)
+	}
+
+	// One credit per lead the API key gets for the first time. Zapier polls
+	// every few minutes and gets the same recent leads back each time.
+	charged := make([]int, 0, len(found))
+	for _, l := range found {
+		charged = append(charged, l.ID)
+	}
+	apiKeyID, hasAPIKey := c.Get("api_key_id").(int)
+	if hasAPIKey {
+		charged, err = h.leadService.UndeliveredLeadIDs(ctx, apiKeyID, charged)
+		if err != nil {
+			return errors.InternalError(c, err)
+		}
+	}
+	if len(charged) > 0 {
+		if hasOrgContext {
+			// Use organization usage limits
+			if err := h.leadService.CheckAndIncrementOrganizationUsage(ctx, orgID, len(charged)); err != nil {
+				return usageError(c, err)
+			}
+		} else {
+			// Use personal usage limits
+			if err := h.leadService.CheckAndIncrementUsage(ctx, userID, len(charged)); err != nil {
+				return usageError(c, err)
+			}
+		}
+		if hasAPIKey {
+			if err := h.leadService.RecordDeliveries(ctx, apiKeyID, charged); err != nil {
+				// Already charged, so the leads are still returned
+				log.Printf("Failed to record Zapier deliveries for API key %d: %v", apiKeyID, err)
+			}
+		}
+	}
+
+	masking, err := h.leadService.ContactMaskingFor(ctx, userID, organizationID)
+	if err != nil {
+		return errors.InternalError(c, err)
+	}
+	if err := h.leadService.MaskContacts(ctx, masking, found); err != nil {
+		return errors.InternalError(c, err)
+	}
+
+	items := make([]webhook.LeadEvent, 0, len(found))
+	for _, l := range found {
+		items = append(items, webhook.NewLeadEventFromResponse(l))
+	}
+
+	return c.JSON(http.StatusOK, items)
+}
+
+// NewLeadsSample godoc
+// @Summary Sample new-leads payload (Zapier trigger)
+// @Description Returns one example item in the new-leads payload shape, used by Zapier as sample data while mapping fields
+// @Tags Integrations
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {array} webhook.LeadEvent
+// @Failure 401 {object} models.ErrorResponse "Missing or invalid API key"
+// @Router /integrations/zapier/new-leads/sample [get]
+func (h *ZapierHandler) NewLeadsSample(c echo.Context) error {
+	return c.JSON(http.StatusOK, []webhook.LeadEvent{webhook.SampleLeadEvent})
+}
+
+// parseZapierSince accepts an RFC3339 timestamp or Unix seconds
+func parseZapierSince(raw string) (time.Time, error) {
+	if seconds, err := strconv.ParseInt(raw, 10, 64); err == nil {
+		return time.Unix(seconds, 0), nil
+	}
+	return time.Parse(time.RFC3339, raw)
+}
@@ -0,0 +1,224 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/user"
+	"github.com/jordanlanch/industrydb/pkg/leads"
+	"github.com/jordanlanch/industrydb/pkg/webhook"
+	"github.com/labstack/echo/v4"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupZapierTest(t *testing.T) (*ent.Client, *ZapierHandler, *leads.Service, time.Time) {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	client.User.Create().
+		SetEmail("zapier@example.com").
+		SetPasswordHash("hash").
+		SetName("Zapier User").
+		SetSubscriptionTier(user.SubscriptionTierBusiness).
+		SetUsageLimit(10000).
+		SaveX(t.Context())
+	client.APIKey.Create().
+		SetUserID(1).
+		SetKeyHash("zapier-key-hash").
+		SetName("Zapier").
+		SetPrefix("idb_zap").
+		SaveX(t.Context())
+
+	base := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	for i, l := range []struct{ name, industry, country string }{
+		{"Oldest Ink", "tattoo", "US"},
+		{"Middle Cuts", "barber", "US"},
+		{"Newest Ink", "tattoo", "GB"},
+	} {
+		client.Lead.Create().
+			SetName(l.name).
+			SetIndustry(lead.Industry(l.industry)).
+			SetCountry(l.country).
+			SetCity("Austin").
+			SetCreatedAt(base.Add(time.Duration(i) * time.Hour)).
+			SaveX(t.Context())
+	}
+
+	leadService := leads.NewService(client, nil)
+	return client, NewZapierHandler(leadService), leadService, base
+}
+
+func pollNewLeads(t *testing.T, handler *ZapierHandler, query string) (*httptest.ResponseRecorder, []webhook.LeadEvent) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/integrations/zapier/new-leads?"+query, nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", 1)
+	c.Set("api_key_id", 1)
+
+	require.NoError(t, handler.NewLeads(c))
+
+	var items []webhook.LeadEvent
+	if rec.Code == http.StatusOK {
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &items))
+	}
+	return rec, items
+}
+
+func TestZapierHandler_NewLeads(t *testing.T) {
+	_, handler, _, base := setupZapierTest(t)
+
+	t.Run("Newest first as a bare array", func(t *testing.T) {
+		rec, items := pollNewLeads(t, handler, "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		require.Len(t, items, 3)
+		assert.Equal(t, "Newest Ink", items[0].Name)
+		assert.Equal(t, "Oldest Ink", items[2].Name)
+		assert.NotZero(t, items[0].ID)
+		assert.Equal(t, base.Add(2*time.Hour).Format(time.RFC3339), items[0].CreatedAt)
+	})
+
+	t.Run("Since cursor", func(t *testing.T) {
+		_, items := pollNewLeads(t, handler, "since="+base.Format(time.RFC3339))
+		require.Len(t, items, 2)
+		assert.Equal(t, "Newest Ink", items[0].Name)
+
+		_, items = pollNewLeads(t, handler, "since="+base.Add(90*time.Minute).Format(time.RFC3339))
+		require.Len(t, items, 1)
+	})
+
+	t.Run("Unix seconds cursor", func(t *testing.T) {
+		_, items := pollNewLeads(t, handler, "since="+strconv.FormatInt(base.Add(time.Hour).Unix(), 10))
+		require.Len(t, items, 1)
+		assert.Equal(t, "Newest Ink", items[0].Name)
+	})
+
+	t.Run("Filters and limit", func(t *testing.T) {
+		_, items := pollNewLeads(t, handler, "industry=tattoo&country=US")
+		require.Len(t, items, 1)
+		assert.Equal(t, "Oldest Ink", items[0].Name)
+
+		_, items = pollNewLeads(t, handler, "limit=2")
+		assert.Len(t, items, 2)
+	})
+
+	t.Run("Invalid parameters", func(t *testing.T) {
+		rec, _ := pollNewLeads(t, handler, "since=yesterday")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		rec, _ = pollNewLeads(t, handler, "limit=0")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		// Out of range limits are rejected, not capped
+		rec, _ = pollNewLeads(t, handler, "limit=101")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestZapierHandler_NewLeads_UsageScopeAndProjection(t *testing.T) {
+	client, handler, leadService, base := setupZapierTest(t)
+
+	t.Run("Each lead returned uses a credit", func(t *testing.T) {
+		before := client.User.GetX(t.Context(), 1).UsageCount
+		_, items := pollNewLeads(t, handler, "limit=2")
+		require.Len(t, items, 2)
+		assert.Equal(t, before+2, client.User.GetX(t.Context(), 1).UsageCount)
+
+		// Empty polls are free
+		_, items = pollNewLeads(t, handler, "since="+base.Add(24*time.Hour).Format(time.RFC3339))
+		assert.Empty(t, items)
+		assert.Equal(t, before+2, client.User.GetX(t.Context(), 1).UsageCount)
+	})
+
+	t.Run("Leads already returned to the key are free", func(t *testing.T) {
+		before := client.User.GetX(t.Context(), 1).UsageCount
+		_, items := pollNewLeads(t, handler, "")
+		require.Len(t, items, 3)
+		assert.Equal(t, before+1, client.User.GetX(t.Context(), 1).UsageCount)
+
+		_, items = pollNewLeads(t, handler, "")
+		require.Len(t, items, 3)
+		assert.Equal(t, before+1, client.User.GetX(t.Context(), 1).UsageCount)
+	})
+
+	t.Run("Rejected without charging past the limit", func(t *testing.T) {
+		client.User.UpdateOneID(1).SetUsageCount(9999).ExecX(t.Context())
+		defer client.User.UpdateOneID(1).SetUsageCount(0).ExecX(t.Context())
+		for _, name := range []string{"Fresh Ink", "Fresh Cuts"} {
+			client.Lead.Create().
+				SetName(name).
+				SetIndustry(lead.IndustryTattoo).
+				SetCountry("US").
+				SetCity("Austin").
+				SetCreatedAt(base.Add(2 * time.Hour)).
+				SaveX(t.Context())
+		}
+
+		rec, _ := pollNewLeads(t, handler, "")
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		assert.Equal(t, 9999, client.User.GetX(t.Context(), 1).UsageCount)
+	})
+
+	t.Run("Tier hidden fields are left out", func(t *testing.T) {
+		client.Lead.Update().SetEmail("hello@example.com").SetPhone("+1 512 555 0100").ExecX(t.Context())
+		require.NoError(t, leads.SetTierSearchHiddenFields(leads.TierSearchHiddenFields{"business": {"email"}}))
+		defer leads.SetTierSearchHiddenFields(nil)
+
+		_, items := pollNewLeads(t, handler, "")
+		require.NotEmpty(t, items)
+		assert.Empty(t, items[0].Email)
+		assert.Equal(t, "+1 512 555 0100", items[0].Phone)
+	})
+
+	t.Run("Organization owned leads are scoped out", func(t *testing.T) {
+		org := client.Organization.Create().
+			SetName("Acme").
+			SetSlug("acme").
+			SetOwnerID(1).
+			SetLastResetAt(time.Now()).
+			SaveX(t.Context())
+		client.Lead.Create().
+			SetName("Private Ink").
+			SetIndustry(lead.IndustryTattoo).
+			SetCountry("US").
+			SetCity("Austin").
+			SetOwnerOrganizationID(org.ID).
+			SetCreatedAt(base.Add(3 * time.Hour)).
+			SaveX(t.Context())
+		leadService.SetOrgScoping(true)
+
+		_, items := pollNewLeads(t, handler, "")
+		require.Len(t, items, 5)
+		for _, item := range items {
+			assert.NotEqual(t, "Private Ink", item.Name)
+		}
+	})
+}
+
+func TestZapierHandler_NewLeadsSample(t *testing.T) {
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(httptest.NewRequest(http.MethodGet, "/api/v1/integrations/zapier/new-leads/sample", nil), rec)
+
+	require.NoError(t, NewZapierHandler(nil).NewLeadsSample(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var items []map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &items))
+	require.Len(t, items, 1)
+
+	// The sample documents every field of the lead.created payload
+	assert.Len(t, items[0], len(webhook.SampleLeadEvent.Map()))
+	for key := range webhook.SampleLeadEvent.Map() {
+		assert.Contains(t, items[0], key)
+	}
+}
@@ -0,0 +1,116 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/user"
+	"github.com/jordanlanch/industrydb/pkg/apikey"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/labstack/echo/v4"
+)
+
+// APIKeyHeader carries API keys on requests authenticated by APIKeyMiddleware
+const APIKeyHeader = "X-API-Key"
+
+// APIKeyMiddleware authenticates requests with an API key in the X-API-Key
+// header, for integrations such as Zapier that cannot refresh JWTs. Keys of
+// users no longer on the Business tier are rejected with 403. With a request
+// log, every authenticated request is recorded against its key.
+func APIKeyMiddleware(service *apikey.Service, db *ent.Client, requestLog *apikey.RequestLog) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			key := c.Request().Header.Get(APIKeyHeader)
+			if key == "" {
+				return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+					Error:   "missing_api_key",
+					Message: "X-API-Key header is required",
+				})
+			}
+
+			// Create context with timeout
+			ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+			defer cancel()
+
+			apiKey, err := service.Authenticate(ctx, key)
+			if err != nil {
+				return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+					Error:   "invalid_api_key",
+					Message: err.Error(),
+				})
+			}
+
+			u, err := db.User.Get(ctx, apiKey.UserID)
+			if err != nil {
+				return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+					Error:   "user_not_found",
+					Message: "User account not found",
+				})
+			}
+
+			// Reject deleted users
+			if u.DeletedAt != nil {
+				return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+					Error:   "account_deleted",
+					Message: "This account has been deleted",
+				})
+			}
+
+			// Keys outlive downgrades, so the tier is checked on every request
+			if u.SubscriptionTier != user.SubscriptionTierBusiness {
+				return c.JSON(http.StatusForbidden, models.ErrorResponse{
+					Error:   "upgrade_required",
+					Message: "API keys are only available on Business tier",
+				})
+			}
+
+			// Set user info in context
+			c.Set("user_id", u.ID)
+			c.Set("user_email", u.Email)
+			c.Set("user_tier", string(u.SubscriptionTier))
+			c.Set("api_key_id", apiKey.ID)
+
+			if requestLog == nil {
+				return next(c)
+			}
+
+			start := time.Now()
+			err = next(c)
+			recordAPIKeyRequest(c, requestLog, apiKey.ID, start, err)
+			return err
+		}
+	}
+}
+
+// recordAPIKeyRequest adds a finished request to its key's request log. A
+// handler error hasn't been written to the response yet, so its status is
+// taken from the error.
+func recordAPIKeyRequest(c echo.Context, requestLog *apikey.RequestLog, keyID int, start time.Time, err error) {
+	status := c.Response().Status
+	if err != nil {
+		var httpErr *echo.HTTPError
+		if errors.As(err, &httpErr) {
+			status = httpErr.Code
+		} else {
+			status = http.StatusInternalServerError
+		}
+	}
+
+	entry := apikey.RequestLogEntry{
+		At:        start.UTC(),
+		Method:    c.Request().Method,
+		Route:     c.Path(),
+		Status:    status,
+		LatencyMs: time.Since(start).Milliseconds(),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := requestLog.Record(ctx, keyID, entry); err != nil {
+		log.Printf("⚠️  Failed to log API key request: %v", err)
+	}
+}
@@ -75,8 +75,9 @@ func JWTMiddlewareWithBlacklist(secret string, blacklist *auth.TokenBlacklist, d
 				}
 			}
 
-			// Store token in context for potential logout
+			// Store token and its session in context for potential logout
 			c.Set("token", token)
+			c.Set("session_id", claims.ID)
 
 			// Set user info in context
 			c.Set("user_id", claims.UserID)
@@ -149,8 +150,9 @@ func JWTFromQueryOrHeader(secret string, blacklist *auth.TokenBlacklist, db *ent
 				}
 			}
 
-			// Store token in context for potential logout
+			// Store token and its session in context for potential logout
 			c.Set("token", token)
+			c.Set("session_id", claims.ID)
 
 			// Set user info in context
 			c.Set("user_id", claims.UserID)
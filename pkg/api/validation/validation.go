@@ -0,0 +1,107 @@
+// Package validation binds and validates request bodies with the validator
+// struct tags shared by all handlers, reporting invalid fields by their JSON
+// name so error bodies match across endpoints.
+package validation
+
+import (
+	stderrors "errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/labstack/echo/v4"
+)
+
+// validate is shared by all handlers, it caches struct metadata and is safe
+// for concurrent use
+var validate = newValidator()
+
+func newValidator() *validator.Validate {
+	v := validator.New()
+	// Report fields by the name clients send
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		for _, tag := range []string{"json", "query", "param", "form"} {
+			name := strings.SplitN(field.Tag.Get(tag), ",", 2)[0]
+			if name != "" && name != "-" {
+				return name
+			}
+		}
+		return field.Name
+	})
+	return v
+}
+
+// Validator returns the shared validator, for handlers validating structs
+// they did not bind
+func Validator() *validator.Validate {
+	return validate
+}
+
+// Bind binds the request into req and validates it. Errors are meant for
+// errors.ValidationError, which reports the invalid fields.
+func Bind(c echo.Context, req interface{}) error {
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+	return validate.Struct(req)
+}
+
+// Fields returns the invalid fields of a validation error, nil for other
+// errors such as malformed JSON
+func Fields(err error) []models.FieldError {
+	var validationErrs validator.ValidationErrors
+	if !stderrors.As(err, &validationErrs) {
+		return nil
+	}
+
+	fields := make([]models.FieldError, len(validationErrs))
+	for i, fe := range validationErrs {
+		fields[i] = models.FieldError{
+			Field:   fieldPath(fe),
+			Rule:    fe.Tag(),
+			Message: message(fe),
+		}
+	}
+	return fields
+}
+
+// fieldPath returns the field's JSON path without the struct name, e.g.
+// "delivery_window.timezone"
+func fieldPath(fe validator.FieldError) string {
+	namespace := fe.Namespace()
+	if i := strings.Index(namespace, "."); i >= 0 {
+		return namespace[i+1:]
+	}
+	return fe.Field()
+}
+
+// message describes a failed rule for clients
+func message(fe validator.FieldError) string {
+	field := fieldPath(fe)
+	switch fe.Tag() {
+	case "required":
+		return fmt.Sprintf("%s is required", field)
+	case "email":
+		return fmt.Sprintf("%s must be a valid email address", field)
+	case "url":
+		return fmt.Sprintf("%s must be a valid URL", field)
+	case "oneof":
+		return fmt.Sprintf("%s must be one of: %s", field, fe.Param())
+	case "len":
+		return fmt.Sprintf("%s must be %s characters long", field, fe.Param())
+	case "min", "gte":
+		if fe.Kind() == reflect.String {
+			return fmt.Sprintf("%s must be at least %s characters long", field, fe.Param())
+		}
+		return fmt.Sprintf("%s must be at least %s", field, fe.Param())
+	case "max", "lte":
+		if fe.Kind() == reflect.String {
+			return fmt.Sprintf("%s must be at most %s characters long", field, fe.Param())
+		}
+		return fmt.Sprintf("%s must be at most %s", field, fe.Param())
+	default:
+		return fmt.Sprintf("%s is invalid", field)
+	}
+}
@@ -0,0 +1,66 @@
+package validation
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testWindow struct {
+	Timezone string `json:"timezone" validate:"required"`
+}
+
+type testRequest struct {
+	Name   string      `json:"name" validate:"required,max=5"`
+	Email  string      `json:"email" validate:"omitempty,email"`
+	Role   string      `json:"role" validate:"omitempty,oneof=admin member"`
+	Count  int         `json:"count" validate:"min=1"`
+	Window *testWindow `json:"window"`
+	Source string      `json:"-" query:"source" validate:"omitempty,max=3"`
+}
+
+func bind(t *testing.T, body string) (testRequest, error) {
+	t.Helper()
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	c := e.NewContext(req, httptest.NewRecorder())
+
+	var r testRequest
+	err := Bind(c, &r)
+	return r, err
+}
+
+func TestBind(t *testing.T) {
+	r, err := bind(t, `{"name": "Ada", "count": 2}`)
+	require.NoError(t, err)
+	assert.Equal(t, "Ada", r.Name)
+
+	_, err = bind(t, `{"name": "Ada Lovelace", "email": "nope", "role": "owner", "window": {}}`)
+	require.Error(t, err)
+	assert.Equal(t, []models.FieldError{
+		{Field: "name", Rule: "max", Message: "name must be at most 5 characters long"},
+		{Field: "email", Rule: "email", Message: "email must be a valid email address"},
+		{Field: "role", Rule: "oneof", Message: "role must be one of: admin member"},
+		{Field: "count", Rule: "min", Message: "count must be at least 1"},
+		{Field: "window.timezone", Rule: "required", Message: "window.timezone is required"},
+	}, Fields(err))
+}
+
+func TestBind_MalformedBody(t *testing.T) {
+	_, err := bind(t, `{broken`)
+	require.Error(t, err)
+	assert.Nil(t, Fields(err))
+}
+
+func TestFields_OtherErrors(t *testing.T) {
+	assert.Nil(t, Fields(errors.New("boom")))
+	assert.Nil(t, Fields(nil))
+}
@@ -0,0 +1,87 @@
+package apikey
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jordanlanch/industrydb/pkg/cache"
+)
+
+// Request log bounds: the newest MaxRequestLogEntries requests of each key
+// are kept, and a key's log expires RequestLogRetention after its last request
+const (
+	MaxRequestLogEntries = 200
+	RequestLogRetention  = 7 * 24 * time.Hour
+)
+
+// RequestLogEntry is one request made with an API key
+type RequestLogEntry struct {
+	At        time.Time `json:"at"`
+	Method    string    `json:"method"`
+	Route     string    `json:"route"` // Route pattern, e.g. /api/v1/integrations/zapier/new-leads
+	Status    int       `json:"status"`
+	LatencyMs int64     `json:"latency_ms"`
+}
+
+// RequestLog keeps the recent requests of each API key in Redis, one
+// capped list per key, newest first
+type RequestLog struct {
+	cache *cache.Client
+}
+
+// NewRequestLog creates a new API key request log
+func NewRequestLog(cache *cache.Client) *RequestLog {
+	return &RequestLog{cache: cache}
+}
+
+// Record adds a request to a key's log, dropping the oldest past
+// MaxRequestLogEntries
+func (l *RequestLog) Record(ctx context.Context, keyID int, entry RequestLogEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to encode request log entry: %w", err)
+	}
+
+	key := requestLogKey(keyID)
+	pipe := l.cache.Redis.Pipeline()
+	pipe.LPush(ctx, key, data)
+	pipe.LTrim(ctx, key, 0, MaxRequestLogEntries-1)
+	pipe.Expire(ctx, key, RequestLogRetention)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to record request: %w", err)
+	}
+	return nil
+}
+
+// List returns up to limit of a key's most recent requests, newest first
+func (l *RequestLog) List(ctx context.Context, keyID int, limit int) ([]RequestLogEntry, error) {
+	if limit <= 0 || limit > MaxRequestLogEntries {
+		limit = MaxRequestLogEntries
+	}
+
+	items, err := l.cache.Redis.LRange(ctx, requestLogKey(keyID), 0, int64(limit-1)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request log: %w", err)
+	}
+
+	entries := make([]RequestLogEntry, 0, len(items))
+	for _, item := range items {
+		var entry RequestLogEntry
+		if err := json.Unmarshal([]byte(item), &entry); err != nil {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// Clear deletes a key's request log
+func (l *RequestLog) Clear(ctx context.Context, keyID int) error {
+	return l.cache.Delete(ctx, requestLogKey(keyID))
+}
+
+func requestLogKey(keyID int) string {
+	return fmt.Sprintf("apikey:requests:%d", keyID)
+}
@@ -0,0 +1,76 @@
+package apikey
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jordanlanch/industrydb/pkg/cache"
+)
+
+func setupRequestLog(t *testing.T) (*RequestLog, *miniredis.Miniredis) {
+	mr := miniredis.RunT(t)
+	client, err := cache.NewClient("redis://" + mr.Addr())
+	require.NoError(t, err)
+	t.Cleanup(func() { client.Close() })
+	return NewRequestLog(client), mr
+}
+
+func TestRequestLog_RecordAndList(t *testing.T) {
+	log, mr := setupRequestLog(t)
+	ctx := context.Background()
+	start := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, log.Record(ctx, 1, RequestLogEntry{
+			At:        start.Add(time.Duration(i) * time.Second),
+			Method:    "GET",
+			Route:     "/api/v1/integrations/zapier/new-leads",
+			Status:    200 + i,
+			LatencyMs: int64(10 * i),
+		}))
+	}
+	require.NoError(t, log.Record(ctx, 2, RequestLogEntry{At: start, Method: "GET", Route: "/other", Status: 200}))
+
+	entries, err := log.List(ctx, 1, 10)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	assert.Equal(t, 202, entries[0].Status, "newest first")
+	assert.Equal(t, int64(20), entries[0].LatencyMs)
+	assert.Equal(t, start, entries[2].At)
+
+	entries, err = log.List(ctx, 1, 2)
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+
+	assert.Equal(t, RequestLogRetention, mr.TTL(requestLogKey(1)))
+
+	require.NoError(t, log.Clear(ctx, 1))
+	entries, err = log.List(ctx, 1, 10)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	// Other keys are untouched
+	entries, err = log.List(ctx, 2, 10)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestRequestLog_KeepsNewestEntries(t *testing.T) {
+	log, _ := setupRequestLog(t)
+	ctx := context.Background()
+
+	for i := 0; i < MaxRequestLogEntries+25; i++ {
+		require.NoError(t, log.Record(ctx, 1, RequestLogEntry{Method: "GET", Route: "/r", Status: i}))
+	}
+
+	entries, err := log.List(ctx, 1, 0)
+	require.NoError(t, err)
+	require.Len(t, entries, MaxRequestLogEntries)
+	assert.Equal(t, MaxRequestLogEntries+24, entries[0].Status)
+	assert.Equal(t, 25, entries[MaxRequestLogEntries-1].Status)
+}
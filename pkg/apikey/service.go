@@ -12,6 +12,7 @@ import (
 	"github.com/jordanlanch/industrydb/ent"
 	"github.com/jordanlanch/industrydb/ent/apikey"
 	"github.com/jordanlanch/industrydb/ent/user"
+	"github.com/jordanlanch/industrydb/ent/zapierdelivery"
 )
 
 // Service handles API key business logic
@@ -102,20 +103,30 @@ func (s *Service) CreateAPIKey(ctx context.Context, userID int, req CreateAPIKey
 	}, nil
 }
 
-// ListAPIKeys returns all API keys for a user (excluding hashes)
-func (s *Service) ListAPIKeys(ctx context.Context, userID int) ([]*ent.APIKey, error) {
+// ListAPIKeys returns the API keys of a user (excluding hashes), newest
+// first, with their total. Up to limit keys after offset are loaded; a
+// limit of 0 loads every key.
+func (s *Service) ListAPIKeys(ctx context.Context, userID, limit, offset int) ([]*ent.APIKey, int, error) {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	keys, err := s.db.APIKey.Query().
-		Where(apikey.UserIDEQ(userID)).
-		Order(ent.Desc(apikey.FieldCreatedAt)).
-		All(ctx)
+	query := s.db.APIKey.Query().Where(apikey.UserIDEQ(userID))
+
+	total, err := query.Clone().Count(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list API keys: %w", err)
+		return nil, 0, fmt.Errorf("failed to count API keys: %w", err)
 	}
 
-	return keys, nil
+	query = query.Order(ent.Desc(apikey.FieldCreatedAt), ent.Desc(apikey.FieldID)).Offset(offset)
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	keys, err := query.All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list API keys: %w", err)
+	}
+
+	return keys, total, nil
 }
 
 // GetAPIKey retrieves a single API key by ID
@@ -173,6 +184,13 @@ func (s *Service) DeleteAPIKey(ctx context.Context, userID int, keyID int) error
 		return err
 	}
 
+	// Delete the leads it was charged for first, they reference the key
+	if _, err := s.db.ZapierDelivery.Delete().
+		Where(zapierdelivery.APIKeyID(key.ID)).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete API key deliveries: %w", err)
+	}
+
 	// Delete the key
 	err = s.db.APIKey.DeleteOne(key).Exec(ctx)
 	if err != nil {
@@ -184,6 +202,15 @@ func (s *Service) DeleteAPIKey(ctx context.Context, userID int, keyID int) error
 
 // ValidateAPIKey validates an API key and returns the associated user ID
 func (s *Service) ValidateAPIKey(ctx context.Context, plainKey string) (int, error) {
+	key, err := s.Authenticate(ctx, plainKey)
+	if err != nil {
+		return 0, err
+	}
+	return key.UserID, nil
+}
+
+// Authenticate validates an API key and returns it, recording its use
+func (s *Service) Authenticate(ctx context.Context, plainKey string) (*ent.APIKey, error) {
 	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
 	defer cancel()
 
@@ -200,14 +227,14 @@ func (s *Service) ValidateAPIKey(ctx context.Context, plainKey string) (int, err
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return 0, errors.New("invalid API key")
+			return nil, errors.New("invalid API key")
 		}
-		return 0, fmt.Errorf("failed to validate API key: %w", err)
+		return nil, fmt.Errorf("failed to validate API key: %w", err)
 	}
 
 	// Check if expired
 	if key.ExpiresAt != nil && key.ExpiresAt.Before(time.Now()) {
-		return 0, errors.New("API key has expired")
+		return nil, errors.New("API key has expired")
 	}
 
 	// Update last_used_at and usage_count asynchronously (don't block request)
@@ -217,11 +244,11 @@ func (s *Service) ValidateAPIKey(ctx context.Context, plainKey string) (int, err
 
 		s.db.APIKey.UpdateOne(key).
 			SetLastUsedAt(time.Now()).
-			SetUsageCount(key.UsageCount + 1).
+			AddUsageCount(1).
 			Exec(updateCtx)
 	}()
 
-	return key.UserID, nil
+	return key, nil
 }
 
 // UpdateAPIKeyName updates the name of an API key
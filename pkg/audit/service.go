@@ -2,6 +2,7 @@ package audit
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	"github.com/jordanlanch/industrydb/ent"
@@ -154,7 +155,7 @@ func (s *Service) LogUserPasswordChange(ctx context.Context, userID int, ipAddre
 func (s *Service) LogExportCreate(ctx context.Context, userID int, exportID int, metadata map[string]interface{}, ipAddress, userAgent string) error {
 	desc := "User created data export"
 	resourceType := "export"
-	resourceID := string(rune(exportID))
+	resourceID := strconv.Itoa(exportID)
 	return s.Log(ctx, LogEntry{
 		UserID:       &userID,
 		Action:       auditlog.ActionExportCreate,
@@ -244,6 +245,45 @@ func (s *Service) GetCriticalLogs(ctx context.Context, limit int) ([]*ent.AuditL
 		All(ctx)
 }
 
+// LogFilter selects audit logs for QueryLogs. Zero values don't filter.
+type LogFilter struct {
+	UserID  *int
+	Actions []auditlog.Action
+	From    time.Time // Inclusive
+	To      time.Time // Exclusive
+	AfterID int       // Only logs with a greater ID, to page through results
+	Limit   int
+}
+
+// QueryLogs retrieves the audit logs matching filter, oldest first. Pass the
+// ID of the last log returned as AfterID to get the next page.
+func (s *Service) QueryLogs(ctx context.Context, filter LogFilter) ([]*ent.AuditLog, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	query := s.db.AuditLog.Query()
+	if filter.UserID != nil {
+		query = query.Where(auditlog.UserIDEQ(*filter.UserID))
+	}
+	if len(filter.Actions) > 0 {
+		query = query.Where(auditlog.ActionIn(filter.Actions...))
+	}
+	if !filter.From.IsZero() {
+		query = query.Where(auditlog.CreatedAtGTE(filter.From))
+	}
+	if !filter.To.IsZero() {
+		query = query.Where(auditlog.CreatedAtLT(filter.To))
+	}
+	if filter.AfterID > 0 {
+		query = query.Where(auditlog.IDGT(filter.AfterID))
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	return query.Order(ent.Asc(auditlog.FieldID)).All(ctx)
+}
+
 // LogUserUpdate logs a user update event by admin
 func (s *Service) LogUserUpdate(ctx context.Context, adminID int, targetUserID int, ipAddress, userAgent string) error {
 	desc := "Admin updated user details"
@@ -287,3 +327,26 @@ func (s *Service) LogUserSuspension(ctx context.Context, adminID int, targetUser
 		Description:  &desc,
 	})
 }
+
+// LogUserActivityReport logs an admin downloading a user's activity report
+func (s *Service) LogUserActivityReport(ctx context.Context, adminID int, targetUserID int, metadata map[string]interface{}, ipAddress, userAgent string) error {
+	desc := "Admin downloaded user activity report"
+	resourceType := "user"
+	resourceID := strconv.Itoa(targetUserID)
+	if metadata == nil {
+		metadata = make(map[string]interface{})
+	}
+	metadata["admin_id"] = adminID
+	metadata["target_user_id"] = targetUserID
+	return s.Log(ctx, LogEntry{
+		UserID:       &adminID,
+		Action:       auditlog.ActionUserActivityReport,
+		ResourceType: &resourceType,
+		ResourceID:   &resourceID,
+		IPAddress:    &ipAddress,
+		UserAgent:    &userAgent,
+		Metadata:     metadata,
+		Severity:     auditlog.SeverityWarning,
+		Description:  &desc,
+	})
+}
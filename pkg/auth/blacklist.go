@@ -43,6 +43,21 @@ func (b *TokenBlacklist) IsBlacklisted(ctx context.Context, token string) (bool,
 	return b.cache.Exists(ctx, key)
 }
 
+// RevokeSession blacklists every token of a session by its token ID (jti),
+// for tokens the caller doesn't hold, e.g. a user's other devices
+func (b *TokenBlacklist) RevokeSession(ctx context.Context, sessionID string, expiration time.Duration) error {
+	key := fmt.Sprintf("jwt:revoked_session:%s", sessionID)
+
+	return b.cache.Set(ctx, key, "revoked", expiration)
+}
+
+// IsSessionRevoked checks if a session was revoked
+func (b *TokenBlacklist) IsSessionRevoked(ctx context.Context, sessionID string) (bool, error) {
+	key := fmt.Sprintf("jwt:revoked_session:%s", sessionID)
+
+	return b.cache.Exists(ctx, key)
+}
+
 // hashToken creates a SHA256 hash of the token
 func (b *TokenBlacklist) hashToken(token string) string {
 	hash := sha256.Sum256([]byte(token))
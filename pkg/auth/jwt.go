@@ -2,6 +2,8 @@ package auth
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"time"
 
@@ -16,13 +18,20 @@ type Claims struct {
 	jwt.RegisteredClaims
 }
 
-// GenerateJWT generates a new JWT token
+// GenerateJWT generates a new JWT token. Each token gets a unique ID (jti)
+// identifying its session, see SessionStore.
 func GenerateJWT(userID int, email, tier, secret string, expirationHours int) (string, error) {
+	tokenID, err := newTokenID()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate token ID: %w", err)
+	}
+
 	claims := &Claims{
 		UserID: userID,
 		Email:  email,
 		Tier:   tier,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        tokenID,
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour * time.Duration(expirationHours))),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			NotBefore: jwt.NewNumericDate(time.Now()),
@@ -33,6 +42,15 @@ func GenerateJWT(userID int, email, tier, secret string, expirationHours int) (s
 	return token.SignedString([]byte(secret))
 }
 
+// newTokenID generates a random token ID
+func newTokenID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
 // ValidateJWT validates a JWT token and returns the claims
 func ValidateJWT(tokenString, secret string) (*Claims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
@@ -71,6 +89,17 @@ func ValidateJWTWithBlacklist(ctx context.Context, tokenString, secret string, b
 		if isBlacklisted {
 			return nil, fmt.Errorf("token has been revoked")
 		}
+
+		// Tokens issued before session tracking have no ID
+		if claims.ID != "" {
+			revoked, err := blacklist.IsSessionRevoked(ctx, claims.ID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to check blacklist: %w", err)
+			}
+			if revoked {
+				return nil, fmt.Errorf("session has been revoked")
+			}
+		}
 	}
 
 	return claims, nil
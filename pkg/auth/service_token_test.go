@@ -0,0 +1,57 @@
+package auth
+
+import (
+	"testing"
+	"time"
+)
+
+func TestServiceToken(t *testing.T) {
+	secret := "internal-service-secret-minimum-32-chars"
+
+	token, err := GenerateServiceToken("export-worker", secret, time.Hour)
+	if err != nil {
+		t.Fatalf("Failed to generate service token: %v", err)
+	}
+
+	claims, err := ValidateServiceToken(token, secret)
+	if err != nil {
+		t.Fatalf("Failed to validate service token: %v", err)
+	}
+	if claims.Service != "export-worker" {
+		t.Errorf("Expected service export-worker, got %s", claims.Service)
+	}
+
+	if _, err := ValidateServiceToken(token, "another-secret-minimum-32-characters"); err == nil {
+		t.Error("Token signed with another secret should be rejected")
+	}
+
+	expired, err := GenerateServiceToken("export-worker", secret, -time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to generate service token: %v", err)
+	}
+	if _, err := ValidateServiceToken(expired, secret); err == nil {
+		t.Error("Expired token should be rejected")
+	}
+}
+
+func TestServiceToken_UserJWTRejected(t *testing.T) {
+	secret := "shared-secret-minimum-32-characters-long"
+
+	// A user JWT lacks the internal audience, even if signed with the same secret
+	userToken, err := GenerateJWT(1, "test@example.com", "business", secret, 24)
+	if err != nil {
+		t.Fatalf("Failed to generate JWT: %v", err)
+	}
+	if _, err := ValidateServiceToken(userToken, secret); err == nil {
+		t.Error("User JWT should not be accepted as a service token")
+	}
+}
+
+func TestServiceToken_Disabled(t *testing.T) {
+	if _, err := GenerateServiceToken("export-worker", "", time.Hour); err != ErrServiceTokensDisabled {
+		t.Errorf("Expected ErrServiceTokensDisabled, got %v", err)
+	}
+	if _, err := ValidateServiceToken("token", ""); err != ErrServiceTokensDisabled {
+		t.Errorf("Expected ErrServiceTokensDisabled, got %v", err)
+	}
+}
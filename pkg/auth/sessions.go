@@ -0,0 +1,191 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jordanlanch/industrydb/pkg/cache"
+	"github.com/redis/go-redis/v9"
+)
+
+// ErrSessionNotFound is returned when a session doesn't exist or has expired
+var ErrSessionNotFound = errors.New("session not found")
+
+// Session is a signed-in device, one per issued JWT
+type Session struct {
+	ID        string    `json:"id"` // Token ID (jti)
+	IssuedAt  time.Time `json:"issued_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+	UserAgent string    `json:"user_agent,omitempty"`
+	IPAddress string    `json:"ip_address,omitempty"`
+	Current   bool      `json:"current"` // Set when listing, for the requesting token
+}
+
+// SessionStore tracks users' active sessions in Redis, one hash per user
+// keyed by token ID, so they can be listed and revoked. Revoked sessions are
+// added to the token blacklist and rejected by ValidateJWTWithBlacklist.
+type SessionStore struct {
+	cache     *cache.Client
+	blacklist *TokenBlacklist
+}
+
+// NewSessionStore creates a new session store
+func NewSessionStore(cache *cache.Client, blacklist *TokenBlacklist) *SessionStore {
+	return &SessionStore{
+		cache:     cache,
+		blacklist: blacklist,
+	}
+}
+
+// Track records a newly issued token as an active session of its user
+func (s *SessionStore) Track(ctx context.Context, token, userAgent, ipAddress string) error {
+	// The token was just issued by us, so its signature needn't be checked
+	claims := &Claims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(token, claims); err != nil {
+		return fmt.Errorf("failed to parse token: %w", err)
+	}
+	if claims.ID == "" || claims.ExpiresAt == nil || claims.IssuedAt == nil {
+		return fmt.Errorf("token has no ID or expiry")
+	}
+	if !claims.ExpiresAt.After(time.Now()) {
+		return fmt.Errorf("token has expired")
+	}
+
+	session := Session{
+		ID:        claims.ID,
+		IssuedAt:  claims.IssuedAt.Time,
+		ExpiresAt: claims.ExpiresAt.Time,
+		UserAgent: userAgent,
+		IPAddress: ipAddress,
+	}
+	data, err := json.Marshal(session)
+	if err != nil {
+		return fmt.Errorf("failed to encode session: %w", err)
+	}
+
+	key := sessionsKey(claims.UserID)
+	if err := s.cache.Redis.HSet(ctx, key, session.ID, data).Err(); err != nil {
+		return fmt.Errorf("failed to store session: %w", err)
+	}
+
+	// Every token has the same lifetime, so the newest outlives the rest
+	return s.cache.Expire(ctx, key, time.Until(session.ExpiresAt))
+}
+
+// IssueToken generates a JWT for a user signing in and, with a session
+// store, records it as an active session so it can be listed and revoked.
+// Every login path (REST, OAuth, SAML, GraphQL) issues its tokens here.
+// Failing to track doesn't fail the login.
+func IssueToken(ctx context.Context, sessions *SessionStore, userID int, email, tier, secret string, expirationHours int, userAgent, ipAddress string) (string, error) {
+	token, err := GenerateJWT(userID, email, tier, secret, expirationHours)
+	if err != nil {
+		return "", err
+	}
+	if sessions == nil {
+		return token, nil
+	}
+
+	trackCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	if err := sessions.Track(trackCtx, token, userAgent, ipAddress); err != nil {
+		log.Printf("⚠️  Failed to track session: %v", err)
+	}
+	return token, nil
+}
+
+// List returns a user's active sessions, newest first. The session with
+// currentID, the requesting token's ID, is marked current.
+func (s *SessionStore) List(ctx context.Context, userID int, currentID string) ([]Session, error) {
+	key := sessionsKey(userID)
+	entries, err := s.cache.Redis.HGetAll(ctx, key).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	now := time.Now()
+	sessions := make([]Session, 0, len(entries))
+	var stale []string
+	for id, data := range entries {
+		var session Session
+		if err := json.Unmarshal([]byte(data), &session); err != nil || !session.ExpiresAt.After(now) {
+			stale = append(stale, id)
+			continue
+		}
+		session.Current = session.ID == currentID
+		sessions = append(sessions, session)
+	}
+
+	if len(stale) > 0 {
+		s.cache.Redis.HDel(ctx, key, stale...)
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].IssuedAt.After(sessions[j].IssuedAt)
+	})
+	return sessions, nil
+}
+
+// Revoke revokes one of a user's sessions
+func (s *SessionStore) Revoke(ctx context.Context, userID int, sessionID string) error {
+	data, err := s.cache.Redis.HGet(ctx, sessionsKey(userID), sessionID).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return ErrSessionNotFound
+		}
+		return fmt.Errorf("failed to get session: %w", err)
+	}
+
+	var session Session
+	if err := json.Unmarshal([]byte(data), &session); err != nil {
+		return fmt.Errorf("failed to decode session: %w", err)
+	}
+	if !session.ExpiresAt.After(time.Now()) {
+		s.cache.Redis.HDel(ctx, sessionsKey(userID), sessionID)
+		return ErrSessionNotFound
+	}
+
+	return s.revoke(ctx, userID, session)
+}
+
+// RevokeAll revokes all of a user's sessions except keepID, if set, and
+// returns how many were revoked
+func (s *SessionStore) RevokeAll(ctx context.Context, userID int, keepID string) (int, error) {
+	sessions, err := s.List(ctx, userID, keepID)
+	if err != nil {
+		return 0, err
+	}
+
+	revoked := 0
+	for _, session := range sessions {
+		if session.Current {
+			continue
+		}
+		if err := s.revoke(ctx, userID, session); err != nil {
+			return revoked, err
+		}
+		revoked++
+	}
+	return revoked, nil
+}
+
+// revoke blacklists a session until its tokens expire and stops tracking it
+func (s *SessionStore) revoke(ctx context.Context, userID int, session Session) error {
+	if err := s.blacklist.RevokeSession(ctx, session.ID, time.Until(session.ExpiresAt)); err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+	if err := s.cache.Redis.HDel(ctx, sessionsKey(userID), session.ID).Err(); err != nil {
+		return fmt.Errorf("failed to remove session: %w", err)
+	}
+	return nil
+}
+
+// sessionsKey is the Redis hash holding a user's sessions
+func sessionsKey(userID int) string {
+	return fmt.Sprintf("sessions:user:%d", userID)
+}
@@ -0,0 +1,173 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSecret = "test-secret-key-minimum-32-characters"
+
+func TestSessionStore_TrackAndList(t *testing.T) {
+	client, mr := setupTestRedis(t)
+	defer mr.Close()
+	defer client.Close()
+
+	store := NewSessionStore(client, NewTokenBlacklist(client))
+	ctx := context.Background()
+
+	first, err := GenerateJWT(1, "user@example.com", "free", testSecret, 24)
+	require.NoError(t, err)
+	require.NoError(t, store.Track(ctx, first, "Firefox", "10.0.0.1"))
+	second, err := GenerateJWT(1, "user@example.com", "free", testSecret, 24)
+	require.NoError(t, err)
+	require.NoError(t, store.Track(ctx, second, "Safari", "10.0.0.2"))
+
+	// Another user's session isn't listed
+	other, err := GenerateJWT(2, "other@example.com", "free", testSecret, 24)
+	require.NoError(t, err)
+	require.NoError(t, store.Track(ctx, other, "Chrome", "10.0.0.3"))
+
+	claims, err := ValidateJWT(second, testSecret)
+	require.NoError(t, err)
+
+	sessions, err := store.List(ctx, 1, claims.ID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+
+	var current []string
+	for _, s := range sessions {
+		assert.NotEmpty(t, s.ID)
+		assert.True(t, s.ExpiresAt.After(time.Now()))
+		if s.Current {
+			current = append(current, s.UserAgent)
+		}
+	}
+	assert.Equal(t, []string{"Safari"}, current)
+}
+
+func TestIssueToken(t *testing.T) {
+	client, mr := setupTestRedis(t)
+	defer mr.Close()
+	defer client.Close()
+
+	store := NewSessionStore(client, NewTokenBlacklist(client))
+	ctx := context.Background()
+
+	token, err := IssueToken(ctx, store, 1, "user@example.com", "pro", testSecret, 24, "Firefox", "10.0.0.1")
+	require.NoError(t, err)
+	claims, err := ValidateJWT(token, testSecret)
+	require.NoError(t, err)
+	assert.Equal(t, "pro", claims.Tier)
+
+	sessions, err := store.List(ctx, 1, claims.ID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.True(t, sessions[0].Current)
+	assert.Equal(t, "Firefox", sessions[0].UserAgent)
+
+	// Without a store the token is only generated
+	token, err = IssueToken(ctx, nil, 1, "user@example.com", "pro", testSecret, 24, "", "")
+	require.NoError(t, err)
+	assert.NotEmpty(t, token)
+}
+
+func TestSessionStore_Revoke(t *testing.T) {
+	client, mr := setupTestRedis(t)
+	defer mr.Close()
+	defer client.Close()
+
+	blacklist := NewTokenBlacklist(client)
+	store := NewSessionStore(client, blacklist)
+	ctx := context.Background()
+
+	token, err := GenerateJWT(1, "user@example.com", "free", testSecret, 24)
+	require.NoError(t, err)
+	require.NoError(t, store.Track(ctx, token, "Firefox", "10.0.0.1"))
+	claims, err := ValidateJWT(token, testSecret)
+	require.NoError(t, err)
+
+	// Other users can't revoke it
+	assert.ErrorIs(t, store.Revoke(ctx, 2, claims.ID), ErrSessionNotFound)
+
+	require.NoError(t, store.Revoke(ctx, 1, claims.ID))
+
+	_, err = ValidateJWTWithBlacklist(ctx, token, testSecret, blacklist)
+	assert.Error(t, err, "revoked session should be rejected")
+
+	sessions, err := store.List(ctx, 1, "")
+	require.NoError(t, err)
+	assert.Empty(t, sessions)
+
+	assert.ErrorIs(t, store.Revoke(ctx, 1, claims.ID), ErrSessionNotFound)
+}
+
+func TestSessionStore_RevokeAll(t *testing.T) {
+	client, mr := setupTestRedis(t)
+	defer mr.Close()
+	defer client.Close()
+
+	blacklist := NewTokenBlacklist(client)
+	store := NewSessionStore(client, blacklist)
+	ctx := context.Background()
+
+	var tokens []string
+	for i := 0; i < 3; i++ {
+		token, err := GenerateJWT(1, "user@example.com", "free", testSecret, 24)
+		require.NoError(t, err)
+		require.NoError(t, store.Track(ctx, token, "Firefox", "10.0.0.1"))
+		tokens = append(tokens, token)
+	}
+	current, err := ValidateJWT(tokens[0], testSecret)
+	require.NoError(t, err)
+
+	t.Run("Keeping the current session", func(t *testing.T) {
+		revoked, err := store.RevokeAll(ctx, 1, current.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 2, revoked)
+
+		_, err = ValidateJWTWithBlacklist(ctx, tokens[0], testSecret, blacklist)
+		assert.NoError(t, err, "current session should stay signed in")
+		for _, token := range tokens[1:] {
+			_, err = ValidateJWTWithBlacklist(ctx, token, testSecret, blacklist)
+			assert.Error(t, err)
+		}
+	})
+
+	t.Run("Everywhere", func(t *testing.T) {
+		revoked, err := store.RevokeAll(ctx, 1, "")
+		require.NoError(t, err)
+		assert.Equal(t, 1, revoked)
+
+		_, err = ValidateJWTWithBlacklist(ctx, tokens[0], testSecret, blacklist)
+		assert.Error(t, err)
+	})
+}
+
+func TestSessionStore_ExpiredSessionsAreDropped(t *testing.T) {
+	client, mr := setupTestRedis(t)
+	defer mr.Close()
+	defer client.Close()
+
+	store := NewSessionStore(client, NewTokenBlacklist(client))
+	ctx := context.Background()
+
+	active, err := GenerateJWT(1, "user@example.com", "free", testSecret, 24)
+	require.NoError(t, err)
+	require.NoError(t, store.Track(ctx, active, "Safari", "10.0.0.2"))
+
+	expired, err := json.Marshal(Session{ID: "expired", ExpiresAt: time.Now().Add(-time.Minute), UserAgent: "Firefox"})
+	require.NoError(t, err)
+	require.NoError(t, client.Redis.HSet(ctx, sessionsKey(1), "expired", expired).Err())
+
+	sessions, err := store.List(ctx, 1, "")
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, "Safari", sessions[0].UserAgent)
+
+	assert.ErrorIs(t, store.Revoke(ctx, 1, "expired"), ErrSessionNotFound)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"strconv"
 	"time"
 
 	"github.com/jordanlanch/industrydb/ent"
@@ -13,6 +14,7 @@ import (
 	"github.com/jordanlanch/industrydb/ent/user"
 	"github.com/jordanlanch/industrydb/pkg/leads"
 	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/jordanlanch/industrydb/pkg/notification"
 	"github.com/stripe/stripe-go/v76"
 	billingportalsession "github.com/stripe/stripe-go/v76/billingportal/session"
 	checkoutsession "github.com/stripe/stripe-go/v76/checkout/session"
@@ -36,6 +38,11 @@ type OrgMembershipChecker interface {
 	CheckMembership(ctx context.Context, orgID int, userID int) (isMember bool, role string, err error)
 }
 
+// PreferenceChecker reports whether a user wants a notification on a channel.
+type PreferenceChecker interface {
+	Allows(ctx context.Context, userID int, eventType, channel string) bool
+}
+
 // IdempotencyStore checks and marks webhook events as processed.
 type IdempotencyStore interface {
 	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error
@@ -51,6 +58,7 @@ type Service struct {
 	audit       AuditLogger
 	orgChecker  OrgMembershipChecker
 	idempotency IdempotencyStore
+	preferences PreferenceChecker
 }
 
 // StripeConfig holds Stripe configuration
@@ -97,6 +105,20 @@ func (s *Service) SetIdempotencyStore(store IdempotencyStore) {
 	s.idempotency = store
 }
 
+// SetNotificationPreferences sets the preferences consulted before sending
+// billing emails.
+func (s *Service) SetNotificationPreferences(p PreferenceChecker) {
+	s.preferences = p
+}
+
+// sendsBillingEmail reports whether billing emails should go to the user.
+func (s *Service) sendsBillingEmail(ctx context.Context, userID int) bool {
+	if s.email == nil {
+		return false
+	}
+	return s.preferences == nil || s.preferences.Allows(ctx, userID, notification.EventBilling, notification.ChannelEmail)
+}
+
 // checkOrgBillingAccess verifies a user has owner or admin role for billing management.
 func (s *Service) checkOrgBillingAccess(orgID int, userID int, role string) error {
 	if role == "owner" || role == "admin" {
@@ -366,9 +388,9 @@ func (s *Service) handleCheckoutCompleted(ctx context.Context, event stripe.Even
 		log.Printf("✅ Organization %s upgraded to %s tier with %d leads/month", org.Name, tier, limit)
 
 		// Create subscription record associated with organization
-		// Note: Subscription schema may need organization_id field
 		_, err = s.db.Subscription.Create().
 			SetUserID(userID). // Keep user ID for tracking
+			SetOrganizationID(orgID).
 			SetTier(subscription.Tier(tier)).
 			SetStatus(subscription.StatusActive).
 			SetStripeSubscriptionID(sess.Subscription.ID).
@@ -465,7 +487,7 @@ func (s *Service) handleSubscriptionUpdated(ctx context.Context, event stripe.Ev
 	}
 
 	// Send email notification based on status change
-	if s.email != nil {
+	if s.sendsBillingEmail(ctx, entSub.UserID) {
 		u, err := s.db.User.Get(ctx, entSub.UserID)
 		if err != nil {
 			log.Printf("⚠️  Failed to get user for email notification: %v", err)
@@ -521,7 +543,7 @@ func (s *Service) handleSubscriptionDeleted(ctx context.Context, event stripe.Ev
 	// Downgrade user to free tier
 	u, err := s.db.User.UpdateOneID(entSub.UserID).
 		SetSubscriptionTier(user.SubscriptionTierFree).
-		SetUsageLimit(50).
+		SetUsageLimit(s.getUsageLimitForTier(string(user.SubscriptionTierFree))).
 		Save(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to downgrade user: %w", err)
@@ -538,7 +560,7 @@ func (s *Service) handleSubscriptionDeleted(ctx context.Context, event stripe.Ev
 			for _, org := range orgs {
 				_, err := s.db.Organization.UpdateOneID(org.ID).
 					SetSubscriptionTier(organization.SubscriptionTierFree).
-					SetUsageLimit(50).
+					SetUsageLimit(s.getUsageLimitForTier(string(organization.SubscriptionTierFree))).
 					Save(ctx)
 				if err != nil {
 					log.Printf("⚠️  Failed to downgrade organization %d: %v", org.ID, err)
@@ -550,7 +572,7 @@ func (s *Service) handleSubscriptionDeleted(ctx context.Context, event stripe.Ev
 	}
 
 	// Send cancellation email notification
-	if s.email != nil {
+	if s.sendsBillingEmail(ctx, u.ID) {
 		subject, html, plain := buildSubscriptionCancelledEmail(u.Name, s.config.BaseURL)
 		if err := s.email.SendEmail(u.Email, u.Name, subject, html, plain); err != nil {
 			log.Printf("⚠️  Failed to send cancellation email to %s: %v", u.Email, err)
@@ -576,7 +598,7 @@ func (s *Service) handleInvoicePaid(ctx context.Context, event stripe.Event) err
 			Only(ctx)
 		if err == nil {
 			u, err := s.db.User.Get(ctx, entSub.UserID)
-			if err == nil {
+			if err == nil && s.sendsBillingEmail(ctx, u.ID) {
 				nextBilling := time.Unix(invoice.PeriodEnd, 0).Format("2006-01-02")
 				subject, html, plain := buildSubscriptionRenewedEmail(u.Name, string(entSub.Tier), nextBilling, s.config.BaseURL)
 				if err := s.email.SendEmail(u.Email, u.Name, subject, html, plain); err != nil {
@@ -633,7 +655,7 @@ func (s *Service) handleInvoicePaymentFailed(ctx context.Context, event stripe.E
 	}
 
 	// Send payment failed email notification
-	if s.email != nil {
+	if s.sendsBillingEmail(ctx, u.ID) {
 		subject, html, plain := buildPaymentFailedEmail(u.Name, s.config.BaseURL)
 		if err := s.email.SendEmail(u.Email, u.Name, subject, html, plain); err != nil {
 			log.Printf("⚠️  Failed to send payment failed email to %s: %v", u.Email, err)
@@ -670,19 +692,9 @@ func (s *Service) getPriceIDForTier(tier string) (string, error) {
 }
 
 // getUsageLimitForTier returns the usage limit for a subscription tier
+// from the configured tier limit table
 func (s *Service) getUsageLimitForTier(tier string) int {
-	switch tier {
-	case "free":
-		return 50
-	case "starter":
-		return 500
-	case "pro":
-		return 2000
-	case "business":
-		return 10000
-	default:
-		return 50 // Default to free tier limit
-	}
+	return leads.GetUsageLimitForTier(tier)
 }
 
 // GetPricing returns pricing information for all tiers
@@ -692,10 +704,10 @@ func (s *Service) GetPricing() *models.PricingResponse {
 			{
 				Name:        "free",
 				Price:       0,
-				LeadsLimit:  50,
+				LeadsLimit:  s.getUsageLimitForTier("free"),
 				Description: "Perfect for trying out the platform",
 				Features: []string{
-					"50 leads per month",
+					leadsPerMonth(s.getUsageLimitForTier("free")),
 					"Basic data fields",
 					"CSV export",
 				},
@@ -703,10 +715,10 @@ func (s *Service) GetPricing() *models.PricingResponse {
 			{
 				Name:        "starter",
 				Price:       49,
-				LeadsLimit:  500,
+				LeadsLimit:  s.getUsageLimitForTier("starter"),
 				Description: "Great for small businesses",
 				Features: []string{
-					"500 leads per month",
+					leadsPerMonth(s.getUsageLimitForTier("starter")),
 					"Phone & Address included",
 					"CSV & Excel export",
 					"Email support",
@@ -715,10 +727,10 @@ func (s *Service) GetPricing() *models.PricingResponse {
 			{
 				Name:        "pro",
 				Price:       149,
-				LeadsLimit:  2000,
+				LeadsLimit:  s.getUsageLimitForTier("pro"),
 				Description: "For growing businesses",
 				Features: []string{
-					"2,000 leads per month",
+					leadsPerMonth(s.getUsageLimitForTier("pro")),
 					"Email & Social media included",
 					"Priority export",
 					"Priority support",
@@ -727,10 +739,10 @@ func (s *Service) GetPricing() *models.PricingResponse {
 			{
 				Name:        "business",
 				Price:       349,
-				LeadsLimit:  10000,
+				LeadsLimit:  s.getUsageLimitForTier("business"),
 				Description: "For large organizations",
 				Features: []string{
-					"10,000 leads per month",
+					leadsPerMonth(s.getUsageLimitForTier("business")),
 					"Full data access",
 					"API access",
 					"Dedicated support",
@@ -741,6 +753,16 @@ func (s *Service) GetPricing() *models.PricingResponse {
 	}
 }
 
+// leadsPerMonth formats a tier's usage limit as a pricing feature line
+// (e.g., "2,000 leads per month")
+func leadsPerMonth(limit int) string {
+	digits := strconv.Itoa(limit)
+	for i := len(digits) - 3; i > 0; i -= 3 {
+		digits = digits[:i] + "," + digits[i:]
+	}
+	return digits + " leads per month"
+}
+
 // CancelUserSubscriptions cancels all active Stripe subscriptions for a user
 // This is called when a user deletes their account (GDPR compliance)
 func (s *Service) CancelUserSubscriptions(ctx context.Context, userID int) error {
@@ -215,6 +215,29 @@ func (m *mockOrgChecker) CheckMembership(ctx context.Context, orgID int, userID
 	return m.isMember, m.role, m.err
 }
 
+type mockPreferences struct {
+	allowed bool
+}
+
+func (m *mockPreferences) Allows(ctx context.Context, userID int, eventType, channel string) bool {
+	return m.allowed
+}
+
+func TestSendsBillingEmail(t *testing.T) {
+	ctx := context.Background()
+	s := &Service{}
+	assert.False(t, s.sendsBillingEmail(ctx, 1), "no email sender")
+
+	s.SetEmailSender(&mockEmailSender{})
+	assert.True(t, s.sendsBillingEmail(ctx, 1), "no preferences set")
+
+	s.SetNotificationPreferences(&mockPreferences{allowed: false})
+	assert.False(t, s.sendsBillingEmail(ctx, 1))
+
+	s.SetNotificationPreferences(&mockPreferences{allowed: true})
+	assert.True(t, s.sendsBillingEmail(ctx, 1))
+}
+
 // =============================================================================
 // Email Template Content Validation
 // =============================================================================
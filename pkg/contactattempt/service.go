@@ -0,0 +1,200 @@
+package contactattempt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/contactattempt"
+	"github.com/jordanlanch/industrydb/ent/lead"
+)
+
+// ErrLeadNotFound is returned when logging an attempt on a missing lead.
+var ErrLeadNotFound = errors.New("lead not found")
+
+// ErrFutureAttempt is returned when attempted_at is in the future.
+var ErrFutureAttempt = errors.New("attempted_at cannot be in the future")
+
+// Service handles contact attempt operations.
+type Service struct {
+	client *ent.Client
+}
+
+// NewService creates a new contact attempt service.
+func NewService(client *ent.Client) *Service {
+	return &Service{
+		client: client,
+	}
+}
+
+// CreateAttemptRequest represents a request to log a contact attempt.
+type CreateAttemptRequest struct {
+	Type        string     `json:"type" validate:"required,oneof=call email sms"`
+	Outcome     string     `json:"outcome" validate:"required,oneof=no_answer left_voicemail busy wrong_number bounced opted_out replied connected"`
+	AttemptedAt *time.Time `json:"attempted_at,omitempty"` // Defaults to now
+}
+
+// AttemptResponse represents a contact attempt response.
+type AttemptResponse struct {
+	ID          int       `json:"id"`
+	LeadID      int       `json:"lead_id"`
+	UserID      int       `json:"user_id"`
+	Type        string    `json:"type"`
+	Outcome     string    `json:"outcome"`
+	Connected   bool      `json:"connected"`
+	AttemptedAt time.Time `json:"attempted_at"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// ChannelStats holds attempt counts for one outreach channel.
+type ChannelStats struct {
+	Attempts    int     `json:"attempts"`
+	Connects    int     `json:"connects"`
+	ConnectRate float64 `json:"connect_rate"` // Percentage of attempts that connected
+}
+
+// Stats aggregates a user's contact attempts.
+type Stats struct {
+	TotalAttempts  int                     `json:"total_attempts"`
+	TotalConnects  int                     `json:"total_connects"`
+	ConnectRate    float64                 `json:"connect_rate"` // Percentage of attempts that connected
+	LeadsAttempted int                     `json:"leads_attempted"`
+	LeadsConnected int                     `json:"leads_connected"`
+	ByType         map[string]ChannelStats `json:"by_type"`
+	ByOutcome      map[string]int          `json:"by_outcome"`
+	PeriodDays     int                     `json:"period_days"`
+}
+
+// IsConnect reports whether an outcome means the lead was reached.
+func IsConnect(outcome contactattempt.Outcome) bool {
+	return outcome == contactattempt.OutcomeConnected || outcome == contactattempt.OutcomeReplied
+}
+
+// CreateAttempt logs a contact attempt on a lead.
+func (s *Service) CreateAttempt(ctx context.Context, userID, leadID int, req CreateAttemptRequest) (*AttemptResponse, error) {
+	attemptedAt := time.Now()
+	if req.AttemptedAt != nil {
+		// Allow small clock skew between client and server
+		if req.AttemptedAt.After(attemptedAt.Add(5 * time.Minute)) {
+			return nil, ErrFutureAttempt
+		}
+		attemptedAt = *req.AttemptedAt
+	}
+
+	exists, err := s.client.Lead.Query().Where(lead.ID(leadID)).Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check lead: %w", err)
+	}
+	if !exists {
+		return nil, ErrLeadNotFound
+	}
+
+	attempt, err := s.client.ContactAttempt.
+		Create().
+		SetLeadID(leadID).
+		SetUserID(userID).
+		SetType(contactattempt.Type(req.Type)).
+		SetOutcome(contactattempt.Outcome(req.Outcome)).
+		SetAttemptedAt(attemptedAt).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create contact attempt: %w", err)
+	}
+
+	return toResponse(attempt), nil
+}
+
+// ListAttemptsByLead returns the user's attempts on a lead, newest first.
+func (s *Service) ListAttemptsByLead(ctx context.Context, userID, leadID int) ([]*AttemptResponse, error) {
+	attempts, err := s.client.ContactAttempt.
+		Query().
+		Where(
+			contactattempt.LeadID(leadID),
+			contactattempt.UserID(userID),
+		).
+		Order(ent.Desc(contactattempt.FieldAttemptedAt), ent.Desc(contactattempt.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list contact attempts: %w", err)
+	}
+
+	responses := make([]*AttemptResponse, len(attempts))
+	for i, attempt := range attempts {
+		responses[i] = toResponse(attempt)
+	}
+
+	return responses, nil
+}
+
+// GetStats aggregates the user's contact attempts over the last days.
+func (s *Service) GetStats(ctx context.Context, userID, days int) (*Stats, error) {
+	attempts, err := s.client.ContactAttempt.
+		Query().
+		Where(
+			contactattempt.UserID(userID),
+			contactattempt.AttemptedAtGTE(time.Now().AddDate(0, 0, -days)),
+		).
+		Select(contactattempt.FieldLeadID, contactattempt.FieldType, contactattempt.FieldOutcome).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load contact attempts: %w", err)
+	}
+
+	stats := &Stats{
+		ByType:     make(map[string]ChannelStats),
+		ByOutcome:  make(map[string]int),
+		PeriodDays: days,
+	}
+	attempted := make(map[int]bool)
+	connected := make(map[int]bool)
+
+	for _, attempt := range attempts {
+		channel := stats.ByType[string(attempt.Type)]
+		channel.Attempts++
+		stats.TotalAttempts++
+		stats.ByOutcome[string(attempt.Outcome)]++
+		attempted[attempt.LeadID] = true
+
+		if IsConnect(attempt.Outcome) {
+			channel.Connects++
+			stats.TotalConnects++
+			connected[attempt.LeadID] = true
+		}
+		stats.ByType[string(attempt.Type)] = channel
+	}
+
+	for channelType, channel := range stats.ByType {
+		channel.ConnectRate = percentage(channel.Connects, channel.Attempts)
+		stats.ByType[channelType] = channel
+	}
+	stats.ConnectRate = percentage(stats.TotalConnects, stats.TotalAttempts)
+	stats.LeadsAttempted = len(attempted)
+	stats.LeadsConnected = len(connected)
+
+	return stats, nil
+}
+
+// toResponse converts an attempt entity to its API response.
+func toResponse(attempt *ent.ContactAttempt) *AttemptResponse {
+	return &AttemptResponse{
+		ID:          attempt.ID,
+		LeadID:      attempt.LeadID,
+		UserID:      attempt.UserID,
+		Type:        string(attempt.Type),
+		Outcome:     string(attempt.Outcome),
+		Connected:   IsConnect(attempt.Outcome),
+		AttemptedAt: attempt.AttemptedAt,
+		CreatedAt:   attempt.CreatedAt,
+	}
+}
+
+// percentage returns part/total as a percentage rounded to 2 decimals.
+func percentage(part, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return math.Round(float64(part)/float64(total)*10000) / 100
+}
@@ -0,0 +1,169 @@
+package contactattempt
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestDB(t *testing.T) (*ent.Client, func()) {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	return client, func() { client.Close() }
+}
+
+func createTestUser(t *testing.T, client *ent.Client, email string) *ent.User {
+	user, err := client.User.
+		Create().
+		SetEmail(email).
+		SetPasswordHash("hashed_password").
+		SetName("Test Rep").
+		Save(context.Background())
+	require.NoError(t, err)
+	return user
+}
+
+func createTestLead(t *testing.T, client *ent.Client, name string) *ent.Lead {
+	lead, err := client.Lead.
+		Create().
+		SetName(name).
+		SetIndustry("tattoo").
+		SetCountry("US").
+		SetCity("New York").
+		Save(context.Background())
+	require.NoError(t, err)
+	return lead
+}
+
+func TestCreateAttempt(t *testing.T) {
+	client, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	service := NewService(client)
+	user := createTestUser(t, client, "rep@example.com")
+	lead := createTestLead(t, client, "Ink Studio")
+
+	t.Run("Success - defaults attempted_at to now", func(t *testing.T) {
+		attempt, err := service.CreateAttempt(ctx, user.ID, lead.ID, CreateAttemptRequest{
+			Type:    "call",
+			Outcome: "left_voicemail",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, lead.ID, attempt.LeadID)
+		assert.Equal(t, user.ID, attempt.UserID)
+		assert.Equal(t, "call", attempt.Type)
+		assert.Equal(t, "left_voicemail", attempt.Outcome)
+		assert.False(t, attempt.Connected)
+		assert.WithinDuration(t, time.Now(), attempt.AttemptedAt, 5*time.Second)
+	})
+
+	t.Run("Success - backdated attempt", func(t *testing.T) {
+		yesterday := time.Now().Add(-24 * time.Hour)
+		attempt, err := service.CreateAttempt(ctx, user.ID, lead.ID, CreateAttemptRequest{
+			Type:        "email",
+			Outcome:     "replied",
+			AttemptedAt: &yesterday,
+		})
+		require.NoError(t, err)
+		assert.True(t, attempt.Connected)
+		assert.WithinDuration(t, yesterday, attempt.AttemptedAt, time.Second)
+	})
+
+	t.Run("Error - future attempt", func(t *testing.T) {
+		tomorrow := time.Now().Add(24 * time.Hour)
+		_, err := service.CreateAttempt(ctx, user.ID, lead.ID, CreateAttemptRequest{
+			Type:        "sms",
+			Outcome:     "connected",
+			AttemptedAt: &tomorrow,
+		})
+		assert.ErrorIs(t, err, ErrFutureAttempt)
+	})
+
+	t.Run("Error - lead not found", func(t *testing.T) {
+		_, err := service.CreateAttempt(ctx, user.ID, 99999, CreateAttemptRequest{
+			Type:    "call",
+			Outcome: "connected",
+		})
+		assert.ErrorIs(t, err, ErrLeadNotFound)
+	})
+}
+
+func TestListAttemptsByLead(t *testing.T) {
+	client, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	service := NewService(client)
+	rep := createTestUser(t, client, "rep@example.com")
+	other := createTestUser(t, client, "other@example.com")
+	lead := createTestLead(t, client, "Ink Studio")
+
+	older := time.Now().Add(-2 * time.Hour)
+	_, err := service.CreateAttempt(ctx, rep.ID, lead.ID, CreateAttemptRequest{Type: "call", Outcome: "no_answer", AttemptedAt: &older})
+	require.NoError(t, err)
+	_, err = service.CreateAttempt(ctx, rep.ID, lead.ID, CreateAttemptRequest{Type: "call", Outcome: "connected"})
+	require.NoError(t, err)
+	_, err = service.CreateAttempt(ctx, other.ID, lead.ID, CreateAttemptRequest{Type: "email", Outcome: "bounced"})
+	require.NoError(t, err)
+
+	attempts, err := service.ListAttemptsByLead(ctx, rep.ID, lead.ID)
+	require.NoError(t, err)
+	require.Len(t, attempts, 2) // Other users' attempts are not visible
+	assert.Equal(t, "connected", attempts[0].Outcome)
+	assert.Equal(t, "no_answer", attempts[1].Outcome)
+}
+
+func TestGetStats(t *testing.T) {
+	client, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	service := NewService(client)
+	rep := createTestUser(t, client, "rep@example.com")
+	other := createTestUser(t, client, "other@example.com")
+	leadA := createTestLead(t, client, "Studio A")
+	leadB := createTestLead(t, client, "Studio B")
+
+	longAgo := time.Now().AddDate(0, 0, -60)
+	attempts := []struct {
+		userID int
+		leadID int
+		req    CreateAttemptRequest
+	}{
+		{rep.ID, leadA.ID, CreateAttemptRequest{Type: "call", Outcome: "no_answer"}},
+		{rep.ID, leadA.ID, CreateAttemptRequest{Type: "call", Outcome: "connected"}},
+		{rep.ID, leadB.ID, CreateAttemptRequest{Type: "email", Outcome: "bounced"}},
+		{rep.ID, leadB.ID, CreateAttemptRequest{Type: "sms", Outcome: "replied"}},
+		{rep.ID, leadB.ID, CreateAttemptRequest{Type: "call", Outcome: "connected", AttemptedAt: &longAgo}}, // Outside period
+		{other.ID, leadA.ID, CreateAttemptRequest{Type: "call", Outcome: "connected"}},                      // Other user
+	}
+	for _, a := range attempts {
+		_, err := service.CreateAttempt(ctx, a.userID, a.leadID, a.req)
+		require.NoError(t, err)
+	}
+
+	stats, err := service.GetStats(ctx, rep.ID, 30)
+	require.NoError(t, err)
+
+	assert.Equal(t, 4, stats.TotalAttempts)
+	assert.Equal(t, 2, stats.TotalConnects)
+	assert.Equal(t, 50.0, stats.ConnectRate)
+	assert.Equal(t, 2, stats.LeadsAttempted)
+	assert.Equal(t, 2, stats.LeadsConnected)
+	assert.Equal(t, ChannelStats{Attempts: 2, Connects: 1, ConnectRate: 50}, stats.ByType["call"])
+	assert.Equal(t, ChannelStats{Attempts: 1, Connects: 0, ConnectRate: 0}, stats.ByType["email"])
+	assert.Equal(t, ChannelStats{Attempts: 1, Connects: 1, ConnectRate: 100}, stats.ByType["sms"])
+	assert.Equal(t, 1, stats.ByOutcome["bounced"])
+	assert.Equal(t, 30, stats.PeriodDays)
+
+	empty, err := service.GetStats(ctx, other.ID+100, 30)
+	require.NoError(t, err)
+	assert.Equal(t, 0, empty.TotalAttempts)
+	assert.Equal(t, 0.0, empty.ConnectRate)
+}
@@ -139,6 +139,23 @@ func (c *Container) initServices() {
 		c.Config.FrontendURL,
 		c.Config.SendGridAPIKey,
 	)
+	c.EmailService.SetDB(c.DB.Ent)
+	if c.Config.EmailProvider != "sendgrid" || c.Config.EmailFallbackProvider != "" {
+		err := c.EmailService.ConfigureProviders(c.Config.EmailProvider, c.Config.EmailFallbackProvider, email.ProviderConfig{
+			SendGridAPIKey: c.Config.SendGridAPIKey,
+			SES: email.SESConfig{
+				AWSAccessKeyID:     c.Config.AWSAccessKeyID,
+				AWSSecretAccessKey: c.Config.AWSSecretAccessKey,
+				AWSRegion:          c.Config.SESRegion,
+			},
+		})
+		if err != nil {
+			c.Logger.Error("Failed to configure email providers, keeping defaults", "error", err)
+		}
+	}
+	if c.Config.EmailTemplateDir != "" {
+		c.EmailService.SetTemplateDir(c.Config.EmailTemplateDir)
+	}
 	c.LeadService = leads.NewService(c.DB.Ent, cacheClient)
 	c.AnalyticsService = analytics.NewService(c.DB.Ent)
 	c.IndustriesService = industries.NewService(c.DB.Ent, cacheClient)
@@ -218,7 +235,7 @@ func (c *Container) initHandlers() {
 	c.AdminHandler = handlers.NewAdminHandler(c.DB.Ent, c.AuditLogger)
 	c.AnalyticsHandler = handlers.NewAnalyticsHandler(c.AnalyticsService)
 	c.APIKeyHandler = handlers.NewAPIKeyHandler(c.APIKeyService)
-	c.SavedSearchHandler = handlers.NewSavedSearchHandler(c.SavedSearchService)
+	c.SavedSearchHandler = handlers.NewSavedSearchHandler(c.SavedSearchService, c.LeadService)
 
 	// TODO: Create IndustriesHandler and OrganizationHandler
 	// c.IndustriesHandler = handlers.NewIndustriesHandler(c.IndustriesService)
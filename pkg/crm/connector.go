@@ -0,0 +1,65 @@
+// Package crm pushes leads into external CRMs.
+package crm
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/crmintegration"
+)
+
+var (
+	// ErrInvalidToken is returned when the CRM rejects the stored credentials
+	ErrInvalidToken = errors.New("CRM rejected the API token")
+	// ErrCRMAPIError is returned when the CRM API returns an unexpected response
+	ErrCRMAPIError = errors.New("CRM API error")
+)
+
+// Push actions reported per lead
+const (
+	ActionCreated = "created"
+	ActionUpdated = "updated"
+)
+
+// PushResult describes the CRM records a lead was written to
+type PushResult struct {
+	Action    string `json:"action"` // created or updated
+	CompanyID string `json:"company_id,omitempty"`
+	ContactID string `json:"contact_id,omitempty"`
+}
+
+// CRMConnector writes leads to one CRM provider. Implementations dedupe
+// against existing CRM records so pushing a lead twice updates it.
+type CRMConnector interface {
+	// Provider returns the provider this connector writes to
+	Provider() crmintegration.Provider
+	// Validate checks that the credentials are accepted
+	Validate(ctx context.Context) error
+	// PushLead creates or updates the CRM records for a lead. knownCompanyID
+	// is the CRM record a previous push created, used when the lead has no
+	// natural dedup key.
+	PushLead(ctx context.Context, lead *ent.Lead, knownCompanyID string) (*PushResult, error)
+}
+
+// ConnectorFactory builds a connector from a decrypted API token
+type ConnectorFactory func(token string) CRMConnector
+
+// websiteDomain returns the bare host of a lead website, used to dedupe
+// companies ("https://www.example.com/about" -> "example.com")
+func websiteDomain(website string) string {
+	website = strings.TrimSpace(website)
+	if website == "" {
+		return ""
+	}
+	if !strings.Contains(website, "://") {
+		website = "https://" + website
+	}
+	u, err := url.Parse(website)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+}
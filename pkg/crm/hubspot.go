@@ -0,0 +1,282 @@
+package crm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/crmintegration"
+)
+
+const (
+	hubSpotAPIURL = "https://api.hubapi.com"
+
+	// hubSpotRateLimitRetries is how often a 429 response is retried
+	hubSpotRateLimitRetries = 3
+)
+
+// HubSpotConnector pushes leads to HubSpot as companies, plus a contact when
+// the lead has an email. Companies are deduped on domain, contacts on email.
+type HubSpotConnector struct {
+	token   string
+	baseURL string
+	client  *http.Client
+	backoff time.Duration // Base wait after a 429
+}
+
+// NewHubSpotConnector creates a connector authenticated with a HubSpot
+// private app token
+func NewHubSpotConnector(token string) CRMConnector {
+	return &HubSpotConnector{
+		token:   token,
+		baseURL: hubSpotAPIURL,
+		client:  &http.Client{Timeout: 15 * time.Second},
+		backoff: time.Second,
+	}
+}
+
+// Provider returns crmintegration.ProviderHubspot
+func (h *HubSpotConnector) Provider() crmintegration.Provider {
+	return crmintegration.ProviderHubspot
+}
+
+// Validate checks the token by listing a single company
+func (h *HubSpotConnector) Validate(ctx context.Context) error {
+	status, err := h.do(ctx, http.MethodGet, "/crm/v3/objects/companies?limit=1", nil, nil)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("%w: status %d", ErrCRMAPIError, status)
+	}
+	return nil
+}
+
+// PushLead upserts the lead's company and contact and associates them
+func (h *HubSpotConnector) PushLead(ctx context.Context, lead *ent.Lead, knownCompanyID string) (*PushResult, error) {
+	result := &PushResult{Action: ActionCreated}
+
+	// Company: dedupe on domain, then on the record a previous push created
+	companyID := ""
+	if domain := websiteDomain(lead.Website); domain != "" {
+		id, err := h.search(ctx, "companies", "domain", domain)
+		if err != nil {
+			return nil, err
+		}
+		companyID = id
+	}
+	if companyID == "" {
+		companyID = knownCompanyID
+	}
+
+	companyID, updated, err := h.upsert(ctx, "companies", companyID, hubSpotCompanyProperties(lead))
+	if err != nil {
+		return nil, err
+	}
+	result.CompanyID = companyID
+	if updated {
+		result.Action = ActionUpdated
+	}
+
+	// Contact: only leads with an email, deduped on email
+	if lead.Email == "" {
+		return result, nil
+	}
+	contactID, err := h.search(ctx, "contacts", "email", lead.Email)
+	if err != nil {
+		return nil, err
+	}
+	contactID, updated, err = h.upsert(ctx, "contacts", contactID, hubSpotContactProperties(lead))
+	if err != nil {
+		return nil, err
+	}
+	result.ContactID = contactID
+	if updated {
+		result.Action = ActionUpdated
+	}
+
+	path := fmt.Sprintf("/crm/v4/objects/contacts/%s/associations/default/companies/%s",
+		url.PathEscape(contactID), url.PathEscape(companyID))
+	status, err := h.do(ctx, http.MethodPut, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK && status != http.StatusCreated {
+		return nil, fmt.Errorf("%w: associate contact returned status %d", ErrCRMAPIError, status)
+	}
+
+	return result, nil
+}
+
+// hubSpotCompanyProperties maps a lead to HubSpot company properties. The
+// industry is not mapped: HubSpot's industry property only accepts its own
+// fixed values.
+func hubSpotCompanyProperties(lead *ent.Lead) map[string]string {
+	props := map[string]string{
+		"name":    lead.Name,
+		"domain":  websiteDomain(lead.Website),
+		"website": lead.Website,
+		"phone":   lead.Phone,
+		"address": lead.Address,
+		"city":    lead.City,
+		"zip":     lead.PostalCode,
+		"country": lead.Country,
+	}
+	if lead.CompanyDescription != "" {
+		props["description"] = lead.CompanyDescription
+	}
+	if lead.EmployeeCount > 0 {
+		props["numberofemployees"] = strconv.Itoa(lead.EmployeeCount)
+	}
+	if lead.LinkedinURL != "" {
+		props["linkedin_company_page"] = lead.LinkedinURL
+	}
+	if lead.FacebookURL != "" {
+		props["facebook_company_page"] = lead.FacebookURL
+	}
+	return withoutEmpty(props)
+}
+
+// hubSpotContactProperties maps a lead to HubSpot contact properties
+func hubSpotContactProperties(lead *ent.Lead) map[string]string {
+	return withoutEmpty(map[string]string{
+		"email":   lead.Email,
+		"phone":   lead.Phone,
+		"company": lead.Name,
+		"website": lead.Website,
+		"address": lead.Address,
+		"city":    lead.City,
+		"zip":     lead.PostalCode,
+		"country": lead.Country,
+	})
+}
+
+// withoutEmpty drops empty properties so pushes never blank CRM values
+func withoutEmpty(props map[string]string) map[string]string {
+	for key, value := range props {
+		if value == "" {
+			delete(props, key)
+		}
+	}
+	return props
+}
+
+// search returns the ID of the first object whose property equals value,
+// or "" if there is none
+func (h *HubSpotConnector) search(ctx context.Context, object, property, value string) (string, error) {
+	body := map[string]interface{}{
+		"filterGroups": []map[string]interface{}{{
+			"filters": []map[string]string{{
+				"propertyName": property,
+				"operator":     "EQ",
+				"value":        value,
+			}},
+		}},
+		"limit": 1,
+	}
+
+	var found struct {
+		Results []struct {
+			ID string `json:"id"`
+		} `json:"results"`
+	}
+	status, err := h.do(ctx, http.MethodPost, "/crm/v3/objects/"+object+"/search", body, &found)
+	if err != nil {
+		return "", err
+	}
+	if status != http.StatusOK {
+		return "", fmt.Errorf("%w: search %s returned status %d", ErrCRMAPIError, object, status)
+	}
+	if len(found.Results) == 0 {
+		return "", nil
+	}
+	return found.Results[0].ID, nil
+}
+
+// upsert updates the object with id, or creates one when id is empty or no
+// longer exists. It returns the object ID and whether it already existed.
+func (h *HubSpotConnector) upsert(ctx context.Context, object, id string, props map[string]string) (string, bool, error) {
+	body := map[string]interface{}{"properties": props}
+	var saved struct {
+		ID string `json:"id"`
+	}
+
+	if id != "" {
+		status, err := h.do(ctx, http.MethodPatch, "/crm/v3/objects/"+object+"/"+url.PathEscape(id), body, &saved)
+		if err != nil {
+			return "", false, err
+		}
+		if status == http.StatusOK {
+			return id, true, nil
+		}
+		if status != http.StatusNotFound {
+			return "", false, fmt.Errorf("%w: update %s returned status %d", ErrCRMAPIError, object, status)
+		}
+		// Deleted in HubSpot since the last push, create it again
+	}
+
+	status, err := h.do(ctx, http.MethodPost, "/crm/v3/objects/"+object, body, &saved)
+	if err != nil {
+		return "", false, err
+	}
+	if status != http.StatusCreated && status != http.StatusOK {
+		return "", false, fmt.Errorf("%w: create %s returned status %d", ErrCRMAPIError, object, status)
+	}
+	return saved.ID, false, nil
+}
+
+// do sends an API request, retrying rate-limited requests, and decodes a
+// successful response into out
+func (h *HubSpotConnector) do(ctx context.Context, method, path string, body, out interface{}) (int, error) {
+	var payload []byte
+	if body != nil {
+		var err error
+		if payload, err = json.Marshal(body); err != nil {
+			return 0, fmt.Errorf("failed to encode request: %w", err)
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		var reader io.Reader
+		if payload != nil {
+			reader = bytes.NewReader(payload)
+		}
+		req, err := http.NewRequestWithContext(ctx, method, h.baseURL+path, reader)
+		if err != nil {
+			return 0, err
+		}
+		req.Header.Set("Authorization", "Bearer "+h.token)
+		if payload != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+
+		resp, err := h.client.Do(req)
+		if err != nil {
+			return 0, fmt.Errorf("HubSpot request failed: %w", err)
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests && attempt < hubSpotRateLimitRetries {
+			resp.Body.Close()
+			time.Sleep(time.Duration(1<<uint(attempt)) * h.backoff)
+			continue
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+			return resp.StatusCode, ErrInvalidToken
+		}
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 && out != nil {
+			if err := json.NewDecoder(resp.Body).Decode(out); err != nil && err != io.EOF {
+				return 0, fmt.Errorf("failed to decode HubSpot response: %w", err)
+			}
+		}
+		return resp.StatusCode, nil
+	}
+}
@@ -0,0 +1,203 @@
+package crm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeHubSpot stores companies and contacts in memory and implements the
+// endpoints HubSpotConnector uses
+type fakeHubSpot struct {
+	mu           sync.Mutex
+	token        string
+	nextID       int
+	objects      map[string]map[string]map[string]string // object type -> id -> properties
+	associations map[string]string                       // contact id -> company id
+	rateLimited  int                                     // Requests to answer with 429
+}
+
+func newFakeHubSpot(t *testing.T, token string) (*fakeHubSpot, *httptest.Server) {
+	fake := &fakeHubSpot{
+		token:  token,
+		nextID: 100,
+		objects: map[string]map[string]map[string]string{
+			"companies": {},
+			"contacts":  {},
+		},
+		associations: map[string]string{},
+	}
+	server := httptest.NewServer(http.HandlerFunc(fake.serve))
+	t.Cleanup(server.Close)
+	return fake, server
+}
+
+func (f *fakeHubSpot) connector(server *httptest.Server, token string) CRMConnector {
+	return &HubSpotConnector{token: token, baseURL: server.URL, client: server.Client()}
+}
+
+func (f *fakeHubSpot) serve(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.rateLimited > 0 {
+		f.rateLimited--
+		w.WriteHeader(http.StatusTooManyRequests)
+		return
+	}
+	if r.Header.Get("Authorization") != "Bearer "+f.token {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	// crm/v4/objects/contacts/{id}/associations/default/companies/{id}
+	if len(parts) == 9 && parts[1] == "v4" {
+		f.associations[parts[4]] = parts[8]
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	if len(parts) < 4 || parts[1] != "v3" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	objects := f.objects[parts[3]]
+
+	var body struct {
+		Properties   map[string]string `json:"properties"`
+		FilterGroups []struct {
+			Filters []struct {
+				PropertyName string `json:"propertyName"`
+				Value        string `json:"value"`
+			} `json:"filters"`
+		} `json:"filterGroups"`
+	}
+	if r.Body != nil {
+		json.NewDecoder(r.Body).Decode(&body)
+	}
+
+	switch {
+	case r.Method == http.MethodGet && len(parts) == 4:
+		json.NewEncoder(w).Encode(map[string]interface{}{"results": []interface{}{}})
+	case r.Method == http.MethodPost && len(parts) == 5 && parts[4] == "search":
+		filter := body.FilterGroups[0].Filters[0]
+		results := []map[string]string{}
+		for id, props := range objects {
+			if props[filter.PropertyName] == filter.Value {
+				results = append(results, map[string]string{"id": id})
+			}
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"results": results})
+	case r.Method == http.MethodPost && len(parts) == 4:
+		f.nextID++
+		id := fmt.Sprint(f.nextID)
+		objects[id] = body.Properties
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]string{"id": id})
+	case r.Method == http.MethodPatch && len(parts) == 5:
+		props, ok := objects[parts[4]]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		for key, value := range body.Properties {
+			props[key] = value
+		}
+		json.NewEncoder(w).Encode(map[string]string{"id": parts[4]})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestWebsiteDomain(t *testing.T) {
+	assert.Equal(t, "example.com", websiteDomain("https://www.Example.com/about"))
+	assert.Equal(t, "example.com", websiteDomain("example.com"))
+	assert.Equal(t, "shop.example.com", websiteDomain("http://shop.example.com"))
+	assert.Equal(t, "", websiteDomain(""))
+}
+
+func TestHubSpotConnector_Validate(t *testing.T) {
+	fake, server := newFakeHubSpot(t, "pat-good")
+
+	assert.NoError(t, fake.connector(server, "pat-good").Validate(context.Background()))
+	assert.ErrorIs(t, fake.connector(server, "pat-bad").Validate(context.Background()), ErrInvalidToken)
+}
+
+func TestHubSpotConnector_PushLead(t *testing.T) {
+	ctx := context.Background()
+	fake, server := newFakeHubSpot(t, "pat-good")
+	connector := fake.connector(server, "pat-good")
+
+	lead := &ent.Lead{
+		ID:            1,
+		Name:          "Ink Masters",
+		Email:         "hello@inkmasters.com",
+		Website:       "https://www.inkmasters.com",
+		Phone:         "+1-555-0100",
+		City:          "Austin",
+		Country:       "US",
+		EmployeeCount: 12,
+	}
+
+	t.Run("Creates company and contact", func(t *testing.T) {
+		result, err := connector.PushLead(ctx, lead, "")
+		require.NoError(t, err)
+		assert.Equal(t, ActionCreated, result.Action)
+		require.NotEmpty(t, result.CompanyID)
+		require.NotEmpty(t, result.ContactID)
+
+		company := fake.objects["companies"][result.CompanyID]
+		assert.Equal(t, "Ink Masters", company["name"])
+		assert.Equal(t, "inkmasters.com", company["domain"])
+		assert.Equal(t, "12", company["numberofemployees"])
+		assert.NotContains(t, company, "address", "empty fields are not sent")
+		assert.Equal(t, "hello@inkmasters.com", fake.objects["contacts"][result.ContactID]["email"])
+		assert.Equal(t, result.CompanyID, fake.associations[result.ContactID])
+	})
+
+	t.Run("Dedupes on domain and email", func(t *testing.T) {
+		updated := *lead
+		updated.Phone = "+1-555-0199"
+
+		result, err := connector.PushLead(ctx, &updated, "")
+		require.NoError(t, err)
+		assert.Equal(t, ActionUpdated, result.Action)
+		assert.Len(t, fake.objects["companies"], 1)
+		assert.Len(t, fake.objects["contacts"], 1)
+		assert.Equal(t, "+1-555-0199", fake.objects["companies"][result.CompanyID]["phone"])
+	})
+
+	t.Run("Falls back to the known company without a domain", func(t *testing.T) {
+		noSite := &ent.Lead{ID: 2, Name: "Walk-in Studio", City: "Austin"}
+
+		first, err := connector.PushLead(ctx, noSite, "")
+		require.NoError(t, err)
+		assert.Empty(t, first.ContactID, "no contact without an email")
+
+		second, err := connector.PushLead(ctx, noSite, first.CompanyID)
+		require.NoError(t, err)
+		assert.Equal(t, ActionUpdated, second.Action)
+		assert.Equal(t, first.CompanyID, second.CompanyID)
+
+		delete(fake.objects["companies"], first.CompanyID)
+		recreated, err := connector.PushLead(ctx, noSite, first.CompanyID)
+		require.NoError(t, err)
+		assert.Equal(t, ActionCreated, recreated.Action)
+		assert.NotEqual(t, first.CompanyID, recreated.CompanyID)
+	})
+
+	t.Run("Retries rate-limited requests", func(t *testing.T) {
+		fake.rateLimited = 2
+		_, err := connector.PushLead(ctx, lead, "")
+		assert.NoError(t, err)
+	})
+}
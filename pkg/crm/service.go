@@ -0,0 +1,462 @@
+package crm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/crmintegration"
+	"github.com/jordanlanch/industrydb/ent/crmleadsync"
+	"github.com/jordanlanch/industrydb/ent/crmpushjob"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/jordanlanch/industrydb/pkg/oauth"
+)
+
+var (
+	// ErrNotConnected is returned when the user has no integration for the provider
+	ErrNotConnected = errors.New("CRM is not connected")
+	// ErrUnsupportedProvider is returned for providers without a connector
+	ErrUnsupportedProvider = errors.New("CRM provider is not supported")
+	// ErrNoLeads is returned when a push selects no leads
+	ErrNoLeads = errors.New("no leads selected")
+	// ErrTooManyLeads is returned when a push selects more leads than allowed
+	ErrTooManyLeads = errors.New("too many leads selected")
+	// ErrJobNotFound is returned when a push job does not exist for the user
+	ErrJobNotFound = errors.New("push job not found")
+)
+
+// DefaultMaxPushLeads caps the leads pushed by a single job
+const DefaultMaxPushLeads = 1000
+
+// searchPageSize matches the page size cap of leads.Service.Search
+const searchPageSize = 100
+
+// LeadSearcher resolves a search filter to leads. It is satisfied by
+// *leads.Service.
+type LeadSearcher interface {
+	Search(ctx context.Context, req models.LeadSearchRequest) (*models.LeadListResponse, error)
+}
+
+// Service stores CRM credentials and runs lead push jobs
+type Service struct {
+	db         *ent.Client
+	leads      LeadSearcher
+	cipher     *oauth.TokenCipher
+	connectors map[crmintegration.Provider]ConnectorFactory
+	maxLeads   int
+}
+
+// NewService creates a CRM service with the HubSpot connector registered.
+// maxLeads <= 0 uses DefaultMaxPushLeads.
+func NewService(db *ent.Client, leads LeadSearcher, cipher *oauth.TokenCipher, maxLeads int) *Service {
+	if maxLeads <= 0 {
+		maxLeads = DefaultMaxPushLeads
+	}
+	s := &Service{
+		db:         db,
+		leads:      leads,
+		cipher:     cipher,
+		connectors: make(map[crmintegration.Provider]ConnectorFactory),
+		maxLeads:   maxLeads,
+	}
+	s.RegisterConnector(crmintegration.ProviderHubspot, NewHubSpotConnector)
+	return s
+}
+
+// RegisterConnector sets the connector used for a provider
+func (s *Service) RegisterConnector(provider crmintegration.Provider, factory ConnectorFactory) {
+	s.connectors[provider] = factory
+}
+
+// MaxLeads returns the number of leads a single push may select
+func (s *Service) MaxLeads() int {
+	return s.maxLeads
+}
+
+// SaveToken validates an API token with the CRM and stores it encrypted,
+// replacing any token saved before
+func (s *Service) SaveToken(ctx context.Context, userID int, provider crmintegration.Provider, token string) (*ent.CRMIntegration, error) {
+	factory, ok := s.connectors[provider]
+	if !ok {
+		return nil, ErrUnsupportedProvider
+	}
+	if err := factory(token).Validate(ctx); err != nil {
+		return nil, err
+	}
+
+	encrypted, err := s.cipher.Encrypt(token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt token: %w", err)
+	}
+
+	existing, err := s.integration(ctx, userID, provider)
+	if err == nil {
+		return existing.Update().
+			SetAPIKey(encrypted).
+			SetEnabled(true).
+			ClearLastSyncError().
+			Save(ctx)
+	}
+	if !errors.Is(err, ErrNotConnected) {
+		return nil, err
+	}
+
+	return s.db.CRMIntegration.Create().
+		SetUserID(userID).
+		SetProvider(provider).
+		SetAccessToken("").
+		SetAPIKey(encrypted).
+		Save(ctx)
+}
+
+// GetIntegration returns the user's integration for a provider
+func (s *Service) GetIntegration(ctx context.Context, userID int, provider crmintegration.Provider) (*ent.CRMIntegration, error) {
+	return s.integration(ctx, userID, provider)
+}
+
+// DeleteIntegration removes the user's integration, its sync records and
+// push jobs
+func (s *Service) DeleteIntegration(ctx context.Context, userID int, provider crmintegration.Provider) error {
+	integration, err := s.integration(ctx, userID, provider)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.db.CRMPushJob.Delete().
+		Where(crmpushjob.IntegrationIDEQ(integration.ID)).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete push jobs: %w", err)
+	}
+	if _, err := s.db.CRMLeadSync.Delete().
+		Where(crmleadsync.IntegrationIDEQ(integration.ID)).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete sync records: %w", err)
+	}
+	return s.db.CRMIntegration.DeleteOneID(integration.ID).Exec(ctx)
+}
+
+// StartPush records a pending push job for the given leads, or for the leads
+// matching filters when leadIDs is empty, and processes it in the background
+func (s *Service) StartPush(ctx context.Context, userID int, provider crmintegration.Provider, leadIDs []int, filters *models.LeadSearchRequest) (*ent.CRMPushJob, error) {
+	if _, ok := s.connectors[provider]; !ok {
+		return nil, ErrUnsupportedProvider
+	}
+	integration, err := s.integration(ctx, userID, provider)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := s.selectLeads(ctx, leadIDs, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := s.db.CRMPushJob.Create().
+		SetUserID(userID).
+		SetIntegrationID(integration.ID).
+		SetTotalLeads(len(ids)).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create push job: %w", err)
+	}
+
+	go s.run(job.ID, integration, ids)
+
+	return job, nil
+}
+
+// GetJob returns a push job owned by the user
+func (s *Service) GetJob(ctx context.Context, userID, jobID int) (*ent.CRMPushJob, error) {
+	job, err := s.db.CRMPushJob.Query().
+		Where(crmpushjob.IDEQ(jobID), crmpushjob.UserIDEQ(userID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrJobNotFound
+	}
+	return job, err
+}
+
+// ListJobs returns the user's most recent push jobs
+func (s *Service) ListJobs(ctx context.Context, userID, limit int) ([]*ent.CRMPushJob, error) {
+	return s.db.CRMPushJob.Query().
+		Where(crmpushjob.UserIDEQ(userID)).
+		Order(ent.Desc(crmpushjob.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+}
+
+// FailInterrupted marks push jobs left pending or processing by a previous
+// process as failed. Jobs run in-process, so they cannot survive a restart.
+func (s *Service) FailInterrupted(ctx context.Context) (int, error) {
+	return s.db.CRMPushJob.Update().
+		Where(crmpushjob.StatusIn(crmpushjob.StatusPending, crmpushjob.StatusProcessing)).
+		SetStatus(crmpushjob.StatusFailed).
+		SetErrorMessage("interrupted by server restart").
+		SetCompletedAt(time.Now()).
+		Save(ctx)
+}
+
+// integration returns the user's integration for a provider
+func (s *Service) integration(ctx context.Context, userID int, provider crmintegration.Provider) (*ent.CRMIntegration, error) {
+	integration, err := s.db.CRMIntegration.Query().
+		Where(
+			crmintegration.UserIDEQ(userID),
+			crmintegration.ProviderEQ(provider),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrNotConnected
+	}
+	return integration, err
+}
+
+// selectLeads dedupes explicit lead IDs, or pages through the search results
+// for filters, and enforces the push size cap
+func (s *Service) selectLeads(ctx context.Context, leadIDs []int, filters *models.LeadSearchRequest) ([]int, error) {
+	if len(leadIDs) > 0 {
+		seen := make(map[int]bool, len(leadIDs))
+		ids := make([]int, 0, len(leadIDs))
+		for _, id := range leadIDs {
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+		if len(ids) > s.maxLeads {
+			return nil, fmt.Errorf("%w: %d leads (max %d)", ErrTooManyLeads, len(ids), s.maxLeads)
+		}
+		return ids, nil
+	}
+
+	if filters == nil {
+		return nil, ErrNoLeads
+	}
+
+	req := *filters
+	req.Limit = searchPageSize
+	var ids []int
+	for page := 1; ; page++ {
+		req.Page = page
+		results, err := s.leads.Search(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to search leads: %w", err)
+		}
+		if page == 1 && results.Pagination.Total > s.maxLeads {
+			return nil, fmt.Errorf("%w: %d leads match (max %d)", ErrTooManyLeads, results.Pagination.Total, s.maxLeads)
+		}
+		for _, l := range results.Data {
+			ids = append(ids, l.ID)
+		}
+		if !results.Pagination.HasNext || len(ids) >= s.maxLeads {
+			break
+		}
+	}
+	if len(ids) == 0 {
+		return nil, ErrNoLeads
+	}
+	return ids, nil
+}
+
+// run pushes each lead and records per-lead results on the job
+func (s *Service) run(jobID int, integration *ent.CRMIntegration, leadIDs []int) {
+	ctx := context.Background()
+
+	if err := s.db.CRMPushJob.UpdateOneID(jobID).
+		SetStatus(crmpushjob.StatusProcessing).
+		SetStartedAt(time.Now()).
+		Exec(ctx); err != nil {
+		log.Printf("❌ Failed to start CRM push job %d: %v", jobID, err)
+		return
+	}
+
+	token, err := s.cipher.Decrypt(integration.APIKey)
+	if err != nil {
+		s.fail(ctx, jobID, fmt.Errorf("failed to decrypt CRM token: %w", err))
+		return
+	}
+	connector := s.connectors[integration.Provider](token)
+
+	leads, err := s.db.Lead.Query().Where(lead.IDIn(leadIDs...)).All(ctx)
+	if err != nil {
+		s.fail(ctx, jobID, fmt.Errorf("failed to load leads: %w", err))
+		return
+	}
+	byID := make(map[int]*ent.Lead, len(leads))
+	for _, l := range leads {
+		byID[l.ID] = l
+	}
+
+	results := make([]map[string]interface{}, 0, len(leadIDs))
+	var created, updated, failed int
+	var lastErr error
+
+	for _, id := range leadIDs {
+		result := map[string]interface{}{"lead_id": id}
+
+		l, ok := byID[id]
+		if !ok {
+			result["error"] = "lead not found"
+			results = append(results, result)
+			failed++
+			continue
+		}
+
+		pushed, err := connector.PushLead(ctx, l, s.knownCompanyID(ctx, integration.ID, id))
+		s.recordSync(ctx, integration.ID, id, pushed, err)
+		if err != nil {
+			result["error"] = err.Error()
+			results = append(results, result)
+			failed++
+			lastErr = err
+			// A rejected token fails every remaining lead the same way
+			if errors.Is(err, ErrInvalidToken) {
+				s.finish(ctx, jobID, integration, results, created, updated, failed+len(leadIDs)-len(results), err)
+				return
+			}
+			continue
+		}
+
+		result["action"] = pushed.Action
+		if pushed.CompanyID != "" {
+			result["company_id"] = pushed.CompanyID
+		}
+		if pushed.ContactID != "" {
+			result["contact_id"] = pushed.ContactID
+		}
+		results = append(results, result)
+		if pushed.Action == ActionUpdated {
+			updated++
+		} else {
+			created++
+		}
+	}
+
+	s.finish(ctx, jobID, integration, results, created, updated, failed, lastErr)
+}
+
+// knownCompanyID returns the CRM company a previous push created for a lead
+func (s *Service) knownCompanyID(ctx context.Context, integrationID, leadID int) string {
+	sync, err := s.db.CRMLeadSync.Query().
+		Where(
+			crmleadsync.IntegrationIDEQ(integrationID),
+			crmleadsync.LeadIDEQ(leadID),
+		).
+		Only(ctx)
+	if err != nil {
+		return ""
+	}
+	return sync.CrmLeadID
+}
+
+// recordSync upserts the lead's sync record with the push outcome
+func (s *Service) recordSync(ctx context.Context, integrationID, leadID int, pushed *PushResult, pushErr error) {
+	existing, err := s.db.CRMLeadSync.Query().
+		Where(
+			crmleadsync.IntegrationIDEQ(integrationID),
+			crmleadsync.LeadIDEQ(leadID),
+		).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		log.Printf("⚠️  Failed to load CRM sync record for lead %d: %v", leadID, err)
+		return
+	}
+
+	if pushErr != nil {
+		if existing != nil {
+			err = existing.Update().
+				SetSyncStatus(crmleadsync.SyncStatusFailed).
+				SetSyncError(pushErr.Error()).
+				Exec(ctx)
+		} else {
+			err = s.db.CRMLeadSync.Create().
+				SetIntegrationID(integrationID).
+				SetLeadID(leadID).
+				SetCrmLeadID("").
+				SetSyncStatus(crmleadsync.SyncStatusFailed).
+				SetSyncDirection(crmleadsync.SyncDirectionToCrm).
+				SetSyncError(pushErr.Error()).
+				Exec(ctx)
+		}
+	} else {
+		crmData := map[string]interface{}{"company_id": pushed.CompanyID}
+		if pushed.ContactID != "" {
+			crmData["contact_id"] = pushed.ContactID
+		}
+		if existing != nil {
+			err = existing.Update().
+				SetCrmLeadID(pushed.CompanyID).
+				SetSyncStatus(crmleadsync.SyncStatusSynced).
+				SetSyncDirection(crmleadsync.SyncDirectionToCrm).
+				SetSyncedAt(time.Now()).
+				ClearSyncError().
+				SetCrmData(crmData).
+				Exec(ctx)
+		} else {
+			err = s.db.CRMLeadSync.Create().
+				SetIntegrationID(integrationID).
+				SetLeadID(leadID).
+				SetCrmLeadID(pushed.CompanyID).
+				SetSyncStatus(crmleadsync.SyncStatusSynced).
+				SetSyncDirection(crmleadsync.SyncDirectionToCrm).
+				SetSyncedAt(time.Now()).
+				SetCrmData(crmData).
+				Exec(ctx)
+		}
+	}
+	if err != nil {
+		log.Printf("⚠️  Failed to record CRM sync for lead %d: %v", leadID, err)
+	}
+}
+
+// finish records the job outcome and the integration's sync status. A job
+// fails only when no lead was pushed.
+func (s *Service) finish(ctx context.Context, jobID int, integration *ent.CRMIntegration, results []map[string]interface{}, created, updated, failed int, lastErr error) {
+	status := crmpushjob.StatusCompleted
+	if created+updated == 0 && failed > 0 {
+		status = crmpushjob.StatusFailed
+	}
+
+	update := s.db.CRMPushJob.UpdateOneID(jobID).
+		SetStatus(status).
+		SetCreatedCount(created).
+		SetUpdatedCount(updated).
+		SetFailureCount(failed).
+		SetResults(results).
+		SetCompletedAt(time.Now())
+	if lastErr != nil {
+		update = update.SetErrorMessage(lastErr.Error())
+	}
+	if err := update.Exec(ctx); err != nil {
+		log.Printf("❌ Failed to record CRM push job %d result: %v", jobID, err)
+	}
+
+	integrationUpdate := s.db.CRMIntegration.UpdateOneID(integration.ID).
+		SetLastSyncAt(time.Now()).
+		AddSyncedLeadsCount(created + updated)
+	if lastErr != nil {
+		integrationUpdate = integrationUpdate.SetLastSyncError(lastErr.Error())
+	} else {
+		integrationUpdate = integrationUpdate.ClearLastSyncError()
+	}
+	if err := integrationUpdate.Exec(ctx); err != nil {
+		log.Printf("⚠️  Failed to record CRM integration %d sync: %v", integration.ID, err)
+	}
+}
+
+// fail marks a job failed before any lead was pushed
+func (s *Service) fail(ctx context.Context, jobID int, cause error) {
+	log.Printf("❌ CRM push job %d failed: %v", jobID, cause)
+
+	err := s.db.CRMPushJob.UpdateOneID(jobID).
+		SetStatus(crmpushjob.StatusFailed).
+		SetErrorMessage(cause.Error()).
+		SetCompletedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		log.Printf("❌ Failed to record CRM push job %d failure: %v", jobID, err)
+	}
+}
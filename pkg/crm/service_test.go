@@ -0,0 +1,236 @@
+package crm
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/crmintegration"
+	"github.com/jordanlanch/industrydb/ent/crmleadsync"
+	"github.com/jordanlanch/industrydb/ent/crmpushjob"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/jordanlanch/industrydb/pkg/oauth"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubSearcher returns fixed search results one page at a time
+type stubSearcher struct {
+	ids []int
+	err error
+}
+
+func (s *stubSearcher) Search(ctx context.Context, req models.LeadSearchRequest) (*models.LeadListResponse, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	start := (req.Page - 1) * req.Limit
+	end := start + req.Limit
+	if end > len(s.ids) {
+		end = len(s.ids)
+	}
+	resp := &models.LeadListResponse{
+		Pagination: models.PaginationInfo{Total: len(s.ids), HasNext: end < len(s.ids)},
+	}
+	for _, id := range s.ids[start:end] {
+		resp.Data = append(resp.Data, models.LeadResponse{ID: id})
+	}
+	return resp, nil
+}
+
+func setupServiceTest(t *testing.T, searcher LeadSearcher, maxLeads int) (*ent.Client, *Service, *fakeHubSpot, int) {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	cipher, err := oauth.NewTokenCipher("test-secret")
+	require.NoError(t, err)
+
+	fake, server := newFakeHubSpot(t, "pat-good")
+	service := NewService(client, searcher, cipher, maxLeads)
+	service.RegisterConnector(crmintegration.ProviderHubspot, func(token string) CRMConnector {
+		return fake.connector(server, token)
+	})
+
+	u, err := client.User.Create().
+		SetEmail("crm@example.com").
+		SetPasswordHash("hashed").
+		SetName("CRM User").
+		Save(context.Background())
+	require.NoError(t, err)
+
+	return client, service, fake, u.ID
+}
+
+// waitForJob waits for a push job to leave pending/processing
+func waitForJob(t *testing.T, client *ent.Client, jobID int) *ent.CRMPushJob {
+	var job *ent.CRMPushJob
+	require.Eventually(t, func() bool {
+		var err error
+		job, err = client.CRMPushJob.Get(context.Background(), jobID)
+		require.NoError(t, err)
+		return job.Status == crmpushjob.StatusCompleted || job.Status == crmpushjob.StatusFailed
+	}, 5*time.Second, 10*time.Millisecond)
+	return job
+}
+
+func TestService_SaveToken(t *testing.T) {
+	client, service, _, userID := setupServiceTest(t, &stubSearcher{}, 0)
+	ctx := context.Background()
+
+	_, err := service.SaveToken(ctx, userID, crmintegration.ProviderHubspot, "pat-bad")
+	assert.ErrorIs(t, err, ErrInvalidToken)
+
+	_, err = service.SaveToken(ctx, userID, crmintegration.ProviderSalesforce, "token")
+	assert.ErrorIs(t, err, ErrUnsupportedProvider)
+
+	integration, err := service.SaveToken(ctx, userID, crmintegration.ProviderHubspot, "pat-good")
+	require.NoError(t, err)
+	assert.NotEqual(t, "pat-good", integration.APIKey, "token is stored encrypted")
+
+	token, err := service.cipher.Decrypt(integration.APIKey)
+	require.NoError(t, err)
+	assert.Equal(t, "pat-good", token)
+
+	// Saving again replaces the token
+	_, err = service.SaveToken(ctx, userID, crmintegration.ProviderHubspot, "pat-good")
+	require.NoError(t, err)
+	count, err := client.CRMIntegration.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestService_Push(t *testing.T) {
+	client, service, fake, userID := setupServiceTest(t, &stubSearcher{}, 0)
+	ctx := context.Background()
+
+	withEmail := client.Lead.Create().
+		SetName("Ink Masters").
+		SetIndustry("tattoo").
+		SetCountry("US").
+		SetCity("Austin").
+		SetEmail("hello@inkmasters.com").
+		SetWebsite("https://inkmasters.com").
+		SaveX(ctx)
+	companyOnly := client.Lead.Create().
+		SetName("Fresh Cuts").
+		SetIndustry("barber").
+		SetCountry("US").
+		SetCity("Dallas").
+		SaveX(ctx)
+
+	_, err := service.StartPush(ctx, userID, crmintegration.ProviderHubspot, []int{withEmail.ID}, nil)
+	assert.ErrorIs(t, err, ErrNotConnected)
+
+	integration, err := service.SaveToken(ctx, userID, crmintegration.ProviderHubspot, "pat-good")
+	require.NoError(t, err)
+
+	job, err := service.StartPush(ctx, userID, crmintegration.ProviderHubspot, []int{withEmail.ID, companyOnly.ID, withEmail.ID, 999999}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 3, job.TotalLeads, "duplicate IDs are pushed once")
+
+	job = waitForJob(t, client, job.ID)
+	assert.Equal(t, crmpushjob.StatusCompleted, job.Status)
+	assert.Equal(t, 2, job.CreatedCount)
+	assert.Equal(t, 0, job.UpdatedCount)
+	assert.Equal(t, 1, job.FailureCount)
+	require.Len(t, job.Results, 3)
+	assert.Equal(t, "created", job.Results[0]["action"])
+	assert.NotEmpty(t, job.Results[0]["contact_id"])
+	assert.Nil(t, job.Results[1]["contact_id"])
+	assert.Equal(t, "lead not found", job.Results[2]["error"])
+	assert.Len(t, fake.objects["companies"], 2)
+	assert.Len(t, fake.objects["contacts"], 1)
+
+	syncs, err := client.CRMLeadSync.Query().
+		Where(crmleadsync.IntegrationIDEQ(integration.ID), crmleadsync.SyncStatusEQ(crmleadsync.SyncStatusSynced)).
+		Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, syncs)
+
+	integration, err = client.CRMIntegration.Get(ctx, integration.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, integration.SyncedLeadsCount)
+	assert.NotNil(t, integration.LastSyncAt)
+
+	t.Run("Pushing again updates the same records", func(t *testing.T) {
+		job, err := service.StartPush(ctx, userID, crmintegration.ProviderHubspot, []int{withEmail.ID, companyOnly.ID}, nil)
+		require.NoError(t, err)
+
+		job = waitForJob(t, client, job.ID)
+		assert.Equal(t, 2, job.UpdatedCount)
+		assert.Equal(t, 0, job.CreatedCount)
+		assert.Len(t, fake.objects["companies"], 2)
+		assert.Len(t, fake.objects["contacts"], 1)
+	})
+
+	t.Run("Jobs are scoped to the user", func(t *testing.T) {
+		_, err := service.GetJob(ctx, userID+1, job.ID)
+		assert.ErrorIs(t, err, ErrJobNotFound)
+
+		jobs, err := service.ListJobs(ctx, userID, 10)
+		require.NoError(t, err)
+		assert.Len(t, jobs, 2)
+	})
+
+	t.Run("Rejected token fails the job", func(t *testing.T) {
+		fake.token = "pat-rotated"
+
+		job, err := service.StartPush(ctx, userID, crmintegration.ProviderHubspot, []int{withEmail.ID, companyOnly.ID}, nil)
+		require.NoError(t, err)
+
+		job = waitForJob(t, client, job.ID)
+		assert.Equal(t, crmpushjob.StatusFailed, job.Status)
+		assert.Equal(t, 2, job.FailureCount)
+		assert.Contains(t, job.ErrorMessage, "rejected")
+	})
+}
+
+func TestService_SelectLeads(t *testing.T) {
+	ids := make([]int, 250)
+	for i := range ids {
+		ids[i] = i + 1
+	}
+	_, service, _, _ := setupServiceTest(t, &stubSearcher{ids: ids}, 300)
+	ctx := context.Background()
+
+	selected, err := service.selectLeads(ctx, nil, &models.LeadSearchRequest{Industry: "tattoo"})
+	require.NoError(t, err)
+	assert.Equal(t, ids, selected, "pages through every search result")
+
+	_, err = service.selectLeads(ctx, nil, nil)
+	assert.ErrorIs(t, err, ErrNoLeads)
+
+	service.maxLeads = 100
+	_, err = service.selectLeads(ctx, nil, &models.LeadSearchRequest{Industry: "tattoo"})
+	assert.ErrorIs(t, err, ErrTooManyLeads)
+	_, err = service.selectLeads(ctx, ids[:101], nil)
+	assert.ErrorIs(t, err, ErrTooManyLeads)
+
+	service.leads = &stubSearcher{err: errors.New("search down")}
+	_, err = service.selectLeads(ctx, nil, &models.LeadSearchRequest{})
+	assert.Error(t, err)
+}
+
+func TestService_FailInterrupted(t *testing.T) {
+	client, service, _, userID := setupServiceTest(t, &stubSearcher{}, 0)
+	ctx := context.Background()
+
+	integration, err := service.SaveToken(ctx, userID, crmintegration.ProviderHubspot, "pat-good")
+	require.NoError(t, err)
+	job := client.CRMPushJob.Create().
+		SetUserID(userID).
+		SetIntegrationID(integration.ID).
+		SetStatus(crmpushjob.StatusProcessing).
+		SaveX(ctx)
+
+	n, err := service.FailInterrupted(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	job = client.CRMPushJob.GetX(ctx, job.ID)
+	assert.Equal(t, crmpushjob.StatusFailed, job.Status)
+}
@@ -0,0 +1,99 @@
+package customfields
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// Custom field limit errors, returned for keys or values the client must fix
+var (
+	ErrInvalidKey    = errors.New("invalid custom field key")
+	ErrTooManyFields = errors.New("too many custom fields")
+	ErrValueTooLarge = errors.New("custom field value too large")
+)
+
+// Limits bounds the custom fields of a lead. Leads stored before a limit was
+// lowered are still returned as is; they only fail validation when edited.
+type Limits struct {
+	MaxFields     int // Keys per lead
+	MaxKeyLength  int // Characters per key
+	MaxValueBytes int // JSON-encoded size of each value
+}
+
+// DefaultLimits are the limits used until SetLimits is called
+var DefaultLimits = Limits{
+	MaxFields:     50,
+	MaxKeyLength:  50,
+	MaxValueBytes: 2048,
+}
+
+// limits holds the limits enforced on writes
+var limits = DefaultLimits
+
+// keyPattern matches the characters allowed in custom field keys
+var keyPattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+// SetLimits replaces the custom field limits. Non-positive values keep the
+// default for that limit. It is meant to be called once at startup.
+func SetLimits(l Limits) {
+	if l.MaxFields <= 0 {
+		l.MaxFields = DefaultLimits.MaxFields
+	}
+	if l.MaxKeyLength <= 0 {
+		l.MaxKeyLength = DefaultLimits.MaxKeyLength
+	}
+	if l.MaxValueBytes <= 0 {
+		l.MaxValueBytes = DefaultLimits.MaxValueBytes
+	}
+	limits = l
+}
+
+// GetLimits returns the custom field limits in effect
+func GetLimits() Limits {
+	return limits
+}
+
+// IsValidationError reports whether err is a client error from validating
+// custom field keys, values or limits
+func IsValidationError(err error) bool {
+	return errors.Is(err, ErrInvalidKey) ||
+		errors.Is(err, ErrTooManyFields) ||
+		errors.Is(err, ErrValueTooLarge) ||
+		errors.Is(err, ErrInvalidFieldValue)
+}
+
+// validateKey checks a key's length and characters
+func validateKey(key string) error {
+	if key == "" {
+		return fmt.Errorf("%w: key cannot be empty", ErrInvalidKey)
+	}
+	if len(key) > limits.MaxKeyLength {
+		return fmt.Errorf("%w: key too long (max %d characters)", ErrInvalidKey, limits.MaxKeyLength)
+	}
+	if !keyPattern.MatchString(key) {
+		return fmt.Errorf("%w: key '%s' may only contain letters, digits, '_', '-' and '.'", ErrInvalidKey, key)
+	}
+	return nil
+}
+
+// validateValueSize checks the JSON-encoded size of a value
+func validateValueSize(key string, value interface{}) error {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("%w: '%s' is not a JSON value", ErrInvalidFieldValue, key)
+	}
+	if len(encoded) > limits.MaxValueBytes {
+		return fmt.Errorf("%w: '%s' is %d bytes (max %d)", ErrValueTooLarge, key, len(encoded), limits.MaxValueBytes)
+	}
+	return nil
+}
+
+// validateFieldCount checks the number of keys a lead would have
+func validateFieldCount(count int) error {
+	if count > limits.MaxFields {
+		return fmt.Errorf("%w: a lead can have at most %d custom fields", ErrTooManyFields, limits.MaxFields)
+	}
+	return nil
+}
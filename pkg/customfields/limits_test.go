@@ -0,0 +1,129 @@
+package customfields
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// withLimits sets custom field limits for the duration of a test
+func withLimits(t *testing.T, l Limits) {
+	t.Helper()
+
+	SetLimits(l)
+	t.Cleanup(func() { SetLimits(DefaultLimits) })
+}
+
+func fieldsOf(count int) map[string]interface{} {
+	fields := make(map[string]interface{}, count)
+	for i := 0; i < count; i++ {
+		fields[fmt.Sprintf("field_%d", i)] = "value"
+	}
+	return fields
+}
+
+func TestSetLimits(t *testing.T) {
+	withLimits(t, Limits{MaxFields: 3})
+
+	assert.Equal(t, Limits{MaxFields: 3, MaxKeyLength: DefaultLimits.MaxKeyLength, MaxValueBytes: DefaultLimits.MaxValueBytes}, GetLimits())
+}
+
+func TestValidateKey(t *testing.T) {
+	withLimits(t, Limits{MaxKeyLength: 10})
+
+	tests := []struct {
+		name  string
+		key   string
+		valid bool
+	}{
+		{"At the length limit", "abcdefghij", true},
+		{"Over the length limit", "abcdefghijk", false},
+		{"Allowed punctuation", "crm.id-2_x", true},
+		{"Empty", "", false},
+		{"Space", "owner name", false},
+		{"Unicode", "dueño", false},
+		{"JSON path characters", "a[0]", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateKey(tt.key)
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrInvalidKey)
+				assert.True(t, IsValidationError(err))
+			}
+		})
+	}
+}
+
+func TestCustomFieldLimits(t *testing.T) {
+	client, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	service := NewService(client)
+	withLimits(t, Limits{MaxFields: 3, MaxValueBytes: 12})
+
+	t.Run("Value at the size limit", func(t *testing.T) {
+		lead := createTestLead(t, client, "Size Studio")
+
+		_, err := service.SetCustomField(ctx, 0, lead.ID, "note", "0123456789") // 12 bytes with quotes
+		assert.NoError(t, err)
+
+		_, err = service.SetCustomField(ctx, 0, lead.ID, "note", "01234567890")
+		assert.ErrorIs(t, err, ErrValueTooLarge)
+
+		_, err = service.UpdateCustomFields(ctx, 0, lead.ID, map[string]interface{}{"tags": []interface{}{"a", "b", "c"}}) // ["a","b","c"] is 13 bytes
+		assert.ErrorIs(t, err, ErrValueTooLarge)
+	})
+
+	t.Run("Keys at the count limit", func(t *testing.T) {
+		lead := createTestLead(t, client, "Count Studio")
+
+		for key := range fieldsOf(3) {
+			_, err := service.SetCustomField(ctx, 0, lead.ID, key, "value")
+			require.NoError(t, err)
+		}
+
+		_, err := service.SetCustomField(ctx, 0, lead.ID, "one_more", "value")
+		assert.ErrorIs(t, err, ErrTooManyFields)
+
+		// Existing keys can still be updated
+		_, err = service.SetCustomField(ctx, 0, lead.ID, "field_0", "updated")
+		assert.NoError(t, err)
+	})
+
+	t.Run("Bulk update at the count limit", func(t *testing.T) {
+		lead := createTestLead(t, client, "Bulk Studio")
+
+		_, err := service.UpdateCustomFields(ctx, 0, lead.ID, fieldsOf(3))
+		assert.NoError(t, err)
+
+		_, err = service.UpdateCustomFields(ctx, 0, lead.ID, fieldsOf(4))
+		assert.ErrorIs(t, err, ErrTooManyFields)
+	})
+
+	t.Run("Over-limit leads are still readable and trimmable", func(t *testing.T) {
+		lead := createTestLead(t, client, "Legacy Studio")
+		legacy := fieldsOf(5)
+		legacy["legacy notes"] = strings.Repeat("x", 100)
+		lead = client.Lead.UpdateOne(lead).SetCustomFields(legacy).SaveX(ctx)
+
+		result, err := service.GetCustomFields(ctx, lead.ID)
+		require.NoError(t, err)
+		assert.Len(t, result.CustomFields, 6)
+
+		_, err = service.SetCustomField(ctx, 0, lead.ID, "field_0", "updated")
+		assert.NoError(t, err)
+
+		result, err = service.RemoveCustomField(ctx, 0, lead.ID, "legacy notes")
+		require.NoError(t, err)
+		assert.Len(t, result.CustomFields, 5)
+	})
+}
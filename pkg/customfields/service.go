@@ -2,12 +2,20 @@ package customfields
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/jordanlanch/industrydb/ent"
 	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/leadchange"
+	"github.com/jordanlanch/industrydb/pkg/industries"
+	"github.com/jordanlanch/industrydb/pkg/leads"
 )
 
+// ErrInvalidFieldValue is returned when a value does not match the type of
+// the industry's recommended field with the same key.
+var ErrInvalidFieldValue = errors.New("invalid custom field value")
+
 // Service handles custom fields operations for leads.
 type Service struct {
 	client *ent.Client
@@ -61,14 +69,15 @@ func (s *Service) GetCustomFields(ctx context.Context, leadID int) (*CustomField
 	}, nil
 }
 
-// SetCustomField sets a single custom field for a lead.
-func (s *Service) SetCustomField(ctx context.Context, leadID int, key string, value interface{}) (*CustomFieldsResponse, error) {
-	// Validate key
-	if key == "" {
-		return nil, fmt.Errorf("key cannot be empty")
+// SetCustomField sets a single custom field for a lead and records the edit
+// in the lead's change history on behalf of userID.
+func (s *Service) SetCustomField(ctx context.Context, userID, leadID int, key string, value interface{}) (*CustomFieldsResponse, error) {
+	// Validate key and value size
+	if err := validateKey(key); err != nil {
+		return nil, err
 	}
-	if len(key) > 50 {
-		return nil, fmt.Errorf("key too long (max 50 characters)")
+	if err := validateValueSize(key, value); err != nil {
+		return nil, err
 	}
 
 	// Get current custom fields
@@ -83,12 +92,21 @@ func (s *Service) SetCustomField(ctx context.Context, leadID int, key string, va
 		return nil, fmt.Errorf("failed to fetch lead: %w", err)
 	}
 
-	// Get existing custom fields or initialize
-	customFields := l.CustomFields
-	if customFields == nil {
-		customFields = make(map[string]interface{})
+	// Validate against the industry's recommended field, if any
+	if err := validateFieldValue(string(l.Industry), key, value); err != nil {
+		return nil, err
 	}
 
+	// Only new keys count against the limit, so over-limit leads stay editable
+	if _, exists := l.CustomFields[key]; !exists {
+		if err := validateFieldCount(len(l.CustomFields) + 1); err != nil {
+			return nil, err
+		}
+	}
+
+	// Copy existing custom fields so the fetched lead keeps its old values
+	customFields := copyFields(l.CustomFields)
+
 	// Set the new field
 	customFields[key] = value
 
@@ -101,6 +119,10 @@ func (s *Service) SetCustomField(ctx context.Context, leadID int, key string, va
 		return nil, fmt.Errorf("failed to update custom field: %w", err)
 	}
 
+	if err := leads.RecordChange(ctx, s.client.LeadChange, l, updatedLead, leadchange.SourceManual, userID); err != nil {
+		return nil, err
+	}
+
 	return &CustomFieldsResponse{
 		LeadID:       updatedLead.ID,
 		CustomFields: updatedLead.CustomFields,
@@ -108,7 +130,7 @@ func (s *Service) SetCustomField(ctx context.Context, leadID int, key string, va
 }
 
 // RemoveCustomField removes a single custom field from a lead.
-func (s *Service) RemoveCustomField(ctx context.Context, leadID int, key string) (*CustomFieldsResponse, error) {
+func (s *Service) RemoveCustomField(ctx context.Context, userID, leadID int, key string) (*CustomFieldsResponse, error) {
 	// Get lead
 	l, err := s.client.Lead.
 		Query().
@@ -121,11 +143,8 @@ func (s *Service) RemoveCustomField(ctx context.Context, leadID int, key string)
 		return nil, fmt.Errorf("failed to fetch lead: %w", err)
 	}
 
-	// Get custom fields
-	customFields := l.CustomFields
-	if customFields == nil {
-		customFields = make(map[string]interface{})
-	}
+	// Copy custom fields so the fetched lead keeps its old values
+	customFields := copyFields(l.CustomFields)
 
 	// Remove the field
 	delete(customFields, key)
@@ -139,6 +158,10 @@ func (s *Service) RemoveCustomField(ctx context.Context, leadID int, key string)
 		return nil, fmt.Errorf("failed to remove custom field: %w", err)
 	}
 
+	if err := leads.RecordChange(ctx, s.client.LeadChange, l, updatedLead, leadchange.SourceManual, userID); err != nil {
+		return nil, err
+	}
+
 	return &CustomFieldsResponse{
 		LeadID:       updatedLead.ID,
 		CustomFields: updatedLead.CustomFields,
@@ -146,19 +169,22 @@ func (s *Service) RemoveCustomField(ctx context.Context, leadID int, key string)
 }
 
 // UpdateCustomFields replaces all custom fields for a lead (bulk update).
-func (s *Service) UpdateCustomFields(ctx context.Context, leadID int, newFields map[string]interface{}) (*CustomFieldsResponse, error) {
+func (s *Service) UpdateCustomFields(ctx context.Context, userID, leadID int, newFields map[string]interface{}) (*CustomFieldsResponse, error) {
 	// Validate that fields map is not nil
 	if newFields == nil {
 		newFields = make(map[string]interface{})
 	}
 
-	// Validate keys
-	for key := range newFields {
-		if key == "" {
-			return nil, fmt.Errorf("custom field key cannot be empty")
+	// Validate the number of keys, keys and value sizes
+	if err := validateFieldCount(len(newFields)); err != nil {
+		return nil, err
+	}
+	for key, value := range newFields {
+		if err := validateKey(key); err != nil {
+			return nil, err
 		}
-		if len(key) > 50 {
-			return nil, fmt.Errorf("custom field key '%s' too long (max 50 characters)", key)
+		if err := validateValueSize(key, value); err != nil {
+			return nil, err
 		}
 	}
 
@@ -174,6 +200,13 @@ func (s *Service) UpdateCustomFields(ctx context.Context, leadID int, newFields
 		return nil, fmt.Errorf("failed to fetch lead: %w", err)
 	}
 
+	// Validate against the industry's recommended fields, if any
+	for key, value := range newFields {
+		if err := validateFieldValue(string(l.Industry), key, value); err != nil {
+			return nil, err
+		}
+	}
+
 	// Update with new fields (replace all)
 	updatedLead, err := s.client.Lead.
 		UpdateOne(l).
@@ -183,6 +216,10 @@ func (s *Service) UpdateCustomFields(ctx context.Context, leadID int, newFields
 		return nil, fmt.Errorf("failed to update custom fields: %w", err)
 	}
 
+	if err := leads.RecordChange(ctx, s.client.LeadChange, l, updatedLead, leadchange.SourceManual, userID); err != nil {
+		return nil, err
+	}
+
 	return &CustomFieldsResponse{
 		LeadID:       updatedLead.ID,
 		CustomFields: updatedLead.CustomFields,
@@ -190,6 +227,28 @@ func (s *Service) UpdateCustomFields(ctx context.Context, leadID int, newFields
 }
 
 // ClearCustomFields removes all custom fields from a lead.
-func (s *Service) ClearCustomFields(ctx context.Context, leadID int) (*CustomFieldsResponse, error) {
-	return s.UpdateCustomFields(ctx, leadID, make(map[string]interface{}))
+func (s *Service) ClearCustomFields(ctx context.Context, userID, leadID int) (*CustomFieldsResponse, error) {
+	return s.UpdateCustomFields(ctx, userID, leadID, make(map[string]interface{}))
+}
+
+// copyFields returns a shallow copy of a custom fields map, never nil.
+func copyFields(fields map[string]interface{}) map[string]interface{} {
+	copied := make(map[string]interface{}, len(fields))
+	for key, value := range fields {
+		copied[key] = value
+	}
+	return copied
+}
+
+// validateFieldValue checks a value against the industry's recommended field
+// with the same key. Keys without a recommended field accept any value.
+func validateFieldValue(industryID, key string, value interface{}) error {
+	template := industries.GetFieldTemplate(industryID, key)
+	if template == nil {
+		return nil
+	}
+	if err := template.Validate(value); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidFieldValue, err.Error())
+	}
+	return nil
 }
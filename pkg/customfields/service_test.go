@@ -6,6 +6,7 @@ import (
 
 	"github.com/jordanlanch/industrydb/ent"
 	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/ent/leadchange"
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -66,7 +67,7 @@ func TestSetCustomField(t *testing.T) {
 	lead := createTestLead(t, client, "Test Studio")
 
 	t.Run("Success - Set single string field", func(t *testing.T) {
-		result, err := service.SetCustomField(ctx, lead.ID, "owner_name", "John Doe")
+		result, err := service.SetCustomField(ctx, 0, lead.ID, "owner_name", "John Doe")
 
 		require.NoError(t, err)
 		assert.NotNil(t, result)
@@ -75,7 +76,7 @@ func TestSetCustomField(t *testing.T) {
 	})
 
 	t.Run("Success - Set numeric field", func(t *testing.T) {
-		result, err := service.SetCustomField(ctx, lead.ID, "annual_revenue", 150000.50)
+		result, err := service.SetCustomField(ctx, 0, lead.ID, "annual_revenue", 150000.50)
 
 		require.NoError(t, err)
 		assert.Equal(t, 150000.50, result.CustomFields["annual_revenue"])
@@ -84,7 +85,7 @@ func TestSetCustomField(t *testing.T) {
 	})
 
 	t.Run("Success - Set boolean field", func(t *testing.T) {
-		result, err := service.SetCustomField(ctx, lead.ID, "has_storefront", true)
+		result, err := service.SetCustomField(ctx, 0, lead.ID, "has_storefront", true)
 
 		require.NoError(t, err)
 		assert.Equal(t, true, result.CustomFields["has_storefront"])
@@ -92,14 +93,26 @@ func TestSetCustomField(t *testing.T) {
 	})
 
 	t.Run("Success - Update existing field", func(t *testing.T) {
-		result, err := service.SetCustomField(ctx, lead.ID, "owner_name", "Jane Smith")
+		result, err := service.SetCustomField(ctx, 0, lead.ID, "owner_name", "Jane Smith")
 
 		require.NoError(t, err)
 		assert.Equal(t, "Jane Smith", result.CustomFields["owner_name"])
+
+		changes, err := client.LeadChange.Query().
+			Where(leadchange.LeadID(lead.ID)).
+			Order(ent.Desc(leadchange.FieldID)).
+			All(ctx)
+		require.NoError(t, err)
+		require.Len(t, changes, 4)
+		assert.Equal(t, leadchange.SourceManual, changes[0].Source)
+		pair := changes[0].Changes["custom_fields"]
+		require.Len(t, pair, 2)
+		assert.Equal(t, "John Doe", pair[0].(map[string]interface{})["owner_name"])
+		assert.Equal(t, "Jane Smith", pair[1].(map[string]interface{})["owner_name"])
 	})
 
 	t.Run("Error - Empty key", func(t *testing.T) {
-		result, err := service.SetCustomField(ctx, lead.ID, "", "value")
+		result, err := service.SetCustomField(ctx, 0, lead.ID, "", "value")
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -108,7 +121,7 @@ func TestSetCustomField(t *testing.T) {
 
 	t.Run("Error - Key too long", func(t *testing.T) {
 		longKey := "this_is_a_very_long_key_that_exceeds_the_maximum_allowed_length_of_fifty_characters"
-		result, err := service.SetCustomField(ctx, lead.ID, longKey, "value")
+		result, err := service.SetCustomField(ctx, 0, lead.ID, longKey, "value")
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -116,7 +129,7 @@ func TestSetCustomField(t *testing.T) {
 	})
 
 	t.Run("Error - Lead not found", func(t *testing.T) {
-		result, err := service.SetCustomField(ctx, 99999, "key", "value")
+		result, err := service.SetCustomField(ctx, 0, 99999, "key", "value")
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -134,15 +147,15 @@ func TestRemoveCustomField(t *testing.T) {
 	lead := createTestLead(t, client, "Test Studio")
 
 	// Set up some fields
-	_, err := service.SetCustomField(ctx, lead.ID, "field1", "value1")
+	_, err := service.SetCustomField(ctx, 0, lead.ID, "field1", "value1")
 	require.NoError(t, err)
-	_, err = service.SetCustomField(ctx, lead.ID, "field2", "value2")
+	_, err = service.SetCustomField(ctx, 0, lead.ID, "field2", "value2")
 	require.NoError(t, err)
-	_, err = service.SetCustomField(ctx, lead.ID, "field3", "value3")
+	_, err = service.SetCustomField(ctx, 0, lead.ID, "field3", "value3")
 	require.NoError(t, err)
 
 	t.Run("Success - Remove existing field", func(t *testing.T) {
-		result, err := service.RemoveCustomField(ctx, lead.ID, "field2")
+		result, err := service.RemoveCustomField(ctx, 0, lead.ID, "field2")
 
 		require.NoError(t, err)
 		assert.NotNil(t, result)
@@ -154,7 +167,7 @@ func TestRemoveCustomField(t *testing.T) {
 	})
 
 	t.Run("Success - Remove non-existent field (no error)", func(t *testing.T) {
-		result, err := service.RemoveCustomField(ctx, lead.ID, "nonexistent")
+		result, err := service.RemoveCustomField(ctx, 0, lead.ID, "nonexistent")
 
 		require.NoError(t, err)
 		assert.NotNil(t, result)
@@ -162,7 +175,7 @@ func TestRemoveCustomField(t *testing.T) {
 	})
 
 	t.Run("Error - Lead not found", func(t *testing.T) {
-		result, err := service.RemoveCustomField(ctx, 99999, "field1")
+		result, err := service.RemoveCustomField(ctx, 0, 99999, "field1")
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -180,7 +193,7 @@ func TestUpdateCustomFields(t *testing.T) {
 	lead := createTestLead(t, client, "Test Studio")
 
 	// Set up initial fields
-	_, err := service.SetCustomField(ctx, lead.ID, "old_field", "old_value")
+	_, err := service.SetCustomField(ctx, 0, lead.ID, "old_field", "old_value")
 	require.NoError(t, err)
 
 	t.Run("Success - Replace all fields", func(t *testing.T) {
@@ -190,7 +203,7 @@ func TestUpdateCustomFields(t *testing.T) {
 			"new_field3": true,
 		}
 
-		result, err := service.UpdateCustomFields(ctx, lead.ID, newFields)
+		result, err := service.UpdateCustomFields(ctx, 0, lead.ID, newFields)
 
 		require.NoError(t, err)
 		assert.NotNil(t, result)
@@ -204,7 +217,7 @@ func TestUpdateCustomFields(t *testing.T) {
 	})
 
 	t.Run("Success - Set to empty map", func(t *testing.T) {
-		result, err := service.UpdateCustomFields(ctx, lead.ID, map[string]interface{}{})
+		result, err := service.UpdateCustomFields(ctx, 0, lead.ID, map[string]interface{}{})
 
 		require.NoError(t, err)
 		assert.NotNil(t, result)
@@ -212,7 +225,7 @@ func TestUpdateCustomFields(t *testing.T) {
 	})
 
 	t.Run("Success - Nil map becomes empty", func(t *testing.T) {
-		result, err := service.UpdateCustomFields(ctx, lead.ID, nil)
+		result, err := service.UpdateCustomFields(ctx, 0, lead.ID, nil)
 
 		require.NoError(t, err)
 		assert.NotNil(t, result)
@@ -225,7 +238,7 @@ func TestUpdateCustomFields(t *testing.T) {
 			longKey: "value",
 		}
 
-		result, err := service.UpdateCustomFields(ctx, lead.ID, newFields)
+		result, err := service.UpdateCustomFields(ctx, 0, lead.ID, newFields)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -238,7 +251,7 @@ func TestUpdateCustomFields(t *testing.T) {
 			"valid_key": "valid_value",
 		}
 
-		result, err := service.UpdateCustomFields(ctx, lead.ID, newFields)
+		result, err := service.UpdateCustomFields(ctx, 0, lead.ID, newFields)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -246,7 +259,7 @@ func TestUpdateCustomFields(t *testing.T) {
 	})
 
 	t.Run("Error - Lead not found", func(t *testing.T) {
-		result, err := service.UpdateCustomFields(ctx, 99999, map[string]interface{}{"key": "value"})
+		result, err := service.UpdateCustomFields(ctx, 0, 99999, map[string]interface{}{"key": "value"})
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -264,13 +277,13 @@ func TestClearCustomFields(t *testing.T) {
 	lead := createTestLead(t, client, "Test Studio")
 
 	// Set up fields
-	_, err := service.SetCustomField(ctx, lead.ID, "field1", "value1")
+	_, err := service.SetCustomField(ctx, 0, lead.ID, "field1", "value1")
 	require.NoError(t, err)
-	_, err = service.SetCustomField(ctx, lead.ID, "field2", "value2")
+	_, err = service.SetCustomField(ctx, 0, lead.ID, "field2", "value2")
 	require.NoError(t, err)
 
 	t.Run("Success - Clear all fields", func(t *testing.T) {
-		result, err := service.ClearCustomFields(ctx, lead.ID)
+		result, err := service.ClearCustomFields(ctx, 0, lead.ID)
 
 		require.NoError(t, err)
 		assert.NotNil(t, result)
@@ -278,7 +291,7 @@ func TestClearCustomFields(t *testing.T) {
 	})
 
 	t.Run("Error - Lead not found", func(t *testing.T) {
-		result, err := service.ClearCustomFields(ctx, 99999)
+		result, err := service.ClearCustomFields(ctx, 0, 99999)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -305,7 +318,7 @@ func TestComplexDataTypes(t *testing.T) {
 			"contacts": []string{"john@example.com", "jane@example.com"},
 		}
 
-		result, err := service.SetCustomField(ctx, lead.ID, "contact_info", complexValue)
+		result, err := service.SetCustomField(ctx, 0, lead.ID, "contact_info", complexValue)
 
 		require.NoError(t, err)
 		assert.NotNil(t, result.CustomFields["contact_info"])
@@ -314,9 +327,70 @@ func TestComplexDataTypes(t *testing.T) {
 	t.Run("Success - Store array", func(t *testing.T) {
 		tags := []interface{}{"vip", "high-priority", "urgent"}
 
-		result, err := service.SetCustomField(ctx, lead.ID, "tags", tags)
+		result, err := service.SetCustomField(ctx, 0, lead.ID, "tags", tags)
 
 		require.NoError(t, err)
 		assert.NotNil(t, result.CustomFields["tags"])
 	})
 }
+
+func TestIndustryFieldValidation(t *testing.T) {
+	client, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	service := NewService(client)
+
+	hotel, err := client.Lead.
+		Create().
+		SetName("Grand Hotel").
+		SetIndustry("hotel").
+		SetCountry("US").
+		SetCity("Chicago").
+		Save(ctx)
+	require.NoError(t, err)
+
+	t.Run("Success - Value matches recommended field", func(t *testing.T) {
+		result, err := service.SetCustomField(ctx, 0, hotel.ID, "star_rating", float64(4))
+
+		require.NoError(t, err)
+		assert.Equal(t, float64(4), result.CustomFields["star_rating"])
+	})
+
+	t.Run("Error - Value out of range", func(t *testing.T) {
+		_, err := service.SetCustomField(ctx, 0, hotel.ID, "star_rating", float64(7))
+
+		assert.ErrorIs(t, err, ErrInvalidFieldValue)
+		assert.Contains(t, err.Error(), "at most 5")
+	})
+
+	t.Run("Error - Wrong type", func(t *testing.T) {
+		_, err := service.SetCustomField(ctx, 0, hotel.ID, "has_restaurant", "yes")
+
+		assert.ErrorIs(t, err, ErrInvalidFieldValue)
+	})
+
+	t.Run("Error - Unknown enum option in bulk update", func(t *testing.T) {
+		_, err := service.UpdateCustomFields(ctx, 0, hotel.ID, map[string]interface{}{
+			"room_count":      float64(120),
+			"booking_channel": "fax",
+		})
+
+		assert.ErrorIs(t, err, ErrInvalidFieldValue)
+	})
+
+	t.Run("Success - Keys without a recommended field accept any value", func(t *testing.T) {
+		result, err := service.SetCustomField(ctx, 0, hotel.ID, "account_manager", float64(42))
+
+		require.NoError(t, err)
+		assert.Equal(t, float64(42), result.CustomFields["account_manager"])
+	})
+
+	t.Run("Success - Same key is unconstrained for other industries", func(t *testing.T) {
+		studio := createTestLead(t, client, "Ink Studio")
+
+		_, err := service.SetCustomField(ctx, 0, studio.ID, "star_rating", "five")
+
+		require.NoError(t, err)
+	})
+}
@@ -3,6 +3,7 @@ package database
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"log"
 	"net/url"
@@ -135,7 +136,7 @@ func NewClientWithPoolAndSSL(databaseURL string, poolCfg PoolConfig, sslCfg *SSL
 
 	// Create Ent client from the configured sql.DB
 	drv := entsql.OpenDB(dialect.Postgres, db)
-	client := ent.NewClient(ent.Driver(drv))
+	client := ent.NewClient(ent.Driver(WithSlowQueryLog(drv)))
 
 	// Run migrations
 	if err := client.Schema.Create(context.Background()); err != nil {
@@ -166,3 +167,27 @@ func (c *Client) Ping(ctx context.Context) error {
 func (c *Client) Stats() sql.DBStats {
 	return c.db.Stats()
 }
+
+// EstimateRows returns the planner's row estimate for a query. EXPLAIN
+// reads table statistics instead of running the query, so it stays fast on
+// large tables but can be off after bulk changes until ANALYZE runs.
+func (c *Client) EstimateRows(ctx context.Context, query string, args ...interface{}) (int, error) {
+	var plan []byte
+	if err := c.db.QueryRowContext(ctx, "EXPLAIN (FORMAT JSON) "+query, args...).Scan(&plan); err != nil {
+		return 0, fmt.Errorf("failed to explain query: %w", err)
+	}
+
+	var explain []struct {
+		Plan struct {
+			Rows float64 `json:"Plan Rows"`
+		} `json:"Plan"`
+	}
+	if err := json.Unmarshal(plan, &explain); err != nil {
+		return 0, fmt.Errorf("failed to parse query plan: %w", err)
+	}
+	if len(explain) == 0 {
+		return 0, fmt.Errorf("failed to parse query plan: empty plan")
+	}
+
+	return int(explain[0].Plan.Rows), nil
+}
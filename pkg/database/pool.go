@@ -0,0 +1,50 @@
+package database
+
+import "time"
+
+// PoolConfigFor returns the connection pool defaults for an API environment
+// (API_ENVIRONMENT). Development keeps a small pool next to a local
+// database, production allows more connections per instance and recycles
+// them less often, any other environment gets DefaultPoolConfig.
+func PoolConfigFor(environment string) PoolConfig {
+	switch environment {
+	case "development":
+		return PoolConfig{
+			MaxOpenConns:    10,
+			MaxIdleConns:    2,
+			ConnMaxLifetime: 5 * time.Minute,
+			ConnMaxIdleTime: 10 * time.Minute,
+		}
+	case "production":
+		return PoolConfig{
+			MaxOpenConns:    40,
+			MaxIdleConns:    10,
+			ConnMaxLifetime: 30 * time.Minute,
+			ConnMaxIdleTime: 5 * time.Minute,
+		}
+	default:
+		return DefaultPoolConfig()
+	}
+}
+
+// Override returns the pool configuration with the positive fields of
+// overrides replacing its own. Max idle connections are capped at max open
+// connections, as database/sql does.
+func (p PoolConfig) Override(overrides PoolConfig) PoolConfig {
+	if overrides.MaxOpenConns > 0 {
+		p.MaxOpenConns = overrides.MaxOpenConns
+	}
+	if overrides.MaxIdleConns > 0 {
+		p.MaxIdleConns = overrides.MaxIdleConns
+	}
+	if overrides.ConnMaxLifetime > 0 {
+		p.ConnMaxLifetime = overrides.ConnMaxLifetime
+	}
+	if overrides.ConnMaxIdleTime > 0 {
+		p.ConnMaxIdleTime = overrides.ConnMaxIdleTime
+	}
+	if p.MaxOpenConns > 0 && p.MaxIdleConns > p.MaxOpenConns {
+		p.MaxIdleConns = p.MaxOpenConns
+	}
+	return p
+}
@@ -0,0 +1,44 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPoolConfigFor(t *testing.T) {
+	assert.Equal(t, 10, PoolConfigFor("development").MaxOpenConns)
+	assert.Equal(t, 40, PoolConfigFor("production").MaxOpenConns)
+	assert.Equal(t, DefaultPoolConfig(), PoolConfigFor("staging"))
+	assert.Equal(t, DefaultPoolConfig(), PoolConfigFor(""))
+
+	for _, env := range []string{"development", "staging", "production"} {
+		cfg := PoolConfigFor(env)
+		assert.LessOrEqual(t, cfg.MaxIdleConns, cfg.MaxOpenConns, env)
+	}
+}
+
+func TestPoolConfigOverride(t *testing.T) {
+	base := DefaultPoolConfig()
+
+	t.Run("zero values keep the defaults", func(t *testing.T) {
+		assert.Equal(t, base, base.Override(PoolConfig{}))
+	})
+
+	t.Run("positive values replace the defaults", func(t *testing.T) {
+		cfg := base.Override(PoolConfig{MaxOpenConns: 60, ConnMaxLifetime: time.Hour})
+		assert.Equal(t, 60, cfg.MaxOpenConns)
+		assert.Equal(t, base.MaxIdleConns, cfg.MaxIdleConns)
+		assert.Equal(t, time.Hour, cfg.ConnMaxLifetime)
+		assert.Equal(t, base.ConnMaxIdleTime, cfg.ConnMaxIdleTime)
+	})
+
+	t.Run("idle connections are capped at open connections", func(t *testing.T) {
+		cfg := base.Override(PoolConfig{MaxOpenConns: 4})
+		assert.Equal(t, 4, cfg.MaxIdleConns)
+
+		cfg = base.Override(PoolConfig{MaxIdleConns: 100})
+		assert.Equal(t, base.MaxOpenConns, cfg.MaxIdleConns)
+	})
+}
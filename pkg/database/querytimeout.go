@@ -0,0 +1,53 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// DefaultQueryTimeout bounds the queries of a request on the main query paths
+// (search, analytics, funnel and cohort reports) until SetQueryTimeout is
+// called
+const DefaultQueryTimeout = 15 * time.Second
+
+// queryTimeout holds the timeout used by WithQueryTimeout
+var queryTimeout = DefaultQueryTimeout
+
+// queryCanceledCode is the PostgreSQL error code of a statement canceled by
+// a context or statement_timeout
+const queryCanceledCode = "57014"
+
+// SetQueryTimeout replaces the timeout used by WithQueryTimeout. A
+// non-positive timeout keeps the default. It is meant to be called once at
+// startup from configuration.
+func SetQueryTimeout(timeout time.Duration) {
+	if timeout <= 0 {
+		timeout = DefaultQueryTimeout
+	}
+	queryTimeout = timeout
+}
+
+// QueryTimeout returns the timeout used by WithQueryTimeout
+func QueryTimeout() time.Duration {
+	return queryTimeout
+}
+
+// WithQueryTimeout returns a copy of ctx canceled after the query timeout.
+// Queries run with it are canceled on the server when it expires, so one
+// expensive query can't hold a pooled connection indefinitely.
+func WithQueryTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, queryTimeout)
+}
+
+// IsQueryTimeout reports whether err comes from a query that ran out of
+// time, either its context deadline or a statement canceled by PostgreSQL
+func IsQueryTimeout(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == queryCanceledCode
+}
@@ -0,0 +1,53 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithQueryTimeout(t *testing.T) {
+	defer SetQueryTimeout(0)
+
+	SetQueryTimeout(50 * time.Millisecond)
+	assert.Equal(t, 50*time.Millisecond, QueryTimeout())
+
+	ctx, cancel := WithQueryTimeout(context.Background())
+	defer cancel()
+	deadline, ok := ctx.Deadline()
+	assert.True(t, ok)
+	assert.WithinDuration(t, time.Now().Add(50*time.Millisecond), deadline, 20*time.Millisecond)
+
+	<-ctx.Done()
+	assert.True(t, IsQueryTimeout(fmt.Errorf("failed to query leads: %w", ctx.Err())))
+
+	// Non-positive timeouts keep the default
+	SetQueryTimeout(0)
+	assert.Equal(t, DefaultQueryTimeout, QueryTimeout())
+}
+
+func TestIsQueryTimeout(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"deadline", context.DeadlineExceeded, true},
+		{"wrapped deadline", fmt.Errorf("failed to count signups: %w", context.DeadlineExceeded), true},
+		{"statement canceled", fmt.Errorf("failed to query leads: %w", &pq.Error{Code: "57014"}), true},
+		{"other postgres error", &pq.Error{Code: "42P01"}, false},
+		{"canceled request", context.Canceled, false},
+		{"other error", errors.New("connection refused"), false},
+		{"nil", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsQueryTimeout(tt.err))
+		})
+	}
+}
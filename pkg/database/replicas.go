@@ -133,7 +133,7 @@ func (c *ClientWithReplicas) connectReplica(replicaURL string, poolCfg PoolConfi
 
 	// Create Ent client
 	drv := entsql.OpenDB(dialect.Postgres, db)
-	entClient := ent.NewClient(ent.Driver(drv))
+	entClient := ent.NewClient(ent.Driver(WithSlowQueryLog(drv)))
 
 	// Test connection
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -0,0 +1,133 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/jordanlanch/industrydb/pkg/logger"
+)
+
+// DefaultSlowQueryThreshold is the duration above which queries are logged
+// until SetSlowQueryThreshold is called
+const DefaultSlowQueryThreshold = time.Second
+
+// maxLoggedQueryLength caps the SQL text of a slow query log line
+const maxLoggedQueryLength = 1000
+
+// slowQueryThreshold holds the threshold used by the slow query log, zero
+// disables it
+var slowQueryThreshold = DefaultSlowQueryThreshold
+
+// slowQueryLogf writes slow query log lines, replaced in tests
+var slowQueryLogf = log.Printf
+
+// SetSlowQueryThreshold replaces the duration above which queries are
+// logged. Zero disables the slow query log, a negative value keeps the
+// default. It is meant to be called once at startup from configuration.
+func SetSlowQueryThreshold(threshold time.Duration) {
+	if threshold < 0 {
+		threshold = DefaultSlowQueryThreshold
+	}
+	slowQueryThreshold = threshold
+}
+
+// WithSlowQueryLog wraps an ent driver so queries, including those run in
+// transactions, that take longer than the slow query threshold are logged
+// with the ID of the request that ran them. Query arguments are left out of
+// the log, as they carry user data.
+func WithSlowQueryLog(drv dialect.Driver) dialect.Driver {
+	return &slowQueryDriver{Driver: drv}
+}
+
+type slowQueryDriver struct {
+	dialect.Driver
+}
+
+// Exec times the underlying driver Exec
+func (d *slowQueryDriver) Exec(ctx context.Context, query string, args, v any) error {
+	start := time.Now()
+	err := d.Driver.Exec(ctx, query, args, v)
+	logSlowQuery(ctx, query, time.Since(start), err)
+	return err
+}
+
+// Query times the underlying driver Query
+func (d *slowQueryDriver) Query(ctx context.Context, query string, args, v any) error {
+	start := time.Now()
+	err := d.Driver.Query(ctx, query, args, v)
+	logSlowQuery(ctx, query, time.Since(start), err)
+	return err
+}
+
+// Tx starts a transaction whose queries are timed
+func (d *slowQueryDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &slowQueryTx{Tx: tx}, nil
+}
+
+// BeginTx starts a transaction with options, if the underlying driver
+// supports them, whose queries are timed
+func (d *slowQueryDriver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.BeginTx is not supported")
+	}
+	tx, err := drv.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &slowQueryTx{Tx: tx}, nil
+}
+
+type slowQueryTx struct {
+	dialect.Tx
+}
+
+// Exec times the underlying transaction Exec
+func (t *slowQueryTx) Exec(ctx context.Context, query string, args, v any) error {
+	start := time.Now()
+	err := t.Tx.Exec(ctx, query, args, v)
+	logSlowQuery(ctx, query, time.Since(start), err)
+	return err
+}
+
+// Query times the underlying transaction Query
+func (t *slowQueryTx) Query(ctx context.Context, query string, args, v any) error {
+	start := time.Now()
+	err := t.Tx.Query(ctx, query, args, v)
+	logSlowQuery(ctx, query, time.Since(start), err)
+	return err
+}
+
+// logSlowQuery logs a query that took longer than the threshold
+func logSlowQuery(ctx context.Context, query string, elapsed time.Duration, err error) {
+	threshold := slowQueryThreshold
+	if threshold <= 0 || elapsed < threshold {
+		return
+	}
+
+	requestID := logger.RequestIDFromContext(ctx)
+	if requestID == "" {
+		requestID = "-"
+	}
+	if len(query) > maxLoggedQueryLength {
+		query = query[:maxLoggedQueryLength] + "..."
+	}
+	query = strings.Join(strings.Fields(query), " ")
+
+	if err != nil {
+		slowQueryLogf("🐢 Slow query (%s, request_id=%s, error=%v): %s", elapsed.Round(time.Millisecond), requestID, err, query)
+		return
+	}
+	slowQueryLogf("🐢 Slow query (%s, request_id=%s): %s", elapsed.Round(time.Millisecond), requestID, query)
+}
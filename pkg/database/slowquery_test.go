@@ -0,0 +1,102 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/jordanlanch/industrydb/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// delayDriver is a driver whose statements take a fixed time
+type delayDriver struct {
+	dialect.Driver
+	delay time.Duration
+	err   error
+}
+
+func (d *delayDriver) Exec(ctx context.Context, query string, args, v any) error {
+	time.Sleep(d.delay)
+	return d.err
+}
+
+func (d *delayDriver) Query(ctx context.Context, query string, args, v any) error {
+	time.Sleep(d.delay)
+	return d.err
+}
+
+func (d *delayDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	return dialect.NopTx(d), nil
+}
+
+func captureSlowQueries(t *testing.T, threshold time.Duration) *[]string {
+	t.Helper()
+	var logged []string
+	originalLogf, originalThreshold := slowQueryLogf, slowQueryThreshold
+	t.Cleanup(func() { slowQueryLogf, slowQueryThreshold = originalLogf, originalThreshold })
+
+	slowQueryLogf = func(format string, args ...any) { logged = append(logged, fmt.Sprintf(format, args...)) }
+	SetSlowQueryThreshold(threshold)
+	return &logged
+}
+
+func TestSlowQueryLog(t *testing.T) {
+	logged := captureSlowQueries(t, 10*time.Millisecond)
+	ctx := logger.WithRequestID(context.Background(), "req-123")
+
+	slow := WithSlowQueryLog(&delayDriver{delay: 20 * time.Millisecond})
+	fast := WithSlowQueryLog(&delayDriver{})
+
+	require.NoError(t, fast.Query(ctx, "SELECT 1", []any{}, nil))
+	assert.Empty(t, *logged)
+
+	require.NoError(t, slow.Query(ctx, "SELECT *\n  FROM leads WHERE email = $1", []any{"secret@example.com"}, nil))
+	require.Len(t, *logged, 1)
+	line := (*logged)[0]
+	assert.Contains(t, line, "request_id=req-123")
+	assert.Contains(t, line, "SELECT * FROM leads WHERE email = $1")
+	assert.NotContains(t, line, "secret@example.com")
+
+	t.Run("without a request", func(t *testing.T) {
+		require.NoError(t, slow.Exec(context.Background(), "UPDATE leads SET verified = true", []any{}, nil))
+		assert.Contains(t, (*logged)[len(*logged)-1], "request_id=-")
+	})
+
+	t.Run("failed queries", func(t *testing.T) {
+		failing := WithSlowQueryLog(&delayDriver{delay: 20 * time.Millisecond, err: context.DeadlineExceeded})
+		err := failing.Query(ctx, "SELECT 1", []any{}, nil)
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+		assert.Contains(t, (*logged)[len(*logged)-1], "error=context deadline exceeded")
+	})
+
+	t.Run("in transactions", func(t *testing.T) {
+		before := len(*logged)
+		tx, err := slow.Tx(ctx)
+		require.NoError(t, err)
+		require.NoError(t, tx.Exec(ctx, "DELETE FROM leads", []any{}, nil))
+		assert.Len(t, *logged, before+1)
+	})
+
+	t.Run("long queries are truncated", func(t *testing.T) {
+		require.NoError(t, slow.Query(ctx, "SELECT "+strings.Repeat("x", 2*maxLoggedQueryLength), []any{}, nil))
+		assert.Less(t, len((*logged)[len(*logged)-1]), maxLoggedQueryLength+100)
+	})
+}
+
+func TestSlowQueryLog_Disabled(t *testing.T) {
+	logged := captureSlowQueries(t, 0)
+
+	slow := WithSlowQueryLog(&delayDriver{delay: 5 * time.Millisecond})
+	require.NoError(t, slow.Query(context.Background(), "SELECT 1", []any{}, nil))
+	assert.Empty(t, *logged)
+
+	// Negative thresholds keep the default
+	SetSlowQueryThreshold(-1)
+	assert.Equal(t, DefaultSlowQueryThreshold, slowQueryThreshold)
+}
@@ -0,0 +1,85 @@
+// Package deliverywindow restricts outbound deliveries (webhooks, sequence
+// emails) to allowed local hours. Deliveries outside the window are
+// deferred to its next opening, never dropped.
+package deliverywindow
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrInvalidWindow is returned for an unknown timezone or out-of-range hours
+var ErrInvalidWindow = errors.New("invalid delivery window")
+
+// Window allows deliveries from StartHour (inclusive) to EndHour (exclusive)
+// in Timezone. An EndHour before StartHour wraps past midnight, so 22-6
+// allows deliveries overnight. A nil *Window allows deliveries 24/7.
+type Window struct {
+	Timezone  string `json:"timezone"`   // IANA name, empty = UTC
+	StartHour int    `json:"start_hour"` // 0-23
+	EndHour   int    `json:"end_hour"`   // 1-24
+}
+
+// FromFields builds a window from stored fields. It returns nil (24/7) when
+// the hours are unset.
+func FromFields(timezone string, startHour, endHour *int) *Window {
+	if startHour == nil || endHour == nil {
+		return nil
+	}
+	return &Window{Timezone: timezone, StartHour: *startHour, EndHour: *endHour}
+}
+
+// Validate checks the timezone and hours
+func (w *Window) Validate() error {
+	if w == nil {
+		return nil
+	}
+	if _, err := time.LoadLocation(w.Timezone); err != nil {
+		return fmt.Errorf("%w: unknown timezone %q", ErrInvalidWindow, w.Timezone)
+	}
+	if w.StartHour < 0 || w.StartHour > 23 {
+		return fmt.Errorf("%w: start_hour must be between 0 and 23", ErrInvalidWindow)
+	}
+	if w.EndHour < 1 || w.EndHour > 24 {
+		return fmt.Errorf("%w: end_hour must be between 1 and 24", ErrInvalidWindow)
+	}
+	if w.StartHour == w.EndHour {
+		return fmt.Errorf("%w: start_hour and end_hour must differ", ErrInvalidWindow)
+	}
+	return nil
+}
+
+// Open reports whether deliveries are allowed at t
+func (w *Window) Open(t time.Time) bool {
+	if w == nil {
+		return true
+	}
+	hour := t.In(w.location()).Hour()
+	if w.StartHour < w.EndHour {
+		return hour >= w.StartHour && hour < w.EndHour
+	}
+	return hour >= w.StartHour || hour < w.EndHour
+}
+
+// Next returns t if the window is open at t, otherwise the start of the
+// next opening
+func (w *Window) Next(t time.Time) time.Time {
+	if w.Open(t) {
+		return t
+	}
+	local := t.In(w.location())
+	start := time.Date(local.Year(), local.Month(), local.Day(), w.StartHour, 0, 0, 0, local.Location())
+	if !start.After(local) {
+		start = time.Date(local.Year(), local.Month(), local.Day()+1, w.StartHour, 0, 0, 0, local.Location())
+	}
+	return start
+}
+
+func (w *Window) location() *time.Location {
+	loc, err := time.LoadLocation(w.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
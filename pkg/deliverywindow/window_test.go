@@ -0,0 +1,63 @@
+package deliverywindow
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWindow_Validate(t *testing.T) {
+	var always *Window
+	assert.NoError(t, always.Validate())
+	assert.NoError(t, (&Window{Timezone: "America/New_York", StartHour: 9, EndHour: 17}).Validate())
+	assert.NoError(t, (&Window{StartHour: 22, EndHour: 6}).Validate())
+
+	for _, w := range []Window{
+		{Timezone: "Mars/Olympus", StartHour: 9, EndHour: 17},
+		{StartHour: -1, EndHour: 17},
+		{StartHour: 9, EndHour: 25},
+		{StartHour: 9, EndHour: 9},
+	} {
+		assert.ErrorIs(t, w.Validate(), ErrInvalidWindow, "%+v", w)
+	}
+}
+
+func TestWindow_Open(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+
+	var always *Window
+	assert.True(t, always.Open(time.Date(2026, 3, 2, 3, 0, 0, 0, ny)))
+
+	business := &Window{Timezone: "America/New_York", StartHour: 9, EndHour: 17}
+	assert.False(t, business.Open(time.Date(2026, 3, 2, 3, 0, 0, 0, ny)))
+	assert.True(t, business.Open(time.Date(2026, 3, 2, 9, 0, 0, 0, ny)))
+	assert.True(t, business.Open(time.Date(2026, 3, 2, 16, 59, 0, 0, ny)))
+	assert.False(t, business.Open(time.Date(2026, 3, 2, 17, 0, 0, 0, ny)))
+	// 14:00 UTC is 09:00 in New York (EST)
+	assert.True(t, business.Open(time.Date(2026, 3, 2, 14, 0, 0, 0, time.UTC)))
+
+	overnight := &Window{StartHour: 22, EndHour: 6}
+	assert.True(t, overnight.Open(time.Date(2026, 3, 2, 23, 0, 0, 0, time.UTC)))
+	assert.True(t, overnight.Open(time.Date(2026, 3, 2, 5, 0, 0, 0, time.UTC)))
+	assert.False(t, overnight.Open(time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)))
+}
+
+func TestWindow_Next(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+	business := &Window{Timezone: "America/New_York", StartHour: 9, EndHour: 17}
+
+	open := time.Date(2026, 3, 2, 10, 30, 0, 0, ny)
+	assert.Equal(t, open, business.Next(open))
+
+	early := time.Date(2026, 3, 2, 3, 0, 0, 0, ny)
+	assert.True(t, time.Date(2026, 3, 2, 9, 0, 0, 0, ny).Equal(business.Next(early)))
+
+	late := time.Date(2026, 3, 2, 18, 0, 0, 0, ny)
+	assert.True(t, time.Date(2026, 3, 3, 9, 0, 0, 0, ny).Equal(business.Next(late)))
+
+	var always *Window
+	assert.Equal(t, late, always.Next(late))
+}
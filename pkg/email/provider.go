@@ -0,0 +1,173 @@
+package email
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/sendgrid/sendgrid-go"
+	"github.com/sendgrid/sendgrid-go/helpers/mail"
+)
+
+// Message is a single email to deliver
+type Message struct {
+	FromEmail string
+	FromName  string
+	ToEmail   string
+	ToName    string
+	Subject   string
+	HTMLBody  string
+	TextBody  string
+}
+
+// SendResult is a provider's response to a send attempt
+type SendResult struct {
+	Provider   string // Provider that made the attempt
+	StatusCode int    // 0 when the request never got a response
+	MessageID  string // Provider message ID
+}
+
+// EmailProvider delivers email through an email service (SendGrid, SES).
+// Send returns an error for any attempt the provider did not accept; the
+// status code in the result tells transient failures (0, 429, 5xx) apart.
+type EmailProvider interface {
+	Name() string
+	Send(ctx context.Context, msg Message) (SendResult, error)
+}
+
+// ProviderConfig holds the settings needed to build any provider
+type ProviderConfig struct {
+	SendGridAPIKey string
+	SES            SESConfig
+}
+
+// NewProvider builds a provider by name ("sendgrid" or "ses")
+func NewProvider(name string, cfg ProviderConfig) (EmailProvider, error) {
+	switch name {
+	case "sendgrid":
+		if cfg.SendGridAPIKey == "" {
+			return nil, fmt.Errorf("sendgrid provider requires SENDGRID_API_KEY")
+		}
+		return NewSendGridProvider(cfg.SendGridAPIKey), nil
+	case "ses":
+		if cfg.SES.AWSRegion == "" {
+			return nil, fmt.Errorf("ses provider requires an AWS region")
+		}
+		return NewSESProvider(cfg.SES)
+	default:
+		return nil, fmt.Errorf("unknown email provider %q (use sendgrid or ses)", name)
+	}
+}
+
+// ConfigureProviders builds and sets the primary and optional fallback
+// providers by name. On error the current providers are kept.
+func (s *Service) ConfigureProviders(primary, fallback string, cfg ProviderConfig) error {
+	primaryProvider, err := NewProvider(primary, cfg)
+	if err != nil {
+		return fmt.Errorf("primary email provider: %w", err)
+	}
+
+	var fallbackProvider EmailProvider
+	if fallback != "" && fallback != primary {
+		fallbackProvider, err = NewProvider(fallback, cfg)
+		if err != nil {
+			return fmt.Errorf("fallback email provider: %w", err)
+		}
+	}
+
+	s.SetProviders(primaryProvider, fallbackProvider)
+	if fallbackProvider != nil {
+		log.Printf("✅ Email providers: %s (fallback: %s)", primaryProvider.Name(), fallbackProvider.Name())
+	} else {
+		log.Printf("✅ Email provider: %s", primaryProvider.Name())
+	}
+	return nil
+}
+
+// SetProviders replaces the delivery providers. Emails go through primary;
+// if it fails, fallback is tried and the switch is logged. fallback may be
+// nil. A nil primary switches the service to console-only mode.
+func (s *Service) SetProviders(primary, fallback EmailProvider) {
+	s.providers = nil
+	if primary == nil {
+		return
+	}
+	s.providers = append(s.providers, primary)
+	if fallback != nil {
+		s.providers = append(s.providers, fallback)
+	}
+}
+
+// send delivers an email through the first provider that accepts it. The
+// result and error of the last attempt are returned when every provider fails.
+func (s *Service) send(ctx context.Context, toEmail, toName, subject, htmlBody, plainTextBody string) (SendResult, error) {
+	msg := Message{
+		FromEmail: s.fromEmail,
+		FromName:  s.fromName,
+		ToEmail:   toEmail,
+		ToName:    toName,
+		Subject:   subject,
+		HTMLBody:  htmlBody,
+		TextBody:  plainTextBody,
+	}
+
+	var result SendResult
+	var err error
+	for i, provider := range s.providers {
+		if i > 0 {
+			log.Printf("⚠️  Email provider %s failed, falling back to %s: %v", s.providers[i-1].Name(), provider.Name(), err)
+		}
+
+		result, err = provider.Send(ctx, msg)
+		result.Provider = provider.Name()
+		if err == nil {
+			return result, nil
+		}
+	}
+
+	return result, err
+}
+
+// SendGridProvider delivers email through the SendGrid API
+type SendGridProvider struct {
+	apiKey string
+}
+
+// NewSendGridProvider creates a SendGrid provider
+func NewSendGridProvider(apiKey string) *SendGridProvider {
+	return &SendGridProvider{apiKey: apiKey}
+}
+
+// Name returns the provider name
+func (p *SendGridProvider) Name() string {
+	return "sendgrid"
+}
+
+// Send sends email using SendGrid API
+func (p *SendGridProvider) Send(ctx context.Context, msg Message) (SendResult, error) {
+	from := mail.NewEmail(msg.FromName, msg.FromEmail)
+	to := mail.NewEmail(msg.ToName, msg.ToEmail)
+
+	message := mail.NewSingleEmail(from, msg.Subject, to, msg.TextBody, msg.HTMLBody)
+
+	client := sendgrid.NewSendClient(p.apiKey)
+	response, err := client.SendWithContext(ctx, message)
+
+	if err != nil {
+		log.Printf("❌ SendGrid error: %v", err)
+		return SendResult{}, fmt.Errorf("failed to send email: %w", err)
+	}
+
+	result := SendResult{StatusCode: response.StatusCode}
+	if ids := response.Headers["X-Message-Id"]; len(ids) > 0 {
+		result.MessageID = ids[0]
+	}
+
+	if response.StatusCode >= 400 {
+		log.Printf("❌ SendGrid returned error status %d: %s", response.StatusCode, response.Body)
+		return result, fmt.Errorf("sendgrid returned error status: %d", response.StatusCode)
+	}
+
+	log.Printf("✅ Email sent successfully to %s (SendGrid status: %d)", msg.ToEmail, response.StatusCode)
+	return result, nil
+}
@@ -0,0 +1,169 @@
+package email
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockProvider returns queued responses in order, then succeeds
+type mockProvider struct {
+	name      string
+	responses []mockResponse
+	calls     int
+	sent      []Message
+}
+
+type mockResponse struct {
+	statusCode int
+	err        error
+}
+
+func (m *mockProvider) Name() string {
+	return m.name
+}
+
+func (m *mockProvider) Send(ctx context.Context, msg Message) (SendResult, error) {
+	m.calls++
+	m.sent = append(m.sent, msg)
+	if len(m.responses) == 0 {
+		// Provider message IDs contain no dots
+		return SendResult{StatusCode: 202, MessageID: fmt.Sprintf("msg%d", m.calls)}, nil
+	}
+	r := m.responses[0]
+	m.responses = m.responses[1:]
+	return SendResult{StatusCode: r.statusCode}, r.err
+}
+
+func TestSend_PrimaryProvider(t *testing.T) {
+	primary := &mockProvider{name: "sendgrid"}
+	fallback := &mockProvider{name: "ses"}
+	svc := NewService("from@example.com", "IndustryDB", "https://app.industrydb.io", "")
+	svc.SetProviders(primary, fallback)
+
+	require.NoError(t, svc.SendVerificationEmail("user@example.com", "Test User", "abc123"))
+
+	assert.Equal(t, 1, primary.calls)
+	assert.Equal(t, 0, fallback.calls, "fallback is only used when the primary fails")
+	require.Len(t, primary.sent, 1)
+	assert.Equal(t, "from@example.com", primary.sent[0].FromEmail)
+	assert.Equal(t, "user@example.com", primary.sent[0].ToEmail)
+	assert.Equal(t, "Verify your IndustryDB account", primary.sent[0].Subject)
+	assert.Contains(t, primary.sent[0].HTMLBody, "/verify-email/abc123")
+}
+
+func TestSend_FallsBackWhenPrimaryFails(t *testing.T) {
+	primary := &mockProvider{name: "sendgrid", responses: []mockResponse{{statusCode: 503, err: errors.New("sendgrid returned error status: 503")}}}
+	fallback := &mockProvider{name: "ses"}
+	svc := NewService("from@example.com", "IndustryDB", "https://app.industrydb.io", "")
+	svc.SetProviders(primary, fallback)
+
+	result, err := svc.send(context.Background(), "user@example.com", "Test User", "Hello", "<p>Hi</p>", "Hi")
+	require.NoError(t, err)
+	assert.Equal(t, "ses", result.Provider)
+	assert.Equal(t, "msg1", result.MessageID)
+	assert.Equal(t, 1, primary.calls)
+	assert.Equal(t, 1, fallback.calls)
+	assert.Equal(t, primary.sent, fallback.sent)
+}
+
+func TestSend_AllProvidersFail(t *testing.T) {
+	primary := &mockProvider{name: "sendgrid", responses: []mockResponse{{statusCode: 401, err: errors.New("sendgrid returned error status: 401")}}}
+	fallback := &mockProvider{name: "ses", responses: []mockResponse{{statusCode: 500, err: errors.New("ses returned error status: 500")}}}
+	svc := NewService("from@example.com", "IndustryDB", "https://app.industrydb.io", "")
+	svc.SetProviders(primary, fallback)
+
+	result, err := svc.send(context.Background(), "user@example.com", "Test User", "Hello", "<p>Hi</p>", "Hi")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ses returned error status: 500")
+	assert.Equal(t, "ses", result.Provider)
+	assert.Equal(t, 500, result.StatusCode, "the last attempt decides whether to retry")
+}
+
+func TestSend_TrackedFallback(t *testing.T) {
+	client, svc, primary := setupTrackingTest(t, mockResponse{err: errors.New("connection refused")})
+	fallback := &mockProvider{name: "ses"}
+	svc.SetProviders(primary, fallback)
+
+	require.NoError(t, svc.SendWelcomeEmail("user@example.com", "Test User"))
+
+	record, err := client.EmailSend.Query().Only(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "sent", string(record.Status))
+	assert.Equal(t, "ses", record.Provider)
+}
+
+func TestSetProviders_ConsoleMode(t *testing.T) {
+	svc := NewService("from@example.com", "IndustryDB", "https://app.industrydb.io", "SG.test-key")
+	svc.SetProviders(nil, nil)
+
+	assert.Empty(t, svc.providers)
+	assert.NoError(t, svc.SendWelcomeEmail("user@example.com", "Test User"))
+}
+
+func TestSESProvider_Send(t *testing.T) {
+	var got sesSendEmailRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v2/email/outbound-emails", r.URL.Path)
+		assert.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/"))
+		assert.Contains(t, r.Header.Get("Authorization"), "/us-west-2/ses/aws4_request")
+		assert.NotEmpty(t, r.Header.Get("X-Amz-Date"))
+
+		body, _ := io.ReadAll(r.Body)
+		require.NoError(t, json.Unmarshal(body, &got))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"MessageId":"0100018b-ses-id"}`))
+	}))
+	defer server.Close()
+
+	provider, err := NewSESProvider(SESConfig{AWSAccessKeyID: "AKIDEXAMPLE", AWSSecretAccessKey: "secret", AWSRegion: "us-west-2"})
+	require.NoError(t, err)
+	provider.endpoint = server.URL
+
+	result, err := provider.Send(context.Background(), Message{
+		FromEmail: "noreply@industrydb.io",
+		FromName:  "IndustryDB",
+		ToEmail:   "user@example.com",
+		ToName:    "Test User",
+		Subject:   "Hello",
+		HTMLBody:  "<p>Hi</p>",
+		TextBody:  "Hi",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 200, result.StatusCode)
+	assert.Equal(t, "0100018b-ses-id", result.MessageID)
+
+	assert.Equal(t, `"IndustryDB" <noreply@industrydb.io>`, got.FromEmailAddress)
+	assert.Equal(t, []string{`"Test User" <user@example.com>`}, got.Destination.ToAddresses)
+	assert.Equal(t, "Hello", got.Content.Simple.Subject.Data)
+	assert.Equal(t, "<p>Hi</p>", got.Content.Simple.Body.HTML.Data)
+	assert.Equal(t, "Hi", got.Content.Simple.Body.Text.Data)
+}
+
+func TestSESProvider_SendError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("x-amzn-ErrorType", "TooManyRequestsException")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"message":"Maximum sending rate exceeded."}`))
+	}))
+	defer server.Close()
+
+	provider, err := NewSESProvider(SESConfig{AWSAccessKeyID: "AKIDEXAMPLE", AWSSecretAccessKey: "secret", AWSRegion: "us-east-1"})
+	require.NoError(t, err)
+	provider.endpoint = server.URL
+
+	result, err := provider.Send(context.Background(), Message{FromEmail: "noreply@industrydb.io", ToEmail: "user@example.com", Subject: "Hello", TextBody: "Hi"})
+	require.Error(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, result.StatusCode)
+	assert.True(t, isTransient(result.StatusCode))
+}
@@ -4,8 +4,7 @@ import (
 	"fmt"
 	"log"
 
-	"github.com/sendgrid/sendgrid-go"
-	"github.com/sendgrid/sendgrid-go/helpers/mail"
+	"github.com/jordanlanch/industrydb/ent"
 )
 
 // Service handles email sending
@@ -15,6 +14,13 @@ type Service struct {
 	baseURL       string
 	sendGridKey   string
 	useSendGrid   bool
+	templates     *TemplateStore
+
+	// Delivery providers, tried in order (primary, then fallback)
+	providers []EmailProvider
+
+	// Send tracking (enabled by SetDB)
+	db *ent.Client
 }
 
 // NewService creates a new email service
@@ -28,199 +34,101 @@ func NewService(fromEmail, fromName, baseURL, sendGridAPIKey string) *Service {
 		log.Printf("⚠️  Email service in console-only mode (set SENDGRID_API_KEY for production)")
 	}
 
-	return &Service{
+	s := &Service{
 		fromEmail:   fromEmail,
 		fromName:    fromName,
 		baseURL:     baseURL,
 		sendGridKey: sendGridAPIKey,
 		useSendGrid: useSendGrid,
+		templates:   NewTemplateStore(""),
+	}
+	if useSendGrid {
+		s.providers = []EmailProvider{NewSendGridProvider(sendGridAPIKey)}
 	}
+	return s
 }
 
 // SendVerificationEmail sends an email verification link
 func (s *Service) SendVerificationEmail(toEmail, toName, token string) error {
 	verificationURL := fmt.Sprintf("%s/verify-email/%s", s.baseURL, token)
 
-	subject := "Verify your IndustryDB account"
-	body := fmt.Sprintf(`
-		<html>
-		<body>
-			<h2>Welcome to IndustryDB!</h2>
-			<p>Hi %s,</p>
-			<p>Thank you for registering with IndustryDB. Please verify your email address by clicking the button below:</p>
-			<p><a href="%s" style="background-color: #4CAF50; color: white; padding: 14px 20px; text-decoration: none; border-radius: 4px; display: inline-block;">Verify Email</a></p>
-			<p>Or copy and paste this link into your browser:</p>
-			<p><a href="%s">%s</a></p>
-			<p><strong>This link will expire in 24 hours.</strong></p>
-			<p>If you didn't create an account, you can safely ignore this email.</p>
-			<p>Thanks,<br>The IndustryDB Team</p>
-		</body>
-		</html>
-	`, toName, verificationURL, verificationURL, verificationURL)
-
-	plainText := fmt.Sprintf(`
-Hi %s,
-
-Welcome to IndustryDB! Please verify your email address by clicking the link below:
-
-%s
-
-This link will expire in 24 hours.
-
-If you didn't create an account, you can safely ignore this email.
-
-Thanks,
-The IndustryDB Team
-	`, toName, verificationURL)
-
-	if s.useSendGrid {
-		return s.sendViaSendGrid(toEmail, toName, subject, body, plainText)
-	}
-
-	// Development mode: log to console
-	return s.logEmailToConsole(toEmail, toName, subject, verificationURL)
+	return s.sendTemplate(TemplateVerification, toEmail, toName, TemplateData{
+		Name: toName,
+		Link: verificationURL,
+	})
 }
 
 // SendPasswordResetEmail sends a password reset link
 func (s *Service) SendPasswordResetEmail(toEmail, toName, token string) error {
 	resetURL := fmt.Sprintf("%s/reset-password/%s", s.baseURL, token)
 
-	subject := "Reset your IndustryDB password"
-	body := fmt.Sprintf(`
-		<html>
-		<body>
-			<h2>Password Reset Request</h2>
-			<p>Hi %s,</p>
-			<p>We received a request to reset your password for your IndustryDB account.</p>
-			<p>Click the button below to reset your password:</p>
-			<p><a href="%s" style="background-color: #2196F3; color: white; padding: 14px 20px; text-decoration: none; border-radius: 4px; display: inline-block;">Reset Password</a></p>
-			<p>Or copy and paste this link into your browser:</p>
-			<p><a href="%s">%s</a></p>
-			<p><strong>This link will expire in 1 hour.</strong></p>
-			<p>If you didn't request a password reset, you can safely ignore this email. Your password will remain unchanged.</p>
-			<p>Thanks,<br>The IndustryDB Team</p>
-		</body>
-		</html>
-	`, toName, resetURL, resetURL, resetURL)
-
-	plainText := fmt.Sprintf(`
-Hi %s,
-
-We received a request to reset your password for your IndustryDB account.
-
-Click the link below to reset your password:
-
-%s
-
-This link will expire in 1 hour.
-
-If you didn't request a password reset, you can safely ignore this email.
-Your password will remain unchanged.
-
-Thanks,
-The IndustryDB Team
-	`, toName, resetURL)
-
-	if s.useSendGrid {
-		return s.sendViaSendGrid(toEmail, toName, subject, body, plainText)
-	}
-
-	// Development mode: log to console
-	return s.logEmailToConsole(toEmail, toName, subject, resetURL)
+	return s.sendTemplate(TemplateReset, toEmail, toName, TemplateData{
+		Name: toName,
+		Link: resetURL,
+	})
 }
 
 // SendWelcomeEmail sends a welcome email after verification
 func (s *Service) SendWelcomeEmail(toEmail, toName string) error {
-	subject := "Welcome to IndustryDB!"
-	body := fmt.Sprintf(`
-		<html>
-		<body>
-			<h2>Welcome to IndustryDB!</h2>
-			<p>Hi %s,</p>
-			<p>Your email has been verified successfully! You now have full access to IndustryDB.</p>
-			<h3>Get Started:</h3>
-			<ul>
-				<li>Search for leads in your target industry</li>
-				<li>Export data in CSV or Excel format</li>
-				<li>Upgrade your plan for more features</li>
-			</ul>
-			<p><a href="%s/dashboard" style="background-color: #4CAF50; color: white; padding: 14px 20px; text-decoration: none; border-radius: 4px; display: inline-block;">Go to Dashboard</a></p>
-			<p>Thanks,<br>The IndustryDB Team</p>
-		</body>
-		</html>
-	`, toName, s.baseURL)
-
-	plainText := fmt.Sprintf(`
-Hi %s,
-
-Your email has been verified successfully! You now have full access to IndustryDB.
-
-Get Started:
-- Search for leads in your target industry
-- Export data in CSV or Excel format
-- Upgrade your plan for more features
-
-Visit your dashboard: %s/dashboard
-
-Thanks,
-The IndustryDB Team
-	`, toName, s.baseURL)
-
-	if s.useSendGrid {
-		return s.sendViaSendGrid(toEmail, toName, subject, body, plainText)
-	}
-
-	// Development mode: log to console
-	log.Printf("📧 [EMAIL] Welcome email to: %s <%s>", toName, toEmail)
-	return nil
+	return s.sendTemplate(TemplateWelcome, toEmail, toName, TemplateData{
+		Name: toName,
+		Link: s.baseURL + "/dashboard",
+	})
 }
 
 // SendOrganizationInviteEmail sends an invitation to join an organization
 func (s *Service) SendOrganizationInviteEmail(toEmail, toName, orgName, inviterName, acceptURL string) error {
-	subject := fmt.Sprintf("You've been invited to join %s on IndustryDB", orgName)
-	body := fmt.Sprintf(`
-		<html>
-		<body>
-			<h2>Organization Invitation</h2>
-			<p>Hi %s,</p>
-			<p><strong>%s</strong> has invited you to join <strong>%s</strong> on IndustryDB.</p>
-			<p>Click the button below to accept the invitation:</p>
-			<p><a href="%s" style="background-color: #4A90E2; color: white; padding: 14px 20px; text-decoration: none; border-radius: 4px; display: inline-block;">Accept Invitation</a></p>
-			<p>Or copy and paste this link into your browser:</p>
-			<p><a href="%s">%s</a></p>
-			<p>If you don't want to join, you can safely ignore this email.</p>
-			<p>Thanks,<br>The IndustryDB Team</p>
-		</body>
-		</html>
-	`, toName, inviterName, orgName, acceptURL, acceptURL, acceptURL)
-
-	plainText := fmt.Sprintf(`
-Hi %s,
-
-%s has invited you to join %s on IndustryDB.
+	return s.sendTemplate(TemplateInvite, toEmail, toName, TemplateData{
+		Name:         toName,
+		Link:         acceptURL,
+		Organization: orgName,
+		Inviter:      inviterName,
+	})
+}
 
-Click the link below to accept the invitation:
+// SendDigestEmail sends a digest listing recent activity
+func (s *Service) SendDigestEmail(toEmail, toName string, items []string) error {
+	return s.sendTemplate(TemplateDigest, toEmail, toName, TemplateData{
+		Name:  toName,
+		Link:  s.baseURL + "/dashboard",
+		Items: items,
+	})
+}
 
-%s
+// SetTemplateDir loads email templates from dir, falling back to the built-in
+// defaults for any template (or part) that is missing there
+func (s *Service) SetTemplateDir(dir string) {
+	s.templates = NewTemplateStore(dir)
+}
 
-If you don't want to join, you can safely ignore this email.
+// RenderTemplate renders a named template. Company defaults to the sender name.
+func (s *Service) RenderTemplate(name TemplateName, data TemplateData) (*RenderedEmail, error) {
+	if data.Company == "" {
+		data.Company = s.fromName
+	}
+	return s.templates.Render(name, data)
+}
 
-Thanks,
-The IndustryDB Team
-	`, toName, inviterName, orgName, acceptURL)
+// sendTemplate renders a template and sends it
+func (s *Service) sendTemplate(name TemplateName, toEmail, toName string, data TemplateData) error {
+	rendered, err := s.RenderTemplate(name, data)
+	if err != nil {
+		return fmt.Errorf("failed to render %s email: %w", name, err)
+	}
 
-	if s.useSendGrid {
-		return s.sendViaSendGrid(toEmail, toName, subject, body, plainText)
+	if len(s.providers) > 0 {
+		return s.deliver(name, toEmail, toName, rendered.Subject, rendered.HTML, rendered.Text)
 	}
 
 	// Development mode: log to console
-	return s.logEmailToConsole(toEmail, toName, subject, acceptURL)
+	return s.logEmailToConsole(toEmail, toName, rendered.Subject, data.Link)
 }
+
 // SendRawEmail sends an email with custom subject and body content.
-// Uses SendGrid in production, logs to console in development.
+// Uses the configured providers in production, logs to console in development.
 func (s *Service) SendRawEmail(toEmail, toName, subject, htmlBody, plainTextBody string) error {
-	if s.useSendGrid {
-		return s.sendViaSendGrid(toEmail, toName, subject, htmlBody, plainTextBody)
+	if len(s.providers) > 0 {
+		return s.deliver("", toEmail, toName, subject, htmlBody, plainTextBody)
 	}
 
 	log.Printf("📧 [EMAIL] %s", subject)
@@ -230,30 +138,6 @@ func (s *Service) SendRawEmail(toEmail, toName, subject, htmlBody, plainTextBody
 	return nil
 }
 
-// sendViaSendGrid sends email using SendGrid API
-func (s *Service) sendViaSendGrid(toEmail, toName, subject, htmlBody, plainTextBody string) error {
-	from := mail.NewEmail(s.fromName, s.fromEmail)
-	to := mail.NewEmail(toName, toEmail)
-
-	message := mail.NewSingleEmail(from, subject, to, plainTextBody, htmlBody)
-
-	client := sendgrid.NewSendClient(s.sendGridKey)
-	response, err := client.Send(message)
-
-	if err != nil {
-		log.Printf("❌ SendGrid error: %v", err)
-		return fmt.Errorf("failed to send email: %w", err)
-	}
-
-	if response.StatusCode >= 400 {
-		log.Printf("❌ SendGrid returned error status %d: %s", response.StatusCode, response.Body)
-		return fmt.Errorf("sendgrid returned error status: %d", response.StatusCode)
-	}
-
-	log.Printf("✅ Email sent successfully to %s (SendGrid status: %d)", toEmail, response.StatusCode)
-	return nil
-}
-
 // logEmailToConsole logs email details to console (development mode)
 func (s *Service) logEmailToConsole(toEmail, toName, subject, actionURL string) error {
 	log.Printf("📧 [EMAIL] %s", subject)
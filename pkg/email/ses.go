@@ -0,0 +1,147 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/mail"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+)
+
+// SESConfig holds Amazon SES configuration
+type SESConfig struct {
+	AWSAccessKeyID     string
+	AWSSecretAccessKey string
+	AWSRegion          string
+}
+
+// SESProvider delivers email through the Amazon SES v2 API
+type SESProvider struct {
+	credentials aws.CredentialsProvider
+	region      string
+	endpoint    string
+	signer      *v4.Signer
+	httpClient  *http.Client
+}
+
+// NewSESProvider creates an SES provider. The sender address must be a
+// verified SES identity in the region.
+func NewSESProvider(cfg SESConfig) (*SESProvider, error) {
+	awsCfg, err := awsconfig.LoadDefaultConfig(context.Background(),
+		awsconfig.WithRegion(cfg.AWSRegion),
+		awsconfig.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(
+			cfg.AWSAccessKeyID,
+			cfg.AWSSecretAccessKey,
+			"",
+		)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS config: %w", err)
+	}
+
+	return &SESProvider{
+		credentials: awsCfg.Credentials,
+		region:      cfg.AWSRegion,
+		endpoint:    fmt.Sprintf("https://email.%s.amazonaws.com", cfg.AWSRegion),
+		signer:      v4.NewSigner(),
+		httpClient:  &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+// Name returns the provider name
+func (p *SESProvider) Name() string {
+	return "ses"
+}
+
+// sesContent is a UTF-8 text part of an SES message
+type sesContent struct {
+	Data    string `json:"Data"`
+	Charset string `json:"Charset"`
+}
+
+// sesSendEmailRequest is the SES v2 SendEmail request body
+type sesSendEmailRequest struct {
+	FromEmailAddress string `json:"FromEmailAddress"`
+	Destination      struct {
+		ToAddresses []string `json:"ToAddresses"`
+	} `json:"Destination"`
+	Content struct {
+		Simple struct {
+			Subject sesContent `json:"Subject"`
+			Body    struct {
+				Text *sesContent `json:"Text,omitempty"`
+				HTML *sesContent `json:"Html,omitempty"`
+			} `json:"Body"`
+		} `json:"Simple"`
+	} `json:"Content"`
+}
+
+// Send sends email using the SES v2 SendEmail API
+func (p *SESProvider) Send(ctx context.Context, msg Message) (SendResult, error) {
+	var body sesSendEmailRequest
+	body.FromEmailAddress = (&mail.Address{Name: msg.FromName, Address: msg.FromEmail}).String()
+	body.Destination.ToAddresses = []string{(&mail.Address{Name: msg.ToName, Address: msg.ToEmail}).String()}
+	body.Content.Simple.Subject = sesContent{Data: msg.Subject, Charset: "UTF-8"}
+	if msg.TextBody != "" {
+		body.Content.Simple.Body.Text = &sesContent{Data: msg.TextBody, Charset: "UTF-8"}
+	}
+	if msg.HTMLBody != "" {
+		body.Content.Simple.Body.HTML = &sesContent{Data: msg.HTMLBody, Charset: "UTF-8"}
+	}
+
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return SendResult{}, fmt.Errorf("failed to encode SES request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint+"/v2/email/outbound-emails", bytes.NewReader(payload))
+	if err != nil {
+		return SendResult{}, fmt.Errorf("failed to create SES request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	creds, err := p.credentials.Retrieve(ctx)
+	if err != nil {
+		return SendResult{}, fmt.Errorf("failed to load AWS credentials: %w", err)
+	}
+	hash := sha256.Sum256(payload)
+	if err := p.signer.SignHTTP(ctx, creds, req, hex.EncodeToString(hash[:]), "ses", p.region, time.Now()); err != nil {
+		return SendResult{}, fmt.Errorf("failed to sign SES request: %w", err)
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		log.Printf("❌ SES error: %v", err)
+		return SendResult{}, fmt.Errorf("failed to send email: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	result := SendResult{StatusCode: resp.StatusCode}
+
+	if resp.StatusCode >= 400 {
+		log.Printf("❌ SES returned error status %d: %s", resp.StatusCode, respBody)
+		return result, fmt.Errorf("ses returned error status: %d", resp.StatusCode)
+	}
+
+	var sent struct {
+		MessageID string `json:"MessageId"`
+	}
+	if err := json.Unmarshal(respBody, &sent); err == nil {
+		result.MessageID = sent.MessageID
+	}
+
+	log.Printf("✅ Email sent successfully to %s (SES status: %d)", msg.ToEmail, resp.StatusCode)
+	return result, nil
+}
@@ -0,0 +1,223 @@
+package email
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	texttemplate "text/template"
+	"time"
+)
+
+// TemplateName identifies an email template
+type TemplateName string
+
+// Email templates
+const (
+	TemplateVerification TemplateName = "verification"
+	TemplateReset        TemplateName = "reset"
+	TemplateWelcome      TemplateName = "welcome"
+	TemplateInvite       TemplateName = "invite"
+	TemplateDigest       TemplateName = "digest"
+)
+
+// TemplateNames lists every email template
+var TemplateNames = []TemplateName{
+	TemplateVerification,
+	TemplateReset,
+	TemplateWelcome,
+	TemplateInvite,
+	TemplateDigest,
+}
+
+// ErrUnknownTemplate is returned when rendering a template that doesn't exist
+var ErrUnknownTemplate = errors.New("unknown email template")
+
+// Each template has three parts, stored as <name>.subject, <name>.html and
+// <name>.txt. A template directory may override any part; missing or broken
+// parts fall back to the built-in defaults.
+const (
+	partSubject = "subject"
+	partHTML    = "html"
+	partText    = "txt"
+)
+
+var templateParts = []string{partSubject, partHTML, partText}
+
+//go:embed templates/*
+var builtinTemplates embed.FS
+
+// TemplateData holds the variables available to email templates. HTML parts
+// are rendered with html/template, so values are escaped and unsafe link
+// schemes are neutralized.
+type TemplateData struct {
+	Name         string   `json:"name"`
+	Link         string   `json:"link"`
+	Company      string   `json:"company"`
+	Organization string   `json:"organization,omitempty"`
+	Inviter      string   `json:"inviter,omitempty"`
+	Items        []string `json:"items,omitempty"`
+}
+
+// RenderedEmail is a rendered email template
+type RenderedEmail struct {
+	Template TemplateName `json:"template"`
+	Subject  string       `json:"subject"`
+	HTML     string       `json:"html"`
+	Text     string       `json:"text"`
+	// Custom lists the parts rendered from the template directory
+	Custom []string `json:"custom"`
+}
+
+// executor is satisfied by both html/template and text/template templates
+type executor interface {
+	Execute(w io.Writer, data any) error
+}
+
+// cachedTemplate is a parsed override and the file version it was parsed from
+type cachedTemplate struct {
+	modTime time.Time
+	tmpl    executor
+}
+
+// TemplateStore renders email templates from an optional override directory,
+// falling back to the built-in defaults. Overrides are re-read when their file
+// changes, so copy can be edited without a restart.
+type TemplateStore struct {
+	dir string
+
+	mu    sync.Mutex
+	cache map[string]cachedTemplate
+}
+
+// NewTemplateStore creates a template store. An empty dir uses only the
+// built-in templates.
+func NewTemplateStore(dir string) *TemplateStore {
+	return &TemplateStore{
+		dir:   dir,
+		cache: make(map[string]cachedTemplate),
+	}
+}
+
+// Render renders all parts of a named template
+func (s *TemplateStore) Render(name TemplateName, data TemplateData) (*RenderedEmail, error) {
+	if !isTemplateName(name) {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTemplate, name)
+	}
+
+	rendered := &RenderedEmail{Template: name, Custom: []string{}}
+	for _, part := range templateParts {
+		out, custom, err := s.renderPart(name, part, data)
+		if err != nil {
+			return nil, err
+		}
+		if custom {
+			rendered.Custom = append(rendered.Custom, part)
+		}
+
+		switch part {
+		case partSubject:
+			// Subjects are a single header line
+			rendered.Subject = strings.Join(strings.Fields(out), " ")
+		case partHTML:
+			rendered.HTML = out
+		case partText:
+			rendered.Text = out
+		}
+	}
+
+	return rendered, nil
+}
+
+// renderPart renders one part, preferring the override directory
+func (s *TemplateStore) renderPart(name TemplateName, part string, data TemplateData) (string, bool, error) {
+	file := string(name) + "." + part
+
+	if tmpl := s.override(file, part); tmpl != nil {
+		var buf bytes.Buffer
+		err := tmpl.Execute(&buf, data)
+		if err == nil {
+			return buf.String(), true, nil
+		}
+		log.Printf("⚠️  Email template %s failed to render, using built-in default: %v", file, err)
+	}
+
+	raw, err := builtinTemplates.ReadFile("templates/" + file)
+	if err != nil {
+		return "", false, fmt.Errorf("missing built-in email template %s: %w", file, err)
+	}
+	tmpl, err := parseTemplate(file, part, string(raw))
+	if err != nil {
+		return "", false, fmt.Errorf("invalid built-in email template %s: %w", file, err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", false, fmt.Errorf("failed to render email template %s: %w", file, err)
+	}
+	return buf.String(), false, nil
+}
+
+// override returns the parsed override for a file, or nil when there is none
+// or it doesn't parse
+func (s *TemplateStore) override(file, part string) executor {
+	if s.dir == "" {
+		return nil
+	}
+
+	path := filepath.Join(s.dir, file)
+	info, err := os.Stat(path)
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			log.Printf("⚠️  Failed to read email template %s: %v", path, err)
+		}
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if cached, ok := s.cache[file]; ok && cached.modTime.Equal(info.ModTime()) {
+		return cached.tmpl
+	}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		log.Printf("⚠️  Failed to read email template %s: %v", path, err)
+		return nil
+	}
+	tmpl, err := parseTemplate(file, part, string(raw))
+	if err != nil {
+		log.Printf("⚠️  Invalid email template %s, using built-in default: %v", path, err)
+		tmpl = nil
+	}
+
+	// Cache failures too, so a broken file is only reported once per change
+	s.cache[file] = cachedTemplate{modTime: info.ModTime(), tmpl: tmpl}
+	return tmpl
+}
+
+// parseTemplate parses HTML parts with html/template and the rest as text
+func parseTemplate(file, part, raw string) (executor, error) {
+	if part == partHTML {
+		return htmltemplate.New(file).Option("missingkey=error").Parse(raw)
+	}
+	return texttemplate.New(file).Option("missingkey=error").Parse(raw)
+}
+
+func isTemplateName(name TemplateName) bool {
+	for _, n := range TemplateNames {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
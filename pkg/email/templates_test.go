@@ -0,0 +1,118 @@
+package email
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplateStore_BuiltinTemplates(t *testing.T) {
+	store := NewTemplateStore("")
+
+	for _, name := range TemplateNames {
+		t.Run(string(name), func(t *testing.T) {
+			rendered, err := store.Render(name, TemplateData{
+				Name:         "Jane",
+				Link:         "https://app.industrydb.io/x",
+				Company:      "IndustryDB",
+				Organization: "Acme",
+				Inviter:      "Bob",
+				Items:        []string{"12 new leads"},
+			})
+			require.NoError(t, err)
+			assert.NotEmpty(t, rendered.Subject)
+			assert.Contains(t, rendered.HTML, "Jane")
+			assert.Contains(t, rendered.Text, "https://app.industrydb.io/x")
+			assert.Empty(t, rendered.Custom)
+		})
+	}
+}
+
+func TestTemplateStore_EscapesVariables(t *testing.T) {
+	store := NewTemplateStore("")
+
+	rendered, err := store.Render(TemplateVerification, TemplateData{
+		Name:    "<script>alert(1)</script>",
+		Link:    "javascript:alert(1)",
+		Company: "IndustryDB",
+	})
+	require.NoError(t, err)
+	assert.NotContains(t, rendered.HTML, "<script>")
+	assert.Contains(t, rendered.HTML, "&lt;script&gt;")
+	assert.NotContains(t, rendered.HTML, `href="javascript:`)
+}
+
+func TestTemplateStore_SubjectIsSingleLine(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "reset.subject"), []byte("Reset for {{.Name}}\n"), 0o644))
+	store := NewTemplateStore(dir)
+
+	rendered, err := store.Render(TemplateReset, TemplateData{Name: "Jane\r\nBcc: evil@example.com"})
+	require.NoError(t, err)
+	assert.Equal(t, "Reset for Jane Bcc: evil@example.com", rendered.Subject)
+}
+
+func TestTemplateStore_Overrides(t *testing.T) {
+	dir := t.TempDir()
+	writeTemplate := func(file, content string) {
+		path := filepath.Join(dir, file)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+		// Make sure the modification time changes between writes
+		modTime := time.Now().Add(time.Duration(len(content)) * time.Second)
+		require.NoError(t, os.Chtimes(path, modTime, modTime))
+	}
+
+	writeTemplate("welcome.html", "<p>Hey {{.Name}}, welcome to {{.Company}}</p>")
+	store := NewTemplateStore(dir)
+	data := TemplateData{Name: "Jane", Company: "IndustryDB", Link: "https://app.industrydb.io/dashboard"}
+
+	rendered, err := store.Render(TemplateWelcome, data)
+	require.NoError(t, err)
+	assert.Equal(t, "<p>Hey Jane, welcome to IndustryDB</p>", rendered.HTML)
+	assert.Equal(t, []string{"html"}, rendered.Custom)
+	// Missing parts fall back to the built-in defaults
+	assert.Equal(t, "Welcome to IndustryDB!", rendered.Subject)
+	assert.Contains(t, rendered.Text, "Visit your dashboard")
+
+	t.Run("reloads edited templates", func(t *testing.T) {
+		writeTemplate("welcome.html", "<p>Hello again {{.Name}}, from the {{.Company}} team</p>")
+
+		rendered, err := store.Render(TemplateWelcome, data)
+		require.NoError(t, err)
+		assert.Equal(t, "<p>Hello again Jane, from the IndustryDB team</p>", rendered.HTML)
+	})
+
+	t.Run("falls back on parse errors", func(t *testing.T) {
+		writeTemplate("welcome.html", "<p>{{.Name</p> broken template")
+
+		rendered, err := store.Render(TemplateWelcome, data)
+		require.NoError(t, err)
+		assert.Contains(t, rendered.HTML, "Go to Dashboard")
+		assert.Empty(t, rendered.Custom)
+	})
+
+	t.Run("falls back on unknown variables", func(t *testing.T) {
+		writeTemplate("welcome.html", "<p>{{.Nickname}} is not a template variable at all</p>")
+
+		rendered, err := store.Render(TemplateWelcome, data)
+		require.NoError(t, err)
+		assert.Contains(t, rendered.HTML, "Go to Dashboard")
+	})
+}
+
+func TestTemplateStore_UnknownTemplate(t *testing.T) {
+	_, err := NewTemplateStore("").Render("newsletter", TemplateData{})
+	assert.ErrorIs(t, err, ErrUnknownTemplate)
+}
+
+func TestService_RenderTemplate_DefaultsCompany(t *testing.T) {
+	svc := NewService("from@example.com", "IndustryDB", "https://app.industrydb.io", "")
+
+	rendered, err := svc.RenderTemplate(TemplateVerification, TemplateData{Name: "Jane", Link: "https://app.industrydb.io/verify-email/abc"})
+	require.NoError(t, err)
+	assert.Equal(t, "Verify your IndustryDB account", rendered.Subject)
+}
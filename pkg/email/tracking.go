@@ -0,0 +1,372 @@
+package email
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/emailsend"
+	"github.com/jordanlanch/industrydb/ent/emailsuppression"
+)
+
+// Send tracking.
+//
+// With a database attached (SetDB), every email sent through a provider is
+// recorded as an EmailSend, along with the provider that handled the last
+// attempt. Transient failures (network errors, 429 and 5xx)
+// are retried with exponential backoff by RetryPending; other rejections fail
+// immediately. Hard bounces reported by the SendGrid event webhook suppress the
+// address, and suppressed addresses are never emailed again.
+
+// ErrUndeliverable is returned when emailing an address that hard-bounced
+var ErrUndeliverable = errors.New("email address is undeliverable")
+
+// ErrInvalidEventSignature is returned when a SendGrid event webhook request
+// is not signed by the configured verification key
+var ErrInvalidEventSignature = errors.New("invalid event webhook signature")
+
+// Retry policy: attempts are spaced 1m, 4m, 16m and 64m apart
+const (
+	maxSendAttempts = 5
+	retryBaseDelay  = time.Minute
+	retryLease      = 10 * time.Minute
+	retryBatchSize  = 100
+)
+
+// SetDB enables send tracking, retries and bounce suppression
+func (s *Service) SetDB(db *ent.Client) {
+	s.db = db
+}
+
+// deliver sends an email through the provider, recording it when tracking is
+// enabled. A send that failed transiently is scheduled for retry and reported
+// as success to the caller.
+func (s *Service) deliver(template TemplateName, toEmail, toName, subject, htmlBody, plainTextBody string) error {
+	ctx := context.Background()
+	if s.db == nil {
+		_, err := s.send(ctx, toEmail, toName, subject, htmlBody, plainTextBody)
+		return err
+	}
+
+	create := s.db.EmailSend.Create().
+		SetToEmail(toEmail).
+		SetToName(toName).
+		SetTemplate(string(template)).
+		SetSubject(subject)
+
+	suppressed, err := s.isSuppressed(ctx, toEmail)
+	if err != nil {
+		log.Printf("⚠️  Failed to check email suppression for %s: %v", toEmail, err)
+	}
+	if suppressed {
+		if err := create.SetStatus(emailsend.StatusSuppressed).SetLastError(ErrUndeliverable.Error()).Exec(ctx); err != nil {
+			log.Printf("⚠️  Failed to record suppressed email to %s: %v", toEmail, err)
+		}
+		log.Printf("⚠️  Not emailing %s: address is undeliverable", toEmail)
+		return fmt.Errorf("%w: %s", ErrUndeliverable, toEmail)
+	}
+
+	record, err := create.
+		SetHTMLBody(htmlBody).
+		SetTextBody(plainTextBody).
+		Save(ctx)
+	if err != nil {
+		// Tracking must never stop a transactional email
+		log.Printf("⚠️  Failed to record email to %s, sending untracked: %v", toEmail, err)
+		_, err := s.send(ctx, toEmail, toName, subject, htmlBody, plainTextBody)
+		return err
+	}
+
+	status, err := s.attempt(ctx, record)
+	if status == emailsend.StatusRetrying {
+		return nil
+	}
+	return err
+}
+
+// attempt sends a recorded email once and records the outcome
+func (s *Service) attempt(ctx context.Context, record *ent.EmailSend) (emailsend.Status, error) {
+	result, sendErr := s.send(ctx, record.ToEmail, record.ToName, record.Subject, record.HTMLBody, record.TextBody)
+
+	now := time.Now()
+	attempts := record.Attempts + 1
+	update := record.Update().
+		SetAttempts(attempts).
+		SetProvider(result.Provider)
+	if result.StatusCode != 0 {
+		update.SetStatusCode(result.StatusCode)
+	}
+
+	var status emailsend.Status
+	switch {
+	case sendErr == nil:
+		status = emailsend.StatusSent
+		update.
+			SetSentAt(now).
+			SetProviderMessageID(result.MessageID).
+			ClearLastError().
+			ClearNextAttemptAt().
+			ClearHTMLBody().
+			ClearTextBody()
+	case isTransient(result.StatusCode) && attempts < maxSendAttempts:
+		status = emailsend.StatusRetrying
+		next := now.Add(retryDelay(attempts))
+		update.
+			SetLastError(sendErr.Error()).
+			SetNextAttemptAt(next)
+		log.Printf("⚠️  Email to %s failed (attempt %d/%d), retrying at %s: %v",
+			record.ToEmail, attempts, maxSendAttempts, next.Format(time.RFC3339), sendErr)
+	default:
+		status = emailsend.StatusFailed
+		update.
+			SetLastError(sendErr.Error()).
+			ClearNextAttemptAt().
+			ClearHTMLBody().
+			ClearTextBody()
+		log.Printf("❌ Email to %s failed after %d attempts: %v", record.ToEmail, attempts, sendErr)
+	}
+
+	if err := update.SetStatus(status).Exec(ctx); err != nil {
+		log.Printf("⚠️  Failed to record email send %d: %v", record.ID, err)
+	}
+
+	return status, sendErr
+}
+
+// isTransient reports whether a failed send may succeed on retry
+func isTransient(statusCode int) bool {
+	return statusCode == 0 || statusCode == 429 || statusCode >= 500
+}
+
+// retryDelay returns the wait before the next attempt: 4^(attempts-1) minutes
+func retryDelay(attempts int) time.Duration {
+	return retryBaseDelay << (2 * uint(attempts-1))
+}
+
+// RetryResult summarizes a retry run
+type RetryResult struct {
+	Retried int `json:"retried"`
+	Sent    int `json:"sent"`
+	Failed  int `json:"failed"`
+}
+
+// RetryPending retries sends whose next attempt is due. Each send is claimed
+// before it is retried, so concurrent runs don't send twice.
+func (s *Service) RetryPending(ctx context.Context, now time.Time) (*RetryResult, error) {
+	result := &RetryResult{}
+	if s.db == nil {
+		return result, nil
+	}
+
+	due, err := s.db.EmailSend.Query().
+		Where(
+			emailsend.StatusEQ(emailsend.StatusRetrying),
+			emailsend.NextAttemptAtLTE(now),
+		).
+		Order(ent.Asc(emailsend.FieldNextAttemptAt)).
+		Limit(retryBatchSize).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load emails to retry: %w", err)
+	}
+
+	for _, record := range due {
+		claimed, err := s.db.EmailSend.Update().
+			Where(
+				emailsend.IDEQ(record.ID),
+				emailsend.StatusEQ(emailsend.StatusRetrying),
+				emailsend.NextAttemptAtLTE(now),
+			).
+			SetNextAttemptAt(now.Add(retryLease)).
+			Save(ctx)
+		if err != nil {
+			return result, fmt.Errorf("failed to claim email send %d: %w", record.ID, err)
+		}
+		if claimed == 0 {
+			continue
+		}
+
+		// Suppression may have happened since the first attempt
+		if suppressed, _ := s.isSuppressed(ctx, record.ToEmail); suppressed {
+			s.suppressSend(ctx, record.ID)
+			result.Failed++
+			continue
+		}
+
+		result.Retried++
+		switch status, _ := s.attempt(ctx, record); status {
+		case emailsend.StatusSent:
+			result.Sent++
+		case emailsend.StatusFailed:
+			result.Failed++
+		}
+	}
+
+	return result, nil
+}
+
+// ListFailures returns the most recent sends that have not been delivered.
+// An empty status lists every undelivered status.
+func (s *Service) ListFailures(ctx context.Context, status string, limit int) ([]*ent.EmailSend, error) {
+	if s.db == nil {
+		return []*ent.EmailSend{}, nil
+	}
+
+	query := s.db.EmailSend.Query()
+	if status != "" {
+		query = query.Where(emailsend.StatusEQ(emailsend.Status(status)))
+	} else {
+		query = query.Where(emailsend.StatusIn(
+			emailsend.StatusRetrying,
+			emailsend.StatusFailed,
+			emailsend.StatusBounced,
+			emailsend.StatusSuppressed,
+		))
+	}
+
+	return query.
+		Order(ent.Desc(emailsend.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+}
+
+// isSuppressed reports whether an address hard-bounced
+func (s *Service) isSuppressed(ctx context.Context, address string) (bool, error) {
+	return s.db.EmailSuppression.Query().
+		Where(emailsuppression.EmailEQ(strings.ToLower(address))).
+		Exist(ctx)
+}
+
+// suppressSend stops a send that can no longer be delivered
+func (s *Service) suppressSend(ctx context.Context, id int) {
+	err := s.db.EmailSend.UpdateOneID(id).
+		SetStatus(emailsend.StatusSuppressed).
+		SetLastError(ErrUndeliverable.Error()).
+		ClearNextAttemptAt().
+		ClearHTMLBody().
+		ClearTextBody().
+		Exec(ctx)
+	if err != nil {
+		log.Printf("⚠️  Failed to suppress email send %d: %v", id, err)
+	}
+}
+
+// Event is a SendGrid event webhook event. Only the fields used for bounce
+// handling are decoded.
+type Event struct {
+	Email       string `json:"email"`
+	Event       string `json:"event"`
+	Type        string `json:"type"`
+	Reason      string `json:"reason"`
+	SGMessageID string `json:"sg_message_id"`
+}
+
+// isHardBounce reports whether an event means the address can't receive mail.
+// Blocked messages (type "blocked") are soft bounces and don't suppress.
+func (e Event) isHardBounce() bool {
+	switch e.Event {
+	case "bounce":
+		return e.Type != "blocked"
+	case "dropped":
+		return strings.Contains(e.Reason, "Bounced Address")
+	}
+	return false
+}
+
+// HandleEvents processes SendGrid event webhook events, suppressing addresses
+// that hard-bounced. It returns the number of newly suppressed addresses.
+func (s *Service) HandleEvents(ctx context.Context, events []Event) (int, error) {
+	if s.db == nil {
+		return 0, nil
+	}
+
+	suppressed := 0
+	for _, event := range events {
+		if event.Email == "" || !event.isHardBounce() {
+			continue
+		}
+
+		address := strings.ToLower(event.Email)
+		err := s.db.EmailSuppression.Create().
+			SetEmail(address).
+			SetReason(event.Reason).
+			Exec(ctx)
+		// Already suppressed addresses still get their sends updated below
+		if err != nil && !ent.IsConstraintError(err) {
+			return suppressed, fmt.Errorf("failed to suppress %s: %w", address, err)
+		}
+		if err == nil {
+			suppressed++
+		}
+
+		// sg_message_id is the X-Message-Id followed by ".filter..." details
+		if messageID, _, _ := strings.Cut(event.SGMessageID, "."); messageID != "" {
+			_, err := s.db.EmailSend.Update().
+				Where(emailsend.ProviderMessageIDEQ(messageID)).
+				SetStatus(emailsend.StatusBounced).
+				SetLastError(event.Reason).
+				Save(ctx)
+			if err != nil {
+				return suppressed, fmt.Errorf("failed to record bounce for %s: %w", address, err)
+			}
+		}
+
+		// Stop retrying anything still queued for the address
+		_, err = s.db.EmailSend.Update().
+			Where(
+				emailsend.ToEmailEqualFold(address),
+				emailsend.StatusIn(emailsend.StatusPending, emailsend.StatusRetrying),
+			).
+			SetStatus(emailsend.StatusSuppressed).
+			SetLastError(ErrUndeliverable.Error()).
+			ClearNextAttemptAt().
+			ClearHTMLBody().
+			ClearTextBody().
+			Save(ctx)
+		if err != nil {
+			return suppressed, fmt.Errorf("failed to cancel retries for %s: %w", address, err)
+		}
+
+		log.Printf("⚠️  Email address %s hard-bounced and is now suppressed: %s", address, event.Reason)
+	}
+
+	return suppressed, nil
+}
+
+// VerifyEventSignature checks a SendGrid signed event webhook request.
+// publicKey is the base64 verification key from the SendGrid mail settings;
+// signature and timestamp are the X-Twilio-Email-Event-Webhook-Signature and
+// X-Twilio-Email-Event-Webhook-Timestamp headers.
+func VerifyEventSignature(publicKey, signature, timestamp string, body []byte) error {
+	der, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil {
+		return fmt.Errorf("invalid verification key: %w", err)
+	}
+	parsed, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return fmt.Errorf("invalid verification key: %w", err)
+	}
+	key, ok := parsed.(*ecdsa.PublicKey)
+	if !ok {
+		return fmt.Errorf("invalid verification key: not an ECDSA key")
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil || timestamp == "" {
+		return ErrInvalidEventSignature
+	}
+
+	digest := sha256.Sum256(append([]byte(timestamp), body...))
+	if !ecdsa.VerifyASN1(key, digest[:], sig) {
+		return ErrInvalidEventSignature
+	}
+	return nil
+}
@@ -0,0 +1,210 @@
+package email
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/emailsend"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTrackingTest(t *testing.T, responses ...mockResponse) (*ent.Client, *Service, *mockProvider) {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	provider := &mockProvider{name: "sendgrid", responses: responses}
+	svc := NewService("from@example.com", "IndustryDB", "https://app.industrydb.io", "")
+	svc.SetDB(client)
+	svc.SetProviders(provider, nil)
+	return client, svc, provider
+}
+
+func TestDeliver_RecordsSentEmail(t *testing.T) {
+	client, svc, _ := setupTrackingTest(t)
+	ctx := context.Background()
+
+	require.NoError(t, svc.SendVerificationEmail("user@example.com", "Test User", "abc123"))
+
+	record, err := client.EmailSend.Query().Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, emailsend.StatusSent, record.Status)
+	assert.Equal(t, "verification", record.Template)
+	assert.Equal(t, 1, record.Attempts)
+	assert.Equal(t, 202, *record.StatusCode)
+	assert.Equal(t, "sendgrid", record.Provider)
+	assert.Equal(t, "msg1", record.ProviderMessageID)
+	assert.NotNil(t, record.SentAt)
+	assert.Empty(t, record.HTMLBody, "bodies are dropped once delivered")
+}
+
+func TestDeliver_RetriesTransientFailures(t *testing.T) {
+	client, svc, provider := setupTrackingTest(t,
+		mockResponse{statusCode: 503, err: errors.New("sendgrid returned error status: 503")},
+		mockResponse{err: errors.New("connection reset")},
+	)
+	ctx := context.Background()
+
+	// The caller isn't failed: the email is queued for retry
+	require.NoError(t, svc.SendPasswordResetEmail("user@example.com", "Test User", "reset-token"))
+
+	record, err := client.EmailSend.Query().Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, emailsend.StatusRetrying, record.Status)
+	assert.Contains(t, record.LastError, "503")
+	assert.NotEmpty(t, record.HTMLBody)
+	firstRetry := *record.NextAttemptAt
+
+	// Not due yet
+	result, err := svc.RetryPending(ctx, firstRetry.Add(-time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.Retried)
+
+	// Network error: backs off further
+	result, err = svc.RetryPending(ctx, firstRetry)
+	require.NoError(t, err)
+	assert.Equal(t, RetryResult{Retried: 1}, *result)
+
+	record, err = client.EmailSend.Get(ctx, record.ID)
+	require.NoError(t, err)
+	assert.Equal(t, emailsend.StatusRetrying, record.Status)
+	assert.Equal(t, 2, record.Attempts)
+	assert.True(t, record.NextAttemptAt.After(firstRetry.Add(3*time.Minute)))
+
+	// Succeeds on the third attempt
+	result, err = svc.RetryPending(ctx, *record.NextAttemptAt)
+	require.NoError(t, err)
+	assert.Equal(t, RetryResult{Retried: 1, Sent: 1}, *result)
+	assert.Equal(t, 3, provider.calls)
+
+	record, err = client.EmailSend.Get(ctx, record.ID)
+	require.NoError(t, err)
+	assert.Equal(t, emailsend.StatusSent, record.Status)
+	assert.Contains(t, record.Subject, "Reset your IndustryDB password")
+}
+
+func TestDeliver_GivesUpAfterMaxAttempts(t *testing.T) {
+	responses := make([]mockResponse, maxSendAttempts)
+	for i := range responses {
+		responses[i] = mockResponse{statusCode: 500, err: errors.New("sendgrid returned error status: 500")}
+	}
+	client, svc, provider := setupTrackingTest(t, responses...)
+	ctx := context.Background()
+
+	require.NoError(t, svc.SendWelcomeEmail("user@example.com", "Test User"))
+
+	now := time.Now()
+	for i := 1; i < maxSendAttempts; i++ {
+		now = now.Add(retryDelay(i))
+		_, err := svc.RetryPending(ctx, now)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, maxSendAttempts, provider.calls)
+
+	record, err := client.EmailSend.Query().Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, emailsend.StatusFailed, record.Status)
+	assert.Nil(t, record.NextAttemptAt)
+
+	failures, err := svc.ListFailures(ctx, "", 50)
+	require.NoError(t, err)
+	assert.Len(t, failures, 1)
+}
+
+func TestDeliver_PermanentRejectionFails(t *testing.T) {
+	client, svc, provider := setupTrackingTest(t,
+		mockResponse{statusCode: 400, err: errors.New("sendgrid returned error status: 400")},
+	)
+	ctx := context.Background()
+
+	err := svc.SendRawEmail("user@example.com", "Test User", "Hello", "<p>Hi</p>", "Hi")
+	require.Error(t, err)
+
+	record, err := client.EmailSend.Query().Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, emailsend.StatusFailed, record.Status)
+	assert.Equal(t, 400, *record.StatusCode)
+
+	result, err := svc.RetryPending(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.Retried)
+	assert.Equal(t, 1, provider.calls)
+}
+
+func TestHandleEvents_HardBounceSuppressesAddress(t *testing.T) {
+	client, svc, provider := setupTrackingTest(t)
+	ctx := context.Background()
+
+	require.NoError(t, svc.SendVerificationEmail("Bounce@Example.com", "Bouncer", "abc"))
+
+	// A queued retry to the same address
+	queued, err := client.EmailSend.Create().
+		SetToEmail("bounce@example.com").
+		SetSubject("Queued").
+		SetStatus(emailsend.StatusRetrying).
+		SetNextAttemptAt(time.Now()).
+		Save(ctx)
+	require.NoError(t, err)
+
+	suppressed, err := svc.HandleEvents(ctx, []Event{
+		{Email: "bounce@example.com", Event: "delivered"},
+		{Email: "blocked@example.com", Event: "bounce", Type: "blocked", Reason: "spam filter"},
+		{Email: "bounce@example.com", Event: "bounce", Type: "bounce", Reason: "550 5.1.1 user unknown", SGMessageID: "msg1.filter0001.16648.5515E0B88.0"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, suppressed)
+
+	sent, err := client.EmailSend.Query().Where(emailsend.ProviderMessageIDEQ("msg1")).Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, emailsend.StatusBounced, sent.Status)
+	assert.Equal(t, "550 5.1.1 user unknown", sent.LastError)
+
+	queued, err = client.EmailSend.Get(ctx, queued.ID)
+	require.NoError(t, err)
+	assert.Equal(t, emailsend.StatusSuppressed, queued.Status)
+
+	// Suppressed addresses are not emailed again
+	err = svc.SendPasswordResetEmail("bounce@example.com", "Bouncer", "reset")
+	assert.ErrorIs(t, err, ErrUndeliverable)
+	assert.Equal(t, 1, provider.calls)
+
+	// Soft bounces don't suppress
+	require.NoError(t, svc.SendWelcomeEmail("blocked@example.com", "Blocked"))
+
+	// Repeated bounce events are idempotent
+	suppressed, err = svc.HandleEvents(ctx, []Event{{Email: "bounce@example.com", Event: "dropped", Reason: "Bounced Address"}})
+	require.NoError(t, err)
+	assert.Equal(t, 0, suppressed)
+}
+
+func TestVerifyEventSignature(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	publicKey := base64.StdEncoding.EncodeToString(der)
+
+	body := []byte(`[{"email":"a@example.com","event":"bounce"}]`)
+	timestamp := "1700000000"
+	digest := sha256.Sum256(append([]byte(timestamp), body...))
+	sig, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+	require.NoError(t, err)
+	signature := base64.StdEncoding.EncodeToString(sig)
+
+	assert.NoError(t, VerifyEventSignature(publicKey, signature, timestamp, body))
+	assert.ErrorIs(t, VerifyEventSignature(publicKey, signature, "1700000001", body), ErrInvalidEventSignature)
+	assert.ErrorIs(t, VerifyEventSignature(publicKey, signature, timestamp, []byte(`[]`)), ErrInvalidEventSignature)
+	assert.ErrorIs(t, VerifyEventSignature(publicKey, "not-base64!", timestamp, body), ErrInvalidEventSignature)
+	assert.Error(t, VerifyEventSignature("bad-key", signature, timestamp, body))
+}
@@ -0,0 +1,143 @@
+package emailsequence
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/emailsequencestep"
+)
+
+// ErrInvalidCondition is returned for a step condition with an unknown type
+// or field, or referencing a step that does not come before it
+var ErrInvalidCondition = errors.New("invalid step condition")
+
+// Condition types. Open and click conditions check the email sent for an
+// earlier step, field conditions compare a lead field.
+const (
+	ConditionOpened         = "opened"
+	ConditionNotOpened      = "not_opened"
+	ConditionClicked        = "clicked"
+	ConditionNotClicked     = "not_clicked"
+	ConditionFieldEquals    = "field_equals"
+	ConditionFieldNotEquals = "field_not_equals"
+)
+
+// conditionFields are the lead fields field conditions can compare
+var conditionFields = map[string]func(*ent.Lead) string{
+	"industry":  func(l *ent.Lead) string { return string(l.Industry) },
+	"sub_niche": func(l *ent.Lead) string { return l.SubNiche },
+	"country":   func(l *ent.Lead) string { return l.Country },
+	"city":      func(l *ent.Lead) string { return l.City },
+	"status":    func(l *ent.Lead) string { return string(l.Status) },
+	"verified":  func(l *ent.Lead) string { return strconv.FormatBool(l.Verified) },
+}
+
+// StepCondition decides whether a step is sent when it is due. A step whose
+// condition does not hold is skipped and the next step takes its place, so
+// consecutive steps with opposite conditions branch the sequence. A nil
+// *StepCondition always holds.
+type StepCondition struct {
+	Type      string `json:"type"`                 // opened, not_opened, clicked, not_clicked, field_equals, field_not_equals
+	StepOrder int    `json:"step_order,omitempty"` // earlier step checked by open and click conditions
+	Field     string `json:"field,omitempty"`      // lead field compared by field conditions
+	Value     string `json:"value,omitempty"`      // value compared by field conditions
+}
+
+// ConditionFromFields builds a step's condition from its stored fields. It
+// returns nil when the step has none.
+func ConditionFromFields(step *ent.EmailSequenceStep) *StepCondition {
+	if step.ConditionType == nil {
+		return nil
+	}
+	cond := &StepCondition{
+		Type:  string(*step.ConditionType),
+		Field: step.ConditionField,
+		Value: step.ConditionValue,
+	}
+	if step.ConditionStepOrder != nil {
+		cond.StepOrder = *step.ConditionStepOrder
+	}
+	return cond
+}
+
+// Validate checks the condition of a step at stepOrder. Open and click
+// conditions must reference an earlier step, field conditions a known lead
+// field.
+func (c *StepCondition) Validate(stepOrder int) error {
+	if c == nil {
+		return nil
+	}
+	switch {
+	case c.checksSend():
+		if c.StepOrder < 1 || c.StepOrder >= stepOrder {
+			return fmt.Errorf("%w: step_order must reference an earlier step", ErrInvalidCondition)
+		}
+	case c.Type == ConditionFieldEquals || c.Type == ConditionFieldNotEquals:
+		if _, ok := conditionFields[c.Field]; !ok {
+			return fmt.Errorf("%w: unknown lead field %q", ErrInvalidCondition, c.Field)
+		}
+	default:
+		return fmt.Errorf("%w: unknown type %q", ErrInvalidCondition, c.Type)
+	}
+	return nil
+}
+
+// checksSend reports whether the condition checks an earlier step's email
+func (c *StepCondition) checksSend() bool {
+	if c == nil {
+		return false
+	}
+	switch c.Type {
+	case ConditionOpened, ConditionNotOpened, ConditionClicked, ConditionNotClicked:
+		return true
+	}
+	return false
+}
+
+// holds evaluates the condition for a lead. sends are the enrollment's
+// emails by step_order.
+func (c *StepCondition) holds(lead *ent.Lead, sends map[int]*ent.EmailSequenceSend) bool {
+	if c == nil {
+		return true
+	}
+	send := sends[c.StepOrder]
+	switch c.Type {
+	case ConditionOpened:
+		return opened(send)
+	case ConditionNotOpened:
+		return !opened(send)
+	case ConditionClicked:
+		return clicked(send)
+	case ConditionNotClicked:
+		return !clicked(send)
+	case ConditionFieldEquals:
+		return conditionFields[c.Field](lead) == c.Value
+	case ConditionFieldNotEquals:
+		return conditionFields[c.Field](lead) != c.Value
+	}
+	return false
+}
+
+// opened reports whether a send was opened, clicks count as opens since
+// images may be blocked
+func opened(send *ent.EmailSequenceSend) bool {
+	return send != nil && (send.OpenedAt != nil || clicked(send))
+}
+
+func clicked(send *ent.EmailSequenceSend) bool {
+	return send != nil && send.ClickedAt != nil
+}
+
+// setCondition sets a step's condition fields on create
+func setCondition(create *ent.EmailSequenceStepCreate, cond *StepCondition) *ent.EmailSequenceStepCreate {
+	if cond == nil {
+		return create
+	}
+	create = create.SetConditionType(emailsequencestep.ConditionType(cond.Type))
+	if cond.checksSend() {
+		return create.SetConditionStepOrder(cond.StepOrder)
+	}
+	return create.SetConditionField(cond.Field).SetConditionValue(cond.Value)
+}
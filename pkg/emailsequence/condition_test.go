@@ -0,0 +1,70 @@
+package emailsequence
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStepConditionValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		cond  *StepCondition
+		valid bool
+	}{
+		{"none", nil, true},
+		{"opened earlier step", &StepCondition{Type: ConditionOpened, StepOrder: 1}, true},
+		{"not clicked earlier step", &StepCondition{Type: ConditionNotClicked, StepOrder: 2}, true},
+		{"own step", &StepCondition{Type: ConditionOpened, StepOrder: 3}, false},
+		{"later step", &StepCondition{Type: ConditionClicked, StepOrder: 4}, false},
+		{"missing step", &StepCondition{Type: ConditionNotOpened}, false},
+		{"known field", &StepCondition{Type: ConditionFieldEquals, Field: "country", Value: "US"}, true},
+		{"unknown field", &StepCondition{Type: ConditionFieldNotEquals, Field: "password_hash"}, false},
+		{"unknown type", &StepCondition{Type: "replied", StepOrder: 1}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cond.Validate(3)
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.True(t, errors.Is(err, ErrInvalidCondition), "got %v", err)
+			}
+		})
+	}
+}
+
+func TestStepConditionHolds(t *testing.T) {
+	now := time.Now()
+	lead := &ent.Lead{Country: "US", Verified: true}
+	sends := map[int]*ent.EmailSequenceSend{
+		1: {OpenedAt: &now},
+		2: {ClickedAt: &now},
+		3: {},
+	}
+
+	tests := []struct {
+		name string
+		cond *StepCondition
+		want bool
+	}{
+		{"none", nil, true},
+		{"opened", &StepCondition{Type: ConditionOpened, StepOrder: 1}, true},
+		{"clicks count as opens", &StepCondition{Type: ConditionOpened, StepOrder: 2}, true},
+		{"not opened", &StepCondition{Type: ConditionNotOpened, StepOrder: 3}, true},
+		{"not sent yet", &StepCondition{Type: ConditionNotOpened, StepOrder: 4}, true},
+		{"clicked", &StepCondition{Type: ConditionClicked, StepOrder: 1}, false},
+		{"not clicked", &StepCondition{Type: ConditionNotClicked, StepOrder: 2}, false},
+		{"field equals", &StepCondition{Type: ConditionFieldEquals, Field: "country", Value: "US"}, true},
+		{"bool field", &StepCondition{Type: ConditionFieldEquals, Field: "verified", Value: "true"}, true},
+		{"field not equals", &StepCondition{Type: ConditionFieldNotEquals, Field: "country", Value: "US"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.cond.holds(lead, sends))
+		})
+	}
+}
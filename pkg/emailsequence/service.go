@@ -8,7 +8,13 @@ import (
 	"github.com/jordanlanch/industrydb/ent"
 	"github.com/jordanlanch/industrydb/ent/emailsequence"
 	"github.com/jordanlanch/industrydb/ent/emailsequenceenrollment"
+	"github.com/jordanlanch/industrydb/ent/emailsequencesend"
 	"github.com/jordanlanch/industrydb/ent/emailsequencestep"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/leadsuppression"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+	"github.com/jordanlanch/industrydb/pkg/deliverywindow"
+	"github.com/jordanlanch/industrydb/pkg/leads"
 )
 
 // Service handles email sequence operations.
@@ -23,15 +29,17 @@ func NewService(client *ent.Client) *Service {
 
 // SequenceResponse represents an email sequence.
 type SequenceResponse struct {
-	ID          int                  `json:"id"`
-	Name        string               `json:"name"`
-	Description string               `json:"description,omitempty"`
-	Status      string               `json:"status"`
-	Trigger     string               `json:"trigger"`
-	CreatedBy   int                  `json:"created_by"`
-	Steps       []SequenceStepBrief  `json:"steps,omitempty"`
-	CreatedAt   time.Time            `json:"created_at"`
-	UpdatedAt   time.Time            `json:"updated_at"`
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Status      string `json:"status"`
+	Trigger     string `json:"trigger"`
+	CreatedBy   int    `json:"created_by"`
+	// DeliveryWindow restricts sends to local hours (null = 24/7)
+	DeliveryWindow *deliverywindow.Window `json:"delivery_window"`
+	Steps          []SequenceStepBrief    `json:"steps,omitempty"`
+	CreatedAt      time.Time              `json:"created_at"`
+	UpdatedAt      time.Time              `json:"updated_at"`
 }
 
 // SequenceStepBrief is a brief representation of a sequence step.
@@ -40,6 +48,8 @@ type SequenceStepBrief struct {
 	StepOrder  int    `json:"step_order"`
 	DelayDays  int    `json:"delay_days"`
 	Subject    string `json:"subject"`
+	// Condition skips the step when it does not hold (null = always sent)
+	Condition *StepCondition `json:"condition"`
 }
 
 // SequenceStepResponse represents a full sequence step.
@@ -50,21 +60,24 @@ type SequenceStepResponse struct {
 	DelayDays  int       `json:"delay_days"`
 	Subject    string    `json:"subject"`
 	Body       string    `json:"body"`
-	CreatedAt  time.Time `json:"created_at"`
+	// Condition skips the step when it does not hold (null = always sent)
+	Condition *StepCondition `json:"condition"`
+	CreatedAt time.Time      `json:"created_at"`
 }
 
 // EnrollmentResponse represents an enrollment.
 type EnrollmentResponse struct {
-	ID            int        `json:"id"`
-	SequenceID    int        `json:"sequence_id"`
-	SequenceName  string     `json:"sequence_name"`
-	LeadID        int        `json:"lead_id"`
-	LeadName      string     `json:"lead_name"`
-	EnrolledBy    int        `json:"enrolled_by"`
-	Status        string     `json:"status"`
-	CurrentStep   int        `json:"current_step"`
-	EnrolledAt    time.Time  `json:"enrolled_at"`
-	CompletedAt   *time.Time `json:"completed_at,omitempty"`
+	ID           int        `json:"id"`
+	SequenceID   int        `json:"sequence_id"`
+	SequenceName string     `json:"sequence_name"`
+	LeadID       int        `json:"lead_id"`
+	LeadName     string     `json:"lead_name"`
+	EnrolledBy   int        `json:"enrolled_by"`
+	Status       string     `json:"status"`
+	CurrentStep  int        `json:"current_step"`
+	EnrolledAt   time.Time  `json:"enrolled_at"`
+	CompletedAt  *time.Time `json:"completed_at,omitempty"`
+	NextSendAt   *time.Time `json:"next_send_at,omitempty"`
 }
 
 // CreateSequenceRequest represents a request to create a sequence.
@@ -72,6 +85,8 @@ type CreateSequenceRequest struct {
 	Name        string `json:"name" validate:"required,max=200"`
 	Description string `json:"description,omitempty"`
 	Trigger     string `json:"trigger" validate:"required,oneof=lead_created lead_assigned lead_status_changed manual"`
+	// DeliveryWindow restricts sends to local hours (default 24/7)
+	DeliveryWindow *deliverywindow.Window `json:"delivery_window,omitempty"`
 }
 
 // UpdateSequenceRequest represents a request to update a sequence.
@@ -79,6 +94,9 @@ type UpdateSequenceRequest struct {
 	Name        *string `json:"name,omitempty" validate:"omitempty,max=200"`
 	Description *string `json:"description,omitempty"`
 	Status      *string `json:"status,omitempty" validate:"omitempty,oneof=draft active paused archived"`
+	// DeliveryWindow replaces the send window; ClearDeliveryWindow sends 24/7
+	DeliveryWindow      *deliverywindow.Window `json:"delivery_window,omitempty"`
+	ClearDeliveryWindow bool                   `json:"clear_delivery_window,omitempty"`
 }
 
 // CreateStepRequest represents a request to create a sequence step.
@@ -88,6 +106,8 @@ type CreateStepRequest struct {
 	DelayDays  int    `json:"delay_days" validate:"min=0"`
 	Subject    string `json:"subject" validate:"required,max=500"`
 	Body       string `json:"body" validate:"required"`
+	// Condition skips the step when it does not hold (default always sent)
+	Condition *StepCondition `json:"condition,omitempty"`
 }
 
 // EnrollLeadRequest represents a request to enroll a lead in a sequence.
@@ -96,28 +116,57 @@ type EnrollLeadRequest struct {
 	LeadID     int `json:"lead_id" validate:"required"`
 }
 
+// StopAllRequest represents a request to stop a sequence's active
+// enrollments. Lead filters are optional and combined; without any, every
+// active enrollment of the sequence is stopped.
+type StopAllRequest struct {
+	LeadIDs    []int  `json:"lead_ids,omitempty"`
+	Industry   string `json:"industry,omitempty"`
+	Country    string `json:"country,omitempty" validate:"omitempty,len=2"`
+	City       string `json:"city,omitempty"`
+	LeadStatus string `json:"lead_status,omitempty" validate:"omitempty,oneof=new contacted qualified negotiating won lost archived"`
+}
+
+// StopAllResponse reports how many enrollments were stopped.
+type StopAllResponse struct {
+	SequenceID int `json:"sequence_id"`
+	Stopped    int `json:"stopped"`
+}
+
 // CreateSequence creates a new email sequence.
 func (s *Service) CreateSequence(ctx context.Context, userID int, req CreateSequenceRequest) (*SequenceResponse, error) {
-	sequence, err := s.client.EmailSequence.
+	if err := req.DeliveryWindow.Validate(); err != nil {
+		return nil, err
+	}
+
+	create := s.client.EmailSequence.
 		Create().
 		SetName(req.Name).
 		SetNillableDescription(&req.Description).
 		SetTrigger(emailsequence.Trigger(req.Trigger)).
-		SetCreatedByUserID(userID).
-		Save(ctx)
+		SetCreatedByUserID(userID)
+	if w := req.DeliveryWindow; w != nil {
+		create = create.
+			SetDeliveryTimezone(w.Timezone).
+			SetDeliveryStartHour(w.StartHour).
+			SetDeliveryEndHour(w.EndHour)
+	}
+
+	sequence, err := create.Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create sequence: %w", err)
 	}
 
 	return &SequenceResponse{
-		ID:          sequence.ID,
-		Name:        sequence.Name,
-		Description: sequence.Description,
-		Status:      string(sequence.Status),
-		Trigger:     string(sequence.Trigger),
-		CreatedBy:   sequence.CreatedByUserID,
-		CreatedAt:   sequence.CreatedAt,
-		UpdatedAt:   sequence.UpdatedAt,
+		ID:             sequence.ID,
+		Name:           sequence.Name,
+		Description:    sequence.Description,
+		Status:         string(sequence.Status),
+		Trigger:        string(sequence.Trigger),
+		CreatedBy:      sequence.CreatedByUserID,
+		DeliveryWindow: DeliveryWindow(sequence),
+		CreatedAt:      sequence.CreatedAt,
+		UpdatedAt:      sequence.UpdatedAt,
 	}, nil
 }
 
@@ -143,20 +192,22 @@ func (s *Service) GetSequence(ctx context.Context, sequenceID int) (*SequenceRes
 				StepOrder: step.StepOrder,
 				DelayDays: step.DelayDays,
 				Subject:   step.Subject,
+				Condition: ConditionFromFields(step),
 			})
 		}
 	}
 
 	return &SequenceResponse{
-		ID:          sequence.ID,
-		Name:        sequence.Name,
-		Description: sequence.Description,
-		Status:      string(sequence.Status),
-		Trigger:     string(sequence.Trigger),
-		CreatedBy:   sequence.CreatedByUserID,
-		Steps:       steps,
-		CreatedAt:   sequence.CreatedAt,
-		UpdatedAt:   sequence.UpdatedAt,
+		ID:             sequence.ID,
+		Name:           sequence.Name,
+		Description:    sequence.Description,
+		Status:         string(sequence.Status),
+		Trigger:        string(sequence.Trigger),
+		CreatedBy:      sequence.CreatedByUserID,
+		DeliveryWindow: DeliveryWindow(sequence),
+		Steps:          steps,
+		CreatedAt:      sequence.CreatedAt,
+		UpdatedAt:      sequence.UpdatedAt,
 	}, nil
 }
 
@@ -174,14 +225,15 @@ func (s *Service) ListSequences(ctx context.Context, userID int) ([]SequenceResp
 	result := make([]SequenceResponse, len(sequences))
 	for i, seq := range sequences {
 		result[i] = SequenceResponse{
-			ID:          seq.ID,
-			Name:        seq.Name,
-			Description: seq.Description,
-			Status:      string(seq.Status),
-			Trigger:     string(seq.Trigger),
-			CreatedBy:   seq.CreatedByUserID,
-			CreatedAt:   seq.CreatedAt,
-			UpdatedAt:   seq.UpdatedAt,
+			ID:             seq.ID,
+			Name:           seq.Name,
+			Description:    seq.Description,
+			Status:         string(seq.Status),
+			Trigger:        string(seq.Trigger),
+			CreatedBy:      seq.CreatedByUserID,
+			DeliveryWindow: DeliveryWindow(seq),
+			CreatedAt:      seq.CreatedAt,
+			UpdatedAt:      seq.UpdatedAt,
 		}
 	}
 
@@ -190,6 +242,10 @@ func (s *Service) ListSequences(ctx context.Context, userID int) ([]SequenceResp
 
 // UpdateSequence updates a sequence.
 func (s *Service) UpdateSequence(ctx context.Context, userID, sequenceID int, req UpdateSequenceRequest) (*SequenceResponse, error) {
+	if err := req.DeliveryWindow.Validate(); err != nil {
+		return nil, err
+	}
+
 	// Verify ownership
 	sequence, err := s.client.EmailSequence.
 		Query().
@@ -216,21 +272,36 @@ func (s *Service) UpdateSequence(ctx context.Context, userID, sequenceID int, re
 	if req.Status != nil {
 		update = update.SetStatus(emailsequence.Status(*req.Status))
 	}
+	if w := req.DeliveryWindow; w != nil {
+		update = update.
+			SetDeliveryTimezone(w.Timezone).
+			SetDeliveryStartHour(w.StartHour).
+			SetDeliveryEndHour(w.EndHour)
+	} else if req.ClearDeliveryWindow {
+		update = update.ClearDeliveryTimezone().ClearDeliveryStartHour().ClearDeliveryEndHour()
+	}
 
 	updated, err := update.Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update sequence: %w", err)
 	}
 
+	if req.DeliveryWindow != nil {
+		if err := s.deferScheduledSends(ctx, updated); err != nil {
+			return nil, err
+		}
+	}
+
 	return &SequenceResponse{
-		ID:          updated.ID,
-		Name:        updated.Name,
-		Description: updated.Description,
-		Status:      string(updated.Status),
-		Trigger:     string(updated.Trigger),
-		CreatedBy:   updated.CreatedByUserID,
-		CreatedAt:   updated.CreatedAt,
-		UpdatedAt:   updated.UpdatedAt,
+		ID:             updated.ID,
+		Name:           updated.Name,
+		Description:    updated.Description,
+		Status:         string(updated.Status),
+		Trigger:        string(updated.Trigger),
+		CreatedBy:      updated.CreatedByUserID,
+		DeliveryWindow: DeliveryWindow(updated),
+		CreatedAt:      updated.CreatedAt,
+		UpdatedAt:      updated.UpdatedAt,
 	}, nil
 }
 
@@ -271,14 +342,34 @@ func (s *Service) CreateStep(ctx context.Context, userID int, req CreateStepRequ
 		return nil, fmt.Errorf("failed to verify sequence: %w", err)
 	}
 
-	step, err := s.client.EmailSequenceStep.
+	if err := req.Condition.Validate(req.StepOrder); err != nil {
+		return nil, err
+	}
+	if req.Condition.checksSend() {
+		exists, err := s.client.EmailSequenceStep.
+			Query().
+			Where(
+				emailsequencestep.SequenceID(req.SequenceID),
+				emailsequencestep.StepOrder(req.Condition.StepOrder),
+			).
+			Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to verify condition step: %w", err)
+		}
+		if !exists {
+			return nil, fmt.Errorf("%w: step %d does not exist", ErrInvalidCondition, req.Condition.StepOrder)
+		}
+	}
+
+	create := s.client.EmailSequenceStep.
 		Create().
 		SetSequenceID(req.SequenceID).
 		SetStepOrder(req.StepOrder).
 		SetDelayDays(req.DelayDays).
 		SetSubject(req.Subject).
-		SetBody(req.Body).
-		Save(ctx)
+		SetBody(req.Body)
+
+	step, err := setCondition(create, req.Condition).Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create step: %w", err)
 	}
@@ -290,6 +381,7 @@ func (s *Service) CreateStep(ctx context.Context, userID int, req CreateStepRequ
 		DelayDays:  step.DelayDays,
 		Subject:    step.Subject,
 		Body:       step.Body,
+		Condition:  ConditionFromFields(step),
 		CreatedAt:  step.CreatedAt,
 	}, nil
 }
@@ -314,6 +406,7 @@ func (s *Service) GetStep(ctx context.Context, stepID int) (*SequenceStepRespons
 		DelayDays:  step.DelayDays,
 		Subject:    step.Subject,
 		Body:       step.Body,
+		Condition:  ConditionFromFields(step),
 		CreatedAt:  step.CreatedAt,
 	}, nil
 }
@@ -358,6 +451,22 @@ func (s *Service) EnrollLead(ctx context.Context, userID int, req EnrollLeadRequ
 		return nil, fmt.Errorf("failed to fetch lead: %w", err)
 	}
 
+	// Leads suppressed by the enrolling user or the sequence owner are
+	// never enrolled, including by automatic triggers
+	suppressed, err := s.client.LeadSuppression.
+		Query().
+		Where(
+			leadsuppression.LeadID(req.LeadID),
+			leadsuppression.Or(leads.SuppressedFor(userID), leads.SuppressedFor(sequence.CreatedByUserID)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check lead suppression: %w", err)
+	}
+	if suppressed {
+		return nil, fmt.Errorf("lead is suppressed")
+	}
+
 	// Create enrollment
 	enrollment, err := s.client.EmailSequenceEnrollment.
 		Create().
@@ -369,6 +478,11 @@ func (s *Service) EnrollLead(ctx context.Context, userID int, req EnrollLeadRequ
 		return nil, fmt.Errorf("failed to create enrollment: %w", err)
 	}
 
+	nextSendAt, err := s.scheduleNextSend(ctx, sequence, enrollment, enrollment.EnrolledAt)
+	if err != nil {
+		return nil, err
+	}
+
 	return &EnrollmentResponse{
 		ID:           enrollment.ID,
 		SequenceID:   enrollment.SequenceID,
@@ -379,6 +493,7 @@ func (s *Service) EnrollLead(ctx context.Context, userID int, req EnrollLeadRequ
 		Status:       string(enrollment.Status),
 		CurrentStep:  enrollment.CurrentStep,
 		EnrolledAt:   enrollment.EnrolledAt,
+		NextSendAt:   nextSendAt,
 	}, nil
 }
 
@@ -458,15 +573,332 @@ func (s *Service) ListLeadEnrollments(ctx context.Context, leadID int) ([]Enroll
 
 // StopEnrollment stops an enrollment (sets status to stopped).
 func (s *Service) StopEnrollment(ctx context.Context, enrollmentID int) error {
-	_, err := s.client.EmailSequenceEnrollment.
-		UpdateOneID(enrollmentID).
+	stopped, err := s.stopEnrollments(ctx, emailsequenceenrollment.ID(enrollmentID))
+	if err != nil {
+		return err
+	}
+	if stopped == 0 {
+		return fmt.Errorf("enrollment not found")
+	}
+
+	return nil
+}
+
+// StopAllEnrollments stops the active enrollments of a sequence owned by the
+// user, limited to the leads matching the request's filters.
+func (s *Service) StopAllEnrollments(ctx context.Context, userID, sequenceID int, req StopAllRequest) (*StopAllResponse, error) {
+	// Verify ownership
+	exists, err := s.client.EmailSequence.
+		Query().
+		Where(
+			emailsequence.ID(sequenceID),
+			emailsequence.CreatedByUserID(userID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify sequence: %w", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("sequence not found or unauthorized")
+	}
+
+	preds := []predicate.EmailSequenceEnrollment{
+		emailsequenceenrollment.SequenceID(sequenceID),
+		emailsequenceenrollment.StatusEQ(emailsequenceenrollment.StatusActive),
+	}
+	if leadPreds := req.leadPredicates(); len(leadPreds) > 0 {
+		preds = append(preds, emailsequenceenrollment.HasLeadWith(leadPreds...))
+	}
+
+	stopped, err := s.stopEnrollments(ctx, preds...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StopAllResponse{
+		SequenceID: sequenceID,
+		Stopped:    stopped,
+	}, nil
+}
+
+// leadPredicates translates the request's lead filters into predicates
+func (req StopAllRequest) leadPredicates() []predicate.Lead {
+	var preds []predicate.Lead
+	if len(req.LeadIDs) > 0 {
+		preds = append(preds, lead.IDIn(req.LeadIDs...))
+	}
+	if req.Industry != "" {
+		preds = append(preds, lead.IndustryEQ(lead.Industry(req.Industry)))
+	}
+	if req.Country != "" {
+		preds = append(preds, lead.CountryEQ(req.Country))
+	}
+	if req.City != "" {
+		preds = append(preds, lead.CityEQ(req.City))
+	}
+	if req.LeadStatus != "" {
+		preds = append(preds, lead.StatusEQ(lead.Status(req.LeadStatus)))
+	}
+	return preds
+}
+
+// stopEnrollments moves the matching enrollments to stopped, after which no
+// more emails are sent to them, and returns how many were stopped
+func (s *Service) stopEnrollments(ctx context.Context, preds ...predicate.EmailSequenceEnrollment) (int, error) {
+	stopped, err := s.client.EmailSequenceEnrollment.
+		Update().
+		Where(preds...).
 		SetStatus(emailsequenceenrollment.StatusStopped).
 		Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to stop enrollment: %w", err)
+	}
+
+	return stopped, nil
+}
+
+// ResolveDueSend is called by the dispatch job for a scheduled send that is
+// due, before delivering it. It evaluates the step's condition and returns
+// the send to deliver: the send itself when the condition holds, otherwise
+// it is marked skipped and the first following step whose condition holds
+// takes its place at the same time. It returns nil when nothing is left to
+// send, completing the enrollment, or when the enrollment is no longer
+// active.
+func (s *Service) ResolveDueSend(ctx context.Context, sendID int) (*ent.EmailSequenceSend, error) {
+	send, err := s.client.EmailSequenceSend.
+		Query().
+		Where(emailsequencesend.ID(sendID)).
+		WithStep().
+		WithEnrollment(func(q *ent.EmailSequenceEnrollmentQuery) {
+			q.WithLead().WithSends(func(q *ent.EmailSequenceSendQuery) { q.WithStep() })
+		}).
+		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return fmt.Errorf("enrollment not found")
+			return nil, fmt.Errorf("send not found")
+		}
+		return nil, fmt.Errorf("failed to fetch send: %w", err)
+	}
+	if send.Status != emailsequencesend.StatusScheduled {
+		return nil, fmt.Errorf("send is not scheduled")
+	}
+
+	enrollment := send.Edges.Enrollment
+	var next *ent.EmailSequenceStep
+	if enrollment.Status == emailsequenceenrollment.StatusActive {
+		next, err = s.nextSendableStep(ctx, send.Edges.Step, enrollment)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if next != nil && next.ID == send.StepID {
+		return send, nil
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	resolved, err := skipSend(ctx, tx.Client(), send, enrollment, next)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return resolved, nil
+}
+
+// RecordSent is called by the dispatch job once a send was delivered. It
+// moves the enrollment to the send's step and schedules the following step,
+// whose condition is evaluated when it is due, or completes the enrollment
+// when the sequence has no further steps.
+func (s *Service) RecordSent(ctx context.Context, sendID int) error {
+	send, err := s.client.EmailSequenceSend.
+		Query().
+		Where(emailsequencesend.ID(sendID)).
+		WithStep().
+		WithEnrollment(func(q *ent.EmailSequenceEnrollmentQuery) { q.WithSequence() }).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("send not found")
+		}
+		return fmt.Errorf("failed to fetch send: %w", err)
+	}
+
+	now := time.Now()
+	if err := s.client.EmailSequenceSend.
+		UpdateOne(send).
+		SetStatus(emailsequencesend.StatusSent).
+		SetSentAt(now).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("failed to update send: %w", err)
+	}
+
+	enrollment, err := s.client.EmailSequenceEnrollment.
+		UpdateOneID(send.EnrollmentID).
+		SetCurrentStep(send.Edges.Step.StepOrder).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update enrollment: %w", err)
+	}
+	if enrollment.Status != emailsequenceenrollment.StatusActive {
+		return nil
+	}
+
+	nextSendAt, err := s.scheduleNextSend(ctx, send.Edges.Enrollment.Edges.Sequence, enrollment, now)
+	if err != nil {
+		return err
+	}
+	if nextSendAt == nil {
+		if err := s.client.EmailSequenceEnrollment.
+			UpdateOne(enrollment).
+			SetStatus(emailsequenceenrollment.StatusCompleted).
+			SetCompletedAt(now).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to complete enrollment: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// nextSendableStep returns the first step of the enrollment's sequence,
+// starting at from, whose condition holds for the enrollment, nil when
+// there is none
+func (s *Service) nextSendableStep(ctx context.Context, from *ent.EmailSequenceStep, enrollment *ent.EmailSequenceEnrollment) (*ent.EmailSequenceStep, error) {
+	steps, err := s.client.EmailSequenceStep.
+		Query().
+		Where(
+			emailsequencestep.SequenceID(from.SequenceID),
+			emailsequencestep.StepOrderGTE(from.StepOrder),
+		).
+		Order(ent.Asc(emailsequencestep.FieldStepOrder)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch steps: %w", err)
+	}
+
+	sends := make(map[int]*ent.EmailSequenceSend, len(enrollment.Edges.Sends))
+	for _, send := range enrollment.Edges.Sends {
+		if send.Edges.Step != nil && send.Status != emailsequencesend.StatusSkipped {
+			sends[send.Edges.Step.StepOrder] = send
+		}
+	}
+
+	for _, step := range steps {
+		if ConditionFromFields(step).holds(enrollment.Edges.Lead, sends) {
+			return step, nil
+		}
+	}
+	return nil, nil
+}
+
+// skipSend marks a send skipped and schedules next in its place at the same
+// time. Without a next step an active enrollment is completed.
+func skipSend(ctx context.Context, client *ent.Client, send *ent.EmailSequenceSend, enrollment *ent.EmailSequenceEnrollment, next *ent.EmailSequenceStep) (*ent.EmailSequenceSend, error) {
+	if err := client.EmailSequenceSend.
+		UpdateOne(send).
+		SetStatus(emailsequencesend.StatusSkipped).
+		Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to skip send: %w", err)
+	}
+
+	if next == nil {
+		if enrollment.Status != emailsequenceenrollment.StatusActive {
+			return nil, nil
+		}
+		if err := client.EmailSequenceEnrollment.
+			UpdateOne(enrollment).
+			SetStatus(emailsequenceenrollment.StatusCompleted).
+			SetCompletedAt(time.Now()).
+			Exec(ctx); err != nil {
+			return nil, fmt.Errorf("failed to complete enrollment: %w", err)
+		}
+		return nil, nil
+	}
+
+	resolved, err := client.EmailSequenceSend.
+		Create().
+		SetEnrollmentID(send.EnrollmentID).
+		SetStepID(next.ID).
+		SetLeadID(send.LeadID).
+		SetScheduledFor(send.ScheduledFor).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to schedule send: %w", err)
+	}
+	return resolved, nil
+}
+
+// DeliveryWindow returns the sequence's send window, nil when it sends 24/7
+func DeliveryWindow(sequence *ent.EmailSequence) *deliverywindow.Window {
+	return deliverywindow.FromFields(sequence.DeliveryTimezone, sequence.DeliveryStartHour, sequence.DeliveryEndHour)
+}
+
+// scheduleNextSend schedules the enrollment's next step delay_days after
+// `after`, deferred to the sequence's send window. It returns nil when the
+// sequence has no further steps.
+func (s *Service) scheduleNextSend(ctx context.Context, sequence *ent.EmailSequence, enrollment *ent.EmailSequenceEnrollment, after time.Time) (*time.Time, error) {
+	step, err := s.client.EmailSequenceStep.
+		Query().
+		Where(
+			emailsequencestep.SequenceID(sequence.ID),
+			emailsequencestep.StepOrderGT(enrollment.CurrentStep),
+		).
+		Order(ent.Asc(emailsequencestep.FieldStepOrder)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch next step: %w", err)
+	}
+
+	scheduledFor := DeliveryWindow(sequence).Next(after.AddDate(0, 0, step.DelayDays))
+	_, err = s.client.EmailSequenceSend.
+		Create().
+		SetEnrollmentID(enrollment.ID).
+		SetStepID(step.ID).
+		SetLeadID(enrollment.LeadID).
+		SetScheduledFor(scheduledFor).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to schedule send: %w", err)
+	}
+
+	return &scheduledFor, nil
+}
+
+// deferScheduledSends moves the sequence's scheduled sends that fall outside
+// its new send window to the window's next opening
+func (s *Service) deferScheduledSends(ctx context.Context, sequence *ent.EmailSequence) error {
+	window := DeliveryWindow(sequence)
+
+	sends, err := s.client.EmailSequenceSend.
+		Query().
+		Where(
+			emailsequencesend.StatusEQ(emailsequencesend.StatusScheduled),
+			emailsequencesend.HasEnrollmentWith(emailsequenceenrollment.SequenceID(sequence.ID)),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch scheduled sends: %w", err)
+	}
+
+	for _, send := range sends {
+		if window.Open(send.ScheduledFor) {
+			continue
+		}
+		if err := s.client.EmailSequenceSend.
+			UpdateOne(send).
+			SetScheduledFor(window.Next(send.ScheduledFor)).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to reschedule send: %w", err)
 		}
-		return fmt.Errorf("failed to stop enrollment: %w", err)
 	}
 
 	return nil
@@ -2,11 +2,14 @@ package emailsequence
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/emailsequencesend"
 	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/pkg/deliverywindow"
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -357,6 +360,24 @@ func TestEnrollLead(t *testing.T) {
 		assert.Contains(t, err.Error(), "already enrolled")
 	})
 
+	t.Run("Error - Lead suppressed", func(t *testing.T) {
+		suppressed := createTestLead(t, client, "Suppressed Lead", "suppressed@test.com")
+		client.LeadSuppression.Create().
+			SetLeadID(suppressed.ID).
+			SetUserID(user.ID).
+			SetReason("Not a fit").
+			SaveX(ctx)
+
+		result, err := service.EnrollLead(ctx, user.ID, EnrollLeadRequest{
+			SequenceID: sequence.ID,
+			LeadID:     suppressed.ID,
+		})
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "suppressed")
+	})
+
 	t.Run("Error - Sequence not active", func(t *testing.T) {
 		// Create draft sequence
 		draftSeq, _ := service.CreateSequence(ctx, user.ID, CreateSequenceRequest{
@@ -379,6 +400,86 @@ func TestEnrollLead(t *testing.T) {
 	})
 }
 
+func TestSequenceDeliveryWindow(t *testing.T) {
+	client, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	service := NewService(client)
+
+	user := createTestUser(t, client, "owner@test.com", "Owner")
+	lead := createTestLead(t, client, "Test Lead", "lead@test.com")
+
+	t.Run("Error - Invalid window", func(t *testing.T) {
+		result, err := service.CreateSequence(ctx, user.ID, CreateSequenceRequest{
+			Name:           "Bad Window",
+			Trigger:        "manual",
+			DeliveryWindow: &deliverywindow.Window{Timezone: "Mars/Olympus", StartHour: 9, EndHour: 17},
+		})
+
+		assert.ErrorIs(t, err, deliverywindow.ErrInvalidWindow)
+		assert.Nil(t, result)
+	})
+
+	business := &deliverywindow.Window{Timezone: "America/New_York", StartHour: 9, EndHour: 17}
+	sequence, err := service.CreateSequence(ctx, user.ID, CreateSequenceRequest{
+		Name:           "Business Hours",
+		Trigger:        "manual",
+		DeliveryWindow: business,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, business, sequence.DeliveryWindow)
+
+	_, err = service.CreateStep(ctx, user.ID, CreateStepRequest{
+		SequenceID: sequence.ID,
+		StepOrder:  1,
+		DelayDays:  1,
+		Subject:    "Welcome",
+		Body:       "Hi {{name}}",
+	})
+	require.NoError(t, err)
+
+	status := "active"
+	_, err = service.UpdateSequence(ctx, user.ID, sequence.ID, UpdateSequenceRequest{Status: &status})
+	require.NoError(t, err)
+
+	t.Run("Success - First send scheduled inside the window", func(t *testing.T) {
+		result, err := service.EnrollLead(ctx, user.ID, EnrollLeadRequest{
+			SequenceID: sequence.ID,
+			LeadID:     lead.ID,
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, result.NextSendAt)
+		assert.True(t, business.Open(*result.NextSendAt))
+		assert.False(t, result.NextSendAt.Before(result.EnrolledAt.AddDate(0, 0, 1)))
+	})
+
+	t.Run("Success - Changing the window defers scheduled sends", func(t *testing.T) {
+		overnight := &deliverywindow.Window{Timezone: "UTC", StartHour: 2, EndHour: 3}
+		result, err := service.UpdateSequence(ctx, user.ID, sequence.ID, UpdateSequenceRequest{
+			DeliveryWindow: overnight,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, overnight, result.DeliveryWindow)
+
+		send, err := client.EmailSequenceSend.Query().
+			Where(emailsequencesend.LeadID(lead.ID)).
+			Only(ctx)
+		require.NoError(t, err)
+		assert.True(t, overnight.Open(send.ScheduledFor))
+	})
+
+	t.Run("Success - Clear window", func(t *testing.T) {
+		result, err := service.UpdateSequence(ctx, user.ID, sequence.ID, UpdateSequenceRequest{
+			ClearDeliveryWindow: true,
+		})
+
+		require.NoError(t, err)
+		assert.Nil(t, result.DeliveryWindow)
+	})
+}
+
 func TestGetEnrollment(t *testing.T) {
 	client, cleanup := setupTestDB(t)
 	defer cleanup()
@@ -488,3 +589,239 @@ func TestStopEnrollment(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestStopAllEnrollments(t *testing.T) {
+	client, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	service := NewService(client)
+
+	owner := createTestUser(t, client, "owner@test.com", "Owner")
+	other := createTestUser(t, client, "other@test.com", "Other")
+
+	sequence, err := service.CreateSequence(ctx, owner.ID, CreateSequenceRequest{
+		Name:    "Campaign",
+		Trigger: "manual",
+	})
+	require.NoError(t, err)
+	status := "active"
+	_, err = service.UpdateSequence(ctx, owner.ID, sequence.ID, UpdateSequenceRequest{Status: &status})
+	require.NoError(t, err)
+
+	enroll := func(name, city string) *EnrollmentResponse {
+		lead := createTestLead(t, client, name, name+"@test.com")
+		client.Lead.UpdateOneID(lead.ID).SetCity(city).ExecX(ctx)
+		enrollment, err := service.EnrollLead(ctx, owner.ID, EnrollLeadRequest{SequenceID: sequence.ID, LeadID: lead.ID})
+		require.NoError(t, err)
+		return enrollment
+	}
+	nyc1 := enroll("nyc1", "NYC")
+	nyc2 := enroll("nyc2", "NYC")
+	la := enroll("la", "LA")
+	completed := enroll("done", "NYC")
+	client.EmailSequenceEnrollment.UpdateOneID(completed.ID).SetStatus("completed").ExecX(ctx)
+
+	statusOf := func(id int) string {
+		enrollment, err := service.GetEnrollment(ctx, id)
+		require.NoError(t, err)
+		return enrollment.Status
+	}
+
+	t.Run("Error - Not the owner", func(t *testing.T) {
+		_, err := service.StopAllEnrollments(ctx, other.ID, sequence.ID, StopAllRequest{})
+		assert.EqualError(t, err, "sequence not found or unauthorized")
+		assert.Equal(t, "active", statusOf(nyc1.ID))
+	})
+
+	t.Run("Success - Filtered by lead", func(t *testing.T) {
+		result, err := service.StopAllEnrollments(ctx, owner.ID, sequence.ID, StopAllRequest{City: "NYC"})
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.Stopped)
+		assert.Equal(t, "stopped", statusOf(nyc1.ID))
+		assert.Equal(t, "stopped", statusOf(nyc2.ID))
+		assert.Equal(t, "active", statusOf(la.ID))
+		// Only active enrollments are stopped
+		assert.Equal(t, "completed", statusOf(completed.ID))
+	})
+
+	t.Run("Success - All remaining", func(t *testing.T) {
+		result, err := service.StopAllEnrollments(ctx, owner.ID, sequence.ID, StopAllRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Stopped)
+		assert.Equal(t, "stopped", statusOf(la.ID))
+
+		result, err = service.StopAllEnrollments(ctx, owner.ID, sequence.ID, StopAllRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, 0, result.Stopped)
+	})
+}
+
+func TestSequenceBranching(t *testing.T) {
+	client, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	service := NewService(client)
+
+	user := createTestUser(t, client, "owner@test.com", "Owner")
+	sequence, err := service.CreateSequence(ctx, user.ID, CreateSequenceRequest{Name: "Branching", Trigger: "manual"})
+	require.NoError(t, err)
+	status := "active"
+	_, err = service.UpdateSequence(ctx, user.ID, sequence.ID, UpdateSequenceRequest{Status: &status})
+	require.NoError(t, err)
+
+	createStep := func(order int, subject string, cond *StepCondition) (*SequenceStepResponse, error) {
+		return service.CreateStep(ctx, user.ID, CreateStepRequest{
+			SequenceID: sequence.ID,
+			StepOrder:  order,
+			DelayDays:  2,
+			Subject:    subject,
+			Body:       "Hi",
+			Condition:  cond,
+		})
+	}
+
+	_, err = createStep(1, "Intro", nil)
+	require.NoError(t, err)
+	opened, err := createStep(2, "Thanks for reading", &StepCondition{Type: ConditionOpened, StepOrder: 1})
+	require.NoError(t, err)
+	assert.Equal(t, &StepCondition{Type: ConditionOpened, StepOrder: 1}, opened.Condition)
+	_, err = createStep(3, "Did you miss this?", &StepCondition{Type: ConditionNotOpened, StepOrder: 1})
+	require.NoError(t, err)
+
+	t.Run("Error - Condition references a missing step", func(t *testing.T) {
+		_, err := createStep(10, "Invalid", &StepCondition{Type: ConditionClicked, StepOrder: 5})
+		assert.True(t, errors.Is(err, ErrInvalidCondition))
+
+		_, err = createStep(11, "Invalid", &StepCondition{Type: ConditionClicked, StepOrder: 11})
+		assert.True(t, errors.Is(err, ErrInvalidCondition))
+	})
+
+	// run enrolls a lead, sends the first step and resolves the second,
+	// returning the subject of the email chosen for it
+	run := func(email string, openFirst bool) (string, int) {
+		lead := createTestLead(t, client, email, email)
+		enrollment, err := service.EnrollLead(ctx, user.ID, EnrollLeadRequest{SequenceID: sequence.ID, LeadID: lead.ID})
+		require.NoError(t, err)
+
+		first := client.EmailSequenceSend.Query().
+			Where(emailsequencesend.EnrollmentID(enrollment.ID)).
+			OnlyX(ctx)
+		resolved, err := service.ResolveDueSend(ctx, first.ID)
+		require.NoError(t, err)
+		require.Equal(t, first.ID, resolved.ID)
+		require.NoError(t, service.RecordSent(ctx, first.ID))
+		if openFirst {
+			client.EmailSequenceSend.UpdateOneID(first.ID).SetOpenedAt(time.Now()).ExecX(ctx)
+		}
+
+		second := client.EmailSequenceSend.Query().
+			Where(
+				emailsequencesend.EnrollmentID(enrollment.ID),
+				emailsequencesend.StatusEQ(emailsequencesend.StatusScheduled),
+			).
+			OnlyX(ctx)
+		resolved, err = service.ResolveDueSend(ctx, second.ID)
+		require.NoError(t, err)
+		require.NotNil(t, resolved)
+		assert.Equal(t, second.ScheduledFor.Unix(), resolved.ScheduledFor.Unix())
+		require.NoError(t, service.RecordSent(ctx, resolved.ID))
+
+		return client.EmailSequenceStep.GetX(ctx, resolved.StepID).Subject, enrollment.ID
+	}
+
+	t.Run("Success - Opened branch", func(t *testing.T) {
+		subject, enrollmentID := run("reader@test.com", true)
+		assert.Equal(t, "Thanks for reading", subject)
+
+		// The other branch is skipped when due, completing the enrollment
+		third := client.EmailSequenceSend.Query().
+			Where(
+				emailsequencesend.EnrollmentID(enrollmentID),
+				emailsequencesend.StatusEQ(emailsequencesend.StatusScheduled),
+			).
+			OnlyX(ctx)
+		resolved, err := service.ResolveDueSend(ctx, third.ID)
+		require.NoError(t, err)
+		assert.Nil(t, resolved)
+
+		enrollment, err := service.GetEnrollment(ctx, enrollmentID)
+		require.NoError(t, err)
+		assert.Equal(t, "completed", enrollment.Status)
+		assert.Equal(t, emailsequencesend.StatusSkipped, client.EmailSequenceSend.GetX(ctx, third.ID).Status)
+	})
+
+	t.Run("Success - Not opened branch", func(t *testing.T) {
+		subject, enrollmentID := run("skimmer@test.com", false)
+		assert.Equal(t, "Did you miss this?", subject)
+
+		// Last step sent
+		enrollment, err := service.GetEnrollment(ctx, enrollmentID)
+		require.NoError(t, err)
+		assert.Equal(t, "completed", enrollment.Status)
+		assert.Equal(t, 3, enrollment.CurrentStep)
+	})
+
+	t.Run("Stopped enrollments are not sent", func(t *testing.T) {
+		lead := createTestLead(t, client, "stopped", "stopped@test.com")
+		enrollment, err := service.EnrollLead(ctx, user.ID, EnrollLeadRequest{SequenceID: sequence.ID, LeadID: lead.ID})
+		require.NoError(t, err)
+		require.NoError(t, service.StopEnrollment(ctx, enrollment.ID))
+
+		send := client.EmailSequenceSend.Query().
+			Where(emailsequencesend.EnrollmentID(enrollment.ID)).
+			OnlyX(ctx)
+		resolved, err := service.ResolveDueSend(ctx, send.ID)
+		require.NoError(t, err)
+		assert.Nil(t, resolved)
+
+		result, err := service.GetEnrollment(ctx, enrollment.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "stopped", result.Status)
+	})
+}
+
+func TestLinearSequenceDispatch(t *testing.T) {
+	client, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	service := NewService(client)
+
+	user := createTestUser(t, client, "owner@test.com", "Owner")
+	sequence, err := service.CreateSequence(ctx, user.ID, CreateSequenceRequest{Name: "Linear", Trigger: "manual"})
+	require.NoError(t, err)
+	status := "active"
+	_, err = service.UpdateSequence(ctx, user.ID, sequence.ID, UpdateSequenceRequest{Status: &status})
+	require.NoError(t, err)
+	for order := 1; order <= 3; order++ {
+		_, err := service.CreateStep(ctx, user.ID, CreateStepRequest{SequenceID: sequence.ID, StepOrder: order, DelayDays: 1, Subject: "Step", Body: "Hi"})
+		require.NoError(t, err)
+	}
+
+	lead := createTestLead(t, client, "Lead", "lead@test.com")
+	enrollment, err := service.EnrollLead(ctx, user.ID, EnrollLeadRequest{SequenceID: sequence.ID, LeadID: lead.ID})
+	require.NoError(t, err)
+
+	for order := 1; order <= 3; order++ {
+		send := client.EmailSequenceSend.Query().
+			Where(
+				emailsequencesend.EnrollmentID(enrollment.ID),
+				emailsequencesend.StatusEQ(emailsequencesend.StatusScheduled),
+			).
+			WithStep().
+			OnlyX(ctx)
+		assert.Equal(t, order, send.Edges.Step.StepOrder)
+
+		resolved, err := service.ResolveDueSend(ctx, send.ID)
+		require.NoError(t, err)
+		assert.Equal(t, send.ID, resolved.ID)
+		require.NoError(t, service.RecordSent(ctx, send.ID))
+	}
+
+	result, err := service.GetEnrollment(ctx, enrollment.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "completed", result.Status)
+	assert.Equal(t, 3, client.EmailSequenceSend.Query().Where(emailsequencesend.StatusEQ(emailsequencesend.StatusSent)).CountX(ctx))
+}
@@ -0,0 +1,202 @@
+package enrichment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/enrichmentattempt"
+)
+
+// ErrRecentlyFailed is returned instead of calling a provider whose last
+// attempt for the lead failed within the retry window
+var ErrRecentlyFailed = errors.New("enrichment recently failed for this lead")
+
+// AttemptCosts is what a provider charges per call, in cents
+type AttemptCosts struct {
+	Company         int // Company enrichment lookup
+	EmailValidation int // Email validation
+}
+
+// attemptCosts holds the costs recorded on enrichment attempts
+var attemptCosts AttemptCosts
+
+// failedRetryAfter is how long a failed company enrichment is not retried
+// with the same provider
+var failedRetryAfter = 24 * time.Hour
+
+// SetAttemptCosts sets the per-call provider costs recorded on enrichment
+// attempts. Every call that reaches the provider is charged, failed or not.
+// It is meant to be called once at startup from configuration.
+func SetAttemptCosts(costs AttemptCosts) {
+	attemptCosts = AttemptCosts{
+		Company:         max(costs.Company, 0),
+		EmailValidation: max(costs.EmailValidation, 0),
+	}
+}
+
+// SetFailedRetryAfter sets how long a lead whose company enrichment failed
+// is not sent to the same provider again (0 always retries). It is meant to
+// be called once at startup from configuration.
+func SetFailedRetryAfter(d time.Duration) {
+	failedRetryAfter = max(d, 0)
+}
+
+// ProviderStats aggregates the enrichment attempts made with a provider
+type ProviderStats struct {
+	Provider  string `json:"provider"`
+	Attempts  int    `json:"attempts"`
+	Successes int    `json:"successes"`
+	Failures  int    `json:"failures"`
+	CostCents int    `json:"cost_cents"`
+}
+
+// attempt is an enrichment provider call to record
+type attempt struct {
+	leadID   int
+	userID   int
+	kind     enrichmentattempt.Kind
+	fields   []string
+	err      error
+	cost     int
+	duration time.Duration
+}
+
+// recordAttempt logs a provider call in the lead's enrichment history. The
+// provider was already called (and paid), so a failure to record it is
+// logged rather than failing the enrichment.
+func (s *Service) recordAttempt(ctx context.Context, a attempt) {
+	builder := s.db.EnrichmentAttempt.Create().
+		SetLeadID(a.leadID).
+		SetProvider(fieldMapping.Provider).
+		SetKind(a.kind).
+		SetSuccess(a.err == nil).
+		SetCostCents(a.cost).
+		SetDurationMs(int(a.duration.Milliseconds()))
+	if a.userID > 0 {
+		builder.SetUserID(a.userID)
+	}
+	if a.err != nil {
+		builder.SetErrorMessage(a.err.Error())
+	} else {
+		builder.SetFieldsReturned(a.fields)
+	}
+	if _, err := builder.Save(ctx); err != nil {
+		log.Printf("Failed to record enrichment attempt for lead %d: %v", a.leadID, err)
+	}
+}
+
+// checkRecentFailure returns ErrRecentlyFailed when the lead's last company
+// enrichment with the current provider failed within the retry window
+func (s *Service) checkRecentFailure(ctx context.Context, leadID int) error {
+	if failedRetryAfter == 0 {
+		return nil
+	}
+	last, err := s.db.EnrichmentAttempt.Query().
+		Where(
+			enrichmentattempt.LeadIDEQ(leadID),
+			enrichmentattempt.ProviderEQ(fieldMapping.Provider),
+			enrichmentattempt.KindEQ(enrichmentattempt.KindCompany),
+		).
+		Order(ent.Desc(enrichmentattempt.FieldCreatedAt), ent.Desc(enrichmentattempt.FieldID)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to check enrichment history: %w", err)
+	}
+	if !last.Success && time.Since(last.CreatedAt) < failedRetryAfter {
+		return fmt.Errorf("%w (%s, retry after %s)", ErrRecentlyFailed, last.Provider, last.CreatedAt.Add(failedRetryAfter).UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+// GetEnrichmentHistory returns the enrichment attempts of a lead, newest
+// first, with their total. Up to limit attempts after offset are loaded; a
+// limit of 0 loads every attempt.
+func (s *Service) GetEnrichmentHistory(ctx context.Context, leadID, limit, offset int) ([]*ent.EnrichmentAttempt, int, error) {
+	if _, err := s.db.Lead.Get(ctx, leadID); err != nil {
+		return nil, 0, err
+	}
+
+	query := s.db.EnrichmentAttempt.Query().Where(enrichmentattempt.LeadIDEQ(leadID))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count enrichment history: %w", err)
+	}
+
+	query = query.
+		Order(ent.Desc(enrichmentattempt.FieldCreatedAt), ent.Desc(enrichmentattempt.FieldID)).
+		Offset(offset)
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	attempts, err := query.All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query enrichment history: %w", err)
+	}
+	return attempts, total, nil
+}
+
+// providerStats aggregates the enrichment attempts per provider
+func (s *Service) providerStats(ctx context.Context) ([]ProviderStats, error) {
+	var rows []struct {
+		Provider string `json:"provider"`
+		Success  bool   `json:"success"`
+		Count    int    `json:"count"`
+		Sum      int    `json:"sum"`
+	}
+	err := s.db.EnrichmentAttempt.Query().
+		GroupBy(enrichmentattempt.FieldProvider, enrichmentattempt.FieldSuccess).
+		Aggregate(ent.Count(), ent.Sum(enrichmentattempt.FieldCostCents)).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate enrichment attempts: %w", err)
+	}
+
+	byProvider := make(map[string]*ProviderStats)
+	for _, row := range rows {
+		stats, ok := byProvider[row.Provider]
+		if !ok {
+			stats = &ProviderStats{Provider: row.Provider}
+			byProvider[row.Provider] = stats
+		}
+		stats.Attempts += row.Count
+		stats.CostCents += row.Sum
+		if row.Success {
+			stats.Successes += row.Count
+		} else {
+			stats.Failures += row.Count
+		}
+	}
+
+	providers := make([]ProviderStats, 0, len(byProvider))
+	for _, stats := range byProvider {
+		providers = append(providers, *stats)
+	}
+	sort.Slice(providers, func(i, j int) bool { return providers[i].Provider < providers[j].Provider })
+	return providers, nil
+}
+
+// returnedFields lists the provider fields that came back with a value,
+// sorted
+func returnedFields(data map[string]interface{}) []string {
+	fields := []string{}
+	for field, value := range data {
+		if value == nil {
+			continue
+		}
+		if text, ok := toString(value); ok && (text == "" || text == "0") {
+			continue
+		}
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
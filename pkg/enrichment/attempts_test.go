@@ -0,0 +1,109 @@
+package enrichment
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent/enrichmentattempt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnrichmentHistory(t *testing.T) {
+	client, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	SetAttemptCosts(AttemptCosts{Company: 25, EmailValidation: 1})
+	defer SetAttemptCosts(AttemptCosts{})
+
+	ctx := context.Background()
+	provider := &MockEnrichmentProvider{}
+	service := NewService(client, provider)
+	l := createTestLead(t, client, "History Ink", "ink@example.com", "https://ink.example")
+
+	_, err := service.EnrichLead(ctx, 0, l.ID)
+	require.NoError(t, err)
+	_, err = service.ValidateLeadEmail(ctx, 0, l.ID)
+	require.NoError(t, err)
+
+	provider.shouldFail = true
+	_, err = service.EnrichLead(ctx, 0, l.ID)
+	require.ErrorIs(t, err, ErrEnrichmentFailed)
+
+	history, total, err := service.GetEnrichmentHistory(ctx, l.ID, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, history, 3)
+	assert.Equal(t, 3, total)
+
+	// Newest first
+	failed, validation, enriched := history[0], history[1], history[2]
+	assert.Equal(t, enrichmentattempt.KindCompany, failed.Kind)
+	assert.False(t, failed.Success)
+	assert.Contains(t, failed.ErrorMessage, "enrichment failed")
+	assert.Empty(t, failed.FieldsReturned)
+	assert.Equal(t, 25, failed.CostCents)
+
+	assert.Equal(t, enrichmentattempt.KindEmailValidation, validation.Kind)
+	assert.True(t, validation.Success)
+	assert.Equal(t, 1, validation.CostCents)
+
+	assert.Equal(t, enrichmentattempt.KindCompany, enriched.Kind)
+	assert.True(t, enriched.Success)
+	assert.Equal(t, "default", enriched.Provider)
+	assert.Equal(t, []string{"description", "employee_count", "facebook", "founded", "industry", "linkedin", "name", "revenue", "twitter"}, enriched.FieldsReturned)
+	assert.Nil(t, enriched.UserID)
+
+	t.Run("unknown lead", func(t *testing.T) {
+		_, _, err := service.GetEnrichmentHistory(ctx, 99999, 0, 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("stats aggregate attempts and cost", func(t *testing.T) {
+		stats, err := service.GetEnrichmentStats(ctx, 0)
+		require.NoError(t, err)
+		assert.Equal(t, 3, stats.Attempts)
+		assert.Equal(t, 1, stats.FailedAttempts)
+		assert.Equal(t, 51, stats.TotalCostCents)
+		assert.Equal(t, []ProviderStats{
+			{Provider: "default", Attempts: 3, Successes: 2, Failures: 1, CostCents: 51},
+		}, stats.Providers)
+	})
+}
+
+func TestEnrichLead_SkipsRecentlyFailed(t *testing.T) {
+	client, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer SetFailedRetryAfter(24 * time.Hour)
+
+	ctx := context.Background()
+	provider := &MockEnrichmentProvider{shouldFail: true}
+	service := NewService(client, provider)
+	l := createTestLead(t, client, "Failing Ink", "ink@example.com", "https://ink.example")
+
+	_, err := service.EnrichLead(ctx, 0, l.ID)
+	require.ErrorIs(t, err, ErrEnrichmentFailed)
+
+	// The provider isn't called again within the retry window
+	provider.shouldFail = false
+	_, err = service.EnrichLead(ctx, 0, l.ID)
+	require.ErrorIs(t, err, ErrRecentlyFailed)
+	attempts, err := client.EnrichmentAttempt.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, attempts)
+
+	// Email validation is a different call and isn't held back
+	_, err = service.ValidateLeadEmail(ctx, 0, l.ID)
+	require.NoError(t, err)
+
+	// Without a window, failures are retried
+	SetFailedRetryAfter(0)
+	enriched, err := service.EnrichLead(ctx, 0, l.ID)
+	require.NoError(t, err)
+	assert.True(t, enriched.IsEnriched)
+
+	// A success clears the failure
+	SetFailedRetryAfter(24 * time.Hour)
+	_, err = service.EnrichLead(ctx, 0, l.ID)
+	require.NoError(t, err)
+}
@@ -0,0 +1,264 @@
+package enrichment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/enrichmentbudget"
+	"github.com/jordanlanch/industrydb/pkg/leads"
+)
+
+// ErrBudgetExceeded is returned instead of calling a provider when the call
+// would take the monthly enrichment spend of the user or organization past
+// its budget
+var ErrBudgetExceeded = errors.New("enrichment budget exceeded")
+
+// ErrInvalidBudget is returned for budget limits that can't be set
+var ErrInvalidBudget = errors.New("invalid enrichment budget")
+
+// BudgetOwner is who an enrichment budget belongs to: an organization when
+// OrganizationID is set, the user otherwise
+type BudgetOwner struct {
+	UserID         int
+	OrganizationID int
+}
+
+// BudgetLimits are admin-set monthly limits overriding the tier defaults.
+// A nil limit falls back to the tier default; 0 is unlimited.
+type BudgetLimits struct {
+	CallLimit      *int `json:"call_limit"`
+	CostLimitCents *int `json:"cost_limit_cents"`
+}
+
+// BudgetStatus is the enrichment spend of a user or organization in the
+// current month against its budget
+type BudgetStatus struct {
+	UserID         int       `json:"user_id,omitempty"`
+	OrganizationID int       `json:"organization_id,omitempty"`
+	Tier           string    `json:"tier"`
+	Calls          int       `json:"calls"`
+	CallLimit      int       `json:"call_limit"` // 0 = unlimited
+	CostCents      int       `json:"cost_cents"`
+	CostLimitCents int       `json:"cost_limit_cents"` // 0 = unlimited
+	Custom         bool      `json:"custom"`           // Admin-set limits override the tier defaults
+	PeriodStart    time.Time `json:"period_start"`
+	ResetAt        time.Time `json:"reset_at"`
+}
+
+type organizationBudgetKey struct{}
+
+type unbudgetedKey struct{}
+
+// WithOrganizationBudget returns a copy of ctx whose enrichments are charged
+// to the organization's budget instead of the requesting user's
+func WithOrganizationBudget(ctx context.Context, orgID int) context.Context {
+	return context.WithValue(ctx, organizationBudgetKey{}, orgID)
+}
+
+// WithoutBudget returns a copy of ctx whose enrichments aren't charged to any
+// budget, for platform runs like the admin candidate worklist
+func WithoutBudget(ctx context.Context) context.Context {
+	return context.WithValue(ctx, unbudgetedKey{}, true)
+}
+
+// budgetOwner returns whose budget the enrichments made with ctx on behalf
+// of userID are charged to. System runs (no user) aren't budgeted.
+func budgetOwner(ctx context.Context, userID int) (BudgetOwner, bool) {
+	if unbudgeted, _ := ctx.Value(unbudgetedKey{}).(bool); unbudgeted {
+		return BudgetOwner{}, false
+	}
+	if orgID, ok := ctx.Value(organizationBudgetKey{}).(int); ok && orgID > 0 {
+		return BudgetOwner{OrganizationID: orgID}, true
+	}
+	if userID > 0 {
+		return BudgetOwner{UserID: userID}, true
+	}
+	return BudgetOwner{}, false
+}
+
+// budgetPeriodStart returns the start of the calendar month (UTC) budgets
+// count from
+func budgetPeriodStart(now time.Time) time.Time {
+	now = now.UTC()
+	return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// budgetFor returns the budget row of an owner for the current month,
+// creating it on first use and resetting its counters when the month rolled
+// over, along with the owner's subscription tier
+func (s *Service) budgetFor(ctx context.Context, owner BudgetOwner) (*ent.EnrichmentBudget, string, error) {
+	var tier string
+	var match func(*ent.EnrichmentBudgetQuery) *ent.EnrichmentBudgetQuery
+	create := s.db.EnrichmentBudget.Create()
+	if owner.OrganizationID > 0 {
+		org, err := s.db.Organization.Get(ctx, owner.OrganizationID)
+		if err != nil {
+			return nil, "", err
+		}
+		tier = string(org.SubscriptionTier)
+		match = func(q *ent.EnrichmentBudgetQuery) *ent.EnrichmentBudgetQuery {
+			return q.Where(enrichmentbudget.OrganizationIDEQ(owner.OrganizationID))
+		}
+		create.SetOrganizationID(owner.OrganizationID)
+	} else {
+		u, err := s.db.User.Get(ctx, owner.UserID)
+		if err != nil {
+			return nil, "", err
+		}
+		tier = string(u.SubscriptionTier)
+		match = func(q *ent.EnrichmentBudgetQuery) *ent.EnrichmentBudgetQuery {
+			return q.Where(enrichmentbudget.UserIDEQ(owner.UserID))
+		}
+		create.SetUserID(owner.UserID)
+	}
+
+	periodStart := budgetPeriodStart(time.Now())
+	budget, err := match(s.db.EnrichmentBudget.Query()).Only(ctx)
+	if ent.IsNotFound(err) {
+		budget, err = create.SetPeriodStart(periodStart).Save(ctx)
+		if ent.IsConstraintError(err) {
+			// A concurrent call created it first
+			budget, err = match(s.db.EnrichmentBudget.Query()).Only(ctx)
+		}
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get enrichment budget: %w", err)
+	}
+
+	if budget.PeriodStart.Before(periodStart) {
+		// Only the first call of the month resets the counters
+		err := s.db.EnrichmentBudget.Update().
+			Where(
+				enrichmentbudget.IDEQ(budget.ID),
+				enrichmentbudget.PeriodStartLT(periodStart),
+			).
+			SetCalls(0).
+			SetCostCents(0).
+			SetPeriodStart(periodStart).
+			Exec(ctx)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to reset enrichment budget: %w", err)
+		}
+		if budget, err = s.db.EnrichmentBudget.Get(ctx, budget.ID); err != nil {
+			return nil, "", fmt.Errorf("failed to get enrichment budget: %w", err)
+		}
+	}
+	return budget, tier, nil
+}
+
+// budgetLimits returns the effective limits of a budget: the admin-set ones,
+// or the tier defaults
+func budgetLimits(budget *ent.EnrichmentBudget, tier string) leads.EnrichmentBudget {
+	limits := leads.GetEnrichmentBudgetForTier(tier)
+	if budget.CallLimit != nil {
+		limits.Calls = *budget.CallLimit
+	}
+	if budget.CostLimitCents != nil {
+		limits.CostCents = *budget.CostLimitCents
+	}
+	return limits
+}
+
+// chargeBudget charges one provider call of the given cost to the budget of
+// the user or organization, or returns ErrBudgetExceeded when it doesn't fit.
+// The limit check and the charge are a single conditional UPDATE, so
+// concurrent bulk jobs can't both take the last call.
+func (s *Service) chargeBudget(ctx context.Context, userID, cost int) error {
+	owner, ok := budgetOwner(ctx, userID)
+	if !ok {
+		return nil
+	}
+	budget, tier, err := s.budgetFor(ctx, owner)
+	if err != nil {
+		return err
+	}
+	limits := budgetLimits(budget, tier)
+
+	update := s.db.EnrichmentBudget.Update().Where(enrichmentbudget.IDEQ(budget.ID))
+	if limits.Calls > 0 {
+		update.Where(enrichmentbudget.CallsLTE(limits.Calls - 1))
+	}
+	if limits.CostCents > 0 && cost > 0 {
+		update.Where(enrichmentbudget.CostCentsLTE(limits.CostCents - cost))
+	}
+	charged, err := update.AddCalls(1).AddCostCents(cost).Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to charge enrichment budget: %w", err)
+	}
+	if charged == 1 {
+		return nil
+	}
+
+	budget, err = s.db.EnrichmentBudget.Get(ctx, budget.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get enrichment budget: %w", err)
+	}
+	return fmt.Errorf("%w: %s", ErrBudgetExceeded, describeSpend(budget, limits))
+}
+
+// describeSpend summarizes the spend of a budget against its limits
+func describeSpend(budget *ent.EnrichmentBudget, limits leads.EnrichmentBudget) string {
+	spend := fmt.Sprintf("%d calls", budget.Calls)
+	if limits.Calls > 0 {
+		spend = fmt.Sprintf("%d/%d calls", budget.Calls, limits.Calls)
+	}
+	if limits.CostCents > 0 {
+		spend += fmt.Sprintf(", %d/%d cents", budget.CostCents, limits.CostCents)
+	}
+	return spend + " this month"
+}
+
+// GetBudget returns the enrichment spend of a user or organization in the
+// current month against its budget
+func (s *Service) GetBudget(ctx context.Context, owner BudgetOwner) (*BudgetStatus, error) {
+	budget, tier, err := s.budgetFor(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	limits := budgetLimits(budget, tier)
+
+	return &BudgetStatus{
+		UserID:         owner.UserID,
+		OrganizationID: owner.OrganizationID,
+		Tier:           tier,
+		Calls:          budget.Calls,
+		CallLimit:      limits.Calls,
+		CostCents:      budget.CostCents,
+		CostLimitCents: limits.CostCents,
+		Custom:         budget.CallLimit != nil || budget.CostLimitCents != nil,
+		PeriodStart:    budget.PeriodStart,
+		ResetAt:        budget.PeriodStart.AddDate(0, 1, 0),
+	}, nil
+}
+
+// SetBudgetLimits replaces the admin-set limits of a user or organization.
+// A nil limit reverts to the tier default.
+func (s *Service) SetBudgetLimits(ctx context.Context, owner BudgetOwner, limits BudgetLimits) (*BudgetStatus, error) {
+	if (limits.CallLimit != nil && *limits.CallLimit < 0) || (limits.CostLimitCents != nil && *limits.CostLimitCents < 0) {
+		return nil, fmt.Errorf("%w: limits can't be negative", ErrInvalidBudget)
+	}
+
+	budget, _, err := s.budgetFor(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	update := s.db.EnrichmentBudget.UpdateOneID(budget.ID)
+	if limits.CallLimit != nil {
+		update.SetCallLimit(*limits.CallLimit)
+	} else {
+		update.ClearCallLimit()
+	}
+	if limits.CostLimitCents != nil {
+		update.SetCostLimitCents(*limits.CostLimitCents)
+	} else {
+		update.ClearCostLimitCents()
+	}
+	if err := update.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to update enrichment budget: %w", err)
+	}
+
+	return s.GetBudget(ctx, owner)
+}
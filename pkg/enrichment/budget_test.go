@@ -0,0 +1,214 @@
+package enrichment
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/enrichmentbudget"
+	"github.com/jordanlanch/industrydb/ent/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createBudgetTestUser(t *testing.T, client *ent.Client, email string, tier user.SubscriptionTier) *ent.User {
+	u, err := client.User.Create().
+		SetEmail(email).
+		SetPasswordHash("hash").
+		SetName("Budget User").
+		SetSubscriptionTier(tier).
+		Save(context.Background())
+	require.NoError(t, err)
+	return u
+}
+
+func intPtr(v int) *int { return &v }
+
+func TestChargeBudget_UserLimit(t *testing.T) {
+	client, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	service := NewService(client, &MockEnrichmentProvider{})
+	u := createBudgetTestUser(t, client, "budget@example.com", user.SubscriptionTierFree)
+	owner := BudgetOwner{UserID: u.ID}
+
+	_, err := service.SetBudgetLimits(ctx, owner, BudgetLimits{CallLimit: intPtr(2)})
+	require.NoError(t, err)
+
+	first := createTestLead(t, client, "First Ink", "first@example.com", "https://first.example")
+	second := createTestLead(t, client, "Second Ink", "second@example.com", "https://second.example")
+	third := createTestLead(t, client, "Third Ink", "third@example.com", "https://third.example")
+
+	_, err = service.EnrichLead(ctx, u.ID, first.ID)
+	require.NoError(t, err)
+	_, err = service.ValidateLeadEmail(ctx, u.ID, first.ID)
+	require.NoError(t, err)
+
+	_, err = service.EnrichLead(ctx, u.ID, second.ID)
+	require.ErrorIs(t, err, ErrBudgetExceeded)
+	assert.Contains(t, err.Error(), "2/2 calls")
+	_, err = service.ValidateLeadEmail(ctx, u.ID, second.ID)
+	require.ErrorIs(t, err, ErrBudgetExceeded)
+
+	// A refused call never reaches the provider
+	history, _, err := service.GetEnrichmentHistory(ctx, second.ID, 0, 0)
+	require.NoError(t, err)
+	assert.Empty(t, history)
+
+	// Bulk runs stop charging once the budget is gone
+	result, err := service.BulkEnrichLeads(ctx, u.ID, []int{second.ID, third.ID})
+	require.NoError(t, err)
+	assert.True(t, result.BudgetExceeded)
+	assert.Equal(t, 2, result.FailureCount)
+
+	// System runs aren't budgeted
+	_, err = service.EnrichLead(ctx, 0, second.ID)
+	require.NoError(t, err)
+
+	status, err := service.GetBudget(ctx, owner)
+	require.NoError(t, err)
+	assert.Equal(t, 2, status.Calls)
+	assert.Equal(t, 2, status.CallLimit)
+	assert.True(t, status.Custom)
+}
+
+func TestChargeBudget_TierDefaultsAndCost(t *testing.T) {
+	client, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	SetAttemptCosts(AttemptCosts{Company: 40})
+	defer SetAttemptCosts(AttemptCosts{})
+
+	ctx := context.Background()
+	service := NewService(client, &MockEnrichmentProvider{})
+	u := createBudgetTestUser(t, client, "pro@example.com", user.SubscriptionTierPro)
+	owner := BudgetOwner{UserID: u.ID}
+
+	status, err := service.GetBudget(ctx, owner)
+	require.NoError(t, err)
+	assert.Equal(t, "pro", status.Tier)
+	assert.Equal(t, 1000, status.CallLimit)
+	assert.Equal(t, 0, status.CostLimitCents)
+	assert.False(t, status.Custom)
+
+	// A dollar cap of $1 fits two 40 cent calls
+	_, err = service.SetBudgetLimits(ctx, owner, BudgetLimits{CostLimitCents: intPtr(100)})
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		require.NoError(t, service.chargeBudget(ctx, u.ID, attemptCosts.Company))
+	}
+	err = service.chargeBudget(ctx, u.ID, attemptCosts.Company)
+	require.ErrorIs(t, err, ErrBudgetExceeded)
+	assert.Contains(t, err.Error(), "80/100 cents")
+
+	// Clearing the limits reverts to the tier defaults
+	status, err = service.SetBudgetLimits(ctx, owner, BudgetLimits{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, status.CostLimitCents)
+	assert.False(t, status.Custom)
+	assert.Equal(t, 80, status.CostCents)
+
+	_, err = service.SetBudgetLimits(ctx, owner, BudgetLimits{CallLimit: intPtr(-1)})
+	assert.ErrorIs(t, err, ErrInvalidBudget)
+	_, err = service.GetBudget(ctx, BudgetOwner{UserID: 99999})
+	assert.True(t, ent.IsNotFound(err))
+}
+
+func TestChargeBudget_Organization(t *testing.T) {
+	client, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	service := NewService(client, &MockEnrichmentProvider{})
+	u := createBudgetTestUser(t, client, "member@example.com", user.SubscriptionTierFree)
+	org, err := client.Organization.Create().
+		SetName("Budget Team").
+		SetSlug("budget-team").
+		SetOwnerID(u.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	_, err = service.SetBudgetLimits(ctx, BudgetOwner{OrganizationID: org.ID}, BudgetLimits{CallLimit: intPtr(1)})
+	require.NoError(t, err)
+
+	orgCtx := WithOrganizationBudget(ctx, org.ID)
+	require.NoError(t, service.chargeBudget(orgCtx, u.ID, 0))
+	require.ErrorIs(t, service.chargeBudget(orgCtx, u.ID, 0), ErrBudgetExceeded)
+
+	// The member's personal budget is untouched
+	require.NoError(t, service.chargeBudget(ctx, u.ID, 0))
+
+	stats, err := service.GetEnrichmentStats(orgCtx, u.ID)
+	require.NoError(t, err)
+	require.NotNil(t, stats.Budget)
+	assert.Equal(t, org.ID, stats.Budget.OrganizationID)
+	assert.Equal(t, 1, stats.Budget.Calls)
+
+	// Admin platform runs aren't charged
+	require.NoError(t, service.chargeBudget(WithoutBudget(orgCtx), u.ID, 0))
+}
+
+func TestChargeBudget_MonthlyReset(t *testing.T) {
+	client, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	service := NewService(client, &MockEnrichmentProvider{})
+	u := createBudgetTestUser(t, client, "reset@example.com", user.SubscriptionTierFree)
+
+	_, err := service.SetBudgetLimits(ctx, BudgetOwner{UserID: u.ID}, BudgetLimits{CallLimit: intPtr(1)})
+	require.NoError(t, err)
+	require.NoError(t, service.chargeBudget(ctx, u.ID, 0))
+	require.ErrorIs(t, service.chargeBudget(ctx, u.ID, 0), ErrBudgetExceeded)
+
+	// Last month's spend doesn't count
+	lastMonth := budgetPeriodStart(time.Now()).AddDate(0, -1, 0)
+	_, err = client.EnrichmentBudget.Update().
+		Where(enrichmentbudget.UserIDEQ(u.ID)).
+		SetPeriodStart(lastMonth).
+		Save(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, service.chargeBudget(ctx, u.ID, 0))
+	status, err := service.GetBudget(ctx, BudgetOwner{UserID: u.ID})
+	require.NoError(t, err)
+	assert.Equal(t, 1, status.Calls)
+	assert.Equal(t, budgetPeriodStart(time.Now()), status.PeriodStart.UTC())
+}
+
+func TestChargeBudget_Concurrent(t *testing.T) {
+	client, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	service := NewService(client, &MockEnrichmentProvider{})
+	u := createBudgetTestUser(t, client, "concurrent@example.com", user.SubscriptionTierFree)
+	_, err := service.SetBudgetLimits(ctx, BudgetOwner{UserID: u.ID}, BudgetLimits{CallLimit: intPtr(5)})
+	require.NoError(t, err)
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		charged int
+	)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if service.chargeBudget(ctx, u.ID, 0) == nil {
+				mu.Lock()
+				charged++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 5, charged)
+	status, err := service.GetBudget(ctx, BudgetOwner{UserID: u.ID})
+	require.NoError(t, err)
+	assert.Equal(t, 5, status.Calls)
+}
@@ -0,0 +1,157 @@
+package enrichment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/lead"
+)
+
+// CandidateThresholds tunes the enrichment candidate heuristic
+type CandidateThresholds struct {
+	MinQualityScore int // Only leads at or above this quality score are worth enriching
+	DefaultLimit    int // Candidates returned when no limit is requested
+	MaxLimit        int // Upper bound on a requested limit
+}
+
+// DefaultCandidateThresholds returns the default candidate heuristic thresholds
+func DefaultCandidateThresholds() CandidateThresholds {
+	return CandidateThresholds{
+		MinQualityScore: 50,
+		DefaultLimit:    50,
+		MaxLimit:        500,
+	}
+}
+
+// candidateThresholds holds the thresholds used by GetEnrichmentCandidates
+var candidateThresholds = DefaultCandidateThresholds()
+
+// SetCandidateThresholds replaces the thresholds used by
+// GetEnrichmentCandidates. Non-positive limits keep their default. It is
+// meant to be called once at startup from configuration.
+func SetCandidateThresholds(thresholds CandidateThresholds) {
+	defaults := DefaultCandidateThresholds()
+	if thresholds.MinQualityScore < 0 {
+		thresholds.MinQualityScore = defaults.MinQualityScore
+	}
+	if thresholds.DefaultLimit <= 0 {
+		thresholds.DefaultLimit = defaults.DefaultLimit
+	}
+	if thresholds.MaxLimit <= 0 {
+		thresholds.MaxLimit = defaults.MaxLimit
+	}
+	if thresholds.DefaultLimit > thresholds.MaxLimit {
+		thresholds.DefaultLimit = thresholds.MaxLimit
+	}
+	candidateThresholds = thresholds
+}
+
+// CandidateFilter narrows the enrichment candidate worklist
+type CandidateFilter struct {
+	Industry string
+	Country  string
+	Limit    int
+}
+
+// EnrichmentCandidate is a lead that would benefit from enrichment
+type EnrichmentCandidate struct {
+	LeadID       int    `json:"lead_id"`
+	Name         string `json:"name"`
+	Industry     string `json:"industry"`
+	Country      string `json:"country"`
+	City         string `json:"city"`
+	Website      string `json:"website"`
+	HasPhone     bool   `json:"has_phone"`
+	Verified     bool   `json:"verified"`
+	QualityScore int    `json:"quality_score"`
+}
+
+// EnrichmentCandidatesResponse is the prioritized candidate worklist
+type EnrichmentCandidatesResponse struct {
+	Candidates      []EnrichmentCandidate `json:"candidates"`
+	Total           int                   `json:"total"` // All matching leads, not just this page
+	MinQualityScore int                   `json:"min_quality_score"`
+}
+
+// GetEnrichmentCandidates returns unenriched leads that are missing an email
+// but have a website to enrich from, highest quality first. Quality already
+// rewards phone, address and verification, so well-rounded leads that only
+// lack an email rise to the top.
+func (s *Service) GetEnrichmentCandidates(ctx context.Context, filter CandidateFilter) (*EnrichmentCandidatesResponse, error) {
+	thresholds := candidateThresholds
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = thresholds.DefaultLimit
+	}
+	if limit > thresholds.MaxLimit {
+		limit = thresholds.MaxLimit
+	}
+
+	query := s.db.Lead.Query().
+		Where(
+			lead.IsEnrichedEQ(false),
+			lead.Or(lead.EmailIsNil(), lead.EmailEQ("")),
+			lead.WebsiteNotNil(),
+			lead.WebsiteNEQ(""),
+			lead.QualityScoreGTE(thresholds.MinQualityScore),
+		)
+	if filter.Industry != "" {
+		query = query.Where(lead.IndustryEQ(lead.Industry(filter.Industry)))
+	}
+	if filter.Country != "" {
+		query = query.Where(lead.CountryEQ(filter.Country))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count enrichment candidates: %w", err)
+	}
+
+	leads, err := query.
+		Order(ent.Desc(lead.FieldQualityScore), ent.Asc(lead.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query enrichment candidates: %w", err)
+	}
+
+	candidates := make([]EnrichmentCandidate, len(leads))
+	for i, l := range leads {
+		candidates[i] = EnrichmentCandidate{
+			LeadID:       l.ID,
+			Name:         l.Name,
+			Industry:     string(l.Industry),
+			Country:      l.Country,
+			City:         l.City,
+			Website:      l.Website,
+			HasPhone:     l.Phone != "",
+			Verified:     l.Verified,
+			QualityScore: l.QualityScore,
+		}
+	}
+
+	return &EnrichmentCandidatesResponse{
+		Candidates:      candidates,
+		Total:           total,
+		MinQualityScore: thresholds.MinQualityScore,
+	}, nil
+}
+
+// EnrichCandidates bulk-enriches the given leads, or the top limit
+// candidates when no leads are selected. It is a platform run, not charged to
+// the admin's enrichment budget.
+func (s *Service) EnrichCandidates(ctx context.Context, userID int, leadIDs []int, limit int) (*BulkEnrichmentResult, error) {
+	if len(leadIDs) == 0 {
+		candidates, err := s.GetEnrichmentCandidates(ctx, CandidateFilter{Limit: limit})
+		if err != nil {
+			return nil, err
+		}
+		for _, candidate := range candidates.Candidates {
+			leadIDs = append(leadIDs, candidate.LeadID)
+		}
+	}
+
+	return s.BulkEnrichLeads(WithoutBudget(ctx), userID, leadIDs)
+}
@@ -0,0 +1,72 @@
+package enrichment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetEnrichmentCandidates(t *testing.T) {
+	client, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	service := NewService(client, &MockEnrichmentProvider{})
+
+	high := createTestLead(t, client, "High", "", "https://high.example.com")
+	client.Lead.UpdateOne(high).SetQualityScore(90).ExecX(ctx)
+	mid := createTestLead(t, client, "Mid", "", "https://mid.example.com")
+	client.Lead.UpdateOne(mid).SetQualityScore(60).ExecX(ctx)
+
+	// Not candidates: low quality, has email, no website, already enriched
+	low := createTestLead(t, client, "Low", "", "https://low.example.com")
+	client.Lead.UpdateOne(low).SetQualityScore(20).ExecX(ctx)
+	createTestLead(t, client, "Has Email", "owner@example.com", "https://email.example.com")
+	createTestLead(t, client, "No Website", "", "")
+	enriched := createTestLead(t, client, "Enriched", "", "https://enriched.example.com")
+	client.Lead.UpdateOne(enriched).SetIsEnriched(true).ExecX(ctx)
+
+	t.Run("Success - Prioritized by quality", func(t *testing.T) {
+		result, err := service.GetEnrichmentCandidates(ctx, CandidateFilter{})
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.Total)
+		require.Len(t, result.Candidates, 2)
+		assert.Equal(t, high.ID, result.Candidates[0].LeadID)
+		assert.Equal(t, mid.ID, result.Candidates[1].LeadID)
+	})
+
+	t.Run("Success - Limit and filters", func(t *testing.T) {
+		result, err := service.GetEnrichmentCandidates(ctx, CandidateFilter{Limit: 1})
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.Total)
+		assert.Len(t, result.Candidates, 1)
+
+		result, err = service.GetEnrichmentCandidates(ctx, CandidateFilter{Industry: string(lead.IndustryGym)})
+		require.NoError(t, err)
+		assert.Empty(t, result.Candidates)
+	})
+
+	t.Run("Success - Configurable quality threshold", func(t *testing.T) {
+		defer SetCandidateThresholds(DefaultCandidateThresholds())
+		SetCandidateThresholds(CandidateThresholds{MinQualityScore: 80})
+
+		result, err := service.GetEnrichmentCandidates(ctx, CandidateFilter{})
+		require.NoError(t, err)
+		require.Len(t, result.Candidates, 1)
+		assert.Equal(t, high.ID, result.Candidates[0].LeadID)
+		assert.Equal(t, 80, result.MinQualityScore)
+	})
+
+	t.Run("Success - Enrich top candidates", func(t *testing.T) {
+		result, err := service.EnrichCandidates(ctx, 0, nil, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.SuccessCount)
+
+		updated, err := client.Lead.Get(ctx, high.ID)
+		require.NoError(t, err)
+		assert.True(t, updated.IsEnriched)
+	})
+}
@@ -0,0 +1,75 @@
+package enrichment
+
+import (
+	"context"
+	"sync"
+)
+
+// DefaultMaxConcurrentCalls is the default number of provider calls that
+// may be in flight at once
+const DefaultMaxConcurrentCalls = 10
+
+// CallMetrics receives the number of provider calls in flight. It is
+// satisfied by *metrics.Metrics.
+type CallMetrics interface {
+	SetEnrichmentCallsInFlight(count int)
+}
+
+// callLimiter bounds the provider calls in flight. Callers past the limit
+// wait for a slot instead of failing.
+type callLimiter struct {
+	slots    chan struct{}
+	metrics  CallMetrics
+	mu       sync.Mutex
+	inFlight int
+}
+
+// newCallLimiter creates a limiter allowing maxCalls concurrent calls,
+// DefaultMaxConcurrentCalls when not positive
+func newCallLimiter(maxCalls int, metrics CallMetrics) *callLimiter {
+	if maxCalls <= 0 {
+		maxCalls = DefaultMaxConcurrentCalls
+	}
+	return &callLimiter{slots: make(chan struct{}, maxCalls), metrics: metrics}
+}
+
+// acquire waits for a call slot, or returns the context's error when it is
+// done first. Every successful acquire must be followed by a release.
+func (l *callLimiter) acquire(ctx context.Context) error {
+	select {
+	case l.slots <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	l.track(1)
+	return nil
+}
+
+// release frees a call slot
+func (l *callLimiter) release() {
+	l.track(-1)
+	<-l.slots
+}
+
+// track updates the in-flight count and reports it
+func (l *callLimiter) track(delta int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.inFlight += delta
+	if l.metrics != nil {
+		l.metrics.SetEnrichmentCallsInFlight(l.inFlight)
+	}
+}
+
+// providerCalls bounds the provider calls of every enrichment in flight,
+// single and bulk alike
+var providerCalls = newCallLimiter(DefaultMaxConcurrentCalls, nil)
+
+// SetMaxConcurrentCalls sets how many provider calls may be in flight at
+// once across all enrichments (0 uses DefaultMaxConcurrentCalls), reporting
+// the in-flight count to metrics when set. Further calls queue until a
+// call finishes. It is meant to be called once at startup from
+// configuration.
+func SetMaxConcurrentCalls(maxCalls int, metrics CallMetrics) {
+	providerCalls = newCallLimiter(maxCalls, metrics)
+}
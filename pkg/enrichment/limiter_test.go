@@ -0,0 +1,142 @@
+package enrichment
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// slowProvider answers like MockEnrichmentProvider after a delay, tracking
+// the most calls it had in flight at once
+type slowProvider struct {
+	MockEnrichmentProvider
+	delay    time.Duration
+	inFlight atomic.Int32
+	peak     atomic.Int32
+}
+
+func (p *slowProvider) EnrichCompany(ctx context.Context, domain string) (*CompanyData, error) {
+	current := p.inFlight.Add(1)
+	defer p.inFlight.Add(-1)
+	for {
+		peak := p.peak.Load()
+		if current <= peak || p.peak.CompareAndSwap(peak, current) {
+			break
+		}
+	}
+	time.Sleep(p.delay)
+	return p.MockEnrichmentProvider.EnrichCompany(ctx, domain)
+}
+
+// gaugeRecorder records the in-flight counts reported by a limiter
+type gaugeRecorder struct {
+	mu     sync.Mutex
+	counts []int
+}
+
+func (r *gaugeRecorder) SetEnrichmentCallsInFlight(count int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.counts = append(r.counts, count)
+}
+
+func (r *gaugeRecorder) peakAndLast() (int, int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	peak := 0
+	for _, count := range r.counts {
+		peak = max(peak, count)
+	}
+	return peak, r.counts[len(r.counts)-1]
+}
+
+func TestCallLimiter(t *testing.T) {
+	gauge := &gaugeRecorder{}
+	limiter := newCallLimiter(2, gauge)
+
+	var inFlight, peak atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.NoError(t, limiter.acquire(context.Background()))
+			current := inFlight.Add(1)
+			for {
+				p := peak.Load()
+				if current <= p || peak.CompareAndSwap(p, current) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			inFlight.Add(-1)
+			limiter.release()
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(2), peak.Load(), "calls past the limit wait for a slot")
+	reportedPeak, last := gauge.peakAndLast()
+	assert.Equal(t, 2, reportedPeak)
+	assert.Equal(t, 0, last)
+
+	// A caller giving up while waiting doesn't get a slot
+	require.NoError(t, limiter.acquire(context.Background()))
+	require.NoError(t, limiter.acquire(context.Background()))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, limiter.acquire(ctx), context.DeadlineExceeded)
+	limiter.release()
+	limiter.release()
+
+	assert.Equal(t, DefaultMaxConcurrentCalls, cap(newCallLimiter(0, nil).slots))
+}
+
+func TestBulkEnrichLeads_BoundsConcurrentProviderCalls(t *testing.T) {
+	// Concurrent jobs need one database shared by the pool's connections
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	gauge := &gaugeRecorder{}
+	SetMaxConcurrentCalls(2, gauge)
+	defer SetMaxConcurrentCalls(0, nil)
+
+	provider := &slowProvider{delay: 20 * time.Millisecond}
+	service := NewService(client, provider)
+
+	jobs := make([][]int, 3)
+	for i := range jobs {
+		for j := 0; j < 2; j++ {
+			l := createTestLead(t, client, "Studio", "studio@example.com", "https://example.com")
+			jobs[i] = append(jobs[i], l.ID)
+		}
+	}
+
+	var wg sync.WaitGroup
+	results := make([]*BulkEnrichmentResult, len(jobs))
+	for i, leadIDs := range jobs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result, err := service.BulkEnrichLeads(context.Background(), 0, leadIDs)
+			require.NoError(t, err)
+			results[i] = result
+		}()
+	}
+	wg.Wait()
+
+	// Every call queued for a slot instead of failing
+	for _, result := range results {
+		assert.Equal(t, 2, result.SuccessCount, result.Errors)
+	}
+	assert.LessOrEqual(t, provider.peak.Load(), int32(2))
+	reportedPeak, last := gauge.peakAndLast()
+	assert.LessOrEqual(t, reportedPeak, 2)
+	assert.Equal(t, 0, last)
+}
@@ -0,0 +1,320 @@
+package enrichment
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/jordanlanch/industrydb/ent"
+)
+
+// Lead fields enrichment can fill
+const (
+	LeadFieldCompanyDescription = "company_description"
+	LeadFieldEmployeeCount      = "employee_count"
+	LeadFieldCompanyRevenue     = "company_revenue"
+	LeadFieldLinkedinURL        = "linkedin_url"
+	LeadFieldTwitterURL         = "twitter_url"
+	LeadFieldFacebookURL        = "facebook_url"
+)
+
+// LeadFields lists the lead fields enrichment can fill
+var LeadFields = []string{
+	LeadFieldCompanyDescription,
+	LeadFieldEmployeeCount,
+	LeadFieldCompanyRevenue,
+	LeadFieldLinkedinURL,
+	LeadFieldTwitterURL,
+	LeadFieldFacebookURL,
+}
+
+// ErrInvalidFieldMapping is returned for a mapping that targets unknown lead
+// fields or maps two provider fields to the same lead field
+var ErrInvalidFieldMapping = errors.New("invalid enrichment field mapping")
+
+// RawCompanyProvider is implemented by providers that return company data
+// under their own field names. Their fields are translated to lead fields
+// with the configured FieldMapping instead of going through CompanyData.
+type RawCompanyProvider interface {
+	EnrichCompanyFields(ctx context.Context, domain string) (map[string]interface{}, error)
+}
+
+// FieldMapping translates a provider's company fields into lead fields and
+// decides which enriched fields may replace data the lead already has
+type FieldMapping struct {
+	Provider  string            // Provider name, shown in the admin config
+	Fields    map[string]string // Provider field -> lead field
+	Overwrite []string          // Lead fields allowed to replace a non-empty value
+}
+
+// DefaultFieldMapping maps the CompanyData fields, by JSON name, and lets
+// every enriched field overwrite existing lead data
+func DefaultFieldMapping() FieldMapping {
+	return FieldMapping{
+		Provider: "default",
+		Fields: map[string]string{
+			"description":    LeadFieldCompanyDescription,
+			"employee_count": LeadFieldEmployeeCount,
+			"revenue":        LeadFieldCompanyRevenue,
+			"linkedin":       LeadFieldLinkedinURL,
+			"twitter":        LeadFieldTwitterURL,
+			"facebook":       LeadFieldFacebookURL,
+		},
+		Overwrite: append([]string(nil), LeadFields...),
+	}
+}
+
+// WithFields returns the mapping with extra provider fields added. An extra
+// field replaces the provider field previously mapped to the same lead field.
+func (m FieldMapping) WithFields(extra map[string]string) FieldMapping {
+	remapped := make(map[string]bool, len(extra))
+	for _, leadField := range extra {
+		remapped[leadField] = true
+	}
+
+	fields := make(map[string]string, len(m.Fields)+len(extra))
+	for providerField, leadField := range m.Fields {
+		if !remapped[leadField] {
+			fields[providerField] = leadField
+		}
+	}
+	for providerField, leadField := range extra {
+		fields[providerField] = leadField
+	}
+
+	m.Fields = fields
+	return m
+}
+
+// Validate checks that the mapping only targets known lead fields, each
+// from a single provider field
+func (m FieldMapping) Validate() error {
+	mappedFrom := make(map[string]string, len(m.Fields))
+	for providerField, leadField := range m.Fields {
+		if !isLeadField(leadField) {
+			return fmt.Errorf("%w: unknown lead field %q", ErrInvalidFieldMapping, leadField)
+		}
+		if other, ok := mappedFrom[leadField]; ok {
+			return fmt.Errorf("%w: %q and %q both map to %q", ErrInvalidFieldMapping, other, providerField, leadField)
+		}
+		mappedFrom[leadField] = providerField
+	}
+	for _, leadField := range m.Overwrite {
+		if !isLeadField(leadField) {
+			return fmt.Errorf("%w: unknown overwrite field %q", ErrInvalidFieldMapping, leadField)
+		}
+	}
+	return nil
+}
+
+// ParseFieldMap parses a "provider_field=lead_field,..." list
+func ParseFieldMap(value string) (map[string]string, error) {
+	fields := make(map[string]string)
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		providerField, leadField, ok := strings.Cut(pair, "=")
+		providerField, leadField = strings.TrimSpace(providerField), strings.TrimSpace(leadField)
+		if !ok || providerField == "" || leadField == "" {
+			return nil, fmt.Errorf("%w: %q is not provider_field=lead_field", ErrInvalidFieldMapping, pair)
+		}
+		fields[providerField] = leadField
+	}
+	return fields, nil
+}
+
+// ParseOverwriteFields parses the lead fields enriched values may overwrite:
+// "all", "none" or a comma-separated list of lead fields
+func ParseOverwriteFields(value string) []string {
+	switch strings.TrimSpace(value) {
+	case "all":
+		return append([]string(nil), LeadFields...)
+	case "none":
+		return []string{}
+	}
+
+	fields := []string{}
+	for _, field := range strings.Split(value, ",") {
+		if field = strings.TrimSpace(field); field != "" {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// fieldMapping holds the mapping used by EnrichLead
+var fieldMapping = DefaultFieldMapping()
+
+// SetFieldMapping replaces the mapping used by EnrichLead. It is meant to be
+// called once at startup from configuration.
+func SetFieldMapping(mapping FieldMapping) error {
+	if err := mapping.Validate(); err != nil {
+		return err
+	}
+	if mapping.Provider == "" {
+		mapping.Provider = DefaultFieldMapping().Provider
+	}
+	fieldMapping = mapping
+	return nil
+}
+
+// FieldRule is how one lead field is filled by enrichment
+type FieldRule struct {
+	LeadField     string `json:"lead_field"`
+	ProviderField string `json:"provider_field,omitempty"` // Empty when no provider field fills it
+	Overwrite     bool   `json:"overwrite"`                // Whether it may replace a non-empty value
+}
+
+// MappingConfig is the effective enrichment field mapping
+type MappingConfig struct {
+	Provider string      `json:"provider"`
+	Fields   []FieldRule `json:"fields"`
+}
+
+// GetMappingConfig returns the effective field mapping, one rule per lead
+// field enrichment can fill
+func GetMappingConfig() MappingConfig {
+	mapping := fieldMapping
+
+	providerFields := make(map[string]string, len(mapping.Fields))
+	for providerField, leadField := range mapping.Fields {
+		providerFields[leadField] = providerField
+	}
+
+	rules := make([]FieldRule, len(LeadFields))
+	for i, leadField := range LeadFields {
+		rules[i] = FieldRule{
+			LeadField:     leadField,
+			ProviderField: providerFields[leadField],
+			Overwrite:     mapping.canOverwrite(leadField),
+		}
+	}
+
+	return MappingConfig{Provider: mapping.Provider, Fields: rules}
+}
+
+// fields returns the company data keyed by JSON field name
+func (d *CompanyData) fields() map[string]interface{} {
+	return map[string]interface{}{
+		"name":           d.Name,
+		"description":    d.Description,
+		"industry":       d.Industry,
+		"employee_count": d.EmployeeCount,
+		"founded":        d.Founded,
+		"revenue":        d.Revenue,
+		"linkedin":       d.LinkedIn,
+		"twitter":        d.Twitter,
+		"facebook":       d.Facebook,
+	}
+}
+
+// apply sets the mapped provider fields on a lead update. Empty values never
+// clear lead data, and fields not allowed to overwrite only fill empty ones.
+func (m FieldMapping) apply(update *ent.LeadUpdateOne, current *ent.Lead, data map[string]interface{}) {
+	for providerField, leadField := range m.Fields {
+		value, ok := data[providerField]
+		if !ok || value == nil {
+			continue
+		}
+		overwrite := m.canOverwrite(leadField)
+
+		if leadField == LeadFieldEmployeeCount {
+			count, ok := toInt(value)
+			if !ok || count <= 0 || (!overwrite && current.EmployeeCount != 0) {
+				continue
+			}
+			update.SetEmployeeCount(count)
+			continue
+		}
+
+		text, ok := toString(value)
+		if !ok || text == "" || (!overwrite && stringField(current, leadField) != "") {
+			continue
+		}
+		switch leadField {
+		case LeadFieldCompanyDescription:
+			update.SetCompanyDescription(text)
+		case LeadFieldCompanyRevenue:
+			update.SetCompanyRevenue(text)
+		case LeadFieldLinkedinURL:
+			update.SetLinkedinURL(text)
+		case LeadFieldTwitterURL:
+			update.SetTwitterURL(text)
+		case LeadFieldFacebookURL:
+			update.SetFacebookURL(text)
+		}
+	}
+}
+
+// canOverwrite reports whether an enriched value may replace a non-empty
+// lead field
+func (m FieldMapping) canOverwrite(leadField string) bool {
+	for _, field := range m.Overwrite {
+		if field == leadField {
+			return true
+		}
+	}
+	return false
+}
+
+// stringField returns the current value of a string lead field
+func stringField(l *ent.Lead, leadField string) string {
+	switch leadField {
+	case LeadFieldCompanyDescription:
+		return l.CompanyDescription
+	case LeadFieldCompanyRevenue:
+		return l.CompanyRevenue
+	case LeadFieldLinkedinURL:
+		return l.LinkedinURL
+	case LeadFieldTwitterURL:
+		return l.TwitterURL
+	case LeadFieldFacebookURL:
+		return l.FacebookURL
+	}
+	return ""
+}
+
+func isLeadField(field string) bool {
+	for _, leadField := range LeadFields {
+		if leadField == field {
+			return true
+		}
+	}
+	return false
+}
+
+// toString converts a provider value to text, formatting numbers
+func toString(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return strings.TrimSpace(v), true
+	case int, int64, float64, json.Number:
+		return fmt.Sprint(v), true
+	}
+	return "", false
+}
+
+// toInt converts a provider value to a whole number
+func toInt(value interface{}) (int, bool) {
+	switch v := value.(type) {
+	case int:
+		return v, true
+	case int64:
+		return int(v), true
+	case float64:
+		return int(math.Round(v)), true
+	case json.Number:
+		n, err := v.Float64()
+		return int(math.Round(n)), err == nil
+	case string:
+		n, err := strconv.Atoi(strings.TrimSpace(v))
+		return n, err == nil
+	}
+	return 0, false
+}
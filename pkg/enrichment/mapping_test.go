@@ -0,0 +1,158 @@
+package enrichment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rawProvider returns company data under its own field names
+type rawProvider struct {
+	MockEnrichmentProvider
+	fields map[string]interface{}
+}
+
+func (p *rawProvider) EnrichCompanyFields(ctx context.Context, domain string) (map[string]interface{}, error) {
+	return p.fields, nil
+}
+
+func TestEnrichLead_RawProviderMapping(t *testing.T) {
+	client, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer SetFieldMapping(DefaultFieldMapping())
+
+	require.NoError(t, SetFieldMapping(FieldMapping{
+		Provider: "clearbit",
+		Fields: map[string]string{
+			"bio":               LeadFieldCompanyDescription,
+			"metrics.employees": LeadFieldEmployeeCount,
+			"linkedin.handle":   LeadFieldLinkedinURL,
+		},
+		Overwrite: LeadFields,
+	}))
+
+	provider := &rawProvider{fields: map[string]interface{}{
+		"bio":               "Tattoo studio",
+		"metrics.employees": float64(12), // JSON numbers decode as float64
+		"linkedin.handle":   "https://linkedin.com/company/ink",
+		"twitter":           "https://twitter.com/ignored", // Not mapped
+	}}
+	service := NewService(client, provider)
+	l := createTestLead(t, client, "Ink", "ink@example.com", "https://ink.example.com")
+
+	enriched, err := service.EnrichLead(context.Background(), 0, l.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Tattoo studio", enriched.CompanyDescription)
+	assert.Equal(t, 12, enriched.EmployeeCount)
+	assert.Equal(t, "https://linkedin.com/company/ink", enriched.LinkedinURL)
+	assert.Empty(t, enriched.TwitterURL)
+	assert.True(t, enriched.IsEnriched)
+}
+
+func TestEnrichLead_OverwritePolicy(t *testing.T) {
+	client, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer SetFieldMapping(DefaultFieldMapping())
+
+	mapping := DefaultFieldMapping()
+	mapping.Overwrite = []string{LeadFieldEmployeeCount}
+	require.NoError(t, SetFieldMapping(mapping))
+
+	service := NewService(client, &MockEnrichmentProvider{})
+	l := createTestLead(t, client, "Studio", "studio@example.com", "https://studio.example.com")
+	l, err := client.Lead.UpdateOne(l).
+		SetCompanyDescription("Curated by hand").
+		SetEmployeeCount(3).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	enriched, err := service.EnrichLead(context.Background(), 0, l.ID)
+	require.NoError(t, err)
+
+	// Protected fields keep their value, but empty ones are still filled
+	assert.Equal(t, "Curated by hand", enriched.CompanyDescription)
+	assert.Equal(t, "https://twitter.com/test", enriched.TwitterURL)
+	// Overwritable fields take the enriched value
+	assert.Equal(t, 50, enriched.EmployeeCount)
+}
+
+func TestEnrichLead_EmptyValuesKeepLeadData(t *testing.T) {
+	client, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	provider := &rawProvider{fields: map[string]interface{}{"description": "", "employee_count": 0}}
+	service := NewService(client, provider)
+	l := createTestLead(t, client, "Studio", "studio@example.com", "https://studio.example.com")
+	l, err := client.Lead.UpdateOne(l).
+		SetCompanyDescription("Existing").
+		SetEmployeeCount(3).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	enriched, err := service.EnrichLead(context.Background(), 0, l.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Existing", enriched.CompanyDescription)
+	assert.Equal(t, 3, enriched.EmployeeCount)
+}
+
+func TestFieldMapping_Validate(t *testing.T) {
+	assert.NoError(t, DefaultFieldMapping().Validate())
+
+	unknown := DefaultFieldMapping().WithFields(map[string]string{"phone": "phone"})
+	assert.ErrorIs(t, unknown.Validate(), ErrInvalidFieldMapping)
+
+	duplicate := FieldMapping{Fields: map[string]string{"bio": LeadFieldCompanyDescription, "about": LeadFieldCompanyDescription}}
+	assert.ErrorIs(t, duplicate.Validate(), ErrInvalidFieldMapping)
+
+	badOverwrite := FieldMapping{Overwrite: []string{"email"}}
+	assert.ErrorIs(t, badOverwrite.Validate(), ErrInvalidFieldMapping)
+
+	assert.ErrorIs(t, SetFieldMapping(duplicate), ErrInvalidFieldMapping)
+	assert.Equal(t, "default", GetMappingConfig().Provider, "invalid mapping is not applied")
+}
+
+func TestFieldMapping_WithFieldsReplacesLeadFieldSource(t *testing.T) {
+	mapping := DefaultFieldMapping().WithFields(map[string]string{"bio": LeadFieldCompanyDescription})
+
+	require.NoError(t, mapping.Validate())
+	assert.Equal(t, LeadFieldCompanyDescription, mapping.Fields["bio"])
+	assert.NotContains(t, mapping.Fields, "description")
+	assert.Equal(t, LeadFieldCompanyRevenue, mapping.Fields["revenue"])
+}
+
+func TestParseFieldMap(t *testing.T) {
+	fields, err := ParseFieldMap(" bio = company_description, employees=employee_count ,")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"bio": "company_description", "employees": "employee_count"}, fields)
+
+	_, err = ParseFieldMap("bio")
+	assert.ErrorIs(t, err, ErrInvalidFieldMapping)
+
+	_, err = ParseFieldMap("=employee_count")
+	assert.ErrorIs(t, err, ErrInvalidFieldMapping)
+}
+
+func TestParseOverwriteFields(t *testing.T) {
+	assert.Equal(t, LeadFields, ParseOverwriteFields("all"))
+	assert.Empty(t, ParseOverwriteFields("none"))
+	assert.Equal(t, []string{"employee_count", "company_revenue"}, ParseOverwriteFields("employee_count, company_revenue,"))
+}
+
+func TestGetMappingConfig(t *testing.T) {
+	defer SetFieldMapping(DefaultFieldMapping())
+
+	mapping := DefaultFieldMapping().WithFields(map[string]string{"bio": LeadFieldCompanyDescription})
+	mapping.Provider = "clearbit"
+	delete(mapping.Fields, "facebook")
+	mapping.Overwrite = []string{LeadFieldEmployeeCount}
+	require.NoError(t, SetFieldMapping(mapping))
+
+	config := GetMappingConfig()
+	assert.Equal(t, "clearbit", config.Provider)
+	require.Len(t, config.Fields, len(LeadFields))
+	assert.Equal(t, FieldRule{LeadField: LeadFieldCompanyDescription, ProviderField: "bio"}, config.Fields[0])
+	assert.Equal(t, FieldRule{LeadField: LeadFieldEmployeeCount, ProviderField: "employee_count", Overwrite: true}, config.Fields[1])
+	assert.Equal(t, FieldRule{LeadField: LeadFieldFacebookURL}, config.Fields[5])
+}
@@ -9,7 +9,10 @@ import (
 	"time"
 
 	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/enrichmentattempt"
 	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/leadchange"
+	"github.com/jordanlanch/industrydb/pkg/leads"
 )
 
 var (
@@ -46,6 +49,8 @@ type BulkEnrichmentResult struct {
 	SuccessCount int                `json:"success_count"`
 	FailureCount int                `json:"failure_count"`
 	Errors       map[int]string     `json:"errors"` // lead_id -> error message
+	// BudgetExceeded is set when the enrichment budget ran out mid-run
+	BudgetExceeded bool `json:"budget_exceeded,omitempty"`
 }
 
 // EnrichmentStats represents statistics about lead enrichment
@@ -54,6 +59,13 @@ type EnrichmentStats struct {
 	EnrichedLeads   int     `json:"enriched_leads"`
 	UnenrichedLeads int     `json:"unenriched_leads"`
 	EnrichmentRate  float64 `json:"enrichment_rate"` // Percentage
+	// Provider calls from the enrichment history, in total and per provider
+	Attempts       int             `json:"attempts"`
+	FailedAttempts int             `json:"failed_attempts"`
+	TotalCostCents int             `json:"total_cost_cents"`
+	Providers      []ProviderStats `json:"providers"`
+	// Budget is the caller's spend this month against its enrichment budget
+	Budget *BudgetStatus `json:"budget,omitempty"`
 }
 
 // EnrichmentProvider is an interface for third-party enrichment APIs
@@ -76,8 +88,9 @@ func NewService(db *ent.Client, provider EnrichmentProvider) *Service {
 	}
 }
 
-// EnrichLead enriches a lead with additional data from third-party APIs
-func (s *Service) EnrichLead(ctx context.Context, leadID int) (*ent.Lead, error) {
+// EnrichLead enriches a lead with additional data from third-party APIs and
+// records the changed fields in the lead's history on behalf of userID.
+func (s *Service) EnrichLead(ctx context.Context, userID, leadID int) (*ent.Lead, error) {
 	// Get the lead
 	l, err := s.db.Lead.Get(ctx, leadID)
 	if err != nil {
@@ -90,33 +103,83 @@ func (s *Service) EnrichLead(ctx context.Context, leadID int) (*ent.Lead, error)
 		return nil, fmt.Errorf("no valid website for enrichment")
 	}
 
-	// Call enrichment API
-	companyData, err := s.provider.EnrichCompany(ctx, domain)
+	// Don't pay the provider again for a lead it just failed on
+	if err := s.checkRecentFailure(ctx, leadID); err != nil {
+		return nil, err
+	}
+
+	// Wait for a provider call slot before charging the budget, so a
+	// request giving up in the queue costs nothing
+	if err := providerCalls.acquire(ctx); err != nil {
+		return nil, fmt.Errorf("enrichment failed: %w", err)
+	}
+	if err := s.chargeBudget(ctx, userID, attemptCosts.Company); err != nil {
+		providerCalls.release()
+		return nil, err
+	}
+
+	// Call enrichment API, logging the attempt in the lead's history
+	started := time.Now()
+	companyFields, err := s.enrichCompany(ctx, domain)
+	providerCalls.release()
+	s.recordAttempt(ctx, attempt{
+		leadID:   leadID,
+		userID:   userID,
+		kind:     enrichmentattempt.KindCompany,
+		fields:   returnedFields(companyFields),
+		err:      err,
+		cost:     attemptCosts.Company,
+		duration: time.Since(started),
+	})
 	if err != nil {
 		return nil, fmt.Errorf("enrichment failed: %w", err)
 	}
 
-	// Update lead with enriched data
+	// Update lead with enriched data, translated by the field mapping
 	update := s.db.Lead.UpdateOneID(leadID).
-		SetCompanyDescription(companyData.Description).
-		SetEmployeeCount(companyData.EmployeeCount).
-		SetCompanyRevenue(companyData.Revenue).
-		SetLinkedinURL(companyData.LinkedIn).
-		SetTwitterURL(companyData.Twitter).
-		SetFacebookURL(companyData.Facebook).
 		SetIsEnriched(true).
 		SetEnrichedAt(time.Now())
+	fieldMapping.apply(update, l, companyFields)
 
 	enrichedLead, err := update.Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to save enriched data: %w", err)
 	}
 
+	// Enriched data affects completeness, so refresh the quality and
+	// completeness scores
+	enrichedLead, err = leads.RefreshQuality(ctx, s.db.Lead, enrichedLead)
+	if err != nil {
+		return nil, err
+	}
+	enrichedLead, err = leads.RefreshCompleteness(ctx, s.db.Lead, enrichedLead)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := leads.RecordChange(ctx, s.db.LeadChange, l, enrichedLead, leadchange.SourceEnrichment, userID); err != nil {
+		return nil, err
+	}
+
 	return enrichedLead, nil
 }
 
+// enrichCompany fetches the company fields of a domain, under the provider's
+// own field names when it supports them
+func (s *Service) enrichCompany(ctx context.Context, domain string) (map[string]interface{}, error) {
+	if raw, ok := s.provider.(RawCompanyProvider); ok {
+		return raw.EnrichCompanyFields(ctx, domain)
+	}
+
+	companyData, err := s.provider.EnrichCompany(ctx, domain)
+	if err != nil {
+		return nil, err
+	}
+	return companyData.fields(), nil
+}
+
 // ValidateLeadEmail validates a lead's email address
-func (s *Service) ValidateLeadEmail(ctx context.Context, leadID int) (*EmailValidation, error) {
+func (s *Service) ValidateLeadEmail(ctx context.Context, userID, leadID int) (*EmailValidation, error) {
 	// Get the lead
 	l, err := s.db.Lead.Get(ctx, leadID)
 	if err != nil {
@@ -126,45 +189,69 @@ func (s *Service) ValidateLeadEmail(ctx context.Context, leadID int) (*EmailVali
 	if l.Email == "" {
 		return nil, fmt.Errorf("no email for validation")
 	}
+	if err := providerCalls.acquire(ctx); err != nil {
+		return nil, fmt.Errorf("email validation failed: %w", err)
+	}
+	if err := s.chargeBudget(ctx, userID, attemptCosts.EmailValidation); err != nil {
+		providerCalls.release()
+		return nil, err
+	}
 
 	// Call email validation API
+	started := time.Now()
 	validation, err := s.provider.ValidateEmail(ctx, l.Email)
+	providerCalls.release()
+	s.recordAttempt(ctx, attempt{
+		leadID:   leadID,
+		userID:   userID,
+		kind:     enrichmentattempt.KindEmailValidation,
+		err:      err,
+		cost:     attemptCosts.EmailValidation,
+		duration: time.Since(started),
+	})
 	if err != nil {
 		return nil, fmt.Errorf("email validation failed: %w", err)
 	}
 
 	// Update lead with validation status
-	if !validation.IsValid || validation.IsDisposable || !validation.Deliverable {
-		// Mark email as invalid
-		_, err = s.db.Lead.UpdateOneID(leadID).
-			SetEmailValidated(false).
-			Save(ctx)
-	} else {
-		// Mark email as valid
-		_, err = s.db.Lead.UpdateOneID(leadID).
-			SetEmailValidated(true).
-			Save(ctx)
-	}
-
+	valid := validation.IsValid && !validation.IsDisposable && validation.Deliverable
+	updated, err := s.db.Lead.UpdateOneID(leadID).
+		SetEmailValidated(valid).
+		Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update email validation status: %w", err)
 	}
 
+	if err := leads.RecordChange(ctx, s.db.LeadChange, l, updated, leadchange.SourceEnrichment, userID); err != nil {
+		return nil, err
+	}
+
 	return validation, nil
 }
 
-// BulkEnrichLeads enriches multiple leads in bulk
-func (s *Service) BulkEnrichLeads(ctx context.Context, leadIDs []int) (*BulkEnrichmentResult, error) {
+// BulkEnrichLeads enriches multiple leads in bulk. Once the budget is
+// exhausted the remaining leads are failed without calling the provider.
+func (s *Service) BulkEnrichLeads(ctx context.Context, userID int, leadIDs []int) (*BulkEnrichmentResult, error) {
 	result := &BulkEnrichmentResult{
 		TotalLeads: len(leadIDs),
 		Errors:     make(map[int]string),
 	}
 
+	var budgetErr error
 	for _, leadID := range leadIDs {
-		_, err := s.EnrichLead(ctx, leadID)
+		if budgetErr != nil {
+			result.FailureCount++
+			result.Errors[leadID] = budgetErr.Error()
+			continue
+		}
+		_, err := s.EnrichLead(ctx, userID, leadID)
 		if err != nil {
 			result.FailureCount++
 			result.Errors[leadID] = err.Error()
+			if errors.Is(err, ErrBudgetExceeded) {
+				budgetErr = err
+				result.BudgetExceeded = true
+			}
 		} else {
 			result.SuccessCount++
 		}
@@ -173,8 +260,9 @@ func (s *Service) BulkEnrichLeads(ctx context.Context, leadIDs []int) (*BulkEnri
 	return result, nil
 }
 
-// GetEnrichmentStats returns statistics about lead enrichment
-func (s *Service) GetEnrichmentStats(ctx context.Context) (*EnrichmentStats, error) {
+// GetEnrichmentStats returns statistics about lead enrichment, with the
+// budget the enrichments of userID are charged to
+func (s *Service) GetEnrichmentStats(ctx context.Context, userID int) (*EnrichmentStats, error) {
 	// Count total leads
 	totalLeads, err := s.db.Lead.Query().Count(ctx)
 	if err != nil {
@@ -196,12 +284,30 @@ func (s *Service) GetEnrichmentStats(ctx context.Context) (*EnrichmentStats, err
 		enrichmentRate = (float64(enrichedLeads) / float64(totalLeads)) * 100
 	}
 
-	return &EnrichmentStats{
+	providers, err := s.providerStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &EnrichmentStats{
 		TotalLeads:      totalLeads,
 		EnrichedLeads:   enrichedLeads,
 		UnenrichedLeads: unenrichedLeads,
 		EnrichmentRate:  enrichmentRate,
-	}, nil
+		Providers:       providers,
+	}
+	for _, provider := range providers {
+		stats.Attempts += provider.Attempts
+		stats.FailedAttempts += provider.Failures
+		stats.TotalCostCents += provider.CostCents
+	}
+
+	if owner, ok := budgetOwner(ctx, userID); ok {
+		if stats.Budget, err = s.GetBudget(ctx, owner); err != nil {
+			return nil, err
+		}
+	}
+	return stats, nil
 }
 
 // extractDomain extracts the domain from a website URL
@@ -7,6 +7,7 @@ import (
 	"github.com/jordanlanch/industrydb/ent"
 	"github.com/jordanlanch/industrydb/ent/enttest"
 	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/pkg/leads"
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -82,7 +83,7 @@ func TestEnrichLead(t *testing.T) {
 	lead := createTestLead(t, client, "Test Business", "test@example.com", "https://example.com")
 
 	t.Run("Success - Enrich lead with company data", func(t *testing.T) {
-		enriched, err := service.EnrichLead(ctx, lead.ID)
+		enriched, err := service.EnrichLead(ctx, 0, lead.ID)
 
 		require.NoError(t, err)
 		require.NotNil(t, enriched)
@@ -104,10 +105,13 @@ func TestEnrichLead(t *testing.T) {
 		// Verify enrichment metadata
 		assert.NotNil(t, enriched.EnrichedAt)
 		assert.True(t, enriched.IsEnriched)
+
+		// Completeness is recomputed: the lead has an email, a website and coordinates
+		assert.Equal(t, leads.CompletenessEmail+leads.CompletenessWebsite+leads.CompletenessCoordinates, enriched.CompletenessScore)
 	})
 
 	t.Run("Success - Validate email", func(t *testing.T) {
-		validation, err := service.ValidateLeadEmail(ctx, lead.ID)
+		validation, err := service.ValidateLeadEmail(ctx, 0, lead.ID)
 
 		require.NoError(t, err)
 		require.NotNil(t, validation)
@@ -122,13 +126,13 @@ func TestEnrichLead(t *testing.T) {
 		failingProvider := &MockEnrichmentProvider{shouldFail: true}
 		failingService := NewService(client, failingProvider)
 
-		_, err := failingService.EnrichLead(ctx, lead.ID)
+		_, err := failingService.EnrichLead(ctx, 0, lead.ID)
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "enrichment failed")
 	})
 
 	t.Run("Failure - Lead not found", func(t *testing.T) {
-		_, err := service.EnrichLead(ctx, 99999)
+		_, err := service.EnrichLead(ctx, 0, 99999)
 		require.Error(t, err)
 	})
 }
@@ -148,7 +152,7 @@ func TestBulkEnrichLeads(t *testing.T) {
 
 	t.Run("Success - Bulk enrich multiple leads", func(t *testing.T) {
 		leadIDs := []int{lead1.ID, lead2.ID, lead3.ID}
-		result, err := service.BulkEnrichLeads(ctx, leadIDs)
+		result, err := service.BulkEnrichLeads(ctx, 0, leadIDs)
 
 		require.NoError(t, err)
 		assert.Equal(t, 3, result.TotalLeads)
@@ -171,7 +175,7 @@ func TestBulkEnrichLeads(t *testing.T) {
 		mixedService := NewService(client, mixedProvider)
 
 		leadIDs := []int{lead1.ID, lead2.ID, 99999} // 99999 doesn't exist
-		result, err := mixedService.BulkEnrichLeads(ctx, leadIDs)
+		result, err := mixedService.BulkEnrichLeads(ctx, 0, leadIDs)
 
 		require.NoError(t, err) // Bulk operation succeeds even with some failures
 		assert.Equal(t, 3, result.TotalLeads)
@@ -195,11 +199,11 @@ func TestGetEnrichmentStats(t *testing.T) {
 	_ = createTestLead(t, client, "Not Enriched", "test3@example.com", "https://example3.com")
 
 	// Enrich first two leads
-	service.EnrichLead(ctx, lead1.ID)
-	service.EnrichLead(ctx, lead2.ID)
+	service.EnrichLead(ctx, 0, lead1.ID)
+	service.EnrichLead(ctx, 0, lead2.ID)
 
 	t.Run("Success - Get enrichment statistics", func(t *testing.T) {
-		stats, err := service.GetEnrichmentStats(ctx)
+		stats, err := service.GetEnrichmentStats(ctx, 0)
 
 		require.NoError(t, err)
 		assert.Equal(t, 3, stats.TotalLeads)
@@ -0,0 +1,208 @@
+// Package errortracking configures Sentry event reporting.
+package errortracking
+
+import (
+	"encoding/json"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/getsentry/sentry-go"
+)
+
+// Filtered replaces scrubbed values in events sent to Sentry
+const Filtered = "[Filtered]"
+
+// sensitiveKeys are header, body, query, extra and context keys whose values
+// never leave the process. Keys are compared lowercased with "-" mapped to
+// "_", so "X-API-Key" matches "x_api_key".
+var sensitiveKeys = map[string]bool{
+	// Auth headers and cookies
+	"authorization":       true,
+	"proxy_authorization": true,
+	"cookie":              true,
+	"set_cookie":          true,
+	"x_csrf_token":        true,
+	"x_webhook_signature": true,
+
+	// Credentials
+	"password":         true,
+	"new_password":     true,
+	"current_password": true,
+	"password_hash":    true,
+	"token":            true,
+	"access_token":     true,
+	"refresh_token":    true,
+	"id_token":         true,
+	"secret":           true,
+	"client_secret":    true,
+	"webhook_secret":   true,
+	"delivery_secret":  true,
+	"totp_code":        true,
+	"otp":              true,
+
+	// API keys
+	"api_key":   true,
+	"apikey":    true,
+	"x_api_key": true,
+	"key_hash":  true,
+
+	// Email bodies
+	"body":          true,
+	"html_body":     true,
+	"text_body":     true,
+	"html_content":  true,
+	"plain_content": true,
+}
+
+// secretPatterns match credentials embedded in free text such as error
+// messages
+var secretPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)bearer\s+[a-z0-9._~+/=-]+`),
+	regexp.MustCompile(`idb_[a-f0-9]{16,}`),
+	regexp.MustCompile(`sk_(live|test)_[A-Za-z0-9]+`),
+}
+
+// IsSensitiveKey reports whether values stored under key are scrubbed
+func IsSensitiveKey(key string) bool {
+	return sensitiveKeys[strings.ReplaceAll(strings.ToLower(key), "-", "_")]
+}
+
+// ScrubEvent removes sensitive data from an event before it is sent. It
+// matches sentry.ClientOptions.BeforeSend.
+func ScrubEvent(event *sentry.Event, _ *sentry.EventHint) *sentry.Event {
+	if event == nil {
+		return nil
+	}
+
+	event.Message = ScrubString(event.Message)
+	for i := range event.Exception {
+		event.Exception[i].Value = ScrubString(event.Exception[i].Value)
+	}
+
+	// Keep the user ID for grouping, drop personal details
+	event.User.Email = ""
+	event.User.IPAddress = ""
+	event.User.Name = ""
+	event.User.Data = scrubStringMap(event.User.Data)
+
+	if event.Request != nil {
+		event.Request.Headers = scrubStringMap(event.Request.Headers)
+		event.Request.Env = scrubStringMap(event.Request.Env)
+		if event.Request.Cookies != "" {
+			event.Request.Cookies = Filtered
+		}
+		event.Request.QueryString = scrubQuery(event.Request.QueryString)
+		event.Request.Data = scrubBody(event.Request.Data)
+		event.Request.URL = ScrubString(event.Request.URL)
+	}
+
+	event.Tags = scrubStringMap(event.Tags)
+	event.Extra = scrubMap(event.Extra)
+	for name, context := range event.Contexts {
+		event.Contexts[name] = scrubMap(context)
+	}
+	for _, breadcrumb := range event.Breadcrumbs {
+		if breadcrumb == nil {
+			continue
+		}
+		breadcrumb.Message = ScrubString(breadcrumb.Message)
+		breadcrumb.Data = scrubMap(breadcrumb.Data)
+	}
+
+	return event
+}
+
+// ScrubString masks bearer tokens and API keys embedded in free text
+func ScrubString(s string) string {
+	for _, pattern := range secretPatterns {
+		s = pattern.ReplaceAllString(s, Filtered)
+	}
+	return s
+}
+
+// scrubValue scrubs nested maps and slices and masks secrets in strings
+func scrubValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return scrubMap(v)
+	case map[string]string:
+		return scrubStringMap(v)
+	case []interface{}:
+		for i := range v {
+			v[i] = scrubValue(v[i])
+		}
+		return v
+	case string:
+		return ScrubString(v)
+	default:
+		return v
+	}
+}
+
+func scrubMap(m map[string]interface{}) map[string]interface{} {
+	for key, value := range m {
+		if IsSensitiveKey(key) {
+			m[key] = Filtered
+			continue
+		}
+		m[key] = scrubValue(value)
+	}
+	return m
+}
+
+func scrubStringMap(m map[string]string) map[string]string {
+	for key, value := range m {
+		if IsSensitiveKey(key) {
+			m[key] = Filtered
+			continue
+		}
+		m[key] = ScrubString(value)
+	}
+	return m
+}
+
+// scrubQuery masks sensitive query parameters
+func scrubQuery(query string) string {
+	if query == "" {
+		return query
+	}
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return Filtered
+	}
+	for key := range values {
+		if IsSensitiveKey(key) {
+			values[key] = []string{Filtered}
+		}
+	}
+	return values.Encode()
+}
+
+// scrubBody masks sensitive fields of a JSON or form-encoded request body.
+// Bodies in other formats are dropped, they cannot be scrubbed reliably.
+func scrubBody(body string) string {
+	if body == "" {
+		return body
+	}
+
+	var parsed interface{}
+	if err := json.Unmarshal([]byte(body), &parsed); err == nil {
+		scrubbed, err := json.Marshal(scrubValue(parsed))
+		if err != nil {
+			return Filtered
+		}
+		return string(scrubbed)
+	}
+
+	if values, err := url.ParseQuery(body); err == nil && strings.Contains(body, "=") {
+		for key := range values {
+			if IsSensitiveKey(key) {
+				values[key] = []string{Filtered}
+			}
+		}
+		return values.Encode()
+	}
+
+	return Filtered
+}
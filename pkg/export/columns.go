@@ -0,0 +1,129 @@
+package export
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/jordanlanch/industrydb/pkg/models"
+)
+
+// ErrInvalidColumn is returned when an export selects an unknown column
+var ErrInvalidColumn = errors.New("invalid export column")
+
+// exportColumn is a selectable export column
+type exportColumn struct {
+	Key    string
+	Header string
+	Value  func(models.LeadResponse) interface{} // Typed value for Excel cells
+}
+
+// exportColumns lists every selectable column in default export order
+var exportColumns = []exportColumn{
+	{"id", "ID", func(l models.LeadResponse) interface{} { return l.ID }},
+	{"name", "Name", func(l models.LeadResponse) interface{} { return l.Name }},
+	{"industry", "Industry", func(l models.LeadResponse) interface{} { return l.Industry }},
+	{"country", "Country", func(l models.LeadResponse) interface{} { return l.Country }},
+	{"city", "City", func(l models.LeadResponse) interface{} { return l.City }},
+	{"address", "Address", func(l models.LeadResponse) interface{} { return l.Address }},
+	{"postal_code", "Postal Code", func(l models.LeadResponse) interface{} { return l.PostalCode }},
+	{"phone", "Phone", func(l models.LeadResponse) interface{} { return l.Phone }},
+	{"email", "Email", func(l models.LeadResponse) interface{} { return l.Email }},
+	{"website", "Website", func(l models.LeadResponse) interface{} { return l.Website }},
+	{"latitude", "Latitude", func(l models.LeadResponse) interface{} { return l.Latitude }},
+	{"longitude", "Longitude", func(l models.LeadResponse) interface{} { return l.Longitude }},
+	{"verified", "Verified", func(l models.LeadResponse) interface{} { return l.Verified }},
+	{"quality_score", "Quality Score", func(l models.LeadResponse) interface{} { return l.QualityScore }},
+	{"created_at", "Created At", func(l models.LeadResponse) interface{} { return l.CreatedAt }},
+	{"updated_at", "Updated At", func(l models.LeadResponse) interface{} { return l.UpdatedAt }},
+}
+
+// ExportRequest.Suppressed values
+const (
+	SuppressedOmit     = "omit"     // Leave suppressed leads out (default)
+	SuppressedAnnotate = "annotate" // Include them with a Suppressed column
+)
+
+// ExportColumnKeys returns the keys accepted in ExportRequest.Columns
+func ExportColumnKeys() []string {
+	keys := make([]string, len(exportColumns))
+	for i, col := range exportColumns {
+		keys[i] = col.Key
+	}
+	return keys
+}
+
+// ValidateColumns checks that every key selects an export column
+func ValidateColumns(keys []string) error {
+	_, err := resolveColumns(keys)
+	return err
+}
+
+// resolveColumns returns the selected columns in request order, or every
+// column when none are selected
+func resolveColumns(keys []string) ([]exportColumn, error) {
+	if len(keys) == 0 {
+		return exportColumns, nil
+	}
+
+	byKey := make(map[string]exportColumn, len(exportColumns))
+	for _, col := range exportColumns {
+		byKey[col.Key] = col
+	}
+
+	columns := make([]exportColumn, 0, len(keys))
+	seen := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		col, ok := byKey[key]
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidColumn, key)
+		}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		columns = append(columns, col)
+	}
+	return columns, nil
+}
+
+// withSuppressedColumn appends a Suppressed column flagging the given leads
+func withSuppressedColumn(columns []exportColumn, suppressedIDs []int) []exportColumn {
+	suppressed := make(map[int]bool, len(suppressedIDs))
+	for _, id := range suppressedIDs {
+		suppressed[id] = true
+	}
+
+	annotated := make([]exportColumn, len(columns), len(columns)+1)
+	copy(annotated, columns)
+	return append(annotated, exportColumn{"suppressed", "Suppressed", func(l models.LeadResponse) interface{} { return suppressed[l.ID] }})
+}
+
+// columnHeaders returns the header row for columns
+func columnHeaders(columns []exportColumn) []string {
+	headers := make([]string, len(columns))
+	for i, col := range columns {
+		headers[i] = col.Header
+	}
+	return headers
+}
+
+// formatRow renders a lead as text cells, as written to CSV and Sheets
+func formatRow(columns []exportColumn, lead models.LeadResponse) []string {
+	row := make([]string, len(columns))
+	for i, col := range columns {
+		switch v := col.Value(lead).(type) {
+		case string:
+			row[i] = v
+		case int:
+			row[i] = strconv.Itoa(v)
+		case float64:
+			row[i] = fmt.Sprintf("%.6f", v)
+		case bool:
+			row[i] = strconv.FormatBool(v)
+		default:
+			row[i] = fmt.Sprint(v)
+		}
+	}
+	return row
+}
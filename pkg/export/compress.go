@@ -0,0 +1,92 @@
+package export
+
+import (
+	"archive/zip"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Export compression options (compress=gzip|zip). Exports are uncompressed
+// by default.
+const (
+	CompressGzip = "gzip"
+	CompressZip  = "zip"
+)
+
+// ValidateCompression checks an export's compress option
+func ValidateCompression(compress string) error {
+	switch compress {
+	case "", CompressGzip, CompressZip:
+		return nil
+	}
+	return fmt.Errorf("invalid compress: must be gzip or zip")
+}
+
+// compressFile replaces the generated export file at path with a compressed
+// artifact and returns its path: path.gz for gzip, or for zip a .zip
+// archive holding the file as its single entry, named after the export.
+// Without compression the file is kept as is.
+func compressFile(path, compress string) (string, error) {
+	var compressedPath string
+	switch compress {
+	case CompressGzip:
+		compressedPath = path + ".gz"
+	case CompressZip:
+		compressedPath = strings.TrimSuffix(path, filepath.Ext(path)) + ".zip"
+	default:
+		return path, nil
+	}
+
+	if err := writeCompressed(path, compressedPath, compress); err != nil {
+		os.Remove(compressedPath)
+		return "", err
+	}
+	if err := os.Remove(path); err != nil {
+		return "", fmt.Errorf("failed to remove uncompressed file: %w", err)
+	}
+	return compressedPath, nil
+}
+
+// writeCompressed writes the file at path, compressed, to compressedPath
+func writeCompressed(path, compressedPath, compress string) error {
+	src, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open file: %w", err)
+	}
+	defer src.Close()
+
+	dst, err := os.Create(compressedPath)
+	if err != nil {
+		return fmt.Errorf("failed to create compressed file: %w", err)
+	}
+	defer dst.Close()
+
+	if compress == CompressGzip {
+		gzipWriter := gzip.NewWriter(dst)
+		gzipWriter.Name = filepath.Base(path)
+		if _, err := io.Copy(gzipWriter, src); err != nil {
+			return fmt.Errorf("failed to compress file: %w", err)
+		}
+		if err := gzipWriter.Close(); err != nil {
+			return fmt.Errorf("failed to close gzip writer: %w", err)
+		}
+	} else {
+		zipWriter := zip.NewWriter(dst)
+		entry, err := zipWriter.Create(filepath.Base(path))
+		if err != nil {
+			return fmt.Errorf("failed to create zip entry: %w", err)
+		}
+		if _, err := io.Copy(entry, src); err != nil {
+			return fmt.Errorf("failed to compress file: %w", err)
+		}
+		if err := zipWriter.Close(); err != nil {
+			return fmt.Errorf("failed to close zip writer: %w", err)
+		}
+	}
+
+	return dst.Close()
+}
@@ -0,0 +1,74 @@
+package export
+
+import (
+	"archive/zip"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressFile(t *testing.T) {
+	const content = "Name,City\nInk Studio,Austin\n"
+	write := func() string {
+		path := filepath.Join(t.TempDir(), "export-7-20261016-120000.csv")
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+		return path
+	}
+
+	t.Run("gzip", func(t *testing.T) {
+		path := write()
+		compressed, err := compressFile(path, CompressGzip)
+		require.NoError(t, err)
+		assert.Equal(t, path+".gz", compressed)
+		assert.NoFileExists(t, path)
+
+		file, err := os.Open(compressed)
+		require.NoError(t, err)
+		defer file.Close()
+		reader, err := gzip.NewReader(file)
+		require.NoError(t, err)
+		assert.Equal(t, "export-7-20261016-120000.csv", reader.Name)
+		data, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		assert.Equal(t, content, string(data))
+	})
+
+	t.Run("zip", func(t *testing.T) {
+		path := write()
+		compressed, err := compressFile(path, CompressZip)
+		require.NoError(t, err)
+		assert.Equal(t, filepath.Join(filepath.Dir(path), "export-7-20261016-120000.zip"), compressed)
+		assert.NoFileExists(t, path)
+
+		archive, err := zip.OpenReader(compressed)
+		require.NoError(t, err)
+		defer archive.Close()
+		require.Len(t, archive.File, 1)
+		assert.Equal(t, "export-7-20261016-120000.csv", archive.File[0].Name)
+		entry, err := archive.File[0].Open()
+		require.NoError(t, err)
+		data, err := io.ReadAll(entry)
+		require.NoError(t, err)
+		assert.Equal(t, content, string(data))
+	})
+
+	t.Run("uncompressed by default", func(t *testing.T) {
+		path := write()
+		compressed, err := compressFile(path, "")
+		require.NoError(t, err)
+		assert.Equal(t, path, compressed)
+		assert.FileExists(t, path)
+	})
+}
+
+func TestValidateCompression(t *testing.T) {
+	assert.NoError(t, ValidateCompression(""))
+	assert.NoError(t, ValidateCompression(CompressGzip))
+	assert.NoError(t, ValidateCompression(CompressZip))
+	assert.Error(t, ValidateCompression("brotli"))
+}
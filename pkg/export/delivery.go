@@ -0,0 +1,147 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/export"
+	"github.com/jordanlanch/industrydb/pkg/webhook"
+)
+
+// ErrInvalidDeliveryURL is returned when an export delivery URL is not an
+// absolute https URL on a public host
+var ErrInvalidDeliveryURL = errors.New("invalid delivery URL")
+
+// Delivery retry defaults, mirroring webhook deliveries
+const (
+	defaultDeliveryRetries = 3
+	defaultDeliveryBackoff = time.Second
+)
+
+// ValidateDeliveryURL checks a delivery URL with the outbound URL guard
+// shared with webhooks (see webhook.ValidateURL): it must be an absolute
+// https URL without credentials whose host is public
+func ValidateDeliveryURL(ctx context.Context, raw string) error {
+	if err := webhook.ValidateURL(ctx, raw); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidDeliveryURL, err)
+	}
+	return nil
+}
+
+// newDeliveryClient creates the guarded client deliveries are sent with
+// (see webhook.NewGuardedClient)
+func newDeliveryClient() *http.Client {
+	return webhook.NewGuardedClient(60 * time.Second)
+}
+
+// deliverExport POSTs the completed export file to its delivery URL with
+// retries and records the outcome on the export. The body is the raw file,
+// signed with the export's delivery secret in X-Webhook-Signature. Gzip
+// compressed files are sent with Content-Encoding: gzip, zip archives as
+// application/zip.
+func (s *Service) deliverExport(ctx context.Context, exp *ent.Export) {
+	body, err := os.ReadFile(exp.FilePath)
+	if err != nil {
+		s.recordDeliveryFailure(ctx, exp.ID, 0, fmt.Sprintf("failed to read export file: %v", err))
+		return
+	}
+
+	signature := webhook.Sign(body, exp.DeliverySecret)
+	contentType := "text/csv"
+	switch exp.Format {
+	case export.FormatExcel:
+		contentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	case export.FormatVcard:
+		contentType = "text/vcard"
+	case export.FormatGeojson:
+		contentType = "application/geo+json"
+	case export.FormatKml:
+		contentType = "application/vnd.google-earth.kml+xml"
+	}
+	var contentEncoding string
+	if exp.Compression != nil {
+		switch *exp.Compression {
+		case export.CompressionGzip:
+			contentEncoding = "gzip"
+		case export.CompressionZip:
+			contentType = "application/zip"
+		}
+	}
+
+	var lastErr string
+	attempts := 0
+	for attempt := 0; attempt <= s.deliveryRetries; attempt++ {
+		if attempt > 0 {
+			// Exponential backoff: 2^attempt * base
+			time.Sleep(time.Duration(1<<uint(attempt)) * s.deliveryBackoff)
+		}
+		attempts++
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, exp.DeliveryURL, bytes.NewReader(body))
+		if err != nil {
+			// A malformed URL will not succeed on retry
+			lastErr = fmt.Sprintf("failed to create request: %v", err)
+			break
+		}
+		req.Header.Set("Content-Type", contentType)
+		if contentEncoding != "" {
+			req.Header.Set("Content-Encoding", contentEncoding)
+		}
+		req.Header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filepath.Base(exp.FilePath)))
+		req.Header.Set("X-Webhook-Signature", signature)
+		req.Header.Set("X-Webhook-Event", webhook.EventExportCompleted)
+		req.Header.Set("X-Export-ID", strconv.Itoa(exp.ID))
+
+		resp, err := s.httpClient.Do(req)
+		if err != nil {
+			lastErr = err.Error()
+			log.Printf("⚠️  Export %d delivery failed (attempt %d/%d): %v", exp.ID, attempt+1, s.deliveryRetries+1, err)
+			if errors.Is(err, webhook.ErrPrivateAddress) {
+				break
+			}
+			continue
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			log.Printf("✅ Export %d delivered to %s", exp.ID, exp.DeliveryURL)
+			_, err := s.db.Export.UpdateOneID(exp.ID).
+				SetDeliveryStatus(export.DeliveryStatusDelivered).
+				SetDeliveryAttempts(attempts).
+				SetDeliveredAt(time.Now()).
+				ClearDeliveryError().
+				Save(ctx)
+			if err != nil {
+				log.Printf("⚠️  Failed to record export %d delivery: %v", exp.ID, err)
+			}
+			return
+		}
+
+		lastErr = fmt.Sprintf("delivery URL returned status %d", resp.StatusCode)
+		log.Printf("⚠️  Export %d delivery returned status %d (attempt %d/%d)", exp.ID, resp.StatusCode, attempt+1, s.deliveryRetries+1)
+	}
+
+	log.Printf("❌ Export %d delivery failed after %d attempts: %s", exp.ID, attempts, lastErr)
+	s.recordDeliveryFailure(ctx, exp.ID, attempts, lastErr)
+}
+
+// recordDeliveryFailure marks an export delivery as failed
+func (s *Service) recordDeliveryFailure(ctx context.Context, exportID, attempts int, message string) {
+	_, err := s.db.Export.UpdateOneID(exportID).
+		SetDeliveryStatus(export.DeliveryStatusFailed).
+		SetDeliveryAttempts(attempts).
+		SetDeliveryError(message).
+		Save(ctx)
+	if err != nil {
+		log.Printf("⚠️  Failed to record export %d delivery failure: %v", exportID, err)
+	}
+}
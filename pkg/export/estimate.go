@@ -0,0 +1,82 @@
+package export
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jordanlanch/industrydb/pkg/leads"
+	"github.com/jordanlanch/industrydb/pkg/models"
+)
+
+// EstimateExport reports how many rows an export with these settings would
+// contain and the lead credits it would use, without creating or charging
+// anything. Each exported lead uses one credit. The matching count comes
+// from leads.Service.EstimateCount, so large results are planner estimates.
+// organizationID is optional - pass nil for personal exports.
+func (s *Service) EstimateExport(ctx context.Context, userID int, organizationID *int, req models.ExportRequest) (*models.ExportEstimateResponse, error) {
+	tier, err := s.leadService.GetExportTier(ctx, userID, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	rowCap := leads.GetExportRowCapForTier(tier)
+
+	estimate := &models.ExportEstimateResponse{
+		Tier:   tier,
+		RowCap: rowCap,
+	}
+	maxLeads := req.MaxLeads
+	if rowCap > 0 && maxLeads > rowCap {
+		// Creating this export would be rejected; estimate the capped one
+		estimate.RowCapExceeded = true
+		maxLeads = rowCap
+	}
+	maxLeads = exportRowLimit(maxLeads, rowCap)
+
+	// Same lead selection processExport makes
+	leads.ApplyVerifiedDefault(&req.Filters, tier)
+	filters := req.Filters
+	since := req.Since
+	var sinceLeadID *int
+	if since == nil && req.SinceLastExport {
+		since, sinceLeadID, err = s.lastHighWaterMark(ctx, userID, organizationID, req.Filters)
+		if err != nil {
+			return nil, err
+		}
+	}
+	filters.UpdatedSince = since
+	filters.UpdatedSinceID = sinceLeadID
+	filters.OrgScope = organizationID
+	if req.Suppressed != SuppressedAnnotate {
+		suppressed, err := s.leadService.SuppressedLeadIDs(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		filters.ExcludeLeadIDs = suppressed
+	}
+
+	count, err := s.leadService.EstimateCount(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+	estimate.MatchingCount = count.Count
+	estimate.Exact = count.Exact
+	estimate.EstimatedRows = count.Count
+	if estimate.EstimatedRows > maxLeads {
+		estimate.EstimatedRows = maxLeads
+	}
+	estimate.QuotaCost = estimate.EstimatedRows
+
+	var usage *models.UsageInfo
+	if organizationID != nil {
+		usage, err = s.leadService.GetOrganizationUsageInfo(ctx, *organizationID)
+	} else {
+		usage, err = s.leadService.GetUsageInfo(ctx, userID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get usage: %w", err)
+	}
+	estimate.Remaining = usage.Remaining
+	estimate.ExceedsRemaining = estimate.QuotaCost > usage.Remaining
+
+	return estimate, nil
+}
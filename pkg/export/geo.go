@@ -0,0 +1,141 @@
+package export
+
+import (
+	"bufio"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"os"
+
+	"github.com/jordanlanch/industrydb/pkg/models"
+)
+
+// hasCoordinates reports whether a lead can be placed on a map. Leads
+// without coordinates come back as 0,0, so that point is treated as missing.
+func hasCoordinates(lead models.LeadResponse) bool {
+	return lead.Latitude != 0 || lead.Longitude != 0
+}
+
+// geoProperties returns the columns used as feature properties: the
+// selected ones except latitude and longitude, which become the geometry
+func geoProperties(columns []exportColumn) []exportColumn {
+	properties := make([]exportColumn, 0, len(columns))
+	for _, col := range columns {
+		if col.Key == "latitude" || col.Key == "longitude" {
+			continue
+		}
+		properties = append(properties, col)
+	}
+	return properties
+}
+
+// geoJSONFeature is a GeoJSON point feature (RFC 7946)
+type geoJSONFeature struct {
+	Type       string                 `json:"type"`
+	Geometry   geoJSONPoint           `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// geoJSONPoint is a GeoJSON point; coordinates are longitude, latitude
+type geoJSONPoint struct {
+	Type        string     `json:"type"`
+	Coordinates [2]float64 `json:"coordinates"`
+}
+
+// generateGeoJSON writes leads with coordinates as a GeoJSON
+// FeatureCollection of points, with the selected columns as properties.
+// It returns how many leads were skipped for having no coordinates.
+func (s *Service) generateGeoJSON(filepath string, columns []exportColumn, leads []models.LeadResponse) (int, error) {
+	file, err := os.Create(filepath)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create file: %w", err)
+	}
+	defer file.Close()
+
+	properties := geoProperties(columns)
+	features := make([]geoJSONFeature, 0, len(leads))
+	skipped := 0
+	for _, lead := range leads {
+		if !hasCoordinates(lead) {
+			skipped++
+			continue
+		}
+		props := make(map[string]interface{}, len(properties))
+		for _, col := range properties {
+			props[col.Key] = col.Value(lead)
+		}
+		features = append(features, geoJSONFeature{
+			Type:       "Feature",
+			Geometry:   geoJSONPoint{Type: "Point", Coordinates: [2]float64{lead.Longitude, lead.Latitude}},
+			Properties: props,
+		})
+	}
+
+	collection := map[string]interface{}{
+		"type":     "FeatureCollection",
+		"features": features,
+	}
+	if err := json.NewEncoder(file).Encode(collection); err != nil {
+		return 0, fmt.Errorf("failed to write geojson: %w", err)
+	}
+
+	return skipped, nil
+}
+
+// kmlData is one ExtendedData property of a KML placemark
+type kmlData struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value"`
+}
+
+// kmlPlacemark is a KML point placemark
+type kmlPlacemark struct {
+	XMLName     xml.Name  `xml:"Placemark"`
+	Name        string    `xml:"name"`
+	Data        []kmlData `xml:"ExtendedData>Data,omitempty"`
+	Coordinates string    `xml:"Point>coordinates"`
+}
+
+// generateKML writes leads with coordinates as KML 2.2 point placemarks,
+// named after the lead with the selected columns as ExtendedData. It
+// returns how many leads were skipped for having no coordinates.
+func (s *Service) generateKML(filepath string, columns []exportColumn, leads []models.LeadResponse) (int, error) {
+	file, err := os.Create(filepath)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create file: %w", err)
+	}
+	defer file.Close()
+
+	writer := bufio.NewWriter(file)
+	writer.WriteString(xml.Header)
+	writer.WriteString(`<kml xmlns="http://www.opengis.net/kml/2.2"><Document><name>IndustryDB leads</name>` + "\n")
+
+	properties := geoProperties(columns)
+	encoder := xml.NewEncoder(writer)
+	skipped := 0
+	for _, lead := range leads {
+		if !hasCoordinates(lead) {
+			skipped++
+			continue
+		}
+		placemark := kmlPlacemark{
+			Name:        lead.Name,
+			Coordinates: fmt.Sprintf("%.6f,%.6f", lead.Longitude, lead.Latitude),
+		}
+		row := formatRow(properties, lead)
+		for i, col := range properties {
+			placemark.Data = append(placemark.Data, kmlData{Name: col.Key, Value: row[i]})
+		}
+		if err := encoder.Encode(placemark); err != nil {
+			return 0, fmt.Errorf("failed to write kml: %w", err)
+		}
+		writer.WriteString("\n")
+	}
+
+	writer.WriteString("</Document></kml>\n")
+	if err := writer.Flush(); err != nil {
+		return 0, fmt.Errorf("failed to write kml: %w", err)
+	}
+
+	return skipped, nil
+}
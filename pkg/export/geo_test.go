@@ -0,0 +1,104 @@
+package export
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var geoTestLeads = []models.LeadResponse{
+	{ID: 1, Name: "Ink & Co", Industry: "tattoo", Phone: "555-0100", Latitude: 30.2672, Longitude: -97.7431},
+	{ID: 2, Name: "Nowhere Studio", Industry: "tattoo"},
+	{ID: 3, Name: "Equator Gym", Industry: "gym", Latitude: 0, Longitude: 32.5},
+}
+
+func TestGenerateGeoJSON(t *testing.T) {
+	s := &Service{}
+	path := filepath.Join(t.TempDir(), "leads.geojson")
+	columns, err := resolveColumns([]string{"name", "industry", "phone", "latitude"})
+	require.NoError(t, err)
+
+	skipped, err := s.generateGeoJSON(path, columns, geoTestLeads)
+	require.NoError(t, err)
+	assert.Equal(t, 1, skipped)
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var collection struct {
+		Type     string `json:"type"`
+		Features []struct {
+			Type     string `json:"type"`
+			Geometry struct {
+				Type        string    `json:"type"`
+				Coordinates []float64 `json:"coordinates"`
+			} `json:"geometry"`
+			Properties map[string]interface{} `json:"properties"`
+		} `json:"features"`
+	}
+	require.NoError(t, json.Unmarshal(content, &collection))
+
+	assert.Equal(t, "FeatureCollection", collection.Type)
+	require.Len(t, collection.Features, 2)
+	feature := collection.Features[0]
+	assert.Equal(t, "Feature", feature.Type)
+	assert.Equal(t, "Point", feature.Geometry.Type)
+	assert.Equal(t, []float64{-97.7431, 30.2672}, feature.Geometry.Coordinates)
+	assert.Equal(t, map[string]interface{}{"name": "Ink & Co", "industry": "tattoo", "phone": "555-0100"}, feature.Properties)
+	assert.Equal(t, "Equator Gym", collection.Features[1].Properties["name"])
+}
+
+func TestGenerateGeoJSON_NoCoordinates(t *testing.T) {
+	s := &Service{}
+	path := filepath.Join(t.TempDir(), "leads.geojson")
+
+	skipped, err := s.generateGeoJSON(path, exportColumns, geoTestLeads[1:2])
+	require.NoError(t, err)
+	assert.Equal(t, 1, skipped)
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":"FeatureCollection","features":[]}`, string(content))
+}
+
+func TestGenerateKML(t *testing.T) {
+	s := &Service{}
+	path := filepath.Join(t.TempDir(), "leads.kml")
+	columns, err := resolveColumns([]string{"name", "industry", "phone"})
+	require.NoError(t, err)
+
+	skipped, err := s.generateKML(path, columns, geoTestLeads)
+	require.NoError(t, err)
+	assert.Equal(t, 1, skipped)
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var doc struct {
+		XMLName  xml.Name `xml:"kml"`
+		Document struct {
+			Placemarks []struct {
+				Name string `xml:"name"`
+				Data []struct {
+					Name  string `xml:"name,attr"`
+					Value string `xml:"value"`
+				} `xml:"ExtendedData>Data"`
+				Coordinates string `xml:"Point>coordinates"`
+			} `xml:"Placemark"`
+		} `xml:"Document"`
+	}
+	require.NoError(t, xml.Unmarshal(content, &doc))
+
+	assert.Equal(t, "http://www.opengis.net/kml/2.2", doc.XMLName.Space)
+	require.Len(t, doc.Document.Placemarks, 2)
+	placemark := doc.Document.Placemarks[0]
+	assert.Equal(t, "Ink & Co", placemark.Name)
+	assert.Equal(t, "-97.743100,30.267200", placemark.Coordinates)
+	require.Len(t, placemark.Data, 3)
+	assert.Equal(t, "phone", placemark.Data[2].Name)
+	assert.Equal(t, "555-0100", placemark.Data[2].Value)
+}
@@ -0,0 +1,194 @@
+package export
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/jordanlanch/industrydb/pkg/models"
+)
+
+// ErrExportQueueFull is returned when an export cannot be queued because the
+// queue, or the caller's share of it, is full
+var ErrExportQueueFull = errors.New("export queue is full")
+
+// Export queue defaults
+const (
+	defaultQueueWorkers     = 4
+	defaultMaxQueued        = 100
+	defaultMaxQueuedPerUser = 10
+)
+
+// QueueConfig bounds asynchronous export processing. Zero values use the
+// defaults.
+type QueueConfig struct {
+	Workers          int // Exports processed at once across all users
+	MaxQueued        int // Exports waiting for a worker across all users
+	MaxQueuedPerUser int // Exports a single user may have waiting
+}
+
+// QueueMetrics receives export queue measurements
+type QueueMetrics interface {
+	SetExportQueueDepth(depth int)
+	ObserveExportQueueWait(wait time.Duration)
+}
+
+// queuedExport is an export waiting for, or holding, a worker
+type queuedExport struct {
+	exportID int
+	userID   int
+	limit    int // Exports the user may have processing at once
+	req      models.ExportRequest
+	queuedAt time.Time
+}
+
+// exportQueue runs exports first-in first-out on a bounded number of
+// workers, skipping users that already have their limit of exports
+// processing
+type exportQueue struct {
+	mu      sync.Mutex
+	config  QueueConfig
+	run     func(job queuedExport)
+	metrics QueueMetrics
+	pending []queuedExport
+	running int
+	active  map[int]int // user ID -> exports processing
+}
+
+// newExportQueue creates a queue that processes each export with run
+func newExportQueue(config QueueConfig, metrics QueueMetrics, run func(job queuedExport)) *exportQueue {
+	if config.Workers <= 0 {
+		config.Workers = defaultQueueWorkers
+	}
+	if config.MaxQueued <= 0 {
+		config.MaxQueued = defaultMaxQueued
+	}
+	if config.MaxQueuedPerUser <= 0 {
+		config.MaxQueuedPerUser = defaultMaxQueuedPerUser
+	}
+
+	return &exportQueue{
+		config:  config,
+		run:     run,
+		metrics: metrics,
+		active:  make(map[int]int),
+	}
+}
+
+// enqueue adds an export to the queue and starts it if a worker and the
+// user's limit allow. It returns the 1-based queue position, or 0 when the
+// export started right away.
+func (q *exportQueue) enqueue(job queuedExport) (int, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if job.limit <= 0 {
+		job.limit = 1
+	}
+	job.queuedAt = time.Now()
+	q.pending = append(q.pending, job)
+	q.dispatchLocked()
+
+	position := q.positionLocked(job.exportID)
+	if position == 0 {
+		return 0, nil
+	}
+
+	// Still waiting: enforce the queue bounds
+	var err error
+	if len(q.pending) > q.config.MaxQueued {
+		err = fmt.Errorf("%w: %d exports are already waiting, try again later", ErrExportQueueFull, q.config.MaxQueued)
+	} else if q.userQueuedLocked(job.userID) > q.config.MaxQueuedPerUser {
+		err = fmt.Errorf("%w: you already have %d exports waiting", ErrExportQueueFull, q.config.MaxQueuedPerUser)
+	}
+	if err != nil {
+		q.pending = append(q.pending[:position-1], q.pending[position:]...)
+		q.reportDepthLocked()
+		return 0, err
+	}
+
+	return position, nil
+}
+
+// position returns the 1-based queue position of an export, or 0 when it is
+// not waiting
+func (q *exportQueue) position(exportID int) int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.positionLocked(exportID)
+}
+
+func (q *exportQueue) positionLocked(exportID int) int {
+	for i, job := range q.pending {
+		if job.exportID == exportID {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+func (q *exportQueue) userQueuedLocked(userID int) int {
+	count := 0
+	for _, job := range q.pending {
+		if job.userID == userID {
+			count++
+		}
+	}
+	return count
+}
+
+// dispatchLocked starts the oldest runnable exports while workers are free
+func (q *exportQueue) dispatchLocked() {
+	for q.running < q.config.Workers {
+		next := -1
+		for i, job := range q.pending {
+			if q.active[job.userID] < job.limit {
+				next = i
+				break
+			}
+		}
+		if next < 0 {
+			break
+		}
+
+		job := q.pending[next]
+		q.pending = append(q.pending[:next], q.pending[next+1:]...)
+		q.running++
+		q.active[job.userID]++
+		if q.metrics != nil {
+			q.metrics.ObserveExportQueueWait(time.Since(job.queuedAt))
+		}
+
+		go q.work(job)
+	}
+
+	q.reportDepthLocked()
+}
+
+func (q *exportQueue) reportDepthLocked() {
+	if q.metrics != nil {
+		q.metrics.SetExportQueueDepth(len(q.pending))
+	}
+}
+
+// work processes one export, then frees its worker for the next
+func (q *exportQueue) work(job queuedExport) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("Export %d panicked: %v", job.exportID, r)
+		}
+
+		q.mu.Lock()
+		defer q.mu.Unlock()
+		q.running--
+		q.active[job.userID]--
+		if q.active[job.userID] <= 0 {
+			delete(q.active, job.userID)
+		}
+		q.dispatchLocked()
+	}()
+
+	q.run(job)
+}
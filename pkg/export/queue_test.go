@@ -0,0 +1,217 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent/export"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// blockingRunner records started exports and holds each one until released
+type blockingRunner struct {
+	mu       sync.Mutex
+	release  map[int]chan struct{}
+	startedC chan int
+}
+
+func newBlockingRunner() *blockingRunner {
+	return &blockingRunner{
+		release:  make(map[int]chan struct{}),
+		startedC: make(chan int, 100),
+	}
+}
+
+func (r *blockingRunner) done(exportID int) chan struct{} {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.release[exportID]; !ok {
+		r.release[exportID] = make(chan struct{})
+	}
+	return r.release[exportID]
+}
+
+func (r *blockingRunner) run(job queuedExport) {
+	r.startedC <- job.exportID
+	<-r.done(job.exportID)
+}
+
+func (r *blockingRunner) finish(exportIDs ...int) {
+	for _, id := range exportIDs {
+		close(r.done(id))
+	}
+}
+
+func (r *blockingRunner) waitStarted(t *testing.T) int {
+	select {
+	case id := <-r.startedC:
+		return id
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for an export to start")
+		return 0
+	}
+}
+
+type recordingMetrics struct {
+	mu    sync.Mutex
+	depth int
+	waits int
+}
+
+func (m *recordingMetrics) SetExportQueueDepth(depth int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.depth = depth
+}
+
+func (m *recordingMetrics) ObserveExportQueueWait(time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.waits++
+}
+
+func TestExportQueue(t *testing.T) {
+	t.Run("Per-user limit queues excess exports", func(t *testing.T) {
+		runner := newBlockingRunner()
+		metrics := &recordingMetrics{}
+		q := newExportQueue(QueueConfig{Workers: 4}, metrics, runner.run)
+
+		position, err := q.enqueue(queuedExport{exportID: 1, userID: 10, limit: 1})
+		require.NoError(t, err)
+		assert.Equal(t, 0, position)
+		assert.Equal(t, 1, runner.waitStarted(t))
+
+		position, err = q.enqueue(queuedExport{exportID: 2, userID: 10, limit: 1})
+		require.NoError(t, err)
+		assert.Equal(t, 1, position)
+
+		// Another user is not held up by the first user's queue
+		position, err = q.enqueue(queuedExport{exportID: 3, userID: 20, limit: 1})
+		require.NoError(t, err)
+		assert.Equal(t, 0, position)
+		assert.Equal(t, 3, runner.waitStarted(t))
+		assert.Equal(t, 1, q.position(2))
+
+		metrics.mu.Lock()
+		assert.Equal(t, 1, metrics.depth)
+		assert.Equal(t, 2, metrics.waits)
+		metrics.mu.Unlock()
+
+		// Finishing the first export starts the queued one
+		runner.finish(1)
+		assert.Equal(t, 2, runner.waitStarted(t))
+		assert.Equal(t, 0, q.position(2))
+		runner.finish(2, 3)
+	})
+
+	t.Run("Worker pool bounds total concurrency", func(t *testing.T) {
+		runner := newBlockingRunner()
+		q := newExportQueue(QueueConfig{Workers: 2}, nil, runner.run)
+
+		for id := 1; id <= 3; id++ {
+			_, err := q.enqueue(queuedExport{exportID: id, userID: id, limit: 1})
+			require.NoError(t, err)
+		}
+		runner.waitStarted(t)
+		runner.waitStarted(t)
+		assert.Equal(t, 1, q.position(3))
+
+		runner.finish(1)
+		assert.Equal(t, 3, runner.waitStarted(t))
+		runner.finish(2, 3)
+	})
+
+	t.Run("Full queue rejects exports", func(t *testing.T) {
+		runner := newBlockingRunner()
+		q := newExportQueue(QueueConfig{Workers: 1, MaxQueued: 5, MaxQueuedPerUser: 1}, nil, runner.run)
+
+		_, err := q.enqueue(queuedExport{exportID: 1, userID: 10})
+		require.NoError(t, err)
+		runner.waitStarted(t)
+
+		_, err = q.enqueue(queuedExport{exportID: 2, userID: 10})
+		require.NoError(t, err)
+
+		_, err = q.enqueue(queuedExport{exportID: 3, userID: 10})
+		assert.True(t, errors.Is(err, ErrExportQueueFull))
+		assert.Equal(t, 0, q.position(3))
+
+		runner.finish(1)
+		runner.waitStarted(t)
+		runner.finish(2)
+	})
+}
+
+func TestService_FailInterrupted(t *testing.T) {
+	client, service, ready := setupDeliveryTest(t, "")
+	ctx := context.Background()
+
+	var interrupted []int
+	for _, status := range []export.Status{export.StatusPending, export.StatusProcessing} {
+		exp, err := client.Export.Create().
+			SetUserID(ready.UserID).
+			SetFormat(export.FormatCsv).
+			SetLeadCount(0).
+			SetStatus(status).
+			Save(ctx)
+		require.NoError(t, err)
+		interrupted = append(interrupted, exp.ID)
+	}
+
+	n, err := service.FailInterrupted(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	for _, id := range interrupted {
+		exp, err := client.Export.Get(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, export.StatusFailed, exp.Status)
+		assert.Contains(t, exp.ErrorMessage, "interrupted by server restart")
+	}
+
+	exp, err := client.Export.Get(ctx, ready.ID)
+	require.NoError(t, err)
+	assert.Equal(t, export.StatusReady, exp.Status)
+}
+
+// recordingTrigger records the export events sent to webhooks
+type recordingTrigger struct {
+	personal     []string
+	organization []string
+}
+
+func (r *recordingTrigger) TriggerWebhooks(ctx context.Context, userID int, event string, data map[string]interface{}) {
+	r.personal = append(r.personal, event)
+}
+
+func (r *recordingTrigger) TriggerOrganizationWebhooks(ctx context.Context, userID int, event string, data map[string]interface{}) {
+	r.organization = append(r.organization, event)
+}
+
+func TestService_FailExportTriggersWebhooks(t *testing.T) {
+	client, service, ready := setupDeliveryTest(t, "")
+	ctx := context.Background()
+	trigger := &recordingTrigger{}
+	service.SetWebhookTrigger(trigger)
+
+	exp, err := client.Export.Create().
+		SetUserID(ready.UserID).
+		SetFormat(export.FormatCsv).
+		SetLeadCount(0).
+		SetStatus(export.StatusProcessing).
+		Save(ctx)
+	require.NoError(t, err)
+
+	service.failExport(ctx, exp.ID, ready.UserID, errors.New("disk full"))
+
+	exp, err = client.Export.Get(ctx, exp.ID)
+	require.NoError(t, err)
+	assert.Equal(t, export.StatusFailed, exp.Status)
+	assert.Equal(t, "disk full", exp.ErrorMessage)
+	assert.Equal(t, []string{"export.failed"}, trigger.personal)
+	assert.Equal(t, []string{"export.failed"}, trigger.organization)
+}
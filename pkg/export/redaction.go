@@ -0,0 +1,231 @@
+package export
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jordanlanch/industrydb/pkg/models"
+)
+
+// ErrInvalidRedactionProfile is returned when an export names an unknown
+// redaction profile, or a configured profile is malformed
+var ErrInvalidRedactionProfile = errors.New("invalid redaction profile")
+
+// Redaction actions
+const (
+	RedactNull = "null" // Blank the field
+	RedactHash = "hash" // Replace the field with its keyed hash
+)
+
+// RedactionProfile maps lead fields to the redaction applied to them in an
+// export. Unlike column selection, a profile records what a recipient is
+// allowed to see: the profile name is stored on the export and audited.
+// Fields it doesn't list are exported as is.
+type RedactionProfile map[string]string
+
+// redactableText are the text fields a profile can null or hash
+var redactableText = map[string]func(*models.LeadResponse) *string{
+	"name":        func(l *models.LeadResponse) *string { return &l.Name },
+	"address":     func(l *models.LeadResponse) *string { return &l.Address },
+	"postal_code": func(l *models.LeadResponse) *string { return &l.PostalCode },
+	"city":        func(l *models.LeadResponse) *string { return &l.City },
+	"phone":       func(l *models.LeadResponse) *string { return &l.Phone },
+	"email":       func(l *models.LeadResponse) *string { return &l.Email },
+	"website":     func(l *models.LeadResponse) *string { return &l.Website },
+	"source_id":   func(l *models.LeadResponse) *string { return &l.SourceID },
+}
+
+// redactableOther are the fields a profile can only null
+var redactableOther = map[string]func(*models.LeadResponse){
+	"latitude":     func(l *models.LeadResponse) { l.Latitude = 0 },
+	"longitude":    func(l *models.LeadResponse) { l.Longitude = 0 },
+	"social_media": func(l *models.LeadResponse) { l.SocialMedia = nil },
+}
+
+// DefaultRedactionProfiles returns the built-in profiles: internal exports
+// everything, partner keeps the business name and location to city level and
+// hashes email and phone so partners can still match leads across exports.
+func DefaultRedactionProfiles() map[string]RedactionProfile {
+	return map[string]RedactionProfile{
+		"internal": {},
+		"partner": {
+			"email":        RedactHash,
+			"phone":        RedactHash,
+			"address":      RedactNull,
+			"postal_code":  RedactNull,
+			"website":      RedactNull,
+			"latitude":     RedactNull,
+			"longitude":    RedactNull,
+			"social_media": RedactNull,
+		},
+	}
+}
+
+// redactionProfiles holds the profiles exports can select
+var redactionProfiles = DefaultRedactionProfiles()
+
+// redactionHashKey keys the hashes of hashed fields
+var redactionHashKey []byte
+
+// SetRedactionProfiles adds profiles to the built-in ones, replacing those
+// with the same name. It is meant to be called once at startup from
+// configuration.
+func SetRedactionProfiles(profiles map[string]RedactionProfile) {
+	merged := DefaultRedactionProfiles()
+	for name, profile := range profiles {
+		merged[name] = profile
+	}
+	redactionProfiles = merged
+}
+
+// SetRedactionHashKey sets the key of hashed fields. Hashes only match
+// across exports made with the same key, so it must stay stable.
+func SetRedactionHashKey(key string) {
+	redactionHashKey = []byte(key)
+}
+
+// RedactionProfileNames returns the names exports can select, sorted
+func RedactionProfileNames() []string {
+	names := make([]string, 0, len(redactionProfiles))
+	for name := range redactionProfiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ValidateRedactionProfile checks that name selects a profile. An empty name
+// selects none.
+func ValidateRedactionProfile(name string) error {
+	if name == "" {
+		return nil
+	}
+	if _, ok := redactionProfiles[name]; !ok {
+		return fmt.Errorf("%w: %q (available: %s)", ErrInvalidRedactionProfile, name, strings.Join(RedactionProfileNames(), ", "))
+	}
+	return nil
+}
+
+// ParseRedactionProfiles parses profiles configured as
+// "name=field:action,field:action;name=...", e.g.
+// "vendor=email:null,phone:hash". Fields are export column keys, actions
+// null or hash; only text fields can be hashed.
+func ParseRedactionProfiles(spec string) (map[string]RedactionProfile, error) {
+	profiles := make(map[string]RedactionProfile)
+	for _, entry := range strings.Split(spec, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		name, rules, ok := strings.Cut(entry, "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("%w: %q must be name=field:action,...", ErrInvalidRedactionProfile, entry)
+		}
+
+		profile := RedactionProfile{}
+		for _, rule := range strings.Split(rules, ",") {
+			field, action, _ := strings.Cut(strings.TrimSpace(rule), ":")
+			if err := validateRedaction(field, action); err != nil {
+				return nil, fmt.Errorf("profile %q: %w", name, err)
+			}
+			profile[field] = action
+		}
+		profiles[name] = profile
+	}
+	return profiles, nil
+}
+
+// validateRedaction checks that action applies to field
+func validateRedaction(field, action string) error {
+	_, isText := redactableText[field]
+	_, isOther := redactableOther[field]
+	switch {
+	case !isText && !isOther:
+		return fmt.Errorf("%w: field %q cannot be redacted", ErrInvalidRedactionProfile, field)
+	case action == RedactNull:
+		return nil
+	case action == RedactHash && isText:
+		return nil
+	case action == RedactHash:
+		return fmt.Errorf("%w: field %q can only be nulled", ErrInvalidRedactionProfile, field)
+	default:
+		return fmt.Errorf("%w: unknown action %q for field %q", ErrInvalidRedactionProfile, action, field)
+	}
+}
+
+// redactLeads applies the named profile to leads in place. An empty or
+// unknown name leaves them as is; names are validated when the export is
+// created.
+func redactLeads(name string, leads []models.LeadResponse) {
+	profile := redactionProfiles[name]
+	if len(profile) == 0 {
+		return
+	}
+
+	for i := range leads {
+		for field, action := range profile {
+			if text, ok := redactableText[field]; ok {
+				value := text(&leads[i])
+				if action == RedactHash {
+					*value = hashValue(*value)
+				} else {
+					*value = ""
+				}
+				continue
+			}
+			if clear, ok := redactableOther[field]; ok {
+				clear(&leads[i])
+			}
+		}
+	}
+}
+
+// hashValue returns the keyed hash of a value, normalized so the same lead
+// hashes alike in every export. Empty values stay empty so missing fields
+// don't all hash to one value.
+func hashValue(value string) string {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		return ""
+	}
+	mac := hmac.New(sha256.New, redactionHashKey)
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// RedactLeadFields applies the named profile to lead fields keyed by export
+// column key, in place. Used for lead data sent outside exports, like the
+// changed fields of lead.updated webhooks. Nulled fields become nil.
+func RedactLeadFields(name string, fields map[string]interface{}) {
+	for field, action := range redactionProfiles[name] {
+		value, ok := fields[field]
+		if !ok || value == nil {
+			continue
+		}
+		if text, isText := value.(string); isText && action == RedactHash {
+			fields[field] = hashValue(text)
+		} else {
+			fields[field] = nil
+		}
+	}
+}
+
+// LeadFieldRedactor applies the export redaction profiles to lead data in
+// webhook payloads (see webhook.Redactor)
+type LeadFieldRedactor struct{}
+
+// RedactionProfileNames returns the names of the redaction profiles
+func (LeadFieldRedactor) RedactionProfileNames() []string {
+	return RedactionProfileNames()
+}
+
+// RedactLeadFields applies the named profile to lead fields in place
+func (LeadFieldRedactor) RedactLeadFields(name string, fields map[string]interface{}) {
+	RedactLeadFields(name, fields)
+}
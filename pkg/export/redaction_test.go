@@ -0,0 +1,140 @@
+package export
+
+import (
+	"testing"
+
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRedactionProfiles(t *testing.T) {
+	profiles, err := ParseRedactionProfiles(" vendor=email:null, phone:hash ; press=latitude:null,longitude:null;")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]RedactionProfile{
+		"vendor": {"email": RedactNull, "phone": RedactHash},
+		"press":  {"latitude": RedactNull, "longitude": RedactNull},
+	}, profiles)
+
+	profiles, err = ParseRedactionProfiles("")
+	require.NoError(t, err)
+	assert.Empty(t, profiles)
+
+	for _, spec := range []string{
+		"vendor",                // no rules
+		"=email:null",           // no name
+		"vendor=ssn:null",       // unknown field
+		"vendor=email:encrypt",  // unknown action
+		"vendor=latitude:hash",  // numbers can't be hashed
+		"vendor=email:null,bad", // rule without action
+	} {
+		_, err := ParseRedactionProfiles(spec)
+		assert.ErrorIs(t, err, ErrInvalidRedactionProfile, spec)
+	}
+}
+
+func TestRedactionProfiles(t *testing.T) {
+	defer SetRedactionProfiles(nil)
+
+	assert.Equal(t, []string{"internal", "partner"}, RedactionProfileNames())
+	assert.NoError(t, ValidateRedactionProfile(""))
+	assert.NoError(t, ValidateRedactionProfile("partner"))
+	assert.ErrorIs(t, ValidateRedactionProfile("vendor"), ErrInvalidRedactionProfile)
+
+	// Configured profiles add to the built-in ones and can replace them
+	SetRedactionProfiles(map[string]RedactionProfile{
+		"vendor":  {"email": RedactNull},
+		"partner": {"phone": RedactNull},
+	})
+	assert.Equal(t, []string{"internal", "partner", "vendor"}, RedactionProfileNames())
+	assert.Equal(t, RedactionProfile{"phone": RedactNull}, redactionProfiles["partner"])
+}
+
+func TestRedactLeads(t *testing.T) {
+	defer SetRedactionHashKey("")
+	SetRedactionHashKey("test-key")
+
+	newLeads := func() []models.LeadResponse {
+		return []models.LeadResponse{
+			{
+				ID:          1,
+				Name:        "Ink Studio",
+				City:        "Austin",
+				Address:     "1 Congress Ave",
+				Phone:       "+1 512 555 0100",
+				Email:       "Hello@Ink.example",
+				Website:     "https://ink.example",
+				Latitude:    30.26,
+				Longitude:   -97.74,
+				SocialMedia: map[string]string{"instagram": "https://instagram.com/ink"},
+			},
+			{ID: 2, Name: "No Contact", City: "Dallas"},
+		}
+	}
+
+	t.Run("partner", func(t *testing.T) {
+		leads := newLeads()
+		redactLeads("partner", leads)
+
+		l := leads[0]
+		assert.Equal(t, "Ink Studio", l.Name)
+		assert.Equal(t, "Austin", l.City)
+		assert.Empty(t, l.Address)
+		assert.Empty(t, l.Website)
+		assert.Zero(t, l.Latitude)
+		assert.Zero(t, l.Longitude)
+		assert.Nil(t, l.SocialMedia)
+		assert.Len(t, l.Email, 64)
+		assert.Equal(t, hashValue("hello@ink.example"), l.Email)
+		assert.Equal(t, hashValue("+1 512 555 0100"), l.Phone)
+
+		// Missing contacts stay empty rather than sharing a hash
+		assert.Empty(t, leads[1].Email)
+		assert.Empty(t, leads[1].Phone)
+	})
+
+	t.Run("hashes are deterministic and keyed", func(t *testing.T) {
+		first, second := newLeads(), newLeads()
+		redactLeads("partner", first)
+		redactLeads("partner", second)
+		assert.Equal(t, first[0].Email, second[0].Email)
+
+		// Case and surrounding spaces don't change the hash
+		assert.Equal(t, hashValue("hello@ink.example"), hashValue(" HELLO@ink.example "))
+
+		SetRedactionHashKey("other-key")
+		assert.NotEqual(t, first[0].Email, hashValue("hello@ink.example"))
+	})
+
+	t.Run("internal and no profile", func(t *testing.T) {
+		for _, name := range []string{"internal", ""} {
+			leads := newLeads()
+			redactLeads(name, leads)
+			assert.Equal(t, newLeads(), leads)
+		}
+	})
+}
+
+func TestRedactLeadFields(t *testing.T) {
+	defer SetRedactionHashKey("")
+	SetRedactionHashKey("test-key")
+
+	fields := map[string]interface{}{
+		"name":      "Ink Studio",
+		"email":     "Hello@Ink.example",
+		"phone":     nil,
+		"address":   "1 Congress Ave",
+		"latitude":  30.26,
+		"employees": 12,
+	}
+	RedactLeadFields("partner", fields)
+
+	assert.Equal(t, map[string]interface{}{
+		"name":      "Ink Studio",
+		"email":     hashValue("hello@ink.example"),
+		"phone":     nil,
+		"address":   nil,
+		"latitude":  nil,
+		"employees": 12,
+	}, fields)
+}
@@ -4,27 +4,55 @@ import (
 	"context"
 	"encoding/csv"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"os"
 	"path/filepath"
-	"strconv"
 	"time"
 
 	"github.com/jordanlanch/industrydb/ent"
 	"github.com/jordanlanch/industrydb/ent/export"
+	"github.com/jordanlanch/industrydb/ent/lead"
 	"github.com/jordanlanch/industrydb/ent/usagelog"
 	"github.com/jordanlanch/industrydb/pkg/analytics"
 	"github.com/jordanlanch/industrydb/pkg/leads"
 	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/jordanlanch/industrydb/pkg/webhook"
 	"github.com/xuri/excelize/v2"
 )
 
+// ErrExportRowCapExceeded is returned when an export asks for more rows than
+// the caller's tier allows in a single export
+var ErrExportRowCapExceeded = errors.New("export row cap exceeded")
+
 // Service handles export business logic
 type Service struct {
 	db               *ent.Client
 	leadService      *leads.Service
 	analyticsService *analytics.Service
 	storagePath      string
+	httpClient       *http.Client   // Used for file delivery
+	deliveryRetries  int            // Retries after the first delivery attempt
+	deliveryBackoff  time.Duration  // Base of the exponential delivery backoff
+	queue            *exportQueue   // Bounded workers for async processing
+	sheets           SheetsWriter   // Google Sheets delivery, nil when not configured
+	counters         Counters       // Created and downloaded export counters, nil records none
+	webhooks         WebhookTrigger // export.completed and export.failed events, nil sends none
+}
+
+// Counters receives export creation and download counts. It is satisfied
+// by *metrics.Metrics.
+type Counters interface {
+	RecordExportCreated()
+	RecordExportDownloaded()
+}
+
+// WebhookTrigger delivers an event to a user's webhooks and to the webhooks
+// of their organizations. It is satisfied by *webhook.Service.
+type WebhookTrigger interface {
+	TriggerWebhooks(ctx context.Context, userID int, event string, data map[string]interface{})
+	TriggerOrganizationWebhooks(ctx context.Context, userID int, event string, data map[string]interface{})
 }
 
 // NewService creates a new export service
@@ -32,30 +60,108 @@ func NewService(db *ent.Client, leadService *leads.Service, analyticsService *an
 	// Ensure storage directory exists
 	os.MkdirAll(storagePath, 0755)
 
-	return &Service{
+	s := &Service{
 		db:               db,
 		leadService:      leadService,
 		analyticsService: analyticsService,
 		storagePath:      storagePath,
+		httpClient:       newDeliveryClient(),
+		deliveryRetries:  defaultDeliveryRetries,
+		deliveryBackoff:  defaultDeliveryBackoff,
 	}
+	s.queue = newExportQueue(QueueConfig{}, nil, s.runQueued)
+	return s
+}
+
+// ConfigureQueue replaces the export queue limits and metrics. It is meant
+// to be called once at startup, before any export is created.
+func (s *Service) ConfigureQueue(config QueueConfig, metrics QueueMetrics) {
+	s.queue = newExportQueue(config, metrics, s.runQueued)
+}
+
+// SetCounters sets where export creations and downloads are counted
+func (s *Service) SetCounters(counters Counters) {
+	s.counters = counters
+}
+
+// SetWebhookTrigger sets where export.completed and export.failed webhook
+// events are sent. Without a trigger, no export webhooks are sent.
+func (s *Service) SetWebhookTrigger(w WebhookTrigger) {
+	s.webhooks = w
+}
+
+// triggerWebhooks sends an export event to the exporting user's webhooks
+// and to their organizations' webhooks
+func (s *Service) triggerWebhooks(ctx context.Context, userID int, event string, data map[string]interface{}) {
+	if s.webhooks == nil {
+		return
+	}
+	s.webhooks.TriggerWebhooks(ctx, userID, event, data)
+	s.webhooks.TriggerOrganizationWebhooks(ctx, userID, event, data)
+}
+
+// failExport marks an export failed and sends export.failed webhooks
+func (s *Service) failExport(ctx context.Context, exportID, userID int, err error) {
+	exp := s.db.Export.UpdateOneID(exportID).
+		SetStatus(export.StatusFailed).
+		SetErrorMessage(err.Error()).
+		SaveX(ctx)
+
+	s.triggerWebhooks(ctx, userID, webhook.EventExportFailed, map[string]interface{}{
+		"export_id": exp.ID,
+		"user_id":   userID,
+		"format":    string(exp.Format),
+		"error":     exp.ErrorMessage,
+	})
 }
 
 // CreateExport creates a new export with the given filters
 // organizationID is optional - pass nil for personal exports
 func (s *Service) CreateExport(ctx context.Context, userID int, organizationID *int, req models.ExportRequest) (*models.ExportResponse, error) {
 	// Validate format
-	if req.Format != "csv" && req.Format != "excel" {
-		return nil, fmt.Errorf("invalid format: must be csv or excel")
+	switch req.Format {
+	case "csv", "excel", "vcard", "geojson", "kml":
+	default:
+		return nil, fmt.Errorf("invalid format: must be csv, excel, vcard, geojson or kml")
+	}
+	if err := ValidateCompression(req.Compress); err != nil {
+		return nil, err
 	}
 
-	// Set max leads if not specified
-	if req.MaxLeads == 0 {
-		req.MaxLeads = 1000
+	// Validate selected columns
+	if _, err := resolveColumns(req.Columns); err != nil {
+		return nil, err
+	}
+	if err := ValidateRedactionProfile(req.RedactionProfile); err != nil {
+		return nil, err
 	}
-	if req.MaxLeads > 10000 {
-		req.MaxLeads = 10000
+
+	// Validate delivery
+	if req.DeliveryURL != "" {
+		if err := ValidateDeliveryURL(ctx, req.DeliveryURL); err != nil {
+			return nil, err
+		}
+	}
+	if req.Delivery == DeliveryGoogleSheets {
+		if err := s.checkSheetsDelivery(ctx, userID, req); err != nil {
+			return nil, err
+		}
 	}
 
+	// Enforce the tier's per-export row cap (0 means unlimited)
+	tier, err := s.leadService.GetExportTier(ctx, userID, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	rowCap := leads.GetExportRowCapForTier(tier)
+	if rowCap > 0 && req.MaxLeads > rowCap {
+		return nil, fmt.Errorf("%w: the %s plan allows up to %d rows per export, upgrade to export more", ErrExportRowCapExceeded, tier, rowCap)
+	}
+
+	req.MaxLeads = exportRowLimit(req.MaxLeads, rowCap)
+	// Verified-only tiers export verified leads unless the filter is set
+	leads.ApplyVerifiedDefault(&req.Filters, tier)
+
 	// Convert filters to map
 	filtersMap := make(map[string]interface{})
 	filtersBytes, err := json.Marshal(req.Filters)
@@ -66,6 +172,16 @@ func (s *Service) CreateExport(ctx context.Context, userID int, organizationID *
 		return nil, fmt.Errorf("failed to unmarshal filters: %w", err)
 	}
 
+	// Resolve the delta lower bound
+	since := req.Since
+	if since == nil && req.SinceLastExport {
+		since, req.SinceLeadID, err = s.lastHighWaterMark(ctx, userID, organizationID, req.Filters)
+		if err != nil {
+			return nil, err
+		}
+	}
+	req.Since = since
+
 	// Create export record
 	creator := s.db.Export.Create().
 		SetUserID(userID).
@@ -79,6 +195,41 @@ func (s *Service) CreateExport(ctx context.Context, userID int, organizationID *
 	if organizationID != nil {
 		creator = creator.SetOrganizationID(*organizationID)
 	}
+	if since != nil {
+		creator = creator.SetSince(*since)
+	}
+	if req.RedactionProfile != "" {
+		creator = creator.SetRedactionProfile(req.RedactionProfile)
+	}
+	if req.Compress != "" {
+		creator = creator.SetCompression(export.Compression(req.Compress))
+	}
+	// Export what the organization may see when lead scoping is on
+	req.Filters.OrgScope = organizationID
+
+	// Deliveries are signed with a per-export secret, returned once below,
+	// unless the request brings its own
+	var deliverySecret string
+	if req.DeliveryURL != "" {
+		deliverySecret = req.DeliverySecret
+		if deliverySecret == "" {
+			deliverySecret, err = webhook.GenerateSecret()
+			if err != nil {
+				return nil, fmt.Errorf("failed to generate delivery secret: %w", err)
+			}
+		}
+		creator = creator.
+			SetDeliveryMethod(export.DeliveryMethodURL).
+			SetDeliveryURL(req.DeliveryURL).
+			SetDeliverySecret(deliverySecret).
+			SetDeliveryStatus(export.DeliveryStatusPending)
+	}
+	if req.Delivery == DeliveryGoogleSheets {
+		creator = creator.
+			SetDeliveryMethod(export.DeliveryMethodGoogleSheets).
+			SetSpreadsheetID(req.SpreadsheetID).
+			SetDeliveryStatus(export.DeliveryStatusPending)
+	}
 
 	exp, err := creator.Save(ctx)
 
@@ -86,10 +237,57 @@ func (s *Service) CreateExport(ctx context.Context, userID int, organizationID *
 		return nil, fmt.Errorf("failed to create export: %w", err)
 	}
 
-	// Process export asynchronously
-	go s.processExport(exp.ID, userID, req)
+	// Queue for processing, at most the tier's concurrent exports per user
+	position, err := s.queue.enqueue(queuedExport{
+		exportID: exp.ID,
+		userID:   userID,
+		limit:    leads.GetExportConcurrencyForTier(tier),
+		req:      req,
+	})
+	if err != nil {
+		s.db.Export.DeleteOneID(exp.ID).Exec(ctx)
+		return nil, err
+	}
 
-	return s.toExportResponse(exp), nil
+	if s.counters != nil {
+		s.counters.RecordExportCreated()
+	}
+
+	response := s.toExportResponse(exp)
+	response.DeliverySecret = deliverySecret
+	response.QueuePosition = position
+	return response, nil
+}
+
+// exportRowLimit returns the most rows an export may contain: maxLeads, or
+// 1000 lowered to the tier's row cap when unset, never more than 10000
+func exportRowLimit(maxLeads, rowCap int) int {
+	if maxLeads == 0 {
+		maxLeads = 1000
+		if rowCap > 0 && rowCap < maxLeads {
+			maxLeads = rowCap
+		}
+	}
+	if maxLeads > 10000 {
+		maxLeads = 10000
+	}
+	return maxLeads
+}
+
+// FailInterrupted marks exports left pending or processing by a previous
+// process as failed. The export queue is in memory, so queued and running
+// exports cannot survive a restart.
+func (s *Service) FailInterrupted(ctx context.Context) (int, error) {
+	return s.db.Export.Update().
+		Where(export.StatusIn(export.StatusPending, export.StatusProcessing)).
+		SetStatus(export.StatusFailed).
+		SetErrorMessage("interrupted by server restart, please export again").
+		Save(ctx)
+}
+
+// runQueued processes an export once the queue hands it a worker
+func (s *Service) runQueued(job queuedExport) {
+	s.processExport(job.exportID, job.userID, job.req)
 }
 
 // processExport processes the export in the background
@@ -104,61 +302,222 @@ func (s *Service) processExport(exportID, userID int, req models.ExportRequest)
 	// Get leads with filters
 	req.Filters.Limit = req.MaxLeads
 	req.Filters.Page = 1
+	if req.Since != nil {
+		// Delta export: oldest changes first so a capped export can be
+		// continued from its high-water mark
+		req.Filters.UpdatedSince = req.Since
+		req.Filters.UpdatedSinceID = req.SinceLeadID
+		req.Filters.SortBy = "updated_at"
+	}
+
+	// Leads the user or their organizations suppressed
+	suppressed, err := s.leadService.SuppressedLeadIDs(ctx, userID)
+	if err != nil {
+		s.failExport(ctx, exportID, userID, err)
+		return
+	}
+	if req.Suppressed != SuppressedAnnotate {
+		req.Filters.ExcludeLeadIDs = suppressed
+	}
 
 	results, err := s.leadService.Search(ctx, req.Filters)
 	if err != nil {
-		s.db.Export.UpdateOneID(exportID).
-			SetStatus(export.StatusFailed).
-			SetErrorMessage(err.Error()).
-			SaveX(ctx)
+		s.failExport(ctx, exportID, userID, err)
+		return
+	}
+
+	// Honor the organization's contact masking policy, as searches do
+	masking, err := s.leadService.ContactMaskingFor(ctx, userID, req.Filters.OrgScope)
+	if err == nil {
+		err = s.leadService.MaskContacts(ctx, masking, results.Data)
+	}
+	if err != nil {
+		s.failExport(ctx, exportID, userID, err)
 		return
 	}
 
+	// Null or hash the fields the export's recipient may not see
+	redactLeads(req.RedactionProfile, results.Data)
+
+	// Columns were validated when the export was created
+	columns, _ := resolveColumns(req.Columns)
+	if req.Suppressed == SuppressedAnnotate {
+		columns = withSuppressedColumn(columns, suppressed)
+	}
+
 	// Generate filename
 	timestamp := time.Now().Format("20060102-150405")
-	filename := fmt.Sprintf("export-%d-%s.%s", exportID, timestamp, req.Format)
+	filename := fmt.Sprintf("export-%d-%s.%s", exportID, timestamp, fileExtension(req.Format))
 	filepath := filepath.Join(s.storagePath, filename)
 
 	// Generate file based on format
+	// Map formats leave out leads without coordinates
 	var genErr error
-	if req.Format == "csv" {
-		genErr = s.generateCSV(filepath, results.Data)
-	} else {
-		genErr = s.generateExcel(filepath, results.Data)
+	skipped := 0
+	switch req.Format {
+	case "csv":
+		genErr = s.generateCSV(filepath, columns, results.Data)
+	case "vcard":
+		genErr = s.generateVCard(filepath, columns, results.Data)
+	case "geojson":
+		skipped, genErr = s.generateGeoJSON(filepath, columns, results.Data)
+	case "kml":
+		skipped, genErr = s.generateKML(filepath, columns, results.Data)
+	default:
+		genErr = s.generateExcel(filepath, columns, results.Data)
+	}
+	leadCount := len(results.Data) - skipped
+
+	// Replace the file with its compressed artifact, if requested
+	if genErr == nil {
+		filepath, genErr = compressFile(filepath, req.Compress)
 	}
 
 	if genErr != nil {
-		s.db.Export.UpdateOneID(exportID).
-			SetStatus(export.StatusFailed).
-			SetErrorMessage(genErr.Error()).
-			SaveX(ctx)
+		s.failExport(ctx, exportID, userID, genErr)
 		return
 	}
 
 	// Update export record
-	s.db.Export.UpdateOneID(exportID).
+	update := s.db.Export.UpdateOneID(exportID).
 		SetStatus(export.StatusReady).
-		SetLeadCount(len(results.Data)).
+		SetLeadCount(leadCount).
+		SetSkippedCount(skipped).
 		SetFilePath(filepath).
-		SetFileURL(fmt.Sprintf("/api/v1/exports/%d/download", exportID)).
-		SaveX(ctx)
+		SetFileURL(fmt.Sprintf("/api/v1/exports/%d/download", exportID))
+	if info, err := os.Stat(filepath); err == nil {
+		update = update.SetFileSize(info.Size())
+	}
+	mark, markLeadID, err := s.highWaterMark(ctx, req.Since, req.SinceLeadID, results)
+	if err != nil {
+		fmt.Printf("Failed to compute export high-water mark: %v\n", err)
+	} else if mark != nil {
+		update = update.SetHighWaterMark(*mark).SetNillableHighWaterLeadID(markLeadID)
+	}
+	exp := update.SaveX(ctx)
+	s.triggerWebhooks(ctx, userID, webhook.EventExportCompleted, map[string]interface{}{
+		"export_id":    exp.ID,
+		"user_id":      userID,
+		"lead_count":   exp.LeadCount,
+		"format":       string(exp.Format),
+		"download_url": exp.FileURL,
+	})
+
+	// Push the file to the customer's URL or spreadsheet, if requested
+	if exp.DeliveryURL != "" {
+		s.deliverExport(ctx, exp)
+	}
+	if exp.DeliveryMethod != nil && *exp.DeliveryMethod == export.DeliveryMethodGoogleSheets {
+		s.deliverToSheets(ctx, exp, columns, results.Data)
+	}
 
 	// Log analytics with actual lead count
-	metadata := map[string]interface{}{
+	metadata := analytics.TargetingMetadata(map[string]interface{}{
 		"format":     req.Format,
 		"max_leads":  req.MaxLeads,
 		"filters":    req.Filters,
-		"lead_count": len(results.Data),
+		"lead_count": leadCount,
 		"export_id":  exportID,
-	}
-	if err := s.analyticsService.LogUsage(ctx, userID, usagelog.ActionExport, len(results.Data), metadata); err != nil {
+	}, req.Filters.Industry, req.Filters.Industries, req.Filters.Country, req.Filters.City)
+	if err := s.analyticsService.LogUsage(ctx, userID, usagelog.ActionExport, leadCount, metadata); err != nil {
 		// Log error but don't fail the export
 		fmt.Printf("Failed to log export analytics: %v\n", err)
 	}
 }
 
+// highWaterMark returns the latest lead updated_at covered by an export and
+// the ID of the last lead covered at that instant, or the previous mark when
+// a delta found no changes. Deltas list leads by (updated_at, id), so the
+// next delta resumes after that lead even when a capped export stopped
+// between leads updated at the same instant. Full exports only get a mark
+// when they include every matching lead, otherwise a delta could skip leads.
+func (s *Service) highWaterMark(ctx context.Context, since *time.Time, sinceLeadID *int, results *models.LeadListResponse) (*time.Time, *int, error) {
+	if since == nil && results.Pagination.Total > len(results.Data) {
+		return nil, nil, nil
+	}
+	if len(results.Data) == 0 {
+		return since, sinceLeadID, nil
+	}
+
+	ids := make([]int, len(results.Data))
+	for i, l := range results.Data {
+		ids[i] = l.ID
+	}
+
+	// Read the exact timestamp, responses only carry second precision
+	latest, err := s.db.Lead.Query().
+		Where(lead.IDIn(ids...)).
+		Order(ent.Desc(lead.FieldUpdatedAt), ent.Desc(lead.FieldID)).
+		Select(lead.FieldUpdatedAt).
+		First(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to compute high-water mark: %w", err)
+	}
+	return &latest.UpdatedAt, &latest.ID, nil
+}
+
+// lastHighWaterMark returns the high-water mark and its lead ID of the most
+// recent ready export with the same filters and owner, or nil if there is
+// none (the delta then falls back to a full export). The lead ID is nil for
+// exports made before it was recorded.
+func (s *Service) lastHighWaterMark(ctx context.Context, userID int, organizationID *int, filters models.LeadSearchRequest) (*time.Time, *int, error) {
+	query := s.db.Export.Query().
+		Where(
+			export.UserID(userID),
+			export.StatusEQ(export.StatusReady),
+			export.HighWaterMarkNotNil(),
+		)
+	if organizationID != nil {
+		query = query.Where(export.OrganizationID(*organizationID))
+	} else {
+		query = query.Where(export.OrganizationIDIsNil())
+	}
+
+	exports, err := query.
+		Order(ent.Desc(export.FieldCreatedAt), ent.Desc(export.FieldID)).
+		Limit(50).
+		All(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query previous exports: %w", err)
+	}
+
+	key := deltaFilterKey(filters)
+	for _, exp := range exports {
+		var previous models.LeadSearchRequest
+		filtersBytes, err := json.Marshal(exp.FiltersApplied)
+		if err != nil || json.Unmarshal(filtersBytes, &previous) != nil {
+			continue
+		}
+		if deltaFilterKey(previous) == key {
+			return exp.HighWaterMark, exp.HighWaterLeadID, nil
+		}
+	}
+
+	return nil, nil, nil
+}
+
+// deltaFilterKey identifies a sync by its filters, ignoring pagination,
+// sorting and the delta bound itself
+func deltaFilterKey(filters models.LeadSearchRequest) string {
+	filters.Page = 0
+	filters.Limit = 0
+	filters.SortBy = ""
+	filters.UpdatedSince = nil
+	key, _ := json.Marshal(filters)
+	return string(key)
+}
+
+// fileExtension returns the export file extension for a format; vCard
+// files use .vcf so phones and CRMs recognize them
+func fileExtension(format string) string {
+	if format == "vcard" {
+		return "vcf"
+	}
+	return format
+}
+
 // generateCSV generates a CSV file from leads
-func (s *Service) generateCSV(filepath string, leads []models.LeadResponse) error {
+func (s *Service) generateCSV(filepath string, columns []exportColumn, leads []models.LeadResponse) error {
 	file, err := os.Create(filepath)
 	if err != nil {
 		return fmt.Errorf("failed to create file: %w", err)
@@ -169,35 +528,13 @@ func (s *Service) generateCSV(filepath string, leads []models.LeadResponse) erro
 	defer writer.Flush()
 
 	// Write header
-	header := []string{
-		"ID", "Name", "Industry", "Country", "City", "Address", "Postal Code",
-		"Phone", "Email", "Website", "Latitude", "Longitude", "Verified",
-		"Quality Score", "Created At",
-	}
-	if err := writer.Write(header); err != nil {
+	if err := writer.Write(columnHeaders(columns)); err != nil {
 		return fmt.Errorf("failed to write header: %w", err)
 	}
 
 	// Write data
 	for _, lead := range leads {
-		row := []string{
-			strconv.Itoa(lead.ID),
-			lead.Name,
-			lead.Industry,
-			lead.Country,
-			lead.City,
-			lead.Address,
-			lead.PostalCode,
-			lead.Phone,
-			lead.Email,
-			lead.Website,
-			fmt.Sprintf("%.6f", lead.Latitude),
-			fmt.Sprintf("%.6f", lead.Longitude),
-			strconv.FormatBool(lead.Verified),
-			strconv.Itoa(lead.QualityScore),
-			lead.CreatedAt,
-		}
-		if err := writer.Write(row); err != nil {
+		if err := writer.Write(formatRow(columns, lead)); err != nil {
 			return fmt.Errorf("failed to write row: %w", err)
 		}
 	}
@@ -206,7 +543,7 @@ func (s *Service) generateCSV(filepath string, leads []models.LeadResponse) erro
 }
 
 // generateExcel generates an Excel file from leads
-func (s *Service) generateExcel(filepath string, leads []models.LeadResponse) error {
+func (s *Service) generateExcel(filepath string, columns []exportColumn, leads []models.LeadResponse) error {
 	f := excelize.NewFile()
 	defer f.Close()
 
@@ -226,14 +563,8 @@ func (s *Service) generateExcel(filepath string, leads []models.LeadResponse) er
 	}
 
 	// Write header
-	headers := []string{
-		"ID", "Name", "Industry", "Country", "City", "Address", "Postal Code",
-		"Phone", "Email", "Website", "Latitude", "Longitude", "Verified",
-		"Quality Score", "Created At",
-	}
-
-	for i, header := range headers {
-		cell := fmt.Sprintf("%c1", 'A'+i)
+	for i, header := range columnHeaders(columns) {
+		cell, _ := excelize.CoordinatesToCellName(i+1, 1)
 		f.SetCellValue(sheetName, cell, header)
 		f.SetCellStyle(sheetName, cell, cell, headerStyle)
 	}
@@ -241,26 +572,15 @@ func (s *Service) generateExcel(filepath string, leads []models.LeadResponse) er
 	// Write data
 	for rowIdx, lead := range leads {
 		row := rowIdx + 2 // Start from row 2 (after header)
-		f.SetCellValue(sheetName, fmt.Sprintf("A%d", row), lead.ID)
-		f.SetCellValue(sheetName, fmt.Sprintf("B%d", row), lead.Name)
-		f.SetCellValue(sheetName, fmt.Sprintf("C%d", row), lead.Industry)
-		f.SetCellValue(sheetName, fmt.Sprintf("D%d", row), lead.Country)
-		f.SetCellValue(sheetName, fmt.Sprintf("E%d", row), lead.City)
-		f.SetCellValue(sheetName, fmt.Sprintf("F%d", row), lead.Address)
-		f.SetCellValue(sheetName, fmt.Sprintf("G%d", row), lead.PostalCode)
-		f.SetCellValue(sheetName, fmt.Sprintf("H%d", row), lead.Phone)
-		f.SetCellValue(sheetName, fmt.Sprintf("I%d", row), lead.Email)
-		f.SetCellValue(sheetName, fmt.Sprintf("J%d", row), lead.Website)
-		f.SetCellValue(sheetName, fmt.Sprintf("K%d", row), lead.Latitude)
-		f.SetCellValue(sheetName, fmt.Sprintf("L%d", row), lead.Longitude)
-		f.SetCellValue(sheetName, fmt.Sprintf("M%d", row), lead.Verified)
-		f.SetCellValue(sheetName, fmt.Sprintf("N%d", row), lead.QualityScore)
-		f.SetCellValue(sheetName, fmt.Sprintf("O%d", row), lead.CreatedAt)
+		for i, col := range columns {
+			cell, _ := excelize.CoordinatesToCellName(i+1, row)
+			f.SetCellValue(sheetName, cell, col.Value(lead))
+		}
 	}
 
 	// Auto-fit columns
-	for i := 0; i < len(headers); i++ {
-		col := string(rune('A' + i))
+	for i := range columns {
+		col, _ := excelize.ColumnNumberToName(i + 1)
 		f.SetColWidth(sheetName, col, col, 15)
 	}
 
@@ -382,6 +702,23 @@ func (s *Service) GetFilePath(ctx context.Context, userID, exportID int) (string
 	return exp.FilePath, nil
 }
 
+// RecordDownload counts a download of an export file and records when it
+// happened
+func (s *Service) RecordDownload(ctx context.Context, exportID int) error {
+	err := s.db.Export.UpdateOneID(exportID).
+		AddDownloadCount(1).
+		SetLastDownloadedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to record export download: %w", err)
+	}
+
+	if s.counters != nil {
+		s.counters.RecordExportDownloaded()
+	}
+	return nil
+}
+
 // toExportResponse converts an Ent export to a response model
 func (s *Service) toExportResponse(exp *ent.Export) *models.ExportResponse {
 	response := &models.ExportResponse{
@@ -392,13 +729,52 @@ func (s *Service) toExportResponse(exp *ent.Export) *models.ExportResponse {
 		CreatedAt: exp.CreatedAt.Format(time.RFC3339),
 	}
 
+	response.SkippedCount = exp.SkippedCount
+	response.RedactionProfile = exp.RedactionProfile
+	if exp.Compression != nil {
+		response.Compression = string(*exp.Compression)
+	}
+	response.DownloadCount = exp.DownloadCount
+	if exp.LastDownloadedAt != nil {
+		response.LastDownloadedAt = exp.LastDownloadedAt.Format(time.RFC3339)
+	}
+
 	if exp.FileURL != "" {
 		response.FileURL = exp.FileURL
 	}
 
+	if exp.Status == export.StatusPending {
+		response.QueuePosition = s.queue.position(exp.ID)
+	}
+
 	if !exp.ExpiresAt.IsZero() {
 		response.ExpiresAt = exp.ExpiresAt.Format(time.RFC3339)
 	}
 
+	if exp.Since != nil {
+		response.Since = exp.Since.Format(time.RFC3339)
+	}
+
+	if exp.HighWaterMark != nil {
+		response.HighWaterMark = exp.HighWaterMark.Format(time.RFC3339)
+	}
+
+	if exp.DeliveryURL != "" || exp.DeliveryMethod != nil {
+		if exp.DeliveryMethod != nil {
+			response.DeliveryMethod = string(*exp.DeliveryMethod)
+		}
+		response.DeliveryURL = exp.DeliveryURL
+		response.SheetURL = exp.SheetURL
+		response.DeliveryWarning = exp.DeliveryWarning
+		response.DeliveryAttempts = exp.DeliveryAttempts
+		response.DeliveryError = exp.DeliveryError
+		if exp.DeliveryStatus != nil {
+			response.DeliveryStatus = string(*exp.DeliveryStatus)
+		}
+		if exp.DeliveredAt != nil {
+			response.DeliveredAt = exp.DeliveredAt.Format(time.RFC3339)
+		}
+	}
+
 	return response
 }
@@ -0,0 +1,94 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/export"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/jordanlanch/industrydb/pkg/oauth"
+)
+
+// DeliveryGoogleSheets is the ExportRequest.Delivery value that writes the
+// export to a Google spreadsheet
+const DeliveryGoogleSheets = "google_sheets"
+
+var (
+	// ErrSheetsUnavailable is returned when Google Sheets delivery is not configured
+	ErrSheetsUnavailable = errors.New("Google Sheets delivery is not available")
+	// ErrSheetsNotConnected is returned when the user has not connected a Google account
+	ErrSheetsNotConnected = errors.New("connect a Google account before exporting to Google Sheets")
+	// ErrConflictingDelivery is returned when an export asks for more than one delivery
+	ErrConflictingDelivery = errors.New("delivery_url and google_sheets delivery cannot be combined")
+)
+
+// SheetsWriter writes export rows to a user's Google spreadsheet
+// (implemented by oauth.Service)
+type SheetsWriter interface {
+	HasGoogleSheetsConnection(ctx context.Context, userID int) (bool, error)
+	WriteToSheet(ctx context.Context, userID int, spreadsheetID, title string, header []string, rows [][]string) (*oauth.SheetResult, error)
+}
+
+// SetSheetsWriter enables Google Sheets delivery
+func (s *Service) SetSheetsWriter(writer SheetsWriter) {
+	s.sheets = writer
+}
+
+// checkSheetsDelivery validates a Google Sheets delivery request before the
+// export is queued
+func (s *Service) checkSheetsDelivery(ctx context.Context, userID int, req models.ExportRequest) error {
+	if req.DeliveryURL != "" {
+		return ErrConflictingDelivery
+	}
+	if s.sheets == nil {
+		return ErrSheetsUnavailable
+	}
+	connected, err := s.sheets.HasGoogleSheetsConnection(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !connected {
+		return ErrSheetsNotConnected
+	}
+	return nil
+}
+
+// deliverToSheets writes a completed export to Google Sheets and records the
+// sheet URL on the export. Rows past the Sheets cell limit are dropped and
+// noted in delivery_warning rather than failing the delivery.
+func (s *Service) deliverToSheets(ctx context.Context, exp *ent.Export, columns []exportColumn, leads []models.LeadResponse) {
+	rows := make([][]string, len(leads))
+	for i, lead := range leads {
+		rows[i] = formatRow(columns, lead)
+	}
+
+	title := fmt.Sprintf("IndustryDB export %d (%s)", exp.ID, exp.CreatedAt.Format("2006-01-02"))
+	result, err := s.sheets.WriteToSheet(ctx, exp.UserID, exp.SpreadsheetID, title, columnHeaders(columns), rows)
+	if err != nil {
+		log.Printf("❌ Export %d Google Sheets delivery failed: %v", exp.ID, err)
+		s.recordDeliveryFailure(ctx, exp.ID, 1, err.Error())
+		return
+	}
+
+	update := s.db.Export.UpdateOneID(exp.ID).
+		SetDeliveryStatus(export.DeliveryStatusDelivered).
+		SetDeliveryAttempts(1).
+		SetDeliveredAt(time.Now()).
+		SetSpreadsheetID(result.SpreadsheetID).
+		SetSheetURL(result.URL).
+		ClearDeliveryError()
+	if result.RowsDropped > 0 {
+		update = update.SetDeliveryWarning(fmt.Sprintf(
+			"Google Sheets cell limit (%d cells) reached: wrote %d of %d rows",
+			oauth.SheetsCellLimit, result.RowsWritten, len(rows)))
+	}
+	if _, err := update.Save(ctx); err != nil {
+		log.Printf("⚠️  Failed to record export %d Google Sheets delivery: %v", exp.ID, err)
+		return
+	}
+	log.Printf("✅ Export %d written to Google Sheets (%d rows)", exp.ID, result.RowsWritten)
+}
@@ -0,0 +1,175 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/ent/export"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/jordanlanch/industrydb/pkg/oauth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSheetsWriter records the rows written and returns a canned result
+type fakeSheetsWriter struct {
+	connected bool
+	result    *oauth.SheetResult
+	err       error
+
+	spreadsheetID string
+	header        []string
+	rows          [][]string
+}
+
+func (w *fakeSheetsWriter) HasGoogleSheetsConnection(ctx context.Context, userID int) (bool, error) {
+	return w.connected, nil
+}
+
+func (w *fakeSheetsWriter) WriteToSheet(ctx context.Context, userID int, spreadsheetID, title string, header []string, rows [][]string) (*oauth.SheetResult, error) {
+	w.spreadsheetID = spreadsheetID
+	w.header = header
+	w.rows = rows
+	return w.result, w.err
+}
+
+func TestResolveColumns(t *testing.T) {
+	columns, err := resolveColumns(nil)
+	require.NoError(t, err)
+	assert.Len(t, columns, len(ExportColumnKeys()))
+
+	columns, err = resolveColumns([]string{"email", "name", "email"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Email", "Name"}, columnHeaders(columns))
+
+	_, err = resolveColumns([]string{"name", "password_hash"})
+	assert.True(t, errors.Is(err, ErrInvalidColumn))
+}
+
+func TestGenerateCSV_SelectedColumns(t *testing.T) {
+	service := &Service{}
+	columns, err := resolveColumns([]string{"name", "quality_score", "verified"})
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "export.csv")
+	leads := []models.LeadResponse{{ID: 1, Name: "Ink Studio", QualityScore: 80, Verified: true}}
+	require.NoError(t, service.generateCSV(path, columns, leads))
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "Name,Quality Score,Verified\nInk Studio,80,true\n", string(content))
+}
+
+func TestDeliverToSheets(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:export_sheets?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	ctx := context.Background()
+	u, err := client.User.Create().
+		SetEmail("sheets@example.com").
+		SetPasswordHash("$2a$10$dummyhash").
+		SetName("Sheets User").
+		Save(ctx)
+	require.NoError(t, err)
+
+	newExport := func() int {
+		exp, err := client.Export.Create().
+			SetUserID(u.ID).
+			SetFormat(export.FormatCsv).
+			SetLeadCount(2).
+			SetStatus(export.StatusReady).
+			SetDeliveryMethod(export.DeliveryMethodGoogleSheets).
+			SetSpreadsheetID("sheet-123").
+			SetDeliveryStatus(export.DeliveryStatusPending).
+			Save(ctx)
+		require.NoError(t, err)
+		return exp.ID
+	}
+
+	columns, err := resolveColumns([]string{"name", "email"})
+	require.NoError(t, err)
+	leads := []models.LeadResponse{
+		{ID: 1, Name: "Ink Studio", Email: "ink@example.com"},
+		{ID: 2, Name: "Iron Gym", Email: "iron@example.com"},
+	}
+
+	t.Run("Success - Records sheet URL", func(t *testing.T) {
+		writer := &fakeSheetsWriter{result: &oauth.SheetResult{
+			SpreadsheetID: "sheet-123",
+			URL:           "https://docs.google.com/spreadsheets/d/sheet-123/edit",
+			RowsWritten:   2,
+		}}
+		service := NewService(client, nil, nil, t.TempDir())
+		service.SetSheetsWriter(writer)
+
+		exp := client.Export.GetX(ctx, newExport())
+		service.deliverToSheets(ctx, exp, columns, leads)
+
+		assert.Equal(t, "sheet-123", writer.spreadsheetID)
+		assert.Equal(t, []string{"Name", "Email"}, writer.header)
+		assert.Equal(t, [][]string{{"Ink Studio", "ink@example.com"}, {"Iron Gym", "iron@example.com"}}, writer.rows)
+
+		updated := client.Export.GetX(ctx, exp.ID)
+		require.NotNil(t, updated.DeliveryStatus)
+		assert.Equal(t, export.DeliveryStatusDelivered, *updated.DeliveryStatus)
+		assert.Equal(t, "https://docs.google.com/spreadsheets/d/sheet-123/edit", updated.SheetURL)
+		assert.Empty(t, updated.DeliveryWarning)
+
+		response := service.toExportResponse(updated)
+		assert.Equal(t, DeliveryGoogleSheets, response.DeliveryMethod)
+		assert.Equal(t, updated.SheetURL, response.SheetURL)
+	})
+
+	t.Run("Success - Warns about rows past the cell limit", func(t *testing.T) {
+		writer := &fakeSheetsWriter{result: &oauth.SheetResult{
+			SpreadsheetID: "sheet-123",
+			URL:           "https://docs.google.com/spreadsheets/d/sheet-123/edit",
+			RowsWritten:   1,
+			RowsDropped:   1,
+		}}
+		service := NewService(client, nil, nil, t.TempDir())
+		service.SetSheetsWriter(writer)
+
+		exp := client.Export.GetX(ctx, newExport())
+		service.deliverToSheets(ctx, exp, columns, leads)
+
+		updated := client.Export.GetX(ctx, exp.ID)
+		assert.Equal(t, export.DeliveryStatusDelivered, *updated.DeliveryStatus)
+		assert.Contains(t, updated.DeliveryWarning, "wrote 1 of 2 rows")
+	})
+
+	t.Run("Failure - Records delivery error", func(t *testing.T) {
+		writer := &fakeSheetsWriter{err: oauth.ErrConnectionRevoked}
+		service := NewService(client, nil, nil, t.TempDir())
+		service.SetSheetsWriter(writer)
+
+		exp := client.Export.GetX(ctx, newExport())
+		service.deliverToSheets(ctx, exp, columns, leads)
+
+		updated := client.Export.GetX(ctx, exp.ID)
+		assert.Equal(t, export.DeliveryStatusFailed, *updated.DeliveryStatus)
+		assert.Equal(t, oauth.ErrConnectionRevoked.Error(), updated.DeliveryError)
+		assert.Empty(t, updated.SheetURL)
+	})
+}
+
+func TestCheckSheetsDelivery(t *testing.T) {
+	ctx := context.Background()
+	req := models.ExportRequest{Format: "csv", Delivery: DeliveryGoogleSheets}
+
+	service := &Service{}
+	assert.True(t, errors.Is(service.checkSheetsDelivery(ctx, 1, req), ErrSheetsUnavailable))
+
+	service.SetSheetsWriter(&fakeSheetsWriter{})
+	assert.True(t, errors.Is(service.checkSheetsDelivery(ctx, 1, req), ErrSheetsNotConnected))
+
+	service.SetSheetsWriter(&fakeSheetsWriter{connected: true})
+	assert.NoError(t, service.checkSheetsDelivery(ctx, 1, req))
+
+	req.DeliveryURL = "https://crm.example.com/imports"
+	assert.True(t, errors.Is(service.checkSheetsDelivery(ctx, 1, req), ErrConflictingDelivery))
+}
@@ -0,0 +1,132 @@
+package export
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jordanlanch/industrydb/pkg/models"
+)
+
+// vcardLineLimit is the longest content line vCard 3.0 allows before
+// folding, in octets (RFC 2425 section 5.8.1)
+const vcardLineLimit = 75
+
+// generateVCard writes each lead as a vCard 3.0 entry. Only the contact
+// columns among the selected ones are mapped: name (ORG), phone (TEL),
+// email (EMAIL), website (URL), address, city, postal_code and country
+// (ADR), industry (CATEGORIES), latitude and longitude (GEO) and id (UID).
+// FN and N are required by vCard 3.0, so FN always carries the lead name
+// and N is left empty. Empty fields are skipped.
+func (s *Service) generateVCard(filepath string, columns []exportColumn, leads []models.LeadResponse) error {
+	file, err := os.Create(filepath)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	defer file.Close()
+
+	selected := make(map[string]bool, len(columns))
+	for _, col := range columns {
+		selected[col.Key] = true
+	}
+
+	writer := bufio.NewWriter(file)
+	for _, lead := range leads {
+		if _, err := writer.WriteString(formatVCard(selected, lead)); err != nil {
+			return fmt.Errorf("failed to write vcard: %w", err)
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		return fmt.Errorf("failed to write vcard: %w", err)
+	}
+
+	return nil
+}
+
+// formatVCard renders one lead as a vCard 3.0 entry with the selected columns
+func formatVCard(selected map[string]bool, lead models.LeadResponse) string {
+	var b strings.Builder
+	line := func(name, value string) {
+		writeVCardLine(&b, name+":"+value)
+	}
+
+	line("BEGIN", "VCARD")
+	line("VERSION", "3.0")
+	line("FN", escapeVCard(lead.Name))
+	line("N", ";;;;") // Leads are businesses, not people
+	if selected["name"] && lead.Name != "" {
+		line("ORG", escapeVCard(lead.Name))
+	}
+	if selected["id"] {
+		line("UID", "industrydb-lead-"+strconv.Itoa(lead.ID))
+	}
+	if selected["phone"] && lead.Phone != "" {
+		line("TEL;TYPE=WORK,VOICE", escapeVCard(lead.Phone))
+	}
+	if selected["email"] && lead.Email != "" {
+		line("EMAIL;TYPE=INTERNET,WORK", escapeVCard(lead.Email))
+	}
+	if selected["website"] && lead.Website != "" {
+		line("URL", escapeVCard(lead.Website))
+	}
+
+	// ADR: PO box;extended;street;locality;region;postal code;country
+	adr := []string{"", "", "", "", "", "", ""}
+	if selected["address"] {
+		adr[2] = escapeVCard(lead.Address)
+	}
+	if selected["city"] {
+		adr[3] = escapeVCard(lead.City)
+	}
+	if selected["postal_code"] {
+		adr[5] = escapeVCard(lead.PostalCode)
+	}
+	if selected["country"] {
+		adr[6] = escapeVCard(lead.Country)
+	}
+	if strings.Join(adr, "") != "" {
+		line("ADR;TYPE=WORK", strings.Join(adr, ";"))
+	}
+
+	if selected["industry"] && lead.Industry != "" {
+		line("CATEGORIES", escapeVCard(lead.Industry))
+	}
+	if selected["latitude"] && selected["longitude"] && lead.Latitude != 0 && lead.Longitude != 0 {
+		line("GEO", fmt.Sprintf("%.6f;%.6f", lead.Latitude, lead.Longitude))
+	}
+	line("END", "VCARD")
+
+	return b.String()
+}
+
+// escapeVCard escapes a text value as vCard 3.0 requires
+func escapeVCard(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, ",", `\,`)
+	value = strings.ReplaceAll(value, ";", `\;`)
+	value = strings.ReplaceAll(value, "\r\n", `\n`)
+	value = strings.ReplaceAll(value, "\n", `\n`)
+	return strings.ReplaceAll(value, "\r", `\n`)
+}
+
+// writeVCardLine writes a content line ending in CRLF, folding it so no
+// line exceeds vcardLineLimit octets without splitting a UTF-8 character
+func writeVCardLine(b *strings.Builder, line string) {
+	limit := vcardLineLimit
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// Continuation lines start with the folding space
+		limit = vcardLineLimit - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
@@ -0,0 +1,92 @@
+package export
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatVCard(t *testing.T) {
+	lead := models.LeadResponse{
+		ID:         42,
+		Name:       "Smith; Sons, Barbers",
+		Industry:   "barber",
+		Country:    "GB",
+		City:       "London",
+		Address:    "1 High St",
+		PostalCode: "N1 1AA",
+		Phone:      "+44 20 7946 0000",
+		Website:    "https://smith.example",
+		Latitude:   51.5,
+		Longitude:  -0.1,
+	}
+	columns, err := resolveColumns(nil)
+	require.NoError(t, err)
+	selected := make(map[string]bool)
+	for _, col := range columns {
+		selected[col.Key] = true
+	}
+
+	card := formatVCard(selected, lead)
+	assert.Equal(t, strings.Join([]string{
+		"BEGIN:VCARD",
+		"VERSION:3.0",
+		`FN:Smith\; Sons\, Barbers`,
+		"N:;;;;",
+		`ORG:Smith\; Sons\, Barbers`,
+		"UID:industrydb-lead-42",
+		"TEL;TYPE=WORK,VOICE:+44 20 7946 0000",
+		"URL:https://smith.example",
+		"ADR;TYPE=WORK:;;1 High St;London;;N1 1AA;GB",
+		"CATEGORIES:barber",
+		"GEO:51.500000;-0.100000",
+		"END:VCARD",
+		"",
+	}, "\r\n"), card)
+
+	t.Run("Skips empty and unselected fields", func(t *testing.T) {
+		card := formatVCard(map[string]bool{"name": true, "email": true, "website": true}, models.LeadResponse{Name: "Quiet Shop", Phone: "555", City: "Austin"})
+		assert.Equal(t, "BEGIN:VCARD\r\nVERSION:3.0\r\nFN:Quiet Shop\r\nN:;;;;\r\nORG:Quiet Shop\r\nEND:VCARD\r\n", card)
+	})
+}
+
+func TestWriteVCardLine_Folds(t *testing.T) {
+	var b strings.Builder
+	long := "NOTE:" + strings.Repeat("é", 60)
+	writeVCardLine(&b, long)
+
+	lines := strings.Split(strings.TrimSuffix(b.String(), "\r\n"), "\r\n")
+	require.Greater(t, len(lines), 1)
+	unfolded := lines[0]
+	for _, line := range lines {
+		assert.LessOrEqual(t, len(line), vcardLineLimit)
+	}
+	for _, line := range lines[1:] {
+		require.True(t, strings.HasPrefix(line, " "))
+		unfolded += line[1:]
+	}
+	assert.Equal(t, long, unfolded)
+}
+
+func TestGenerateVCard(t *testing.T) {
+	s := &Service{}
+	path := filepath.Join(t.TempDir(), "leads.vcf")
+	columns, err := resolveColumns([]string{"name", "email"})
+	require.NoError(t, err)
+
+	leads := []models.LeadResponse{
+		{Name: "First", Email: "first@example.com"},
+		{Name: "Second"},
+	}
+	require.NoError(t, s.generateVCard(path, columns, leads))
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, 2, strings.Count(string(content), "BEGIN:VCARD"))
+	assert.Equal(t, 1, strings.Count(string(content), "EMAIL;TYPE=INTERNET,WORK:first@example.com"))
+}
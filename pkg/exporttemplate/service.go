@@ -0,0 +1,250 @@
+package exporttemplate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/exporttemplate"
+	"github.com/jordanlanch/industrydb/ent/organization"
+	"github.com/jordanlanch/industrydb/ent/organizationmember"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+	entsavedsearch "github.com/jordanlanch/industrydb/ent/savedsearch"
+	"github.com/jordanlanch/industrydb/pkg/export"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/jordanlanch/industrydb/pkg/savedsearch"
+)
+
+// Export template errors
+var (
+	ErrTemplateNotFound      = errors.New("export template not found")
+	ErrSavedSearchNotFound   = errors.New("saved search not found")
+	ErrSavedSearchDeleted    = errors.New("the template's saved search was deleted")
+	ErrAmbiguousSource       = errors.New("set either saved_search_id or filters, not both")
+	ErrInvalidFilters        = errors.New("invalid filters")
+	ErrNotOrganizationMember = errors.New("not a member of the organization")
+)
+
+// CreateRequest creates an export template. Leads are selected by either a
+// saved search of the user or inline filters in saved search format.
+type CreateRequest struct {
+	Name           string                 `json:"name" validate:"required,max=100"`
+	Format         string                 `json:"format" validate:"required,oneof=csv excel vcard geojson kml"`
+	Columns        []string               `json:"columns,omitempty" validate:"omitempty,max=50"`
+	SavedSearchID  *int                   `json:"saved_search_id,omitempty" validate:"omitempty,min=1"`
+	Filters        map[string]interface{} `json:"filters,omitempty"`
+	MaxLeads       int                    `json:"max_leads,omitempty" validate:"omitempty,min=1,max=10000"`
+	OrganizationID *int                   `json:"organization_id,omitempty" validate:"omitempty,min=1"` // Share with an organization
+}
+
+// TemplateResponse is an export template
+type TemplateResponse struct {
+	ID             int                    `json:"id"`
+	UserID         int                    `json:"user_id"`                   // Who created the template
+	OrganizationID *int                   `json:"organization_id,omitempty"` // Set when shared with an organization
+	Name           string                 `json:"name"`
+	Format         string                 `json:"format"`
+	Columns        []string               `json:"columns,omitempty"`
+	SavedSearchID  *int                   `json:"saved_search_id,omitempty"`
+	Filters        map[string]interface{} `json:"filters,omitempty"`
+	MaxLeads       int                    `json:"max_leads,omitempty"`
+	CreatedAt      string                 `json:"created_at"`
+	UpdatedAt      string                 `json:"updated_at"`
+}
+
+// Service handles export template business logic
+type Service struct {
+	db *ent.Client
+}
+
+// NewService creates a new export template service
+func NewService(db *ent.Client) *Service {
+	return &Service{db: db}
+}
+
+// VisibleTo matches the templates a user can use: their own and those
+// shared with organizations they are an active member of
+func VisibleTo(userID int) predicate.ExportTemplate {
+	return exporttemplate.Or(
+		exporttemplate.UserID(userID),
+		exporttemplate.HasOrganizationWith(organization.HasMembersWith(
+			organizationmember.UserID(userID),
+			organizationmember.StatusEQ(organizationmember.StatusActive),
+		)),
+	)
+}
+
+// Create saves an export template for the user, shared with every member of
+// req.OrganizationID when set
+func (s *Service) Create(ctx context.Context, userID int, req CreateRequest) (*TemplateResponse, error) {
+	if (req.SavedSearchID == nil) == (req.Filters == nil) {
+		return nil, ErrAmbiguousSource
+	}
+	if err := export.ValidateColumns(req.Columns); err != nil {
+		return nil, err
+	}
+
+	if req.SavedSearchID != nil {
+		owned, err := s.db.SavedSearch.Query().
+			Where(entsavedsearch.ID(*req.SavedSearchID), entsavedsearch.UserID(userID)).
+			Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get saved search: %w", err)
+		}
+		if !owned {
+			return nil, ErrSavedSearchNotFound
+		}
+	} else if err := savedsearch.ValidateFilters(req.Filters); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFilters, err)
+	}
+
+	if req.OrganizationID != nil {
+		isMember, err := s.db.OrganizationMember.Query().
+			Where(
+				organizationmember.OrganizationID(*req.OrganizationID),
+				organizationmember.UserID(userID),
+				organizationmember.StatusEQ(organizationmember.StatusActive),
+			).
+			Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check organization membership: %w", err)
+		}
+		if !isMember {
+			return nil, ErrNotOrganizationMember
+		}
+	}
+
+	create := s.db.ExportTemplate.Create().
+		SetUserID(userID).
+		SetNillableOrganizationID(req.OrganizationID).
+		SetName(req.Name).
+		SetFormat(exporttemplate.Format(req.Format)).
+		SetNillableSavedSearchID(req.SavedSearchID).
+		SetMaxLeads(req.MaxLeads)
+	if len(req.Columns) > 0 {
+		create = create.SetColumns(req.Columns)
+	}
+	if req.Filters != nil {
+		create = create.SetFilters(req.Filters)
+	}
+
+	template, err := create.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create export template: %w", err)
+	}
+
+	response := toTemplateResponse(template)
+	return &response, nil
+}
+
+// List lists the templates visible to the user, newest first
+func (s *Service) List(ctx context.Context, userID int) ([]TemplateResponse, error) {
+	templates, err := s.db.ExportTemplate.Query().
+		Where(VisibleTo(userID)).
+		Order(ent.Desc(exporttemplate.FieldCreatedAt), ent.Desc(exporttemplate.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list export templates: %w", err)
+	}
+
+	data := make([]TemplateResponse, len(templates))
+	for i, template := range templates {
+		data[i] = toTemplateResponse(template)
+	}
+	return data, nil
+}
+
+// Get retrieves a template visible to the user
+func (s *Service) Get(ctx context.Context, templateID, userID int) (*TemplateResponse, error) {
+	template, err := s.get(ctx, templateID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := toTemplateResponse(template)
+	return &response, nil
+}
+
+// Delete deletes a template created by the user
+func (s *Service) Delete(ctx context.Context, templateID, userID int) error {
+	deleted, err := s.db.ExportTemplate.Delete().
+		Where(exporttemplate.ID(templateID), exporttemplate.UserID(userID)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete export template: %w", err)
+	}
+	if deleted == 0 {
+		return ErrTemplateNotFound
+	}
+	return nil
+}
+
+// ExportRequest builds the export request a template visible to the user
+// describes. Filters that no longer apply, such as removed industries, are
+// dropped and reported as warnings (see savedsearch.ToSearchRequest), and
+// the template can't be used once all its industries are gone.
+func (s *Service) ExportRequest(ctx context.Context, templateID, userID int) (models.ExportRequest, []string, error) {
+	template, err := s.get(ctx, templateID, userID)
+	if err != nil {
+		return models.ExportRequest{}, nil, err
+	}
+
+	filters := template.Filters
+	if template.SavedSearchID != nil {
+		search, err := s.db.SavedSearch.Get(ctx, *template.SavedSearchID)
+		if err != nil && !ent.IsNotFound(err) {
+			return models.ExportRequest{}, nil, fmt.Errorf("failed to get saved search: %w", err)
+		}
+		if search != nil {
+			filters = search.Filters
+		}
+	}
+	// Templates reference a saved search or hold filters, so neither means
+	// the saved search was deleted
+	if filters == nil {
+		return models.ExportRequest{}, nil, ErrSavedSearchDeleted
+	}
+
+	searchReq, warnings, err := savedsearch.ToSearchRequest(filters)
+	if err != nil {
+		return models.ExportRequest{}, nil, err
+	}
+	return models.ExportRequest{
+		Format:   string(template.Format),
+		Filters:  searchReq,
+		MaxLeads: template.MaxLeads,
+		Columns:  template.Columns,
+	}, warnings, nil
+}
+
+// get retrieves a template visible to the user
+func (s *Service) get(ctx context.Context, templateID, userID int) (*ent.ExportTemplate, error) {
+	template, err := s.db.ExportTemplate.Query().
+		Where(exporttemplate.ID(templateID), VisibleTo(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrTemplateNotFound
+		}
+		return nil, fmt.Errorf("failed to get export template: %w", err)
+	}
+	return template, nil
+}
+
+func toTemplateResponse(template *ent.ExportTemplate) TemplateResponse {
+	return TemplateResponse{
+		ID:             template.ID,
+		UserID:         template.UserID,
+		OrganizationID: template.OrganizationID,
+		Name:           template.Name,
+		Format:         string(template.Format),
+		Columns:        template.Columns,
+		SavedSearchID:  template.SavedSearchID,
+		Filters:        template.Filters,
+		MaxLeads:       template.MaxLeads,
+		CreatedAt:      template.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      template.UpdatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,203 @@
+package exporttemplate
+
+import (
+	"context"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/ent/organizationmember"
+	"github.com/jordanlanch/industrydb/pkg/export"
+	"github.com/jordanlanch/industrydb/pkg/savedsearch"
+)
+
+// setupTestDB creates an in-memory SQLite database for testing
+func setupTestDB(t *testing.T) *ent.Client {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+// createTestUser creates a test user in the database
+func createTestUser(t *testing.T, client *ent.Client, email string) *ent.User {
+	return client.User.Create().
+		SetEmail(email).
+		SetPasswordHash("hashed_password").
+		SetName("Test User").
+		SetSubscriptionTier("free").
+		SaveX(context.Background())
+}
+
+func TestService_Create(t *testing.T) {
+	client := setupTestDB(t)
+	service := NewService(client)
+	ctx := context.Background()
+
+	owner := createTestUser(t, client, "owner@example.com")
+	other := createTestUser(t, client, "other@example.com")
+	search := client.SavedSearch.Create().
+		SetUserID(owner.ID).
+		SetName("NYC Restaurants").
+		SetFilters(map[string]interface{}{"industry": "restaurant", "city": "New York"}).
+		SaveX(ctx)
+
+	t.Run("With a saved search", func(t *testing.T) {
+		template, err := service.Create(ctx, owner.ID, CreateRequest{
+			Name:          "Weekly NYC",
+			Format:        "csv",
+			Columns:       []string{"name", "email"},
+			SavedSearchID: &search.ID,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Weekly NYC", template.Name)
+		assert.Equal(t, []string{"name", "email"}, template.Columns)
+		assert.Equal(t, &search.ID, template.SavedSearchID)
+		assert.Nil(t, template.Filters)
+	})
+
+	t.Run("With inline filters", func(t *testing.T) {
+		template, err := service.Create(ctx, owner.ID, CreateRequest{
+			Name:    "Berlin gyms",
+			Format:  "excel",
+			Filters: map[string]interface{}{"industry": "gym", "city": "Berlin"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "gym", template.Filters["industry"])
+	})
+
+	tests := []struct {
+		name     string
+		userID   int
+		req      CreateRequest
+		expected error
+	}{
+		{"Neither saved search nor filters", owner.ID, CreateRequest{Name: "Empty", Format: "csv"}, ErrAmbiguousSource},
+		{"Both saved search and filters", owner.ID, CreateRequest{Name: "Both", Format: "csv", SavedSearchID: &search.ID, Filters: map[string]interface{}{}}, ErrAmbiguousSource},
+		{"Another user's saved search", other.ID, CreateRequest{Name: "Stolen", Format: "csv", SavedSearchID: &search.ID}, ErrSavedSearchNotFound},
+		{"Unknown column", owner.ID, CreateRequest{Name: "Columns", Format: "csv", Columns: []string{"ssn"}, Filters: map[string]interface{}{}}, export.ErrInvalidColumn},
+		{"Invalid filter key", owner.ID, CreateRequest{Name: "Bad", Format: "csv", Filters: map[string]interface{}{"ssn": "x"}}, ErrInvalidFilters},
+		{"Organization the user is not in", owner.ID, CreateRequest{Name: "Shared", Format: "csv", Filters: map[string]interface{}{}, OrganizationID: intPtr(999)}, ErrNotOrganizationMember},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.Create(ctx, tt.userID, tt.req)
+			assert.ErrorIs(t, err, tt.expected)
+		})
+	}
+
+}
+
+func TestService_Sharing(t *testing.T) {
+	client := setupTestDB(t)
+	service := NewService(client)
+	ctx := context.Background()
+
+	admin := createTestUser(t, client, "admin@example.com")
+	rep := createTestUser(t, client, "rep@example.com")
+	outsider := createTestUser(t, client, "outsider@example.com")
+	org := client.Organization.Create().SetName("Sales Team").SetSlug("sales-team").SetOwnerID(admin.ID).SaveX(ctx)
+	client.OrganizationMember.Create().SetOrganizationID(org.ID).SetUserID(admin.ID).SetRole(organizationmember.RoleAdmin).SaveX(ctx)
+	client.OrganizationMember.Create().SetOrganizationID(org.ID).SetUserID(rep.ID).SetRole(organizationmember.RoleMember).SaveX(ctx)
+
+	shared, err := service.Create(ctx, admin.ID, CreateRequest{
+		Name: "Team export", Format: "csv", Filters: map[string]interface{}{"country": "US"}, OrganizationID: &org.ID,
+	})
+	require.NoError(t, err)
+	personal, err := service.Create(ctx, admin.ID, CreateRequest{
+		Name: "Mine", Format: "csv", Filters: map[string]interface{}{"country": "DE"},
+	})
+	require.NoError(t, err)
+
+	t.Run("Members see shared templates", func(t *testing.T) {
+		templates, err := service.List(ctx, rep.ID)
+		require.NoError(t, err)
+		require.Len(t, templates, 1)
+		assert.Equal(t, shared.ID, templates[0].ID)
+
+		_, err = service.Get(ctx, personal.ID, rep.ID)
+		assert.ErrorIs(t, err, ErrTemplateNotFound)
+	})
+
+	t.Run("Outsiders see nothing", func(t *testing.T) {
+		_, err := service.Get(ctx, shared.ID, outsider.ID)
+		assert.ErrorIs(t, err, ErrTemplateNotFound)
+	})
+
+	t.Run("Only the creator deletes", func(t *testing.T) {
+		assert.ErrorIs(t, service.Delete(ctx, shared.ID, rep.ID), ErrTemplateNotFound)
+		assert.NoError(t, service.Delete(ctx, shared.ID, admin.ID))
+		_, err := service.Get(ctx, shared.ID, admin.ID)
+		assert.ErrorIs(t, err, ErrTemplateNotFound)
+	})
+}
+
+func TestService_ExportRequest(t *testing.T) {
+	client := setupTestDB(t)
+	service := NewService(client)
+	ctx := context.Background()
+
+	user := createTestUser(t, client, "exporter@example.com")
+	search := client.SavedSearch.Create().
+		SetUserID(user.ID).
+		SetName("NYC").
+		SetFilters(map[string]interface{}{"industry": "restaurant", "city": "New York"}).
+		SaveX(ctx)
+
+	fromSearch, err := service.Create(ctx, user.ID, CreateRequest{
+		Name: "NYC", Format: "excel", Columns: []string{"name"}, SavedSearchID: &search.ID, MaxLeads: 250,
+	})
+	require.NoError(t, err)
+
+	t.Run("Uses the saved search's current filters", func(t *testing.T) {
+		client.SavedSearch.UpdateOne(search).
+			SetFilters(map[string]interface{}{"industry": "restaurant", "city": "Boston"}).
+			ExecX(ctx)
+
+		req, warnings, err := service.ExportRequest(ctx, fromSearch.ID, user.ID)
+		require.NoError(t, err)
+		assert.Empty(t, warnings)
+		assert.Equal(t, "excel", req.Format)
+		assert.Equal(t, []string{"name"}, req.Columns)
+		assert.Equal(t, 250, req.MaxLeads)
+		assert.Equal(t, "restaurant", req.Filters.Industry)
+		assert.Equal(t, "Boston", req.Filters.City)
+	})
+
+	t.Run("Inline filters with warnings", func(t *testing.T) {
+		inline, err := service.Create(ctx, user.ID, CreateRequest{
+			Name: "Partly gone", Format: "csv", Filters: map[string]interface{}{"industries": []interface{}{"cafe", "no_such_industry"}, "country": "US"},
+		})
+		require.NoError(t, err)
+
+		req, warnings, err := service.ExportRequest(ctx, inline.ID, user.ID)
+		require.NoError(t, err)
+		assert.Len(t, warnings, 1)
+		assert.Equal(t, "US", req.Filters.Country)
+		assert.Equal(t, []string{"cafe"}, req.Filters.Industries)
+	})
+
+	t.Run("Inline filters without any industry left", func(t *testing.T) {
+		inline, err := service.Create(ctx, user.ID, CreateRequest{
+			Name: "Gone", Format: "csv", Filters: map[string]interface{}{"industry": "no_such_industry", "country": "US"},
+		})
+		require.NoError(t, err)
+
+		_, _, err = service.ExportRequest(ctx, inline.ID, user.ID)
+		assert.ErrorIs(t, err, savedsearch.ErrNoAvailableIndustries)
+	})
+
+	t.Run("Deleted saved search", func(t *testing.T) {
+		client.SavedSearch.DeleteOne(search).ExecX(ctx)
+
+		_, _, err := service.ExportRequest(ctx, fromSearch.ID, user.ID)
+		assert.ErrorIs(t, err, ErrSavedSearchDeleted)
+	})
+}
+
+func intPtr(i int) *int {
+	return &i
+}
@@ -0,0 +1,94 @@
+// Package geoip resolves client IP addresses to countries from a MaxMind
+// GeoIP2/GeoLite2 country or city database.
+package geoip
+
+import (
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/oschwald/geoip2-golang"
+)
+
+// maxCachedIPs bounds the per-IP lookup cache. It is cleared when full.
+const maxCachedIPs = 10000
+
+// LookupFunc returns the ISO 3166-1 alpha-2 country code of an IP, or an
+// empty string when the database doesn't know it
+type LookupFunc func(ip net.IP) (string, error)
+
+// Resolver resolves IPs to countries, caching lookups per IP
+type Resolver struct {
+	lookup LookupFunc
+	close  func() error
+
+	mu    sync.Mutex
+	cache map[string]string
+}
+
+// NewResolver creates a resolver that looks countries up with lookup
+func NewResolver(lookup LookupFunc) *Resolver {
+	return &Resolver{
+		lookup: lookup,
+		cache:  make(map[string]string),
+	}
+}
+
+// Open creates a resolver reading the MaxMind database at path
+func Open(path string) (*Resolver, error) {
+	db, err := geoip2.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open geo-IP database: %w", err)
+	}
+
+	r := NewResolver(func(ip net.IP) (string, error) {
+		record, err := db.Country(ip)
+		if err != nil {
+			return "", err
+		}
+		return record.Country.IsoCode, nil
+	})
+	r.close = db.Close
+	return r, nil
+}
+
+// Country returns the country code of an IP address. Private, loopback and
+// unparsable addresses, failed lookups and unknown IPs return "".
+func (r *Resolver) Country(ip string) string {
+	parsed := net.ParseIP(ip)
+	if parsed == nil || parsed.IsPrivate() || parsed.IsLoopback() || parsed.IsUnspecified() {
+		return ""
+	}
+	key := parsed.String()
+
+	r.mu.Lock()
+	country, ok := r.cache[key]
+	r.mu.Unlock()
+	if ok {
+		return country
+	}
+
+	// Failed lookups are cached as unknown too: the database only changes on
+	// restart
+	country, err := r.lookup(parsed)
+	if err != nil {
+		country = ""
+	}
+
+	r.mu.Lock()
+	if len(r.cache) >= maxCachedIPs {
+		r.cache = make(map[string]string)
+	}
+	r.cache[key] = country
+	r.mu.Unlock()
+
+	return country
+}
+
+// Close closes the database
+func (r *Resolver) Close() error {
+	if r.close == nil {
+		return nil
+	}
+	return r.close()
+}
@@ -0,0 +1,46 @@
+package geoip
+
+import (
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolver_Country(t *testing.T) {
+	lookups := 0
+	r := NewResolver(func(ip net.IP) (string, error) {
+		lookups++
+		switch ip.String() {
+		case "81.2.69.142":
+			return "GB", nil
+		case "2a02:c7f::1":
+			return "GB", nil
+		case "203.0.113.9":
+			return "", errors.New("lookup failed")
+		}
+		return "", nil
+	})
+
+	assert.Equal(t, "GB", r.Country("81.2.69.142"))
+	assert.Equal(t, "GB", r.Country("2a02:c7f::1"))
+	assert.Equal(t, "", r.Country("198.51.100.7"))
+	assert.Equal(t, "", r.Country("203.0.113.9"))
+
+	// Lookups are cached per IP, failures included
+	assert.Equal(t, "GB", r.Country("81.2.69.142"))
+	assert.Equal(t, "", r.Country("203.0.113.9"))
+	assert.Equal(t, 4, lookups)
+
+	// Private, loopback and invalid addresses are never looked up
+	for _, ip := range []string{"10.0.0.1", "192.168.1.20", "127.0.0.1", "::1", "0.0.0.0", "not-an-ip", ""} {
+		assert.Equal(t, "", r.Country(ip), ip)
+	}
+	assert.Equal(t, 4, lookups)
+}
+
+func TestOpen_MissingDatabase(t *testing.T) {
+	_, err := Open(t.TempDir() + "/missing.mmdb")
+	assert.Error(t, err)
+}
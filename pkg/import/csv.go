@@ -3,6 +3,7 @@ package importpkg
 import (
 	"context"
 	"encoding/csv"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/jordanlanch/industrydb/ent"
 	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/pkg/leads"
 )
 
 // CSVImportService handles bulk import of leads from CSV
@@ -36,6 +38,14 @@ type ImportResult struct {
 	ImportedLeads  []ImportedLead     `json:"imported_leads,omitempty"`
 }
 
+// addError records a row error, keeping at most config.MaxErrors of them
+func (r *ImportResult) addError(importErr ImportError, config CSVConfig) {
+	if config.MaxErrors > 0 && len(r.Errors) >= config.MaxErrors {
+		return
+	}
+	r.Errors = append(r.Errors, importErr)
+}
+
 // ImportError represents an error during import
 type ImportError struct {
 	Row     int    `json:"row"`
@@ -59,6 +69,11 @@ type CSVConfig struct {
 	ValidateOnly     bool // Only validate, don't import
 	UpdateExisting   bool // Update existing leads if found
 	BatchSize        int  // Number of records per transaction
+	MaxErrors        int  // Maximum row errors kept in the result (0 = unlimited)
+	OmitImported     bool // Don't list imported leads in the result
+
+	// OnProgress is called after each batch with the running totals
+	OnProgress func(result *ImportResult)
 }
 
 // DefaultCSVConfig returns default configuration
@@ -91,6 +106,7 @@ var OptionalFields = []string{
 	"longitude",
 	"sub_niche",
 	"quality_score",
+	"source_id",
 }
 
 // ImportFromCSV imports leads from CSV reader
@@ -145,10 +161,16 @@ func (s *CSVImportService) ImportFromCSV(ctx context.Context, r io.Reader, confi
 			break
 		}
 		if err != nil {
-			result.Errors = append(result.Errors, ImportError{
+			// Malformed rows are skipped; anything else means the source is unreadable
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				result.Duration = time.Since(startTime).String()
+				return result, fmt.Errorf("failed to read CSV: %w", err)
+			}
+			result.addError(ImportError{
 				Row:     rowNum,
 				Message: fmt.Sprintf("CSV read error: %v", err),
-			})
+			}, config)
 			result.FailureCount++
 			rowNum++
 			continue
@@ -159,7 +181,7 @@ func (s *CSVImportService) ImportFromCSV(ctx context.Context, r io.Reader, confi
 		// Parse row into lead data
 		leadData, parseErr := s.parseRow(row, headerMap, rowNum)
 		if parseErr != nil {
-			result.Errors = append(result.Errors, *parseErr)
+			result.addError(*parseErr, config)
 			result.FailureCount++
 			rowNum++
 			continue
@@ -167,7 +189,7 @@ func (s *CSVImportService) ImportFromCSV(ctx context.Context, r io.Reader, confi
 
 		// Validate lead data
 		if validationErr := s.validateLead(leadData, rowNum); validationErr != nil {
-			result.Errors = append(result.Errors, *validationErr)
+			result.addError(*validationErr, config)
 			result.FailureCount++
 			rowNum++
 			continue
@@ -176,6 +198,9 @@ func (s *CSVImportService) ImportFromCSV(ctx context.Context, r io.Reader, confi
 		// If validate-only mode, skip actual import
 		if config.ValidateOnly {
 			result.SuccessCount++
+			if config.OnProgress != nil && result.TotalRows%config.BatchSize == 0 {
+				config.OnProgress(result)
+			}
 			rowNum++
 			continue
 		}
@@ -184,7 +209,9 @@ func (s *CSVImportService) ImportFromCSV(ctx context.Context, r io.Reader, confi
 		leadCreate := s.client.Lead.Create().
 			SetName(leadData.Name).
 			SetIndustry(lead.Industry(leadData.Industry)).
-			SetCountry(leadData.Country)
+			SetCountry(leadData.Country).
+			SetSource(lead.SourceCsvImport).
+			SetLastSeenAt(startTime)
 
 		if leadData.City != "" {
 			leadCreate.SetCity(leadData.City)
@@ -216,15 +243,29 @@ func (s *CSVImportService) ImportFromCSV(ctx context.Context, r io.Reader, confi
 		if leadData.QualityScore != 0 {
 			leadCreate.SetQualityScore(leadData.QualityScore)
 		}
+		leadCreate.SetCompletenessScore(leads.CalculateCompleteness(&ent.Lead{
+			Email:     leadData.Email,
+			Phone:     leadData.Phone,
+			Website:   leadData.Website,
+			Address:   leadData.Address,
+			Latitude:  leadData.Latitude,
+			Longitude: leadData.Longitude,
+		}))
+		if leadData.SourceID != "" {
+			leadCreate.SetSourceID(leadData.SourceID)
+		}
 
 		batch = append(batch, leadCreate)
 
 		// Process batch when full
 		if len(batch) >= config.BatchSize {
-			if batchErr := s.processBatch(ctx, batch, result, rowNum-len(batch)+1); batchErr != nil {
+			if batchErr := s.processBatch(ctx, batch, result, rowNum-len(batch)+1, config); batchErr != nil {
 				log.Printf("⚠️  Batch processing error: %v", batchErr)
 			}
 			batch = make([]*ent.LeadCreate, 0, config.BatchSize)
+			if config.OnProgress != nil {
+				config.OnProgress(result)
+			}
 		}
 
 		rowNum++
@@ -232,7 +273,7 @@ func (s *CSVImportService) ImportFromCSV(ctx context.Context, r io.Reader, confi
 
 	// Process remaining batch
 	if len(batch) > 0 && !config.ValidateOnly {
-		if batchErr := s.processBatch(ctx, batch, result, rowNum-len(batch)+1); batchErr != nil {
+		if batchErr := s.processBatch(ctx, batch, result, rowNum-len(batch)+1, config); batchErr != nil {
 			log.Printf("⚠️  Final batch processing error: %v", batchErr)
 		}
 	}
@@ -246,7 +287,7 @@ func (s *CSVImportService) ImportFromCSV(ctx context.Context, r io.Reader, confi
 }
 
 // processBatch processes a batch of lead creates in a transaction
-func (s *CSVImportService) processBatch(ctx context.Context, batch []*ent.LeadCreate, result *ImportResult, startRow int) error {
+func (s *CSVImportService) processBatch(ctx context.Context, batch []*ent.LeadCreate, result *ImportResult, startRow int, config CSVConfig) error {
 	// Start transaction
 	tx, err := s.client.Tx(ctx)
 	if err != nil {
@@ -258,15 +299,18 @@ func (s *CSVImportService) processBatch(ctx context.Context, batch []*ent.LeadCr
 		lead, err := leadCreate.Save(ctx)
 		if err != nil {
 			tx.Rollback()
-			result.Errors = append(result.Errors, ImportError{
+			result.addError(ImportError{
 				Row:     startRow + i,
 				Message: fmt.Sprintf("Failed to create lead: %v", err),
-			})
+			}, config)
 			result.FailureCount++
 			continue
 		}
 
 		result.SuccessCount++
+		if config.OmitImported {
+			continue
+		}
 		result.ImportedLeads = append(result.ImportedLeads, ImportedLead{
 			Row:      startRow + i,
 			LeadID:   strconv.Itoa(lead.ID),
@@ -298,6 +342,7 @@ type LeadData struct {
 	Longitude    float64
 	SubNiche     string
 	QualityScore int
+	SourceID     string
 }
 
 // parseRow parses a CSV row into LeadData
@@ -325,6 +370,7 @@ func (s *CSVImportService) parseRow(row []string, headerMap map[string]int, rowN
 	data.Email = getField("email")
 	data.Website = getField("website")
 	data.SubNiche = getField("sub_niche")
+	data.SourceID = getField("source_id")
 
 	// Parse numeric fields (skip errors, use default 0)
 	// Latitude/Longitude parsing would go here
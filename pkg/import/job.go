@@ -0,0 +1,268 @@
+package importpkg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/importjob"
+)
+
+// ErrS3NotConfigured is returned when an S3 import is requested but no import
+// bucket is configured
+var ErrS3NotConfigured = errors.New("S3 imports are not configured")
+
+// ErrJobTimeout is returned when an import job runs past its deadline
+var ErrJobTimeout = errors.New("import job timed out")
+
+// Remote import defaults
+const (
+	DefaultRemoteMaxBytes = 5 * 1024 * 1024 * 1024 // 5GB
+	DefaultJobTimeout     = 6 * time.Hour
+	maxJobErrors          = 100
+	remoteBatchSize       = 500
+	progressInterval      = 2 * time.Second
+)
+
+// JobConfig holds remote import job configuration
+type JobConfig struct {
+	MaxBytes     int64         // Maximum source size in bytes (0 = DefaultRemoteMaxBytes)
+	AllowedHosts []string      // HTTPS hosts imports may be read from (empty = any public host)
+	Timeout      time.Duration // Deadline for downloading and importing a source (0 = DefaultJobTimeout)
+}
+
+// JobService runs CSV imports from S3 or HTTPS URLs as tracked background jobs
+type JobService struct {
+	db         *ent.Client
+	importer   *CSVImportService
+	s3         *S3Opener
+	httpClient *http.Client
+	config     JobConfig
+}
+
+// NewJobService creates a new import job service. s3 may be nil when S3
+// imports are not configured.
+func NewJobService(db *ent.Client, s3 *S3Opener, config JobConfig) *JobService {
+	if config.MaxBytes <= 0 {
+		config.MaxBytes = DefaultRemoteMaxBytes
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = DefaultJobTimeout
+	}
+	return &JobService{
+		db:         db,
+		importer:   NewCSVImportService(db),
+		s3:         s3,
+		httpClient: newDownloadClient(),
+		config:     config,
+	}
+}
+
+// JobRequest describes a remote CSV import. Exactly one of S3Key and URL is set.
+type JobRequest struct {
+	S3Key        string `json:"s3_key,omitempty"`
+	URL          string `json:"url,omitempty"`
+	ValidateOnly bool   `json:"validate_only"`
+}
+
+// MaxBytes returns the size cap for remote sources
+func (s *JobService) MaxBytes() int64 {
+	return s.config.MaxBytes
+}
+
+// Start validates the source, records a pending job and starts processing it
+// in the background
+func (s *JobService) Start(ctx context.Context, userID int, req JobRequest) (*ent.ImportJob, error) {
+	sourceType, source, err := s.resolve(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := s.db.ImportJob.Create().
+		SetUserID(userID).
+		SetSourceType(sourceType).
+		SetSource(req.S3Key + req.URL).
+		SetValidateOnly(req.ValidateOnly).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create import job: %w", err)
+	}
+
+	go s.run(job.ID, source, req.ValidateOnly)
+
+	return job, nil
+}
+
+// resolve validates a request and returns its source
+func (s *JobService) resolve(ctx context.Context, req JobRequest) (importjob.SourceType, Source, error) {
+	switch {
+	case req.S3Key != "" && req.URL != "":
+		return "", nil, fmt.Errorf("%w: set either s3_key or url, not both", ErrInvalidSource)
+	case req.S3Key != "":
+		if err := ValidateS3Key(req.S3Key); err != nil {
+			return "", nil, err
+		}
+		if s.s3 == nil {
+			return "", nil, ErrS3NotConfigured
+		}
+		return importjob.SourceTypeS3, s.s3.Source(req.S3Key), nil
+	case req.URL != "":
+		if err := ValidateURL(ctx, req.URL, s.config.AllowedHosts); err != nil {
+			return "", nil, err
+		}
+		return importjob.SourceTypeURL, &urlSource{client: s.httpClient, url: req.URL}, nil
+	default:
+		return "", nil, fmt.Errorf("%w: s3_key or url is required", ErrInvalidSource)
+	}
+}
+
+// Get returns an import job by ID
+func (s *JobService) Get(ctx context.Context, id int) (*ent.ImportJob, error) {
+	return s.db.ImportJob.Get(ctx, id)
+}
+
+// List returns the most recent import jobs
+func (s *JobService) List(ctx context.Context, limit int) ([]*ent.ImportJob, error) {
+	return s.db.ImportJob.Query().
+		Order(ent.Desc(importjob.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+}
+
+// FailInterrupted marks jobs left pending or processing by a previous process
+// as failed. Jobs run in-process, so they cannot survive a restart.
+func (s *JobService) FailInterrupted(ctx context.Context) (int, error) {
+	return s.db.ImportJob.Update().
+		Where(importjob.StatusIn(importjob.StatusPending, importjob.StatusProcessing)).
+		SetStatus(importjob.StatusFailed).
+		SetErrorMessage("interrupted by server restart").
+		SetCompletedAt(time.Now()).
+		Save(ctx)
+}
+
+// run streams the source through the CSV importer and records progress. The
+// download and import are bounded by the job timeout, so a stalled source
+// can't hold the job in processing; job updates use ctx so the failure can
+// still be recorded.
+func (s *JobService) run(jobID int, source Source, validateOnly bool) {
+	ctx := context.Background()
+	importCtx, cancel := context.WithTimeout(ctx, s.config.Timeout)
+	defer cancel()
+
+	if err := s.db.ImportJob.UpdateOneID(jobID).
+		SetStatus(importjob.StatusProcessing).
+		SetStartedAt(time.Now()).
+		Exec(ctx); err != nil {
+		log.Printf("❌ Failed to start import job %d: %v", jobID, err)
+		return
+	}
+
+	body, size, err := source.Open(importCtx)
+	if err != nil {
+		s.fail(ctx, jobID, s.db.ImportJob.UpdateOneID(jobID), s.deadlineError(importCtx, err))
+		return
+	}
+	defer body.Close()
+
+	update := s.db.ImportJob.UpdateOneID(jobID)
+	if size >= 0 {
+		if size > s.config.MaxBytes {
+			s.fail(ctx, jobID, update.SetTotalBytes(size), fmt.Errorf("%w: %d bytes (max %d)", ErrSourceTooLarge, size, s.config.MaxBytes))
+			return
+		}
+		if err := update.SetTotalBytes(size).Exec(ctx); err != nil {
+			log.Printf("⚠️  Failed to record import job %d size: %v", jobID, err)
+		}
+	}
+
+	reader := &cappedReader{r: body, max: s.config.MaxBytes}
+
+	config := DefaultCSVConfig()
+	config.MaxRows = 0 // Remote sources are bounded by size instead
+	config.ValidateOnly = validateOnly
+	config.BatchSize = remoteBatchSize
+	config.MaxErrors = maxJobErrors
+	config.OmitImported = true
+
+	lastProgress := time.Now()
+	config.OnProgress = func(result *ImportResult) {
+		if time.Since(lastProgress) < progressInterval {
+			return
+		}
+		lastProgress = time.Now()
+		if err := s.progressUpdate(jobID, reader, result).Exec(ctx); err != nil {
+			log.Printf("⚠️  Failed to record import job %d progress: %v", jobID, err)
+		}
+	}
+
+	result, err := s.importer.ImportFromCSV(importCtx, reader, config)
+	if err != nil {
+		if result == nil {
+			result = &ImportResult{}
+		}
+		s.fail(ctx, jobID, s.progressUpdate(jobID, reader, result), s.deadlineError(importCtx, err))
+		return
+	}
+
+	if err := s.progressUpdate(jobID, reader, result).
+		SetStatus(importjob.StatusCompleted).
+		SetCompletedAt(time.Now()).
+		Exec(ctx); err != nil {
+		log.Printf("❌ Failed to complete import job %d: %v", jobID, err)
+		return
+	}
+
+	log.Printf("✅ Import job %d completed: %d success, %d failures", jobID, result.SuccessCount, result.FailureCount)
+}
+
+// progressUpdate builds an update recording the running totals
+func (s *JobService) progressUpdate(jobID int, reader *cappedReader, result *ImportResult) *ent.ImportJobUpdateOne {
+	return s.db.ImportJob.UpdateOneID(jobID).
+		SetBytesRead(min(reader.read.Load(), reader.max)).
+		SetTotalRows(result.TotalRows).
+		SetSuccessCount(result.SuccessCount).
+		SetFailureCount(result.FailureCount).
+		SetErrors(errorMaps(result.Errors))
+}
+
+// deadlineError reports err as ErrJobTimeout when the job ran out of time
+func (s *JobService) deadlineError(importCtx context.Context, err error) error {
+	if errors.Is(importCtx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("%w after %s", ErrJobTimeout, s.config.Timeout)
+	}
+	return err
+}
+
+// fail marks a job failed, applying update so partial totals are kept
+func (s *JobService) fail(ctx context.Context, jobID int, update *ent.ImportJobUpdateOne, cause error) {
+	log.Printf("❌ Import job %d failed: %v", jobID, cause)
+
+	err := update.
+		SetStatus(importjob.StatusFailed).
+		SetErrorMessage(cause.Error()).
+		SetCompletedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		log.Printf("❌ Failed to record import job %d failure: %v", jobID, err)
+	}
+}
+
+// errorMaps converts row errors to the JSON shape stored on the job
+func errorMaps(importErrors []ImportError) []map[string]interface{} {
+	maps := make([]map[string]interface{}, 0, len(importErrors))
+	for _, e := range importErrors {
+		m := map[string]interface{}{"row": e.Row, "message": e.Message}
+		if e.Field != "" {
+			m["field"] = e.Field
+		}
+		if e.Value != "" {
+			m["value"] = e.Value
+		}
+		maps = append(maps, m)
+	}
+	return maps
+}
@@ -0,0 +1,175 @@
+package importpkg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync/atomic"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/jordanlanch/industrydb/pkg/webhook"
+)
+
+// ErrInvalidSource is returned when a remote import source is not allowed
+var ErrInvalidSource = errors.New("invalid import source")
+
+// ErrSourceTooLarge is returned when a remote CSV exceeds the size cap
+var ErrSourceTooLarge = errors.New("import source exceeds size limit")
+
+// Source is a remote CSV file
+type Source interface {
+	// Open starts streaming the file. size is -1 when unknown.
+	Open(ctx context.Context) (body io.ReadCloser, size int64, err error)
+}
+
+// S3Config holds S3 import source configuration
+type S3Config struct {
+	AWSAccessKeyID     string
+	AWSSecretAccessKey string
+	AWSRegion          string
+	Bucket             string
+}
+
+// S3Opener opens objects in the import bucket
+type S3Opener struct {
+	s3Client *s3.Client
+	bucket   string
+}
+
+// NewS3Opener creates an opener for the import bucket
+func NewS3Opener(cfg S3Config) (*S3Opener, error) {
+	awsCfg, err := awsconfig.LoadDefaultConfig(context.Background(),
+		awsconfig.WithRegion(cfg.AWSRegion),
+		awsconfig.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(
+			cfg.AWSAccessKeyID,
+			cfg.AWSSecretAccessKey,
+			"",
+		)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS config: %w", err)
+	}
+
+	return &S3Opener{
+		s3Client: s3.NewFromConfig(awsCfg),
+		bucket:   cfg.Bucket,
+	}, nil
+}
+
+// Source returns the object with the given key as an import source
+func (o *S3Opener) Source(key string) Source {
+	return &s3Source{opener: o, key: key}
+}
+
+type s3Source struct {
+	opener *S3Opener
+	key    string
+}
+
+func (s *s3Source) Open(ctx context.Context) (io.ReadCloser, int64, error) {
+	out, err := s.opener.s3Client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.opener.bucket),
+		Key:    aws.String(s.key),
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get s3://%s/%s: %w", s.opener.bucket, s.key, err)
+	}
+
+	size := int64(-1)
+	if out.ContentLength != nil {
+		size = *out.ContentLength
+	}
+	return out.Body, size, nil
+}
+
+// ValidateS3Key checks that a key names a CSV object inside the import bucket
+func ValidateS3Key(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || path.Clean(key) != key || strings.Contains(key, "..") {
+		return fmt.Errorf("%w: invalid S3 key", ErrInvalidSource)
+	}
+	if !strings.HasSuffix(strings.ToLower(key), ".csv") {
+		return fmt.Errorf("%w: S3 key must end in .csv", ErrInvalidSource)
+	}
+	return nil
+}
+
+// ValidateURL checks a remote import URL with the outbound URL guard shared
+// with webhooks (see webhook.ValidateURL): it must be an absolute https URL
+// without credentials whose host is public. When allowedHosts is set, its
+// host must also be one of them.
+func ValidateURL(ctx context.Context, raw string, allowedHosts []string) error {
+	if err := webhook.ValidateURL(ctx, raw); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidSource, err)
+	}
+	if len(allowedHosts) == 0 {
+		return nil
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSource, err)
+	}
+	host := strings.ToLower(u.Hostname())
+	for _, allowed := range allowedHosts {
+		if host == strings.ToLower(allowed) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: host %s is not in the import allowlist", ErrInvalidSource, host)
+}
+
+// newDownloadClient creates the guarded client remote CSVs are downloaded
+// with (see webhook.NewGuardedClient), which times out connecting and waiting
+// for response headers. There is no overall client timeout: large files may
+// take a long time to stream, so the download is bounded by the job timeout
+// instead (see JobConfig.Timeout).
+func newDownloadClient() *http.Client {
+	return webhook.NewGuardedClient(0)
+}
+
+type urlSource struct {
+	client *http.Client
+	url    string
+}
+
+func (s *urlSource) Open(ctx context.Context) (io.ReadCloser, int64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: %v", ErrInvalidSource, err)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to download CSV: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, 0, fmt.Errorf("failed to download CSV: HTTP %d", resp.StatusCode)
+	}
+
+	return resp.Body, resp.ContentLength, nil
+}
+
+// cappedReader counts bytes read and fails with ErrSourceTooLarge once more
+// than max bytes have been read, rather than silently truncating the file
+type cappedReader struct {
+	r    io.Reader
+	max  int64
+	read atomic.Int64
+}
+
+func (c *cappedReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	if c.read.Add(int64(n)) > c.max {
+		return n, ErrSourceTooLarge
+	}
+	return n, err
+}
@@ -0,0 +1,118 @@
+package industries
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/lead"
+)
+
+// CategoryIndustry is an industry within a category, with its lead count
+type CategoryIndustry struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Icon         string `json:"icon"`
+	Description  string `json:"description"`
+	Active       bool   `json:"active"`
+	SortOrder    int    `json:"sort_order"`
+	HasSubNiches bool   `json:"has_sub_niches"`
+	LeadCount    int    `json:"lead_count"`
+}
+
+// CategoryWithIndustries is a category with its industries nested and the
+// total lead count of those industries
+type CategoryWithIndustries struct {
+	CategoryInfo
+	LeadCount  int                `json:"lead_count"`
+	Industries []CategoryIndustry `json:"industries"`
+}
+
+// GetCategoriesWithCounts returns every category of AllCategories with its
+// industries and lead counts, both sorted by SortOrder. Inactive industries
+// are left out unless includeInactive is set. Results are cached for 15
+// minutes (lead counts change frequently).
+func (s *Service) GetCategoriesWithCounts(ctx context.Context, includeInactive bool) ([]CategoryWithIndustries, error) {
+	cacheKey := "industries:categories:active"
+	if includeInactive {
+		cacheKey = "industries:categories:all"
+	}
+
+	// Try to get from cache
+	if cached, err := s.cache.Get(ctx, cacheKey); err == nil && cached != "" {
+		var response []CategoryWithIndustries
+		if err := json.Unmarshal([]byte(cached), &response); err == nil {
+			return response, nil
+		}
+	}
+
+	// Count leads per industry in one query
+	var rows []struct {
+		Industry string `json:"industry"`
+		Count    int    `json:"count"`
+	}
+	err := s.db.Lead.Query().
+		GroupBy(lead.FieldIndustry).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count leads by industry: %w", err)
+	}
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		counts[row.Industry] = row.Count
+	}
+
+	result := categoryTree(AllCategories(), GetIndustriesByCategory, counts, includeInactive)
+
+	// Cache the response for 15 minutes
+	if responseJSON, err := json.Marshal(result); err == nil {
+		_ = s.cache.Set(ctx, cacheKey, responseJSON, 15*time.Minute)
+	}
+
+	return result, nil
+}
+
+// categoryTree nests each category's industries, from industriesOf, with
+// their lead counts
+func categoryTree(categories []CategoryInfo, industriesOf func(category string) []IndustryConfig, counts map[string]int, includeInactive bool) []CategoryWithIndustries {
+	categories = append([]CategoryInfo(nil), categories...)
+	sort.SliceStable(categories, func(i, j int) bool {
+		return categories[i].SortOrder < categories[j].SortOrder
+	})
+
+	result := make([]CategoryWithIndustries, 0, len(categories))
+	for _, cat := range categories {
+		entry := CategoryWithIndustries{
+			CategoryInfo: cat,
+			Industries:   []CategoryIndustry{},
+		}
+
+		for _, ind := range industriesOf(cat.ID) {
+			if !ind.Active && !includeInactive {
+				continue
+			}
+			entry.Industries = append(entry.Industries, CategoryIndustry{
+				ID:           ind.ID,
+				Name:         ind.Name,
+				Icon:         ind.Icon,
+				Description:  ind.Description,
+				Active:       ind.Active,
+				SortOrder:    ind.SortOrder,
+				HasSubNiches: ind.HasSubNiches,
+				LeadCount:    counts[ind.ID],
+			})
+			entry.LeadCount += counts[ind.ID]
+		}
+
+		sort.SliceStable(entry.Industries, func(i, j int) bool {
+			return entry.Industries[i].SortOrder < entry.Industries[j].SortOrder
+		})
+		result = append(result, entry)
+	}
+
+	return result
+}
@@ -0,0 +1,48 @@
+package industries
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCategoryTree(t *testing.T) {
+	categories := []CategoryInfo{
+		{ID: "second", SortOrder: 2},
+		{ID: "first", SortOrder: 1},
+	}
+	industriesOf := func(category string) []IndustryConfig {
+		if category != "first" {
+			return nil
+		}
+		return []IndustryConfig{
+			{ID: "later", Active: true, SortOrder: 5},
+			{ID: "retired", Active: false, SortOrder: 1},
+			{ID: "earlier", Active: true, SortOrder: 3},
+		}
+	}
+	counts := map[string]int{"later": 4, "retired": 2, "earlier": 1}
+
+	t.Run("Active industries only", func(t *testing.T) {
+		tree := categoryTree(categories, industriesOf, counts, false)
+		require.Len(t, tree, 2)
+		assert.Equal(t, "first", tree[0].ID)
+		assert.Equal(t, "second", tree[1].ID)
+
+		require.Len(t, tree[0].Industries, 2)
+		assert.Equal(t, "earlier", tree[0].Industries[0].ID)
+		assert.Equal(t, "later", tree[0].Industries[1].ID)
+		assert.Equal(t, 5, tree[0].LeadCount)
+
+		assert.NotNil(t, tree[1].Industries)
+		assert.Zero(t, tree[1].LeadCount)
+	})
+
+	t.Run("Including inactive industries", func(t *testing.T) {
+		tree := categoryTree(categories, industriesOf, counts, true)
+		require.Len(t, tree[0].Industries, 3)
+		assert.Equal(t, "retired", tree[0].Industries[0].ID)
+		assert.Equal(t, 7, tree[0].LeadCount)
+	})
+}
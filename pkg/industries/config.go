@@ -26,6 +26,7 @@ type IndustryConfig struct {
 	HasSubNiches      bool              `json:"has_sub_niches"`       // Whether this industry has sub-niches
 	SubNicheLabel     string            `json:"sub_niche_label"`      // Display label (e.g., "Cuisine Type", "Gym Type")
 	SubNiches         []SubNicheConfig  `json:"sub_niches,omitempty"` // List of sub-niches
+	Fields            []FieldTemplate   `json:"fields,omitempty"`     // Recommended structured fields for leads
 }
 
 // CategoryInfo holds category metadata
@@ -53,6 +54,7 @@ func AllIndustries() []IndustryConfig {
 			HasSubNiches:  true,
 			SubNicheLabel: "Tattoo Style",
 			SubNiches:     TattooSubNiches(),
+			Fields:        TattooFields(),
 		},
 		{
 			ID:            "beauty",
@@ -124,6 +126,7 @@ func AllIndustries() []IndustryConfig {
 			HasSubNiches:  true,
 			SubNicheLabel: "Gym Type",
 			SubNiches:     GymSubNiches(),
+			Fields:        GymFields(),
 		},
 		{
 			ID:            "dentist",
@@ -134,6 +137,7 @@ func AllIndustries() []IndustryConfig {
 			Description:   "Dental clinics and dentists",
 			Active:        true,
 			SortOrder:     7,
+			Fields:        DentistFields(),
 		},
 		{
 			ID:            "pharmacy",
@@ -172,6 +176,7 @@ func AllIndustries() []IndustryConfig {
 			HasSubNiches:  true,
 			SubNicheLabel: "Cuisine Type",
 			SubNiches:     RestaurantSubNiches(),
+			Fields:        RestaurantFields(),
 		},
 		{
 			ID:            "cafe",
@@ -182,6 +187,7 @@ func AllIndustries() []IndustryConfig {
 			Description:   "Cafes and coffee shops",
 			Active:        true,
 			SortOrder:     11,
+			Fields:        CafeFields(),
 		},
 		{
 			ID:            "bar",
@@ -859,6 +865,7 @@ func AllIndustries() []IndustryConfig {
 			Description:   "Hotels and accommodations",
 			Active:        true,
 			SortOrder:     74,
+			Fields:        HotelFields(),
 		},
 		{
 			ID:            "motel",
@@ -0,0 +1,298 @@
+package industries
+
+import (
+	"fmt"
+	"math"
+)
+
+// Field types supported by industry field templates
+const (
+	FieldTypeString  = "string"
+	FieldTypeNumber  = "number"
+	FieldTypeInteger = "integer"
+	FieldTypeBoolean = "boolean"
+	FieldTypeEnum    = "enum"
+)
+
+// FieldTemplate describes a recommended structured field for leads of an
+// industry (e.g., seating capacity for restaurants, star rating for hotels).
+// Values are stored in the lead's custom fields under Key.
+type FieldTemplate struct {
+	Key         string   `json:"key"`
+	Label       string   `json:"label"`
+	Type        string   `json:"type"`              // string, number, integer, boolean, enum
+	Options     []string `json:"options,omitempty"` // Allowed values for enum fields
+	Min         *float64 `json:"min,omitempty"`     // Minimum for number/integer fields
+	Max         *float64 `json:"max,omitempty"`     // Maximum for number/integer fields
+	Unit        string   `json:"unit,omitempty"`    // Display unit (e.g., "seats", "m²")
+	Description string   `json:"description"`
+}
+
+// bound returns a pointer to a field bound
+func bound(v float64) *float64 {
+	return &v
+}
+
+// RestaurantFields returns recommended fields for restaurants
+func RestaurantFields() []FieldTemplate {
+	return []FieldTemplate{
+		{
+			Key:         "seating_capacity",
+			Label:       "Seating Capacity",
+			Type:        FieldTypeInteger,
+			Min:         bound(0),
+			Unit:        "seats",
+			Description: "Number of seats available for dine-in guests",
+		},
+		{
+			Key:         "price_range",
+			Label:       "Price Range",
+			Type:        FieldTypeEnum,
+			Options:     []string{"$", "$$", "$$$", "$$$$"},
+			Description: "Typical price level per person",
+		},
+		{
+			Key:         "offers_delivery",
+			Label:       "Offers Delivery",
+			Type:        FieldTypeBoolean,
+			Description: "Whether the restaurant delivers orders",
+		},
+		{
+			Key:         "outdoor_seating",
+			Label:       "Outdoor Seating",
+			Type:        FieldTypeBoolean,
+			Description: "Whether the restaurant has outdoor seating",
+		},
+		{
+			Key:         "reservation_system",
+			Label:       "Reservation System",
+			Type:        FieldTypeString,
+			Description: "Booking platform in use (e.g., OpenTable, Resy)",
+		},
+	}
+}
+
+// CafeFields returns recommended fields for cafes
+func CafeFields() []FieldTemplate {
+	return []FieldTemplate{
+		{
+			Key:         "seating_capacity",
+			Label:       "Seating Capacity",
+			Type:        FieldTypeInteger,
+			Min:         bound(0),
+			Unit:        "seats",
+			Description: "Number of seats available for guests",
+		},
+		{
+			Key:         "roasts_own_coffee",
+			Label:       "Roasts Own Coffee",
+			Type:        FieldTypeBoolean,
+			Description: "Whether the cafe roasts its own beans",
+		},
+		{
+			Key:         "offers_wifi",
+			Label:       "Offers Wi-Fi",
+			Type:        FieldTypeBoolean,
+			Description: "Whether free Wi-Fi is available to customers",
+		},
+	}
+}
+
+// HotelFields returns recommended fields for hotels
+func HotelFields() []FieldTemplate {
+	return []FieldTemplate{
+		{
+			Key:         "star_rating",
+			Label:       "Star Rating",
+			Type:        FieldTypeInteger,
+			Min:         bound(1),
+			Max:         bound(5),
+			Unit:        "stars",
+			Description: "Official hotel star classification",
+		},
+		{
+			Key:         "room_count",
+			Label:       "Number of Rooms",
+			Type:        FieldTypeInteger,
+			Min:         bound(0),
+			Unit:        "rooms",
+			Description: "Total number of guest rooms",
+		},
+		{
+			Key:         "has_restaurant",
+			Label:       "On-site Restaurant",
+			Type:        FieldTypeBoolean,
+			Description: "Whether the hotel operates a restaurant",
+		},
+		{
+			Key:         "booking_channel",
+			Label:       "Primary Booking Channel",
+			Type:        FieldTypeEnum,
+			Options:     []string{"direct", "ota", "mixed"},
+			Description: "Where most bookings come from (direct, online travel agencies, or both)",
+		},
+	}
+}
+
+// GymFields returns recommended fields for gyms
+func GymFields() []FieldTemplate {
+	return []FieldTemplate{
+		{
+			Key:         "member_count",
+			Label:       "Member Count",
+			Type:        FieldTypeInteger,
+			Min:         bound(0),
+			Unit:        "members",
+			Description: "Approximate number of active members",
+		},
+		{
+			Key:         "floor_area",
+			Label:       "Floor Area",
+			Type:        FieldTypeNumber,
+			Min:         bound(0),
+			Unit:        "m²",
+			Description: "Training floor area",
+		},
+		{
+			Key:         "open_24_hours",
+			Label:       "Open 24 Hours",
+			Type:        FieldTypeBoolean,
+			Description: "Whether the gym is open around the clock",
+		},
+		{
+			Key:         "offers_classes",
+			Label:       "Offers Group Classes",
+			Type:        FieldTypeBoolean,
+			Description: "Whether the gym runs group fitness classes",
+		},
+	}
+}
+
+// TattooFields returns recommended fields for tattoo studios
+func TattooFields() []FieldTemplate {
+	return []FieldTemplate{
+		{
+			Key:         "artist_count",
+			Label:       "Number of Artists",
+			Type:        FieldTypeInteger,
+			Min:         bound(0),
+			Unit:        "artists",
+			Description: "Resident tattoo artists at the studio",
+		},
+		{
+			Key:         "accepts_walk_ins",
+			Label:       "Accepts Walk-ins",
+			Type:        FieldTypeBoolean,
+			Description: "Whether the studio tattoos without an appointment",
+		},
+		{
+			Key:         "offers_piercing",
+			Label:       "Offers Piercing",
+			Type:        FieldTypeBoolean,
+			Description: "Whether the studio also offers body piercing",
+		},
+	}
+}
+
+// DentistFields returns recommended fields for dental clinics
+func DentistFields() []FieldTemplate {
+	return []FieldTemplate{
+		{
+			Key:         "dentist_count",
+			Label:       "Number of Dentists",
+			Type:        FieldTypeInteger,
+			Min:         bound(0),
+			Unit:        "dentists",
+			Description: "Practicing dentists at the clinic",
+		},
+		{
+			Key:         "accepts_insurance",
+			Label:       "Accepts Insurance",
+			Type:        FieldTypeBoolean,
+			Description: "Whether the clinic accepts dental insurance",
+		},
+		{
+			Key:         "practice_type",
+			Label:       "Practice Type",
+			Type:        FieldTypeEnum,
+			Options:     []string{"general", "orthodontics", "pediatric", "cosmetic", "multi_specialty"},
+			Description: "Main focus of the practice",
+		},
+	}
+}
+
+// GetFieldTemplates returns the recommended fields for an industry
+func GetFieldTemplates(industryID string) []FieldTemplate {
+	industry := GetIndustryByID(industryID)
+	if industry == nil || industry.Fields == nil {
+		return []FieldTemplate{}
+	}
+	return industry.Fields
+}
+
+// GetFieldTemplate returns a specific recommended field by industry and key
+func GetFieldTemplate(industryID, key string) *FieldTemplate {
+	for _, field := range GetFieldTemplates(industryID) {
+		if field.Key == key {
+			return &field
+		}
+	}
+	return nil
+}
+
+// Validate checks that a JSON-decoded value matches the field's type and
+// constraints.
+func (f FieldTemplate) Validate(value interface{}) error {
+	switch f.Type {
+	case FieldTypeString:
+		if _, ok := value.(string); !ok {
+			return fmt.Errorf("%s must be a string", f.Key)
+		}
+	case FieldTypeBoolean:
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("%s must be a boolean", f.Key)
+		}
+	case FieldTypeEnum:
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("%s must be one of %v", f.Key, f.Options)
+		}
+		for _, option := range f.Options {
+			if s == option {
+				return nil
+			}
+		}
+		return fmt.Errorf("%s must be one of %v", f.Key, f.Options)
+	case FieldTypeNumber, FieldTypeInteger:
+		n, ok := toFloat(value)
+		if !ok {
+			return fmt.Errorf("%s must be a number", f.Key)
+		}
+		if f.Type == FieldTypeInteger && n != math.Trunc(n) {
+			return fmt.Errorf("%s must be a whole number", f.Key)
+		}
+		if f.Min != nil && n < *f.Min {
+			return fmt.Errorf("%s must be at least %v", f.Key, *f.Min)
+		}
+		if f.Max != nil && n > *f.Max {
+			return fmt.Errorf("%s must be at most %v", f.Key, *f.Max)
+		}
+	}
+	return nil
+}
+
+// toFloat converts a JSON-decoded number into a float64
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,199 @@
+package industries
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// DefaultLocale is the language of the industry configs, used whenever a
+// translation is missing
+const DefaultLocale = "en"
+
+// Translation is the localized text of a category, industry or sub-niche.
+// Empty fields fall back to English.
+type Translation struct {
+	Name          string `json:"name,omitempty"`
+	Description   string `json:"description,omitempty"`
+	SubNicheLabel string `json:"sub_niche_label,omitempty"` // Industries only
+}
+
+// LocaleTranslations holds one locale's translations, keyed by ID
+type LocaleTranslations struct {
+	Categories map[string]Translation `json:"categories,omitempty"`
+	Industries map[string]Translation `json:"industries,omitempty"`
+	SubNiches  map[string]Translation `json:"sub_niches,omitempty"` // Keyed "<industry>/<sub-niche>"
+}
+
+// Translations maps a locale (e.g. "es", "pt-BR") to its translations
+type Translations map[string]LocaleTranslations
+
+// translations holds the translations used by the Localize functions, and
+// matcher the locales they cover, English first.
+var (
+	translations = Translations{}
+	locales      = []string{DefaultLocale}
+	matcher      = language.NewMatcher([]language.Tag{language.English})
+)
+
+// SetTranslations replaces the translations used by the Localize functions.
+// It is meant to be called once at startup, e.g. with LoadTranslations.
+func SetTranslations(t Translations) {
+	normalized := make(Translations, len(t))
+	keys := []string{DefaultLocale}
+	tags := []language.Tag{language.English}
+	for locale, lt := range t {
+		tag, err := language.Parse(locale)
+		if err != nil || tag == language.English {
+			continue
+		}
+		normalized[tag.String()] = lt
+	}
+	for locale := range normalized {
+		keys = append(keys, locale)
+	}
+	sort.Strings(keys[1:])
+	for _, locale := range keys[1:] {
+		tags = append(tags, language.MustParse(locale))
+	}
+
+	translations = normalized
+	locales = keys
+	matcher = language.NewMatcher(tags)
+}
+
+// LoadTranslations reads translations from a JSON file of the form
+// {"es": {"industries": {"tattoo": {"name": "Estudios de tatuaje"}}}}
+func LoadTranslations(path string) (Translations, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read industry translations: %w", err)
+	}
+
+	var t Translations
+	if err := json.Unmarshal(data, &t); err != nil {
+		return nil, fmt.Errorf("failed to parse industry translations: %w", err)
+	}
+	return t, nil
+}
+
+// ResolveLocale picks the supported locale for a request: the lang query
+// parameter when set, otherwise the best match for the Accept-Language
+// header. Unsupported languages resolve to DefaultLocale.
+func ResolveLocale(lang, acceptLanguage string) string {
+	var desired []language.Tag
+	if lang != "" {
+		tag, err := language.Parse(lang)
+		if err != nil {
+			return DefaultLocale
+		}
+		desired = []language.Tag{tag}
+	} else {
+		tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+		if err != nil || len(tags) == 0 {
+			return DefaultLocale
+		}
+		desired = tags
+	}
+
+	_, index, confidence := matcher.Match(desired...)
+	if confidence == language.No {
+		return DefaultLocale
+	}
+	return locales[index]
+}
+
+// LocalizeCategoryResponses translates grouped industries in place
+func LocalizeCategoryResponses(locale string, categories []CategoryResponse) {
+	for i := range categories {
+		cat := &categories[i]
+		t := lookup(locale, func(lt LocaleTranslations) map[string]Translation { return lt.Categories }, cat.ID)
+		cat.Name, cat.Description = t.text(cat.Name, cat.Description)
+		for j := range cat.Industries {
+			LocalizeIndustryResponse(locale, &cat.Industries[j])
+		}
+	}
+}
+
+// LocalizeIndustryResponse translates an industry in place
+func LocalizeIndustryResponse(locale string, industry *IndustryResponse) {
+	t := industryTranslation(locale, industry.ID)
+	industry.Name, industry.Description = t.text(industry.Name, industry.Description)
+}
+
+// LocalizeIndustriesWithCount translates industries with lead counts in place
+func LocalizeIndustriesWithCount(locale string, industries []IndustryWithCount) {
+	for i := range industries {
+		ind := &industries[i]
+		t := industryTranslation(locale, ind.ID)
+		ind.Name, ind.Description = t.text(ind.Name, ind.Description)
+	}
+}
+
+// LocalizeCategoriesWithIndustries translates the category tree in place
+func LocalizeCategoriesWithIndustries(locale string, categories []CategoryWithIndustries) {
+	for i := range categories {
+		cat := &categories[i]
+		t := lookup(locale, func(lt LocaleTranslations) map[string]Translation { return lt.Categories }, cat.ID)
+		cat.Name, cat.Description = t.text(cat.Name, cat.Description)
+		for j := range cat.Industries {
+			ind := &cat.Industries[j]
+			t := industryTranslation(locale, ind.ID)
+			ind.Name, ind.Description = t.text(ind.Name, ind.Description)
+		}
+	}
+}
+
+// LocalizeSubNicheLabel translates an industry's sub-niche label
+func LocalizeSubNicheLabel(locale, industryID, label string) string {
+	return fallback(industryTranslation(locale, industryID).SubNicheLabel, label)
+}
+
+// LocalizeSubNiches translates an industry's sub-niches in place
+func LocalizeSubNiches(locale, industryID string, subNiches []SubNicheWithCount) {
+	for i := range subNiches {
+		sn := &subNiches[i]
+		t := lookup(locale, func(lt LocaleTranslations) map[string]Translation { return lt.SubNiches }, industryID+"/"+sn.ID)
+		sn.Name, sn.Description = t.text(sn.Name, sn.Description)
+	}
+}
+
+// industryTranslation returns an industry's translation
+func industryTranslation(locale, id string) Translation {
+	return lookup(locale, func(lt LocaleTranslations) map[string]Translation { return lt.Industries }, id)
+}
+
+// lookup returns the translation of id in the given section of a locale,
+// falling back from a regional locale (pt-BR) to its language (pt). Missing
+// translations are empty, so every field falls back to English.
+func lookup(locale string, section func(LocaleTranslations) map[string]Translation, id string) Translation {
+	if locale == DefaultLocale {
+		return Translation{}
+	}
+
+	t := section(translations[locale])[id]
+	if base, _, found := strings.Cut(locale, "-"); found {
+		parent := section(translations[base])[id]
+		t.Name = fallback(t.Name, parent.Name)
+		t.Description = fallback(t.Description, parent.Description)
+		t.SubNicheLabel = fallback(t.SubNicheLabel, parent.SubNicheLabel)
+	}
+	return t
+}
+
+// text returns the translated name and description, or the English ones
+func (t Translation) text(name, description string) (string, string) {
+	return fallback(t.Name, name), fallback(t.Description, description)
+}
+
+// fallback returns value, or def when value is empty
+func fallback(value, def string) string {
+	if value == "" {
+		return def
+	}
+	return value
+}
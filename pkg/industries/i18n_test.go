@@ -0,0 +1,173 @@
+package industries
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setTestTranslations(t *testing.T) {
+	t.Helper()
+
+	SetTranslations(Translations{
+		"es": {
+			Categories: map[string]Translation{"personal_care": {Name: "Cuidado personal"}},
+			Industries: map[string]Translation{
+				"tattoo": {Name: "Estudios de tatuaje", Description: "Estudios y artistas", SubNicheLabel: "Estilo"},
+				"beauty": {Name: "Salones de belleza"},
+			},
+			SubNiches: map[string]Translation{"tattoo/traditional": {Name: "Tradicional"}},
+		},
+		"pt-br": {
+			Industries: map[string]Translation{"tattoo": {Name: "Estúdios de tatuagem"}},
+		},
+		"pt": {
+			Industries: map[string]Translation{"tattoo": {Description: "Estúdios e artistas"}},
+		},
+		"not a locale": {},
+	})
+	t.Cleanup(func() { SetTranslations(nil) })
+}
+
+func TestResolveLocale(t *testing.T) {
+	setTestTranslations(t)
+
+	tests := []struct {
+		name           string
+		lang           string
+		acceptLanguage string
+		expected       string
+	}{
+		{"No preference", "", "", DefaultLocale},
+		{"Lang param", "es", "", "es"},
+		{"Lang param overrides header", "en", "es", DefaultLocale},
+		{"Regional variant of a supported language", "es-MX", "", "es"},
+		{"Regional locale", "", "pt-BR", "pt-BR"},
+		{"Quality values", "", "fr;q=0.9, es;q=0.8, en;q=0.1", "es"},
+		{"Unsupported language", "", "fr", DefaultLocale},
+		{"Invalid lang param", "!!", "es", DefaultLocale},
+		{"Invalid header", "", ";;;=", DefaultLocale},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ResolveLocale(tt.lang, tt.acceptLanguage))
+		})
+	}
+}
+
+func TestLocalizeIndustryResponse(t *testing.T) {
+	setTestTranslations(t)
+
+	english := func() IndustryResponse {
+		return IndustryResponse{ID: "tattoo", Name: "Tattoo Studios", Description: "Tattoo parlors"}
+	}
+
+	t.Run("Translated", func(t *testing.T) {
+		industry := english()
+		LocalizeIndustryResponse("es", &industry)
+		assert.Equal(t, "Estudios de tatuaje", industry.Name)
+		assert.Equal(t, "Estudios y artistas", industry.Description)
+	})
+
+	t.Run("Missing field falls back to English", func(t *testing.T) {
+		industry := IndustryResponse{ID: "beauty", Name: "Beauty Salons", Description: "Hair and nails"}
+		LocalizeIndustryResponse("es", &industry)
+		assert.Equal(t, "Salones de belleza", industry.Name)
+		assert.Equal(t, "Hair and nails", industry.Description)
+	})
+
+	t.Run("Missing industry falls back to English", func(t *testing.T) {
+		industry := IndustryResponse{ID: "gym", Name: "Gyms", Description: "Fitness centers"}
+		LocalizeIndustryResponse("es", &industry)
+		assert.Equal(t, "Gyms", industry.Name)
+		assert.Equal(t, "Fitness centers", industry.Description)
+	})
+
+	t.Run("Regional locale falls back to its language", func(t *testing.T) {
+		industry := english()
+		LocalizeIndustryResponse("pt-BR", &industry)
+		assert.Equal(t, "Estúdios de tatuagem", industry.Name)
+		assert.Equal(t, "Estúdios e artistas", industry.Description)
+	})
+
+	t.Run("English", func(t *testing.T) {
+		industry := english()
+		LocalizeIndustryResponse(DefaultLocale, &industry)
+		assert.Equal(t, english(), industry)
+	})
+}
+
+func TestLocalizeCategories(t *testing.T) {
+	setTestTranslations(t)
+
+	categories := []CategoryResponse{{
+		ID:          "personal_care",
+		Name:        "Personal Care",
+		Description: "Beauty and body art",
+		Industries:  []IndustryResponse{{ID: "tattoo", Name: "Tattoo Studios"}},
+	}}
+	LocalizeCategoryResponses("es", categories)
+	assert.Equal(t, "Cuidado personal", categories[0].Name)
+	assert.Equal(t, "Beauty and body art", categories[0].Description)
+	assert.Equal(t, "Estudios de tatuaje", categories[0].Industries[0].Name)
+
+	tree := []CategoryWithIndustries{{
+		CategoryInfo: CategoryInfo{ID: "personal_care", Name: "Personal Care"},
+		Industries:   []CategoryIndustry{{ID: "tattoo", Name: "Tattoo Studios"}},
+	}}
+	LocalizeCategoriesWithIndustries("es", tree)
+	assert.Equal(t, "Cuidado personal", tree[0].Name)
+	assert.Equal(t, "Estudios de tatuaje", tree[0].Industries[0].Name)
+}
+
+func TestLocalizeSubNiches(t *testing.T) {
+	setTestTranslations(t)
+
+	subNiches := []SubNicheWithCount{
+		{ID: "traditional", Name: "Traditional"},
+		{ID: "realism", Name: "Realism"},
+	}
+	LocalizeSubNiches("es", "tattoo", subNiches)
+	assert.Equal(t, "Tradicional", subNiches[0].Name)
+	assert.Equal(t, "Realism", subNiches[1].Name)
+
+	assert.Equal(t, "Estilo", LocalizeSubNicheLabel("es", "tattoo", "Style"))
+	assert.Equal(t, "Style", LocalizeSubNicheLabel("pt-BR", "tattoo", "Style"))
+	assert.Equal(t, "Style", LocalizeSubNicheLabel(DefaultLocale, "tattoo", "Style"))
+}
+
+func TestLoadTranslations(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Run("Valid file", func(t *testing.T) {
+		path := filepath.Join(dir, "translations.json")
+		data, err := json.Marshal(map[string]interface{}{
+			"es": map[string]interface{}{
+				"industries": map[string]interface{}{"tattoo": map[string]string{"name": "Estudios de tatuaje"}},
+			},
+		})
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(path, data, 0o600))
+
+		loaded, err := LoadTranslations(path)
+		require.NoError(t, err)
+		assert.Equal(t, "Estudios de tatuaje", loaded["es"].Industries["tattoo"].Name)
+	})
+
+	t.Run("Missing file", func(t *testing.T) {
+		_, err := LoadTranslations(filepath.Join(dir, "missing.json"))
+		assert.Error(t, err)
+	})
+
+	t.Run("Invalid JSON", func(t *testing.T) {
+		path := filepath.Join(dir, "invalid.json")
+		require.NoError(t, os.WriteFile(path, []byte("{"), 0o600))
+		_, err := LoadTranslations(path)
+		assert.Error(t, err)
+	})
+}
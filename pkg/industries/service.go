@@ -374,3 +374,16 @@ func (s *Service) InvalidateCache(ctx context.Context) error {
 	// Delete all keys matching "industries:*" pattern
 	return s.cache.DeletePattern(ctx, "industries:*")
 }
+
+// RefreshLeadCounts drops the cached lead counts and precomputes the
+// unfiltered per-industry counts, so they match the leads table again after
+// bulk changes
+func (s *Service) RefreshLeadCounts(ctx context.Context) error {
+	if err := s.InvalidateCache(ctx); err != nil {
+		return fmt.Errorf("failed to invalidate industry caches: %w", err)
+	}
+	if _, err := s.GetIndustriesWithLeadCounts(ctx, "", ""); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package industries
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
+
+// ConfigVersion identifies the content of the industry configuration, so
+// clients can tell when cached industry lists are stale
+type ConfigVersion struct {
+	Version       string `json:"version"` // SHA-256 of the industries, categories and translations
+	IndustryCount int    `json:"industry_count"`
+	CategoryCount int    `json:"category_count"`
+}
+
+// CurrentConfigVersion returns the version of the industry configuration
+// in use. It changes whenever an industry, sub-niche, field template,
+// category or loaded translation changes, and only then.
+func CurrentConfigVersion() (ConfigVersion, error) {
+	return configVersion(AllIndustries(), AllCategories(), translations)
+}
+
+// configVersion hashes the JSON encoding of the configuration, which is
+// stable: struct fields keep their order and map keys are sorted
+func configVersion(industries []IndustryConfig, categories []CategoryInfo, t Translations) (ConfigVersion, error) {
+	content, err := json.Marshal(struct {
+		Industries   []IndustryConfig `json:"industries"`
+		Categories   []CategoryInfo   `json:"categories"`
+		Translations Translations     `json:"translations"`
+	}{industries, categories, t})
+	if err != nil {
+		return ConfigVersion{}, fmt.Errorf("failed to encode industry configuration: %w", err)
+	}
+
+	hash := sha256.Sum256(content)
+	return ConfigVersion{
+		Version:       hex.EncodeToString(hash[:]),
+		IndustryCount: len(industries),
+		CategoryCount: len(categories),
+	}, nil
+}
@@ -0,0 +1,44 @@
+package industries
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigVersion(t *testing.T) {
+	current, err := CurrentConfigVersion()
+	require.NoError(t, err)
+	assert.Len(t, current.Version, 64)
+	assert.Equal(t, len(AllIndustries()), current.IndustryCount)
+	assert.Equal(t, len(AllCategories()), current.CategoryCount)
+
+	again, err := CurrentConfigVersion()
+	require.NoError(t, err)
+	assert.Equal(t, current, again, "the version is stable")
+
+	version := func(industries []IndustryConfig, categories []CategoryInfo, t2 Translations) string {
+		v, err := configVersion(industries, categories, t2)
+		require.NoError(t, err)
+		return v.Version
+	}
+
+	// Any content change, however deep, changes the version
+	industries := AllIndustries()
+	industries[0].SubNiches = append([]SubNicheConfig(nil), industries[0].SubNiches...)
+	industries[0].SubNiches[0].Name += "!"
+	assert.NotEqual(t, current.Version, version(industries, AllCategories(), translations))
+
+	categories := AllCategories()
+	categories[0].SortOrder++
+	assert.NotEqual(t, current.Version, version(AllIndustries(), categories, translations))
+
+	translated := Translations{"es": {Industries: map[string]Translation{"tattoo": {Name: "Tatuajes"}}}}
+	assert.NotEqual(t, current.Version, version(AllIndustries(), AllCategories(), translated))
+
+	// Reordering industries is a change too, as lists keep the config order
+	reordered := AllIndustries()
+	reordered[0], reordered[1] = reordered[1], reordered[0]
+	assert.NotEqual(t, current.Version, version(reordered, AllCategories(), translations))
+}
@@ -6,15 +6,32 @@ import (
 	"time"
 
 	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/pkg/analytics"
 	"github.com/jordanlanch/industrydb/pkg/cache"
+	"github.com/jordanlanch/industrydb/pkg/email"
+	"github.com/jordanlanch/industrydb/pkg/leadassignment"
+	"github.com/jordanlanch/industrydb/pkg/leadlifecycle"
+	"github.com/jordanlanch/industrydb/pkg/leads"
+	"github.com/jordanlanch/industrydb/pkg/retention"
+	"github.com/jordanlanch/industrydb/pkg/scheduledexport"
+	"github.com/jordanlanch/industrydb/pkg/webhook"
 	"github.com/robfig/cron/v3"
 )
 
 // CronManager manages scheduled jobs
 type CronManager struct {
-	cron    *cron.Cron
-	monitor *DataMonitor
-	logger  *log.Logger
+	cron             *cron.Cron
+	monitor          *DataMonitor
+	leadService      *leads.Service
+	lifecycleService *leadlifecycle.Service
+	analyticsService *analytics.Service
+	retentionService *retention.Service
+	emailService     *email.Service
+	webhookService   *webhook.Service
+	scheduleService  *scheduledexport.Service
+	claimService     *leadassignment.Service
+	health           *jobMonitor
+	logger           *log.Logger
 }
 
 // NewCronManager creates a new cron manager
@@ -24,33 +41,54 @@ func NewCronManager(db *ent.Client, cache *cache.Client, logger *log.Logger) *Cr
 	}
 
 	return &CronManager{
-		cron:    cron.New(),
-		monitor: NewDataMonitor(db, cache, logger),
-		logger:  logger,
+		cron:             cron.New(),
+		monitor:          NewDataMonitor(db, cache, logger),
+		leadService:      leads.NewService(db, cache),
+		lifecycleService: leadlifecycle.NewService(db),
+		analyticsService: analytics.NewService(db),
+		health:           newJobMonitor(cache, time.Now()),
+		logger:           logger,
 	}
 }
 
+// addJob schedules a job with a standard cron spec and tracks its runs for
+// JobHealth. Each run gets a context bounded by timeout, which is also the
+// time a run may take before the job counts as overdue. Critical jobs being
+// overdue degrades JobHealth.
+func (cm *CronManager) addJob(name, spec string, timeout time.Duration, critical bool, run func(ctx context.Context) error) error {
+	if err := cm.health.register(name, spec, timeout, critical); err != nil {
+		return err
+	}
+
+	_, err := cm.cron.AddFunc(spec, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		cm.health.started(name, time.Now())
+		err := run(ctx)
+		cm.health.finished(context.Background(), name, time.Now(), err)
+	})
+	return err
+}
+
 // SetupJobs configures all scheduled jobs
 func (cm *CronManager) SetupJobs() error {
 	cm.logger.Println("Setting up cron jobs...")
 
 	// Daily at 2 AM: Populate industries with low data (< 100 leads)
-	_, err := cm.cron.AddFunc("0 2 * * *", func() {
+	err := cm.addJob("data_population", "0 2 * * *", 30*time.Minute, true, func(ctx context.Context) error {
 		cm.logger.Println("🕐 Running daily data population job...")
 
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
-		defer cancel()
-
 		// Detect industries with < 100 leads
 		pairs, err := cm.monitor.DetectLowDataIndustries(ctx, 100)
 		if err != nil {
 			cm.logger.Printf("❌ Failed to detect low data industries: %v", err)
-			return
+			return err
 		}
 
 		if len(pairs) == 0 {
 			cm.logger.Println("✅ No industries with low data found")
-			return
+			return nil
 		}
 
 		cm.logger.Printf("Found %d industry/country pairs with < 100 leads", len(pairs))
@@ -67,10 +105,11 @@ func (cm *CronManager) SetupJobs() error {
 		// Trigger fetches (max 3 concurrent)
 		if err := cm.monitor.TriggerDataFetchBatch(ctx, topPairs, 1000, 3); err != nil {
 			cm.logger.Printf("⚠️ Batch fetch completed with errors: %v", err)
-			return
+			return err
 		}
 
 		cm.logger.Println("✅ Daily data population job completed")
+		return nil
 	})
 
 	if err != nil {
@@ -78,22 +117,19 @@ func (cm *CronManager) SetupJobs() error {
 	}
 
 	// Weekly on Sunday at 3 AM: Detect and populate missing combinations
-	_, err = cm.cron.AddFunc("0 3 * * 0", func() {
+	err = cm.addJob("missing_combinations", "0 3 * * 0", time.Hour, true, func(ctx context.Context) error {
 		cm.logger.Println("🕐 Running weekly missing data detection job...")
 
-		ctx, cancel := context.WithTimeout(context.Background(), 1*time.Hour)
-		defer cancel()
-
 		// Detect missing combinations
 		pairs, err := cm.monitor.DetectMissingCombinations(ctx)
 		if err != nil {
 			cm.logger.Printf("❌ Failed to detect missing combinations: %v", err)
-			return
+			return err
 		}
 
 		if len(pairs) == 0 {
 			cm.logger.Println("✅ No missing combinations found")
-			return
+			return nil
 		}
 
 		cm.logger.Printf("Found %d missing combinations", len(pairs))
@@ -110,10 +146,11 @@ func (cm *CronManager) SetupJobs() error {
 		// Trigger fetches (max 5 concurrent)
 		if err := cm.monitor.TriggerDataFetchBatch(ctx, topPairs, 500, 5); err != nil {
 			cm.logger.Printf("⚠️ Batch fetch completed with errors: %v", err)
-			return
+			return err
 		}
 
 		cm.logger.Println("✅ Weekly missing data detection job completed")
+		return nil
 	})
 
 	if err != nil {
@@ -121,16 +158,13 @@ func (cm *CronManager) SetupJobs() error {
 	}
 
 	// Daily at 4 AM: Log population statistics
-	_, err = cm.cron.AddFunc("0 4 * * *", func() {
+	err = cm.addJob("population_stats", "0 4 * * *", time.Minute, false, func(ctx context.Context) error {
 		cm.logger.Println("🕐 Logging population statistics...")
 
-		ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
-		defer cancel()
-
 		stats, err := cm.monitor.GetPopulationStats(ctx)
 		if err != nil {
 			cm.logger.Printf("❌ Failed to get population stats: %v", err)
-			return
+			return err
 		}
 
 		cm.logger.Printf("📊 Population Statistics:")
@@ -138,20 +172,262 @@ func (cm *CronManager) SetupJobs() error {
 		cm.logger.Printf("  Total combinations: %v", stats["total_combinations"])
 		cm.logger.Printf("  Top industries: %v", stats["top_industries"])
 		cm.logger.Printf("  Top countries: %v", stats["top_countries"])
+		return nil
 	})
 
 	if err != nil {
 		return err
 	}
 
+	// Daily at 5 AM: Recompute lead quality scores (catches recency decay and direct edits)
+	err = cm.addJob("quality_scores", "0 5 * * *", time.Hour, true, func(ctx context.Context) error {
+		cm.logger.Println("🕐 Recomputing lead quality scores...")
+
+		result, err := cm.leadService.RecomputeQualityScores(ctx, nil)
+		if err != nil {
+			cm.logger.Printf("❌ Failed to recompute quality scores: %v", err)
+			return err
+		}
+
+		cm.logger.Printf("✅ Quality scores recomputed: %d processed, %d updated", result.Processed, result.Updated)
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	// Hourly: Flag leads that exceeded their status SLA
+	err = cm.addJob("lead_status_sla", "0 * * * *", 15*time.Minute, false, func(ctx context.Context) error {
+		cm.logger.Println("🕐 Checking lead status SLAs...")
+
+		result, err := cm.lifecycleService.FlagOverdueLeads(ctx, time.Now())
+		if err != nil {
+			cm.logger.Printf("❌ Failed to check lead status SLAs: %v", err)
+			return err
+		}
+
+		cm.logger.Printf("✅ Lead status SLAs checked: %d checked, %d flagged overdue, %d reps notified", result.Checked, result.Flagged, result.Notified)
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	// Hourly at :45: Reset usage for users and organizations starting a new cycle
+	err = cm.addJob("usage_reset", "45 * * * *", 15*time.Minute, true, func(ctx context.Context) error {
+		cm.logger.Println("🕐 Resetting usage for new billing cycles...")
+
+		result, err := cm.leadService.ResetDueUsage(ctx, time.Now())
+		if err != nil {
+			cm.logger.Printf("❌ Failed to reset usage: %v", err)
+			return err
+		}
+
+		cm.logger.Printf("✅ Usage reset: %d users, %d organizations", result.Users, result.Organizations)
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	// Daily at 00:30: Roll up completed days of usage logs for analytics
+	err = cm.addJob("usage_rollup", "30 0 * * *", time.Hour, true, func(ctx context.Context) error {
+		cm.logger.Println("🕐 Rolling up daily usage...")
+
+		written, err := cm.analyticsService.RollUpPending(ctx, time.Now())
+		if err != nil {
+			cm.logger.Printf("❌ Failed to roll up daily usage: %v", err)
+			return err
+		}
+
+		cm.logger.Printf("✅ Daily usage rolled up: %d rollups written", written)
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	// Daily at 1 AM: Purge usage logs and audit logs past their retention window
+	if cm.retentionService != nil {
+		err = cm.addJob("retention_purge", "0 1 * * *", 2*time.Hour, true, func(ctx context.Context) error {
+			cm.logger.Println("🕐 Running data retention purge...")
+
+			result, err := cm.retentionService.Purge(ctx, time.Now(), nil)
+			if err != nil {
+				cm.logger.Printf("❌ Failed to purge expired data: %v", err)
+				return err
+			}
+
+			cm.logger.Printf("✅ Data retention purge completed: %d usage logs (%d aggregates updated), %d audit logs",
+				result.UsageLogs.Purged, result.AggregatesUpdated, result.AuditLogs.Purged)
+			return nil
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	// Every 5 minutes: Retry transactional emails that failed transiently
+	if cm.emailService != nil {
+		err = cm.addJob("email_retry", "*/5 * * * *", 4*time.Minute, false, func(ctx context.Context) error {
+			result, err := cm.emailService.RetryPending(ctx, time.Now())
+			if err != nil {
+				cm.logger.Printf("❌ Failed to retry emails: %v", err)
+				return err
+			}
+
+			if result.Retried > 0 {
+				cm.logger.Printf("✅ Email retries: %d retried, %d sent, %d failed", result.Retried, result.Sent, result.Failed)
+			}
+			return nil
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	// Every 5 minutes: Deliver webhook events deferred by delivery windows
+	if cm.webhookService != nil {
+		err = cm.addJob("webhook_deferred_delivery", "*/5 * * * *", 4*time.Minute, false, func(ctx context.Context) error {
+			flushed, err := cm.webhookService.FlushDeferred(ctx, time.Now())
+			if err != nil {
+				cm.logger.Printf("❌ Failed to deliver deferred webhook events: %v", err)
+				return err
+			}
+
+			if flushed > 0 {
+				cm.logger.Printf("✅ Deferred webhook events: %d delivered", flushed)
+			}
+			return nil
+		})
+
+		if err != nil {
+			return err
+		}
+
+		// Every minute: Send lead changes as lead.updated webhook events
+		err = cm.addJob("webhook_lead_updates", "* * * * *", 50*time.Second, false, func(ctx context.Context) error {
+			sent, err := cm.webhookService.DispatchLeadChanges(ctx)
+			if err != nil {
+				cm.logger.Printf("❌ Failed to send lead.updated webhook events: %v", err)
+				return err
+			}
+
+			if sent > 0 {
+				cm.logger.Printf("✅ lead.updated webhook events: %d sent", sent)
+			}
+			return nil
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	// Every minute: Create the exports of due scheduled exports
+	if cm.scheduleService != nil {
+		err = cm.addJob("scheduled_exports", "* * * * *", 50*time.Second, true, func(ctx context.Context) error {
+			created, err := cm.scheduleService.RunDue(ctx, time.Now())
+			if err != nil {
+				cm.logger.Printf("❌ Failed to run scheduled exports: %v", err)
+				return err
+			}
+
+			if created > 0 {
+				cm.logger.Printf("✅ Scheduled exports: %d exports created", created)
+			}
+			return nil
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	// Hourly at :15: Expire lead claims abandoned past the claim timeout
+	if cm.claimService != nil {
+		err = cm.addJob("lead_claim_expiry", "15 * * * *", 10*time.Minute, false, func(ctx context.Context) error {
+			expired, err := cm.claimService.ExpireClaims(ctx, time.Now())
+			if err != nil {
+				cm.logger.Printf("❌ Failed to expire lead claims: %v", err)
+				return err
+			}
+
+			if expired > 0 {
+				cm.logger.Printf("✅ Lead claims: %d expired after inactivity", expired)
+			}
+			return nil
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
 	cm.logger.Println("✅ Cron jobs configured successfully")
 	cm.logger.Println("  - Daily at 2 AM: Populate low-data industries")
 	cm.logger.Println("  - Weekly on Sunday at 3 AM: Populate missing combinations")
 	cm.logger.Println("  - Daily at 4 AM: Log statistics")
+	cm.logger.Println("  - Daily at 5 AM: Recompute lead quality scores")
+	cm.logger.Println("  - Hourly: Flag leads overdue on their status SLA")
+	cm.logger.Println("  - Hourly at :45: Reset usage for new billing cycles")
+	cm.logger.Println("  - Daily at 00:30: Roll up daily usage")
+	if cm.retentionService != nil {
+		cm.logger.Println("  - Daily at 1 AM: Purge expired usage logs and audit logs")
+	}
+	if cm.emailService != nil {
+		cm.logger.Println("  - Every 5 minutes: Retry failed emails")
+	}
+	if cm.webhookService != nil {
+		cm.logger.Println("  - Every 5 minutes: Deliver webhook events deferred by delivery windows")
+		cm.logger.Println("  - Every minute: Send lead changes as lead.updated webhook events")
+	}
+	if cm.scheduleService != nil {
+		cm.logger.Println("  - Every minute: Run due scheduled exports")
+	}
+	if cm.claimService != nil {
+		cm.logger.Println("  - Hourly at :15: Expire inactive lead claims")
+	}
 
 	return nil
 }
 
+// SetRetentionService enables the nightly data retention purge. It must be
+// called before SetupJobs.
+func (cm *CronManager) SetRetentionService(service *retention.Service) {
+	cm.retentionService = service
+}
+
+// SetEmailService enables retries of failed transactional emails. It must be
+// called before SetupJobs.
+func (cm *CronManager) SetEmailService(service *email.Service) {
+	cm.emailService = service
+}
+
+// SetWebhookService enables delivery of webhook events deferred by delivery
+// windows and of lead.updated events. It must be called before SetupJobs.
+func (cm *CronManager) SetWebhookService(service *webhook.Service) {
+	cm.webhookService = service
+}
+
+// SetScheduledExportService enables running scheduled exports. It must be
+// called before SetupJobs.
+func (cm *CronManager) SetScheduledExportService(service *scheduledexport.Service) {
+	cm.scheduleService = service
+}
+
+// SetLeadClaimService enables expiring lead claims abandoned past the
+// service's claim timeout. It must be called before SetupJobs.
+func (cm *CronManager) SetLeadClaimService(service *leadassignment.Service) {
+	cm.claimService = service
+}
+
 // Start starts the cron scheduler
 func (cm *CronManager) Start() {
 	cm.logger.Println("🚀 Starting cron scheduler...")
@@ -164,7 +440,19 @@ func (cm *CronManager) Stop() {
 	cm.cron.Stop()
 }
 
+// JobHealth reports each scheduled job's last run and success and whether
+// it is overdue on its schedule. The status is degraded while a critical
+// job is overdue.
+func (cm *CronManager) JobHealth(ctx context.Context, now time.Time) JobHealth {
+	return cm.health.health(ctx, now)
+}
+
 // GetMonitor returns the data monitor (for manual triggers)
 func (cm *CronManager) GetMonitor() *DataMonitor {
 	return cm.monitor
 }
+
+// GetLeadLifecycleService returns the lead lifecycle service used by the SLA job
+func (cm *CronManager) GetLeadLifecycleService() *leadlifecycle.Service {
+	return cm.lifecycleService
+}
@@ -0,0 +1,199 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jordanlanch/industrydb/pkg/cache"
+	"github.com/robfig/cron/v3"
+)
+
+// Job health statuses
+const (
+	JobHealthOK       = "ok"
+	JobHealthDegraded = "degraded" // A critical job is overdue
+)
+
+// lastSuccessTTL bounds how long a job's last success is kept in Redis,
+// longer than the longest schedule (weekly)
+const lastSuccessTTL = 30 * 24 * time.Hour
+
+// JobStatus is the health of one scheduled job
+type JobStatus struct {
+	Name          string     `json:"name"`
+	Schedule      string     `json:"schedule"`
+	Critical      bool       `json:"critical"`
+	Running       bool       `json:"running"`
+	LastRunAt     *time.Time `json:"last_run_at,omitempty"`
+	LastSuccessAt *time.Time `json:"last_success_at,omitempty"`
+	LastRunFailed bool       `json:"last_run_failed"`
+	DueBy         time.Time  `json:"due_by"` // The next success is expected by then
+	Overdue       bool       `json:"overdue"`
+}
+
+// JobHealth reports whether the scheduled jobs are running on schedule
+type JobHealth struct {
+	Status    string      `json:"status"`
+	CheckedAt time.Time   `json:"checked_at"`
+	Overdue   []string    `json:"overdue"` // Names of overdue jobs, critical or not
+	Jobs      []JobStatus `json:"jobs"`
+}
+
+// trackedJob is a job registered with a jobMonitor
+type trackedJob struct {
+	name          string
+	spec          string
+	schedule      cron.Schedule
+	timeout       time.Duration
+	critical      bool
+	running       bool
+	lastRunAt     time.Time
+	lastSuccessAt time.Time
+	lastRunFailed bool
+}
+
+// jobMonitor records the runs of scheduled jobs. Last successes are also
+// kept in Redis, so they survive restarts and are shared by instances.
+type jobMonitor struct {
+	mu        sync.Mutex
+	jobs      []*trackedJob
+	byName    map[string]*trackedJob
+	cache     *cache.Client
+	startedAt time.Time
+}
+
+func newJobMonitor(cache *cache.Client, startedAt time.Time) *jobMonitor {
+	return &jobMonitor{
+		byName:    make(map[string]*trackedJob),
+		cache:     cache,
+		startedAt: startedAt,
+	}
+}
+
+// register adds a job with a standard cron schedule
+func (m *jobMonitor) register(name, spec string, timeout time.Duration, critical bool) error {
+	schedule, err := cron.ParseStandard(spec)
+	if err != nil {
+		return fmt.Errorf("invalid schedule %q for job %s: %w", spec, name, err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, exists := m.byName[name]; exists {
+		return fmt.Errorf("job %s is already registered", name)
+	}
+	job := &trackedJob{name: name, spec: spec, schedule: schedule, timeout: timeout, critical: critical}
+	m.jobs = append(m.jobs, job)
+	m.byName[name] = job
+	return nil
+}
+
+// started records that a job started running
+func (m *jobMonitor) started(name string, at time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if job := m.byName[name]; job != nil {
+		job.running = true
+		job.lastRunAt = at
+	}
+}
+
+// finished records the outcome of a job run
+func (m *jobMonitor) finished(ctx context.Context, name string, at time.Time, runErr error) {
+	m.mu.Lock()
+	job := m.byName[name]
+	if job != nil {
+		job.running = false
+		job.lastRunFailed = runErr != nil
+		if runErr == nil {
+			job.lastSuccessAt = at
+		}
+	}
+	m.mu.Unlock()
+
+	if job != nil && runErr == nil && m.cache != nil {
+		// Best effort: without Redis the in-memory time is still reported
+		_ = m.cache.Set(ctx, lastSuccessKey(name), at.UTC().Format(time.RFC3339), lastSuccessTTL)
+	}
+}
+
+// health reports the status of every job at now. A job is overdue when it
+// hasn't succeeded since the first run scheduled after its last success (or
+// after the monitor started), allowing the job's timeout to finish.
+func (m *jobMonitor) health(ctx context.Context, now time.Time) JobHealth {
+	m.mu.Lock()
+	jobs := make([]trackedJob, len(m.jobs))
+	for i, job := range m.jobs {
+		jobs[i] = *job
+	}
+	m.mu.Unlock()
+
+	report := JobHealth{
+		Status:    JobHealthOK,
+		CheckedAt: now,
+		Overdue:   []string{},
+		Jobs:      make([]JobStatus, 0, len(jobs)),
+	}
+	for _, job := range jobs {
+		lastSuccess := job.lastSuccessAt
+		if stored := m.storedLastSuccess(ctx, job.name); stored.After(lastSuccess) {
+			lastSuccess = stored
+		}
+
+		reference := m.startedAt
+		if lastSuccess.After(reference) {
+			reference = lastSuccess
+		}
+		dueBy := job.schedule.Next(reference).Add(job.timeout)
+
+		status := JobStatus{
+			Name:          job.name,
+			Schedule:      job.spec,
+			Critical:      job.critical,
+			Running:       job.running,
+			LastRunFailed: job.lastRunFailed,
+			DueBy:         dueBy,
+			Overdue:       now.After(dueBy),
+		}
+		if !job.lastRunAt.IsZero() {
+			lastRun := job.lastRunAt
+			status.LastRunAt = &lastRun
+		}
+		if !lastSuccess.IsZero() {
+			status.LastSuccessAt = &lastSuccess
+		}
+
+		if status.Overdue {
+			report.Overdue = append(report.Overdue, job.name)
+			if job.critical {
+				report.Status = JobHealthDegraded
+			}
+		}
+		report.Jobs = append(report.Jobs, status)
+	}
+
+	return report
+}
+
+// storedLastSuccess reads a job's last success from Redis, or zero
+func (m *jobMonitor) storedLastSuccess(ctx context.Context, name string) time.Time {
+	if m.cache == nil {
+		return time.Time{}
+	}
+	value, err := m.cache.Get(ctx, lastSuccessKey(name))
+	if err != nil {
+		return time.Time{}
+	}
+	at, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}
+	}
+	return at
+}
+
+// lastSuccessKey is the Redis key of a job's last successful run
+func lastSuccessKey(name string) string {
+	return "cron:last_success:" + name
+}
@@ -0,0 +1,101 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/pkg/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestJobMonitor_Health(t *testing.T) {
+	ctx := context.Background()
+	started := time.Date(2026, 10, 15, 12, 0, 0, 0, time.Local)
+	monitor := newJobMonitor(nil, started)
+	require.NoError(t, monitor.register("nightly", "0 2 * * *", 30*time.Minute, true))
+	require.NoError(t, monitor.register("hourly", "0 * * * *", 10*time.Minute, false))
+	assert.Error(t, monitor.register("hourly", "0 * * * *", 10*time.Minute, false))
+	assert.Error(t, monitor.register("broken", "not a schedule", time.Minute, false))
+
+	// Before the first scheduled runs are due, nothing is overdue
+	report := monitor.health(ctx, started.Add(time.Hour))
+	assert.Equal(t, JobHealthOK, report.Status)
+	assert.Empty(t, report.Overdue)
+	require.Len(t, report.Jobs, 2)
+	assert.Equal(t, started.Add(24*time.Hour-10*time.Hour+30*time.Minute), report.Jobs[0].DueBy)
+
+	// An hourly job that never succeeded is overdue, but isn't critical
+	monitor.started("hourly", started.Add(time.Hour))
+	monitor.finished(ctx, "hourly", started.Add(time.Hour+time.Minute), errors.New("database unavailable"))
+	report = monitor.health(ctx, started.Add(2*time.Hour))
+	assert.Equal(t, JobHealthOK, report.Status)
+	assert.Equal(t, []string{"hourly"}, report.Overdue)
+	assert.True(t, report.Jobs[1].LastRunFailed)
+	assert.NotNil(t, report.Jobs[1].LastRunAt)
+	assert.Nil(t, report.Jobs[1].LastSuccessAt)
+
+	// A missed nightly run degrades health once its timeout has passed
+	nightly := time.Date(2026, 10, 16, 2, 0, 0, 0, time.Local)
+	assert.Equal(t, JobHealthOK, monitor.health(ctx, nightly.Add(20*time.Minute)).Status)
+	report = monitor.health(ctx, nightly.Add(31*time.Minute))
+	assert.Equal(t, JobHealthDegraded, report.Status)
+	assert.Contains(t, report.Overdue, "nightly")
+
+	// A success clears it until the next run is due
+	monitor.started("nightly", nightly.Add(32*time.Minute))
+	monitor.finished(ctx, "nightly", nightly.Add(40*time.Minute), nil)
+	report = monitor.health(ctx, nightly.Add(time.Hour))
+	assert.NotContains(t, report.Overdue, "nightly")
+	assert.False(t, report.Jobs[0].LastRunFailed)
+	assert.Equal(t, nightly.Add(24*time.Hour+30*time.Minute), report.Jobs[0].DueBy)
+}
+
+func TestJobMonitor_SharesLastSuccess(t *testing.T) {
+	ctx := context.Background()
+	mr := miniredis.RunT(t)
+	cacheClient, err := cache.NewClient("redis://" + mr.Addr())
+	require.NoError(t, err)
+	defer cacheClient.Close()
+
+	started := time.Date(2026, 10, 15, 12, 0, 0, 0, time.Local)
+	first := newJobMonitor(cacheClient, started)
+	require.NoError(t, first.register("nightly", "0 2 * * *", 30*time.Minute, true))
+	succeeded := time.Date(2026, 10, 16, 2, 10, 0, 0, time.Local)
+	first.finished(ctx, "nightly", succeeded, nil)
+
+	// Another instance, or this one after a restart, sees the success
+	second := newJobMonitor(cacheClient, started)
+	require.NoError(t, second.register("nightly", "0 2 * * *", 30*time.Minute, true))
+	report := second.health(ctx, succeeded.Add(time.Hour))
+	assert.Equal(t, JobHealthOK, report.Status)
+	require.NotNil(t, report.Jobs[0].LastSuccessAt)
+	assert.True(t, report.Jobs[0].LastSuccessAt.Equal(succeeded))
+}
+
+func TestCronManager_JobHealth(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:jobs_health_test?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	cm := NewCronManager(client, nil, nil)
+	require.NoError(t, cm.SetupJobs())
+
+	report := cm.JobHealth(context.Background(), time.Now())
+	assert.Equal(t, JobHealthOK, report.Status)
+	assert.Empty(t, report.Overdue)
+
+	names := make([]string, len(report.Jobs))
+	for i, job := range report.Jobs {
+		names[i] = job.Name
+	}
+	assert.Equal(t, []string{
+		"data_population", "missing_combinations", "population_stats", "quality_scores",
+		"lead_status_sla", "usage_reset", "usage_rollup",
+	}, names)
+}
@@ -0,0 +1,294 @@
+package leadassignment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/contactattempt"
+	"github.com/jordanlanch/industrydb/ent/leadassignment"
+	"github.com/jordanlanch/industrydb/ent/leadchange"
+	"github.com/jordanlanch/industrydb/ent/leadnote"
+	"github.com/jordanlanch/industrydb/ent/leadstatushistory"
+)
+
+// Claim errors
+var (
+	// ErrLeadClaimed is returned when claiming a lead someone else holds
+	ErrLeadClaimed = errors.New("lead is already claimed")
+	// ErrNotClaimed is returned when releasing a lead the caller hasn't claimed
+	ErrNotClaimed = errors.New("lead is not claimed by you")
+)
+
+// SetClaimTimeout makes claims expire once the claimer hasn't worked the
+// lead (notes, contact attempts, edits or status changes) for timeout.
+// Zero, the default, keeps claims until they are released.
+func (s *Service) SetClaimTimeout(timeout time.Duration) {
+	s.claimTimeout = timeout
+}
+
+// ClaimLead assigns a lead nobody holds to userID. A claim is a
+// self-assignment recorded in the assignment history with type "claim".
+// Claiming a lead the user already holds returns the current assignment;
+// a lead held by someone else returns ErrLeadClaimed, unless their claim
+// has expired. Concurrent claims can't both succeed, since a lead has at
+// most one current assignment.
+func (s *Service) ClaimLead(ctx context.Context, leadID, userID int) (*AssignmentResponse, error) {
+	l, err := s.client.Lead.Get(ctx, leadID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("lead not found")
+		}
+		return nil, fmt.Errorf("failed to fetch lead: %w", err)
+	}
+	u, err := s.client.User.Get(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("user not found")
+		}
+		return nil, fmt.Errorf("failed to fetch user: %w", err)
+	}
+
+	current, err := s.client.LeadAssignment.
+		Query().
+		Where(
+			leadassignment.LeadID(leadID),
+			leadassignment.IsActive(true),
+		).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to fetch assignment: %w", err)
+	}
+
+	if current != nil {
+		if current.UserID == userID {
+			return claimResponse(current, l, u), nil
+		}
+
+		expired, err := s.claimExpired(ctx, current, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		if !expired {
+			return nil, ErrLeadClaimed
+		}
+		if _, err := s.endAssignment(ctx, current.ID, leadassignment.EndReasonExpired); err != nil {
+			return nil, err
+		}
+	}
+
+	assignment, err := s.client.LeadAssignment.
+		Create().
+		SetLeadID(leadID).
+		SetUserID(userID).
+		SetAssignedByUserID(userID).
+		SetAssignmentType(leadassignment.AssignmentTypeClaim).
+		SetAssignmentReason("claim").
+		SetIsActive(true).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			// Someone else claimed or was assigned the lead meanwhile
+			return nil, ErrLeadClaimed
+		}
+		return nil, fmt.Errorf("failed to create claim: %w", err)
+	}
+
+	return claimResponse(assignment, l, u), nil
+}
+
+// ReleaseLead ends the user's claim on a lead, returning it to the pool.
+// Only claims can be released; leads assigned to the user by someone else,
+// or not held by them at all, return ErrNotClaimed.
+func (s *Service) ReleaseLead(ctx context.Context, leadID, userID int) (*AssignmentResponse, error) {
+	l, err := s.client.Lead.Get(ctx, leadID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("lead not found")
+		}
+		return nil, fmt.Errorf("failed to fetch lead: %w", err)
+	}
+
+	claim, err := s.client.LeadAssignment.
+		Query().
+		Where(
+			leadassignment.LeadID(leadID),
+			leadassignment.UserID(userID),
+			leadassignment.AssignmentTypeEQ(leadassignment.AssignmentTypeClaim),
+			leadassignment.IsActive(true),
+		).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotClaimed
+		}
+		return nil, fmt.Errorf("failed to fetch claim: %w", err)
+	}
+
+	released, err := s.endAssignment(ctx, claim.ID, leadassignment.EndReasonReleased)
+	if err != nil {
+		return nil, err
+	}
+	if released == nil {
+		// Expired or reassigned meanwhile
+		return nil, ErrNotClaimed
+	}
+
+	return claimResponse(released, l, claim.Edges.User), nil
+}
+
+// ExpireClaims ends the claims whose claimer hasn't worked the lead for the
+// claim timeout, so abandoned leads return to the pool. It returns the
+// number of claims expired, and does nothing without a claim timeout.
+func (s *Service) ExpireClaims(ctx context.Context, now time.Time) (int, error) {
+	if s.claimTimeout <= 0 {
+		return 0, nil
+	}
+
+	// Claims younger than the timeout can't have expired yet
+	claims, err := s.client.LeadAssignment.
+		Query().
+		Where(
+			leadassignment.AssignmentTypeEQ(leadassignment.AssignmentTypeClaim),
+			leadassignment.IsActive(true),
+			leadassignment.AssignedAtLT(now.Add(-s.claimTimeout)),
+		).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch claims: %w", err)
+	}
+
+	expired := 0
+	for _, claim := range claims {
+		stale, err := s.claimExpired(ctx, claim, now)
+		if err != nil {
+			return expired, err
+		}
+		if !stale {
+			continue
+		}
+		ended, err := s.endAssignment(ctx, claim.ID, leadassignment.EndReasonExpired)
+		if err != nil {
+			return expired, err
+		}
+		if ended != nil {
+			expired++
+		}
+	}
+
+	return expired, nil
+}
+
+// claimExpired reports whether an assignment is a claim whose claimer
+// hasn't worked the lead for the claim timeout
+func (s *Service) claimExpired(ctx context.Context, a *ent.LeadAssignment, now time.Time) (bool, error) {
+	if s.claimTimeout <= 0 || a.AssignmentType != leadassignment.AssignmentTypeClaim {
+		return false, nil
+	}
+	lastActivity, err := s.lastActivity(ctx, a)
+	if err != nil {
+		return false, err
+	}
+	return now.Sub(lastActivity) >= s.claimTimeout, nil
+}
+
+// lastActivity returns when the assignee last worked the lead since it was
+// assigned: a note, contact attempt, field change or status change
+func (s *Service) lastActivity(ctx context.Context, a *ent.LeadAssignment) (time.Time, error) {
+	last := a.AssignedAt
+	latest := func(t time.Time) {
+		if t.After(last) {
+			last = t
+		}
+	}
+
+	note, err := s.client.LeadNote.Query().
+		Where(leadnote.LeadID(a.LeadID), leadnote.UserID(a.UserID)).
+		Order(ent.Desc(leadnote.FieldUpdatedAt)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return last, fmt.Errorf("failed to fetch lead notes: %w", err)
+	}
+	if note != nil {
+		latest(note.UpdatedAt)
+	}
+
+	attempt, err := s.client.ContactAttempt.Query().
+		Where(contactattempt.LeadID(a.LeadID), contactattempt.UserID(a.UserID)).
+		Order(ent.Desc(contactattempt.FieldCreatedAt)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return last, fmt.Errorf("failed to fetch contact attempts: %w", err)
+	}
+	if attempt != nil {
+		latest(attempt.CreatedAt)
+	}
+
+	change, err := s.client.LeadChange.Query().
+		Where(leadchange.LeadID(a.LeadID), leadchange.UserID(a.UserID)).
+		Order(ent.Desc(leadchange.FieldCreatedAt)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return last, fmt.Errorf("failed to fetch lead changes: %w", err)
+	}
+	if change != nil {
+		latest(change.CreatedAt)
+	}
+
+	status, err := s.client.LeadStatusHistory.Query().
+		Where(leadstatushistory.LeadID(a.LeadID), leadstatushistory.UserID(a.UserID)).
+		Order(ent.Desc(leadstatushistory.FieldCreatedAt)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return last, fmt.Errorf("failed to fetch status history: %w", err)
+	}
+	if status != nil {
+		latest(status.CreatedAt)
+	}
+
+	return last, nil
+}
+
+// endAssignment ends a current assignment. It returns nil when the
+// assignment had already ended.
+func (s *Service) endAssignment(ctx context.Context, assignmentID int, reason leadassignment.EndReason) (*ent.LeadAssignment, error) {
+	ended, err := s.client.LeadAssignment.
+		UpdateOneID(assignmentID).
+		Where(leadassignment.IsActive(true)).
+		SetIsActive(false).
+		SetEndedAt(time.Now()).
+		SetEndReason(reason).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to end assignment: %w", err)
+	}
+	return ended, nil
+}
+
+// claimResponse builds the response of a claim or release
+func claimResponse(a *ent.LeadAssignment, l *ent.Lead, u *ent.User) *AssignmentResponse {
+	response := &AssignmentResponse{
+		ID:             a.ID,
+		LeadID:         a.LeadID,
+		LeadName:       l.Name,
+		UserID:         a.UserID,
+		AssignmentType: string(a.AssignmentType),
+		Reason:         a.AssignmentReason,
+		AssignedAt:     a.AssignedAt,
+		IsActive:       a.IsActive,
+		EndedAt:        a.EndedAt,
+	}
+	if u != nil {
+		response.UserName = u.Name
+	}
+	if a.EndReason != nil {
+		response.EndReason = string(*a.EndReason)
+	}
+	return response
+}
@@ -0,0 +1,215 @@
+package leadassignment
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/leadassignment"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClaimLead(t *testing.T) {
+	client, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	service := NewService(client)
+
+	rep1 := createTestUser(t, client, "rep1@test.com", "Rep 1")
+	rep2 := createTestUser(t, client, "rep2@test.com", "Rep 2")
+	lead := createTestLead(t, client, "Pool Studio")
+
+	claim, err := service.ClaimLead(ctx, lead.ID, rep1.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "claim", claim.AssignmentType)
+	assert.Equal(t, rep1.ID, claim.UserID)
+	assert.Equal(t, "Rep 1", claim.UserName)
+	assert.True(t, claim.IsActive)
+
+	t.Run("Claiming again keeps the claim", func(t *testing.T) {
+		again, err := service.ClaimLead(ctx, lead.ID, rep1.ID)
+		require.NoError(t, err)
+		assert.Equal(t, claim.ID, again.ID)
+	})
+
+	t.Run("Others can't claim or release it", func(t *testing.T) {
+		_, err := service.ClaimLead(ctx, lead.ID, rep2.ID)
+		assert.ErrorIs(t, err, ErrLeadClaimed)
+
+		_, err = service.ReleaseLead(ctx, lead.ID, rep2.ID)
+		assert.ErrorIs(t, err, ErrNotClaimed)
+	})
+
+	t.Run("Released leads can be claimed by others", func(t *testing.T) {
+		released, err := service.ReleaseLead(ctx, lead.ID, rep1.ID)
+		require.NoError(t, err)
+		assert.False(t, released.IsActive)
+		assert.Equal(t, "released", released.EndReason)
+		assert.NotNil(t, released.EndedAt)
+
+		_, err = service.ReleaseLead(ctx, lead.ID, rep1.ID)
+		assert.ErrorIs(t, err, ErrNotClaimed)
+
+		claim2, err := service.ClaimLead(ctx, lead.ID, rep2.ID)
+		require.NoError(t, err)
+		assert.Equal(t, rep2.ID, claim2.UserID)
+
+		history, err := service.GetLeadAssignmentHistory(ctx, lead.ID)
+		require.NoError(t, err)
+		require.Len(t, history, 2)
+		assert.Equal(t, "claim", history[1].AssignmentType)
+		assert.Equal(t, "released", history[1].EndReason)
+	})
+
+	t.Run("Lead not found", func(t *testing.T) {
+		_, err := service.ClaimLead(ctx, 99999, rep1.ID)
+		require.Error(t, err)
+		assert.Equal(t, "lead not found", err.Error())
+	})
+}
+
+func TestClaimLead_AssignedLeadIsClaimed(t *testing.T) {
+	client, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	service := NewService(client)
+
+	manager := createTestUser(t, client, "manager@test.com", "Manager")
+	rep1 := createTestUser(t, client, "rep1@test.com", "Rep 1")
+	rep2 := createTestUser(t, client, "rep2@test.com", "Rep 2")
+	lead := createTestLead(t, client, "Assigned Studio")
+
+	_, err := service.AssignLead(ctx, AssignLeadRequest{LeadID: lead.ID, UserID: rep1.ID}, manager.ID)
+	require.NoError(t, err)
+
+	_, err = service.ClaimLead(ctx, lead.ID, rep2.ID)
+	assert.ErrorIs(t, err, ErrLeadClaimed)
+
+	// Assignments made by someone else aren't claims to release
+	_, err = service.ReleaseLead(ctx, lead.ID, rep1.ID)
+	assert.ErrorIs(t, err, ErrNotClaimed)
+
+	// Reassigning ends the claim with a reason
+	claimLead := createTestLead(t, client, "Claimed Studio")
+	_, err = service.ClaimLead(ctx, claimLead.ID, rep1.ID)
+	require.NoError(t, err)
+	_, err = service.AssignLead(ctx, AssignLeadRequest{LeadID: claimLead.ID, UserID: rep2.ID}, manager.ID)
+	require.NoError(t, err)
+
+	history, err := service.GetLeadAssignmentHistory(ctx, claimLead.ID)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, "reassigned", history[1].EndReason)
+}
+
+func TestClaimLead_OneCurrentAssignment(t *testing.T) {
+	client, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	rep1 := createTestUser(t, client, "rep1@test.com", "Rep 1")
+	rep2 := createTestUser(t, client, "rep2@test.com", "Rep 2")
+	lead := createTestLead(t, client, "Race Studio")
+
+	_, err := NewService(client).ClaimLead(ctx, lead.ID, rep1.ID)
+	require.NoError(t, err)
+
+	// A racing claim that passed the check still can't be saved
+	_, err = client.LeadAssignment.Create().
+		SetLeadID(lead.ID).
+		SetUserID(rep2.ID).
+		SetAssignmentType(leadassignment.AssignmentTypeClaim).
+		Save(ctx)
+	assert.True(t, ent.IsConstraintError(err))
+}
+
+func TestClaimLead_ExpiredClaimCanBeTaken(t *testing.T) {
+	client, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	service := NewService(client)
+	service.SetClaimTimeout(time.Millisecond)
+
+	rep1 := createTestUser(t, client, "rep1@test.com", "Rep 1")
+	rep2 := createTestUser(t, client, "rep2@test.com", "Rep 2")
+	lead := createTestLead(t, client, "Abandoned Studio")
+
+	_, err := service.ClaimLead(ctx, lead.ID, rep1.ID)
+	require.NoError(t, err)
+	time.Sleep(5 * time.Millisecond)
+
+	claim, err := service.ClaimLead(ctx, lead.ID, rep2.ID)
+	require.NoError(t, err)
+	assert.Equal(t, rep2.ID, claim.UserID)
+
+	history, err := service.GetLeadAssignmentHistory(ctx, lead.ID)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, "expired", history[1].EndReason)
+}
+
+func TestExpireClaims(t *testing.T) {
+	client, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	service := NewService(client)
+
+	rep := createTestUser(t, client, "rep@test.com", "Rep")
+	idle := createTestLead(t, client, "Idle Studio")
+	worked := createTestLead(t, client, "Worked Studio")
+
+	_, err := service.ClaimLead(ctx, idle.ID, rep.ID)
+	require.NoError(t, err)
+	_, err = service.ClaimLead(ctx, worked.ID, rep.ID)
+	require.NoError(t, err)
+
+	now := time.Now()
+	_, err = client.LeadNote.Create().
+		SetLeadID(worked.ID).
+		SetUserID(rep.ID).
+		SetContent("Called, follow up Friday").
+		SetUpdatedAt(now.Add(90 * time.Minute)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	t.Run("Without a timeout claims never expire", func(t *testing.T) {
+		expired, err := service.ExpireClaims(ctx, now.Add(24*time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, 0, expired)
+	})
+
+	service.SetClaimTimeout(time.Hour)
+
+	t.Run("Claims within the timeout are kept", func(t *testing.T) {
+		expired, err := service.ExpireClaims(ctx, now.Add(30*time.Minute))
+		require.NoError(t, err)
+		assert.Equal(t, 0, expired)
+	})
+
+	t.Run("Only claims without recent activity expire", func(t *testing.T) {
+		expired, err := service.ExpireClaims(ctx, now.Add(2*time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, 1, expired)
+
+		current, err := service.GetCurrentAssignment(ctx, idle.ID)
+		require.NoError(t, err)
+		assert.Nil(t, current)
+
+		current, err = service.GetCurrentAssignment(ctx, worked.ID)
+		require.NoError(t, err)
+		require.NotNil(t, current)
+		assert.Equal(t, rep.ID, current.UserID)
+	})
+
+	t.Run("Worked claims expire once idle for the timeout", func(t *testing.T) {
+		expired, err := service.ExpireClaims(ctx, now.Add(3*time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, 1, expired)
+	})
+}
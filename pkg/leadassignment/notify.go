@@ -0,0 +1,170 @@
+package leadassignment
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"log"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/pkg/notification"
+)
+
+// EventLeadAssigned is the webhook event sent to a rep's webhooks when a
+// lead is assigned to them
+const EventLeadAssigned = "lead.assigned"
+
+// Notifier abstracts email sending for assignment notifications. It is
+// satisfied by leadlifecycle.EmailNotifier.
+type Notifier interface {
+	SendEmail(toEmail, toName, subject, htmlBody, plainTextBody string) error
+}
+
+// WebhookTrigger delivers an event to a user's webhooks and to the webhooks
+// of their organizations. It is satisfied by *webhook.Service.
+type WebhookTrigger interface {
+	TriggerWebhooks(ctx context.Context, userID int, event string, data map[string]interface{})
+	TriggerOrganizationWebhooks(ctx context.Context, userID int, event string, data map[string]interface{})
+}
+
+// PreferenceChecker reports whether a user wants a notification on a
+// channel. It is satisfied by *notification.Service.
+type PreferenceChecker interface {
+	Allows(ctx context.Context, userID int, eventType, channel string) bool
+}
+
+// SetNotifier sets the notifier used to email reps about new assignments.
+// Without a notifier, no assignment emails are sent.
+func (s *Service) SetNotifier(n Notifier) {
+	s.notifier = n
+}
+
+// SetWebhookTrigger sets where lead.assigned webhook events are sent.
+// Without a trigger, no assignment webhooks are sent.
+func (s *Service) SetWebhookTrigger(w WebhookTrigger) {
+	s.webhooks = w
+}
+
+// SetPreferences sets the notification preferences consulted before
+// notifying a rep. Without it, every rep gets emails and webhooks.
+func (s *Service) SetPreferences(p PreferenceChecker) {
+	s.preferences = p
+}
+
+// allows reports whether the rep wants lead_assigned notifications on the
+// channel
+func (s *Service) allows(ctx context.Context, userID int, channel string) bool {
+	return s.preferences == nil || s.preferences.Allows(ctx, userID, notification.EventLeadAssigned, channel)
+}
+
+// NotifyAssignment tells the assigned rep about an assignment by email and
+// through their webhooks subscribed to lead.assigned, on whichever channels
+// their lead_assigned notification preferences allow. Reps assigning a lead to themselves are not
+// notified. The webhooks of the rep's organizations get lead.assigned
+// regardless of the rep's preferences, since they belong to the
+// organization. Failures are logged; it is meant to run in the background.
+func (s *Service) NotifyAssignment(ctx context.Context, assignment *AssignmentResponse, assignedBy int) {
+	if assignment.UserID == assignedBy || (s.notifier == nil && s.webhooks == nil) {
+		return
+	}
+
+	rep, err := s.client.User.Get(ctx, assignment.UserID)
+	if err != nil {
+		log.Printf("Failed to fetch rep %d for assignment %d: %v", assignment.UserID, assignment.ID, err)
+		return
+	}
+	l, err := s.client.Lead.Get(ctx, assignment.LeadID)
+	if err != nil {
+		log.Printf("Failed to fetch lead %d for assignment %d: %v", assignment.LeadID, assignment.ID, err)
+		return
+	}
+	assignerName := ""
+	if assigner, err := s.client.User.Get(ctx, assignedBy); err == nil {
+		assignerName = assigner.Name
+	} else if !ent.IsNotFound(err) {
+		log.Printf("Failed to fetch assigner %d for assignment %d: %v", assignedBy, assignment.ID, err)
+	}
+
+	if s.webhooks != nil {
+		data := map[string]interface{}{
+			"assignment_id":       assignment.ID,
+			"assignment_type":     assignment.AssignmentType,
+			"reason":              assignment.Reason,
+			"assigned_to_user_id": rep.ID,
+			"assigned_by_user_id": assignedBy,
+			"assigned_by_name":    assignerName,
+			"lead":                leadSummary(l),
+		}
+		if s.allows(ctx, rep.ID, notification.ChannelWebhook) {
+			s.webhooks.TriggerWebhooks(ctx, rep.ID, EventLeadAssigned, data)
+		}
+		s.webhooks.TriggerOrganizationWebhooks(ctx, rep.ID, EventLeadAssigned, data)
+	}
+
+	if s.notifier != nil && rep.Email != "" && s.allows(ctx, rep.ID, notification.ChannelEmail) {
+		s.emailAssignment(rep, l, assignment, assignerName)
+	}
+}
+
+// leadSummary is the lead as included in lead.assigned webhook payloads
+func leadSummary(l *ent.Lead) map[string]interface{} {
+	return map[string]interface{}{
+		"id":            l.ID,
+		"name":          l.Name,
+		"industry":      string(l.Industry),
+		"city":          l.City,
+		"country":       l.Country,
+		"email":         l.Email,
+		"phone":         l.Phone,
+		"website":       l.Website,
+		"quality_score": l.QualityScore,
+	}
+}
+
+// emailAssignment emails the rep the lead summary and who assigned it
+func (s *Service) emailAssignment(rep *ent.User, l *ent.Lead, assignment *AssignmentResponse, assignerName string) {
+	assignedBy := assignerName
+	if assignment.AssignmentType == "auto" {
+		assignedBy = "Auto-assignment"
+		if assignerName != "" {
+			assignedBy = fmt.Sprintf("Auto-assignment (requested by %s)", assignerName)
+		}
+	} else if assignedBy == "" {
+		assignedBy = "A teammate"
+	}
+	location := l.City
+	if l.Country != "" {
+		location = fmt.Sprintf("%s, %s", l.City, l.Country)
+	}
+
+	subject := fmt.Sprintf("New lead assigned: %s", l.Name)
+	htmlBody := fmt.Sprintf(`
+		<html>
+		<body>
+			<p>Hi %s,</p>
+			<p><strong>%s</strong> assigned you the lead <strong>%s</strong> (%s, %s).</p>
+			<p>Quality score: %d<br>Reason: %s</p>
+			<p>To stop these emails, turn off lead assignment emails in your notification preferences.</p>
+			<p>Thanks,<br>The IndustryDB Team</p>
+		</body>
+		</html>
+	`, html.EscapeString(rep.Name), html.EscapeString(assignedBy), html.EscapeString(l.Name),
+		html.EscapeString(string(l.Industry)), html.EscapeString(location), l.QualityScore, html.EscapeString(assignment.Reason))
+	plainText := fmt.Sprintf(`
+Hi %s,
+
+%s assigned you the lead %s (%s, %s).
+
+Quality score: %d
+Reason: %s
+
+To stop these emails, turn off lead assignment emails in your notification preferences.
+
+Thanks,
+The IndustryDB Team
+	`, rep.Name, assignedBy, l.Name, l.Industry, location, l.QualityScore, assignment.Reason)
+
+	if err := s.notifier.SendEmail(rep.Email, rep.Name, subject, htmlBody, plainText); err != nil {
+		log.Printf("Failed to notify user %d about assignment %d: %v", rep.ID, assignment.ID, err)
+	}
+}
@@ -0,0 +1,115 @@
+package leadassignment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jordanlanch/industrydb/pkg/notification"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type sentEmail struct {
+	to, subject, body string
+}
+
+type fakeNotifier struct {
+	sent []sentEmail
+}
+
+func (n *fakeNotifier) SendEmail(toEmail, toName, subject, htmlBody, plainTextBody string) error {
+	n.sent = append(n.sent, sentEmail{to: toEmail, subject: subject, body: plainTextBody})
+	return nil
+}
+
+type triggeredEvent struct {
+	userID int
+	event  string
+	data   map[string]interface{}
+}
+
+type fakeWebhooks struct {
+	triggered    []triggeredEvent
+	orgTriggered []triggeredEvent
+}
+
+func (w *fakeWebhooks) TriggerWebhooks(ctx context.Context, userID int, event string, data map[string]interface{}) {
+	w.triggered = append(w.triggered, triggeredEvent{userID: userID, event: event, data: data})
+}
+
+func (w *fakeWebhooks) TriggerOrganizationWebhooks(ctx context.Context, userID int, event string, data map[string]interface{}) {
+	w.orgTriggered = append(w.orgTriggered, triggeredEvent{userID: userID, event: event, data: data})
+}
+
+func TestNotifyAssignment(t *testing.T) {
+	client, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	notifier := &fakeNotifier{}
+	webhooks := &fakeWebhooks{}
+	service := NewService(client)
+	service.SetNotifier(notifier)
+	service.SetWebhookTrigger(webhooks)
+	preferences := notification.NewService(client)
+	service.SetPreferences(preferences)
+
+	manager := createTestUser(t, client, "manager@test.com", "Manager")
+	rep := createTestUser(t, client, "rep@test.com", "Rep")
+	lead := createTestLead(t, client, "Ink Studio")
+
+	assign := func(userID, assignedBy int) *AssignmentResponse {
+		result, err := service.AssignLead(ctx, AssignLeadRequest{LeadID: lead.ID, UserID: userID}, assignedBy)
+		require.NoError(t, err)
+		return result
+	}
+
+	t.Run("Emails the rep and triggers lead.assigned", func(t *testing.T) {
+		service.NotifyAssignment(ctx, assign(rep.ID, manager.ID), manager.ID)
+
+		require.Len(t, notifier.sent, 1)
+		assert.Equal(t, "rep@test.com", notifier.sent[0].to)
+		assert.Equal(t, "New lead assigned: Ink Studio", notifier.sent[0].subject)
+		assert.Contains(t, notifier.sent[0].body, "Manager assigned you the lead Ink Studio")
+
+		require.Len(t, webhooks.triggered, 1)
+		assert.Equal(t, rep.ID, webhooks.triggered[0].userID)
+		assert.Equal(t, EventLeadAssigned, webhooks.triggered[0].event)
+		assert.Equal(t, manager.ID, webhooks.triggered[0].data["assigned_by_user_id"])
+		assert.Equal(t, "Ink Studio", webhooks.triggered[0].data["lead"].(map[string]interface{})["name"])
+		assert.Equal(t, webhooks.triggered, webhooks.orgTriggered)
+	})
+
+	t.Run("Self-assignment is not notified", func(t *testing.T) {
+		notifier.sent, webhooks.triggered, webhooks.orgTriggered = nil, nil, nil
+		service.NotifyAssignment(ctx, assign(manager.ID, manager.ID), manager.ID)
+
+		assert.Empty(t, notifier.sent)
+		assert.Empty(t, webhooks.triggered)
+		assert.Empty(t, webhooks.orgTriggered)
+	})
+
+	t.Run("Preferences choose the channels", func(t *testing.T) {
+		off := false
+		notifier.sent, webhooks.triggered = nil, nil
+		_, err := preferences.Update(ctx, rep.ID, []notification.UpdatePreferenceRequest{
+			{EventType: notification.EventLeadAssigned, Email: &off},
+		})
+		require.NoError(t, err)
+		service.NotifyAssignment(ctx, assign(rep.ID, manager.ID), manager.ID)
+
+		assert.Empty(t, notifier.sent)
+		assert.Len(t, webhooks.triggered, 1)
+
+		notifier.sent, webhooks.triggered, webhooks.orgTriggered = nil, nil, nil
+		_, err = preferences.Update(ctx, rep.ID, []notification.UpdatePreferenceRequest{
+			{EventType: notification.EventLeadAssigned, Webhook: &off},
+		})
+		require.NoError(t, err)
+		service.NotifyAssignment(ctx, assign(rep.ID, manager.ID), manager.ID)
+
+		assert.Empty(t, notifier.sent)
+		assert.Empty(t, webhooks.triggered)
+		assert.Len(t, webhooks.orgTriggered, 1, "organization webhooks ignore the rep's preferences")
+	})
+}
@@ -13,7 +13,12 @@ import (
 
 // Service handles lead assignment operations.
 type Service struct {
-	client *ent.Client
+	client      *ent.Client
+	notifier    Notifier          // Assignment emails, nil sends none
+	webhooks    WebhookTrigger    // lead.assigned webhooks, nil sends none
+	preferences PreferenceChecker // Per-user channel preferences, nil allows all
+
+	claimTimeout time.Duration // Inactivity after which claims expire, zero never
 }
 
 // NewService creates a new lead assignment service.
@@ -23,15 +28,17 @@ func NewService(client *ent.Client) *Service {
 
 // AssignmentResponse represents a lead assignment.
 type AssignmentResponse struct {
-	ID             int       `json:"id"`
-	LeadID         int       `json:"lead_id"`
-	LeadName       string    `json:"lead_name"`
-	UserID         int       `json:"user_id"`
-	UserName       string    `json:"user_name"`
-	AssignmentType string    `json:"assignment_type"` // "auto" or "manual"
-	Reason         string    `json:"reason,omitempty"`
-	AssignedAt     time.Time `json:"assigned_at"`
-	IsActive       bool      `json:"is_active"`
+	ID             int        `json:"id"`
+	LeadID         int        `json:"lead_id"`
+	LeadName       string     `json:"lead_name"`
+	UserID         int        `json:"user_id"`
+	UserName       string     `json:"user_name"`
+	AssignmentType string     `json:"assignment_type"` // "auto", "manual" or "claim"
+	Reason         string     `json:"reason,omitempty"`
+	AssignedAt     time.Time  `json:"assigned_at"`
+	IsActive       bool       `json:"is_active"`
+	EndedAt        *time.Time `json:"ended_at,omitempty"`
+	EndReason      string     `json:"end_reason,omitempty"` // "reassigned", "released" or "expired"
 }
 
 // AssignLeadRequest represents a manual assignment request.
@@ -81,6 +88,8 @@ func (s *Service) AssignLead(ctx context.Context, req AssignLeadRequest, assigne
 			leadassignment.IsActive(true),
 		).
 		SetIsActive(false).
+		SetEndedAt(time.Now()).
+		SetEndReason(leadassignment.EndReasonReassigned).
 		Save(ctx)
 	if err != nil {
 		tx.Rollback()
@@ -199,6 +208,8 @@ func (s *Service) AutoAssignLead(ctx context.Context, leadID int) (*AssignmentRe
 			leadassignment.IsActive(true),
 		).
 		SetIsActive(false).
+		SetEndedAt(time.Now()).
+		SetEndReason(leadassignment.EndReasonReassigned).
 		Save(ctx)
 	if err != nil {
 		tx.Rollback()
@@ -333,6 +344,10 @@ func (s *Service) GetLeadAssignmentHistory(ctx context.Context, leadID int) ([]A
 			Reason:         a.AssignmentReason,
 			AssignedAt:     a.AssignedAt,
 			IsActive:       a.IsActive,
+			EndedAt:        a.EndedAt,
+		}
+		if a.EndReason != nil {
+			result[i].EndReason = string(*a.EndReason)
 		}
 	}
 
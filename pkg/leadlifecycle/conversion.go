@@ -0,0 +1,129 @@
+package leadlifecycle
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/leadassignment"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+)
+
+// ConversionStats summarizes the lifecycle of a set of leads. A lead is
+// converted once it is won.
+type ConversionStats struct {
+	TotalLeads           int            `json:"total_leads"`
+	AssignedLeads        int            `json:"assigned_leads"`
+	StatusCounts         map[string]int `json:"status_counts"`
+	Converted            int            `json:"converted"`
+	Lost                 int            `json:"lost"`
+	ConversionRate       float64        `json:"conversion_rate"`         // Percentage of leads won
+	WinRate              float64        `json:"win_rate"`                // Percentage of closed (won or lost) leads won
+	AvgHoursToConversion float64        `json:"avg_hours_to_conversion"` // From first assignment (or creation) to won
+}
+
+// GetConversionStats computes the conversion stats of the leads matching preds
+func (s *Service) GetConversionStats(ctx context.Context, preds ...predicate.Lead) (*ConversionStats, error) {
+	counts, err := s.statusCounts(ctx, preds...)
+	if err != nil {
+		return nil, err
+	}
+
+	assigned, err := s.client.Lead.Query().
+		Where(append(preds, lead.HasAssignmentsWith(leadassignment.IsActive(true)))...).
+		Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count assigned leads: %w", err)
+	}
+
+	won, err := s.client.Lead.Query().
+		Where(append(preds, lead.StatusEQ(lead.StatusWon))...).
+		WithAssignments().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load converted leads: %w", err)
+	}
+
+	stats := &ConversionStats{
+		AssignedLeads: assigned,
+		StatusCounts:  counts,
+		Converted:     counts[string(StatusWon)],
+		Lost:          counts[string(StatusLost)],
+	}
+	for _, count := range counts {
+		stats.TotalLeads += count
+	}
+
+	var totalHours float64
+	for _, l := range won {
+		totalHours += hoursToConversion(l)
+	}
+	if len(won) > 0 {
+		stats.AvgHoursToConversion = roundTwo(totalHours / float64(len(won)))
+	}
+	stats.computeRates()
+
+	return stats, nil
+}
+
+// CombineConversionStats adds up the stats of disjoint sets of leads, such
+// as the leads of several territories
+func CombineConversionStats(stats ...ConversionStats) ConversionStats {
+	combined := ConversionStats{StatusCounts: make(map[string]int)}
+
+	var totalHours float64
+	for _, st := range stats {
+		combined.TotalLeads += st.TotalLeads
+		combined.AssignedLeads += st.AssignedLeads
+		combined.Converted += st.Converted
+		combined.Lost += st.Lost
+		for status, count := range st.StatusCounts {
+			combined.StatusCounts[status] += count
+		}
+		totalHours += st.AvgHoursToConversion * float64(st.Converted)
+	}
+	if combined.Converted > 0 {
+		combined.AvgHoursToConversion = roundTwo(totalHours / float64(combined.Converted))
+	}
+	combined.computeRates()
+
+	return combined
+}
+
+// computeRates derives the conversion and win rates from the counts
+func (s *ConversionStats) computeRates() {
+	s.ConversionRate = percentage(s.Converted, s.TotalLeads)
+	s.WinRate = percentage(s.Converted, s.Converted+s.Lost)
+}
+
+// hoursToConversion returns the hours from a won lead's first assignment, or
+// its creation when it was never assigned, to when it was won
+func hoursToConversion(l *ent.Lead) float64 {
+	start := l.CreatedAt
+	for i, a := range l.Edges.Assignments {
+		if i == 0 || a.AssignedAt.Before(start) {
+			start = a.AssignedAt
+		}
+	}
+
+	if l.StatusChangedAt.Before(start) {
+		return 0
+	}
+	return l.StatusChangedAt.Sub(start).Hours()
+}
+
+// percentage returns numerator/denominator as a percentage rounded to 2
+// decimal places
+func percentage(numerator, denominator int) float64 {
+	if denominator == 0 {
+		return 0
+	}
+	return roundTwo(float64(numerator) / float64(denominator) * 100)
+}
+
+// roundTwo rounds to 2 decimal places
+func roundTwo(v float64) float64 {
+	return math.Round(v*100) / 100
+}
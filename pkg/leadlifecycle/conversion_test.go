@@ -0,0 +1,62 @@
+package leadlifecycle
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetConversionStats(t *testing.T) {
+	client, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	service := NewService(client)
+	user := createTestUser(t, client, "rep@example.com", "Rep")
+	now := time.Now()
+
+	won := createTestLead(t, client, "Won Studio")
+	_, err := client.LeadAssignment.Create().
+		SetLeadID(won.ID).
+		SetUserID(user.ID).
+		SetAssignedAt(now.Add(-48 * time.Hour)).
+		Save(ctx)
+	require.NoError(t, err)
+	require.NoError(t, won.Update().SetStatus(lead.StatusWon).SetStatusChangedAt(now).Exec(ctx))
+
+	lost := createTestLead(t, client, "Lost Studio")
+	require.NoError(t, lost.Update().SetStatus(lead.StatusLost).Exec(ctx))
+	createTestLead(t, client, "New Studio")
+	other := createTestLead(t, client, "Other City Studio")
+	require.NoError(t, other.Update().SetCity("Boston").Exec(ctx))
+
+	stats, err := service.GetConversionStats(ctx, lead.City("New York"))
+	require.NoError(t, err)
+	assert.Equal(t, 3, stats.TotalLeads)
+	assert.Equal(t, 1, stats.AssignedLeads)
+	assert.Equal(t, 1, stats.Converted)
+	assert.Equal(t, 1, stats.Lost)
+	assert.Equal(t, 1, stats.StatusCounts["new"])
+	assert.Equal(t, 33.33, stats.ConversionRate)
+	assert.Equal(t, 50.0, stats.WinRate)
+	assert.InDelta(t, 48, stats.AvgHoursToConversion, 0.01)
+}
+
+func TestCombineConversionStats(t *testing.T) {
+	combined := CombineConversionStats(
+		ConversionStats{TotalLeads: 4, Converted: 1, Lost: 1, AvgHoursToConversion: 10, StatusCounts: map[string]int{"won": 1, "lost": 1, "new": 2}},
+		ConversionStats{TotalLeads: 6, Converted: 3, AvgHoursToConversion: 30, StatusCounts: map[string]int{"won": 3, "new": 3}},
+		ConversionStats{},
+	)
+
+	assert.Equal(t, 10, combined.TotalLeads)
+	assert.Equal(t, 4, combined.Converted)
+	assert.Equal(t, 5, combined.StatusCounts["new"])
+	assert.Equal(t, 40.0, combined.ConversionRate)
+	assert.Equal(t, 80.0, combined.WinRate)
+	assert.Equal(t, 25.0, combined.AvgHoursToConversion)
+}
@@ -7,12 +7,17 @@ import (
 
 	"github.com/jordanlanch/industrydb/ent"
 	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/leadchange"
 	"github.com/jordanlanch/industrydb/ent/leadstatushistory"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+	"github.com/jordanlanch/industrydb/pkg/leads"
 )
 
 // Service handles lead lifecycle operations.
 type Service struct {
-	client *ent.Client
+	client      *ent.Client
+	notifier    Notifier
+	preferences PreferenceChecker
 }
 
 // NewService creates a new lead lifecycle service.
@@ -104,12 +109,18 @@ func (s *Service) UpdateLeadStatus(ctx context.Context, userID, leadID int, req
 		UpdateOne(currentLead).
 		SetStatus(lead.Status(newStatus)).
 		SetStatusChangedAt(now).
+		ClearSLAOverdueSince().
 		Save(ctx)
 	if err != nil {
 		tx.Rollback()
 		return nil, fmt.Errorf("failed to update lead status: %w", err)
 	}
 
+	if err := leads.RecordChange(ctx, tx.LeadChange, currentLead, updatedLead, leadchange.SourceManual, userID); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
 	// Create history record
 	historyBuilder := tx.LeadStatusHistory.
 		Create().
@@ -206,15 +217,16 @@ func (s *Service) GetLeadStatusHistory(ctx context.Context, leadID int) ([]Statu
 	return response, nil
 }
 
-// GetLeadsByStatus retrieves all leads with a specific status.
-func (s *Service) GetLeadsByStatus(ctx context.Context, status string, limit int) ([]LeadWithStatusResponse, error) {
+// GetLeadsByStatus retrieves the leads with a specific status among the
+// leads matching scope, such as an organization's visible leads.
+func (s *Service) GetLeadsByStatus(ctx context.Context, status string, limit int, scope ...predicate.Lead) ([]LeadWithStatusResponse, error) {
 	if limit <= 0 || limit > 100 {
 		limit = 50 // Default limit
 	}
 
 	leads, err := s.client.Lead.
 		Query().
-		Where(lead.StatusEQ(lead.Status(status))).
+		Where(append(scope, lead.StatusEQ(lead.Status(status)))...).
 		Order(ent.Desc(lead.FieldStatusChangedAt)).
 		Limit(limit).
 		All(ctx)
@@ -238,15 +250,20 @@ func (s *Service) GetLeadsByStatus(ctx context.Context, status string, limit int
 	return response, nil
 }
 
-// GetStatusCounts returns count of leads in each status.
-func (s *Service) GetStatusCounts(ctx context.Context) (map[string]int, error) {
+// GetStatusCounts returns count of leads matching scope in each status.
+func (s *Service) GetStatusCounts(ctx context.Context, scope ...predicate.Lead) (map[string]int, error) {
+	return s.statusCounts(ctx, scope...)
+}
+
+// statusCounts returns the count of leads matching preds in each status
+func (s *Service) statusCounts(ctx context.Context, preds ...predicate.Lead) (map[string]int, error) {
 	statuses := []string{"new", "contacted", "qualified", "negotiating", "won", "lost", "archived"}
 	counts := make(map[string]int)
 
 	for _, status := range statuses {
 		count, err := s.client.Lead.
 			Query().
-			Where(lead.StatusEQ(lead.Status(status))).
+			Where(append(preds, lead.StatusEQ(lead.Status(status)))...).
 			Count(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("failed to count leads for status %s: %w", status, err)
@@ -0,0 +1,385 @@
+package leadlifecycle
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/leadassignment"
+	"github.com/jordanlanch/industrydb/ent/leadstatushistory"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+	"github.com/jordanlanch/industrydb/ent/territory"
+	"github.com/jordanlanch/industrydb/ent/territorymember"
+	"github.com/jordanlanch/industrydb/pkg/email"
+	"github.com/jordanlanch/industrydb/pkg/leads"
+	"github.com/jordanlanch/industrydb/pkg/notification"
+)
+
+// StatusSLAs maps a lead status to the maximum time a lead may stay in it
+// before it is considered overdue. Statuses without an entry (or with a
+// zero duration) have no SLA.
+type StatusSLAs map[LeadStatus]time.Duration
+
+// DefaultStatusSLAs returns the default SLAs. Terminal statuses (won, lost,
+// archived) have no SLA.
+func DefaultStatusSLAs() StatusSLAs {
+	return StatusSLAs{
+		StatusNew:         2 * 24 * time.Hour,
+		StatusContacted:   3 * 24 * time.Hour,
+		StatusQualified:   7 * 24 * time.Hour,
+		StatusNegotiating: 14 * 24 * time.Hour,
+	}
+}
+
+// statusSLAs holds the SLAs used by FlagOverdueLeads.
+var statusSLAs = DefaultStatusSLAs()
+
+// SetStatusSLAs replaces the SLAs used by FlagOverdueLeads.
+// It is meant to be called once at startup from configuration.
+func SetStatusSLAs(slas StatusSLAs) {
+	statusSLAs = slas
+}
+
+// Notifier abstracts email sending for overdue lead notifications.
+type Notifier interface {
+	SendEmail(toEmail, toName, subject, htmlBody, plainTextBody string) error
+}
+
+// EmailNotifier adapts the email.Service to the Notifier interface.
+type EmailNotifier struct {
+	service *email.Service
+}
+
+// NewEmailNotifier creates a new notifier wrapping the email service.
+func NewEmailNotifier(s *email.Service) *EmailNotifier {
+	return &EmailNotifier{service: s}
+}
+
+// SendEmail sends an email using the underlying email service.
+func (n *EmailNotifier) SendEmail(toEmail, toName, subject, htmlBody, plainTextBody string) error {
+	return n.service.SendRawEmail(toEmail, toName, subject, htmlBody, plainTextBody)
+}
+
+// SetNotifier sets the notifier used to alert assigned reps when a lead
+// goes overdue. Without a notifier, overdue leads are only flagged.
+func (s *Service) SetNotifier(n Notifier) {
+	s.notifier = n
+}
+
+// PreferenceChecker reports whether a user wants a notification on a
+// channel. It is satisfied by *notification.Service.
+type PreferenceChecker interface {
+	Allows(ctx context.Context, userID int, eventType, channel string) bool
+}
+
+// SetPreferences sets the notification preferences consulted before
+// emailing a rep about an overdue lead. Without it, every rep is emailed.
+func (s *Service) SetPreferences(p PreferenceChecker) {
+	s.preferences = p
+}
+
+// SLACheckResult reports the outcome of an overdue lead check.
+type SLACheckResult struct {
+	Checked  int `json:"checked"`
+	Flagged  int `json:"flagged"`
+	Notified int `json:"notified"`
+}
+
+// OverdueLeadResponse represents a lead that exceeded its status SLA.
+type OverdueLeadResponse struct {
+	LeadWithStatusResponse
+	EnteredStatusAt time.Time `json:"entered_status_at"`
+	OverdueSince    time.Time `json:"overdue_since"`
+	HoursInStatus   int       `json:"hours_in_status"`
+	AssignedUserID  *int      `json:"assigned_user_id,omitempty"`
+}
+
+// enteredStatusTimes returns when each lead entered its current status,
+// taken from its most recent status history entry. Leads that never changed
+// status fall back to status_changed_at.
+func (s *Service) enteredStatusTimes(ctx context.Context, batch []*ent.Lead) (map[int]time.Time, error) {
+	ids := make([]int, len(batch))
+	current := make(map[int]string, len(batch))
+	entered := make(map[int]time.Time, len(batch))
+	for i, l := range batch {
+		ids[i] = l.ID
+		current[l.ID] = string(l.Status)
+		entered[l.ID] = l.StatusChangedAt
+	}
+
+	entries, err := s.client.LeadStatusHistory.
+		Query().
+		Where(leadstatushistory.LeadIDIn(ids...)).
+		Order(ent.Desc(leadstatushistory.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch status history: %w", err)
+	}
+
+	found := make(map[int]bool, len(batch))
+	for _, entry := range entries {
+		if found[entry.LeadID] || string(entry.NewStatus) != current[entry.LeadID] {
+			continue
+		}
+		found[entry.LeadID] = true
+		entered[entry.LeadID] = entry.CreatedAt
+	}
+	return entered, nil
+}
+
+// slaBatchSize is how many candidate leads FlagOverdueLeads loads at a time
+const slaBatchSize = 500
+
+// FlagOverdueLeads flags leads that have stayed in their current status
+// longer than its SLA and notifies the assigned rep, if any. Leads that are
+// already flagged are skipped, so each lead is notified once per status.
+// Only worked leads, assigned to a rep, in a territory or owned by an
+// organization, are held to SLAs: the rest of the catalog is new and stays
+// that way, so it is never scanned.
+func (s *Service) FlagOverdueLeads(ctx context.Context, now time.Time) (*SLACheckResult, error) {
+	result := &SLACheckResult{}
+
+	for status, sla := range statusSLAs {
+		if sla <= 0 {
+			continue
+		}
+
+		for lastID := 0; ; {
+			// status_changed_at moves with every status change, so it narrows
+			// the candidates before time-in-status is computed from history
+			candidates, err := s.client.Lead.
+				Query().
+				Where(
+					lead.IDGT(lastID),
+					lead.StatusEQ(lead.Status(status)),
+					lead.SLAOverdueSinceIsNil(),
+					lead.StatusChangedAtLTE(now.Add(-sla)),
+					lead.Or(
+						lead.HasAssignmentsWith(leadassignment.IsActive(true)),
+						lead.HasTerritory(),
+						lead.OwnerOrganizationIDNotNil(),
+					),
+				).
+				Order(ent.Asc(lead.FieldID)).
+				Limit(slaBatchSize).
+				All(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch leads for status %s: %w", status, err)
+			}
+			if len(candidates) == 0 {
+				break
+			}
+			lastID = candidates[len(candidates)-1].ID
+
+			if err := s.flagOverdueBatch(ctx, candidates, sla, now, result); err != nil {
+				return nil, err
+			}
+			if len(candidates) < slaBatchSize {
+				break
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// flagOverdueBatch flags the candidates past their SLA in a single update
+// and notifies their reps
+func (s *Service) flagOverdueBatch(ctx context.Context, candidates []*ent.Lead, sla time.Duration, now time.Time, result *SLACheckResult) error {
+	result.Checked += len(candidates)
+
+	entered, err := s.enteredStatusTimes(ctx, candidates)
+	if err != nil {
+		return err
+	}
+	var overdue []*ent.Lead
+	deadlines := make(map[int]time.Time)
+	for _, l := range candidates {
+		deadline := entered[l.ID].Add(sla)
+		if now.Before(deadline) {
+			continue
+		}
+		overdue = append(overdue, l)
+		deadlines[l.ID] = deadline
+	}
+	if len(overdue) == 0 {
+		return nil
+	}
+
+	// The flag is bookkeeping, so updated_at is left as is
+	ids := make([]int, len(overdue))
+	for i, l := range overdue {
+		ids[i] = l.ID
+	}
+	flagged, err := s.client.Lead.
+		Update().
+		Where(lead.IDIn(ids...), lead.SLAOverdueSinceIsNil()).
+		Modify(func(u *sql.UpdateBuilder) {
+			u.Set(lead.FieldSLAOverdueSince, deadlineCase(ids, deadlines))
+			leads.KeepUpdatedAt(u)
+		}).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to flag overdue leads: %w", err)
+	}
+	result.Flagged += flagged
+
+	if s.notifier == nil {
+		return nil
+	}
+	assignments, err := s.client.LeadAssignment.
+		Query().
+		Where(
+			leadassignment.LeadIDIn(ids...),
+			leadassignment.IsActive(true),
+		).
+		WithUser().
+		All(ctx)
+	if err != nil {
+		log.Printf("Failed to fetch assignments for overdue leads: %v", err)
+		return nil
+	}
+	reps := make(map[int]*ent.User, len(assignments))
+	for _, assignment := range assignments {
+		if _, ok := reps[assignment.LeadID]; !ok {
+			reps[assignment.LeadID] = assignment.Edges.User
+		}
+	}
+	for _, l := range overdue {
+		if s.notifyOverdue(ctx, l, reps[l.ID], entered[l.ID], now) {
+			result.Notified++
+		}
+	}
+	return nil
+}
+
+// deadlineCase is the SLA deadline of each lead in ids, as a SQL CASE on
+// the lead ID. The ELSE branch keeps the column's type for the parameters.
+func deadlineCase(ids []int, deadlines map[int]time.Time) sql.Querier {
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.WriteString("CASE ").Ident(lead.FieldID)
+		for _, id := range ids {
+			b.WriteString(" WHEN ").Arg(id).WriteString(" THEN ").Arg(deadlines[id])
+		}
+		b.WriteString(" ELSE ").Ident(lead.FieldSLAOverdueSince).WriteString(" END")
+	})
+}
+
+// notifyOverdue emails the rep currently assigned to an overdue lead, unless
+// they turned off lead_sla_overdue emails.
+// Notification failures are logged and do not stop the SLA check.
+func (s *Service) notifyOverdue(ctx context.Context, l *ent.Lead, rep *ent.User, entered, now time.Time) bool {
+	if rep == nil || rep.Email == "" {
+		return false
+	}
+	if s.preferences != nil && !s.preferences.Allows(ctx, rep.ID, notification.EventLeadSLAOverdue, notification.ChannelEmail) {
+		return false
+	}
+
+	days := int(now.Sub(entered).Hours() / 24)
+	subject := fmt.Sprintf("Lead overdue: %s", l.Name)
+	htmlBody := fmt.Sprintf(`
+		<html>
+		<body>
+			<p>Hi %s,</p>
+			<p>The lead <strong>%s</strong> has been in status <strong>%s</strong> for %d days, which exceeds its SLA.</p>
+			<p>Please follow up and move it forward.</p>
+			<p>Thanks,<br>The IndustryDB Team</p>
+		</body>
+		</html>
+	`, rep.Name, l.Name, l.Status, days)
+	plainText := fmt.Sprintf(`
+Hi %s,
+
+The lead %s has been in status %s for %d days, which exceeds its SLA.
+
+Please follow up and move it forward.
+
+Thanks,
+The IndustryDB Team
+	`, rep.Name, l.Name, l.Status, days)
+
+	if err := s.notifier.SendEmail(rep.Email, rep.Name, subject, htmlBody, plainText); err != nil {
+		log.Printf("Failed to notify user %d about overdue lead %d: %v", rep.ID, l.ID, err)
+		return false
+	}
+
+	return true
+}
+
+// GetOverdueLeads returns flagged overdue leads that are assigned to the user
+// or belong to one of the user's active territories, most overdue first.
+// Only leads matching scope are returned.
+func (s *Service) GetOverdueLeads(ctx context.Context, userID int, limit int, scope ...predicate.Lead) ([]OverdueLeadResponse, error) {
+	if limit <= 0 || limit > 100 {
+		limit = 50 // Default limit
+	}
+
+	leads, err := s.client.Lead.
+		Query().
+		Where(scope...).
+		Where(
+			lead.SLAOverdueSinceNotNil(),
+			lead.Or(
+				lead.HasAssignmentsWith(
+					leadassignment.UserID(userID),
+					leadassignment.IsActive(true),
+				),
+				lead.HasTerritoryWith(
+					territory.Active(true),
+					territory.HasMembersWith(territorymember.UserID(userID)),
+				),
+			),
+		).
+		WithAssignments(func(q *ent.LeadAssignmentQuery) {
+			q.Where(leadassignment.IsActive(true))
+		}).
+		Order(ent.Asc(lead.FieldSLAOverdueSince)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch overdue leads: %w", err)
+	}
+
+	if len(leads) == 0 {
+		return []OverdueLeadResponse{}, nil
+	}
+	enteredAt, err := s.enteredStatusTimes(ctx, leads)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	response := make([]OverdueLeadResponse, len(leads))
+	for i, l := range leads {
+		entered := enteredAt[l.ID]
+
+		var assignedUserID *int
+		if len(l.Edges.Assignments) > 0 {
+			id := l.Edges.Assignments[0].UserID
+			assignedUserID = &id
+		}
+
+		response[i] = OverdueLeadResponse{
+			LeadWithStatusResponse: LeadWithStatusResponse{
+				ID:              l.ID,
+				Name:            l.Name,
+				Status:          string(l.Status),
+				StatusChangedAt: l.StatusChangedAt,
+				Industry:        string(l.Industry),
+				Country:         l.Country,
+				City:            l.City,
+			},
+			EnteredStatusAt: entered,
+			OverdueSince:    *l.SLAOverdueSince,
+			HoursInStatus:   int(now.Sub(entered).Hours()),
+			AssignedUserID:  assignedUserID,
+		}
+	}
+
+	return response, nil
+}
@@ -0,0 +1,202 @@
+package leadlifecycle
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/leadstatushistory"
+	"github.com/jordanlanch/industrydb/pkg/notification"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingNotifier struct {
+	sent []string
+}
+
+func (n *recordingNotifier) SendEmail(toEmail, toName, subject, htmlBody, plainTextBody string) error {
+	n.sent = append(n.sent, toEmail)
+	return nil
+}
+
+func createLeadInStatus(t *testing.T, client *ent.Client, name string, status lead.Status, changedAt time.Time) *ent.Lead {
+	l, err := client.Lead.
+		Create().
+		SetName(name).
+		SetIndustry("tattoo").
+		SetCountry("US").
+		SetCity("New York").
+		SetStatus(status).
+		SetStatusChangedAt(changedAt).
+		Save(context.Background())
+	require.NoError(t, err)
+	return l
+}
+
+func recordStatusChange(t *testing.T, client *ent.Client, leadID, userID int, status string, at time.Time) {
+	_, err := client.LeadStatusHistory.
+		Create().
+		SetLeadID(leadID).
+		SetUserID(userID).
+		SetOldStatus(leadstatushistory.OldStatusNew).
+		SetNewStatus(leadstatushistory.NewStatus(status)).
+		SetCreatedAt(at).
+		Save(context.Background())
+	require.NoError(t, err)
+}
+
+func TestFlagOverdueLeads(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:leadlifecycle_sla?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	SetStatusSLAs(DefaultStatusSLAs())
+	defer SetStatusSLAs(DefaultStatusSLAs())
+
+	ctx := context.Background()
+	now := time.Now()
+	day := 24 * time.Hour
+
+	rep := createTestUser(t, client, "rep@example.com", "Rep")
+	member := createTestUser(t, client, "member@example.com", "Member")
+	other := createTestUser(t, client, "other@example.com", "Other")
+
+	// Contacted 5 days ago (SLA 3 days) and assigned to rep
+	stale := createLeadInStatus(t, client, "Stale Contacted", lead.StatusContacted, now.Add(-5*day))
+	recordStatusChange(t, client, stale.ID, rep.ID, "contacted", now.Add(-5*day))
+	_, err := client.LeadAssignment.Create().
+		SetLeadID(stale.ID).
+		SetUserID(rep.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// Contacted yesterday, within SLA
+	fresh := createLeadInStatus(t, client, "Fresh Contacted", lead.StatusContacted, now.Add(-1*day))
+	recordStatusChange(t, client, fresh.ID, rep.ID, "contacted", now.Add(-1*day))
+
+	// status_changed_at is old but history shows the status was re-entered recently
+	reentered := createLeadInStatus(t, client, "Re-entered Contacted", lead.StatusContacted, now.Add(-10*day))
+	recordStatusChange(t, client, reentered.ID, rep.ID, "contacted", now.Add(-10*day))
+	recordStatusChange(t, client, reentered.ID, rep.ID, "contacted", now.Add(-1*day))
+
+	// Won long ago, terminal statuses have no SLA
+	createLeadInStatus(t, client, "Old Won", lead.StatusWon, now.Add(-30*day))
+
+	// New for 3 days (SLA 2 days) without history, in the member's territory
+	unworked := createLeadInStatus(t, client, "Unworked New", lead.StatusNew, now.Add(-3*day))
+	terr, err := client.Territory.Create().
+		SetName("East").
+		SetCreatedByUserID(member.ID).
+		AddLeads(unworked).
+		Save(ctx)
+	require.NoError(t, err)
+	_, err = client.TerritoryMember.Create().
+		SetTerritoryID(terr.ID).
+		SetUserID(member.ID).
+		SetAddedByUserID(member.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// New for 3 days but nobody works it, catalog leads have no SLA
+	catalog := createLeadInStatus(t, client, "Catalog New", lead.StatusNew, now.Add(-3*day))
+
+	service := NewService(client)
+	notifier := &recordingNotifier{}
+	service.SetNotifier(notifier)
+
+	t.Run("flags leads past their SLA and notifies the assigned rep", func(t *testing.T) {
+		before, err := client.Lead.Get(ctx, stale.ID)
+		require.NoError(t, err)
+
+		result, err := service.FlagOverdueLeads(ctx, now)
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.Flagged)
+		assert.Equal(t, 1, result.Notified)
+		assert.Equal(t, []string{"rep@example.com"}, notifier.sent)
+
+		flagged, err := client.Lead.Get(ctx, stale.ID)
+		require.NoError(t, err)
+		require.NotNil(t, flagged.SLAOverdueSince)
+		assert.WithinDuration(t, now.Add(-2*day), *flagged.SLAOverdueSince, time.Second)
+		assert.True(t, before.UpdatedAt.Equal(flagged.UpdatedAt), "flagging must not bump updated_at")
+
+		l, err := client.Lead.Get(ctx, catalog.ID)
+		require.NoError(t, err)
+		assert.Nil(t, l.SLAOverdueSince)
+
+		for _, id := range []int{fresh.ID, reentered.ID} {
+			l, err := client.Lead.Get(ctx, id)
+			require.NoError(t, err)
+			assert.Nil(t, l.SLAOverdueSince)
+		}
+	})
+
+	t.Run("does not flag or notify twice", func(t *testing.T) {
+		result, err := service.FlagOverdueLeads(ctx, now)
+		require.NoError(t, err)
+		assert.Equal(t, 0, result.Flagged)
+		assert.Len(t, notifier.sent, 1)
+	})
+
+	t.Run("lists overdue leads for assignee and territory members", func(t *testing.T) {
+		repLeads, err := service.GetOverdueLeads(ctx, rep.ID, 50)
+		require.NoError(t, err)
+		require.Len(t, repLeads, 1)
+		assert.Equal(t, stale.ID, repLeads[0].ID)
+		require.NotNil(t, repLeads[0].AssignedUserID)
+		assert.Equal(t, rep.ID, *repLeads[0].AssignedUserID)
+		assert.GreaterOrEqual(t, repLeads[0].HoursInStatus, 119)
+
+		memberLeads, err := service.GetOverdueLeads(ctx, member.ID, 50)
+		require.NoError(t, err)
+		require.Len(t, memberLeads, 1)
+		assert.Equal(t, unworked.ID, memberLeads[0].ID)
+		assert.Nil(t, memberLeads[0].AssignedUserID)
+
+		otherLeads, err := service.GetOverdueLeads(ctx, other.ID, 50)
+		require.NoError(t, err)
+		assert.Empty(t, otherLeads)
+	})
+
+	t.Run("status change clears the overdue flag", func(t *testing.T) {
+		_, err := service.UpdateLeadStatus(ctx, rep.ID, stale.ID, UpdateStatusRequest{Status: "qualified"})
+		require.NoError(t, err)
+
+		l, err := client.Lead.Get(ctx, stale.ID)
+		require.NoError(t, err)
+		assert.Nil(t, l.SLAOverdueSince)
+
+		repLeads, err := service.GetOverdueLeads(ctx, rep.ID, 50)
+		require.NoError(t, err)
+		assert.Empty(t, repLeads)
+	})
+
+	t.Run("respects the rep's overdue email preference", func(t *testing.T) {
+		preferences := notification.NewService(client)
+		service.SetPreferences(preferences)
+		defer service.SetPreferences(nil)
+
+		off := false
+		_, err := preferences.Update(ctx, other.ID, []notification.UpdatePreferenceRequest{
+			{EventType: notification.EventLeadSLAOverdue, Email: &off},
+		})
+		require.NoError(t, err)
+
+		quiet := createLeadInStatus(t, client, "Quiet Contacted", lead.StatusContacted, now.Add(-5*day))
+		recordStatusChange(t, client, quiet.ID, other.ID, "contacted", now.Add(-5*day))
+		_, err = client.LeadAssignment.Create().
+			SetLeadID(quiet.ID).
+			SetUserID(other.ID).
+			Save(ctx)
+		require.NoError(t, err)
+
+		result, err := service.FlagOverdueLeads(ctx, now)
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Flagged)
+		assert.Equal(t, 0, result.Notified)
+		assert.Len(t, notifier.sent, 1)
+	})
+}
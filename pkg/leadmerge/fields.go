@@ -0,0 +1,120 @@
+package leadmerge
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/jordanlanch/industrydb/ent"
+)
+
+// Strategy decides which lead's value a merged custom field keeps when both
+// leads set it to different values
+type Strategy string
+
+// Merge strategies
+const (
+	// StrategyKeepSurvivor keeps the survivor's value
+	StrategyKeepSurvivor Strategy = "keep_survivor"
+	// StrategyKeepNewest keeps the value of the lead updated last, the
+	// survivor's on a tie
+	StrategyKeepNewest Strategy = "keep_newest"
+	// StrategyKeepNonEmpty keeps the survivor's value unless it is empty
+	StrategyKeepNonEmpty Strategy = "keep_non_empty"
+)
+
+// DefaultStrategy is used when a merge request doesn't name one
+const DefaultStrategy = StrategyKeepSurvivor
+
+// Valid reports whether s is a known strategy
+func (s Strategy) Valid() bool {
+	switch s {
+	case StrategyKeepSurvivor, StrategyKeepNewest, StrategyKeepNonEmpty:
+		return true
+	}
+	return false
+}
+
+// Sources of a resolved custom field value
+const (
+	SourceSurvivor  = "survivor"
+	SourceDuplicate = "duplicate"
+)
+
+// FieldConflict is a custom field both leads set to different values, and
+// how the strategy resolved it
+type FieldConflict struct {
+	Key            string      `json:"key"`
+	SurvivorValue  interface{} `json:"survivor_value"`
+	DuplicateValue interface{} `json:"duplicate_value"`
+	ResolvedValue  interface{} `json:"resolved_value"`
+	Source         string      `json:"source"` // "survivor" or "duplicate"
+}
+
+// MergeCustomFields combines the custom fields of two leads. Keys set on
+// only one lead are kept as is, keys set to the same value on both are kept
+// once, and keys set to different values are resolved by the strategy and
+// returned as conflicts, sorted by key. Neither lead is modified.
+func MergeCustomFields(survivor, duplicate *ent.Lead, strategy Strategy) (map[string]interface{}, []FieldConflict) {
+	merged := make(map[string]interface{}, len(survivor.CustomFields)+len(duplicate.CustomFields))
+	for key, value := range survivor.CustomFields {
+		merged[key] = value
+	}
+
+	conflicts := []FieldConflict{}
+	for key, dupValue := range duplicate.CustomFields {
+		survivorValue, ok := survivor.CustomFields[key]
+		if !ok {
+			merged[key] = dupValue
+			continue
+		}
+		if reflect.DeepEqual(survivorValue, dupValue) {
+			continue
+		}
+
+		conflict := FieldConflict{
+			Key:            key,
+			SurvivorValue:  survivorValue,
+			DuplicateValue: dupValue,
+			ResolvedValue:  survivorValue,
+			Source:         SourceSurvivor,
+		}
+		if keepDuplicate(survivor, duplicate, survivorValue, dupValue, strategy) {
+			conflict.ResolvedValue = dupValue
+			conflict.Source = SourceDuplicate
+		}
+		merged[key] = conflict.ResolvedValue
+		conflicts = append(conflicts, conflict)
+	}
+
+	sort.Slice(conflicts, func(i, j int) bool { return conflicts[i].Key < conflicts[j].Key })
+	return merged, conflicts
+}
+
+// keepDuplicate reports whether a conflicting field takes the duplicate's value
+func keepDuplicate(survivor, duplicate *ent.Lead, survivorValue, dupValue interface{}, strategy Strategy) bool {
+	switch strategy {
+	case StrategyKeepNewest:
+		return duplicate.UpdatedAt.After(survivor.UpdatedAt)
+	case StrategyKeepNonEmpty:
+		return isEmpty(survivorValue) && !isEmpty(dupValue)
+	default:
+		return false
+	}
+}
+
+// isEmpty reports whether a custom field value carries no data: null, a
+// blank string, or an empty list or object. False and zero are values.
+func isEmpty(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return strings.TrimSpace(v) == ""
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
@@ -0,0 +1,115 @@
+package leadmerge
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeCustomFields(t *testing.T) {
+	older := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	newer := older.Add(time.Hour)
+
+	survivor := &ent.Lead{
+		UpdatedAt: older,
+		CustomFields: map[string]interface{}{
+			"budget":   "10k",
+			"owner":    "",
+			"tags":     []interface{}{},
+			"priority": float64(1),
+			"source":   "fair",
+			"active":   false,
+		},
+	}
+	duplicate := &ent.Lead{
+		UpdatedAt: newer,
+		CustomFields: map[string]interface{}{
+			"budget":   "20k",
+			"owner":    "ana",
+			"tags":     []interface{}{"vip"},
+			"priority": nil,
+			"source":   "fair", // Same value, not a conflict
+			"active":   true,
+			"region":   "west", // Only on the duplicate
+		},
+	}
+
+	tests := []struct {
+		strategy Strategy
+		want     map[string]interface{}
+		sources  map[string]string
+	}{
+		{
+			strategy: StrategyKeepSurvivor,
+			want: map[string]interface{}{
+				"budget": "10k", "owner": "", "tags": []interface{}{}, "priority": float64(1),
+				"source": "fair", "active": false, "region": "west",
+			},
+			sources: map[string]string{
+				"active": SourceSurvivor, "budget": SourceSurvivor, "owner": SourceSurvivor,
+				"priority": SourceSurvivor, "tags": SourceSurvivor,
+			},
+		},
+		{
+			strategy: StrategyKeepNewest,
+			want: map[string]interface{}{
+				"budget": "20k", "owner": "ana", "tags": []interface{}{"vip"}, "priority": nil,
+				"source": "fair", "active": true, "region": "west",
+			},
+			sources: map[string]string{
+				"active": SourceDuplicate, "budget": SourceDuplicate, "owner": SourceDuplicate,
+				"priority": SourceDuplicate, "tags": SourceDuplicate,
+			},
+		},
+		{
+			// Empty survivor values give way, false and zero are values
+			strategy: StrategyKeepNonEmpty,
+			want: map[string]interface{}{
+				"budget": "10k", "owner": "ana", "tags": []interface{}{"vip"}, "priority": float64(1),
+				"source": "fair", "active": false, "region": "west",
+			},
+			sources: map[string]string{
+				"active": SourceSurvivor, "budget": SourceSurvivor, "owner": SourceDuplicate,
+				"priority": SourceSurvivor, "tags": SourceDuplicate,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.strategy), func(t *testing.T) {
+			merged, conflicts := MergeCustomFields(survivor, duplicate, tt.strategy)
+			assert.Equal(t, tt.want, merged)
+
+			keys := []string{}
+			sources := map[string]string{}
+			for _, conflict := range conflicts {
+				keys = append(keys, conflict.Key)
+				sources[conflict.Key] = conflict.Source
+				assert.Equal(t, survivor.CustomFields[conflict.Key], conflict.SurvivorValue)
+				assert.Equal(t, duplicate.CustomFields[conflict.Key], conflict.DuplicateValue)
+				assert.Equal(t, merged[conflict.Key], conflict.ResolvedValue)
+			}
+			assert.Equal(t, []string{"active", "budget", "owner", "priority", "tags"}, keys)
+			assert.Equal(t, tt.sources, sources)
+		})
+	}
+
+	t.Run("keep_newest keeps the survivor on a tie", func(t *testing.T) {
+		tied := &ent.Lead{UpdatedAt: older, CustomFields: map[string]interface{}{"budget": "20k"}}
+		merged, conflicts := MergeCustomFields(survivor, tied, StrategyKeepNewest)
+		assert.Equal(t, "10k", merged["budget"])
+		assert.Equal(t, SourceSurvivor, conflicts[0].Source)
+	})
+
+	t.Run("no custom fields", func(t *testing.T) {
+		merged, conflicts := MergeCustomFields(&ent.Lead{}, &ent.Lead{}, StrategyKeepSurvivor)
+		assert.Empty(t, merged)
+		assert.NotNil(t, conflicts)
+		assert.Empty(t, conflicts)
+	})
+
+	// Neither lead is modified
+	assert.Equal(t, "", survivor.CustomFields["owner"])
+	assert.Len(t, survivor.CustomFields, 6)
+}
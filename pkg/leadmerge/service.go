@@ -0,0 +1,256 @@
+package leadmerge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/leadassignment"
+	"github.com/jordanlanch/industrydb/ent/leadchange"
+	"github.com/jordanlanch/industrydb/ent/leadnote"
+	"github.com/jordanlanch/industrydb/ent/leadstatushistory"
+	"github.com/jordanlanch/industrydb/pkg/customfields"
+	"github.com/jordanlanch/industrydb/pkg/leads"
+)
+
+// Merge errors, returned for requests the client must fix
+var (
+	ErrLeadNotFound    = errors.New("lead not found")
+	ErrSameLead        = errors.New("a lead can't be merged into itself")
+	ErrInvalidStrategy = errors.New("invalid merge strategy")
+	ErrAlreadyMerged   = errors.New("lead was already merged")
+)
+
+// MergedIntoKey is the metadata key recording, on an archived duplicate, the
+// lead it was merged into
+const MergedIntoKey = "merged_into"
+
+// Service merges duplicate leads into a surviving lead.
+type Service struct {
+	client *ent.Client
+}
+
+// NewService creates a new lead merge service.
+func NewService(client *ent.Client) *Service {
+	return &Service{client: client}
+}
+
+// Result describes a merge, previewed or applied. Notes and Assignments
+// count the duplicate's notes and assignments, which move to the survivor.
+type Result struct {
+	SurvivorID   int                    `json:"survivor_id"`
+	DuplicateID  int                    `json:"duplicate_id"`
+	Strategy     Strategy               `json:"strategy"`
+	CustomFields map[string]interface{} `json:"custom_fields"` // Survivor's custom fields after the merge
+	Conflicts    []FieldConflict        `json:"conflicts"`
+	Notes        int                    `json:"notes"`
+	Assignments  int                    `json:"assignments"`
+	// EndedAssignmentID is the duplicate's current assignment, ended because
+	// the survivor has its own
+	EndedAssignmentID *int `json:"ended_assignment_id,omitempty"`
+	Merged            bool `json:"merged"`
+}
+
+// Preview returns what merging duplicateID into survivorID with the strategy
+// would do, without changing anything.
+func (s *Service) Preview(ctx context.Context, survivorID, duplicateID int, strategy Strategy) (*Result, error) {
+	result, _, _, err := plan(ctx, s.client, survivorID, duplicateID, strategy)
+	return result, err
+}
+
+// Merge merges duplicateID into survivorID on behalf of userID, in one
+// transaction: the survivor gets the combined custom fields, and the
+// duplicate's notes and assignment history move to it. If both leads have a
+// current assignment, the survivor's is kept and the duplicate's ends as
+// reassigned. The duplicate is archived, with the survivor's ID under
+// MergedIntoKey in its metadata, rather than deleted, so its own values
+// stay available.
+func (s *Service) Merge(ctx context.Context, userID, survivorID, duplicateID int, strategy Strategy) (*Result, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	result, err := merge(ctx, tx, userID, survivorID, duplicateID, strategy)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return result, nil
+}
+
+// merge applies a merge within tx
+func merge(ctx context.Context, tx *ent.Tx, userID, survivorID, duplicateID int, strategy Strategy) (*Result, error) {
+	result, survivor, duplicate, err := plan(ctx, tx.Client(), survivorID, duplicateID, strategy)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+
+	updatedSurvivor, err := tx.Lead.UpdateOne(survivor).
+		SetCustomFields(result.CustomFields).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update survivor: %w", err)
+	}
+	if err := leads.RecordChange(ctx, tx.LeadChange, survivor, updatedSurvivor, leadchange.SourceManual, userID); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.LeadNote.Update().
+		Where(leadnote.LeadID(duplicateID)).
+		SetLeadID(survivorID).
+		Save(ctx); err != nil {
+		return nil, fmt.Errorf("failed to move notes: %w", err)
+	}
+
+	// At most one current assignment per lead
+	if result.EndedAssignmentID != nil {
+		if _, err := tx.LeadAssignment.UpdateOneID(*result.EndedAssignmentID).
+			SetIsActive(false).
+			SetEndedAt(now).
+			SetEndReason(leadassignment.EndReasonReassigned).
+			Save(ctx); err != nil {
+			return nil, fmt.Errorf("failed to end duplicate assignment: %w", err)
+		}
+	}
+	if _, err := tx.LeadAssignment.Update().
+		Where(leadassignment.LeadID(duplicateID)).
+		SetLeadID(survivorID).
+		Save(ctx); err != nil {
+		return nil, fmt.Errorf("failed to move assignments: %w", err)
+	}
+
+	metadata := make(map[string]interface{}, len(duplicate.Metadata)+1)
+	for key, value := range duplicate.Metadata {
+		metadata[key] = value
+	}
+	metadata[MergedIntoKey] = survivorID
+	archive := tx.Lead.UpdateOne(duplicate).SetMetadata(metadata)
+	if duplicate.Status != lead.StatusArchived {
+		archive.SetStatus(lead.StatusArchived).
+			SetStatusChangedAt(now).
+			ClearSLAOverdueSince()
+	}
+	archivedDuplicate, err := archive.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to archive duplicate: %w", err)
+	}
+	if err := leads.RecordChange(ctx, tx.LeadChange, duplicate, archivedDuplicate, leadchange.SourceManual, userID); err != nil {
+		return nil, err
+	}
+	if duplicate.Status != lead.StatusArchived {
+		if _, err := tx.LeadStatusHistory.Create().
+			SetLeadID(duplicateID).
+			SetUserID(userID).
+			SetOldStatus(leadstatushistory.OldStatus(duplicate.Status)).
+			SetNewStatus(leadstatushistory.NewStatusArchived).
+			SetReason(fmt.Sprintf("Merged into lead %d", survivorID)).
+			Save(ctx); err != nil {
+			return nil, fmt.Errorf("failed to create status history: %w", err)
+		}
+	}
+
+	result.Merged = true
+	return result, nil
+}
+
+// plan computes a merge from the current state of both leads, returned
+// with it
+func plan(ctx context.Context, client *ent.Client, survivorID, duplicateID int, strategy Strategy) (*Result, *ent.Lead, *ent.Lead, error) {
+	if strategy == "" {
+		strategy = DefaultStrategy
+	}
+	if !strategy.Valid() {
+		return nil, nil, nil, fmt.Errorf("%w: %q", ErrInvalidStrategy, strategy)
+	}
+	if survivorID == duplicateID {
+		return nil, nil, nil, ErrSameLead
+	}
+
+	survivor, duplicate, err := getLeads(ctx, client, survivorID, duplicateID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	for _, l := range []*ent.Lead{survivor, duplicate} {
+		if _, ok := l.Metadata[MergedIntoKey]; ok {
+			return nil, nil, nil, fmt.Errorf("%w: lead %d", ErrAlreadyMerged, l.ID)
+		}
+	}
+
+	merged, conflicts := MergeCustomFields(survivor, duplicate, strategy)
+	if maxFields := customfields.GetLimits().MaxFields; len(merged) > maxFields {
+		return nil, nil, nil, fmt.Errorf("%w: the merged lead would have %d custom fields (max %d)", customfields.ErrTooManyFields, len(merged), maxFields)
+	}
+
+	notes, err := client.LeadNote.Query().Where(leadnote.LeadID(duplicateID)).Count(ctx)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to count notes: %w", err)
+	}
+	assignments, err := client.LeadAssignment.Query().Where(leadassignment.LeadID(duplicateID)).All(ctx)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to list assignments: %w", err)
+	}
+
+	result := &Result{
+		SurvivorID:   survivorID,
+		DuplicateID:  duplicateID,
+		Strategy:     strategy,
+		CustomFields: merged,
+		Conflicts:    conflicts,
+		Notes:        notes,
+		Assignments:  len(assignments),
+	}
+
+	for _, a := range assignments {
+		if !a.IsActive {
+			continue
+		}
+		survivorAssigned, err := client.LeadAssignment.Query().
+			Where(leadassignment.LeadID(survivorID), leadassignment.IsActive(true)).
+			Exist(ctx)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to check survivor assignment: %w", err)
+		}
+		if survivorAssigned {
+			id := a.ID
+			result.EndedAssignmentID = &id
+		}
+	}
+
+	return result, survivor, duplicate, nil
+}
+
+// getLeads loads the survivor and the duplicate
+func getLeads(ctx context.Context, client *ent.Client, survivorID, duplicateID int) (*ent.Lead, *ent.Lead, error) {
+	survivor, err := client.Lead.Get(ctx, survivorID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, fmt.Errorf("%w: %d", ErrLeadNotFound, survivorID)
+		}
+		return nil, nil, fmt.Errorf("failed to fetch lead: %w", err)
+	}
+	duplicate, err := client.Lead.Get(ctx, duplicateID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, fmt.Errorf("%w: %d", ErrLeadNotFound, duplicateID)
+		}
+		return nil, nil, fmt.Errorf("failed to fetch lead: %w", err)
+	}
+	return survivor, duplicate, nil
+}
+
+// IsValidationError reports whether err is a client error from a merge
+// request, other than a missing lead
+func IsValidationError(err error) bool {
+	return errors.Is(err, ErrSameLead) ||
+		errors.Is(err, ErrInvalidStrategy) ||
+		customfields.IsValidationError(err)
+}
@@ -0,0 +1,204 @@
+package leadmerge
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/leadassignment"
+	"github.com/jordanlanch/industrydb/ent/leadnote"
+	"github.com/jordanlanch/industrydb/ent/leadstatushistory"
+	"github.com/jordanlanch/industrydb/pkg/customfields"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mergeFixture struct {
+	client    *ent.Client
+	service   *Service
+	user      *ent.User
+	survivor  *ent.Lead
+	duplicate *ent.Lead
+}
+
+func setupMergeTest(t *testing.T) *mergeFixture {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	ctx := context.Background()
+
+	u := client.User.Create().
+		SetEmail("merge@test.com").
+		SetName("Merger").
+		SetPasswordHash("hashed").
+		SaveX(ctx)
+
+	newLead := func(name string, fields map[string]interface{}) *ent.Lead {
+		return client.Lead.Create().
+			SetName(name).
+			SetIndustry("tattoo").
+			SetCountry("US").
+			SetCity("NYC").
+			SetCustomFields(fields).
+			SaveX(ctx)
+	}
+	survivor := newLead("Ink Studio", map[string]interface{}{"budget": "10k", "owner": ""})
+	duplicate := newLead("Ink Studio NYC", map[string]interface{}{"budget": "20k", "owner": "ana", "region": "west"})
+
+	return &mergeFixture{client: client, service: NewService(client), user: u, survivor: survivor, duplicate: duplicate}
+}
+
+func (f *mergeFixture) addNote(leadID int, content string) {
+	f.client.LeadNote.Create().SetLeadID(leadID).SetUserID(f.user.ID).SetContent(content).SaveX(context.Background())
+}
+
+func (f *mergeFixture) addAssignment(leadID int, active bool) *ent.LeadAssignment {
+	return f.client.LeadAssignment.Create().
+		SetLeadID(leadID).
+		SetUserID(f.user.ID).
+		SetAssignedByUserID(f.user.ID).
+		SetAssignmentType(leadassignment.AssignmentTypeManual).
+		SetIsActive(active).
+		SaveX(context.Background())
+}
+
+func TestPreview(t *testing.T) {
+	f := setupMergeTest(t)
+	ctx := context.Background()
+	f.addNote(f.duplicate.ID, "Called, no answer")
+	f.addAssignment(f.duplicate.ID, true)
+	f.addAssignment(f.survivor.ID, true)
+
+	result, err := f.service.Preview(ctx, f.survivor.ID, f.duplicate.ID, StrategyKeepNonEmpty)
+	require.NoError(t, err)
+
+	assert.False(t, result.Merged)
+	assert.Equal(t, StrategyKeepNonEmpty, result.Strategy)
+	assert.Equal(t, map[string]interface{}{"budget": "10k", "owner": "ana", "region": "west"}, result.CustomFields)
+	require.Len(t, result.Conflicts, 2)
+	assert.Equal(t, "budget", result.Conflicts[0].Key)
+	assert.Equal(t, SourceSurvivor, result.Conflicts[0].Source)
+	assert.Equal(t, "owner", result.Conflicts[1].Key)
+	assert.Equal(t, SourceDuplicate, result.Conflicts[1].Source)
+	assert.Equal(t, 1, result.Notes)
+	assert.Equal(t, 1, result.Assignments)
+	assert.NotNil(t, result.EndedAssignmentID)
+
+	// Nothing changed
+	survivor := f.client.Lead.GetX(ctx, f.survivor.ID)
+	assert.Equal(t, f.survivor.CustomFields, survivor.CustomFields)
+	assert.Equal(t, 1, f.client.LeadNote.Query().Where(leadnote.LeadID(f.duplicate.ID)).CountX(ctx))
+	assert.Equal(t, lead.StatusNew, f.client.Lead.GetX(ctx, f.duplicate.ID).Status)
+
+	t.Run("defaults to keep_survivor", func(t *testing.T) {
+		result, err := f.service.Preview(ctx, f.survivor.ID, f.duplicate.ID, "")
+		require.NoError(t, err)
+		assert.Equal(t, StrategyKeepSurvivor, result.Strategy)
+		assert.Equal(t, "", result.CustomFields["owner"])
+	})
+}
+
+func TestPreview_Errors(t *testing.T) {
+	f := setupMergeTest(t)
+	ctx := context.Background()
+
+	_, err := f.service.Preview(ctx, f.survivor.ID, f.duplicate.ID, "keep_oldest")
+	assert.ErrorIs(t, err, ErrInvalidStrategy)
+
+	_, err = f.service.Preview(ctx, f.survivor.ID, f.survivor.ID, "")
+	assert.ErrorIs(t, err, ErrSameLead)
+
+	_, err = f.service.Preview(ctx, f.survivor.ID, 99999, "")
+	assert.ErrorIs(t, err, ErrLeadNotFound)
+
+	t.Run("too many merged custom fields", func(t *testing.T) {
+		defer customfields.SetLimits(customfields.DefaultLimits)
+		customfields.SetLimits(customfields.Limits{MaxFields: 2})
+
+		_, err := f.service.Preview(ctx, f.survivor.ID, f.duplicate.ID, "")
+		assert.ErrorIs(t, err, customfields.ErrTooManyFields)
+		assert.True(t, IsValidationError(err))
+	})
+}
+
+func TestMerge(t *testing.T) {
+	f := setupMergeTest(t)
+	ctx := context.Background()
+	f.addNote(f.survivor.ID, "Survivor note")
+	f.addNote(f.duplicate.ID, "Duplicate note 1")
+	f.addNote(f.duplicate.ID, "Duplicate note 2")
+	survivorAssignment := f.addAssignment(f.survivor.ID, true)
+	duplicatePast := f.addAssignment(f.duplicate.ID, false)
+	duplicateCurrent := f.addAssignment(f.duplicate.ID, true)
+
+	result, err := f.service.Merge(ctx, f.user.ID, f.survivor.ID, f.duplicate.ID, StrategyKeepNewest)
+	require.NoError(t, err)
+	assert.True(t, result.Merged)
+	require.NotNil(t, result.EndedAssignmentID)
+	assert.Equal(t, duplicateCurrent.ID, *result.EndedAssignmentID)
+
+	// The duplicate was updated last, so its values win
+	survivor := f.client.Lead.GetX(ctx, f.survivor.ID)
+	assert.Equal(t, map[string]interface{}{"budget": "20k", "owner": "ana", "region": "west"}, survivor.CustomFields)
+	assert.Equal(t, 1, survivor.QueryChanges().CountX(ctx))
+
+	// Notes and assignment history moved, only the survivor's assignment is current
+	assert.Equal(t, 3, f.client.LeadNote.Query().Where(leadnote.LeadID(f.survivor.ID)).CountX(ctx))
+	assignments := f.client.LeadAssignment.Query().Where(leadassignment.LeadID(f.survivor.ID)).AllX(ctx)
+	assert.Len(t, assignments, 3)
+	for _, a := range assignments {
+		assert.Equal(t, a.ID == survivorAssignment.ID, a.IsActive, a.ID)
+	}
+	ended := f.client.LeadAssignment.GetX(ctx, duplicateCurrent.ID)
+	require.NotNil(t, ended.EndReason)
+	assert.Equal(t, leadassignment.EndReasonReassigned, *ended.EndReason)
+	assert.Nil(t, f.client.LeadAssignment.GetX(ctx, duplicatePast.ID).EndReason)
+
+	// The duplicate is archived, keeping its own values
+	duplicate := f.client.Lead.GetX(ctx, f.duplicate.ID)
+	assert.Equal(t, lead.StatusArchived, duplicate.Status)
+	assert.EqualValues(t, f.survivor.ID, duplicate.Metadata[MergedIntoKey])
+	assert.Equal(t, "ana", duplicate.CustomFields["owner"])
+	history := f.client.LeadStatusHistory.Query().Where(leadstatushistory.LeadID(f.duplicate.ID)).OnlyX(ctx)
+	assert.Equal(t, leadstatushistory.NewStatusArchived, history.NewStatus)
+
+	t.Run("merged leads can't be merged again", func(t *testing.T) {
+		other := f.client.Lead.Create().SetName("Other").SetIndustry("tattoo").SetCountry("US").SetCity("NYC").SaveX(ctx)
+		_, err := f.service.Merge(ctx, f.user.ID, other.ID, f.duplicate.ID, "")
+		assert.ErrorIs(t, err, ErrAlreadyMerged)
+		_, err = f.service.Merge(ctx, f.user.ID, f.duplicate.ID, other.ID, "")
+		assert.ErrorIs(t, err, ErrAlreadyMerged)
+	})
+}
+
+func TestMerge_MovesCurrentAssignment(t *testing.T) {
+	f := setupMergeTest(t)
+	ctx := context.Background()
+	current := f.addAssignment(f.duplicate.ID, true)
+
+	result, err := f.service.Merge(ctx, f.user.ID, f.survivor.ID, f.duplicate.ID, "")
+	require.NoError(t, err)
+	assert.Nil(t, result.EndedAssignmentID)
+
+	// Without a current assignment of its own, the survivor takes the duplicate's
+	moved := f.client.LeadAssignment.GetX(ctx, current.ID)
+	assert.Equal(t, f.survivor.ID, moved.LeadID)
+	assert.True(t, moved.IsActive)
+}
+
+func TestMerge_RollsBackOnFailure(t *testing.T) {
+	f := setupMergeTest(t)
+	ctx := context.Background()
+	f.addNote(f.duplicate.ID, "Duplicate note")
+
+	// The acting user doesn't exist, so the merge fails partway
+	_, err := f.service.Merge(ctx, 99999, f.survivor.ID, f.duplicate.ID, StrategyKeepNewest)
+	require.Error(t, err)
+
+	assert.Equal(t, f.survivor.CustomFields, f.client.Lead.GetX(ctx, f.survivor.ID).CustomFields)
+	assert.Equal(t, 1, f.client.LeadNote.Query().Where(leadnote.LeadID(f.duplicate.ID)).CountX(ctx))
+	assert.Equal(t, lead.StatusNew, f.client.Lead.GetX(ctx, f.duplicate.ID).Status)
+}
@@ -2,13 +2,18 @@ package leadnote
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/jordanlanch/industrydb/ent"
 	"github.com/jordanlanch/industrydb/ent/leadnote"
+	"github.com/jordanlanch/industrydb/ent/predicate"
 )
 
+// ErrInvalidVisibility is returned for a visibility other than private or team
+var ErrInvalidVisibility = errors.New("visibility must be private or team")
+
 // Service handles lead note operations.
 type Service struct {
 	client *ent.Client
@@ -23,31 +28,60 @@ func NewService(client *ent.Client) *Service {
 
 // CreateNoteRequest represents a request to create a new note.
 type CreateNoteRequest struct {
-	LeadID   int    `json:"lead_id" validate:"required,gt=0"`
-	Content  string `json:"content" validate:"required,min=1,max=10000"`
-	IsPinned bool   `json:"is_pinned"`
+	LeadID     int    `json:"lead_id" validate:"required,gt=0"`
+	Content    string `json:"content" validate:"required,min=1,max=10000"`
+	IsPinned   bool   `json:"is_pinned"`
+	Visibility string `json:"visibility,omitempty" validate:"omitempty,oneof=private team"` // Defaults to team
 }
 
 // UpdateNoteRequest represents a request to update a note.
 type UpdateNoteRequest struct {
-	Content  *string `json:"content,omitempty" validate:"omitempty,min=1,max=10000"`
-	IsPinned *bool   `json:"is_pinned,omitempty"`
+	Content    *string `json:"content,omitempty" validate:"omitempty,min=1,max=10000"`
+	IsPinned   *bool   `json:"is_pinned,omitempty"`
+	Visibility *string `json:"visibility,omitempty" validate:"omitempty,oneof=private team"`
 }
 
 // NoteResponse represents a lead note response.
 type NoteResponse struct {
-	ID        int       `json:"id"`
-	LeadID    int       `json:"lead_id"`
-	UserID    int       `json:"user_id"`
-	UserName  string    `json:"user_name"`
-	Content   string    `json:"content"`
-	IsPinned  bool      `json:"is_pinned"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID         int       `json:"id"`
+	LeadID     int       `json:"lead_id"`
+	UserID     int       `json:"user_id"`
+	UserName   string    `json:"user_name"`
+	Content    string    `json:"content"`
+	IsPinned   bool      `json:"is_pinned"`
+	Visibility string    `json:"visibility"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// VisibleTo matches the notes userID may read: team notes and the user's
+// own private notes. Every query returning notes to a user must apply it.
+func VisibleTo(userID int) predicate.LeadNote {
+	return leadnote.Or(
+		leadnote.VisibilityEQ(leadnote.VisibilityTeam),
+		leadnote.UserID(userID),
+	)
+}
+
+// ParseVisibility validates a requested visibility, an empty one meaning team
+func ParseVisibility(visibility string) (leadnote.Visibility, error) {
+	if visibility == "" {
+		return leadnote.VisibilityTeam, nil
+	}
+	v := leadnote.Visibility(visibility)
+	if err := leadnote.VisibilityValidator(v); err != nil {
+		return "", ErrInvalidVisibility
+	}
+	return v, nil
 }
 
 // CreateNote creates a new note for a lead.
 func (s *Service) CreateNote(ctx context.Context, userID int, req CreateNoteRequest) (*NoteResponse, error) {
+	visibility, err := ParseVisibility(req.Visibility)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create the note
 	note, err := s.client.LeadNote.
 		Create().
@@ -55,6 +89,7 @@ func (s *Service) CreateNote(ctx context.Context, userID int, req CreateNoteRequ
 		SetUserID(userID).
 		SetContent(req.Content).
 		SetIsPinned(req.IsPinned).
+		SetVisibility(visibility).
 		Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create note: %w", err)
@@ -67,22 +102,24 @@ func (s *Service) CreateNote(ctx context.Context, userID int, req CreateNoteRequ
 	}
 
 	return &NoteResponse{
-		ID:        note.ID,
-		LeadID:    note.LeadID,
-		UserID:    note.UserID,
-		UserName:  user.Name,
-		Content:   note.Content,
-		IsPinned:  note.IsPinned,
-		CreatedAt: note.CreatedAt,
-		UpdatedAt: note.UpdatedAt,
+		ID:         note.ID,
+		LeadID:     note.LeadID,
+		UserID:     note.UserID,
+		UserName:   user.Name,
+		Content:    note.Content,
+		IsPinned:   note.IsPinned,
+		Visibility: string(note.Visibility),
+		CreatedAt:  note.CreatedAt,
+		UpdatedAt:  note.UpdatedAt,
 	}, nil
 }
 
-// GetNoteByID retrieves a single note by ID.
-func (s *Service) GetNoteByID(ctx context.Context, noteID int) (*NoteResponse, error) {
+// GetNoteByID retrieves a single note by ID. Another user's private note
+// is reported as not found, so its existence isn't revealed.
+func (s *Service) GetNoteByID(ctx context.Context, userID, noteID int) (*NoteResponse, error) {
 	note, err := s.client.LeadNote.
 		Query().
-		Where(leadnote.ID(noteID)).
+		Where(leadnote.ID(noteID), VisibleTo(userID)).
 		WithUser().
 		Only(ctx)
 	if err != nil {
@@ -93,22 +130,23 @@ func (s *Service) GetNoteByID(ctx context.Context, noteID int) (*NoteResponse, e
 	}
 
 	return &NoteResponse{
-		ID:        note.ID,
-		LeadID:    note.LeadID,
-		UserID:    note.UserID,
-		UserName:  note.Edges.User.Name,
-		Content:   note.Content,
-		IsPinned:  note.IsPinned,
-		CreatedAt: note.CreatedAt,
-		UpdatedAt: note.UpdatedAt,
+		ID:         note.ID,
+		LeadID:     note.LeadID,
+		UserID:     note.UserID,
+		UserName:   note.Edges.User.Name,
+		Content:    note.Content,
+		IsPinned:   note.IsPinned,
+		Visibility: string(note.Visibility),
+		CreatedAt:  note.CreatedAt,
+		UpdatedAt:  note.UpdatedAt,
 	}, nil
 }
 
-// ListNotesByLead retrieves all notes for a lead, ordered by pinned first, then by creation date descending.
-func (s *Service) ListNotesByLead(ctx context.Context, leadID int) ([]*NoteResponse, error) {
+// ListNotesByLead retrieves the notes of a lead visible to userID, ordered by pinned first, then by creation date descending.
+func (s *Service) ListNotesByLead(ctx context.Context, userID, leadID int) ([]*NoteResponse, error) {
 	notes, err := s.client.LeadNote.
 		Query().
-		Where(leadnote.LeadID(leadID)).
+		Where(leadnote.LeadID(leadID), VisibleTo(userID)).
 		WithUser().
 		Order(ent.Desc(leadnote.FieldIsPinned), ent.Desc(leadnote.FieldCreatedAt)).
 		All(ctx)
@@ -119,14 +157,15 @@ func (s *Service) ListNotesByLead(ctx context.Context, leadID int) ([]*NoteRespo
 	responses := make([]*NoteResponse, len(notes))
 	for i, note := range notes {
 		responses[i] = &NoteResponse{
-			ID:        note.ID,
-			LeadID:    note.LeadID,
-			UserID:    note.UserID,
-			UserName:  note.Edges.User.Name,
-			Content:   note.Content,
-			IsPinned:  note.IsPinned,
-			CreatedAt: note.CreatedAt,
-			UpdatedAt: note.UpdatedAt,
+			ID:         note.ID,
+			LeadID:     note.LeadID,
+			UserID:     note.UserID,
+			UserName:   note.Edges.User.Name,
+			Content:    note.Content,
+			IsPinned:   note.IsPinned,
+			Visibility: string(note.Visibility),
+			CreatedAt:  note.CreatedAt,
+			UpdatedAt:  note.UpdatedAt,
 		}
 	}
 
@@ -135,10 +174,11 @@ func (s *Service) ListNotesByLead(ctx context.Context, leadID int) ([]*NoteRespo
 
 // UpdateNote updates an existing note.
 func (s *Service) UpdateNote(ctx context.Context, userID, noteID int, req UpdateNoteRequest) (*NoteResponse, error) {
-	// Check if the note exists and belongs to the user
+	// Check if the note exists and belongs to the user. Other users'
+	// private notes are reported as not found.
 	note, err := s.client.LeadNote.
 		Query().
-		Where(leadnote.ID(noteID)).
+		Where(leadnote.ID(noteID), VisibleTo(userID)).
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -160,6 +200,13 @@ func (s *Service) UpdateNote(ctx context.Context, userID, noteID int, req Update
 	if req.IsPinned != nil {
 		update = update.SetIsPinned(*req.IsPinned)
 	}
+	if req.Visibility != nil {
+		visibility, err := ParseVisibility(*req.Visibility)
+		if err != nil {
+			return nil, err
+		}
+		update = update.SetVisibility(visibility)
+	}
 
 	// Save update
 	updatedNote, err := update.Save(ctx)
@@ -174,23 +221,25 @@ func (s *Service) UpdateNote(ctx context.Context, userID, noteID int, req Update
 	}
 
 	return &NoteResponse{
-		ID:        updatedNote.ID,
-		LeadID:    updatedNote.LeadID,
-		UserID:    updatedNote.UserID,
-		UserName:  user.Name,
-		Content:   updatedNote.Content,
-		IsPinned:  updatedNote.IsPinned,
-		CreatedAt: updatedNote.CreatedAt,
-		UpdatedAt: updatedNote.UpdatedAt,
+		ID:         updatedNote.ID,
+		LeadID:     updatedNote.LeadID,
+		UserID:     updatedNote.UserID,
+		UserName:   user.Name,
+		Content:    updatedNote.Content,
+		IsPinned:   updatedNote.IsPinned,
+		Visibility: string(updatedNote.Visibility),
+		CreatedAt:  updatedNote.CreatedAt,
+		UpdatedAt:  updatedNote.UpdatedAt,
 	}, nil
 }
 
 // DeleteNote deletes a note.
 func (s *Service) DeleteNote(ctx context.Context, userID, noteID int) error {
-	// Check if the note exists and belongs to the user
+	// Check if the note exists and belongs to the user. Other users'
+	// private notes are reported as not found.
 	note, err := s.client.LeadNote.
 		Query().
-		Where(leadnote.ID(noteID)).
+		Where(leadnote.ID(noteID), VisibleTo(userID)).
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -212,11 +261,11 @@ func (s *Service) DeleteNote(ctx context.Context, userID, noteID int) error {
 	return nil
 }
 
-// CountNotesByLead returns the number of notes for a lead.
-func (s *Service) CountNotesByLead(ctx context.Context, leadID int) (int, error) {
+// CountNotesByLead returns the number of notes for a lead visible to userID.
+func (s *Service) CountNotesByLead(ctx context.Context, userID, leadID int) (int, error) {
 	count, err := s.client.LeadNote.
 		Query().
-		Where(leadnote.LeadID(leadID)).
+		Where(leadnote.LeadID(leadID), VisibleTo(userID)).
 		Count(ctx)
 	if err != nil {
 		return 0, fmt.Errorf("failed to count notes: %w", err)
@@ -126,7 +126,7 @@ func TestGetNoteByID(t *testing.T) {
 	require.NoError(t, err)
 
 	t.Run("Success - Get existing note", func(t *testing.T) {
-		note, err := service.GetNoteByID(ctx, createdNote.ID)
+		note, err := service.GetNoteByID(ctx, user.ID, createdNote.ID)
 
 		require.NoError(t, err)
 		assert.NotNil(t, note)
@@ -136,7 +136,7 @@ func TestGetNoteByID(t *testing.T) {
 	})
 
 	t.Run("Error - Note not found", func(t *testing.T) {
-		note, err := service.GetNoteByID(ctx, 99999)
+		note, err := service.GetNoteByID(ctx, user.ID, 99999)
 
 		assert.Error(t, err)
 		assert.Nil(t, note)
@@ -156,7 +156,7 @@ func TestListNotesByLead(t *testing.T) {
 	lead := createTestLead(t, client)
 
 	t.Run("Empty list - No notes", func(t *testing.T) {
-		notes, err := service.ListNotesByLead(ctx, lead.ID)
+		notes, err := service.ListNotesByLead(ctx, user1.ID, lead.ID)
 
 		require.NoError(t, err)
 		assert.Empty(t, notes)
@@ -187,7 +187,7 @@ func TestListNotesByLead(t *testing.T) {
 		})
 		require.NoError(t, err)
 
-		notes, err := service.ListNotesByLead(ctx, lead.ID)
+		notes, err := service.ListNotesByLead(ctx, user1.ID, lead.ID)
 
 		require.NoError(t, err)
 		assert.Len(t, notes, 3)
@@ -208,10 +208,10 @@ func TestListNotesByLead(t *testing.T) {
 		})
 		require.NoError(t, err)
 
-		notes1, err := service.ListNotesByLead(ctx, lead.ID)
+		notes1, err := service.ListNotesByLead(ctx, user1.ID, lead.ID)
 		require.NoError(t, err)
 
-		notes2, err := service.ListNotesByLead(ctx, lead2.ID)
+		notes2, err := service.ListNotesByLead(ctx, user1.ID, lead2.ID)
 		require.NoError(t, err)
 
 		// lead should have 3 notes from previous test
@@ -329,7 +329,7 @@ func TestDeleteNote(t *testing.T) {
 		require.NoError(t, err)
 
 		// Verify note is deleted
-		deletedNote, err := service.GetNoteByID(ctx, note.ID)
+		deletedNote, err := service.GetNoteByID(ctx, user1.ID, note.ID)
 		assert.Error(t, err)
 		assert.Nil(t, deletedNote)
 	})
@@ -347,7 +347,7 @@ func TestDeleteNote(t *testing.T) {
 		assert.Contains(t, err.Error(), "unauthorized")
 
 		// Verify note still exists
-		existingNote, err := service.GetNoteByID(ctx, note.ID)
+		existingNote, err := service.GetNoteByID(ctx, user1.ID, note.ID)
 		require.NoError(t, err)
 		assert.NotNil(t, existingNote)
 	})
@@ -371,7 +371,7 @@ func TestCountNotesByLead(t *testing.T) {
 	lead := createTestLead(t, client)
 
 	t.Run("Zero count - No notes", func(t *testing.T) {
-		count, err := service.CountNotesByLead(ctx, lead.ID)
+		count, err := service.CountNotesByLead(ctx, user.ID, lead.ID)
 
 		require.NoError(t, err)
 		assert.Equal(t, 0, count)
@@ -387,23 +387,106 @@ func TestCountNotesByLead(t *testing.T) {
 			require.NoError(t, err)
 		}
 
-		count, err := service.CountNotesByLead(ctx, lead.ID)
+		count, err := service.CountNotesByLead(ctx, user.ID, lead.ID)
 
 		require.NoError(t, err)
 		assert.Equal(t, 3, count)
 	})
 
 	t.Run("Success - Count after deletion", func(t *testing.T) {
-		notes, err := service.ListNotesByLead(ctx, lead.ID)
+		notes, err := service.ListNotesByLead(ctx, user.ID, lead.ID)
 		require.NoError(t, err)
 
 		// Delete one note
 		err = service.DeleteNote(ctx, user.ID, notes[0].ID)
 		require.NoError(t, err)
 
-		count, err := service.CountNotesByLead(ctx, lead.ID)
+		count, err := service.CountNotesByLead(ctx, user.ID, lead.ID)
 
 		require.NoError(t, err)
 		assert.Equal(t, 2, count)
 	})
 }
+
+func TestNoteVisibility(t *testing.T) {
+	client, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	service := NewService(client)
+
+	author := createTestUser(t, client, "author@example.com", "Author")
+	teammate := createTestUser(t, client, "teammate@example.com", "Teammate")
+	lead := createTestLead(t, client)
+
+	teamNote, err := service.CreateNote(ctx, author.ID, CreateNoteRequest{
+		LeadID:  lead.ID,
+		Content: "Shared with the team",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "team", teamNote.Visibility, "notes default to team visibility")
+
+	privateNote, err := service.CreateNote(ctx, author.ID, CreateNoteRequest{
+		LeadID:     lead.ID,
+		Content:    "Only for me",
+		Visibility: "private",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "private", privateNote.Visibility)
+
+	_, err = service.CreateNote(ctx, author.ID, CreateNoteRequest{
+		LeadID:     lead.ID,
+		Content:    "Public?",
+		Visibility: "public",
+	})
+	assert.ErrorIs(t, err, ErrInvalidVisibility)
+
+	t.Run("Author sees both notes", func(t *testing.T) {
+		notes, err := service.ListNotesByLead(ctx, author.ID, lead.ID)
+		require.NoError(t, err)
+		assert.Len(t, notes, 2)
+
+		count, err := service.CountNotesByLead(ctx, author.ID, lead.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+
+		note, err := service.GetNoteByID(ctx, author.ID, privateNote.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Only for me", note.Content)
+	})
+
+	t.Run("Private notes don't leak to other members", func(t *testing.T) {
+		notes, err := service.ListNotesByLead(ctx, teammate.ID, lead.ID)
+		require.NoError(t, err)
+		require.Len(t, notes, 1)
+		assert.Equal(t, teamNote.ID, notes[0].ID)
+
+		count, err := service.CountNotesByLead(ctx, teammate.ID, lead.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+
+		_, err = service.GetNoteByID(ctx, teammate.ID, privateNote.ID)
+		assert.EqualError(t, err, "note not found")
+
+		// Nor does their existence, through update or delete
+		content := "Edited"
+		_, err = service.UpdateNote(ctx, teammate.ID, privateNote.ID, UpdateNoteRequest{Content: &content})
+		assert.EqualError(t, err, "note not found")
+		assert.EqualError(t, service.DeleteNote(ctx, teammate.ID, privateNote.ID), "note not found")
+	})
+
+	t.Run("Author can share a private note", func(t *testing.T) {
+		team := "team"
+		updated, err := service.UpdateNote(ctx, author.ID, privateNote.ID, UpdateNoteRequest{Visibility: &team})
+		require.NoError(t, err)
+		assert.Equal(t, "team", updated.Visibility)
+
+		note, err := service.GetNoteByID(ctx, teammate.ID, privateNote.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Only for me", note.Content)
+
+		invalid := "everyone"
+		_, err = service.UpdateNote(ctx, author.ID, privateNote.ID, UpdateNoteRequest{Visibility: &invalid})
+		assert.ErrorIs(t, err, ErrInvalidVisibility)
+	})
+}
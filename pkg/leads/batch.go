@@ -0,0 +1,86 @@
+package leads
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/pkg/models"
+)
+
+// MaxBatchGetIDs is the most distinct lead IDs one batch lookup may request
+const MaxBatchGetIDs = 100
+
+// ErrBatchTooLarge is returned when a batch lookup requests too many leads
+var ErrBatchTooLarge = fmt.Errorf("at most %d distinct lead IDs can be requested at once", MaxBatchGetIDs)
+
+// ErrBatchEmpty is returned when a batch lookup requests no leads
+var ErrBatchEmpty = errors.New("at least one lead ID is required")
+
+// BatchGetRequest looks up leads by ID. Duplicate IDs are ignored.
+type BatchGetRequest struct {
+	IDs []int `json:"ids" validate:"required,min=1,dive,min=1"`
+}
+
+// BatchGetResponse holds the leads found, in request order, and the
+// requested IDs that don't exist or aren't visible to the caller
+type BatchGetResponse struct {
+	Data     []models.LeadResponse `json:"data"`
+	NotFound []int                 `json:"not_found"`
+}
+
+// GetByIDs retrieves the leads with the given IDs, deduplicated, that are
+// visible in orgScope when lead scoping is on (see SetOrgScoping)
+func (s *Service) GetByIDs(ctx context.Context, ids []int, orgScope *int) (*BatchGetResponse, error) {
+	unique := dedupeIDs(ids)
+	if len(unique) == 0 {
+		return nil, ErrBatchEmpty
+	}
+	if len(unique) > MaxBatchGetIDs {
+		return nil, ErrBatchTooLarge
+	}
+
+	preds, err := s.scopedPredicates(ctx, models.LeadSearchRequest{OrgScope: orgScope})
+	if err != nil {
+		return nil, err
+	}
+	found, err := s.db.Lead.Query().
+		Where(append(preds, lead.IDIn(unique...))...).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get leads: %w", err)
+	}
+
+	byID := make(map[int]models.LeadResponse, len(found))
+	for _, l := range found {
+		byID[l.ID] = s.toLeadResponse(l)
+	}
+
+	response := &BatchGetResponse{
+		Data:     make([]models.LeadResponse, 0, len(found)),
+		NotFound: []int{},
+	}
+	for _, id := range unique {
+		if l, ok := byID[id]; ok {
+			response.Data = append(response.Data, l)
+		} else {
+			response.NotFound = append(response.NotFound, id)
+		}
+	}
+	return response, nil
+}
+
+// dedupeIDs removes duplicate IDs, keeping the first occurrence
+func dedupeIDs(ids []int) []int {
+	seen := make(map[int]bool, len(ids))
+	unique := make([]int, 0, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		unique = append(unique, id)
+	}
+	return unique
+}
@@ -0,0 +1,66 @@
+package leads
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetByIDs(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:leads_batch?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	service := NewService(client, nil)
+
+	acme := createScopeTestOrganization(t, client, "acme")
+	first := createScopeTestLead(t, client, "First", "tattoo", "US", nil)
+	second := createScopeTestLead(t, client, "Second", "gym", "US", nil)
+	owned := createScopeTestLead(t, client, "Acme Owned", "gym", "US", &acme.ID)
+
+	t.Run("Request order, duplicates and missing IDs", func(t *testing.T) {
+		result, err := service.GetByIDs(ctx, []int{second.ID, 99999, first.ID, second.ID}, nil)
+		require.NoError(t, err)
+
+		require.Len(t, result.Data, 2)
+		assert.Equal(t, second.ID, result.Data[0].ID)
+		assert.Equal(t, first.ID, result.Data[1].ID)
+		assert.Equal(t, []int{99999}, result.NotFound)
+	})
+
+	t.Run("Batch size", func(t *testing.T) {
+		_, err := service.GetByIDs(ctx, nil, nil)
+		assert.ErrorIs(t, err, ErrBatchEmpty)
+
+		ids := make([]int, 0, MaxBatchGetIDs+1)
+		for i := 1; i <= MaxBatchGetIDs+1; i++ {
+			ids = append(ids, i)
+		}
+		_, err = service.GetByIDs(ctx, ids, nil)
+		assert.ErrorIs(t, err, ErrBatchTooLarge)
+
+		// Duplicates don't count toward the cap
+		_, err = service.GetByIDs(ctx, append(ids[:MaxBatchGetIDs], 1, 2, 3), nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Organization scoping", func(t *testing.T) {
+		service.SetOrgScoping(true)
+		defer service.SetOrgScoping(false)
+
+		result, err := service.GetByIDs(ctx, []int{first.ID, owned.ID}, nil)
+		require.NoError(t, err)
+		require.Len(t, result.Data, 1)
+		assert.Equal(t, first.ID, result.Data[0].ID)
+		assert.Equal(t, []int{owned.ID}, result.NotFound)
+
+		result, err = service.GetByIDs(ctx, []int{first.ID, owned.ID}, &acme.ID)
+		require.NoError(t, err)
+		require.Len(t, result.Data, 1)
+		assert.Equal(t, owned.ID, result.Data[0].ID)
+		assert.Equal(t, []int{first.ID}, result.NotFound)
+	})
+}
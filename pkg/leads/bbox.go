@@ -0,0 +1,76 @@
+package leads
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+)
+
+// ErrInvalidBoundingBox is returned for a malformed bbox search parameter
+var ErrInvalidBoundingBox = errors.New("invalid bounding box")
+
+// MaxBoundingBoxResults caps the leads a bounding box search pages through.
+// Larger matches report zoom_in so map clients narrow the viewport instead
+// of paging through a continent.
+const MaxBoundingBoxResults = 1000
+
+// BoundingBox is a rectangle in degrees. A box whose MinLng is greater than
+// its MaxLng crosses the antimeridian.
+type BoundingBox struct {
+	MinLng float64
+	MinLat float64
+	MaxLng float64
+	MaxLat float64
+}
+
+// ParseBoundingBox parses "min_lng,min_lat,max_lng,max_lat" (GeoJSON bbox
+// order). An empty value returns nil.
+func ParseBoundingBox(value string) (*BoundingBox, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(value, ",")
+	if len(parts) != 4 {
+		return nil, fmt.Errorf("%w: expected min_lng,min_lat,max_lng,max_lat", ErrInvalidBoundingBox)
+	}
+	coords := make([]float64, 4)
+	for i, part := range parts {
+		coord, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil || math.IsNaN(coord) {
+			return nil, fmt.Errorf("%w: %q is not a number", ErrInvalidBoundingBox, part)
+		}
+		coords[i] = coord
+	}
+
+	box := &BoundingBox{MinLng: coords[0], MinLat: coords[1], MaxLng: coords[2], MaxLat: coords[3]}
+	switch {
+	case math.Abs(box.MinLng) > 180 || math.Abs(box.MaxLng) > 180:
+		return nil, fmt.Errorf("%w: longitudes must be between -180 and 180", ErrInvalidBoundingBox)
+	case math.Abs(box.MinLat) > 90 || math.Abs(box.MaxLat) > 90:
+		return nil, fmt.Errorf("%w: latitudes must be between -90 and 90", ErrInvalidBoundingBox)
+	case box.MinLat > box.MaxLat:
+		return nil, fmt.Errorf("%w: min_lat cannot be greater than max_lat", ErrInvalidBoundingBox)
+	}
+	return box, nil
+}
+
+// predicate matches the leads with coordinates inside the box. Leads without
+// coordinates, stored as 0,0, never match.
+func (b *BoundingBox) predicate() predicate.Lead {
+	lng := lead.And(lead.LongitudeGTE(b.MinLng), lead.LongitudeLTE(b.MaxLng))
+	if b.MinLng > b.MaxLng {
+		lng = lead.Or(lead.LongitudeGTE(b.MinLng), lead.LongitudeLTE(b.MaxLng))
+	}
+	return lead.And(
+		lead.LatitudeGTE(b.MinLat),
+		lead.LatitudeLTE(b.MaxLat),
+		lng,
+		lead.Not(lead.And(lead.LatitudeEQ(0), lead.LongitudeEQ(0))),
+	)
+}
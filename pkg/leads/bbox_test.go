@@ -0,0 +1,143 @@
+package leads
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBoundingBox(t *testing.T) {
+	box, err := ParseBoundingBox("-74.1, 40.6,-73.9,40.9")
+	require.NoError(t, err)
+	assert.Equal(t, &BoundingBox{MinLng: -74.1, MinLat: 40.6, MaxLng: -73.9, MaxLat: 40.9}, box)
+
+	box, err = ParseBoundingBox("")
+	require.NoError(t, err)
+	assert.Nil(t, box)
+
+	for _, value := range []string{
+		"-74.1,40.6,-73.9",        // too few numbers
+		"-74.1,40.6,-73.9,40.9,1", // too many numbers
+		"west,40.6,-73.9,40.9",    // not a number
+		"-74.1,NaN,-73.9,40.9",    // NaN
+		"-181,40.6,-73.9,40.9",    // longitude out of range
+		"-74.1,40.6,-73.9,91",     // latitude out of range
+		"-74.1,40.9,-73.9,40.6",   // min_lat above max_lat
+	} {
+		_, err := ParseBoundingBox(value)
+		assert.ErrorIs(t, err, ErrInvalidBoundingBox, value)
+	}
+}
+
+func TestSearch_BoundingBox(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+	defer client.Close()
+	service := NewService(client, nil)
+	ctx := context.Background()
+
+	createLead := func(name string, industry lead.Industry, lat, lng float64) {
+		_, err := client.Lead.Create().
+			SetName(name).
+			SetIndustry(industry).
+			SetCountry("US").
+			SetCity("Test City").
+			SetLatitude(lat).
+			SetLongitude(lng).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+	createLead("Manhattan Tattoo", lead.IndustryTattoo, 40.7580, -73.9855)
+	createLead("Brooklyn Tattoo", lead.IndustryTattoo, 40.6782, -73.9442)
+	createLead("Manhattan Gym", lead.IndustryGym, 40.7484, -73.9857)
+	createLead("Boston Tattoo", lead.IndustryTattoo, 42.3601, -71.0589)
+	createLead("Fiji Tattoo", lead.IndustryTattoo, -17.7134, 178.0650)
+	createLead("Samoa Tattoo", lead.IndustryTattoo, -13.7590, -172.1046)
+	createLead("No Coordinates", lead.IndustryTattoo, 0, 0)
+
+	search := func(req models.LeadSearchRequest) *models.LeadListResponse {
+		req.Page, req.Limit = 1, 10
+		result, err := service.Search(ctx, req)
+		require.NoError(t, err)
+		return result
+	}
+	names := func(result *models.LeadListResponse) []string {
+		var names []string
+		for _, l := range result.Data {
+			names = append(names, l.Name)
+		}
+		return names
+	}
+
+	t.Run("matches leads inside the box", func(t *testing.T) {
+		result := search(models.LeadSearchRequest{BBox: "-74.1,40.6,-73.9,40.9"})
+		assert.ElementsMatch(t, []string{"Manhattan Tattoo", "Brooklyn Tattoo", "Manhattan Gym"}, names(result))
+		assert.Equal(t, "-74.1,40.6,-73.9,40.9", result.Filters.BBox)
+		assert.False(t, result.ZoomIn)
+	})
+
+	t.Run("composes with other filters", func(t *testing.T) {
+		result := search(models.LeadSearchRequest{BBox: "-74.1,40.6,-73.9,40.9", Industry: "tattoo"})
+		assert.ElementsMatch(t, []string{"Manhattan Tattoo", "Brooklyn Tattoo"}, names(result))
+	})
+
+	t.Run("leads without coordinates never match", func(t *testing.T) {
+		result := search(models.LeadSearchRequest{BBox: "-10,-10,10,10"})
+		assert.Empty(t, result.Data)
+	})
+
+	t.Run("box crossing the antimeridian", func(t *testing.T) {
+		result := search(models.LeadSearchRequest{BBox: "170,-25,-170,-5"})
+		assert.ElementsMatch(t, []string{"Fiji Tattoo", "Samoa Tattoo"}, names(result))
+	})
+
+	t.Run("invalid box", func(t *testing.T) {
+		_, err := service.Search(ctx, models.LeadSearchRequest{BBox: "1,2,3", Page: 1, Limit: 10})
+		assert.ErrorIs(t, err, ErrInvalidBoundingBox)
+	})
+}
+
+func TestSearch_BoundingBoxZoomIn(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+	defer client.Close()
+	service := NewService(client, nil)
+	ctx := context.Background()
+
+	builders := make([]*ent.LeadCreate, MaxBoundingBoxResults+5)
+	for i := range builders {
+		builders[i] = client.Lead.Create().
+			SetName("Tattoo").
+			SetIndustry(lead.IndustryTattoo).
+			SetCountry("US").
+			SetCity("New York").
+			SetLatitude(40.7).
+			SetLongitude(-74.0)
+	}
+	require.NoError(t, client.Lead.CreateBulk(builders...).Exec(ctx))
+
+	req := models.LeadSearchRequest{BBox: "-75,40,-73,41", Page: 10, Limit: 100}
+	result, err := service.Search(ctx, req)
+	require.NoError(t, err)
+	assert.True(t, result.ZoomIn)
+	assert.Equal(t, MaxBoundingBoxResults+5, result.Pagination.Total)
+	assert.Equal(t, 10, result.Pagination.TotalPages)
+	assert.Len(t, result.Data, 100)
+	assert.False(t, result.Pagination.HasNext)
+
+	// Pages past the cap are empty
+	req.Page = 11
+	result, err = service.Search(ctx, req)
+	require.NoError(t, err)
+	assert.Empty(t, result.Data)
+
+	// Without a box the same leads page normally
+	result, err = service.Search(ctx, models.LeadSearchRequest{Page: 11, Limit: 100})
+	require.NoError(t, err)
+	assert.False(t, result.ZoomIn)
+	assert.Len(t, result.Data, 5)
+}
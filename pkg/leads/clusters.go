@@ -0,0 +1,209 @@
+package leads
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/pkg/models"
+)
+
+// ErrInvalidZoom is returned for a cluster zoom level out of range
+var ErrInvalidZoom = errors.New("invalid zoom level")
+
+const (
+	// MaxClusterZoom is the deepest map zoom level clusters accept
+	MaxClusterZoom = 22
+	// IndividualLeadsZoom is the zoom level from which GET /leads/clusters
+	// returns individual leads instead of clusters
+	IndividualLeadsZoom = 15
+	// clusterCellsPerTile splits each map tile side into this many grid
+	// cells, so clusters are about 64px apart on 256px tiles
+	clusterCellsPerTile = 4
+)
+
+// LeadCluster is the leads of one grid cell, placed at their centroid
+type LeadCluster struct {
+	Key        string         `json:"key"` // "zoom/x/y" grid cell, stable across requests
+	Latitude   float64        `json:"latitude"`
+	Longitude  float64        `json:"longitude"`
+	Count      int            `json:"count"`
+	Industries map[string]int `json:"industries"`        // Lead count per industry
+	LeadID     *int           `json:"lead_id,omitempty"` // Set when the cluster is a single lead
+}
+
+// LeadPoint is a lead shown individually on the map. It carries no contact
+// data: clients fetch a lead's details when it is selected.
+type LeadPoint struct {
+	ID        int     `json:"id"`
+	Name      string  `json:"name"`
+	Industry  string  `json:"industry"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// LeadClusterResponse aggregates the leads inside a bounding box for map
+// display: clusters below IndividualLeadsZoom, individual leads from it
+type LeadClusterResponse struct {
+	Zoom     int           `json:"zoom"`
+	BBox     string        `json:"bbox"`
+	Total    int           `json:"total"` // Leads inside the box
+	Clusters []LeadCluster `json:"clusters"`
+	Leads    []LeadPoint   `json:"leads,omitempty"`
+	// ZoomIn is set when more than MaxBoundingBoxResults leads would be
+	// returned individually and only the first are
+	ZoomIn bool `json:"zoom_in,omitempty"`
+}
+
+// clusterRow is the lead data clustering reads
+type clusterRow struct {
+	ID        int     `json:"id"`
+	Name      string  `json:"name"`
+	Industry  string  `json:"industry"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// clusterCellSize returns the side in degrees of the grid cells at a zoom
+// level. The grid is anchored at -180,-90, so a cell's leads cluster the same
+// whatever the viewport.
+func clusterCellSize(zoom int) float64 {
+	return 360 / float64(int(1)<<zoom) / clusterCellsPerTile
+}
+
+// Clusters aggregates the leads matching a search, which must have a
+// bounding box, for a map at the given zoom level. Results are cached for 5
+// minutes.
+func (s *Service) Clusters(ctx context.Context, req models.LeadSearchRequest, zoom int) (*LeadClusterResponse, error) {
+	if zoom < 0 || zoom > MaxClusterZoom {
+		return nil, fmt.Errorf("%w: must be between 0 and %d", ErrInvalidZoom, MaxClusterZoom)
+	}
+	if req.BBox == "" {
+		return nil, fmt.Errorf("%w: bbox is required", ErrInvalidBoundingBox)
+	}
+	req.Page, req.Limit = 1, 1
+
+	cacheKey := fmt.Sprintf("leads:clusters:%d:%s", zoom, s.generateCacheKey(req))
+	if s.cache != nil {
+		if cached, err := s.cache.Get(ctx, cacheKey); err == nil && cached != "" {
+			var response LeadClusterResponse
+			if err := json.Unmarshal([]byte(cached), &response); err == nil {
+				return &response, nil
+			}
+		}
+	}
+
+	preds, err := s.scopedPredicates(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	query := s.db.Lead.Query().Where(preds...)
+
+	response := &LeadClusterResponse{Zoom: zoom, BBox: req.BBox, Clusters: []LeadCluster{}}
+	if zoom >= IndividualLeadsZoom {
+		err = s.clusterLeads(ctx, query, response)
+	} else {
+		err = s.clusterCells(ctx, query, zoom, response)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if s.cache != nil {
+		if responseJSON, err := json.Marshal(response); err == nil {
+			_ = s.cache.Set(ctx, cacheKey, responseJSON, 5*time.Minute)
+		}
+	}
+	return response, nil
+}
+
+// clusterLeads lists the leads individually, up to MaxBoundingBoxResults
+func (s *Service) clusterLeads(ctx context.Context, query *ent.LeadQuery, response *LeadClusterResponse) error {
+	var rows []clusterRow
+	err := query.Clone().
+		Order(ent.Asc(lead.FieldID)).
+		Limit(MaxBoundingBoxResults+1).
+		Select(lead.FieldID, lead.FieldName, lead.FieldIndustry, lead.FieldLatitude, lead.FieldLongitude).
+		Scan(ctx, &rows)
+	if err != nil {
+		return fmt.Errorf("failed to query leads: %w", err)
+	}
+
+	response.Total = len(rows)
+	if len(rows) > MaxBoundingBoxResults {
+		response.ZoomIn = true
+		rows = rows[:MaxBoundingBoxResults]
+		if response.Total, err = query.Count(ctx); err != nil {
+			return fmt.Errorf("failed to count leads: %w", err)
+		}
+	}
+
+	response.Leads = make([]LeadPoint, len(rows))
+	for i, row := range rows {
+		response.Leads[i] = LeadPoint(row)
+	}
+	return nil
+}
+
+// clusterCells buckets the leads into grid cells. Only the coordinates and
+// industry are read, so the box is aggregated in one pass.
+func (s *Service) clusterCells(ctx context.Context, query *ent.LeadQuery, zoom int, response *LeadClusterResponse) error {
+	var rows []clusterRow
+	err := query.Clone().
+		Select(lead.FieldID, lead.FieldIndustry, lead.FieldLatitude, lead.FieldLongitude).
+		Scan(ctx, &rows)
+	if err != nil {
+		return fmt.Errorf("failed to query lead coordinates: %w", err)
+	}
+
+	type cell struct {
+		x, y     int
+		lat, lng float64 // Coordinate sums
+		leadID   int
+		cluster  LeadCluster
+	}
+	size := clusterCellSize(zoom)
+	cells := make(map[[2]int]*cell)
+	for _, row := range rows {
+		x := int(math.Floor((row.Longitude + 180) / size))
+		y := int(math.Floor((row.Latitude + 90) / size))
+		c, ok := cells[[2]int{x, y}]
+		if !ok {
+			c = &cell{x: x, y: y, leadID: row.ID, cluster: LeadCluster{Industries: map[string]int{}}}
+			cells[[2]int{x, y}] = c
+		}
+		c.lat += row.Latitude
+		c.lng += row.Longitude
+		c.cluster.Count++
+		c.cluster.Industries[row.Industry]++
+	}
+
+	for _, c := range cells {
+		cluster := c.cluster
+		cluster.Key = fmt.Sprintf("%d/%d/%d", zoom, c.x, c.y)
+		cluster.Latitude = c.lat / float64(cluster.Count)
+		cluster.Longitude = c.lng / float64(cluster.Count)
+		if cluster.Count == 1 {
+			leadID := c.leadID
+			cluster.LeadID = &leadID
+		}
+		response.Clusters = append(response.Clusters, cluster)
+	}
+	// Largest clusters first
+	sort.Slice(response.Clusters, func(i, j int) bool {
+		a, b := response.Clusters[i], response.Clusters[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		return a.Key < b.Key
+	})
+
+	response.Total = len(rows)
+	return nil
+}
@@ -0,0 +1,158 @@
+package leads
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/pkg/cache"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupClustersTest(t *testing.T) (*ent.Client, *Service) {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	ctx := context.Background()
+	for _, l := range []struct {
+		name     string
+		industry lead.Industry
+		lat, lng float64
+	}{
+		{"Midtown Tattoo", lead.IndustryTattoo, 40.7580, -73.9855},
+		{"Midtown Gym", lead.IndustryGym, 40.7484, -73.9857},
+		{"Brooklyn Tattoo", lead.IndustryTattoo, 40.6782, -73.9442},
+		{"Boston Tattoo", lead.IndustryTattoo, 42.3601, -71.0589},
+		{"No Coordinates", lead.IndustryTattoo, 0, 0},
+	} {
+		_, err := client.Lead.Create().
+			SetName(l.name).
+			SetIndustry(l.industry).
+			SetCountry("US").
+			SetCity("Test City").
+			SetLatitude(l.lat).
+			SetLongitude(l.lng).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+	return client, NewService(client, nil)
+}
+
+func TestClusters_Grid(t *testing.T) {
+	_, service := setupClustersTest(t)
+	ctx := context.Background()
+	req := models.LeadSearchRequest{BBox: "-75,40,-70,43"}
+
+	t.Run("low zoom merges nearby leads", func(t *testing.T) {
+		// Cells are 22.5° at zoom 2: New York and Boston share one
+		result, err := service.Clusters(ctx, req, 2)
+		require.NoError(t, err)
+		assert.Equal(t, 4, result.Total)
+		assert.Empty(t, result.Leads)
+		require.Len(t, result.Clusters, 1)
+
+		cluster := result.Clusters[0]
+		assert.Equal(t, 4, cluster.Count)
+		assert.Equal(t, map[string]int{"tattoo": 3, "gym": 1}, cluster.Industries)
+		assert.InDelta(t, (40.7580+40.7484+40.6782+42.3601)/4, cluster.Latitude, 1e-9)
+		assert.InDelta(t, (-73.9855-73.9857-73.9442-71.0589)/4, cluster.Longitude, 1e-9)
+		assert.Nil(t, cluster.LeadID)
+	})
+
+	t.Run("higher zoom splits clusters", func(t *testing.T) {
+		// Cells are about 0.09° at zoom 10
+		result, err := service.Clusters(ctx, req, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 4, result.Total)
+		require.Len(t, result.Clusters, 3)
+
+		// Largest cluster first
+		assert.Equal(t, 2, result.Clusters[0].Count)
+		assert.Equal(t, map[string]int{"tattoo": 1, "gym": 1}, result.Clusters[0].Industries)
+		for _, cluster := range result.Clusters[1:] {
+			assert.Equal(t, 1, cluster.Count)
+			assert.NotNil(t, cluster.LeadID)
+		}
+	})
+
+	t.Run("filters apply", func(t *testing.T) {
+		filtered := req
+		filtered.Industry = "tattoo"
+		result, err := service.Clusters(ctx, filtered, 2)
+		require.NoError(t, err)
+		require.Len(t, result.Clusters, 1)
+		assert.Equal(t, map[string]int{"tattoo": 3}, result.Clusters[0].Industries)
+	})
+
+	t.Run("cell keys don't depend on the viewport", func(t *testing.T) {
+		wide, err := service.Clusters(ctx, models.LeadSearchRequest{BBox: "-80,35,-65,45"}, 10)
+		require.NoError(t, err)
+		narrow, err := service.Clusters(ctx, req, 10)
+		require.NoError(t, err)
+		assert.Equal(t, narrow.Clusters, wide.Clusters)
+	})
+}
+
+func TestClusters_IndividualLeads(t *testing.T) {
+	_, service := setupClustersTest(t)
+
+	result, err := service.Clusters(context.Background(), models.LeadSearchRequest{BBox: "-74.1,40.6,-73.9,40.9"}, IndividualLeadsZoom)
+	require.NoError(t, err)
+	assert.Equal(t, 3, result.Total)
+	assert.Empty(t, result.Clusters)
+	require.Len(t, result.Leads, 3)
+	assert.Equal(t, "Midtown Tattoo", result.Leads[0].Name)
+	assert.Equal(t, "tattoo", result.Leads[0].Industry)
+	assert.Equal(t, 40.7580, result.Leads[0].Latitude)
+	assert.False(t, result.ZoomIn)
+}
+
+func TestClusters_InvalidRequest(t *testing.T) {
+	_, service := setupClustersTest(t)
+	ctx := context.Background()
+
+	_, err := service.Clusters(ctx, models.LeadSearchRequest{}, 5)
+	assert.ErrorIs(t, err, ErrInvalidBoundingBox)
+
+	_, err = service.Clusters(ctx, models.LeadSearchRequest{BBox: "-75,40,-70,43"}, MaxClusterZoom+1)
+	assert.ErrorIs(t, err, ErrInvalidZoom)
+}
+
+func TestClusters_Cached(t *testing.T) {
+	client, _ := setupClustersTest(t)
+	mr := miniredis.RunT(t)
+	cacheClient, err := cache.NewClient("redis://" + mr.Addr())
+	require.NoError(t, err)
+	service := NewService(client, cacheClient)
+	ctx := context.Background()
+	req := models.LeadSearchRequest{BBox: "-75,40,-70,43"}
+
+	first, err := service.Clusters(ctx, req, 2)
+	require.NoError(t, err)
+
+	// A lead added after the first request is only seen once the cache is
+	// invalidated
+	_, err = client.Lead.Create().
+		SetName("New Tattoo").
+		SetIndustry(lead.IndustryTattoo).
+		SetCountry("US").
+		SetCity("Test City").
+		SetLatitude(41).
+		SetLongitude(-72).
+		Save(ctx)
+	require.NoError(t, err)
+
+	cached, err := service.Clusters(ctx, req, 2)
+	require.NoError(t, err)
+	assert.Equal(t, first, cached)
+
+	require.NoError(t, service.InvalidateCache(ctx))
+	fresh, err := service.Clusters(ctx, req, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 5, fresh.Total)
+}
@@ -0,0 +1,64 @@
+package leads
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jordanlanch/industrydb/ent"
+)
+
+// Completeness weights: the points a lead earns for each populated field.
+// They sum to 100. Unlike the quality score, completeness ignores
+// verification and recency: it only measures how much we know.
+const (
+	CompletenessEmail       = 25
+	CompletenessPhone       = 25
+	CompletenessWebsite     = 20
+	CompletenessAddress     = 15
+	CompletenessCoordinates = 15
+)
+
+// CalculateCompleteness computes a lead's completeness score (0-100) from
+// which of email, phone, website, address and coordinates are populated.
+// Coordinates count when either is non-zero, as missing ones are stored as 0.
+func CalculateCompleteness(l *ent.Lead) int {
+	score := 0
+
+	if l.Email != "" {
+		score += CompletenessEmail
+	}
+	if l.Phone != "" {
+		score += CompletenessPhone
+	}
+	if l.Website != "" {
+		score += CompletenessWebsite
+	}
+	if l.Address != "" {
+		score += CompletenessAddress
+	}
+	if l.Latitude != 0 || l.Longitude != 0 {
+		score += CompletenessCoordinates
+	}
+
+	return score
+}
+
+// RefreshCompleteness recalculates a lead's completeness score and saves it
+// when it changed, leaving updated_at as is. It accepts a LeadClient so it
+// can run inside a transaction.
+func RefreshCompleteness(ctx context.Context, leads *ent.LeadClient, l *ent.Lead) (*ent.Lead, error) {
+	score := CalculateCompleteness(l)
+	if score == l.CompletenessScore {
+		return l, nil
+	}
+
+	updated, err := leads.UpdateOne(l).
+		SetCompletenessScore(score).
+		Modify(KeepUpdatedAt).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update completeness score: %w", err)
+	}
+
+	return updated, nil
+}
@@ -0,0 +1,70 @@
+package leads
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalculateCompleteness(t *testing.T) {
+	t.Run("Empty lead scores zero", func(t *testing.T) {
+		assert.Equal(t, 0, CalculateCompleteness(&ent.Lead{Verified: true, QualityScore: 90}))
+	})
+
+	t.Run("All fields score 100", func(t *testing.T) {
+		l := &ent.Lead{
+			Email:     "a@example.com",
+			Phone:     "+12125551234",
+			Website:   "https://example.com",
+			Address:   "1 Main St",
+			Latitude:  40.7,
+			Longitude: -74.0,
+		}
+		assert.Equal(t, 100, CalculateCompleteness(l))
+	})
+
+	t.Run("Weights add up per field", func(t *testing.T) {
+		l := &ent.Lead{Phone: "+12125551234", Longitude: 32.5}
+		assert.Equal(t, CompletenessPhone+CompletenessCoordinates, CalculateCompleteness(l))
+	})
+}
+
+func TestCompletenessSearch(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:leads_completeness?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	service := NewService(client, nil)
+
+	partial := createTestLeadWithFields(t, client, "Partial", true, false, false, false)
+	full := createTestLeadWithFields(t, client, "Full", true, true, true, false)
+	empty := createTestLeadWithFields(t, client, "Empty", false, false, false, false)
+
+	_, err := service.RecomputeQualityScores(ctx, nil)
+	require.NoError(t, err)
+
+	minScore := CompletenessEmail
+	response, err := service.Search(ctx, models.LeadSearchRequest{
+		MinCompleteness: &minScore,
+		SortBy:          "completeness_score",
+		Page:            1,
+		Limit:           10,
+	})
+	require.NoError(t, err)
+	require.Len(t, response.Data, 2)
+	assert.Equal(t, full.ID, response.Data[0].ID)
+	assert.Equal(t, CompletenessEmail+CompletenessPhone+CompletenessWebsite, response.Data[0].CompletenessScore)
+	assert.Equal(t, partial.ID, response.Data[1].ID)
+	assert.Equal(t, &minScore, response.Filters.MinCompleteness)
+
+	maxScore := 0
+	response, err = service.Search(ctx, models.LeadSearchRequest{MaxCompleteness: &maxScore, Page: 1, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, response.Data, 1)
+	assert.Equal(t, empty.ID, response.Data[0].ID)
+}
@@ -0,0 +1,60 @@
+package leads
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/pkg/models"
+)
+
+// ExactCountThreshold is the largest estimated result counted exactly.
+// Larger results return the planner estimate, as COUNT(*) over them is slow.
+const ExactCountThreshold = 10000
+
+// RowEstimator estimates the rows a Postgres query returns from planner
+// statistics, without running it
+type RowEstimator interface {
+	EstimateRows(ctx context.Context, query string, args ...interface{}) (int, error)
+}
+
+// SetRowEstimator enables estimated counts for large results
+func (s *Service) SetRowEstimator(estimator RowEstimator) {
+	s.estimator = estimator
+}
+
+// EstimateCount counts the leads matching a search. Results the planner
+// estimates above ExactCountThreshold return that estimate with Exact false;
+// smaller results, or any result without a RowEstimator, are counted exactly.
+func (s *Service) EstimateCount(ctx context.Context, req models.LeadSearchRequest) (*models.LeadCountResponse, error) {
+	preds, err := s.scopedPredicates(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.estimator != nil {
+		selector := sql.Dialect(dialect.Postgres).
+			Select(lead.FieldID).
+			From(sql.Table(lead.Table))
+		for _, p := range preds {
+			p(selector)
+		}
+		query, args := selector.Query()
+
+		estimate, err := s.estimator.EstimateRows(ctx, query, args...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to estimate leads: %w", err)
+		}
+		if estimate > ExactCountThreshold {
+			return &models.LeadCountResponse{Count: estimate, Exact: false}, nil
+		}
+	}
+
+	count, err := s.db.Lead.Query().Where(preds...).Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count leads: %w", err)
+	}
+	return &models.LeadCountResponse{Count: count, Exact: true}, nil
+}
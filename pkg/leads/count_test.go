@@ -0,0 +1,61 @@
+package leads
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeEstimator returns a fixed planner estimate and records the query
+type fakeEstimator struct {
+	rows  int
+	query string
+	args  []interface{}
+}
+
+func (f *fakeEstimator) EstimateRows(ctx context.Context, query string, args ...interface{}) (int, error) {
+	f.query, f.args = query, args
+	return f.rows, nil
+}
+
+func TestEstimateCount(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:leads_count?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	service := NewService(client, nil)
+
+	createTestLeadWithFields(t, client, "With Email", true, false, false, false)
+	createTestLeadWithFields(t, client, "Without Email", false, false, false, false)
+	hasEmail := true
+
+	t.Run("Exact without an estimator", func(t *testing.T) {
+		count, err := service.EstimateCount(ctx, models.LeadSearchRequest{HasEmail: &hasEmail})
+		require.NoError(t, err)
+		assert.Equal(t, &models.LeadCountResponse{Count: 1, Exact: true}, count)
+	})
+
+	t.Run("Small estimates are counted exactly", func(t *testing.T) {
+		estimator := &fakeEstimator{rows: 40}
+		service.SetRowEstimator(estimator)
+
+		count, err := service.EstimateCount(ctx, models.LeadSearchRequest{Country: "US"})
+		require.NoError(t, err)
+		assert.Equal(t, &models.LeadCountResponse{Count: 2, Exact: true}, count)
+		assert.Contains(t, estimator.query, `FROM "leads"`)
+		assert.Contains(t, estimator.query, `"country" = $1`)
+		assert.Equal(t, []interface{}{"US"}, estimator.args)
+	})
+
+	t.Run("Large estimates are returned as is", func(t *testing.T) {
+		service.SetRowEstimator(&fakeEstimator{rows: ExactCountThreshold + 1})
+
+		count, err := service.EstimateCount(ctx, models.LeadSearchRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, &models.LeadCountResponse{Count: ExactCountThreshold + 1, Exact: false}, count)
+	})
+}
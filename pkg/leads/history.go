@@ -0,0 +1,170 @@
+package leads
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/leadchange"
+)
+
+// Change history limits for GetChangeHistory.
+const (
+	DefaultHistoryLimit = 50
+	MaxHistoryLimit     = 200
+)
+
+// untrackedFields are lead JSON keys left out of change history because they
+// change on every write or are not lead data.
+var untrackedFields = map[string]bool{
+	"id":         true,
+	"edges":      true,
+	"created_at": true,
+	"updated_at": true,
+}
+
+// FieldChange is a single field modification in a change history entry.
+type FieldChange struct {
+	Field string      `json:"field"`
+	Old   interface{} `json:"old"`
+	New   interface{} `json:"new"`
+}
+
+// ChangeEntry is one lead update in the change history.
+type ChangeEntry struct {
+	ID        int           `json:"id"`
+	UserID    *int          `json:"user_id,omitempty"`
+	Source    string        `json:"source"`
+	Changes   []FieldChange `json:"changes"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+// DiffLead returns the fields that differ between two versions of a lead,
+// keyed by JSON field name with an [old, new] pair as value.
+func DiffLead(before, after *ent.Lead) (map[string][]interface{}, error) {
+	oldFields, err := leadFields(before)
+	if err != nil {
+		return nil, err
+	}
+	newFields, err := leadFields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := make(map[string][]interface{})
+	for key, newValue := range newFields {
+		if oldValue := oldFields[key]; !reflect.DeepEqual(oldValue, newValue) {
+			diff[key] = []interface{}{oldValue, newValue}
+		}
+	}
+	for key, oldValue := range oldFields {
+		if _, ok := newFields[key]; !ok {
+			diff[key] = []interface{}{oldValue, nil}
+		}
+	}
+
+	return diff, nil
+}
+
+// leadFields flattens a lead into its tracked JSON fields. Empty values are
+// omitted by the ent JSON tags, so they compare as nil.
+func leadFields(l *ent.Lead) (map[string]interface{}, error) {
+	data, err := json.Marshal(l)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode lead: %w", err)
+	}
+
+	fields := make(map[string]interface{})
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("failed to decode lead: %w", err)
+	}
+	for key := range untrackedFields {
+		delete(fields, key)
+	}
+
+	return fields, nil
+}
+
+// RecordChange stores the fields that differ between before and after as a
+// single history entry. Nothing is written when no tracked field changed.
+// A userID of 0 records the change without an actor. It accepts a
+// LeadChangeClient so it can run inside a transaction.
+func RecordChange(ctx context.Context, changes *ent.LeadChangeClient, before, after *ent.Lead, source leadchange.Source, userID int) error {
+	diff, err := DiffLead(before, after)
+	if err != nil {
+		return err
+	}
+	if len(diff) == 0 {
+		return nil
+	}
+
+	builder := changes.Create().
+		SetLeadID(after.ID).
+		SetSource(source).
+		SetChanges(diff)
+	if userID > 0 {
+		builder.SetUserID(userID)
+	}
+
+	if _, err := builder.Save(ctx); err != nil {
+		return fmt.Errorf("failed to record lead change: %w", err)
+	}
+
+	return nil
+}
+
+// GetChangeHistory returns the field-level change history of a lead, newest
+// first. Fields within an entry are sorted by name.
+func (s *Service) GetChangeHistory(ctx context.Context, leadID, limit int) ([]ChangeEntry, error) {
+	if limit <= 0 {
+		limit = DefaultHistoryLimit
+	}
+	if limit > MaxHistoryLimit {
+		limit = MaxHistoryLimit
+	}
+
+	exists, err := s.db.Lead.Query().Where(lead.ID(leadID)).Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lead: %w", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("lead not found")
+	}
+
+	rows, err := s.db.LeadChange.Query().
+		Where(leadchange.LeadID(leadID)).
+		Order(ent.Desc(leadchange.FieldCreatedAt), ent.Desc(leadchange.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch change history: %w", err)
+	}
+
+	entries := make([]ChangeEntry, len(rows))
+	for i, row := range rows {
+		fields := make([]FieldChange, 0, len(row.Changes))
+		for name, pair := range row.Changes {
+			change := FieldChange{Field: name}
+			if len(pair) == 2 {
+				change.Old, change.New = pair[0], pair[1]
+			}
+			fields = append(fields, change)
+		}
+		sort.Slice(fields, func(a, b int) bool { return fields[a].Field < fields[b].Field })
+
+		entries[i] = ChangeEntry{
+			ID:        row.ID,
+			UserID:    row.UserID,
+			Source:    string(row.Source),
+			Changes:   fields,
+			CreatedAt: row.CreatedAt,
+		}
+	}
+
+	return entries, nil
+}
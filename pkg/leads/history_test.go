@@ -0,0 +1,95 @@
+package leads
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/ent/leadchange"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffLead(t *testing.T) {
+	before := &ent.Lead{ID: 1, Name: "Ink", Email: "old@example.com", Phone: "+1234567890"}
+
+	t.Run("Only changed fields are returned", func(t *testing.T) {
+		after := *before
+		after.Email = "new@example.com"
+		after.Website = "https://example.com"
+		after.Phone = ""
+
+		diff, err := DiffLead(before, &after)
+		require.NoError(t, err)
+		assert.Equal(t, map[string][]interface{}{
+			"email":   {"old@example.com", "new@example.com"},
+			"website": {nil, "https://example.com"},
+			"phone":   {"+1234567890", nil},
+		}, diff)
+	})
+
+	t.Run("Bookkeeping fields are ignored", func(t *testing.T) {
+		after := *before
+		after.UpdatedAt = before.UpdatedAt.AddDate(0, 0, 1)
+
+		diff, err := DiffLead(before, &after)
+		require.NoError(t, err)
+		assert.Empty(t, diff)
+	})
+}
+
+func TestChangeHistory(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:leads_history?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	service := NewService(client, nil)
+
+	user, err := client.User.Create().
+		SetEmail("editor@example.com").
+		SetPasswordHash("hash").
+		SetName("Editor").
+		Save(ctx)
+	require.NoError(t, err)
+
+	l := createTestLeadWithFields(t, client, "History", true, false, false, false)
+
+	updated, err := client.Lead.UpdateOne(l).SetPhone("+1234567890").SetEmail("owner@example.com").Save(ctx)
+	require.NoError(t, err)
+	require.NoError(t, RecordChange(ctx, client.LeadChange, l, updated, leadchange.SourceManual, user.ID))
+
+	// No-op updates are not recorded
+	require.NoError(t, RecordChange(ctx, client.LeadChange, updated, updated, leadchange.SourceManual, user.ID))
+
+	enriched, err := client.Lead.UpdateOne(updated).SetIsEnriched(true).Save(ctx)
+	require.NoError(t, err)
+	require.NoError(t, RecordChange(ctx, client.LeadChange, updated, enriched, leadchange.SourceEnrichment, 0))
+
+	history, err := service.GetChangeHistory(ctx, l.ID, 0)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+
+	assert.Equal(t, "enrichment", history[0].Source)
+	assert.Nil(t, history[0].UserID)
+	assert.Equal(t, []FieldChange{{Field: "is_enriched", Old: nil, New: true}}, history[0].Changes)
+
+	assert.Equal(t, "manual", history[1].Source)
+	require.NotNil(t, history[1].UserID)
+	assert.Equal(t, user.ID, *history[1].UserID)
+	assert.Equal(t, []FieldChange{
+		{Field: "email", Old: "test@example.com", New: "owner@example.com"},
+		{Field: "phone", Old: nil, New: "+1234567890"},
+	}, history[1].Changes)
+
+	t.Run("Limit caps entries", func(t *testing.T) {
+		history, err := service.GetChangeHistory(ctx, l.ID, 1)
+		require.NoError(t, err)
+		assert.Len(t, history, 1)
+	})
+
+	t.Run("Unknown lead", func(t *testing.T) {
+		_, err := service.GetChangeHistory(ctx, 99999, 0)
+		assert.EqualError(t, err, "lead not found")
+	})
+}
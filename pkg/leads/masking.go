@@ -0,0 +1,103 @@
+package leads
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jordanlanch/industrydb/ent/leadassignment"
+	"github.com/jordanlanch/industrydb/ent/organization"
+	"github.com/jordanlanch/industrydb/ent/organizationmember"
+	"github.com/jordanlanch/industrydb/pkg/models"
+)
+
+// ContactMasking hides lead emails and phones from a member of
+// organizations with the mask_unassigned_contacts policy, except on the
+// leads actively assigned to them
+type ContactMasking struct {
+	UserID int
+	// Mask every lead, when the request is made as a masking organization
+	all bool
+	// Masking organizations, whose owned leads are masked in any request
+	organizations map[int]bool
+}
+
+// ContactMaskingFor returns the masking for userID, acting in orgID when
+// set, or nil when they see contact info unmasked. Owners and admins of an
+// organization are never masked by it.
+func (s *Service) ContactMaskingFor(ctx context.Context, userID int, orgID *int) (*ContactMasking, error) {
+	orgIDs, err := s.db.OrganizationMember.Query().
+		Where(
+			organizationmember.UserID(userID),
+			organizationmember.StatusEQ(organizationmember.StatusActive),
+			organizationmember.RoleNotIn(organizationmember.RoleOwner, organizationmember.RoleAdmin),
+			organizationmember.HasOrganizationWith(
+				organization.MaskUnassignedContacts(true),
+				organization.OwnerIDNEQ(userID),
+			),
+		).
+		Select(organizationmember.FieldOrganizationID).
+		Ints(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load organization masking policies: %w", err)
+	}
+	if len(orgIDs) == 0 {
+		return nil, nil
+	}
+
+	masking := &ContactMasking{UserID: userID, organizations: make(map[int]bool, len(orgIDs))}
+	for _, id := range orgIDs {
+		masking.organizations[id] = true
+	}
+	masking.all = orgID != nil && masking.organizations[*orgID]
+	return masking, nil
+}
+
+// masks reports whether the masking applies to a lead, before assignments
+func (m *ContactMasking) masks(l models.LeadResponse) bool {
+	return m.all || (l.OwnerOrganizationID != nil && m.organizations[*l.OwnerOrganizationID])
+}
+
+// MaskContacts masks, in place, the email and phone of the leads the masking
+// applies to that aren't actively assigned to the masked user. A nil masking
+// leaves leads as is.
+func (s *Service) MaskContacts(ctx context.Context, masking *ContactMasking, leads []models.LeadResponse) error {
+	if masking == nil || len(leads) == 0 {
+		return nil
+	}
+
+	ids := make([]int, 0, len(leads))
+	for _, l := range leads {
+		if masking.masks(l) {
+			ids = append(ids, l.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	assignedIDs, err := s.db.LeadAssignment.Query().
+		Where(
+			leadassignment.LeadIDIn(ids...),
+			leadassignment.UserID(masking.UserID),
+			leadassignment.IsActive(true),
+		).
+		Select(leadassignment.FieldLeadID).
+		Ints(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load lead assignments: %w", err)
+	}
+	assigned := make(map[int]bool, len(assignedIDs))
+	for _, id := range assignedIDs {
+		assigned[id] = true
+	}
+
+	for i := range leads {
+		if !masking.masks(leads[i]) || assigned[leads[i].ID] {
+			continue
+		}
+		leads[i].Email = MaskEmail(leads[i].Email)
+		leads[i].Phone = MaskPhone(leads[i].Phone)
+		leads[i].ContactMasked = true
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package leads
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/ent/organizationmember"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContactMasking(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:leads_masking?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	service := NewService(client, nil)
+
+	owner := createSuppressionUser(t, client, "owner@example.com")
+	admin := createSuppressionUser(t, client, "admin@example.com")
+	junior := createSuppressionUser(t, client, "junior@example.com")
+
+	org := client.Organization.Create().
+		SetName("Sales Team").
+		SetSlug("sales-team").
+		SetOwnerID(owner.ID).
+		SetMaskUnassignedContacts(true).
+		SaveX(ctx)
+	client.OrganizationMember.Create().SetOrganizationID(org.ID).SetUserID(admin.ID).SetRole(organizationmember.RoleAdmin).SaveX(ctx)
+	client.OrganizationMember.Create().SetOrganizationID(org.ID).SetUserID(junior.ID).SetRole(organizationmember.RoleMember).SaveX(ctx)
+
+	assigned := createTestLeadWithFields(t, client, "Assigned Ink", true, true, false, false)
+	unassigned := createTestLeadWithFields(t, client, "Unassigned Ink", true, true, false, false)
+	client.LeadAssignment.Create().SetLeadID(assigned.ID).SetUserID(junior.ID).SaveX(ctx)
+
+	responses := func() []models.LeadResponse {
+		return []models.LeadResponse{
+			{ID: assigned.ID, Email: assigned.Email, Phone: assigned.Phone},
+			{ID: unassigned.ID, Email: unassigned.Email, Phone: unassigned.Phone},
+		}
+	}
+
+	t.Run("Members see contacts of their assigned leads only", func(t *testing.T) {
+		masking, err := service.ContactMaskingFor(ctx, junior.ID, &org.ID)
+		require.NoError(t, err)
+		require.NotNil(t, masking)
+
+		data := responses()
+		require.NoError(t, service.MaskContacts(ctx, masking, data))
+		assert.Equal(t, "test@example.com", data[0].Email)
+		assert.False(t, data[0].ContactMasked)
+		assert.Equal(t, "t***@e******.com", data[1].Email)
+		assert.Equal(t, "+********90", data[1].Phone)
+		assert.True(t, data[1].ContactMasked)
+	})
+
+	t.Run("Owners and admins are not masked", func(t *testing.T) {
+		for _, userID := range []int{owner.ID, admin.ID} {
+			masking, err := service.ContactMaskingFor(ctx, userID, &org.ID)
+			require.NoError(t, err)
+			assert.Nil(t, masking)
+		}
+	})
+
+	t.Run("Outside the organization only its own leads are masked", func(t *testing.T) {
+		masking, err := service.ContactMaskingFor(ctx, junior.ID, nil)
+		require.NoError(t, err)
+
+		data := responses()
+		require.NoError(t, service.MaskContacts(ctx, masking, data))
+		assert.Equal(t, "test@example.com", data[1].Email)
+
+		data = responses()
+		data[1].OwnerOrganizationID = &org.ID
+		require.NoError(t, service.MaskContacts(ctx, masking, data))
+		assert.True(t, data[1].ContactMasked)
+		assert.False(t, data[0].ContactMasked)
+	})
+
+	t.Run("Policy off", func(t *testing.T) {
+		client.Organization.UpdateOneID(org.ID).SetMaskUnassignedContacts(false).ExecX(ctx)
+
+		masking, err := service.ContactMaskingFor(ctx, junior.ID, &org.ID)
+		require.NoError(t, err)
+		assert.Nil(t, masking)
+	})
+}
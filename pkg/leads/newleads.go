@@ -0,0 +1,119 @@
+package leads
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/zapierdelivery"
+	"github.com/jordanlanch/industrydb/pkg/models"
+)
+
+// MaxNewLeads is the most leads one new-leads poll may return
+const MaxNewLeads = 100
+
+// NewLeadsRequest selects the leads a polling trigger such as Zapier hasn't
+// seen yet
+type NewLeadsRequest struct {
+	// Only leads created after Since, when set
+	Since    *time.Time
+	Industry string
+	Country  string
+	City     string
+	// Most leads to return, MaxNewLeads when not positive
+	Limit int
+	// Limit scoped deployments to the organization's accessible leads
+	OrgScope *int
+	// Leads to leave out, such as the ones the poller suppressed
+	ExcludeLeadIDs []int
+	// Lead fields left out for the poller's tier, as in search results
+	HiddenFields []string
+}
+
+// NewLeads returns the leads created after req.Since, newest first. Leads are
+// scoped like searches (see SetOrgScoping) and the tier's hidden fields are
+// left out.
+func (s *Service) NewLeads(ctx context.Context, req NewLeadsRequest) ([]models.LeadResponse, error) {
+	limit := req.Limit
+	if limit <= 0 || limit > MaxNewLeads {
+		limit = MaxNewLeads
+	}
+
+	preds, err := s.scopedPredicates(ctx, models.LeadSearchRequest{
+		Industry:       req.Industry,
+		Country:        req.Country,
+		City:           req.City,
+		ExcludeLeadIDs: req.ExcludeLeadIDs,
+		OrgScope:       req.OrgScope,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if req.Since != nil {
+		preds = append(preds, lead.CreatedAtGT(*req.Since))
+	}
+
+	found, err := s.db.Lead.Query().
+		Where(preds...).
+		Order(ent.Desc(lead.FieldCreatedAt), ent.Desc(lead.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get new leads: %w", err)
+	}
+
+	response := &models.LeadListResponse{Data: make([]models.LeadResponse, 0, len(found))}
+	for _, l := range found {
+		response.Data = append(response.Data, s.toLeadResponse(l))
+	}
+	return projectResponse(response, req.HiddenFields).Data, nil
+}
+
+// UndeliveredLeadIDs returns the leads of leadIDs a new-leads poll never
+// returned to the API key, in the same order
+func (s *Service) UndeliveredLeadIDs(ctx context.Context, apiKeyID int, leadIDs []int) ([]int, error) {
+	if len(leadIDs) == 0 {
+		return nil, nil
+	}
+
+	delivered, err := s.db.ZapierDelivery.Query().
+		Where(
+			zapierdelivery.APIKeyID(apiKeyID),
+			zapierdelivery.LeadIDIn(leadIDs...),
+		).
+		Select(zapierdelivery.FieldLeadID).
+		Ints(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get delivered leads: %w", err)
+	}
+
+	seen := make(map[int]bool, len(delivered))
+	for _, id := range delivered {
+		seen[id] = true
+	}
+	undelivered := make([]int, 0, len(leadIDs)-len(delivered))
+	for _, id := range leadIDs {
+		if !seen[id] {
+			undelivered = append(undelivered, id)
+		}
+	}
+	return undelivered, nil
+}
+
+// RecordDeliveries remembers that a new-leads poll returned the leads to the
+// API key, so later polls returning them again aren't charged. Leads a
+// concurrent poll already recorded are skipped.
+func (s *Service) RecordDeliveries(ctx context.Context, apiKeyID int, leadIDs []int) error {
+	for _, id := range leadIDs {
+		err := s.db.ZapierDelivery.Create().
+			SetAPIKeyID(apiKeyID).
+			SetLeadID(id).
+			Exec(ctx)
+		if err != nil && !ent.IsConstraintError(err) {
+			return fmt.Errorf("failed to record delivered lead: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package leads
+
+import "github.com/jordanlanch/industrydb/pkg/models"
+
+// DefaultMaxPageSize caps searches that don't set
+// LeadSearchRequest.MaxLimit, such as exports and internal callers.
+const DefaultMaxPageSize = 100
+
+// TierMaxPageSizes maps a subscription tier to the largest page of leads a
+// single search may return. Larger requests are clamped, not rejected.
+type TierMaxPageSizes map[string]int
+
+// DefaultTierMaxPageSizes returns the default maximum page sizes.
+func DefaultTierMaxPageSizes() TierMaxPageSizes {
+	return TierMaxPageSizes{
+		"free":     100,
+		"starter":  100,
+		"pro":      250,
+		"business": 1000,
+	}
+}
+
+// tierMaxPageSizes holds the sizes used by GetMaxPageSizeForTier.
+var tierMaxPageSizes = DefaultTierMaxPageSizes()
+
+// SetTierMaxPageSizes replaces the sizes used by GetMaxPageSizeForTier.
+// Tiers missing from sizes (or with a non-positive size) keep their
+// default. It is meant to be called once at startup from configuration.
+func SetTierMaxPageSizes(sizes TierMaxPageSizes) {
+	merged := DefaultTierMaxPageSizes()
+	for tier, size := range sizes {
+		if size > 0 {
+			merged[tier] = size
+		}
+	}
+	tierMaxPageSizes = merged
+}
+
+// GetMaxPageSizeForTier returns the maximum page size for a subscription
+// tier. Unknown tiers get the free tier size.
+func GetMaxPageSizeForTier(tier string) int {
+	if size, ok := tierMaxPageSizes[tier]; ok {
+		return size
+	}
+	return tierMaxPageSizes["free"]
+}
+
+// clampPageSize caps req.Limit at req.MaxLimit (DefaultMaxPageSize when
+// unset) and returns the limit originally requested.
+func clampPageSize(req *models.LeadSearchRequest) int {
+	requested := req.Limit
+	maxLimit := req.MaxLimit
+	if maxLimit <= 0 {
+		maxLimit = DefaultMaxPageSize
+	}
+	if req.Limit > maxLimit {
+		req.Limit = maxLimit
+	}
+	return requested
+}
+
+// markClamped records on a search response that the requested page size
+// was reduced to the maximum allowed.
+func markClamped(response *models.LeadListResponse, requested int) *models.LeadListResponse {
+	if requested > response.Pagination.Limit {
+		response.Pagination.LimitClamped = true
+		response.Pagination.RequestedLimit = requested
+	}
+	return response
+}
@@ -0,0 +1,122 @@
+package leads
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jordanlanch/industrydb/pkg/models"
+)
+
+// SearchProjectableFields are the lead fields a tier's search results may
+// leave out
+var SearchProjectableFields = []string{"email", "phone", "website", "address", "postal_code", "social_media"}
+
+// TierSearchHiddenFields maps a subscription tier to the lead fields left out
+// of its search result lists. Hidden fields stay available from the lead
+// detail view (GetByID), which counts a credit.
+type TierSearchHiddenFields map[string][]string
+
+// DefaultTierSearchHiddenFields returns the default projection per tier: free
+// search results omit contact details, paid tiers get them inline.
+func DefaultTierSearchHiddenFields() TierSearchHiddenFields {
+	return TierSearchHiddenFields{
+		"free":     {"email", "phone"},
+		"starter":  {},
+		"pro":      {},
+		"business": {},
+	}
+}
+
+// tierSearchHiddenFields holds the fields used by GetSearchHiddenFieldsForTier.
+var tierSearchHiddenFields = DefaultTierSearchHiddenFields()
+
+// SetTierSearchHiddenFields replaces the fields used by
+// GetSearchHiddenFieldsForTier. Tiers missing from fields (or with nil
+// fields) keep their default; an empty list hides nothing. It returns an
+// error, leaving the fields unchanged, for fields not in
+// SearchProjectableFields. It is meant to be called once at startup from
+// configuration.
+func SetTierSearchHiddenFields(fields TierSearchHiddenFields) error {
+	merged := DefaultTierSearchHiddenFields()
+	for tier, hidden := range fields {
+		if hidden == nil {
+			continue
+		}
+		for _, field := range hidden {
+			if !isProjectableField(field) {
+				return fmt.Errorf("unknown search field %q for tier %s", field, tier)
+			}
+		}
+		merged[tier] = hidden
+	}
+	tierSearchHiddenFields = merged
+	return nil
+}
+
+// GetSearchHiddenFieldsForTier returns the lead fields left out of a
+// subscription tier's search results. Unknown tiers get the free tier
+// fields.
+func GetSearchHiddenFieldsForTier(tier string) []string {
+	if hidden, ok := tierSearchHiddenFields[tier]; ok {
+		return hidden
+	}
+	return tierSearchHiddenFields["free"]
+}
+
+// ParseSearchFields parses a comma-separated list of lead fields, or "none"
+// for an empty list. An empty value returns nil, keeping the tier default.
+func ParseSearchFields(value string) []string {
+	value = strings.TrimSpace(value)
+	switch value {
+	case "":
+		return nil
+	case "none":
+		return []string{}
+	}
+
+	fields := []string{}
+	for _, field := range strings.Split(value, ",") {
+		if field = strings.TrimSpace(field); field != "" {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+func isProjectableField(field string) bool {
+	for _, projectable := range SearchProjectableFields {
+		if field == projectable {
+			return true
+		}
+	}
+	return false
+}
+
+// projectResponse clears, in place, the hidden fields of the leads in a
+// search response and lists them in the response
+func projectResponse(response *models.LeadListResponse, hidden []string) *models.LeadListResponse {
+	if len(hidden) == 0 {
+		return response
+	}
+	response.HiddenFields = hidden
+	for i := range response.Data {
+		l := &response.Data[i]
+		for _, field := range hidden {
+			switch field {
+			case "email":
+				l.Email = ""
+			case "phone":
+				l.Phone = ""
+			case "website":
+				l.Website = ""
+			case "address":
+				l.Address = ""
+			case "postal_code":
+				l.PostalCode = ""
+			case "social_media":
+				l.SocialMedia = nil
+			}
+		}
+	}
+	return response
+}
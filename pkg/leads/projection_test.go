@@ -0,0 +1,99 @@
+package leads
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/pkg/cache"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetTierSearchHiddenFields(t *testing.T) {
+	defer SetTierSearchHiddenFields(nil)
+
+	assert.Equal(t, []string{"email", "phone"}, GetSearchHiddenFieldsForTier("free"))
+	assert.Empty(t, GetSearchHiddenFieldsForTier("business"))
+
+	require.NoError(t, SetTierSearchHiddenFields(TierSearchHiddenFields{
+		"free":    {"email", "phone", "social_media"},
+		"starter": {"email"},
+		"pro":     nil,
+	}))
+	assert.Equal(t, []string{"email", "phone", "social_media"}, GetSearchHiddenFieldsForTier("free"))
+	assert.Equal(t, []string{"email"}, GetSearchHiddenFieldsForTier("starter"))
+	// Unset tiers keep the defaults
+	assert.Empty(t, GetSearchHiddenFieldsForTier("pro"))
+	// Unknown tiers fall back to the configured free fields
+	assert.Equal(t, []string{"email", "phone", "social_media"}, GetSearchHiddenFieldsForTier("unknown"))
+
+	t.Run("unknown fields are rejected", func(t *testing.T) {
+		err := SetTierSearchHiddenFields(TierSearchHiddenFields{"free": {"name"}})
+		assert.Error(t, err)
+		assert.Equal(t, []string{"email", "phone", "social_media"}, GetSearchHiddenFieldsForTier("free"))
+	})
+}
+
+func TestParseSearchFields(t *testing.T) {
+	assert.Nil(t, ParseSearchFields(""))
+	assert.Equal(t, []string{}, ParseSearchFields("none"))
+	assert.Equal(t, []string{"email", "phone"}, ParseSearchFields(" email, phone ,"))
+}
+
+func TestSearch_ProjectsFieldsByTier(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+	defer client.Close()
+
+	mr := miniredis.RunT(t)
+	cacheClient, err := cache.NewClient("redis://" + mr.Addr())
+	require.NoError(t, err)
+	service := NewService(client, cacheClient)
+	ctx := context.Background()
+
+	createTestLeadWithFields(t, client, "Contact Studio", true, true, true, true)
+
+	search := func(tier string) *models.LeadListResponse {
+		result, err := service.Search(ctx, models.LeadSearchRequest{
+			Industry:     "tattoo",
+			Page:         1,
+			Limit:        10,
+			HiddenFields: GetSearchHiddenFieldsForTier(tier),
+		})
+		require.NoError(t, err)
+		require.Len(t, result.Data, 1)
+		return result
+	}
+
+	t.Run("free results omit contact fields", func(t *testing.T) {
+		result := search("free")
+		assert.Equal(t, []string{"email", "phone"}, result.HiddenFields)
+		assert.Empty(t, result.Data[0].Email)
+		assert.Empty(t, result.Data[0].Phone)
+		// Other fields are kept
+		assert.Equal(t, "https://example.com", result.Data[0].Website)
+		assert.NotEmpty(t, result.Data[0].SocialMedia)
+	})
+
+	t.Run("paid results include them, even from the cache", func(t *testing.T) {
+		result := search("pro")
+		assert.Empty(t, result.HiddenFields)
+		assert.Equal(t, "test@example.com", result.Data[0].Email)
+		assert.Equal(t, "+1234567890", result.Data[0].Phone)
+	})
+
+	t.Run("free results from the cache omit them too", func(t *testing.T) {
+		result := search("free")
+		assert.Empty(t, result.Data[0].Email)
+		assert.Empty(t, result.Data[0].Phone)
+	})
+
+	t.Run("the detail view has every field", func(t *testing.T) {
+		lead, err := service.GetByID(ctx, search("free").Data[0].ID, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "test@example.com", lead.Email)
+		assert.Equal(t, "+1234567890", lead.Phone)
+	})
+}
@@ -0,0 +1,128 @@
+package leads
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jordanlanch/industrydb/pkg/models"
+)
+
+// MaxPublicPreviewLeads caps the number of leads returned to anonymous users
+const MaxPublicPreviewLeads = 5
+
+// PublicLeadPreview is the projection of a lead shown to anonymous users.
+// It deliberately has no address, website URL, coordinates or social media
+// fields, and contact details are masked, so full contact data cannot leak
+// through this type.
+type PublicLeadPreview struct {
+	Name         string `json:"name"`
+	Industry     string `json:"industry"`
+	City         string `json:"city"`
+	Country      string `json:"country"`
+	Email        string `json:"email,omitempty"` // Masked
+	Phone        string `json:"phone,omitempty"` // Masked
+	HasWebsite   bool   `json:"has_website"`
+	Verified     bool   `json:"verified"`
+	QualityScore int    `json:"quality_score"`
+}
+
+// PublicPreviewResponse represents the anonymous lead teaser
+type PublicPreviewResponse struct {
+	Leads []PublicLeadPreview `json:"leads"`
+	Total int                 `json:"total"` // Total matching leads available after signup
+}
+
+// PublicPreview returns a small, masked sample of leads for an industry and
+// city for unauthenticated users.
+func (s *Service) PublicPreview(ctx context.Context, industry, country, city string) (*PublicPreviewResponse, error) {
+	results, err := s.Search(ctx, models.LeadSearchRequest{
+		Industry: industry,
+		Country:  country,
+		City:     city,
+		SortBy:   "quality_score",
+		Page:     1,
+		Limit:    MaxPublicPreviewLeads,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	previews := make([]PublicLeadPreview, 0, MaxPublicPreviewLeads)
+	for _, l := range results.Data {
+		if len(previews) == MaxPublicPreviewLeads {
+			break
+		}
+		previews = append(previews, PublicLeadPreview{
+			Name:         l.Name,
+			Industry:     l.Industry,
+			City:         l.City,
+			Country:      l.Country,
+			Email:        MaskEmail(l.Email),
+			Phone:        MaskPhone(l.Phone),
+			HasWebsite:   l.Website != "",
+			Verified:     l.Verified,
+			QualityScore: l.QualityScore,
+		})
+	}
+
+	return &PublicPreviewResponse{
+		Leads: previews,
+		Total: results.Pagination.Total,
+	}, nil
+}
+
+// MaskEmail keeps the first character of the local part and the domain's
+// top-level domain: "owner@studio.com" → "o****@s*****.com".
+func MaskEmail(email string) string {
+	if email == "" {
+		return ""
+	}
+
+	at := strings.LastIndex(email, "@")
+	if at <= 0 {
+		return maskAllButFirst(email)
+	}
+	local, domain := email[:at], email[at+1:]
+
+	tld := ""
+	if dot := strings.LastIndex(domain, "."); dot > 0 {
+		tld = domain[dot:]
+		domain = domain[:dot]
+	}
+
+	return maskAllButFirst(local) + "@" + maskAllButFirst(domain) + tld
+}
+
+// MaskPhone replaces every digit except the last two with "*", keeping
+// formatting characters: "+1 555-123-4567" → "+* ***-***-**67".
+func MaskPhone(phone string) string {
+	digits := 0
+	for _, r := range phone {
+		if r >= '0' && r <= '9' {
+			digits++
+		}
+	}
+
+	var b strings.Builder
+	seen := 0
+	for _, r := range phone {
+		if r >= '0' && r <= '9' {
+			seen++
+			if seen <= digits-2 {
+				b.WriteRune('*')
+				continue
+			}
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// maskAllButFirst replaces all but the first character with "*"
+func maskAllButFirst(s string) string {
+	runes := []rune(s)
+	if len(runes) == 0 {
+		return ""
+	}
+	return string(runes[0]) + strings.Repeat("*", len(runes)-1)
+}
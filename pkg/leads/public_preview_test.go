@@ -0,0 +1,74 @@
+package leads
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaskEmail(t *testing.T) {
+	assert.Equal(t, "o****@s*****.com", MaskEmail("owner@studio.com"))
+	assert.Equal(t, "a@e*********.uk", MaskEmail("a@example.co.uk"))
+	assert.Equal(t, "i**@l********", MaskEmail("ink@localhost"))
+	assert.Equal(t, "n*****", MaskEmail("noatsi"))
+	assert.Equal(t, "", MaskEmail(""))
+}
+
+func TestMaskPhone(t *testing.T) {
+	assert.Equal(t, "+* ***-***-**67", MaskPhone("+1 555-123-4567"))
+	assert.Equal(t, "*******89", MaskPhone("123456789"))
+	assert.Equal(t, "", MaskPhone(""))
+}
+
+func TestPublicPreview(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:leads_public_preview?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	service := NewService(client, nil)
+
+	ctx := context.Background()
+	for i := 0; i < MaxPublicPreviewLeads+3; i++ {
+		_, err := client.Lead.Create().
+			SetName(fmt.Sprintf("Ink Studio %d", i)).
+			SetIndustry(lead.IndustryTattoo).
+			SetCountry("US").
+			SetCity("Austin").
+			SetEmail(fmt.Sprintf("owner%d@inkstudio.com", i)).
+			SetPhone(fmt.Sprintf("+1 512-555-01%02d", i)).
+			SetAddress("100 Congress Ave").
+			SetWebsite("https://inkstudio.com").
+			Save(ctx)
+		require.NoError(t, err)
+	}
+	_, err := client.Lead.Create().
+		SetName("Other City").
+		SetIndustry(lead.IndustryTattoo).
+		SetCountry("US").
+		SetCity("Dallas").
+		Save(ctx)
+	require.NoError(t, err)
+
+	result, err := service.PublicPreview(ctx, "tattoo", "US", "Austin")
+	require.NoError(t, err)
+
+	assert.Equal(t, MaxPublicPreviewLeads+3, result.Total)
+	require.Len(t, result.Leads, MaxPublicPreviewLeads)
+	for _, l := range result.Leads {
+		assert.Equal(t, "Austin", l.City)
+		assert.Regexp(t, `^o\*+@i\*+\.com$`, l.Email)
+		assert.Regexp(t, `^\+\* \*{3}-\*{3}-\*{2}\d{2}$`, l.Phone)
+		assert.True(t, l.HasWebsite)
+	}
+
+	// Full contact data must never appear in the serialized response
+	body, err := json.Marshal(result)
+	require.NoError(t, err)
+	assert.NotContains(t, string(body), "inkstudio")
+	assert.NotContains(t, string(body), "512-555")
+	assert.NotContains(t, string(body), "Congress")
+}
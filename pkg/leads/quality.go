@@ -0,0 +1,196 @@
+package leads
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/lead"
+)
+
+// QualityWeights configures the quality score rubric. Each weight is the
+// number of points a lead earns when the signal is present:
+//
+//   - Email:    lead has an email address
+//   - Phone:    lead has a phone number
+//   - Website:  lead has a website
+//   - Address:  lead has a street address
+//   - Verified: lead has been verified by a reviewer
+//   - Recency:  lead data was scraped, enriched or verified within RecencyDays
+//
+// The resulting score is clamped to 0-100.
+type QualityWeights struct {
+	Email       int
+	Phone       int
+	Website     int
+	Address     int
+	Verified    int
+	Recency     int
+	RecencyDays int
+}
+
+// DefaultQualityWeights returns the default rubric, which sums to 100.
+func DefaultQualityWeights() QualityWeights {
+	return QualityWeights{
+		Email:       20,
+		Phone:       20,
+		Website:     15,
+		Address:     15,
+		Verified:    20,
+		Recency:     10,
+		RecencyDays: 180,
+	}
+}
+
+// qualityWeights holds the rubric used by RecalculateQuality.
+var qualityWeights = DefaultQualityWeights()
+
+// SetQualityWeights replaces the rubric used by RecalculateQuality.
+// It is meant to be called once at startup from configuration.
+func SetQualityWeights(w QualityWeights) {
+	qualityWeights = w
+}
+
+// QualityRecomputeResult reports the outcome of a quality score recomputation.
+type QualityRecomputeResult struct {
+	Processed int `json:"processed"`
+	Updated   int `json:"updated"`
+}
+
+// RecalculateQuality computes a lead's quality score from its completeness
+// using the configured rubric.
+func RecalculateQuality(l *ent.Lead) int {
+	return CalculateQuality(l, qualityWeights, time.Now())
+}
+
+// CalculateQuality computes a lead's quality score with the given weights.
+func CalculateQuality(l *ent.Lead, w QualityWeights, now time.Time) int {
+	score := 0
+
+	if l.Email != "" {
+		score += w.Email
+	}
+	if l.Phone != "" {
+		score += w.Phone
+	}
+	if l.Website != "" {
+		score += w.Website
+	}
+	if l.Address != "" {
+		score += w.Address
+	}
+	if l.Verified {
+		score += w.Verified
+	}
+
+	// Recency uses the freshest of the scrape (creation, or the last time the
+	// source confirmed the lead), enrichment and verification. updated_at is
+	// not used: bookkeeping writes such as this score move it too.
+	lastTouched := l.CreatedAt
+	if l.LastSeenAt != nil && l.LastSeenAt.After(lastTouched) {
+		lastTouched = *l.LastSeenAt
+	}
+	if l.EnrichedAt != nil && l.EnrichedAt.After(lastTouched) {
+		lastTouched = *l.EnrichedAt
+	}
+	if l.VerifiedSince != nil && l.VerifiedSince.After(lastTouched) {
+		lastTouched = *l.VerifiedSince
+	}
+	if w.RecencyDays > 0 && !lastTouched.IsZero() &&
+		now.Sub(lastTouched) <= time.Duration(w.RecencyDays)*24*time.Hour {
+		score += w.Recency
+	}
+
+	if score > 100 {
+		score = 100
+	}
+	if score < 0 {
+		score = 0
+	}
+
+	return score
+}
+
+// RefreshQuality recalculates a lead's quality score and saves it when it
+// changed, leaving updated_at as is. It accepts a LeadClient so it can run
+// inside a transaction.
+func RefreshQuality(ctx context.Context, leads *ent.LeadClient, l *ent.Lead) (*ent.Lead, error) {
+	score := RecalculateQuality(l)
+	if score == l.QualityScore {
+		return l, nil
+	}
+
+	updated, err := leads.UpdateOne(l).
+		SetQualityScore(score).
+		Modify(KeepUpdatedAt).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update quality score: %w", err)
+	}
+
+	return updated, nil
+}
+
+// RecomputeQualityScores recalculates quality and completeness scores for
+// the given leads, or for every lead when leadIDs is empty. Leads are
+// processed in batches and only changed scores are written; Updated counts
+// leads with either score changed.
+func (s *Service) RecomputeQualityScores(ctx context.Context, leadIDs []int) (*QualityRecomputeResult, error) {
+	return s.recomputeScores(ctx, leadIDs, false, nil)
+}
+
+// recomputeScores walks the leads in ID order, batch by batch. A dry run
+// only counts the leads whose scores are stale. onBatch, when set, is called
+// with the running totals after each batch.
+func (s *Service) recomputeScores(ctx context.Context, leadIDs []int, dryRun bool, onBatch func(QualityRecomputeResult)) (*QualityRecomputeResult, error) {
+	const batchSize = 500
+
+	result := &QualityRecomputeResult{}
+	lastID := 0
+	for {
+		query := s.db.Lead.Query().
+			Where(lead.IDGT(lastID)).
+			Order(ent.Asc(lead.FieldID)).
+			Limit(batchSize)
+		if len(leadIDs) > 0 {
+			query = query.Where(lead.IDIn(leadIDs...))
+		}
+
+		batch, err := query.All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch leads: %w", err)
+		}
+		if len(batch) == 0 {
+			break
+		}
+
+		for _, l := range batch {
+			result.Processed++
+			if dryRun {
+				if RecalculateQuality(l) != l.QualityScore || CalculateCompleteness(l) != l.CompletenessScore {
+					result.Updated++
+				}
+				continue
+			}
+			updated, err := RefreshQuality(ctx, s.db.Lead, l)
+			if err != nil {
+				return nil, err
+			}
+			updated, err = RefreshCompleteness(ctx, s.db.Lead, updated)
+			if err != nil {
+				return nil, err
+			}
+			if updated.QualityScore != l.QualityScore || updated.CompletenessScore != l.CompletenessScore {
+				result.Updated++
+			}
+		}
+
+		lastID = batch[len(batch)-1].ID
+		if onBatch != nil {
+			onBatch(*result)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,97 @@
+package leads
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalculateQuality(t *testing.T) {
+	now := time.Now()
+	w := DefaultQualityWeights()
+
+	t.Run("Empty stale lead scores zero", func(t *testing.T) {
+		l := &ent.Lead{CreatedAt: now.AddDate(-1, 0, 0)}
+		assert.Equal(t, 0, CalculateQuality(l, w, now))
+	})
+
+	t.Run("Complete recent verified lead scores 100", func(t *testing.T) {
+		l := &ent.Lead{
+			Email:     "a@example.com",
+			Phone:     "+12125551234",
+			Website:   "https://example.com",
+			Address:   "1 Main St",
+			Verified:  true,
+			CreatedAt: now,
+		}
+		assert.Equal(t, 100, CalculateQuality(l, w, now))
+	})
+
+	t.Run("Contact fields only", func(t *testing.T) {
+		l := &ent.Lead{Email: "a@example.com", Phone: "+12125551234", CreatedAt: now.AddDate(-1, 0, 0)}
+		assert.Equal(t, w.Email+w.Phone, CalculateQuality(l, w, now))
+	})
+
+	t.Run("Recent verification counts as recency", func(t *testing.T) {
+		verifiedAt := now.Add(-time.Hour)
+		l := &ent.Lead{Verified: true, VerifiedSince: &verifiedAt, CreatedAt: now.AddDate(-1, 0, 0)}
+		assert.Equal(t, w.Verified+w.Recency, CalculateQuality(l, w, now))
+	})
+
+	t.Run("Source confirmation counts as recency", func(t *testing.T) {
+		seenAt := now.Add(-time.Hour)
+		l := &ent.Lead{LastSeenAt: &seenAt, CreatedAt: now.AddDate(-1, 0, 0)}
+		assert.Equal(t, w.Recency, CalculateQuality(l, w, now))
+	})
+
+	t.Run("Recent edit alone does not count as recency", func(t *testing.T) {
+		l := &ent.Lead{CreatedAt: now.AddDate(-1, 0, 0), UpdatedAt: now}
+		assert.Equal(t, 0, CalculateQuality(l, w, now))
+	})
+
+	t.Run("Custom weights are capped at 100", func(t *testing.T) {
+		custom := QualityWeights{Email: 80, Phone: 80}
+		l := &ent.Lead{Email: "a@example.com", Phone: "+12125551234"}
+		assert.Equal(t, 100, CalculateQuality(l, custom, now))
+	})
+}
+
+func TestRecomputeQualityScores(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:leads_quality?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	service := NewService(client, nil)
+
+	complete := createTestLeadWithFields(t, client, "Complete", true, true, true, false)
+	empty := createTestLeadWithFields(t, client, "Empty", false, false, false, false)
+
+	before, err := client.Lead.Get(ctx, complete.ID)
+	require.NoError(t, err)
+
+	result, err := service.RecomputeQualityScores(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Processed)
+
+	w := DefaultQualityWeights()
+	updated, err := client.Lead.Get(ctx, complete.ID)
+	require.NoError(t, err)
+	assert.Equal(t, w.Email+w.Phone+w.Website+w.Recency, updated.QualityScore)
+	assert.Equal(t, CompletenessEmail+CompletenessPhone+CompletenessWebsite, updated.CompletenessScore)
+	assert.True(t, before.UpdatedAt.Equal(updated.UpdatedAt), "score writes must not bump updated_at")
+
+	updated, err = client.Lead.Get(ctx, empty.ID)
+	require.NoError(t, err)
+	assert.Equal(t, w.Recency, updated.QualityScore)
+
+	// Scores are already current, so a second run writes nothing
+	result, err = service.RecomputeQualityScores(ctx, []int{complete.ID})
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Processed)
+	assert.Equal(t, 0, result.Updated)
+}
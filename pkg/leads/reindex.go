@@ -0,0 +1,162 @@
+package leads
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/leadreindexjob"
+)
+
+// ErrReindexRunning is returned when a reindex is started while another one
+// is pending or processing
+var ErrReindexRunning = errors.New("a lead reindex is already running")
+
+// CountRefresher rebuilds precomputed lead counts, such as the per-industry
+// counts behind the industry filters
+type CountRefresher interface {
+	RefreshLeadCounts(ctx context.Context) error
+}
+
+// ReindexService recomputes derived lead data as tracked background jobs:
+// quality and completeness scores, then the precomputed counts. It is safe
+// to run online: leads are read in ID order in batches and only drifted rows
+// are written, one lead at a time, so no long transaction or table lock is
+// held. Full-text search vectors are computed at query time and need no
+// repair.
+type ReindexService struct {
+	db     *ent.Client
+	leads  *Service
+	counts CountRefresher
+}
+
+// NewReindexService creates a new reindex service. counts may be nil when no
+// counts are precomputed.
+func NewReindexService(db *ent.Client, leads *Service, counts CountRefresher) *ReindexService {
+	return &ReindexService{
+		db:     db,
+		leads:  leads,
+		counts: counts,
+	}
+}
+
+// Start records a pending reindex job and runs it in the background. A dry
+// run only counts the leads with drifted data.
+func (s *ReindexService) Start(ctx context.Context, userID int, dryRun bool) (*ent.LeadReindexJob, error) {
+	running, err := s.db.LeadReindexJob.Query().
+		Where(leadreindexjob.StatusIn(leadreindexjob.StatusPending, leadreindexjob.StatusProcessing)).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check running reindex jobs: %w", err)
+	}
+	if running {
+		return nil, ErrReindexRunning
+	}
+
+	job, err := s.db.LeadReindexJob.Create().
+		SetUserID(userID).
+		SetDryRun(dryRun).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create reindex job: %w", err)
+	}
+
+	go s.run(job.ID, dryRun)
+
+	return job, nil
+}
+
+// Get returns a reindex job by ID
+func (s *ReindexService) Get(ctx context.Context, id int) (*ent.LeadReindexJob, error) {
+	return s.db.LeadReindexJob.Get(ctx, id)
+}
+
+// List returns the most recent reindex jobs
+func (s *ReindexService) List(ctx context.Context, limit int) ([]*ent.LeadReindexJob, error) {
+	return s.db.LeadReindexJob.Query().
+		Order(ent.Desc(leadreindexjob.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+}
+
+// FailInterrupted marks jobs left pending or processing by a previous process
+// as failed. Jobs run in-process, so they cannot survive a restart.
+func (s *ReindexService) FailInterrupted(ctx context.Context) (int, error) {
+	return s.db.LeadReindexJob.Update().
+		Where(leadreindexjob.StatusIn(leadreindexjob.StatusPending, leadreindexjob.StatusProcessing)).
+		SetStatus(leadreindexjob.StatusFailed).
+		SetErrorMessage("interrupted by server restart").
+		SetCompletedAt(time.Now()).
+		Save(ctx)
+}
+
+// run recomputes the scores, recording progress after each batch, then
+// refreshes the precomputed counts
+func (s *ReindexService) run(jobID int, dryRun bool) {
+	ctx := context.Background()
+
+	total, err := s.db.Lead.Query().Count(ctx)
+	if err != nil {
+		s.fail(ctx, jobID, fmt.Errorf("failed to count leads: %w", err))
+		return
+	}
+
+	if err := s.db.LeadReindexJob.UpdateOneID(jobID).
+		SetStatus(leadreindexjob.StatusProcessing).
+		SetTotalLeads(total).
+		SetStartedAt(time.Now()).
+		Exec(ctx); err != nil {
+		log.Printf("❌ Failed to start lead reindex job %d: %v", jobID, err)
+		return
+	}
+
+	result, err := s.leads.recomputeScores(ctx, nil, dryRun, func(progress QualityRecomputeResult) {
+		if err := s.progressUpdate(jobID, progress).Exec(ctx); err != nil {
+			log.Printf("⚠️  Failed to record lead reindex job %d progress: %v", jobID, err)
+		}
+	})
+	if err != nil {
+		s.fail(ctx, jobID, err)
+		return
+	}
+
+	if !dryRun && s.counts != nil {
+		if err := s.counts.RefreshLeadCounts(ctx); err != nil {
+			s.fail(ctx, jobID, fmt.Errorf("failed to refresh lead counts: %w", err))
+			return
+		}
+	}
+
+	if err := s.progressUpdate(jobID, *result).
+		SetStatus(leadreindexjob.StatusCompleted).
+		SetCompletedAt(time.Now()).
+		Exec(ctx); err != nil {
+		log.Printf("❌ Failed to complete lead reindex job %d: %v", jobID, err)
+		return
+	}
+
+	log.Printf("✅ Lead reindex job %d completed: %d processed, %d affected (dry run: %t)", jobID, result.Processed, result.Updated, dryRun)
+}
+
+// progressUpdate builds an update recording the running totals
+func (s *ReindexService) progressUpdate(jobID int, progress QualityRecomputeResult) *ent.LeadReindexJobUpdateOne {
+	return s.db.LeadReindexJob.UpdateOneID(jobID).
+		SetProcessed(progress.Processed).
+		SetAffected(progress.Updated)
+}
+
+// fail marks a job failed, keeping the progress recorded so far
+func (s *ReindexService) fail(ctx context.Context, jobID int, cause error) {
+	log.Printf("❌ Lead reindex job %d failed: %v", jobID, cause)
+
+	if err := s.db.LeadReindexJob.UpdateOneID(jobID).
+		SetStatus(leadreindexjob.StatusFailed).
+		SetErrorMessage(cause.Error()).
+		SetCompletedAt(time.Now()).
+		Exec(ctx); err != nil {
+		log.Printf("❌ Failed to record lead reindex job %d failure: %v", jobID, err)
+	}
+}
@@ -0,0 +1,129 @@
+package leads
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/ent/leadreindexjob"
+	"github.com/jordanlanch/industrydb/ent/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeCountRefresher records count refreshes
+type fakeCountRefresher struct {
+	calls int
+	err   error
+}
+
+func (f *fakeCountRefresher) RefreshLeadCounts(ctx context.Context) error {
+	f.calls++
+	return f.err
+}
+
+func setupReindexTest(t *testing.T) (*ent.Client, *ReindexService, *fakeCountRefresher, *ent.User) {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	admin, err := client.User.Create().
+		SetEmail("reindex-admin@example.com").
+		SetPasswordHash("hashed").
+		SetName("Admin").
+		SetRole(user.RoleAdmin).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	counts := &fakeCountRefresher{}
+	return client, NewReindexService(client, NewService(client, nil), counts), counts, admin
+}
+
+func createReindexJob(t *testing.T, client *ent.Client, adminID int, dryRun bool) *ent.LeadReindexJob {
+	job, err := client.LeadReindexJob.Create().
+		SetUserID(adminID).
+		SetDryRun(dryRun).
+		Save(context.Background())
+	require.NoError(t, err)
+	return job
+}
+
+func TestReindexService_Run(t *testing.T) {
+	client, service, counts, admin := setupReindexTest(t)
+	ctx := context.Background()
+
+	// Imported leads carry no scores, the recent one's are current already
+	stale := createTestLeadWithFields(t, client, "Stale", true, true, false, false)
+	current := createTestLeadWithFields(t, client, "Current", false, false, false, false)
+	_, err := service.leads.RecomputeQualityScores(ctx, []int{current.ID})
+	require.NoError(t, err)
+
+	t.Run("dry run only counts", func(t *testing.T) {
+		job := createReindexJob(t, client, admin.ID, true)
+		service.run(job.ID, true)
+
+		job, err := client.LeadReindexJob.Get(ctx, job.ID)
+		require.NoError(t, err)
+		assert.Equal(t, leadreindexjob.StatusCompleted, job.Status)
+		assert.Equal(t, 2, job.TotalLeads)
+		assert.Equal(t, 2, job.Processed)
+		assert.Equal(t, 1, job.Affected)
+		assert.NotNil(t, job.StartedAt)
+		assert.NotNil(t, job.CompletedAt)
+
+		unchanged, err := client.Lead.Get(ctx, stale.ID)
+		require.NoError(t, err)
+		assert.Zero(t, unchanged.CompletenessScore)
+		assert.Zero(t, counts.calls)
+	})
+
+	t.Run("reindex repairs scores and counts", func(t *testing.T) {
+		job := createReindexJob(t, client, admin.ID, false)
+		service.run(job.ID, false)
+
+		job, err := client.LeadReindexJob.Get(ctx, job.ID)
+		require.NoError(t, err)
+		assert.Equal(t, leadreindexjob.StatusCompleted, job.Status)
+		assert.Equal(t, 2, job.Processed)
+		assert.Equal(t, 1, job.Affected)
+		assert.Equal(t, 1, counts.calls)
+
+		repaired, err := client.Lead.Get(ctx, stale.ID)
+		require.NoError(t, err)
+		assert.Equal(t, CompletenessEmail+CompletenessPhone, repaired.CompletenessScore)
+	})
+
+	t.Run("failed count refresh fails the job", func(t *testing.T) {
+		counts.err = errors.New("redis unavailable")
+		defer func() { counts.err = nil }()
+
+		job := createReindexJob(t, client, admin.ID, false)
+		service.run(job.ID, false)
+
+		job, err := client.LeadReindexJob.Get(ctx, job.ID)
+		require.NoError(t, err)
+		assert.Equal(t, leadreindexjob.StatusFailed, job.Status)
+		assert.Contains(t, job.ErrorMessage, "redis unavailable")
+		assert.Equal(t, 2, job.Processed)
+	})
+}
+
+func TestReindexService_StartWhileRunning(t *testing.T) {
+	client, service, _, admin := setupReindexTest(t)
+	ctx := context.Background()
+
+	running := createReindexJob(t, client, admin.ID, false)
+
+	_, err := service.Start(ctx, admin.ID, true)
+	assert.ErrorIs(t, err, ErrReindexRunning)
+
+	n, err := service.FailInterrupted(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	running, err = service.Get(ctx, running.ID)
+	require.NoError(t, err)
+	assert.Equal(t, leadreindexjob.StatusFailed, running.Status)
+	assert.Equal(t, "interrupted by server restart", running.ErrorMessage)
+}
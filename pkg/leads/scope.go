@@ -0,0 +1,256 @@
+package leads
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/leadlicense"
+	"github.com/jordanlanch/industrydb/ent/organization"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+	"github.com/jordanlanch/industrydb/pkg/models"
+)
+
+// Lead scoping errors
+var (
+	ErrOrganizationNotFound = errors.New("organization not found")
+	ErrLicenseNotFound      = errors.New("lead license not found")
+	ErrLicenseExists        = errors.New("organization already has this lead license")
+	ErrInvalidLicense       = errors.New("invalid lead license")
+)
+
+// LeadLicenseRequest grants an organization a segment of the global pool.
+// Empty industry or country match any value.
+type LeadLicenseRequest struct {
+	Industry string `json:"industry,omitempty"`
+	Country  string `json:"country,omitempty" validate:"omitempty,len=2"`
+}
+
+// LeadLicenseResponse is a lead segment licensed to an organization
+type LeadLicenseResponse struct {
+	ID             int    `json:"id"`
+	OrganizationID int    `json:"organization_id"`
+	Industry       string `json:"industry,omitempty"`
+	Country        string `json:"country,omitempty"`
+	CreatedAt      string `json:"created_at"`
+}
+
+// SetLeadOwnerRequest moves a lead into an organization, or back to the
+// global pool when OrganizationID is nil
+type SetLeadOwnerRequest struct {
+	OrganizationID *int `json:"organization_id"`
+}
+
+// SetOrgScoping turns organization lead scoping on or off. When on, searches
+// with an OrgScope only match the leads that organization owns or licenses,
+// and searches without one only match leads no organization owns. When off
+// (the default) every search covers the whole global pool.
+func (s *Service) SetOrgScoping(enabled bool) {
+	s.orgScoping = enabled
+}
+
+// OrgScopingEnabled reports whether organization lead scoping is on
+func (s *Service) OrgScopingEnabled() bool {
+	return s.orgScoping
+}
+
+// scopedPredicates returns the search predicates for req, plus the
+// organization visibility predicate when lead scoping is on
+func (s *Service) scopedPredicates(ctx context.Context, req models.LeadSearchRequest) ([]predicate.Lead, error) {
+	preds := searchPredicates(req)
+	bbox, err := ParseBoundingBox(req.BBox)
+	if err != nil {
+		return nil, err
+	}
+	if bbox != nil {
+		preds = append(preds, bbox.predicate())
+	}
+
+	scope, err := s.ScopePredicates(ctx, req.OrgScope)
+	if err != nil {
+		return nil, err
+	}
+	return append(preds, scope...), nil
+}
+
+// ScopePredicates returns the predicates matching the leads visible in
+// orgScope (nil for callers acting without an organization), for queries
+// outside this service to apply the same visibility as searches. There are
+// none when lead scoping is off.
+func (s *Service) ScopePredicates(ctx context.Context, orgScope *int) ([]predicate.Lead, error) {
+	if !s.orgScoping {
+		return nil, nil
+	}
+	if orgScope == nil {
+		return []predicate.Lead{lead.OwnerOrganizationIDIsNil()}, nil
+	}
+
+	licenses, err := s.db.LeadLicense.Query().
+		Where(leadlicense.OrganizationID(*orgScope)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load lead licenses: %w", err)
+	}
+
+	// Owned leads, plus the unowned leads in any licensed segment
+	visible := []predicate.Lead{lead.OwnerOrganizationID(*orgScope)}
+	for _, license := range licenses {
+		visible = append(visible, licensePredicate(license))
+	}
+	return []predicate.Lead{lead.Or(visible...)}, nil
+}
+
+// licensePredicate matches the unowned leads in a license's segment
+func licensePredicate(license *ent.LeadLicense) predicate.Lead {
+	preds := []predicate.Lead{lead.OwnerOrganizationIDIsNil()}
+	if license.Industry != "" {
+		preds = append(preds, lead.IndustryEQ(lead.Industry(license.Industry)))
+	}
+	if license.Country != "" {
+		preds = append(preds, lead.CountryEQ(license.Country))
+	}
+	return lead.And(preds...)
+}
+
+// scopeKey is the organization scope part of cache keys
+func (s *Service) scopeKey(req models.LeadSearchRequest) string {
+	if !s.orgScoping {
+		return ""
+	}
+	if req.OrgScope == nil {
+		return "global"
+	}
+	return fmt.Sprintf("org%d", *req.OrgScope)
+}
+
+// GrantLeadLicense licenses a segment of the global pool to an organization.
+// adminID is recorded as the grantor.
+func (s *Service) GrantLeadLicense(ctx context.Context, adminID, orgID int, req LeadLicenseRequest) (*LeadLicenseResponse, error) {
+	industry := strings.ToLower(strings.TrimSpace(req.Industry))
+	country := strings.ToUpper(strings.TrimSpace(req.Country))
+	if industry != "" {
+		if err := lead.IndustryValidator(lead.Industry(industry)); err != nil {
+			return nil, fmt.Errorf("%w: unknown industry %q", ErrInvalidLicense, industry)
+		}
+	}
+	if country != "" && len(country) != 2 {
+		return nil, fmt.Errorf("%w: country must be a 2-letter code", ErrInvalidLicense)
+	}
+
+	if err := s.checkOrganizationExists(ctx, orgID); err != nil {
+		return nil, err
+	}
+
+	// Empty segments are stored as "" rather than NULL so the unique index
+	// catches duplicates
+	license, err := s.db.LeadLicense.Create().
+		SetOrganizationID(orgID).
+		SetIndustry(industry).
+		SetCountry(country).
+		SetCreatedBy(adminID).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, ErrLicenseExists
+		}
+		return nil, fmt.Errorf("failed to create lead license: %w", err)
+	}
+
+	s.invalidateScopedCache(ctx)
+	return toLeadLicenseResponse(license), nil
+}
+
+// ListLeadLicenses returns an organization's lead licenses, oldest first
+func (s *Service) ListLeadLicenses(ctx context.Context, orgID int) ([]LeadLicenseResponse, error) {
+	licenses, err := s.db.LeadLicense.Query().
+		Where(leadlicense.OrganizationID(orgID)).
+		Order(ent.Asc(leadlicense.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list lead licenses: %w", err)
+	}
+
+	responses := make([]LeadLicenseResponse, len(licenses))
+	for i, license := range licenses {
+		responses[i] = *toLeadLicenseResponse(license)
+	}
+	return responses, nil
+}
+
+// RevokeLeadLicense deletes one of an organization's lead licenses
+func (s *Service) RevokeLeadLicense(ctx context.Context, orgID, licenseID int) error {
+	deleted, err := s.db.LeadLicense.Delete().
+		Where(leadlicense.ID(licenseID), leadlicense.OrganizationID(orgID)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete lead license: %w", err)
+	}
+	if deleted == 0 {
+		return ErrLicenseNotFound
+	}
+
+	s.invalidateScopedCache(ctx)
+	return nil
+}
+
+// SetLeadOwner gives a lead to an organization, so only it sees the lead
+// when lead scoping is on, or returns it to the global pool when orgID is nil
+func (s *Service) SetLeadOwner(ctx context.Context, leadID int, orgID *int) (*models.LeadResponse, error) {
+	if orgID != nil {
+		if err := s.checkOrganizationExists(ctx, *orgID); err != nil {
+			return nil, err
+		}
+	}
+
+	update := s.db.Lead.UpdateOneID(leadID)
+	if orgID != nil {
+		update.SetOwnerOrganizationID(*orgID)
+	} else {
+		update.ClearOwnerOrganizationID()
+	}
+	updated, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrLeadNotFound
+		}
+		return nil, fmt.Errorf("failed to update lead owner: %w", err)
+	}
+
+	s.invalidateScopedCache(ctx)
+	response := s.toLeadResponse(updated)
+	return &response, nil
+}
+
+// checkOrganizationExists returns ErrOrganizationNotFound for unknown IDs
+func (s *Service) checkOrganizationExists(ctx context.Context, orgID int) error {
+	exists, err := s.db.Organization.Query().Where(organization.ID(orgID)).Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get organization: %w", err)
+	}
+	if !exists {
+		return ErrOrganizationNotFound
+	}
+	return nil
+}
+
+// invalidateScopedCache drops cached searches after a visibility change.
+// Only scoped searches can be stale, so nothing is dropped when scoping is off.
+func (s *Service) invalidateScopedCache(ctx context.Context) {
+	if s.orgScoping {
+		_ = s.InvalidateCache(ctx)
+	}
+}
+
+func toLeadLicenseResponse(license *ent.LeadLicense) *LeadLicenseResponse {
+	return &LeadLicenseResponse{
+		ID:             license.ID,
+		OrganizationID: license.OrganizationID,
+		Industry:       license.Industry,
+		Country:        license.Country,
+		CreatedAt:      license.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,188 @@
+package leads
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createScopeTestOrganization(t *testing.T, client *ent.Client, slug string) *ent.Organization {
+	ctx := context.Background()
+	owner := client.User.Create().
+		SetEmail(slug + "@example.com").
+		SetPasswordHash("hashed_password").
+		SetName(slug).
+		SaveX(ctx)
+	return client.Organization.Create().
+		SetName(slug).
+		SetSlug(slug).
+		SetOwnerID(owner.ID).
+		SetLastResetAt(time.Now()).
+		SaveX(ctx)
+}
+
+func createScopeTestLead(t *testing.T, client *ent.Client, name, industry, country string, ownerOrgID *int) *ent.Lead {
+	return client.Lead.Create().
+		SetName(name).
+		SetIndustry(lead.Industry(industry)).
+		SetCountry(country).
+		SetCity("Austin").
+		SetNillableOwnerOrganizationID(ownerOrgID).
+		SaveX(context.Background())
+}
+
+func TestOrgScoping(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:leads_scope?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	service := NewService(client, nil)
+
+	acme := createScopeTestOrganization(t, client, "acme")
+	globex := createScopeTestOrganization(t, client, "globex")
+
+	usTattoo := createScopeTestLead(t, client, "US Tattoo", "tattoo", "US", nil)
+	gbTattoo := createScopeTestLead(t, client, "GB Tattoo", "tattoo", "GB", nil)
+	usGym := createScopeTestLead(t, client, "US Gym", "gym", "US", nil)
+	acmeOwned := createScopeTestLead(t, client, "Acme Gym", "gym", "GB", &acme.ID)
+	globexOwned := createScopeTestLead(t, client, "Globex Tattoo", "tattoo", "US", &globex.ID)
+
+	visible := func(orgID *int) []int {
+		ids, _, err := service.MatchingLeadIDs(ctx, models.LeadSearchRequest{OrgScope: orgID}, 100)
+		require.NoError(t, err)
+		return ids
+	}
+
+	t.Run("Off by default", func(t *testing.T) {
+		assert.False(t, service.OrgScopingEnabled())
+		assert.Len(t, visible(nil), 5)
+		assert.Len(t, visible(&acme.ID), 5)
+	})
+
+	service.SetOrgScoping(true)
+
+	t.Run("Searches without an organization see the unowned leads", func(t *testing.T) {
+		assert.ElementsMatch(t, []int{usTattoo.ID, gbTattoo.ID, usGym.ID}, visible(nil))
+	})
+
+	t.Run("Organizations see only their own leads without licenses", func(t *testing.T) {
+		assert.Equal(t, []int{acmeOwned.ID}, visible(&acme.ID))
+		assert.Equal(t, []int{globexOwned.ID}, visible(&globex.ID))
+	})
+
+	t.Run("Licensed segments of the global pool", func(t *testing.T) {
+		_, err := service.GrantLeadLicense(ctx, 0, acme.ID, LeadLicenseRequest{Industry: "Tattoo"})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []int{acmeOwned.ID, usTattoo.ID, gbTattoo.ID}, visible(&acme.ID))
+
+		_, err = service.GrantLeadLicense(ctx, 0, acme.ID, LeadLicenseRequest{Industry: "gym", Country: "us"})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []int{acmeOwned.ID, usTattoo.ID, gbTattoo.ID, usGym.ID}, visible(&acme.ID))
+
+		// Another organization's leads are never licensed
+		assert.NotContains(t, visible(&acme.ID), globexOwned.ID)
+	})
+
+	t.Run("Filters still apply", func(t *testing.T) {
+		ids, _, err := service.MatchingLeadIDs(ctx, models.LeadSearchRequest{OrgScope: &acme.ID, Country: "GB"}, 100)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []int{acmeOwned.ID, gbTattoo.ID}, ids)
+
+		count, err := service.EstimateCount(ctx, models.LeadSearchRequest{OrgScope: &globex.ID})
+		require.NoError(t, err)
+		assert.Equal(t, 1, count.Count)
+	})
+
+	t.Run("Single lead lookups", func(t *testing.T) {
+		found, err := service.GetByID(ctx, acmeOwned.ID, &acme.ID)
+		require.NoError(t, err)
+		assert.Equal(t, acmeOwned.ID, found.ID)
+
+		_, err = service.GetByID(ctx, globexOwned.ID, &acme.ID)
+		assert.ErrorIs(t, err, ErrLeadNotFound, "another organization's lead is not found")
+		_, err = service.GetByID(ctx, acmeOwned.ID, nil)
+		assert.ErrorIs(t, err, ErrLeadNotFound, "owned leads are hidden without an organization")
+
+		_, err = service.GetByID(ctx, usTattoo.ID, nil)
+		assert.NoError(t, err)
+	})
+}
+
+func TestLeadLicenses(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:leads_licenses?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	service := NewService(client, nil)
+	org := createScopeTestOrganization(t, client, "acme")
+
+	license, err := service.GrantLeadLicense(ctx, 0, org.ID, LeadLicenseRequest{Industry: " Tattoo ", Country: "us"})
+	require.NoError(t, err)
+	assert.Equal(t, org.ID, license.OrganizationID)
+	assert.Equal(t, "tattoo", license.Industry)
+	assert.Equal(t, "US", license.Country)
+
+	t.Run("Duplicate segment", func(t *testing.T) {
+		_, err := service.GrantLeadLicense(ctx, 0, org.ID, LeadLicenseRequest{Industry: "tattoo", Country: "US"})
+		assert.ErrorIs(t, err, ErrLicenseExists)
+	})
+
+	t.Run("Invalid segment", func(t *testing.T) {
+		_, err := service.GrantLeadLicense(ctx, 0, org.ID, LeadLicenseRequest{Industry: "spaceships"})
+		assert.ErrorIs(t, err, ErrInvalidLicense)
+		_, err = service.GrantLeadLicense(ctx, 0, org.ID, LeadLicenseRequest{Country: "USA"})
+		assert.ErrorIs(t, err, ErrInvalidLicense)
+	})
+
+	t.Run("Unknown organization", func(t *testing.T) {
+		_, err := service.GrantLeadLicense(ctx, 0, 99999, LeadLicenseRequest{Industry: "gym"})
+		assert.ErrorIs(t, err, ErrOrganizationNotFound)
+	})
+
+	t.Run("List and revoke", func(t *testing.T) {
+		licenses, err := service.ListLeadLicenses(ctx, org.ID)
+		require.NoError(t, err)
+		require.Len(t, licenses, 1)
+		assert.Equal(t, license.ID, licenses[0].ID)
+
+		require.NoError(t, service.RevokeLeadLicense(ctx, org.ID, license.ID))
+		assert.ErrorIs(t, service.RevokeLeadLicense(ctx, org.ID, license.ID), ErrLicenseNotFound)
+
+		licenses, err = service.ListLeadLicenses(ctx, org.ID)
+		require.NoError(t, err)
+		assert.Empty(t, licenses)
+	})
+}
+
+func TestSetLeadOwner(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:leads_owner?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	service := NewService(client, nil)
+	org := createScopeTestOrganization(t, client, "acme")
+	l := createScopeTestLead(t, client, "Ink Studio", "tattoo", "US", nil)
+
+	resp, err := service.SetLeadOwner(ctx, l.ID, &org.ID)
+	require.NoError(t, err)
+	require.NotNil(t, resp.OwnerOrganizationID)
+	assert.Equal(t, org.ID, *resp.OwnerOrganizationID)
+
+	resp, err = service.SetLeadOwner(ctx, l.ID, nil)
+	require.NoError(t, err)
+	assert.Nil(t, resp.OwnerOrganizationID)
+
+	unknown := 99999
+	_, err = service.SetLeadOwner(ctx, l.ID, &unknown)
+	assert.ErrorIs(t, err, ErrOrganizationNotFound)
+
+	_, err = service.SetLeadOwner(ctx, 99999, nil)
+	assert.ErrorIs(t, err, ErrLeadNotFound)
+}
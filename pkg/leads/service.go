@@ -2,12 +2,17 @@ package leads
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/jordanlanch/industrydb/ent"
 	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/predicate"
 	"github.com/jordanlanch/industrydb/pkg/domain"
 	"github.com/jordanlanch/industrydb/pkg/models"
 	"entgo.io/ent/dialect/sql"
@@ -15,8 +20,11 @@ import (
 
 // Service handles lead business logic
 type Service struct {
-	db    *ent.Client
-	cache domain.CacheRepository
+	db        *ent.Client
+	cache     domain.CacheRepository
+	estimator RowEstimator // Planner row estimates, nil counts exactly
+	// Limit searches to the leads the searcher's organization owns or licenses
+	orgScoping bool
 }
 
 // NewService creates a new lead service
@@ -36,9 +44,8 @@ func (s *Service) Search(ctx context.Context, req models.LeadSearchRequest) (*mo
 	if req.Limit == 0 {
 		req.Limit = 50
 	}
-	if req.Limit > 100 {
-		req.Limit = 100
-	}
+	// Clamp oversized pages to the searcher's maximum
+	requestedLimit := clampPageSize(&req)
 
 	// Generate cache key
 	cacheKey := s.generateCacheKey(req)
@@ -48,92 +55,17 @@ func (s *Service) Search(ctx context.Context, req models.LeadSearchRequest) (*mo
 		if cached, err := s.cache.Get(ctx, cacheKey); err == nil && cached != "" {
 			var response models.LeadListResponse
 			if err := json.Unmarshal([]byte(cached), &response); err == nil {
-				return &response, nil
+				return projectResponse(markClamped(&response, requestedLimit), req.HiddenFields), nil
 			}
 		}
 	}
 
 	// Build query
-	query := s.db.Lead.Query()
-
-	// Apply filters
-	if req.Industry != "" {
-		query = query.Where(lead.IndustryEQ(lead.Industry(req.Industry)))
-	}
-	if req.SubNiche != "" {
-		query = query.Where(lead.SubNicheEQ(req.SubNiche))
-	}
-	if req.CuisineType != "" {
-		query = query.Where(lead.CuisineTypeEQ(req.CuisineType))
-	}
-	if req.SportType != "" {
-		query = query.Where(lead.SportTypeEQ(req.SportType))
-	}
-	if req.TattooStyle != "" {
-		query = query.Where(lead.TattooStyleEQ(req.TattooStyle))
-	}
-	if req.Country != "" {
-		query = query.Where(lead.CountryEQ(req.Country))
-	}
-	if req.City != "" {
-		query = query.Where(lead.CityEQ(req.City))
-	}
-	if req.HasEmail != nil && *req.HasEmail {
-		query = query.Where(lead.EmailNEQ(""), lead.EmailNotNil())
-	}
-	if req.HasPhone != nil && *req.HasPhone {
-		query = query.Where(lead.PhoneNEQ(""), lead.PhoneNotNil())
-	}
-	if req.HasWebsite != nil && *req.HasWebsite {
-		query = query.Where(lead.WebsiteNEQ(""), lead.WebsiteNotNil())
-	}
-	if req.HasSocialMedia != nil && *req.HasSocialMedia {
-		// Filter for leads with non-empty social_media JSON
-		query = query.Where(lead.SocialMediaNotNil())
-	}
-	if req.Verified != nil {
-		query = query.Where(lead.VerifiedEQ(*req.Verified))
-	}
-
-	// Full-text search using PostgreSQL ts_query
-	if req.Query != "" {
-		// Use plainto_tsquery to handle user input safely
-		query = query.Where(func(s *sql.Selector) {
-			s.Where(sql.P(func(b *sql.Builder) {
-				// Search in name, address, and city using to_tsvector
-				b.WriteString("(")
-				b.WriteString("to_tsvector('english', COALESCE(name, '')) || ")
-				b.WriteString("to_tsvector('english', COALESCE(address, '')) || ")
-				b.WriteString("to_tsvector('english', COALESCE(city, ''))")
-				b.WriteString(") @@ plainto_tsquery('english', ")
-				b.Arg(req.Query)
-				b.WriteString(")")
-			}))
-		})
-	}
-
-	// Radius search using PostGIS
-	if req.Latitude != nil && req.Longitude != nil && req.Radius != nil {
-		// Convert radius to meters (PostGIS uses meters)
-		radiusMeters := *req.Radius * 1000 // Default to km
-		if req.Unit == "miles" {
-			radiusMeters = *req.Radius * 1609.34 // Miles to meters
-		}
-
-		// Use PostGIS ST_DWithin for efficient radius search
-		// ST_DWithin uses spatial index and is faster than ST_Distance
-		query = query.Where(func(s *sql.Selector) {
-			s.Where(sql.P(func(b *sql.Builder) {
-				b.WriteString("ST_DWithin(")
-				b.WriteString("ST_MakePoint(longitude, latitude)::geography, ")
-				b.WriteString("ST_MakePoint(")
-				b.Arg(*req.Longitude).Comma().Arg(*req.Latitude)
-				b.WriteString(")::geography, ")
-				b.Arg(radiusMeters)
-				b.WriteString(")")
-			}))
-		})
+	preds, err := s.scopedPredicates(ctx, req)
+	if err != nil {
+		return nil, err
 	}
+	query := s.db.Lead.Query().Where(preds...)
 
 	// Get total count
 	total, err := query.Count(ctx)
@@ -141,16 +73,29 @@ func (s *Service) Search(ctx context.Context, req models.LeadSearchRequest) (*mo
 		return nil, fmt.Errorf("failed to count leads: %w", err)
 	}
 
+	// Bounding box searches page through at most MaxBoundingBoxResults
+	pageable := total
+	zoomIn := req.BBox != "" && total > MaxBoundingBoxResults
+	if zoomIn {
+		pageable = MaxBoundingBoxResults
+	}
+
 	// Calculate pagination
 	offset := (req.Page - 1) * req.Limit
-	totalPages := (total + req.Limit - 1) / req.Limit
+	totalPages := (pageable + req.Limit - 1) / req.Limit
+	pageLimit := min(req.Limit, max(pageable-offset, 0))
 
 	// Apply sorting
-	sortedQuery := query.Limit(req.Limit).Offset(offset)
+	sortedQuery := query.Limit(pageLimit).Offset(offset)
 
 	switch req.SortBy {
 	case "quality_score":
 		sortedQuery = sortedQuery.Order(ent.Desc(lead.FieldQualityScore))
+	case "completeness_score":
+		sortedQuery = sortedQuery.Order(ent.Desc(lead.FieldCompletenessScore), ent.Desc(lead.FieldCreatedAt))
+	case "updated_at":
+		// Oldest change first, so capped delta exports can resume from the last lead
+		sortedQuery = sortedQuery.Order(ent.Asc(lead.FieldUpdatedAt), ent.Asc(lead.FieldID))
 	case "verified":
 		// Verified first, then by creation date
 		sortedQuery = sortedQuery.Order(ent.Desc(lead.FieldVerified), ent.Desc(lead.FieldCreatedAt))
@@ -186,10 +131,12 @@ func (s *Service) Search(ctx context.Context, req models.LeadSearchRequest) (*mo
 	}
 
 	// Get paginated results
-	leads, err := sortedQuery.All(ctx)
-
-	if err != nil {
-		return nil, fmt.Errorf("failed to query leads: %w", err)
+	var leads []*ent.Lead
+	if pageLimit > 0 {
+		leads, err = sortedQuery.All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query leads: %w", err)
+		}
 	}
 
 	// Convert to response
@@ -198,6 +145,11 @@ func (s *Service) Search(ctx context.Context, req models.LeadSearchRequest) (*mo
 		leadResponses[i] = s.toLeadResponse(l)
 	}
 
+	updatedSince := ""
+	if req.UpdatedSince != nil {
+		updatedSince = req.UpdatedSince.Format(time.RFC3339)
+	}
+
 	response := &models.LeadListResponse{
 		Data: leadResponses,
 		Pagination: models.PaginationInfo{
@@ -209,38 +161,240 @@ func (s *Service) Search(ctx context.Context, req models.LeadSearchRequest) (*mo
 			HasPrev:    req.Page > 1,
 		},
 		Filters: models.AppliedFilters{
-			Industry:       req.Industry,
-			SubNiche:       req.SubNiche,
-			Specialties:    req.Specialties,
-			CuisineType:    req.CuisineType,
-			SportType:      req.SportType,
-			TattooStyle:    req.TattooStyle,
-			Country:        req.Country,
-			City:           req.City,
-			HasEmail:       req.HasEmail,
-			HasPhone:       req.HasPhone,
-			HasWebsite:     req.HasWebsite,
-			HasSocialMedia: req.HasSocialMedia,
-			Verified:       req.Verified,
+			Industry:        req.Industry,
+			Industries:      req.Industries,
+			SubNiche:        req.SubNiche,
+			Specialties:     req.Specialties,
+			CuisineType:     req.CuisineType,
+			SportType:       req.SportType,
+			TattooStyle:     req.TattooStyle,
+			Country:         req.Country,
+			City:            req.City,
+			HasEmail:        req.HasEmail,
+			HasPhone:        req.HasPhone,
+			HasWebsite:      req.HasWebsite,
+			HasAddress:      req.HasAddress,
+			Missing:         req.Missing,
+			HasSocialMedia:  req.HasSocialMedia,
+			Verified:        req.Verified,
+			Source:          req.Source,
+			UpdatedSince:    updatedSince,
+			MinQuality:      req.MinQuality,
+			MaxQuality:      req.MaxQuality,
+			MinCompleteness: req.MinCompleteness,
+			MaxCompleteness: req.MaxCompleteness,
+			BBox:            req.BBox,
 		},
+		ZoomIn: zoomIn,
 	}
 
-	// Cache the response for 5 minutes (if cache client is available)
+	// Cache the response for 5 minutes (if cache client is available). The
+	// full response is cached, hidden fields are left out per request.
 	if s.cache != nil {
 		if responseJSON, err := json.Marshal(response); err == nil {
 			_ = s.cache.Set(ctx, cacheKey, responseJSON, 5*time.Minute)
 		}
 	}
 
-	return response, nil
+	return projectResponse(markClamped(response, requestedLimit), req.HiddenFields), nil
+}
+
+// MatchingLeadIDs returns up to max IDs of the leads matching a search,
+// ascending, and whether more leads matched. Pagination and sorting are
+// ignored.
+func (s *Service) MatchingLeadIDs(ctx context.Context, req models.LeadSearchRequest, max int) ([]int, bool, error) {
+	preds, err := s.scopedPredicates(ctx, req)
+	if err != nil {
+		return nil, false, err
+	}
+	ids, err := s.db.Lead.Query().
+		Where(preds...).
+		Order(ent.Asc(lead.FieldID)).
+		Limit(max + 1).
+		IDs(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to query lead IDs: %w", err)
+	}
+	if len(ids) > max {
+		return ids[:max], true, nil
+	}
+	return ids, false, nil
+}
+
+// searchPredicates translates search filters into lead predicates, shared
+// by Search, Preview and EstimateCount
+func searchPredicates(req models.LeadSearchRequest) []predicate.Lead {
+	var preds []predicate.Lead
+
+	if industries := industryFilter(req); len(industries) == 1 {
+		preds = append(preds, lead.IndustryEQ(industries[0]))
+	} else if len(industries) > 1 {
+		preds = append(preds, lead.IndustryIn(industries...))
+	}
+	if req.SubNiche != "" {
+		preds = append(preds, lead.SubNicheEQ(req.SubNiche))
+	}
+	if req.CuisineType != "" {
+		preds = append(preds, lead.CuisineTypeEQ(req.CuisineType))
+	}
+	if req.SportType != "" {
+		preds = append(preds, lead.SportTypeEQ(req.SportType))
+	}
+	if req.TattooStyle != "" {
+		preds = append(preds, lead.TattooStyleEQ(req.TattooStyle))
+	}
+	if req.Country != "" {
+		preds = append(preds, lead.CountryEQ(req.Country))
+	}
+	if req.City != "" {
+		preds = append(preds, lead.CityEQ(req.City))
+	}
+	// has_<field>=false doesn't filter; missing selects the leads without it
+	if req.HasEmail != nil && *req.HasEmail {
+		preds = append(preds, presentFilter(lead.FieldEmail))
+	}
+	if req.HasPhone != nil && *req.HasPhone {
+		preds = append(preds, presentFilter(lead.FieldPhone))
+	}
+	if req.HasWebsite != nil && *req.HasWebsite {
+		preds = append(preds, presentFilter(lead.FieldWebsite))
+	}
+	if req.HasAddress != nil && *req.HasAddress {
+		preds = append(preds, presentFilter(lead.FieldAddress))
+	}
+	for _, field := range req.Missing {
+		if missingFields[field] {
+			preds = append(preds, missingFilter(field))
+		}
+	}
+	if req.HasSocialMedia != nil && *req.HasSocialMedia {
+		// Filter for leads with non-empty social_media JSON
+		preds = append(preds, lead.SocialMediaNotNil())
+	}
+	if req.Verified != nil {
+		preds = append(preds, lead.VerifiedEQ(*req.Verified))
+	}
+	if req.Source != "" {
+		preds = append(preds, lead.SourceEQ(lead.Source(req.Source)))
+	}
+	if req.UpdatedSince != nil {
+		if req.UpdatedSinceID != nil {
+			// Compound (updated_at, id) cursor: leads updated at the same
+			// instant as the last one covered are not skipped
+			preds = append(preds, lead.Or(
+				lead.UpdatedAtGT(*req.UpdatedSince),
+				lead.And(lead.UpdatedAtEQ(*req.UpdatedSince), lead.IDGT(*req.UpdatedSinceID)),
+			))
+		} else {
+			preds = append(preds, lead.UpdatedAtGT(*req.UpdatedSince))
+		}
+	}
+	if req.MinQuality != nil {
+		preds = append(preds, lead.QualityScoreGTE(*req.MinQuality))
+	}
+	if req.MaxQuality != nil {
+		preds = append(preds, lead.QualityScoreLTE(*req.MaxQuality))
+	}
+	if req.MinCompleteness != nil {
+		preds = append(preds, lead.CompletenessScoreGTE(*req.MinCompleteness))
+	}
+	if req.MaxCompleteness != nil {
+		preds = append(preds, lead.CompletenessScoreLTE(*req.MaxCompleteness))
+	}
+	if len(req.ExcludeLeadIDs) > 0 {
+		preds = append(preds, lead.IDNotIn(req.ExcludeLeadIDs...))
+	}
+
+	// Full-text search using PostgreSQL ts_query
+	if req.Query != "" {
+		// Use plainto_tsquery to handle user input safely
+		preds = append(preds, func(s *sql.Selector) {
+			s.Where(sql.P(func(b *sql.Builder) {
+				// Search in name, address, and city using to_tsvector
+				b.WriteString("(")
+				b.WriteString("to_tsvector('english', COALESCE(name, '')) || ")
+				b.WriteString("to_tsvector('english', COALESCE(address, '')) || ")
+				b.WriteString("to_tsvector('english', COALESCE(city, ''))")
+				b.WriteString(") @@ plainto_tsquery('english', ")
+				b.Arg(req.Query)
+				b.WriteString(")")
+			}))
+		})
+	}
+
+	// Radius search using PostGIS
+	if req.Latitude != nil && req.Longitude != nil && req.Radius != nil {
+		// Convert radius to meters (PostGIS uses meters)
+		radiusMeters := *req.Radius * 1000 // Default to km
+		if req.Unit == "miles" {
+			radiusMeters = *req.Radius * 1609.34 // Miles to meters
+		}
+
+		// Use PostGIS ST_DWithin for efficient radius search
+		// ST_DWithin uses spatial index and is faster than ST_Distance
+		preds = append(preds, func(s *sql.Selector) {
+			s.Where(sql.P(func(b *sql.Builder) {
+				b.WriteString("ST_DWithin(")
+				b.WriteString("ST_MakePoint(longitude, latitude)::geography, ")
+				b.WriteString("ST_MakePoint(")
+				b.Arg(*req.Longitude).Comma().Arg(*req.Latitude)
+				b.WriteString(")::geography, ")
+				b.Arg(radiusMeters)
+				b.WriteString(")")
+			}))
+		})
+	}
+
+	return preds
+}
+
+// missingFields are the lead fields the missing filter accepts
+var missingFields = map[string]bool{
+	lead.FieldEmail:   true,
+	lead.FieldPhone:   true,
+	lead.FieldWebsite: true,
+	lead.FieldAddress: true,
+}
+
+// presentFilter matches leads where the given field is set and not empty
+func presentFilter(field string) predicate.Lead {
+	return lead.And(predicate.Lead(sql.FieldNotNull(field)), predicate.Lead(sql.FieldNEQ(field, "")))
+}
+
+// missingFilter matches leads where the given field is NULL or empty
+func missingFilter(field string) predicate.Lead {
+	return lead.Or(predicate.Lead(sql.FieldIsNull(field)), predicate.Lead(sql.FieldEQ(field, "")))
+}
+
+// industryFilter merges the singular industry with the industries list,
+// dropping duplicates. Leads matching any of the returned industries are
+// included (OR semantics).
+func industryFilter(req models.LeadSearchRequest) []lead.Industry {
+	var industries []lead.Industry
+	seen := make(map[string]bool)
+	for _, ind := range append([]string{req.Industry}, req.Industries...) {
+		if ind == "" || seen[ind] {
+			continue
+		}
+		seen[ind] = true
+		industries = append(industries, lead.Industry(ind))
+	}
+	return industries
 }
 
-// GetByID retrieves a single lead by ID
-func (s *Service) GetByID(ctx context.Context, id int) (*models.LeadResponse, error) {
-	l, err := s.db.Lead.Get(ctx, id)
+// GetByID retrieves a single lead by ID. When lead scoping is on (see
+// SetOrgScoping), leads not visible in orgScope are reported as not found.
+func (s *Service) GetByID(ctx context.Context, id int, orgScope *int) (*models.LeadResponse, error) {
+	preds, err := s.scopedPredicates(ctx, models.LeadSearchRequest{OrgScope: orgScope})
+	if err != nil {
+		return nil, err
+	}
+	l, err := s.db.Lead.Query().
+		Where(append(preds, lead.IDEQ(id))...).
+		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return nil, fmt.Errorf("lead not found")
+			return nil, ErrLeadNotFound
 		}
 		return nil, fmt.Errorf("failed to get lead: %w", err)
 	}
@@ -251,31 +405,61 @@ func (s *Service) GetByID(ctx context.Context, id int) (*models.LeadResponse, er
 
 // toLeadResponse converts an Ent lead to a response model
 func (s *Service) toLeadResponse(l *ent.Lead) models.LeadResponse {
+	verifiedSince := ""
+	if l.VerifiedSince != nil {
+		verifiedSince = l.VerifiedSince.Format(time.RFC3339)
+	}
+	lastSeenAt := ""
+	if l.LastSeenAt != nil {
+		lastSeenAt = l.LastSeenAt.Format(time.RFC3339)
+	}
+
 	return models.LeadResponse{
-		ID:           l.ID,
-		Name:         l.Name,
-		Industry:     string(l.Industry),
-		SubNiche:     l.SubNiche,
-		Specialties:  l.Specialties,
-		CuisineType:  l.CuisineType,
-		SportType:    l.SportType,
-		TattooStyle:  l.TattooStyle,
-		Country:      l.Country,
-		City:         l.City,
-		Address:      l.Address,
-		PostalCode:   l.PostalCode,
-		Phone:        l.Phone,
-		Email:        l.Email,
-		Website:      l.Website,
-		SocialMedia:  l.SocialMedia,
-		Latitude:     l.Latitude,
-		Longitude:    l.Longitude,
-		Verified:     l.Verified,
-		QualityScore: l.QualityScore,
-		CreatedAt:    l.CreatedAt.Format(time.RFC3339),
+		ID:                  l.ID,
+		Name:                l.Name,
+		Industry:            string(l.Industry),
+		SubNiche:            l.SubNiche,
+		Specialties:         l.Specialties,
+		CuisineType:         l.CuisineType,
+		SportType:           l.SportType,
+		TattooStyle:         l.TattooStyle,
+		Country:             l.Country,
+		City:                l.City,
+		Address:             l.Address,
+		PostalCode:          l.PostalCode,
+		Phone:               l.Phone,
+		Email:               l.Email,
+		Website:             l.Website,
+		SocialMedia:         l.SocialMedia,
+		Tags:                l.Tags,
+		OwnerOrganizationID: l.OwnerOrganizationID,
+		Latitude:            l.Latitude,
+		Longitude:           l.Longitude,
+		Verified:            l.Verified,
+		VerifiedSince:       verifiedSince,
+		VerifiedBy:          l.VerifiedBy,
+		QualityScore:        l.QualityScore,
+		CompletenessScore:   l.CompletenessScore,
+		Source:              string(l.Source),
+		SourceID:            l.SourceID,
+		LastSeenAt:          lastSeenAt,
+		CreatedAt:           l.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:           l.UpdatedAt.Format(time.RFC3339),
 	}
 }
 
+// completenessKey is the completeness score range part of cache keys
+func completenessKey(req models.LeadSearchRequest) string {
+	key := "-"
+	if req.MinCompleteness != nil {
+		key = fmt.Sprintf("%d-", *req.MinCompleteness)
+	}
+	if req.MaxCompleteness != nil {
+		key += fmt.Sprintf("%d", *req.MaxCompleteness)
+	}
+	return key
+}
+
 // generateCacheKey generates a cache key from search parameters
 func (s *Service) generateCacheKey(req models.LeadSearchRequest) string {
 	hasEmail := ""
@@ -290,6 +474,10 @@ func (s *Service) generateCacheKey(req models.LeadSearchRequest) string {
 	if req.HasWebsite != nil {
 		hasWebsite = fmt.Sprintf("%t", *req.HasWebsite)
 	}
+	hasAddress := ""
+	if req.HasAddress != nil {
+		hasAddress = fmt.Sprintf("%t", *req.HasAddress)
+	}
 	hasSocialMedia := ""
 	if req.HasSocialMedia != nil {
 		hasSocialMedia = fmt.Sprintf("%t", *req.HasSocialMedia)
@@ -298,6 +486,15 @@ func (s *Service) generateCacheKey(req models.LeadSearchRequest) string {
 	if req.Verified != nil {
 		verified = fmt.Sprintf("%t", *req.Verified)
 	}
+	minQuality := ""
+	if req.MinQuality != nil {
+		minQuality = fmt.Sprintf("%d", *req.MinQuality)
+	}
+	maxQuality := ""
+	if req.MaxQuality != nil {
+		maxQuality = fmt.Sprintf("%d", *req.MaxQuality)
+	}
+	completeness := completenessKey(req)
 	// Radius search parameters
 	latitude := ""
 	longitude := ""
@@ -312,25 +509,54 @@ func (s *Service) generateCacheKey(req models.LeadSearchRequest) string {
 	if req.Radius != nil {
 		radius = fmt.Sprintf("%f", *req.Radius)
 	}
+	updatedSince := ""
+	if req.UpdatedSince != nil {
+		updatedSince = req.UpdatedSince.UTC().Format(time.RFC3339Nano)
+		if req.UpdatedSinceID != nil {
+			updatedSince += fmt.Sprintf("#%d", *req.UpdatedSinceID)
+		}
+	}
 	sortBy := req.SortBy
+	// Suppressing or unsuppressing a lead changes the key
+	excluded := ""
+	if len(req.ExcludeLeadIDs) > 0 {
+		ids := append([]int(nil), req.ExcludeLeadIDs...)
+		sort.Ints(ids)
+		hash := sha256.Sum256([]byte(fmt.Sprint(ids)))
+		excluded = hex.EncodeToString(hash[:8])
+	}
 
-	return fmt.Sprintf("leads:search:%s:%s:%s:%s:%s:%s:%s:%s:%s:%s:%s:%s:%s:%s:%s:%s:%s:%s:%d:%d",
+	return fmt.Sprintf("leads:search:%s:%s:%s:%s:%s:%s:%s:%s:%s:%s:%s:%s:%s:%s:%s:%s:%s:%s:%s:%s:%s:%s:%s:%s:%s:%s:%s:%s:%s:%d:%d",
 		req.Query,
-		req.Industry, req.SubNiche, req.CuisineType, req.SportType, req.TattooStyle,
+		req.Industry, strings.Join(req.Industries, ","), req.SubNiche, req.CuisineType, req.SportType, req.TattooStyle,
 		req.Country, req.City,
-		hasEmail, hasPhone, hasWebsite, hasSocialMedia, verified,
-		latitude, longitude, radius, unit, sortBy,
+		hasEmail, hasPhone, hasWebsite, hasAddress, strings.Join(req.Missing, ","), hasSocialMedia, verified, req.Source, updatedSince, minQuality, maxQuality, completeness,
+		latitude, longitude, radius, unit, req.BBox, sortBy, excluded, s.scopeKey(req),
 		req.Page, req.Limit)
 }
 
 // Preview generates preview statistics for a search without charging credits
 func (s *Service) Preview(ctx context.Context, req models.LeadSearchRequest) (*models.LeadPreviewResponse, error) {
 	// Generate cache key for preview
-	cacheKey := fmt.Sprintf("leads:preview:%s:%s:%s:%s:%s:%s:%s",
-		req.Industry, req.SubNiche, req.Country, req.City,
+	minQuality := ""
+	if req.MinQuality != nil {
+		minQuality = fmt.Sprintf("%d", *req.MinQuality)
+	}
+	maxQuality := ""
+	if req.MaxQuality != nil {
+		maxQuality = fmt.Sprintf("%d", *req.MaxQuality)
+	}
+	cacheKey := fmt.Sprintf("leads:preview:%s:%s:%s:%s:%s:%s:%s:%s:%s:%s:%s:%s:%s:%s:%s:%s:%s:%s",
+		req.Industry, strings.Join(req.Industries, ","), req.SubNiche, req.Country, req.City,
 		fmt.Sprintf("%v", req.HasEmail),
 		fmt.Sprintf("%v", req.HasPhone),
-		fmt.Sprintf("%v", req.Verified))
+		fmt.Sprintf("%v", req.HasWebsite),
+		fmt.Sprintf("%v", req.HasAddress),
+		strings.Join(req.Missing, ","),
+		fmt.Sprintf("%v", req.Verified),
+		req.Source,
+		fmt.Sprintf("%v", req.UpdatedSince),
+		minQuality, maxQuality, completenessKey(req), req.BBox, s.scopeKey(req))
 
 	// Try to get from cache (15 minutes - longer than search since it's cheaper)
 	if s.cache != nil {
@@ -343,86 +569,11 @@ func (s *Service) Preview(ctx context.Context, req models.LeadSearchRequest) (*m
 	}
 
 	// Build base query (same filters as Search)
-	query := s.db.Lead.Query()
-
-	// Apply filters
-	if req.Industry != "" {
-		query = query.Where(lead.IndustryEQ(lead.Industry(req.Industry)))
-	}
-	if req.SubNiche != "" {
-		query = query.Where(lead.SubNicheEQ(req.SubNiche))
-	}
-	if req.CuisineType != "" {
-		query = query.Where(lead.CuisineTypeEQ(req.CuisineType))
-	}
-	if req.SportType != "" {
-		query = query.Where(lead.SportTypeEQ(req.SportType))
-	}
-	if req.TattooStyle != "" {
-		query = query.Where(lead.TattooStyleEQ(req.TattooStyle))
-	}
-	if req.Country != "" {
-		query = query.Where(lead.CountryEQ(req.Country))
-	}
-	if req.City != "" {
-		query = query.Where(lead.CityEQ(req.City))
-	}
-	if req.HasEmail != nil && *req.HasEmail {
-		query = query.Where(lead.EmailNEQ(""), lead.EmailNotNil())
-	}
-	if req.HasPhone != nil && *req.HasPhone {
-		query = query.Where(lead.PhoneNEQ(""), lead.PhoneNotNil())
-	}
-	if req.HasWebsite != nil && *req.HasWebsite {
-		query = query.Where(lead.WebsiteNEQ(""), lead.WebsiteNotNil())
-	}
-	if req.HasSocialMedia != nil && *req.HasSocialMedia {
-		// Filter for leads with non-empty social_media JSON
-		query = query.Where(lead.SocialMediaNotNil())
-	}
-	if req.Verified != nil {
-		query = query.Where(lead.VerifiedEQ(*req.Verified))
-	}
-
-	// Full-text search using PostgreSQL ts_query
-	if req.Query != "" {
-		// Use plainto_tsquery to handle user input safely
-		query = query.Where(func(s *sql.Selector) {
-			s.Where(sql.P(func(b *sql.Builder) {
-				// Search in name, address, and city using to_tsvector
-				b.WriteString("(")
-				b.WriteString("to_tsvector('english', COALESCE(name, '')) || ")
-				b.WriteString("to_tsvector('english', COALESCE(address, '')) || ")
-				b.WriteString("to_tsvector('english', COALESCE(city, ''))")
-				b.WriteString(") @@ plainto_tsquery('english', ")
-				b.Arg(req.Query)
-				b.WriteString(")")
-			}))
-		})
-	}
-
-	// Radius search using PostGIS
-	if req.Latitude != nil && req.Longitude != nil && req.Radius != nil {
-		// Convert radius to meters (PostGIS uses meters)
-		radiusMeters := *req.Radius * 1000 // Default to km
-		if req.Unit == "miles" {
-			radiusMeters = *req.Radius * 1609.34 // Miles to meters
-		}
-
-		// Use PostGIS ST_DWithin for efficient radius search
-		// ST_DWithin uses spatial index and is faster than ST_Distance
-		query = query.Where(func(s *sql.Selector) {
-			s.Where(sql.P(func(b *sql.Builder) {
-				b.WriteString("ST_DWithin(")
-				b.WriteString("ST_MakePoint(longitude, latitude)::geography, ")
-				b.WriteString("ST_MakePoint(")
-				b.Arg(*req.Longitude).Comma().Arg(*req.Latitude)
-				b.WriteString(")::geography, ")
-				b.Arg(radiusMeters)
-				b.WriteString(")")
-			}))
-		})
+	preds, err := s.scopedPredicates(ctx, req)
+	if err != nil {
+		return nil, err
 	}
+	query := s.db.Lead.Query().Where(preds...)
 
 	// Get total count
 	totalCount, err := query.Count(ctx)
@@ -522,3 +673,13 @@ func (s *Service) InvalidateCache(ctx context.Context) error {
 	}
 	return nil
 }
+
+// KeepUpdatedAt is a lead update modifier leaving updated_at as is. Use it
+// for bookkeeping writes (scores, SLA flags) that don't change what we know
+// about a lead, so they don't show up in delta exports or count as recent
+// activity.
+func KeepUpdatedAt(u *sql.UpdateBuilder) {
+	u.Set(lead.FieldUpdatedAt, sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(lead.FieldUpdatedAt)
+	}))
+}
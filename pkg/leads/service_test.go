@@ -799,3 +799,266 @@ func TestSearch_QueryWithOtherFilters(t *testing.T) {
 		assert.True(t, strings.Contains(text, "new") || strings.Contains(text, "york"))
 	}
 }
+
+func TestSearch_WithMultipleIndustries(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:leads_multi_industry?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	service := NewService(client, nil)
+
+	ctx := context.Background()
+	seed := []struct {
+		industry lead.Industry
+		country  string
+	}{
+		{lead.IndustryCafe, "US"},
+		{lead.IndustryCafe, "US"},
+		{lead.IndustryBakery, "US"},
+		{lead.IndustryBakery, "GB"},
+		{lead.IndustryGym, "US"},
+	}
+	for i, s := range seed {
+		_, err := client.Lead.Create().
+			SetName(fmt.Sprintf("Lead %d", i)).
+			SetIndustry(s.industry).
+			SetCountry(s.country).
+			SetCity("Springfield").
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	t.Run("Matches any listed industry", func(t *testing.T) {
+		result, err := service.Search(ctx, models.LeadSearchRequest{
+			Industries: []string{"cafe", "bakery"},
+			Page:       1,
+			Limit:      10,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 4, result.Pagination.Total)
+		assert.Equal(t, []string{"cafe", "bakery"}, result.Filters.Industries)
+		for _, l := range result.Data {
+			assert.Contains(t, []string{"cafe", "bakery"}, l.Industry)
+		}
+	})
+
+	t.Run("Singular industry is merged with the list", func(t *testing.T) {
+		result, err := service.Search(ctx, models.LeadSearchRequest{
+			Industry:   "gym",
+			Industries: []string{"bakery"},
+			Page:       1,
+			Limit:      10,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 3, result.Pagination.Total)
+	})
+
+	t.Run("Combined with country paginates correctly", func(t *testing.T) {
+		req := models.LeadSearchRequest{
+			Industries: []string{"cafe", "bakery"},
+			Country:    "US",
+			Page:       1,
+			Limit:      2,
+		}
+		first, err := service.Search(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, 3, first.Pagination.Total)
+		assert.Equal(t, 2, first.Pagination.TotalPages)
+		assert.Len(t, first.Data, 2)
+		assert.True(t, first.Pagination.HasNext)
+
+		req.Page = 2
+		second, err := service.Search(ctx, req)
+		require.NoError(t, err)
+		assert.Len(t, second.Data, 1)
+		assert.False(t, second.Pagination.HasNext)
+		assert.NotEqual(t, first.Data[0].ID, second.Data[0].ID)
+		assert.NotEqual(t, first.Data[1].ID, second.Data[0].ID)
+	})
+}
+
+func TestSearch_WithQualityRange(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:leads_quality_range?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	service := NewService(client, nil)
+
+	ctx := context.Background()
+	for i, score := range []int{10, 55, 70, 85, 100} {
+		_, err := client.Lead.Create().
+			SetName(fmt.Sprintf("Lead %d", i)).
+			SetIndustry(lead.IndustryTattoo).
+			SetCountry("US").
+			SetCity("New York").
+			SetQualityScore(score).
+			SetVerified(score >= 85).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	minQuality, maxQuality := 70, 90
+	verified := true
+
+	t.Run("Min only", func(t *testing.T) {
+		result, err := service.Search(ctx, models.LeadSearchRequest{MinQuality: &minQuality, Page: 1, Limit: 10})
+		require.NoError(t, err)
+		assert.Equal(t, 3, result.Pagination.Total)
+		for _, l := range result.Data {
+			assert.GreaterOrEqual(t, l.QualityScore, minQuality)
+		}
+	})
+
+	t.Run("Min and max are inclusive", func(t *testing.T) {
+		result, err := service.Search(ctx, models.LeadSearchRequest{MinQuality: &minQuality, MaxQuality: &maxQuality, Page: 1, Limit: 10})
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.Pagination.Total)
+		assert.Equal(t, &minQuality, result.Filters.MinQuality)
+		assert.Equal(t, &maxQuality, result.Filters.MaxQuality)
+	})
+
+	t.Run("Combined with verified", func(t *testing.T) {
+		result, err := service.Search(ctx, models.LeadSearchRequest{MinQuality: &minQuality, Verified: &verified, Page: 1, Limit: 10})
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.Pagination.Total)
+	})
+}
+
+func TestSearch_WithCompletenessFilters(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:leads_completeness?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	service := NewService(client, nil)
+
+	ctx := context.Background()
+	seed := []struct {
+		industry lead.Industry
+		country  string
+		email    string
+		address  string
+	}{
+		{lead.IndustryTattoo, "US", "a@example.com", "1 Main St"},
+		{lead.IndustryTattoo, "US", "b@example.com", ""},
+		{lead.IndustryTattoo, "US", "", "3 Main St"},
+		{lead.IndustryTattoo, "GB", "d@example.com", "4 High St"},
+		{lead.IndustryGym, "US", "e@example.com", "5 Main St"},
+	}
+	for i, s := range seed {
+		builder := client.Lead.Create().
+			SetName(fmt.Sprintf("Lead %d", i)).
+			SetIndustry(s.industry).
+			SetCountry(s.country).
+			SetCity("Springfield")
+		if s.email != "" {
+			builder.SetEmail(s.email)
+		}
+		if s.address != "" {
+			builder.SetAddress(s.address)
+		}
+		_, err := builder.Save(ctx)
+		require.NoError(t, err)
+	}
+
+	yes, no := true, false
+
+	t.Run("HasAddress true composes with industry and country", func(t *testing.T) {
+		result, err := service.Search(ctx, models.LeadSearchRequest{
+			Industry:   "tattoo",
+			Country:    "US",
+			HasAddress: &yes,
+			Page:       1,
+			Limit:      10,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.Pagination.Total)
+		for _, l := range result.Data {
+			assert.NotEmpty(t, l.Address)
+		}
+		require.NotNil(t, result.Filters.HasAddress)
+		assert.True(t, *result.Filters.HasAddress)
+	})
+
+	t.Run("HasEmail false doesn't filter", func(t *testing.T) {
+		result, err := service.Search(ctx, models.LeadSearchRequest{HasEmail: &no, Page: 1, Limit: 10})
+		require.NoError(t, err)
+		assert.Equal(t, 5, result.Pagination.Total)
+	})
+
+	t.Run("Missing email matches leads without email", func(t *testing.T) {
+		result, err := service.Search(ctx, models.LeadSearchRequest{Missing: []string{"email"}, Page: 1, Limit: 10})
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Pagination.Total)
+		assert.Empty(t, result.Data[0].Email)
+		assert.Equal(t, []string{"email"}, result.Filters.Missing)
+	})
+
+	t.Run("Missing address composes with HasEmail", func(t *testing.T) {
+		result, err := service.Search(ctx, models.LeadSearchRequest{
+			HasEmail: &yes,
+			Missing:  []string{"address"},
+			Page:     1,
+			Limit:    10,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Pagination.Total)
+		assert.Equal(t, "b@example.com", result.Data[0].Email)
+	})
+
+	t.Run("Paginates with combined filters", func(t *testing.T) {
+		req := models.LeadSearchRequest{
+			Country:    "US",
+			HasEmail:   &yes,
+			HasAddress: &yes,
+			Page:       1,
+			Limit:      1,
+		}
+		first, err := service.Search(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, 2, first.Pagination.Total)
+		assert.Equal(t, 2, first.Pagination.TotalPages)
+		require.Len(t, first.Data, 1)
+
+		req.Page = 2
+		second, err := service.Search(ctx, req)
+		require.NoError(t, err)
+		require.Len(t, second.Data, 1)
+		assert.NotEqual(t, first.Data[0].ID, second.Data[0].ID)
+	})
+}
+
+func TestSearch_WithSource(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:leads_source?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	service := NewService(client, nil)
+
+	ctx := context.Background()
+	seenAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	// Source defaults to osm when not set
+	_, err := client.Lead.Create().
+		SetName("OSM Lead").
+		SetIndustry(lead.IndustryTattoo).
+		SetCountry("US").
+		SetCity("New York").
+		Save(ctx)
+	require.NoError(t, err)
+	_, err = client.Lead.Create().
+		SetName("Imported Lead").
+		SetIndustry(lead.IndustryTattoo).
+		SetCountry("US").
+		SetCity("New York").
+		SetSource(lead.SourceCsvImport).
+		SetSourceID("row-42").
+		SetLastSeenAt(seenAt).
+		Save(ctx)
+	require.NoError(t, err)
+
+	result, err := service.Search(ctx, models.LeadSearchRequest{Source: "csv_import", Page: 1, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, result.Data, 1)
+	assert.Equal(t, "csv_import", result.Data[0].Source)
+	assert.Equal(t, "row-42", result.Data[0].SourceID)
+	assert.Equal(t, seenAt.Format(time.RFC3339), result.Data[0].LastSeenAt)
+	assert.Equal(t, "csv_import", result.Filters.Source)
+
+	result, err = service.Search(ctx, models.LeadSearchRequest{Source: "osm", Page: 1, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, result.Data, 1)
+	assert.Equal(t, "OSM Lead", result.Data[0].Name)
+	assert.Empty(t, result.Data[0].LastSeenAt)
+}
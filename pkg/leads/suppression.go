@@ -0,0 +1,216 @@
+package leads
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/leadsuppression"
+	"github.com/jordanlanch/industrydb/ent/organization"
+	"github.com/jordanlanch/industrydb/ent/organizationmember"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+	"github.com/jordanlanch/industrydb/pkg/models"
+)
+
+// Suppression errors
+var (
+	ErrLeadNotFound          = errors.New("lead not found")
+	ErrNotSuppressed         = errors.New("lead is not suppressed")
+	ErrNotOrganizationMember = errors.New("not a member of the organization")
+)
+
+// SuppressionResponse is a lead on a suppression list
+type SuppressionResponse struct {
+	ID             int    `json:"id"`
+	LeadID         int    `json:"lead_id"`
+	LeadName       string `json:"lead_name"`
+	UserID         int    `json:"user_id"`                   // Who suppressed the lead
+	OrganizationID *int   `json:"organization_id,omitempty"` // Set when shared with an organization
+	Reason         string `json:"reason,omitempty"`
+	CreatedAt      string `json:"created_at"`
+}
+
+// SuppressionListResponse is a page of suppressed leads
+type SuppressionListResponse struct {
+	Data       []SuppressionResponse `json:"data"`
+	Pagination models.PaginationInfo `json:"pagination"`
+}
+
+// SuppressedFor matches the suppressions that hide leads from a user: their
+// own and those shared with organizations they are an active member of
+func SuppressedFor(userID int) predicate.LeadSuppression {
+	return leadsuppression.Or(
+		leadsuppression.UserID(userID),
+		leadsuppression.HasOrganizationWith(organization.HasMembersWith(
+			organizationmember.UserID(userID),
+			organizationmember.StatusEQ(organizationmember.StatusActive),
+		)),
+	)
+}
+
+// Suppress hides a lead from the user's searches and exports, or from every
+// member's when organizationID is set. Suppressing a lead again replaces the
+// reason and sharing.
+func (s *Service) Suppress(ctx context.Context, userID, leadID int, reason string, organizationID *int) (*SuppressionResponse, error) {
+	l, err := s.db.Lead.Get(ctx, leadID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrLeadNotFound
+		}
+		return nil, fmt.Errorf("failed to get lead: %w", err)
+	}
+
+	if organizationID != nil {
+		isMember, err := s.db.OrganizationMember.Query().
+			Where(
+				organizationmember.OrganizationID(*organizationID),
+				organizationmember.UserID(userID),
+				organizationmember.StatusEQ(organizationmember.StatusActive),
+			).
+			Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check organization membership: %w", err)
+		}
+		if !isMember {
+			return nil, ErrNotOrganizationMember
+		}
+	}
+
+	existing, err := s.db.LeadSuppression.Query().
+		Where(leadsuppression.UserID(userID), leadsuppression.LeadID(leadID)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get suppression: %w", err)
+	}
+
+	var suppression *ent.LeadSuppression
+	if existing != nil {
+		update := existing.Update().
+			SetReason(reason).
+			SetNillableOrganizationID(organizationID)
+		if organizationID == nil {
+			update = update.ClearOrganizationID()
+		}
+		suppression, err = update.Save(ctx)
+	} else {
+		suppression, err = s.db.LeadSuppression.Create().
+			SetLeadID(leadID).
+			SetUserID(userID).
+			SetNillableOrganizationID(organizationID).
+			SetReason(reason).
+			Save(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to suppress lead: %w", err)
+	}
+
+	response := toSuppressionResponse(suppression, l.Name)
+	return &response, nil
+}
+
+// Unsuppress removes the user's suppression of a lead, and the suppressions
+// shared with organizations the user administers
+func (s *Service) Unsuppress(ctx context.Context, userID, leadID int) error {
+	deleted, err := s.db.LeadSuppression.Delete().
+		Where(
+			leadsuppression.LeadID(leadID),
+			leadsuppression.Or(
+				leadsuppression.UserID(userID),
+				leadsuppression.HasOrganizationWith(organization.HasMembersWith(
+					organizationmember.UserID(userID),
+					organizationmember.StatusEQ(organizationmember.StatusActive),
+					organizationmember.RoleIn(organizationmember.RoleOwner, organizationmember.RoleAdmin),
+				)),
+			),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to unsuppress lead: %w", err)
+	}
+	if deleted == 0 {
+		return ErrNotSuppressed
+	}
+
+	return nil
+}
+
+// ListSuppressions lists the suppressions hiding leads from the user, newest first
+func (s *Service) ListSuppressions(ctx context.Context, userID, page, limit int) (*SuppressionListResponse, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 50
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	query := s.db.LeadSuppression.Query().Where(SuppressedFor(userID))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count suppressions: %w", err)
+	}
+
+	suppressions, err := query.
+		WithLead().
+		Order(ent.Desc(leadsuppression.FieldCreatedAt), ent.Desc(leadsuppression.FieldID)).
+		Limit(limit).
+		Offset((page - 1) * limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list suppressions: %w", err)
+	}
+
+	data := make([]SuppressionResponse, len(suppressions))
+	for i, suppression := range suppressions {
+		leadName := ""
+		if suppression.Edges.Lead != nil {
+			leadName = suppression.Edges.Lead.Name
+		}
+		data[i] = toSuppressionResponse(suppression, leadName)
+	}
+
+	totalPages := (total + limit - 1) / limit
+	return &SuppressionListResponse{
+		Data: data,
+		Pagination: models.PaginationInfo{
+			Page:       page,
+			Limit:      limit,
+			Total:      total,
+			TotalPages: totalPages,
+			HasNext:    page < totalPages,
+			HasPrev:    page > 1,
+		},
+	}, nil
+}
+
+// SuppressedLeadIDs returns the IDs of the leads hidden from the user, for
+// LeadSearchRequest.ExcludeLeadIDs
+func (s *Service) SuppressedLeadIDs(ctx context.Context, userID int) ([]int, error) {
+	ids, err := s.db.LeadSuppression.Query().
+		Where(SuppressedFor(userID)).
+		Unique(true).
+		Select(leadsuppression.FieldLeadID).
+		Ints(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get suppressed leads: %w", err)
+	}
+	return ids, nil
+}
+
+// toSuppressionResponse converts an Ent suppression to a response model
+func toSuppressionResponse(suppression *ent.LeadSuppression, leadName string) SuppressionResponse {
+	return SuppressionResponse{
+		ID:             suppression.ID,
+		LeadID:         suppression.LeadID,
+		LeadName:       leadName,
+		UserID:         suppression.UserID,
+		OrganizationID: suppression.OrganizationID,
+		Reason:         suppression.Reason,
+		CreatedAt:      suppression.CreatedAt.Format(time.RFC3339),
+	}
+}
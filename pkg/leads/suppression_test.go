@@ -0,0 +1,120 @@
+package leads
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/ent/organizationmember"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createSuppressionUser(t *testing.T, client *ent.Client, email string) *ent.User {
+	user, err := client.User.Create().
+		SetEmail(email).
+		SetPasswordHash("hash").
+		SetName(email).
+		Save(context.Background())
+	require.NoError(t, err)
+	return user
+}
+
+func TestSuppression(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:leads_suppression?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	service := NewService(client, nil)
+
+	rep := createSuppressionUser(t, client, "rep@example.com")
+	admin := createSuppressionUser(t, client, "admin@example.com")
+	outsider := createSuppressionUser(t, client, "outsider@example.com")
+
+	org, err := client.Organization.Create().
+		SetName("Sales Team").
+		SetSlug("sales-team").
+		SetOwnerID(admin.ID).
+		Save(ctx)
+	require.NoError(t, err)
+	client.OrganizationMember.Create().SetOrganizationID(org.ID).SetUserID(admin.ID).SetRole(organizationmember.RoleAdmin).SaveX(ctx)
+	client.OrganizationMember.Create().SetOrganizationID(org.ID).SetUserID(rep.ID).SetRole(organizationmember.RoleMember).SaveX(ctx)
+
+	personal := createTestLeadWithFields(t, client, "Disqualified Ink", true, false, false, false)
+	shared := createTestLeadWithFields(t, client, "Shared Ink", true, false, false, false)
+	visible := createTestLeadWithFields(t, client, "Visible Ink", true, false, false, false)
+
+	t.Run("Personal suppression hides the lead from its user only", func(t *testing.T) {
+		suppression, err := service.Suppress(ctx, rep.ID, personal.ID, "Closed down", nil)
+		require.NoError(t, err)
+		assert.Equal(t, "Disqualified Ink", suppression.LeadName)
+		assert.Equal(t, "Closed down", suppression.Reason)
+		assert.Nil(t, suppression.OrganizationID)
+
+		ids, err := service.SuppressedLeadIDs(ctx, rep.ID)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []int{personal.ID}, ids)
+
+		ids, err = service.SuppressedLeadIDs(ctx, admin.ID)
+		require.NoError(t, err)
+		assert.Empty(t, ids)
+	})
+
+	t.Run("Search excludes suppressed leads", func(t *testing.T) {
+		results, err := service.Search(ctx, models.LeadSearchRequest{ExcludeLeadIDs: []int{personal.ID}})
+		require.NoError(t, err)
+		assert.Equal(t, 2, results.Pagination.Total)
+		for _, l := range results.Data {
+			assert.NotEqual(t, personal.ID, l.ID)
+		}
+	})
+
+	t.Run("Organization suppression hides the lead from every member", func(t *testing.T) {
+		_, err := service.Suppress(ctx, admin.ID, shared.ID, "Competitor", &org.ID)
+		require.NoError(t, err)
+
+		ids, err := service.SuppressedLeadIDs(ctx, rep.ID)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []int{personal.ID, shared.ID}, ids)
+
+		ids, err = service.SuppressedLeadIDs(ctx, outsider.ID)
+		require.NoError(t, err)
+		assert.Empty(t, ids)
+
+		list, err := service.ListSuppressions(ctx, rep.ID, 1, 50)
+		require.NoError(t, err)
+		assert.Equal(t, 2, list.Pagination.Total)
+	})
+
+	t.Run("Suppressing again replaces the reason", func(t *testing.T) {
+		suppression, err := service.Suppress(ctx, rep.ID, personal.ID, "Not a fit", nil)
+		require.NoError(t, err)
+		assert.Equal(t, "Not a fit", suppression.Reason)
+
+		count, err := client.LeadSuppression.Query().Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		_, err := service.Suppress(ctx, rep.ID, 999999, "", nil)
+		assert.ErrorIs(t, err, ErrLeadNotFound)
+
+		_, err = service.Suppress(ctx, outsider.ID, visible.ID, "", &org.ID)
+		assert.ErrorIs(t, err, ErrNotOrganizationMember)
+
+		assert.ErrorIs(t, service.Unsuppress(ctx, rep.ID, visible.ID), ErrNotSuppressed)
+	})
+
+	t.Run("Only organization admins unsuppress shared leads", func(t *testing.T) {
+		assert.ErrorIs(t, service.Unsuppress(ctx, rep.ID, shared.ID), ErrNotSuppressed)
+		assert.NoError(t, service.Unsuppress(ctx, admin.ID, shared.ID))
+		assert.NoError(t, service.Unsuppress(ctx, rep.ID, personal.ID))
+
+		ids, err := service.SuppressedLeadIDs(ctx, rep.ID)
+		require.NoError(t, err)
+		assert.Empty(t, ids)
+	})
+}
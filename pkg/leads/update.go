@@ -0,0 +1,238 @@
+package leads
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/leadchange"
+	"github.com/jordanlanch/industrydb/ent/organizationmember"
+	"github.com/jordanlanch/industrydb/ent/user"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/jordanlanch/industrydb/pkg/phone"
+)
+
+// Lead update errors
+var (
+	ErrInvalidLeadUpdate = errors.New("invalid lead update")
+	ErrLeadEditForbidden = errors.New("only admins and the owner of the lead's organization can edit it")
+	ErrLeadConflict      = errors.New("lead was modified since it was read")
+)
+
+// UpdateLeadRequest is a partial update of a lead: only the fields set are
+// changed. An empty string clears an optional field.
+type UpdateLeadRequest struct {
+	Name       *string  `json:"name,omitempty"`
+	Address    *string  `json:"address,omitempty"`
+	PostalCode *string  `json:"postal_code,omitempty"`
+	Phone      *string  `json:"phone,omitempty"`   // Validated for the lead's country and stored in E.164
+	Website    *string  `json:"website,omitempty"` // Absolute http(s) URL
+	Latitude   *float64 `json:"latitude,omitempty"`
+	Longitude  *float64 `json:"longitude,omitempty"`
+	// UpdatedAt is the lead's updated_at as last read. When set, the update
+	// fails with ErrLeadConflict if the lead changed since.
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// UpdateLead applies a partial update to a lead on behalf of userID, who must
+// be an admin or the owner of the lead's owning organization. The quality
+// and completeness scores are recomputed and the change is recorded in the
+// lead's history, in one transaction.
+func (s *Service) UpdateLead(ctx context.Context, userID, leadID int, req UpdateLeadRequest) (*models.LeadResponse, error) {
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	updated, err := updateLead(ctx, tx, userID, leadID, req)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	_ = s.InvalidateCache(ctx)
+	response := s.toLeadResponse(updated)
+	return &response, nil
+}
+
+// updateLead applies a lead update within tx
+func updateLead(ctx context.Context, tx *ent.Tx, userID, leadID int, req UpdateLeadRequest) (*ent.Lead, error) {
+	l, err := tx.Lead.Get(ctx, leadID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrLeadNotFound
+		}
+		return nil, fmt.Errorf("failed to get lead: %w", err)
+	}
+
+	if err := checkLeadEditor(ctx, tx.Client(), userID, l); err != nil {
+		return nil, err
+	}
+
+	// Clients see updated_at with second precision
+	if req.UpdatedAt != nil && !l.UpdatedAt.Truncate(time.Second).Equal(req.UpdatedAt.Truncate(time.Second)) {
+		return nil, ErrLeadConflict
+	}
+
+	update, changed, err := buildLeadUpdate(tx.Lead.UpdateOne(l), l, req)
+	if err != nil {
+		return nil, err
+	}
+	if !changed {
+		return l, nil
+	}
+
+	// The lead must not have changed since it was read above
+	updated, err := update.Where(lead.UpdatedAt(l.UpdatedAt)).Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrLeadConflict
+		}
+		return nil, fmt.Errorf("failed to update lead: %w", err)
+	}
+
+	updated, err = RefreshQuality(ctx, tx.Lead, updated)
+	if err != nil {
+		return nil, err
+	}
+	updated, err = RefreshCompleteness(ctx, tx.Lead, updated)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := RecordChange(ctx, tx.LeadChange, l, updated, leadchange.SourceManual, userID); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// checkLeadEditor returns ErrLeadEditForbidden unless the user is an admin or
+// the owner of the organization owning the lead
+func checkLeadEditor(ctx context.Context, client *ent.Client, userID int, l *ent.Lead) error {
+	u, err := client.User.Get(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrLeadEditForbidden
+		}
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	if u.Role == user.RoleAdmin || u.Role == user.RoleSuperadmin {
+		return nil
+	}
+	if l.OwnerOrganizationID == nil {
+		return ErrLeadEditForbidden
+	}
+
+	isOwner, err := client.OrganizationMember.Query().
+		Where(
+			organizationmember.OrganizationID(*l.OwnerOrganizationID),
+			organizationmember.UserID(userID),
+			organizationmember.RoleEQ(organizationmember.RoleOwner),
+			organizationmember.StatusEQ(organizationmember.StatusActive),
+		).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check organization membership: %w", err)
+	}
+	if !isOwner {
+		return ErrLeadEditForbidden
+	}
+	return nil
+}
+
+// buildLeadUpdate validates the request against the lead and sets the fields
+// that differ on update, reporting whether any did
+func buildLeadUpdate(update *ent.LeadUpdateOne, l *ent.Lead, req UpdateLeadRequest) (*ent.LeadUpdateOne, bool, error) {
+	changed := false
+
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if name == "" {
+			return nil, false, fmt.Errorf("%w: name can't be empty", ErrInvalidLeadUpdate)
+		}
+		if name != l.Name {
+			update.SetName(name)
+			changed = true
+		}
+	}
+
+	setOptional := func(value *string, current string, set func(string) *ent.LeadUpdateOne, clear func() *ent.LeadUpdateOne) {
+		if value == nil || *value == current {
+			return
+		}
+		if *value == "" {
+			clear()
+		} else {
+			set(*value)
+		}
+		changed = true
+	}
+
+	if req.Address != nil {
+		address := strings.TrimSpace(*req.Address)
+		setOptional(&address, l.Address, update.SetAddress, update.ClearAddress)
+	}
+	if req.PostalCode != nil {
+		postalCode := strings.TrimSpace(*req.PostalCode)
+		setOptional(&postalCode, l.PostalCode, update.SetPostalCode, update.ClearPostalCode)
+	}
+
+	if req.Phone != nil {
+		number := strings.TrimSpace(*req.Phone)
+		if number != "" {
+			result, err := phone.ValidatePhone(number, l.Country)
+			if err != nil || !result.IsValid {
+				return nil, false, fmt.Errorf("%w: phone %q is not a valid number for %s", ErrInvalidLeadUpdate, number, l.Country)
+			}
+			number = result.E164Format
+		}
+		setOptional(&number, l.Phone, update.SetPhone, update.ClearPhone)
+	}
+
+	if req.Website != nil {
+		website := strings.TrimSpace(*req.Website)
+		if website != "" && !isWebsiteURL(website) {
+			return nil, false, fmt.Errorf("%w: website must be an absolute http or https URL", ErrInvalidLeadUpdate)
+		}
+		setOptional(&website, l.Website, update.SetWebsite, update.ClearWebsite)
+	}
+
+	// Coordinates only make sense as a pair
+	if (req.Latitude == nil) != (req.Longitude == nil) {
+		return nil, false, fmt.Errorf("%w: latitude and longitude must be set together", ErrInvalidLeadUpdate)
+	}
+	if req.Latitude != nil {
+		lat, lng := *req.Latitude, *req.Longitude
+		if lat < -90 || lat > 90 {
+			return nil, false, fmt.Errorf("%w: latitude must be between -90 and 90", ErrInvalidLeadUpdate)
+		}
+		if lng < -180 || lng > 180 {
+			return nil, false, fmt.Errorf("%w: longitude must be between -180 and 180", ErrInvalidLeadUpdate)
+		}
+		if lat != l.Latitude || lng != l.Longitude {
+			update.SetLatitude(lat).SetLongitude(lng)
+			changed = true
+		}
+	}
+
+	return update, changed, nil
+}
+
+// isWebsiteURL reports whether raw is an absolute http(s) URL with a host
+func isWebsiteURL(raw string) bool {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
@@ -0,0 +1,128 @@
+package leads
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/ent/leadchange"
+	"github.com/jordanlanch/industrydb/ent/organizationmember"
+	"github.com/jordanlanch/industrydb/ent/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateLead(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:leads_update?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	service := NewService(client, nil)
+
+	acme := createScopeTestOrganization(t, client, "update-acme")
+	client.OrganizationMember.Create().
+		SetOrganizationID(acme.ID).
+		SetUserID(acme.OwnerID).
+		SetRole(organizationmember.RoleOwner).
+		SaveX(ctx)
+	member := client.User.Create().
+		SetEmail("update-member@example.com").
+		SetPasswordHash("hashed_password").
+		SetName("Member").
+		SaveX(ctx)
+	client.OrganizationMember.Create().
+		SetOrganizationID(acme.ID).
+		SetUserID(member.ID).
+		SetRole(organizationmember.RoleMember).
+		SaveX(ctx)
+	admin := client.User.Create().
+		SetEmail("update-admin@example.com").
+		SetPasswordHash("hashed_password").
+		SetName("Admin").
+		SetRole(user.RoleAdmin).
+		SaveX(ctx)
+
+	owned := createScopeTestLead(t, client, "Acme Gym", "gym", "US", &acme.ID)
+	global := createScopeTestLead(t, client, "Global Gym", "gym", "US", nil)
+
+	str := func(s string) *string { return &s }
+	num := func(f float64) *float64 { return &f }
+
+	t.Run("permissions", func(t *testing.T) {
+		_, err := service.UpdateLead(ctx, member.ID, owned.ID, UpdateLeadRequest{Name: str("Nope")})
+		assert.ErrorIs(t, err, ErrLeadEditForbidden)
+
+		// Only admins edit leads in the global pool
+		_, err = service.UpdateLead(ctx, acme.OwnerID, global.ID, UpdateLeadRequest{Name: str("Nope")})
+		assert.ErrorIs(t, err, ErrLeadEditForbidden)
+
+		_, err = service.UpdateLead(ctx, admin.ID, 999999, UpdateLeadRequest{Name: str("Nope")})
+		assert.ErrorIs(t, err, ErrLeadNotFound)
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		for name, req := range map[string]UpdateLeadRequest{
+			"empty name":       {Name: str("  ")},
+			"invalid phone":    {Phone: str("12")},
+			"relative website": {Website: str("acme.example.com")},
+			"ftp website":      {Website: str("ftp://acme.example.com")},
+			"latitude range":   {Latitude: num(91), Longitude: num(0)},
+			"longitude range":  {Latitude: num(0), Longitude: num(-181)},
+			"lone latitude":    {Latitude: num(30)},
+		} {
+			_, err := service.UpdateLead(ctx, admin.ID, owned.ID, req)
+			assert.ErrorIs(t, err, ErrInvalidLeadUpdate, name)
+		}
+	})
+
+	t.Run("partial update", func(t *testing.T) {
+		resp, err := service.UpdateLead(ctx, acme.OwnerID, owned.ID, UpdateLeadRequest{
+			Phone:     str("(512) 555-0143"),
+			Website:   str("https://acme.example.com"),
+			Latitude:  num(30.2672),
+			Longitude: num(-97.7431),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Acme Gym", resp.Name, "fields not provided are kept")
+		assert.Equal(t, "+15125550143", resp.Phone)
+		assert.Equal(t, "https://acme.example.com", resp.Website)
+		assert.Equal(t, 30.2672, resp.Latitude)
+		assert.Equal(t, CompletenessPhone+CompletenessWebsite+CompletenessCoordinates, resp.CompletenessScore)
+
+		stored := client.Lead.GetX(ctx, owned.ID)
+		assert.Equal(t, RecalculateQuality(stored), stored.QualityScore)
+
+		change := client.LeadChange.Query().OnlyX(ctx)
+		assert.Equal(t, leadchange.SourceManual, change.Source)
+		require.NotNil(t, change.UserID)
+		assert.Equal(t, acme.OwnerID, *change.UserID)
+		assert.Contains(t, change.Changes, "phone")
+		assert.Contains(t, change.Changes, "website")
+		assert.NotContains(t, change.Changes, "name")
+
+		// Clearing an optional field
+		resp, err = service.UpdateLead(ctx, admin.ID, owned.ID, UpdateLeadRequest{Website: str("")})
+		require.NoError(t, err)
+		assert.Empty(t, resp.Website)
+
+		// A no-op update records nothing
+		_, err = service.UpdateLead(ctx, admin.ID, owned.ID, UpdateLeadRequest{Phone: str("+15125550143")})
+		require.NoError(t, err)
+		assert.Equal(t, 2, client.LeadChange.Query().CountX(ctx))
+	})
+
+	t.Run("optimistic concurrency", func(t *testing.T) {
+		current := client.Lead.GetX(ctx, global.ID)
+
+		stale := current.UpdatedAt.Add(-time.Hour)
+		_, err := service.UpdateLead(ctx, admin.ID, global.ID, UpdateLeadRequest{Name: str("Renamed"), UpdatedAt: &stale})
+		assert.ErrorIs(t, err, ErrLeadConflict)
+
+		// As clients see it, with second precision
+		seen := current.UpdatedAt.Truncate(time.Second)
+		resp, err := service.UpdateLead(ctx, admin.ID, global.ID, UpdateLeadRequest{Name: str("Renamed"), UpdatedAt: &seen})
+		require.NoError(t, err)
+		assert.Equal(t, "Renamed", resp.Name)
+	})
+}
@@ -2,119 +2,94 @@ package leads
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
+	"github.com/jordanlanch/industrydb/ent"
 	"github.com/jordanlanch/industrydb/ent/organization"
 	"github.com/jordanlanch/industrydb/ent/user"
 	"github.com/jordanlanch/industrydb/pkg/models"
 )
 
-// CheckAndIncrementUsage checks if user can access more leads and increments usage
-// Uses a transaction with FOR UPDATE locking to prevent race conditions
-func (s *Service) CheckAndIncrementUsage(ctx context.Context, userID int, count int) error {
-	// Start transaction for atomic operations
-	tx, err := s.db.Tx(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to start transaction: %w", err)
-	}
-	defer func() {
-		if err != nil {
-			_ = tx.Rollback()
-		}
-	}()
+// ErrUsageLimitExceeded is returned when an increment would take usage past
+// the monthly limit
+var ErrUsageLimitExceeded = errors.New("usage limit exceeded")
 
-	// Get user within transaction
-	// The transaction itself provides serializable isolation
-	u, err := tx.User.Get(ctx, userID)
+// CheckAndIncrementUsage checks if user can access more leads and increments usage.
+// The limit check and the increment are a single conditional UPDATE
+// (usage_count = usage_count + count WHERE usage_count + count <= usage_limit),
+// so concurrent requests can't read the same count and overshoot the limit.
+func (s *Service) CheckAndIncrementUsage(ctx context.Context, userID int, count int) error {
+	u, err := s.db.User.Get(ctx, userID)
 	if err != nil {
 		return fmt.Errorf("failed to get user: %w", err)
 	}
-
-	// Check if usage needs to be reset (monthly)
-	if time.Since(u.LastResetAt) > 30*24*time.Hour {
-		// Reset usage
-		u, err = tx.User.UpdateOneID(userID).
-			SetUsageCount(0).
-			SetLastResetAt(time.Now()).
-			Save(ctx)
-		if err != nil {
-			return fmt.Errorf("failed to reset usage: %w", err)
-		}
+	// Start a new usage cycle if it rolled over since the last reset
+	if _, err := s.resetUserUsageIfDue(ctx, u, time.Now()); err != nil {
+		return err
 	}
 
-	// Check if user has enough remaining usage
-	if u.UsageCount+count > u.UsageLimit {
-		return fmt.Errorf("usage limit exceeded: %d/%d used", u.UsageCount, u.UsageLimit)
-	}
-
-	// Increment usage
-	_, err = tx.User.UpdateOneID(userID).
-		SetUsageCount(u.UsageCount + count).
+	_, err = s.db.User.UpdateOneID(userID).
+		Where(withinUsageLimit(user.FieldUsageCount, user.FieldUsageLimit, count)).
+		AddUsageCount(count).
 		Save(ctx)
-	if err != nil {
+	if err == nil {
+		return nil
+	}
+	if !ent.IsNotFound(err) {
 		return fmt.Errorf("failed to increment usage: %w", err)
 	}
 
-	// Commit transaction
-	if err = tx.Commit(); err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
+	// Either the user was deleted meanwhile or the increment didn't fit
+	u, err = s.db.User.Get(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
 	}
-
-	return nil
+	return fmt.Errorf("%w: %d/%d used", ErrUsageLimitExceeded, u.UsageCount, u.UsageLimit)
 }
 
-// CheckAndIncrementOrganizationUsage checks if organization can access more leads and increments usage
-// Uses a transaction with FOR UPDATE locking to prevent race conditions
+// CheckAndIncrementOrganizationUsage checks if organization can access more leads and increments usage.
+// Like CheckAndIncrementUsage, the limit is checked by the UPDATE itself.
 func (s *Service) CheckAndIncrementOrganizationUsage(ctx context.Context, orgID int, count int) error {
-	// Start transaction for atomic operations
-	tx, err := s.db.Tx(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to start transaction: %w", err)
-	}
-	defer func() {
-		if err != nil {
-			_ = tx.Rollback()
-		}
-	}()
-
-	// Get organization within transaction
-	org, err := tx.Organization.Get(ctx, orgID)
+	org, err := s.db.Organization.Get(ctx, orgID)
 	if err != nil {
 		return fmt.Errorf("failed to get organization: %w", err)
 	}
-
-	// Check if usage needs to be reset (monthly)
-	if time.Since(org.LastResetAt) > 30*24*time.Hour {
-		// Reset usage
-		org, err = tx.Organization.UpdateOneID(orgID).
-			SetUsageCount(0).
-			SetLastResetAt(time.Now()).
-			Save(ctx)
-		if err != nil {
-			return fmt.Errorf("failed to reset organization usage: %w", err)
-		}
+	// Start a new usage cycle if it rolled over since the last reset
+	if _, err := s.resetOrganizationUsageIfDue(ctx, org, time.Now()); err != nil {
+		return err
 	}
 
-	// Check if organization has enough remaining usage
-	if org.UsageCount+count > org.UsageLimit {
-		return fmt.Errorf("organization usage limit exceeded: %d/%d used", org.UsageCount, org.UsageLimit)
-	}
-
-	// Increment usage
-	_, err = tx.Organization.UpdateOneID(orgID).
-		SetUsageCount(org.UsageCount + count).
+	_, err = s.db.Organization.UpdateOneID(orgID).
+		Where(withinUsageLimit(organization.FieldUsageCount, organization.FieldUsageLimit, count)).
+		AddUsageCount(count).
 		Save(ctx)
-	if err != nil {
+	if err == nil {
+		return nil
+	}
+	if !ent.IsNotFound(err) {
 		return fmt.Errorf("failed to increment organization usage: %w", err)
 	}
 
-	// Commit transaction
-	if err = tx.Commit(); err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
+	// Either the organization was deleted meanwhile or the increment didn't fit
+	org, err = s.db.Organization.Get(ctx, orgID)
+	if err != nil {
+		return fmt.Errorf("failed to get organization: %w", err)
 	}
+	return fmt.Errorf("organization %w: %d/%d used", ErrUsageLimitExceeded, org.UsageCount, org.UsageLimit)
+}
 
-	return nil
+// withinUsageLimit matches rows whose usage can grow by count without
+// exceeding their limit, evaluated by the database at update time
+func withinUsageLimit(countColumn, limitColumn string, count int) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.WriteString(s.C(countColumn)).WriteString(" + ").Arg(count).
+				WriteString(" <= ").WriteString(s.C(limitColumn))
+		}))
+	}
 }
 
 // GetUsageInfo returns user usage statistics
@@ -124,16 +99,27 @@ func (s *Service) GetUsageInfo(ctx context.Context, userID int) (*models.UsageIn
 		return nil, fmt.Errorf("failed to get user: %w", err)
 	}
 
-	// Calculate reset date (30 days from last reset)
-	resetAt := u.LastResetAt.Add(30 * 24 * time.Hour)
+	// Usage resets at the end of the billing period, or 30 days after the
+	// last reset without one
+	now := time.Now()
+	period, err := s.userBillingPeriod(ctx, u.ID, now)
+	if err != nil {
+		return nil, err
+	}
+	start, resetAt := usageCycle(u.LastResetAt, period, now)
+	usageCount := u.UsageCount
+	if u.LastResetAt.Before(start) {
+		// The cycle rolled over; the next charge or the reset job resets it
+		usageCount = 0
+	}
 
-	remaining := u.UsageLimit - u.UsageCount
+	remaining := u.UsageLimit - usageCount
 	if remaining < 0 {
 		remaining = 0
 	}
 
 	return &models.UsageInfo{
-		UsageCount: u.UsageCount,
+		UsageCount: usageCount,
 		UsageLimit: u.UsageLimit,
 		Remaining:  remaining,
 		ResetAt:    resetAt.Format(time.RFC3339),
@@ -148,16 +134,27 @@ func (s *Service) GetOrganizationUsageInfo(ctx context.Context, orgID int) (*mod
 		return nil, fmt.Errorf("failed to get organization: %w", err)
 	}
 
-	// Calculate reset date (30 days from last reset)
-	resetAt := org.LastResetAt.Add(30 * 24 * time.Hour)
+	// Usage resets at the end of the billing period, or 30 days after the
+	// last reset without one
+	now := time.Now()
+	period, err := s.organizationBillingPeriod(ctx, org.ID, now)
+	if err != nil {
+		return nil, err
+	}
+	start, resetAt := usageCycle(org.LastResetAt, period, now)
+	usageCount := org.UsageCount
+	if org.LastResetAt.Before(start) {
+		// The cycle rolled over; the next charge or the reset job resets it
+		usageCount = 0
+	}
 
-	remaining := org.UsageLimit - org.UsageCount
+	remaining := org.UsageLimit - usageCount
 	if remaining < 0 {
 		remaining = 0
 	}
 
 	return &models.UsageInfo{
-		UsageCount: org.UsageCount,
+		UsageCount: usageCount,
 		UsageLimit: org.UsageLimit,
 		Remaining:  remaining,
 		ResetAt:    resetAt.Format(time.RFC3339),
@@ -165,19 +162,211 @@ func (s *Service) GetOrganizationUsageInfo(ctx context.Context, orgID int) (*mod
 	}, nil
 }
 
-// GetUsageLimitForTier returns the usage limit for a subscription tier
+// TierUsageLimits maps a subscription tier to its monthly usage limit.
+type TierUsageLimits map[string]int
+
+// DefaultTierUsageLimits returns the default monthly usage limits.
+func DefaultTierUsageLimits() TierUsageLimits {
+	return TierUsageLimits{
+		"free":     50,
+		"starter":  500,
+		"pro":      2000,
+		"business": 10000,
+	}
+}
+
+// tierUsageLimits holds the limits used by GetUsageLimitForTier.
+var tierUsageLimits = DefaultTierUsageLimits()
+
+// SetTierUsageLimits replaces the limits used by GetUsageLimitForTier.
+// Tiers missing from limits (or with a non-positive limit) keep their
+// default. It is meant to be called once at startup from configuration.
+func SetTierUsageLimits(limits TierUsageLimits) {
+	merged := DefaultTierUsageLimits()
+	for tier, limit := range limits {
+		if limit > 0 {
+			merged[tier] = limit
+		}
+	}
+	tierUsageLimits = merged
+}
+
+// GetUsageLimitForTier returns the usage limit for a subscription tier.
+// Unknown tiers get the free tier limit.
 func GetUsageLimitForTier(tier string) int {
-	switch tier {
-	case "free":
-		return 50
-	case "starter":
-		return 500
-	case "pro":
-		return 2000
-	case "business":
-		return 10000
-	default:
-		return 50
+	if limit, ok := tierUsageLimits[tier]; ok {
+		return limit
+	}
+	return tierUsageLimits["free"]
+}
+
+// TierExportRowCaps maps a subscription tier to the maximum number of rows
+// in a single export. A cap of 0 means unlimited.
+type TierExportRowCaps map[string]int
+
+// DefaultTierExportRowCaps returns the default per-export row caps.
+func DefaultTierExportRowCaps() TierExportRowCaps {
+	return TierExportRowCaps{
+		"free":     100,
+		"starter":  1000,
+		"pro":      10000,
+		"business": 0,
+	}
+}
+
+// tierExportRowCaps holds the caps used by GetExportRowCapForTier.
+var tierExportRowCaps = DefaultTierExportRowCaps()
+
+// SetTierExportRowCaps replaces the caps used by GetExportRowCapForTier.
+// Tiers missing from caps (or with a negative cap) keep their default; 0
+// makes a tier unlimited. It is meant to be called once at startup from
+// configuration.
+func SetTierExportRowCaps(caps TierExportRowCaps) {
+	merged := DefaultTierExportRowCaps()
+	for tier, limit := range caps {
+		if limit >= 0 {
+			merged[tier] = limit
+		}
+	}
+	tierExportRowCaps = merged
+}
+
+// GetExportRowCapForTier returns the per-export row cap for a subscription
+// tier, 0 meaning unlimited. Unknown tiers get the free tier cap.
+func GetExportRowCapForTier(tier string) int {
+	if limit, ok := tierExportRowCaps[tier]; ok {
+		return limit
+	}
+	return tierExportRowCaps["free"]
+}
+
+// TierExportConcurrency maps a subscription tier to the number of exports a
+// user may have processing at once. Further exports wait in the queue.
+type TierExportConcurrency map[string]int
+
+// DefaultTierExportConcurrency returns the default concurrent export limits.
+func DefaultTierExportConcurrency() TierExportConcurrency {
+	return TierExportConcurrency{
+		"free":     1,
+		"starter":  1,
+		"pro":      2,
+		"business": 4,
+	}
+}
+
+// tierExportConcurrency holds the limits used by GetExportConcurrencyForTier.
+var tierExportConcurrency = DefaultTierExportConcurrency()
+
+// SetTierExportConcurrency replaces the limits used by
+// GetExportConcurrencyForTier. Tiers missing from limits (or with a
+// non-positive limit) keep their default. It is meant to be called once at
+// startup from configuration.
+func SetTierExportConcurrency(limits TierExportConcurrency) {
+	merged := DefaultTierExportConcurrency()
+	for tier, limit := range limits {
+		if limit > 0 {
+			merged[tier] = limit
+		}
+	}
+	tierExportConcurrency = merged
+}
+
+// GetExportConcurrencyForTier returns the concurrent export limit for a
+// subscription tier. Unknown tiers get the free tier limit.
+func GetExportConcurrencyForTier(tier string) int {
+	if limit, ok := tierExportConcurrency[tier]; ok {
+		return limit
+	}
+	return tierExportConcurrency["free"]
+}
+
+// EnrichmentBudget bounds the enrichment provider calls and cost of a user
+// or organization per month. A limit of 0 means unlimited.
+type EnrichmentBudget struct {
+	Calls     int `json:"calls"`
+	CostCents int `json:"cost_cents"`
+}
+
+// TierEnrichmentBudgets maps a subscription tier to its default monthly
+// enrichment budget.
+type TierEnrichmentBudgets map[string]EnrichmentBudget
+
+// DefaultTierEnrichmentBudgets returns the default monthly enrichment
+// budgets. Costs are unlimited by default: they depend on the provider.
+func DefaultTierEnrichmentBudgets() TierEnrichmentBudgets {
+	return TierEnrichmentBudgets{
+		"free":     {Calls: 25},
+		"starter":  {Calls: 250},
+		"pro":      {Calls: 1000},
+		"business": {Calls: 5000},
+	}
+}
+
+// tierEnrichmentBudgets holds the budgets used by
+// GetEnrichmentBudgetForTier.
+var tierEnrichmentBudgets = DefaultTierEnrichmentBudgets()
+
+// SetTierEnrichmentBudgets replaces the budgets used by
+// GetEnrichmentBudgetForTier. Negative limits keep their default; 0 makes a
+// limit unlimited. It is meant to be called once at startup from
+// configuration.
+func SetTierEnrichmentBudgets(budgets TierEnrichmentBudgets) {
+	merged := DefaultTierEnrichmentBudgets()
+	for tier, budget := range budgets {
+		current := merged[tier]
+		if budget.Calls >= 0 {
+			current.Calls = budget.Calls
+		}
+		if budget.CostCents >= 0 {
+			current.CostCents = budget.CostCents
+		}
+		merged[tier] = current
+	}
+	tierEnrichmentBudgets = merged
+}
+
+// GetEnrichmentBudgetForTier returns the default monthly enrichment budget
+// of a subscription tier. Unknown tiers get the free tier budget.
+func GetEnrichmentBudgetForTier(tier string) EnrichmentBudget {
+	if budget, ok := tierEnrichmentBudgets[tier]; ok {
+		return budget
+	}
+	return tierEnrichmentBudgets["free"]
+}
+
+// GetExportTier returns the subscription tier that governs an export or
+// search: the organization's tier in an organization context, the user's
+// otherwise.
+func (s *Service) GetExportTier(ctx context.Context, userID int, organizationID *int) (string, error) {
+	if organizationID != nil {
+		org, err := s.db.Organization.Query().Where(organization.IDEQ(*organizationID)).Only(ctx)
+		if err != nil {
+			return "", fmt.Errorf("failed to get organization: %w", err)
+		}
+		return string(org.SubscriptionTier), nil
+	}
+
+	u, err := s.db.User.Query().Where(user.IDEQ(userID)).Only(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get user: %w", err)
+	}
+	return string(u.SubscriptionTier), nil
+}
+
+// ApplyExportRowCap annotates a search preview with the tier's export row
+// cap and warns when the estimated count would not fit in one export.
+func ApplyExportRowCap(preview *models.LeadPreviewResponse, tier string) {
+	limit := GetExportRowCapForTier(tier)
+	if limit == 0 {
+		return
+	}
+
+	preview.ExportRowCap = limit
+	if preview.EstimatedCount > limit {
+		preview.Warning = fmt.Sprintf(
+			"This search matches %d leads but your %s plan exports at most %d rows per export. Upgrade to export more.",
+			preview.EstimatedCount, tier, limit,
+		)
 	}
 }
 
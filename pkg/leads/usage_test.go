@@ -1,7 +1,17 @@
 package leads
 
 import (
+	"context"
+	"path/filepath"
+	"sync"
 	"testing"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGetUsageLimitForTier(t *testing.T) {
@@ -26,6 +36,77 @@ func TestGetUsageLimitForTier(t *testing.T) {
 	}
 }
 
+func TestSetTierUsageLimits(t *testing.T) {
+	defer SetTierUsageLimits(nil)
+
+	SetTierUsageLimits(TierUsageLimits{"free": 100, "pro": 5000, "business": 0})
+
+	if got := GetUsageLimitForTier("free"); got != 100 {
+		t.Errorf("free limit = %d, want 100", got)
+	}
+	if got := GetUsageLimitForTier("pro"); got != 5000 {
+		t.Errorf("pro limit = %d, want 5000", got)
+	}
+	// Unset and non-positive values keep the defaults
+	if got := GetUsageLimitForTier("starter"); got != 500 {
+		t.Errorf("starter limit = %d, want 500", got)
+	}
+	if got := GetUsageLimitForTier("business"); got != 10000 {
+		t.Errorf("business limit = %d, want 10000", got)
+	}
+	// Unknown tiers fall back to the configured free limit
+	if got := GetUsageLimitForTier("unknown"); got != 100 {
+		t.Errorf("unknown limit = %d, want 100", got)
+	}
+}
+
+func TestSetTierExportRowCaps(t *testing.T) {
+	defer SetTierExportRowCaps(nil)
+
+	SetTierExportRowCaps(TierExportRowCaps{"free": 25, "pro": 0, "starter": -1})
+
+	if got := GetExportRowCapForTier("free"); got != 25 {
+		t.Errorf("free cap = %d, want 25", got)
+	}
+	// Zero makes a tier unlimited
+	if got := GetExportRowCapForTier("pro"); got != 0 {
+		t.Errorf("pro cap = %d, want 0", got)
+	}
+	// Unset and negative values keep the defaults
+	if got := GetExportRowCapForTier("starter"); got != 1000 {
+		t.Errorf("starter cap = %d, want 1000", got)
+	}
+	if got := GetExportRowCapForTier("business"); got != 0 {
+		t.Errorf("business cap = %d, want 0", got)
+	}
+	if got := GetExportRowCapForTier("unknown"); got != 25 {
+		t.Errorf("unknown cap = %d, want 25", got)
+	}
+}
+
+func TestApplyExportRowCap(t *testing.T) {
+	preview := &models.LeadPreviewResponse{EstimatedCount: 150}
+	ApplyExportRowCap(preview, "free")
+	if preview.ExportRowCap != 100 {
+		t.Errorf("free export row cap = %d, want 100", preview.ExportRowCap)
+	}
+	if preview.Warning == "" {
+		t.Error("expected a warning when the estimate exceeds the cap")
+	}
+
+	preview = &models.LeadPreviewResponse{EstimatedCount: 80}
+	ApplyExportRowCap(preview, "free")
+	if preview.Warning != "" {
+		t.Errorf("unexpected warning %q for an estimate under the cap", preview.Warning)
+	}
+
+	preview = &models.LeadPreviewResponse{EstimatedCount: 50000}
+	ApplyExportRowCap(preview, "business")
+	if preview.ExportRowCap != 0 || preview.Warning != "" {
+		t.Errorf("business preview = %+v, want no cap and no warning", preview)
+	}
+}
+
 func TestCalculateQualityScore(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -129,3 +210,129 @@ func calculateQualityScore(phone, email, website, address string, lat, lon *floa
 
 	return score
 }
+
+// setupUsageTest opens a file database, since concurrent writers on the
+// shared in-memory cache fail with table locks instead of waiting
+func setupUsageTest(t *testing.T) (*Service, *ent.Client) {
+	dsn := "file:" + filepath.Join(t.TempDir(), "usage.db") + "?_fk=1&_busy_timeout=10000&_journal_mode=WAL"
+	client := enttest.Open(t, "sqlite3", dsn)
+	t.Cleanup(func() { client.Close() })
+	return NewService(client, nil), client
+}
+
+// incrementConcurrently runs increment from n goroutines at once and returns
+// how many succeeded, failing on errors other than the usage limit
+func incrementConcurrently(t *testing.T, n int, increment func() error) int {
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		succeeded int
+		start     = make(chan struct{})
+	)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			err := increment()
+			mu.Lock()
+			defer mu.Unlock()
+			if err == nil {
+				succeeded++
+				return
+			}
+			assert.ErrorIs(t, err, ErrUsageLimitExceeded)
+		}()
+	}
+	close(start)
+	wg.Wait()
+	return succeeded
+}
+
+func TestCheckAndIncrementUsage_Concurrent(t *testing.T) {
+	service, client := setupUsageTest(t)
+	ctx := context.Background()
+
+	u := client.User.Create().
+		SetEmail("burst@example.com").
+		SetPasswordHash("hash").
+		SetName("Burst").
+		SetUsageLimit(10).
+		SaveX(ctx)
+
+	succeeded := incrementConcurrently(t, 30, func() error {
+		return service.CheckAndIncrementUsage(ctx, u.ID, 1)
+	})
+
+	assert.Equal(t, 10, succeeded)
+	assert.Equal(t, 10, client.User.GetX(ctx, u.ID).UsageCount)
+
+	err := service.CheckAndIncrementUsage(ctx, u.ID, 1)
+	require.ErrorIs(t, err, ErrUsageLimitExceeded)
+	assert.Equal(t, "usage limit exceeded: 10/10 used", err.Error())
+}
+
+func TestCheckAndIncrementUsage_ConcurrentBatches(t *testing.T) {
+	service, client := setupUsageTest(t)
+	ctx := context.Background()
+
+	u := client.User.Create().
+		SetEmail("batches@example.com").
+		SetPasswordHash("hash").
+		SetName("Batches").
+		SetUsageLimit(10).
+		SaveX(ctx)
+
+	// Batches of 3 fit three times; a partial batch is never charged
+	succeeded := incrementConcurrently(t, 20, func() error {
+		return service.CheckAndIncrementUsage(ctx, u.ID, 3)
+	})
+
+	assert.Equal(t, 3, succeeded)
+	assert.Equal(t, 9, client.User.GetX(ctx, u.ID).UsageCount)
+}
+
+func TestCheckAndIncrementOrganizationUsage_Concurrent(t *testing.T) {
+	service, client := setupUsageTest(t)
+	ctx := context.Background()
+
+	owner := client.User.Create().
+		SetEmail("owner@example.com").
+		SetPasswordHash("hash").
+		SetName("Owner").
+		SaveX(ctx)
+	org := client.Organization.Create().
+		SetName("Burst Org").
+		SetSlug("burst-org").
+		SetOwnerID(owner.ID).
+		SetUsageLimit(15).
+		SaveX(ctx)
+
+	succeeded := incrementConcurrently(t, 40, func() error {
+		return service.CheckAndIncrementOrganizationUsage(ctx, org.ID, 1)
+	})
+
+	assert.Equal(t, 15, succeeded)
+	assert.Equal(t, 15, client.Organization.GetX(ctx, org.ID).UsageCount)
+}
+
+func TestCheckAndIncrementUsage_MonthlyReset(t *testing.T) {
+	service, client := setupUsageTest(t)
+	ctx := context.Background()
+
+	u := client.User.Create().
+		SetEmail("reset@example.com").
+		SetPasswordHash("hash").
+		SetName("Reset").
+		SetUsageLimit(10).
+		SetUsageCount(10).
+		SetLastResetAt(time.Now().Add(-31 * 24 * time.Hour)).
+		SaveX(ctx)
+
+	require.NoError(t, service.CheckAndIncrementUsage(ctx, u.ID, 4))
+	assert.Equal(t, 4, client.User.GetX(ctx, u.ID).UsageCount)
+
+	err := service.CheckAndIncrementUsage(ctx, 99999, 1)
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrUsageLimitExceeded)
+}
@@ -0,0 +1,202 @@
+package leads
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/organization"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+	"github.com/jordanlanch/industrydb/ent/subscription"
+	"github.com/jordanlanch/industrydb/ent/user"
+)
+
+// usageResetPeriod is the usage cycle of accounts without a Stripe billing
+// period, counted from their last reset
+const usageResetPeriod = 30 * 24 * time.Hour
+
+// billingPeriodStatuses are the subscription statuses whose Stripe billing
+// period drives the usage cycle
+var billingPeriodStatuses = []subscription.Status{
+	subscription.StatusActive,
+	subscription.StatusTrialing,
+	subscription.StatusPastDue,
+}
+
+// UsageResetResult reports the outcome of a usage reset run
+type UsageResetResult struct {
+	Users         int `json:"users"`
+	Organizations int `json:"organizations"`
+}
+
+// usageCycle returns when the current usage cycle started and when the
+// next one starts. With a Stripe billing period covering now the cycle is
+// that period. Otherwise cycles are usageResetPeriod long, anchored at
+// lastResetAt so they don't drift with the time of the reset.
+func usageCycle(lastResetAt time.Time, period *ent.Subscription, now time.Time) (start, next time.Time) {
+	if period != nil {
+		return period.CurrentPeriodStart, period.CurrentPeriodEnd
+	}
+	start = lastResetAt
+	if elapsed := now.Sub(lastResetAt); elapsed >= usageResetPeriod {
+		start = lastResetAt.Add(elapsed / usageResetPeriod * usageResetPeriod)
+	}
+	return start, start.Add(usageResetPeriod)
+}
+
+// billingPeriod returns the subscription whose current Stripe billing period
+// covers now, or nil when there is none
+func (s *Service) billingPeriod(ctx context.Context, owner predicate.Subscription, now time.Time) (*ent.Subscription, error) {
+	sub, err := s.db.Subscription.Query().
+		Where(
+			owner,
+			subscription.StatusIn(billingPeriodStatuses...),
+			subscription.CurrentPeriodStartLTE(now),
+			subscription.CurrentPeriodEndGT(now),
+		).
+		Order(ent.Desc(subscription.FieldCurrentPeriodStart)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get billing period: %w", err)
+	}
+	return sub, nil
+}
+
+// userBillingPeriod returns the billing period of the user's personal
+// subscription covering now, if any
+func (s *Service) userBillingPeriod(ctx context.Context, userID int, now time.Time) (*ent.Subscription, error) {
+	return s.billingPeriod(ctx, subscription.And(
+		subscription.UserIDEQ(userID),
+		subscription.OrganizationIDIsNil(),
+	), now)
+}
+
+// organizationBillingPeriod returns the billing period of the organization's
+// subscription covering now, if any
+func (s *Service) organizationBillingPeriod(ctx context.Context, orgID int, now time.Time) (*ent.Subscription, error) {
+	return s.billingPeriod(ctx, subscription.OrganizationIDEQ(orgID), now)
+}
+
+// resetUserUsageIfDue sets the user's usage count to zero once their usage
+// cycle rolls over. The reset moves last_reset_at to the cycle start and
+// only applies while last_reset_at is before it, so it happens once per
+// cycle however many callers race for it. It reports whether it reset.
+func (s *Service) resetUserUsageIfDue(ctx context.Context, u *ent.User, now time.Time) (bool, error) {
+	period, err := s.userBillingPeriod(ctx, u.ID, now)
+	if err != nil {
+		return false, err
+	}
+	start, _ := usageCycle(u.LastResetAt, period, now)
+	if !u.LastResetAt.Before(start) {
+		return false, nil
+	}
+
+	reset, err := s.db.User.Update().
+		Where(user.IDEQ(u.ID), user.LastResetAtLT(start)).
+		SetUsageCount(0).
+		SetLastResetAt(start).
+		Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to reset usage: %w", err)
+	}
+	return reset > 0, nil
+}
+
+// resetOrganizationUsageIfDue is resetUserUsageIfDue for an organization
+func (s *Service) resetOrganizationUsageIfDue(ctx context.Context, org *ent.Organization, now time.Time) (bool, error) {
+	period, err := s.organizationBillingPeriod(ctx, org.ID, now)
+	if err != nil {
+		return false, err
+	}
+	start, _ := usageCycle(org.LastResetAt, period, now)
+	if !org.LastResetAt.Before(start) {
+		return false, nil
+	}
+
+	reset, err := s.db.Organization.Update().
+		Where(organization.IDEQ(org.ID), organization.LastResetAtLT(start)).
+		SetUsageCount(0).
+		SetLastResetAt(start).
+		Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to reset organization usage: %w", err)
+	}
+	return reset > 0, nil
+}
+
+// ResetDueUsage resets the usage of every user and organization whose usage
+// cycle rolled over: paid accounts at the start of their Stripe billing
+// period, others every 30 days from their last reset. Resets are
+// idempotent, so the job can run often and alongside the reset
+// CheckAndIncrementUsage does on access.
+func (s *Service) ResetDueUsage(ctx context.Context, now time.Time) (*UsageResetResult, error) {
+	result := &UsageResetResult{}
+
+	// Accounts past a 30-day cycle, or in a billing period that started
+	// after their last reset
+	periods, err := s.db.Subscription.Query().
+		Where(
+			subscription.StatusIn(billingPeriodStatuses...),
+			subscription.CurrentPeriodStartLTE(now),
+			subscription.CurrentPeriodEndGT(now),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get billing periods: %w", err)
+	}
+	userPreds := []predicate.User{user.LastResetAtLTE(now.Add(-usageResetPeriod))}
+	orgPreds := []predicate.Organization{organization.LastResetAtLTE(now.Add(-usageResetPeriod))}
+	for _, period := range periods {
+		if period.OrganizationID != nil {
+			orgPreds = append(orgPreds, organization.And(
+				organization.IDEQ(*period.OrganizationID),
+				organization.LastResetAtLT(period.CurrentPeriodStart),
+			))
+		} else {
+			userPreds = append(userPreds, user.And(
+				user.IDEQ(period.UserID),
+				user.LastResetAtLT(period.CurrentPeriodStart),
+			))
+		}
+	}
+
+	users, err := s.db.User.Query().
+		Where(user.Or(userPreds...)).
+		Select(user.FieldLastResetAt).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users due a usage reset: %w", err)
+	}
+	for _, u := range users {
+		reset, err := s.resetUserUsageIfDue(ctx, u, now)
+		if err != nil {
+			return result, err
+		}
+		if reset {
+			result.Users++
+		}
+	}
+
+	orgs, err := s.db.Organization.Query().
+		Where(organization.Or(orgPreds...)).
+		Select(organization.FieldLastResetAt).
+		All(ctx)
+	if err != nil {
+		return result, fmt.Errorf("failed to get organizations due a usage reset: %w", err)
+	}
+	for _, org := range orgs {
+		reset, err := s.resetOrganizationUsageIfDue(ctx, org, now)
+		if err != nil {
+			return result, err
+		}
+		if reset {
+			result.Organizations++
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,145 @@
+package leads
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/subscription"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createUsageResetUser(t *testing.T, client *ent.Client, email string, usage int, lastResetAt time.Time) *ent.User {
+	return client.User.Create().
+		SetEmail(email).
+		SetPasswordHash("hash").
+		SetName("Reset").
+		SetUsageLimit(100).
+		SetUsageCount(usage).
+		SetLastResetAt(lastResetAt).
+		SaveX(context.Background())
+}
+
+func TestResetDueUsage(t *testing.T) {
+	service, client := setupUsageTest(t)
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Second)
+
+	rolledOver := createUsageResetUser(t, client, "rolled@example.com", 40, now.Add(-31*24*time.Hour))
+	skippedCycles := createUsageResetUser(t, client, "skipped@example.com", 40, now.Add(-65*24*time.Hour))
+	current := createUsageResetUser(t, client, "current@example.com", 40, now.Add(-10*24*time.Hour))
+
+	// Paid user whose billing period started after their last reset
+	renewed := createUsageResetUser(t, client, "renewed@example.com", 40, now.Add(-20*24*time.Hour))
+	periodStart := now.Add(-2 * 24 * time.Hour)
+	client.Subscription.Create().
+		SetUserID(renewed.ID).
+		SetTier(subscription.TierPro).
+		SetStripeSubscriptionID("sub_renewed").
+		SetCurrentPeriodStart(periodStart).
+		SetCurrentPeriodEnd(periodStart.AddDate(0, 1, 0)).
+		SaveX(ctx)
+
+	// Paid user in a 31-day period: 30 days after the reset is still the same cycle
+	longMonth := createUsageResetUser(t, client, "long-month@example.com", 40, now.Add(-30*24*time.Hour-time.Hour))
+	client.Subscription.Create().
+		SetUserID(longMonth.ID).
+		SetTier(subscription.TierPro).
+		SetStripeSubscriptionID("sub_long_month").
+		SetCurrentPeriodStart(longMonth.LastResetAt).
+		SetCurrentPeriodEnd(longMonth.LastResetAt.Add(31 * 24 * time.Hour)).
+		SaveX(ctx)
+
+	// Organization aligned with its own subscription
+	org := client.Organization.Create().
+		SetName("Reset Org").
+		SetSlug("reset-org").
+		SetOwnerID(current.ID).
+		SetUsageCount(70).
+		SetLastResetAt(now.Add(-15 * 24 * time.Hour)).
+		SaveX(ctx)
+	client.Subscription.Create().
+		SetUserID(current.ID).
+		SetOrganizationID(org.ID).
+		SetTier(subscription.TierBusiness).
+		SetStripeSubscriptionID("sub_org").
+		SetCurrentPeriodStart(periodStart).
+		SetCurrentPeriodEnd(periodStart.AddDate(0, 1, 0)).
+		SaveX(ctx)
+
+	result, err := service.ResetDueUsage(ctx, now)
+	require.NoError(t, err)
+	assert.Equal(t, 3, result.Users)
+	assert.Equal(t, 1, result.Organizations)
+
+	t.Run("30-day cycles stay anchored to the last reset", func(t *testing.T) {
+		u := client.User.GetX(ctx, rolledOver.ID)
+		assert.Zero(t, u.UsageCount)
+		assert.True(t, u.LastResetAt.Equal(rolledOver.LastResetAt.Add(30*24*time.Hour)))
+
+		u = client.User.GetX(ctx, skippedCycles.ID)
+		assert.Zero(t, u.UsageCount)
+		assert.True(t, u.LastResetAt.Equal(skippedCycles.LastResetAt.Add(60*24*time.Hour)))
+	})
+
+	t.Run("paid accounts reset at the start of the billing period", func(t *testing.T) {
+		u := client.User.GetX(ctx, renewed.ID)
+		assert.Zero(t, u.UsageCount)
+		assert.True(t, u.LastResetAt.Equal(periodStart))
+
+		o := client.Organization.GetX(ctx, org.ID)
+		assert.Zero(t, o.UsageCount)
+		assert.True(t, o.LastResetAt.Equal(periodStart))
+	})
+
+	t.Run("accounts within their cycle keep their usage", func(t *testing.T) {
+		assert.Equal(t, 40, client.User.GetX(ctx, current.ID).UsageCount)
+		assert.Equal(t, 40, client.User.GetX(ctx, longMonth.ID).UsageCount)
+	})
+
+	t.Run("running again in the same cycle resets nothing", func(t *testing.T) {
+		_, err := client.User.UpdateOneID(rolledOver.ID).SetUsageCount(5).Save(ctx)
+		require.NoError(t, err)
+
+		result, err := service.ResetDueUsage(ctx, now.Add(time.Hour))
+		require.NoError(t, err)
+		assert.Zero(t, result.Users)
+		assert.Zero(t, result.Organizations)
+		assert.Equal(t, 5, client.User.GetX(ctx, rolledOver.ID).UsageCount)
+	})
+}
+
+func TestGetUsageInfo_BillingPeriod(t *testing.T) {
+	service, client := setupUsageTest(t)
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Second)
+
+	free := createUsageResetUser(t, client, "free-info@example.com", 30, now.Add(-10*24*time.Hour))
+	info, err := service.GetUsageInfo(ctx, free.ID)
+	require.NoError(t, err)
+	assert.Equal(t, free.LastResetAt.Add(30*24*time.Hour).Format(time.RFC3339), info.ResetAt)
+	assert.Equal(t, 70, info.Remaining)
+
+	// A new billing period that hasn't been reset yet shows no usage
+	paid := createUsageResetUser(t, client, "paid-info@example.com", 30, now.Add(-10*24*time.Hour))
+	periodEnd := now.Add(28 * 24 * time.Hour)
+	client.Subscription.Create().
+		SetUserID(paid.ID).
+		SetTier(subscription.TierPro).
+		SetStripeSubscriptionID("sub_info").
+		SetCurrentPeriodStart(now.Add(-2 * 24 * time.Hour)).
+		SetCurrentPeriodEnd(periodEnd).
+		SaveX(ctx)
+
+	info, err = service.GetUsageInfo(ctx, paid.ID)
+	require.NoError(t, err)
+	assert.Equal(t, periodEnd.Format(time.RFC3339), info.ResetAt)
+	assert.Zero(t, info.UsageCount)
+	assert.Equal(t, 100, info.Remaining)
+
+	// The next charge starts the new cycle
+	require.NoError(t, service.CheckAndIncrementUsage(ctx, paid.ID, 1))
+	assert.Equal(t, 1, client.User.GetX(ctx, paid.ID).UsageCount)
+}
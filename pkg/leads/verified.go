@@ -0,0 +1,52 @@
+package leads
+
+import "github.com/jordanlanch/industrydb/pkg/models"
+
+// TierVerifiedOnly maps a subscription tier to whether its searches and
+// exports only include verified leads when the verified filter is not set.
+// Every tier may still set the filter explicitly.
+type TierVerifiedOnly map[string]bool
+
+// DefaultTierVerifiedOnly returns the default verified-only setting per
+// tier: free searches only show verified leads, paid tiers see everything.
+func DefaultTierVerifiedOnly() TierVerifiedOnly {
+	return TierVerifiedOnly{
+		"free":     true,
+		"starter":  false,
+		"pro":      false,
+		"business": false,
+	}
+}
+
+// tierVerifiedOnly holds the settings used by GetVerifiedOnlyForTier.
+var tierVerifiedOnly = DefaultTierVerifiedOnly()
+
+// SetTierVerifiedOnly replaces the settings used by GetVerifiedOnlyForTier.
+// Tiers missing from settings keep their default. It is meant to be called
+// once at startup from configuration.
+func SetTierVerifiedOnly(settings TierVerifiedOnly) {
+	merged := DefaultTierVerifiedOnly()
+	for tier, verifiedOnly := range settings {
+		merged[tier] = verifiedOnly
+	}
+	tierVerifiedOnly = merged
+}
+
+// GetVerifiedOnlyForTier reports whether a subscription tier only sees
+// verified leads by default. Unknown tiers get the free tier setting.
+func GetVerifiedOnlyForTier(tier string) bool {
+	if verifiedOnly, ok := tierVerifiedOnly[tier]; ok {
+		return verifiedOnly
+	}
+	return tierVerifiedOnly["free"]
+}
+
+// ApplyVerifiedDefault restricts a search to verified leads when the
+// searcher's tier is verified-only and the request doesn't set the
+// verified filter itself.
+func ApplyVerifiedDefault(req *models.LeadSearchRequest, tier string) {
+	if req.Verified == nil && GetVerifiedOnlyForTier(tier) {
+		verified := true
+		req.Verified = &verified
+	}
+}
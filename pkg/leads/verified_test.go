@@ -0,0 +1,48 @@
+package leads
+
+import (
+	"testing"
+
+	"github.com/jordanlanch/industrydb/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetTierVerifiedOnly(t *testing.T) {
+	defer SetTierVerifiedOnly(nil)
+
+	assert.True(t, GetVerifiedOnlyForTier("free"))
+	assert.False(t, GetVerifiedOnlyForTier("business"))
+
+	SetTierVerifiedOnly(TierVerifiedOnly{"free": false, "starter": true})
+
+	assert.False(t, GetVerifiedOnlyForTier("free"))
+	assert.True(t, GetVerifiedOnlyForTier("starter"))
+	// Unset tiers keep the defaults
+	assert.False(t, GetVerifiedOnlyForTier("pro"))
+	// Unknown tiers fall back to the configured free setting
+	assert.False(t, GetVerifiedOnlyForTier("unknown"))
+}
+
+func TestApplyVerifiedDefault(t *testing.T) {
+	t.Run("verified-only tier defaults to verified leads", func(t *testing.T) {
+		req := models.LeadSearchRequest{}
+		ApplyVerifiedDefault(&req, "free")
+		require.NotNil(t, req.Verified)
+		assert.True(t, *req.Verified)
+	})
+
+	t.Run("other tiers leave the filter unset", func(t *testing.T) {
+		req := models.LeadSearchRequest{}
+		ApplyVerifiedDefault(&req, "business")
+		assert.Nil(t, req.Verified)
+	})
+
+	t.Run("explicit filter wins", func(t *testing.T) {
+		unverified := false
+		req := models.LeadSearchRequest{Verified: &unverified}
+		ApplyVerifiedDefault(&req, "free")
+		require.NotNil(t, req.Verified)
+		assert.False(t, *req.Verified)
+	})
+}
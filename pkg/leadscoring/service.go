@@ -9,6 +9,7 @@ import (
 
 	"github.com/jordanlanch/industrydb/ent"
 	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/predicate"
 )
 
 // Service handles lead scoring operations.
@@ -238,14 +239,16 @@ func (s *Service) UpdateAllScores(ctx context.Context, limit int) (int, error) {
 	return updated, nil
 }
 
-// GetTopScoringLeads retrieves leads sorted by quality score.
-func (s *Service) GetTopScoringLeads(ctx context.Context, limit int) ([]*ent.Lead, error) {
+// GetTopScoringLeads retrieves leads sorted by quality score. Only leads
+// matching scope, such as an organization's visible leads, are considered.
+func (s *Service) GetTopScoringLeads(ctx context.Context, limit int, scope ...predicate.Lead) ([]*ent.Lead, error) {
 	if limit <= 0 || limit > 100 {
 		limit = 50
 	}
 
 	leads, err := s.client.Lead.
 		Query().
+		Where(scope...).
 		Order(ent.Desc(lead.FieldQualityScore)).
 		Limit(limit).
 		All(ctx)
@@ -256,8 +259,9 @@ func (s *Service) GetTopScoringLeads(ctx context.Context, limit int) ([]*ent.Lea
 	return leads, nil
 }
 
-// GetLowScoringLeads retrieves leads with low quality scores (need improvement).
-func (s *Service) GetLowScoringLeads(ctx context.Context, threshold, limit int) ([]*ent.Lead, error) {
+// GetLowScoringLeads retrieves leads with low quality scores (need
+// improvement) among the leads matching scope.
+func (s *Service) GetLowScoringLeads(ctx context.Context, threshold, limit int, scope ...predicate.Lead) ([]*ent.Lead, error) {
 	if threshold <= 0 {
 		threshold = 30 // Default threshold
 	}
@@ -268,7 +272,7 @@ func (s *Service) GetLowScoringLeads(ctx context.Context, threshold, limit int)
 
 	leads, err := s.client.Lead.
 		Query().
-		Where(lead.QualityScoreLT(threshold)).
+		Where(append(scope, lead.QualityScoreLT(threshold))...).
 		Order(ent.Asc(lead.FieldQualityScore)).
 		Limit(limit).
 		All(ctx)
@@ -279,11 +283,13 @@ func (s *Service) GetLowScoringLeads(ctx context.Context, threshold, limit int)
 	return leads, nil
 }
 
-// GetScoreDistribution returns distribution of scores across all leads.
-func (s *Service) GetScoreDistribution(ctx context.Context) (map[string]int, error) {
+// GetScoreDistribution returns distribution of scores across the leads
+// matching scope.
+func (s *Service) GetScoreDistribution(ctx context.Context, scope ...predicate.Lead) (map[string]int, error) {
 	// Get all leads with their scores
 	leads, err := s.client.Lead.
 		Query().
+		Where(scope...).
 		Select(lead.FieldQualityScore).
 		All(ctx)
 	if err != nil {
@@ -0,0 +1,332 @@
+package leadtags
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/leadchange"
+	"github.com/jordanlanch/industrydb/pkg/leads"
+	"github.com/jordanlanch/industrydb/pkg/models"
+)
+
+// Tag limits.
+const (
+	MaxTagLength    = 50
+	MaxTagsPerLead  = 20
+	MaxBulkTagLeads = 1000
+)
+
+// Per-lead outcomes of a bulk tag operation.
+const (
+	StatusUpdated          = "updated"
+	StatusUnchanged        = "unchanged"
+	StatusNotFound         = "not_found"
+	StatusTagLimitExceeded = "tag_limit_exceeded"
+	StatusFailed           = "failed"
+)
+
+var (
+	ErrInvalidTag      = errors.New("invalid tag")
+	ErrTooManyTags     = fmt.Errorf("a lead can have at most %d tags", MaxTagsPerLead)
+	ErrNoTagChanges    = errors.New("at least one tag to add or remove is required")
+	ErrInvalidTargets  = errors.New("provide either lead_ids or filters")
+	ErrTooManyLeads    = fmt.Errorf("cannot tag more than %d leads at once", MaxBulkTagLeads)
+	ErrLeadNotFound    = errors.New("lead not found")
+	errTagLimitReached = errors.New("tag limit reached")
+)
+
+// Service handles lead tagging.
+type Service struct {
+	client *ent.Client
+	leads  *leads.Service
+}
+
+// NewService creates a new lead tags service. leadService resolves the
+// search filters of bulk operations.
+func NewService(client *ent.Client, leadService *leads.Service) *Service {
+	return &Service{client: client, leads: leadService}
+}
+
+// TagsResponse represents the tags of a lead.
+type TagsResponse struct {
+	LeadID int      `json:"lead_id"`
+	Tags   []string `json:"tags"`
+}
+
+// SetTagsRequest represents a request to replace the tags of a lead.
+type SetTagsRequest struct {
+	Tags []string `json:"tags"`
+}
+
+// BulkTagRequest adds and/or removes tags across leads selected either by
+// ID or by a lead search filter.
+type BulkTagRequest struct {
+	LeadIDs []int                     `json:"lead_ids,omitempty"`
+	Filters *models.LeadSearchRequest `json:"filters,omitempty"`
+	Add     []string                  `json:"add,omitempty"`
+	Remove  []string                  `json:"remove,omitempty"`
+}
+
+// BulkTagLeadResult is the outcome of a bulk tag operation for one lead.
+type BulkTagLeadResult struct {
+	LeadID int      `json:"lead_id"`
+	Status string   `json:"status"`
+	Tags   []string `json:"tags,omitempty"`
+	Error  string   `json:"error,omitempty"`
+}
+
+// BulkTagResult reports the outcome of a bulk tag operation.
+type BulkTagResult struct {
+	Requested int                 `json:"requested"`
+	Updated   int                 `json:"updated"`
+	Unchanged int                 `json:"unchanged"`
+	Failed    int                 `json:"failed"`
+	Add       []string            `json:"add"`
+	Remove    []string            `json:"remove"`
+	Results   []BulkTagLeadResult `json:"results"`
+}
+
+// NormalizeTag trims and lowercases a tag and joins words with hyphens, so
+// "Cold Outreach Q1" becomes "cold-outreach-q1". Tags may contain letters,
+// digits, hyphens and underscores and are at most MaxTagLength characters.
+func NormalizeTag(tag string) (string, error) {
+	normalized := strings.Join(strings.Fields(strings.ToLower(tag)), "-")
+	if normalized == "" {
+		return "", fmt.Errorf("%w: tag cannot be empty", ErrInvalidTag)
+	}
+	if utf8.RuneCountInString(normalized) > MaxTagLength {
+		return "", fmt.Errorf("%w: %q is longer than %d characters", ErrInvalidTag, normalized, MaxTagLength)
+	}
+	for _, r := range normalized {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-' && r != '_' {
+			return "", fmt.Errorf("%w: %q may only contain letters, digits, hyphens and underscores", ErrInvalidTag, tag)
+		}
+	}
+	return normalized, nil
+}
+
+// NormalizeTags normalizes each tag and drops duplicates, keeping the first
+// occurrence order. It never returns nil.
+func NormalizeTags(tags []string) ([]string, error) {
+	normalized := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		t, err := NormalizeTag(tag)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[t] {
+			seen[t] = true
+			normalized = append(normalized, t)
+		}
+	}
+	return normalized, nil
+}
+
+// SetTags replaces the tags of a lead and records the edit in the lead's
+// change history on behalf of userID.
+func (s *Service) SetTags(ctx context.Context, userID, leadID int, tags []string) (*TagsResponse, error) {
+	normalized, err := NormalizeTags(tags)
+	if err != nil {
+		return nil, err
+	}
+	if len(normalized) > MaxTagsPerLead {
+		return nil, ErrTooManyTags
+	}
+
+	updated, err := s.updateTags(ctx, userID, leadID, func([]string) []string {
+		return normalized
+	})
+	if err != nil {
+		return nil, err
+	}
+	if updated == nil {
+		return &TagsResponse{LeadID: leadID, Tags: normalized}, nil
+	}
+
+	return &TagsResponse{LeadID: updated.ID, Tags: tagsOf(updated)}, nil
+}
+
+// BulkUpdateTags adds and removes tags across the requested leads. Each lead
+// is updated in its own transaction, so one failing lead does not undo the
+// others; the per-lead outcome is reported in the result. A lead whose tag
+// count would exceed MaxTagsPerLead after the operation is left untouched.
+// Tags in both add and remove end up removed.
+func (s *Service) BulkUpdateTags(ctx context.Context, userID int, req BulkTagRequest) (*BulkTagResult, error) {
+	add, err := NormalizeTags(req.Add)
+	if err != nil {
+		return nil, err
+	}
+	remove, err := NormalizeTags(req.Remove)
+	if err != nil {
+		return nil, err
+	}
+	if len(add) == 0 && len(remove) == 0 {
+		return nil, ErrNoTagChanges
+	}
+
+	ids, err := s.resolveTargets(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &BulkTagResult{
+		Requested: len(ids),
+		Add:       add,
+		Remove:    remove,
+		Results:   make([]BulkTagLeadResult, 0, len(ids)),
+	}
+	for _, id := range ids {
+		leadResult := BulkTagLeadResult{LeadID: id}
+		updated, err := s.updateTags(ctx, userID, id, func(current []string) []string {
+			return applyTags(current, add, remove)
+		})
+		switch {
+		case err == nil && updated == nil:
+			leadResult.Status = StatusUnchanged
+			result.Unchanged++
+		case err == nil:
+			leadResult.Status = StatusUpdated
+			leadResult.Tags = tagsOf(updated)
+			result.Updated++
+		case errors.Is(err, ErrLeadNotFound):
+			leadResult.Status = StatusNotFound
+			result.Failed++
+		case errors.Is(err, errTagLimitReached):
+			leadResult.Status = StatusTagLimitExceeded
+			leadResult.Error = ErrTooManyTags.Error()
+			result.Failed++
+		default:
+			leadResult.Status = StatusFailed
+			leadResult.Error = "failed to update tags"
+			result.Failed++
+		}
+		result.Results = append(result.Results, leadResult)
+	}
+
+	return result, nil
+}
+
+// resolveTargets returns the deduplicated lead IDs a bulk request applies
+// to, from either its lead IDs or its search filters
+func (s *Service) resolveTargets(ctx context.Context, req BulkTagRequest) ([]int, error) {
+	if (len(req.LeadIDs) == 0) == (req.Filters == nil) {
+		return nil, ErrInvalidTargets
+	}
+
+	if req.Filters != nil {
+		ids, truncated, err := s.leads.MatchingLeadIDs(ctx, *req.Filters, MaxBulkTagLeads)
+		if err != nil {
+			return nil, err
+		}
+		if truncated {
+			return nil, ErrTooManyLeads
+		}
+		return ids, nil
+	}
+
+	seen := make(map[int]bool, len(req.LeadIDs))
+	ids := make([]int, 0, len(req.LeadIDs))
+	for _, id := range req.LeadIDs {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) > MaxBulkTagLeads {
+		return nil, ErrTooManyLeads
+	}
+	return ids, nil
+}
+
+// updateTags rewrites a lead's tags with change in a transaction and records
+// the change history. It returns nil without writing when the tags are
+// unchanged, and errTagLimitReached when the new tags exceed MaxTagsPerLead.
+func (s *Service) updateTags(ctx context.Context, userID, leadID int, change func([]string) []string) (*ent.Lead, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	l, err := tx.Lead.Get(ctx, leadID)
+	if err != nil {
+		tx.Rollback()
+		if ent.IsNotFound(err) {
+			return nil, ErrLeadNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch lead: %w", err)
+	}
+
+	tags := change(l.Tags)
+	if equalTags(l.Tags, tags) {
+		tx.Rollback()
+		return nil, nil
+	}
+	if len(tags) > MaxTagsPerLead {
+		tx.Rollback()
+		return nil, errTagLimitReached
+	}
+
+	updated, err := tx.Lead.UpdateOne(l).SetTags(tags).Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to update tags: %w", err)
+	}
+
+	if err := leads.RecordChange(ctx, tx.LeadChange, l, updated, leadchange.SourceManual, userID); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return updated, nil
+}
+
+// applyTags returns current with add appended and remove dropped, keeping
+// the existing order
+func applyTags(current, add, remove []string) []string {
+	removed := make(map[string]bool, len(remove))
+	for _, tag := range remove {
+		removed[tag] = true
+	}
+
+	tags := make([]string, 0, len(current)+len(add))
+	seen := make(map[string]bool, len(current)+len(add))
+	for _, tag := range append(append([]string{}, current...), add...) {
+		if !removed[tag] && !seen[tag] {
+			seen[tag] = true
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// equalTags reports whether two tag lists are identical, treating nil and
+// empty as equal
+func equalTags(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// tagsOf returns the tags of a lead, never nil
+func tagsOf(l *ent.Lead) []string {
+	if l.Tags == nil {
+		return []string{}
+	}
+	return l.Tags
+}
@@ -0,0 +1,224 @@
+package leadtags
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/ent/leadchange"
+	"github.com/jordanlanch/industrydb/pkg/leads"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestService(t *testing.T) (*Service, *ent.Client) {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	return NewService(client, leads.NewService(client, nil)), client
+}
+
+func createTestUser(t *testing.T, client *ent.Client) *ent.User {
+	user, err := client.User.
+		Create().
+		SetEmail("tagger@example.com").
+		SetPasswordHash("hashed_password").
+		SetName("Tagger").
+		Save(context.Background())
+	require.NoError(t, err)
+	return user
+}
+
+func createTestLead(t *testing.T, client *ent.Client, name, city string, tags ...string) *ent.Lead {
+	builder := client.Lead.
+		Create().
+		SetName(name).
+		SetIndustry("tattoo").
+		SetCountry("US").
+		SetCity(city)
+	if len(tags) > 0 {
+		builder.SetTags(tags)
+	}
+	l, err := builder.Save(context.Background())
+	require.NoError(t, err)
+	return l
+}
+
+func TestNormalizeTag(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{input: "cold-outreach-Q1", want: "cold-outreach-q1"},
+		{input: "  Cold   Outreach Q1 ", want: "cold-outreach-q1"},
+		{input: "vip_client", want: "vip_client"},
+		{input: "café", want: "café"},
+		{input: "   ", wantErr: true},
+		{input: "q1/2026", wantErr: true},
+		{input: strings.Repeat("a", MaxTagLength+1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := NormalizeTag(tt.input)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidTag)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("NormalizeTags drops duplicates", func(t *testing.T) {
+		tags, err := NormalizeTags([]string{"VIP", "follow up", "vip"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"vip", "follow-up"}, tags)
+	})
+}
+
+func TestSetTags(t *testing.T) {
+	service, client := setupTestService(t)
+	ctx := context.Background()
+	user := createTestUser(t, client)
+	lead := createTestLead(t, client, "Ink Studio", "Austin")
+
+	result, err := service.SetTags(ctx, user.ID, lead.ID, []string{"VIP", "Cold Outreach"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"vip", "cold-outreach"}, result.Tags)
+
+	change, err := client.LeadChange.Query().Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, leadchange.SourceManual, change.Source)
+	require.NotNil(t, change.UserID)
+	assert.Equal(t, user.ID, *change.UserID)
+	assert.Contains(t, change.Changes, "tags")
+
+	t.Run("Too many tags", func(t *testing.T) {
+		tags := make([]string, MaxTagsPerLead+1)
+		for i := range tags {
+			tags[i] = fmt.Sprintf("tag-%d", i)
+		}
+		_, err := service.SetTags(ctx, user.ID, lead.ID, tags)
+		assert.ErrorIs(t, err, ErrTooManyTags)
+	})
+
+	t.Run("Lead not found", func(t *testing.T) {
+		_, err := service.SetTags(ctx, user.ID, 99999, []string{"vip"})
+		assert.ErrorIs(t, err, ErrLeadNotFound)
+	})
+}
+
+func TestBulkUpdateTags(t *testing.T) {
+	service, client := setupTestService(t)
+	ctx := context.Background()
+	user := createTestUser(t, client)
+
+	fresh := createTestLead(t, client, "Fresh", "Austin")
+	tagged := createTestLead(t, client, "Tagged", "Austin", "stale", "vip")
+	already := createTestLead(t, client, "Already", "Austin", "cold-outreach-q1")
+
+	full := make([]string, MaxTagsPerLead)
+	for i := range full {
+		full[i] = fmt.Sprintf("tag-%d", i)
+	}
+	fullLead := createTestLead(t, client, "Full", "Austin", full...)
+
+	result, err := service.BulkUpdateTags(ctx, user.ID, BulkTagRequest{
+		LeadIDs: []int{fresh.ID, tagged.ID, already.ID, fullLead.ID, 99999, fresh.ID},
+		Add:     []string{"Cold Outreach Q1"},
+		Remove:  []string{"stale"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 5, result.Requested)
+	assert.Equal(t, 2, result.Updated)
+	assert.Equal(t, 1, result.Unchanged)
+	assert.Equal(t, 2, result.Failed)
+	assert.Equal(t, []string{"cold-outreach-q1"}, result.Add)
+
+	statuses := make(map[int]BulkTagLeadResult)
+	for _, r := range result.Results {
+		statuses[r.LeadID] = r
+	}
+	assert.Equal(t, StatusUpdated, statuses[fresh.ID].Status)
+	assert.Equal(t, []string{"cold-outreach-q1"}, statuses[fresh.ID].Tags)
+	assert.Equal(t, []string{"vip", "cold-outreach-q1"}, statuses[tagged.ID].Tags)
+	assert.Equal(t, StatusUnchanged, statuses[already.ID].Status)
+	assert.Equal(t, StatusTagLimitExceeded, statuses[fullLead.ID].Status)
+	assert.Equal(t, StatusNotFound, statuses[99999].Status)
+
+	// The lead over the limit is left untouched
+	reloaded, err := client.Lead.Get(ctx, fullLead.ID)
+	require.NoError(t, err)
+	assert.Equal(t, full, reloaded.Tags)
+
+	// Only the updated leads get a history entry
+	changes, err := client.LeadChange.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, changes)
+
+	t.Run("Remove frees room under the limit", func(t *testing.T) {
+		result, err := service.BulkUpdateTags(ctx, user.ID, BulkTagRequest{
+			LeadIDs: []int{fullLead.ID},
+			Add:     []string{"cold-outreach-q1"},
+			Remove:  []string{"tag-0"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Updated)
+		assert.Len(t, result.Results[0].Tags, MaxTagsPerLead)
+	})
+}
+
+func TestBulkUpdateTags_Filters(t *testing.T) {
+	service, client := setupTestService(t)
+	ctx := context.Background()
+	user := createTestUser(t, client)
+
+	austin := createTestLead(t, client, "Austin Ink", "Austin")
+	createTestLead(t, client, "Dallas Ink", "Dallas")
+
+	result, err := service.BulkUpdateTags(ctx, user.ID, BulkTagRequest{
+		Filters: &models.LeadSearchRequest{City: "Austin"},
+		Add:     []string{"texas"},
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Results, 1)
+	assert.Equal(t, austin.ID, result.Results[0].LeadID)
+	assert.Equal(t, StatusUpdated, result.Results[0].Status)
+}
+
+func TestBulkUpdateTags_Validation(t *testing.T) {
+	service, client := setupTestService(t)
+	ctx := context.Background()
+	user := createTestUser(t, client)
+	lead := createTestLead(t, client, "Ink Studio", "Austin")
+
+	_, err := service.BulkUpdateTags(ctx, user.ID, BulkTagRequest{LeadIDs: []int{lead.ID}})
+	assert.ErrorIs(t, err, ErrNoTagChanges)
+
+	_, err = service.BulkUpdateTags(ctx, user.ID, BulkTagRequest{LeadIDs: []int{lead.ID}, Add: []string{"a/b"}})
+	assert.ErrorIs(t, err, ErrInvalidTag)
+
+	_, err = service.BulkUpdateTags(ctx, user.ID, BulkTagRequest{Add: []string{"vip"}})
+	assert.ErrorIs(t, err, ErrInvalidTargets)
+
+	_, err = service.BulkUpdateTags(ctx, user.ID, BulkTagRequest{
+		LeadIDs: []int{lead.ID},
+		Filters: &models.LeadSearchRequest{City: "Austin"},
+		Add:     []string{"vip"},
+	})
+	assert.ErrorIs(t, err, ErrInvalidTargets)
+
+	ids := make([]int, MaxBulkTagLeads+1)
+	for i := range ids {
+		ids[i] = i + 1
+	}
+	_, err = service.BulkUpdateTags(ctx, user.ID, BulkTagRequest{LeadIDs: ids, Add: []string{"vip"}})
+	assert.ErrorIs(t, err, ErrTooManyLeads)
+}
@@ -0,0 +1,509 @@
+package leadverification
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/leadchange"
+	"github.com/jordanlanch/industrydb/ent/leadverification"
+	"github.com/jordanlanch/industrydb/pkg/leads"
+)
+
+// Service handles lead verification operations.
+type Service struct {
+	client *ent.Client
+}
+
+// NewService creates a new lead verification service.
+func NewService(client *ent.Client) *Service {
+	return &Service{client: client}
+}
+
+// Verification methods accepted as evidence.
+const (
+	MethodPhoneConfirmed = "phone_confirmed"
+	MethodEmailConfirmed = "email_confirmed"
+	MethodWebsiteChecked = "website_checked"
+	MethodSiteVisit      = "site_visit"
+	MethodThirdParty     = "third_party"
+	MethodManualReview   = "manual_review"
+)
+
+// ValidMethods lists all accepted verification methods.
+var ValidMethods = []string{
+	MethodPhoneConfirmed,
+	MethodEmailConfirmed,
+	MethodWebsiteChecked,
+	MethodSiteVisit,
+	MethodThirdParty,
+	MethodManualReview,
+}
+
+// VerifyRequest represents a request to verify a lead.
+type VerifyRequest struct {
+	Method string `json:"method" validate:"required,oneof=phone_confirmed email_confirmed website_checked site_visit third_party manual_review"`
+	Note   string `json:"note,omitempty" validate:"max=1000"`
+}
+
+// UnverifyRequest represents a request to remove a lead's verification.
+type UnverifyRequest struct {
+	Reason string `json:"reason" validate:"required,max=1000"`
+}
+
+// VerificationResponse represents a single verification event.
+type VerificationResponse struct {
+	ID        int       `json:"id"`
+	LeadID    int       `json:"lead_id"`
+	UserID    int       `json:"user_id"`
+	UserName  string    `json:"user_name"`
+	Action    string    `json:"action"`
+	Method    *string   `json:"method,omitempty"`
+	Note      *string   `json:"note,omitempty"`
+	Reason    *string   `json:"reason,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// StatusResponse represents the current verification state of a lead.
+type StatusResponse struct {
+	LeadID        int        `json:"lead_id"`
+	Verified      bool       `json:"verified"`
+	VerifiedSince *time.Time `json:"verified_since,omitempty"`
+	VerifiedBy    *int       `json:"verified_by,omitempty"`
+	// DataChanged is true when the lead's contact data differs from what was
+	// verified, meaning the lead should be re-verified.
+	DataChanged bool `json:"data_changed_since_verification"`
+}
+
+// MaxBulkVerifyLeads is the maximum number of leads accepted in one bulk verification.
+const MaxBulkVerifyLeads = 10000
+
+// BulkVerifyRequest represents a request to verify many leads with shared evidence.
+type BulkVerifyRequest struct {
+	LeadIDs []int  `json:"lead_ids"`
+	Method  string `json:"method" validate:"required,oneof=phone_confirmed email_confirmed website_checked site_visit third_party manual_review"`
+	Note    string `json:"note,omitempty" validate:"max=1000"`
+}
+
+// BulkVerifyResult reports the outcome of a bulk verification.
+type BulkVerifyResult struct {
+	Requested       int   `json:"requested"`
+	Updated         int   `json:"updated"`
+	AlreadyVerified int   `json:"already_verified"`
+	NotFound        int   `json:"not_found"`
+	NotFoundIDs     []int `json:"not_found_ids,omitempty"`
+}
+
+// IsValidMethod reports whether method is an accepted verification method.
+func IsValidMethod(method string) bool {
+	for _, m := range ValidMethods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// Fingerprint returns a stable hash of the lead's contact data. It is stored
+// with each verification event so later edits can be detected.
+func Fingerprint(l *ent.Lead) string {
+	parts := []string{
+		l.Name,
+		l.Address,
+		l.PostalCode,
+		l.City,
+		l.Country,
+		l.Phone,
+		l.Email,
+		l.Website,
+	}
+	for i, p := range parts {
+		parts[i] = strings.ToLower(strings.TrimSpace(p))
+	}
+	sum := sha256.Sum256([]byte(strings.Join(parts, "|")))
+	return hex.EncodeToString(sum[:])
+}
+
+// VerifyLead marks a lead as verified and records the evidence. Verifying an
+// already verified lead records a re-verification and refreshes verified_since.
+func (s *Service) VerifyLead(ctx context.Context, userID, leadID int, req VerifyRequest) (*StatusResponse, error) {
+	if !IsValidMethod(req.Method) {
+		return nil, fmt.Errorf("invalid verification method")
+	}
+
+	l, err := s.client.Lead.Get(ctx, leadID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("lead not found")
+		}
+		return nil, fmt.Errorf("failed to fetch lead: %w", err)
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	updated, err := recordVerification(ctx, tx, userID, l, req.Method, req.Note, time.Now())
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return &StatusResponse{
+		LeadID:        updated.ID,
+		Verified:      updated.Verified,
+		VerifiedSince: updated.VerifiedSince,
+		VerifiedBy:    updated.VerifiedBy,
+		DataChanged:   false,
+	}, nil
+}
+
+// recordVerification flips the lead to verified and appends a verify event
+// within the given transaction.
+func recordVerification(ctx context.Context, tx *ent.Tx, userID int, l *ent.Lead, method, note string, now time.Time) (*ent.Lead, error) {
+	updated, err := tx.Lead.
+		UpdateOne(l).
+		SetVerified(true).
+		SetVerifiedSince(now).
+		SetVerifiedBy(userID).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update lead: %w", err)
+	}
+
+	updated, err = leads.RefreshQuality(ctx, tx.Lead, updated)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := leads.RecordChange(ctx, tx.LeadChange, l, updated, leadchange.SourceVerification, userID); err != nil {
+		return nil, err
+	}
+
+	builder := tx.LeadVerification.
+		Create().
+		SetLeadID(l.ID).
+		SetUserID(userID).
+		SetAction(leadverification.ActionVerify).
+		SetMethod(leadverification.Method(method)).
+		SetDataFingerprint(Fingerprint(updated)).
+		SetCreatedAt(now)
+	if note != "" {
+		builder.SetNote(note)
+	}
+
+	if _, err := builder.Save(ctx); err != nil {
+		return nil, fmt.Errorf("failed to record verification: %w", err)
+	}
+
+	return updated, nil
+}
+
+// BulkVerifyLeads marks the given leads as verified with shared evidence.
+// Leads that are already verified are left untouched, and unknown IDs are
+// reported back. Duplicate IDs are counted once.
+func (s *Service) BulkVerifyLeads(ctx context.Context, userID int, req BulkVerifyRequest) (*BulkVerifyResult, error) {
+	if !IsValidMethod(req.Method) {
+		return nil, fmt.Errorf("invalid verification method")
+	}
+
+	seen := make(map[int]bool, len(req.LeadIDs))
+	ids := make([]int, 0, len(req.LeadIDs))
+	for _, id := range req.LeadIDs {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("no lead IDs provided")
+	}
+	if len(ids) > MaxBulkVerifyLeads {
+		return nil, fmt.Errorf("too many lead IDs: maximum is %d", MaxBulkVerifyLeads)
+	}
+
+	leads, err := s.client.Lead.
+		Query().
+		Where(lead.IDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch leads: %w", err)
+	}
+
+	found := make(map[int]*ent.Lead, len(leads))
+	for _, l := range leads {
+		found[l.ID] = l
+	}
+
+	result := &BulkVerifyResult{Requested: len(ids)}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	now := time.Now()
+	for _, id := range ids {
+		l, ok := found[id]
+		if !ok {
+			result.NotFound++
+			result.NotFoundIDs = append(result.NotFoundIDs, id)
+			continue
+		}
+		if l.Verified {
+			result.AlreadyVerified++
+			continue
+		}
+
+		if _, err := recordVerification(ctx, tx, userID, l, req.Method, req.Note, now); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		result.Updated++
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return result, nil
+}
+
+// ParseLeadIDsCSV reads lead IDs from a CSV file. IDs are taken from the
+// "id" or "lead_id" column when a header row is present, otherwise from the
+// first column. Blank rows are skipped.
+func ParseLeadIDsCSV(r io.Reader) ([]int, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var ids []int
+	column := 0
+	line := 0
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV: %w", err)
+		}
+		line++
+
+		if line == 1 {
+			header := false
+			for i, field := range record {
+				name := strings.ToLower(strings.TrimSpace(field))
+				if name == "id" || name == "lead_id" {
+					column = i
+					header = true
+					break
+				}
+			}
+			if header {
+				continue
+			}
+		}
+
+		if column >= len(record) || strings.TrimSpace(record[column]) == "" {
+			continue
+		}
+
+		id, err := strconv.Atoi(strings.TrimSpace(record[column]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid lead ID %q on line %d", record[column], line)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// UnverifyLead removes a lead's verification and records the reason.
+func (s *Service) UnverifyLead(ctx context.Context, userID, leadID int, req UnverifyRequest) (*StatusResponse, error) {
+	if strings.TrimSpace(req.Reason) == "" {
+		return nil, fmt.Errorf("reason is required")
+	}
+
+	l, err := s.client.Lead.Get(ctx, leadID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("lead not found")
+		}
+		return nil, fmt.Errorf("failed to fetch lead: %w", err)
+	}
+
+	if !l.Verified {
+		return nil, fmt.Errorf("lead is not verified")
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	updated, err := tx.Lead.
+		UpdateOne(l).
+		SetVerified(false).
+		ClearVerifiedSince().
+		ClearVerifiedBy().
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to update lead: %w", err)
+	}
+
+	updated, err = leads.RefreshQuality(ctx, tx.Lead, updated)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := leads.RecordChange(ctx, tx.LeadChange, l, updated, leadchange.SourceVerification, userID); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	_, err = tx.LeadVerification.
+		Create().
+		SetLeadID(leadID).
+		SetUserID(userID).
+		SetAction(leadverification.ActionUnverify).
+		SetReason(req.Reason).
+		SetDataFingerprint(Fingerprint(updated)).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to record verification: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return &StatusResponse{
+		LeadID:   updated.ID,
+		Verified: false,
+	}, nil
+}
+
+// GetStatus returns the current verification state of a lead, including
+// whether its contact data has changed since it was last verified.
+func (s *Service) GetStatus(ctx context.Context, leadID int) (*StatusResponse, error) {
+	l, err := s.client.Lead.Get(ctx, leadID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("lead not found")
+		}
+		return nil, fmt.Errorf("failed to fetch lead: %w", err)
+	}
+
+	status := &StatusResponse{
+		LeadID:        l.ID,
+		Verified:      l.Verified,
+		VerifiedSince: l.VerifiedSince,
+		VerifiedBy:    l.VerifiedBy,
+	}
+
+	if !l.Verified {
+		return status, nil
+	}
+
+	last, err := s.client.LeadVerification.
+		Query().
+		Where(
+			leadverification.LeadID(leadID),
+			leadverification.ActionEQ(leadverification.ActionVerify),
+		).
+		Order(ent.Desc(leadverification.FieldCreatedAt), ent.Desc(leadverification.FieldID)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to fetch last verification: %w", err)
+	}
+	if last != nil && last.DataFingerprint != "" {
+		status.DataChanged = last.DataFingerprint != Fingerprint(l)
+	}
+
+	return status, nil
+}
+
+// GetVerificationHistory retrieves all verification events for a lead, newest first.
+func (s *Service) GetVerificationHistory(ctx context.Context, leadID int) ([]VerificationResponse, error) {
+	exists, err := s.client.Lead.
+		Query().
+		Where(lead.ID(leadID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check lead existence: %w", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("lead not found")
+	}
+
+	events, err := s.client.LeadVerification.
+		Query().
+		Where(leadverification.LeadID(leadID)).
+		WithUser().
+		Order(ent.Desc(leadverification.FieldCreatedAt), ent.Desc(leadverification.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch verification history: %w", err)
+	}
+
+	response := make([]VerificationResponse, len(events))
+	for i, e := range events {
+		response[i] = toVerificationResponse(e)
+	}
+
+	return response, nil
+}
+
+// toVerificationResponse converts an ent verification event to a response.
+func toVerificationResponse(e *ent.LeadVerification) VerificationResponse {
+	var method *string
+	if e.Method != nil {
+		m := string(*e.Method)
+		method = &m
+	}
+
+	var note *string
+	if e.Note != "" {
+		note = &e.Note
+	}
+
+	var reason *string
+	if e.Reason != "" {
+		reason = &e.Reason
+	}
+
+	userName := "Unknown User"
+	if u := e.Edges.User; u != nil {
+		userName = u.Name
+	}
+
+	return VerificationResponse{
+		ID:        e.ID,
+		LeadID:    e.LeadID,
+		UserID:    e.UserID,
+		UserName:  userName,
+		Action:    string(e.Action),
+		Method:    method,
+		Note:      note,
+		Reason:    reason,
+		CreatedAt: e.CreatedAt,
+	}
+}
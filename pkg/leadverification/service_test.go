@@ -0,0 +1,279 @@
+package leadverification
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/ent/leadchange"
+	"github.com/jordanlanch/industrydb/pkg/leads"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestDB(t *testing.T) (*ent.Client, func()) {
+	client := enttest.Open(t, "sqlite3", "file:leadverification?mode=memory&cache=shared&_fk=1")
+	return client, func() { client.Close() }
+}
+
+func createTestUser(t *testing.T, client *ent.Client, email, name string) *ent.User {
+	user, err := client.User.
+		Create().
+		SetEmail(email).
+		SetPasswordHash("hashed_password").
+		SetName(name).
+		Save(context.Background())
+	require.NoError(t, err)
+	return user
+}
+
+func createTestLead(t *testing.T, client *ent.Client, name string) *ent.Lead {
+	l, err := client.Lead.
+		Create().
+		SetName(name).
+		SetIndustry("tattoo").
+		SetCountry("US").
+		SetCity("New York").
+		SetPhone("+12125551234").
+		Save(context.Background())
+	require.NoError(t, err)
+	return l
+}
+
+func TestVerifyLead(t *testing.T) {
+	client, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	service := NewService(client)
+
+	user := createTestUser(t, client, "qa@example.com", "QA Reviewer")
+	testLead := createTestLead(t, client, "Ink Studio")
+
+	t.Run("Success - Verify unverified lead", func(t *testing.T) {
+		result, err := service.VerifyLead(ctx, user.ID, testLead.ID, VerifyRequest{
+			Method: MethodPhoneConfirmed,
+			Note:   "Spoke with owner",
+		})
+
+		require.NoError(t, err)
+		assert.True(t, result.Verified)
+		require.NotNil(t, result.VerifiedSince)
+		require.NotNil(t, result.VerifiedBy)
+		assert.Equal(t, user.ID, *result.VerifiedBy)
+
+		updated, err := client.Lead.Get(ctx, testLead.ID)
+		require.NoError(t, err)
+		assert.True(t, updated.Verified)
+		assert.NotNil(t, updated.VerifiedSince)
+		w := leads.DefaultQualityWeights()
+		assert.Equal(t, w.Phone+w.Verified+w.Recency, updated.QualityScore, "verification should refresh the quality score")
+
+		history, err := service.GetVerificationHistory(ctx, testLead.ID)
+		require.NoError(t, err)
+		require.Len(t, history, 1)
+		assert.Equal(t, "verify", history[0].Action)
+		assert.Equal(t, MethodPhoneConfirmed, *history[0].Method)
+		assert.Equal(t, "Spoke with owner", *history[0].Note)
+		assert.Equal(t, "QA Reviewer", history[0].UserName)
+
+		changes, err := client.LeadChange.Query().Where(leadchange.LeadID(testLead.ID)).All(ctx)
+		require.NoError(t, err)
+		require.Len(t, changes, 1)
+		assert.Equal(t, leadchange.SourceVerification, changes[0].Source)
+		assert.Equal(t, user.ID, *changes[0].UserID)
+		assert.Equal(t, []interface{}{nil, true}, changes[0].Changes["verified"])
+	})
+
+	t.Run("Success - Re-verification records another event", func(t *testing.T) {
+		_, err := service.VerifyLead(ctx, user.ID, testLead.ID, VerifyRequest{
+			Method: MethodWebsiteChecked,
+		})
+		require.NoError(t, err)
+
+		history, err := service.GetVerificationHistory(ctx, testLead.ID)
+		require.NoError(t, err)
+		assert.Len(t, history, 2)
+		assert.Equal(t, MethodWebsiteChecked, *history[0].Method)
+		assert.Nil(t, history[0].Note)
+	})
+
+	t.Run("Error - Invalid method", func(t *testing.T) {
+		_, err := service.VerifyLead(ctx, user.ID, testLead.ID, VerifyRequest{Method: "gut_feeling"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid verification method")
+	})
+
+	t.Run("Error - Lead not found", func(t *testing.T) {
+		_, err := service.VerifyLead(ctx, user.ID, 99999, VerifyRequest{Method: MethodManualReview})
+		require.Error(t, err)
+		assert.Equal(t, "lead not found", err.Error())
+	})
+}
+
+func TestUnverifyLead(t *testing.T) {
+	client, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	service := NewService(client)
+
+	user := createTestUser(t, client, "qa@example.com", "QA Reviewer")
+	testLead := createTestLead(t, client, "Ink Studio")
+
+	t.Run("Error - Lead not verified", func(t *testing.T) {
+		_, err := service.UnverifyLead(ctx, user.ID, testLead.ID, UnverifyRequest{Reason: "Closed"})
+		require.Error(t, err)
+		assert.Equal(t, "lead is not verified", err.Error())
+	})
+
+	t.Run("Success - Unverify verified lead", func(t *testing.T) {
+		_, err := service.VerifyLead(ctx, user.ID, testLead.ID, VerifyRequest{Method: MethodPhoneConfirmed})
+		require.NoError(t, err)
+
+		result, err := service.UnverifyLead(ctx, user.ID, testLead.ID, UnverifyRequest{Reason: "Phone disconnected"})
+		require.NoError(t, err)
+		assert.False(t, result.Verified)
+		assert.Nil(t, result.VerifiedSince)
+
+		updated, err := client.Lead.Get(ctx, testLead.ID)
+		require.NoError(t, err)
+		assert.False(t, updated.Verified)
+		assert.Nil(t, updated.VerifiedSince)
+		assert.Nil(t, updated.VerifiedBy)
+
+		history, err := service.GetVerificationHistory(ctx, testLead.ID)
+		require.NoError(t, err)
+		require.Len(t, history, 2)
+		assert.Equal(t, "unverify", history[0].Action)
+		assert.Nil(t, history[0].Method)
+		assert.Equal(t, "Phone disconnected", *history[0].Reason)
+	})
+
+	t.Run("Error - Missing reason", func(t *testing.T) {
+		_, err := service.UnverifyLead(ctx, user.ID, testLead.ID, UnverifyRequest{})
+		require.Error(t, err)
+	})
+}
+
+func TestGetStatus_DetectsDataChanges(t *testing.T) {
+	client, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	service := NewService(client)
+
+	user := createTestUser(t, client, "qa@example.com", "QA Reviewer")
+	testLead := createTestLead(t, client, "Ink Studio")
+
+	_, err := service.VerifyLead(ctx, user.ID, testLead.ID, VerifyRequest{Method: MethodPhoneConfirmed})
+	require.NoError(t, err)
+
+	status, err := service.GetStatus(ctx, testLead.ID)
+	require.NoError(t, err)
+	assert.True(t, status.Verified)
+	assert.False(t, status.DataChanged)
+
+	// Change contact data after verification
+	_, err = client.Lead.UpdateOneID(testLead.ID).SetPhone("+12125559999").Save(ctx)
+	require.NoError(t, err)
+
+	status, err = service.GetStatus(ctx, testLead.ID)
+	require.NoError(t, err)
+	assert.True(t, status.DataChanged, "changed phone should require re-verification")
+
+	// Re-verifying clears the flag
+	_, err = service.VerifyLead(ctx, user.ID, testLead.ID, VerifyRequest{Method: MethodPhoneConfirmed})
+	require.NoError(t, err)
+
+	status, err = service.GetStatus(ctx, testLead.ID)
+	require.NoError(t, err)
+	assert.False(t, status.DataChanged)
+}
+
+func TestFingerprint(t *testing.T) {
+	a := &ent.Lead{Name: "Ink Studio", Phone: "+1 212", Email: "A@example.com", City: "NYC", Country: "US"}
+	b := &ent.Lead{Name: "ink studio ", Phone: "+1 212", Email: "a@example.com", City: "nyc", Country: "US"}
+	c := &ent.Lead{Name: "Ink Studio", Phone: "+1 213", Email: "a@example.com", City: "NYC", Country: "US"}
+
+	assert.Equal(t, Fingerprint(a), Fingerprint(b), "fingerprint should ignore case and whitespace")
+	assert.NotEqual(t, Fingerprint(a), Fingerprint(c))
+}
+
+func TestBulkVerifyLeads(t *testing.T) {
+	client, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	service := NewService(client)
+
+	user := createTestUser(t, client, "qa@example.com", "QA Reviewer")
+	leadA := createTestLead(t, client, "Studio A")
+	leadB := createTestLead(t, client, "Studio B")
+	leadC := createTestLead(t, client, "Studio C")
+
+	_, err := service.VerifyLead(ctx, user.ID, leadC.ID, VerifyRequest{Method: MethodSiteVisit})
+	require.NoError(t, err)
+
+	t.Run("Success - Reports updated, already verified and not found", func(t *testing.T) {
+		result, err := service.BulkVerifyLeads(ctx, user.ID, BulkVerifyRequest{
+			LeadIDs: []int{leadA.ID, leadB.ID, leadC.ID, leadA.ID, 99999},
+			Method:  MethodManualReview,
+			Note:    "QA batch 12",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 4, result.Requested)
+		assert.Equal(t, 2, result.Updated)
+		assert.Equal(t, 1, result.AlreadyVerified)
+		assert.Equal(t, 1, result.NotFound)
+		assert.Equal(t, []int{99999}, result.NotFoundIDs)
+
+		for _, id := range []int{leadA.ID, leadB.ID} {
+			history, err := service.GetVerificationHistory(ctx, id)
+			require.NoError(t, err)
+			require.Len(t, history, 1)
+			assert.Equal(t, MethodManualReview, *history[0].Method)
+			assert.Equal(t, "QA batch 12", *history[0].Note)
+		}
+
+		// Already verified lead keeps its original evidence
+		history, err := service.GetVerificationHistory(ctx, leadC.ID)
+		require.NoError(t, err)
+		require.Len(t, history, 1)
+		assert.Equal(t, MethodSiteVisit, *history[0].Method)
+	})
+
+	t.Run("Error - Invalid method", func(t *testing.T) {
+		_, err := service.BulkVerifyLeads(ctx, user.ID, BulkVerifyRequest{LeadIDs: []int{leadA.ID}, Method: "guess"})
+		require.Error(t, err)
+	})
+
+	t.Run("Error - No lead IDs", func(t *testing.T) {
+		_, err := service.BulkVerifyLeads(ctx, user.ID, BulkVerifyRequest{Method: MethodManualReview})
+		require.Error(t, err)
+	})
+}
+
+func TestParseLeadIDsCSV(t *testing.T) {
+	t.Run("Header with lead_id column", func(t *testing.T) {
+		ids, err := ParseLeadIDsCSV(strings.NewReader("name,lead_id\nStudio A,12\nStudio B, 15\n,\n"))
+		require.NoError(t, err)
+		assert.Equal(t, []int{12, 15}, ids)
+	})
+
+	t.Run("No header uses first column", func(t *testing.T) {
+		ids, err := ParseLeadIDsCSV(strings.NewReader("1\n2\n\n3\n"))
+		require.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3}, ids)
+	})
+
+	t.Run("Invalid ID", func(t *testing.T) {
+		_, err := ParseLeadIDsCSV(strings.NewReader("id\n1\nabc\n"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "line 3")
+	})
+}
@@ -0,0 +1,72 @@
+package metrics
+
+import (
+	"database/sql"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// DBPoolCollector exports database/sql connection pool statistics. Stats
+// are read when /metrics is scraped, so the values are never stale.
+type DBPoolCollector struct {
+	stats func() sql.DBStats
+
+	maxOpen           *prometheus.Desc
+	open              *prometheus.Desc
+	inUse             *prometheus.Desc
+	idle              *prometheus.Desc
+	waitCount         *prometheus.Desc
+	waitDuration      *prometheus.Desc
+	maxIdleClosed     *prometheus.Desc
+	maxIdleTimeClosed *prometheus.Desc
+	maxLifetimeClosed *prometheus.Desc
+}
+
+// NewDBPoolCollector creates a collector reading pool statistics from stats,
+// typically database.Client.Stats
+func NewDBPoolCollector(stats func() sql.DBStats) *DBPoolCollector {
+	return &DBPoolCollector{
+		stats:             stats,
+		maxOpen:           prometheus.NewDesc("db_pool_max_open_connections", "Maximum number of open database connections", nil, nil),
+		open:              prometheus.NewDesc("db_pool_open_connections", "Number of open database connections, in use and idle", nil, nil),
+		inUse:             prometheus.NewDesc("db_pool_in_use_connections", "Number of database connections in use", nil, nil),
+		idle:              prometheus.NewDesc("db_pool_idle_connections", "Number of idle database connections", nil, nil),
+		waitCount:         prometheus.NewDesc("db_pool_wait_count_total", "Total number of times a query waited for a free database connection", nil, nil),
+		waitDuration:      prometheus.NewDesc("db_pool_wait_duration_seconds_total", "Total time spent waiting for a free database connection", nil, nil),
+		maxIdleClosed:     prometheus.NewDesc("db_pool_max_idle_closed_total", "Total number of connections closed because of the max idle connections limit", nil, nil),
+		maxIdleTimeClosed: prometheus.NewDesc("db_pool_max_idle_time_closed_total", "Total number of connections closed because of the max idle time", nil, nil),
+		maxLifetimeClosed: prometheus.NewDesc("db_pool_max_lifetime_closed_total", "Total number of connections closed because of the max lifetime", nil, nil),
+	}
+}
+
+// Describe implements prometheus.Collector
+func (c *DBPoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.maxOpen
+	ch <- c.open
+	ch <- c.inUse
+	ch <- c.idle
+	ch <- c.waitCount
+	ch <- c.waitDuration
+	ch <- c.maxIdleClosed
+	ch <- c.maxIdleTimeClosed
+	ch <- c.maxLifetimeClosed
+}
+
+// Collect implements prometheus.Collector
+func (c *DBPoolCollector) Collect(ch chan<- prometheus.Metric) {
+	stats := c.stats()
+	ch <- prometheus.MustNewConstMetric(c.maxOpen, prometheus.GaugeValue, float64(stats.MaxOpenConnections))
+	ch <- prometheus.MustNewConstMetric(c.open, prometheus.GaugeValue, float64(stats.OpenConnections))
+	ch <- prometheus.MustNewConstMetric(c.inUse, prometheus.GaugeValue, float64(stats.InUse))
+	ch <- prometheus.MustNewConstMetric(c.idle, prometheus.GaugeValue, float64(stats.Idle))
+	ch <- prometheus.MustNewConstMetric(c.waitCount, prometheus.CounterValue, float64(stats.WaitCount))
+	ch <- prometheus.MustNewConstMetric(c.waitDuration, prometheus.CounterValue, stats.WaitDuration.Seconds())
+	ch <- prometheus.MustNewConstMetric(c.maxIdleClosed, prometheus.CounterValue, float64(stats.MaxIdleClosed))
+	ch <- prometheus.MustNewConstMetric(c.maxIdleTimeClosed, prometheus.CounterValue, float64(stats.MaxIdleTimeClosed))
+	ch <- prometheus.MustNewConstMetric(c.maxLifetimeClosed, prometheus.CounterValue, float64(stats.MaxLifetimeClosed))
+}
+
+// RegisterDBPool exports the connection pool statistics on /metrics
+func (m *Metrics) RegisterDBPool(stats func() sql.DBStats) {
+	prometheus.MustRegister(NewDBPoolCollector(stats))
+}
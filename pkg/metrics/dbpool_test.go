@@ -0,0 +1,55 @@
+package metrics
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDBPoolCollector(t *testing.T) {
+	stats := sql.DBStats{
+		MaxOpenConnections: 25,
+		OpenConnections:    7,
+		InUse:              5,
+		Idle:               2,
+		WaitCount:          12,
+		WaitDuration:       1500 * time.Millisecond,
+	}
+	registry := prometheus.NewRegistry()
+	require.NoError(t, registry.Register(NewDBPoolCollector(func() sql.DBStats { return stats })))
+
+	expected := `
+# HELP db_pool_in_use_connections Number of database connections in use
+# TYPE db_pool_in_use_connections gauge
+db_pool_in_use_connections 5
+# HELP db_pool_idle_connections Number of idle database connections
+# TYPE db_pool_idle_connections gauge
+db_pool_idle_connections 2
+# HELP db_pool_wait_count_total Total number of times a query waited for a free database connection
+# TYPE db_pool_wait_count_total counter
+db_pool_wait_count_total 12
+# HELP db_pool_wait_duration_seconds_total Total time spent waiting for a free database connection
+# TYPE db_pool_wait_duration_seconds_total counter
+db_pool_wait_duration_seconds_total 1.5
+`
+	assert.NoError(t, testutil.GatherAndCompare(registry, strings.NewReader(expected),
+		"db_pool_in_use_connections", "db_pool_idle_connections", "db_pool_wait_count_total", "db_pool_wait_duration_seconds_total"))
+
+	// Stats are read on every scrape
+	stats.InUse = 25
+	assert.NoError(t, testutil.GatherAndCompare(registry, strings.NewReader(`
+# HELP db_pool_in_use_connections Number of database connections in use
+# TYPE db_pool_in_use_connections gauge
+db_pool_in_use_connections 25
+`), "db_pool_in_use_connections"))
+
+	count, err := testutil.GatherAndCount(registry)
+	require.NoError(t, err)
+	assert.Equal(t, 9, count)
+}
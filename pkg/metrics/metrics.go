@@ -20,10 +20,18 @@ type Metrics struct {
 	// Business metrics
 	LeadsSearched    prometheus.Counter
 	ExportsCreated   prometheus.Counter
+	ExportsDownloaded prometheus.Counter
 	UsersRegistered  prometheus.Counter
 	LoginAttempts    *prometheus.CounterVec
 	SubscriptionsSold *prometheus.CounterVec
 
+	// Export queue metrics
+	ExportQueueDepth prometheus.Gauge
+	ExportQueueWait  prometheus.Histogram
+
+	// Enrichment metrics
+	EnrichmentCallsInFlight prometheus.Gauge
+
 	// Database metrics
 	DBQueryDuration *prometheus.HistogramVec
 	DBConnections   prometheus.Gauge
@@ -78,6 +86,10 @@ func New() *Metrics {
 			Name: "exports_created_total",
 			Help: "Total number of exports created",
 		}),
+		ExportsDownloaded: promauto.NewCounter(prometheus.CounterOpts{
+			Name: "exports_downloaded_total",
+			Help: "Total number of export file downloads",
+		}),
 		UsersRegistered: promauto.NewCounter(prometheus.CounterOpts{
 			Name: "users_registered_total",
 			Help: "Total number of users registered",
@@ -97,6 +109,23 @@ func New() *Metrics {
 			[]string{"tier"}, // starter, pro, business
 		),
 
+		// Export queue metrics
+		ExportQueueDepth: promauto.NewGauge(prometheus.GaugeOpts{
+			Name: "export_queue_depth",
+			Help: "Number of exports waiting for a worker",
+		}),
+		ExportQueueWait: promauto.NewHistogram(prometheus.HistogramOpts{
+			Name:    "export_queue_wait_seconds",
+			Help:    "Time exports spend waiting for a worker in seconds",
+			Buckets: []float64{0.1, 1, 5, 15, 30, 60, 120, 300, 600},
+		}),
+
+		// Enrichment metrics
+		EnrichmentCallsInFlight: promauto.NewGauge(prometheus.GaugeOpts{
+			Name: "enrichment_provider_calls_in_flight",
+			Help: "Number of enrichment provider calls in flight",
+		}),
+
 		// Database metrics
 		DBQueryDuration: promauto.NewHistogramVec(
 			prometheus.HistogramOpts{
@@ -170,6 +199,11 @@ func (m *Metrics) RecordExportCreated() {
 	m.ExportsCreated.Inc()
 }
 
+// RecordExportDownloaded increments exports downloaded counter
+func (m *Metrics) RecordExportDownloaded() {
+	m.ExportsDownloaded.Inc()
+}
+
 // RecordUserRegistered increments users registered counter
 func (m *Metrics) RecordUserRegistered() {
 	m.UsersRegistered.Inc()
@@ -189,6 +223,21 @@ func (m *Metrics) RecordSubscriptionSold(tier string) {
 	m.SubscriptionsSold.WithLabelValues(tier).Inc()
 }
 
+// SetExportQueueDepth updates the export queue depth gauge
+func (m *Metrics) SetExportQueueDepth(depth int) {
+	m.ExportQueueDepth.Set(float64(depth))
+}
+
+// ObserveExportQueueWait records how long an export waited for a worker
+func (m *Metrics) ObserveExportQueueWait(wait time.Duration) {
+	m.ExportQueueWait.Observe(wait.Seconds())
+}
+
+// SetEnrichmentCallsInFlight updates the enrichment provider calls in flight gauge
+func (m *Metrics) SetEnrichmentCallsInFlight(count int) {
+	m.EnrichmentCallsInFlight.Set(float64(count))
+}
+
 // RecordDBQuery records database query duration
 func (m *Metrics) RecordDBQuery(operation string, duration time.Duration) {
 	m.DBQueryDuration.WithLabelValues(operation).Observe(duration.Seconds())
@@ -29,6 +29,35 @@ func CORSConfig() middleware.CORSConfig {
 			"Content-Type",
 			"Accept",
 			"Authorization",
+			IdempotencyKeyHeader,
 		},
 	}
 }
+
+// CORSConfigFor returns the CORS configuration for an environment.
+// allowedOrigins, when set, replace the default origins; otherwise
+// production only allows the production frontends, and other environments
+// the local ones too. A wildcard origin is never allowed, since requests
+// carry credentials.
+func CORSConfigFor(environment string, allowedOrigins []string) middleware.CORSConfig {
+	config := CORSConfig()
+
+	origins := make([]string, 0, len(allowedOrigins))
+	for _, origin := range allowedOrigins {
+		if origin != "*" {
+			origins = append(origins, origin)
+		}
+	}
+
+	switch {
+	case len(origins) > 0:
+		config.AllowOrigins = origins
+	case environment == productionEnvironment:
+		config.AllowOrigins = []string{
+			"https://industrydb.io",
+			"https://www.industrydb.io",
+		}
+	}
+
+	return config
+}
@@ -235,6 +235,7 @@ func TestCORSConfig_Values(t *testing.T) {
 		"Content-Type",
 		"Accept",
 		"Authorization",
+		"Idempotency-Key",
 	}, cfg.AllowHeaders)
 }
 
@@ -247,3 +248,25 @@ func TestCORSConfig_NoWildcardOrigin(t *testing.T) {
 			"wildcard origin must not be used with AllowCredentials")
 	}
 }
+
+// --- Per-environment origins ---
+
+func TestCORSConfigFor_Environments(t *testing.T) {
+	assert.ElementsMatch(t, CORSConfig().AllowOrigins, CORSConfigFor("development", nil).AllowOrigins)
+
+	assert.ElementsMatch(t, []string{
+		"https://industrydb.io",
+		"https://www.industrydb.io",
+	}, CORSConfigFor("production", nil).AllowOrigins)
+}
+
+func TestCORSConfigFor_ConfiguredOrigins(t *testing.T) {
+	cfg := CORSConfigFor("production", []string{"https://staging.industrydb.io", "*"})
+
+	assert.Equal(t, []string{"https://staging.industrydb.io"}, cfg.AllowOrigins)
+	assert.True(t, cfg.AllowCredentials)
+
+	// Only a wildcard falls back to the defaults
+	cfg = CORSConfigFor("production", []string{"*"})
+	assert.NotContains(t, cfg.AllowOrigins, "*")
+}
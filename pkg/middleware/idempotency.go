@@ -0,0 +1,246 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/jordanlanch/industrydb/pkg/cache"
+	"github.com/labstack/echo/v4"
+	"github.com/redis/go-redis/v9"
+)
+
+// IdempotencyKeyHeader is the request header carrying a client's idempotency key
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// IdempotentReplayedHeader is set on responses replayed from an earlier request
+const IdempotentReplayedHeader = "Idempotent-Replayed"
+
+// DefaultIdempotencyTTL is how long responses are kept for replay
+const DefaultIdempotencyTTL = 24 * time.Hour
+
+// idempotencyLockTTL bounds how long a request holds its key while it runs,
+// so a crashed request doesn't block retries until the TTL
+const idempotencyLockTTL = time.Minute
+
+// maxIdempotentResponseSize is the largest response stored for replay;
+// larger responses are not cached and retries run again
+const maxIdempotentResponseSize = 1 << 20
+
+// maxIdempotencyKeyLength is the longest accepted idempotency key
+const maxIdempotencyKeyLength = 255
+
+// idempotencySecretFields are JSON response fields shown only once: new API
+// keys, webhook signing secrets and export delivery secrets. They are left
+// out of stored responses, so a replay returns the ID and metadata of what
+// was created but not its secret.
+var idempotencySecretFields = [][]string{
+	{"secret"},
+	{"delivery_secret"},
+	{"api_key", "key"},
+}
+
+// idempotencyRecord is the state of an idempotency key in Redis
+type idempotencyRecord struct {
+	Fingerprint string `json:"fingerprint"` // Hash of the request the key was first used with
+	Done        bool   `json:"done"`
+	Status      int    `json:"status,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+	Body        []byte `json:"body,omitempty"`
+}
+
+// Idempotency returns a middleware that makes retrying a mutating request
+// safe. When a request carries an Idempotency-Key header, the first response
+// is stored in Redis for ttl and returned, with Idempotent-Replayed: true,
+// for later requests with the same key instead of running the handler again.
+// Keys are scoped per user and endpoint (method and path), so the same key
+// may be reused on other endpoints. Reusing a key with a different request
+// (query or body) returns 422, and a retry while the first request is still
+// running returns 409. Only successful responses are stored, without their
+// secret fields (see idempotencySecretFields); after an error the key is
+// released, so the request can be fixed and retried. Requests
+// without the header, or without an authenticated user, run as usual, and
+// Redis errors fail open.
+func Idempotency(store *cache.Client, ttl time.Duration) echo.MiddlewareFunc {
+	if ttl <= 0 {
+		ttl = DefaultIdempotencyTTL
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			key := c.Request().Header.Get(IdempotencyKeyHeader)
+			userID, ok := c.Get("user_id").(int)
+			if key == "" || !ok || store == nil {
+				return next(c)
+			}
+			if len(key) > maxIdempotencyKeyLength {
+				return c.JSON(http.StatusBadRequest, map[string]string{
+					"error":   "invalid_idempotency_key",
+					"message": fmt.Sprintf("Idempotency-Key must be at most %d characters", maxIdempotencyKeyLength),
+				})
+			}
+
+			req := c.Request()
+			body, err := io.ReadAll(req.Body)
+			if err != nil {
+				return c.JSON(http.StatusBadRequest, map[string]string{
+					"error":   "invalid_request",
+					"message": "Failed to read request body",
+				})
+			}
+			req.Body = io.NopCloser(bytes.NewReader(body))
+
+			ctx := req.Context()
+			redisKey := idempotencyRedisKey(userID, req.Method, req.URL.Path, key)
+			fingerprint := requestFingerprint(req.URL.RawQuery, body)
+
+			pending, _ := json.Marshal(idempotencyRecord{Fingerprint: fingerprint})
+			acquired, err := store.Redis.SetNX(ctx, redisKey, pending, idempotencyLockTTL).Result()
+			if err != nil {
+				log.Printf("⚠️  Idempotency check failed, running request without it: %v", err)
+				return next(c)
+			}
+
+			if !acquired {
+				raw, err := store.Redis.Get(ctx, redisKey).Bytes()
+				if err == redis.Nil {
+					// The key expired in between; have the client retry
+					return idempotencyInProgress(c)
+				}
+				if err != nil {
+					log.Printf("⚠️  Idempotency lookup failed, running request without it: %v", err)
+					return next(c)
+				}
+
+				var record idempotencyRecord
+				if err := json.Unmarshal(raw, &record); err != nil {
+					log.Printf("⚠️  Malformed idempotency record %s: %v", redisKey, err)
+					return next(c)
+				}
+				if record.Fingerprint != fingerprint {
+					return c.JSON(http.StatusUnprocessableEntity, map[string]string{
+						"error":   "idempotency_key_mismatch",
+						"message": "This Idempotency-Key was already used with a different request",
+					})
+				}
+				if !record.Done {
+					return idempotencyInProgress(c)
+				}
+
+				c.Response().Header().Set(IdempotentReplayedHeader, "true")
+				return c.Blob(record.Status, record.ContentType, record.Body)
+			}
+
+			recorder := &responseRecorder{ResponseWriter: c.Response().Writer}
+			c.Response().Writer = recorder
+			err = next(c)
+			c.Response().Writer = recorder.ResponseWriter
+
+			// Failed requests release the key, so the client can retry them
+			status := c.Response().Status
+			if err != nil || status < 200 || status >= 300 || recorder.overflow {
+				if delErr := store.Delete(ctx, redisKey); delErr != nil {
+					log.Printf("⚠️  Failed to release idempotency key %s: %v", redisKey, delErr)
+				}
+				return err
+			}
+
+			contentType := c.Response().Header().Get(echo.HeaderContentType)
+			done, _ := json.Marshal(idempotencyRecord{
+				Fingerprint: fingerprint,
+				Done:        true,
+				Status:      status,
+				ContentType: contentType,
+				Body:        withoutSecrets(contentType, recorder.body.Bytes()),
+			})
+			if err := store.Set(ctx, redisKey, done, ttl); err != nil {
+				log.Printf("⚠️  Failed to store idempotent response %s: %v", redisKey, err)
+			}
+			return nil
+		}
+	}
+}
+
+// withoutSecrets removes idempotencySecretFields from a JSON response body.
+// Other bodies are returned unchanged.
+func withoutSecrets(contentType string, body []byte) []byte {
+	if !strings.HasPrefix(contentType, echo.MIMEApplicationJSON) {
+		return body
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return body
+	}
+
+	removed := false
+	for _, path := range idempotencySecretFields {
+		parent := fields
+		for _, name := range path[:len(path)-1] {
+			parent, _ = parent[name].(map[string]interface{})
+		}
+		if _, ok := parent[path[len(path)-1]]; ok {
+			delete(parent, path[len(path)-1])
+			removed = true
+		}
+	}
+	if !removed {
+		return body
+	}
+
+	redacted, err := json.Marshal(fields)
+	if err != nil {
+		return body
+	}
+	return redacted
+}
+
+// idempotencyInProgress responds to a retry of a request still running
+func idempotencyInProgress(c echo.Context) error {
+	return c.JSON(http.StatusConflict, map[string]string{
+		"error":   "idempotency_request_in_progress",
+		"message": "A request with this Idempotency-Key is still in progress; retry later",
+	})
+}
+
+// idempotencyRedisKey scopes a client's idempotency key to a user and
+// endpoint. The key is hashed, since clients choose its characters.
+func idempotencyRedisKey(userID int, method, path, key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return fmt.Sprintf("idempotency:%d:%s:%s:%s", userID, method, path, hex.EncodeToString(hash[:]))
+}
+
+// requestFingerprint identifies a request's query and body
+func requestFingerprint(query string, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(query))
+	hash.Write([]byte{0})
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// responseRecorder passes a response through while keeping a copy of its
+// body, up to maxIdempotentResponseSize
+type responseRecorder struct {
+	http.ResponseWriter
+	body     bytes.Buffer
+	overflow bool
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	if !r.overflow {
+		if r.body.Len()+len(b) > maxIdempotentResponseSize {
+			r.overflow = true
+			r.body.Reset()
+		} else {
+			r.body.Write(b)
+		}
+	}
+	return r.ResponseWriter.Write(b)
+}
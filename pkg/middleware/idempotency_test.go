@@ -0,0 +1,165 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/jordanlanch/industrydb/pkg/cache"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupIdempotency(t *testing.T) (*miniredis.Miniredis, *echo.Echo, *int32) {
+	t.Helper()
+
+	mr := miniredis.RunT(t)
+	store, err := cache.NewClient("redis://" + mr.Addr())
+	require.NoError(t, err)
+	t.Cleanup(func() { store.Close() })
+
+	var calls int32
+	e := echo.New()
+	authenticate := func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if userID, err := strconv.Atoi(c.Request().Header.Get("X-Test-User")); err == nil {
+				c.Set("user_id", userID)
+			}
+			return next(c)
+		}
+	}
+	e.POST("/exports", func(c echo.Context) error {
+		n := atomic.AddInt32(&calls, 1)
+		if strings.Contains(c.QueryParam("fail"), "1") {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid"})
+		}
+		return c.JSON(http.StatusCreated, map[string]int32{"id": n})
+	}, authenticate, Idempotency(store, time.Hour))
+
+	return mr, e, &calls
+}
+
+func postExport(e *echo.Echo, userID, key, target, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, target, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set("X-Test-User", userID)
+	if key != "" {
+		req.Header.Set(IdempotencyKeyHeader, key)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestIdempotency_ReplaysFirstResponse(t *testing.T) {
+	mr, e, calls := setupIdempotency(t)
+
+	first := postExport(e, "1", "key-1", "/exports", `{"format":"csv"}`)
+	require.Equal(t, http.StatusCreated, first.Code)
+	assert.Empty(t, first.Header().Get(IdempotentReplayedHeader))
+
+	retry := postExport(e, "1", "key-1", "/exports", `{"format":"csv"}`)
+	assert.Equal(t, http.StatusCreated, retry.Code)
+	assert.Equal(t, "true", retry.Header().Get(IdempotentReplayedHeader))
+	assert.Equal(t, first.Body.String(), retry.Body.String())
+	assert.Equal(t, echo.MIMEApplicationJSON, retry.Header().Get(echo.HeaderContentType))
+	assert.Equal(t, int32(1), atomic.LoadInt32(calls))
+
+	// Keys are scoped per user
+	other := postExport(e, "2", "key-1", "/exports", `{"format":"csv"}`)
+	assert.Equal(t, http.StatusCreated, other.Code)
+	assert.Empty(t, other.Header().Get(IdempotentReplayedHeader))
+	assert.Equal(t, int32(2), atomic.LoadInt32(calls))
+
+	// Without a key every request runs
+	postExport(e, "1", "", "/exports", `{"format":"csv"}`)
+	assert.Equal(t, int32(3), atomic.LoadInt32(calls))
+
+	// After the TTL the key can be used again
+	mr.FastForward(time.Hour + time.Second)
+	again := postExport(e, "1", "key-1", "/exports", `{"format":"csv"}`)
+	assert.Empty(t, again.Header().Get(IdempotentReplayedHeader))
+	assert.Equal(t, int32(4), atomic.LoadInt32(calls))
+}
+
+func TestIdempotency_DoesNotStoreSecrets(t *testing.T) {
+	mr := miniredis.RunT(t)
+	store, err := cache.NewClient("redis://" + mr.Addr())
+	require.NoError(t, err)
+	t.Cleanup(func() { store.Close() })
+
+	e := echo.New()
+	e.POST("/api-keys", func(c echo.Context) error {
+		return c.JSON(http.StatusCreated, map[string]interface{}{
+			"api_key": map[string]interface{}{"id": 7, "name": "ci", "key": "idb_live_secret"},
+			"secret":  "whsec_secret",
+		})
+	}, func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			c.Set("user_id", 1)
+			return next(c)
+		}
+	}, Idempotency(store, time.Hour))
+
+	post := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api-keys", strings.NewReader(`{"name":"ci"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(IdempotencyKeyHeader, "key-1")
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	first := post()
+	require.Equal(t, http.StatusCreated, first.Code)
+	assert.Contains(t, first.Body.String(), "idb_live_secret")
+	assert.Contains(t, first.Body.String(), "whsec_secret")
+
+	// The replay is served from the stored response, which has no secrets
+	retry := post()
+	assert.Equal(t, http.StatusCreated, retry.Code)
+	assert.Equal(t, "true", retry.Header().Get(IdempotentReplayedHeader))
+	assert.JSONEq(t, `{"api_key":{"id":7,"name":"ci"}}`, retry.Body.String())
+}
+
+func TestIdempotency_MismatchedRequest(t *testing.T) {
+	_, e, calls := setupIdempotency(t)
+
+	require.Equal(t, http.StatusCreated, postExport(e, "1", "key-2", "/exports", `{"format":"csv"}`).Code)
+
+	rec := postExport(e, "1", "key-2", "/exports", `{"format":"excel"}`)
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Contains(t, rec.Body.String(), "idempotency_key_mismatch")
+
+	rec = postExport(e, "1", "key-2", "/exports?organization_id=5", `{"format":"csv"}`)
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Equal(t, int32(1), atomic.LoadInt32(calls))
+}
+
+func TestIdempotency_InProgressAndErrors(t *testing.T) {
+	mr, e, calls := setupIdempotency(t)
+
+	// A request holding the key makes retries wait
+	pending := `{"fingerprint":"` + requestFingerprint("", []byte(`{"format":"csv"}`)) + `","done":false}`
+	require.NoError(t, mr.Set(idempotencyRedisKey(1, http.MethodPost, "/exports", "key-3"), pending))
+	rec := postExport(e, "1", "key-3", "/exports", `{"format":"csv"}`)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.Equal(t, int32(0), atomic.LoadInt32(calls))
+
+	// Errors release the key, so a fixed request can reuse it
+	rec = postExport(e, "1", "key-4", "/exports?fail=1", `{}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = postExport(e, "1", "key-4", "/exports", `{"format":"csv"}`)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.Empty(t, rec.Header().Get(IdempotentReplayedHeader))
+	assert.Equal(t, int32(2), atomic.LoadInt32(calls))
+
+	rec = postExport(e, "1", strings.Repeat("k", maxIdempotencyKeyLength+1), "/exports", `{}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
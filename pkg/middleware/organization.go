@@ -59,57 +59,106 @@ func CheckOrganizationAccess(db *ent.Client, orgService *organization.Service) e
 				})
 			}
 
-			// Create context with timeout for database queries
-			ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
-			defer cancel()
+			if ok, err := loadOrganizationContext(c, db, orgService, userID, orgID); !ok {
+				return err
+			}
 
-			// Check if user is a member of the organization
-			isMember, role, err := orgService.CheckMembership(ctx, orgID, userID)
-			if err != nil {
-				return c.JSON(http.StatusInternalServerError, map[string]string{
-					"error":   "membership_check_failed",
-					"message": "Failed to verify organization membership",
-				})
+			// User is a member, continue to next handler
+			return next(c)
+		}
+	}
+}
+
+// OptionalOrganizationContext loads the organization context like
+// CheckOrganizationAccess when the request names an organization in the
+// organization_id query parameter, and lets other requests through without
+// one. Only the query parameter is read, so it is safe on routes whose :id
+// is not an organization.
+func OptionalOrganizationContext(db *ent.Client, orgService *organization.Service) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			orgIDStr := c.QueryParam("organization_id")
+			if orgIDStr == "" {
+				return next(c)
 			}
 
-			if !isMember {
-				return c.JSON(http.StatusForbidden, map[string]interface{}{
-					"error":   "not_organization_member",
-					"message": "You are not a member of this organization",
-					"details": map[string]interface{}{
-						"organization_id": orgID,
-						"user_id":         userID,
-					},
+			userID, ok := c.Get("user_id").(int)
+			if !ok {
+				return c.JSON(http.StatusUnauthorized, map[string]string{
+					"error":   "unauthorized",
+					"message": "Authentication required",
 				})
 			}
 
-			// Load organization details
-			org, err := db.Organization.Get(ctx, orgID)
+			orgID, err := strconv.Atoi(orgIDStr)
 			if err != nil {
-				if ent.IsNotFound(err) {
-					return c.JSON(http.StatusNotFound, map[string]string{
-						"error":   "organization_not_found",
-						"message": "Organization not found",
-					})
-				}
-				return c.JSON(http.StatusInternalServerError, map[string]string{
-					"error":   "organization_load_failed",
-					"message": "Failed to load organization details",
+				return c.JSON(http.StatusBadRequest, map[string]string{
+					"error":   "invalid_organization_id",
+					"message": "Organization ID must be a number",
 				})
 			}
 
-			// Store organization, organization_id, and user's role in context
-			// DDD: Organization is aggregate root, bounded context is maintained
-			c.Set("organization", org)
-			c.Set("organization_id", orgID)
-			c.Set("organization_role", role)
+			if ok, err := loadOrganizationContext(c, db, orgService, userID, orgID); !ok {
+				return err
+			}
 
-			// User is a member, continue to next handler
 			return next(c)
 		}
 	}
 }
 
+// loadOrganizationContext checks the user belongs to the organization and
+// stores it in the context. When it returns false the error response has
+// been written and its result should be returned.
+func loadOrganizationContext(c echo.Context, db *ent.Client, orgService *organization.Service, userID, orgID int) (bool, error) {
+	// Create context with timeout for database queries
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	// Check if user is a member of the organization
+	isMember, role, err := orgService.CheckMembership(ctx, orgID, userID)
+	if err != nil {
+		return false, c.JSON(http.StatusInternalServerError, map[string]string{
+			"error":   "membership_check_failed",
+			"message": "Failed to verify organization membership",
+		})
+	}
+
+	if !isMember {
+		return false, c.JSON(http.StatusForbidden, map[string]interface{}{
+			"error":   "not_organization_member",
+			"message": "You are not a member of this organization",
+			"details": map[string]interface{}{
+				"organization_id": orgID,
+				"user_id":         userID,
+			},
+		})
+	}
+
+	// Load organization details
+	org, err := db.Organization.Get(ctx, orgID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return false, c.JSON(http.StatusNotFound, map[string]string{
+				"error":   "organization_not_found",
+				"message": "Organization not found",
+			})
+		}
+		return false, c.JSON(http.StatusInternalServerError, map[string]string{
+			"error":   "organization_load_failed",
+			"message": "Failed to load organization details",
+		})
+	}
+
+	// Store organization, organization_id, and user's role in context
+	// DDD: Organization is aggregate root, bounded context is maintained
+	c.Set("organization", org)
+	c.Set("organization_id", orgID)
+	c.Set("organization_role", role)
+
+	return true, nil
+}
+
 // RequireOrganizationRole middleware ensures user has a specific role in the organization
 // Must be used AFTER CheckOrganizationAccess middleware
 //
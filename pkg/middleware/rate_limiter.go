@@ -15,6 +15,9 @@ type RateLimiter struct {
 	mu       sync.RWMutex
 	r        rate.Limit // requests per second
 	b        int        // burst
+
+	// Skip requests with an internal service token (see ServiceTokenMiddleware)
+	exemptInternal bool
 }
 
 // NewRateLimiter creates a new rate limiter
@@ -65,10 +68,20 @@ func (rl *RateLimiter) cleanupVisitors() {
 	}
 }
 
+// ExemptInternalServices lets requests with a valid internal service token
+// through unthrottled. ServiceTokenMiddleware must run first.
+func (rl *RateLimiter) ExemptInternalServices() {
+	rl.exemptInternal = true
+}
+
 // RateLimitMiddleware creates an Echo middleware for rate limiting
 func (rl *RateLimiter) RateLimitMiddleware() echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
+			if rl.exemptInternal && IsInternalService(c) {
+				return next(c)
+			}
+
 			// Get IP address
 			ip := c.RealIP()
 			if ip == "" {
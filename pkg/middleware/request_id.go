@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"regexp"
+
+	"github.com/jordanlanch/industrydb/pkg/logger"
+	"github.com/labstack/echo/v4"
+)
+
+// requestIDPattern matches the request IDs accepted from clients and proxies,
+// so they can be logged as is
+var requestIDPattern = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
+
+// RequestID returns a middleware that gives every request an ID: the
+// X-Request-ID header sent by the client or a proxy when it is a short
+// token, otherwise a generated one. The ID is returned in the X-Request-ID
+// response header and stored in the request context, where the slow query
+// log reads it (logger.RequestIDFromContext).
+func RequestID() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			requestID := req.Header.Get(echo.HeaderXRequestID)
+			if !requestIDPattern.MatchString(requestID) {
+				requestID = newRequestID()
+			}
+			req.Header.Set(echo.HeaderXRequestID, requestID)
+			c.Response().Header().Set(echo.HeaderXRequestID, requestID)
+			c.SetRequest(req.WithContext(logger.WithRequestID(req.Context(), requestID)))
+			return next(c)
+		}
+	}
+}
+
+// newRequestID returns a random 32 character hex ID
+func newRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jordanlanch/industrydb/pkg/logger"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func runRequestID(t *testing.T, incoming string) (contextID, responseID string) {
+	t.Helper()
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/test", nil)
+	if incoming != "" {
+		req.Header.Set(echo.HeaderXRequestID, incoming)
+	}
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	handler := RequestID()(func(c echo.Context) error {
+		contextID = logger.RequestIDFromContext(c.Request().Context())
+		return c.String(http.StatusOK, "OK")
+	})
+	require.NoError(t, handler(c))
+	return contextID, rec.Header().Get(echo.HeaderXRequestID)
+}
+
+func TestRequestID_Generated(t *testing.T) {
+	contextID, responseID := runRequestID(t, "")
+
+	assert.Len(t, contextID, 32)
+	assert.Equal(t, contextID, responseID)
+
+	other, _ := runRequestID(t, "")
+	assert.NotEqual(t, contextID, other)
+}
+
+func TestRequestID_FromProxy(t *testing.T) {
+	contextID, responseID := runRequestID(t, "lb-7f3a.42_x")
+
+	assert.Equal(t, "lb-7f3a.42_x", contextID)
+	assert.Equal(t, "lb-7f3a.42_x", responseID)
+}
+
+func TestRequestID_UnsafeIncomingReplaced(t *testing.T) {
+	for _, incoming := range []string{"id with spaces", "id\nforged log line", strings.Repeat("a", 65)} {
+		contextID, responseID := runRequestID(t, incoming)
+		assert.NotEqual(t, incoming, contextID)
+		assert.Len(t, contextID, 32)
+		assert.Equal(t, contextID, responseID)
+	}
+}
@@ -0,0 +1,82 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+// serveWithPolicy sends a request through the policy middleware and returns
+// the response headers
+func serveWithPolicy(policy SecurityPolicy, path string, tls bool) http.Header {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if tls {
+		req.Header.Set(echo.HeaderXForwardedProto, "https")
+	}
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	handler := SecurityPolicyMiddleware(policy)(func(c echo.Context) error {
+		return c.String(http.StatusOK, "OK")
+	})
+	_ = handler(c)
+	return rec.Header()
+}
+
+func TestSecurityPolicyFor_Development(t *testing.T) {
+	policy := SecurityPolicyFor("development")
+
+	assert.Equal(t, DefaultSecurityHeadersConfig(), policy.Headers)
+	assert.Zero(t, policy.HSTSMaxAge)
+	assert.Contains(t, policy.DocsContentSecurityPolicy, "script-src 'self' 'unsafe-inline' https://unpkg.com")
+	assert.NotContains(t, policy.Headers.ContentSecurityPolicy, "unpkg")
+}
+
+func TestSecurityPolicyFor_Production(t *testing.T) {
+	policy := SecurityPolicyFor("production")
+
+	assert.Equal(t, DefaultHSTSMaxAge, policy.HSTSMaxAge)
+	assert.Contains(t, policy.Headers.ContentSecurityPolicy, "upgrade-insecure-requests")
+	assert.Contains(t, policy.DocsContentSecurityPolicy, "upgrade-insecure-requests")
+	assert.NotContains(t, policy.Headers.ContentSecurityPolicy, "unpkg")
+}
+
+func TestSecurityPolicyMiddleware_HSTSOnlyOverTLS(t *testing.T) {
+	production := SecurityPolicyFor("production")
+
+	headers := serveWithPolicy(production, "/api/v1/leads", true)
+	assert.Equal(t, "max-age=31536000; includeSubdomains", headers.Get(echo.HeaderStrictTransportSecurity))
+
+	headers = serveWithPolicy(production, "/api/v1/leads", false)
+	assert.Empty(t, headers.Get(echo.HeaderStrictTransportSecurity))
+
+	headers = serveWithPolicy(SecurityPolicyFor("development"), "/api/v1/leads", true)
+	assert.Empty(t, headers.Get(echo.HeaderStrictTransportSecurity))
+}
+
+func TestSecurityPolicyMiddleware_DocsCSP(t *testing.T) {
+	policy := SecurityPolicyFor("development")
+
+	for _, path := range []string{"/docs", "/docs/swagger.yaml", "/swagger/index.html"} {
+		headers := serveWithPolicy(policy, path, false)
+		assert.Equal(t, policy.DocsContentSecurityPolicy, headers.Get("Content-Security-Policy"), path)
+	}
+
+	for _, path := range []string{"/", "/api/v1/leads", "/docsearch"} {
+		headers := serveWithPolicy(policy, path, false)
+		assert.Equal(t, policy.Headers.ContentSecurityPolicy, headers.Get("Content-Security-Policy"), path)
+	}
+}
+
+func TestSecurityPolicyMiddleware_StandardHeaders(t *testing.T) {
+	headers := serveWithPolicy(SecurityPolicyFor("development"), "/api/v1/leads", false)
+
+	assert.Equal(t, "nosniff", headers.Get(echo.HeaderXContentTypeOptions))
+	assert.Equal(t, "SAMEORIGIN", headers.Get(echo.HeaderXFrameOptions))
+	assert.Equal(t, "1; mode=block", headers.Get(echo.HeaderXXSSProtection))
+	assert.Equal(t, "strict-origin-when-cross-origin", headers.Get("Referrer-Policy"))
+}
@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/jordanlanch/industrydb/pkg/audit"
+	"github.com/jordanlanch/industrydb/pkg/auth"
+	"github.com/labstack/echo/v4"
+)
+
+// ServiceTokenHeader carries internal service tokens (see
+// auth.GenerateServiceToken). It is separate from Authorization, so the
+// request still authenticates as usual.
+const ServiceTokenHeader = "X-Service-Token"
+
+// internalServiceKey is the context key holding the service name of
+// requests with a valid service token
+const internalServiceKey = "internal_service"
+
+// ServiceTokenMiddleware recognizes internal service tokens so our own
+// workers and cron jobs are not throttled by limiters created with
+// ExemptInternalServices. It must run before those limiters. Tokens are
+// signed with secret, the internal service secret; with no secret service
+// tokens are ignored and every request is throttled as usual. A token that
+// fails validation is rejected rather than silently throttled. Every
+// exempted request is written to the audit log once it completes.
+func ServiceTokenMiddleware(secret string, auditLogger *audit.Service) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			token := c.Request().Header.Get(ServiceTokenHeader)
+			if token == "" || secret == "" {
+				return next(c)
+			}
+
+			claims, err := auth.ValidateServiceToken(token, secret)
+			if err != nil {
+				return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+					"error":   "invalid_service_token",
+					"message": "Invalid internal service token",
+				})
+			}
+
+			c.Set(internalServiceKey, claims.Service)
+			err = next(c)
+
+			if auditLogger != nil {
+				logServiceRequest(c, auditLogger, claims.Service)
+			}
+			return err
+		}
+	}
+}
+
+// IsInternalService reports whether the request carries a valid internal
+// service token
+func IsInternalService(c echo.Context) bool {
+	service, ok := c.Get(internalServiceKey).(string)
+	return ok && service != ""
+}
+
+// logServiceRequest records a request made with a service token, along
+// with the user it authenticated as, if any
+func logServiceRequest(c echo.Context, auditLogger *audit.Service, service string) {
+	var userID *int
+	if id, ok := c.Get("user_id").(int); ok {
+		userID = &id
+	}
+	resourceType := "internal_service"
+	ipAddress, userAgent := audit.GetRequestContext(c)
+	description := fmt.Sprintf("Internal service %s: %s %s", service, c.Request().Method, c.Path())
+
+	go auditLogger.Log(context.Background(), audit.LogEntry{
+		UserID:       userID,
+		Action:       "internal_service_request",
+		ResourceType: &resourceType,
+		ResourceID:   &service,
+		IPAddress:    &ipAddress,
+		UserAgent:    &userAgent,
+		Metadata: map[string]interface{}{
+			"service": service,
+			"method":  c.Request().Method,
+			"path":    c.Path(),
+			"status":  c.Response().Status,
+		},
+		Severity:    "info",
+		Description: &description,
+	})
+}
@@ -0,0 +1,96 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jordanlanch/industrydb/pkg/auth"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testServiceSecret = "internal-service-secret-minimum-32-chars"
+
+func serviceTokenChain(global *RateLimiter, tier *TierRateLimiter, secret string) echo.HandlerFunc {
+	handler := func(c echo.Context) error {
+		return c.String(http.StatusOK, "OK")
+	}
+	return ServiceTokenMiddleware(secret, nil)(global.RateLimitMiddleware()(tier.Middleware()(handler)))
+}
+
+func serve(e *echo.Echo, handler echo.HandlerFunc, token string) int {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = "10.0.0.1:1234"
+	if token != "" {
+		req.Header.Set(ServiceTokenHeader, token)
+	}
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	if err := handler(c); err != nil {
+		e.HTTPErrorHandler(err, c)
+	}
+	return rec.Code
+}
+
+func TestServiceTokenMiddleware_ExemptsFromRateLimits(t *testing.T) {
+	e := echo.New()
+	global := NewRateLimiter(1, 1)
+	tier := NewTierRateLimiter()
+	global.ExemptInternalServices()
+	tier.ExemptInternalServices()
+	handler := serviceTokenChain(global, tier, testServiceSecret)
+
+	token, err := auth.GenerateServiceToken("cron", testServiceSecret, time.Hour)
+	require.NoError(t, err)
+
+	for i := 0; i < 20; i++ {
+		assert.Equal(t, http.StatusOK, serve(e, handler, token), "service request %d should not be throttled", i+1)
+	}
+
+	// Customers from the same IP are still throttled
+	assert.Equal(t, http.StatusOK, serve(e, handler, ""))
+	assert.Equal(t, http.StatusTooManyRequests, serve(e, handler, ""))
+}
+
+func TestServiceTokenMiddleware_RejectsInvalidTokens(t *testing.T) {
+	e := echo.New()
+	global := NewRateLimiter(60, 10)
+	global.ExemptInternalServices()
+	handler := serviceTokenChain(global, NewTierRateLimiter(), testServiceSecret)
+
+	forged, err := auth.GenerateServiceToken("cron", "customer-guessed-secret-32-characters", time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, serve(e, handler, forged))
+
+	userJWT, err := auth.GenerateJWT(1, "user@example.com", "business", testServiceSecret, 1)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, serve(e, handler, userJWT))
+}
+
+func TestServiceTokenMiddleware_DisabledWithoutSecret(t *testing.T) {
+	e := echo.New()
+	global := NewRateLimiter(1, 1)
+	global.ExemptInternalServices()
+	handler := serviceTokenChain(global, NewTierRateLimiter(), "")
+
+	token, err := auth.GenerateServiceToken("cron", testServiceSecret, time.Hour)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, serve(e, handler, token))
+	assert.Equal(t, http.StatusTooManyRequests, serve(e, handler, token))
+}
+
+func TestRateLimiter_InternalServicesThrottledUnlessExempt(t *testing.T) {
+	e := echo.New()
+	login := NewRateLimiter(1, 1)
+	handler := serviceTokenChain(login, NewTierRateLimiter(), testServiceSecret)
+
+	token, err := auth.GenerateServiceToken("cron", testServiceSecret, time.Hour)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, serve(e, handler, token))
+	assert.Equal(t, http.StatusTooManyRequests, serve(e, handler, token))
+}
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"math"
 	"net/http"
 	"sync"
 	"time"
@@ -9,12 +10,27 @@ import (
 	"golang.org/x/time/rate"
 )
 
+// DefaultRateLimitWarningPercent is the share of the burst a client may use
+// before responses carry the X-RateLimit-Warning header
+const DefaultRateLimitWarningPercent = 80
+
+// RateLimitWarningHeader is set on responses to clients past their warning
+// threshold, including throttled ones
+const RateLimitWarningHeader = "X-RateLimit-Warning"
+
 // TierLimits defines rate limits for each subscription tier
 type TierLimits struct {
 	RequestsPerMinute int
 	Burst             int
+	// WarningPercent of the burst used before warning the client; 0 disables
+	WarningPercent int
 }
 
+// RateLimitWarningFunc is called when an authenticated user crosses their
+// tier's warning threshold. It is called once per crossing, and again only
+// after the user's usage has dropped back below the threshold.
+type RateLimitWarningFunc func(userID int, tier string, limits TierLimits)
+
 // TierRateLimiter implements tier-based rate limiting
 type TierRateLimiter struct {
 	// Limiters for authenticated users (by user ID)
@@ -28,6 +44,13 @@ type TierRateLimiter struct {
 
 	// Default limits for unauthenticated requests
 	defaultLimits TierLimits
+
+	// Skip requests with an internal service token (see ServiceTokenMiddleware)
+	exemptInternal bool
+
+	// Users past their warning threshold, notified through onWarning
+	warned    map[int]bool
+	onWarning RateLimitWarningFunc
 }
 
 // NewTierRateLimiter creates a new tier-based rate limiter
@@ -35,27 +58,33 @@ func NewTierRateLimiter() *TierRateLimiter {
 	trl := &TierRateLimiter{
 		userLimiters: make(map[int]*rate.Limiter),
 		ipLimiters:   make(map[string]*rate.Limiter),
+		warned:       make(map[int]bool),
 		tierLimits: map[string]TierLimits{
 			"free": {
 				RequestsPerMinute: 60,  // 1 request per second
 				Burst:             10,  // Allow burst of 10
+				WarningPercent:    DefaultRateLimitWarningPercent,
 			},
 			"starter": {
 				RequestsPerMinute: 120, // 2 requests per second
 				Burst:             20,
+				WarningPercent:    DefaultRateLimitWarningPercent,
 			},
 			"pro": {
 				RequestsPerMinute: 300, // 5 requests per second
 				Burst:             50,
+				WarningPercent:    DefaultRateLimitWarningPercent,
 			},
 			"business": {
 				RequestsPerMinute: 600,  // 10 requests per second
 				Burst:             100,  // Allow larger bursts
+				WarningPercent:    DefaultRateLimitWarningPercent,
 			},
 		},
 		defaultLimits: TierLimits{
 			RequestsPerMinute: 30, // Unauthenticated users: 30 req/min
 			Burst:             5,
+			WarningPercent:    DefaultRateLimitWarningPercent,
 		},
 	}
 
@@ -117,6 +146,7 @@ func (trl *TierRateLimiter) cleanupLimiters() {
 			// If limiter has full burst tokens, it hasn't been used recently
 			if limiter.Tokens() >= float64(limiter.Burst()) {
 				delete(trl.userLimiters, userID)
+				delete(trl.warned, userID)
 			}
 		}
 
@@ -131,19 +161,77 @@ func (trl *TierRateLimiter) cleanupLimiters() {
 	}
 }
 
+// ExemptInternalServices lets requests with a valid internal service token
+// through unthrottled. ServiceTokenMiddleware must run first.
+func (trl *TierRateLimiter) ExemptInternalServices() {
+	trl.exemptInternal = true
+}
+
+// OnWarning sets the function notified when a user crosses their warning
+// threshold, e.g. to send a webhook. It runs in its own goroutine.
+func (trl *TierRateLimiter) OnWarning(fn RateLimitWarningFunc) {
+	trl.onWarning = fn
+}
+
+// pastWarning reports whether a limiter has used at least percent of its
+// burst, counting only whole requests left. Throttled limiters always are.
+func pastWarning(limiter *rate.Limiter, percent int) bool {
+	if percent <= 0 {
+		return false
+	}
+	used := limiter.Burst() - int(math.Floor(limiter.Tokens()))
+	return used*100 >= limiter.Burst()*percent
+}
+
+// limitsForTier returns the limits of a tier, defaulting to free
+func (trl *TierRateLimiter) limitsForTier(tier string) TierLimits {
+	trl.mu.RLock()
+	defer trl.mu.RUnlock()
+
+	limits, exists := trl.tierLimits[tier]
+	if !exists {
+		limits = trl.tierLimits["free"]
+	}
+	return limits
+}
+
+// trackWarning records whether a user is past their warning threshold and
+// reports whether they just crossed it
+func (trl *TierRateLimiter) trackWarning(userID int, past bool) bool {
+	trl.mu.Lock()
+	defer trl.mu.Unlock()
+
+	if !past {
+		delete(trl.warned, userID)
+		return false
+	}
+	if trl.warned[userID] {
+		return false
+	}
+	trl.warned[userID] = true
+	return true
+}
+
 // Middleware creates an Echo middleware for tier-based rate limiting
 func (trl *TierRateLimiter) Middleware() echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
+			if trl.exemptInternal && IsInternalService(c) {
+				return next(c)
+			}
+
 			var limiter *rate.Limiter
+			var limits TierLimits
 
 			// Try to get user ID and tier from context (set by auth middleware)
 			userID, hasUserID := c.Get("user_id").(int)
 			tier, hasTier := c.Get("user_tier").(string)
+			authenticated := hasUserID && hasTier
 
-			if hasUserID && hasTier {
+			if authenticated {
 				// Authenticated user - use tier-based limiting
 				limiter = trl.getUserLimiter(userID, tier)
+				limits = trl.limitsForTier(tier)
 			} else {
 				// Unauthenticated user - use IP-based limiting
 				ip := c.RealIP()
@@ -151,10 +239,23 @@ func (trl *TierRateLimiter) Middleware() echo.MiddlewareFunc {
 					ip = c.Request().RemoteAddr
 				}
 				limiter = trl.getIPLimiter(ip)
+				limits = trl.defaultLimits
+			}
+
+			allowed := limiter.Allow()
+
+			// Warn clients nearing the limit, whether or not this request
+			// is throttled
+			past := pastWarning(limiter, limits.WarningPercent)
+			if past {
+				c.Response().Header().Set(RateLimitWarningHeader, "true")
+			}
+			if authenticated && trl.trackWarning(userID, past) && trl.onWarning != nil {
+				go trl.onWarning(userID, tier, limits)
 			}
 
 			// Check if request is allowed
-			if !limiter.Allow() {
+			if !allowed {
 				// Get user tier for error message
 				tierInfo := "unauthenticated"
 				if hasTier {
@@ -187,8 +288,26 @@ func (trl *TierRateLimiter) SetTierLimits(tier string, requestsPerMinute, burst
 	trl.mu.Lock()
 	defer trl.mu.Unlock()
 
+	warningPercent := DefaultRateLimitWarningPercent
+	if limits, exists := trl.tierLimits[tier]; exists {
+		warningPercent = limits.WarningPercent
+	}
+
 	trl.tierLimits[tier] = TierLimits{
 		RequestsPerMinute: requestsPerMinute,
 		Burst:             burst,
+		WarningPercent:    warningPercent,
+	}
+}
+
+// SetTierWarningPercent sets the share of a tier's burst used before
+// warning; 0 disables warnings for the tier. Unknown tiers are ignored.
+func (trl *TierRateLimiter) SetTierWarningPercent(tier string, percent int) {
+	trl.mu.Lock()
+	defer trl.mu.Unlock()
+
+	if limits, exists := trl.tierLimits[tier]; exists {
+		limits.WarningPercent = percent
+		trl.tierLimits[tier] = limits
 	}
 }
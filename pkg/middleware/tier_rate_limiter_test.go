@@ -221,3 +221,97 @@ func TestTierRateLimiter_TokenRefill(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, http.StatusOK, rec.Code, "Request should succeed after token refill")
 }
+
+func TestTierRateLimiter_Warning(t *testing.T) {
+	trl := NewTierRateLimiter()
+	trl.SetTierLimits("slow", 1, 10) // Practically no refill during the test
+
+	warnings := make(chan TierLimits, 10)
+	trl.OnWarning(func(userID int, tier string, limits TierLimits) {
+		assert.Equal(t, 1, userID)
+		assert.Equal(t, "slow", tier)
+		warnings <- limits
+	})
+
+	e := echo.New()
+	handler := trl.Middleware()(func(c echo.Context) error {
+		return c.String(http.StatusOK, "OK")
+	})
+
+	for i := 1; i <= 12; i++ {
+		req := httptest.NewRequest(http.MethodGet, "/test", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", 1)
+		c.Set("user_tier", "slow")
+		assert.NoError(t, handler(c))
+
+		switch {
+		case i < 8:
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Empty(t, rec.Header().Get(RateLimitWarningHeader), "request %d", i)
+		case i <= 10:
+			// 80% of the burst used: warned before being throttled
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, "true", rec.Header().Get(RateLimitWarningHeader), "request %d", i)
+		default:
+			// The warning is still sent on throttled requests
+			assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+			assert.Equal(t, "true", rec.Header().Get(RateLimitWarningHeader), "request %d", i)
+		}
+	}
+
+	// Notified once per crossing
+	select {
+	case limits := <-warnings:
+		assert.Equal(t, DefaultRateLimitWarningPercent, limits.WarningPercent)
+	case <-time.After(time.Second):
+		t.Fatal("expected a warning notification")
+	}
+	select {
+	case <-warnings:
+		t.Fatal("expected a single warning notification")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestTierRateLimiter_WarningPercent(t *testing.T) {
+	trl := NewTierRateLimiter()
+	trl.SetTierLimits("slow", 1, 10)
+	e := echo.New()
+	handler := trl.Middleware()(func(c echo.Context) error {
+		return c.String(http.StatusOK, "OK")
+	})
+
+	request := func(userID int) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/test", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", userID)
+		c.Set("user_tier", "slow")
+		assert.NoError(t, handler(c))
+		return rec
+	}
+
+	t.Run("Custom threshold", func(t *testing.T) {
+		trl.SetTierWarningPercent("slow", 50)
+		for i := 1; i <= 4; i++ {
+			assert.Empty(t, request(1).Header().Get(RateLimitWarningHeader))
+		}
+		assert.Equal(t, "true", request(1).Header().Get(RateLimitWarningHeader))
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		trl.SetTierWarningPercent("slow", 0)
+		for i := 1; i <= 11; i++ {
+			assert.Empty(t, request(2).Header().Get(RateLimitWarningHeader))
+		}
+	})
+
+	t.Run("Kept when changing limits", func(t *testing.T) {
+		trl.SetTierWarningPercent("slow", 30)
+		trl.SetTierLimits("slow", 2, 10)
+		limits, _ := trl.GetTierLimits("slow")
+		assert.Equal(t, 30, limits.WarningPercent)
+	})
+}
@@ -36,6 +36,15 @@ type UserInfo struct {
 type ErrorResponse struct {
 	Error   string `json:"error"`
 	Message string `json:"message,omitempty"`
+	// Fields lists the invalid request fields of a validation_error
+	Fields []FieldError `json:"fields,omitempty"`
+}
+
+// FieldError describes an invalid request field
+type FieldError struct {
+	Field   string `json:"field"`   // JSON name, nested fields joined by "."
+	Rule    string `json:"rule"`    // Failed validation rule (required, max, oneof...)
+	Message string `json:"message"` // Human-readable description
 }
 
 // SuccessResponse represents a success response
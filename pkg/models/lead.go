@@ -1,10 +1,13 @@
 package models
 
+import "time"
+
 // LeadSearchRequest represents search parameters for leads
 type LeadSearchRequest struct {
 	// Full-text search
 	Query       string   `query:"q"`
 	Industry    string   `query:"industry" validate:"omitempty,oneof=tattoo beauty barber gym restaurant cafe bar bakery dentist pharmacy massage car_repair car_wash car_dealer clothing convenience lawyer accountant spa nail_salon"`
+	Industries  []string `query:"industries" validate:"omitempty,dive,oneof=tattoo beauty barber gym restaurant cafe bar bakery dentist pharmacy massage car_repair car_wash car_dealer clothing convenience lawyer accountant spa nail_salon"`
 	SubNiche    string   `query:"sub_niche"`
 	Specialties []string `query:"specialties"`
 	CuisineType string   `query:"cuisine_type"`
@@ -15,42 +18,94 @@ type LeadSearchRequest struct {
 	HasEmail       *bool    `query:"has_email"`
 	HasPhone       *bool    `query:"has_phone"`
 	HasWebsite     *bool    `query:"has_website"`
+	HasAddress     *bool    `query:"has_address"`
+	// Only leads missing all of these fields (email, phone, website,
+	// address). has_<field>=false doesn't filter, for compatibility.
+	Missing        []string `query:"missing" validate:"omitempty,dive,oneof=email phone website address"`
 	HasSocialMedia *bool    `query:"has_social_media"`
 	Verified       *bool    `query:"verified"`
+	Source         string   `query:"source" validate:"omitempty,oneof=osm csv_import manual"`
+	// Only leads created or updated after this time (RFC3339)
+	UpdatedSince *time.Time `query:"updated_since"`
+	// With UpdatedSince, also leads updated exactly at UpdatedSince with a
+	// larger ID, so a delta export resumes after the last lead it covered.
+	// Set by the server.
+	UpdatedSinceID *int `json:"-"`
+	// Quality score range (0-100, inclusive)
+	MinQuality *int `query:"min_quality" validate:"omitempty,min=0,max=100"`
+	MaxQuality *int `query:"max_quality" validate:"omitempty,min=0,max=100"`
+	// Completeness score range (0-100, inclusive)
+	MinCompleteness *int `query:"min_completeness" validate:"omitempty,min=0,max=100"`
+	MaxCompleteness *int `query:"max_completeness" validate:"omitempty,min=0,max=100"`
 	// Radius search parameters
 	Latitude  *float64 `query:"latitude" validate:"omitempty,min=-90,max=90"`
 	Longitude *float64 `query:"longitude" validate:"omitempty,min=-180,max=180"`
 	Radius    *float64 `query:"radius" validate:"omitempty,min=0"`
 	Unit      string   `query:"unit" validate:"omitempty,oneof=km miles"`
+	// Bounding box search: "min_lng,min_lat,max_lng,max_lat" in degrees
+	// (GeoJSON bbox order), e.g. the map viewport
+	BBox string `query:"bbox"`
 	// Sorting
-	SortBy string `query:"sort_by" validate:"omitempty,oneof=newest quality_score distance verified relevance"`
+	SortBy string `query:"sort_by" validate:"omitempty,oneof=newest quality_score completeness_score distance verified relevance updated_at"`
 	Page   int    `query:"page" validate:"min=1"`
-	Limit  int    `query:"limit" validate:"min=1,max=100"`
+	Limit  int    `query:"limit" validate:"min=1"` // Clamped to MaxLimit
+	// Largest page the searcher may request, set by the server from their
+	// tier (0 means leads.DefaultMaxPageSize)
+	MaxLimit int `json:"-"`
+	// Leads suppressed by the searching user, set by the server
+	ExcludeLeadIDs []int `json:"-"`
+	// Searcher's active organization, set by the server. When lead scoping
+	// is on, the search only matches the leads it owns or licenses, or only
+	// unowned leads when nil.
+	OrgScope *int `json:"-"`
+	// Lead fields left out of the results, set by the server from the
+	// searcher's tier
+	HiddenFields []string `json:"-"`
+}
+
+// PublicLeadPreviewRequest represents an anonymous lead preview request
+type PublicLeadPreviewRequest struct {
+	Industry string `query:"industry" validate:"required,oneof=tattoo beauty barber gym restaurant cafe bar bakery dentist pharmacy massage car_repair car_wash car_dealer clothing convenience lawyer accountant spa nail_salon"`
+	Country  string `query:"country" validate:"omitempty,len=2"`
+	City     string `query:"city" validate:"required,max=100"`
 }
 
 // LeadResponse represents a single lead in API responses
 type LeadResponse struct {
-	ID           int               `json:"id"`
-	Name         string            `json:"name"`
-	Industry     string            `json:"industry"`
-	SubNiche     string            `json:"sub_niche,omitempty"`
-	Specialties  []string          `json:"specialties,omitempty"`
-	CuisineType  string            `json:"cuisine_type,omitempty"`
-	SportType    string            `json:"sport_type,omitempty"`
-	TattooStyle  string            `json:"tattoo_style,omitempty"`
-	Country      string            `json:"country"`
-	City         string            `json:"city"`
-	Address      string            `json:"address,omitempty"`
-	PostalCode   string            `json:"postal_code,omitempty"`
-	Phone        string            `json:"phone,omitempty"`
-	Email        string            `json:"email,omitempty"`
-	Website      string            `json:"website,omitempty"`
-	SocialMedia  map[string]string `json:"social_media,omitempty"`
-	Latitude     float64           `json:"latitude,omitempty"`
-	Longitude    float64           `json:"longitude,omitempty"`
-	Verified     bool              `json:"verified"`
-	QualityScore int               `json:"quality_score"`
-	CreatedAt    string            `json:"created_at"`
+	ID            int               `json:"id"`
+	Name          string            `json:"name"`
+	Industry      string            `json:"industry"`
+	SubNiche      string            `json:"sub_niche,omitempty"`
+	Specialties   []string          `json:"specialties,omitempty"`
+	CuisineType   string            `json:"cuisine_type,omitempty"`
+	SportType     string            `json:"sport_type,omitempty"`
+	TattooStyle   string            `json:"tattoo_style,omitempty"`
+	Country       string            `json:"country"`
+	City          string            `json:"city"`
+	Address       string            `json:"address,omitempty"`
+	PostalCode    string            `json:"postal_code,omitempty"`
+	Phone         string            `json:"phone,omitempty"`
+	Email         string            `json:"email,omitempty"`
+	Website       string            `json:"website,omitempty"`
+	// Set when email and phone are masked by the organization's policy
+	ContactMasked bool              `json:"contact_masked,omitempty"`
+	SocialMedia   map[string]string `json:"social_media,omitempty"`
+	Tags          []string          `json:"tags,omitempty"`
+	// Owning organization when lead scoping is on, unset for the global pool
+	OwnerOrganizationID *int `json:"owner_organization_id,omitempty"`
+	Latitude      float64           `json:"latitude,omitempty"`
+	Longitude     float64           `json:"longitude,omitempty"`
+	Verified      bool              `json:"verified"`
+	VerifiedSince string            `json:"verified_since,omitempty"`
+	VerifiedBy    *int              `json:"verified_by,omitempty"`
+	QualityScore  int               `json:"quality_score"`
+	// Share of email, phone, website, address and coordinates populated
+	CompletenessScore int    `json:"completeness_score"`
+	Source            string `json:"source"`
+	SourceID          string `json:"source_id,omitempty"`
+	LastSeenAt        string `json:"last_seen_at,omitempty"`
+	CreatedAt         string `json:"created_at"`
+	UpdatedAt         string `json:"updated_at"`
 }
 
 // LeadListResponse represents a paginated list of leads
@@ -58,6 +113,13 @@ type LeadListResponse struct {
 	Data       []LeadResponse   `json:"data"`
 	Pagination PaginationInfo   `json:"pagination"`
 	Filters    AppliedFilters   `json:"filters"`
+	// Lead fields left out for the searcher's tier, available from the lead
+	// detail view
+	HiddenFields []string `json:"hidden_fields,omitempty"`
+	// Set when a bounding box search matched more leads than can be paged
+	// through: pagination stops at leads.MaxBoundingBoxResults, zoom in to
+	// see the rest
+	ZoomIn bool `json:"zoom_in,omitempty"`
 }
 
 // PaginationInfo contains pagination metadata
@@ -68,11 +130,15 @@ type PaginationInfo struct {
 	TotalPages int `json:"total_pages"`
 	HasNext    bool `json:"has_next"`
 	HasPrev    bool `json:"has_prev"`
+	// Set when the requested limit exceeded the maximum page size and was clamped
+	LimitClamped   bool `json:"limit_clamped,omitempty"`
+	RequestedLimit int  `json:"requested_limit,omitempty"`
 }
 
 // AppliedFilters shows what filters were applied to the search
 type AppliedFilters struct {
 	Industry    string   `json:"industry,omitempty"`
+	Industries  []string `json:"industries,omitempty"`
 	SubNiche    string   `json:"sub_niche,omitempty"`
 	Specialties []string `json:"specialties,omitempty"`
 	CuisineType string   `json:"cuisine_type,omitempty"`
@@ -83,15 +149,60 @@ type AppliedFilters struct {
 	HasEmail       *bool    `json:"has_email,omitempty"`
 	HasPhone       *bool    `json:"has_phone,omitempty"`
 	HasWebsite     *bool    `json:"has_website,omitempty"`
+	HasAddress     *bool    `json:"has_address,omitempty"`
+	Missing        []string `json:"missing,omitempty"`
 	HasSocialMedia *bool    `json:"has_social_media,omitempty"`
 	Verified       *bool    `json:"verified,omitempty"`
+	Source         string   `json:"source,omitempty"`
+	UpdatedSince   string   `json:"updated_since,omitempty"`
+	MinQuality     *int     `json:"min_quality,omitempty"`
+	MaxQuality     *int     `json:"max_quality,omitempty"`
+	MinCompleteness *int    `json:"min_completeness,omitempty"`
+	MaxCompleteness *int    `json:"max_completeness,omitempty"`
+	BBox            string  `json:"bbox,omitempty"`
+	// Set when Country was defaulted from the searcher's geo-IP location;
+	// pass country= (empty) to search globally
+	CountryDefaulted bool `json:"country_defaulted,omitempty"`
+}
+
+// LeadCountResponse is the number of leads matching a search
+type LeadCountResponse struct {
+	Count int  `json:"count"`
+	Exact bool `json:"exact"` // false when Count is a planner estimate
 }
 
 // ExportRequest represents an export request
 type ExportRequest struct {
-	Format      string             `json:"format" validate:"required,oneof=csv excel"`
+	Format      string             `json:"format" validate:"required,oneof=csv excel vcard geojson kml"`
 	Filters     LeadSearchRequest  `json:"filters"`
 	MaxLeads    int                `json:"max_leads" validate:"min=1,max=10000"`
+	// Delta export: only leads created/updated after Since, or after the
+	// high-water mark of the last ready export with the same filters
+	Since           *time.Time `json:"since,omitempty"`
+	SinceLastExport bool       `json:"since_last_export,omitempty"`
+	// ID of the last lead covered at Since, set from the last export's
+	// high-water mark with SinceLastExport
+	SinceLeadID *int `json:"-"`
+	// Optional URL the completed file is POSTed to (signed like webhooks)
+	DeliveryURL string `json:"delivery_url,omitempty" validate:"omitempty,url,max=2048"`
+	// Secret to sign the delivery with instead of a new one, set by
+	// scheduled exports so every run is signed alike
+	DeliverySecret string `json:"-"`
+	// Optional google_sheets delivery to the user's connected Google account:
+	// appends to SpreadsheetID, or creates a new spreadsheet when empty
+	Delivery      string `json:"delivery,omitempty" validate:"omitempty,oneof=google_sheets"`
+	SpreadsheetID string `json:"spreadsheet_id,omitempty" validate:"omitempty,max=200"`
+	// Columns to include, in order (default: all columns)
+	Columns []string `json:"columns,omitempty" validate:"omitempty,max=50"`
+	// Leads suppressed by the user: omit them (default) or include them
+	// with a Suppressed column
+	Suppressed string `json:"suppressed,omitempty" validate:"omitempty,oneof=omit annotate"`
+	// Named redaction profile nulling or hashing fields the recipient may
+	// not see (default: none)
+	RedactionProfile string `json:"redaction_profile,omitempty" validate:"omitempty,max=50"`
+	// Compress the file as gzip (a .gz served with Content-Encoding: gzip)
+	// or zip (a .zip with a single entry). Default: uncompressed
+	Compress string `json:"compress,omitempty" validate:"omitempty,oneof=gzip zip"`
 }
 
 // ExportResponse represents an export response
@@ -103,6 +214,36 @@ type ExportResponse struct {
 	FileURL     string `json:"file_url,omitempty"`
 	ExpiresAt   string `json:"expires_at,omitempty"`
 	CreatedAt   string `json:"created_at"`
+	Since         string `json:"since,omitempty"`           // Lower bound of a delta export
+	HighWaterMark string `json:"high_water_mark,omitempty"` // Resume point for the next delta export
+	DeliveryMethod   string `json:"delivery_method,omitempty"` // url or google_sheets
+	DeliveryURL      string `json:"delivery_url,omitempty"`
+	SheetURL         string `json:"sheet_url,omitempty"` // Spreadsheet a google_sheets export was written to
+	DeliverySecret   string `json:"delivery_secret,omitempty"` // Only returned on creation
+	DeliveryStatus   string `json:"delivery_status,omitempty"` // pending, delivered, failed
+	DeliveryAttempts int    `json:"delivery_attempts,omitempty"`
+	DeliveredAt      string `json:"delivered_at,omitempty"`
+	DeliveryError    string `json:"delivery_error,omitempty"`
+	DeliveryWarning  string `json:"delivery_warning,omitempty"` // e.g. rows dropped at the Sheets cell limit
+	QueuePosition    int    `json:"queue_position,omitempty"` // 1-based position while waiting for a worker
+	SkippedCount     int    `json:"skipped_count,omitempty"` // Leads without coordinates left out of geojson/kml
+	RedactionProfile string `json:"redaction_profile,omitempty"` // Redaction profile applied to the file
+	Compression      string `json:"compression,omitempty"` // gzip or zip, omitted when uncompressed
+	DownloadCount    int    `json:"download_count"`
+	LastDownloadedAt string `json:"last_downloaded_at,omitempty"`
+}
+
+// ExportEstimateResponse is what an export would contain and cost, without creating it
+type ExportEstimateResponse struct {
+	MatchingCount    int    `json:"matching_count"`             // Leads matching the filters
+	Exact            bool   `json:"exact"`                      // False when matching_count is a planner estimate
+	EstimatedRows    int    `json:"estimated_rows"`             // Rows after max_leads and the tier's row cap
+	QuotaCost        int    `json:"quota_cost"`                 // Lead credits the export would use
+	Remaining        int    `json:"remaining"`                  // Lead credits left this period
+	ExceedsRemaining bool   `json:"exceeds_remaining"`          // quota_cost is more than remaining
+	Tier             string `json:"tier"`                       // Tier whose caps apply
+	RowCap           int    `json:"row_cap,omitempty"`          // Per-export row cap, omitted when unlimited
+	RowCapExceeded   bool   `json:"row_cap_exceeded,omitempty"` // max_leads is above row_cap, so creating the export would be rejected
 }
 
 // ExportListResponse represents a list of exports
@@ -130,4 +271,7 @@ type LeadPreviewResponse struct {
 	VerifiedCount   int     `json:"verified_count"`
 	VerifiedPct     float64 `json:"verified_pct"`
 	QualityScoreAvg float64 `json:"quality_score_avg"`
+	// Per-export row cap of the caller's tier (omitted when unlimited)
+	ExportRowCap int    `json:"export_row_cap,omitempty"`
+	Warning      string `json:"warning,omitempty"`
 }
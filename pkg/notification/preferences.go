@@ -0,0 +1,242 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/usernotificationpreference"
+)
+
+// Notification event types users can set channel preferences for
+const (
+	EventSecurity       = "security"
+	EventBilling        = "billing"
+	EventLeadAssigned   = "lead_assigned"
+	EventLeadSLAOverdue = "lead_sla_overdue"
+	EventMention        = "mention"
+	EventDigest         = "digest"
+	EventMarketing      = "marketing"
+	EventRateLimit      = "rate_limit_warning"
+)
+
+// Notification channels
+const (
+	ChannelEmail   = "email"
+	ChannelWebhook = "webhook"
+)
+
+// ErrUnknownEventType is returned when updating a preference for an event
+// type that does not exist.
+var ErrUnknownEventType = errors.New("unknown notification event type")
+
+// ErrLockedEventType is returned when trying to change a preference that
+// cannot be turned off, such as security emails.
+var ErrLockedEventType = errors.New("notification event type cannot be changed")
+
+// EventType describes a notification event and its default channels.
+type EventType struct {
+	Name        string
+	Description string
+	Email       bool
+	Webhook     bool
+	// Locked event types always use their defaults
+	Locked bool
+}
+
+// EventTypes lists every notification event type, in the order they are
+// shown to users. Users who never set a preference get these defaults.
+var EventTypes = []EventType{
+	{Name: EventSecurity, Description: "Email verification, password resets and account security", Email: true, Locked: true},
+	{Name: EventBilling, Description: "Subscription activations, renewals, cancellations and failed payments", Email: true},
+	{Name: EventLeadAssigned, Description: "A lead is assigned to you", Email: true, Webhook: true},
+	{Name: EventLeadSLAOverdue, Description: "A lead assigned to you is overdue in its status", Email: true},
+	{Name: EventMention, Description: "A teammate mentions you", Email: true},
+	{Name: EventDigest, Description: "Periodic activity digests", Email: true},
+	{Name: EventRateLimit, Description: "Your API usage nears your plan's rate limit", Webhook: true},
+	{Name: EventMarketing, Description: "Product news and offers"},
+}
+
+// LookupEventType returns the event type with the given name.
+func LookupEventType(name string) (EventType, bool) {
+	for _, et := range EventTypes {
+		if et.Name == name {
+			return et, true
+		}
+	}
+	return EventType{}, false
+}
+
+// Preference is a user's effective channel settings for one event type.
+type Preference struct {
+	EventType   string `json:"event_type"`
+	Description string `json:"description"`
+	Email       bool   `json:"email"`
+	Webhook     bool   `json:"webhook"`
+	Locked      bool   `json:"locked"`
+}
+
+// UpdatePreferenceRequest changes the channels of one event type. Omitted
+// channels keep their current setting.
+type UpdatePreferenceRequest struct {
+	EventType string `json:"event_type" validate:"required"`
+	Email     *bool  `json:"email,omitempty"`
+	Webhook   *bool  `json:"webhook,omitempty"`
+}
+
+// Service handles notification preference operations.
+type Service struct {
+	client *ent.Client
+}
+
+// NewService creates a new notification preference service.
+func NewService(client *ent.Client) *Service {
+	return &Service{client: client}
+}
+
+// Get returns the user's preferences for every event type, with defaults
+// filled in for event types the user never set.
+func (s *Service) Get(ctx context.Context, userID int) ([]Preference, error) {
+	rows, err := s.client.UserNotificationPreference.
+		Query().
+		Where(usernotificationpreference.UserID(userID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notification preferences: %w", err)
+	}
+
+	stored := make(map[string]*ent.UserNotificationPreference, len(rows))
+	for _, row := range rows {
+		stored[row.EventType] = row
+	}
+
+	prefs := make([]Preference, 0, len(EventTypes))
+	for _, et := range EventTypes {
+		pref := Preference{
+			EventType:   et.Name,
+			Description: et.Description,
+			Email:       et.Email,
+			Webhook:     et.Webhook,
+			Locked:      et.Locked,
+		}
+		if row, ok := stored[et.Name]; ok && !et.Locked {
+			pref.Email = row.Email
+			pref.Webhook = row.Webhook
+		}
+		prefs = append(prefs, pref)
+	}
+
+	return prefs, nil
+}
+
+// Update applies the requested changes and returns the resulting
+// preferences. Nothing is saved if any event type is unknown or locked.
+func (s *Service) Update(ctx context.Context, userID int, updates []UpdatePreferenceRequest) ([]Preference, error) {
+	for _, u := range updates {
+		et, ok := LookupEventType(u.EventType)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownEventType, u.EventType)
+		}
+		if et.Locked {
+			return nil, fmt.Errorf("%w: %s", ErrLockedEventType, u.EventType)
+		}
+	}
+
+	current, err := s.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	effective := make(map[string]Preference, len(current))
+	for _, pref := range current {
+		effective[pref.EventType] = pref
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	for _, u := range updates {
+		pref := effective[u.EventType]
+		if u.Email != nil {
+			pref.Email = *u.Email
+		}
+		if u.Webhook != nil {
+			pref.Webhook = *u.Webhook
+		}
+		effective[u.EventType] = pref
+
+		existing, err := tx.UserNotificationPreference.
+			Query().
+			Where(
+				usernotificationpreference.UserID(userID),
+				usernotificationpreference.EventType(u.EventType),
+			).
+			Only(ctx)
+		switch {
+		case err == nil:
+			_, err = tx.UserNotificationPreference.UpdateOne(existing).
+				SetEmail(pref.Email).
+				SetWebhook(pref.Webhook).
+				Save(ctx)
+		case ent.IsNotFound(err):
+			_, err = tx.UserNotificationPreference.Create().
+				SetUserID(userID).
+				SetEventType(u.EventType).
+				SetEmail(pref.Email).
+				SetWebhook(pref.Webhook).
+				Save(ctx)
+		}
+		if err != nil {
+			tx.Rollback()
+			return nil, fmt.Errorf("failed to save notification preference: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	prefs := make([]Preference, 0, len(current))
+	for _, pref := range current {
+		prefs = append(prefs, effective[pref.EventType])
+	}
+	return prefs, nil
+}
+
+// Allows reports whether the user wants notifications of the event type on
+// the channel. Unknown event types are allowed; if the lookup fails the
+// event type's default is used.
+func (s *Service) Allows(ctx context.Context, userID int, eventType, channel string) bool {
+	et, ok := LookupEventType(eventType)
+	if !ok {
+		return true
+	}
+
+	email, webhook := et.Email, et.Webhook
+	if !et.Locked {
+		pref, err := s.client.UserNotificationPreference.
+			Query().
+			Where(
+				usernotificationpreference.UserID(userID),
+				usernotificationpreference.EventType(eventType),
+			).
+			Only(ctx)
+		switch {
+		case err == nil:
+			email, webhook = pref.Email, pref.Webhook
+		case !ent.IsNotFound(err):
+			log.Printf("Failed to get notification preference %s for user %d: %v", eventType, userID, err)
+		}
+	}
+
+	switch channel {
+	case ChannelEmail:
+		return email
+	case ChannelWebhook:
+		return webhook
+	}
+	return true
+}
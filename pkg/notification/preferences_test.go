@@ -0,0 +1,127 @@
+package notification
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestDB(t *testing.T) (*ent.Client, func()) {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	return client, func() { client.Close() }
+}
+
+func createTestUser(t *testing.T, client *ent.Client, email string) *ent.User {
+	user, err := client.User.
+		Create().
+		SetEmail(email).
+		SetPasswordHash("hashed_password").
+		SetName("Test User").
+		Save(context.Background())
+	require.NoError(t, err)
+	return user
+}
+
+func preferenceFor(t *testing.T, prefs []Preference, eventType string) Preference {
+	for _, pref := range prefs {
+		if pref.EventType == eventType {
+			return pref
+		}
+	}
+	t.Fatalf("no preference for %s", eventType)
+	return Preference{}
+}
+
+func TestService_Defaults(t *testing.T) {
+	client, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	service := NewService(client)
+	user := createTestUser(t, client, "defaults@example.com")
+
+	prefs, err := service.Get(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, prefs, len(EventTypes))
+
+	security := preferenceFor(t, prefs, EventSecurity)
+	assert.True(t, security.Email)
+	assert.True(t, security.Locked)
+
+	marketing := preferenceFor(t, prefs, EventMarketing)
+	assert.False(t, marketing.Email)
+	assert.False(t, marketing.Webhook)
+
+	assert.True(t, service.Allows(ctx, user.ID, EventLeadAssigned, ChannelEmail))
+	assert.True(t, service.Allows(ctx, user.ID, EventLeadAssigned, ChannelWebhook))
+	assert.False(t, service.Allows(ctx, user.ID, EventMarketing, ChannelEmail))
+	assert.True(t, service.Allows(ctx, user.ID, EventSecurity, ChannelEmail))
+}
+
+func TestService_Update(t *testing.T) {
+	client, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	service := NewService(client)
+	user := createTestUser(t, client, "update@example.com")
+	other := createTestUser(t, client, "other@example.com")
+	on, off := true, false
+
+	t.Run("Changes only the given channels", func(t *testing.T) {
+		prefs, err := service.Update(ctx, user.ID, []UpdatePreferenceRequest{
+			{EventType: EventLeadAssigned, Email: &off},
+			{EventType: EventMarketing, Email: &on},
+		})
+		require.NoError(t, err)
+
+		assigned := preferenceFor(t, prefs, EventLeadAssigned)
+		assert.False(t, assigned.Email)
+		assert.True(t, assigned.Webhook)
+		assert.True(t, preferenceFor(t, prefs, EventMarketing).Email)
+
+		assert.False(t, service.Allows(ctx, user.ID, EventLeadAssigned, ChannelEmail))
+		assert.True(t, service.Allows(ctx, user.ID, EventLeadAssigned, ChannelWebhook))
+		assert.True(t, service.Allows(ctx, user.ID, EventMarketing, ChannelEmail))
+		assert.True(t, service.Allows(ctx, other.ID, EventLeadAssigned, ChannelEmail))
+	})
+
+	t.Run("Updating again keeps one row per event type", func(t *testing.T) {
+		_, err := service.Update(ctx, user.ID, []UpdatePreferenceRequest{
+			{EventType: EventLeadAssigned, Webhook: &off},
+		})
+		require.NoError(t, err)
+
+		prefs, err := service.Get(ctx, user.ID)
+		require.NoError(t, err)
+		assigned := preferenceFor(t, prefs, EventLeadAssigned)
+		assert.False(t, assigned.Email)
+		assert.False(t, assigned.Webhook)
+
+		count, err := client.UserNotificationPreference.Query().Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+	})
+
+	t.Run("Rejects unknown and locked event types", func(t *testing.T) {
+		_, err := service.Update(ctx, user.ID, []UpdatePreferenceRequest{
+			{EventType: EventDigest, Email: &off},
+			{EventType: "carrier_pigeon", Email: &off},
+		})
+		assert.ErrorIs(t, err, ErrUnknownEventType)
+
+		_, err = service.Update(ctx, user.ID, []UpdatePreferenceRequest{
+			{EventType: EventSecurity, Email: &off},
+		})
+		assert.ErrorIs(t, err, ErrLockedEventType)
+
+		// Nothing from the rejected batches was saved
+		assert.True(t, service.Allows(ctx, user.ID, EventDigest, ChannelEmail))
+		assert.True(t, service.Allows(ctx, user.ID, EventSecurity, ChannelEmail))
+	})
+}
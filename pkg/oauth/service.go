@@ -50,6 +50,11 @@ type Service struct {
 	db     *ent.Client
 	config *config.Config
 	client *http.Client
+
+	// Google endpoints used by integrations, overridable in tests
+	googleTokenURL    string
+	googleUserInfoURL string
+	sheetsAPIURL      string
 }
 
 // NewService creates a new OAuth service
@@ -60,6 +65,9 @@ func NewService(db *ent.Client, cfg *config.Config) *Service {
 		client: &http.Client{
 			Timeout: 10 * time.Second,
 		},
+		googleTokenURL:    googleTokenURL,
+		googleUserInfoURL: googleUserInfoURL,
+		sheetsAPIURL:      sheetsAPIURL,
 	}
 }
 
@@ -0,0 +1,514 @@
+package oauth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/integrationconnection"
+)
+
+const (
+	googleTokenURL    = "https://oauth2.googleapis.com/token"
+	googleUserInfoURL = "https://www.googleapis.com/oauth2/v2/userinfo"
+	sheetsAPIURL      = "https://sheets.googleapis.com/v4/spreadsheets"
+
+	// googleSheetsScopes lets exports create and edit spreadsheets
+	googleSheetsScopes = "https://www.googleapis.com/auth/spreadsheets https://www.googleapis.com/auth/userinfo.email"
+
+	// SheetsCellLimit is the maximum number of cells in a Google spreadsheet,
+	// across all of its sheets
+	SheetsCellLimit = 10000000
+
+	// sheetsAppendBatch is the number of rows sent per append request
+	sheetsAppendBatch = 2000
+)
+
+var (
+	// ErrNotConnected is returned when the user has not connected a Google account
+	ErrNotConnected = errors.New("Google account not connected")
+	// ErrConnectionRevoked is returned when Google no longer accepts the stored refresh token
+	ErrConnectionRevoked = errors.New("Google account access was revoked, reconnect it")
+	// ErrSpreadsheetNotFound is returned when the target spreadsheet does not exist or is not shared with the user
+	ErrSpreadsheetNotFound = errors.New("spreadsheet not found")
+	// ErrSheetFull is returned when a spreadsheet has no room left below the cell limit
+	ErrSheetFull = errors.New("spreadsheet has reached the Google Sheets cell limit")
+)
+
+// Connection describes an account a user connected for export delivery
+type Connection struct {
+	Provider     string    `json:"provider"`
+	AccountEmail string    `json:"account_email,omitempty"`
+	Scopes       string    `json:"scopes,omitempty"`
+	ConnectedAt  time.Time `json:"connected_at"`
+}
+
+// SheetResult reports where exported rows were written
+type SheetResult struct {
+	SpreadsheetID string
+	URL           string
+	RowsWritten   int // Data rows written, excluding the header
+	RowsDropped   int // Data rows left out to stay under SheetsCellLimit
+}
+
+// GetGoogleSheetsAuthURL returns the consent URL for connecting a Google
+// account for Sheets delivery. Offline access with forced consent makes
+// Google return a refresh token on every connect.
+func (s *Service) GetGoogleSheetsAuthURL(state string) string {
+	params := url.Values{}
+	params.Add("client_id", s.config.GoogleClientID)
+	params.Add("redirect_uri", s.config.GoogleSheetsRedirectURL)
+	params.Add("response_type", "code")
+	params.Add("scope", googleSheetsScopes)
+	params.Add("access_type", "offline")
+	params.Add("prompt", "consent")
+	params.Add("include_granted_scopes", "true")
+	params.Add("state", state)
+	return "https://accounts.google.com/o/oauth2/v2/auth?" + params.Encode()
+}
+
+// ConnectGoogleSheets exchanges an authorization code and stores the
+// encrypted refresh token, replacing any previous Google connection
+func (s *Service) ConnectGoogleSheets(ctx context.Context, userID int, code string) (*Connection, error) {
+	data := url.Values{}
+	data.Set("code", code)
+	data.Set("client_id", s.config.GoogleClientID)
+	data.Set("client_secret", s.config.GoogleClientSecret)
+	data.Set("redirect_uri", s.config.GoogleSheetsRedirectURL)
+	data.Set("grant_type", "authorization_code")
+
+	var tokenResp struct {
+		AccessToken  string `json:"access_token"`
+		RefreshToken string `json:"refresh_token"`
+		Scope        string `json:"scope"`
+	}
+	status, err := s.postForm(ctx, s.googleTokenURL, data, &tokenResp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange code: %w", err)
+	}
+	if status != http.StatusOK {
+		return nil, ErrInvalidCode
+	}
+	if tokenResp.RefreshToken == "" {
+		return nil, fmt.Errorf("%w: Google did not return a refresh token", ErrProviderAPIError)
+	}
+
+	email, err := s.googleAccountEmail(ctx, tokenResp.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	cipher, err := s.tokenCipher()
+	if err != nil {
+		return nil, err
+	}
+	encrypted, err := cipher.Encrypt(tokenResp.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := s.db.IntegrationConnection.Query().
+		Where(
+			integrationconnection.UserIDEQ(userID),
+			integrationconnection.ProviderEQ(integrationconnection.ProviderGoogleSheets),
+		).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		conn, err = s.db.IntegrationConnection.Create().
+			SetUserID(userID).
+			SetProvider(integrationconnection.ProviderGoogleSheets).
+			SetAccountEmail(email).
+			SetRefreshToken(encrypted).
+			SetScopes(tokenResp.Scope).
+			Save(ctx)
+	case err == nil:
+		conn, err = conn.Update().
+			SetAccountEmail(email).
+			SetRefreshToken(encrypted).
+			SetScopes(tokenResp.Scope).
+			Save(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to save Google connection: %w", err)
+	}
+
+	return toConnection(conn), nil
+}
+
+// DisconnectGoogleSheets removes the user's Google connection
+func (s *Service) DisconnectGoogleSheets(ctx context.Context, userID int) error {
+	deleted, err := s.db.IntegrationConnection.Delete().
+		Where(
+			integrationconnection.UserIDEQ(userID),
+			integrationconnection.ProviderEQ(integrationconnection.ProviderGoogleSheets),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete Google connection: %w", err)
+	}
+	if deleted == 0 {
+		return ErrNotConnected
+	}
+	return nil
+}
+
+// ListConnections returns the accounts a user connected
+func (s *Service) ListConnections(ctx context.Context, userID int) ([]Connection, error) {
+	conns, err := s.db.IntegrationConnection.Query().
+		Where(integrationconnection.UserIDEQ(userID)).
+		Order(ent.Asc(integrationconnection.FieldProvider)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list connections: %w", err)
+	}
+
+	connections := make([]Connection, len(conns))
+	for i, conn := range conns {
+		connections[i] = *toConnection(conn)
+	}
+	return connections, nil
+}
+
+// HasGoogleSheetsConnection reports whether the user connected a Google account
+func (s *Service) HasGoogleSheetsConnection(ctx context.Context, userID int) (bool, error) {
+	return s.db.IntegrationConnection.Query().
+		Where(
+			integrationconnection.UserIDEQ(userID),
+			integrationconnection.ProviderEQ(integrationconnection.ProviderGoogleSheets),
+		).
+		Exist(ctx)
+}
+
+// WriteToSheet writes rows to a spreadsheet on the user's Google account.
+// Without a spreadsheetID a new spreadsheet named title is created;
+// otherwise rows are appended to its first sheet, with the header only when
+// that sheet is empty. Rows that would take the spreadsheet past
+// SheetsCellLimit are dropped and counted in the result.
+func (s *Service) WriteToSheet(ctx context.Context, userID int, spreadsheetID, title string, header []string, rows [][]string) (*SheetResult, error) {
+	accessToken, err := s.googleAccessToken(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var sheet *spreadsheet
+	if spreadsheetID == "" {
+		sheet, err = s.createSpreadsheet(ctx, accessToken, title)
+	} else {
+		sheet, err = s.getSpreadsheet(ctx, accessToken, spreadsheetID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(sheet.Sheets) == 0 {
+		return nil, fmt.Errorf("%w: spreadsheet has no sheets", ErrProviderAPIError)
+	}
+	target := sheet.Sheets[0].Properties
+
+	writeHeader := spreadsheetID == ""
+	if !writeHeader {
+		writeHeader, err = s.sheetIsEmpty(ctx, accessToken, sheet.SpreadsheetID, target.Title)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	values := make([][]string, 0, len(rows)+1)
+	if writeHeader {
+		values = append(values, header)
+	}
+	values = append(values, rows...)
+
+	fit := rowsWithinCellLimit(sheet.cellCount(), target.GridProperties.RowCount, target.GridProperties.ColumnCount, len(header))
+	if fit <= 0 || (writeHeader && fit == 1 && len(rows) > 0) {
+		return nil, ErrSheetFull
+	}
+	dropped := 0
+	if len(values) > fit {
+		dropped = len(values) - fit
+		values = values[:fit]
+	}
+
+	for start := 0; start < len(values); start += sheetsAppendBatch {
+		end := start + sheetsAppendBatch
+		if end > len(values) {
+			end = len(values)
+		}
+		if err := s.appendValues(ctx, accessToken, sheet.SpreadsheetID, target.Title, values[start:end]); err != nil {
+			return nil, err
+		}
+	}
+
+	return &SheetResult{
+		SpreadsheetID: sheet.SpreadsheetID,
+		URL:           sheet.SpreadsheetURL,
+		RowsWritten:   len(rows) - dropped,
+		RowsDropped:   dropped,
+	}, nil
+}
+
+// rowsWithinCellLimit returns how many rows of width columns can be
+// inserted into a sheet of rowCount x columnCount without the spreadsheet
+// exceeding SheetsCellLimit. Inserted rows take the full sheet width, and a
+// wider row widens every existing row.
+func rowsWithinCellLimit(cells, rowCount, columnCount, width int) int {
+	available := SheetsCellLimit - cells
+	if width > columnCount {
+		available -= rowCount * (width - columnCount)
+	} else {
+		width = columnCount
+	}
+	if available <= 0 || width <= 0 {
+		return 0
+	}
+	return available / width
+}
+
+// spreadsheet is the subset of the Sheets API spreadsheet resource we use
+type spreadsheet struct {
+	SpreadsheetID  string `json:"spreadsheetId"`
+	SpreadsheetURL string `json:"spreadsheetUrl"`
+	Sheets         []struct {
+		Properties struct {
+			Title          string `json:"title"`
+			GridProperties struct {
+				RowCount    int `json:"rowCount"`
+				ColumnCount int `json:"columnCount"`
+			} `json:"gridProperties"`
+		} `json:"properties"`
+	} `json:"sheets"`
+}
+
+// cellCount returns the cells allocated across all sheets
+func (sp *spreadsheet) cellCount() int {
+	cells := 0
+	for _, sheet := range sp.Sheets {
+		cells += sheet.Properties.GridProperties.RowCount * sheet.Properties.GridProperties.ColumnCount
+	}
+	return cells
+}
+
+func (s *Service) createSpreadsheet(ctx context.Context, accessToken, title string) (*spreadsheet, error) {
+	body := map[string]interface{}{
+		"properties": map[string]string{"title": title},
+		"sheets": []map[string]interface{}{
+			{"properties": map[string]string{"title": "Leads"}},
+		},
+	}
+
+	var created spreadsheet
+	status, err := s.sheetsRequest(ctx, accessToken, http.MethodPost, s.sheetsAPIURL, body, &created)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("%w: create spreadsheet returned status %d", ErrProviderAPIError, status)
+	}
+	return &created, nil
+}
+
+func (s *Service) getSpreadsheet(ctx context.Context, accessToken, spreadsheetID string) (*spreadsheet, error) {
+	endpoint := s.sheetsAPIURL + "/" + url.PathEscape(spreadsheetID) +
+		"?fields=" + url.QueryEscape("spreadsheetId,spreadsheetUrl,sheets.properties(title,gridProperties)")
+
+	var existing spreadsheet
+	status, err := s.sheetsRequest(ctx, accessToken, http.MethodGet, endpoint, nil, &existing)
+	if err != nil {
+		return nil, err
+	}
+	if status == http.StatusNotFound || status == http.StatusForbidden {
+		return nil, ErrSpreadsheetNotFound
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("%w: get spreadsheet returned status %d", ErrProviderAPIError, status)
+	}
+	return &existing, nil
+}
+
+// sheetIsEmpty reports whether the first row of a sheet has no values
+func (s *Service) sheetIsEmpty(ctx context.Context, accessToken, spreadsheetID, sheetTitle string) (bool, error) {
+	endpoint := s.sheetsAPIURL + "/" + url.PathEscape(spreadsheetID) +
+		"/values/" + url.PathEscape(sheetRange(sheetTitle, "1:1"))
+
+	var firstRow struct {
+		Values [][]string `json:"values"`
+	}
+	status, err := s.sheetsRequest(ctx, accessToken, http.MethodGet, endpoint, nil, &firstRow)
+	if err != nil {
+		return false, err
+	}
+	if status != http.StatusOK {
+		return false, fmt.Errorf("%w: read sheet returned status %d", ErrProviderAPIError, status)
+	}
+	return len(firstRow.Values) == 0, nil
+}
+
+func (s *Service) appendValues(ctx context.Context, accessToken, spreadsheetID, sheetTitle string, values [][]string) error {
+	endpoint := s.sheetsAPIURL + "/" + url.PathEscape(spreadsheetID) +
+		"/values/" + url.PathEscape(sheetRange(sheetTitle, "A1")) + ":append" +
+		"?valueInputOption=RAW&insertDataOption=INSERT_ROWS"
+
+	status, err := s.sheetsRequest(ctx, accessToken, http.MethodPost, endpoint, map[string]interface{}{"values": values}, nil)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("%w: append rows returned status %d", ErrProviderAPIError, status)
+	}
+	return nil
+}
+
+// sheetRange builds an A1 range on a sheet, quoting the sheet title
+func sheetRange(sheetTitle, cells string) string {
+	return "'" + sheetTitle + "'!" + cells
+}
+
+// sheetsRequest sends a Sheets API request and decodes a 200 response into out
+func (s *Service) sheetsRequest(ctx context.Context, accessToken, method, endpoint string, body, out interface{}) (int, error) {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return 0, fmt.Errorf("failed to encode request: %w", err)
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reader)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("Sheets API request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusOK && out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return 0, fmt.Errorf("failed to decode Sheets API response: %w", err)
+		}
+	}
+	return resp.StatusCode, nil
+}
+
+// googleAccessToken exchanges the user's stored refresh token for an access token
+func (s *Service) googleAccessToken(ctx context.Context, userID int) (string, error) {
+	conn, err := s.db.IntegrationConnection.Query().
+		Where(
+			integrationconnection.UserIDEQ(userID),
+			integrationconnection.ProviderEQ(integrationconnection.ProviderGoogleSheets),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", ErrNotConnected
+		}
+		return "", fmt.Errorf("failed to get Google connection: %w", err)
+	}
+
+	cipher, err := s.tokenCipher()
+	if err != nil {
+		return "", err
+	}
+	refreshToken, err := cipher.Decrypt(conn.RefreshToken)
+	if err != nil {
+		return "", err
+	}
+
+	data := url.Values{}
+	data.Set("client_id", s.config.GoogleClientID)
+	data.Set("client_secret", s.config.GoogleClientSecret)
+	data.Set("refresh_token", refreshToken)
+	data.Set("grant_type", "refresh_token")
+
+	var tokenResp struct {
+		AccessToken string `json:"access_token"`
+	}
+	status, err := s.postForm(ctx, s.googleTokenURL, data, &tokenResp)
+	if err != nil {
+		return "", fmt.Errorf("failed to refresh Google token: %w", err)
+	}
+	if status == http.StatusBadRequest || status == http.StatusUnauthorized {
+		return "", ErrConnectionRevoked
+	}
+	if status != http.StatusOK {
+		return "", fmt.Errorf("%w: token refresh returned status %d", ErrProviderAPIError, status)
+	}
+	return tokenResp.AccessToken, nil
+}
+
+// googleAccountEmail returns the email of the account an access token belongs to
+func (s *Service) googleAccountEmail(ctx context.Context, accessToken string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.googleUserInfoURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to get user info: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", ErrProviderAPIError
+	}
+
+	var googleUser struct {
+		Email string `json:"email"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&googleUser); err != nil {
+		return "", fmt.Errorf("failed to decode user info: %w", err)
+	}
+	return googleUser.Email, nil
+}
+
+// postForm POSTs a form and decodes a 200 JSON response into out
+func (s *Service) postForm(ctx context.Context, endpoint string, data url.Values, out interface{}) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewBufferString(data.Encode()))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusOK {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return 0, fmt.Errorf("failed to decode token response: %w", err)
+		}
+	}
+	return resp.StatusCode, nil
+}
+
+// tokenCipher returns the cipher for stored integration tokens
+func (s *Service) tokenCipher() (*TokenCipher, error) {
+	return NewIntegrationTokenCipher(s.config)
+}
+
+func toConnection(conn *ent.IntegrationConnection) *Connection {
+	return &Connection{
+		Provider:     string(conn.Provider),
+		AccountEmail: conn.AccountEmail,
+		Scopes:       conn.Scopes,
+		ConnectedAt:  conn.CreatedAt,
+	}
+}
@@ -0,0 +1,275 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jordanlanch/industrydb/config"
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// fakeGoogle serves the token, userinfo and Sheets endpoints
+type fakeGoogle struct {
+	mu          sync.Mutex
+	rowCount    int      // Grid rows of existing spreadsheets
+	firstRow    []string // First row of existing spreadsheets
+	revoked     bool
+	created     []string     // Titles of created spreadsheets
+	appended    [][][]string // Values of each append request
+	refreshedBy []string     // Refresh tokens presented
+}
+
+func (g *fakeGoogle) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		switch r.Form.Get("grant_type") {
+		case "authorization_code":
+			if r.Form.Get("code") != "good-code" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]string{
+				"access_token":  "access-1",
+				"refresh_token": "refresh-secret",
+				"scope":         googleSheetsScopes,
+			})
+		case "refresh_token":
+			g.refreshedBy = append(g.refreshedBy, r.Form.Get("refresh_token"))
+			if g.revoked {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]string{"access_token": "access-2"})
+		}
+	})
+	mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{"email": "owner@example.com"})
+	})
+	mux.HandleFunc("/sheets", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Properties struct {
+				Title string `json:"title"`
+			} `json:"properties"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		g.mu.Lock()
+		g.created = append(g.created, body.Properties.Title)
+		g.mu.Unlock()
+		w.Write([]byte(`{"spreadsheetId":"new-sheet","spreadsheetUrl":"https://docs.google.com/spreadsheets/d/new-sheet/edit",
+			"sheets":[{"properties":{"title":"Leads","gridProperties":{"rowCount":1000,"columnCount":26}}}]}`))
+	})
+	mux.HandleFunc("/sheets/", func(w http.ResponseWriter, r *http.Request) {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		path := strings.TrimPrefix(r.URL.Path, "/sheets/")
+		switch {
+		case strings.HasPrefix(path, "missing"):
+			w.WriteHeader(http.StatusNotFound)
+		case strings.HasSuffix(path, ":append"):
+			var body struct {
+				Values [][]string `json:"values"`
+			}
+			json.NewDecoder(r.Body).Decode(&body)
+			g.appended = append(g.appended, body.Values)
+			w.Write([]byte(`{}`))
+		case strings.Contains(path, "/values/"):
+			values := [][]string{}
+			if g.firstRow != nil {
+				values = append(values, g.firstRow)
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"values": values})
+		default:
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"spreadsheetId":  "existing",
+				"spreadsheetUrl": "https://docs.google.com/spreadsheets/d/existing/edit",
+				"sheets": []map[string]interface{}{
+					{"properties": map[string]interface{}{
+						"title":          "Leads",
+						"gridProperties": map[string]int{"rowCount": g.rowCount, "columnCount": 2},
+					}},
+				},
+			})
+		}
+	})
+	return mux
+}
+
+func setupSheetsTest(t *testing.T) (*ent.Client, *Service, *fakeGoogle) {
+	client := enttest.Open(t, "sqlite3", "file:oauth_sheets?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	google := &fakeGoogle{rowCount: 1000}
+	server := httptest.NewServer(google.handler())
+	t.Cleanup(server.Close)
+
+	service := NewService(client, &config.Config{
+		GoogleClientID:          "client-id",
+		GoogleClientSecret:      "client-secret",
+		GoogleSheetsRedirectURL: "http://localhost:8080/api/v1/integrations/google/callback",
+		JWTSecret:               "test-secret",
+	})
+	service.googleTokenURL = server.URL + "/token"
+	service.googleUserInfoURL = server.URL + "/userinfo"
+	service.sheetsAPIURL = server.URL + "/sheets"
+
+	return client, service, google
+}
+
+func TestTokenCipher(t *testing.T) {
+	cipher, err := NewTokenCipher("key")
+	require.NoError(t, err)
+
+	encrypted, err := cipher.Encrypt("refresh-secret")
+	require.NoError(t, err)
+	assert.NotContains(t, encrypted, "refresh-secret")
+
+	decrypted, err := cipher.Decrypt(encrypted)
+	require.NoError(t, err)
+	assert.Equal(t, "refresh-secret", decrypted)
+
+	other, err := NewTokenCipher("other-key")
+	require.NoError(t, err)
+	_, err = other.Decrypt(encrypted)
+	assert.True(t, errors.Is(err, ErrInvalidCiphertext))
+
+	_, err = NewTokenCipher("")
+	assert.Error(t, err)
+}
+
+func TestGetGoogleSheetsAuthURL(t *testing.T) {
+	_, service, _ := setupSheetsTest(t)
+
+	authURL := service.GetGoogleSheetsAuthURL("state-1")
+	assert.Contains(t, authURL, "access_type=offline")
+	assert.Contains(t, authURL, "prompt=consent")
+	assert.Contains(t, authURL, "state=state-1")
+	assert.Contains(t, authURL, "auth%2Fspreadsheets")
+}
+
+func TestGoogleSheetsConnection(t *testing.T) {
+	client, service, google := setupSheetsTest(t)
+	ctx := context.Background()
+
+	u, err := client.User.Create().
+		SetEmail("sheets@example.com").
+		SetPasswordHash("$2a$10$dummyhash").
+		SetName("Sheets User").
+		Save(ctx)
+	require.NoError(t, err)
+
+	t.Run("Failure - Not connected", func(t *testing.T) {
+		_, err := service.WriteToSheet(ctx, u.ID, "", "Export", []string{"Name"}, nil)
+		assert.True(t, errors.Is(err, ErrNotConnected))
+	})
+
+	t.Run("Failure - Invalid code", func(t *testing.T) {
+		_, err := service.ConnectGoogleSheets(ctx, u.ID, "bad-code")
+		assert.True(t, errors.Is(err, ErrInvalidCode))
+	})
+
+	t.Run("Success - Connect stores encrypted refresh token", func(t *testing.T) {
+		conn, err := service.ConnectGoogleSheets(ctx, u.ID, "good-code")
+		require.NoError(t, err)
+		assert.Equal(t, "owner@example.com", conn.AccountEmail)
+
+		stored := client.IntegrationConnection.Query().OnlyX(ctx)
+		assert.NotEqual(t, "refresh-secret", stored.RefreshToken)
+
+		// Reconnecting replaces the connection
+		_, err = service.ConnectGoogleSheets(ctx, u.ID, "good-code")
+		require.NoError(t, err)
+		assert.Equal(t, 1, client.IntegrationConnection.Query().CountX(ctx))
+
+		connected, err := service.HasGoogleSheetsConnection(ctx, u.ID)
+		require.NoError(t, err)
+		assert.True(t, connected)
+	})
+
+	t.Run("Success - New spreadsheet with header", func(t *testing.T) {
+		result, err := service.WriteToSheet(ctx, u.ID, "", "IndustryDB export 1", []string{"Name", "Email"},
+			[][]string{{"Ink Studio", "ink@example.com"}})
+		require.NoError(t, err)
+
+		assert.Equal(t, "new-sheet", result.SpreadsheetID)
+		assert.Equal(t, "https://docs.google.com/spreadsheets/d/new-sheet/edit", result.URL)
+		assert.Equal(t, 1, result.RowsWritten)
+		assert.Equal(t, []string{"IndustryDB export 1"}, google.created)
+		assert.Equal(t, [][]string{{"Name", "Email"}, {"Ink Studio", "ink@example.com"}}, google.appended[len(google.appended)-1])
+		assert.Equal(t, "refresh-secret", google.refreshedBy[len(google.refreshedBy)-1])
+	})
+
+	t.Run("Success - Append skips header on a sheet with data", func(t *testing.T) {
+		google.firstRow = []string{"Name", "Email"}
+		defer func() { google.firstRow = nil }()
+
+		result, err := service.WriteToSheet(ctx, u.ID, "existing", "ignored", []string{"Name", "Email"},
+			[][]string{{"Iron Gym", "iron@example.com"}})
+		require.NoError(t, err)
+
+		assert.Equal(t, "existing", result.SpreadsheetID)
+		assert.Equal(t, [][]string{{"Iron Gym", "iron@example.com"}}, google.appended[len(google.appended)-1])
+	})
+
+	t.Run("Success - Rows past the cell limit are dropped", func(t *testing.T) {
+		// Room for exactly two more rows of two columns
+		google.rowCount = SheetsCellLimit/2 - 2
+		google.firstRow = []string{"Name", "Email"}
+		defer func() { google.rowCount, google.firstRow = 1000, nil }()
+
+		rows := [][]string{{"a", "1"}, {"b", "2"}, {"c", "3"}}
+		result, err := service.WriteToSheet(ctx, u.ID, "existing", "ignored", []string{"Name", "Email"}, rows)
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, result.RowsWritten)
+		assert.Equal(t, 1, result.RowsDropped)
+		assert.Equal(t, rows[:2], google.appended[len(google.appended)-1])
+	})
+
+	t.Run("Failure - Full spreadsheet", func(t *testing.T) {
+		google.rowCount = SheetsCellLimit / 2
+		defer func() { google.rowCount = 1000 }()
+
+		_, err := service.WriteToSheet(ctx, u.ID, "existing", "ignored", []string{"Name", "Email"}, [][]string{{"a", "1"}})
+		assert.True(t, errors.Is(err, ErrSheetFull))
+	})
+
+	t.Run("Failure - Missing spreadsheet", func(t *testing.T) {
+		_, err := service.WriteToSheet(ctx, u.ID, "missing", "ignored", []string{"Name"}, nil)
+		assert.True(t, errors.Is(err, ErrSpreadsheetNotFound))
+	})
+
+	t.Run("Failure - Revoked access", func(t *testing.T) {
+		google.revoked = true
+		defer func() { google.revoked = false }()
+
+		_, err := service.WriteToSheet(ctx, u.ID, "", "Export", []string{"Name"}, nil)
+		assert.True(t, errors.Is(err, ErrConnectionRevoked))
+	})
+
+	t.Run("Success - Disconnect", func(t *testing.T) {
+		require.NoError(t, service.DisconnectGoogleSheets(ctx, u.ID))
+		assert.True(t, errors.Is(service.DisconnectGoogleSheets(ctx, u.ID), ErrNotConnected))
+	})
+}
+
+func TestRowsWithinCellLimit(t *testing.T) {
+	// Empty default sheet: rows take the full 26-column width
+	assert.Equal(t, (SheetsCellLimit-26000)/26, rowsWithinCellLimit(26000, 1000, 26, 16))
+	// Wider rows widen every existing row first
+	assert.Equal(t, (SheetsCellLimit-2000-1000*2)/4, rowsWithinCellLimit(2000, 1000, 2, 4))
+	assert.Equal(t, 0, rowsWithinCellLimit(SheetsCellLimit, 5000000, 2, 2))
+}
@@ -0,0 +1,78 @@
+package oauth
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/jordanlanch/industrydb/config"
+)
+
+// ErrInvalidCiphertext is returned when a stored token cannot be decrypted
+var ErrInvalidCiphertext = errors.New("invalid encrypted token")
+
+// TokenCipher encrypts integration tokens at rest with AES-256-GCM
+type TokenCipher struct {
+	aead cipher.AEAD
+}
+
+// NewTokenCipher creates a cipher keyed by the SHA-256 of secret, so any
+// non-empty secret (e.g. INTEGRATION_ENCRYPTION_KEY) yields a valid key
+func NewTokenCipher(secret string) (*TokenCipher, error) {
+	if secret == "" {
+		return nil, errors.New("token encryption key is empty")
+	}
+
+	key := sha256.Sum256([]byte(secret))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCM: %w", err)
+	}
+
+	return &TokenCipher{aead: aead}, nil
+}
+
+// NewIntegrationTokenCipher returns the cipher for stored integration
+// tokens, keyed by INTEGRATION_ENCRYPTION_KEY or, when unset, the JWT secret
+func NewIntegrationTokenCipher(cfg *config.Config) (*TokenCipher, error) {
+	key := cfg.IntegrationEncryptionKey
+	if key == "" {
+		key = "integration-tokens:" + cfg.JWTSecret
+	}
+	return NewTokenCipher(key)
+}
+
+// Encrypt returns the base64-encoded nonce and ciphertext of plaintext
+func (c *TokenCipher) Encrypt(plaintext string) (string, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	sealed := c.aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt reverses Encrypt
+func (c *TokenCipher) Decrypt(encoded string) (string, error) {
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(sealed) < c.aead.NonceSize() {
+		return "", ErrInvalidCiphertext
+	}
+
+	nonce, ciphertext := sealed[:c.aead.NonceSize()], sealed[c.aead.NonceSize():]
+	plaintext, err := c.aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", ErrInvalidCiphertext
+	}
+	return string(plaintext), nil
+}
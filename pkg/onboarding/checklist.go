@@ -0,0 +1,210 @@
+// Package onboarding computes the onboarding checklist of a user from what
+// they actually did: verifying their email, searching, exporting, inviting a
+// teammate. Items complete on their own as the user performs them.
+package onboarding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/apikey"
+	"github.com/jordanlanch/industrydb/ent/export"
+	"github.com/jordanlanch/industrydb/ent/organization"
+	"github.com/jordanlanch/industrydb/ent/organizationmember"
+	"github.com/jordanlanch/industrydb/ent/savedsearch"
+	"github.com/jordanlanch/industrydb/ent/usagelog"
+)
+
+// ErrInvalidChecklist is returned for a checklist configuration with unknown
+// or repeated items
+var ErrInvalidChecklist = errors.New("invalid onboarding checklist")
+
+// Checklist item keys
+const (
+	ItemVerifyEmail     = "verify_email"
+	ItemFirstSearch     = "first_search"
+	ItemFirstExport     = "first_export"
+	ItemInviteTeammate  = "invite_teammate"
+	ItemSaveSearch      = "save_search"
+	ItemFirstEnrichment = "first_enrichment"
+	ItemCreateAPIKey    = "create_api_key"
+)
+
+// completionCheck returns when a user completed an item, or nil when they
+// haven't yet
+type completionCheck func(ctx context.Context, db *ent.Client, u *ent.User) (*time.Time, error)
+
+// itemDefinition is a checklist item the configuration can use
+type itemDefinition struct {
+	title string
+	check completionCheck
+}
+
+// definitions are the items checklists are built from, by key
+var definitions = map[string]itemDefinition{
+	ItemVerifyEmail: {
+		title: "Verify your email",
+		check: func(ctx context.Context, db *ent.Client, u *ent.User) (*time.Time, error) {
+			if !u.EmailVerified {
+				return nil, nil
+			}
+			if u.EmailVerifiedAt != nil {
+				return u.EmailVerifiedAt, nil
+			}
+			return &u.CreatedAt, nil
+		},
+	},
+	ItemFirstSearch: {
+		title: "Run your first search",
+		check: firstUsage(usagelog.ActionSearch),
+	},
+	ItemFirstExport: {
+		title: "Create your first export",
+		check: func(ctx context.Context, db *ent.Client, u *ent.User) (*time.Time, error) {
+			first, err := db.Export.Query().
+				Where(export.UserIDEQ(u.ID)).
+				Order(ent.Asc(export.FieldCreatedAt)).
+				First(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return &first.CreatedAt, nil
+		},
+	},
+	ItemInviteTeammate: {
+		title: "Invite a teammate",
+		check: func(ctx context.Context, db *ent.Client, u *ent.User) (*time.Time, error) {
+			// Invitations sent to organizations the user manages
+			first, err := db.OrganizationMember.Query().
+				Where(
+					organizationmember.UserIDNEQ(u.ID),
+					organizationmember.InvitedAtNotNil(),
+					organizationmember.HasOrganizationWith(organization.HasMembersWith(
+						organizationmember.UserIDEQ(u.ID),
+						organizationmember.RoleIn(organizationmember.RoleOwner, organizationmember.RoleAdmin),
+					)),
+				).
+				Order(ent.Asc(organizationmember.FieldInvitedAt)).
+				First(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return first.InvitedAt, nil
+		},
+	},
+	ItemSaveSearch: {
+		title: "Save a search",
+		check: func(ctx context.Context, db *ent.Client, u *ent.User) (*time.Time, error) {
+			first, err := db.SavedSearch.Query().
+				Where(savedsearch.UserIDEQ(u.ID)).
+				Order(ent.Asc(savedsearch.FieldCreatedAt)).
+				First(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return &first.CreatedAt, nil
+		},
+	},
+	ItemFirstEnrichment: {
+		title: "Enrich a lead",
+		check: firstUsage(usagelog.ActionEnrichment),
+	},
+	ItemCreateAPIKey: {
+		title: "Create an API key",
+		check: func(ctx context.Context, db *ent.Client, u *ent.User) (*time.Time, error) {
+			first, err := db.APIKey.Query().
+				Where(apikey.UserIDEQ(u.ID)).
+				Order(ent.Asc(apikey.FieldCreatedAt)).
+				First(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return &first.CreatedAt, nil
+		},
+	},
+}
+
+// firstUsage completes an item at the user's first usage log of an action
+func firstUsage(action usagelog.Action) completionCheck {
+	return func(ctx context.Context, db *ent.Client, u *ent.User) (*time.Time, error) {
+		first, err := db.UsageLog.Query().
+			Where(usagelog.UserIDEQ(u.ID), usagelog.ActionEQ(action)).
+			Order(ent.Asc(usagelog.FieldCreatedAt)).
+			First(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &first.CreatedAt, nil
+	}
+}
+
+// ChecklistItem is a configured checklist item: a known item key with the
+// title shown to users
+type ChecklistItem struct {
+	Key   string
+	Title string
+}
+
+// DefaultChecklist is the checklist used without configuration
+var DefaultChecklist = []ChecklistItem{
+	{Key: ItemVerifyEmail, Title: definitions[ItemVerifyEmail].title},
+	{Key: ItemFirstSearch, Title: definitions[ItemFirstSearch].title},
+	{Key: ItemFirstExport, Title: definitions[ItemFirstExport].title},
+	{Key: ItemInviteTeammate, Title: definitions[ItemInviteTeammate].title},
+}
+
+// checklist holds the items GetChecklist reports, in order
+var checklist = DefaultChecklist
+
+// ParseChecklist parses a "key,key=Custom title,..." list of checklist items.
+// Items without a title get their default one. An empty value is the
+// default checklist.
+func ParseChecklist(value string) ([]ChecklistItem, error) {
+	var items []ChecklistItem
+	seen := make(map[string]bool)
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		key, title, _ := strings.Cut(entry, "=")
+		key, title = strings.TrimSpace(key), strings.TrimSpace(title)
+
+		definition, ok := definitions[key]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown item %q", ErrInvalidChecklist, key)
+		}
+		if seen[key] {
+			return nil, fmt.Errorf("%w: item %q listed twice", ErrInvalidChecklist, key)
+		}
+		seen[key] = true
+
+		if title == "" {
+			title = definition.title
+		}
+		items = append(items, ChecklistItem{Key: key, Title: title})
+	}
+	if len(items) == 0 {
+		return DefaultChecklist, nil
+	}
+	return items, nil
+}
+
+// SetChecklist replaces the checklist items. It is meant to be called once
+// at startup from configuration.
+func SetChecklist(items []ChecklistItem) error {
+	if len(items) == 0 {
+		return fmt.Errorf("%w: no items", ErrInvalidChecklist)
+	}
+	for _, item := range items {
+		if _, ok := definitions[item.Key]; !ok {
+			return fmt.Errorf("%w: unknown item %q", ErrInvalidChecklist, item.Key)
+		}
+	}
+	checklist = items
+	return nil
+}
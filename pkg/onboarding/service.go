@@ -0,0 +1,82 @@
+package onboarding
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+)
+
+// Item is the completion status of a checklist item
+type Item struct {
+	Key         string     `json:"key"`
+	Title       string     `json:"title"`
+	Completed   bool       `json:"completed"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+// Status is a user's onboarding checklist
+type Status struct {
+	Items          []Item `json:"items"`
+	CompletedCount int    `json:"completed_count"`
+	TotalCount     int    `json:"total_count"`
+	Progress       int    `json:"progress"` // Percentage of items completed
+	// Completed is set once every item is done, or when onboarding was
+	// completed manually (ManuallyCompleted)
+	Completed         bool `json:"completed"`
+	ManuallyCompleted bool `json:"manually_completed"`
+}
+
+// Service computes onboarding checklists
+type Service struct {
+	db *ent.Client
+}
+
+// NewService creates a new onboarding service
+func NewService(db *ent.Client) *Service {
+	return &Service{db: db}
+}
+
+// GetStatus returns the onboarding checklist of a user, each item computed
+// from their activity. The first time every item is done, the user's
+// onboarding is marked completed.
+func (s *Service) GetStatus(ctx context.Context, userID int) (*Status, error) {
+	u, err := s.db.User.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &Status{
+		Items:      make([]Item, 0, len(checklist)),
+		TotalCount: len(checklist),
+	}
+	for _, configured := range checklist {
+		completedAt, err := definitions[configured.Key].check(ctx, s.db, u)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to check onboarding item %s: %w", configured.Key, err)
+		}
+
+		item := Item{Key: configured.Key, Title: configured.Title}
+		if err == nil && completedAt != nil {
+			item.Completed = true
+			item.CompletedAt = completedAt
+			status.CompletedCount++
+		}
+		status.Items = append(status.Items, item)
+	}
+	if status.TotalCount > 0 {
+		status.Progress = status.CompletedCount * 100 / status.TotalCount
+	}
+
+	allDone := status.CompletedCount == status.TotalCount
+	status.Completed = allDone || u.OnboardingCompleted
+	status.ManuallyCompleted = u.OnboardingCompleted && !allDone
+
+	if allDone && !u.OnboardingCompleted {
+		if err := s.db.User.UpdateOneID(userID).SetOnboardingCompleted(true).Exec(ctx); err != nil {
+			return nil, fmt.Errorf("failed to complete onboarding: %w", err)
+		}
+	}
+	return status, nil
+}
@@ -0,0 +1,152 @@
+package onboarding
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/ent/organizationmember"
+	"github.com/jordanlanch/industrydb/ent/usagelog"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestDB(t *testing.T) *ent.Client {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func createTestUser(t *testing.T, client *ent.Client, email string) *ent.User {
+	u, err := client.User.Create().
+		SetEmail(email).
+		SetPasswordHash("hash").
+		SetName("Onboarding User").
+		Save(context.Background())
+	require.NoError(t, err)
+	return u
+}
+
+func itemsByKey(status *Status) map[string]Item {
+	items := make(map[string]Item)
+	for _, item := range status.Items {
+		items[item.Key] = item
+	}
+	return items
+}
+
+func TestGetStatus_CompletesFromActivity(t *testing.T) {
+	client := setupTestDB(t)
+	service := NewService(client)
+	ctx := context.Background()
+	u := createTestUser(t, client, "new@example.com")
+
+	status, err := service.GetStatus(ctx, u.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 0, status.CompletedCount)
+	assert.Equal(t, 4, status.TotalCount)
+	assert.False(t, status.Completed)
+	require.Len(t, status.Items, 4)
+	assert.Equal(t, ItemVerifyEmail, status.Items[0].Key)
+	assert.Equal(t, "Verify your email", status.Items[0].Title)
+
+	// Verify email and run a search
+	verifiedAt := time.Now().Add(-time.Hour)
+	client.User.UpdateOneID(u.ID).SetEmailVerified(true).SetEmailVerifiedAt(verifiedAt).ExecX(ctx)
+	client.UsageLog.Create().SetUserID(u.ID).SetAction(usagelog.ActionSearch).ExecX(ctx)
+
+	status, err = service.GetStatus(ctx, u.ID)
+	require.NoError(t, err)
+	items := itemsByKey(status)
+	assert.True(t, items[ItemVerifyEmail].Completed)
+	assert.WithinDuration(t, verifiedAt, *items[ItemVerifyEmail].CompletedAt, time.Second)
+	assert.True(t, items[ItemFirstSearch].Completed)
+	assert.False(t, items[ItemFirstExport].Completed)
+	assert.Nil(t, items[ItemFirstExport].CompletedAt)
+	assert.Equal(t, 50, status.Progress)
+
+	// Export and invite a teammate to an organization the user owns
+	client.Export.Create().
+		SetUserID(u.ID).
+		SetFormat("csv").
+		SetFiltersApplied(map[string]interface{}{}).
+		SetLeadCount(10).
+		ExecX(ctx)
+	org := client.Organization.Create().SetName("Team").SetSlug("team").SetOwnerID(u.ID).SaveX(ctx)
+	client.OrganizationMember.Create().SetOrganizationID(org.ID).SetUserID(u.ID).SetRole(organizationmember.RoleOwner).ExecX(ctx)
+	teammate := createTestUser(t, client, "teammate@example.com")
+	client.OrganizationMember.Create().
+		SetOrganizationID(org.ID).
+		SetUserID(teammate.ID).
+		SetStatus(organizationmember.StatusPending).
+		SetInvitedAt(time.Now()).
+		ExecX(ctx)
+
+	status, err = service.GetStatus(ctx, u.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 4, status.CompletedCount)
+	assert.Equal(t, 100, status.Progress)
+	assert.True(t, status.Completed)
+	assert.False(t, status.ManuallyCompleted)
+
+	// The user's onboarding is marked completed
+	u = client.User.GetX(ctx, u.ID)
+	assert.True(t, u.OnboardingCompleted)
+
+	// The invited teammate didn't invite anyone
+	status, err = service.GetStatus(ctx, teammate.ID)
+	require.NoError(t, err)
+	assert.False(t, itemsByKey(status)[ItemInviteTeammate].Completed)
+}
+
+func TestGetStatus_ManuallyCompleted(t *testing.T) {
+	client := setupTestDB(t)
+	service := NewService(client)
+	ctx := context.Background()
+	u := createTestUser(t, client, "manual@example.com")
+	client.User.UpdateOneID(u.ID).SetOnboardingCompleted(true).ExecX(ctx)
+
+	status, err := service.GetStatus(ctx, u.ID)
+	require.NoError(t, err)
+	assert.True(t, status.Completed)
+	assert.True(t, status.ManuallyCompleted)
+	assert.Equal(t, 0, status.CompletedCount)
+
+	_, err = service.GetStatus(ctx, 99999)
+	assert.True(t, ent.IsNotFound(err))
+}
+
+func TestParseChecklist(t *testing.T) {
+	defer SetChecklist(DefaultChecklist)
+
+	items, err := ParseChecklist("")
+	require.NoError(t, err)
+	assert.Equal(t, DefaultChecklist, items)
+
+	items, err = ParseChecklist("first_search, save_search=Save your favorite search ,create_api_key")
+	require.NoError(t, err)
+	assert.Equal(t, []ChecklistItem{
+		{Key: ItemFirstSearch, Title: "Run your first search"},
+		{Key: ItemSaveSearch, Title: "Save your favorite search"},
+		{Key: ItemCreateAPIKey, Title: "Create an API key"},
+	}, items)
+	require.NoError(t, SetChecklist(items))
+
+	client := setupTestDB(t)
+	u := createTestUser(t, client, "custom@example.com")
+	client.UsageLog.Create().SetUserID(u.ID).SetAction(usagelog.ActionSearch).ExecX(context.Background())
+	status, err := NewService(client).GetStatus(context.Background(), u.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 3, status.TotalCount)
+	assert.Equal(t, 1, status.CompletedCount)
+	assert.Equal(t, "Save your favorite search", status.Items[1].Title)
+
+	_, err = ParseChecklist("first_search,tweet_about_us")
+	assert.ErrorIs(t, err, ErrInvalidChecklist)
+	_, err = ParseChecklist("first_search,first_search")
+	assert.ErrorIs(t, err, ErrInvalidChecklist)
+	assert.ErrorIs(t, SetChecklist(nil), ErrInvalidChecklist)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/jordanlanch/industrydb/ent/organization"
 	"github.com/jordanlanch/industrydb/ent/organizationmember"
 	"github.com/jordanlanch/industrydb/ent/user"
+	"github.com/jordanlanch/industrydb/pkg/leads"
 )
 
 // InviteEmailSender abstracts invitation email sending for testability
@@ -160,36 +161,43 @@ func (s *Service) GetOrganizationBySlug(ctx context.Context, slug string) (*ent.
 	return org, nil
 }
 
-// ListUserOrganizations lists all organizations a user belongs to
-func (s *Service) ListUserOrganizations(ctx context.Context, userID int) ([]*ent.Organization, error) {
+// ListUserOrganizations lists the organizations a user is an active member
+// of, oldest first, with their total. Up to limit organizations after
+// offset are loaded; a limit of 0 loads every organization.
+func (s *Service) ListUserOrganizations(ctx context.Context, userID, limit, offset int) ([]*ent.Organization, int, error) {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	// Get all organization memberships for user
-	memberships, err := s.db.OrganizationMember.Query().
-		Where(organizationmember.UserIDEQ(userID)).
-		Where(organizationmember.StatusEQ(organizationmember.StatusActive)).
-		WithOrganization().
-		All(ctx)
+	query := s.db.Organization.Query().
+		Where(organization.HasMembersWith(
+			organizationmember.UserIDEQ(userID),
+			organizationmember.StatusEQ(organizationmember.StatusActive),
+		))
+
+	total, err := query.Clone().Count(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list user organizations: %w", err)
+		return nil, 0, fmt.Errorf("failed to count user organizations: %w", err)
 	}
 
-	// Extract organizations from memberships
-	orgs := make([]*ent.Organization, 0, len(memberships))
-	for _, membership := range memberships {
-		if membership.Edges.Organization != nil {
-			orgs = append(orgs, membership.Edges.Organization)
-		}
+	query = query.Order(ent.Asc(organization.FieldID)).Offset(offset)
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	orgs, err := query.All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list user organizations: %w", err)
 	}
 
-	return orgs, nil
+	return orgs, total, nil
 }
 
 // UpdateOrganizationRequest represents a request to update an organization
 type UpdateOrganizationRequest struct {
 	Name         *string `json:"name" validate:"omitempty,min=2,max=100"`
 	BillingEmail *string `json:"billing_email" validate:"omitempty,email"`
+	// Mask emails and phones of leads not assigned to members without an
+	// owner or admin role
+	MaskUnassignedContacts *bool `json:"mask_unassigned_contacts"`
 }
 
 // UpdateOrganization updates an organization
@@ -205,6 +213,9 @@ func (s *Service) UpdateOrganization(ctx context.Context, orgID int, req UpdateO
 	if req.BillingEmail != nil {
 		update.SetBillingEmail(*req.BillingEmail)
 	}
+	if req.MaskUnassignedContacts != nil {
+		update.SetMaskUnassignedContacts(*req.MaskUnassignedContacts)
+	}
 
 	org, err := update.Save(ctx)
 	if err != nil {
@@ -454,19 +465,9 @@ func isValidSlug(slug string) bool {
 	return re.MatchString(slug)
 }
 
+// getTierLimit returns the configured usage limit for a tier
 func getTierLimit(tier string) int {
-	switch tier {
-	case "free":
-		return 50
-	case "starter":
-		return 500
-	case "pro":
-		return 2000
-	case "business":
-		return 10000
-	default:
-		return 50
-	}
+	return leads.GetUsageLimitForTier(tier)
 }
 
 // generateInvitationToken generates a secure random token for invitations
@@ -202,12 +202,19 @@ func TestService_ListUserOrganizations(t *testing.T) {
 	require.NoError(t, err)
 
 	// List organizations for owner (should see both)
-	ownerOrgs, err := service.ListUserOrganizations(ctx, ownerID)
+	ownerOrgs, total, err := service.ListUserOrganizations(ctx, ownerID, 0, 0)
 	require.NoError(t, err)
 	assert.Len(t, ownerOrgs, 2)
+	assert.Equal(t, 2, total)
+
+	ownerPage, total, err := service.ListUserOrganizations(ctx, ownerID, 1, 1)
+	require.NoError(t, err)
+	require.Len(t, ownerPage, 1)
+	assert.Equal(t, ownerOrgs[1].ID, ownerPage[0].ID)
+	assert.Equal(t, 2, total)
 
 	// List organizations for member (should see only org1)
-	memberOrgs, err := service.ListUserOrganizations(ctx, memberID)
+	memberOrgs, _, err := service.ListUserOrganizations(ctx, memberID, 0, 0)
 	require.NoError(t, err)
 	assert.Len(t, memberOrgs, 1)
 	assert.Equal(t, org1.ID, memberOrgs[0].ID)
@@ -0,0 +1,64 @@
+package retention
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+// S3Config holds S3 archive configuration
+type S3Config struct {
+	AWSAccessKeyID     string
+	AWSSecretAccessKey string
+	AWSRegion          string
+	Bucket             string
+}
+
+// S3Archiver archives purged rows to S3
+type S3Archiver struct {
+	s3Client *s3.Client
+	bucket   string
+}
+
+// NewS3Archiver creates a new S3 archiver
+func NewS3Archiver(cfg S3Config) (*S3Archiver, error) {
+	awsCfg, err := config.LoadDefaultConfig(context.Background(),
+		config.WithRegion(cfg.AWSRegion),
+		config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(
+			cfg.AWSAccessKeyID,
+			cfg.AWSSecretAccessKey,
+			"",
+		)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS config: %w", err)
+	}
+
+	return &S3Archiver{
+		s3Client: s3.NewFromConfig(awsCfg),
+		bucket:   cfg.Bucket,
+	}, nil
+}
+
+// Archive uploads data to the archive bucket
+func (a *S3Archiver) Archive(ctx context.Context, key string, data []byte) error {
+	_, err := a.s3Client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:          aws.String(a.bucket),
+		Key:             aws.String(key),
+		Body:            bytes.NewReader(data),
+		ContentType:     aws.String("application/x-ndjson"),
+		ContentEncoding: aws.String("gzip"),
+		StorageClass:    types.StorageClassGlacierIr, // Archives are rarely read
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upload to S3: %w", err)
+	}
+
+	return nil
+}
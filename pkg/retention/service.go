@@ -0,0 +1,316 @@
+package retention
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/auditlog"
+	"github.com/jordanlanch/industrydb/ent/usagelog"
+	"github.com/jordanlanch/industrydb/pkg/analytics"
+)
+
+// batchSize is the number of rows archived and deleted per transaction
+const batchSize = 1000
+
+// ErrPurgeInProgress is returned when a purge is started while another runs
+var ErrPurgeInProgress = errors.New("retention purge already in progress")
+
+// Archiver stores purged rows before they are deleted
+type Archiver interface {
+	Archive(ctx context.Context, key string, data []byte) error
+}
+
+// Config holds retention configuration
+type Config struct {
+	UsageLogRetentionDays int // Usage logs older than this are rolled up and purged (0 disables)
+	AuditLogRetentionDays int // Audit logs older than this are purged (0 disables)
+}
+
+// Service purges usage logs and audit logs past their retention window
+type Service struct {
+	db        *ent.Client
+	analytics *analytics.Service
+	config    Config
+	archiver  Archiver
+	mu        sync.Mutex
+}
+
+// NewService creates a new retention service. archiver may be nil, in which
+// case purged rows are deleted without being archived.
+func NewService(db *ent.Client, cfg Config, archiver Archiver) *Service {
+	return &Service{
+		db:        db,
+		analytics: analytics.NewService(db),
+		config:    cfg,
+		archiver:  archiver,
+	}
+}
+
+// TablePreview describes what a purge would remove from one table
+type TablePreview struct {
+	Enabled       bool       `json:"enabled"`
+	RetentionDays int        `json:"retention_days"`
+	Cutoff        *time.Time `json:"cutoff,omitempty"` // Rows created before this are purged
+	Rows          int        `json:"rows"`
+	Oldest        *time.Time `json:"oldest,omitempty"` // Oldest row that would be purged
+}
+
+// Preview describes what a purge would remove
+type Preview struct {
+	UsageLogs TablePreview `json:"usage_logs"`
+	AuditLogs TablePreview `json:"audit_logs"`
+	Archive   bool         `json:"archive"` // Whether purged rows are archived first
+}
+
+// TableResult holds the outcome of purging one table
+type TableResult struct {
+	Purged   int `json:"purged"`
+	Archived int `json:"archived"`
+}
+
+// PurgeResult holds the outcome of a purge
+type PurgeResult struct {
+	UsageLogs         TableResult `json:"usage_logs"`
+	AuditLogs         TableResult `json:"audit_logs"`
+	AggregatesUpdated int         `json:"aggregates_updated"` // Daily rollups written before purging
+	StartedAt         time.Time   `json:"started_at"`
+	FinishedAt        time.Time   `json:"finished_at"`
+}
+
+// cutoff returns the start of the UTC day retentionDays before now, so a day
+// is never partially purged
+func cutoff(now time.Time, retentionDays int) time.Time {
+	return now.UTC().AddDate(0, 0, -retentionDays).Truncate(24 * time.Hour)
+}
+
+// Preview returns how many rows a purge run at now would remove
+func (s *Service) Preview(ctx context.Context, now time.Time) (*Preview, error) {
+	preview := &Preview{
+		UsageLogs: TablePreview{RetentionDays: s.config.UsageLogRetentionDays},
+		AuditLogs: TablePreview{RetentionDays: s.config.AuditLogRetentionDays},
+		Archive:   s.archiver != nil,
+	}
+
+	if s.config.UsageLogRetentionDays > 0 {
+		before := cutoff(now, s.config.UsageLogRetentionDays)
+		query := s.db.UsageLog.Query().Where(usagelog.CreatedAtLT(before))
+
+		rows, err := query.Clone().Count(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count usage logs: %w", err)
+		}
+		preview.UsageLogs.Enabled = true
+		preview.UsageLogs.Cutoff = &before
+		preview.UsageLogs.Rows = rows
+
+		if rows > 0 {
+			oldest, err := query.Order(ent.Asc(usagelog.FieldCreatedAt)).First(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to find oldest usage log: %w", err)
+			}
+			preview.UsageLogs.Oldest = &oldest.CreatedAt
+		}
+	}
+
+	if s.config.AuditLogRetentionDays > 0 {
+		before := cutoff(now, s.config.AuditLogRetentionDays)
+		query := s.db.AuditLog.Query().Where(auditlog.CreatedAtLT(before))
+
+		rows, err := query.Clone().Count(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count audit logs: %w", err)
+		}
+		preview.AuditLogs.Enabled = true
+		preview.AuditLogs.Cutoff = &before
+		preview.AuditLogs.Rows = rows
+
+		if rows > 0 {
+			oldest, err := query.Order(ent.Asc(auditlog.FieldCreatedAt)).First(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to find oldest audit log: %w", err)
+			}
+			preview.AuditLogs.Oldest = &oldest.CreatedAt
+		}
+	}
+
+	return preview, nil
+}
+
+// Purge archives (when an archiver is configured) and deletes usage logs and
+// audit logs past their retention window. Usage logs are first rolled up into
+// daily rollups so historical analytics keep working. actorID is the admin
+// who started the purge, or nil for the scheduled job.
+func (s *Service) Purge(ctx context.Context, now time.Time, actorID *int) (*PurgeResult, error) {
+	if !s.mu.TryLock() {
+		return nil, ErrPurgeInProgress
+	}
+	defer s.mu.Unlock()
+
+	result := &PurgeResult{StartedAt: time.Now()}
+
+	if s.config.UsageLogRetentionDays > 0 {
+		if err := s.purgeUsageLogs(ctx, now, cutoff(now, s.config.UsageLogRetentionDays), result); err != nil {
+			return result, err
+		}
+	}
+
+	if s.config.AuditLogRetentionDays > 0 {
+		if err := s.purgeAuditLogs(ctx, cutoff(now, s.config.AuditLogRetentionDays), result); err != nil {
+			return result, err
+		}
+	}
+
+	result.FinishedAt = time.Now()
+
+	if result.UsageLogs.Purged > 0 || result.AuditLogs.Purged > 0 {
+		s.recordPurge(ctx, result, actorID)
+	}
+
+	return result, nil
+}
+
+// purgeUsageLogs rolls up pending days, then archives and deletes usage
+// logs created before the cutoff. Only rolled-up days are deleted.
+func (s *Service) purgeUsageLogs(ctx context.Context, now, before time.Time, result *PurgeResult) error {
+	rolledUp, err := s.analytics.RollUpPending(ctx, now)
+	if err != nil {
+		return fmt.Errorf("failed to roll up usage logs: %w", err)
+	}
+	result.AggregatesUpdated = rolledUp
+
+	watermark, err := s.analytics.RollupWatermark(ctx)
+	if err != nil {
+		return err
+	}
+	if watermark.Before(before) {
+		before = watermark
+	}
+
+	for {
+		logs, err := s.db.UsageLog.Query().
+			Where(usagelog.CreatedAtLT(before)).
+			Order(ent.Asc(usagelog.FieldID)).
+			Limit(batchSize).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to load usage logs: %w", err)
+		}
+		if len(logs) == 0 {
+			return nil
+		}
+
+		if s.archiver != nil {
+			key := archiveKey("usage_logs", before, logs[0].ID, logs[len(logs)-1].ID)
+			if err := archiveRows(ctx, s.archiver, key, logs); err != nil {
+				return err
+			}
+			result.UsageLogs.Archived += len(logs)
+		}
+
+		ids := make([]int, len(logs))
+		for i, log := range logs {
+			ids[i] = log.ID
+		}
+		if _, err := s.db.UsageLog.Delete().Where(usagelog.IDIn(ids...)).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete usage logs: %w", err)
+		}
+		result.UsageLogs.Purged += len(logs)
+
+		if len(logs) < batchSize {
+			return nil
+		}
+	}
+}
+
+// purgeAuditLogs archives and deletes audit logs created before the cutoff,
+// one batch at a time
+func (s *Service) purgeAuditLogs(ctx context.Context, before time.Time, result *PurgeResult) error {
+	for {
+		logs, err := s.db.AuditLog.Query().
+			Where(auditlog.CreatedAtLT(before)).
+			Order(ent.Asc(auditlog.FieldID)).
+			Limit(batchSize).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to load audit logs: %w", err)
+		}
+		if len(logs) == 0 {
+			return nil
+		}
+
+		if s.archiver != nil {
+			key := archiveKey("audit_logs", before, logs[0].ID, logs[len(logs)-1].ID)
+			if err := archiveRows(ctx, s.archiver, key, logs); err != nil {
+				return err
+			}
+			result.AuditLogs.Archived += len(logs)
+		}
+
+		ids := make([]int, len(logs))
+		for i, log := range logs {
+			ids[i] = log.ID
+		}
+		if _, err := s.db.AuditLog.Delete().Where(auditlog.IDIn(ids...)).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete audit logs: %w", err)
+		}
+		result.AuditLogs.Purged += len(logs)
+
+		if len(logs) < batchSize {
+			return nil
+		}
+	}
+}
+
+// archiveKey returns the archive object key for a batch of rows
+func archiveKey(table string, before time.Time, firstID, lastID int) string {
+	return fmt.Sprintf("retention/%s/%s/%d-%d.jsonl.gz", table, before.Format("2006-01-02"), firstID, lastID)
+}
+
+// archiveRows writes rows to the archiver as gzipped JSON lines
+func archiveRows[T any](ctx context.Context, archiver Archiver, key string, rows []T) error {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	encoder := json.NewEncoder(gz)
+	for _, row := range rows {
+		if err := encoder.Encode(row); err != nil {
+			return fmt.Errorf("failed to encode archive %s: %w", key, err)
+		}
+	}
+	if err := gz.Close(); err != nil {
+		return fmt.Errorf("failed to encode archive %s: %w", key, err)
+	}
+
+	if err := archiver.Archive(ctx, key, buf.Bytes()); err != nil {
+		return fmt.Errorf("failed to archive %s: %w", key, err)
+	}
+	return nil
+}
+
+// recordPurge writes an audit log entry for a purge; failures are not fatal
+func (s *Service) recordPurge(ctx context.Context, result *PurgeResult, actorID *int) {
+	create := s.db.AuditLog.Create().
+		SetAction(auditlog.ActionDataPurge).
+		SetSeverity(auditlog.SeverityWarning).
+		SetResourceType("retention").
+		SetDescription(fmt.Sprintf("Retention purge removed %d usage logs and %d audit logs", result.UsageLogs.Purged, result.AuditLogs.Purged)).
+		SetMetadata(map[string]interface{}{
+			"usage_logs_purged":        result.UsageLogs.Purged,
+			"audit_logs_purged":        result.AuditLogs.Purged,
+			"archived":                 s.archiver != nil,
+			"usage_log_retention_days": s.config.UsageLogRetentionDays,
+			"audit_log_retention_days": s.config.AuditLogRetentionDays,
+		})
+	if actorID != nil {
+		create = create.SetUserID(*actorID)
+	}
+	if err := create.Exec(ctx); err != nil {
+		fmt.Printf("Failed to record retention purge in audit log: %v\n", err)
+	}
+}
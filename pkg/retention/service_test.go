@@ -0,0 +1,243 @@
+package retention
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/auditlog"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/ent/usagedailyaggregate"
+	"github.com/jordanlanch/industrydb/ent/usagelog"
+	"github.com/jordanlanch/industrydb/ent/user"
+	"github.com/jordanlanch/industrydb/pkg/analytics"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryArchiver records archived objects in memory
+type memoryArchiver struct {
+	objects map[string][]byte
+	err     error
+}
+
+func (a *memoryArchiver) Archive(ctx context.Context, key string, data []byte) error {
+	if a.err != nil {
+		return a.err
+	}
+	if a.objects == nil {
+		a.objects = make(map[string][]byte)
+	}
+	a.objects[key] = data
+	return nil
+}
+
+// lines decodes every archived object into JSON lines
+func (a *memoryArchiver) lines(t *testing.T) []map[string]interface{} {
+	var lines []map[string]interface{}
+	for _, data := range a.objects {
+		gz, err := gzip.NewReader(bytes.NewReader(data))
+		require.NoError(t, err)
+		raw, err := io.ReadAll(gz)
+		require.NoError(t, err)
+
+		decoder := json.NewDecoder(bytes.NewReader(raw))
+		for decoder.More() {
+			var line map[string]interface{}
+			require.NoError(t, decoder.Decode(&line))
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+func setupTestDB(t *testing.T) *ent.Client {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func createTestUser(t *testing.T, client *ent.Client, email string) *ent.User {
+	u, err := client.User.Create().
+		SetEmail(email).
+		SetPasswordHash("hashed").
+		SetName("Test User").
+		SetSubscriptionTier(user.SubscriptionTierPro).
+		Save(context.Background())
+	require.NoError(t, err)
+	return u
+}
+
+func createUsageLog(t *testing.T, client *ent.Client, userID int, action usagelog.Action, count int, createdAt time.Time) {
+	_, err := client.UsageLog.Create().
+		SetUserID(userID).
+		SetAction(action).
+		SetCount(count).
+		SetCreatedAt(createdAt).
+		Save(context.Background())
+	require.NoError(t, err)
+}
+
+func createAuditLog(t *testing.T, client *ent.Client, createdAt time.Time) {
+	_, err := client.AuditLog.Create().
+		SetAction(auditlog.ActionUserLogin).
+		SetCreatedAt(createdAt).
+		Save(context.Background())
+	require.NoError(t, err)
+}
+
+func TestPreview(t *testing.T) {
+	client := setupTestDB(t)
+	u := createTestUser(t, client, "preview@example.com")
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	createUsageLog(t, client, u.ID, usagelog.ActionSearch, 10, now.AddDate(0, 0, -200))
+	createUsageLog(t, client, u.ID, usagelog.ActionSearch, 10, now.AddDate(0, 0, -100))
+	createUsageLog(t, client, u.ID, usagelog.ActionSearch, 10, now)
+	createAuditLog(t, client, now.AddDate(-3, 0, 0))
+	createAuditLog(t, client, now.AddDate(0, 0, -200))
+
+	service := NewService(client, Config{UsageLogRetentionDays: 90, AuditLogRetentionDays: 365}, nil)
+
+	preview, err := service.Preview(context.Background(), now)
+	require.NoError(t, err)
+
+	assert.False(t, preview.Archive)
+	assert.True(t, preview.UsageLogs.Enabled)
+	assert.Equal(t, 90, preview.UsageLogs.RetentionDays)
+	assert.Equal(t, time.Date(2026, 7, 18, 0, 0, 0, 0, time.UTC), *preview.UsageLogs.Cutoff)
+	assert.Equal(t, 2, preview.UsageLogs.Rows)
+	assert.True(t, now.AddDate(0, 0, -200).Equal(*preview.UsageLogs.Oldest))
+	assert.Equal(t, 1, preview.AuditLogs.Rows)
+
+	// Preview does not delete anything
+	count, err := client.UsageLog.Query().Count(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+
+	t.Run("disabled tables", func(t *testing.T) {
+		service := NewService(client, Config{}, &memoryArchiver{})
+
+		preview, err := service.Preview(context.Background(), now)
+		require.NoError(t, err)
+		assert.True(t, preview.Archive)
+		assert.False(t, preview.UsageLogs.Enabled)
+		assert.Nil(t, preview.UsageLogs.Cutoff)
+		assert.Equal(t, 0, preview.UsageLogs.Rows)
+		assert.False(t, preview.AuditLogs.Enabled)
+	})
+}
+
+func TestPurge(t *testing.T) {
+	client := setupTestDB(t)
+	ctx := context.Background()
+	u := createTestUser(t, client, "purge@example.com")
+	admin := createTestUser(t, client, "purge-admin@example.com")
+	now := time.Now().UTC()
+	old := now.AddDate(0, 0, -100).Truncate(24 * time.Hour).Add(10 * time.Hour)
+
+	createUsageLog(t, client, u.ID, usagelog.ActionSearch, 10, old)
+	createUsageLog(t, client, u.ID, usagelog.ActionSearch, 5, old.Add(time.Hour))
+	createUsageLog(t, client, u.ID, usagelog.ActionExport, 100, old)
+	createUsageLog(t, client, u.ID, usagelog.ActionSearch, 7, now)
+	createAuditLog(t, client, now.AddDate(-3, 0, 0))
+	createAuditLog(t, client, now)
+
+	analyticsService := analytics.NewService(client)
+	before, err := analyticsService.GetUsageSummary(ctx, u.ID, 180)
+	require.NoError(t, err)
+
+	archiver := &memoryArchiver{}
+	service := NewService(client, Config{UsageLogRetentionDays: 90, AuditLogRetentionDays: 365}, archiver)
+
+	result, err := service.Purge(ctx, now, &admin.ID)
+	require.NoError(t, err)
+
+	assert.Equal(t, TableResult{Purged: 3, Archived: 3}, result.UsageLogs)
+	assert.Equal(t, TableResult{Purged: 1, Archived: 1}, result.AuditLogs)
+	assert.Equal(t, 2, result.AggregatesUpdated)
+	assert.Len(t, archiver.lines(t), 4)
+
+	remaining, err := client.UsageLog.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, remaining)
+
+	search, err := client.UsageDailyAggregate.Query().
+		Where(usagedailyaggregate.ActionEQ(usagedailyaggregate.ActionSearch)).
+		Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, search.Events)
+	assert.Equal(t, 15, search.Count)
+	assert.True(t, old.Truncate(24*time.Hour).Equal(search.Date))
+
+	export, err := client.UsageDailyAggregate.Query().
+		Where(usagedailyaggregate.ActionEQ(usagedailyaggregate.ActionExport)).
+		Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, export.Events)
+	assert.Equal(t, 100, export.Count)
+
+	// Historical analytics are unchanged
+	after, err := analyticsService.GetUsageSummary(ctx, u.ID, 180)
+	require.NoError(t, err)
+	assert.Equal(t, before, after)
+
+	// The purge itself is audited
+	entry, err := client.AuditLog.Query().Where(auditlog.ActionEQ(auditlog.ActionDataPurge)).Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, admin.ID, *entry.UserID)
+	assert.Equal(t, float64(3), entry.Metadata["usage_logs_purged"])
+
+	t.Run("nothing to purge", func(t *testing.T) {
+		result, err := service.Purge(ctx, now, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 0, result.UsageLogs.Purged)
+		assert.Equal(t, 0, result.AuditLogs.Purged)
+
+		purges, err := client.AuditLog.Query().Where(auditlog.ActionEQ(auditlog.ActionDataPurge)).Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, purges)
+	})
+}
+
+func TestPurge_ArchiveFailureKeepsRows(t *testing.T) {
+	client := setupTestDB(t)
+	ctx := context.Background()
+	u := createTestUser(t, client, "archive-fail@example.com")
+	now := time.Now().UTC()
+
+	createUsageLog(t, client, u.ID, usagelog.ActionSearch, 10, now.AddDate(0, 0, -100))
+
+	service := NewService(client, Config{UsageLogRetentionDays: 90}, &memoryArchiver{err: errors.New("bucket unavailable")})
+
+	_, err := service.Purge(ctx, now, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "bucket unavailable")
+
+	count, err := client.UsageLog.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	// The day is rolled up before archiving, so a retry finds it done
+	rollups, err := client.UsageDailyAggregate.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, rollups)
+}
+
+func TestPurge_InProgress(t *testing.T) {
+	client := setupTestDB(t)
+	service := NewService(client, Config{UsageLogRetentionDays: 90}, nil)
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	_, err := service.Purge(context.Background(), time.Now(), nil)
+	assert.ErrorIs(t, err, ErrPurgeInProgress)
+}
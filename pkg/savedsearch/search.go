@@ -0,0 +1,219 @@
+package savedsearch
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/pkg/industries"
+	"github.com/jordanlanch/industrydb/pkg/models"
+)
+
+// ErrNoAvailableIndustries is returned by ToSearchRequest when every industry
+// the filters name was removed from the catalog. Dropping them all would
+// search every industry instead.
+var ErrNoAvailableIndustries = errors.New("none of the saved search's industries are available anymore")
+
+// ToSearchRequest converts stored saved search filters into a lead search
+// request. Filters that can no longer be applied (e.g. an industry that was
+// removed from the catalog, or a malformed value) are dropped and reported
+// as warnings so the search can still run, unless no industry is left of an
+// industry filter (ErrNoAvailableIndustries).
+func ToSearchRequest(filters map[string]interface{}) (models.LeadSearchRequest, []string, error) {
+	var req models.LeadSearchRequest
+	warnings := []string{}
+	removedIndustries := 0
+
+	for key, value := range filters {
+		switch key {
+		case "industry":
+			id, ok := value.(string)
+			if !ok {
+				warnings = append(warnings, invalidValueWarning(key))
+				continue
+			}
+			if !isAvailableIndustry(id) {
+				warnings = append(warnings, unavailableIndustryWarning(id))
+				removedIndustries++
+				continue
+			}
+			req.Industry = id
+		case "industries":
+			ids, ok := toStringSlice(value)
+			if !ok {
+				warnings = append(warnings, invalidValueWarning(key))
+				continue
+			}
+			for _, id := range ids {
+				if !isAvailableIndustry(id) {
+					warnings = append(warnings, unavailableIndustryWarning(id))
+					removedIndustries++
+					continue
+				}
+				req.Industries = append(req.Industries, id)
+			}
+		case "sub_niche", "cuisine_type", "sport_type", "tattoo_style", "country", "city":
+			s, ok := value.(string)
+			if !ok {
+				warnings = append(warnings, invalidValueWarning(key))
+				continue
+			}
+			switch key {
+			case "sub_niche":
+				req.SubNiche = s
+			case "cuisine_type":
+				req.CuisineType = s
+			case "sport_type":
+				req.SportType = s
+			case "tattoo_style":
+				req.TattooStyle = s
+			case "country":
+				req.Country = s
+			case "city":
+				req.City = s
+			}
+		case "source":
+			source, ok := value.(string)
+			if !ok || lead.SourceValidator(lead.Source(source)) != nil {
+				warnings = append(warnings, invalidValueWarning(key))
+				continue
+			}
+			req.Source = source
+		case "specialties":
+			specialties, ok := toStringSlice(value)
+			if !ok {
+				warnings = append(warnings, invalidValueWarning(key))
+				continue
+			}
+			req.Specialties = specialties
+		case "missing":
+			fields, ok := toStringSlice(value)
+			if !ok || !allMissingFields(fields) {
+				warnings = append(warnings, invalidValueWarning(key))
+				continue
+			}
+			req.Missing = fields
+		case "has_email", "has_phone", "has_website", "has_address", "verified":
+			b, ok := value.(bool)
+			if !ok {
+				warnings = append(warnings, invalidValueWarning(key))
+				continue
+			}
+			switch key {
+			case "has_email":
+				req.HasEmail = &b
+			case "has_phone":
+				req.HasPhone = &b
+			case "has_website":
+				req.HasWebsite = &b
+			case "has_address":
+				req.HasAddress = &b
+			case "verified":
+				req.Verified = &b
+			}
+		case "quality_score_min", "quality_score_max":
+			score, ok := toInt(value)
+			if !ok || score < 0 || score > 100 {
+				warnings = append(warnings, invalidValueWarning(key))
+				continue
+			}
+			if key == "quality_score_min" {
+				req.MinQuality = &score
+			} else {
+				req.MaxQuality = &score
+			}
+		case "completeness_score_min", "completeness_score_max":
+			score, ok := toInt(value)
+			if !ok || score < 0 || score > 100 {
+				warnings = append(warnings, invalidValueWarning(key))
+				continue
+			}
+			if key == "completeness_score_min" {
+				req.MinCompleteness = &score
+			} else {
+				req.MaxCompleteness = &score
+			}
+		default:
+			warnings = append(warnings, fmt.Sprintf("filter '%s' is not supported and was ignored", key))
+		}
+	}
+
+	if req.MinQuality != nil && req.MaxQuality != nil && *req.MinQuality > *req.MaxQuality {
+		warnings = append(warnings, "quality_score_min is greater than quality_score_max; quality filters were ignored")
+		req.MinQuality = nil
+		req.MaxQuality = nil
+	}
+	if req.MinCompleteness != nil && req.MaxCompleteness != nil && *req.MinCompleteness > *req.MaxCompleteness {
+		warnings = append(warnings, "completeness_score_min is greater than completeness_score_max; completeness filters were ignored")
+		req.MinCompleteness = nil
+		req.MaxCompleteness = nil
+	}
+
+	if removedIndustries > 0 && req.Industry == "" && len(req.Industries) == 0 {
+		return req, warnings, ErrNoAvailableIndustries
+	}
+
+	return req, warnings, nil
+}
+
+// allMissingFields reports whether every field is one the missing filter
+// accepts
+func allMissingFields(fields []string) bool {
+	for _, field := range fields {
+		switch field {
+		case "email", "phone", "website", "address":
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// isAvailableIndustry reports whether an industry still exists and is active
+func isAvailableIndustry(id string) bool {
+	industry := industries.GetIndustryByID(id)
+	return industry != nil && industry.Active
+}
+
+func unavailableIndustryWarning(id string) string {
+	return fmt.Sprintf("industry '%s' is no longer available and was ignored", id)
+}
+
+func invalidValueWarning(key string) string {
+	return fmt.Sprintf("filter '%s' has an invalid value and was ignored", key)
+}
+
+// toStringSlice converts a JSON-decoded array into a string slice
+func toStringSlice(value interface{}) ([]string, bool) {
+	switch v := value.(type) {
+	case []string:
+		return v, true
+	case []interface{}:
+		result := make([]string, 0, len(v))
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return nil, false
+			}
+			result = append(result, s)
+		}
+		return result, true
+	default:
+		return nil, false
+	}
+}
+
+// toInt converts a JSON-decoded number into an int
+func toInt(value interface{}) (int, bool) {
+	switch v := value.(type) {
+	case int:
+		return v, true
+	case float64:
+		if v != float64(int(v)) {
+			return 0, false
+		}
+		return int(v), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,105 @@
+package savedsearch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToSearchRequest(t *testing.T) {
+	t.Run("maps stored filters", func(t *testing.T) {
+		filters := map[string]interface{}{
+			"industry":               "tattoo",
+			"industries":             []interface{}{"cafe", "bakery"},
+			"specialties":            []interface{}{"realism"},
+			"country":                "US",
+			"city":                   "Austin",
+			"has_email":              true,
+			"has_address":            false,
+			"missing":                []interface{}{"phone"},
+			"verified":               true,
+			"source":                 "osm",
+			"quality_score_min":      float64(40),
+			"quality_score_max":      float64(90),
+			"completeness_score_min": float64(50),
+		}
+
+		req, warnings, err := ToSearchRequest(filters)
+		require.NoError(t, err)
+		assert.Empty(t, warnings)
+		assert.Equal(t, "tattoo", req.Industry)
+		assert.Equal(t, []string{"cafe", "bakery"}, req.Industries)
+		assert.Equal(t, []string{"realism"}, req.Specialties)
+		assert.Equal(t, "US", req.Country)
+		assert.Equal(t, "Austin", req.City)
+		require.NotNil(t, req.HasEmail)
+		assert.True(t, *req.HasEmail)
+		require.NotNil(t, req.HasAddress)
+		assert.False(t, *req.HasAddress)
+		assert.Equal(t, []string{"phone"}, req.Missing)
+		require.NotNil(t, req.Verified)
+		assert.True(t, *req.Verified)
+		assert.Equal(t, "osm", req.Source)
+		require.NotNil(t, req.MinQuality)
+		assert.Equal(t, 40, *req.MinQuality)
+		require.NotNil(t, req.MaxQuality)
+		assert.Equal(t, 90, *req.MaxQuality)
+		require.NotNil(t, req.MinCompleteness)
+		assert.Equal(t, 50, *req.MinCompleteness)
+		assert.Nil(t, req.MaxCompleteness)
+	})
+
+	t.Run("drops removed industries with a warning", func(t *testing.T) {
+		req, warnings, err := ToSearchRequest(map[string]interface{}{
+			"industry":   "blacksmith",
+			"industries": []interface{}{"cafe", "telegraph_office"},
+		})
+		require.NoError(t, err)
+
+		assert.Empty(t, req.Industry)
+		assert.Equal(t, []string{"cafe"}, req.Industries)
+		assert.Len(t, warnings, 2)
+		assert.Contains(t, warnings, "industry 'blacksmith' is no longer available and was ignored")
+		assert.Contains(t, warnings, "industry 'telegraph_office' is no longer available and was ignored")
+	})
+
+	t.Run("refuses to drop every industry", func(t *testing.T) {
+		_, warnings, err := ToSearchRequest(map[string]interface{}{
+			"industries": []interface{}{"blacksmith", "telegraph_office"},
+			"country":    "US",
+		})
+
+		assert.ErrorIs(t, err, ErrNoAvailableIndustries)
+		assert.Len(t, warnings, 2)
+	})
+
+	t.Run("ignores malformed values", func(t *testing.T) {
+		req, warnings, err := ToSearchRequest(map[string]interface{}{
+			"has_email":         "yes",
+			"quality_score_min": float64(150),
+			"source":            "carrier_pigeon",
+			"missing":           []interface{}{"fax"},
+			"unknown":           1,
+		})
+		require.NoError(t, err)
+
+		assert.Nil(t, req.HasEmail)
+		assert.Nil(t, req.MinQuality)
+		assert.Empty(t, req.Source)
+		assert.Nil(t, req.Missing)
+		assert.Len(t, warnings, 5)
+	})
+
+	t.Run("ignores inverted quality range", func(t *testing.T) {
+		req, warnings, err := ToSearchRequest(map[string]interface{}{
+			"quality_score_min": float64(80),
+			"quality_score_max": float64(20),
+		})
+		require.NoError(t, err)
+
+		assert.Nil(t, req.MinQuality)
+		assert.Nil(t, req.MaxQuality)
+		assert.Len(t, warnings, 1)
+	})
+}
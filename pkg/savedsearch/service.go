@@ -28,13 +28,28 @@ func (s *Service) Create(ctx context.Context, userID int, name string, filters m
 		Save(ctx)
 }
 
-// List lists all saved searches for a user
-func (s *Service) List(ctx context.Context, userID int) ([]*ent.SavedSearch, error) {
-	return s.db.SavedSearch.
+// List lists the saved searches of a user, newest first, with their total.
+// Up to limit searches after offset are loaded; a limit of 0 loads every
+// search.
+func (s *Service) List(ctx context.Context, userID, limit, offset int) ([]*ent.SavedSearch, int, error) {
+	query := s.db.SavedSearch.
 		Query().
-		Where(savedsearch.UserIDEQ(userID)).
-		Order(ent.Desc(savedsearch.FieldCreatedAt)).
-		All(ctx)
+		Where(savedsearch.UserIDEQ(userID))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query = query.Order(ent.Desc(savedsearch.FieldCreatedAt), ent.Desc(savedsearch.FieldID)).Offset(offset)
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	searches, err := query.All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return searches, total, nil
 }
 
 // Get retrieves a specific saved search
@@ -63,6 +78,10 @@ func (s *Service) Update(ctx context.Context, searchID, userID int, name *string
 	}
 
 	if filters != nil {
+		// Earlier results no longer compare with the new filters
+		if err := s.deleteSnapshots(ctx, search.ID); err != nil {
+			return nil, err
+		}
 		update = update.SetFilters(filters)
 	}
 
@@ -77,6 +96,10 @@ func (s *Service) Delete(ctx context.Context, searchID, userID int) error {
 		return err
 	}
 
+	if err := s.deleteSnapshots(ctx, search.ID); err != nil {
+		return err
+	}
+
 	return s.db.SavedSearch.DeleteOne(search).Exec(ctx)
 }
 
@@ -108,20 +131,25 @@ func (s *Service) Exists(ctx context.Context, userID int, name string) (bool, er
 func ValidateFilters(filters map[string]interface{}) error {
 	// Allowed filter keys
 	allowedKeys := map[string]bool{
-		"industry":           true,
-		"sub_niche":          true,
-		"specialties":        true,
-		"cuisine_type":       true,
-		"sport_type":         true,
-		"tattoo_style":       true,
-		"country":            true,
-		"city":               true,
-		"has_email":          true,
-		"has_phone":          true,
-		"has_website":        true,
-		"verified":           true,
-		"quality_score_min":  true,
-		"quality_score_max":  true,
+		"industry":               true,
+		"industries":             true,
+		"sub_niche":              true,
+		"specialties":            true,
+		"cuisine_type":           true,
+		"sport_type":             true,
+		"tattoo_style":           true,
+		"country":                true,
+		"city":                   true,
+		"has_email":              true,
+		"has_phone":              true,
+		"has_website":            true,
+		"has_address":            true,
+		"verified":               true,
+		"source":                 true,
+		"quality_score_min":      true,
+		"quality_score_max":      true,
+		"completeness_score_min": true,
+		"completeness_score_max": true,
 	}
 
 	// Check for invalid keys
@@ -99,16 +99,24 @@ func TestService_List(t *testing.T) {
 	require.NoError(t, err)
 
 	// List searches for user 1
-	searches, err := service.List(ctx, userID1)
+	searches, total, err := service.List(ctx, userID1, 0, 0)
 	require.NoError(t, err)
 	assert.Len(t, searches, 2)
+	assert.Equal(t, 2, total)
+
+	// Pages are loaded with limit and offset, the total counts every search
+	page, total, err := service.List(ctx, userID1, 1, 1)
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	assert.Equal(t, searches[1].ID, page[0].ID)
+	assert.Equal(t, 2, total)
 
 	// Verify results are sorted by created_at DESC (newest first)
 	assert.Equal(t, "Search 2", searches[0].Name)
 	assert.Equal(t, "Search 1", searches[1].Name)
 
 	// List searches for user 2
-	searches, err = service.List(ctx, userID2)
+	searches, _, err = service.List(ctx, userID2, 0, 0)
 	require.NoError(t, err)
 	assert.Len(t, searches, 1)
 	assert.Equal(t, "Search 3", searches[0].Name)
@@ -234,7 +242,7 @@ func TestService_Delete(t *testing.T) {
 	assert.Error(t, err, "Search should be deleted")
 
 	// Verify list is empty
-	searches, err := service.List(ctx, userID)
+	searches, _, err := service.List(ctx, userID, 0, 0)
 	require.NoError(t, err)
 	assert.Len(t, searches, 0)
 }
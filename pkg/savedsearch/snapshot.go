@@ -0,0 +1,193 @@
+package savedsearch
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/savedsearchsnapshot"
+)
+
+const (
+	// SnapshotRetention is how many result snapshots are kept per saved search
+	SnapshotRetention = 10
+	// MaxSnapshotLeads is the most lead IDs a snapshot stores; larger results
+	// are cut off and their diffs marked truncated
+	MaxSnapshotLeads = 50000
+)
+
+// ResultDiff is how a saved search's results changed since its previous run
+type ResultDiff struct {
+	Added   []int `json:"added"`
+	Removed []int `json:"removed"`
+	// Previous run compared against, nil on the first tracked run
+	PreviousRunAt *string `json:"previous_run_at,omitempty"`
+	// Either run matched more than MaxSnapshotLeads leads, so the diff only
+	// covers the lowest lead IDs
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// SetTrackChanges turns result snapshots on or off for a saved search.
+// Turning tracking off deletes the stored snapshots.
+func (s *Service) SetTrackChanges(ctx context.Context, searchID, userID int, enabled bool) (*ent.SavedSearch, error) {
+	search, err := s.Get(ctx, searchID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !enabled {
+		if err := s.deleteSnapshots(ctx, search.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.db.SavedSearch.UpdateOne(search).SetTrackChanges(enabled).Save(ctx)
+}
+
+// RecordSnapshot stores the lead IDs a run matched, prunes snapshots beyond
+// SnapshotRetention and returns the changes since the previous snapshot
+func (s *Service) RecordSnapshot(ctx context.Context, searchID int, leadIDs []int, truncated bool) (*ResultDiff, error) {
+	previous, err := s.db.SavedSearchSnapshot.Query().
+		Where(savedsearchsnapshot.SavedSearchID(searchID)).
+		Order(ent.Desc(savedsearchsnapshot.FieldCreatedAt), ent.Desc(savedsearchsnapshot.FieldID)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get previous snapshot: %w", err)
+	}
+
+	current, err := s.db.SavedSearchSnapshot.Create().
+		SetSavedSearchID(searchID).
+		SetLeadIds(encodeLeadIDs(leadIDs)).
+		SetLeadCount(len(leadIDs)).
+		SetTruncated(truncated).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save snapshot: %w", err)
+	}
+
+	if err := s.pruneSnapshots(ctx, searchID); err != nil {
+		return nil, err
+	}
+
+	return diffSnapshots(previous, current)
+}
+
+// LatestDiff returns the changes between the two most recent snapshots, so
+// paging through a run reports the same diff as its first page. It returns
+// nil when the search has never been run with tracking on.
+func (s *Service) LatestDiff(ctx context.Context, searchID int) (*ResultDiff, error) {
+	snapshots, err := s.db.SavedSearchSnapshot.Query().
+		Where(savedsearchsnapshot.SavedSearchID(searchID)).
+		Order(ent.Desc(savedsearchsnapshot.FieldCreatedAt), ent.Desc(savedsearchsnapshot.FieldID)).
+		Limit(2).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get snapshots: %w", err)
+	}
+
+	switch len(snapshots) {
+	case 0:
+		return nil, nil
+	case 1:
+		return diffSnapshots(nil, snapshots[0])
+	}
+	return diffSnapshots(snapshots[1], snapshots[0])
+}
+
+// pruneSnapshots deletes all but the newest SnapshotRetention snapshots
+func (s *Service) pruneSnapshots(ctx context.Context, searchID int) error {
+	stale, err := s.db.SavedSearchSnapshot.Query().
+		Where(savedsearchsnapshot.SavedSearchID(searchID)).
+		Order(ent.Desc(savedsearchsnapshot.FieldCreatedAt), ent.Desc(savedsearchsnapshot.FieldID)).
+		Offset(SnapshotRetention).
+		IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to find stale snapshots: %w", err)
+	}
+	if len(stale) == 0 {
+		return nil
+	}
+
+	if _, err := s.db.SavedSearchSnapshot.Delete().Where(savedsearchsnapshot.IDIn(stale...)).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to prune snapshots: %w", err)
+	}
+	return nil
+}
+
+// deleteSnapshots deletes every snapshot of a saved search
+func (s *Service) deleteSnapshots(ctx context.Context, searchID int) error {
+	if _, err := s.db.SavedSearchSnapshot.Delete().Where(savedsearchsnapshot.SavedSearchID(searchID)).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete snapshots: %w", err)
+	}
+	return nil
+}
+
+// diffSnapshots compares two snapshots; previous may be nil
+func diffSnapshots(previous, current *ent.SavedSearchSnapshot) (*ResultDiff, error) {
+	currentIDs, err := decodeLeadIDs(current.LeadIds)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &ResultDiff{Added: []int{}, Removed: []int{}, Truncated: current.Truncated}
+	if previous == nil {
+		return diff, nil
+	}
+
+	previousIDs, err := decodeLeadIDs(previous.LeadIds)
+	if err != nil {
+		return nil, err
+	}
+	previousRunAt := previous.CreatedAt.Format(time.RFC3339)
+	diff.PreviousRunAt = &previousRunAt
+	diff.Truncated = diff.Truncated || previous.Truncated
+
+	// Both lists are sorted ascending, so merge them
+	i, j := 0, 0
+	for i < len(previousIDs) || j < len(currentIDs) {
+		switch {
+		case j == len(currentIDs) || (i < len(previousIDs) && previousIDs[i] < currentIDs[j]):
+			diff.Removed = append(diff.Removed, previousIDs[i])
+			i++
+		case i == len(previousIDs) || currentIDs[j] < previousIDs[i]:
+			diff.Added = append(diff.Added, currentIDs[j])
+			j++
+		default:
+			i++
+			j++
+		}
+	}
+
+	return diff, nil
+}
+
+// encodeLeadIDs stores ascending lead IDs as varint gaps between
+// consecutive IDs, a few bytes per lead instead of a JSON number each
+func encodeLeadIDs(ids []int) []byte {
+	buf := make([]byte, 0, len(ids)*2)
+	previous := 0
+	for _, id := range ids {
+		buf = binary.AppendUvarint(buf, uint64(id-previous))
+		previous = id
+	}
+	return buf
+}
+
+// decodeLeadIDs reverses encodeLeadIDs
+func decodeLeadIDs(buf []byte) ([]int, error) {
+	var ids []int
+	previous := 0
+	for len(buf) > 0 {
+		gap, n := binary.Uvarint(buf)
+		if n <= 0 {
+			return nil, errors.New("corrupt saved search snapshot")
+		}
+		previous += int(gap)
+		ids = append(ids, previous)
+		buf = buf[n:]
+	}
+	return ids, nil
+}
@@ -0,0 +1,107 @@
+package savedsearch
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/ent/savedsearchsnapshot"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeLeadIDs(t *testing.T) {
+	ids := []int{3, 4, 150, 70000, 70001}
+	encoded := encodeLeadIDs(ids)
+	assert.Less(t, len(encoded), len(ids)*4)
+
+	decoded, err := decodeLeadIDs(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, ids, decoded)
+
+	decoded, err = decodeLeadIDs(encodeLeadIDs(nil))
+	require.NoError(t, err)
+	assert.Empty(t, decoded)
+
+	_, err = decodeLeadIDs([]byte{0x80})
+	assert.Error(t, err)
+}
+
+func TestService_Snapshots(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:savedsearch_snapshots?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	service := NewService(client)
+	userID := createTestUser(t, client, "diff@example.com")
+
+	search, err := service.Create(ctx, userID, "Austin Tattoo", map[string]interface{}{"industry": "tattoo"})
+	require.NoError(t, err)
+	search, err = service.SetTrackChanges(ctx, search.ID, userID, true)
+	require.NoError(t, err)
+	assert.True(t, search.TrackChanges)
+
+	t.Run("First run has nothing to compare", func(t *testing.T) {
+		diff, err := service.RecordSnapshot(ctx, search.ID, []int{1, 2, 3}, false)
+		require.NoError(t, err)
+		assert.Nil(t, diff.PreviousRunAt)
+		assert.Empty(t, diff.Added)
+		assert.Empty(t, diff.Removed)
+	})
+
+	t.Run("Later runs report added and removed leads", func(t *testing.T) {
+		diff, err := service.RecordSnapshot(ctx, search.ID, []int{2, 3, 5, 8}, false)
+		require.NoError(t, err)
+		require.NotNil(t, diff.PreviousRunAt)
+		assert.Equal(t, []int{5, 8}, diff.Added)
+		assert.Equal(t, []int{1}, diff.Removed)
+
+		latest, err := service.LatestDiff(ctx, search.ID)
+		require.NoError(t, err)
+		assert.Equal(t, diff, latest)
+	})
+
+	t.Run("Old snapshots are pruned", func(t *testing.T) {
+		for i := 0; i < SnapshotRetention+3; i++ {
+			_, err := service.RecordSnapshot(ctx, search.ID, []int{i}, i == 0)
+			require.NoError(t, err)
+		}
+		count, err := client.SavedSearchSnapshot.Query().Where(savedsearchsnapshot.SavedSearchID(search.ID)).Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, SnapshotRetention, count)
+	})
+
+	t.Run("Changing filters restarts the diff", func(t *testing.T) {
+		_, err := service.Update(ctx, search.ID, userID, nil, map[string]interface{}{"industry": "barber"})
+		require.NoError(t, err)
+
+		latest, err := service.LatestDiff(ctx, search.ID)
+		require.NoError(t, err)
+		assert.Nil(t, latest)
+	})
+
+	t.Run("Turning tracking off discards snapshots", func(t *testing.T) {
+		_, err := service.RecordSnapshot(ctx, search.ID, []int{1}, false)
+		require.NoError(t, err)
+
+		search, err := service.SetTrackChanges(ctx, search.ID, userID, false)
+		require.NoError(t, err)
+		assert.False(t, search.TrackChanges)
+
+		count, err := client.SavedSearchSnapshot.Query().Count(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, count)
+	})
+
+	t.Run("Deleting the search deletes its snapshots", func(t *testing.T) {
+		_, err := service.SetTrackChanges(ctx, search.ID, userID, true)
+		require.NoError(t, err)
+		_, err = service.RecordSnapshot(ctx, search.ID, []int{1}, false)
+		require.NoError(t, err)
+
+		require.NoError(t, service.Delete(ctx, search.ID, userID))
+		count, err := client.SavedSearchSnapshot.Query().Count(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, count)
+	})
+}
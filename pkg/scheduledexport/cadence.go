@@ -0,0 +1,82 @@
+package scheduledexport
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// Cadence errors
+var (
+	ErrInvalidCadence     = errors.New("invalid cadence")
+	ErrCadenceTooFrequent = errors.New("cadence runs more often than the plan allows")
+	ErrSchedulesNotInPlan = errors.New("scheduled exports are not available on this plan")
+	ErrScheduleLimit      = errors.New("scheduled export limit reached for this plan")
+)
+
+// TierLimits bounds the scheduled exports of a subscription tier: how many
+// active schedules it may have and the shortest interval between two runs
+type TierLimits struct {
+	MaxSchedules int           `json:"max_schedules"`
+	MinInterval  time.Duration `json:"min_interval"`
+}
+
+// tierLimits are the scheduled export limits of each tier. The free tier
+// has no scheduled exports.
+var tierLimits = map[string]TierLimits{
+	"free":     {MaxSchedules: 0},
+	"starter":  {MaxSchedules: 2, MinInterval: 7 * 24 * time.Hour},
+	"pro":      {MaxSchedules: 10, MinInterval: 24 * time.Hour},
+	"business": {MaxSchedules: 50, MinInterval: time.Hour},
+}
+
+// GetLimitsForTier returns the scheduled export limits of a subscription
+// tier. Unknown tiers get the free tier limits.
+func GetLimitsForTier(tier string) TierLimits {
+	if limits, ok := tierLimits[tier]; ok {
+		return limits
+	}
+	return tierLimits["free"]
+}
+
+// intervalSamples is how many upcoming runs are checked against the tier's
+// minimum interval, enough to cover irregular cadences like "0 9 1,2 * *"
+const intervalSamples = 20
+
+// ParseCadence parses a cron expression: 5 fields ("0 9 * * 1" is every
+// Monday at 9:00 UTC), a descriptor (@daily, @weekly, @monthly), or either
+// prefixed with a time zone ("CRON_TZ=Europe/Madrid 0 9 * * 1").
+func ParseCadence(cadence string) (cron.Schedule, error) {
+	schedule, err := cron.ParseStandard(cadence)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCadence, err)
+	}
+	return schedule, nil
+}
+
+// CheckCadence verifies a schedule runs no more often than the tier allows,
+// comparing the gaps between its next runs after from
+func CheckCadence(schedule cron.Schedule, tier string, from time.Time) error {
+	limits := GetLimitsForTier(tier)
+	if limits.MaxSchedules == 0 {
+		return ErrSchedulesNotInPlan
+	}
+
+	prev := schedule.Next(from)
+	if prev.IsZero() {
+		return fmt.Errorf("%w: it never runs", ErrInvalidCadence)
+	}
+	for i := 1; i < intervalSamples; i++ {
+		next := schedule.Next(prev)
+		if next.IsZero() {
+			break
+		}
+		if next.Sub(prev) < limits.MinInterval {
+			return fmt.Errorf("%w: the %s plan runs schedules at most every %s", ErrCadenceTooFrequent, tier, limits.MinInterval)
+		}
+		prev = next
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package scheduledexport
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCadence(t *testing.T) {
+	tests := []struct {
+		cadence string
+		valid   bool
+	}{
+		{"0 9 * * 1", true},
+		{"@daily", true},
+		{"@weekly", true},
+		{"CRON_TZ=Europe/Madrid 0 9 * * 1", true},
+		{"", false},
+		{"every monday", false},
+		{"0 0 9 * * 1", false},
+		{"CRON_TZ=Nowhere/Land 0 9 * * 1", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.cadence, func(t *testing.T) {
+			_, err := ParseCadence(tt.cadence)
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrInvalidCadence)
+			}
+		})
+	}
+}
+
+func TestCheckCadence(t *testing.T) {
+	from := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		cadence  string
+		tier     string
+		expected error
+	}{
+		{"Free tier has no schedules", "@weekly", "free", ErrSchedulesNotInPlan},
+		{"Unknown tier is free", "@weekly", "platinum", ErrSchedulesNotInPlan},
+		{"Starter weekly", "0 9 * * 1", "starter", nil},
+		{"Starter daily", "@daily", "starter", ErrCadenceTooFrequent},
+		{"Starter monthly", "@monthly", "starter", nil},
+		{"Pro daily", "@daily", "pro", nil},
+		{"Pro hourly", "@hourly", "pro", ErrCadenceTooFrequent},
+		{"Pro twice a month on consecutive days", "0 9 1,2 * *", "pro", nil},
+		{"Pro twice on the same day", "0 9,10 1 * *", "pro", ErrCadenceTooFrequent},
+		{"Business hourly", "@hourly", "business", nil},
+		{"Business every minute", "* * * * *", "business", ErrCadenceTooFrequent},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := ParseCadence(tt.cadence)
+			require.NoError(t, err)
+
+			err = CheckCadence(schedule, tt.tier, from)
+			if tt.expected == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestGetLimitsForTier(t *testing.T) {
+	assert.Equal(t, 0, GetLimitsForTier("free").MaxSchedules)
+	assert.Equal(t, 10, GetLimitsForTier("pro").MaxSchedules)
+	assert.Equal(t, time.Hour, GetLimitsForTier("business").MinInterval)
+	assert.Equal(t, GetLimitsForTier("free"), GetLimitsForTier("unknown"))
+}
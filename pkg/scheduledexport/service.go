@@ -0,0 +1,533 @@
+package scheduledexport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/organizationmember"
+	"github.com/jordanlanch/industrydb/ent/savedsearch"
+	"github.com/jordanlanch/industrydb/ent/scheduledexport"
+	"github.com/jordanlanch/industrydb/pkg/export"
+	"github.com/jordanlanch/industrydb/pkg/models"
+	searchpkg "github.com/jordanlanch/industrydb/pkg/savedsearch"
+	"github.com/jordanlanch/industrydb/pkg/webhook"
+	"github.com/robfig/cron/v3"
+)
+
+// Scheduled export errors
+var (
+	ErrScheduleNotFound      = errors.New("scheduled export not found")
+	ErrSavedSearchNotFound   = errors.New("saved search not found")
+	ErrSavedSearchDeleted    = errors.New("the schedule's saved search was deleted")
+	ErrAmbiguousSource       = errors.New("set either saved_search_id or filters, not both")
+	ErrInvalidFilters        = errors.New("invalid filters")
+	ErrNotOrganizationMember = errors.New("not a member of the organization")
+	ErrDeliveryURLRequired   = errors.New("delivery_url is required for url delivery")
+)
+
+// Delivery methods
+const (
+	DeliveryDownload = "download"
+	DeliveryURL      = "url"
+)
+
+// Exporter creates exports, queued and delivered like POST /exports
+type Exporter interface {
+	CreateExport(ctx context.Context, userID int, organizationID *int, req models.ExportRequest) (*models.ExportResponse, error)
+}
+
+// TierResolver returns the subscription tier governing a user's exports,
+// the organization's when organizationID is set
+type TierResolver interface {
+	GetExportTier(ctx context.Context, userID int, organizationID *int) (string, error)
+}
+
+// CreateRequest creates a scheduled export. Leads are selected by either a
+// saved search of the user or inline filters in saved search format.
+type CreateRequest struct {
+	Name            string                 `json:"name" validate:"required,max=100"`
+	Format          string                 `json:"format" validate:"required,oneof=csv excel vcard geojson kml"`
+	Columns         []string               `json:"columns,omitempty" validate:"omitempty,max=50"`
+	SavedSearchID   *int                   `json:"saved_search_id,omitempty" validate:"omitempty,min=1"`
+	Filters         map[string]interface{} `json:"filters,omitempty"`
+	MaxLeads        int                    `json:"max_leads,omitempty" validate:"omitempty,min=1,max=10000"`
+	SinceLastExport bool                   `json:"since_last_export,omitempty"` // Only leads new or updated since the previous run
+	Cadence         string                 `json:"cadence" validate:"required,max=100"`
+	DeliveryMethod  string                 `json:"delivery_method,omitempty" validate:"omitempty,oneof=download url"`
+	DeliveryURL     string                 `json:"delivery_url,omitempty" validate:"omitempty,url,max=2048"`
+	OrganizationID  *int                   `json:"organization_id,omitempty" validate:"omitempty,min=1"` // Run as an organization
+}
+
+// ScheduleResponse is a scheduled export
+type ScheduleResponse struct {
+	ID              int                    `json:"id"`
+	OrganizationID  *int                   `json:"organization_id,omitempty"`
+	Name            string                 `json:"name"`
+	Format          string                 `json:"format"`
+	Columns         []string               `json:"columns,omitempty"`
+	SavedSearchID   *int                   `json:"saved_search_id,omitempty"`
+	Filters         map[string]interface{} `json:"filters,omitempty"`
+	MaxLeads        int                    `json:"max_leads,omitempty"`
+	SinceLastExport bool                   `json:"since_last_export"`
+	Cadence         string                 `json:"cadence"`
+	DeliveryMethod  string                 `json:"delivery_method"`
+	DeliveryURL     string                 `json:"delivery_url,omitempty"`
+	DeliverySecret  string                 `json:"delivery_secret,omitempty"` // Only returned on creation
+	Status          string                 `json:"status"`
+	NextRunAt       string                 `json:"next_run_at,omitempty"` // Unset while paused
+	LastRunAt       string                 `json:"last_run_at,omitempty"`
+	LastExportID    *int                   `json:"last_export_id,omitempty"`
+	LastError       string                 `json:"last_error,omitempty"`
+	RunCount        int                    `json:"run_count"`
+	CreatedAt       string                 `json:"created_at"`
+	UpdatedAt       string                 `json:"updated_at"`
+}
+
+// Service handles scheduled export business logic
+type Service struct {
+	db       *ent.Client
+	exporter Exporter
+	tiers    TierResolver
+	now      func() time.Time
+}
+
+// NewService creates a new scheduled export service
+func NewService(db *ent.Client, exporter Exporter, tiers TierResolver) *Service {
+	return &Service{db: db, exporter: exporter, tiers: tiers, now: time.Now}
+}
+
+// Create saves a scheduled export for the user, running as
+// req.OrganizationID when set. The cadence and the number of active
+// schedules are limited by the governing tier.
+func (s *Service) Create(ctx context.Context, userID int, req CreateRequest) (*ScheduleResponse, error) {
+	if (req.SavedSearchID == nil) == (req.Filters == nil) {
+		return nil, ErrAmbiguousSource
+	}
+	if err := export.ValidateColumns(req.Columns); err != nil {
+		return nil, err
+	}
+
+	deliveryMethod := req.DeliveryMethod
+	if deliveryMethod == "" {
+		deliveryMethod = DeliveryDownload
+	}
+	if deliveryMethod == DeliveryURL {
+		if req.DeliveryURL == "" {
+			return nil, ErrDeliveryURLRequired
+		}
+		if err := export.ValidateDeliveryURL(ctx, req.DeliveryURL); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.SavedSearchID != nil {
+		owned, err := s.db.SavedSearch.Query().
+			Where(savedsearch.ID(*req.SavedSearchID), savedsearch.UserID(userID)).
+			Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get saved search: %w", err)
+		}
+		if !owned {
+			return nil, ErrSavedSearchNotFound
+		}
+	} else if err := searchpkg.ValidateFilters(req.Filters); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFilters, err)
+	}
+
+	if req.OrganizationID != nil {
+		isMember, err := s.isMember(ctx, *req.OrganizationID, userID)
+		if err != nil {
+			return nil, err
+		}
+		if !isMember {
+			return nil, ErrNotOrganizationMember
+		}
+	}
+
+	schedule, err := ParseCadence(req.Cadence)
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	if err := s.checkTier(ctx, userID, req.OrganizationID, schedule, now, 0); err != nil {
+		return nil, err
+	}
+
+	create := s.db.ScheduledExport.Create().
+		SetUserID(userID).
+		SetNillableOrganizationID(req.OrganizationID).
+		SetName(req.Name).
+		SetFormat(scheduledexport.Format(req.Format)).
+		SetNillableSavedSearchID(req.SavedSearchID).
+		SetMaxLeads(req.MaxLeads).
+		SetSinceLastExport(req.SinceLastExport).
+		SetCadence(req.Cadence).
+		SetDeliveryMethod(scheduledexport.DeliveryMethod(deliveryMethod)).
+		SetNextRunAt(schedule.Next(now))
+	if len(req.Columns) > 0 {
+		create = create.SetColumns(req.Columns)
+	}
+	if req.Filters != nil {
+		create = create.SetFilters(req.Filters)
+	}
+	// Every delivery of the schedule is signed with one secret, returned once
+	var secret string
+	if deliveryMethod == DeliveryURL {
+		secret, err = webhook.GenerateSecret()
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate delivery secret: %w", err)
+		}
+		create = create.SetDeliveryURL(req.DeliveryURL).SetDeliverySecret(secret)
+	}
+
+	scheduled, err := create.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create scheduled export: %w", err)
+	}
+
+	response := toScheduleResponse(scheduled)
+	response.DeliverySecret = secret
+	return &response, nil
+}
+
+// List lists the user's scheduled exports, newest first
+func (s *Service) List(ctx context.Context, userID int) ([]ScheduleResponse, error) {
+	schedules, err := s.db.ScheduledExport.Query().
+		Where(scheduledexport.UserID(userID)).
+		Order(ent.Desc(scheduledexport.FieldCreatedAt), ent.Desc(scheduledexport.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scheduled exports: %w", err)
+	}
+
+	data := make([]ScheduleResponse, len(schedules))
+	for i, scheduled := range schedules {
+		data[i] = toScheduleResponse(scheduled)
+	}
+	return data, nil
+}
+
+// Get retrieves a scheduled export of the user
+func (s *Service) Get(ctx context.Context, scheduleID, userID int) (*ScheduleResponse, error) {
+	scheduled, err := s.get(ctx, scheduleID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := toScheduleResponse(scheduled)
+	return &response, nil
+}
+
+// Pause stops a scheduled export from running until resumed
+func (s *Service) Pause(ctx context.Context, scheduleID, userID int) (*ScheduleResponse, error) {
+	scheduled, err := s.get(ctx, scheduleID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduled, err = scheduled.Update().
+		SetStatus(scheduledexport.StatusPaused).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pause scheduled export: %w", err)
+	}
+
+	response := toScheduleResponse(scheduled)
+	return &response, nil
+}
+
+// Resume reactivates a paused scheduled export from its next run after now,
+// checking the cadence against the current tier again
+func (s *Service) Resume(ctx context.Context, scheduleID, userID int) (*ScheduleResponse, error) {
+	scheduled, err := s.get(ctx, scheduleID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if scheduled.Status == scheduledexport.StatusActive {
+		response := toScheduleResponse(scheduled)
+		return &response, nil
+	}
+
+	schedule, err := ParseCadence(scheduled.Cadence)
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	if err := s.checkTier(ctx, userID, scheduled.OrganizationID, schedule, now, scheduled.ID); err != nil {
+		return nil, err
+	}
+
+	scheduled, err = scheduled.Update().
+		SetStatus(scheduledexport.StatusActive).
+		SetNextRunAt(schedule.Next(now)).
+		ClearLastError().
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resume scheduled export: %w", err)
+	}
+
+	response := toScheduleResponse(scheduled)
+	return &response, nil
+}
+
+// Delete deletes a scheduled export of the user. Exports it created are kept.
+func (s *Service) Delete(ctx context.Context, scheduleID, userID int) error {
+	deleted, err := s.db.ScheduledExport.Delete().
+		Where(scheduledexport.ID(scheduleID), scheduledexport.UserID(userID)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete scheduled export: %w", err)
+	}
+	if deleted == 0 {
+		return ErrScheduleNotFound
+	}
+	return nil
+}
+
+// RunDue creates the exports of the active schedules due at now and
+// returns how many were created. Each schedule is claimed by moving its
+// next run forward first, so concurrent runners never export it twice.
+// Schedules that can no longer run, e.g. after a downgrade or when their
+// saved search was deleted, are paused with the reason in last_error.
+func (s *Service) RunDue(ctx context.Context, now time.Time) (int, error) {
+	due, err := s.db.ScheduledExport.Query().
+		Where(
+			scheduledexport.StatusEQ(scheduledexport.StatusActive),
+			scheduledexport.NextRunAtLTE(now),
+		).
+		Order(ent.Asc(scheduledexport.FieldNextRunAt)).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load due scheduled exports: %w", err)
+	}
+
+	created := 0
+	for _, scheduled := range due {
+		ok, err := s.run(ctx, scheduled, now)
+		if err != nil {
+			log.Printf("⚠️  Scheduled export %d failed: %v", scheduled.ID, err)
+		}
+		if ok {
+			created++
+		}
+	}
+	return created, nil
+}
+
+// run claims and runs one due schedule, reporting whether it created an export
+func (s *Service) run(ctx context.Context, scheduled *ent.ScheduledExport, now time.Time) (bool, error) {
+	schedule, err := ParseCadence(scheduled.Cadence)
+	if err != nil {
+		return false, s.pause(ctx, scheduled.ID, err)
+	}
+
+	claimed, err := s.db.ScheduledExport.Update().
+		Where(
+			scheduledexport.ID(scheduled.ID),
+			scheduledexport.StatusEQ(scheduledexport.StatusActive),
+			scheduledexport.NextRunAt(scheduled.NextRunAt),
+		).
+		SetNextRunAt(schedule.Next(now)).
+		SetLastRunAt(now).
+		Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to claim scheduled export: %w", err)
+	}
+	if claimed == 0 {
+		// Another runner got it first
+		return false, nil
+	}
+
+	req, err := s.exportRequest(ctx, scheduled, now)
+	if err != nil {
+		return false, s.pause(ctx, scheduled.ID, err)
+	}
+
+	exp, err := s.exporter.CreateExport(ctx, scheduled.UserID, scheduled.OrganizationID, req)
+	if err != nil {
+		if errors.Is(err, export.ErrExportRowCapExceeded) || errors.Is(err, export.ErrInvalidColumn) || errors.Is(err, export.ErrInvalidDeliveryURL) {
+			return false, s.pause(ctx, scheduled.ID, err)
+		}
+		// Transient, e.g. a full export queue: the next run tries again
+		if _, updateErr := s.db.ScheduledExport.UpdateOneID(scheduled.ID).SetLastError(truncate(err.Error())).Save(ctx); updateErr != nil {
+			log.Printf("⚠️  Failed to record scheduled export %d error: %v", scheduled.ID, updateErr)
+		}
+		return false, err
+	}
+
+	_, err = s.db.ScheduledExport.UpdateOneID(scheduled.ID).
+		SetLastExportID(exp.ID).
+		AddRunCount(1).
+		ClearLastError().
+		Save(ctx)
+	if err != nil {
+		return true, fmt.Errorf("failed to record scheduled export run: %w", err)
+	}
+	return true, nil
+}
+
+// exportRequest builds the export request of a schedule, checking that the
+// user may still run it
+func (s *Service) exportRequest(ctx context.Context, scheduled *ent.ScheduledExport, now time.Time) (models.ExportRequest, error) {
+	if scheduled.OrganizationID != nil {
+		isMember, err := s.isMember(ctx, *scheduled.OrganizationID, scheduled.UserID)
+		if err != nil {
+			return models.ExportRequest{}, err
+		}
+		if !isMember {
+			return models.ExportRequest{}, ErrNotOrganizationMember
+		}
+	}
+
+	schedule, err := ParseCadence(scheduled.Cadence)
+	if err != nil {
+		return models.ExportRequest{}, err
+	}
+	tier, err := s.tiers.GetExportTier(ctx, scheduled.UserID, scheduled.OrganizationID)
+	if err != nil {
+		return models.ExportRequest{}, err
+	}
+	if err := CheckCadence(schedule, tier, now); err != nil {
+		return models.ExportRequest{}, err
+	}
+
+	filters := scheduled.Filters
+	if scheduled.SavedSearchID != nil {
+		search, err := s.db.SavedSearch.Get(ctx, *scheduled.SavedSearchID)
+		if err != nil && !ent.IsNotFound(err) {
+			return models.ExportRequest{}, fmt.Errorf("failed to get saved search: %w", err)
+		}
+		if search != nil {
+			filters = search.Filters
+		}
+	}
+	// Schedules reference a saved search or hold filters, so neither means
+	// the saved search was deleted
+	if filters == nil {
+		return models.ExportRequest{}, ErrSavedSearchDeleted
+	}
+
+	searchReq, _, err := searchpkg.ToSearchRequest(filters)
+	if err != nil {
+		return models.ExportRequest{}, err
+	}
+	return models.ExportRequest{
+		Format:          string(scheduled.Format),
+		Filters:         searchReq,
+		MaxLeads:        scheduled.MaxLeads,
+		Columns:         scheduled.Columns,
+		SinceLastExport: scheduled.SinceLastExport,
+		DeliveryURL:     scheduled.DeliveryURL,
+		DeliverySecret:  scheduled.DeliverySecret,
+	}, nil
+}
+
+// pause pauses a schedule that cannot run, recording why, and returns the reason
+func (s *Service) pause(ctx context.Context, scheduleID int, reason error) error {
+	_, err := s.db.ScheduledExport.UpdateOneID(scheduleID).
+		SetStatus(scheduledexport.StatusPaused).
+		SetLastError(truncate(reason.Error())).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to pause scheduled export: %w", err)
+	}
+	return reason
+}
+
+// checkTier verifies the governing tier allows the cadence and one more
+// active schedule besides exceptID
+func (s *Service) checkTier(ctx context.Context, userID int, organizationID *int, schedule cron.Schedule, now time.Time, exceptID int) error {
+	tier, err := s.tiers.GetExportTier(ctx, userID, organizationID)
+	if err != nil {
+		return err
+	}
+	if err := CheckCadence(schedule, tier, now); err != nil {
+		return err
+	}
+
+	active, err := s.db.ScheduledExport.Query().
+		Where(
+			scheduledexport.UserID(userID),
+			scheduledexport.StatusEQ(scheduledexport.StatusActive),
+			scheduledexport.IDNEQ(exceptID),
+		).
+		Count(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to count scheduled exports: %w", err)
+	}
+	if limit := GetLimitsForTier(tier).MaxSchedules; active >= limit {
+		return fmt.Errorf("%w: the %s plan allows %d active scheduled exports", ErrScheduleLimit, tier, limit)
+	}
+	return nil
+}
+
+// isMember reports whether the user is an active member of the organization
+func (s *Service) isMember(ctx context.Context, organizationID, userID int) (bool, error) {
+	isMember, err := s.db.OrganizationMember.Query().
+		Where(
+			organizationmember.OrganizationID(organizationID),
+			organizationmember.UserID(userID),
+			organizationmember.StatusEQ(organizationmember.StatusActive),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to check organization membership: %w", err)
+	}
+	return isMember, nil
+}
+
+// get retrieves a scheduled export of the user
+func (s *Service) get(ctx context.Context, scheduleID, userID int) (*ent.ScheduledExport, error) {
+	scheduled, err := s.db.ScheduledExport.Query().
+		Where(scheduledexport.ID(scheduleID), scheduledexport.UserID(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrScheduleNotFound
+		}
+		return nil, fmt.Errorf("failed to get scheduled export: %w", err)
+	}
+	return scheduled, nil
+}
+
+// truncate shortens an error message to fit last_error
+func truncate(message string) string {
+	if len(message) > 1000 {
+		return message[:1000]
+	}
+	return message
+}
+
+func toScheduleResponse(scheduled *ent.ScheduledExport) ScheduleResponse {
+	response := ScheduleResponse{
+		ID:              scheduled.ID,
+		OrganizationID:  scheduled.OrganizationID,
+		Name:            scheduled.Name,
+		Format:          string(scheduled.Format),
+		Columns:         scheduled.Columns,
+		SavedSearchID:   scheduled.SavedSearchID,
+		Filters:         scheduled.Filters,
+		MaxLeads:        scheduled.MaxLeads,
+		SinceLastExport: scheduled.SinceLastExport,
+		Cadence:         scheduled.Cadence,
+		DeliveryMethod:  string(scheduled.DeliveryMethod),
+		DeliveryURL:     scheduled.DeliveryURL,
+		Status:          string(scheduled.Status),
+		LastExportID:    scheduled.LastExportID,
+		LastError:       scheduled.LastError,
+		RunCount:        scheduled.RunCount,
+		CreatedAt:       scheduled.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:       scheduled.UpdatedAt.Format(time.RFC3339),
+	}
+	if scheduled.Status == scheduledexport.StatusActive {
+		response.NextRunAt = scheduled.NextRunAt.Format(time.RFC3339)
+	}
+	if scheduled.LastRunAt != nil {
+		response.LastRunAt = scheduled.LastRunAt.Format(time.RFC3339)
+	}
+	return response
+}
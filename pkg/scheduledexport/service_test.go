@@ -0,0 +1,348 @@
+package scheduledexport
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/ent/organizationmember"
+	"github.com/jordanlanch/industrydb/pkg/export"
+	"github.com/jordanlanch/industrydb/pkg/models"
+)
+
+// fakeExporter records the exports it is asked to create
+type fakeExporter struct {
+	requests []models.ExportRequest
+	orgIDs   []*int
+	err      error
+}
+
+func (f *fakeExporter) CreateExport(ctx context.Context, userID int, organizationID *int, req models.ExportRequest) (*models.ExportResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.requests = append(f.requests, req)
+	f.orgIDs = append(f.orgIDs, organizationID)
+	return &models.ExportResponse{ID: 100 + len(f.requests), Status: "pending"}, nil
+}
+
+// fakeTiers resolves every user to one tier
+type fakeTiers struct {
+	tier string
+}
+
+func (f *fakeTiers) GetExportTier(ctx context.Context, userID int, organizationID *int) (string, error) {
+	return f.tier, nil
+}
+
+// setupTestDB creates an in-memory SQLite database for testing
+func setupTestDB(t *testing.T) *ent.Client {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+// createTestUser creates a test user in the database
+func createTestUser(t *testing.T, client *ent.Client, email string) *ent.User {
+	return client.User.Create().
+		SetEmail(email).
+		SetPasswordHash("hashed_password").
+		SetName("Test User").
+		SetSubscriptionTier("pro").
+		SaveX(context.Background())
+}
+
+func setupService(t *testing.T, tier string) (*Service, *ent.Client, *fakeExporter, *fakeTiers) {
+	client := setupTestDB(t)
+	exporter := &fakeExporter{}
+	tiers := &fakeTiers{tier: tier}
+	service := NewService(client, exporter, tiers)
+	now := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	service.now = func() time.Time { return now }
+	return service, client, exporter, tiers
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func TestService_Create(t *testing.T) {
+	service, client, _, _ := setupService(t, "pro")
+	ctx := context.Background()
+
+	owner := createTestUser(t, client, "owner@example.com")
+	other := createTestUser(t, client, "other@example.com")
+	search := client.SavedSearch.Create().
+		SetUserID(owner.ID).
+		SetName("NYC Restaurants").
+		SetFilters(map[string]interface{}{"industry": "restaurant", "city": "New York"}).
+		SaveX(ctx)
+
+	t.Run("With a saved search", func(t *testing.T) {
+		scheduled, err := service.Create(ctx, owner.ID, CreateRequest{
+			Name:          "Weekly NYC",
+			Format:        "csv",
+			SavedSearchID: &search.ID,
+			Cadence:       "0 9 * * 1",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "active", scheduled.Status)
+		assert.Equal(t, "download", scheduled.DeliveryMethod)
+		assert.Equal(t, "2026-03-09T09:00:00Z", scheduled.NextRunAt)
+		assert.Empty(t, scheduled.DeliverySecret)
+	})
+
+	t.Run("With URL delivery", func(t *testing.T) {
+		scheduled, err := service.Create(ctx, owner.ID, CreateRequest{
+			Name:            "Daily delta",
+			Format:          "csv",
+			Filters:         map[string]interface{}{"country": "US"},
+			SinceLastExport: true,
+			Cadence:         "@daily",
+			DeliveryMethod:  "url",
+			DeliveryURL:     "https://example.com/hooks/leads",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "url", scheduled.DeliveryMethod)
+		assert.NotEmpty(t, scheduled.DeliverySecret)
+		assert.True(t, scheduled.SinceLastExport)
+
+		// The secret is only returned once
+		fetched, err := service.Get(ctx, scheduled.ID, owner.ID)
+		require.NoError(t, err)
+		assert.Empty(t, fetched.DeliverySecret)
+	})
+
+	tests := []struct {
+		name     string
+		userID   int
+		req      CreateRequest
+		expected error
+	}{
+		{"Neither saved search nor filters", owner.ID, CreateRequest{Name: "X", Format: "csv", Cadence: "@daily"}, ErrAmbiguousSource},
+		{"Both saved search and filters", owner.ID, CreateRequest{Name: "X", Format: "csv", Cadence: "@daily", SavedSearchID: &search.ID, Filters: map[string]interface{}{}}, ErrAmbiguousSource},
+		{"Someone else's saved search", other.ID, CreateRequest{Name: "X", Format: "csv", Cadence: "@daily", SavedSearchID: &search.ID}, ErrSavedSearchNotFound},
+		{"Invalid filters", owner.ID, CreateRequest{Name: "X", Format: "csv", Cadence: "@daily", Filters: map[string]interface{}{"bogus": 1}}, ErrInvalidFilters},
+		{"Invalid column", owner.ID, CreateRequest{Name: "X", Format: "csv", Cadence: "@daily", Filters: map[string]interface{}{}, Columns: []string{"password"}}, export.ErrInvalidColumn},
+		{"URL delivery without URL", owner.ID, CreateRequest{Name: "X", Format: "csv", Cadence: "@daily", Filters: map[string]interface{}{}, DeliveryMethod: "url"}, ErrDeliveryURLRequired},
+		{"Private delivery URL", owner.ID, CreateRequest{Name: "X", Format: "csv", Cadence: "@daily", Filters: map[string]interface{}{}, DeliveryMethod: "url", DeliveryURL: "http://127.0.0.1/hook"}, export.ErrInvalidDeliveryURL},
+		{"Invalid cadence", owner.ID, CreateRequest{Name: "X", Format: "csv", Cadence: "whenever", Filters: map[string]interface{}{}}, ErrInvalidCadence},
+		{"Cadence too frequent", owner.ID, CreateRequest{Name: "X", Format: "csv", Cadence: "@hourly", Filters: map[string]interface{}{}}, ErrCadenceTooFrequent},
+		{"Organization the user is not in", owner.ID, CreateRequest{Name: "X", Format: "csv", Cadence: "@daily", Filters: map[string]interface{}{}, OrganizationID: intPtr(999)}, ErrNotOrganizationMember},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.Create(ctx, tt.userID, tt.req)
+			assert.ErrorIs(t, err, tt.expected)
+		})
+	}
+}
+
+func TestService_Create_TierLimits(t *testing.T) {
+	service, client, _, tiers := setupService(t, "free")
+	ctx := context.Background()
+	user := createTestUser(t, client, "user@example.com")
+	req := CreateRequest{Name: "Weekly", Format: "csv", Filters: map[string]interface{}{}, Cadence: "@weekly"}
+
+	_, err := service.Create(ctx, user.ID, req)
+	assert.ErrorIs(t, err, ErrSchedulesNotInPlan)
+
+	tiers.tier = "starter"
+	for i := 0; i < 2; i++ {
+		_, err := service.Create(ctx, user.ID, req)
+		require.NoError(t, err)
+	}
+	_, err = service.Create(ctx, user.ID, req)
+	assert.ErrorIs(t, err, ErrScheduleLimit)
+
+	// Paused schedules don't count against the limit
+	schedules, err := service.List(ctx, user.ID)
+	require.NoError(t, err)
+	_, err = service.Pause(ctx, schedules[0].ID, user.ID)
+	require.NoError(t, err)
+	_, err = service.Create(ctx, user.ID, req)
+	require.NoError(t, err)
+
+	// ...until resumed
+	_, err = service.Resume(ctx, schedules[0].ID, user.ID)
+	assert.ErrorIs(t, err, ErrScheduleLimit)
+}
+
+func TestService_PauseResumeDelete(t *testing.T) {
+	service, client, _, _ := setupService(t, "pro")
+	ctx := context.Background()
+	owner := createTestUser(t, client, "owner@example.com")
+	other := createTestUser(t, client, "other@example.com")
+
+	scheduled, err := service.Create(ctx, owner.ID, CreateRequest{
+		Name: "Daily", Format: "csv", Filters: map[string]interface{}{}, Cadence: "@daily",
+	})
+	require.NoError(t, err)
+
+	_, err = service.Pause(ctx, scheduled.ID, other.ID)
+	assert.ErrorIs(t, err, ErrScheduleNotFound)
+
+	paused, err := service.Pause(ctx, scheduled.ID, owner.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "paused", paused.Status)
+	assert.Empty(t, paused.NextRunAt)
+
+	// Resuming runs from the next occurrence after now, skipping missed runs
+	later := time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC)
+	service.now = func() time.Time { return later }
+	resumed, err := service.Resume(ctx, scheduled.ID, owner.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "active", resumed.Status)
+	assert.Equal(t, "2026-03-11T00:00:00Z", resumed.NextRunAt)
+
+	assert.ErrorIs(t, service.Delete(ctx, scheduled.ID, other.ID), ErrScheduleNotFound)
+	require.NoError(t, service.Delete(ctx, scheduled.ID, owner.ID))
+	_, err = service.Get(ctx, scheduled.ID, owner.ID)
+	assert.ErrorIs(t, err, ErrScheduleNotFound)
+}
+
+func TestService_RunDue(t *testing.T) {
+	service, client, exporter, tiers := setupService(t, "pro")
+	ctx := context.Background()
+	owner := createTestUser(t, client, "owner@example.com")
+	search := client.SavedSearch.Create().
+		SetUserID(owner.ID).
+		SetName("Gyms").
+		SetFilters(map[string]interface{}{"industry": "gym", "country": "US"}).
+		SaveX(ctx)
+
+	scheduled, err := service.Create(ctx, owner.ID, CreateRequest{
+		Name:            "Daily gyms",
+		Format:          "excel",
+		Columns:         []string{"name", "email"},
+		SavedSearchID:   &search.ID,
+		MaxLeads:        500,
+		SinceLastExport: true,
+		Cadence:         "@daily",
+		DeliveryMethod:  "url",
+		DeliveryURL:     "https://example.com/hooks/leads",
+	})
+	require.NoError(t, err)
+	secret := scheduled.DeliverySecret
+
+	t.Run("Nothing due yet", func(t *testing.T) {
+		created, err := service.RunDue(ctx, time.Date(2026, 3, 2, 23, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.Equal(t, 0, created)
+	})
+
+	runAt := time.Date(2026, 3, 3, 0, 0, 30, 0, time.UTC)
+	t.Run("Creates the due export", func(t *testing.T) {
+		created, err := service.RunDue(ctx, runAt)
+		require.NoError(t, err)
+		assert.Equal(t, 1, created)
+
+		require.Len(t, exporter.requests, 1)
+		req := exporter.requests[0]
+		assert.Equal(t, "excel", req.Format)
+		assert.Equal(t, "gym", req.Filters.Industry)
+		assert.Equal(t, "US", req.Filters.Country)
+		assert.Equal(t, 500, req.MaxLeads)
+		assert.Equal(t, []string{"name", "email"}, req.Columns)
+		assert.True(t, req.SinceLastExport)
+		assert.Equal(t, "https://example.com/hooks/leads", req.DeliveryURL)
+		assert.Equal(t, secret, req.DeliverySecret)
+		assert.Nil(t, exporter.orgIDs[0])
+
+		fetched, err := service.Get(ctx, scheduled.ID, owner.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 1, fetched.RunCount)
+		assert.Equal(t, intPtr(101), fetched.LastExportID)
+		assert.Equal(t, "2026-03-04T00:00:00Z", fetched.NextRunAt)
+		assert.Equal(t, "2026-03-03T00:00:30Z", fetched.LastRunAt)
+	})
+
+	t.Run("Does not run twice", func(t *testing.T) {
+		created, err := service.RunDue(ctx, runAt)
+		require.NoError(t, err)
+		assert.Equal(t, 0, created)
+		assert.Len(t, exporter.requests, 1)
+	})
+
+	t.Run("Transient errors are retried on the next run", func(t *testing.T) {
+		exporter.err = export.ErrExportQueueFull
+		created, err := service.RunDue(ctx, time.Date(2026, 3, 4, 0, 1, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.Equal(t, 0, created)
+		exporter.err = nil
+
+		fetched, err := service.Get(ctx, scheduled.ID, owner.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "active", fetched.Status)
+		assert.Equal(t, export.ErrExportQueueFull.Error(), fetched.LastError)
+		assert.Equal(t, "2026-03-05T00:00:00Z", fetched.NextRunAt)
+	})
+
+	t.Run("Pauses after a downgrade", func(t *testing.T) {
+		tiers.tier = "starter"
+		created, err := service.RunDue(ctx, time.Date(2026, 3, 5, 0, 1, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.Equal(t, 0, created)
+		tiers.tier = "pro"
+
+		fetched, err := service.Get(ctx, scheduled.ID, owner.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "paused", fetched.Status)
+		assert.Contains(t, fetched.LastError, ErrCadenceTooFrequent.Error())
+	})
+
+	t.Run("Pauses when the saved search was deleted", func(t *testing.T) {
+		service.now = func() time.Time { return time.Date(2026, 3, 5, 12, 0, 0, 0, time.UTC) }
+		_, err := service.Resume(ctx, scheduled.ID, owner.ID)
+		require.NoError(t, err)
+		client.SavedSearch.DeleteOneID(search.ID).ExecX(ctx)
+
+		created, err := service.RunDue(ctx, time.Date(2026, 3, 6, 0, 1, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.Equal(t, 0, created)
+
+		fetched, err := service.Get(ctx, scheduled.ID, owner.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "paused", fetched.Status)
+		assert.Equal(t, ErrSavedSearchDeleted.Error(), fetched.LastError)
+	})
+}
+
+func TestService_RunDue_Organization(t *testing.T) {
+	service, client, exporter, _ := setupService(t, "business")
+	ctx := context.Background()
+	admin := createTestUser(t, client, "admin@example.com")
+	rep := createTestUser(t, client, "rep@example.com")
+
+	org := client.Organization.Create().SetName("Sales Team").SetSlug("sales-team").SetOwnerID(admin.ID).SaveX(ctx)
+	client.OrganizationMember.Create().SetOrganizationID(org.ID).SetUserID(admin.ID).SetRole(organizationmember.RoleAdmin).SaveX(ctx)
+	member := client.OrganizationMember.Create().SetOrganizationID(org.ID).SetUserID(rep.ID).SetRole(organizationmember.RoleMember).SaveX(ctx)
+
+	scheduled, err := service.Create(ctx, rep.ID, CreateRequest{
+		Name: "Team hourly", Format: "csv", Filters: map[string]interface{}{"country": "US"}, Cadence: "@hourly", OrganizationID: &org.ID,
+	})
+	require.NoError(t, err)
+
+	created, err := service.RunDue(ctx, time.Date(2026, 3, 2, 13, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, 1, created)
+	require.Len(t, exporter.orgIDs, 1)
+	assert.Equal(t, &org.ID, exporter.orgIDs[0])
+
+	// Leaving the organization stops the schedule
+	client.OrganizationMember.DeleteOne(member).ExecX(ctx)
+	created, err = service.RunDue(ctx, time.Date(2026, 3, 2, 14, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, 0, created)
+
+	fetched, err := service.Get(ctx, scheduled.ID, rep.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "paused", fetched.Status)
+	assert.Equal(t, ErrNotOrganizationMember.Error(), fetched.LastError)
+}
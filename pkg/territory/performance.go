@@ -0,0 +1,140 @@
+package territory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+	"github.com/jordanlanch/industrydb/ent/territory"
+	"github.com/jordanlanch/industrydb/ent/territorymember"
+	"github.com/jordanlanch/industrydb/pkg/cache"
+	"github.com/jordanlanch/industrydb/pkg/leadlifecycle"
+)
+
+// performanceCacheTTL is how long a territory's performance is cached
+const performanceCacheTTL = 10 * time.Minute
+
+// TerritoryPerformance is the lead lifecycle performance of a territory
+type TerritoryPerformance struct {
+	TerritoryID int    `json:"territory_id"`
+	Name        string `json:"name"`
+	Active      bool   `json:"active"`
+	MemberCount int    `json:"member_count"`
+	leadlifecycle.ConversionStats
+	ComputedAt string `json:"computed_at"`
+}
+
+// PerformanceOverview is the performance of every territory a user can view,
+// with their combined totals
+type PerformanceOverview struct {
+	Territories []TerritoryPerformance        `json:"territories"`
+	Totals      leadlifecycle.ConversionStats `json:"totals"`
+}
+
+// SetCache enables caching of territory performance
+func (s *Service) SetCache(client *cache.Client) {
+	s.cache = client
+}
+
+// visibleTo matches the territories a user created or is a member of
+func visibleTo(userID int) predicate.Territory {
+	return territory.Or(
+		territory.CreatedByUserID(userID),
+		territory.HasMembersWith(territorymember.UserID(userID)),
+	)
+}
+
+// GetTerritoryPerformance returns the performance of a territory the user
+// created or is a member of. Other territories are reported as not found.
+func (s *Service) GetTerritoryPerformance(ctx context.Context, territoryID, userID int) (*TerritoryPerformance, error) {
+	t, err := s.client.Territory.Query().
+		Where(territory.ID(territoryID), visibleTo(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("territory not found")
+		}
+		return nil, fmt.Errorf("failed to get territory: %w", err)
+	}
+
+	return s.performance(ctx, t)
+}
+
+// ListTerritoryPerformance returns the performance of every territory the
+// user created or is a member of, sorted by name
+func (s *Service) ListTerritoryPerformance(ctx context.Context, userID int, activeOnly bool) (*PerformanceOverview, error) {
+	query := s.client.Territory.Query().Where(visibleTo(userID))
+	if activeOnly {
+		query = query.Where(territory.Active(true))
+	}
+
+	territories, err := query.Order(ent.Asc(territory.FieldName)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list territories: %w", err)
+	}
+
+	overview := &PerformanceOverview{Territories: make([]TerritoryPerformance, 0, len(territories))}
+	stats := make([]leadlifecycle.ConversionStats, 0, len(territories))
+	for _, t := range territories {
+		perf, err := s.performance(ctx, t)
+		if err != nil {
+			return nil, err
+		}
+		overview.Territories = append(overview.Territories, *perf)
+		stats = append(stats, perf.ConversionStats)
+	}
+	overview.Totals = leadlifecycle.CombineConversionStats(stats...)
+
+	return overview, nil
+}
+
+// performance computes a territory's performance, cached for
+// performanceCacheTTL when a cache is set
+func (s *Service) performance(ctx context.Context, t *ent.Territory) (*TerritoryPerformance, error) {
+	cacheKey := fmt.Sprintf("territory:performance:%d", t.ID)
+	if s.cache != nil {
+		if cached, err := s.cache.Get(ctx, cacheKey); err == nil && cached != "" {
+			var perf TerritoryPerformance
+			if err := json.Unmarshal([]byte(cached), &perf); err == nil {
+				// Name and status are cheap to keep current
+				perf.Name = t.Name
+				perf.Active = t.Active
+				return &perf, nil
+			}
+		}
+	}
+
+	stats, err := leadlifecycle.NewService(s.client).
+		GetConversionStats(ctx, lead.HasTerritoryWith(territory.ID(t.ID)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute territory performance: %w", err)
+	}
+
+	members, err := s.client.TerritoryMember.Query().
+		Where(territorymember.TerritoryID(t.ID)).
+		Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count members: %w", err)
+	}
+
+	perf := &TerritoryPerformance{
+		TerritoryID:     t.ID,
+		Name:            t.Name,
+		Active:          t.Active,
+		MemberCount:     members,
+		ConversionStats: *stats,
+		ComputedAt:      time.Now().Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if s.cache != nil {
+		if data, err := json.Marshal(perf); err == nil {
+			_ = s.cache.Set(ctx, cacheKey, data, performanceCacheTTL)
+		}
+	}
+
+	return perf, nil
+}
@@ -0,0 +1,137 @@
+package territory
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/lead"
+	"github.com/jordanlanch/industrydb/pkg/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTerritoryLead(t *testing.T, client *ent.Client, territoryID int, status lead.Status) *ent.Lead {
+	l, err := client.Lead.Create().
+		SetName("Studio").
+		SetIndustry("tattoo").
+		SetCountry("US").
+		SetCity("Seattle").
+		SetStatus(status).
+		SetTerritoryID(territoryID).
+		Save(context.Background())
+	require.NoError(t, err)
+	return l
+}
+
+func TestGetTerritoryPerformance(t *testing.T) {
+	client, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	service := NewService(client)
+
+	creator := createTestUser(t, client, "creator@test.com", "Creator")
+	member := createTestUser(t, client, "member@test.com", "Member")
+	outsider := createTestUser(t, client, "outsider@test.com", "Outsider")
+
+	west, err := service.CreateTerritory(ctx, creator.ID, CreateTerritoryRequest{Name: "West"})
+	require.NoError(t, err)
+	_, err = service.AddMember(ctx, west.ID, member.ID, "member", creator.ID)
+	require.NoError(t, err)
+
+	createTerritoryLead(t, client, west.ID, lead.StatusWon)
+	createTerritoryLead(t, client, west.ID, lead.StatusLost)
+	createTerritoryLead(t, client, west.ID, lead.StatusNew)
+	createTerritoryLead(t, client, west.ID, lead.StatusNew)
+
+	t.Run("Creator and members can view", func(t *testing.T) {
+		for _, userID := range []int{creator.ID, member.ID} {
+			perf, err := service.GetTerritoryPerformance(ctx, west.ID, userID)
+			require.NoError(t, err)
+			assert.Equal(t, "West", perf.Name)
+			assert.Equal(t, 1, perf.MemberCount)
+			assert.Equal(t, 4, perf.TotalLeads)
+			assert.Equal(t, 1, perf.Converted)
+			assert.Equal(t, 25.0, perf.ConversionRate)
+			assert.Equal(t, 50.0, perf.WinRate)
+		}
+	})
+
+	t.Run("Hidden from other users", func(t *testing.T) {
+		_, err := service.GetTerritoryPerformance(ctx, west.ID, outsider.ID)
+		require.Error(t, err)
+		assert.Equal(t, "territory not found", err.Error())
+	})
+}
+
+func TestListTerritoryPerformance(t *testing.T) {
+	client, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	service := NewService(client)
+
+	manager := createTestUser(t, client, "manager@test.com", "Manager")
+	other := createTestUser(t, client, "other@test.com", "Other")
+
+	west, err := service.CreateTerritory(ctx, manager.ID, CreateTerritoryRequest{Name: "West"})
+	require.NoError(t, err)
+	east, err := service.CreateTerritory(ctx, other.ID, CreateTerritoryRequest{Name: "East"})
+	require.NoError(t, err)
+	_, err = service.AddMember(ctx, east.ID, manager.ID, "manager", other.ID)
+	require.NoError(t, err)
+	hidden, err := service.CreateTerritory(ctx, other.ID, CreateTerritoryRequest{Name: "Hidden"})
+	require.NoError(t, err)
+
+	createTerritoryLead(t, client, west.ID, lead.StatusWon)
+	createTerritoryLead(t, client, west.ID, lead.StatusNew)
+	createTerritoryLead(t, client, east.ID, lead.StatusWon)
+	createTerritoryLead(t, client, east.ID, lead.StatusWon)
+	createTerritoryLead(t, client, hidden.ID, lead.StatusNew)
+
+	overview, err := service.ListTerritoryPerformance(ctx, manager.ID, false)
+	require.NoError(t, err)
+	require.Len(t, overview.Territories, 2)
+	assert.Equal(t, "East", overview.Territories[0].Name)
+	assert.Equal(t, "West", overview.Territories[1].Name)
+	assert.Equal(t, 4, overview.Totals.TotalLeads)
+	assert.Equal(t, 3, overview.Totals.Converted)
+	assert.Equal(t, 75.0, overview.Totals.ConversionRate)
+}
+
+func TestTerritoryPerformance_Cached(t *testing.T) {
+	client, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	mr := miniredis.RunT(t)
+	cacheClient, err := cache.NewClient("redis://" + mr.Addr())
+	require.NoError(t, err)
+	defer cacheClient.Close()
+
+	ctx := context.Background()
+	service := NewService(client)
+	service.SetCache(cacheClient)
+
+	user := createTestUser(t, client, "cache@test.com", "Cache")
+	west, err := service.CreateTerritory(ctx, user.ID, CreateTerritoryRequest{Name: "West"})
+	require.NoError(t, err)
+	createTerritoryLead(t, client, west.ID, lead.StatusWon)
+
+	first, err := service.GetTerritoryPerformance(ctx, west.ID, user.ID)
+	require.NoError(t, err)
+	assert.True(t, mr.Exists(fmt.Sprintf("territory:performance:%d", west.ID)))
+
+	// New leads show up once the cached aggregates expire
+	createTerritoryLead(t, client, west.ID, lead.StatusNew)
+	cached, err := service.GetTerritoryPerformance(ctx, west.ID, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, first.TotalLeads, cached.TotalLeads)
+
+	mr.FastForward(performanceCacheTTL)
+	fresh, err := service.GetTerritoryPerformance(ctx, west.ID, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, fresh.TotalLeads)
+}
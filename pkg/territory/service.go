@@ -7,11 +7,13 @@ import (
 	"github.com/jordanlanch/industrydb/ent"
 	"github.com/jordanlanch/industrydb/ent/territory"
 	"github.com/jordanlanch/industrydb/ent/territorymember"
+	"github.com/jordanlanch/industrydb/pkg/cache"
 )
 
 // Service handles territory management operations.
 type Service struct {
 	client *ent.Client
+	cache  *cache.Client
 }
 
 // NewService creates a new territory service.
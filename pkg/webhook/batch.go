@@ -0,0 +1,258 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+	"github.com/jordanlanch/industrydb/ent/webhook"
+)
+
+// EventBatch is the X-Webhook-Event header of batched deliveries, whose body
+// is a JSON array of event payloads
+const EventBatch = "batch"
+
+// Batching defaults, used for fields left at zero
+const (
+	DefaultBatchMaxEvents     = 100
+	DefaultBatchWindowSeconds = 5
+)
+
+// ErrInvalidBatching is returned for out-of-range batching settings
+var ErrInvalidBatching = errors.New("invalid batching")
+
+// Batching delivers a webhook's events in batches: events are collected
+// until MaxEvents are pending or WindowSeconds have passed since the first,
+// whichever comes first, and sent as one signed array. A nil *Batching
+// delivers each event on its own.
+type Batching struct {
+	MaxEvents     int `json:"max_events"`     // 2-100, default 100
+	WindowSeconds int `json:"window_seconds"` // 1-60, default 5
+}
+
+// withDefaults returns the batching with zero fields set to their defaults
+func (b *Batching) withDefaults() *Batching {
+	if b == nil {
+		return nil
+	}
+	filled := *b
+	if filled.MaxEvents == 0 {
+		filled.MaxEvents = DefaultBatchMaxEvents
+	}
+	if filled.WindowSeconds == 0 {
+		filled.WindowSeconds = DefaultBatchWindowSeconds
+	}
+	return &filled
+}
+
+// Validate checks the batch size and window
+func (b *Batching) Validate() error {
+	if b == nil {
+		return nil
+	}
+	b = b.withDefaults()
+	if b.MaxEvents < 2 || b.MaxEvents > 100 {
+		return fmt.Errorf("%w: max_events must be between 2 and 100", ErrInvalidBatching)
+	}
+	if b.WindowSeconds < 1 || b.WindowSeconds > 60 {
+		return fmt.Errorf("%w: window_seconds must be between 1 and 60", ErrInvalidBatching)
+	}
+	return nil
+}
+
+// window returns how long a batch collects events
+func (b *Batching) window() time.Duration {
+	return time.Duration(b.WindowSeconds) * time.Second
+}
+
+// BatchingOf returns the webhook's batching, nil when it delivers each
+// event on its own
+func BatchingOf(wh *ent.Webhook) *Batching {
+	if wh.BatchMaxEvents == nil || wh.BatchWindowSeconds == nil {
+		return nil
+	}
+	return &Batching{MaxEvents: *wh.BatchMaxEvents, WindowSeconds: *wh.BatchWindowSeconds}
+}
+
+// SetBatching enables batched delivery for a personal webhook, or disables
+// it with a nil batching. Events already collected are delivered with the
+// old settings.
+func (s *Service) SetBatching(ctx context.Context, webhookID int, userID int, batching *Batching) (*ent.Webhook, error) {
+	return s.setBatching(ctx, webhookID, personal(userID), batching)
+}
+
+// SetOrganizationBatching enables or, with a nil batching, disables batched
+// delivery for an organization webhook
+func (s *Service) SetOrganizationBatching(ctx context.Context, orgID int, webhookID int, batching *Batching) (*ent.Webhook, error) {
+	return s.setBatching(ctx, webhookID, webhook.OrganizationID(orgID), batching)
+}
+
+// setBatching sets the batching of a webhook matching scope
+func (s *Service) setBatching(ctx context.Context, webhookID int, scope predicate.Webhook, batching *Batching) (*ent.Webhook, error) {
+	if err := batching.Validate(); err != nil {
+		return nil, err
+	}
+	batching = batching.withDefaults()
+
+	update := s.client.Webhook.UpdateOneID(webhookID).
+		Where(scope)
+	if batching == nil {
+		update = update.ClearBatchMaxEvents().ClearBatchWindowSeconds()
+	} else {
+		update = update.
+			SetBatchMaxEvents(batching.MaxEvents).
+			SetBatchWindowSeconds(batching.WindowSeconds)
+	}
+
+	wh, err := update.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update webhook: %w", err)
+	}
+
+	return wh, nil
+}
+
+// batcher collects the events of batching webhooks in memory until their
+// batch is full or its window ends
+type batcher struct {
+	mu      sync.Mutex
+	pending map[int]*pendingBatch
+}
+
+// pendingBatch is a batch still collecting events
+type pendingBatch struct {
+	wh       *ent.Webhook
+	payloads []Payload
+	timer    *time.Timer
+}
+
+func newBatcher() *batcher {
+	return &batcher{pending: make(map[int]*pendingBatch)}
+}
+
+// batchEvent adds an event to the webhook's pending batch, delivering the
+// batch once it holds MaxEvents events or its window ends
+func (s *Service) batchEvent(wh *ent.Webhook, batching *Batching, payload Payload) {
+	b := s.batches
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	batch, ok := b.pending[wh.ID]
+	if !ok {
+		batch = &pendingBatch{wh: wh}
+		b.pending[wh.ID] = batch
+		batch.timer = time.AfterFunc(batching.window(), func() {
+			if payloads := b.take(wh.ID, batch); len(payloads) > 0 {
+				s.deliverBatch(wh, payloads)
+			}
+		})
+	}
+
+	batch.payloads = append(batch.payloads, payload)
+	if len(batch.payloads) >= batching.MaxEvents {
+		batch.timer.Stop()
+		delete(b.pending, wh.ID)
+		go s.deliverBatch(wh, batch.payloads)
+	}
+}
+
+// take removes a batch that is still pending and returns its events
+func (b *batcher) take(webhookID int, batch *pendingBatch) []Payload {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.pending[webhookID] != batch {
+		// Already delivered because it filled up
+		return nil
+	}
+	delete(b.pending, webhookID)
+	return batch.payloads
+}
+
+// FlushBatches queues the events of every pending batch so FlushDeferred
+// delivers them, instead of losing them when the server stops. It returns
+// the number of events queued.
+func (s *Service) FlushBatches(ctx context.Context) int {
+	b := s.batches
+	b.mu.Lock()
+	pending := b.pending
+	b.pending = make(map[int]*pendingBatch)
+	b.mu.Unlock()
+
+	queued := 0
+	for _, batch := range pending {
+		batch.timer.Stop()
+		for _, payload := range batch.payloads {
+			s.queueEvent(ctx, batch.wh, payload)
+		}
+		queued += len(batch.payloads)
+	}
+	return queued
+}
+
+// deliverAll delivers events in order, in batches for batching webhooks
+func (s *Service) deliverAll(wh *ent.Webhook, payloads []Payload) {
+	batching := BatchingOf(wh)
+	if batching == nil {
+		for _, payload := range payloads {
+			s.deliverPayload(wh, payload)
+		}
+		return
+	}
+
+	for start := 0; start < len(payloads); start += batching.MaxEvents {
+		end := start + batching.MaxEvents
+		if end > len(payloads) {
+			end = len(payloads)
+		}
+		s.deliverBatch(wh, payloads[start:end])
+	}
+}
+
+// deliverBatch delivers events as one JSON array, rendered in the webhook's
+// pinned schema version and signed as a whole, with retries. A failed batch
+// dead-letters each of its events, so they can be replayed one by one.
+func (s *Service) deliverBatch(wh *ent.Webhook, payloads []Payload) {
+	ctx := context.Background()
+	version := ResolveSchemaVersion(wh.SchemaVersion, time.Now())
+
+	rendered := make([]Payload, len(payloads))
+	for i, payload := range payloads {
+		rendered[i] = RenderPayload(payload, version)
+	}
+	body, err := json.Marshal(rendered)
+	if err != nil {
+		log.Printf("⚠️  Failed to marshal webhook batch: %v", err)
+		s.incrementFailureCount(ctx, wh.ID)
+		return
+	}
+
+	headers := map[string]string{"X-Webhook-Batch-Size": strconv.Itoa(len(payloads))}
+	attempts, delivered := s.deliverWithRetries(wh, body, EventBatch, version, headers)
+	if delivered {
+		log.Printf("✅ Webhook batch delivered successfully: %s (%d events)", wh.URL, len(payloads))
+		s.incrementSuccessCount(ctx, wh.ID)
+		return
+	}
+
+	if current, err := s.client.Webhook.Get(ctx, wh.ID); err == nil && current.PausedAt != nil {
+		log.Printf("⏸️  Webhook paused during delivery, queuing batch: %s (%d events)", wh.URL, len(payloads))
+		for _, payload := range payloads {
+			s.queueEvent(ctx, current, payload)
+		}
+		return
+	}
+
+	log.Printf("❌ Webhook batch delivery failed after %d attempts: %s (%d events)", len(attempts), wh.URL, len(payloads))
+	s.incrementFailureCount(ctx, wh.ID)
+	for _, payload := range payloads {
+		s.deadLetter(ctx, wh.ID, payload, version, attempts)
+	}
+}
@@ -0,0 +1,193 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// batchRecorder records the deliveries a test endpoint receives
+type batchRecorder struct {
+	mu         sync.Mutex
+	bodies     [][]byte
+	signatures []string
+	events     []string
+	sizes      []string
+}
+
+func (r *batchRecorder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+	r.mu.Lock()
+	r.bodies = append(r.bodies, body)
+	r.signatures = append(r.signatures, req.Header.Get("X-Webhook-Signature"))
+	r.events = append(r.events, req.Header.Get("X-Webhook-Event"))
+	r.sizes = append(r.sizes, req.Header.Get("X-Webhook-Batch-Size"))
+	r.mu.Unlock()
+	w.WriteHeader(http.StatusOK)
+}
+
+// waitFor waits until the endpoint received n deliveries
+func (r *batchRecorder) waitFor(t *testing.T, n int, timeout time.Duration) {
+	t.Helper()
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		r.mu.Lock()
+		got := len(r.bodies)
+		r.mu.Unlock()
+		if got >= n {
+			return
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %d deliveries", n)
+}
+
+func TestBatchingValidate(t *testing.T) {
+	tests := []struct {
+		batching *Batching
+		valid    bool
+	}{
+		{nil, true},
+		{&Batching{}, true},
+		{&Batching{MaxEvents: 2, WindowSeconds: 60}, true},
+		{&Batching{MaxEvents: 1}, false},
+		{&Batching{MaxEvents: 101}, false},
+		{&Batching{WindowSeconds: 61}, false},
+		{&Batching{WindowSeconds: -1}, false},
+	}
+	for _, tt := range tests {
+		err := tt.batching.Validate()
+		if tt.valid && err != nil {
+			t.Errorf("Validate(%+v) = %v, want nil", tt.batching, err)
+		}
+		if !tt.valid && !errors.Is(err, ErrInvalidBatching) {
+			t.Errorf("Validate(%+v) = %v, want ErrInvalidBatching", tt.batching, err)
+		}
+	}
+}
+
+func TestBatchedDelivery(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:webhook_batch_test?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	allowTestServers(t)
+	recorder := &batchRecorder{}
+	server := httptest.NewServer(recorder)
+	defer server.Close()
+
+	ctx := context.Background()
+	service := NewService(client)
+
+	u := client.User.Create().
+		SetEmail("batch@test.com").
+		SetPasswordHash("hashed").
+		SetName("Batch").
+		SaveX(ctx)
+	wh, err := service.CreateWebhook(ctx, u.ID, server.URL, []string{EventLeadCreated}, "")
+	if err != nil {
+		t.Fatalf("CreateWebhook: %v", err)
+	}
+	wh, err = service.SetBatching(ctx, wh.ID, u.ID, &Batching{MaxEvents: 3, WindowSeconds: 1})
+	if err != nil {
+		t.Fatalf("SetBatching: %v", err)
+	}
+	if got := BatchingOf(wh); got == nil || got.MaxEvents != 3 || got.WindowSeconds != 1 {
+		t.Fatalf("BatchingOf = %+v, want 3 events / 1s", got)
+	}
+
+	// A full batch is delivered right away
+	for i := 1; i <= 3; i++ {
+		service.TriggerWebhooks(ctx, u.ID, EventLeadCreated, map[string]interface{}{"lead_id": i})
+	}
+	recorder.waitFor(t, 1, 500*time.Millisecond)
+
+	var batch []Payload
+	if err := json.Unmarshal(recorder.bodies[0], &batch); err != nil {
+		t.Fatalf("batch body is not an array of payloads: %v", err)
+	}
+	if len(batch) != 3 || batch[0].Data["lead_id"] != float64(1) || batch[2].Data["lead_id"] != float64(3) {
+		t.Errorf("batch = %+v, want lead_id 1..3 in order", batch)
+	}
+	if recorder.events[0] != EventBatch || recorder.sizes[0] != "3" {
+		t.Errorf("headers = %q, %q; want %q, 3", recorder.events[0], recorder.sizes[0], EventBatch)
+	}
+	if !VerifySignature(recorder.bodies[0], recorder.signatures[0], wh.Secret) {
+		t.Error("signature does not cover the whole batch")
+	}
+
+	// A partial batch is delivered when its window ends
+	service.TriggerWebhooks(ctx, u.ID, EventLeadCreated, map[string]interface{}{"lead_id": 4})
+	recorder.waitFor(t, 2, 3*time.Second)
+	if recorder.sizes[1] != "1" {
+		t.Errorf("window flush batch size = %q, want 1", recorder.sizes[1])
+	}
+
+	// Disabling batching goes back to one delivery per event
+	if _, err := service.SetBatching(ctx, wh.ID, u.ID, nil); err != nil {
+		t.Fatalf("SetBatching(nil): %v", err)
+	}
+	service.TriggerWebhooks(ctx, u.ID, EventLeadCreated, map[string]interface{}{"lead_id": 5})
+	recorder.waitFor(t, 3, 500*time.Millisecond)
+	var single Payload
+	if err := json.Unmarshal(recorder.bodies[2], &single); err != nil || single.Event != EventLeadCreated {
+		t.Errorf("unbatched body = %s, want a single payload", recorder.bodies[2])
+	}
+	if recorder.events[2] != EventLeadCreated || recorder.sizes[2] != "" {
+		t.Errorf("unbatched headers = %q, %q", recorder.events[2], recorder.sizes[2])
+	}
+}
+
+func TestFlushBatchesQueuesPendingEvents(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:webhook_batch_flush_test?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	allowTestServers(t)
+	recorder := &batchRecorder{}
+	server := httptest.NewServer(recorder)
+	defer server.Close()
+
+	ctx := context.Background()
+	service := NewService(client)
+
+	u := client.User.Create().
+		SetEmail("flush@test.com").
+		SetPasswordHash("hashed").
+		SetName("Flush").
+		SaveX(ctx)
+	wh, err := service.CreateWebhook(ctx, u.ID, server.URL, []string{EventLeadCreated}, "")
+	if err != nil {
+		t.Fatalf("CreateWebhook: %v", err)
+	}
+	if _, err := service.SetBatching(ctx, wh.ID, u.ID, &Batching{WindowSeconds: 60}); err != nil {
+		t.Fatalf("SetBatching: %v", err)
+	}
+
+	service.TriggerWebhooks(ctx, u.ID, EventLeadCreated, map[string]interface{}{"lead_id": 1})
+	service.TriggerWebhooks(ctx, u.ID, EventLeadCreated, map[string]interface{}{"lead_id": 2})
+
+	if queued := service.FlushBatches(ctx); queued != 2 {
+		t.Fatalf("FlushBatches = %d, want 2", queued)
+	}
+	if wh = client.Webhook.GetX(ctx, wh.ID); len(wh.QueuedEvents) != 2 {
+		t.Fatalf("queued events = %d, want 2", len(wh.QueuedEvents))
+	}
+
+	// The next deferred flush delivers them as one batch
+	flushed, err := service.FlushDeferred(ctx, time.Now())
+	if err != nil || flushed != 2 {
+		t.Fatalf("FlushDeferred = %d, %v; want 2", flushed, err)
+	}
+	recorder.waitFor(t, 1, time.Second)
+	if recorder.sizes[0] != "2" {
+		t.Errorf("batch size = %q, want 2", recorder.sizes[0])
+	}
+}
@@ -0,0 +1,81 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent/predicate"
+	"github.com/jordanlanch/industrydb/ent/webhook"
+)
+
+// ErrEmptyBulkDeleteFilter is returned when a bulk delete sets no filter,
+// which would delete every webhook
+var ErrEmptyBulkDeleteFilter = errors.New("at least one filter is required")
+
+// BulkDeleteFilter selects the webhooks a bulk delete removes. Webhooks must
+// match every filter set.
+type BulkDeleteFilter struct {
+	Inactive      bool       `json:"inactive"`       // Only disabled webhooks
+	Host          string     `json:"host"`           // Only webhooks whose URL targets this host
+	CreatedBefore *time.Time `json:"created_before"` // Only webhooks created before this time
+}
+
+// IsEmpty reports whether the filter sets no condition
+func (f BulkDeleteFilter) IsEmpty() bool {
+	return !f.Inactive && strings.TrimSpace(f.Host) == "" && f.CreatedBefore == nil
+}
+
+// BulkDeleteWebhooks deletes the user's personal webhooks matching a filter,
+// along with their deliveries, and returns the IDs deleted
+func (s *Service) BulkDeleteWebhooks(ctx context.Context, userID int, filter BulkDeleteFilter) ([]int, error) {
+	if filter.IsEmpty() {
+		return nil, ErrEmptyBulkDeleteFilter
+	}
+
+	conditions := []predicate.Webhook{personal(userID)}
+	if filter.Inactive {
+		conditions = append(conditions, webhook.Active(false))
+	}
+	if filter.CreatedBefore != nil {
+		conditions = append(conditions, webhook.CreatedAtLT(*filter.CreatedBefore))
+	}
+
+	webhooks, err := s.client.Webhook.Query().
+		Where(conditions...).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query webhooks: %w", err)
+	}
+
+	// Host names are case-insensitive and URLs may carry a port, so hosts are
+	// compared after parsing rather than in the query
+	host := strings.ToLower(strings.TrimSpace(filter.Host))
+	ids := make([]int, 0, len(webhooks))
+	for _, wh := range webhooks {
+		if host != "" {
+			u, err := url.Parse(wh.URL)
+			if err != nil || strings.ToLower(u.Hostname()) != host {
+				continue
+			}
+		}
+		ids = append(ids, wh.ID)
+	}
+	if len(ids) == 0 {
+		return ids, nil
+	}
+
+	scope := webhook.And(webhook.IDIn(ids...), personal(userID))
+	if err := s.deleteDeliveries(ctx, scope); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.client.Webhook.Delete().Where(scope).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to delete webhooks: %w", err)
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,104 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/ent/webhookdelivery"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestBulkDeleteWebhooks(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:webhook_bulk_delete_test?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	service := NewService(client)
+
+	u := client.User.Create().
+		SetEmail("bulk-delete@test.com").
+		SetPasswordHash("hashed").
+		SetName("Cleaner").
+		SaveX(ctx)
+	other := client.User.Create().
+		SetEmail("bulk-delete-other@test.com").
+		SetPasswordHash("hashed").
+		SetName("Bystander").
+		SaveX(ctx)
+	org := client.Organization.Create().
+		SetName("Cleanup Team").
+		SetSlug("cleanup-team").
+		SetOwnerID(u.ID).
+		SaveX(ctx)
+
+	lastWeek := time.Now().Add(-7 * 24 * time.Hour)
+	create := func(userID int, rawURL string, active bool, createdAt time.Time) int {
+		return client.Webhook.Create().
+			SetUserID(userID).
+			SetURL(rawURL).
+			SetEvents([]string{EventLeadCreated}).
+			SetSecret("secret").
+			SetActive(active).
+			SetCreatedAt(createdAt).
+			SaveX(ctx).ID
+	}
+	junkOld := create(u.ID, "https://Junk.example.com:8443/hook", true, lastWeek)
+	junkNew := create(u.ID, "https://junk.example.com/other", false, time.Now())
+	disabled := create(u.ID, "https://crm.example.com/hook", false, time.Now())
+	kept := create(u.ID, "https://crm.example.com/hook", true, lastWeek)
+	othersJunk := create(other.ID, "https://junk.example.com/hook", false, lastWeek)
+	orgJunk := client.Webhook.Create().
+		SetUserID(u.ID).
+		SetOrganizationID(org.ID).
+		SetURL("https://junk.example.com/hook").
+		SetEvents([]string{EventLeadCreated}).
+		SetSecret("secret").
+		SetActive(false).
+		SaveX(ctx).ID
+
+	client.WebhookDelivery.Create().
+		SetWebhookID(junkOld).
+		SetEventID("evt_1").
+		SetEvent(EventLeadCreated).
+		SetPayload(map[string]interface{}{"id": "evt_1"}).
+		SetSchemaVersion(LatestSchemaVersion).
+		SetLastError("endpoint returned status 500").
+		SaveX(ctx)
+
+	if _, err := service.BulkDeleteWebhooks(ctx, u.ID, BulkDeleteFilter{Host: "  "}); !errors.Is(err, ErrEmptyBulkDeleteFilter) {
+		t.Fatalf("BulkDeleteWebhooks without filter = %v, want ErrEmptyBulkDeleteFilter", err)
+	}
+
+	// Filters combine
+	ids, err := service.BulkDeleteWebhooks(ctx, u.ID, BulkDeleteFilter{Inactive: true, Host: "crm.example.com"})
+	if err != nil || len(ids) != 1 || ids[0] != disabled {
+		t.Fatalf("BulkDeleteWebhooks(inactive, crm host) = %v, %v; want [%d]", ids, err, disabled)
+	}
+
+	// Hosts match case-insensitively and regardless of port, and only the
+	// user's personal webhooks are deleted
+	ids, err = service.BulkDeleteWebhooks(ctx, u.ID, BulkDeleteFilter{Host: "JUNK.example.com"})
+	sort.Ints(ids)
+	if err != nil || len(ids) != 2 || ids[0] != junkOld || ids[1] != junkNew {
+		t.Fatalf("BulkDeleteWebhooks(junk host) = %v, %v; want [%d %d]", ids, err, junkOld, junkNew)
+	}
+	if n := client.WebhookDelivery.Query().Where(webhookdelivery.WebhookID(junkOld)).CountX(ctx); n != 0 {
+		t.Errorf("deliveries of deleted webhook = %d, want 0", n)
+	}
+
+	cutoff := time.Now().Add(-24 * time.Hour)
+	ids, err = service.BulkDeleteWebhooks(ctx, u.ID, BulkDeleteFilter{CreatedBefore: &cutoff})
+	if err != nil || len(ids) != 1 || ids[0] != kept {
+		t.Fatalf("BulkDeleteWebhooks(created before) = %v, %v; want [%d]", ids, err, kept)
+	}
+
+	for _, id := range []int{othersJunk, orgJunk} {
+		if _, err := client.Webhook.Get(ctx, id); err != nil {
+			t.Errorf("webhook %d: %v, want kept", id, err)
+		}
+	}
+}
@@ -0,0 +1,246 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+	"github.com/jordanlanch/industrydb/ent/webhookdelivery"
+)
+
+// MaxFailedDeliveries is the number of dead-lettered deliveries kept per
+// webhook; older ones are dropped as new ones fail
+const MaxFailedDeliveries = 500
+
+// Dead-letter errors
+var (
+	ErrDeliveryNotFound         = errors.New("webhook delivery not found")
+	ErrDeliveryAlreadyDelivered = errors.New("webhook delivery was already replayed successfully")
+)
+
+// DeliveryAttempt is one attempt to deliver an event
+type DeliveryAttempt struct {
+	At         time.Time `json:"at"`
+	StatusCode int       `json:"status_code,omitempty"` // Zero when no response was received
+	Error      string    `json:"error,omitempty"`
+
+	// refused is set when the URL or the address it resolved to is not
+	// allowed, which retrying can't fix
+	refused bool
+}
+
+// succeeded reports whether the endpoint accepted the delivery
+func (a DeliveryAttempt) succeeded() bool {
+	return a.Error == "" && a.StatusCode >= 200 && a.StatusCode < 300
+}
+
+// FailedDelivery is a dead-lettered delivery
+type FailedDelivery struct {
+	ID             int               `json:"id"`
+	WebhookID      int               `json:"webhook_id"`
+	EventID        string            `json:"event_id,omitempty"`
+	Event          string            `json:"event"`
+	Status         string            `json:"status"`
+	Payload        Payload           `json:"payload"` // As originally sent
+	Attempts       []DeliveryAttempt `json:"attempts"`
+	LastError      string            `json:"last_error,omitempty"`
+	ReplayCount    int               `json:"replay_count"`
+	LastReplayedAt *time.Time        `json:"last_replayed_at,omitempty"`
+	FailedAt       time.Time         `json:"failed_at"`
+}
+
+// deadLetter stores a delivery that failed every retry, dropping the
+// webhook's oldest dead letters past MaxFailedDeliveries
+func (s *Service) deadLetter(ctx context.Context, webhookID int, payload Payload, version string, attempts []DeliveryAttempt) {
+	stored, err := encodeJSON[map[string]interface{}](payload)
+	if err != nil {
+		log.Printf("⚠️  Failed to encode dead-lettered payload for webhook %d: %v", webhookID, err)
+		return
+	}
+	history, err := encodeJSON[[]map[string]interface{}](attempts)
+	if err != nil {
+		log.Printf("⚠️  Failed to encode delivery attempts for webhook %d: %v", webhookID, err)
+		return
+	}
+
+	lastError := ""
+	if len(attempts) > 0 {
+		lastError = attempts[len(attempts)-1].Error
+	}
+
+	_, err = s.client.WebhookDelivery.Create().
+		SetWebhookID(webhookID).
+		SetEventID(payload.ID).
+		SetEvent(payload.Event).
+		SetPayload(stored).
+		SetSchemaVersion(version).
+		SetAttempts(history).
+		SetLastError(lastError).
+		Save(ctx)
+	if err != nil {
+		log.Printf("⚠️  Failed to dead-letter delivery for webhook %d: %v", webhookID, err)
+		return
+	}
+
+	// Keep the newest MaxFailedDeliveries
+	stale, err := s.client.WebhookDelivery.Query().
+		Where(webhookdelivery.WebhookID(webhookID)).
+		Order(ent.Desc(webhookdelivery.FieldID)).
+		Offset(MaxFailedDeliveries).
+		IDs(ctx)
+	if err == nil && len(stale) > 0 {
+		_, err = s.client.WebhookDelivery.Delete().
+			Where(webhookdelivery.IDIn(stale...)).
+			Exec(ctx)
+	}
+	if err != nil {
+		log.Printf("⚠️  Failed to prune dead-lettered deliveries for webhook %d: %v", webhookID, err)
+	}
+}
+
+// ListFailedDeliveries returns a personal webhook's dead-lettered deliveries
+// still failing, newest first, up to limit
+func (s *Service) ListFailedDeliveries(ctx context.Context, webhookID int, userID int, limit int) ([]FailedDelivery, error) {
+	if _, err := s.GetWebhook(ctx, webhookID, userID); err != nil {
+		return nil, err
+	}
+
+	deliveries, err := s.client.WebhookDelivery.Query().
+		Where(
+			webhookdelivery.WebhookID(webhookID),
+			webhookdelivery.StatusEQ(webhookdelivery.StatusFailed),
+		).
+		Order(ent.Desc(webhookdelivery.FieldCreatedAt), ent.Desc(webhookdelivery.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list failed deliveries: %w", err)
+	}
+
+	failed := make([]FailedDelivery, 0, len(deliveries))
+	for _, d := range deliveries {
+		failed = append(failed, toFailedDelivery(d))
+	}
+	return failed, nil
+}
+
+// ReplayDelivery re-attempts a dead-lettered delivery of a personal webhook
+// once, in the webhook's current schema version and signed with its current
+// secret. The event ID is kept so receivers can deduplicate, and the
+// timestamp is refreshed. The attempt is recorded on the delivery, which is
+// marked delivered when it succeeds.
+func (s *Service) ReplayDelivery(ctx context.Context, webhookID int, userID int, deliveryID int) (*FailedDelivery, error) {
+	wh, err := s.GetWebhook(ctx, webhookID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := s.client.WebhookDelivery.Query().
+		Where(
+			webhookdelivery.ID(deliveryID),
+			webhookdelivery.WebhookID(webhookID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrDeliveryNotFound
+		}
+		return nil, fmt.Errorf("failed to get webhook delivery: %w", err)
+	}
+	if d.Status == webhookdelivery.StatusDelivered {
+		return nil, ErrDeliveryAlreadyDelivered
+	}
+
+	payload, err := decodeQueuedEvent(d.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode webhook delivery payload: %w", err)
+	}
+	payload.Timestamp = time.Now().Unix()
+
+	version := ResolveSchemaVersion(wh.SchemaVersion, time.Now())
+	body, err := json.Marshal(RenderPayload(payload, version))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal webhook payload: %w", err)
+	}
+
+	attempt := s.send(wh.URL, body, generateSignature(body, wh.Secret), payload.Event, version, nil)
+	recorded, err := encodeJSON[[]map[string]interface{}]([]DeliveryAttempt{attempt})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode delivery attempt: %w", err)
+	}
+
+	update := s.client.WebhookDelivery.UpdateOne(d).
+		AppendAttempts(recorded).
+		AddReplayCount(1).
+		SetLastReplayedAt(attempt.At)
+	if attempt.succeeded() {
+		update = update.SetStatus(webhookdelivery.StatusDelivered)
+		s.incrementSuccessCount(ctx, wh.ID)
+	} else {
+		update = update.SetLastError(attempt.Error)
+	}
+	d, err = update.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update webhook delivery: %w", err)
+	}
+
+	replayed := toFailedDelivery(d)
+	return &replayed, nil
+}
+
+// deleteDeliveries removes the dead letters of webhooks matching scope,
+// before the webhooks themselves are deleted
+func (s *Service) deleteDeliveries(ctx context.Context, scope predicate.Webhook) error {
+	_, err := s.client.WebhookDelivery.Delete().
+		Where(webhookdelivery.HasWebhookWith(scope)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook deliveries: %w", err)
+	}
+	return nil
+}
+
+// toFailedDelivery converts a stored dead letter, rendering the payload in
+// the schema version it was sent in
+func toFailedDelivery(d *ent.WebhookDelivery) FailedDelivery {
+	payload, err := decodeQueuedEvent(d.Payload)
+	if err != nil {
+		log.Printf("⚠️  Malformed payload on webhook delivery %d: %v", d.ID, err)
+	}
+
+	var attempts []DeliveryAttempt
+	if raw, err := json.Marshal(d.Attempts); err == nil {
+		_ = json.Unmarshal(raw, &attempts)
+	}
+
+	return FailedDelivery{
+		ID:             d.ID,
+		WebhookID:      d.WebhookID,
+		EventID:        d.EventID,
+		Event:          d.Event,
+		Status:         string(d.Status),
+		Payload:        RenderPayload(payload, d.SchemaVersion),
+		Attempts:       attempts,
+		LastError:      d.LastError,
+		ReplayCount:    d.ReplayCount,
+		LastReplayedAt: d.LastReplayedAt,
+		FailedAt:       d.CreatedAt,
+	}
+}
+
+// encodeJSON converts a value to the generic JSON shape stored in ent JSON
+// fields
+func encodeJSON[T any](v interface{}) (T, error) {
+	var out T
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return out, err
+	}
+	err = json.Unmarshal(raw, &out)
+	return out, err
+}
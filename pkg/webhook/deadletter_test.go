@@ -0,0 +1,133 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/ent/webhookdelivery"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestFailedDeliveryReplay(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:webhook_deadletter_test?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	var healthy atomic.Bool
+	var lastBody []byte
+	var lastSignature string
+	allowTestServers(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lastBody, _ = io.ReadAll(r.Body)
+		lastSignature = r.Header.Get("X-Webhook-Signature")
+		if !healthy.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	service := NewService(client)
+
+	u := client.User.Create().
+		SetEmail("deadletter@test.com").
+		SetPasswordHash("hashed").
+		SetName("Dead Letter").
+		SaveX(ctx)
+	wh, err := service.CreateWebhook(ctx, u.ID, server.URL, []string{EventLeadCreated}, "")
+	if err != nil {
+		t.Fatalf("CreateWebhook: %v", err)
+	}
+	wh = client.Webhook.UpdateOne(wh).SetRetryCount(1).SaveX(ctx)
+
+	payload, err := newPayload(EventLeadCreated, map[string]interface{}{"lead_id": 7})
+	if err != nil {
+		t.Fatalf("newPayload: %v", err)
+	}
+	payload.Timestamp = 1000
+	service.deliverPayload(wh, payload)
+
+	failed, err := service.ListFailedDeliveries(ctx, wh.ID, u.ID, 50)
+	if err != nil {
+		t.Fatalf("ListFailedDeliveries: %v", err)
+	}
+	if len(failed) != 1 {
+		t.Fatalf("failed deliveries = %d, want 1", len(failed))
+	}
+	delivery := failed[0]
+	if delivery.EventID != payload.ID || delivery.Payload.Data["lead_id"] != float64(7) {
+		t.Errorf("dead letter = %+v, want the original payload", delivery)
+	}
+	if len(delivery.Attempts) != 2 || delivery.Attempts[0].StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("attempts = %+v, want both retries with status 503", delivery.Attempts)
+	}
+	if delivery.LastError == "" {
+		t.Error("last error is empty")
+	}
+
+	t.Run("Failed replay is recorded", func(t *testing.T) {
+		replayed, err := service.ReplayDelivery(ctx, wh.ID, u.ID, delivery.ID)
+		if err != nil {
+			t.Fatalf("ReplayDelivery: %v", err)
+		}
+		if replayed.Status != string(webhookdelivery.StatusFailed) || len(replayed.Attempts) != 3 || replayed.ReplayCount != 1 {
+			t.Errorf("replayed = %+v, want still failed with 3 attempts", replayed)
+		}
+	})
+
+	t.Run("Successful replay is re-signed with a fresh timestamp", func(t *testing.T) {
+		healthy.Store(true)
+		replayed, err := service.ReplayDelivery(ctx, wh.ID, u.ID, delivery.ID)
+		if err != nil {
+			t.Fatalf("ReplayDelivery: %v", err)
+		}
+		if replayed.Status != string(webhookdelivery.StatusDelivered) || replayed.LastReplayedAt == nil {
+			t.Errorf("replayed = %+v, want delivered", replayed)
+		}
+
+		var sent Payload
+		if err := json.Unmarshal(lastBody, &sent); err != nil {
+			t.Fatalf("replayed body: %v", err)
+		}
+		if sent.ID != payload.ID || sent.Timestamp <= payload.Timestamp {
+			t.Errorf("replayed payload id %s timestamp %d, want id %s and a fresh timestamp", sent.ID, sent.Timestamp, payload.ID)
+		}
+		if !VerifySignature(lastBody, lastSignature, wh.Secret) {
+			t.Error("replayed payload signature does not verify")
+		}
+
+		if failed, _ := service.ListFailedDeliveries(ctx, wh.ID, u.ID, 50); len(failed) != 0 {
+			t.Errorf("failed deliveries after replay = %d, want 0", len(failed))
+		}
+		if _, err := service.ReplayDelivery(ctx, wh.ID, u.ID, delivery.ID); !errors.Is(err, ErrDeliveryAlreadyDelivered) {
+			t.Errorf("second replay error = %v, want ErrDeliveryAlreadyDelivered", err)
+		}
+	})
+
+	t.Run("Other users' webhooks", func(t *testing.T) {
+		if _, err := service.ListFailedDeliveries(ctx, wh.ID, u.ID+1, 50); !ent.IsNotFound(err) {
+			t.Errorf("list error = %v, want not found", err)
+		}
+		if _, err := service.ReplayDelivery(ctx, wh.ID, u.ID, 99999); !errors.Is(err, ErrDeliveryNotFound) {
+			t.Errorf("replay error = %v, want ErrDeliveryNotFound", err)
+		}
+	})
+
+	t.Run("Deleting the webhook removes its dead letters", func(t *testing.T) {
+		if err := service.DeleteWebhook(ctx, wh.ID, u.ID); err != nil {
+			t.Fatalf("DeleteWebhook: %v", err)
+		}
+		if n := client.WebhookDelivery.Query().CountX(ctx); n != 0 {
+			t.Errorf("dead letters after delete = %d, want 0", n)
+		}
+	})
+}
@@ -0,0 +1,120 @@
+package webhook
+
+import (
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/pkg/models"
+)
+
+// LeadEvent is the lead payload of the Zapier new-leads polling trigger.
+type LeadEvent struct {
+	ID           int     `json:"id"`
+	Name         string  `json:"name"`
+	Industry     string  `json:"industry"`
+	SubNiche     string  `json:"sub_niche"`
+	Country      string  `json:"country"`
+	City         string  `json:"city"`
+	Address      string  `json:"address"`
+	PostalCode   string  `json:"postal_code"`
+	Phone        string  `json:"phone"`
+	Email        string  `json:"email"`
+	Website      string  `json:"website"`
+	Latitude     float64 `json:"latitude"`
+	Longitude    float64 `json:"longitude"`
+	Verified     bool    `json:"verified"`
+	QualityScore int     `json:"quality_score"`
+	CreatedAt    string  `json:"created_at"`
+}
+
+// NewLeadEvent builds the new-leads payload for a lead
+func NewLeadEvent(l *ent.Lead) LeadEvent {
+	return LeadEvent{
+		ID:           l.ID,
+		Name:         l.Name,
+		Industry:     string(l.Industry),
+		SubNiche:     l.SubNiche,
+		Country:      l.Country,
+		City:         l.City,
+		Address:      l.Address,
+		PostalCode:   l.PostalCode,
+		Phone:        l.Phone,
+		Email:        l.Email,
+		Website:      l.Website,
+		Latitude:     l.Latitude,
+		Longitude:    l.Longitude,
+		Verified:     l.Verified,
+		QualityScore: l.QualityScore,
+		CreatedAt:    l.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// NewLeadEventFromResponse builds the new-leads payload for a lead as served
+// to a user, keeping the masking and field projection already applied to it
+func NewLeadEventFromResponse(l models.LeadResponse) LeadEvent {
+	createdAt := l.CreatedAt
+	if t, err := time.Parse(time.RFC3339, createdAt); err == nil {
+		createdAt = t.UTC().Format(time.RFC3339)
+	}
+	return LeadEvent{
+		ID:           l.ID,
+		Name:         l.Name,
+		Industry:     l.Industry,
+		SubNiche:     l.SubNiche,
+		Country:      l.Country,
+		City:         l.City,
+		Address:      l.Address,
+		PostalCode:   l.PostalCode,
+		Phone:        l.Phone,
+		Email:        l.Email,
+		Website:      l.Website,
+		Latitude:     l.Latitude,
+		Longitude:    l.Longitude,
+		Verified:     l.Verified,
+		QualityScore: l.QualityScore,
+		CreatedAt:    createdAt,
+	}
+}
+
+// Map returns the event as webhook payload data
+func (e LeadEvent) Map() map[string]interface{} {
+	return map[string]interface{}{
+		"id":            e.ID,
+		"name":          e.Name,
+		"industry":      e.Industry,
+		"sub_niche":     e.SubNiche,
+		"country":       e.Country,
+		"city":          e.City,
+		"address":       e.Address,
+		"postal_code":   e.PostalCode,
+		"phone":         e.Phone,
+		"email":         e.Email,
+		"website":       e.Website,
+		"latitude":      e.Latitude,
+		"longitude":     e.Longitude,
+		"verified":      e.Verified,
+		"quality_score": e.QualityScore,
+		"created_at":    e.CreatedAt,
+	}
+}
+
+// SampleLeadEvent is an example new-leads payload, used as Zapier
+// sample data when an account has no new leads yet
+var SampleLeadEvent = LeadEvent{
+	ID:           123456,
+	Name:         "Ink Masters Studio",
+	Industry:     "tattoo",
+	SubNiche:     "traditional",
+	Country:      "US",
+	City:         "Austin",
+	Address:      "100 Congress Ave",
+	PostalCode:   "78701",
+	Phone:        "+1-512-555-0100",
+	Email:        "hello@inkmasters.example",
+	Website:      "https://inkmasters.example",
+	Latitude:     30.2637,
+	Longitude:    -97.7444,
+	Verified:     true,
+	QualityScore: 85,
+	CreatedAt:    "2026-01-15T09:30:00Z",
+}
@@ -0,0 +1,281 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/leadchange"
+	"github.com/jordanlanch/industrydb/ent/predicate"
+	"github.com/jordanlanch/industrydb/ent/webhook"
+)
+
+// ErrInvalidRedactionProfile is returned when a webhook names an unknown
+// redaction profile
+var ErrInvalidRedactionProfile = errors.New("invalid redaction profile")
+
+// maxLeadChangesPerRun caps the lead changes one DispatchLeadChanges run
+// reads; the rest are sent by the next run
+const maxLeadChangesPerRun = 500
+
+// Redactor applies named redaction profiles to lead fields keyed by JSON
+// field name. export.LeadFieldRedactor implements it with the export
+// redaction profiles.
+type Redactor interface {
+	RedactionProfileNames() []string
+	RedactLeadFields(profile string, fields map[string]interface{})
+}
+
+// SetRedactor enables redaction profiles on webhooks
+func (s *Service) SetRedactor(redactor Redactor) {
+	s.redactor = redactor
+}
+
+// leadDataFields are the lead fields lead.updated events report: the lead
+// data we publish. CRM state (status, tags, custom fields, owner) is private
+// to the account that changed it, and bookkeeping fields aren't lead data,
+// so changes to them are not sent.
+var leadDataFields = map[string]bool{
+	"name":                true,
+	"industry":            true,
+	"sub_niche":           true,
+	"specialties":         true,
+	"cuisine_type":        true,
+	"sport_type":          true,
+	"tattoo_style":        true,
+	"country":             true,
+	"city":                true,
+	"address":             true,
+	"postal_code":         true,
+	"phone":               true,
+	"email":               true,
+	"email_validated":     true,
+	"website":             true,
+	"social_media":        true,
+	"linkedin_url":        true,
+	"twitter_url":         true,
+	"facebook_url":        true,
+	"latitude":            true,
+	"longitude":           true,
+	"verified":            true,
+	"quality_score":       true,
+	"company_description": true,
+	"employee_count":      true,
+	"company_revenue":     true,
+}
+
+// ValidateRedactionProfile checks that name selects a redaction profile. An
+// empty name selects none.
+func (s *Service) ValidateRedactionProfile(name string) error {
+	if name == "" {
+		return nil
+	}
+	var names []string
+	if s.redactor != nil {
+		names = s.redactor.RedactionProfileNames()
+	}
+	for _, available := range names {
+		if available == name {
+			return nil
+		}
+	}
+	if len(names) == 0 {
+		return fmt.Errorf("%w: %q (no profiles are available)", ErrInvalidRedactionProfile, name)
+	}
+	return fmt.Errorf("%w: %q (available: %s)", ErrInvalidRedactionProfile, name, strings.Join(names, ", "))
+}
+
+// SetRedactionProfile sets the redaction profile applied to lead data sent
+// to a webhook. An empty profile sends lead data as is.
+func (s *Service) SetRedactionProfile(ctx context.Context, webhookID int, userID int, profile string) (*ent.Webhook, error) {
+	return s.setRedactionProfile(ctx, webhookID, personal(userID), profile)
+}
+
+// SetOrganizationRedactionProfile sets the redaction profile of an
+// organization webhook
+func (s *Service) SetOrganizationRedactionProfile(ctx context.Context, orgID int, webhookID int, profile string) (*ent.Webhook, error) {
+	return s.setRedactionProfile(ctx, webhookID, webhook.OrganizationID(orgID), profile)
+}
+
+// setRedactionProfile sets the redaction profile of a webhook matching scope
+func (s *Service) setRedactionProfile(ctx context.Context, webhookID int, scope predicate.Webhook, profile string) (*ent.Webhook, error) {
+	if err := s.ValidateRedactionProfile(profile); err != nil {
+		return nil, err
+	}
+
+	wh, err := s.client.Webhook.UpdateOneID(webhookID).
+		Where(scope).
+		SetRedactionProfile(profile).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update webhook: %w", err)
+	}
+
+	return wh, nil
+}
+
+// DispatchLeadChanges sends the lead changes recorded since the last run as
+// lead.updated events to the webhooks subscribed to them, and returns the
+// number of events sent. Each webhook keeps a cursor into the change log, so
+// changes made while no run happened are sent by the next one. Webhooks
+// start with the changes made after their first run.
+func (s *Service) DispatchLeadChanges(ctx context.Context) (int, error) {
+	webhooks, err := s.client.Webhook.Query().
+		Where(webhook.Active(true)).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query webhooks: %w", err)
+	}
+
+	var subscribed []*ent.Webhook
+	for _, wh := range webhooks {
+		if containsEvent(wh.Events, EventLeadUpdated) {
+			subscribed = append(subscribed, wh)
+		}
+	}
+	if len(subscribed) == 0 {
+		return 0, nil
+	}
+
+	latest, err := s.client.LeadChange.Query().
+		Order(ent.Desc(leadchange.FieldID)).
+		FirstID(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return 0, fmt.Errorf("failed to query lead changes: %w", err)
+	}
+
+	var pending []*ent.Webhook
+	from := latest
+	for _, wh := range subscribed {
+		if wh.LeadChangeCursor == nil {
+			// New subscribers start from now
+			err := s.client.Webhook.Update().
+				Where(webhook.ID(wh.ID), webhook.LeadChangeCursorIsNil()).
+				SetLeadChangeCursor(latest).
+				Exec(ctx)
+			if err != nil {
+				return 0, fmt.Errorf("failed to start lead change cursor: %w", err)
+			}
+			continue
+		}
+		if *wh.LeadChangeCursor < latest {
+			pending = append(pending, wh)
+			from = min(from, *wh.LeadChangeCursor)
+		}
+	}
+	if len(pending) == 0 {
+		return 0, nil
+	}
+
+	changes, err := s.client.LeadChange.Query().
+		Where(leadchange.IDGT(from)).
+		Order(ent.Asc(leadchange.FieldID)).
+		Limit(maxLeadChangesPerRun).
+		WithLead().
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query lead changes: %w", err)
+	}
+
+	sent := 0
+	for _, wh := range pending {
+		var due []*ent.LeadChange
+		for _, change := range changes {
+			if change.ID > *wh.LeadChangeCursor {
+				due = append(due, change)
+			}
+		}
+		if len(due) == 0 {
+			continue
+		}
+
+		// Advance the cursor before sending, so a concurrent run on another
+		// instance can't send the same changes
+		claimed, err := s.client.Webhook.Update().
+			Where(webhook.ID(wh.ID), webhook.LeadChangeCursor(*wh.LeadChangeCursor)).
+			SetLeadChangeCursor(due[len(due)-1].ID).
+			Save(ctx)
+		if err != nil {
+			return sent, fmt.Errorf("failed to advance lead change cursor: %w", err)
+		}
+		if claimed == 0 {
+			continue
+		}
+
+		for _, change := range due {
+			if !leadVisibleTo(wh, change.Edges.Lead) {
+				continue
+			}
+			data := s.leadUpdatedData(wh, change)
+			if data == nil {
+				continue
+			}
+			s.dispatch(ctx, []*ent.Webhook{wh}, EventLeadUpdated, data)
+			sent++
+		}
+	}
+
+	return sent, nil
+}
+
+// leadVisibleTo reports whether a webhook may receive events about a lead.
+// Leads an organization owns are only sent to that organization's webhooks.
+func leadVisibleTo(wh *ent.Webhook, l *ent.Lead) bool {
+	if l == nil {
+		return false
+	}
+	if l.OwnerOrganizationID == nil {
+		return true
+	}
+	return wh.OrganizationID != nil && *wh.OrganizationID == *l.OwnerOrganizationID
+}
+
+// leadUpdatedData builds the lead.updated data of a change for a webhook,
+// redacted with its redaction profile, or nil when no published lead field
+// changed
+func (s *Service) leadUpdatedData(wh *ent.Webhook, change *ent.LeadChange) map[string]interface{} {
+	oldValues := make(map[string]interface{})
+	newValues := make(map[string]interface{})
+	for field, values := range change.Changes {
+		if !leadDataFields[field] || len(values) != 2 {
+			continue
+		}
+		oldValues[field] = values[0]
+		newValues[field] = values[1]
+	}
+	if len(newValues) == 0 {
+		return nil
+	}
+
+	if wh.RedactionProfile != "" && s.redactor != nil {
+		s.redactor.RedactLeadFields(wh.RedactionProfile, oldValues)
+		s.redactor.RedactLeadFields(wh.RedactionProfile, newValues)
+	}
+
+	changed := make(map[string]interface{}, len(newValues))
+	for field := range newValues {
+		// Changes the profile hides entirely aren't reported
+		if reflect.DeepEqual(oldValues[field], newValues[field]) {
+			continue
+		}
+		changed[field] = map[string]interface{}{
+			"old": oldValues[field],
+			"new": newValues[field],
+		}
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"lead_id":        change.LeadID,
+		"change_id":      change.ID,
+		"source":         string(change.Source),
+		"changed_at":     change.CreatedAt.UTC().Format(time.RFC3339),
+		"changed_fields": changed,
+	}
+}
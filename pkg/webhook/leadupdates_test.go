@@ -0,0 +1,183 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/ent/leadchange"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// maskingRedactor is a Redactor whose "partner" profile masks emails but
+// their first letter
+type maskingRedactor struct{}
+
+func (maskingRedactor) RedactionProfileNames() []string {
+	return []string{"internal", "partner"}
+}
+
+func (maskingRedactor) RedactLeadFields(profile string, fields map[string]interface{}) {
+	if profile != "partner" {
+		return
+	}
+	if value, ok := fields["email"].(string); ok {
+		fields["email"] = value[:1] + "***"
+	}
+}
+
+// changedFields decodes the changed_fields of a lead.updated delivery
+func changedFields(t *testing.T, body []byte) (int, map[string]map[string]interface{}) {
+	t.Helper()
+	var payload struct {
+		Event string `json:"event"`
+		Data  struct {
+			LeadID        int                               `json:"lead_id"`
+			ChangedFields map[string]map[string]interface{} `json:"changed_fields"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		t.Fatalf("decode delivery: %v", err)
+	}
+	if payload.Event != EventLeadUpdated {
+		t.Fatalf("event = %q, want %q", payload.Event, EventLeadUpdated)
+	}
+	return payload.Data.LeadID, payload.Data.ChangedFields
+}
+
+func TestDispatchLeadChanges(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:webhook_lead_updates_test?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	allowTestServers(t)
+	personalRecorder, orgRecorder := &batchRecorder{}, &batchRecorder{}
+	personalServer := httptest.NewServer(personalRecorder)
+	defer personalServer.Close()
+	orgServer := httptest.NewServer(orgRecorder)
+	defer orgServer.Close()
+
+	ctx := context.Background()
+	service := NewService(client)
+	service.SetRedactor(maskingRedactor{})
+
+	u := client.User.Create().
+		SetEmail("lead-updates@test.com").
+		SetPasswordHash("hashed").
+		SetName("Integrator").
+		SaveX(ctx)
+	org := client.Organization.Create().
+		SetName("Sync Team").
+		SetSlug("sync-team").
+		SetOwnerID(u.ID).
+		SaveX(ctx)
+
+	personal, err := service.CreateWebhook(ctx, u.ID, personalServer.URL, []string{EventLeadUpdated}, "")
+	if err != nil {
+		t.Fatalf("CreateWebhook: %v", err)
+	}
+	if _, err := service.SetRedactionProfile(ctx, personal.ID, u.ID, "partner"); err != nil {
+		t.Fatalf("SetRedactionProfile: %v", err)
+	}
+	if _, err := service.CreateOrganizationWebhook(ctx, org.ID, u.ID, orgServer.URL, []string{EventLeadUpdated}, ""); err != nil {
+		t.Fatalf("CreateOrganizationWebhook: %v", err)
+	}
+	if _, err := service.CreateWebhook(ctx, u.ID, personalServer.URL, []string{EventLeadCreated}, ""); err != nil {
+		t.Fatalf("CreateWebhook: %v", err)
+	}
+
+	newLead := func(name string) *ent.LeadCreate {
+		return client.Lead.Create().SetName(name).SetIndustry("tattoo").SetCountry("US").SetCity("Austin")
+	}
+	global := newLead("Global Ink").SaveX(ctx)
+	owned := newLead("Team Ink").SetOwnerOrganizationID(org.ID).SaveX(ctx)
+	record := func(leadID int, changes map[string][]interface{}) {
+		client.LeadChange.Create().
+			SetLeadID(leadID).
+			SetSource(leadchange.SourceEnrichment).
+			SetChanges(changes).
+			SaveX(ctx)
+	}
+
+	// Changes before a webhook's first run aren't sent
+	record(global.ID, map[string][]interface{}{"city": {"Dallas", "Austin"}})
+	if sent, err := service.DispatchLeadChanges(ctx); err != nil || sent != 0 {
+		t.Fatalf("first DispatchLeadChanges = %d, %v; want 0", sent, err)
+	}
+
+	record(global.ID, map[string][]interface{}{
+		"email":  {"old@ink.example", "new@ink.example"},
+		"status": {"new", "contacted"}, // CRM state isn't published
+	})
+	record(owned.ID, map[string][]interface{}{"phone": {nil, "+1 512 555 0100"}})
+	record(global.ID, map[string][]interface{}{"tags": {nil, []interface{}{"vip"}}})
+
+	sent, err := service.DispatchLeadChanges(ctx)
+	if err != nil || sent != 3 {
+		t.Fatalf("DispatchLeadChanges = %d, %v; want 3", sent, err)
+	}
+	personalRecorder.waitFor(t, 1, 5*time.Second)
+	orgRecorder.waitFor(t, 2, 5*time.Second)
+
+	// The owned lead only goes to its organization, and the personal webhook
+	// gets emails masked by its profile
+	if len(personalRecorder.bodies) != 1 {
+		t.Fatalf("personal deliveries = %d, want 1", len(personalRecorder.bodies))
+	}
+	leadID, fields := changedFields(t, personalRecorder.bodies[0])
+	if leadID != global.ID || len(fields) != 1 {
+		t.Fatalf("personal delivery = lead %d %v, want lead %d email only", leadID, fields, global.ID)
+	}
+	if fields["email"]["old"] != "o***" || fields["email"]["new"] != "n***" {
+		t.Errorf("personal email change = %v, want masked", fields["email"])
+	}
+
+	// Deliveries are concurrent, so they may arrive in any order
+	byLead := make(map[int]map[string]map[string]interface{})
+	for _, body := range orgRecorder.bodies {
+		leadID, fields := changedFields(t, body)
+		byLead[leadID] = fields
+	}
+	if email := byLead[global.ID]["email"]; email["old"] != "old@ink.example" || email["new"] != "new@ink.example" {
+		t.Errorf("organization email change = %v, want unredacted", email)
+	}
+	if phone := byLead[owned.ID]["phone"]; phone["old"] != nil || phone["new"] != "+1 512 555 0100" {
+		t.Errorf("organization phone change = %v, want the owned lead's phone", phone)
+	}
+
+	// Each change is sent once
+	if sent, err := service.DispatchLeadChanges(ctx); err != nil || sent != 0 {
+		t.Errorf("repeated DispatchLeadChanges = %d, %v; want 0", sent, err)
+	}
+
+	// Deactivating restarts the webhook from the latest change
+	active := false
+	if _, err := service.UpdateWebhook(ctx, personal.ID, u.ID, nil, nil, &active, nil); err != nil {
+		t.Fatalf("UpdateWebhook: %v", err)
+	}
+	if wh := client.Webhook.GetX(ctx, personal.ID); wh.LeadChangeCursor != nil {
+		t.Errorf("cursor after deactivation = %d, want nil", *wh.LeadChangeCursor)
+	}
+}
+
+func TestValidateRedactionProfile(t *testing.T) {
+	service := &Service{}
+	if err := service.ValidateRedactionProfile(""); err != nil {
+		t.Errorf(`ValidateRedactionProfile("") = %v, want nil`, err)
+	}
+	if err := service.ValidateRedactionProfile("partner"); !errors.Is(err, ErrInvalidRedactionProfile) {
+		t.Errorf("ValidateRedactionProfile without redactor = %v, want ErrInvalidRedactionProfile", err)
+	}
+
+	service.SetRedactor(maskingRedactor{})
+	if err := service.ValidateRedactionProfile("partner"); err != nil {
+		t.Errorf("ValidateRedactionProfile(partner) = %v, want nil", err)
+	}
+	if err := service.ValidateRedactionProfile("vendor"); !errors.Is(err, ErrInvalidRedactionProfile) {
+		t.Errorf("ValidateRedactionProfile(vendor) = %v, want ErrInvalidRedactionProfile", err)
+	}
+}
@@ -0,0 +1,122 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/organization"
+	"github.com/jordanlanch/industrydb/ent/organizationmember"
+	"github.com/jordanlanch/industrydb/ent/webhook"
+	"github.com/jordanlanch/industrydb/pkg/deliverywindow"
+)
+
+// MaxOrganizationWebhooks is the maximum number of webhooks an
+// organization can have
+const MaxOrganizationWebhooks = 10
+
+// ErrOrganizationWebhookLimit is returned when an organization already has
+// MaxOrganizationWebhooks webhooks
+var ErrOrganizationWebhookLimit = errors.New("organization webhook limit reached")
+
+// CreateOrganizationWebhook creates a webhook owned by an organization.
+// createdBy is recorded as the creator; the webhook keeps working after
+// they leave the organization.
+func (s *Service) CreateOrganizationWebhook(ctx context.Context, orgID int, createdBy int, url string, events []string, description string) (*ent.Webhook, error) {
+	if err := ValidateURL(ctx, url); err != nil {
+		return nil, err
+	}
+
+	count, err := s.client.Webhook.Query().
+		Where(webhook.OrganizationID(orgID)).
+		Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count organization webhooks: %w", err)
+	}
+	if count >= MaxOrganizationWebhooks {
+		return nil, ErrOrganizationWebhookLimit
+	}
+
+	secret, err := generateSecret()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate secret: %w", err)
+	}
+
+	wh, err := s.client.Webhook.Create().
+		SetOrganizationID(orgID).
+		SetUserID(createdBy).
+		SetURL(url).
+		SetEvents(events).
+		SetSecret(secret).
+		SetDescription(description).
+		SetSchemaVersion(LatestSchemaVersion).
+		SetActive(true).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook: %w", err)
+	}
+
+	return wh, nil
+}
+
+// ListOrganizationWebhooks lists the webhooks of an organization, newest
+// first, with their total. Up to limit webhooks after offset are loaded; a
+// limit of 0 loads every webhook.
+func (s *Service) ListOrganizationWebhooks(ctx context.Context, orgID, limit, offset int) ([]*ent.Webhook, int, error) {
+	return s.listWebhooks(ctx, webhook.OrganizationID(orgID), limit, offset)
+}
+
+// UpdateOrganizationWebhook updates an organization webhook. A non-nil
+// schemaVersion pins the payload schema version sent to the webhook.
+func (s *Service) UpdateOrganizationWebhook(ctx context.Context, orgID int, webhookID int, url *string, events []string, active *bool, schemaVersion *string) (*ent.Webhook, error) {
+	return s.updateWebhook(ctx, webhookID, webhook.OrganizationID(orgID), url, events, active, schemaVersion)
+}
+
+// SetOrganizationDeliveryWindow restricts deliveries of an organization
+// webhook to a window of local hours; a nil window delivers 24/7
+func (s *Service) SetOrganizationDeliveryWindow(ctx context.Context, orgID int, webhookID int, window *deliverywindow.Window) (*ent.Webhook, error) {
+	return s.setDeliveryWindow(ctx, webhookID, webhook.OrganizationID(orgID), window)
+}
+
+// DeleteOrganizationWebhook deletes an organization webhook and its
+// dead-lettered deliveries
+func (s *Service) DeleteOrganizationWebhook(ctx context.Context, orgID int, webhookID int) error {
+	if err := s.deleteDeliveries(ctx, webhook.And(webhook.ID(webhookID), webhook.OrganizationID(orgID))); err != nil {
+		return err
+	}
+
+	err := s.client.Webhook.DeleteOneID(webhookID).
+		Where(webhook.OrganizationID(orgID)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+
+	return nil
+}
+
+// TriggerOrganizationWebhooks triggers the active webhooks of every active
+// organization the user is an active member of, so an organization's
+// integrations receive events for all of its members.
+func (s *Service) TriggerOrganizationWebhooks(ctx context.Context, userID int, event string, data map[string]interface{}) {
+	webhooks, err := s.client.Webhook.Query().
+		Where(
+			webhook.Active(true),
+			webhook.HasOrganizationWith(
+				organization.Active(true),
+				organization.HasMembersWith(
+					organizationmember.UserID(userID),
+					organizationmember.StatusEQ(organizationmember.StatusActive),
+				),
+			),
+		).
+		All(ctx)
+	if err != nil {
+		log.Printf("⚠️  Failed to query organization webhooks for event %s: %v", event, err)
+		return
+	}
+
+	s.dispatch(ctx, webhooks, event, data)
+}
@@ -0,0 +1,132 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/ent/organizationmember"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestOrganizationWebhooks(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:webhook_org_test?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	delivered := make(chan string, 10)
+	allowTestServers(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		delivered <- r.Header.Get("X-Webhook-Event")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	service := NewService(client)
+
+	newUser := func(email string) *ent.User {
+		return client.User.Create().
+			SetEmail(email).
+			SetPasswordHash("hashed").
+			SetName(email).
+			SaveX(ctx)
+	}
+	admin := newUser("admin@test.com")
+	member := newUser("member@test.com")
+	outsider := newUser("outsider@test.com")
+
+	org := client.Organization.Create().
+		SetName("Acme").
+		SetSlug("acme").
+		SetOwnerID(admin.ID).
+		SetLastResetAt(time.Now()).
+		SaveX(ctx)
+	adminMembership := client.OrganizationMember.Create().
+		SetOrganizationID(org.ID).
+		SetUserID(admin.ID).
+		SetRole(organizationmember.RoleAdmin).
+		SetStatus(organizationmember.StatusActive).
+		SaveX(ctx)
+	client.OrganizationMember.Create().
+		SetOrganizationID(org.ID).
+		SetUserID(member.ID).
+		SetRole(organizationmember.RoleMember).
+		SetStatus(organizationmember.StatusActive).
+		SaveX(ctx)
+
+	wh, err := service.CreateOrganizationWebhook(ctx, org.ID, admin.ID, server.URL, []string{EventLeadCreated}, "CRM sync")
+	if err != nil {
+		t.Fatalf("CreateOrganizationWebhook: %v", err)
+	}
+	if wh.OrganizationID == nil || *wh.OrganizationID != org.ID || wh.Secret == "" {
+		t.Fatalf("organization webhook = %+v, want organization %d with a secret", wh, org.ID)
+	}
+
+	t.Run("Not listed or editable as the creator's personal webhook", func(t *testing.T) {
+		personalHooks, _, err := service.ListWebhooks(ctx, admin.ID, 0, 0)
+		if err != nil || len(personalHooks) != 0 {
+			t.Errorf("ListWebhooks = %d, %v; want none", len(personalHooks), err)
+		}
+		if err := service.DeleteWebhook(ctx, wh.ID, admin.ID); err != nil {
+			t.Fatalf("DeleteWebhook: %v", err)
+		}
+		if _, err := client.Webhook.Get(ctx, wh.ID); err != nil {
+			t.Errorf("organization webhook deleted through the personal API: %v", err)
+		}
+	})
+
+	t.Run("Fires for events of any member", func(t *testing.T) {
+		service.TriggerOrganizationWebhooks(ctx, outsider.ID, EventLeadCreated, map[string]interface{}{"lead_id": 1})
+		service.TriggerOrganizationWebhooks(ctx, member.ID, EventExportCompleted, map[string]interface{}{"export_id": 1})
+		service.TriggerOrganizationWebhooks(ctx, member.ID, EventLeadCreated, map[string]interface{}{"lead_id": 2})
+
+		select {
+		case event := <-delivered:
+			if event != EventLeadCreated {
+				t.Errorf("delivered event = %q, want %q", event, EventLeadCreated)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("organization webhook was not delivered")
+		}
+		select {
+		case event := <-delivered:
+			t.Errorf("unexpected delivery of %q", event)
+		case <-time.After(200 * time.Millisecond):
+		}
+	})
+
+	t.Run("Survives the creator leaving", func(t *testing.T) {
+		client.OrganizationMember.DeleteOne(adminMembership).ExecX(ctx)
+		service.TriggerOrganizationWebhooks(ctx, member.ID, EventLeadCreated, map[string]interface{}{"lead_id": 3})
+
+		select {
+		case <-delivered:
+		case <-time.After(5 * time.Second):
+			t.Fatal("organization webhook was not delivered after the creator left")
+		}
+	})
+
+	t.Run("Enforces the organization limit", func(t *testing.T) {
+		for i := 1; i < MaxOrganizationWebhooks; i++ {
+			if _, err := service.CreateOrganizationWebhook(ctx, org.ID, member.ID, server.URL, []string{EventExportFailed}, ""); err != nil {
+				t.Fatalf("CreateOrganizationWebhook %d: %v", i, err)
+			}
+		}
+		_, err := service.CreateOrganizationWebhook(ctx, org.ID, member.ID, server.URL, []string{EventExportFailed}, "")
+		if !errors.Is(err, ErrOrganizationWebhookLimit) {
+			t.Errorf("CreateOrganizationWebhook over the limit = %v, want ErrOrganizationWebhookLimit", err)
+		}
+
+		if err := service.DeleteOrganizationWebhook(ctx, org.ID, wh.ID); err != nil {
+			t.Fatalf("DeleteOrganizationWebhook: %v", err)
+		}
+		if _, err := service.CreateOrganizationWebhook(ctx, org.ID, member.ID, server.URL, []string{EventExportFailed}, ""); err != nil {
+			t.Errorf("CreateOrganizationWebhook after delete: %v", err)
+		}
+	})
+}
@@ -8,49 +8,69 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
 	"time"
 
 	"github.com/jordanlanch/industrydb/ent"
+	"github.com/jordanlanch/industrydb/ent/predicate"
 	"github.com/jordanlanch/industrydb/ent/user"
 	"github.com/jordanlanch/industrydb/ent/webhook"
+	"github.com/jordanlanch/industrydb/pkg/deliverywindow"
 )
 
 // Service handles webhook operations
 type Service struct {
 	client     *ent.Client
 	httpClient *http.Client
+	batches    *batcher
+	redactor   Redactor
 }
 
 // NewService creates a new webhook service
 func NewService(client *ent.Client) *Service {
 	return &Service{
-		client: client,
-		httpClient: &http.Client{
-			Timeout: 10 * time.Second,
-		},
+		client:     client,
+		httpClient: NewGuardedClient(10 * time.Second),
+		batches:    newBatcher(),
 	}
 }
 
 // Event types
 const (
 	EventLeadCreated     = "lead.created"
+	EventLeadUpdated     = "lead.updated"
 	EventExportCompleted = "export.completed"
 	EventExportFailed    = "export.failed"
 	EventUserRegistered  = "user.registered"
+	// Sent when a user nears their tier rate limit
+	EventRateLimitWarning = "rate_limit.warning"
 )
 
-// Payload represents a webhook payload
+// MaxQueuedEvents is the maximum number of events buffered for a paused
+// webhook. Events beyond the limit are dropped. Events deferred by a
+// delivery window are not capped, they wait at most a day.
+const MaxQueuedEvents = 100
+
+// Payload represents a webhook payload in the latest schema version
+// (see RenderPayload for older versions)
 type Payload struct {
-	Event     string                 `json:"event"`
-	Data      map[string]interface{} `json:"data"`
-	Timestamp int64                  `json:"timestamp"`
+	SchemaVersion string                 `json:"schema_version"`
+	ID            string                 `json:"id,omitempty"`
+	Event         string                 `json:"event"`
+	Data          map[string]interface{} `json:"data"`
+	Timestamp     int64                  `json:"timestamp"`
+	OccurredAt    string                 `json:"occurred_at,omitempty"`
 }
 
 // CreateWebhook creates a new webhook for a user
 func (s *Service) CreateWebhook(ctx context.Context, userID int, url string, events []string, description string) (*ent.Webhook, error) {
+	if err := ValidateURL(ctx, url); err != nil {
+		return nil, err
+	}
+
 	// Generate secret for HMAC signature
 	secret, err := generateSecret()
 	if err != nil {
@@ -63,6 +83,7 @@ func (s *Service) CreateWebhook(ctx context.Context, userID int, url string, eve
 		SetEvents(events).
 		SetSecret(secret).
 		SetDescription(description).
+		SetSchemaVersion(LatestSchemaVersion).
 		SetActive(true).
 		Save(ctx)
 	if err != nil {
@@ -72,17 +93,41 @@ func (s *Service) CreateWebhook(ctx context.Context, userID int, url string, eve
 	return webhook, nil
 }
 
-// ListWebhooks lists all webhooks for a user
-func (s *Service) ListWebhooks(ctx context.Context, userID int) ([]*ent.Webhook, error) {
-	webhooks, err := s.client.Webhook.Query().
-		Where(webhook.HasUserWith(user.ID(userID))).
-		Order(ent.Desc(webhook.FieldCreatedAt)).
-		All(ctx)
+// personal matches a user's personal webhooks. Organization webhooks the
+// user created belong to the organization and are managed through it.
+func personal(userID int) predicate.Webhook {
+	return webhook.And(
+		webhook.HasUserWith(user.ID(userID)),
+		webhook.OrganizationIDIsNil(),
+	)
+}
+
+// ListWebhooks lists the personal webhooks of a user, newest first, with
+// their total. Up to limit webhooks after offset are loaded; a limit of 0
+// loads every webhook.
+func (s *Service) ListWebhooks(ctx context.Context, userID, limit, offset int) ([]*ent.Webhook, int, error) {
+	return s.listWebhooks(ctx, personal(userID), limit, offset)
+}
+
+// listWebhooks lists a page of the webhooks matching owner, with their total
+func (s *Service) listWebhooks(ctx context.Context, owner predicate.Webhook, limit, offset int) ([]*ent.Webhook, int, error) {
+	query := s.client.Webhook.Query().Where(owner)
+
+	total, err := query.Clone().Count(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list webhooks: %w", err)
+		return nil, 0, fmt.Errorf("failed to count webhooks: %w", err)
 	}
 
-	return webhooks, nil
+	query = query.Order(ent.Desc(webhook.FieldCreatedAt), ent.Desc(webhook.FieldID)).Offset(offset)
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	webhooks, err := query.All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list webhooks: %w", err)
+	}
+
+	return webhooks, total, nil
 }
 
 // GetWebhook retrieves a webhook by ID
@@ -90,7 +135,7 @@ func (s *Service) GetWebhook(ctx context.Context, webhookID int, userID int) (*e
 	wh, err := s.client.Webhook.Query().
 		Where(
 			webhook.ID(webhookID),
-			webhook.HasUserWith(user.ID(userID)),
+			personal(userID),
 		).
 		Only(ctx)
 	if err != nil {
@@ -100,10 +145,28 @@ func (s *Service) GetWebhook(ctx context.Context, webhookID int, userID int) (*e
 	return wh, nil
 }
 
-// UpdateWebhook updates a webhook
-func (s *Service) UpdateWebhook(ctx context.Context, webhookID int, userID int, url *string, events []string, active *bool) (*ent.Webhook, error) {
+// UpdateWebhook updates a webhook. A non-nil schemaVersion pins the
+// payload schema version sent to the webhook.
+func (s *Service) UpdateWebhook(ctx context.Context, webhookID int, userID int, url *string, events []string, active *bool, schemaVersion *string) (*ent.Webhook, error) {
+	return s.updateWebhook(ctx, webhookID, personal(userID), url, events, active, schemaVersion)
+}
+
+// updateWebhook updates a webhook matching scope
+func (s *Service) updateWebhook(ctx context.Context, webhookID int, scope predicate.Webhook, url *string, events []string, active *bool, schemaVersion *string) (*ent.Webhook, error) {
+	if schemaVersion != nil {
+		if err := ValidateSchemaVersion(*schemaVersion, time.Now()); err != nil {
+			return nil, err
+		}
+	}
+
+	if url != nil {
+		if err := ValidateURL(ctx, *url); err != nil {
+			return nil, err
+		}
+	}
+
 	update := s.client.Webhook.UpdateOneID(webhookID).
-		Where(webhook.HasUserWith(user.ID(userID)))
+		Where(scope)
 
 	if url != nil {
 		update.SetURL(*url)
@@ -114,6 +177,14 @@ func (s *Service) UpdateWebhook(ctx context.Context, webhookID int, userID int,
 	if active != nil {
 		update.SetActive(*active)
 	}
+	if (events != nil && !containsEvent(events, EventLeadUpdated)) || (active != nil && !*active) {
+		// lead.updated restarts from the latest change when resubscribed,
+		// rather than replaying the changes missed meanwhile
+		update.ClearLeadChangeCursor()
+	}
+	if schemaVersion != nil {
+		update.SetSchemaVersion(*schemaVersion)
+	}
 
 	wh, err := update.Save(ctx)
 	if err != nil {
@@ -123,12 +194,16 @@ func (s *Service) UpdateWebhook(ctx context.Context, webhookID int, userID int,
 	return wh, nil
 }
 
-// DeleteWebhook deletes a webhook
+// DeleteWebhook deletes a webhook and its dead-lettered deliveries
 func (s *Service) DeleteWebhook(ctx context.Context, webhookID int, userID int) error {
+	if err := s.deleteDeliveries(ctx, webhook.And(webhook.ID(webhookID), personal(userID))); err != nil {
+		return err
+	}
+
 	_, err := s.client.Webhook.Delete().
 		Where(
 			webhook.ID(webhookID),
-			webhook.HasUserWith(user.ID(userID)),
+			personal(userID),
 		).
 		Exec(ctx)
 	if err != nil {
@@ -138,12 +213,210 @@ func (s *Service) DeleteWebhook(ctx context.Context, webhookID int, userID int)
 	return nil
 }
 
-// TriggerWebhooks triggers all active webhooks for a specific event
+// PauseWebhook pauses deliveries for a webhook without changing its
+// configuration. Events are queued while paused. Pausing an already paused
+// webhook is a no-op.
+func (s *Service) PauseWebhook(ctx context.Context, webhookID int, userID int) (*ent.Webhook, error) {
+	wh, err := s.GetWebhook(ctx, webhookID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if wh.PausedAt != nil {
+		return wh, nil
+	}
+
+	wh, err = s.client.Webhook.UpdateOne(wh).
+		SetPausedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pause webhook: %w", err)
+	}
+
+	return wh, nil
+}
+
+// ResumeWebhook resumes deliveries for a paused webhook and replays the
+// events queued during the pause, oldest first. It returns the resumed
+// webhook and the number of events being replayed.
+func (s *Service) ResumeWebhook(ctx context.Context, webhookID int, userID int) (*ent.Webhook, int, error) {
+	wh, err := s.GetWebhook(ctx, webhookID, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if wh.PausedAt == nil {
+		return wh, 0, nil
+	}
+
+	queued := wh.QueuedEvents
+	wh, err = s.client.Webhook.UpdateOne(wh).
+		ClearPausedAt().
+		Save(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to resume webhook: %w", err)
+	}
+
+	payloads := make([]Payload, 0, len(queued))
+	for _, item := range queued {
+		payload, err := decodeQueuedEvent(item)
+		if err != nil {
+			log.Printf("⚠️  Skipping malformed queued webhook event: %v", err)
+			continue
+		}
+		payloads = append(payloads, payload)
+	}
+
+	// Outside the delivery window the queue is delivered when it opens
+	if !DeliveryWindow(wh).Open(time.Now()) {
+		return wh, 0, nil
+	}
+	if _, err := s.client.Webhook.UpdateOne(wh).ClearQueuedEvents().Save(ctx); err != nil {
+		return nil, 0, fmt.Errorf("failed to resume webhook: %w", err)
+	}
+
+	if len(payloads) > 0 {
+		go s.deliverAll(wh, payloads)
+	}
+
+	return wh, len(payloads), nil
+}
+
+// queueEvent buffers an event for a paused webhook, up to MaxQueuedEvents,
+// or for a webhook outside its delivery window.
+func (s *Service) queueEvent(ctx context.Context, wh *ent.Webhook, payload Payload) {
+	paused := wh.PausedAt != nil
+	if paused && len(wh.QueuedEvents) >= MaxQueuedEvents {
+		log.Printf("⚠️  Webhook %d queue is full, dropping event %s", wh.ID, payload.Event)
+		return
+	}
+
+	item := map[string]interface{}{
+		"id":          payload.ID,
+		"event":       payload.Event,
+		"data":        payload.Data,
+		"timestamp":   payload.Timestamp,
+		"occurred_at": payload.OccurredAt,
+	}
+
+	update := s.client.Webhook.UpdateOneID(wh.ID)
+	if paused {
+		update = update.Where(webhook.PausedAtNotNil())
+	}
+	if _, err := update.AppendQueuedEvents([]map[string]interface{}{item}).Save(ctx); err != nil {
+		log.Printf("⚠️  Failed to queue event %s for webhook %d: %v", payload.Event, wh.ID, err)
+	}
+}
+
+// DeliveryWindow returns the webhook's delivery window, nil when it
+// delivers 24/7
+func DeliveryWindow(wh *ent.Webhook) *deliverywindow.Window {
+	return deliverywindow.FromFields(wh.DeliveryTimezone, wh.DeliveryStartHour, wh.DeliveryEndHour)
+}
+
+// SetDeliveryWindow restricts deliveries to a window of local hours. A nil
+// window delivers 24/7; events deferred by the old window are delivered by
+// the next FlushDeferred.
+func (s *Service) SetDeliveryWindow(ctx context.Context, webhookID int, userID int, window *deliverywindow.Window) (*ent.Webhook, error) {
+	return s.setDeliveryWindow(ctx, webhookID, personal(userID), window)
+}
+
+// setDeliveryWindow sets the delivery window of a webhook matching scope
+func (s *Service) setDeliveryWindow(ctx context.Context, webhookID int, scope predicate.Webhook, window *deliverywindow.Window) (*ent.Webhook, error) {
+	if err := window.Validate(); err != nil {
+		return nil, err
+	}
+
+	update := s.client.Webhook.UpdateOneID(webhookID).
+		Where(scope)
+	if window == nil {
+		update = update.ClearDeliveryTimezone().ClearDeliveryStartHour().ClearDeliveryEndHour()
+	} else {
+		update = update.
+			SetDeliveryTimezone(window.Timezone).
+			SetDeliveryStartHour(window.StartHour).
+			SetDeliveryEndHour(window.EndHour)
+	}
+
+	wh, err := update.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update webhook: %w", err)
+	}
+
+	return wh, nil
+}
+
+// FlushDeferred delivers events deferred by a delivery window for webhooks
+// whose window is open at now, oldest first. It returns the number of
+// events delivered.
+func (s *Service) FlushDeferred(ctx context.Context, now time.Time) (int, error) {
+	webhooks, err := s.client.Webhook.Query().
+		Where(
+			webhook.Active(true),
+			webhook.PausedAtIsNil(),
+			webhook.QueuedEventsNotNil(),
+		).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query deferred webhook events: %w", err)
+	}
+
+	flushed := 0
+	for _, wh := range webhooks {
+		if len(wh.QueuedEvents) == 0 || !DeliveryWindow(wh).Open(now) {
+			continue
+		}
+
+		// Claim the queue; events appended since it was read change
+		// updated_at and are picked up by the next run
+		claimed, err := s.client.Webhook.Update().
+			Where(webhook.ID(wh.ID), webhook.UpdatedAt(wh.UpdatedAt)).
+			ClearQueuedEvents().
+			Save(ctx)
+		if err != nil {
+			log.Printf("⚠️  Failed to claim deferred events for webhook %d: %v", wh.ID, err)
+			continue
+		}
+		if claimed == 0 {
+			continue
+		}
+
+		payloads := make([]Payload, 0, len(wh.QueuedEvents))
+		for _, item := range wh.QueuedEvents {
+			payload, err := decodeQueuedEvent(item)
+			if err != nil {
+				log.Printf("⚠️  Skipping malformed deferred webhook event: %v", err)
+				continue
+			}
+			payloads = append(payloads, payload)
+		}
+		flushed += len(payloads)
+
+		go s.deliverAll(wh, payloads)
+	}
+
+	return flushed, nil
+}
+
+// decodeQueuedEvent converts a stored queued event back into a payload.
+func decodeQueuedEvent(item map[string]interface{}) (Payload, error) {
+	var payload Payload
+	raw, err := json.Marshal(item)
+	if err != nil {
+		return payload, err
+	}
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return payload, err
+	}
+	return payload, nil
+}
+
+// TriggerWebhooks triggers all active personal webhooks of a user for a
+// specific event. Organization webhooks are triggered by
+// TriggerOrganizationWebhooks.
 func (s *Service) TriggerWebhooks(ctx context.Context, userID int, event string, data map[string]interface{}) {
 	// Query active webhooks that subscribe to this event
 	webhooks, err := s.client.Webhook.Query().
 		Where(
-			webhook.HasUserWith(user.ID(userID)),
+			personal(userID),
 			webhook.Active(true),
 		).
 		All(ctx)
@@ -152,38 +425,109 @@ func (s *Service) TriggerWebhooks(ctx context.Context, userID int, event string,
 		return
 	}
 
+	s.dispatch(ctx, webhooks, event, data)
+}
+
+// dispatch delivers an event to the webhooks subscribed to it, queuing it
+// for paused webhooks and webhooks outside their delivery window
+func (s *Service) dispatch(ctx context.Context, webhooks []*ent.Webhook, event string, data map[string]interface{}) {
+	payload, err := newPayload(event, data)
+	if err != nil {
+		log.Printf("⚠️  Failed to build webhook payload for event %s: %v", event, err)
+		return
+	}
+
 	// Filter webhooks that subscribe to this event
+	now := time.Now()
 	for _, wh := range webhooks {
 		if containsEvent(wh.Events, event) {
+			// Paused webhooks queue the event for replay on resume
+			if wh.PausedAt != nil {
+				s.queueEvent(ctx, wh, payload)
+				continue
+			}
+
+			// Outside the delivery window, or behind events deferred by it,
+			// the event waits for FlushDeferred
+			if len(wh.QueuedEvents) > 0 || !DeliveryWindow(wh).Open(now) {
+				s.queueEvent(ctx, wh, payload)
+				continue
+			}
+
+			// Batching webhooks collect the event into their next batch
+			if batching := BatchingOf(wh); batching != nil {
+				s.batchEvent(wh, batching, payload)
+				continue
+			}
+
 			// Trigger webhook asynchronously
-			go s.deliverWebhook(wh, event, data)
+			go s.deliverPayload(wh, payload)
 		}
 	}
 }
 
-// deliverWebhook delivers a webhook with retries
-func (s *Service) deliverWebhook(wh *ent.Webhook, event string, data map[string]interface{}) {
-	ctx := context.Background()
-
-	payload := Payload{
-		Event:     event,
-		Data:      data,
-		Timestamp: time.Now().Unix(),
+// newPayload builds a latest-version payload with a unique event ID
+func newPayload(event string, data map[string]interface{}) (Payload, error) {
+	id := make([]byte, 12)
+	if _, err := rand.Read(id); err != nil {
+		return Payload{}, err
 	}
 
+	now := time.Now()
+	return Payload{
+		ID:         "evt_" + hex.EncodeToString(id),
+		Event:      event,
+		Data:       data,
+		Timestamp:  now.Unix(),
+		OccurredAt: now.UTC().Format(time.RFC3339),
+	}, nil
+}
+
+// deliverPayload delivers a payload with retries, rendered in the
+// webhook's pinned schema version
+func (s *Service) deliverPayload(wh *ent.Webhook, payload Payload) {
+	ctx := context.Background()
+	event := payload.Event
+	version := ResolveSchemaVersion(wh.SchemaVersion, time.Now())
+
 	// Marshal payload
-	body, err := json.Marshal(payload)
+	body, err := json.Marshal(RenderPayload(payload, version))
 	if err != nil {
 		log.Printf("⚠️  Failed to marshal webhook payload: %v", err)
 		s.incrementFailureCount(ctx, wh.ID)
 		return
 	}
 
+	attempts, delivered := s.deliverWithRetries(wh, body, event, version, nil)
+	if delivered {
+		log.Printf("✅ Webhook delivered successfully: %s (event: %s)", wh.URL, event)
+		s.incrementSuccessCount(ctx, wh.ID)
+		return
+	}
+
+	// If the webhook was paused while retrying, queue the event instead of
+	// counting a failure against an endpoint the owner knows is down
+	if current, err := s.client.Webhook.Get(ctx, wh.ID); err == nil && current.PausedAt != nil {
+		log.Printf("⏸️  Webhook paused during delivery, queuing event: %s (event: %s)", wh.URL, event)
+		s.queueEvent(ctx, current, payload)
+		return
+	}
+
+	// All retries failed, keep the delivery for inspection and replay
+	log.Printf("❌ Webhook delivery failed after %d attempts: %s (event: %s)", len(attempts), wh.URL, event)
+	s.incrementFailureCount(ctx, wh.ID)
+	s.deadLetter(ctx, wh.ID, payload, version, attempts)
+}
+
+// deliverWithRetries signs and sends a body, retrying with exponential
+// backoff up to the webhook's retry count. It returns the attempts made and
+// whether the last one succeeded.
+func (s *Service) deliverWithRetries(wh *ent.Webhook, body []byte, event, version string, headers map[string]string) ([]DeliveryAttempt, bool) {
 	// Generate HMAC signature
 	signature := generateSignature(body, wh.Secret)
 
-	// Attempt delivery with retries
 	maxRetries := wh.RetryCount
+	attempts := make([]DeliveryAttempt, 0, maxRetries+1)
 	for attempt := 0; attempt <= maxRetries; attempt++ {
 		if attempt > 0 {
 			// Exponential backoff: 2^attempt seconds
@@ -191,39 +535,63 @@ func (s *Service) deliverWebhook(wh *ent.Webhook, event string, data map[string]
 			time.Sleep(backoff)
 		}
 
-		// Create HTTP request
-		req, err := http.NewRequest("POST", wh.URL, bytes.NewReader(body))
-		if err != nil {
-			log.Printf("⚠️  Failed to create webhook request: %v", err)
-			continue
+		result := s.send(wh.URL, body, signature, event, version, headers)
+		attempts = append(attempts, result)
+		if result.succeeded() {
+			return attempts, true
 		}
 
-		req.Header.Set("Content-Type", "application/json")
-		req.Header.Set("X-Webhook-Signature", signature)
-		req.Header.Set("X-Webhook-Event", event)
-
-		// Send request
-		resp, err := s.httpClient.Do(req)
-		if err != nil {
-			log.Printf("⚠️  Webhook delivery failed (attempt %d/%d): %v", attempt+1, maxRetries+1, err)
-			continue
+		log.Printf("⚠️  Webhook delivery failed (attempt %d/%d): %s", attempt+1, maxRetries+1, result.Error)
+		if result.refused {
+			break
 		}
+	}
 
-		// Check response
-		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
-			log.Printf("✅ Webhook delivered successfully: %s (event: %s)", wh.URL, event)
-			s.incrementSuccessCount(ctx, wh.ID)
-			resp.Body.Close()
-			return
-		}
+	return attempts, false
+}
 
-		log.Printf("⚠️  Webhook returned error status %d (attempt %d/%d)", resp.StatusCode, attempt+1, maxRetries+1)
-		resp.Body.Close()
+// send makes one delivery attempt of a signed body, with optional extra
+// headers
+func (s *Service) send(url string, body []byte, signature, event, version string, headers map[string]string) DeliveryAttempt {
+	attempt := DeliveryAttempt{At: time.Now().UTC()}
+
+	// Webhooks saved before URL validation, or before the policy changed,
+	// are checked again; the client checks the addresses it connects to
+	if _, err := checkURL(url); err != nil {
+		attempt.Error = err.Error()
+		attempt.refused = true
+		return attempt
 	}
 
-	// All retries failed
-	log.Printf("❌ Webhook delivery failed after %d attempts: %s (event: %s)", maxRetries+1, wh.URL, event)
-	s.incrementFailureCount(ctx, wh.ID)
+	// Create HTTP request
+	req, err := http.NewRequest("POST", url, bytes.NewReader(body))
+	if err != nil {
+		attempt.Error = fmt.Sprintf("failed to create request: %v", err)
+		return attempt
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Webhook-Signature", signature)
+	req.Header.Set("X-Webhook-Event", event)
+	req.Header.Set("X-Webhook-Schema-Version", version)
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	// Send request
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		attempt.Error = err.Error()
+		attempt.refused = errors.Is(err, ErrPrivateAddress)
+		return attempt
+	}
+	resp.Body.Close()
+
+	attempt.StatusCode = resp.StatusCode
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		attempt.Error = fmt.Sprintf("endpoint returned status %d", resp.StatusCode)
+	}
+	return attempt
 }
 
 // incrementSuccessCount increments the success count for a webhook
@@ -264,6 +632,18 @@ func generateSignature(payload []byte, secret string) string {
 	return hex.EncodeToString(mac.Sum(nil))
 }
 
+// GenerateSecret generates a random signing secret for deliveries made
+// outside of registered webhooks (e.g., export file delivery)
+func GenerateSecret() (string, error) {
+	return generateSecret()
+}
+
+// Sign returns the HMAC-SHA256 signature sent in the X-Webhook-Signature
+// header, so other deliveries are verified the same way as webhooks
+func Sign(payload []byte, secret string) string {
+	return generateSignature(payload, secret)
+}
+
 // VerifySignature verifies the HMAC signature of a webhook payload
 func VerifySignature(payload []byte, signature string, secret string) bool {
 	expected := generateSignature(payload, secret)
@@ -0,0 +1,64 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jordanlanch/industrydb/ent/enttest"
+	"github.com/jordanlanch/industrydb/pkg/deliverywindow"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestDeliveryWindowDefersEvents(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:webhook_window_test?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	allowTestServers(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	service := NewService(client)
+
+	u := client.User.Create().
+		SetEmail("window@test.com").
+		SetPasswordHash("hashed").
+		SetName("Window").
+		SaveX(ctx)
+	wh, err := service.CreateWebhook(ctx, u.ID, server.URL, []string{EventLeadCreated}, "")
+	if err != nil {
+		t.Fatalf("CreateWebhook: %v", err)
+	}
+
+	// A one-hour window that opens an hour from now is closed now
+	now := time.Now().UTC()
+	window := &deliverywindow.Window{Timezone: "UTC", StartHour: (now.Hour() + 1) % 24, EndHour: (now.Hour()+1)%24 + 1}
+	if _, err := service.SetDeliveryWindow(ctx, wh.ID, u.ID, window); err != nil {
+		t.Fatalf("SetDeliveryWindow: %v", err)
+	}
+
+	service.TriggerWebhooks(ctx, u.ID, EventLeadCreated, map[string]interface{}{"lead_id": 1})
+
+	wh = client.Webhook.GetX(ctx, wh.ID)
+	if len(wh.QueuedEvents) != 1 {
+		t.Fatalf("queued events = %d, want 1 deferred outside the window", len(wh.QueuedEvents))
+	}
+
+	flushed, err := service.FlushDeferred(ctx, now)
+	if err != nil || flushed != 0 {
+		t.Errorf("FlushDeferred while closed = %d, %v; want 0", flushed, err)
+	}
+
+	flushed, err = service.FlushDeferred(ctx, window.Next(now))
+	if err != nil || flushed != 1 {
+		t.Errorf("FlushDeferred while open = %d, %v; want 1", flushed, err)
+	}
+	if wh = client.Webhook.GetX(ctx, wh.ID); len(wh.QueuedEvents) != 0 {
+		t.Errorf("queued events after flush = %d, want 0", len(wh.QueuedEvents))
+	}
+}
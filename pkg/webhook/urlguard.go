@@ -0,0 +1,194 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// ErrInvalidURL is returned when an outbound URL is not an absolute https
+// URL on a public host
+var ErrInvalidURL = errors.New("URL not allowed")
+
+// ErrPrivateAddress is returned when a guarded client connects to a
+// non-public IP
+var ErrPrivateAddress = errors.New("connections to private network addresses are not allowed")
+
+// URLPolicy configures which hosts outbound requests may target on top of
+// the built-in rules. It applies to every user-supplied URL the server
+// calls: webhooks, export deliveries and remote imports. Entries are host
+// names or IPs; a leading "." (or "*.") matches every subdomain.
+type URLPolicy struct {
+	// AllowedHosts may be targeted over plain http and on private addresses,
+	// for self-hosted setups and testing
+	AllowedHosts []string
+	// BlockedHosts are our own internal hosts, which may never be targeted
+	// even when they resolve to public IPs
+	BlockedHosts []string
+}
+
+// urlPolicy holds the configured URL policy
+var urlPolicy URLPolicy
+
+// SetURLPolicy replaces the outbound URL policy. It is meant to be called
+// once at startup from configuration.
+func SetURLPolicy(policy URLPolicy) {
+	urlPolicy = URLPolicy{
+		AllowedHosts: normalizeHosts(policy.AllowedHosts),
+		BlockedHosts: normalizeHosts(policy.BlockedHosts),
+	}
+}
+
+func normalizeHosts(hosts []string) []string {
+	normalized := make([]string, 0, len(hosts))
+	for _, host := range hosts {
+		host = strings.ToLower(strings.TrimSpace(host))
+		host = strings.TrimPrefix(host, "*")
+		if host != "" && host != "." {
+			normalized = append(normalized, host)
+		}
+	}
+	return normalized
+}
+
+// matchesHost reports whether host is one of the entries
+func matchesHost(host string, entries []string) bool {
+	for _, entry := range entries {
+		if strings.HasPrefix(entry, ".") {
+			if strings.HasSuffix(host, entry) {
+				return true
+			}
+		} else if host == entry {
+			return true
+		}
+	}
+	return false
+}
+
+// isInternalHost reports whether a host name belongs to a private network
+// or to our own infrastructure
+func isInternalHost(host string) bool {
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") || strings.HasSuffix(host, ".local") || strings.HasSuffix(host, ".internal") {
+		return true
+	}
+	return matchesHost(host, urlPolicy.BlockedHosts)
+}
+
+// isPublicIP reports whether an IP is routable on the public internet
+func isPublicIP(ip net.IP) bool {
+	if ip4 := ip.To4(); ip4 != nil {
+		// "This network" 0.0.0.0/8 and the carrier-grade NAT range
+		// 100.64.0.0/10, which cloud providers use for internal services
+		if ip4[0] == 0 || (ip4[0] == 100 && ip4[1]&0xc0 == 64) {
+			return false
+		}
+	}
+	return !(ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsMulticast())
+}
+
+// checkURL checks an outbound URL without resolving its host: it must be an
+// absolute https URL without credentials whose host is neither internal nor
+// a non-public IP. Hosts in the allowlist may use http and private
+// addresses. It returns the lowercased host.
+func checkURL(raw string) (string, error) {
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" || (u.Scheme != "https" && u.Scheme != "http") {
+		return "", fmt.Errorf("%w: must be an absolute https URL", ErrInvalidURL)
+	}
+	if u.User != nil {
+		return "", fmt.Errorf("%w: credentials in URL are not allowed", ErrInvalidURL)
+	}
+
+	host := strings.ToLower(u.Hostname())
+	if matchesHost(host, urlPolicy.AllowedHosts) {
+		return host, nil
+	}
+	if u.Scheme != "https" {
+		return "", fmt.Errorf("%w: must be an absolute https URL", ErrInvalidURL)
+	}
+	if isInternalHost(host) {
+		return "", fmt.Errorf("%w: host %s is not public", ErrInvalidURL, host)
+	}
+	if ip := net.ParseIP(host); ip != nil && !isPublicIP(ip) {
+		return "", fmt.Errorf("%w: host %s is not public", ErrInvalidURL, host)
+	}
+	return host, nil
+}
+
+// ValidateURL checks that an outbound URL (a webhook, export delivery or
+// remote import URL) is an absolute https URL without credentials whose host
+// is public, resolving host names to reject ones pointing at loopback,
+// private, link-local or CGNAT addresses. Host names that don't resolve are
+// accepted; clients from NewGuardedClient check the addresses they connect
+// to again.
+func ValidateURL(ctx context.Context, raw string) error {
+	host, err := checkURL(raw)
+	if err != nil {
+		return err
+	}
+	if matchesHost(host, urlPolicy.AllowedHosts) || net.ParseIP(host) != nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return nil
+	}
+	for _, addr := range addrs {
+		if !isPublicIP(addr.IP) {
+			return fmt.Errorf("%w: host %s resolves to a non-public address", ErrInvalidURL, host)
+		}
+	}
+	return nil
+}
+
+// NewGuardedClient creates an HTTP client that refuses to connect to
+// non-public addresses unless the host is allowlisted, so host names
+// resolving to internal IPs (including after DNS changes) are rejected at
+// connect time with ErrPrivateAddress. It neither follows redirects nor
+// uses a proxy. A zero timeout leaves the overall request unbounded, for
+// long downloads bounded by their context instead.
+func NewGuardedClient(timeout time.Duration) *http.Client {
+	direct := &net.Dialer{Timeout: 10 * time.Second}
+	guarded := &net.Dialer{
+		Timeout: 10 * time.Second,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !isPublicIP(ip) {
+				return ErrPrivateAddress
+			}
+			return nil
+		},
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, network, address string) (net.Conn, error) {
+				host, _, err := net.SplitHostPort(address)
+				if err == nil && matchesHost(strings.ToLower(host), urlPolicy.AllowedHosts) {
+					return direct.DialContext(ctx, network, address)
+				}
+				return guarded.DialContext(ctx, network, address)
+			},
+			TLSHandshakeTimeout:   10 * time.Second,
+			ResponseHeaderTimeout: 30 * time.Second,
+		},
+		// Redirects could point at internal hosts; requests must not follow them
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
@@ -0,0 +1,126 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Payload schema versions.
+//
+// Supported versions:
+//   - "2" (latest, released 2026-10-16): adds "id", unique per event and
+//     stable across retries and replays, and "occurred_at" (RFC3339).
+//   - "1" (released 2026-02-03, sunset 2027-04-30): the original payload
+//     with "event", "data" and a unix "timestamp".
+//
+// Every delivery carries its version in the "schema_version" field and the
+// X-Webhook-Schema-Version header. Webhooks created before versioning are
+// pinned to "1"; new webhooks use the latest version. After a version's
+// sunset, webhooks pinned to it receive the latest version.
+const (
+	SchemaVersion1      = "1"
+	SchemaVersion2      = "2"
+	LatestSchemaVersion = SchemaVersion2
+)
+
+// ErrUnsupportedSchemaVersion is returned when pinning a webhook to a
+// version that does not exist or is past its sunset
+var ErrUnsupportedSchemaVersion = errors.New("unsupported webhook schema version")
+
+// SchemaVersion describes a payload schema version
+type SchemaVersion struct {
+	Version  string     `json:"version"`
+	Released time.Time  `json:"released"`
+	Sunset   *time.Time `json:"sunset,omitempty"` // Nil while the version has no planned sunset
+	Changes  string     `json:"changes"`
+
+	// downgrade converts a payload in the next newer version to this one
+	downgrade func(Payload) Payload
+}
+
+// schemaVersions is the version registry, newest first
+var schemaVersions = []SchemaVersion{
+	{
+		Version:  SchemaVersion2,
+		Released: time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC),
+		Changes:  "Adds id (unique per event, stable across retries) and occurred_at (RFC3339)",
+	},
+	{
+		Version:   SchemaVersion1,
+		Released:  time.Date(2026, 2, 3, 0, 0, 0, 0, time.UTC),
+		Sunset:    sunsetDate(2027, 4, 30),
+		Changes:   "Original payload: event, data and unix timestamp",
+		downgrade: downgradeToV1,
+	},
+}
+
+// sunsetDate returns a pointer to the given UTC date
+func sunsetDate(year int, month time.Month, day int) *time.Time {
+	t := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	return &t
+}
+
+// downgradeToV1 removes the fields added in version 2
+func downgradeToV1(p Payload) Payload {
+	p.ID = ""
+	p.OccurredAt = ""
+	return p
+}
+
+// SupportedSchemaVersions returns the payload schema versions that can still
+// be pinned, newest first
+func SupportedSchemaVersions(now time.Time) []SchemaVersion {
+	versions := make([]SchemaVersion, 0, len(schemaVersions))
+	for _, v := range schemaVersions {
+		if v.isSupported(now) {
+			versions = append(versions, v)
+		}
+	}
+	return versions
+}
+
+// ValidateSchemaVersion checks that a version exists and is not past its sunset
+func ValidateSchemaVersion(version string, now time.Time) error {
+	for _, v := range schemaVersions {
+		if v.Version == version {
+			if !v.isSupported(now) {
+				return fmt.Errorf("%w: %s was sunset on %s", ErrUnsupportedSchemaVersion, version, v.Sunset.Format("2006-01-02"))
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s", ErrUnsupportedSchemaVersion, version)
+}
+
+// ResolveSchemaVersion returns the version to deliver for a pinned version,
+// falling back to the latest version for unknown or sunset versions
+func ResolveSchemaVersion(pinned string, now time.Time) string {
+	if ValidateSchemaVersion(pinned, now) != nil {
+		return LatestSchemaVersion
+	}
+	return pinned
+}
+
+// RenderPayload converts a payload in the latest version to the given
+// version by applying each downgrade in turn
+func RenderPayload(p Payload, version string) Payload {
+	for _, v := range schemaVersions {
+		if v.downgrade != nil {
+			p = v.downgrade(p)
+		}
+		if v.Version == version {
+			p.SchemaVersion = version
+			return p
+		}
+	}
+
+	// Unknown versions are never pinned (see ResolveSchemaVersion)
+	p.SchemaVersion = LatestSchemaVersion
+	return p
+}
+
+// isSupported reports whether the version is before its sunset
+func (v SchemaVersion) isSupported(now time.Time) bool {
+	return v.Sunset == nil || now.Before(*v.Sunset)
+}
@@ -0,0 +1,59 @@
+package webhook
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRenderPayload(t *testing.T) {
+	latest := Payload{
+		ID:         "evt_123",
+		Event:      EventLeadCreated,
+		Data:       map[string]interface{}{"lead_id": 1},
+		Timestamp:  1706956800,
+		OccurredAt: "2024-02-03T10:40:00Z",
+	}
+
+	v2 := RenderPayload(latest, SchemaVersion2)
+	if v2.SchemaVersion != "2" || v2.ID != "evt_123" || v2.OccurredAt == "" {
+		t.Errorf("version 2 payload = %+v, want id and occurred_at kept", v2)
+	}
+
+	v1 := RenderPayload(latest, SchemaVersion1)
+	if v1.SchemaVersion != "1" || v1.ID != "" || v1.OccurredAt != "" {
+		t.Errorf("version 1 payload = %+v, want id and occurred_at removed", v1)
+	}
+	if v1.Event != latest.Event || v1.Timestamp != latest.Timestamp {
+		t.Errorf("version 1 payload = %+v, want event and timestamp kept", v1)
+	}
+}
+
+func TestValidateSchemaVersion(t *testing.T) {
+	beforeSunset := time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)
+	afterSunset := time.Date(2027, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	if err := ValidateSchemaVersion(SchemaVersion1, beforeSunset); err != nil {
+		t.Errorf("version 1 before sunset: unexpected error %v", err)
+	}
+	if err := ValidateSchemaVersion(SchemaVersion1, afterSunset); !errors.Is(err, ErrUnsupportedSchemaVersion) {
+		t.Errorf("version 1 after sunset: got %v, want ErrUnsupportedSchemaVersion", err)
+	}
+	if err := ValidateSchemaVersion("99", beforeSunset); !errors.Is(err, ErrUnsupportedSchemaVersion) {
+		t.Errorf("unknown version: got %v, want ErrUnsupportedSchemaVersion", err)
+	}
+
+	if got := ResolveSchemaVersion(SchemaVersion1, beforeSunset); got != SchemaVersion1 {
+		t.Errorf("ResolveSchemaVersion before sunset = %s, want 1", got)
+	}
+	if got := ResolveSchemaVersion(SchemaVersion1, afterSunset); got != LatestSchemaVersion {
+		t.Errorf("ResolveSchemaVersion after sunset = %s, want latest", got)
+	}
+	if got := ResolveSchemaVersion("", beforeSunset); got != LatestSchemaVersion {
+		t.Errorf("ResolveSchemaVersion for empty = %s, want latest", got)
+	}
+
+	if got := len(SupportedSchemaVersions(afterSunset)); got != 1 {
+		t.Errorf("SupportedSchemaVersions after sunset returned %d versions, want 1", got)
+	}
+}